@@ -0,0 +1,212 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"clockzen-next/internal/application/dto"
+)
+
+// Analysis types, as the API sends and accepts them
+type (
+	SpendingAnalysisRequest   = dto.SpendingAnalysisRequest
+	SpendingAnalysis          = dto.SpendingAnalysisResponse
+	TrendAnalysisRequest      = dto.TrendAnalysisRequest
+	TrendAnalysis             = dto.TrendAnalysisResponse
+	AnomalyDetectionRequest   = dto.AnomalyDetectionRequest
+	AnomalyDetection          = dto.AnomalyDetectionResponse
+	BacktestRequest           = dto.BacktestRequest
+	Backtest                  = dto.BacktestResponse
+	WhatIfRequest             = dto.WhatIfRequest
+	WhatIf                    = dto.WhatIfResponse
+	ComparePeriodsRequest     = dto.ComparePeriodsRequest
+	ComparePeriods            = dto.ComparePeriodsResponse
+	SafeToSpendRequest        = dto.SafeToSpendRequest
+	SafeToSpend               = dto.SafeToSpendResponse
+	BillNegotiationRequest    = dto.BillNegotiationRequest
+	BillNegotiation           = dto.BillNegotiationResponse
+	RecurringDetectionRequest = dto.RecurringDetectionRequest
+	RecurringDetection        = dto.RecurringDetectionResponse
+	SpendingForecastRequest   = dto.SpendingForecastRequest
+	SpendingForecast          = dto.SpendingForecastResponse
+	AnalysisType              = dto.AnalysisType
+	AnalysisList              = dto.AnalysisListResponse
+	JobAccepted               = dto.JobAcceptedResponse
+)
+
+// Analysis is a stored analysis with its result
+type Analysis struct {
+	dto.AnalysisResultResponse
+	// Result is the analysis' response, such as a SpendingAnalysis for
+	// type "spending"; see DecodeResult
+	Result json.RawMessage `json:"result,omitempty"`
+}
+
+// DecodeResult decodes the analysis' result into v
+func (a *Analysis) DecodeResult(v any) error {
+	return json.Unmarshal(a.Result, v)
+}
+
+// AnalysisService runs spending analyses. Analyses only read the user's
+// transactions, so failed requests are retried like reads.
+type AnalysisService struct {
+	client *Client
+}
+
+// run posts an analysis request to /api/analysis/{name}
+func (s *AnalysisService) run(ctx context.Context, name string, req, resp any) error {
+	return s.client.do(ctx, &request{
+		method:     http.MethodPost,
+		path:       "/api/analysis/" + name,
+		body:       req,
+		idempotent: true,
+	}, resp)
+}
+
+// Spending breaks the user's spending down by category and period
+func (s *AnalysisService) Spending(ctx context.Context, req *SpendingAnalysisRequest) (*SpendingAnalysis, error) {
+	var resp SpendingAnalysis
+	if err := s.run(ctx, "spending", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Trends finds how the user's spending changes over time
+func (s *AnalysisService) Trends(ctx context.Context, req *TrendAnalysisRequest) (*TrendAnalysis, error) {
+	var resp TrendAnalysis
+	if err := s.run(ctx, "trends", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Anomalies finds unusual transactions
+func (s *AnalysisService) Anomalies(ctx context.Context, req *AnomalyDetectionRequest) (*AnomalyDetection, error) {
+	var resp AnomalyDetection
+	if err := s.run(ctx, "anomalies", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Backtest replays a budget against past transactions
+func (s *AnalysisService) Backtest(ctx context.Context, req *BacktestRequest) (*Backtest, error) {
+	var resp Backtest
+	if err := s.run(ctx, "backtest", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BacktestAsync queues a backtest as a job. Its result is stored as an
+// analysis once the job completes.
+func (s *AnalysisService) BacktestAsync(ctx context.Context, req *BacktestRequest) (*JobAccepted, error) {
+	var resp JobAccepted
+	err := s.client.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/analysis/backtest",
+		query:  url.Values{"async": {"true"}},
+		body:   req,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// WhatIf projects the effect of a change to the user's finances
+func (s *AnalysisService) WhatIf(ctx context.Context, req *WhatIfRequest) (*WhatIf, error) {
+	var resp WhatIf
+	if err := s.run(ctx, "what-if", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Compare compares the user's spending in two periods
+func (s *AnalysisService) Compare(ctx context.Context, req *ComparePeriodsRequest) (*ComparePeriods, error) {
+	var resp ComparePeriods
+	if err := s.run(ctx, "compare", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SafeToSpend works out how much the user can spend today
+func (s *AnalysisService) SafeToSpend(ctx context.Context, req *SafeToSpendRequest) (*SafeToSpend, error) {
+	var resp SafeToSpend
+	if err := s.run(ctx, "safe-to-spend", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BillNegotiation finds recurring bills whose price went up
+func (s *AnalysisService) BillNegotiation(ctx context.Context, req *BillNegotiationRequest) (*BillNegotiation, error) {
+	var resp BillNegotiation
+	if err := s.run(ctx, "bill-negotiation", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Recurring finds subscriptions and recurring bills
+func (s *AnalysisService) Recurring(ctx context.Context, req *RecurringDetectionRequest) (*RecurringDetection, error) {
+	var resp RecurringDetection
+	if err := s.run(ctx, "recurring", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Forecast forecasts the user's spending for the coming months
+func (s *AnalysisService) Forecast(ctx context.Context, req *SpendingForecastRequest) (*SpendingForecast, error) {
+	var resp SpendingForecast
+	if err := s.run(ctx, "forecast", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// List lists the user's stored analyses, of one type if analysisType is
+// not empty
+func (s *AnalysisService) List(ctx context.Context, analysisType AnalysisType) (*AnalysisList, error) {
+	query := url.Values{}
+	if analysisType != "" {
+		query.Set("type", string(analysisType))
+	}
+	var resp AnalysisList
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   "/api/analysis",
+		query:  query,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Get returns a stored analysis
+func (s *AnalysisService) Get(ctx context.Context, id string) (*Analysis, error) {
+	var resp Analysis
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   pathf("/api/analysis/%s", id),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Delete deletes a stored analysis
+func (s *AnalysisService) Delete(ctx context.Context, id string) error {
+	return s.client.do(ctx, &request{
+		method: http.MethodDelete,
+		path:   pathf("/api/analysis/%s", id),
+	}, nil)
+}
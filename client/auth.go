@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"clockzen-next/internal/presentation/http/handlers/users"
+)
+
+// TokenSource supplies the bearer tokens requests are sent with
+type TokenSource interface {
+	// Token returns the token to send
+	Token(ctx context.Context) (string, error)
+	// Invalidate drops a token the API rejected, so the next call to Token
+	// gets a new one if the source can
+	Invalidate()
+}
+
+// StaticToken is a token source that always returns the same token, such as
+// one issued to a service
+type StaticToken string
+
+// Token implements TokenSource
+func (t StaticToken) Token(context.Context) (string, error) {
+	return string(t), nil
+}
+
+// Invalidate implements TokenSource; a static token can't be replaced
+func (t StaticToken) Invalidate() {}
+
+// AuthResponse is a signed in user and their bearer token
+type AuthResponse = users.AuthResponse
+
+// tokenRefreshMargin is how long before it expires a token is replaced
+const tokenRefreshMargin = time.Minute
+
+// Login signs in with an email and password and returns the user's token.
+// It does not change the client's token source; see SetCredentials.
+func (c *Client) Login(ctx context.Context, email, password string) (*AuthResponse, error) {
+	var resp AuthResponse
+	err := c.do(ctx, &request{
+		method:    http.MethodPost,
+		path:      "/api/auth/login",
+		body:      users.LoginRequest{Email: email, Password: password},
+		anonymous: true,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetCredentials authenticates the client as the user with the given email
+// and password. The client signs in on its first request and again shortly
+// before the token expires or when it is rejected.
+func (c *Client) SetCredentials(email, password string) {
+	c.SetTokenSource(&passwordSource{client: c, email: email, password: password})
+}
+
+// passwordSource is a token source that signs in with a password
+type passwordSource struct {
+	client   *Client
+	email    string
+	password string
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// Token implements TokenSource
+func (s *passwordSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && time.Now().Add(tokenRefreshMargin).Before(s.expiresAt) {
+		return s.token, nil
+	}
+	resp, err := s.client.Login(ctx, s.email, s.password)
+	if err != nil {
+		return "", err
+	}
+	s.token, s.expiresAt = resp.Token, resp.ExpiresAt
+	return s.token, nil
+}
+
+// Invalidate implements TokenSource
+func (s *passwordSource) Invalidate() {
+	s.mu.Lock()
+	s.token = ""
+	s.mu.Unlock()
+}
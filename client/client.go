@@ -0,0 +1,307 @@
+// Package client is a typed Go client for the REST API, for services that
+// consume it from other processes, such as a remotely run worker.
+//
+// A Client groups its methods by resource:
+//
+//	c, err := client.New(client.DefaultConfig("https://api.example.com"))
+//	c.SetTokenSource(client.StaticToken(token))
+//	conns, err := c.Connections.ListEmail(ctx, &client.ListOptions{Limit: 20})
+//
+// Requests are authenticated with the bearer token of the client's
+// TokenSource. A token rejected with 401 is dropped from the source and the
+// request retried once with a fresh one. Requests that are safe to repeat
+// are retried with exponential backoff on network errors, 429 and 5xx
+// responses; failed requests return an *APIError carrying the API's error
+// code.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config holds the client configuration
+type Config struct {
+	// BaseURL is the API's address, e.g. https://api.example.com
+	BaseURL string
+	// OrganizationID scopes requests to an organization the caller belongs
+	// to; empty for the caller's own data
+	OrganizationID string
+	// HTTPClient sends the requests
+	HTTPClient *http.Client
+	// MaxRetries is how many times a failed request is retried
+	MaxRetries int
+	// RetryBackoff is the wait before the first retry; it doubles with each
+	// retry up to MaxRetryBackoff
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+	// UserAgent is sent with every request
+	UserAgent string
+}
+
+// DefaultConfig returns the default configuration for the API at baseURL
+func DefaultConfig(baseURL string) Config {
+	return Config{
+		BaseURL:         baseURL,
+		HTTPClient:      &http.Client{Timeout: 60 * time.Second},
+		MaxRetries:      3,
+		RetryBackoff:    500 * time.Millisecond,
+		MaxRetryBackoff: 10 * time.Second,
+		UserAgent:       "clockzen-client",
+	}
+}
+
+// Client is a client for the REST API. It is safe for concurrent use.
+type Client struct {
+	baseURL *url.URL
+	config  Config
+	tokens  TokenSource
+
+	// Connections manages email and Google Drive connections
+	Connections *ConnectionsService
+	// Labels manages the Gmail labels and Drive folders synced from
+	// connections
+	Labels *LabelsService
+	// Syncs starts and follows email and Drive syncs
+	Syncs *SyncsService
+	// Analysis runs spending analyses
+	Analysis *AnalysisService
+	// Retirement manages retirement plans, projections and FIRE
+	// calculations
+	Retirement *RetirementService
+}
+
+// New creates a client with the given configuration
+func New(config Config) (*Client, error) {
+	baseURL, err := url.Parse(strings.TrimSuffix(config.BaseURL, "/"))
+	if err != nil || baseURL.Scheme == "" || baseURL.Host == "" {
+		return nil, fmt.Errorf("invalid base URL %q", config.BaseURL)
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.DefaultClient
+	}
+	if config.MaxRetries < 0 {
+		config.MaxRetries = 0
+	}
+
+	c := &Client{baseURL: baseURL, config: config}
+	c.Connections = &ConnectionsService{client: c}
+	c.Labels = &LabelsService{client: c}
+	c.Syncs = &SyncsService{client: c}
+	c.Analysis = &AnalysisService{client: c}
+	c.Retirement = &RetirementService{client: c}
+	return c, nil
+}
+
+// NewDefault creates a client for the API at baseURL with the default
+// configuration
+func NewDefault(baseURL string) (*Client, error) {
+	return New(DefaultConfig(baseURL))
+}
+
+// SetTokenSource sets where the client gets its bearer tokens; requests are
+// sent unauthenticated without one
+func (c *Client) SetTokenSource(tokens TokenSource) {
+	c.tokens = tokens
+}
+
+// APIError is an error response from the API
+type APIError struct {
+	StatusCode int
+	// Code is the API's error code, e.g. "not_found" or "validation_error"
+	Code    string `json:"error"`
+	Message string `json:"message"`
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("api: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("api: %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// IsNotFound reports whether err is an API error for a missing resource
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// request is an API request
+type request struct {
+	method string
+	path   string
+	query  url.Values
+	body   any
+	// idempotent marks POSTs that can be repeated safely, such as analyses
+	// that only read data; other methods are always retried
+	idempotent bool
+	// anonymous requests are sent without a bearer token
+	anonymous bool
+}
+
+// retryable reports whether the request may be sent again after a failure
+func (r *request) retryable() bool {
+	return r.idempotent || r.method != http.MethodPost
+}
+
+// do sends the request and decodes the response into out, which may be nil
+// for responses without a body
+func (c *Client) do(ctx context.Context, req *request, out any) error {
+	var body []byte
+	if req.body != nil {
+		var err error
+		if body, err = json.Marshal(req.body); err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+	}
+
+	reauthorized := false
+	for attempt := 0; ; attempt++ {
+		resp, err := c.send(ctx, req, body)
+		if err == nil && resp.StatusCode == http.StatusUnauthorized && !reauthorized && !req.anonymous && c.tokens != nil {
+			// The token may have expired or been revoked; try once more
+			// with a new one
+			resp.Body.Close()
+			c.tokens.Invalidate()
+			reauthorized = true
+			attempt--
+			continue
+		}
+
+		if attempt < c.config.MaxRetries && req.retryable() && shouldRetry(resp, err) {
+			wait := c.backoff(attempt, resp)
+			if resp != nil {
+				resp.Body.Close()
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		return decodeResponse(resp, out)
+	}
+}
+
+// send sends one attempt of the request
+func (c *Client) send(ctx context.Context, req *request, body []byte) (*http.Response, error) {
+	u := c.baseURL.JoinPath(req.path)
+	u.RawQuery = req.query.Encode()
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, req.method, u.String(), reader)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	httpReq.Header.Set("Accept", "application/json")
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	if c.config.UserAgent != "" {
+		httpReq.Header.Set("User-Agent", c.config.UserAgent)
+	}
+	if c.config.OrganizationID != "" {
+		httpReq.Header.Set("X-Organization-ID", c.config.OrganizationID)
+	}
+	if c.tokens != nil && !req.anonymous {
+		token, err := c.tokens.Token(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("getting token: %w", err)
+		}
+		httpReq.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.config.HTTPClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", req.method, req.path, err)
+	}
+	return resp, nil
+}
+
+// shouldRetry reports whether a failed attempt is worth repeating
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		// Signing in failed with an answer from the API, which a retry
+		// won't change
+		var apiErr *APIError
+		return !errors.As(err, &apiErr) && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns the wait before retrying an attempt: the response's
+// Retry-After when given, otherwise an exponential backoff with jitter
+func (c *Client) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return min(time.Duration(seconds)*time.Second, c.config.MaxRetryBackoff)
+		}
+	}
+	wait := c.config.RetryBackoff << attempt
+	if c.config.MaxRetryBackoff > 0 && (wait > c.config.MaxRetryBackoff || wait <= 0) {
+		wait = c.config.MaxRetryBackoff
+	}
+	if wait <= 0 {
+		return 0
+	}
+	// Spread retries of concurrent requests by up to a quarter
+	return wait - time.Duration(rand.Int64N(int64(wait)/4+1))
+}
+
+// pathf formats an API path, escaping the arguments as path segments
+func pathf(format string, ids ...string) string {
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		args[i] = url.PathEscape(id)
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// decodeResponse decodes a successful response into out, or returns the
+// APIError of a failed one
+func decodeResponse(resp *http.Response, out any) error {
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		apiErr := &APIError{StatusCode: resp.StatusCode}
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+		if json.Unmarshal(data, apiErr) != nil || apiErr.Code == "" {
+			// Routers answer some requests, such as unknown methods, in
+			// plain text
+			apiErr.Message = strings.TrimSpace(string(data))
+		}
+		return apiErr
+	}
+
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	config := DefaultConfig(server.URL)
+	config.RetryBackoff = time.Millisecond
+	config.MaxRetryBackoff = 5 * time.Millisecond
+	c, err := New(config)
+	require.NoError(t, err)
+	return c
+}
+
+func TestTypedRequest(t *testing.T) {
+	var got *http.Request
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		json.NewEncoder(w).Encode(map[string]any{
+			"connections": []map[string]any{{"id": "conn-1", "email": "a@example.com"}},
+			"total":       1,
+			"next_cursor": "abc",
+		})
+	})
+	c.SetTokenSource(StaticToken("secret"))
+	c.config.OrganizationID = "org-1"
+
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	list, err := c.Connections.ListEmail(context.Background(), &ListOptions{
+		Limit:     20,
+		Sort:      "-created_at",
+		Filters:   map[string][]string{"status": {"active", "expired"}},
+		StartDate: &since,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "/api/integrations/email/connections", got.URL.Path)
+	assert.Equal(t, "Bearer secret", got.Header.Get("Authorization"))
+	assert.Equal(t, "org-1", got.Header.Get("X-Organization-ID"))
+	query := got.URL.Query()
+	assert.Equal(t, "20", query.Get("limit"))
+	assert.Equal(t, "-created_at", query.Get("sort"))
+	assert.Equal(t, "active,expired", query.Get("status"))
+	assert.Equal(t, "2026-01-01T00:00:00Z", query.Get("start_date"))
+
+	require.Len(t, list.Connections, 1)
+	assert.Equal(t, "conn-1", list.Connections[0].ID)
+	assert.Equal(t, "abc", list.NextCursor)
+}
+
+func TestRetries(t *testing.T) {
+	t.Run("retries reads until they succeed", func(t *testing.T) {
+		var calls atomic.Int32
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]any{"id": "label-1"})
+		})
+
+		label, err := c.Labels.Get(context.Background(), "label-1")
+		require.NoError(t, err)
+		assert.Equal(t, "label-1", label.ID)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("gives up after MaxRetries", func(t *testing.T) {
+		var calls atomic.Int32
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusBadGateway)
+		})
+
+		_, err := c.Syncs.GetEmail(context.Background(), "sync-1")
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusBadGateway, apiErr.StatusCode)
+		assert.Equal(t, int32(4), calls.Load())
+	})
+
+	t.Run("does not repeat writes", func(t *testing.T) {
+		var calls atomic.Int32
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+
+		_, err := c.Syncs.StartEmail(context.Background(), "conn-1", &EmailSyncRequest{SyncType: "full"})
+		require.Error(t, err)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
+
+func TestErrors(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"not_found","message":"Plan not found"}`))
+	})
+
+	_, err := c.Retirement.GetPlan(context.Background(), "missing")
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "not_found", apiErr.Code)
+	assert.Equal(t, "Plan not found", apiErr.Message)
+	assert.True(t, IsNotFound(err))
+}
+
+func TestCredentials(t *testing.T) {
+	var logins atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/auth/login" {
+			n := logins.Add(1)
+			json.NewEncoder(w).Encode(map[string]any{
+				"token":      []string{"", "expired", "fresh"}[n],
+				"expires_at": time.Now().Add(time.Hour),
+			})
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	c.SetCredentials("a@example.com", "password")
+
+	// The first token is rejected, so the client signs in again
+	require.NoError(t, c.Analysis.Delete(context.Background(), "analysis-1"))
+	assert.Equal(t, int32(2), logins.Load())
+}
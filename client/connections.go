@@ -0,0 +1,185 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"clockzen-next/internal/presentation/http/handlers/integration"
+)
+
+// Connection types, as the API sends and accepts them
+type (
+	EmailConnection              = integration.EmailConnectionResponse
+	EmailConnectionList          = integration.ListEmailConnectionsResponse
+	UpdateEmailConnectionRequest = integration.UpdateEmailConnectionRequest
+	EmailOAuthRequest            = integration.EmailInitiateOAuthRequest
+	DriveConnection              = integration.ConnectionResponse
+	DriveConnectionList          = integration.ListConnectionsResponse
+	UpdateDriveConnectionRequest = integration.UpdateConnectionRequest
+	DriveOAuthRequest            = integration.InitiateOAuthRequest
+	// OAuthStart is the provider URL that starts connecting an account
+	OAuthStart = integration.InitiateOAuthResponse
+)
+
+// ConnectionsService manages email and Google Drive connections. A
+// connection is created by sending the user to the URL of an OAuth start;
+// the provider redirects back to the API, which stores the connection.
+type ConnectionsService struct {
+	client *Client
+}
+
+// StartEmailOAuth returns the URL that connects a mailbox
+func (s *ConnectionsService) StartEmailOAuth(ctx context.Context, req *EmailOAuthRequest) (*OAuthStart, error) {
+	var resp OAuthStart
+	err := s.client.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/integrations/email/oauth/initiate",
+		body:   req,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListEmail lists email connections, the newest first unless opts sorts
+// them otherwise. Filters: status, provider.
+func (s *ConnectionsService) ListEmail(ctx context.Context, opts *ListOptions) (*EmailConnectionList, error) {
+	var resp EmailConnectionList
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   "/api/integrations/email/connections",
+		query:  opts.values(),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetEmail returns an email connection
+func (s *ConnectionsService) GetEmail(ctx context.Context, id string) (*EmailConnection, error) {
+	var resp EmailConnection
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   pathf("/api/integrations/email/connections/%s", id),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateEmail changes an email connection's sync settings
+func (s *ConnectionsService) UpdateEmail(ctx context.Context, id string, req *UpdateEmailConnectionRequest) (*EmailConnection, error) {
+	var resp EmailConnection
+	err := s.client.do(ctx, &request{
+		method: http.MethodPatch,
+		path:   pathf("/api/integrations/email/connections/%s", id),
+		body:   req,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RefreshEmail refreshes an email connection's access token
+func (s *ConnectionsService) RefreshEmail(ctx context.Context, id string) (*EmailConnection, error) {
+	var resp EmailConnection
+	err := s.client.do(ctx, &request{
+		method:     http.MethodPost,
+		path:       pathf("/api/integrations/email/connections/%s/refresh", id),
+		idempotent: true,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DisconnectEmail revokes and deletes an email connection
+func (s *ConnectionsService) DisconnectEmail(ctx context.Context, id string) error {
+	return s.client.do(ctx, &request{
+		method: http.MethodDelete,
+		path:   pathf("/api/integrations/email/connections/%s", id),
+	}, nil)
+}
+
+// StartDriveOAuth returns the URL that connects a Google Drive
+func (s *ConnectionsService) StartDriveOAuth(ctx context.Context, req *DriveOAuthRequest) (*OAuthStart, error) {
+	var resp OAuthStart
+	err := s.client.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/integrations/drive/oauth/initiate",
+		body:   req,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListDrive lists Drive connections, the newest first unless opts sorts
+// them otherwise. Filters: status.
+func (s *ConnectionsService) ListDrive(ctx context.Context, opts *ListOptions) (*DriveConnectionList, error) {
+	var resp DriveConnectionList
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   "/api/integrations/drive/connections",
+		query:  opts.values(),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetDrive returns a Drive connection
+func (s *ConnectionsService) GetDrive(ctx context.Context, id string) (*DriveConnection, error) {
+	var resp DriveConnection
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   pathf("/api/integrations/drive/connections/%s", id),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateDrive changes a Drive connection's sync settings
+func (s *ConnectionsService) UpdateDrive(ctx context.Context, id string, req *UpdateDriveConnectionRequest) (*DriveConnection, error) {
+	var resp DriveConnection
+	err := s.client.do(ctx, &request{
+		method: http.MethodPatch,
+		path:   pathf("/api/integrations/drive/connections/%s", id),
+		body:   req,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RefreshDrive refreshes a Drive connection's access token
+func (s *ConnectionsService) RefreshDrive(ctx context.Context, id string) (*DriveConnection, error) {
+	var resp DriveConnection
+	err := s.client.do(ctx, &request{
+		method:     http.MethodPost,
+		path:       pathf("/api/integrations/drive/connections/%s/refresh", id),
+		idempotent: true,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DisconnectDrive revokes and deletes a Drive connection
+func (s *ConnectionsService) DisconnectDrive(ctx context.Context, id string) error {
+	return s.client.do(ctx, &request{
+		method: http.MethodDelete,
+		path:   pathf("/api/integrations/drive/connections/%s", id),
+	}, nil)
+}
@@ -0,0 +1,250 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"clockzen-next/internal/presentation/http/handlers/integration"
+)
+
+// Label and folder types, as the API sends and accepts them
+type (
+	EmailLabel           = integration.EmailLabelResponse
+	EmailLabelList       = integration.ListEmailLabelsResponse
+	CreateLabelRequest   = integration.CreateEmailLabelRequest
+	UpdateLabelRequest   = integration.UpdateEmailLabelRequest
+	DriveFolder          = integration.FolderResponse
+	DriveFolderList      = integration.ListFoldersResponse
+	CreateFolderRequest  = integration.CreateFolderRequest
+	UpdateFolderRequest  = integration.UpdateFolderRequest
+	PickerFolderList     = integration.PickerFoldersResponse
+	PickerFolder         = integration.PickerFolderResponse
+	SelectFoldersRequest = integration.SelectFoldersRequest
+	FolderSelection      = integration.FolderSelectionRequest
+)
+
+// PickerOptions pages the folder picker's listings of Drive folders
+type PickerOptions struct {
+	// PageSize is the number of folders per page, 100 if zero
+	PageSize int
+	// PageToken is the NextPageToken of the previous page
+	PageToken string
+}
+
+// values encodes the options as query parameters
+func (o *PickerOptions) values() url.Values {
+	query := url.Values{}
+	if o == nil {
+		return query
+	}
+	if o.PageSize > 0 {
+		query.Set("page_size", strconv.Itoa(o.PageSize))
+	}
+	if o.PageToken != "" {
+		query.Set("page_token", o.PageToken)
+	}
+	return query
+}
+
+// LabelsService manages what is synced from connections: the labels of
+// email connections and the folders of Drive connections
+type LabelsService struct {
+	client *Client
+}
+
+// List lists an email connection's labels by name unless opts sorts them
+// otherwise. Filters: label_type.
+func (s *LabelsService) List(ctx context.Context, connectionID string, opts *ListOptions) (*EmailLabelList, error) {
+	var resp EmailLabelList
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   pathf("/api/integrations/email/connections/%s/labels", connectionID),
+		query:  opts.values(),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Fetch reads an email connection's labels from the provider and stores
+// them, returning the synced labels
+func (s *LabelsService) Fetch(ctx context.Context, connectionID string) (*EmailLabelList, error) {
+	var resp EmailLabelList
+	err := s.client.do(ctx, &request{
+		method:     http.MethodPost,
+		path:       pathf("/api/integrations/email/connections/%s/labels/fetch", connectionID),
+		idempotent: true,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Create adds a label to an email connection
+func (s *LabelsService) Create(ctx context.Context, connectionID string, req *CreateLabelRequest) (*EmailLabel, error) {
+	var resp EmailLabel
+	err := s.client.do(ctx, &request{
+		method: http.MethodPost,
+		path:   pathf("/api/integrations/email/connections/%s/labels", connectionID),
+		body:   req,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Get returns a label
+func (s *LabelsService) Get(ctx context.Context, id string) (*EmailLabel, error) {
+	var resp EmailLabel
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   pathf("/api/integrations/email/labels/%s", id),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Update changes a label's sync settings
+func (s *LabelsService) Update(ctx context.Context, id string, req *UpdateLabelRequest) (*EmailLabel, error) {
+	var resp EmailLabel
+	err := s.client.do(ctx, &request{
+		method: http.MethodPatch,
+		path:   pathf("/api/integrations/email/labels/%s", id),
+		body:   req,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Delete stops syncing a label
+func (s *LabelsService) Delete(ctx context.Context, id string) error {
+	return s.client.do(ctx, &request{
+		method: http.MethodDelete,
+		path:   pathf("/api/integrations/email/labels/%s", id),
+	}, nil)
+}
+
+// ListFolders lists a Drive connection's tracked folders by name unless
+// opts sorts them otherwise. Filters: sync_direction.
+func (s *LabelsService) ListFolders(ctx context.Context, connectionID string, opts *ListOptions) (*DriveFolderList, error) {
+	var resp DriveFolderList
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   pathf("/api/integrations/drive/connections/%s/folders", connectionID),
+		query:  opts.values(),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateFolder starts tracking a folder of a Drive connection
+func (s *LabelsService) CreateFolder(ctx context.Context, connectionID string, req *CreateFolderRequest) (*DriveFolder, error) {
+	var resp DriveFolder
+	err := s.client.do(ctx, &request{
+		method: http.MethodPost,
+		path:   pathf("/api/integrations/drive/connections/%s/folders", connectionID),
+		body:   req,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetFolder returns a tracked folder
+func (s *LabelsService) GetFolder(ctx context.Context, id string) (*DriveFolder, error) {
+	var resp DriveFolder
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   pathf("/api/integrations/drive/folders/%s", id),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateFolder changes a tracked folder's sync settings
+func (s *LabelsService) UpdateFolder(ctx context.Context, id string, req *UpdateFolderRequest) (*DriveFolder, error) {
+	var resp DriveFolder
+	err := s.client.do(ctx, &request{
+		method: http.MethodPatch,
+		path:   pathf("/api/integrations/drive/folders/%s", id),
+		body:   req,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteFolder stops tracking a folder
+func (s *LabelsService) DeleteFolder(ctx context.Context, id string) error {
+	return s.client.do(ctx, &request{
+		method: http.MethodDelete,
+		path:   pathf("/api/integrations/drive/folders/%s", id),
+	}, nil)
+}
+
+// BrowseFolders lists the Drive folders directly within a parent folder,
+// "" or "root" for the top of My Drive, marking those already tracked
+func (s *LabelsService) BrowseFolders(ctx context.Context, connectionID, parentID string, opts *PickerOptions) (*PickerFolderList, error) {
+	query := opts.values()
+	if parentID != "" {
+		query.Set("parent_id", parentID)
+	}
+	var resp PickerFolderList
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   pathf("/api/integrations/drive/connections/%s/picker/folders", connectionID),
+		query:  query,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SearchFolders lists the Drive folders whose name contains name, marking
+// those already tracked
+func (s *LabelsService) SearchFolders(ctx context.Context, connectionID, name string, opts *PickerOptions) (*PickerFolderList, error) {
+	query := opts.values()
+	query.Set("q", name)
+	var resp PickerFolderList
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   pathf("/api/integrations/drive/connections/%s/picker/search", connectionID),
+		query:  query,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SelectFolders tracks the selected Drive folders and stops tracking those
+// deselected, returning the folders now tracked
+func (s *LabelsService) SelectFolders(ctx context.Context, connectionID string, req *SelectFoldersRequest) (*DriveFolderList, error) {
+	var resp DriveFolderList
+	err := s.client.do(ctx, &request{
+		method:     http.MethodPost,
+		path:       pathf("/api/integrations/drive/connections/%s/picker/selections", connectionID),
+		body:       req,
+		idempotent: true,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
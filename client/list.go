@@ -0,0 +1,55 @@
+package client
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ListOptions pages, filters and sorts the integration lists. Zero fields
+// are left to the API's defaults.
+type ListOptions struct {
+	// Limit is the number of rows per page, at most 200
+	Limit int
+	// Cursor is the NextCursor of the previous page
+	Cursor string
+	// Sort is the column to sort by, prefixed with "-" for descending, such
+	// as "-created_at"
+	Sort string
+	// Filters maps columns to the values rows must have one of, such as
+	// {"status": {"active", "expired"}}
+	Filters map[string][]string
+	// StartDate and EndDate limit the list to rows created in the range
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+// values encodes the options as query parameters
+func (o *ListOptions) values() url.Values {
+	query := url.Values{}
+	if o == nil {
+		return query
+	}
+	if o.Limit > 0 {
+		query.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Cursor != "" {
+		query.Set("cursor", o.Cursor)
+	}
+	if o.Sort != "" {
+		query.Set("sort", o.Sort)
+	}
+	for column, values := range o.Filters {
+		if len(values) > 0 {
+			query.Set(column, strings.Join(values, ","))
+		}
+	}
+	if o.StartDate != nil {
+		query.Set("start_date", o.StartDate.Format(time.RFC3339))
+	}
+	if o.EndDate != nil {
+		query.Set("end_date", o.EndDate.Format(time.RFC3339))
+	}
+	return query
+}
@@ -0,0 +1,259 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"clockzen-next/internal/application/dto"
+	"clockzen-next/internal/presentation/http/handlers/retirement"
+)
+
+// Retirement types, as the API sends and accepts them
+type (
+	RetirementPlan          = dto.RetirementPlanResponse
+	RetirementPlanList      = retirement.ListPlansResponse
+	CreatePlanRequest       = retirement.CreatePlanRequest
+	UpdatePlanRequest       = retirement.UpdatePlanRequest
+	Projection              = retirement.Projection
+	ProjectionConfig        = retirement.ProjectionConfig
+	ProjectionList          = retirement.ListProjectionsResponse
+	CreateProjectionRequest = retirement.CreateProjectionRequest
+	UpdateProjectionRequest = retirement.UpdateProjectionRequest
+	FIRECalculation         = retirement.FIRECalculation
+	FIRECalculationList     = retirement.ListFIREResponse
+	CreateFIRERequest       = retirement.CreateFIRERequest
+	UpdateFIRERequest       = retirement.UpdateFIRERequest
+	FIREMilestone           = dto.FIREMilestoneResponse
+)
+
+// RetirementService manages retirement plans, the projections run on them
+// and FIRE calculations
+type RetirementService struct {
+	client *Client
+}
+
+// CreatePlan creates a retirement plan
+func (s *RetirementService) CreatePlan(ctx context.Context, req *CreatePlanRequest) (*RetirementPlan, error) {
+	var resp RetirementPlan
+	err := s.client.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/retirement/plans",
+		body:   req,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListPlans lists the user's retirement plans
+func (s *RetirementService) ListPlans(ctx context.Context) (*RetirementPlanList, error) {
+	var resp RetirementPlanList
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   "/api/retirement/plans",
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetPlan returns a retirement plan
+func (s *RetirementService) GetPlan(ctx context.Context, id string) (*RetirementPlan, error) {
+	var resp RetirementPlan
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   pathf("/api/retirement/plans/%s", id),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdatePlan changes a retirement plan
+func (s *RetirementService) UpdatePlan(ctx context.Context, id string, req *UpdatePlanRequest) (*RetirementPlan, error) {
+	var resp RetirementPlan
+	err := s.client.do(ctx, &request{
+		method: http.MethodPatch,
+		path:   pathf("/api/retirement/plans/%s", id),
+		body:   req,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeletePlan deletes a retirement plan
+func (s *RetirementService) DeletePlan(ctx context.Context, id string) error {
+	return s.client.do(ctx, &request{
+		method: http.MethodDelete,
+		path:   pathf("/api/retirement/plans/%s", id),
+	}, nil)
+}
+
+// CreateProjection creates a projection of a plan; RunProjection computes
+// its results
+func (s *RetirementService) CreateProjection(ctx context.Context, req *CreateProjectionRequest) (*Projection, error) {
+	var resp Projection
+	err := s.client.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/retirement/projections",
+		body:   req,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListProjections lists projections, of one plan if planID is not empty
+func (s *RetirementService) ListProjections(ctx context.Context, planID string) (*ProjectionList, error) {
+	var resp ProjectionList
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   "/api/retirement/projections",
+		query:  planQuery(planID),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetProjection returns a projection
+func (s *RetirementService) GetProjection(ctx context.Context, id string) (*Projection, error) {
+	var resp Projection
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   pathf("/api/retirement/projections/%s", id),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateProjection changes a projection's name or configuration
+func (s *RetirementService) UpdateProjection(ctx context.Context, id string, req *UpdateProjectionRequest) (*Projection, error) {
+	var resp Projection
+	err := s.client.do(ctx, &request{
+		method: http.MethodPatch,
+		path:   pathf("/api/retirement/projections/%s", id),
+		body:   req,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RunProjection computes a projection's results, replacing any earlier ones
+func (s *RetirementService) RunProjection(ctx context.Context, id string) (*Projection, error) {
+	var resp Projection
+	err := s.client.do(ctx, &request{
+		method:     http.MethodPost,
+		path:       pathf("/api/retirement/projections/%s/run", id),
+		idempotent: true,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteProjection deletes a projection
+func (s *RetirementService) DeleteProjection(ctx context.Context, id string) error {
+	return s.client.do(ctx, &request{
+		method: http.MethodDelete,
+		path:   pathf("/api/retirement/projections/%s", id),
+	}, nil)
+}
+
+// CreateFIRE creates and computes a FIRE calculation
+func (s *RetirementService) CreateFIRE(ctx context.Context, req *CreateFIRERequest) (*FIRECalculation, error) {
+	var resp FIRECalculation
+	err := s.client.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/retirement/fire",
+		body:   req,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListFIRE lists FIRE calculations, of one plan if planID is not empty
+func (s *RetirementService) ListFIRE(ctx context.Context, planID string) (*FIRECalculationList, error) {
+	var resp FIRECalculationList
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   "/api/retirement/fire",
+		query:  planQuery(planID),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetFIRE returns a FIRE calculation
+func (s *RetirementService) GetFIRE(ctx context.Context, id string) (*FIRECalculation, error) {
+	var resp FIRECalculation
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   pathf("/api/retirement/fire/%s", id),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateFIRE changes a FIRE calculation and computes it again
+func (s *RetirementService) UpdateFIRE(ctx context.Context, id string, req *UpdateFIRERequest) (*FIRECalculation, error) {
+	var resp FIRECalculation
+	err := s.client.do(ctx, &request{
+		method: http.MethodPatch,
+		path:   pathf("/api/retirement/fire/%s", id),
+		body:   req,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// FIREMilestones returns the milestones on a FIRE calculation's timeline
+func (s *RetirementService) FIREMilestones(ctx context.Context, id string) ([]FIREMilestone, error) {
+	var resp []FIREMilestone
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   pathf("/api/retirement/fire/%s/milestones", id),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteFIRE deletes a FIRE calculation
+func (s *RetirementService) DeleteFIRE(ctx context.Context, id string) error {
+	return s.client.do(ctx, &request{
+		method: http.MethodDelete,
+		path:   pathf("/api/retirement/fire/%s", id),
+	}, nil)
+}
+
+// planQuery filters a list to one plan's entries
+func planQuery(planID string) url.Values {
+	query := url.Values{}
+	if planID != "" {
+		query.Set("plan_id", planID)
+	}
+	return query
+}
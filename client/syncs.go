@@ -0,0 +1,167 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"clockzen-next/internal/presentation/http/handlers/integration"
+)
+
+// Sync types, as the API sends and accepts them
+type (
+	EmailSync            = integration.EmailSyncResponse
+	EmailSyncList        = integration.ListEmailSyncsResponse
+	EmailSyncRequest     = integration.EmailTriggerSyncRequest
+	EmailSyncFailureList = integration.ListEmailSyncFailuresResponse
+	DriveSync            = integration.SyncResponse
+	DriveSyncList        = integration.ListSyncsResponse
+	DriveSyncRequest     = integration.TriggerSyncRequest
+)
+
+// SyncsService starts and follows the syncs of email and Drive
+// connections. Syncs run in the background: starting one returns it
+// running, and GetEmail or GetDrive report its progress.
+type SyncsService struct {
+	client *Client
+}
+
+// StartEmail starts syncing an email connection
+func (s *SyncsService) StartEmail(ctx context.Context, connectionID string, req *EmailSyncRequest) (*EmailSync, error) {
+	var resp EmailSync
+	err := s.client.do(ctx, &request{
+		method: http.MethodPost,
+		path:   pathf("/api/integrations/email/connections/%s/sync", connectionID),
+		body:   req,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CancelEmail cancels an email connection's running sync
+func (s *SyncsService) CancelEmail(ctx context.Context, connectionID string) error {
+	return s.client.do(ctx, &request{
+		method:     http.MethodPost,
+		path:       pathf("/api/integrations/email/connections/%s/sync/cancel", connectionID),
+		idempotent: true,
+	}, nil)
+}
+
+// GetEmail returns an email sync
+func (s *SyncsService) GetEmail(ctx context.Context, id string) (*EmailSync, error) {
+	var resp EmailSync
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   pathf("/api/integrations/email/syncs/%s", id),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListEmail lists an email connection's syncs, the latest first unless opts
+// sorts them otherwise. Filters: status, sync_type, label_id.
+func (s *SyncsService) ListEmail(ctx context.Context, connectionID string, opts *ListOptions) (*EmailSyncList, error) {
+	var resp EmailSyncList
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   pathf("/api/integrations/email/connections/%s/syncs", connectionID),
+		query:  opts.values(),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ResumeEmail continues an interrupted email sync from where it stopped
+func (s *SyncsService) ResumeEmail(ctx context.Context, id string) (*EmailSync, error) {
+	var resp EmailSync
+	err := s.client.do(ctx, &request{
+		method: http.MethodPost,
+		path:   pathf("/api/integrations/email/syncs/%s/resume", id),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListEmailFailures lists the messages an email sync failed to process
+func (s *SyncsService) ListEmailFailures(ctx context.Context, id string) (*EmailSyncFailureList, error) {
+	var resp EmailSyncFailureList
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   pathf("/api/integrations/email/syncs/%s/failures", id),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RetryEmailFailures processes the messages an email sync failed on again
+func (s *SyncsService) RetryEmailFailures(ctx context.Context, id string) (*EmailSync, error) {
+	var resp EmailSync
+	err := s.client.do(ctx, &request{
+		method: http.MethodPost,
+		path:   pathf("/api/integrations/email/syncs/%s/retry", id),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// StartDrive starts syncing a Drive connection
+func (s *SyncsService) StartDrive(ctx context.Context, connectionID string, req *DriveSyncRequest) (*DriveSync, error) {
+	var resp DriveSync
+	err := s.client.do(ctx, &request{
+		method: http.MethodPost,
+		path:   pathf("/api/integrations/drive/connections/%s/sync", connectionID),
+		body:   req,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CancelDrive cancels a Drive connection's running sync
+func (s *SyncsService) CancelDrive(ctx context.Context, connectionID string) error {
+	return s.client.do(ctx, &request{
+		method:     http.MethodPost,
+		path:       pathf("/api/integrations/drive/connections/%s/sync/cancel", connectionID),
+		idempotent: true,
+	}, nil)
+}
+
+// GetDrive returns a Drive sync
+func (s *SyncsService) GetDrive(ctx context.Context, id string) (*DriveSync, error) {
+	var resp DriveSync
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   pathf("/api/integrations/drive/syncs/%s", id),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListDrive lists a Drive connection's syncs, the latest first unless opts
+// sorts them otherwise. Filters: status, sync_type, folder_id.
+func (s *SyncsService) ListDrive(ctx context.Context, connectionID string, opts *ListOptions) (*DriveSyncList, error) {
+	var resp DriveSyncList
+	err := s.client.do(ctx, &request{
+		method: http.MethodGet,
+		path:   pathf("/api/integrations/drive/connections/%s/syncs", connectionID),
+		query:  opts.values(),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -2,30 +2,118 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
-	"log"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"runtime/debug"
 	"syscall"
 	"time"
 
+	"github.com/redis/go-redis/v9"
+
+	appalerts "clockzen-next/internal/application/alerts"
+	appbudgets "clockzen-next/internal/application/budgets"
+	appdebts "clockzen-next/internal/application/debts"
+	"clockzen-next/internal/application/dto"
+	appemergencyfund "clockzen-next/internal/application/emergencyfund"
+	appintegration "clockzen-next/internal/application/integration"
+	"clockzen-next/internal/application/jobs"
+	appmerchants "clockzen-next/internal/application/merchants"
+	apporganizations "clockzen-next/internal/application/organizations"
+	appreceipts "clockzen-next/internal/application/receipts"
+	appRetirement "clockzen-next/internal/application/retirement"
+	apptransactions "clockzen-next/internal/application/transactions"
+	appusers "clockzen-next/internal/application/users"
+	appwebhooks "clockzen-next/internal/application/webhooks"
 	"clockzen-next/internal/ent"
-	"clockzen-next/internal/infrastructure/google"
+	"clockzen-next/internal/infrastructure/capitalmarkets"
+	"clockzen-next/internal/infrastructure/config"
+	"clockzen-next/internal/infrastructure/dbmigrate"
+	"clockzen-next/internal/infrastructure/i18n"
+	"clockzen-next/internal/infrastructure/logging"
+	"clockzen-next/internal/infrastructure/mail"
+	"clockzen-next/internal/infrastructure/oauthstate"
+	"clockzen-next/internal/infrastructure/observability"
+	"clockzen-next/internal/infrastructure/queue"
+	"clockzen-next/internal/infrastructure/storage"
+	"clockzen-next/internal/infrastructure/telemetry"
+	"clockzen-next/internal/infrastructure/tracing"
+	"clockzen-next/internal/presentation/graph"
 	"clockzen-next/internal/presentation/http/handlers/admin"
+	"clockzen-next/internal/presentation/http/handlers/alerts"
 	"clockzen-next/internal/presentation/http/handlers/analysis"
+	"clockzen-next/internal/presentation/http/handlers/budgets"
+	confighandlers "clockzen-next/internal/presentation/http/handlers/config"
+	"clockzen-next/internal/presentation/http/handlers/debts"
+	"clockzen-next/internal/presentation/http/handlers/emergencyfund"
+	"clockzen-next/internal/presentation/http/handlers/goals"
 	"clockzen-next/internal/presentation/http/handlers/integration"
+	jobhandlers "clockzen-next/internal/presentation/http/handlers/jobs"
+	"clockzen-next/internal/presentation/http/handlers/merchants"
+	"clockzen-next/internal/presentation/http/handlers/organizations"
+	"clockzen-next/internal/presentation/http/handlers/receipts"
+	"clockzen-next/internal/presentation/http/handlers/reports"
 	"clockzen-next/internal/presentation/http/handlers/retirement"
 	"clockzen-next/internal/presentation/http/handlers/rules"
+	"clockzen-next/internal/presentation/http/handlers/search"
+	telemetryhandlers "clockzen-next/internal/presentation/http/handlers/telemetry"
+	"clockzen-next/internal/presentation/http/handlers/transactions"
+	"clockzen-next/internal/presentation/http/handlers/users"
+	"clockzen-next/internal/presentation/http/handlers/webhooks"
 	"clockzen-next/internal/presentation/http/middleware"
+	"clockzen-next/internal/presentation/rpc"
 
 	_ "github.com/lib/pq"
 )
 
 func main() {
-	// Get configuration from environment
-	port := getEnv("PORT", "8080")
-	dbURL := getEnv("DATABASE_URL", "")
+	// Load configuration from the config file, environment and flags; the
+	// server doesn't start with missing or invalid settings
+	cfg := config.DefaultAPI()
+	if err := config.Load(&cfg, config.Options{FlagSet: flag.CommandLine, Args: os.Args[1:]}); err != nil {
+		fmt.Fprintf(os.Stderr, "invalid configuration: %v\n", err)
+		os.Exit(1)
+	}
+	port := cfg.Server.Port
+	dbURL := cfg.Database.URL.Reveal()
+
+	// Configure structured logging; LOG_FORMAT=json for log aggregation
+	slog.SetDefault(newLogger("clockzen-api", cfg.Logging))
+	slog.Info("configuration loaded", "config", config.Sanitize(cfg))
+
+	// Trace requests through sync services, Google API calls and queries
+	shutdownTracing := setupTracing(cfg.Tracing.Config("clockzen-api"))
+
+	// Configure authentication; every API route except health checks, sign
+	// in and OAuth callbacks requires a signed bearer token
+	authConfig := middleware.AuthConfig{
+		Secret: []byte(cfg.Auth.JWTSecret.Reveal()),
+		Issuer: cfg.Auth.JWTIssuer,
+		Leeway: cfg.Auth.Leeway,
+	}
+	requireAuth := middleware.RequireAuth(authConfig)
+
+	// Track per-route latency against the SLO and keep recent slow queries
+	// for /api/admin/slo and /api/admin/slow-queries
+	sloConfig := observability.DefaultSLOConfig()
+	sloConfig.LatencyTarget = cfg.SLO.LatencyTarget
+	sloConfig.Objective = cfg.SLO.Objective
+	sloTracker := observability.NewSLOTracker(sloConfig)
+
+	slowQueryLog := observability.NewSlowQueryLog(200)
+	slowQueryConfig := observability.DefaultSlowQueryConfig()
+	slowQueryConfig.Threshold = cfg.Database.SlowQueryThreshold
+	slowQueryConfig.Log = slowQueryLog
+
+	// Anonymous usage telemetry is opt-in; see setupTelemetry
+	telemetryReporter := setupTelemetry(cfg.Telemetry.Config())
+	telemetryReporter.Start(context.Background())
 
 	// Create HTTP server mux
 	mux := http.NewServeMux()
@@ -34,62 +122,359 @@ func main() {
 	mux.HandleFunc("/health", handleHealth)
 	mux.HandleFunc("/api/health", handleHealth)
 
-	// Register admin routes (protected by admin middleware)
-	// Create admin router and wrap with RequireAdmin middleware
+	// Register admin routes; they are mounted behind the auth middleware
+	// and only served to verified users with the admin role
 	adminRouter := admin.NewDefaultRouter()
+	adminRouter.GetObservabilityHandler().SetSLOTracker(sloTracker)
+	adminRouter.GetObservabilityHandler().SetSlowQueryLog(slowQueryLog)
+
+	// Self-hosters can receive their installations' telemetry here instead
+	// of sending it elsewhere; totals are served by /api/admin/telemetry
+	if cfg.TelemetryReceiver.Enabled {
+		aggregator := telemetry.NewAggregatorWithDefaults()
+		adminRouter.GetObservabilityHandler().SetTelemetryAggregator(aggregator)
+		telemetryhandlers.NewDefaultRouter(aggregator, cfg.TelemetryReceiver.Token.Reveal()).RegisterRoutes(mux)
+		slog.Info("telemetry receiver enabled")
+	}
 	adminMux := http.NewServeMux()
 	adminRouter.RegisterRoutes(adminMux)
-	mux.Handle("/api/admin/", middleware.RequireAdmin(adminMux))
+	mux.Handle("/api/admin/", requireAuth(middleware.RequireAdmin(adminMux)))
+
+	// Authenticated API routes are registered on their own mux, which is
+	// mounted behind the auth middleware once the routes needing the
+	// database are known
+	apiMux := http.NewServeMux()
+	var organizationMembers middleware.OrganizationMembers
+
+	// Admins can check the settings the server is running with, secrets
+	// redacted
+	configMux := http.NewServeMux()
+	confighandlers.NewDefaultRouter(config.Sanitize(cfg)).RegisterRoutes(configMux)
+	apiMux.Handle("/api/config", middleware.RequireAdmin(configMux))
 
 	// Register retirement routes (doesn't require DB)
 	retirementRouter := retirement.NewDefaultRouter()
-	retirementRouter.RegisterRoutes(mux)
+	retirementRouter.RegisterRoutes(apiMux)
+
+	// Analyses can load market assumptions from a capital market
+	// assumptions service as the "remote" provider
+	if baseURL := cfg.CapitalMarkets.URL; baseURL != "" {
+		retirementRouter.SetAssumptionProvider(capitalmarkets.NewClient(capitalmarkets.Config{
+			BaseURL: baseURL,
+			APIKey:  cfg.CapitalMarkets.APIKey.Reveal(),
+		}))
+		slog.Info("remote market assumptions enabled")
+	}
 
 	// Register rules routes (doesn't require DB)
 	rulesRouter := rules.NewDefaultRouter()
-	rulesRouter.RegisterRoutes(mux)
+	rulesRouter.RegisterRoutes(apiMux)
 
 	// Register analysis routes (doesn't require DB)
 	analysisRouter := analysis.NewDefaultRouter()
-	analysisRouter.RegisterRoutes(mux)
+	analysisRouter.RegisterRoutes(apiMux)
+
+	// Background jobs need the database job queue
+	var jobScheduler *jobs.Scheduler
+	// The gRPC API serves syncs once the database is configured
+	var syncServer *rpc.SyncServer
 
 	// Register integration routes if database is configured
 	if dbURL != "" {
-		entClient, err := ent.Open("postgres", dbURL)
+		drv, err := observability.OpenDriver("postgres", dbURL, cfg.Database.Pool(), slowQueryConfig)
 		if err != nil {
-			log.Printf("Warning: Failed to connect to database: %v", err)
-			log.Println("Integration routes will not be available")
+			slog.Warn("failed to connect to database; integration routes will not be available", "error", err)
 		} else {
+			entClient := ent.NewClient(ent.Driver(tracing.NewDriver(drv)))
 			defer entClient.Close()
+			// Users authenticated by tokens issued elsewhere get accounts
+			// when they first connect an account or change a budget
+			appusers.Provision(entClient)
 
-			// Run migrations
 			ctx := context.Background()
-			if err := entClient.Schema.Create(ctx); err != nil {
-				log.Printf("Warning: Failed to run migrations: %v", err)
-			} else {
-				log.Println("Database migrations completed")
+			if err := migrateSchema(ctx, drv.DB(), cfg.Database.AutoMigrate); err != nil {
+				slog.Warn("failed to check database migrations", "error", err)
 			}
 
-			// Configure OAuth (from environment)
-			oauthConfig := &google.Config{
-				ClientID:     getEnv("GOOGLE_CLIENT_ID", ""),
-				ClientSecret: getEnv("GOOGLE_CLIENT_SECRET", ""),
-				RedirectURL:  getEnv("GOOGLE_REDIRECT_URL", ""),
+			// Tax brackets and limits for new tax years are kept in the
+			// database, where /api/admin/tax-data saves them, alongside the
+			// built-in years
+			if err := appRetirement.DefaultTaxData().LoadDB(ctx, entClient); err != nil {
+				fatal("failed to load tax data", "error", err)
+			}
+
+			// Configure OAuth
+			oauthConfig := cfg.Google.Config()
+
+			// OAuth tokens are encrypted at rest; the first key in
+			// TOKEN_ENCRYPTION_KEYS seals new tokens, the rest only decrypt
+			keyring, err := cfg.Encryption.Keyring()
+			if err != nil {
+				fatal("failed to load token encryption keys", "error", err)
+			}
+
+			// OAuth states live in Redis when REDIS_URL is set, or else in
+			// the database, so any replica can finish a flow another
+			// started
+			var states oauthstate.Store = oauthstate.NewPostgresStore(entClient)
+			if cfg.Redis.URL != "" {
+				options, err := cfg.Redis.Options()
+				if err != nil {
+					fatal("failed to parse Redis URL", "error", err)
+				}
+				redisClient := redis.NewClient(options)
+				defer redisClient.Close()
+				states = oauthstate.NewDefaultRedisStore(redisClient)
+				slog.Info("oauth states kept in redis", "addr", options.Addr)
 			}
 
 			// Register integration routes
-			integrationRouter := integration.NewDefaultRouter(entClient, oauthConfig)
-			integrationRouter.RegisterRoutes(mux)
-			log.Println("Integration routes registered")
+			tokens := appintegration.NewTokenStore(entClient, keyring)
+			// The sync services are shared with the gRPC API, so syncs
+			// started over either see each other
+			emailSyncService := appintegration.NewEmailSyncServiceWithDefaults(entClient, oauthConfig)
+			driveSyncService := appintegration.NewDriveSyncServiceWithDefaults(entClient, oauthConfig)
+			integrationRouter := integration.NewRouter(
+				integration.NewDriveHandlerWithSyncService(entClient, oauthConfig, driveSyncService),
+				integration.NewEmailHandlerWithSyncService(entClient, oauthConfig, emailSyncService),
+			)
+			// One manager refreshes the tokens for the handlers and syncs
+			integrationRouter.SetTokenManager(appintegration.NewTokenManagerWithDefaults(entClient, oauthConfig, tokens))
+			integrationRouter.SetStateStore(states)
+			syncServer = rpc.NewSyncServer(entClient, emailSyncService, driveSyncService)
+			// SANDBOX_MODE lets email connections be made to a synthetic
+			// mailbox of receipts, for demos and integration tests without
+			// Google credentials
+			if cfg.SandboxMode {
+				integrationRouter.SetSandboxMode(true)
+				slog.Warn("sandbox mode enabled; email connections can use the synthetic sandbox mailbox")
+			}
+			integrationRouter.RegisterPublicRoutes(mux)
+			integrationRouter.RegisterRoutes(apiMux)
+			slog.Info("integration routes registered")
+
+			// Search covers the messages and receipts the integrations
+			// index
+			search.NewDefaultRouter(entClient).RegisterRoutes(apiMux)
+			slog.Info("search routes registered")
+
+			// Users' webhook endpoints are posted events by the worker;
+			// events raised here are queued for it. Endpoint secrets are
+			// encrypted at rest like OAuth tokens.
+			webhookService := appwebhooks.NewService(entClient, keyring)
+			webhooks.NewRouter(webhooks.NewWebhookHandler(webhookService)).RegisterRoutes(apiMux)
+			slog.Info("webhook routes registered")
+
+			// Receipt viewers highlight the fields OCR read, and users
+			// correct them as training feedback
+			receiptService := appreceipts.NewService(entClient)
+			receiptService.SetEventPublisher(webhookService)
+			receipts.NewRouter(receipts.NewReceiptHandler(receiptService)).RegisterRoutes(apiMux)
+			slog.Info("receipt routes registered")
+
+			// Manual transactions are analyzed alongside those from
+			// receipts once analyses run on stored transactions; card
+			// accounts set the periods of statement-aligned analyses
+			transactionService := apptransactions.NewService(entClient)
+			merchantService := appmerchants.NewService(entClient)
+			transactionService.SetMerchantResolver(merchantService)
+			transactions.NewRouter(transactions.NewTransactionHandler(transactionService)).RegisterRoutes(apiMux)
+			analysisRouter.SetTransactionRepository(transactionService)
+			analysisRouter.SetStatementCycleRepository(transactionService)
+			retirementRouter.SetIncomeRepository(transactionService)
+			retirementRouter.SetTransactionRepository(transactionService)
+			slog.Info("transaction routes registered")
+
+			// Transactions are linked to canonical merchants, so spending
+			// at a merchant groups under its clean name
+			merchants.NewDefaultRouter(merchantService).RegisterRoutes(apiMux)
+			slog.Info("merchant routes registered")
+
+			// Recorded debts are projected in the debt payoff what-if
+			// scenario
+			debtService := appdebts.NewService(entClient)
+			debts.NewRouter(debts.NewDebtHandler(debtService)).RegisterRoutes(apiMux)
+			analysisRouter.SetDebtPayoffPlanner(debtService)
+			slog.Info("debt routes registered")
+
+			// Backtests of a budget use the category figures reallocated
+			// in each period
+			budgetService := appbudgets.NewService(entClient)
+			budgets.NewRouter(budgets.NewBudgetHandler(budgetService)).RegisterRoutes(apiMux)
+			analysisRouter.SetReallocationRepository(budgetService)
+			slog.Info("budget routes registered")
+
+			// Emergency fund coverage is part of what-if feasibility; the
+			// worker records its history and raises alerts
+			fundService := appemergencyfund.NewService(entClient, transactionService)
+			emergencyfund.NewRouter(emergencyfund.NewEmergencyFundHandler(fundService)).RegisterRoutes(apiMux)
+			analysisRouter.SetEmergencyFundMonitor(fundService)
+			slog.Info("emergency fund routes registered")
+
+			// Goals linked to a category are tracked by its transactions
+			goals.NewDefaultRouter(entClient, transactionService).RegisterRoutes(apiMux)
+			slog.Info("goal routes registered")
+
+			// The worker raises and delivers alerts on a schedule; users
+			// list them, set their preferences and can evaluate on demand
+			alertService := appalerts.NewService(entClient, transactionService)
+			alertService.SetEventPublisher(webhookService)
+			alerts.NewRouter(alerts.NewAlertHandler(alertService)).RegisterRoutes(apiMux)
+			slog.Info("alert routes registered")
+
+			// Reports are generated on demand; the worker emails them at
+			// month end
+			reports.NewDefaultRouter(entClient, transactionService).RegisterRoutes(apiMux)
+			slog.Info("report routes registered")
+
+			// The admin queue endpoints manage the worker's job queue; jobs
+			// are only enqueued and run by the worker process
+			jobQueue := queue.NewWithDefaults(entClient)
+			adminRouter.GetQueueHandler().SetJobQueue(jobQueue)
+
+			// Long-running analyses are queued as jobs that the worker
+			// runs, so they survive restarts and any instance can report
+			// on them
+			jobService := jobs.NewServiceWithDefaults(entClient, jobQueue)
+			retirementRouter.SetJobService(jobService)
+			analysisRouter.SetJobService(jobService)
+
+			// The scheduler submits user-defined recurring jobs to the
+			// job service. Schedules are stored in the database and each
+			// run is claimed by one instance, so every instance checks for
+			// due schedules. Failed runs are logged as alerts; repeated
+			// failures pause the schedule.
+			jobScheduler = jobs.NewSchedulerWithDefaults(jobService)
+			jobScheduler.SetOnFailure(func(schedule jobs.Schedule, run jobs.ScheduleRun) {
+				slog.Error("scheduled job failed",
+					"alert", true,
+					"schedule_id", schedule.ID,
+					"schedule_name", schedule.Name,
+					"user_id", schedule.UserID,
+					"consecutive_failures", schedule.ConsecutiveFailures,
+					"error", run.Error,
+				)
+			})
+			// Raise capture recommendations from scheduled scans are
+			// logged as notifications
+			retirementRouter.SetOnRaiseCapture(func(userID string, recommendation *dto.RaiseCaptureResponse) {
+				slog.Info("raise capture recommended",
+					"notification", true,
+					"user_id", userID,
+					"cashflow_id", recommendation.CashFlowID,
+					"annual_raise", recommendation.AnnualRaise,
+					"capture_percent", recommendation.CapturePercent,
+					"portfolio_at_retirement_gain", recommendation.PortfolioAtRetirementGain,
+				)
+			})
+			if err := retirementRouter.RegisterScheduledJobs(jobScheduler); err != nil {
+				fatal("failed to register retirement schedules", "error", err)
+			}
+			if err := analysisRouter.RegisterScheduledJobs(jobScheduler); err != nil {
+				fatal("failed to register analysis schedules", "error", err)
+			}
+			if err := jobScheduler.Start(context.Background()); err != nil {
+				fatal("failed to start job scheduler", "error", err)
+			}
+
+			jobhandlers.NewDefaultRouter(jobService, jobScheduler).RegisterRoutes(apiMux)
+			slog.Info("job routes registered")
+
+			// Organizations share connections, budgets and reports among
+			// their members
+			organizationService := apporganizations.NewService(entClient)
+			organizations.NewRouter(organizations.NewOrganizationHandler(organizationService)).RegisterRoutes(apiMux)
+			organizationMembers = organizationService
+			slog.Info("organization routes registered")
+
+			// Users sign up and sign in for a bearer token, with a
+			// password or their Google account, and manage their profile.
+			// Changed email addresses are verified with a token emailed
+			// to them. Deleting an account revokes its connections' OAuth tokens
+			// and purges attachment content from the worker's storage.
+			userService := appusers.NewService(entClient)
+			userService.SetTokenStore(tokens)
+			userService.SetOAuthConfig(oauthConfig)
+			userService.SetMailSender(newMailSender(cfg.Mail))
+			if dir := cfg.AttachmentStorageDir; dir != "" {
+				blobs, err := storage.NewFileStore(dir)
+				if err != nil {
+					fatal("failed to open attachment storage", "error", err)
+				}
+				userService.SetBlobStore(blobs)
+			}
+			usersRouter := users.NewRouter(users.NewUserHandler(userService, authConfig, cfg.Auth.TokenTTL))
+			usersRouter.GetUserHandler().SetStateStore(states)
+			if cfg.Google.LoginRedirectURL != "" {
+				usersRouter.GetUserHandler().SetGoogleConfig(cfg.Google.LoginConfig())
+				slog.Info("google sign in enabled")
+			}
+			usersRouter.RegisterPublicRoutes(mux)
+			usersRouter.RegisterRoutes(apiMux)
+			slog.Info("user routes registered")
+
+			// The GraphQL API reads the same connections, transactions
+			// and budgets as the REST routes, in the caller's scope
+			graphHandler, err := graph.NewHandler(entClient)
+			if err != nil {
+				fatal("failed to build GraphQL schema", "error", err)
+			}
+			apiMux.Handle("/api/graphql", graphHandler)
+			slog.Info("graphql route registered")
 		}
 	} else {
-		log.Println("DATABASE_URL not set, integration routes disabled")
+		slog.Info("DATABASE_URL not set, integration routes disabled")
+	}
+
+	// Text the API generates is in the user's preferred language, falling
+	// back to Accept-Language. Requests naming an organization in
+	// X-Organization-ID act on its data once the user's membership is
+	// checked; viewers can still run analyses, query the read-only GraphQL
+	// API and change their own profile, and the organization routes check
+	// roles themselves.
+	scopeOrganization := middleware.ScopeOrganization(organizationMembers, "/api/analysis/", "/api/graphql", "/api/organizations", "/api/users/")
+	mux.Handle("/api/", requireAuth(middleware.Localize(i18n.Default())(scopeOrganization(apiMux))))
+
+	// Internal consumers can use the gRPC API, which streams sync progress
+	// and has typed errors. It authenticates and scopes calls like the
+	// HTTP API.
+	var grpcServer *rpc.Server
+	if grpcPort := cfg.GRPCPort; grpcPort != "" {
+		grpcServer = rpc.NewServer(authConfig, organizationMembers)
+		grpcServer.RegisterAnalysisService(rpc.NewAnalysisServer(analysisRouter.GetHandler()))
+		if syncServer != nil {
+			grpcServer.RegisterSyncService(syncServer)
+		}
+
+		lis, err := net.Listen("tcp", ":"+grpcPort)
+		if err != nil {
+			fatal("failed to listen for gRPC", "error", err)
+		}
+		go func() {
+			slog.Info("starting gRPC server", "port", grpcPort)
+			if err := grpcServer.Serve(lis); err != nil {
+				fatal("failed to start gRPC server", "error", err)
+			}
+		}()
 	}
 
+	// Wrap the mux in middleware, outermost last. Request IDs are assigned
+	// first so every log line written while serving a request carries one.
+	// Requests' queries are cancelled at their deadline, so a slow
+	// analysis can't hold its database connections after the client has
+	// given up
+	var handler http.Handler = middleware.RequestTimeout(cfg.RequestTimeout)(mux)
+	handler = middleware.TrackSLO(sloTracker)(handler)
+	handler = middleware.RecordUsage(telemetryReporter)(handler)
+	handler = middleware.LogRequests(slog.Default())(handler)
+	handler = middleware.Trace("clockzen-api")(handler)
+	handler = corsMiddleware(cfg.CORSOrigin)(handler)
+	handler = middleware.RequestID(handler)
+
 	// Create HTTP server
 	server := &http.Server{
 		Addr:         ":" + port,
-		Handler:      corsMiddleware(loggingMiddleware(mux)),
+		Handler:      handler,
 		ReadTimeout:  15 * time.Second,
 		WriteTimeout: 15 * time.Second,
 		IdleTimeout:  60 * time.Second,
@@ -97,9 +482,9 @@ func main() {
 
 	// Start server in goroutine
 	go func() {
-		log.Printf("Starting API server on port %s", port)
+		slog.Info("starting API server", "port", port)
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("Failed to start server: %v", err)
+			fatal("failed to start server", "error", err)
 		}
 	}()
 
@@ -108,17 +493,37 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	log.Println("Shutting down server...")
+	slog.Info("shutting down server")
 
 	// Create context with timeout for shutdown
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
 	if err := server.Shutdown(ctx); err != nil {
-		log.Fatalf("Server forced to shutdown: %v", err)
+		fatal("server forced to shutdown", "error", err)
+	}
+	if grpcServer != nil {
+		grpcServer.Shutdown(ctx)
+	}
+
+	// Stop scheduling new runs; submitted jobs keep running on the worker
+	if jobScheduler != nil {
+		if err := jobScheduler.Stop(); err != nil {
+			slog.Error("stopping job scheduler", "error", err)
+		}
 	}
 
-	log.Println("Server exited gracefully")
+	// Send usage recorded since the last telemetry report
+	if err := telemetryReporter.Stop(ctx); err != nil {
+		slog.Warn("sending final telemetry report", "error", err)
+	}
+
+	// Flush spans still buffered for export
+	if err := shutdownTracing(ctx); err != nil {
+		slog.Error("flushing traces", "error", err)
+	}
+
+	slog.Info("server exited gracefully")
 }
 
 // handleHealth returns health check status
@@ -132,40 +537,122 @@ func handleHealth(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(response)
 }
 
-// loggingMiddleware logs incoming requests
-func loggingMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		start := time.Now()
-		next.ServeHTTP(w, r)
-		log.Printf("%s %s %s", r.Method, r.URL.Path, time.Since(start))
-	})
+// corsMiddleware adds CORS headers allowing the given origin
+func corsMiddleware(allowedOrigin string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Access-Control-Allow-Origin", allowedOrigin)
+			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Request-ID, X-Organization-ID")
+			w.Header().Set("Access-Control-Expose-Headers", "X-Request-ID")
+
+			// Handle preflight requests
+			if r.Method == http.MethodOptions {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// newLogger creates the process logger from the logging configuration,
+// exiting if it is invalid
+func newLogger(service string, settings config.Logging) *slog.Logger {
+	loggingConfig, err := settings.Config(service)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid logging configuration: %v\n", err)
+		os.Exit(1)
+	}
+	logger, err := logging.New(os.Stderr, loggingConfig)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid logging configuration: %v\n", err)
+		os.Exit(1)
+	}
+	return logger
 }
 
-// corsMiddleware adds CORS headers
-func corsMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Get allowed origin from environment or default to *
-		allowedOrigin := getEnv("CORS_ORIGIN", "*")
+// setupTracing configures tracing. Spans are only exported when an
+// endpoint is set.
+func setupTracing(tracingConfig tracing.Config) func(context.Context) error {
+	shutdown, err := tracing.Setup(context.Background(), tracingConfig)
+	if err != nil {
+		fatal("failed to configure tracing", "error", err)
+	}
+	return shutdown
+}
 
-		w.Header().Set("Access-Control-Allow-Origin", allowedOrigin)
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+// setupTelemetry configures anonymous usage reporting. Nothing is recorded
+// unless telemetry is enabled and its endpoint is set to the URL of a
+// receiver, such as another installation's /telemetry/v1/reports. Without an
+// installation ID, a random one is used per process.
+func setupTelemetry(telemetryConfig telemetry.Config) *telemetry.Reporter {
+	if info, ok := debug.ReadBuildInfo(); ok {
+		telemetryConfig.Version = info.Main.Version
+	}
 
-		// Handle preflight requests
-		if r.Method == http.MethodOptions {
-			w.WriteHeader(http.StatusOK)
-			return
-		}
+	if telemetryConfig.Enabled && telemetryConfig.Endpoint == "" {
+		slog.Warn("TELEMETRY_ENABLED is set but TELEMETRY_ENDPOINT is not; telemetry disabled")
+	}
+	reporter := telemetry.NewReporter(telemetryConfig)
+	if reporter.Enabled() {
+		slog.Info("anonymous usage telemetry enabled", "endpoint", telemetryConfig.Endpoint)
+	}
+	return reporter
+}
 
-		next.ServeHTTP(w, r)
-	})
+// newMailSender creates the email sender of the configured provider: "smtp"
+// sends through an SMTP server and "sendgrid" through the SendGrid API.
+// Without a provider, emails are logged. The provider's settings were
+// checked when the configuration was loaded.
+func newMailSender(settings config.Mail) mail.Sender {
+	switch settings.Provider {
+	case config.MailProviderSMTP:
+		return mail.NewSMTPSender(mail.SMTPConfig{
+			Host:     settings.SMTPHost,
+			Port:     settings.SMTPPort,
+			Username: settings.SMTPUsername,
+			Password: settings.SMTPPassword.Reveal(),
+			From:     settings.From,
+		})
+	case config.MailProviderSendGrid:
+		return mail.NewSendGridSender(mail.SendGridConfig{
+			APIKey: settings.SendGridAPIKey.Reveal(),
+			From:   settings.From,
+		}, nil)
+	default:
+		slog.Info("no mail provider configured, emails will be logged")
+		return mail.LogSender{}
+	}
 }
 
-// getEnv returns the value of an environment variable or a default value
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+// migrateSchema applies pending schema migrations when autoMigrate is set,
+// and otherwise warns if the database is behind the binary
+func migrateSchema(ctx context.Context, db *sql.DB, autoMigrate bool) error {
+	migrator, err := dbmigrate.NewWithDefaults(db)
+	if err != nil {
+		return err
+	}
+	if !autoMigrate {
+		pending, err := migrator.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		if len(pending) > 0 {
+			slog.Warn("database schema has pending migrations; run migratedb up", "pending", len(pending))
+		}
+		return nil
 	}
-	return defaultValue
+	applied, err := migrator.Up(ctx, 0)
+	for _, m := range applied {
+		slog.Info("applied database migration", "version", m.Version, "name", m.Name)
+	}
+	return err
 }
 
+// fatal logs an error and exits
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
@@ -3,15 +3,25 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"os"
 	"time"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"clockzen-next/internal/application/integration"
+	"clockzen-next/internal/application/users"
 	"clockzen-next/internal/ent"
 	"clockzen-next/internal/ent/emailconnection"
 	"clockzen-next/internal/ent/googledriveconnection"
+	"clockzen-next/internal/infrastructure/config"
+	"clockzen-next/internal/infrastructure/dbmigrate"
+	"clockzen-next/internal/infrastructure/encryption"
+	"clockzen-next/internal/infrastructure/google"
 
 	"github.com/google/uuid"
 	_ "github.com/lib/pq"
@@ -53,51 +63,79 @@ type MigrationStats struct {
 }
 
 func main() {
-	// Command line flags
-	legacyDSN := flag.String("legacy-db", "", "Legacy database connection string (required)")
-	targetDSN := flag.String("target-db", "", "Target database connection string (required)")
-	dryRun := flag.Bool("dry-run", false, "Perform a dry run without writing to target database")
-	batchSize := flag.Int("batch-size", 100, "Number of records to process per batch")
-	verbose := flag.Bool("verbose", false, "Enable verbose logging")
-
-	flag.Parse()
-
-	if *legacyDSN == "" || *targetDSN == "" {
-		fmt.Println("Usage: migrate -legacy-db <dsn> -target-db <dsn> [-dry-run] [-batch-size N] [-verbose]")
-		fmt.Println("\nRequired flags:")
-		fmt.Println("  -legacy-db    Legacy database connection string")
-		fmt.Println("  -target-db    Target database connection string")
-		fmt.Println("\nOptional flags:")
-		fmt.Println("  -dry-run      Perform a dry run without writing to target database")
-		fmt.Println("  -batch-size   Number of records to process per batch (default: 100)")
-		fmt.Println("  -verbose      Enable verbose logging")
+	// Settings come from flags, or from the config file and environment,
+	// so database URLs needn't be given on the command line
+	cfg := config.DefaultMigrate()
+	flag.Usage = printUsage
+	if err := config.Load(&cfg, config.Options{FlagSet: flag.CommandLine, Args: os.Args[1:]}); err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid configuration: %v\n\n", err)
+		printUsage()
 		os.Exit(1)
 	}
+	legacyDSN := cfg.LegacyDB.Reveal()
+	targetDSN := cfg.TargetDB.Reveal()
+	if cfg.Verbose {
+		log.Printf("Configuration: %v", config.Sanitize(cfg))
+	}
 
 	ctx := context.Background()
 
-	// Connect to legacy database
-	legacyDB, err := sql.Open("postgres", *legacyDSN)
+	// Load the token encryption keys; without them imported tokens are
+	// stored in plaintext
+	var keyring *encryption.Keyring
+	if cfg.Encryption.TokenKeys != "" {
+		var err error
+		keyring, err = cfg.Encryption.Keyring()
+		if err != nil {
+			log.Fatalf("Failed to load token encryption keys: %v", err)
+		}
+	}
+
+	if cfg.EncryptTokens {
+		runTokenEncryption(ctx, targetDSN, keyring, cfg.BatchSize, cfg.DryRun)
+		return
+	}
+
+	if cfg.Rollback != "" {
+		runRollback(ctx, targetDSN, cfg.Rollback, cfg.DryRun)
+		return
+	}
+
+	mapping, err := LoadMapping(cfg.Mapping)
 	if err != nil {
-		log.Fatalf("Failed to connect to legacy database: %v", err)
+		log.Fatalf("Failed to load mapping: %v", err)
 	}
-	defer legacyDB.Close()
 
-	if err := legacyDB.PingContext(ctx); err != nil {
-		log.Fatalf("Failed to ping legacy database: %v", err)
+	// Connect to legacy database
+	source, err := OpenSource(ctx, cfg.LegacyDriver, legacyDSN, mapping)
+	if err != nil {
+		log.Fatalf("Failed to open legacy database: %v", err)
 	}
-	log.Println("Connected to legacy database")
+	defer source.Close()
+	log.Printf("Connected to legacy %s database", cfg.LegacyDriver)
 
 	// Connect to target database using ent
-	targetClient, err := ent.Open("postgres", *targetDSN)
+	targetDB, err := sql.Open("postgres", targetDSN)
 	if err != nil {
 		log.Fatalf("Failed to connect to target database: %v", err)
 	}
+	targetClient := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, targetDB)))
 	defer targetClient.Close()
+	// Migrated users get accounts with their connections
+	users.Provision(targetClient)
 
-	// Run ent migrations on target database
-	if !*dryRun {
-		if err := targetClient.Schema.Create(ctx); err != nil {
+	if cfg.Verify {
+		runVerification(ctx, source, targetClient, cfg.VerifySample, cfg.Verbose)
+		return
+	}
+
+	// Apply schema migrations on target database
+	if !cfg.DryRun {
+		schemaMigrator, err := dbmigrate.NewWithDefaults(targetDB)
+		if err != nil {
+			log.Fatalf("Failed to load schema migrations: %v", err)
+		}
+		if _, err := schemaMigrator.Up(ctx, 0); err != nil {
 			log.Fatalf("Failed to run migrations on target database: %v", err)
 		}
 		log.Println("Target database schema ready")
@@ -105,13 +143,18 @@ func main() {
 
 	// Create migrator
 	migrator := &Migrator{
-		legacyDB:     legacyDB,
+		source:       source,
 		targetClient: targetClient,
-		batchSize:    *batchSize,
-		dryRun:       *dryRun,
-		verbose:      *verbose,
+		batchSize:    cfg.BatchSize,
+		tokens:       integration.NewTokenStore(targetClient, keyring),
+		dryRun:       cfg.DryRun,
+		verbose:      cfg.Verbose,
+		resume:       cfg.Resume,
 		stats:        &MigrationStats{},
 	}
+	if !cfg.DryRun {
+		migrator.state = NewStateStore(targetClient)
+	}
 
 	// Run migration
 	if err := migrator.Run(ctx); err != nil {
@@ -122,13 +165,107 @@ func main() {
 	migrator.PrintSummary()
 }
 
+// printUsage prints how the tool is run
+func printUsage() {
+	fmt.Println("Usage: migrate -legacy-db <dsn> -target-db <dsn> [-legacy-driver name] [-mapping file] [-dry-run] [-resume] [-batch-size N] [-verbose]")
+	fmt.Println("       migrate -verify -legacy-db <dsn> -target-db <dsn> [-legacy-driver name] [-mapping file] [-verify-sample N]")
+	fmt.Println("       migrate -rollback <run-id> -target-db <dsn> [-dry-run]")
+	fmt.Println("       migrate -encrypt-tokens -target-db <dsn> [-dry-run] [-batch-size N]")
+	fmt.Println("\nRequired flags:")
+	fmt.Println("  -legacy-db       Legacy database connection string")
+	fmt.Println("  -target-db       Target database connection string")
+	fmt.Println("\nOptional flags:")
+	fmt.Println("  -legacy-driver   Legacy database driver: postgres, mysql or sqlite3 (default: postgres)")
+	fmt.Println("  -mapping         YAML or JSON file mapping legacy tables, columns and values,")
+	fmt.Println("                   for legacy schemas that differ from the default")
+	fmt.Println("  -dry-run         Perform a dry run without writing to target database")
+	fmt.Println("  -batch-size      Number of records to process per batch (default: 100)")
+	fmt.Println("  -verbose         Enable verbose logging")
+	fmt.Println("  -resume          Continue an interrupted migration from where it stopped,")
+	fmt.Println("                   rather than from the beginning")
+	fmt.Println("  -verify          Compare row counts and sampled records of the legacy and")
+	fmt.Println("                   target databases instead of migrating")
+	fmt.Println("  -verify-sample   Number of records of each table to compare (default: 50)")
+	fmt.Println("  -rollback        Delete the records created by a migration run, by the run ID")
+	fmt.Println("                   printed in its summary, instead of migrating")
+	fmt.Println("  -encrypt-tokens  Encrypt plaintext tokens and re-encrypt tokens sealed with")
+	fmt.Println("                   an old key, instead of importing from the legacy database")
+	fmt.Println("\nSettings can also be given in a YAML file with -config (or CONFIG_FILE), and")
+	fmt.Println("the database connection strings as LEGACY_DATABASE_URL and TARGET_DATABASE_URL.")
+	fmt.Println("Tokens are encrypted with the keys in TOKEN_ENCRYPTION_KEYS when it is set.")
+	fmt.Println("Progress is kept in the migration_state table of the target database.")
+}
+
+// runVerification compares the legacy and target databases and prints a
+// summary, exiting with an error status if they differ
+func runVerification(ctx context.Context, source *Source, client *ent.Client, sample int, verbose bool) {
+	report, err := NewVerifier(source, client, sample, verbose).Run(ctx)
+	if err != nil {
+		log.Fatalf("Verification failed: %v", err)
+	}
+	report.PrintSummary()
+
+	if !report.OK() {
+		os.Exit(1)
+	}
+}
+
+// runTokenEncryption encrypts the tokens already stored in the target
+// database and prints a summary
+func runTokenEncryption(ctx context.Context, targetDSN string, keyring *encryption.Keyring, batchSize int, dryRun bool) {
+	if keyring == nil {
+		log.Fatal("TOKEN_ENCRYPTION_KEYS must be set to encrypt tokens")
+	}
+
+	client, err := ent.Open("postgres", targetDSN)
+	if err != nil {
+		log.Fatalf("Failed to connect to target database: %v", err)
+	}
+	defer client.Close()
+
+	log.Printf("Encrypting tokens with key %q", keyring.PrimaryKeyID())
+	tokens := integration.NewTokenStore(client, keyring)
+	stats, err := tokens.MigrateTokens(ctx, integration.TokenMigrationOptions{
+		BatchSize: batchSize,
+		DryRun:    dryRun,
+	})
+	if err != nil {
+		log.Fatalf("Token encryption failed: %v", err)
+	}
+
+	fmt.Println("\n=== Token Encryption Summary ===")
+	fmt.Printf("Email connections scanned:    %d\n", stats.EmailConnectionsScanned)
+	fmt.Printf("Email connections encrypted:  %d\n", stats.EmailConnectionsUpdated)
+	fmt.Printf("Drive connections scanned:    %d\n", stats.DriveConnectionsScanned)
+	fmt.Printf("Drive connections encrypted:  %d\n", stats.DriveConnectionsUpdated)
+
+	if len(stats.Errors) > 0 {
+		fmt.Printf("\nErrors encountered: %d\n", len(stats.Errors))
+		for _, err := range stats.Errors {
+			fmt.Printf("  - %s\n", err)
+		}
+	}
+
+	if dryRun {
+		fmt.Println("\n[DRY RUN] No changes were made to the target database")
+	}
+
+	if len(stats.Errors) > 0 {
+		os.Exit(1)
+	}
+}
+
 // Migrator handles the data migration process
 type Migrator struct {
-	legacyDB     *sql.DB
+	source       *Source
 	targetClient *ent.Client
+	tokens       *integration.TokenStore
 	batchSize    int
 	dryRun       bool
 	verbose      bool
+	resume       bool
+	state        *StateStore // Nil in a dry run, which records no progress
+	runID        string      // Recorded on the rows the run creates
 	stats        *MigrationStats
 	userIDMap    map[string]string // Maps legacy user IDs to new user IDs
 }
@@ -137,7 +274,27 @@ type Migrator struct {
 func (m *Migrator) Run(ctx context.Context) error {
 	m.userIDMap = make(map[string]string)
 
-	log.Println("Starting migration...")
+	// Users keep the IDs given them by earlier runs
+	if m.state != nil {
+		userIDs, err := m.state.UserIDs(ctx)
+		if err != nil {
+			return err
+		}
+		m.userIDMap = userIDs
+
+		m.runID, err = m.state.StartRun(ctx, m.resume)
+		if err != nil {
+			return err
+		}
+	}
+
+	if m.resume {
+		log.Printf("Resuming migration run %s (%d users already mapped)...", m.runID, len(m.userIDMap))
+	} else if m.runID != "" {
+		log.Printf("Starting migration run %s...", m.runID)
+	} else {
+		log.Println("Starting migration...")
+	}
 
 	// Step 1: Migrate users
 	if err := m.migrateUsers(ctx); err != nil {
@@ -154,6 +311,12 @@ func (m *Migrator) Run(ctx context.Context) error {
 		return fmt.Errorf("receipt migration failed: %w", err)
 	}
 
+	if m.state != nil {
+		if err := m.state.FinishRun(ctx, m.runID, runCompleted); err != nil {
+			return err
+		}
+	}
+
 	log.Println("Migration completed successfully")
 	return nil
 }
@@ -163,12 +326,14 @@ func (m *Migrator) migrateReceiptsPhase(ctx context.Context) error {
 	log.Println("Starting receipt migration phase...")
 
 	receiptMigrator := NewReceiptMigrator(
-		m.legacyDB,
+		m.source,
 		m.targetClient,
 		WithBatchSize(m.batchSize),
 		WithDryRun(m.dryRun),
 		WithVerbose(m.verbose),
 		WithUserIDMap(m.userIDMap),
+		WithState(m.state, m.resume),
+		WithRunID(m.runID),
 		WithProgressCallback(func(progress ReceiptMigrationProgress) {
 			if m.verbose {
 				log.Printf("[%s] Processed: receipts=%d, transactions=%d, line_items=%d, current=%s",
@@ -195,9 +360,12 @@ func (m *Migrator) migrateReceiptsPhase(ctx context.Context) error {
 func (m *Migrator) migrateUsers(ctx context.Context) error {
 	log.Println("Migrating users...")
 
-	offset := 0
+	after, err := startAfter(ctx, m.state, m.resume, phaseUsers)
+	if err != nil {
+		return err
+	}
 	for {
-		users, err := m.fetchLegacyUsers(ctx, offset, m.batchSize)
+		users, err := m.fetchLegacyUsers(ctx, after, m.batchSize)
 		if err != nil {
 			return fmt.Errorf("failed to fetch legacy users: %w", err)
 		}
@@ -206,19 +374,28 @@ func (m *Migrator) migrateUsers(ctx context.Context) error {
 			break
 		}
 
+		mapped := make(map[string]string)
 		for _, user := range users {
 			if m.verbose {
 				log.Printf("Processing user: %s (%s)", user.ID, user.Email)
 			}
 
-			// Map legacy user ID to a new UUID if needed
-			newUserID := uuid.New().String()
-			m.userIDMap[user.ID] = newUserID
+			// Map legacy user ID to a new UUID unless an earlier run did
+			if _, ok := m.userIDMap[user.ID]; !ok {
+				newUserID := uuid.New().String()
+				m.userIDMap[user.ID] = newUserID
+				mapped[user.ID] = newUserID
+			}
 			m.stats.UsersProcessed++
 		}
 
-		offset += len(users)
-		log.Printf("Processed %d users...", offset)
+		after = users[len(users)-1].ID
+		if m.state != nil {
+			if err := m.state.SaveUserIDs(ctx, m.runID, mapped, after); err != nil {
+				return err
+			}
+		}
+		log.Printf("Processed %d users...", m.stats.UsersProcessed)
 	}
 
 	log.Printf("User migration complete: %d users processed", m.stats.UsersProcessed)
@@ -229,9 +406,12 @@ func (m *Migrator) migrateUsers(ctx context.Context) error {
 func (m *Migrator) migrateAccounts(ctx context.Context) error {
 	log.Println("Migrating accounts...")
 
-	offset := 0
+	after, err := startAfter(ctx, m.state, m.resume, phaseAccounts)
+	if err != nil {
+		return err
+	}
 	for {
-		accounts, err := m.fetchLegacyAccounts(ctx, offset, m.batchSize)
+		accounts, err := m.fetchLegacyAccounts(ctx, after, m.batchSize)
 		if err != nil {
 			return fmt.Errorf("failed to fetch legacy accounts: %w", err)
 		}
@@ -252,8 +432,11 @@ func (m *Migrator) migrateAccounts(ctx context.Context) error {
 			m.stats.AccountsProcessed++
 		}
 
-		offset += len(accounts)
-		log.Printf("Processed %d accounts...", offset)
+		after = accounts[len(accounts)-1].ID
+		if err := advanceCursor(ctx, m.state, m.runID, phaseAccounts, after); err != nil {
+			return err
+		}
+		log.Printf("Processed %d accounts...", m.stats.AccountsProcessed)
 	}
 
 	log.Printf("Account migration complete: %d accounts processed", m.stats.AccountsProcessed)
@@ -294,29 +477,24 @@ func (m *Migrator) createEmailConnection(ctx context.Context, account LegacyAcco
 	// Map provider to enum
 	provider := mapEmailProvider(account.Provider)
 
-	// Check if connection already exists
-	exists, err := m.targetClient.EmailConnection.Query().
-		Where(emailconnection.ProviderAccountID(account.ProviderID)).
-		Exist(ctx)
+	accessToken, refreshToken, err := m.tokens.Seal(&google.Token{
+		AccessToken:  account.AccessToken,
+		RefreshToken: account.RefreshToken,
+	})
 	if err != nil {
-		return fmt.Errorf("failed to check existing connection: %w", err)
-	}
-	if exists {
-		if m.verbose {
-			log.Printf("EmailConnection for provider account %s already exists, skipping", account.ProviderID)
-		}
-		return nil
+		return fmt.Errorf("failed to encrypt tokens: %w", err)
 	}
 
-	// Create the email connection
+	// Create the email connection, leaving any existing row for the same
+	// provider account untouched
 	create := m.targetClient.EmailConnection.Create().
 		SetID(uuid.New().String()).
 		SetUserID(userID).
 		SetProviderAccountID(account.ProviderID).
 		SetEmail(account.Email).
 		SetProvider(provider).
-		SetAccessToken(account.AccessToken).
-		SetRefreshToken(account.RefreshToken).
+		SetAccessToken(accessToken).
+		SetRefreshToken(refreshToken).
 		SetTokenExpiry(account.TokenExpiry).
 		SetStatus(status).
 		SetCreatedAt(account.CreatedAt).
@@ -325,8 +503,20 @@ func (m *Migrator) createEmailConnection(ctx context.Context, account LegacyAcco
 	if account.LastSyncAt != nil {
 		create.SetLastSyncAt(*account.LastSyncAt)
 	}
+	if m.runID != "" {
+		create.SetMigrationRunID(m.runID)
+	}
 
-	_, err = create.Save(ctx)
+	_, err = create.
+		OnConflictColumns(emailconnection.FieldProviderAccountID, emailconnection.FieldProvider).
+		DoNothing().
+		ID(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		if m.verbose {
+			log.Printf("EmailConnection for provider account %s already exists, skipping", account.ProviderID)
+		}
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("failed to create email connection: %w", err)
 	}
@@ -346,28 +536,23 @@ func (m *Migrator) createDriveConnection(ctx context.Context, account LegacyAcco
 		googleAccountID = account.ProviderID
 	}
 
-	// Check if connection already exists
-	exists, err := m.targetClient.GoogleDriveConnection.Query().
-		Where(googledriveconnection.GoogleAccountID(googleAccountID)).
-		Exist(ctx)
+	accessToken, refreshToken, err := m.tokens.Seal(&google.Token{
+		AccessToken:  account.AccessToken,
+		RefreshToken: account.RefreshToken,
+	})
 	if err != nil {
-		return fmt.Errorf("failed to check existing connection: %w", err)
-	}
-	if exists {
-		if m.verbose {
-			log.Printf("GoogleDriveConnection for account %s already exists, skipping", googleAccountID)
-		}
-		return nil
+		return fmt.Errorf("failed to encrypt tokens: %w", err)
 	}
 
-	// Create the drive connection
+	// Create the drive connection, leaving any existing row for the same
+	// Google account untouched
 	create := m.targetClient.GoogleDriveConnection.Create().
 		SetID(uuid.New().String()).
 		SetUserID(userID).
 		SetGoogleAccountID(googleAccountID).
 		SetEmail(account.Email).
-		SetAccessToken(account.AccessToken).
-		SetRefreshToken(account.RefreshToken).
+		SetAccessToken(accessToken).
+		SetRefreshToken(refreshToken).
 		SetTokenExpiry(account.TokenExpiry).
 		SetStatus(status).
 		SetCreatedAt(account.CreatedAt).
@@ -376,8 +561,20 @@ func (m *Migrator) createDriveConnection(ctx context.Context, account LegacyAcco
 	if account.LastSyncAt != nil {
 		create.SetLastSyncAt(*account.LastSyncAt)
 	}
+	if m.runID != "" {
+		create.SetMigrationRunID(m.runID)
+	}
 
-	_, err = create.Save(ctx)
+	_, err = create.
+		OnConflictColumns(googledriveconnection.FieldGoogleAccountID).
+		DoNothing().
+		ID(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		if m.verbose {
+			log.Printf("GoogleDriveConnection for account %s already exists, skipping", googleAccountID)
+		}
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("failed to create drive connection: %w", err)
 	}
@@ -386,19 +583,18 @@ func (m *Migrator) createDriveConnection(ctx context.Context, account LegacyAcco
 	return nil
 }
 
-// fetchLegacyUsers fetches users from the legacy database with pagination
-func (m *Migrator) fetchLegacyUsers(ctx context.Context, offset, limit int) ([]LegacyUser, error) {
-	query := `
-		SELECT id, email, COALESCE(name, ''), created_at, updated_at
-		FROM users
-		ORDER BY id
-		LIMIT $1 OFFSET $2
-	`
-
-	rows, err := m.legacyDB.QueryContext(ctx, query, limit, offset)
+// fetchLegacyUsers fetches the page of users from the legacy database after
+// the user with ID after
+func (m *Migrator) fetchLegacyUsers(ctx context.Context, after string, limit int) ([]LegacyUser, error) {
+	rows, err := m.source.Page(ctx, phaseUsers, after, limit)
 	if err != nil {
 		return nil, err
 	}
+	return scanLegacyUsers(rows)
+}
+
+// scanLegacyUsers scans users from rows and closes rows
+func scanLegacyUsers(rows *sql.Rows) ([]LegacyUser, error) {
 	defer rows.Close()
 
 	var users []LegacyUser
@@ -413,32 +609,19 @@ func (m *Migrator) fetchLegacyUsers(ctx context.Context, offset, limit int) ([]L
 	return users, rows.Err()
 }
 
-// fetchLegacyAccounts fetches accounts from the legacy database with pagination
-func (m *Migrator) fetchLegacyAccounts(ctx context.Context, offset, limit int) ([]LegacyAccount, error) {
-	query := `
-		SELECT
-			id,
-			user_id,
-			provider,
-			provider_id,
-			email,
-			access_token,
-			refresh_token,
-			token_expiry,
-			COALESCE(status, 'active'),
-			created_at,
-			updated_at,
-			last_sync_at,
-			COALESCE(google_account_id, '')
-		FROM accounts
-		ORDER BY id
-		LIMIT $1 OFFSET $2
-	`
-
-	rows, err := m.legacyDB.QueryContext(ctx, query, limit, offset)
+// fetchLegacyAccounts fetches the page of accounts from the legacy database
+// after the account with ID after
+func (m *Migrator) fetchLegacyAccounts(ctx context.Context, after string, limit int) ([]LegacyAccount, error) {
+	rows, err := m.source.Page(ctx, phaseAccounts, after, limit)
 	if err != nil {
 		return nil, err
 	}
+	return scanLegacyAccounts(m.source, rows)
+}
+
+// scanLegacyAccounts scans accounts from rows, translating their values
+// through the source's mapping, and closes rows
+func scanLegacyAccounts(source *Source, rows *sql.Rows) ([]LegacyAccount, error) {
 	defer rows.Close()
 
 	var accounts []LegacyAccount
@@ -461,6 +644,8 @@ func (m *Migrator) fetchLegacyAccounts(ctx context.Context, offset, limit int) (
 		); err != nil {
 			return nil, err
 		}
+		account.Provider = source.Value(valueProvider, account.Provider)
+		account.Status = source.Value(valueAccountStatus, account.Status)
 		accounts = append(accounts, account)
 	}
 
@@ -516,6 +701,9 @@ func mapEmailProvider(legacyProvider string) emailconnection.Provider {
 // PrintSummary prints the migration statistics
 func (m *Migrator) PrintSummary() {
 	fmt.Println("\n=== Migration Summary ===")
+	if m.runID != "" {
+		fmt.Printf("Run ID:                       %s\n", m.runID)
+	}
 	fmt.Printf("Users processed:              %d\n", m.stats.UsersProcessed)
 	fmt.Printf("Accounts processed:           %d\n", m.stats.AccountsProcessed)
 	fmt.Printf("Email connections created:    %d\n", m.stats.EmailConnectionsCreated)
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Mapping describes a legacy schema: the table each entity is read from,
+// the column or SQL expression each field is read from, and how legacy
+// values of enum-like fields translate to the values the migration knows.
+// Anything left out of a mapping file keeps the default, which is the
+// original legacy schema.
+//
+// An example mapping file:
+//
+//	tables:
+//	  users:
+//	    table: members
+//	    columns:
+//	      id: member_id
+//	      name: "COALESCE(display_name, '')"
+//	values:
+//	  provider:
+//	    google: gmail
+//	  account_status:
+//	    disabled: inactive
+type Mapping struct {
+	Tables map[string]TableMapping      `json:"tables" yaml:"tables"`
+	Values map[string]map[string]string `json:"values" yaml:"values"`
+}
+
+// TableMapping maps an entity to a legacy table
+type TableMapping struct {
+	// Table is the legacy table name
+	Table string `json:"table" yaml:"table"`
+	// Columns maps fields to the column or SQL expression they are read from
+	Columns map[string]string `json:"columns" yaml:"columns"`
+}
+
+// Kinds of value that can be translated
+const (
+	valueProvider          = "provider"
+	valueAccountStatus     = "account_status"
+	valueSourceType        = "source_type"
+	valueReceiptStatus     = "receipt_status"
+	valueTransactionType   = "transaction_type"
+	valueTransactionStatus = "transaction_status"
+)
+
+var valueKinds = []string{
+	valueProvider, valueAccountStatus, valueSourceType,
+	valueReceiptStatus, valueTransactionType, valueTransactionStatus,
+}
+
+// column is a field of an entity and the expression it is read from by
+// default
+type column struct {
+	field string
+	expr  string
+}
+
+// entities lists the fields read for each entity, in the order they are
+// scanned, with the original legacy schema as the default
+var entities = map[string][]column{
+	phaseUsers: {
+		{"id", "id"},
+		{"email", "email"},
+		{"name", "COALESCE(name, '')"},
+		{"created_at", "created_at"},
+		{"updated_at", "updated_at"},
+	},
+	phaseAccounts: {
+		{"id", "id"},
+		{"user_id", "user_id"},
+		{"provider", "provider"},
+		{"provider_id", "provider_id"},
+		{"email", "email"},
+		{"access_token", "access_token"},
+		{"refresh_token", "refresh_token"},
+		{"token_expiry", "token_expiry"},
+		{"status", "COALESCE(status, 'active')"},
+		{"created_at", "created_at"},
+		{"updated_at", "updated_at"},
+		{"last_sync_at", "last_sync_at"},
+		{"google_account_id", "COALESCE(google_account_id, '')"},
+	},
+	phaseReceipts: columns(
+		"id", "user_id", "source_type", "source_id", "source_connection_id",
+		"file_name", "file_path", "mime_type", "file_size",
+		"storage_bucket", "storage_key", "thumbnail_path",
+		"status", "ocr_completed", "ocr_text", "ocr_confidence",
+		"merchant_name", "merchant_address", "receipt_date",
+		"total_amount", "tax_amount", "subtotal_amount", "currency",
+		"payment_method", "receipt_number", "category_tags",
+		"extracted_data", "metadata", "notes",
+		"created_at", "updated_at", "processed_at",
+	),
+	phaseTransactions: columns(
+		"id", "receipt_id", "user_id", "type", "amount", "currency",
+		"transaction_date", "description", "merchant_name", "merchant_category",
+		"payment_method", "card_last_four", "reference_number", "authorization_code",
+		"status", "is_recurring", "recurrence_pattern", "category_tags",
+		"metadata", "notes", "created_at", "updated_at",
+	),
+	phaseLineItems: columns(
+		"id", "receipt_id", "line_number", "description", "sku", "product_code",
+		"quantity", "unit", "unit_price", "total_price",
+		"discount_amount", "discount_description", "tax_amount", "tax_rate",
+		"is_taxable", "category", "tags", "metadata",
+		"created_at", "updated_at",
+	),
+}
+
+// columns returns fields read from columns of the same name
+func columns(fields ...string) []column {
+	cols := make([]column, len(fields))
+	for i, f := range fields {
+		cols[i] = column{field: f, expr: f}
+	}
+	return cols
+}
+
+// LoadMapping reads a mapping file, as JSON if its name ends in .json and
+// as YAML otherwise. An empty path is the default mapping.
+func LoadMapping(path string) (*Mapping, error) {
+	mapping := &Mapping{}
+	if path == "" {
+		return mapping, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mapping: %w", err)
+	}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.Unmarshal(data, mapping)
+	} else {
+		err = yaml.Unmarshal(data, mapping)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mapping %s: %w", path, err)
+	}
+	if err := mapping.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid mapping %s: %w", path, err)
+	}
+	return mapping, nil
+}
+
+// Validate checks the mapping only names entities, fields and value kinds
+// that exist, so a typo isn't silently ignored
+func (m *Mapping) Validate() error {
+	for entity, table := range m.Tables {
+		cols, ok := entities[entity]
+		if !ok {
+			return fmt.Errorf("unknown table %q, expected one of %s", entity, strings.Join(entityNames(), ", "))
+		}
+		for field, expr := range table.Columns {
+			if !slices.ContainsFunc(cols, func(col column) bool { return col.field == field }) {
+				return fmt.Errorf("unknown column %q for %s", field, entity)
+			}
+			if strings.TrimSpace(expr) == "" {
+				return fmt.Errorf("empty column %q for %s", field, entity)
+			}
+		}
+	}
+	for kind := range m.Values {
+		if !slices.Contains(valueKinds, kind) {
+			return fmt.Errorf("unknown value kind %q, expected one of %s", kind, strings.Join(valueKinds, ", "))
+		}
+	}
+	return nil
+}
+
+// Table returns the legacy table the entity is read from
+func (m *Mapping) Table(entity string) string {
+	if table := m.Tables[entity].Table; table != "" {
+		return table
+	}
+	return entity
+}
+
+// Columns returns the expressions the entity's fields are read from, in
+// the order they are scanned
+func (m *Mapping) Columns(entity string) []string {
+	overrides := m.Tables[entity].Columns
+	cols := entities[entity]
+	exprs := make([]string, len(cols))
+	for i, col := range cols {
+		exprs[i] = col.expr
+		if expr, ok := overrides[col.field]; ok {
+			exprs[i] = expr
+		}
+	}
+	return exprs
+}
+
+// Value translates a legacy value of kind, leaving values the mapping
+// doesn't list as they are
+func (m *Mapping) Value(kind, legacy string) string {
+	if value, ok := m.Values[kind][legacy]; ok {
+		return value
+	}
+	return legacy
+}
+
+// entityNames returns the names of the entities, sorted
+func entityNames() []string {
+	names := make([]string, 0, len(entities))
+	for name := range entities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -122,7 +122,7 @@ type ReceiptMigrationStats struct {
 
 // ReceiptMigrator handles receipt data migration
 type ReceiptMigrator struct {
-	legacyDB        *sql.DB
+	source          *Source
 	targetClient    *ent.Client
 	batchSize       int
 	dryRun          bool
@@ -133,6 +133,9 @@ type ReceiptMigrator struct {
 	sourceImagePath string            // Path to legacy image storage
 	targetImagePath string            // Path to new image storage
 	progressCb      func(ReceiptMigrationProgress)
+	state           *StateStore // Records progress, if set
+	resume          bool        // Whether to continue from the recorded progress
+	runID           string      // Recorded on the rows the migration creates
 }
 
 // ReceiptMigrationProgress represents the current migration progress
@@ -149,9 +152,9 @@ type ReceiptMigrationProgress struct {
 }
 
 // NewReceiptMigrator creates a new receipt migrator
-func NewReceiptMigrator(legacyDB *sql.DB, targetClient *ent.Client, opts ...ReceiptMigratorOption) *ReceiptMigrator {
+func NewReceiptMigrator(source *Source, targetClient *ent.Client, opts ...ReceiptMigratorOption) *ReceiptMigrator {
 	m := &ReceiptMigrator{
-		legacyDB:        legacyDB,
+		source:          source,
 		targetClient:    targetClient,
 		batchSize:       100,
 		dryRun:          false,
@@ -199,6 +202,23 @@ func WithUserIDMap(userIDMap map[string]string) ReceiptMigratorOption {
 	}
 }
 
+// WithState records progress in state, and continues from the progress
+// already recorded if resume is set
+func WithState(state *StateStore, resume bool) ReceiptMigratorOption {
+	return func(m *ReceiptMigrator) {
+		m.state = state
+		m.resume = resume
+	}
+}
+
+// WithRunID records runID on the receipts, transactions and line items
+// created, so the run can be rolled back
+func WithRunID(runID string) ReceiptMigratorOption {
+	return func(m *ReceiptMigrator) {
+		m.runID = runID
+	}
+}
+
 // WithImagePaths sets the source and target image storage paths
 func WithImagePaths(source, target string) ReceiptMigratorOption {
 	return func(m *ReceiptMigrator) {
@@ -259,9 +279,7 @@ func (m *ReceiptMigrator) Run(ctx context.Context) error {
 
 // countLegacyReceipts counts the total number of receipts to migrate
 func (m *ReceiptMigrator) countLegacyReceipts(ctx context.Context) (int, error) {
-	var count int
-	err := m.legacyDB.QueryRowContext(ctx, "SELECT COUNT(*) FROM receipts").Scan(&count)
-	return count, err
+	return m.source.Count(ctx, phaseReceipts)
 }
 
 // migrateReceipts migrates receipt records from the legacy database
@@ -269,9 +287,12 @@ func (m *ReceiptMigrator) migrateReceipts(ctx context.Context) error {
 	log.Println("Migrating receipts...")
 	m.reportProgress(ReceiptMigrationProgress{Phase: "receipts"})
 
-	offset := 0
+	after, err := startAfter(ctx, m.state, m.resume, phaseReceipts)
+	if err != nil {
+		return err
+	}
 	for {
-		receipts, err := m.fetchLegacyReceipts(ctx, offset, m.batchSize)
+		receipts, err := m.fetchLegacyReceipts(ctx, after, m.batchSize)
 		if err != nil {
 			return fmt.Errorf("failed to fetch legacy receipts: %w", err)
 		}
@@ -297,8 +318,11 @@ func (m *ReceiptMigrator) migrateReceipts(ctx context.Context) error {
 			})
 		}
 
-		offset += len(receipts)
-		log.Printf("Processed %d receipts...", offset)
+		after = receipts[len(receipts)-1].ID
+		if err := advanceCursor(ctx, m.state, m.runID, phaseReceipts, after); err != nil {
+			return err
+		}
+		log.Printf("Processed %d receipts...", m.stats.ReceiptsProcessed)
 	}
 
 	log.Printf("Receipt migration complete: %d receipts processed, %d created, %d skipped",
@@ -428,6 +452,9 @@ func (m *ReceiptMigrator) migrateReceipt(ctx context.Context, legacyReceipt Lega
 	if legacyReceipt.ProcessedAt != nil {
 		create.SetProcessedAt(*legacyReceipt.ProcessedAt)
 	}
+	if m.runID != "" {
+		create.SetMigrationRunID(m.runID)
+	}
 
 	_, err = create.Save(ctx)
 	if err != nil {
@@ -439,14 +466,39 @@ func (m *ReceiptMigrator) migrateReceipt(ctx context.Context, legacyReceipt Lega
 	return nil
 }
 
+// newReceiptID returns the ID of the receipt migrated from the legacy
+// receipt. Receipts migrated by an earlier run aren't in the mapping, so
+// they are looked up by legacy ID.
+func (m *ReceiptMigrator) newReceiptID(ctx context.Context, legacyID string) (string, error) {
+	if id, ok := m.receiptIDMap[legacyID]; ok {
+		return id, nil
+	}
+	if !m.dryRun {
+		id, err := m.targetClient.Receipt.Query().
+			Where(receipt.LegacyID(legacyID)).
+			FirstID(ctx)
+		if err == nil {
+			m.receiptIDMap[legacyID] = id
+			return id, nil
+		}
+		if !ent.IsNotFound(err) {
+			return "", fmt.Errorf("failed to look up receipt %s: %w", legacyID, err)
+		}
+	}
+	return "", fmt.Errorf("receipt ID %s not found in mapping", legacyID)
+}
+
 // migrateTransactions migrates transaction records from the legacy database
 func (m *ReceiptMigrator) migrateTransactions(ctx context.Context) error {
 	log.Println("Migrating transactions...")
 	m.reportProgress(ReceiptMigrationProgress{Phase: "transactions"})
 
-	offset := 0
+	after, err := startAfter(ctx, m.state, m.resume, phaseTransactions)
+	if err != nil {
+		return err
+	}
 	for {
-		transactions, err := m.fetchLegacyTransactions(ctx, offset, m.batchSize)
+		transactions, err := m.fetchLegacyTransactions(ctx, after, m.batchSize)
 		if err != nil {
 			return fmt.Errorf("failed to fetch legacy transactions: %w", err)
 		}
@@ -471,8 +523,11 @@ func (m *ReceiptMigrator) migrateTransactions(ctx context.Context) error {
 			})
 		}
 
-		offset += len(transactions)
-		log.Printf("Processed %d transactions...", offset)
+		after = transactions[len(transactions)-1].ID
+		if err := advanceCursor(ctx, m.state, m.runID, phaseTransactions, after); err != nil {
+			return err
+		}
+		log.Printf("Processed %d transactions...", m.stats.TransactionsProcessed)
 	}
 
 	log.Printf("Transaction migration complete: %d processed, %d created",
@@ -482,10 +537,9 @@ func (m *ReceiptMigrator) migrateTransactions(ctx context.Context) error {
 
 // migrateTransaction migrates a single transaction record
 func (m *ReceiptMigrator) migrateTransaction(ctx context.Context, legacyTx LegacyTransaction) error {
-	// Get the new receipt ID from the mapping
-	newReceiptID, ok := m.receiptIDMap[legacyTx.ReceiptID]
-	if !ok {
-		return fmt.Errorf("receipt ID %s not found in mapping", legacyTx.ReceiptID)
+	newReceiptID, err := m.newReceiptID(ctx, legacyTx.ReceiptID)
+	if err != nil {
+		return err
 	}
 
 	// Get the new user ID from the mapping
@@ -565,6 +619,9 @@ func (m *ReceiptMigrator) migrateTransaction(ctx context.Context, legacyTx Legac
 	if legacyTx.Notes != nil {
 		create.SetNotes(*legacyTx.Notes)
 	}
+	if m.runID != "" {
+		create.SetMigrationRunID(m.runID)
+	}
 
 	_, err = create.Save(ctx)
 	if err != nil {
@@ -580,9 +637,12 @@ func (m *ReceiptMigrator) migrateLineItems(ctx context.Context) error {
 	log.Println("Migrating line items...")
 	m.reportProgress(ReceiptMigrationProgress{Phase: "line_items"})
 
-	offset := 0
+	after, err := startAfter(ctx, m.state, m.resume, phaseLineItems)
+	if err != nil {
+		return err
+	}
 	for {
-		lineItems, err := m.fetchLegacyLineItems(ctx, offset, m.batchSize)
+		lineItems, err := m.fetchLegacyLineItems(ctx, after, m.batchSize)
 		if err != nil {
 			return fmt.Errorf("failed to fetch legacy line items: %w", err)
 		}
@@ -607,8 +667,11 @@ func (m *ReceiptMigrator) migrateLineItems(ctx context.Context) error {
 			})
 		}
 
-		offset += len(lineItems)
-		log.Printf("Processed %d line items...", offset)
+		after = lineItems[len(lineItems)-1].ID
+		if err := advanceCursor(ctx, m.state, m.runID, phaseLineItems, after); err != nil {
+			return err
+		}
+		log.Printf("Processed %d line items...", m.stats.LineItemsProcessed)
 	}
 
 	log.Printf("Line item migration complete: %d processed, %d created",
@@ -618,10 +681,9 @@ func (m *ReceiptMigrator) migrateLineItems(ctx context.Context) error {
 
 // migrateLineItem migrates a single line item record
 func (m *ReceiptMigrator) migrateLineItem(ctx context.Context, legacyItem LegacyLineItem) error {
-	// Get the new receipt ID from the mapping
-	newReceiptID, ok := m.receiptIDMap[legacyItem.ReceiptID]
-	if !ok {
-		return fmt.Errorf("receipt ID %s not found in mapping", legacyItem.ReceiptID)
+	newReceiptID, err := m.newReceiptID(ctx, legacyItem.ReceiptID)
+	if err != nil {
+		return err
 	}
 
 	if m.verbose {
@@ -687,6 +749,9 @@ func (m *ReceiptMigrator) migrateLineItem(ctx context.Context, legacyItem Legacy
 	if len(legacyItem.Metadata) > 0 {
 		create.SetMetadata(legacyItem.Metadata)
 	}
+	if m.runID != "" {
+		create.SetMigrationRunID(m.runID)
+	}
 
 	_, err = create.Save(ctx)
 	if err != nil {
@@ -796,28 +861,19 @@ func (m *ReceiptMigrator) verifyDataIntegrity(ctx context.Context) error {
 	return nil
 }
 
-// fetchLegacyReceipts fetches receipts from the legacy database with pagination
-func (m *ReceiptMigrator) fetchLegacyReceipts(ctx context.Context, offset, limit int) ([]LegacyReceipt, error) {
-	query := `
-		SELECT
-			id, user_id, source_type, source_id, source_connection_id,
-			file_name, file_path, mime_type, file_size,
-			storage_bucket, storage_key, thumbnail_path,
-			status, ocr_completed, ocr_text, ocr_confidence,
-			merchant_name, merchant_address, receipt_date,
-			total_amount, tax_amount, subtotal_amount, currency,
-			payment_method, receipt_number, category_tags,
-			extracted_data, metadata, notes,
-			created_at, updated_at, processed_at
-		FROM receipts
-		ORDER BY id
-		LIMIT $1 OFFSET $2
-	`
-
-	rows, err := m.legacyDB.QueryContext(ctx, query, limit, offset)
+// fetchLegacyReceipts fetches the page of receipts from the legacy database
+// after the receipt with ID after
+func (m *ReceiptMigrator) fetchLegacyReceipts(ctx context.Context, after string, limit int) ([]LegacyReceipt, error) {
+	rows, err := m.source.Page(ctx, phaseReceipts, after, limit)
 	if err != nil {
 		return nil, err
 	}
+	return scanLegacyReceipts(m.source, rows)
+}
+
+// scanLegacyReceipts scans receipts from rows, translating their values
+// through the source's mapping, and closes rows
+func scanLegacyReceipts(source *Source, rows *sql.Rows) ([]LegacyReceipt, error) {
 	defer rows.Close()
 
 	var receipts []LegacyReceipt
@@ -850,30 +906,27 @@ func (m *ReceiptMigrator) fetchLegacyReceipts(ctx context.Context, offset, limit
 			_ = json.Unmarshal([]byte(metadataJSON.String), &r.Metadata)
 		}
 
+		r.SourceType = source.Value(valueSourceType, r.SourceType)
+		r.Status = source.Value(valueReceiptStatus, r.Status)
 		receipts = append(receipts, r)
 	}
 
 	return receipts, rows.Err()
 }
 
-// fetchLegacyTransactions fetches transactions from the legacy database with pagination
-func (m *ReceiptMigrator) fetchLegacyTransactions(ctx context.Context, offset, limit int) ([]LegacyTransaction, error) {
-	query := `
-		SELECT
-			id, receipt_id, user_id, type, amount, currency,
-			transaction_date, description, merchant_name, merchant_category,
-			payment_method, card_last_four, reference_number, authorization_code,
-			status, is_recurring, recurrence_pattern, category_tags,
-			metadata, notes, created_at, updated_at
-		FROM transactions
-		ORDER BY id
-		LIMIT $1 OFFSET $2
-	`
-
-	rows, err := m.legacyDB.QueryContext(ctx, query, limit, offset)
+// fetchLegacyTransactions fetches the page of transactions from the legacy database
+// after the transaction with ID after
+func (m *ReceiptMigrator) fetchLegacyTransactions(ctx context.Context, after string, limit int) ([]LegacyTransaction, error) {
+	rows, err := m.source.Page(ctx, phaseTransactions, after, limit)
 	if err != nil {
 		return nil, err
 	}
+	return scanLegacyTransactions(m.source, rows)
+}
+
+// scanLegacyTransactions scans transactions from rows, translating their values
+// through the source's mapping, and closes rows
+func scanLegacyTransactions(source *Source, rows *sql.Rows) ([]LegacyTransaction, error) {
 	defer rows.Close()
 
 	var transactions []LegacyTransaction
@@ -899,30 +952,26 @@ func (m *ReceiptMigrator) fetchLegacyTransactions(ctx context.Context, offset, l
 			_ = json.Unmarshal([]byte(metadataJSON.String), &tx.Metadata)
 		}
 
+		tx.Type = source.Value(valueTransactionType, tx.Type)
+		tx.Status = source.Value(valueTransactionStatus, tx.Status)
 		transactions = append(transactions, tx)
 	}
 
 	return transactions, rows.Err()
 }
 
-// fetchLegacyLineItems fetches line items from the legacy database with pagination
-func (m *ReceiptMigrator) fetchLegacyLineItems(ctx context.Context, offset, limit int) ([]LegacyLineItem, error) {
-	query := `
-		SELECT
-			id, receipt_id, line_number, description, sku, product_code,
-			quantity, unit, unit_price, total_price,
-			discount_amount, discount_description, tax_amount, tax_rate,
-			is_taxable, category, tags, metadata,
-			created_at, updated_at
-		FROM line_items
-		ORDER BY id
-		LIMIT $1 OFFSET $2
-	`
-
-	rows, err := m.legacyDB.QueryContext(ctx, query, limit, offset)
+// fetchLegacyLineItems fetches the page of line items from the legacy database
+// after the line item with ID after
+func (m *ReceiptMigrator) fetchLegacyLineItems(ctx context.Context, after string, limit int) ([]LegacyLineItem, error) {
+	rows, err := m.source.Page(ctx, phaseLineItems, after, limit)
 	if err != nil {
 		return nil, err
 	}
+	return scanLegacyLineItems(rows)
+}
+
+// scanLegacyLineItems scans line items from rows and closes rows
+func scanLegacyLineItems(rows *sql.Rows) ([]LegacyLineItem, error) {
 	defer rows.Close()
 
 	var lineItems []LegacyLineItem
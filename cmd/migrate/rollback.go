@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/googledriveconnection"
+	"clockzen-next/internal/ent/lineitem"
+	"clockzen-next/internal/ent/migrationstate"
+	"clockzen-next/internal/ent/receipt"
+	"clockzen-next/internal/ent/transaction"
+)
+
+// RollbackStats counts the rows created by a run that were deleted
+type RollbackStats struct {
+	LineItems        int
+	Transactions     int
+	Receipts         int
+	EmailConnections int
+	DriveConnections int
+}
+
+// runRollback deletes the rows created by a migration run and prints a
+// summary
+func runRollback(ctx context.Context, targetDSN, runID string, dryRun bool) {
+	client, err := ent.Open("postgres", targetDSN)
+	if err != nil {
+		log.Fatalf("Failed to connect to target database: %v", err)
+	}
+	defer client.Close()
+
+	status, err := NewStateStore(client).RunStatus(ctx, runID)
+	if err != nil {
+		log.Fatalf("Rollback failed: %v", err)
+	}
+	switch status {
+	case "":
+		log.Fatalf("No migration run %s", runID)
+	case runRolledBack:
+		log.Fatalf("Migration run %s was already rolled back", runID)
+	}
+
+	log.Printf("Rolling back migration run %s (%s)", runID, status)
+	stats, err := rollbackRun(ctx, client, runID, dryRun)
+	if err != nil {
+		log.Fatalf("Rollback failed: %v", err)
+	}
+
+	fmt.Println("\n=== Rollback Summary ===")
+	fmt.Printf("Line items deleted:           %d\n", stats.LineItems)
+	fmt.Printf("Transactions deleted:         %d\n", stats.Transactions)
+	fmt.Printf("Receipts deleted:             %d\n", stats.Receipts)
+	fmt.Printf("Email connections deleted:    %d\n", stats.EmailConnections)
+	fmt.Printf("Drive connections deleted:    %d\n", stats.DriveConnections)
+
+	if dryRun {
+		fmt.Println("\n[DRY RUN] No changes were made to the target database")
+	}
+}
+
+// rollbackRun deletes the rows the run created, children before their
+// parents, in one transaction, so a rollback that fails, e.g. because a
+// connection has synced since, leaves everything as it was. The cursors the
+// run saved are reset, so resuming starts from the beginning rather than
+// skipping what was rolled back, while other runs' cursors are kept; legacy
+// users keep their new IDs. Receipt images that were copied are left in
+// place. A dry run only counts the rows.
+func rollbackRun(ctx context.Context, client *ent.Client, runID string, dryRun bool) (*RollbackStats, error) {
+	stats := &RollbackStats{}
+
+	if dryRun {
+		counts := []struct {
+			n     *int
+			count func(context.Context) (int, error)
+		}{
+			{&stats.LineItems, client.LineItem.Query().Where(lineitem.MigrationRunID(runID)).Count},
+			{&stats.Transactions, client.Transaction.Query().Where(transaction.MigrationRunID(runID)).Count},
+			{&stats.Receipts, client.Receipt.Query().Where(receipt.MigrationRunID(runID)).Count},
+			{&stats.EmailConnections, client.EmailConnection.Query().Where(emailconnection.MigrationRunID(runID)).Count},
+			{&stats.DriveConnections, client.GoogleDriveConnection.Query().Where(googledriveconnection.MigrationRunID(runID)).Count},
+		}
+		for _, c := range counts {
+			n, err := c.count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count rows created by run: %w", err)
+			}
+			*c.n = n
+		}
+		return stats, nil
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	deletes := []struct {
+		name string
+		n    *int
+		exec func() (int, error)
+	}{
+		{"line items", &stats.LineItems, func() (int, error) {
+			return tx.LineItem.Delete().Where(lineitem.MigrationRunID(runID)).Exec(ctx)
+		}},
+		{"transactions", &stats.Transactions, func() (int, error) {
+			return tx.Transaction.Delete().Where(transaction.MigrationRunID(runID)).Exec(ctx)
+		}},
+		{"receipts", &stats.Receipts, func() (int, error) {
+			return tx.Receipt.Delete().Where(receipt.MigrationRunID(runID)).Exec(ctx)
+		}},
+		{"email connections", &stats.EmailConnections, func() (int, error) {
+			return tx.EmailConnection.Delete().Where(emailconnection.MigrationRunID(runID)).Exec(ctx)
+		}},
+		{"drive connections", &stats.DriveConnections, func() (int, error) {
+			return tx.GoogleDriveConnection.Delete().Where(googledriveconnection.MigrationRunID(runID)).Exec(ctx)
+		}},
+	}
+	for _, d := range deletes {
+		n, err := d.exec()
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("failed to delete %s: %w", d.name, err)
+		}
+		*d.n = n
+	}
+
+	if _, err := tx.MigrationState.Delete().
+		Where(
+			migrationstate.KindEQ(migrationstate.KindCursor),
+			migrationstate.RunID(runID),
+		).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("failed to reset cursors: %w", err)
+	}
+	if err := finishRun(ctx, tx.Client(), runID, runRolledBack); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit rollback: %w", err)
+	}
+	return stats, nil
+}
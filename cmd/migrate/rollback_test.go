@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"clockzen-next/internal/application/transactions"
+	"clockzen-next/internal/ent/enttest"
+	"clockzen-next/internal/ent/migrationstate"
+	"clockzen-next/internal/ent/transaction"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollbackRun(t *testing.T) {
+	ctx := context.Background()
+	source := newLegacySource(t)
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	state := NewStateStore(client)
+	runID := migrate(t, source, client)
+
+	// A transaction deleted and restored since is still the run's
+	migrated := client.Transaction.Query().Where(transaction.LegacyID("t1")).OnlyX(ctx)
+	service := transactions.NewService(client)
+	operation, err := service.BulkDelete(ctx, migrated.UserID, transactions.BulkSelection{TransactionIDs: []string{migrated.ID}})
+	require.NoError(t, err)
+	_, err = service.UndoOperation(ctx, migrated.UserID, operation.ID)
+	require.NoError(t, err)
+
+	// Another run owns the accounts cursor
+	require.NoError(t, state.SaveCursor(ctx, "other-run", phaseAccounts, "a9"))
+
+	stats, err := rollbackRun(ctx, client, runID, true)
+	require.NoError(t, err)
+	assert.Equal(t, &RollbackStats{LineItems: 1, Transactions: 1, Receipts: 1}, stats)
+	assert.Equal(t, 1, client.Transaction.Query().CountX(ctx), "a dry run deletes nothing")
+
+	stats, err = rollbackRun(ctx, client, runID, false)
+	require.NoError(t, err)
+	assert.Equal(t, &RollbackStats{LineItems: 1, Transactions: 1, Receipts: 1}, stats)
+	assert.Zero(t, client.Transaction.Query().CountX(ctx))
+	assert.Zero(t, client.Receipt.Query().CountX(ctx))
+	assert.Zero(t, client.LineItem.Query().CountX(ctx))
+
+	status, err := state.RunStatus(ctx, runID)
+	require.NoError(t, err)
+	assert.Equal(t, runRolledBack, status)
+
+	// Only the rolled back run's cursors are reset; users keep their IDs
+	for _, phase := range []string{phaseUsers, phaseReceipts, phaseTransactions, phaseLineItems} {
+		cursor, err := state.Cursor(ctx, phase)
+		require.NoError(t, err)
+		assert.Empty(t, cursor, phase)
+	}
+	cursor, err := state.Cursor(ctx, phaseAccounts)
+	require.NoError(t, err)
+	assert.Equal(t, "a9", cursor)
+	assert.Equal(t, 1, client.MigrationState.Query().Where(migrationstate.KindEQ(migrationstate.KindUserID)).CountX(ctx))
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Driver opens a legacy database and knows how its SQL differs
+type Driver interface {
+	// Open opens the database at dsn
+	Open(dsn string) (*sql.DB, error)
+	// Placeholder returns the placeholder for the nth query argument,
+	// counting from 1
+	Placeholder(n int) string
+	// Random returns the expression ordering rows randomly
+	Random() string
+}
+
+// drivers are the source drivers, by name
+var drivers = map[string]Driver{
+	"postgres": postgresDriver{},
+	"mysql":    mysqlDriver{},
+	"sqlite3":  sqliteDriver{},
+}
+
+// RegisterDriver makes a source driver available by name
+func RegisterDriver(name string, driver Driver) {
+	drivers[name] = driver
+}
+
+// driverNames returns the names of the source drivers, sorted
+func driverNames() []string {
+	names := make([]string, 0, len(drivers))
+	for name := range drivers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// postgresDriver reads a PostgreSQL database
+type postgresDriver struct{}
+
+func (postgresDriver) Open(dsn string) (*sql.DB, error) {
+	return sql.Open("postgres", dsn)
+}
+
+func (postgresDriver) Placeholder(n int) string {
+	return "$" + strconv.Itoa(n)
+}
+
+func (postgresDriver) Random() string {
+	return "RANDOM()"
+}
+
+// mysqlDriver reads a MySQL database. Times are always parsed, so
+// timestamp columns scan as they do from the other drivers.
+type mysqlDriver struct{}
+
+func (mysqlDriver) Open(dsn string) (*sql.DB, error) {
+	config, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+	config.ParseTime = true
+	connector, err := mysql.NewConnector(config)
+	if err != nil {
+		return nil, err
+	}
+	return sql.OpenDB(connector), nil
+}
+
+func (mysqlDriver) Placeholder(int) string {
+	return "?"
+}
+
+func (mysqlDriver) Random() string {
+	return "RAND()"
+}
+
+// sqliteDriver reads a SQLite database file. Timestamps are parsed from
+// columns declared DATETIME, TIMESTAMP or DATE.
+type sqliteDriver struct{}
+
+func (sqliteDriver) Open(dsn string) (*sql.DB, error) {
+	return sql.Open("sqlite3", dsn)
+}
+
+func (sqliteDriver) Placeholder(int) string {
+	return "?"
+}
+
+func (sqliteDriver) Random() string {
+	return "RANDOM()"
+}
+
+// Source reads a legacy database through a driver, with its schema
+// described by a mapping
+type Source struct {
+	db      *sql.DB
+	driver  Driver
+	mapping *Mapping
+}
+
+// OpenSource connects to the legacy database at dsn with the named driver
+func OpenSource(ctx context.Context, driverName, dsn string, mapping *Mapping) (*Source, error) {
+	driver, ok := drivers[driverName]
+	if !ok {
+		return nil, fmt.Errorf("unknown source driver %q, expected one of %s", driverName, strings.Join(driverNames(), ", "))
+	}
+	db, err := driver.Open(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping: %w", err)
+	}
+	return &Source{db: db, driver: driver, mapping: mapping}, nil
+}
+
+// Close closes the legacy database
+func (s *Source) Close() error {
+	return s.db.Close()
+}
+
+// Page queries the next page of at most limit rows of the entity, ordered
+// by ID, after the row with ID after. Columns are in the order the entity
+// lists its fields. The first page, with after "", has no lower bound, so
+// IDs of any type compare.
+func (s *Source) Page(ctx context.Context, entity, after string, limit int) (*sql.Rows, error) {
+	cols := s.mapping.Columns(entity)
+	// id is always an entity's first field
+	id := cols[0]
+
+	var query strings.Builder
+	fmt.Fprintf(&query, "SELECT %s FROM %s", strings.Join(cols, ", "), s.mapping.Table(entity))
+	var args []any
+	if after != "" {
+		args = append(args, after)
+		fmt.Fprintf(&query, " WHERE %s > %s", id, s.driver.Placeholder(len(args)))
+	}
+	args = append(args, limit)
+	fmt.Fprintf(&query, " ORDER BY %s LIMIT %s", id, s.driver.Placeholder(len(args)))
+
+	return s.db.QueryContext(ctx, query.String(), args...)
+}
+
+// Sample queries up to n rows of the entity chosen at random, with columns
+// as for Page
+func (s *Source) Sample(ctx context.Context, entity string, n int) (*sql.Rows, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s ORDER BY %s LIMIT %s",
+		strings.Join(s.mapping.Columns(entity), ", "), s.mapping.Table(entity),
+		s.driver.Random(), s.driver.Placeholder(1))
+	return s.db.QueryContext(ctx, query, n)
+}
+
+// Count counts the rows of the entity
+func (s *Source) Count(ctx context.Context, entity string) (int, error) {
+	var count int
+	err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM "+s.mapping.Table(entity)).Scan(&count)
+	return count, err
+}
+
+// Value translates a legacy value of kind through the mapping
+func (s *Source) Value(kind, legacy string) string {
+	return s.mapping.Value(kind, legacy)
+}
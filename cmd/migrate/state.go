@@ -0,0 +1,225 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/migrationstate"
+
+	"github.com/google/uuid"
+)
+
+// Phases of the migration, each migrating one legacy table with its own
+// cursor. They also name the tables in a mapping.
+const (
+	phaseUsers        = "users"
+	phaseAccounts     = "accounts"
+	phaseReceipts     = "receipts"
+	phaseTransactions = "transactions"
+	phaseLineItems    = "line_items"
+)
+
+// Statuses of a migration run
+const (
+	runRunning    = "running"
+	runCompleted  = "completed"
+	runRolledBack = "rolled_back"
+)
+
+// StateStore persists migration progress in the migration_state table of
+// the target database: the new ID given to each legacy user, so reruns keep
+// them, the last legacy ID each phase migrated, so an interrupted
+// migration can resume after it, and each run, so what it created can be
+// rolled back
+type StateStore struct {
+	client *ent.Client
+}
+
+// NewStateStore creates a state store on the target database
+func NewStateStore(client *ent.Client) *StateStore {
+	return &StateStore{client: client}
+}
+
+// UserIDs returns the new user ID of every legacy user migrated so far,
+// keyed by legacy user ID
+func (s *StateStore) UserIDs(ctx context.Context) (map[string]string, error) {
+	states, err := s.client.MigrationState.Query().
+		Where(migrationstate.KindEQ(migrationstate.KindUserID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load user ID mapping: %w", err)
+	}
+	userIDs := make(map[string]string, len(states))
+	for _, state := range states {
+		userIDs[state.Key] = state.Value
+	}
+	return userIDs, nil
+}
+
+// SaveUserIDs saves a batch of new user IDs, keyed by legacy user ID,
+// together with the run's users cursor, so the batch is recorded whole or
+// not at all
+func (s *StateStore) SaveUserIDs(ctx context.Context, runID string, userIDs map[string]string, cursor string) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	if len(userIDs) > 0 {
+		creates := make([]*ent.MigrationStateCreate, 0, len(userIDs))
+		for legacyID, newID := range userIDs {
+			creates = append(creates, tx.MigrationState.Create().
+				SetID(uuid.New().String()).
+				SetKind(migrationstate.KindUserID).
+				SetKey(legacyID).
+				SetValue(newID))
+		}
+		// A mapping is never changed once saved
+		err := tx.MigrationState.CreateBulk(creates...).
+			OnConflictColumns(migrationstate.FieldKind, migrationstate.FieldKey).
+			DoNothing().
+			Exec(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to save user ID mapping: %w", err)
+		}
+	}
+
+	if err := saveCursor(ctx, tx.Client(), runID, phaseUsers, cursor); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// Cursor returns the last legacy ID the phase migrated, or "" if it hasn't
+// started
+func (s *StateStore) Cursor(ctx context.Context, phase string) (string, error) {
+	state, err := s.client.MigrationState.Query().
+		Where(
+			migrationstate.KindEQ(migrationstate.KindCursor),
+			migrationstate.Key(phase),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to load %s cursor: %w", phase, err)
+	}
+	return state.Value, nil
+}
+
+// SaveCursor records id as the last legacy ID the phase migrated in the run
+func (s *StateStore) SaveCursor(ctx context.Context, runID, phase, id string) error {
+	return saveCursor(ctx, s.client, runID, phase, id)
+}
+
+// saveCursor upserts the phase's cursor, recording the run that saved it
+func saveCursor(ctx context.Context, client *ent.Client, runID, phase, id string) error {
+	err := client.MigrationState.Create().
+		SetID(uuid.New().String()).
+		SetKind(migrationstate.KindCursor).
+		SetKey(phase).
+		SetValue(id).
+		SetRunID(runID).
+		OnConflictColumns(migrationstate.FieldKind, migrationstate.FieldKey).
+		UpdateValue().
+		UpdateRunID().
+		UpdateUpdatedAt().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save %s cursor: %w", phase, err)
+	}
+	return nil
+}
+
+// StartRun records a new run of the migration and returns its ID. When
+// resuming, the latest run that didn't finish is continued instead, so
+// everything it creates can be rolled back together.
+func (s *StateStore) StartRun(ctx context.Context, resume bool) (string, error) {
+	if resume {
+		run, err := s.client.MigrationState.Query().
+			Where(
+				migrationstate.KindEQ(migrationstate.KindRun),
+				migrationstate.Value(runRunning),
+			).
+			Order(ent.Desc(migrationstate.FieldCreatedAt)).
+			First(ctx)
+		if err == nil {
+			return run.Key, nil
+		}
+		if !ent.IsNotFound(err) {
+			return "", fmt.Errorf("failed to load interrupted run: %w", err)
+		}
+	}
+
+	runID := uuid.New().String()
+	err := s.client.MigrationState.Create().
+		SetID(uuid.New().String()).
+		SetKind(migrationstate.KindRun).
+		SetKey(runID).
+		SetValue(runRunning).
+		Exec(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to record run: %w", err)
+	}
+	return runID, nil
+}
+
+// RunStatus returns the status of the run, or "" if there is no such run
+func (s *StateStore) RunStatus(ctx context.Context, runID string) (string, error) {
+	run, err := s.client.MigrationState.Query().
+		Where(
+			migrationstate.KindEQ(migrationstate.KindRun),
+			migrationstate.Key(runID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to load run %s: %w", runID, err)
+	}
+	return run.Value, nil
+}
+
+// FinishRun sets the status of the run
+func (s *StateStore) FinishRun(ctx context.Context, runID, status string) error {
+	return finishRun(ctx, s.client, runID, status)
+}
+
+// finishRun sets the status of the run
+func finishRun(ctx context.Context, client *ent.Client, runID, status string) error {
+	err := client.MigrationState.Update().
+		Where(
+			migrationstate.KindEQ(migrationstate.KindRun),
+			migrationstate.Key(runID),
+		).
+		SetValue(status).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update run %s: %w", runID, err)
+	}
+	return nil
+}
+
+// startAfter returns the legacy ID the phase starts after: its cursor when
+// resuming, or "" to start from the beginning. Without a store, as in a dry
+// run, every phase starts from the beginning.
+func startAfter(ctx context.Context, state *StateStore, resume bool, phase string) (string, error) {
+	if state == nil || !resume {
+		return "", nil
+	}
+	return state.Cursor(ctx, phase)
+}
+
+// advanceCursor records id as the last legacy ID the phase migrated in the
+// run, if there is a store to record it in
+func advanceCursor(ctx context.Context, state *StateStore, runID, phase, id string) error {
+	if state == nil {
+		return nil
+	}
+	return state.SaveCursor(ctx, runID, phase, id)
+}
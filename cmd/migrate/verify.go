@@ -0,0 +1,405 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/googledriveconnection"
+	"clockzen-next/internal/ent/lineitem"
+	"clockzen-next/internal/ent/receipt"
+	"clockzen-next/internal/ent/transaction"
+)
+
+// VerifyReport is what verifying a migration found
+type VerifyReport struct {
+	Counts     []CountCheck
+	Sampled    int
+	Mismatches []string
+}
+
+// CountCheck compares how many rows of an entity the legacy database has
+// with how many were migrated
+type CountCheck struct {
+	Entity string
+	Legacy int
+	Target int
+}
+
+// OK reports whether every row was migrated
+func (c CountCheck) OK() bool {
+	return c.Legacy == c.Target
+}
+
+// Verifier compares the legacy and target databases after a migration: the
+// row counts of each entity, and a random sample of legacy rows against the
+// rows migrated from them
+type Verifier struct {
+	source  *Source
+	client  *ent.Client
+	state   *StateStore
+	sample  int
+	verbose bool
+	userIDs map[string]string
+	report  *VerifyReport
+}
+
+// NewVerifier creates a verifier checking sample rows of each entity
+func NewVerifier(source *Source, client *ent.Client, sample int, verbose bool) *Verifier {
+	return &Verifier{
+		source:  source,
+		client:  client,
+		state:   NewStateStore(client),
+		sample:  sample,
+		verbose: verbose,
+	}
+}
+
+// Run verifies the migration. Differences are reported, not returned as
+// errors; an error means the databases couldn't be compared.
+func (v *Verifier) Run(ctx context.Context) (*VerifyReport, error) {
+	v.report = &VerifyReport{}
+
+	userIDs, err := v.state.UserIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	v.userIDs = userIDs
+
+	log.Println("Comparing row counts...")
+	if err := v.compareCounts(ctx); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Comparing %d sampled records of each table...", v.sample)
+	samples := []struct {
+		entity string
+		check  func(context.Context) error
+	}{
+		{phaseUsers, v.sampleUsers},
+		{phaseAccounts, v.sampleAccounts},
+		{phaseReceipts, v.sampleReceipts},
+		{phaseTransactions, v.sampleTransactions},
+		{phaseLineItems, v.sampleLineItems},
+	}
+	for _, s := range samples {
+		if err := s.check(ctx); err != nil {
+			return nil, fmt.Errorf("failed to sample %s: %w", s.entity, err)
+		}
+	}
+
+	return v.report, nil
+}
+
+// compareCounts compares the rows of each entity. Users aren't stored in
+// the target, so their count is of users given a new ID; connections and
+// receipt data are counted if the migration created them.
+func (v *Verifier) compareCounts(ctx context.Context) error {
+	targets := []struct {
+		entity string
+		count  func(context.Context) (int, error)
+	}{
+		{phaseUsers, func(context.Context) (int, error) { return len(v.userIDs), nil }},
+		{phaseAccounts, func(ctx context.Context) (int, error) {
+			emails, err := v.client.EmailConnection.Query().
+				Where(emailconnection.MigrationRunIDNotNil()).
+				Count(ctx)
+			if err != nil {
+				return 0, err
+			}
+			drives, err := v.client.GoogleDriveConnection.Query().
+				Where(googledriveconnection.MigrationRunIDNotNil()).
+				Count(ctx)
+			return emails + drives, err
+		}},
+		{phaseReceipts, v.client.Receipt.Query().Where(receipt.LegacyIDNotNil()).Count},
+		{phaseTransactions, v.client.Transaction.Query().Where(transaction.LegacyIDNotNil()).Count},
+		{phaseLineItems, v.client.LineItem.Query().Where(lineitem.LegacyIDNotNil()).Count},
+	}
+
+	for _, t := range targets {
+		legacy, err := v.source.Count(ctx, t.entity)
+		if err != nil {
+			return fmt.Errorf("failed to count legacy %s: %w", t.entity, err)
+		}
+		target, err := t.count(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to count migrated %s: %w", t.entity, err)
+		}
+		v.report.Counts = append(v.report.Counts, CountCheck{Entity: t.entity, Legacy: legacy, Target: target})
+	}
+	return nil
+}
+
+// sampleUsers checks sampled users were given a new ID
+func (v *Verifier) sampleUsers(ctx context.Context) error {
+	rows, err := v.source.Sample(ctx, phaseUsers, v.sample)
+	if err != nil {
+		return err
+	}
+	users, err := scanLegacyUsers(rows)
+	if err != nil {
+		return err
+	}
+	for _, user := range users {
+		v.report.Sampled++
+		if _, ok := v.userIDs[user.ID]; !ok {
+			v.mismatch("user %s: not migrated", user.ID)
+		}
+	}
+	return nil
+}
+
+// sampleAccounts checks sampled accounts against the connections migrated
+// from them
+func (v *Verifier) sampleAccounts(ctx context.Context) error {
+	rows, err := v.source.Sample(ctx, phaseAccounts, v.sample)
+	if err != nil {
+		return err
+	}
+	accounts, err := scanLegacyAccounts(v.source, rows)
+	if err != nil {
+		return err
+	}
+
+	for _, account := range accounts {
+		v.report.Sampled++
+		what := "account " + account.ID
+		userID, ok := v.userIDs[account.UserID]
+		if !ok {
+			v.mismatch("%s: user %s not migrated", what, account.UserID)
+			continue
+		}
+
+		switch account.Provider {
+		case "gmail", "outlook", "imap":
+			conn, err := v.client.EmailConnection.Query().
+				Where(
+					emailconnection.ProviderAccountID(account.ProviderID),
+					emailconnection.ProviderEQ(mapEmailProvider(account.Provider)),
+				).
+				Only(ctx)
+			if ent.IsNotFound(err) {
+				v.mismatch("%s: not migrated", what)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			v.compare(what, "user_id", userID, conn.UserID)
+			v.compare(what, "email", account.Email, conn.Email)
+			v.compare(what, "status", string(mapStatus(account.Status)), string(conn.Status))
+		case "google_drive":
+			googleAccountID := account.GoogleAccountID
+			if googleAccountID == "" {
+				googleAccountID = account.ProviderID
+			}
+			conn, err := v.client.GoogleDriveConnection.Query().
+				Where(googledriveconnection.GoogleAccountID(googleAccountID)).
+				Only(ctx)
+			if ent.IsNotFound(err) {
+				v.mismatch("%s: not migrated", what)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			v.compare(what, "user_id", userID, conn.UserID)
+			v.compare(what, "email", account.Email, conn.Email)
+			v.compare(what, "status", string(mapDriveStatus(account.Status)), string(conn.Status))
+		default:
+			v.mismatch("%s: unknown provider %s", what, account.Provider)
+		}
+	}
+	return nil
+}
+
+// sampleReceipts checks sampled receipts against the receipts migrated
+// from them
+func (v *Verifier) sampleReceipts(ctx context.Context) error {
+	rows, err := v.source.Sample(ctx, phaseReceipts, v.sample)
+	if err != nil {
+		return err
+	}
+	receipts, err := scanLegacyReceipts(v.source, rows)
+	if err != nil {
+		return err
+	}
+
+	for _, legacy := range receipts {
+		v.report.Sampled++
+		what := "receipt " + legacy.ID
+		r, err := v.client.Receipt.Query().
+			Where(receipt.LegacyID(legacy.ID)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			v.mismatch("%s: not migrated", what)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		v.compare(what, "user_id", v.newUserID(legacy.UserID), r.UserID)
+		v.compare(what, "file_name", legacy.FileName, r.FileName)
+		v.compare(what, "status", string(mapReceiptStatus(legacy.Status)), string(r.Status))
+		v.compare(what, "currency", legacy.Currency, r.Currency)
+		v.compare(what, "total_amount", derefFloat(legacy.TotalAmount), derefFloat(r.TotalAmount))
+	}
+	return nil
+}
+
+// sampleTransactions checks sampled transactions against the transactions
+// migrated from them
+func (v *Verifier) sampleTransactions(ctx context.Context) error {
+	rows, err := v.source.Sample(ctx, phaseTransactions, v.sample)
+	if err != nil {
+		return err
+	}
+	transactions, err := scanLegacyTransactions(v.source, rows)
+	if err != nil {
+		return err
+	}
+
+	for _, legacy := range transactions {
+		v.report.Sampled++
+		what := "transaction " + legacy.ID
+		tx, err := v.client.Transaction.Query().
+			Where(transaction.LegacyID(legacy.ID)).
+			WithReceipt().
+			First(ctx)
+		if ent.IsNotFound(err) {
+			v.mismatch("%s: not migrated", what)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		v.compare(what, "user_id", v.newUserID(legacy.UserID), tx.UserID)
+		v.compare(what, "type", string(mapTransactionType(legacy.Type)), string(tx.Type))
+		v.compare(what, "amount", legacy.Amount, tx.Amount)
+		v.compare(what, "currency", legacy.Currency, tx.Currency)
+		v.compareTime(what, "transaction_date", legacy.TransactionDate, tx.TransactionDate)
+		v.compareReceipt(what, legacy.ReceiptID, tx.Edges.Receipt)
+	}
+	return nil
+}
+
+// sampleLineItems checks sampled line items against the line items
+// migrated from them
+func (v *Verifier) sampleLineItems(ctx context.Context) error {
+	rows, err := v.source.Sample(ctx, phaseLineItems, v.sample)
+	if err != nil {
+		return err
+	}
+	items, err := scanLegacyLineItems(rows)
+	if err != nil {
+		return err
+	}
+
+	for _, legacy := range items {
+		v.report.Sampled++
+		what := "line item " + legacy.ID
+		item, err := v.client.LineItem.Query().
+			Where(lineitem.LegacyID(legacy.ID)).
+			WithReceipt().
+			First(ctx)
+		if ent.IsNotFound(err) {
+			v.mismatch("%s: not migrated", what)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		v.compare(what, "description", legacy.Description, item.Description)
+		v.compare(what, "quantity", legacy.Quantity, item.Quantity)
+		v.compare(what, "total_price", legacy.TotalPrice, item.TotalPrice)
+		v.compareReceipt(what, legacy.ReceiptID, item.Edges.Receipt)
+	}
+	return nil
+}
+
+// newUserID returns the ID a legacy user was migrated to. Receipts of
+// users that weren't migrated keep the legacy ID.
+func (v *Verifier) newUserID(legacyID string) string {
+	if id, ok := v.userIDs[legacyID]; ok {
+		return id
+	}
+	return legacyID
+}
+
+// compare records a mismatch if a field's legacy and migrated values differ
+func (v *Verifier) compare(what, field string, legacy, target any) {
+	if legacy != target {
+		v.mismatch("%s: %s is %v in legacy but %v in target", what, field, legacy, target)
+	}
+}
+
+// compareTime records a mismatch if a time field's legacy and migrated
+// values are different instants
+func (v *Verifier) compareTime(what, field string, legacy, target time.Time) {
+	if !legacy.Equal(target) {
+		v.mismatch("%s: %s is %s in legacy but %s in target", what, field, legacy.Format(time.RFC3339), target.Format(time.RFC3339))
+	}
+}
+
+// compareReceipt records a mismatch if a migrated row doesn't belong to
+// the receipt migrated from its legacy receipt
+func (v *Verifier) compareReceipt(what, legacyReceiptID string, r *ent.Receipt) {
+	if r == nil || r.LegacyID == nil || *r.LegacyID != legacyReceiptID {
+		v.mismatch("%s: not attached to receipt %s", what, legacyReceiptID)
+	}
+}
+
+// mismatch records a difference between the databases
+func (v *Verifier) mismatch(format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	v.report.Mismatches = append(v.report.Mismatches, msg)
+	if v.verbose {
+		log.Println(msg)
+	}
+}
+
+// derefFloat returns *f, or nil for a nil f, so optional amounts compare
+// by value
+func derefFloat(f *float64) any {
+	if f == nil {
+		return nil
+	}
+	return *f
+}
+
+// PrintSummary prints the verification report
+func (r *VerifyReport) PrintSummary() {
+	fmt.Println("\n=== Verification Summary ===")
+	fmt.Printf("%-14s %10s %10s\n", "Table", "Legacy", "Migrated")
+	for _, c := range r.Counts {
+		status := ""
+		if !c.OK() {
+			status = "  MISMATCH"
+		}
+		fmt.Printf("%-14s %10d %10d%s\n", c.Entity, c.Legacy, c.Target, status)
+	}
+
+	fmt.Printf("\nRecords sampled:              %d\n", r.Sampled)
+	fmt.Printf("Mismatches found:             %d\n", len(r.Mismatches))
+	for i, msg := range r.Mismatches {
+		if i >= 20 {
+			fmt.Printf("  ... and %d more mismatches\n", len(r.Mismatches)-20)
+			break
+		}
+		fmt.Printf("  - %s\n", msg)
+	}
+}
+
+// OK reports whether the verification found no differences
+func (r *VerifyReport) OK() bool {
+	for _, c := range r.Counts {
+		if !c.OK() {
+			return false
+		}
+	}
+	return len(r.Mismatches) == 0
+}
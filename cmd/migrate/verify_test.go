@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/enttest"
+	"clockzen-next/internal/ent/transaction"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// legacySchema is the original legacy schema, with the columns the default
+// mapping reads
+const legacySchema = `
+CREATE TABLE users (
+	id TEXT PRIMARY KEY, email TEXT NOT NULL, name TEXT,
+	created_at DATETIME NOT NULL, updated_at DATETIME NOT NULL
+);
+CREATE TABLE accounts (
+	id TEXT PRIMARY KEY, user_id TEXT, provider TEXT, provider_id TEXT, email TEXT,
+	access_token TEXT, refresh_token TEXT, token_expiry DATETIME, status TEXT,
+	created_at DATETIME, updated_at DATETIME, last_sync_at DATETIME, google_account_id TEXT
+);
+CREATE TABLE receipts (
+	id TEXT PRIMARY KEY, user_id TEXT, source_type TEXT, source_id TEXT, source_connection_id TEXT,
+	file_name TEXT, file_path TEXT, mime_type TEXT, file_size INTEGER,
+	storage_bucket TEXT, storage_key TEXT, thumbnail_path TEXT,
+	status TEXT, ocr_completed BOOLEAN, ocr_text TEXT, ocr_confidence REAL,
+	merchant_name TEXT, merchant_address TEXT, receipt_date DATETIME,
+	total_amount REAL, tax_amount REAL, subtotal_amount REAL, currency TEXT,
+	payment_method TEXT, receipt_number TEXT, category_tags TEXT,
+	extracted_data TEXT, metadata TEXT, notes TEXT,
+	created_at DATETIME, updated_at DATETIME, processed_at DATETIME
+);
+CREATE TABLE transactions (
+	id TEXT PRIMARY KEY, receipt_id TEXT, user_id TEXT, type TEXT, amount REAL, currency TEXT,
+	transaction_date DATETIME, description TEXT, merchant_name TEXT, merchant_category TEXT,
+	payment_method TEXT, card_last_four TEXT, reference_number TEXT, authorization_code TEXT,
+	status TEXT, is_recurring BOOLEAN, recurrence_pattern TEXT, category_tags TEXT,
+	metadata TEXT, notes TEXT, created_at DATETIME, updated_at DATETIME
+);
+CREATE TABLE line_items (
+	id TEXT PRIMARY KEY, receipt_id TEXT, line_number INTEGER, description TEXT, sku TEXT, product_code TEXT,
+	quantity REAL, unit TEXT, unit_price REAL, total_price REAL,
+	discount_amount REAL, discount_description TEXT, tax_amount REAL, tax_rate REAL,
+	is_taxable BOOLEAN, category TEXT, tags TEXT, metadata TEXT,
+	created_at DATETIME, updated_at DATETIME
+);
+`
+
+// newLegacySource creates a legacy database holding a user with a receipt,
+// its transaction and a line item
+func newLegacySource(t *testing.T) *Source {
+	t.Helper()
+	ctx := context.Background()
+	source, err := OpenSource(ctx, "sqlite3", "file:legacy_"+t.Name()+"?mode=memory&cache=shared", &Mapping{})
+	require.NoError(t, err)
+	t.Cleanup(func() { source.Close() })
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	statements := []struct {
+		query string
+		args  []any
+	}{
+		{legacySchema, nil},
+		{`INSERT INTO users (id, email, name, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`,
+			[]any{"u1", "jane@example.com", "Jane", now, now}},
+		{`INSERT INTO receipts (id, user_id, source_type, file_name, mime_type, file_size, status, ocr_completed,
+			total_amount, currency, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			[]any{"r1", "u1", "upload", "coffee.jpg", "image/jpeg", 1024, "processed", true, 4.5, "USD", now, now}},
+		{`INSERT INTO transactions (id, receipt_id, user_id, type, amount, currency, transaction_date, status,
+			is_recurring, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			[]any{"t1", "r1", "u1", "purchase", 4.5, "USD", now, "completed", false, now, now}},
+		{`INSERT INTO line_items (id, receipt_id, line_number, description, quantity, unit_price, total_price,
+			discount_amount, tax_amount, is_taxable, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			[]any{"l1", "r1", 1, "Latte", 1, 4.5, 4.5, 0, 0, true, now, now}},
+	}
+	for _, s := range statements {
+		_, err := source.db.ExecContext(ctx, s.query, s.args...)
+		require.NoError(t, err)
+	}
+	return source
+}
+
+// migrate runs a migration of source into client and returns its run ID
+func migrate(t *testing.T, source *Source, client *ent.Client) string {
+	t.Helper()
+	migrator := &Migrator{
+		source:       source,
+		targetClient: client,
+		batchSize:    10,
+		state:        NewStateStore(client),
+		stats:        &MigrationStats{},
+	}
+	require.NoError(t, migrator.Run(context.Background()))
+	require.Empty(t, migrator.stats.Errors)
+	return migrator.runID
+}
+
+func TestVerifier(t *testing.T) {
+	ctx := context.Background()
+	source := newLegacySource(t)
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	migrate(t, source, client)
+
+	report, err := NewVerifier(source, client, 10, false).Run(ctx)
+	require.NoError(t, err)
+	assert.True(t, report.OK(), report.Mismatches)
+	assert.Equal(t, 4, report.Sampled)
+	for _, c := range report.Counts {
+		assert.True(t, c.OK(), c.Entity)
+	}
+
+	// A migrated row that changed or went missing is reported
+	_, err = client.Transaction.Update().
+		Where(transaction.LegacyID("t1")).
+		SetAmount(5).
+		Save(ctx)
+	require.NoError(t, err)
+	report, err = NewVerifier(source, client, 10, false).Run(ctx)
+	require.NoError(t, err)
+	assert.False(t, report.OK())
+	assert.Equal(t, []string{"transaction t1: amount is 4.5 in legacy but 5 in target"}, report.Mismatches)
+
+	_, err = client.LineItem.Delete().Exec(ctx)
+	require.NoError(t, err)
+	report, err = NewVerifier(source, client, 10, false).Run(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, report.Mismatches, "line item l1: not migrated")
+	assert.Contains(t, report.Counts, CountCheck{Entity: phaseLineItems, Legacy: 1, Target: 0})
+}
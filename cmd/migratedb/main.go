@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/sqltool"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+
+	entmigrate "clockzen-next/internal/ent/migrate"
+	"clockzen-next/internal/infrastructure/config"
+	"clockzen-next/internal/infrastructure/dbmigrate"
+
+	_ "github.com/lib/pq"
+)
+
+func main() {
+	if len(os.Args) < 2 || strings.HasPrefix(os.Args[1], "-") {
+		printUsage()
+		os.Exit(2)
+	}
+	command := os.Args[1]
+
+	cfg := config.DefaultMigrateDB()
+	flag.Usage = printUsage
+	if err := config.Load(&cfg, config.Options{FlagSet: flag.CommandLine, Args: os.Args[2:]}); err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid configuration: %v\n\n", err)
+		printUsage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	switch command {
+	case "up", "down", "status", "baseline":
+		if cfg.DatabaseURL == "" {
+			fmt.Fprintln(os.Stderr, "DATABASE_URL or -database-url is required")
+			os.Exit(2)
+		}
+		runMigrator(ctx, command, cfg)
+	case "diff":
+		runDiff(ctx, cfg)
+	case "hash":
+		runHash(cfg)
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n\n", command)
+		printUsage()
+		os.Exit(2)
+	}
+}
+
+// printUsage prints how the tool is run
+func printUsage() {
+	fmt.Println("Usage: migratedb <command> [flags]")
+	fmt.Println("\nCommands:")
+	fmt.Println("  up        Apply pending migrations, or the next -steps of them")
+	fmt.Println("  down      Revert the last applied migration, or the last -steps of them")
+	fmt.Println("  status    List migrations and whether each has been applied")
+	fmt.Println("  baseline  Record migrations up to -version as applied without running them,")
+	fmt.Println("            for databases created before versioned migrations")
+	fmt.Println("  diff      Generate a migration named -name for changes to the ent schema,")
+	fmt.Println("            planned on the empty database at -dev-url")
+	fmt.Println("  hash      Rewrite atlas.sum after editing a migration by hand")
+	fmt.Println("\nFlags:")
+	fmt.Println("  -database-url  Database to migrate (or DATABASE_URL)")
+	fmt.Println("  -dev-url       Empty database for diff (or MIGRATE_DEV_DATABASE_URL)")
+	fmt.Println("  -dir           Migration directory for diff and hash (default: " + dbmigrate.Dir + ")")
+	fmt.Println("  -steps         Number of migrations for up or down")
+	fmt.Println("  -version       Version for baseline")
+	fmt.Println("  -name          Name for diff")
+	fmt.Println("  -config        YAML config file (or CONFIG_FILE)")
+	fmt.Println("\nup, down, status and baseline use the migrations built into this binary.")
+}
+
+// runMigrator applies, reverts or reports the built-in migrations
+func runMigrator(ctx context.Context, command string, cfg config.MigrateDB) {
+	db, err := sql.Open("postgres", cfg.DatabaseURL.Reveal())
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	migrator, err := dbmigrate.NewWithDefaults(db)
+	if err != nil {
+		log.Fatalf("Failed to load migrations: %v", err)
+	}
+
+	switch command {
+	case "up":
+		applied, err := migrator.Up(ctx, cfg.Steps)
+		for _, m := range applied {
+			log.Printf("Applied %d_%s", m.Version, m.Name)
+		}
+		if err != nil {
+			log.Fatalf("Migration failed: %v", err)
+		}
+		if len(applied) == 0 {
+			log.Println("No pending migrations")
+		}
+	case "down":
+		reverted, err := migrator.Down(ctx, cfg.Steps)
+		for _, m := range reverted {
+			log.Printf("Reverted %d_%s", m.Version, m.Name)
+		}
+		if err != nil {
+			log.Fatalf("Revert failed: %v", err)
+		}
+		if len(reverted) == 0 {
+			log.Println("No applied migrations")
+		}
+	case "baseline":
+		if cfg.Version == 0 {
+			log.Fatal("-version is required for baseline")
+		}
+		recorded, err := migrator.Baseline(ctx, cfg.Version)
+		if err != nil {
+			log.Fatalf("Baseline failed: %v", err)
+		}
+		log.Printf("Recorded %d migrations as applied", len(recorded))
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			log.Fatalf("Failed to read migration status: %v", err)
+		}
+		printStatus(statuses)
+	}
+}
+
+// printStatus prints a table of migrations
+func printStatus(statuses []dbmigrate.Status) {
+	pending := 0
+	fmt.Printf("%-16s %-40s %s\n", "VERSION", "NAME", "APPLIED")
+	for _, s := range statuses {
+		applied := "pending"
+		switch {
+		case s.Missing:
+			applied = s.AppliedAt.Format("2006-01-02 15:04:05") + " (no migration file)"
+		case s.Applied:
+			applied = s.AppliedAt.Format("2006-01-02 15:04:05")
+		default:
+			pending++
+		}
+		fmt.Printf("%-16d %-40s %s\n", s.Version, s.Name, applied)
+	}
+	fmt.Printf("\n%d pending\n", pending)
+}
+
+// runDiff writes a migration for the difference between the migration
+// directory, replayed on the dev database, and the ent schema
+func runDiff(ctx context.Context, cfg config.MigrateDB) {
+	if cfg.DevURL == "" || cfg.Name == "" {
+		log.Fatal("-dev-url and -name are required for diff")
+	}
+	db, err := sql.Open("postgres", cfg.DevURL.Reveal())
+	if err != nil {
+		log.Fatalf("Failed to connect to dev database: %v", err)
+	}
+	defer db.Close()
+
+	dir, err := sqltool.NewGolangMigrateDir(cfg.Dir)
+	if err != nil {
+		log.Fatalf("Failed to open migration directory: %v", err)
+	}
+	m, err := schema.NewMigrate(entsql.OpenDB(dialect.Postgres, db),
+		schema.WithDir(dir),
+		schema.WithMigrationMode(schema.ModeReplay),
+		schema.WithDropColumn(true),
+		schema.WithDropIndex(true),
+		schema.WithErrNoPlan(true),
+	)
+	if err != nil {
+		log.Fatalf("Failed to plan migration: %v", err)
+	}
+	if err := m.NamedDiff(ctx, cfg.Name, entmigrate.Tables...); err != nil {
+		if errors.Is(err, migrate.ErrNoPlan) {
+			log.Println("The ent schema matches the migrations; nothing to generate")
+			return
+		}
+		log.Fatalf("Failed to generate migration: %v", err)
+	}
+	log.Printf("Generated migration %s in %s; review it before committing", cfg.Name, cfg.Dir)
+}
+
+// runHash rewrites the checksum file of the migration directory
+func runHash(cfg config.MigrateDB) {
+	dir, err := sqltool.NewGolangMigrateDir(cfg.Dir)
+	if err != nil {
+		log.Fatalf("Failed to open migration directory: %v", err)
+	}
+	sum, err := dir.Checksum()
+	if err != nil {
+		log.Fatalf("Failed to hash migrations: %v", err)
+	}
+	if err := migrate.WriteSumFile(dir, sum); err != nil {
+		log.Fatalf("Failed to write atlas.sum: %v", err)
+	}
+	log.Println("Updated atlas.sum")
+}
@@ -2,71 +2,247 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
-	"log"
+	"flag"
+	"fmt"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"clockzen-next/internal/application/alerts"
+	"clockzen-next/internal/application/emergencyfund"
 	"clockzen-next/internal/application/integration"
+	"clockzen-next/internal/application/jobs"
+	"clockzen-next/internal/application/notifications"
+	"clockzen-next/internal/application/reports"
+	appRetirement "clockzen-next/internal/application/retirement"
+	"clockzen-next/internal/application/transactions"
+	"clockzen-next/internal/application/webhooks"
 	"clockzen-next/internal/ent"
-	"clockzen-next/internal/infrastructure/google"
+	"clockzen-next/internal/infrastructure/config"
+	"clockzen-next/internal/infrastructure/dbmigrate"
+	"clockzen-next/internal/infrastructure/logging"
+	"clockzen-next/internal/infrastructure/mail"
+	"clockzen-next/internal/infrastructure/observability"
+	"clockzen-next/internal/infrastructure/queue"
+	"clockzen-next/internal/infrastructure/storage"
+	"clockzen-next/internal/infrastructure/tracing"
 	"clockzen-next/internal/infrastructure/worker"
+	"clockzen-next/internal/presentation/http/handlers/analysis"
+	"clockzen-next/internal/presentation/http/handlers/retirement"
+	"clockzen-next/internal/presentation/http/handlers/workers"
 
 	_ "github.com/lib/pq"
 )
 
 func main() {
-	// Get configuration from environment
-	port := getEnv("PORT", "8081")
-	dbURL := getEnv("DATABASE_URL", "")
-
-	if dbURL == "" {
-		log.Fatal("DATABASE_URL is required for worker")
+	// Load configuration from the config file, environment and flags; the
+	// worker doesn't start with missing or invalid settings
+	cfg := config.DefaultWorker()
+	if err := config.Load(&cfg, config.Options{FlagSet: flag.CommandLine, Args: os.Args[1:]}); err != nil {
+		fmt.Fprintf(os.Stderr, "invalid configuration: %v\n", err)
+		os.Exit(1)
 	}
+	port := cfg.Server.Port
+
+	// Configure structured logging; LOG_FORMAT=json for log aggregation
+	slog.SetDefault(newLogger("clockzen-worker", cfg.Logging))
+	slog.Info("configuration loaded", "config", config.Sanitize(cfg))
+
+	// Trace syncs through Google API calls and queries
+	shutdownTracing := setupTracing(cfg.Tracing.Config("clockzen-worker"))
 
-	// Connect to database
-	entClient, err := ent.Open("postgres", dbURL)
+	// Connect to database with a bounded pool, logging queries slower than
+	// the threshold
+	slowQueryConfig := observability.DefaultSlowQueryConfig()
+	slowQueryConfig.Threshold = cfg.Database.SlowQueryThreshold
+	drv, err := observability.OpenDriver("postgres", cfg.Database.URL.Reveal(), cfg.Database.Pool(), slowQueryConfig)
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		fatal("failed to connect to database", "error", err)
 	}
+	entClient := ent.NewClient(ent.Driver(tracing.NewDriver(drv)))
 	defer entClient.Close()
 
-	// Run migrations
 	ctx := context.Background()
-	if err := entClient.Schema.Create(ctx); err != nil {
-		log.Printf("Warning: Failed to run migrations: %v", err)
-	} else {
-		log.Println("Database migrations completed")
+	if err := migrateSchema(ctx, drv.DB(), cfg.Database.AutoMigrate); err != nil {
+		fatal("failed to check database migrations", "error", err)
 	}
 
-	// Configure OAuth (from environment)
-	oauthConfig := &google.Config{
-		ClientID:     getEnv("GOOGLE_CLIENT_ID", ""),
-		ClientSecret: getEnv("GOOGLE_CLIENT_SECRET", ""),
-		RedirectURL:  getEnv("GOOGLE_REDIRECT_URL", ""),
-	}
+	// Configure OAuth
+	oauthConfig := cfg.Google.Config()
 
 	// Create sync services with default configuration
 	emailSyncService := integration.NewEmailSyncServiceWithDefaults(entClient, oauthConfig)
 	driveSyncService := integration.NewDriveSyncServiceWithDefaults(entClient, oauthConfig)
 
-	// Create workers with default configuration
-	emailWorker := worker.NewEmailImportWorkerWithDefaults(entClient, oauthConfig, emailSyncService)
-	driveWorker := worker.NewDriveSyncWorkerWithDefaults(entClient, oauthConfig, driveSyncService)
+	// Decrypt stored OAuth tokens with the keys shared with the API server
+	keyring, err := cfg.Encryption.Keyring()
+	if err != nil {
+		fatal("failed to load token encryption keys", "error", err)
+	}
+	tokens := integration.NewTokenStore(entClient, keyring)
+
+	// Both sync services refresh tokens through one manager, so a
+	// connection's token is refreshed once however many syncs need it
+	tokenManager := integration.NewTokenManagerWithDefaults(entClient, oauthConfig, tokens)
+	emailSyncService.SetTokenManager(tokenManager)
+	driveSyncService.SetTokenManager(tokenManager)
+
+	// Keep downloaded attachment content on disk, stored once per SHA-256
+	if dir := cfg.AttachmentStorageDir; dir != "" {
+		blobs, err := storage.NewFileStore(dir)
+		if err != nil {
+			fatal("failed to open attachment storage", "error", err)
+		}
+		emailSyncService.SetBlobStore(blobs)
+	}
+
+	// Tax brackets and limits for new tax years are kept in the database,
+	// where /api/admin/tax-data saves them, alongside the built-in years
+	if err := appRetirement.DefaultTaxData().LoadDB(ctx, entClient); err != nil {
+		fatal("failed to load tax data", "error", err)
+	}
+
+	// Tasks are stored in the database-backed job queue, shared by every
+	// worker process, so queued work survives restarts
+	jobQueue := queue.New(entClient, cfg.Queue.Config())
+
+	// Events are queued as deliveries to users' webhook endpoints, and
+	// posted with retries by the webhook dispatcher below
+	webhookService := webhooks.NewService(entClient, keyring)
+
+	// Create workers with the configured pool sizes
+	emailWorker := worker.NewEmailImportWorker(entClient, oauthConfig, emailSyncService, jobQueue, cfg.EmailWorkerConfig())
+	driveWorker := worker.NewDriveSyncWorker(entClient, oauthConfig, driveSyncService, jobQueue, cfg.DriveWorkerConfig())
+	emailWorker.SetEventPublisher(webhookService)
+	driveWorker.SetEventPublisher(webhookService)
 
 	// Start workers
 	if err := emailWorker.Start(ctx); err != nil {
-		log.Fatalf("Failed to start email worker: %v", err)
+		fatal("failed to start email worker", "error", err)
+	}
+	slog.Info("email import worker started")
+
+	if resumed, err := emailWorker.QueueInterruptedSyncs(ctx); err != nil {
+		slog.Error("failed to queue interrupted email syncs", "error", err)
+	} else if resumed > 0 {
+		slog.Info("queued interrupted email syncs for resume", "count", resumed)
 	}
-	log.Println("Email import worker started")
 
 	if err := driveWorker.Start(ctx); err != nil {
-		log.Fatalf("Failed to start drive worker: %v", err)
+		fatal("failed to start drive worker", "error", err)
 	}
-	log.Println("Drive sync worker started")
+	slog.Info("drive sync worker started")
+
+	// Run the long-running analyses the API queues. The handlers use the
+	// same transaction repositories as the API's analysis routes.
+	transactionService := transactions.NewService(entClient)
+	analysisRouter := analysis.NewDefaultRouter()
+	analysisRouter.SetTransactionRepository(transactionService)
+	analysisRouter.SetStatementCycleRepository(transactionService)
+	jobService := jobs.NewServiceWithDefaults(entClient, jobQueue)
+	if err := analysisRouter.RegisterJobHandlers(jobService); err != nil {
+		fatal("failed to register analysis jobs", "error", err)
+	}
+	retirementRouter := retirement.NewDefaultRouter()
+	retirementRouter.SetIncomeRepository(transactionService)
+	if err := retirementRouter.RegisterJobHandlers(jobService); err != nil {
+		fatal("failed to register retirement jobs", "error", err)
+	}
+	if err := jobService.Start(ctx); err != nil {
+		fatal("failed to start job service", "error", err)
+	}
+
+	if err := jobQueue.Start(ctx); err != nil {
+		fatal("failed to start job queue", "error", err)
+	}
+	slog.Info("job queue started")
+
+	// Queue syncs of connections as their sync schedule comes due
+	schedulerConfig := worker.DefaultSyncSchedulerConfig()
+	schedulerConfig.CheckInterval = cfg.Intervals.SyncSchedule
+	syncScheduler := worker.NewSyncScheduler(entClient, emailWorker, driveWorker, schedulerConfig)
+	if err := syncScheduler.Start(ctx); err != nil {
+		fatal("failed to start sync scheduler", "error", err)
+	}
+	slog.Info("sync scheduler started")
+
+	// Record emergency fund coverage daily; funds that drop below the
+	// user's target are logged as alerts
+	fundService := emergencyfund.NewService(entClient, transactionService)
+	fundService.SetOnAlert(func(alert emergencyfund.Alert) {
+		slog.Warn("emergency fund below target",
+			"alert", true,
+			"user_id", alert.UserID,
+			"months_covered", alert.MonthsCovered,
+			"target_months", alert.TargetMonths,
+			"shortfall", alert.Shortfall,
+		)
+	})
+	fundConfig := worker.DefaultEmergencyFundMonitorConfig()
+	fundConfig.CheckInterval = cfg.Intervals.EmergencyFund
+	fundMonitor := worker.NewEmergencyFundMonitor(fundService, fundConfig)
+	if err := fundMonitor.Start(ctx); err != nil {
+		fatal("failed to start emergency fund monitor", "error", err)
+	}
+	slog.Info("emergency fund monitor started")
+
+	// Email goes through the configured provider, or is logged if there is
+	// none
+	mailSender := newMailSender(cfg.Mail)
+
+	// Evaluate spending for anomalies and budget overruns hourly, and
+	// deliver the alerts raised. Webhooks are posted like webhook
+	// deliveries, only to public addresses, and emails sent; push has no
+	// provider configured, so its deliveries are logged.
+	alertService := alerts.NewService(entClient, transactionService)
+	alertService.SetNotifier(alerts.ChannelWebhook, alerts.NewWebhookNotifier(webhooks.NewDeliveryClient(webhooks.DefaultDeliveryConfig().Timeout)))
+	alertService.SetNotifier(alerts.ChannelEmail, alerts.NewEmailNotifier(mailSender))
+	alertService.SetNotifier(alerts.ChannelPush, alerts.LogNotifier{Channel: alerts.ChannelPush})
+	alertService.SetEventPublisher(webhookService)
+	alertConfig := worker.DefaultAlertEngineConfig()
+	alertConfig.CheckInterval = cfg.Intervals.Alerts
+	alertEngine := worker.NewAlertEngine(alertService, alertConfig)
+	if err := alertEngine.Start(ctx); err != nil {
+		fatal("failed to start alert engine", "error", err)
+	}
+	slog.Info("alert engine started")
+
+	// Post webhook deliveries as they come due
+	dispatcherConfig := worker.DefaultWebhookDispatcherConfig()
+	dispatcherConfig.PollInterval = cfg.Intervals.Webhooks
+	webhookDispatcher := worker.NewWebhookDispatcher(webhookService, dispatcherConfig)
+	if err := webhookDispatcher.Start(ctx); err != nil {
+		fatal("failed to start webhook dispatcher", "error", err)
+	}
+	slog.Info("webhook dispatcher started")
+
+	// Email users whose connections expired, were revoked or keep failing
+	// to sync, and send weekly summaries and monthly reports
+	notificationConfig := notifications.DefaultConfig()
+	notificationConfig.SyncErrorThreshold = cfg.NotificationSyncErrorThreshold
+	notificationService := notifications.NewService(entClient, mailSender, notificationConfig)
+	notificationService.SetReportGenerator(reports.NewService(entClient, transactionService))
+	monitorConfig := worker.DefaultNotificationMonitorConfig()
+	monitorConfig.CheckInterval = cfg.Intervals.Notifications
+	notificationMonitor := worker.NewNotificationMonitor(notificationService, monitorConfig)
+	if err := notificationMonitor.Start(ctx); err != nil {
+		fatal("failed to start notification monitor", "error", err)
+	}
+	slog.Info("notification monitor started")
+
+	// Renew connection tokens before they expire, so syncs don't have to
+	refresherConfig := worker.DefaultTokenRefresherConfig()
+	refresherConfig.CheckInterval = cfg.Intervals.TokenRefresh
+	tokenRefresher := worker.NewTokenRefresher(tokenManager, refresherConfig)
+	if err := tokenRefresher.Start(ctx); err != nil {
+		fatal("failed to start token refresher", "error", err)
+	}
+	slog.Info("token refresher started")
 
 	// Create HTTP server for health checks
 	mux := http.NewServeMux()
@@ -75,32 +251,88 @@ func main() {
 
 		// Check if workers are running
 		status := "healthy"
-		if !emailWorker.IsRunning() || !driveWorker.IsRunning() {
+		if !emailWorker.IsRunning() || !driveWorker.IsRunning() || !jobQueue.IsRunning() || !syncScheduler.IsRunning() || !fundMonitor.IsRunning() || !alertEngine.IsRunning() || !webhookDispatcher.IsRunning() || !notificationMonitor.IsRunning() || !tokenRefresher.IsRunning() {
 			status = "unhealthy"
 			w.WriteHeader(http.StatusServiceUnavailable)
 		} else {
 			w.WriteHeader(http.StatusOK)
 		}
 
+		// Queue depth is best effort; a failed count is reported as -1
+		emailQueued, err := emailWorker.QueuedTaskCount(r.Context())
+		if err != nil {
+			emailQueued = -1
+		}
+		driveQueued, err := driveWorker.QueuedTaskCount(r.Context())
+		if err != nil {
+			driveQueued = -1
+		}
+
 		response := map[string]any{
 			"status":  status,
 			"service": "clockzen-worker",
 			"workers": map[string]any{
 				"email": map[string]any{
 					"running":      emailWorker.IsRunning(),
-					"queued_tasks": emailWorker.QueuedTaskCount(),
+					"paused":       emailWorker.IsPaused(),
+					"queued_tasks": emailQueued,
 					"ocr_queued":   emailWorker.QueuedOCRTaskCount(),
 				},
 				"drive": map[string]any{
 					"running":      driveWorker.IsRunning(),
-					"queued_tasks": driveWorker.QueuedTaskCount(),
+					"paused":       driveWorker.IsPaused(),
+					"queued_tasks": driveQueued,
 					"ocr_queued":   driveWorker.QueuedOCRTaskCount(),
 				},
+				"scheduler": map[string]any{
+					"running": syncScheduler.IsRunning(),
+					"paused":  syncScheduler.IsPaused(),
+				},
+				"emergency_fund": map[string]any{
+					"running": fundMonitor.IsRunning(),
+					"paused":  fundMonitor.IsPaused(),
+				},
+				"alerts": map[string]any{
+					"running": alertEngine.IsRunning(),
+					"paused":  alertEngine.IsPaused(),
+				},
+				"webhooks": map[string]any{
+					"running": webhookDispatcher.IsRunning(),
+					"paused":  webhookDispatcher.IsPaused(),
+				},
+				"notifications": map[string]any{
+					"running": notificationMonitor.IsRunning(),
+					"paused":  notificationMonitor.IsPaused(),
+				},
+				"tokens": map[string]any{
+					"running": tokenRefresher.IsRunning(),
+					"paused":  tokenRefresher.IsPaused(),
+				},
 			},
 		}
 		json.NewEncoder(w).Encode(response)
 	})
 
+	// Admin endpoints to pause and resume workers, trigger syncs, inspect
+	// the task queues and drain before a deploy, enabled by
+	// WORKER_ADMIN_TOKEN
+	if token := cfg.AdminToken.Reveal(); token != "" {
+		adminRouter := workers.NewDefaultRouter(token)
+		adminHandler := adminRouter.GetAdminHandler()
+		adminHandler.SetWorker("email", emailWorker)
+		adminHandler.SetWorker("drive", driveWorker)
+		adminHandler.SetWorker("scheduler", syncScheduler)
+		adminHandler.SetWorker("emergency_fund", fundMonitor)
+		adminHandler.SetWorker("alerts", alertEngine)
+		adminHandler.SetWorker("webhooks", webhookDispatcher)
+		adminHandler.SetWorker("notifications", notificationMonitor)
+		adminHandler.SetWorker("tokens", tokenRefresher)
+		adminHandler.SetSyncTrigger(syncScheduler)
+		adminHandler.SetJobQueue(jobQueue)
+		adminRouter.RegisterRoutes(mux)
+		slog.Info("worker admin endpoints enabled")
+	}
+
 	server := &http.Server{
 		Addr:         ":" + port,
 		Handler:      mux,
@@ -111,9 +343,9 @@ func main() {
 
 	// Start health check server in goroutine
 	go func() {
-		log.Printf("Starting worker health check server on port %s", port)
+		slog.Info("starting worker health check server", "port", port)
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("Failed to start health check server: %v", err)
+			fatal("failed to start health check server", "error", err)
 		}
 	}()
 
@@ -122,14 +354,38 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	log.Println("Shutting down worker...")
+	slog.Info("shutting down worker")
+
+	// Stop scheduling syncs, then stop taking jobs; jobs interrupted here go
+	// back to pending for the next worker to pick up
+	if err := syncScheduler.Stop(); err != nil {
+		slog.Error("stopping sync scheduler", "error", err)
+	}
+	if err := fundMonitor.Stop(); err != nil {
+		slog.Error("stopping emergency fund monitor", "error", err)
+	}
+	if err := alertEngine.Stop(); err != nil {
+		slog.Error("stopping alert engine", "error", err)
+	}
+	if err := webhookDispatcher.Stop(); err != nil {
+		slog.Error("stopping webhook dispatcher", "error", err)
+	}
+	if err := notificationMonitor.Stop(); err != nil {
+		slog.Error("stopping notification monitor", "error", err)
+	}
+	if err := tokenRefresher.Stop(); err != nil {
+		slog.Error("stopping token refresher", "error", err)
+	}
+	if err := jobQueue.Stop(); err != nil {
+		slog.Error("stopping job queue", "error", err)
+	}
 
 	// Stop workers gracefully
 	if err := emailWorker.Stop(); err != nil {
-		log.Printf("Error stopping email worker: %v", err)
+		slog.Error("stopping email worker", "error", err)
 	}
 	if err := driveWorker.Stop(); err != nil {
-		log.Printf("Error stopping drive worker: %v", err)
+		slog.Error("stopping drive worker", "error", err)
 	}
 
 	// Shutdown health check server
@@ -137,16 +393,94 @@ func main() {
 	defer cancel()
 
 	if err := server.Shutdown(shutdownCtx); err != nil {
-		log.Fatalf("Server forced to shutdown: %v", err)
+		fatal("server forced to shutdown", "error", err)
 	}
 
-	log.Println("Worker exited gracefully")
+	// Flush spans still buffered for export
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		slog.Error("flushing traces", "error", err)
+	}
+
+	slog.Info("worker exited gracefully")
 }
 
-// getEnv returns the value of an environment variable or a default value
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+// newLogger creates the process logger from the logging configuration,
+// exiting if it is invalid
+func newLogger(service string, settings config.Logging) *slog.Logger {
+	loggingConfig, err := settings.Config(service)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid logging configuration: %v\n", err)
+		os.Exit(1)
 	}
-	return defaultValue
+	logger, err := logging.New(os.Stderr, loggingConfig)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid logging configuration: %v\n", err)
+		os.Exit(1)
+	}
+	return logger
+}
+
+// setupTracing configures tracing. Spans are only exported when an
+// endpoint is set.
+func setupTracing(tracingConfig tracing.Config) func(context.Context) error {
+	shutdown, err := tracing.Setup(context.Background(), tracingConfig)
+	if err != nil {
+		fatal("failed to configure tracing", "error", err)
+	}
+	return shutdown
+}
+
+// newMailSender creates the email sender of the configured provider: "smtp"
+// sends through an SMTP server and "sendgrid" through the SendGrid API.
+// Without a provider, emails are logged. The provider's settings were
+// checked when the configuration was loaded.
+func newMailSender(settings config.Mail) mail.Sender {
+	switch settings.Provider {
+	case config.MailProviderSMTP:
+		return mail.NewSMTPSender(mail.SMTPConfig{
+			Host:     settings.SMTPHost,
+			Port:     settings.SMTPPort,
+			Username: settings.SMTPUsername,
+			Password: settings.SMTPPassword.Reveal(),
+			From:     settings.From,
+		})
+	case config.MailProviderSendGrid:
+		return mail.NewSendGridSender(mail.SendGridConfig{
+			APIKey: settings.SendGridAPIKey.Reveal(),
+			From:   settings.From,
+		}, nil)
+	default:
+		slog.Info("no mail provider configured, emails will be logged")
+		return mail.LogSender{}
+	}
+}
+
+// migrateSchema applies pending schema migrations when autoMigrate is set,
+// and otherwise warns if the database is behind the binary
+func migrateSchema(ctx context.Context, db *sql.DB, autoMigrate bool) error {
+	migrator, err := dbmigrate.NewWithDefaults(db)
+	if err != nil {
+		return err
+	}
+	if !autoMigrate {
+		pending, err := migrator.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		if len(pending) > 0 {
+			slog.Warn("database schema has pending migrations; run migratedb up", "pending", len(pending))
+		}
+		return nil
+	}
+	applied, err := migrator.Up(ctx, 0)
+	for _, m := range applied {
+		slog.Info("applied database migration", "version", m.Version, "name", m.Name)
+	}
+	return err
+}
+
+// fatal logs an error and exits
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
 }
@@ -0,0 +1,384 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/application/webhooks"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/alert"
+	"clockzen-next/internal/ent/alertpreference"
+	"clockzen-next/internal/infrastructure/mail"
+)
+
+// Channel is a way of delivering alerts to a user
+type Channel string
+
+const (
+	ChannelEmail   Channel = "email"
+	ChannelWebhook Channel = "webhook"
+	ChannelPush    Channel = "push"
+)
+
+// Delivery is what one notification carries: a single alert, or a digest
+// of the alerts raised since the last one
+type Delivery struct {
+	UserID string
+	Digest bool
+	Alerts []*ent.Alert
+}
+
+// Notifier delivers alerts over a channel to a recipient: an email
+// address, webhook URL or device token
+type Notifier interface {
+	Notify(ctx context.Context, recipient string, delivery Delivery) error
+}
+
+// severityRank orders severities from least to most severe
+func severityRank(severity alert.Severity) int {
+	switch severity {
+	case alert.SeverityHigh:
+		return 3
+	case alert.SeverityMedium:
+		return 2
+	case alert.SeverityLow:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// digestDue reports whether a digest is due at now: alerts delivered as
+// they are raised are always due, and digests once their period has passed
+// since the last
+func digestDue(preferences Preferences, now time.Time) bool {
+	if preferences.Digest == alertpreference.DigestImmediate || preferences.LastDigestAt == nil {
+		return true
+	}
+	period := 24 * time.Hour
+	if preferences.Digest == alertpreference.DigestHourly {
+		period = time.Hour
+	}
+	return !now.Before(preferences.LastDigestAt.Add(period))
+}
+
+// recipients returns the user's recipient on each channel they've set up
+func (p Preferences) recipients() map[Channel]string {
+	recipients := make(map[Channel]string)
+	if p.Email != nil {
+		recipients[ChannelEmail] = *p.Email
+	}
+	if p.WebhookURL != nil {
+		recipients[ChannelWebhook] = *p.WebhookURL
+	}
+	if p.PushToken != nil {
+		recipients[ChannelPush] = *p.PushToken
+	}
+	return recipients
+}
+
+// Deliver sends the user's pending alerts to their channels, one by one or
+// as a digest once one is due, and returns how many were delivered. Alerts
+// below the user's minimum severity, or with no channel to go to, are
+// skipped; they are still listed. An alert counts as delivered if any
+// channel took it; if none did, the error is kept and it is retried on the
+// next run.
+func (s *Service) Deliver(ctx context.Context, userID string, now time.Time) (int, error) {
+	pending, err := s.entClient.Alert.Query().
+		Where(alert.UserID(userID), alert.DeliveryStatusEQ(alert.DeliveryStatusPending)).
+		Order(ent.Asc(alert.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("querying pending alerts: %w", err)
+	}
+	if len(pending) == 0 {
+		return 0, nil
+	}
+
+	preferences, err := s.GetPreferences(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	notifiers := s.channelNotifiers(preferences.recipients())
+
+	var skipped []string
+	var alerts []*ent.Alert
+	for _, record := range pending {
+		if len(notifiers) == 0 || severityRank(record.Severity) < severityRank(preferences.MinSeverity) {
+			skipped = append(skipped, record.ID)
+			continue
+		}
+		alerts = append(alerts, record)
+	}
+	if len(skipped) > 0 {
+		err := s.entClient.Alert.Update().
+			Where(alert.IDIn(skipped...)).
+			SetDeliveryStatus(alert.DeliveryStatusSkipped).
+			ClearDeliveryError().
+			Exec(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("skipping alerts: %w", err)
+		}
+	}
+	if len(alerts) == 0 || !digestDue(*preferences, now) {
+		return 0, nil
+	}
+
+	var deliveries []Delivery
+	if preferences.Digest == alertpreference.DigestImmediate {
+		for _, record := range alerts {
+			deliveries = append(deliveries, Delivery{UserID: userID, Alerts: []*ent.Alert{record}})
+		}
+	} else {
+		deliveries = []Delivery{{UserID: userID, Digest: true, Alerts: alerts}}
+	}
+
+	delivered := 0
+	for _, delivery := range deliveries {
+		ids := make([]string, len(delivery.Alerts))
+		for i, record := range delivery.Alerts {
+			ids[i] = record.ID
+		}
+
+		update := s.entClient.Alert.Update().Where(alert.IDIn(ids...))
+		if err := notify(ctx, notifiers, delivery); err != nil {
+			update.SetDeliveryError(err.Error())
+		} else {
+			update.SetDeliveryStatus(alert.DeliveryStatusDelivered).
+				SetDeliveredAt(now).
+				ClearDeliveryError()
+			delivered += len(ids)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return delivered, fmt.Errorf("updating alerts: %w", err)
+		}
+	}
+
+	if preferences.Digest != alertpreference.DigestImmediate && delivered > 0 {
+		err := s.entClient.AlertPreference.Update().
+			Where(alertpreference.UserID(userID)).
+			SetLastDigestAt(now).
+			Exec(ctx)
+		if err != nil {
+			return delivered, fmt.Errorf("updating last digest: %w", err)
+		}
+	}
+	return delivered, nil
+}
+
+// DeliverAll delivers the pending alerts of every user who has any, and
+// returns how many were delivered. Failures are logged, so one user doesn't
+// hold up the others.
+func (s *Service) DeliverAll(ctx context.Context, now time.Time) int {
+	userIDs, err := s.entClient.Alert.Query().
+		Where(alert.DeliveryStatusEQ(alert.DeliveryStatusPending)).
+		GroupBy(alert.FieldUserID).
+		Strings(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "querying users with pending alerts", "error", err)
+		return 0
+	}
+
+	delivered := 0
+	for _, userID := range userIDs {
+		if ctx.Err() != nil {
+			break
+		}
+		count, err := s.Deliver(ctx, userID, now)
+		delivered += count
+		if err != nil {
+			slog.ErrorContext(ctx, "delivering alerts", "user_id", userID, "error", err)
+		}
+	}
+	return delivered
+}
+
+// channelNotifier is a notifier and the recipient it delivers to
+type channelNotifier struct {
+	channel   Channel
+	recipient string
+	notifier  Notifier
+}
+
+// channelNotifiers returns the notifiers for the user's recipients, on the
+// channels that have one
+func (s *Service) channelNotifiers(recipients map[Channel]string) []channelNotifier {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var notifiers []channelNotifier
+	for _, channel := range []Channel{ChannelEmail, ChannelWebhook, ChannelPush} {
+		recipient, ok := recipients[channel]
+		if !ok {
+			continue
+		}
+		if notifier, ok := s.notifiers[channel]; ok {
+			notifiers = append(notifiers, channelNotifier{channel: channel, recipient: recipient, notifier: notifier})
+		}
+	}
+	return notifiers
+}
+
+// notify sends a delivery over each channel. It fails only if every
+// channel failed.
+func notify(ctx context.Context, notifiers []channelNotifier, delivery Delivery) error {
+	var errs []string
+	for _, n := range notifiers {
+		if err := n.notifier.Notify(ctx, n.recipient, delivery); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", n.channel, err))
+		}
+	}
+	if len(errs) == len(notifiers) && len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// AlertPayload is an alert as notifiers send it
+type AlertPayload struct {
+	ID        string                 `json:"id"`
+	Kind      string                 `json:"kind"`
+	Severity  string                 `json:"severity"`
+	Title     string                 `json:"title"`
+	Message   string                 `json:"message"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+// DeliveryPayload is a delivery as notifiers send it
+type DeliveryPayload struct {
+	UserID string         `json:"user_id"`
+	Digest bool           `json:"digest"`
+	Alerts []AlertPayload `json:"alerts"`
+}
+
+// Payload returns the delivery as notifiers send it
+func (d Delivery) Payload() DeliveryPayload {
+	payload := DeliveryPayload{
+		UserID: d.UserID,
+		Digest: d.Digest,
+		Alerts: make([]AlertPayload, len(d.Alerts)),
+	}
+	for i, record := range d.Alerts {
+		payload.Alerts[i] = AlertPayload{
+			ID:        record.ID,
+			Kind:      string(record.Kind),
+			Severity:  string(record.Severity),
+			Title:     record.Title,
+			Message:   record.Message,
+			Data:      record.Data,
+			CreatedAt: record.CreatedAt,
+		}
+	}
+	return payload
+}
+
+// WebhookNotifier posts deliveries as JSON to the user's webhook URL
+type WebhookNotifier struct {
+	client *http.Client
+}
+
+// NewWebhookNotifier creates a webhook notifier that posts with client, or
+// if it is nil with the webhook delivery client, which times out after 10
+// seconds and only posts to public addresses without following redirects
+func NewWebhookNotifier(client *http.Client) *WebhookNotifier {
+	if client == nil {
+		client = webhooks.NewDeliveryClient(10 * time.Second)
+	}
+	return &WebhookNotifier{client: client}
+}
+
+// Notify posts the delivery to the webhook URL; any status but 2xx fails
+func (n *WebhookNotifier) Notify(ctx context.Context, recipient string, delivery Delivery) error {
+	body, err := json.Marshal(delivery.Payload())
+	if err != nil {
+		return fmt.Errorf("encoding delivery: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, recipient, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("posting webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return nil
+}
+
+// EmailNotifier emails deliveries to the user's email address
+type EmailNotifier struct {
+	sender mail.Sender
+}
+
+// NewEmailNotifier creates an email notifier that sends with sender
+func NewEmailNotifier(sender mail.Sender) *EmailNotifier {
+	return &EmailNotifier{sender: sender}
+}
+
+// Notify emails the delivery: a single alert under its title, or a digest
+// listing each alert
+func (n *EmailNotifier) Notify(ctx context.Context, recipient string, delivery Delivery) error {
+	if len(delivery.Alerts) == 0 {
+		return nil
+	}
+	return n.sender.Send(ctx, emailMessage(recipient, delivery))
+}
+
+// emailMessage formats a delivery as an email
+func emailMessage(recipient string, delivery Delivery) mail.Message {
+	subject := delivery.Alerts[0].Title
+	if len(delivery.Alerts) > 1 {
+		subject = fmt.Sprintf("%d new spending alerts", len(delivery.Alerts))
+	}
+	var text strings.Builder
+	for i, record := range delivery.Alerts {
+		if i > 0 {
+			text.WriteString("\n")
+		}
+		fmt.Fprintf(&text, "%s (%s)\n%s\n", record.Title, record.Severity, record.Message)
+	}
+	return mail.Message{To: recipient, Subject: subject, Text: text.String()}
+}
+
+// LogNotifier logs deliveries as notifications. It stands in for a channel
+// whose provider isn't configured, as the API and worker log their other
+// notifications.
+type LogNotifier struct {
+	Channel Channel
+	Logger  *slog.Logger
+}
+
+// Notify logs each alert in the delivery
+func (n LogNotifier) Notify(ctx context.Context, recipient string, delivery Delivery) error {
+	logger := n.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	for _, record := range delivery.Alerts {
+		logger.InfoContext(ctx, "alert delivered",
+			"notification", true,
+			"channel", string(n.Channel),
+			"recipient", recipient,
+			"user_id", delivery.UserID,
+			"digest", delivery.Digest,
+			"alert_id", record.ID,
+			"kind", string(record.Kind),
+			"severity", string(record.Severity),
+			"title", record.Title,
+		)
+	}
+	return nil
+}
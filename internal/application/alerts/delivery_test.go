@@ -0,0 +1,120 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/application/webhooks"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/alert"
+	"clockzen-next/internal/ent/alertpreference"
+)
+
+// stubNotifier records deliveries, or fails them
+type stubNotifier struct {
+	err        error
+	deliveries []Delivery
+}
+
+func (n *stubNotifier) Notify(ctx context.Context, recipient string, delivery Delivery) error {
+	n.deliveries = append(n.deliveries, delivery)
+	return n.err
+}
+
+func TestDigestDue(t *testing.T) {
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	last := now.Add(-2 * time.Hour)
+
+	tests := []struct {
+		name   string
+		digest alertpreference.Digest
+		last   *time.Time
+		want   bool
+	}{
+		{name: "immediate", digest: alertpreference.DigestImmediate, last: &last, want: true},
+		{name: "first digest", digest: alertpreference.DigestDaily, want: true},
+		{name: "hourly after an hour", digest: alertpreference.DigestHourly, last: &last, want: true},
+		{name: "daily within the day", digest: alertpreference.DigestDaily, last: &last, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, digestDue(Preferences{Digest: tt.digest, LastDigestAt: tt.last}, now))
+		})
+	}
+}
+
+func TestNotify(t *testing.T) {
+	delivery := Delivery{UserID: "u1", Alerts: []*ent.Alert{{ID: "a1"}}}
+	failing := &stubNotifier{err: errors.New("unreachable")}
+	working := &stubNotifier{}
+
+	// Delivered if any channel takes it
+	err := notify(context.Background(), []channelNotifier{
+		{channel: ChannelWebhook, notifier: failing},
+		{channel: ChannelEmail, notifier: working},
+	}, delivery)
+	require.NoError(t, err)
+	assert.Len(t, working.deliveries, 1)
+
+	err = notify(context.Background(), []channelNotifier{{channel: ChannelWebhook, notifier: failing}}, delivery)
+	assert.EqualError(t, err, "webhook: unreachable")
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	var received DeliveryPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		if received.Digest {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer server.Close()
+
+	notifier := NewWebhookNotifier(server.Client())
+	delivery := Delivery{
+		UserID: "u1",
+		Alerts: []*ent.Alert{{ID: "a1", Kind: alert.KindBudgetOverrun, Severity: alert.SeverityHigh, Title: "Over your dining budget"}},
+	}
+	require.NoError(t, notifier.Notify(context.Background(), server.URL, delivery))
+	assert.Equal(t, "u1", received.UserID)
+	require.Len(t, received.Alerts, 1)
+	assert.Equal(t, "budget_overrun", received.Alerts[0].Kind)
+
+	delivery.Digest = true
+	assert.Error(t, notifier.Notify(context.Background(), server.URL, delivery))
+
+	// By default the notifier doesn't post to the test server's loopback
+	// address
+	err := NewWebhookNotifier(nil).Notify(context.Background(), server.URL, delivery)
+	assert.ErrorIs(t, err, webhooks.ErrBlockedAddress)
+}
+
+func TestEmailMessage(t *testing.T) {
+	single := Delivery{
+		UserID: "u1",
+		Alerts: []*ent.Alert{{ID: "a1", Severity: alert.SeverityHigh, Title: "Over your dining budget", Message: "You've spent $250.00"}},
+	}
+	message := emailMessage("me@example.com", single)
+	assert.Equal(t, "me@example.com", message.To)
+	assert.Equal(t, "Over your dining budget", message.Subject)
+	assert.Equal(t, "Over your dining budget (high)\nYou've spent $250.00\n", message.Text)
+
+	digest := Delivery{
+		UserID: "u1",
+		Digest: true,
+		Alerts: []*ent.Alert{{ID: "a1", Title: "One"}, {ID: "a2", Title: "Two"}},
+	}
+	message = emailMessage("me@example.com", digest)
+	assert.Equal(t, "2 new spending alerts", message.Subject)
+	assert.Contains(t, message.Text, "One")
+	assert.Contains(t, message.Text, "Two")
+}
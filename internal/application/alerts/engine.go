@@ -0,0 +1,277 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/application/webhooks"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/alert"
+	"clockzen-next/internal/ent/transaction"
+
+	"github.com/google/uuid"
+)
+
+// AnomalyLookbackDays is how far back each evaluation looks for anomalies.
+// Anomalies already alerted are not raised again, so the window only needs
+// to cover the time between evaluations with room for late transactions.
+const AnomalyLookbackDays = 7
+
+// anomalyTitles are the titles of anomaly alerts by anomaly type
+var anomalyTitles = map[analysis.AnomalyType]string{
+	analysis.AnomalyUnusuallyHigh:    "Unusually high spending",
+	analysis.AnomalyUnusuallyLow:     "Unusually low spending",
+	analysis.AnomalyNewCategory:      "Spending in a new category",
+	analysis.AnomalyNewMerchant:      "Spending at a new merchant",
+	analysis.AnomalyUnusualTime:      "Spending at an unusual time",
+	analysis.AnomalyDuplicateCharge:  "Possible duplicate charge",
+	analysis.AnomalyLargeTransaction: "Large transaction",
+}
+
+// finding is something found worth alerting a user about
+type finding struct {
+	Kind      alert.Kind
+	Severity  alert.Severity
+	Title     string
+	Message   string
+	DedupeKey string
+	Data      map[string]interface{}
+}
+
+// anomalyFindings turns detected anomalies into findings, one per anomaly
+func anomalyFindings(anomalies []analysis.SpendingAnomaly) []finding {
+	findings := make([]finding, 0, len(anomalies))
+	for _, a := range anomalies {
+		title, ok := anomalyTitles[a.Type]
+		if !ok {
+			title = "Unusual spending"
+		}
+		data := map[string]interface{}{
+			"anomaly_type":     string(a.Type),
+			"amount":           a.Amount,
+			"transaction_date": a.TransactionDate,
+		}
+		if a.Category != "" {
+			data["category"] = string(a.Category)
+		}
+		if a.MerchantName != "" {
+			data["merchant_name"] = a.MerchantName
+		}
+		if a.TransactionID != "" {
+			data["transaction_id"] = a.TransactionID
+		}
+		if a.ExpectedAmount != 0 {
+			data["expected_amount"] = a.ExpectedAmount
+		}
+		findings = append(findings, finding{
+			Kind:      alert.KindAnomaly,
+			Severity:  alert.Severity(a.Severity),
+			Title:     title,
+			Message:   a.Description,
+			DedupeKey: "anomaly:" + a.ID,
+			Data:      data,
+		})
+	}
+	return findings
+}
+
+// budgetFindings compares the month's spending by category against the
+// user's limits. A category over its limit raises an overrun; one past the
+// threshold percent of it, a warning. Each is raised once per category and
+// month.
+func budgetFindings(transactions []analysis.Transaction, limits map[string]float64, thresholdPercent float64, now time.Time) []finding {
+	spent := make(map[string]float64)
+	for _, t := range transactions {
+		spent[strings.ToLower(string(t.Category))] += t.Amount
+	}
+
+	categories := make([]string, 0, len(limits))
+	for category := range limits {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+
+	month := now.Format("2006-01")
+	var findings []finding
+	for _, category := range categories {
+		limit := limits[category]
+		amount := math.Round(spent[category]*100) / 100
+		percent := math.Round(amount/limit*1000) / 10
+		data := map[string]interface{}{
+			"category": category,
+			"month":    month,
+			"spent":    amount,
+			"limit":    limit,
+			"percent":  percent,
+		}
+
+		switch {
+		case amount > limit:
+			findings = append(findings, finding{
+				Kind:      alert.KindBudgetOverrun,
+				Severity:  alert.SeverityHigh,
+				Title:     fmt.Sprintf("Over your %s budget", category),
+				Message:   fmt.Sprintf("You've spent $%.2f on %s this month, $%.2f over your $%.2f limit.", amount, category, amount-limit, limit),
+				DedupeKey: fmt.Sprintf("budget:%s:%s:100", category, month),
+				Data:      data,
+			})
+		case thresholdPercent < 100 && amount >= limit*thresholdPercent/100:
+			findings = append(findings, finding{
+				Kind:      alert.KindBudgetOverrun,
+				Severity:  alert.SeverityMedium,
+				Title:     fmt.Sprintf("Nearing your %s budget", category),
+				Message:   fmt.Sprintf("You've spent $%.2f on %s this month, %.0f%% of your $%.2f limit.", amount, category, percent, limit),
+				DedupeKey: fmt.Sprintf("budget:%s:%s:%g", category, month, thresholdPercent),
+				Data:      data,
+			})
+		}
+	}
+	return findings
+}
+
+// monthStart returns the start of the month containing t
+func monthStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+// Evaluate checks the user's recent spending for anomalies and their
+// spending this month against their budget limits, as their preferences
+// ask, and stores an alert for each finding not alerted before. It returns
+// the alerts raised; they are delivered by Deliver.
+func (s *Service) Evaluate(ctx context.Context, userID string, now time.Time) ([]*ent.Alert, error) {
+	preferences, err := s.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []finding
+	if preferences.AnomalyAlerts {
+		result, err := s.spending.DetectAnomalies(ctx, userID, now.AddDate(0, 0, -AnomalyLookbackDays), now)
+		if err != nil {
+			return nil, fmt.Errorf("detecting anomalies: %w", err)
+		}
+		findings = append(findings, anomalyFindings(result.Anomalies)...)
+	}
+	if preferences.BudgetAlerts && len(preferences.BudgetLimits) > 0 {
+		transactions, err := s.transactions.GetByUserID(ctx, userID, monthStart(now), now)
+		if err != nil {
+			return nil, fmt.Errorf("querying spending: %w", err)
+		}
+		findings = append(findings, budgetFindings(transactions, preferences.BudgetLimits, preferences.BudgetThresholdPercent, now)...)
+	}
+	if len(findings) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, len(findings))
+	for i, f := range findings {
+		keys[i] = f.DedupeKey
+	}
+	alerted, err := s.entClient.Alert.Query().
+		Where(alert.UserID(userID), alert.DedupeKeyIn(keys...)).
+		Select(alert.FieldDedupeKey).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying alerts: %w", err)
+	}
+	seen := make(map[string]bool, len(alerted))
+	for _, key := range alerted {
+		seen[key] = true
+	}
+
+	var raised []*ent.Alert
+	for _, f := range findings {
+		if seen[f.DedupeKey] {
+			continue
+		}
+		seen[f.DedupeKey] = true
+
+		record, err := s.entClient.Alert.Create().
+			SetID(uuid.New().String()).
+			SetUserID(userID).
+			SetKind(f.Kind).
+			SetSeverity(f.Severity).
+			SetTitle(f.Title).
+			SetMessage(f.Message).
+			SetDedupeKey(f.DedupeKey).
+			SetData(f.Data).
+			SetCreatedAt(now).
+			Save(ctx)
+		if err != nil {
+			// Raised by a concurrent evaluation
+			if ent.IsConstraintError(err) {
+				continue
+			}
+			return raised, fmt.Errorf("saving alert: %w", err)
+		}
+		raised = append(raised, record)
+		s.publishRaised(ctx, record)
+	}
+	return raised, nil
+}
+
+// publishRaised publishes an event for a raised alert: anomaly.detected
+// for anomalies, and budget.exceeded for spending over a limit. Warnings
+// of spending nearing a limit aren't published. Failures are logged; the
+// alert is still delivered to the user's channels.
+func (s *Service) publishRaised(ctx context.Context, record *ent.Alert) {
+	s.mu.RLock()
+	publisher := s.publisher
+	s.mu.RUnlock()
+	if publisher == nil {
+		return
+	}
+
+	var event webhooks.EventType
+	switch {
+	case record.Kind == alert.KindAnomaly:
+		event = webhooks.EventAnomalyDetected
+	case record.Kind == alert.KindBudgetOverrun && record.Severity == alert.SeverityHigh:
+		event = webhooks.EventBudgetExceeded
+	default:
+		return
+	}
+
+	payload := Delivery{UserID: record.UserID, Alerts: []*ent.Alert{record}}.Payload()
+	if err := publisher.Publish(ctx, record.UserID, event, payload.Alerts[0]); err != nil {
+		slog.ErrorContext(ctx, "publishing alert event", "alert_id", record.ID, "event", string(event), "error", err)
+	}
+}
+
+// EvaluateAll evaluates every user with spending since the start of the
+// anomaly window or of the month, whichever is earlier, and returns how
+// many alerts were raised. Failures are logged, so one user doesn't hold up
+// the others.
+func (s *Service) EvaluateAll(ctx context.Context, now time.Time) int {
+	since := monthStart(now)
+	if lookback := now.AddDate(0, 0, -AnomalyLookbackDays); lookback.Before(since) {
+		since = lookback
+	}
+	userIDs, err := s.entClient.Transaction.Query().
+		Where(transaction.TransactionDateGTE(since)).
+		GroupBy(transaction.FieldUserID).
+		Strings(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "querying users with recent spending", "error", err)
+		return 0
+	}
+
+	raised := 0
+	for _, userID := range userIDs {
+		if ctx.Err() != nil {
+			break
+		}
+		alerts, err := s.Evaluate(ctx, userID, now)
+		raised += len(alerts)
+		if err != nil {
+			slog.ErrorContext(ctx, "evaluating alerts", "user_id", userID, "error", err)
+		}
+	}
+	return raised
+}
@@ -0,0 +1,69 @@
+package alerts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/ent/alert"
+)
+
+func TestAnomalyFindings(t *testing.T) {
+	date := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	findings := anomalyFindings([]analysis.SpendingAnomaly{
+		{
+			ID:              "t1-duplicate_charge",
+			Type:            analysis.AnomalyDuplicateCharge,
+			Severity:        analysis.SeverityHigh,
+			MerchantName:    "Corner Cafe",
+			Amount:          12.5,
+			TransactionID:   "t1",
+			TransactionDate: date,
+			Description:     "Possible duplicate charge of $12.50 at Corner Cafe",
+		},
+		{ID: "t2-unusually_low", Type: analysis.AnomalyUnusuallyLow, Severity: analysis.SeverityLow},
+	})
+
+	require.Len(t, findings, 2)
+	assert.Equal(t, alert.KindAnomaly, findings[0].Kind)
+	assert.Equal(t, alert.SeverityHigh, findings[0].Severity)
+	assert.Equal(t, "Possible duplicate charge", findings[0].Title)
+	assert.Equal(t, "anomaly:t1-duplicate_charge", findings[0].DedupeKey)
+	assert.Equal(t, "t1", findings[0].Data["transaction_id"])
+	assert.Equal(t, "Corner Cafe", findings[0].Data["merchant_name"])
+	assert.NotContains(t, findings[1].Data, "merchant_name")
+	assert.Equal(t, alert.SeverityLow, findings[1].Severity)
+}
+
+func TestBudgetFindings(t *testing.T) {
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	transactions := []analysis.Transaction{
+		{Category: analysis.CategoryDining, Amount: 180},
+		{Category: analysis.CategoryDining, Amount: 150},
+		{Category: analysis.CategoryGroceries, Amount: 250},
+		{Category: analysis.CategoryTravel, Amount: 20},
+	}
+	limits := map[string]float64{"dining": 300, "groceries": 300, "travel": 500}
+
+	findings := budgetFindings(transactions, limits, 80, now)
+	require.Len(t, findings, 2)
+
+	assert.Equal(t, alert.KindBudgetOverrun, findings[0].Kind)
+	assert.Equal(t, alert.SeverityHigh, findings[0].Severity)
+	assert.Equal(t, "Over your dining budget", findings[0].Title)
+	assert.Equal(t, "budget:dining:2026-03:100", findings[0].DedupeKey)
+	assert.Equal(t, 330.0, findings[0].Data["spent"])
+	assert.Equal(t, 110.0, findings[0].Data["percent"])
+
+	assert.Equal(t, alert.SeverityMedium, findings[1].Severity)
+	assert.Equal(t, "Nearing your groceries budget", findings[1].Title)
+	assert.Equal(t, "budget:groceries:2026-03:80", findings[1].DedupeKey)
+
+	// At 100 only overruns are alerted
+	findings = budgetFindings(transactions, limits, 100, now)
+	require.Len(t, findings, 1)
+	assert.Equal(t, "budget:dining:2026-03:100", findings[0].DedupeKey)
+}
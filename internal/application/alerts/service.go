@@ -0,0 +1,379 @@
+// Package alerts raises alerts about a user's spending on a schedule, rather
+// than only when they ask for an analysis: anomalies in their recent
+// spending, and spending that nears or goes over their monthly budget
+// limits. Alerts are stored, so each finding is alerted once, and delivered
+// to the channels in the user's preferences, either as they are raised or
+// batched into an hourly or daily digest.
+package alerts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/application/webhooks"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/alert"
+	"clockzen-next/internal/ent/alertpreference"
+
+	"github.com/google/uuid"
+)
+
+// Errors returned by the alert service
+var (
+	ErrAlertNotFound      = errors.New("alert not found")
+	ErrInvalidPreferences = errors.New("invalid alert preferences")
+	ErrInvalidKind        = errors.New("kind must be one of: anomaly, budget_overrun")
+)
+
+// Defaults for alert lists
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 500
+)
+
+// Preferences are what a user wants to be alerted about, and how
+type Preferences struct {
+	AnomalyAlerts bool
+	BudgetAlerts  bool
+	// BudgetLimits are monthly spending limits by spending category
+	BudgetLimits map[string]float64
+	// BudgetThresholdPercent is the share of a limit spent that raises a
+	// warning; 100 only alerts overruns
+	BudgetThresholdPercent float64
+	MinSeverity            alert.Severity
+	Email                  *string
+	WebhookURL             *string
+	PushToken              *string
+	Digest                 alertpreference.Digest
+	// WeeklySummary is whether the user is emailed a summary of their
+	// week's syncs and spending
+	WeeklySummary bool
+	// MonthlyReport is whether the user is emailed their monthly spending
+	// report
+	MonthlyReport bool
+	LastDigestAt  *time.Time
+}
+
+// DefaultPreferences are the preferences of a user who hasn't set any:
+// every alert is raised and listed, but there is nowhere to deliver them
+func DefaultPreferences() Preferences {
+	return Preferences{
+		AnomalyAlerts:          true,
+		BudgetAlerts:           true,
+		BudgetLimits:           map[string]float64{},
+		BudgetThresholdPercent: alertpreference.DefaultBudgetThresholdPercent,
+		MinSeverity:            alert.Severity(alertpreference.DefaultMinSeverity),
+		Digest:                 alertpreference.DefaultDigest,
+		WeeklySummary:          alertpreference.DefaultWeeklySummary,
+		MonthlyReport:          alertpreference.DefaultMonthlyReport,
+	}
+}
+
+// PreferencesUpdate changes the preferences that are set. Empty strings
+// clear a channel; a non-nil BudgetLimits replaces the limits.
+type PreferencesUpdate struct {
+	AnomalyAlerts          *bool
+	BudgetAlerts           *bool
+	BudgetLimits           map[string]float64
+	BudgetThresholdPercent *float64
+	MinSeverity            *string
+	Email                  *string
+	WebhookURL             *string
+	PushToken              *string
+	Digest                 *string
+	WeeklySummary          *bool
+	MonthlyReport          *bool
+}
+
+// Validate checks the preferences can be saved
+func (p Preferences) Validate() error {
+	for category, limit := range p.BudgetLimits {
+		if category == "" {
+			return fmt.Errorf("%w: budget limit categories must not be empty", ErrInvalidPreferences)
+		}
+		if limit <= 0 {
+			return fmt.Errorf("%w: budget limit for %s must be positive", ErrInvalidPreferences, category)
+		}
+	}
+	if p.BudgetThresholdPercent <= 0 || p.BudgetThresholdPercent > 100 {
+		return fmt.Errorf("%w: budget_threshold_percent must be between 0 and 100", ErrInvalidPreferences)
+	}
+	if alert.SeverityValidator(p.MinSeverity) != nil {
+		return fmt.Errorf("%w: min_severity must be one of: low, medium, high", ErrInvalidPreferences)
+	}
+	if alertpreference.DigestValidator(p.Digest) != nil {
+		return fmt.Errorf("%w: digest must be one of: immediate, hourly, daily", ErrInvalidPreferences)
+	}
+	if p.Email != nil {
+		if _, err := mail.ParseAddress(*p.Email); err != nil {
+			return fmt.Errorf("%w: email is not a valid address", ErrInvalidPreferences)
+		}
+	}
+	if p.WebhookURL != nil {
+		u, err := url.Parse(*p.WebhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w: webhook_url must be an http or https URL", ErrInvalidPreferences)
+		}
+		if err := webhooks.CheckHost(u.Hostname()); err != nil {
+			return fmt.Errorf("%w: webhook_url must be a public address", ErrInvalidPreferences)
+		}
+	}
+	return nil
+}
+
+// apply returns the preferences with the update's changes
+func (p Preferences) apply(update PreferencesUpdate) Preferences {
+	if update.AnomalyAlerts != nil {
+		p.AnomalyAlerts = *update.AnomalyAlerts
+	}
+	if update.BudgetAlerts != nil {
+		p.BudgetAlerts = *update.BudgetAlerts
+	}
+	if update.BudgetLimits != nil {
+		p.BudgetLimits = make(map[string]float64, len(update.BudgetLimits))
+		for category, limit := range update.BudgetLimits {
+			p.BudgetLimits[strings.ToLower(strings.TrimSpace(category))] = limit
+		}
+	}
+	if update.BudgetThresholdPercent != nil {
+		p.BudgetThresholdPercent = *update.BudgetThresholdPercent
+	}
+	if update.MinSeverity != nil {
+		p.MinSeverity = alert.Severity(*update.MinSeverity)
+	}
+	if update.Digest != nil {
+		p.Digest = alertpreference.Digest(*update.Digest)
+	}
+	if update.WeeklySummary != nil {
+		p.WeeklySummary = *update.WeeklySummary
+	}
+	if update.MonthlyReport != nil {
+		p.MonthlyReport = *update.MonthlyReport
+	}
+	p.Email = channelValue(p.Email, update.Email)
+	p.WebhookURL = channelValue(p.WebhookURL, update.WebhookURL)
+	p.PushToken = channelValue(p.PushToken, update.PushToken)
+	return p
+}
+
+// channelValue returns a channel's updated recipient: current if update is
+// nil, none if it is empty
+func channelValue(current, update *string) *string {
+	if update == nil {
+		return current
+	}
+	value := strings.TrimSpace(*update)
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// AlertFilter narrows a list of alerts
+type AlertFilter struct {
+	UnreadOnly bool
+	Kind       string
+	Limit      int
+}
+
+// Service raises, stores and delivers alerts
+type Service struct {
+	entClient    *ent.Client
+	transactions analysis.TransactionRepository
+	spending     *analysis.SpendingService
+
+	mu        sync.RWMutex
+	notifiers map[Channel]Notifier
+	publisher webhooks.Publisher
+}
+
+// NewService creates a new alert service that analyzes the transactions in
+// transactions
+func NewService(entClient *ent.Client, transactions analysis.TransactionRepository) *Service {
+	return &Service{
+		entClient:    entClient,
+		transactions: transactions,
+		spending:     analysis.NewSpendingServiceWithDefaults(transactions),
+		notifiers:    make(map[Channel]Notifier),
+	}
+}
+
+// SetNotifier sets how alerts are delivered over a channel. Alerts are
+// not delivered over channels without a notifier.
+func (s *Service) SetNotifier(channel Channel, notifier Notifier) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.notifiers[channel] = notifier
+}
+
+// SetEventPublisher sets where anomaly.detected and budget.exceeded events
+// are published as alerts are raised
+func (s *Service) SetEventPublisher(publisher webhooks.Publisher) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.publisher = publisher
+}
+
+// GetPreferences returns the user's alert preferences, or the defaults if
+// they haven't set any
+func (s *Service) GetPreferences(ctx context.Context, userID string) (*Preferences, error) {
+	record, err := s.entClient.AlertPreference.Query().
+		Where(alertpreference.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			preferences := DefaultPreferences()
+			return &preferences, nil
+		}
+		return nil, fmt.Errorf("getting preferences: %w", err)
+	}
+	preferences := toPreferences(record)
+	return &preferences, nil
+}
+
+// UpdatePreferences changes the user's alert preferences
+func (s *Service) UpdatePreferences(ctx context.Context, userID string, update PreferencesUpdate) (*Preferences, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+
+	current, err := s.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	preferences := current.apply(update)
+	if err := preferences.Validate(); err != nil {
+		return nil, err
+	}
+
+	err = s.entClient.AlertPreference.Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetAnomalyAlerts(preferences.AnomalyAlerts).
+		SetBudgetAlerts(preferences.BudgetAlerts).
+		SetBudgetLimits(preferences.BudgetLimits).
+		SetBudgetThresholdPercent(preferences.BudgetThresholdPercent).
+		SetMinSeverity(alertpreference.MinSeverity(preferences.MinSeverity)).
+		SetNillableEmail(preferences.Email).
+		SetNillableWebhookURL(preferences.WebhookURL).
+		SetNillablePushToken(preferences.PushToken).
+		SetDigest(preferences.Digest).
+		SetWeeklySummary(preferences.WeeklySummary).
+		SetMonthlyReport(preferences.MonthlyReport).
+		OnConflictColumns(alertpreference.FieldUserID).
+		Update(func(u *ent.AlertPreferenceUpsert) {
+			u.UpdateAnomalyAlerts()
+			u.UpdateBudgetAlerts()
+			u.UpdateBudgetLimits()
+			u.UpdateBudgetThresholdPercent()
+			u.UpdateMinSeverity()
+			u.UpdateDigest()
+			u.UpdateWeeklySummary()
+			u.UpdateMonthlyReport()
+			if preferences.Email != nil {
+				u.UpdateEmail()
+			} else {
+				u.ClearEmail()
+			}
+			if preferences.WebhookURL != nil {
+				u.UpdateWebhookURL()
+			} else {
+				u.ClearWebhookURL()
+			}
+			if preferences.PushToken != nil {
+				u.UpdatePushToken()
+			} else {
+				u.ClearPushToken()
+			}
+			u.SetUpdatedAt(time.Now())
+		}).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("saving preferences: %w", err)
+	}
+	return &preferences, nil
+}
+
+// ListAlerts returns the user's alerts, the latest first
+func (s *Service) ListAlerts(ctx context.Context, userID string, filter AlertFilter) ([]*ent.Alert, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultListLimit
+	}
+	if filter.Limit > MaxListLimit {
+		filter.Limit = MaxListLimit
+	}
+
+	query := s.entClient.Alert.Query().
+		Where(alert.UserID(userID))
+	if filter.UnreadOnly {
+		query.Where(alert.ReadAtIsNil())
+	}
+	if filter.Kind != "" {
+		if alert.KindValidator(alert.Kind(filter.Kind)) != nil {
+			return nil, ErrInvalidKind
+		}
+		query.Where(alert.KindEQ(alert.Kind(filter.Kind)))
+	}
+
+	records, err := query.
+		Order(ent.Desc(alert.FieldCreatedAt)).
+		Limit(filter.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying alerts: %w", err)
+	}
+	return records, nil
+}
+
+// MarkRead marks one of the user's alerts as read
+func (s *Service) MarkRead(ctx context.Context, userID, alertID string) (*ent.Alert, error) {
+	record, err := s.entClient.Alert.Query().
+		Where(alert.ID(alertID), alert.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrAlertNotFound
+		}
+		return nil, fmt.Errorf("getting alert: %w", err)
+	}
+	if record.ReadAt != nil {
+		return record, nil
+	}
+
+	record, err = record.Update().
+		SetReadAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("updating alert: %w", err)
+	}
+	return record, nil
+}
+
+// toPreferences converts stored preferences
+func toPreferences(record *ent.AlertPreference) Preferences {
+	limits := record.BudgetLimits
+	if limits == nil {
+		limits = map[string]float64{}
+	}
+	return Preferences{
+		AnomalyAlerts:          record.AnomalyAlerts,
+		BudgetAlerts:           record.BudgetAlerts,
+		BudgetLimits:           limits,
+		BudgetThresholdPercent: record.BudgetThresholdPercent,
+		MinSeverity:            alert.Severity(record.MinSeverity),
+		Email:                  record.Email,
+		WebhookURL:             record.WebhookURL,
+		PushToken:              record.PushToken,
+		Digest:                 record.Digest,
+		WeeklySummary:          record.WeeklySummary,
+		MonthlyReport:          record.MonthlyReport,
+		LastDigestAt:           record.LastDigestAt,
+	}
+}
@@ -0,0 +1,57 @@
+package alerts
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func strPtr(s string) *string { return &s }
+
+func floatPtr(f float64) *float64 { return &f }
+
+func TestPreferencesApply(t *testing.T) {
+	preferences := DefaultPreferences()
+	preferences.WebhookURL = strPtr("https://example.com/hook")
+
+	updated := preferences.apply(PreferencesUpdate{
+		BudgetLimits:           map[string]float64{" Dining ": 300},
+		BudgetThresholdPercent: floatPtr(80),
+		Email:                  strPtr(" me@example.com "),
+		WebhookURL:             strPtr(""),
+		Digest:                 strPtr("hourly"),
+	})
+	assert.Equal(t, map[string]float64{"dining": 300}, updated.BudgetLimits)
+	assert.Equal(t, 80.0, updated.BudgetThresholdPercent)
+	assert.Equal(t, "me@example.com", *updated.Email)
+	assert.Nil(t, updated.WebhookURL)
+	assert.Equal(t, "hourly", string(updated.Digest))
+	assert.True(t, updated.AnomalyAlerts)
+	assert.NoError(t, updated.Validate())
+}
+
+func TestPreferencesValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		update PreferencesUpdate
+	}{
+		{name: "negative limit", update: PreferencesUpdate{BudgetLimits: map[string]float64{"dining": -1}}},
+		{name: "empty category", update: PreferencesUpdate{BudgetLimits: map[string]float64{" ": 100}}},
+		{name: "threshold over 100", update: PreferencesUpdate{BudgetThresholdPercent: floatPtr(120)}},
+		{name: "unknown severity", update: PreferencesUpdate{MinSeverity: strPtr("urgent")}},
+		{name: "unknown digest", update: PreferencesUpdate{Digest: strPtr("weekly")}},
+		{name: "bad email", update: PreferencesUpdate{Email: strPtr("not an address")}},
+		{name: "non-http webhook", update: PreferencesUpdate{WebhookURL: strPtr("ftp://example.com")}},
+		{name: "loopback webhook", update: PreferencesUpdate{WebhookURL: strPtr("http://127.0.0.1:8080/hook")}},
+		{name: "localhost webhook", update: PreferencesUpdate{WebhookURL: strPtr("http://localhost/hook")}},
+		{name: "private webhook", update: PreferencesUpdate{WebhookURL: strPtr("https://10.0.0.5/hook")}},
+		{name: "metadata webhook", update: PreferencesUpdate{WebhookURL: strPtr("http://169.254.169.254/latest")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := DefaultPreferences().apply(tt.update).Validate()
+			assert.True(t, errors.Is(err, ErrInvalidPreferences), "got %v", err)
+		})
+	}
+}
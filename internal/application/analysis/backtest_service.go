@@ -7,6 +7,8 @@ import (
 	"math"
 	"sort"
 	"time"
+
+	"clockzen-next/internal/infrastructure/i18n"
 )
 
 // =============================================================================
@@ -64,8 +66,25 @@ const (
 	ScenarioEmergencyFund     WhatIfScenario = "emergency_fund"
 	ScenarioLifestyleChange   WhatIfScenario = "lifestyle_change"
 	ScenarioCategoryReduction WhatIfScenario = "category_reduction"
+	ScenarioRoundUpSavings    WhatIfScenario = "round_up_savings"
+	ScenarioWindfall          WhatIfScenario = "windfall"
 )
 
+// Where a windfall scenario's inflow is placed: cash is spendable the month
+// it arrives, while an inherited IRA must be emptied within ten years under
+// the 10-year rule and is paid out evenly over those months
+const (
+	WindfallAccountTaxable      = "taxable"
+	WindfallAccountInheritedIRA = "inherited_ira"
+)
+
+// inheritedIRAMonths is how many months an inherited IRA is paid out over
+const inheritedIRAMonths = 120
+
+// DefaultRoundUpIncrement rounds purchases up to the nearest dollar in the
+// round-up savings scenario
+const DefaultRoundUpIncrement = 1.0
+
 // =============================================================================
 // Budget Data Types
 // =============================================================================
@@ -82,6 +101,14 @@ type Budget struct {
 	CategoryBudgets map[BudgetCategory]float64   `json:"category_budgets"`
 	Income          float64                      `json:"income"`
 	SavingsGoal     float64                      `json:"savings_goal"`
+	// StatementCycle is the card cycle that statement periods follow
+	StatementCycle  *StatementCycle              `json:"statement_cycle,omitempty"`
+	// MemberBudgets are household members' sub-budgets, rolled up into the
+	// totals above when the budget is analyzed
+	MemberBudgets []MemberBudget `json:"member_budgets,omitempty"`
+	// Reallocations move money between categories in the periods they are
+	// effective in
+	Reallocations []BudgetReallocation `json:"reallocations,omitempty"`
 	CreatedAt       time.Time                    `json:"created_at"`
 	UpdatedAt       time.Time                    `json:"updated_at"`
 }
@@ -113,6 +140,10 @@ type PeriodBacktestResult struct {
 	TransactionCount  int                             `json:"transaction_count"`
 	LargestExpense    float64                         `json:"largest_expense"`
 	AverageDaily      float64                         `json:"average_daily"`
+	// MemberResults compare members' spending with their sub-budgets
+	MemberResults []MemberBudgetResult `json:"member_results,omitempty"`
+	// Reallocations were applied to the period's category budgets
+	Reallocations []BudgetReallocation `json:"reallocations,omitempty"`
 }
 
 // CategoryTrendData represents trend data for a specific category
@@ -185,6 +216,33 @@ type WhatIfParameters struct {
 	TimeframeMonths  int                     `json:"timeframe_months,omitempty"`
 	OneTimeExpense   float64                 `json:"one_time_expense,omitempty"`
 	RecurringChange  float64                 `json:"recurring_change,omitempty"`
+	RoundUpIncrement float64                 `json:"round_up_increment,omitempty"` // Round-up scenario; defaults to DefaultRoundUpIncrement
+	DebtStrategy     string                  `json:"debt_strategy,omitempty"`      // Debt payoff scenario: avalanche (default) or snowball
+	ExtraDebtPayment float64                 `json:"extra_debt_payment,omitempty"` // Debt payoff scenario: paid on top of the minimums
+	WindfallAmount   float64                 `json:"windfall_amount,omitempty"`    // Windfall scenario: the one-time inflow
+	WindfallMonth    int                     `json:"windfall_month,omitempty"`     // Windfall scenario: projected month it arrives in, from 1 (default)
+	WindfallTaxRate  float64                 `json:"windfall_tax_rate,omitempty"`  // Windfall scenario: tax on the inflow or its distributions
+	WindfallAccount  string                  `json:"windfall_account,omitempty"`   // Windfall scenario: taxable (default) or inherited_ira
+}
+
+// WindfallIncome returns the windfall's after-tax income in a projected
+// month, counted from 1
+func (p WhatIfParameters) WindfallIncome(month int) float64 {
+	if p.WindfallAmount <= 0 {
+		return 0
+	}
+	start := max(p.WindfallMonth, 1)
+	net := p.WindfallAmount * (1 - p.WindfallTaxRate)
+	if p.WindfallAccount == WindfallAccountInheritedIRA {
+		if month < start || month >= start+inheritedIRAMonths {
+			return 0
+		}
+		return net / inheritedIRAMonths
+	}
+	if month != start {
+		return 0
+	}
+	return net
 }
 
 // WhatIfProjection represents a projected month in the what-if analysis
@@ -198,6 +256,11 @@ type WhatIfProjection struct {
 	BudgetVariance    float64                      `json:"budget_variance"`
 	CategoryBreakdown map[BudgetCategory]float64   `json:"category_breakdown"`
 	GoalProgress      float64                      `json:"goal_progress,omitempty"`
+	RoundUpSavings    float64                      `json:"round_up_savings,omitempty"`
+	CumulativeRoundUps float64                     `json:"cumulative_round_ups,omitempty"`
+	DebtPayment       float64                      `json:"debt_payment,omitempty"`
+	DebtBalance       float64                      `json:"debt_balance,omitempty"`
+	WindfallIncome    float64                      `json:"windfall_income,omitempty"`
 }
 
 // WhatIfComparison compares baseline vs scenario
@@ -209,6 +272,18 @@ type WhatIfComparison struct {
 	BaselineSavings  float64 `json:"baseline_savings"`
 	ScenarioSavings  float64 `json:"scenario_savings"`
 	SavingsDifference float64 `json:"savings_difference"`
+	// Round-up scenario: what rounding up the baseline purchases would have
+	// set aside, and what it would set aside over the projection
+	HistoricalRoundUps float64 `json:"historical_round_ups,omitempty"`
+	ProjectedRoundUps  float64 `json:"projected_round_ups,omitempty"`
+	// Debt payoff scenario: months until the debts are paid off and the
+	// interest paid until then, which may run past the projection
+	DebtPayoffMonths int     `json:"debt_payoff_months,omitempty"`
+	DebtInterest     float64 `json:"debt_interest,omitempty"`
+	// Windfall scenario: the after-tax windfall received over the
+	// projection, and what an inherited IRA still has to pay out after it
+	WindfallReceived float64 `json:"windfall_received,omitempty"`
+	WindfallDeferred float64 `json:"windfall_deferred,omitempty"`
 }
 
 // WhatIfResult represents the complete what-if analysis result
@@ -233,6 +308,10 @@ type FeasibilityAssessment struct {
 	TimeToGoal      int     `json:"time_to_goal,omitempty"` // months
 	Obstacles       []string `json:"obstacles,omitempty"`
 	Opportunities   []string `json:"opportunities,omitempty"`
+	// Emergency fund: months of expenses the user's designated accounts
+	// cover, and the user's target, when the fund is monitored
+	EmergencyFundMonths *float64 `json:"emergency_fund_months,omitempty"`
+	EmergencyFundTarget float64  `json:"emergency_fund_target,omitempty"`
 }
 
 // WhatIfRecommendation provides recommendations for what-if scenarios
@@ -286,6 +365,15 @@ type HeatmapCell struct {
 	Color  string  `json:"color,omitempty"`
 }
 
+// DataTable is an accessible alternative to a chart: the same values laid
+// out as rows under column headers, with numbers already formatted
+type DataTable struct {
+	Chart   string     `json:"chart"`
+	Caption string     `json:"caption"`
+	Headers []string   `json:"headers"`
+	Rows    [][]string `json:"rows"`
+}
+
 // VisualizationData contains all visualization data for backtest results
 type VisualizationData struct {
 	// Budget vs Actual over time
@@ -308,6 +396,10 @@ type VisualizationData struct {
 
 	// Forecast data (if applicable)
 	ForecastData []TimeSeriesData `json:"forecast_data,omitempty"`
+
+	// Tabular equivalents of the charts above, for screen readers. Only
+	// set when requested; see ResponseShape.IncludeTables.
+	Tables []DataTable `json:"tables,omitempty"`
 }
 
 // =============================================================================
@@ -321,6 +413,36 @@ type BudgetRepository interface {
 	GetTransactionsByBudget(ctx context.Context, userID string, startDate, endDate time.Time) ([]Transaction, error)
 }
 
+// DebtPayoffPlanner plans paying off a user's recorded debts for the debt
+// payoff what-if scenario. The schedule starts next month and ends when the
+// debts are paid off; it is empty if the user has no debts.
+type DebtPayoffPlanner interface {
+	PlanDebtPayoff(ctx context.Context, userID string, strategy string, extraPayment float64) ([]DebtPayoffMonth, error)
+}
+
+// EmergencyFundMonitor reports how well a user's emergency fund covers
+// their expenses, which the what-if feasibility assessment takes into
+// account
+type EmergencyFundMonitor interface {
+	EmergencyFundCoverage(ctx context.Context, userID string) (*EmergencyFundCoverage, error)
+}
+
+// EmergencyFundCoverage is a user's emergency fund against their expenses
+type EmergencyFundCoverage struct {
+	Balance         float64
+	MonthlyExpenses float64
+	// MonthsCovered is nil when the user has no expense history
+	MonthsCovered *float64
+	TargetMonths  float64
+}
+
+// DebtPayoffMonth is a month of a debt payoff schedule
+type DebtPayoffMonth struct {
+	Payment          float64
+	Interest         float64
+	RemainingBalance float64
+}
+
 // =============================================================================
 // Service Configuration
 // =============================================================================
@@ -370,6 +492,8 @@ func DefaultBacktestConfig() BacktestConfig {
 type BacktestService struct {
 	config BacktestConfig
 	repo   BudgetRepository
+	debts  DebtPayoffPlanner
+	funds  EmergencyFundMonitor
 }
 
 // NewBacktestService creates a new backtest service
@@ -385,6 +509,18 @@ func NewBacktestServiceWithDefaults(repo BudgetRepository) *BacktestService {
 	return NewBacktestService(repo, DefaultBacktestConfig())
 }
 
+// SetDebtPayoffPlanner sets the planner the debt payoff scenario projects
+// the user's debts with. Without one the scenario projects spending only.
+func (s *BacktestService) SetDebtPayoffPlanner(planner DebtPayoffPlanner) {
+	s.debts = planner
+}
+
+// SetEmergencyFundMonitor sets the monitor whose coverage the feasibility
+// assessment includes. Without one the assessment leaves it out.
+func (s *BacktestService) SetEmergencyFundMonitor(monitor EmergencyFundMonitor) {
+	s.funds = monitor
+}
+
 // =============================================================================
 // Historical Simulation Methods
 // =============================================================================
@@ -402,12 +538,24 @@ func (s *BacktestService) RunHistoricalBacktest(
 	if endDate.Before(startDate) {
 		return nil, errors.New("endDate must be after startDate")
 	}
+	if budget.Period == BacktestPeriodStatement {
+		if budget.StatementCycle == nil {
+			return nil, ErrStatementCycleRequired
+		}
+		if err := budget.StatementCycle.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	budget = householdBudget(budget)
 
 	// Get historical transactions
 	transactions, err := s.repo.GetTransactionsByBudget(ctx, userID, startDate, endDate)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get transactions: %w", err)
 	}
+	if budget.Period == BacktestPeriodStatement {
+		transactions = budget.StatementCycle.Filter(transactions)
+	}
 
 	// Group transactions by period
 	periodResults := s.simulateHistoricalPeriods(transactions, budget, startDate, endDate)
@@ -419,7 +567,7 @@ func (s *BacktestService) RunHistoricalBacktest(
 	categoryTrends := s.analyzeCategoryTrends(periodResults, budget)
 
 	// Generate recommendations
-	recommendations := s.generateBacktestRecommendations(periodResults, summary, categoryTrends)
+	recommendations := s.generateBacktestRecommendations(i18n.FromContext(ctx), periodResults, summary, categoryTrends)
 
 	return &BacktestResult{
 		UserID:          userID,
@@ -447,20 +595,23 @@ func (s *BacktestService) simulateHistoricalPeriods(
 	// Group transactions by period
 	periodMap := make(map[time.Time][]Transaction)
 	for _, t := range transactions {
-		periodStart := s.getPeriodStart(t.TransactionDate, budget.Period)
+		periodStart := s.getPeriodStart(t.TransactionDate, budget)
 		periodMap[periodStart] = append(periodMap[periodStart], t)
 	}
 
 	// Generate all periods in range
-	current := s.getPeriodStart(startDate, budget.Period)
+	current := s.getPeriodStart(startDate, budget)
 	for !current.After(endDate) {
-		periodEnd := s.getPeriodEnd(current, budget.Period)
+		periodEnd := s.getPeriodEnd(current, budget)
 		periodTransactions := periodMap[current]
 
-		result := s.calculatePeriodResult(periodTransactions, budget, current, periodEnd)
+		periodBudget, reallocations := reallocatedBudget(budget, current, periodEnd)
+		result := s.calculatePeriodResult(periodTransactions, periodBudget, current, periodEnd)
+		result.MemberResults = s.memberBudgetResults(periodTransactions, budget, current, periodEnd)
+		result.Reallocations = reallocations
 		results = append(results, result)
 
-		current = s.nextPeriod(current, budget.Period)
+		current = s.nextPeriod(current, budget)
 	}
 
 	return results
@@ -651,7 +802,7 @@ func (s *BacktestService) analyzeCategoryTrends(
 		// Calculate seasonality if we have enough data
 		var seasonality []float64
 		if len(data) >= s.config.SeasonalityLookback {
-			seasonality = s.calculateSeasonality(data)
+			seasonality = seasonalIndices(data)
 		}
 
 		trends[cat] = CategoryTrendData{
@@ -669,8 +820,9 @@ func (s *BacktestService) analyzeCategoryTrends(
 	return trends
 }
 
-// calculateSeasonality calculates seasonal patterns
-func (s *BacktestService) calculateSeasonality(data []float64) []float64 {
+// seasonalIndices calculates seasonal patterns: each month's average as a
+// share of the overall average, the first value being the first month
+func seasonalIndices(data []float64) []float64 {
 	if len(data) < 12 {
 		return nil
 	}
@@ -702,6 +854,7 @@ func (s *BacktestService) calculateSeasonality(data []float64) []float64 {
 
 // generateBacktestRecommendations generates recommendations based on backtest
 func (s *BacktestService) generateBacktestRecommendations(
+	loc *i18n.Localizer,
 	periodResults []PeriodBacktestResult,
 	summary BacktestSummary,
 	categoryTrends map[BudgetCategory]CategoryTrendData,
@@ -713,8 +866,8 @@ func (s *BacktestService) generateBacktestRecommendations(
 		recommendations = append(recommendations, BudgetRecommendation{
 			Priority:    "high",
 			Type:        "budget_adjustment",
-			Title:       "Budget Needs Adjustment",
-			Description: "You're consistently spending more than budgeted. Consider reviewing your budget allocations or finding areas to cut back.",
+			Title:       loc.Message("analysis.budget.adjustment.title", nil),
+			Description: loc.Message("analysis.budget.adjustment.description", nil),
 			Impact:      summary.TotalActual - summary.TotalBudgeted,
 			Confidence:  0.9,
 		})
@@ -722,13 +875,15 @@ func (s *BacktestService) generateBacktestRecommendations(
 
 	// Check for categories with increasing trends
 	for cat, trend := range categoryTrends {
+		data := map[string]any{"Category": loc.Category(string(cat))}
 		if trend.TrendDirection == TrendIncreasing && trend.TrendSlope > trend.AverageAmount*0.1 {
+			data["Percent"] = loc.Percent((trend.TrendSlope / trend.AverageAmount) * 100)
 			recommendations = append(recommendations, BudgetRecommendation{
 				Category:    cat,
 				Priority:    "medium",
 				Type:        "trend_alert",
-				Title:       fmt.Sprintf("Rising %s Spending", cat),
-				Description: fmt.Sprintf("Your %s spending has been increasing by approximately %.1f%% per period.", cat, (trend.TrendSlope/trend.AverageAmount)*100),
+				Title:       loc.Message("analysis.budget.rising.title", data),
+				Description: loc.Message("analysis.budget.rising.description", data),
 				Impact:      trend.TrendSlope * 6, // Projected 6-period impact
 				Confidence:  0.7,
 			})
@@ -740,8 +895,8 @@ func (s *BacktestService) generateBacktestRecommendations(
 				Category:    cat,
 				Priority:    "low",
 				Type:        "volatility_alert",
-				Title:       fmt.Sprintf("Variable %s Spending", cat),
-				Description: fmt.Sprintf("Your %s spending varies significantly. Consider setting aside a buffer for this category.", cat),
+				Title:       loc.Message("analysis.budget.volatile.title", data),
+				Description: loc.Message("analysis.budget.volatile.description", data),
 				Confidence:  0.6,
 			})
 		}
@@ -752,8 +907,8 @@ func (s *BacktestService) generateBacktestRecommendations(
 		recommendations = append(recommendations, BudgetRecommendation{
 			Priority:    "medium",
 			Type:        "consistency",
-			Title:       "Improve Spending Consistency",
-			Description: "Your spending varies significantly from month to month. More consistent spending can help with financial planning.",
+			Title:       loc.Message("analysis.budget.consistency.title", nil),
+			Description: loc.Message("analysis.budget.consistency.description", nil),
 			Confidence:  0.8,
 		})
 	}
@@ -781,6 +936,7 @@ func (s *BacktestService) RunWhatIfAnalysis(
 	if userID == "" {
 		return nil, errors.New("userID is required")
 	}
+	budget = householdBudget(budget)
 
 	// Get baseline data
 	endDate := time.Now()
@@ -793,6 +949,13 @@ func (s *BacktestService) RunWhatIfAnalysis(
 
 	// Calculate baseline averages
 	baseline := s.calculateBaselineMetrics(transactions, budget)
+	if params.ScenarioType == ScenarioRoundUpSavings {
+		if params.RoundUpIncrement <= 0 {
+			params.RoundUpIncrement = DefaultRoundUpIncrement
+		}
+		baseline.HistoricalRoundUps = s.calculateRoundUps(transactions, params.RoundUpIncrement)
+		baseline.MonthlyRoundUps = baseline.HistoricalRoundUps / 6
+	}
 
 	// Determine projection months
 	projectionMonths := params.TimeframeMonths
@@ -803,17 +966,42 @@ func (s *BacktestService) RunWhatIfAnalysis(
 		projectionMonths = s.config.MaxProjectionMonths
 	}
 
+	// Plan paying off the user's debts
+	var debtSchedule []DebtPayoffMonth
+	if params.ScenarioType == ScenarioDebtPayoff && s.debts != nil {
+		debtSchedule, err = s.debts.PlanDebtPayoff(ctx, userID, params.DebtStrategy, params.ExtraDebtPayment)
+		if err != nil {
+			return nil, fmt.Errorf("failed to plan debt payoff: %w", err)
+		}
+	}
+
 	// Generate projections
-	projections := s.generateWhatIfProjections(baseline, budget, params, projectionMonths)
+	projections := s.generateWhatIfProjections(baseline, budget, params, projectionMonths, debtSchedule)
 
 	// Calculate comparison
 	comparison := s.calculateWhatIfComparison(baseline, projections, params)
+	if len(debtSchedule) > 0 {
+		comparison.DebtPayoffMonths = len(debtSchedule)
+		for _, month := range debtSchedule {
+			comparison.DebtInterest += month.Interest
+		}
+		comparison.DebtInterest = math.Round(comparison.DebtInterest*100) / 100
+	}
+
+	// Check the user's emergency fund against their target
+	var emergencyFund *EmergencyFundCoverage
+	if s.funds != nil {
+		emergencyFund, err = s.funds.EmergencyFundCoverage(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check emergency fund: %w", err)
+		}
+	}
 
 	// Assess feasibility
-	feasibility := s.assessFeasibility(baseline, params, projections)
+	feasibility := s.assessFeasibility(i18n.FromContext(ctx), baseline, params, projections, emergencyFund)
 
 	// Generate recommendations
-	recommendations := s.generateWhatIfRecommendations(baseline, params, feasibility)
+	recommendations := s.generateWhatIfRecommendations(i18n.FromContext(ctx), baseline, params, feasibility)
 
 	return &WhatIfResult{
 		UserID:          userID,
@@ -836,6 +1024,8 @@ type baselineMetrics struct {
 	CategoryAverages        map[BudgetCategory]float64
 	ExpenseVolatility       float64
 	IncomeStability         float64
+	HistoricalRoundUps      float64
+	MonthlyRoundUps         float64
 }
 
 // calculateBaselineMetrics calculates baseline metrics from transactions
@@ -869,15 +1059,19 @@ func (s *BacktestService) calculateBaselineMetrics(
 	}
 }
 
-// generateWhatIfProjections generates projections for what-if scenario
+// generateWhatIfProjections generates projections for what-if scenario.
+// With a debt payoff schedule, the schedule's payments replace the debt
+// category, so paid off debts free up their payments as savings.
 func (s *BacktestService) generateWhatIfProjections(
 	baseline baselineMetrics,
 	budget Budget,
 	params WhatIfParameters,
 	months int,
+	debtSchedule []DebtPayoffMonth,
 ) []WhatIfProjection {
 	projections := make([]WhatIfProjection, months)
 	cumulativeSavings := 0.0
+	cumulativeRoundUps := 0.0
 
 	for i := 0; i < months; i++ {
 		date := time.Now().AddDate(0, i+1, 0)
@@ -888,6 +1082,10 @@ func (s *BacktestService) generateWhatIfProjections(
 			projectedIncome *= (1 + params.IncomeChange)
 		}
 
+		// Add the windfall, or this month's inherited IRA distribution
+		windfallIncome := params.WindfallIncome(i + 1)
+		projectedIncome += windfallIncome
+
 		// Calculate projected expenses by category
 		categoryBreakdown := make(map[BudgetCategory]float64)
 		projectedExpenses := 0.0
@@ -909,6 +1107,17 @@ func (s *BacktestService) generateWhatIfProjections(
 			projectedExpenses += amount
 		}
 
+		// Replace the debt category with the payoff schedule
+		debtPayment, debtBalance := 0.0, 0.0
+		if len(debtSchedule) > 0 {
+			if i < len(debtSchedule) {
+				debtPayment = debtSchedule[i].Payment
+				debtBalance = debtSchedule[i].RemainingBalance
+			}
+			projectedExpenses += debtPayment - categoryBreakdown[BudgetCategoryDebt]
+			categoryBreakdown[BudgetCategoryDebt] = debtPayment
+		}
+
 		// Add one-time expense if in first month
 		if i == 0 && params.OneTimeExpense > 0 {
 			projectedExpenses += params.OneTimeExpense
@@ -930,16 +1139,26 @@ func (s *BacktestService) generateWhatIfProjections(
 			}
 		}
 
+		// Round-ups move part of each month's savings aside as purchases
+		// are made; they don't change income or spending
+		roundUps := baseline.MonthlyRoundUps
+		cumulativeRoundUps += roundUps
+
 		projections[i] = WhatIfProjection{
-			Month:             i + 1,
-			Date:              date,
-			ProjectedIncome:   projectedIncome,
-			ProjectedExpenses: projectedExpenses,
-			ProjectedSavings:  projectedSavings,
-			CumulativeSavings: cumulativeSavings,
-			BudgetVariance:    budget.TotalBudget - projectedExpenses,
-			CategoryBreakdown: categoryBreakdown,
-			GoalProgress:      goalProgress,
+			Month:              i + 1,
+			Date:               date,
+			ProjectedIncome:    projectedIncome,
+			ProjectedExpenses:  projectedExpenses,
+			ProjectedSavings:   projectedSavings,
+			CumulativeSavings:  cumulativeSavings,
+			BudgetVariance:     budget.TotalBudget - projectedExpenses,
+			CategoryBreakdown:  categoryBreakdown,
+			GoalProgress:       goalProgress,
+			RoundUpSavings:     roundUps,
+			CumulativeRoundUps: cumulativeRoundUps,
+			DebtPayment:        debtPayment,
+			DebtBalance:        debtBalance,
+			WindfallIncome:     windfallIncome,
 		}
 	}
 
@@ -959,9 +1178,13 @@ func (s *BacktestService) calculateWhatIfComparison(
 
 	scenarioTotal := 0.0
 	scenarioSavings := 0.0
+	projectedRoundUps := 0.0
+	windfallReceived := 0.0
 	for _, p := range projections {
 		scenarioTotal += p.ProjectedExpenses
 		scenarioSavings += p.ProjectedSavings
+		projectedRoundUps += p.RoundUpSavings
+		windfallReceived += p.WindfallIncome
 	}
 
 	difference := scenarioTotal - baselineTotal
@@ -971,21 +1194,60 @@ func (s *BacktestService) calculateWhatIfComparison(
 	}
 
 	return WhatIfComparison{
-		BaselineTotal:     baselineTotal,
-		ScenarioTotal:     scenarioTotal,
-		Difference:        difference,
-		DifferencePercent: diffPercent,
-		BaselineSavings:   baselineSavings,
-		ScenarioSavings:   scenarioSavings,
-		SavingsDifference: scenarioSavings - baselineSavings,
+		BaselineTotal:      baselineTotal,
+		ScenarioTotal:      scenarioTotal,
+		Difference:         difference,
+		DifferencePercent:  diffPercent,
+		BaselineSavings:    baselineSavings,
+		ScenarioSavings:    scenarioSavings,
+		SavingsDifference:  scenarioSavings - baselineSavings,
+		HistoricalRoundUps: baseline.HistoricalRoundUps,
+		ProjectedRoundUps:  projectedRoundUps,
+		WindfallReceived:   windfallReceived,
+		WindfallDeferred:   math.Max(0, params.WindfallAmount*(1-params.WindfallTaxRate)-windfallReceived),
 	}
 }
 
+// calculateRoundUps totals what rounding each purchase up to the next
+// multiple of increment would have set aside.
+//
+// The scenario deliberately ignores the user's stored rounding rule: it
+// answers "what would rounding up by this increment save", so it also works
+// for users without a rule and for comparing increments. It only models the
+// increment; a rule's multiplier, cap and payment methods aren't applied.
+func (s *BacktestService) calculateRoundUps(transactions []Transaction, increment float64) float64 {
+	totalCents := 0.0
+	for _, t := range transactions {
+		totalCents += math.Round(RoundUpAmount(t.Amount, increment) * 100)
+	}
+	return totalCents / 100
+}
+
+// RoundUpAmount returns the difference between a purchase amount and the
+// next multiple of increment. Amounts are handled in cents so that, for
+// example, 3.30 rounded to the next 0.10 gives nothing rather than a
+// floating point remainder. Refunds and amounts already on a multiple give
+// nothing.
+func RoundUpAmount(amount, increment float64) float64 {
+	amountCents := math.Round(amount * 100)
+	incrementCents := math.Round(increment * 100)
+	if amountCents <= 0 || incrementCents <= 0 {
+		return 0
+	}
+	remainder := math.Mod(amountCents, incrementCents)
+	if remainder == 0 {
+		return 0
+	}
+	return (incrementCents - remainder) / 100
+}
+
 // assessFeasibility assesses if a scenario is achievable
 func (s *BacktestService) assessFeasibility(
+	loc *i18n.Localizer,
 	baseline baselineMetrics,
 	params WhatIfParameters,
 	projections []WhatIfProjection,
+	emergencyFund *EmergencyFundCoverage,
 ) FeasibilityAssessment {
 	assessment := FeasibilityAssessment{
 		IsFeasible:      true,
@@ -1005,7 +1267,7 @@ func (s *BacktestService) assessFeasibility(
 		assessment.RiskLevel = "medium"
 		assessment.ConfidenceLevel = 0.6
 		assessment.Obstacles = append(assessment.Obstacles,
-			fmt.Sprintf("%d months projected with negative cash flow", negativeMonths))
+			loc.Plural("analysis.whatif.obstacle.negative_cash_flow", negativeMonths, nil))
 	}
 
 	if negativeMonths > len(projections)/2 {
@@ -1033,16 +1295,33 @@ func (s *BacktestService) assessFeasibility(
 			if finalProgress < 50 {
 				assessment.RiskLevel = "high"
 				assessment.Obstacles = append(assessment.Obstacles,
-					fmt.Sprintf("Only %.1f%% of savings goal achievable in timeframe", finalProgress))
+					loc.Message("analysis.whatif.obstacle.goal_shortfall", map[string]any{"Percent": loc.Percent(finalProgress)}))
 			}
 		}
 	}
 
+	// A fund below the user's target leaves less room for a scenario that
+	// goes wrong
+	if emergencyFund != nil && emergencyFund.MonthsCovered != nil {
+		assessment.EmergencyFundMonths = emergencyFund.MonthsCovered
+		assessment.EmergencyFundTarget = emergencyFund.TargetMonths
+		if *emergencyFund.MonthsCovered < emergencyFund.TargetMonths {
+			if assessment.RiskLevel == "low" {
+				assessment.RiskLevel = "medium"
+			}
+			assessment.Obstacles = append(assessment.Obstacles,
+				loc.Message("analysis.whatif.obstacle.emergency_fund_low", map[string]any{
+					"Months": loc.Decimal(*emergencyFund.MonthsCovered),
+					"Target": loc.Decimal(emergencyFund.TargetMonths),
+				}))
+		}
+	}
+
 	// Check for opportunities
 	if params.ExpenseChange < 0 {
 		potentialSavings := baseline.AverageExpenses * math.Abs(params.ExpenseChange) * float64(len(projections))
 		assessment.Opportunities = append(assessment.Opportunities,
-			fmt.Sprintf("Potential savings of $%.2f over the projection period", potentialSavings))
+			loc.Message("analysis.whatif.opportunity.savings", map[string]any{"Amount": loc.Amount(potentialSavings)}))
 	}
 
 	return assessment
@@ -1050,6 +1329,7 @@ func (s *BacktestService) assessFeasibility(
 
 // generateWhatIfRecommendations generates recommendations for what-if scenarios
 func (s *BacktestService) generateWhatIfRecommendations(
+	loc *i18n.Localizer,
 	baseline baselineMetrics,
 	params WhatIfParameters,
 	feasibility FeasibilityAssessment,
@@ -1060,20 +1340,34 @@ func (s *BacktestService) generateWhatIfRecommendations(
 	case ScenarioIncomeDecrease:
 		recommendations = append(recommendations, WhatIfRecommendation{
 			Category:    "expense",
-			Action:      "Review discretionary spending",
+			Action:      loc.Message("analysis.whatif.discretionary.action", nil),
 			Impact:      baseline.CategoryAverages[BudgetCategoryEntertainment] * 0.3,
 			Difficulty:  "moderate",
-			Description: "Reducing entertainment expenses by 30% can help offset income changes.",
+			Description: loc.Message("analysis.whatif.discretionary.description", nil),
 		})
 
 	case ScenarioSavingsGoal:
 		if !feasibility.IsFeasible {
 			recommendations = append(recommendations, WhatIfRecommendation{
 				Category:    "timeline",
-				Action:      "Extend timeline",
+				Action:      loc.Message("analysis.whatif.timeline.action", nil),
 				Impact:      params.TargetSavings / float64(feasibility.TimeToGoal),
 				Difficulty:  "easy",
-				Description: fmt.Sprintf("Consider extending your timeline to %d months to reach your goal.", feasibility.TimeToGoal),
+				Description: loc.Plural("analysis.whatif.timeline.description", feasibility.TimeToGoal, nil),
+			})
+		}
+
+	case ScenarioRoundUpSavings:
+		if baseline.MonthlyRoundUps > 0 {
+			recommendations = append(recommendations, WhatIfRecommendation{
+				Category:   "savings",
+				Action:     loc.Message("analysis.whatif.round_up.action", nil),
+				Impact:     baseline.MonthlyRoundUps,
+				Difficulty: "easy",
+				Description: loc.Message("analysis.whatif.round_up.description", map[string]any{
+					"Increment": loc.Amount(params.RoundUpIncrement),
+					"Amount":    loc.Amount(baseline.HistoricalRoundUps),
+				}),
 			})
 		}
 
@@ -1089,10 +1383,10 @@ func (s *BacktestService) generateWhatIfRecommendations(
 		}
 		recommendations = append(recommendations, WhatIfRecommendation{
 			Category:    string(largestCat),
-			Action:      "Offset with largest category reduction",
+			Action:      loc.Message("analysis.whatif.offset.action", nil),
 			Impact:      largestAmt * 0.1,
 			Difficulty:  "moderate",
-			Description: fmt.Sprintf("A 10%% reduction in %s could offset increased expenses.", largestCat),
+			Description: loc.Message("analysis.whatif.offset.description", map[string]any{"Category": loc.Category(string(largestCat))}),
 		})
 	}
 
@@ -1100,10 +1394,10 @@ func (s *BacktestService) generateWhatIfRecommendations(
 	if feasibility.RiskLevel == "high" {
 		recommendations = append(recommendations, WhatIfRecommendation{
 			Category:    "emergency",
-			Action:      "Build emergency fund",
+			Action:      loc.Message("analysis.whatif.emergency_fund.action", nil),
 			Impact:      baseline.AverageExpenses * 3,
 			Difficulty:  "hard",
-			Description: "High-risk scenarios should be backed by a 3-month emergency fund.",
+			Description: loc.Message("analysis.whatif.emergency_fund.description", nil),
 		})
 	}
 
@@ -1144,6 +1438,9 @@ func (s *BacktestService) GenerateVisualizationData(result *BacktestResult) *Vis
 		viz.CategoryTrends[cat] = s.generateCategoryTrendPoints(trend)
 	}
 
+	// Spending forecast for the periods after the backtest
+	viz.ForecastData = s.generateForecastSeries(result)
+
 	return viz
 }
 
@@ -1312,6 +1609,53 @@ func (s *BacktestService) generateCumulativeSavings(periods []PeriodBacktestResu
 	return points
 }
 
+// generateForecastSeries forecasts actual spending for the ForecastPeriods
+// periods after the backtest, with the bounds of its confidence band. It is
+// nil when there are too few periods to fit a trend to.
+func (s *BacktestService) generateForecastSeries(result *BacktestResult) []TimeSeriesData {
+	periods := result.PeriodResults
+	if s.config.ForecastPeriods <= 0 || len(periods) < max(s.config.MinPeriodsForTrend, 2) {
+		return nil
+	}
+
+	history := make([]float64, len(periods))
+	for i, p := range periods {
+		history[i] = p.ActualAmount
+	}
+
+	// Statement cycles run about a month, so they step and repeat monthly
+	period := result.Period
+	if period == BacktestPeriodStatement {
+		period = BacktestPeriodMonthly
+	}
+	forecast := forecastSeries(history, s.config.ForecastPeriods, s.config.ForecastConfidence, period == BacktestPeriodMonthly)
+
+	expectedSeries := TimeSeriesData{
+		Series: "Forecast",
+		Color:  "#FF9800",
+	}
+	lowerSeries := TimeSeriesData{
+		Series: "Forecast Lower",
+		Color:  "#FFE0B2",
+	}
+	upperSeries := TimeSeriesData{
+		Series: "Forecast Upper",
+		Color:  "#FFE0B2",
+	}
+
+	start := periods[len(periods)-1].PeriodStart
+	for _, p := range forecast {
+		start = s.nextPeriod(start, Budget{Period: period})
+		label := start.Format("Jan 2006")
+		date := start.Format("2006-01-02")
+		expectedSeries.Data = append(expectedSeries.Data, ChartDataPoint{Label: label, Value: p.Amount, Date: date})
+		lowerSeries.Data = append(lowerSeries.Data, ChartDataPoint{Label: label, Value: p.Lower, Date: date})
+		upperSeries.Data = append(upperSeries.Data, ChartDataPoint{Label: label, Value: p.Upper, Date: date})
+	}
+
+	return []TimeSeriesData{expectedSeries, lowerSeries, upperSeries}
+}
+
 // generateCategoryTrendPoints generates trend chart points for a category
 func (s *BacktestService) generateCategoryTrendPoints(trend CategoryTrendData) []ChartDataPoint {
 	var points []ChartDataPoint
@@ -1472,9 +1816,12 @@ func (s *BacktestService) mapSpendingToBudgetCategory(spendingCat SpendingCatego
 	return BudgetCategoryOther
 }
 
-// getPeriodStart returns the start of the period containing the given time
-func (s *BacktestService) getPeriodStart(t time.Time, period BacktestPeriod) time.Time {
-	switch period {
+// getPeriodStart returns the start of the budget period containing the
+// given time
+func (s *BacktestService) getPeriodStart(t time.Time, budget Budget) time.Time {
+	switch budget.Period {
+	case BacktestPeriodStatement:
+		return budget.StatementCycle.PeriodStart(t)
 	case BacktestPeriodWeekly:
 		weekday := int(t.Weekday())
 		return time.Date(t.Year(), t.Month(), t.Day()-weekday, 0, 0, 0, 0, t.Location())
@@ -1490,9 +1837,11 @@ func (s *BacktestService) getPeriodStart(t time.Time, period BacktestPeriod) tim
 	}
 }
 
-// getPeriodEnd returns the end of the period
-func (s *BacktestService) getPeriodEnd(start time.Time, period BacktestPeriod) time.Time {
-	switch period {
+// getPeriodEnd returns the end of the budget period
+func (s *BacktestService) getPeriodEnd(start time.Time, budget Budget) time.Time {
+	switch budget.Period {
+	case BacktestPeriodStatement:
+		return budget.StatementCycle.PeriodEnd(start)
 	case BacktestPeriodWeekly:
 		return start.AddDate(0, 0, 7).Add(-time.Nanosecond)
 	case BacktestPeriodMonthly:
@@ -1506,9 +1855,11 @@ func (s *BacktestService) getPeriodEnd(start time.Time, period BacktestPeriod) t
 	}
 }
 
-// nextPeriod returns the start of the next period
-func (s *BacktestService) nextPeriod(current time.Time, period BacktestPeriod) time.Time {
-	switch period {
+// nextPeriod returns the start of the next budget period
+func (s *BacktestService) nextPeriod(current time.Time, budget Budget) time.Time {
+	switch budget.Period {
+	case BacktestPeriodStatement:
+		return budget.StatementCycle.NextPeriod(current)
 	case BacktestPeriodWeekly:
 		return current.AddDate(0, 0, 7)
 	case BacktestPeriodMonthly:
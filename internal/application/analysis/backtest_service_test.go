@@ -0,0 +1,180 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubBudgets serves a fixed set of transactions as the baseline, and as a
+// user's transactions
+type stubBudgets struct {
+	transactions []Transaction
+}
+
+func (s stubBudgets) GetBudgetByID(ctx context.Context, budgetID string) (*Budget, error) {
+	return nil, nil
+}
+
+func (s stubBudgets) GetBudgetsByUserID(ctx context.Context, userID string) ([]Budget, error) {
+	return nil, nil
+}
+
+func (s stubBudgets) GetTransactionsByBudget(ctx context.Context, userID string, startDate, endDate time.Time) ([]Transaction, error) {
+	return s.transactions, nil
+}
+
+func (s stubBudgets) GetByUserID(ctx context.Context, userID string, startDate, endDate time.Time) ([]Transaction, error) {
+	return s.transactions, nil
+}
+
+func (s stubBudgets) GetByCategory(ctx context.Context, userID string, category SpendingCategory, startDate, endDate time.Time) ([]Transaction, error) {
+	return s.transactions, nil
+}
+
+func TestRoundUpAmount(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    float64
+		increment float64
+		want      float64
+	}{
+		{name: "next dollar", amount: 4.35, increment: 1, want: 0.65},
+		{name: "already on a multiple", amount: 3.30, increment: 0.10, want: 0},
+		{name: "no floating point remainder", amount: 0.29, increment: 0.10, want: 0.01},
+		{name: "larger increment", amount: 17.25, increment: 5, want: 2.75},
+		{name: "refund", amount: -4.35, increment: 1, want: 0},
+		{name: "no increment", amount: 4.35, increment: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RoundUpAmount(tt.amount, tt.increment))
+		})
+	}
+}
+
+func TestWhatIfRoundUps(t *testing.T) {
+	// 0.65 + 0.70 + 0.90 to the next dollar; whole amounts and refunds round
+	// up nothing
+	repo := stubBudgets{transactions: []Transaction{
+		{Amount: 4.35, Category: CategoryGroceries},
+		{Amount: 3.30, Category: CategoryDining},
+		{Amount: 12.00, Category: CategoryGroceries},
+		{Amount: 7.10, Category: CategoryDining},
+		{Amount: -5.25, Category: CategoryGroceries},
+	}}
+	service := NewBacktestServiceWithDefaults(repo)
+	budget := Budget{Income: 5000, TotalBudget: 4000}
+
+	tests := []struct {
+		name           string
+		params         WhatIfParameters
+		wantHistorical float64
+		wantMonths     int
+	}{
+		{
+			name:           "defaults to the next dollar",
+			params:         WhatIfParameters{ScenarioType: ScenarioRoundUpSavings},
+			wantHistorical: 2.25,
+			wantMonths:     12,
+		},
+		{
+			name:           "dime increment",
+			params:         WhatIfParameters{ScenarioType: ScenarioRoundUpSavings, RoundUpIncrement: 0.10, TimeframeMonths: 6},
+			wantHistorical: 0.05,
+			wantMonths:     6,
+		},
+		{
+			name:           "five dollar increment",
+			params:         WhatIfParameters{ScenarioType: ScenarioRoundUpSavings, RoundUpIncrement: 5, TimeframeMonths: 3},
+			wantHistorical: 0.65 + 1.70 + 3.00 + 2.90,
+			wantMonths:     3,
+		},
+		{
+			name:   "other scenarios round up nothing",
+			params: WhatIfParameters{ScenarioType: ScenarioExpenseDecrease, ExpenseChange: -0.1, TimeframeMonths: 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.RunWhatIfAnalysis(context.Background(), "user-1", budget, tt.params)
+			require.NoError(t, err)
+
+			// The baseline covers six months
+			monthly := tt.wantHistorical / 6
+			assert.InDelta(t, tt.wantHistorical, result.Comparison.HistoricalRoundUps, 0.001)
+			assert.InDelta(t, monthly*float64(len(result.Projections)), result.Comparison.ProjectedRoundUps, 0.001)
+			if tt.wantMonths > 0 {
+				require.Len(t, result.Projections, tt.wantMonths)
+			}
+
+			cumulative := 0.0
+			for _, p := range result.Projections {
+				assert.InDelta(t, monthly, p.RoundUpSavings, 0.001)
+				cumulative += p.RoundUpSavings
+				assert.InDelta(t, cumulative, p.CumulativeRoundUps, 0.001)
+			}
+		})
+	}
+}
+
+func TestWhatIfWindfall(t *testing.T) {
+	service := NewBacktestServiceWithDefaults(stubBudgets{})
+	budget := Budget{Income: 5000, TotalBudget: 4000}
+
+	tests := []struct {
+		name         string
+		params       WhatIfParameters
+		wantIncome   map[int]float64
+		wantReceived float64
+		wantDeferred float64
+	}{
+		{
+			name:         "cash in the first month",
+			params:       WhatIfParameters{ScenarioType: ScenarioWindfall, WindfallAmount: 10000},
+			wantIncome:   map[int]float64{1: 10000},
+			wantReceived: 10000,
+		},
+		{
+			name:         "taxed cash in a later month",
+			params:       WhatIfParameters{ScenarioType: ScenarioWindfall, WindfallAmount: 10000, WindfallMonth: 3, WindfallTaxRate: 0.25},
+			wantIncome:   map[int]float64{3: 7500},
+			wantReceived: 7500,
+		},
+		{
+			name: "inherited IRA paid out over ten years",
+			params: WhatIfParameters{ScenarioType: ScenarioWindfall, WindfallAmount: 120000, WindfallMonth: 7,
+				WindfallTaxRate: 0.2, WindfallAccount: WindfallAccountInheritedIRA},
+			wantIncome:   map[int]float64{7: 800, 8: 800, 9: 800, 10: 800, 11: 800, 12: 800},
+			wantReceived: 4800,
+			wantDeferred: 96000 - 4800,
+		},
+		{
+			name:         "after the projection",
+			params:       WhatIfParameters{ScenarioType: ScenarioWindfall, WindfallAmount: 10000, WindfallMonth: 13},
+			wantDeferred: 10000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.RunWhatIfAnalysis(context.Background(), "user-1", budget, tt.params)
+			require.NoError(t, err)
+			require.Len(t, result.Projections, 12)
+
+			// The windfall is on top of the usual income
+			first := result.Projections[0]
+			for _, p := range result.Projections {
+				assert.InDelta(t, tt.wantIncome[p.Month], p.WindfallIncome, 1e-9, "month %d", p.Month)
+				assert.InDelta(t, first.ProjectedIncome-first.WindfallIncome, p.ProjectedIncome-p.WindfallIncome, 1e-9)
+			}
+			assert.InDelta(t, tt.wantReceived, result.Comparison.WindfallReceived, 1e-9)
+			assert.InDelta(t, tt.wantDeferred, result.Comparison.WindfallDeferred, 1e-9)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+)
+
+// =============================================================================
+// Bill Negotiation Opportunities
+// =============================================================================
+
+// Recurring bills whose price has gone up more than a threshold over the
+// past year are worth a call: discretionary subscriptions can be cancelled,
+// essential services negotiated or downgraded back to last year's price.
+
+// billNegotiationLookbackMonths is how much history bills and their prices
+// are taken from; yearly bills need two years to show an increase
+const billNegotiationLookbackMonths = 25
+
+// yearAgoSlackDays is how much sooner than a full year before the latest
+// charge a charge may be to count as last year's price
+const yearAgoSlackDays = 15
+
+// BillAction is what to do about a bill whose price has gone up
+type BillAction string
+
+const (
+	// BillActionCancel suggests cancelling a discretionary subscription
+	BillActionCancel BillAction = "cancel"
+	// BillActionNegotiate suggests negotiating or downgrading an essential
+	// service
+	BillActionNegotiate BillAction = "negotiate"
+)
+
+// essentialBillCategories are the categories of bills that are negotiated
+// rather than cancelled
+var essentialBillCategories = map[SpendingCategory]bool{
+	CategoryHousing:        true,
+	CategoryUtilities:      true,
+	CategoryInsurance:      true,
+	CategoryHealthcare:     true,
+	CategoryTransportation: true,
+	CategoryEducation:      true,
+}
+
+// BillNegotiationOpportunity is a recurring bill whose price went up more
+// than the threshold over the past year
+type BillNegotiationOpportunity struct {
+	Merchant string           `json:"merchant"`
+	Category SpendingCategory `json:"category"`
+	Cadence  BillCadence      `json:"cadence"`
+
+	// Price at the latest charge and a year before it
+	CurrentPrice    float64   `json:"current_price"`
+	PreviousPrice   float64   `json:"previous_price"`
+	PreviousDate    time.Time `json:"previous_date"`
+	IncreasePercent float64   `json:"increase_percent"`
+
+	ChargesPerYear float64 `json:"charges_per_year"`
+	AnnualCost     float64 `json:"annual_cost"`
+
+	// Annual savings from cancelling the bill, or from getting it back to
+	// last year's price
+	CancellationSavings float64 `json:"cancellation_savings"`
+	DowngradeSavings    float64 `json:"downgrade_savings"`
+
+	// The suggested action and what it would save a year
+	SuggestedAction        BillAction `json:"suggested_action"`
+	EstimatedAnnualSavings float64    `json:"estimated_annual_savings"`
+
+	PriceHistory []PricePoint `json:"price_history"`
+}
+
+// BillNegotiationReport lists a user's bill negotiation opportunities, the
+// largest savings first
+type BillNegotiationReport struct {
+	UserID             string    `json:"user_id"`
+	AsOf               time.Time `json:"as_of"`
+	MinIncreasePercent float64   `json:"min_increase_percent"`
+
+	// Recurring bills still being charged
+	BillsReviewed int `json:"bills_reviewed"`
+
+	Opportunities         []BillNegotiationOpportunity `json:"opportunities"`
+	TotalEstimatedSavings float64                      `json:"total_estimated_savings"`
+	TotalAnnualIncrease   float64                      `json:"total_annual_increase"`
+}
+
+// DetectBillNegotiationOpportunities flags the user's recurring bills whose
+// price rose more than minIncreasePercent year over year as of asOf. With
+// minIncreasePercent 0 the configured PriceIncreasePercent applies.
+func (s *SpendingService) DetectBillNegotiationOpportunities(
+	ctx context.Context,
+	userID string,
+	asOf time.Time,
+	minIncreasePercent float64,
+) (*BillNegotiationReport, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+	if minIncreasePercent < 0 {
+		return nil, errors.New("minIncreasePercent cannot be negative")
+	}
+	if minIncreasePercent == 0 {
+		minIncreasePercent = s.config.PriceIncreasePercent
+	}
+
+	transactions, err := s.repo.GetByUserID(ctx, userID, asOf.AddDate(0, -billNegotiationLookbackMonths, 0), asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	bills := DetectRecurringBills(transactions, asOf)
+	report := &BillNegotiationReport{
+		UserID:             userID,
+		AsOf:               asOf,
+		MinIncreasePercent: minIncreasePercent,
+		BillsReviewed:      len(bills),
+		Opportunities:      []BillNegotiationOpportunity{},
+	}
+	for _, bill := range bills {
+		opportunity, ok := negotiationOpportunity(bill, minIncreasePercent)
+		if !ok {
+			continue
+		}
+		report.Opportunities = append(report.Opportunities, opportunity)
+		report.TotalEstimatedSavings += opportunity.EstimatedAnnualSavings
+		report.TotalAnnualIncrease += opportunity.DowngradeSavings
+	}
+
+	sort.SliceStable(report.Opportunities, func(i, j int) bool {
+		return report.Opportunities[i].EstimatedAnnualSavings > report.Opportunities[j].EstimatedAnnualSavings
+	})
+	return report, nil
+}
+
+// negotiationOpportunity reports whether bill's price rose more than
+// minIncreasePercent since a year before its latest charge, and if so the
+// opportunity
+func negotiationOpportunity(bill RecurringBill, minIncreasePercent float64) (BillNegotiationOpportunity, bool) {
+	cutoff := bill.LastDate.AddDate(-1, 0, yearAgoSlackDays)
+	var previous *PricePoint
+	for i := range bill.PriceHistory {
+		if bill.PriceHistory[i].Date.After(cutoff) {
+			break
+		}
+		previous = &bill.PriceHistory[i]
+	}
+	if previous == nil || previous.Amount <= 0 {
+		return BillNegotiationOpportunity{}, false
+	}
+
+	current := bill.PriceHistory[len(bill.PriceHistory)-1].Amount
+	increase := (current - previous.Amount) / previous.Amount * 100
+	if increase <= minIncreasePercent {
+		return BillNegotiationOpportunity{}, false
+	}
+
+	chargesPerYear := bill.Cadence.chargesPerYear()
+	opportunity := BillNegotiationOpportunity{
+		Merchant:            bill.Merchant,
+		Category:            bill.Category,
+		Cadence:             bill.Cadence,
+		CurrentPrice:        current,
+		PreviousPrice:       previous.Amount,
+		PreviousDate:        previous.Date,
+		IncreasePercent:     increase,
+		ChargesPerYear:      chargesPerYear,
+		AnnualCost:          current * chargesPerYear,
+		CancellationSavings: current * chargesPerYear,
+		DowngradeSavings:    (current - previous.Amount) * chargesPerYear,
+		PriceHistory:        bill.PriceHistory,
+	}
+	if essentialBillCategories[bill.Category] {
+		opportunity.SuggestedAction = BillActionNegotiate
+		opportunity.EstimatedAnnualSavings = opportunity.DowngradeSavings
+	} else {
+		opportunity.SuggestedAction = BillActionCancel
+		opportunity.EstimatedAnnualSavings = opportunity.CancellationSavings
+	}
+	return opportunity, true
+}
+
+// chargesPerYear returns how many times a year a bill is charged
+func (c BillCadence) chargesPerYear() float64 {
+	switch c {
+	case CadenceWeekly:
+		return 52
+	case CadenceBiweekly:
+		return 26
+	case CadenceQuarterly:
+		return 4
+	case CadenceYearly:
+		return 1
+	default:
+		return 12
+	}
+}
@@ -0,0 +1,98 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// monthlyCharges charges merchant on the 20th of each month from first,
+// at price(month) for the nth month
+func monthlyCharges(merchant string, category SpendingCategory, first time.Time, months int, price func(n int) float64) []Transaction {
+	charges := make([]Transaction, months)
+	for n := range months {
+		charges[n] = Transaction{
+			Amount:          price(n),
+			MerchantName:    merchant,
+			Category:        category,
+			TransactionDate: first.AddDate(0, n, 0),
+		}
+	}
+	return charges
+}
+
+func TestDetectBillNegotiationOpportunities(t *testing.T) {
+	asOf := day(2026, 3, 25)
+	start := day(2025, 3, 20)
+
+	var transactions []Transaction
+	// Up 23% in January, within the price tolerance of one bill
+	transactions = append(transactions, monthlyCharges("StreamCo", CategorySubscriptions, start, 13, func(n int) float64 {
+		if n >= 10 {
+			return 15.99
+		}
+		return 12.99
+	})...)
+	// Up a third in February, which stuck
+	transactions = append(transactions, monthlyCharges("FiberNet", CategoryUtilities, start, 13, func(n int) float64 {
+		if n >= 11 {
+			return 80
+		}
+		return 60
+	})...)
+	// Unchanged
+	transactions = append(transactions, monthlyCharges("Gym", CategoryHealthcare, start, 13, func(int) float64 {
+		return 40
+	})...)
+	// Up under the threshold
+	transactions = append(transactions, monthlyCharges("PhoneCo", CategoryUtilities, start, 13, func(n int) float64 {
+		if n >= 6 {
+			return 53
+		}
+		return 50
+	})...)
+	// Too new to compare with last year
+	transactions = append(transactions, monthlyCharges("NewApp", CategorySubscriptions, day(2025, 11, 20), 5, func(n int) float64 {
+		return 5 + float64(n)
+	})...)
+
+	service := NewSpendingServiceWithDefaults(stubBudgets{transactions: transactions})
+
+	report, err := service.DetectBillNegotiationOpportunities(context.Background(), "user-1", asOf, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, 10.0, report.MinIncreasePercent)
+	assert.Equal(t, 5, report.BillsReviewed)
+	require.Len(t, report.Opportunities, 2)
+
+	internet := report.Opportunities[0]
+	assert.Equal(t, "FiberNet", internet.Merchant)
+	assert.Equal(t, BillActionNegotiate, internet.SuggestedAction)
+	assert.Equal(t, 80.0, internet.CurrentPrice)
+	assert.Equal(t, 60.0, internet.PreviousPrice)
+	assert.Equal(t, start, internet.PreviousDate)
+	assert.InDelta(t, 33.33, internet.IncreasePercent, 0.01)
+	assert.InDelta(t, 960, internet.AnnualCost, 1e-9)
+	assert.InDelta(t, 240, internet.DowngradeSavings, 1e-9)
+	assert.InDelta(t, 240, internet.EstimatedAnnualSavings, 1e-9)
+	assert.Len(t, internet.PriceHistory, 13)
+
+	streaming := report.Opportunities[1]
+	assert.Equal(t, "StreamCo", streaming.Merchant)
+	assert.Equal(t, BillActionCancel, streaming.SuggestedAction)
+	assert.InDelta(t, 15.99*12, streaming.CancellationSavings, 1e-9)
+	assert.InDelta(t, 3*12, streaming.DowngradeSavings, 1e-9)
+	assert.InDelta(t, 15.99*12, streaming.EstimatedAnnualSavings, 1e-9)
+
+	assert.InDelta(t, 240+15.99*12, report.TotalEstimatedSavings, 1e-9)
+	assert.InDelta(t, 240+36, report.TotalAnnualIncrease, 1e-9)
+
+	// A higher threshold leaves only the larger increase
+	report, err = service.DetectBillNegotiationOpportunities(context.Background(), "user-1", asOf, 25)
+	require.NoError(t, err)
+	require.Len(t, report.Opportunities, 1)
+	assert.Equal(t, "FiberNet", report.Opportunities[0].Merchant)
+}
@@ -0,0 +1,182 @@
+package analysis
+
+import (
+	"math"
+)
+
+// =============================================================================
+// Response Shaping Types
+// =============================================================================
+
+// ResponseShape controls how much detail is kept when a backtest result is
+// returned to a client. The zero value keeps the full result.
+type ResponseShape struct {
+	// MaxPoints caps the number of entries in each time series. Zero keeps
+	// every point.
+	MaxPoints int `json:"max_points,omitempty"`
+
+	// OmitCategoryDetails drops per-period category breakdowns, which make up
+	// the bulk of long monthly backtests.
+	OmitCategoryDetails bool `json:"omit_category_details,omitempty"`
+
+	// IncludeTables adds a data table for every chart in visualization
+	// data, built from the shaped series.
+	IncludeTables bool `json:"include_tables,omitempty"`
+}
+
+// MinDownsamplePoints is the smallest series length downsampling will produce.
+// Fewer points than this cannot keep both endpoints of a series.
+const MinDownsamplePoints = 2
+
+// IsZero reports whether the shape leaves results untouched
+func (s ResponseShape) IsZero() bool {
+	return s.MaxPoints == 0 && !s.OmitCategoryDetails && !s.IncludeTables
+}
+
+// Bucket is a half-open [Start, End) range of indices into a series
+type Bucket struct {
+	Start int
+	End   int
+}
+
+// Len returns the number of points in the bucket
+func (b Bucket) Len() int {
+	return b.End - b.Start
+}
+
+// =============================================================================
+// Downsampling Utilities
+// =============================================================================
+
+// DownsampleBuckets splits a series of n points into at most maxPoints
+// contiguous buckets of near-equal size. A maxPoints of zero, or one at least
+// as large as n, yields one bucket per point.
+func DownsampleBuckets(n, maxPoints int) []Bucket {
+	if n <= 0 {
+		return nil
+	}
+	if maxPoints <= 0 || maxPoints >= n {
+		buckets := make([]Bucket, n)
+		for i := range buckets {
+			buckets[i] = Bucket{Start: i, End: i + 1}
+		}
+		return buckets
+	}
+
+	buckets := make([]Bucket, maxPoints)
+	for i := range buckets {
+		buckets[i] = Bucket{
+			Start: i * n / maxPoints,
+			End:   (i + 1) * n / maxPoints,
+		}
+	}
+	return buckets
+}
+
+// DownsampleSeries reduces a chart series to at most maxPoints points using
+// the Largest-Triangle-Three-Buckets algorithm. The first and last points are
+// always kept and the selected points are the ones that best preserve the
+// visual shape of the line.
+func DownsampleSeries(points []ChartDataPoint, maxPoints int) []ChartDataPoint {
+	if maxPoints <= 0 || len(points) <= maxPoints {
+		return points
+	}
+	if maxPoints < MinDownsamplePoints {
+		maxPoints = MinDownsamplePoints
+	}
+
+	sampled := make([]ChartDataPoint, 0, maxPoints)
+	sampled = append(sampled, points[0])
+
+	if maxPoints > MinDownsamplePoints {
+		// Interior points are split into maxPoints-2 buckets
+		inner := DownsampleBuckets(len(points)-2, maxPoints-2)
+		selected := 0
+
+		for i, bucket := range inner {
+			start, end := bucket.Start+1, bucket.End+1
+
+			// Average of the next bucket (or the last point) is the third vertex
+			var nextX, nextY float64
+			if i+1 < len(inner) {
+				next := inner[i+1]
+				for j := next.Start + 1; j < next.End+1; j++ {
+					nextX += float64(j)
+					nextY += points[j].Value
+				}
+				nextX /= float64(next.Len())
+				nextY /= float64(next.Len())
+			} else {
+				nextX = float64(len(points) - 1)
+				nextY = points[len(points)-1].Value
+			}
+
+			prevX, prevY := float64(selected), points[selected].Value
+			best, bestArea := start, -1.0
+			for j := start; j < end; j++ {
+				area := math.Abs((prevX-nextX)*(points[j].Value-prevY) - (prevX-float64(j))*(nextY-prevY))
+				if area > bestArea {
+					best, bestArea = j, area
+				}
+			}
+
+			sampled = append(sampled, points[best])
+			selected = best
+		}
+	}
+
+	return append(sampled, points[len(points)-1])
+}
+
+// =============================================================================
+// Result Shaping Methods
+// =============================================================================
+
+// ShapeVisualizationData returns a copy of viz trimmed according to shape.
+// Line series are downsampled while per-category aggregates are kept, since
+// they do not grow with the length of the backtest. Tables, if requested,
+// match the trimmed charts.
+func (s *BacktestService) ShapeVisualizationData(viz *VisualizationData, shape ResponseShape) *VisualizationData {
+	if viz == nil || shape.IsZero() {
+		return viz
+	}
+
+	shaped := *viz
+	if shape.MaxPoints > 0 {
+		shaped.BudgetVsActualTimeSeries = downsampleTimeSeries(viz.BudgetVsActualTimeSeries, shape.MaxPoints)
+		shaped.ForecastData = downsampleTimeSeries(viz.ForecastData, shape.MaxPoints)
+		shaped.CumulativeSavings = DownsampleSeries(viz.CumulativeSavings, shape.MaxPoints)
+
+		if viz.CategoryTrends != nil {
+			shaped.CategoryTrends = make(map[BudgetCategory][]ChartDataPoint, len(viz.CategoryTrends))
+			for cat, points := range viz.CategoryTrends {
+				shaped.CategoryTrends[cat] = DownsampleSeries(points, shape.MaxPoints)
+			}
+		}
+	}
+
+	// The heatmap holds one cell per category per period
+	if shape.OmitCategoryDetails {
+		shaped.PerformanceHeatmap = nil
+	}
+
+	if shape.IncludeTables {
+		shaped.Tables = s.GenerateVisualizationTables(&shaped)
+	}
+
+	return &shaped
+}
+
+// downsampleTimeSeries downsamples every series in the slice
+func downsampleTimeSeries(series []TimeSeriesData, maxPoints int) []TimeSeriesData {
+	if series == nil {
+		return nil
+	}
+
+	shaped := make([]TimeSeriesData, len(series))
+	for i, ts := range series {
+		ts.Data = DownsampleSeries(ts.Data, maxPoints)
+		shaped[i] = ts
+	}
+	return shaped
+}
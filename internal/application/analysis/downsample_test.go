@@ -0,0 +1,68 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownsampleBuckets(t *testing.T) {
+	tests := []struct {
+		name      string
+		n         int
+		maxPoints int
+		want      []Bucket
+	}{
+		{name: "empty series", n: 0, maxPoints: 3, want: nil},
+		{name: "no limit", n: 3, maxPoints: 0, want: []Bucket{{0, 1}, {1, 2}, {2, 3}}},
+		{name: "limit above length", n: 2, maxPoints: 5, want: []Bucket{{0, 1}, {1, 2}}},
+		{name: "even split", n: 6, maxPoints: 3, want: []Bucket{{0, 2}, {2, 4}, {4, 6}}},
+		{name: "uneven split", n: 7, maxPoints: 3, want: []Bucket{{0, 2}, {2, 4}, {4, 7}}},
+		{name: "single bucket", n: 4, maxPoints: 1, want: []Bucket{{0, 4}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DownsampleBuckets(tt.n, tt.maxPoints)
+			assert.Equal(t, tt.want, got)
+
+			// Buckets cover the series once, in order
+			next := 0
+			for _, b := range got {
+				assert.Equal(t, next, b.Start)
+				assert.Positive(t, b.Len())
+				next = b.End
+			}
+			if tt.n > 0 {
+				assert.Equal(t, tt.n, next)
+			}
+		})
+	}
+}
+
+func TestDownsampleSeries(t *testing.T) {
+	points := make([]ChartDataPoint, 12)
+	for i := range points {
+		points[i] = ChartDataPoint{Label: string(rune('a' + i)), Value: 1}
+	}
+	points[5].Value = 100 // a spike the sampled line must keep
+
+	t.Run("short series is unchanged", func(t *testing.T) {
+		assert.Equal(t, points, DownsampleSeries(points, len(points)))
+		assert.Equal(t, points, DownsampleSeries(points, 0))
+	})
+
+	t.Run("keeps endpoints and shape", func(t *testing.T) {
+		sampled := DownsampleSeries(points, 5)
+		require.Len(t, sampled, 5)
+		assert.Equal(t, points[0], sampled[0])
+		assert.Equal(t, points[len(points)-1], sampled[len(sampled)-1])
+		assert.Contains(t, sampled, points[5])
+	})
+
+	t.Run("raises limit to the minimum", func(t *testing.T) {
+		sampled := DownsampleSeries(points, 1)
+		assert.Equal(t, []ChartDataPoint{points[0], points[len(points)-1]}, sampled)
+	})
+}
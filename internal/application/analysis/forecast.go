@@ -0,0 +1,276 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// =============================================================================
+// Spending Forecasts
+// =============================================================================
+
+// Spending is forecast per category from its monthly history: a linear
+// trend fitted to the history, scaled by each calendar month's seasonal
+// index once there are two years of history to find seasons in. The
+// confidence band is the trend's prediction interval, which widens the
+// further out the forecast reaches.
+
+// Forecasts reach from MinForecastMonths to MaxForecastMonths ahead
+const (
+	MinForecastMonths = 3
+	MaxForecastMonths = 12
+)
+
+// seasonalForecastPeriods is how much monthly history a seasonal forecast
+// needs: two years, so one unusual month isn't taken for a season
+const seasonalForecastPeriods = 24
+
+// ForecastPoint is the spending forecast for a period, with the bounds of
+// its confidence band
+type ForecastPoint struct {
+	PeriodStart time.Time `json:"period_start"`
+	Amount      float64   `json:"amount"`
+	Lower       float64   `json:"lower"`
+	Upper       float64   `json:"upper"`
+}
+
+// CategoryForecast is the spending forecast for a category
+type CategoryForecast struct {
+	Category SpendingCategory `json:"category"`
+
+	// HistoryMonths is how many months the forecast was fitted to, from
+	// the first with spending in the category
+	HistoryMonths  int            `json:"history_months"`
+	AverageMonthly float64        `json:"average_monthly"`
+	Trend          TrendDirection `json:"trend"`
+	Seasonal       bool           `json:"seasonal"`
+
+	Points []ForecastPoint `json:"points"`
+	Total  float64         `json:"total"`
+}
+
+// SpendingForecast forecasts a user's spending for the coming months, by
+// category and in total
+type SpendingForecast struct {
+	UserID     string    `json:"user_id"`
+	AsOf       time.Time `json:"as_of"`
+	Months     int       `json:"months"`
+	Confidence float64   `json:"confidence"`
+
+	// Categories are those with enough history, the largest forecast first
+	Categories []CategoryForecast `json:"categories"`
+	Total      []ForecastPoint    `json:"total"`
+}
+
+// ForecastConfig holds configuration for spending forecasts
+type ForecastConfig struct {
+	HistoryMonths    int     // Months of history forecasts are fitted to
+	MinHistoryMonths int     // Fewest months of history a category is forecast from
+	Confidence       float64 // Confidence level of the bands, between 0 and 1
+}
+
+// DefaultForecastConfig returns a config with reasonable defaults
+func DefaultForecastConfig() ForecastConfig {
+	return ForecastConfig{
+		HistoryMonths:    24,
+		MinHistoryMonths: 3,
+		Confidence:       0.8,
+	}
+}
+
+// ForecastService forecasts spending from a user's transaction history
+type ForecastService struct {
+	spending *SpendingService
+	config   ForecastConfig
+}
+
+// NewForecastService creates a new forecast service
+func NewForecastService(repo TransactionRepository, config ForecastConfig) *ForecastService {
+	return &ForecastService{
+		spending: NewSpendingServiceWithDefaults(repo),
+		config:   config,
+	}
+}
+
+// NewForecastServiceWithDefaults creates a forecast service with default
+// configuration
+func NewForecastServiceWithDefaults(repo TransactionRepository) *ForecastService {
+	return NewForecastService(repo, DefaultForecastConfig())
+}
+
+// ForecastSpending forecasts the user's spending for the months months
+// starting with asOf's, fitted to the complete months before it
+func (s *ForecastService) ForecastSpending(ctx context.Context, userID string, months int, asOf time.Time) (*SpendingForecast, error) {
+	if months < MinForecastMonths || months > MaxForecastMonths {
+		return nil, fmt.Errorf("months must be between %d and %d", MinForecastMonths, MaxForecastMonths)
+	}
+	if s.config.Confidence <= 0 || s.config.Confidence >= 1 {
+		return nil, errors.New("confidence must be between 0 and 1")
+	}
+
+	thisMonth := time.Date(asOf.Year(), asOf.Month(), 1, 0, 0, 0, 0, asOf.Location())
+	start := thisMonth.AddDate(0, -s.config.HistoryMonths, 0)
+	overTime, err := s.spending.analyzeSpending(ctx, userID, start, thisMonth.Add(-time.Nanosecond), PeriodMonthly, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Monthly totals, oldest first
+	monthIndex := func(t time.Time) int {
+		return (t.Year()-start.Year())*12 + int(t.Month()) - int(start.Month())
+	}
+	totals := make([]float64, s.config.HistoryMonths)
+	byCategory := make(map[SpendingCategory][]float64)
+	firstSpending := s.config.HistoryMonths
+	for _, p := range overTime.Periods {
+		i := monthIndex(p.StartDate)
+		if i < 0 || i >= s.config.HistoryMonths {
+			continue
+		}
+		totals[i] += p.TotalAmount
+		firstSpending = min(firstSpending, i)
+		for cat, spending := range p.ByCategory {
+			if byCategory[cat] == nil {
+				byCategory[cat] = make([]float64, s.config.HistoryMonths)
+			}
+			byCategory[cat][i] += spending.Amount
+		}
+	}
+
+	forecast := &SpendingForecast{
+		UserID:     userID,
+		AsOf:       asOf,
+		Months:     months,
+		Confidence: s.config.Confidence,
+		Categories: []CategoryForecast{},
+		Total:      []ForecastPoint{},
+	}
+	if s.config.HistoryMonths-firstSpending >= s.config.MinHistoryMonths {
+		forecast.Total = datedForecast(forecastSeries(totals[firstSpending:], months, s.config.Confidence, true), thisMonth)
+	}
+
+	for cat, monthly := range byCategory {
+		// A category's history starts when spending in it did
+		first := 0
+		for first < len(monthly) && monthly[first] == 0 {
+			first++
+		}
+		history := monthly[first:]
+		if len(history) < s.config.MinHistoryMonths {
+			continue
+		}
+
+		average := mean(history)
+		slope, _, _ := linearRegression(history)
+		trend := TrendStable
+		if slope > average*0.05 {
+			trend = TrendIncreasing
+		} else if slope < -average*0.05 {
+			trend = TrendDecreasing
+		}
+
+		points := datedForecast(forecastSeries(history, months, s.config.Confidence, true), thisMonth)
+		total := 0.0
+		for _, p := range points {
+			total += p.Amount
+		}
+		forecast.Categories = append(forecast.Categories, CategoryForecast{
+			Category:       cat,
+			HistoryMonths:  len(history),
+			AverageMonthly: average,
+			Trend:          trend,
+			Seasonal:       len(history) >= seasonalForecastPeriods,
+			Points:         points,
+			Total:          total,
+		})
+	}
+
+	sort.Slice(forecast.Categories, func(i, j int) bool {
+		if forecast.Categories[i].Total != forecast.Categories[j].Total {
+			return forecast.Categories[i].Total > forecast.Categories[j].Total
+		}
+		return forecast.Categories[i].Category < forecast.Categories[j].Category
+	})
+	return forecast, nil
+}
+
+// datedForecast dates monthly forecast points, the first in the month
+// starting first
+func datedForecast(points []ForecastPoint, first time.Time) []ForecastPoint {
+	for i := range points {
+		points[i].PeriodStart = first.AddDate(0, i, 0)
+	}
+	return points
+}
+
+// forecastSeries forecasts the horizon periods after history, oldest first,
+// with confidence bands at the given confidence level. Monthly histories
+// may be seasonal: with seasonalForecastPeriods of history the trend is
+// fitted to the deseasonalized history and the forecast scaled back by
+// each month's seasonal index. Forecasts and bounds never go below zero.
+func forecastSeries(history []float64, horizon int, confidence float64, monthly bool) []ForecastPoint {
+	n := len(history)
+	if n == 0 || horizon <= 0 {
+		return nil
+	}
+
+	var seasons []float64
+	if monthly && n >= seasonalForecastPeriods {
+		seasons = seasonalIndices(history)
+	}
+	adjusted := history
+	if seasons != nil {
+		adjusted = make([]float64, n)
+		for i, value := range history {
+			adjusted[i] = value
+			if seasons[i%12] > 0 {
+				adjusted[i] = value / seasons[i%12]
+			}
+		}
+	}
+
+	slope, intercept, _ := linearRegression(adjusted)
+	if n < 2 {
+		slope, intercept = 0, history[0]
+	}
+
+	// The standard error of the fit, and the spread of the periods fitted
+	// to, which the prediction interval widens with
+	var squaredErrors float64
+	for i, value := range adjusted {
+		residual := value - (intercept + slope*float64(i))
+		squaredErrors += residual * residual
+	}
+	standardError := 0.0
+	if n > 2 {
+		standardError = math.Sqrt(squaredErrors / float64(n-2))
+	}
+	xMean := float64(n-1) / 2
+	sxx := float64(n) * float64(n*n-1) / 12
+	z := math.Sqrt2 * math.Erfinv(confidence)
+
+	points := make([]ForecastPoint, horizon)
+	for h := range horizon {
+		x := float64(n + h)
+		amount := intercept + slope*x
+		margin := 0.0
+		if sxx > 0 {
+			margin = z * standardError * math.Sqrt(1+1/float64(n)+(x-xMean)*(x-xMean)/sxx)
+		}
+		if seasons != nil {
+			season := seasons[(n+h)%12]
+			amount *= season
+			margin *= season
+		}
+		points[h] = ForecastPoint{
+			Amount: math.Max(0, amount),
+			Lower:  math.Max(0, amount-margin),
+			Upper:  math.Max(0, amount+margin),
+		}
+	}
+	return points
+}
@@ -0,0 +1,142 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForecastSeriesLinear(t *testing.T) {
+	history := []float64{100, 110, 120, 130, 140, 150}
+
+	points := forecastSeries(history, 3, 0.8, true)
+	require.Len(t, points, 3)
+	for i, want := range []float64{160, 170, 180} {
+		assert.InDelta(t, want, points[i].Amount, 1e-9)
+		// A perfect fit leaves nothing to be unsure of
+		assert.InDelta(t, want, points[i].Lower, 1e-9)
+		assert.InDelta(t, want, points[i].Upper, 1e-9)
+	}
+}
+
+func TestForecastSeriesBands(t *testing.T) {
+	history := []float64{100, 130, 95, 120, 105, 140, 98, 125}
+
+	points := forecastSeries(history, 6, 0.8, false)
+	require.Len(t, points, 6)
+	for i, p := range points {
+		assert.Less(t, p.Lower, p.Amount)
+		assert.Greater(t, p.Upper, p.Amount)
+		if i > 0 {
+			assert.Greater(t, p.Upper-p.Lower, points[i-1].Upper-points[i-1].Lower, "bands widen further out")
+		}
+	}
+
+	wider := forecastSeries(history, 6, 0.95, false)
+	assert.Greater(t, wider[0].Upper-wider[0].Lower, points[0].Upper-points[0].Lower)
+}
+
+func TestForecastSeriesSeasonal(t *testing.T) {
+	// Flat, but double in December
+	var history []float64
+	for i := range 24 {
+		if i%12 == 11 {
+			history = append(history, 200)
+		} else {
+			history = append(history, 100)
+		}
+	}
+
+	points := forecastSeries(history, 12, 0.8, true)
+	require.Len(t, points, 12)
+	assert.InDelta(t, 2, points[11].Amount/points[0].Amount, 1e-9)
+	for _, p := range points[:11] {
+		assert.InDelta(t, points[0].Amount, p.Amount, 1e-9)
+	}
+
+	// Without two years of history a December is just a December
+	points = forecastSeries(history[12:], 12, 0.8, true)
+	assert.Less(t, points[11].Amount/points[0].Amount, 1.5)
+}
+
+func TestForecastSpending(t *testing.T) {
+	asOf := day(2026, 3, 10)
+
+	// Rent held steady for a year; groceries started in October and grow
+	var transactions []Transaction
+	transactions = append(transactions, monthlyCharges("Landlord", CategoryHousing, day(2025, 3, 1), 12, func(n int) float64 {
+		return 1500
+	})...)
+	transactions = append(transactions, monthlyCharges("GroceryMart", CategoryGroceries, day(2025, 10, 5), 5, func(n int) float64 {
+		return 300 + 20*float64(n)
+	})...)
+	// This month's spending isn't complete, so isn't fitted to
+	transactions = append(transactions, Transaction{Amount: 5000, Category: CategoryHousing, TransactionDate: day(2026, 3, 2)})
+	// One month isn't enough to forecast from
+	transactions = append(transactions, Transaction{Amount: 80, Category: CategoryEntertainment, TransactionDate: day(2026, 2, 14)})
+
+	service := NewForecastServiceWithDefaults(stubBudgets{transactions: transactions})
+
+	forecast, err := service.ForecastSpending(context.Background(), "user-1", 6, asOf)
+	require.NoError(t, err)
+	assert.Equal(t, 6, forecast.Months)
+	assert.Equal(t, 0.8, forecast.Confidence)
+	require.Len(t, forecast.Categories, 2)
+
+	housing := forecast.Categories[0]
+	assert.Equal(t, CategoryHousing, housing.Category)
+	assert.Equal(t, 12, housing.HistoryMonths)
+	assert.Equal(t, TrendStable, housing.Trend)
+	assert.False(t, housing.Seasonal)
+	require.Len(t, housing.Points, 6)
+	assert.Equal(t, day(2026, 3, 1), housing.Points[0].PeriodStart)
+	assert.Equal(t, day(2026, 8, 1), housing.Points[5].PeriodStart)
+	assert.InDelta(t, 1500, housing.Points[0].Amount, 1e-6)
+	assert.InDelta(t, 9000, housing.Total, 1e-6)
+
+	groceries := forecast.Categories[1]
+	assert.Equal(t, CategoryGroceries, groceries.Category)
+	assert.Equal(t, 5, groceries.HistoryMonths)
+	assert.Equal(t, TrendIncreasing, groceries.Trend)
+	assert.InDelta(t, 400, groceries.Points[0].Amount, 1e-6)
+	assert.InDelta(t, 500, groceries.Points[5].Amount, 1e-6)
+
+	require.Len(t, forecast.Total, 6)
+	assert.Equal(t, day(2026, 3, 1), forecast.Total[0].PeriodStart)
+	assert.Greater(t, forecast.Total[0].Upper, forecast.Total[0].Lower)
+
+	_, err = service.ForecastSpending(context.Background(), "user-1", 2, asOf)
+	assert.Error(t, err)
+	_, err = service.ForecastSpending(context.Background(), "user-1", 13, asOf)
+	assert.Error(t, err)
+}
+
+func TestGenerateVisualizationDataForecast(t *testing.T) {
+	service := NewBacktestServiceWithDefaults(stubBudgets{})
+
+	result := &BacktestResult{Period: BacktestPeriodMonthly}
+	start := day(2025, 1, 1)
+	for i := range 6 {
+		result.PeriodResults = append(result.PeriodResults, PeriodBacktestResult{
+			PeriodStart:  start.AddDate(0, i, 0),
+			ActualAmount: 1000 + 50*float64(i),
+		})
+	}
+
+	viz := service.GenerateVisualizationData(result)
+	require.Len(t, viz.ForecastData, 3)
+	forecast := viz.ForecastData[0]
+	assert.Equal(t, "Forecast", forecast.Series)
+	require.Len(t, forecast.Data, 6)
+	assert.Equal(t, "2025-07-01", forecast.Data[0].Date)
+	assert.Equal(t, "Jul 2025", forecast.Data[0].Label)
+	assert.InDelta(t, 1300, forecast.Data[0].Value, 1e-9)
+	assert.Equal(t, "Forecast Lower", viz.ForecastData[1].Series)
+	assert.Equal(t, "Forecast Upper", viz.ForecastData[2].Series)
+
+	// Too little history to forecast from
+	result.PeriodResults = result.PeriodResults[:1]
+	assert.Nil(t, service.GenerateVisualizationData(result).ForecastData)
+}
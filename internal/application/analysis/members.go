@@ -0,0 +1,158 @@
+package analysis
+
+import (
+	"sort"
+	"time"
+)
+
+// =============================================================================
+// Household Members
+// =============================================================================
+
+// Spending in a household is attributed to its members through
+// Transaction.MemberID; spending attributed to no one is shared. A budget's
+// own amounts cover shared spending, and each member's sub-budget rolls up
+// into the household budget.
+
+// MemberSpending is a household member's share of spending
+type MemberSpending struct {
+	// MemberID is empty for shared spending
+	MemberID         string             `json:"member_id"`
+	Amount           float64            `json:"amount"`
+	TransactionCount int                `json:"transaction_count"`
+	Percentage       float64            `json:"percentage"`
+	ByCategory       []CategorySpending `json:"by_category"`
+}
+
+// MemberBudget is a household member's sub-budget
+type MemberBudget struct {
+	MemberID        string                     `json:"member_id"`
+	TotalBudget     float64                    `json:"total_budget"`
+	CategoryBudgets map[BudgetCategory]float64 `json:"category_budgets"`
+}
+
+// MemberBudgetResult is how a member's spending compared with their
+// sub-budget in one period
+type MemberBudgetResult struct {
+	MemberID         string                     `json:"member_id"`
+	BudgetedAmount   float64                    `json:"budgeted_amount"`
+	ActualAmount     float64                    `json:"actual_amount"`
+	Variance         float64                    `json:"variance"`
+	VariancePercent  float64                    `json:"variance_percent"`
+	Performance      BudgetPerformance          `json:"performance"`
+	CategoryResults  []BudgetCategoryAllocation `json:"category_results"`
+	TransactionCount int                        `json:"transaction_count"`
+}
+
+// householdBudget returns the budget with its members' sub-budgets rolled up
+// into its totals
+func householdBudget(budget Budget) Budget {
+	if len(budget.MemberBudgets) == 0 {
+		return budget
+	}
+
+	categoryBudgets := make(map[BudgetCategory]float64, len(budget.CategoryBudgets))
+	for category, amount := range budget.CategoryBudgets {
+		categoryBudgets[category] = amount
+	}
+	for _, member := range budget.MemberBudgets {
+		budget.TotalBudget += member.TotalBudget
+		for category, amount := range member.CategoryBudgets {
+			categoryBudgets[category] += amount
+		}
+	}
+	budget.CategoryBudgets = categoryBudgets
+	return budget
+}
+
+// memberBreakdown splits spending by household member, largest share first.
+// It is nil when no spending is attributed to a member.
+func memberBreakdown(transactions []Transaction) []MemberSpending {
+	byMember := make(map[string][]Transaction)
+	attributed := false
+	total := 0.0
+	for _, t := range transactions {
+		byMember[t.MemberID] = append(byMember[t.MemberID], t)
+		attributed = attributed || t.MemberID != ""
+		total += t.Amount
+	}
+	if !attributed {
+		return nil
+	}
+
+	members := make([]MemberSpending, 0, len(byMember))
+	for memberID, memberTransactions := range byMember {
+		amounts := make(map[SpendingCategory]*CategorySpending)
+		member := MemberSpending{
+			MemberID:         memberID,
+			TransactionCount: len(memberTransactions),
+		}
+		for _, t := range memberTransactions {
+			member.Amount += t.Amount
+			cs, ok := amounts[t.Category]
+			if !ok {
+				cs = &CategorySpending{Category: t.Category}
+				amounts[t.Category] = cs
+			}
+			cs.Amount += t.Amount
+			cs.TransactionCount++
+		}
+		if total > 0 {
+			member.Percentage = member.Amount / total * 100
+		}
+		for _, cs := range amounts {
+			if member.Amount > 0 {
+				cs.Percentage = cs.Amount / member.Amount * 100
+			}
+			cs.AverageTransaction = cs.Amount / float64(cs.TransactionCount)
+			member.ByCategory = append(member.ByCategory, *cs)
+		}
+		sort.Slice(member.ByCategory, func(i, j int) bool {
+			return member.ByCategory[i].Amount > member.ByCategory[j].Amount
+		})
+		members = append(members, member)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].Amount != members[j].Amount {
+			return members[i].Amount > members[j].Amount
+		}
+		return members[i].MemberID < members[j].MemberID
+	})
+	return members
+}
+
+// memberBudgetResults compares each member's spending in a period with
+// their sub-budget
+func (s *BacktestService) memberBudgetResults(
+	transactions []Transaction,
+	budget Budget,
+	periodStart, periodEnd time.Time,
+) []MemberBudgetResult {
+	if len(budget.MemberBudgets) == 0 {
+		return nil
+	}
+
+	byMember := make(map[string][]Transaction)
+	for _, t := range transactions {
+		byMember[t.MemberID] = append(byMember[t.MemberID], t)
+	}
+
+	results := make([]MemberBudgetResult, len(budget.MemberBudgets))
+	for i, member := range budget.MemberBudgets {
+		period := s.calculatePeriodResult(byMember[member.MemberID], Budget{
+			TotalBudget:     member.TotalBudget,
+			CategoryBudgets: member.CategoryBudgets,
+		}, periodStart, periodEnd)
+		results[i] = MemberBudgetResult{
+			MemberID:         member.MemberID,
+			BudgetedAmount:   period.BudgetedAmount,
+			ActualAmount:     period.ActualAmount,
+			Variance:         period.Variance,
+			VariancePercent:  period.VariancePercent,
+			Performance:      period.Performance,
+			CategoryResults:  period.CategoryResults,
+			TransactionCount: period.TransactionCount,
+		}
+	}
+	return results
+}
@@ -0,0 +1,90 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHouseholdBudgetRollsUpMembers(t *testing.T) {
+	budget := Budget{
+		TotalBudget:     1000,
+		CategoryBudgets: map[BudgetCategory]float64{BudgetCategoryHousing: 800},
+		MemberBudgets: []MemberBudget{
+			{MemberID: "alex", TotalBudget: 300, CategoryBudgets: map[BudgetCategory]float64{BudgetCategoryFood: 200, BudgetCategoryPersonal: 100}},
+			{MemberID: "sam", TotalBudget: 150, CategoryBudgets: map[BudgetCategory]float64{BudgetCategoryFood: 150}},
+		},
+	}
+
+	household := householdBudget(budget)
+
+	assert.Equal(t, 1450.0, household.TotalBudget)
+	assert.Equal(t, map[BudgetCategory]float64{
+		BudgetCategoryHousing:  800,
+		BudgetCategoryFood:     350,
+		BudgetCategoryPersonal: 100,
+	}, household.CategoryBudgets)
+	// The caller's budget is left as it was
+	assert.Equal(t, map[BudgetCategory]float64{BudgetCategoryHousing: 800}, budget.CategoryBudgets)
+}
+
+func TestMemberBreakdown(t *testing.T) {
+	assert.Nil(t, memberBreakdown([]Transaction{{Amount: 10, Category: CategoryGroceries}}))
+
+	members := memberBreakdown([]Transaction{
+		{Amount: 60, Category: CategoryGroceries, MemberID: "alex"},
+		{Amount: 15, Category: CategoryDining, MemberID: "alex"},
+		{Amount: 20, Category: CategoryDining, MemberID: "sam"},
+		{Amount: 5, Category: CategoryGroceries},
+	})
+
+	require.Len(t, members, 3)
+	assert.Equal(t, "alex", members[0].MemberID)
+	assert.Equal(t, 75.0, members[0].Amount)
+	assert.Equal(t, 2, members[0].TransactionCount)
+	assert.InDelta(t, 75.0, members[0].Percentage, 1e-9)
+	require.Len(t, members[0].ByCategory, 2)
+	assert.Equal(t, CategoryGroceries, members[0].ByCategory[0].Category)
+	assert.InDelta(t, 80.0, members[0].ByCategory[0].Percentage, 1e-9)
+	assert.Equal(t, "sam", members[1].MemberID)
+	assert.Equal(t, "", members[2].MemberID)
+	assert.Equal(t, 5.0, members[2].Amount)
+}
+
+func TestHistoricalBacktestMemberResults(t *testing.T) {
+	month := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	repo := stubBudgets{transactions: []Transaction{
+		{Amount: 120, Category: CategoryGroceries, MemberID: "alex", TransactionDate: month.AddDate(0, 0, 3)},
+		{Amount: 40, Category: CategoryDining, MemberID: "sam", TransactionDate: month.AddDate(0, 0, 9)},
+		{Amount: 500, Category: CategoryGroceries, TransactionDate: month.AddDate(0, 0, 12)},
+	}}
+	service := NewBacktestServiceWithDefaults(repo)
+
+	result, err := service.RunHistoricalBacktest(context.Background(), "user-1", Budget{
+		Period:      BacktestPeriodMonthly,
+		TotalBudget: 600,
+		MemberBudgets: []MemberBudget{
+			{MemberID: "alex", TotalBudget: 100},
+			{MemberID: "sam", TotalBudget: 100},
+		},
+	}, month, month.AddDate(0, 1, -1))
+	require.NoError(t, err)
+
+	require.Len(t, result.PeriodResults, 1)
+	period := result.PeriodResults[0]
+	assert.Equal(t, 800.0, period.BudgetedAmount)
+	assert.Equal(t, 660.0, period.ActualAmount)
+
+	require.Len(t, period.MemberResults, 2)
+	alex, sam := period.MemberResults[0], period.MemberResults[1]
+	assert.Equal(t, "alex", alex.MemberID)
+	assert.Equal(t, 120.0, alex.ActualAmount)
+	assert.Equal(t, 1, alex.TransactionCount)
+	assert.Less(t, alex.Variance, 0.0)
+	assert.Equal(t, "sam", sam.MemberID)
+	assert.Equal(t, 40.0, sam.ActualAmount)
+	assert.Greater(t, sam.Variance, 0.0)
+}
@@ -0,0 +1,100 @@
+package analysis
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// =============================================================================
+// Raise Detection
+// =============================================================================
+
+// Paychecks are detected like bills, as deposits from the same payer at a
+// regular interval. A raise is a step up in pay that holds for at least two
+// paychecks, so a one-off bonus or overtime check isn't mistaken for one.
+
+// IncomeRepository provides a user's income deposits
+type IncomeRepository interface {
+	GetIncome(ctx context.Context, userID string, startDate, endDate time.Time) ([]Transaction, error)
+}
+
+// RaiseLookbackMonths is how much deposit history paychecks are detected
+// from
+const RaiseLookbackMonths = 13
+
+// DefaultMinRaisePercent is the least pay increase counted as a raise
+const DefaultMinRaisePercent = 2.0
+
+// raiseConfirmingPaychecks is how many paychecks at the new pay make a raise
+const raiseConfirmingPaychecks = 2
+
+// Raise is a lasting increase in a recurring paycheck
+type Raise struct {
+	Source        string      `json:"source"`
+	Cadence       BillCadence `json:"cadence"`
+	PreviousPay   float64     `json:"previous_pay"`
+	CurrentPay    float64     `json:"current_pay"`
+	EffectiveDate time.Time   `json:"effective_date"`
+
+	IncreasePercent float64 `json:"increase_percent"`
+	AnnualIncrease  float64 `json:"annual_increase"`
+}
+
+// DetectRaises finds the latest raise of each paycheck in income of at least
+// minIncreasePercent, most recent first
+func DetectRaises(income []Transaction, now time.Time, minIncreasePercent float64) []Raise {
+	var raises []Raise
+	for _, paycheck := range DetectRecurringBills(income, now) {
+		if raise, ok := latestRaise(paycheck, minIncreasePercent); ok {
+			raises = append(raises, raise)
+		}
+	}
+
+	sort.SliceStable(raises, func(i, j int) bool {
+		return raises[i].EffectiveDate.After(raises[j].EffectiveDate)
+	})
+	return raises
+}
+
+// latestRaise reports whether a paycheck's pay last stepped up by at least
+// minIncreasePercent and stayed up, and if so the raise
+func latestRaise(paycheck RecurringBill, minIncreasePercent float64) (Raise, bool) {
+	history := paycheck.PriceHistory
+	threshold := 1 + minIncreasePercent/100
+
+	for i := len(history) - raiseConfirmingPaychecks; i >= 1; i-- {
+		before := history[i-1].Amount
+		held := true
+		for _, p := range history[i:] {
+			held = held && p.Amount >= before*threshold
+		}
+		if !held {
+			continue
+		}
+
+		previous := make([]float64, 0, billPriceCharges)
+		for _, p := range history[max(0, i-billPriceCharges):i] {
+			previous = append(previous, p.Amount)
+		}
+		current := make([]float64, 0, billPriceCharges)
+		for _, p := range history[max(i, len(history)-billPriceCharges):] {
+			current = append(current, p.Amount)
+		}
+
+		previousPay, currentPay := median(previous), median(current)
+		if previousPay <= 0 || currentPay < previousPay*threshold {
+			continue
+		}
+		return Raise{
+			Source:          paycheck.Merchant,
+			Cadence:         paycheck.Cadence,
+			PreviousPay:     previousPay,
+			CurrentPay:      currentPay,
+			EffectiveDate:   history[i].Date,
+			IncreasePercent: (currentPay - previousPay) / previousPay * 100,
+			AnnualIncrease:  (currentPay - previousPay) * paycheck.Cadence.chargesPerYear(),
+		}, true
+	}
+	return Raise{}, false
+}
@@ -0,0 +1,62 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectRaises(t *testing.T) {
+	now := day(2026, 3, 25)
+	start := day(2025, 3, 20)
+
+	var income []Transaction
+	// A 5% raise from November
+	income = append(income, monthlyCharges("Acme Payroll", CategoryOther, start, 13, func(n int) float64 {
+		if n >= 8 {
+			return 4200
+		}
+		return 4000
+	})...)
+	// A one-off bonus in the latest paycheck
+	income = append(income, monthlyCharges("Side Gig", CategoryOther, start, 13, func(n int) float64 {
+		if n == 12 {
+			return 1100
+		}
+		return 1000
+	})...)
+	// Varies a little, under the threshold
+	income = append(income, monthlyCharges("Rent Income", CategoryOther, start, 13, func(n int) float64 {
+		return 1500 + float64(n%2)*10
+	})...)
+
+	raises := DetectRaises(income, now, DefaultMinRaisePercent)
+
+	require.Len(t, raises, 1)
+	raise := raises[0]
+	assert.Equal(t, "Acme Payroll", raise.Source)
+	assert.Equal(t, CadenceMonthly, raise.Cadence)
+	assert.Equal(t, day(2025, 11, 20), raise.EffectiveDate)
+	assert.Equal(t, 4000.0, raise.PreviousPay)
+	assert.Equal(t, 4200.0, raise.CurrentPay)
+	assert.InDelta(t, 5, raise.IncreasePercent, 1e-9)
+	assert.InDelta(t, 2400, raise.AnnualIncrease, 1e-9)
+
+	// Two raises in a row report the latest
+	income = monthlyCharges("Acme Payroll", CategoryOther, start, 13, func(n int) float64 {
+		switch {
+		case n >= 10:
+			return 4400
+		case n >= 5:
+			return 4200
+		}
+		return 4000
+	})
+	raises = DetectRaises(income, now, DefaultMinRaisePercent)
+	require.Len(t, raises, 1)
+	assert.Equal(t, day(2026, 1, 20), raises[0].EffectiveDate)
+	assert.Equal(t, 4200.0, raises[0].PreviousPay)
+
+	assert.Empty(t, DetectRaises(income, now, 10))
+}
@@ -0,0 +1,89 @@
+package analysis
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Budget Reallocations
+// =============================================================================
+
+// Money can be moved between a budget's categories mid-period. A
+// reallocation changes the category budgets of the period containing its
+// effective date only; the budget's total is unchanged. Backtests compare
+// each period's spending with its reallocated figures.
+
+// BudgetCategories are the categories a budget allocates to
+var BudgetCategories = []BudgetCategory{
+	BudgetCategoryHousing,
+	BudgetCategoryFood,
+	BudgetCategoryTransportation,
+	BudgetCategoryUtilities,
+	BudgetCategoryHealthcare,
+	BudgetCategoryEntertainment,
+	BudgetCategoryDebt,
+	BudgetCategorySavings,
+	BudgetCategoryPersonal,
+	BudgetCategoryOther,
+}
+
+// IsValid reports whether c is one of BudgetCategories
+func (c BudgetCategory) IsValid() bool {
+	for _, category := range BudgetCategories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// BudgetReallocation moves an amount between two of a budget's categories
+type BudgetReallocation struct {
+	ID            string         `json:"id"`
+	FromCategory  BudgetCategory `json:"from_category"`
+	ToCategory    BudgetCategory `json:"to_category"`
+	Amount        float64        `json:"amount"`
+	EffectiveDate time.Time      `json:"effective_date"`
+	Note          string         `json:"note,omitempty"`
+	CreatedAt     time.Time      `json:"created_at"`
+}
+
+// ReallocationRepository looks up the reallocations of users' budgets,
+// oldest first
+type ReallocationRepository interface {
+	GetReallocations(ctx context.Context, userID, budgetID string) ([]BudgetReallocation, error)
+}
+
+// reallocatedBudget returns the budget for a period with the reallocations
+// effective in it applied in order, and the reallocations as applied. A
+// category can't give more than it has left, so a reallocation moves at
+// most that; one that would move nothing is skipped.
+func reallocatedBudget(budget Budget, periodStart, periodEnd time.Time) (Budget, []BudgetReallocation) {
+	var applied []BudgetReallocation
+	for _, r := range budget.Reallocations {
+		if r.EffectiveDate.Before(periodStart) || r.EffectiveDate.After(periodEnd) {
+			continue
+		}
+		if applied == nil {
+			categoryBudgets := make(map[BudgetCategory]float64, len(budget.CategoryBudgets)+1)
+			for category, amount := range budget.CategoryBudgets {
+				categoryBudgets[category] = amount
+			}
+			budget.CategoryBudgets = categoryBudgets
+			applied = []BudgetReallocation{}
+		}
+
+		r.Amount = min(r.Amount, budget.CategoryBudgets[r.FromCategory])
+		if r.Amount <= 0 {
+			continue
+		}
+		budget.CategoryBudgets[r.FromCategory] -= r.Amount
+		budget.CategoryBudgets[r.ToCategory] += r.Amount
+		applied = append(applied, r)
+	}
+	if len(applied) == 0 {
+		return budget, nil
+	}
+	return budget, applied
+}
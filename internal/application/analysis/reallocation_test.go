@@ -0,0 +1,89 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReallocatedBudget(t *testing.T) {
+	march := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	marchEnd := march.AddDate(0, 1, 0).Add(-time.Nanosecond)
+	budget := Budget{
+		TotalBudget: 1000,
+		CategoryBudgets: map[BudgetCategory]float64{
+			BudgetCategoryFood:          400,
+			BudgetCategoryEntertainment: 100,
+		},
+		Reallocations: []BudgetReallocation{
+			{ID: "before", FromCategory: BudgetCategoryFood, ToCategory: BudgetCategoryHousing, Amount: 50, EffectiveDate: march.AddDate(0, 0, -1)},
+			{ID: "first", FromCategory: BudgetCategoryEntertainment, ToCategory: BudgetCategoryFood, Amount: 60, EffectiveDate: march.AddDate(0, 0, 4)},
+			{ID: "capped", FromCategory: BudgetCategoryEntertainment, ToCategory: BudgetCategoryPersonal, Amount: 75, EffectiveDate: march.AddDate(0, 0, 9)},
+			{ID: "empty", FromCategory: BudgetCategoryEntertainment, ToCategory: BudgetCategoryFood, Amount: 10, EffectiveDate: marchEnd},
+		},
+	}
+
+	periodBudget, applied := reallocatedBudget(budget, march, marchEnd)
+
+	assert.Equal(t, 1000.0, periodBudget.TotalBudget)
+	assert.Equal(t, map[BudgetCategory]float64{
+		BudgetCategoryFood:          460,
+		BudgetCategoryEntertainment: 0,
+		BudgetCategoryPersonal:      40,
+	}, periodBudget.CategoryBudgets)
+	require.Len(t, applied, 2)
+	assert.Equal(t, "first", applied[0].ID)
+	assert.Equal(t, "capped", applied[1].ID)
+	assert.Equal(t, 40.0, applied[1].Amount)
+	// Other periods keep the original allocation
+	assert.Equal(t, 400.0, budget.CategoryBudgets[BudgetCategoryFood])
+
+	unchanged, applied := reallocatedBudget(budget, marchEnd.Add(time.Nanosecond), marchEnd.AddDate(0, 1, 0))
+	assert.Nil(t, applied)
+	assert.Equal(t, budget.CategoryBudgets, unchanged.CategoryBudgets)
+}
+
+func TestHistoricalBacktestUsesReallocatedFigures(t *testing.T) {
+	march := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	repo := stubBudgets{transactions: []Transaction{
+		{Amount: 450, Category: CategoryGroceries, TransactionDate: march.AddDate(0, 0, 20)},
+		{Amount: 450, Category: CategoryGroceries, TransactionDate: march.AddDate(0, 1, 20)},
+	}}
+	service := NewBacktestServiceWithDefaults(repo)
+
+	result, err := service.RunHistoricalBacktest(context.Background(), "user-1", Budget{
+		Period:      BacktestPeriodMonthly,
+		TotalBudget: 600,
+		CategoryBudgets: map[BudgetCategory]float64{
+			BudgetCategoryFood:          400,
+			BudgetCategoryEntertainment: 200,
+		},
+		Reallocations: []BudgetReallocation{
+			{FromCategory: BudgetCategoryEntertainment, ToCategory: BudgetCategoryFood, Amount: 100, EffectiveDate: march.AddDate(0, 0, 15)},
+		},
+	}, march, march.AddDate(0, 2, -1))
+	require.NoError(t, err)
+
+	require.Len(t, result.PeriodResults, 2)
+	foodBudget := func(period PeriodBacktestResult) BudgetCategoryAllocation {
+		for _, cr := range period.CategoryResults {
+			if cr.Category == BudgetCategoryFood {
+				return cr
+			}
+		}
+		t.Fatalf("no food budget in %s", period.PeriodStart)
+		return BudgetCategoryAllocation{}
+	}
+
+	reallocated, original := result.PeriodResults[0], result.PeriodResults[1]
+	assert.Equal(t, 500.0, foodBudget(reallocated).BudgetAmount)
+	assert.Equal(t, 50.0, foodBudget(reallocated).Variance)
+	require.Len(t, reallocated.Reallocations, 1)
+	assert.Equal(t, 600.0, reallocated.BudgetedAmount)
+	assert.Equal(t, 400.0, foodBudget(original).BudgetAmount)
+	assert.Equal(t, -50.0, foodBudget(original).Variance)
+	assert.Empty(t, original.Reallocations)
+}
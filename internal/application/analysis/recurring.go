@@ -0,0 +1,246 @@
+package analysis
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// =============================================================================
+// Recurring Bill Detection
+// =============================================================================
+
+// Bills are detected from transaction history: charges from the same
+// merchant for about the same amount at a regular interval. A merchant needs
+// three charges to count as a bill, or two when the charges are flagged as
+// recurring. A bill's price may change along the way, as long as the new
+// price sticks; each bill keeps its price history.
+
+// BillCadence is how often a recurring bill is charged
+type BillCadence string
+
+const (
+	CadenceWeekly    BillCadence = "weekly"
+	CadenceBiweekly  BillCadence = "biweekly"
+	CadenceMonthly   BillCadence = "monthly"
+	CadenceQuarterly BillCadence = "quarterly"
+	CadenceYearly    BillCadence = "yearly"
+)
+
+// billCadences are the cadences detected, with their typical interval and
+// how far an interval may stray from it, in days
+var billCadences = []struct {
+	cadence   BillCadence
+	days      float64
+	tolerance float64
+}{
+	{CadenceWeekly, 7, 1},
+	{CadenceBiweekly, 14, 2},
+	{CadenceMonthly, 30.4, 4},
+	{CadenceQuarterly, 91.3, 7},
+	{CadenceYearly, 365.25, 12},
+}
+
+// billAmountTolerance is how far (as a share of the previous charge) a
+// charge may stray from the one before it without being a price change,
+// unless a RecurringDetectionService is configured otherwise
+const billAmountTolerance = 0.30
+
+// billPriceCharges is how many of the latest charges a bill's expected
+// amount is taken from
+const billPriceCharges = 3
+
+// PricePoint is one charge of a recurring bill
+type PricePoint struct {
+	Date   time.Time `json:"date"`
+	Amount float64   `json:"amount"`
+}
+
+// RecurringBill is a charge detected as recurring in a user's transactions
+type RecurringBill struct {
+	Merchant string           `json:"merchant"`
+	Category SpendingCategory `json:"category"`
+	Cadence  BillCadence      `json:"cadence"`
+
+	// Amount expected at the next charge: the median of the latest few
+	// charges since the price last changed
+	Amount float64 `json:"amount"`
+
+	Occurrences int       `json:"occurrences"`
+	LastDate    time.Time `json:"last_date"`
+	NextDate    time.Time `json:"next_date"`
+
+	// Every charge seen, oldest first
+	PriceHistory []PricePoint `json:"price_history"`
+
+	// key is the normalized merchant the bill's charges were grouped by
+	key string
+}
+
+// advance returns the date of the charge after one on date
+func (c BillCadence) advance(date time.Time) time.Time {
+	switch c {
+	case CadenceWeekly:
+		return date.AddDate(0, 0, 7)
+	case CadenceBiweekly:
+		return date.AddDate(0, 0, 14)
+	case CadenceQuarterly:
+		return date.AddDate(0, 3, 0)
+	case CadenceYearly:
+		return date.AddDate(1, 0, 0)
+	default:
+		return date.AddDate(0, 1, 0)
+	}
+}
+
+// DueBy returns the dates the bill is next charged up to and including
+// date. The first may be a little in the past: a late charge that hasn't
+// been seen yet is still due.
+func (b RecurringBill) DueBy(date time.Time) []time.Time {
+	var dates []time.Time
+	for due := b.NextDate; !due.After(date); due = b.Cadence.advance(due) {
+		dates = append(dates, due)
+	}
+	return dates
+}
+
+// DetectRecurringBills finds the recurring bills in transactions that are
+// still being charged as of now, soonest due first
+func DetectRecurringBills(transactions []Transaction, now time.Time) []RecurringBill {
+	return detectRecurringBills(transactions, now, billAmountTolerance)
+}
+
+// detectRecurringBills finds the recurring bills in transactions, where
+// consecutive charges within tolerance (a share of the previous charge) of
+// each other are the same price
+func detectRecurringBills(transactions []Transaction, now time.Time, tolerance float64) []RecurringBill {
+	groups := make(map[string][]Transaction)
+	for _, t := range transactions {
+		key := billKey(t)
+		if key == "" || t.Amount <= 0 || t.TransactionDate.After(now) {
+			continue
+		}
+		groups[key] = append(groups[key], t)
+	}
+
+	var bills []RecurringBill
+	for key, charges := range groups {
+		if bill, ok := detectBill(key, charges, now, tolerance); ok {
+			bills = append(bills, bill)
+		}
+	}
+
+	sort.Slice(bills, func(i, j int) bool {
+		if !bills[i].NextDate.Equal(bills[j].NextDate) {
+			return bills[i].NextDate.Before(bills[j].NextDate)
+		}
+		return bills[i].key < bills[j].key
+	})
+	return bills
+}
+
+// detectBill reports whether one merchant's charges recur, and if so the bill
+func detectBill(key string, charges []Transaction, now time.Time, tolerance float64) (RecurringBill, bool) {
+	sort.Slice(charges, func(i, j int) bool {
+		return charges[i].TransactionDate.Before(charges[j].TransactionDate)
+	})
+
+	flagged := false
+	for _, t := range charges {
+		flagged = flagged || t.IsRecurring
+	}
+	minCharges := 3
+	if flagged {
+		minCharges = 2
+	}
+	if len(charges) < minCharges {
+		return RecurringBill{}, false
+	}
+
+	intervals := make([]float64, len(charges)-1)
+	for i := 1; i < len(charges); i++ {
+		intervals[i-1] = charges[i].TransactionDate.Sub(charges[i-1].TransactionDate).Hours() / 24
+	}
+	typical := median(intervals)
+
+	cadenceIndex := -1
+	for i, c := range billCadences {
+		if math.Abs(typical-c.days) <= c.tolerance {
+			cadenceIndex = i
+			break
+		}
+	}
+	if cadenceIndex < 0 {
+		return RecurringBill{}, false
+	}
+	cadence := billCadences[cadenceIndex]
+	for _, interval := range intervals {
+		if math.Abs(interval-cadence.days) > cadence.tolerance {
+			return RecurringBill{}, false
+		}
+	}
+
+	// A price change counts when the new price sticks or is the latest
+	// charge; amounts that jump around aren't a bill
+	priceSince := 0
+	for i := 1; i < len(charges); i++ {
+		if sameBillAmount(charges[i-1].Amount, charges[i].Amount, tolerance) {
+			continue
+		}
+		if i < len(charges)-1 && !sameBillAmount(charges[i].Amount, charges[i+1].Amount, tolerance) {
+			return RecurringBill{}, false
+		}
+		priceSince = i
+	}
+
+	history := make([]PricePoint, len(charges))
+	for i, t := range charges {
+		history[i] = PricePoint{Date: t.TransactionDate, Amount: t.Amount}
+	}
+	priceSince = max(priceSince, len(charges)-billPriceCharges)
+	current := make([]float64, 0, len(charges)-priceSince)
+	for _, t := range charges[priceSince:] {
+		current = append(current, t.Amount)
+	}
+
+	last := charges[len(charges)-1]
+	next := cadence.cadence.advance(last.TransactionDate)
+	// A bill that's missed its next charge by more than the cadence allows
+	// has stopped
+	grace := time.Duration(cadence.tolerance*24) * time.Hour
+	if now.After(next.Add(grace)) {
+		return RecurringBill{}, false
+	}
+
+	merchant := last.MerchantName
+	if merchant == "" {
+		merchant = last.Description
+	}
+	return RecurringBill{
+		Merchant:     strings.TrimSpace(merchant),
+		Category:     last.Category,
+		Cadence:      cadence.cadence,
+		Amount:       median(current),
+		Occurrences:  len(charges),
+		LastDate:     last.TransactionDate,
+		NextDate:     next,
+		PriceHistory: history,
+		key:          key,
+	}, true
+}
+
+// sameBillAmount reports whether a charge of amount after one of previous is
+// the same price, give or take tolerance as a share of previous
+func sameBillAmount(previous, amount, tolerance float64) bool {
+	return math.Abs(amount-previous) <= previous*tolerance
+}
+
+// billKey is the normalized merchant a transaction's charge is grouped by
+func billKey(t Transaction) string {
+	name := t.MerchantName
+	if strings.TrimSpace(name) == "" {
+		name = t.Description
+	}
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
@@ -0,0 +1,212 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"time"
+)
+
+// =============================================================================
+// Recurring Transaction Detection
+// =============================================================================
+
+// Everything a user is charged on a schedule, detected like bills: charges
+// from the same merchant for about the same amount at a regular interval.
+// Discretionary charges are subscriptions and essential services bills.
+// Each is costed over a year, and flagged when its price last went up.
+
+// RecurringKind tells subscriptions from bills
+type RecurringKind string
+
+const (
+	// RecurringKindSubscription is a discretionary charge, one that can be
+	// cancelled
+	RecurringKindSubscription RecurringKind = "subscription"
+	// RecurringKindBill is a charge for an essential service
+	RecurringKindBill RecurringKind = "bill"
+)
+
+// priceSteadyShare is how far (as a share of the price) charges may stray
+// from each other and still be the same price when looking for increases
+const priceSteadyShare = 0.01
+
+// RecurringDetectionConfig holds configuration for recurring detection
+type RecurringDetectionConfig struct {
+	LookbackMonths          int     // History charges are detected from; yearly charges need two years
+	AmountTolerance         float64 // Share a charge may stray from the one before and be the same price
+	MinPriceIncreasePercent float64 // Least rise from one charge to the next flagged as a price increase
+}
+
+// DefaultRecurringDetectionConfig returns a config with reasonable defaults
+func DefaultRecurringDetectionConfig() RecurringDetectionConfig {
+	return RecurringDetectionConfig{
+		LookbackMonths:          billNegotiationLookbackMonths,
+		AmountTolerance:         billAmountTolerance,
+		MinPriceIncreasePercent: 2.0,
+	}
+}
+
+// PriceIncrease is the latest rise in a recurring charge's price
+type PriceIncrease struct {
+	PreviousAmount  float64   `json:"previous_amount"`
+	NewAmount       float64   `json:"new_amount"`
+	Date            time.Time `json:"date"`
+	IncreasePercent float64   `json:"increase_percent"`
+	AnnualIncrease  float64   `json:"annual_increase"`
+}
+
+// RecurringCharge is a subscription or bill detected in a user's
+// transactions, with what it costs
+type RecurringCharge struct {
+	RecurringBill
+	Kind RecurringKind `json:"kind"`
+
+	ChargesPerYear float64 `json:"charges_per_year"`
+	AnnualCost     float64 `json:"annual_cost"`
+	MonthlyCost    float64 `json:"monthly_cost"`
+
+	// PriceIncrease is nil unless the price went up and stayed up
+	PriceIncrease *PriceIncrease `json:"price_increase,omitempty"`
+}
+
+// RecurringReport lists a user's recurring charges, the costliest first
+type RecurringReport struct {
+	UserID string    `json:"user_id"`
+	AsOf   time.Time `json:"as_of"`
+
+	Charges []RecurringCharge `json:"charges"`
+
+	Subscriptions           int     `json:"subscriptions"`
+	Bills                   int     `json:"bills"`
+	PriceIncreases          int     `json:"price_increases"`
+	SubscriptionsAnnualCost float64 `json:"subscriptions_annual_cost"`
+	BillsAnnualCost         float64 `json:"bills_annual_cost"`
+	TotalAnnualCost         float64 `json:"total_annual_cost"`
+	TotalMonthlyCost        float64 `json:"total_monthly_cost"`
+}
+
+// RecurringDetectionService finds subscriptions and recurring bills in a
+// user's transaction history
+type RecurringDetectionService struct {
+	repo   TransactionRepository
+	config RecurringDetectionConfig
+}
+
+// NewRecurringDetectionService creates a new recurring detection service
+func NewRecurringDetectionService(repo TransactionRepository, config RecurringDetectionConfig) *RecurringDetectionService {
+	return &RecurringDetectionService{
+		repo:   repo,
+		config: config,
+	}
+}
+
+// NewRecurringDetectionServiceWithDefaults creates a recurring detection
+// service with default configuration
+func NewRecurringDetectionServiceWithDefaults(repo TransactionRepository) *RecurringDetectionService {
+	return NewRecurringDetectionService(repo, DefaultRecurringDetectionConfig())
+}
+
+// DetectRecurring finds the user's subscriptions and recurring bills still
+// being charged as of asOf
+func (s *RecurringDetectionService) DetectRecurring(ctx context.Context, userID string, asOf time.Time) (*RecurringReport, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+	if s.config.AmountTolerance <= 0 || s.config.AmountTolerance >= 1 {
+		return nil, errors.New("amount tolerance must be between 0 and 1")
+	}
+	if s.config.MinPriceIncreasePercent < 0 {
+		return nil, errors.New("minimum price increase cannot be negative")
+	}
+
+	transactions, err := s.repo.GetByUserID(ctx, userID, asOf.AddDate(0, -s.config.LookbackMonths, 0), asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &RecurringReport{
+		UserID:  userID,
+		AsOf:    asOf,
+		Charges: []RecurringCharge{},
+	}
+	for _, bill := range detectRecurringBills(transactions, asOf, s.config.AmountTolerance) {
+		charge := s.recurringCharge(bill)
+		report.Charges = append(report.Charges, charge)
+
+		if charge.Kind == RecurringKindSubscription {
+			report.Subscriptions++
+			report.SubscriptionsAnnualCost += charge.AnnualCost
+		} else {
+			report.Bills++
+			report.BillsAnnualCost += charge.AnnualCost
+		}
+		if charge.PriceIncrease != nil {
+			report.PriceIncreases++
+		}
+		report.TotalAnnualCost += charge.AnnualCost
+	}
+	report.TotalMonthlyCost = report.TotalAnnualCost / 12
+
+	sort.SliceStable(report.Charges, func(i, j int) bool {
+		return report.Charges[i].AnnualCost > report.Charges[j].AnnualCost
+	})
+	return report, nil
+}
+
+// recurringCharge classifies and costs a detected bill
+func (s *RecurringDetectionService) recurringCharge(bill RecurringBill) RecurringCharge {
+	chargesPerYear := bill.Cadence.chargesPerYear()
+	charge := RecurringCharge{
+		RecurringBill:  bill,
+		Kind:           RecurringKindSubscription,
+		ChargesPerYear: chargesPerYear,
+		AnnualCost:     bill.Amount * chargesPerYear,
+		MonthlyCost:    bill.Amount * chargesPerYear / 12,
+	}
+	if essentialBillCategories[bill.Category] {
+		charge.Kind = RecurringKindBill
+	}
+	if increase := latestPriceIncrease(bill.PriceHistory, s.config.MinPriceIncreasePercent); increase != nil {
+		increase.AnnualIncrease = (increase.NewAmount - increase.PreviousAmount) * chargesPerYear
+		charge.PriceIncrease = increase
+	}
+	return charge
+}
+
+// latestPriceIncrease returns the latest rise of at least minPercent from
+// one charge to the next that the price has kept, or nil. Amounts that vary
+// from charge to charge, like metered utilities, have no price to raise, so
+// a rise only counts between steady prices.
+func latestPriceIncrease(history []PricePoint, minPercent float64) *PriceIncrease {
+	latest := history[len(history)-1].Amount
+	for i := len(history) - 1; i > 0; i-- {
+		previous, amount := history[i-1].Amount, history[i].Amount
+		if previous <= 0 || amount <= previous || (amount-previous)/previous*100 < minPercent {
+			continue
+		}
+		if i > 1 && !steadyPrice(history[i-2].Amount, previous) {
+			return nil
+		}
+		if i < len(history)-1 && !steadyPrice(amount, history[i+1].Amount) {
+			return nil
+		}
+		if latest < amount*(1-priceSteadyShare) {
+			return nil
+		}
+		return &PriceIncrease{
+			PreviousAmount:  previous,
+			NewAmount:       amount,
+			Date:            history[i].Date,
+			IncreasePercent: (amount - previous) / previous * 100,
+		}
+	}
+	return nil
+}
+
+// steadyPrice reports whether a charge of amount after one of previous is
+// the same price, give or take priceSteadyShare
+func steadyPrice(previous, amount float64) bool {
+	return math.Abs(amount-previous) <= previous*priceSteadyShare
+}
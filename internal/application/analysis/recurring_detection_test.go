@@ -0,0 +1,118 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectRecurring(t *testing.T) {
+	asOf := day(2026, 3, 25)
+	start := day(2025, 3, 20)
+
+	var transactions []Transaction
+	// Up from 12.99 to 15.99 in January
+	transactions = append(transactions, monthlyCharges("StreamCo", CategorySubscriptions, start, 13, func(n int) float64 {
+		if n >= 10 {
+			return 15.99
+		}
+		return 12.99
+	})...)
+	// Metered, so it varies from month to month
+	usage := []float64{80, 95, 70, 88, 82, 90, 76, 85, 92, 79, 84, 88, 81}
+	transactions = append(transactions, monthlyCharges("PowerCo", CategoryUtilities, start, 13, func(n int) float64 {
+		return usage[n]
+	})...)
+	// Up for a while, then back to the old price
+	transactions = append(transactions, monthlyCharges("Gym", CategoryEntertainment, start, 13, func(n int) float64 {
+		if n >= 6 && n < 10 {
+			return 45
+		}
+		return 40
+	})...)
+	// Yearly, flagged as recurring by the bank
+	for _, date := range []int{2024, 2025} {
+		transactions = append(transactions, Transaction{
+			Amount:          1200,
+			MerchantName:    "HomeShield",
+			Category:        CategoryInsurance,
+			TransactionDate: day(date, 4, 1),
+			IsRecurring:     true,
+		})
+	}
+
+	service := NewRecurringDetectionServiceWithDefaults(stubBudgets{transactions: transactions})
+
+	report, err := service.DetectRecurring(context.Background(), "user-1", asOf)
+	require.NoError(t, err)
+	require.Len(t, report.Charges, 4)
+
+	insurance := report.Charges[0]
+	assert.Equal(t, "HomeShield", insurance.Merchant)
+	assert.Equal(t, RecurringKindBill, insurance.Kind)
+	assert.Equal(t, CadenceYearly, insurance.Cadence)
+	assert.InDelta(t, 1200, insurance.AnnualCost, 1e-9)
+	assert.InDelta(t, 100, insurance.MonthlyCost, 1e-9)
+	assert.Nil(t, insurance.PriceIncrease)
+
+	power := report.Charges[1]
+	assert.Equal(t, "PowerCo", power.Merchant)
+	assert.Equal(t, RecurringKindBill, power.Kind)
+	assert.Nil(t, power.PriceIncrease)
+
+	gym := report.Charges[2]
+	assert.Equal(t, "Gym", gym.Merchant)
+	assert.Equal(t, RecurringKindSubscription, gym.Kind)
+	assert.InDelta(t, 480, gym.AnnualCost, 1e-9)
+	assert.Nil(t, gym.PriceIncrease)
+
+	streaming := report.Charges[3]
+	assert.Equal(t, "StreamCo", streaming.Merchant)
+	assert.Equal(t, RecurringKindSubscription, streaming.Kind)
+	assert.InDelta(t, 15.99*12, streaming.AnnualCost, 1e-9)
+	require.NotNil(t, streaming.PriceIncrease)
+	assert.Equal(t, 12.99, streaming.PriceIncrease.PreviousAmount)
+	assert.Equal(t, 15.99, streaming.PriceIncrease.NewAmount)
+	assert.Equal(t, start.AddDate(0, 10, 0), streaming.PriceIncrease.Date)
+	assert.InDelta(t, 23.09, streaming.PriceIncrease.IncreasePercent, 0.01)
+	assert.InDelta(t, 36, streaming.PriceIncrease.AnnualIncrease, 1e-9)
+
+	assert.Equal(t, 2, report.Subscriptions)
+	assert.Equal(t, 2, report.Bills)
+	assert.Equal(t, 1, report.PriceIncreases)
+	assert.InDelta(t, 480+15.99*12, report.SubscriptionsAnnualCost, 1e-9)
+	assert.InDelta(t, report.SubscriptionsAnnualCost+report.BillsAnnualCost, report.TotalAnnualCost, 1e-9)
+	assert.InDelta(t, report.TotalAnnualCost/12, report.TotalMonthlyCost, 1e-9)
+}
+
+func TestDetectRecurringAmountTolerance(t *testing.T) {
+	asOf := day(2026, 3, 25)
+	// Alternates 50% apart, too far to be the same price by default
+	transactions := monthlyCharges("CloudBox", CategorySubscriptions, day(2025, 9, 20), 7, func(n int) float64 {
+		if n%2 == 1 {
+			return 15
+		}
+		return 10
+	})
+
+	report, err := NewRecurringDetectionServiceWithDefaults(stubBudgets{transactions: transactions}).
+		DetectRecurring(context.Background(), "user-1", asOf)
+	require.NoError(t, err)
+	assert.Empty(t, report.Charges)
+
+	config := DefaultRecurringDetectionConfig()
+	config.AmountTolerance = 0.5
+	report, err = NewRecurringDetectionService(stubBudgets{transactions: transactions}, config).
+		DetectRecurring(context.Background(), "user-1", asOf)
+	require.NoError(t, err)
+	require.Len(t, report.Charges, 1)
+	assert.Equal(t, "CloudBox", report.Charges[0].Merchant)
+	assert.Nil(t, report.Charges[0].PriceIncrease)
+
+	config.AmountTolerance = 1
+	_, err = NewRecurringDetectionService(stubBudgets{transactions: transactions}, config).
+		DetectRecurring(context.Background(), "user-1", asOf)
+	assert.Error(t, err)
+}
@@ -0,0 +1,203 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// =============================================================================
+// Safe to Spend
+// =============================================================================
+
+// The safe-to-spend number is what's left of the current budget period once
+// the bills still due before it ends and the rest of the period's everyday
+// spending are set aside. Bills are detected from the user's history; the
+// everyday spending is forecast from the daily rate of non-bill spending over
+// the lookback.
+
+// safeToSpendLookbackDays is how much history bills are detected in and the
+// everyday spending rate is taken from
+const safeToSpendLookbackDays = 90
+
+// ErrCategoryNotBudgeted is returned when safe to spend is asked for a
+// category the budget doesn't allocate to
+var ErrCategoryNotBudgeted = errors.New("category is not in the budget")
+
+// UpcomingBill is a recurring bill charged before the budget period ends
+type UpcomingBill struct {
+	Merchant string         `json:"merchant"`
+	Category BudgetCategory `json:"category"`
+	Cadence  BillCadence    `json:"cadence"`
+	Amount   float64        `json:"amount"`
+	DueDate  time.Time      `json:"due_date"`
+}
+
+// SafeToSpend is how much can be spent today without running over the
+// budget, overall or in one category
+type SafeToSpend struct {
+	UserID   string         `json:"user_id"`
+	BudgetID string         `json:"budget_id"`
+	Category BudgetCategory `json:"category,omitempty"`
+	Date     time.Time      `json:"date"`
+
+	// The budget period containing Date
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+
+	// Days left in the period, today included
+	DaysRemaining int `json:"days_remaining"`
+
+	BudgetedAmount  float64 `json:"budgeted_amount"`
+	SpentAmount     float64 `json:"spent_amount"`
+	RemainingBudget float64 `json:"remaining_budget"`
+
+	// Bills still due this period and their total
+	UpcomingBills      []UpcomingBill `json:"upcoming_bills"`
+	UpcomingBillsTotal float64        `json:"upcoming_bills_total"`
+
+	// Everyday spending expected after today, and the daily rate it's
+	// forecast from
+	DailySpendingRate float64 `json:"daily_spending_rate"`
+	ForecastSpending  float64 `json:"forecast_spending"`
+
+	// What can be spent today and still leave room for the bills and the
+	// forecast spending
+	SafeToSpend float64 `json:"safe_to_spend"`
+
+	// What can be spent each remaining day once the bills are paid
+	DailyAllowance float64 `json:"daily_allowance"`
+
+	// Reallocations applied to this period's budget
+	Reallocations []BudgetReallocation `json:"reallocations,omitempty"`
+}
+
+// SafeToSpend computes how much of the budget period containing now can be
+// spent today, overall or in category when it's set
+func (s *BacktestService) SafeToSpend(
+	ctx context.Context,
+	userID string,
+	budget Budget,
+	category BudgetCategory,
+	now time.Time,
+) (*SafeToSpend, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+	if budget.Period == BacktestPeriodStatement {
+		if budget.StatementCycle == nil {
+			return nil, ErrStatementCycleRequired
+		}
+		if err := budget.StatementCycle.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	budget = householdBudget(budget)
+
+	periodStart := s.getPeriodStart(now, budget)
+	periodEnd := s.getPeriodEnd(periodStart, budget)
+	budget, reallocations := reallocatedBudget(budget, periodStart, periodEnd)
+
+	budgeted := budget.TotalBudget
+	if category != "" {
+		amount, ok := budget.CategoryBudgets[category]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrCategoryNotBudgeted, category)
+		}
+		budgeted = amount
+	}
+
+	lookbackStart := now.AddDate(0, 0, -safeToSpendLookbackDays)
+	transactions, err := s.repo.GetTransactionsByBudget(ctx, userID, minTime(periodStart, lookbackStart), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+	if budget.Period == BacktestPeriodStatement {
+		transactions = budget.StatementCycle.Filter(transactions)
+	}
+
+	inCategory := func(c SpendingCategory) bool {
+		return category == "" || s.mapSpendingToBudgetCategory(c) == category
+	}
+
+	result := &SafeToSpend{
+		UserID:         userID,
+		BudgetID:       budget.ID,
+		Category:       category,
+		Date:           now,
+		PeriodStart:    periodStart,
+		PeriodEnd:      periodEnd,
+		BudgetedAmount: budgeted,
+		UpcomingBills:  []UpcomingBill{},
+		Reallocations:  reallocations,
+	}
+
+	for _, t := range transactions {
+		if !t.TransactionDate.Before(periodStart) && !t.TransactionDate.After(now) && inCategory(t.Category) {
+			result.SpentAmount += t.Amount
+		}
+	}
+	result.RemainingBudget = budgeted - result.SpentAmount
+
+	bills := DetectRecurringBills(transactions, now)
+	billKeys := make(map[string]bool, len(bills))
+	for _, bill := range bills {
+		billKeys[bill.key] = true
+		if !inCategory(bill.Category) {
+			continue
+		}
+		for _, due := range bill.DueBy(periodEnd) {
+			result.UpcomingBills = append(result.UpcomingBills, UpcomingBill{
+				Merchant: bill.Merchant,
+				Category: s.mapSpendingToBudgetCategory(bill.Category),
+				Cadence:  bill.Cadence,
+				Amount:   bill.Amount,
+				DueDate:  due,
+			})
+			result.UpcomingBillsTotal += bill.Amount
+		}
+	}
+
+	// Everyday spending is forecast from the days of history there are, up to
+	// the lookback
+	historyStart := now
+	everyday := 0.0
+	for _, t := range transactions {
+		if t.TransactionDate.Before(lookbackStart) || t.TransactionDate.After(now) {
+			continue
+		}
+		historyStart = minTime(historyStart, t.TransactionDate)
+		if !billKeys[billKey(t)] && inCategory(t.Category) {
+			everyday += t.Amount
+		}
+	}
+	if historyDays := math.Ceil(now.Sub(historyStart).Hours() / 24); historyDays > 0 {
+		result.DailySpendingRate = everyday / historyDays
+	}
+
+	// Today's spending is what's being decided, so the forecast covers the
+	// days after it
+	tomorrow := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, now.Location())
+	daysAfterToday := 0
+	if periodEnd.After(tomorrow) {
+		daysAfterToday = int(math.Ceil(periodEnd.Sub(tomorrow).Hours() / 24))
+	}
+	result.DaysRemaining = daysAfterToday + 1
+	result.ForecastSpending = result.DailySpendingRate * float64(daysAfterToday)
+
+	afterBills := result.RemainingBudget - result.UpcomingBillsTotal
+	result.SafeToSpend = math.Max(0, afterBills-result.ForecastSpending)
+	result.DailyAllowance = math.Max(0, afterBills/float64(result.DaysRemaining))
+
+	return result, nil
+}
+
+// minTime returns the earlier of a and b
+func minTime(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
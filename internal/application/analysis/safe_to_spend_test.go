@@ -0,0 +1,130 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestDetectRecurringBills(t *testing.T) {
+	now := day(2026, 3, 10)
+	transactions := []Transaction{
+		// Monthly, with a small price rise
+		{Amount: 15.49, MerchantName: "Netflix", Category: CategoryEntertainment, TransactionDate: day(2025, 12, 20)},
+		{Amount: 15.49, MerchantName: "NETFLIX ", Category: CategoryEntertainment, TransactionDate: day(2026, 1, 20)},
+		{Amount: 17.99, MerchantName: "netflix", Category: CategoryEntertainment, TransactionDate: day(2026, 2, 20)},
+		// Flagged as recurring, so two charges are enough
+		{Amount: 90, Description: "City Water", Category: CategoryUtilities, TransactionDate: day(2025, 12, 5), IsRecurring: true},
+		{Amount: 95, Description: "City Water", Category: CategoryUtilities, TransactionDate: day(2026, 3, 5), IsRecurring: true},
+		// Stopped after November
+		{Amount: 40, MerchantName: "Gym", Category: CategoryHealthcare, TransactionDate: day(2025, 9, 15)},
+		{Amount: 40, MerchantName: "Gym", Category: CategoryHealthcare, TransactionDate: day(2025, 10, 15)},
+		{Amount: 40, MerchantName: "Gym", Category: CategoryHealthcare, TransactionDate: day(2025, 11, 15)},
+		// Regular but for very different amounts
+		{Amount: 20, MerchantName: "Grocer", Category: CategoryGroceries, TransactionDate: day(2026, 2, 17)},
+		{Amount: 140, MerchantName: "Grocer", Category: CategoryGroceries, TransactionDate: day(2026, 2, 24)},
+		{Amount: 65, MerchantName: "Grocer", Category: CategoryGroceries, TransactionDate: day(2026, 3, 3)},
+		// Irregular
+		{Amount: 30, MerchantName: "Cafe", Category: CategoryDining, TransactionDate: day(2026, 1, 2)},
+		{Amount: 30, MerchantName: "Cafe", Category: CategoryDining, TransactionDate: day(2026, 1, 9)},
+		{Amount: 30, MerchantName: "Cafe", Category: CategoryDining, TransactionDate: day(2026, 2, 27)},
+	}
+
+	bills := DetectRecurringBills(transactions, now)
+
+	require.Len(t, bills, 2)
+	assert.Equal(t, "netflix", bills[0].Merchant)
+	assert.Equal(t, CadenceMonthly, bills[0].Cadence)
+	assert.Equal(t, 15.49, bills[0].Amount)
+	assert.Equal(t, 3, bills[0].Occurrences)
+	assert.Equal(t, day(2026, 3, 20), bills[0].NextDate)
+
+	assert.Equal(t, "City Water", bills[1].Merchant)
+	assert.Equal(t, CadenceQuarterly, bills[1].Cadence)
+	assert.Equal(t, day(2026, 6, 5), bills[1].NextDate)
+
+	assert.Equal(t, []time.Time{day(2026, 3, 20), day(2026, 4, 20)}, bills[0].DueBy(day(2026, 4, 30)))
+	assert.Empty(t, bills[1].DueBy(day(2026, 3, 31)))
+}
+
+func TestSafeToSpend(t *testing.T) {
+	now := day(2026, 3, 10).Add(12 * time.Hour)
+	repo := stubBudgets{transactions: []Transaction{
+		{Amount: 15.99, MerchantName: "Netflix", Category: CategoryEntertainment, TransactionDate: day(2025, 12, 20)},
+		{Amount: 15.99, MerchantName: "Netflix", Category: CategoryEntertainment, TransactionDate: day(2026, 1, 20)},
+		{Amount: 15.99, MerchantName: "Netflix", Category: CategoryEntertainment, TransactionDate: day(2026, 2, 20)},
+		{Amount: 1200, MerchantName: "Landlord", Category: CategoryHousing, TransactionDate: day(2026, 1, 1)},
+		{Amount: 1200, MerchantName: "Landlord", Category: CategoryHousing, TransactionDate: day(2026, 2, 1)},
+		{Amount: 1200, MerchantName: "Landlord", Category: CategoryHousing, TransactionDate: day(2026, 3, 1)},
+		{Amount: 200, MerchantName: "Grocer", Category: CategoryGroceries, TransactionDate: day(2026, 1, 10)},
+		{Amount: 200, MerchantName: "Market", Category: CategoryGroceries, TransactionDate: day(2026, 2, 10)},
+		{Amount: 120, MerchantName: "Farm Stand", Category: CategoryGroceries, TransactionDate: day(2026, 3, 3)},
+		{Amount: 60, MerchantName: "Deli", Category: CategoryDining, TransactionDate: day(2026, 3, 8)},
+	}}
+	service := NewBacktestServiceWithDefaults(repo)
+	budget := Budget{
+		Period:      BacktestPeriodMonthly,
+		TotalBudget: 2000,
+		CategoryBudgets: map[BudgetCategory]float64{
+			BudgetCategoryHousing:       1200,
+			BudgetCategoryFood:          600,
+			BudgetCategoryEntertainment: 200,
+		},
+	}
+	// Non-bill spending since the first transaction in the lookback, on
+	// 20 December, 80.5 days ago
+	dailyRate := 580.0 / 81
+
+	overall, err := service.SafeToSpend(context.Background(), "user-1", budget, "", now)
+	require.NoError(t, err)
+
+	assert.Equal(t, day(2026, 3, 1), overall.PeriodStart)
+	assert.Equal(t, 22, overall.DaysRemaining)
+	assert.InDelta(t, 1380, overall.SpentAmount, 1e-9)
+	assert.InDelta(t, 620, overall.RemainingBudget, 1e-9)
+	require.Len(t, overall.UpcomingBills, 1)
+	assert.Equal(t, "Netflix", overall.UpcomingBills[0].Merchant)
+	assert.Equal(t, BudgetCategoryEntertainment, overall.UpcomingBills[0].Category)
+	assert.Equal(t, day(2026, 3, 20), overall.UpcomingBills[0].DueDate)
+	assert.InDelta(t, 15.99, overall.UpcomingBillsTotal, 1e-9)
+	assert.InDelta(t, dailyRate, overall.DailySpendingRate, 1e-9)
+	assert.InDelta(t, dailyRate*21, overall.ForecastSpending, 1e-9)
+	assert.InDelta(t, 620-15.99-dailyRate*21, overall.SafeToSpend, 1e-9)
+	assert.InDelta(t, (620-15.99)/22, overall.DailyAllowance, 1e-9)
+
+	food, err := service.SafeToSpend(context.Background(), "user-1", budget, BudgetCategoryFood, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, 600.0, food.BudgetedAmount)
+	assert.InDelta(t, 180, food.SpentAmount, 1e-9)
+	assert.Empty(t, food.UpcomingBills)
+	assert.InDelta(t, 420-dailyRate*21, food.SafeToSpend, 1e-9)
+
+	housing, err := service.SafeToSpend(context.Background(), "user-1", budget, BudgetCategoryHousing, now)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, housing.SafeToSpend)
+	assert.Equal(t, 0.0, housing.DailyAllowance)
+}
+
+func TestSafeToSpendErrors(t *testing.T) {
+	service := NewBacktestServiceWithDefaults(stubBudgets{})
+	now := day(2026, 3, 10)
+
+	_, err := service.SafeToSpend(context.Background(), "user-1", Budget{
+		Period:          BacktestPeriodMonthly,
+		TotalBudget:     500,
+		CategoryBudgets: map[BudgetCategory]float64{BudgetCategoryFood: 500},
+	}, BudgetCategoryDebt, now)
+	assert.True(t, errors.Is(err, ErrCategoryNotBudgeted))
+
+	_, err = service.SafeToSpend(context.Background(), "user-1", Budget{Period: BacktestPeriodStatement}, "", now)
+	assert.True(t, errors.Is(err, ErrStatementCycleRequired))
+}
@@ -7,6 +7,8 @@ import (
 	"math"
 	"sort"
 	"time"
+
+	"clockzen-next/internal/infrastructure/i18n"
 )
 
 // SpendingCategory represents a spending category
@@ -83,6 +85,10 @@ type Transaction struct {
 	Description     string
 	IsRecurring     bool
 	Tags            []string
+	CardLastFour    string
+	// MemberID is the household member the spending is attributed to, empty
+	// for shared spending
+	MemberID string
 }
 
 // CategorySpending represents spending for a single category in a time period
@@ -114,6 +120,9 @@ type SpendingOverTime struct {
 	TotalSpending    float64                               `json:"total_spending"`
 	AveragePerPeriod float64                               `json:"average_per_period"`
 	TopCategories    []CategorySpending                    `json:"top_categories"`
+	// Members splits spending by household member; nil when no spending
+	// is attributed to one
+	Members []MemberSpending `json:"members,omitempty"`
 }
 
 // SpendingTrend represents a detected spending trend
@@ -186,6 +195,9 @@ type SpendingAnalysisConfig struct {
 	DuplicateTimeWindowHours int    // Hours window for duplicate detection
 	MinTransactionsForStats  int    // Minimum transactions for statistical analysis
 
+	// Bill negotiation settings
+	PriceIncreasePercent float64 // Year-over-year price increase that flags a bill
+
 	// General settings
 	DefaultLookbackDays int // Default days to look back for analysis
 }
@@ -200,6 +212,7 @@ func DefaultSpendingAnalysisConfig() SpendingAnalysisConfig {
 		LargeTransactionMultiple: 3.0,
 		DuplicateTimeWindowHours: 24,
 		MinTransactionsForStats:  5,
+		PriceIncreasePercent:     10.0,
 		DefaultLookbackDays:      90,
 	}
 }
@@ -229,12 +242,42 @@ func NewSpendingServiceWithDefaults(repo TransactionRepository) *SpendingService
 	return NewSpendingService(repo, DefaultSpendingAnalysisConfig())
 }
 
-// AnalyzeSpendingByCategory analyzes spending by category over time
+// AnalyzeSpendingByCategory analyzes spending by category over time.
+// Statement periods need the card's cycle; see AnalyzeSpendingByStatement.
 func (s *SpendingService) AnalyzeSpendingByCategory(
 	ctx context.Context,
 	userID string,
 	startDate, endDate time.Time,
 	period TimePeriod,
+) (*SpendingOverTime, error) {
+	if period == PeriodStatement {
+		return nil, ErrStatementCycleRequired
+	}
+	return s.analyzeSpending(ctx, userID, startDate, endDate, period, nil)
+}
+
+// AnalyzeSpendingByStatement analyzes spending by category over the
+// statement periods of a card, counting only the card's transactions
+func (s *SpendingService) AnalyzeSpendingByStatement(
+	ctx context.Context,
+	userID string,
+	cycle StatementCycle,
+	startDate, endDate time.Time,
+) (*SpendingOverTime, error) {
+	if err := cycle.Validate(); err != nil {
+		return nil, err
+	}
+	return s.analyzeSpending(ctx, userID, startDate, endDate, PeriodStatement, &cycle)
+}
+
+// analyzeSpending groups spending into periods, which follow cycle when it
+// is set
+func (s *SpendingService) analyzeSpending(
+	ctx context.Context,
+	userID string,
+	startDate, endDate time.Time,
+	period TimePeriod,
+	cycle *StatementCycle,
 ) (*SpendingOverTime, error) {
 	if userID == "" {
 		return nil, errors.New("userID is required")
@@ -247,8 +290,11 @@ func (s *SpendingService) AnalyzeSpendingByCategory(
 	if err != nil {
 		return nil, err
 	}
+	if cycle != nil {
+		transactions = cycle.Filter(transactions)
+	}
 
-	periods := s.groupTransactionsByPeriod(transactions, startDate, endDate, period)
+	periods := s.groupTransactionsByPeriod(transactions, startDate, endDate, period, cycle)
 	categoryTotals := make(map[SpendingCategory]float64)
 	totalSpending := 0.0
 
@@ -276,9 +322,35 @@ func (s *SpendingService) AnalyzeSpendingByCategory(
 		TotalSpending:    totalSpending,
 		AveragePerPeriod: avgPerPeriod,
 		TopCategories:    topCategories,
+		Members:          memberBreakdown(transactions),
 	}, nil
 }
 
+// MonthlySpending returns total spending for each calendar month from
+// startDate through endDate, oldest first. Months without spending are 0.
+func (s *SpendingService) MonthlySpending(
+	ctx context.Context,
+	userID string,
+	startDate, endDate time.Time,
+) ([]float64, error) {
+	overTime, err := s.analyzeSpending(ctx, userID, startDate, endDate, PeriodMonthly, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make(map[time.Time]float64, len(overTime.Periods))
+	for _, p := range overTime.Periods {
+		month := time.Date(p.StartDate.Year(), p.StartDate.Month(), 1, 0, 0, 0, 0, startDate.Location())
+		totals[month] += p.TotalAmount
+	}
+
+	var monthly []float64
+	for month := s.getPeriodStart(startDate, PeriodMonthly); !month.After(endDate); month = month.AddDate(0, 1, 0) {
+		monthly = append(monthly, totals[month])
+	}
+	return monthly, nil
+}
+
 // DetectTrends analyzes spending patterns to detect trends
 func (s *SpendingService) DetectTrends(
 	ctx context.Context,
@@ -306,12 +378,13 @@ func (s *SpendingService) DetectTrends(
 		}, nil
 	}
 
+	loc := i18n.FromContext(ctx)
 	var trends []SpendingTrend
 	var significantTrends []SpendingTrend
 
 	// Analyze trends for each category
 	for category := range spendingData.CategoryTotals {
-		trend := s.calculateCategoryTrend(spendingData.Periods, category)
+		trend := s.calculateCategoryTrend(loc, spendingData.Periods, category)
 		if trend != nil {
 			trends = append(trends, *trend)
 			if trend.RSquared >= s.config.TrendSignificanceLevel &&
@@ -322,7 +395,7 @@ func (s *SpendingService) DetectTrends(
 	}
 
 	// Calculate overall spending trend
-	overallTrend := s.calculateOverallTrend(spendingData.Periods)
+	overallTrend := s.calculateOverallTrend(loc, spendingData.Periods)
 
 	// Sort trends by absolute change percent
 	sort.Slice(significantTrends, func(i, j int) bool {
@@ -362,10 +435,11 @@ func (s *SpendingService) DetectAnomalies(
 	stats := s.calculateSpendingStatistics(transactions)
 
 	// Detect various types of anomalies
-	anomalies = append(anomalies, s.detectAmountAnomalies(transactions, stats)...)
-	anomalies = append(anomalies, s.detectCategoryAnomalies(transactions, stats)...)
-	anomalies = append(anomalies, s.detectDuplicateCharges(transactions)...)
-	anomalies = append(anomalies, s.detectLargeTransactions(transactions, stats)...)
+	loc := i18n.FromContext(ctx)
+	anomalies = append(anomalies, s.detectAmountAnomalies(loc, transactions, stats)...)
+	anomalies = append(anomalies, s.detectCategoryAnomalies(loc, transactions, stats)...)
+	anomalies = append(anomalies, s.detectDuplicateCharges(loc, transactions)...)
+	anomalies = append(anomalies, s.detectLargeTransactions(loc, transactions, stats)...)
 
 	// Sort anomalies by severity and date
 	sort.Slice(anomalies, func(i, j int) bool {
@@ -581,17 +655,25 @@ type spendingStatistics struct {
 	MerchantHistory   map[string][]float64
 }
 
-// groupTransactionsByPeriod groups transactions into time periods
+// groupTransactionsByPeriod groups transactions into time periods, or into
+// statement periods when cycle is set
 func (s *SpendingService) groupTransactionsByPeriod(
 	transactions []Transaction,
 	startDate, endDate time.Time,
 	period TimePeriod,
+	cycle *StatementCycle,
 ) []PeriodSpending {
 	periodMap := make(map[time.Time]*PeriodSpending)
 
 	for _, t := range transactions {
-		periodStart := s.getPeriodStart(t.TransactionDate, period)
-		periodEnd := s.getPeriodEnd(periodStart, period)
+		var periodStart, periodEnd time.Time
+		if cycle != nil {
+			periodStart = cycle.PeriodStart(t.TransactionDate)
+			periodEnd = cycle.PeriodEnd(periodStart)
+		} else {
+			periodStart = s.getPeriodStart(t.TransactionDate, period)
+			periodEnd = s.getPeriodEnd(periodStart, period)
+		}
 
 		if ps, exists := periodMap[periodStart]; exists {
 			ps.TotalAmount += t.Amount
@@ -712,6 +794,7 @@ func (s *SpendingService) getTopCategories(
 
 // calculateCategoryTrend calculates the trend for a specific category
 func (s *SpendingService) calculateCategoryTrend(
+	loc *i18n.Localizer,
 	periods []PeriodSpending,
 	category SpendingCategory,
 ) *SpendingTrend {
@@ -744,7 +827,7 @@ func (s *SpendingService) calculateCategoryTrend(
 		direction = TrendDecreasing
 	}
 
-	description := generateTrendDescription(category, direction, changePercent)
+	description := generateTrendDescription(loc, category, direction, changePercent)
 
 	return &SpendingTrend{
 		Category:      category,
@@ -762,7 +845,7 @@ func (s *SpendingService) calculateCategoryTrend(
 }
 
 // calculateOverallTrend calculates the overall spending trend
-func (s *SpendingService) calculateOverallTrend(periods []PeriodSpending) SpendingTrend {
+func (s *SpendingService) calculateOverallTrend(loc *i18n.Localizer, periods []PeriodSpending) SpendingTrend {
 	var amounts []float64
 	for _, p := range periods {
 		amounts = append(amounts, p.TotalAmount)
@@ -798,7 +881,7 @@ func (s *SpendingService) calculateOverallTrend(periods []PeriodSpending) Spendi
 		RSquared:      rSquared,
 		Confidence:    rSquared,
 		PeriodCount:   len(amounts),
-		Description:   generateTrendDescription("overall", direction, changePercent),
+		Description:   generateTrendDescription(loc, "overall", direction, changePercent),
 	}
 }
 
@@ -840,6 +923,7 @@ func (s *SpendingService) calculateSpendingStatistics(transactions []Transaction
 
 // detectAmountAnomalies detects transactions with unusual amounts
 func (s *SpendingService) detectAmountAnomalies(
+	loc *i18n.Localizer,
 	transactions []Transaction,
 	stats spendingStatistics,
 ) []SpendingAnomaly {
@@ -877,7 +961,7 @@ func (s *SpendingService) detectAmountAnomalies(
 				ZScore:          zScore,
 				TransactionID:   t.ID,
 				TransactionDate: t.TransactionDate,
-				Description:     generateAnomalyDescription(anomalyType, t, stats.Mean, zScore),
+				Description:     generateAnomalyDescription(loc, anomalyType, t, stats.Mean, zScore),
 				Confidence:      confidence,
 			})
 		}
@@ -888,6 +972,7 @@ func (s *SpendingService) detectAmountAnomalies(
 
 // detectCategoryAnomalies detects unusual spending in specific categories
 func (s *SpendingService) detectCategoryAnomalies(
+	loc *i18n.Localizer,
 	transactions []Transaction,
 	stats spendingStatistics,
 ) []SpendingAnomaly {
@@ -924,7 +1009,7 @@ func (s *SpendingService) detectCategoryAnomalies(
 				ZScore:          zScore,
 				TransactionID:   t.ID,
 				TransactionDate: t.TransactionDate,
-				Description:     generateCategoryAnomalyDescription(t.Category, t.Amount, catMean, zScore),
+				Description:     generateCategoryAnomalyDescription(loc, t.Category, t.Amount, catMean, zScore),
 				Confidence:      confidence,
 			})
 		}
@@ -934,7 +1019,7 @@ func (s *SpendingService) detectCategoryAnomalies(
 }
 
 // detectDuplicateCharges identifies potential duplicate charges
-func (s *SpendingService) detectDuplicateCharges(transactions []Transaction) []SpendingAnomaly {
+func (s *SpendingService) detectDuplicateCharges(loc *i18n.Localizer, transactions []Transaction) []SpendingAnomaly {
 	var anomalies []SpendingAnomaly
 	seen := make(map[string][]Transaction)
 
@@ -966,7 +1051,7 @@ func (s *SpendingService) detectDuplicateCharges(transactions []Transaction) []S
 					Amount:          txns[i].Amount,
 					TransactionID:   txns[i].ID,
 					TransactionDate: txns[i].TransactionDate,
-					Description:     generateDuplicateDescription(txns[i], txns[i-1]),
+					Description:     generateDuplicateDescription(loc, txns[i], txns[i-1]),
 					Confidence:      0.7,
 				})
 			}
@@ -978,6 +1063,7 @@ func (s *SpendingService) detectDuplicateCharges(transactions []Transaction) []S
 
 // detectLargeTransactions identifies unusually large transactions
 func (s *SpendingService) detectLargeTransactions(
+	loc *i18n.Localizer,
 	transactions []Transaction,
 	stats spendingStatistics,
 ) []SpendingAnomaly {
@@ -1013,7 +1099,7 @@ func (s *SpendingService) detectLargeTransactions(
 				ZScore:          zScore,
 				TransactionID:   t.ID,
 				TransactionDate: t.TransactionDate,
-				Description:     generateLargeTransactionDescription(t, stats.Mean),
+				Description:     generateLargeTransactionDescription(loc, t, stats.Mean),
 				Confidence:      0.9,
 			})
 		}
@@ -1143,50 +1229,55 @@ func formatFloat(f float64) string {
 	return fmt.Sprintf("%.2f", math.Floor(f*100)/100)
 }
 
-func generateTrendDescription(category interface{}, direction TrendDirection, changePercent float64) string {
-	catStr := "Spending"
+func generateTrendDescription(loc *i18n.Localizer, category interface{}, direction TrendDirection, changePercent float64) string {
+	data := map[string]any{}
+	subject := "overall"
 	if cat, ok := category.(SpendingCategory); ok {
-		catStr = string(cat) + " spending"
+		subject = "category"
+		data["Category"] = loc.Category(string(cat))
 	}
 
 	switch direction {
 	case TrendIncreasing:
-		return catStr + " has increased by " + formatPercent(changePercent)
+		data["Percent"] = formatPercent(loc, changePercent)
+		return loc.Message("analysis.trend."+subject+".increasing", data)
 	case TrendDecreasing:
-		return catStr + " has decreased by " + formatPercent(math.Abs(changePercent))
+		data["Percent"] = formatPercent(loc, math.Abs(changePercent))
+		return loc.Message("analysis.trend."+subject+".decreasing", data)
 	default:
-		return catStr + " has remained stable"
+		return loc.Message("analysis.trend."+subject+".stable", data)
 	}
 }
 
-func formatPercent(p float64) string {
-	return fmt.Sprintf("%.1f%%", math.Floor(p*10)/10)
+func formatPercent(loc *i18n.Localizer, p float64) string {
+	return loc.Percent(math.Floor(p*10) / 10)
 }
 
-func generateAnomalyDescription(anomalyType AnomalyType, t Transaction, expected float64, zScore float64) string {
+func generateAnomalyDescription(loc *i18n.Localizer, anomalyType AnomalyType, t Transaction, expected float64, zScore float64) string {
 	switch anomalyType {
 	case AnomalyUnusuallyHigh:
-		return "Transaction amount is unusually high compared to typical spending"
+		return loc.Message("analysis.anomaly.unusually_high", nil)
 	case AnomalyUnusuallyLow:
-		return "Transaction amount is unusually low compared to typical spending"
+		return loc.Message("analysis.anomaly.unusually_low", nil)
 	default:
-		return "Unusual transaction detected"
+		return loc.Message("analysis.anomaly.unusual", nil)
 	}
 }
 
-func generateCategoryAnomalyDescription(category SpendingCategory, amount, expected, zScore float64) string {
+func generateCategoryAnomalyDescription(loc *i18n.Localizer, category SpendingCategory, amount, expected, zScore float64) string {
+	data := map[string]any{"Category": loc.Category(string(category))}
 	if amount > expected {
-		return "Spending in " + string(category) + " is unusually high compared to typical patterns"
+		return loc.Message("analysis.anomaly.category_high", data)
 	}
-	return "Spending in " + string(category) + " is unusually low compared to typical patterns"
+	return loc.Message("analysis.anomaly.category_low", data)
 }
 
-func generateDuplicateDescription(t1, t2 Transaction) string {
-	return "Potential duplicate charge: same amount at " + t1.MerchantName + " within a short time period"
+func generateDuplicateDescription(loc *i18n.Localizer, t1, t2 Transaction) string {
+	return loc.Message("analysis.anomaly.duplicate_charge_at", map[string]any{"Merchant": t1.MerchantName})
 }
 
-func generateLargeTransactionDescription(t Transaction, avgAmount float64) string {
-	return "Large transaction at " + t.MerchantName + " significantly exceeds your average spending"
+func generateLargeTransactionDescription(loc *i18n.Localizer, t Transaction, avgAmount float64) string {
+	return loc.Message("analysis.anomaly.large_transaction_at", map[string]any{"Merchant": t.MerchantName})
 }
 
 func getTopIncreases(changes []CategoryChange, n int) []CategoryChange {
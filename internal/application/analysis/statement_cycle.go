@@ -0,0 +1,108 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// =============================================================================
+// Card Statement Cycles
+// =============================================================================
+
+// Statement periods follow a card's billing cycle rather than the calendar:
+// a period starts the day after the statement closes and ends on the next
+// closing day, so "this statement period" matches what the user owes.
+const (
+	PeriodStatement         TimePeriod     = "statement"
+	BacktestPeriodStatement BacktestPeriod = "statement"
+)
+
+// Errors for statement-aligned analyses
+var (
+	ErrStatementCycleRequired = errors.New("statement periods require a statement cycle")
+	ErrStatementCycleNotFound = errors.New("statement cycle not found")
+	ErrInvalidClosingDay      = errors.New("statement closing day must be between 1 and 31")
+)
+
+// StatementCycle is the billing cycle of a card account
+type StatementCycle struct {
+	AccountID string `json:"account_id,omitempty"`
+	// ClosingDay is the day of the month the statement closes. In months
+	// with fewer days it closes on the last day.
+	ClosingDay int `json:"closing_day"`
+	// CardLastFour limits the cycle's statements to transactions on the
+	// card; empty includes every transaction
+	CardLastFour string `json:"card_last_four,omitempty"`
+}
+
+// StatementCycleRepository looks up the statement cycles of users' card
+// accounts
+type StatementCycleRepository interface {
+	GetStatementCycle(ctx context.Context, userID, accountID string) (*StatementCycle, error)
+}
+
+// Validate checks the cycle's closing day
+func (c StatementCycle) Validate() error {
+	if c.ClosingDay < 1 || c.ClosingDay > 31 {
+		return ErrInvalidClosingDay
+	}
+	return nil
+}
+
+// PeriodStart returns the start of the statement period containing t, the
+// day after the previous statement closed
+func (c StatementCycle) PeriodStart(t time.Time) time.Time {
+	closing := c.closingDate(t.Year(), t.Month(), t.Location())
+	if t.Day() <= closing.Day() {
+		closing = c.closingDate(t.Year(), t.Month()-1, t.Location())
+	}
+	return closing.AddDate(0, 0, 1)
+}
+
+// PeriodEnd returns the end of the statement period starting at start, the
+// last instant of its closing day
+func (c StatementCycle) PeriodEnd(start time.Time) time.Time {
+	return c.NextPeriod(start).Add(-time.Nanosecond)
+}
+
+// NextPeriod returns the start of the statement period after the one
+// starting at start
+func (c StatementCycle) NextPeriod(start time.Time) time.Time {
+	closing := c.closingDate(start.Year(), start.Month(), start.Location())
+	if start.Day() > closing.Day() {
+		closing = c.closingDate(start.Year(), start.Month()+1, start.Location())
+	}
+	return closing.AddDate(0, 0, 1)
+}
+
+// Includes reports whether the transaction belongs to the cycle's card
+func (c StatementCycle) Includes(t Transaction) bool {
+	return c.CardLastFour == "" || t.CardLastFour == c.CardLastFour
+}
+
+// Filter returns the transactions that belong to the cycle's card
+func (c StatementCycle) Filter(transactions []Transaction) []Transaction {
+	if c.CardLastFour == "" {
+		return transactions
+	}
+	var filtered []Transaction
+	for _, t := range transactions {
+		if c.Includes(t) {
+			filtered = append(filtered, t)
+		}
+	}
+	return filtered
+}
+
+// closingDate returns the midnight of the day the statement closes in the
+// given month, which may be out of range as in time.Date
+func (c StatementCycle) closingDate(year int, month time.Month, loc *time.Location) time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, loc)
+	lastDay := first.AddDate(0, 1, -1).Day()
+	day := c.ClosingDay
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(first.Year(), first.Month(), day, 0, 0, 0, 0, loc)
+}
@@ -0,0 +1,84 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestStatementCyclePeriods(t *testing.T) {
+	tests := []struct {
+		name       string
+		closingDay int
+		at         time.Time
+		wantStart  time.Time
+		wantNext   time.Time
+	}{
+		{name: "mid month", closingDay: 15, at: date(2026, time.June, 20), wantStart: date(2026, time.June, 16), wantNext: date(2026, time.July, 16)},
+		{name: "on the closing day", closingDay: 15, at: date(2026, time.June, 15).Add(18 * time.Hour), wantStart: date(2026, time.May, 16), wantNext: date(2026, time.June, 16)},
+		{name: "day after closing", closingDay: 15, at: date(2026, time.June, 16), wantStart: date(2026, time.June, 16), wantNext: date(2026, time.July, 16)},
+		{name: "first of the month", closingDay: 1, at: date(2026, time.March, 1), wantStart: date(2026, time.February, 2), wantNext: date(2026, time.March, 2)},
+		{name: "across the year end", closingDay: 20, at: date(2027, time.January, 5), wantStart: date(2026, time.December, 21), wantNext: date(2027, time.January, 21)},
+		{name: "31st closes on the last day of short months", closingDay: 31, at: date(2026, time.April, 30), wantStart: date(2026, time.April, 1), wantNext: date(2026, time.May, 1)},
+		{name: "31st in February", closingDay: 31, at: date(2026, time.February, 28), wantStart: date(2026, time.February, 1), wantNext: date(2026, time.March, 1)},
+		{name: "31st after February", closingDay: 31, at: date(2026, time.March, 1), wantStart: date(2026, time.March, 1), wantNext: date(2026, time.April, 1)},
+		{name: "31st in a leap February", closingDay: 31, at: date(2028, time.February, 29), wantStart: date(2028, time.February, 1), wantNext: date(2028, time.March, 1)},
+		{name: "31st in January", closingDay: 31, at: date(2026, time.January, 31), wantStart: date(2026, time.January, 1), wantNext: date(2026, time.February, 1)},
+		{name: "30th spans February", closingDay: 30, at: date(2026, time.February, 10), wantStart: date(2026, time.January, 31), wantNext: date(2026, time.March, 1)},
+		{name: "30th after February", closingDay: 30, at: date(2026, time.March, 30), wantStart: date(2026, time.March, 1), wantNext: date(2026, time.March, 31)},
+		{name: "30th on the 31st", closingDay: 30, at: date(2026, time.March, 31), wantStart: date(2026, time.March, 31), wantNext: date(2026, time.May, 1)},
+		{name: "29th in February", closingDay: 29, at: date(2026, time.February, 28), wantStart: date(2026, time.January, 30), wantNext: date(2026, time.March, 1)},
+		{name: "29th in a leap February", closingDay: 29, at: date(2028, time.February, 29), wantStart: date(2028, time.January, 30), wantNext: date(2028, time.March, 1)},
+		{name: "29th after a leap February", closingDay: 29, at: date(2028, time.March, 1), wantStart: date(2028, time.March, 1), wantNext: date(2028, time.March, 30)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cycle := StatementCycle{ClosingDay: tt.closingDay}
+			start := cycle.PeriodStart(tt.at)
+			assert.Equal(t, tt.wantStart, start)
+			assert.Equal(t, tt.wantNext, cycle.NextPeriod(start))
+			assert.Equal(t, tt.wantNext.Add(-time.Nanosecond), cycle.PeriodEnd(start))
+
+			// The period contains the time, and the next one starts where
+			// it ends
+			assert.False(t, tt.at.Before(start))
+			assert.True(t, tt.at.Before(cycle.NextPeriod(start)))
+			assert.Equal(t, cycle.NextPeriod(start), cycle.PeriodStart(cycle.NextPeriod(start)))
+		})
+	}
+}
+
+func TestStatementCyclePeriodsCoverEveryDay(t *testing.T) {
+	// Consecutive periods tile two years, leap day included, for every
+	// closing day
+	for closingDay := 1; closingDay <= 31; closingDay++ {
+		cycle := StatementCycle{ClosingDay: closingDay}
+		start := cycle.PeriodStart(date(2027, time.January, 1))
+		for start.Before(date(2029, time.January, 1)) {
+			next := cycle.NextPeriod(start)
+			closing := next.AddDate(0, 0, -1)
+			lastDay := date(closing.Year(), closing.Month()+1, 0).Day()
+			assert.Equal(t, min(closingDay, lastDay), closing.Day(), "closing day %d in %s", closingDay, closing.Format("2006-01"))
+			for day := start; day.Before(next); day = day.AddDate(0, 0, 1) {
+				if !assert.Equal(t, start, cycle.PeriodStart(day), "closing day %d on %s", closingDay, day.Format(time.DateOnly)) {
+					return
+				}
+			}
+			start = next
+		}
+	}
+}
+
+func TestStatementCycleValidate(t *testing.T) {
+	for _, day := range []int{1, 15, 28, 31} {
+		assert.NoError(t, StatementCycle{ClosingDay: day}.Validate(), day)
+	}
+	for _, day := range []int{-1, 0, 32} {
+		assert.ErrorIs(t, StatementCycle{ClosingDay: day}.Validate(), ErrInvalidClosingDay, day)
+	}
+}
@@ -0,0 +1,173 @@
+package analysis
+
+import (
+	"sort"
+	"strconv"
+)
+
+// =============================================================================
+// Accessible Data Tables
+// =============================================================================
+
+// GenerateVisualizationTables lays out every chart in viz as a data table,
+// so screen-reader frontends can present the values without interpreting
+// chart payloads. Charts without data get no table.
+func (s *BacktestService) GenerateVisualizationTables(viz *VisualizationData) []DataTable {
+	if viz == nil {
+		return nil
+	}
+
+	var tables []DataTable
+	if table, ok := timeSeriesTable("budget_vs_actual_time_series", "Budget vs. actual spending by period", viz.BudgetVsActualTimeSeries); ok {
+		tables = append(tables, table)
+	}
+	if len(viz.CategoryBreakdown) > 0 {
+		tables = append(tables, categoryBreakdownTable(viz.CategoryBreakdown))
+	}
+	if len(viz.VarianceByCategory.Categories) > 0 {
+		tables = append(tables, varianceTable(viz.VarianceByCategory))
+	}
+	if len(viz.PerformanceHeatmap) > 0 {
+		tables = append(tables, heatmapTable(viz.PerformanceHeatmap))
+	}
+	if len(viz.CumulativeSavings) > 0 {
+		tables = append(tables, pointsTable("cumulative_savings", "Cumulative savings by period", "Cumulative savings", viz.CumulativeSavings))
+	}
+
+	// Map order is random, so category trends are listed alphabetically
+	categories := make([]BudgetCategory, 0, len(viz.CategoryTrends))
+	for cat, points := range viz.CategoryTrends {
+		if len(points) > 0 {
+			categories = append(categories, cat)
+		}
+	}
+	sort.Slice(categories, func(i, j int) bool { return categories[i] < categories[j] })
+	for _, cat := range categories {
+		tables = append(tables, pointsTable("category_trends."+string(cat), "Spending trend for "+string(cat), "Amount", viz.CategoryTrends[cat]))
+	}
+
+	if table, ok := timeSeriesTable("forecast_data", "Forecast by period", viz.ForecastData); ok {
+		tables = append(tables, table)
+	}
+
+	return tables
+}
+
+// timeSeriesTable lays out series that share period labels with one column
+// per series. Series are matched by position; a series with fewer points
+// leaves its remaining cells empty.
+func timeSeriesTable(chart, caption string, series []TimeSeriesData) (DataTable, bool) {
+	rowCount := 0
+	for _, ts := range series {
+		rowCount = max(rowCount, len(ts.Data))
+	}
+	if rowCount == 0 {
+		return DataTable{}, false
+	}
+
+	table := DataTable{
+		Chart:   chart,
+		Caption: caption,
+		Headers: []string{"Period"},
+		Rows:    make([][]string, rowCount),
+	}
+	for _, ts := range series {
+		table.Headers = append(table.Headers, ts.Series)
+	}
+
+	for i := range table.Rows {
+		row := make([]string, 1+len(series))
+		for j, ts := range series {
+			if i >= len(ts.Data) {
+				continue
+			}
+			if row[0] == "" {
+				row[0] = ts.Data[i].Label
+			}
+			row[1+j] = formatTableAmount(ts.Data[i].Value)
+		}
+		table.Rows[i] = row
+	}
+
+	return table, true
+}
+
+// pointsTable lays out a single series as period and value columns
+func pointsTable(chart, caption, valueHeader string, points []ChartDataPoint) DataTable {
+	table := DataTable{
+		Chart:   chart,
+		Caption: caption,
+		Headers: []string{"Period", valueHeader},
+		Rows:    make([][]string, len(points)),
+	}
+	for i, p := range points {
+		table.Rows[i] = []string{p.Label, formatTableAmount(p.Value)}
+	}
+	return table
+}
+
+// categoryBreakdownTable lays out pie chart slices with their share
+func categoryBreakdownTable(slices []PieChartData) DataTable {
+	table := DataTable{
+		Chart:   "category_breakdown",
+		Caption: "Spending by category",
+		Headers: []string{"Category", "Amount", "Share"},
+		Rows:    make([][]string, len(slices)),
+	}
+	for i, slice := range slices {
+		table.Rows[i] = []string{slice.Label, formatTableAmount(slice.Value), formatTablePercent(slice.Percentage)}
+	}
+	return table
+}
+
+// varianceTable lays out the budget comparison with a row per category
+func varianceTable(data ComparisonChartData) DataTable {
+	table := DataTable{
+		Chart:   "variance_by_category",
+		Caption: "Budgeted and actual spending by category",
+		Headers: []string{"Category", "Budgeted", "Actual", "Variance"},
+		Rows:    make([][]string, len(data.Categories)),
+	}
+	for i, cat := range data.Categories {
+		table.Rows[i] = []string{
+			cat,
+			formatTableAmount(valueAt(data.Budgeted, i)),
+			formatTableAmount(valueAt(data.Actual, i)),
+			formatTableAmount(valueAt(data.Variance, i)),
+		}
+	}
+	return table
+}
+
+// heatmapTable lays out heatmap cells one per row, which reads better than
+// a grid when navigated cell by cell
+func heatmapTable(cells []HeatmapCell) DataTable {
+	table := DataTable{
+		Chart:   "performance_heatmap",
+		Caption: "Budget variance by category and period",
+		Headers: []string{"Category", "Period", "Variance"},
+		Rows:    make([][]string, len(cells)),
+	}
+	for i, cell := range cells {
+		table.Rows[i] = []string{cell.Row, cell.Column, formatTableAmount(cell.Value)}
+	}
+	return table
+}
+
+// valueAt returns values[i], or zero if values is too short
+func valueAt(values []float64, i int) float64 {
+	if i < len(values) {
+		return values[i]
+	}
+	return 0
+}
+
+// formatTableAmount formats an amount for a table cell
+func formatTableAmount(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
+
+// formatTablePercent formats a percentage for a table cell
+func formatTablePercent(p float64) string {
+	return strconv.FormatFloat(p, 'f', 1, 64) + "%"
+}
@@ -0,0 +1,144 @@
+// Package budgets records changes users make to their budgets mid-period,
+// such as moving money between categories.
+package budgets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/application/organizations"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/budgetreallocation"
+	"clockzen-next/internal/ent/predicate"
+
+	"github.com/google/uuid"
+)
+
+// Errors returned by the budget service
+var (
+	ErrInvalidBudgetID = errors.New("budget_id is required")
+	ErrInvalidCategory = errors.New("category must be one of: housing, food, transportation, utilities, healthcare, entertainment, debt, savings, personal, other")
+	ErrSameCategory    = errors.New("from_category and to_category must differ")
+	ErrInvalidAmount   = errors.New("amount must be positive")
+)
+
+// ReallocationInput describes money moved between two of a budget's
+// categories
+type ReallocationInput struct {
+	FromCategory analysis.BudgetCategory
+	ToCategory   analysis.BudgetCategory
+	Amount       float64
+	// EffectiveDate picks the budget period the money is moved in; zero is
+	// today
+	EffectiveDate time.Time
+	Note          string
+	// OrganizationID is the organization whose budget is changed; empty for
+	// the user's own budget
+	OrganizationID string
+}
+
+// Service records budget reallocations
+type Service struct {
+	entClient *ent.Client
+}
+
+// NewService creates a new budget service
+func NewService(entClient *ent.Client) *Service {
+	return &Service{
+		entClient: entClient,
+	}
+}
+
+// Reallocate moves money between two categories of the user's budget, or
+// the organization's if input.OrganizationID is set, in the period
+// containing the effective date. Reallocations are kept as the
+// budget's history and can't be changed; money is moved back with another
+// reallocation.
+func (s *Service) Reallocate(ctx context.Context, userID, budgetID string, input ReallocationInput) (*ent.BudgetReallocation, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+	if budgetID == "" {
+		return nil, ErrInvalidBudgetID
+	}
+	if !input.FromCategory.IsValid() || !input.ToCategory.IsValid() {
+		return nil, ErrInvalidCategory
+	}
+	if input.FromCategory == input.ToCategory {
+		return nil, ErrSameCategory
+	}
+	if input.Amount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	if input.EffectiveDate.IsZero() {
+		input.EffectiveDate = time.Now()
+	}
+
+	create := s.entClient.BudgetReallocation.Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetBudgetID(budgetID).
+		SetFromCategory(string(input.FromCategory)).
+		SetToCategory(string(input.ToCategory)).
+		SetAmount(input.Amount).
+		SetEffectiveDate(input.EffectiveDate)
+	if input.Note != "" {
+		create.SetNote(input.Note)
+	}
+	if input.OrganizationID != "" {
+		create.SetOrganizationID(input.OrganizationID)
+	}
+
+	record, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating budget reallocation: %w", err)
+	}
+	return record, nil
+}
+
+// ListReallocations returns the history of reallocations of the budget of
+// the user or organization, oldest first
+func (s *Service) ListReallocations(ctx context.Context, userID, budgetID string) ([]*ent.BudgetReallocation, error) {
+	records, err := s.entClient.BudgetReallocation.Query().
+		Where(
+			organizations.OwnedBy[predicate.BudgetReallocation](userID),
+			budgetreallocation.BudgetID(budgetID),
+		).
+		Order(
+			ent.Asc(budgetreallocation.FieldEffectiveDate),
+			ent.Asc(budgetreallocation.FieldCreatedAt),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying budget reallocations: %w", err)
+	}
+	return records, nil
+}
+
+// GetReallocations returns the reallocations of the user's budget for
+// backtests. The service implements analysis.ReallocationRepository.
+func (s *Service) GetReallocations(ctx context.Context, userID, budgetID string) ([]analysis.BudgetReallocation, error) {
+	records, err := s.ListReallocations(ctx, userID, budgetID)
+	if err != nil {
+		return nil, err
+	}
+
+	reallocations := make([]analysis.BudgetReallocation, len(records))
+	for i, record := range records {
+		reallocations[i] = analysis.BudgetReallocation{
+			ID:            record.ID,
+			FromCategory:  analysis.BudgetCategory(record.FromCategory),
+			ToCategory:    analysis.BudgetCategory(record.ToCategory),
+			Amount:        record.Amount,
+			EffectiveDate: record.EffectiveDate,
+			CreatedAt:     record.CreatedAt,
+		}
+		if record.Note != nil {
+			reallocations[i].Note = *record.Note
+		}
+	}
+	return reallocations, nil
+}
@@ -0,0 +1,36 @@
+package debts
+
+import (
+	"context"
+	"time"
+
+	"clockzen-next/internal/application/analysis"
+)
+
+// PlanDebtPayoff returns the monthly schedule of paying off the user's
+// debts with a strategy (avalanche if empty) for what-if analysis. The
+// service implements analysis.DebtPayoffPlanner.
+func (s *Service) PlanDebtPayoff(ctx context.Context, userID string, strategy string, extraPayment float64) ([]analysis.DebtPayoffMonth, error) {
+	if strategy == "" {
+		strategy = string(StrategyAvalanche)
+	}
+	debts, err := s.planDebts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := Plan(debts, extraPayment, Strategy(strategy), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := make([]analysis.DebtPayoffMonth, len(plan.Schedule))
+	for i, month := range plan.Schedule {
+		schedule[i] = analysis.DebtPayoffMonth{
+			Payment:          month.Payment,
+			Interest:         month.Interest,
+			RemainingBalance: month.RemainingBalance,
+		}
+	}
+	return schedule, nil
+}
@@ -0,0 +1,304 @@
+package debts
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"clockzen-next/internal/domain/money"
+)
+
+// Strategy is the order extra payments are put toward debts
+type Strategy string
+
+const (
+	// StrategyAvalanche pays the highest rate debt first, which costs the
+	// least interest
+	StrategyAvalanche Strategy = "avalanche"
+	// StrategySnowball pays the smallest balance first, which clears
+	// individual debts soonest
+	StrategySnowball Strategy = "snowball"
+)
+
+// MaxPayoffMonths caps simulations; debts not paid off within 50 years are
+// treated as never paid off
+const MaxPayoffMonths = 600
+
+// ErrNeverPaidOff is returned when the payments don't cover the interest,
+// so the debts would never be paid off
+var ErrNeverPaidOff = errors.New("payments don't cover the interest; the debts would never be paid off")
+
+// PlanDebt is a debt to plan paying off
+type PlanDebt struct {
+	ID             string
+	Name           string
+	Balance        float64
+	APR            float64
+	MinimumPayment float64
+}
+
+// DebtPayoff is when a single debt is paid off under a plan
+type DebtPayoff struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	PayoffMonth  int       `json:"payoff_month"`
+	PayoffDate   time.Time `json:"payoff_date"`
+	InterestPaid float64   `json:"interest_paid"`
+}
+
+// PayoffMonth totals the payments made in a month of a plan
+type PayoffMonth struct {
+	Month            int       `json:"month"`
+	Date             time.Time `json:"date"`
+	Payment          float64   `json:"payment"`
+	Interest         float64   `json:"interest"`
+	Principal        float64   `json:"principal"`
+	RemainingBalance float64   `json:"remaining_balance"`
+}
+
+// PayoffPlan is the month by month schedule of paying off debts with a
+// strategy
+type PayoffPlan struct {
+	Strategy      Strategy  `json:"strategy,omitempty"`
+	MonthlyBudget float64   `json:"monthly_budget"`
+	Months        int       `json:"months"`
+	PayoffDate    time.Time `json:"payoff_date"`
+	TotalInterest float64   `json:"total_interest"`
+	TotalPaid     float64   `json:"total_paid"`
+	// InterestSaved is the interest saved over paying only each debt's
+	// minimum, if minimum payments alone would ever pay the debts off
+	InterestSaved *float64      `json:"interest_saved,omitempty"`
+	Debts         []DebtPayoff  `json:"debts"`
+	Schedule      []PayoffMonth `json:"schedule"`
+}
+
+// PlanComparison compares the avalanche and snowball plans for the same
+// debts and monthly budget
+type PlanComparison struct {
+	ExtraPayment float64     `json:"extra_payment"`
+	Avalanche    *PayoffPlan `json:"avalanche"`
+	Snowball     *PayoffPlan `json:"snowball"`
+	// MinimumOnly pays each debt's minimum without putting freed up
+	// payments toward the others; nil if that would never pay them off
+	MinimumOnly *PayoffPlan `json:"minimum_only,omitempty"`
+	// AvalancheInterestSaved is the interest avalanche saves over snowball
+	AvalancheInterestSaved float64  `json:"avalanche_interest_saved"`
+	Recommended            Strategy `json:"recommended"`
+}
+
+// ValidateStrategy checks that strategy is avalanche or snowball
+func ValidateStrategy(strategy Strategy) error {
+	switch strategy {
+	case StrategyAvalanche, StrategySnowball:
+		return nil
+	default:
+		return ErrInvalidStrategy
+	}
+}
+
+// ComparePlans plans paying off the debts with both strategies, putting
+// extraPayment on top of the minimums each month. Plans start the month
+// after start.
+func ComparePlans(debts []PlanDebt, extraPayment float64, start time.Time) (*PlanComparison, error) {
+	avalanche, err := Plan(debts, extraPayment, StrategyAvalanche, start)
+	if err != nil {
+		return nil, err
+	}
+	snowball, err := Plan(debts, extraPayment, StrategySnowball, start)
+	if err != nil {
+		return nil, err
+	}
+
+	comparison := &PlanComparison{
+		ExtraPayment:           extraPayment,
+		Avalanche:              avalanche,
+		Snowball:               snowball,
+		AvalancheInterestSaved: money.RoundCents(snowball.TotalInterest - avalanche.TotalInterest),
+		Recommended:            StrategyAvalanche,
+	}
+	// Snowball costs no more here and clears debts sooner
+	if comparison.AvalancheInterestSaved <= 0 {
+		comparison.Recommended = StrategySnowball
+	}
+
+	if minimumOnly, err := simulate(debts, 0, "", false, start); err == nil {
+		comparison.MinimumOnly = minimumOnly
+	}
+	return comparison, nil
+}
+
+// Plan simulates paying off the debts with a strategy. Each month interest
+// accrues, every debt gets its minimum payment and the rest of the monthly
+// budget (the sum of the minimums plus extraPayment) goes to the debt the
+// strategy targets. A debt's minimum keeps going toward the others once it
+// is paid off.
+func Plan(debts []PlanDebt, extraPayment float64, strategy Strategy, start time.Time) (*PayoffPlan, error) {
+	if err := ValidateStrategy(strategy); err != nil {
+		return nil, err
+	}
+	if extraPayment < 0 {
+		return nil, ErrInvalidExtraPayment
+	}
+
+	plan, err := simulate(debts, extraPayment, strategy, true, start)
+	if err != nil {
+		return nil, err
+	}
+
+	if minimumOnly, err := simulate(debts, 0, "", false, start); err == nil {
+		saved := money.RoundCents(minimumOnly.TotalInterest - plan.TotalInterest)
+		plan.InterestSaved = &saved
+	}
+	return plan, nil
+}
+
+// debtState tracks a debt during a simulation, in cents
+type debtState struct {
+	debt     PlanDebt
+	balance  int64
+	minimum  int64
+	interest int64
+	paidOff  int
+	paid     bool
+}
+
+// simulate runs a payoff simulation. With rollover, payments freed up by
+// paid off debts and extraPayment go to the strategy's target; without it
+// each debt is paid only its minimum and extraPayment is ignored.
+func simulate(debts []PlanDebt, extraPayment float64, strategy Strategy, rollover bool, start time.Time) (*PayoffPlan, error) {
+	states := make([]*debtState, len(debts))
+	var budget int64
+	for i, d := range debts {
+		states[i] = &debtState{
+			debt:    d,
+			balance: toCents(d.Balance),
+			minimum: toCents(d.MinimumPayment),
+		}
+		states[i].paid = states[i].balance == 0
+		budget += states[i].minimum
+	}
+	if rollover {
+		budget += toCents(extraPayment)
+	}
+
+	// Targets are ordered once, up front, as the strategies are usually
+	// followed; ties go to the smaller balance, then the higher rate
+	order := make([]*debtState, len(states))
+	copy(order, states)
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := order[i].debt, order[j].debt
+		if strategy == StrategySnowball {
+			if a.Balance != b.Balance {
+				return a.Balance < b.Balance
+			}
+			return a.APR > b.APR
+		}
+		if a.APR != b.APR {
+			return a.APR > b.APR
+		}
+		return a.Balance < b.Balance
+	})
+
+	plan := &PayoffPlan{
+		Strategy:      strategy,
+		MonthlyBudget: fromCents(budget),
+		Schedule:      []PayoffMonth{},
+	}
+
+	var totalInterest, totalPaid int64
+	for month := 1; remaining(states) > 0; month++ {
+		if month > MaxPayoffMonths {
+			return nil, ErrNeverPaidOff
+		}
+
+		var monthInterest, monthPayment int64
+		for _, s := range states {
+			if s.balance == 0 {
+				continue
+			}
+			interest := int64(math.Round(float64(s.balance) * s.debt.APR / 100 / 12))
+			s.balance += interest
+			s.interest += interest
+			monthInterest += interest
+		}
+
+		// Minimums first
+		available := budget
+		for _, s := range states {
+			if s.balance == 0 {
+				continue
+			}
+			payment := min(s.minimum, s.balance)
+			s.balance -= payment
+			available -= payment
+			monthPayment += payment
+		}
+
+		// Then the rest of the budget, in strategy order
+		if rollover {
+			for _, s := range order {
+				if available <= 0 {
+					break
+				}
+				payment := min(available, s.balance)
+				s.balance -= payment
+				available -= payment
+				monthPayment += payment
+			}
+		}
+
+		for _, s := range states {
+			if s.balance == 0 && !s.paid {
+				s.paid = true
+				s.paidOff = month
+			}
+		}
+
+		totalInterest += monthInterest
+		totalPaid += monthPayment
+		plan.Months = month
+		plan.Schedule = append(plan.Schedule, PayoffMonth{
+			Month:            month,
+			Date:             start.AddDate(0, month, 0),
+			Payment:          fromCents(monthPayment),
+			Interest:         fromCents(monthInterest),
+			Principal:        fromCents(monthPayment - monthInterest),
+			RemainingBalance: fromCents(remaining(states)),
+		})
+	}
+
+	plan.PayoffDate = start.AddDate(0, plan.Months, 0)
+	plan.TotalInterest = fromCents(totalInterest)
+	plan.TotalPaid = fromCents(totalPaid)
+	plan.Debts = make([]DebtPayoff, len(order))
+	for i, s := range order {
+		plan.Debts[i] = DebtPayoff{
+			ID:           s.debt.ID,
+			Name:         s.debt.Name,
+			PayoffMonth:  s.paidOff,
+			PayoffDate:   start.AddDate(0, s.paidOff, 0),
+			InterestPaid: fromCents(s.interest),
+		}
+	}
+	return plan, nil
+}
+
+// remaining totals the balances left, in cents
+func remaining(states []*debtState) int64 {
+	var total int64
+	for _, s := range states {
+		total += s.balance
+	}
+	return total
+}
+
+// toCents converts an amount to whole cents
+func toCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+// fromCents converts whole cents to an amount
+func fromCents(cents int64) float64 {
+	return float64(cents) / 100
+}
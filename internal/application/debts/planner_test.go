@@ -0,0 +1,145 @@
+package debts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var planStart = time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// testDebts has a high rate card with the larger balance and a low rate
+// loan with the smaller one, so the strategies target different debts
+func testDebts() []PlanDebt {
+	return []PlanDebt{
+		{ID: "loan", Name: "Car loan", Balance: 500, APR: 6, MinimumPayment: 25},
+		{ID: "card", Name: "Credit card", Balance: 1000, APR: 24, MinimumPayment: 50},
+	}
+}
+
+func payoffMonth(t *testing.T, plan *PayoffPlan, id string) int {
+	t.Helper()
+	for _, d := range plan.Debts {
+		if d.ID == id {
+			return d.PayoffMonth
+		}
+	}
+	t.Fatalf("debt %s not in plan", id)
+	return 0
+}
+
+func TestPlanPayoffOrder(t *testing.T) {
+	t.Run("avalanche pays the highest rate first", func(t *testing.T) {
+		plan, err := Plan(testDebts(), 200, StrategyAvalanche, planStart)
+		require.NoError(t, err)
+
+		assert.Equal(t, "card", plan.Debts[0].ID)
+		assert.Less(t, payoffMonth(t, plan, "card"), payoffMonth(t, plan, "loan"))
+	})
+
+	t.Run("snowball pays the smallest balance first", func(t *testing.T) {
+		plan, err := Plan(testDebts(), 200, StrategySnowball, planStart)
+		require.NoError(t, err)
+
+		assert.Equal(t, "loan", plan.Debts[0].ID)
+		assert.Less(t, payoffMonth(t, plan, "loan"), payoffMonth(t, plan, "card"))
+	})
+
+	t.Run("avalanche costs less interest", func(t *testing.T) {
+		comparison, err := ComparePlans(testDebts(), 200, planStart)
+		require.NoError(t, err)
+
+		assert.Less(t, comparison.Avalanche.TotalInterest, comparison.Snowball.TotalInterest)
+		assert.Positive(t, comparison.AvalancheInterestSaved)
+		assert.Equal(t, StrategyAvalanche, comparison.Recommended)
+		require.NotNil(t, comparison.MinimumOnly)
+		assert.Greater(t, comparison.MinimumOnly.Months, comparison.Avalanche.Months)
+	})
+
+	t.Run("every debt is paid off by the last month", func(t *testing.T) {
+		plan, err := Plan(testDebts(), 200, StrategyAvalanche, planStart)
+		require.NoError(t, err)
+
+		last := plan.Schedule[len(plan.Schedule)-1]
+		assert.Zero(t, last.RemainingBalance)
+		assert.Equal(t, plan.Months, last.Month)
+		assert.Equal(t, planStart.AddDate(0, plan.Months, 0), plan.PayoffDate)
+		assert.InDelta(t, 1500+plan.TotalInterest, plan.TotalPaid, 0.001)
+	})
+}
+
+func TestPlanExtraPayment(t *testing.T) {
+	debts := []PlanDebt{{ID: "loan", Balance: 1000, APR: 0, MinimumPayment: 100}}
+
+	tests := []struct {
+		name       string
+		extra      float64
+		wantBudget float64
+		wantMonths int
+	}{
+		{name: "minimum only", extra: 0, wantBudget: 100, wantMonths: 10},
+		{name: "extra goes to the target", extra: 150, wantBudget: 250, wantMonths: 4},
+		{name: "partial last month", extra: 200, wantBudget: 300, wantMonths: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := Plan(debts, tt.extra, StrategyAvalanche, planStart)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantBudget, plan.MonthlyBudget)
+			assert.Equal(t, tt.wantMonths, plan.Months)
+			assert.Equal(t, tt.wantBudget, plan.Schedule[0].Payment)
+			assert.Equal(t, 1000.0, plan.TotalPaid)
+			assert.Zero(t, plan.TotalInterest)
+			require.NotNil(t, plan.InterestSaved)
+			assert.Zero(t, *plan.InterestSaved)
+		})
+	}
+}
+
+func TestPlanFreedMinimumRollsOver(t *testing.T) {
+	debts := []PlanDebt{
+		{ID: "small", Balance: 100, MinimumPayment: 50},
+		{ID: "large", Balance: 1000, MinimumPayment: 50},
+	}
+
+	plan, err := Plan(debts, 0, StrategySnowball, planStart)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, payoffMonth(t, plan, "small"))
+	// 900 left after two months at 50, then 100 a month
+	assert.Equal(t, 11, payoffMonth(t, plan, "large"))
+	assert.Equal(t, 100.0, plan.Schedule[5].Payment)
+}
+
+func TestPlanNeverPaidOff(t *testing.T) {
+	// 2% a month on 10,000 is 200 of interest against a 100 minimum
+	debts := []PlanDebt{{ID: "card", Balance: 10000, APR: 24, MinimumPayment: 100}}
+
+	_, err := Plan(debts, 0, StrategyAvalanche, planStart)
+	assert.ErrorIs(t, err, ErrNeverPaidOff)
+
+	t.Run("enough extra pays it off", func(t *testing.T) {
+		plan, err := Plan(debts, 400, StrategyAvalanche, planStart)
+		require.NoError(t, err)
+		assert.Positive(t, plan.Months)
+		assert.Nil(t, plan.InterestSaved, "minimums alone never pay it off")
+	})
+
+	t.Run("comparison has no minimum-only plan", func(t *testing.T) {
+		comparison, err := ComparePlans(debts, 400, planStart)
+		require.NoError(t, err)
+		assert.Nil(t, comparison.MinimumOnly)
+	})
+}
+
+func TestPlanValidation(t *testing.T) {
+	_, err := Plan(testDebts(), 0, Strategy("highest_first"), planStart)
+	assert.ErrorIs(t, err, ErrInvalidStrategy)
+
+	_, err = Plan(testDebts(), -10, StrategyAvalanche, planStart)
+	assert.ErrorIs(t, err, ErrInvalidExtraPayment)
+}
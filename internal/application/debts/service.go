@@ -0,0 +1,270 @@
+// Package debts tracks a user's debts and plans paying them off.
+package debts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"clockzen-next/internal/domain/money"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/debt"
+
+	"github.com/google/uuid"
+)
+
+// Errors returned by the debt service
+var (
+	ErrDebtNotFound          = errors.New("debt not found")
+	ErrInvalidName           = errors.New("name is required")
+	ErrInvalidType           = errors.New("type must be one of: credit_card, student_loan, auto_loan, mortgage, personal_loan, medical, other")
+	ErrInvalidBalance        = errors.New("balance must not be negative")
+	ErrInvalidAPR            = errors.New("apr must not be negative")
+	ErrInvalidMinimumPayment = errors.New("minimum_payment must be positive")
+	ErrInvalidExtraPayment   = errors.New("extra_payment must not be negative")
+	ErrInvalidStrategy       = errors.New("strategy must be avalanche or snowball")
+)
+
+// DebtInput describes a debt to record
+type DebtInput struct {
+	Name           string
+	Type           debt.Type
+	Balance        float64
+	APR            float64
+	MinimumPayment float64
+}
+
+// DebtUpdate changes the fields of a debt that are set
+type DebtUpdate struct {
+	Name           *string
+	Type           *debt.Type
+	Balance        *float64
+	APR            *float64
+	MinimumPayment *float64
+}
+
+// Summary totals a user's debts for the dashboard, with when they would be
+// paid off under the recommended strategy
+type Summary struct {
+	UserID              string
+	DebtCount           int
+	TotalBalance        float64
+	TotalMinimumPayment float64
+	// WeightedAPR is the average rate weighted by balance
+	WeightedAPR float64
+	// Plan compares the strategies at minimum payments; nil when the user
+	// has no debts or the minimums never pay them off
+	Plan *PlanComparison
+}
+
+// Service records debts and plans paying them off
+type Service struct {
+	entClient *ent.Client
+}
+
+// NewService creates a new debt service
+func NewService(entClient *ent.Client) *Service {
+	return &Service{
+		entClient: entClient,
+	}
+}
+
+// CreateDebt records a debt for the user
+func (s *Service) CreateDebt(ctx context.Context, userID string, input DebtInput) (*ent.Debt, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+	if input.Type == "" {
+		input.Type = debt.DefaultType
+	}
+	if err := validateDebt(input); err != nil {
+		return nil, err
+	}
+
+	record, err := s.entClient.Debt.Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetName(input.Name).
+		SetType(input.Type).
+		SetBalance(input.Balance).
+		SetApr(input.APR).
+		SetMinimumPayment(input.MinimumPayment).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating debt: %w", err)
+	}
+	return record, nil
+}
+
+// ListDebts returns the user's debts, highest rate first
+func (s *Service) ListDebts(ctx context.Context, userID string) ([]*ent.Debt, error) {
+	records, err := s.entClient.Debt.Query().
+		Where(debt.UserID(userID)).
+		Order(ent.Desc(debt.FieldApr), ent.Asc(debt.FieldBalance)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying debts: %w", err)
+	}
+	return records, nil
+}
+
+// GetDebt returns one of the user's debts
+func (s *Service) GetDebt(ctx context.Context, userID, id string) (*ent.Debt, error) {
+	record, err := s.entClient.Debt.Query().
+		Where(debt.ID(id), debt.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrDebtNotFound
+		}
+		return nil, fmt.Errorf("getting debt: %w", err)
+	}
+	return record, nil
+}
+
+// UpdateDebt changes one of the user's debts, e.g. to record its balance
+// after a payment
+func (s *Service) UpdateDebt(ctx context.Context, userID, id string, input DebtUpdate) (*ent.Debt, error) {
+	record, err := s.GetDebt(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := DebtInput{
+		Name:           record.Name,
+		Type:           record.Type,
+		Balance:        record.Balance,
+		APR:            record.Apr,
+		MinimumPayment: record.MinimumPayment,
+	}
+	if input.Name != nil {
+		merged.Name = *input.Name
+	}
+	if input.Type != nil {
+		merged.Type = *input.Type
+	}
+	if input.Balance != nil {
+		merged.Balance = *input.Balance
+	}
+	if input.APR != nil {
+		merged.APR = *input.APR
+	}
+	if input.MinimumPayment != nil {
+		merged.MinimumPayment = *input.MinimumPayment
+	}
+	if err := validateDebt(merged); err != nil {
+		return nil, err
+	}
+
+	record, err = record.Update().
+		SetName(merged.Name).
+		SetType(merged.Type).
+		SetBalance(merged.Balance).
+		SetApr(merged.APR).
+		SetMinimumPayment(merged.MinimumPayment).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("updating debt: %w", err)
+	}
+	return record, nil
+}
+
+// DeleteDebt removes one of the user's debts, e.g. once it is paid off
+func (s *Service) DeleteDebt(ctx context.Context, userID, id string) error {
+	deleted, err := s.entClient.Debt.Delete().
+		Where(debt.ID(id), debt.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting debt: %w", err)
+	}
+	if deleted == 0 {
+		return ErrDebtNotFound
+	}
+	return nil
+}
+
+// ComparePlans plans paying off the user's debts with both strategies,
+// putting extraPayment toward them each month on top of the minimums
+func (s *Service) ComparePlans(ctx context.Context, userID string, extraPayment float64) (*PlanComparison, error) {
+	if extraPayment < 0 {
+		return nil, ErrInvalidExtraPayment
+	}
+	debts, err := s.planDebts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return ComparePlans(debts, extraPayment, time.Now())
+}
+
+// GetSummary totals the user's debts for the dashboard
+func (s *Service) GetSummary(ctx context.Context, userID string) (*Summary, error) {
+	debts, err := s.planDebts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &Summary{
+		UserID:    userID,
+		DebtCount: len(debts),
+	}
+	weightedRate := 0.0
+	for _, d := range debts {
+		summary.TotalBalance += d.Balance
+		summary.TotalMinimumPayment += d.MinimumPayment
+		weightedRate += d.Balance * d.APR
+	}
+	if summary.TotalBalance > 0 {
+		summary.WeightedAPR = money.RoundCents(weightedRate / summary.TotalBalance)
+	}
+	summary.TotalBalance = money.RoundCents(summary.TotalBalance)
+	summary.TotalMinimumPayment = money.RoundCents(summary.TotalMinimumPayment)
+
+	if len(debts) > 0 {
+		plan, err := ComparePlans(debts, 0, time.Now())
+		if err != nil && !errors.Is(err, ErrNeverPaidOff) {
+			return nil, err
+		}
+		summary.Plan = plan
+	}
+	return summary, nil
+}
+
+// planDebts loads the user's debts for planning
+func (s *Service) planDebts(ctx context.Context, userID string) ([]PlanDebt, error) {
+	records, err := s.ListDebts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	debts := make([]PlanDebt, len(records))
+	for i, record := range records {
+		debts[i] = PlanDebt{
+			ID:             record.ID,
+			Name:           record.Name,
+			Balance:        record.Balance,
+			APR:            record.Apr,
+			MinimumPayment: record.MinimumPayment,
+		}
+	}
+	return debts, nil
+}
+
+// validateDebt checks a debt's fields before it is saved
+func validateDebt(input DebtInput) error {
+	if input.Name == "" {
+		return ErrInvalidName
+	}
+	if debt.TypeValidator(input.Type) != nil {
+		return ErrInvalidType
+	}
+	if input.Balance < 0 {
+		return ErrInvalidBalance
+	}
+	if input.APR < 0 {
+		return ErrInvalidAPR
+	}
+	if input.MinimumPayment <= 0 {
+		return ErrInvalidMinimumPayment
+	}
+	return nil
+}
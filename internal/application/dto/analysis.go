@@ -16,6 +16,9 @@ const (
 	AnalysisTypeBacktest  AnalysisType = "backtest"
 	AnalysisTypeWhatIf    AnalysisType = "what_if"
 	AnalysisTypeComparison AnalysisType = "comparison"
+	AnalysisTypeBillNegotiation AnalysisType = "bill_negotiation"
+	AnalysisTypeRecurring AnalysisType = "recurring"
+	AnalysisTypeForecast AnalysisType = "forecast"
 )
 
 // TimePeriod represents a time period for analysis
@@ -26,6 +29,8 @@ const (
 	TimePeriodWeekly  TimePeriod = "weekly"
 	TimePeriodMonthly TimePeriod = "monthly"
 	TimePeriodYearly  TimePeriod = "yearly"
+	// TimePeriodStatement follows the statement cycle of a card account
+	TimePeriodStatement TimePeriod = "statement"
 )
 
 // TrendDirection indicates the direction of a trend
@@ -78,6 +83,9 @@ type SpendingAnalysisRequest struct {
 	EndDate   time.Time  `json:"end_date"`
 	Period    TimePeriod `json:"period,omitempty"`
 	Category  string     `json:"category,omitempty"`
+	// CardAccountID is the card whose statement periods are analyzed;
+	// required when period is "statement"
+	CardAccountID string `json:"card_account_id,omitempty"`
 }
 
 // CategorySpendingResponse represents spending for a single category
@@ -108,7 +116,20 @@ type SpendingAnalysisResponse struct {
 	TotalSpending    float64                    `json:"total_spending"`
 	AveragePerPeriod float64                    `json:"average_per_period"`
 	TopCategories    []CategorySpendingResponse `json:"top_categories"`
-	AnalyzedAt       time.Time                  `json:"analyzed_at"`
+	// Members splits spending by household member; omitted when no
+	// spending is attributed to one
+	Members    []MemberSpendingResponse `json:"members,omitempty"`
+	AnalyzedAt time.Time                `json:"analyzed_at"`
+}
+
+// MemberSpendingResponse represents a household member's share of spending.
+// MemberID is empty for shared spending.
+type MemberSpendingResponse struct {
+	MemberID         string                     `json:"member_id"`
+	Amount           float64                    `json:"amount"`
+	TransactionCount int                        `json:"transaction_count"`
+	Percentage       float64                    `json:"percentage"`
+	ByCategory       []CategorySpendingResponse `json:"by_category"`
 }
 
 // =============================================================================
@@ -205,6 +226,19 @@ type BudgetRequest struct {
 	CategoryBudgets map[string]float64 `json:"category_budgets,omitempty"`
 	Income          float64            `json:"income,omitempty"`
 	SavingsGoal     float64            `json:"savings_goal,omitempty"`
+	// CardAccountID is the card whose statement periods the budget
+	// follows; required when period is "statement"
+	CardAccountID string `json:"card_account_id,omitempty"`
+	// MemberBudgets are household members' sub-budgets. The amounts above
+	// cover shared spending; the household budget adds the members'.
+	MemberBudgets []MemberBudgetRequest `json:"member_budgets,omitempty"`
+}
+
+// MemberBudgetRequest represents a household member's sub-budget
+type MemberBudgetRequest struct {
+	MemberID        string             `json:"member_id"`
+	TotalBudget     float64            `json:"total_budget"`
+	CategoryBudgets map[string]float64 `json:"category_budgets,omitempty"`
 }
 
 // BacktestRequest represents a request to run a backtest
@@ -238,6 +272,37 @@ type PeriodBacktestResponse struct {
 	TransactionCount int                          `json:"transaction_count"`
 	LargestExpense   float64                      `json:"largest_expense"`
 	AverageDaily     float64                      `json:"average_daily"`
+	// MemberResults compare household members' spending with their
+	// sub-budgets
+	MemberResults []MemberBudgetResultResponse `json:"member_results,omitempty"`
+	// Reallocations moved money between categories in the period; the
+	// category results use the reallocated figures
+	Reallocations []BudgetReallocationResponse `json:"reallocations,omitempty"`
+}
+
+// BudgetReallocationResponse represents money moved between two of a
+// budget's categories in a period
+type BudgetReallocationResponse struct {
+	ID           string `json:"id"`
+	FromCategory string `json:"from_category"`
+	ToCategory   string `json:"to_category"`
+	// Amount is the amount moved, at most what the category had left
+	Amount        float64   `json:"amount"`
+	EffectiveDate time.Time `json:"effective_date"`
+	Note          string    `json:"note,omitempty"`
+}
+
+// MemberBudgetResultResponse represents how a household member's spending
+// compared with their sub-budget in one period
+type MemberBudgetResultResponse struct {
+	MemberID         string                       `json:"member_id"`
+	BudgetedAmount   float64                      `json:"budgeted_amount"`
+	ActualAmount     float64                      `json:"actual_amount"`
+	Variance         float64                      `json:"variance"`
+	VariancePercent  float64                      `json:"variance_percent"`
+	Performance      BudgetPerformance            `json:"performance"`
+	CategoryResults  []CategoryAllocationResponse `json:"category_results"`
+	TransactionCount int                          `json:"transaction_count"`
 }
 
 // BacktestSummaryResponse provides aggregate statistics for the backtest
@@ -299,30 +364,48 @@ type WhatIfRequest struct {
 	TimeframeMonths  int                `json:"timeframe_months,omitempty"`
 	OneTimeExpense   float64            `json:"one_time_expense,omitempty"`
 	RecurringChange  float64            `json:"recurring_change,omitempty"`
+	RoundUpIncrement float64            `json:"round_up_increment,omitempty"`
+	DebtStrategy     string             `json:"debt_strategy,omitempty"`
+	ExtraDebtPayment float64            `json:"extra_debt_payment,omitempty"`
+	WindfallAmount   float64            `json:"windfall_amount,omitempty"`
+	WindfallMonth    int                `json:"windfall_month,omitempty"`
+	WindfallTaxRate  float64            `json:"windfall_tax_rate,omitempty"`
+	WindfallAccount  string             `json:"windfall_account,omitempty"`
 }
 
 // WhatIfProjectionResponse represents a projected month
 type WhatIfProjectionResponse struct {
-	Month             int                `json:"month"`
-	Date              time.Time          `json:"date"`
-	ProjectedIncome   float64            `json:"projected_income"`
-	ProjectedExpenses float64            `json:"projected_expenses"`
-	ProjectedSavings  float64            `json:"projected_savings"`
-	CumulativeSavings float64            `json:"cumulative_savings"`
-	BudgetVariance    float64            `json:"budget_variance"`
-	CategoryBreakdown map[string]float64 `json:"category_breakdown"`
-	GoalProgress      float64            `json:"goal_progress,omitempty"`
+	Month              int                `json:"month"`
+	Date               time.Time          `json:"date"`
+	ProjectedIncome    float64            `json:"projected_income"`
+	ProjectedExpenses  float64            `json:"projected_expenses"`
+	ProjectedSavings   float64            `json:"projected_savings"`
+	CumulativeSavings  float64            `json:"cumulative_savings"`
+	BudgetVariance     float64            `json:"budget_variance"`
+	CategoryBreakdown  map[string]float64 `json:"category_breakdown"`
+	GoalProgress       float64            `json:"goal_progress,omitempty"`
+	RoundUpSavings     float64            `json:"round_up_savings,omitempty"`
+	CumulativeRoundUps float64            `json:"cumulative_round_ups,omitempty"`
+	DebtPayment        float64            `json:"debt_payment,omitempty"`
+	DebtBalance        float64            `json:"debt_balance,omitempty"`
+	WindfallIncome     float64            `json:"windfall_income,omitempty"`
 }
 
 // WhatIfComparisonResponse compares baseline vs scenario
 type WhatIfComparisonResponse struct {
-	BaselineTotal     float64 `json:"baseline_total"`
-	ScenarioTotal     float64 `json:"scenario_total"`
-	Difference        float64 `json:"difference"`
-	DifferencePercent float64 `json:"difference_percent"`
-	BaselineSavings   float64 `json:"baseline_savings"`
-	ScenarioSavings   float64 `json:"scenario_savings"`
-	SavingsDifference float64 `json:"savings_difference"`
+	BaselineTotal      float64 `json:"baseline_total"`
+	ScenarioTotal      float64 `json:"scenario_total"`
+	Difference         float64 `json:"difference"`
+	DifferencePercent  float64 `json:"difference_percent"`
+	BaselineSavings    float64 `json:"baseline_savings"`
+	ScenarioSavings    float64 `json:"scenario_savings"`
+	SavingsDifference  float64 `json:"savings_difference"`
+	HistoricalRoundUps float64 `json:"historical_round_ups,omitempty"`
+	ProjectedRoundUps  float64 `json:"projected_round_ups,omitempty"`
+	DebtPayoffMonths   int     `json:"debt_payoff_months,omitempty"`
+	DebtInterest       float64 `json:"debt_interest,omitempty"`
+	WindfallReceived   float64 `json:"windfall_received,omitempty"`
+	WindfallDeferred   float64 `json:"windfall_deferred,omitempty"`
 }
 
 // FeasibilityResponse assesses if a scenario is achievable
@@ -334,6 +417,9 @@ type FeasibilityResponse struct {
 	TimeToGoal      int      `json:"time_to_goal,omitempty"`
 	Obstacles       []string `json:"obstacles,omitempty"`
 	Opportunities   []string `json:"opportunities,omitempty"`
+	// Set when the user's emergency fund is monitored
+	EmergencyFundMonths *float64 `json:"emergency_fund_months,omitempty"`
+	EmergencyFundTarget float64  `json:"emergency_fund_target,omitempty"`
 }
 
 // WhatIfRecommendationResponse provides recommendations for what-if scenarios
@@ -398,6 +484,219 @@ type ComparePeriodsResponse struct {
 	AnalyzedAt         time.Time                `json:"analyzed_at"`
 }
 
+// =============================================================================
+// Safe to Spend DTOs
+// =============================================================================
+
+// SafeToSpendRequest represents a request for how much is safe to spend
+// today, overall or in one of the budget's categories
+type SafeToSpendRequest struct {
+	UserID   string        `json:"user_id"`
+	Budget   BudgetRequest `json:"budget"`
+	Category string        `json:"category,omitempty"`
+	// Date defaults to now
+	Date *time.Time `json:"date,omitempty"`
+}
+
+// UpcomingBillResponse represents a recurring bill due before the budget
+// period ends
+type UpcomingBillResponse struct {
+	Merchant string    `json:"merchant"`
+	Category string    `json:"category"`
+	Cadence  string    `json:"cadence"`
+	Amount   float64   `json:"amount"`
+	DueDate  time.Time `json:"due_date"`
+}
+
+// SafeToSpendResponse represents how much can be spent today without
+// running over the budget
+type SafeToSpendResponse struct {
+	UserID          string    `json:"user_id"`
+	BudgetID        string    `json:"budget_id"`
+	Category        string    `json:"category,omitempty"`
+	Date            time.Time `json:"date"`
+	PeriodStart     time.Time `json:"period_start"`
+	PeriodEnd       time.Time `json:"period_end"`
+	DaysRemaining   int       `json:"days_remaining"`
+	BudgetedAmount  float64   `json:"budgeted_amount"`
+	SpentAmount     float64   `json:"spent_amount"`
+	RemainingBudget float64   `json:"remaining_budget"`
+	// UpcomingBills are the recurring bills detected in the user's history
+	// that are due before the period ends
+	UpcomingBills      []UpcomingBillResponse `json:"upcoming_bills"`
+	UpcomingBillsTotal float64                `json:"upcoming_bills_total"`
+	// ForecastSpending is the everyday spending expected after today at
+	// DailySpendingRate
+	DailySpendingRate float64 `json:"daily_spending_rate"`
+	ForecastSpending  float64 `json:"forecast_spending"`
+	// SafeToSpend is what's left after the upcoming bills and the forecast
+	SafeToSpend float64 `json:"safe_to_spend"`
+	// DailyAllowance spreads what's left after the bills over the remaining
+	// days
+	DailyAllowance float64                      `json:"daily_allowance"`
+	Reallocations  []BudgetReallocationResponse `json:"reallocations,omitempty"`
+	AnalyzedAt     time.Time                    `json:"analyzed_at"`
+}
+
+// =============================================================================
+// Bill Negotiation DTOs
+// =============================================================================
+
+// BillNegotiationRequest represents a request to find recurring bills whose
+// price went up year over year
+type BillNegotiationRequest struct {
+	UserID string `json:"user_id"`
+	// MinIncreasePercent defaults to 10
+	MinIncreasePercent float64 `json:"min_increase_percent,omitempty"`
+}
+
+// PricePointResponse represents one charge of a recurring bill
+type PricePointResponse struct {
+	Date   time.Time `json:"date"`
+	Amount float64   `json:"amount"`
+}
+
+// BillNegotiationOpportunityResponse represents a recurring bill whose price
+// went up more than the threshold over the past year
+type BillNegotiationOpportunityResponse struct {
+	Merchant        string    `json:"merchant"`
+	Category        string    `json:"category"`
+	Cadence         string    `json:"cadence"`
+	CurrentPrice    float64   `json:"current_price"`
+	PreviousPrice   float64   `json:"previous_price"`
+	PreviousDate    time.Time `json:"previous_date"`
+	IncreasePercent float64   `json:"increase_percent"`
+	AnnualCost      float64   `json:"annual_cost"`
+	// CancellationSavings is what cancelling saves a year; DowngradeSavings
+	// is what getting back to last year's price saves
+	CancellationSavings float64 `json:"cancellation_savings"`
+	DowngradeSavings    float64 `json:"downgrade_savings"`
+	// SuggestedAction is "cancel" for discretionary subscriptions and
+	// "negotiate" for essential services
+	SuggestedAction        string               `json:"suggested_action"`
+	EstimatedAnnualSavings float64              `json:"estimated_annual_savings"`
+	PriceHistory           []PricePointResponse `json:"price_history"`
+}
+
+// BillNegotiationResponse represents the bills worth negotiating or
+// cancelling, the largest savings first
+type BillNegotiationResponse struct {
+	UserID                string                               `json:"user_id"`
+	MinIncreasePercent    float64                              `json:"min_increase_percent"`
+	BillsReviewed         int                                  `json:"bills_reviewed"`
+	Opportunities         []BillNegotiationOpportunityResponse `json:"opportunities"`
+	TotalEstimatedSavings float64                              `json:"total_estimated_savings"`
+	TotalAnnualIncrease   float64                              `json:"total_annual_increase"`
+	AnalyzedAt            time.Time                            `json:"analyzed_at"`
+}
+
+// =============================================================================
+// Recurring Detection DTOs
+// =============================================================================
+
+// RecurringDetectionRequest represents a request to find a user's
+// subscriptions and recurring bills
+type RecurringDetectionRequest struct {
+	UserID string `json:"user_id"`
+	// AmountTolerance is how far (as a share) a charge may stray from the
+	// one before and be the same price; defaults to 0.3
+	AmountTolerance float64 `json:"amount_tolerance,omitempty"`
+	// MinPriceIncreasePercent defaults to 2
+	MinPriceIncreasePercent float64 `json:"min_price_increase_percent,omitempty"`
+}
+
+// PriceIncreaseResponse represents the latest rise in a recurring charge's
+// price
+type PriceIncreaseResponse struct {
+	PreviousAmount  float64   `json:"previous_amount"`
+	NewAmount       float64   `json:"new_amount"`
+	Date            time.Time `json:"date"`
+	IncreasePercent float64   `json:"increase_percent"`
+	AnnualIncrease  float64   `json:"annual_increase"`
+}
+
+// RecurringChargeResponse represents a subscription or recurring bill
+type RecurringChargeResponse struct {
+	Merchant string `json:"merchant"`
+	Category string `json:"category"`
+	// Kind is "subscription" for discretionary charges and "bill" for
+	// essential services
+	Kind        string    `json:"kind"`
+	Cadence     string    `json:"cadence"`
+	Amount      float64   `json:"amount"`
+	Occurrences int       `json:"occurrences"`
+	LastDate    time.Time `json:"last_date"`
+	NextDate    time.Time `json:"next_date"`
+	AnnualCost  float64   `json:"annual_cost"`
+	MonthlyCost float64   `json:"monthly_cost"`
+	// PriceIncrease is omitted unless the price went up and stayed up
+	PriceIncrease *PriceIncreaseResponse `json:"price_increase,omitempty"`
+	PriceHistory  []PricePointResponse   `json:"price_history"`
+}
+
+// RecurringDetectionResponse represents a user's recurring charges, the
+// costliest first
+type RecurringDetectionResponse struct {
+	UserID                  string                    `json:"user_id"`
+	Charges                 []RecurringChargeResponse `json:"charges"`
+	Subscriptions           int                       `json:"subscriptions"`
+	Bills                   int                       `json:"bills"`
+	PriceIncreases          int                       `json:"price_increases"`
+	SubscriptionsAnnualCost float64                   `json:"subscriptions_annual_cost"`
+	BillsAnnualCost         float64                   `json:"bills_annual_cost"`
+	TotalAnnualCost         float64                   `json:"total_annual_cost"`
+	TotalMonthlyCost        float64                   `json:"total_monthly_cost"`
+	AnalyzedAt              time.Time                 `json:"analyzed_at"`
+}
+
+// =============================================================================
+// Spending Forecast DTOs
+// =============================================================================
+
+// SpendingForecastRequest represents a request to forecast a user's
+// spending for the coming months
+type SpendingForecastRequest struct {
+	UserID string `json:"user_id"`
+	// Months is how many months to forecast, from 3 to 12; defaults to 6
+	Months int `json:"months,omitempty"`
+	// Confidence is the confidence level of the bands, between 0 and 1;
+	// defaults to 0.8
+	Confidence float64 `json:"confidence,omitempty"`
+}
+
+// ForecastPointResponse represents the spending forecast for a month, with
+// the bounds of its confidence band
+type ForecastPointResponse struct {
+	PeriodStart time.Time `json:"period_start"`
+	Amount      float64   `json:"amount"`
+	Lower       float64   `json:"lower"`
+	Upper       float64   `json:"upper"`
+}
+
+// CategoryForecastResponse represents the spending forecast for a category
+type CategoryForecastResponse struct {
+	Category       string  `json:"category"`
+	HistoryMonths  int     `json:"history_months"`
+	AverageMonthly float64 `json:"average_monthly"`
+	Trend          string  `json:"trend"`
+	// Seasonal is set when the forecast follows the category's seasons,
+	// which takes two years of history
+	Seasonal bool                    `json:"seasonal"`
+	Points   []ForecastPointResponse `json:"points"`
+	Total    float64                 `json:"total"`
+}
+
+// SpendingForecastResponse represents a user's spending forecast, by
+// category (the largest first) and in total
+type SpendingForecastResponse struct {
+	UserID     string                     `json:"user_id"`
+	Months     int                        `json:"months"`
+	Confidence float64                    `json:"confidence"`
+	Categories []CategoryForecastResponse `json:"categories"`
+	Total      []ForecastPointResponse    `json:"total"`
+	AnalyzedAt time.Time                  `json:"analyzed_at"`
+}
+
 // =============================================================================
 // List Response DTOs
 // =============================================================================
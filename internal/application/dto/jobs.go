@@ -0,0 +1,127 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// =============================================================================
+// Job DTOs
+// =============================================================================
+
+// JobResponse represents a background analysis job
+type JobResponse struct {
+	ID          string     `json:"id"`
+	Type        string     `json:"type"`
+	Status      string     `json:"status"`
+	Progress    float64    `json:"progress"`
+	Result      any        `json:"result,omitempty"`
+	Error       string     `json:"error,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// JobAcceptedResponse is returned when a request is run asynchronously
+type JobAcceptedResponse struct {
+	JobID     string `json:"job_id"`
+	Status    string `json:"status"`
+	StatusURL string `json:"status_url"`
+	StreamURL string `json:"stream_url"`
+}
+
+// ListJobsResponse represents a list of jobs
+type ListJobsResponse struct {
+	Jobs  []JobResponse `json:"jobs"`
+	Total int           `json:"total"`
+}
+
+// =============================================================================
+// Job Schedule DTOs
+// =============================================================================
+
+// CreateJobScheduleRequest represents a request to schedule a recurring job
+type CreateJobScheduleRequest struct {
+	Name      string          `json:"name"`
+	JobType   string          `json:"job_type"`
+	Frequency string          `json:"frequency"` // daily, weekly, monthly, quarterly
+	Params    json.RawMessage `json:"params,omitempty"`
+	StartAt   *time.Time      `json:"start_at,omitempty"`
+}
+
+// UpdateJobScheduleRequest represents a request to update a job schedule
+type UpdateJobScheduleRequest struct {
+	Name      *string         `json:"name,omitempty"`
+	Frequency *string         `json:"frequency,omitempty"`
+	Params    json.RawMessage `json:"params,omitempty"`
+	Enabled   *bool           `json:"enabled,omitempty"`
+	NextRunAt *time.Time      `json:"next_run_at,omitempty"`
+}
+
+// JobScheduleResponse represents a recurring job schedule
+type JobScheduleResponse struct {
+	ID                  string          `json:"id"`
+	Name                string          `json:"name"`
+	JobType             string          `json:"job_type"`
+	Frequency           string          `json:"frequency"`
+	Params              json.RawMessage `json:"params,omitempty"`
+	Enabled             bool            `json:"enabled"`
+	NextRunAt           time.Time       `json:"next_run_at"`
+	LastRunAt           *time.Time      `json:"last_run_at,omitempty"`
+	LastStatus          string          `json:"last_status,omitempty"`
+	LastError           string          `json:"last_error,omitempty"`
+	ConsecutiveFailures int             `json:"consecutive_failures"`
+	CreatedAt           time.Time       `json:"created_at"`
+	UpdatedAt           time.Time       `json:"updated_at"`
+}
+
+// ListJobSchedulesResponse represents a list of job schedules
+type ListJobSchedulesResponse struct {
+	Schedules []JobScheduleResponse `json:"schedules"`
+	Total     int                   `json:"total"`
+}
+
+// JobScheduleRunResponse represents one execution of a job schedule
+type JobScheduleRunResponse struct {
+	JobID       string     `json:"job_id,omitempty"`
+	Status      string     `json:"status"`
+	Error       string     `json:"error,omitempty"`
+	StartedAt   time.Time  `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// ListJobScheduleRunsResponse represents a schedule's run history
+type ListJobScheduleRunsResponse struct {
+	ScheduleID string                   `json:"schedule_id"`
+	Runs       []JobScheduleRunResponse `json:"runs"`
+	Total      int                      `json:"total"`
+}
+
+// AnomalyScanScheduleParams configures a scheduled anomaly scan
+type AnomalyScanScheduleParams struct {
+	LookbackDays int `json:"lookback_days,omitempty"` // defaults to 7
+}
+
+// BillNegotiationScheduleParams configures a scheduled bill negotiation scan
+type BillNegotiationScheduleParams struct {
+	MinIncreasePercent float64 `json:"min_increase_percent,omitempty"` // defaults to 10
+}
+
+// BacktestScheduleParams configures a scheduled budget backtest refresh
+type BacktestScheduleParams struct {
+	Budget         BudgetRequest `json:"budget"`
+	LookbackMonths int           `json:"lookback_months,omitempty"` // defaults to 12
+}
+
+// MonteCarloScheduleParams configures a scheduled retirement re-run
+type MonteCarloScheduleParams struct {
+	BacktestID string `json:"backtest_id"`
+}
+
+// RaiseCaptureScheduleParams configures a scheduled raise capture scan of
+// a cash flow analysis
+type RaiseCaptureScheduleParams struct {
+	CashFlowID      string  `json:"cashflow_id"`
+	CapturePercent  float64 `json:"capture_percent,omitempty"`   // defaults to 50
+	MinRaisePercent float64 `json:"min_raise_percent,omitempty"` // defaults to 2
+}
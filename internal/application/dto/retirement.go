@@ -50,6 +50,13 @@ type AccountWithdrawalsResponse struct {
 	TotalWithdrawals      float64 `json:"total_withdrawals"`
 	TaxOwed               float64 `json:"tax_owed"`
 	ShortfallAmount       float64 `json:"shortfall_amount,omitempty"`
+	// RequiredMinimumDistribution is the part of the traditional withdrawal
+	// required from age 73
+	RequiredMinimumDistribution float64 `json:"required_minimum_distribution,omitempty"`
+
+	// RothConversion is moved from traditional to Roth and taxed as
+	// income, not included in TotalWithdrawals
+	RothConversion float64 `json:"roth_conversion,omitempty"`
 }
 
 // =============================================================================
@@ -144,6 +151,33 @@ type IncomeBreakdownResponse struct {
 	RentalIncome       float64 `json:"rental_income"`
 	OtherIncome        float64 `json:"other_income"`
 	TotalIncome        float64 `json:"total_income"`
+
+	// SelfEmploymentIncome is included in TotalIncome
+	SelfEmploymentIncome float64 `json:"self_employment_income,omitempty"`
+
+	// The spouse's part of employment income, Social Security and pension
+	SpouseEmploymentIncome float64 `json:"spouse_employment_income,omitempty"`
+	SpouseSocialSecurity   float64 `json:"spouse_social_security,omitempty"`
+	SpousePension          float64 `json:"spouse_pension,omitempty"`
+
+	// PensionLumpSum is a pension taken as a lump sum and rolled over, not
+	// included in TotalIncome
+	PensionLumpSum float64 `json:"pension_lump_sum,omitempty"`
+
+	// RentalSaleProceeds is a rental property sale's proceeds after its
+	// mortgage, invested rather than included in TotalIncome
+	RentalSaleProceeds float64 `json:"rental_sale_proceeds,omitempty"`
+
+	// Windfall is cash received and invested rather than included in
+	// TotalIncome, taxable_windfall the part taxed as income; inherited IRA
+	// distributions are taxed and reinvested
+	Windfall                 float64 `json:"windfall,omitempty"`
+	TaxableWindfall          float64 `json:"taxable_windfall,omitempty"`
+	InheritedIRADistribution float64 `json:"inherited_ira_distribution,omitempty"`
+
+	// InheritedRothDistribution is paid out of inherited Roth IRAs tax-free
+	// and reinvested
+	InheritedRothDistribution float64 `json:"inherited_roth_distribution,omitempty"`
 }
 
 // =============================================================================
@@ -184,6 +218,30 @@ type ExpenseBreakdownResponse struct {
 	DiscretionaryExpense  float64 `json:"discretionary_expense"`
 	OtherExpenses         float64 `json:"other_expenses"`
 	TotalExpenses         float64 `json:"total_expenses"`
+
+	// IRMAASurcharge is the Medicare premium surcharge included in
+	// HealthcareExpense, set by MAGI two years before; IRMAATier is its
+	// tier, 0 for none
+	IRMAASurcharge float64 `json:"irmaa_surcharge,omitempty"`
+	IRMAATier      int     `json:"irmaa_tier,omitempty"`
+
+	// DebtPayments are payments on loans other than mortgages, included in
+	// TotalExpenses; MortgagePayments are included in HousingExpense
+	DebtPayments     float64 `json:"debt_payments,omitempty"`
+	MortgagePayments float64 `json:"mortgage_payments,omitempty"`
+	DebtInterest     float64 `json:"debt_interest,omitempty"`
+
+	// LongTermCareCost less LongTermCareBenefit is included in
+	// HealthcareExpense, and LongTermCarePremium in InsuranceExpense
+	LongTermCareCost    float64 `json:"long_term_care_cost,omitempty"`
+	LongTermCareBenefit float64 `json:"long_term_care_benefit,omitempty"`
+	LongTermCarePremium float64 `json:"long_term_care_premium,omitempty"`
+
+	// ACAPremium is the marketplace premium before Medicare and
+	// ACAPremiumTaxCredit the credit towards it, included in
+	// HealthcareExpense net of each other
+	ACAPremium          float64 `json:"aca_premium,omitempty"`
+	ACAPremiumTaxCredit float64 `json:"aca_premium_tax_credit,omitempty"`
 }
 
 // =============================================================================
@@ -197,6 +255,39 @@ type TaxBreakdownResponse struct {
 	FICATax         float64 `json:"fica_tax"`
 	CapitalGainsTax float64 `json:"capital_gains_tax"`
 	TotalTax        float64 `json:"total_tax"`
+	// RMDTax is the federal and state tax added by the required minimum
+	// distribution, included in the taxes above
+	RMDTax float64 `json:"rmd_tax,omitempty"`
+	// TaxableSocialSecurity is the part of Social Security benefits
+	// subject to federal tax
+	TaxableSocialSecurity float64 `json:"taxable_social_security,omitempty"`
+	// MAGI is the modified adjusted gross income IRMAA looks back to and
+	// the ACA premium tax credit is based on
+	MAGI float64 `json:"magi,omitempty"`
+
+	// SelfEmploymentTax is included in FICATax, QBIDeduction comes off
+	// taxable income, and EstimatedTaxPayments are the quarterly payments
+	// of tax that isn't withheld
+	SelfEmploymentTax    float64                       `json:"self_employment_tax,omitempty"`
+	QBIDeduction         float64                       `json:"qbi_deduction,omitempty"`
+	EstimatedTaxPayments []EstimatedTaxPaymentResponse `json:"estimated_tax_payments,omitempty"`
+
+	// Rental property depreciation deducted, and a sale's capital gain and
+	// recaptured depreciation, taxed in CapitalGainsTax
+	RentalDepreciation    float64 `json:"rental_depreciation,omitempty"`
+	RentalSaleGain        float64 `json:"rental_sale_gain,omitempty"`
+	DepreciationRecapture float64 `json:"depreciation_recapture,omitempty"`
+
+	// ACASubsidyHeadroom is how much more MAGI, such as a Roth conversion,
+	// fits under the ACA subsidy cliff
+	ACASubsidyHeadroom float64 `json:"aca_subsidy_headroom,omitempty"`
+}
+
+// EstimatedTaxPaymentResponse represents a quarterly estimated tax payment
+type EstimatedTaxPaymentResponse struct {
+	Quarter int     `json:"quarter"`
+	DueDate string  `json:"due_date"`
+	Amount  float64 `json:"amount"`
 }
 
 // TaxImpactResponse represents detailed tax impact analysis
@@ -222,6 +313,17 @@ type TaxImpactResponse struct {
 	RothConversionOpportunity float64 `json:"roth_conversion_opportunity"`
 	TaxLossHarvestingAmount   float64 `json:"tax_loss_harvesting_amount"`
 	AdditionalTraditionalRoom float64 `json:"additional_traditional_room"`
+
+	// Required minimum distributions and the tax they add
+	RequiredMinimumDistribution float64 `json:"required_minimum_distribution"`
+	RMDTax                      float64 `json:"rmd_tax"`
+
+	// Social Security benefits subject to federal tax
+	TaxableSocialSecurity float64 `json:"taxable_social_security"`
+
+	// Self-employment tax, included in FICATax, and the QBI deduction
+	SelfEmploymentTax float64 `json:"self_employment_tax"`
+	QBIDeduction      float64 `json:"qbi_deduction"`
 }
 
 // TaxBracketResponse represents a tax bracket
@@ -345,6 +447,85 @@ type YearCashFlowResponse struct {
 	// Portfolio state
 	TotalPortfolio float64 `json:"total_portfolio"`
 	IsRetired      bool    `json:"is_retired"`
+
+	// SpouseAge is the spouse's age in a joint analysis; Survivor marks
+	// the years after the spouse has died
+	SpouseAge int  `json:"spouse_age,omitempty"`
+	Survivor  bool `json:"survivor,omitempty"`
+
+	// RentalEquity is rental properties' value less their mortgages;
+	// NetWorth adds it to TotalPortfolio
+	RentalEquity float64 `json:"rental_equity,omitempty"`
+	NetWorth     float64 `json:"net_worth"`
+
+	// DebtBalance is what is owed at the end of the year, taken off
+	// NetWorth
+	DebtBalance float64 `json:"debt_balance,omitempty"`
+
+	// InheritedIRABalance and InheritedRothBalance are what is left in
+	// inherited traditional and Roth IRAs, included in TotalPortfolio
+	InheritedIRABalance  float64 `json:"inherited_ira_balance,omitempty"`
+	InheritedRothBalance float64 `json:"inherited_roth_balance,omitempty"`
+
+	// PortfolioReturn is the year's return; with an allocation it is the
+	// household glide path's, and Allocation is that path's mix
+	PortfolioReturn float64                  `json:"portfolio_return"`
+	Allocation      *AssetAllocationResponse `json:"allocation,omitempty"`
+
+	// Spending is the year's spending on the configured expense lines, and
+	// SpendingAdjustment how the spending strategy scaled them
+	Spending           float64 `json:"spending"`
+	SpendingAdjustment float64 `json:"spending_adjustment"`
+}
+
+// AssetAllocationResponse is the share held in each asset class
+type AssetAllocationResponse struct {
+	Stocks float64 `json:"stocks"`
+	Bonds  float64 `json:"bonds"`
+	Cash   float64 `json:"cash"`
+}
+
+// CashFlowYearDetailResponse is a single year of a cash flow analysis with
+// its income, expenses and taxes itemized
+type CashFlowYearDetailResponse struct {
+	CashFlowID string               `json:"cashflow_id"`
+	Summary    YearCashFlowResponse `json:"summary"`
+
+	IncomeFlows  []CashFlowResponse `json:"income_flows"`
+	ExpenseFlows []CashFlowResponse `json:"expense_flows"`
+	TaxFlows     []CashFlowResponse `json:"tax_flows"`
+
+	// Annotations are the notes on the year
+	Annotations []CashFlowAnnotationResponse `json:"annotations,omitempty"`
+}
+
+// CashFlowAnnotationRequest represents a note on a saved cash flow
+// analysis, attached to the year at an age or to one of its assumptions
+// (a config field, such as "inflation_rate")
+type CashFlowAnnotationRequest struct {
+	Age        int    `json:"age,omitempty"`
+	Assumption string `json:"assumption,omitempty"`
+	Note       string `json:"note"`
+	// Author is who wrote the note, such as the user's advisor
+	Author string `json:"author,omitempty"`
+}
+
+// UpdateCashFlowAnnotationRequest represents changes to a note; its year or
+// assumption can't be changed
+type UpdateCashFlowAnnotationRequest struct {
+	Note   *string `json:"note,omitempty"`
+	Author *string `json:"author,omitempty"`
+}
+
+// CashFlowAnnotationResponse represents a note on a cash flow analysis
+type CashFlowAnnotationResponse struct {
+	ID         string    `json:"id"`
+	Age        int       `json:"age,omitempty"`
+	Assumption string    `json:"assumption,omitempty"`
+	Note       string    `json:"note"`
+	Author     string    `json:"author,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
 }
 
 // CashFlowResultsResponse represents complete cash flow analysis results
@@ -372,10 +553,22 @@ type CashFlowResultsResponse struct {
 	RetirementReadiness  float64 `json:"retirement_readiness"`
 	ExpensesCoveredYears int     `json:"expenses_covered_years"`
 
+	// Spending is how retired spending varied in real terms
+	Spending SpendingVolatilityResponse `json:"spending"`
+
 	// Calculation metadata
 	CalculationDurationMs int64 `json:"calculation_duration_ms"`
 }
 
+// SpendingVolatilityResponse describes how retired spending moved in real
+// terms: the standard deviation of its yearly change, its lowest level
+// relative to the first retired year and its largest one-year cut
+type SpendingVolatilityResponse struct {
+	Volatility          float64 `json:"volatility"`
+	LowestSpendingRatio float64 `json:"lowest_spending_ratio"`
+	LargestCut          float64 `json:"largest_cut"`
+}
+
 // SankeyNodeResponse represents a node in a Sankey diagram
 type SankeyNodeResponse struct {
 	ID       string  `json:"id"`
@@ -396,6 +589,37 @@ type SankeyLinkResponse struct {
 type SankeyDataResponse struct {
 	Nodes []SankeyNodeResponse `json:"nodes"`
 	Links []SankeyLinkResponse `json:"links"`
+	Table *DataTableResponse   `json:"table,omitempty"`
+}
+
+// SankeyPeriodResponse is a Sankey diagram of one year or decade of an
+// analysis
+type SankeyPeriodResponse struct {
+	Label    string             `json:"label"`
+	StartAge int                `json:"start_age"`
+	EndAge   int                `json:"end_age"`
+	Sankey   SankeyDataResponse `json:"sankey"`
+
+	// Annotations are the notes on the period's years
+	Annotations []CashFlowAnnotationResponse `json:"annotations,omitempty"`
+}
+
+// SankeyExportResponse is an analysis's Sankey diagrams by year or decade
+type SankeyExportResponse struct {
+	CashFlowID  string                 `json:"cashflow_id"`
+	Granularity string                 `json:"granularity"`
+	Periods     []SankeyPeriodResponse `json:"periods"`
+
+	// Annotations are the notes on the analysis's assumptions
+	Annotations []CashFlowAnnotationResponse `json:"annotations,omitempty"`
+}
+
+// DataTableResponse represents a chart's data as a table for screen
+// readers, with numbers already formatted
+type DataTableResponse struct {
+	Caption string     `json:"caption"`
+	Headers []string   `json:"headers"`
+	Rows    [][]string `json:"rows"`
 }
 
 // =============================================================================
@@ -435,6 +659,105 @@ type MonteCarloResultsResponse struct {
 	CalculationDurationMs int64                    `json:"calculation_duration_ms"`
 }
 
+// CashFlowMonteCarloRequest represents settings for a Monte Carlo cash flow
+// analysis. Distributions are normal, lognormal or historical; omitted
+// fields use defaults.
+type CashFlowMonteCarloRequest struct {
+	Iterations            int      `json:"iterations,omitempty"`
+	ReturnDistribution    string   `json:"return_distribution,omitempty"`
+	ReturnStdDev          *float64 `json:"return_std_dev,omitempty"`
+	InflationDistribution string   `json:"inflation_distribution,omitempty"`
+	InflationStdDev       *float64 `json:"inflation_std_dev,omitempty"`
+	SequenceRiskYears     int      `json:"sequence_risk_years,omitempty"`
+	Seed                  int64    `json:"seed,omitempty"`
+}
+
+// CashFlowFIRERequest chooses the FIRE variant (regular, lean, fat or
+// barista) and the retirement age the Coast FIRE number and required
+// savings rate aim for, the analysis's retirement_age when omitted.
+// return_shifts are added to the return assumptions for the sensitivity
+// breakdown, -2, -1, +1 and +2 points when omitted.
+type CashFlowFIRERequest struct {
+	Variant string `json:"variant,omitempty"`
+
+	// PartTimeIncome and PartTimeEndAge are barista FIRE's part-time work
+	// in retirement
+	PartTimeIncome float64 `json:"part_time_income,omitempty"`
+	PartTimeEndAge int     `json:"part_time_end_age,omitempty"`
+
+	TargetAge    int       `json:"target_age,omitempty"`
+	ReturnShifts []float64 `json:"return_shifts,omitempty"`
+}
+
+// PortfolioPathPointResponse represents portfolio percentiles at the end of
+// one year across all simulations
+type PortfolioPathPointResponse struct {
+	Year        int                       `json:"year"`
+	Age         int                       `json:"age"`
+	Percentiles PercentileResultsResponse `json:"percentiles"`
+}
+
+// SequenceRiskResponse represents how much early retirement returns decide
+// the outcome
+type SequenceRiskResponse struct {
+	Years                           int     `json:"years"`
+	WorstQuartileSuccessProbability float64 `json:"worst_quartile_success_probability"`
+	BestQuartileSuccessProbability  float64 `json:"best_quartile_success_probability"`
+	WorstQuartileEarlyReturn        float64 `json:"worst_quartile_early_return"`
+	BestQuartileEarlyReturn         float64 `json:"best_quartile_early_return"`
+	EarlyReturnCorrelation          float64 `json:"early_return_correlation"`
+	AverageDepletionAge             float64 `json:"average_depletion_age"`
+}
+
+// CashFlowMonteCarloResponse represents Monte Carlo cash flow analysis results
+type CashFlowMonteCarloResponse struct {
+	SuccessProbability    float64                      `json:"success_probability"`
+	SuccessCount          int                          `json:"success_count"`
+	TotalSimulations      int                          `json:"total_simulations"`
+	PortfolioPaths        []PortfolioPathPointResponse `json:"portfolio_paths"`
+	FinalPercentiles      PercentileResultsResponse    `json:"final_percentiles"`
+	SequenceRisk          SequenceRiskResponse         `json:"sequence_risk"`
+	Spending              SpendingVolatilityResponse   `json:"spending"`
+	CalculationDurationMs int64                        `json:"calculation_duration_ms"`
+}
+
+// CashFlowHistoricalRequest limits which windows of market history a
+// historical backtest replays by the year they start in; omitted years
+// replay every window since 1928
+type CashFlowHistoricalRequest struct {
+	FirstStartYear int `json:"first_start_year,omitempty"`
+	LastStartYear  int `json:"last_start_year,omitempty"`
+}
+
+// HistoricalWindowResponse represents the outcome of replaying the analysis
+// through one window of market history, in first-year dollars
+type HistoricalWindowResponse struct {
+	StartYear            int     `json:"start_year"`
+	EndYear              int     `json:"end_year"`
+	Success              bool    `json:"success"`
+	DepletionAge         int     `json:"depletion_age,omitempty"`
+	FinalPortfolio       float64 `json:"final_portfolio"`
+	LowestPortfolio      float64 `json:"lowest_portfolio"`
+	LowestPortfolioAge   int     `json:"lowest_portfolio_age"`
+	AnnualizedRealReturn float64 `json:"annualized_real_return"`
+}
+
+// CashFlowHistoricalResponse represents historical backtest results
+type CashFlowHistoricalResponse struct {
+	WindowYears           int                          `json:"window_years"`
+	SuccessRate           float64                      `json:"success_rate"`
+	SuccessCount          int                          `json:"success_count"`
+	TotalWindows          int                          `json:"total_windows"`
+	Best                  HistoricalWindowResponse     `json:"best"`
+	Median                HistoricalWindowResponse     `json:"median"`
+	Worst                 HistoricalWindowResponse     `json:"worst"`
+	FailedStartYears      []int                        `json:"failed_start_years"`
+	Windows               []HistoricalWindowResponse   `json:"windows"`
+	PortfolioPaths        []PortfolioPathPointResponse `json:"portfolio_paths"`
+	FinalPercentiles      PercentileResultsResponse    `json:"final_percentiles"`
+	CalculationDurationMs int64                        `json:"calculation_duration_ms"`
+}
+
 // =============================================================================
 // FIRE Calculation DTOs
 // =============================================================================
@@ -647,3 +970,556 @@ type StrategyResultResponse struct {
 	EffectiveTaxRate    float64                `json:"effective_tax_rate"`
 	IsRecommended       bool                   `json:"is_recommended"`
 }
+
+// =============================================================================
+// Social Security DTOs
+// =============================================================================
+
+// EarningsRecordRequest is a year of covered earnings
+type EarningsRecordRequest struct {
+	Year     int     `json:"year"`
+	Earnings float64 `json:"earnings"`
+}
+
+// SocialSecurityEarnerRequest describes a worker whose benefit is estimated,
+// from an earnings history, a current salary with assumed growth, or both
+type SocialSecurityEarnerRequest struct {
+	BirthYear       int                     `json:"birth_year"`
+	EarningsHistory []EarningsRecordRequest `json:"earnings_history,omitempty"`
+	CurrentSalary   float64                 `json:"current_salary,omitempty"`
+	SalaryGrowth    float64                 `json:"salary_growth,omitempty"`
+	StopWorkingAge  int                     `json:"stop_working_age,omitempty"`
+	// ClaimingAge is 62-70; omitted claims at full retirement age
+	ClaimingAge int `json:"claiming_age,omitempty"`
+}
+
+// SocialSecurityEstimateRequest is the input for a Social Security estimate
+type SocialSecurityEstimateRequest struct {
+	// CurrentYear defaults to this year
+	CurrentYear int                          `json:"current_year,omitempty"`
+	Worker      SocialSecurityEarnerRequest  `json:"worker"`
+	Spouse      *SocialSecurityEarnerRequest `json:"spouse,omitempty"`
+}
+
+// ClaimingOptionResponse is the benefit for claiming at one age
+type ClaimingOptionResponse struct {
+	Age             int     `json:"age"`
+	AdjustmentRatio float64 `json:"adjustment_ratio"`
+	MonthlyBenefit  float64 `json:"monthly_benefit"`
+	AnnualBenefit   float64 `json:"annual_benefit"`
+}
+
+// EarnerBenefitResponse is the benefit estimate for one earner
+type EarnerBenefitResponse struct {
+	AIME                    float64                  `json:"aime"`
+	PIA                     float64                  `json:"pia"`
+	BendPoints              [2]float64               `json:"bend_points"`
+	FullRetirementAgeMonths int                      `json:"full_retirement_age_months"`
+	ClaimingAge             int                      `json:"claiming_age"`
+	AdjustmentRatio         float64                  `json:"adjustment_ratio"`
+	MonthlyBenefit          float64                  `json:"monthly_benefit"`
+	MonthlySpousalBenefit   float64                  `json:"monthly_spousal_benefit"`
+	AnnualBenefit           float64                  `json:"annual_benefit"`
+	ClaimingOptions         []ClaimingOptionResponse `json:"claiming_options"`
+}
+
+// SocialSecurityEstimateResponse is the estimated household benefit, in
+// today's dollars
+type SocialSecurityEstimateResponse struct {
+	Worker                 EarnerBenefitResponse  `json:"worker"`
+	Spouse                 *EarnerBenefitResponse `json:"spouse,omitempty"`
+	HouseholdAnnualBenefit float64                `json:"household_annual_benefit"`
+}
+
+// =============================================================================
+// Raise Capture DTOs
+// =============================================================================
+
+// RaiseCaptureRequest asks what saving part of a raise would do for a cash
+// flow analysis. Without an annual raise the user's latest detected raise
+// is used.
+type RaiseCaptureRequest struct {
+	AnnualRaise     float64 `json:"annual_raise,omitempty"`
+	CapturePercent  float64 `json:"capture_percent,omitempty"`   // defaults to 50
+	MinRaisePercent float64 `json:"min_raise_percent,omitempty"` // defaults to 2
+}
+
+// RaiseResponse is a raise detected in the user's paychecks
+type RaiseResponse struct {
+	Source          string    `json:"source"`
+	Cadence         string    `json:"cadence"`
+	PreviousPay     float64   `json:"previous_pay"`
+	CurrentPay      float64   `json:"current_pay"`
+	EffectiveDate   time.Time `json:"effective_date"`
+	IncreasePercent float64   `json:"increase_percent"`
+	AnnualIncrease  float64   `json:"annual_increase"`
+}
+
+// RaiseCaptureOutcomeResponse summarizes the plan with or without the
+// raise captured
+type RaiseCaptureOutcomeResponse struct {
+	TraditionalContributionRate float64 `json:"traditional_contribution_rate"`
+	PortfolioAtRetirement       float64 `json:"portfolio_at_retirement"`
+	FinalPortfolio              float64 `json:"final_portfolio"`
+	RetirementReadiness         float64 `json:"retirement_readiness"`
+	ExpensesCoveredYears        int     `json:"expenses_covered_years"`
+	DepletionAge                int     `json:"depletion_age,omitempty"`
+}
+
+// RaiseCaptureResponse recommends directing part of a raise to retirement
+// savings, with what it would do for the plan
+type RaiseCaptureResponse struct {
+	ID         string         `json:"id"`
+	CashFlowID string         `json:"cashflow_id"`
+	Raise      *RaiseResponse `json:"raise,omitempty"`
+
+	AnnualRaise            float64 `json:"annual_raise"`
+	CapturePercent         float64 `json:"capture_percent"`
+	AdditionalContribution float64 `json:"additional_contribution"`
+
+	Kept     RaiseCaptureOutcomeResponse `json:"kept"`
+	Captured RaiseCaptureOutcomeResponse `json:"captured"`
+
+	PortfolioAtRetirementGain float64 `json:"portfolio_at_retirement_gain"`
+	FinalPortfolioGain        float64 `json:"final_portfolio_gain"`
+	ReadinessGain             float64 `json:"readiness_gain"`
+	YearsGained               int     `json:"years_gained"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// =============================================================================
+// Spending Shape DTOs
+// =============================================================================
+
+// SpendingShapeRequest asks how a cash flow analysis's withdrawal strategies
+// fare when retirement spending follows the user's own pattern instead of
+// flat inflation-adjusted expenses. Without monthly spending the user's
+// stored transactions are used.
+type SpendingShapeRequest struct {
+	// MonthlySpending is total spending per month, oldest first
+	MonthlySpending []float64 `json:"monthly_spending,omitempty"`
+	LookbackMonths  int       `json:"lookback_months,omitempty"` // defaults to 24
+}
+
+// SpendingShapeOutcomeResponse summarizes a withdrawal strategy's run
+type SpendingShapeOutcomeResponse struct {
+	FinalPortfolio       float64 `json:"final_portfolio"`
+	TotalTax             float64 `json:"total_tax"`
+	RetirementExpenses   float64 `json:"retirement_expenses"`
+	ExpensesCoveredYears int     `json:"expenses_covered_years"`
+	RetirementReadiness  float64 `json:"retirement_readiness"`
+	DepletionAge         int     `json:"depletion_age,omitempty"`
+}
+
+// SpendingShapeStrategyResponse compares a withdrawal strategy under flat
+// and personalized spending
+type SpendingShapeStrategyResponse struct {
+	Strategy     WithdrawalStrategyType       `json:"strategy"`
+	Flat         SpendingShapeOutcomeResponse `json:"flat"`
+	Personalized SpendingShapeOutcomeResponse `json:"personalized"`
+}
+
+// SpendingShapeResponse compares withdrawal strategies under flat and
+// personalized retirement spending
+type SpendingShapeResponse struct {
+	CashFlowID string `json:"cashflow_id"`
+
+	// The spending history the shape was derived from
+	HistoryMonths      int     `json:"history_months"`
+	AnnualSpending     float64 `json:"annual_spending"`
+	AnnualTrendPercent float64 `json:"annual_trend_percent"`
+	ConfiguredExpenses float64 `json:"configured_expenses"`
+
+	// Shape scales each retirement year's expenses, from the retirement age
+	Shape      []float64                       `json:"shape"`
+	Strategies []SpendingShapeStrategyResponse `json:"strategies"`
+
+	BestFlatStrategy         WithdrawalStrategyType `json:"best_flat_strategy"`
+	BestPersonalizedStrategy WithdrawalStrategyType `json:"best_personalized_strategy"`
+}
+
+// =============================================================================
+// Relocation DTOs
+// =============================================================================
+
+// RelocationRequest asks how a cash flow analysis's plan fares if the
+// household moves to each of several states
+type RelocationRequest struct {
+	States  []string `json:"states"`
+	MoveAge int      `json:"move_age,omitempty"` // defaults to the retirement age
+}
+
+// RelocationOutcomeResponse summarizes the plan with the household living in
+// a state
+type RelocationOutcomeResponse struct {
+	StateCode string `json:"state_code,omitempty"`
+	StateName string `json:"state_name,omitempty"`
+
+	StateTax             float64 `json:"state_tax"`
+	TotalLifetimeTax     float64 `json:"total_lifetime_tax"`
+	FinalPortfolio       float64 `json:"final_portfolio"`
+	ExpensesCoveredYears int     `json:"expenses_covered_years"`
+	DepletionAge         int     `json:"depletion_age,omitempty"`
+
+	StateTaxSavings    float64 `json:"state_tax_savings"`
+	FinalPortfolioGain float64 `json:"final_portfolio_gain"`
+}
+
+// RelocationResponse compares staying with moving to each requested state.
+// State tax is counted from the move on.
+type RelocationResponse struct {
+	CashFlowID string                      `json:"cashflow_id"`
+	MoveAge    int                         `json:"move_age"`
+	Current    RelocationOutcomeResponse   `json:"current"`
+	Scenarios  []RelocationOutcomeResponse `json:"scenarios"`
+	BestState  string                      `json:"best_state,omitempty"`
+}
+
+// =============================================================================
+// Results Diff DTOs
+// =============================================================================
+
+// MetricDiffResponse compares one figure between two runs
+type MetricDiffResponse struct {
+	Base     float64 `json:"base"`
+	Compare  float64 `json:"compare"`
+	Delta    float64 `json:"delta"`
+	Percent  float64 `json:"percent"`
+	Material bool    `json:"material"`
+}
+
+// YearDiffResponse compares one year of two runs
+type YearDiffResponse struct {
+	Age              int                `json:"age"`
+	TotalIncome      MetricDiffResponse `json:"total_income"`
+	TotalExpenses    MetricDiffResponse `json:"total_expenses"`
+	TotalTax         MetricDiffResponse `json:"total_tax"`
+	TotalSavings     MetricDiffResponse `json:"total_savings"`
+	TotalWithdrawals MetricDiffResponse `json:"total_withdrawals"`
+	NetCashFlow      MetricDiffResponse `json:"net_cash_flow"`
+	TotalPortfolio   MetricDiffResponse `json:"total_portfolio"`
+	Material         bool               `json:"material"`
+}
+
+// DiffDriverResponse is a cash flow line that moved the results most
+type DiffDriverResponse struct {
+	Line  string  `json:"line"`
+	Delta float64 `json:"delta"`
+	Share float64 `json:"share"`
+}
+
+// ResultsDiffSummaryResponse compares lifetime figures of two runs
+type ResultsDiffSummaryResponse struct {
+	FinalPortfolio           MetricDiffResponse `json:"final_portfolio"`
+	TotalLifetimeIncome      MetricDiffResponse `json:"total_lifetime_income"`
+	TotalLifetimeExpenses    MetricDiffResponse `json:"total_lifetime_expenses"`
+	TotalLifetimeTax         MetricDiffResponse `json:"total_lifetime_tax"`
+	TotalLifetimeSavings     MetricDiffResponse `json:"total_lifetime_savings"`
+	TotalLifetimeWithdrawals MetricDiffResponse `json:"total_lifetime_withdrawals"`
+	RetirementReadiness      MetricDiffResponse `json:"retirement_readiness"`
+	ExpensesCoveredYears     MetricDiffResponse `json:"expenses_covered_years"`
+}
+
+// ResultsDiffResponse shows what changed between two cash flow runs
+type ResultsDiffResponse struct {
+	// ChangedFields are the config fields that differ
+	ChangedFields []string `json:"changed_fields"`
+
+	Summary ResultsDiffSummaryResponse `json:"summary"`
+	Years   []YearDiffResponse         `json:"years"`
+	// MaterialYears counts the years with a material change
+	MaterialYears int `json:"material_years"`
+
+	// Drivers are the (up to three) lines that moved most over the lifetime
+	Drivers []DiffDriverResponse `json:"drivers"`
+}
+
+// =============================================================================
+// Pension DTOs
+// =============================================================================
+
+// PensionLumpSumResponse compares drawing a pension as an annuity with
+// rolling its lump sum over at the start age
+type PensionLumpSumResponse struct {
+	Name          string  `json:"name,omitempty"`
+	Spouse        bool    `json:"spouse,omitempty"`
+	AnnualBenefit float64 `json:"annual_benefit"`
+	StartAge      int     `json:"start_age"`
+	LumpSum       float64 `json:"lump_sum"`
+
+	Annuity      SpendingShapeOutcomeResponse `json:"annuity"`
+	LumpSumTaken SpendingShapeOutcomeResponse `json:"lump_sum_taken"`
+
+	// AnnuityPresentValue is the annuity's payments discounted to the start
+	// age at the expected return; ImpliedReturn is what the lump sum would
+	// have to earn to pay as much
+	AnnuityPresentValue float64 `json:"annuity_present_value"`
+	ImpliedReturn       float64 `json:"implied_return"`
+	PreferLumpSum       bool    `json:"prefer_lump_sum"`
+}
+
+// PensionLumpSumsResponse lists the lump sum comparison for each pension
+// offering one
+type PensionLumpSumsResponse struct {
+	CashFlowID string                   `json:"cashflow_id"`
+	Pensions   []PensionLumpSumResponse `json:"pensions"`
+}
+
+// =============================================================================
+// Debt Payoff DTOs
+// =============================================================================
+
+// DebtPayoffRequest asks whether paying extra on one of a cash flow
+// analysis's debts beats investing it
+type DebtPayoffRequest struct {
+	DebtIndex    int     `json:"debt_index"`
+	ExtraPayment float64 `json:"extra_payment"` // a year
+}
+
+// DebtPayoffResponse compares paying extra on a debt with investing the
+// extra until the debt would otherwise be paid off. Wealth figures are
+// after capital gains tax on returns.
+type DebtPayoffResponse struct {
+	CashFlowID   string  `json:"cashflow_id"`
+	DebtName     string  `json:"debt_name,omitempty"`
+	ExtraPayment float64 `json:"extra_payment"`
+
+	PayoffYears        int     `json:"payoff_years"`
+	PrepaidPayoffYears int     `json:"prepaid_payoff_years"`
+	InterestSaved      float64 `json:"interest_saved"`
+
+	PrepayWealth float64 `json:"prepay_wealth"`
+	InvestWealth float64 `json:"invest_wealth"`
+	PreferPrepay bool    `json:"prefer_prepay"`
+
+	// The whole plan without and with the extra payment
+	Baseline SpendingShapeOutcomeResponse `json:"baseline"`
+	Prepay   SpendingShapeOutcomeResponse `json:"prepay"`
+}
+
+// CashFlowScenarioMetricsResponse is one row of a scenario comparison:
+// success_probability and median_ending_portfolio are simulated, the rest
+// projected
+type CashFlowScenarioMetricsResponse struct {
+	Name string `json:"name"`
+
+	SuccessProbability    float64 `json:"success_probability"`
+	MedianEndingPortfolio float64 `json:"median_ending_portfolio"`
+
+	LifetimeTax          float64 `json:"lifetime_tax"`
+	EndingPortfolio      float64 `json:"ending_portfolio"`
+	RetirementReadiness  float64 `json:"retirement_readiness"`
+	ExpensesCoveredYears int     `json:"expenses_covered_years"`
+	DepletionAge         int     `json:"depletion_age,omitempty"`
+}
+
+// CashFlowScenarioBatchResponse compares scenarios side by side, in the
+// order requested. The remaining fields name the scenario leading on each
+// key metric.
+type CashFlowScenarioBatchResponse struct {
+	Scenarios []CashFlowScenarioMetricsResponse `json:"scenarios"`
+
+	HighestSuccessProbability string `json:"highest_success_probability"`
+	LowestLifetimeTax         string `json:"lowest_lifetime_tax"`
+	HighestEndingPortfolio    string `json:"highest_ending_portfolio"`
+}
+
+// CashFlowTaxStrategyResponse is a plan's projected outcome under one
+// withdrawal strategy
+type CashFlowTaxStrategyResponse struct {
+	Strategy WithdrawalStrategyType `json:"strategy"`
+
+	LifetimeTax          float64 `json:"lifetime_tax"`
+	EndingPortfolio      float64 `json:"ending_portfolio"`
+	RetirementExpenses   float64 `json:"retirement_expenses"`
+	RetirementReadiness  float64 `json:"retirement_readiness"`
+	ExpensesCoveredYears int     `json:"expenses_covered_years"`
+	DepletionAge         int     `json:"depletion_age,omitempty"`
+}
+
+// CashFlowTaxStrategiesResponse compares a plan's withdrawal strategies.
+// While a comparison job runs, its partial result lists the strategies
+// finished so far, completed of total. The remaining fields name the
+// strategy leading on each key metric among them.
+type CashFlowTaxStrategiesResponse struct {
+	CashFlowID string                        `json:"cashflow_id"`
+	Strategies []CashFlowTaxStrategyResponse `json:"strategies"`
+	Completed  int                           `json:"completed"`
+	Total      int                           `json:"total"`
+
+	LowestLifetimeTax      WithdrawalStrategyType `json:"lowest_lifetime_tax"`
+	HighestEndingPortfolio WithdrawalStrategyType `json:"highest_ending_portfolio"`
+}
+
+// CashFlowFIREFiguresResponse are a plan's financial independence figures,
+// each -1 when out of reach: the earliest age retiring is feasible and the
+// portfolio then, the portfolio that needs no more saving to retire at the
+// target age, and the share of employment income to save to retire then
+type CashFlowFIREFiguresResponse struct {
+	EarliestRetirementAge int     `json:"earliest_retirement_age"`
+	PortfolioAtFIRE       float64 `json:"portfolio_at_fire"`
+	CoastFIRENumber       float64 `json:"coast_fire_number"`
+	RequiredSavingsRate   float64 `json:"required_savings_rate"`
+}
+
+// CashFlowFIRESensitivityResponse is the figures with the return
+// assumptions shifted
+type CashFlowFIRESensitivityResponse struct {
+	ReturnShift float64 `json:"return_shift"`
+	CashFlowFIREFiguresResponse
+}
+
+// CashFlowFIREResponse is a plan's financial independence figures and
+// their sensitivity to the return assumptions
+type CashFlowFIREResponse struct {
+	CashFlowID string `json:"cashflow_id"`
+	Variant    string `json:"variant"`
+	TargetAge  int    `json:"target_age"`
+
+	CurrentPortfolio   float64 `json:"current_portfolio"`
+	CurrentSavingsRate float64 `json:"current_savings_rate"`
+
+	CashFlowFIREFiguresResponse
+	CoastFIREReached bool `json:"coast_fire_reached"`
+
+	Sensitivity []CashFlowFIRESensitivityResponse `json:"sensitivity"`
+}
+
+// CashFlowInflationPathRequest is an inflation path: yearly rates from the
+// stress test's start age, after which the analysis's inflation_rate
+// resumes unless sustained repeats the last rate to the end
+type CashFlowInflationPathRequest struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Rates       []float64 `json:"rates"`
+	Sustained   bool      `json:"sustained,omitempty"`
+}
+
+// CashFlowInflationStressRequest chooses the inflation paths a stress test
+// runs, sustained 5%, a 1970s-style spike and deflation when omitted, and
+// the age they start at, the analysis's retirement_age when omitted
+type CashFlowInflationStressRequest struct {
+	Paths    []CashFlowInflationPathRequest `json:"paths,omitempty"`
+	StartAge int                            `json:"start_age,omitempty"`
+}
+
+// CashFlowExpensePressureResponse is how much more an expense category
+// costs under an inflation path than at the analysis's inflation rate
+type CashFlowExpensePressureResponse struct {
+	Category  string  `json:"category"`
+	ExtraCost float64 `json:"extra_cost"`
+}
+
+// CashFlowInflationStressResultResponse is a plan's outcome under one
+// inflation path. depletion_age is the first age spending isn't met, 0
+// when it always is; breaking_expenses rank the categories costing more up
+// to then.
+type CashFlowInflationStressResultResponse struct {
+	Path             string  `json:"path"`
+	Description      string  `json:"description,omitempty"`
+	AverageInflation float64 `json:"average_inflation"`
+
+	Success      bool `json:"success"`
+	DepletionAge int  `json:"depletion_age,omitempty"`
+
+	FinalPortfolio     float64 `json:"final_portfolio"`
+	RealFinalPortfolio float64 `json:"real_final_portfolio"`
+	TotalExpenses      float64 `json:"total_expenses"`
+
+	BreakingExpenses []CashFlowExpensePressureResponse `json:"breaking_expenses"`
+}
+
+// CashFlowInflationStressResponse compares a plan under each inflation
+// path with the plan at its own inflation rate
+type CashFlowInflationStressResponse struct {
+	CashFlowID string `json:"cashflow_id"`
+	StartAge   int    `json:"start_age"`
+
+	Baseline     CashFlowInflationStressResultResponse   `json:"baseline"`
+	Paths        []CashFlowInflationStressResultResponse `json:"paths"`
+	FirstToBreak string                                  `json:"first_to_break,omitempty"`
+}
+
+// SpendingStrategiesResponse compares the spending strategies in the same
+// simulated markets
+type SpendingStrategiesResponse struct {
+	CashFlowID string                            `json:"cashflow_id"`
+	Strategies []SpendingStrategyOutcomeResponse `json:"strategies"`
+}
+
+// SpendingStrategyOutcomeResponse is how a spending strategy fares, its
+// spending figures averaged across simulations
+type SpendingStrategyOutcomeResponse struct {
+	Strategy             string                     `json:"strategy"`
+	SuccessProbability   float64                    `json:"success_probability"`
+	MedianFinalPortfolio float64                    `json:"median_final_portfolio"`
+	Spending             SpendingVolatilityResponse `json:"spending"`
+}
+
+// InheritedIRAStrategyResponse is how the plan fares with one way of
+// spreading the inherited traditional IRAs over their ten years
+type InheritedIRAStrategyResponse struct {
+	Strategy   string  `json:"strategy"`
+	TargetRate float64 `json:"target_rate,omitempty"`
+
+	Distributions       float64 `json:"distributions"`
+	LargestDistribution float64 `json:"largest_distribution"`
+
+	Outcome SpendingShapeOutcomeResponse `json:"outcome"`
+}
+
+// InheritedIRAResponse compares ways of spreading the inherited
+// traditional IRAs; recommended is the strategy leaving the largest final
+// portfolio
+type InheritedIRAResponse struct {
+	CashFlowID  string                         `json:"cashflow_id"`
+	Strategies  []InheritedIRAStrategyResponse `json:"strategies"`
+	Recommended InheritedIRAStrategyResponse   `json:"recommended"`
+}
+
+// LongTermCareResponse shows what a long-term care event does to the plan:
+// projected with care starting at start_age, and simulated with the
+// event's probability
+type LongTermCareResponse struct {
+	CashFlowID string `json:"cashflow_id"`
+	StartAge   int    `json:"start_age"`
+
+	WithoutCare   SpendingShapeOutcomeResponse `json:"without_care"`
+	WithCare      SpendingShapeOutcomeResponse `json:"with_care"`
+	TotalCost     float64                      `json:"total_cost"`
+	TotalBenefits float64                      `json:"total_benefits"`
+	TotalPremiums float64                      `json:"total_premiums"`
+
+	SuccessWithoutCare    float64 `json:"success_without_care"`
+	SuccessWithCare       float64 `json:"success_with_care"`
+	DepletionRiskIncrease float64 `json:"depletion_risk_increase"`
+
+	// RecommendedReserve is what to set aside today to pay the care costs
+	// insurance doesn't
+	RecommendedReserve float64 `json:"recommended_reserve"`
+}
+
+// MarketAssumptionsResponse is each asset class's expected return and
+// volatility, and how their returns are correlated
+type MarketAssumptionsResponse struct {
+	StockReturn     float64 `json:"stock_return"`
+	BondReturn      float64 `json:"bond_return"`
+	CashReturn      float64 `json:"cash_return"`
+	StockVolatility float64 `json:"stock_volatility"`
+	BondVolatility  float64 `json:"bond_volatility"`
+	CashVolatility  float64 `json:"cash_volatility"`
+
+	StockBondCorrelation float64 `json:"stock_bond_correlation"`
+	StockCashCorrelation float64 `json:"stock_cash_correlation"`
+	BondCashCorrelation  float64 `json:"bond_cash_correlation"`
+}
+
+// MarketAssumptionSetResponse is one published version of a provider's
+// market assumptions
+type MarketAssumptionSetResponse struct {
+	Provider    string                    `json:"provider"`
+	Version     string                    `json:"version"`
+	AsOf        *time.Time                `json:"as_of,omitempty"`
+	Assumptions MarketAssumptionsResponse `json:"assumptions"`
+}
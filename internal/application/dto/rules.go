@@ -140,7 +140,6 @@ type ActionResponse struct {
 
 // CreateRuleRequest represents a request to create a new rule
 type CreateRuleRequest struct {
-	UserID       string              `json:"user_id"`
 	ConfigID     string              `json:"config_id,omitempty"`
 	Name         string              `json:"name"`
 	Description  string              `json:"description,omitempty"`
@@ -284,7 +283,6 @@ type TestRuleRequest struct {
 
 // BatchExecuteRequest represents a request to execute multiple rules
 type BatchExecuteRequest struct {
-	UserID           string                 `json:"user_id"`
 	ItemID           string                 `json:"item_id"`
 	ItemType         string                 `json:"item_type"`
 	Data             map[string]interface{} `json:"data"`
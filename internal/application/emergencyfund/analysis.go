@@ -0,0 +1,23 @@
+package emergencyfund
+
+import (
+	"context"
+
+	"clockzen-next/internal/application/analysis"
+)
+
+// EmergencyFundCoverage returns how well the user's emergency fund covers
+// their expenses for what-if analysis. The service implements
+// analysis.EmergencyFundMonitor.
+func (s *Service) EmergencyFundCoverage(ctx context.Context, userID string) (*analysis.EmergencyFundCoverage, error) {
+	status, err := s.GetStatus(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &analysis.EmergencyFundCoverage{
+		Balance:         status.Balance,
+		MonthlyExpenses: status.MonthlyExpenses,
+		MonthsCovered:   status.MonthsCovered,
+		TargetMonths:    status.TargetMonths,
+	}, nil
+}
@@ -0,0 +1,256 @@
+package emergencyfund
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"clockzen-next/internal/domain/money"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/emergencyfundsnapshot"
+	"clockzen-next/internal/ent/liquidaccount"
+
+	"github.com/google/uuid"
+)
+
+// ExpenseHistoryMonths is how many months of transactions monthly expenses
+// are averaged over
+const ExpenseHistoryMonths = 6
+
+// Status is how many months of expenses a user's emergency fund covers
+type Status struct {
+	UserID string
+	// AccountCount is the number of accounts designated as the fund
+	AccountCount    int
+	Balance         float64
+	MonthlyExpenses float64
+	// ExpenseMonths is the number of months of history the expenses were
+	// averaged over; a new user may have less than ExpenseHistoryMonths
+	ExpenseMonths int
+	// MonthsCovered is nil when the user has no expense history
+	MonthsCovered *float64
+	TargetMonths  float64
+	// TargetBalance is the balance that would cover the target, and
+	// Shortfall how far the fund is from it
+	TargetBalance float64
+	Shortfall     float64
+	BelowTarget   bool
+	CheckedAt     time.Time
+}
+
+// Alert is raised when a user's emergency fund drops below their target
+type Alert struct {
+	UserID        string
+	MonthsCovered float64
+	TargetMonths  float64
+	Shortfall     float64
+	At            time.Time
+}
+
+// AlertFunc is called when a user's emergency fund drops below their target
+type AlertFunc func(alert Alert)
+
+// SetOnAlert sets the callback for emergency funds that drop below target
+func (s *Service) SetOnAlert(callback AlertFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onAlert = callback
+}
+
+// GetStatus works out how many months of the user's average expenses
+// their designated accounts cover
+func (s *Service) GetStatus(ctx context.Context, userID string) (*Status, error) {
+	now := time.Now()
+	accounts, err := s.entClient.LiquidAccount.Query().
+		Where(liquidaccount.UserID(userID), liquidaccount.EmergencyFund(true)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying accounts: %w", err)
+	}
+	target, err := s.GetTargetMonths(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &Status{
+		UserID:       userID,
+		AccountCount: len(accounts),
+		TargetMonths: target,
+		CheckedAt:    now,
+	}
+	for _, account := range accounts {
+		status.Balance += account.Balance
+	}
+	status.Balance = money.RoundCents(status.Balance)
+
+	status.MonthlyExpenses, status.ExpenseMonths, err = s.monthlyExpenses(ctx, userID, now)
+	if err != nil {
+		return nil, err
+	}
+	status.setCoverage()
+	return status, nil
+}
+
+// setCoverage works out the months of expenses the balance covers and how
+// far it is from the target. Without expenses there is nothing to cover, so
+// the fund is never below target.
+func (s *Status) setCoverage() {
+	s.MonthsCovered = nil
+	s.TargetBalance, s.Shortfall, s.BelowTarget = 0, 0, false
+	if s.MonthlyExpenses <= 0 {
+		return
+	}
+
+	covered := math.Round(s.Balance/s.MonthlyExpenses*10) / 10
+	s.MonthsCovered = &covered
+	s.TargetBalance = money.RoundCents(s.MonthlyExpenses * s.TargetMonths)
+	s.Shortfall = money.RoundCents(math.Max(0, s.TargetBalance-s.Balance))
+	s.BelowTarget = s.Balance < s.TargetBalance
+}
+
+// CheckStatus works out the user's status, records it as today's snapshot
+// and raises an alert if the fund has dropped below target since the last
+// snapshot. A fund that stays below target is alerted once.
+func (s *Service) CheckStatus(ctx context.Context, userID string) (*Status, error) {
+	status, err := s.GetStatus(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := s.entClient.EmergencyFundSnapshot.Query().
+		Where(emergencyfundsnapshot.UserID(userID)).
+		Order(ent.Desc(emergencyfundsnapshot.FieldDate)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("getting last snapshot: %w", err)
+	}
+
+	err = s.entClient.EmergencyFundSnapshot.Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetDate(snapshotDate(status.CheckedAt)).
+		SetBalance(status.Balance).
+		SetMonthlyExpenses(status.MonthlyExpenses).
+		SetNillableMonthsCovered(status.MonthsCovered).
+		SetTargetMonths(status.TargetMonths).
+		SetBelowTarget(status.BelowTarget).
+		OnConflictColumns(emergencyfundsnapshot.FieldUserID, emergencyfundsnapshot.FieldDate).
+		Update(func(u *ent.EmergencyFundSnapshotUpsert) {
+			u.UpdateBalance()
+			u.UpdateMonthlyExpenses()
+			if status.MonthsCovered != nil {
+				u.UpdateMonthsCovered()
+			} else {
+				u.ClearMonthsCovered()
+			}
+			u.UpdateTargetMonths()
+			u.UpdateBelowTarget()
+			u.SetUpdatedAt(time.Now())
+		}).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("saving snapshot: %w", err)
+	}
+
+	if status.BelowTarget && (previous == nil || !previous.BelowTarget) {
+		s.alert(Alert{
+			UserID:        userID,
+			MonthsCovered: *status.MonthsCovered,
+			TargetMonths:  status.TargetMonths,
+			Shortfall:     status.Shortfall,
+			At:            status.CheckedAt,
+		})
+	}
+	return status, nil
+}
+
+// CheckAll checks the emergency fund of every user with a designated
+// account and returns how many were checked. Failures are logged, so one
+// user doesn't hold up the others.
+func (s *Service) CheckAll(ctx context.Context) int {
+	userIDs, err := s.entClient.LiquidAccount.Query().
+		Where(liquidaccount.EmergencyFund(true)).
+		GroupBy(liquidaccount.FieldUserID).
+		Strings(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "querying users with emergency funds", "error", err)
+		return 0
+	}
+
+	checked := 0
+	for _, userID := range userIDs {
+		if ctx.Err() != nil {
+			break
+		}
+		if _, err := s.CheckStatus(ctx, userID); err != nil {
+			slog.ErrorContext(ctx, "checking emergency fund", "user_id", userID, "error", err)
+			continue
+		}
+		checked++
+	}
+	return checked
+}
+
+// GetHistory returns the user's snapshots taken since a time, oldest first
+func (s *Service) GetHistory(ctx context.Context, userID string, since time.Time) ([]*ent.EmergencyFundSnapshot, error) {
+	snapshots, err := s.entClient.EmergencyFundSnapshot.Query().
+		Where(
+			emergencyfundsnapshot.UserID(userID),
+			emergencyfundsnapshot.DateGTE(snapshotDate(since)),
+		).
+		Order(ent.Asc(emergencyfundsnapshot.FieldDate)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying snapshots: %w", err)
+	}
+	return snapshots, nil
+}
+
+// monthlyExpenses averages the user's spending over the last
+// ExpenseHistoryMonths, or over the months since their first transaction
+// if that is sooner, so a new user's expenses aren't understated. It
+// returns the average and the number of months it was taken over.
+func (s *Service) monthlyExpenses(ctx context.Context, userID string, now time.Time) (float64, int, error) {
+	if s.expenses == nil {
+		return 0, 0, nil
+	}
+	transactions, err := s.expenses.GetByUserID(ctx, userID, now.AddDate(0, -ExpenseHistoryMonths, 0), now)
+	if err != nil {
+		return 0, 0, fmt.Errorf("querying expenses: %w", err)
+	}
+	if len(transactions) == 0 {
+		return 0, 0, nil
+	}
+
+	total := 0.0
+	first := now
+	for _, t := range transactions {
+		total += t.Amount
+		if t.TransactionDate.Before(first) {
+			first = t.TransactionDate
+		}
+	}
+
+	// Months are counted as started, so a week of history is one month
+	months := int(math.Ceil(now.Sub(first).Hours() / 24 / 30.44))
+	months = max(1, min(months, ExpenseHistoryMonths))
+	return money.RoundCents(total / float64(months)), months, nil
+}
+
+// alert calls the alert callback, if one is set
+func (s *Service) alert(alert Alert) {
+	s.mu.RLock()
+	callback := s.onAlert
+	s.mu.RUnlock()
+
+	if callback != nil {
+		callback(alert)
+	}
+}
+
+// snapshotDate is the day a snapshot taken at t is recorded for
+func snapshotDate(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
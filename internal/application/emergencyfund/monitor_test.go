@@ -0,0 +1,141 @@
+package emergencyfund
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/application/analysis"
+)
+
+// stubExpenses serves a fixed set of transactions
+type stubExpenses struct {
+	transactions []analysis.Transaction
+}
+
+func (s stubExpenses) GetByUserID(ctx context.Context, userID string, startDate, endDate time.Time) ([]analysis.Transaction, error) {
+	return s.transactions, nil
+}
+
+func (s stubExpenses) GetByCategory(ctx context.Context, userID string, category analysis.SpendingCategory, startDate, endDate time.Time) ([]analysis.Transaction, error) {
+	return nil, nil
+}
+
+func TestStatusCoverage(t *testing.T) {
+	tests := []struct {
+		name          string
+		balance       float64
+		expenses      float64
+		target        float64
+		wantCovered   *float64
+		wantTarget    float64
+		wantShortfall float64
+		wantBelow     bool
+	}{
+		{
+			name:    "no expenses",
+			balance: 5000, expenses: 0, target: 6,
+			wantCovered: nil,
+		},
+		{
+			name:    "no expenses and no balance",
+			balance: 0, expenses: 0, target: 6,
+			wantCovered: nil,
+		},
+		{
+			name:    "below target",
+			balance: 6000, expenses: 2000, target: 6,
+			wantCovered: ptr(3.0), wantTarget: 12000, wantShortfall: 6000, wantBelow: true,
+		},
+		{
+			name:    "exactly on target",
+			balance: 12000, expenses: 2000, target: 6,
+			wantCovered: ptr(6.0), wantTarget: 12000,
+		},
+		{
+			name:    "above target",
+			balance: 15000, expenses: 2000, target: 6,
+			wantCovered: ptr(7.5), wantTarget: 12000,
+		},
+		{
+			name:    "rounds to a tenth of a month",
+			balance: 1000, expenses: 3000, target: 3,
+			wantCovered: ptr(0.3), wantTarget: 9000, wantShortfall: 8000, wantBelow: true,
+		},
+		{
+			name:    "empty fund",
+			balance: 0, expenses: 1500, target: 3,
+			wantCovered: ptr(0.0), wantTarget: 4500, wantShortfall: 4500, wantBelow: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := &Status{Balance: tt.balance, MonthlyExpenses: tt.expenses, TargetMonths: tt.target}
+			status.setCoverage()
+
+			assert.Equal(t, tt.wantCovered, status.MonthsCovered)
+			assert.Equal(t, tt.wantTarget, status.TargetBalance)
+			assert.Equal(t, tt.wantShortfall, status.Shortfall)
+			assert.Equal(t, tt.wantBelow, status.BelowTarget)
+		})
+	}
+}
+
+func TestMonthlyExpenses(t *testing.T) {
+	now := time.Date(2026, time.July, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("no repository", func(t *testing.T) {
+		expenses, months, err := (&Service{}).monthlyExpenses(context.Background(), "user-1", now)
+		require.NoError(t, err)
+		assert.Zero(t, expenses)
+		assert.Zero(t, months)
+	})
+
+	t.Run("no transactions", func(t *testing.T) {
+		service := &Service{expenses: stubExpenses{}}
+		expenses, months, err := service.monthlyExpenses(context.Background(), "user-1", now)
+		require.NoError(t, err)
+		assert.Zero(t, expenses)
+		assert.Zero(t, months)
+	})
+
+	t.Run("full history", func(t *testing.T) {
+		service := &Service{expenses: stubExpenses{transactions: []analysis.Transaction{
+			{Amount: 3000, TransactionDate: now.AddDate(0, -6, 1)},
+			{Amount: 3000, TransactionDate: now.AddDate(0, -1, 0)},
+		}}}
+		expenses, months, err := service.monthlyExpenses(context.Background(), "user-1", now)
+		require.NoError(t, err)
+		assert.Equal(t, ExpenseHistoryMonths, months)
+		assert.Equal(t, 1000.0, expenses)
+	})
+
+	t.Run("new user averages over months of history", func(t *testing.T) {
+		service := &Service{expenses: stubExpenses{transactions: []analysis.Transaction{
+			{Amount: 1200, TransactionDate: now.AddDate(0, 0, -45)},
+			{Amount: 800, TransactionDate: now.AddDate(0, 0, -3)},
+		}}}
+		expenses, months, err := service.monthlyExpenses(context.Background(), "user-1", now)
+		require.NoError(t, err)
+		assert.Equal(t, 2, months)
+		assert.Equal(t, 1000.0, expenses)
+	})
+
+	t.Run("a week of history counts as a month", func(t *testing.T) {
+		service := &Service{expenses: stubExpenses{transactions: []analysis.Transaction{
+			{Amount: 450, TransactionDate: now.AddDate(0, 0, -7)},
+		}}}
+		expenses, months, err := service.monthlyExpenses(context.Background(), "user-1", now)
+		require.NoError(t, err)
+		assert.Equal(t, 1, months)
+		assert.Equal(t, 450.0, expenses)
+	})
+}
+
+func ptr(v float64) *float64 {
+	return &v
+}
@@ -0,0 +1,228 @@
+// Package emergencyfund tracks the liquid accounts a user sets aside for
+// emergencies and monitors how many months of expenses they cover.
+package emergencyfund
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/emergencyfundtarget"
+	"clockzen-next/internal/ent/liquidaccount"
+
+	"github.com/google/uuid"
+)
+
+// Errors returned by the emergency fund service
+var (
+	ErrAccountNotFound = errors.New("account not found")
+	ErrInvalidName     = errors.New("name is required")
+	ErrInvalidType     = errors.New("type must be one of: checking, savings, money_market, cash, other")
+	ErrInvalidBalance  = errors.New("balance must not be negative")
+	ErrInvalidTarget   = errors.New("target_months must be positive")
+)
+
+// DefaultTargetMonths is the target for users who haven't set one
+const DefaultTargetMonths = 6.0
+
+// AccountInput describes a liquid account to record
+type AccountInput struct {
+	Name    string
+	Type    liquidaccount.Type
+	Balance float64
+	// EmergencyFund designates the account as part of the emergency fund;
+	// nil designates it
+	EmergencyFund *bool
+}
+
+// AccountUpdate changes the fields of an account that are set
+type AccountUpdate struct {
+	Name          *string
+	Type          *liquidaccount.Type
+	Balance       *float64
+	EmergencyFund *bool
+}
+
+// Service records liquid accounts and monitors the emergency fund they make
+// up
+type Service struct {
+	entClient *ent.Client
+	expenses  analysis.TransactionRepository
+
+	mu      sync.RWMutex
+	onAlert AlertFunc
+}
+
+// NewService creates a new emergency fund service. Expenses are averaged
+// from the transactions in expenses.
+func NewService(entClient *ent.Client, expenses analysis.TransactionRepository) *Service {
+	return &Service{
+		entClient: entClient,
+		expenses:  expenses,
+	}
+}
+
+// CreateAccount records a liquid account for the user
+func (s *Service) CreateAccount(ctx context.Context, userID string, input AccountInput) (*ent.LiquidAccount, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+	if input.Type == "" {
+		input.Type = liquidaccount.DefaultType
+	}
+	if err := validateAccount(input.Name, input.Type, input.Balance); err != nil {
+		return nil, err
+	}
+	emergencyFund := true
+	if input.EmergencyFund != nil {
+		emergencyFund = *input.EmergencyFund
+	}
+
+	record, err := s.entClient.LiquidAccount.Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetName(input.Name).
+		SetType(input.Type).
+		SetBalance(input.Balance).
+		SetEmergencyFund(emergencyFund).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating account: %w", err)
+	}
+	return record, nil
+}
+
+// ListAccounts returns the user's liquid accounts, largest balance first
+func (s *Service) ListAccounts(ctx context.Context, userID string) ([]*ent.LiquidAccount, error) {
+	records, err := s.entClient.LiquidAccount.Query().
+		Where(liquidaccount.UserID(userID)).
+		Order(ent.Desc(liquidaccount.FieldBalance), ent.Asc(liquidaccount.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying accounts: %w", err)
+	}
+	return records, nil
+}
+
+// GetAccount returns one of the user's liquid accounts
+func (s *Service) GetAccount(ctx context.Context, userID, id string) (*ent.LiquidAccount, error) {
+	record, err := s.entClient.LiquidAccount.Query().
+		Where(liquidaccount.ID(id), liquidaccount.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrAccountNotFound
+		}
+		return nil, fmt.Errorf("getting account: %w", err)
+	}
+	return record, nil
+}
+
+// UpdateAccount changes one of the user's liquid accounts, e.g. to record
+// its current balance
+func (s *Service) UpdateAccount(ctx context.Context, userID, id string, input AccountUpdate) (*ent.LiquidAccount, error) {
+	record, err := s.GetAccount(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	name, accountType, balance := record.Name, record.Type, record.Balance
+	if input.Name != nil {
+		name = *input.Name
+	}
+	if input.Type != nil {
+		accountType = *input.Type
+	}
+	if input.Balance != nil {
+		balance = *input.Balance
+	}
+	if err := validateAccount(name, accountType, balance); err != nil {
+		return nil, err
+	}
+
+	update := record.Update().
+		SetName(name).
+		SetType(accountType).
+		SetBalance(balance)
+	if input.EmergencyFund != nil {
+		update.SetEmergencyFund(*input.EmergencyFund)
+	}
+	record, err = update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("updating account: %w", err)
+	}
+	return record, nil
+}
+
+// DeleteAccount removes one of the user's liquid accounts
+func (s *Service) DeleteAccount(ctx context.Context, userID, id string) error {
+	deleted, err := s.entClient.LiquidAccount.Delete().
+		Where(liquidaccount.ID(id), liquidaccount.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting account: %w", err)
+	}
+	if deleted == 0 {
+		return ErrAccountNotFound
+	}
+	return nil
+}
+
+// GetTargetMonths returns the months of expenses the user wants their
+// emergency fund to cover, DefaultTargetMonths if they haven't set it
+func (s *Service) GetTargetMonths(ctx context.Context, userID string) (float64, error) {
+	target, err := s.entClient.EmergencyFundTarget.Query().
+		Where(emergencyfundtarget.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return DefaultTargetMonths, nil
+		}
+		return 0, fmt.Errorf("getting target: %w", err)
+	}
+	return target.TargetMonths, nil
+}
+
+// SetTargetMonths sets the months of expenses the user wants their
+// emergency fund to cover
+func (s *Service) SetTargetMonths(ctx context.Context, userID string, months float64) error {
+	if userID == "" {
+		return errors.New("userID is required")
+	}
+	if months <= 0 {
+		return ErrInvalidTarget
+	}
+
+	err := s.entClient.EmergencyFundTarget.Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetTargetMonths(months).
+		OnConflictColumns(emergencyfundtarget.FieldUserID).
+		Update(func(u *ent.EmergencyFundTargetUpsert) {
+			u.UpdateTargetMonths()
+			u.SetUpdatedAt(time.Now())
+		}).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("saving target: %w", err)
+	}
+	return nil
+}
+
+// validateAccount checks an account's fields before it is saved
+func validateAccount(name string, accountType liquidaccount.Type, balance float64) error {
+	if name == "" {
+		return ErrInvalidName
+	}
+	if liquidaccount.TypeValidator(accountType) != nil {
+		return ErrInvalidType
+	}
+	if balance < 0 {
+		return ErrInvalidBalance
+	}
+	return nil
+}
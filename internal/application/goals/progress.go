@@ -0,0 +1,192 @@
+package goals
+
+import (
+	"math"
+	"time"
+
+	"clockzen-next/internal/domain/money"
+)
+
+// RecentMonths is how many months of progress the rate a goal is projected
+// at is averaged over
+const RecentMonths = 3
+
+// avgMonthDays converts between days and months for rates and projections
+const avgMonthDays = 365.25 / 12
+
+// Contribution is dated progress toward a goal: a transaction in its
+// category
+type Contribution struct {
+	Date   time.Time
+	Amount float64
+}
+
+// State is what a goal's progress is computed from
+type State struct {
+	Target   float64
+	Deadline *time.Time
+	// Start is when the goal was set, and Initial the amount toward the
+	// target then
+	Start   time.Time
+	Initial float64
+
+	// Contributions are the progress since Start of a goal linked to a
+	// category, oldest first
+	Contributions []Contribution
+	// Current is the amount toward the target now of a goal linked to an
+	// account. Its progress isn't dated, so it is spread evenly since Start.
+	Current *float64
+}
+
+// TimelinePoint is a goal's progress in a calendar month
+type TimelinePoint struct {
+	Month       time.Time `json:"month"`
+	Contributed float64   `json:"contributed"`
+	// Amount is the amount toward the target at the end of the month, or
+	// now for the current month
+	Amount float64 `json:"amount"`
+}
+
+// Progress is how far a goal has come and when it will be met
+type Progress struct {
+	Amount    float64 `json:"amount"`
+	Target    float64 `json:"target"`
+	Remaining float64 `json:"remaining"`
+	Percent   float64 `json:"percent"`
+	Complete  bool    `json:"complete"`
+
+	// MonthlyRate is the average progress a month over the recent months
+	MonthlyRate float64 `json:"monthly_rate"`
+	// ProjectedCompletion is when the goal is met at MonthlyRate; nil when
+	// it is complete or not progressing
+	ProjectedCompletion *time.Time `json:"projected_completion,omitempty"`
+	// RequiredMonthly is the progress a month that meets the deadline
+	RequiredMonthly float64 `json:"required_monthly,omitempty"`
+	// OnTrack reports whether the goal is complete or projected to be met,
+	// by the deadline if it has one
+	OnTrack bool `json:"on_track"`
+
+	Timeline []TimelinePoint `json:"timeline"`
+}
+
+// ComputeProgress computes a goal's progress as of now
+func ComputeProgress(state State, now time.Time) *Progress {
+	progress := &Progress{Target: state.Target}
+	elapsed := max(monthsBetween(state.Start, now), 1)
+
+	if state.Current != nil {
+		progress.Amount = *state.Current
+		progress.MonthlyRate = (*state.Current - state.Initial) / elapsed
+		progress.Timeline = accountTimeline(state, now)
+	} else {
+		progress.Amount = state.Initial
+		window := min(elapsed, RecentMonths)
+		recentSince := now.Add(-time.Duration(window * avgMonthDays * float64(24*time.Hour)))
+		recent := 0.0
+		for _, c := range state.Contributions {
+			progress.Amount += c.Amount
+			if c.Date.After(recentSince) {
+				recent += c.Amount
+			}
+		}
+		progress.MonthlyRate = recent / window
+		progress.Timeline = contributionTimeline(state, now)
+	}
+
+	progress.Remaining = max(state.Target-progress.Amount, 0)
+	progress.Complete = progress.Remaining == 0
+	if state.Target > 0 {
+		progress.Percent = money.RoundCents(min(progress.Amount/state.Target, 1) * 100)
+	}
+
+	if !progress.Complete {
+		if progress.MonthlyRate > 0 {
+			months := progress.Remaining / progress.MonthlyRate
+			projected := dateOf(now.AddDate(0, 0, int(math.Ceil(months*avgMonthDays))))
+			progress.ProjectedCompletion = &projected
+		}
+		if state.Deadline != nil {
+			// An overdue goal needs the rest at once
+			monthsLeft := max(monthsBetween(now, *state.Deadline), 1)
+			progress.RequiredMonthly = money.RoundCents(progress.Remaining / monthsLeft)
+		}
+	}
+	switch {
+	case progress.Complete:
+		progress.OnTrack = true
+	case progress.ProjectedCompletion == nil:
+	case state.Deadline == nil:
+		progress.OnTrack = true
+	default:
+		progress.OnTrack = !progress.ProjectedCompletion.After(*state.Deadline)
+	}
+
+	progress.Amount = money.RoundCents(progress.Amount)
+	progress.Remaining = money.RoundCents(progress.Remaining)
+	progress.MonthlyRate = money.RoundCents(progress.MonthlyRate)
+	return progress
+}
+
+// contributionTimeline totals a category goal's contributions by month,
+// from the month it was set through the current month
+func contributionTimeline(state State, now time.Time) []TimelinePoint {
+	var timeline []TimelinePoint
+	amount := state.Initial
+	i := 0
+	for month := monthOf(state.Start); !month.After(now); month = month.AddDate(0, 1, 0) {
+		next := month.AddDate(0, 1, 0)
+		point := TimelinePoint{Month: month}
+		for ; i < len(state.Contributions) && state.Contributions[i].Date.Before(next); i++ {
+			point.Contributed += state.Contributions[i].Amount
+		}
+		amount += point.Contributed
+		point.Contributed = money.RoundCents(point.Contributed)
+		point.Amount = money.RoundCents(amount)
+		timeline = append(timeline, point)
+	}
+	return timeline
+}
+
+// accountTimeline spreads an account goal's progress evenly over the
+// months since it was set
+func accountTimeline(state State, now time.Time) []TimelinePoint {
+	total := *state.Current - state.Initial
+	elapsed := monthsBetween(state.Start, now)
+
+	var timeline []TimelinePoint
+	for month := monthOf(state.Start); !month.After(now); month = month.AddDate(0, 1, 0) {
+		end := month.AddDate(0, 1, 0)
+		if end.After(now) {
+			end = now
+		}
+		share := 1.0
+		if elapsed > 0 {
+			share = min(monthsBetween(state.Start, end)/elapsed, 1)
+		}
+		amount := state.Initial + total*share
+		point := TimelinePoint{Month: month, Amount: money.RoundCents(amount)}
+		if len(timeline) == 0 {
+			point.Contributed = money.RoundCents(amount - state.Initial)
+		} else {
+			point.Contributed = money.RoundCents(amount - timeline[len(timeline)-1].Amount)
+		}
+		timeline = append(timeline, point)
+	}
+	return timeline
+}
+
+// monthsBetween is the number of months from one time to another, negative
+// when to is before from
+func monthsBetween(from, to time.Time) float64 {
+	return to.Sub(from).Hours() / 24 / avgMonthDays
+}
+
+// monthOf is the first day of t's month
+func monthOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+// dateOf is the start of t's day
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
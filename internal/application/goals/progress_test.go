@@ -0,0 +1,119 @@
+package goals
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var goalStart = time.Date(2026, time.January, 15, 0, 0, 0, 0, time.UTC)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestComputeProgressFromContributions(t *testing.T) {
+	deadline := date(2026, time.December, 31)
+	state := State{
+		Target:   6000,
+		Deadline: &deadline,
+		Start:    goalStart,
+		Initial:  1000,
+		Contributions: []Contribution{
+			{Date: date(2026, time.January, 20), Amount: 500},
+			{Date: date(2026, time.February, 20), Amount: 500},
+			{Date: date(2026, time.March, 20), Amount: 500},
+			{Date: date(2026, time.April, 20), Amount: 500},
+		},
+	}
+	now := date(2026, time.May, 1)
+
+	progress := ComputeProgress(state, now)
+	assert.Equal(t, 3000.0, progress.Amount)
+	assert.Equal(t, 3000.0, progress.Remaining)
+	assert.Equal(t, 50.0, progress.Percent)
+	assert.False(t, progress.Complete)
+
+	// The last three months saved 1500
+	assert.Equal(t, 500.0, progress.MonthlyRate)
+	require.NotNil(t, progress.ProjectedCompletion)
+	assert.True(t, progress.ProjectedCompletion.After(date(2026, time.October, 25)))
+	assert.True(t, progress.ProjectedCompletion.Before(date(2026, time.November, 5)))
+	assert.True(t, progress.OnTrack)
+	assert.InDelta(t, 3000/8.0, progress.RequiredMonthly, 10)
+
+	// A point a month from the month the goal was set
+	require.Len(t, progress.Timeline, 5)
+	assert.Equal(t, date(2026, time.January, 1), progress.Timeline[0].Month)
+	assert.Equal(t, 500.0, progress.Timeline[0].Contributed)
+	assert.Equal(t, 1500.0, progress.Timeline[0].Amount)
+	assert.Equal(t, 3000.0, progress.Timeline[3].Amount)
+	assert.Equal(t, 0.0, progress.Timeline[4].Contributed)
+	assert.Equal(t, 3000.0, progress.Timeline[4].Amount)
+}
+
+func TestComputeProgressBehindDeadline(t *testing.T) {
+	deadline := date(2026, time.June, 30)
+	state := State{
+		Target:   6000,
+		Deadline: &deadline,
+		Start:    goalStart,
+		Contributions: []Contribution{
+			{Date: date(2026, time.March, 1), Amount: 300},
+		},
+	}
+
+	progress := ComputeProgress(state, date(2026, time.April, 1))
+	assert.False(t, progress.OnTrack)
+	require.NotNil(t, progress.ProjectedCompletion)
+	assert.True(t, progress.ProjectedCompletion.After(deadline))
+	assert.Greater(t, progress.RequiredMonthly, progress.MonthlyRate)
+}
+
+func TestComputeProgressNotProgressing(t *testing.T) {
+	progress := ComputeProgress(State{Target: 1000, Start: goalStart}, date(2026, time.March, 1))
+	assert.Equal(t, 0.0, progress.MonthlyRate)
+	assert.Nil(t, progress.ProjectedCompletion)
+	assert.False(t, progress.OnTrack)
+}
+
+func TestComputeProgressComplete(t *testing.T) {
+	state := State{
+		Target:        1000,
+		Start:         goalStart,
+		Initial:       800,
+		Contributions: []Contribution{{Date: date(2026, time.February, 1), Amount: 400}},
+	}
+
+	progress := ComputeProgress(state, date(2026, time.March, 1))
+	assert.True(t, progress.Complete)
+	assert.True(t, progress.OnTrack)
+	assert.Equal(t, 100.0, progress.Percent)
+	assert.Equal(t, 0.0, progress.Remaining)
+	assert.Nil(t, progress.ProjectedCompletion)
+}
+
+func TestComputeProgressFromAccount(t *testing.T) {
+	current := 4000.0
+	state := State{
+		Target:  10000,
+		Start:   date(2026, time.January, 1),
+		Initial: 1000,
+		Current: &current,
+	}
+
+	progress := ComputeProgress(state, date(2026, time.July, 1))
+	assert.Equal(t, 4000.0, progress.Amount)
+	assert.InDelta(t, 500, progress.MonthlyRate, 5)
+	require.NotNil(t, progress.ProjectedCompletion)
+	assert.True(t, progress.OnTrack)
+
+	// The account's growth is spread evenly over the months
+	require.Len(t, progress.Timeline, 7)
+	assert.InDelta(t, 1500, progress.Timeline[0].Amount, 15)
+	assert.InDelta(t, 500, progress.Timeline[2].Contributed, 15)
+	assert.Equal(t, 4000.0, progress.Timeline[6].Amount)
+	assert.Equal(t, 0.0, progress.Timeline[6].Contributed)
+}
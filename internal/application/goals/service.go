@@ -0,0 +1,304 @@
+// Package goals tracks a user's savings and debt payoff goals and their
+// progress.
+//
+// A goal is linked to a category or to an account. Transactions in a
+// goal's category since it was set count toward it. A savings goal linked
+// to a liquid account has saved the account's balance; a debt payoff goal
+// linked to a debt has paid off what the balance has fallen since the goal
+// was set.
+package goals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/debt"
+	"clockzen-next/internal/ent/goal"
+	"clockzen-next/internal/ent/liquidaccount"
+
+	"github.com/google/uuid"
+)
+
+// Errors returned by the goal service
+var (
+	ErrGoalNotFound          = errors.New("goal not found")
+	ErrAccountNotFound       = errors.New("linked account not found")
+	ErrInvalidName           = errors.New("name is required")
+	ErrInvalidType           = errors.New("type must be savings or debt_payoff")
+	ErrInvalidTarget         = errors.New("target_amount must be positive")
+	ErrInvalidStartingAmount = errors.New("starting_amount must not be negative")
+	ErrInvalidLink           = errors.New("set one of category or account_id")
+	ErrNoContributions       = errors.New("goals linked to a category need stored transactions")
+)
+
+// ContributionRepository finds the transactions that count toward goals
+// linked to a category
+type ContributionRepository interface {
+	// GetContributions returns the user's transactions in a category, or
+	// tagged with it, between startDate and endDate, oldest first
+	GetContributions(ctx context.Context, userID, category string, startDate, endDate time.Time) ([]analysis.Transaction, error)
+}
+
+// GoalInput describes a goal to set
+type GoalInput struct {
+	Name string
+	Type goal.Type
+	// TargetAmount defaults to the linked debt's balance for debt payoff
+	// goals
+	TargetAmount float64
+	// StartingAmount is what a savings goal linked to a category has
+	// already saved. Goals linked to an account start from its balance.
+	StartingAmount float64
+	Deadline       *time.Time
+
+	Category  string
+	AccountID string
+}
+
+// GoalUpdate changes the fields of a goal that are set. A goal's type and
+// link can't be changed.
+type GoalUpdate struct {
+	Name           *string
+	TargetAmount   *float64
+	StartingAmount *float64
+	Deadline       *time.Time
+	// ClearDeadline removes the deadline
+	ClearDeadline bool
+}
+
+// Service records goals and computes their progress
+type Service struct {
+	entClient     *ent.Client
+	contributions ContributionRepository
+}
+
+// NewService creates a new goal service. Contributions to goals linked to
+// a category are found in contributions.
+func NewService(entClient *ent.Client, contributions ContributionRepository) *Service {
+	return &Service{
+		entClient:     entClient,
+		contributions: contributions,
+	}
+}
+
+// CreateGoal sets a goal for the user
+func (s *Service) CreateGoal(ctx context.Context, userID string, input GoalInput) (*ent.Goal, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+	if input.Type == "" {
+		input.Type = goal.DefaultType
+	}
+	if input.Name == "" {
+		return nil, ErrInvalidName
+	}
+	if goal.TypeValidator(input.Type) != nil {
+		return nil, ErrInvalidType
+	}
+	if (input.Category == "") == (input.AccountID == "") {
+		return nil, ErrInvalidLink
+	}
+
+	if input.AccountID != "" {
+		balance, err := s.accountBalance(ctx, userID, input.Type, input.AccountID)
+		if err != nil {
+			return nil, err
+		}
+		input.StartingAmount = balance
+		if input.Type == goal.TypeDebtPayoff && input.TargetAmount == 0 {
+			input.TargetAmount = balance
+		}
+	}
+	if err := validateAmounts(input.TargetAmount, input.StartingAmount); err != nil {
+		return nil, err
+	}
+
+	create := s.entClient.Goal.Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetName(input.Name).
+		SetType(input.Type).
+		SetTargetAmount(input.TargetAmount).
+		SetStartingAmount(input.StartingAmount).
+		SetNillableDeadline(input.Deadline)
+	if input.Category != "" {
+		create.SetCategory(input.Category)
+	}
+	if input.AccountID != "" {
+		create.SetAccountID(input.AccountID)
+	}
+	record, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating goal: %w", err)
+	}
+	return record, nil
+}
+
+// ListGoals returns the user's goals, soonest deadline first
+func (s *Service) ListGoals(ctx context.Context, userID string) ([]*ent.Goal, error) {
+	records, err := s.entClient.Goal.Query().
+		Where(goal.UserID(userID)).
+		Order(ent.Asc(goal.FieldDeadline), ent.Asc(goal.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying goals: %w", err)
+	}
+	return records, nil
+}
+
+// GetGoal returns one of the user's goals
+func (s *Service) GetGoal(ctx context.Context, userID, id string) (*ent.Goal, error) {
+	record, err := s.entClient.Goal.Query().
+		Where(goal.ID(id), goal.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrGoalNotFound
+		}
+		return nil, fmt.Errorf("getting goal: %w", err)
+	}
+	return record, nil
+}
+
+// UpdateGoal changes one of the user's goals
+func (s *Service) UpdateGoal(ctx context.Context, userID, id string, input GoalUpdate) (*ent.Goal, error) {
+	record, err := s.GetGoal(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	name, target, starting := record.Name, record.TargetAmount, record.StartingAmount
+	if input.Name != nil {
+		name = *input.Name
+	}
+	if input.TargetAmount != nil {
+		target = *input.TargetAmount
+	}
+	if input.StartingAmount != nil {
+		starting = *input.StartingAmount
+	}
+	if name == "" {
+		return nil, ErrInvalidName
+	}
+	if err := validateAmounts(target, starting); err != nil {
+		return nil, err
+	}
+
+	update := record.Update().
+		SetName(name).
+		SetTargetAmount(target).
+		SetStartingAmount(starting)
+	switch {
+	case input.ClearDeadline:
+		update.ClearDeadline()
+	case input.Deadline != nil:
+		update.SetDeadline(*input.Deadline)
+	}
+	record, err = update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("updating goal: %w", err)
+	}
+	return record, nil
+}
+
+// DeleteGoal removes one of the user's goals
+func (s *Service) DeleteGoal(ctx context.Context, userID, id string) error {
+	deleted, err := s.entClient.Goal.Delete().
+		Where(goal.ID(id), goal.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting goal: %w", err)
+	}
+	if deleted == 0 {
+		return ErrGoalNotFound
+	}
+	return nil
+}
+
+// GetProgress computes one of the user's goals' progress as of now, with
+// its monthly timeline since it was set
+func (s *Service) GetProgress(ctx context.Context, userID, id string, now time.Time) (*Progress, error) {
+	record, err := s.GetGoal(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	state := State{
+		Target:   record.TargetAmount,
+		Deadline: record.Deadline,
+		Start:    record.CreatedAt,
+		Initial:  record.StartingAmount,
+	}
+	switch {
+	case record.AccountID != nil:
+		balance, err := s.accountBalance(ctx, userID, record.Type, *record.AccountID)
+		if err != nil {
+			return nil, err
+		}
+		current := balance
+		if record.Type == goal.TypeDebtPayoff {
+			// Paid off is what the balance has fallen since the goal was
+			// set, counting from nothing paid
+			current = max(record.StartingAmount-balance, 0)
+			state.Initial = 0
+		}
+		state.Current = &current
+
+	case record.Category != nil:
+		if s.contributions == nil {
+			return nil, ErrNoContributions
+		}
+		if record.Type == goal.TypeDebtPayoff {
+			state.Initial = 0
+		}
+		transactions, err := s.contributions.GetContributions(ctx, userID, *record.Category, record.CreatedAt, now)
+		if err != nil {
+			return nil, fmt.Errorf("querying contributions: %w", err)
+		}
+		state.Contributions = make([]Contribution, len(transactions))
+		for i, t := range transactions {
+			state.Contributions[i] = Contribution{Date: t.TransactionDate, Amount: t.Amount}
+		}
+	}
+	return ComputeProgress(state, now), nil
+}
+
+// accountBalance returns the balance of the account a goal is linked to:
+// a liquid account for savings goals, a debt for debt payoff goals
+func (s *Service) accountBalance(ctx context.Context, userID string, goalType goal.Type, accountID string) (float64, error) {
+	var balance float64
+	var err error
+	if goalType == goal.TypeDebtPayoff {
+		balance, err = s.entClient.Debt.Query().
+			Where(debt.ID(accountID), debt.UserID(userID)).
+			Select(debt.FieldBalance).
+			Float64(ctx)
+	} else {
+		balance, err = s.entClient.LiquidAccount.Query().
+			Where(liquidaccount.ID(accountID), liquidaccount.UserID(userID)).
+			Select(liquidaccount.FieldBalance).
+			Float64(ctx)
+	}
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, ErrAccountNotFound
+		}
+		return 0, fmt.Errorf("getting linked account: %w", err)
+	}
+	return balance, nil
+}
+
+// validateAmounts checks a goal's amounts before it is saved
+func validateAmounts(target, starting float64) error {
+	if target <= 0 {
+		return ErrInvalidTarget
+	}
+	if starting < 0 {
+		return ErrInvalidStartingAmount
+	}
+	return nil
+}
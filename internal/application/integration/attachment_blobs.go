@@ -0,0 +1,131 @@
+package integration
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/attachmentblob"
+	"clockzen-next/internal/ent/attachmentlink"
+	"clockzen-next/internal/infrastructure/google"
+
+	"github.com/google/uuid"
+)
+
+// BlobStore keeps attachment content outside the database
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+}
+
+// StoredAttachment is the blob a downloaded attachment's content was stored
+// as
+type StoredAttachment struct {
+	BlobID      string
+	ContentHash string
+	// Duplicate is set when the connection already linked the content from
+	// this or another message, so it needn't be OCRed again. Content stored
+	// for other connections doesn't count: their OCR results aren't theirs
+	// to share, and saying so would reveal that someone holds the file.
+	Duplicate bool
+}
+
+// SetBlobStore sets the store downloaded attachment content is kept in.
+// Without one, only the content hashes are recorded.
+func (s *EmailSyncService) SetBlobStore(store BlobStore) {
+	s.blobs = store
+}
+
+// ContentHash returns the hex SHA-256 of attachment content
+func ContentHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// blobKey is where content with a hash is kept in the blob store, fanned
+// out by the first two characters to keep directories small
+func blobKey(hash string) string {
+	return "attachments/sha256/" + hash[:2] + "/" + hash
+}
+
+// StoreAttachment stores an attachment's content once per SHA-256 and links
+// the message to it. Content already stored, from any message, is not
+// written again; the message is only linked to the existing blob.
+func (s *EmailSyncService) StoreAttachment(ctx context.Context, connectionID, messageID string, att google.AttachmentInfo, data []byte) (*StoredAttachment, error) {
+	hash := ContentHash(data)
+	stored := &StoredAttachment{ContentHash: hash}
+
+	blob, err := s.entClient.AttachmentBlob.Query().
+		Where(attachmentblob.Sha256(hash)).
+		Only(ctx)
+	switch {
+	case err == nil:
+	case ent.IsNotFound(err):
+		blob, err = s.createBlob(ctx, hash, att, data)
+		if ent.IsConstraintError(err) {
+			// Another message with the same content was stored first
+			blob, err = s.entClient.AttachmentBlob.Query().
+				Where(attachmentblob.Sha256(hash)).
+				Only(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("querying attachment blob: %w", err)
+	}
+	stored.BlobID = blob.ID
+
+	linked, err := s.entClient.AttachmentLink.Query().
+		Where(attachmentlink.BlobID(blob.ID), attachmentlink.ConnectionID(connectionID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying attachment links: %w", err)
+	}
+	stored.Duplicate = linked
+
+	err = s.entClient.AttachmentLink.Create().
+		SetID(uuid.New().String()).
+		SetBlobID(blob.ID).
+		SetConnectionID(connectionID).
+		SetMessageID(messageID).
+		SetAttachmentID(att.AttachmentID).
+		SetFilename(att.Filename).
+		OnConflictColumns(attachmentlink.FieldConnectionID, attachmentlink.FieldMessageID, attachmentlink.FieldBlobID).
+		Ignore().
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("linking attachment blob: %w", err)
+	}
+	return stored, nil
+}
+
+// createBlob writes new content to the blob store, if one is set, and
+// records it. Content is written first so a recorded blob always has its
+// content; a failed record leaves the content to be overwritten by the
+// next attempt.
+func (s *EmailSyncService) createBlob(ctx context.Context, hash string, att google.AttachmentInfo, data []byte) (*ent.AttachmentBlob, error) {
+	create := s.entClient.AttachmentBlob.Create().
+		SetID(uuid.New().String()).
+		SetSha256(hash).
+		SetSize(int64(len(data))).
+		SetMimeType(att.MimeType)
+
+	if s.blobs != nil {
+		key := blobKey(hash)
+		if err := s.blobs.Put(ctx, key, data); err != nil {
+			return nil, fmt.Errorf("storing attachment content: %w", err)
+		}
+		create.SetStorageKey(key)
+	}
+
+	blob, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("creating attachment blob: %w", err)
+	}
+	return blob, nil
+}
@@ -0,0 +1,286 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/googledriveconnection"
+	"clockzen-next/internal/ent/googledrivefolder"
+	"clockzen-next/internal/infrastructure/google"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotAFolder is returned when a Drive file picked as a folder to track
+// is not a folder
+var ErrNotAFolder = errors.New("drive file is not a folder")
+
+// maxFolderDepth bounds how many ancestors are looked up for a folder's
+// path
+const maxFolderDepth = 32
+
+// DriveFolderEntry is a folder in the connected Drive, as offered by the
+// folder picker
+type DriveFolderEntry struct {
+	ID           string
+	Name         string
+	ParentIDs    []string
+	ModifiedTime time.Time
+	// Tracked is the folder's tracking record, nil if it isn't tracked
+	Tracked *ent.GoogleDriveFolder
+}
+
+// DriveFolderPage is a page of Drive folders
+type DriveFolderPage struct {
+	Folders []DriveFolderEntry
+	// NextPageToken gets the next page; empty on the last page
+	NextPageToken string
+}
+
+// FolderSelection picks a Drive folder to track, or to stop tracking
+type FolderSelection struct {
+	DriveFolderID string
+	// Recursive syncs the folder's subfolders along with it
+	Recursive bool
+	// Deselect stops tracking the folder
+	Deselect bool
+}
+
+// ListDriveFolders lists a page of the folders directly within a Drive
+// folder, "root" for the top of My Drive, marking those already tracked.
+// Pickers load each level as it is opened.
+func (s *DriveSyncService) ListDriveFolders(ctx context.Context, connectionID, parentID, pageToken string, pageSize int) (*DriveFolderPage, error) {
+	driveClient, err := s.connectionDriveClient(ctx, connectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := driveClient.ListSubfolders(ctx, parentID, google.ListFilesOptions{
+		PageSize:  pageSize,
+		PageToken: pageToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing drive folders: %w", err)
+	}
+	return s.folderPage(ctx, connectionID, result)
+}
+
+// SearchDriveFolders lists a page of the folders anywhere in the connected
+// Drive whose name contains name, marking those already tracked
+func (s *DriveSyncService) SearchDriveFolders(ctx context.Context, connectionID, name, pageToken string, pageSize int) (*DriveFolderPage, error) {
+	driveClient, err := s.connectionDriveClient(ctx, connectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := driveClient.SearchFolders(ctx, name, google.ListFilesOptions{
+		PageSize:  pageSize,
+		PageToken: pageToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("searching drive folders: %w", err)
+	}
+	return s.folderPage(ctx, connectionID, result)
+}
+
+// SelectFolders starts or stops tracking the selected folders of a
+// connection and returns the records of those now tracked. Folders already
+// tracked are updated and re-enabled, keeping their sync statistics. The
+// folders are looked up in Drive first, for their names and paths, and all
+// changes are saved in one transaction.
+func (s *DriveSyncService) SelectFolders(ctx context.Context, connectionID string, selections []FolderSelection) ([]*ent.GoogleDriveFolder, error) {
+	driveClient, err := s.connectionDriveClient(ctx, connectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	type selectedFolder struct {
+		file      *google.DriveFile
+		path      string
+		recursive bool
+	}
+	var (
+		selected   []selectedFolder
+		deselected []string
+	)
+	for _, selection := range selections {
+		if selection.Deselect {
+			deselected = append(deselected, selection.DriveFolderID)
+			continue
+		}
+
+		file, err := driveClient.GetFile(ctx, selection.DriveFolderID, "id,name,mimeType,parents")
+		if errors.Is(err, google.ErrFileNotFound) {
+			return nil, fmt.Errorf("%w: %s", ErrFolderNotFound, selection.DriveFolderID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("getting drive folder %s: %w", selection.DriveFolderID, err)
+		}
+		if !file.IsFolder() {
+			return nil, fmt.Errorf("%w: %s", ErrNotAFolder, selection.DriveFolderID)
+		}
+		path, err := folderPath(ctx, driveClient, file)
+		if err != nil {
+			return nil, err
+		}
+		selected = append(selected, selectedFolder{file: file, path: path, recursive: selection.Recursive})
+	}
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if len(deselected) > 0 {
+		_, err := tx.GoogleDriveFolder.Delete().
+			Where(
+				googledrivefolder.ConnectionID(connectionID),
+				googledrivefolder.DriveFolderIDIn(deselected...),
+			).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("removing deselected folders: %w", err)
+		}
+	}
+
+	selectedIDs := make([]string, len(selected))
+	for i, folder := range selected {
+		selectedIDs[i] = folder.file.ID
+
+		var parentID *string
+		if len(folder.file.Parents) > 0 {
+			parentID = &folder.file.Parents[0]
+		}
+		err := tx.GoogleDriveFolder.Create().
+			SetID(uuid.New().String()).
+			SetConnectionID(connectionID).
+			SetDriveFolderID(folder.file.ID).
+			SetName(folder.file.Name).
+			SetPath(folder.path).
+			SetNillableParentFolderID(parentID).
+			SetIsRoot(true).
+			SetRecursive(folder.recursive).
+			OnConflictColumns(googledrivefolder.FieldConnectionID, googledrivefolder.FieldDriveFolderID).
+			Update(func(u *ent.GoogleDriveFolderUpsert) {
+				u.UpdateName()
+				u.UpdatePath()
+				u.UpdateParentFolderID()
+				u.UpdateRecursive()
+				u.SetSyncEnabled(true)
+				u.UpdateUpdatedAt()
+			}).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("saving folder %s: %w", folder.file.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing folder selection: %w", err)
+	}
+
+	if len(selectedIDs) == 0 {
+		return []*ent.GoogleDriveFolder{}, nil
+	}
+	folders, err := s.entClient.GoogleDriveFolder.Query().
+		Where(
+			googledrivefolder.ConnectionID(connectionID),
+			googledrivefolder.DriveFolderIDIn(selectedIDs...),
+		).
+		Order(googledrivefolder.ByPath(), googledrivefolder.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting selected folders: %w", err)
+	}
+	return folders, nil
+}
+
+// connectionDriveClient creates a Drive client for an active connection
+func (s *DriveSyncService) connectionDriveClient(ctx context.Context, connectionID string) (*google.DriveClient, error) {
+	connection, err := s.entClient.GoogleDriveConnection.Get(ctx, connectionID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrConnectionNotFound
+		}
+		return nil, fmt.Errorf("getting connection: %w", err)
+	}
+
+	if connection.Status != googledriveconnection.StatusActive {
+		return nil, ErrConnectionInactive
+	}
+
+	tokenSource, err := s.tokenManager.DriveTokenSource(connection)
+	if err != nil {
+		return nil, err
+	}
+	return google.NewDriveClient(tokenSource), nil
+}
+
+// folderPage converts a Drive listing to a page of folders, with the
+// tracking records of the folders the connection tracks
+func (s *DriveSyncService) folderPage(ctx context.Context, connectionID string, result *google.FileListResponse) (*DriveFolderPage, error) {
+	ids := make([]string, len(result.Files))
+	for i, file := range result.Files {
+		ids[i] = file.ID
+	}
+
+	tracked := make(map[string]*ent.GoogleDriveFolder)
+	if len(ids) > 0 {
+		folders, err := s.entClient.GoogleDriveFolder.Query().
+			Where(
+				googledrivefolder.ConnectionID(connectionID),
+				googledrivefolder.DriveFolderIDIn(ids...),
+			).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("getting tracked folders: %w", err)
+		}
+		for _, folder := range folders {
+			tracked[folder.DriveFolderID] = folder
+		}
+	}
+
+	page := &DriveFolderPage{
+		Folders:       make([]DriveFolderEntry, len(result.Files)),
+		NextPageToken: result.NextPageToken,
+	}
+	for i, file := range result.Files {
+		page.Folders[i] = DriveFolderEntry{
+			ID:           file.ID,
+			Name:         file.Name,
+			ParentIDs:    file.Parents,
+			ModifiedTime: file.ModifiedTime,
+			Tracked:      tracked[file.ID],
+		}
+	}
+	return page, nil
+}
+
+// folderPath returns the names of a folder and its ancestors from the top
+// of its drive, such as "My Drive/Receipts/2024". The path stops at the
+// first ancestor the connection can't see, as for folders shared with the
+// user.
+func folderPath(ctx context.Context, driveClient *google.DriveClient, folder *google.DriveFile) (string, error) {
+	names := []string{folder.Name}
+	parents := folder.Parents
+	for depth := 0; len(parents) > 0 && depth < maxFolderDepth; depth++ {
+		parent, err := driveClient.GetFile(ctx, parents[0], "id,name,parents")
+		if errors.Is(err, google.ErrFileNotFound) || errors.Is(err, google.ErrAccessDenied) {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("getting parent of drive folder %s: %w", folder.ID, err)
+		}
+		names = append(names, parent.Name)
+		parents = parent.Parents
+	}
+
+	slices.Reverse(names)
+	return strings.Join(names, "/"), nil
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -15,8 +16,10 @@ import (
 	"clockzen-next/internal/ent/googledrivefolder"
 	"clockzen-next/internal/ent/googledrivesync"
 	"clockzen-next/internal/infrastructure/google"
+	"clockzen-next/internal/infrastructure/tracing"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // Sync service errors
@@ -125,20 +128,25 @@ type SyncProgressCallback func(progress SyncProgress)
 
 // DriveSyncService provides Google Drive sync functionality
 type DriveSyncService struct {
-	config      SyncConfig
-	entClient   *ent.Client
-	oauthCfg    *google.Config
-	mu          sync.RWMutex
-	activeSyncs map[string]context.CancelFunc
+	config       SyncConfig
+	entClient    *ent.Client
+	oauthCfg     *google.Config
+	tokens       *TokenStore
+	tokenManager *TokenManager
+	mu           sync.RWMutex
+	activeSyncs  map[string]context.CancelFunc
 }
 
 // NewDriveSyncService creates a new drive sync service
 func NewDriveSyncService(entClient *ent.Client, oauthCfg *google.Config, config SyncConfig) *DriveSyncService {
+	tokens := NewTokenStore(entClient, nil)
 	return &DriveSyncService{
-		config:      config,
-		entClient:   entClient,
-		oauthCfg:    oauthCfg,
-		activeSyncs: make(map[string]context.CancelFunc),
+		config:       config,
+		entClient:    entClient,
+		oauthCfg:     oauthCfg,
+		tokens:       tokens,
+		tokenManager: NewTokenManagerWithDefaults(entClient, oauthCfg, tokens),
+		activeSyncs:  make(map[string]context.CancelFunc),
 	}
 }
 
@@ -147,6 +155,25 @@ func NewDriveSyncServiceWithDefaults(entClient *ent.Client, oauthCfg *google.Con
 	return NewDriveSyncService(entClient, oauthCfg, DefaultSyncConfig())
 }
 
+// SetTokenStore sets the store used to decrypt connection tokens
+func (s *DriveSyncService) SetTokenStore(tokens *TokenStore) {
+	s.tokens = tokens
+	s.tokenManager.SetTokenStore(tokens)
+}
+
+// SetTokenManager sets the manager refreshing connection tokens, so it can
+// be shared with other services. It replaces the token store with the
+// manager's.
+func (s *DriveSyncService) SetTokenManager(manager *TokenManager) {
+	s.tokenManager = manager
+	s.tokens = manager.TokenStore()
+}
+
+// TokenManager returns the manager refreshing connection tokens
+func (s *DriveSyncService) TokenManager() *TokenManager {
+	return s.tokenManager
+}
+
 // SyncFolder performs a sync operation for a specific folder
 func (s *DriveSyncService) SyncFolder(ctx context.Context, connectionID, folderID string, syncType string) (*SyncResult, error) {
 	return s.SyncFolderWithProgress(ctx, connectionID, folderID, syncType, nil)
@@ -154,10 +181,26 @@ func (s *DriveSyncService) SyncFolder(ctx context.Context, connectionID, folderI
 
 // SyncFolderWithProgress performs a sync with progress callback
 func (s *DriveSyncService) SyncFolderWithProgress(ctx context.Context, connectionID, folderID string, syncType string, progressCb SyncProgressCallback) (*SyncResult, error) {
+	return s.SyncFolderInRange(ctx, connectionID, folderID, syncType, SyncRange{}, progressCb)
+}
+
+// SyncFolderInRange performs a sync with progress callback, limited to files
+// modified within dateRange. A zero range syncs every file.
+func (s *DriveSyncService) SyncFolderInRange(ctx context.Context, connectionID, folderID string, syncType string, dateRange SyncRange, progressCb SyncProgressCallback) (_ *SyncResult, err error) {
+	ctx, span := tracing.Start(ctx, "DriveSyncService.SyncFolder",
+		attribute.String("sync.connection_id", connectionID),
+		attribute.String("sync.folder_id", folderID),
+		attribute.String("sync.type", syncType),
+	)
+	defer tracing.End(span, &err)
+
 	// Validate sync type
 	if !isValidSyncType(syncType) {
 		return nil, fmt.Errorf("%w: %s", ErrInvalidSyncType, syncType)
 	}
+	if err := dateRange.validate(syncType); err != nil {
+		return nil, err
+	}
 
 	// Check if sync is already running
 	s.mu.RLock()
@@ -207,6 +250,13 @@ func (s *DriveSyncService) SyncFolderWithProgress(ctx context.Context, connectio
 	if err != nil {
 		return nil, fmt.Errorf("creating sync record: %w", err)
 	}
+	span.SetAttributes(attribute.String("sync.id", syncID))
+	slog.InfoContext(ctx, "drive sync started",
+		"sync_id", syncID,
+		"connection_id", connectionID,
+		"folder_id", folderID,
+		"sync_type", syncType,
+	)
 
 	// Register active sync with cancellation
 	ctx, cancel := context.WithCancel(ctx)
@@ -221,29 +271,22 @@ func (s *DriveSyncService) SyncFolderWithProgress(ctx context.Context, connectio
 		cancel()
 	}()
 
-	// Create OAuth token and drive client
-	oauthClient, err := google.NewClient(s.oauthCfg)
+	// Create drive client
+	tokenSource, err := s.tokenManager.DriveTokenSource(connection)
 	if err != nil {
-		return s.failSync(ctx, syncRecord, fmt.Errorf("creating oauth client: %w", err))
-	}
-
-	token := &google.Token{
-		AccessToken:  connection.AccessToken,
-		RefreshToken: connection.RefreshToken,
-		Expiry:       connection.TokenExpiry,
+		return s.failSync(ctx, syncRecord, err)
 	}
-	tokenSource := google.NewTokenSource(oauthClient, token)
 	driveClient := google.NewDriveClient(tokenSource)
 
 	// Perform the sync based on type
 	var result *SyncResult
 	switch syncType {
 	case "full":
-		result, err = s.performFullSync(ctx, driveClient, syncRecord, folder, progressCb)
+		result, err = s.performFullSync(ctx, driveClient, syncRecord, folder, dateRange, progressCb)
 	case "incremental":
 		result, err = s.performIncrementalSync(ctx, driveClient, syncRecord, folder, progressCb)
 	case "manual":
-		result, err = s.performFullSync(ctx, driveClient, syncRecord, folder, progressCb)
+		result, err = s.performFullSync(ctx, driveClient, syncRecord, folder, dateRange, progressCb)
 	default:
 		return s.failSync(ctx, syncRecord, ErrInvalidSyncType)
 	}
@@ -252,19 +295,38 @@ func (s *DriveSyncService) SyncFolderWithProgress(ctx context.Context, connectio
 		return s.failSync(ctx, syncRecord, err)
 	}
 
-	// Update connection's last sync time
-	_, err = s.entClient.GoogleDriveConnection.UpdateOneID(connectionID).
-		SetLastSyncAt(time.Now()).
-		Save(ctx)
-	if err != nil {
-		// Log but don't fail - sync was successful
-	}
-
+	// Update connection's last sync time. A date-scoped sync skipped files
+	// outside its range, so the connection isn't synced up to now.
+	if dateRange.IsZero() {
+		_, err = s.entClient.GoogleDriveConnection.UpdateOneID(connectionID).
+			SetLastSyncAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			// Log but don't fail - sync was successful
+			slog.WarnContext(ctx, "updating connection last sync time",
+				"connection_id", connectionID,
+				"error", err,
+			)
+		}
+	}
+
+	slog.InfoContext(ctx, "drive sync completed",
+		"sync_id", result.SyncID,
+		"connection_id", connectionID,
+		"files_scanned", result.FilesScanned,
+		"files_downloaded", result.FilesDownloaded,
+		"files_failed", result.FilesFailed,
+		"bytes_transferred", result.BytesTransferred,
+		"duration", time.Since(now),
+	)
 	return result, nil
 }
 
-// performFullSync scans all files in the folder(s)
-func (s *DriveSyncService) performFullSync(ctx context.Context, driveClient *google.DriveClient, syncRecord *ent.GoogleDriveSync, folder *ent.GoogleDriveFolder, progressCb SyncProgressCallback) (*SyncResult, error) {
+// performFullSync scans all files in the folder(s) modified within dateRange
+func (s *DriveSyncService) performFullSync(ctx context.Context, driveClient *google.DriveClient, syncRecord *ent.GoogleDriveSync, folder *ent.GoogleDriveFolder, dateRange SyncRange, progressCb SyncProgressCallback) (_ *SyncResult, err error) {
+	ctx, span := tracing.Start(ctx, "DriveSyncService.performFullSync", attribute.String("sync.id", syncRecord.ID))
+	defer tracing.End(span, &err)
+
 	result := &SyncResult{
 		SyncID:       syncRecord.ID,
 		ConnectionID: syncRecord.ConnectionID,
@@ -275,12 +337,12 @@ func (s *DriveSyncService) performFullSync(ctx context.Context, driveClient *goo
 		Receipts:     make([]ExtractedReceipt, 0),
 	}
 
-	var folderIDs []string
+	var folders []*ent.GoogleDriveFolder
 	if folder != nil {
-		folderIDs = []string{folder.DriveFolderID}
+		folders = []*ent.GoogleDriveFolder{folder}
 	} else {
 		// Get all enabled folders for this connection
-		folders, err := s.entClient.GoogleDriveFolder.Query().
+		folders, err = s.entClient.GoogleDriveFolder.Query().
 			Where(
 				googledrivefolder.ConnectionID(syncRecord.ConnectionID),
 				googledrivefolder.SyncEnabled(true),
@@ -292,30 +354,35 @@ func (s *DriveSyncService) performFullSync(ctx context.Context, driveClient *goo
 		if len(folders) == 0 {
 			return nil, ErrNoFoldersToSync
 		}
-		for _, f := range folders {
-			folderIDs = append(folderIDs, f.DriveFolderID)
-		}
 	}
 
 	// Scan all folders
-	for _, fid := range folderIDs {
+	for _, f := range folders {
 		select {
 		case <-ctx.Done():
 			return nil, ctx.Err()
 		default:
 		}
 
-		err := s.scanFolderRecursive(ctx, driveClient, fid, "", result, progressCb)
+		err := s.scanFolder(ctx, driveClient, f.DriveFolderID, "", dateRange.driveQuery(), f.Recursive, result, progressCb)
 		if err != nil {
+			if quotaExhausted(err) {
+				return nil, err
+			}
 			result.FilesFailed++
 			continue
 		}
 	}
 
-	// Get a new start page token for future incremental syncs
-	startToken, err := driveClient.GetStartPageToken(ctx)
-	if err == nil {
-		result.ChangeToken = &startToken
+	// Get a new start page token for future incremental syncs. A
+	// date-scoped sync leaves none, so incremental syncs keep following
+	// changes from the last unscoped sync rather than skip files outside
+	// the range.
+	if dateRange.IsZero() {
+		startToken, err := driveClient.GetStartPageToken(ctx)
+		if err == nil {
+			result.ChangeToken = &startToken
+		}
 	}
 
 	// Complete the sync
@@ -343,7 +410,10 @@ func (s *DriveSyncService) performFullSync(ctx context.Context, driveClient *goo
 }
 
 // performIncrementalSync uses change tokens to sync only changed files
-func (s *DriveSyncService) performIncrementalSync(ctx context.Context, driveClient *google.DriveClient, syncRecord *ent.GoogleDriveSync, folder *ent.GoogleDriveFolder, progressCb SyncProgressCallback) (*SyncResult, error) {
+func (s *DriveSyncService) performIncrementalSync(ctx context.Context, driveClient *google.DriveClient, syncRecord *ent.GoogleDriveSync, folder *ent.GoogleDriveFolder, progressCb SyncProgressCallback) (_ *SyncResult, err error) {
+	ctx, span := tracing.Start(ctx, "DriveSyncService.performIncrementalSync", attribute.String("sync.id", syncRecord.ID))
+	defer tracing.End(span, &err)
+
 	result := &SyncResult{
 		SyncID:       syncRecord.ID,
 		ConnectionID: syncRecord.ConnectionID,
@@ -499,10 +569,15 @@ func (s *DriveSyncService) performIncrementalSync(ctx context.Context, driveClie
 	return result, nil
 }
 
-// scanFolderRecursive scans a folder and its subfolders
-func (s *DriveSyncService) scanFolderRecursive(ctx context.Context, driveClient *google.DriveClient, folderID, folderPath string, result *SyncResult, progressCb SyncProgressCallback) error {
+// scanFolder scans a folder, and its subfolders if recursive is set. A
+// non-empty query further filters the files listed in each folder.
+func (s *DriveSyncService) scanFolder(ctx context.Context, driveClient *google.DriveClient, folderID, folderPath, query string, recursive bool, result *SyncResult, progressCb SyncProgressCallback) (err error) {
+	ctx, span := tracing.Start(ctx, "DriveSyncService.scanFolder", attribute.String("drive.folder_id", folderID))
+	defer tracing.End(span, &err)
+
 	files, err := driveClient.ListFolderAll(ctx, folderID, google.ListFilesOptions{
 		PageSize: 100,
+		Query:    query,
 	})
 	if err != nil {
 		return fmt.Errorf("listing folder %s: %w", folderID, err)
@@ -515,13 +590,20 @@ func (s *DriveSyncService) scanFolderRecursive(ctx context.Context, driveClient
 		default:
 		}
 
+		if file.IsFolder() && !recursive {
+			continue
+		}
+
 		result.FilesScanned++
 		filePath := filepath.Join(folderPath, file.Name)
 
 		if file.IsFolder() {
 			// Recursively scan subfolders
-			err := s.scanFolderRecursive(ctx, driveClient, file.ID, filePath, result, progressCb)
+			err := s.scanFolder(ctx, driveClient, file.ID, filePath, query, true, result, progressCb)
 			if err != nil {
+				if quotaExhausted(err) {
+					return err
+				}
 				result.FilesFailed++
 				continue
 			}
@@ -596,6 +678,16 @@ func (s *DriveSyncService) failSync(ctx context.Context, syncRecord *ent.GoogleD
 	errMsg := err.Error()
 	now := time.Now()
 
+	level := slog.LevelError
+	if errors.Is(err, context.Canceled) {
+		level = slog.LevelInfo
+	}
+	slog.Log(ctx, level, "drive sync failed",
+		"sync_id", syncRecord.ID,
+		"connection_id", syncRecord.ConnectionID,
+		"error", errMsg,
+	)
+
 	_, updateErr := s.entClient.GoogleDriveSync.UpdateOneID(syncRecord.ID).
 		SetStatus(googledrivesync.StatusFailed).
 		SetCompletedAt(now).
@@ -640,6 +732,30 @@ func (s *DriveSyncService) GetSyncStatus(ctx context.Context, syncID string) (*S
 		return nil, fmt.Errorf("getting sync: %w", err)
 	}
 
+	return NewSyncResult(sync), nil
+}
+
+// GetSyncHistory retrieves sync history for a connection
+func (s *DriveSyncService) GetSyncHistory(ctx context.Context, connectionID string, limit int) ([]*SyncResult, error) {
+	syncs, err := s.entClient.GoogleDriveSync.Query().
+		Where(googledrivesync.ConnectionID(connectionID)).
+		Order(ent.Desc(googledrivesync.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying sync history: %w", err)
+	}
+
+	results := make([]*SyncResult, len(syncs))
+	for i, sync := range syncs {
+		results[i] = NewSyncResult(sync)
+	}
+
+	return results, nil
+}
+
+// NewSyncResult returns the result of a stored sync
+func NewSyncResult(sync *ent.GoogleDriveSync) *SyncResult {
 	result := &SyncResult{
 		SyncID:           sync.ID,
 		ConnectionID:     sync.ConnectionID,
@@ -663,46 +779,7 @@ func (s *DriveSyncService) GetSyncStatus(ctx context.Context, syncID string) (*S
 		result.CompletedAt = sync.CompletedAt
 	}
 
-	return result, nil
-}
-
-// GetSyncHistory retrieves sync history for a connection
-func (s *DriveSyncService) GetSyncHistory(ctx context.Context, connectionID string, limit int) ([]*SyncResult, error) {
-	syncs, err := s.entClient.GoogleDriveSync.Query().
-		Where(googledrivesync.ConnectionID(connectionID)).
-		Order(ent.Desc(googledrivesync.FieldCreatedAt)).
-		Limit(limit).
-		All(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("querying sync history: %w", err)
-	}
-
-	results := make([]*SyncResult, len(syncs))
-	for i, sync := range syncs {
-		results[i] = &SyncResult{
-			SyncID:           sync.ID,
-			ConnectionID:     sync.ConnectionID,
-			FolderID:         sync.FolderID,
-			SyncType:         string(sync.SyncType),
-			Status:           string(sync.Status),
-			FilesScanned:     sync.FilesScanned,
-			FilesDownloaded:  sync.FilesDownloaded,
-			FilesUploaded:    sync.FilesUploaded,
-			FilesDeleted:     sync.FilesDeleted,
-			FilesFailed:      sync.FilesFailed,
-			BytesTransferred: sync.BytesTransferred,
-			ErrorMessage:     sync.ErrorMessage,
-			ChangeToken:      sync.ChangeToken,
-		}
-		if sync.StartedAt != nil {
-			results[i].StartedAt = *sync.StartedAt
-		}
-		if sync.CompletedAt != nil {
-			results[i].CompletedAt = sync.CompletedAt
-		}
-	}
-
-	return results, nil
+	return result
 }
 
 // GetActiveSyncs returns currently running syncs
@@ -733,17 +810,10 @@ func (s *DriveSyncService) ExtractReceiptsFromFolder(ctx context.Context, connec
 	}
 
 	// Create drive client
-	oauthClient, err := google.NewClient(s.oauthCfg)
+	tokenSource, err := s.tokenManager.DriveTokenSource(connection)
 	if err != nil {
-		return nil, fmt.Errorf("creating oauth client: %w", err)
+		return nil, err
 	}
-
-	token := &google.Token{
-		AccessToken:  connection.AccessToken,
-		RefreshToken: connection.RefreshToken,
-		Expiry:       connection.TokenExpiry,
-	}
-	tokenSource := google.NewTokenSource(oauthClient, token)
 	driveClient := google.NewDriveClient(tokenSource)
 
 	// List files in folder
@@ -788,17 +858,10 @@ func (s *DriveSyncService) DownloadReceipt(ctx context.Context, connectionID, fi
 	}
 
 	// Create drive client
-	oauthClient, err := google.NewClient(s.oauthCfg)
+	tokenSource, err := s.tokenManager.DriveTokenSource(connection)
 	if err != nil {
-		return nil, nil, fmt.Errorf("creating oauth client: %w", err)
-	}
-
-	token := &google.Token{
-		AccessToken:  connection.AccessToken,
-		RefreshToken: connection.RefreshToken,
-		Expiry:       connection.TokenExpiry,
+		return nil, nil, err
 	}
-	tokenSource := google.NewTokenSource(oauthClient, token)
 	driveClient := google.NewDriveClient(tokenSource)
 
 	// Get file metadata
@@ -0,0 +1,75 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/emailmessage"
+	"clockzen-next/internal/infrastructure/google"
+
+	"github.com/google/uuid"
+)
+
+// amountPattern matches amounts of money written with a currency symbol,
+// e.g. "$1,234.56" or "€ 12.50"
+var amountPattern = regexp.MustCompile(`[$€£]\s?(\d{1,3}(?:,\d{3})+(?:\.\d{2})?|\d+(?:\.\d{2})?)`)
+
+// MentionedAmount returns the largest amount of money mentioned in text,
+// which for a receipt email is usually the total, or nil if none is
+func MentionedAmount(text string) *float64 {
+	var largest *float64
+	for _, match := range amountPattern.FindAllStringSubmatch(text, -1) {
+		amount, err := strconv.ParseFloat(strings.ReplaceAll(match[1], ",", ""), 64)
+		if err != nil {
+			continue
+		}
+		if largest == nil || amount > *largest {
+			largest = &amount
+		}
+	}
+	return largest
+}
+
+// indexMessage records a message's headers and snippet for search. A
+// message indexed again, e.g. by a backfill, is updated in place.
+func (s *EmailSyncService) indexMessage(ctx context.Context, connectionID string, message *google.GmailMessage, attachmentCount int) error {
+	subject := message.Payload.GetHeader("Subject")
+	create := s.entClient.EmailMessage.Create().
+		SetID(uuid.New().String()).
+		SetConnectionID(connectionID).
+		SetMessageID(message.ID).
+		SetThreadID(message.ThreadID).
+		SetSubject(subject).
+		SetSender(message.Payload.GetHeader("From")).
+		SetSnippet(message.Snippet).
+		SetLabelIds(message.LabelIDs).
+		SetHasAttachments(attachmentCount > 0).
+		SetAttachmentCount(attachmentCount).
+		SetNillableAmount(MentionedAmount(subject + " " + message.Snippet))
+	if receivedAt, err := message.InternalDateTime(); err == nil && !receivedAt.IsZero() {
+		create.SetReceivedAt(receivedAt)
+	}
+
+	err := create.
+		OnConflictColumns(emailmessage.FieldConnectionID, emailmessage.FieldMessageID).
+		Update(func(u *ent.EmailMessageUpsert) {
+			u.UpdateSubject()
+			u.UpdateSender()
+			u.UpdateSnippet()
+			u.UpdateLabelIds()
+			u.UpdateHasAttachments()
+			u.UpdateAttachmentCount()
+			u.UpdateAmount()
+			u.SetUpdatedAt(time.Now())
+		}).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("indexing message: %w", err)
+	}
+	return nil
+}
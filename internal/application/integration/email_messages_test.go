@@ -0,0 +1,40 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMentionedAmount(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want *float64
+	}{
+		{name: "none", text: "Your order has shipped"},
+		{name: "plain number", text: "Order 12345 confirmed"},
+		{name: "dollars", text: "Your receipt for $42.50", want: ptr(42.50)},
+		{name: "whole amount", text: "Total: $18", want: ptr(18)},
+		{name: "thousands separator", text: "Invoice total $1,234.56", want: ptr(1234.56)},
+		{name: "other currency", text: "Betrag € 12.50", want: ptr(12.50)},
+		{name: "largest of several", text: "Subtotal $40.00, tax $3.20, total $43.20", want: ptr(43.20)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MentionedAmount(tt.text)
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, *tt.want, *got)
+		})
+	}
+}
+
+func ptr(v float64) *float64 {
+	return &v
+}
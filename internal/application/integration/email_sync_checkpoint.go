@@ -0,0 +1,222 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/emailsync"
+	"clockzen-next/internal/infrastructure/google"
+	"clockzen-next/internal/infrastructure/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ErrEmailSyncNotResumable is returned when resuming a sync that is not an
+// interrupted full or manual sync with a checkpoint
+var ErrEmailSyncNotResumable = errors.New("email sync cannot be resumed")
+
+// emailSyncCheckpoint is the resumable progress of a full or manual sync,
+// stored in the sync record's checkpoints field
+type emailSyncCheckpoint struct {
+	// Query is the Gmail search query the sync was started with, so a
+	// resumed sync keeps its date range
+	Query string `json:"query,omitempty"`
+	// Labels holds the progress of each provider label, by label ID
+	Labels map[string]*labelCheckpoint `json:"labels,omitempty"`
+}
+
+// labelCheckpoint is the progress of a scan of one label
+type labelCheckpoint struct {
+	// PageToken identifies the first page not yet fully processed. Empty
+	// means the first page.
+	PageToken string `json:"page_token,omitempty"`
+	// Done is set once every page of the label has been processed
+	Done bool `json:"done,omitempty"`
+}
+
+// newEmailSyncCheckpoint returns an empty checkpoint for a sync scanning
+// messages matching query
+func newEmailSyncCheckpoint(query string) *emailSyncCheckpoint {
+	return &emailSyncCheckpoint{
+		Query:  query,
+		Labels: make(map[string]*labelCheckpoint),
+	}
+}
+
+// loadEmailSyncCheckpoint decodes a checkpoint stored on a sync record
+func loadEmailSyncCheckpoint(stored map[string]interface{}) (*emailSyncCheckpoint, error) {
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return nil, fmt.Errorf("encoding checkpoint: %w", err)
+	}
+	checkpoint := newEmailSyncCheckpoint("")
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, fmt.Errorf("decoding checkpoint: %w", err)
+	}
+	if checkpoint.Labels == nil {
+		checkpoint.Labels = make(map[string]*labelCheckpoint)
+	}
+	return checkpoint, nil
+}
+
+// label returns the progress of a label, adding it if it is not yet known
+func (c *emailSyncCheckpoint) label(labelID string) *labelCheckpoint {
+	progress, ok := c.Labels[labelID]
+	if !ok {
+		progress = &labelCheckpoint{}
+		c.Labels[labelID] = progress
+	}
+	return progress
+}
+
+// stored encodes the checkpoint for the sync record's checkpoints field
+func (c *emailSyncCheckpoint) stored() (map[string]interface{}, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var stored map[string]interface{}
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, err
+	}
+	return stored, nil
+}
+
+// saveCheckpoint stores the checkpoint on the sync record together with the
+// counters so far. A failed save only means a resumed sync repeats more
+// work, so it is logged rather than failing the sync.
+func (s *EmailSyncService) saveCheckpoint(ctx context.Context, result *EmailSyncResult, checkpoint *emailSyncCheckpoint) {
+	stored, err := checkpoint.stored()
+	if err == nil {
+		_, err = s.entClient.EmailSync.UpdateOneID(result.SyncID).
+			SetCheckpoints(stored).
+			SetMessagesScanned(result.MessagesScanned).
+			SetMessagesDownloaded(result.MessagesDownloaded).
+			SetMessagesIndexed(result.MessagesIndexed).
+			SetMessagesFailed(result.MessagesFailed).
+			SetAttachmentsDownloaded(result.AttachmentsDownloaded).
+			SetBytesTransferred(result.BytesTransferred).
+			Save(ctx)
+	}
+	if err != nil {
+		slog.WarnContext(ctx, "saving email sync checkpoint",
+			"sync_id", result.SyncID,
+			"error", err,
+		)
+	}
+}
+
+// ResumeSync continues an interrupted full or manual sync from its last
+// checkpoint. The sync keeps its record, date range and counters; labels
+// already scanned are skipped and the others restart from the page they
+// were on. Syncs that failed, were cancelled, or were left running by a
+// stopped worker can be resumed.
+func (s *EmailSyncService) ResumeSync(ctx context.Context, syncID string, progressCb EmailSyncProgressCallback) (_ *EmailSyncResult, err error) {
+	ctx, span := tracing.Start(ctx, "EmailSyncService.ResumeSync", attribute.String("sync.id", syncID))
+	defer tracing.End(span, &err)
+
+	syncRecord, err := s.entClient.EmailSync.Get(ctx, syncID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrEmailSyncNotFound
+		}
+		return nil, fmt.Errorf("getting sync: %w", err)
+	}
+
+	switch {
+	case syncRecord.SyncType != emailsync.SyncTypeFull && syncRecord.SyncType != emailsync.SyncTypeManual:
+		return nil, fmt.Errorf("%w: %s syncs are not checkpointed", ErrEmailSyncNotResumable, syncRecord.SyncType)
+	case syncRecord.Status == emailsync.StatusCompleted || syncRecord.Status == emailsync.StatusPending:
+		return nil, fmt.Errorf("%w: status is %s", ErrEmailSyncNotResumable, syncRecord.Status)
+	case syncRecord.Checkpoints == nil:
+		return nil, fmt.Errorf("%w: no checkpoint was saved", ErrEmailSyncNotResumable)
+	}
+
+	checkpoint, err := loadEmailSyncCheckpoint(syncRecord.Checkpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if sync is already running
+	s.mu.RLock()
+	if _, exists := s.activeSyncs[syncRecord.ConnectionID]; exists {
+		s.mu.RUnlock()
+		return nil, ErrEmailSyncAlreadyRunning
+	}
+	s.mu.RUnlock()
+
+	connection, err := s.entClient.EmailConnection.Get(ctx, syncRecord.ConnectionID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrEmailConnectionNotFound
+		}
+		return nil, fmt.Errorf("getting connection: %w", err)
+	}
+	if connection.Status != emailconnection.StatusActive {
+		return nil, fmt.Errorf("%w: status is %s", ErrEmailConnectionInactive, connection.Status)
+	}
+
+	var label *ent.EmailLabel
+	if syncRecord.LabelID != nil && *syncRecord.LabelID != "" {
+		label, err = s.entClient.EmailLabel.Get(ctx, *syncRecord.LabelID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, ErrEmailLabelNotFound
+			}
+			return nil, fmt.Errorf("getting label: %w", err)
+		}
+	}
+
+	syncRecord, err = s.entClient.EmailSync.UpdateOneID(syncID).
+		SetStatus(emailsync.StatusRunning).
+		ClearCompletedAt().
+		ClearErrorMessage().
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("updating sync record: %w", err)
+	}
+	span.SetAttributes(attribute.String("sync.connection_id", syncRecord.ConnectionID))
+	slog.InfoContext(ctx, "email sync resumed",
+		"sync_id", syncID,
+		"connection_id", syncRecord.ConnectionID,
+		"messages_scanned", syncRecord.MessagesScanned,
+	)
+
+	return s.runSync(ctx, connection, syncRecord, time.Now(), func(ctx context.Context, gmailClient *google.GmailClient) (*EmailSyncResult, error) {
+		return s.performFullEmailSync(ctx, gmailClient, syncRecord, label, checkpoint, progressCb)
+	})
+}
+
+// InterruptedSyncs returns checkpointed syncs still marked running that are
+// not running in this process, i.e. those left behind when a worker stopped
+// mid-sync. Call it at startup, before any sync is started, and resume them
+// with ResumeSync.
+func (s *EmailSyncService) InterruptedSyncs(ctx context.Context) ([]*ent.EmailSync, error) {
+	syncs, err := s.entClient.EmailSync.Query().
+		Where(
+			emailsync.StatusEQ(emailsync.StatusRunning),
+			emailsync.SyncTypeIn(emailsync.SyncTypeFull, emailsync.SyncTypeManual),
+			emailsync.CheckpointsNotNil(),
+		).
+		Order(ent.Asc(emailsync.FieldStartedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying interrupted syncs: %w", err)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	interrupted := make([]*ent.EmailSync, 0, len(syncs))
+	for _, record := range syncs {
+		if _, active := s.activeSyncs[record.ConnectionID]; !active {
+			interrupted = append(interrupted, record)
+		}
+	}
+	return interrupted, nil
+}
@@ -0,0 +1,259 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/emailsync"
+	"clockzen-next/internal/ent/emailsyncfailure"
+	"clockzen-next/internal/infrastructure/google"
+	"clockzen-next/internal/infrastructure/tracing"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ErrEmailSyncNoFailures is returned when retrying a sync that has no
+// failed messages left to retry
+var ErrEmailSyncNoFailures = errors.New("email sync has no failed messages")
+
+// recordMessageFailure stores a message that failed to process in a sync, so
+// it can be listed and retried later. A message that fails again in the
+// same sync has its error replaced and its attempts counted. A failed save
+// is logged rather than failing the sync, which has already counted the
+// message in MessagesFailed.
+func (s *EmailSyncService) recordMessageFailure(ctx context.Context, result *EmailSyncResult, messageID string, cause error) {
+	err := s.entClient.EmailSyncFailure.Create().
+		SetID(uuid.New().String()).
+		SetSyncID(result.SyncID).
+		SetConnectionID(result.ConnectionID).
+		SetMessageID(messageID).
+		SetErrorMessage(cause.Error()).
+		OnConflictColumns(emailsyncfailure.FieldSyncID, emailsyncfailure.FieldMessageID).
+		Update(func(u *ent.EmailSyncFailureUpsert) {
+			u.UpdateErrorMessage()
+			u.AddAttempts(1)
+			u.SetStatus(emailsyncfailure.StatusFailed)
+			u.ClearResolvedAt()
+			u.SetUpdatedAt(time.Now())
+		}).
+		Exec(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "recording email sync message failure",
+			"sync_id", result.SyncID,
+			"message_id", messageID,
+			"error", err,
+		)
+	}
+}
+
+// GetSyncFailures returns the messages that failed to process in a sync,
+// oldest first, including those a retry has since resolved
+func (s *EmailSyncService) GetSyncFailures(ctx context.Context, syncID string) ([]*ent.EmailSyncFailure, error) {
+	exists, err := s.entClient.EmailSync.Query().
+		Where(emailsync.ID(syncID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting sync: %w", err)
+	}
+	if !exists {
+		return nil, ErrEmailSyncNotFound
+	}
+
+	failures, err := s.entClient.EmailSyncFailure.Query().
+		Where(emailsyncfailure.SyncID(syncID)).
+		Order(ent.Asc(emailsyncfailure.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying sync failures: %w", err)
+	}
+	return failures, nil
+}
+
+// RetrySyncFailures processes again the messages that failed in a sync,
+// leaving the rest of the sync alone. Messages that succeed are marked
+// resolved and moved from the sync's failed count to its downloaded and
+// indexed counts; those that fail again keep their failure with the new
+// error. The result counts only the retried messages.
+func (s *EmailSyncService) RetrySyncFailures(ctx context.Context, syncID string, progressCb EmailSyncProgressCallback) (_ *EmailSyncResult, err error) {
+	ctx, span := tracing.Start(ctx, "EmailSyncService.RetrySyncFailures", attribute.String("sync.id", syncID))
+	defer tracing.End(span, &err)
+
+	syncRecord, err := s.entClient.EmailSync.Get(ctx, syncID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrEmailSyncNotFound
+		}
+		return nil, fmt.Errorf("getting sync: %w", err)
+	}
+	span.SetAttributes(attribute.String("sync.connection_id", syncRecord.ConnectionID))
+
+	failures, err := s.entClient.EmailSyncFailure.Query().
+		Where(
+			emailsyncfailure.SyncID(syncID),
+			emailsyncfailure.StatusEQ(emailsyncfailure.StatusFailed),
+		).
+		Order(ent.Asc(emailsyncfailure.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying sync failures: %w", err)
+	}
+	if len(failures) == 0 {
+		return nil, ErrEmailSyncNoFailures
+	}
+
+	connection, err := s.entClient.EmailConnection.Get(ctx, syncRecord.ConnectionID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrEmailConnectionNotFound
+		}
+		return nil, fmt.Errorf("getting connection: %w", err)
+	}
+	if connection.Status != emailconnection.StatusActive {
+		return nil, fmt.Errorf("%w: status is %s", ErrEmailConnectionInactive, connection.Status)
+	}
+
+	// Register active sync with cancellation. The retry doesn't go through
+	// runSync, since a failed retry must not mark the original sync failed.
+	ctx, cancel := context.WithCancel(ctx)
+	s.mu.Lock()
+	if _, exists := s.activeSyncs[connection.ID]; exists {
+		s.mu.Unlock()
+		cancel()
+		return nil, ErrEmailSyncAlreadyRunning
+	}
+	s.activeSyncs[connection.ID] = cancel
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.activeSyncs, connection.ID)
+		s.mu.Unlock()
+		cancel()
+	}()
+
+	gmailClient, err := s.gmailClient(connection)
+	if err != nil {
+		return nil, err
+	}
+
+	startedAt := time.Now()
+	result := &EmailSyncResult{
+		SyncID:       syncID,
+		ConnectionID: syncRecord.ConnectionID,
+		LabelID:      syncRecord.LabelID,
+		SyncType:     string(syncRecord.SyncType),
+		Status:       "running",
+		StartedAt:    startedAt,
+		Receipts:     make([]ExtractedEmailReceipt, 0),
+		Attachments:  make([]ExtractedEmailAttachment, 0),
+	}
+
+	var retryErr error
+	for _, failure := range failures {
+		if err := ctx.Err(); err != nil {
+			retryErr = err
+			break
+		}
+		if err := s.retryMessage(ctx, gmailClient, failure, result, progressCb); err != nil {
+			retryErr = err
+			break
+		}
+	}
+
+	// Move recovered messages out of the sync's failed count, even if the
+	// retry stopped early, so the counts match the stored failures
+	if result.MessagesDownloaded > 0 {
+		_, err = s.entClient.EmailSync.UpdateOneID(syncID).
+			AddMessagesDownloaded(result.MessagesDownloaded).
+			AddMessagesIndexed(result.MessagesIndexed).
+			AddMessagesFailed(-result.MessagesDownloaded).
+			AddAttachmentsDownloaded(result.AttachmentsDownloaded).
+			AddBytesTransferred(result.BytesTransferred).
+			Save(context.WithoutCancel(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("updating sync record: %w", err)
+		}
+	}
+	if retryErr != nil {
+		return nil, retryErr
+	}
+
+	result.Status = "completed"
+	now := time.Now()
+	result.CompletedAt = &now
+
+	slog.InfoContext(ctx, "email sync failures retried",
+		"sync_id", syncID,
+		"connection_id", syncRecord.ConnectionID,
+		"messages_retried", result.MessagesScanned,
+		"messages_recovered", result.MessagesDownloaded,
+		"messages_failed", result.MessagesFailed,
+		"duration", time.Since(startedAt),
+	)
+	return result, nil
+}
+
+// retryMessage fetches and processes a failed message again, marking the
+// failure resolved if it succeeds and recording the new error if not.
+// Errors that should stop the retry, like an exhausted quota, are returned.
+func (s *EmailSyncService) retryMessage(ctx context.Context, gmailClient *google.GmailClient, failure *ent.EmailSyncFailure, result *EmailSyncResult, progressCb EmailSyncProgressCallback) error {
+	retryCtx := ctx
+	if s.config.MessageProcessingTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.config.MessageProcessingTimeout)
+		defer cancel()
+	}
+
+	result.MessagesScanned++
+
+	fullMessage, err := gmailClient.GetMessageContent(ctx, failure.MessageID)
+	var processed *processedMessage
+	if err == nil {
+		processed, err = s.processMessage(ctx, gmailClient, failure.ConnectionID, fullMessage)
+	}
+	if err != nil {
+		if quotaExhausted(err) {
+			return err
+		}
+		if retryCtx.Err() != nil {
+			return retryCtx.Err()
+		}
+		result.MessagesFailed++
+		s.recordMessageFailure(retryCtx, result, failure.MessageID, err)
+		return nil
+	}
+
+	_, err = s.entClient.EmailSyncFailure.UpdateOne(failure).
+		SetStatus(emailsyncfailure.StatusResolved).
+		SetResolvedAt(time.Now()).
+		AddAttempts(1).
+		Save(retryCtx)
+	if err != nil {
+		return fmt.Errorf("resolving sync failure: %w", err)
+	}
+	processed.addTo(result)
+	result.MessagesDownloaded++
+
+	if progressCb != nil {
+		subject := ""
+		if fullMessage.Payload != nil {
+			subject = fullMessage.Payload.GetHeader("Subject")
+		}
+		progressCb(EmailSyncProgress{
+			SyncID:                result.SyncID,
+			Status:                "running",
+			MessagesScanned:       result.MessagesScanned,
+			MessagesProcessed:     result.MessagesDownloaded,
+			AttachmentsDownloaded: result.AttachmentsDownloaded,
+			BytesTransferred:      result.BytesTransferred,
+			CurrentMessage:        subject,
+		})
+	}
+	return nil
+}
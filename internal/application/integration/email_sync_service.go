@@ -5,18 +5,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"path/filepath"
 	"strings"
 	"sync"
 	"time"
 
 	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/attachmentlink"
 	"clockzen-next/internal/ent/emailconnection"
 	"clockzen-next/internal/ent/emaillabel"
 	"clockzen-next/internal/ent/emailsync"
 	"clockzen-next/internal/infrastructure/google"
+	"clockzen-next/internal/infrastructure/tracing"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // Email sync service errors
@@ -32,6 +36,17 @@ var (
 	ErrAttachmentDownloadFail     = errors.New("attachment download failed")
 )
 
+// errScanLimitReached stops a label scan that hit its message cap
+var errScanLimitReached = errors.New("scan limit reached")
+
+// quotaExhausted reports whether err means Google is rejecting requests for
+// exceeding a rate limit or quota. Syncs stop on such errors, rather than
+// count every remaining item as failed, and can be run again later.
+func quotaExhausted(err error) bool {
+	var quota *google.QuotaError
+	return errors.As(err, &quota)
+}
+
 // Receipt-related attachment extensions
 var receiptAttachmentExtensions = map[string]bool{
 	".pdf":  true,
@@ -73,6 +88,14 @@ type EmailSyncConfig struct {
 	ReceiptKeywords []string
 	// BatchSize for message processing
 	BatchSize int
+	// BackfillMaxMessages caps the messages scanned by the backfill that
+	// replaces an incremental sync once the Gmail history ID has expired.
+	// Zero means no cap.
+	BackfillMaxMessages int
+	// SkipImportedMessages skips downloading messages whose receipt
+	// attachments an earlier run already stored, found through the
+	// attachment links of the connection
+	SkipImportedMessages bool
 }
 
 // DefaultEmailSyncConfig returns sensible default configuration
@@ -104,7 +127,9 @@ func DefaultEmailSyncConfig() EmailSyncConfig {
 			"billing",
 			"subscription",
 		},
-		BatchSize: 100,
+		BatchSize:            100,
+		BackfillMaxMessages:  2000,
+		SkipImportedMessages: true,
 	}
 }
 
@@ -122,11 +147,19 @@ type EmailSyncResult struct {
 	MessagesIndexed       int
 	MessagesFailed        int
 	AttachmentsDownloaded int
-	BytesTransferred      int64
-	ErrorMessage          *string
-	HistoryID             *string
-	Receipts              []ExtractedEmailReceipt
-	Attachments           []ExtractedEmailAttachment
+	// AttachmentsDeduplicated counts downloaded attachments whose content
+	// was already stored
+	AttachmentsDeduplicated int
+	BytesTransferred        int64
+	ErrorMessage            *string
+	HistoryID               *string
+	FallbackReason          *string
+	// Scoped is set for syncs limited to a date range. They skip messages
+	// outside it, so they don't move the history baseline or the
+	// connection's last sync time.
+	Scoped      bool
+	Receipts    []ExtractedEmailReceipt
+	Attachments []ExtractedEmailAttachment
 }
 
 // ExtractedEmailReceipt represents a receipt extracted from an email
@@ -152,6 +185,13 @@ type ExtractedEmailAttachment struct {
 	MimeType     string
 	Size         int
 	IsReceipt    bool
+	// ContentHash is the hex SHA-256 of the content, set once downloaded
+	ContentHash string
+	// BlobID is the stored blob holding the content
+	BlobID string
+	// Duplicate is set when the connection already stored the content from
+	// an earlier message, so it has already been queued for OCR
+	Duplicate bool
 }
 
 // EmailSyncProgress reports real-time email sync progress
@@ -172,20 +212,26 @@ type EmailSyncProgressCallback func(progress EmailSyncProgress)
 
 // EmailSyncService provides email sync functionality
 type EmailSyncService struct {
-	config      EmailSyncConfig
-	entClient   *ent.Client
-	oauthCfg    *google.Config
-	mu          sync.RWMutex
-	activeSyncs map[string]context.CancelFunc
+	config       EmailSyncConfig
+	entClient    *ent.Client
+	oauthCfg     *google.Config
+	tokens       *TokenStore
+	tokenManager *TokenManager
+	blobs        BlobStore
+	mu           sync.RWMutex
+	activeSyncs  map[string]context.CancelFunc
 }
 
 // NewEmailSyncService creates a new email sync service
 func NewEmailSyncService(entClient *ent.Client, oauthCfg *google.Config, config EmailSyncConfig) *EmailSyncService {
+	tokens := NewTokenStore(entClient, nil)
 	return &EmailSyncService{
-		config:      config,
-		entClient:   entClient,
-		oauthCfg:    oauthCfg,
-		activeSyncs: make(map[string]context.CancelFunc),
+		config:       config,
+		entClient:    entClient,
+		oauthCfg:     oauthCfg,
+		tokens:       tokens,
+		tokenManager: NewTokenManagerWithDefaults(entClient, oauthCfg, tokens),
+		activeSyncs:  make(map[string]context.CancelFunc),
 	}
 }
 
@@ -194,6 +240,25 @@ func NewEmailSyncServiceWithDefaults(entClient *ent.Client, oauthCfg *google.Con
 	return NewEmailSyncService(entClient, oauthCfg, DefaultEmailSyncConfig())
 }
 
+// SetTokenStore sets the store used to decrypt connection tokens
+func (s *EmailSyncService) SetTokenStore(tokens *TokenStore) {
+	s.tokens = tokens
+	s.tokenManager.SetTokenStore(tokens)
+}
+
+// SetTokenManager sets the manager refreshing connection tokens, so it can
+// be shared with other services. It replaces the token store with the
+// manager's.
+func (s *EmailSyncService) SetTokenManager(manager *TokenManager) {
+	s.tokenManager = manager
+	s.tokens = manager.TokenStore()
+}
+
+// TokenManager returns the manager refreshing connection tokens
+func (s *EmailSyncService) TokenManager() *TokenManager {
+	return s.tokenManager
+}
+
 // SyncLabel performs a sync operation for a specific label
 func (s *EmailSyncService) SyncLabel(ctx context.Context, connectionID, labelID string, syncType string) (*EmailSyncResult, error) {
 	return s.SyncLabelWithProgress(ctx, connectionID, labelID, syncType, nil)
@@ -201,10 +266,26 @@ func (s *EmailSyncService) SyncLabel(ctx context.Context, connectionID, labelID
 
 // SyncLabelWithProgress performs a sync with progress callback
 func (s *EmailSyncService) SyncLabelWithProgress(ctx context.Context, connectionID, labelID string, syncType string, progressCb EmailSyncProgressCallback) (*EmailSyncResult, error) {
+	return s.SyncLabelInRange(ctx, connectionID, labelID, syncType, SyncRange{}, progressCb)
+}
+
+// SyncLabelInRange performs a sync with progress callback, limited to
+// messages received within dateRange. A zero range syncs every message.
+func (s *EmailSyncService) SyncLabelInRange(ctx context.Context, connectionID, labelID string, syncType string, dateRange SyncRange, progressCb EmailSyncProgressCallback) (_ *EmailSyncResult, err error) {
+	ctx, span := tracing.Start(ctx, "EmailSyncService.SyncLabel",
+		attribute.String("sync.connection_id", connectionID),
+		attribute.String("sync.label_id", labelID),
+		attribute.String("sync.type", syncType),
+	)
+	defer tracing.End(span, &err)
+
 	// Validate sync type
 	if !isValidEmailSyncType(syncType) {
 		return nil, fmt.Errorf("%w: %s", ErrInvalidEmailSyncType, syncType)
 	}
+	if err := dateRange.validate(syncType); err != nil {
+		return nil, err
+	}
 
 	// Check if sync is already running
 	s.mu.RLock()
@@ -254,6 +335,29 @@ func (s *EmailSyncService) SyncLabelWithProgress(ctx context.Context, connection
 	if err != nil {
 		return nil, fmt.Errorf("creating sync record: %w", err)
 	}
+	span.SetAttributes(attribute.String("sync.id", syncID))
+	slog.InfoContext(ctx, "email sync started",
+		"sync_id", syncID,
+		"connection_id", connectionID,
+		"label_id", labelID,
+		"sync_type", syncType,
+	)
+
+	return s.runSync(ctx, connection, syncRecord, now, func(ctx context.Context, gmailClient *google.GmailClient) (*EmailSyncResult, error) {
+		switch syncType {
+		case "full", "manual":
+			return s.performFullEmailSync(ctx, gmailClient, syncRecord, label, newEmailSyncCheckpoint(dateRange.gmailQuery()), progressCb)
+		case "incremental":
+			return s.performIncrementalEmailSync(ctx, gmailClient, syncRecord, label, progressCb)
+		}
+		return nil, ErrInvalidEmailSyncType
+	})
+}
+
+// runSync registers the sync as active for its connection, builds a Gmail
+// client and runs perform, marking the sync record failed if it errors
+func (s *EmailSyncService) runSync(ctx context.Context, connection *ent.EmailConnection, syncRecord *ent.EmailSync, now time.Time, perform func(ctx context.Context, gmailClient *google.GmailClient) (*EmailSyncResult, error)) (*EmailSyncResult, error) {
+	connectionID := connection.ID
 
 	// Register active sync with cancellation
 	ctx, cancel := context.WithCancel(ctx)
@@ -268,84 +372,93 @@ func (s *EmailSyncService) SyncLabelWithProgress(ctx context.Context, connection
 		cancel()
 	}()
 
-	// Create OAuth token and Gmail client
-	oauthClient, err := google.NewClient(s.oauthCfg)
+	gmailClient, err := s.gmailClient(connection)
 	if err != nil {
-		return s.failSync(ctx, syncRecord, fmt.Errorf("creating oauth client: %w", err))
-	}
-
-	token := &google.Token{
-		AccessToken:  connection.AccessToken,
-		RefreshToken: connection.RefreshToken,
-		Expiry:       connection.TokenExpiry,
-	}
-	tokenSource := google.NewTokenSource(oauthClient, token)
-	gmailClient := google.NewGmailClient(tokenSource)
-
-	// Perform the sync based on type
-	var result *EmailSyncResult
-	switch syncType {
-	case "full":
-		result, err = s.performFullEmailSync(ctx, gmailClient, syncRecord, label, progressCb)
-	case "incremental":
-		result, err = s.performIncrementalEmailSync(ctx, gmailClient, syncRecord, label, progressCb)
-	case "manual":
-		result, err = s.performFullEmailSync(ctx, gmailClient, syncRecord, label, progressCb)
-	default:
-		return s.failSync(ctx, syncRecord, ErrInvalidEmailSyncType)
+		return s.failSync(ctx, syncRecord, err)
 	}
 
+	result, err := perform(ctx, gmailClient)
 	if err != nil {
 		return s.failSync(ctx, syncRecord, err)
 	}
 
 	// Update connection's last sync time
-	_, err = s.entClient.EmailConnection.UpdateOneID(connectionID).
-		SetLastSyncAt(time.Now()).
-		Save(ctx)
-	if err != nil {
-		// Log but don't fail - sync was successful
+	if !result.Scoped {
+		_, err = s.entClient.EmailConnection.UpdateOneID(connectionID).
+			SetLastSyncAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			// Log but don't fail - sync was successful
+			slog.WarnContext(ctx, "updating connection last sync time",
+				"connection_id", connectionID,
+				"error", err,
+			)
+		}
 	}
 
+	slog.InfoContext(ctx, "email sync completed",
+		"sync_id", result.SyncID,
+		"connection_id", connectionID,
+		"messages_scanned", result.MessagesScanned,
+		"messages_downloaded", result.MessagesDownloaded,
+		"messages_failed", result.MessagesFailed,
+		"bytes_transferred", result.BytesTransferred,
+		"duration", time.Since(now),
+	)
 	return result, nil
 }
 
-// performFullEmailSync scans all messages in the label(s)
-func (s *EmailSyncService) performFullEmailSync(ctx context.Context, gmailClient *google.GmailClient, syncRecord *ent.EmailSync, label *ent.EmailLabel, progressCb EmailSyncProgressCallback) (*EmailSyncResult, error) {
-	result := &EmailSyncResult{
-		SyncID:       syncRecord.ID,
-		ConnectionID: syncRecord.ConnectionID,
-		LabelID:      syncRecord.LabelID,
-		SyncType:     string(syncRecord.SyncType),
-		Status:       "running",
-		StartedAt:    *syncRecord.StartedAt,
-		Receipts:     make([]ExtractedEmailReceipt, 0),
-		Attachments:  make([]ExtractedEmailAttachment, 0),
+// gmailClient creates a Gmail client authorized with the connection's token.
+// Sandbox connections read their synthetic mailbox instead of Gmail.
+func (s *EmailSyncService) gmailClient(connection *ent.EmailConnection) (*google.GmailClient, error) {
+	if connection.Provider == emailconnection.ProviderSandbox {
+		return google.NewSandboxGmailClient(connection.Email), nil
 	}
 
-	var labelIDs []string
-	if label != nil {
-		labelIDs = []string{label.ProviderLabelID}
-	} else {
-		// Get all enabled labels for this connection
-		labels, err := s.entClient.EmailLabel.Query().
-			Where(
-				emaillabel.ConnectionID(syncRecord.ConnectionID),
-				emaillabel.SyncEnabled(true),
-			).
-			All(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("querying labels: %w", err)
-		}
-		if len(labels) == 0 {
-			return nil, ErrNoEmailLabelsToSync
-		}
-		for _, l := range labels {
-			labelIDs = append(labelIDs, l.ProviderLabelID)
-		}
+	tokenSource, err := s.tokenManager.EmailTokenSource(connection)
+	if err != nil {
+		return nil, err
 	}
+	return google.NewGmailClient(tokenSource), nil
+}
+
+// performFullEmailSync scans all messages in the label(s) matching the
+// checkpoint's query, skipping labels and pages the checkpoint records as
+// already processed. Counters continue from those stored on the sync record
+// so a resumed sync reports totals for the whole run.
+func (s *EmailSyncService) performFullEmailSync(ctx context.Context, gmailClient *google.GmailClient, syncRecord *ent.EmailSync, label *ent.EmailLabel, checkpoint *emailSyncCheckpoint, progressCb EmailSyncProgressCallback) (_ *EmailSyncResult, err error) {
+	ctx, span := tracing.Start(ctx, "EmailSyncService.performFullEmailSync", attribute.String("sync.id", syncRecord.ID))
+	defer tracing.End(span, &err)
+
+	result := &EmailSyncResult{
+		SyncID:                syncRecord.ID,
+		ConnectionID:          syncRecord.ConnectionID,
+		LabelID:               syncRecord.LabelID,
+		SyncType:              string(syncRecord.SyncType),
+		Status:                "running",
+		StartedAt:             *syncRecord.StartedAt,
+		MessagesScanned:       syncRecord.MessagesScanned,
+		MessagesDownloaded:    syncRecord.MessagesDownloaded,
+		MessagesIndexed:       syncRecord.MessagesIndexed,
+		MessagesFailed:        syncRecord.MessagesFailed,
+		AttachmentsDownloaded: syncRecord.AttachmentsDownloaded,
+		BytesTransferred:      syncRecord.BytesTransferred,
+		Receipts:              make([]ExtractedEmailReceipt, 0),
+		Attachments:           make([]ExtractedEmailAttachment, 0),
+	}
+
+	labelIDs, err := s.syncLabelIDs(ctx, syncRecord.ConnectionID, label)
+	if err != nil {
+		return nil, err
+	}
+	s.saveCheckpoint(ctx, result, checkpoint)
 
 	// Scan all labels
+	scan := labelScan{
+		Query:      checkpoint.Query,
+		Seen:       make(map[string]bool),
+		Checkpoint: checkpoint,
+	}
 	for _, lid := range labelIDs {
 		select {
 		case <-ctx.Done():
@@ -353,17 +466,39 @@ func (s *EmailSyncService) performFullEmailSync(ctx context.Context, gmailClient
 		default:
 		}
 
-		err := s.scanLabelMessages(ctx, gmailClient, lid, result, progressCb)
+		progress := checkpoint.label(lid)
+		if progress.Done {
+			continue
+		}
+
+		err := s.scanLabelMessages(ctx, gmailClient, lid, scan, result, progressCb)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if quotaExhausted(err) {
+				// Keep the checkpoint so the sync can resume once the
+				// quota resets
+				return nil, err
+			}
 			result.MessagesFailed++
 			continue
 		}
+
+		progress.Done = true
+		progress.PageToken = ""
+		s.saveCheckpoint(ctx, result, checkpoint)
 	}
 
-	// Get a new history ID for future incremental syncs
-	profile, err := gmailClient.GetProfile(ctx)
-	if err == nil && profile.HistoryID != "" {
-		result.HistoryID = &profile.HistoryID
+	// Get a new history ID for future incremental syncs. A date-scoped sync
+	// records none, so incremental syncs and backfills keep starting from
+	// the last unscoped sync rather than skip mail outside the range.
+	result.Scoped = checkpoint.Query != ""
+	if !result.Scoped {
+		profile, err := gmailClient.GetProfile(ctx)
+		if err == nil && profile.HistoryID != "" {
+			result.HistoryID = &profile.HistoryID
+		}
 	}
 
 	// Complete the sync
@@ -382,6 +517,7 @@ func (s *EmailSyncService) performFullEmailSync(ctx context.Context, gmailClient
 		SetAttachmentsDownloaded(result.AttachmentsDownloaded).
 		SetBytesTransferred(result.BytesTransferred).
 		SetNillableHistoryID(result.HistoryID).
+		ClearCheckpoints().
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("updating sync record: %w", err)
@@ -391,7 +527,10 @@ func (s *EmailSyncService) performFullEmailSync(ctx context.Context, gmailClient
 }
 
 // performIncrementalEmailSync uses history ID to sync only changed messages
-func (s *EmailSyncService) performIncrementalEmailSync(ctx context.Context, gmailClient *google.GmailClient, syncRecord *ent.EmailSync, label *ent.EmailLabel, progressCb EmailSyncProgressCallback) (*EmailSyncResult, error) {
+func (s *EmailSyncService) performIncrementalEmailSync(ctx context.Context, gmailClient *google.GmailClient, syncRecord *ent.EmailSync, label *ent.EmailLabel, progressCb EmailSyncProgressCallback) (_ *EmailSyncResult, err error) {
+	ctx, span := tracing.Start(ctx, "EmailSyncService.performIncrementalEmailSync", attribute.String("sync.id", syncRecord.ID))
+	defer tracing.End(span, &err)
+
 	result := &EmailSyncResult{
 		SyncID:       syncRecord.ID,
 		ConnectionID: syncRecord.ConnectionID,
@@ -450,9 +589,8 @@ func (s *EmailSyncService) performIncrementalEmailSync(ctx context.Context, gmai
 		HistoryTypes: []string{"messageAdded", "labelAdded"},
 	})
 	if err != nil {
-		// If history ID is invalid (too old), fall back to full sync
 		if errors.Is(err, google.ErrInvalidHistoryID) {
-			return s.performFullEmailSync(ctx, gmailClient, syncRecord, label, progressCb)
+			return s.recoverExpiredHistory(ctx, gmailClient, syncRecord, label, startHistoryID, lastSync, progressCb)
 		}
 		return nil, fmt.Errorf("listing history: %w", err)
 	}
@@ -490,15 +628,25 @@ func (s *EmailSyncService) performIncrementalEmailSync(ctx context.Context, gmai
 			// Get full message details
 			fullMessage, err := gmailClient.GetMessageContent(ctx, added.Message.ID)
 			if err != nil {
+				if quotaExhausted(err) {
+					return nil, err
+				}
 				result.MessagesFailed++
+				s.recordMessageFailure(ctx, result, added.Message.ID, err)
 				continue
 			}
 
 			// Process the message
-			if err := s.processMessage(ctx, gmailClient, fullMessage, result, progressCb); err != nil {
+			processed, err := s.processMessage(ctx, gmailClient, result.ConnectionID, fullMessage)
+			if err != nil {
+				if quotaExhausted(err) {
+					return nil, err
+				}
 				result.MessagesFailed++
+				s.recordMessageFailure(ctx, result, added.Message.ID, err)
 				continue
 			}
+			processed.addTo(result)
 
 			result.MessagesDownloaded++
 		}
@@ -525,14 +673,24 @@ func (s *EmailSyncService) performIncrementalEmailSync(ctx context.Context, gmai
 
 			fullMessage, err := gmailClient.GetMessageContent(ctx, labelAdded.Message.ID)
 			if err != nil {
+				if quotaExhausted(err) {
+					return nil, err
+				}
 				result.MessagesFailed++
+				s.recordMessageFailure(ctx, result, labelAdded.Message.ID, err)
 				continue
 			}
 
-			if err := s.processMessage(ctx, gmailClient, fullMessage, result, progressCb); err != nil {
+			processed, err := s.processMessage(ctx, gmailClient, result.ConnectionID, fullMessage)
+			if err != nil {
+				if quotaExhausted(err) {
+					return nil, err
+				}
 				result.MessagesFailed++
+				s.recordMessageFailure(ctx, result, labelAdded.Message.ID, err)
 				continue
 			}
+			processed.addTo(result)
 
 			result.MessagesDownloaded++
 		}
@@ -567,21 +725,219 @@ func (s *EmailSyncService) performIncrementalEmailSync(ctx context.Context, gmai
 	return result, nil
 }
 
+// labelScan narrows scanLabelMessages to part of a label
+type labelScan struct {
+	// Query is a Gmail search query, e.g. "after:1700000000"
+	Query string
+	// MaxMessages stops the scan once the sync has scanned this many
+	// messages in total. Zero means no limit.
+	MaxMessages int
+	// Seen holds the IDs of messages already scanned by this sync run, so
+	// scans of several labels share it. Nil disables deduplication.
+	Seen map[string]bool
+	// Checkpoint records the page reached in each label and is saved as
+	// the scan moves between pages. Nil disables checkpointing.
+	Checkpoint *emailSyncCheckpoint
+}
+
+// recoverExpiredHistory runs when Gmail no longer has history for the
+// stored history ID. Rather than silently rescanning the whole mailbox, it
+// backfills only the messages received since the last successful sync, and
+// falls back to a full sync only when that time isn't known. Either way the
+// reason is stored on the sync record before the scan starts.
+func (s *EmailSyncService) recoverExpiredHistory(ctx context.Context, gmailClient *google.GmailClient, syncRecord *ent.EmailSync, label *ent.EmailLabel, historyID string, lastSync *ent.EmailSync, progressCb EmailSyncProgressCallback) (*EmailSyncResult, error) {
+	if lastSync == nil || lastSync.CompletedAt == nil {
+		reason := fmt.Sprintf("history ID %s expired and no completed sync is known; ran a full sync", historyID)
+		slog.WarnContext(ctx, "email sync history expired, running full sync",
+			"sync_id", syncRecord.ID,
+			"connection_id", syncRecord.ConnectionID,
+			"history_id", historyID,
+		)
+		if err := s.setFallbackReason(ctx, syncRecord.ID, reason); err != nil {
+			return nil, err
+		}
+		result, err := s.performFullEmailSync(ctx, gmailClient, syncRecord, label, newEmailSyncCheckpoint(""), progressCb)
+		if err != nil {
+			return nil, err
+		}
+		result.FallbackReason = &reason
+		return result, nil
+	}
+
+	since := *lastSync.CompletedAt
+	reason := fmt.Sprintf("history ID %s expired; backfilled messages received after %s", historyID, since.UTC().Format(time.RFC3339))
+	slog.WarnContext(ctx, "email sync history expired, backfilling",
+		"sync_id", syncRecord.ID,
+		"connection_id", syncRecord.ConnectionID,
+		"history_id", historyID,
+		"since", since,
+	)
+	if err := s.setFallbackReason(ctx, syncRecord.ID, reason); err != nil {
+		return nil, err
+	}
+	return s.performBackfillEmailSync(ctx, gmailClient, syncRecord, label, since, reason, progressCb)
+}
+
+// performBackfillEmailSync scans only the messages received after since,
+// scanning at most BackfillMaxMessages messages
+func (s *EmailSyncService) performBackfillEmailSync(ctx context.Context, gmailClient *google.GmailClient, syncRecord *ent.EmailSync, label *ent.EmailLabel, since time.Time, reason string, progressCb EmailSyncProgressCallback) (_ *EmailSyncResult, err error) {
+	ctx, span := tracing.Start(ctx, "EmailSyncService.performBackfillEmailSync", attribute.String("sync.id", syncRecord.ID))
+	defer tracing.End(span, &err)
+
+	result := &EmailSyncResult{
+		SyncID:       syncRecord.ID,
+		ConnectionID: syncRecord.ConnectionID,
+		LabelID:      syncRecord.LabelID,
+		SyncType:     string(syncRecord.SyncType),
+		Status:       "running",
+		StartedAt:    *syncRecord.StartedAt,
+		Receipts:     make([]ExtractedEmailReceipt, 0),
+		Attachments:  make([]ExtractedEmailAttachment, 0),
+	}
+
+	labelIDs, err := s.syncLabelIDs(ctx, syncRecord.ConnectionID, label)
+	if err != nil {
+		return nil, err
+	}
+
+	// Take the new history ID first so messages arriving during the
+	// backfill are picked up by the next incremental sync
+	profile, err := gmailClient.GetProfile(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting profile for history ID: %w", err)
+	}
+
+	scan := labelScan{
+		Query:       fmt.Sprintf("after:%d", since.Unix()),
+		MaxMessages: s.config.BackfillMaxMessages,
+		Seen:        make(map[string]bool),
+	}
+	for _, lid := range labelIDs {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		err := s.scanLabelMessages(ctx, gmailClient, lid, scan, result, progressCb)
+		if errors.Is(err, errScanLimitReached) {
+			reason = fmt.Sprintf("%s; stopped after %d messages, older messages in that window were skipped", reason, result.MessagesScanned)
+			break
+		}
+		if err != nil {
+			result.MessagesFailed++
+			continue
+		}
+	}
+
+	if profile.HistoryID != "" {
+		result.HistoryID = &profile.HistoryID
+	}
+	result.FallbackReason = &reason
+
+	// Complete the sync
+	result.Status = "completed"
+	now := time.Now()
+	result.CompletedAt = &now
+
+	// Update sync record
+	_, err = s.entClient.EmailSync.UpdateOneID(syncRecord.ID).
+		SetStatus(emailsync.StatusCompleted).
+		SetCompletedAt(now).
+		SetMessagesScanned(result.MessagesScanned).
+		SetMessagesDownloaded(result.MessagesDownloaded).
+		SetMessagesIndexed(result.MessagesIndexed).
+		SetMessagesFailed(result.MessagesFailed).
+		SetAttachmentsDownloaded(result.AttachmentsDownloaded).
+		SetBytesTransferred(result.BytesTransferred).
+		SetNillableHistoryID(result.HistoryID).
+		SetFallbackReason(reason).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("updating sync record: %w", err)
+	}
+
+	return result, nil
+}
+
+// setFallbackReason records why a sync didn't run incrementally
+func (s *EmailSyncService) setFallbackReason(ctx context.Context, syncID, reason string) error {
+	_, err := s.entClient.EmailSync.UpdateOneID(syncID).
+		SetFallbackReason(reason).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("updating sync record: %w", err)
+	}
+	return nil
+}
+
+// syncLabelIDs returns the provider label IDs a sync covers: the given label,
+// or every sync-enabled label of the connection
+func (s *EmailSyncService) syncLabelIDs(ctx context.Context, connectionID string, label *ent.EmailLabel) ([]string, error) {
+	if label != nil {
+		return []string{label.ProviderLabelID}, nil
+	}
+
+	labels, err := s.entClient.EmailLabel.Query().
+		Where(
+			emaillabel.ConnectionID(connectionID),
+			emaillabel.SyncEnabled(true),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying labels: %w", err)
+	}
+	if len(labels) == 0 {
+		return nil, ErrNoEmailLabelsToSync
+	}
+
+	labelIDs := make([]string, 0, len(labels))
+	for _, l := range labels {
+		labelIDs = append(labelIDs, l.ProviderLabelID)
+	}
+	return labelIDs, nil
+}
+
 // scanLabelMessages scans messages in a specific label
-func (s *EmailSyncService) scanLabelMessages(ctx context.Context, gmailClient *google.GmailClient, labelID string, result *EmailSyncResult, progressCb EmailSyncProgressCallback) error {
-	// Use iterator for efficient pagination
-	iterator := gmailClient.NewMessageIterator(ctx, google.ListMessagesOptions{
+func (s *EmailSyncService) scanLabelMessages(ctx context.Context, gmailClient *google.GmailClient, labelID string, scan labelScan, result *EmailSyncResult, progressCb EmailSyncProgressCallback) error {
+	opts := google.ListMessagesOptions{
 		MaxResults: s.config.BatchSize,
 		LabelIDs:   []string{labelID},
-	})
+		Query:      scan.Query,
+	}
+	var progress *labelCheckpoint
+	if scan.Checkpoint != nil {
+		progress = scan.Checkpoint.label(labelID)
+		opts.PageToken = progress.PageToken
+	}
+	page := opts.PageToken
+
+	// Use iterator for efficient pagination
+	iterator := gmailClient.NewMessageIterator(ctx, opts)
+
+	// Messages are fetched and processed by a bounded pool of goroutines.
+	// mu guards result while they run; the deferred Wait means no message
+	// is still being processed once the scan returns. A message that runs
+	// into the API quota stops the scan by cancelling scanCtx with the quota
+	// error as its cause.
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	sem := make(chan struct{}, max(s.config.MaxConcurrentMessages, 1))
+	scanCtx, stopScan := context.WithCancelCause(ctx)
+	defer stopScan(nil)
+	defer wg.Wait()
 
 	for {
 		select {
-		case <-ctx.Done():
-			return ctx.Err()
+		case <-scanCtx.Done():
+			return context.Cause(scanCtx)
 		default:
 		}
 
+		if scan.MaxMessages > 0 && result.MessagesScanned >= scan.MaxMessages {
+			return errScanLimitReached
+		}
+
 		msgRef, err := iterator.Next()
 		if err != nil {
 			return fmt.Errorf("iterating messages: %w", err)
@@ -590,50 +946,134 @@ func (s *EmailSyncService) scanLabelMessages(ctx context.Context, gmailClient *g
 			break // No more messages
 		}
 
+		// Once every message on the previous page has been processed, a
+		// resumed scan can start from this one
+		if progress != nil && iterator.PageToken() != page {
+			wg.Wait()
+			if err := context.Cause(scanCtx); err != nil {
+				return err
+			}
+			page = iterator.PageToken()
+			progress.PageToken = page
+			s.saveCheckpoint(ctx, result, scan.Checkpoint)
+		}
+
+		// A message with several synced labels is listed once per label
+		if scan.Seen != nil {
+			if scan.Seen[msgRef.ID] {
+				continue
+			}
+			scan.Seen[msgRef.ID] = true
+		}
+
+		mu.Lock()
 		result.MessagesScanned++
+		mu.Unlock()
 
-		// Get full message details
-		fullMessage, err := gmailClient.GetMessageContent(ctx, msgRef.ID)
-		if err != nil {
-			result.MessagesFailed++
-			continue
+		select {
+		case sem <- struct{}{}:
+		case <-scanCtx.Done():
+			return context.Cause(scanCtx)
 		}
+		wg.Add(1)
+		go func(messageID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := s.syncMessage(scanCtx, gmailClient, messageID, result, &mu, progressCb); err != nil {
+				stopScan(err)
+			}
+		}(msgRef.ID)
+	}
+
+	// Report a quota error hit by the last messages
+	wg.Wait()
+	return context.Cause(scanCtx)
+}
+
+// syncMessage fetches and processes one listed message and records the
+// outcome on result, holding mu while it does. Each message gets its own
+// MessageProcessingTimeout, so one slow message can't stall the scan. A
+// message is counted as failed unless the scan is being stopped; errors
+// that should stop the scan, like an exhausted quota, are returned.
+func (s *EmailSyncService) syncMessage(ctx context.Context, gmailClient *google.GmailClient, messageID string, result *EmailSyncResult, mu *sync.Mutex, progressCb EmailSyncProgressCallback) error {
+	scanCtx := ctx
+	if s.config.MessageProcessingTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.config.MessageProcessingTimeout)
+		defer cancel()
+	}
 
-		// Process the message
-		if err := s.processMessage(ctx, gmailClient, fullMessage, result, progressCb); err != nil {
+	if s.config.SkipImportedMessages && s.messageImported(ctx, result.ConnectionID, messageID) {
+		return nil
+	}
+
+	// Get full message details, then process the message
+	fullMessage, err := gmailClient.GetMessageContent(ctx, messageID)
+	var processed *processedMessage
+	if err == nil {
+		processed, err = s.processMessage(ctx, gmailClient, result.ConnectionID, fullMessage)
+	}
+	if err != nil {
+		if quotaExhausted(err) {
+			return err
+		}
+		if scanCtx.Err() == nil {
+			mu.Lock()
 			result.MessagesFailed++
-			continue
+			mu.Unlock()
+			s.recordMessageFailure(scanCtx, result, messageID, err)
 		}
+		return nil
+	}
 
-		result.MessagesDownloaded++
+	mu.Lock()
+	defer mu.Unlock()
+	processed.addTo(result)
+	result.MessagesDownloaded++
 
-		// Report progress
-		if progressCb != nil {
-			subject := ""
-			if fullMessage.Payload != nil {
-				subject = fullMessage.Payload.GetHeader("Subject")
-			}
-			progressCb(EmailSyncProgress{
-				SyncID:                result.SyncID,
-				Status:                "running",
-				MessagesScanned:       result.MessagesScanned,
-				MessagesProcessed:     result.MessagesDownloaded,
-				AttachmentsDownloaded: result.AttachmentsDownloaded,
-				BytesTransferred:      result.BytesTransferred,
-				CurrentMessage:        subject,
-			})
+	// Report progress
+	if progressCb != nil {
+		subject := ""
+		if fullMessage.Payload != nil {
+			subject = fullMessage.Payload.GetHeader("Subject")
 		}
+		progressCb(EmailSyncProgress{
+			SyncID:                result.SyncID,
+			Status:                "running",
+			MessagesScanned:       result.MessagesScanned,
+			MessagesProcessed:     result.MessagesDownloaded,
+			AttachmentsDownloaded: result.AttachmentsDownloaded,
+			BytesTransferred:      result.BytesTransferred,
+			CurrentMessage:        subject,
+		})
 	}
-
 	return nil
 }
 
+// messageImported reports whether an earlier sync of the connection stored
+// the message's receipt attachments, and so already queued them for OCR.
+// Lookup errors are treated as not imported so the message is processed
+// again rather than missed.
+func (s *EmailSyncService) messageImported(ctx context.Context, connectionID, messageID string) bool {
+	exists, err := s.entClient.AttachmentLink.Query().
+		Where(
+			attachmentlink.ConnectionID(connectionID),
+			attachmentlink.MessageID(messageID),
+		).
+		Exist(ctx)
+	return err == nil && exists
+}
+
 // processMessage processes a single email message
-func (s *EmailSyncService) processMessage(ctx context.Context, gmailClient *google.GmailClient, message *google.GmailMessage, result *EmailSyncResult, progressCb EmailSyncProgressCallback) error {
+func (s *EmailSyncService) processMessage(ctx context.Context, gmailClient *google.GmailClient, connectionID string, message *google.GmailMessage) (_ *processedMessage, err error) {
+	processed := &processedMessage{}
 	if message == nil || message.Payload == nil {
-		return nil
+		return processed, nil
 	}
 
+	ctx, span := tracing.Start(ctx, "EmailSyncService.processMessage", attribute.String("gmail.message_id", message.ID))
+	defer tracing.End(span, &err)
+
 	// Extract message metadata
 	subject := message.Payload.GetHeader("Subject")
 	from := message.Payload.GetHeader("From")
@@ -653,7 +1093,7 @@ func (s *EmailSyncService) processMessage(ctx context.Context, gmailClient *goog
 		for _, att := range attachments {
 			select {
 			case <-ctx.Done():
-				return ctx.Err()
+				return nil, ctx.Err()
 			default:
 			}
 
@@ -676,19 +1116,35 @@ func (s *EmailSyncService) processMessage(ctx context.Context, gmailClient *goog
 
 			// Download attachment if it's a receipt or if receipt extraction is enabled
 			if isReceiptAttachment && s.config.EnableReceiptExtraction {
-				_, err := gmailClient.DownloadAttachment(ctx, message.ID, att.AttachmentID)
+				data, err := gmailClient.DownloadAttachment(ctx, message.ID, att.AttachmentID)
 				if err != nil {
+					if quotaExhausted(err) {
+						return nil, err
+					}
 					// Log but continue
 					continue
 				}
-				result.AttachmentsDownloaded++
-				result.BytesTransferred += int64(att.Size)
+				processed.AttachmentsDownloaded++
+				processed.BytesTransferred += int64(att.Size)
+
+				// The same receipt often arrives in several emails; its
+				// content is stored and OCRed once
+				stored, err := s.StoreAttachment(ctx, connectionID, message.ID, att, data)
+				if err != nil {
+					return nil, fmt.Errorf("storing attachment %s: %w", att.AttachmentID, err)
+				}
+				extractedAtt.ContentHash = stored.ContentHash
+				extractedAtt.BlobID = stored.BlobID
+				extractedAtt.Duplicate = stored.Duplicate
+				if stored.Duplicate {
+					processed.AttachmentsDeduplicated++
+				}
 			}
 
 			extractedAttachments = append(extractedAttachments, extractedAtt)
-			result.Attachments = append(result.Attachments, extractedAtt)
 		}
 	}
+	processed.Attachments = extractedAttachments
 
 	// If this is a receipt email, extract receipt information
 	if isReceiptEmail && s.config.EnableReceiptExtraction {
@@ -705,11 +1161,40 @@ func (s *EmailSyncService) processMessage(ctx context.Context, gmailClient *goog
 			AttachmentCount: len(attachments),
 			Attachments:     extractedAttachments,
 		}
-		result.Receipts = append(result.Receipts, receipt)
+		processed.Receipt = &receipt
 	}
 
-	result.MessagesIndexed++
-	return nil
+	if err := s.indexMessage(ctx, connectionID, message, len(attachments)); err != nil {
+		return nil, err
+	}
+	processed.Indexed = true
+	return processed, nil
+}
+
+// processedMessage is what processMessage extracted from one message. It is
+// kept apart from the sync result so messages can be processed concurrently
+// and added to the result one at a time.
+type processedMessage struct {
+	Attachments             []ExtractedEmailAttachment
+	Receipt                 *ExtractedEmailReceipt
+	AttachmentsDownloaded   int
+	AttachmentsDeduplicated int
+	BytesTransferred        int64
+	Indexed                 bool
+}
+
+// addTo adds the message's attachments, receipt and counters to result
+func (p *processedMessage) addTo(result *EmailSyncResult) {
+	result.Attachments = append(result.Attachments, p.Attachments...)
+	if p.Receipt != nil {
+		result.Receipts = append(result.Receipts, *p.Receipt)
+	}
+	result.AttachmentsDownloaded += p.AttachmentsDownloaded
+	result.AttachmentsDeduplicated += p.AttachmentsDeduplicated
+	result.BytesTransferred += p.BytesTransferred
+	if p.Indexed {
+		result.MessagesIndexed++
+	}
 }
 
 // isReceiptEmail checks if an email is likely a receipt based on content and attachments
@@ -771,6 +1256,16 @@ func (s *EmailSyncService) failSync(ctx context.Context, syncRecord *ent.EmailSy
 	errMsg := err.Error()
 	now := time.Now()
 
+	level := slog.LevelError
+	if errors.Is(err, context.Canceled) {
+		level = slog.LevelInfo
+	}
+	slog.Log(ctx, level, "email sync failed",
+		"sync_id", syncRecord.ID,
+		"connection_id", syncRecord.ConnectionID,
+		"error", errMsg,
+	)
+
 	_, updateErr := s.entClient.EmailSync.UpdateOneID(syncRecord.ID).
 		SetStatus(emailsync.StatusFailed).
 		SetCompletedAt(now).
@@ -815,6 +1310,30 @@ func (s *EmailSyncService) GetSyncStatus(ctx context.Context, syncID string) (*E
 		return nil, fmt.Errorf("getting sync: %w", err)
 	}
 
+	return NewEmailSyncResult(sync), nil
+}
+
+// GetSyncHistory retrieves sync history for a connection
+func (s *EmailSyncService) GetSyncHistory(ctx context.Context, connectionID string, limit int) ([]*EmailSyncResult, error) {
+	syncs, err := s.entClient.EmailSync.Query().
+		Where(emailsync.ConnectionID(connectionID)).
+		Order(ent.Desc(emailsync.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying sync history: %w", err)
+	}
+
+	results := make([]*EmailSyncResult, len(syncs))
+	for i, sync := range syncs {
+		results[i] = NewEmailSyncResult(sync)
+	}
+
+	return results, nil
+}
+
+// NewEmailSyncResult returns the result of a stored sync
+func NewEmailSyncResult(sync *ent.EmailSync) *EmailSyncResult {
 	result := &EmailSyncResult{
 		SyncID:                sync.ID,
 		ConnectionID:          sync.ConnectionID,
@@ -829,6 +1348,7 @@ func (s *EmailSyncService) GetSyncStatus(ctx context.Context, syncID string) (*E
 		BytesTransferred:      sync.BytesTransferred,
 		ErrorMessage:          sync.ErrorMessage,
 		HistoryID:             sync.HistoryID,
+		FallbackReason:        sync.FallbackReason,
 	}
 
 	if sync.StartedAt != nil {
@@ -838,46 +1358,7 @@ func (s *EmailSyncService) GetSyncStatus(ctx context.Context, syncID string) (*E
 		result.CompletedAt = sync.CompletedAt
 	}
 
-	return result, nil
-}
-
-// GetSyncHistory retrieves sync history for a connection
-func (s *EmailSyncService) GetSyncHistory(ctx context.Context, connectionID string, limit int) ([]*EmailSyncResult, error) {
-	syncs, err := s.entClient.EmailSync.Query().
-		Where(emailsync.ConnectionID(connectionID)).
-		Order(ent.Desc(emailsync.FieldCreatedAt)).
-		Limit(limit).
-		All(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("querying sync history: %w", err)
-	}
-
-	results := make([]*EmailSyncResult, len(syncs))
-	for i, sync := range syncs {
-		results[i] = &EmailSyncResult{
-			SyncID:                sync.ID,
-			ConnectionID:          sync.ConnectionID,
-			LabelID:               sync.LabelID,
-			SyncType:              string(sync.SyncType),
-			Status:                string(sync.Status),
-			MessagesScanned:       sync.MessagesScanned,
-			MessagesDownloaded:    sync.MessagesDownloaded,
-			MessagesIndexed:       sync.MessagesIndexed,
-			MessagesFailed:        sync.MessagesFailed,
-			AttachmentsDownloaded: sync.AttachmentsDownloaded,
-			BytesTransferred:      sync.BytesTransferred,
-			ErrorMessage:          sync.ErrorMessage,
-			HistoryID:             sync.HistoryID,
-		}
-		if sync.StartedAt != nil {
-			results[i].StartedAt = *sync.StartedAt
-		}
-		if sync.CompletedAt != nil {
-			results[i].CompletedAt = sync.CompletedAt
-		}
-	}
-
-	return results, nil
+	return result
 }
 
 // GetActiveSyncs returns currently running syncs
@@ -990,19 +1471,11 @@ func (s *EmailSyncService) ExtractReceiptsFromLabel(ctx context.Context, connect
 	}
 
 	// Create Gmail client
-	oauthClient, err := google.NewClient(s.oauthCfg)
+	gmailClient, err := s.gmailClient(connection)
 	if err != nil {
-		return nil, fmt.Errorf("creating oauth client: %w", err)
+		return nil, err
 	}
 
-	token := &google.Token{
-		AccessToken:  connection.AccessToken,
-		RefreshToken: connection.RefreshToken,
-		Expiry:       connection.TokenExpiry,
-	}
-	tokenSource := google.NewTokenSource(oauthClient, token)
-	gmailClient := google.NewGmailClient(tokenSource)
-
 	// Build a search query for receipts
 	queryParts := make([]string, 0, len(s.config.ReceiptKeywords))
 	for _, keyword := range s.config.ReceiptKeywords {
@@ -1088,18 +1561,10 @@ func (s *EmailSyncService) DownloadAttachment(ctx context.Context, connectionID,
 	}
 
 	// Create Gmail client
-	oauthClient, err := google.NewClient(s.oauthCfg)
+	gmailClient, err := s.gmailClient(connection)
 	if err != nil {
-		return nil, nil, fmt.Errorf("creating oauth client: %w", err)
-	}
-
-	token := &google.Token{
-		AccessToken:  connection.AccessToken,
-		RefreshToken: connection.RefreshToken,
-		Expiry:       connection.TokenExpiry,
+		return nil, nil, err
 	}
-	tokenSource := google.NewTokenSource(oauthClient, token)
-	gmailClient := google.NewGmailClient(tokenSource)
 
 	// Get message to find attachment metadata
 	message, err := gmailClient.GetMessageContent(ctx, messageID)
@@ -1187,11 +1652,12 @@ func (t *EmailSyncStatusTracker) Unwatch(syncID string, ch <-chan EmailSyncProgr
 
 // Notify sends progress update to all watchers
 func (t *EmailSyncStatusTracker) Notify(progress EmailSyncProgress) {
+	// Hold the read lock while sending so Unwatch cannot close a channel
+	// mid-send; sends never block, so writers are not held up for long.
 	t.mu.RLock()
-	watchers := t.watchers[progress.SyncID]
-	t.mu.RUnlock()
+	defer t.mu.RUnlock()
 
-	for _, ch := range watchers {
+	for _, ch := range t.watchers[progress.SyncID] {
 		select {
 		case ch <- progress:
 		default:
@@ -0,0 +1,71 @@
+package integration
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/infrastructure/google"
+)
+
+// ErrInvalidSyncRange is returned when a sync range is empty or is used with
+// a sync type that cannot be scoped
+var ErrInvalidSyncRange = errors.New("invalid sync range")
+
+// SyncRange limits a full or manual sync to items dated within a time range.
+// After is inclusive and Before is exclusive; a zero bound is open.
+type SyncRange struct {
+	After  time.Time
+	Before time.Time
+}
+
+// IsZero reports whether the range has no bounds
+func (r SyncRange) IsZero() bool {
+	return r.After.IsZero() && r.Before.IsZero()
+}
+
+// validate checks the range can be applied to a sync of the given type.
+// Incremental syncs follow change history rather than scanning, so they
+// cannot be scoped.
+func (r SyncRange) validate(syncType string) error {
+	if r.IsZero() {
+		return nil
+	}
+	if syncType == "incremental" {
+		return fmt.Errorf("%w: only full and manual syncs can be scoped by date", ErrInvalidSyncRange)
+	}
+	if !r.After.IsZero() && !r.Before.IsZero() && !r.After.Before(r.Before) {
+		return fmt.Errorf("%w: after must be earlier than before", ErrInvalidSyncRange)
+	}
+	return nil
+}
+
+// gmailQuery returns the Gmail search terms selecting messages received in
+// the range
+func (r SyncRange) gmailQuery() string {
+	var terms []string
+	if !r.After.IsZero() {
+		terms = append(terms, fmt.Sprintf("after:%d", r.After.Unix()))
+	}
+	if !r.Before.IsZero() {
+		terms = append(terms, fmt.Sprintf("before:%d", r.Before.Unix()))
+	}
+	return strings.Join(terms, " ")
+}
+
+// driveQuery returns the Drive search clause selecting files modified in the
+// range. Folders always match so the scan can still descend into them.
+func (r SyncRange) driveQuery() string {
+	var terms []string
+	if !r.After.IsZero() {
+		terms = append(terms, fmt.Sprintf("modifiedTime >= '%s'", r.After.UTC().Format(time.RFC3339)))
+	}
+	if !r.Before.IsZero() {
+		terms = append(terms, fmt.Sprintf("modifiedTime < '%s'", r.Before.UTC().Format(time.RFC3339)))
+	}
+	if len(terms) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("(mimeType = '%s' or (%s))", google.MimeTypeFolder, strings.Join(terms, " and "))
+}
@@ -0,0 +1,57 @@
+package integration
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrInvalidSyncSchedule is returned for a sync schedule that isn't one of
+// the supported schedules
+var ErrInvalidSyncSchedule = errors.New("sync schedule must be one of: every_15_minutes, hourly, daily, manual")
+
+// Sync schedules of email and Drive connections. The worker syncs scheduled
+// connections incrementally; manual connections only sync on request.
+const (
+	SyncScheduleEvery15Minutes = "every_15_minutes"
+	SyncScheduleHourly         = "hourly"
+	SyncScheduleDaily          = "daily"
+	SyncScheduleManual         = "manual"
+)
+
+// SyncScheduleInterval returns how often a connection on the schedule
+// syncs. It reports false for manual connections.
+func SyncScheduleInterval(schedule string) (time.Duration, bool) {
+	switch schedule {
+	case SyncScheduleEvery15Minutes:
+		return 15 * time.Minute, true
+	case SyncScheduleHourly:
+		return time.Hour, true
+	case SyncScheduleDaily:
+		return 24 * time.Hour, true
+	default:
+		return 0, false
+	}
+}
+
+// ValidateSyncSchedule checks that schedule is a supported sync schedule
+func ValidateSyncSchedule(schedule string) error {
+	if _, ok := SyncScheduleInterval(schedule); !ok && schedule != SyncScheduleManual {
+		return ErrInvalidSyncSchedule
+	}
+	return nil
+}
+
+// NextScheduledSync returns when a connection put on the schedule is next
+// due: one interval after its last sync, or now if it has never synced or
+// is overdue. It returns nil for manual connections.
+func NextScheduledSync(schedule string, lastSyncAt *time.Time, now time.Time) *time.Time {
+	interval, ok := SyncScheduleInterval(schedule)
+	if !ok {
+		return nil
+	}
+	next := now
+	if lastSyncAt != nil && lastSyncAt.Add(interval).After(now) {
+		next = lastSyncAt.Add(interval)
+	}
+	return &next
+}
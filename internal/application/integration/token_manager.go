@@ -0,0 +1,423 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/googledriveconnection"
+	"clockzen-next/internal/ent/predicate"
+	"clockzen-next/internal/infrastructure/google"
+
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// ErrNoRefreshToken is returned when a connection's token has expired and
+// it has no refresh token to renew it with
+var ErrNoRefreshToken = errors.New("connection has no refresh token")
+
+// TokenManagerConfig configures a TokenManager
+type TokenManagerConfig struct {
+	// RefreshBefore is how long before its expiry RefreshExpiring renews a
+	// token
+	RefreshBefore time.Duration
+	// MinValidity is how long a token handed out must remain valid; tokens
+	// expiring sooner are refreshed first
+	MinValidity time.Duration
+	// BatchSize is the number of connections loaded per query
+	BatchSize int
+}
+
+// DefaultTokenManagerConfig returns the default token manager configuration
+func DefaultTokenManagerConfig() TokenManagerConfig {
+	return TokenManagerConfig{
+		RefreshBefore: 10 * time.Minute,
+		MinValidity:   time.Minute,
+		BatchSize:     100,
+	}
+}
+
+// TokenRefreshResult reports the outcome of RefreshExpiring
+type TokenRefreshResult struct {
+	Refreshed int
+	// Expired counts connections Google no longer accepts the refresh token
+	// of; they are marked expired until the user reconnects them
+	Expired int
+	Failed  int
+}
+
+// TokenManager is the one place connection tokens are refreshed. Refreshes
+// of a connection are serialized, within the process by a per-connection
+// lock and across processes by locking the connection row, and the rotated
+// token is stored in the same database transaction. A caller that waited
+// on another's refresh gets the token it stored instead of refreshing again.
+type TokenManager struct {
+	entClient  *ent.Client
+	oauthCfg   *google.Config
+	tokens     *TokenStore
+	config     TokenManagerConfig
+	locks      *connectionLocks
+	httpClient *http.Client
+}
+
+// NewTokenManager creates a token manager
+func NewTokenManager(entClient *ent.Client, oauthCfg *google.Config, tokens *TokenStore, config TokenManagerConfig) *TokenManager {
+	return &TokenManager{
+		entClient: entClient,
+		oauthCfg:  oauthCfg,
+		tokens:    tokens,
+		config:    config,
+		locks:     newConnectionLocks(),
+	}
+}
+
+// NewTokenManagerWithDefaults creates a token manager with default
+// configuration
+func NewTokenManagerWithDefaults(entClient *ent.Client, oauthCfg *google.Config, tokens *TokenStore) *TokenManager {
+	return NewTokenManager(entClient, oauthCfg, tokens, DefaultTokenManagerConfig())
+}
+
+// TokenStore returns the store the manager encrypts and decrypts tokens
+// with
+func (m *TokenManager) TokenStore() *TokenStore {
+	return m.tokens
+}
+
+// SetTokenStore sets the store used to encrypt and decrypt tokens
+func (m *TokenManager) SetTokenStore(tokens *TokenStore) {
+	m.tokens = tokens
+}
+
+// SetHTTPClient sets the client used to call Google's token endpoint, in
+// place of the OAuth client's default
+func (m *TokenManager) SetHTTPClient(httpClient *http.Client) {
+	m.httpClient = httpClient
+}
+
+// EmailToken returns an email connection's token, refreshed first if it
+// expires within MinValidity
+func (m *TokenManager) EmailToken(ctx context.Context, connectionID string) (*google.Token, error) {
+	token, _, err := m.refresh(ctx, emailTokens, connectionID, m.config.MinValidity, false)
+	return token, err
+}
+
+// DriveToken returns a Drive connection's token, refreshed first if it
+// expires within MinValidity
+func (m *TokenManager) DriveToken(ctx context.Context, connectionID string) (*google.Token, error) {
+	token, _, err := m.refresh(ctx, driveTokens, connectionID, m.config.MinValidity, false)
+	return token, err
+}
+
+// RefreshEmailToken refreshes an email connection's token whether or not
+// it is about to expire
+func (m *TokenManager) RefreshEmailToken(ctx context.Context, connectionID string) (*google.Token, error) {
+	token, _, err := m.refresh(ctx, emailTokens, connectionID, 0, true)
+	return token, err
+}
+
+// RefreshDriveToken refreshes a Drive connection's token whether or not it
+// is about to expire
+func (m *TokenManager) RefreshDriveToken(ctx context.Context, connectionID string) (*google.Token, error) {
+	token, _, err := m.refresh(ctx, driveTokens, connectionID, 0, true)
+	return token, err
+}
+
+// EmailTokenSource returns a token source for an email connection that
+// gets renewed tokens from the manager
+func (m *TokenManager) EmailTokenSource(conn *ent.EmailConnection) (*google.TokenSource, error) {
+	token, err := m.tokens.EmailToken(conn)
+	if err != nil {
+		return nil, err
+	}
+	return google.NewTokenSourceFunc(token, func(ctx context.Context) (*google.Token, error) {
+		return m.EmailToken(ctx, conn.ID)
+	}), nil
+}
+
+// DriveTokenSource returns a token source for a Drive connection that gets
+// renewed tokens from the manager
+func (m *TokenManager) DriveTokenSource(conn *ent.GoogleDriveConnection) (*google.TokenSource, error) {
+	token, err := m.tokens.DriveToken(conn)
+	if err != nil {
+		return nil, err
+	}
+	return google.NewTokenSourceFunc(token, func(ctx context.Context) (*google.Token, error) {
+		return m.DriveToken(ctx, conn.ID)
+	}), nil
+}
+
+// RefreshExpiring refreshes the tokens of active connections that expire
+// within RefreshBefore of now, so syncs and API requests rarely have to
+// wait on a refresh
+func (m *TokenManager) RefreshExpiring(ctx context.Context, now time.Time) (*TokenRefreshResult, error) {
+	result := &TokenRefreshResult{}
+	deadline := now.Add(m.config.RefreshBefore)
+
+	err := m.refreshBatches(ctx, emailTokens, result, func(lastID string) ([]string, error) {
+		return m.entClient.EmailConnection.Query().
+			Where(
+				emailconnection.IDGT(lastID),
+				emailconnection.StatusEQ(emailconnection.StatusActive),
+				emailconnection.ProviderNEQ(emailconnection.ProviderSandbox),
+				emailconnection.TokenExpiryLT(deadline),
+			).
+			Order(emailconnection.ByID()).
+			Limit(m.config.BatchSize).
+			IDs(ctx)
+	})
+	if err != nil {
+		return result, fmt.Errorf("listing email connections: %w", err)
+	}
+
+	err = m.refreshBatches(ctx, driveTokens, result, func(lastID string) ([]string, error) {
+		return m.entClient.GoogleDriveConnection.Query().
+			Where(
+				googledriveconnection.IDGT(lastID),
+				googledriveconnection.StatusEQ(googledriveconnection.StatusActive),
+				googledriveconnection.TokenExpiryLT(deadline),
+			).
+			Order(googledriveconnection.ByID()).
+			Limit(m.config.BatchSize).
+			IDs(ctx)
+	})
+	if err != nil {
+		return result, fmt.Errorf("listing drive connections: %w", err)
+	}
+	return result, nil
+}
+
+// refreshBatches refreshes the connections list returns, a batch at a time
+func (m *TokenManager) refreshBatches(ctx context.Context, kind tokenKind, result *TokenRefreshResult, list func(lastID string) ([]string, error)) error {
+	lastID := ""
+	for {
+		ids, err := list(lastID)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		for _, id := range ids {
+			lastID = id
+			_, refreshed, err := m.refresh(ctx, kind, id, m.config.RefreshBefore, false)
+			switch {
+			case NeedsReauthorization(err):
+				result.Expired++
+			case err != nil:
+				result.Failed++
+				slog.WarnContext(ctx, "refreshing connection token",
+					"connection", kind.name,
+					"connection_id", id,
+					"error", err,
+				)
+			case refreshed:
+				result.Refreshed++
+			}
+		}
+	}
+}
+
+// refresh returns a connection's token, refreshing it first if force is set
+// or it expires within validFor. The connection row stays locked from
+// reading the token until the refreshed one is stored. A refresh token
+// Google rejects marks the connection expired.
+func (m *TokenManager) refresh(ctx context.Context, kind tokenKind, connectionID string, validFor time.Duration, force bool) (_ *google.Token, refreshed bool, err error) {
+	unlock := m.locks.lock(kind.name + ":" + connectionID)
+	defer unlock()
+
+	tx, err := m.entClient.Tx(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stored, err := kind.load(ctx, tx, connectionID)
+	if err != nil {
+		return nil, false, err
+	}
+	token, err := m.tokens.open(stored.accessToken, stored.refreshToken, stored.expiry)
+	if err != nil {
+		return nil, false, err
+	}
+	if stored.sandbox || (!force && !token.IsExpiredWithBuffer(validFor)) {
+		return token, false, nil
+	}
+	if token.RefreshToken == "" {
+		return nil, false, ErrNoRefreshToken
+	}
+
+	client, err := m.oauthClient()
+	if err != nil {
+		return nil, false, fmt.Errorf("creating oauth client: %w", err)
+	}
+	token, err = client.RefreshToken(ctx, token.RefreshToken)
+	if NeedsReauthorization(err) {
+		if expireErr := kind.expire(ctx, tx, connectionID); expireErr != nil {
+			return nil, false, fmt.Errorf("marking %s connection expired: %w (refresh error: %v)", kind.name, expireErr, err)
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			return nil, false, fmt.Errorf("committing expired %s connection: %w (refresh error: %v)", kind.name, commitErr, err)
+		}
+		return nil, false, err
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	accessToken, refreshToken, err := m.tokens.Seal(token)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := kind.save(ctx, tx, connectionID, accessToken, refreshToken, token.Expiry); err != nil {
+		return nil, false, fmt.Errorf("saving %s connection token: %w", kind.name, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, fmt.Errorf("committing %s connection token: %w", kind.name, err)
+	}
+	return token, true, nil
+}
+
+// oauthClient creates the client that refreshes tokens
+func (m *TokenManager) oauthClient() (*google.Client, error) {
+	if m.httpClient != nil {
+		return google.NewClientWithHTTP(m.oauthCfg, m.httpClient)
+	}
+	return google.NewClient(m.oauthCfg)
+}
+
+// storedToken is a connection's token as stored
+type storedToken struct {
+	accessToken  string
+	refreshToken string
+	expiry       time.Time
+	// sandbox connections have a token that is never refreshed
+	sandbox bool
+}
+
+// tokenKind reads and writes the tokens of one kind of connection within
+// a transaction
+type tokenKind struct {
+	name   string
+	load   func(ctx context.Context, tx *ent.Tx, connectionID string) (*storedToken, error)
+	save   func(ctx context.Context, tx *ent.Tx, connectionID, accessToken, refreshToken string, expiry time.Time) error
+	expire func(ctx context.Context, tx *ent.Tx, connectionID string) error
+}
+
+// forUpdate locks the selected rows until the transaction ends
+func forUpdate(s *entsql.Selector) {
+	s.ForUpdate()
+}
+
+var emailTokens = tokenKind{
+	name: "email",
+	load: func(ctx context.Context, tx *ent.Tx, connectionID string) (*storedToken, error) {
+		conn, err := tx.EmailConnection.Query().
+			Where(emailconnection.ID(connectionID), predicate.EmailConnection(forUpdate)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return nil, ErrEmailConnectionNotFound
+		}
+		if err != nil {
+			return nil, fmt.Errorf("getting email connection: %w", err)
+		}
+		return &storedToken{
+			accessToken:  conn.AccessToken,
+			refreshToken: conn.RefreshToken,
+			expiry:       conn.TokenExpiry,
+			sandbox:      conn.Provider == emailconnection.ProviderSandbox,
+		}, nil
+	},
+	save: func(ctx context.Context, tx *ent.Tx, connectionID, accessToken, refreshToken string, expiry time.Time) error {
+		return tx.EmailConnection.UpdateOneID(connectionID).
+			SetAccessToken(accessToken).
+			SetRefreshToken(refreshToken).
+			SetTokenExpiry(expiry).
+			Exec(ctx)
+	},
+	expire: func(ctx context.Context, tx *ent.Tx, connectionID string) error {
+		return tx.EmailConnection.UpdateOneID(connectionID).
+			SetStatus(emailconnection.StatusExpired).
+			Exec(ctx)
+	},
+}
+
+var driveTokens = tokenKind{
+	name: "drive",
+	load: func(ctx context.Context, tx *ent.Tx, connectionID string) (*storedToken, error) {
+		conn, err := tx.GoogleDriveConnection.Query().
+			Where(googledriveconnection.ID(connectionID), predicate.GoogleDriveConnection(forUpdate)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return nil, ErrConnectionNotFound
+		}
+		if err != nil {
+			return nil, fmt.Errorf("getting drive connection: %w", err)
+		}
+		return &storedToken{
+			accessToken:  conn.AccessToken,
+			refreshToken: conn.RefreshToken,
+			expiry:       conn.TokenExpiry,
+		}, nil
+	},
+	save: func(ctx context.Context, tx *ent.Tx, connectionID, accessToken, refreshToken string, expiry time.Time) error {
+		return tx.GoogleDriveConnection.UpdateOneID(connectionID).
+			SetAccessToken(accessToken).
+			SetRefreshToken(refreshToken).
+			SetTokenExpiry(expiry).
+			Exec(ctx)
+	},
+	expire: func(ctx context.Context, tx *ent.Tx, connectionID string) error {
+		return tx.GoogleDriveConnection.UpdateOneID(connectionID).
+			SetStatus(googledriveconnection.StatusExpired).
+			Exec(ctx)
+	},
+}
+
+// connectionLocks serializes work on a connection within the process.
+// Entries are removed once no one holds or waits for them.
+type connectionLocks struct {
+	mu    sync.Mutex
+	locks map[string]*connectionLock
+}
+
+// connectionLock is a lock and the number of callers holding or waiting
+// for it
+type connectionLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// newConnectionLocks creates an empty set of locks
+func newConnectionLocks() *connectionLocks {
+	return &connectionLocks{locks: make(map[string]*connectionLock)}
+}
+
+// lock locks key and returns the function unlocking it
+func (l *connectionLocks) lock(key string) func() {
+	l.mu.Lock()
+	entry, ok := l.locks[key]
+	if !ok {
+		entry = &connectionLock{}
+		l.locks[key] = entry
+	}
+	entry.refs++
+	l.mu.Unlock()
+
+	entry.mu.Lock()
+	return func() {
+		entry.mu.Unlock()
+		l.mu.Lock()
+		entry.refs--
+		if entry.refs == 0 {
+			delete(l.locks, key)
+		}
+		l.mu.Unlock()
+	}
+}
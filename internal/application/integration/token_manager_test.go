@@ -0,0 +1,48 @@
+package integration
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectionLocks(t *testing.T) {
+	locks := newConnectionLocks()
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		held    int
+		maxHeld int
+	)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlock := locks.lock("email:conn-1")
+			defer unlock()
+
+			mu.Lock()
+			held++
+			maxHeld = max(maxHeld, held)
+			mu.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			held--
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, maxHeld, "one caller holds a connection's lock at a time")
+	assert.Empty(t, locks.locks, "released locks are removed")
+
+	unlockFirst := locks.lock("email:conn-1")
+	unlockOther := locks.lock("drive:conn-1")
+	unlockOther()
+	unlockFirst()
+}
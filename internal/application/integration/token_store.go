@@ -0,0 +1,266 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/googledriveconnection"
+	"clockzen-next/internal/infrastructure/encryption"
+	"clockzen-next/internal/infrastructure/google"
+)
+
+// Token store errors
+var (
+	ErrTokenDecryptFailed = errors.New("failed to decrypt stored token")
+	ErrTokenKeyRequired   = errors.New("token migration requires an encryption key")
+)
+
+// TokenStore encrypts OAuth tokens before they are written to connection
+// rows and decrypts them when they are read back. A store without a keyring
+// passes tokens through unchanged.
+type TokenStore struct {
+	entClient *ent.Client
+	keyring   *encryption.Keyring
+}
+
+// NewTokenStore creates a token store. keyring may be nil to store tokens
+// in plaintext.
+func NewTokenStore(entClient *ent.Client, keyring *encryption.Keyring) *TokenStore {
+	return &TokenStore{
+		entClient: entClient,
+		keyring:   keyring,
+	}
+}
+
+// Encrypted reports whether tokens are encrypted at rest
+func (s *TokenStore) Encrypted() bool {
+	return s.keyring != nil
+}
+
+// Seal returns the access and refresh tokens in their stored form
+func (s *TokenStore) Seal(token *google.Token) (accessToken, refreshToken string, err error) {
+	if s.keyring == nil {
+		return token.AccessToken, token.RefreshToken, nil
+	}
+
+	accessToken, err = s.keyring.Encrypt(token.AccessToken)
+	if err != nil {
+		return "", "", fmt.Errorf("encrypting access token: %w", err)
+	}
+	refreshToken, err = s.keyring.Encrypt(token.RefreshToken)
+	if err != nil {
+		return "", "", fmt.Errorf("encrypting refresh token: %w", err)
+	}
+	return accessToken, refreshToken, nil
+}
+
+// EmailToken returns the decrypted OAuth token for an email connection
+func (s *TokenStore) EmailToken(conn *ent.EmailConnection) (*google.Token, error) {
+	return s.open(conn.AccessToken, conn.RefreshToken, conn.TokenExpiry)
+}
+
+// DriveToken returns the decrypted OAuth token for a Drive connection
+func (s *TokenStore) DriveToken(conn *ent.GoogleDriveConnection) (*google.Token, error) {
+	return s.open(conn.AccessToken, conn.RefreshToken, conn.TokenExpiry)
+}
+
+// NeedsReauthorization reports whether err means Google rejected a
+// connection's refresh token for good, as opposed to a transient failure
+func NeedsReauthorization(err error) bool {
+	return errors.Is(err, google.ErrInvalidGrant)
+}
+
+// open decrypts stored tokens. Plaintext tokens written before encryption
+// was enabled are returned as is.
+func (s *TokenStore) open(accessToken, refreshToken string, expiry time.Time) (*google.Token, error) {
+	token := &google.Token{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		Expiry:       expiry,
+	}
+
+	if s.keyring == nil {
+		if encryption.IsEncrypted(accessToken) || encryption.IsEncrypted(refreshToken) {
+			return nil, fmt.Errorf("%w: no encryption key configured", ErrTokenDecryptFailed)
+		}
+		return token, nil
+	}
+
+	var err error
+	if token.AccessToken, err = s.keyring.Decrypt(accessToken); err != nil {
+		return nil, fmt.Errorf("%w: access token: %v", ErrTokenDecryptFailed, err)
+	}
+	if token.RefreshToken, err = s.keyring.Decrypt(refreshToken); err != nil {
+		return nil, fmt.Errorf("%w: refresh token: %v", ErrTokenDecryptFailed, err)
+	}
+	return token, nil
+}
+
+// TokenMigrationOptions configures MigrateTokens
+type TokenMigrationOptions struct {
+	// BatchSize is the number of rows loaded per query
+	BatchSize int
+	// DryRun counts rows that need encrypting without writing them
+	DryRun bool
+}
+
+// TokenMigrationStats reports the outcome of MigrateTokens
+type TokenMigrationStats struct {
+	EmailConnectionsScanned int
+	EmailConnectionsUpdated int
+	DriveConnectionsScanned int
+	DriveConnectionsUpdated int
+	Errors                  []string
+}
+
+// MigrateTokens encrypts plaintext tokens and re-encrypts tokens sealed with
+// an old key, so a key can be retired once the migration completes. Rows
+// already sealed with the primary key are left untouched, so the migration
+// can be re-run safely.
+func (s *TokenStore) MigrateTokens(ctx context.Context, opts TokenMigrationOptions) (*TokenMigrationStats, error) {
+	if s.keyring == nil {
+		return nil, ErrTokenKeyRequired
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 100
+	}
+
+	stats := &TokenMigrationStats{}
+	if err := s.migrateEmailConnections(ctx, opts, stats); err != nil {
+		return stats, err
+	}
+	if err := s.migrateDriveConnections(ctx, opts, stats); err != nil {
+		return stats, err
+	}
+	return stats, nil
+}
+
+// migrateEmailConnections rotates the tokens of every email connection
+func (s *TokenStore) migrateEmailConnections(ctx context.Context, opts TokenMigrationOptions, stats *TokenMigrationStats) error {
+	lastID := ""
+	for {
+		conns, err := s.entClient.EmailConnection.Query().
+			Where(emailconnection.IDGT(lastID)).
+			Order(emailconnection.ByID()).
+			Limit(opts.BatchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("listing email connections: %w", err)
+		}
+		if len(conns) == 0 {
+			return nil
+		}
+
+		for _, conn := range conns {
+			lastID = conn.ID
+			stats.EmailConnectionsScanned++
+
+			accessToken, refreshToken, changed, err := s.rotate(conn.AccessToken, conn.RefreshToken)
+			if err != nil {
+				stats.Errors = append(stats.Errors, fmt.Sprintf("email connection %s: %v", conn.ID, err))
+				continue
+			}
+			if !changed {
+				continue
+			}
+
+			if !opts.DryRun {
+				// Only update if the tokens weren't refreshed since they were read
+				n, err := s.entClient.EmailConnection.Update().
+					Where(
+						emailconnection.ID(conn.ID),
+						emailconnection.AccessToken(conn.AccessToken),
+						emailconnection.RefreshToken(conn.RefreshToken),
+					).
+					SetAccessToken(accessToken).
+					SetRefreshToken(refreshToken).
+					Save(ctx)
+				if err != nil {
+					stats.Errors = append(stats.Errors, fmt.Sprintf("email connection %s: %v", conn.ID, err))
+					continue
+				}
+				if n == 0 {
+					// Refreshed concurrently; the new tokens were sealed with the primary key
+					continue
+				}
+			}
+			stats.EmailConnectionsUpdated++
+		}
+	}
+}
+
+// migrateDriveConnections rotates the tokens of every Drive connection
+func (s *TokenStore) migrateDriveConnections(ctx context.Context, opts TokenMigrationOptions, stats *TokenMigrationStats) error {
+	lastID := ""
+	for {
+		conns, err := s.entClient.GoogleDriveConnection.Query().
+			Where(googledriveconnection.IDGT(lastID)).
+			Order(googledriveconnection.ByID()).
+			Limit(opts.BatchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("listing drive connections: %w", err)
+		}
+		if len(conns) == 0 {
+			return nil
+		}
+
+		for _, conn := range conns {
+			lastID = conn.ID
+			stats.DriveConnectionsScanned++
+
+			accessToken, refreshToken, changed, err := s.rotate(conn.AccessToken, conn.RefreshToken)
+			if err != nil {
+				stats.Errors = append(stats.Errors, fmt.Sprintf("drive connection %s: %v", conn.ID, err))
+				continue
+			}
+			if !changed {
+				continue
+			}
+
+			if !opts.DryRun {
+				// Only update if the tokens weren't refreshed since they were read
+				n, err := s.entClient.GoogleDriveConnection.Update().
+					Where(
+						googledriveconnection.ID(conn.ID),
+						googledriveconnection.AccessToken(conn.AccessToken),
+						googledriveconnection.RefreshToken(conn.RefreshToken),
+					).
+					SetAccessToken(accessToken).
+					SetRefreshToken(refreshToken).
+					Save(ctx)
+				if err != nil {
+					stats.Errors = append(stats.Errors, fmt.Sprintf("drive connection %s: %v", conn.ID, err))
+					continue
+				}
+				if n == 0 {
+					// Refreshed concurrently; the new tokens were sealed with the primary key
+					continue
+				}
+			}
+			stats.DriveConnectionsUpdated++
+		}
+	}
+}
+
+// rotate re-encrypts a stored token pair with the primary key if either
+// token is plaintext or sealed with an old key
+func (s *TokenStore) rotate(accessToken, refreshToken string) (string, string, bool, error) {
+	if !s.keyring.NeedsRotation(accessToken) && !s.keyring.NeedsRotation(refreshToken) {
+		return accessToken, refreshToken, false, nil
+	}
+
+	rotatedAccess, err := s.keyring.Rotate(accessToken)
+	if err != nil {
+		return "", "", false, fmt.Errorf("access token: %w", err)
+	}
+	rotatedRefresh, err := s.keyring.Rotate(refreshToken)
+	if err != nil {
+		return "", "", false, fmt.Errorf("refresh token: %w", err)
+	}
+	return rotatedAccess, rotatedRefresh, true, nil
+}
@@ -0,0 +1,72 @@
+package integration
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/infrastructure/encryption"
+	"clockzen-next/internal/infrastructure/google"
+)
+
+func TestTokenStoreSealAndOpen(t *testing.T) {
+	keyring, err := encryption.NewKeyring([]encryption.Key{
+		{ID: "k1", Material: bytes.Repeat([]byte{1}, 32)},
+	})
+	require.NoError(t, err)
+	store := NewTokenStore(nil, keyring)
+	expiry := time.Now().Add(time.Hour)
+
+	access, refresh, err := store.Seal(&google.Token{AccessToken: "access", RefreshToken: "refresh"})
+	require.NoError(t, err)
+	assert.True(t, encryption.IsEncrypted(access))
+	assert.True(t, encryption.IsEncrypted(refresh))
+
+	token, err := store.EmailToken(&ent.EmailConnection{AccessToken: access, RefreshToken: refresh, TokenExpiry: expiry})
+	require.NoError(t, err)
+	assert.Equal(t, "access", token.AccessToken)
+	assert.Equal(t, "refresh", token.RefreshToken)
+	assert.Equal(t, expiry, token.Expiry)
+
+	t.Run("plaintext rows pass through", func(t *testing.T) {
+		token, err := store.DriveToken(&ent.GoogleDriveConnection{AccessToken: "legacy-access", RefreshToken: "legacy-refresh"})
+		require.NoError(t, err)
+		assert.Equal(t, "legacy-access", token.AccessToken)
+		assert.Equal(t, "legacy-refresh", token.RefreshToken)
+	})
+
+	t.Run("sealed with a retired key", func(t *testing.T) {
+		other, err := encryption.NewKeyring([]encryption.Key{
+			{ID: "k2", Material: bytes.Repeat([]byte{2}, 32)},
+		})
+		require.NoError(t, err)
+		_, err = NewTokenStore(nil, other).EmailToken(&ent.EmailConnection{AccessToken: access, RefreshToken: refresh})
+		assert.ErrorIs(t, err, ErrTokenDecryptFailed)
+	})
+
+	t.Run("no keyring", func(t *testing.T) {
+		plain := NewTokenStore(nil, nil)
+		access, refresh, err := plain.Seal(&google.Token{AccessToken: "access", RefreshToken: "refresh"})
+		require.NoError(t, err)
+		assert.Equal(t, "access", access)
+		assert.Equal(t, "refresh", refresh)
+
+		_, err = plain.EmailToken(&ent.EmailConnection{AccessToken: access, RefreshToken: "enc:v1:k1:AAAA"})
+		assert.ErrorIs(t, err, ErrTokenDecryptFailed)
+	})
+}
+
+func TestNeedsReauthorization(t *testing.T) {
+	revoked := fmt.Errorf("getting token: %w", fmt.Errorf("%w: %w", google.ErrRefreshFailed,
+		&google.TokenError{StatusCode: 400, Code: "invalid_grant", Description: "Token has been expired or revoked."}))
+	assert.True(t, NeedsReauthorization(revoked))
+
+	unavailable := fmt.Errorf("%w: %w", google.ErrRefreshFailed, &google.TokenError{StatusCode: 503})
+	assert.False(t, NeedsReauthorization(unavailable))
+	assert.False(t, NeedsReauthorization(nil))
+}
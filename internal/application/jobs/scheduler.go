@@ -0,0 +1,771 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/jobschedule"
+	"clockzen-next/internal/ent/jobschedulerun"
+
+	"github.com/google/uuid"
+)
+
+// Scheduler errors
+var (
+	ErrScheduleNotFound      = errors.New("schedule not found")
+	ErrInvalidFrequency      = errors.New("invalid schedule frequency")
+	ErrInvalidScheduleParams = errors.New("invalid schedule params")
+	ErrNoRunnerForJobType    = errors.New("job type cannot be scheduled")
+	ErrSchedulerNotRunning   = errors.New("scheduler is not running")
+	ErrScheduleLimitReached  = errors.New("schedule limit reached")
+	ErrInvalidRunner         = errors.New("runner params function is required")
+)
+
+// Job types that only run on a schedule
+const (
+	// JobTypeAnomalyScan scans recent spending for anomalies
+	JobTypeAnomalyScan JobType = "anomaly_scan"
+
+	// JobTypeBillNegotiationScan looks for recurring bills whose price went
+	// up year over year
+	JobTypeBillNegotiationScan JobType = "bill_negotiation_scan"
+
+	// JobTypeRaiseCaptureScan looks for raises and suggests saving part of
+	// them toward retirement
+	JobTypeRaiseCaptureScan JobType = "raise_capture_scan"
+)
+
+// Frequency controls how often a schedule runs
+type Frequency string
+
+const (
+	FrequencyDaily     Frequency = "daily"
+	FrequencyWeekly    Frequency = "weekly"
+	FrequencyMonthly   Frequency = "monthly"
+	FrequencyQuarterly Frequency = "quarterly"
+)
+
+// IsValid reports whether the frequency is supported
+func (f Frequency) IsValid() bool {
+	switch f {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyQuarterly:
+		return true
+	default:
+		return false
+	}
+}
+
+// Next returns the first run time after t
+func (f Frequency) Next(t time.Time) time.Time {
+	switch f {
+	case FrequencyDaily:
+		return t.AddDate(0, 0, 1)
+	case FrequencyWeekly:
+		return t.AddDate(0, 0, 7)
+	case FrequencyMonthly:
+		return t.AddDate(0, 1, 0)
+	case FrequencyQuarterly:
+		return t.AddDate(0, 3, 0)
+	default:
+		return t
+	}
+}
+
+// Schedule is a user-defined recurring job
+type Schedule struct {
+	ID                  string
+	UserID              string
+	Name                string
+	JobType             JobType
+	Frequency           Frequency
+	Params              json.RawMessage
+	Enabled             bool
+	NextRunAt           time.Time
+	LastRunAt           *time.Time
+	LastStatus          JobStatus
+	LastError           string
+	ConsecutiveFailures int
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// ScheduleRun records one execution of a schedule
+type ScheduleRun struct {
+	ID          string
+	JobID       string
+	ScheduleID  string
+	Status      JobStatus
+	Error       string
+	StartedAt   time.Time
+	CompletedAt *time.Time
+}
+
+// Runner prepares the scheduled runs of a job type. Params builds the
+// parameters of a run's job from the schedule; it is also called when a
+// schedule is created or updated so invalid parameters are rejected up
+// front. OnComplete, if set, is called with the job of each run that
+// completes, once, in whichever scheduling process records the outcome.
+type Runner struct {
+	Params     func(schedule *Schedule) (any, error)
+	OnComplete func(schedule Schedule, job *Job)
+}
+
+// FailureAlertFunc is called when a scheduled run fails
+type FailureAlertFunc func(schedule Schedule, run ScheduleRun)
+
+// SchedulerConfig holds configuration for the scheduler
+type SchedulerConfig struct {
+	// PollInterval is how often due schedules and unfinished runs are
+	// checked
+	PollInterval time.Duration
+	// HistorySize is the number of runs kept per schedule
+	HistorySize int
+	// MaxSchedulesPerUser limits how many schedules a user may create
+	MaxSchedulesPerUser int
+	// DisableAfterFailures pauses a schedule after this many consecutive
+	// failures. Zero never pauses.
+	DisableAfterFailures int
+}
+
+// DefaultSchedulerConfig returns sensible default configuration
+func DefaultSchedulerConfig() SchedulerConfig {
+	return SchedulerConfig{
+		PollInterval:         time.Minute,
+		HistorySize:          50,
+		MaxSchedulesPerUser:  25,
+		DisableAfterFailures: 5,
+	}
+}
+
+// Scheduler submits jobs for recurring schedules to the job service.
+// Schedules and their run history are stored in the database, so they
+// survive restarts and every API instance serves the same schedules. Each
+// instance checks for due schedules; a run is claimed by advancing the
+// schedule's next run, so it is submitted by exactly one of them.
+type Scheduler struct {
+	config    SchedulerConfig
+	service   *Service
+	entClient *ent.Client
+
+	mu        sync.RWMutex
+	running   bool
+	runners   map[JobType]Runner
+	onFailure FailureAlertFunc
+	stopCh    chan struct{}
+	wg        sync.WaitGroup
+}
+
+// NewScheduler creates a new scheduler that runs jobs on service
+func NewScheduler(service *Service, config SchedulerConfig) *Scheduler {
+	return &Scheduler{
+		config:    config,
+		service:   service,
+		entClient: service.entClient,
+		runners:   make(map[JobType]Runner),
+		stopCh:    make(chan struct{}),
+	}
+}
+
+// NewSchedulerWithDefaults creates a scheduler with default configuration
+func NewSchedulerWithDefaults(service *Service) *Scheduler {
+	return NewScheduler(service, DefaultSchedulerConfig())
+}
+
+// RegisterRunner makes a job type schedulable
+func (s *Scheduler) RegisterRunner(jobType JobType, runner Runner) error {
+	if runner.Params == nil {
+		return ErrInvalidRunner
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.runners[jobType] = runner
+	return nil
+}
+
+// SetOnFailure sets the callback for failed scheduled runs
+func (s *Scheduler) SetOnFailure(callback FailureAlertFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onFailure = callback
+}
+
+// Start begins checking for due schedules
+func (s *Scheduler) Start(ctx context.Context) error {
+	s.mu.Lock()
+	if s.running {
+		s.mu.Unlock()
+		return nil
+	}
+	s.running = true
+	s.stopCh = make(chan struct{})
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go s.pollLoop(ctx)
+
+	return nil
+}
+
+// Stop stops the scheduler; jobs already submitted keep running
+func (s *Scheduler) Stop() error {
+	s.mu.Lock()
+	if !s.running {
+		s.mu.Unlock()
+		return ErrSchedulerNotRunning
+	}
+	s.running = false
+	close(s.stopCh)
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	return nil
+}
+
+// Create adds a schedule. The first run is at startAt, or one period from
+// now when startAt is zero.
+func (s *Scheduler) Create(ctx context.Context, userID, name string, jobType JobType, frequency Frequency, params json.RawMessage, startAt time.Time) (*Schedule, error) {
+	if !frequency.IsValid() {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidFrequency, frequency)
+	}
+
+	now := time.Now()
+	if startAt.IsZero() {
+		startAt = frequency.Next(now)
+	}
+
+	schedule := Schedule{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		Name:      name,
+		JobType:   jobType,
+		Frequency: frequency,
+		Params:    params,
+		Enabled:   true,
+		NextRunAt: startAt,
+	}
+	if err := s.validate(&schedule); err != nil {
+		return nil, err
+	}
+
+	if s.config.MaxSchedulesPerUser > 0 {
+		count, err := s.entClient.JobSchedule.Query().
+			Where(jobschedule.UserID(userID)).
+			Count(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("counting schedules: %w", err)
+		}
+		if count >= s.config.MaxSchedulesPerUser {
+			return nil, ErrScheduleLimitReached
+		}
+	}
+
+	record, err := s.entClient.JobSchedule.Create().
+		SetID(schedule.ID).
+		SetUserID(userID).
+		SetName(name).
+		SetJobType(string(jobType)).
+		SetFrequency(jobschedule.Frequency(frequency)).
+		SetParams(params).
+		SetNextRunAt(startAt).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating schedule: %w", err)
+	}
+	return scheduleFromRecord(record), nil
+}
+
+// ScheduleUpdate holds optional changes to a schedule
+type ScheduleUpdate struct {
+	Name      *string
+	Frequency *Frequency
+	Params    json.RawMessage
+	Enabled   *bool
+	NextRunAt *time.Time
+}
+
+// Update applies changes to a schedule. Only the changed fields are saved,
+// so the outcome of a run recorded meanwhile is kept.
+func (s *Scheduler) Update(ctx context.Context, scheduleID string, update ScheduleUpdate) (*Schedule, error) {
+	updated, err := s.Get(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.Name != nil {
+		updated.Name = *update.Name
+	}
+	if update.Frequency != nil {
+		if !update.Frequency.IsValid() {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidFrequency, *update.Frequency)
+		}
+		updated.Frequency = *update.Frequency
+	}
+	if update.Params != nil {
+		updated.Params = update.Params
+	}
+	if update.Enabled != nil {
+		updated.Enabled = *update.Enabled
+	}
+	if update.NextRunAt != nil {
+		updated.NextRunAt = *update.NextRunAt
+	}
+	if err := s.validate(updated); err != nil {
+		return nil, err
+	}
+
+	query := s.entClient.JobSchedule.UpdateOneID(scheduleID).
+		SetName(updated.Name).
+		SetFrequency(jobschedule.Frequency(updated.Frequency)).
+		SetParams(updated.Params).
+		SetNextRunAt(updated.NextRunAt)
+	if update.Enabled != nil {
+		query.SetEnabled(*update.Enabled)
+		if *update.Enabled {
+			// Re-enabling clears the failure streak that may have paused it
+			query.SetConsecutiveFailures(0)
+		}
+	}
+	record, err := query.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrScheduleNotFound
+		}
+		return nil, fmt.Errorf("updating schedule: %w", err)
+	}
+	return scheduleFromRecord(record), nil
+}
+
+// Delete removes a schedule and its history
+func (s *Scheduler) Delete(ctx context.Context, scheduleID string) error {
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.JobScheduleRun.Delete().Where(jobschedulerun.ScheduleID(scheduleID)).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting schedule runs: %w", err)
+	}
+	if err := tx.JobSchedule.DeleteOneID(scheduleID).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return ErrScheduleNotFound
+		}
+		return fmt.Errorf("deleting schedule: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing schedule deletion: %w", err)
+	}
+	return nil
+}
+
+// Get returns a snapshot of a schedule
+func (s *Scheduler) Get(ctx context.Context, scheduleID string) (*Schedule, error) {
+	record, err := s.entClient.JobSchedule.Get(ctx, scheduleID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrScheduleNotFound
+		}
+		return nil, fmt.Errorf("getting schedule: %w", err)
+	}
+	return scheduleFromRecord(record), nil
+}
+
+// List returns the schedules owned by userID, ordered by next run
+func (s *Scheduler) List(ctx context.Context, userID string) ([]*Schedule, error) {
+	records, err := s.entClient.JobSchedule.Query().
+		Where(jobschedule.UserID(userID)).
+		Order(jobschedule.ByNextRunAt(), jobschedule.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing schedules: %w", err)
+	}
+
+	schedules := make([]*Schedule, len(records))
+	for i, record := range records {
+		schedules[i] = scheduleFromRecord(record)
+	}
+	return schedules, nil
+}
+
+// History returns a schedule's runs, newest first
+func (s *Scheduler) History(ctx context.Context, scheduleID string) ([]ScheduleRun, error) {
+	if _, err := s.Get(ctx, scheduleID); err != nil {
+		return nil, err
+	}
+
+	records, err := s.entClient.JobScheduleRun.Query().
+		Where(jobschedulerun.ScheduleID(scheduleID)).
+		Order(ent.Desc(jobschedulerun.FieldStartedAt), ent.Desc(jobschedulerun.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing schedule runs: %w", err)
+	}
+
+	runs := make([]ScheduleRun, len(records))
+	for i, record := range records {
+		runs[i] = runFromRecord(record)
+	}
+	return runs, nil
+}
+
+// RunNow submits a schedule's job immediately without moving its next run
+func (s *Scheduler) RunNow(ctx context.Context, scheduleID string) (*Job, error) {
+	schedule, err := s.Get(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	return s.submit(ctx, *schedule)
+}
+
+// validate checks that the schedule's job type is schedulable and that
+// its parameters are accepted by the runner
+func (s *Scheduler) validate(schedule *Schedule) error {
+	s.mu.RLock()
+	runner, ok := s.runners[schedule.JobType]
+	s.mu.RUnlock()
+
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNoRunnerForJobType, schedule.JobType)
+	}
+	if _, err := runner.Params(schedule); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidScheduleParams, err)
+	}
+	return nil
+}
+
+// pollLoop submits due schedules and records the outcome of finished runs
+// until the scheduler stops
+func (s *Scheduler) pollLoop(ctx context.Context) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			return
+		case now := <-ticker.C:
+			s.RunDue(ctx, now)
+			s.SettleRuns(ctx)
+		}
+	}
+}
+
+// RunDue submits every enabled schedule whose next run is at or before now
+// and advances it to its following run. It returns the number submitted.
+// A schedule is only submitted by the process that advances it, so
+// processes checking at the same time don't both submit it.
+func (s *Scheduler) RunDue(ctx context.Context, now time.Time) int {
+	due, err := s.entClient.JobSchedule.Query().
+		Where(
+			jobschedule.Enabled(true),
+			jobschedule.NextRunAtLTE(now),
+		).
+		All(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "checking for due schedules", "error", err)
+		return 0
+	}
+
+	submitted := 0
+	for _, record := range due {
+		claimed, err := s.entClient.JobSchedule.Update().
+			Where(
+				jobschedule.ID(record.ID),
+				jobschedule.Enabled(true),
+				jobschedule.NextRunAt(record.NextRunAt),
+			).
+			SetNextRunAt(nextRunAfter(Frequency(record.Frequency), record.NextRunAt, now)).
+			Save(ctx)
+		if err != nil {
+			slog.WarnContext(ctx, "claiming due schedule", "schedule_id", record.ID, "error", err)
+			continue
+		}
+		if claimed == 0 {
+			// Another process claimed the run, or the schedule was changed
+			continue
+		}
+		if _, err := s.submit(ctx, *scheduleFromRecord(record)); err == nil {
+			submitted++
+		}
+	}
+	return submitted
+}
+
+// nextRunAfter returns the first run of a schedule after now, skipping runs
+// missed while no process was checking rather than submitting a backlog
+func nextRunAfter(frequency Frequency, next, now time.Time) time.Time {
+	for !next.After(now) {
+		next = frequency.Next(next)
+	}
+	return next
+}
+
+// submit builds and queues the job for a schedule and records the run
+func (s *Scheduler) submit(ctx context.Context, schedule Schedule) (*Job, error) {
+	s.mu.RLock()
+	runner, ok := s.runners[schedule.JobType]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNoRunnerForJobType, schedule.JobType)
+	}
+
+	startedAt := time.Now()
+	params, err := runner.Params(&schedule)
+	if err == nil {
+		var job *Job
+		job, err = s.service.Submit(ctx, schedule.UserID, schedule.JobType, params)
+		if err == nil {
+			record, recordErr := s.entClient.JobScheduleRun.Create().
+				SetID(uuid.New().String()).
+				SetScheduleID(schedule.ID).
+				SetUserID(schedule.UserID).
+				SetJobID(job.ID).
+				SetStatus(jobschedulerun.Status(job.Status)).
+				SetStartedAt(startedAt).
+				Save(ctx)
+			if recordErr != nil {
+				slog.WarnContext(ctx, "recording schedule run", "schedule_id", schedule.ID, "job_id", job.ID, "error", recordErr)
+				return job, nil
+			}
+			s.trimHistory(ctx, schedule.ID)
+			go s.trackJob(record)
+			return job, nil
+		}
+	}
+
+	// The job never ran; record the failure so it shows in history
+	completedAt := time.Now()
+	record, recordErr := s.entClient.JobScheduleRun.Create().
+		SetID(uuid.New().String()).
+		SetScheduleID(schedule.ID).
+		SetUserID(schedule.UserID).
+		SetStatus(jobschedulerun.StatusFailed).
+		SetError(err.Error()).
+		SetStartedAt(startedAt).
+		SetCompletedAt(completedAt).
+		Save(ctx)
+	if recordErr != nil {
+		slog.WarnContext(ctx, "recording schedule run", "schedule_id", schedule.ID, "error", recordErr)
+		return nil, err
+	}
+	s.trimHistory(ctx, schedule.ID)
+	s.finishSchedule(ctx, runFromRecord(record))
+	return nil, err
+}
+
+// trackJob waits for a scheduled job to finish and records its outcome.
+// Runs whose process stops before their job finishes are recorded by
+// SettleRuns instead.
+func (s *Scheduler) trackJob(record *ent.JobScheduleRun) {
+	ctx := context.Background()
+	job, err := s.service.Await(ctx, *record.JobID)
+	if err != nil {
+		return
+	}
+	s.settle(ctx, record, job)
+}
+
+// SettleRuns records the outcome of every run whose job has finished but
+// whose outcome hasn't been recorded yet. It returns the number recorded.
+func (s *Scheduler) SettleRuns(ctx context.Context) int {
+	records, err := s.entClient.JobScheduleRun.Query().
+		Where(
+			jobschedulerun.StatusIn(jobschedulerun.StatusPending, jobschedulerun.StatusRunning),
+			jobschedulerun.JobIDNotNil(),
+		).
+		All(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "checking unfinished schedule runs", "error", err)
+		return 0
+	}
+
+	settled := 0
+	for _, record := range records {
+		job, err := s.service.Get(ctx, *record.JobID)
+		if errors.Is(err, ErrJobNotFound) {
+			// The job was deleted along with its result
+			completedAt := time.Now()
+			job = &Job{ID: *record.JobID, Status: JobStatusFailed, Error: err.Error(), CompletedAt: &completedAt}
+		} else if err != nil {
+			slog.WarnContext(ctx, "getting scheduled job", "job_id", *record.JobID, "error", err)
+			continue
+		}
+		if s.settle(ctx, record, job) {
+			settled++
+		}
+	}
+	return settled
+}
+
+// settle records the outcome of a run's job once the job has finished,
+// handing a completed job to the runner. Only the process that moves the
+// run out of pending or running records it, so the runner and the failure
+// alert see each outcome once. It reports whether this call recorded it.
+func (s *Scheduler) settle(ctx context.Context, record *ent.JobScheduleRun, job *Job) bool {
+	if !job.Status.IsTerminal() {
+		if string(job.Status) != string(record.Status) {
+			err := s.entClient.JobScheduleRun.Update().
+				Where(
+					jobschedulerun.ID(record.ID),
+					jobschedulerun.StatusIn(jobschedulerun.StatusPending, jobschedulerun.StatusRunning),
+				).
+				SetStatus(jobschedulerun.Status(job.Status)).
+				Exec(ctx)
+			if err != nil {
+				slog.WarnContext(ctx, "updating schedule run", "run_id", record.ID, "error", err)
+			}
+		}
+		return false
+	}
+
+	completedAt := time.Now()
+	if job.CompletedAt != nil {
+		completedAt = *job.CompletedAt
+	}
+	claimed, err := s.entClient.JobScheduleRun.Update().
+		Where(
+			jobschedulerun.ID(record.ID),
+			jobschedulerun.StatusIn(jobschedulerun.StatusPending, jobschedulerun.StatusRunning),
+		).
+		SetStatus(jobschedulerun.Status(job.Status)).
+		SetError(job.Error).
+		SetCompletedAt(completedAt).
+		Save(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "recording schedule run", "run_id", record.ID, "error", err)
+		return false
+	}
+	if claimed == 0 {
+		return false
+	}
+
+	schedule, err := s.Get(ctx, record.ScheduleID)
+	if err != nil {
+		// Deleted while the job ran
+		return true
+	}
+
+	s.mu.RLock()
+	runner := s.runners[schedule.JobType]
+	s.mu.RUnlock()
+	if job.Status == JobStatusCompleted && runner.OnComplete != nil {
+		runner.OnComplete(*schedule, job)
+	}
+
+	run := runFromRecord(record)
+	run.Status = job.Status
+	run.Error = job.Error
+	run.CompletedAt = &completedAt
+	s.finishSchedule(ctx, run)
+	return true
+}
+
+// finishSchedule records a finished run's outcome on its schedule,
+// updating the failure streak and raising an alert when the run failed
+func (s *Scheduler) finishSchedule(ctx context.Context, run ScheduleRun) {
+	failed := run.Status == JobStatusFailed
+	query := s.entClient.JobSchedule.UpdateOneID(run.ScheduleID).
+		SetNillableLastRunAt(run.CompletedAt).
+		SetLastStatus(string(run.Status)).
+		SetLastError(run.Error)
+	if failed {
+		query.AddConsecutiveFailures(1)
+	} else if run.Status == JobStatusCompleted {
+		query.SetConsecutiveFailures(0)
+	}
+	record, err := query.Save(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			slog.WarnContext(ctx, "recording schedule outcome", "schedule_id", run.ScheduleID, "error", err)
+		}
+		return
+	}
+	if !failed {
+		return
+	}
+
+	if s.config.DisableAfterFailures > 0 && record.ConsecutiveFailures >= s.config.DisableAfterFailures && record.Enabled {
+		record, err = s.entClient.JobSchedule.UpdateOneID(run.ScheduleID).
+			SetEnabled(false).
+			Save(ctx)
+		if err != nil {
+			slog.WarnContext(ctx, "pausing failing schedule", "schedule_id", run.ScheduleID, "error", err)
+			return
+		}
+	}
+
+	s.mu.RLock()
+	callback := s.onFailure
+	s.mu.RUnlock()
+	if callback != nil {
+		callback(*scheduleFromRecord(record), run)
+	}
+}
+
+// trimHistory deletes a schedule's runs beyond the configured history
+// size, oldest first
+func (s *Scheduler) trimHistory(ctx context.Context, scheduleID string) {
+	if s.config.HistorySize <= 0 {
+		return
+	}
+	ids, err := s.entClient.JobScheduleRun.Query().
+		Where(jobschedulerun.ScheduleID(scheduleID)).
+		Order(ent.Desc(jobschedulerun.FieldStartedAt), ent.Desc(jobschedulerun.FieldID)).
+		Offset(s.config.HistorySize).
+		IDs(ctx)
+	if err == nil && len(ids) > 0 {
+		_, err = s.entClient.JobScheduleRun.Delete().
+			Where(jobschedulerun.IDIn(ids...)).
+			Exec(ctx)
+	}
+	if err != nil {
+		slog.WarnContext(ctx, "trimming schedule history", "schedule_id", scheduleID, "error", err)
+	}
+}
+
+// scheduleFromRecord converts a stored schedule to a schedule snapshot
+func scheduleFromRecord(record *ent.JobSchedule) *Schedule {
+	return &Schedule{
+		ID:                  record.ID,
+		UserID:              record.UserID,
+		Name:                record.Name,
+		JobType:             JobType(record.JobType),
+		Frequency:           Frequency(record.Frequency),
+		Params:              record.Params,
+		Enabled:             record.Enabled,
+		NextRunAt:           record.NextRunAt,
+		LastRunAt:           record.LastRunAt,
+		LastStatus:          JobStatus(record.LastStatus),
+		LastError:           record.LastError,
+		ConsecutiveFailures: record.ConsecutiveFailures,
+		CreatedAt:           record.CreatedAt,
+		UpdatedAt:           record.UpdatedAt,
+	}
+}
+
+// runFromRecord converts a stored run to a run snapshot
+func runFromRecord(record *ent.JobScheduleRun) ScheduleRun {
+	run := ScheduleRun{
+		ID:          record.ID,
+		ScheduleID:  record.ScheduleID,
+		Status:      JobStatus(record.Status),
+		Error:       record.Error,
+		StartedAt:   record.StartedAt,
+		CompletedAt: record.CompletedAt,
+	}
+	if record.JobID != nil {
+		run.JobID = *record.JobID
+	}
+	return run
+}
@@ -0,0 +1,61 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrequencyNext(t *testing.T) {
+	start := time.Date(2026, time.January, 31, 9, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, start.AddDate(0, 0, 1), FrequencyDaily.Next(start))
+	assert.Equal(t, start.AddDate(0, 0, 7), FrequencyWeekly.Next(start))
+	assert.Equal(t, start.AddDate(0, 1, 0), FrequencyMonthly.Next(start))
+	assert.Equal(t, start.AddDate(0, 3, 0), FrequencyQuarterly.Next(start))
+
+	assert.True(t, FrequencyWeekly.IsValid())
+	assert.False(t, Frequency("hourly").IsValid())
+}
+
+func TestNextRunAfter(t *testing.T) {
+	next := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+
+	// A run that is due moves to the following period
+	assert.Equal(t, next.AddDate(0, 0, 7), nextRunAfter(FrequencyWeekly, next, next))
+
+	// Runs missed while nothing was checking are skipped, not caught up
+	now := next.AddDate(0, 0, 20)
+	assert.Equal(t, next.AddDate(0, 0, 21), nextRunAfter(FrequencyWeekly, next, now))
+}
+
+func TestSchedulerValidation(t *testing.T) {
+	scheduler := NewSchedulerWithDefaults(&Service{})
+	ctx := context.Background()
+
+	assert.ErrorIs(t, scheduler.RegisterRunner(JobTypeAnomalyScan, Runner{}), ErrInvalidRunner)
+	require.NoError(t, scheduler.RegisterRunner(JobTypeAnomalyScan, Runner{
+		Params: func(schedule *Schedule) (any, error) {
+			if string(schedule.Params) == `{"lookback_days":-1}` {
+				return nil, errors.New("lookback_days cannot be negative")
+			}
+			return schedule.Params, nil
+		},
+	}))
+
+	// Schedules are checked before anything is stored
+	_, err := scheduler.Create(ctx, "user-1", "Scan", JobTypeAnomalyScan, Frequency("hourly"), nil, time.Time{})
+	assert.ErrorIs(t, err, ErrInvalidFrequency)
+
+	_, err = scheduler.Create(ctx, "user-1", "Scan", JobTypeTaxStrategies, FrequencyDaily, nil, time.Time{})
+	assert.ErrorIs(t, err, ErrNoRunnerForJobType)
+
+	_, err = scheduler.Create(ctx, "user-1", "Scan", JobTypeAnomalyScan, FrequencyDaily, []byte(`{"lookback_days":-1}`), time.Time{})
+	assert.ErrorIs(t, err, ErrInvalidScheduleParams)
+
+	assert.ErrorIs(t, scheduler.Stop(), ErrSchedulerNotRunning)
+}
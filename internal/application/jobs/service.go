@@ -0,0 +1,419 @@
+// Package jobs runs long-running analyses in the background so HTTP requests
+// can return immediately and clients can retrieve results later.
+//
+// Jobs are stored in the database job queue. The API submits and reads them
+// and the worker process runs them, so a job survives restarts and is
+// visible to every API instance.
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/queuedjob"
+	"clockzen-next/internal/infrastructure/queue"
+)
+
+// QueueName is the job queue analysis jobs run on
+const QueueName = "analysis_jobs"
+
+// Job service errors
+var (
+	ErrJobNotFound         = errors.New("job not found")
+	ErrJobFinished         = errors.New("job has already finished")
+	ErrInvalidJobHandler   = errors.New("job handler is required")
+	ErrNoHandlerForJobType = errors.New("no handler for job type")
+)
+
+// JobType identifies the kind of analysis a job runs
+type JobType string
+
+const (
+	JobTypeMonteCarlo     JobType = "monte_carlo"
+	JobTypeBudgetBacktest JobType = "budget_backtest"
+	JobTypeScenarioBatch  JobType = "scenario_batch"
+	JobTypeTaxStrategies  JobType = "tax_strategies"
+)
+
+// JobStatus represents the lifecycle state of a job
+type JobStatus string
+
+const (
+	JobStatusPending   JobStatus = "pending"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusCompleted JobStatus = "completed"
+	JobStatusFailed    JobStatus = "failed"
+	JobStatusCancelled JobStatus = "cancelled"
+)
+
+// IsTerminal reports whether the status is final
+func (s JobStatus) IsTerminal() bool {
+	switch s {
+	case JobStatusCompleted, JobStatusFailed, JobStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// Job is a snapshot of a background job
+type Job struct {
+	ID          string
+	UserID      string
+	Type        JobType
+	Status      JobStatus
+	Progress    float64 // 0 to 1
+	Params      json.RawMessage
+	Result      json.RawMessage // partial until the job completes
+	Error       string
+	CreatedAt   time.Time
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+
+	// partial records a partial result while a worker runs the job
+	partial func(result any)
+}
+
+// DecodeParams unmarshals the parameters the job was submitted with into v
+func (j *Job) DecodeParams(v any) error {
+	if len(j.Params) == 0 {
+		return nil
+	}
+	return json.Unmarshal(j.Params, v)
+}
+
+// DecodeResult unmarshals a completed job's result into v
+func (j *Job) DecodeResult(v any) error {
+	if len(j.Result) == 0 {
+		return nil
+	}
+	return json.Unmarshal(j.Result, v)
+}
+
+// ReportPartialResult stores what the job has produced so far, for clients
+// to see before it completes. It is only recorded while a worker runs the
+// job and is replaced by what the job's handler returns.
+func (j *Job) ReportPartialResult(result any) {
+	if j.partial != nil {
+		j.partial(result)
+	}
+}
+
+// ProgressFunc reports job progress as a fraction between 0 and 1
+type ProgressFunc func(progress float64)
+
+// Handler performs the work of a job from its parameters. What it returns
+// is stored as the job's result. It should return promptly with the
+// context's error once ctx is cancelled.
+type Handler func(ctx context.Context, job *Job, report ProgressFunc) (any, error)
+
+// Config holds configuration for the job service
+type Config struct {
+	// Workers is the number of jobs a worker process runs concurrently
+	Workers int
+	// JobTimeout is the maximum time a single job may run
+	JobTimeout time.Duration
+	// WatchInterval is how often a watched job is reloaded
+	WatchInterval time.Duration
+	// ProgressStep is the smallest change in progress that is stored, so
+	// fine-grained reports don't write to the database on every call
+	ProgressStep float64
+}
+
+// DefaultConfig returns sensible default configuration
+func DefaultConfig() Config {
+	return Config{
+		Workers:       4,
+		JobTimeout:    30 * time.Minute,
+		WatchInterval: time.Second,
+		ProgressStep:  0.01,
+	}
+}
+
+// Service submits jobs to the database job queue and, in the worker
+// process, runs them with the handlers registered for their type
+type Service struct {
+	config    Config
+	entClient *ent.Client
+	queue     *queue.Queue
+
+	mu       sync.RWMutex
+	running  bool
+	handlers map[JobType]Handler
+}
+
+// NewService creates a new job service on the given queue
+func NewService(entClient *ent.Client, q *queue.Queue, config Config) *Service {
+	return &Service{
+		config:    config,
+		entClient: entClient,
+		queue:     q,
+		handlers:  make(map[JobType]Handler),
+	}
+}
+
+// NewServiceWithDefaults creates a service with default configuration
+func NewServiceWithDefaults(entClient *ent.Client, q *queue.Queue) *Service {
+	return NewService(entClient, q, DefaultConfig())
+}
+
+// RegisterHandler sets the handler that runs jobs of the given type. Only
+// the worker process registers handlers.
+func (s *Service) RegisterHandler(jobType JobType, handler Handler) error {
+	if handler == nil {
+		return ErrInvalidJobHandler
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[jobType] = handler
+	return nil
+}
+
+// Start registers the service with its queue so that jobs are run by this
+// process once the queue is started
+func (s *Service) Start(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		return nil
+	}
+
+	err := s.queue.Register(ctx, QueueName, s.run, queue.HandlerOptions{
+		Concurrency: s.config.Workers,
+		Timeout:     s.config.JobTimeout,
+	})
+	if err != nil {
+		return fmt.Errorf("registering job handler: %w", err)
+	}
+	s.running = true
+	return nil
+}
+
+// IsRunning reports whether this process runs jobs
+func (s *Service) IsRunning() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.running
+}
+
+// Submit queues a job owned by userID. The params are stored as JSON and
+// decoded by the job type's handler on the worker.
+func (s *Service) Submit(ctx context.Context, userID string, jobType JobType, params any) (*Job, error) {
+	record, err := s.queue.Enqueue(ctx, QueueName, string(jobType), params, queue.EnqueueOptions{
+		OwnerID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return jobFromRecord(record), nil
+}
+
+// Get returns a snapshot of a job
+func (s *Service) Get(ctx context.Context, jobID string) (*Job, error) {
+	record, err := s.queue.GetJob(ctx, QueueName, jobID)
+	if err != nil {
+		if errors.Is(err, queue.ErrJobNotFound) {
+			return nil, ErrJobNotFound
+		}
+		return nil, err
+	}
+	return jobFromRecord(record), nil
+}
+
+// List returns the jobs owned by userID, newest first
+func (s *Service) List(ctx context.Context, userID string) ([]*Job, error) {
+	records, err := s.entClient.QueuedJob.Query().
+		Where(
+			queuedjob.Queue(QueueName),
+			queuedjob.OwnerID(userID),
+		).
+		Order(ent.Desc(queuedjob.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing jobs: %w", err)
+	}
+
+	jobs := make([]*Job, len(records))
+	for i, record := range records {
+		jobs[i] = jobFromRecord(record)
+	}
+	return jobs, nil
+}
+
+// Cancel stops a pending or running job. A running job is stopped by its
+// worker at the next heartbeat.
+func (s *Service) Cancel(ctx context.Context, jobID string) (*Job, error) {
+	record, err := s.queue.CancelJob(ctx, QueueName, jobID)
+	if err != nil {
+		switch {
+		case errors.Is(err, queue.ErrJobNotFound):
+			return nil, ErrJobNotFound
+		case errors.Is(err, queue.ErrJobNotCancellable):
+			return nil, ErrJobFinished
+		}
+		return nil, err
+	}
+	return jobFromRecord(record), nil
+}
+
+// Watch returns a channel that receives a snapshot of the job each time it
+// changes, starting with its current state. The job is reloaded every
+// WatchInterval. The channel is closed once the job finishes or ctx is done.
+func (s *Service) Watch(ctx context.Context, jobID string) (<-chan Job, error) {
+	job, err := s.Get(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan Job, 1)
+	ch <- *job
+	if job.Status.IsTerminal() {
+		close(ch)
+		return ch, nil
+	}
+
+	go func() {
+		defer close(ch)
+
+		ticker := time.NewTicker(s.config.WatchInterval)
+		defer ticker.Stop()
+
+		last := *job
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			current, err := s.Get(ctx, jobID)
+			if err != nil {
+				// Deleted, or the database is unreachable; watchers see the
+				// last state they were sent
+				return
+			}
+			if current.Status != last.Status || current.Progress != last.Progress || !bytes.Equal(current.Result, last.Result) {
+				select {
+				case ch <- *current:
+				case <-ctx.Done():
+					return
+				}
+				last = *current
+			}
+			if current.Status.IsTerminal() {
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// Await blocks until the job finishes and returns its final state
+func (s *Service) Await(ctx context.Context, jobID string) (*Job, error) {
+	updates, err := s.Watch(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	var last Job
+	for job := range updates {
+		last = job
+	}
+	if !last.Status.IsTerminal() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return nil, ErrJobNotFound
+	}
+	return &last, nil
+}
+
+// run is the queue handler: it runs a claimed job with the handler for its
+// type and stores its progress as it goes
+func (s *Service) run(ctx context.Context, queued *queue.Job) (any, error) {
+	s.mu.RLock()
+	handler, ok := s.handlers[JobType(queued.Type)]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNoHandlerForJobType, queued.Type)
+	}
+
+	job := &Job{
+		ID:        queued.ID,
+		UserID:    queued.OwnerID,
+		Type:      JobType(queued.Type),
+		Status:    JobStatusRunning,
+		Params:    queued.Payload,
+		CreatedAt: queued.CreatedAt,
+	}
+
+	// Handlers may report from several goroutines at once; partial results
+	// are recorded in the order they are reported
+	var mu sync.Mutex
+	var stored float64
+	report := func(progress float64) {
+		mu.Lock()
+		defer mu.Unlock()
+		if progress <= stored || (progress < 1 && progress-stored < s.config.ProgressStep) {
+			return
+		}
+		stored = progress
+		if err := s.queue.ReportProgress(ctx, job.ID, progress); err != nil && ctx.Err() == nil {
+			slog.WarnContext(ctx, "recording job progress", "job_id", job.ID, "error", err)
+		}
+	}
+
+	job.partial = func(result any) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err := s.queue.ReportPartialResult(ctx, job.ID, result); err != nil && ctx.Err() == nil {
+			slog.WarnContext(ctx, "recording partial job result", "job_id", job.ID, "error", err)
+		}
+	}
+
+	return handler(ctx, job, report)
+}
+
+// jobFromRecord converts a queued job record to a job snapshot
+func jobFromRecord(record *ent.QueuedJob) *Job {
+	job := &Job{
+		ID:        record.ID,
+		Type:      JobType(record.Type),
+		Progress:  record.Progress,
+		Params:    record.Payload,
+		Result:    record.Result,
+		CreatedAt: record.CreatedAt,
+		StartedAt: record.StartedAt,
+	}
+	if record.OwnerID != nil {
+		job.UserID = *record.OwnerID
+	}
+	if record.Error != nil {
+		job.Error = *record.Error
+	}
+
+	switch record.Status {
+	case queuedjob.StatusProcessing:
+		job.Status = JobStatusRunning
+	case queuedjob.StatusCompleted:
+		job.Status = JobStatusCompleted
+		job.CompletedAt = record.CompletedAt
+	case queuedjob.StatusFailed:
+		job.Status = JobStatusFailed
+		job.CompletedAt = record.CompletedAt
+	case queuedjob.StatusCancelled:
+		job.Status = JobStatusCancelled
+		job.CompletedAt = record.CancelledAt
+	default:
+		job.Status = JobStatusPending
+	}
+	return job
+}
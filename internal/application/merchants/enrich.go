@@ -0,0 +1,57 @@
+package merchants
+
+import (
+	"context"
+	"strings"
+
+	"clockzen-next/internal/application/analysis"
+)
+
+// Enrichment is what is known about a merchant beyond its name. Empty
+// fields weren't found.
+type Enrichment struct {
+	Category analysis.SpendingCategory
+	LogoURL  string
+	Website  string
+}
+
+// Enricher looks up a merchant by its normalized name, e.g. in a merchant
+// data provider. It returns nil when it knows nothing of the merchant.
+type Enricher interface {
+	Enrich(ctx context.Context, name string) (*Enrichment, error)
+}
+
+// categoryKeywords are words in merchant names that give away their
+// category, checked in order and matched as whole words
+var categoryKeywords = []struct {
+	category analysis.SpendingCategory
+	words    []string
+}{
+	{analysis.CategorySubscriptions, []string{"netflix", "spotify", "hulu", "disney+", "patreon", "youtube"}},
+	{analysis.CategoryGroceries, []string{"grocery", "groceries", "market", "supermarket", "foods", "trader joe's", "safeway", "kroger", "aldi"}},
+	{analysis.CategoryDining, []string{"coffee", "cafe", "café", "restaurant", "pizza", "burger", "grill", "bakery", "diner", "bar", "starbucks", "mcdonald's", "taco", "sushi"}},
+	{analysis.CategoryTransportation, []string{"uber", "lyft", "shell", "chevron", "exxon", "gas", "fuel", "parking", "transit"}},
+	{analysis.CategoryTravel, []string{"airlines", "airline", "hotel", "airbnb", "marriott", "hilton"}},
+	{analysis.CategoryUtilities, []string{"electric", "energy", "water", "utility", "comcast", "verizon", "at&t", "t-mobile"}},
+	{analysis.CategoryHealthcare, []string{"pharmacy", "cvs", "walgreens", "clinic", "dental", "medical", "hospital"}},
+	{analysis.CategoryEntertainment, []string{"cinema", "theater", "theatre", "steam", "ticketmaster"}},
+	{analysis.CategoryShopping, []string{"amazon", "walmart", "target", "costco", "ebay", "etsy"}},
+	{analysis.CategoryPersonalCare, []string{"salon", "barber", "spa"}},
+}
+
+// KeywordEnricher categorizes merchants by words in their names, like
+// "coffee" or "pharmacy". It has no logos.
+type KeywordEnricher struct{}
+
+// Enrich implements Enricher
+func (KeywordEnricher) Enrich(ctx context.Context, name string) (*Enrichment, error) {
+	padded := " " + strings.Join(strings.Fields(strings.ToLower(name)), " ") + " "
+	for _, entry := range categoryKeywords {
+		for _, keyword := range entry.words {
+			if strings.Contains(padded, " "+keyword+" ") {
+				return &Enrichment{Category: entry.category}, nil
+			}
+		}
+	}
+	return nil, nil
+}
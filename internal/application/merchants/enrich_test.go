@@ -0,0 +1,36 @@
+package merchants
+
+import (
+	"context"
+	"testing"
+
+	"clockzen-next/internal/application/analysis"
+)
+
+func TestKeywordEnricher(t *testing.T) {
+	tests := []struct {
+		name string
+		want analysis.SpendingCategory
+	}{
+		{"Blue Bottle Coffee", analysis.CategoryDining},
+		{"Trader Joe's", analysis.CategoryGroceries},
+		{"Whole Foods Market", analysis.CategoryGroceries},
+		{"Netflix", analysis.CategorySubscriptions},
+		{"Shell Oil", analysis.CategoryTransportation},
+		{"Barnes & Noble", ""},
+	}
+
+	for _, tt := range tests {
+		enrichment, err := KeywordEnricher{}.Enrich(context.Background(), tt.name)
+		if err != nil {
+			t.Fatalf("Enrich(%q): %v", tt.name, err)
+		}
+		var got analysis.SpendingCategory
+		if enrichment != nil {
+			got = enrichment.Category
+		}
+		if got != tt.want {
+			t.Errorf("Enrich(%q) category = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
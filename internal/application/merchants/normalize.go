@@ -0,0 +1,141 @@
+package merchants
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Card networks pass merchant names along as the merchant's processor sends
+// them: "SQ *BLUE BOTTLE COFFEE 4423 SAN F" is a Square payment at Blue
+// Bottle Coffee's store 4423 in San Francisco, cut off at the field's
+// width. Normalizing takes away what isn't the merchant's name: processor
+// prefixes, store numbers and reference codes, and the location after them.
+
+// processorPrefixes are added to the merchant's name by payment processors
+// and marketplaces, matched after uppercasing with spaces around "*" removed
+var processorPrefixes = []string{
+	"SQ*", "SQU*", "TST*", "SP*", "PY*", "PP*", "PAYPAL*", "IN*", "DD*",
+	"GOOGLE*", "GGL*", "APL*", "WPY*", "CKE*", "BT*", "ZLR*", "EB*",
+}
+
+// knownAliases maps the abbreviations some large merchants appear under to
+// their names, matched as prefixes of the cleaned-up name (web addresses
+// without their top-level domain)
+var knownAliases = []struct {
+	prefix string
+	name   string
+}{
+	{"AMZN MKTP", "Amazon"},
+	{"AMZN", "Amazon"},
+	{"AMAZON MKTPL", "Amazon"},
+	{"APPLE BILL", "Apple"},
+	{"WAL MART", "Walmart"},
+	{"WM SUPERCENTER", "Walmart"},
+	{"MCDONALD'S", "McDonald's"},
+	{"MCDONALDS", "McDonald's"},
+}
+
+// usStates are the state codes that end a merchant's location
+var usStates = map[string]bool{
+	"AL": true, "AK": true, "AZ": true, "AR": true, "CA": true, "CO": true, "CT": true,
+	"DE": true, "DC": true, "FL": true, "GA": true, "HI": true, "ID": true, "IL": true,
+	"IN": true, "IA": true, "KS": true, "KY": true, "LA": true, "ME": true, "MD": true,
+	"MA": true, "MI": true, "MN": true, "MS": true, "MO": true, "MT": true, "NE": true,
+	"NV": true, "NH": true, "NJ": true, "NM": true, "NY": true, "NC": true, "ND": true,
+	"OH": true, "OK": true, "OR": true, "PA": true, "RI": true, "SC": true, "SD": true,
+	"TN": true, "TX": true, "UT": true, "VT": true, "VA": true, "WA": true, "WV": true,
+	"WI": true, "WY": true,
+}
+
+// Normalize cleans up a raw merchant string into the merchant's name,
+// e.g. "Blue Bottle Coffee" for "SQ *BLUE BOTTLE COFFEE 4423 SAN F". It is
+// empty when nothing in raw looks like a name.
+func Normalize(raw string) string {
+	s := strings.ToUpper(strings.TrimSpace(raw))
+	s = strings.Join(strings.Fields(s), " ")
+	s = strings.ReplaceAll(s, " *", "*")
+	s = strings.ReplaceAll(s, "* ", "*")
+
+	for _, prefix := range processorPrefixes {
+		if rest, ok := strings.CutPrefix(s, prefix); ok && rest != "" {
+			s = rest
+			break
+		}
+	}
+	// What follows a remaining "*" is an order or reference number
+	if name, _, ok := strings.Cut(s, "*"); ok && strings.TrimSpace(name) != "" {
+		s = name
+	}
+
+	// Web addresses are named without their scheme and top-level domain
+	s = strings.TrimPrefix(s, "HTTPS://")
+	s = strings.TrimPrefix(s, "HTTP://")
+	s = strings.TrimPrefix(s, "WWW.")
+	for _, tld := range []string{".COM", ".NET", ".ORG", ".CO", ".IO"} {
+		if i := strings.Index(s, tld); i > 0 && (i+len(tld) == len(s) || !isLetter(s[i+len(tld)])) {
+			s = s[:i] + s[i+len(tld):]
+			break
+		}
+	}
+
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '&' && r != '\'' && r != '#'
+	})
+	// The name ends at the first store or reference number; what follows is
+	// where the store is
+	for i, word := range words {
+		if i > 0 && strings.ContainsFunc(word, func(r rune) bool { return unicode.IsDigit(r) || r == '#' }) {
+			words = words[:i]
+			break
+		}
+	}
+	if len(words) > 1 && usStates[words[len(words)-1]] {
+		words = words[:len(words)-1]
+	}
+	s = strings.Join(words, " ")
+	if !strings.ContainsFunc(s, unicode.IsLetter) {
+		return ""
+	}
+
+	for _, alias := range knownAliases {
+		if s == alias.prefix || strings.HasPrefix(s, alias.prefix+" ") {
+			return alias.name
+		}
+	}
+
+	words = strings.Fields(s)
+	for i, word := range words {
+		words[i] = titleCase(word)
+	}
+	return strings.Join(words, " ")
+}
+
+// Key is the lowercased form of a merchant name that names normalizing to
+// the same merchant share, ignoring punctuation
+func Key(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '&'
+	})
+	return strings.Join(words, " ")
+}
+
+// titleCase capitalizes the first letter of an uppercase word. Words like
+// "AT&T" that are initials stay uppercase.
+func titleCase(word string) string {
+	if strings.Contains(word, "&") {
+		return word
+	}
+	runes := []rune(strings.ToLower(word))
+	for i, r := range runes {
+		if unicode.IsLetter(r) {
+			runes[i] = unicode.ToUpper(r)
+			break
+		}
+	}
+	return string(runes)
+}
+
+// isLetter reports whether b is an ASCII letter
+func isLetter(b byte) bool {
+	return ('A' <= b && b <= 'Z') || ('a' <= b && b <= 'z')
+}
@@ -0,0 +1,57 @@
+package merchants
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want string
+	}{
+		{"SQ *BLUE BOTTLE COFFEE 4423 SAN F", "Blue Bottle Coffee"},
+		{"SQ *COFFEE 4423 SAN F", "Coffee"},
+		{"TST* JOES PIZZA 0012 BROOKLYN NY", "Joes Pizza"},
+		{"PAYPAL *SPOTIFY", "Spotify"},
+		{"STARBUCKS STORE #12345 SEATTLE WA", "Starbucks Store"},
+		{"SHELL OIL 57442189 HOUSTON TX", "Shell Oil"},
+		{"TRADER JOE'S #552 QPS PORTLAND OR", "Trader Joe's"},
+		{"NETFLIX.COM", "Netflix"},
+		{"NETFLIX.COM 866-579-7172 CA", "Netflix"},
+		{"AMZN MKTP US*2K3AB1CD0", "Amazon"},
+		{"Amazon.com*MK2LA1JF0", "Amazon"},
+		{"APPLE.COM/BILL 866-712-7753 CA", "Apple"},
+		{"WAL-MART #1234", "Walmart"},
+		{"AT&T *PAYMENT", "AT&T"},
+		{"COSTCO WHSE #0481 SAN JOSE CA", "Costco Whse"},
+		{"Whole Foods Market", "Whole Foods Market"},
+		{"7-ELEVEN 33012", "7 Eleven"},
+		{"Target", "Target"},
+		{"   ", ""},
+		{"#1234", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			if got := Normalize(tt.raw); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKey(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Blue Bottle Coffee", "blue bottle coffee"},
+		{"Trader Joe's", "trader joe s"},
+		{"AT&T", "at&t"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := Key(tt.name); got != tt.want {
+			t.Errorf("Key(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
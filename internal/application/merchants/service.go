@@ -0,0 +1,268 @@
+package merchants
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/merchant"
+	"clockzen-next/internal/ent/transaction"
+
+	"github.com/google/uuid"
+)
+
+// ErrMerchantNotFound is returned for merchants that don't exist
+var ErrMerchantNotFound = errors.New("merchant not found")
+
+// backfillBatchSize is how many transactions a backfill links at a time
+const backfillBatchSize = 500
+
+// BackfillResult reports what linking a user's transactions to canonical
+// merchants did
+type BackfillResult struct {
+	UserID string
+	// Scanned counts the transactions with a merchant name not yet linked
+	Scanned int
+	// Linked counts those linked to a merchant; the rest have merchant
+	// names that don't normalize to one
+	Linked int
+	// Categorized counts uncategorized transactions given their merchant's
+	// category
+	Categorized      int
+	MerchantsCreated int
+}
+
+// Service keeps the canonical merchant table, resolving raw merchant
+// strings to the merchants they name
+type Service struct {
+	entClient *ent.Client
+	enricher  Enricher
+}
+
+// NewService creates a new merchant service, enriching new merchants with
+// the built-in keyword categories
+func NewService(entClient *ent.Client) *Service {
+	return &Service{
+		entClient: entClient,
+		enricher:  KeywordEnricher{},
+	}
+}
+
+// SetEnricher looks up new merchants' categories and logos with enricher;
+// nil turns enrichment off
+func (s *Service) SetEnricher(enricher Enricher) {
+	s.enricher = enricher
+}
+
+// Resolve returns the canonical merchant raw names, creating it the first
+// time it is seen. It is nil when raw doesn't normalize to a name.
+func (s *Service) Resolve(ctx context.Context, raw string) (*ent.Merchant, error) {
+	record, _, err := s.resolve(ctx, raw)
+	return record, err
+}
+
+// List returns the canonical merchants of the user's transactions by name,
+// those whose name contains query if it is set, at most limit of them.
+// Merchants are shared by every user, so each only sees the ones they have
+// transactions at.
+func (s *Service) List(ctx context.Context, userID, query string, limit int) ([]*ent.Merchant, error) {
+	q := s.entClient.Merchant.Query().
+		Where(merchant.HasTransactionsWith(transaction.UserID(userID)))
+	if key := Key(query); key != "" {
+		q.Where(merchant.NormalizedKeyContains(key))
+	}
+	records, err := q.Order(ent.Asc(merchant.FieldName)).Limit(limit).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying merchants: %w", err)
+	}
+	return records, nil
+}
+
+// Get returns a canonical merchant of the user's transactions; other
+// merchants are not found
+func (s *Service) Get(ctx context.Context, userID, id string) (*ent.Merchant, error) {
+	record, err := s.entClient.Merchant.Query().
+		Where(
+			merchant.ID(id),
+			merchant.HasTransactionsWith(transaction.UserID(userID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrMerchantNotFound
+		}
+		return nil, fmt.Errorf("getting merchant: %w", err)
+	}
+	return record, nil
+}
+
+// FindByName returns the canonical merchant of the user's transactions a
+// raw merchant string normalizes to, without creating it; nil if there is
+// none yet
+func (s *Service) FindByName(ctx context.Context, userID, raw string) (*ent.Merchant, error) {
+	key := Key(Normalize(raw))
+	if key == "" {
+		return nil, nil
+	}
+	record, err := s.entClient.Merchant.Query().
+		Where(
+			merchant.NormalizedKey(key),
+			merchant.HasTransactionsWith(transaction.UserID(userID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("getting merchant: %w", err)
+	}
+	return record, nil
+}
+
+// Backfill links the user's transactions that have a merchant name to
+// their canonical merchants, giving uncategorized ones the merchant's
+// category. Transactions already linked are left alone, so it can be run
+// again to pick up new ones.
+func (s *Service) Backfill(ctx context.Context, userID string) (*BackfillResult, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+
+	result := &BackfillResult{UserID: userID}
+	resolved := make(map[string]*ent.Merchant)
+	after := ""
+	for {
+		batch, err := s.entClient.Transaction.Query().
+			Where(
+				transaction.UserID(userID),
+				transaction.MerchantIDIsNil(),
+				transaction.MerchantNameNotNil(),
+				transaction.IDGT(after),
+			).
+			Order(ent.Asc(transaction.FieldID)).
+			Limit(backfillBatchSize).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("querying transactions: %w", err)
+		}
+		if len(batch) == 0 {
+			return result, nil
+		}
+		after = batch[len(batch)-1].ID
+		result.Scanned += len(batch)
+
+		// Link the batch a merchant at a time
+		byMerchant := make(map[*ent.Merchant][]string)
+		for _, record := range batch {
+			raw := *record.MerchantName
+			m, seen := resolved[raw]
+			if !seen {
+				var created bool
+				m, created, err = s.resolve(ctx, raw)
+				if err != nil {
+					return nil, err
+				}
+				resolved[raw] = m
+				if created {
+					result.MerchantsCreated++
+				}
+			}
+			if m != nil {
+				byMerchant[m] = append(byMerchant[m], record.ID)
+			}
+		}
+
+		for m, ids := range byMerchant {
+			linked, err := s.entClient.Transaction.Update().
+				Where(transaction.UserID(userID), transaction.IDIn(ids...)).
+				SetMerchantID(m.ID).
+				Save(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("linking transactions to %s: %w", m.Name, err)
+			}
+			result.Linked += linked
+
+			if m.Category == nil {
+				continue
+			}
+			categorized, err := s.entClient.Transaction.Update().
+				Where(
+					transaction.UserID(userID),
+					transaction.IDIn(ids...),
+					transaction.Or(transaction.MerchantCategoryIsNil(), transaction.MerchantCategoryEQ("")),
+				).
+				SetMerchantCategory(*m.Category).
+				Save(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("categorizing transactions at %s: %w", m.Name, err)
+			}
+			result.Categorized += categorized
+		}
+	}
+}
+
+// resolve returns the canonical merchant raw names, and whether it was
+// created for it. Merchants not yet enriched are enriched as they are
+// resolved.
+func (s *Service) resolve(ctx context.Context, raw string) (*ent.Merchant, bool, error) {
+	name := Normalize(raw)
+	key := Key(name)
+	if key == "" {
+		return nil, false, nil
+	}
+
+	id := uuid.New().String()
+	err := s.entClient.Merchant.Create().
+		SetID(id).
+		SetName(name).
+		SetNormalizedKey(key).
+		OnConflictColumns(merchant.FieldNormalizedKey).
+		Ignore().
+		Exec(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("saving merchant: %w", err)
+	}
+	record, err := s.entClient.Merchant.Query().Where(merchant.NormalizedKey(key)).Only(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("getting merchant: %w", err)
+	}
+
+	if record.EnrichedAt == nil && s.enricher != nil {
+		record = s.enrich(ctx, record)
+	}
+	return record, record.ID == id, nil
+}
+
+// enrich looks up a merchant's category and logo, keeping what it already
+// has. A failed lookup is tried again the next time the merchant is
+// resolved.
+func (s *Service) enrich(ctx context.Context, record *ent.Merchant) *ent.Merchant {
+	enrichment, err := s.enricher.Enrich(ctx, record.Name)
+	if err != nil {
+		slog.WarnContext(ctx, "enriching merchant", "merchant_id", record.ID, "name", record.Name, "error", err)
+		return record
+	}
+
+	update := record.Update().SetEnrichedAt(time.Now())
+	if enrichment != nil {
+		if enrichment.Category != "" && record.Category == nil {
+			update.SetCategory(strings.ToLower(string(enrichment.Category)))
+		}
+		if enrichment.LogoURL != "" && record.LogoURL == nil {
+			update.SetLogoURL(enrichment.LogoURL)
+		}
+		if enrichment.Website != "" && record.Website == nil {
+			update.SetWebsite(enrichment.Website)
+		}
+	}
+	enriched, err := update.Save(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "saving merchant enrichment", "merchant_id", record.ID, "error", err)
+		return record
+	}
+	return enriched
+}
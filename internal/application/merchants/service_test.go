@@ -0,0 +1,62 @@
+package merchants
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clockzen-next/internal/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerchantsAreScopedToTransactions(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	s := NewService(client)
+	s.SetEnricher(nil)
+
+	coffee, err := s.Resolve(ctx, "SQ *BLUE BOTTLE COFFEE 4423")
+	require.NoError(t, err)
+	clinic, err := s.Resolve(ctx, "Downtown Clinic")
+	require.NoError(t, err)
+	for i, tx := range []struct{ userID, merchantID string }{
+		{"u1", coffee.ID},
+		{"u2", clinic.ID},
+	} {
+		client.Transaction.Create().
+			SetID(string(rune('a' + i))).
+			SetUserID(tx.userID).
+			SetAmount(12).
+			SetTransactionDate(time.Now()).
+			SetMerchantID(tx.merchantID).
+			SaveX(ctx)
+	}
+
+	records, err := s.List(ctx, "u1", "", 50)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, coffee.ID, records[0].ID)
+	records, err = s.List(ctx, "u1", "clinic", 50)
+	require.NoError(t, err)
+	assert.Empty(t, records)
+	records, err = s.List(ctx, "u3", "", 50)
+	require.NoError(t, err)
+	assert.Empty(t, records)
+
+	record, err := s.Get(ctx, "u1", coffee.ID)
+	require.NoError(t, err)
+	assert.Equal(t, coffee.Name, record.Name)
+	_, err = s.Get(ctx, "u1", clinic.ID)
+	assert.ErrorIs(t, err, ErrMerchantNotFound, "other users' merchants are not found")
+
+	record, err = s.FindByName(ctx, "u2", "DOWNTOWN CLINIC")
+	require.NoError(t, err)
+	require.NotNil(t, record)
+	record, err = s.FindByName(ctx, "u1", "DOWNTOWN CLINIC")
+	require.NoError(t, err)
+	assert.Nil(t, record)
+}
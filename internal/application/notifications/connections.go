@@ -0,0 +1,289 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/emailsync"
+	"clockzen-next/internal/ent/googledriveconnection"
+	"clockzen-next/internal/ent/googledrivesync"
+	"clockzen-next/internal/ent/notification"
+)
+
+// Kinds of connection
+const (
+	connectionEmail = "email"
+	connectionDrive = "drive"
+)
+
+// connection is an email or drive connection
+type connection struct {
+	Kind        string
+	ID          string
+	UserID      string
+	Email       string
+	Status      string
+	TokenExpiry time.Time
+}
+
+// label names the connection in a notification
+func (c connection) label() string {
+	if c.Kind == connectionDrive {
+		return "Google Drive account " + c.Email
+	}
+	return "mailbox " + c.Email
+}
+
+// syncStreak is a run of failed syncs since a connection last synced
+type syncStreak struct {
+	Failures  int
+	FirstID   string
+	Since     time.Time
+	LastError string
+}
+
+// connectionFailureNotice is the notice for a connection that expired or
+// was revoked. Reconnecting stores new tokens, so a connection that fails
+// again after it is notified again.
+func connectionFailureNotice(c connection, recipient string) notice {
+	reason := "its access has expired"
+	if c.Status == string(emailconnection.StatusRevoked) {
+		reason = "its access was revoked"
+	}
+	return notice{
+		UserID:    c.UserID,
+		Kind:      notification.KindConnectionFailure,
+		DedupeKey: fmt.Sprintf("connection:%s:%s:%s:%d", c.Kind, c.ID, c.Status, c.TokenExpiry.Unix()),
+		Recipient: recipient,
+		Subject:   fmt.Sprintf("Reconnect your %s", c.label()),
+		Text: fmt.Sprintf("We can no longer sync receipts from your %s because %s.\n\n"+
+			"Reconnect it in ClockZen to pick up where the last sync left off. "+
+			"Receipts already imported are not affected.\n", c.label(), reason),
+	}
+}
+
+// syncErrorsNotice is the notice for a connection whose syncs keep
+// failing. It is sent once per streak of failures.
+func syncErrorsNotice(c connection, streak syncStreak, recipient string) notice {
+	var text strings.Builder
+	fmt.Fprintf(&text, "The last %d syncs of your %s have failed, since %s.\n\n",
+		streak.Failures, c.label(), streak.Since.UTC().Format("Jan 2, 2006 15:04 MST"))
+	if streak.LastError != "" {
+		fmt.Fprintf(&text, "The latest error was: %s\n\n", streak.LastError)
+	}
+	text.WriteString("We'll keep trying. If it keeps failing, reconnecting the account in ClockZen usually fixes it.\n")
+	return notice{
+		UserID:    c.UserID,
+		Kind:      notification.KindSyncErrors,
+		DedupeKey: fmt.Sprintf("sync_errors:%s:%s:%s", c.Kind, c.ID, streak.FirstID),
+		Recipient: recipient,
+		Subject:   fmt.Sprintf("Syncing your %s is failing", c.label()),
+		Text:      text.String(),
+	}
+}
+
+// connections returns the email and drive connections in the statuses
+func (s *Service) connections(ctx context.Context, statuses ...string) ([]connection, error) {
+	emailStatuses := make([]emailconnection.Status, len(statuses))
+	driveStatuses := make([]googledriveconnection.Status, len(statuses))
+	for i, status := range statuses {
+		emailStatuses[i] = emailconnection.Status(status)
+		driveStatuses[i] = googledriveconnection.Status(status)
+	}
+
+	emailConnections, err := s.entClient.EmailConnection.Query().
+		Where(emailconnection.StatusIn(emailStatuses...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying email connections: %w", err)
+	}
+	driveConnections, err := s.entClient.GoogleDriveConnection.Query().
+		Where(googledriveconnection.StatusIn(driveStatuses...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying drive connections: %w", err)
+	}
+
+	connections := make([]connection, 0, len(emailConnections)+len(driveConnections))
+	for _, c := range emailConnections {
+		connections = append(connections, connection{
+			Kind:        connectionEmail,
+			ID:          c.ID,
+			UserID:      c.UserID,
+			Email:       c.Email,
+			Status:      string(c.Status),
+			TokenExpiry: c.TokenExpiry,
+		})
+	}
+	for _, c := range driveConnections {
+		connections = append(connections, connection{
+			Kind:        connectionDrive,
+			ID:          c.ID,
+			UserID:      c.UserID,
+			Email:       c.Email,
+			Status:      string(c.Status),
+			TokenExpiry: c.TokenExpiry,
+		})
+	}
+	return connections, nil
+}
+
+// CheckConnections notifies the owners of connections that expired or
+// were revoked, and returns how many were notified
+func (s *Service) CheckConnections(ctx context.Context) int {
+	connections, err := s.connections(ctx, string(emailconnection.StatusExpired), string(emailconnection.StatusRevoked))
+	if err != nil {
+		slog.ErrorContext(ctx, "querying failed connections", "error", err)
+		return 0
+	}
+
+	sent := 0
+	for _, c := range connections {
+		if ctx.Err() != nil {
+			break
+		}
+		recipient, err := s.recipient(ctx, c.UserID, c.Email)
+		if err != nil {
+			logFailure(ctx, "notifying connection failure", c.UserID, err)
+			continue
+		}
+		ok, err := s.notify(ctx, connectionFailureNotice(c, recipient))
+		if err != nil {
+			logFailure(ctx, "notifying connection failure", c.UserID, err)
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent
+}
+
+// CheckSyncErrors notifies the owners of active connections whose last
+// SyncErrorThreshold syncs or more failed, and returns how many were
+// notified
+func (s *Service) CheckSyncErrors(ctx context.Context) int {
+	connections, err := s.connections(ctx, string(emailconnection.StatusActive))
+	if err != nil {
+		slog.ErrorContext(ctx, "querying active connections", "error", err)
+		return 0
+	}
+
+	sent := 0
+	for _, c := range connections {
+		if ctx.Err() != nil {
+			break
+		}
+		var streak syncStreak
+		if c.Kind == connectionDrive {
+			streak, err = s.driveSyncStreak(ctx, c.ID)
+		} else {
+			streak, err = s.emailSyncStreak(ctx, c.ID)
+		}
+		if err != nil {
+			logFailure(ctx, "checking sync errors", c.UserID, err)
+			continue
+		}
+		if streak.Failures < s.config.SyncErrorThreshold {
+			continue
+		}
+
+		recipient, err := s.recipient(ctx, c.UserID, c.Email)
+		if err != nil {
+			logFailure(ctx, "notifying sync errors", c.UserID, err)
+			continue
+		}
+		ok, err := s.notify(ctx, syncErrorsNotice(c, streak, recipient))
+		if err != nil {
+			logFailure(ctx, "notifying sync errors", c.UserID, err)
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent
+}
+
+// emailSyncStreak returns the failed syncs of an email connection since it
+// last completed one
+func (s *Service) emailSyncStreak(ctx context.Context, connectionID string) (syncStreak, error) {
+	query := s.entClient.EmailSync.Query().
+		Where(emailsync.ConnectionID(connectionID), emailsync.StatusEQ(emailsync.StatusFailed))
+	last, err := s.entClient.EmailSync.Query().
+		Where(emailsync.ConnectionID(connectionID), emailsync.StatusEQ(emailsync.StatusCompleted)).
+		Order(ent.Desc(emailsync.FieldCreatedAt)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return syncStreak{}, fmt.Errorf("querying syncs: %w", err)
+	}
+	if last != nil {
+		query.Where(emailsync.CreatedAtGT(last.CreatedAt))
+	}
+
+	failures, err := query.Clone().Count(ctx)
+	if err != nil {
+		return syncStreak{}, fmt.Errorf("counting failed syncs: %w", err)
+	}
+	if failures == 0 {
+		return syncStreak{}, nil
+	}
+	streak := syncStreak{Failures: failures}
+	first, err := query.Clone().Order(ent.Asc(emailsync.FieldCreatedAt)).First(ctx)
+	if err != nil {
+		return streak, fmt.Errorf("querying syncs: %w", err)
+	}
+	latest, err := query.Clone().Order(ent.Desc(emailsync.FieldCreatedAt)).First(ctx)
+	if err != nil {
+		return streak, fmt.Errorf("querying syncs: %w", err)
+	}
+	streak.FirstID = first.ID
+	streak.Since = first.CreatedAt
+	if latest.ErrorMessage != nil {
+		streak.LastError = *latest.ErrorMessage
+	}
+	return streak, nil
+}
+
+// driveSyncStreak returns the failed syncs of a drive connection since it
+// last completed one
+func (s *Service) driveSyncStreak(ctx context.Context, connectionID string) (syncStreak, error) {
+	query := s.entClient.GoogleDriveSync.Query().
+		Where(googledrivesync.ConnectionID(connectionID), googledrivesync.StatusEQ(googledrivesync.StatusFailed))
+	last, err := s.entClient.GoogleDriveSync.Query().
+		Where(googledrivesync.ConnectionID(connectionID), googledrivesync.StatusEQ(googledrivesync.StatusCompleted)).
+		Order(ent.Desc(googledrivesync.FieldCreatedAt)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return syncStreak{}, fmt.Errorf("querying syncs: %w", err)
+	}
+	if last != nil {
+		query.Where(googledrivesync.CreatedAtGT(last.CreatedAt))
+	}
+
+	failures, err := query.Clone().Count(ctx)
+	if err != nil {
+		return syncStreak{}, fmt.Errorf("counting failed syncs: %w", err)
+	}
+	if failures == 0 {
+		return syncStreak{}, nil
+	}
+	streak := syncStreak{Failures: failures}
+	first, err := query.Clone().Order(ent.Asc(googledrivesync.FieldCreatedAt)).First(ctx)
+	if err != nil {
+		return streak, fmt.Errorf("querying syncs: %w", err)
+	}
+	latest, err := query.Clone().Order(ent.Desc(googledrivesync.FieldCreatedAt)).First(ctx)
+	if err != nil {
+		return streak, fmt.Errorf("querying syncs: %w", err)
+	}
+	streak.FirstID = first.ID
+	streak.Since = first.CreatedAt
+	if latest.ErrorMessage != nil {
+		streak.LastError = *latest.ErrorMessage
+	}
+	return streak, nil
+}
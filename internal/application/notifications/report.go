@@ -0,0 +1,165 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/application/reports"
+	"clockzen-next/internal/ent/notification"
+	"clockzen-next/internal/ent/transaction"
+	"clockzen-next/internal/infrastructure/mail"
+)
+
+// monthlyReportKey identifies the monthly report for the month starting at
+// start, e.g. monthly_report:2026-09
+func monthlyReportKey(start time.Time) string {
+	return "monthly_report:" + start.Format(reports.MonthLayout)
+}
+
+// monthlyReportNotice is the notice for a user's monthly report: the
+// report as HTML, with a plain text digest of it and the PDF attached
+func monthlyReportNotice(report *reports.MonthlyReport, recipient string) (notice, error) {
+	html, err := reports.RenderHTML(report)
+	if err != nil {
+		return notice{}, err
+	}
+	month := report.StartDate.Format("January 2006")
+
+	var text strings.Builder
+	fmt.Fprintf(&text, "Here's your spending report for %s.\n\n", month)
+	fmt.Fprintf(&text, "Spending: $%.2f across %d transactions\n", report.TotalSpending, report.TransactionCount)
+	if report.ChangePercent != nil {
+		fmt.Fprintf(&text, "Compared with last month: %+.1f%% ($%.2f)\n", *report.ChangePercent, report.PreviousSpending)
+	}
+	var categories []reports.CategoryLine
+	for _, c := range report.Categories {
+		if c.Amount > 0 && len(categories) < topCategoryCount {
+			categories = append(categories, c)
+		}
+	}
+	if len(categories) > 0 {
+		text.WriteString("\nTop categories:\n")
+		for _, c := range categories {
+			fmt.Fprintf(&text, "  %s: $%.2f\n", c.Category, c.Amount)
+		}
+	}
+	var over []string
+	for _, b := range report.Budgets {
+		if b.Status == reports.BudgetOver {
+			over = append(over, b.Category)
+		}
+	}
+	if len(over) > 0 {
+		fmt.Fprintf(&text, "\nOver budget: %s\n", strings.Join(over, ", "))
+	}
+	if len(report.Anomalies) > 0 {
+		fmt.Fprintf(&text, "Unusual spending: %d\n", len(report.Anomalies))
+	}
+	text.WriteString("\nThe full report is attached as a PDF.\n")
+	text.WriteString("\nYou can turn these reports off with monthly_report in your alert preferences.\n")
+
+	return notice{
+		UserID:    report.UserID,
+		Kind:      notification.KindMonthlyReport,
+		DedupeKey: monthlyReportKey(report.StartDate),
+		Recipient: recipient,
+		Subject:   fmt.Sprintf("Your ClockZen spending report for %s: $%.2f spent", month, report.TotalSpending),
+		Text:      text.String(),
+		HTML:      string(html),
+		Attachments: []mail.Attachment{{
+			Filename:    "spending-report-" + report.Month + ".pdf",
+			ContentType: "application/pdf",
+			Data:        reports.RenderPDF(report),
+		}},
+	}, nil
+}
+
+// SendMonthlyReports emails each user who hasn't turned them off their
+// spending report for the month before the one containing now, once the
+// month is over, and returns how many were sent. Only users who spent in
+// the month are emailed.
+func (s *Service) SendMonthlyReports(ctx context.Context, now time.Time) int {
+	if s.reports == nil {
+		return 0
+	}
+	start, err := reports.ParseMonth("", now)
+	if err != nil {
+		slog.ErrorContext(ctx, "finding month to report", "error", err)
+		return 0
+	}
+	users, err := s.entClient.Transaction.Query().
+		Where(
+			transaction.TypeIn(transaction.TypePurchase, transaction.TypePayment),
+			transaction.TransactionDateGTE(start),
+			transaction.TransactionDateLT(start.AddDate(0, 1, 0)),
+		).
+		GroupBy(transaction.FieldUserID).
+		Strings(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "querying users to report on", "error", err)
+		return 0
+	}
+
+	sent := 0
+	for _, userID := range users {
+		if ctx.Err() != nil {
+			break
+		}
+		ok, err := s.sendMonthlyReport(ctx, userID, start)
+		if err != nil {
+			logFailure(ctx, "sending monthly report", userID, err)
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent
+}
+
+// sendMonthlyReport sends the user's report for the month starting at
+// start, if they want it and it hasn't been sent
+func (s *Service) sendMonthlyReport(ctx context.Context, userID string, start time.Time) (bool, error) {
+	preferences, err := s.preferences(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if preferences != nil && !preferences.MonthlyReport {
+		return false, nil
+	}
+	// Reports are costly to generate, so skip those already sent first
+	sent, err := s.entClient.Notification.Query().
+		Where(
+			notification.UserID(userID),
+			notification.DedupeKey(monthlyReportKey(start)),
+			notification.StatusEQ(notification.StatusSent),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("querying notifications: %w", err)
+	}
+	if sent {
+		return false, nil
+	}
+
+	fallback, err := s.accountEmail(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	recipient, err := recipientFor(preferences, fallback)
+	if err != nil {
+		return false, err
+	}
+
+	report, err := s.reports.Generate(ctx, userID, start)
+	if err != nil {
+		return false, err
+	}
+	n, err := monthlyReportNotice(report, recipient)
+	if err != nil {
+		return false, err
+	}
+	return s.notify(ctx, n)
+}
@@ -0,0 +1,259 @@
+// Package notifications emails users about their account, rather than
+// individual alerts: a mailbox or drive connection that expired or was
+// revoked and needs reconnecting, syncs that keep failing, a weekly
+// summary of their syncs and spending, and their monthly spending report.
+// Each notification is recorded, so it is sent once; one that fails to
+// send is retried on the next run.
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"clockzen-next/internal/application/reports"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/alertpreference"
+	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/googledriveconnection"
+	"clockzen-next/internal/ent/notification"
+	"clockzen-next/internal/infrastructure/mail"
+
+	"github.com/google/uuid"
+)
+
+// ErrNoRecipient is returned for a user with no address to email
+var ErrNoRecipient = errors.New("no address to notify")
+
+// Config holds configuration for notifications
+type Config struct {
+	// SyncErrorThreshold is how many syncs of a connection in a row must
+	// fail before the user is notified
+	SyncErrorThreshold int
+	// MaxAttempts is how many times a notification is tried before it is
+	// given up on
+	MaxAttempts int
+}
+
+// DefaultConfig returns the default notification configuration
+func DefaultConfig() Config {
+	return Config{
+		SyncErrorThreshold: 3,
+		MaxAttempts:        5,
+	}
+}
+
+// notice is a notification to send
+type notice struct {
+	UserID    string
+	Kind      notification.Kind
+	DedupeKey string
+	Recipient string
+	Subject   string
+	Text      string
+	// HTML and Attachments are optional
+	HTML        string
+	Attachments []mail.Attachment
+}
+
+// Service finds what users should be notified about and emails them
+type Service struct {
+	entClient *ent.Client
+	sender    mail.Sender
+	config    Config
+	reports   *reports.Service
+}
+
+// NewService creates a new notification service that sends with sender
+func NewService(entClient *ent.Client, sender mail.Sender, config Config) *Service {
+	return &Service{
+		entClient: entClient,
+		sender:    sender,
+		config:    config,
+	}
+}
+
+// NewServiceWithDefaults creates a notification service with default
+// configuration
+func NewServiceWithDefaults(entClient *ent.Client, sender mail.Sender) *Service {
+	return NewService(entClient, sender, DefaultConfig())
+}
+
+// Result counts the notifications sent by a run
+type Result struct {
+	ConnectionFailures int
+	SyncErrors         int
+	WeeklySummaries    int
+	MonthlyReports     int
+}
+
+// SetReportGenerator sets the service that generates monthly reports;
+// without one, monthly reports aren't sent
+func (s *Service) SetReportGenerator(generator *reports.Service) {
+	s.reports = generator
+}
+
+// Run checks every connection for failures and sends the weekly summaries
+// and monthly reports due at now. Failures are logged, so one user doesn't
+// hold up the others.
+func (s *Service) Run(ctx context.Context, now time.Time) Result {
+	return Result{
+		ConnectionFailures: s.CheckConnections(ctx),
+		SyncErrors:         s.CheckSyncErrors(ctx),
+		WeeklySummaries:    s.SendWeeklySummaries(ctx, now),
+		MonthlyReports:     s.SendMonthlyReports(ctx, now),
+	}
+}
+
+// preferences returns the user's alert preferences, or nil if they haven't
+// set any
+func (s *Service) preferences(ctx context.Context, userID string) (*ent.AlertPreference, error) {
+	record, err := s.entClient.AlertPreference.Query().
+		Where(alertpreference.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("getting preferences: %w", err)
+	}
+	return record, nil
+}
+
+// recipient returns where to email the user: the email in their alert
+// preferences, or else fallback
+func (s *Service) recipient(ctx context.Context, userID, fallback string) (string, error) {
+	preferences, err := s.preferences(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	return recipientFor(preferences, fallback)
+}
+
+// recipientFor returns the email in preferences, or else fallback
+func recipientFor(preferences *ent.AlertPreference, fallback string) (string, error) {
+	if preferences != nil && preferences.Email != nil {
+		return *preferences.Email, nil
+	}
+	if fallback == "" {
+		return "", ErrNoRecipient
+	}
+	return fallback, nil
+}
+
+// accountEmail returns the address of one of the user's connected
+// accounts, preferring mailboxes, or "" if they have none
+func (s *Service) accountEmail(ctx context.Context, userID string) (string, error) {
+	emails, err := s.entClient.EmailConnection.Query().
+		Where(emailconnection.UserID(userID)).
+		Order(ent.Asc(emailconnection.FieldCreatedAt)).
+		Limit(1).
+		Select(emailconnection.FieldEmail).
+		Strings(ctx)
+	if err != nil {
+		return "", fmt.Errorf("querying email connections: %w", err)
+	}
+	if len(emails) > 0 {
+		return emails[0], nil
+	}
+
+	emails, err = s.entClient.GoogleDriveConnection.Query().
+		Where(googledriveconnection.UserID(userID)).
+		Order(ent.Asc(googledriveconnection.FieldCreatedAt)).
+		Limit(1).
+		Select(googledriveconnection.FieldEmail).
+		Strings(ctx)
+	if err != nil {
+		return "", fmt.Errorf("querying drive connections: %w", err)
+	}
+	if len(emails) > 0 {
+		return emails[0], nil
+	}
+	return "", nil
+}
+
+// notify sends the notice unless it was sent before, or has failed
+// MaxAttempts times, and reports whether it was sent. The notice is
+// recorded before it is sent, as failed until the send succeeds, so
+// concurrent runs don't both send it and a crash mid-send retries it.
+func (s *Service) notify(ctx context.Context, n notice) (bool, error) {
+	existing, err := s.entClient.Notification.Query().
+		Where(notification.UserID(n.UserID), notification.DedupeKey(n.DedupeKey)).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		existing, err = s.entClient.Notification.Create().
+			SetID(uuid.New().String()).
+			SetUserID(n.UserID).
+			SetKind(n.Kind).
+			SetDedupeKey(n.DedupeKey).
+			SetRecipient(n.Recipient).
+			SetSubject(n.Subject).
+			SetStatus(notification.StatusFailed).
+			SetAttempts(1).
+			Save(ctx)
+		if err != nil {
+			// Claimed by a concurrent run
+			if ent.IsConstraintError(err) {
+				return false, nil
+			}
+			return false, fmt.Errorf("saving notification: %w", err)
+		}
+	case err != nil:
+		return false, fmt.Errorf("querying notifications: %w", err)
+	case existing.Status == notification.StatusSent || existing.Attempts >= s.config.MaxAttempts:
+		return false, nil
+	default:
+		// Claim the retry, so a concurrent run doesn't send it too
+		claimed, err := s.entClient.Notification.Update().
+			Where(
+				notification.ID(existing.ID),
+				notification.StatusEQ(notification.StatusFailed),
+				notification.Attempts(existing.Attempts),
+			).
+			SetRecipient(n.Recipient).
+			SetSubject(n.Subject).
+			AddAttempts(1).
+			Save(ctx)
+		if err != nil {
+			return false, fmt.Errorf("claiming notification: %w", err)
+		}
+		if claimed == 0 {
+			return false, nil
+		}
+	}
+
+	sendErr := s.sender.Send(ctx, mail.Message{
+		To:          n.Recipient,
+		Subject:     n.Subject,
+		Text:        n.Text,
+		HTML:        n.HTML,
+		Attachments: n.Attachments,
+	})
+	update := s.entClient.Notification.UpdateOneID(existing.ID)
+	if sendErr != nil {
+		update.SetError(sendErr.Error())
+	} else {
+		update.SetStatus(notification.StatusSent).
+			SetSentAt(time.Now()).
+			ClearError()
+	}
+	if err := update.Exec(ctx); err != nil {
+		return sendErr == nil, fmt.Errorf("updating notification: %w", err)
+	}
+	if sendErr != nil {
+		return false, fmt.Errorf("sending notification: %w", sendErr)
+	}
+	return true, nil
+}
+
+// logFailure logs a failure to notify a user
+func logFailure(ctx context.Context, msg, userID string, err error) {
+	if errors.Is(err, ErrNoRecipient) {
+		slog.DebugContext(ctx, msg, "user_id", userID, "error", err)
+		return
+	}
+	slog.ErrorContext(ctx, msg, "user_id", userID, "error", err)
+}
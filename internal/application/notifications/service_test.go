@@ -0,0 +1,180 @@
+package notifications
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"clockzen-next/internal/application/reports"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/notification"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreviousWeek(t *testing.T) {
+	monday := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		now  time.Time
+	}{
+		{"monday midnight", monday.Add(7 * 24 * time.Hour)},
+		{"friday", time.Date(2026, 10, 23, 15, 30, 0, 0, time.UTC)},
+		{"sunday night", time.Date(2026, 10, 25, 23, 59, 0, 0, time.UTC)},
+		{"other zone", time.Date(2026, 10, 19, 1, 0, 0, 0, time.FixedZone("UTC+2", 2*60*60)).Add(24 * time.Hour)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := previousWeek(tt.now)
+			assert.Equal(t, monday, start)
+			assert.Equal(t, monday.AddDate(0, 0, 7), end)
+		})
+	}
+}
+
+func TestWeekKey(t *testing.T) {
+	assert.Equal(t, "2026-W42", weekKey(time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)))
+	// ISO weeks belong to the year their Thursday is in
+	assert.Equal(t, "2026-W01", weekKey(time.Date(2025, 12, 29, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestTopCategories(t *testing.T) {
+	top := topCategories(map[string]float64{
+		"groceries": 120.504,
+		"dining":    80,
+		"travel":    80,
+		"fuel":      10,
+	}, 3)
+	assert.Equal(t, []CategorySpending{
+		{Category: "groceries", Amount: 120.5},
+		{Category: "dining", Amount: 80},
+		{Category: "travel", Amount: 80},
+	}, top)
+	assert.Empty(t, topCategories(nil, 3))
+}
+
+func TestRecipientFor(t *testing.T) {
+	email := "alerts@example.com"
+
+	recipient, err := recipientFor(&ent.AlertPreference{Email: &email}, "account@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, email, recipient)
+
+	recipient, err = recipientFor(&ent.AlertPreference{}, "account@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "account@example.com", recipient)
+
+	recipient, err = recipientFor(nil, "account@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "account@example.com", recipient)
+
+	_, err = recipientFor(nil, "")
+	assert.ErrorIs(t, err, ErrNoRecipient)
+}
+
+func TestConnectionFailureNotice(t *testing.T) {
+	expiry := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	c := connection{
+		Kind:        connectionDrive,
+		ID:          "conn-1",
+		UserID:      "user-1",
+		Email:       "me@example.com",
+		Status:      "revoked",
+		TokenExpiry: expiry,
+	}
+
+	n := connectionFailureNotice(c, "me@example.com")
+	assert.Equal(t, notification.KindConnectionFailure, n.Kind)
+	assert.Equal(t, "user-1", n.UserID)
+	assert.Equal(t, "Reconnect your Google Drive account me@example.com", n.Subject)
+	assert.Contains(t, n.Text, "its access was revoked")
+
+	// Reconnecting refreshes the tokens, so a later failure is a new notice
+	c.TokenExpiry = expiry.Add(time.Hour)
+	assert.NotEqual(t, n.DedupeKey, connectionFailureNotice(c, "me@example.com").DedupeKey)
+}
+
+func TestSyncErrorsNotice(t *testing.T) {
+	c := connection{Kind: connectionEmail, ID: "conn-1", UserID: "user-1", Email: "me@example.com"}
+	streak := syncStreak{
+		Failures:  4,
+		FirstID:   "sync-1",
+		Since:     time.Date(2026, 10, 14, 9, 0, 0, 0, time.UTC),
+		LastError: "quota exceeded",
+	}
+
+	n := syncErrorsNotice(c, streak, "me@example.com")
+	assert.Equal(t, notification.KindSyncErrors, n.Kind)
+	assert.Equal(t, "sync_errors:email:conn-1:sync-1", n.DedupeKey)
+	assert.Contains(t, n.Text, "The last 4 syncs of your mailbox me@example.com have failed")
+	assert.Contains(t, n.Text, "quota exceeded")
+
+	// The streak growing doesn't make it a new notice
+	streak.Failures = 5
+	assert.Equal(t, n.DedupeKey, syncErrorsNotice(c, streak, "me@example.com").DedupeKey)
+}
+
+func TestWeeklySummaryNotice(t *testing.T) {
+	start := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+	summary := WeeklySummary{
+		UserID:           "user-1",
+		Start:            start,
+		End:              start.AddDate(0, 0, 7),
+		SyncsCompleted:   12,
+		SyncsFailed:      1,
+		ReceiptsAdded:    5,
+		Transactions:     7,
+		Spending:         243.1,
+		SpendingCategory: []CategorySpending{{Category: "groceries", Amount: 150}},
+	}
+	assert.False(t, summary.Empty())
+	assert.True(t, WeeklySummary{}.Empty())
+
+	n := weeklySummaryNotice(summary, "me@example.com")
+	assert.Equal(t, notification.KindWeeklySummary, n.Kind)
+	assert.Equal(t, "weekly_summary:2026-W41", n.DedupeKey)
+	assert.Equal(t, "Your ClockZen week: $243.10 spent, 5 receipts added", n.Subject)
+	assert.Contains(t, n.Text, "Oct 5 to Oct 11, 2026")
+	assert.Contains(t, n.Text, "Syncs: 12 completed, 1 failed")
+	assert.Contains(t, n.Text, "groceries: $150.00")
+	assert.Contains(t, n.Text, "Some syncs failed")
+}
+
+func TestMonthlyReportNotice(t *testing.T) {
+	change := 25.0
+	report := &reports.MonthlyReport{
+		UserID:           "user-1",
+		Month:            "2026-09",
+		StartDate:        time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		TotalSpending:    500,
+		TransactionCount: 20,
+		PreviousSpending: 400,
+		ChangePercent:    &change,
+		Categories: []reports.CategoryLine{
+			{Category: "groceries", Amount: 300},
+			{Category: "dining", Amount: 200},
+			{Category: "travel", PreviousAmount: 50, ChangeAmount: -50},
+		},
+		Budgets: []reports.BudgetLine{
+			{Category: "dining", Limit: 150, Spent: 200, Status: reports.BudgetOver},
+			{Category: "groceries", Limit: 400, Spent: 300, Status: reports.BudgetUnder},
+		},
+	}
+
+	n, err := monthlyReportNotice(report, "me@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, notification.KindMonthlyReport, n.Kind)
+	assert.Equal(t, "monthly_report:2026-09", n.DedupeKey)
+	assert.Equal(t, "Your ClockZen spending report for September 2026: $500.00 spent", n.Subject)
+	assert.Contains(t, n.Text, "Compared with last month: +25.0% ($400.00)")
+	assert.Contains(t, n.Text, "groceries: $300.00")
+	assert.NotContains(t, n.Text, "travel")
+	assert.Contains(t, n.Text, "Over budget: dining")
+	assert.Contains(t, n.HTML, "Spending report for September 2026")
+
+	require.Len(t, n.Attachments, 1)
+	assert.Equal(t, "spending-report-2026-09.pdf", n.Attachments[0].Filename)
+	assert.Equal(t, "application/pdf", n.Attachments[0].ContentType)
+	assert.True(t, bytes.HasPrefix(n.Attachments[0].Data, []byte("%PDF-")))
+}
@@ -0,0 +1,298 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/emailsync"
+	"clockzen-next/internal/ent/googledriveconnection"
+	"clockzen-next/internal/ent/googledrivesync"
+	"clockzen-next/internal/ent/notification"
+	"clockzen-next/internal/ent/receipt"
+	"clockzen-next/internal/ent/transaction"
+)
+
+// topCategoryCount is how many spending categories a summary lists
+const topCategoryCount = 3
+
+// WeeklySummary is what a user's week looked like
+type WeeklySummary struct {
+	UserID string
+	// Start and End bound the week: from Monday 00:00 UTC to the next
+	Start time.Time
+	End   time.Time
+
+	SyncsCompleted   int
+	SyncsFailed      int
+	ReceiptsAdded    int
+	Transactions     int
+	Spending         float64
+	SpendingCategory []CategorySpending
+}
+
+// CategorySpending is spending in one category
+type CategorySpending struct {
+	Category string
+	Amount   float64
+}
+
+// Empty reports whether nothing happened in the week, so there is nothing
+// to summarize
+func (w WeeklySummary) Empty() bool {
+	return w.SyncsCompleted == 0 && w.SyncsFailed == 0 && w.ReceiptsAdded == 0 && w.Transactions == 0
+}
+
+// previousWeek returns the bounds of the ISO week before the one
+// containing now, in UTC
+func previousWeek(now time.Time) (time.Time, time.Time) {
+	now = now.UTC()
+	daysSinceMonday := (int(now.Weekday()) + 6) % 7
+	end := time.Date(now.Year(), now.Month(), now.Day()-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+	return end.AddDate(0, 0, -7), end
+}
+
+// weekKey identifies the ISO week starting at start, e.g. 2026-W41
+func weekKey(start time.Time) string {
+	year, week := start.ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// weeklySummaryNotice is the notice for a user's weekly summary
+func weeklySummaryNotice(summary WeeklySummary, recipient string) notice {
+	var text strings.Builder
+	fmt.Fprintf(&text, "Here's your week in ClockZen, %s to %s.\n\n",
+		summary.Start.Format("Jan 2"), summary.End.AddDate(0, 0, -1).Format("Jan 2, 2006"))
+	fmt.Fprintf(&text, "Syncs: %d completed, %d failed\n", summary.SyncsCompleted, summary.SyncsFailed)
+	fmt.Fprintf(&text, "Receipts added: %d\n", summary.ReceiptsAdded)
+	fmt.Fprintf(&text, "Spending: $%.2f across %d transactions\n", summary.Spending, summary.Transactions)
+	if len(summary.SpendingCategory) > 0 {
+		text.WriteString("\nTop categories:\n")
+		for _, c := range summary.SpendingCategory {
+			fmt.Fprintf(&text, "  %s: $%.2f\n", c.Category, c.Amount)
+		}
+	}
+	if summary.SyncsFailed > 0 {
+		text.WriteString("\nSome syncs failed this week. If they keep failing, reconnecting the account usually fixes it.\n")
+	}
+	text.WriteString("\nYou can turn these summaries off with weekly_summary in your alert preferences.\n")
+
+	return notice{
+		UserID:    summary.UserID,
+		Kind:      notification.KindWeeklySummary,
+		DedupeKey: "weekly_summary:" + weekKey(summary.Start),
+		Recipient: recipient,
+		Subject:   fmt.Sprintf("Your ClockZen week: $%.2f spent, %d receipts added", summary.Spending, summary.ReceiptsAdded),
+		Text:      text.String(),
+	}
+}
+
+// topCategories returns the categories spent the most in, the most first
+func topCategories(spent map[string]float64, n int) []CategorySpending {
+	categories := make([]CategorySpending, 0, len(spent))
+	for category, amount := range spent {
+		categories = append(categories, CategorySpending{Category: category, Amount: math.Round(amount*100) / 100})
+	}
+	sort.Slice(categories, func(i, j int) bool {
+		if categories[i].Amount != categories[j].Amount {
+			return categories[i].Amount > categories[j].Amount
+		}
+		return categories[i].Category < categories[j].Category
+	})
+	if len(categories) > n {
+		categories = categories[:n]
+	}
+	return categories
+}
+
+// Summarize returns what the user's week from start to end looked like
+func (s *Service) Summarize(ctx context.Context, userID string, start, end time.Time) (*WeeklySummary, error) {
+	summary := &WeeklySummary{UserID: userID, Start: start, End: end}
+
+	type statusCount struct {
+		Status string `json:"status"`
+		Count  int    `json:"count"`
+	}
+	var emailSyncs, driveSyncs []statusCount
+	err := s.entClient.EmailSync.Query().
+		Where(
+			emailsync.HasConnectionWith(emailconnection.UserID(userID)),
+			emailsync.CreatedAtGTE(start),
+			emailsync.CreatedAtLT(end),
+		).
+		GroupBy(emailsync.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &emailSyncs)
+	if err != nil {
+		return nil, fmt.Errorf("counting email syncs: %w", err)
+	}
+	err = s.entClient.GoogleDriveSync.Query().
+		Where(
+			googledrivesync.HasConnectionWith(googledriveconnection.UserID(userID)),
+			googledrivesync.CreatedAtGTE(start),
+			googledrivesync.CreatedAtLT(end),
+		).
+		GroupBy(googledrivesync.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &driveSyncs)
+	if err != nil {
+		return nil, fmt.Errorf("counting drive syncs: %w", err)
+	}
+	for _, group := range append(emailSyncs, driveSyncs...) {
+		switch group.Status {
+		case string(emailsync.StatusCompleted):
+			summary.SyncsCompleted += group.Count
+		case string(emailsync.StatusFailed):
+			summary.SyncsFailed += group.Count
+		}
+	}
+
+	summary.ReceiptsAdded, err = s.entClient.Receipt.Query().
+		Where(
+			receipt.UserID(userID),
+			receipt.CreatedAtGTE(start),
+			receipt.CreatedAtLT(end),
+		).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("counting receipts: %w", err)
+	}
+
+	purchases, err := s.entClient.Transaction.Query().
+		Where(
+			transaction.UserID(userID),
+			transaction.TypeEQ(transaction.TypePurchase),
+			transaction.TransactionDateGTE(start),
+			transaction.TransactionDateLT(end),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying transactions: %w", err)
+	}
+	spent := make(map[string]float64)
+	for _, t := range purchases {
+		summary.Spending += t.Amount
+		category := "uncategorized"
+		if t.MerchantCategory != nil && *t.MerchantCategory != "" {
+			category = strings.ToLower(*t.MerchantCategory)
+		}
+		spent[category] += t.Amount
+	}
+	summary.Transactions = len(purchases)
+	summary.Spending = math.Round(summary.Spending*100) / 100
+	summary.SpendingCategory = topCategories(spent, topCategoryCount)
+	return summary, nil
+}
+
+// summaryUsers returns the users with a connection or spending in the week
+func (s *Service) summaryUsers(ctx context.Context, start, end time.Time) ([]string, error) {
+	emailUsers, err := s.entClient.EmailConnection.Query().
+		GroupBy(emailconnection.FieldUserID).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying email connections: %w", err)
+	}
+	driveUsers, err := s.entClient.GoogleDriveConnection.Query().
+		GroupBy(googledriveconnection.FieldUserID).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying drive connections: %w", err)
+	}
+	spendingUsers, err := s.entClient.Transaction.Query().
+		Where(transaction.TransactionDateGTE(start), transaction.TransactionDateLT(end)).
+		GroupBy(transaction.FieldUserID).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying transactions: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	var users []string
+	for _, group := range [][]string{emailUsers, driveUsers, spendingUsers} {
+		for _, userID := range group {
+			if !seen[userID] {
+				seen[userID] = true
+				users = append(users, userID)
+			}
+		}
+	}
+	sort.Strings(users)
+	return users, nil
+}
+
+// SendWeeklySummaries emails each user who hasn't turned them off a
+// summary of the week before the one containing now, once the week is
+// over, and returns how many were sent. Users with nothing to summarize
+// aren't emailed.
+func (s *Service) SendWeeklySummaries(ctx context.Context, now time.Time) int {
+	start, end := previousWeek(now)
+	users, err := s.summaryUsers(ctx, start, end)
+	if err != nil {
+		slog.ErrorContext(ctx, "querying users to summarize", "error", err)
+		return 0
+	}
+
+	sent := 0
+	for _, userID := range users {
+		if ctx.Err() != nil {
+			break
+		}
+		ok, err := s.sendWeeklySummary(ctx, userID, start, end)
+		if err != nil {
+			logFailure(ctx, "sending weekly summary", userID, err)
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent
+}
+
+// sendWeeklySummary sends the user's summary of the week, if they want it
+// and it hasn't been sent
+func (s *Service) sendWeeklySummary(ctx context.Context, userID string, start, end time.Time) (bool, error) {
+	preferences, err := s.preferences(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if preferences != nil && !preferences.WeeklySummary {
+		return false, nil
+	}
+	sent, err := s.entClient.Notification.Query().
+		Where(
+			notification.UserID(userID),
+			notification.DedupeKey("weekly_summary:"+weekKey(start)),
+			notification.StatusEQ(notification.StatusSent),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("querying notifications: %w", err)
+	}
+	if sent {
+		return false, nil
+	}
+
+	summary, err := s.Summarize(ctx, userID, start, end)
+	if err != nil {
+		return false, err
+	}
+	if summary.Empty() {
+		return false, nil
+	}
+
+	fallback, err := s.accountEmail(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	recipient, err := recipientFor(preferences, fallback)
+	if err != nil {
+		return false, err
+	}
+	return s.notify(ctx, weeklySummaryNotice(*summary, recipient))
+}
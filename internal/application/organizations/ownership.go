@@ -0,0 +1,35 @@
+package organizations
+
+import (
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// Connections and budget reallocations record the user who made them in
+// user_id and, when made in an organization, the organization in
+// organization_id. Their owner is the organization if there is one, or else
+// the user.
+
+// OwnerID returns the ID a row made by the user, in the organization if it
+// isn't nil, is owned by
+func OwnerID(userID string, organizationID *string) string {
+	if organizationID != nil && *organizationID != "" {
+		return *organizationID
+	}
+	return userID
+}
+
+// OwnedBy returns a predicate for tables with user_id and organization_id
+// columns, matching the rows owned by ownerID: the rows made in the
+// organization ownerID, or the user ownerID's rows made outside any
+// organization
+func OwnedBy[P ~func(*entsql.Selector)](ownerID string) P {
+	return func(s *entsql.Selector) {
+		s.Where(entsql.Or(
+			entsql.EQ(s.C("organization_id"), ownerID),
+			entsql.And(
+				entsql.EQ(s.C("user_id"), ownerID),
+				entsql.IsNull(s.C("organization_id")),
+			),
+		))
+	}
+}
@@ -0,0 +1,421 @@
+// Package organizations lets users share their connections, budgets and
+// reports with others. An organization's members each have a role: owners
+// and admins manage it, members share its data and viewers can only read it.
+// Requests act in an organization by naming it in the X-Organization-ID
+// header, and the data they read and write is then owned by the
+// organization rather than the user. Connections and budget reallocations
+// still record the user who made them, see OwnedBy.
+package organizations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/membership"
+
+	"github.com/google/uuid"
+)
+
+// Errors returned by the organization service
+var (
+	ErrNotFound               = errors.New("organization not found")
+	ErrMemberNotFound         = errors.New("member not found")
+	ErrAlreadyMember          = errors.New("user is already a member")
+	ErrForbidden              = errors.New("your role in the organization doesn't allow this")
+	ErrLastOwner              = errors.New("an organization must keep at least one owner")
+	ErrInvalidName            = errors.New("name is required")
+	ErrInvalidUserID          = errors.New("user_id is required")
+	ErrInvalidRole            = errors.New("role must be one of: owner, admin, member, viewer")
+	ErrNameTooLong            = errors.New("name must be at most 100 characters")
+	errUserIDRequired         = errors.New("userID is required")
+	errOrganizationIDRequired = errors.New("organizationID is required")
+)
+
+// maxNameLength is the longest organization name accepted
+const maxNameLength = 100
+
+// Role is a member's role in an organization
+type Role string
+
+// Member roles, from most to least privileged
+const (
+	RoleOwner  Role = "owner"
+	RoleAdmin  Role = "admin"
+	RoleMember Role = "member"
+	RoleViewer Role = "viewer"
+)
+
+// rank orders the roles by privilege; unknown roles rank lowest
+func (r Role) rank() int {
+	switch r {
+	case RoleOwner:
+		return 4
+	case RoleAdmin:
+		return 3
+	case RoleMember:
+		return 2
+	case RoleViewer:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// IsValid reports whether the role is known
+func (r Role) IsValid() bool {
+	return r.rank() > 0
+}
+
+// AtLeast reports whether the role has all the permissions of other
+func (r Role) AtLeast(other Role) bool {
+	return r.IsValid() && r.rank() >= other.rank()
+}
+
+// CanWrite reports whether the role may change the organization's data
+func (r Role) CanWrite() bool {
+	return r.AtLeast(RoleMember)
+}
+
+// CanManage reports whether the role may rename the organization and manage
+// its members
+func (r Role) CanManage() bool {
+	return r.AtLeast(RoleAdmin)
+}
+
+// Service manages organizations and their members
+type Service struct {
+	entClient *ent.Client
+}
+
+// NewService creates a new organization service
+func NewService(entClient *ent.Client) *Service {
+	return &Service{
+		entClient: entClient,
+	}
+}
+
+// Create creates an organization with the user as its owner
+func (s *Service) Create(ctx context.Context, userID, name string) (*ent.Membership, error) {
+	if userID == "" {
+		return nil, errUserIDRequired
+	}
+	name, err := validateName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	org, err := tx.Organization.Create().
+		SetID(uuid.New().String()).
+		SetName(name).
+		SetCreatedBy(userID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating organization: %w", err)
+	}
+	owner, err := tx.Membership.Create().
+		SetID(uuid.New().String()).
+		SetOrganizationID(org.ID).
+		SetUserID(userID).
+		SetRole(membership.RoleOwner).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("adding organization owner: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing organization: %w", err)
+	}
+
+	owner.Edges.Organization = org
+	return owner, nil
+}
+
+// List returns the user's memberships, with their organizations, oldest
+// first
+func (s *Service) List(ctx context.Context, userID string) ([]*ent.Membership, error) {
+	memberships, err := s.entClient.Membership.Query().
+		Where(membership.UserID(userID)).
+		WithOrganization().
+		Order(ent.Asc(membership.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying memberships: %w", err)
+	}
+	return memberships, nil
+}
+
+// Get returns the user's membership of an organization, with the
+// organization. Organizations the user doesn't belong to are not found.
+func (s *Service) Get(ctx context.Context, userID, organizationID string) (*ent.Membership, error) {
+	member, err := s.entClient.Membership.Query().
+		Where(
+			membership.OrganizationID(organizationID),
+			membership.UserID(userID),
+		).
+		WithOrganization().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("querying membership: %w", err)
+	}
+	return member, nil
+}
+
+// MemberRole returns the user's role in an organization. It lets requests
+// be scoped to the organization.
+func (s *Service) MemberRole(ctx context.Context, organizationID, userID string) (Role, error) {
+	if organizationID == "" {
+		return "", errOrganizationIDRequired
+	}
+	member, err := s.entClient.Membership.Query().
+		Where(
+			membership.OrganizationID(organizationID),
+			membership.UserID(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", ErrNotFound
+		}
+		return "", fmt.Errorf("querying membership: %w", err)
+	}
+	return Role(member.Role), nil
+}
+
+// Rename changes an organization's name. Only owners and admins can.
+func (s *Service) Rename(ctx context.Context, userID, organizationID, name string) (*ent.Organization, error) {
+	name, err := validateName(name)
+	if err != nil {
+		return nil, err
+	}
+	actor, err := s.Get(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if !Role(actor.Role).CanManage() {
+		return nil, ErrForbidden
+	}
+
+	org, err := s.entClient.Organization.UpdateOneID(organizationID).
+		SetName(name).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("renaming organization: %w", err)
+	}
+	return org, nil
+}
+
+// Delete deletes an organization and its memberships. Only owners can. Data
+// the organization owned is kept but no longer reachable by its members.
+func (s *Service) Delete(ctx context.Context, userID, organizationID string) error {
+	actor, err := s.Get(ctx, userID, organizationID)
+	if err != nil {
+		return err
+	}
+	if Role(actor.Role) != RoleOwner {
+		return ErrForbidden
+	}
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Membership.Delete().Where(membership.OrganizationID(organizationID)).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting memberships: %w", err)
+	}
+	if err := tx.Organization.DeleteOneID(organizationID).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting organization: %w", err)
+	}
+	return tx.Commit()
+}
+
+// ListMembers returns the members of an organization the user belongs to,
+// oldest first
+func (s *Service) ListMembers(ctx context.Context, userID, organizationID string) ([]*ent.Membership, error) {
+	if _, err := s.Get(ctx, userID, organizationID); err != nil {
+		return nil, err
+	}
+	members, err := s.entClient.Membership.Query().
+		Where(membership.OrganizationID(organizationID)).
+		Order(ent.Asc(membership.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying members: %w", err)
+	}
+	return members, nil
+}
+
+// AddMember adds a user to an organization. Owners and admins can add
+// members with roles up to their own.
+func (s *Service) AddMember(ctx context.Context, userID, organizationID, memberID string, role Role) (*ent.Membership, error) {
+	memberID = strings.TrimSpace(memberID)
+	if memberID == "" {
+		return nil, ErrInvalidUserID
+	}
+	if !role.IsValid() {
+		return nil, ErrInvalidRole
+	}
+	actor, err := s.Get(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	actorRole := Role(actor.Role)
+	if !actorRole.CanManage() || !actorRole.AtLeast(role) {
+		return nil, ErrForbidden
+	}
+
+	member, err := s.entClient.Membership.Create().
+		SetID(uuid.New().String()).
+		SetOrganizationID(organizationID).
+		SetUserID(memberID).
+		SetRole(membership.Role(role)).
+		SetInvitedBy(userID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrAlreadyMember
+		}
+		return nil, fmt.Errorf("adding member: %w", err)
+	}
+	return member, nil
+}
+
+// UpdateMemberRole changes a member's role. Owners and admins can change
+// the roles of members ranked no higher than themselves, to roles up to
+// their own. The last owner can't be demoted.
+func (s *Service) UpdateMemberRole(ctx context.Context, userID, organizationID, memberID string, role Role) (*ent.Membership, error) {
+	if !role.IsValid() {
+		return nil, ErrInvalidRole
+	}
+	actor, err := s.Get(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.entClient.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	member, err := findMember(ctx, tx, organizationID, memberID)
+	if err != nil {
+		return nil, err
+	}
+	actorRole := Role(actor.Role)
+	if !actorRole.CanManage() || !actorRole.AtLeast(Role(member.Role)) || !actorRole.AtLeast(role) {
+		return nil, ErrForbidden
+	}
+	if Role(member.Role) == RoleOwner && role != RoleOwner {
+		if err := keepOwner(ctx, tx, organizationID); err != nil {
+			return nil, err
+		}
+	}
+
+	member, err = tx.Membership.UpdateOne(member).
+		SetRole(membership.Role(role)).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("updating member: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing member: %w", err)
+	}
+	return member, nil
+}
+
+// RemoveMember removes a user from an organization. Members can leave, and
+// owners and admins can remove members ranked no higher than themselves.
+// The last owner can't be removed.
+func (s *Service) RemoveMember(ctx context.Context, userID, organizationID, memberID string) error {
+	actor, err := s.Get(ctx, userID, organizationID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.entClient.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	member, err := findMember(ctx, tx, organizationID, memberID)
+	if err != nil {
+		return err
+	}
+	actorRole := Role(actor.Role)
+	if memberID != userID && (!actorRole.CanManage() || !actorRole.AtLeast(Role(member.Role))) {
+		return ErrForbidden
+	}
+	if Role(member.Role) == RoleOwner {
+		if err := keepOwner(ctx, tx, organizationID); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Membership.DeleteOne(member).Exec(ctx); err != nil {
+		return fmt.Errorf("removing member: %w", err)
+	}
+	return tx.Commit()
+}
+
+// findMember returns a user's membership of an organization
+func findMember(ctx context.Context, tx *ent.Tx, organizationID, memberID string) (*ent.Membership, error) {
+	member, err := tx.Membership.Query().
+		Where(
+			membership.OrganizationID(organizationID),
+			membership.UserID(memberID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrMemberNotFound
+		}
+		return nil, fmt.Errorf("querying member: %w", err)
+	}
+	return member, nil
+}
+
+// keepOwner fails unless the organization has another owner besides the
+// one being demoted or removed. It runs in a serializable transaction, so
+// two owners can't both leave at once.
+func keepOwner(ctx context.Context, tx *ent.Tx, organizationID string) error {
+	owners, err := tx.Membership.Query().
+		Where(
+			membership.OrganizationID(organizationID),
+			membership.RoleEQ(membership.RoleOwner),
+		).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("querying owners: %w", err)
+	}
+	if len(owners) <= 1 {
+		return ErrLastOwner
+	}
+	return nil
+}
+
+// validateName trims an organization name and checks its length
+func validateName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", ErrInvalidName
+	}
+	if len([]rune(name)) > maxNameLength {
+		return "", ErrNameTooLong
+	}
+	return name, nil
+}
@@ -0,0 +1,54 @@
+package organizations
+
+import (
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"clockzen-next/internal/ent/predicate"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRole(t *testing.T) {
+	assert.True(t, RoleOwner.AtLeast(RoleAdmin))
+	assert.True(t, RoleAdmin.AtLeast(RoleAdmin))
+	assert.False(t, RoleMember.AtLeast(RoleAdmin))
+	assert.False(t, Role("guest").AtLeast(RoleViewer), "unknown roles have no permissions")
+
+	assert.True(t, RoleMember.CanWrite())
+	assert.False(t, RoleViewer.CanWrite())
+	assert.True(t, RoleAdmin.CanManage())
+	assert.False(t, RoleMember.CanManage())
+}
+
+func TestValidateName(t *testing.T) {
+	name, err := validateName("  Household  ")
+	assert.NoError(t, err)
+	assert.Equal(t, "Household", name)
+
+	_, err = validateName(" ")
+	assert.ErrorIs(t, err, ErrInvalidName)
+
+	_, err = validateName(strings.Repeat("a", maxNameLength+1))
+	assert.ErrorIs(t, err, ErrNameTooLong)
+}
+
+func TestOwnerID(t *testing.T) {
+	organizationID := "org-1"
+	empty := ""
+	assert.Equal(t, "org-1", OwnerID("user-1", &organizationID))
+	assert.Equal(t, "user-1", OwnerID("user-1", nil))
+	assert.Equal(t, "user-1", OwnerID("user-1", &empty))
+}
+
+func TestOwnedBy(t *testing.T) {
+	s := entsql.Dialect(dialect.Postgres).Select("*").From(entsql.Table("email_connections"))
+	OwnedBy[predicate.EmailConnection]("owner-1")(s)
+
+	query, args := s.Query()
+	assert.Equal(t, `SELECT * FROM "email_connections" WHERE "email_connections"."organization_id" = $1 OR ("email_connections"."user_id" = $2 AND "email_connections"."organization_id" IS NULL)`, query)
+	assert.Equal(t, []any{"owner-1", "owner-1"}, args)
+}
@@ -0,0 +1,198 @@
+// Package receipts serves what was read from a user's receipts: the OCR
+// text, and where on the receipt image each field was found, so a viewer
+// can highlight them. Corrections of those regions are kept as training
+// feedback for field extraction. Each receipt also has a provenance trail
+// of the pipeline stages it went through, from the email it was fetched in
+// to the categories its transactions were given.
+//
+// Field regions are stored with the rest of the extraction, as a list
+// under "ocr_fields" in a receipt's extracted_data. Bounding boxes are
+// relative to their page: X and Y locate the top-left corner and Width and
+// Height the size, each a fraction (0-1) of the page's width or height, so
+// they scale with whatever size the image is shown at.
+package receipts
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Errors returned for receipt OCR requests
+var (
+	ErrReceiptNotFound = errors.New("receipt not found")
+	ErrUnknownField    = errors.New("field must be one of: merchant_name, merchant_address, receipt_date, total_amount, tax_amount, subtotal_amount, payment_method, receipt_number")
+	ErrInvalidBox      = errors.New("invalid bounding box")
+	ErrInvalidValue    = errors.New("invalid field value")
+)
+
+// ocrFieldsKey is the extracted_data key field regions are stored under
+const ocrFieldsKey = "ocr_fields"
+
+// Fields are the receipt fields OCR extracts and users can correct
+var Fields = []string{
+	"merchant_name",
+	"merchant_address",
+	"receipt_date",
+	"total_amount",
+	"tax_amount",
+	"subtotal_amount",
+	"payment_method",
+	"receipt_number",
+}
+
+// BoundingBox is a region of a receipt page, in fractions of the page's
+// size. Page counts from 0.
+type BoundingBox struct {
+	Page   int     `json:"page"`
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+}
+
+// Validate checks the box has a size and lies within its page
+func (b BoundingBox) Validate() error {
+	switch {
+	case b.Page < 0:
+		return fmt.Errorf("%w: page must not be negative", ErrInvalidBox)
+	case b.Width <= 0 || b.Height <= 0:
+		return fmt.Errorf("%w: width and height must be positive", ErrInvalidBox)
+	case b.X < 0 || b.Y < 0 || b.X+b.Width > 1 || b.Y+b.Height > 1:
+		return fmt.Errorf("%w: the box must lie within the page, in fractions (0-1) of its size", ErrInvalidBox)
+	}
+	return nil
+}
+
+// OCRField is a field read from a receipt and where it was read from
+type OCRField struct {
+	Field      string       `json:"field"`
+	Value      string       `json:"value"`
+	Confidence *float64     `json:"confidence,omitempty"`
+	Box        *BoundingBox `json:"box,omitempty"`
+	// Corrected is set once a user has corrected the field
+	Corrected bool `json:"corrected,omitempty"`
+}
+
+// OCRResult is what was read from a receipt
+type OCRResult struct {
+	ReceiptID  string
+	FileName   string
+	MimeType   string
+	Completed  bool
+	Text       *string
+	Confidence *float64
+	Fields     []OCRField
+}
+
+// Correction is a user's selection of where a field is on a receipt, and
+// optionally the value they read there
+type Correction struct {
+	Field string
+	Value string
+	Box   BoundingBox
+}
+
+// isField reports whether name is a field users can correct
+func isField(name string) bool {
+	for _, field := range Fields {
+		if field == name {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeFields returns the field regions stored in a receipt's extracted
+// data. Malformed entries are skipped.
+func decodeFields(extracted map[string]interface{}) []OCRField {
+	raw, ok := extracted[ocrFieldsKey].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var fields []OCRField
+	for _, entry := range raw {
+		encoded, err := json.Marshal(entry)
+		if err != nil {
+			continue
+		}
+		var field OCRField
+		if err := json.Unmarshal(encoded, &field); err != nil || field.Field == "" {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// encodeFields returns extracted data with its field regions replaced by
+// fields, leaving the rest of it alone
+func encodeFields(extracted map[string]interface{}, fields []OCRField) (map[string]interface{}, error) {
+	encoded, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	var raw []interface{}
+	if err := json.Unmarshal(encoded, &raw); err != nil {
+		return nil, err
+	}
+
+	updated := make(map[string]interface{}, len(extracted)+1)
+	for key, value := range extracted {
+		updated[key] = value
+	}
+	updated[ocrFieldsKey] = raw
+	return updated, nil
+}
+
+// applyCorrection returns fields with the corrected field's region (and
+// value, if one was given) replaced, or added if it wasn't found, and the
+// field as it was before
+func applyCorrection(fields []OCRField, correction Correction) ([]OCRField, *OCRField) {
+	box := correction.Box
+	corrected := make([]OCRField, len(fields))
+	copy(corrected, fields)
+
+	for i, field := range corrected {
+		if field.Field != correction.Field {
+			continue
+		}
+		previous := field
+		field.Box = &box
+		field.Corrected = true
+		field.Confidence = nil
+		if correction.Value != "" {
+			field.Value = correction.Value
+		}
+		corrected[i] = field
+		return corrected, &previous
+	}
+
+	return append(corrected, OCRField{
+		Field:     correction.Field,
+		Value:     correction.Value,
+		Box:       &box,
+		Corrected: true,
+	}), nil
+}
+
+// parseAmount parses a corrected amount, e.g. "42.50"
+func parseAmount(value string) (float64, error) {
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil || amount < 0 {
+		return 0, fmt.Errorf("%w: %q is not an amount", ErrInvalidValue, value)
+	}
+	return amount, nil
+}
+
+// parseReceiptDate parses a corrected receipt date (YYYY-MM-DD)
+func parseReceiptDate(value string) (time.Time, error) {
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %q is not a date (YYYY-MM-DD)", ErrInvalidValue, value)
+	}
+	return date, nil
+}
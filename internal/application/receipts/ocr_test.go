@@ -0,0 +1,109 @@
+package receipts
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoundingBoxValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		box     BoundingBox
+		wantErr bool
+	}{
+		{name: "within the page", box: BoundingBox{X: 0.1, Y: 0.8, Width: 0.3, Height: 0.05}},
+		{name: "whole page", box: BoundingBox{Page: 1, Width: 1, Height: 1}},
+		{name: "negative page", box: BoundingBox{Page: -1, Width: 0.1, Height: 0.1}, wantErr: true},
+		{name: "no width", box: BoundingBox{X: 0.1, Y: 0.1, Height: 0.1}, wantErr: true},
+		{name: "off the right edge", box: BoundingBox{X: 0.8, Y: 0.1, Width: 0.3, Height: 0.1}, wantErr: true},
+		{name: "pixel coordinates", box: BoundingBox{X: 120, Y: 640, Width: 200, Height: 40}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.box.Validate()
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidBox), "got %v", err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDecodeFields(t *testing.T) {
+	extracted := map[string]interface{}{
+		"vendor": "ignored",
+		"ocr_fields": []interface{}{
+			map[string]interface{}{
+				"field":      "total_amount",
+				"value":      "42.50",
+				"confidence": 0.92,
+				"box":        map[string]interface{}{"page": 0, "x": 0.6, "y": 0.85, "width": 0.2, "height": 0.04},
+			},
+			map[string]interface{}{"value": "no field name"},
+			"not an object",
+			map[string]interface{}{"field": "merchant_name", "value": "Blue Bottle Coffee"},
+		},
+	}
+
+	fields := decodeFields(extracted)
+	require.Len(t, fields, 2)
+	assert.Equal(t, "total_amount", fields[0].Field)
+	assert.Equal(t, "42.50", fields[0].Value)
+	require.NotNil(t, fields[0].Confidence)
+	assert.Equal(t, 0.92, *fields[0].Confidence)
+	assert.Equal(t, &BoundingBox{X: 0.6, Y: 0.85, Width: 0.2, Height: 0.04}, fields[0].Box)
+	assert.Equal(t, "merchant_name", fields[1].Field)
+	assert.Nil(t, fields[1].Box)
+
+	assert.Nil(t, decodeFields(nil))
+	assert.Nil(t, decodeFields(map[string]interface{}{"ocr_fields": "malformed"}))
+}
+
+func TestApplyCorrection(t *testing.T) {
+	confidence := 0.4
+	predicted := BoundingBox{X: 0.1, Y: 0.5, Width: 0.2, Height: 0.04}
+	fields := []OCRField{
+		{Field: "merchant_name", Value: "Blue Bottle Coffee"},
+		{Field: "total_amount", Value: "4.25", Confidence: &confidence, Box: &predicted},
+	}
+	box := BoundingBox{X: 0.6, Y: 0.85, Width: 0.2, Height: 0.04}
+
+	t.Run("replaces the field", func(t *testing.T) {
+		corrected, previous := applyCorrection(fields, Correction{Field: "total_amount", Value: "42.50", Box: box})
+		require.NotNil(t, previous)
+		assert.Equal(t, "4.25", previous.Value)
+		assert.Equal(t, &predicted, previous.Box)
+		assert.Equal(t, OCRField{Field: "total_amount", Value: "42.50", Box: &box, Corrected: true}, corrected[1])
+		assert.Equal(t, "4.25", fields[1].Value, "the original fields are left alone")
+	})
+
+	t.Run("keeps the value without one", func(t *testing.T) {
+		corrected, _ := applyCorrection(fields, Correction{Field: "merchant_name", Box: box})
+		assert.Equal(t, "Blue Bottle Coffee", corrected[0].Value)
+		assert.Equal(t, &box, corrected[0].Box)
+	})
+
+	t.Run("adds a missing field", func(t *testing.T) {
+		corrected, previous := applyCorrection(fields, Correction{Field: "tax_amount", Value: "3.10", Box: box})
+		assert.Nil(t, previous)
+		require.Len(t, corrected, 3)
+		assert.Equal(t, OCRField{Field: "tax_amount", Value: "3.10", Box: &box, Corrected: true}, corrected[2])
+	})
+}
+
+func TestEncodeFieldsRoundTrip(t *testing.T) {
+	box := BoundingBox{Page: 1, X: 0.6, Y: 0.85, Width: 0.2, Height: 0.04}
+	fields := []OCRField{{Field: "total_amount", Value: "42.50", Box: &box, Corrected: true}}
+	extracted := map[string]interface{}{"vendor": "kept"}
+
+	encoded, err := encodeFields(extracted, fields)
+	require.NoError(t, err)
+	assert.Equal(t, "kept", encoded["vendor"])
+	assert.Equal(t, fields, decodeFields(encoded))
+	assert.NotContains(t, extracted, "ocr_fields", "the original extracted data is left alone")
+}
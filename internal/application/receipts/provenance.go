@@ -0,0 +1,301 @@
+package receipts
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"clockzen-next/internal/ent"
+)
+
+// A receipt's provenance is the trail of pipeline stages it went through,
+// in order: the source message fetched, the attachment downloaded, OCR run,
+// fields parsed, matched to transactions and those categorized. Pipeline
+// components record each stage they run as an event, with its version.
+// Stages nothing was recorded for are inferred from what the receipt and
+// the records around it show, so older receipts have a trail too; inferred
+// steps have no version.
+
+// Pipeline stages, in the order they run
+const (
+	StageFetched     = "fetched"
+	StageDownloaded  = "downloaded"
+	StageOCR         = "ocr"
+	StageParsed      = "parsed"
+	StageMatched     = "matched"
+	StageCategorized = "categorized"
+)
+
+// Stages are the pipeline stages in the order they run
+var Stages = []string{StageFetched, StageDownloaded, StageOCR, StageParsed, StageMatched, StageCategorized}
+
+// Statuses of a provenance step
+const (
+	StepSucceeded = "succeeded"
+	StepFailed    = "failed"
+	// StepPending is a stage the receipt hasn't been through yet
+	StepPending = "pending"
+	// StepSkipped is a stage that doesn't apply to the receipt's source,
+	// e.g. fetching an uploaded receipt
+	StepSkipped = "skipped"
+)
+
+// ErrInvalidEvent is returned for a pipeline event that can't be recorded
+var ErrInvalidEvent = errors.New("invalid pipeline event")
+
+// ProvenanceEvent is a pipeline stage run on a receipt, as reported by the
+// component that ran it
+type ProvenanceEvent struct {
+	Stage   string
+	Status  string
+	Version string
+	Detail  string
+	Data    map[string]interface{}
+	// OccurredAt defaults to when the event is recorded
+	OccurredAt time.Time
+}
+
+// Validate checks the event names a stage and a final status
+func (e ProvenanceEvent) Validate() error {
+	if !isStage(e.Stage) {
+		return fmt.Errorf("%w: stage must be one of: fetched, downloaded, ocr, parsed, matched, categorized", ErrInvalidEvent)
+	}
+	if e.Status != "" && e.Status != StepSucceeded && e.Status != StepFailed {
+		return fmt.Errorf("%w: status must be succeeded or failed", ErrInvalidEvent)
+	}
+	return nil
+}
+
+// ProvenanceStep is one step of a receipt's trail
+type ProvenanceStep struct {
+	Stage  string `json:"stage"`
+	Status string `json:"status"`
+	// Recorded is whether the step was recorded by the pipeline, rather
+	// than inferred
+	Recorded   bool                   `json:"recorded"`
+	OccurredAt *time.Time             `json:"occurred_at,omitempty"`
+	Version    *string                `json:"version,omitempty"`
+	Detail     *string                `json:"detail,omitempty"`
+	Data       map[string]interface{} `json:"data,omitempty"`
+}
+
+// Provenance is the trail of pipeline stages a receipt went through
+type Provenance struct {
+	ReceiptID  string
+	SourceType string
+	Status     string
+	Steps      []ProvenanceStep
+}
+
+// provenanceEvidence is what the records around a receipt show of the
+// stages it went through
+type provenanceEvidence struct {
+	// Message is the email the receipt came from, if indexed
+	Message *ent.EmailMessage
+	// Attachments are the message's downloaded attachments
+	Attachments []*ent.AttachmentLink
+	// Blobs are the stored contents of the attachments, by ID
+	Blobs map[string]*ent.AttachmentBlob
+	// Transactions are those matched to the receipt
+	Transactions []*ent.Transaction
+}
+
+// isStage reports whether stage is a pipeline stage
+func isStage(stage string) bool {
+	for _, s := range Stages {
+		if s == stage {
+			return true
+		}
+	}
+	return false
+}
+
+// buildProvenance assembles a receipt's trail, stage by stage: the events
+// recorded for a stage in the order they occurred, else the step inferred
+// from the evidence
+func buildProvenance(record *ent.Receipt, events []*ent.ReceiptEvent, evidence provenanceEvidence) *Provenance {
+	recorded := make(map[string][]*ent.ReceiptEvent)
+	for _, event := range events {
+		recorded[string(event.Stage)] = append(recorded[string(event.Stage)], event)
+	}
+
+	provenance := &Provenance{
+		ReceiptID:  record.ID,
+		SourceType: string(record.SourceType),
+		Status:     string(record.Status),
+	}
+	for _, stage := range Stages {
+		stageEvents := recorded[stage]
+		if len(stageEvents) == 0 {
+			provenance.Steps = append(provenance.Steps, inferStep(stage, record, evidence))
+			continue
+		}
+		sort.SliceStable(stageEvents, func(i, j int) bool {
+			return stageEvents[i].OccurredAt.Before(stageEvents[j].OccurredAt)
+		})
+		for _, event := range stageEvents {
+			occurredAt := event.OccurredAt
+			provenance.Steps = append(provenance.Steps, ProvenanceStep{
+				Stage:      stage,
+				Status:     string(event.Status),
+				Recorded:   true,
+				OccurredAt: &occurredAt,
+				Version:    event.Version,
+				Detail:     event.Detail,
+				Data:       event.Data,
+			})
+		}
+	}
+	return provenance
+}
+
+// inferStep infers a stage's step from the receipt and the evidence
+func inferStep(stage string, record *ent.Receipt, evidence provenanceEvidence) ProvenanceStep {
+	step := ProvenanceStep{Stage: stage, Status: StepPending}
+	failed := string(record.Status) == StepFailed
+
+	switch stage {
+	case StageFetched:
+		switch {
+		case string(record.SourceType) != "email" && string(record.SourceType) != "drive":
+			step.Status = StepSkipped
+		case evidence.Message != nil:
+			step.Status = StepSucceeded
+			step.OccurredAt = timePtr(evidence.Message.CreatedAt)
+			step.Data = map[string]interface{}{
+				"message_id":  evidence.Message.MessageID,
+				"subject":     evidence.Message.Subject,
+				"sender":      evidence.Message.Sender,
+				"received_at": evidence.Message.ReceivedAt,
+			}
+		case record.SourceID != nil:
+			// Fetched, though when isn't known
+			step.Status = StepSucceeded
+			step.Data = map[string]interface{}{"source_id": *record.SourceID}
+		}
+
+	case StageDownloaded:
+		// The receipt's file was stored when it was created; the message's
+		// attachment, if it is known, says when it was downloaded
+		step.Status = StepSucceeded
+		step.OccurredAt = timePtr(record.CreatedAt)
+		step.Data = map[string]interface{}{
+			"file_name": record.FileName,
+			"mime_type": record.MimeType,
+			"size":      record.FileSize,
+		}
+		if link := receiptAttachment(record, evidence.Attachments); link != nil {
+			step.OccurredAt = timePtr(link.CreatedAt)
+			step.Data["attachment_id"] = link.AttachmentID
+			if blob, ok := evidence.Blobs[link.BlobID]; ok {
+				step.Data["sha256"] = blob.Sha256
+				step.Data["size"] = blob.Size
+			}
+		}
+
+	case StageOCR:
+		switch {
+		case record.OcrCompleted:
+			step.Status = StepSucceeded
+			step.OccurredAt = record.ProcessedAt
+			if record.OcrConfidence != nil {
+				step.Data = map[string]interface{}{"confidence": *record.OcrConfidence}
+			}
+		case failed:
+			step.Status = StepFailed
+		}
+
+	case StageParsed:
+		fields := parsedFields(record)
+		switch {
+		case len(fields) > 0:
+			step.Status = StepSucceeded
+			step.OccurredAt = record.ProcessedAt
+			step.Data = map[string]interface{}{"fields": fields}
+			if record.TotalAmount != nil {
+				step.Data["total_amount"] = *record.TotalAmount
+			}
+		case failed:
+			step.Status = StepFailed
+		}
+
+	case StageMatched:
+		if len(evidence.Transactions) > 0 {
+			ids := make([]string, len(evidence.Transactions))
+			first := evidence.Transactions[0].CreatedAt
+			for i, t := range evidence.Transactions {
+				ids[i] = t.ID
+				if t.CreatedAt.Before(first) {
+					first = t.CreatedAt
+				}
+			}
+			step.Status = StepSucceeded
+			step.OccurredAt = timePtr(first)
+			step.Data = map[string]interface{}{"transaction_ids": ids}
+		}
+
+	case StageCategorized:
+		var categories []string
+		var last time.Time
+		seen := make(map[string]bool)
+		for _, t := range evidence.Transactions {
+			if t.MerchantCategory == nil || *t.MerchantCategory == "" {
+				continue
+			}
+			if !seen[*t.MerchantCategory] {
+				seen[*t.MerchantCategory] = true
+				categories = append(categories, *t.MerchantCategory)
+			}
+			if t.UpdatedAt.After(last) {
+				last = t.UpdatedAt
+			}
+		}
+		if len(categories) > 0 {
+			step.Status = StepSucceeded
+			step.OccurredAt = timePtr(last)
+			step.Data = map[string]interface{}{"categories": categories}
+		}
+	}
+	return step
+}
+
+// receiptAttachment returns the message attachment the receipt's file came
+// from: the one with its file name, else the only one
+func receiptAttachment(record *ent.Receipt, links []*ent.AttachmentLink) *ent.AttachmentLink {
+	for _, link := range links {
+		if link.Filename == record.FileName {
+			return link
+		}
+	}
+	if len(links) == 1 {
+		return links[0]
+	}
+	return nil
+}
+
+// parsedFields returns the receipt fields that have been extracted
+func parsedFields(record *ent.Receipt) []string {
+	var fields []string
+	set := map[string]bool{
+		"merchant_name":    record.MerchantName != nil,
+		"merchant_address": record.MerchantAddress != nil,
+		"receipt_date":     record.ReceiptDate != nil,
+		"total_amount":     record.TotalAmount != nil,
+		"tax_amount":       record.TaxAmount != nil,
+		"subtotal_amount":  record.SubtotalAmount != nil,
+		"payment_method":   record.PaymentMethod != nil,
+		"receipt_number":   record.ReceiptNumber != nil,
+	}
+	for _, field := range Fields {
+		if set[field] {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// timePtr returns a pointer to t
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,110 @@
+package receipts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/receipt"
+	"clockzen-next/internal/ent/receiptevent"
+)
+
+func strPtr(s string) *string { return &s }
+
+func floatPtr(f float64) *float64 { return &f }
+
+// stepStatuses returns the stage and status of each step
+func stepStatuses(provenance *Provenance) []string {
+	statuses := make([]string, len(provenance.Steps))
+	for i, step := range provenance.Steps {
+		statuses[i] = step.Stage + ":" + step.Status
+	}
+	return statuses
+}
+
+func TestProvenanceEventValidate(t *testing.T) {
+	assert.NoError(t, ProvenanceEvent{Stage: StageOCR}.Validate())
+	assert.NoError(t, ProvenanceEvent{Stage: StageParsed, Status: StepFailed}.Validate())
+	assert.ErrorIs(t, ProvenanceEvent{Stage: "printed"}.Validate(), ErrInvalidEvent)
+	assert.ErrorIs(t, ProvenanceEvent{Stage: StageOCR, Status: StepPending}.Validate(), ErrInvalidEvent)
+}
+
+func TestBuildProvenanceInfersSteps(t *testing.T) {
+	created := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	processed := created.Add(time.Minute)
+	record := &ent.Receipt{
+		ID:            "r1",
+		SourceType:    receipt.SourceTypeEmail,
+		SourceID:      strPtr("m1"),
+		FileName:      "receipt.pdf",
+		MimeType:      "application/pdf",
+		FileSize:      2048,
+		Status:        receipt.StatusProcessed,
+		OcrCompleted:  true,
+		OcrConfidence: floatPtr(0.92),
+		MerchantName:  strPtr("Corner Cafe"),
+		TotalAmount:   floatPtr(12.5),
+		ProcessedAt:   &processed,
+		CreatedAt:     created,
+	}
+	evidence := provenanceEvidence{
+		Message: &ent.EmailMessage{MessageID: "m1", Subject: "Your receipt", CreatedAt: created.Add(-time.Hour)},
+		Attachments: []*ent.AttachmentLink{
+			{BlobID: "b0", AttachmentID: "a0", Filename: "logo.png", CreatedAt: created.Add(-50 * time.Minute)},
+			{BlobID: "b1", AttachmentID: "a1", Filename: "receipt.pdf", CreatedAt: created.Add(-45 * time.Minute)},
+		},
+		Blobs: map[string]*ent.AttachmentBlob{"b1": {ID: "b1", Sha256: "abc", Size: 2048}},
+		Transactions: []*ent.Transaction{
+			{ID: "t1", CreatedAt: processed.Add(time.Minute), UpdatedAt: processed.Add(time.Hour), MerchantCategory: strPtr("dining")},
+		},
+	}
+
+	provenance := buildProvenance(record, nil, evidence)
+	assert.Equal(t, []string{
+		"fetched:succeeded", "downloaded:succeeded", "ocr:succeeded",
+		"parsed:succeeded", "matched:succeeded", "categorized:succeeded",
+	}, stepStatuses(provenance))
+	for _, step := range provenance.Steps {
+		assert.False(t, step.Recorded)
+		assert.Nil(t, step.Version)
+		require.NotNil(t, step.OccurredAt, step.Stage)
+	}
+
+	downloaded := provenance.Steps[1]
+	assert.Equal(t, created.Add(-45*time.Minute), *downloaded.OccurredAt)
+	assert.Equal(t, "a1", downloaded.Data["attachment_id"])
+	assert.Equal(t, "abc", downloaded.Data["sha256"])
+	assert.Equal(t, []string{"merchant_name", "total_amount"}, provenance.Steps[3].Data["fields"])
+	assert.Equal(t, []string{"t1"}, provenance.Steps[4].Data["transaction_ids"])
+	assert.Equal(t, []string{"dining"}, provenance.Steps[5].Data["categories"])
+}
+
+func TestBuildProvenancePrefersRecordedEvents(t *testing.T) {
+	created := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	record := &ent.Receipt{
+		ID:         "r1",
+		SourceType: receipt.SourceTypeUpload,
+		FileName:   "scan.jpg",
+		Status:     receipt.StatusFailed,
+		CreatedAt:  created,
+	}
+	events := []*ent.ReceiptEvent{
+		{Stage: receiptevent.StageOcr, Status: receiptevent.StatusSucceeded, Version: strPtr("ocr-2.1"), OccurredAt: created.Add(2 * time.Minute)},
+		{Stage: receiptevent.StageOcr, Status: receiptevent.StatusFailed, Version: strPtr("ocr-2.0"), OccurredAt: created.Add(time.Minute)},
+	}
+
+	provenance := buildProvenance(record, events, provenanceEvidence{})
+	assert.Equal(t, []string{
+		"fetched:skipped", "downloaded:succeeded", "ocr:failed", "ocr:succeeded",
+		"parsed:failed", "matched:pending", "categorized:pending",
+	}, stepStatuses(provenance))
+
+	// Recorded attempts are in the order they ran
+	assert.True(t, provenance.Steps[2].Recorded)
+	assert.Equal(t, "ocr-2.0", *provenance.Steps[2].Version)
+	assert.Equal(t, "ocr-2.1", *provenance.Steps[3].Version)
+	assert.False(t, provenance.Steps[4].Recorded)
+}
@@ -0,0 +1,354 @@
+package receipts
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/application/webhooks"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/attachmentblob"
+	"clockzen-next/internal/ent/attachmentlink"
+	"clockzen-next/internal/ent/emailmessage"
+	"clockzen-next/internal/ent/ocrfeedback"
+	"clockzen-next/internal/ent/receipt"
+	"clockzen-next/internal/ent/receiptevent"
+	"clockzen-next/internal/ent/transaction"
+
+	"github.com/google/uuid"
+)
+
+// Service reads receipts' OCR results and provenance, and records
+// corrections of them and the pipeline stages they went through
+type Service struct {
+	entClient *ent.Client
+	publisher webhooks.Publisher
+}
+
+// NewService creates a new receipt service
+func NewService(entClient *ent.Client) *Service {
+	return &Service{
+		entClient: entClient,
+	}
+}
+
+// SetEventPublisher sets where receipt.extracted events are published as
+// receipts' fields are parsed
+func (s *Service) SetEventPublisher(publisher webhooks.Publisher) {
+	s.publisher = publisher
+}
+
+// GetOCR returns the OCR text and field regions of one of the user's
+// receipts
+func (s *Service) GetOCR(ctx context.Context, userID, receiptID string) (*OCRResult, error) {
+	record, err := s.getReceipt(ctx, s.entClient, userID, receiptID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OCRResult{
+		ReceiptID:  record.ID,
+		FileName:   record.FileName,
+		MimeType:   record.MimeType,
+		Completed:  record.OcrCompleted,
+		Text:       record.OcrText,
+		Confidence: record.OcrConfidence,
+		Fields:     decodeFields(record.ExtractedData),
+	}, nil
+}
+
+// SubmitCorrection records a user's correction of where a field is on one
+// of their receipts as training feedback, along with what was extracted
+// before. The receipt's field region is replaced by the correction, and
+// its field by the corrected value if one was given.
+func (s *Service) SubmitCorrection(ctx context.Context, userID, receiptID string, correction Correction) (*ent.OCRFeedback, error) {
+	correction.Value = strings.TrimSpace(correction.Value)
+	if !isField(correction.Field) {
+		return nil, ErrUnknownField
+	}
+	if err := correction.Box.Validate(); err != nil {
+		return nil, err
+	}
+	box, err := json.Marshal(correction.Box)
+	if err != nil {
+		return nil, fmt.Errorf("encoding bounding box: %w", err)
+	}
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	record, err := s.getReceipt(ctx, tx.Client(), userID, receiptID)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, previous := applyCorrection(decodeFields(record.ExtractedData), correction)
+	extracted, err := encodeFields(record.ExtractedData, fields)
+	if err != nil {
+		return nil, fmt.Errorf("encoding field regions: %w", err)
+	}
+	update := record.Update().SetExtractedData(extracted)
+	if correction.Value != "" {
+		if err := setField(update, correction.Field, correction.Value); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return nil, fmt.Errorf("updating receipt: %w", err)
+	}
+
+	create := tx.OCRFeedback.Create().
+		SetID(uuid.New().String()).
+		SetReceiptID(record.ID).
+		SetUserID(userID).
+		SetField(correction.Field).
+		SetBox(box)
+	if correction.Value != "" {
+		create.SetValue(correction.Value)
+	}
+	if previous != nil {
+		create.SetPredictedValue(previous.Value)
+		if previous.Box != nil {
+			predictedBox, err := json.Marshal(previous.Box)
+			if err != nil {
+				return nil, fmt.Errorf("encoding bounding box: %w", err)
+			}
+			create.SetPredictedBox(predictedBox)
+		}
+	}
+	feedback, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("recording feedback: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing correction: %w", err)
+	}
+	return feedback.Unwrap(), nil
+}
+
+// ListFeedback returns the corrections recorded for one of the user's
+// receipts, the latest first
+func (s *Service) ListFeedback(ctx context.Context, userID, receiptID string) ([]*ent.OCRFeedback, error) {
+	if _, err := s.getReceipt(ctx, s.entClient, userID, receiptID); err != nil {
+		return nil, err
+	}
+
+	records, err := s.entClient.OCRFeedback.Query().
+		Where(ocrfeedback.ReceiptID(receiptID)).
+		Order(ent.Desc(ocrfeedback.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying feedback: %w", err)
+	}
+	return records, nil
+}
+
+// RecordEvent records a pipeline stage run on one of the user's receipts
+func (s *Service) RecordEvent(ctx context.Context, userID, receiptID string, event ProvenanceEvent) (*ent.ReceiptEvent, error) {
+	if err := event.Validate(); err != nil {
+		return nil, err
+	}
+	receiptRecord, err := s.getReceipt(ctx, s.entClient, userID, receiptID)
+	if err != nil {
+		return nil, err
+	}
+
+	create := s.entClient.ReceiptEvent.Create().
+		SetID(uuid.New().String()).
+		SetReceiptID(receiptID).
+		SetUserID(userID).
+		SetStage(receiptevent.Stage(event.Stage))
+	if event.Status != "" {
+		create.SetStatus(receiptevent.Status(event.Status))
+	}
+	if version := strings.TrimSpace(event.Version); version != "" {
+		create.SetVersion(version)
+	}
+	if detail := strings.TrimSpace(event.Detail); detail != "" {
+		create.SetDetail(detail)
+	}
+	if len(event.Data) > 0 {
+		create.SetData(event.Data)
+	}
+	if event.OccurredAt.IsZero() {
+		create.SetOccurredAt(time.Now())
+	} else {
+		create.SetOccurredAt(event.OccurredAt)
+	}
+
+	record, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("recording event: %w", err)
+	}
+	if record.Stage == receiptevent.StageParsed && record.Status == receiptevent.StatusSucceeded {
+		s.publishExtracted(ctx, receiptRecord, record)
+	}
+	return record, nil
+}
+
+// ExtractedEvent is the data of a receipt.extracted event: the fields
+// parsed from a receipt
+type ExtractedEvent struct {
+	ReceiptID       string     `json:"receipt_id"`
+	SourceType      string     `json:"source_type"`
+	MerchantName    *string    `json:"merchant_name,omitempty"`
+	ReceiptDate     *time.Time `json:"receipt_date,omitempty"`
+	TotalAmount     *float64   `json:"total_amount,omitempty"`
+	TaxAmount       *float64   `json:"tax_amount,omitempty"`
+	Currency        string     `json:"currency,omitempty"`
+	OCRConfidence   *float64   `json:"ocr_confidence,omitempty"`
+	PipelineVersion *string    `json:"pipeline_version,omitempty"`
+	ExtractedAt     time.Time  `json:"extracted_at"`
+}
+
+// publishExtracted publishes a receipt.extracted event for a receipt whose
+// fields were parsed. Failures are logged; the stage is still recorded.
+func (s *Service) publishExtracted(ctx context.Context, receiptRecord *ent.Receipt, event *ent.ReceiptEvent) {
+	if s.publisher == nil {
+		return
+	}
+	data := ExtractedEvent{
+		ReceiptID:       receiptRecord.ID,
+		SourceType:      string(receiptRecord.SourceType),
+		MerchantName:    receiptRecord.MerchantName,
+		ReceiptDate:     receiptRecord.ReceiptDate,
+		TotalAmount:     receiptRecord.TotalAmount,
+		TaxAmount:       receiptRecord.TaxAmount,
+		Currency:        receiptRecord.Currency,
+		OCRConfidence:   receiptRecord.OcrConfidence,
+		PipelineVersion: event.Version,
+		ExtractedAt:     event.OccurredAt,
+	}
+	if err := s.publisher.Publish(ctx, receiptRecord.UserID, webhooks.EventReceiptExtracted, data); err != nil {
+		slog.ErrorContext(ctx, "publishing receipt event", "receipt_id", receiptRecord.ID, "error", err)
+	}
+}
+
+// GetProvenance returns the trail of pipeline stages one of the user's
+// receipts went through
+func (s *Service) GetProvenance(ctx context.Context, userID, receiptID string) (*Provenance, error) {
+	record, err := s.getReceipt(ctx, s.entClient, userID, receiptID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.entClient.ReceiptEvent.Query().
+		Where(receiptevent.ReceiptID(record.ID)).
+		Order(ent.Asc(receiptevent.FieldOccurredAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying events: %w", err)
+	}
+
+	evidence := provenanceEvidence{Blobs: make(map[string]*ent.AttachmentBlob)}
+	if record.SourceType == receipt.SourceTypeEmail && record.SourceConnectionID != nil && record.SourceID != nil {
+		message, err := s.entClient.EmailMessage.Query().
+			Where(
+				emailmessage.ConnectionID(*record.SourceConnectionID),
+				emailmessage.MessageID(*record.SourceID),
+			).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("querying source message: %w", err)
+		}
+		evidence.Message = message
+
+		evidence.Attachments, err = s.entClient.AttachmentLink.Query().
+			Where(
+				attachmentlink.ConnectionID(*record.SourceConnectionID),
+				attachmentlink.MessageID(*record.SourceID),
+			).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("querying attachments: %w", err)
+		}
+		blobIDs := make([]string, len(evidence.Attachments))
+		for i, link := range evidence.Attachments {
+			blobIDs[i] = link.BlobID
+		}
+		if len(blobIDs) > 0 {
+			blobs, err := s.entClient.AttachmentBlob.Query().
+				Where(attachmentblob.IDIn(blobIDs...)).
+				All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("querying attachment contents: %w", err)
+			}
+			for _, blob := range blobs {
+				evidence.Blobs[blob.ID] = blob
+			}
+		}
+	}
+
+	evidence.Transactions, err = s.entClient.Transaction.Query().
+		Where(transaction.ReceiptID(record.ID), transaction.UserID(userID)).
+		Order(ent.Asc(transaction.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying transactions: %w", err)
+	}
+
+	return buildProvenance(record, events, evidence), nil
+}
+
+// getReceipt loads one of the user's receipts
+func (s *Service) getReceipt(ctx context.Context, client *ent.Client, userID, receiptID string) (*ent.Receipt, error) {
+	record, err := client.Receipt.Query().
+		Where(receipt.ID(receiptID), receipt.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrReceiptNotFound
+		}
+		return nil, fmt.Errorf("getting receipt: %w", err)
+	}
+	return record, nil
+}
+
+// setField sets a receipt field to a corrected value
+func setField(update *ent.ReceiptUpdateOne, field, value string) error {
+	switch field {
+	case "merchant_name":
+		update.SetMerchantName(value)
+	case "merchant_address":
+		update.SetMerchantAddress(value)
+	case "payment_method":
+		update.SetPaymentMethod(value)
+	case "receipt_number":
+		update.SetReceiptNumber(value)
+	case "receipt_date":
+		date, err := parseReceiptDate(value)
+		if err != nil {
+			return err
+		}
+		update.SetReceiptDate(date)
+	case "total_amount":
+		amount, err := parseAmount(value)
+		if err != nil {
+			return err
+		}
+		update.SetTotalAmount(amount)
+	case "tax_amount":
+		amount, err := parseAmount(value)
+		if err != nil {
+			return err
+		}
+		update.SetTaxAmount(amount)
+	case "subtotal_amount":
+		amount, err := parseAmount(value)
+		if err != nil {
+			return err
+		}
+		update.SetSubtotalAmount(amount)
+	default:
+		return errors.New("unhandled receipt field " + field)
+	}
+	return nil
+}
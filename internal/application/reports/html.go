@@ -0,0 +1,197 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"math"
+	"strings"
+	"time"
+)
+
+// htmlFuncs format a report's values in its template
+var htmlFuncs = template.FuncMap{
+	"money":         money,
+	"signedMoney":   signedMoney,
+	"percent":       func(p float64) string { return fmt.Sprintf("%.1f%%", p) },
+	"change":        func(p *float64) string { return fmt.Sprintf("%+.1f%%", *p) },
+	"title":         title,
+	"date":          func(t time.Time) string { return t.Format("Jan 2") },
+	"monthName":     monthName,
+	"statusColor":   func(status string) string { return statusColors[status] },
+	"severityColor": func(severity string) string { return severityColors[severity] },
+	"maxCategory": func(lines []CategoryLine) float64 {
+		max := 0.0
+		for _, l := range lines {
+			max = math.Max(max, l.Amount)
+		}
+		return max
+	},
+	"maxMonth": func(totals []MonthTotal) float64 {
+		max := 0.0
+		for _, t := range totals {
+			max = math.Max(max, t.Amount)
+		}
+		return max
+	},
+	// bar is the width of an amount's bar, as a percent of the largest
+	"bar": func(amount, max float64) string {
+		if max <= 0 || amount <= 0 {
+			return "0"
+		}
+		return fmt.Sprintf("%.0f", math.Max(1, amount/max*100))
+	},
+}
+
+// htmlTemplate lays out a report as a standalone page that also reads as
+// an email: styles are inline, and bars are table cells rather than images
+var htmlTemplate = template.Must(template.New("report").Funcs(htmlFuncs).Parse(reportHTML))
+
+// reportHTML is the report's template
+const reportHTML = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Spending report for {{monthName .Month}}</title>
+</head>
+<body style="margin:0;padding:24px;background:#f5f5f5;font-family:Helvetica,Arial,sans-serif;color:#222;">
+<div style="max-width:680px;margin:0 auto;background:#fff;padding:32px;border-radius:8px;">
+<h1 style="margin:0 0 4px;font-size:24px;">Spending report for {{monthName .Month}}</h1>
+<p style="margin:0 0 24px;color:#777;font-size:13px;">Generated {{.GeneratedAt.Format "Jan 2, 2006 15:04 MST"}}</p>
+
+<table style="width:100%;border-collapse:collapse;margin-bottom:24px;">
+<tr>
+<td style="padding:12px;background:#f0f4f8;border-radius:6px;width:33%;"><div style="color:#777;font-size:12px;">Total spending</div><div style="font-size:22px;font-weight:bold;">{{money .TotalSpending}}</div></td>
+<td style="padding:12px;width:33%;"><div style="color:#777;font-size:12px;">Transactions</div><div style="font-size:22px;font-weight:bold;">{{.TransactionCount}}</div><div style="color:#777;font-size:12px;">{{money .AverageTransaction}} average</div></td>
+<td style="padding:12px;width:33%;"><div style="color:#777;font-size:12px;">vs. previous month</div><div style="font-size:22px;font-weight:bold;">{{if .ChangePercent}}{{change .ChangePercent}}{{else}}&ndash;{{end}}</div><div style="color:#777;font-size:12px;">{{money .PreviousSpending}} last month</div></td>
+</tr>
+</table>
+
+<h2 style="font-size:18px;border-bottom:1px solid #ddd;padding-bottom:6px;">Spending by category</h2>
+{{if .Categories}}
+<table style="width:100%;border-collapse:collapse;font-size:14px;">
+<tr style="color:#777;font-size:12px;text-align:left;"><th style="padding:4px 0;">Category</th><th></th><th style="text-align:right;">Amount</th><th style="text-align:right;">Share</th><th style="text-align:right;">vs. last month</th></tr>
+{{$max := maxCategory .Categories}}{{range .Categories}}
+<tr>
+<td style="padding:4px 8px 4px 0;white-space:nowrap;">{{title .Category}}</td>
+<td style="width:40%;"><div style="background:#4a90d9;height:10px;width:{{bar .Amount $max}}%;"></div></td>
+<td style="text-align:right;">{{money .Amount}}</td>
+<td style="text-align:right;">{{percent .Percentage}}</td>
+<td style="text-align:right;">{{signedMoney .ChangeAmount}}</td>
+</tr>{{end}}
+</table>
+{{else}}<p style="color:#777;">No spending this month.</p>{{end}}
+
+<h2 style="font-size:18px;border-bottom:1px solid #ddd;padding-bottom:6px;">Trend</h2>
+<table style="width:100%;border-collapse:collapse;font-size:14px;">
+{{$max := maxMonth .Trend}}{{range .Trend}}
+<tr>
+<td style="padding:4px 8px 4px 0;white-space:nowrap;width:20%;">{{monthName .Month}}</td>
+<td style="width:60%;"><div style="background:#7fb77e;height:10px;width:{{bar .Amount $max}}%;"></div></td>
+<td style="text-align:right;">{{money .Amount}}</td>
+</tr>{{end}}
+</table>
+
+<h2 style="font-size:18px;border-bottom:1px solid #ddd;padding-bottom:6px;">Budget performance</h2>
+{{if .Budgets}}
+<table style="width:100%;border-collapse:collapse;font-size:14px;">
+<tr style="color:#777;font-size:12px;text-align:left;"><th style="padding:4px 0;">Category</th><th style="text-align:right;">Spent</th><th style="text-align:right;">Limit</th><th style="text-align:right;">Used</th><th style="text-align:right;">Status</th></tr>
+{{range .Budgets}}
+<tr>
+<td style="padding:4px 0;">{{title .Category}}</td>
+<td style="text-align:right;">{{money .Spent}}</td>
+<td style="text-align:right;">{{money .Limit}}</td>
+<td style="text-align:right;">{{percent .Percent}}</td>
+<td style="text-align:right;font-weight:bold;color:{{statusColor .Status}};">{{title .Status}}</td>
+</tr>{{end}}
+</table>
+{{else}}<p style="color:#777;">No budget limits set. Add them to your alert preferences to track them here.</p>{{end}}
+
+<h2 style="font-size:18px;border-bottom:1px solid #ddd;padding-bottom:6px;">Top merchants</h2>
+{{if .TopMerchants}}
+<table style="width:100%;border-collapse:collapse;font-size:14px;">
+{{range .TopMerchants}}
+<tr><td style="padding:4px 0;">{{.Merchant}}</td><td style="text-align:right;color:#777;">{{.TransactionCount}} transactions</td><td style="text-align:right;">{{money .Amount}}</td></tr>{{end}}
+</table>
+{{else}}<p style="color:#777;">No merchants this month.</p>{{end}}
+
+<h2 style="font-size:18px;border-bottom:1px solid #ddd;padding-bottom:6px;">Anomalies</h2>
+{{if .Anomalies}}
+<table style="width:100%;border-collapse:collapse;font-size:14px;">
+{{range .Anomalies}}
+<tr><td style="padding:4px 8px 4px 0;white-space:nowrap;vertical-align:top;">{{date .Date}}</td><td style="padding:4px 0;">{{.Description}}</td><td style="text-align:right;vertical-align:top;color:{{severityColor .Severity}};">{{title .Severity}}</td></tr>{{end}}
+</table>
+{{else}}<p style="color:#777;">Nothing unusual this month.</p>{{end}}
+</div>
+</body>
+</html>
+`
+
+// statusColors color budget statuses
+var statusColors = map[string]string{
+	BudgetUnder: "#2e7d32",
+	BudgetNear:  "#ef6c00",
+	BudgetOver:  "#c62828",
+}
+
+// severityColors color anomaly severities
+var severityColors = map[string]string{
+	"low":    "#777",
+	"medium": "#ef6c00",
+	"high":   "#c62828",
+}
+
+// RenderHTML renders the report as an HTML page
+func RenderHTML(report *MonthlyReport) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, report); err != nil {
+		return nil, fmt.Errorf("rendering report: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// money formats an amount, e.g. $1,234.50
+func money(amount float64) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	whole := fmt.Sprintf("%.2f", amount)
+	dot := strings.IndexByte(whole, '.')
+	var grouped strings.Builder
+	for i, digit := range whole[:dot] {
+		if i > 0 && (dot-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+	return sign + "$" + grouped.String() + whole[dot:]
+}
+
+// signedMoney formats a change in amount, e.g. +$12.00
+func signedMoney(amount float64) string {
+	if amount > 0 {
+		return "+" + money(amount)
+	}
+	return money(amount)
+}
+
+// title formats a category or status for display, e.g. personal_care as
+// Personal care
+func title(s string) string {
+	s = strings.ReplaceAll(s, "_", " ")
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// monthName formats a YYYY-MM month, e.g. September 2026
+func monthName(month string) string {
+	t, err := time.Parse(MonthLayout, month)
+	if err != nil {
+		return month
+	}
+	return t.Format("January 2006")
+}
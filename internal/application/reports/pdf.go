@@ -0,0 +1,185 @@
+package reports
+
+import (
+	"fmt"
+	"math"
+
+	"clockzen-next/internal/infrastructure/pdf"
+)
+
+// Page layout of PDF reports, in points
+const (
+	pdfMargin     = 54.0
+	pdfLineHeight = 18.0
+	pdfBarHeight  = 9.0
+)
+
+// Colors of PDF reports
+var (
+	pdfCategoryBar = pdf.Color{R: 0.29, G: 0.56, B: 0.85}
+	pdfTrendBar    = pdf.Color{R: 0.5, G: 0.72, B: 0.49}
+	pdfGreen       = pdf.Color{R: 0.18, G: 0.49, B: 0.2}
+	pdfOrange      = pdf.Color{R: 0.94, G: 0.42, B: 0}
+	pdfRed         = pdf.Color{R: 0.78, G: 0.16, B: 0.16}
+)
+
+// pdfWriter lays out a report top to bottom, starting new pages as they
+// fill
+type pdfWriter struct {
+	doc *pdf.Document
+	y   float64
+}
+
+// newline moves down by height, starting a new page if there isn't room
+func (w *pdfWriter) newline(height float64) {
+	w.y += height
+	if w.y > w.doc.Height()-pdfMargin {
+		w.doc.AddPage()
+		w.y = pdfMargin + height
+	}
+}
+
+// heading writes a section heading with a rule under it
+func (w *pdfWriter) heading(text string) {
+	w.newline(pdfLineHeight * 2)
+	w.doc.Text(pdfMargin, w.y, pdf.HelveticaBold, 14, pdf.Black, text)
+	w.doc.Line(pdfMargin, w.y+5, w.doc.Width()-pdfMargin, w.y+5, 0.5, pdf.LightGray)
+	w.y += 6
+}
+
+// note writes a line of secondary text
+func (w *pdfWriter) note(text string) {
+	w.newline(pdfLineHeight)
+	w.doc.Text(pdfMargin, w.y, pdf.Helvetica, 10, pdf.Gray, text)
+}
+
+// row writes a line of cells: the first left aligned at the margin, the
+// rest right aligned at the x of their column
+func (w *pdfWriter) row(font pdf.Font, color pdf.Color, first string, firstWidth float64, columns []float64, cells ...string) {
+	w.newline(pdfLineHeight)
+	w.doc.Text(pdfMargin, w.y, font, 10, color, pdf.Truncate(font, 10, firstWidth, first))
+	for i, cell := range cells {
+		w.doc.TextRight(columns[i], w.y, font, 10, color, cell)
+	}
+}
+
+// bar draws a bar for an amount, as a share of max, in the row just written
+func (w *pdfWriter) bar(x, width, amount, max float64, color pdf.Color) {
+	if max <= 0 || amount <= 0 {
+		return
+	}
+	w.doc.Rect(x, w.y-pdfBarHeight+1, math.Max(1, width*amount/max), pdfBarHeight, color)
+}
+
+// RenderPDF renders the report as a PDF document on letter pages
+func RenderPDF(report *MonthlyReport) []byte {
+	doc := pdf.NewDocument(pdf.LetterWidth, pdf.LetterHeight)
+	doc.AddPage()
+	w := &pdfWriter{doc: doc, y: pdfMargin}
+	right := doc.Width() - pdfMargin
+
+	w.newline(8)
+	doc.Text(pdfMargin, w.y, pdf.HelveticaBold, 20, pdf.Black, "Spending report for "+monthName(report.Month))
+	w.newline(pdfLineHeight)
+	doc.Text(pdfMargin, w.y, pdf.Helvetica, 9, pdf.Gray, "Generated "+report.GeneratedAt.Format("Jan 2, 2006 15:04 MST"))
+
+	// Summary
+	w.newline(pdfLineHeight * 2)
+	summary := []struct{ label, value, detail string }{
+		{"Total spending", money(report.TotalSpending), ""},
+		{"Transactions", fmt.Sprintf("%d", report.TransactionCount), money(report.AverageTransaction) + " average"},
+		{"vs. previous month", "-", money(report.PreviousSpending) + " last month"},
+	}
+	if report.ChangePercent != nil {
+		summary[2].value = fmt.Sprintf("%+.1f%%", *report.ChangePercent)
+	}
+	columnWidth := (right - pdfMargin) / float64(len(summary))
+	for i, s := range summary {
+		x := pdfMargin + columnWidth*float64(i)
+		doc.Text(x, w.y, pdf.Helvetica, 9, pdf.Gray, s.label)
+		doc.Text(x, w.y+20, pdf.HelveticaBold, 16, pdf.Black, s.value)
+		doc.Text(x, w.y+34, pdf.Helvetica, 9, pdf.Gray, s.detail)
+	}
+	w.y += 34
+
+	// Spending by category: name, bar, amount, share, change
+	w.heading("Spending by category")
+	if len(report.Categories) == 0 {
+		w.note("No spending this month.")
+	} else {
+		columns := []float64{right - 130, right - 70, right}
+		w.row(pdf.Helvetica, pdf.Gray, "Category", 110, columns, "Amount", "Share", "vs. last month")
+		max := 0.0
+		for _, c := range report.Categories {
+			max = math.Max(max, c.Amount)
+		}
+		for _, c := range report.Categories {
+			w.row(pdf.Helvetica, pdf.Black, title(c.Category), 110, columns,
+				money(c.Amount), fmt.Sprintf("%.1f%%", c.Percentage), signedMoney(c.ChangeAmount))
+			w.bar(pdfMargin+115, columns[0]-pdfMargin-185, c.Amount, max, pdfCategoryBar)
+		}
+	}
+
+	// Trend: month, bar, amount
+	w.heading("Trend")
+	max := 0.0
+	for _, t := range report.Trend {
+		max = math.Max(max, t.Amount)
+	}
+	for _, t := range report.Trend {
+		w.row(pdf.Helvetica, pdf.Black, monthName(t.Month), 110, []float64{right}, money(t.Amount))
+		w.bar(pdfMargin+115, right-pdfMargin-190, t.Amount, max, pdfTrendBar)
+	}
+
+	// Budget performance
+	w.heading("Budget performance")
+	if len(report.Budgets) == 0 {
+		w.note("No budget limits set. Add them to your alert preferences to track them here.")
+	} else {
+		columns := []float64{right - 240, right - 160, right - 80, right}
+		w.row(pdf.Helvetica, pdf.Gray, "Category", 180, columns, "Spent", "Limit", "Used", "Status")
+		for _, b := range report.Budgets {
+			w.row(pdf.Helvetica, pdf.Black, title(b.Category), 180, columns[:3],
+				money(b.Spent), money(b.Limit), fmt.Sprintf("%.1f%%", b.Percent))
+			color := pdfGreen
+			switch b.Status {
+			case BudgetNear:
+				color = pdfOrange
+			case BudgetOver:
+				color = pdfRed
+			}
+			doc.TextRight(right, w.y, pdf.HelveticaBold, 10, color, title(b.Status))
+		}
+	}
+
+	// Top merchants
+	w.heading("Top merchants")
+	if len(report.TopMerchants) == 0 {
+		w.note("No merchants this month.")
+	}
+	for _, m := range report.TopMerchants {
+		w.row(pdf.Helvetica, pdf.Black, m.Merchant, 280, []float64{right - 90, right},
+			fmt.Sprintf("%d transactions", m.TransactionCount), money(m.Amount))
+	}
+
+	// Anomalies
+	w.heading("Anomalies")
+	if len(report.Anomalies) == 0 {
+		w.note("Nothing unusual this month.")
+	}
+	for _, a := range report.Anomalies {
+		color := pdf.Gray
+		switch a.Severity {
+		case "medium":
+			color = pdfOrange
+		case "high":
+			color = pdfRed
+		}
+		w.newline(pdfLineHeight)
+		doc.Text(pdfMargin, w.y, pdf.Helvetica, 10, pdf.Black, a.Date.Format("Jan 2"))
+		doc.Text(pdfMargin+50, w.y, pdf.Helvetica, 10, pdf.Black, pdf.Truncate(pdf.Helvetica, 10, right-pdfMargin-110, a.Description))
+		doc.TextRight(right, w.y, pdf.Helvetica, 10, color, title(a.Severity))
+	}
+
+	return doc.Bytes()
+}
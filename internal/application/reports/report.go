@@ -0,0 +1,375 @@
+// Package reports generates a user's monthly spending report: the month's
+// spending by category against the month before, the trend over recent
+// months, anomalies, budget performance and top merchants. Reports are
+// rendered as HTML or PDF, and generated on demand or emailed at month end.
+package reports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/alertpreference"
+)
+
+// ErrInvalidMonth is returned for a month that isn't YYYY-MM, or hasn't
+// started
+var ErrInvalidMonth = errors.New("month must be YYYY-MM and not in the future")
+
+// MonthLayout is the layout of report months, e.g. 2026-09
+const MonthLayout = "2006-01"
+
+// Report sizes
+const (
+	// TrendMonths is how many months the trend covers, ending with the
+	// report's month
+	TrendMonths = 6
+	// AnomalyLookbackMonths is how many months before the report's month
+	// anomalies are measured against
+	AnomalyLookbackMonths = 3
+	// TopMerchantCount is how many merchants the report lists
+	TopMerchantCount = 10
+	// MaxAnomalies is how many anomalies the report lists, the most severe
+	// first
+	MaxAnomalies = 10
+)
+
+// Budget statuses
+const (
+	BudgetUnder = "under"
+	BudgetNear  = "near"
+	BudgetOver  = "over"
+)
+
+// MonthlyReport is a user's spending in one month
+type MonthlyReport struct {
+	UserID string `json:"user_id"`
+	// Month is the report's month, e.g. 2026-09
+	Month       string    `json:"month"`
+	StartDate   time.Time `json:"start_date"`
+	EndDate     time.Time `json:"end_date"`
+	GeneratedAt time.Time `json:"generated_at"`
+
+	TotalSpending      float64 `json:"total_spending"`
+	TransactionCount   int     `json:"transaction_count"`
+	AverageTransaction float64 `json:"average_transaction"`
+	PreviousSpending   float64 `json:"previous_spending"`
+	// ChangePercent is the change from the month before; nil if nothing was
+	// spent then
+	ChangePercent *float64 `json:"change_percent,omitempty"`
+
+	Categories   []CategoryLine `json:"categories"`
+	Trend        []MonthTotal   `json:"trend"`
+	Anomalies    []AnomalyLine  `json:"anomalies"`
+	Budgets      []BudgetLine   `json:"budgets"`
+	TopMerchants []MerchantLine `json:"top_merchants"`
+}
+
+// CategoryLine is the month's spending in a category
+type CategoryLine struct {
+	Category         string  `json:"category"`
+	Amount           float64 `json:"amount"`
+	TransactionCount int     `json:"transaction_count"`
+	Percentage       float64 `json:"percentage"`
+	PreviousAmount   float64 `json:"previous_amount"`
+	ChangeAmount     float64 `json:"change_amount"`
+}
+
+// MonthTotal is the spending in a month of the trend
+type MonthTotal struct {
+	Month  string  `json:"month"`
+	Amount float64 `json:"amount"`
+}
+
+// AnomalyLine is unusual spending found in the month
+type AnomalyLine struct {
+	Date        time.Time `json:"date"`
+	Type        string    `json:"type"`
+	Severity    string    `json:"severity"`
+	Description string    `json:"description"`
+	Amount      float64   `json:"amount"`
+}
+
+// BudgetLine is the month's spending in a category against its budget
+// limit
+type BudgetLine struct {
+	Category string  `json:"category"`
+	Limit    float64 `json:"limit"`
+	Spent    float64 `json:"spent"`
+	Percent  float64 `json:"percent"`
+	// Status is under, near (past the user's budget threshold) or over
+	Status string `json:"status"`
+}
+
+// MerchantLine is the month's spending at a merchant
+type MerchantLine struct {
+	Merchant         string  `json:"merchant"`
+	Amount           float64 `json:"amount"`
+	TransactionCount int     `json:"transaction_count"`
+}
+
+// Service generates monthly reports
+type Service struct {
+	entClient    *ent.Client
+	transactions analysis.TransactionRepository
+	spending     *analysis.SpendingService
+}
+
+// NewService creates a new report service that reports on the spending in
+// transactions
+func NewService(entClient *ent.Client, transactions analysis.TransactionRepository) *Service {
+	return &Service{
+		entClient:    entClient,
+		transactions: transactions,
+		spending:     analysis.NewSpendingServiceWithDefaults(transactions),
+	}
+}
+
+// ParseMonth parses a YYYY-MM month, rejecting months that haven't started
+// at now. An empty month is the last complete month.
+func ParseMonth(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return monthStart(now).AddDate(0, -1, 0), nil
+	}
+	month, err := time.Parse(MonthLayout, value)
+	if err != nil {
+		return time.Time{}, ErrInvalidMonth
+	}
+	if month.After(now) {
+		return time.Time{}, ErrInvalidMonth
+	}
+	return month, nil
+}
+
+// monthStart returns the start of the month containing t, in UTC
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// monthEnd returns the last instant of the month starting at start;
+// spending is queried inclusive of its end date
+func monthEnd(start time.Time) time.Time {
+	return start.AddDate(0, 1, 0).Add(-time.Nanosecond)
+}
+
+// Generate generates the user's report for the month containing month
+func (s *Service) Generate(ctx context.Context, userID string, month time.Time) (*MonthlyReport, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+	start := monthStart(month)
+	end := monthEnd(start)
+	previousStart := start.AddDate(0, -1, 0)
+
+	current, err := s.transactions.GetByUserID(ctx, userID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("querying spending: %w", err)
+	}
+	previous, err := s.transactions.GetByUserID(ctx, userID, previousStart, monthEnd(previousStart))
+	if err != nil {
+		return nil, fmt.Errorf("querying previous spending: %w", err)
+	}
+	trendStart := start.AddDate(0, -(TrendMonths - 1), 0)
+	monthly, err := s.spending.MonthlySpending(ctx, userID, trendStart, end)
+	if err != nil {
+		return nil, fmt.Errorf("querying spending trend: %w", err)
+	}
+	// Anomalies are measured against the months before, so the month's
+	// spending is compared with what is usual rather than only itself
+	detected, err := s.spending.DetectAnomalies(ctx, userID, start.AddDate(0, -AnomalyLookbackMonths, 0), end)
+	if err != nil {
+		return nil, fmt.Errorf("detecting anomalies: %w", err)
+	}
+
+	var limits map[string]float64
+	threshold := alertpreference.DefaultBudgetThresholdPercent
+	preferences, err := s.entClient.AlertPreference.Query().
+		Where(alertpreference.UserID(userID)).
+		Only(ctx)
+	switch {
+	case err == nil:
+		limits = preferences.BudgetLimits
+		threshold = preferences.BudgetThresholdPercent
+	case !ent.IsNotFound(err):
+		return nil, fmt.Errorf("getting budget limits: %w", err)
+	}
+
+	report := build(userID, start, current, previous)
+	report.Trend = trend(trendStart, monthly)
+	report.Anomalies = anomalies(detected.Anomalies, start, end)
+	report.Budgets = budgets(report.Categories, limits, threshold)
+	report.GeneratedAt = time.Now()
+	return report, nil
+}
+
+// build reports the month's spending by category and merchant against the
+// month before
+func build(userID string, start time.Time, current, previous []analysis.Transaction) *MonthlyReport {
+	report := &MonthlyReport{
+		UserID:           userID,
+		Month:            start.Format(MonthLayout),
+		StartDate:        start,
+		EndDate:          start.AddDate(0, 1, 0),
+		TransactionCount: len(current),
+		Categories:       []CategoryLine{},
+		Anomalies:        []AnomalyLine{},
+		Budgets:          []BudgetLine{},
+		TopMerchants:     []MerchantLine{},
+	}
+
+	categories := make(map[string]*CategoryLine)
+	merchants := make(map[string]*MerchantLine)
+	line := func(category analysis.SpendingCategory) *CategoryLine {
+		name := strings.ToLower(string(category))
+		if categories[name] == nil {
+			categories[name] = &CategoryLine{Category: name}
+		}
+		return categories[name]
+	}
+	for _, t := range current {
+		report.TotalSpending += t.Amount
+		c := line(t.Category)
+		c.Amount += t.Amount
+		c.TransactionCount++
+
+		if t.MerchantName != "" {
+			m := merchants[t.MerchantName]
+			if m == nil {
+				m = &MerchantLine{Merchant: t.MerchantName}
+				merchants[t.MerchantName] = m
+			}
+			m.Amount += t.Amount
+			m.TransactionCount++
+		}
+	}
+	for _, t := range previous {
+		report.PreviousSpending += t.Amount
+		line(t.Category).PreviousAmount += t.Amount
+	}
+
+	report.TotalSpending = round(report.TotalSpending)
+	report.PreviousSpending = round(report.PreviousSpending)
+	if report.TransactionCount > 0 {
+		report.AverageTransaction = round(report.TotalSpending / float64(report.TransactionCount))
+	}
+	if report.PreviousSpending > 0 {
+		change := round((report.TotalSpending - report.PreviousSpending) / report.PreviousSpending * 100)
+		report.ChangePercent = &change
+	}
+
+	for _, c := range categories {
+		c.Amount = round(c.Amount)
+		c.PreviousAmount = round(c.PreviousAmount)
+		c.ChangeAmount = round(c.Amount - c.PreviousAmount)
+		if report.TotalSpending > 0 {
+			c.Percentage = round(c.Amount / report.TotalSpending * 100)
+		}
+		report.Categories = append(report.Categories, *c)
+	}
+	sort.Slice(report.Categories, func(i, j int) bool {
+		a, b := report.Categories[i], report.Categories[j]
+		if a.Amount != b.Amount {
+			return a.Amount > b.Amount
+		}
+		if a.PreviousAmount != b.PreviousAmount {
+			return a.PreviousAmount > b.PreviousAmount
+		}
+		return a.Category < b.Category
+	})
+
+	for _, m := range merchants {
+		m.Amount = round(m.Amount)
+		report.TopMerchants = append(report.TopMerchants, *m)
+	}
+	sort.Slice(report.TopMerchants, func(i, j int) bool {
+		a, b := report.TopMerchants[i], report.TopMerchants[j]
+		if a.Amount != b.Amount {
+			return a.Amount > b.Amount
+		}
+		return a.Merchant < b.Merchant
+	})
+	if len(report.TopMerchants) > TopMerchantCount {
+		report.TopMerchants = report.TopMerchants[:TopMerchantCount]
+	}
+	return report
+}
+
+// trend labels the monthly totals from start, oldest first
+func trend(start time.Time, monthly []float64) []MonthTotal {
+	totals := make([]MonthTotal, len(monthly))
+	for i, amount := range monthly {
+		totals[i] = MonthTotal{
+			Month:  start.AddDate(0, i, 0).Format(MonthLayout),
+			Amount: round(amount),
+		}
+	}
+	return totals
+}
+
+// anomalies returns the anomalies found between start and end, the most
+// severe first, up to MaxAnomalies
+func anomalies(detected []analysis.SpendingAnomaly, start, end time.Time) []AnomalyLine {
+	lines := []AnomalyLine{}
+	for _, a := range detected {
+		if a.TransactionDate.Before(start) || a.TransactionDate.After(end) {
+			continue
+		}
+		lines = append(lines, AnomalyLine{
+			Date:        a.TransactionDate,
+			Type:        string(a.Type),
+			Severity:    string(a.Severity),
+			Description: a.Description,
+			Amount:      round(a.Amount),
+		})
+		if len(lines) == MaxAnomalies {
+			break
+		}
+	}
+	return lines
+}
+
+// budgets compares the month's spending by category against the user's
+// budget limits, alphabetically by category
+func budgets(categories []CategoryLine, limits map[string]float64, thresholdPercent float64) []BudgetLine {
+	spent := make(map[string]float64, len(categories))
+	for _, c := range categories {
+		spent[c.Category] = c.Amount
+	}
+
+	lines := make([]BudgetLine, 0, len(limits))
+	for category, limit := range limits {
+		if limit <= 0 {
+			continue
+		}
+		line := BudgetLine{
+			Category: category,
+			Limit:    limit,
+			Spent:    spent[category],
+			Percent:  round(spent[category] / limit * 100),
+			Status:   BudgetUnder,
+		}
+		switch {
+		case line.Spent > limit:
+			line.Status = BudgetOver
+		case thresholdPercent < 100 && line.Percent >= thresholdPercent:
+			line.Status = BudgetNear
+		}
+		lines = append(lines, line)
+	}
+	sort.Slice(lines, func(i, j int) bool {
+		return lines[i].Category < lines[j].Category
+	})
+	return lines
+}
+
+// round rounds to cents
+func round(f float64) float64 {
+	return math.Round(f*100) / 100
+}
@@ -0,0 +1,176 @@
+package reports
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"clockzen-next/internal/application/analysis"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var september = time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+
+func spend(day int, month time.Month, amount float64, category, merchant string) analysis.Transaction {
+	return analysis.Transaction{
+		Amount:          amount,
+		Category:        analysis.SpendingCategory(category),
+		MerchantName:    merchant,
+		TransactionDate: time.Date(2026, month, day, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestParseMonth(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+	month, err := ParseMonth("", now)
+	require.NoError(t, err)
+	assert.Equal(t, september, month)
+
+	month, err = ParseMonth("2026-10", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), month)
+
+	_, err = ParseMonth("2026-11", now)
+	assert.ErrorIs(t, err, ErrInvalidMonth)
+	_, err = ParseMonth("September", now)
+	assert.ErrorIs(t, err, ErrInvalidMonth)
+}
+
+func TestBuild(t *testing.T) {
+	current := []analysis.Transaction{
+		spend(2, 9, 100, "groceries", "Fresh Market"),
+		spend(9, 9, 50.25, "groceries", "Fresh Market"),
+		spend(12, 9, 40, "dining", "Noodle Bar"),
+		spend(20, 9, 9.75, "other", ""),
+	}
+	previous := []analysis.Transaction{
+		spend(5, 8, 80, "groceries", "Fresh Market"),
+		spend(15, 8, 20, "travel", "Airline"),
+	}
+
+	report := build("user-1", september, current, previous)
+	assert.Equal(t, "2026-09", report.Month)
+	assert.Equal(t, 200.0, report.TotalSpending)
+	assert.Equal(t, 4, report.TransactionCount)
+	assert.Equal(t, 50.0, report.AverageTransaction)
+	assert.Equal(t, 100.0, report.PreviousSpending)
+	require.NotNil(t, report.ChangePercent)
+	assert.Equal(t, 100.0, *report.ChangePercent)
+
+	require.Len(t, report.Categories, 4)
+	assert.Equal(t, CategoryLine{
+		Category: "groceries", Amount: 150.25, TransactionCount: 2, Percentage: 75.13,
+		PreviousAmount: 80, ChangeAmount: 70.25,
+	}, report.Categories[0])
+	assert.Equal(t, "dining", report.Categories[1].Category)
+	assert.Equal(t, "other", report.Categories[2].Category)
+	// Categories only spent in last month are listed, to show the drop
+	assert.Equal(t, CategoryLine{Category: "travel", PreviousAmount: 20, ChangeAmount: -20}, report.Categories[3])
+
+	assert.Equal(t, []MerchantLine{
+		{Merchant: "Fresh Market", Amount: 150.25, TransactionCount: 2},
+		{Merchant: "Noodle Bar", Amount: 40, TransactionCount: 1},
+	}, report.TopMerchants)
+}
+
+func TestBuildWithoutPreviousSpending(t *testing.T) {
+	report := build("user-1", september, []analysis.Transaction{spend(2, 9, 10, "dining", "Cafe")}, nil)
+	assert.Nil(t, report.ChangePercent)
+
+	empty := build("user-1", september, nil, nil)
+	assert.Zero(t, empty.AverageTransaction)
+	assert.NotNil(t, empty.Categories)
+}
+
+func TestBudgets(t *testing.T) {
+	categories := []CategoryLine{
+		{Category: "groceries", Amount: 420},
+		{Category: "dining", Amount: 170},
+		{Category: "travel", Amount: 50},
+	}
+	limits := map[string]float64{"groceries": 400, "dining": 200, "travel": 500, "fuel": 100}
+
+	assert.Equal(t, []BudgetLine{
+		{Category: "dining", Limit: 200, Spent: 170, Percent: 85, Status: BudgetNear},
+		{Category: "fuel", Limit: 100, Spent: 0, Percent: 0, Status: BudgetUnder},
+		{Category: "groceries", Limit: 400, Spent: 420, Percent: 105, Status: BudgetOver},
+		{Category: "travel", Limit: 500, Spent: 50, Percent: 10, Status: BudgetUnder},
+	}, budgets(categories, limits, 80))
+
+	// A threshold of 100 only flags overruns
+	lines := budgets(categories, limits, 100)
+	assert.Equal(t, BudgetUnder, lines[0].Status)
+	assert.Empty(t, budgets(categories, nil, 80))
+}
+
+func TestAnomaliesAndTrend(t *testing.T) {
+	end := monthEnd(september)
+	detected := []analysis.SpendingAnomaly{
+		{Type: analysis.AnomalyLargeTransaction, Severity: analysis.SeverityHigh, Amount: 900, TransactionDate: time.Date(2026, 9, 3, 0, 0, 0, 0, time.UTC), Description: "Large"},
+		{Type: analysis.AnomalyUnusuallyHigh, Severity: analysis.SeverityMedium, Amount: 300, TransactionDate: time.Date(2026, 8, 30, 0, 0, 0, 0, time.UTC), Description: "Before the month"},
+	}
+	lines := anomalies(detected, september, end)
+	require.Len(t, lines, 1)
+	assert.Equal(t, "Large", lines[0].Description)
+	assert.Equal(t, "high", lines[0].Severity)
+
+	assert.Equal(t, []MonthTotal{
+		{Month: "2026-08", Amount: 10.13},
+		{Month: "2026-09", Amount: 0},
+	}, trend(time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC), []float64{10.125, 0}))
+}
+
+func TestMoney(t *testing.T) {
+	assert.Equal(t, "$0.00", money(0))
+	assert.Equal(t, "$999.50", money(999.5))
+	assert.Equal(t, "$1,234,567.89", money(1234567.89))
+	assert.Equal(t, "-$1,200.00", money(-1200))
+	assert.Equal(t, "+$12.00", signedMoney(12))
+	assert.Equal(t, "Personal care", title("personal_care"))
+	assert.Equal(t, "September 2026", monthName("2026-09"))
+}
+
+func sampleReport() *MonthlyReport {
+	report := build("user-1", september, []analysis.Transaction{
+		spend(2, 9, 120, "groceries", "Fresh Market"),
+		spend(12, 9, 40, "dining", "Noodle <Bar>"),
+	}, []analysis.Transaction{spend(5, 8, 80, "groceries", "Fresh Market")})
+	report.Trend = []MonthTotal{{Month: "2026-08", Amount: 80}, {Month: "2026-09", Amount: 160}}
+	report.Budgets = budgets(report.Categories, map[string]float64{"groceries": 100}, 80)
+	report.Anomalies = []AnomalyLine{{Date: september.AddDate(0, 0, 11), Type: "large_transaction", Severity: "high", Description: "Large charge", Amount: 40}}
+	report.GeneratedAt = time.Date(2026, 10, 1, 6, 0, 0, 0, time.UTC)
+	return report
+}
+
+func TestRenderHTML(t *testing.T) {
+	out, err := RenderHTML(sampleReport())
+	require.NoError(t, err)
+
+	html := string(out)
+	assert.Contains(t, html, "Spending report for September 2026")
+	assert.Contains(t, html, "$160.00")
+	assert.Contains(t, html, "&#43;100.0%")
+	assert.Contains(t, html, "Groceries")
+	assert.Contains(t, html, "Noodle &lt;Bar&gt;")
+	assert.Contains(t, html, "color:#c62828")
+	assert.Contains(t, html, "Large charge")
+	assert.NotContains(t, html, "ZgotmplZ")
+}
+
+func TestRenderPDF(t *testing.T) {
+	out := RenderPDF(sampleReport())
+	assert.True(t, bytes.HasPrefix(out, []byte("%PDF-")))
+	assert.Contains(t, string(out), "(Spending report for September 2026) Tj")
+	assert.Contains(t, string(out), "(Noodle <Bar>) Tj")
+	assert.Contains(t, string(out), "(Over) Tj")
+
+	// Long reports run onto more pages
+	long := sampleReport()
+	for i := 0; i < 60; i++ {
+		long.TopMerchants = append(long.TopMerchants, MerchantLine{Merchant: "Merchant", Amount: 1, TransactionCount: 1})
+	}
+	assert.NotContains(t, string(RenderPDF(long)), "/Count 1 >>")
+}
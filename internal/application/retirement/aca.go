@@ -0,0 +1,204 @@
+package retirement
+
+import (
+	"errors"
+	"math"
+)
+
+// =============================================================================
+// ACA Marketplace Coverage
+// =============================================================================
+
+// Before Medicare, a retired household without wages buys marketplace
+// coverage for each person under the eligibility age. The premium, in
+// today's dollars growing like healthcare costs, is added to
+// HealthcareExpense, taken as out-of-pocket costs, and paid in full; the
+// premium tax credit is claimed on the year's MAGI once the year's
+// withdrawals are known and reinvested in the taxable balance. The credit
+// is the benchmark (second-lowest-cost silver) premium less the share of
+// MAGI the household is expected to contribute, rising with income as a
+// multiple of the poverty line; above the subsidy cliff there is none, so
+// a little more income can cost the whole credit. Medicaid isn't modeled:
+// incomes under the first band pay its share.
+
+// ACACoverage prices marketplace coverage for the years before Medicare
+type ACACoverage struct {
+	// BenchmarkPremium is the benchmark plan's annual premium per person
+	// today, and Premium the chosen plan's, the benchmark when 0
+	BenchmarkPremium float64
+	Premium          float64
+
+	// Rules are the subsidy's poverty line and contribution schedule,
+	// DefaultACASubsidyRules when empty
+	Rules ACASubsidyRules
+}
+
+// ACAContributionBand is the share of MAGI expected towards the benchmark
+// premium from an income, as a multiple of the poverty line, rising
+// linearly from InitialRate to FinalRate by the next band
+type ACAContributionBand struct {
+	Over        float64
+	InitialRate float64
+	FinalRate   float64
+}
+
+// ACASubsidyRules set the premium tax credit
+type ACASubsidyRules struct {
+	// PovertyLine is the poverty guideline for one person today, and
+	// PovertyLinePerPerson what each further person adds
+	PovertyLine          float64
+	PovertyLinePerPerson float64
+
+	Bands []ACAContributionBand
+
+	// SubsidyCliff is the income, as a multiple of the poverty line, above
+	// which there is no credit; 0 for none, the last band's final rate
+	// applying above it
+	SubsidyCliff float64
+}
+
+// DefaultACASubsidyRules returns the credit's rules from 2026, when the
+// enhanced subsidies ended and the cliff at 400% of the poverty line
+// returned
+func DefaultACASubsidyRules() ACASubsidyRules {
+	return ACASubsidyRules{
+		PovertyLine:          15650,
+		PovertyLinePerPerson: 5500,
+		Bands: []ACAContributionBand{
+			{Over: 0, InitialRate: 0.021, FinalRate: 0.021},
+			{Over: 1.33, InitialRate: 0.0314, FinalRate: 0.0419},
+			{Over: 1.5, InitialRate: 0.0419, FinalRate: 0.066},
+			{Over: 2, InitialRate: 0.066, FinalRate: 0.0844},
+			{Over: 2.5, InitialRate: 0.0844, FinalRate: 0.0996},
+			{Over: 3, InitialRate: 0.0996, FinalRate: 0.0996},
+		},
+		SubsidyCliff: 4,
+	}
+}
+
+// withDefaults returns the default rules when none are set
+func (r ACASubsidyRules) withDefaults() ACASubsidyRules {
+	if len(r.Bands) == 0 {
+		return DefaultACASubsidyRules()
+	}
+	return r
+}
+
+// validateACA checks the premiums and subsidy rules
+func validateACA(config CashFlowConfig) error {
+	aca := config.ACA
+	if aca == nil {
+		return nil
+	}
+	if aca.BenchmarkPremium < 0 || aca.Premium < 0 {
+		return errors.New("ACA premiums cannot be negative")
+	}
+	r := aca.Rules
+	if len(r.Bands) == 0 {
+		return nil
+	}
+	if r.PovertyLine <= 0 || r.PovertyLinePerPerson < 0 {
+		return errors.New("ACA PovertyLine must be positive")
+	}
+	if r.Bands[0].Over != 0 {
+		return errors.New("ACA contribution bands must start at 0")
+	}
+	for i, band := range r.Bands {
+		if band.InitialRate < 0 || band.FinalRate < band.InitialRate || band.FinalRate > 1 {
+			return errors.New("ACA contribution band rates must rise between 0 and 1")
+		}
+		if i > 0 && band.Over <= r.Bands[i-1].Over {
+			return errors.New("ACA contribution bands are out of order")
+		}
+	}
+	if r.SubsidyCliff < 0 || (r.SubsidyCliff > 0 && r.SubsidyCliff <= r.Bands[len(r.Bands)-1].Over) {
+		return errors.New("ACA SubsidyCliff must be above the last contribution band")
+	}
+	return nil
+}
+
+// acaYear is a plan year's marketplace coverage
+type acaYear struct {
+	rules       ACASubsidyRules
+	premium     float64
+	benchmark   float64
+	povertyLine float64
+}
+
+// acaYear prices a plan year's coverage: none while working or once
+// everyone is on Medicare
+func (c CashFlowConfig) acaYear(flow YearCashFlow, eligibilityAge int, inflationFactor, healthcareInflation float64) acaYear {
+	if c.ACA == nil || !flow.IsRetired || flow.EmploymentIncome > 0 {
+		return acaYear{}
+	}
+	household := 1
+	if c.Spouse != nil && !flow.Survivor {
+		household++
+	}
+	covered := household - c.medicareEnrollees(flow, eligibilityAge)
+	if covered <= 0 {
+		return acaYear{}
+	}
+
+	rules := c.ACA.Rules.withDefaults()
+	premium := c.ACA.Premium
+	if premium == 0 {
+		premium = c.ACA.BenchmarkPremium
+	}
+	return acaYear{
+		rules:       rules,
+		premium:     premium * float64(covered) * healthcareInflation,
+		benchmark:   c.ACA.BenchmarkPremium * float64(covered) * healthcareInflation,
+		povertyLine: (rules.PovertyLine + rules.PovertyLinePerPerson*float64(household-1)) * inflationFactor,
+	}
+}
+
+// contributionRate is the share of MAGI expected at an income as a
+// multiple of the poverty line, and whether it is under the cliff
+func (r ACASubsidyRules) contributionRate(ratio float64) (float64, bool) {
+	if r.SubsidyCliff > 0 && ratio > r.SubsidyCliff {
+		return 0, false
+	}
+	for i := len(r.Bands) - 1; i >= 0; i-- {
+		band := r.Bands[i]
+		if ratio < band.Over {
+			continue
+		}
+		if i+1 == len(r.Bands) {
+			return band.FinalRate, true
+		}
+		progress := (ratio - band.Over) / (r.Bands[i+1].Over - band.Over)
+		return band.InitialRate + progress*(band.FinalRate-band.InitialRate), true
+	}
+	return r.Bands[0].InitialRate, true
+}
+
+// credit is the premium tax credit at MAGI, at most the premium
+func (a acaYear) credit(magi float64) float64 {
+	if a.premium <= 0 || a.povertyLine <= 0 {
+		return 0
+	}
+	rate, eligible := a.rules.contributionRate(magi / a.povertyLine)
+	if !eligible {
+		return 0
+	}
+	return math.Min(a.premium, math.Max(0, a.benchmark-rate*math.Max(0, magi)))
+}
+
+// headroom is the MAGI left before the subsidy cliff, 0 without coverage,
+// a cliff or a credit to lose
+func (a acaYear) headroom(magi float64) float64 {
+	if a.premium <= 0 || a.rules.SubsidyCliff == 0 {
+		return 0
+	}
+	return math.Max(0, a.rules.SubsidyCliff*a.povertyLine-magi)
+}
+
+// conversionLimit is the most income can rise by without losing the
+// credit: unlimited without coverage, a cliff or a credit to lose
+func (a acaYear) conversionLimit(magi float64) float64 {
+	if a.credit(magi) <= 0 || a.rules.SubsidyCliff == 0 {
+		return math.Inf(1)
+	}
+	return a.headroom(magi)
+}
@@ -0,0 +1,129 @@
+package retirement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestACAContributionRate(t *testing.T) {
+	rules := DefaultACASubsidyRules()
+
+	tests := []struct {
+		name         string
+		ratio        float64
+		wantRate     float64
+		wantEligible bool
+	}{
+		{name: "under the poverty line", ratio: 0.5, wantRate: 0.021, wantEligible: true},
+		{name: "start of a band", ratio: 1.5, wantRate: 0.0419, wantEligible: true},
+		{name: "within a band", ratio: 1.75, wantRate: (0.0419 + 0.066) / 2, wantEligible: true},
+		{name: "top band", ratio: 3.5, wantRate: 0.0996, wantEligible: true},
+		{name: "at the cliff", ratio: 4, wantRate: 0.0996, wantEligible: true},
+		{name: "over the cliff", ratio: 4.01},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, eligible := rules.contributionRate(tt.ratio)
+			assert.InDelta(t, tt.wantRate, rate, 1e-12)
+			assert.Equal(t, tt.wantEligible, eligible)
+		})
+	}
+
+	// Without a cliff the top rate applies to any income
+	rules.SubsidyCliff = 0
+	rate, eligible := rules.contributionRate(10)
+	assert.True(t, eligible)
+	assert.Equal(t, 0.0996, rate)
+}
+
+func TestACAYear(t *testing.T) {
+	config := CashFlowConfig{
+		ACA:    &ACACoverage{BenchmarkPremium: 10000, Premium: 8000},
+		Spouse: &SpouseConfig{},
+	}
+
+	// Both covered before Medicare, the poverty line for two grown by
+	// inflation
+	aca := config.acaYear(YearCashFlow{Age: 60, SpouseAge: 58, IsRetired: true}, 65, 2, 1.5)
+	assert.Equal(t, 24000.0, aca.premium)
+	assert.Equal(t, 30000.0, aca.benchmark)
+	assert.Equal(t, 42300.0, aca.povertyLine)
+
+	// At twice the poverty line the household pays 6.6% of MAGI, and the
+	// credit is capped at the cheaper plan's premium
+	assert.InDelta(t, 24000, aca.credit(84600), 1e-9)
+	aca.premium = 30000
+	assert.InDelta(t, 30000-0.066*84600, aca.credit(84600), 1e-9)
+	assert.Equal(t, 0.0, aca.credit(4*42300+1))
+	assert.InDelta(t, 4*42300-84600, aca.headroom(84600), 1e-9)
+	assert.InDelta(t, 4*42300-84600, aca.conversionLimit(84600), 1e-9)
+
+	// Once over the cliff there is nothing left to lose
+	assert.True(t, aca.conversionLimit(200000) > 1e12)
+
+	// One on Medicare leaves the other covered; none while working
+	aca = config.acaYear(YearCashFlow{Age: 66, SpouseAge: 63, IsRetired: true}, 65, 1, 1)
+	assert.Equal(t, 8000.0, aca.premium)
+	assert.Equal(t, acaYear{}, config.acaYear(YearCashFlow{Age: 60, IsRetired: true, EmploymentIncome: 50000}, 65, 1, 1))
+	assert.Equal(t, acaYear{}, config.acaYear(YearCashFlow{Age: 60}, 65, 1, 1))
+}
+
+func TestCashFlowACA(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	config.CurrentAge = 55
+	config.RetirementAge = 55
+	config.LifeExpectancy = 90
+	config.EmploymentIncome = 0
+	config.TaxableBalance = 400000
+	config.TraditionalBalance = 800000
+	config.ACA = &ACACoverage{BenchmarkPremium: 9000}
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+	results, err := service.RunAnalysis()
+	require.NoError(t, err)
+
+	// Drawing on the taxable balance keeps MAGI low and the credit high
+	first := results.YearlyFlows[0]
+	assert.Equal(t, 9000.0, first.ACAPremium)
+	assert.Positive(t, first.ACAPremiumTaxCredit)
+	assert.Positive(t, first.ACASubsidyHeadroom)
+	withoutACA := config
+	withoutACA.ACA = nil
+	baseline, err := service.RunAnalysisWithConfig(withoutACA)
+	require.NoError(t, err)
+	assert.InDelta(t, baseline.YearlyFlows[0].HealthcareExpense+first.ACAPremium-first.ACAPremiumTaxCredit,
+		first.HealthcareExpense, 1e-6)
+
+	// Medicare takes over at 65
+	assert.Equal(t, 0.0, results.YearlyFlows[10].ACAPremium)
+
+	// Conversions are only limited with a credit to keep, stopping at the
+	// cliff
+	config.UseRothConversion = true
+	config.RothConversionAmount = 100000
+	config.RothConversionEndAge = 65
+	withoutACA.UseRothConversion = true
+	withoutACA.RothConversionAmount = 100000
+	withoutACA.RothConversionEndAge = 65
+	unchecked, err := service.RunAnalysisWithConfig(withoutACA)
+	require.NoError(t, err)
+	assert.Equal(t, 100000.0, unchecked.YearlyFlows[0].RothConversion)
+	assert.Equal(t, 0.0, unchecked.YearlyFlows[10].RothConversion)
+
+	converted, err := service.RunAnalysisWithConfig(config)
+	require.NoError(t, err)
+	year := converted.YearlyFlows[0]
+	assert.Positive(t, year.RothConversion)
+	assert.Less(t, year.RothConversion, 100000.0)
+	assert.Positive(t, year.ACAPremiumTaxCredit)
+	assert.Greater(t, year.TotalTax, first.TotalTax)
+
+	config.RothConversionAmount = -1
+	assert.Error(t, validateCashFlowConfig(config))
+	config.RothConversionAmount = 0
+	config.ACA.Rules = ACASubsidyRules{PovertyLine: 15000, Bands: []ACAContributionBand{{Over: 0, InitialRate: 0.02, FinalRate: 0.1}}, SubsidyCliff: -1}
+	assert.Error(t, validateCashFlowConfig(config))
+}
@@ -0,0 +1,286 @@
+package retirement
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+)
+
+// =============================================================================
+// Asset Allocation and Glide Paths
+// =============================================================================
+
+// Without an allocation every account grows at the plan's portfolio return.
+// With one, each account holds stocks, bonds and cash in the shares its
+// glide path sets for the year's age, interpolated between the path's
+// points, and earns those asset classes' returns. Simulations draw each
+// asset class with its own volatility, correlated with the others by the
+// assumptions' correlations (independent when they are 0); the bundled
+// market history has no bond series, so historical runs pair historical
+// stock returns with bond and cash returns drawn around their expected
+// returns, correlated with the historical stock return's deviation.
+
+// allocationTolerance is how far an allocation's shares may sum from 1
+const allocationTolerance = 1e-6
+
+// AssetAllocation is the share of an account in each asset class
+type AssetAllocation struct {
+	Stocks float64
+	Bonds  float64
+	Cash   float64
+}
+
+// validate checks the shares are between 0 and 1 and add up to 1
+func (a AssetAllocation) validate() error {
+	for _, share := range []float64{a.Stocks, a.Bonds, a.Cash} {
+		if share < 0 || share > 1 {
+			return errors.New("allocation shares must be between 0 and 1")
+		}
+	}
+	if math.Abs(a.Stocks+a.Bonds+a.Cash-1) > allocationTolerance {
+		return errors.New("allocation shares must add up to 1")
+	}
+	return nil
+}
+
+// Return is the allocation's return given each asset class's
+func (a AssetAllocation) Return(r AssetReturns) float64 {
+	return a.Stocks*r.Stocks + a.Bonds*r.Bonds + a.Cash*r.Cash
+}
+
+// AssetReturns are a year's return of each asset class
+type AssetReturns struct {
+	Stocks float64
+	Bonds  float64
+	Cash   float64
+}
+
+// MarketAssumptions are each asset class's expected annual return, its
+// volatility (standard deviation) and its correlation with the others
+type MarketAssumptions struct {
+	StockReturn     float64
+	BondReturn      float64
+	CashReturn      float64
+	StockVolatility float64
+	BondVolatility  float64
+	CashVolatility  float64
+
+	StockBondCorrelation float64
+	StockCashCorrelation float64
+	BondCashCorrelation  float64
+}
+
+// DefaultMarketAssumptions returns long-run nominal return assumptions
+func DefaultMarketAssumptions() MarketAssumptions {
+	return MarketAssumptions{
+		StockReturn:     0.08,
+		BondReturn:      0.04,
+		CashReturn:      0.02,
+		StockVolatility: 0.17,
+		BondVolatility:  0.06,
+		CashVolatility:  0.01,
+	}
+}
+
+// Expected returns the asset classes' expected returns
+func (m MarketAssumptions) Expected() AssetReturns {
+	return AssetReturns{Stocks: m.StockReturn, Bonds: m.BondReturn, Cash: m.CashReturn}
+}
+
+// Validate checks the volatilities aren't negative and the correlations
+// are between -1 and 1 and consistent with each other
+func (m MarketAssumptions) Validate() error {
+	if m.StockVolatility < 0 || m.BondVolatility < 0 || m.CashVolatility < 0 {
+		return errors.New("asset volatilities cannot be negative")
+	}
+	for _, c := range []float64{m.StockBondCorrelation, m.StockCashCorrelation, m.BondCashCorrelation} {
+		if c < -1 || c > 1 {
+			return errors.New("asset correlations must be between -1 and 1")
+		}
+	}
+	if _, ok := m.correlationFactor(); !ok {
+		return errors.New("asset correlations are inconsistent with each other")
+	}
+	return nil
+}
+
+// correlationFactor is the lower triangular Cholesky factor of the
+// stock, bond and cash correlation matrix; ok is false when the matrix
+// isn't positive semidefinite
+func (m MarketAssumptions) correlationFactor() (l [3][3]float64, ok bool) {
+	const tolerance = 1e-9
+	l[0][0] = 1
+	l[1][0] = m.StockBondCorrelation
+	l[2][0] = m.StockCashCorrelation
+
+	bond := 1 - l[1][0]*l[1][0]
+	l[1][1] = math.Sqrt(math.Max(bond, 0))
+	crossed := m.BondCashCorrelation - l[2][0]*l[1][0]
+	if l[1][1] > tolerance {
+		l[2][1] = crossed / l[1][1]
+	} else if math.Abs(crossed) > tolerance {
+		return l, false
+	}
+
+	cash := 1 - l[2][0]*l[2][0] - l[2][1]*l[2][1]
+	if cash < -tolerance {
+		return l, false
+	}
+	l[2][2] = math.Sqrt(math.Max(cash, 0))
+	return l, true
+}
+
+// GlidePathPoint is the allocation held from Age
+type GlidePathPoint struct {
+	Age        int
+	Allocation AssetAllocation
+}
+
+// GlidePath is an allocation changing with age, its points in age order.
+// Between points the allocation moves in a straight line; before the first
+// and after the last it holds.
+type GlidePath []GlidePathPoint
+
+// At returns the allocation at age
+func (g GlidePath) At(age int) AssetAllocation {
+	if age <= g[0].Age {
+		return g[0].Allocation
+	}
+	for i := 1; i < len(g); i++ {
+		if age < g[i].Age {
+			from, to := g[i-1], g[i]
+			t := float64(age-from.Age) / float64(to.Age-from.Age)
+			return AssetAllocation{
+				Stocks: from.Allocation.Stocks + t*(to.Allocation.Stocks-from.Allocation.Stocks),
+				Bonds:  from.Allocation.Bonds + t*(to.Allocation.Bonds-from.Allocation.Bonds),
+				Cash:   from.Allocation.Cash + t*(to.Allocation.Cash-from.Allocation.Cash),
+			}
+		}
+	}
+	return g[len(g)-1].Allocation
+}
+
+// validate checks the path has points in increasing age order and valid
+// allocations
+func (g GlidePath) validate() error {
+	if len(g) == 0 {
+		return errors.New("glide path needs at least one point")
+	}
+	for i, point := range g {
+		if i > 0 && point.Age <= g[i-1].Age {
+			return errors.New("glide path ages must increase")
+		}
+		if err := point.Allocation.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BondTentGlidePath moves from baseStocks in stocks down to
+// retirementStocks over the years before retirement and back up over as
+// many years after, the rest in bonds: a bond tent protecting the years
+// when sequence of returns risk is highest
+func BondTentGlidePath(retirementAge, years int, baseStocks, retirementStocks float64) GlidePath {
+	point := func(age int, stocks float64) GlidePathPoint {
+		return GlidePathPoint{Age: age, Allocation: AssetAllocation{Stocks: stocks, Bonds: 1 - stocks}}
+	}
+	return GlidePath{
+		point(retirementAge-years, baseStocks),
+		point(retirementAge, retirementStocks),
+		point(retirementAge+years, baseStocks),
+	}
+}
+
+// AllocationConfig sets how the accounts are invested. GlidePath applies
+// to every account without its own.
+type AllocationConfig struct {
+	Assumptions MarketAssumptions
+	GlidePath   GlidePath
+
+	Taxable     GlidePath
+	Traditional GlidePath
+	Roth        GlidePath
+	HSA         GlidePath
+}
+
+// validate checks the glide paths and market assumptions
+func (a *AllocationConfig) validate() error {
+	if err := a.GlidePath.validate(); err != nil {
+		return err
+	}
+	for _, path := range []GlidePath{a.Taxable, a.Traditional, a.Roth, a.HSA} {
+		if path == nil {
+			continue
+		}
+		if err := path.validate(); err != nil {
+			return err
+		}
+	}
+	return a.Assumptions.Validate()
+}
+
+// accountPath returns an account's glide path, the household's if it has
+// none of its own
+func (a *AllocationConfig) accountPath(path GlidePath) GlidePath {
+	if path == nil {
+		return a.GlidePath
+	}
+	return path
+}
+
+// accountReturns returns each account's return in a plan year: the
+// portfolio return, or with an allocation, its return on the year's asset
+// class returns
+func (c CashFlowConfig) accountReturns(age int, portfolioReturn float64, assets []AssetReturns, year int) (taxable, traditional, roth, hsa float64) {
+	a := c.Allocation
+	if a == nil || assets == nil {
+		return portfolioReturn, portfolioReturn, portfolioReturn, portfolioReturn
+	}
+	r := assets[year]
+	return a.accountPath(a.Taxable).At(age).Return(r),
+		a.accountPath(a.Traditional).At(age).Return(r),
+		a.accountPath(a.Roth).At(age).Return(r),
+		a.accountPath(a.HSA).At(age).Return(r)
+}
+
+// allocatedReturns sets each year's portfolio return to the household glide
+// path's return on that year's asset class returns
+func (c CashFlowConfig) allocatedReturns(returns []float64, assets []AssetReturns) {
+	for year := range returns {
+		returns[year] = c.Allocation.GlidePath.At(c.CurrentAge + year).Return(assets[year])
+	}
+}
+
+// drawAssetReturns draws a year's asset class returns; a historical draw
+// keeps the stock return already drawn from history
+func drawAssetReturns(m MarketAssumptions, mc CashFlowMonteCarloConfig, historicalStocks float64, rng *rand.Rand) AssetReturns {
+	rate := func(mean, stdDev, z float64) float64 {
+		if mc.ReturnDistribution == DistributionLognormal {
+			return lognormalRate(mean, stdDev, z)
+		}
+		return mean + stdDev*z
+	}
+
+	// Independent shocks, correlated through the Cholesky factor
+	bond, cash := rng.NormFloat64(), rng.NormFloat64()
+	var stock float64
+	historical := mc.ReturnDistribution == DistributionHistorical
+	if !historical {
+		stock = rng.NormFloat64()
+	} else if m.StockVolatility > 0 {
+		stock = (historicalStocks - m.StockReturn) / m.StockVolatility
+	}
+	l, _ := m.correlationFactor()
+
+	r := AssetReturns{
+		Bonds: rate(m.BondReturn, m.BondVolatility, l[1][0]*stock+l[1][1]*bond),
+		Cash:  rate(m.CashReturn, m.CashVolatility, l[2][0]*stock+l[2][1]*bond+l[2][2]*cash),
+	}
+	if historical {
+		r.Stocks = historicalStocks
+	} else {
+		r.Stocks = rate(m.StockReturn, m.StockVolatility, stock)
+	}
+	return r
+}
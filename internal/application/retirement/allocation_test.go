@@ -0,0 +1,111 @@
+package retirement
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlidePathAt(t *testing.T) {
+	path := BondTentGlidePath(65, 10, 0.8, 0.4)
+
+	assert.Equal(t, 0.8, path.At(40).Stocks)
+	assert.InDelta(t, 0.2, path.At(40).Bonds, 1e-9)
+	assert.InDelta(t, 0.6, path.At(60).Stocks, 1e-9)
+	assert.InDelta(t, 0.4, path.At(60).Bonds, 1e-9)
+	assert.Equal(t, 0.4, path.At(65).Stocks)
+	assert.InDelta(t, 0.6, path.At(70).Stocks, 1e-9)
+	assert.Equal(t, 0.8, path.At(90).Stocks)
+	require.NoError(t, path.validate())
+
+	assert.Error(t, GlidePath{}.validate())
+	assert.Error(t, GlidePath{{Age: 50, Allocation: AssetAllocation{Stocks: 1}}, {Age: 50, Allocation: AssetAllocation{Bonds: 1}}}.validate())
+	assert.Error(t, GlidePath{{Age: 50, Allocation: AssetAllocation{Stocks: 0.7}}}.validate())
+}
+
+func TestCashFlowAllocation(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	config.Allocation = &AllocationConfig{
+		Assumptions: DefaultMarketAssumptions(),
+		GlidePath:   BondTentGlidePath(config.RetirementAge, 10, 0.9, 0.3),
+		Roth:        GlidePath{{Age: config.CurrentAge, Allocation: AssetAllocation{Stocks: 1}}},
+	}
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+	results, err := service.RunAnalysis()
+	require.NoError(t, err)
+
+	// The portfolio return follows the household glide path
+	first := results.YearlyFlows[0]
+	assert.Equal(t, 0.9, first.Allocation.Stocks)
+	assert.InDelta(t, 0.9*0.08+0.1*0.04, first.PortfolioReturn, 1e-9)
+	retired := results.YearlyFlows[config.RetirementAge-config.CurrentAge]
+	assert.InDelta(t, 0.3*0.08+0.7*0.04, retired.PortfolioReturn, 1e-9)
+
+	// The bond tent ends with less than the same plan all in stocks
+	allStocks := config
+	allStocks.Allocation = &AllocationConfig{
+		Assumptions: DefaultMarketAssumptions(),
+		GlidePath:   GlidePath{{Age: config.CurrentAge, Allocation: AssetAllocation{Stocks: 1}}},
+	}
+	stocks, err := service.RunAnalysisWithConfig(allStocks)
+	require.NoError(t, err)
+	assert.Less(t, results.YearlyFlows[len(results.YearlyFlows)-1].TotalPortfolio,
+		stocks.YearlyFlows[len(stocks.YearlyFlows)-1].TotalPortfolio)
+
+	// Without an allocation every year earns ExpectedReturn
+	config.Allocation = nil
+	plain, err := service.RunAnalysisWithConfig(config)
+	require.NoError(t, err)
+	assert.Equal(t, config.ExpectedReturn, plain.YearlyFlows[0].PortfolioReturn)
+	assert.Equal(t, AssetAllocation{}, plain.YearlyFlows[0].Allocation)
+
+	config.Allocation = &AllocationConfig{GlidePath: GlidePath{{Age: 50, Allocation: AssetAllocation{Stocks: 2}}}}
+	assert.Error(t, validateCashFlowConfig(config))
+}
+
+func TestDrawAssetReturns(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	m := DefaultMarketAssumptions()
+	m.BondVolatility = 0
+
+	r := drawAssetReturns(m, CashFlowMonteCarloConfig{ReturnDistribution: DistributionHistorical}, 0.25, rng)
+	assert.Equal(t, 0.25, r.Stocks)
+	assert.Equal(t, m.BondReturn, r.Bonds)
+
+	r = drawAssetReturns(m, CashFlowMonteCarloConfig{ReturnDistribution: DistributionNormal}, 0.25, rng)
+	assert.NotEqual(t, 0.25, r.Stocks)
+	assert.Equal(t, m.BondReturn, r.Bonds)
+}
+
+func TestCorrelatedAssetReturns(t *testing.T) {
+	m := DefaultMarketAssumptions()
+	m.StockBondCorrelation = 0.9
+	require.NoError(t, m.Validate())
+
+	rng := rand.New(rand.NewSource(3))
+	mc := CashFlowMonteCarloConfig{ReturnDistribution: DistributionNormal}
+	const draws = 5000
+	var sumS, sumB, sumSS, sumBB, sumSB float64
+	for i := 0; i < draws; i++ {
+		r := drawAssetReturns(m, mc, 0, rng)
+		sumS += r.Stocks
+		sumB += r.Bonds
+		sumSS += r.Stocks * r.Stocks
+		sumBB += r.Bonds * r.Bonds
+		sumSB += r.Stocks * r.Bonds
+	}
+	cov := sumSB/draws - sumS/draws*sumB/draws
+	varS := sumSS/draws - sumS/draws*sumS/draws
+	varB := sumBB/draws - sumB/draws*sumB/draws
+	assert.InDelta(t, 0.9, cov/math.Sqrt(varS*varB), 0.05)
+
+	m.StockCashCorrelation = 0.9
+	m.BondCashCorrelation = -0.9
+	assert.Error(t, m.Validate())
+	m.BondCashCorrelation = 1.5
+	assert.Error(t, m.Validate())
+}
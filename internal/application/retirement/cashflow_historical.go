@@ -0,0 +1,231 @@
+package retirement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// ErrHistoryTooShort is returned when the bundled market history has no
+// window as long as the analysis
+var ErrHistoryTooShort = errors.New("market history is shorter than the analysis")
+
+// HistoricalBacktestConfig holds the settings for replaying the analysis
+// through history. Every window of consecutive calendar years as long as the
+// analysis is replayed, starting in the years between FirstStartYear and
+// LastStartYear.
+type HistoricalBacktestConfig struct {
+	// First calendar year a window may start in (0 for the earliest)
+	FirstStartYear int
+
+	// Last calendar year a window may start in (0 for the latest)
+	LastStartYear int
+}
+
+// HistoricalWindow is the outcome of replaying the analysis through one
+// window of market history. Portfolio values are in the first year's
+// dollars, deflated by the window's inflation.
+type HistoricalWindow struct {
+	// Calendar years the window replays
+	StartYear int
+	EndYear   int
+
+	// Whether every retired year's spending was met, and if not, the age the
+	// portfolio ran out
+	Success      bool
+	DepletionAge int
+
+	FinalPortfolio float64
+
+	// Lowest portfolio in retirement and the age it was reached
+	LowestPortfolio    float64
+	LowestPortfolioAge int
+
+	// Annualized real return over the window
+	AnnualizedRealReturn float64
+}
+
+// HistoricalBacktestResults holds the outcomes of replaying the analysis
+// through every window of market history
+type HistoricalBacktestResults struct {
+	// Years in each window
+	WindowYears int
+
+	// Share of windows (0-1) in which every retired year's spending was met
+	SuccessRate  float64
+	SuccessCount int
+	TotalWindows int
+
+	// Windows ranked by outcome: failures by how early the portfolio ran
+	// out, then by final portfolio
+	Best   HistoricalWindow
+	Median HistoricalWindow
+	Worst  HistoricalWindow
+
+	// Every window, oldest start first
+	Windows []HistoricalWindow
+
+	// Start years of the windows in which the plan failed
+	FailedStartYears []int
+
+	// Portfolio percentiles across windows at the end of each year, and at
+	// the end of the analysis, in first-year dollars
+	PortfolioPaths   []PortfolioPathPoint
+	FinalPercentiles PercentileResults
+
+	// Backtest duration
+	Duration time.Duration
+}
+
+// RunHistoricalBacktest replays the analysis through every window of the
+// bundled market history, each year's return and inflation taken from the
+// same calendar year, the way cFIREsim does. A window succeeds when the
+// portfolio covers every retired year's spending.
+func (s *CashFlowService) RunHistoricalBacktest(ctx context.Context, config CashFlowConfig, backtest HistoricalBacktestConfig) (*HistoricalBacktestResults, error) {
+	if err := validateCashFlowConfig(config); err != nil {
+		return nil, err
+	}
+
+	totalYears := config.LifeExpectancy - config.CurrentAge
+	first := historicalYears[0].Year
+	last := historicalYears[len(historicalYears)-1].Year - totalYears + 1
+	if last < first {
+		return nil, fmt.Errorf("%w: a %d-year analysis needs more than the %d years since %d",
+			ErrHistoryTooShort, totalYears, len(historicalYears), first)
+	}
+	if backtest.FirstStartYear != 0 {
+		first = max(first, backtest.FirstStartYear)
+	}
+	if backtest.LastStartYear != 0 {
+		last = min(last, backtest.LastStartYear)
+	}
+	if last < first {
+		return nil, fmt.Errorf("%w: no %d-year window starts between %d and %d",
+			ErrHistoryTooShort, totalYears, backtest.FirstStartYear, backtest.LastStartYear)
+	}
+
+	startTime := time.Now()
+
+	windows := make([]HistoricalWindow, 0, last-first+1)
+	portfolios := make([][]float64, 0, last-first+1)
+	for startYear := first; startYear <= last; startYear++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		window, path := s.replayWindow(config, startYear-historicalYears[0].Year)
+		windows = append(windows, window)
+		portfolios = append(portfolios, path)
+	}
+
+	results := &HistoricalBacktestResults{
+		WindowYears:    totalYears,
+		TotalWindows:   len(windows),
+		Windows:        windows,
+		PortfolioPaths: make([]PortfolioPathPoint, totalYears),
+	}
+	for _, window := range windows {
+		if window.Success {
+			results.SuccessCount++
+		} else {
+			results.FailedStartYears = append(results.FailedStartYears, window.StartYear)
+		}
+	}
+	results.SuccessRate = float64(results.SuccessCount) / float64(len(windows))
+
+	ranked := make([]HistoricalWindow, len(windows))
+	copy(ranked, windows)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return worseOutcome(ranked[i], ranked[j])
+	})
+	results.Worst = ranked[0]
+	results.Median = ranked[len(ranked)/2]
+	results.Best = ranked[len(ranked)-1]
+
+	values := make([]float64, len(windows))
+	for year := range totalYears {
+		for i, path := range portfolios {
+			values[i] = path[year]
+		}
+		sort.Float64s(values)
+		results.PortfolioPaths[year] = PortfolioPathPoint{
+			Year:        year + 1,
+			Age:         config.CurrentAge + year,
+			Percentiles: percentilesOf(values),
+		}
+	}
+	results.FinalPercentiles = results.PortfolioPaths[totalYears-1].Percentiles
+
+	results.Duration = time.Since(startTime)
+	return results, nil
+}
+
+// replayWindow projects the analysis through the market history starting at
+// historicalYears[offset], returning the outcome and the real portfolio at
+// the end of each year
+func (s *CashFlowService) replayWindow(config CashFlowConfig, offset int) (HistoricalWindow, []float64) {
+	totalYears := config.LifeExpectancy - config.CurrentAge
+	history := historicalYears[offset : offset+totalYears]
+	returns := make([]float64, totalYears)
+	inflation := make([]float64, totalYears)
+	var assets []AssetReturns
+	for year, h := range history {
+		returns[year] = h.StockReturn
+		inflation[year] = h.Inflation
+	}
+	if config.Allocation != nil {
+		// There is no bond history; bonds and cash earn their expected returns
+		assets = make([]AssetReturns, totalYears)
+		for year, h := range history {
+			assets[year] = config.Allocation.Assumptions.Expected()
+			assets[year].Stocks = h.StockReturn
+		}
+		config.allocatedReturns(returns, assets)
+	}
+
+	flows := s.projectYears(config, returns, assets, inflation)
+
+	window := HistoricalWindow{
+		StartYear:       history[0].Year,
+		EndYear:         history[totalYears-1].Year,
+		Success:         true,
+		LowestPortfolio: math.Inf(1),
+	}
+	path := make([]float64, totalYears)
+	deflator, growth := 1.0, 1.0
+	for year, flow := range flows {
+		deflator *= 1 + inflation[year]
+		growth *= (1 + returns[year]) / (1 + inflation[year])
+		path[year] = flow.TotalPortfolio / deflator
+
+		if !flow.IsRetired {
+			continue
+		}
+		if path[year] < window.LowestPortfolio {
+			window.LowestPortfolio = path[year]
+			window.LowestPortfolioAge = flow.Age
+		}
+		if window.Success && flow.NetCashFlow < -shortfallTolerance {
+			window.Success = false
+			window.DepletionAge = flow.Age
+		}
+	}
+	window.FinalPortfolio = path[totalYears-1]
+	window.AnnualizedRealReturn = math.Pow(math.Max(growth, 0), 1/float64(totalYears)) - 1
+
+	return window, path
+}
+
+// worseOutcome reports whether window a ended worse than b: it failed when b
+// didn't, ran out earlier, or ended with less
+func worseOutcome(a, b HistoricalWindow) bool {
+	if a.Success != b.Success {
+		return !a.Success
+	}
+	if !a.Success && a.DepletionAge != b.DepletionAge {
+		return a.DepletionAge < b.DepletionAge
+	}
+	return a.FinalPortfolio < b.FinalPortfolio
+}
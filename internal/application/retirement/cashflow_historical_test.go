@@ -0,0 +1,107 @@
+package retirement
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// retireeConfig is a retiree drawing from a portfolio over 30 years, so
+// outcomes depend on the market history replayed
+func retireeConfig() CashFlowConfig {
+	config := DefaultCashFlowConfig()
+	config.CurrentAge = 65
+	config.RetirementAge = 65
+	config.LifeExpectancy = 95
+	config.TaxableBalance = 600000
+	config.TraditionalBalance = 400000
+	config.RothBalance = 0
+	config.HSABalance = 0
+	config.SocialSecurityStartAge = 67
+	return config
+}
+
+func TestRunHistoricalBacktestReplaysRollingWindows(t *testing.T) {
+	config := retireeConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	results, err := service.RunHistoricalBacktest(context.Background(), config, HistoricalBacktestConfig{})
+	require.NoError(t, err)
+
+	first, last := historicalYears[0].Year, historicalYears[len(historicalYears)-1].Year
+	assert.Equal(t, 30, results.WindowYears)
+	require.Equal(t, last-first-30+2, results.TotalWindows)
+	require.Len(t, results.Windows, results.TotalWindows)
+	assert.Equal(t, first, results.Windows[0].StartYear)
+	assert.Equal(t, first+29, results.Windows[0].EndYear)
+	assert.Equal(t, last, results.Windows[len(results.Windows)-1].EndYear)
+
+	assert.Equal(t, results.TotalWindows-len(results.FailedStartYears), results.SuccessCount)
+	assert.InDelta(t, float64(results.SuccessCount)/float64(results.TotalWindows), results.SuccessRate, 1e-12)
+	assert.Greater(t, results.SuccessRate, 0.0)
+	assert.Less(t, results.SuccessRate, 1.0)
+
+	// Best, median and worst are ranked by outcome
+	assert.False(t, results.Worst.Success)
+	assert.True(t, results.Best.Success)
+	assert.False(t, worseOutcome(results.Median, results.Worst))
+	assert.False(t, worseOutcome(results.Best, results.Median))
+	for _, window := range results.Windows {
+		assert.False(t, worseOutcome(window, results.Worst))
+		assert.False(t, worseOutcome(results.Best, window))
+	}
+
+	require.Len(t, results.PortfolioPaths, 30)
+	assert.LessOrEqual(t, results.FinalPercentiles.P5, results.FinalPercentiles.P95)
+}
+
+func TestRunHistoricalBacktestWindowMatchesHistory(t *testing.T) {
+	config := retireeConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	results, err := service.RunHistoricalBacktest(context.Background(), config, HistoricalBacktestConfig{
+		FirstStartYear: 1966,
+		LastStartYear:  1966,
+	})
+	require.NoError(t, err)
+	require.Len(t, results.Windows, 1)
+
+	// Replaying 1966-1995 by hand gives the same portfolio
+	offset := 1966 - historicalYears[0].Year
+	returns := make([]float64, 30)
+	inflation := make([]float64, 30)
+	deflator := 1.0
+	for year := range 30 {
+		returns[year] = historicalYears[offset+year].StockReturn
+		inflation[year] = historicalYears[offset+year].Inflation
+		deflator *= 1 + inflation[year]
+	}
+	flows := service.projectYears(config, returns, nil, inflation)
+
+	window := results.Windows[0]
+	assert.Equal(t, 1966, window.StartYear)
+	assert.Equal(t, 1995, window.EndYear)
+	assert.InDelta(t, flows[29].TotalPortfolio/deflator, window.FinalPortfolio, 1e-6)
+	assert.Equal(t, window, results.Best)
+	assert.Equal(t, window, results.Worst)
+}
+
+func TestRunHistoricalBacktestHistoryTooShort(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	config.CurrentAge = 20
+	config.LifeExpectancy = 120
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	_, err = service.RunHistoricalBacktest(context.Background(), config, HistoricalBacktestConfig{})
+	assert.True(t, errors.Is(err, ErrHistoryTooShort))
+
+	config = retireeConfig()
+	_, err = service.RunHistoricalBacktest(context.Background(), config, HistoricalBacktestConfig{FirstStartYear: 2000})
+	assert.True(t, errors.Is(err, ErrHistoryTooShort))
+}
@@ -0,0 +1,445 @@
+package retirement
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Distribution is how a simulation draws each year's rate
+type Distribution string
+
+const (
+	// DistributionNormal draws rates from a normal distribution
+	DistributionNormal Distribution = "normal"
+	// DistributionLognormal draws growth factors (1 + rate) from a lognormal
+	// distribution, so a year can never lose more than everything
+	DistributionLognormal Distribution = "lognormal"
+	// DistributionHistorical bootstraps rates from the bundled market history
+	DistributionHistorical Distribution = "historical"
+)
+
+// defaultSequenceRiskYears is how many early retirement years the
+// sequence-of-returns metrics look at by default
+const defaultSequenceRiskYears = 10
+
+// shortfallTolerance is how far a retired year's cash flow may fall short
+// before the plan counts as failed, absorbing rounding in the withdrawals
+const shortfallTolerance = 1.0
+
+// CashFlowMonteCarloConfig holds the settings for a Monte Carlo cash flow
+// analysis. The means of the normal and lognormal distributions are the
+// analysis's ExpectedReturn and InflationRate.
+type CashFlowMonteCarloConfig struct {
+	// Number of simulations to run
+	Iterations int
+
+	// How each year's portfolio return is drawn, and its volatility
+	ReturnDistribution Distribution
+	ReturnStdDev       float64
+
+	// How each year's inflation rate is drawn, and its volatility. When both
+	// distributions are historical, a year's return and inflation come from
+	// the same calendar year.
+	InflationDistribution Distribution
+	InflationStdDev       float64
+
+	// Early retirement years the sequence-of-returns metrics look at
+	// (0 for 10)
+	SequenceRiskYears int
+
+	// Random seed (0 for time-based seed)
+	Seed int64
+
+	// Number of parallel workers (0 for auto-detect)
+	Workers int
+}
+
+// DefaultCashFlowMonteCarloConfig returns a CashFlowMonteCarloConfig with
+// reasonable defaults
+func DefaultCashFlowMonteCarloConfig() CashFlowMonteCarloConfig {
+	return CashFlowMonteCarloConfig{
+		Iterations:            1000,
+		ReturnDistribution:    DistributionNormal,
+		ReturnStdDev:          0.15,
+		InflationDistribution: DistributionNormal,
+		InflationStdDev:       0.01,
+		SequenceRiskYears:     defaultSequenceRiskYears,
+	}
+}
+
+// PortfolioPathPoint holds the spread of portfolio values across all
+// simulations at the end of one year
+type PortfolioPathPoint struct {
+	Year        int
+	Age         int
+	Percentiles PercentileResults
+}
+
+// SequenceRiskMetrics describes how much the order of returns early in
+// retirement decides the outcome. Early returns are annualized real returns
+// over the first years of retirement.
+type SequenceRiskMetrics struct {
+	// Early retirement years considered
+	Years int
+
+	// Success probability when early returns fall in the worst and best
+	// quarter of simulations
+	WorstQuartileSuccessProbability float64
+	BestQuartileSuccessProbability  float64
+
+	// Early returns at the worst and best quartile boundaries
+	WorstQuartileEarlyReturn float64
+	BestQuartileEarlyReturn  float64
+
+	// Correlation (-1 to 1) between early returns and the final portfolio
+	EarlyReturnCorrelation float64
+
+	// Average age the portfolio ran out in failed simulations
+	AverageDepletionAge float64
+}
+
+// CashFlowMonteCarloResults holds aggregate results of a Monte Carlo cash
+// flow analysis
+type CashFlowMonteCarloResults struct {
+	// Share of simulations (0-1) in which every retired year's spending was met
+	SuccessProbability float64
+	SuccessCount       int
+	TotalSimulations   int
+
+	// Portfolio percentiles at the end of each year
+	PortfolioPaths []PortfolioPathPoint
+
+	// Portfolio percentiles at the end of the analysis
+	FinalPercentiles PercentileResults
+
+	SequenceRisk SequenceRiskMetrics
+
+	// Spending is how retired spending varied, averaged across simulations
+	Spending SpendingVolatility
+
+	// Simulation duration
+	Duration time.Duration
+}
+
+// cashFlowTrial is the outcome of one simulation
+type cashFlowTrial struct {
+	portfolios   []float64
+	success      bool
+	depletionAge int
+	earlyReturn  float64
+	spending     SpendingVolatility
+}
+
+// validateMonteCarloConfig validates the Monte Carlo settings against the
+// analysis they run
+func validateMonteCarloConfig(config CashFlowConfig, mc CashFlowMonteCarloConfig) error {
+	if mc.Iterations <= 0 {
+		return errors.New("Iterations must be positive")
+	}
+	if mc.ReturnStdDev < 0 || mc.InflationStdDev < 0 {
+		return errors.New("standard deviations cannot be negative")
+	}
+	for _, d := range []Distribution{mc.ReturnDistribution, mc.InflationDistribution} {
+		switch d {
+		case DistributionNormal, DistributionLognormal, DistributionHistorical:
+		default:
+			return errors.New("distribution must be normal, lognormal or historical")
+		}
+	}
+	if mc.ReturnDistribution == DistributionLognormal && config.ExpectedReturn <= -1 {
+		return errors.New("ExpectedReturn must be greater than -1 for a lognormal distribution")
+	}
+	if mc.SequenceRiskYears < 0 {
+		return errors.New("SequenceRiskYears cannot be negative")
+	}
+	return nil
+}
+
+// RunMonteCarlo runs the cash flow analysis many times with each year's
+// return and inflation drawn at random, stopping early with the context's
+// error if ctx is cancelled. A simulation succeeds when the portfolio covers
+// every retired year's spending.
+func (s *CashFlowService) RunMonteCarlo(ctx context.Context, config CashFlowConfig, mc CashFlowMonteCarloConfig) (*CashFlowMonteCarloResults, error) {
+	if err := validateCashFlowConfig(config); err != nil {
+		return nil, err
+	}
+	if err := validateMonteCarloConfig(config, mc); err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+
+	workers := mc.Workers
+	if workers <= 0 {
+		workers = 4 // Default to 4 workers
+	}
+	seed := mc.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	seeds := rand.New(rand.NewSource(seed))
+
+	trials := make([]cashFlowTrial, mc.Iterations)
+
+	// Run simulations in parallel
+	var wg sync.WaitGroup
+	iterationsPerWorker := mc.Iterations / workers
+	remainder := mc.Iterations % workers
+
+	startIdx := 0
+	for w := 0; w < workers; w++ {
+		count := iterationsPerWorker
+		if w < remainder {
+			count++
+		}
+
+		wg.Add(1)
+		go func(start, count int, workerSeed int64) {
+			defer wg.Done()
+			rng := rand.New(rand.NewSource(workerSeed))
+			for i := 0; i < count; i++ {
+				if i%progressReportInterval == 0 && ctx.Err() != nil {
+					return
+				}
+				trials[start+i] = s.runCashFlowTrial(config, mc, rng)
+			}
+		}(startIdx, count, seeds.Int63())
+
+		startIdx += count
+	}
+
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	results := aggregateCashFlowTrials(config, mc, trials)
+	results.Duration = time.Since(startTime)
+	return results, nil
+}
+
+// runCashFlowTrial projects one simulated lifetime
+func (s *CashFlowService) runCashFlowTrial(config CashFlowConfig, mc CashFlowMonteCarloConfig, rng *rand.Rand) cashFlowTrial {
+	totalYears := config.LifeExpectancy - config.CurrentAge
+	returns := make([]float64, totalYears)
+	inflation := make([]float64, totalYears)
+	var assets []AssetReturns
+	if config.Allocation != nil {
+		assets = make([]AssetReturns, totalYears)
+	}
+	for year := range totalYears {
+		returns[year], inflation[year] = drawYear(config, mc, rng)
+		if assets != nil {
+			assets[year] = drawAssetReturns(config.Allocation.Assumptions, mc, returns[year], rng)
+		}
+	}
+	if assets != nil {
+		config.allocatedReturns(returns, assets)
+	}
+	config = drawLongTermCare(config, rng)
+
+	flows := s.projectYears(config, returns, assets, inflation)
+
+	trial := cashFlowTrial{
+		portfolios: make([]float64, totalYears),
+		success:    true,
+		spending:   spendingVolatility(flows, inflation),
+	}
+	for year, flow := range flows {
+		trial.portfolios[year] = flow.TotalPortfolio
+		if trial.success && flow.IsRetired && flow.NetCashFlow < -shortfallTolerance {
+			trial.success = false
+			trial.depletionAge = flow.Age
+		}
+	}
+
+	// Annualized real return over the first years of retirement
+	first := retirementStartYear(config)
+	years := sequenceRiskYears(config, mc)
+	growth := 1.0
+	for year := first; year < first+years; year++ {
+		growth *= (1 + returns[year]) / (1 + inflation[year])
+	}
+	if years > 0 {
+		trial.earlyReturn = math.Pow(math.Max(growth, 0), 1/float64(years)) - 1
+	}
+
+	return trial
+}
+
+// drawYear draws one year's portfolio return and inflation rate
+func drawYear(config CashFlowConfig, mc CashFlowMonteCarloConfig, rng *rand.Rand) (float64, float64) {
+	if mc.ReturnDistribution == DistributionHistorical && mc.InflationDistribution == DistributionHistorical {
+		year := historicalYears[rng.Intn(len(historicalYears))]
+		return year.StockReturn, year.Inflation
+	}
+
+	var annualReturn, inflation float64
+	switch mc.ReturnDistribution {
+	case DistributionLognormal:
+		annualReturn = drawLognormal(config.ExpectedReturn, mc.ReturnStdDev, rng)
+	case DistributionHistorical:
+		annualReturn = historicalYears[rng.Intn(len(historicalYears))].StockReturn
+	default:
+		annualReturn = config.ExpectedReturn + mc.ReturnStdDev*rng.NormFloat64()
+	}
+	switch mc.InflationDistribution {
+	case DistributionLognormal:
+		inflation = drawLognormal(config.InflationRate, mc.InflationStdDev, rng)
+	case DistributionHistorical:
+		inflation = historicalYears[rng.Intn(len(historicalYears))].Inflation
+	default:
+		inflation = config.InflationRate + mc.InflationStdDev*rng.NormFloat64()
+	}
+	return annualReturn, inflation
+}
+
+// drawLognormal draws a rate whose growth factor (1 + rate) is lognormal
+// with the given mean and standard deviation
+func drawLognormal(mean, stdDev float64, rng *rand.Rand) float64 {
+	return lognormalRate(mean, stdDev, rng.NormFloat64())
+}
+
+// lognormalRate is the lognormal rate z standard deviations from the
+// center of its distribution
+func lognormalRate(mean, stdDev, z float64) float64 {
+	growth := 1 + mean
+	sigma2 := math.Log(1 + (stdDev*stdDev)/(growth*growth))
+	mu := math.Log(growth) - sigma2/2
+	return math.Exp(mu+math.Sqrt(sigma2)*z) - 1
+}
+
+// retirementStartYear returns the index of the first retired year
+func retirementStartYear(config CashFlowConfig) int {
+	return max(0, config.RetirementAge-config.CurrentAge)
+}
+
+// sequenceRiskYears returns how many early retirement years the
+// sequence-of-returns metrics look at
+func sequenceRiskYears(config CashFlowConfig, mc CashFlowMonteCarloConfig) int {
+	years := mc.SequenceRiskYears
+	if years == 0 {
+		years = defaultSequenceRiskYears
+	}
+	retiredYears := config.LifeExpectancy - config.CurrentAge - retirementStartYear(config)
+	return min(years, retiredYears)
+}
+
+// aggregateCashFlowTrials computes percentile paths, success probability
+// and sequence-of-returns metrics from the simulations
+func aggregateCashFlowTrials(config CashFlowConfig, mc CashFlowMonteCarloConfig, trials []cashFlowTrial) *CashFlowMonteCarloResults {
+	n := len(trials)
+	totalYears := config.LifeExpectancy - config.CurrentAge
+
+	results := &CashFlowMonteCarloResults{
+		TotalSimulations: n,
+		PortfolioPaths:   make([]PortfolioPathPoint, totalYears),
+	}
+
+	values := make([]float64, n)
+	for year := range totalYears {
+		for i, trial := range trials {
+			values[i] = trial.portfolios[year]
+		}
+		sort.Float64s(values)
+		results.PortfolioPaths[year] = PortfolioPathPoint{
+			Year:        year + 1,
+			Age:         config.CurrentAge + year,
+			Percentiles: percentilesOf(values),
+		}
+	}
+	if totalYears > 0 {
+		results.FinalPercentiles = results.PortfolioPaths[totalYears-1].Percentiles
+	}
+
+	var depletionAgeSum int
+	for _, trial := range trials {
+		if trial.success {
+			results.SuccessCount++
+		} else {
+			depletionAgeSum += trial.depletionAge
+		}
+		results.Spending.Volatility += trial.spending.Volatility / float64(n)
+		results.Spending.LowestSpendingRatio += trial.spending.LowestSpendingRatio / float64(n)
+		results.Spending.LargestCut += trial.spending.LargestCut / float64(n)
+	}
+	results.SuccessProbability = float64(results.SuccessCount) / float64(n)
+
+	results.SequenceRisk = sequenceRisk(trials, sequenceRiskYears(config, mc))
+	if failed := n - results.SuccessCount; failed > 0 {
+		results.SequenceRisk.AverageDepletionAge = float64(depletionAgeSum) / float64(failed)
+	}
+
+	return results
+}
+
+// sequenceRisk compares outcomes of simulations with the worst and best
+// early retirement returns
+func sequenceRisk(trials []cashFlowTrial, years int) SequenceRiskMetrics {
+	metrics := SequenceRiskMetrics{Years: years}
+	if years == 0 || len(trials) == 0 {
+		return metrics
+	}
+
+	sorted := make([]cashFlowTrial, len(trials))
+	copy(sorted, trials)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].earlyReturn < sorted[j].earlyReturn
+	})
+
+	quartile := max(1, len(sorted)/4)
+	successRate := func(trials []cashFlowTrial) float64 {
+		var successes int
+		for _, trial := range trials {
+			if trial.success {
+				successes++
+			}
+		}
+		return float64(successes) / float64(len(trials))
+	}
+	metrics.WorstQuartileSuccessProbability = successRate(sorted[:quartile])
+	metrics.BestQuartileSuccessProbability = successRate(sorted[len(sorted)-quartile:])
+	metrics.WorstQuartileEarlyReturn = sorted[quartile-1].earlyReturn
+	metrics.BestQuartileEarlyReturn = sorted[len(sorted)-quartile].earlyReturn
+
+	earlyReturns := make([]float64, len(trials))
+	finalValues := make([]float64, len(trials))
+	for i, trial := range trials {
+		earlyReturns[i] = trial.earlyReturn
+		finalValues[i] = trial.portfolios[len(trial.portfolios)-1]
+	}
+	metrics.EarlyReturnCorrelation = correlation(earlyReturns, finalValues)
+
+	return metrics
+}
+
+// correlation returns the Pearson correlation of x and y, or 0 when either
+// does not vary
+func correlation(x, y []float64) float64 {
+	n := float64(len(x))
+	var meanX, meanY float64
+	for i := range x {
+		meanX += x[i]
+		meanY += y[i]
+	}
+	meanX /= n
+	meanY /= n
+
+	var cov, varX, varY float64
+	for i := range x {
+		dx, dy := x[i]-meanX, y[i]-meanY
+		cov += dx * dy
+		varX += dx * dx
+		varY += dy * dy
+	}
+	if varX == 0 || varY == 0 {
+		return 0
+	}
+	return cov / math.Sqrt(varX*varY)
+}
@@ -0,0 +1,142 @@
+package retirement
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunMonteCarloWithoutVolatilityMatchesAnalysis(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	deterministic, err := service.RunAnalysis()
+	require.NoError(t, err)
+
+	mc := DefaultCashFlowMonteCarloConfig()
+	mc.Iterations = 20
+	mc.ReturnStdDev = 0
+	mc.InflationStdDev = 0
+	mc.Seed = 1
+
+	results, err := service.RunMonteCarlo(context.Background(), config, mc)
+	require.NoError(t, err)
+
+	require.Len(t, results.PortfolioPaths, len(deterministic.YearlyFlows))
+	for i, point := range results.PortfolioPaths {
+		want := deterministic.YearlyFlows[i].TotalPortfolio
+		assert.InDelta(t, want, point.Percentiles.P5, 1e-6*math.Max(1, want))
+		assert.InDelta(t, want, point.Percentiles.P95, 1e-6*math.Max(1, want))
+		assert.Equal(t, deterministic.YearlyFlows[i].Age, point.Age)
+	}
+	assert.Equal(t, 20, results.TotalSimulations)
+	assert.Equal(t, 1.0, results.SuccessProbability)
+}
+
+func TestRunMonteCarloSeedIsReproducible(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	for _, distribution := range []Distribution{DistributionNormal, DistributionLognormal, DistributionHistorical} {
+		t.Run(string(distribution), func(t *testing.T) {
+			mc := DefaultCashFlowMonteCarloConfig()
+			mc.Iterations = 200
+			mc.ReturnDistribution = distribution
+			mc.InflationDistribution = distribution
+			mc.Seed = 42
+
+			first, err := service.RunMonteCarlo(context.Background(), config, mc)
+			require.NoError(t, err)
+			second, err := service.RunMonteCarlo(context.Background(), config, mc)
+			require.NoError(t, err)
+
+			assert.Equal(t, first.SuccessProbability, second.SuccessProbability)
+			assert.Equal(t, first.FinalPercentiles, second.FinalPercentiles)
+			assert.LessOrEqual(t, first.FinalPercentiles.P5, first.FinalPercentiles.P50)
+			assert.LessOrEqual(t, first.FinalPercentiles.P50, first.FinalPercentiles.P95)
+		})
+	}
+}
+
+func TestRunMonteCarloSequenceRisk(t *testing.T) {
+	// A retiree drawing heavily from a modest portfolio, so outcomes hinge
+	// on the first years' returns
+	config := DefaultCashFlowConfig()
+	config.CurrentAge = 65
+	config.RetirementAge = 65
+	config.LifeExpectancy = 95
+	config.TaxableBalance = 600000
+	config.TraditionalBalance = 400000
+	config.RothBalance = 0
+	config.HSABalance = 0
+	config.SocialSecurityStartAge = 67
+
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	mc := DefaultCashFlowMonteCarloConfig()
+	mc.Iterations = 2000
+	mc.Seed = 7
+
+	results, err := service.RunMonteCarlo(context.Background(), config, mc)
+	require.NoError(t, err)
+
+	risk := results.SequenceRisk
+	assert.Equal(t, 10, risk.Years)
+	assert.Greater(t, results.SuccessProbability, 0.0)
+	assert.Less(t, results.SuccessProbability, 1.0)
+	assert.Less(t, risk.WorstQuartileSuccessProbability, risk.BestQuartileSuccessProbability)
+	assert.Less(t, risk.WorstQuartileEarlyReturn, risk.BestQuartileEarlyReturn)
+	assert.Greater(t, risk.EarlyReturnCorrelation, 0.0)
+	assert.GreaterOrEqual(t, risk.AverageDepletionAge, 65.0)
+	assert.Less(t, risk.AverageDepletionAge, 95.0)
+}
+
+func TestDrawYearHistoricalKeepsCalendarYearsTogether(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	mc := DefaultCashFlowMonteCarloConfig()
+	mc.ReturnDistribution = DistributionHistorical
+	mc.InflationDistribution = DistributionHistorical
+
+	years := make(map[HistoricalYear]bool, len(historicalYears))
+	for _, year := range historicalYears {
+		years[HistoricalYear{StockReturn: year.StockReturn, Inflation: year.Inflation}] = true
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for range 500 {
+		annualReturn, inflation := drawYear(config, mc, rng)
+		assert.True(t, years[HistoricalYear{StockReturn: annualReturn, Inflation: inflation}])
+	}
+}
+
+func TestRunMonteCarloValidation(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		modify func(*CashFlowMonteCarloConfig)
+	}{
+		{name: "no iterations", modify: func(mc *CashFlowMonteCarloConfig) { mc.Iterations = 0 }},
+		{name: "negative volatility", modify: func(mc *CashFlowMonteCarloConfig) { mc.ReturnStdDev = -0.1 }},
+		{name: "unknown distribution", modify: func(mc *CashFlowMonteCarloConfig) { mc.InflationDistribution = "uniform" }},
+		{name: "negative sequence years", modify: func(mc *CashFlowMonteCarloConfig) { mc.SequenceRiskYears = -1 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := DefaultCashFlowMonteCarloConfig()
+			tt.modify(&mc)
+			_, err := service.RunMonteCarlo(context.Background(), config, mc)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -1,9 +1,12 @@
 package retirement
 
 import (
+	"context"
 	"errors"
 	"math"
 	"time"
+
+	"clockzen-next/internal/infrastructure/i18n"
 )
 
 // FlowCategory represents the category of a cash flow
@@ -12,6 +15,7 @@ type FlowCategory string
 const (
 	// Income categories
 	FlowCategoryEmploymentIncome   FlowCategory = "employment_income"
+	FlowCategorySelfEmployment     FlowCategory = "self_employment_income"
 	FlowCategorySocialSecurity     FlowCategory = "social_security"
 	FlowCategoryPension            FlowCategory = "pension"
 	FlowCategoryInvestmentIncome   FlowCategory = "investment_income"
@@ -33,6 +37,7 @@ const (
 	FlowCategoryInsurance     FlowCategory = "insurance"
 	FlowCategoryDiscretionary FlowCategory = "discretionary"
 	FlowCategoryOtherExpenses FlowCategory = "other_expenses"
+	FlowCategoryDebtPayments  FlowCategory = "debt_payments"
 
 	// Tax categories
 	FlowCategoryFederalTax   FlowCategory = "federal_tax"
@@ -76,6 +81,54 @@ type CashFlowConfig struct {
 	RentalIncome             float64
 	OtherIncome              float64
 
+	// SelfEmploymentIncome is net self-employment earnings until
+	// retirement, growing like EmploymentIncome
+	SelfEmploymentIncome float64
+
+	// PartTimeIncome is earnings from part-time work in retirement, in
+	// today's dollars, until PartTimeEndAge (for life when 0). It is taxed
+	// as self-employment income and not saved.
+	PartTimeIncome float64
+	PartTimeEndAge int
+
+	// Spouse, when set, is a second person planned for jointly
+	Spouse *SpouseConfig
+
+	// Pensions are pensions and annuities with their own COLA and survivor
+	// terms, paid alongside PensionBenefit
+	Pensions []Pension
+
+	// RentalProperties are rental properties modeled with their mortgages,
+	// depreciation and sale, alongside the flat RentalIncome
+	RentalProperties []RentalProperty
+
+	// Debts are amortized until paid off; a mortgage's payments are added
+	// to HousingExpense, which then excludes them
+	Debts []Debt
+
+	// Allocation, when set, invests each account along a glide path with
+	// separate stock, bond and cash returns in place of ExpectedReturn
+	Allocation *AllocationConfig
+
+	// LongTermCare, when set, is a care event paid as healthcare, with its
+	// insurance
+	LongTermCare *LongTermCare
+
+	// Windfalls are one-time inflows such as inheritances
+	Windfalls []Windfall
+
+	// InheritedIRAs are inherited accounts held at the start of the plan,
+	// emptied under the 10-year rule; InheritedIRAStrategy spreads the
+	// traditional ones, even when empty, filling up to the
+	// InheritedIRATargetRate bracket with fill_bracket
+	InheritedIRAs          []InheritedIRA
+	InheritedIRAStrategy   InheritedIRAStrategy
+	InheritedIRATargetRate float64
+
+	// ACA, when set, prices marketplace coverage and its premium tax
+	// credit for the retired years before Medicare
+	ACA *ACACoverage
+
 	// Portfolio balances
 	TaxableBalance     float64
 	TraditionalBalance float64
@@ -107,6 +160,11 @@ type CashFlowConfig struct {
 	// Expense growth rates (can differ from inflation)
 	HealthcareGrowthRate float64 // Typically higher than general inflation
 
+	// RetirementSpendingShape scales expenses in each year of retirement,
+	// starting at RetirementAge; the last multiplier carries on past its end.
+	// Without one, expenses stay flat in today's dollars.
+	RetirementSpendingShape []float64
+
 	// Market assumptions
 	ExpectedReturn float64
 	InflationRate  float64
@@ -118,9 +176,21 @@ type CashFlowConfig struct {
 	CapitalGainsRate   float64
 	StateHasNoIncomeTax bool
 
+	// StateCode selects a state's income tax rules (e.g. "CA") in place of
+	// StateTaxRate and StateHasNoIncomeTax. RelocationStateCode, when set,
+	// replaces it from RelocationAge on.
+	StateCode           string
+	RelocationStateCode string
+	RelocationAge       int
+
 	// Withdrawal strategy
 	WithdrawalStrategy WithdrawalStrategy
 
+	// SpendingStrategy decides how much is spent in retirement, planned
+	// when empty, with SpendingRules' parameters
+	SpendingStrategy SpendingStrategy
+	SpendingRules    SpendingRules
+
 	// Tax optimization settings
 	UseTaxGainHarvesting  bool
 	UseRothConversion     bool
@@ -173,6 +243,92 @@ type YearCashFlow struct {
 	OtherIncome        float64
 	TotalIncome        float64
 
+	// SelfEmploymentIncome is included in TotalIncome
+	SelfEmploymentIncome float64
+
+	// SpouseAge is the spouse's age, 0 without one. The spouse's wages,
+	// Social Security and pension are included in the household's lines;
+	// Survivor marks the years after the spouse has died.
+	SpouseAge              int
+	SpouseEmploymentIncome float64
+	SpouseSocialSecurity   float64
+	SpousePension          float64
+	Survivor               bool
+
+	// PensionLumpSum is a pension taken as a lump sum this year, rolled
+	// over to the traditional balance rather than counted as income
+	PensionLumpSum float64
+
+	// Windfall is cash received this year and invested in the taxable
+	// balance rather than counted as income; TaxableWindfall is the part
+	// taxed as ordinary income. InheritedIRADistribution is paid out of
+	// inherited traditional IRAs under the 10-year rule, taxed as ordinary
+	// income and reinvested, and InheritedIRABalance what is left in them
+	// at the end of the year, included in TotalPortfolio.
+	Windfall                 float64
+	TaxableWindfall          float64
+	InheritedIRADistribution float64
+	InheritedIRABalance      float64
+
+	// InheritedRothDistribution is paid out of inherited Roth IRAs tax-free
+	// and reinvested; InheritedRothBalance is included in TotalPortfolio
+	InheritedRothDistribution float64
+	InheritedRothBalance      float64
+
+	// RentalPropertyCashFlow is rental properties' rent less operating
+	// expenses and mortgage payments, included in RentalIncome; they are
+	// taxed on RentalPropertyTaxableIncome, after interest, depreciation
+	// and suspended losses. A sale's net proceeds go to the taxable
+	// balance, its gain and recaptured depreciation taxed as capital gains.
+	RentalPropertyCashFlow      float64
+	RentalPropertyTaxableIncome float64
+	RentalDepreciation          float64
+	RentalSaleProceeds          float64
+	RentalSaleGain              float64
+	DepreciationRecapture       float64
+
+	// RentalEquity is rental properties' value less their mortgages at the
+	// end of the year; NetWorth adds it to TotalPortfolio
+	RentalEquity float64
+	NetWorth     float64
+
+	// DebtPayments are payments on debts other than mortgages, included in
+	// TotalExpenses; MortgagePayments are included in HousingExpense.
+	// DebtBalance is what is owed at the end of the year, taken off
+	// NetWorth.
+	DebtPayments     float64
+	MortgagePayments float64
+	DebtInterest     float64
+	DebtBalance      float64
+
+	// PortfolioReturn is the year's return, on the household glide path
+	// with an allocation; Allocation is that path's allocation for the year
+	PortfolioReturn float64
+	Allocation      AssetAllocation
+
+	// LongTermCareCost less LongTermCareBenefit is included in
+	// HealthcareExpense, and LongTermCarePremium in InsuranceExpense
+	LongTermCareCost    float64
+	LongTermCareBenefit float64
+	LongTermCarePremium float64
+
+	// Spending is the year's spending on the configured expense lines, and
+	// SpendingAdjustment how the spending strategy scaled them
+	Spending           float64
+	SpendingAdjustment float64
+
+	// ACAPremium is the marketplace premium of those covered before
+	// Medicare and ACAPremiumTaxCredit the credit towards it on the year's
+	// MAGI, included in HealthcareExpense net of each other.
+	// ACASubsidyHeadroom is the MAGI left before the subsidy cliff.
+	ACAPremium          float64
+	ACAPremiumTaxCredit float64
+	ACASubsidyHeadroom  float64
+
+	// RothConversion is moved from the traditional balance to Roth and
+	// taxed as income
+	RothConversion float64
+
 	// Withdrawal flows
 	TaxableWithdrawal     float64
 	TraditionalWithdrawal float64
@@ -180,6 +336,30 @@ type YearCashFlow struct {
 	HSAWithdrawal         float64
 	TotalWithdrawals      float64
 
+	// Required minimum distribution from traditional accounts, included in
+	// TraditionalWithdrawal, and the tax it adds
+	RequiredMinimumDistribution float64
+	RMDTax                      float64
+
+	// TaxableSocialSecurity is the part of SocialSecurity subject to
+	// federal tax
+	TaxableSocialSecurity float64
+
+	// SelfEmploymentTax is both halves of FICA on self-employment income,
+	// included in FICATax; QBIDeduction is the qualified business income
+	// deduction. EstimatedTaxDue is the federal tax paid in quarterly
+	// estimated payments rather than withheld.
+	SelfEmploymentTax float64
+	QBIDeduction      float64
+	EstimatedTaxDue   float64
+
+	// MAGI is the year's modified adjusted gross income. IRMAASurcharge is
+	// the Medicare premium surcharge set by MAGI two years before, included
+	// in HealthcareExpense, and IRMAATier its tier, 0 for none.
+	MAGI           float64
+	IRMAASurcharge float64
+	IRMAATier      int
+
 	// Expense flows
 	HousingExpense        float64
 	HealthcareExpense     float64
@@ -238,6 +418,19 @@ type TaxImpactAnalysis struct {
 	RothConversionOpportunity float64 // Amount that could be converted in lower bracket
 	TaxLossHarvestingAmount   float64 // Potential tax loss harvesting
 	AdditionalTraditionalRoom float64 // Room to contribute more to traditional
+
+	// Required minimum distributions
+	RequiredMinimumDistribution float64 // Taxed as ordinary income
+	RMDTax                      float64 // Federal and state tax the RMD adds
+
+	// Social Security taxation
+	TaxableSocialSecurity       float64 // Benefits subject to federal tax
+	SocialSecurityInclusionRate float64 // Benefits made taxable per extra dollar of income
+
+	// Self-employment
+	SelfEmploymentTax  float64 // Both FICA halves, included in FICATax
+	QBIDeduction       float64 // Qualified business income deduction
+	WithheldFederalTax float64 // Federal tax on income other than self-employment
 }
 
 // CashFlowResults holds the complete cash flow analysis results
@@ -265,6 +458,9 @@ type CashFlowResults struct {
 	RetirementReadiness float64 // 0-1 score
 	ExpensesCoveredYears int
 
+	// Spending is how retired spending varied in real terms
+	Spending SpendingVolatility
+
 	// Calculation duration
 	Duration time.Duration
 }
@@ -360,7 +556,50 @@ func validateCashFlowConfig(config CashFlowConfig) error {
 		(config.SocialSecurityStartAge < 62 || config.SocialSecurityStartAge > 70) {
 		return errors.New("SocialSecurityStartAge must be between 62 and 70")
 	}
-	return nil
+	if config.SelfEmploymentIncome < 0 {
+		return errors.New("SelfEmploymentIncome cannot be negative")
+	}
+	if config.PartTimeIncome < 0 || config.PartTimeEndAge < 0 {
+		return errors.New("PartTimeIncome and PartTimeEndAge cannot be negative")
+	}
+	if config.Spouse != nil {
+		if err := config.Spouse.validate(); err != nil {
+			return err
+		}
+	}
+	if err := validatePensions(config); err != nil {
+		return err
+	}
+	if err := validateRentalProperties(config); err != nil {
+		return err
+	}
+	if err := validateDebts(config); err != nil {
+		return err
+	}
+	if config.Allocation != nil {
+		if err := config.Allocation.validate(); err != nil {
+			return err
+		}
+	}
+	if err := validateLongTermCare(config); err != nil {
+		return err
+	}
+	if err := validateSpendingStrategy(config); err != nil {
+		return err
+	}
+	if err := validateWindfalls(config); err != nil {
+		return err
+	}
+	if err := validateInheritedIRAs(config); err != nil {
+		return err
+	}
+	if err := validateACA(config); err != nil {
+		return err
+	}
+	if err := validateRothConversion(config); err != nil {
+		return err
+	}
+	return validateStateCodes(config)
 }
 
 // RunAnalysis executes the cash flow analysis and returns results
@@ -377,13 +616,12 @@ func (s *CashFlowService) RunAnalysisWithConfig(config CashFlowConfig) (*CashFlo
 	startTime := time.Now()
 
 	totalYears := config.LifeExpectancy - config.CurrentAge
-	yearlyFlows := make([]YearCashFlow, totalYears)
-
-	// Initialize portfolio balances
-	taxable := config.TaxableBalance
-	traditional := config.TraditionalBalance
-	roth := config.RothBalance
-	hsa := config.HSABalance
+	returns, assets := config.expectedReturns()
+	inflation := make([]float64, totalYears)
+	for year := range totalYears {
+		inflation[year] = config.InflationRate
+	}
+	yearlyFlows := s.projectYears(config, returns, assets, inflation)
 
 	// Tracking variables
 	var (
@@ -392,13 +630,112 @@ func (s *CashFlowService) RunAnalysisWithConfig(config CashFlowConfig) (*CashFlo
 		totalTax         float64
 		totalSavings     float64
 		totalWithdrawals float64
-		cumulativeSurplus float64
+		totalRMDs        float64
+		totalRMDTax      float64
+		totalTaxableSS   float64
 	)
+	for _, flow := range yearlyFlows {
+		totalIncome += flow.TotalIncome
+		totalExpenses += flow.TotalExpenses
+		totalTax += flow.TotalTax
+		totalSavings += flow.TotalSavings
+		totalWithdrawals += flow.TotalWithdrawals
+		totalRMDs += flow.RequiredMinimumDistribution
+		totalRMDTax += flow.RMDTax
+		totalTaxableSS += flow.TaxableSocialSecurity
+	}
+
+	// Generate Sankey diagrams
+	accumulationSankey := s.GenerateSankeyData(yearlyFlows, false)
+	retirementSankey := s.GenerateSankeyData(yearlyFlows, true)
+
+	// Calculate retirement readiness
+	retirementReadiness := s.calculateRetirementReadiness(yearlyFlows, config)
+
+	// Count years expenses are covered
+	expensesCovered := 0
+	for _, flow := range yearlyFlows {
+		if flow.IsRetired && flow.TotalPortfolio > 0 {
+			expensesCovered++
+		}
+	}
+
+	results := &CashFlowResults{
+		YearlyFlows:              yearlyFlows,
+		TotalLifetimeIncome:      totalIncome,
+		TotalLifetimeExpenses:    totalExpenses,
+		TotalLifetimeTax:         totalTax,
+		TotalLifetimeSavings:     totalSavings,
+		TotalLifetimeWithdrawals: totalWithdrawals,
+		AccumulationSankey:       accumulationSankey,
+		RetirementSankey:         retirementSankey,
+		YearsOfData:              totalYears,
+		RetirementReadiness:      retirementReadiness,
+		ExpensesCoveredYears:     expensesCovered,
+		Spending:                 spendingVolatility(yearlyFlows, inflation),
+		Duration:                 time.Since(startTime),
+	}
+
+	// Calculate average effective tax rate
+	if totalIncome > 0 {
+		results.AverageEffectiveTaxRate = totalTax / totalIncome
+	}
+
+	// Lifetime RMDs and the tax they added, and taxable benefits
+	results.LifetimeTaxAnalysis.RequiredMinimumDistribution = totalRMDs
+	results.LifetimeTaxAnalysis.RMDTax = totalRMDTax
+	results.LifetimeTaxAnalysis.TaxableSocialSecurity = totalTaxableSS
+
+	return results, nil
+}
+
+// expectedReturns are the portfolio returns of each year of the analysis
+// when markets return as expected, and with an allocation each year's asset
+// class returns
+func (c CashFlowConfig) expectedReturns() ([]float64, []AssetReturns) {
+	totalYears := c.LifeExpectancy - c.CurrentAge
+	returns := make([]float64, totalYears)
+	var assets []AssetReturns
+	for year := range totalYears {
+		returns[year] = c.ExpectedReturn
+	}
+	if c.Allocation != nil {
+		assets = make([]AssetReturns, totalYears)
+		for year := range assets {
+			assets[year] = c.Allocation.Assumptions.Expected()
+		}
+		c.allocatedReturns(returns, assets)
+	}
+	return returns, assets
+}
+
+// projectYears projects each year's cash flows given that year's portfolio
+// return and inflation rate. Both slices hold one rate per year of the
+// analysis; inflation applies from the following year on. With an
+// allocation, assets holds each year's asset class returns and the accounts
+// grow by their glide paths' returns on them instead.
+func (s *CashFlowService) projectYears(config CashFlowConfig, returns []float64, assets []AssetReturns, inflation []float64) []YearCashFlow {
+	totalYears := config.LifeExpectancy - config.CurrentAge
+	yearlyFlows := make([]YearCashFlow, totalYears)
+
+	// Initialize portfolio balances
+	taxable, traditional, roth, hsa := config.householdBalances()
+	properties := newRentalProperties(config)
+	debts := newDebtBalances(config)
+	plan := newSpendingPlan(config)
+	inherited := newInheritedAccounts(config)
+
+	var cumulativeSurplus float64
+	inflationFactor := 1.0
+
+	// Each year's inflation factor, to deflate MAGI for IRMAA thresholds
+	taxYear := DefaultTaxData().Active()
+	medicare := taxYear.Medicare
+	inflationFactors := make([]float64, totalYears)
 
 	for year := range totalYears {
 		age := config.CurrentAge + year
 		isRetired := age >= config.RetirementAge
-		inflationFactor := math.Pow(1+config.InflationRate, float64(year))
 		healthcareInflation := math.Pow(1+config.HealthcareGrowthRate, float64(year))
 
 		yearFlow := YearCashFlow{
@@ -409,8 +746,12 @@ func (s *CashFlowService) RunAnalysisWithConfig(config CashFlowConfig) (*CashFlo
 
 		// Calculate income
 		if !isRetired {
-			// Employment income with growth
-			yearFlow.EmploymentIncome = config.EmploymentIncome * math.Pow(1+config.EmploymentIncomeGrowth, float64(year))
+			// Employment and self-employment income with growth
+			incomeGrowth := math.Pow(1+config.EmploymentIncomeGrowth, float64(year))
+			yearFlow.EmploymentIncome = config.EmploymentIncome * incomeGrowth
+			yearFlow.SelfEmploymentIncome = config.SelfEmploymentIncome * incomeGrowth
+		} else if config.PartTimeEndAge == 0 || age < config.PartTimeEndAge {
+			yearFlow.SelfEmploymentIncome = config.PartTimeIncome * inflationFactor
 		}
 
 		if config.SocialSecurityStartAge > 0 && age >= config.SocialSecurityStartAge {
@@ -420,40 +761,98 @@ func (s *CashFlowService) RunAnalysisWithConfig(config CashFlowConfig) (*CashFlo
 		if config.PensionStartAge > 0 && age >= config.PensionStartAge {
 			yearFlow.Pension = config.PensionBenefit * inflationFactor
 		}
+		config.addSpouseIncome(&yearFlow, year, inflationFactor)
+		config.addPensionIncome(&yearFlow, year)
 
 		// Investment income (dividends, interest) - assume 2% of taxable portfolio
 		yearFlow.InvestmentIncome = taxable * 0.02
 
 		yearFlow.RentalIncome = config.RentalIncome * inflationFactor
+		addRentalPropertyFlows(&yearFlow, properties, inflationFactor)
+		inherited.receive(config, &yearFlow, inflationFactor)
 		yearFlow.OtherIncome = config.OtherIncome * inflationFactor
 
-		yearFlow.TotalIncome = yearFlow.EmploymentIncome + yearFlow.SocialSecurity +
+		yearFlow.TotalIncome = yearFlow.EmploymentIncome + yearFlow.SelfEmploymentIncome + yearFlow.SocialSecurity +
 			yearFlow.Pension + yearFlow.InvestmentIncome + yearFlow.RentalIncome + yearFlow.OtherIncome
 
-		// Calculate expenses (inflation-adjusted)
-		yearFlow.HousingExpense = config.HousingExpense * inflationFactor
-		yearFlow.HealthcareExpense = config.HealthcareExpense * healthcareInflation
-		yearFlow.FoodExpense = config.FoodExpense * inflationFactor
-		yearFlow.TransportationExpense = config.TransportationExpense * inflationFactor
-		yearFlow.UtilitiesExpense = config.UtilitiesExpense * inflationFactor
-		yearFlow.InsuranceExpense = config.InsuranceExpense * inflationFactor
-		yearFlow.DiscretionaryExpense = config.DiscretionaryExpense * inflationFactor
-		yearFlow.OtherExpenses = config.OtherExpenses * inflationFactor
+		// Calculate expenses (inflation-adjusted, shaped in retirement)
+		shape := retirementSpendingMultiplier(config, age) * config.survivorExpenseMultiplier(yearFlow)
+		planned := config.plannedSpending(inflationFactor, healthcareInflation) * shape
+		yearFlow.SpendingAdjustment = 1
+		if isRetired {
+			yearFlow.SpendingAdjustment = plan.adjustment(config, year, planned, yearFlow.TotalIncome,
+				taxable+traditional+roth+hsa, returns, inflation)
+			shape *= yearFlow.SpendingAdjustment
+		}
+		yearFlow.Spending = planned * yearFlow.SpendingAdjustment
+		yearFlow.HousingExpense = config.HousingExpense * inflationFactor * shape
+		yearFlow.HealthcareExpense = config.HealthcareExpense * healthcareInflation * shape
+
+		// IRMAA surcharges follow MAGI from two years before, for each
+		// enrollee; years looking back before the plan starts have none
+		enrollees := config.medicareEnrollees(yearFlow, medicare.EligibilityAge)
+		if lookback := year - irmaaLookbackYears; lookback >= 0 && enrollees > 0 {
+			tier, surcharge := medicare.IRMAATierFor(yearlyFlows[lookback].MAGI / inflationFactors[lookback])
+			yearFlow.IRMAATier = tier
+			yearFlow.IRMAASurcharge = surcharge.AnnualSurcharge() * float64(enrollees) * healthcareInflation
+			yearFlow.HealthcareExpense += yearFlow.IRMAASurcharge
+		}
+
+		// Marketplace coverage before Medicare is paid in full; the credit
+		// comes once the year's MAGI is known
+		aca := config.acaYear(yearFlow, medicare.EligibilityAge, inflationFactor, healthcareInflation)
+		yearFlow.ACAPremium = aca.premium
+		yearFlow.HealthcareExpense += aca.premium
+		yearFlow.FoodExpense = config.FoodExpense * inflationFactor * shape
+		yearFlow.TransportationExpense = config.TransportationExpense * inflationFactor * shape
+		yearFlow.UtilitiesExpense = config.UtilitiesExpense * inflationFactor * shape
+		yearFlow.InsuranceExpense = config.InsuranceExpense * inflationFactor * shape
+		yearFlow.DiscretionaryExpense = config.DiscretionaryExpense * inflationFactor * shape
+		yearFlow.OtherExpenses = config.OtherExpenses * inflationFactor * shape
+		config.addLongTermCare(&yearFlow, year, healthcareInflation)
+		addDebtPayments(&yearFlow, debts)
 
 		yearFlow.TotalExpenses = yearFlow.HousingExpense + yearFlow.HealthcareExpense +
 			yearFlow.FoodExpense + yearFlow.TransportationExpense + yearFlow.UtilitiesExpense +
-			yearFlow.InsuranceExpense + yearFlow.DiscretionaryExpense + yearFlow.OtherExpenses
+			yearFlow.InsuranceExpense + yearFlow.DiscretionaryExpense + yearFlow.OtherExpenses +
+			yearFlow.DebtPayments
+
+		// RMDs are due on the traditional balance at the end of last year,
+		// whether or not the money is needed
+		yearFlow.RequiredMinimumDistribution = CalculateRMD(age, traditional)
 
-		// Calculate taxes
-		taxAnalysis := s.CalculateTaxImpact(yearFlow, config, isRetired)
+		// Inherited IRAs pay out under the 10-year rule, taxed like the RMD
+		inherited.distribute(config, &yearFlow, taxYear)
+
+		// Roth conversions keep the income known so far under the ACA
+		// subsidy cliff
+		knownMAGI := modifiedAGI(yearFlow, config, taxYear) + yearFlow.RequiredMinimumDistribution
+		yearFlow.RothConversion = config.rothConversion(yearFlow, traditional, inflationFactor, aca.conversionLimit(knownMAGI))
+
+		// Calculate taxes in the state lived in this year
+		stateConfig := config.stateAt(age)
+		taxAnalysis := s.CalculateTaxImpact(yearFlow, stateConfig, isRetired)
 		yearFlow.FederalTax = taxAnalysis.FederalTax
 		yearFlow.StateTax = taxAnalysis.StateTax
 		yearFlow.FICATax = taxAnalysis.FICATax
 		yearFlow.CapitalGainsTax = taxAnalysis.CapitalGainsTax
 		yearFlow.TotalTax = taxAnalysis.TotalTaxLiability
+		yearFlow.RMDTax = taxAnalysis.RMDTax
+		yearFlow.TaxableSocialSecurity = taxAnalysis.TaxableSocialSecurity
+		yearFlow.SelfEmploymentTax = taxAnalysis.SelfEmploymentTax
+		yearFlow.QBIDeduction = taxAnalysis.QBIDeduction
+
+		// Tax not withheld is paid in estimated payments
+		var prior *YearCashFlow
+		if year > 0 {
+			prior = &yearlyFlows[year-1]
+		}
+		yearFlow.EstimatedTaxDue = taxYear.EstimatedTaxDue(
+			taxAnalysis.FederalTax+taxAnalysis.SelfEmploymentTax, taxAnalysis.WithheldFederalTax, prior)
 
-		// Calculate savings/contributions
-		if !isRetired && yearFlow.EmploymentIncome > 0 {
+		// Calculate savings/contributions; a spouse still working after
+		// retirement keeps saving from their wages
+		if yearFlow.EmploymentIncome > 0 {
 			yearFlow.TaxableSavings = yearFlow.EmploymentIncome * config.TaxableContributionRate
 			yearFlow.TraditionalSavings = yearFlow.EmploymentIncome * config.TraditionalContributionRate
 			yearFlow.RothSavings = yearFlow.EmploymentIncome * config.RothContributionRate
@@ -467,24 +866,56 @@ func (s *CashFlowService) RunAnalysisWithConfig(config CashFlowConfig) (*CashFlo
 		yearFlow.TotalSavings = yearFlow.TaxableSavings + yearFlow.TraditionalSavings +
 			yearFlow.RothSavings + yearFlow.HSASavings
 
+		// Take the RMD first; further withdrawals come from what's left
+		rmd := yearFlow.RequiredMinimumDistribution
+		traditional -= rmd + yearFlow.RothConversion
+		roth += yearFlow.RothConversion
+		yearFlow.TraditionalWithdrawal = rmd
+		yearFlow.TotalWithdrawals = rmd
+
+		// A pension lump sum is rolled over and a property sale's proceeds
+		// invested, available from this year
+		traditional += yearFlow.PensionLumpSum
+		taxable += yearFlow.RentalSaleProceeds + yearFlow.Windfall + yearFlow.InheritedIRADistribution +
+			yearFlow.InheritedRothDistribution
+
 		// Calculate withdrawals needed in retirement
 		if isRetired {
-			netNeeded := yearFlow.TotalExpenses + yearFlow.TotalTax - yearFlow.TotalIncome
+			taxable += yearFlow.TaxableSavings
+			traditional += yearFlow.TraditionalSavings
+			roth += yearFlow.RothSavings
+			hsa += yearFlow.HSASavings
+
+			netNeeded := yearFlow.TotalExpenses + yearFlow.TotalTax + yearFlow.TotalSavings - yearFlow.TotalIncome - rmd
 			if netNeeded > 0 {
-				withdrawals := s.CalculateWithdrawals(netNeeded, taxable, traditional, roth, hsa, config)
+				// Traditional withdrawals can make more Social Security
+				// taxable, taxing them at a higher effective rate
+				withdrawalConfig := stateConfig
+				withdrawalConfig.FederalTaxRate *= 1 + taxAnalysis.SocialSecurityInclusionRate
+				withdrawals := s.CalculateWithdrawals(netNeeded, taxable, traditional, roth, hsa, withdrawalConfig)
 				yearFlow.TaxableWithdrawal = withdrawals.TaxableWithdrawal
-				yearFlow.TraditionalWithdrawal = withdrawals.TraditionalWithdrawal
+				yearFlow.TraditionalWithdrawal += withdrawals.TraditionalWithdrawal
 				yearFlow.RothWithdrawal = withdrawals.RothWithdrawal
 				yearFlow.HSAWithdrawal = withdrawals.HSAWithdrawal
-				yearFlow.TotalWithdrawals = withdrawals.TotalWithdrawal
+				yearFlow.TotalWithdrawals += withdrawals.TotalWithdrawal
 
 				// Update account balances
 				taxable -= withdrawals.TaxableWithdrawal
 				traditional -= withdrawals.TraditionalWithdrawal
 				roth -= withdrawals.RothWithdrawal
 				hsa -= withdrawals.HSAWithdrawal
+			} else if rmd > 0 {
+				// Whatever of the RMD isn't spent is reinvested
+				reinvested := math.Min(-netNeeded, rmd)
+				yearFlow.TaxableSavings += reinvested
+				yearFlow.TotalSavings += reinvested
+				taxable += reinvested
 			}
 		} else {
+			// The RMD of someone still working is reinvested
+			yearFlow.TaxableSavings += rmd
+			yearFlow.TotalSavings += rmd
+
 			// Add savings to accounts
 			taxable += yearFlow.TaxableSavings
 			traditional += yearFlow.TraditionalSavings
@@ -492,11 +923,29 @@ func (s *CashFlowService) RunAnalysisWithConfig(config CashFlowConfig) (*CashFlo
 			hsa += yearFlow.HSASavings
 		}
 
+		// The premium tax credit is claimed on the year's MAGI and
+		// reinvested
+		yearFlow.MAGI = modifiedAGI(yearFlow, config, taxYear)
+		if credit := aca.credit(yearFlow.MAGI); credit > 0 {
+			yearFlow.ACAPremiumTaxCredit = credit
+			yearFlow.HealthcareExpense -= credit
+			yearFlow.TotalExpenses -= credit
+			yearFlow.TaxableSavings += credit
+			yearFlow.TotalSavings += credit
+			taxable += credit
+		}
+		yearFlow.ACASubsidyHeadroom = aca.headroom(yearFlow.MAGI)
+
 		// Apply investment growth
-		taxable *= (1 + config.ExpectedReturn)
-		traditional *= (1 + config.ExpectedReturn)
-		roth *= (1 + config.ExpectedReturn)
-		hsa *= (1 + config.ExpectedReturn)
+		taxableReturn, traditionalReturn, rothReturn, hsaReturn := config.accountReturns(age, returns[year], assets, year)
+		taxable *= (1 + taxableReturn)
+		traditional *= (1 + traditionalReturn)
+		roth *= (1 + rothReturn)
+		hsa *= (1 + hsaReturn)
+		yearFlow.PortfolioReturn = returns[year]
+		if config.Allocation != nil {
+			yearFlow.Allocation = config.Allocation.GlidePath.At(age)
+		}
 
 		// Ensure no negative balances
 		taxable = math.Max(0, taxable)
@@ -504,7 +953,10 @@ func (s *CashFlowService) RunAnalysisWithConfig(config CashFlowConfig) (*CashFlo
 		roth = math.Max(0, roth)
 		hsa = math.Max(0, hsa)
 
-		yearFlow.TotalPortfolio = taxable + traditional + roth + hsa
+		yearFlow.InheritedIRABalance, yearFlow.InheritedRothBalance = inherited.grow(traditionalReturn, rothReturn)
+		yearFlow.TotalPortfolio = taxable + traditional + roth + hsa + yearFlow.InheritedIRABalance +
+			yearFlow.InheritedRothBalance
+		yearFlow.NetWorth = yearFlow.TotalPortfolio + yearFlow.RentalEquity - yearFlow.DebtBalance
 
 		// Calculate net cash flow
 		yearFlow.NetCashFlow = yearFlow.TotalIncome + yearFlow.TotalWithdrawals -
@@ -513,69 +965,36 @@ func (s *CashFlowService) RunAnalysisWithConfig(config CashFlowConfig) (*CashFlo
 		cumulativeSurplus += yearFlow.NetCashFlow
 		yearFlow.CumulativeSurplus = cumulativeSurplus
 
-		// Track totals
-		totalIncome += yearFlow.TotalIncome
-		totalExpenses += yearFlow.TotalExpenses
-		totalTax += yearFlow.TotalTax
-		totalSavings += yearFlow.TotalSavings
-		totalWithdrawals += yearFlow.TotalWithdrawals
-
 		yearlyFlows[year] = yearFlow
+		inflationFactors[year] = inflationFactor
+		inflationFactor *= 1 + inflation[year]
 	}
 
-	// Generate Sankey diagrams
-	accumulationSankey := s.GenerateSankeyData(yearlyFlows, false)
-	retirementSankey := s.GenerateSankeyData(yearlyFlows, true)
+	return yearlyFlows
+}
 
-	// Calculate retirement readiness
-	retirementReadiness := s.calculateRetirementReadiness(yearlyFlows, config)
 
-	// Count years expenses are covered
-	expensesCovered := 0
+// GenerateSankeyData creates Sankey diagram data from yearly cash flows
+func (s *CashFlowService) GenerateSankeyData(yearlyFlows []YearCashFlow, retirementOnly bool) SankeyData {
+	// Aggregate flows based on phase
+	var phase []YearCashFlow
 	for _, flow := range yearlyFlows {
-		if flow.IsRetired && flow.TotalPortfolio > 0 {
-			expensesCovered++
+		if flow.IsRetired == retirementOnly {
+			phase = append(phase, flow)
 		}
 	}
-
-	results := &CashFlowResults{
-		YearlyFlows:              yearlyFlows,
-		TotalLifetimeIncome:      totalIncome,
-		TotalLifetimeExpenses:    totalExpenses,
-		TotalLifetimeTax:         totalTax,
-		TotalLifetimeSavings:     totalSavings,
-		TotalLifetimeWithdrawals: totalWithdrawals,
-		AccumulationSankey:       accumulationSankey,
-		RetirementSankey:         retirementSankey,
-		YearsOfData:              totalYears,
-		RetirementReadiness:      retirementReadiness,
-		ExpensesCoveredYears:     expensesCovered,
-		Duration:                 time.Since(startTime),
-	}
-
-	// Calculate average effective tax rate
-	if totalIncome > 0 {
-		results.AverageEffectiveTaxRate = totalTax / totalIncome
-	}
-
-	return results, nil
+	return s.GenerateSankeyDataForYears(phase)
 }
 
-// GenerateSankeyData creates Sankey diagram data from yearly cash flows
-func (s *CashFlowService) GenerateSankeyData(yearlyFlows []YearCashFlow, retirementOnly bool) SankeyData {
-	// Aggregate flows based on phase
+// GenerateSankeyDataForYears generates Sankey diagram data from the total
+// of the given years' flows
+func (s *CashFlowService) GenerateSankeyDataForYears(yearlyFlows []YearCashFlow) SankeyData {
 	var aggregateFlow YearCashFlow
 	count := 0
 
 	for _, flow := range yearlyFlows {
-		if retirementOnly && !flow.IsRetired {
-			continue
-		}
-		if !retirementOnly && flow.IsRetired {
-			continue
-		}
-
 		aggregateFlow.EmploymentIncome += flow.EmploymentIncome
+		aggregateFlow.SelfEmploymentIncome += flow.SelfEmploymentIncome
 		aggregateFlow.SocialSecurity += flow.SocialSecurity
 		aggregateFlow.Pension += flow.Pension
 		aggregateFlow.InvestmentIncome += flow.InvestmentIncome
@@ -595,6 +1014,7 @@ func (s *CashFlowService) GenerateSankeyData(yearlyFlows []YearCashFlow, retirem
 		aggregateFlow.InsuranceExpense += flow.InsuranceExpense
 		aggregateFlow.DiscretionaryExpense += flow.DiscretionaryExpense
 		aggregateFlow.OtherExpenses += flow.OtherExpenses
+		aggregateFlow.DebtPayments += flow.DebtPayments
 
 		aggregateFlow.FederalTax += flow.FederalTax
 		aggregateFlow.StateTax += flow.StateTax
@@ -619,6 +1039,10 @@ func (s *CashFlowService) GenerateSankeyData(yearlyFlows []YearCashFlow, retirem
 		nodes = append(nodes, SankeyNode{ID: "employment", Label: "Employment Income", Category: FlowTypeIncome, Value: aggregateFlow.EmploymentIncome})
 		totalIncome += aggregateFlow.EmploymentIncome
 	}
+	if aggregateFlow.SelfEmploymentIncome > 0 {
+		nodes = append(nodes, SankeyNode{ID: "self_employment", Label: "Self-Employment Income", Category: FlowTypeIncome, Value: aggregateFlow.SelfEmploymentIncome})
+		totalIncome += aggregateFlow.SelfEmploymentIncome
+	}
 	if aggregateFlow.SocialSecurity > 0 {
 		nodes = append(nodes, SankeyNode{ID: "social_security", Label: "Social Security", Category: FlowTypeIncome, Value: aggregateFlow.SocialSecurity})
 		totalIncome += aggregateFlow.SocialSecurity
@@ -669,6 +1093,9 @@ func (s *CashFlowService) GenerateSankeyData(yearlyFlows []YearCashFlow, retirem
 	if aggregateFlow.EmploymentIncome > 0 {
 		links = append(links, SankeyLink{Source: "employment", Target: "total_pool", Value: aggregateFlow.EmploymentIncome})
 	}
+	if aggregateFlow.SelfEmploymentIncome > 0 {
+		links = append(links, SankeyLink{Source: "self_employment", Target: "total_pool", Value: aggregateFlow.SelfEmploymentIncome})
+	}
 	if aggregateFlow.SocialSecurity > 0 {
 		links = append(links, SankeyLink{Source: "social_security", Target: "total_pool", Value: aggregateFlow.SocialSecurity})
 	}
@@ -727,7 +1154,8 @@ func (s *CashFlowService) GenerateSankeyData(yearlyFlows []YearCashFlow, retirem
 	totalExpenses := aggregateFlow.HousingExpense + aggregateFlow.HealthcareExpense +
 		aggregateFlow.FoodExpense + aggregateFlow.TransportationExpense +
 		aggregateFlow.UtilitiesExpense + aggregateFlow.InsuranceExpense +
-		aggregateFlow.DiscretionaryExpense + aggregateFlow.OtherExpenses
+		aggregateFlow.DiscretionaryExpense + aggregateFlow.OtherExpenses +
+		aggregateFlow.DebtPayments
 
 	if totalExpenses > 0 {
 		nodes = append(nodes, SankeyNode{ID: "expenses", Label: "Living Expenses", Category: FlowTypeExpense, Value: totalExpenses})
@@ -766,6 +1194,10 @@ func (s *CashFlowService) GenerateSankeyData(yearlyFlows []YearCashFlow, retirem
 		nodes = append(nodes, SankeyNode{ID: "other_expenses", Label: "Other Expenses", Category: FlowTypeExpense, Value: aggregateFlow.OtherExpenses})
 		links = append(links, SankeyLink{Source: "expenses", Target: "other_expenses", Value: aggregateFlow.OtherExpenses})
 	}
+	if aggregateFlow.DebtPayments > 0 {
+		nodes = append(nodes, SankeyNode{ID: "debt_payments", Label: "Debt Payments", Category: FlowTypeExpense, Value: aggregateFlow.DebtPayments})
+		links = append(links, SankeyLink{Source: "expenses", Target: "debt_payments", Value: aggregateFlow.DebtPayments})
+	}
 
 	// Savings nodes (accumulation phase only)
 	totalSavings := aggregateFlow.TaxableSavings + aggregateFlow.TraditionalSavings +
@@ -803,41 +1235,73 @@ func (s *CashFlowService) GenerateSankeyData(yearlyFlows []YearCashFlow, retirem
 func (s *CashFlowService) CalculateTaxImpact(yearFlow YearCashFlow, config CashFlowConfig, isRetired bool) TaxImpactAnalysis {
 	analysis := TaxImpactAnalysis{}
 
-	// Calculate gross income
-	analysis.GrossIncome = yearFlow.EmploymentIncome + yearFlow.SocialSecurity +
-		yearFlow.Pension + yearFlow.InvestmentIncome + yearFlow.RentalIncome +
-		yearFlow.OtherIncome + yearFlow.TraditionalWithdrawal
+	// Calculate gross income. Traditional withdrawals include the RMD, which
+	// is taxed before the rest of the year's withdrawals are known.
+	analysis.GrossIncome = yearFlow.EmploymentIncome + yearFlow.SelfEmploymentIncome + yearFlow.SocialSecurity +
+		yearFlow.Pension + yearFlow.InvestmentIncome + yearFlow.taxableRentalIncome() +
+		yearFlow.OtherIncome + math.Max(yearFlow.TraditionalWithdrawal, yearFlow.RequiredMinimumDistribution) +
+		yearFlow.TaxableWindfall + yearFlow.InheritedIRADistribution + yearFlow.RothConversion
 
 	// Calculate taxable income (gross minus traditional contributions)
 	traditionalDeduction := yearFlow.EmploymentIncome * config.TraditionalContributionRate
 	hsaDeduction := yearFlow.EmploymentIncome * config.HSAContributionRate
 
-	// Standard deduction (2024 values, married filing jointly)
-	standardDeduction := 29200.0
+	// Standard deduction and brackets of the active tax year (married filing jointly)
+	taxYear := DefaultTaxData().Active()
+	brackets := taxYear.Brackets()
 
-	analysis.TaxableIncome = math.Max(0, analysis.GrossIncome-traditionalDeduction-hsaDeduction-standardDeduction)
+	// Self-employment tax, half of which is deducted
+	seTax, seDeduction := taxYear.SelfEmploymentTax(yearFlow.SelfEmploymentIncome, yearFlow.EmploymentIncome-yearFlow.SpouseEmploymentIncome)
+	analysis.SelfEmploymentTax = seTax
 
-	// Calculate federal tax using progressive brackets
-	analysis.FederalTax = s.calculateProgressiveTax(analysis.TaxableIncome, getFederalTaxBrackets())
+	// Only part of Social Security is taxable, depending on other income
+	otherIncome := analysis.GrossIncome - yearFlow.SocialSecurity - traditionalDeduction - hsaDeduction - seDeduction
+	analysis.TaxableSocialSecurity = taxYear.TaxableSocialSecurity(yearFlow.SocialSecurity, otherIncome)
+	analysis.SocialSecurityInclusionRate = taxYear.SocialSecurityInclusionRate(yearFlow.SocialSecurity, otherIncome)
 
-	// Calculate state tax (simplified flat rate)
-	if !config.StateHasNoIncomeTax {
-		analysis.StateTax = analysis.TaxableIncome * config.StateTaxRate
-	}
+	// The QBI deduction comes off taxable income after the standard deduction
+	incomeBeforeQBI := math.Max(0, otherIncome+analysis.TaxableSocialSecurity-taxYear.StandardDeduction)
+	analysis.QBIDeduction = taxYear.QualifiedBusinessIncomeDeduction(yearFlow.SelfEmploymentIncome-seDeduction, incomeBeforeQBI)
+	analysis.TaxableIncome = incomeBeforeQBI - analysis.QBIDeduction
 
-	// FICA tax (only on employment income, up to Social Security wage base)
-	if !isRetired && yearFlow.EmploymentIncome > 0 {
-		socialSecurityWageBase := 168600.0 // 2024 limit
-		socialSecurityTax := math.Min(yearFlow.EmploymentIncome, socialSecurityWageBase) * 0.062
-		medicareTax := yearFlow.EmploymentIncome * 0.0145
+	// Calculate federal tax using progressive brackets
+	analysis.FederalTax = s.calculateProgressiveTax(analysis.TaxableIncome, brackets)
+
+	// Calculate state tax by the state's rules, or a simplified flat rate;
+	// states tax a rental property sale's gains as income
+	saleGains := math.Max(0, yearFlow.RentalSaleGain) + yearFlow.DepreciationRecapture
+	stateRules, hasStateRules := StateTaxRulesFor(config.StateCode)
+	stateIncome := StateIncome{
+		Age:                   yearFlow.Age,
+		Wages:                 yearFlow.EmploymentIncome + yearFlow.SelfEmploymentIncome - traditionalDeduction - hsaDeduction - seDeduction,
+		SocialSecurity:        yearFlow.SocialSecurity,
+		Pension:               yearFlow.Pension,
+		RetirementWithdrawals: math.Max(yearFlow.TraditionalWithdrawal, yearFlow.RequiredMinimumDistribution) + yearFlow.InheritedIRADistribution + yearFlow.RothConversion,
+		Other:                 yearFlow.InvestmentIncome + yearFlow.taxableRentalIncome() + yearFlow.OtherIncome + saleGains + yearFlow.TaxableWindfall,
+	}
+	switch {
+	case hasStateRules:
+		analysis.StateTax = stateRules.Tax(stateIncome)
+	case !config.StateHasNoIncomeTax:
+		analysis.StateTax = (analysis.TaxableIncome + saleGains) * config.StateTaxRate
+	}
+
+	// FICA tax (only on employment income, up to Social Security wage base
+	// for each earner)
+	if yearFlow.EmploymentIncome > 0 {
+		ownWages := yearFlow.EmploymentIncome - yearFlow.SpouseEmploymentIncome
+		socialSecurityTax := (math.Min(ownWages, taxYear.SocialSecurityWageBase) +
+			math.Min(yearFlow.SpouseEmploymentIncome, taxYear.SocialSecurityWageBase)) * taxYear.SocialSecurityTaxRate
+		medicareTax := yearFlow.EmploymentIncome * taxYear.MedicareTaxRate
 
 		// Additional Medicare tax on high earners
-		if yearFlow.EmploymentIncome > 200000 {
-			medicareTax += (yearFlow.EmploymentIncome - 200000) * 0.009
+		if yearFlow.EmploymentIncome > taxYear.AdditionalMedicareThreshold {
+			medicareTax += (yearFlow.EmploymentIncome - taxYear.AdditionalMedicareThreshold) * taxYear.AdditionalMedicareTaxRate
 		}
 
 		analysis.FICATax = socialSecurityTax + medicareTax
 	}
+	analysis.FICATax += seTax
 
 	// Capital gains tax on investment income
 	if yearFlow.InvestmentIncome > 0 {
@@ -845,6 +1309,27 @@ func (s *CashFlowService) CalculateTaxImpact(yearFlow YearCashFlow, config CashF
 		analysis.CapitalGainsTax = yearFlow.InvestmentIncome * config.CapitalGainsRate
 	}
 
+	// A rental property sale's gain is taxed at the capital gains rate and
+	// recaptured depreciation at the marginal rate, up to 25%
+	analysis.CapitalGainsTax += math.Max(0, yearFlow.RentalSaleGain)*config.CapitalGainsRate +
+		yearFlow.DepreciationRecapture*math.Min(unrecapturedGainRate, s.getMarginalTaxRate(analysis.TaxableIncome, brackets))
+
+	// Tax the RMD adds: federal and state tax on the top of taxable income
+	if yearFlow.RequiredMinimumDistribution > 0 {
+		analysis.RequiredMinimumDistribution = yearFlow.RequiredMinimumDistribution
+		otherWithoutRMD := otherIncome - yearFlow.RequiredMinimumDistribution
+		withoutRMD := math.Max(0, otherWithoutRMD+
+			taxYear.TaxableSocialSecurity(yearFlow.SocialSecurity, otherWithoutRMD)-taxYear.StandardDeduction-analysis.QBIDeduction)
+		analysis.RMDTax = analysis.FederalTax - s.calculateProgressiveTax(withoutRMD, brackets)
+		switch {
+		case hasStateRules:
+			stateIncome.RetirementWithdrawals -= yearFlow.RequiredMinimumDistribution
+			analysis.RMDTax += analysis.StateTax - stateRules.Tax(stateIncome)
+		case !config.StateHasNoIncomeTax:
+			analysis.RMDTax += (analysis.TaxableIncome - withoutRMD) * config.StateTaxRate
+		}
+	}
+
 	// Total tax liability
 	analysis.TotalTaxLiability = analysis.FederalTax + analysis.StateTax + analysis.FICATax + analysis.CapitalGainsTax
 
@@ -852,16 +1337,24 @@ func (s *CashFlowService) CalculateTaxImpact(yearFlow YearCashFlow, config CashF
 	if analysis.GrossIncome > 0 {
 		analysis.EffectiveTaxRate = analysis.TotalTaxLiability / analysis.GrossIncome
 	}
-	analysis.MarginalTaxRate = s.getMarginalTaxRate(analysis.TaxableIncome)
+	analysis.MarginalTaxRate = s.getMarginalTaxRate(analysis.TaxableIncome, brackets)
 
 	// Calculate tax-advantaged benefits
 	analysis.TraditionalTaxSavings = traditionalDeduction * analysis.MarginalTaxRate
 	analysis.HSATaxBenefit = hsaDeduction * analysis.MarginalTaxRate
 
 	// Calculate Roth conversion opportunity (fill up to current bracket)
-	currentBracketCeiling := s.getCurrentBracketCeiling(analysis.TaxableIncome)
+	currentBracketCeiling := s.getCurrentBracketCeiling(analysis.TaxableIncome, brackets)
 	analysis.RothConversionOpportunity = math.Max(0, currentBracketCeiling-analysis.TaxableIncome)
 
+	// Tax on income other than self-employment is taken to be withheld
+	analysis.WithheldFederalTax = analysis.FederalTax
+	if yearFlow.SelfEmploymentIncome > 0 {
+		withoutSE := yearFlow
+		withoutSE.SelfEmploymentIncome = 0
+		analysis.WithheldFederalTax = s.CalculateTaxImpact(withoutSE, config, isRetired).FederalTax
+	}
+
 	return analysis
 }
 
@@ -874,7 +1367,7 @@ func (s *CashFlowService) CalculateWithdrawals(
 	result := WithdrawalResult{}
 	remaining := needed
 	totalBalance := taxable + traditional + roth + hsa
-	taxRate := config.FederalTaxRate + config.StateTaxRate
+	taxRate := config.FederalTaxRate + config.stateWithdrawalTaxRate()
 
 	if totalBalance <= 0 || remaining <= 0 {
 		return result
@@ -961,25 +1454,11 @@ func (s *CashFlowService) CalculateWithdrawals(
 
 // calculateProgressiveTax calculates tax using progressive brackets
 func (s *CashFlowService) calculateProgressiveTax(income float64, brackets []TaxBracket) float64 {
-	totalTax := 0.0
-	remaining := income
-
-	for _, bracket := range brackets {
-		if remaining <= 0 {
-			break
-		}
-		bracketWidth := bracket.MaxIncome - bracket.MinIncome
-		taxableInBracket := math.Min(remaining, bracketWidth)
-		totalTax += taxableInBracket * bracket.Rate
-		remaining -= taxableInBracket
-	}
-
-	return totalTax
+	return progressiveTax(income, brackets)
 }
 
 // getMarginalTaxRate returns the marginal tax rate for given income
-func (s *CashFlowService) getMarginalTaxRate(income float64) float64 {
-	brackets := getFederalTaxBrackets()
+func (s *CashFlowService) getMarginalTaxRate(income float64, brackets []TaxBracket) float64 {
 	for _, bracket := range brackets {
 		if income <= bracket.MaxIncome {
 			return bracket.Rate
@@ -989,8 +1468,7 @@ func (s *CashFlowService) getMarginalTaxRate(income float64) float64 {
 }
 
 // getCurrentBracketCeiling returns the ceiling of the current tax bracket
-func (s *CashFlowService) getCurrentBracketCeiling(income float64) float64 {
-	brackets := getFederalTaxBrackets()
+func (s *CashFlowService) getCurrentBracketCeiling(income float64, brackets []TaxBracket) float64 {
 	for _, bracket := range brackets {
 		if income <= bracket.MaxIncome {
 			return bracket.MaxIncome
@@ -999,19 +1477,6 @@ func (s *CashFlowService) getCurrentBracketCeiling(income float64) float64 {
 	return math.MaxFloat64
 }
 
-// getFederalTaxBrackets returns 2024 federal tax brackets (married filing jointly)
-func getFederalTaxBrackets() []TaxBracket {
-	return []TaxBracket{
-		{0, 23200, 0.10},
-		{23200, 94300, 0.12},
-		{94300, 201050, 0.22},
-		{201050, 383900, 0.24},
-		{383900, 487450, 0.32},
-		{487450, 731200, 0.35},
-		{731200, math.MaxFloat64, 0.37},
-	}
-}
-
 // calculateRetirementReadiness calculates a 0-1 score for retirement readiness
 func (s *CashFlowService) calculateRetirementReadiness(yearlyFlows []YearCashFlow, config CashFlowConfig) float64 {
 	retirementYears := config.LifeExpectancy - config.RetirementAge
@@ -1084,24 +1549,12 @@ func (s *CashFlowService) GetConfig() CashFlowConfig {
 
 // CompareTaxStrategies compares different withdrawal strategies
 func (s *CashFlowService) CompareTaxStrategies(config CashFlowConfig) (map[WithdrawalStrategy]*CashFlowResults, error) {
-	strategies := []WithdrawalStrategy{ProRata, TaxableFirst, TraditionalFirst, RothFirst, TaxOptimized}
-	results := make(map[WithdrawalStrategy]*CashFlowResults)
-
-	for _, strategy := range strategies {
-		testConfig := config
-		testConfig.WithdrawalStrategy = strategy
-		result, err := s.RunAnalysisWithConfig(testConfig)
-		if err != nil {
-			return nil, err
-		}
-		results[strategy] = result
-	}
-
-	return results, nil
+	return s.CompareTaxStrategiesConcurrently(context.Background(), config, 0, nil)
 }
 
 // CalculateIncomeFlows returns a breakdown of all income flows for a year
-func (s *CashFlowService) CalculateIncomeFlows(flow YearCashFlow) []CashFlow {
+func (s *CashFlowService) CalculateIncomeFlows(ctx context.Context, flow YearCashFlow) []CashFlow {
+	loc := i18n.FromContext(ctx)
 	flows := []CashFlow{}
 
 	if flow.EmploymentIncome > 0 {
@@ -1109,7 +1562,15 @@ func (s *CashFlowService) CalculateIncomeFlows(flow YearCashFlow) []CashFlow {
 			Category:    FlowCategoryEmploymentIncome,
 			Type:        FlowTypeIncome,
 			Amount:      flow.EmploymentIncome,
-			Description: "Employment income",
+			Description: flowDescription(loc, FlowCategoryEmploymentIncome),
+		})
+	}
+	if flow.SelfEmploymentIncome > 0 {
+		flows = append(flows, CashFlow{
+			Category:    FlowCategorySelfEmployment,
+			Type:        FlowTypeIncome,
+			Amount:      flow.SelfEmploymentIncome,
+			Description: flowDescription(loc, FlowCategorySelfEmployment),
 		})
 	}
 	if flow.SocialSecurity > 0 {
@@ -1117,7 +1578,7 @@ func (s *CashFlowService) CalculateIncomeFlows(flow YearCashFlow) []CashFlow {
 			Category:    FlowCategorySocialSecurity,
 			Type:        FlowTypeIncome,
 			Amount:      flow.SocialSecurity,
-			Description: "Social Security benefits",
+			Description: flowDescription(loc, FlowCategorySocialSecurity),
 		})
 	}
 	if flow.Pension > 0 {
@@ -1125,7 +1586,7 @@ func (s *CashFlowService) CalculateIncomeFlows(flow YearCashFlow) []CashFlow {
 			Category:    FlowCategoryPension,
 			Type:        FlowTypeIncome,
 			Amount:      flow.Pension,
-			Description: "Pension income",
+			Description: flowDescription(loc, FlowCategoryPension),
 		})
 	}
 	if flow.InvestmentIncome > 0 {
@@ -1133,7 +1594,7 @@ func (s *CashFlowService) CalculateIncomeFlows(flow YearCashFlow) []CashFlow {
 			Category:    FlowCategoryInvestmentIncome,
 			Type:        FlowTypeIncome,
 			Amount:      flow.InvestmentIncome,
-			Description: "Dividends and interest",
+			Description: flowDescription(loc, FlowCategoryInvestmentIncome),
 		})
 	}
 	if flow.RentalIncome > 0 {
@@ -1141,7 +1602,7 @@ func (s *CashFlowService) CalculateIncomeFlows(flow YearCashFlow) []CashFlow {
 			Category:    FlowCategoryRentalIncome,
 			Type:        FlowTypeIncome,
 			Amount:      flow.RentalIncome,
-			Description: "Rental property income",
+			Description: flowDescription(loc, FlowCategoryRentalIncome),
 		})
 	}
 	if flow.OtherIncome > 0 {
@@ -1149,7 +1610,7 @@ func (s *CashFlowService) CalculateIncomeFlows(flow YearCashFlow) []CashFlow {
 			Category:    FlowCategoryOtherIncome,
 			Type:        FlowTypeIncome,
 			Amount:      flow.OtherIncome,
-			Description: "Other income sources",
+			Description: flowDescription(loc, FlowCategoryOtherIncome),
 		})
 	}
 
@@ -1157,7 +1618,8 @@ func (s *CashFlowService) CalculateIncomeFlows(flow YearCashFlow) []CashFlow {
 }
 
 // CalculateExpenseFlows returns a breakdown of all expense flows for a year
-func (s *CashFlowService) CalculateExpenseFlows(flow YearCashFlow) []CashFlow {
+func (s *CashFlowService) CalculateExpenseFlows(ctx context.Context, flow YearCashFlow) []CashFlow {
+	loc := i18n.FromContext(ctx)
 	flows := []CashFlow{}
 
 	if flow.HousingExpense > 0 {
@@ -1165,7 +1627,7 @@ func (s *CashFlowService) CalculateExpenseFlows(flow YearCashFlow) []CashFlow {
 			Category:    FlowCategoryHousing,
 			Type:        FlowTypeExpense,
 			Amount:      flow.HousingExpense,
-			Description: "Housing costs (mortgage/rent, property tax, maintenance)",
+			Description: flowDescription(loc, FlowCategoryHousing),
 		})
 	}
 	if flow.HealthcareExpense > 0 {
@@ -1173,7 +1635,7 @@ func (s *CashFlowService) CalculateExpenseFlows(flow YearCashFlow) []CashFlow {
 			Category:    FlowCategoryHealthcare,
 			Type:        FlowTypeExpense,
 			Amount:      flow.HealthcareExpense,
-			Description: "Healthcare costs (insurance, out-of-pocket)",
+			Description: flowDescription(loc, FlowCategoryHealthcare),
 		})
 	}
 	if flow.FoodExpense > 0 {
@@ -1181,7 +1643,7 @@ func (s *CashFlowService) CalculateExpenseFlows(flow YearCashFlow) []CashFlow {
 			Category:    FlowCategoryFood,
 			Type:        FlowTypeExpense,
 			Amount:      flow.FoodExpense,
-			Description: "Food and groceries",
+			Description: flowDescription(loc, FlowCategoryFood),
 		})
 	}
 	if flow.TransportationExpense > 0 {
@@ -1189,7 +1651,7 @@ func (s *CashFlowService) CalculateExpenseFlows(flow YearCashFlow) []CashFlow {
 			Category:    FlowCategoryTransportation,
 			Type:        FlowTypeExpense,
 			Amount:      flow.TransportationExpense,
-			Description: "Transportation costs",
+			Description: flowDescription(loc, FlowCategoryTransportation),
 		})
 	}
 	if flow.UtilitiesExpense > 0 {
@@ -1197,7 +1659,7 @@ func (s *CashFlowService) CalculateExpenseFlows(flow YearCashFlow) []CashFlow {
 			Category:    FlowCategoryUtilities,
 			Type:        FlowTypeExpense,
 			Amount:      flow.UtilitiesExpense,
-			Description: "Utilities (electric, gas, water, internet)",
+			Description: flowDescription(loc, FlowCategoryUtilities),
 		})
 	}
 	if flow.InsuranceExpense > 0 {
@@ -1205,7 +1667,7 @@ func (s *CashFlowService) CalculateExpenseFlows(flow YearCashFlow) []CashFlow {
 			Category:    FlowCategoryInsurance,
 			Type:        FlowTypeExpense,
 			Amount:      flow.InsuranceExpense,
-			Description: "Insurance (life, auto, home)",
+			Description: flowDescription(loc, FlowCategoryInsurance),
 		})
 	}
 	if flow.DiscretionaryExpense > 0 {
@@ -1213,7 +1675,7 @@ func (s *CashFlowService) CalculateExpenseFlows(flow YearCashFlow) []CashFlow {
 			Category:    FlowCategoryDiscretionary,
 			Type:        FlowTypeExpense,
 			Amount:      flow.DiscretionaryExpense,
-			Description: "Discretionary spending (entertainment, travel)",
+			Description: flowDescription(loc, FlowCategoryDiscretionary),
 		})
 	}
 	if flow.OtherExpenses > 0 {
@@ -1221,7 +1683,15 @@ func (s *CashFlowService) CalculateExpenseFlows(flow YearCashFlow) []CashFlow {
 			Category:    FlowCategoryOtherExpenses,
 			Type:        FlowTypeExpense,
 			Amount:      flow.OtherExpenses,
-			Description: "Other expenses",
+			Description: flowDescription(loc, FlowCategoryOtherExpenses),
+		})
+	}
+	if flow.DebtPayments > 0 {
+		flows = append(flows, CashFlow{
+			Category:    FlowCategoryDebtPayments,
+			Type:        FlowTypeExpense,
+			Amount:      flow.DebtPayments,
+			Description: flowDescription(loc, FlowCategoryDebtPayments),
 		})
 	}
 
@@ -1229,7 +1699,8 @@ func (s *CashFlowService) CalculateExpenseFlows(flow YearCashFlow) []CashFlow {
 }
 
 // CalculateTaxFlows returns a breakdown of all tax flows for a year
-func (s *CashFlowService) CalculateTaxFlows(flow YearCashFlow) []CashFlow {
+func (s *CashFlowService) CalculateTaxFlows(ctx context.Context, flow YearCashFlow) []CashFlow {
+	loc := i18n.FromContext(ctx)
 	flows := []CashFlow{}
 
 	if flow.FederalTax > 0 {
@@ -1237,7 +1708,7 @@ func (s *CashFlowService) CalculateTaxFlows(flow YearCashFlow) []CashFlow {
 			Category:    FlowCategoryFederalTax,
 			Type:        FlowTypeTax,
 			Amount:      flow.FederalTax,
-			Description: "Federal income tax",
+			Description: flowDescription(loc, FlowCategoryFederalTax),
 		})
 	}
 	if flow.StateTax > 0 {
@@ -1245,7 +1716,7 @@ func (s *CashFlowService) CalculateTaxFlows(flow YearCashFlow) []CashFlow {
 			Category:    FlowCategoryStateTax,
 			Type:        FlowTypeTax,
 			Amount:      flow.StateTax,
-			Description: "State income tax",
+			Description: flowDescription(loc, FlowCategoryStateTax),
 		})
 	}
 	if flow.FICATax > 0 {
@@ -1253,7 +1724,7 @@ func (s *CashFlowService) CalculateTaxFlows(flow YearCashFlow) []CashFlow {
 			Category:    FlowCategoryFICATax,
 			Type:        FlowTypeTax,
 			Amount:      flow.FICATax,
-			Description: "Social Security and Medicare tax",
+			Description: flowDescription(loc, FlowCategoryFICATax),
 		})
 	}
 	if flow.CapitalGainsTax > 0 {
@@ -1261,9 +1732,15 @@ func (s *CashFlowService) CalculateTaxFlows(flow YearCashFlow) []CashFlow {
 			Category:    FlowCategoryCapitalGains,
 			Type:        FlowTypeTax,
 			Amount:      flow.CapitalGainsTax,
-			Description: "Capital gains tax",
+			Description: flowDescription(loc, FlowCategoryCapitalGains),
 		})
 	}
 
 	return flows
 }
+
+// flowDescription describes a cash flow category in the localizer's
+// language
+func flowDescription(loc *i18n.Localizer, category FlowCategory) string {
+	return loc.Message("retirement.flow."+string(category), nil)
+}
@@ -0,0 +1,208 @@
+package retirement
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// =============================================================================
+// Debts
+// =============================================================================
+
+// Debts are amortized with a fixed annual payment over their remaining
+// term, plus any extra principal payment, until they are paid off.
+// Payments are nominal, so they shrink in real terms, and stop when the
+// balance is paid: a mortgage's payments are part of HousingExpense, which
+// then holds only the rest of housing costs, and other debts' payments are
+// DebtPayments. Interest isn't deducted; the tax model takes the standard
+// deduction.
+
+const (
+	// maxDebtYears bounds amortization of a debt that would never be paid
+	// off
+	maxDebtYears = 100
+	// paidOffBalance is the rounding left over from a final payment
+	paidOffBalance = 0.01
+)
+
+// DebtType is the kind of debt
+type DebtType string
+
+const (
+	DebtMortgage    DebtType = "mortgage"
+	DebtStudentLoan DebtType = "student_loan"
+	DebtAutoLoan    DebtType = "auto_loan"
+	DebtOther       DebtType = "other"
+)
+
+// Debt is a loan paid down through the plan
+type Debt struct {
+	Name string
+	Type DebtType
+
+	Balance float64
+	// Rate is the annual interest rate, e.g. 0.065 for 6.5%
+	Rate float64
+	// TermYears is how many years are left to pay
+	TermYears int
+	// ExtraPayment is extra principal paid each year on top of the
+	// scheduled payment
+	ExtraPayment float64
+}
+
+// ScheduledPayment is the fixed annual payment that pays the balance off
+// over the remaining term
+func (d Debt) ScheduledPayment() float64 {
+	if d.Balance <= 0 || d.TermYears <= 0 {
+		return 0
+	}
+	if d.Rate == 0 {
+		return d.Balance / float64(d.TermYears)
+	}
+	return d.Balance * d.Rate / (1 - math.Pow(1+d.Rate, -float64(d.TermYears)))
+}
+
+// validateDebts checks each debt's amounts and term
+func validateDebts(config CashFlowConfig) error {
+	for _, d := range config.Debts {
+		switch d.Type {
+		case DebtMortgage, DebtStudentLoan, DebtAutoLoan, DebtOther:
+		default:
+			return fmt.Errorf("unknown debt type %q", d.Type)
+		}
+		if d.Balance < 0 || d.ExtraPayment < 0 {
+			return errors.New("debt Balance and ExtraPayment cannot be negative")
+		}
+		if d.Rate < 0 || d.Rate > 1 {
+			return errors.New("debt Rate must be between 0 and 1")
+		}
+		if d.TermYears < 1 || d.TermYears > 50 {
+			return errors.New("debt TermYears must be between 1 and 50")
+		}
+	}
+	return nil
+}
+
+// debtBalance tracks a debt through the plan
+type debtBalance struct {
+	Debt
+	payment float64
+}
+
+// newDebtBalances starts tracking config's debts
+func newDebtBalances(config CashFlowConfig) []*debtBalance {
+	debts := make([]*debtBalance, len(config.Debts))
+	for i, d := range config.Debts {
+		debts[i] = &debtBalance{Debt: d, payment: d.ScheduledPayment() + d.ExtraPayment}
+	}
+	return debts
+}
+
+// pay makes a year's payment, returning it and the interest in it
+func (d *debtBalance) pay() (payment, interest float64) {
+	if d.Balance <= 0 {
+		return 0, 0
+	}
+	interest = d.Balance * d.Rate
+	payment = math.Min(d.payment, d.Balance+interest)
+	d.Balance -= payment - interest
+	if d.Balance < paidOffBalance {
+		d.Balance = 0
+	}
+	return payment, interest
+}
+
+// addDebtPayments adds a plan year's debt payments to the household's
+// expenses
+func addDebtPayments(flow *YearCashFlow, debts []*debtBalance) {
+	for _, d := range debts {
+		payment, interest := d.pay()
+		if d.Type == DebtMortgage {
+			flow.HousingExpense += payment
+			flow.MortgagePayments += payment
+		} else {
+			flow.DebtPayments += payment
+		}
+		flow.DebtInterest += interest
+		flow.DebtBalance += d.Balance
+	}
+}
+
+// DebtPayoffComparison compares paying extra on a debt with investing the
+// extra in the taxable account, spending the same each year until the
+// debt would otherwise be paid off
+type DebtPayoffComparison struct {
+	Debt         Debt
+	ExtraPayment float64
+
+	// Years to pay the debt off without and with the extra payment, and
+	// the interest the extra payment saves
+	PayoffYears        int
+	PrepaidPayoffYears int
+	InterestSaved      float64
+
+	// PrepayWealth is what investing the freed-up payments after the early
+	// payoff grows to by the original payoff; InvestWealth is what
+	// investing the extra all along grows to. Returns are after capital
+	// gains tax.
+	PrepayWealth float64
+	InvestWealth float64
+	PreferPrepay bool
+
+	// The plan without and with the extra payment
+	Baseline StrategyOutcome
+	Prepay   StrategyOutcome
+}
+
+// CompareDebtPayoff compares paying extra a year on config's debt at index
+// with investing it
+func (s *CashFlowService) CompareDebtPayoff(config CashFlowConfig, index int, extra float64) (*DebtPayoffComparison, error) {
+	if index < 0 || index >= len(config.Debts) {
+		return nil, errors.New("debt index out of range")
+	}
+	if extra <= 0 {
+		return nil, errors.New("extra payment must be positive")
+	}
+	debt := config.Debts[index]
+
+	baseline, err := s.RunAnalysisWithConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	prepaid := config
+	prepaid.Debts = append([]Debt(nil), config.Debts...)
+	prepaid.Debts[index].ExtraPayment += extra
+	prepay, err := s.RunAnalysisWithConfig(prepaid)
+	if err != nil {
+		return nil, err
+	}
+
+	comparison := &DebtPayoffComparison{
+		Debt:         debt,
+		ExtraPayment: extra,
+		Baseline:     strategyOutcome(baseline),
+		Prepay:       strategyOutcome(prepay),
+	}
+
+	// Year by year until the original payoff, both ways spend the scheduled
+	// payment plus the extra on the debt or in the taxable account
+	growth := 1 + config.ExpectedReturn*(1-config.CapitalGainsRate)
+	scheduled := &debtBalance{Debt: debt, payment: debt.ScheduledPayment() + debt.ExtraPayment}
+	early := &debtBalance{Debt: debt, payment: scheduled.payment + extra}
+	for year := 0; scheduled.Balance > 0 && year < maxDebtYears; year++ {
+		payment, interest := scheduled.pay()
+		comparison.PayoffYears++
+		comparison.InterestSaved += interest
+		comparison.InvestWealth = comparison.InvestWealth*growth + extra
+
+		earlyPayment, earlyInterest := early.pay()
+		if earlyPayment > 0 {
+			comparison.PrepaidPayoffYears++
+		}
+		comparison.InterestSaved -= earlyInterest
+		comparison.PrepayWealth = comparison.PrepayWealth*growth + payment + extra - earlyPayment
+	}
+	comparison.PreferPrepay = comparison.PrepayWealth > comparison.InvestWealth
+	return comparison, nil
+}
@@ -0,0 +1,80 @@
+package retirement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDebtScheduledPayment(t *testing.T) {
+	// 200,000 over 30 years at 6% is 14,529.78 a year
+	mortgage := Debt{Type: DebtMortgage, Balance: 200000, Rate: 0.06, TermYears: 30}
+	assert.InDelta(t, 14529.78, mortgage.ScheduledPayment(), 0.01)
+
+	assert.Equal(t, 2000.0, Debt{Balance: 10000, TermYears: 5}.ScheduledPayment())
+	assert.Equal(t, 0.0, Debt{TermYears: 5}.ScheduledPayment())
+}
+
+func TestCashFlowDebts(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	config.Debts = []Debt{
+		{Name: "home", Type: DebtMortgage, Balance: 200000, Rate: 0.06, TermYears: 10},
+		{Name: "car", Type: DebtAutoLoan, Balance: 20000, Rate: 0.05, TermYears: 4},
+	}
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+	results, err := service.RunAnalysis()
+	require.NoError(t, err)
+
+	first := results.YearlyFlows[0]
+	mortgage := config.Debts[0].ScheduledPayment()
+	assert.InDelta(t, mortgage, first.MortgagePayments, 1e-6)
+	assert.InDelta(t, config.HousingExpense+mortgage, first.HousingExpense, 1e-6)
+	assert.InDelta(t, config.Debts[1].ScheduledPayment(), first.DebtPayments, 1e-6)
+	assert.InDelta(t, 200000*0.06+20000*0.05, first.DebtInterest, 1e-6)
+	assert.InDelta(t, first.TotalPortfolio-first.DebtBalance, first.NetWorth, 1e-6)
+
+	// Housing drops once the mortgage is paid off
+	paidOff := results.YearlyFlows[10]
+	assert.Equal(t, 0.0, paidOff.MortgagePayments)
+	assert.Equal(t, 0.0, paidOff.DebtPayments)
+	assert.Equal(t, 0.0, paidOff.DebtBalance)
+	assert.Less(t, paidOff.HousingExpense, results.YearlyFlows[9].HousingExpense)
+
+	// Extra payments pay the car off a year sooner
+	config.Debts[1].ExtraPayment = 3000
+	results, err = service.RunAnalysisWithConfig(config)
+	require.NoError(t, err)
+	assert.Positive(t, results.YearlyFlows[2].DebtPayments)
+	assert.Equal(t, 0.0, results.YearlyFlows[3].DebtPayments)
+}
+
+func TestCompareDebtPayoff(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	config.Debts = []Debt{{Type: DebtStudentLoan, Balance: 50000, Rate: 0.08, TermYears: 10}}
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	comparison, err := service.CompareDebtPayoff(config, 0, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, 10, comparison.PayoffYears)
+	assert.Less(t, comparison.PrepaidPayoffYears, 10)
+	assert.Positive(t, comparison.InterestSaved)
+	assert.Equal(t, 0.0, config.Debts[0].ExtraPayment)
+
+	// At 8% the debt costs more than a 7% return less capital gains tax
+	assert.True(t, comparison.PreferPrepay)
+	assert.Greater(t, comparison.PrepayWealth, comparison.InvestWealth)
+
+	// A cheap debt is better left to its schedule
+	config.Debts[0].Rate = 0.02
+	comparison, err = service.CompareDebtPayoff(config, 0, 5000)
+	require.NoError(t, err)
+	assert.False(t, comparison.PreferPrepay)
+
+	_, err = service.CompareDebtPayoff(config, 1, 5000)
+	assert.Error(t, err)
+	_, err = service.CompareDebtPayoff(config, 0, 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,278 @@
+package retirement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// =============================================================================
+// Dynamic Spending Strategies
+// =============================================================================
+
+// WithdrawalStrategy picks which accounts pay for spending; a spending
+// strategy decides how much is spent. The planned strategy spends the
+// configured expenses. The others set each retired year's withdrawal from
+// the portfolio as it stands at the start of the year, and spending is
+// income plus that withdrawal: the configured expense lines are scaled to
+// it, while debt payments, long-term care, IRMAA surcharges and taxes are
+// paid on top.
+//
+//   - fixed_percentage withdraws WithdrawalRate of the portfolio.
+//   - guardrails (Guyton-Klinger) starts from the planned spending, raises
+//     the withdrawal with inflation except after a losing year when its
+//     rate is above the initial rate, and cuts or raises it by
+//     GuardrailAdjustment when its rate drifts GuardrailBand past the
+//     initial rate; cuts stop in the last GuardrailFinalYears.
+//   - vpw (Variable Percentage Withdrawal) withdraws the share of the
+//     portfolio that would pay it out evenly in real terms over the years
+//     left, at VPWReturn.
+
+// SpendingStrategy decides how much is spent each year of retirement
+type SpendingStrategy string
+
+const (
+	SpendingPlanned         SpendingStrategy = "planned"
+	SpendingFixedPercentage SpendingStrategy = "fixed_percentage"
+	SpendingGuardrails      SpendingStrategy = "guardrails"
+	SpendingVPW             SpendingStrategy = "vpw"
+)
+
+// SpendingRules are the dynamic spending strategies' parameters; zero
+// fields take their defaults
+type SpendingRules struct {
+	WithdrawalRate float64
+
+	GuardrailBand       float64
+	GuardrailAdjustment float64
+	GuardrailFinalYears int
+
+	// VPWReturn is the real return VPW's payout schedule assumes
+	VPWReturn float64
+}
+
+// DefaultSpendingRules returns the strategies' usual parameters
+func DefaultSpendingRules() SpendingRules {
+	return SpendingRules{
+		WithdrawalRate:      0.04,
+		GuardrailBand:       0.2,
+		GuardrailAdjustment: 0.1,
+		GuardrailFinalYears: 15,
+		VPWReturn:           0.035,
+	}
+}
+
+// withDefaults fills zero fields with their defaults
+func (r SpendingRules) withDefaults() SpendingRules {
+	defaults := DefaultSpendingRules()
+	if r.WithdrawalRate == 0 {
+		r.WithdrawalRate = defaults.WithdrawalRate
+	}
+	if r.GuardrailBand == 0 {
+		r.GuardrailBand = defaults.GuardrailBand
+	}
+	if r.GuardrailAdjustment == 0 {
+		r.GuardrailAdjustment = defaults.GuardrailAdjustment
+	}
+	if r.GuardrailFinalYears == 0 {
+		r.GuardrailFinalYears = defaults.GuardrailFinalYears
+	}
+	if r.VPWReturn == 0 {
+		r.VPWReturn = defaults.VPWReturn
+	}
+	return r
+}
+
+// validateSpendingStrategy checks the strategy is known and its rules sane
+func validateSpendingStrategy(config CashFlowConfig) error {
+	switch config.SpendingStrategy {
+	case "", SpendingPlanned, SpendingFixedPercentage, SpendingGuardrails, SpendingVPW:
+	default:
+		return fmt.Errorf("unknown spending strategy %q", config.SpendingStrategy)
+	}
+	r := config.SpendingRules
+	if r.WithdrawalRate < 0 || r.WithdrawalRate > 0.2 {
+		return errors.New("WithdrawalRate must be between 0 and 0.2")
+	}
+	if r.GuardrailBand < 0 || r.GuardrailBand > 1 || r.GuardrailAdjustment < 0 || r.GuardrailAdjustment > 0.5 {
+		return errors.New("GuardrailBand must be between 0 and 1 and GuardrailAdjustment between 0 and 0.5")
+	}
+	if r.GuardrailFinalYears < 0 {
+		return errors.New("GuardrailFinalYears cannot be negative")
+	}
+	if r.VPWReturn < -0.05 || r.VPWReturn > 0.15 {
+		return errors.New("VPWReturn must be between -0.05 and 0.15")
+	}
+	return nil
+}
+
+// plannedSpending is a year's configured expense lines before shaping
+func (c CashFlowConfig) plannedSpending(inflationFactor, healthcareInflation float64) float64 {
+	return c.HealthcareExpense*healthcareInflation + (c.HousingExpense+c.FoodExpense+
+		c.TransportationExpense+c.UtilitiesExpense+c.InsuranceExpense+
+		c.DiscretionaryExpense+c.OtherExpenses)*inflationFactor
+}
+
+// spendingPlan tracks a dynamic strategy's withdrawal through retirement
+type spendingPlan struct {
+	rules       SpendingRules
+	withdrawal  float64
+	initialRate float64
+	started     bool
+}
+
+// newSpendingPlan starts tracking config's spending strategy
+func newSpendingPlan(config CashFlowConfig) *spendingPlan {
+	return &spendingPlan{rules: config.SpendingRules.withDefaults()}
+}
+
+// adjustment returns how much a retired year's planned spending is scaled
+// by, given the portfolio at the start of the year and the year's income.
+// returns and inflation are the analysis's yearly rates.
+func (p *spendingPlan) adjustment(config CashFlowConfig, year int, planned, income, portfolio float64, returns, inflation []float64) float64 {
+	age := config.CurrentAge + year
+	switch config.SpendingStrategy {
+	case SpendingFixedPercentage:
+		p.withdrawal = p.rules.WithdrawalRate * portfolio
+	case SpendingVPW:
+		p.withdrawal = portfolio * vpwRate(p.rules.VPWReturn, config.LifeExpectancy-age)
+	case SpendingGuardrails:
+		p.guardrails(config, year, planned, income, portfolio, returns, inflation)
+	default:
+		return 1
+	}
+	if planned <= 0 {
+		return 1
+	}
+	return (income + p.withdrawal) / planned
+}
+
+// guardrails sets the year's Guyton-Klinger withdrawal
+func (p *spendingPlan) guardrails(config CashFlowConfig, year int, planned, income, portfolio float64, returns, inflation []float64) {
+	if !p.started {
+		p.started = true
+		p.withdrawal = math.Max(0, planned-income)
+		if portfolio > 0 {
+			p.initialRate = p.withdrawal / portfolio
+		}
+		return
+	}
+	if portfolio <= 0 {
+		p.withdrawal *= 1 + inflation[year-1]
+		return
+	}
+
+	// No inflation raise after a losing year while withdrawing more than
+	// the initial rate
+	if returns[year-1] >= 0 || p.withdrawal/portfolio <= p.initialRate {
+		p.withdrawal *= 1 + inflation[year-1]
+	}
+
+	rate := p.withdrawal / portfolio
+	yearsLeft := config.LifeExpectancy - config.CurrentAge - year
+	switch {
+	case rate > p.initialRate*(1+p.rules.GuardrailBand) && yearsLeft > p.rules.GuardrailFinalYears:
+		p.withdrawal *= 1 - p.rules.GuardrailAdjustment
+	case rate < p.initialRate*(1-p.rules.GuardrailBand):
+		p.withdrawal *= 1 + p.rules.GuardrailAdjustment
+	}
+}
+
+// vpwRate is the share of a portfolio that pays it out in equal real
+// amounts over years at a real return
+func vpwRate(realReturn float64, years int) float64 {
+	if years <= 1 {
+		return 1
+	}
+	if realReturn == 0 {
+		return 1 / float64(years)
+	}
+	// Payments are at the start of each year
+	return realReturn / ((1 + realReturn) * (1 - math.Pow(1+realReturn, -float64(years))))
+}
+
+// SpendingVolatility describes how retired spending moved in real terms
+type SpendingVolatility struct {
+	// Volatility is the standard deviation of the year-over-year change in
+	// real spending
+	Volatility float64
+	// LowestSpendingRatio is the lowest real spending relative to the first
+	// retired year's; LargestCut the biggest one-year real drop
+	LowestSpendingRatio float64
+	LargestCut          float64
+}
+
+// spendingVolatility measures retired years' real spending on the
+// configured expense lines
+func spendingVolatility(flows []YearCashFlow, inflation []float64) SpendingVolatility {
+	var realSpending []float64
+	deflator := 1.0
+	for year, flow := range flows {
+		if flow.IsRetired {
+			realSpending = append(realSpending, flow.Spending/deflator)
+		}
+		deflator *= 1 + inflation[year]
+	}
+	if len(realSpending) == 0 || realSpending[0] <= 0 {
+		return SpendingVolatility{}
+	}
+
+	metrics := SpendingVolatility{LowestSpendingRatio: 1}
+	var changes []float64
+	for i, spending := range realSpending {
+		metrics.LowestSpendingRatio = math.Min(metrics.LowestSpendingRatio, spending/realSpending[0])
+		if i > 0 && realSpending[i-1] > 0 {
+			change := spending/realSpending[i-1] - 1
+			changes = append(changes, change)
+			metrics.LargestCut = math.Max(metrics.LargestCut, -change)
+		}
+	}
+	if len(changes) > 0 {
+		var mean, variance float64
+		for _, change := range changes {
+			mean += change
+		}
+		mean /= float64(len(changes))
+		for _, change := range changes {
+			variance += (change - mean) * (change - mean)
+		}
+		metrics.Volatility = math.Sqrt(variance / float64(len(changes)))
+	}
+	return metrics
+}
+
+// SpendingStrategyOutcome is how a spending strategy fares in simulation
+type SpendingStrategyOutcome struct {
+	Strategy             SpendingStrategy
+	SuccessProbability   float64
+	MedianFinalPortfolio float64
+	// Spending is averaged across the simulations
+	Spending SpendingVolatility
+}
+
+// CompareSpendingStrategies simulates config under each spending strategy
+// with the same market draws
+func (s *CashFlowService) CompareSpendingStrategies(ctx context.Context, config CashFlowConfig, mc CashFlowMonteCarloConfig) ([]SpendingStrategyOutcome, error) {
+	if mc.Seed == 0 {
+		mc.Seed = time.Now().UnixNano()
+	}
+	strategies := []SpendingStrategy{SpendingPlanned, SpendingFixedPercentage, SpendingGuardrails, SpendingVPW}
+	outcomes := make([]SpendingStrategyOutcome, len(strategies))
+	for i, strategy := range strategies {
+		strategyConfig := config
+		strategyConfig.SpendingStrategy = strategy
+		results, err := s.RunMonteCarlo(ctx, strategyConfig, mc)
+		if err != nil {
+			return nil, err
+		}
+		outcomes[i] = SpendingStrategyOutcome{
+			Strategy:             strategy,
+			SuccessProbability:   results.SuccessProbability,
+			MedianFinalPortfolio: results.FinalPercentiles.P50,
+			Spending:             results.Spending,
+		}
+	}
+	return outcomes, nil
+}
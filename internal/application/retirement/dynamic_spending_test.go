@@ -0,0 +1,91 @@
+package retirement
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVPWRate(t *testing.T) {
+	assert.Equal(t, 1.0, vpwRate(0.035, 1))
+	assert.Equal(t, 0.1, vpwRate(0, 10))
+
+	// Paying out 1 evenly at 5% over 2 years: 0.5122 now and 0.5122 next
+	// year from the 0.4878 left growing to 0.5122
+	rate := vpwRate(0.05, 2)
+	assert.InDelta(t, (1-rate)*1.05, rate, 1e-9)
+	assert.Greater(t, vpwRate(0.035, 10), vpwRate(0.035, 30))
+}
+
+func TestGuardrails(t *testing.T) {
+	config := CashFlowConfig{CurrentAge: 65, LifeExpectancy: 95, SpendingStrategy: SpendingGuardrails}
+	plan := newSpendingPlan(config)
+	returns := []float64{-0.2, 0.3, 0}
+	inflation := []float64{0.03, 0.03, 0}
+
+	// Spending starts as planned: a 4% withdrawal on top of income
+	assert.Equal(t, 1.0, plan.adjustment(config, 0, 100000, 20000, 2000000, returns, inflation))
+	assert.InDelta(t, 0.04, plan.initialRate, 1e-12)
+
+	// After a loss the withdrawal isn't raised for inflation, and a rate
+	// past the upper guardrail cuts it
+	assert.InDelta(t, 0.92, plan.adjustment(config, 1, 100000, 20000, 1200000, returns, inflation), 1e-12)
+	assert.InDelta(t, 72000, plan.withdrawal, 1e-9)
+
+	// After a gain it's raised for inflation, and a rate past the lower
+	// guardrail raises it again
+	plan.adjustment(config, 2, 100000, 20000, 3000000, returns, inflation)
+	assert.InDelta(t, 72000*1.03*1.1, plan.withdrawal, 1e-9)
+}
+
+func TestCashFlowSpendingStrategies(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	config.SpendingStrategy = SpendingFixedPercentage
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+	results, err := service.RunAnalysis()
+	require.NoError(t, err)
+
+	// The first retired year spends its income and 4% of the portfolio
+	retired := config.RetirementAge - config.CurrentAge
+	first := results.YearlyFlows[retired]
+	assert.InDelta(t, first.TotalIncome+0.04*results.YearlyFlows[retired-1].TotalPortfolio, first.Spending, 1e-6)
+	assert.Equal(t, 1.0, results.YearlyFlows[0].SpendingAdjustment)
+
+	// Planned spending only grows with healthcare costs
+	config.SpendingStrategy = ""
+	planned, err := service.RunAnalysisWithConfig(config)
+	require.NoError(t, err)
+	assert.Less(t, planned.Spending.Volatility, 0.001)
+	assert.Equal(t, 0.0, planned.Spending.LargestCut)
+
+	config.SpendingStrategy = "percentage"
+	assert.Error(t, validateCashFlowConfig(config))
+}
+
+func TestCompareSpendingStrategies(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	mc := DefaultCashFlowMonteCarloConfig()
+	mc.Iterations = 200
+	mc.Seed = 7
+	outcomes, err := service.CompareSpendingStrategies(context.Background(), config, mc)
+	require.NoError(t, err)
+	require.Len(t, outcomes, 4)
+
+	planned := outcomes[0]
+	assert.Equal(t, SpendingPlanned, planned.Strategy)
+	for _, outcome := range outcomes[1:] {
+		// Spending follows the markets
+		assert.Greater(t, outcome.Spending.Volatility, planned.Spending.Volatility, outcome.Strategy)
+		assert.Positive(t, outcome.Spending.LargestCut, outcome.Strategy)
+	}
+
+	// Fixed-percentage and VPW withdrawals never empty the portfolio
+	assert.GreaterOrEqual(t, outcomes[1].SuccessProbability, planned.SuccessProbability)
+	assert.GreaterOrEqual(t, outcomes[3].SuccessProbability, planned.SuccessProbability)
+}
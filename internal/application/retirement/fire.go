@@ -0,0 +1,395 @@
+package retirement
+
+import (
+	"errors"
+	"fmt"
+)
+
+// =============================================================================
+// FIRE Calculator
+// =============================================================================
+
+// Financial independence figures are found by searching the cash flow
+// projection rather than by the 4% rule: a plan is feasible when the
+// portfolio lasts through every retired year.
+//
+//   - The earliest retirement age is the first age the plan is feasible
+//     retiring at, saving as configured until then.
+//   - The Coast FIRE number is the smallest portfolio that, with no further
+//     contributions, is feasible retiring at the target age. Balances are
+//     scaled in their current mix, all taxable when there are none.
+//   - The required savings rate is the smallest share of employment income
+//     saved, split like the configured contribution rates (all taxable when
+//     there are none), that is feasible retiring at the target age.
+//
+// Lean and fat FIRE scale retirement spending by the FIRE calculator's
+// factors; barista FIRE adds part-time work in retirement. Each figure is
+// broken down by shifts in the return assumptions: ExpectedReturn, and
+// every asset class's return with an allocation.
+
+// FIREVariant is a flavor of financial independence
+type FIREVariant string
+
+const (
+	FIRERegular FIREVariant = "regular"
+	FIRELean    FIREVariant = "lean"
+	FIREFat     FIREVariant = "fat"
+	FIREBarista FIREVariant = "barista"
+)
+
+const (
+	// leanFIRESpending and fatFIRESpending scale retirement spending
+	leanFIRESpending = 0.7
+	fatFIRESpending  = 1.5
+
+	// fireBalanceTolerance and fireRateTolerance are how closely the Coast
+	// FIRE number and required savings rate are searched for
+	fireBalanceTolerance = 100.0
+	fireRateTolerance    = 0.0005
+
+	// maxCoastFIRENumber is the largest Coast FIRE number searched for
+	maxCoastFIRENumber = 1e10
+)
+
+// DefaultFIREReturnShifts are the return assumption shifts the sensitivity
+// breakdown tries by default
+var DefaultFIREReturnShifts = []float64{-0.02, -0.01, 0.01, 0.02}
+
+// FIREOptions choose the variant and target of a FIRE analysis
+type FIREOptions struct {
+	Variant FIREVariant
+
+	// PartTimeIncome and PartTimeEndAge are barista FIRE's part-time work
+	// in retirement, as in CashFlowConfig
+	PartTimeIncome float64
+	PartTimeEndAge int
+
+	// TargetAge is the retirement age the Coast FIRE number and required
+	// savings rate aim for, the config's RetirementAge when 0
+	TargetAge int
+
+	// ReturnShifts are added to the return assumptions for the sensitivity
+	// breakdown, DefaultFIREReturnShifts when empty
+	ReturnShifts []float64
+}
+
+// FIREFigures are a plan's financial independence figures; each is -1
+// when out of reach
+type FIREFigures struct {
+	EarliestRetirementAge int
+	// PortfolioAtFIRE is the portfolio at the earliest retirement age
+	PortfolioAtFIRE float64
+
+	CoastFIRENumber     float64
+	RequiredSavingsRate float64
+}
+
+// FIRESensitivity is the figures with the return assumptions shifted
+type FIRESensitivity struct {
+	ReturnShift float64
+	FIREFigures
+}
+
+// FIREAnalysis is a plan's financial independence figures and their
+// sensitivity to the return assumptions
+type FIREAnalysis struct {
+	Variant   FIREVariant
+	TargetAge int
+
+	CurrentPortfolio   float64
+	CurrentSavingsRate float64
+
+	FIREFigures
+	// CoastFIREReached is whether the current portfolio is at least the
+	// Coast FIRE number
+	CoastFIREReached bool
+
+	Sensitivity []FIRESensitivity
+}
+
+// AnalyzeFIRE finds config's financial independence figures
+func (s *CashFlowService) AnalyzeFIRE(config CashFlowConfig, options FIREOptions) (*FIREAnalysis, error) {
+	if err := validateCashFlowConfig(config); err != nil {
+		return nil, err
+	}
+	if err := options.validate(config); err != nil {
+		return nil, err
+	}
+	config = options.apply(config)
+	if options.TargetAge == 0 {
+		options.TargetAge = config.RetirementAge
+	}
+	shifts := options.ReturnShifts
+	if len(shifts) == 0 {
+		shifts = DefaultFIREReturnShifts
+	}
+
+	analysis := &FIREAnalysis{
+		Variant:            options.Variant,
+		TargetAge:          options.TargetAge,
+		CurrentPortfolio:   config.householdPortfolio(),
+		CurrentSavingsRate: config.savingsRate(),
+	}
+	if analysis.Variant == "" {
+		analysis.Variant = FIRERegular
+	}
+
+	figures, err := s.fireFigures(config, options.TargetAge)
+	if err != nil {
+		return nil, err
+	}
+	analysis.FIREFigures = figures
+	analysis.CoastFIREReached = figures.CoastFIRENumber >= 0 && analysis.CurrentPortfolio >= figures.CoastFIRENumber
+
+	for _, shift := range shifts {
+		figures, err := s.fireFigures(config.withReturnShift(shift), options.TargetAge)
+		if err != nil {
+			return nil, err
+		}
+		analysis.Sensitivity = append(analysis.Sensitivity, FIRESensitivity{ReturnShift: shift, FIREFigures: figures})
+	}
+	return analysis, nil
+}
+
+// validate checks the options against the plan
+func (o FIREOptions) validate(config CashFlowConfig) error {
+	switch o.Variant {
+	case "", FIRERegular, FIRELean, FIREFat:
+	case FIREBarista:
+		if o.PartTimeIncome <= 0 {
+			return errors.New("barista FIRE needs PartTimeIncome")
+		}
+		if o.PartTimeEndAge < 0 {
+			return errors.New("PartTimeEndAge cannot be negative")
+		}
+	default:
+		return fmt.Errorf("unknown FIRE variant %q", o.Variant)
+	}
+	if o.TargetAge != 0 && (o.TargetAge < config.CurrentAge || o.TargetAge >= config.LifeExpectancy) {
+		return errors.New("FIRE TargetAge must be between CurrentAge and LifeExpectancy")
+	}
+	for _, shift := range o.ReturnShifts {
+		if shift <= -1 || shift >= 1 {
+			return errors.New("FIRE ReturnShifts must be between -1 and 1")
+		}
+	}
+	return nil
+}
+
+// apply adjusts config for the variant
+func (o FIREOptions) apply(config CashFlowConfig) CashFlowConfig {
+	switch o.Variant {
+	case FIRELean:
+		config.RetirementSpendingShape = scaledSpendingShape(config.RetirementSpendingShape, leanFIRESpending)
+	case FIREFat:
+		config.RetirementSpendingShape = scaledSpendingShape(config.RetirementSpendingShape, fatFIRESpending)
+	case FIREBarista:
+		config.PartTimeIncome = o.PartTimeIncome
+		config.PartTimeEndAge = o.PartTimeEndAge
+	}
+	return config
+}
+
+// scaledSpendingShape scales every year of a retirement spending shape
+func scaledSpendingShape(shape []float64, factor float64) []float64 {
+	if len(shape) == 0 {
+		return []float64{factor}
+	}
+	scaled := make([]float64, len(shape))
+	for i, multiplier := range shape {
+		scaled[i] = multiplier * factor
+	}
+	return scaled
+}
+
+// fireFigures searches for config's figures, aiming to retire at targetAge
+func (s *CashFlowService) fireFigures(config CashFlowConfig, targetAge int) (FIREFigures, error) {
+	figures := FIREFigures{EarliestRetirementAge: -1, CoastFIRENumber: -1, RequiredSavingsRate: -1}
+
+	for age := config.CurrentAge; age < config.LifeExpectancy; age++ {
+		retiring := config
+		retiring.RetirementAge = age
+		results, feasible, err := s.fireFeasible(retiring)
+		if err != nil {
+			return figures, err
+		}
+		if feasible {
+			figures.EarliestRetirementAge = age
+			if year := age - config.CurrentAge; year > 0 {
+				figures.PortfolioAtFIRE = results.YearlyFlows[year-1].TotalPortfolio
+			} else {
+				figures.PortfolioAtFIRE = config.householdPortfolio()
+			}
+			break
+		}
+	}
+
+	target := config
+	target.RetirementAge = targetAge
+
+	coast, err := s.coastFIRENumber(target)
+	if err != nil {
+		return figures, err
+	}
+	figures.CoastFIRENumber = coast
+
+	rate, err := s.requiredSavingsRate(target)
+	if err != nil {
+		return figures, err
+	}
+	figures.RequiredSavingsRate = rate
+	return figures, nil
+}
+
+// coastFIRENumber searches for the smallest portfolio that is feasible
+// without further contributions, -1 if none up to maxCoastFIRENumber is
+func (s *CashFlowService) coastFIRENumber(config CashFlowConfig) (float64, error) {
+	config = config.withSavingsRate(0)
+	config.FixedTaxableContribution = 0
+	config.FixedTraditionalContribution = 0
+	config.FixedRothContribution = 0
+	config.FixedHSAContribution = 0
+
+	feasibleWith := func(portfolio float64) (bool, error) {
+		_, feasible, err := s.fireFeasible(config.withPortfolio(portfolio))
+		return feasible, err
+	}
+
+	lo, hi := 0.0, max(config.householdPortfolio(), 100000)
+	for {
+		feasible, err := feasibleWith(hi)
+		if err != nil {
+			return 0, err
+		}
+		if feasible {
+			break
+		}
+		if hi >= maxCoastFIRENumber {
+			return -1, nil
+		}
+		lo, hi = hi, hi*2
+	}
+	if lo == 0 {
+		if feasible, err := feasibleWith(0); err != nil || feasible {
+			return 0, err
+		}
+	}
+	for hi-lo > fireBalanceTolerance {
+		mid := (lo + hi) / 2
+		feasible, err := feasibleWith(mid)
+		if err != nil {
+			return 0, err
+		}
+		if feasible {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi, nil
+}
+
+// requiredSavingsRate searches for the smallest savings rate that is
+// feasible, -1 if saving all employment income isn't
+func (s *CashFlowService) requiredSavingsRate(config CashFlowConfig) (float64, error) {
+	feasibleAt := func(rate float64) (bool, error) {
+		_, feasible, err := s.fireFeasible(config.withSavingsRate(rate))
+		return feasible, err
+	}
+
+	if feasible, err := feasibleAt(0); err != nil || feasible {
+		return 0, err
+	}
+	if config.EmploymentIncome <= 0 {
+		return -1, nil
+	}
+	if feasible, err := feasibleAt(1); err != nil || !feasible {
+		return -1, err
+	}
+	lo, hi := 0.0, 1.0
+	for hi-lo > fireRateTolerance {
+		mid := (lo + hi) / 2
+		feasible, err := feasibleAt(mid)
+		if err != nil {
+			return 0, err
+		}
+		if feasible {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi, nil
+}
+
+// fireFeasible projects config and reports whether the portfolio lasts
+// through retirement
+func (s *CashFlowService) fireFeasible(config CashFlowConfig) (*CashFlowResults, bool, error) {
+	results, err := s.RunAnalysisWithConfig(config)
+	if err != nil {
+		return nil, false, err
+	}
+	return results, strategyOutcome(results).DepletionAge == 0, nil
+}
+
+// householdPortfolio is the household's current invested balances
+func (c CashFlowConfig) householdPortfolio() float64 {
+	taxable, traditional, roth, hsa := c.householdBalances()
+	return taxable + traditional + roth + hsa
+}
+
+// withPortfolio scales the household's balances to total portfolio
+func (c CashFlowConfig) withPortfolio(portfolio float64) CashFlowConfig {
+	current := c.householdPortfolio()
+	if current <= 0 {
+		c.TaxableBalance = portfolio
+		return c
+	}
+	factor := portfolio / current
+	c.TaxableBalance *= factor
+	c.TraditionalBalance *= factor
+	c.RothBalance *= factor
+	c.HSABalance *= factor
+	if c.Spouse != nil {
+		spouse := *c.Spouse
+		spouse.TaxableBalance *= factor
+		spouse.TraditionalBalance *= factor
+		spouse.RothBalance *= factor
+		spouse.HSABalance *= factor
+		c.Spouse = &spouse
+	}
+	return c
+}
+
+// savingsRate is the share of employment income saved
+func (c CashFlowConfig) savingsRate() float64 {
+	return c.TaxableContributionRate + c.TraditionalContributionRate + c.RothContributionRate + c.HSAContributionRate
+}
+
+// withSavingsRate scales the contribution rates to save rate in total
+func (c CashFlowConfig) withSavingsRate(rate float64) CashFlowConfig {
+	current := c.savingsRate()
+	if current <= 0 {
+		c.TaxableContributionRate = rate
+		return c
+	}
+	factor := rate / current
+	c.TaxableContributionRate *= factor
+	c.TraditionalContributionRate *= factor
+	c.RothContributionRate *= factor
+	c.HSAContributionRate *= factor
+	return c
+}
+
+// withReturnShift adds shift to the return assumptions
+func (c CashFlowConfig) withReturnShift(shift float64) CashFlowConfig {
+	c.ExpectedReturn += shift
+	if c.Allocation != nil {
+		allocation := *c.Allocation
+		allocation.Assumptions.StockReturn += shift
+		allocation.Assumptions.BondReturn += shift
+		allocation.Assumptions.CashReturn += shift
+		c.Allocation = &allocation
+	}
+	return c
+}
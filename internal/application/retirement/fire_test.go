@@ -0,0 +1,116 @@
+package retirement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeFIRE(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	analysis, err := service.AnalyzeFIRE(config, FIREOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, FIRERegular, analysis.Variant)
+	assert.Equal(t, config.RetirementAge, analysis.TargetAge)
+	assert.InDelta(t, 0.28, analysis.CurrentSavingsRate, 1e-12)
+
+	// Retiring at the earliest age is feasible, a year earlier isn't
+	earliest := analysis.EarliestRetirementAge
+	require.Greater(t, earliest, config.CurrentAge)
+	retiring := config
+	retiring.RetirementAge = earliest
+	_, feasible, err := service.fireFeasible(retiring)
+	require.NoError(t, err)
+	assert.True(t, feasible)
+	retiring.RetirementAge = earliest - 1
+	_, feasible, err = service.fireFeasible(retiring)
+	require.NoError(t, err)
+	assert.False(t, feasible)
+	assert.Positive(t, analysis.PortfolioAtFIRE)
+
+	// The Coast FIRE number funds retirement without saving another dollar
+	require.Positive(t, analysis.CoastFIRENumber)
+	coasting := config.withSavingsRate(0).withPortfolio(analysis.CoastFIRENumber)
+	_, feasible, err = service.fireFeasible(coasting)
+	require.NoError(t, err)
+	assert.True(t, feasible)
+	_, feasible, err = service.fireFeasible(config.withSavingsRate(0).withPortfolio(analysis.CoastFIRENumber - 2*fireBalanceTolerance))
+	require.NoError(t, err)
+	assert.False(t, feasible)
+	assert.Equal(t, analysis.CurrentPortfolio >= analysis.CoastFIRENumber, analysis.CoastFIREReached)
+
+	// The required savings rate is at most what is saved when the target
+	// age is feasible
+	require.GreaterOrEqual(t, analysis.RequiredSavingsRate, 0.0)
+	if earliest <= config.RetirementAge {
+		assert.LessOrEqual(t, analysis.RequiredSavingsRate, analysis.CurrentSavingsRate+fireRateTolerance)
+	}
+
+	// Lower returns push everything out, higher returns pull it in
+	require.Len(t, analysis.Sensitivity, len(DefaultFIREReturnShifts))
+	lower, higher := analysis.Sensitivity[0], analysis.Sensitivity[3]
+	assert.Equal(t, -0.02, lower.ReturnShift)
+	assert.GreaterOrEqual(t, lower.EarliestRetirementAge, earliest)
+	assert.LessOrEqual(t, higher.EarliestRetirementAge, earliest)
+	assert.Greater(t, lower.CoastFIRENumber, analysis.CoastFIRENumber)
+	assert.Less(t, higher.CoastFIRENumber, analysis.CoastFIRENumber)
+}
+
+func TestAnalyzeFIREVariants(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+	options := FIREOptions{ReturnShifts: []float64{0.01}}
+
+	figures := map[FIREVariant]FIREFigures{}
+	for _, variant := range []FIREVariant{FIRERegular, FIRELean, FIREFat} {
+		options.Variant = variant
+		analysis, err := service.AnalyzeFIRE(config, options)
+		require.NoError(t, err, variant)
+		figures[variant] = analysis.FIREFigures
+	}
+	assert.LessOrEqual(t, figures[FIRELean].EarliestRetirementAge, figures[FIRERegular].EarliestRetirementAge)
+	assert.GreaterOrEqual(t, figures[FIREFat].EarliestRetirementAge, figures[FIRERegular].EarliestRetirementAge)
+	assert.Less(t, figures[FIRELean].CoastFIRENumber, figures[FIRERegular].CoastFIRENumber)
+	assert.Greater(t, figures[FIREFat].CoastFIRENumber, figures[FIRERegular].CoastFIRENumber)
+
+	// Part-time work in retirement lowers the Coast FIRE number
+	options.Variant = FIREBarista
+	options.PartTimeIncome = 25000
+	options.PartTimeEndAge = 67
+	barista, err := service.AnalyzeFIRE(config, options)
+	require.NoError(t, err)
+	assert.Less(t, barista.CoastFIRENumber, figures[FIRERegular].CoastFIRENumber)
+
+	options.PartTimeIncome = 0
+	_, err = service.AnalyzeFIRE(config, options)
+	assert.Error(t, err)
+	_, err = service.AnalyzeFIRE(config, FIREOptions{Variant: "chubby"})
+	assert.Error(t, err)
+	_, err = service.AnalyzeFIRE(config, FIREOptions{TargetAge: config.LifeExpectancy})
+	assert.Error(t, err)
+}
+
+func TestCashFlowPartTimeIncome(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	config.PartTimeIncome = 20000
+	config.PartTimeEndAge = config.RetirementAge + 2
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+	results, err := service.RunAnalysis()
+	require.NoError(t, err)
+
+	retired := config.RetirementAge - config.CurrentAge
+	assert.Equal(t, 0.0, results.YearlyFlows[retired-1].SelfEmploymentIncome)
+	assert.Positive(t, results.YearlyFlows[retired].SelfEmploymentIncome)
+	assert.Positive(t, results.YearlyFlows[retired+1].SelfEmploymentIncome)
+	assert.Equal(t, 0.0, results.YearlyFlows[retired+2].SelfEmploymentIncome)
+	assert.Equal(t, 0.0, results.YearlyFlows[retired].TotalSavings)
+
+	config.PartTimeIncome = -1
+	assert.Error(t, validateCashFlowConfig(config))
+}
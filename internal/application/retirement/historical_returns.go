@@ -0,0 +1,121 @@
+package retirement
+
+// HistoricalYear holds one calendar year of US market history
+type HistoricalYear struct {
+	Year int
+
+	// S&P 500 total return, dividends reinvested
+	StockReturn float64
+
+	// CPI-U inflation, December to December
+	Inflation float64
+}
+
+// historicalYears is the bundled market history simulations draw from,
+// oldest year first
+var historicalYears = []HistoricalYear{
+	{1928, 0.4381, -0.010},
+	{1929, -0.0830, 0.002},
+	{1930, -0.2512, -0.060},
+	{1931, -0.4384, -0.095},
+	{1932, -0.0864, -0.103},
+	{1933, 0.4998, 0.008},
+	{1934, -0.0119, 0.015},
+	{1935, 0.4674, 0.030},
+	{1936, 0.3194, 0.014},
+	{1937, -0.3534, 0.029},
+	{1938, 0.2928, -0.028},
+	{1939, -0.0110, 0.000},
+	{1940, -0.1067, 0.007},
+	{1941, -0.1277, 0.099},
+	{1942, 0.1917, 0.090},
+	{1943, 0.2506, 0.030},
+	{1944, 0.1903, 0.023},
+	{1945, 0.3582, 0.022},
+	{1946, -0.0843, 0.181},
+	{1947, 0.0520, 0.088},
+	{1948, 0.0570, 0.030},
+	{1949, 0.1830, -0.021},
+	{1950, 0.3081, 0.059},
+	{1951, 0.2368, 0.060},
+	{1952, 0.1815, 0.008},
+	{1953, -0.0121, 0.007},
+	{1954, 0.5256, -0.007},
+	{1955, 0.3260, 0.004},
+	{1956, 0.0744, 0.030},
+	{1957, -0.1046, 0.029},
+	{1958, 0.4372, 0.018},
+	{1959, 0.1206, 0.017},
+	{1960, 0.0034, 0.014},
+	{1961, 0.2664, 0.007},
+	{1962, -0.0881, 0.013},
+	{1963, 0.2261, 0.016},
+	{1964, 0.1642, 0.010},
+	{1965, 0.1240, 0.019},
+	{1966, -0.0997, 0.035},
+	{1967, 0.2380, 0.030},
+	{1968, 0.1081, 0.047},
+	{1969, -0.0824, 0.062},
+	{1970, 0.0356, 0.056},
+	{1971, 0.1422, 0.033},
+	{1972, 0.1876, 0.034},
+	{1973, -0.1431, 0.087},
+	{1974, -0.2590, 0.123},
+	{1975, 0.3700, 0.069},
+	{1976, 0.2383, 0.049},
+	{1977, -0.0698, 0.067},
+	{1978, 0.0651, 0.090},
+	{1979, 0.1852, 0.133},
+	{1980, 0.3174, 0.125},
+	{1981, -0.0470, 0.089},
+	{1982, 0.2042, 0.038},
+	{1983, 0.2234, 0.038},
+	{1984, 0.0615, 0.039},
+	{1985, 0.3124, 0.038},
+	{1986, 0.1849, 0.011},
+	{1987, 0.0581, 0.044},
+	{1988, 0.1654, 0.044},
+	{1989, 0.3148, 0.046},
+	{1990, -0.0306, 0.061},
+	{1991, 0.3023, 0.031},
+	{1992, 0.0749, 0.029},
+	{1993, 0.0997, 0.027},
+	{1994, 0.0133, 0.027},
+	{1995, 0.3720, 0.025},
+	{1996, 0.2268, 0.033},
+	{1997, 0.3310, 0.017},
+	{1998, 0.2834, 0.016},
+	{1999, 0.2089, 0.027},
+	{2000, -0.0903, 0.034},
+	{2001, -0.1185, 0.016},
+	{2002, -0.2197, 0.024},
+	{2003, 0.2836, 0.019},
+	{2004, 0.1074, 0.033},
+	{2005, 0.0483, 0.034},
+	{2006, 0.1561, 0.025},
+	{2007, 0.0548, 0.041},
+	{2008, -0.3655, 0.001},
+	{2009, 0.2594, 0.027},
+	{2010, 0.1482, 0.015},
+	{2011, 0.0210, 0.030},
+	{2012, 0.1589, 0.017},
+	{2013, 0.3215, 0.015},
+	{2014, 0.1352, 0.008},
+	{2015, 0.0138, 0.007},
+	{2016, 0.1177, 0.021},
+	{2017, 0.2161, 0.021},
+	{2018, -0.0423, 0.019},
+	{2019, 0.3121, 0.023},
+	{2020, 0.1802, 0.014},
+	{2021, 0.2847, 0.070},
+	{2022, -0.1804, 0.065},
+	{2023, 0.2606, 0.034},
+	{2024, 0.2488, 0.029},
+}
+
+// HistoricalYears returns the bundled market history, oldest year first
+func HistoricalYears() []HistoricalYear {
+	years := make([]HistoricalYear, len(historicalYears))
+	copy(years, historicalYears)
+	return years
+}
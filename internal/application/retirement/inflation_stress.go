@@ -0,0 +1,293 @@
+package retirement
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// =============================================================================
+// Inflation Stress Testing
+// =============================================================================
+
+// A stress test projects the plan under inflation paths other than its
+// steady inflation rate. A path starts at an age, by default the retirement
+// age, and runs for as many years as it has rates; the plan's rate resumes
+// after it unless it is sustained to the end. Markets return as expected
+// throughout, so the paths show what rising prices alone do to the plan.
+// Healthcare keeps its own growth rate.
+//
+// Under each path the plan breaks at the first retired year spending can't
+// be met. The expenses that break it are those that cost the most more than
+// they do at the plan's inflation rate up to that age, or over the whole
+// analysis when the plan holds.
+
+// MaxInflationPaths is the most inflation paths a stress test runs
+const MaxInflationPaths = 10
+
+// InflationPath is a named sequence of yearly inflation rates
+type InflationPath struct {
+	Name        string
+	Description string
+
+	Rates []float64
+
+	// Sustained repeats the last rate to the end of the analysis
+	Sustained bool
+}
+
+// DefaultInflationPaths are the paths a stress test runs by default:
+// sustained 5% inflation, the 1973-1982 inflation of the 1970s, and a
+// decade of 1% deflation
+func DefaultInflationPaths() []InflationPath {
+	return []InflationPath{
+		{
+			Name:        "sustained_5",
+			Description: "Prices rise 5% a year for the rest of the plan",
+			Rates:       []float64{0.05},
+			Sustained:   true,
+		},
+		{
+			Name:        "1970s_spike",
+			Description: "Inflation follows 1973 to 1982, peaking above 13%",
+			Rates:       historicalInflation(1973, 1982),
+		},
+		{
+			Name:        "deflation",
+			Description: "Prices fall 1% a year for a decade",
+			Rates:       []float64{-0.01, -0.01, -0.01, -0.01, -0.01, -0.01, -0.01, -0.01, -0.01, -0.01},
+		},
+	}
+}
+
+// InflationStressOptions choose the paths a stress test runs and when
+type InflationStressOptions struct {
+	// Paths default to DefaultInflationPaths
+	Paths []InflationPath
+
+	// StartAge is the age paths start at, the retirement age when 0
+	StartAge int
+}
+
+// ExpensePressure is how much more an expense category costs under an
+// inflation path than at the plan's inflation rate. Categories are named as
+// in the Sankey diagrams.
+type ExpensePressure struct {
+	Category  string
+	ExtraCost float64
+}
+
+// InflationStressResult is the plan's outcome under one inflation path
+type InflationStressResult struct {
+	Path        string
+	Description string
+
+	// AverageInflation is the compound average over the analysis
+	AverageInflation float64
+
+	// Success is whether every retired year's spending was met; if not,
+	// DepletionAge is the first age it wasn't
+	Success      bool
+	DepletionAge int
+
+	// FinalPortfolio is in nominal dollars, RealFinalPortfolio in the first
+	// year's
+	FinalPortfolio     float64
+	RealFinalPortfolio float64
+	TotalExpenses      float64
+
+	// BreakingExpenses are the categories costing more than at the plan's
+	// inflation rate, most first
+	BreakingExpenses []ExpensePressure
+}
+
+// InflationStressAnalysis compares the plan under each inflation path with
+// the plan at its own inflation rate
+type InflationStressAnalysis struct {
+	StartAge int
+
+	Baseline InflationStressResult
+	Paths    []InflationStressResult
+
+	// FirstToBreak is the path the plan breaks under earliest, empty when
+	// it holds under every path
+	FirstToBreak string
+}
+
+// StressTestInflation projects the plan under each inflation path
+func (s *CashFlowService) StressTestInflation(config CashFlowConfig, options InflationStressOptions) (*InflationStressAnalysis, error) {
+	if err := validateCashFlowConfig(config); err != nil {
+		return nil, err
+	}
+	paths := options.Paths
+	if len(paths) == 0 {
+		paths = DefaultInflationPaths()
+	}
+	if err := validateInflationPaths(paths); err != nil {
+		return nil, err
+	}
+	startAge := options.StartAge
+	if startAge == 0 {
+		startAge = max(config.RetirementAge, config.CurrentAge)
+	}
+	if startAge < config.CurrentAge || startAge >= config.LifeExpectancy {
+		return nil, errors.New("inflation paths must start between the current age and life expectancy")
+	}
+
+	totalYears := config.LifeExpectancy - config.CurrentAge
+	returns, assets := config.expectedReturns()
+	steady := make([]float64, totalYears)
+	for year := range steady {
+		steady[year] = config.InflationRate
+	}
+	baseFlows := s.projectYears(config, returns, assets, steady)
+
+	analysis := &InflationStressAnalysis{
+		StartAge: startAge,
+		Baseline: inflationStressResult(InflationPath{
+			Name:        "baseline",
+			Description: "The plan's own inflation rate",
+		}, baseFlows, baseFlows, steady),
+	}
+	earliest := 0
+	for _, path := range paths {
+		inflation := path.yearlyRates(config, startAge)
+		flows := s.projectYears(config, returns, assets, inflation)
+		result := inflationStressResult(path, flows, baseFlows, inflation)
+		analysis.Paths = append(analysis.Paths, result)
+
+		if !result.Success && (earliest == 0 || result.DepletionAge < earliest) {
+			earliest = result.DepletionAge
+			analysis.FirstToBreak = result.Path
+		}
+	}
+	return analysis, nil
+}
+
+// yearlyRates is the inflation of each year of the analysis under the path
+func (p InflationPath) yearlyRates(config CashFlowConfig, startAge int) []float64 {
+	totalYears := config.LifeExpectancy - config.CurrentAge
+	rates := make([]float64, totalYears)
+	for year := range rates {
+		rates[year] = config.InflationRate
+		i := config.CurrentAge + year - startAge
+		switch {
+		case i < 0:
+		case i < len(p.Rates):
+			rates[year] = p.Rates[i]
+		case p.Sustained:
+			rates[year] = p.Rates[len(p.Rates)-1]
+		}
+	}
+	return rates
+}
+
+// inflationStressResult summarizes flows projected under a path against
+// the baseline's
+func inflationStressResult(path InflationPath, flows, baseFlows []YearCashFlow, inflation []float64) InflationStressResult {
+	result := InflationStressResult{
+		Path:        path.Name,
+		Description: path.Description,
+		Success:     true,
+	}
+
+	deflator := 1.0
+	last := len(flows) - 1
+	for year, flow := range flows {
+		deflator *= 1 + inflation[year]
+		result.TotalExpenses += flow.TotalExpenses
+		if result.Success && flow.IsRetired && flow.NetCashFlow < -shortfallTolerance {
+			result.Success = false
+			result.DepletionAge = flow.Age
+			last = year
+		}
+	}
+	result.AverageInflation = compoundAverage(deflator, len(flows))
+	result.FinalPortfolio = flows[len(flows)-1].TotalPortfolio
+	result.RealFinalPortfolio = result.FinalPortfolio / deflator
+
+	extra := make(map[string]float64)
+	for year := 0; year <= last; year++ {
+		stressed, base := expenseCategories(flows[year]), expenseCategories(baseFlows[year])
+		for category, cost := range stressed {
+			extra[category] += cost - base[category]
+		}
+	}
+	for category, cost := range extra {
+		// Rounding leaves cents between categories inflation doesn't move
+		if cost >= 1 {
+			result.BreakingExpenses = append(result.BreakingExpenses, ExpensePressure{Category: category, ExtraCost: cost})
+		}
+	}
+	sort.Slice(result.BreakingExpenses, func(i, j int) bool {
+		a, b := result.BreakingExpenses[i], result.BreakingExpenses[j]
+		if a.ExtraCost != b.ExtraCost {
+			return a.ExtraCost > b.ExtraCost
+		}
+		return a.Category < b.Category
+	})
+	return result
+}
+
+// expenseCategories is a year's expenses by Sankey category
+func expenseCategories(flow YearCashFlow) map[string]float64 {
+	return map[string]float64{
+		"housing":        flow.HousingExpense,
+		"healthcare":     flow.HealthcareExpense,
+		"food":           flow.FoodExpense,
+		"transportation": flow.TransportationExpense,
+		"utilities":      flow.UtilitiesExpense,
+		"insurance":      flow.InsuranceExpense,
+		"discretionary":  flow.DiscretionaryExpense,
+		"other_expenses": flow.OtherExpenses,
+		"debt_payments":  flow.DebtPayments,
+	}
+}
+
+// compoundAverage is the yearly rate that compounds to growth over years
+func compoundAverage(growth float64, years int) float64 {
+	if years == 0 || growth <= 0 {
+		return 0
+	}
+	return math.Pow(growth, 1/float64(years)) - 1
+}
+
+// historicalInflation is the bundled history's inflation from one calendar
+// year through another
+func historicalInflation(from, to int) []float64 {
+	var rates []float64
+	for _, h := range historicalYears {
+		if h.Year >= from && h.Year <= to {
+			rates = append(rates, h.Inflation)
+		}
+	}
+	return rates
+}
+
+// validateInflationPaths checks paths are named uniquely and have plausible
+// rates
+func validateInflationPaths(paths []InflationPath) error {
+	if len(paths) > MaxInflationPaths {
+		return fmt.Errorf("a stress test runs at most %d inflation paths", MaxInflationPaths)
+	}
+	names := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		if path.Name == "" {
+			return errors.New("inflation paths need a name")
+		}
+		if names[path.Name] {
+			return fmt.Errorf("inflation path %q is named twice", path.Name)
+		}
+		names[path.Name] = true
+		if len(path.Rates) == 0 {
+			return fmt.Errorf("inflation path %q has no rates", path.Name)
+		}
+		for _, rate := range path.Rates {
+			if rate <= -0.5 || rate > 1 {
+				return fmt.Errorf("inflation path %q rates must be between -50%% and 100%%", path.Name)
+			}
+		}
+	}
+	return nil
+}
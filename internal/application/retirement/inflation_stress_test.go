@@ -0,0 +1,86 @@
+package retirement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStressTestInflation(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	analysis, err := service.StressTestInflation(config, InflationStressOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, config.RetirementAge, analysis.StartAge)
+	assert.InDelta(t, config.InflationRate, analysis.Baseline.AverageInflation, 1e-9)
+	assert.Empty(t, analysis.Baseline.BreakingExpenses)
+	require.Len(t, analysis.Paths, len(DefaultInflationPaths()))
+
+	byName := make(map[string]InflationStressResult)
+	for _, result := range analysis.Paths {
+		byName[result.Path] = result
+	}
+
+	// Higher inflation costs more and leaves less; deflation the reverse
+	sustained := byName["sustained_5"]
+	assert.Greater(t, sustained.AverageInflation, config.InflationRate)
+	assert.Greater(t, sustained.TotalExpenses, analysis.Baseline.TotalExpenses)
+	assert.Less(t, sustained.RealFinalPortfolio, analysis.Baseline.RealFinalPortfolio)
+	require.NotEmpty(t, sustained.BreakingExpenses)
+	for i := 1; i < len(sustained.BreakingExpenses); i++ {
+		assert.GreaterOrEqual(t, sustained.BreakingExpenses[i-1].ExtraCost, sustained.BreakingExpenses[i].ExtraCost)
+	}
+
+	deflation := byName["deflation"]
+	assert.Less(t, deflation.TotalExpenses, analysis.Baseline.TotalExpenses)
+	for _, pressure := range deflation.BreakingExpenses {
+		assert.NotEqual(t, "food", pressure.Category)
+	}
+
+	spike := byName["1970s_spike"]
+	assert.Len(t, historicalInflation(1973, 1982), 10)
+	assert.Greater(t, spike.TotalExpenses, analysis.Baseline.TotalExpenses)
+}
+
+func TestStressTestInflationBreaksPlan(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	config.DiscretionaryExpense *= 3
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	analysis, err := service.StressTestInflation(config, InflationStressOptions{
+		Paths: []InflationPath{
+			{Name: "mild", Rates: []float64{config.InflationRate}, Sustained: true},
+			{Name: "severe", Rates: []float64{0.12}, Sustained: true},
+		},
+	})
+	require.NoError(t, err)
+	severe := analysis.Paths[1]
+	require.False(t, severe.Success)
+	assert.Equal(t, "severe", analysis.FirstToBreak)
+	assert.Equal(t, "discretionary", severe.BreakingExpenses[0].Category)
+	if mild := analysis.Paths[0]; !mild.Success {
+		assert.Greater(t, mild.DepletionAge, severe.DepletionAge)
+	}
+}
+
+func TestStressTestInflationErrors(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	for name, options := range map[string]InflationStressOptions{
+		"unnamed":   {Paths: []InflationPath{{Rates: []float64{0.03}}}},
+		"no rates":  {Paths: []InflationPath{{Name: "empty"}}},
+		"twice":     {Paths: []InflationPath{{Name: "a", Rates: []float64{0.03}}, {Name: "a", Rates: []float64{0.04}}}},
+		"too high":  {Paths: []InflationPath{{Name: "a", Rates: []float64{2}}}},
+		"too early": {StartAge: config.CurrentAge - 1},
+		"too late":  {StartAge: config.LifeExpectancy},
+	} {
+		_, err := service.StressTestInflation(config, options)
+		assert.Error(t, err, name)
+	}
+}
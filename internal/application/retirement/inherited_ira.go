@@ -0,0 +1,260 @@
+package retirement
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// =============================================================================
+// Inherited IRAs
+// =============================================================================
+
+// Under the SECURE Act's 10-year rule an inherited IRA must be emptied by
+// the end of the tenth year after the owner's death. Inherited accounts
+// are held apart from the household's own, either from the start of the
+// plan (InheritedIRAs) or received as a windfall, paying out from the
+// following year. Traditional distributions are ordinary income, forced
+// into the year's taxes whether or not the money is needed; Roth
+// distributions are tax-free. Both are reinvested in the taxable balance.
+// Inherited traditional IRAs grow with the traditional balance and are
+// spread over the years by InheritedIRAStrategy:
+//
+//   - even pays out equal shares of what is left each year.
+//   - deferred leaves it all to the last year.
+//   - fill_bracket pays out what fills taxable income known before the
+//     year's withdrawals up to the top of the InheritedIRATargetRate
+//     bracket.
+//
+// Whatever is left is paid out in the last year. Inherited Roth IRAs grow
+// with the Roth balance, tax-free, until the last year. Annual
+// distributions due when the owner had already started RMDs aren't
+// modeled.
+
+// inheritedIRAYears is how many years an inherited IRA must be emptied in
+const inheritedIRAYears = 10
+
+// InheritedIRAStrategy spreads an inherited traditional IRA over its years
+type InheritedIRAStrategy string
+
+const (
+	InheritedIRAEven        InheritedIRAStrategy = "even"
+	InheritedIRADeferred    InheritedIRAStrategy = "deferred"
+	InheritedIRAFillBracket InheritedIRAStrategy = "fill_bracket"
+)
+
+// DefaultInheritedIRATargetRate is the bracket fill_bracket fills by default
+const DefaultInheritedIRATargetRate = 0.22
+
+// InheritedIRA is an inherited account held at the start of the plan
+type InheritedIRA struct {
+	Name    string
+	Balance float64
+	Roth    bool
+
+	// YearsLeft is how many years of the ten are left, this one included;
+	// all ten when 0
+	YearsLeft int
+}
+
+// validateInheritedIRAs checks the accounts and distribution strategy
+func validateInheritedIRAs(config CashFlowConfig) error {
+	for _, ira := range config.InheritedIRAs {
+		if ira.Balance < 0 {
+			return errors.New("inherited IRA Balance cannot be negative")
+		}
+		if ira.YearsLeft < 0 || ira.YearsLeft > inheritedIRAYears {
+			return errors.New("inherited IRA YearsLeft must be between 0 and 10")
+		}
+	}
+	switch config.InheritedIRAStrategy {
+	case "", InheritedIRAEven, InheritedIRADeferred, InheritedIRAFillBracket:
+	default:
+		return fmt.Errorf("unknown inherited IRA strategy %q", config.InheritedIRAStrategy)
+	}
+	if config.InheritedIRATargetRate < 0 || config.InheritedIRATargetRate >= 1 {
+		return errors.New("InheritedIRATargetRate must be between 0 and 1")
+	}
+	return nil
+}
+
+// inheritedAccount tracks an inherited IRA through its distribution window
+type inheritedAccount struct {
+	balance   float64
+	roth      bool
+	yearsLeft int
+	// fromAge is the first person's age distributions start at
+	fromAge int
+}
+
+// inheritedAccounts tracks the inherited IRAs held so far
+type inheritedAccounts struct {
+	accounts []*inheritedAccount
+}
+
+// newInheritedAccounts starts with the inherited IRAs held at the start of
+// the plan
+func newInheritedAccounts(config CashFlowConfig) inheritedAccounts {
+	var a inheritedAccounts
+	for _, ira := range config.InheritedIRAs {
+		yearsLeft := ira.YearsLeft
+		if yearsLeft == 0 {
+			yearsLeft = inheritedIRAYears
+		}
+		a.accounts = append(a.accounts, &inheritedAccount{
+			balance:   ira.Balance,
+			roth:      ira.Roth,
+			yearsLeft: yearsLeft,
+			fromAge:   config.CurrentAge,
+		})
+	}
+	return a
+}
+
+// distribute pays out a plan year's inherited IRA distributions
+func (a *inheritedAccounts) distribute(config CashFlowConfig, flow *YearCashFlow, taxYear *TaxYearData) {
+	for _, account := range a.accounts {
+		if account.yearsLeft == 0 || flow.Age < account.fromAge {
+			continue
+		}
+		distribution := account.distribution(config, *flow, taxYear)
+		account.balance -= distribution
+		account.yearsLeft--
+		if account.roth {
+			flow.InheritedRothDistribution += distribution
+		} else {
+			flow.InheritedIRADistribution += distribution
+		}
+	}
+}
+
+// distribution is what an account pays out in a plan year, given the
+// year's income so far
+func (a *inheritedAccount) distribution(config CashFlowConfig, flow YearCashFlow, taxYear *TaxYearData) float64 {
+	if a.yearsLeft <= 1 {
+		return a.balance
+	}
+	if a.roth {
+		return 0
+	}
+	switch config.InheritedIRAStrategy {
+	case InheritedIRADeferred:
+		return 0
+	case InheritedIRAFillBracket:
+		rate := config.InheritedIRATargetRate
+		if rate == 0 {
+			rate = DefaultInheritedIRATargetRate
+		}
+		magi := modifiedAGI(flow, config, taxYear) + flow.RequiredMinimumDistribution
+		taxableIncome := magi + taxYear.TaxableSocialSecurity(flow.SocialSecurity, magi) - taxYear.StandardDeduction
+		room := bracketCeiling(taxYear.Brackets(), rate) - taxableIncome
+		return math.Min(a.balance, math.Max(0, room))
+	default:
+		return a.balance / float64(a.yearsLeft)
+	}
+}
+
+// bracketCeiling is the top of the highest bracket taxed at no more than
+// rate, 0 when even the lowest is taxed higher
+func bracketCeiling(brackets []TaxBracket, rate float64) float64 {
+	var ceiling float64
+	for _, bracket := range brackets {
+		if bracket.Rate > rate {
+			break
+		}
+		ceiling = bracket.MaxIncome
+	}
+	return ceiling
+}
+
+// grow grows the inherited IRAs by a year's returns and returns what is
+// left in the traditional and Roth accounts
+func (a *inheritedAccounts) grow(traditionalReturn, rothReturn float64) (traditional, roth float64) {
+	for _, account := range a.accounts {
+		if account.roth {
+			account.balance *= 1 + rothReturn
+			roth += account.balance
+		} else {
+			account.balance *= 1 + traditionalReturn
+			traditional += account.balance
+		}
+	}
+	return traditional, roth
+}
+
+// InheritedIRAOutcome is how the plan fares with one way of spreading the
+// inherited traditional IRAs
+type InheritedIRAOutcome struct {
+	Strategy InheritedIRAStrategy
+	// TargetRate is the bracket fill_bracket fills
+	TargetRate float64
+
+	// Distributions are the inherited traditional IRAs' total payouts and
+	// LargestDistribution the largest year's
+	Distributions       float64
+	LargestDistribution float64
+
+	Outcome StrategyOutcome
+}
+
+// InheritedIRAAnalysis compares ways of spreading the inherited
+// traditional IRAs
+type InheritedIRAAnalysis struct {
+	Outcomes []InheritedIRAOutcome
+	// Recommended is the index of the outcome leaving the largest final
+	// portfolio
+	Recommended int
+}
+
+// CompareInheritedIRAStrategies projects config with its inherited
+// traditional IRAs paid out evenly, deferred, and filling each bracket up
+// to 24%
+func (s *CashFlowService) CompareInheritedIRAStrategies(config CashFlowConfig) (*InheritedIRAAnalysis, error) {
+	if !config.hasInheritedTraditionalIRA() {
+		return nil, errors.New("analysis has no inherited traditional IRA")
+	}
+
+	candidates := []InheritedIRAOutcome{{Strategy: InheritedIRAEven}, {Strategy: InheritedIRADeferred}}
+	for _, bracket := range DefaultTaxData().Active().Brackets() {
+		if bracket.Rate <= 0.24 {
+			candidates = append(candidates, InheritedIRAOutcome{Strategy: InheritedIRAFillBracket, TargetRate: bracket.Rate})
+		}
+	}
+
+	analysis := &InheritedIRAAnalysis{}
+	for _, candidate := range candidates {
+		strategyConfig := config
+		strategyConfig.InheritedIRAStrategy = candidate.Strategy
+		strategyConfig.InheritedIRATargetRate = candidate.TargetRate
+		results, err := s.RunAnalysisWithConfig(strategyConfig)
+		if err != nil {
+			return nil, err
+		}
+		candidate.Outcome = strategyOutcome(results)
+		for _, flow := range results.YearlyFlows {
+			candidate.Distributions += flow.InheritedIRADistribution
+			candidate.LargestDistribution = math.Max(candidate.LargestDistribution, flow.InheritedIRADistribution)
+		}
+		analysis.Outcomes = append(analysis.Outcomes, candidate)
+		if candidate.Outcome.FinalPortfolio > analysis.Outcomes[analysis.Recommended].Outcome.FinalPortfolio {
+			analysis.Recommended = len(analysis.Outcomes) - 1
+		}
+	}
+	return analysis, nil
+}
+
+// hasInheritedTraditionalIRA reports whether config holds or inherits a
+// traditional IRA
+func (c CashFlowConfig) hasInheritedTraditionalIRA() bool {
+	for _, ira := range c.InheritedIRAs {
+		if !ira.Roth {
+			return true
+		}
+	}
+	for _, w := range c.Windfalls {
+		if w.Account == WindfallInheritedIRA {
+			return true
+		}
+	}
+	return false
+}
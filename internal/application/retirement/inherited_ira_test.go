@@ -0,0 +1,131 @@
+package retirement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBracketCeiling(t *testing.T) {
+	brackets := []TaxBracket{{0, 20000, 0.1}, {20000, 80000, 0.12}, {80000, 170000, 0.22}}
+	assert.Equal(t, 80000.0, bracketCeiling(brackets, 0.12))
+	assert.Equal(t, 80000.0, bracketCeiling(brackets, 0.15))
+	assert.Equal(t, 170000.0, bracketCeiling(brackets, 0.22))
+	assert.Equal(t, 0.0, bracketCeiling(brackets, 0.05))
+}
+
+func TestInheritedIRADistribution(t *testing.T) {
+	taxYear := DefaultTaxData().Active()
+	flow := YearCashFlow{Age: 60, Pension: 40000}
+	account := inheritedAccount{balance: 500000, yearsLeft: 5}
+
+	tests := []struct {
+		name     string
+		strategy InheritedIRAStrategy
+		rate     float64
+		want     float64
+	}{
+		{name: "even", strategy: InheritedIRAEven, want: 100000},
+		{name: "even by default", want: 100000},
+		{name: "deferred", strategy: InheritedIRADeferred, want: 0},
+		{
+			name:     "fill the 12% bracket",
+			strategy: InheritedIRAFillBracket,
+			rate:     0.12,
+			want:     bracketCeiling(taxYear.Brackets(), 0.12) + taxYear.StandardDeduction - 40000,
+		},
+		{
+			name:     "fill the 22% bracket by default",
+			strategy: InheritedIRAFillBracket,
+			want:     bracketCeiling(taxYear.Brackets(), 0.22) + taxYear.StandardDeduction - 40000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := CashFlowConfig{InheritedIRAStrategy: tt.strategy, InheritedIRATargetRate: tt.rate}
+			assert.InDelta(t, tt.want, account.distribution(config, flow, taxYear), 1e-9)
+		})
+	}
+
+	// The last year empties the account, and a Roth waits for it
+	config := CashFlowConfig{InheritedIRAStrategy: InheritedIRADeferred}
+	last := inheritedAccount{balance: 500000, yearsLeft: 1}
+	assert.Equal(t, 500000.0, last.distribution(config, flow, taxYear))
+	roth := inheritedAccount{balance: 500000, yearsLeft: 5, roth: true}
+	assert.Equal(t, 0.0, roth.distribution(CashFlowConfig{}, flow, taxYear))
+}
+
+func TestCashFlowInheritedIRAs(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+	baseline, err := service.RunAnalysis()
+	require.NoError(t, err)
+
+	config.InheritedIRAs = []InheritedIRA{
+		{Name: "father's IRA", Balance: 300000, YearsLeft: 4},
+		{Name: "mother's Roth", Balance: 100000, Roth: true},
+	}
+	results, err := service.RunAnalysisWithConfig(config)
+	require.NoError(t, err)
+
+	// The traditional IRA's distributions are forced into the year's taxes
+	for year := range 4 {
+		flow := results.YearlyFlows[year]
+		assert.Positive(t, flow.InheritedIRADistribution, year)
+		assert.Greater(t, flow.TotalTax, baseline.YearlyFlows[year].TotalTax, year)
+	}
+	assert.InDelta(t, 0, results.YearlyFlows[3].InheritedIRABalance, 1e-6)
+
+	// The Roth is paid out tax-free in its tenth year
+	for year := range 9 {
+		assert.Equal(t, 0.0, results.YearlyFlows[year].InheritedRothDistribution, year)
+	}
+	withoutRoth := config
+	withoutRoth.InheritedIRAs = config.InheritedIRAs[:1]
+	traditionalOnly, err := service.RunAnalysisWithConfig(withoutRoth)
+	require.NoError(t, err)
+	tenth := results.YearlyFlows[9]
+	assert.Greater(t, tenth.InheritedRothDistribution, 100000.0)
+	assert.Equal(t, traditionalOnly.YearlyFlows[9].TotalTax, tenth.TotalTax)
+	assert.Equal(t, 0.0, tenth.InheritedRothBalance)
+	assert.Greater(t, results.YearlyFlows[0].TotalPortfolio, baseline.YearlyFlows[0].TotalPortfolio)
+
+	config.InheritedIRAs[0].YearsLeft = 11
+	assert.Error(t, validateCashFlowConfig(config))
+	config.InheritedIRAs[0].YearsLeft = 4
+	config.InheritedIRAStrategy = "lump_sum"
+	assert.Error(t, validateCashFlowConfig(config))
+}
+
+func TestCompareInheritedIRAStrategies(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	config.CurrentAge = 55
+	config.RetirementAge = 55
+	config.EmploymentIncome = 0
+	config.InheritedIRAs = []InheritedIRA{{Name: "father's IRA", Balance: 600000}}
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	analysis, err := service.CompareInheritedIRAStrategies(config)
+	require.NoError(t, err)
+	require.Len(t, analysis.Outcomes, 6)
+	even, deferred := analysis.Outcomes[0], analysis.Outcomes[1]
+	assert.Equal(t, InheritedIRAEven, even.Strategy)
+	assert.Equal(t, InheritedIRADeferred, deferred.Strategy)
+	assert.Greater(t, deferred.LargestDistribution, even.LargestDistribution)
+	assert.Greater(t, deferred.Outcome.TotalTax, even.Outcome.TotalTax)
+
+	// The recommendation leaves the most behind
+	best := analysis.Outcomes[analysis.Recommended]
+	for _, outcome := range analysis.Outcomes {
+		assert.GreaterOrEqual(t, best.Outcome.FinalPortfolio, outcome.Outcome.FinalPortfolio)
+		assert.Positive(t, outcome.Distributions)
+	}
+
+	config.InheritedIRAs[0].Roth = true
+	_, err = service.CompareInheritedIRAStrategies(config)
+	assert.Error(t, err)
+}
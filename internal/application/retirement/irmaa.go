@@ -0,0 +1,86 @@
+package retirement
+
+import (
+	"errors"
+)
+
+// =============================================================================
+// Medicare Premiums and IRMAA
+// =============================================================================
+
+// From Medicare eligibility, Part B and Part D premiums carry an
+// income-related monthly adjustment amount (IRMAA) when modified adjusted
+// gross income (MAGI) from two years before is over the first threshold.
+// HealthcareExpense is taken to already include the standard premiums; the
+// surcharges are added on top, so large traditional withdrawals show up as
+// higher healthcare costs two years later. Thresholds and amounts are in
+// the tax year's dollars; MAGI is deflated to them before it is compared.
+
+// irmaaLookbackYears is how many years before a premium year its MAGI is
+// taken from
+const irmaaLookbackYears = 2
+
+// IRMAATier is a MAGI threshold and the monthly Part B and Part D
+// surcharges above it, per enrollee
+type IRMAATier struct {
+	Over  float64 `json:"over"`
+	PartB float64 `json:"part_b"`
+	PartD float64 `json:"part_d"`
+}
+
+// AnnualSurcharge is the tier's surcharges over a year
+func (t IRMAATier) AnnualSurcharge() float64 {
+	return (t.PartB + t.PartD) * 12
+}
+
+// MedicarePremiums are a year's Medicare premiums, monthly per enrollee,
+// and IRMAA tiers for married couples filing jointly
+type MedicarePremiums struct {
+	EligibilityAge   int         `json:"eligibility_age"`
+	PartBPremium     float64     `json:"part_b_premium"`
+	PartDBasePremium float64     `json:"part_d_base_premium"`
+	IRMAATiers       []IRMAATier `json:"irmaa_tiers"`
+}
+
+// validate checks the tiers are in order; data without tiers has no
+// surcharges
+func (m MedicarePremiums) validate() error {
+	if len(m.IRMAATiers) == 0 {
+		return nil
+	}
+	if m.EligibilityAge <= 0 {
+		return errors.New("medicare eligibility_age must be positive")
+	}
+	for i, tier := range m.IRMAATiers {
+		if tier.Over <= 0 || tier.PartB < 0 || tier.PartD < 0 {
+			return errors.New("medicare irmaa_tiers must have positive thresholds and non-negative surcharges")
+		}
+		if i > 0 && (tier.Over <= m.IRMAATiers[i-1].Over || tier.PartB < m.IRMAATiers[i-1].PartB) {
+			return errors.New("medicare irmaa_tiers are out of order")
+		}
+	}
+	return nil
+}
+
+// IRMAATierFor returns the tier MAGI falls in, 1 for the first, and its
+// surcharges; 0 and no surcharge under the first threshold
+func (m MedicarePremiums) IRMAATierFor(magi float64) (int, IRMAATier) {
+	for i := len(m.IRMAATiers) - 1; i >= 0; i-- {
+		if magi > m.IRMAATiers[i].Over {
+			return i + 1, m.IRMAATiers[i]
+		}
+	}
+	return 0, IRMAATier{}
+}
+
+// modifiedAGI is a year's MAGI: income less pre-tax contributions and the
+// deducted half of self-employment tax, with the taxable part of Social
+// Security, rental property sale gains, every traditional withdrawal and
+// Roth conversions
+func modifiedAGI(flow YearCashFlow, config CashFlowConfig, taxYear *TaxYearData) float64 {
+	wages := flow.EmploymentIncome * (1 - config.TraditionalContributionRate - config.HSAContributionRate)
+	_, seDeduction := taxYear.SelfEmploymentTax(flow.SelfEmploymentIncome, flow.EmploymentIncome-flow.SpouseEmploymentIncome)
+	return wages + flow.SelfEmploymentIncome - seDeduction + flow.TaxableSocialSecurity + flow.Pension +
+		flow.InvestmentIncome + flow.taxableRentalIncome() + flow.RentalSaleGain + flow.DepreciationRecapture +
+		flow.OtherIncome + flow.TraditionalWithdrawal + flow.TaxableWindfall + flow.InheritedIRADistribution + flow.RothConversion
+}
@@ -0,0 +1,60 @@
+package retirement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIRMAATierFor(t *testing.T) {
+	medicare := DefaultTaxData().Active().Medicare
+
+	tier, surcharge := medicare.IRMAATierFor(206000)
+	assert.Equal(t, 0, tier)
+	assert.Equal(t, 0.0, surcharge.AnnualSurcharge())
+
+	tier, surcharge = medicare.IRMAATierFor(206001)
+	assert.Equal(t, 1, tier)
+	assert.InDelta(t, (69.90+12.90)*12, surcharge.AnnualSurcharge(), 1e-9)
+
+	tier, _ = medicare.IRMAATierFor(1000000)
+	assert.Equal(t, len(medicare.IRMAATiers), tier)
+}
+
+func TestIRMAASurchargeFollowsMAGI(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	config.CurrentAge = 64
+	config.RetirementAge = 64
+	config.LifeExpectancy = 75
+	config.SocialSecurityStartAge = 70
+	config.InflationRate = 0
+	config.HealthcareGrowthRate = 0
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	modest, err := service.RunAnalysisWithConfig(config)
+	require.NoError(t, err)
+	for _, flow := range modest.YearlyFlows {
+		assert.Equal(t, 0.0, flow.IRMAASurcharge, "age %d", flow.Age)
+	}
+
+	// A large pension puts MAGI in the top tier; surcharges start once
+	// there is MAGI from two years before at Medicare age
+	wealthy := config
+	wealthy.PensionBenefit = 800000
+	wealthy.PensionStartAge = 64
+	results, err := service.RunAnalysisWithConfig(wealthy)
+	require.NoError(t, err)
+
+	top := DefaultTaxData().Active().Medicare.IRMAATiers
+	for i, flow := range results.YearlyFlows {
+		if i < irmaaLookbackYears {
+			assert.Equal(t, 0.0, flow.IRMAASurcharge, "age %d", flow.Age)
+			continue
+		}
+		assert.Equal(t, len(top), flow.IRMAATier, "age %d", flow.Age)
+		assert.InDelta(t, top[len(top)-1].AnnualSurcharge(), flow.IRMAASurcharge, 1e-6, "age %d", flow.Age)
+		assert.InDelta(t, modest.YearlyFlows[i].HealthcareExpense+flow.IRMAASurcharge, flow.HealthcareExpense, 1e-6)
+	}
+}
@@ -0,0 +1,215 @@
+package retirement
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// =============================================================================
+// Long-Term Care
+// =============================================================================
+
+// A long-term care event costs AnnualCost a year, in today's dollars
+// growing like healthcare costs, for DurationYears from the first person's
+// StartAge. The projection models the event when StartAge is set; Monte
+// Carlo simulations instead draw whether it happens with Probability,
+// starting at an age drawn evenly between EarliestAge and LatestAge.
+// Insurance benefits pay part of the cost, which is HealthcareExpense, and
+// premiums are InsuranceExpense until care starts and premiums are waived.
+// Costs aren't deducted as medical expenses; the tax model takes the
+// standard deduction, and benefits are tax-free reimbursements.
+
+// LongTermCare is a care event and its insurance
+type LongTermCare struct {
+	AnnualCost    float64
+	DurationYears int
+
+	// StartAge, when set, is the first person's age care starts at in the
+	// projection
+	StartAge int
+
+	// Probability is the chance care is needed in a simulation, starting
+	// between EarliestAge and LatestAge; without it simulations follow
+	// StartAge
+	Probability float64
+	EarliestAge int
+	LatestAge   int
+
+	Insurance *LongTermCareInsurance
+}
+
+// LongTermCareInsurance is a policy paying towards care
+type LongTermCareInsurance struct {
+	// AnnualPremium is level and paid until PremiumEndAge (0 for life) or
+	// care starts
+	AnnualPremium float64
+	PremiumEndAge int
+
+	// AnnualBenefit is the most the policy pays a year today, growing by
+	// BenefitGrowth (an inflation rider), for at most BenefitYears
+	// (0 for as long as care lasts)
+	AnnualBenefit float64
+	BenefitGrowth float64
+	BenefitYears  int
+}
+
+// validateLongTermCare checks the event and policy fit the analysis
+func validateLongTermCare(config CashFlowConfig) error {
+	ltc := config.LongTermCare
+	if ltc == nil {
+		return nil
+	}
+	if ltc.AnnualCost < 0 {
+		return errors.New("long-term care AnnualCost cannot be negative")
+	}
+	if ltc.DurationYears < 1 || ltc.DurationYears > 30 {
+		return errors.New("long-term care DurationYears must be between 1 and 30")
+	}
+	if ltc.StartAge != 0 && (ltc.StartAge < config.CurrentAge || ltc.StartAge >= config.LifeExpectancy) {
+		return errors.New("long-term care StartAge must be between CurrentAge and LifeExpectancy")
+	}
+	if ltc.Probability < 0 || ltc.Probability > 1 {
+		return errors.New("long-term care Probability must be between 0 and 1")
+	}
+	if ltc.Probability > 0 && (ltc.EarliestAge < config.CurrentAge || ltc.LatestAge < ltc.EarliestAge ||
+		ltc.LatestAge >= config.LifeExpectancy) {
+		return errors.New("long-term care EarliestAge and LatestAge must be in order between CurrentAge and LifeExpectancy")
+	}
+	if p := ltc.Insurance; p != nil {
+		if p.AnnualPremium < 0 || p.AnnualBenefit < 0 || p.BenefitYears < 0 {
+			return errors.New("long-term care insurance amounts cannot be negative")
+		}
+		if p.BenefitGrowth < 0 || p.BenefitGrowth > 0.1 {
+			return errors.New("long-term care insurance BenefitGrowth must be between 0 and 0.1")
+		}
+	}
+	return nil
+}
+
+// addLongTermCare adds a plan year's care costs, insurance benefits and
+// premiums to the household's expenses
+func (c CashFlowConfig) addLongTermCare(flow *YearCashFlow, year int, healthcareInflation float64) {
+	ltc := c.LongTermCare
+	if ltc == nil {
+		return
+	}
+	careYear := -1
+	if ltc.StartAge > 0 && flow.Age >= ltc.StartAge {
+		careYear = flow.Age - ltc.StartAge
+	}
+
+	if careYear >= 0 && careYear < ltc.DurationYears {
+		flow.LongTermCareCost = ltc.AnnualCost * healthcareInflation
+		if p := ltc.Insurance; p != nil && (p.BenefitYears == 0 || careYear < p.BenefitYears) {
+			benefit := p.AnnualBenefit * math.Pow(1+p.BenefitGrowth, float64(year))
+			flow.LongTermCareBenefit = math.Min(benefit, flow.LongTermCareCost)
+		}
+		flow.HealthcareExpense += flow.LongTermCareCost - flow.LongTermCareBenefit
+	}
+
+	if p := ltc.Insurance; p != nil && careYear < 0 && (p.PremiumEndAge == 0 || flow.Age < p.PremiumEndAge) {
+		flow.LongTermCarePremium = p.AnnualPremium
+		flow.InsuranceExpense += p.AnnualPremium
+	}
+}
+
+// drawLongTermCare sets a simulation's care event: whether it happens and at
+// what age, when it has a probability
+func drawLongTermCare(config CashFlowConfig, rng *rand.Rand) CashFlowConfig {
+	if config.LongTermCare == nil || config.LongTermCare.Probability == 0 {
+		return config
+	}
+	ltc := *config.LongTermCare
+	ltc.StartAge = 0
+	if rng.Float64() < ltc.Probability {
+		ltc.StartAge = ltc.EarliestAge + rng.Intn(ltc.LatestAge-ltc.EarliestAge+1)
+	}
+	config.LongTermCare = &ltc
+	return config
+}
+
+// LongTermCareAnalysis shows what a care event does to the plan
+type LongTermCareAnalysis struct {
+	// StartAge is the age care starts at in the projected scenario
+	StartAge int
+
+	// The projected plan without care and with it, and the scenario's
+	// nominal care costs, insurance benefits and premiums
+	WithoutCare   StrategyOutcome
+	WithCare      StrategyOutcome
+	TotalCost     float64
+	TotalBenefits float64
+	TotalPremiums float64
+
+	// Simulated success probability without care and with the care risk,
+	// and how much more likely the portfolio is to run out
+	SuccessWithoutCare    float64
+	SuccessWithCare       float64
+	DepletionRiskIncrease float64
+
+	// RecommendedReserve is what to set aside today, invested at
+	// ExpectedReturn, to pay the scenario's care costs insurance doesn't
+	RecommendedReserve float64
+}
+
+// AnalyzeLongTermCare compares the plan with and without config's care
+// event, projected at StartAge (EarliestAge when unset) and simulated with
+// its probability
+func (s *CashFlowService) AnalyzeLongTermCare(ctx context.Context, config CashFlowConfig, mc CashFlowMonteCarloConfig) (*LongTermCareAnalysis, error) {
+	if config.LongTermCare == nil {
+		return nil, errors.New("analysis has no long-term care event")
+	}
+	ltc := *config.LongTermCare
+	if ltc.StartAge == 0 {
+		ltc.StartAge = ltc.EarliestAge
+	}
+	if ltc.StartAge == 0 {
+		return nil, errors.New("long-term care event needs a StartAge or Probability")
+	}
+
+	withoutCare := config
+	withoutCare.LongTermCare = nil
+	baseline, err := s.RunAnalysisWithConfig(withoutCare)
+	if err != nil {
+		return nil, err
+	}
+	scenario := config
+	scenario.LongTermCare = &ltc
+	care, err := s.RunAnalysisWithConfig(scenario)
+	if err != nil {
+		return nil, err
+	}
+
+	analysis := &LongTermCareAnalysis{
+		StartAge:    ltc.StartAge,
+		WithoutCare: strategyOutcome(baseline),
+		WithCare:    strategyOutcome(care),
+	}
+	for year, flow := range care.YearlyFlows {
+		analysis.TotalCost += flow.LongTermCareCost
+		analysis.TotalBenefits += flow.LongTermCareBenefit
+		analysis.TotalPremiums += flow.LongTermCarePremium
+		uninsured := flow.LongTermCareCost - flow.LongTermCareBenefit
+		analysis.RecommendedReserve += uninsured / math.Pow(1+config.ExpectedReturn, float64(year))
+	}
+
+	// Both simulations start from the same seed
+	if mc.Seed == 0 {
+		mc.Seed = time.Now().UnixNano()
+	}
+	simulatedWithout, err := s.RunMonteCarlo(ctx, withoutCare, mc)
+	if err != nil {
+		return nil, err
+	}
+	simulatedWith, err := s.RunMonteCarlo(ctx, config, mc)
+	if err != nil {
+		return nil, err
+	}
+	analysis.SuccessWithoutCare = simulatedWithout.SuccessProbability
+	analysis.SuccessWithCare = simulatedWith.SuccessProbability
+	analysis.DepletionRiskIncrease = analysis.SuccessWithoutCare - analysis.SuccessWithCare
+	return analysis, nil
+}
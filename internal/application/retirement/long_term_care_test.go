@@ -0,0 +1,107 @@
+package retirement
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddLongTermCare(t *testing.T) {
+	config := CashFlowConfig{LongTermCare: &LongTermCare{
+		AnnualCost:    100000,
+		DurationYears: 3,
+		StartAge:      80,
+		Insurance: &LongTermCareInsurance{
+			AnnualPremium: 3000,
+			AnnualBenefit: 60000,
+			BenefitYears:  2,
+		},
+	}}
+
+	// Premiums are paid until care starts
+	flow := YearCashFlow{Age: 70}
+	config.addLongTermCare(&flow, 20, 1)
+	assert.Equal(t, 3000.0, flow.LongTermCarePremium)
+	assert.Equal(t, 3000.0, flow.InsuranceExpense)
+	assert.Equal(t, 0.0, flow.HealthcareExpense)
+
+	// ...then waived, with the policy paying towards the cost
+	flow = YearCashFlow{Age: 80}
+	config.addLongTermCare(&flow, 30, 2)
+	assert.Equal(t, 0.0, flow.LongTermCarePremium)
+	assert.Equal(t, 200000.0, flow.LongTermCareCost)
+	assert.Equal(t, 60000.0, flow.LongTermCareBenefit)
+	assert.Equal(t, 140000.0, flow.HealthcareExpense)
+
+	// Benefits run out before care does
+	flow = YearCashFlow{Age: 82}
+	config.addLongTermCare(&flow, 32, 2)
+	assert.Equal(t, 200000.0, flow.HealthcareExpense)
+
+	// ...and care ends after its duration
+	flow = YearCashFlow{Age: 83}
+	config.addLongTermCare(&flow, 33, 2)
+	assert.Equal(t, YearCashFlow{Age: 83}, flow)
+}
+
+func TestDrawLongTermCare(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	config := CashFlowConfig{LongTermCare: &LongTermCare{Probability: 1, EarliestAge: 75, LatestAge: 85}}
+	for range 50 {
+		drawn := drawLongTermCare(config, rng)
+		assert.GreaterOrEqual(t, drawn.LongTermCare.StartAge, 75)
+		assert.LessOrEqual(t, drawn.LongTermCare.StartAge, 85)
+	}
+	assert.Equal(t, 0, config.LongTermCare.StartAge)
+
+	config.LongTermCare.Probability = 0
+	config.LongTermCare.StartAge = 80
+	assert.Equal(t, 80, drawLongTermCare(config, rng).LongTermCare.StartAge)
+}
+
+func TestAnalyzeLongTermCare(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	config.LongTermCare = &LongTermCare{
+		AnnualCost:    120000,
+		DurationYears: 4,
+		Probability:   0.5,
+		EarliestAge:   78,
+		LatestAge:     88,
+	}
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	mc := DefaultCashFlowMonteCarloConfig()
+	mc.Iterations = 200
+	mc.Seed = 42
+	analysis, err := service.AnalyzeLongTermCare(context.Background(), config, mc)
+	require.NoError(t, err)
+
+	assert.Equal(t, 78, analysis.StartAge)
+	assert.Positive(t, analysis.TotalCost)
+	assert.Less(t, analysis.WithCare.FinalPortfolio, analysis.WithoutCare.FinalPortfolio)
+	assert.GreaterOrEqual(t, analysis.SuccessWithoutCare, analysis.SuccessWithCare)
+	assert.Positive(t, analysis.RecommendedReserve)
+	assert.Less(t, analysis.RecommendedReserve, analysis.TotalCost)
+
+	// Insurance shrinks the reserve needed
+	insured := config
+	ltc := *config.LongTermCare
+	ltc.Insurance = &LongTermCareInsurance{AnnualPremium: 2500, AnnualBenefit: 80000, BenefitGrowth: 0.03}
+	insured.LongTermCare = &ltc
+	insuredAnalysis, err := service.AnalyzeLongTermCare(context.Background(), insured, mc)
+	require.NoError(t, err)
+	assert.Positive(t, insuredAnalysis.TotalBenefits)
+	assert.Positive(t, insuredAnalysis.TotalPremiums)
+	assert.Less(t, insuredAnalysis.RecommendedReserve, analysis.RecommendedReserve)
+
+	config.LongTermCare = nil
+	_, err = service.AnalyzeLongTermCare(context.Background(), config, mc)
+	assert.Error(t, err)
+
+	config.LongTermCare = &LongTermCare{DurationYears: 2, Probability: 0.5, EarliestAge: 90, LatestAge: 80}
+	assert.Error(t, validateCashFlowConfig(config))
+}
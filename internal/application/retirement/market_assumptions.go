@@ -0,0 +1,274 @@
+package retirement
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// =============================================================================
+// Market Assumption Providers
+// =============================================================================
+
+// Market assumptions come from providers: the built-in defaults, CSV
+// uploads, or a remote capital market assumptions service. Each provider
+// publishes numbered versions that never change once published, so a plan
+// pinned to a provider's version projects the same way however often the
+// provider publishes new assumptions.
+//
+// A CSV holds one row per asset class (stocks, bonds and cash) with its
+// expected return and volatility, and optionally its correlation with each
+// asset class in columns named for them:
+//
+//	asset_class,expected_return,volatility,stocks,bonds,cash
+//	stocks,0.08,0.17,1,0.1,0
+//	bonds,0.04,0.06,0.1,1,0.2
+//	cash,0.02,0.01,0,0.2,1
+
+// ErrAssumptionVersionNotFound is returned for a version a provider never
+// published
+var ErrAssumptionVersionNotFound = errors.New("market assumption version not found")
+
+// DefaultAssumptionProviderName names the built-in assumptions' provider
+const DefaultAssumptionProviderName = "default"
+
+// AssumptionSet is one published version of a provider's assumptions
+type AssumptionSet struct {
+	Provider    string
+	Version     string
+	AsOf        time.Time
+	Assumptions MarketAssumptions
+}
+
+// AssumptionProvider supplies versioned market assumptions
+type AssumptionProvider interface {
+	// Name identifies the provider
+	Name() string
+	// Latest returns the most recently published version
+	Latest(ctx context.Context) (*AssumptionSet, error)
+	// Version returns a published version, ErrAssumptionVersionNotFound if
+	// there is none
+	Version(ctx context.Context, version string) (*AssumptionSet, error)
+}
+
+// StaticAssumptionProvider serves assumptions held in memory, the last
+// added being the latest. It is safe for concurrent use.
+type StaticAssumptionProvider struct {
+	name string
+
+	mu   sync.RWMutex
+	sets []AssumptionSet
+}
+
+// NewStaticAssumptionProvider creates a provider publishing sets, in order
+func NewStaticAssumptionProvider(name string, sets ...AssumptionSet) *StaticAssumptionProvider {
+	p := &StaticAssumptionProvider{name: name}
+	for _, set := range sets {
+		set.Provider = name
+		p.sets = append(p.sets, set)
+	}
+	return p
+}
+
+// DefaultAssumptionProvider publishes DefaultMarketAssumptions as version 1
+func DefaultAssumptionProvider() *StaticAssumptionProvider {
+	return NewStaticAssumptionProvider(DefaultAssumptionProviderName, AssumptionSet{
+		Version:     "1",
+		Assumptions: DefaultMarketAssumptions(),
+	})
+}
+
+// Name identifies the provider
+func (p *StaticAssumptionProvider) Name() string {
+	return p.name
+}
+
+// Latest returns the most recently published version
+func (p *StaticAssumptionProvider) Latest(ctx context.Context) (*AssumptionSet, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if len(p.sets) == 0 {
+		return nil, ErrAssumptionVersionNotFound
+	}
+	set := p.sets[len(p.sets)-1]
+	return &set, nil
+}
+
+// Version returns a published version
+func (p *StaticAssumptionProvider) Version(ctx context.Context, version string) (*AssumptionSet, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for _, set := range p.sets {
+		if set.Version == version {
+			return &set, nil
+		}
+	}
+	return nil, ErrAssumptionVersionNotFound
+}
+
+// Publish validates assumptions and publishes them as the next numbered
+// version
+func (p *StaticAssumptionProvider) Publish(assumptions MarketAssumptions, asOf time.Time) (*AssumptionSet, error) {
+	if err := assumptions.Validate(); err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	set := AssumptionSet{
+		Provider:    p.name,
+		Version:     strconv.Itoa(len(p.sets) + 1),
+		AsOf:        asOf,
+		Assumptions: assumptions,
+	}
+	p.sets = append(p.sets, set)
+	return &set, nil
+}
+
+// ResolveAssumptions returns provider's version of the assumptions, its
+// latest when version is empty
+func ResolveAssumptions(ctx context.Context, provider AssumptionProvider, version string) (*AssumptionSet, error) {
+	if version == "" {
+		return provider.Latest(ctx)
+	}
+	return provider.Version(ctx, version)
+}
+
+// assumptionClasses are the asset classes a CSV's rows and correlation
+// columns name
+var assumptionClasses = []string{"stocks", "bonds", "cash"}
+
+// ParseMarketAssumptionsCSV reads assumptions from CSV, as described above
+func ParseMarketAssumptionsCSV(r io.Reader) (MarketAssumptions, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return MarketAssumptions{}, fmt.Errorf("reading assumptions CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return MarketAssumptions{}, errors.New("assumptions CSV is empty")
+	}
+
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"asset_class", "expected_return", "volatility"} {
+		if _, ok := columns[name]; !ok {
+			return MarketAssumptions{}, fmt.Errorf("assumptions CSV has no %s column", name)
+		}
+	}
+
+	type row struct {
+		expected, volatility float64
+		correlations         map[string]float64
+	}
+	rows := make(map[string]row)
+	for line, record := range records[1:] {
+		field := func(column string) (float64, bool, error) {
+			i, ok := columns[column]
+			if !ok || i >= len(record) || strings.TrimSpace(record[i]) == "" {
+				return 0, false, nil
+			}
+			value, err := strconv.ParseFloat(strings.TrimSpace(record[i]), 64)
+			if err != nil {
+				return 0, false, fmt.Errorf("assumptions CSV line %d: %s: %w", line+2, column, err)
+			}
+			return value, true, nil
+		}
+
+		class := strings.ToLower(strings.TrimSpace(record[columns["asset_class"]]))
+		if !isAssumptionClass(class) {
+			return MarketAssumptions{}, fmt.Errorf("assumptions CSV line %d: unknown asset class %q", line+2, class)
+		}
+		if _, seen := rows[class]; seen {
+			return MarketAssumptions{}, fmt.Errorf("assumptions CSV lists %s twice", class)
+		}
+		var entry row
+		var err error
+		var ok bool
+		if entry.expected, ok, err = field("expected_return"); err != nil || !ok {
+			return MarketAssumptions{}, csvFieldError(err, line, "expected_return")
+		}
+		if entry.volatility, ok, err = field("volatility"); err != nil || !ok {
+			return MarketAssumptions{}, csvFieldError(err, line, "volatility")
+		}
+		entry.correlations = make(map[string]float64)
+		for _, other := range assumptionClasses {
+			value, ok, err := field(other)
+			if err != nil {
+				return MarketAssumptions{}, err
+			}
+			if ok {
+				entry.correlations[other] = value
+			}
+		}
+		rows[class] = entry
+	}
+
+	for _, class := range assumptionClasses {
+		if _, ok := rows[class]; !ok {
+			return MarketAssumptions{}, fmt.Errorf("assumptions CSV has no %s row", class)
+		}
+	}
+
+	// Correlations may be given on either side of the diagonal, but must
+	// agree when given on both
+	correlation := func(a, b string) (float64, error) {
+		ab, abOK := rows[a].correlations[b]
+		ba, baOK := rows[b].correlations[a]
+		if abOK && baOK && ab != ba {
+			return 0, fmt.Errorf("assumptions CSV correlations of %s and %s disagree", a, b)
+		}
+		if abOK {
+			return ab, nil
+		}
+		return ba, nil
+	}
+	m := MarketAssumptions{
+		StockReturn:     rows["stocks"].expected,
+		BondReturn:      rows["bonds"].expected,
+		CashReturn:      rows["cash"].expected,
+		StockVolatility: rows["stocks"].volatility,
+		BondVolatility:  rows["bonds"].volatility,
+		CashVolatility:  rows["cash"].volatility,
+	}
+	if m.StockBondCorrelation, err = correlation("stocks", "bonds"); err != nil {
+		return MarketAssumptions{}, err
+	}
+	if m.StockCashCorrelation, err = correlation("stocks", "cash"); err != nil {
+		return MarketAssumptions{}, err
+	}
+	if m.BondCashCorrelation, err = correlation("bonds", "cash"); err != nil {
+		return MarketAssumptions{}, err
+	}
+	if err := m.Validate(); err != nil {
+		return MarketAssumptions{}, err
+	}
+	return m, nil
+}
+
+// isAssumptionClass reports whether class is stocks, bonds or cash
+func isAssumptionClass(class string) bool {
+	for _, c := range assumptionClasses {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+// csvFieldError reports a required CSV field that is missing or invalid
+func csvFieldError(err error, line int, column string) error {
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("assumptions CSV line %d has no %s", line+2, column)
+}
@@ -0,0 +1,80 @@
+package retirement
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMarketAssumptionsCSV(t *testing.T) {
+	m, err := ParseMarketAssumptionsCSV(strings.NewReader(`asset_class,expected_return,volatility,stocks,bonds,cash
+stocks,0.08,0.17,1,0.1,
+bonds,0.04,0.06,0.1,1,0.2
+cash,0.02,0.01,,,1
+`))
+	require.NoError(t, err)
+	assert.Equal(t, 0.08, m.StockReturn)
+	assert.Equal(t, 0.06, m.BondVolatility)
+	assert.Equal(t, 0.1, m.StockBondCorrelation)
+	assert.Equal(t, 0.0, m.StockCashCorrelation)
+	assert.Equal(t, 0.2, m.BondCashCorrelation)
+
+	// Correlation columns are optional
+	m, err = ParseMarketAssumptionsCSV(strings.NewReader("asset_class,expected_return,volatility\nstocks,0.07,0.15\nbonds,0.03,0.05\ncash,0.01,0\n"))
+	require.NoError(t, err)
+	assert.Equal(t, 0.07, m.StockReturn)
+	assert.Equal(t, 0.0, m.StockBondCorrelation)
+
+	for name, csv := range map[string]string{
+		"empty":         "",
+		"no volatility": "asset_class,expected_return\nstocks,0.07\n",
+		"missing row":   "asset_class,expected_return,volatility\nstocks,0.07,0.15\nbonds,0.03,0.05\n",
+		"unknown row":   "asset_class,expected_return,volatility\ngold,0.05,0.2\n",
+		"bad number":    "asset_class,expected_return,volatility\nstocks,seven,0.15\nbonds,0.03,0.05\ncash,0.01,0\n",
+		"asymmetric":    "asset_class,expected_return,volatility,stocks,bonds\nstocks,0.07,0.15,1,0.3\nbonds,0.03,0.05,0.2,1\ncash,0.01,0,,\n",
+		"negative vol":  "asset_class,expected_return,volatility\nstocks,0.07,-0.15\nbonds,0.03,0.05\ncash,0.01,0\n",
+	} {
+		_, err := ParseMarketAssumptionsCSV(strings.NewReader(csv))
+		assert.Error(t, err, name)
+	}
+}
+
+func TestStaticAssumptionProvider(t *testing.T) {
+	ctx := context.Background()
+	provider := DefaultAssumptionProvider()
+	assert.Equal(t, DefaultAssumptionProviderName, provider.Name())
+
+	latest, err := ResolveAssumptions(ctx, provider, "")
+	require.NoError(t, err)
+	assert.Equal(t, "1", latest.Version)
+	assert.Equal(t, DefaultMarketAssumptions(), latest.Assumptions)
+
+	// Publishing adds a version without changing the ones before it
+	updated := DefaultMarketAssumptions()
+	updated.StockReturn = 0.05
+	asOf := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	set, err := provider.Publish(updated, asOf)
+	require.NoError(t, err)
+	assert.Equal(t, "2", set.Version)
+	assert.Equal(t, DefaultAssumptionProviderName, set.Provider)
+
+	latest, err = ResolveAssumptions(ctx, provider, "")
+	require.NoError(t, err)
+	assert.Equal(t, *set, *latest)
+	pinned, err := ResolveAssumptions(ctx, provider, "1")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultMarketAssumptions(), pinned.Assumptions)
+
+	_, err = provider.Version(ctx, "3")
+	assert.ErrorIs(t, err, ErrAssumptionVersionNotFound)
+	_, err = NewStaticAssumptionProvider("empty").Latest(ctx)
+	assert.ErrorIs(t, err, ErrAssumptionVersionNotFound)
+
+	updated.StockVolatility = -1
+	_, err = provider.Publish(updated, asOf)
+	assert.Error(t, err)
+}
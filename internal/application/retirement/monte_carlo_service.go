@@ -1,11 +1,13 @@
 package retirement
 
 import (
+	"context"
 	"errors"
 	"math"
 	"math/rand"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -182,6 +184,24 @@ func (s *MonteCarloService) RunSimulation() (*MonteCarloResults, error) {
 
 // RunSimulationWithConfig executes simulation with custom config
 func (s *MonteCarloService) RunSimulationWithConfig(config SimulationConfig) (*MonteCarloResults, error) {
+	return s.runSimulation(context.Background(), config, nil)
+}
+
+// SimulationProgressCallback is called as iterations complete
+type SimulationProgressCallback func(completed, total int)
+
+// progressReportInterval is how many iterations a worker runs between
+// cancellation checks and progress reports
+const progressReportInterval = 500
+
+// RunSimulationContext executes the simulation, stopping early with the
+// context's error if ctx is cancelled. progressCb may be nil.
+func (s *MonteCarloService) RunSimulationContext(ctx context.Context, progressCb SimulationProgressCallback) (*MonteCarloResults, error) {
+	return s.runSimulation(ctx, s.config, progressCb)
+}
+
+// runSimulation runs the iterations across workers
+func (s *MonteCarloService) runSimulation(ctx context.Context, config SimulationConfig, progressCb SimulationProgressCallback) (*MonteCarloResults, error) {
 	if err := validateConfig(config); err != nil {
 		return nil, err
 	}
@@ -194,6 +214,7 @@ func (s *MonteCarloService) RunSimulationWithConfig(config SimulationConfig) (*M
 	}
 
 	results := make([]SimulationResult, config.NumIterations)
+	var completed atomic.Int64
 
 	// Run simulations in parallel
 	var wg sync.WaitGroup
@@ -212,6 +233,15 @@ func (s *MonteCarloService) RunSimulationWithConfig(config SimulationConfig) (*M
 			defer wg.Done()
 			rng := rand.New(rand.NewSource(workerSeed))
 			for i := 0; i < count; i++ {
+				if i%progressReportInterval == 0 && i > 0 {
+					if ctx.Err() != nil {
+						return
+					}
+					done := completed.Add(progressReportInterval)
+					if progressCb != nil {
+						progressCb(int(done), config.NumIterations)
+					}
+				}
 				results[start+i] = s.runSingleSimulation(config, rng)
 			}
 		}(startIdx, count, s.getNewSeed())
@@ -221,6 +251,10 @@ func (s *MonteCarloService) RunSimulationWithConfig(config SimulationConfig) (*M
 
 	wg.Wait()
 
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	// Calculate aggregate results
 	aggregateResults := s.calculateAggregateResults(results, startTime)
 
@@ -390,19 +424,29 @@ func (s *MonteCarloService) calculateAggregateResults(results []SimulationResult
 
 // calculatePercentiles computes percentile values from sorted data
 func (s *MonteCarloService) calculatePercentiles(sortedValues []float64) PercentileResults {
-	return PercentileResults{
-		P5:  s.getPercentile(sortedValues, 5),
-		P10: s.getPercentile(sortedValues, 10),
-		P25: s.getPercentile(sortedValues, 25),
-		P50: s.getPercentile(sortedValues, 50),
-		P75: s.getPercentile(sortedValues, 75),
-		P90: s.getPercentile(sortedValues, 90),
-		P95: s.getPercentile(sortedValues, 95),
-	}
+	return percentilesOf(sortedValues)
 }
 
 // getPercentile returns the value at the given percentile (0-100)
 func (s *MonteCarloService) getPercentile(sortedValues []float64, percentile float64) float64 {
+	return percentileOf(sortedValues, percentile)
+}
+
+// percentilesOf computes percentile values from sorted data
+func percentilesOf(sortedValues []float64) PercentileResults {
+	return PercentileResults{
+		P5:  percentileOf(sortedValues, 5),
+		P10: percentileOf(sortedValues, 10),
+		P25: percentileOf(sortedValues, 25),
+		P50: percentileOf(sortedValues, 50),
+		P75: percentileOf(sortedValues, 75),
+		P90: percentileOf(sortedValues, 90),
+		P95: percentileOf(sortedValues, 95),
+	}
+}
+
+// percentileOf returns the value at the given percentile (0-100) of sorted data
+func percentileOf(sortedValues []float64, percentile float64) float64 {
 	if len(sortedValues) == 0 {
 		return 0
 	}
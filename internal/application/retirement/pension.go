@@ -0,0 +1,203 @@
+package retirement
+
+import (
+	"errors"
+	"math"
+)
+
+// =============================================================================
+// Pensions and Annuities
+// =============================================================================
+
+// Unlike the flat PensionBenefit, which follows general inflation, a Pension
+// is paid as the plan quotes it: AnnualBenefit in the dollars of the year
+// payments start, raised by its own COLA each year after, so a pension
+// without a COLA loses value to inflation. A spouse's pension drops to
+// SurvivorShare after the spouse dies; the plan ends with the first
+// person's life, so their own pensions are never in survivor payment.
+// A pension offering a lump sum can take it instead, rolled over to the
+// traditional balance at the start age without tax.
+
+// Pension is a defined benefit pension or income annuity
+type Pension struct {
+	Name string
+
+	// Spouse marks a pension paid on the spouse's work record, started by
+	// the spouse's age
+	Spouse bool
+
+	// AnnualBenefit is the first year's payment at StartAge, or this year's
+	// payment for a pension already being paid
+	AnnualBenefit float64
+	StartAge      int
+
+	// COLA is the annual increase once payments start, e.g. 0.02 for 2%
+	COLA float64
+
+	// SurvivorShare is the part paid on after the owner dies: 0 for a
+	// single life annuity, 0.5 or 1 for joint and survivor options
+	SurvivorShare float64
+
+	// LumpSum is what the plan offers instead of the annuity; TakeLumpSum
+	// rolls it over rather than drawing payments
+	LumpSum     float64
+	TakeLumpSum bool
+}
+
+// validatePensions checks each pension's ages and rates are in range
+func validatePensions(config CashFlowConfig) error {
+	for _, p := range config.Pensions {
+		if p.Spouse && config.Spouse == nil {
+			return errors.New("pension " + p.Name + " is the spouse's but there is no spouse")
+		}
+		if p.StartAge <= 0 || p.StartAge > 120 {
+			return errors.New("pension StartAge must be between 1 and 120")
+		}
+		if p.AnnualBenefit < 0 || p.LumpSum < 0 {
+			return errors.New("pension AnnualBenefit and LumpSum cannot be negative")
+		}
+		if p.COLA < 0 || p.COLA > 0.1 {
+			return errors.New("pension COLA must be between 0 and 0.1")
+		}
+		if p.SurvivorShare < 0 || p.SurvivorShare > 1 {
+			return errors.New("pension SurvivorShare must be between 0 and 1")
+		}
+		if p.TakeLumpSum && (p.LumpSum <= 0 || p.StartAge < config.pensionOwnerCurrentAge(p)) {
+			return errors.New("pension " + p.Name + " has no lump sum left to take")
+		}
+	}
+	return nil
+}
+
+// pensionOwnerCurrentAge is the pension owner's age in the first plan year
+func (c CashFlowConfig) pensionOwnerCurrentAge(p Pension) int {
+	if p.Spouse {
+		return c.Spouse.CurrentAge
+	}
+	return c.CurrentAge
+}
+
+// pensionPayment is what a pension pays in a plan year, 0 if it was taken
+// as a lump sum
+func (c CashFlowConfig) pensionPayment(p Pension, year int) float64 {
+	currentAge := c.pensionOwnerCurrentAge(p)
+	age := currentAge + year
+	if p.TakeLumpSum || age < p.StartAge {
+		return 0
+	}
+	payment := p.AnnualBenefit * math.Pow(1+p.COLA, float64(age-max(p.StartAge, currentAge)))
+	if p.Spouse && c.Spouse.DeathAge > 0 && age >= c.Spouse.DeathAge {
+		payment *= p.SurvivorShare
+	}
+	return payment
+}
+
+// addPensionIncome adds a plan year's pension payments to the household's
+// income, and any lump sum taken that year
+func (c CashFlowConfig) addPensionIncome(flow *YearCashFlow, year int) {
+	for _, p := range c.Pensions {
+		if p.TakeLumpSum && c.pensionOwnerCurrentAge(p)+year == p.StartAge {
+			flow.PensionLumpSum += p.LumpSum
+		}
+		payment := c.pensionPayment(p, year)
+		flow.Pension += payment
+		if p.Spouse {
+			flow.SpousePension += payment
+		}
+	}
+}
+
+// PensionLumpSumComparison compares drawing a pension as an annuity with
+// rolling its lump sum over at the start age
+type PensionLumpSumComparison struct {
+	Pension Pension
+	Annuity StrategyOutcome
+	LumpSum StrategyOutcome
+
+	// AnnuityPresentValue is the annuity's payments over the plan,
+	// discounted to the start age at the expected return
+	AnnuityPresentValue float64
+
+	// ImpliedReturn is the return the lump sum would have to earn to pay
+	// as much as the annuity over the plan
+	ImpliedReturn float64
+
+	// PreferLumpSum is set when the lump sum leaves the larger final
+	// portfolio
+	PreferLumpSum bool
+}
+
+// ComparePensionLumpSums runs config with each pension offering a lump sum
+// drawn as an annuity and taken as the lump sum, the other pensions as
+// configured. Pensions already being paid are left out.
+func (s *CashFlowService) ComparePensionLumpSums(config CashFlowConfig) ([]PensionLumpSumComparison, error) {
+	if err := validateCashFlowConfig(config); err != nil {
+		return nil, err
+	}
+
+	var comparisons []PensionLumpSumComparison
+	for i, p := range config.Pensions {
+		if p.LumpSum <= 0 || p.StartAge < config.pensionOwnerCurrentAge(p) {
+			continue
+		}
+
+		outcomes := make([]StrategyOutcome, 2)
+		for j, takeLumpSum := range []bool{false, true} {
+			scenario := config
+			scenario.Pensions = append([]Pension(nil), config.Pensions...)
+			scenario.Pensions[i].TakeLumpSum = takeLumpSum
+			results, err := s.RunAnalysisWithConfig(scenario)
+			if err != nil {
+				return nil, err
+			}
+			outcomes[j] = strategyOutcome(results)
+		}
+
+		annuity := p
+		annuity.TakeLumpSum = false
+		payments := config.pensionPaymentsFrom(annuity)
+		comparisons = append(comparisons, PensionLumpSumComparison{
+			Pension:             annuity,
+			Annuity:             outcomes[0],
+			LumpSum:             outcomes[1],
+			AnnuityPresentValue: presentValue(payments, config.ExpectedReturn),
+			ImpliedReturn:       impliedReturn(payments, p.LumpSum),
+			PreferLumpSum:       outcomes[1].FinalPortfolio > outcomes[0].FinalPortfolio,
+		})
+	}
+	return comparisons, nil
+}
+
+// pensionPaymentsFrom returns a pension's payments in each plan year from
+// its start age
+func (c CashFlowConfig) pensionPaymentsFrom(p Pension) []float64 {
+	var payments []float64
+	for year := p.StartAge - c.pensionOwnerCurrentAge(p); year < c.LifeExpectancy-c.CurrentAge; year++ {
+		payments = append(payments, c.pensionPayment(p, year))
+	}
+	return payments
+}
+
+// presentValue discounts yearly payments, the first paid now, at rate
+func presentValue(payments []float64, rate float64) float64 {
+	var value float64
+	for k, payment := range payments {
+		value += payment / math.Pow(1+rate, float64(k))
+	}
+	return value
+}
+
+// impliedReturn finds the rate at which payments are worth lumpSum, by
+// bisection between -50% and 100%
+func impliedReturn(payments []float64, lumpSum float64) float64 {
+	low, high := -0.5, 1.0
+	for range 100 {
+		mid := (low + high) / 2
+		if presentValue(payments, mid) > lumpSum {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return (low + high) / 2
+}
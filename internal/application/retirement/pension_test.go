@@ -0,0 +1,104 @@
+package retirement
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCashFlowPensionCOLA(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	config.Pensions = []Pension{{Name: "state", AnnualBenefit: 24000, StartAge: 65, COLA: 0.01}}
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+	results, err := service.RunAnalysis()
+	require.NoError(t, err)
+
+	// Paid as quoted from the start age, then raised by the COLA
+	for _, flow := range results.YearlyFlows {
+		want := 0.0
+		if flow.Age >= 65 {
+			want = 24000 * math.Pow(1.01, float64(flow.Age-65))
+		}
+		assert.InDelta(t, want, flow.Pension, 1e-6, "age %d", flow.Age)
+	}
+}
+
+func TestCashFlowPensionSurvivor(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	config.Spouse = &SpouseConfig{CurrentAge: config.CurrentAge, RetirementAge: config.RetirementAge, DeathAge: 75}
+	config.Pensions = []Pension{{Spouse: true, AnnualBenefit: 20000, StartAge: 65, SurvivorShare: 0.5}}
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+	results, err := service.RunAnalysis()
+	require.NoError(t, err)
+
+	assert.Equal(t, 20000.0, results.YearlyFlows[74-config.CurrentAge].SpousePension)
+	assert.Equal(t, 10000.0, results.YearlyFlows[75-config.CurrentAge].SpousePension)
+	assert.Equal(t, 10000.0, results.YearlyFlows[75-config.CurrentAge].Pension)
+}
+
+func TestCashFlowPensionLumpSum(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	config.Pensions = []Pension{{AnnualBenefit: 24000, StartAge: 65, LumpSum: 300000, TakeLumpSum: true}}
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+	results, err := service.RunAnalysis()
+	require.NoError(t, err)
+
+	for _, flow := range results.YearlyFlows {
+		assert.Equal(t, 0.0, flow.Pension, "age %d", flow.Age)
+		if flow.Age == 65 {
+			assert.Equal(t, 300000.0, flow.PensionLumpSum)
+		} else {
+			assert.Equal(t, 0.0, flow.PensionLumpSum, "age %d", flow.Age)
+		}
+	}
+
+	// A lump sum can't be taken from a pension already being paid
+	config.Pensions[0].StartAge = config.CurrentAge - 1
+	assert.Error(t, validateCashFlowConfig(config))
+}
+
+func TestComparePensionLumpSums(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	config.Pensions = []Pension{
+		{Name: "offered", AnnualBenefit: 24000, StartAge: 65, LumpSum: 300000},
+		{Name: "annuity only", AnnualBenefit: 12000, StartAge: 65},
+	}
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	comparisons, err := service.ComparePensionLumpSums(config)
+	require.NoError(t, err)
+	require.Len(t, comparisons, 1)
+
+	comparison := comparisons[0]
+	assert.Equal(t, "offered", comparison.Pension.Name)
+	assert.False(t, config.Pensions[0].TakeLumpSum)
+	assert.NotEqual(t, comparison.Annuity.FinalPortfolio, comparison.LumpSum.FinalPortfolio)
+	assert.Equal(t, comparison.LumpSum.FinalPortfolio > comparison.Annuity.FinalPortfolio, comparison.PreferLumpSum)
+
+	// The lump sum is worth the payments at the implied return
+	payments := config.pensionPaymentsFrom(comparison.Pension)
+	require.Len(t, payments, config.LifeExpectancy-65)
+	assert.InDelta(t, 300000, presentValue(payments, comparison.ImpliedReturn), 1e-3)
+	assert.InDelta(t, presentValue(payments, config.ExpectedReturn), comparison.AnnuityPresentValue, 1e-9)
+}
+
+func TestValidatePensions(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	for name, pension := range map[string]Pension{
+		"no start age":         {AnnualBenefit: 1000},
+		"negative benefit":     {AnnualBenefit: -1, StartAge: 65},
+		"COLA too high":        {AnnualBenefit: 1000, StartAge: 65, COLA: 0.5},
+		"survivor share":       {AnnualBenefit: 1000, StartAge: 65, SurvivorShare: 2},
+		"spouse without one":   {AnnualBenefit: 1000, StartAge: 65, Spouse: true},
+		"lump sum not offered": {AnnualBenefit: 1000, StartAge: 65, TakeLumpSum: true},
+	} {
+		config.Pensions = []Pension{pension}
+		assert.Error(t, validateCashFlowConfig(config), name)
+	}
+}
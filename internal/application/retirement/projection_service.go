@@ -239,6 +239,7 @@ type ProjectionService struct {
 
 // DefaultProjectionConfig returns a ProjectionConfig with reasonable defaults
 func DefaultProjectionConfig() ProjectionConfig {
+	limits := DefaultTaxData().Active().ContributionLimits
 	return ProjectionConfig{
 		CurrentAge:              35,
 		RetirementAge:           65,
@@ -248,9 +249,9 @@ func DefaultProjectionConfig() ProjectionConfig {
 		RothBalance:             50000,
 		HSABalance:              10000,
 		TaxableContribution:     10000,
-		TraditionalContribution: 23000, // 2024 401k limit
-		RothContribution:        7000,  // 2024 IRA limit
-		HSAContribution:         4150,  // 2024 HSA limit
+		TraditionalContribution: limits.Traditional401k,
+		RothContribution:        limits.IRA,
+		HSAContribution:         limits.HSASelfOnly,
 		ExpectedReturn:          0.07,
 		InflationRate:           0.025,
 		AnnualExpenses:          60000,
@@ -871,16 +872,8 @@ type RothConversionPlan struct {
 // calculateOptimalConversion finds optimal conversion amount to minimize taxes
 func (s *ProjectionService) calculateOptimalConversion(brackets []TaxBracket, otherIncome float64) float64 {
 	if len(brackets) == 0 {
-		// Use default 2024 brackets (married filing jointly)
-		brackets = []TaxBracket{
-			{0, 23200, 0.10},
-			{23200, 94300, 0.12},
-			{94300, 201050, 0.22},
-			{201050, 383900, 0.24},
-			{383900, 487450, 0.32},
-			{487450, 731200, 0.35},
-			{731200, math.MaxFloat64, 0.37},
-		}
+		// Use the active tax year's brackets (married filing jointly)
+		brackets = DefaultTaxData().Active().Brackets()
 	}
 
 	// Fill up to 22% bracket as a reasonable optimization target
@@ -900,15 +893,7 @@ func (s *ProjectionService) calculateOptimalConversion(brackets []TaxBracket, ot
 // calculateTaxOnConversion calculates tax owed on a Roth conversion
 func (s *ProjectionService) calculateTaxOnConversion(amount float64, brackets []TaxBracket) float64 {
 	if len(brackets) == 0 {
-		brackets = []TaxBracket{
-			{0, 23200, 0.10},
-			{23200, 94300, 0.12},
-			{94300, 201050, 0.22},
-			{201050, 383900, 0.24},
-			{383900, 487450, 0.32},
-			{487450, 731200, 0.35},
-			{731200, math.MaxFloat64, 0.37},
-		}
+		brackets = DefaultTaxData().Active().Brackets()
 	}
 
 	totalTax := 0.0
@@ -0,0 +1,119 @@
+package retirement
+
+import "errors"
+
+// =============================================================================
+// Raise Capture
+// =============================================================================
+
+// When pay goes up, saving part of the raise is the easiest contribution
+// increase to make: take-home pay still rises, so the saving goes unmissed.
+// The plan is run twice with the raise, once as it stands and once with the
+// captured share added to traditional contributions, and the outcomes are
+// compared.
+
+// DefaultRaiseCapturePercent is the share of a raise suggested for savings
+const DefaultRaiseCapturePercent = 50
+
+// RaiseCaptureOutcome summarizes a cash flow run
+type RaiseCaptureOutcome struct {
+	TraditionalContributionRate float64
+	PortfolioAtRetirement       float64
+	FinalPortfolio              float64
+	RetirementReadiness         float64
+	ExpensesCoveredYears        int
+	// DepletionAge is when the portfolio runs out, 0 if it lasts
+	DepletionAge int
+}
+
+// RaiseCapture compares keeping a raise with saving part of it
+type RaiseCapture struct {
+	AnnualRaise    float64
+	CapturePercent float64
+
+	// AdditionalContribution is the captured share of the raise a year,
+	// growing with employment income
+	AdditionalContribution float64
+
+	Kept     RaiseCaptureOutcome
+	Captured RaiseCaptureOutcome
+
+	// Gains from capturing the raise
+	PortfolioAtRetirementGain float64
+	FinalPortfolioGain        float64
+	ReadinessGain             float64
+	// YearsGained is how much longer the portfolio lasts
+	YearsGained int
+}
+
+// AnalyzeRaiseCapture compares the plan in config after an annualRaise to
+// employment income, with and without capturePercent of it saved
+func (s *CashFlowService) AnalyzeRaiseCapture(config CashFlowConfig, annualRaise, capturePercent float64) (*RaiseCapture, error) {
+	if annualRaise <= 0 {
+		return nil, errors.New("annualRaise must be positive")
+	}
+	if capturePercent <= 0 || capturePercent > 100 {
+		return nil, errors.New("capturePercent must be between 0 and 100")
+	}
+	if config.EmploymentIncome <= 0 || config.CurrentAge >= config.RetirementAge {
+		return nil, errors.New("raise capture needs employment income before retirement")
+	}
+
+	kept := config
+	kept.EmploymentIncome += annualRaise
+	captured := kept
+	additional := annualRaise * capturePercent / 100
+	captured.TraditionalContributionRate += additional / kept.EmploymentIncome
+
+	keptOutcome, err := s.raiseCaptureOutcome(kept)
+	if err != nil {
+		return nil, err
+	}
+	capturedOutcome, err := s.raiseCaptureOutcome(captured)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RaiseCapture{
+		AnnualRaise:               annualRaise,
+		CapturePercent:            capturePercent,
+		AdditionalContribution:    additional,
+		Kept:                      keptOutcome,
+		Captured:                  capturedOutcome,
+		PortfolioAtRetirementGain: capturedOutcome.PortfolioAtRetirement - keptOutcome.PortfolioAtRetirement,
+		FinalPortfolioGain:        capturedOutcome.FinalPortfolio - keptOutcome.FinalPortfolio,
+		ReadinessGain:             capturedOutcome.RetirementReadiness - keptOutcome.RetirementReadiness,
+		YearsGained:               capturedOutcome.ExpensesCoveredYears - keptOutcome.ExpensesCoveredYears,
+	}, nil
+}
+
+// raiseCaptureOutcome runs config and summarizes the results
+func (s *CashFlowService) raiseCaptureOutcome(config CashFlowConfig) (RaiseCaptureOutcome, error) {
+	results, err := s.RunAnalysisWithConfig(config)
+	if err != nil {
+		return RaiseCaptureOutcome{}, err
+	}
+
+	outcome := RaiseCaptureOutcome{
+		TraditionalContributionRate: config.TraditionalContributionRate,
+		RetirementReadiness:         results.RetirementReadiness,
+		ExpensesCoveredYears:        results.ExpensesCoveredYears,
+	}
+	flows := results.YearlyFlows
+	if len(flows) == 0 {
+		return outcome, nil
+	}
+	outcome.FinalPortfolio = flows[len(flows)-1].TotalPortfolio
+	// The portfolio at retirement is the balance the last working year ends
+	// with
+	if i := config.RetirementAge - config.CurrentAge - 1; i >= 0 && i < len(flows) {
+		outcome.PortfolioAtRetirement = flows[i].TotalPortfolio
+	}
+	for _, flow := range flows {
+		if flow.IsRetired && flow.TotalPortfolio <= 0 {
+			outcome.DepletionAge = flow.Age
+			break
+		}
+	}
+	return outcome, nil
+}
@@ -0,0 +1,35 @@
+package retirement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeRaiseCapture(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	capture, err := service.AnalyzeRaiseCapture(config, 10000, 50)
+	require.NoError(t, err)
+
+	assert.Equal(t, 5000.0, capture.AdditionalContribution)
+	assert.Equal(t, config.TraditionalContributionRate, capture.Kept.TraditionalContributionRate)
+	assert.InDelta(t, config.TraditionalContributionRate+5000.0/110000, capture.Captured.TraditionalContributionRate, 1e-12)
+	assert.Positive(t, capture.PortfolioAtRetirementGain)
+	assert.Positive(t, capture.FinalPortfolioGain)
+	assert.GreaterOrEqual(t, capture.ReadinessGain, 0.0)
+	assert.InDelta(t, capture.Captured.PortfolioAtRetirement-capture.Kept.PortfolioAtRetirement, capture.PortfolioAtRetirementGain, 1e-9)
+
+	_, err = service.AnalyzeRaiseCapture(config, 0, 50)
+	assert.Error(t, err)
+	_, err = service.AnalyzeRaiseCapture(config, 10000, 150)
+	assert.Error(t, err)
+
+	retired := config
+	retired.CurrentAge = 65
+	_, err = service.AnalyzeRaiseCapture(retired, 10000, 50)
+	assert.Error(t, err)
+}
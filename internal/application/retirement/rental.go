@@ -0,0 +1,170 @@
+package retirement
+
+import (
+	"errors"
+	"math"
+)
+
+// =============================================================================
+// Rental Properties
+// =============================================================================
+
+// A rental property brings in its rent less operating expenses and mortgage
+// payments, in today's dollars like the rest of CashFlowConfig, while its
+// value grows by its own appreciation rate. It is taxed on rent less
+// operating expenses, mortgage interest and straight-line depreciation of
+// the building over 27.5 years. Losses are suspended as passive losses and
+// offset later rental income, and whatever is left is released when the
+// property is sold. A sale happens at the start of the year the first
+// person turns SaleAge: the mortgage is paid off, the rest of the proceeds
+// go to the taxable balance, depreciation taken is recaptured at ordinary
+// rates up to 25% and the remaining gain is taxed at the capital gains rate.
+
+const (
+	// residentialRecoveryYears is the depreciation period of residential
+	// rental buildings
+	residentialRecoveryYears = 27.5
+	// unrecapturedGainRate is the most depreciation recapture is taxed at
+	unrecapturedGainRate = 0.25
+)
+
+// RentalProperty is a rental property and its mortgage
+type RentalProperty struct {
+	Name string
+
+	// Value is the market value today; AppreciationRate is its annual
+	// growth
+	Value            float64
+	AppreciationRate float64
+
+	// GrossRent and OperatingExpenses (property tax, insurance, repairs,
+	// management) are annual amounts in today's dollars
+	GrossRent         float64
+	OperatingExpenses float64
+
+	// MortgagePayment is the annual principal and interest payment
+	MortgageBalance float64
+	MortgageRate    float64
+	MortgagePayment float64
+
+	// CostBasis is what was paid for the property and improvements;
+	// DepreciableBasis is the building's part of it, and
+	// AccumulatedDepreciation what has been deducted so far
+	CostBasis               float64
+	DepreciableBasis        float64
+	AccumulatedDepreciation float64
+
+	// SaleAge, when set, is the first person's age the property is sold
+	// at; SellingCostRate is the commission and closing costs taken off the
+	// price
+	SaleAge         int
+	SellingCostRate float64
+}
+
+// validateRentalProperties checks each property's amounts are consistent
+func validateRentalProperties(config CashFlowConfig) error {
+	for _, p := range config.RentalProperties {
+		for _, amount := range []float64{p.Value, p.GrossRent, p.OperatingExpenses, p.MortgageBalance,
+			p.MortgagePayment, p.CostBasis, p.DepreciableBasis, p.AccumulatedDepreciation} {
+			if amount < 0 {
+				return errors.New("rental property amounts cannot be negative")
+			}
+		}
+		if p.AppreciationRate < -1 || p.AppreciationRate > 1 || p.MortgageRate < 0 || p.MortgageRate > 1 {
+			return errors.New("rental property rates must be between 0 and 1")
+		}
+		if p.DepreciableBasis > p.CostBasis || p.AccumulatedDepreciation > p.DepreciableBasis {
+			return errors.New("rental property DepreciableBasis must be within CostBasis and AccumulatedDepreciation within DepreciableBasis")
+		}
+		if p.MortgageBalance > 0 && p.MortgagePayment <= p.MortgageBalance*p.MortgageRate {
+			return errors.New("rental property MortgagePayment must be more than a year's interest")
+		}
+		if p.SaleAge != 0 && (p.SaleAge < config.CurrentAge || p.SaleAge >= config.LifeExpectancy) {
+			return errors.New("rental property SaleAge must be between CurrentAge and LifeExpectancy")
+		}
+		if p.SellingCostRate < 0 || p.SellingCostRate > 0.2 {
+			return errors.New("rental property SellingCostRate must be between 0 and 0.2")
+		}
+	}
+	return nil
+}
+
+// rentalProperty tracks a property through the plan
+type rentalProperty struct {
+	RentalProperty
+	suspendedLoss float64
+	sold          bool
+}
+
+// newRentalProperties starts tracking config's properties
+func newRentalProperties(config CashFlowConfig) []*rentalProperty {
+	properties := make([]*rentalProperty, len(config.RentalProperties))
+	for i, p := range config.RentalProperties {
+		properties[i] = &rentalProperty{RentalProperty: p}
+	}
+	return properties
+}
+
+// addRentalPropertyFlows adds a plan year of every property to the
+// household's flows, selling those due
+func addRentalPropertyFlows(flow *YearCashFlow, properties []*rentalProperty, inflationFactor float64) {
+	for _, p := range properties {
+		if p.sold {
+			continue
+		}
+		if p.SaleAge > 0 && flow.Age >= p.SaleAge {
+			p.sell(flow)
+			continue
+		}
+
+		rent := p.GrossRent * inflationFactor
+		expenses := p.OperatingExpenses * inflationFactor
+		interest := p.MortgageBalance * p.MortgageRate
+		payment := math.Min(p.MortgagePayment, p.MortgageBalance+interest)
+		p.MortgageBalance -= payment - interest
+
+		depreciation := math.Min(p.DepreciableBasis/residentialRecoveryYears, p.DepreciableBasis-p.AccumulatedDepreciation)
+		p.AccumulatedDepreciation += depreciation
+
+		// Losses are suspended; income uses them up first
+		taxable := rent - expenses - interest - depreciation
+		if taxable < 0 {
+			p.suspendedLoss -= taxable
+			taxable = 0
+		} else {
+			used := math.Min(taxable, p.suspendedLoss)
+			p.suspendedLoss -= used
+			taxable -= used
+		}
+
+		p.Value *= 1 + p.AppreciationRate
+
+		flow.RentalPropertyCashFlow += rent - expenses - payment
+		flow.RentalPropertyTaxableIncome += taxable
+		flow.RentalDepreciation += depreciation
+		flow.RentalEquity += p.Value - p.MortgageBalance
+	}
+	flow.RentalIncome += flow.RentalPropertyCashFlow
+}
+
+// sell sells the property at its value at the start of the year
+func (p *rentalProperty) sell(flow *YearCashFlow) {
+	price := p.Value * (1 - p.SellingCostRate)
+	gain := price - (p.CostBasis - p.AccumulatedDepreciation)
+	recapture := math.Max(0, math.Min(gain, p.AccumulatedDepreciation))
+
+	flow.RentalSaleProceeds += price - p.MortgageBalance
+	flow.DepreciationRecapture += recapture
+	flow.RentalSaleGain += gain - recapture
+
+	// Suspended losses are released against ordinary income
+	flow.RentalPropertyTaxableIncome -= p.suspendedLoss
+	p.suspendedLoss = 0
+	p.sold = true
+}
+
+// taxableRentalIncome is rental income as it is taxed: properties count by
+// their taxable income rather than their cash flow
+func (f YearCashFlow) taxableRentalIncome() float64 {
+	return f.RentalIncome - f.RentalPropertyCashFlow + f.RentalPropertyTaxableIncome
+}
@@ -0,0 +1,104 @@
+package retirement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRentalProperty() RentalProperty {
+	return RentalProperty{
+		Name:              "duplex",
+		Value:             400000,
+		GrossRent:         30000,
+		OperatingExpenses: 8000,
+		MortgageBalance:   200000,
+		MortgageRate:      0.05,
+		MortgagePayment:   15000,
+		CostBasis:         300000,
+		DepreciableBasis:  275000,
+	}
+}
+
+func TestRentalPropertyFlows(t *testing.T) {
+	properties := newRentalProperties(CashFlowConfig{RentalProperties: []RentalProperty{testRentalProperty()}})
+
+	flow := YearCashFlow{Age: 50, RentalIncome: 1000}
+	addRentalPropertyFlows(&flow, properties, 1)
+
+	// 30,000 rent less 8,000 expenses and 15,000 of mortgage payments
+	assert.Equal(t, 7000.0, flow.RentalPropertyCashFlow)
+	assert.Equal(t, 8000.0, flow.RentalIncome)
+	// 10,000 of interest and 10,000 of depreciation leave 2,000 taxable
+	assert.Equal(t, 10000.0, flow.RentalDepreciation)
+	assert.InDelta(t, 2000, flow.RentalPropertyTaxableIncome, 1e-9)
+	assert.InDelta(t, 1000+2000, flow.taxableRentalIncome(), 1e-9)
+	assert.InDelta(t, 400000-195000, flow.RentalEquity, 1e-9)
+	assert.InDelta(t, 195000, properties[0].MortgageBalance, 1e-9)
+}
+
+func TestRentalPropertySuspendedLosses(t *testing.T) {
+	property := testRentalProperty()
+	property.GrossRent = 20000
+	property.SaleAge = 52
+	properties := newRentalProperties(CashFlowConfig{RentalProperties: []RentalProperty{property}})
+
+	// Losses are suspended rather than deducted
+	flow := YearCashFlow{Age: 50}
+	addRentalPropertyFlows(&flow, properties, 1)
+	assert.Equal(t, 0.0, flow.RentalPropertyTaxableIncome)
+	assert.InDelta(t, 8000, properties[0].suspendedLoss, 1e-9)
+
+	flow = YearCashFlow{Age: 51}
+	addRentalPropertyFlows(&flow, properties, 1)
+	suspended := properties[0].suspendedLoss
+
+	// ...and released when the property is sold
+	flow = YearCashFlow{Age: 52}
+	addRentalPropertyFlows(&flow, properties, 1)
+	assert.InDelta(t, -suspended, flow.RentalPropertyTaxableIncome, 1e-9)
+	assert.Equal(t, 0.0, flow.RentalEquity)
+
+	// Two years' depreciation is recaptured, the rest of the gain over the
+	// 300,000 basis is a capital gain
+	assert.InDelta(t, 20000, flow.DepreciationRecapture, 1e-9)
+	assert.InDelta(t, 100000, flow.RentalSaleGain, 1e-9)
+	assert.InDelta(t, 400000-properties[0].MortgageBalance, flow.RentalSaleProceeds, 1e-9)
+
+	// Sold properties have no more flows
+	flow = YearCashFlow{Age: 53}
+	addRentalPropertyFlows(&flow, properties, 1)
+	assert.Equal(t, YearCashFlow{Age: 53}, flow)
+}
+
+func TestCashFlowRentalPropertySale(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	property := testRentalProperty()
+	property.SaleAge = config.RetirementAge
+	property.AppreciationRate = 0.03
+	property.SellingCostRate = 0.06
+	config.RentalProperties = []RentalProperty{property}
+
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+	results, err := service.RunAnalysis()
+	require.NoError(t, err)
+
+	first := results.YearlyFlows[0]
+	assert.Positive(t, first.RentalEquity)
+	assert.InDelta(t, first.TotalPortfolio+first.RentalEquity, first.NetWorth, 1e-6)
+
+	sale := results.YearlyFlows[config.RetirementAge-config.CurrentAge]
+	before := results.YearlyFlows[config.RetirementAge-config.CurrentAge-1]
+	assert.Positive(t, sale.RentalSaleProceeds)
+	assert.Positive(t, sale.RentalSaleGain)
+	assert.Positive(t, sale.DepreciationRecapture)
+	assert.Greater(t, sale.CapitalGainsTax, before.CapitalGainsTax)
+	assert.Equal(t, 0.0, sale.RentalEquity)
+	assert.Greater(t, sale.MAGI, before.MAGI)
+
+	// A sale before the plan starts is rejected
+	config.RentalProperties[0].SaleAge = config.CurrentAge - 1
+	assert.Error(t, validateCashFlowConfig(config))
+}
@@ -0,0 +1,152 @@
+package retirement
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// =============================================================================
+// Result Caching
+// =============================================================================
+
+// Projections and simulations are deterministic in their configuration and
+// the tax figures, so identical requests can share results. A result is
+// cached under a fingerprint of everything it depends on: the
+// configuration, including the market assumptions and the version they
+// were pinned to, and the tax data's revision. Changing any of them changes
+// the fingerprint; a cache also drops everything it holds when the tax data
+// changes, since none of it can be hit again.
+
+// DefaultResultCacheSize is how many results a cache keeps by default
+const DefaultResultCacheSize = 256
+
+// ResultCache keeps the most recently used results by fingerprint. Cached
+// values are shared between callers, who must not modify them. It is safe
+// for concurrent use.
+type ResultCache[V any] struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	// order holds the entries, most recently used first
+	order *list.List
+	// revision is the tax data revision the entries were computed with
+	revision uint64
+
+	hits   int64
+	misses int64
+}
+
+// resultCacheEntry is a cached result and its fingerprint
+type resultCacheEntry[V any] struct {
+	key   string
+	value V
+}
+
+// ResultCacheStats describe a cache's use
+type ResultCacheStats struct {
+	Entries int
+	Hits    int64
+	Misses  int64
+}
+
+// NewResultCache creates a cache of up to capacity results
+// (DefaultResultCacheSize when 0)
+func NewResultCache[V any](capacity int) *ResultCache[V] {
+	if capacity <= 0 {
+		capacity = DefaultResultCacheSize
+	}
+	return &ResultCache[V]{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+		revision: DefaultTaxData().Revision(),
+	}
+}
+
+// Get returns the result cached under key
+func (c *ResultCache[V]) Get(key string) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checkRevision()
+
+	element, ok := c.entries[key]
+	if !ok {
+		c.misses++
+		var zero V
+		return zero, false
+	}
+	c.hits++
+	c.order.MoveToFront(element)
+	return element.Value.(*resultCacheEntry[V]).value, true
+}
+
+// Put caches a result under key, evicting the least recently used result
+// when the cache is full
+func (c *ResultCache[V]) Put(key string, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checkRevision()
+
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*resultCacheEntry[V]).value = value
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&resultCacheEntry[V]{key: key, value: value})
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*resultCacheEntry[V]).key)
+	}
+}
+
+// Purge drops every cached result
+func (c *ResultCache[V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.purge()
+}
+
+// Stats returns how many results are cached and how often they were found
+func (c *ResultCache[V]) Stats() ResultCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return ResultCacheStats{
+		Entries: c.order.Len(),
+		Hits:    c.hits,
+		Misses:  c.misses,
+	}
+}
+
+// checkRevision drops the cached results when the tax data has changed
+// since they were computed
+func (c *ResultCache[V]) checkRevision() {
+	if revision := DefaultTaxData().Revision(); revision != c.revision {
+		c.purge()
+		c.revision = revision
+	}
+}
+
+// purge drops every cached result; the caller holds c.mu
+func (c *ResultCache[V]) purge() {
+	c.entries = make(map[string]*list.Element)
+	c.order.Init()
+}
+
+// Fingerprint identifies a computation by its inputs and the tax data
+// revision. parts are hashed as JSON, so they must marshal.
+func Fingerprint(parts ...any) (string, error) {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "tax:%d\n", DefaultTaxData().Revision())
+	encoder := json.NewEncoder(hash)
+	for _, part := range parts {
+		if err := encoder.Encode(part); err != nil {
+			return "", fmt.Errorf("fingerprinting: %w", err)
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,71 @@
+package retirement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFingerprint(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	key, err := Fingerprint(config)
+	require.NoError(t, err)
+	again, err := Fingerprint(DefaultCashFlowConfig())
+	require.NoError(t, err)
+	assert.Equal(t, key, again)
+
+	changed := config
+	changed.InflationRate += 0.001
+	other, err := Fingerprint(changed)
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other)
+
+	withVersion, err := Fingerprint(config, "v2")
+	require.NoError(t, err)
+	assert.NotEqual(t, key, withVersion)
+
+	_, err = Fingerprint(func() {})
+	assert.Error(t, err)
+}
+
+func TestResultCache(t *testing.T) {
+	cache := NewResultCache[int](2)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+
+	value, ok := cache.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, 1, value)
+
+	// b is now the least recently used
+	cache.Put("c", 3)
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+	value, ok = cache.Get("c")
+	require.True(t, ok)
+	assert.Equal(t, 3, value)
+	assert.Equal(t, ResultCacheStats{Entries: 2, Hits: 2, Misses: 1}, cache.Stats())
+
+	cache.Purge()
+	_, ok = cache.Get("a")
+	assert.False(t, ok)
+}
+
+func TestResultCacheTaxDataChange(t *testing.T) {
+	cache := NewResultCache[int](0)
+	key, err := Fingerprint(DefaultCashFlowConfig())
+	require.NoError(t, err)
+	cache.Put(key, 1)
+
+	// Activating a tax year, even the active one, invalidates results
+	store := DefaultTaxData()
+	require.NoError(t, store.Activate(store.Active().TaxYear))
+
+	_, ok := cache.Get(key)
+	assert.False(t, ok)
+	assert.Equal(t, 0, cache.Stats().Entries)
+	newKey, err := Fingerprint(DefaultCashFlowConfig())
+	require.NoError(t, err)
+	assert.NotEqual(t, key, newKey)
+}
@@ -0,0 +1,234 @@
+package retirement
+
+import (
+	"math"
+	"sort"
+)
+
+// =============================================================================
+// Results Diff
+// =============================================================================
+
+// Two runs of the plan, typically before and after a config tweak, are
+// compared year by year and over the lifetime. A change is material when it
+// moves a figure by at least MaterialityPercent of the base figure and by at
+// least materialityMinimum. The drivers of a diff are the income, expense,
+// tax and savings lines that moved most over the lifetime.
+
+const (
+	// MaterialityPercent is the smallest change, relative to the base
+	// figure, that is material
+	MaterialityPercent = 1.0
+	// materialityMinimum is the smallest material change in dollars
+	materialityMinimum = 1000.0
+	// diffDriverCount is how many drivers a diff highlights
+	diffDriverCount = 3
+)
+
+// MetricDiff compares one figure between two runs
+type MetricDiff struct {
+	Base     float64
+	Compare  float64
+	Delta    float64
+	Percent  float64 // Delta relative to Base, 0 when Base is 0
+	Material bool
+}
+
+// YearDiff compares one year of two runs. Years are matched by age.
+type YearDiff struct {
+	Age int
+
+	TotalIncome      MetricDiff
+	TotalExpenses    MetricDiff
+	TotalTax         MetricDiff
+	TotalSavings     MetricDiff
+	TotalWithdrawals MetricDiff
+	NetCashFlow      MetricDiff
+	TotalPortfolio   MetricDiff
+
+	// Material is whether any of the year's figures changed materially
+	Material bool
+}
+
+// DiffDriver is a cash flow line and how much it changed over the lifetime
+type DiffDriver struct {
+	Line  string
+	Delta float64
+	// Share is the line's part of all lines' absolute change, 0 to 1
+	Share float64
+}
+
+// ResultsDiff compares two runs of the plan
+type ResultsDiff struct {
+	Years []YearDiff
+
+	FinalPortfolio           MetricDiff
+	TotalLifetimeIncome      MetricDiff
+	TotalLifetimeExpenses    MetricDiff
+	TotalLifetimeTax         MetricDiff
+	TotalLifetimeSavings     MetricDiff
+	TotalLifetimeWithdrawals MetricDiff
+	RetirementReadiness      MetricDiff
+	ExpensesCoveredYears     MetricDiff
+
+	// Drivers are the lines that moved most, largest change first
+	Drivers []DiffDriver
+}
+
+// DiffResults compares compare to base
+func DiffResults(base, compare *CashFlowResults) *ResultsDiff {
+	diff := &ResultsDiff{
+		FinalPortfolio:           diffMetric(finalPortfolio(base), finalPortfolio(compare)),
+		TotalLifetimeIncome:      diffMetric(base.TotalLifetimeIncome, compare.TotalLifetimeIncome),
+		TotalLifetimeExpenses:    diffMetric(base.TotalLifetimeExpenses, compare.TotalLifetimeExpenses),
+		TotalLifetimeTax:         diffMetric(base.TotalLifetimeTax, compare.TotalLifetimeTax),
+		TotalLifetimeSavings:     diffMetric(base.TotalLifetimeSavings, compare.TotalLifetimeSavings),
+		TotalLifetimeWithdrawals: diffMetric(base.TotalLifetimeWithdrawals, compare.TotalLifetimeWithdrawals),
+	}
+
+	// Readiness and years covered aren't dollar figures: a point of
+	// readiness or any change in years covered is material
+	diff.RetirementReadiness = diffMetric(base.RetirementReadiness, compare.RetirementReadiness)
+	diff.RetirementReadiness.Material = math.Abs(diff.RetirementReadiness.Delta) >= 0.01
+	diff.ExpensesCoveredYears = diffMetric(float64(base.ExpensesCoveredYears), float64(compare.ExpensesCoveredYears))
+	diff.ExpensesCoveredYears.Material = diff.ExpensesCoveredYears.Delta != 0
+
+	// Ages either run covers; a year only one run has is compared to zero
+	byAge := make(map[int][2]*YearCashFlow)
+	for i := range base.YearlyFlows {
+		flows := byAge[base.YearlyFlows[i].Age]
+		flows[0] = &base.YearlyFlows[i]
+		byAge[base.YearlyFlows[i].Age] = flows
+	}
+	for i := range compare.YearlyFlows {
+		flows := byAge[compare.YearlyFlows[i].Age]
+		flows[1] = &compare.YearlyFlows[i]
+		byAge[compare.YearlyFlows[i].Age] = flows
+	}
+	ages := make([]int, 0, len(byAge))
+	for age := range byAge {
+		ages = append(ages, age)
+	}
+	sort.Ints(ages)
+
+	lines := make(map[string]float64)
+	for _, age := range ages {
+		flows := byAge[age]
+		var from, to YearCashFlow
+		if flows[0] != nil {
+			from = *flows[0]
+		}
+		if flows[1] != nil {
+			to = *flows[1]
+		}
+
+		year := YearDiff{
+			Age:              age,
+			TotalIncome:      diffMetric(from.TotalIncome, to.TotalIncome),
+			TotalExpenses:    diffMetric(from.TotalExpenses, to.TotalExpenses),
+			TotalTax:         diffMetric(from.TotalTax, to.TotalTax),
+			TotalSavings:     diffMetric(from.TotalSavings, to.TotalSavings),
+			TotalWithdrawals: diffMetric(from.TotalWithdrawals, to.TotalWithdrawals),
+			NetCashFlow:      diffMetric(from.NetCashFlow, to.NetCashFlow),
+			TotalPortfolio:   diffMetric(from.TotalPortfolio, to.TotalPortfolio),
+		}
+		year.Material = year.TotalIncome.Material || year.TotalExpenses.Material ||
+			year.TotalTax.Material || year.TotalSavings.Material ||
+			year.TotalWithdrawals.Material || year.NetCashFlow.Material ||
+			year.TotalPortfolio.Material
+		diff.Years = append(diff.Years, year)
+
+		fromLines, toLines := cashFlowLines(from), cashFlowLines(to)
+		for line := range fromLines {
+			lines[line] += toLines[line] - fromLines[line]
+		}
+	}
+
+	diff.Drivers = diffDrivers(lines)
+	return diff
+}
+
+// diffMetric compares a figure of two runs
+func diffMetric(base, compare float64) MetricDiff {
+	metric := MetricDiff{
+		Base:    base,
+		Compare: compare,
+		Delta:   compare - base,
+	}
+	if base != 0 {
+		metric.Percent = metric.Delta / math.Abs(base) * 100
+	}
+	metric.Material = math.Abs(metric.Delta) >= materialityMinimum &&
+		(base == 0 || math.Abs(metric.Percent) >= MaterialityPercent)
+	return metric
+}
+
+// diffDrivers returns the diffDriverCount lines with the largest changes
+func diffDrivers(lines map[string]float64) []DiffDriver {
+	var total float64
+	drivers := make([]DiffDriver, 0, len(lines))
+	for line, delta := range lines {
+		total += math.Abs(delta)
+		if delta != 0 {
+			drivers = append(drivers, DiffDriver{Line: line, Delta: delta})
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+
+	sort.Slice(drivers, func(i, j int) bool {
+		if math.Abs(drivers[i].Delta) != math.Abs(drivers[j].Delta) {
+			return math.Abs(drivers[i].Delta) > math.Abs(drivers[j].Delta)
+		}
+		return drivers[i].Line < drivers[j].Line
+	})
+	drivers = drivers[:min(diffDriverCount, len(drivers))]
+	for i := range drivers {
+		drivers[i].Share = math.Abs(drivers[i].Delta) / total
+	}
+	return drivers
+}
+
+// cashFlowLines are a year's income, expense, tax, savings and withdrawal
+// lines, by name
+func cashFlowLines(flow YearCashFlow) map[string]float64 {
+	return map[string]float64{
+		"employment_income":      flow.EmploymentIncome,
+		"self_employment_income": flow.SelfEmploymentIncome,
+		"social_security":        flow.SocialSecurity,
+		"pension":                flow.Pension,
+		"investment_income":      flow.InvestmentIncome,
+		"rental_income":          flow.RentalIncome,
+		"other_income":           flow.OtherIncome,
+		"housing_expense":        flow.HousingExpense,
+		"healthcare_expense":     flow.HealthcareExpense,
+		"food_expense":           flow.FoodExpense,
+		"transportation_expense": flow.TransportationExpense,
+		"utilities_expense":      flow.UtilitiesExpense,
+		"insurance_expense":      flow.InsuranceExpense,
+		"discretionary_expense":  flow.DiscretionaryExpense,
+		"other_expenses":         flow.OtherExpenses,
+		"debt_payments":          flow.DebtPayments,
+		"federal_tax":            flow.FederalTax,
+		"state_tax":              flow.StateTax,
+		"fica_tax":               flow.FICATax,
+		"capital_gains_tax":      flow.CapitalGainsTax,
+		"taxable_savings":        flow.TaxableSavings,
+		"traditional_savings":    flow.TraditionalSavings,
+		"roth_savings":           flow.RothSavings,
+		"hsa_savings":            flow.HSASavings,
+		"taxable_withdrawal":     flow.TaxableWithdrawal,
+		"traditional_withdrawal": flow.TraditionalWithdrawal,
+		"roth_withdrawal":        flow.RothWithdrawal,
+		"hsa_withdrawal":         flow.HSAWithdrawal,
+	}
+}
+
+// finalPortfolio is the portfolio at the end of the last year
+func finalPortfolio(results *CashFlowResults) float64 {
+	if n := len(results.YearlyFlows); n > 0 {
+		return results.YearlyFlows[n-1].TotalPortfolio
+	}
+	return 0
+}
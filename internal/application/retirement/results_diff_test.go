@@ -0,0 +1,79 @@
+package retirement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffResults(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+	base, err := service.RunAnalysisWithConfig(config)
+	require.NoError(t, err)
+
+	// Nothing changes between identical runs
+	same := DiffResults(base, base)
+	require.Len(t, same.Years, len(base.YearlyFlows))
+	for _, year := range same.Years {
+		assert.False(t, year.Material, "age %d", year.Age)
+	}
+	assert.False(t, same.FinalPortfolio.Material)
+	assert.Empty(t, same.Drivers)
+
+	// A bigger salary is more employment income and more savings, which
+	// compound into larger withdrawals
+	raised := config
+	raised.EmploymentIncome += 20000
+	compare, err := service.RunAnalysisWithConfig(raised)
+	require.NoError(t, err)
+
+	diff := DiffResults(base, compare)
+	assert.True(t, diff.FinalPortfolio.Material)
+	assert.Positive(t, diff.FinalPortfolio.Delta)
+	assert.InDelta(t, compare.TotalLifetimeIncome-base.TotalLifetimeIncome, diff.TotalLifetimeIncome.Delta, 1e-6)
+	assert.True(t, diff.Years[0].Material)
+	assert.InDelta(t, 20000, diff.Years[0].TotalIncome.Delta, 1e-6)
+
+	require.Len(t, diff.Drivers, 3)
+	lines := make(map[string]float64)
+	for _, driver := range diff.Drivers {
+		lines[driver.Line] = driver.Delta
+	}
+	assert.Positive(t, lines["employment_income"])
+	for i := 1; i < len(diff.Drivers); i++ {
+		assert.GreaterOrEqual(t, diff.Drivers[i-1].Share, diff.Drivers[i].Share)
+	}
+}
+
+func TestDiffResultsLifespans(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+	base, err := service.RunAnalysisWithConfig(config)
+	require.NoError(t, err)
+
+	longer := config
+	longer.LifeExpectancy += 5
+	compare, err := service.RunAnalysisWithConfig(longer)
+	require.NoError(t, err)
+
+	// Years only the longer run has are compared to nothing
+	diff := DiffResults(base, compare)
+	require.Len(t, diff.Years, len(compare.YearlyFlows))
+	last := diff.Years[len(diff.Years)-1]
+	assert.Equal(t, 0.0, last.TotalExpenses.Base)
+	assert.Positive(t, last.TotalExpenses.Delta)
+}
+
+func TestDiffMetricMateriality(t *testing.T) {
+	// Large in dollars but under a percent
+	assert.False(t, diffMetric(1000000, 1005000).Material)
+	// Large in percent but under the dollar minimum
+	assert.False(t, diffMetric(100, 200).Material)
+	assert.True(t, diffMetric(100000, 102000).Material)
+	assert.True(t, diffMetric(0, 5000).Material)
+	assert.InDelta(t, -10.0, diffMetric(50000, 45000).Percent, 1e-9)
+}
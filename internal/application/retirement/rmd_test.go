@@ -0,0 +1,83 @@
+package retirement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateRMD(t *testing.T) {
+	assert.Equal(t, 0.0, CalculateRMD(72, 500000))
+	assert.InDelta(t, 500000/26.5, CalculateRMD(73, 500000), 1e-9)
+	assert.InDelta(t, 500000/6.4, CalculateRMD(100, 500000), 1e-9)
+	assert.InDelta(t, 500000/2.0, CalculateRMD(125, 500000), 1e-9)
+	assert.Equal(t, 0.0, CalculateRMD(80, 0))
+}
+
+// rmdConfig is a retiree with only a traditional account, turning 70
+func rmdConfig() CashFlowConfig {
+	config := DefaultCashFlowConfig()
+	config.CurrentAge = 70
+	config.RetirementAge = 70
+	config.LifeExpectancy = 80
+	config.TaxableBalance = 0
+	config.TraditionalBalance = 1000000
+	config.RothBalance = 0
+	config.HSABalance = 0
+	return config
+}
+
+func TestCashFlowForcesRMDs(t *testing.T) {
+	config := rmdConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	results, err := service.RunAnalysisWithConfig(config)
+	require.NoError(t, err)
+
+	totalRMDs, totalRMDTax := 0.0, 0.0
+	for _, flow := range results.YearlyFlows {
+		totalRMDs += flow.RequiredMinimumDistribution
+		totalRMDTax += flow.RMDTax
+		if flow.Age < RMDStartAge {
+			assert.Zero(t, flow.RequiredMinimumDistribution, "age %d", flow.Age)
+			continue
+		}
+		assert.Positive(t, flow.RequiredMinimumDistribution, "age %d", flow.Age)
+		assert.Positive(t, flow.RMDTax, "age %d", flow.Age)
+		assert.GreaterOrEqual(t, flow.TraditionalWithdrawal, flow.RequiredMinimumDistribution, "age %d", flow.Age)
+	}
+	assert.InDelta(t, totalRMDs, results.LifetimeTaxAnalysis.RequiredMinimumDistribution, 1e-6)
+	assert.InDelta(t, totalRMDTax, results.LifetimeTaxAnalysis.RMDTax, 1e-6)
+}
+
+func TestCashFlowReinvestsUnspentRMD(t *testing.T) {
+	config := rmdConfig()
+	config.CurrentAge = 73
+	config.RetirementAge = 73
+	config.SocialSecurityStartAge = 0
+	config.HousingExpense = 0
+	config.HealthcareExpense = 0
+	config.FoodExpense = 0
+	config.TransportationExpense = 0
+	config.UtilitiesExpense = 0
+	config.InsuranceExpense = 0
+	config.DiscretionaryExpense = 0
+	config.OtherExpenses = 0
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	flows := service.projectYears(config, make([]float64, 7), nil, make([]float64, 7))
+
+	first := flows[0]
+	rmd := 1000000 / 26.5
+	assert.InDelta(t, rmd, first.RequiredMinimumDistribution, 1e-9)
+	assert.InDelta(t, rmd, first.TraditionalWithdrawal, 1e-9)
+	// The RMD is the only income, so all of the tax is the RMD's
+	assert.InDelta(t, first.TotalTax, first.RMDTax, 1e-9)
+	// What isn't needed for the tax is reinvested
+	assert.InDelta(t, rmd-first.TotalTax, first.TaxableSavings, 1e-9)
+	assert.InDelta(t, 0, first.NetCashFlow, 1e-9)
+	assert.InDelta(t, 1000000-first.TotalTax, first.TotalPortfolio, 1e-6)
+}
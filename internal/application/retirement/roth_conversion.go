@@ -0,0 +1,35 @@
+package retirement
+
+import (
+	"errors"
+	"math"
+)
+
+// =============================================================================
+// Roth Conversions
+// =============================================================================
+
+// With UseRothConversion, each retired year before RothConversionEndAge
+// (0 for no end) converts RothConversionAmount, in today's dollars, from
+// the traditional balance left after the RMD to Roth. The conversion is
+// ordinary income taxed that year and counts towards MAGI. With ACA
+// coverage it stops short of the subsidy cliff while there is a credit to
+// lose; the year's withdrawals aren't known yet, so only income known
+// before them is counted.
+
+// validateRothConversion checks the conversion amount
+func validateRothConversion(config CashFlowConfig) error {
+	if config.RothConversionAmount < 0 {
+		return errors.New("RothConversionAmount cannot be negative")
+	}
+	return nil
+}
+
+// rothConversion is a plan year's conversion, at most limit
+func (c CashFlowConfig) rothConversion(flow YearCashFlow, traditional, inflationFactor, limit float64) float64 {
+	if !c.UseRothConversion || !flow.IsRetired || (c.RothConversionEndAge > 0 && flow.Age >= c.RothConversionEndAge) {
+		return 0
+	}
+	amount := math.Min(c.RothConversionAmount*inflationFactor, traditional-flow.RequiredMinimumDistribution)
+	return math.Max(0, math.Min(amount, limit))
+}
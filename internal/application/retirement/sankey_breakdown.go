@@ -0,0 +1,117 @@
+package retirement
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// =============================================================================
+// Sankey Breakdowns
+// =============================================================================
+
+// Besides the accumulation and retirement totals, flows can be diagrammed
+// for each year or each decade of age, so a diagram can be drawn for any
+// part of the plan. Decades run from ages ending in 0 to ages ending in 9,
+// the first and last cut short by the analysis's ages.
+
+// SankeyGranularity is how finely a breakdown divides the analysis
+type SankeyGranularity string
+
+const (
+	SankeyByYear   SankeyGranularity = "year"
+	SankeyByDecade SankeyGranularity = "decade"
+)
+
+// SankeyPeriod is a Sankey diagram of the analysis's years from StartAge
+// through EndAge
+type SankeyPeriod struct {
+	Label    string
+	StartAge int
+	EndAge   int
+
+	Sankey SankeyData
+
+	// Notes are annotations on the period's years, exported with it
+	Notes []string
+}
+
+// GenerateSankeyBreakdown diagrams each year or decade of yearlyFlows whose
+// ages are between fromAge and toAge (0 for no bound)
+func (s *CashFlowService) GenerateSankeyBreakdown(yearlyFlows []YearCashFlow, granularity SankeyGranularity, fromAge, toAge int) ([]SankeyPeriod, error) {
+	var width int
+	switch granularity {
+	case SankeyByYear:
+		width = 1
+	case SankeyByDecade:
+		width = 10
+	default:
+		return nil, fmt.Errorf("unknown Sankey granularity %q", granularity)
+	}
+
+	var periods []SankeyPeriod
+	var years []YearCashFlow
+	flush := func() {
+		if len(years) == 0 {
+			return
+		}
+		period := SankeyPeriod{
+			StartAge: years[0].Age,
+			EndAge:   years[len(years)-1].Age,
+			Sankey:   s.GenerateSankeyDataForYears(years),
+		}
+		period.Label = "Age " + strconv.Itoa(period.StartAge)
+		if period.EndAge != period.StartAge {
+			period.Label = fmt.Sprintf("Ages %d-%d", period.StartAge, period.EndAge)
+		}
+		periods = append(periods, period)
+		years = nil
+	}
+	for _, flow := range yearlyFlows {
+		if (fromAge != 0 && flow.Age < fromAge) || (toAge != 0 && flow.Age > toAge) {
+			continue
+		}
+		if len(years) > 0 && flow.Age/width != years[0].Age/width {
+			flush()
+		}
+		years = append(years, flow)
+	}
+	flush()
+	return periods, nil
+}
+
+// WriteSankeyCSV writes periods' diagrams as CSV, one row per link with its
+// period, the labels and categories of its ends, and the period's notes
+func WriteSankeyCSV(w io.Writer, periods []SankeyPeriod) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{
+		"period", "start_age", "end_age",
+		"source", "source_label", "source_category",
+		"target", "target_label", "target_category",
+		"value", "notes",
+	}); err != nil {
+		return err
+	}
+	for _, period := range periods {
+		notes := strings.Join(period.Notes, "; ")
+		nodes := make(map[string]SankeyNode, len(period.Sankey.Nodes))
+		for _, node := range period.Sankey.Nodes {
+			nodes[node.ID] = node
+		}
+		for _, link := range period.Sankey.Links {
+			source, target := nodes[link.Source], nodes[link.Target]
+			if err := writer.Write([]string{
+				period.Label, strconv.Itoa(period.StartAge), strconv.Itoa(period.EndAge),
+				link.Source, source.Label, string(source.Category),
+				link.Target, target.Label, string(target.Category),
+				strconv.FormatFloat(link.Value, 'f', 2, 64), notes,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
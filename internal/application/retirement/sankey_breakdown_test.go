@@ -0,0 +1,81 @@
+package retirement
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateSankeyBreakdown(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+	results, err := service.RunAnalysis()
+	require.NoError(t, err)
+
+	years, err := service.GenerateSankeyBreakdown(results.YearlyFlows, SankeyByYear, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, years, len(results.YearlyFlows))
+	first := years[0]
+	assert.Equal(t, config.CurrentAge, first.StartAge)
+	assert.Equal(t, first.StartAge, first.EndAge)
+	assert.Equal(t, service.GenerateSankeyDataForYears(results.YearlyFlows[:1]), first.Sankey)
+
+	// Decades run from ages ending in 0, cut short at the ends
+	decades, err := service.GenerateSankeyBreakdown(results.YearlyFlows, SankeyByDecade, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, config.CurrentAge, decades[0].StartAge)
+	assert.Equal(t, config.CurrentAge/10*10+9, decades[0].EndAge)
+	assert.Equal(t, config.LifeExpectancy-1, decades[len(decades)-1].EndAge)
+	for _, decade := range decades[1 : len(decades)-1] {
+		assert.Equal(t, 0, decade.StartAge%10)
+		assert.Equal(t, decade.StartAge+9, decade.EndAge)
+		assert.Equal(t, fmt.Sprintf("Ages %d-%d", decade.StartAge, decade.EndAge), decade.Label)
+	}
+
+	// Selected ages only
+	one, err := service.GenerateSankeyBreakdown(results.YearlyFlows, SankeyByYear, config.RetirementAge, config.RetirementAge)
+	require.NoError(t, err)
+	require.Len(t, one, 1)
+	assert.Equal(t, config.RetirementAge, one[0].StartAge)
+
+	_, err = service.GenerateSankeyBreakdown(results.YearlyFlows, "month", 0, 0)
+	assert.Error(t, err)
+
+	// Phase totals are unchanged
+	var retired []YearCashFlow
+	for _, flow := range results.YearlyFlows {
+		if flow.IsRetired {
+			retired = append(retired, flow)
+		}
+	}
+	assert.Equal(t, service.GenerateSankeyDataForYears(retired), results.RetirementSankey)
+}
+
+func TestWriteSankeyCSV(t *testing.T) {
+	periods := []SankeyPeriod{{
+		Label:    "Age 40",
+		StartAge: 40,
+		EndAge:   40,
+		Sankey: SankeyData{
+			Nodes: []SankeyNode{
+				{ID: "employment", Label: "Employment Income", Category: FlowTypeIncome, Value: 100},
+				{ID: "taxes", Label: "Taxes", Category: FlowTypeTax, Value: 25.5},
+			},
+			Links: []SankeyLink{{Source: "employment", Target: "taxes", Value: 25.5}},
+		},
+		Notes: []string{"Kid starts college", "Sabbatical"},
+	}}
+
+	var out strings.Builder
+	require.NoError(t, WriteSankeyCSV(&out, periods))
+	records, err := csv.NewReader(strings.NewReader(out.String())).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, "period", records[0][0])
+	assert.Equal(t, []string{"Age 40", "40", "40", "employment", "Employment Income", string(FlowTypeIncome), "taxes", "Taxes", string(FlowTypeTax), "25.50", "Kid starts college; Sabbatical"}, records[1])
+}
@@ -0,0 +1,126 @@
+package retirement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// =============================================================================
+// Scenario Batches
+// =============================================================================
+
+// A batch compares up to MaxBatchScenarios alternative plans side by side.
+// Each scenario is projected once and simulated with the same Monte Carlo
+// settings and seed, so differences between them come from the plans and
+// not from the market draws. Scenarios run concurrently; the first to fail
+// stops the rest.
+
+// MaxBatchScenarios is the most scenarios a batch compares
+const MaxBatchScenarios = 10
+
+// BatchScenario is one plan in a batch
+type BatchScenario struct {
+	Name   string
+	Config CashFlowConfig
+}
+
+// ScenarioMetrics are a scenario's key figures for comparison
+type ScenarioMetrics struct {
+	Name string
+
+	// SuccessProbability and MedianFinalPortfolio come from simulation,
+	// the rest from the projection
+	SuccessProbability   float64
+	MedianFinalPortfolio float64
+
+	Outcome StrategyOutcome
+}
+
+// RunScenarioBatch projects and simulates each scenario, running up to
+// workers at a time (4 when 0). progress, when set, is called as each
+// scenario finishes. Metrics are in the order of scenarios.
+func RunScenarioBatch(ctx context.Context, scenarios []BatchScenario, mc CashFlowMonteCarloConfig, workers int, progress func(completed, total int)) ([]ScenarioMetrics, error) {
+	if len(scenarios) == 0 {
+		return nil, errors.New("a batch needs at least one scenario")
+	}
+	if len(scenarios) > MaxBatchScenarios {
+		return nil, fmt.Errorf("a batch compares at most %d scenarios", MaxBatchScenarios)
+	}
+	if workers <= 0 {
+		workers = 4
+	}
+	if mc.Seed == 0 {
+		mc.Seed = time.Now().UnixNano()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	metrics := make([]ScenarioMetrics, len(scenarios))
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		firstErr  error
+		completed int
+	)
+	slots := make(chan struct{}, workers)
+	for i, scenario := range scenarios {
+		wg.Add(1)
+		go func(i int, scenario BatchScenario) {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			result, err := runBatchScenario(ctx, scenario, mc)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			metrics[i] = result
+			completed++
+			if progress != nil {
+				progress(completed, len(scenarios))
+			}
+		}(i, scenario)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return metrics, nil
+}
+
+// runBatchScenario projects and simulates one scenario
+func runBatchScenario(ctx context.Context, scenario BatchScenario, mc CashFlowMonteCarloConfig) (ScenarioMetrics, error) {
+	if err := ctx.Err(); err != nil {
+		return ScenarioMetrics{}, err
+	}
+	service, err := NewCashFlowService(scenario.Config)
+	if err != nil {
+		return ScenarioMetrics{}, fmt.Errorf("scenario %q: %w", scenario.Name, err)
+	}
+	results, err := service.RunAnalysis()
+	if err != nil {
+		return ScenarioMetrics{}, fmt.Errorf("scenario %q: %w", scenario.Name, err)
+	}
+	simulation, err := service.RunMonteCarlo(ctx, scenario.Config, mc)
+	if err != nil {
+		return ScenarioMetrics{}, fmt.Errorf("scenario %q: %w", scenario.Name, err)
+	}
+	return ScenarioMetrics{
+		Name:                 scenario.Name,
+		SuccessProbability:   simulation.SuccessProbability,
+		MedianFinalPortfolio: simulation.FinalPercentiles.P50,
+		Outcome:              strategyOutcome(results),
+	}, nil
+}
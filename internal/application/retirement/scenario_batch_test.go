@@ -0,0 +1,73 @@
+package retirement
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunScenarioBatch(t *testing.T) {
+	base := DefaultCashFlowConfig()
+	later := base
+	later.RetirementAge = base.RetirementAge + 3
+	frugal := base
+	frugal.DiscretionaryExpense = base.DiscretionaryExpense * 0.5
+
+	mc := DefaultCashFlowMonteCarloConfig()
+	mc.Iterations = 200
+	mc.Seed = 7
+
+	scenarios := []BatchScenario{
+		{Name: "base", Config: base},
+		{Name: "retire later", Config: later},
+		{Name: "spend less", Config: frugal},
+	}
+	var calls []int
+	metrics, err := RunScenarioBatch(context.Background(), scenarios, mc, 2, func(completed, total int) {
+		assert.Equal(t, 3, total)
+		calls = append(calls, completed)
+	})
+	require.NoError(t, err)
+	require.Len(t, metrics, 3)
+	assert.Equal(t, []int{1, 2, 3}, calls)
+
+	// Metrics keep the scenarios' order, and match running each alone
+	for i, scenario := range scenarios {
+		assert.Equal(t, scenario.Name, metrics[i].Name)
+		alone, err := RunScenarioBatch(context.Background(), scenarios[i:i+1], mc, 1, nil)
+		require.NoError(t, err)
+		assert.Equal(t, alone[0], metrics[i])
+	}
+	assert.Greater(t, metrics[2].Outcome.FinalPortfolio, metrics[0].Outcome.FinalPortfolio)
+	assert.GreaterOrEqual(t, metrics[2].SuccessProbability, metrics[0].SuccessProbability)
+}
+
+func TestRunScenarioBatchErrors(t *testing.T) {
+	mc := DefaultCashFlowMonteCarloConfig()
+	mc.Iterations = 20
+
+	_, err := RunScenarioBatch(context.Background(), nil, mc, 0, nil)
+	assert.Error(t, err)
+
+	tooMany := make([]BatchScenario, MaxBatchScenarios+1)
+	for i := range tooMany {
+		tooMany[i].Config = DefaultCashFlowConfig()
+	}
+	_, err = RunScenarioBatch(context.Background(), tooMany, mc, 0, nil)
+	assert.Error(t, err)
+
+	invalid := DefaultCashFlowConfig()
+	invalid.LifeExpectancy = invalid.CurrentAge
+	_, err = RunScenarioBatch(context.Background(), []BatchScenario{
+		{Name: "base", Config: DefaultCashFlowConfig()},
+		{Name: "broken", Config: invalid},
+	}, mc, 0, nil)
+	assert.ErrorContains(t, err, `scenario "broken"`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = RunScenarioBatch(ctx, tooMany[:2], mc, 0, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+}
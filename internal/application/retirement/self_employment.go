@@ -0,0 +1,142 @@
+package retirement
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// =============================================================================
+// Self-Employment and Estimated Tax
+// =============================================================================
+
+// Self-employment income pays both halves of Social Security and Medicare
+// tax on 92.35% of net earnings (net of the employer half), with the
+// Social Security part capped at the wage base left over after wages. Half
+// of it is deducted from income. The qualified business income (QBI)
+// deduction is approximated as its rate on business income, limited to
+// taxable income and phased out above the threshold as for a specified
+// service business; wage and property limits aren't modeled.
+//
+// Tax not withheld from wages and other income is paid in four equal
+// estimated payments, enough to meet the smaller of the current and prior
+// year safe harbors. Tax on everything but self-employment income is taken
+// to be withheld.
+
+// QBIRules are a year's qualified business income deduction limits
+type QBIRules struct {
+	Rate         float64 `json:"rate"`
+	Threshold    float64 `json:"threshold"`
+	PhaseInRange float64 `json:"phase_in_range"`
+}
+
+// EstimatedTaxRules are a year's estimated tax safe harbors: paying
+// CurrentYearShare of this year's tax or PriorYearShare of last year's,
+// HighIncomePriorYearShare when last year's AGI was over HighIncomeThreshold.
+// No payments are due when less than MinimumBalanceDue isn't withheld.
+type EstimatedTaxRules struct {
+	CurrentYearShare         float64 `json:"current_year_share"`
+	PriorYearShare           float64 `json:"prior_year_share"`
+	HighIncomePriorYearShare float64 `json:"high_income_prior_year_share"`
+	HighIncomeThreshold      float64 `json:"high_income_threshold"`
+	MinimumBalanceDue        float64 `json:"minimum_balance_due"`
+}
+
+// validateSelfEmployment checks the QBI rate and estimated tax shares are
+// plausible
+func (d *TaxYearData) validateSelfEmployment() error {
+	if d.QBIDeduction.Rate < 0 || d.QBIDeduction.Rate > 1 || d.QBIDeduction.PhaseInRange < 0 {
+		return errors.New("qbi_deduction rate must be between 0 and 1 and phase_in_range non-negative")
+	}
+	rules := d.EstimatedTax
+	for _, share := range []float64{rules.CurrentYearShare, rules.PriorYearShare, rules.HighIncomePriorYearShare} {
+		if share < 0 || share > 2 {
+			return errors.New("estimated_tax shares must be between 0 and 2")
+		}
+	}
+	return nil
+}
+
+// SelfEmploymentTax returns the Social Security and Medicare tax on net
+// self-employment earnings for someone who also has wages, and the part of
+// it deducted from income
+func (d *TaxYearData) SelfEmploymentTax(netEarnings, wages float64) (tax, deductible float64) {
+	if netEarnings <= 0 {
+		return 0, 0
+	}
+	earnings := netEarnings * (1 - d.SocialSecurityTaxRate - d.MedicareTaxRate)
+	socialSecurity := math.Min(earnings, math.Max(0, d.SocialSecurityWageBase-wages)) * 2 * d.SocialSecurityTaxRate
+	medicare := earnings * 2 * d.MedicareTaxRate
+
+	// Additional Medicare tax applies to what wages leave over the threshold
+	additional := math.Max(0, wages+earnings-math.Max(d.AdditionalMedicareThreshold, wages)) * d.AdditionalMedicareTaxRate
+
+	return socialSecurity + medicare + additional, (socialSecurity + medicare) / 2
+}
+
+// QualifiedBusinessIncomeDeduction returns the QBI deduction on qualified
+// business income given taxable income before the deduction
+func (d *TaxYearData) QualifiedBusinessIncomeDeduction(qbi, taxableIncome float64) float64 {
+	rules := d.QBIDeduction
+	if qbi <= 0 || taxableIncome <= 0 {
+		return 0
+	}
+	deduction := rules.Rate * math.Min(qbi, taxableIncome)
+	if taxableIncome > rules.Threshold {
+		if rules.PhaseInRange <= 0 {
+			return 0
+		}
+		deduction *= math.Max(0, 1-(taxableIncome-rules.Threshold)/rules.PhaseInRange)
+	}
+	return deduction
+}
+
+// EstimatedTaxDue returns a year's estimated tax payments given its federal
+// income and self-employment tax, how much of it is withheld and the
+// previous year, nil in the first year
+func (d *TaxYearData) EstimatedTaxDue(liability, withheld float64, prior *YearCashFlow) float64 {
+	rules := d.EstimatedTax
+	if liability-withheld < rules.MinimumBalanceDue {
+		return 0
+	}
+	required := liability * rules.CurrentYearShare
+	if prior != nil {
+		share := rules.PriorYearShare
+		if prior.MAGI > rules.HighIncomeThreshold {
+			share = rules.HighIncomePriorYearShare
+		}
+		required = math.Min(required, (prior.FederalTax+prior.SelfEmploymentTax)*share)
+	}
+	return math.Max(0, required-withheld)
+}
+
+// EstimatedTaxPayment is one quarter's estimated tax payment
+type EstimatedTaxPayment struct {
+	Quarter int
+	DueDate time.Time
+	Amount  float64
+}
+
+// EstimatedTaxSchedule splits a year's estimated tax into four equal
+// payments, due April 15, June 15 and September 15 of the tax year and
+// January 15 of the next. Due dates aren't moved off weekends and holidays.
+func EstimatedTaxSchedule(annual float64, taxYear int) []EstimatedTaxPayment {
+	if annual <= 0 {
+		return nil
+	}
+	dueDates := []time.Time{
+		time.Date(taxYear, time.April, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(taxYear, time.June, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(taxYear, time.September, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(taxYear+1, time.January, 15, 0, 0, 0, 0, time.UTC),
+	}
+	payments := make([]EstimatedTaxPayment, len(dueDates))
+	for i, due := range dueDates {
+		payments[i] = EstimatedTaxPayment{
+			Quarter: i + 1,
+			DueDate: due,
+			Amount:  annual / float64(len(dueDates)),
+		}
+	}
+	return payments
+}
@@ -0,0 +1,106 @@
+package retirement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelfEmploymentTax(t *testing.T) {
+	year, ok := DefaultTaxData().Get(2024)
+	require.True(t, ok)
+
+	// 100,000 of net earnings is 92,350 of SE earnings at 15.3%
+	tax, deductible := year.SelfEmploymentTax(100000, 0)
+	assert.InDelta(t, 92350*0.153, tax, 1e-6)
+	assert.InDelta(t, tax/2, deductible, 1e-6)
+
+	// Wages over the wage base leave only Medicare, plus the additional
+	// Medicare tax over the threshold
+	tax, deductible = year.SelfEmploymentTax(100000, 250000)
+	assert.InDelta(t, 92350*0.029+92350*0.009, tax, 1e-6)
+	assert.InDelta(t, 92350*0.029/2, deductible, 1e-6)
+
+	tax, _ = year.SelfEmploymentTax(0, 50000)
+	assert.Equal(t, 0.0, tax)
+}
+
+func TestQualifiedBusinessIncomeDeduction(t *testing.T) {
+	year, ok := DefaultTaxData().Get(2024)
+	require.True(t, ok)
+
+	assert.InDelta(t, 16000, year.QualifiedBusinessIncomeDeduction(80000, 150000), 1e-9)
+	// Limited to 20% of taxable income
+	assert.InDelta(t, 10000, year.QualifiedBusinessIncomeDeduction(80000, 50000), 1e-9)
+	// Half phased out halfway through the phase-in range
+	assert.InDelta(t, 40000, year.QualifiedBusinessIncomeDeduction(400000, 433900), 1e-9)
+	assert.Equal(t, 0.0, year.QualifiedBusinessIncomeDeduction(400000, 500000))
+}
+
+func TestEstimatedTaxDue(t *testing.T) {
+	year, ok := DefaultTaxData().Get(2024)
+	require.True(t, ok)
+
+	// Under the minimum balance nothing is due
+	assert.Equal(t, 0.0, year.EstimatedTaxDue(20500, 20000, nil))
+
+	// The first year pays 90% of this year's tax
+	assert.InDelta(t, 0.9*40000-10000, year.EstimatedTaxDue(40000, 10000, nil), 1e-9)
+
+	// Last year's tax is the smaller safe harbor, 110% of it at high income
+	prior := &YearCashFlow{FederalTax: 20000, SelfEmploymentTax: 10000, MAGI: 100000}
+	assert.InDelta(t, 30000-10000, year.EstimatedTaxDue(40000, 10000, prior), 1e-9)
+	prior.MAGI = 200000
+	assert.InDelta(t, 33000-10000, year.EstimatedTaxDue(40000, 10000, prior), 1e-9)
+}
+
+func TestEstimatedTaxSchedule(t *testing.T) {
+	assert.Nil(t, EstimatedTaxSchedule(0, 2025))
+
+	schedule := EstimatedTaxSchedule(10000, 2025)
+	require.Len(t, schedule, 4)
+	assert.Equal(t, time.Date(2025, time.April, 15, 0, 0, 0, 0, time.UTC), schedule[0].DueDate)
+	assert.Equal(t, time.Date(2026, time.January, 15, 0, 0, 0, 0, time.UTC), schedule[3].DueDate)
+	for i, payment := range schedule {
+		assert.Equal(t, i+1, payment.Quarter)
+		assert.Equal(t, 2500.0, payment.Amount)
+	}
+}
+
+func TestCashFlowSelfEmployment(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	// The same income earned as a contractor pays both FICA halves, less
+	// the QBI deduction, and is paid in estimated payments
+	contractor := config
+	contractor.SelfEmploymentIncome = config.EmploymentIncome
+	contractor.EmploymentIncome = 0
+	employee, err := service.RunAnalysisWithConfig(config)
+	require.NoError(t, err)
+	results, err := service.RunAnalysisWithConfig(contractor)
+	require.NoError(t, err)
+
+	first := results.YearlyFlows[0]
+	assert.Equal(t, config.EmploymentIncome, first.SelfEmploymentIncome)
+	assert.Equal(t, employee.YearlyFlows[0].TotalIncome, first.TotalIncome)
+	assert.Greater(t, first.FICATax, employee.YearlyFlows[0].FICATax)
+	assert.InDelta(t, first.SelfEmploymentTax, first.FICATax, 1e-9)
+	assert.Positive(t, first.QBIDeduction)
+	assert.Positive(t, first.EstimatedTaxDue)
+	assert.Positive(t, first.MAGI)
+
+	// Employees have their tax withheld
+	assert.Equal(t, 0.0, employee.YearlyFlows[0].EstimatedTaxDue)
+
+	// Self-employment stops at retirement
+	for _, flow := range results.YearlyFlows {
+		if flow.IsRetired {
+			assert.Equal(t, 0.0, flow.SelfEmploymentIncome, "age %d", flow.Age)
+			assert.Equal(t, 0.0, flow.SelfEmploymentTax, "age %d", flow.Age)
+		}
+	}
+}
@@ -0,0 +1,403 @@
+package retirement
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// =============================================================================
+// Social Security Benefit Estimation
+// =============================================================================
+
+// Benefits follow the SSA formula: earnings are wage-indexed to the year the
+// worker turns 60, the highest 35 years are averaged into the AIME, and the
+// PIA is 90/32/15% of the AIME across the bend points. Claiming before full
+// retirement age reduces the benefit; claiming after it earns delayed
+// retirement credits up to age 70. Wages and the taxable maximum are held at
+// their latest published values for future years, so estimates are in
+// today's dollars like the rest of CashFlowConfig.
+
+const (
+	// EarliestClaimingAge is the earliest age retirement benefits can start
+	EarliestClaimingAge = 62
+	// LatestClaimingAge is the age delayed retirement credits stop accruing
+	LatestClaimingAge = 70
+
+	// careerStartAge is the age earnings are assumed to start from when only
+	// a current salary is given
+	careerStartAge = 22
+	// benefitComputationYears is how many of the highest indexed years of
+	// earnings are averaged
+	benefitComputationYears = 35
+	// minBirthYear is the earliest birth year the wage index covers
+	minBirthYear = 1930
+)
+
+// awiFirstYear is the first year of averageWageIndex
+const awiFirstYear = 1951
+
+// averageWageIndex is the SSA national average wage index from 1951
+var averageWageIndex = []float64{
+	2799.16, 2973.32, 3139.44, 3155.64, 3301.44, 3532.36, 3641.72, 3673.80, 3855.80, // 1951-1959
+	4007.12, 4086.76, 4291.40, 4396.64, 4576.32, 4658.72, 4938.36, 5213.44, 5571.76, 5893.76, // 1960-1969
+	6186.24, 6497.08, 7133.80, 7580.16, 8030.76, 8630.92, 9226.48, 9779.44, 10556.03, 11479.46, // 1970-1979
+	12513.46, 13773.10, 14531.34, 15239.24, 16135.07, 16822.51, 17321.82, 18426.51, 19334.04, 20099.55, // 1980-1989
+	21027.98, 21811.60, 22935.42, 23132.67, 23753.53, 24705.66, 25913.90, 27426.00, 28861.44, 30469.84, // 1990-1999
+	32154.82, 32921.92, 33252.09, 34064.95, 35648.55, 36952.94, 38651.41, 40405.48, 41334.97, 40711.61, // 2000-2009
+	41673.83, 42979.61, 44321.67, 44888.16, 46481.52, 48098.63, 48642.15, 50321.89, 52145.80, 54099.99, // 2010-2019
+	55628.60, 60575.07, 63795.13, 66621.80, // 2020-2023
+}
+
+// taxableMaximum is the most earnings subject to Social Security tax each
+// year from 1951
+var taxableMaximum = []float64{
+	3600, 3600, 3600, 3600, 4200, 4200, 4200, 4200, 4800, // 1951-1959
+	4800, 4800, 4800, 4800, 4800, 4800, 6600, 6600, 7800, 7800, // 1960-1969
+	7800, 7800, 9000, 10800, 13200, 14100, 15300, 16500, 17700, 22900, // 1970-1979
+	25900, 29700, 32400, 35700, 37800, 39600, 42000, 43800, 45000, 48000, // 1980-1989
+	51300, 53400, 55500, 57600, 60600, 61200, 62700, 65400, 68400, 72600, // 1990-1999
+	76200, 80400, 84900, 87000, 87900, 90000, 94200, 97500, 102000, 106800, // 2000-2009
+	106800, 106800, 110100, 113700, 117000, 118500, 118500, 127200, 128400, 132900, // 2010-2019
+	137700, 142800, 147000, 160200, 168600, 176100, // 2020-2025
+}
+
+// bendPointBaseYear is the year the 1979 formula's bend points of $180 and
+// $1,085 are scaled from
+const bendPointBaseYear = 1977
+
+// indexedValue returns the value in table for year, using the first or last
+// value for years outside it
+func indexedValue(table []float64, year int) float64 {
+	i := min(max(year-awiFirstYear, 0), len(table)-1)
+	return table[i]
+}
+
+// EarningsRecord is a year of earnings covered by Social Security
+type EarningsRecord struct {
+	Year     int
+	Earnings float64
+}
+
+// SocialSecurityEarner describes a worker whose benefit is estimated
+type SocialSecurityEarner struct {
+	BirthYear int
+
+	// EarningsHistory holds past covered earnings in nominal dollars
+	EarningsHistory []EarningsRecord
+
+	// CurrentSalary, in today's dollars, is projected from the current year
+	// until StopWorkingAge with real SalaryGrowth a year. Without an earnings
+	// history it is also backfilled to age 22 at the same growth.
+	CurrentSalary  float64
+	SalaryGrowth   float64
+	StopWorkingAge int // 0 works until ClaimingAge
+
+	// ClaimingAge is when benefits start, 62-70; 0 claims at full retirement
+	// age rounded up to a whole year
+	ClaimingAge int
+}
+
+// SocialSecurityInput is a worker and an optional spouse, as of CurrentYear
+type SocialSecurityInput struct {
+	Worker      SocialSecurityEarner
+	Spouse      *SocialSecurityEarner
+	CurrentYear int
+}
+
+// ClaimingOption is the benefit for claiming at one age
+type ClaimingOption struct {
+	Age             int
+	AdjustmentRatio float64
+	MonthlyBenefit  float64
+	AnnualBenefit   float64
+}
+
+// EarnerBenefit is the benefit estimate for one earner
+type EarnerBenefit struct {
+	// AIME is the average indexed monthly earnings; PIA the primary insurance
+	// amount, the monthly benefit at full retirement age
+	AIME       float64
+	PIA        float64
+	BendPoints [2]float64
+
+	// FullRetirementAgeMonths is the full retirement age in months
+	FullRetirementAgeMonths int
+	ClaimingAge             int
+
+	// AdjustmentRatio is the benefit at ClaimingAge as a fraction of PIA,
+	// under 1 for early claiming and over 1 with delayed retirement credits
+	AdjustmentRatio float64
+	MonthlyBenefit  float64
+
+	// MonthlySpousalBenefit is the top-up on the other spouse's record, up to
+	// half their PIA less this earner's own
+	MonthlySpousalBenefit float64
+	AnnualBenefit         float64
+
+	// ClaimingOptions shows the own benefit at every age from 62 to 70
+	ClaimingOptions []ClaimingOption
+}
+
+// SocialSecurityEstimate is the estimated household benefit
+type SocialSecurityEstimate struct {
+	Worker EarnerBenefit
+	Spouse *EarnerBenefit
+
+	// HouseholdAnnualBenefit is both earners' own and spousal benefits a year
+	HouseholdAnnualBenefit float64
+}
+
+// ApplyTo feeds the estimate into config as the household benefit, starting
+// at the worker's claiming age. A config planning for the spouse jointly
+// gets each earner's benefit at their own claiming age instead.
+func (e *SocialSecurityEstimate) ApplyTo(config *CashFlowConfig) {
+	if e.Spouse != nil && config.Spouse != nil {
+		config.SocialSecurityBenefit = e.Worker.AnnualBenefit
+		config.SocialSecurityStartAge = e.Worker.ClaimingAge
+		spouse := *config.Spouse
+		spouse.SocialSecurityBenefit = e.Spouse.AnnualBenefit
+		spouse.SocialSecurityStartAge = e.Spouse.ClaimingAge
+		config.Spouse = &spouse
+		return
+	}
+	config.SocialSecurityBenefit = e.HouseholdAnnualBenefit
+	config.SocialSecurityStartAge = e.Worker.ClaimingAge
+}
+
+// Validate checks the input is complete and in range
+func (in SocialSecurityInput) Validate() error {
+	if in.CurrentYear < awiFirstYear {
+		return errors.New("CurrentYear is required")
+	}
+	if err := in.Worker.validate(in.CurrentYear); err != nil {
+		return err
+	}
+	if in.Spouse != nil {
+		if err := in.Spouse.validate(in.CurrentYear); err != nil {
+			return errors.New("spouse: " + err.Error())
+		}
+	}
+	return nil
+}
+
+func (e SocialSecurityEarner) validate(currentYear int) error {
+	if e.BirthYear < minBirthYear || e.BirthYear > currentYear {
+		return errors.New("BirthYear is out of range")
+	}
+	if e.ClaimingAge != 0 && (e.ClaimingAge < EarliestClaimingAge || e.ClaimingAge > LatestClaimingAge) {
+		return errors.New("ClaimingAge must be between 62 and 70")
+	}
+	if e.CurrentSalary < 0 {
+		return errors.New("CurrentSalary cannot be negative")
+	}
+	if e.SalaryGrowth < -1 || e.SalaryGrowth > 1 {
+		return errors.New("SalaryGrowth must be between -1 and 1")
+	}
+	if e.StopWorkingAge < 0 || e.StopWorkingAge > 100 {
+		return errors.New("StopWorkingAge must be between 0 and 100")
+	}
+	for _, record := range e.EarningsHistory {
+		if record.Earnings < 0 {
+			return errors.New("earnings cannot be negative")
+		}
+		if record.Year > currentYear {
+			return errors.New("earnings history cannot include future years")
+		}
+	}
+	return nil
+}
+
+// EstimateSocialSecurity estimates the worker's and spouse's benefits from
+// their earnings
+func EstimateSocialSecurity(in SocialSecurityInput) (*SocialSecurityEstimate, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
+	worker := estimateEarner(in.Worker, in.CurrentYear)
+	estimate := &SocialSecurityEstimate{Worker: worker}
+	if in.Spouse != nil {
+		spouse := estimateEarner(*in.Spouse, in.CurrentYear)
+		estimate.Worker.MonthlySpousalBenefit = SpousalBenefit(worker.PIA, spouse.PIA, worker.ClaimingAge*12-worker.FullRetirementAgeMonths)
+		spouse.MonthlySpousalBenefit = SpousalBenefit(spouse.PIA, worker.PIA, spouse.ClaimingAge*12-spouse.FullRetirementAgeMonths)
+		estimate.Spouse = &spouse
+	}
+
+	for _, earner := range []*EarnerBenefit{&estimate.Worker, estimate.Spouse} {
+		if earner == nil {
+			continue
+		}
+		earner.AnnualBenefit = (earner.MonthlyBenefit + earner.MonthlySpousalBenefit) * 12
+		estimate.HouseholdAnnualBenefit += earner.AnnualBenefit
+	}
+	return estimate, nil
+}
+
+// estimateEarner estimates one earner's own benefit
+func estimateEarner(e SocialSecurityEarner, currentYear int) EarnerBenefit {
+	fra := FullRetirementAgeMonths(e.BirthYear)
+	claimingAge := e.ClaimingAge
+	if claimingAge == 0 {
+		claimingAge = (fra + 11) / 12
+	}
+
+	aime := CalculateAIME(e.BirthYear, e.earnings(currentYear, claimingAge))
+	bendPoints := BendPoints(e.BirthYear + EarliestClaimingAge)
+	pia := CalculatePIA(aime, bendPoints)
+
+	benefit := EarnerBenefit{
+		AIME:                    aime,
+		PIA:                     pia,
+		BendPoints:              bendPoints,
+		FullRetirementAgeMonths: fra,
+		ClaimingAge:             claimingAge,
+		ClaimingOptions:         make([]ClaimingOption, 0, LatestClaimingAge-EarliestClaimingAge+1),
+	}
+	for age := EarliestClaimingAge; age <= LatestClaimingAge; age++ {
+		ratio := ClaimingAdjustment(age*12 - fra)
+		monthly := math.Floor(pia * ratio)
+		benefit.ClaimingOptions = append(benefit.ClaimingOptions, ClaimingOption{
+			Age:             age,
+			AdjustmentRatio: ratio,
+			MonthlyBenefit:  monthly,
+			AnnualBenefit:   monthly * 12,
+		})
+		if age == claimingAge {
+			benefit.AdjustmentRatio = ratio
+			benefit.MonthlyBenefit = monthly
+		}
+	}
+	return benefit
+}
+
+// earnings returns the earner's history with the projected salary added for
+// the current and future working years
+func (e SocialSecurityEarner) earnings(currentYear, claimingAge int) []EarningsRecord {
+	byYear := make(map[int]float64, len(e.EarningsHistory))
+	for _, record := range e.EarningsHistory {
+		byYear[record.Year] += record.Earnings
+	}
+
+	if e.CurrentSalary > 0 {
+		stopAge := e.StopWorkingAge
+		if stopAge == 0 {
+			stopAge = claimingAge
+		}
+		latestAWI := averageWageIndex[len(averageWageIndex)-1]
+
+		first := currentYear
+		if len(e.EarningsHistory) == 0 {
+			first = e.BirthYear + careerStartAge
+		}
+		for year := first; year < e.BirthYear+stopAge; year++ {
+			if _, ok := byYear[year]; ok {
+				continue
+			}
+			// Past salaries are taken to have kept pace with wages, so they
+			// are deflated by the wage index back to their year
+			salary := e.CurrentSalary * math.Pow(1+e.SalaryGrowth, float64(year-currentYear))
+			byYear[year] = salary * indexedValue(averageWageIndex, year) / latestAWI
+		}
+	}
+
+	records := make([]EarningsRecord, 0, len(byYear))
+	for year, earnings := range byYear {
+		records = append(records, EarningsRecord{Year: year, Earnings: earnings})
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Year < records[j].Year })
+	return records
+}
+
+// CalculateAIME returns the average indexed monthly earnings: each year's
+// earnings, capped at the taxable maximum and indexed to the year the worker
+// turns 60, averaged over the highest 35 years and rounded down to the dollar
+func CalculateAIME(birthYear int, earnings []EarningsRecord) float64 {
+	indexYear := birthYear + 60
+	indexed := make([]float64, 0, len(earnings))
+	for _, record := range earnings {
+		if record.Year < awiFirstYear {
+			continue
+		}
+		amount := min(record.Earnings, indexedValue(taxableMaximum, record.Year))
+		if record.Year < indexYear {
+			amount *= indexedValue(averageWageIndex, indexYear) / indexedValue(averageWageIndex, record.Year)
+		}
+		indexed = append(indexed, amount)
+	}
+
+	sort.Sort(sort.Reverse(sort.Float64Slice(indexed)))
+	total := 0.0
+	for _, amount := range indexed[:min(len(indexed), benefitComputationYears)] {
+		total += amount
+	}
+	return math.Floor(total / (benefitComputationYears * 12))
+}
+
+// BendPoints returns the PIA formula bend points for workers first eligible,
+// at 62, in eligibilityYear, rounded to the dollar
+func BendPoints(eligibilityYear int) [2]float64 {
+	scale := indexedValue(averageWageIndex, eligibilityYear-2) / indexedValue(averageWageIndex, bendPointBaseYear)
+	return [2]float64{math.Round(180 * scale), math.Round(1085 * scale)}
+}
+
+// CalculatePIA returns the primary insurance amount for aime: 90% up to the
+// first bend point, 32% up to the second and 15% above, rounded down to the
+// dime
+func CalculatePIA(aime float64, bendPoints [2]float64) float64 {
+	pia := 0.90 * min(aime, bendPoints[0])
+	if aime > bendPoints[0] {
+		pia += 0.32 * (min(aime, bendPoints[1]) - bendPoints[0])
+	}
+	if aime > bendPoints[1] {
+		pia += 0.15 * (aime - bendPoints[1])
+	}
+	return math.Floor(pia*10) / 10
+}
+
+// FullRetirementAgeMonths returns the full retirement age, in months, for
+// those born in birthYear
+func FullRetirementAgeMonths(birthYear int) int {
+	switch {
+	case birthYear <= 1937:
+		return 65 * 12
+	case birthYear <= 1942:
+		return 65*12 + 2*(birthYear-1937)
+	case birthYear <= 1954:
+		return 66 * 12
+	case birthYear <= 1959:
+		return 66*12 + 2*(birthYear-1954)
+	default:
+		return 67 * 12
+	}
+}
+
+// ClaimingAdjustment returns the benefit as a fraction of PIA for claiming
+// monthsFromFRA months after full retirement age (negative for before): 5/9%
+// off a month for the first 36 months early and 5/12% for the rest, or 2/3%
+// on a month delayed
+func ClaimingAdjustment(monthsFromFRA int) float64 {
+	if monthsFromFRA >= 0 {
+		return 1 + float64(monthsFromFRA)*2.0/3/100
+	}
+	early := -monthsFromFRA
+	return 1 - float64(min(early, 36))*5.0/9/100 - float64(max(early-36, 0))*5.0/12/100
+}
+
+// SpousalBenefit returns the monthly spousal top-up for an earner with own
+// PIA claiming monthsFromFRA months after full retirement age: half the
+// other spouse's PIA less the earner's own, reduced 25/36% a month for the
+// first 36 months early and 5/12% for the rest. Delaying past full
+// retirement age doesn't increase it.
+func SpousalBenefit(ownPIA, otherPIA float64, monthsFromFRA int) float64 {
+	excess := otherPIA/2 - ownPIA
+	if excess <= 0 {
+		return 0
+	}
+	ratio := 1.0
+	if monthsFromFRA < 0 {
+		early := -monthsFromFRA
+		ratio = 1 - float64(min(early, 36))*25.0/36/100 - float64(max(early-36, 0))*5.0/12/100
+	}
+	return math.Floor(excess * ratio)
+}
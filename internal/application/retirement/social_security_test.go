@@ -0,0 +1,87 @@
+package retirement
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSocialSecurityFormula(t *testing.T) {
+	assert.Equal(t, [2]float64{1226, 7391}, BendPoints(2025))
+	assert.Equal(t, [2]float64{1174, 7078}, BendPoints(2024))
+	assert.Equal(t, 3167.5, CalculatePIA(8000, BendPoints(2025)))
+	assert.Equal(t, 900.0, CalculatePIA(1000, BendPoints(2025)))
+
+	assert.Equal(t, 65*12, FullRetirementAgeMonths(1937))
+	assert.Equal(t, 66*12+6, FullRetirementAgeMonths(1957))
+	assert.Equal(t, 67*12, FullRetirementAgeMonths(1960))
+
+	assert.InDelta(t, 0.70, ClaimingAdjustment(-60), 1e-9)
+	assert.InDelta(t, 0.80, ClaimingAdjustment(-36), 1e-9)
+	assert.InDelta(t, 1.24, ClaimingAdjustment(36), 1e-9)
+
+	assert.Equal(t, 650.0, SpousalBenefit(0, 2000, -60))
+	assert.Equal(t, 400.0, SpousalBenefit(600, 2000, 24))
+	assert.Equal(t, 0.0, SpousalBenefit(1200, 2000, 0))
+
+	// Capped at the 1990 taxable maximum and indexed to 2020
+	aime := CalculateAIME(1960, []EarningsRecord{{Year: 1990, Earnings: 100000}})
+	assert.Equal(t, math.Floor(51300*55628.60/21027.98/420), aime)
+}
+
+func TestEstimateSocialSecurity(t *testing.T) {
+	estimate, err := EstimateSocialSecurity(SocialSecurityInput{
+		CurrentYear: 2025,
+		Worker:      SocialSecurityEarner{BirthYear: 1970, CurrentSalary: 60000},
+		Spouse:      &SocialSecurityEarner{BirthYear: 1972},
+	})
+	require.NoError(t, err)
+
+	// A flat real salary from 22 to 67 indexes to itself every year
+	worker := estimate.Worker
+	assert.Equal(t, 5000.0, worker.AIME)
+	assert.Equal(t, 2311.0, worker.PIA)
+	assert.Equal(t, 67, worker.ClaimingAge)
+	assert.Equal(t, 1.0, worker.AdjustmentRatio)
+	assert.Equal(t, 2311.0, worker.MonthlyBenefit)
+	assert.Zero(t, worker.MonthlySpousalBenefit)
+	require.Len(t, worker.ClaimingOptions, 9)
+	assert.Equal(t, 1617.0, worker.ClaimingOptions[0].MonthlyBenefit)
+	assert.Equal(t, 2865.0, worker.ClaimingOptions[8].MonthlyBenefit)
+
+	require.NotNil(t, estimate.Spouse)
+	assert.Zero(t, estimate.Spouse.PIA)
+	assert.Equal(t, 1155.0, estimate.Spouse.MonthlySpousalBenefit)
+	assert.Equal(t, (2311.0+1155)*12, estimate.HouseholdAnnualBenefit)
+
+	config := DefaultCashFlowConfig()
+	estimate.ApplyTo(&config)
+	assert.Equal(t, estimate.HouseholdAnnualBenefit, config.SocialSecurityBenefit)
+	assert.Equal(t, 67, config.SocialSecurityStartAge)
+	require.NoError(t, validateCashFlowConfig(config))
+
+	// A joint plan gets each earner's benefit separately
+	config.Spouse = &SpouseConfig{CurrentAge: 40, RetirementAge: 65}
+	estimate.ApplyTo(&config)
+	assert.Equal(t, estimate.Worker.AnnualBenefit, config.SocialSecurityBenefit)
+	assert.Equal(t, estimate.Spouse.AnnualBenefit, config.Spouse.SocialSecurityBenefit)
+	assert.Equal(t, estimate.Spouse.ClaimingAge, config.Spouse.SocialSecurityStartAge)
+
+	// Claiming early and stopping work at 55
+	early, err := EstimateSocialSecurity(SocialSecurityInput{
+		CurrentYear: 2025,
+		Worker:      SocialSecurityEarner{BirthYear: 1970, CurrentSalary: 60000, StopWorkingAge: 55, ClaimingAge: 62},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, math.Floor(60000.0*33/35/12), early.Worker.AIME)
+	assert.InDelta(t, 0.70, early.Worker.AdjustmentRatio, 1e-9)
+	assert.Nil(t, early.Spouse)
+
+	_, err = EstimateSocialSecurity(SocialSecurityInput{
+		CurrentYear: 2025,
+		Worker:      SocialSecurityEarner{BirthYear: 1970, ClaimingAge: 61},
+	})
+	assert.Error(t, err)
+}
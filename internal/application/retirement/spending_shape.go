@@ -0,0 +1,226 @@
+package retirement
+
+import (
+	"errors"
+	"math"
+)
+
+// =============================================================================
+// Retirement Spending Shape
+// =============================================================================
+
+// Retirees don't spend a flat inflation-adjusted amount: real spending tends
+// to fall through the active years and rise again late in life with
+// healthcare, the "spending smile" (Blanchett, 2014). A spending shape
+// scales each retirement year's expenses; a personalized one starts from
+// the user's actual spending and its recent trend, which gives way to the
+// smile over the first years of retirement.
+
+const (
+	// spendingTrendFadeYears is how many years into retirement an observed
+	// spending trend gives way to the spending smile
+	spendingTrendFadeYears = 10
+	// maxSpendingTrend caps the observed real annual spending change
+	maxSpendingTrend = 0.05
+	// minSpendingHistoryMonths is the least history a trend is taken from
+	minSpendingHistoryMonths = 12
+)
+
+// SpendingHistory summarizes a user's recent spending
+type SpendingHistory struct {
+	// AnnualSpending is the last 12 months' spending
+	AnnualSpending float64
+	// AnnualTrend is the nominal annual rate spending changed at
+	AnnualTrend float64
+	Months      int
+}
+
+// NewSpendingHistory summarizes monthly spending totals, oldest first
+func NewSpendingHistory(monthly []float64) (SpendingHistory, error) {
+	if len(monthly) < minSpendingHistoryMonths {
+		return SpendingHistory{}, errors.New("at least 12 months of spending are required")
+	}
+
+	history := SpendingHistory{Months: len(monthly)}
+	for _, amount := range monthly[len(monthly)-12:] {
+		history.AnnualSpending += amount
+	}
+
+	// Least-squares slope of the monthly totals, annualized against their
+	// mean
+	n := float64(len(monthly))
+	var sumX, sumY, sumXY, sumX2 float64
+	for i, amount := range monthly {
+		x := float64(i)
+		sumX += x
+		sumY += amount
+		sumXY += x * amount
+		sumX2 += x * x
+	}
+	if sumY > 0 {
+		slope := (n*sumXY - sumX*sumY) / (n*sumX2 - sumX*sumX)
+		history.AnnualTrend = slope * 12 / (sumY / n)
+	}
+	return history, nil
+}
+
+// SpendingSmileChange returns the expected real change in a retiree's
+// annual spending at age, given annual spending in today's dollars
+func SpendingSmileChange(age int, annualSpending float64) float64 {
+	a := float64(age)
+	return 0.00008*a*a - 0.0125*a - 0.0066*math.Log(math.Max(annualSpending, 1)) + 0.546
+}
+
+// SpendingSmile returns the spending shape that follows the spending smile
+// from config's retirement age, relative to the first year of retirement
+func SpendingSmile(config CashFlowConfig) []float64 {
+	return spendingShape(config, 1, 0)
+}
+
+// PersonalizedSpendingShape returns the spending shape for the user's
+// history: their spending level relative to config's expenses, changing at
+// their observed real trend at first and following the spending smile after
+func PersonalizedSpendingShape(config CashFlowConfig, history SpendingHistory) []float64 {
+	level := 1.0
+	if total := config.AnnualExpenses(); total > 0 && history.AnnualSpending > 0 {
+		level = history.AnnualSpending / total
+	}
+	trend := math.Max(-maxSpendingTrend, math.Min(maxSpendingTrend, history.AnnualTrend-config.InflationRate))
+	return spendingShape(config, level, trend)
+}
+
+// spendingShape scales each retirement year's expenses, starting at level.
+// Each year's real change blends trend into the spending smile over the
+// first spendingTrendFadeYears.
+func spendingShape(config CashFlowConfig, level, trend float64) []float64 {
+	years := config.LifeExpectancy - config.RetirementAge
+	if years <= 0 {
+		return nil
+	}
+	spending := config.AnnualExpenses() * level
+
+	shape := make([]float64, years)
+	shape[0] = level
+	for t := 1; t < years; t++ {
+		weight := math.Max(0, 1-float64(t-1)/spendingTrendFadeYears)
+		change := weight*trend + (1-weight)*SpendingSmileChange(config.RetirementAge+t-1, spending)
+		shape[t] = shape[t-1] * (1 + change)
+	}
+	return shape
+}
+
+// AnnualExpenses returns the configured annual expenses in today's dollars
+func (c CashFlowConfig) AnnualExpenses() float64 {
+	return c.HousingExpense + c.HealthcareExpense + c.FoodExpense +
+		c.TransportationExpense + c.UtilitiesExpense + c.InsuranceExpense +
+		c.DiscretionaryExpense + c.OtherExpenses
+}
+
+// retirementSpendingMultiplier returns the spending shape's multiplier for
+// the retirement year that starts at age, 1 before retirement or without a
+// shape. Years past the end of the shape use its last multiplier.
+func retirementSpendingMultiplier(config CashFlowConfig, age int) float64 {
+	shape := config.RetirementSpendingShape
+	if len(shape) == 0 || age < config.RetirementAge {
+		return 1
+	}
+	return shape[min(age-config.RetirementAge, len(shape)-1)]
+}
+
+// StrategyOutcome summarizes a withdrawal strategy's run
+type StrategyOutcome struct {
+	FinalPortfolio       float64
+	TotalTax             float64
+	RetirementExpenses   float64
+	ExpensesCoveredYears int
+	RetirementReadiness  float64
+	// DepletionAge is when the portfolio runs out, 0 if it lasts
+	DepletionAge int
+}
+
+// SpendingShapeStrategy compares one strategy under flat and shaped
+// spending
+type SpendingShapeStrategy struct {
+	Strategy WithdrawalStrategy
+	Flat     StrategyOutcome
+	Shaped   StrategyOutcome
+}
+
+// SpendingShapeComparison compares every withdrawal strategy under flat
+// inflation-adjusted expenses and under a spending shape
+type SpendingShapeComparison struct {
+	Shape      []float64
+	Strategies []SpendingShapeStrategy
+
+	// The strategy leaving the largest final portfolio under each
+	// assumption
+	BestFlat   WithdrawalStrategy
+	BestShaped WithdrawalStrategy
+}
+
+// CompareSpendingShapes runs every withdrawal strategy on config with flat
+// expenses and with shape
+func (s *CashFlowService) CompareSpendingShapes(config CashFlowConfig, shape []float64) (*SpendingShapeComparison, error) {
+	if len(shape) == 0 {
+		return nil, errors.New("spending shape is required")
+	}
+	for _, multiplier := range shape {
+		if multiplier < 0 {
+			return nil, errors.New("spending shape multipliers cannot be negative")
+		}
+	}
+
+	flat := config
+	flat.RetirementSpendingShape = nil
+	shaped := config
+	shaped.RetirementSpendingShape = shape
+
+	flatResults, err := s.CompareTaxStrategies(flat)
+	if err != nil {
+		return nil, err
+	}
+	shapedResults, err := s.CompareTaxStrategies(shaped)
+	if err != nil {
+		return nil, err
+	}
+
+	comparison := &SpendingShapeComparison{Shape: shape}
+	var bestFlat, bestShaped float64
+	for i, strategy := range WithdrawalStrategies() {
+		row := SpendingShapeStrategy{
+			Strategy: strategy,
+			Flat:     strategyOutcome(flatResults[strategy]),
+			Shaped:   strategyOutcome(shapedResults[strategy]),
+		}
+		if i == 0 || row.Flat.FinalPortfolio > bestFlat {
+			comparison.BestFlat, bestFlat = strategy, row.Flat.FinalPortfolio
+		}
+		if i == 0 || row.Shaped.FinalPortfolio > bestShaped {
+			comparison.BestShaped, bestShaped = strategy, row.Shaped.FinalPortfolio
+		}
+		comparison.Strategies = append(comparison.Strategies, row)
+	}
+	return comparison, nil
+}
+
+// strategyOutcome summarizes a strategy's results
+func strategyOutcome(results *CashFlowResults) StrategyOutcome {
+	outcome := StrategyOutcome{
+		TotalTax:             results.TotalLifetimeTax,
+		ExpensesCoveredYears: results.ExpensesCoveredYears,
+		RetirementReadiness:  results.RetirementReadiness,
+	}
+	if n := len(results.YearlyFlows); n > 0 {
+		outcome.FinalPortfolio = results.YearlyFlows[n-1].TotalPortfolio
+	}
+	for _, flow := range results.YearlyFlows {
+		if !flow.IsRetired {
+			continue
+		}
+		outcome.RetirementExpenses += flow.TotalExpenses
+		if outcome.DepletionAge == 0 && flow.TotalPortfolio <= 0 {
+			outcome.DepletionAge = flow.Age
+		}
+	}
+	return outcome
+}
@@ -0,0 +1,114 @@
+package retirement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpendingHistory(t *testing.T) {
+	// Two years rising 50 a month from 4000
+	monthly := make([]float64, 24)
+	for i := range monthly {
+		monthly[i] = 4000 + 50*float64(i)
+	}
+	history, err := NewSpendingHistory(monthly)
+	require.NoError(t, err)
+
+	assert.Equal(t, 24, history.Months)
+	assert.InDelta(t, 12*(4000+50*17.5), history.AnnualSpending, 1e-6)
+	assert.InDelta(t, 600/(4000+50*11.5), history.AnnualTrend, 1e-9)
+
+	_, err = NewSpendingHistory(monthly[:11])
+	assert.Error(t, err)
+}
+
+func TestSpendingSmile(t *testing.T) {
+	// Real spending falls through the seventies and rises again in the
+	// nineties
+	assert.Negative(t, SpendingSmileChange(75, 60000))
+	assert.Positive(t, SpendingSmileChange(95, 60000))
+	// Higher spenders cut back more
+	assert.Less(t, SpendingSmileChange(75, 120000), SpendingSmileChange(75, 60000))
+
+	config := DefaultCashFlowConfig()
+	smile := SpendingSmile(config)
+	require.Len(t, smile, config.LifeExpectancy-config.RetirementAge)
+	assert.Equal(t, 1.0, smile[0])
+	assert.Less(t, smile[15], 1.0)
+}
+
+func TestPersonalizedSpendingShape(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	history := SpendingHistory{
+		AnnualSpending: config.AnnualExpenses() * 0.8,
+		AnnualTrend:    config.InflationRate + 0.02,
+		Months:         24,
+	}
+	shape := PersonalizedSpendingShape(config, history)
+	require.Len(t, shape, config.LifeExpectancy-config.RetirementAge)
+
+	// Starts at the user's level and follows their trend at first
+	assert.InDelta(t, 0.8, shape[0], 1e-9)
+	assert.InDelta(t, 0.8*1.02, shape[1], 1e-9)
+
+	// An implausible trend is capped
+	history.AnnualTrend = 0.5
+	capped := PersonalizedSpendingShape(config, history)
+	assert.InDelta(t, 0.8*(1+maxSpendingTrend), capped[1], 1e-9)
+}
+
+func TestRetirementSpendingShapeScalesExpenses(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	config.RetirementSpendingShape = []float64{0.5, 0.75}
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	flat := config
+	flat.RetirementSpendingShape = nil
+	flatResults, err := service.RunAnalysisWithConfig(flat)
+	require.NoError(t, err)
+	shapedResults, err := service.RunAnalysisWithConfig(config)
+	require.NoError(t, err)
+
+	// IRMAA surcharges follow income rather than the shape
+	for i, flow := range shapedResults.YearlyFlows {
+		want := flatResults.YearlyFlows[i].TotalExpenses - flatResults.YearlyFlows[i].IRMAASurcharge
+		switch {
+		case flow.Age == config.RetirementAge:
+			want *= 0.5
+		case flow.Age > config.RetirementAge:
+			// The last multiplier carries on
+			want *= 0.75
+		}
+		assert.InDelta(t, want, flow.TotalExpenses-flow.IRMAASurcharge, 1e-6, "age %d", flow.Age)
+	}
+}
+
+func TestCompareSpendingShapes(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	comparison, err := service.CompareSpendingShapes(config, []float64{0.8})
+	require.NoError(t, err)
+	require.Len(t, comparison.Strategies, 5)
+
+	for _, row := range comparison.Strategies {
+		assert.Less(t, row.Shaped.RetirementExpenses, row.Flat.RetirementExpenses)
+		assert.GreaterOrEqual(t, row.Shaped.FinalPortfolio, row.Flat.FinalPortfolio)
+	}
+	for _, row := range comparison.Strategies {
+		if row.Strategy == comparison.BestShaped {
+			for _, other := range comparison.Strategies {
+				assert.GreaterOrEqual(t, row.Shaped.FinalPortfolio, other.Shaped.FinalPortfolio)
+			}
+		}
+	}
+
+	_, err = service.CompareSpendingShapes(config, nil)
+	assert.Error(t, err)
+	_, err = service.CompareSpendingShapes(config, []float64{-1})
+	assert.Error(t, err)
+}
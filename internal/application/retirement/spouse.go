@@ -0,0 +1,158 @@
+package retirement
+
+import (
+	"errors"
+	"math"
+)
+
+// =============================================================================
+// Spouse and Partner
+// =============================================================================
+
+// A spouse or partner is planned for jointly: their wages, Social Security
+// and pension are added to the household's income lines by their own age,
+// and their balances are pooled with the household's. The plan still runs
+// to the first person's LifeExpectancy and RMDs follow the first person's
+// age. In a survivor scenario the spouse dies at DeathAge: their wages stop,
+// the survivor keeps the larger of the two Social Security benefits and
+// PensionSurvivorShare of the pension, and expenses fall by
+// SurvivorExpenseReduction. Taxes stay on joint brackets throughout.
+
+// survivorBenefitAge is the earliest age a survivor benefit is paid to
+// someone without their own benefit
+const survivorBenefitAge = 60
+
+// SpouseConfig is the second person in a joint plan, in today's dollars
+type SpouseConfig struct {
+	CurrentAge    int
+	RetirementAge int
+
+	// DeathAge, when set, ends the spouse's income at that age for a
+	// survivor scenario
+	DeathAge int
+
+	// Income sources
+	EmploymentIncome       float64
+	EmploymentIncomeGrowth float64
+	SocialSecurityBenefit  float64
+	SocialSecurityStartAge int
+	PensionBenefit         float64
+	PensionStartAge        int
+
+	// PensionSurvivorShare is the part of the pension paid on after the
+	// spouse dies, e.g. 0.5 for a 50% joint and survivor annuity
+	PensionSurvivorShare float64
+
+	// SurvivorExpenseReduction is how much household expenses fall after
+	// the spouse dies, e.g. 0.2 for 20%
+	SurvivorExpenseReduction float64
+
+	// Portfolio balances, pooled with the household's
+	TaxableBalance     float64
+	TraditionalBalance float64
+	RothBalance        float64
+	HSABalance         float64
+}
+
+// validate checks the spouse's ages and shares are in range
+func (s SpouseConfig) validate() error {
+	if s.CurrentAge < 0 || s.CurrentAge > 120 {
+		return errors.New("spouse CurrentAge must be between 0 and 120")
+	}
+	if s.RetirementAge < s.CurrentAge {
+		return errors.New("spouse RetirementAge must be >= CurrentAge")
+	}
+	if s.DeathAge != 0 && s.DeathAge <= s.CurrentAge {
+		return errors.New("spouse DeathAge must be > CurrentAge")
+	}
+	if s.SocialSecurityStartAge != 0 &&
+		(s.SocialSecurityStartAge < EarliestClaimingAge || s.SocialSecurityStartAge > LatestClaimingAge) {
+		return errors.New("spouse SocialSecurityStartAge must be between 62 and 70")
+	}
+	if s.PensionSurvivorShare < 0 || s.PensionSurvivorShare > 1 {
+		return errors.New("spouse PensionSurvivorShare must be between 0 and 1")
+	}
+	if s.SurvivorExpenseReduction < 0 || s.SurvivorExpenseReduction > 1 {
+		return errors.New("spouse SurvivorExpenseReduction must be between 0 and 1")
+	}
+	for _, amount := range []float64{s.EmploymentIncome, s.SocialSecurityBenefit, s.PensionBenefit,
+		s.TaxableBalance, s.TraditionalBalance, s.RothBalance, s.HSABalance} {
+		if amount < 0 {
+			return errors.New("spouse income and balances cannot be negative")
+		}
+	}
+	return nil
+}
+
+// householdBalances returns the starting balances of both partners
+func (c CashFlowConfig) householdBalances() (taxable, traditional, roth, hsa float64) {
+	taxable, traditional, roth, hsa = c.TaxableBalance, c.TraditionalBalance, c.RothBalance, c.HSABalance
+	if s := c.Spouse; s != nil {
+		taxable += s.TaxableBalance
+		traditional += s.TraditionalBalance
+		roth += s.RothBalance
+		hsa += s.HSABalance
+	}
+	return taxable, traditional, roth, hsa
+}
+
+// addSpouseIncome adds the spouse's income in a plan year to the
+// household's; the first person's own Social Security must already be set
+func (c CashFlowConfig) addSpouseIncome(flow *YearCashFlow, year int, inflationFactor float64) {
+	s := c.Spouse
+	if s == nil {
+		return
+	}
+	flow.SpouseAge = s.CurrentAge + year
+	flow.Survivor = s.DeathAge > 0 && flow.SpouseAge >= s.DeathAge
+
+	var pension float64
+	if s.PensionStartAge > 0 && flow.SpouseAge >= s.PensionStartAge {
+		pension = s.PensionBenefit * inflationFactor
+	}
+
+	if flow.Survivor {
+		// The survivor benefit tops the survivor's own up to what the
+		// spouse received, or would have claimed
+		claimingAge := c.SocialSecurityStartAge
+		if claimingAge == 0 {
+			claimingAge = survivorBenefitAge
+		}
+		if s.SocialSecurityStartAge > 0 && flow.Age >= claimingAge {
+			flow.SpouseSocialSecurity = math.Max(0, s.SocialSecurityBenefit*inflationFactor-flow.SocialSecurity)
+		}
+		flow.SpousePension = pension * s.PensionSurvivorShare
+	} else {
+		if flow.SpouseAge < s.RetirementAge {
+			flow.SpouseEmploymentIncome = s.EmploymentIncome * math.Pow(1+s.EmploymentIncomeGrowth, float64(year))
+		}
+		if s.SocialSecurityStartAge > 0 && flow.SpouseAge >= s.SocialSecurityStartAge {
+			flow.SpouseSocialSecurity = s.SocialSecurityBenefit * inflationFactor
+		}
+		flow.SpousePension = pension
+	}
+
+	flow.EmploymentIncome += flow.SpouseEmploymentIncome
+	flow.SocialSecurity += flow.SpouseSocialSecurity
+	flow.Pension += flow.SpousePension
+}
+
+// survivorExpenseMultiplier scales a year's expenses once the spouse has died
+func (c CashFlowConfig) survivorExpenseMultiplier(flow YearCashFlow) float64 {
+	if !flow.Survivor {
+		return 1
+	}
+	return 1 - c.Spouse.SurvivorExpenseReduction
+}
+
+// medicareEnrollees is how many people in the household are on Medicare
+func (c CashFlowConfig) medicareEnrollees(flow YearCashFlow, eligibilityAge int) int {
+	var enrollees int
+	if flow.Age >= eligibilityAge {
+		enrollees++
+	}
+	if c.Spouse != nil && !flow.Survivor && flow.SpouseAge >= eligibilityAge {
+		enrollees++
+	}
+	return enrollees
+}
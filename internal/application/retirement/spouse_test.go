@@ -0,0 +1,121 @@
+package retirement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCashFlowSpouse(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	joint := config
+	joint.Spouse = &SpouseConfig{
+		CurrentAge:             config.CurrentAge - 2,
+		RetirementAge:          config.RetirementAge,
+		EmploymentIncome:       60000,
+		SocialSecurityBenefit:  20000,
+		SocialSecurityStartAge: 67,
+		TraditionalBalance:     50000,
+	}
+	single, err := service.RunAnalysisWithConfig(config)
+	require.NoError(t, err)
+	results, err := service.RunAnalysisWithConfig(joint)
+	require.NoError(t, err)
+
+	first := results.YearlyFlows[0]
+	assert.Equal(t, config.CurrentAge-2, first.SpouseAge)
+	assert.Equal(t, 60000.0, first.SpouseEmploymentIncome)
+	assert.InDelta(t, single.YearlyFlows[0].EmploymentIncome+60000, first.EmploymentIncome, 1e-9)
+	// Each earner pays Social Security tax on their own wages
+	assert.Greater(t, first.FICATax, single.YearlyFlows[0].FICATax)
+	assert.Greater(t, first.TotalPortfolio, single.YearlyFlows[0].TotalPortfolio)
+
+	// The spouse works two years past the first person's retirement and
+	// keeps saving from their wages
+	retirementYear := config.RetirementAge - config.CurrentAge
+	for _, flow := range results.YearlyFlows[retirementYear : retirementYear+2] {
+		assert.True(t, flow.IsRetired)
+		assert.Positive(t, flow.SpouseEmploymentIncome, "age %d", flow.Age)
+		assert.Positive(t, flow.TraditionalSavings, "age %d", flow.Age)
+	}
+	assert.Equal(t, 0.0, results.YearlyFlows[retirementYear+2].SpouseEmploymentIncome)
+
+	// Benefits start at the spouse's own claiming age
+	for _, flow := range results.YearlyFlows {
+		assert.Equal(t, flow.SpouseAge >= 67, flow.SpouseSocialSecurity > 0, "spouse age %d", flow.SpouseAge)
+		assert.False(t, flow.Survivor)
+	}
+}
+
+func TestCashFlowSpouseSurvivor(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	config.SocialSecurityBenefit = 30000
+	config.SocialSecurityStartAge = 67
+	config.Spouse = &SpouseConfig{
+		CurrentAge:               config.CurrentAge,
+		RetirementAge:            config.RetirementAge,
+		DeathAge:                 80,
+		SocialSecurityBenefit:    20000,
+		SocialSecurityStartAge:   67,
+		PensionBenefit:           10000,
+		PensionStartAge:          65,
+		PensionSurvivorShare:     0.5,
+		SurvivorExpenseReduction: 0.25,
+	}
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+	results, err := service.RunAnalysis()
+	require.NoError(t, err)
+
+	before := results.YearlyFlows[79-config.CurrentAge]
+	after := results.YearlyFlows[80-config.CurrentAge]
+	assert.False(t, before.Survivor)
+	assert.True(t, after.Survivor)
+
+	// The survivor keeps the larger benefit and half the pension
+	assert.InDelta(t, before.SocialSecurity-before.SpouseSocialSecurity, after.SocialSecurity/(1+config.InflationRate), 1e-6)
+	assert.Equal(t, 0.0, after.SpouseSocialSecurity)
+	assert.InDelta(t, before.SpousePension/2, after.SpousePension/(1+config.InflationRate), 1e-6)
+	assert.InDelta(t, before.FoodExpense*0.75, after.FoodExpense/(1+config.InflationRate), 1e-6)
+
+	// A survivor whose own benefit is the smaller one is topped up to the
+	// spouse's
+	config.Spouse.SocialSecurityBenefit = 40000
+	results, err = service.RunAnalysisWithConfig(config)
+	require.NoError(t, err)
+	before = results.YearlyFlows[79-config.CurrentAge]
+	after = results.YearlyFlows[80-config.CurrentAge]
+	assert.InDelta(t, before.SpouseSocialSecurity, after.SocialSecurity/(1+config.InflationRate), 1e-6)
+	assert.Positive(t, after.SpouseSocialSecurity)
+}
+
+func TestSpouseConfigValidate(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	valid := SpouseConfig{CurrentAge: 40, RetirementAge: 65}
+
+	tests := []struct {
+		name   string
+		modify func(*SpouseConfig)
+	}{
+		{"retirement before current age", func(s *SpouseConfig) { s.RetirementAge = 30 }},
+		{"death before current age", func(s *SpouseConfig) { s.DeathAge = 40 }},
+		{"early claiming", func(s *SpouseConfig) { s.SocialSecurityStartAge = 60 }},
+		{"survivor share over 1", func(s *SpouseConfig) { s.PensionSurvivorShare = 1.5 }},
+		{"negative balance", func(s *SpouseConfig) { s.RothBalance = -1 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spouse := valid
+			tt.modify(&spouse)
+			config.Spouse = &spouse
+			assert.Error(t, validateCashFlowConfig(config))
+		})
+	}
+
+	config.Spouse = &valid
+	assert.NoError(t, validateCashFlowConfig(config))
+}
@@ -0,0 +1,339 @@
+package retirement
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// =============================================================================
+// State Income Tax
+// =============================================================================
+
+// State income tax is modeled per state from data/state_taxes.json: 2024
+// brackets and standard deduction for married couples filing jointly, the
+// share of Social Security benefits the state taxes, and how much retirement
+// income it excludes. Local income taxes aren't modeled.
+//
+// Retirement income is pensions, traditional account withdrawals and the
+// taxed share of Social Security. Exclusions come off it first, from
+// ExclusionAge, as a household amount.
+
+//go:embed data/state_taxes.json
+var stateTaxData []byte
+
+// StateTaxRules are a state's income tax rules
+type StateTaxRules struct {
+	Code string
+	Name string
+
+	// Brackets are empty in states without an income tax
+	Brackets          []TaxBracket
+	StandardDeduction float64
+
+	// SocialSecurityTaxedShare is the share of benefits taxed, 0 to 1
+	SocialSecurityTaxedShare float64
+	// PensionExempt excludes pensions entirely
+	PensionExempt bool
+	// RetirementIncomeExempt excludes all retirement income
+	RetirementIncomeExempt bool
+	// RetirementExclusion is how much other retirement income is excluded
+	RetirementExclusion float64
+	// ExclusionAge is the age exclusions start at, 0 for any age
+	ExclusionAge int
+}
+
+// StateIncome is a year's income as the state sees it
+type StateIncome struct {
+	Age int
+
+	// Wages are after pre-tax contributions
+	Wages                 float64
+	SocialSecurity        float64
+	Pension               float64
+	RetirementWithdrawals float64
+	// Other is investment, rental and other income
+	Other float64
+}
+
+// stateTaxFile is the layout of data/state_taxes.json
+type stateTaxFile struct {
+	TaxYear int `json:"tax_year"`
+	States  []struct {
+		Code                     string          `json:"code"`
+		Name                     string          `json:"name"`
+		Brackets                 []RateThreshold `json:"brackets"`
+		StandardDeduction        float64         `json:"standard_deduction"`
+		SocialSecurityTaxedShare float64         `json:"social_security_taxed_share"`
+		PensionExempt            bool            `json:"pension_exempt"`
+		RetirementIncomeExempt   bool            `json:"retirement_income_exempt"`
+		RetirementExclusion      float64         `json:"retirement_exclusion"`
+		ExclusionAge             int             `json:"exclusion_age"`
+	} `json:"states"`
+}
+
+// stateTaxTables are the embedded state rules, keyed by state code
+var stateTaxTables = sync.OnceValue(func() map[string]StateTaxRules {
+	tables, err := parseStateTaxRules(stateTaxData)
+	if err != nil {
+		panic(fmt.Sprintf("retirement: %v", err))
+	}
+	return tables
+})
+
+// parseStateTaxRules parses state rules in the layout of
+// data/state_taxes.json
+func parseStateTaxRules(data []byte) (map[string]StateTaxRules, error) {
+	var file stateTaxFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing state tax rules: %w", err)
+	}
+
+	tables := make(map[string]StateTaxRules, len(file.States))
+	for _, state := range file.States {
+		if state.Code == "" {
+			return nil, errors.New("state tax rules without a state code")
+		}
+		if _, exists := tables[state.Code]; exists {
+			return nil, fmt.Errorf("state %s has more than one set of tax rules", state.Code)
+		}
+
+		rules := StateTaxRules{
+			Code:                     state.Code,
+			Name:                     state.Name,
+			StandardDeduction:        state.StandardDeduction,
+			SocialSecurityTaxedShare: state.SocialSecurityTaxedShare,
+			PensionExempt:            state.PensionExempt,
+			RetirementIncomeExempt:   state.RetirementIncomeExempt,
+			RetirementExclusion:      state.RetirementExclusion,
+			ExclusionAge:             state.ExclusionAge,
+		}
+		brackets, err := thresholdBrackets(state.Brackets)
+		if err != nil {
+			return nil, fmt.Errorf("state %s: %w", state.Code, err)
+		}
+		rules.Brackets = brackets
+		tables[state.Code] = rules
+	}
+	return tables, nil
+}
+
+// StateTaxRulesFor returns the tax rules of the state with code, e.g. "CA"
+func StateTaxRulesFor(code string) (StateTaxRules, bool) {
+	rules, ok := stateTaxTables()[strings.ToUpper(code)]
+	return rules, ok
+}
+
+// StateCodes returns the codes of every state with tax rules, sorted
+func StateCodes() []string {
+	codes := make([]string, 0, len(stateTaxTables()))
+	for code := range stateTaxTables() {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// HasIncomeTax reports whether the state taxes income
+func (r StateTaxRules) HasIncomeTax() bool {
+	return len(r.Brackets) > 0
+}
+
+// TaxableIncome returns the income the state taxes
+func (r StateTaxRules) TaxableIncome(income StateIncome) float64 {
+	retirement := income.SocialSecurity*r.SocialSecurityTaxedShare + income.RetirementWithdrawals
+	if !r.PensionExempt {
+		retirement += income.Pension
+	}
+	if income.Age >= r.ExclusionAge {
+		if r.RetirementIncomeExempt {
+			retirement = 0
+		} else {
+			retirement = math.Max(0, retirement-r.RetirementExclusion)
+		}
+	}
+	return math.Max(0, income.Wages+retirement+income.Other-r.StandardDeduction)
+}
+
+// Tax returns the state's income tax on income
+func (r StateTaxRules) Tax(income StateIncome) float64 {
+	return progressiveTax(r.TaxableIncome(income), r.Brackets)
+}
+
+// MarginalRate returns the state's tax rate on the next dollar of taxable
+// income
+func (r StateTaxRules) MarginalRate(taxableIncome float64) float64 {
+	for _, bracket := range r.Brackets {
+		if taxableIncome < bracket.MaxIncome {
+			return bracket.Rate
+		}
+	}
+	return 0
+}
+
+// stateAt returns config as it applies at age: the relocation state
+// replaces StateCode once the household has moved
+func (c CashFlowConfig) stateAt(age int) CashFlowConfig {
+	if c.RelocationStateCode != "" && age >= c.RelocationAge {
+		c.StateCode = c.RelocationStateCode
+	}
+	return c
+}
+
+// stateWithdrawalTaxRate is the state tax rate traditional withdrawals are
+// grossed up for: the flat StateTaxRate, or the selected state's marginal
+// rate on income the size of the configured expenses
+func (c CashFlowConfig) stateWithdrawalTaxRate() float64 {
+	rules, ok := StateTaxRulesFor(c.StateCode)
+	if !ok {
+		return c.StateTaxRate
+	}
+	if rules.RetirementIncomeExempt {
+		return 0
+	}
+	return rules.MarginalRate(math.Max(0, c.AnnualExpenses()-rules.StandardDeduction))
+}
+
+// validateStateCodes checks config's state codes are known
+func validateStateCodes(config CashFlowConfig) error {
+	for _, code := range []string{config.StateCode, config.RelocationStateCode} {
+		if _, ok := StateTaxRulesFor(code); code != "" && !ok {
+			return fmt.Errorf("unknown state code %q", code)
+		}
+	}
+	return nil
+}
+
+// progressiveTax calculates tax on income using progressive brackets
+func progressiveTax(income float64, brackets []TaxBracket) float64 {
+	totalTax := 0.0
+	remaining := income
+
+	for _, bracket := range brackets {
+		if remaining <= 0 {
+			break
+		}
+		bracketWidth := bracket.MaxIncome - bracket.MinIncome
+		taxableInBracket := math.Min(remaining, bracketWidth)
+		totalTax += taxableInBracket * bracket.Rate
+		remaining -= taxableInBracket
+	}
+
+	return totalTax
+}
+
+// =============================================================================
+// Relocation
+// =============================================================================
+
+// RelocationOutcome summarizes the plan with the household living in a state
+type RelocationOutcome struct {
+	StateCode string
+	StateName string
+
+	// StateTax is the state tax paid from the move on
+	StateTax         float64
+	TotalLifetimeTax float64
+	FinalPortfolio   float64
+
+	ExpensesCoveredYears int
+	// DepletionAge is when the portfolio runs out, 0 if it lasts
+	DepletionAge int
+
+	// Differences from staying
+	StateTaxSavings    float64
+	FinalPortfolioGain float64
+}
+
+// RelocationComparison compares staying with moving to each of several
+// states
+type RelocationComparison struct {
+	MoveAge   int
+	Current   RelocationOutcome
+	Scenarios []RelocationOutcome
+
+	// Best is the state leaving the largest final portfolio, empty if no
+	// move beats staying
+	Best string
+}
+
+// CompareRelocation runs config as it stands and with the household moving
+// to each of stateCodes at moveAge, by default the retirement age
+func (s *CashFlowService) CompareRelocation(config CashFlowConfig, stateCodes []string, moveAge int) (*RelocationComparison, error) {
+	if len(stateCodes) == 0 {
+		return nil, errors.New("at least one state is required")
+	}
+	if moveAge == 0 {
+		moveAge = config.RetirementAge
+	}
+	if moveAge < config.CurrentAge || moveAge >= config.LifeExpectancy {
+		return nil, errors.New("moveAge must be between CurrentAge and LifeExpectancy")
+	}
+	config.RelocationStateCode = ""
+
+	current, err := s.relocationOutcome(config, moveAge)
+	if err != nil {
+		return nil, err
+	}
+	current.StateCode = strings.ToUpper(config.StateCode)
+	if rules, ok := StateTaxRulesFor(config.StateCode); ok {
+		current.StateName = rules.Name
+	}
+
+	comparison := &RelocationComparison{MoveAge: moveAge, Current: current}
+	best := current.FinalPortfolio
+	for _, code := range stateCodes {
+		rules, ok := StateTaxRulesFor(code)
+		if !ok {
+			return nil, fmt.Errorf("unknown state code %q", code)
+		}
+
+		moved := config
+		moved.RelocationStateCode = rules.Code
+		moved.RelocationAge = moveAge
+		outcome, err := s.relocationOutcome(moved, moveAge)
+		if err != nil {
+			return nil, err
+		}
+		outcome.StateCode = rules.Code
+		outcome.StateName = rules.Name
+		outcome.StateTaxSavings = current.StateTax - outcome.StateTax
+		outcome.FinalPortfolioGain = outcome.FinalPortfolio - current.FinalPortfolio
+
+		if outcome.FinalPortfolio > best {
+			comparison.Best, best = rules.Code, outcome.FinalPortfolio
+		}
+		comparison.Scenarios = append(comparison.Scenarios, outcome)
+	}
+	return comparison, nil
+}
+
+// relocationOutcome runs config and summarizes the results from moveAge on
+func (s *CashFlowService) relocationOutcome(config CashFlowConfig, moveAge int) (RelocationOutcome, error) {
+	results, err := s.RunAnalysisWithConfig(config)
+	if err != nil {
+		return RelocationOutcome{}, err
+	}
+
+	outcome := RelocationOutcome{
+		TotalLifetimeTax:     results.TotalLifetimeTax,
+		ExpensesCoveredYears: results.ExpensesCoveredYears,
+	}
+	if n := len(results.YearlyFlows); n > 0 {
+		outcome.FinalPortfolio = results.YearlyFlows[n-1].TotalPortfolio
+	}
+	for _, flow := range results.YearlyFlows {
+		if flow.Age >= moveAge {
+			outcome.StateTax += flow.StateTax
+		}
+		if outcome.DepletionAge == 0 && flow.IsRetired && flow.TotalPortfolio <= 0 {
+			outcome.DepletionAge = flow.Age
+		}
+	}
+	return outcome, nil
+}
@@ -0,0 +1,124 @@
+package retirement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateTaxRules(t *testing.T) {
+	// Every state and DC
+	codes := StateCodes()
+	require.Len(t, codes, 51)
+	for _, code := range codes {
+		rules, ok := StateTaxRulesFor(code)
+		require.True(t, ok, code)
+		assert.NotEmpty(t, rules.Name, code)
+	}
+	for _, code := range []string{"AK", "FL", "NV", "NH", "SD", "TN", "TX", "WA", "WY"} {
+		rules, _ := StateTaxRulesFor(code)
+		assert.False(t, rules.HasIncomeTax(), code)
+	}
+
+	_, ok := StateTaxRulesFor("ca")
+	assert.True(t, ok)
+	_, ok = StateTaxRulesFor("XX")
+	assert.False(t, ok)
+
+	_, err := parseStateTaxRules([]byte(`{"states": [{"code": "ZZ", "brackets": [{"over": 1000, "rate": 0.02}, {"over": 0, "rate": 0.01}]}]}`))
+	assert.Error(t, err)
+	_, err = parseStateTaxRules([]byte(`{"states": [{"code": "ZZ"}, {"code": "ZZ"}]}`))
+	assert.Error(t, err)
+}
+
+func TestStateTax(t *testing.T) {
+	california, _ := StateTaxRulesFor("CA")
+	// 100,000 of wages less the 11,080 standard deduction
+	tax := california.Tax(StateIncome{Age: 40, Wages: 100000})
+	assert.InDelta(t, 0.01*21512+0.02*(50998-21512)+0.04*(80490-50998)+0.06*(88920-80490), tax, 1e-6)
+	// California doesn't tax Social Security
+	assert.Equal(t, tax, california.Tax(StateIncome{Age: 70, Wages: 100000, SocialSecurity: 40000}))
+
+	// New York excludes 40,000 of pensions from 59
+	newYork, _ := StateTaxRulesFor("NY")
+	assert.Equal(t, 33950.0, newYork.TaxableIncome(StateIncome{Age: 55, Pension: 50000}))
+	assert.Equal(t, 0.0, newYork.TaxableIncome(StateIncome{Age: 60, Pension: 50000}))
+	assert.Equal(t, 3950.0, newYork.TaxableIncome(StateIncome{Age: 60, Pension: 30000, RetirementWithdrawals: 30000}))
+
+	// Illinois exempts all retirement income, but not other income
+	illinois, _ := StateTaxRulesFor("IL")
+	assert.Equal(t, 0.0, illinois.TaxableIncome(StateIncome{Age: 70, SocialSecurity: 40000, RetirementWithdrawals: 60000}))
+	assert.Equal(t, 4450.0, illinois.TaxableIncome(StateIncome{Age: 70, RetirementWithdrawals: 60000, Other: 10000}))
+
+	// Minnesota taxes Social Security
+	minnesota, _ := StateTaxRulesFor("MN")
+	assert.Equal(t, 10850.0, minnesota.TaxableIncome(StateIncome{Age: 70, SocialSecurity: 40000}))
+	assert.Equal(t, 0.068, minnesota.MarginalRate(50000))
+}
+
+func TestCashFlowStateTax(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	config.StateCode = "TX"
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	texas, err := service.RunAnalysisWithConfig(config)
+	require.NoError(t, err)
+	for _, flow := range texas.YearlyFlows {
+		assert.Zero(t, flow.StateTax, "age %d", flow.Age)
+	}
+
+	config.StateCode = "CA"
+	california, err := service.RunAnalysisWithConfig(config)
+	require.NoError(t, err)
+	assert.Positive(t, california.YearlyFlows[0].StateTax)
+	assert.Greater(t, california.TotalLifetimeTax, texas.TotalLifetimeTax)
+
+	// Moving to Texas at retirement ends California tax
+	config.RelocationStateCode = "TX"
+	config.RelocationAge = config.RetirementAge
+	moved, err := service.RunAnalysisWithConfig(config)
+	require.NoError(t, err)
+	for _, flow := range moved.YearlyFlows {
+		if flow.Age < config.RetirementAge {
+			assert.Equal(t, california.YearlyFlows[flow.Year-1].StateTax, flow.StateTax)
+		} else {
+			assert.Zero(t, flow.StateTax)
+		}
+	}
+
+	config.StateCode = "XX"
+	_, err = NewCashFlowService(config)
+	assert.Error(t, err)
+}
+
+func TestCompareRelocation(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	config.StateCode = "CA"
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	comparison, err := service.CompareRelocation(config, []string{"TX", "or"}, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, config.RetirementAge, comparison.MoveAge)
+	assert.Equal(t, "CA", comparison.Current.StateCode)
+	assert.Equal(t, "California", comparison.Current.StateName)
+	require.Len(t, comparison.Scenarios, 2)
+
+	texas := comparison.Scenarios[0]
+	assert.Equal(t, "TX", texas.StateCode)
+	assert.Zero(t, texas.StateTax)
+	assert.InDelta(t, comparison.Current.StateTax, texas.StateTaxSavings, 1e-6)
+	assert.Positive(t, texas.FinalPortfolioGain)
+	assert.Equal(t, "OR", comparison.Scenarios[1].StateCode)
+	assert.Equal(t, "TX", comparison.Best)
+
+	_, err = service.CompareRelocation(config, []string{"XX"}, 0)
+	assert.Error(t, err)
+	_, err = service.CompareRelocation(config, nil, 0)
+	assert.Error(t, err)
+	_, err = service.CompareRelocation(config, []string{"TX"}, config.LifeExpectancy)
+	assert.Error(t, err)
+}
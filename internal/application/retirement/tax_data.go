@@ -0,0 +1,353 @@
+package retirement
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"path"
+	"sort"
+	"sync"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/taxyear"
+)
+
+// =============================================================================
+// Tax Year Data
+// =============================================================================
+
+// Federal tax figures that change every year (brackets, the standard
+// deduction, payroll tax limits, the QBI deduction, estimated tax safe
+// harbors, Medicare premiums and contribution limits) are kept in one data
+// file per tax year, data/tax_years/<year>.json. The files built in are
+// loaded at startup along with any installed in the database, and a new
+// year can be installed while running; the latest year is active unless
+// another is activated. Analyses use the active year.
+
+//go:embed data/tax_years/*.json
+var taxYearFiles embed.FS
+
+// RateThreshold is a tax bracket by the income it starts at
+type RateThreshold struct {
+	Over float64 `json:"over"`
+	Rate float64 `json:"rate"`
+}
+
+// ContributionLimits are a year's retirement account contribution limits
+type ContributionLimits struct {
+	Traditional401k        float64 `json:"401k"`
+	Traditional401kCatchUp float64 `json:"401k_catch_up"`
+	IRA                    float64 `json:"ira"`
+	IRACatchUp             float64 `json:"ira_catch_up"`
+	HSASelfOnly            float64 `json:"hsa_self_only"`
+	HSAFamily              float64 `json:"hsa_family"`
+	HSACatchUp             float64 `json:"hsa_catch_up"`
+}
+
+// TaxYearData are the federal tax figures of a tax year, for married couples
+// filing jointly
+type TaxYearData struct {
+	TaxYear      int    `json:"tax_year"`
+	FilingStatus string `json:"filing_status"`
+
+	FederalBrackets   []RateThreshold `json:"federal_brackets"`
+	StandardDeduction float64         `json:"standard_deduction"`
+
+	SocialSecurityWageBase      float64 `json:"social_security_wage_base"`
+	SocialSecurityTaxRate       float64 `json:"social_security_tax_rate"`
+	MedicareTaxRate             float64 `json:"medicare_tax_rate"`
+	AdditionalMedicareTaxRate   float64 `json:"additional_medicare_tax_rate"`
+	AdditionalMedicareThreshold float64 `json:"additional_medicare_threshold"`
+
+	// Provisional income above the base amount makes up to half of Social
+	// Security benefits taxable, and above the adjusted base amount up to 85%
+	SocialSecurityBaseAmount         float64 `json:"social_security_base_amount"`
+	SocialSecurityAdjustedBaseAmount float64 `json:"social_security_adjusted_base_amount"`
+
+	QBIDeduction QBIRules          `json:"qbi_deduction"`
+	EstimatedTax EstimatedTaxRules `json:"estimated_tax"`
+
+	Medicare           MedicarePremiums   `json:"medicare"`
+	ContributionLimits ContributionLimits `json:"contribution_limits"`
+
+	// brackets are FederalBrackets with their upper bounds
+	brackets []TaxBracket
+}
+
+// ParseTaxYearData parses and validates a tax year data file
+func ParseTaxYearData(data []byte) (*TaxYearData, error) {
+	var year TaxYearData
+	if err := json.Unmarshal(data, &year); err != nil {
+		return nil, fmt.Errorf("parsing tax year data: %w", err)
+	}
+	if year.TaxYear < 2000 || year.TaxYear > 2100 {
+		return nil, errors.New("tax_year must be between 2000 and 2100")
+	}
+	if len(year.FederalBrackets) == 0 || year.FederalBrackets[0].Over != 0 {
+		return nil, errors.New("federal_brackets must start at 0")
+	}
+	brackets, err := thresholdBrackets(year.FederalBrackets)
+	if err != nil {
+		return nil, fmt.Errorf("federal_brackets: %w", err)
+	}
+	year.brackets = brackets
+
+	if year.StandardDeduction < 0 || year.SocialSecurityWageBase <= 0 || year.AdditionalMedicareThreshold <= 0 {
+		return nil, errors.New("standard_deduction, social_security_wage_base and additional_medicare_threshold must be positive")
+	}
+	if year.SocialSecurityBaseAmount <= 0 || year.SocialSecurityAdjustedBaseAmount <= year.SocialSecurityBaseAmount {
+		return nil, errors.New("social_security_adjusted_base_amount must be above a positive social_security_base_amount")
+	}
+	if err := year.validateSelfEmployment(); err != nil {
+		return nil, err
+	}
+	if err := year.Medicare.validate(); err != nil {
+		return nil, err
+	}
+	for _, rate := range []float64{year.SocialSecurityTaxRate, year.MedicareTaxRate, year.AdditionalMedicareTaxRate} {
+		if rate < 0 || rate > 1 {
+			return nil, errors.New("payroll tax rates must be between 0 and 1")
+		}
+	}
+	return &year, nil
+}
+
+// thresholdBrackets converts brackets by starting income, in order, to
+// brackets with upper bounds
+func thresholdBrackets(thresholds []RateThreshold) ([]TaxBracket, error) {
+	brackets := make([]TaxBracket, 0, len(thresholds))
+	for i, threshold := range thresholds {
+		if i > 0 && threshold.Over <= thresholds[i-1].Over {
+			return nil, errors.New("brackets are out of order")
+		}
+		if threshold.Rate < 0 || threshold.Rate > 1 {
+			return nil, errors.New("bracket rates must be between 0 and 1")
+		}
+		upper := math.MaxFloat64
+		if i+1 < len(thresholds) {
+			upper = thresholds[i+1].Over
+		}
+		brackets = append(brackets, TaxBracket{threshold.Over, upper, threshold.Rate})
+	}
+	return brackets, nil
+}
+
+// Brackets returns the federal brackets with their upper bounds
+func (d *TaxYearData) Brackets() []TaxBracket {
+	return d.brackets
+}
+
+// TaxableSocialSecurity returns how much of a year's Social Security
+// benefits are taxable, given the year's other income less adjustments.
+// Provisional income, the other income plus half the benefits, between the
+// base and adjusted base amounts makes half of what it exceeds the base
+// amount by taxable, up to half the benefits; above the adjusted base amount
+// 85% of the excess is added, up to 85% of the benefits.
+func (d *TaxYearData) TaxableSocialSecurity(benefits, otherIncome float64) float64 {
+	if benefits <= 0 {
+		return 0
+	}
+	provisional := otherIncome + benefits/2
+	if provisional <= d.SocialSecurityBaseAmount {
+		return 0
+	}
+	tier := d.SocialSecurityAdjustedBaseAmount - d.SocialSecurityBaseAmount
+	if provisional <= d.SocialSecurityAdjustedBaseAmount {
+		return math.Min(benefits/2, (provisional-d.SocialSecurityBaseAmount)/2)
+	}
+	return math.Min(benefits*0.85,
+		(provisional-d.SocialSecurityAdjustedBaseAmount)*0.85+math.Min(benefits/2, tier/2))
+}
+
+// SocialSecurityInclusionRate returns how much more of the benefits become
+// taxable for each further dollar of other income: 0, 0.5 or 0.85. Income
+// taxed at this rate on top of its own is the "tax torpedo".
+func (d *TaxYearData) SocialSecurityInclusionRate(benefits, otherIncome float64) float64 {
+	if benefits <= 0 || otherIncome+benefits/2 < d.SocialSecurityBaseAmount {
+		return 0
+	}
+	taxable := d.TaxableSocialSecurity(benefits, otherIncome)
+	switch {
+	case otherIncome+benefits/2 < d.SocialSecurityAdjustedBaseAmount:
+		if taxable < benefits/2 {
+			return 0.5
+		}
+	case taxable < benefits*0.85:
+		return 0.85
+	}
+	return 0
+}
+
+// TaxDataStore holds the tax year data sets and which one is active
+type TaxDataStore struct {
+	mu     sync.RWMutex
+	years  map[int]*TaxYearData
+	active int
+	// client saves installed years, nil to keep them in memory
+	client *ent.Client
+	// revision counts changes to the years or which is active
+	revision uint64
+}
+
+// NewTaxDataStore creates a store of the built-in tax years, the latest
+// active
+func NewTaxDataStore() (*TaxDataStore, error) {
+	store := &TaxDataStore{years: make(map[int]*TaxYearData)}
+	if err := store.loadFS(taxYearFiles, "data/tax_years"); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// defaultTaxData is the store analyses take their tax figures from
+var defaultTaxData = sync.OnceValue(func() *TaxDataStore {
+	store, err := NewTaxDataStore()
+	if err != nil {
+		panic(fmt.Sprintf("retirement: %v", err))
+	}
+	return store
+})
+
+// DefaultTaxData returns the store analyses take their tax figures from
+func DefaultTaxData() *TaxDataStore {
+	return defaultTaxData()
+}
+
+// LoadDB adds the tax years installed in the database, replacing built-in
+// years with the same tax year, and saves years installed from now on
+// there. The latest year becomes active.
+func (s *TaxDataStore) LoadDB(ctx context.Context, client *ent.Client) error {
+	rows, err := client.TaxYear.Query().
+		Order(taxyear.ByID()).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("getting installed tax years: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, row := range rows {
+		year, err := ParseTaxYearData(row.Data)
+		if err != nil {
+			return fmt.Errorf("loading installed tax year %d: %w", row.ID, err)
+		}
+		s.years[year.TaxYear] = year
+		s.active = max(s.active, year.TaxYear)
+	}
+	s.client = client
+	s.revision++
+	return nil
+}
+
+// loadFS adds the tax years in the .json files of dir in fsys and activates
+// the latest
+func (s *TaxDataStore) loadFS(fsys fs.FS, dir string) error {
+	files, err := fs.Glob(fsys, path.Join(dir, "*.json"))
+	if err != nil {
+		return fmt.Errorf("listing tax year files: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, file := range files {
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", file, err)
+		}
+		year, err := ParseTaxYearData(data)
+		if err != nil {
+			return fmt.Errorf("loading %s: %w", file, err)
+		}
+		s.years[year.TaxYear] = year
+		s.active = max(s.active, year.TaxYear)
+	}
+	s.revision++
+	if len(s.years) == 0 {
+		return errors.New("no tax year data")
+	}
+	return nil
+}
+
+// Install parses a tax year data file and adds it, replacing any data for
+// the same year, saving it when the store was loaded from the database. The
+// year becomes active if activate is set.
+func (s *TaxDataStore) Install(ctx context.Context, data []byte, activate bool) (*TaxYearData, error) {
+	year, err := ParseTaxYearData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.client != nil {
+		err := s.client.TaxYear.Create().
+			SetID(year.TaxYear).
+			SetData(data).
+			OnConflictColumns(taxyear.FieldID).
+			Update(func(u *ent.TaxYearUpsert) {
+				u.UpdateData()
+				u.UpdateUpdatedAt()
+			}).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("saving tax year data: %w", err)
+		}
+	}
+	s.years[year.TaxYear] = year
+	if activate {
+		s.active = year.TaxYear
+	}
+	s.revision++
+	return year, nil
+}
+
+// Activate makes a loaded tax year the one analyses use
+func (s *TaxDataStore) Activate(taxYear int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.years[taxYear]; !ok {
+		return fmt.Errorf("no data for tax year %d", taxYear)
+	}
+	s.active = taxYear
+	s.revision++
+	return nil
+}
+
+// Revision changes whenever a tax year is loaded, installed or activated,
+// so results computed with the store's figures can tell they are stale
+func (s *TaxDataStore) Revision() uint64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.revision
+}
+
+// Active returns the tax year analyses use
+func (s *TaxDataStore) Active() *TaxYearData {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.years[s.active]
+}
+
+// Get returns the data of a tax year
+func (s *TaxDataStore) Get(taxYear int) (*TaxYearData, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	year, ok := s.years[taxYear]
+	return year, ok
+}
+
+// Years returns the loaded tax years, oldest first
+func (s *TaxDataStore) Years() []int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	years := make([]int, 0, len(s.years))
+	for year := range s.years {
+		years = append(years, year)
+	}
+	sort.Ints(years)
+	return years
+}
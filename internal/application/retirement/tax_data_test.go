@@ -0,0 +1,150 @@
+package retirement
+
+import (
+	"context"
+	"encoding/json"
+	"io/fs"
+	"math"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaxDataStoreBuiltIn(t *testing.T) {
+	store, err := NewTaxDataStore()
+	require.NoError(t, err)
+
+	active := store.Active()
+	require.NotNil(t, active)
+	assert.Equal(t, store.Years()[len(store.Years())-1], active.TaxYear)
+
+	year, ok := store.Get(2024)
+	require.True(t, ok)
+	assert.Equal(t, 29200.0, year.StandardDeduction)
+	assert.Equal(t, 168600.0, year.SocialSecurityWageBase)
+	assert.Equal(t, 23000.0, year.ContributionLimits.Traditional401k)
+
+	brackets := year.Brackets()
+	require.Len(t, brackets, 7)
+	assert.Equal(t, TaxBracket{0, 23200, 0.10}, brackets[0])
+	assert.Equal(t, TaxBracket{731200, math.MaxFloat64, 0.37}, brackets[6])
+}
+
+func TestBundledTaxYearsParse(t *testing.T) {
+	files, err := fs.Glob(taxYearFiles, "data/tax_years/*.json")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, file := range files {
+		t.Run(file, func(t *testing.T) {
+			data, err := taxYearFiles.ReadFile(file)
+			require.NoError(t, err)
+			year, err := ParseTaxYearData(data)
+			require.NoError(t, err)
+			assert.Equal(t, strconv.Itoa(year.TaxYear)+".json", filepath.Base(file), "files are named after their tax year")
+		})
+	}
+}
+
+// taxYearFile returns the 2024 data file relabelled as taxYear, with the
+// standard deduction changed
+func taxYearFile(t *testing.T, taxYear int, standardDeduction float64) []byte {
+	data, err := taxYearFiles.ReadFile("data/tax_years/2024.json")
+	require.NoError(t, err)
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(data, &fields))
+	fields["tax_year"] = taxYear
+	fields["standard_deduction"] = standardDeduction
+	data, err = json.Marshal(fields)
+	require.NoError(t, err)
+	return data
+}
+
+func TestTaxDataStoreInstall(t *testing.T) {
+	store, err := NewTaxDataStore()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// Installing without activating leaves analyses on the current year
+	year, err := store.Install(ctx, taxYearFile(t, 2025, 30000), false)
+	require.NoError(t, err)
+	assert.Equal(t, 2025, year.TaxYear)
+	assert.Equal(t, 2024, store.Active().TaxYear)
+	assert.Equal(t, []int{2024, 2025}, store.Years())
+
+	require.NoError(t, store.Activate(2025))
+	assert.Equal(t, 30000.0, store.Active().StandardDeduction)
+	assert.Error(t, store.Activate(2030))
+
+	_, err = store.Install(ctx, []byte("{}"), true)
+	assert.Error(t, err)
+	assert.Equal(t, 2025, store.Active().TaxYear)
+}
+
+func TestParseTaxYearDataInvalid(t *testing.T) {
+	_, err := ParseTaxYearData([]byte("{"))
+	assert.Error(t, err)
+
+	_, err = ParseTaxYearData(taxYearFile(t, 1900, 30000))
+	assert.Error(t, err)
+
+	_, err = ParseTaxYearData(taxYearFile(t, 2025, -1))
+	assert.Error(t, err)
+
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(taxYearFile(t, 2025, 30000), &fields))
+	fields["federal_brackets"] = []map[string]float64{{"over": 0, "rate": 0.1}, {"over": 0, "rate": 0.2}}
+	data, err := json.Marshal(fields)
+	require.NoError(t, err)
+	_, err = ParseTaxYearData(data)
+	assert.Error(t, err)
+}
+
+func TestTaxableSocialSecurity(t *testing.T) {
+	year, ok := DefaultTaxData().Get(2024)
+	require.True(t, ok)
+
+	tests := []struct {
+		name          string
+		benefits      float64
+		otherIncome   float64
+		wantTaxable   float64
+		wantInclusion float64
+	}{
+		{"no benefits", 0, 100000, 0, 0},
+		// Provisional income 30,000 is under the 32,000 base amount
+		{"under base amount", 30000, 15000, 0, 0},
+		// Provisional income 40,000: half the 8,000 over the base amount
+		{"between base amounts", 30000, 25000, 4000, 0.5},
+		// Provisional income 60,000: 85% of 16,000 plus the 6,000 first tier
+		{"over adjusted base amount", 30000, 45000, 19600, 0.85},
+		// 85% of benefits is the most that is ever taxable
+		{"capped at 85%", 30000, 200000, 25500, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.wantTaxable, year.TaxableSocialSecurity(tt.benefits, tt.otherIncome), 1e-9)
+			assert.Equal(t, tt.wantInclusion, year.SocialSecurityInclusionRate(tt.benefits, tt.otherIncome))
+		})
+	}
+}
+
+func TestCalculateTaxImpactSocialSecurity(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	// Benefits alone stay under the base amount and aren't taxed
+	analysis := service.CalculateTaxImpact(YearCashFlow{Age: 70, SocialSecurity: 40000}, config, true)
+	assert.Equal(t, 0.0, analysis.TaxableSocialSecurity)
+	assert.Equal(t, 0.0, analysis.TaxableIncome)
+	assert.Equal(t, 40000.0, analysis.GrossIncome)
+
+	// A pension makes 85% of benefits taxable
+	analysis = service.CalculateTaxImpact(YearCashFlow{Age: 70, SocialSecurity: 40000, Pension: 80000}, config, true)
+	assert.InDelta(t, 34000, analysis.TaxableSocialSecurity, 1e-9)
+	assert.InDelta(t, 80000+34000-29200, analysis.TaxableIncome, 1e-9)
+}
@@ -0,0 +1,90 @@
+package retirement
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// =============================================================================
+// Tax Strategy Comparison
+// =============================================================================
+
+// Comparing withdrawal strategies projects the plan once per strategy. The
+// projections are independent, so they run concurrently, up to a number of
+// workers at a time; the first to fail stops the rest.
+
+// WithdrawalStrategies are the strategies a comparison runs, in the order
+// they are reported
+func WithdrawalStrategies() []WithdrawalStrategy {
+	return []WithdrawalStrategy{ProRata, TaxableFirst, TraditionalFirst, RothFirst, TaxOptimized}
+}
+
+// StrategyResult is one strategy's projection in a comparison
+type StrategyResult struct {
+	Strategy WithdrawalStrategy
+	Results  *CashFlowResults
+	Outcome  StrategyOutcome
+}
+
+// CompareTaxStrategiesConcurrently projects config under each withdrawal
+// strategy, running up to workers at a time (one per CPU when 0). progress,
+// when set, is called with each strategy's results as it finishes; calls
+// are never concurrent.
+func (s *CashFlowService) CompareTaxStrategiesConcurrently(ctx context.Context, config CashFlowConfig, workers int, progress func(result StrategyResult, completed, total int)) (map[WithdrawalStrategy]*CashFlowResults, error) {
+	strategies := WithdrawalStrategies()
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(map[WithdrawalStrategy]*CashFlowResults, len(strategies))
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	slots := make(chan struct{}, workers)
+	for _, strategy := range strategies {
+		wg.Add(1)
+		go func(strategy WithdrawalStrategy) {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			var result *CashFlowResults
+			err := ctx.Err()
+			if err == nil {
+				testConfig := config
+				testConfig.WithdrawalStrategy = strategy
+				result, err = s.RunAnalysisWithConfig(testConfig)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			results[strategy] = result
+			if progress != nil {
+				progress(StrategyResult{
+					Strategy: strategy,
+					Results:  result,
+					Outcome:  strategyOutcome(result),
+				}, len(results), len(strategies))
+			}
+		}(strategy)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return results, nil
+}
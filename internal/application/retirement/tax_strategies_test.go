@@ -0,0 +1,49 @@
+package retirement
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareTaxStrategiesConcurrently(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	seen := make(map[WithdrawalStrategy]bool)
+	var calls []int
+	results, err := service.CompareTaxStrategiesConcurrently(context.Background(), config, 2, func(result StrategyResult, completed, total int) {
+		assert.Equal(t, len(WithdrawalStrategies()), total)
+		assert.NotNil(t, result.Results)
+		seen[result.Strategy] = true
+		calls = append(calls, completed)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, calls)
+	require.Len(t, results, len(WithdrawalStrategies()))
+
+	// Each strategy matches running it alone
+	for _, strategy := range WithdrawalStrategies() {
+		assert.True(t, seen[strategy])
+		alone := config
+		alone.WithdrawalStrategy = strategy
+		expected, err := service.RunAnalysisWithConfig(alone)
+		require.NoError(t, err)
+		assert.Equal(t, expected.TotalLifetimeTax, results[strategy].TotalLifetimeTax)
+		assert.Equal(t, expected.YearlyFlows, results[strategy].YearlyFlows)
+	}
+}
+
+func TestCompareTaxStrategiesCancelled(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = service.CompareTaxStrategiesConcurrently(ctx, config, 1, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+}
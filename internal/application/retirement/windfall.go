@@ -0,0 +1,80 @@
+package retirement
+
+import (
+	"errors"
+	"fmt"
+)
+
+// =============================================================================
+// Windfalls and Inheritances
+// =============================================================================
+
+// A windfall is a one-time inflow in today's dollars, received at the start
+// of the year the first person turns Age. Cash goes to the taxable balance
+// and, when Taxable, is ordinary income that year; an inheritance isn't. An
+// inherited traditional or Roth IRA is kept apart and paid out over the ten
+// years after it is received under the 10-year rule (see inherited_ira.go).
+
+// WindfallAccount is where a windfall is placed
+type WindfallAccount string
+
+const (
+	WindfallTaxable       WindfallAccount = "taxable"
+	WindfallInheritedIRA  WindfallAccount = "inherited_ira"
+	WindfallInheritedRoth WindfallAccount = "inherited_roth"
+)
+
+// Windfall is a one-time inflow such as an inheritance, gift or sale
+type Windfall struct {
+	Name    string
+	Amount  float64
+	Age     int
+	Taxable bool
+	Account WindfallAccount
+}
+
+// validateWindfalls checks each windfall's amount, age and account
+func validateWindfalls(config CashFlowConfig) error {
+	for _, w := range config.Windfalls {
+		if w.Amount < 0 {
+			return errors.New("windfall Amount cannot be negative")
+		}
+		if w.Age < config.CurrentAge || w.Age >= config.LifeExpectancy {
+			return errors.New("windfall Age must be between CurrentAge and LifeExpectancy")
+		}
+		switch w.Account {
+		case WindfallTaxable:
+		case WindfallInheritedIRA, WindfallInheritedRoth:
+			if w.Taxable {
+				return errors.New("an inherited IRA is taxed, if at all, as it is distributed, not when received")
+			}
+		default:
+			return fmt.Errorf("unknown windfall account %q", w.Account)
+		}
+	}
+	return nil
+}
+
+// receive adds a plan year's windfalls to the household's flows; inherited
+// IRAs start paying out the following year
+func (a *inheritedAccounts) receive(config CashFlowConfig, flow *YearCashFlow, inflationFactor float64) {
+	for _, w := range config.Windfalls {
+		if w.Age != flow.Age {
+			continue
+		}
+		amount := w.Amount * inflationFactor
+		if w.Account == WindfallInheritedIRA || w.Account == WindfallInheritedRoth {
+			a.accounts = append(a.accounts, &inheritedAccount{
+				balance:   amount,
+				roth:      w.Account == WindfallInheritedRoth,
+				yearsLeft: inheritedIRAYears,
+				fromAge:   flow.Age + 1,
+			})
+			continue
+		}
+		flow.Windfall += amount
+		if w.Taxable {
+			flow.TaxableWindfall += amount
+		}
+	}
+}
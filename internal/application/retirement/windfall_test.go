@@ -0,0 +1,96 @@
+package retirement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceiveWindfalls(t *testing.T) {
+	config := CashFlowConfig{Windfalls: []Windfall{
+		{Name: "gift", Amount: 10000, Age: 60, Account: WindfallTaxable},
+		{Name: "bonus", Amount: 5000, Age: 60, Taxable: true, Account: WindfallTaxable},
+		{Name: "parent's IRA", Amount: 100000, Age: 60, Account: WindfallInheritedIRA},
+		{Name: "parent's Roth", Amount: 50000, Age: 61, Account: WindfallInheritedRoth},
+	}}
+	taxYear := DefaultTaxData().Active()
+	var accounts inheritedAccounts
+
+	// Cash arrives at once, grown with inflation; the IRA waits a year
+	flow := YearCashFlow{Age: 60}
+	accounts.receive(config, &flow, 2)
+	accounts.distribute(config, &flow, taxYear)
+	assert.Equal(t, 30000.0, flow.Windfall)
+	assert.Equal(t, 10000.0, flow.TaxableWindfall)
+	assert.Equal(t, 0.0, flow.InheritedIRADistribution)
+	traditional, roth := accounts.grow(0, 0)
+	assert.Equal(t, 200000.0, traditional)
+	assert.Equal(t, 0.0, roth)
+
+	// ...then pays out in equal shares of what is left over ten years
+	total := 0.0
+	for age := 61; age <= 70; age++ {
+		flow = YearCashFlow{Age: age}
+		accounts.receive(config, &flow, 2)
+		accounts.distribute(config, &flow, taxYear)
+		assert.Equal(t, 0.0, flow.Windfall)
+		assert.Equal(t, 0.0, flow.InheritedRothDistribution)
+		total += flow.InheritedIRADistribution
+		accounts.grow(0.05, 0.05)
+	}
+	traditional, roth = accounts.grow(0, 0)
+	assert.InDelta(t, 0, traditional, 1e-6)
+	assert.Greater(t, total, 200000.0)
+	assert.Positive(t, roth)
+
+	// The Roth grows untouched until its last year
+	flow = YearCashFlow{Age: 71}
+	accounts.distribute(config, &flow, taxYear)
+	assert.Equal(t, 0.0, flow.InheritedIRADistribution)
+	assert.Equal(t, roth, flow.InheritedRothDistribution)
+}
+
+func TestCashFlowWindfalls(t *testing.T) {
+	config := DefaultCashFlowConfig()
+	service, err := NewCashFlowService(config)
+	require.NoError(t, err)
+	baseline, err := service.RunAnalysis()
+	require.NoError(t, err)
+
+	age := config.CurrentAge + 5
+	config.Windfalls = []Windfall{{Name: "inheritance", Amount: 200000, Age: age, Account: WindfallTaxable}}
+	inheritance, err := service.RunAnalysisWithConfig(config)
+	require.NoError(t, err)
+
+	// An inheritance isn't income
+	year := inheritance.YearlyFlows[5]
+	assert.Positive(t, year.Windfall)
+	assert.Equal(t, baseline.YearlyFlows[5].TotalTax, year.TotalTax)
+	assert.Greater(t, inheritance.YearlyFlows[5].TotalPortfolio, baseline.YearlyFlows[5].TotalPortfolio)
+
+	// A taxable windfall is
+	config.Windfalls[0].Taxable = true
+	taxed, err := service.RunAnalysisWithConfig(config)
+	require.NoError(t, err)
+	assert.Greater(t, taxed.YearlyFlows[5].TotalTax, year.TotalTax)
+
+	// An inherited IRA is taxed as it is emptied over the next ten years
+	config.Windfalls[0] = Windfall{Name: "inherited IRA", Amount: 200000, Age: age, Account: WindfallInheritedIRA}
+	ira, err := service.RunAnalysisWithConfig(config)
+	require.NoError(t, err)
+	assert.Positive(t, ira.YearlyFlows[5].InheritedIRABalance)
+	for i := 6; i <= 15; i++ {
+		assert.Positive(t, ira.YearlyFlows[i].InheritedIRADistribution, i)
+		assert.Greater(t, ira.YearlyFlows[i].TotalTax, baseline.YearlyFlows[i].TotalTax, i)
+	}
+	assert.InDelta(t, 0, ira.YearlyFlows[15].InheritedIRABalance, 1e-6)
+	assert.Equal(t, 0.0, ira.YearlyFlows[16].InheritedIRADistribution)
+
+	config.Windfalls[0].Taxable = true
+	assert.Error(t, validateCashFlowConfig(config))
+	config.Windfalls[0] = Windfall{Amount: 1000, Age: config.LifeExpectancy, Account: WindfallTaxable}
+	assert.Error(t, validateCashFlowConfig(config))
+	config.Windfalls[0] = Windfall{Amount: 1000, Age: age, Account: "brokerage"}
+	assert.Error(t, validateCashFlowConfig(config))
+}
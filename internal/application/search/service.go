@@ -0,0 +1,385 @@
+// Package search finds a user's indexed email messages and receipts across
+// all of their connections.
+//
+// Matching uses Postgres full-text search with the simple configuration, so
+// words aren't stemmed and amounts such as 42.50 match as written. Messages
+// are searched by subject, sender, snippet and mentioned amount; receipts
+// by file name, merchant, OCR text, receipt number, notes and total.
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+
+	"clockzen-next/internal/application/organizations"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/emaillabel"
+	"clockzen-next/internal/ent/emailmessage"
+	"clockzen-next/internal/ent/predicate"
+	"clockzen-next/internal/ent/receipt"
+)
+
+// Errors returned by the search service
+var (
+	ErrInvalidDateRange   = errors.New("from must not be after to")
+	ErrInvalidAmountRange = errors.New("min_amount must not be more than max_amount")
+	ErrInvalidType        = errors.New("type must be email or receipt")
+	ErrLabelNotFound      = errors.New("label not found")
+)
+
+// Result types
+const (
+	TypeEmail   = "email"
+	TypeReceipt = "receipt"
+)
+
+// DefaultLimit and MaxLimit bound the number of results returned
+const (
+	DefaultLimit = 50
+	MaxLimit     = 200
+)
+
+// Query describes what to search for. Every set filter must match.
+type Query struct {
+	// Text is matched against the indexed text; empty matches everything
+	Text string
+	// From and To bound when a message was received or a receipt dated
+	From *time.Time
+	To   *time.Time
+	// LabelID is an email label; receipts then only match if they were
+	// imported from a message with the label
+	LabelID string
+	// HasAttachment filters messages by whether they have attachments.
+	// Receipts are files, so they only match when it is unset or true.
+	HasAttachment *bool
+	// MinAmount and MaxAmount bound a receipt's total or the amount a
+	// message mentions
+	MinAmount *float64
+	MaxAmount *float64
+	// Type limits results to messages or receipts; empty returns both
+	Type  string
+	Limit int
+}
+
+// Result is a matching message or receipt
+type Result struct {
+	Type         string
+	ID           string
+	ConnectionID string
+	// MessageID is the provider's message ID, for receipts imported from
+	// email too
+	MessageID      string
+	Title          string
+	Snippet        string
+	Date           *time.Time
+	Amount         *float64
+	HasAttachments bool
+	// Rank orders results; higher is more relevant
+	Rank float64
+}
+
+// Service searches indexed messages and receipts
+type Service struct {
+	entClient *ent.Client
+}
+
+// NewService creates a new search service
+func NewService(entClient *ent.Client) *Service {
+	return &Service{
+		entClient: entClient,
+	}
+}
+
+// Search returns the user's messages and receipts matching the query, most
+// relevant first and then newest first
+func (s *Service) Search(ctx context.Context, userID string, query Query) ([]Result, error) {
+	if err := query.validate(); err != nil {
+		return nil, err
+	}
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	limit = min(limit, MaxLimit)
+
+	connectionIDs, err := s.entClient.EmailConnection.Query().
+		Where(organizations.OwnedBy[predicate.EmailConnection](userID)).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying connections: %w", err)
+	}
+
+	var label *ent.EmailLabel
+	if query.LabelID != "" {
+		label, err = s.entClient.EmailLabel.Query().
+			Where(emaillabel.ID(query.LabelID), emaillabel.ConnectionIDIn(connectionIDs...)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return nil, ErrLabelNotFound
+		}
+		if err != nil {
+			return nil, fmt.Errorf("querying label: %w", err)
+		}
+	}
+
+	var results []Result
+	if query.Type == "" || query.Type == TypeEmail {
+		messages, err := s.searchMessages(ctx, connectionIDs, label, query, limit)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, messages...)
+	}
+	if query.Type == "" || query.Type == TypeReceipt {
+		receipts, err := s.searchReceipts(ctx, userID, connectionIDs, label, query, limit)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, receipts...)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Rank != results[j].Rank {
+			return results[i].Rank > results[j].Rank
+		}
+		return dateOf(results[i]).After(dateOf(results[j]))
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+// validate checks the query's filters
+func (q Query) validate() error {
+	if q.From != nil && q.To != nil && q.From.After(*q.To) {
+		return ErrInvalidDateRange
+	}
+	if q.MinAmount != nil && q.MaxAmount != nil && *q.MinAmount > *q.MaxAmount {
+		return ErrInvalidAmountRange
+	}
+	if q.Type != "" && q.Type != TypeEmail && q.Type != TypeReceipt {
+		return ErrInvalidType
+	}
+	return nil
+}
+
+// searchMessages searches the messages of the user's connections
+func (s *Service) searchMessages(ctx context.Context, connectionIDs []string, label *ent.EmailLabel, query Query, limit int) ([]Result, error) {
+	if len(connectionIDs) == 0 {
+		return nil, nil
+	}
+
+	predicates := []predicate.EmailMessage{emailmessage.ConnectionIDIn(connectionIDs...)}
+	if query.Text != "" {
+		predicates = append(predicates, predicate.EmailMessage(matches(query.Text,
+			emailmessage.FieldSubject, emailmessage.FieldSender, emailmessage.FieldSnippet, emailmessage.FieldAmount)))
+	}
+	if query.From != nil {
+		predicates = append(predicates, emailmessage.ReceivedAtGTE(*query.From))
+	}
+	if query.To != nil {
+		predicates = append(predicates, emailmessage.ReceivedAtLTE(*query.To))
+	}
+	if label != nil {
+		predicates = append(predicates, labelled(label))
+	}
+	if query.HasAttachment != nil {
+		predicates = append(predicates, emailmessage.HasAttachments(*query.HasAttachment))
+	}
+	if query.MinAmount != nil {
+		predicates = append(predicates, emailmessage.AmountGTE(*query.MinAmount))
+	}
+	if query.MaxAmount != nil {
+		predicates = append(predicates, emailmessage.AmountLTE(*query.MaxAmount))
+	}
+
+	messages, err := s.entClient.EmailMessage.Query().
+		Where(predicates...).
+		Order(ent.Desc(emailmessage.FieldReceivedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("searching messages: %w", err)
+	}
+
+	results := make([]Result, len(messages))
+	for i, m := range messages {
+		results[i] = Result{
+			Type:           TypeEmail,
+			ID:             m.ID,
+			ConnectionID:   m.ConnectionID,
+			MessageID:      m.MessageID,
+			Title:          m.Subject,
+			Snippet:        m.Snippet,
+			Date:           m.ReceivedAt,
+			Amount:         m.Amount,
+			HasAttachments: m.HasAttachments,
+			Rank:           rank(query.Text, m.Subject, m.Sender, m.Snippet),
+		}
+	}
+	return results, nil
+}
+
+// searchReceipts searches the user's receipts
+func (s *Service) searchReceipts(ctx context.Context, userID string, connectionIDs []string, label *ent.EmailLabel, query Query, limit int) ([]Result, error) {
+	if query.HasAttachment != nil && !*query.HasAttachment {
+		return nil, nil
+	}
+
+	predicates := []predicate.Receipt{receipt.UserID(userID)}
+	if query.Text != "" {
+		predicates = append(predicates, predicate.Receipt(matches(query.Text,
+			receipt.FieldFileName, receipt.FieldMerchantName, receipt.FieldOcrText,
+			receipt.FieldReceiptNumber, receipt.FieldNotes, receipt.FieldTotalAmount)))
+	}
+	if query.From != nil {
+		predicates = append(predicates, receipt.ReceiptDateGTE(*query.From))
+	}
+	if query.To != nil {
+		predicates = append(predicates, receipt.ReceiptDateLTE(*query.To))
+	}
+	if query.MinAmount != nil {
+		predicates = append(predicates, receipt.TotalAmountGTE(*query.MinAmount))
+	}
+	if query.MaxAmount != nil {
+		predicates = append(predicates, receipt.TotalAmountLTE(*query.MaxAmount))
+	}
+	if label != nil {
+		// Receipts from a labelled message, found by the message's
+		// provider ID
+		messageIDs, err := s.entClient.EmailMessage.Query().
+			Where(emailmessage.ConnectionID(label.ConnectionID), labelled(label)).
+			Select(emailmessage.FieldMessageID).
+			Strings(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("querying labelled messages: %w", err)
+		}
+		predicates = append(predicates,
+			receipt.SourceTypeEQ(receipt.SourceTypeEmail),
+			receipt.SourceConnectionID(label.ConnectionID),
+			receipt.SourceIDIn(messageIDs...),
+		)
+	}
+
+	receipts, err := s.entClient.Receipt.Query().
+		Where(predicates...).
+		Order(ent.Desc(receipt.FieldReceiptDate), ent.Desc(receipt.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("searching receipts: %w", err)
+	}
+
+	results := make([]Result, len(receipts))
+	for i, r := range receipts {
+		result := Result{
+			Type:           TypeReceipt,
+			ID:             r.ID,
+			Title:          r.FileName,
+			Date:           r.ReceiptDate,
+			Amount:         r.TotalAmount,
+			HasAttachments: true,
+		}
+		if r.MerchantName != nil {
+			result.Title = *r.MerchantName
+		}
+		if r.OcrText != nil {
+			result.Snippet = excerpt(*r.OcrText, query.Text)
+		}
+		if r.SourceType == receipt.SourceTypeEmail && r.SourceID != nil {
+			result.MessageID = *r.SourceID
+		}
+		if r.SourceConnectionID != nil {
+			result.ConnectionID = *r.SourceConnectionID
+		}
+		result.Rank = rank(query.Text, result.Title, deref(r.OcrText), deref(r.Notes))
+		results[i] = result
+	}
+	return results, nil
+}
+
+// matches is a full-text predicate over the given columns. Numeric columns
+// are matched by their text, so searching for an amount finds it.
+func matches(text string, columns ...string) func(*entsql.Selector) {
+	return func(s *entsql.Selector) {
+		parts := make([]string, len(columns))
+		for i, column := range columns {
+			parts[i] = fmt.Sprintf("coalesce(%s::text, '')", s.C(column))
+		}
+		s.Where(entsql.P(func(b *entsql.Builder) {
+			b.WriteString("to_tsvector('simple', " + strings.Join(parts, " || ' ' || ") + ") @@ websearch_to_tsquery('simple', ")
+			b.Arg(text)
+			b.WriteString(")")
+		}))
+	}
+}
+
+// labelled matches messages that have the label
+func labelled(label *ent.EmailLabel) predicate.EmailMessage {
+	return predicate.EmailMessage(func(s *entsql.Selector) {
+		s.Where(sqljson.ValueContains(emailmessage.FieldLabelIds, label.ProviderLabelID))
+	})
+}
+
+// rank scores how well fields match the search text: the share of its
+// words they contain, with earlier fields weighted higher. Matching in the
+// database decides what is returned; rank only orders it.
+func rank(text string, fields ...string) float64 {
+	words := strings.Fields(strings.ToLower(text))
+	if len(words) == 0 {
+		return 0
+	}
+
+	score := 0.0
+	for i, field := range fields {
+		field = strings.ToLower(field)
+		weight := 1 / float64(i+1)
+		for _, word := range words {
+			if strings.Contains(field, strings.Trim(word, `"-`)) {
+				score += weight
+			}
+		}
+	}
+	return score / float64(len(words))
+}
+
+// excerpt returns up to 200 characters of text around the first search word
+// it contains, or its start if none
+func excerpt(text, query string) string {
+	const size = 200
+	runes := []rune(text)
+	start := 0
+	lower := strings.ToLower(text)
+	for _, word := range strings.Fields(strings.ToLower(query)) {
+		if i := strings.Index(lower, strings.Trim(word, `"-`)); i >= 0 {
+			start = max(len([]rune(lower[:i]))-size/4, 0)
+			break
+		}
+	}
+	end := min(start+size, len(runes))
+	return strings.TrimSpace(string(runes[start:end]))
+}
+
+// dateOf returns a result's date, or the zero time if it has none
+func dateOf(r Result) time.Time {
+	if r.Date == nil {
+		return time.Time{}
+	}
+	return *r.Date
+}
+
+// deref returns the string a pointer points to, or "" for nil
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
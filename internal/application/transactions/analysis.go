@@ -0,0 +1,168 @@
+package transactions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/application/merchants"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/merchant"
+	"clockzen-next/internal/ent/predicate"
+	"clockzen-next/internal/ent/receipt"
+	"clockzen-next/internal/ent/transaction"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+)
+
+// spendingTypes are the transaction types counted as spending. Withdrawals
+// aren't: cash spent after a withdrawal is entered by hand as purchases, and
+// counting both would double the spending.
+var spendingTypes = []transaction.Type{
+	transaction.TypePurchase,
+	transaction.TypePayment,
+}
+
+// excludedStatuses are transactions whose money never left or came back
+var excludedStatuses = []transaction.Status{
+	transaction.StatusFailed,
+	transaction.StatusRefunded,
+	transaction.StatusCancelled,
+}
+
+// GetByUserID returns the user's spending between startDate and endDate for
+// analysis, whether extracted from receipts or entered by hand. The service
+// implements analysis.TransactionRepository.
+func (s *Service) GetByUserID(ctx context.Context, userID string, startDate, endDate time.Time) ([]analysis.Transaction, error) {
+	return s.spending(ctx, userID, startDate, endDate)
+}
+
+// GetByCategory returns the user's spending in a category between startDate
+// and endDate
+func (s *Service) GetByCategory(ctx context.Context, userID string, category analysis.SpendingCategory, startDate, endDate time.Time) ([]analysis.Transaction, error) {
+	if category == analysis.CategoryOther {
+		return s.spending(ctx, userID, startDate, endDate, transaction.Or(
+			transaction.MerchantCategoryIsNil(),
+			transaction.MerchantCategoryEQ(""),
+			transaction.MerchantCategoryEqualFold(string(category)),
+		))
+	}
+	return s.spending(ctx, userID, startDate, endDate, transaction.MerchantCategoryEqualFold(string(category)))
+}
+
+// GetIncome returns the user's deposits between startDate and endDate, in
+// which paychecks are detected. The service implements
+// analysis.IncomeRepository.
+func (s *Service) GetIncome(ctx context.Context, userID string, startDate, endDate time.Time) ([]analysis.Transaction, error) {
+	return s.query(ctx, userID, startDate, endDate, transaction.TypeEQ(transaction.TypeDeposit))
+}
+
+// GetContributions returns the user's transactions in a category, or
+// tagged with it, between startDate and endDate, whatever their type but
+// refunds: the transfers, deposits and payments that count toward a goal.
+// The service implements goals.ContributionRepository.
+func (s *Service) GetContributions(ctx context.Context, userID, category string, startDate, endDate time.Time) ([]analysis.Transaction, error) {
+	return s.query(ctx, userID, startDate, endDate,
+		transaction.TypeNEQ(transaction.TypeRefund),
+		transaction.Or(transaction.MerchantCategoryEqualFold(category), tagged(category)),
+	)
+}
+
+// tagged matches transactions that have the category tag
+func tagged(tag string) predicate.Transaction {
+	return predicate.Transaction(func(s *entsql.Selector) {
+		s.Where(sqljson.ValueContains(transaction.FieldCategoryTags, tag))
+	})
+}
+
+// spending queries the user's spending transactions, oldest first, each
+// attributed to a household member if it has one
+func (s *Service) spending(ctx context.Context, userID string, startDate, endDate time.Time, where ...predicate.Transaction) ([]analysis.Transaction, error) {
+	return s.query(ctx, userID, startDate, endDate, append(where, transaction.TypeIn(spendingTypes...))...)
+}
+
+// query queries the user's transactions, oldest first, each attributed to a
+// household member if it has one
+func (s *Service) query(ctx context.Context, userID string, startDate, endDate time.Time, where ...predicate.Transaction) ([]analysis.Transaction, error) {
+	members, err := s.membersByConnection(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := s.entClient.Transaction.Query().
+		Where(
+			transaction.UserID(userID),
+			transaction.TransactionDateGTE(startDate),
+			transaction.TransactionDateLTE(endDate),
+			transaction.StatusNotIn(excludedStatuses...),
+		).
+		Where(where...).
+		WithReceipt(func(q *ent.ReceiptQuery) {
+			q.Select(receipt.FieldSourceConnectionID)
+		}).
+		WithMerchant(func(q *ent.MerchantQuery) {
+			q.Select(merchant.FieldName)
+		}).
+		Order(ent.Asc(transaction.FieldTransactionDate)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying transactions: %w", err)
+	}
+
+	transactions := make([]analysis.Transaction, len(records))
+	for i, record := range records {
+		transactions[i] = toAnalysisTransaction(record)
+		transactions[i].MemberID = memberOf(record, members)
+	}
+	return transactions, nil
+}
+
+// memberOf returns the household member a transaction is attributed to: the
+// member it was assigned to by hand, else the member its receipt's
+// connection is assigned to. It is empty for shared spending.
+func memberOf(record *ent.Transaction, membersByConnection map[string]string) string {
+	if record.MemberID != nil {
+		return *record.MemberID
+	}
+	if r := record.Edges.Receipt; r != nil && r.SourceConnectionID != nil {
+		return membersByConnection[*r.SourceConnectionID]
+	}
+	return ""
+}
+
+// toAnalysisTransaction converts a stored transaction for spending analysis.
+// Transactions without a category are analyzed as "other". Merchants go by
+// their canonical name, or the cleaned-up merchant name until one is
+// linked, so spending at a merchant groups together.
+func toAnalysisTransaction(record *ent.Transaction) analysis.Transaction {
+	t := analysis.Transaction{
+		ID:              record.ID,
+		UserID:          record.UserID,
+		Amount:          record.Amount,
+		Category:        analysis.CategoryOther,
+		TransactionDate: record.TransactionDate,
+		IsRecurring:     record.IsRecurring,
+		Tags:            record.CategoryTags,
+	}
+	if record.MerchantCategory != nil && *record.MerchantCategory != "" {
+		t.Category = analysis.SpendingCategory(strings.ToLower(*record.MerchantCategory))
+	}
+	if m := record.Edges.Merchant; m != nil {
+		t.MerchantName = m.Name
+	} else if record.MerchantName != nil {
+		t.MerchantName = merchants.Normalize(*record.MerchantName)
+		if t.MerchantName == "" {
+			t.MerchantName = *record.MerchantName
+		}
+	}
+	if record.Description != nil {
+		t.Description = *record.Description
+	}
+	if record.CardLastFour != nil {
+		t.CardLastFour = *record.CardLastFour
+	}
+	return t
+}
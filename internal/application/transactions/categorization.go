@@ -0,0 +1,601 @@
+package transactions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/bulkoperation"
+	"clockzen-next/internal/ent/categorizationrule"
+	"clockzen-next/internal/ent/transaction"
+
+	"github.com/google/uuid"
+)
+
+// Errors returned for categorization rules
+var (
+	ErrCategorizationRuleNotFound = errors.New("categorization rule not found")
+	ErrInvalidRule                = errors.New("invalid categorization rule")
+)
+
+const (
+	// MaxRunChanges is the most changes a categorization run lists; the
+	// counts cover them all
+	MaxRunChanges = 100
+
+	// categorizationBatchSize is how many transactions a run categorizes at
+	// a time
+	categorizationBatchSize = 500
+
+	// draftRuleID identifies a previewed rule that hasn't been saved
+	draftRuleID = "draft"
+)
+
+// CategorizationRuleInput configures a categorization rule. A transaction
+// matches when it meets every criterion set: its merchant name matches
+// MerchantPattern (a regular expression, ignoring case), its amount is
+// within MinAmount and MaxAmount, and its description contains one of
+// DescriptionKeywords (ignoring case). At least one criterion must be set,
+// and the rule must assign a category or tags.
+type CategorizationRuleInput struct {
+	Name                string
+	Priority            int
+	Enabled             bool
+	MerchantPattern     string
+	MinAmount           *float64
+	MaxAmount           *float64
+	DescriptionKeywords []string
+	Category            string
+	Tags                []string
+}
+
+// CategorizationOptions configure a categorization run over the user's
+// transactions
+type CategorizationOptions struct {
+	// Overwrite recategorizes transactions that already have a category;
+	// otherwise only uncategorized ones are given one. Tags are only ever
+	// added.
+	Overwrite bool
+	// FilterID limits the run to the transactions a saved filter matches
+	FilterID string
+	// Draft is an unsaved rule previewed along with the user's rules
+	Draft *CategorizationRuleInput
+}
+
+// CategorizationChange is what a run changes about a transaction
+type CategorizationChange struct {
+	Transaction      *ent.Transaction
+	PreviousCategory *string
+	// Category is the category given, empty if the category is unchanged
+	Category  string
+	AddedTags []string
+	// RuleIDs are the rules the transaction matched, in priority order
+	RuleIDs []string
+}
+
+// CategorizationRun reports what running the user's categorization rules
+// over their transactions did, or would do in a preview
+type CategorizationRun struct {
+	UserID  string
+	DryRun  bool
+	Scanned int
+	// Matched counts transactions matching a rule, Changed those changed by
+	// it: Categorized given a category and Tagged given tags
+	Matched     int
+	Changed     int
+	Categorized int
+	Tagged      int
+	// Changes lists the first MaxRunChanges changes
+	Changes []CategorizationChange
+	// OperationIDs are the bulk operations journaling the changes applied,
+	// one a batch, which undo them
+	OperationIDs []string
+}
+
+// Categorization is what the rules a transaction matches assign it
+type Categorization struct {
+	// Category comes from the first matching rule that assigns one, and
+	// CategoryRuleID is that rule
+	Category       string
+	CategoryRuleID string
+	// Tags are those of every matching rule
+	Tags []string
+	// RuleIDs are the rules matched, in priority order
+	RuleIDs []string
+}
+
+// Categorizer matches transactions against a user's categorization rules
+type Categorizer struct {
+	rules []compiledRule
+}
+
+// compiledRule is a categorization rule ready to match transactions
+type compiledRule struct {
+	id        string
+	merchant  *regexp.Regexp
+	minAmount *float64
+	maxAmount *float64
+	keywords  []string
+	category  string
+	tags      []string
+}
+
+// NewCategorizer compiles a user's rules, ordered by priority (lowest
+// first, then oldest first). Disabled rules are left out.
+func NewCategorizer(rules []*ent.CategorizationRule) (*Categorizer, error) {
+	ordered := make([]*ent.CategorizationRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Enabled {
+			ordered = append(ordered, rule)
+		}
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].Priority != ordered[j].Priority {
+			return ordered[i].Priority < ordered[j].Priority
+		}
+		return ordered[i].CreatedAt.Before(ordered[j].CreatedAt)
+	})
+
+	c := &Categorizer{rules: make([]compiledRule, len(ordered))}
+	for i, rule := range ordered {
+		compiled := compiledRule{
+			id:        rule.ID,
+			minAmount: rule.MinAmount,
+			maxAmount: rule.MaxAmount,
+			tags:      rule.Tags,
+		}
+		if rule.MerchantPattern != nil {
+			pattern, err := regexp.Compile("(?i)" + *rule.MerchantPattern)
+			if err != nil {
+				return nil, fmt.Errorf("%w: rule %s: merchant_pattern: %v", ErrInvalidRule, rule.ID, err)
+			}
+			compiled.merchant = pattern
+		}
+		for _, keyword := range rule.DescriptionKeywords {
+			compiled.keywords = append(compiled.keywords, strings.ToLower(keyword))
+		}
+		if rule.Category != nil {
+			compiled.category = *rule.Category
+		}
+		c.rules[i] = compiled
+	}
+	return c, nil
+}
+
+// Categorize returns what the rules matching a transaction assign it
+func (c *Categorizer) Categorize(merchantName, description string, amount float64) Categorization {
+	var result Categorization
+	description = strings.ToLower(description)
+	for _, rule := range c.rules {
+		if !rule.matches(merchantName, description, amount) {
+			continue
+		}
+		result.RuleIDs = append(result.RuleIDs, rule.id)
+		if result.Category == "" && rule.category != "" {
+			result.Category = rule.category
+			result.CategoryRuleID = rule.id
+		}
+		result.Tags = mergeTags(result.Tags, rule.tags)
+	}
+	return result
+}
+
+// matches reports whether a transaction meets every criterion of the rule.
+// The description is already lowercase.
+func (r compiledRule) matches(merchantName, description string, amount float64) bool {
+	if r.merchant != nil && !r.merchant.MatchString(merchantName) {
+		return false
+	}
+	if r.minAmount != nil && amount < *r.minAmount {
+		return false
+	}
+	if r.maxAmount != nil && amount > *r.maxAmount {
+		return false
+	}
+	if len(r.keywords) > 0 {
+		found := false
+		for _, keyword := range r.keywords {
+			if strings.Contains(description, keyword) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// categorizeTransaction returns what the rules matching a stored
+// transaction assign it
+func (c *Categorizer) categorizeTransaction(t *ent.Transaction) Categorization {
+	merchantName, description := "", ""
+	if t.MerchantName != nil {
+		merchantName = *t.MerchantName
+	}
+	if t.Description != nil {
+		description = *t.Description
+	}
+	return c.Categorize(merchantName, description, t.Amount)
+}
+
+// planChange returns what a categorization changes about a transaction,
+// and whether it changes anything. Transactions with a category only get a
+// different one when overwrite is set.
+func planChange(t *ent.Transaction, result Categorization, overwrite bool) (CategorizationChange, bool) {
+	change := CategorizationChange{
+		Transaction:      t,
+		PreviousCategory: t.MerchantCategory,
+		RuleIDs:          result.RuleIDs,
+	}
+	if result.Category != "" {
+		switch {
+		case t.MerchantCategory == nil || *t.MerchantCategory == "":
+			change.Category = result.Category
+		case overwrite && !strings.EqualFold(*t.MerchantCategory, result.Category):
+			change.Category = result.Category
+		}
+	}
+	for _, tag := range result.Tags {
+		if !containsTag(t.CategoryTags, tag) {
+			change.AddedTags = append(change.AddedTags, tag)
+		}
+	}
+	return change, change.Category != "" || len(change.AddedTags) > 0
+}
+
+// mergeTags returns tags with those of more it doesn't have yet appended
+func mergeTags(tags, more []string) []string {
+	for _, tag := range more {
+		if !containsTag(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// containsTag reports whether tags has tag
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// normalize validates a rule's configuration, returning it with its name,
+// category and tags trimmed, and the category lowercase
+func (in CategorizationRuleInput) normalize() (CategorizationRuleInput, error) {
+	in.Name = strings.TrimSpace(in.Name)
+	if in.Name == "" {
+		return in, fmt.Errorf("%w: name is required", ErrInvalidRule)
+	}
+
+	in.MerchantPattern = strings.TrimSpace(in.MerchantPattern)
+	if in.MerchantPattern != "" {
+		if _, err := regexp.Compile(in.MerchantPattern); err != nil {
+			return in, fmt.Errorf("%w: merchant_pattern: %v", ErrInvalidRule, err)
+		}
+	}
+	if (in.MinAmount != nil && *in.MinAmount < 0) || (in.MaxAmount != nil && *in.MaxAmount < 0) {
+		return in, fmt.Errorf("%w: amounts must not be negative", ErrInvalidRule)
+	}
+	if in.MinAmount != nil && in.MaxAmount != nil && *in.MinAmount > *in.MaxAmount {
+		return in, fmt.Errorf("%w: min_amount must not be above max_amount", ErrInvalidRule)
+	}
+	keywords := make([]string, 0, len(in.DescriptionKeywords))
+	for _, keyword := range in.DescriptionKeywords {
+		if keyword = strings.TrimSpace(keyword); keyword != "" {
+			keywords = append(keywords, keyword)
+		}
+	}
+	in.DescriptionKeywords = keywords
+	if in.MerchantPattern == "" && in.MinAmount == nil && in.MaxAmount == nil && len(in.DescriptionKeywords) == 0 {
+		return in, fmt.Errorf("%w: set merchant_pattern, min_amount, max_amount or description_keywords", ErrInvalidRule)
+	}
+
+	in.Category = strings.ToLower(strings.TrimSpace(in.Category))
+	var tags []string
+	for _, tag := range in.Tags {
+		if tag = strings.TrimSpace(tag); tag != "" && !containsTag(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	in.Tags = tags
+	if in.Category == "" && len(in.Tags) == 0 {
+		return in, fmt.Errorf("%w: set a category or tags to assign", ErrInvalidRule)
+	}
+	return in, nil
+}
+
+// asRule returns a normalized input as an unsaved rule
+func (in CategorizationRuleInput) asRule(id string) *ent.CategorizationRule {
+	rule := &ent.CategorizationRule{
+		ID:                  id,
+		Name:                in.Name,
+		Priority:            in.Priority,
+		Enabled:             in.Enabled,
+		MinAmount:           in.MinAmount,
+		MaxAmount:           in.MaxAmount,
+		DescriptionKeywords: in.DescriptionKeywords,
+		Tags:                in.Tags,
+	}
+	if in.MerchantPattern != "" {
+		rule.MerchantPattern = &in.MerchantPattern
+	}
+	if in.Category != "" {
+		rule.Category = &in.Category
+	}
+	return rule
+}
+
+// ListCategorizationRules returns the user's categorization rules in the
+// order they are tried
+func (s *Service) ListCategorizationRules(ctx context.Context, userID string) ([]*ent.CategorizationRule, error) {
+	records, err := s.entClient.CategorizationRule.Query().
+		Where(categorizationrule.UserID(userID)).
+		Order(ent.Asc(categorizationrule.FieldPriority), ent.Asc(categorizationrule.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying categorization rules: %w", err)
+	}
+	return records, nil
+}
+
+// GetCategorizationRule returns one of the user's categorization rules
+func (s *Service) GetCategorizationRule(ctx context.Context, userID, id string) (*ent.CategorizationRule, error) {
+	record, err := s.entClient.CategorizationRule.Query().
+		Where(categorizationrule.ID(id), categorizationrule.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrCategorizationRuleNotFound
+		}
+		return nil, fmt.Errorf("getting categorization rule: %w", err)
+	}
+	return record, nil
+}
+
+// CreateCategorizationRule adds a categorization rule for the user
+func (s *Service) CreateCategorizationRule(ctx context.Context, userID string, input CategorizationRuleInput) (*ent.CategorizationRule, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+	input, err := input.normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	create := s.entClient.CategorizationRule.Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetName(input.Name).
+		SetPriority(input.Priority).
+		SetEnabled(input.Enabled).
+		SetNillableMinAmount(input.MinAmount).
+		SetNillableMaxAmount(input.MaxAmount)
+	if input.MerchantPattern != "" {
+		create.SetMerchantPattern(input.MerchantPattern)
+	}
+	if len(input.DescriptionKeywords) > 0 {
+		create.SetDescriptionKeywords(input.DescriptionKeywords)
+	}
+	if input.Category != "" {
+		create.SetCategory(input.Category)
+	}
+	if len(input.Tags) > 0 {
+		create.SetTags(input.Tags)
+	}
+
+	record, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating categorization rule: %w", err)
+	}
+	return record, nil
+}
+
+// UpdateCategorizationRule replaces the configuration of one of the user's
+// categorization rules
+func (s *Service) UpdateCategorizationRule(ctx context.Context, userID, id string, input CategorizationRuleInput) (*ent.CategorizationRule, error) {
+	input, err := input.normalize()
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.GetCategorizationRule(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	update := existing.Update().
+		SetName(input.Name).
+		SetPriority(input.Priority).
+		SetEnabled(input.Enabled).
+		ClearMerchantPattern().
+		ClearMinAmount().
+		ClearMaxAmount().
+		ClearDescriptionKeywords().
+		ClearCategory().
+		ClearTags()
+	if input.MerchantPattern != "" {
+		update.SetMerchantPattern(input.MerchantPattern)
+	}
+	if input.MinAmount != nil {
+		update.SetMinAmount(*input.MinAmount)
+	}
+	if input.MaxAmount != nil {
+		update.SetMaxAmount(*input.MaxAmount)
+	}
+	if len(input.DescriptionKeywords) > 0 {
+		update.SetDescriptionKeywords(input.DescriptionKeywords)
+	}
+	if input.Category != "" {
+		update.SetCategory(input.Category)
+	}
+	if len(input.Tags) > 0 {
+		update.SetTags(input.Tags)
+	}
+
+	record, err := update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("updating categorization rule: %w", err)
+	}
+	return record, nil
+}
+
+// DeleteCategorizationRule deletes one of the user's categorization rules
+func (s *Service) DeleteCategorizationRule(ctx context.Context, userID, id string) error {
+	deleted, err := s.entClient.CategorizationRule.Delete().
+		Where(categorizationrule.ID(id), categorizationrule.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting categorization rule: %w", err)
+	}
+	if deleted == 0 {
+		return ErrCategorizationRuleNotFound
+	}
+	return nil
+}
+
+// PreviewCategorization runs the user's categorization rules, and the
+// draft rule if there is one, over their transactions without changing
+// anything, reporting what applying them would change
+func (s *Service) PreviewCategorization(ctx context.Context, userID string, opts CategorizationOptions) (*CategorizationRun, error) {
+	return s.runCategorization(ctx, userID, opts, true)
+}
+
+// ApplyCategorization re-applies the user's categorization rules to their
+// transactions, e.g. after adding a rule. Each batch of changes is applied
+// in a transaction with a bulk operation journaling it, so it can be undone.
+func (s *Service) ApplyCategorization(ctx context.Context, userID string, opts CategorizationOptions) (*CategorizationRun, error) {
+	opts.Draft = nil
+	return s.runCategorization(ctx, userID, opts, false)
+}
+
+// runCategorization runs the user's categorization rules over their
+// transactions a batch at a time, changing them unless dryRun is set
+func (s *Service) runCategorization(ctx context.Context, userID string, opts CategorizationOptions, dryRun bool) (*CategorizationRun, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+
+	rules, err := s.ListCategorizationRules(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Draft != nil {
+		draft, err := opts.Draft.normalize()
+		if err != nil {
+			return nil, err
+		}
+		draft.Enabled = true
+		rules = append(rules, draft.asRule(draftRuleID))
+	}
+	categorizer, err := NewCategorizer(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	query := s.entClient.Transaction.Query().Where(transaction.UserID(userID))
+	if opts.FilterID != "" {
+		filter, err := s.LoadSavedFilter(ctx, userID, opts.FilterID)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(filter.predicates()...)
+	}
+
+	run := &CategorizationRun{UserID: userID, DryRun: dryRun}
+	after := ""
+	for {
+		batch, err := query.Clone().
+			Where(transaction.IDGT(after)).
+			Order(ent.Asc(transaction.FieldID)).
+			Limit(categorizationBatchSize).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("querying transactions: %w", err)
+		}
+		if len(batch) == 0 {
+			return run, nil
+		}
+		after = batch[len(batch)-1].ID
+		run.Scanned += len(batch)
+
+		var changes []CategorizationChange
+		var journaled []*ent.Transaction
+		for _, record := range batch {
+			result := categorizer.categorizeTransaction(record)
+			if len(result.RuleIDs) == 0 {
+				continue
+			}
+			run.Matched++
+			change, changed := planChange(record, result, opts.Overwrite)
+			if !changed {
+				continue
+			}
+			changes = append(changes, change)
+			journaled = append(journaled, record)
+			run.Changed++
+			if change.Category != "" {
+				run.Categorized++
+			}
+			if len(change.AddedTags) > 0 {
+				run.Tagged++
+			}
+			if len(run.Changes) < MaxRunChanges {
+				run.Changes = append(run.Changes, change)
+			}
+		}
+
+		if dryRun || len(changes) == 0 {
+			continue
+		}
+		operation, err := s.runBulkOperation(ctx, userID, journaled, bulkoperation.KindCategorize, nil, func(tx *ent.Tx, _ []string, now time.Time) error {
+			for _, change := range changes {
+				if err := applyChange(ctx, tx, change, now); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		run.OperationIDs = append(run.OperationIDs, operation.ID)
+	}
+}
+
+// applyChange saves a categorization change to its transaction, stamping
+// it with the time of the operation journaling the change
+func applyChange(ctx context.Context, tx *ent.Tx, change CategorizationChange, now time.Time) error {
+	update := tx.Transaction.UpdateOneID(change.Transaction.ID).SetUpdatedAt(now)
+	if change.Category != "" {
+		update.SetMerchantCategory(change.Category)
+	}
+	if len(change.AddedTags) > 0 {
+		update.SetCategoryTags(mergeTags(append([]string(nil), change.Transaction.CategoryTags...), change.AddedTags))
+	}
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("categorizing transaction %s: %w", change.Transaction.ID, err)
+	}
+	return nil
+}
+
+// categorizer loads the user's categorization rules to match new
+// transactions against
+func (s *Service) categorizer(ctx context.Context, userID string) (*Categorizer, error) {
+	rules, err := s.entClient.CategorizationRule.Query().
+		Where(categorizationrule.UserID(userID), categorizationrule.Enabled(true)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying categorization rules: %w", err)
+	}
+	return NewCategorizer(rules)
+}
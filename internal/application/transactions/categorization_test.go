@@ -0,0 +1,182 @@
+package transactions
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/ent"
+)
+
+func strPtr(s string) *string { return &s }
+
+func floatPtr(f float64) *float64 { return &f }
+
+func TestCategorizer(t *testing.T) {
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	rules := []*ent.CategorizationRule{
+		{
+			ID:        "large",
+			Priority:  10,
+			Enabled:   true,
+			MinAmount: floatPtr(500),
+			Tags:      []string{"review"},
+			CreatedAt: created,
+		},
+		{
+			ID:              "coffee",
+			Priority:        1,
+			Enabled:         true,
+			MerchantPattern: strPtr(`^(starbucks|blue bottle)`),
+			Category:        strPtr("dining"),
+			Tags:            []string{"coffee"},
+			CreatedAt:       created,
+		},
+		{
+			ID:                  "work-travel",
+			Priority:            1,
+			Enabled:             true,
+			DescriptionKeywords: []string{"Conference", "client visit"},
+			MaxAmount:           floatPtr(2000),
+			Category:            strPtr("travel"),
+			Tags:                []string{"business", "review"},
+			CreatedAt:           created.Add(time.Hour),
+		},
+		{
+			ID:              "disabled",
+			Priority:        0,
+			Enabled:         false,
+			MerchantPattern: strPtr(`.*`),
+			Category:        strPtr("other"),
+			CreatedAt:       created,
+		},
+	}
+	categorizer, err := NewCategorizer(rules)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		merchantName string
+		description  string
+		amount       float64
+		want         Categorization
+	}{
+		{
+			name:         "merchant pattern ignores case",
+			merchantName: "STARBUCKS STORE #123",
+			amount:       5.75,
+			want:         Categorization{Category: "dining", CategoryRuleID: "coffee", Tags: []string{"coffee"}, RuleIDs: []string{"coffee"}},
+		},
+		{
+			name:         "category from the first rule, tags from all",
+			merchantName: "Blue Bottle Coffee",
+			description:  "Coffee with the CONFERENCE speakers",
+			amount:       640,
+			want: Categorization{
+				Category:       "dining",
+				CategoryRuleID: "coffee",
+				Tags:           []string{"coffee", "business", "review"},
+				RuleIDs:        []string{"coffee", "work-travel", "large"},
+			},
+		},
+		{
+			name:        "keyword outside the amount range",
+			description: "Flights for client visit",
+			amount:      2400,
+			want:        Categorization{Tags: []string{"review"}, RuleIDs: []string{"large"}},
+		},
+		{
+			name:         "no match",
+			merchantName: "Shell Oil",
+			amount:       40,
+			want:         Categorization{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, categorizer.Categorize(tt.merchantName, tt.description, tt.amount))
+		})
+	}
+}
+
+func TestNewCategorizerInvalidPattern(t *testing.T) {
+	_, err := NewCategorizer([]*ent.CategorizationRule{
+		{ID: "broken", Enabled: true, MerchantPattern: strPtr(`(unclosed`), Category: strPtr("dining")},
+	})
+	assert.True(t, errors.Is(err, ErrInvalidRule), "got %v", err)
+}
+
+func TestPlanChange(t *testing.T) {
+	result := Categorization{Category: "dining", Tags: []string{"coffee", "work"}, RuleIDs: []string{"coffee"}}
+
+	t.Run("uncategorized", func(t *testing.T) {
+		record := &ent.Transaction{ID: "t1", CategoryTags: []string{"work"}}
+		change, changed := planChange(record, result, false)
+		assert.True(t, changed)
+		assert.Equal(t, "dining", change.Category)
+		assert.Equal(t, []string{"coffee"}, change.AddedTags)
+	})
+
+	t.Run("categorized keeps its category", func(t *testing.T) {
+		record := &ent.Transaction{ID: "t2", MerchantCategory: strPtr("groceries"), CategoryTags: []string{"coffee", "work"}}
+		change, changed := planChange(record, result, false)
+		assert.False(t, changed)
+		assert.Empty(t, change.Category)
+	})
+
+	t.Run("overwrite recategorizes", func(t *testing.T) {
+		record := &ent.Transaction{ID: "t3", MerchantCategory: strPtr("groceries")}
+		change, changed := planChange(record, result, true)
+		assert.True(t, changed)
+		assert.Equal(t, "dining", change.Category)
+		assert.Equal(t, "groceries", *change.PreviousCategory)
+	})
+
+	t.Run("overwrite leaves the same category", func(t *testing.T) {
+		record := &ent.Transaction{ID: "t4", MerchantCategory: strPtr("Dining"), CategoryTags: []string{"coffee", "work"}}
+		_, changed := planChange(record, result, true)
+		assert.False(t, changed)
+	})
+}
+
+func TestCategorizationRuleInputNormalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   CategorizationRuleInput
+		wantErr bool
+	}{
+		{name: "merchant pattern", input: CategorizationRuleInput{Name: "Coffee", MerchantPattern: "starbucks", Category: "Dining"}},
+		{name: "amount range with tags", input: CategorizationRuleInput{Name: "Large", MinAmount: floatPtr(500), Tags: []string{"review"}}},
+		{name: "no name", input: CategorizationRuleInput{MerchantPattern: "starbucks", Category: "dining"}, wantErr: true},
+		{name: "no criteria", input: CategorizationRuleInput{Name: "All", DescriptionKeywords: []string{"  "}, Category: "dining"}, wantErr: true},
+		{name: "nothing assigned", input: CategorizationRuleInput{Name: "Coffee", MerchantPattern: "starbucks", Tags: []string{" "}}, wantErr: true},
+		{name: "invalid pattern", input: CategorizationRuleInput{Name: "Coffee", MerchantPattern: "(starbucks", Category: "dining"}, wantErr: true},
+		{name: "min above max", input: CategorizationRuleInput{Name: "Range", MinAmount: floatPtr(50), MaxAmount: floatPtr(10), Category: "dining"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.input.normalize()
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidRule), "got %v", err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	normalized, err := CategorizationRuleInput{
+		Name:            " Coffee ",
+		MerchantPattern: "starbucks",
+		Category:        " Dining ",
+		Tags:            []string{"coffee", " coffee", ""},
+	}.normalize()
+	require.NoError(t, err)
+	assert.Equal(t, "Coffee", normalized.Name)
+	assert.Equal(t, "dining", normalized.Category)
+	assert.Equal(t, []string{"coffee"}, normalized.Tags)
+}
@@ -0,0 +1,231 @@
+package transactions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"clockzen-next/internal/application/organizations"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/googledriveconnection"
+	"clockzen-next/internal/ent/householdmember"
+	"clockzen-next/internal/ent/predicate"
+	"clockzen-next/internal/ent/transaction"
+
+	"github.com/google/uuid"
+)
+
+// Errors returned for household members
+var (
+	ErrMemberNotFound      = errors.New("household member not found")
+	ErrInvalidMemberName   = errors.New("name is required")
+	ErrConnectionNotFound  = errors.New("connection not found")
+	ErrConnectionAssigned  = errors.New("connection is already assigned to another member")
+	ErrTransactionNotFound = errors.New("transaction not found")
+)
+
+// MemberInput describes a household member. Receipts synced through the
+// member's connections are attributed to them.
+type MemberInput struct {
+	Name          string
+	ConnectionIDs []string
+}
+
+// MemberUpdate changes the fields of a household member that are set. An
+// empty ConnectionIDs clears them.
+type MemberUpdate struct {
+	Name          *string
+	ConnectionIDs *[]string
+}
+
+// CreateMember adds a member to the user's household
+func (s *Service) CreateMember(ctx context.Context, userID string, input MemberInput) (*ent.HouseholdMember, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+	if err := s.validateMember(ctx, userID, "", input.Name, input.ConnectionIDs); err != nil {
+		return nil, err
+	}
+
+	record, err := s.entClient.HouseholdMember.Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetName(input.Name).
+		SetConnectionIds(input.ConnectionIDs).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating household member: %w", err)
+	}
+	return record, nil
+}
+
+// ListMembers returns the members of the user's household by name
+func (s *Service) ListMembers(ctx context.Context, userID string) ([]*ent.HouseholdMember, error) {
+	records, err := s.entClient.HouseholdMember.Query().
+		Where(householdmember.UserID(userID)).
+		Order(ent.Asc(householdmember.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying household members: %w", err)
+	}
+	return records, nil
+}
+
+// GetMember returns a member of the user's household
+func (s *Service) GetMember(ctx context.Context, userID, id string) (*ent.HouseholdMember, error) {
+	record, err := s.entClient.HouseholdMember.Query().
+		Where(householdmember.ID(id), householdmember.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrMemberNotFound
+		}
+		return nil, fmt.Errorf("getting household member: %w", err)
+	}
+	return record, nil
+}
+
+// UpdateMember changes a member of the user's household
+func (s *Service) UpdateMember(ctx context.Context, userID, id string, input MemberUpdate) (*ent.HouseholdMember, error) {
+	record, err := s.GetMember(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	name, connectionIDs := record.Name, record.ConnectionIds
+	if input.Name != nil {
+		name = *input.Name
+	}
+	if input.ConnectionIDs != nil {
+		connectionIDs = *input.ConnectionIDs
+	}
+	if err := s.validateMember(ctx, userID, id, name, connectionIDs); err != nil {
+		return nil, err
+	}
+
+	update := record.Update().SetName(name)
+	if len(connectionIDs) > 0 {
+		update.SetConnectionIds(connectionIDs)
+	} else {
+		update.ClearConnectionIds()
+	}
+	record, err = update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("updating household member: %w", err)
+	}
+	return record, nil
+}
+
+// DeleteMember removes a member from the user's household. Transactions
+// assigned to them by hand go back to being attributed by connection.
+func (s *Service) DeleteMember(ctx context.Context, userID, id string) error {
+	deleted, err := s.entClient.HouseholdMember.Delete().
+		Where(householdmember.ID(id), householdmember.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting household member: %w", err)
+	}
+	if deleted == 0 {
+		return ErrMemberNotFound
+	}
+	if _, err := s.entClient.Transaction.Update().
+		Where(transaction.UserID(userID), transaction.MemberID(id)).
+		ClearMemberID().
+		Save(ctx); err != nil {
+		return fmt.Errorf("unassigning transactions: %w", err)
+	}
+	return nil
+}
+
+// AssignTransaction attributes one of the user's transactions to a member of
+// their household, overriding its source connection. An empty memberID
+// clears the assignment.
+func (s *Service) AssignTransaction(ctx context.Context, userID, transactionID, memberID string) (*ent.Transaction, error) {
+	if memberID != "" {
+		if _, err := s.GetMember(ctx, userID, memberID); err != nil {
+			return nil, err
+		}
+	}
+
+	record, err := s.entClient.Transaction.Query().
+		Where(transaction.ID(transactionID), transaction.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, fmt.Errorf("getting transaction: %w", err)
+	}
+
+	update := record.Update()
+	if memberID != "" {
+		update.SetMemberID(memberID)
+	} else {
+		update.ClearMemberID()
+	}
+	record, err = update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("assigning transaction: %w", err)
+	}
+	return record, nil
+}
+
+// membersByConnection maps the user's connections to the household members
+// they are assigned to
+func (s *Service) membersByConnection(ctx context.Context, userID string) (map[string]string, error) {
+	members, err := s.ListMembers(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	byConnection := make(map[string]string)
+	for _, member := range members {
+		for _, connectionID := range member.ConnectionIds {
+			byConnection[connectionID] = member.ID
+		}
+	}
+	return byConnection, nil
+}
+
+// validateMember checks a member's fields before they are saved. Each
+// connection must be one of the user's email or Drive connections and not
+// already assigned to another member (memberID is the member being saved).
+func (s *Service) validateMember(ctx context.Context, userID, memberID, name string, connectionIDs []string) error {
+	if name == "" {
+		return ErrInvalidMemberName
+	}
+	if len(connectionIDs) == 0 {
+		return nil
+	}
+
+	emails, err := s.entClient.EmailConnection.Query().
+		Where(organizations.OwnedBy[predicate.EmailConnection](userID), emailconnection.IDIn(connectionIDs...)).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("querying email connections: %w", err)
+	}
+	drives, err := s.entClient.GoogleDriveConnection.Query().
+		Where(organizations.OwnedBy[predicate.GoogleDriveConnection](userID), googledriveconnection.IDIn(connectionIDs...)).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("querying drive connections: %w", err)
+	}
+	owned := make(map[string]bool, len(emails)+len(drives))
+	for _, id := range append(emails, drives...) {
+		owned[id] = true
+	}
+
+	assigned, err := s.membersByConnection(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, connectionID := range connectionIDs {
+		if !owned[connectionID] {
+			return fmt.Errorf("%w: %s", ErrConnectionNotFound, connectionID)
+		}
+		if other, ok := assigned[connectionID]; ok && other != memberID {
+			return fmt.Errorf("%w: %s", ErrConnectionAssigned, connectionID)
+		}
+	}
+	return nil
+}
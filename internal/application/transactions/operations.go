@@ -0,0 +1,375 @@
+package transactions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/bulkoperation"
+	"clockzen-next/internal/ent/transaction"
+
+	"github.com/google/uuid"
+)
+
+// Errors returned for bulk operations
+var (
+	ErrEmptySelection    = errors.New("select transactions by transaction_ids, merchant_name or filter_id")
+	ErrSelectionTooLarge = fmt.Errorf("at most %d transactions can be changed at once", MaxBulkTransactions)
+	ErrInvalidCategory   = errors.New("category is required")
+	ErrOperationNotFound = errors.New("operation not found")
+	ErrOperationUndone   = errors.New("operation has already been undone")
+	ErrUndoWindowExpired = errors.New("operation can no longer be undone")
+)
+
+const (
+	// MaxBulkTransactions is the most transactions one bulk operation changes
+	MaxBulkTransactions = 1000
+
+	// UndoWindow is how long after a bulk operation it can be undone
+	UndoWindow = 24 * time.Hour
+)
+
+// BulkSelection picks the user's transactions a bulk operation changes:
+// those listed, every one from a merchant (ignoring case), or every one a
+// saved filter matches
+type BulkSelection struct {
+	TransactionIDs []string
+	MerchantName   string
+	FilterID       string
+}
+
+// UndoResult reports what undoing a bulk operation restored
+type UndoResult struct {
+	Operation *ent.BulkOperation
+	Restored  int
+	// Skipped counts the transactions changed again since the operation,
+	// which are left as they are: recategorized or edited since, or
+	// recreated
+	Skipped int
+}
+
+// BulkRecategorize moves the selected transactions to category, journaling
+// their previous categories so the change can be undone
+func (s *Service) BulkRecategorize(ctx context.Context, userID string, selection BulkSelection, category string) (*ent.BulkOperation, error) {
+	category = strings.ToLower(strings.TrimSpace(category))
+	if category == "" {
+		return nil, ErrInvalidCategory
+	}
+
+	selected, err := s.selectTransactions(ctx, userID, selection)
+	if err != nil {
+		return nil, err
+	}
+	return s.runBulkOperation(ctx, userID, selected, bulkoperation.KindRecategorize, &category, func(tx *ent.Tx, ids []string, _ time.Time) error {
+		_, err := tx.Transaction.Update().
+			Where(transaction.UserID(userID), transaction.IDIn(ids...)).
+			SetMerchantCategory(category).
+			Save(ctx)
+		return err
+	})
+}
+
+// BulkDelete deletes the selected transactions, journaling them so they can
+// be restored
+func (s *Service) BulkDelete(ctx context.Context, userID string, selection BulkSelection) (*ent.BulkOperation, error) {
+	selected, err := s.selectTransactions(ctx, userID, selection)
+	if err != nil {
+		return nil, err
+	}
+	return s.runBulkOperation(ctx, userID, selected, bulkoperation.KindDelete, nil, func(tx *ent.Tx, ids []string, _ time.Time) error {
+		_, err := tx.Transaction.Delete().
+			Where(transaction.UserID(userID), transaction.IDIn(ids...)).
+			Exec(ctx)
+		return err
+	})
+}
+
+// ListOperations returns the user's bulk operations, the latest first
+func (s *Service) ListOperations(ctx context.Context, userID string) ([]*ent.BulkOperation, error) {
+	records, err := s.entClient.BulkOperation.Query().
+		Where(bulkoperation.UserID(userID)).
+		Order(ent.Desc(bulkoperation.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying operations: %w", err)
+	}
+	return records, nil
+}
+
+// GetOperation returns one of the user's bulk operations
+func (s *Service) GetOperation(ctx context.Context, userID, id string) (*ent.BulkOperation, error) {
+	record, err := s.entClient.BulkOperation.Query().
+		Where(bulkoperation.ID(id), bulkoperation.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrOperationNotFound
+		}
+		return nil, fmt.Errorf("getting operation: %w", err)
+	}
+	return record, nil
+}
+
+// UndoOperation puts the transactions a bulk operation changed back as they
+// were, within UndoWindow of it. Transactions changed again since are left
+// alone rather than losing the later change.
+func (s *Service) UndoOperation(ctx context.Context, userID, id string) (*UndoResult, error) {
+	record, err := s.GetOperation(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if record.UndoneAt != nil {
+		return nil, ErrOperationUndone
+	}
+	if now.After(record.ExpiresAt) {
+		return nil, ErrUndoWindowExpired
+	}
+
+	var images []*ent.Transaction
+	if err := json.Unmarshal(record.BeforeImages, &images); err != nil {
+		return nil, fmt.Errorf("reading operation journal: %w", err)
+	}
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Claim the operation first, so two undos of it can't both restore
+	claimed, err := tx.BulkOperation.Update().
+		Where(bulkoperation.ID(id), bulkoperation.UndoneAtIsNil()).
+		SetUndoneAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("marking operation undone: %w", err)
+	}
+	if claimed == 0 {
+		return nil, ErrOperationUndone
+	}
+
+	result := &UndoResult{}
+	for _, image := range images {
+		var restored bool
+		switch record.Kind {
+		case bulkoperation.KindRecategorize:
+			restored, err = restoreCategory(ctx, tx, image, *record.Category)
+		case bulkoperation.KindDelete:
+			restored, err = restoreDeleted(ctx, tx, image)
+		case bulkoperation.KindCategorize:
+			restored, err = restoreCategorization(ctx, tx, image, record.CreatedAt)
+		default:
+			err = fmt.Errorf("unknown operation kind %q", record.Kind)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("restoring transaction %s: %w", image.ID, err)
+		}
+		if restored {
+			result.Restored++
+		} else {
+			result.Skipped++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing undo: %w", err)
+	}
+	result.Operation, err = s.GetOperation(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// runBulkOperation applies a bulk change to the selected transactions and
+// journals their before-images with it, in one database transaction. apply
+// is given the time the operation is recorded at.
+func (s *Service) runBulkOperation(
+	ctx context.Context,
+	userID string,
+	selected []*ent.Transaction,
+	kind bulkoperation.Kind,
+	category *string,
+	apply func(tx *ent.Tx, ids []string, now time.Time) error,
+) (*ent.BulkOperation, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+
+	ids := make([]string, len(selected))
+	for i, record := range selected {
+		ids[i] = record.ID
+	}
+	images, err := json.Marshal(selected)
+	if err != nil {
+		return nil, fmt.Errorf("journaling transactions: %w", err)
+	}
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	if err := apply(tx, ids, now); err != nil {
+		return nil, fmt.Errorf("applying %s: %w", kind, err)
+	}
+	record, err := tx.BulkOperation.Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetKind(kind).
+		SetNillableCategory(category).
+		SetTransactionIds(ids).
+		SetBeforeImages(images).
+		SetExpiresAt(now.Add(UndoWindow)).
+		SetCreatedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("recording operation: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing %s: %w", kind, err)
+	}
+	return record.Unwrap(), nil
+}
+
+// selectTransactions loads the user's transactions a selection picks. Every
+// listed transaction must be the user's.
+func (s *Service) selectTransactions(ctx context.Context, userID string, selection BulkSelection) ([]*ent.Transaction, error) {
+	query := s.entClient.Transaction.Query().Where(transaction.UserID(userID))
+	switch {
+	case len(selection.TransactionIDs) > 0:
+		query.Where(transaction.IDIn(selection.TransactionIDs...))
+	case strings.TrimSpace(selection.MerchantName) != "":
+		query.Where(transaction.MerchantNameEqualFold(strings.TrimSpace(selection.MerchantName)))
+	case selection.FilterID != "":
+		filter, err := s.LoadSavedFilter(ctx, userID, selection.FilterID)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(filter.predicates()...)
+	default:
+		return nil, ErrEmptySelection
+	}
+
+	records, err := query.Limit(MaxBulkTransactions + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying transactions: %w", err)
+	}
+	if len(records) > MaxBulkTransactions {
+		return nil, ErrSelectionTooLarge
+	}
+	if len(selection.TransactionIDs) > 0 {
+		found := make(map[string]bool, len(records))
+		for _, record := range records {
+			found[record.ID] = true
+		}
+		for _, id := range selection.TransactionIDs {
+			if !found[id] {
+				return nil, fmt.Errorf("%w: %s", ErrTransactionNotFound, id)
+			}
+		}
+	}
+	if len(records) == 0 {
+		return nil, ErrEmptySelection
+	}
+	return records, nil
+}
+
+// restoreCategory puts a recategorized transaction back in its previous
+// category, unless it has been deleted or moved from category since
+func restoreCategory(ctx context.Context, tx *ent.Tx, image *ent.Transaction, category string) (bool, error) {
+	update := tx.Transaction.Update().
+		Where(
+			transaction.ID(image.ID),
+			transaction.UserID(image.UserID),
+			transaction.MerchantCategory(category),
+		)
+	if image.MerchantCategory != nil {
+		update.SetMerchantCategory(*image.MerchantCategory)
+	} else {
+		update.ClearMerchantCategory()
+	}
+	updated, err := update.Save(ctx)
+	return updated > 0, err
+}
+
+// restoreCategorization puts a transaction categorized by rules back as it
+// was, unless it has been deleted or changed since: the categorization
+// stamped it with the time of the operation
+func restoreCategorization(ctx context.Context, tx *ent.Tx, image *ent.Transaction, categorized time.Time) (bool, error) {
+	update := tx.Transaction.Update().
+		Where(
+			transaction.ID(image.ID),
+			transaction.UserID(image.UserID),
+			transaction.UpdatedAt(categorized),
+		)
+	if image.MerchantCategory != nil {
+		update.SetMerchantCategory(*image.MerchantCategory)
+	} else {
+		update.ClearMerchantCategory()
+	}
+	if image.CategoryTags != nil {
+		update.SetCategoryTags(image.CategoryTags)
+	} else {
+		update.ClearCategoryTags()
+	}
+	updated, err := update.Save(ctx)
+	return updated > 0, err
+}
+
+// restoreDeleted recreates a deleted transaction as it was, unless one with
+// its ID exists again
+func restoreDeleted(ctx context.Context, tx *ent.Tx, image *ent.Transaction) (bool, error) {
+	exists, err := tx.Transaction.Query().Where(transaction.ID(image.ID)).Exist(ctx)
+	if err != nil || exists {
+		return false, err
+	}
+
+	create := tx.Transaction.Create().
+		SetID(image.ID).
+		SetUserID(image.UserID).
+		SetSource(image.Source).
+		SetType(image.Type).
+		SetAmount(image.Amount).
+		SetCurrency(image.Currency).
+		SetTransactionDate(image.TransactionDate).
+		SetNillableDescription(image.Description).
+		SetNillableMerchantName(image.MerchantName).
+		SetNillableMerchantCategory(image.MerchantCategory).
+		SetNillablePaymentMethod(image.PaymentMethod).
+		SetNillableCardLastFour(image.CardLastFour).
+		SetNillableReferenceNumber(image.ReferenceNumber).
+		SetNillableAuthorizationCode(image.AuthorizationCode).
+		SetStatus(image.Status).
+		SetRoundUpAmount(image.RoundUpAmount).
+		SetIsRecurring(image.IsRecurring).
+		SetNillableRecurrencePattern(image.RecurrencePattern).
+		SetNillableNotes(image.Notes).
+		SetNillableMemberID(image.MemberID).
+		SetNillableMerchantID(image.MerchantID).
+		SetNillableLegacyID(image.LegacyID).
+		SetNillableMigrationRunID(image.MigrationRunID).
+		SetCreatedAt(image.CreatedAt).
+		SetUpdatedAt(image.UpdatedAt)
+	if image.ReceiptID != "" {
+		create.SetReceiptID(image.ReceiptID)
+	}
+	if image.CategoryTags != nil {
+		create.SetCategoryTags(image.CategoryTags)
+	}
+	if image.Metadata != nil {
+		create.SetMetadata(image.Metadata)
+	}
+	if _, err := create.Save(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,243 @@
+package transactions
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/bulkoperation"
+	"clockzen-next/internal/ent/enttest"
+)
+
+func newTestService(t *testing.T) *Service {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	return NewService(client)
+}
+
+// createTransaction creates a transaction of the user's in category, or
+// uncategorized if category is ""
+func createTransaction(t *testing.T, s *Service, id, userID, category string) *ent.Transaction {
+	t.Helper()
+	create := s.entClient.Transaction.Create().
+		SetID(id).
+		SetUserID(userID).
+		SetAmount(12.5).
+		SetTransactionDate(time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC)).
+		SetMerchantName("Blue Bottle").
+		SetCategoryTags([]string{"coffee"}).
+		SetMetadata(map[string]interface{}{"source": "card"})
+	if category != "" {
+		create.SetMerchantCategory(category)
+	}
+	return create.SaveX(context.Background())
+}
+
+// category returns a transaction's category, or "" if it has none
+func category(t *testing.T, s *Service, id string) string {
+	t.Helper()
+	record := s.entClient.Transaction.GetX(context.Background(), id)
+	if record.MerchantCategory == nil {
+		return ""
+	}
+	return *record.MerchantCategory
+}
+
+func TestBulkRecategorizeJournalsChange(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	createTransaction(t, s, "t1", "u1", "dining")
+	createTransaction(t, s, "t2", "u1", "")
+	createTransaction(t, s, "t3", "u2", "dining")
+
+	operation, err := s.BulkRecategorize(ctx, "u1", BulkSelection{MerchantName: "blue bottle"}, " Coffee ")
+	require.NoError(t, err)
+	assert.Equal(t, bulkoperation.KindRecategorize, operation.Kind)
+	assert.Equal(t, "coffee", *operation.Category)
+	assert.ElementsMatch(t, []string{"t1", "t2"}, operation.TransactionIds)
+	assert.WithinDuration(t, operation.CreatedAt.Add(UndoWindow), operation.ExpiresAt, time.Second)
+	assert.Nil(t, operation.UndoneAt)
+
+	var images []*ent.Transaction
+	require.NoError(t, json.Unmarshal(operation.BeforeImages, &images))
+	before := map[string]*string{}
+	for _, image := range images {
+		before[image.ID] = image.MerchantCategory
+	}
+	assert.Equal(t, map[string]*string{"t1": strPtr("dining"), "t2": nil}, before)
+
+	assert.Equal(t, "coffee", category(t, s, "t1"))
+	assert.Equal(t, "coffee", category(t, s, "t2"))
+	assert.Equal(t, "dining", category(t, s, "t3"), "other users' transactions aren't selected")
+
+	_, err = s.BulkRecategorize(ctx, "u1", BulkSelection{TransactionIDs: []string{"t1", "t3"}}, "travel")
+	assert.ErrorIs(t, err, ErrTransactionNotFound)
+	assert.Equal(t, "coffee", category(t, s, "t1"), "nothing changes when a listed transaction isn't the user's")
+}
+
+func TestUndoRecategorize(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	createTransaction(t, s, "t1", "u1", "dining")
+	createTransaction(t, s, "t2", "u1", "")
+	createTransaction(t, s, "t3", "u1", "groceries")
+
+	operation, err := s.BulkRecategorize(ctx, "u1", BulkSelection{TransactionIDs: []string{"t1", "t2", "t3"}}, "coffee")
+	require.NoError(t, err)
+
+	// Changed since the operation, so left as it is
+	s.entClient.Transaction.UpdateOneID("t3").SetMerchantCategory("travel").ExecX(ctx)
+
+	_, err = s.UndoOperation(ctx, "u2", operation.ID)
+	assert.ErrorIs(t, err, ErrOperationNotFound)
+
+	result, err := s.UndoOperation(ctx, "u1", operation.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Restored)
+	assert.Equal(t, 1, result.Skipped)
+	assert.NotNil(t, result.Operation.UndoneAt)
+	assert.Equal(t, "dining", category(t, s, "t1"))
+	assert.Equal(t, "", category(t, s, "t2"))
+	assert.Equal(t, "travel", category(t, s, "t3"))
+
+	_, err = s.UndoOperation(ctx, "u1", operation.ID)
+	assert.ErrorIs(t, err, ErrOperationUndone)
+}
+
+func TestUndoBulkDelete(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	original := createTransaction(t, s, "t1", "u1", "dining")
+	createTransaction(t, s, "t2", "u1", "dining")
+
+	operation, err := s.BulkDelete(ctx, "u1", BulkSelection{TransactionIDs: []string{"t1", "t2"}})
+	require.NoError(t, err)
+	assert.Equal(t, bulkoperation.KindDelete, operation.Kind)
+	assert.Zero(t, s.entClient.Transaction.Query().CountX(ctx))
+
+	// Recreated since the operation, so left as it is
+	createTransaction(t, s, "t2", "u1", "travel")
+
+	result, err := s.UndoOperation(ctx, "u1", operation.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Restored)
+	assert.Equal(t, 1, result.Skipped)
+	assert.Equal(t, "travel", category(t, s, "t2"))
+
+	restored := s.entClient.Transaction.GetX(ctx, "t1")
+	assert.Equal(t, original.UserID, restored.UserID)
+	assert.Equal(t, original.Amount, restored.Amount)
+	assert.Equal(t, *original.MerchantName, *restored.MerchantName)
+	assert.Equal(t, *original.MerchantCategory, *restored.MerchantCategory)
+	assert.Equal(t, original.CategoryTags, restored.CategoryTags)
+	assert.Equal(t, original.Metadata, restored.Metadata)
+	assert.True(t, original.TransactionDate.Equal(restored.TransactionDate))
+	assert.True(t, original.CreatedAt.Equal(restored.CreatedAt))
+}
+
+func TestUndoIsClaimedOnce(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	createTransaction(t, s, "t1", "u1", "dining")
+	operation, err := s.BulkRecategorize(ctx, "u1", BulkSelection{TransactionIDs: []string{"t1"}}, "coffee")
+	require.NoError(t, err)
+
+	// Another undo finishes between this one loading the operation and
+	// claiming it
+	raced := false
+	s.entClient.BulkOperation.Intercept(ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			value, err := next.Query(ctx, q)
+			if err == nil && !raced {
+				raced = true
+				s.entClient.BulkOperation.UpdateOneID(operation.ID).SetUndoneAt(time.Now()).ExecX(ctx)
+			}
+			return value, err
+		})
+	}))
+
+	_, err = s.UndoOperation(ctx, "u1", operation.ID)
+	assert.ErrorIs(t, err, ErrOperationUndone)
+	assert.True(t, raced)
+	assert.Equal(t, "coffee", category(t, s, "t1"), "the losing undo restores nothing")
+}
+
+func TestUndoWindowExpires(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	record := createTransaction(t, s, "t1", "u1", "coffee")
+	images, err := json.Marshal([]*ent.Transaction{record})
+	require.NoError(t, err)
+
+	// Recorded before the undo window
+	created := time.Now().Add(-UndoWindow - time.Minute)
+	operation := s.entClient.BulkOperation.Create().
+		SetID("op1").
+		SetUserID("u1").
+		SetKind(bulkoperation.KindRecategorize).
+		SetCategory("coffee").
+		SetTransactionIds([]string{"t1"}).
+		SetBeforeImages(images).
+		SetExpiresAt(created.Add(UndoWindow)).
+		SetCreatedAt(created).
+		SaveX(ctx)
+
+	_, err = s.UndoOperation(ctx, "u1", operation.ID)
+	assert.ErrorIs(t, err, ErrUndoWindowExpired)
+	assert.Equal(t, "coffee", category(t, s, "t1"))
+	assert.Nil(t, s.entClient.BulkOperation.GetX(ctx, operation.ID).UndoneAt)
+}
+
+func TestUndoApplyCategorization(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	createTransaction(t, s, "t1", "u1", "")
+	createTransaction(t, s, "t2", "u1", "dining")
+	createTransaction(t, s, "t3", "u1", "")
+	_, err := s.CreateCategorizationRule(ctx, "u1", CategorizationRuleInput{
+		Name:            "Coffee",
+		Enabled:         true,
+		MerchantPattern: "blue bottle",
+		Category:        "coffee",
+		Tags:            []string{"caffeine"},
+	})
+	require.NoError(t, err)
+
+	run, err := s.ApplyCategorization(ctx, "u1", CategorizationOptions{Overwrite: true})
+	require.NoError(t, err)
+	assert.Equal(t, 3, run.Changed)
+	require.Len(t, run.OperationIDs, 1)
+	assert.Equal(t, "coffee", category(t, s, "t1"))
+	assert.Equal(t, "coffee", category(t, s, "t2"))
+	assert.Equal(t, []string{"coffee", "caffeine"}, s.entClient.Transaction.GetX(ctx, "t1").CategoryTags)
+
+	operation, err := s.GetOperation(ctx, "u1", run.OperationIDs[0])
+	require.NoError(t, err)
+	assert.Equal(t, bulkoperation.KindCategorize, operation.Kind)
+	assert.ElementsMatch(t, []string{"t1", "t2", "t3"}, operation.TransactionIds)
+
+	// Edited since the run, so left as it is
+	s.entClient.Transaction.UpdateOneID("t3").SetNotes("team coffee").ExecX(ctx)
+
+	result, err := s.UndoOperation(ctx, "u1", operation.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Restored)
+	assert.Equal(t, 1, result.Skipped)
+	assert.Equal(t, "", category(t, s, "t1"))
+	assert.Equal(t, "dining", category(t, s, "t2"))
+	assert.Equal(t, []string{"coffee"}, s.entClient.Transaction.GetX(ctx, "t1").CategoryTags)
+	assert.Equal(t, "coffee", category(t, s, "t3"))
+
+	// Previews journal nothing
+	run, err = s.PreviewCategorization(ctx, "u1", CategorizationOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, run.OperationIDs)
+	assert.Len(t, s.entClient.BulkOperation.Query().AllX(ctx), 1)
+}
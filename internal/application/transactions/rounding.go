@@ -0,0 +1,39 @@
+package transactions
+
+import (
+	"math"
+	"slices"
+	"strings"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/domain/money"
+	"clockzen-next/internal/ent"
+)
+
+// RoundUp returns the amount the rule sets aside for a purchase: the
+// difference between the amount and the next multiple of the rule's
+// increment, times its multiplier and capped at its maximum. Amounts that
+// are already a multiple of the increment aren't rounded. A nil or disabled
+// rule, or one that doesn't cover the payment method, rounds up nothing.
+//
+// The difference is worked out by analysis.RoundUpAmount, which the
+// round-up what-if scenario uses too.
+func RoundUp(rule *ent.RoundingRule, amount float64, paymentMethod string) float64 {
+	if rule == nil || !rule.Enabled {
+		return 0
+	}
+	if len(rule.PaymentMethods) > 0 && !slices.Contains(rule.PaymentMethods, strings.ToLower(paymentMethod)) {
+		return 0
+	}
+
+	difference := analysis.RoundUpAmount(amount, rule.Increment)
+	if difference == 0 {
+		return 0
+	}
+
+	roundUp := money.RoundCents(math.Round(difference*100) * rule.Multiplier / 100)
+	if rule.MaxRoundUp != nil && roundUp > *rule.MaxRoundUp {
+		roundUp = *rule.MaxRoundUp
+	}
+	return roundUp
+}
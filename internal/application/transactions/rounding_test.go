@@ -0,0 +1,126 @@
+package transactions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"clockzen-next/internal/ent"
+)
+
+func TestRoundUp(t *testing.T) {
+	maxRoundUp := 0.5
+
+	tests := []struct {
+		name          string
+		rule          *ent.RoundingRule
+		amount        float64
+		paymentMethod string
+		want          float64
+	}{
+		{
+			name:   "next dollar",
+			rule:   &ent.RoundingRule{Enabled: true, Increment: 1, Multiplier: 1},
+			amount: 4.35,
+			want:   0.65,
+		},
+		{
+			name:   "already on a multiple",
+			rule:   &ent.RoundingRule{Enabled: true, Increment: 0.10, Multiplier: 1},
+			amount: 3.30,
+			want:   0,
+		},
+		{
+			name:   "whole dollar amount",
+			rule:   &ent.RoundingRule{Enabled: true, Increment: 1, Multiplier: 1},
+			amount: 12,
+			want:   0,
+		},
+		{
+			name:   "no floating point remainder",
+			rule:   &ent.RoundingRule{Enabled: true, Increment: 0.10, Multiplier: 1},
+			amount: 0.29,
+			want:   0.01,
+		},
+		{
+			name:   "larger increment",
+			rule:   &ent.RoundingRule{Enabled: true, Increment: 5, Multiplier: 1},
+			amount: 17.25,
+			want:   2.75,
+		},
+		{
+			name:   "multiplier",
+			rule:   &ent.RoundingRule{Enabled: true, Increment: 1, Multiplier: 3},
+			amount: 4.75,
+			want:   0.75,
+		},
+		{
+			name:   "fractional multiplier rounds to cents",
+			rule:   &ent.RoundingRule{Enabled: true, Increment: 1, Multiplier: 1.5},
+			amount: 4.99,
+			want:   0.02,
+		},
+		{
+			name:   "capped at the maximum",
+			rule:   &ent.RoundingRule{Enabled: true, Increment: 1, Multiplier: 2, MaxRoundUp: &maxRoundUp},
+			amount: 4.35,
+			want:   0.5,
+		},
+		{
+			name:   "under the maximum",
+			rule:   &ent.RoundingRule{Enabled: true, Increment: 1, Multiplier: 1, MaxRoundUp: &maxRoundUp},
+			amount: 4.85,
+			want:   0.15,
+		},
+		{
+			name:          "covered payment method",
+			rule:          &ent.RoundingRule{Enabled: true, Increment: 1, Multiplier: 1, PaymentMethods: []string{"debit_card"}},
+			amount:        4.35,
+			paymentMethod: "Debit_Card",
+			want:          0.65,
+		},
+		{
+			name:          "payment method not covered",
+			rule:          &ent.RoundingRule{Enabled: true, Increment: 1, Multiplier: 1, PaymentMethods: []string{"debit_card"}},
+			amount:        4.35,
+			paymentMethod: "credit_card",
+			want:          0,
+		},
+		{
+			name:          "no payment methods covers all",
+			rule:          &ent.RoundingRule{Enabled: true, Increment: 1, Multiplier: 1},
+			amount:        4.35,
+			paymentMethod: "cash",
+			want:          0.65,
+		},
+		{
+			name:   "disabled rule",
+			rule:   &ent.RoundingRule{Enabled: false, Increment: 1, Multiplier: 1},
+			amount: 4.35,
+			want:   0,
+		},
+		{
+			name:   "no rule",
+			amount: 4.35,
+			want:   0,
+		},
+		{
+			name:   "refund",
+			rule:   &ent.RoundingRule{Enabled: true, Increment: 1, Multiplier: 1},
+			amount: -4.35,
+			want:   0,
+		},
+		{
+			name:   "no increment",
+			rule:   &ent.RoundingRule{Enabled: true, Increment: 0, Multiplier: 1},
+			amount: 4.35,
+			want:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RoundUp(tt.rule, tt.amount, tt.paymentMethod))
+		})
+	}
+}
@@ -0,0 +1,375 @@
+package transactions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/merchant"
+	"clockzen-next/internal/ent/predicate"
+	"clockzen-next/internal/ent/savedfilter"
+	"clockzen-next/internal/ent/transaction"
+
+	"github.com/google/uuid"
+)
+
+// Errors returned for transaction searches and saved filters
+var (
+	ErrInvalidFilter     = errors.New("invalid transaction filter")
+	ErrFilterNotFound    = errors.New("saved filter not found")
+	ErrFilterNameTaken   = errors.New("a saved filter with that name already exists")
+	ErrInvalidFilterName = errors.New("name is required")
+)
+
+const (
+	// DefaultSearchLimit is how many transactions a search returns when no
+	// limit is given
+	DefaultSearchLimit = 50
+
+	// MaxSearchLimit is the most transactions a search returns at once
+	MaxSearchLimit = 500
+
+	// DefaultSort orders searched transactions, the latest first
+	DefaultSort = "-transaction_date"
+)
+
+// sortFields are the fields searched transactions can be sorted by
+var sortFields = map[string]string{
+	"transaction_date": transaction.FieldTransactionDate,
+	"amount":           transaction.FieldAmount,
+	"merchant_name":    transaction.FieldMerchantName,
+	"created_at":       transaction.FieldCreatedAt,
+}
+
+// TransactionFilter picks the transactions matching all of its criteria;
+// empty criteria match everything. Lists match any of their entries:
+// Categories, Tags and Merchants ignoring case. Merchants are raw or
+// canonical merchant names, or canonical merchant IDs. Text is searched for
+// in descriptions, merchant names and notes. Filters are saved as JSON.
+type TransactionFilter struct {
+	MinAmount  *float64   `json:"min_amount,omitempty"`
+	MaxAmount  *float64   `json:"max_amount,omitempty"`
+	Categories []string   `json:"categories,omitempty"`
+	Tags       []string   `json:"tags,omitempty"`
+	Merchants  []string   `json:"merchants,omitempty"`
+	Text       string     `json:"text,omitempty"`
+	Sources    []string   `json:"sources,omitempty"`
+	StartDate  *time.Time `json:"start_date,omitempty"`
+	EndDate    *time.Time `json:"end_date,omitempty"`
+	// Sort is a field to sort by, prefixed with "-" for descending:
+	// transaction_date, amount, merchant_name or created_at
+	Sort string `json:"sort,omitempty"`
+}
+
+// SearchResult is a page of the transactions a filter matches
+type SearchResult struct {
+	Transactions []*ent.Transaction
+	// Total counts every matching transaction, not just those on the page
+	Total  int
+	Limit  int
+	Offset int
+}
+
+// Validate checks the filter's criteria are consistent and its sources and
+// sort are known
+func (f TransactionFilter) Validate() error {
+	if f.MinAmount != nil && f.MaxAmount != nil && *f.MinAmount > *f.MaxAmount {
+		return fmt.Errorf("%w: min_amount must not be above max_amount", ErrInvalidFilter)
+	}
+	if f.StartDate != nil && f.EndDate != nil && f.EndDate.Before(*f.StartDate) {
+		return fmt.Errorf("%w: end_date must be after start_date", ErrInvalidFilter)
+	}
+	for _, source := range f.Sources {
+		if err := transaction.SourceValidator(transaction.Source(source)); err != nil {
+			return fmt.Errorf("%w: source must be one of: receipt, manual", ErrInvalidFilter)
+		}
+	}
+	if f.Sort != "" {
+		if _, ok := sortFields[strings.TrimPrefix(f.Sort, "-")]; !ok {
+			return fmt.Errorf("%w: sort must be one of: transaction_date, amount, merchant_name, created_at", ErrInvalidFilter)
+		}
+	}
+	return nil
+}
+
+// Merge returns the filter with the criteria set in override replacing its
+// own, e.g. a saved filter narrowed to a date range
+func (f TransactionFilter) Merge(override TransactionFilter) TransactionFilter {
+	if override.MinAmount != nil {
+		f.MinAmount = override.MinAmount
+	}
+	if override.MaxAmount != nil {
+		f.MaxAmount = override.MaxAmount
+	}
+	if len(override.Categories) > 0 {
+		f.Categories = override.Categories
+	}
+	if len(override.Tags) > 0 {
+		f.Tags = override.Tags
+	}
+	if len(override.Merchants) > 0 {
+		f.Merchants = override.Merchants
+	}
+	if override.Text != "" {
+		f.Text = override.Text
+	}
+	if len(override.Sources) > 0 {
+		f.Sources = override.Sources
+	}
+	if override.StartDate != nil {
+		f.StartDate = override.StartDate
+	}
+	if override.EndDate != nil {
+		f.EndDate = override.EndDate
+	}
+	if override.Sort != "" {
+		f.Sort = override.Sort
+	}
+	return f
+}
+
+// predicates returns the filter's criteria as transaction predicates
+func (f TransactionFilter) predicates() []predicate.Transaction {
+	var where []predicate.Transaction
+	if f.MinAmount != nil {
+		where = append(where, transaction.AmountGTE(*f.MinAmount))
+	}
+	if f.MaxAmount != nil {
+		where = append(where, transaction.AmountLTE(*f.MaxAmount))
+	}
+	if len(f.Categories) > 0 {
+		matches := make([]predicate.Transaction, len(f.Categories))
+		for i, category := range f.Categories {
+			matches[i] = transaction.MerchantCategoryEqualFold(strings.TrimSpace(category))
+		}
+		where = append(where, transaction.Or(matches...))
+	}
+	if len(f.Tags) > 0 {
+		matches := make([]predicate.Transaction, len(f.Tags))
+		for i, tag := range f.Tags {
+			matches[i] = tagged(strings.TrimSpace(tag))
+		}
+		where = append(where, transaction.Or(matches...))
+	}
+	if len(f.Merchants) > 0 {
+		var matches []predicate.Transaction
+		for _, name := range f.Merchants {
+			name = strings.TrimSpace(name)
+			matches = append(matches,
+				transaction.MerchantID(name),
+				transaction.MerchantNameEqualFold(name),
+				transaction.HasMerchantWith(merchant.NameEqualFold(name)),
+			)
+		}
+		where = append(where, transaction.Or(matches...))
+	}
+	if text := strings.TrimSpace(f.Text); text != "" {
+		where = append(where, transaction.Or(
+			transaction.DescriptionContainsFold(text),
+			transaction.MerchantNameContainsFold(text),
+			transaction.NotesContainsFold(text),
+		))
+	}
+	if len(f.Sources) > 0 {
+		sources := make([]transaction.Source, len(f.Sources))
+		for i, source := range f.Sources {
+			sources[i] = transaction.Source(source)
+		}
+		where = append(where, transaction.SourceIn(sources...))
+	}
+	if f.StartDate != nil {
+		where = append(where, transaction.TransactionDateGTE(*f.StartDate))
+	}
+	if f.EndDate != nil {
+		where = append(where, transaction.TransactionDateLTE(*f.EndDate))
+	}
+	return where
+}
+
+// order returns the filter's sort as a query order, ties broken by ID
+func (f TransactionFilter) order() []transaction.OrderOption {
+	sort := f.Sort
+	if sort == "" {
+		sort = DefaultSort
+	}
+	field := sortFields[strings.TrimPrefix(sort, "-")]
+	if strings.HasPrefix(sort, "-") {
+		return []transaction.OrderOption{ent.Desc(field), ent.Desc(transaction.FieldID)}
+	}
+	return []transaction.OrderOption{ent.Asc(field), ent.Asc(transaction.FieldID)}
+}
+
+// SearchTransactions returns a page of the user's transactions matching
+// filter, limit of them (DefaultSearchLimit if zero) after skipping offset
+func (s *Service) SearchTransactions(ctx context.Context, userID string, filter TransactionFilter, limit, offset int) (*SearchResult, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	if limit == 0 {
+		limit = DefaultSearchLimit
+	}
+	if limit < 0 || limit > MaxSearchLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidFilter, MaxSearchLimit)
+	}
+	if offset < 0 {
+		return nil, fmt.Errorf("%w: offset must not be negative", ErrInvalidFilter)
+	}
+
+	query := s.entClient.Transaction.Query().
+		Where(transaction.UserID(userID)).
+		Where(filter.predicates()...)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("counting transactions: %w", err)
+	}
+	records, err := query.
+		Order(filter.order()...).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying transactions: %w", err)
+	}
+
+	return &SearchResult{
+		Transactions: records,
+		Total:        total,
+		Limit:        limit,
+		Offset:       offset,
+	}, nil
+}
+
+// ListSavedFilters returns the user's saved filters by name
+func (s *Service) ListSavedFilters(ctx context.Context, userID string) ([]*ent.SavedFilter, error) {
+	records, err := s.entClient.SavedFilter.Query().
+		Where(savedfilter.UserID(userID)).
+		Order(ent.Asc(savedfilter.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying saved filters: %w", err)
+	}
+	return records, nil
+}
+
+// GetSavedFilter returns one of the user's saved filters
+func (s *Service) GetSavedFilter(ctx context.Context, userID, id string) (*ent.SavedFilter, error) {
+	record, err := s.entClient.SavedFilter.Query().
+		Where(savedfilter.ID(id), savedfilter.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrFilterNotFound
+		}
+		return nil, fmt.Errorf("getting saved filter: %w", err)
+	}
+	return record, nil
+}
+
+// LoadSavedFilter returns the transaction filter one of the user's saved
+// filters holds, for searches, bulk operations and anything else picking
+// transactions by a saved filter
+func (s *Service) LoadSavedFilter(ctx context.Context, userID, id string) (TransactionFilter, error) {
+	record, err := s.GetSavedFilter(ctx, userID, id)
+	if err != nil {
+		return TransactionFilter{}, err
+	}
+	return DecodeSavedFilter(record)
+}
+
+// DecodeSavedFilter returns the transaction filter a saved filter holds
+func DecodeSavedFilter(record *ent.SavedFilter) (TransactionFilter, error) {
+	var filter TransactionFilter
+	if err := json.Unmarshal(record.Filter, &filter); err != nil {
+		return TransactionFilter{}, fmt.Errorf("decoding saved filter %s: %w", record.ID, err)
+	}
+	return filter, nil
+}
+
+// CreateSavedFilter saves a transaction filter under a name, unique among
+// the user's saved filters
+func (s *Service) CreateSavedFilter(ctx context.Context, userID, name string, filter TransactionFilter) (*ent.SavedFilter, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+	name, encoded, err := encodeSavedFilter(name, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := s.entClient.SavedFilter.Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetName(name).
+		SetFilter(encoded).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrFilterNameTaken
+		}
+		return nil, fmt.Errorf("saving filter: %w", err)
+	}
+	return record, nil
+}
+
+// UpdateSavedFilter renames one of the user's saved filters and replaces
+// the filter it holds
+func (s *Service) UpdateSavedFilter(ctx context.Context, userID, id, name string, filter TransactionFilter) (*ent.SavedFilter, error) {
+	name, encoded, err := encodeSavedFilter(name, filter)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.GetSavedFilter(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := existing.Update().
+		SetName(name).
+		SetFilter(encoded).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrFilterNameTaken
+		}
+		return nil, fmt.Errorf("updating saved filter: %w", err)
+	}
+	return record, nil
+}
+
+// DeleteSavedFilter deletes one of the user's saved filters
+func (s *Service) DeleteSavedFilter(ctx context.Context, userID, id string) error {
+	deleted, err := s.entClient.SavedFilter.Delete().
+		Where(savedfilter.ID(id), savedfilter.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting saved filter: %w", err)
+	}
+	if deleted == 0 {
+		return ErrFilterNotFound
+	}
+	return nil
+}
+
+// encodeSavedFilter validates a filter to save and its name, returning the
+// trimmed name and the filter as JSON
+func encodeSavedFilter(name string, filter TransactionFilter) (string, json.RawMessage, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", nil, ErrInvalidFilterName
+	}
+	if err := filter.Validate(); err != nil {
+		return "", nil, err
+	}
+	encoded, err := json.Marshal(filter)
+	if err != nil {
+		return "", nil, fmt.Errorf("encoding filter: %w", err)
+	}
+	return name, encoded, nil
+}
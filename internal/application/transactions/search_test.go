@@ -0,0 +1,80 @@
+package transactions
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionFilterValidate(t *testing.T) {
+	low, high := 10.0, 100.0
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		filter  TransactionFilter
+		wantErr bool
+	}{
+		{name: "empty", filter: TransactionFilter{}},
+		{
+			name: "every criterion",
+			filter: TransactionFilter{
+				MinAmount:  &low,
+				MaxAmount:  &high,
+				Categories: []string{"dining"},
+				Tags:       []string{"vacation"},
+				Merchants:  []string{"Blue Bottle Coffee"},
+				Text:       "latte",
+				Sources:    []string{"receipt", "manual"},
+				StartDate:  &start,
+				EndDate:    &end,
+				Sort:       "-amount",
+			},
+		},
+		{name: "min above max", filter: TransactionFilter{MinAmount: &high, MaxAmount: &low}, wantErr: true},
+		{name: "end before start", filter: TransactionFilter{StartDate: &end, EndDate: &start}, wantErr: true},
+		{name: "unknown source", filter: TransactionFilter{Sources: []string{"import"}}, wantErr: true},
+		{name: "unknown sort", filter: TransactionFilter{Sort: "-category"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidFilter), "got %v", err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTransactionFilterMerge(t *testing.T) {
+	low, high := 10.0, 100.0
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	saved := TransactionFilter{
+		MinAmount:  &low,
+		Categories: []string{"dining"},
+		Merchants:  []string{"Starbucks"},
+		Sort:       "amount",
+	}
+	merged := saved.Merge(TransactionFilter{
+		MaxAmount:  &high,
+		Categories: []string{"groceries"},
+		StartDate:  &start,
+	})
+
+	assert.Equal(t, TransactionFilter{
+		MinAmount:  &low,
+		MaxAmount:  &high,
+		Categories: []string{"groceries"},
+		Merchants:  []string{"Starbucks"},
+		StartDate:  &start,
+		Sort:       "amount",
+	}, merged)
+	assert.Equal(t, []string{"dining"}, saved.Categories, "merging leaves the saved filter alone")
+}
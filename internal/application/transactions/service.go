@@ -0,0 +1,309 @@
+package transactions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/domain/money"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/roundingrule"
+	"clockzen-next/internal/ent/transaction"
+
+	"github.com/google/uuid"
+)
+
+// Errors returned by the transaction service
+var (
+	ErrInvalidAmount        = errors.New("amount must be positive")
+	ErrInvalidIncrement     = errors.New("increment must be positive")
+	ErrInvalidMultiplier    = errors.New("multiplier must be positive")
+	ErrInvalidMaxRoundUp    = errors.New("max_round_up must be positive")
+	ErrRoundingRuleNotFound = errors.New("rounding rule not found")
+)
+
+// DefaultPaymentMethod is recorded for manual entries that don't name one,
+// since most are cash purchases without a receipt
+const DefaultPaymentMethod = "cash"
+
+// ManualTransaction is a transaction entered by hand rather than extracted
+// from a receipt
+type ManualTransaction struct {
+	UserID          string
+	Type            transaction.Type
+	Amount          float64
+	Currency        string
+	TransactionDate time.Time
+	Description     string
+	MerchantName    string
+	Category        string
+	PaymentMethod   string
+	Tags            []string
+	Notes           string
+	// MemberID attributes the transaction to a member of the user's
+	// household
+	MemberID string
+}
+
+// RoundingRuleInput configures a user's rounding rule
+type RoundingRuleInput struct {
+	Increment      float64
+	Multiplier     float64
+	MaxRoundUp     *float64
+	PaymentMethods []string
+	Enabled        bool
+}
+
+// RoundUpSummary totals the round-ups set aside over a date range
+type RoundUpSummary struct {
+	UserID           string
+	StartDate        time.Time
+	EndDate          time.Time
+	TotalRoundUp     float64
+	TotalSpent       float64
+	TransactionCount int
+}
+
+// MerchantResolver resolves raw merchant names to canonical merchants. It
+// returns nil for names that don't normalize to one.
+type MerchantResolver interface {
+	Resolve(ctx context.Context, raw string) (*ent.Merchant, error)
+}
+
+// Service records manual transactions and applies rounding rules. It also
+// serves transactions to spending analysis.
+type Service struct {
+	entClient *ent.Client
+	merchants MerchantResolver
+}
+
+// NewService creates a new transaction service
+func NewService(entClient *ent.Client) *Service {
+	return &Service{
+		entClient: entClient,
+	}
+}
+
+// SetMerchantResolver links transactions entered by hand to canonical
+// merchants as they are recorded, categorizing them by the merchant unless
+// given a category. Without one they are linked by backfills.
+func (s *Service) SetMerchantResolver(resolver MerchantResolver) {
+	s.merchants = resolver
+}
+
+// CreateManualTransaction records a transaction entered by hand. Purchases
+// are rounded up by the user's rounding rule, if they have one that applies.
+// The user's categorization rules categorize it unless given a category,
+// and add their tags.
+func (s *Service) CreateManualTransaction(ctx context.Context, input ManualTransaction) (*ent.Transaction, error) {
+	if input.UserID == "" {
+		return nil, errors.New("userID is required")
+	}
+	if input.Amount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	if input.Type == "" {
+		input.Type = transaction.TypePurchase
+	}
+	if err := transaction.TypeValidator(input.Type); err != nil {
+		return nil, err
+	}
+	if input.TransactionDate.IsZero() {
+		input.TransactionDate = time.Now()
+	}
+	if input.PaymentMethod == "" {
+		input.PaymentMethod = DefaultPaymentMethod
+	}
+	if input.MemberID != "" {
+		if _, err := s.GetMember(ctx, input.UserID, input.MemberID); err != nil {
+			return nil, err
+		}
+	}
+
+	roundUp := 0.0
+	if input.Type == transaction.TypePurchase {
+		rule, err := s.entClient.RoundingRule.Query().
+			Where(roundingrule.UserID(input.UserID)).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("getting rounding rule: %w", err)
+		}
+		roundUp = RoundUp(rule, input.Amount, input.PaymentMethod)
+	}
+
+	categorizer, err := s.categorizer(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	categorization := categorizer.Categorize(input.MerchantName, input.Description, input.Amount)
+	if input.Category == "" {
+		input.Category = categorization.Category
+	}
+	if len(categorization.Tags) > 0 {
+		input.Tags = mergeTags(append([]string(nil), input.Tags...), categorization.Tags)
+	}
+
+	create := s.entClient.Transaction.Create().
+		SetID(uuid.New().String()).
+		SetUserID(input.UserID).
+		SetSource(transaction.SourceManual).
+		SetType(input.Type).
+		SetAmount(input.Amount).
+		SetTransactionDate(input.TransactionDate).
+		SetPaymentMethod(input.PaymentMethod).
+		SetRoundUpAmount(roundUp)
+	if input.Currency != "" {
+		create.SetCurrency(strings.ToUpper(input.Currency))
+	}
+	if input.Description != "" {
+		create.SetDescription(input.Description)
+	}
+	if input.MerchantName != "" {
+		create.SetMerchantName(input.MerchantName)
+	}
+	if input.Category != "" {
+		create.SetMerchantCategory(strings.ToLower(input.Category))
+	}
+	if input.MerchantName != "" && s.merchants != nil {
+		merchant, err := s.merchants.Resolve(ctx, input.MerchantName)
+		if err != nil {
+			return nil, fmt.Errorf("resolving merchant: %w", err)
+		}
+		if merchant != nil {
+			create.SetMerchantID(merchant.ID)
+			if input.Category == "" && merchant.Category != nil {
+				create.SetMerchantCategory(*merchant.Category)
+			}
+		}
+	}
+	if len(input.Tags) > 0 {
+		create.SetCategoryTags(input.Tags)
+	}
+	if input.Notes != "" {
+		create.SetNotes(input.Notes)
+	}
+	if input.MemberID != "" {
+		create.SetMemberID(input.MemberID)
+	}
+
+	record, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating transaction: %w", err)
+	}
+	return record, nil
+}
+
+// GetRoundingRule returns the user's rounding rule
+func (s *Service) GetRoundingRule(ctx context.Context, userID string) (*ent.RoundingRule, error) {
+	rule, err := s.entClient.RoundingRule.Query().
+		Where(roundingrule.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrRoundingRuleNotFound
+		}
+		return nil, fmt.Errorf("getting rounding rule: %w", err)
+	}
+	return rule, nil
+}
+
+// SetRoundingRule creates or replaces the user's rounding rule. It applies
+// to transactions entered from then on; existing round-ups are kept.
+func (s *Service) SetRoundingRule(ctx context.Context, userID string, input RoundingRuleInput) (*ent.RoundingRule, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+	if input.Increment <= 0 {
+		return nil, ErrInvalidIncrement
+	}
+	if input.Multiplier == 0 {
+		input.Multiplier = 1
+	}
+	if input.Multiplier < 0 {
+		return nil, ErrInvalidMultiplier
+	}
+	if input.MaxRoundUp != nil && *input.MaxRoundUp <= 0 {
+		return nil, ErrInvalidMaxRoundUp
+	}
+
+	paymentMethods := make([]string, len(input.PaymentMethods))
+	for i, method := range input.PaymentMethods {
+		paymentMethods[i] = strings.ToLower(method)
+	}
+
+	err := s.entClient.RoundingRule.Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetIncrement(input.Increment).
+		SetMultiplier(input.Multiplier).
+		SetNillableMaxRoundUp(input.MaxRoundUp).
+		SetPaymentMethods(paymentMethods).
+		SetEnabled(input.Enabled).
+		OnConflictColumns(roundingrule.FieldUserID).
+		Update(func(u *ent.RoundingRuleUpsert) {
+			u.UpdateIncrement()
+			u.UpdateMultiplier()
+			if input.MaxRoundUp != nil {
+				u.UpdateMaxRoundUp()
+			} else {
+				u.ClearMaxRoundUp()
+			}
+			u.UpdatePaymentMethods()
+			u.UpdateEnabled()
+			u.SetUpdatedAt(time.Now())
+		}).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("saving rounding rule: %w", err)
+	}
+	return s.GetRoundingRule(ctx, userID)
+}
+
+// DeleteRoundingRule removes the user's rounding rule, so new purchases are
+// no longer rounded up
+func (s *Service) DeleteRoundingRule(ctx context.Context, userID string) error {
+	deleted, err := s.entClient.RoundingRule.Delete().
+		Where(roundingrule.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting rounding rule: %w", err)
+	}
+	if deleted == 0 {
+		return ErrRoundingRuleNotFound
+	}
+	return nil
+}
+
+// GetRoundUpSummary totals the round-ups of the user's transactions between
+// startDate and endDate, whether entered by hand or from receipts
+func (s *Service) GetRoundUpSummary(ctx context.Context, userID string, startDate, endDate time.Time) (*RoundUpSummary, error) {
+	records, err := s.entClient.Transaction.Query().
+		Where(
+			transaction.UserID(userID),
+			transaction.TransactionDateGTE(startDate),
+			transaction.TransactionDateLTE(endDate),
+			transaction.RoundUpAmountGT(0),
+			transaction.StatusNotIn(excludedStatuses...),
+		).
+		Select(transaction.FieldAmount, transaction.FieldRoundUpAmount).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying transactions: %w", err)
+	}
+
+	summary := &RoundUpSummary{
+		UserID:           userID,
+		StartDate:        startDate,
+		EndDate:          endDate,
+		TransactionCount: len(records),
+	}
+	for _, record := range records {
+		summary.TotalRoundUp += record.RoundUpAmount
+		summary.TotalSpent += record.Amount
+	}
+	summary.TotalRoundUp = money.RoundCents(summary.TotalRoundUp)
+	summary.TotalSpent = money.RoundCents(summary.TotalSpent)
+	return summary, nil
+}
@@ -0,0 +1,252 @@
+package transactions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/domain/money"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/cardaccount"
+
+	"github.com/google/uuid"
+)
+
+// Errors returned for card accounts
+var (
+	ErrCardAccountNotFound = errors.New("card account not found")
+	ErrInvalidCardName     = errors.New("name is required")
+	ErrInvalidLastFour     = errors.New("card_last_four must be 4 digits")
+	ErrInvalidDueDays      = errors.New("payment_due_days must not be negative")
+)
+
+// CardAccountInput describes a card account and its statement cycle
+type CardAccountInput struct {
+	Name                string
+	CardLastFour        string
+	StatementClosingDay int
+	// PaymentDueDays defaults to 25 when nil
+	PaymentDueDays *int
+}
+
+// CardAccountUpdate changes the fields of a card account that are set. An
+// empty CardLastFour clears it.
+type CardAccountUpdate struct {
+	Name                *string
+	CardLastFour        *string
+	StatementClosingDay *int
+	PaymentDueDays      *int
+}
+
+// StatementSummary is a card's spending in one statement period
+type StatementSummary struct {
+	Account          *ent.CardAccount
+	PeriodStart      time.Time
+	PeriodEnd        time.Time
+	DueDate          time.Time
+	TotalSpent       float64
+	TransactionCount int
+	// Closed is whether the statement closed before the summary was taken
+	Closed bool
+}
+
+// CreateCardAccount records a card account and its statement cycle
+func (s *Service) CreateCardAccount(ctx context.Context, userID string, input CardAccountInput) (*ent.CardAccount, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+	dueDays := cardaccount.DefaultPaymentDueDays
+	if input.PaymentDueDays != nil {
+		dueDays = *input.PaymentDueDays
+	}
+	if err := validateCardAccount(input.Name, input.CardLastFour, input.StatementClosingDay, dueDays); err != nil {
+		return nil, err
+	}
+
+	create := s.entClient.CardAccount.Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetName(input.Name).
+		SetStatementClosingDay(input.StatementClosingDay).
+		SetPaymentDueDays(dueDays)
+	if input.CardLastFour != "" {
+		create.SetCardLastFour(input.CardLastFour)
+	}
+	record, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating card account: %w", err)
+	}
+	return record, nil
+}
+
+// ListCardAccounts returns the user's card accounts by name
+func (s *Service) ListCardAccounts(ctx context.Context, userID string) ([]*ent.CardAccount, error) {
+	records, err := s.entClient.CardAccount.Query().
+		Where(cardaccount.UserID(userID)).
+		Order(ent.Asc(cardaccount.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying card accounts: %w", err)
+	}
+	return records, nil
+}
+
+// GetCardAccount returns one of the user's card accounts
+func (s *Service) GetCardAccount(ctx context.Context, userID, id string) (*ent.CardAccount, error) {
+	record, err := s.entClient.CardAccount.Query().
+		Where(cardaccount.ID(id), cardaccount.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrCardAccountNotFound
+		}
+		return nil, fmt.Errorf("getting card account: %w", err)
+	}
+	return record, nil
+}
+
+// UpdateCardAccount changes one of the user's card accounts
+func (s *Service) UpdateCardAccount(ctx context.Context, userID, id string, input CardAccountUpdate) (*ent.CardAccount, error) {
+	record, err := s.GetCardAccount(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	name, closingDay, dueDays := record.Name, record.StatementClosingDay, record.PaymentDueDays
+	lastFour := ""
+	if record.CardLastFour != nil {
+		lastFour = *record.CardLastFour
+	}
+	if input.Name != nil {
+		name = *input.Name
+	}
+	if input.CardLastFour != nil {
+		lastFour = *input.CardLastFour
+	}
+	if input.StatementClosingDay != nil {
+		closingDay = *input.StatementClosingDay
+	}
+	if input.PaymentDueDays != nil {
+		dueDays = *input.PaymentDueDays
+	}
+	if err := validateCardAccount(name, lastFour, closingDay, dueDays); err != nil {
+		return nil, err
+	}
+
+	update := record.Update().
+		SetName(name).
+		SetStatementClosingDay(closingDay).
+		SetPaymentDueDays(dueDays)
+	if lastFour != "" {
+		update.SetCardLastFour(lastFour)
+	} else {
+		update.ClearCardLastFour()
+	}
+	record, err = update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("updating card account: %w", err)
+	}
+	return record, nil
+}
+
+// DeleteCardAccount removes one of the user's card accounts
+func (s *Service) DeleteCardAccount(ctx context.Context, userID, id string) error {
+	deleted, err := s.entClient.CardAccount.Delete().
+		Where(cardaccount.ID(id), cardaccount.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting card account: %w", err)
+	}
+	if deleted == 0 {
+		return ErrCardAccountNotFound
+	}
+	return nil
+}
+
+// GetStatementCycle returns the statement cycle of one of the user's card
+// accounts. The service implements analysis.StatementCycleRepository.
+func (s *Service) GetStatementCycle(ctx context.Context, userID, accountID string) (*analysis.StatementCycle, error) {
+	record, err := s.GetCardAccount(ctx, userID, accountID)
+	if err != nil {
+		if errors.Is(err, ErrCardAccountNotFound) {
+			return nil, fmt.Errorf("%w: %s", analysis.ErrStatementCycleNotFound, accountID)
+		}
+		return nil, err
+	}
+	return statementCycle(record), nil
+}
+
+// GetStatement summarizes the card's spending in the statement period
+// containing date, so the current period shows what the user will owe
+func (s *Service) GetStatement(ctx context.Context, userID, accountID string, date time.Time) (*StatementSummary, error) {
+	record, err := s.GetCardAccount(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	cycle := statementCycle(record)
+	start := cycle.PeriodStart(date)
+	end := cycle.PeriodEnd(start)
+	spending, err := s.spending(ctx, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &StatementSummary{
+		Account:     record,
+		PeriodStart: start,
+		PeriodEnd:   end,
+		DueDate:     cycle.NextPeriod(start).AddDate(0, 0, record.PaymentDueDays-1),
+		Closed:      time.Now().After(end),
+	}
+	for _, t := range cycle.Filter(spending) {
+		summary.TotalSpent += t.Amount
+		summary.TransactionCount++
+	}
+	summary.TotalSpent = money.RoundCents(summary.TotalSpent)
+	return summary, nil
+}
+
+// statementCycle returns the statement cycle of a card account
+func statementCycle(record *ent.CardAccount) *analysis.StatementCycle {
+	cycle := &analysis.StatementCycle{
+		AccountID:  record.ID,
+		ClosingDay: record.StatementClosingDay,
+	}
+	if record.CardLastFour != nil {
+		cycle.CardLastFour = *record.CardLastFour
+	}
+	return cycle
+}
+
+// validateCardAccount checks a card account's fields before it is saved
+func validateCardAccount(name, lastFour string, closingDay, dueDays int) error {
+	if name == "" {
+		return ErrInvalidCardName
+	}
+	if lastFour != "" && !isLastFour(lastFour) {
+		return ErrInvalidLastFour
+	}
+	if err := (analysis.StatementCycle{ClosingDay: closingDay}).Validate(); err != nil {
+		return err
+	}
+	if dueDays < 0 {
+		return ErrInvalidDueDays
+	}
+	return nil
+}
+
+// isLastFour reports whether s is the last 4 digits of a card number
+func isLastFour(s string) bool {
+	if len(s) != 4 {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,489 @@
+package transactions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/bulkoperation"
+	"clockzen-next/internal/ent/categoryfeedback"
+	"clockzen-next/internal/ent/transaction"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidSuggestion is returned for malformed suggestion requests and
+// feedback
+var ErrInvalidSuggestion = errors.New("invalid category suggestion")
+
+// Sources of category suggestions
+const (
+	// SuggestionSourceModel marks categories suggested by the model learned
+	// from the user's corrections
+	SuggestionSourceModel = "model"
+	// SuggestionSourceRule marks categories suggested by the user's
+	// categorization rules, when the model isn't confident enough
+	SuggestionSourceRule = "rule"
+)
+
+const (
+	// DefaultSuggestionConfidence is the confidence below which the model's
+	// suggestions give way to the categorization rules
+	DefaultSuggestionConfidence = 0.6
+
+	// DefaultSuggestionLimit is how many uncategorized transactions get
+	// suggestions when none are listed
+	DefaultSuggestionLimit = 50
+
+	// MaxSuggestionLimit is the most transactions suggested for at once
+	MaxSuggestionLimit = 500
+
+	// maxSuggestionScores is how many of the model's categories a
+	// suggestion reports
+	maxSuggestionScores = 3
+
+	// trainingFeedbackLimit and trainingOperationLimit cap how much of the
+	// user's latest feedback and bulk recategorizations the model learns
+	// from
+	trainingFeedbackLimit  = 5000
+	trainingOperationLimit = 200
+)
+
+// CategoryScore is the model's confidence (0-1) that a transaction belongs
+// in a category
+type CategoryScore struct {
+	Category   string
+	Confidence float64
+}
+
+// CategorySuggestion is the category suggested for a transaction. Category
+// is empty if neither the model nor the rules suggest one.
+type CategorySuggestion struct {
+	Transaction *ent.Transaction
+	Category    string
+	// Source is SuggestionSourceModel or SuggestionSourceRule
+	Source string
+	// Confidence is the model's confidence in its best category, even when
+	// the rules made the suggestion
+	Confidence float64
+	// Scores are the model's best categories, most likely first
+	Scores []CategoryScore
+}
+
+// SuggestionResult holds the suggestions for a set of transactions
+type SuggestionResult struct {
+	Suggestions []CategorySuggestion
+	// TrainingExamples counts the corrections the model learned from
+	TrainingExamples int
+}
+
+// SuggestionOptions picks the transactions to suggest categories for:
+// those listed, or else the latest Limit uncategorized ones
+type SuggestionOptions struct {
+	TransactionIDs []string
+	Limit          int
+	// MinConfidence overrides DefaultSuggestionConfidence
+	MinConfidence *float64
+}
+
+// SuggestionFeedback is a user accepting or rejecting a suggested category.
+// A rejection can correct the category; either way the rejected category
+// isn't suggested for the transaction again.
+type SuggestionFeedback struct {
+	TransactionID     string
+	SuggestedCategory string
+	Source            string
+	Confidence        *float64
+	Accepted          bool
+	Category          string
+}
+
+// CategoryModel is a multinomial naive Bayes classifier of transactions
+// into categories, by the words of their merchant names and descriptions
+type CategoryModel struct {
+	examples   map[string]int
+	counts     map[string]map[string]int
+	totals     map[string]int
+	vocabulary map[string]bool
+	size       int
+}
+
+// NewCategoryModel creates a model that has learned nothing
+func NewCategoryModel() *CategoryModel {
+	return &CategoryModel{
+		examples:   make(map[string]int),
+		counts:     make(map[string]map[string]int),
+		totals:     make(map[string]int),
+		vocabulary: make(map[string]bool),
+	}
+}
+
+// Train learns that a transaction belongs in a category
+func (m *CategoryModel) Train(merchantName, description, category string) {
+	category = strings.ToLower(strings.TrimSpace(category))
+	tokens := featureTokens(merchantName, description)
+	if category == "" || len(tokens) == 0 {
+		return
+	}
+
+	if m.counts[category] == nil {
+		m.counts[category] = make(map[string]int)
+	}
+	for _, token := range tokens {
+		m.counts[category][token]++
+		m.vocabulary[token] = true
+	}
+	m.totals[category] += len(tokens)
+	m.examples[category]++
+	m.size++
+}
+
+// Examples returns how many transactions the model learned from
+func (m *CategoryModel) Examples() int {
+	return m.size
+}
+
+// Predict returns the model's confidence in each category it knows for a
+// transaction, most likely first. It predicts nothing until it knows two
+// categories, or for transactions sharing no words with those it learned.
+func (m *CategoryModel) Predict(merchantName, description string) []CategoryScore {
+	if len(m.examples) < 2 {
+		return nil
+	}
+	var known []string
+	for _, token := range featureTokens(merchantName, description) {
+		if m.vocabulary[token] {
+			known = append(known, token)
+		}
+	}
+	if len(known) == 0 {
+		return nil
+	}
+
+	// Log-probabilities with Laplace smoothing, normalized with softmax
+	scores := make([]CategoryScore, 0, len(m.examples))
+	best := math.Inf(-1)
+	for category, examples := range m.examples {
+		logp := math.Log(float64(examples+1) / float64(m.size+len(m.examples)))
+		for _, token := range known {
+			logp += math.Log(float64(m.counts[category][token]+1) / float64(m.totals[category]+len(m.vocabulary)))
+		}
+		scores = append(scores, CategoryScore{Category: category, Confidence: logp})
+		best = math.Max(best, logp)
+	}
+	sum := 0.0
+	for i := range scores {
+		scores[i].Confidence = math.Exp(scores[i].Confidence - best)
+		sum += scores[i].Confidence
+	}
+	for i := range scores {
+		scores[i].Confidence /= sum
+	}
+
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Confidence != scores[j].Confidence {
+			return scores[i].Confidence > scores[j].Confidence
+		}
+		return scores[i].Category < scores[j].Category
+	})
+	return scores
+}
+
+// featureTokens returns the words the model classifies a transaction by:
+// the merchant name as a whole, its words, and the description's words.
+// Numbers and single letters are left out.
+func featureTokens(merchantName, description string) []string {
+	var tokens []string
+	words := func(text, prefix string) {
+		for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			if len(word) < 2 || strings.IndexFunc(word, unicode.IsLetter) < 0 {
+				continue
+			}
+			tokens = append(tokens, prefix+word)
+		}
+	}
+
+	if merchant := strings.Join(strings.Fields(strings.ToLower(merchantName)), " "); merchant != "" {
+		tokens = append(tokens, "merchant="+merchant)
+	}
+	words(merchantName, "m:")
+	words(description, "d:")
+	return tokens
+}
+
+// suggestCategory suggests a category for a transaction: the model's best
+// category not rejected for it, if the model is at least minConfidence
+// sure of it, or else the category the rules give
+func suggestCategory(model *CategoryModel, categorizer *Categorizer, t *ent.Transaction, minConfidence float64, rejected map[string]bool) CategorySuggestion {
+	suggestion := CategorySuggestion{Transaction: t}
+	for _, score := range model.Predict(deref(t.MerchantName), deref(t.Description)) {
+		if rejected[score.Category] {
+			continue
+		}
+		suggestion.Scores = append(suggestion.Scores, score)
+		if len(suggestion.Scores) == maxSuggestionScores {
+			break
+		}
+	}
+	if len(suggestion.Scores) > 0 {
+		best := suggestion.Scores[0]
+		suggestion.Confidence = best.Confidence
+		if best.Confidence >= minConfidence {
+			suggestion.Category = best.Category
+			suggestion.Source = SuggestionSourceModel
+			return suggestion
+		}
+	}
+
+	// The model isn't sure enough; the rules decide
+	if category := categorizer.categorizeTransaction(t).Category; category != "" && !rejected[category] {
+		suggestion.Category = category
+		suggestion.Source = SuggestionSourceRule
+	}
+	return suggestion
+}
+
+// SuggestCategories suggests categories for the user's transactions, from
+// a model learned from their corrections and, where it isn't confident
+// enough, their categorization rules
+func (s *Service) SuggestCategories(ctx context.Context, userID string, opts SuggestionOptions) (*SuggestionResult, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+	minConfidence := DefaultSuggestionConfidence
+	if opts.MinConfidence != nil {
+		minConfidence = *opts.MinConfidence
+	}
+	if minConfidence < 0 || minConfidence > 1 {
+		return nil, fmt.Errorf("%w: min_confidence must be between 0 and 1", ErrInvalidSuggestion)
+	}
+	limit := opts.Limit
+	if limit == 0 {
+		limit = DefaultSuggestionLimit
+	}
+	if limit < 0 || limit > MaxSuggestionLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidSuggestion, MaxSuggestionLimit)
+	}
+
+	records, err := s.suggestionTransactions(ctx, userID, opts.TransactionIDs, limit)
+	if err != nil {
+		return nil, err
+	}
+	model, err := s.categoryModel(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	categorizer, err := s.categorizer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	rejected, err := s.rejectedCategories(ctx, userID, records)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SuggestionResult{
+		Suggestions:      make([]CategorySuggestion, len(records)),
+		TrainingExamples: model.Examples(),
+	}
+	for i, record := range records {
+		result.Suggestions[i] = suggestCategory(model, categorizer, record, minConfidence, rejected[record.ID])
+	}
+	return result, nil
+}
+
+// RecordSuggestionFeedback records a user accepting or rejecting a
+// category suggested for one of their transactions, for the model to learn
+// from. The transaction is given the category accepted or corrected to.
+func (s *Service) RecordSuggestionFeedback(ctx context.Context, userID string, input SuggestionFeedback) (*ent.CategoryFeedback, error) {
+	suggested := strings.ToLower(strings.TrimSpace(input.SuggestedCategory))
+	category := strings.ToLower(strings.TrimSpace(input.Category))
+	switch {
+	case input.TransactionID == "":
+		return nil, fmt.Errorf("%w: transaction_id is required", ErrInvalidSuggestion)
+	case suggested == "":
+		return nil, fmt.Errorf("%w: suggested_category is required", ErrInvalidSuggestion)
+	case categoryfeedback.SuggestionSourceValidator(categoryfeedback.SuggestionSource(input.Source)) != nil:
+		return nil, fmt.Errorf("%w: source must be one of: model, rule", ErrInvalidSuggestion)
+	case input.Confidence != nil && (*input.Confidence < 0 || *input.Confidence > 1):
+		return nil, fmt.Errorf("%w: confidence must be between 0 and 1", ErrInvalidSuggestion)
+	case input.Accepted && category != "" && category != suggested:
+		return nil, fmt.Errorf("%w: an accepted suggestion can't be corrected", ErrInvalidSuggestion)
+	case !input.Accepted && category == suggested:
+		return nil, fmt.Errorf("%w: a rejected suggestion must be corrected to another category", ErrInvalidSuggestion)
+	}
+	if input.Accepted {
+		category = suggested
+	}
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	record, err := tx.Transaction.Query().
+		Where(transaction.ID(input.TransactionID), transaction.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, fmt.Errorf("getting transaction: %w", err)
+	}
+	if category != "" {
+		if err := record.Update().SetMerchantCategory(category).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("categorizing transaction: %w", err)
+		}
+	}
+
+	create := tx.CategoryFeedback.Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetTransactionID(record.ID).
+		SetNillableMerchantName(record.MerchantName).
+		SetNillableDescription(record.Description).
+		SetSuggestedCategory(suggested).
+		SetSuggestionSource(categoryfeedback.SuggestionSource(input.Source)).
+		SetNillableConfidence(input.Confidence).
+		SetAccepted(input.Accepted)
+	if category != "" {
+		create.SetCategory(category)
+	}
+	feedback, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("recording feedback: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing feedback: %w", err)
+	}
+	return feedback.Unwrap(), nil
+}
+
+// suggestionTransactions loads the listed transactions of the user, or
+// else their latest uncategorized ones
+func (s *Service) suggestionTransactions(ctx context.Context, userID string, ids []string, limit int) ([]*ent.Transaction, error) {
+	query := s.entClient.Transaction.Query().Where(transaction.UserID(userID))
+	if len(ids) == 0 {
+		records, err := query.
+			Where(transaction.MerchantCategoryIsNil()).
+			Order(ent.Desc(transaction.FieldTransactionDate), ent.Desc(transaction.FieldID)).
+			Limit(limit).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("querying transactions: %w", err)
+		}
+		return records, nil
+	}
+	if len(ids) > limit {
+		return nil, fmt.Errorf("%w: at most %d transactions can be listed", ErrInvalidSuggestion, limit)
+	}
+
+	records, err := query.Where(transaction.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying transactions: %w", err)
+	}
+	byID := make(map[string]*ent.Transaction, len(records))
+	for _, record := range records {
+		byID[record.ID] = record
+	}
+	ordered := make([]*ent.Transaction, 0, len(ids))
+	for _, id := range ids {
+		record, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrTransactionNotFound, id)
+		}
+		ordered = append(ordered, record)
+	}
+	return ordered, nil
+}
+
+// categoryModel trains a model on the user's corrections: the categories
+// they accepted or corrected suggestions to, and their bulk
+// recategorizations that weren't undone
+func (s *Service) categoryModel(ctx context.Context, userID string) (*CategoryModel, error) {
+	model := NewCategoryModel()
+
+	feedback, err := s.entClient.CategoryFeedback.Query().
+		Where(categoryfeedback.UserID(userID), categoryfeedback.CategoryNotNil()).
+		Order(ent.Desc(categoryfeedback.FieldCreatedAt)).
+		Limit(trainingFeedbackLimit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying category feedback: %w", err)
+	}
+	for _, record := range feedback {
+		model.Train(deref(record.MerchantName), deref(record.Description), *record.Category)
+	}
+
+	operations, err := s.entClient.BulkOperation.Query().
+		Where(
+			bulkoperation.UserID(userID),
+			bulkoperation.KindEQ(bulkoperation.KindRecategorize),
+			bulkoperation.UndoneAtIsNil(),
+		).
+		Order(ent.Desc(bulkoperation.FieldCreatedAt)).
+		Limit(trainingOperationLimit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying bulk operations: %w", err)
+	}
+	for _, operation := range operations {
+		if operation.Category == nil {
+			continue
+		}
+		var images []*ent.Transaction
+		if err := json.Unmarshal(operation.BeforeImages, &images); err != nil {
+			return nil, fmt.Errorf("decoding operation %s: %w", operation.ID, err)
+		}
+		for _, image := range images {
+			model.Train(deref(image.MerchantName), deref(image.Description), *operation.Category)
+		}
+	}
+	return model, nil
+}
+
+// rejectedCategories returns the categories rejected for each of the
+// transactions
+func (s *Service) rejectedCategories(ctx context.Context, userID string, records []*ent.Transaction) (map[string]map[string]bool, error) {
+	ids := make([]string, len(records))
+	for i, record := range records {
+		ids[i] = record.ID
+	}
+	feedback, err := s.entClient.CategoryFeedback.Query().
+		Where(
+			categoryfeedback.UserID(userID),
+			categoryfeedback.TransactionIDIn(ids...),
+			categoryfeedback.Accepted(false),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying category feedback: %w", err)
+	}
+
+	rejected := make(map[string]map[string]bool)
+	for _, record := range feedback {
+		if rejected[record.TransactionID] == nil {
+			rejected[record.TransactionID] = make(map[string]bool)
+		}
+		rejected[record.TransactionID][record.SuggestedCategory] = true
+	}
+	return rejected, nil
+}
+
+// deref returns the value of an optional string, or "" if it is nil
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
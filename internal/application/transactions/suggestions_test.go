@@ -0,0 +1,125 @@
+package transactions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/ent"
+)
+
+// trainedModel returns a model trained on a few corrections
+func trainedModel() *CategoryModel {
+	model := NewCategoryModel()
+	model.Train("Blue Bottle Coffee", "Latte", "Dining")
+	model.Train("Blue Bottle Coffee", "Cold brew", "dining")
+	model.Train("Starbucks", "Coffee and croissant", "dining")
+	model.Train("Whole Foods Market", "Weekly groceries", "groceries")
+	model.Train("Trader Joe's", "Groceries", "groceries")
+	model.Train("Shell", "Fuel", "transport")
+	model.Train("", "", "ignored")
+	return model
+}
+
+func TestFeatureTokens(t *testing.T) {
+	assert.Equal(t,
+		[]string{"merchant=whole foods #123", "m:whole", "m:foods", "d:card", "d:purchase"},
+		featureTokens("Whole  Foods #123", "Card purchase 4242 x"),
+	)
+	assert.Empty(t, featureTokens("", "12 34"))
+}
+
+func TestCategoryModelPredict(t *testing.T) {
+	model := trainedModel()
+	assert.Equal(t, 6, model.Examples())
+
+	scores := model.Predict("Blue Bottle Coffee", "Pour over")
+	require.Len(t, scores, 3)
+	assert.Equal(t, "dining", scores[0].Category)
+	assert.Greater(t, scores[0].Confidence, 0.8)
+	total := 0.0
+	for _, score := range scores {
+		total += score.Confidence
+	}
+	assert.InDelta(t, 1, total, 1e-9)
+
+	scores = model.Predict("Whole Foods", "groceries")
+	require.NotEmpty(t, scores)
+	assert.Equal(t, "groceries", scores[0].Category)
+
+	// Nothing in common with what was learned
+	assert.Empty(t, model.Predict("Acme Hardware", "Screws"))
+
+	// One category isn't enough to choose between
+	single := NewCategoryModel()
+	single.Train("Starbucks", "Latte", "dining")
+	assert.Empty(t, single.Predict("Starbucks", "Latte"))
+}
+
+func TestSuggestCategory(t *testing.T) {
+	categorizer, err := NewCategorizer([]*ent.CategorizationRule{{
+		ID:              "fuel",
+		Enabled:         true,
+		MerchantPattern: strPtr(`shell|chevron`),
+		Category:        strPtr("auto"),
+		CreatedAt:       time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}})
+	require.NoError(t, err)
+	model := trainedModel()
+
+	tests := []struct {
+		name        string
+		merchant    string
+		description string
+		rejected    map[string]bool
+		category    string
+		source      string
+	}{
+		{
+			name:        "confident model",
+			merchant:    "Blue Bottle Coffee",
+			description: "Latte",
+			category:    "dining",
+			source:      SuggestionSourceModel,
+		},
+		{
+			name:        "unknown to the model falls back to rules",
+			merchant:    "Chevron",
+			description: "Pump 4",
+			category:    "auto",
+			source:      SuggestionSourceRule,
+		},
+		{
+			name:        "rejected category is not suggested",
+			merchant:    "Blue Bottle Coffee",
+			description: "Latte",
+			rejected:    map[string]bool{"dining": true},
+		},
+		{
+			name:     "nothing to go on",
+			merchant: "Acme Hardware",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := &ent.Transaction{ID: "t1", MerchantName: strPtr(tt.merchant), Description: strPtr(tt.description), Amount: 10}
+			suggestion := suggestCategory(model, categorizer, record, DefaultSuggestionConfidence, tt.rejected)
+			assert.Equal(t, tt.category, suggestion.Category)
+			assert.Equal(t, tt.source, suggestion.Source)
+			for _, score := range suggestion.Scores {
+				assert.False(t, tt.rejected[score.Category])
+			}
+		})
+	}
+
+	// Below the threshold the rules decide, but the model's confidence is
+	// still reported
+	record := &ent.Transaction{ID: "t2", MerchantName: strPtr("Shell"), Description: strPtr("Fuel")}
+	suggestion := suggestCategory(model, categorizer, record, 0.99, nil)
+	assert.Equal(t, "auto", suggestion.Category)
+	assert.Equal(t, SuggestionSourceRule, suggestion.Source)
+	assert.Positive(t, suggestion.Confidence)
+	assert.Equal(t, "transport", suggestion.Scores[0].Category)
+}
@@ -0,0 +1,330 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"clockzen-next/internal/application/integration"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/alert"
+	"clockzen-next/internal/ent/alertpreference"
+	"clockzen-next/internal/ent/attachmentblob"
+	"clockzen-next/internal/ent/attachmentlink"
+	"clockzen-next/internal/ent/budgetreallocation"
+	"clockzen-next/internal/ent/bulkoperation"
+	"clockzen-next/internal/ent/cardaccount"
+	"clockzen-next/internal/ent/categorizationrule"
+	"clockzen-next/internal/ent/categoryfeedback"
+	"clockzen-next/internal/ent/debt"
+	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/emailmessage"
+	"clockzen-next/internal/ent/emergencyfundsnapshot"
+	"clockzen-next/internal/ent/emergencyfundtarget"
+	"clockzen-next/internal/ent/goal"
+	"clockzen-next/internal/ent/googledriveconnection"
+	"clockzen-next/internal/ent/householdmember"
+	"clockzen-next/internal/ent/jobschedule"
+	"clockzen-next/internal/ent/jobschedulerun"
+	"clockzen-next/internal/ent/lineitem"
+	"clockzen-next/internal/ent/liquidaccount"
+	"clockzen-next/internal/ent/membership"
+	"clockzen-next/internal/ent/notification"
+	"clockzen-next/internal/ent/ocrfeedback"
+	"clockzen-next/internal/ent/organization"
+	"clockzen-next/internal/ent/pipelineconfig"
+	"clockzen-next/internal/ent/pipelinerule"
+	"clockzen-next/internal/ent/pipelineversion"
+	"clockzen-next/internal/ent/queuedjob"
+	"clockzen-next/internal/ent/receipt"
+	"clockzen-next/internal/ent/receiptevent"
+	"clockzen-next/internal/ent/roundingrule"
+	"clockzen-next/internal/ent/savedfilter"
+	"clockzen-next/internal/ent/transaction"
+	"clockzen-next/internal/ent/user"
+	"clockzen-next/internal/ent/webhookdelivery"
+	"clockzen-next/internal/ent/webhookendpoint"
+	"clockzen-next/internal/infrastructure/google"
+)
+
+// ErrSoleOwner is returned when deleting the only owner of an organization
+// that has other members
+var ErrSoleOwner = errors.New("user is the only owner of an organization with other members; transfer ownership first")
+
+// BlobStore deletes stored attachment content
+type BlobStore interface {
+	Delete(ctx context.Context, key string) error
+}
+
+// SetTokenStore sets the store decrypting connections' OAuth tokens, which
+// are revoked when their user is deleted
+func (s *Service) SetTokenStore(tokens *integration.TokenStore) {
+	s.tokens = tokens
+}
+
+// SetOAuthConfig sets the OAuth client tokens are revoked with
+func (s *Service) SetOAuthConfig(config *google.Config) {
+	s.oauthConfig = config
+}
+
+// SetBlobStore sets the store attachment content is purged from
+func (s *Service) SetBlobStore(blobs BlobStore) {
+	s.blobs = blobs
+}
+
+// deleteStep deletes the rows of one table
+type deleteStep struct {
+	table string
+	exec  func(ctx context.Context) (int, error)
+}
+
+// DeleteAccount permanently deletes a user and everything stored for them,
+// and records the deletion. Their connections' OAuth tokens are revoked
+// first, on a best-effort basis, and attachment content no other user's
+// messages reference is purged. Organizations the user is the only member
+// of are deleted with them, along with their data.
+func (s *Service) DeleteAccount(ctx context.Context, userID, requestedBy string) (*ent.AccountDeletion, error) {
+	if userID == "" || requestedBy == "" {
+		return nil, errUserIDRequired
+	}
+
+	orgIDs, err := s.organizationsDeletedWith(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	owners := append([]string{userID}, orgIDs...)
+
+	emailConns, err := s.entClient.EmailConnection.Query().
+		Where(emailconnection.Or(
+			emailconnection.UserID(userID),
+			emailconnection.OrganizationIDIn(orgIDs...),
+		)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying email connections: %w", err)
+	}
+	driveConns, err := s.entClient.GoogleDriveConnection.Query().
+		Where(googledriveconnection.Or(
+			googledriveconnection.UserID(userID),
+			googledriveconnection.OrganizationIDIn(orgIDs...),
+		)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying drive connections: %w", err)
+	}
+
+	// Tokens are revoked before the rows holding them are deleted, so a
+	// failed deletion leaves connections that need reconnecting rather than
+	// tokens nobody can revoke
+	revoked, failed := s.revokeTokens(ctx, emailConns, driveConns)
+
+	emailConnIDs := make([]string, len(emailConns))
+	for i, conn := range emailConns {
+		emailConnIDs[i] = conn.ID
+	}
+	driveConnIDs := make([]string, len(driveConns))
+	for i, conn := range driveConns {
+		driveConnIDs[i] = conn.ID
+	}
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	blobIDs, err := tx.AttachmentLink.Query().
+		Where(attachmentlink.ConnectionIDIn(emailConnIDs...)).
+		Unique(true).
+		Select(attachmentlink.FieldBlobID).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying attachment links: %w", err)
+	}
+
+	// Children are deleted before the rows they reference
+	steps := []deleteStep{
+		{"attachment_links", tx.AttachmentLink.Delete().Where(attachmentlink.ConnectionIDIn(emailConnIDs...)).Exec},
+		{"email_messages", tx.EmailMessage.Delete().Where(emailmessage.ConnectionIDIn(emailConnIDs...)).Exec},
+		{"email_connections", tx.EmailConnection.Delete().Where(emailconnection.IDIn(emailConnIDs...)).Exec},
+		{"google_drive_connections", tx.GoogleDriveConnection.Delete().Where(googledriveconnection.IDIn(driveConnIDs...)).Exec},
+		{"line_items", tx.LineItem.Delete().Where(lineitem.HasReceiptWith(receipt.UserIDIn(owners...))).Exec},
+		{"transactions", tx.Transaction.Delete().Where(transaction.UserIDIn(owners...)).Exec},
+		{"receipt_events", tx.ReceiptEvent.Delete().Where(receiptevent.UserIDIn(owners...)).Exec},
+		{"ocr_feedbacks", tx.OCRFeedback.Delete().Where(ocrfeedback.UserIDIn(owners...)).Exec},
+		{"receipts", tx.Receipt.Delete().Where(receipt.UserIDIn(owners...)).Exec},
+		{"bulk_operations", tx.BulkOperation.Delete().Where(bulkoperation.UserIDIn(owners...)).Exec},
+		{"categorization_rules", tx.CategorizationRule.Delete().Where(categorizationrule.UserIDIn(owners...)).Exec},
+		{"category_feedbacks", tx.CategoryFeedback.Delete().Where(categoryfeedback.UserIDIn(owners...)).Exec},
+		{"rounding_rules", tx.RoundingRule.Delete().Where(roundingrule.UserIDIn(owners...)).Exec},
+		{"saved_filters", tx.SavedFilter.Delete().Where(savedfilter.UserIDIn(owners...)).Exec},
+		{"pipeline_rules", tx.PipelineRule.Delete().Where(pipelinerule.Or(
+			pipelinerule.UserIDIn(owners...),
+			pipelinerule.HasConfigWith(pipelineconfig.UserIDIn(owners...)),
+		)).Exec},
+		{"pipeline_versions", tx.PipelineVersion.Delete().Where(pipelineversion.HasConfigWith(pipelineconfig.UserIDIn(owners...))).Exec},
+		{"pipeline_configs", tx.PipelineConfig.Delete().Where(pipelineconfig.UserIDIn(owners...)).Exec},
+		{"budget_reallocations", tx.BudgetReallocation.Delete().Where(budgetreallocation.Or(
+			budgetreallocation.UserID(userID),
+			budgetreallocation.OrganizationIDIn(orgIDs...),
+		)).Exec},
+		{"alert_preferences", tx.AlertPreference.Delete().Where(alertpreference.UserIDIn(owners...)).Exec},
+		{"alerts", tx.Alert.Delete().Where(alert.UserIDIn(owners...)).Exec},
+		{"notifications", tx.Notification.Delete().Where(notification.UserIDIn(owners...)).Exec},
+		{"goals", tx.Goal.Delete().Where(goal.UserIDIn(owners...)).Exec},
+		{"debts", tx.Debt.Delete().Where(debt.UserIDIn(owners...)).Exec},
+		{"liquid_accounts", tx.LiquidAccount.Delete().Where(liquidaccount.UserIDIn(owners...)).Exec},
+		{"card_accounts", tx.CardAccount.Delete().Where(cardaccount.UserIDIn(owners...)).Exec},
+		{"household_members", tx.HouseholdMember.Delete().Where(householdmember.UserIDIn(owners...)).Exec},
+		{"emergency_fund_snapshots", tx.EmergencyFundSnapshot.Delete().Where(emergencyfundsnapshot.UserIDIn(owners...)).Exec},
+		{"emergency_fund_targets", tx.EmergencyFundTarget.Delete().Where(emergencyfundtarget.UserIDIn(owners...)).Exec},
+		{"webhook_deliveries", tx.WebhookDelivery.Delete().Where(webhookdelivery.UserIDIn(owners...)).Exec},
+		{"webhook_endpoints", tx.WebhookEndpoint.Delete().Where(webhookendpoint.UserIDIn(owners...)).Exec},
+		{"job_schedule_runs", tx.JobScheduleRun.Delete().Where(jobschedulerun.UserIDIn(owners...)).Exec},
+		{"job_schedules", tx.JobSchedule.Delete().Where(jobschedule.UserIDIn(owners...)).Exec},
+		{"queued_jobs", tx.QueuedJob.Delete().Where(queuedjob.OwnerIDIn(owners...)).Exec},
+		{"memberships", tx.Membership.Delete().Where(membership.Or(
+			membership.UserID(userID),
+			membership.OrganizationIDIn(orgIDs...),
+		)).Exec},
+		{"organizations", tx.Organization.Delete().Where(organization.IDIn(orgIDs...)).Exec},
+		{"users", tx.User.Delete().Where(user.ID(userID)).Exec},
+	}
+
+	rowsDeleted := make(map[string]int)
+	for _, step := range steps {
+		n, err := step.exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("deleting %s: %w", step.table, err)
+		}
+		if n > 0 {
+			rowsDeleted[step.table] = n
+		}
+	}
+
+	// Attachment content is stored once however many messages include it,
+	// so only content no remaining message links to is purged
+	orphans, err := tx.AttachmentBlob.Query().
+		Where(attachmentblob.IDIn(blobIDs...), attachmentblob.Not(attachmentblob.HasLinks())).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying attachment blobs: %w", err)
+	}
+	orphanIDs := make([]string, len(orphans))
+	for i, blob := range orphans {
+		orphanIDs[i] = blob.ID
+	}
+	if n, err := tx.AttachmentBlob.Delete().Where(attachmentblob.IDIn(orphanIDs...)).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("deleting attachment_blobs: %w", err)
+	} else if n > 0 {
+		rowsDeleted["attachment_blobs"] = n
+	}
+
+	record, err := tx.AccountDeletion.Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetRequestedBy(requestedBy).
+		SetRowsDeleted(rowsDeleted).
+		SetTokensRevoked(revoked).
+		SetTokensFailed(failed).
+		SetBlobsPurged(len(orphans)).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("recording deletion: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing deletion: %w", err)
+	}
+
+	// Content is removed once nothing references it; a file left behind by
+	// a failure is unreachable and holds no account data on its own
+	if s.blobs != nil {
+		for _, blob := range orphans {
+			if err := s.blobs.Delete(ctx, blob.StorageKey); err != nil {
+				slog.Warn("failed to purge attachment content", "storage_key", blob.StorageKey, "error", err)
+			}
+		}
+	}
+	return record, nil
+}
+
+// organizationsDeletedWith returns the organizations the user is the only
+// member of, which are deleted with them. It returns ErrSoleOwner if the
+// user is the only owner of an organization others belong to.
+func (s *Service) organizationsDeletedWith(ctx context.Context, userID string) ([]string, error) {
+	memberships, err := s.entClient.Membership.Query().
+		Where(membership.UserID(userID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying memberships: %w", err)
+	}
+
+	var orgIDs []string
+	for _, m := range memberships {
+		others, err := s.entClient.Membership.Query().
+			Where(membership.OrganizationID(m.OrganizationID), membership.UserIDNEQ(userID)).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("querying members: %w", err)
+		}
+		if len(others) == 0 {
+			orgIDs = append(orgIDs, m.OrganizationID)
+			continue
+		}
+		if m.Role != membership.RoleOwner {
+			continue
+		}
+		otherOwner := false
+		for _, other := range others {
+			otherOwner = otherOwner || other.Role == membership.RoleOwner
+		}
+		if !otherOwner {
+			return nil, ErrSoleOwner
+		}
+	}
+	return orgIDs, nil
+}
+
+// revokeTokens revokes the connections' refresh tokens with Google and
+// returns how many were revoked and how many failed. Connections that are
+// already revoked or have no Google token are skipped.
+func (s *Service) revokeTokens(ctx context.Context, emailConns []*ent.EmailConnection, driveConns []*ent.GoogleDriveConnection) (revoked, failed int) {
+	if s.tokens == nil || s.oauthConfig == nil {
+		return 0, 0
+	}
+	oauthClient, err := google.NewClient(s.oauthConfig)
+	if err != nil {
+		slog.Warn("failed to create OAuth client; tokens not revoked", "error", err)
+		return 0, len(emailConns) + len(driveConns)
+	}
+
+	revoke := func(token *google.Token, err error) {
+		if err == nil && token.RefreshToken == "" {
+			return
+		}
+		if err == nil {
+			err = oauthClient.RevokeToken(ctx, token.RefreshToken)
+		}
+		if err != nil {
+			slog.Warn("failed to revoke OAuth token", "error", err)
+			failed++
+			return
+		}
+		revoked++
+	}
+
+	for _, conn := range emailConns {
+		if conn.Provider == emailconnection.ProviderGmail && conn.Status != emailconnection.StatusRevoked {
+			revoke(s.tokens.EmailToken(conn))
+		}
+	}
+	for _, conn := range driveConns {
+		if conn.Status != googledriveconnection.StatusRevoked {
+			revoke(s.tokens.DriveToken(conn))
+		}
+	}
+	return revoked, failed
+}
@@ -0,0 +1,174 @@
+package users
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/alert"
+	"clockzen-next/internal/ent/alertpreference"
+	"clockzen-next/internal/ent/attachmentlink"
+	"clockzen-next/internal/ent/budgetreallocation"
+	"clockzen-next/internal/ent/bulkoperation"
+	"clockzen-next/internal/ent/cardaccount"
+	"clockzen-next/internal/ent/categorizationrule"
+	"clockzen-next/internal/ent/categoryfeedback"
+	"clockzen-next/internal/ent/debt"
+	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/emaillabel"
+	"clockzen-next/internal/ent/emailmessage"
+	"clockzen-next/internal/ent/emailsync"
+	"clockzen-next/internal/ent/emergencyfundsnapshot"
+	"clockzen-next/internal/ent/emergencyfundtarget"
+	"clockzen-next/internal/ent/goal"
+	"clockzen-next/internal/ent/googledriveconnection"
+	"clockzen-next/internal/ent/googledrivefolder"
+	"clockzen-next/internal/ent/googledrivesync"
+	"clockzen-next/internal/ent/householdmember"
+	"clockzen-next/internal/ent/liquidaccount"
+	"clockzen-next/internal/ent/membership"
+	"clockzen-next/internal/ent/notification"
+	"clockzen-next/internal/ent/ocrfeedback"
+	"clockzen-next/internal/ent/pipelineconfig"
+	"clockzen-next/internal/ent/queuedjob"
+	"clockzen-next/internal/ent/receipt"
+	"clockzen-next/internal/ent/receiptevent"
+	"clockzen-next/internal/ent/roundingrule"
+	"clockzen-next/internal/ent/savedfilter"
+	"clockzen-next/internal/ent/transaction"
+	"clockzen-next/internal/ent/webhookdelivery"
+	"clockzen-next/internal/ent/webhookendpoint"
+)
+
+// ExportManifest describes an export; it is the archive's manifest.json
+type ExportManifest struct {
+	UserID     string         `json:"user_id"`
+	ExportedAt time.Time      `json:"exported_at"`
+	Files      map[string]int `json:"files"` // records per file
+}
+
+// exportFile is one JSON file of an export
+type exportFile struct {
+	name string
+	rows func(ctx context.Context) (any, int, error)
+}
+
+// rows adapts an ent query to an export file's rows
+func rows[T any](all func(ctx context.Context) ([]T, error)) func(ctx context.Context) (any, int, error) {
+	return func(ctx context.Context) (any, int, error) {
+		items, err := all(ctx)
+		if items == nil {
+			items = []T{}
+		}
+		return items, len(items), err
+	}
+}
+
+// Export writes a zip archive of everything stored for the user to w, one
+// JSON file per kind of record plus a manifest. It covers the user's own
+// data, not that of organizations they belong to. OAuth tokens, password
+// hashes and webhook secrets are left out.
+func (s *Service) Export(ctx context.Context, userID string, w io.Writer) (*ExportManifest, error) {
+	u, err := s.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	emailConnIDs, err := s.entClient.EmailConnection.Query().
+		Where(emailconnection.UserID(userID)).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying email connections: %w", err)
+	}
+	driveConnIDs, err := s.entClient.GoogleDriveConnection.Query().
+		Where(googledriveconnection.UserID(userID)).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying drive connections: %w", err)
+	}
+
+	c := s.entClient
+	files := []exportFile{
+		{"user.json", func(context.Context) (any, int, error) { return u, 1, nil }},
+		{"memberships.json", rows(c.Membership.Query().Where(membership.UserID(userID)).WithOrganization().All)},
+		{"email_connections.json", rows(c.EmailConnection.Query().Where(emailconnection.IDIn(emailConnIDs...)).All)},
+		{"email_labels.json", rows(c.EmailLabel.Query().Where(emaillabel.ConnectionIDIn(emailConnIDs...)).All)},
+		{"email_syncs.json", rows(c.EmailSync.Query().Where(emailsync.ConnectionIDIn(emailConnIDs...)).WithFailures().All)},
+		{"email_messages.json", rows(c.EmailMessage.Query().Where(emailmessage.ConnectionIDIn(emailConnIDs...)).Order(ent.Asc(emailmessage.FieldReceivedAt)).All)},
+		{"attachments.json", rows(c.AttachmentLink.Query().Where(attachmentlink.ConnectionIDIn(emailConnIDs...)).WithBlob().All)},
+		{"google_drive_connections.json", rows(c.GoogleDriveConnection.Query().Where(googledriveconnection.IDIn(driveConnIDs...)).All)},
+		{"google_drive_folders.json", rows(c.GoogleDriveFolder.Query().Where(googledrivefolder.ConnectionIDIn(driveConnIDs...)).All)},
+		{"google_drive_syncs.json", rows(c.GoogleDriveSync.Query().Where(googledrivesync.ConnectionIDIn(driveConnIDs...)).All)},
+		{"receipts.json", rows(c.Receipt.Query().Where(receipt.UserID(userID)).WithLineItems().All)},
+		{"receipt_events.json", rows(c.ReceiptEvent.Query().Where(receiptevent.UserID(userID)).All)},
+		{"ocr_feedback.json", rows(c.OCRFeedback.Query().Where(ocrfeedback.UserID(userID)).All)},
+		{"transactions.json", rows(c.Transaction.Query().Where(transaction.UserID(userID)).Order(ent.Asc(transaction.FieldTransactionDate)).All)},
+		{"bulk_operations.json", rows(c.BulkOperation.Query().Where(bulkoperation.UserID(userID)).All)},
+		{"categorization_rules.json", rows(c.CategorizationRule.Query().Where(categorizationrule.UserID(userID)).All)},
+		{"category_feedback.json", rows(c.CategoryFeedback.Query().Where(categoryfeedback.UserID(userID)).All)},
+		{"rounding_rules.json", rows(c.RoundingRule.Query().Where(roundingrule.UserID(userID)).All)},
+		{"saved_filters.json", rows(c.SavedFilter.Query().Where(savedfilter.UserID(userID)).All)},
+		{"pipelines.json", rows(c.PipelineConfig.Query().Where(pipelineconfig.UserID(userID)).WithRules().WithVersions().All)},
+		{"budget_reallocations.json", rows(c.BudgetReallocation.Query().Where(budgetreallocation.UserID(userID)).All)},
+		{"alert_preferences.json", rows(c.AlertPreference.Query().Where(alertpreference.UserID(userID)).All)},
+		{"alerts.json", rows(c.Alert.Query().Where(alert.UserID(userID)).All)},
+		{"notifications.json", rows(c.Notification.Query().Where(notification.UserID(userID)).All)},
+		{"goals.json", rows(c.Goal.Query().Where(goal.UserID(userID)).All)},
+		{"debts.json", rows(c.Debt.Query().Where(debt.UserID(userID)).All)},
+		{"liquid_accounts.json", rows(c.LiquidAccount.Query().Where(liquidaccount.UserID(userID)).All)},
+		{"card_accounts.json", rows(c.CardAccount.Query().Where(cardaccount.UserID(userID)).All)},
+		{"household_members.json", rows(c.HouseholdMember.Query().Where(householdmember.UserID(userID)).All)},
+		{"emergency_fund_snapshots.json", rows(c.EmergencyFundSnapshot.Query().Where(emergencyfundsnapshot.UserID(userID)).All)},
+		{"emergency_fund_targets.json", rows(c.EmergencyFundTarget.Query().Where(emergencyfundtarget.UserID(userID)).All)},
+		{"webhook_endpoints.json", rows(c.WebhookEndpoint.Query().Where(webhookendpoint.UserID(userID)).All)},
+		{"webhook_deliveries.json", rows(c.WebhookDelivery.Query().Where(webhookdelivery.UserID(userID)).All)},
+		// Analyses run as jobs; their inputs and results are kept on them
+		{"analyses.json", rows(c.QueuedJob.Query().Where(queuedjob.OwnerID(userID)).Order(ent.Asc(queuedjob.FieldCreatedAt)).All)},
+	}
+
+	manifest := &ExportManifest{
+		UserID:     userID,
+		ExportedAt: time.Now().UTC(),
+		Files:      make(map[string]int, len(files)),
+	}
+	archive := zip.NewWriter(w)
+	for _, file := range files {
+		data, count, err := file.rows(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("exporting %s: %w", file.name, err)
+		}
+		if err := writeJSONFile(archive, file.name, manifest.ExportedAt, data); err != nil {
+			return nil, err
+		}
+		manifest.Files[file.name] = count
+	}
+	if err := writeJSONFile(archive, "manifest.json", manifest.ExportedAt, manifest); err != nil {
+		return nil, err
+	}
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("writing export: %w", err)
+	}
+	return manifest, nil
+}
+
+// writeJSONFile adds v to the archive as an indented JSON file
+func writeJSONFile(archive *zip.Writer, name string, modified time.Time, v any) error {
+	f, err := archive.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modified,
+	})
+	if err != nil {
+		return fmt.Errorf("writing %s: %w", name, err)
+	}
+	encoder := json.NewEncoder(f)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return fmt.Errorf("writing %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package users
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/hook"
+	"clockzen-next/internal/ent/user"
+)
+
+// Ensure creates the account of a user authenticated by a token issued
+// elsewhere, if they don't have one yet
+func Ensure(ctx context.Context, client *ent.Client, userID string) error {
+	if userID == "" {
+		return errUserIDRequired
+	}
+	exists, err := client.User.Query().Where(user.ID(userID)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("querying user: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	// Conflicts are ignored rather than failed, which would abort the
+	// transaction the user is created in
+	err = client.User.Create().
+		SetID(userID).
+		OnConflictColumns(user.FieldID).
+		DoNothing().
+		Exec(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("creating user: %w", err)
+	}
+	return nil
+}
+
+// Provision registers hooks on client that create the accounts of users
+// authenticated by tokens issued elsewhere before the connections and
+// budget reallocations referencing them
+func Provision(client *ent.Client) {
+	provision := func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			value, ok := m.Field("user_id")
+			if userID, _ := value.(string); ok && userID != "" {
+				if mc, ok := m.(interface{ Client() *ent.Client }); ok {
+					if err := Ensure(ctx, mc.Client(), userID); err != nil {
+						return nil, err
+					}
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+
+	client.EmailConnection.Use(hook.On(provision, ent.OpCreate))
+	client.GoogleDriveConnection.Use(hook.On(provision, ent.OpCreate))
+	client.BudgetReallocation.Use(hook.On(provision, ent.OpCreate))
+}
@@ -0,0 +1,499 @@
+// Package users manages user accounts: registration and sign in with an
+// email and password or a Google account, profiles, and exporting and
+// deleting everything stored for a user. Users authenticated by tokens
+// issued elsewhere get an account the first time they are used, see
+// Provision.
+package users
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	netmail "net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"clockzen-next/internal/application/integration"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/user"
+	"clockzen-next/internal/infrastructure/google"
+	"clockzen-next/internal/infrastructure/mail"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Errors returned by the user service
+var (
+	ErrNotFound            = errors.New("user not found")
+	ErrInvalidCredentials  = errors.New("email or password is incorrect")
+	ErrEmailTaken          = errors.New("email is already registered")
+	ErrInvalidEmail        = errors.New("email must be a valid address")
+	ErrPasswordTooShort    = errors.New("password must be at least 8 characters")
+	ErrPasswordTooLong     = errors.New("password must be at most 72 bytes")
+	ErrNameTooLong         = errors.New("name must be at most 100 characters")
+	ErrGoogleAccountTaken  = errors.New("email is registered with another Google account")
+	ErrInvalidVerification = errors.New("verification token is invalid or expired")
+	ErrNothingToVerify     = errors.New("email is already verified")
+	errUserIDRequired      = errors.New("userID is required")
+)
+
+const (
+	// minPasswordLength is the shortest password accepted, in characters
+	minPasswordLength = 8
+	// maxPasswordLength is the longest password bcrypt hashes, in bytes
+	maxPasswordLength = 72
+	// maxNameLength is the longest display name accepted
+	maxNameLength = 100
+	// emailVerificationTTL is how long an emailed verification token
+	// verifies
+	emailVerificationTTL = 24 * time.Hour
+)
+
+// dummyHash is compared against when signing in to an unknown email, so the
+// response takes as long as for a wrong password
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("clockzen-dummy-password"), bcrypt.DefaultCost)
+
+// Registration is a new user signing up with an email and password
+type Registration struct {
+	Email    string
+	Password string
+	Name     string
+	Locale   string
+}
+
+// GoogleAccount is the Google account a user signs in with
+type GoogleAccount struct {
+	ID            string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Locale        string
+}
+
+// ProfileUpdate changes a user's profile; nil fields are left unchanged
+type ProfileUpdate struct {
+	Name   *string
+	Email  *string
+	Locale *string
+}
+
+// Service manages user accounts
+type Service struct {
+	entClient   *ent.Client
+	tokens      *integration.TokenStore // nil skips revoking tokens
+	oauthConfig *google.Config
+	blobs       BlobStore // nil leaves attachment content in place
+	sender      mail.Sender
+}
+
+// NewService creates a new user service
+func NewService(entClient *ent.Client) *Service {
+	return &Service{
+		entClient: entClient,
+		sender:    mail.LogSender{},
+	}
+}
+
+// SetMailSender sets the sender verification emails are sent with; they
+// are logged by default
+func (s *Service) SetMailSender(sender mail.Sender) {
+	s.sender = sender
+}
+
+// Register creates a user who signs in with an email and password
+func (s *Service) Register(ctx context.Context, input Registration) (*ent.User, error) {
+	email, err := normalizeEmail(input.Email)
+	if err != nil {
+		return nil, err
+	}
+	name, err := validateName(input.Name)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := hashPassword(input.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	create := s.entClient.User.Create().
+		SetID(uuid.New().String()).
+		SetEmail(email).
+		SetName(name).
+		SetPasswordHash(hash).
+		SetLastLoginAt(now)
+	if locale := strings.TrimSpace(input.Locale); locale != "" {
+		create.SetLocale(locale)
+	}
+
+	u, err := create.Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, ErrEmailTaken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("creating user: %w", err)
+	}
+	return u, nil
+}
+
+// Login returns the user with the email and password. Unknown emails, users
+// without a password and wrong passwords are all ErrInvalidCredentials.
+func (s *Service) Login(ctx context.Context, email, password string) (*ent.User, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	u, err := s.entClient.User.Query().
+		Where(user.Email(email)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("querying user: %w", err)
+	}
+
+	if u == nil || u.PasswordHash == nil {
+		_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+		return nil, ErrInvalidCredentials
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(*u.PasswordHash), []byte(password)); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	return s.touchLogin(ctx, u)
+}
+
+// LoginWithGoogle returns the user who signs in with the Google account. A
+// user registered with the account's email is linked to it if Google
+// verified the email; otherwise a new user is created. Linking a user who
+// never verified the email clears their password, since anyone could have
+// registered it before the address's owner signed in.
+func (s *Service) LoginWithGoogle(ctx context.Context, account GoogleAccount) (*ent.User, error) {
+	if account.ID == "" {
+		return nil, errors.New("google account ID is required")
+	}
+
+	u, err := s.entClient.User.Query().
+		Where(user.GoogleID(account.ID)).
+		Only(ctx)
+	if err == nil {
+		return s.touchLogin(ctx, u)
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("querying user: %w", err)
+	}
+
+	email := ""
+	if account.EmailVerified {
+		email, _ = normalizeEmail(account.Email)
+	}
+
+	if email != "" {
+		u, err := s.entClient.User.Query().
+			Where(user.Email(email)).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("querying user: %w", err)
+		}
+		if u != nil {
+			if u.GoogleID != nil {
+				return nil, ErrGoogleAccountTaken
+			}
+			update := u.Update().
+				SetGoogleID(account.ID).
+				SetEmailVerified(true).
+				SetLastLoginAt(time.Now())
+			if !u.EmailVerified {
+				update.ClearPasswordHash().
+					ClearPendingEmail().
+					ClearEmailVerificationHash().
+					ClearEmailVerificationExpiresAt()
+			}
+			if u.Name == "" {
+				update.SetName(truncateName(account.Name))
+			}
+			u, err = update.Save(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("linking google account: %w", err)
+			}
+			return u, nil
+		}
+	}
+
+	create := s.entClient.User.Create().
+		SetID(uuid.New().String()).
+		SetGoogleID(account.ID).
+		SetName(truncateName(account.Name)).
+		SetLastLoginAt(time.Now())
+	if email != "" {
+		create.SetEmail(email).SetEmailVerified(true)
+	}
+	if account.Locale != "" {
+		create.SetLocale(account.Locale)
+	}
+
+	u, err = create.Save(ctx)
+	if ent.IsConstraintError(err) {
+		// Another sign in with the same account won the race
+		return s.entClient.User.Query().Where(user.GoogleID(account.ID)).Only(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("creating user: %w", err)
+	}
+	return u, nil
+}
+
+// Get returns the user, creating the account of a user authenticated by a
+// token issued elsewhere
+func (s *Service) Get(ctx context.Context, userID string) (*ent.User, error) {
+	if err := Ensure(ctx, s.entClient, userID); err != nil {
+		return nil, err
+	}
+	u, err := s.entClient.User.Get(ctx, userID)
+	if ent.IsNotFound(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("querying user: %w", err)
+	}
+	return u, nil
+}
+
+// UpdateProfile changes the user's name, email or locale. A changed email
+// is pending until verified: a token is emailed to the new address, and
+// VerifyEmail replaces the user's email with it.
+func (s *Service) UpdateProfile(ctx context.Context, userID string, input ProfileUpdate) (*ent.User, error) {
+	u, err := s.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	update := u.Update()
+	var verify, token string
+	if input.Name != nil {
+		name, err := validateName(*input.Name)
+		if err != nil {
+			return nil, err
+		}
+		update.SetName(name)
+	}
+	if input.Email != nil {
+		email, err := normalizeEmail(*input.Email)
+		if err != nil {
+			return nil, err
+		}
+		if u.Email == nil || *u.Email != email {
+			taken, err := s.entClient.User.Query().Where(user.Email(email)).Exist(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("querying user: %w", err)
+			}
+			if taken {
+				return nil, ErrEmailTaken
+			}
+			if token, err = s.setVerification(update.Mutation()); err != nil {
+				return nil, err
+			}
+			update.SetPendingEmail(email)
+			verify = email
+		} else if u.PendingEmail != nil {
+			// Changing back to the current address cancels the change
+			update.ClearPendingEmail().
+				ClearEmailVerificationHash().
+				ClearEmailVerificationExpiresAt()
+		}
+	}
+	if input.Locale != nil {
+		if locale := strings.TrimSpace(*input.Locale); locale != "" {
+			update.SetLocale(locale)
+		} else {
+			update.ClearLocale()
+		}
+	}
+
+	u, err = update.Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, ErrEmailTaken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("updating user: %w", err)
+	}
+	if token != "" {
+		if err := s.sendVerification(ctx, verify, token); err != nil {
+			return nil, err
+		}
+	}
+	return u, nil
+}
+
+// SendEmailVerification emails a new verification token for the user's
+// pending email, or their current email if it isn't verified. Tokens sent
+// before no longer verify.
+func (s *Service) SendEmailVerification(ctx context.Context, userID string) error {
+	u, err := s.Get(ctx, userID)
+	if err != nil {
+		return err
+	}
+	var email string
+	switch {
+	case u.PendingEmail != nil:
+		email = *u.PendingEmail
+	case u.Email != nil && !u.EmailVerified:
+		email = *u.Email
+	default:
+		return ErrNothingToVerify
+	}
+
+	update := u.Update()
+	token, err := s.setVerification(update.Mutation())
+	if err != nil {
+		return err
+	}
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("updating user: %w", err)
+	}
+	return s.sendVerification(ctx, email, token)
+}
+
+// VerifyEmail verifies the user's email with a token emailed to it: the
+// pending email replaces the user's, or else their current email is
+// verified
+func (s *Service) VerifyEmail(ctx context.Context, userID, token string) (*ent.User, error) {
+	u, err := s.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if u.EmailVerificationHash == nil || u.EmailVerificationExpiresAt == nil ||
+		time.Now().After(*u.EmailVerificationExpiresAt) ||
+		subtle.ConstantTimeCompare([]byte(hashToken(token)), []byte(*u.EmailVerificationHash)) != 1 {
+		return nil, ErrInvalidVerification
+	}
+
+	update := u.Update().
+		SetEmailVerified(true).
+		ClearPendingEmail().
+		ClearEmailVerificationHash().
+		ClearEmailVerificationExpiresAt()
+	if u.PendingEmail != nil {
+		update.SetEmail(*u.PendingEmail)
+	}
+	u, err = update.Save(ctx)
+	if ent.IsConstraintError(err) {
+		// Another user verified the address first
+		return nil, ErrEmailTaken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("updating user: %w", err)
+	}
+	return u, nil
+}
+
+// setVerification sets a new email verification token on the user being
+// updated, and returns it
+func (s *Service) setVerification(m *ent.UserMutation) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating verification token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	m.SetEmailVerificationHash(hashToken(token))
+	m.SetEmailVerificationExpiresAt(time.Now().Add(emailVerificationTTL))
+	return token, nil
+}
+
+// sendVerification emails a verification token to the address it verifies
+func (s *Service) sendVerification(ctx context.Context, email, token string) error {
+	err := s.sender.Send(ctx, mail.Message{
+		To:      email,
+		Subject: "Verify your email address",
+		Text: "Use this token to verify " + email + " as your ClockZen email address:\n\n" + token +
+			"\n\nIt expires in 24 hours. If you didn't ask for this, you can ignore this email.",
+	})
+	if err != nil {
+		return fmt.Errorf("sending verification email: %w", err)
+	}
+	return nil
+}
+
+// hashToken returns the hex SHA-256 hash a verification token is stored as
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// ChangePassword sets the user's password. The current password must be
+// given unless the user has none, such as users who signed up with Google.
+func (s *Service) ChangePassword(ctx context.Context, userID, current, password string) error {
+	u, err := s.Get(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if u.PasswordHash != nil {
+		if err := bcrypt.CompareHashAndPassword([]byte(*u.PasswordHash), []byte(current)); err != nil {
+			return ErrInvalidCredentials
+		}
+	}
+
+	hash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+	if err := u.Update().SetPasswordHash(hash).Exec(ctx); err != nil {
+		return fmt.Errorf("updating password: %w", err)
+	}
+	return nil
+}
+
+// touchLogin records that the user signed in
+func (s *Service) touchLogin(ctx context.Context, u *ent.User) (*ent.User, error) {
+	u, err := u.Update().SetLastLoginAt(time.Now()).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("recording sign in: %w", err)
+	}
+	return u, nil
+}
+
+// normalizeEmail validates an email address and lowercases it
+func normalizeEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	addr, err := netmail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "", ErrInvalidEmail
+	}
+	return email, nil
+}
+
+// validateName trims a display name and checks its length
+func validateName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if utf8.RuneCountInString(name) > maxNameLength {
+		return "", ErrNameTooLong
+	}
+	return name, nil
+}
+
+// truncateName shortens a display name from another provider to the
+// longest accepted
+func truncateName(name string) string {
+	name = strings.TrimSpace(name)
+	if runes := []rune(name); len(runes) > maxNameLength {
+		return string(runes[:maxNameLength])
+	}
+	return name
+}
+
+// hashPassword checks a password's length and hashes it
+func hashPassword(password string) (string, error) {
+	if utf8.RuneCountInString(password) < minPasswordLength {
+		return "", ErrPasswordTooShort
+	}
+	if len(password) > maxPasswordLength {
+		return "", ErrPasswordTooLong
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("hashing password: %w", err)
+	}
+	return string(hash), nil
+}
@@ -0,0 +1,188 @@
+package users
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/enttest"
+	"clockzen-next/internal/infrastructure/mail"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestNormalizeEmail(t *testing.T) {
+	email, err := normalizeEmail("  Jane.Doe@Example.COM ")
+	require.NoError(t, err)
+	assert.Equal(t, "jane.doe@example.com", email)
+
+	for _, invalid := range []string{"", "jane", "Jane <jane@example.com>", "jane@example.com, joe@example.com"} {
+		_, err := normalizeEmail(invalid)
+		assert.ErrorIs(t, err, ErrInvalidEmail, invalid)
+	}
+}
+
+func TestHashPassword(t *testing.T) {
+	hash, err := hashPassword("correct horse")
+	require.NoError(t, err)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte("correct horse")))
+
+	_, err = hashPassword("short")
+	assert.ErrorIs(t, err, ErrPasswordTooShort)
+	_, err = hashPassword(strings.Repeat("a", 73))
+	assert.ErrorIs(t, err, ErrPasswordTooLong)
+}
+
+func TestValidateName(t *testing.T) {
+	name, err := validateName("  Jane Doe ")
+	require.NoError(t, err)
+	assert.Equal(t, "Jane Doe", name)
+
+	_, err = validateName(strings.Repeat("é", 101))
+	assert.ErrorIs(t, err, ErrNameTooLong)
+	assert.Equal(t, strings.Repeat("é", 100), truncateName(strings.Repeat("é", 101)))
+}
+
+func TestExportFiles(t *testing.T) {
+	none := rows(func(context.Context) ([]*ent.Receipt, error) { return nil, nil })
+	data, count, err := none(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	require.NoError(t, writeJSONFile(archive, "receipts.json", time.Now(), data))
+	require.NoError(t, archive.Close())
+
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Len(t, reader.File, 1)
+	f, err := reader.File[0].Open()
+	require.NoError(t, err)
+	defer f.Close()
+	content, err := io.ReadAll(f)
+	require.NoError(t, err)
+
+	// Empty files are arrays, not null
+	var receipts []json.RawMessage
+	require.NoError(t, json.Unmarshal(content, &receipts))
+	assert.NotNil(t, receipts)
+	assert.Equal(t, "receipts.json", reader.File[0].Name)
+}
+
+// mailbox records the messages sent to it
+type mailbox struct {
+	messages []mail.Message
+}
+
+func (m *mailbox) Send(_ context.Context, message mail.Message) error {
+	m.messages = append(m.messages, message)
+	return nil
+}
+
+// token returns the verification token of the last message
+func (m *mailbox) token(t *testing.T) string {
+	t.Helper()
+	require.NotEmpty(t, m.messages)
+	lines := strings.Split(m.messages[len(m.messages)-1].Text, "\n")
+	require.Greater(t, len(lines), 2)
+	return lines[2]
+}
+
+func newTestService(t *testing.T) (*Service, *mailbox) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	sent := &mailbox{}
+	s := NewService(client)
+	s.SetMailSender(sent)
+	return s, sent
+}
+
+func TestLoginWithGoogleLinksRegisteredUsers(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService(t)
+
+	// Someone registers the address before its owner signs in with Google
+	squatter, err := s.Register(ctx, Registration{Email: "jane@example.com", Password: "squatter password"})
+	require.NoError(t, err)
+	require.False(t, squatter.EmailVerified)
+
+	linked, err := s.LoginWithGoogle(ctx, GoogleAccount{ID: "g1", Email: "jane@example.com", EmailVerified: true, Name: "Jane"})
+	require.NoError(t, err)
+	assert.Equal(t, squatter.ID, linked.ID)
+	assert.True(t, linked.EmailVerified)
+	assert.Nil(t, linked.PasswordHash, "the unverified registration's password no longer signs in")
+	_, err = s.Login(ctx, "jane@example.com", "squatter password")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	// A verified user keeps their password when linking
+	owner, err := s.Register(ctx, Registration{Email: "joe@example.com", Password: "joe's password"})
+	require.NoError(t, err)
+	require.NoError(t, owner.Update().SetEmailVerified(true).Exec(ctx))
+	linked, err = s.LoginWithGoogle(ctx, GoogleAccount{ID: "g2", Email: "joe@example.com", EmailVerified: true})
+	require.NoError(t, err)
+	assert.NotNil(t, linked.PasswordHash)
+	_, err = s.Login(ctx, "joe@example.com", "joe's password")
+	assert.NoError(t, err)
+}
+
+func TestEmailChangeIsVerified(t *testing.T) {
+	ctx := context.Background()
+	s, sent := newTestService(t)
+
+	u, err := s.Register(ctx, Registration{Email: "jane@example.com", Password: "correct horse"})
+	require.NoError(t, err)
+	_, err = s.Register(ctx, Registration{Email: "joe@example.com", Password: "correct horse"})
+	require.NoError(t, err)
+
+	newEmail := "jane@work.example.com"
+	u, err = s.UpdateProfile(ctx, u.ID, ProfileUpdate{Email: &newEmail})
+	require.NoError(t, err)
+	assert.Equal(t, "jane@example.com", *u.Email, "the email isn't changed until verified")
+	assert.Equal(t, newEmail, *u.PendingEmail)
+	require.Len(t, sent.messages, 1)
+	assert.Equal(t, newEmail, sent.messages[0].To)
+	token := sent.token(t)
+
+	_, err = s.VerifyEmail(ctx, u.ID, "wrong token")
+	assert.ErrorIs(t, err, ErrInvalidVerification)
+
+	u, err = s.VerifyEmail(ctx, u.ID, token)
+	require.NoError(t, err)
+	assert.Equal(t, newEmail, *u.Email)
+	assert.True(t, u.EmailVerified)
+	assert.Nil(t, u.PendingEmail)
+	_, err = s.VerifyEmail(ctx, u.ID, token)
+	assert.ErrorIs(t, err, ErrInvalidVerification, "tokens verify once")
+	assert.ErrorIs(t, s.SendEmailVerification(ctx, u.ID), ErrNothingToVerify)
+
+	// Another user's address can't be claimed
+	taken := "joe@example.com"
+	_, err = s.UpdateProfile(ctx, u.ID, ProfileUpdate{Email: &taken})
+	assert.ErrorIs(t, err, ErrEmailTaken)
+
+	// Expired tokens don't verify
+	other := "jane@home.example.com"
+	_, err = s.UpdateProfile(ctx, u.ID, ProfileUpdate{Email: &other})
+	require.NoError(t, err)
+	token = sent.token(t)
+	require.NoError(t, s.entClient.User.UpdateOneID(u.ID).SetEmailVerificationExpiresAt(time.Now().Add(-time.Minute)).Exec(ctx))
+	_, err = s.VerifyEmail(ctx, u.ID, token)
+	assert.ErrorIs(t, err, ErrInvalidVerification)
+
+	// A resent token replaces it
+	require.NoError(t, s.SendEmailVerification(ctx, u.ID))
+	u, err = s.VerifyEmail(ctx, u.ID, sent.token(t))
+	require.NoError(t, err)
+	assert.Equal(t, other, *u.Email)
+}
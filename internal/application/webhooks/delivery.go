@@ -0,0 +1,272 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/webhookdelivery"
+)
+
+// Headers set on every delivery
+const (
+	HeaderSignature = "X-ClockZen-Signature"
+	HeaderEvent     = "X-ClockZen-Event"
+	HeaderDelivery  = "X-ClockZen-Delivery"
+)
+
+// maxDiscardedBody is how much of a response body is read, and
+// discarded, before the connection is closed
+const maxDiscardedBody = 4096
+
+// ErrInvalidSignature is returned by VerifySignature for a signature that
+// doesn't match the body, or is too old
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// DeliveryConfig holds configuration for posting deliveries
+type DeliveryConfig struct {
+	// MaxAttempts is how many times a delivery is attempted before it
+	// fails
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry; each retry after
+	// waits twice as long as the one before
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between retries
+	MaxBackoff time.Duration
+	// Timeout limits each attempt
+	Timeout time.Duration
+	// BatchSize is the number of due deliveries loaded per run
+	BatchSize int
+}
+
+// DefaultDeliveryConfig returns the default delivery configuration: 8
+// attempts over about 4 hours
+func DefaultDeliveryConfig() DeliveryConfig {
+	return DeliveryConfig{
+		MaxAttempts:    8,
+		InitialBackoff: time.Minute,
+		MaxBackoff:     2 * time.Hour,
+		Timeout:        10 * time.Second,
+		BatchSize:      100,
+	}
+}
+
+// RetryDelay returns how long to wait before retrying a delivery that has
+// failed attempts times
+func (c DeliveryConfig) RetryDelay(attempts int) time.Duration {
+	delay := c.InitialBackoff
+	for i := 1; i < attempts && delay < c.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > c.MaxBackoff {
+		delay = c.MaxBackoff
+	}
+	return delay
+}
+
+// Sign returns the signature header for a body posted at timestamp:
+// "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>">". Signing the
+// timestamp with the body lets receivers reject replayed deliveries.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	return "t=" + t + ",v1=" + signature(secret, t, body)
+}
+
+// signature returns the hex HMAC-SHA256 of "<t>.<body>"
+func signature(secret, t string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(t))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks a signature header made by Sign matches the body,
+// and was made no more than tolerance before now. Receivers in Go can use
+// it as is.
+func VerifySignature(secret, header string, body []byte, tolerance time.Duration, now time.Time) error {
+	var t, v1 string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			t = value
+		case "v1":
+			v1 = value
+		}
+	}
+	unix, err := strconv.ParseInt(t, 10, 64)
+	if err != nil || v1 == "" {
+		return fmt.Errorf("%w: malformed header", ErrInvalidSignature)
+	}
+	if now.Sub(time.Unix(unix, 0)) > tolerance {
+		return fmt.Errorf("%w: timestamp too old", ErrInvalidSignature)
+	}
+	if !hmac.Equal([]byte(v1), []byte(signature(secret, t, body))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// DeliverDue posts the deliveries due by now and returns how many
+// succeeded. Each is claimed before it is posted, so workers running
+// side by side don't post it twice. Failures are logged and retried.
+func (s *Service) DeliverDue(ctx context.Context, now time.Time) int {
+	due, err := s.entClient.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(s.config.BatchSize).
+		All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "querying due webhook deliveries", "error", err)
+		return 0
+	}
+
+	delivered := 0
+	for _, record := range due {
+		if ctx.Err() != nil {
+			break
+		}
+
+		// Push the next attempt past this one's timeout, so the delivery
+		// isn't picked up again while it is being posted
+		claimed, err := s.entClient.WebhookDelivery.Update().
+			Where(
+				webhookdelivery.ID(record.ID),
+				webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+				webhookdelivery.NextAttemptAtEQ(*record.NextAttemptAt),
+			).
+			SetNextAttemptAt(now.Add(s.config.Timeout + time.Minute)).
+			Save(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "claiming webhook delivery", "delivery_id", record.ID, "error", err)
+			continue
+		}
+		if claimed == 0 {
+			continue
+		}
+
+		record, err = s.deliver(ctx, record)
+		if err != nil {
+			slog.ErrorContext(ctx, "delivering webhook", "delivery_id", record.ID, "error", err)
+			continue
+		}
+		if record.Status == webhookdelivery.StatusSucceeded {
+			delivered++
+		}
+	}
+	return delivered
+}
+
+// deliver posts a claimed delivery to its endpoint. Deliveries to
+// endpoints that were disabled since they were queued fail without being
+// posted.
+func (s *Service) deliver(ctx context.Context, record *ent.WebhookDelivery) (*ent.WebhookDelivery, error) {
+	endpoint, err := s.entClient.WebhookEndpoint.Get(ctx, record.EndpointID)
+	if err != nil && !ent.IsNotFound(err) {
+		return record, fmt.Errorf("getting endpoint: %w", err)
+	}
+	if endpoint == nil || !endpoint.Enabled {
+		reason := "endpoint disabled"
+		if endpoint == nil {
+			reason = "endpoint deleted"
+		}
+		record, err = record.Update().
+			SetStatus(webhookdelivery.StatusFailed).
+			ClearNextAttemptAt().
+			SetError(reason).
+			Save(ctx)
+		if err != nil {
+			return record, fmt.Errorf("updating delivery: %w", err)
+		}
+		return record, nil
+	}
+	return s.attempt(ctx, endpoint, record, time.Now(), true)
+}
+
+// attempt posts a delivery to its endpoint and records the outcome. A
+// failed attempt is retried with backoff if retry is set and attempts
+// remain; otherwise the delivery fails.
+func (s *Service) attempt(ctx context.Context, endpoint *ent.WebhookEndpoint, record *ent.WebhookDelivery, now time.Time, retry bool) (*ent.WebhookDelivery, error) {
+	attempts := record.Attempts + 1
+	update := record.Update().
+		SetAttempts(attempts).
+		SetLastAttemptAt(now).
+		ClearResponseStatus()
+
+	status, err := s.post(ctx, endpoint, record, now)
+	if status != 0 {
+		update.SetResponseStatus(status)
+	}
+
+	switch {
+	case err == nil:
+		update.SetStatus(webhookdelivery.StatusSucceeded).
+			SetDeliveredAt(now).
+			ClearNextAttemptAt().
+			ClearError()
+	case retry && attempts < s.config.MaxAttempts:
+		update.SetNextAttemptAt(now.Add(s.config.RetryDelay(attempts))).
+			SetError(err.Error())
+	default:
+		update.SetStatus(webhookdelivery.StatusFailed).
+			ClearNextAttemptAt().
+			SetError(err.Error())
+	}
+
+	record, saveErr := update.Save(ctx)
+	if saveErr != nil {
+		return record, fmt.Errorf("updating delivery: %w", saveErr)
+	}
+	return record, nil
+}
+
+// post signs and posts a delivery's payload, and returns the response
+// status. Any status but 2xx fails. Response bodies are discarded rather
+// than logged, so deliveries can't be used to read pages back.
+func (s *Service) post(ctx context.Context, endpoint *ent.WebhookEndpoint, record *ent.WebhookDelivery, now time.Time) (int, error) {
+	secret, err := s.openSecret(endpoint)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(record.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "ClockZen-Webhooks/1.0")
+	req.Header.Set(HeaderSignature, Sign(secret, now, record.Payload))
+	req.Header.Set(HeaderEvent, record.Event)
+	req.Header.Set(HeaderDelivery, record.ID)
+
+	resp, err := s.client.Do(req)
+	if errors.Is(err, ErrBlockedAddress) {
+		return 0, ErrBlockedAddress
+	}
+	if err != nil {
+		return 0, fmt.Errorf("posting webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Read a little of the body so the connection can be reused
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxDiscardedBody))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
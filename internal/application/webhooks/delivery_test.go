@@ -0,0 +1,123 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/ent"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	body := []byte(`{"type":"sync.completed"}`)
+	header := Sign("whsec_test", now, body)
+	assert.Regexp(t, `^t=\d+,v1=[0-9a-f]{64}$`, header)
+
+	require.NoError(t, VerifySignature("whsec_test", header, body, 5*time.Minute, now.Add(time.Minute)))
+
+	err := VerifySignature("whsec_other", header, body, 5*time.Minute, now)
+	assert.True(t, errors.Is(err, ErrInvalidSignature))
+	err = VerifySignature("whsec_test", header, []byte(`{}`), 5*time.Minute, now)
+	assert.True(t, errors.Is(err, ErrInvalidSignature))
+	err = VerifySignature("whsec_test", header, body, 5*time.Minute, now.Add(time.Hour))
+	assert.True(t, errors.Is(err, ErrInvalidSignature))
+	err = VerifySignature("whsec_test", "garbage", body, 5*time.Minute, now)
+	assert.True(t, errors.Is(err, ErrInvalidSignature))
+}
+
+func TestRetryDelay(t *testing.T) {
+	config := DefaultDeliveryConfig()
+	assert.Equal(t, time.Minute, config.RetryDelay(1))
+	assert.Equal(t, 2*time.Minute, config.RetryDelay(2))
+	assert.Equal(t, 64*time.Minute, config.RetryDelay(7))
+	assert.Equal(t, 2*time.Hour, config.RetryDelay(8))
+	assert.Equal(t, 2*time.Hour, config.RetryDelay(30))
+}
+
+func TestPost(t *testing.T) {
+	now := time.Now()
+	payload := []byte(`{"id":"evt1","type":"receipt.extracted"}`)
+
+	var status int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, payload, body)
+		assert.Equal(t, "receipt.extracted", r.Header.Get(HeaderEvent))
+		assert.Equal(t, "d1", r.Header.Get(HeaderDelivery))
+		assert.NoError(t, VerifySignature("whsec_test", r.Header.Get(HeaderSignature), body, time.Minute, now))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	s := &Service{client: server.Client(), config: DefaultDeliveryConfig()}
+	endpoint := &ent.WebhookEndpoint{ID: "e1", URL: server.URL, Secret: "whsec_test", Enabled: true}
+	record := &ent.WebhookDelivery{ID: "d1", Event: "receipt.extracted", Payload: payload}
+
+	status = http.StatusAccepted
+	code, err := s.post(context.Background(), endpoint, record, now)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, code)
+
+	status = http.StatusServiceUnavailable
+	code, err = s.post(context.Background(), endpoint, record, now)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+}
+
+func TestDeliveryClient(t *testing.T) {
+	var posted int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		posted++
+		if r.URL.Path == "/moved" {
+			http.Redirect(w, r, "/internal", http.StatusTemporaryRedirect)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	endpoint := &ent.WebhookEndpoint{ID: "e1", URL: server.URL, Secret: "whsec_test", Enabled: true}
+	record := &ent.WebhookDelivery{ID: "d1", Event: "webhook.test", Payload: []byte(`{}`)}
+
+	t.Run("refuses private addresses when connecting", func(t *testing.T) {
+		s := &Service{client: NewDeliveryClient(time.Second), config: DefaultDeliveryConfig()}
+		code, err := s.post(context.Background(), endpoint, record, time.Now())
+		assert.ErrorIs(t, err, ErrBlockedAddress)
+		assert.Zero(t, code)
+		assert.Zero(t, posted)
+	})
+
+	t.Run("doesn't follow redirects", func(t *testing.T) {
+		client := NewDeliveryClient(time.Second)
+		client.Transport = server.Client().Transport
+		s := &Service{client: client, config: DefaultDeliveryConfig()}
+
+		moved := &ent.WebhookEndpoint{ID: "e1", URL: server.URL + "/moved", Secret: "whsec_test", Enabled: true}
+		code, err := s.post(context.Background(), moved, record, time.Now())
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusTemporaryRedirect, code)
+		assert.Equal(t, 1, posted)
+	})
+}
+
+func TestIsBlocked(t *testing.T) {
+	for _, addr := range []string{
+		"127.0.0.1", "::1", "10.1.2.3", "172.16.0.1", "192.168.1.1", "169.254.169.254",
+		"fd00:ec2::254", "fe80::1", "0.0.0.0", "100.64.0.1", "::ffff:127.0.0.1", "224.0.0.1",
+	} {
+		assert.True(t, isBlocked(netip.MustParseAddr(addr)), addr)
+	}
+	for _, addr := range []string{"93.184.216.34", "8.8.8.8", "2606:4700:4700::1111"} {
+		assert.False(t, isBlocked(netip.MustParseAddr(addr)), addr)
+	}
+}
@@ -0,0 +1,59 @@
+package webhooks
+
+import (
+	"context"
+	"time"
+)
+
+// EventType is a kind of event webhooks are posted for
+type EventType string
+
+const (
+	// EventSyncCompleted is posted when an email or Drive sync completes
+	EventSyncCompleted EventType = "sync.completed"
+	// EventReceiptExtracted is posted when a receipt's fields are parsed
+	EventReceiptExtracted EventType = "receipt.extracted"
+	// EventAnomalyDetected is posted when an alert is raised for unusual
+	// spending
+	EventAnomalyDetected EventType = "anomaly.detected"
+	// EventBudgetExceeded is posted when spending in a category goes over
+	// its monthly budget limit
+	EventBudgetExceeded EventType = "budget.exceeded"
+	// EventTest is posted by test deliveries only; endpoints can't
+	// subscribe to it
+	EventTest EventType = "webhook.test"
+)
+
+// Events are the event types endpoints can subscribe to
+var Events = []EventType{
+	EventSyncCompleted,
+	EventReceiptExtracted,
+	EventAnomalyDetected,
+	EventBudgetExceeded,
+}
+
+// isEvent reports whether name is an event type endpoints can subscribe to
+func isEvent(name string) bool {
+	for _, event := range Events {
+		if string(event) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Envelope is the body of every delivery: the event and its data
+type Envelope struct {
+	ID        string    `json:"id"`
+	Type      EventType `json:"type"`
+	UserID    string    `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+// Publisher queues an event for delivery to the user's webhook endpoints.
+// Components that raise events take a Publisher, so they don't depend on
+// how events are delivered.
+type Publisher interface {
+	Publish(ctx context.Context, userID string, event EventType, data any) error
+}
@@ -0,0 +1,104 @@
+package webhooks
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrBlockedAddress is returned for webhook URLs that resolve to loopback,
+// private, link-local or other addresses not reachable from the internet.
+// Deliveries are only posted to public addresses, so endpoints can't be
+// used to reach the servers' own network or cloud metadata services.
+var ErrBlockedAddress = errors.New("webhook address is not a public address")
+
+// blockedPrefixes are the non-public ranges not covered by the net/netip
+// address classes checked in isBlocked
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // "this" network
+	netip.MustParsePrefix("100.64.0.0/10"), // carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),  // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"), // benchmarking
+	netip.MustParsePrefix("240.0.0.0/4"),   // reserved, and broadcast
+	netip.MustParsePrefix("64:ff9b::/96"),  // NAT64 of IPv4 addresses
+	netip.MustParsePrefix("2001:db8::/32"), // documentation
+}
+
+// isBlocked reports whether deliveries to addr are refused. Cloud metadata
+// services, at 169.254.169.254 and fd00:ec2::254, are link-local and
+// private respectively.
+func isBlocked(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsUnspecified() || addr.IsLoopback() || addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() {
+		return true
+	}
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckHost rejects URL hosts that are, or are named as, blocked addresses.
+// Other names are checked when a delivery connects, against the addresses
+// they resolve to then. Other packages posting to user-given URLs check
+// them with it when they're saved.
+func CheckHost(host string) error {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrBlockedAddress
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && isBlocked(addr) {
+		return ErrBlockedAddress
+	}
+	return nil
+}
+
+// dialControl refuses connections to blocked addresses. It runs after the
+// host name is resolved, for each address connected to, so a name that
+// resolves to a public address when an endpoint is saved and a private one
+// when a delivery is posted is still refused.
+func dialControl(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, address)
+	}
+	if isBlocked(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, addrPort.Addr())
+	}
+	return nil
+}
+
+// NewDeliveryClient creates the client deliveries are posted with, and that
+// other packages post to user-given URLs with. It only connects to public
+// addresses, never through a proxy, and doesn't follow redirects: a
+// redirect response fails the attempt like any other non-2xx status.
+func NewDeliveryClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second,
+		Control:   dialControl,
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:           dialer.DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
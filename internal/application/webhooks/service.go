@@ -0,0 +1,465 @@
+// Package webhooks posts ClockZen events, such as completed syncs and
+// extracted receipts, to URLs users configure, so external systems can
+// react to them. Each event is stored as a delivery per subscribed
+// endpoint, signed with the endpoint's secret, and posted by the worker;
+// failed posts are retried with backoff, and every delivery's outcome is
+// kept as its log.
+package webhooks
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/webhookdelivery"
+	"clockzen-next/internal/ent/webhookendpoint"
+	"clockzen-next/internal/infrastructure/encryption"
+
+	"github.com/google/uuid"
+)
+
+// Errors returned by the webhook service
+var (
+	ErrEndpointNotFound    = errors.New("webhook endpoint not found")
+	ErrInvalidEndpoint     = errors.New("invalid webhook endpoint")
+	ErrInvalidStatus       = errors.New("status must be one of: pending, succeeded, failed")
+	ErrSecretDecryptFailed = errors.New("failed to decrypt webhook secret")
+)
+
+// Defaults for delivery lists
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 500
+)
+
+// secretPrefix marks webhook secrets, so they are recognizable in logs and
+// config
+const secretPrefix = "whsec_"
+
+// EndpointInput is a new webhook endpoint
+type EndpointInput struct {
+	URL         string
+	Events      []string
+	Description *string
+	// Enabled defaults to true
+	Enabled *bool
+}
+
+// EndpointUpdate changes the fields that are set. An empty description
+// clears it; a non-nil Events replaces the subscribed events.
+type EndpointUpdate struct {
+	URL         *string
+	Events      []string
+	Description *string
+	Enabled     *bool
+}
+
+// DeliveryFilter narrows a list of deliveries
+type DeliveryFilter struct {
+	Status string
+	Limit  int
+}
+
+// Service manages users' webhook endpoints, and queues and posts
+// deliveries to them
+type Service struct {
+	entClient *ent.Client
+	keyring   *encryption.Keyring
+	client    *http.Client
+	config    DeliveryConfig
+}
+
+// NewService creates a new webhook service. keyring may be nil to store
+// secrets in plaintext.
+func NewService(entClient *ent.Client, keyring *encryption.Keyring) *Service {
+	return &Service{
+		entClient: entClient,
+		keyring:   keyring,
+		client:    NewDeliveryClient(DefaultDeliveryConfig().Timeout),
+		config:    DefaultDeliveryConfig(),
+	}
+}
+
+// SetDeliveryConfig sets how deliveries are posted and retried
+func (s *Service) SetDeliveryConfig(config DeliveryConfig) {
+	s.config = config
+	s.client = NewDeliveryClient(config.Timeout)
+}
+
+// SetHTTPClient sets the client deliveries are posted with
+func (s *Service) SetHTTPClient(client *http.Client) {
+	s.client = client
+}
+
+// validateURL checks url is an http or https URL whose host isn't a
+// loopback or private address
+func validateURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return fmt.Errorf("%w: url must be an http or https URL", ErrInvalidEndpoint)
+	}
+	if err := CheckHost(u.Hostname()); err != nil {
+		return fmt.Errorf("%w: url must be a public address", ErrInvalidEndpoint)
+	}
+	return nil
+}
+
+// normalizeEvents checks events are ones endpoints can subscribe to, and
+// returns them without duplicates
+func normalizeEvents(events []string) ([]string, error) {
+	if len(events) == 0 {
+		return nil, fmt.Errorf("%w: at least one event is required", ErrInvalidEndpoint)
+	}
+	seen := make(map[string]bool, len(events))
+	normalized := make([]string, 0, len(events))
+	for _, event := range events {
+		event = strings.TrimSpace(event)
+		if !isEvent(event) {
+			return nil, fmt.Errorf("%w: events must be among: sync.completed, receipt.extracted, anomaly.detected, budget.exceeded", ErrInvalidEndpoint)
+		}
+		if seen[event] {
+			continue
+		}
+		seen[event] = true
+		normalized = append(normalized, event)
+	}
+	return normalized, nil
+}
+
+// newSecret generates a signing secret
+func newSecret() (string, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return "", fmt.Errorf("generating secret: %w", err)
+	}
+	return secretPrefix + base64.RawURLEncoding.EncodeToString(key), nil
+}
+
+// sealSecret returns a secret in its stored form
+func (s *Service) sealSecret(secret string) (string, error) {
+	if s.keyring == nil {
+		return secret, nil
+	}
+	sealed, err := s.keyring.Encrypt(secret)
+	if err != nil {
+		return "", fmt.Errorf("encrypting secret: %w", err)
+	}
+	return sealed, nil
+}
+
+// openSecret decrypts an endpoint's stored secret. Plaintext secrets
+// written before encryption was enabled are returned as is.
+func (s *Service) openSecret(endpoint *ent.WebhookEndpoint) (string, error) {
+	if s.keyring == nil {
+		if encryption.IsEncrypted(endpoint.Secret) {
+			return "", fmt.Errorf("%w: no encryption key configured", ErrSecretDecryptFailed)
+		}
+		return endpoint.Secret, nil
+	}
+	secret, err := s.keyring.Decrypt(endpoint.Secret)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrSecretDecryptFailed, err)
+	}
+	return secret, nil
+}
+
+// CreateEndpoint adds a webhook endpoint for the user, and returns it with
+// its signing secret. The secret isn't returned again; it can be rotated.
+func (s *Service) CreateEndpoint(ctx context.Context, userID string, input EndpointInput) (*ent.WebhookEndpoint, string, error) {
+	if userID == "" {
+		return nil, "", errors.New("userID is required")
+	}
+	input.URL = strings.TrimSpace(input.URL)
+	if err := validateURL(input.URL); err != nil {
+		return nil, "", err
+	}
+	events, err := normalizeEvents(input.Events)
+	if err != nil {
+		return nil, "", err
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return nil, "", err
+	}
+	sealed, err := s.sealSecret(secret)
+	if err != nil {
+		return nil, "", err
+	}
+
+	create := s.entClient.WebhookEndpoint.Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetURL(input.URL).
+		SetSecret(sealed).
+		SetEvents(events)
+	if input.Enabled != nil {
+		create.SetEnabled(*input.Enabled)
+	}
+	if input.Description != nil {
+		if description := strings.TrimSpace(*input.Description); description != "" {
+			create.SetDescription(description)
+		}
+	}
+
+	record, err := create.Save(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("creating endpoint: %w", err)
+	}
+	return record, secret, nil
+}
+
+// ListEndpoints returns the user's webhook endpoints, the oldest first
+func (s *Service) ListEndpoints(ctx context.Context, userID string) ([]*ent.WebhookEndpoint, error) {
+	records, err := s.entClient.WebhookEndpoint.Query().
+		Where(webhookendpoint.UserID(userID)).
+		Order(ent.Asc(webhookendpoint.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying endpoints: %w", err)
+	}
+	return records, nil
+}
+
+// GetEndpoint returns one of the user's webhook endpoints
+func (s *Service) GetEndpoint(ctx context.Context, userID, endpointID string) (*ent.WebhookEndpoint, error) {
+	record, err := s.entClient.WebhookEndpoint.Query().
+		Where(webhookendpoint.ID(endpointID), webhookendpoint.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrEndpointNotFound
+		}
+		return nil, fmt.Errorf("getting endpoint: %w", err)
+	}
+	return record, nil
+}
+
+// UpdateEndpoint changes one of the user's webhook endpoints
+func (s *Service) UpdateEndpoint(ctx context.Context, userID, endpointID string, update EndpointUpdate) (*ent.WebhookEndpoint, error) {
+	record, err := s.GetEndpoint(ctx, userID, endpointID)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := record.Update()
+	if update.URL != nil {
+		value := strings.TrimSpace(*update.URL)
+		if err := validateURL(value); err != nil {
+			return nil, err
+		}
+		builder.SetURL(value)
+	}
+	if update.Events != nil {
+		events, err := normalizeEvents(update.Events)
+		if err != nil {
+			return nil, err
+		}
+		builder.SetEvents(events)
+	}
+	if update.Description != nil {
+		if description := strings.TrimSpace(*update.Description); description != "" {
+			builder.SetDescription(description)
+		} else {
+			builder.ClearDescription()
+		}
+	}
+	if update.Enabled != nil {
+		builder.SetEnabled(*update.Enabled)
+	}
+
+	record, err = builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("updating endpoint: %w", err)
+	}
+	return record, nil
+}
+
+// RotateSecret replaces the signing secret of one of the user's webhook
+// endpoints, and returns the new one. Deliveries still pending are signed
+// with it when they are next attempted.
+func (s *Service) RotateSecret(ctx context.Context, userID, endpointID string) (*ent.WebhookEndpoint, string, error) {
+	record, err := s.GetEndpoint(ctx, userID, endpointID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return nil, "", err
+	}
+	sealed, err := s.sealSecret(secret)
+	if err != nil {
+		return nil, "", err
+	}
+
+	record, err = record.Update().
+		SetSecret(sealed).
+		Save(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("updating endpoint: %w", err)
+	}
+	return record, secret, nil
+}
+
+// DeleteEndpoint deletes one of the user's webhook endpoints and its
+// delivery log
+func (s *Service) DeleteEndpoint(ctx context.Context, userID, endpointID string) error {
+	if _, err := s.GetEndpoint(ctx, userID, endpointID); err != nil {
+		return err
+	}
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	if _, err := tx.WebhookDelivery.Delete().
+		Where(webhookdelivery.EndpointID(endpointID)).
+		Exec(ctx); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("deleting deliveries: %w", err)
+	}
+	if err := tx.WebhookEndpoint.DeleteOneID(endpointID).Exec(ctx); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("deleting endpoint: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// ListDeliveries returns the delivery log of one of the user's webhook
+// endpoints, the latest first
+func (s *Service) ListDeliveries(ctx context.Context, userID, endpointID string, filter DeliveryFilter) ([]*ent.WebhookDelivery, error) {
+	if _, err := s.GetEndpoint(ctx, userID, endpointID); err != nil {
+		return nil, err
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultListLimit
+	}
+	if filter.Limit > MaxListLimit {
+		filter.Limit = MaxListLimit
+	}
+
+	query := s.entClient.WebhookDelivery.Query().
+		Where(webhookdelivery.EndpointID(endpointID))
+	if filter.Status != "" {
+		if webhookdelivery.StatusValidator(webhookdelivery.Status(filter.Status)) != nil {
+			return nil, ErrInvalidStatus
+		}
+		query.Where(webhookdelivery.StatusEQ(webhookdelivery.Status(filter.Status)))
+	}
+
+	records, err := query.
+		Order(ent.Desc(webhookdelivery.FieldCreatedAt)).
+		Limit(filter.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying deliveries: %w", err)
+	}
+	return records, nil
+}
+
+// Publish queues an event for delivery to each of the user's enabled
+// endpoints subscribed to it. The deliveries share the event's ID, so
+// receivers can tell repeats apart; they are posted by DeliverDue.
+func (s *Service) Publish(ctx context.Context, userID string, event EventType, data any) error {
+	endpoints, err := s.entClient.WebhookEndpoint.Query().
+		Where(webhookendpoint.UserID(userID), webhookendpoint.Enabled(true)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("querying endpoints: %w", err)
+	}
+
+	var subscribed []*ent.WebhookEndpoint
+	for _, endpoint := range endpoints {
+		for _, name := range endpoint.Events {
+			if name == string(event) {
+				subscribed = append(subscribed, endpoint)
+				break
+			}
+		}
+	}
+	if len(subscribed) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	payload, eventID, err := encodeEvent(userID, event, data, now)
+	if err != nil {
+		return err
+	}
+
+	builders := make([]*ent.WebhookDeliveryCreate, len(subscribed))
+	for i, endpoint := range subscribed {
+		builders[i] = s.newDelivery(endpoint, event, eventID, payload, now).
+			SetNextAttemptAt(now)
+	}
+	if err := s.entClient.WebhookDelivery.CreateBulk(builders...).Exec(ctx); err != nil {
+		return fmt.Errorf("queueing deliveries: %w", err)
+	}
+	return nil
+}
+
+// SendTest posts a webhook.test event to one of the user's endpoints right
+// away, whether or not it is enabled, and returns the delivery with the
+// attempt's outcome. A failed test isn't retried.
+func (s *Service) SendTest(ctx context.Context, userID, endpointID string) (*ent.WebhookDelivery, error) {
+	endpoint, err := s.GetEndpoint(ctx, userID, endpointID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	payload, eventID, err := encodeEvent(userID, EventTest, map[string]any{
+		"endpoint_id": endpoint.ID,
+		"message":     "This is a test delivery from ClockZen.",
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := s.newDelivery(endpoint, EventTest, eventID, payload, now).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("saving delivery: %w", err)
+	}
+	return s.attempt(ctx, endpoint, record, time.Now(), false)
+}
+
+// newDelivery builds a pending delivery of an event to an endpoint
+func (s *Service) newDelivery(endpoint *ent.WebhookEndpoint, event EventType, eventID string, payload json.RawMessage, now time.Time) *ent.WebhookDeliveryCreate {
+	return s.entClient.WebhookDelivery.Create().
+		SetID(uuid.New().String()).
+		SetEndpointID(endpoint.ID).
+		SetUserID(endpoint.UserID).
+		SetEvent(string(event)).
+		SetEventID(eventID).
+		SetPayload(payload).
+		SetCreatedAt(now)
+}
+
+// encodeEvent wraps an event's data in its envelope, and returns the body
+// deliveries post and the event's ID
+func encodeEvent(userID string, event EventType, data any, now time.Time) (json.RawMessage, string, error) {
+	envelope := Envelope{
+		ID:        uuid.New().String(),
+		Type:      event,
+		UserID:    userID,
+		CreatedAt: now.UTC(),
+		Data:      data,
+	}
+	payload, err := json.Marshal(envelope)
+	if err != nil {
+		return nil, "", fmt.Errorf("encoding event: %w", err)
+	}
+	return payload, envelope.ID, nil
+}
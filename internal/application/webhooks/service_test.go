@@ -0,0 +1,85 @@
+package webhooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/infrastructure/encryption"
+)
+
+func TestNormalizeEvents(t *testing.T) {
+	events, err := normalizeEvents([]string{"sync.completed", " budget.exceeded ", "sync.completed"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sync.completed", "budget.exceeded"}, events)
+
+	_, err = normalizeEvents(nil)
+	assert.True(t, errors.Is(err, ErrInvalidEndpoint))
+	_, err = normalizeEvents([]string{"webhook.test"})
+	assert.True(t, errors.Is(err, ErrInvalidEndpoint))
+}
+
+func TestValidateURL(t *testing.T) {
+	assert.NoError(t, validateURL("https://example.com/hooks"))
+	assert.NoError(t, validateURL("http://93.184.216.34:8080/hooks"))
+	assert.Error(t, validateURL("ftp://example.com"))
+	assert.Error(t, validateURL("example.com/hooks"))
+
+	for _, raw := range []string{
+		"http://localhost:8080",
+		"http://api.localhost/hooks",
+		"http://127.0.0.1/hooks",
+		"http://[::1]:9000",
+		"http://10.0.0.5/hooks",
+		"http://169.254.169.254/latest/meta-data/",
+	} {
+		assert.ErrorIs(t, validateURL(raw), ErrInvalidEndpoint, raw)
+	}
+}
+
+func TestSecrets(t *testing.T) {
+	secret, err := newSecret()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(secret, secretPrefix))
+
+	keyring, err := encryption.NewKeyring([]encryption.Key{{ID: "k1", Material: bytes.Repeat([]byte{1}, 32)}})
+	require.NoError(t, err)
+	s := &Service{keyring: keyring}
+	sealed, err := s.sealSecret(secret)
+	require.NoError(t, err)
+	assert.True(t, encryption.IsEncrypted(sealed))
+
+	opened, err := s.openSecret(&ent.WebhookEndpoint{Secret: sealed})
+	require.NoError(t, err)
+	assert.Equal(t, secret, opened)
+
+	// Secrets stored before encryption was enabled still open
+	opened, err = s.openSecret(&ent.WebhookEndpoint{Secret: "whsec_plain"})
+	require.NoError(t, err)
+	assert.Equal(t, "whsec_plain", opened)
+
+	// Sealed secrets can't be opened without the keys
+	_, err = (&Service{}).openSecret(&ent.WebhookEndpoint{Secret: sealed})
+	assert.True(t, errors.Is(err, ErrSecretDecryptFailed))
+}
+
+func TestEncodeEvent(t *testing.T) {
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	payload, eventID, err := encodeEvent("u1", EventAnomalyDetected, map[string]any{"amount": 42.5}, now)
+	require.NoError(t, err)
+
+	var envelope map[string]any
+	require.NoError(t, json.Unmarshal(payload, &envelope))
+	assert.Equal(t, eventID, envelope["id"])
+	assert.Equal(t, "anomaly.detected", envelope["type"])
+	assert.Equal(t, "u1", envelope["user_id"])
+	assert.Equal(t, "2026-03-20T12:00:00Z", envelope["created_at"])
+	assert.Equal(t, map[string]any{"amount": 42.5}, envelope["data"])
+}
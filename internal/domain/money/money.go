@@ -0,0 +1,10 @@
+// Package money holds helpers for the dollar amounts the application works
+// with, which are kept as float64s.
+package money
+
+import "math"
+
+// RoundCents rounds an amount to whole cents, halves away from zero
+func RoundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
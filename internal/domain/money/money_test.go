@@ -0,0 +1,15 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundCents(t *testing.T) {
+	assert.Equal(t, 12.35, RoundCents(12.345))
+	assert.Equal(t, 12.34, RoundCents(12.3449))
+	assert.Equal(t, -12.35, RoundCents(-12.345))
+	assert.Equal(t, 0.3, RoundCents(0.1+0.2))
+	assert.Equal(t, 100.0, RoundCents(100))
+}
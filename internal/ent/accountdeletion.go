@@ -0,0 +1,177 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/accountdeletion"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AccountDeletion is the model entity for the AccountDeletion schema.
+type AccountDeletion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ID of the deleted user; kept after the user is gone, so not a foreign key
+	UserID string `json:"user_id,omitempty"`
+	// ID of the user who asked for the deletion, the user themselves or an admin
+	RequestedBy string `json:"requested_by,omitempty"`
+	// Rows deleted per table, e.g. {"receipts": 12}
+	RowsDeleted map[string]int `json:"rows_deleted,omitempty"`
+	// OAuth tokens of the user's connections revoked with Google
+	TokensRevoked int `json:"tokens_revoked,omitempty"`
+	// OAuth tokens Google could not revoke; they lapse when they expire
+	TokensFailed int `json:"tokens_failed,omitempty"`
+	// Attachment contents no other user's messages referenced, deleted with the user
+	BlobsPurged int `json:"blobs_purged,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccountDeletion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accountdeletion.FieldRowsDeleted:
+			values[i] = new([]byte)
+		case accountdeletion.FieldTokensRevoked, accountdeletion.FieldTokensFailed, accountdeletion.FieldBlobsPurged:
+			values[i] = new(sql.NullInt64)
+		case accountdeletion.FieldID, accountdeletion.FieldUserID, accountdeletion.FieldRequestedBy:
+			values[i] = new(sql.NullString)
+		case accountdeletion.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccountDeletion fields.
+func (_m *AccountDeletion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accountdeletion.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case accountdeletion.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case accountdeletion.FieldRequestedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_by", values[i])
+			} else if value.Valid {
+				_m.RequestedBy = value.String
+			}
+		case accountdeletion.FieldRowsDeleted:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rows_deleted", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RowsDeleted); err != nil {
+					return fmt.Errorf("unmarshal field rows_deleted: %w", err)
+				}
+			}
+		case accountdeletion.FieldTokensRevoked:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tokens_revoked", values[i])
+			} else if value.Valid {
+				_m.TokensRevoked = int(value.Int64)
+			}
+		case accountdeletion.FieldTokensFailed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tokens_failed", values[i])
+			} else if value.Valid {
+				_m.TokensFailed = int(value.Int64)
+			}
+		case accountdeletion.FieldBlobsPurged:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field blobs_purged", values[i])
+			} else if value.Valid {
+				_m.BlobsPurged = int(value.Int64)
+			}
+		case accountdeletion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AccountDeletion.
+// This includes values selected through modifiers, order, etc.
+func (_m *AccountDeletion) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AccountDeletion.
+// Note that you need to call AccountDeletion.Unwrap() before calling this method if this AccountDeletion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AccountDeletion) Update() *AccountDeletionUpdateOne {
+	return NewAccountDeletionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AccountDeletion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AccountDeletion) Unwrap() *AccountDeletion {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccountDeletion is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AccountDeletion) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccountDeletion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("requested_by=")
+	builder.WriteString(_m.RequestedBy)
+	builder.WriteString(", ")
+	builder.WriteString("rows_deleted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RowsDeleted))
+	builder.WriteString(", ")
+	builder.WriteString("tokens_revoked=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TokensRevoked))
+	builder.WriteString(", ")
+	builder.WriteString("tokens_failed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TokensFailed))
+	builder.WriteString(", ")
+	builder.WriteString("blobs_purged=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BlobsPurged))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AccountDeletions is a parsable slice of AccountDeletion.
+type AccountDeletions []*AccountDeletion
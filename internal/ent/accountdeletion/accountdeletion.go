@@ -0,0 +1,107 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accountdeletion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the accountdeletion type in the database.
+	Label = "account_deletion"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldRequestedBy holds the string denoting the requested_by field in the database.
+	FieldRequestedBy = "requested_by"
+	// FieldRowsDeleted holds the string denoting the rows_deleted field in the database.
+	FieldRowsDeleted = "rows_deleted"
+	// FieldTokensRevoked holds the string denoting the tokens_revoked field in the database.
+	FieldTokensRevoked = "tokens_revoked"
+	// FieldTokensFailed holds the string denoting the tokens_failed field in the database.
+	FieldTokensFailed = "tokens_failed"
+	// FieldBlobsPurged holds the string denoting the blobs_purged field in the database.
+	FieldBlobsPurged = "blobs_purged"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the accountdeletion in the database.
+	Table = "account_deletions"
+)
+
+// Columns holds all SQL columns for accountdeletion fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldRequestedBy,
+	FieldRowsDeleted,
+	FieldTokensRevoked,
+	FieldTokensFailed,
+	FieldBlobsPurged,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// RequestedByValidator is a validator for the "requested_by" field. It is called by the builders before save.
+	RequestedByValidator func(string) error
+	// DefaultTokensRevoked holds the default value on creation for the "tokens_revoked" field.
+	DefaultTokensRevoked int
+	// DefaultTokensFailed holds the default value on creation for the "tokens_failed" field.
+	DefaultTokensFailed int
+	// DefaultBlobsPurged holds the default value on creation for the "blobs_purged" field.
+	DefaultBlobsPurged int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the AccountDeletion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByRequestedBy orders the results by the requested_by field.
+func ByRequestedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestedBy, opts...).ToFunc()
+}
+
+// ByTokensRevoked orders the results by the tokens_revoked field.
+func ByTokensRevoked(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokensRevoked, opts...).ToFunc()
+}
+
+// ByTokensFailed orders the results by the tokens_failed field.
+func ByTokensFailed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokensFailed, opts...).ToFunc()
+}
+
+// ByBlobsPurged orders the results by the blobs_purged field.
+func ByBlobsPurged(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlobsPurged, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
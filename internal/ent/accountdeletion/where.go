@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accountdeletion
+
+import (
+	"clockzen-next/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldContainsFold(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldUserID, v))
+}
+
+// RequestedBy applies equality check predicate on the "requested_by" field. It's identical to RequestedByEQ.
+func RequestedBy(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldRequestedBy, v))
+}
+
+// TokensRevoked applies equality check predicate on the "tokens_revoked" field. It's identical to TokensRevokedEQ.
+func TokensRevoked(v int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldTokensRevoked, v))
+}
+
+// TokensFailed applies equality check predicate on the "tokens_failed" field. It's identical to TokensFailedEQ.
+func TokensFailed(v int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldTokensFailed, v))
+}
+
+// BlobsPurged applies equality check predicate on the "blobs_purged" field. It's identical to BlobsPurgedEQ.
+func BlobsPurged(v int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldBlobsPurged, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// RequestedByEQ applies the EQ predicate on the "requested_by" field.
+func RequestedByEQ(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldRequestedBy, v))
+}
+
+// RequestedByNEQ applies the NEQ predicate on the "requested_by" field.
+func RequestedByNEQ(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNEQ(FieldRequestedBy, v))
+}
+
+// RequestedByIn applies the In predicate on the "requested_by" field.
+func RequestedByIn(vs ...string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIn(FieldRequestedBy, vs...))
+}
+
+// RequestedByNotIn applies the NotIn predicate on the "requested_by" field.
+func RequestedByNotIn(vs ...string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotIn(FieldRequestedBy, vs...))
+}
+
+// RequestedByGT applies the GT predicate on the "requested_by" field.
+func RequestedByGT(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGT(FieldRequestedBy, v))
+}
+
+// RequestedByGTE applies the GTE predicate on the "requested_by" field.
+func RequestedByGTE(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGTE(FieldRequestedBy, v))
+}
+
+// RequestedByLT applies the LT predicate on the "requested_by" field.
+func RequestedByLT(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLT(FieldRequestedBy, v))
+}
+
+// RequestedByLTE applies the LTE predicate on the "requested_by" field.
+func RequestedByLTE(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLTE(FieldRequestedBy, v))
+}
+
+// RequestedByContains applies the Contains predicate on the "requested_by" field.
+func RequestedByContains(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldContains(FieldRequestedBy, v))
+}
+
+// RequestedByHasPrefix applies the HasPrefix predicate on the "requested_by" field.
+func RequestedByHasPrefix(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldHasPrefix(FieldRequestedBy, v))
+}
+
+// RequestedByHasSuffix applies the HasSuffix predicate on the "requested_by" field.
+func RequestedByHasSuffix(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldHasSuffix(FieldRequestedBy, v))
+}
+
+// RequestedByEqualFold applies the EqualFold predicate on the "requested_by" field.
+func RequestedByEqualFold(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEqualFold(FieldRequestedBy, v))
+}
+
+// RequestedByContainsFold applies the ContainsFold predicate on the "requested_by" field.
+func RequestedByContainsFold(v string) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldContainsFold(FieldRequestedBy, v))
+}
+
+// RowsDeletedIsNil applies the IsNil predicate on the "rows_deleted" field.
+func RowsDeletedIsNil() predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIsNull(FieldRowsDeleted))
+}
+
+// RowsDeletedNotNil applies the NotNil predicate on the "rows_deleted" field.
+func RowsDeletedNotNil() predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotNull(FieldRowsDeleted))
+}
+
+// TokensRevokedEQ applies the EQ predicate on the "tokens_revoked" field.
+func TokensRevokedEQ(v int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldTokensRevoked, v))
+}
+
+// TokensRevokedNEQ applies the NEQ predicate on the "tokens_revoked" field.
+func TokensRevokedNEQ(v int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNEQ(FieldTokensRevoked, v))
+}
+
+// TokensRevokedIn applies the In predicate on the "tokens_revoked" field.
+func TokensRevokedIn(vs ...int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIn(FieldTokensRevoked, vs...))
+}
+
+// TokensRevokedNotIn applies the NotIn predicate on the "tokens_revoked" field.
+func TokensRevokedNotIn(vs ...int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotIn(FieldTokensRevoked, vs...))
+}
+
+// TokensRevokedGT applies the GT predicate on the "tokens_revoked" field.
+func TokensRevokedGT(v int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGT(FieldTokensRevoked, v))
+}
+
+// TokensRevokedGTE applies the GTE predicate on the "tokens_revoked" field.
+func TokensRevokedGTE(v int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGTE(FieldTokensRevoked, v))
+}
+
+// TokensRevokedLT applies the LT predicate on the "tokens_revoked" field.
+func TokensRevokedLT(v int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLT(FieldTokensRevoked, v))
+}
+
+// TokensRevokedLTE applies the LTE predicate on the "tokens_revoked" field.
+func TokensRevokedLTE(v int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLTE(FieldTokensRevoked, v))
+}
+
+// TokensFailedEQ applies the EQ predicate on the "tokens_failed" field.
+func TokensFailedEQ(v int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldTokensFailed, v))
+}
+
+// TokensFailedNEQ applies the NEQ predicate on the "tokens_failed" field.
+func TokensFailedNEQ(v int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNEQ(FieldTokensFailed, v))
+}
+
+// TokensFailedIn applies the In predicate on the "tokens_failed" field.
+func TokensFailedIn(vs ...int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIn(FieldTokensFailed, vs...))
+}
+
+// TokensFailedNotIn applies the NotIn predicate on the "tokens_failed" field.
+func TokensFailedNotIn(vs ...int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotIn(FieldTokensFailed, vs...))
+}
+
+// TokensFailedGT applies the GT predicate on the "tokens_failed" field.
+func TokensFailedGT(v int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGT(FieldTokensFailed, v))
+}
+
+// TokensFailedGTE applies the GTE predicate on the "tokens_failed" field.
+func TokensFailedGTE(v int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGTE(FieldTokensFailed, v))
+}
+
+// TokensFailedLT applies the LT predicate on the "tokens_failed" field.
+func TokensFailedLT(v int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLT(FieldTokensFailed, v))
+}
+
+// TokensFailedLTE applies the LTE predicate on the "tokens_failed" field.
+func TokensFailedLTE(v int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLTE(FieldTokensFailed, v))
+}
+
+// BlobsPurgedEQ applies the EQ predicate on the "blobs_purged" field.
+func BlobsPurgedEQ(v int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldBlobsPurged, v))
+}
+
+// BlobsPurgedNEQ applies the NEQ predicate on the "blobs_purged" field.
+func BlobsPurgedNEQ(v int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNEQ(FieldBlobsPurged, v))
+}
+
+// BlobsPurgedIn applies the In predicate on the "blobs_purged" field.
+func BlobsPurgedIn(vs ...int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIn(FieldBlobsPurged, vs...))
+}
+
+// BlobsPurgedNotIn applies the NotIn predicate on the "blobs_purged" field.
+func BlobsPurgedNotIn(vs ...int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotIn(FieldBlobsPurged, vs...))
+}
+
+// BlobsPurgedGT applies the GT predicate on the "blobs_purged" field.
+func BlobsPurgedGT(v int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGT(FieldBlobsPurged, v))
+}
+
+// BlobsPurgedGTE applies the GTE predicate on the "blobs_purged" field.
+func BlobsPurgedGTE(v int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGTE(FieldBlobsPurged, v))
+}
+
+// BlobsPurgedLT applies the LT predicate on the "blobs_purged" field.
+func BlobsPurgedLT(v int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLT(FieldBlobsPurged, v))
+}
+
+// BlobsPurgedLTE applies the LTE predicate on the "blobs_purged" field.
+func BlobsPurgedLTE(v int) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLTE(FieldBlobsPurged, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AccountDeletion) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AccountDeletion) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AccountDeletion) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.NotPredicates(p))
+}
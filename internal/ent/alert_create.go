@@ -0,0 +1,933 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/alert"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AlertCreate is the builder for creating a Alert entity.
+type AlertCreate struct {
+	config
+	mutation *AlertMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *AlertCreate) SetUserID(v string) *AlertCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *AlertCreate) SetKind(v alert.Kind) *AlertCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetSeverity sets the "severity" field.
+func (_c *AlertCreate) SetSeverity(v alert.Severity) *AlertCreate {
+	_c.mutation.SetSeverity(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *AlertCreate) SetTitle(v string) *AlertCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *AlertCreate) SetMessage(v string) *AlertCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetDedupeKey sets the "dedupe_key" field.
+func (_c *AlertCreate) SetDedupeKey(v string) *AlertCreate {
+	_c.mutation.SetDedupeKey(v)
+	return _c
+}
+
+// SetData sets the "data" field.
+func (_c *AlertCreate) SetData(v map[string]interface{}) *AlertCreate {
+	_c.mutation.SetData(v)
+	return _c
+}
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (_c *AlertCreate) SetDeliveryStatus(v alert.DeliveryStatus) *AlertCreate {
+	_c.mutation.SetDeliveryStatus(v)
+	return _c
+}
+
+// SetNillableDeliveryStatus sets the "delivery_status" field if the given value is not nil.
+func (_c *AlertCreate) SetNillableDeliveryStatus(v *alert.DeliveryStatus) *AlertCreate {
+	if v != nil {
+		_c.SetDeliveryStatus(*v)
+	}
+	return _c
+}
+
+// SetDeliveryError sets the "delivery_error" field.
+func (_c *AlertCreate) SetDeliveryError(v string) *AlertCreate {
+	_c.mutation.SetDeliveryError(v)
+	return _c
+}
+
+// SetNillableDeliveryError sets the "delivery_error" field if the given value is not nil.
+func (_c *AlertCreate) SetNillableDeliveryError(v *string) *AlertCreate {
+	if v != nil {
+		_c.SetDeliveryError(*v)
+	}
+	return _c
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_c *AlertCreate) SetDeliveredAt(v time.Time) *AlertCreate {
+	_c.mutation.SetDeliveredAt(v)
+	return _c
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_c *AlertCreate) SetNillableDeliveredAt(v *time.Time) *AlertCreate {
+	if v != nil {
+		_c.SetDeliveredAt(*v)
+	}
+	return _c
+}
+
+// SetReadAt sets the "read_at" field.
+func (_c *AlertCreate) SetReadAt(v time.Time) *AlertCreate {
+	_c.mutation.SetReadAt(v)
+	return _c
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (_c *AlertCreate) SetNillableReadAt(v *time.Time) *AlertCreate {
+	if v != nil {
+		_c.SetReadAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AlertCreate) SetCreatedAt(v time.Time) *AlertCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AlertCreate) SetNillableCreatedAt(v *time.Time) *AlertCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AlertCreate) SetID(v string) *AlertCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the AlertMutation object of the builder.
+func (_c *AlertCreate) Mutation() *AlertMutation {
+	return _c.mutation
+}
+
+// Save creates the Alert in the database.
+func (_c *AlertCreate) Save(ctx context.Context) (*Alert, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AlertCreate) SaveX(ctx context.Context) *Alert {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AlertCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AlertCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AlertCreate) defaults() {
+	if _, ok := _c.mutation.DeliveryStatus(); !ok {
+		v := alert.DefaultDeliveryStatus
+		_c.mutation.SetDeliveryStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := alert.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AlertCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Alert.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := alert.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Alert.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Alert.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := alert.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Alert.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Severity(); !ok {
+		return &ValidationError{Name: "severity", err: errors.New(`ent: missing required field "Alert.severity"`)}
+	}
+	if v, ok := _c.mutation.Severity(); ok {
+		if err := alert.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`ent: validator failed for field "Alert.severity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Alert.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := alert.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Alert.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Message(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required field "Alert.message"`)}
+	}
+	if _, ok := _c.mutation.DedupeKey(); !ok {
+		return &ValidationError{Name: "dedupe_key", err: errors.New(`ent: missing required field "Alert.dedupe_key"`)}
+	}
+	if v, ok := _c.mutation.DedupeKey(); ok {
+		if err := alert.DedupeKeyValidator(v); err != nil {
+			return &ValidationError{Name: "dedupe_key", err: fmt.Errorf(`ent: validator failed for field "Alert.dedupe_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DeliveryStatus(); !ok {
+		return &ValidationError{Name: "delivery_status", err: errors.New(`ent: missing required field "Alert.delivery_status"`)}
+	}
+	if v, ok := _c.mutation.DeliveryStatus(); ok {
+		if err := alert.DeliveryStatusValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_status", err: fmt.Errorf(`ent: validator failed for field "Alert.delivery_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Alert.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *AlertCreate) sqlSave(ctx context.Context) (*Alert, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Alert.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AlertCreate) createSpec() (*Alert, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Alert{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(alert.Table, sqlgraph.NewFieldSpec(alert.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(alert.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(alert.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Severity(); ok {
+		_spec.SetField(alert.FieldSeverity, field.TypeEnum, value)
+		_node.Severity = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(alert.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(alert.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := _c.mutation.DedupeKey(); ok {
+		_spec.SetField(alert.FieldDedupeKey, field.TypeString, value)
+		_node.DedupeKey = value
+	}
+	if value, ok := _c.mutation.Data(); ok {
+		_spec.SetField(alert.FieldData, field.TypeJSON, value)
+		_node.Data = value
+	}
+	if value, ok := _c.mutation.DeliveryStatus(); ok {
+		_spec.SetField(alert.FieldDeliveryStatus, field.TypeEnum, value)
+		_node.DeliveryStatus = value
+	}
+	if value, ok := _c.mutation.DeliveryError(); ok {
+		_spec.SetField(alert.FieldDeliveryError, field.TypeString, value)
+		_node.DeliveryError = &value
+	}
+	if value, ok := _c.mutation.DeliveredAt(); ok {
+		_spec.SetField(alert.FieldDeliveredAt, field.TypeTime, value)
+		_node.DeliveredAt = &value
+	}
+	if value, ok := _c.mutation.ReadAt(); ok {
+		_spec.SetField(alert.FieldReadAt, field.TypeTime, value)
+		_node.ReadAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(alert.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Alert.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AlertUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AlertCreate) OnConflict(opts ...sql.ConflictOption) *AlertUpsertOne {
+	_c.conflict = opts
+	return &AlertUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Alert.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AlertCreate) OnConflictColumns(columns ...string) *AlertUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AlertUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AlertUpsertOne is the builder for "upsert"-ing
+	//  one Alert node.
+	AlertUpsertOne struct {
+		create *AlertCreate
+	}
+
+	// AlertUpsert is the "OnConflict" setter.
+	AlertUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (u *AlertUpsert) SetDeliveryStatus(v alert.DeliveryStatus) *AlertUpsert {
+	u.Set(alert.FieldDeliveryStatus, v)
+	return u
+}
+
+// UpdateDeliveryStatus sets the "delivery_status" field to the value that was provided on create.
+func (u *AlertUpsert) UpdateDeliveryStatus() *AlertUpsert {
+	u.SetExcluded(alert.FieldDeliveryStatus)
+	return u
+}
+
+// SetDeliveryError sets the "delivery_error" field.
+func (u *AlertUpsert) SetDeliveryError(v string) *AlertUpsert {
+	u.Set(alert.FieldDeliveryError, v)
+	return u
+}
+
+// UpdateDeliveryError sets the "delivery_error" field to the value that was provided on create.
+func (u *AlertUpsert) UpdateDeliveryError() *AlertUpsert {
+	u.SetExcluded(alert.FieldDeliveryError)
+	return u
+}
+
+// ClearDeliveryError clears the value of the "delivery_error" field.
+func (u *AlertUpsert) ClearDeliveryError() *AlertUpsert {
+	u.SetNull(alert.FieldDeliveryError)
+	return u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *AlertUpsert) SetDeliveredAt(v time.Time) *AlertUpsert {
+	u.Set(alert.FieldDeliveredAt, v)
+	return u
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *AlertUpsert) UpdateDeliveredAt() *AlertUpsert {
+	u.SetExcluded(alert.FieldDeliveredAt)
+	return u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *AlertUpsert) ClearDeliveredAt() *AlertUpsert {
+	u.SetNull(alert.FieldDeliveredAt)
+	return u
+}
+
+// SetReadAt sets the "read_at" field.
+func (u *AlertUpsert) SetReadAt(v time.Time) *AlertUpsert {
+	u.Set(alert.FieldReadAt, v)
+	return u
+}
+
+// UpdateReadAt sets the "read_at" field to the value that was provided on create.
+func (u *AlertUpsert) UpdateReadAt() *AlertUpsert {
+	u.SetExcluded(alert.FieldReadAt)
+	return u
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (u *AlertUpsert) ClearReadAt() *AlertUpsert {
+	u.SetNull(alert.FieldReadAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Alert.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(alert.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AlertUpsertOne) UpdateNewValues() *AlertUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(alert.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(alert.FieldUserID)
+		}
+		if _, exists := u.create.mutation.Kind(); exists {
+			s.SetIgnore(alert.FieldKind)
+		}
+		if _, exists := u.create.mutation.Severity(); exists {
+			s.SetIgnore(alert.FieldSeverity)
+		}
+		if _, exists := u.create.mutation.Title(); exists {
+			s.SetIgnore(alert.FieldTitle)
+		}
+		if _, exists := u.create.mutation.Message(); exists {
+			s.SetIgnore(alert.FieldMessage)
+		}
+		if _, exists := u.create.mutation.DedupeKey(); exists {
+			s.SetIgnore(alert.FieldDedupeKey)
+		}
+		if _, exists := u.create.mutation.Data(); exists {
+			s.SetIgnore(alert.FieldData)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(alert.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Alert.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AlertUpsertOne) Ignore() *AlertUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AlertUpsertOne) DoNothing() *AlertUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AlertCreate.OnConflict
+// documentation for more info.
+func (u *AlertUpsertOne) Update(set func(*AlertUpsert)) *AlertUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AlertUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (u *AlertUpsertOne) SetDeliveryStatus(v alert.DeliveryStatus) *AlertUpsertOne {
+	return u.Update(func(s *AlertUpsert) {
+		s.SetDeliveryStatus(v)
+	})
+}
+
+// UpdateDeliveryStatus sets the "delivery_status" field to the value that was provided on create.
+func (u *AlertUpsertOne) UpdateDeliveryStatus() *AlertUpsertOne {
+	return u.Update(func(s *AlertUpsert) {
+		s.UpdateDeliveryStatus()
+	})
+}
+
+// SetDeliveryError sets the "delivery_error" field.
+func (u *AlertUpsertOne) SetDeliveryError(v string) *AlertUpsertOne {
+	return u.Update(func(s *AlertUpsert) {
+		s.SetDeliveryError(v)
+	})
+}
+
+// UpdateDeliveryError sets the "delivery_error" field to the value that was provided on create.
+func (u *AlertUpsertOne) UpdateDeliveryError() *AlertUpsertOne {
+	return u.Update(func(s *AlertUpsert) {
+		s.UpdateDeliveryError()
+	})
+}
+
+// ClearDeliveryError clears the value of the "delivery_error" field.
+func (u *AlertUpsertOne) ClearDeliveryError() *AlertUpsertOne {
+	return u.Update(func(s *AlertUpsert) {
+		s.ClearDeliveryError()
+	})
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *AlertUpsertOne) SetDeliveredAt(v time.Time) *AlertUpsertOne {
+	return u.Update(func(s *AlertUpsert) {
+		s.SetDeliveredAt(v)
+	})
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *AlertUpsertOne) UpdateDeliveredAt() *AlertUpsertOne {
+	return u.Update(func(s *AlertUpsert) {
+		s.UpdateDeliveredAt()
+	})
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *AlertUpsertOne) ClearDeliveredAt() *AlertUpsertOne {
+	return u.Update(func(s *AlertUpsert) {
+		s.ClearDeliveredAt()
+	})
+}
+
+// SetReadAt sets the "read_at" field.
+func (u *AlertUpsertOne) SetReadAt(v time.Time) *AlertUpsertOne {
+	return u.Update(func(s *AlertUpsert) {
+		s.SetReadAt(v)
+	})
+}
+
+// UpdateReadAt sets the "read_at" field to the value that was provided on create.
+func (u *AlertUpsertOne) UpdateReadAt() *AlertUpsertOne {
+	return u.Update(func(s *AlertUpsert) {
+		s.UpdateReadAt()
+	})
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (u *AlertUpsertOne) ClearReadAt() *AlertUpsertOne {
+	return u.Update(func(s *AlertUpsert) {
+		s.ClearReadAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AlertUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AlertCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AlertUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AlertUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AlertUpsertOne.ID is not supported by MySQL driver. Use AlertUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AlertUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AlertCreateBulk is the builder for creating many Alert entities in bulk.
+type AlertCreateBulk struct {
+	config
+	err      error
+	builders []*AlertCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Alert entities in the database.
+func (_c *AlertCreateBulk) Save(ctx context.Context) ([]*Alert, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Alert, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AlertMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AlertCreateBulk) SaveX(ctx context.Context) []*Alert {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AlertCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AlertCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Alert.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AlertUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AlertCreateBulk) OnConflict(opts ...sql.ConflictOption) *AlertUpsertBulk {
+	_c.conflict = opts
+	return &AlertUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Alert.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AlertCreateBulk) OnConflictColumns(columns ...string) *AlertUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AlertUpsertBulk{
+		create: _c,
+	}
+}
+
+// AlertUpsertBulk is the builder for "upsert"-ing
+// a bulk of Alert nodes.
+type AlertUpsertBulk struct {
+	create *AlertCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Alert.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(alert.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AlertUpsertBulk) UpdateNewValues() *AlertUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(alert.FieldID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(alert.FieldUserID)
+			}
+			if _, exists := b.mutation.Kind(); exists {
+				s.SetIgnore(alert.FieldKind)
+			}
+			if _, exists := b.mutation.Severity(); exists {
+				s.SetIgnore(alert.FieldSeverity)
+			}
+			if _, exists := b.mutation.Title(); exists {
+				s.SetIgnore(alert.FieldTitle)
+			}
+			if _, exists := b.mutation.Message(); exists {
+				s.SetIgnore(alert.FieldMessage)
+			}
+			if _, exists := b.mutation.DedupeKey(); exists {
+				s.SetIgnore(alert.FieldDedupeKey)
+			}
+			if _, exists := b.mutation.Data(); exists {
+				s.SetIgnore(alert.FieldData)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(alert.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Alert.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AlertUpsertBulk) Ignore() *AlertUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AlertUpsertBulk) DoNothing() *AlertUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AlertCreateBulk.OnConflict
+// documentation for more info.
+func (u *AlertUpsertBulk) Update(set func(*AlertUpsert)) *AlertUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AlertUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (u *AlertUpsertBulk) SetDeliveryStatus(v alert.DeliveryStatus) *AlertUpsertBulk {
+	return u.Update(func(s *AlertUpsert) {
+		s.SetDeliveryStatus(v)
+	})
+}
+
+// UpdateDeliveryStatus sets the "delivery_status" field to the value that was provided on create.
+func (u *AlertUpsertBulk) UpdateDeliveryStatus() *AlertUpsertBulk {
+	return u.Update(func(s *AlertUpsert) {
+		s.UpdateDeliveryStatus()
+	})
+}
+
+// SetDeliveryError sets the "delivery_error" field.
+func (u *AlertUpsertBulk) SetDeliveryError(v string) *AlertUpsertBulk {
+	return u.Update(func(s *AlertUpsert) {
+		s.SetDeliveryError(v)
+	})
+}
+
+// UpdateDeliveryError sets the "delivery_error" field to the value that was provided on create.
+func (u *AlertUpsertBulk) UpdateDeliveryError() *AlertUpsertBulk {
+	return u.Update(func(s *AlertUpsert) {
+		s.UpdateDeliveryError()
+	})
+}
+
+// ClearDeliveryError clears the value of the "delivery_error" field.
+func (u *AlertUpsertBulk) ClearDeliveryError() *AlertUpsertBulk {
+	return u.Update(func(s *AlertUpsert) {
+		s.ClearDeliveryError()
+	})
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *AlertUpsertBulk) SetDeliveredAt(v time.Time) *AlertUpsertBulk {
+	return u.Update(func(s *AlertUpsert) {
+		s.SetDeliveredAt(v)
+	})
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *AlertUpsertBulk) UpdateDeliveredAt() *AlertUpsertBulk {
+	return u.Update(func(s *AlertUpsert) {
+		s.UpdateDeliveredAt()
+	})
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *AlertUpsertBulk) ClearDeliveredAt() *AlertUpsertBulk {
+	return u.Update(func(s *AlertUpsert) {
+		s.ClearDeliveredAt()
+	})
+}
+
+// SetReadAt sets the "read_at" field.
+func (u *AlertUpsertBulk) SetReadAt(v time.Time) *AlertUpsertBulk {
+	return u.Update(func(s *AlertUpsert) {
+		s.SetReadAt(v)
+	})
+}
+
+// UpdateReadAt sets the "read_at" field to the value that was provided on create.
+func (u *AlertUpsertBulk) UpdateReadAt() *AlertUpsertBulk {
+	return u.Update(func(s *AlertUpsert) {
+		s.UpdateReadAt()
+	})
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (u *AlertUpsertBulk) ClearReadAt() *AlertUpsertBulk {
+	return u.Update(func(s *AlertUpsert) {
+		s.ClearReadAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AlertUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AlertCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AlertCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AlertUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
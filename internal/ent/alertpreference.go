@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/alertpreference"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AlertPreference is the model entity for the AlertPreference schema.
+type AlertPreference struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ID of the user the preferences belong to
+	UserID string `json:"user_id,omitempty"`
+	// Whether spending anomalies raise alerts
+	AnomalyAlerts bool `json:"anomaly_alerts,omitempty"`
+	// Whether budget overruns raise alerts
+	BudgetAlerts bool `json:"budget_alerts,omitempty"`
+	// Monthly spending limit per spending category, e.g. {"dining": 300}
+	BudgetLimits map[string]float64 `json:"budget_limits,omitempty"`
+	// Share of a limit spent that raises a warning before the overrun itself, e.g. 80
+	BudgetThresholdPercent float64 `json:"budget_threshold_percent,omitempty"`
+	// Least severe alert delivered; less severe ones are only listed
+	MinSeverity alertpreference.MinSeverity `json:"min_severity,omitempty"`
+	// Address alerts are emailed to
+	Email *string `json:"email,omitempty"`
+	// URL alerts are posted to as JSON
+	WebhookURL *string `json:"webhook_url,omitempty"`
+	// Device token alerts are pushed to
+	PushToken *string `json:"push_token,omitempty"`
+	// Whether alerts are delivered as they are raised, or batched into a digest
+	Digest alertpreference.Digest `json:"digest,omitempty"`
+	// Whether a summary of the week's syncs, receipts and spending is emailed every week
+	WeeklySummary bool `json:"weekly_summary,omitempty"`
+	// Whether the monthly spending report is emailed at the end of each month
+	MonthlyReport bool `json:"monthly_report,omitempty"`
+	// When the last digest was delivered
+	LastDigestAt *time.Time `json:"last_digest_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AlertPreference) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case alertpreference.FieldBudgetLimits:
+			values[i] = new([]byte)
+		case alertpreference.FieldAnomalyAlerts, alertpreference.FieldBudgetAlerts, alertpreference.FieldWeeklySummary, alertpreference.FieldMonthlyReport:
+			values[i] = new(sql.NullBool)
+		case alertpreference.FieldBudgetThresholdPercent:
+			values[i] = new(sql.NullFloat64)
+		case alertpreference.FieldID, alertpreference.FieldUserID, alertpreference.FieldMinSeverity, alertpreference.FieldEmail, alertpreference.FieldWebhookURL, alertpreference.FieldPushToken, alertpreference.FieldDigest:
+			values[i] = new(sql.NullString)
+		case alertpreference.FieldLastDigestAt, alertpreference.FieldCreatedAt, alertpreference.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AlertPreference fields.
+func (_m *AlertPreference) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case alertpreference.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case alertpreference.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case alertpreference.FieldAnomalyAlerts:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field anomaly_alerts", values[i])
+			} else if value.Valid {
+				_m.AnomalyAlerts = value.Bool
+			}
+		case alertpreference.FieldBudgetAlerts:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field budget_alerts", values[i])
+			} else if value.Valid {
+				_m.BudgetAlerts = value.Bool
+			}
+		case alertpreference.FieldBudgetLimits:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field budget_limits", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.BudgetLimits); err != nil {
+					return fmt.Errorf("unmarshal field budget_limits: %w", err)
+				}
+			}
+		case alertpreference.FieldBudgetThresholdPercent:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field budget_threshold_percent", values[i])
+			} else if value.Valid {
+				_m.BudgetThresholdPercent = value.Float64
+			}
+		case alertpreference.FieldMinSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field min_severity", values[i])
+			} else if value.Valid {
+				_m.MinSeverity = alertpreference.MinSeverity(value.String)
+			}
+		case alertpreference.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				_m.Email = new(string)
+				*_m.Email = value.String
+			}
+		case alertpreference.FieldWebhookURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field webhook_url", values[i])
+			} else if value.Valid {
+				_m.WebhookURL = new(string)
+				*_m.WebhookURL = value.String
+			}
+		case alertpreference.FieldPushToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field push_token", values[i])
+			} else if value.Valid {
+				_m.PushToken = new(string)
+				*_m.PushToken = value.String
+			}
+		case alertpreference.FieldDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field digest", values[i])
+			} else if value.Valid {
+				_m.Digest = alertpreference.Digest(value.String)
+			}
+		case alertpreference.FieldWeeklySummary:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field weekly_summary", values[i])
+			} else if value.Valid {
+				_m.WeeklySummary = value.Bool
+			}
+		case alertpreference.FieldMonthlyReport:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field monthly_report", values[i])
+			} else if value.Valid {
+				_m.MonthlyReport = value.Bool
+			}
+		case alertpreference.FieldLastDigestAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_digest_at", values[i])
+			} else if value.Valid {
+				_m.LastDigestAt = new(time.Time)
+				*_m.LastDigestAt = value.Time
+			}
+		case alertpreference.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case alertpreference.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AlertPreference.
+// This includes values selected through modifiers, order, etc.
+func (_m *AlertPreference) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AlertPreference.
+// Note that you need to call AlertPreference.Unwrap() before calling this method if this AlertPreference
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AlertPreference) Update() *AlertPreferenceUpdateOne {
+	return NewAlertPreferenceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AlertPreference entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AlertPreference) Unwrap() *AlertPreference {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AlertPreference is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AlertPreference) String() string {
+	var builder strings.Builder
+	builder.WriteString("AlertPreference(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("anomaly_alerts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AnomalyAlerts))
+	builder.WriteString(", ")
+	builder.WriteString("budget_alerts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BudgetAlerts))
+	builder.WriteString(", ")
+	builder.WriteString("budget_limits=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BudgetLimits))
+	builder.WriteString(", ")
+	builder.WriteString("budget_threshold_percent=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BudgetThresholdPercent))
+	builder.WriteString(", ")
+	builder.WriteString("min_severity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinSeverity))
+	builder.WriteString(", ")
+	if v := _m.Email; v != nil {
+		builder.WriteString("email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.WebhookURL; v != nil {
+		builder.WriteString("webhook_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.PushToken; v != nil {
+		builder.WriteString("push_token=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("digest=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Digest))
+	builder.WriteString(", ")
+	builder.WriteString("weekly_summary=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WeeklySummary))
+	builder.WriteString(", ")
+	builder.WriteString("monthly_report=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MonthlyReport))
+	builder.WriteString(", ")
+	if v := _m.LastDigestAt; v != nil {
+		builder.WriteString("last_digest_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AlertPreferences is a parsable slice of AlertPreference.
+type AlertPreferences []*AlertPreference
@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package alertpreference
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the alertpreference type in the database.
+	Label = "alert_preference"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldAnomalyAlerts holds the string denoting the anomaly_alerts field in the database.
+	FieldAnomalyAlerts = "anomaly_alerts"
+	// FieldBudgetAlerts holds the string denoting the budget_alerts field in the database.
+	FieldBudgetAlerts = "budget_alerts"
+	// FieldBudgetLimits holds the string denoting the budget_limits field in the database.
+	FieldBudgetLimits = "budget_limits"
+	// FieldBudgetThresholdPercent holds the string denoting the budget_threshold_percent field in the database.
+	FieldBudgetThresholdPercent = "budget_threshold_percent"
+	// FieldMinSeverity holds the string denoting the min_severity field in the database.
+	FieldMinSeverity = "min_severity"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldWebhookURL holds the string denoting the webhook_url field in the database.
+	FieldWebhookURL = "webhook_url"
+	// FieldPushToken holds the string denoting the push_token field in the database.
+	FieldPushToken = "push_token"
+	// FieldDigest holds the string denoting the digest field in the database.
+	FieldDigest = "digest"
+	// FieldWeeklySummary holds the string denoting the weekly_summary field in the database.
+	FieldWeeklySummary = "weekly_summary"
+	// FieldMonthlyReport holds the string denoting the monthly_report field in the database.
+	FieldMonthlyReport = "monthly_report"
+	// FieldLastDigestAt holds the string denoting the last_digest_at field in the database.
+	FieldLastDigestAt = "last_digest_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the alertpreference in the database.
+	Table = "alert_preferences"
+)
+
+// Columns holds all SQL columns for alertpreference fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldAnomalyAlerts,
+	FieldBudgetAlerts,
+	FieldBudgetLimits,
+	FieldBudgetThresholdPercent,
+	FieldMinSeverity,
+	FieldEmail,
+	FieldWebhookURL,
+	FieldPushToken,
+	FieldDigest,
+	FieldWeeklySummary,
+	FieldMonthlyReport,
+	FieldLastDigestAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// DefaultAnomalyAlerts holds the default value on creation for the "anomaly_alerts" field.
+	DefaultAnomalyAlerts bool
+	// DefaultBudgetAlerts holds the default value on creation for the "budget_alerts" field.
+	DefaultBudgetAlerts bool
+	// DefaultBudgetThresholdPercent holds the default value on creation for the "budget_threshold_percent" field.
+	DefaultBudgetThresholdPercent float64
+	// BudgetThresholdPercentValidator is a validator for the "budget_threshold_percent" field. It is called by the builders before save.
+	BudgetThresholdPercentValidator func(float64) error
+	// DefaultWeeklySummary holds the default value on creation for the "weekly_summary" field.
+	DefaultWeeklySummary bool
+	// DefaultMonthlyReport holds the default value on creation for the "monthly_report" field.
+	DefaultMonthlyReport bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// MinSeverity defines the type for the "min_severity" enum field.
+type MinSeverity string
+
+// MinSeverityMedium is the default value of the MinSeverity enum.
+const DefaultMinSeverity = MinSeverityMedium
+
+// MinSeverity values.
+const (
+	MinSeverityLow    MinSeverity = "low"
+	MinSeverityMedium MinSeverity = "medium"
+	MinSeverityHigh   MinSeverity = "high"
+)
+
+func (ms MinSeverity) String() string {
+	return string(ms)
+}
+
+// MinSeverityValidator is a validator for the "min_severity" field enum values. It is called by the builders before save.
+func MinSeverityValidator(ms MinSeverity) error {
+	switch ms {
+	case MinSeverityLow, MinSeverityMedium, MinSeverityHigh:
+		return nil
+	default:
+		return fmt.Errorf("alertpreference: invalid enum value for min_severity field: %q", ms)
+	}
+}
+
+// Digest defines the type for the "digest" enum field.
+type Digest string
+
+// DigestDaily is the default value of the Digest enum.
+const DefaultDigest = DigestDaily
+
+// Digest values.
+const (
+	DigestImmediate Digest = "immediate"
+	DigestHourly    Digest = "hourly"
+	DigestDaily     Digest = "daily"
+)
+
+func (d Digest) String() string {
+	return string(d)
+}
+
+// DigestValidator is a validator for the "digest" field enum values. It is called by the builders before save.
+func DigestValidator(d Digest) error {
+	switch d {
+	case DigestImmediate, DigestHourly, DigestDaily:
+		return nil
+	default:
+		return fmt.Errorf("alertpreference: invalid enum value for digest field: %q", d)
+	}
+}
+
+// OrderOption defines the ordering options for the AlertPreference queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByAnomalyAlerts orders the results by the anomaly_alerts field.
+func ByAnomalyAlerts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnomalyAlerts, opts...).ToFunc()
+}
+
+// ByBudgetAlerts orders the results by the budget_alerts field.
+func ByBudgetAlerts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBudgetAlerts, opts...).ToFunc()
+}
+
+// ByBudgetThresholdPercent orders the results by the budget_threshold_percent field.
+func ByBudgetThresholdPercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBudgetThresholdPercent, opts...).ToFunc()
+}
+
+// ByMinSeverity orders the results by the min_severity field.
+func ByMinSeverity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinSeverity, opts...).ToFunc()
+}
+
+// ByEmail orders the results by the email field.
+func ByEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmail, opts...).ToFunc()
+}
+
+// ByWebhookURL orders the results by the webhook_url field.
+func ByWebhookURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWebhookURL, opts...).ToFunc()
+}
+
+// ByPushToken orders the results by the push_token field.
+func ByPushToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPushToken, opts...).ToFunc()
+}
+
+// ByDigest orders the results by the digest field.
+func ByDigest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDigest, opts...).ToFunc()
+}
+
+// ByWeeklySummary orders the results by the weekly_summary field.
+func ByWeeklySummary(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWeeklySummary, opts...).ToFunc()
+}
+
+// ByMonthlyReport orders the results by the monthly_report field.
+func ByMonthlyReport(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonthlyReport, opts...).ToFunc()
+}
+
+// ByLastDigestAt orders the results by the last_digest_at field.
+func ByLastDigestAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastDigestAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
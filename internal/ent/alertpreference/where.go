@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package alertpreference
+
+import (
+	"clockzen-next/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldContainsFold(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldUserID, v))
+}
+
+// AnomalyAlerts applies equality check predicate on the "anomaly_alerts" field. It's identical to AnomalyAlertsEQ.
+func AnomalyAlerts(v bool) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldAnomalyAlerts, v))
+}
+
+// BudgetAlerts applies equality check predicate on the "budget_alerts" field. It's identical to BudgetAlertsEQ.
+func BudgetAlerts(v bool) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldBudgetAlerts, v))
+}
+
+// BudgetThresholdPercent applies equality check predicate on the "budget_threshold_percent" field. It's identical to BudgetThresholdPercentEQ.
+func BudgetThresholdPercent(v float64) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldBudgetThresholdPercent, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldEmail, v))
+}
+
+// WebhookURL applies equality check predicate on the "webhook_url" field. It's identical to WebhookURLEQ.
+func WebhookURL(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldWebhookURL, v))
+}
+
+// PushToken applies equality check predicate on the "push_token" field. It's identical to PushTokenEQ.
+func PushToken(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldPushToken, v))
+}
+
+// WeeklySummary applies equality check predicate on the "weekly_summary" field. It's identical to WeeklySummaryEQ.
+func WeeklySummary(v bool) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldWeeklySummary, v))
+}
+
+// MonthlyReport applies equality check predicate on the "monthly_report" field. It's identical to MonthlyReportEQ.
+func MonthlyReport(v bool) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldMonthlyReport, v))
+}
+
+// LastDigestAt applies equality check predicate on the "last_digest_at" field. It's identical to LastDigestAtEQ.
+func LastDigestAt(v time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldLastDigestAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// AnomalyAlertsEQ applies the EQ predicate on the "anomaly_alerts" field.
+func AnomalyAlertsEQ(v bool) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldAnomalyAlerts, v))
+}
+
+// AnomalyAlertsNEQ applies the NEQ predicate on the "anomaly_alerts" field.
+func AnomalyAlertsNEQ(v bool) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNEQ(FieldAnomalyAlerts, v))
+}
+
+// BudgetAlertsEQ applies the EQ predicate on the "budget_alerts" field.
+func BudgetAlertsEQ(v bool) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldBudgetAlerts, v))
+}
+
+// BudgetAlertsNEQ applies the NEQ predicate on the "budget_alerts" field.
+func BudgetAlertsNEQ(v bool) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNEQ(FieldBudgetAlerts, v))
+}
+
+// BudgetLimitsIsNil applies the IsNil predicate on the "budget_limits" field.
+func BudgetLimitsIsNil() predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldIsNull(FieldBudgetLimits))
+}
+
+// BudgetLimitsNotNil applies the NotNil predicate on the "budget_limits" field.
+func BudgetLimitsNotNil() predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNotNull(FieldBudgetLimits))
+}
+
+// BudgetThresholdPercentEQ applies the EQ predicate on the "budget_threshold_percent" field.
+func BudgetThresholdPercentEQ(v float64) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldBudgetThresholdPercent, v))
+}
+
+// BudgetThresholdPercentNEQ applies the NEQ predicate on the "budget_threshold_percent" field.
+func BudgetThresholdPercentNEQ(v float64) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNEQ(FieldBudgetThresholdPercent, v))
+}
+
+// BudgetThresholdPercentIn applies the In predicate on the "budget_threshold_percent" field.
+func BudgetThresholdPercentIn(vs ...float64) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldIn(FieldBudgetThresholdPercent, vs...))
+}
+
+// BudgetThresholdPercentNotIn applies the NotIn predicate on the "budget_threshold_percent" field.
+func BudgetThresholdPercentNotIn(vs ...float64) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNotIn(FieldBudgetThresholdPercent, vs...))
+}
+
+// BudgetThresholdPercentGT applies the GT predicate on the "budget_threshold_percent" field.
+func BudgetThresholdPercentGT(v float64) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldGT(FieldBudgetThresholdPercent, v))
+}
+
+// BudgetThresholdPercentGTE applies the GTE predicate on the "budget_threshold_percent" field.
+func BudgetThresholdPercentGTE(v float64) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldGTE(FieldBudgetThresholdPercent, v))
+}
+
+// BudgetThresholdPercentLT applies the LT predicate on the "budget_threshold_percent" field.
+func BudgetThresholdPercentLT(v float64) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldLT(FieldBudgetThresholdPercent, v))
+}
+
+// BudgetThresholdPercentLTE applies the LTE predicate on the "budget_threshold_percent" field.
+func BudgetThresholdPercentLTE(v float64) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldLTE(FieldBudgetThresholdPercent, v))
+}
+
+// MinSeverityEQ applies the EQ predicate on the "min_severity" field.
+func MinSeverityEQ(v MinSeverity) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldMinSeverity, v))
+}
+
+// MinSeverityNEQ applies the NEQ predicate on the "min_severity" field.
+func MinSeverityNEQ(v MinSeverity) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNEQ(FieldMinSeverity, v))
+}
+
+// MinSeverityIn applies the In predicate on the "min_severity" field.
+func MinSeverityIn(vs ...MinSeverity) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldIn(FieldMinSeverity, vs...))
+}
+
+// MinSeverityNotIn applies the NotIn predicate on the "min_severity" field.
+func MinSeverityNotIn(vs ...MinSeverity) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNotIn(FieldMinSeverity, vs...))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailIsNil applies the IsNil predicate on the "email" field.
+func EmailIsNil() predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldIsNull(FieldEmail))
+}
+
+// EmailNotNil applies the NotNil predicate on the "email" field.
+func EmailNotNil() predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNotNull(FieldEmail))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// WebhookURLEQ applies the EQ predicate on the "webhook_url" field.
+func WebhookURLEQ(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldWebhookURL, v))
+}
+
+// WebhookURLNEQ applies the NEQ predicate on the "webhook_url" field.
+func WebhookURLNEQ(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNEQ(FieldWebhookURL, v))
+}
+
+// WebhookURLIn applies the In predicate on the "webhook_url" field.
+func WebhookURLIn(vs ...string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldIn(FieldWebhookURL, vs...))
+}
+
+// WebhookURLNotIn applies the NotIn predicate on the "webhook_url" field.
+func WebhookURLNotIn(vs ...string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNotIn(FieldWebhookURL, vs...))
+}
+
+// WebhookURLGT applies the GT predicate on the "webhook_url" field.
+func WebhookURLGT(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldGT(FieldWebhookURL, v))
+}
+
+// WebhookURLGTE applies the GTE predicate on the "webhook_url" field.
+func WebhookURLGTE(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldGTE(FieldWebhookURL, v))
+}
+
+// WebhookURLLT applies the LT predicate on the "webhook_url" field.
+func WebhookURLLT(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldLT(FieldWebhookURL, v))
+}
+
+// WebhookURLLTE applies the LTE predicate on the "webhook_url" field.
+func WebhookURLLTE(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldLTE(FieldWebhookURL, v))
+}
+
+// WebhookURLContains applies the Contains predicate on the "webhook_url" field.
+func WebhookURLContains(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldContains(FieldWebhookURL, v))
+}
+
+// WebhookURLHasPrefix applies the HasPrefix predicate on the "webhook_url" field.
+func WebhookURLHasPrefix(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldHasPrefix(FieldWebhookURL, v))
+}
+
+// WebhookURLHasSuffix applies the HasSuffix predicate on the "webhook_url" field.
+func WebhookURLHasSuffix(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldHasSuffix(FieldWebhookURL, v))
+}
+
+// WebhookURLIsNil applies the IsNil predicate on the "webhook_url" field.
+func WebhookURLIsNil() predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldIsNull(FieldWebhookURL))
+}
+
+// WebhookURLNotNil applies the NotNil predicate on the "webhook_url" field.
+func WebhookURLNotNil() predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNotNull(FieldWebhookURL))
+}
+
+// WebhookURLEqualFold applies the EqualFold predicate on the "webhook_url" field.
+func WebhookURLEqualFold(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEqualFold(FieldWebhookURL, v))
+}
+
+// WebhookURLContainsFold applies the ContainsFold predicate on the "webhook_url" field.
+func WebhookURLContainsFold(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldContainsFold(FieldWebhookURL, v))
+}
+
+// PushTokenEQ applies the EQ predicate on the "push_token" field.
+func PushTokenEQ(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldPushToken, v))
+}
+
+// PushTokenNEQ applies the NEQ predicate on the "push_token" field.
+func PushTokenNEQ(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNEQ(FieldPushToken, v))
+}
+
+// PushTokenIn applies the In predicate on the "push_token" field.
+func PushTokenIn(vs ...string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldIn(FieldPushToken, vs...))
+}
+
+// PushTokenNotIn applies the NotIn predicate on the "push_token" field.
+func PushTokenNotIn(vs ...string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNotIn(FieldPushToken, vs...))
+}
+
+// PushTokenGT applies the GT predicate on the "push_token" field.
+func PushTokenGT(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldGT(FieldPushToken, v))
+}
+
+// PushTokenGTE applies the GTE predicate on the "push_token" field.
+func PushTokenGTE(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldGTE(FieldPushToken, v))
+}
+
+// PushTokenLT applies the LT predicate on the "push_token" field.
+func PushTokenLT(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldLT(FieldPushToken, v))
+}
+
+// PushTokenLTE applies the LTE predicate on the "push_token" field.
+func PushTokenLTE(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldLTE(FieldPushToken, v))
+}
+
+// PushTokenContains applies the Contains predicate on the "push_token" field.
+func PushTokenContains(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldContains(FieldPushToken, v))
+}
+
+// PushTokenHasPrefix applies the HasPrefix predicate on the "push_token" field.
+func PushTokenHasPrefix(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldHasPrefix(FieldPushToken, v))
+}
+
+// PushTokenHasSuffix applies the HasSuffix predicate on the "push_token" field.
+func PushTokenHasSuffix(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldHasSuffix(FieldPushToken, v))
+}
+
+// PushTokenIsNil applies the IsNil predicate on the "push_token" field.
+func PushTokenIsNil() predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldIsNull(FieldPushToken))
+}
+
+// PushTokenNotNil applies the NotNil predicate on the "push_token" field.
+func PushTokenNotNil() predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNotNull(FieldPushToken))
+}
+
+// PushTokenEqualFold applies the EqualFold predicate on the "push_token" field.
+func PushTokenEqualFold(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEqualFold(FieldPushToken, v))
+}
+
+// PushTokenContainsFold applies the ContainsFold predicate on the "push_token" field.
+func PushTokenContainsFold(v string) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldContainsFold(FieldPushToken, v))
+}
+
+// DigestEQ applies the EQ predicate on the "digest" field.
+func DigestEQ(v Digest) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldDigest, v))
+}
+
+// DigestNEQ applies the NEQ predicate on the "digest" field.
+func DigestNEQ(v Digest) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNEQ(FieldDigest, v))
+}
+
+// DigestIn applies the In predicate on the "digest" field.
+func DigestIn(vs ...Digest) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldIn(FieldDigest, vs...))
+}
+
+// DigestNotIn applies the NotIn predicate on the "digest" field.
+func DigestNotIn(vs ...Digest) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNotIn(FieldDigest, vs...))
+}
+
+// WeeklySummaryEQ applies the EQ predicate on the "weekly_summary" field.
+func WeeklySummaryEQ(v bool) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldWeeklySummary, v))
+}
+
+// WeeklySummaryNEQ applies the NEQ predicate on the "weekly_summary" field.
+func WeeklySummaryNEQ(v bool) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNEQ(FieldWeeklySummary, v))
+}
+
+// MonthlyReportEQ applies the EQ predicate on the "monthly_report" field.
+func MonthlyReportEQ(v bool) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldMonthlyReport, v))
+}
+
+// MonthlyReportNEQ applies the NEQ predicate on the "monthly_report" field.
+func MonthlyReportNEQ(v bool) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNEQ(FieldMonthlyReport, v))
+}
+
+// LastDigestAtEQ applies the EQ predicate on the "last_digest_at" field.
+func LastDigestAtEQ(v time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldLastDigestAt, v))
+}
+
+// LastDigestAtNEQ applies the NEQ predicate on the "last_digest_at" field.
+func LastDigestAtNEQ(v time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNEQ(FieldLastDigestAt, v))
+}
+
+// LastDigestAtIn applies the In predicate on the "last_digest_at" field.
+func LastDigestAtIn(vs ...time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldIn(FieldLastDigestAt, vs...))
+}
+
+// LastDigestAtNotIn applies the NotIn predicate on the "last_digest_at" field.
+func LastDigestAtNotIn(vs ...time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNotIn(FieldLastDigestAt, vs...))
+}
+
+// LastDigestAtGT applies the GT predicate on the "last_digest_at" field.
+func LastDigestAtGT(v time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldGT(FieldLastDigestAt, v))
+}
+
+// LastDigestAtGTE applies the GTE predicate on the "last_digest_at" field.
+func LastDigestAtGTE(v time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldGTE(FieldLastDigestAt, v))
+}
+
+// LastDigestAtLT applies the LT predicate on the "last_digest_at" field.
+func LastDigestAtLT(v time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldLT(FieldLastDigestAt, v))
+}
+
+// LastDigestAtLTE applies the LTE predicate on the "last_digest_at" field.
+func LastDigestAtLTE(v time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldLTE(FieldLastDigestAt, v))
+}
+
+// LastDigestAtIsNil applies the IsNil predicate on the "last_digest_at" field.
+func LastDigestAtIsNil() predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldIsNull(FieldLastDigestAt))
+}
+
+// LastDigestAtNotNil applies the NotNil predicate on the "last_digest_at" field.
+func LastDigestAtNotNil() predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNotNull(FieldLastDigestAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AlertPreference) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AlertPreference) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AlertPreference) predicate.AlertPreference {
+	return predicate.AlertPreference(sql.NotPredicates(p))
+}
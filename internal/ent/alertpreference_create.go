@@ -0,0 +1,1469 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/alertpreference"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AlertPreferenceCreate is the builder for creating a AlertPreference entity.
+type AlertPreferenceCreate struct {
+	config
+	mutation *AlertPreferenceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *AlertPreferenceCreate) SetUserID(v string) *AlertPreferenceCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetAnomalyAlerts sets the "anomaly_alerts" field.
+func (_c *AlertPreferenceCreate) SetAnomalyAlerts(v bool) *AlertPreferenceCreate {
+	_c.mutation.SetAnomalyAlerts(v)
+	return _c
+}
+
+// SetNillableAnomalyAlerts sets the "anomaly_alerts" field if the given value is not nil.
+func (_c *AlertPreferenceCreate) SetNillableAnomalyAlerts(v *bool) *AlertPreferenceCreate {
+	if v != nil {
+		_c.SetAnomalyAlerts(*v)
+	}
+	return _c
+}
+
+// SetBudgetAlerts sets the "budget_alerts" field.
+func (_c *AlertPreferenceCreate) SetBudgetAlerts(v bool) *AlertPreferenceCreate {
+	_c.mutation.SetBudgetAlerts(v)
+	return _c
+}
+
+// SetNillableBudgetAlerts sets the "budget_alerts" field if the given value is not nil.
+func (_c *AlertPreferenceCreate) SetNillableBudgetAlerts(v *bool) *AlertPreferenceCreate {
+	if v != nil {
+		_c.SetBudgetAlerts(*v)
+	}
+	return _c
+}
+
+// SetBudgetLimits sets the "budget_limits" field.
+func (_c *AlertPreferenceCreate) SetBudgetLimits(v map[string]float64) *AlertPreferenceCreate {
+	_c.mutation.SetBudgetLimits(v)
+	return _c
+}
+
+// SetBudgetThresholdPercent sets the "budget_threshold_percent" field.
+func (_c *AlertPreferenceCreate) SetBudgetThresholdPercent(v float64) *AlertPreferenceCreate {
+	_c.mutation.SetBudgetThresholdPercent(v)
+	return _c
+}
+
+// SetNillableBudgetThresholdPercent sets the "budget_threshold_percent" field if the given value is not nil.
+func (_c *AlertPreferenceCreate) SetNillableBudgetThresholdPercent(v *float64) *AlertPreferenceCreate {
+	if v != nil {
+		_c.SetBudgetThresholdPercent(*v)
+	}
+	return _c
+}
+
+// SetMinSeverity sets the "min_severity" field.
+func (_c *AlertPreferenceCreate) SetMinSeverity(v alertpreference.MinSeverity) *AlertPreferenceCreate {
+	_c.mutation.SetMinSeverity(v)
+	return _c
+}
+
+// SetNillableMinSeverity sets the "min_severity" field if the given value is not nil.
+func (_c *AlertPreferenceCreate) SetNillableMinSeverity(v *alertpreference.MinSeverity) *AlertPreferenceCreate {
+	if v != nil {
+		_c.SetMinSeverity(*v)
+	}
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *AlertPreferenceCreate) SetEmail(v string) *AlertPreferenceCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_c *AlertPreferenceCreate) SetNillableEmail(v *string) *AlertPreferenceCreate {
+	if v != nil {
+		_c.SetEmail(*v)
+	}
+	return _c
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (_c *AlertPreferenceCreate) SetWebhookURL(v string) *AlertPreferenceCreate {
+	_c.mutation.SetWebhookURL(v)
+	return _c
+}
+
+// SetNillableWebhookURL sets the "webhook_url" field if the given value is not nil.
+func (_c *AlertPreferenceCreate) SetNillableWebhookURL(v *string) *AlertPreferenceCreate {
+	if v != nil {
+		_c.SetWebhookURL(*v)
+	}
+	return _c
+}
+
+// SetPushToken sets the "push_token" field.
+func (_c *AlertPreferenceCreate) SetPushToken(v string) *AlertPreferenceCreate {
+	_c.mutation.SetPushToken(v)
+	return _c
+}
+
+// SetNillablePushToken sets the "push_token" field if the given value is not nil.
+func (_c *AlertPreferenceCreate) SetNillablePushToken(v *string) *AlertPreferenceCreate {
+	if v != nil {
+		_c.SetPushToken(*v)
+	}
+	return _c
+}
+
+// SetDigest sets the "digest" field.
+func (_c *AlertPreferenceCreate) SetDigest(v alertpreference.Digest) *AlertPreferenceCreate {
+	_c.mutation.SetDigest(v)
+	return _c
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (_c *AlertPreferenceCreate) SetNillableDigest(v *alertpreference.Digest) *AlertPreferenceCreate {
+	if v != nil {
+		_c.SetDigest(*v)
+	}
+	return _c
+}
+
+// SetWeeklySummary sets the "weekly_summary" field.
+func (_c *AlertPreferenceCreate) SetWeeklySummary(v bool) *AlertPreferenceCreate {
+	_c.mutation.SetWeeklySummary(v)
+	return _c
+}
+
+// SetNillableWeeklySummary sets the "weekly_summary" field if the given value is not nil.
+func (_c *AlertPreferenceCreate) SetNillableWeeklySummary(v *bool) *AlertPreferenceCreate {
+	if v != nil {
+		_c.SetWeeklySummary(*v)
+	}
+	return _c
+}
+
+// SetMonthlyReport sets the "monthly_report" field.
+func (_c *AlertPreferenceCreate) SetMonthlyReport(v bool) *AlertPreferenceCreate {
+	_c.mutation.SetMonthlyReport(v)
+	return _c
+}
+
+// SetNillableMonthlyReport sets the "monthly_report" field if the given value is not nil.
+func (_c *AlertPreferenceCreate) SetNillableMonthlyReport(v *bool) *AlertPreferenceCreate {
+	if v != nil {
+		_c.SetMonthlyReport(*v)
+	}
+	return _c
+}
+
+// SetLastDigestAt sets the "last_digest_at" field.
+func (_c *AlertPreferenceCreate) SetLastDigestAt(v time.Time) *AlertPreferenceCreate {
+	_c.mutation.SetLastDigestAt(v)
+	return _c
+}
+
+// SetNillableLastDigestAt sets the "last_digest_at" field if the given value is not nil.
+func (_c *AlertPreferenceCreate) SetNillableLastDigestAt(v *time.Time) *AlertPreferenceCreate {
+	if v != nil {
+		_c.SetLastDigestAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AlertPreferenceCreate) SetCreatedAt(v time.Time) *AlertPreferenceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AlertPreferenceCreate) SetNillableCreatedAt(v *time.Time) *AlertPreferenceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AlertPreferenceCreate) SetUpdatedAt(v time.Time) *AlertPreferenceCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AlertPreferenceCreate) SetNillableUpdatedAt(v *time.Time) *AlertPreferenceCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AlertPreferenceCreate) SetID(v string) *AlertPreferenceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the AlertPreferenceMutation object of the builder.
+func (_c *AlertPreferenceCreate) Mutation() *AlertPreferenceMutation {
+	return _c.mutation
+}
+
+// Save creates the AlertPreference in the database.
+func (_c *AlertPreferenceCreate) Save(ctx context.Context) (*AlertPreference, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AlertPreferenceCreate) SaveX(ctx context.Context) *AlertPreference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AlertPreferenceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AlertPreferenceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AlertPreferenceCreate) defaults() {
+	if _, ok := _c.mutation.AnomalyAlerts(); !ok {
+		v := alertpreference.DefaultAnomalyAlerts
+		_c.mutation.SetAnomalyAlerts(v)
+	}
+	if _, ok := _c.mutation.BudgetAlerts(); !ok {
+		v := alertpreference.DefaultBudgetAlerts
+		_c.mutation.SetBudgetAlerts(v)
+	}
+	if _, ok := _c.mutation.BudgetThresholdPercent(); !ok {
+		v := alertpreference.DefaultBudgetThresholdPercent
+		_c.mutation.SetBudgetThresholdPercent(v)
+	}
+	if _, ok := _c.mutation.MinSeverity(); !ok {
+		v := alertpreference.DefaultMinSeverity
+		_c.mutation.SetMinSeverity(v)
+	}
+	if _, ok := _c.mutation.Digest(); !ok {
+		v := alertpreference.DefaultDigest
+		_c.mutation.SetDigest(v)
+	}
+	if _, ok := _c.mutation.WeeklySummary(); !ok {
+		v := alertpreference.DefaultWeeklySummary
+		_c.mutation.SetWeeklySummary(v)
+	}
+	if _, ok := _c.mutation.MonthlyReport(); !ok {
+		v := alertpreference.DefaultMonthlyReport
+		_c.mutation.SetMonthlyReport(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := alertpreference.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := alertpreference.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AlertPreferenceCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "AlertPreference.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := alertpreference.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "AlertPreference.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AnomalyAlerts(); !ok {
+		return &ValidationError{Name: "anomaly_alerts", err: errors.New(`ent: missing required field "AlertPreference.anomaly_alerts"`)}
+	}
+	if _, ok := _c.mutation.BudgetAlerts(); !ok {
+		return &ValidationError{Name: "budget_alerts", err: errors.New(`ent: missing required field "AlertPreference.budget_alerts"`)}
+	}
+	if _, ok := _c.mutation.BudgetThresholdPercent(); !ok {
+		return &ValidationError{Name: "budget_threshold_percent", err: errors.New(`ent: missing required field "AlertPreference.budget_threshold_percent"`)}
+	}
+	if v, ok := _c.mutation.BudgetThresholdPercent(); ok {
+		if err := alertpreference.BudgetThresholdPercentValidator(v); err != nil {
+			return &ValidationError{Name: "budget_threshold_percent", err: fmt.Errorf(`ent: validator failed for field "AlertPreference.budget_threshold_percent": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MinSeverity(); !ok {
+		return &ValidationError{Name: "min_severity", err: errors.New(`ent: missing required field "AlertPreference.min_severity"`)}
+	}
+	if v, ok := _c.mutation.MinSeverity(); ok {
+		if err := alertpreference.MinSeverityValidator(v); err != nil {
+			return &ValidationError{Name: "min_severity", err: fmt.Errorf(`ent: validator failed for field "AlertPreference.min_severity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Digest(); !ok {
+		return &ValidationError{Name: "digest", err: errors.New(`ent: missing required field "AlertPreference.digest"`)}
+	}
+	if v, ok := _c.mutation.Digest(); ok {
+		if err := alertpreference.DigestValidator(v); err != nil {
+			return &ValidationError{Name: "digest", err: fmt.Errorf(`ent: validator failed for field "AlertPreference.digest": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.WeeklySummary(); !ok {
+		return &ValidationError{Name: "weekly_summary", err: errors.New(`ent: missing required field "AlertPreference.weekly_summary"`)}
+	}
+	if _, ok := _c.mutation.MonthlyReport(); !ok {
+		return &ValidationError{Name: "monthly_report", err: errors.New(`ent: missing required field "AlertPreference.monthly_report"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AlertPreference.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AlertPreference.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *AlertPreferenceCreate) sqlSave(ctx context.Context) (*AlertPreference, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected AlertPreference.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AlertPreferenceCreate) createSpec() (*AlertPreference, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AlertPreference{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(alertpreference.Table, sqlgraph.NewFieldSpec(alertpreference.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(alertpreference.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.AnomalyAlerts(); ok {
+		_spec.SetField(alertpreference.FieldAnomalyAlerts, field.TypeBool, value)
+		_node.AnomalyAlerts = value
+	}
+	if value, ok := _c.mutation.BudgetAlerts(); ok {
+		_spec.SetField(alertpreference.FieldBudgetAlerts, field.TypeBool, value)
+		_node.BudgetAlerts = value
+	}
+	if value, ok := _c.mutation.BudgetLimits(); ok {
+		_spec.SetField(alertpreference.FieldBudgetLimits, field.TypeJSON, value)
+		_node.BudgetLimits = value
+	}
+	if value, ok := _c.mutation.BudgetThresholdPercent(); ok {
+		_spec.SetField(alertpreference.FieldBudgetThresholdPercent, field.TypeFloat64, value)
+		_node.BudgetThresholdPercent = value
+	}
+	if value, ok := _c.mutation.MinSeverity(); ok {
+		_spec.SetField(alertpreference.FieldMinSeverity, field.TypeEnum, value)
+		_node.MinSeverity = value
+	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(alertpreference.FieldEmail, field.TypeString, value)
+		_node.Email = &value
+	}
+	if value, ok := _c.mutation.WebhookURL(); ok {
+		_spec.SetField(alertpreference.FieldWebhookURL, field.TypeString, value)
+		_node.WebhookURL = &value
+	}
+	if value, ok := _c.mutation.PushToken(); ok {
+		_spec.SetField(alertpreference.FieldPushToken, field.TypeString, value)
+		_node.PushToken = &value
+	}
+	if value, ok := _c.mutation.Digest(); ok {
+		_spec.SetField(alertpreference.FieldDigest, field.TypeEnum, value)
+		_node.Digest = value
+	}
+	if value, ok := _c.mutation.WeeklySummary(); ok {
+		_spec.SetField(alertpreference.FieldWeeklySummary, field.TypeBool, value)
+		_node.WeeklySummary = value
+	}
+	if value, ok := _c.mutation.MonthlyReport(); ok {
+		_spec.SetField(alertpreference.FieldMonthlyReport, field.TypeBool, value)
+		_node.MonthlyReport = value
+	}
+	if value, ok := _c.mutation.LastDigestAt(); ok {
+		_spec.SetField(alertpreference.FieldLastDigestAt, field.TypeTime, value)
+		_node.LastDigestAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(alertpreference.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(alertpreference.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AlertPreference.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AlertPreferenceUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AlertPreferenceCreate) OnConflict(opts ...sql.ConflictOption) *AlertPreferenceUpsertOne {
+	_c.conflict = opts
+	return &AlertPreferenceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AlertPreference.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AlertPreferenceCreate) OnConflictColumns(columns ...string) *AlertPreferenceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AlertPreferenceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AlertPreferenceUpsertOne is the builder for "upsert"-ing
+	//  one AlertPreference node.
+	AlertPreferenceUpsertOne struct {
+		create *AlertPreferenceCreate
+	}
+
+	// AlertPreferenceUpsert is the "OnConflict" setter.
+	AlertPreferenceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *AlertPreferenceUpsert) SetUserID(v string) *AlertPreferenceUpsert {
+	u.Set(alertpreference.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AlertPreferenceUpsert) UpdateUserID() *AlertPreferenceUpsert {
+	u.SetExcluded(alertpreference.FieldUserID)
+	return u
+}
+
+// SetAnomalyAlerts sets the "anomaly_alerts" field.
+func (u *AlertPreferenceUpsert) SetAnomalyAlerts(v bool) *AlertPreferenceUpsert {
+	u.Set(alertpreference.FieldAnomalyAlerts, v)
+	return u
+}
+
+// UpdateAnomalyAlerts sets the "anomaly_alerts" field to the value that was provided on create.
+func (u *AlertPreferenceUpsert) UpdateAnomalyAlerts() *AlertPreferenceUpsert {
+	u.SetExcluded(alertpreference.FieldAnomalyAlerts)
+	return u
+}
+
+// SetBudgetAlerts sets the "budget_alerts" field.
+func (u *AlertPreferenceUpsert) SetBudgetAlerts(v bool) *AlertPreferenceUpsert {
+	u.Set(alertpreference.FieldBudgetAlerts, v)
+	return u
+}
+
+// UpdateBudgetAlerts sets the "budget_alerts" field to the value that was provided on create.
+func (u *AlertPreferenceUpsert) UpdateBudgetAlerts() *AlertPreferenceUpsert {
+	u.SetExcluded(alertpreference.FieldBudgetAlerts)
+	return u
+}
+
+// SetBudgetLimits sets the "budget_limits" field.
+func (u *AlertPreferenceUpsert) SetBudgetLimits(v map[string]float64) *AlertPreferenceUpsert {
+	u.Set(alertpreference.FieldBudgetLimits, v)
+	return u
+}
+
+// UpdateBudgetLimits sets the "budget_limits" field to the value that was provided on create.
+func (u *AlertPreferenceUpsert) UpdateBudgetLimits() *AlertPreferenceUpsert {
+	u.SetExcluded(alertpreference.FieldBudgetLimits)
+	return u
+}
+
+// ClearBudgetLimits clears the value of the "budget_limits" field.
+func (u *AlertPreferenceUpsert) ClearBudgetLimits() *AlertPreferenceUpsert {
+	u.SetNull(alertpreference.FieldBudgetLimits)
+	return u
+}
+
+// SetBudgetThresholdPercent sets the "budget_threshold_percent" field.
+func (u *AlertPreferenceUpsert) SetBudgetThresholdPercent(v float64) *AlertPreferenceUpsert {
+	u.Set(alertpreference.FieldBudgetThresholdPercent, v)
+	return u
+}
+
+// UpdateBudgetThresholdPercent sets the "budget_threshold_percent" field to the value that was provided on create.
+func (u *AlertPreferenceUpsert) UpdateBudgetThresholdPercent() *AlertPreferenceUpsert {
+	u.SetExcluded(alertpreference.FieldBudgetThresholdPercent)
+	return u
+}
+
+// AddBudgetThresholdPercent adds v to the "budget_threshold_percent" field.
+func (u *AlertPreferenceUpsert) AddBudgetThresholdPercent(v float64) *AlertPreferenceUpsert {
+	u.Add(alertpreference.FieldBudgetThresholdPercent, v)
+	return u
+}
+
+// SetMinSeverity sets the "min_severity" field.
+func (u *AlertPreferenceUpsert) SetMinSeverity(v alertpreference.MinSeverity) *AlertPreferenceUpsert {
+	u.Set(alertpreference.FieldMinSeverity, v)
+	return u
+}
+
+// UpdateMinSeverity sets the "min_severity" field to the value that was provided on create.
+func (u *AlertPreferenceUpsert) UpdateMinSeverity() *AlertPreferenceUpsert {
+	u.SetExcluded(alertpreference.FieldMinSeverity)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *AlertPreferenceUpsert) SetEmail(v string) *AlertPreferenceUpsert {
+	u.Set(alertpreference.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *AlertPreferenceUpsert) UpdateEmail() *AlertPreferenceUpsert {
+	u.SetExcluded(alertpreference.FieldEmail)
+	return u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *AlertPreferenceUpsert) ClearEmail() *AlertPreferenceUpsert {
+	u.SetNull(alertpreference.FieldEmail)
+	return u
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (u *AlertPreferenceUpsert) SetWebhookURL(v string) *AlertPreferenceUpsert {
+	u.Set(alertpreference.FieldWebhookURL, v)
+	return u
+}
+
+// UpdateWebhookURL sets the "webhook_url" field to the value that was provided on create.
+func (u *AlertPreferenceUpsert) UpdateWebhookURL() *AlertPreferenceUpsert {
+	u.SetExcluded(alertpreference.FieldWebhookURL)
+	return u
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (u *AlertPreferenceUpsert) ClearWebhookURL() *AlertPreferenceUpsert {
+	u.SetNull(alertpreference.FieldWebhookURL)
+	return u
+}
+
+// SetPushToken sets the "push_token" field.
+func (u *AlertPreferenceUpsert) SetPushToken(v string) *AlertPreferenceUpsert {
+	u.Set(alertpreference.FieldPushToken, v)
+	return u
+}
+
+// UpdatePushToken sets the "push_token" field to the value that was provided on create.
+func (u *AlertPreferenceUpsert) UpdatePushToken() *AlertPreferenceUpsert {
+	u.SetExcluded(alertpreference.FieldPushToken)
+	return u
+}
+
+// ClearPushToken clears the value of the "push_token" field.
+func (u *AlertPreferenceUpsert) ClearPushToken() *AlertPreferenceUpsert {
+	u.SetNull(alertpreference.FieldPushToken)
+	return u
+}
+
+// SetDigest sets the "digest" field.
+func (u *AlertPreferenceUpsert) SetDigest(v alertpreference.Digest) *AlertPreferenceUpsert {
+	u.Set(alertpreference.FieldDigest, v)
+	return u
+}
+
+// UpdateDigest sets the "digest" field to the value that was provided on create.
+func (u *AlertPreferenceUpsert) UpdateDigest() *AlertPreferenceUpsert {
+	u.SetExcluded(alertpreference.FieldDigest)
+	return u
+}
+
+// SetWeeklySummary sets the "weekly_summary" field.
+func (u *AlertPreferenceUpsert) SetWeeklySummary(v bool) *AlertPreferenceUpsert {
+	u.Set(alertpreference.FieldWeeklySummary, v)
+	return u
+}
+
+// UpdateWeeklySummary sets the "weekly_summary" field to the value that was provided on create.
+func (u *AlertPreferenceUpsert) UpdateWeeklySummary() *AlertPreferenceUpsert {
+	u.SetExcluded(alertpreference.FieldWeeklySummary)
+	return u
+}
+
+// SetMonthlyReport sets the "monthly_report" field.
+func (u *AlertPreferenceUpsert) SetMonthlyReport(v bool) *AlertPreferenceUpsert {
+	u.Set(alertpreference.FieldMonthlyReport, v)
+	return u
+}
+
+// UpdateMonthlyReport sets the "monthly_report" field to the value that was provided on create.
+func (u *AlertPreferenceUpsert) UpdateMonthlyReport() *AlertPreferenceUpsert {
+	u.SetExcluded(alertpreference.FieldMonthlyReport)
+	return u
+}
+
+// SetLastDigestAt sets the "last_digest_at" field.
+func (u *AlertPreferenceUpsert) SetLastDigestAt(v time.Time) *AlertPreferenceUpsert {
+	u.Set(alertpreference.FieldLastDigestAt, v)
+	return u
+}
+
+// UpdateLastDigestAt sets the "last_digest_at" field to the value that was provided on create.
+func (u *AlertPreferenceUpsert) UpdateLastDigestAt() *AlertPreferenceUpsert {
+	u.SetExcluded(alertpreference.FieldLastDigestAt)
+	return u
+}
+
+// ClearLastDigestAt clears the value of the "last_digest_at" field.
+func (u *AlertPreferenceUpsert) ClearLastDigestAt() *AlertPreferenceUpsert {
+	u.SetNull(alertpreference.FieldLastDigestAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AlertPreferenceUpsert) SetUpdatedAt(v time.Time) *AlertPreferenceUpsert {
+	u.Set(alertpreference.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AlertPreferenceUpsert) UpdateUpdatedAt() *AlertPreferenceUpsert {
+	u.SetExcluded(alertpreference.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AlertPreference.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(alertpreference.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AlertPreferenceUpsertOne) UpdateNewValues() *AlertPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(alertpreference.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(alertpreference.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AlertPreference.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AlertPreferenceUpsertOne) Ignore() *AlertPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AlertPreferenceUpsertOne) DoNothing() *AlertPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AlertPreferenceCreate.OnConflict
+// documentation for more info.
+func (u *AlertPreferenceUpsertOne) Update(set func(*AlertPreferenceUpsert)) *AlertPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AlertPreferenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AlertPreferenceUpsertOne) SetUserID(v string) *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertOne) UpdateUserID() *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetAnomalyAlerts sets the "anomaly_alerts" field.
+func (u *AlertPreferenceUpsertOne) SetAnomalyAlerts(v bool) *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetAnomalyAlerts(v)
+	})
+}
+
+// UpdateAnomalyAlerts sets the "anomaly_alerts" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertOne) UpdateAnomalyAlerts() *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateAnomalyAlerts()
+	})
+}
+
+// SetBudgetAlerts sets the "budget_alerts" field.
+func (u *AlertPreferenceUpsertOne) SetBudgetAlerts(v bool) *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetBudgetAlerts(v)
+	})
+}
+
+// UpdateBudgetAlerts sets the "budget_alerts" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertOne) UpdateBudgetAlerts() *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateBudgetAlerts()
+	})
+}
+
+// SetBudgetLimits sets the "budget_limits" field.
+func (u *AlertPreferenceUpsertOne) SetBudgetLimits(v map[string]float64) *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetBudgetLimits(v)
+	})
+}
+
+// UpdateBudgetLimits sets the "budget_limits" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertOne) UpdateBudgetLimits() *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateBudgetLimits()
+	})
+}
+
+// ClearBudgetLimits clears the value of the "budget_limits" field.
+func (u *AlertPreferenceUpsertOne) ClearBudgetLimits() *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.ClearBudgetLimits()
+	})
+}
+
+// SetBudgetThresholdPercent sets the "budget_threshold_percent" field.
+func (u *AlertPreferenceUpsertOne) SetBudgetThresholdPercent(v float64) *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetBudgetThresholdPercent(v)
+	})
+}
+
+// AddBudgetThresholdPercent adds v to the "budget_threshold_percent" field.
+func (u *AlertPreferenceUpsertOne) AddBudgetThresholdPercent(v float64) *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.AddBudgetThresholdPercent(v)
+	})
+}
+
+// UpdateBudgetThresholdPercent sets the "budget_threshold_percent" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertOne) UpdateBudgetThresholdPercent() *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateBudgetThresholdPercent()
+	})
+}
+
+// SetMinSeverity sets the "min_severity" field.
+func (u *AlertPreferenceUpsertOne) SetMinSeverity(v alertpreference.MinSeverity) *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetMinSeverity(v)
+	})
+}
+
+// UpdateMinSeverity sets the "min_severity" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertOne) UpdateMinSeverity() *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateMinSeverity()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *AlertPreferenceUpsertOne) SetEmail(v string) *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertOne) UpdateEmail() *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *AlertPreferenceUpsertOne) ClearEmail() *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (u *AlertPreferenceUpsertOne) SetWebhookURL(v string) *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetWebhookURL(v)
+	})
+}
+
+// UpdateWebhookURL sets the "webhook_url" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertOne) UpdateWebhookURL() *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateWebhookURL()
+	})
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (u *AlertPreferenceUpsertOne) ClearWebhookURL() *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.ClearWebhookURL()
+	})
+}
+
+// SetPushToken sets the "push_token" field.
+func (u *AlertPreferenceUpsertOne) SetPushToken(v string) *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetPushToken(v)
+	})
+}
+
+// UpdatePushToken sets the "push_token" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertOne) UpdatePushToken() *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdatePushToken()
+	})
+}
+
+// ClearPushToken clears the value of the "push_token" field.
+func (u *AlertPreferenceUpsertOne) ClearPushToken() *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.ClearPushToken()
+	})
+}
+
+// SetDigest sets the "digest" field.
+func (u *AlertPreferenceUpsertOne) SetDigest(v alertpreference.Digest) *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetDigest(v)
+	})
+}
+
+// UpdateDigest sets the "digest" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertOne) UpdateDigest() *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateDigest()
+	})
+}
+
+// SetWeeklySummary sets the "weekly_summary" field.
+func (u *AlertPreferenceUpsertOne) SetWeeklySummary(v bool) *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetWeeklySummary(v)
+	})
+}
+
+// UpdateWeeklySummary sets the "weekly_summary" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertOne) UpdateWeeklySummary() *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateWeeklySummary()
+	})
+}
+
+// SetMonthlyReport sets the "monthly_report" field.
+func (u *AlertPreferenceUpsertOne) SetMonthlyReport(v bool) *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetMonthlyReport(v)
+	})
+}
+
+// UpdateMonthlyReport sets the "monthly_report" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertOne) UpdateMonthlyReport() *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateMonthlyReport()
+	})
+}
+
+// SetLastDigestAt sets the "last_digest_at" field.
+func (u *AlertPreferenceUpsertOne) SetLastDigestAt(v time.Time) *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetLastDigestAt(v)
+	})
+}
+
+// UpdateLastDigestAt sets the "last_digest_at" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertOne) UpdateLastDigestAt() *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateLastDigestAt()
+	})
+}
+
+// ClearLastDigestAt clears the value of the "last_digest_at" field.
+func (u *AlertPreferenceUpsertOne) ClearLastDigestAt() *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.ClearLastDigestAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AlertPreferenceUpsertOne) SetUpdatedAt(v time.Time) *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertOne) UpdateUpdatedAt() *AlertPreferenceUpsertOne {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AlertPreferenceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AlertPreferenceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AlertPreferenceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AlertPreferenceUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AlertPreferenceUpsertOne.ID is not supported by MySQL driver. Use AlertPreferenceUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AlertPreferenceUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AlertPreferenceCreateBulk is the builder for creating many AlertPreference entities in bulk.
+type AlertPreferenceCreateBulk struct {
+	config
+	err      error
+	builders []*AlertPreferenceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AlertPreference entities in the database.
+func (_c *AlertPreferenceCreateBulk) Save(ctx context.Context) ([]*AlertPreference, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AlertPreference, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AlertPreferenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AlertPreferenceCreateBulk) SaveX(ctx context.Context) []*AlertPreference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AlertPreferenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AlertPreferenceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AlertPreference.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AlertPreferenceUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AlertPreferenceCreateBulk) OnConflict(opts ...sql.ConflictOption) *AlertPreferenceUpsertBulk {
+	_c.conflict = opts
+	return &AlertPreferenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AlertPreference.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AlertPreferenceCreateBulk) OnConflictColumns(columns ...string) *AlertPreferenceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AlertPreferenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// AlertPreferenceUpsertBulk is the builder for "upsert"-ing
+// a bulk of AlertPreference nodes.
+type AlertPreferenceUpsertBulk struct {
+	create *AlertPreferenceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AlertPreference.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(alertpreference.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AlertPreferenceUpsertBulk) UpdateNewValues() *AlertPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(alertpreference.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(alertpreference.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AlertPreference.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AlertPreferenceUpsertBulk) Ignore() *AlertPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AlertPreferenceUpsertBulk) DoNothing() *AlertPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AlertPreferenceCreateBulk.OnConflict
+// documentation for more info.
+func (u *AlertPreferenceUpsertBulk) Update(set func(*AlertPreferenceUpsert)) *AlertPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AlertPreferenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AlertPreferenceUpsertBulk) SetUserID(v string) *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertBulk) UpdateUserID() *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetAnomalyAlerts sets the "anomaly_alerts" field.
+func (u *AlertPreferenceUpsertBulk) SetAnomalyAlerts(v bool) *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetAnomalyAlerts(v)
+	})
+}
+
+// UpdateAnomalyAlerts sets the "anomaly_alerts" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertBulk) UpdateAnomalyAlerts() *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateAnomalyAlerts()
+	})
+}
+
+// SetBudgetAlerts sets the "budget_alerts" field.
+func (u *AlertPreferenceUpsertBulk) SetBudgetAlerts(v bool) *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetBudgetAlerts(v)
+	})
+}
+
+// UpdateBudgetAlerts sets the "budget_alerts" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertBulk) UpdateBudgetAlerts() *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateBudgetAlerts()
+	})
+}
+
+// SetBudgetLimits sets the "budget_limits" field.
+func (u *AlertPreferenceUpsertBulk) SetBudgetLimits(v map[string]float64) *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetBudgetLimits(v)
+	})
+}
+
+// UpdateBudgetLimits sets the "budget_limits" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertBulk) UpdateBudgetLimits() *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateBudgetLimits()
+	})
+}
+
+// ClearBudgetLimits clears the value of the "budget_limits" field.
+func (u *AlertPreferenceUpsertBulk) ClearBudgetLimits() *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.ClearBudgetLimits()
+	})
+}
+
+// SetBudgetThresholdPercent sets the "budget_threshold_percent" field.
+func (u *AlertPreferenceUpsertBulk) SetBudgetThresholdPercent(v float64) *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetBudgetThresholdPercent(v)
+	})
+}
+
+// AddBudgetThresholdPercent adds v to the "budget_threshold_percent" field.
+func (u *AlertPreferenceUpsertBulk) AddBudgetThresholdPercent(v float64) *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.AddBudgetThresholdPercent(v)
+	})
+}
+
+// UpdateBudgetThresholdPercent sets the "budget_threshold_percent" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertBulk) UpdateBudgetThresholdPercent() *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateBudgetThresholdPercent()
+	})
+}
+
+// SetMinSeverity sets the "min_severity" field.
+func (u *AlertPreferenceUpsertBulk) SetMinSeverity(v alertpreference.MinSeverity) *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetMinSeverity(v)
+	})
+}
+
+// UpdateMinSeverity sets the "min_severity" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertBulk) UpdateMinSeverity() *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateMinSeverity()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *AlertPreferenceUpsertBulk) SetEmail(v string) *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertBulk) UpdateEmail() *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *AlertPreferenceUpsertBulk) ClearEmail() *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (u *AlertPreferenceUpsertBulk) SetWebhookURL(v string) *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetWebhookURL(v)
+	})
+}
+
+// UpdateWebhookURL sets the "webhook_url" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertBulk) UpdateWebhookURL() *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateWebhookURL()
+	})
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (u *AlertPreferenceUpsertBulk) ClearWebhookURL() *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.ClearWebhookURL()
+	})
+}
+
+// SetPushToken sets the "push_token" field.
+func (u *AlertPreferenceUpsertBulk) SetPushToken(v string) *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetPushToken(v)
+	})
+}
+
+// UpdatePushToken sets the "push_token" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertBulk) UpdatePushToken() *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdatePushToken()
+	})
+}
+
+// ClearPushToken clears the value of the "push_token" field.
+func (u *AlertPreferenceUpsertBulk) ClearPushToken() *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.ClearPushToken()
+	})
+}
+
+// SetDigest sets the "digest" field.
+func (u *AlertPreferenceUpsertBulk) SetDigest(v alertpreference.Digest) *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetDigest(v)
+	})
+}
+
+// UpdateDigest sets the "digest" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertBulk) UpdateDigest() *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateDigest()
+	})
+}
+
+// SetWeeklySummary sets the "weekly_summary" field.
+func (u *AlertPreferenceUpsertBulk) SetWeeklySummary(v bool) *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetWeeklySummary(v)
+	})
+}
+
+// UpdateWeeklySummary sets the "weekly_summary" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertBulk) UpdateWeeklySummary() *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateWeeklySummary()
+	})
+}
+
+// SetMonthlyReport sets the "monthly_report" field.
+func (u *AlertPreferenceUpsertBulk) SetMonthlyReport(v bool) *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetMonthlyReport(v)
+	})
+}
+
+// UpdateMonthlyReport sets the "monthly_report" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertBulk) UpdateMonthlyReport() *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateMonthlyReport()
+	})
+}
+
+// SetLastDigestAt sets the "last_digest_at" field.
+func (u *AlertPreferenceUpsertBulk) SetLastDigestAt(v time.Time) *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetLastDigestAt(v)
+	})
+}
+
+// UpdateLastDigestAt sets the "last_digest_at" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertBulk) UpdateLastDigestAt() *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateLastDigestAt()
+	})
+}
+
+// ClearLastDigestAt clears the value of the "last_digest_at" field.
+func (u *AlertPreferenceUpsertBulk) ClearLastDigestAt() *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.ClearLastDigestAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AlertPreferenceUpsertBulk) SetUpdatedAt(v time.Time) *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AlertPreferenceUpsertBulk) UpdateUpdatedAt() *AlertPreferenceUpsertBulk {
+	return u.Update(func(s *AlertPreferenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AlertPreferenceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AlertPreferenceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AlertPreferenceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AlertPreferenceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
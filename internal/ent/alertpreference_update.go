@@ -0,0 +1,804 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/alertpreference"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AlertPreferenceUpdate is the builder for updating AlertPreference entities.
+type AlertPreferenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AlertPreferenceMutation
+}
+
+// Where appends a list predicates to the AlertPreferenceUpdate builder.
+func (_u *AlertPreferenceUpdate) Where(ps ...predicate.AlertPreference) *AlertPreferenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AlertPreferenceUpdate) SetUserID(v string) *AlertPreferenceUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AlertPreferenceUpdate) SetNillableUserID(v *string) *AlertPreferenceUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetAnomalyAlerts sets the "anomaly_alerts" field.
+func (_u *AlertPreferenceUpdate) SetAnomalyAlerts(v bool) *AlertPreferenceUpdate {
+	_u.mutation.SetAnomalyAlerts(v)
+	return _u
+}
+
+// SetNillableAnomalyAlerts sets the "anomaly_alerts" field if the given value is not nil.
+func (_u *AlertPreferenceUpdate) SetNillableAnomalyAlerts(v *bool) *AlertPreferenceUpdate {
+	if v != nil {
+		_u.SetAnomalyAlerts(*v)
+	}
+	return _u
+}
+
+// SetBudgetAlerts sets the "budget_alerts" field.
+func (_u *AlertPreferenceUpdate) SetBudgetAlerts(v bool) *AlertPreferenceUpdate {
+	_u.mutation.SetBudgetAlerts(v)
+	return _u
+}
+
+// SetNillableBudgetAlerts sets the "budget_alerts" field if the given value is not nil.
+func (_u *AlertPreferenceUpdate) SetNillableBudgetAlerts(v *bool) *AlertPreferenceUpdate {
+	if v != nil {
+		_u.SetBudgetAlerts(*v)
+	}
+	return _u
+}
+
+// SetBudgetLimits sets the "budget_limits" field.
+func (_u *AlertPreferenceUpdate) SetBudgetLimits(v map[string]float64) *AlertPreferenceUpdate {
+	_u.mutation.SetBudgetLimits(v)
+	return _u
+}
+
+// ClearBudgetLimits clears the value of the "budget_limits" field.
+func (_u *AlertPreferenceUpdate) ClearBudgetLimits() *AlertPreferenceUpdate {
+	_u.mutation.ClearBudgetLimits()
+	return _u
+}
+
+// SetBudgetThresholdPercent sets the "budget_threshold_percent" field.
+func (_u *AlertPreferenceUpdate) SetBudgetThresholdPercent(v float64) *AlertPreferenceUpdate {
+	_u.mutation.ResetBudgetThresholdPercent()
+	_u.mutation.SetBudgetThresholdPercent(v)
+	return _u
+}
+
+// SetNillableBudgetThresholdPercent sets the "budget_threshold_percent" field if the given value is not nil.
+func (_u *AlertPreferenceUpdate) SetNillableBudgetThresholdPercent(v *float64) *AlertPreferenceUpdate {
+	if v != nil {
+		_u.SetBudgetThresholdPercent(*v)
+	}
+	return _u
+}
+
+// AddBudgetThresholdPercent adds value to the "budget_threshold_percent" field.
+func (_u *AlertPreferenceUpdate) AddBudgetThresholdPercent(v float64) *AlertPreferenceUpdate {
+	_u.mutation.AddBudgetThresholdPercent(v)
+	return _u
+}
+
+// SetMinSeverity sets the "min_severity" field.
+func (_u *AlertPreferenceUpdate) SetMinSeverity(v alertpreference.MinSeverity) *AlertPreferenceUpdate {
+	_u.mutation.SetMinSeverity(v)
+	return _u
+}
+
+// SetNillableMinSeverity sets the "min_severity" field if the given value is not nil.
+func (_u *AlertPreferenceUpdate) SetNillableMinSeverity(v *alertpreference.MinSeverity) *AlertPreferenceUpdate {
+	if v != nil {
+		_u.SetMinSeverity(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *AlertPreferenceUpdate) SetEmail(v string) *AlertPreferenceUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *AlertPreferenceUpdate) SetNillableEmail(v *string) *AlertPreferenceUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *AlertPreferenceUpdate) ClearEmail() *AlertPreferenceUpdate {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (_u *AlertPreferenceUpdate) SetWebhookURL(v string) *AlertPreferenceUpdate {
+	_u.mutation.SetWebhookURL(v)
+	return _u
+}
+
+// SetNillableWebhookURL sets the "webhook_url" field if the given value is not nil.
+func (_u *AlertPreferenceUpdate) SetNillableWebhookURL(v *string) *AlertPreferenceUpdate {
+	if v != nil {
+		_u.SetWebhookURL(*v)
+	}
+	return _u
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (_u *AlertPreferenceUpdate) ClearWebhookURL() *AlertPreferenceUpdate {
+	_u.mutation.ClearWebhookURL()
+	return _u
+}
+
+// SetPushToken sets the "push_token" field.
+func (_u *AlertPreferenceUpdate) SetPushToken(v string) *AlertPreferenceUpdate {
+	_u.mutation.SetPushToken(v)
+	return _u
+}
+
+// SetNillablePushToken sets the "push_token" field if the given value is not nil.
+func (_u *AlertPreferenceUpdate) SetNillablePushToken(v *string) *AlertPreferenceUpdate {
+	if v != nil {
+		_u.SetPushToken(*v)
+	}
+	return _u
+}
+
+// ClearPushToken clears the value of the "push_token" field.
+func (_u *AlertPreferenceUpdate) ClearPushToken() *AlertPreferenceUpdate {
+	_u.mutation.ClearPushToken()
+	return _u
+}
+
+// SetDigest sets the "digest" field.
+func (_u *AlertPreferenceUpdate) SetDigest(v alertpreference.Digest) *AlertPreferenceUpdate {
+	_u.mutation.SetDigest(v)
+	return _u
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (_u *AlertPreferenceUpdate) SetNillableDigest(v *alertpreference.Digest) *AlertPreferenceUpdate {
+	if v != nil {
+		_u.SetDigest(*v)
+	}
+	return _u
+}
+
+// SetWeeklySummary sets the "weekly_summary" field.
+func (_u *AlertPreferenceUpdate) SetWeeklySummary(v bool) *AlertPreferenceUpdate {
+	_u.mutation.SetWeeklySummary(v)
+	return _u
+}
+
+// SetNillableWeeklySummary sets the "weekly_summary" field if the given value is not nil.
+func (_u *AlertPreferenceUpdate) SetNillableWeeklySummary(v *bool) *AlertPreferenceUpdate {
+	if v != nil {
+		_u.SetWeeklySummary(*v)
+	}
+	return _u
+}
+
+// SetMonthlyReport sets the "monthly_report" field.
+func (_u *AlertPreferenceUpdate) SetMonthlyReport(v bool) *AlertPreferenceUpdate {
+	_u.mutation.SetMonthlyReport(v)
+	return _u
+}
+
+// SetNillableMonthlyReport sets the "monthly_report" field if the given value is not nil.
+func (_u *AlertPreferenceUpdate) SetNillableMonthlyReport(v *bool) *AlertPreferenceUpdate {
+	if v != nil {
+		_u.SetMonthlyReport(*v)
+	}
+	return _u
+}
+
+// SetLastDigestAt sets the "last_digest_at" field.
+func (_u *AlertPreferenceUpdate) SetLastDigestAt(v time.Time) *AlertPreferenceUpdate {
+	_u.mutation.SetLastDigestAt(v)
+	return _u
+}
+
+// SetNillableLastDigestAt sets the "last_digest_at" field if the given value is not nil.
+func (_u *AlertPreferenceUpdate) SetNillableLastDigestAt(v *time.Time) *AlertPreferenceUpdate {
+	if v != nil {
+		_u.SetLastDigestAt(*v)
+	}
+	return _u
+}
+
+// ClearLastDigestAt clears the value of the "last_digest_at" field.
+func (_u *AlertPreferenceUpdate) ClearLastDigestAt() *AlertPreferenceUpdate {
+	_u.mutation.ClearLastDigestAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AlertPreferenceUpdate) SetUpdatedAt(v time.Time) *AlertPreferenceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the AlertPreferenceMutation object of the builder.
+func (_u *AlertPreferenceUpdate) Mutation() *AlertPreferenceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AlertPreferenceUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AlertPreferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AlertPreferenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AlertPreferenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AlertPreferenceUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := alertpreference.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AlertPreferenceUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := alertpreference.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "AlertPreference.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BudgetThresholdPercent(); ok {
+		if err := alertpreference.BudgetThresholdPercentValidator(v); err != nil {
+			return &ValidationError{Name: "budget_threshold_percent", err: fmt.Errorf(`ent: validator failed for field "AlertPreference.budget_threshold_percent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MinSeverity(); ok {
+		if err := alertpreference.MinSeverityValidator(v); err != nil {
+			return &ValidationError{Name: "min_severity", err: fmt.Errorf(`ent: validator failed for field "AlertPreference.min_severity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Digest(); ok {
+		if err := alertpreference.DigestValidator(v); err != nil {
+			return &ValidationError{Name: "digest", err: fmt.Errorf(`ent: validator failed for field "AlertPreference.digest": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AlertPreferenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(alertpreference.Table, alertpreference.Columns, sqlgraph.NewFieldSpec(alertpreference.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(alertpreference.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AnomalyAlerts(); ok {
+		_spec.SetField(alertpreference.FieldAnomalyAlerts, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BudgetAlerts(); ok {
+		_spec.SetField(alertpreference.FieldBudgetAlerts, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BudgetLimits(); ok {
+		_spec.SetField(alertpreference.FieldBudgetLimits, field.TypeJSON, value)
+	}
+	if _u.mutation.BudgetLimitsCleared() {
+		_spec.ClearField(alertpreference.FieldBudgetLimits, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.BudgetThresholdPercent(); ok {
+		_spec.SetField(alertpreference.FieldBudgetThresholdPercent, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBudgetThresholdPercent(); ok {
+		_spec.AddField(alertpreference.FieldBudgetThresholdPercent, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MinSeverity(); ok {
+		_spec.SetField(alertpreference.FieldMinSeverity, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(alertpreference.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(alertpreference.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.WebhookURL(); ok {
+		_spec.SetField(alertpreference.FieldWebhookURL, field.TypeString, value)
+	}
+	if _u.mutation.WebhookURLCleared() {
+		_spec.ClearField(alertpreference.FieldWebhookURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.PushToken(); ok {
+		_spec.SetField(alertpreference.FieldPushToken, field.TypeString, value)
+	}
+	if _u.mutation.PushTokenCleared() {
+		_spec.ClearField(alertpreference.FieldPushToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.Digest(); ok {
+		_spec.SetField(alertpreference.FieldDigest, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.WeeklySummary(); ok {
+		_spec.SetField(alertpreference.FieldWeeklySummary, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MonthlyReport(); ok {
+		_spec.SetField(alertpreference.FieldMonthlyReport, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastDigestAt(); ok {
+		_spec.SetField(alertpreference.FieldLastDigestAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastDigestAtCleared() {
+		_spec.ClearField(alertpreference.FieldLastDigestAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(alertpreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{alertpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AlertPreferenceUpdateOne is the builder for updating a single AlertPreference entity.
+type AlertPreferenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AlertPreferenceMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AlertPreferenceUpdateOne) SetUserID(v string) *AlertPreferenceUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AlertPreferenceUpdateOne) SetNillableUserID(v *string) *AlertPreferenceUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetAnomalyAlerts sets the "anomaly_alerts" field.
+func (_u *AlertPreferenceUpdateOne) SetAnomalyAlerts(v bool) *AlertPreferenceUpdateOne {
+	_u.mutation.SetAnomalyAlerts(v)
+	return _u
+}
+
+// SetNillableAnomalyAlerts sets the "anomaly_alerts" field if the given value is not nil.
+func (_u *AlertPreferenceUpdateOne) SetNillableAnomalyAlerts(v *bool) *AlertPreferenceUpdateOne {
+	if v != nil {
+		_u.SetAnomalyAlerts(*v)
+	}
+	return _u
+}
+
+// SetBudgetAlerts sets the "budget_alerts" field.
+func (_u *AlertPreferenceUpdateOne) SetBudgetAlerts(v bool) *AlertPreferenceUpdateOne {
+	_u.mutation.SetBudgetAlerts(v)
+	return _u
+}
+
+// SetNillableBudgetAlerts sets the "budget_alerts" field if the given value is not nil.
+func (_u *AlertPreferenceUpdateOne) SetNillableBudgetAlerts(v *bool) *AlertPreferenceUpdateOne {
+	if v != nil {
+		_u.SetBudgetAlerts(*v)
+	}
+	return _u
+}
+
+// SetBudgetLimits sets the "budget_limits" field.
+func (_u *AlertPreferenceUpdateOne) SetBudgetLimits(v map[string]float64) *AlertPreferenceUpdateOne {
+	_u.mutation.SetBudgetLimits(v)
+	return _u
+}
+
+// ClearBudgetLimits clears the value of the "budget_limits" field.
+func (_u *AlertPreferenceUpdateOne) ClearBudgetLimits() *AlertPreferenceUpdateOne {
+	_u.mutation.ClearBudgetLimits()
+	return _u
+}
+
+// SetBudgetThresholdPercent sets the "budget_threshold_percent" field.
+func (_u *AlertPreferenceUpdateOne) SetBudgetThresholdPercent(v float64) *AlertPreferenceUpdateOne {
+	_u.mutation.ResetBudgetThresholdPercent()
+	_u.mutation.SetBudgetThresholdPercent(v)
+	return _u
+}
+
+// SetNillableBudgetThresholdPercent sets the "budget_threshold_percent" field if the given value is not nil.
+func (_u *AlertPreferenceUpdateOne) SetNillableBudgetThresholdPercent(v *float64) *AlertPreferenceUpdateOne {
+	if v != nil {
+		_u.SetBudgetThresholdPercent(*v)
+	}
+	return _u
+}
+
+// AddBudgetThresholdPercent adds value to the "budget_threshold_percent" field.
+func (_u *AlertPreferenceUpdateOne) AddBudgetThresholdPercent(v float64) *AlertPreferenceUpdateOne {
+	_u.mutation.AddBudgetThresholdPercent(v)
+	return _u
+}
+
+// SetMinSeverity sets the "min_severity" field.
+func (_u *AlertPreferenceUpdateOne) SetMinSeverity(v alertpreference.MinSeverity) *AlertPreferenceUpdateOne {
+	_u.mutation.SetMinSeverity(v)
+	return _u
+}
+
+// SetNillableMinSeverity sets the "min_severity" field if the given value is not nil.
+func (_u *AlertPreferenceUpdateOne) SetNillableMinSeverity(v *alertpreference.MinSeverity) *AlertPreferenceUpdateOne {
+	if v != nil {
+		_u.SetMinSeverity(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *AlertPreferenceUpdateOne) SetEmail(v string) *AlertPreferenceUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *AlertPreferenceUpdateOne) SetNillableEmail(v *string) *AlertPreferenceUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *AlertPreferenceUpdateOne) ClearEmail() *AlertPreferenceUpdateOne {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (_u *AlertPreferenceUpdateOne) SetWebhookURL(v string) *AlertPreferenceUpdateOne {
+	_u.mutation.SetWebhookURL(v)
+	return _u
+}
+
+// SetNillableWebhookURL sets the "webhook_url" field if the given value is not nil.
+func (_u *AlertPreferenceUpdateOne) SetNillableWebhookURL(v *string) *AlertPreferenceUpdateOne {
+	if v != nil {
+		_u.SetWebhookURL(*v)
+	}
+	return _u
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (_u *AlertPreferenceUpdateOne) ClearWebhookURL() *AlertPreferenceUpdateOne {
+	_u.mutation.ClearWebhookURL()
+	return _u
+}
+
+// SetPushToken sets the "push_token" field.
+func (_u *AlertPreferenceUpdateOne) SetPushToken(v string) *AlertPreferenceUpdateOne {
+	_u.mutation.SetPushToken(v)
+	return _u
+}
+
+// SetNillablePushToken sets the "push_token" field if the given value is not nil.
+func (_u *AlertPreferenceUpdateOne) SetNillablePushToken(v *string) *AlertPreferenceUpdateOne {
+	if v != nil {
+		_u.SetPushToken(*v)
+	}
+	return _u
+}
+
+// ClearPushToken clears the value of the "push_token" field.
+func (_u *AlertPreferenceUpdateOne) ClearPushToken() *AlertPreferenceUpdateOne {
+	_u.mutation.ClearPushToken()
+	return _u
+}
+
+// SetDigest sets the "digest" field.
+func (_u *AlertPreferenceUpdateOne) SetDigest(v alertpreference.Digest) *AlertPreferenceUpdateOne {
+	_u.mutation.SetDigest(v)
+	return _u
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (_u *AlertPreferenceUpdateOne) SetNillableDigest(v *alertpreference.Digest) *AlertPreferenceUpdateOne {
+	if v != nil {
+		_u.SetDigest(*v)
+	}
+	return _u
+}
+
+// SetWeeklySummary sets the "weekly_summary" field.
+func (_u *AlertPreferenceUpdateOne) SetWeeklySummary(v bool) *AlertPreferenceUpdateOne {
+	_u.mutation.SetWeeklySummary(v)
+	return _u
+}
+
+// SetNillableWeeklySummary sets the "weekly_summary" field if the given value is not nil.
+func (_u *AlertPreferenceUpdateOne) SetNillableWeeklySummary(v *bool) *AlertPreferenceUpdateOne {
+	if v != nil {
+		_u.SetWeeklySummary(*v)
+	}
+	return _u
+}
+
+// SetMonthlyReport sets the "monthly_report" field.
+func (_u *AlertPreferenceUpdateOne) SetMonthlyReport(v bool) *AlertPreferenceUpdateOne {
+	_u.mutation.SetMonthlyReport(v)
+	return _u
+}
+
+// SetNillableMonthlyReport sets the "monthly_report" field if the given value is not nil.
+func (_u *AlertPreferenceUpdateOne) SetNillableMonthlyReport(v *bool) *AlertPreferenceUpdateOne {
+	if v != nil {
+		_u.SetMonthlyReport(*v)
+	}
+	return _u
+}
+
+// SetLastDigestAt sets the "last_digest_at" field.
+func (_u *AlertPreferenceUpdateOne) SetLastDigestAt(v time.Time) *AlertPreferenceUpdateOne {
+	_u.mutation.SetLastDigestAt(v)
+	return _u
+}
+
+// SetNillableLastDigestAt sets the "last_digest_at" field if the given value is not nil.
+func (_u *AlertPreferenceUpdateOne) SetNillableLastDigestAt(v *time.Time) *AlertPreferenceUpdateOne {
+	if v != nil {
+		_u.SetLastDigestAt(*v)
+	}
+	return _u
+}
+
+// ClearLastDigestAt clears the value of the "last_digest_at" field.
+func (_u *AlertPreferenceUpdateOne) ClearLastDigestAt() *AlertPreferenceUpdateOne {
+	_u.mutation.ClearLastDigestAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AlertPreferenceUpdateOne) SetUpdatedAt(v time.Time) *AlertPreferenceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the AlertPreferenceMutation object of the builder.
+func (_u *AlertPreferenceUpdateOne) Mutation() *AlertPreferenceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AlertPreferenceUpdate builder.
+func (_u *AlertPreferenceUpdateOne) Where(ps ...predicate.AlertPreference) *AlertPreferenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AlertPreferenceUpdateOne) Select(field string, fields ...string) *AlertPreferenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AlertPreference entity.
+func (_u *AlertPreferenceUpdateOne) Save(ctx context.Context) (*AlertPreference, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AlertPreferenceUpdateOne) SaveX(ctx context.Context) *AlertPreference {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AlertPreferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AlertPreferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AlertPreferenceUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := alertpreference.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AlertPreferenceUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := alertpreference.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "AlertPreference.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BudgetThresholdPercent(); ok {
+		if err := alertpreference.BudgetThresholdPercentValidator(v); err != nil {
+			return &ValidationError{Name: "budget_threshold_percent", err: fmt.Errorf(`ent: validator failed for field "AlertPreference.budget_threshold_percent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MinSeverity(); ok {
+		if err := alertpreference.MinSeverityValidator(v); err != nil {
+			return &ValidationError{Name: "min_severity", err: fmt.Errorf(`ent: validator failed for field "AlertPreference.min_severity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Digest(); ok {
+		if err := alertpreference.DigestValidator(v); err != nil {
+			return &ValidationError{Name: "digest", err: fmt.Errorf(`ent: validator failed for field "AlertPreference.digest": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AlertPreferenceUpdateOne) sqlSave(ctx context.Context) (_node *AlertPreference, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(alertpreference.Table, alertpreference.Columns, sqlgraph.NewFieldSpec(alertpreference.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AlertPreference.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, alertpreference.FieldID)
+		for _, f := range fields {
+			if !alertpreference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != alertpreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(alertpreference.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AnomalyAlerts(); ok {
+		_spec.SetField(alertpreference.FieldAnomalyAlerts, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BudgetAlerts(); ok {
+		_spec.SetField(alertpreference.FieldBudgetAlerts, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BudgetLimits(); ok {
+		_spec.SetField(alertpreference.FieldBudgetLimits, field.TypeJSON, value)
+	}
+	if _u.mutation.BudgetLimitsCleared() {
+		_spec.ClearField(alertpreference.FieldBudgetLimits, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.BudgetThresholdPercent(); ok {
+		_spec.SetField(alertpreference.FieldBudgetThresholdPercent, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBudgetThresholdPercent(); ok {
+		_spec.AddField(alertpreference.FieldBudgetThresholdPercent, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MinSeverity(); ok {
+		_spec.SetField(alertpreference.FieldMinSeverity, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(alertpreference.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(alertpreference.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.WebhookURL(); ok {
+		_spec.SetField(alertpreference.FieldWebhookURL, field.TypeString, value)
+	}
+	if _u.mutation.WebhookURLCleared() {
+		_spec.ClearField(alertpreference.FieldWebhookURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.PushToken(); ok {
+		_spec.SetField(alertpreference.FieldPushToken, field.TypeString, value)
+	}
+	if _u.mutation.PushTokenCleared() {
+		_spec.ClearField(alertpreference.FieldPushToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.Digest(); ok {
+		_spec.SetField(alertpreference.FieldDigest, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.WeeklySummary(); ok {
+		_spec.SetField(alertpreference.FieldWeeklySummary, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MonthlyReport(); ok {
+		_spec.SetField(alertpreference.FieldMonthlyReport, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastDigestAt(); ok {
+		_spec.SetField(alertpreference.FieldLastDigestAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastDigestAtCleared() {
+		_spec.ClearField(alertpreference.FieldLastDigestAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(alertpreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &AlertPreference{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{alertpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/attachmentblob"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AttachmentBlob is the model entity for the AttachmentBlob schema.
+type AttachmentBlob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Hex SHA-256 of the attachment content
+	Sha256 string `json:"sha256,omitempty"`
+	// Size of the content in bytes
+	Size int64 `json:"size,omitempty"`
+	// MIME type of the attachment the content was first stored from
+	MimeType string `json:"mime_type,omitempty"`
+	// Key of the content in the blob store; empty when no store is configured
+	StorageKey string `json:"storage_key,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AttachmentBlobQuery when eager-loading is set.
+	Edges        AttachmentBlobEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AttachmentBlobEdges holds the relations/edges for other nodes in the graph.
+type AttachmentBlobEdges struct {
+	// Message attachments with this content
+	Links []*AttachmentLink `json:"links,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LinksOrErr returns the Links value or an error if the edge
+// was not loaded in eager-loading.
+func (e AttachmentBlobEdges) LinksOrErr() ([]*AttachmentLink, error) {
+	if e.loadedTypes[0] {
+		return e.Links, nil
+	}
+	return nil, &NotLoadedError{edge: "links"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AttachmentBlob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case attachmentblob.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case attachmentblob.FieldID, attachmentblob.FieldSha256, attachmentblob.FieldMimeType, attachmentblob.FieldStorageKey:
+			values[i] = new(sql.NullString)
+		case attachmentblob.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AttachmentBlob fields.
+func (_m *AttachmentBlob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case attachmentblob.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case attachmentblob.FieldSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sha256", values[i])
+			} else if value.Valid {
+				_m.Sha256 = value.String
+			}
+		case attachmentblob.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				_m.Size = value.Int64
+			}
+		case attachmentblob.FieldMimeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mime_type", values[i])
+			} else if value.Valid {
+				_m.MimeType = value.String
+			}
+		case attachmentblob.FieldStorageKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_key", values[i])
+			} else if value.Valid {
+				_m.StorageKey = value.String
+			}
+		case attachmentblob.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AttachmentBlob.
+// This includes values selected through modifiers, order, etc.
+func (_m *AttachmentBlob) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLinks queries the "links" edge of the AttachmentBlob entity.
+func (_m *AttachmentBlob) QueryLinks() *AttachmentLinkQuery {
+	return NewAttachmentBlobClient(_m.config).QueryLinks(_m)
+}
+
+// Update returns a builder for updating this AttachmentBlob.
+// Note that you need to call AttachmentBlob.Unwrap() before calling this method if this AttachmentBlob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AttachmentBlob) Update() *AttachmentBlobUpdateOne {
+	return NewAttachmentBlobClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AttachmentBlob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AttachmentBlob) Unwrap() *AttachmentBlob {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AttachmentBlob is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AttachmentBlob) String() string {
+	var builder strings.Builder
+	builder.WriteString("AttachmentBlob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("sha256=")
+	builder.WriteString(_m.Sha256)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Size))
+	builder.WriteString(", ")
+	builder.WriteString("mime_type=")
+	builder.WriteString(_m.MimeType)
+	builder.WriteString(", ")
+	builder.WriteString("storage_key=")
+	builder.WriteString(_m.StorageKey)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AttachmentBlobs is a parsable slice of AttachmentBlob.
+type AttachmentBlobs []*AttachmentBlob
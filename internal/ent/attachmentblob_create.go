@@ -0,0 +1,710 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/attachmentblob"
+	"clockzen-next/internal/ent/attachmentlink"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttachmentBlobCreate is the builder for creating a AttachmentBlob entity.
+type AttachmentBlobCreate struct {
+	config
+	mutation *AttachmentBlobMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetSha256 sets the "sha256" field.
+func (_c *AttachmentBlobCreate) SetSha256(v string) *AttachmentBlobCreate {
+	_c.mutation.SetSha256(v)
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *AttachmentBlobCreate) SetSize(v int64) *AttachmentBlobCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_c *AttachmentBlobCreate) SetMimeType(v string) *AttachmentBlobCreate {
+	_c.mutation.SetMimeType(v)
+	return _c
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_c *AttachmentBlobCreate) SetNillableMimeType(v *string) *AttachmentBlobCreate {
+	if v != nil {
+		_c.SetMimeType(*v)
+	}
+	return _c
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_c *AttachmentBlobCreate) SetStorageKey(v string) *AttachmentBlobCreate {
+	_c.mutation.SetStorageKey(v)
+	return _c
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (_c *AttachmentBlobCreate) SetNillableStorageKey(v *string) *AttachmentBlobCreate {
+	if v != nil {
+		_c.SetStorageKey(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AttachmentBlobCreate) SetCreatedAt(v time.Time) *AttachmentBlobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AttachmentBlobCreate) SetNillableCreatedAt(v *time.Time) *AttachmentBlobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AttachmentBlobCreate) SetID(v string) *AttachmentBlobCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// AddLinkIDs adds the "links" edge to the AttachmentLink entity by IDs.
+func (_c *AttachmentBlobCreate) AddLinkIDs(ids ...string) *AttachmentBlobCreate {
+	_c.mutation.AddLinkIDs(ids...)
+	return _c
+}
+
+// AddLinks adds the "links" edges to the AttachmentLink entity.
+func (_c *AttachmentBlobCreate) AddLinks(v ...*AttachmentLink) *AttachmentBlobCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLinkIDs(ids...)
+}
+
+// Mutation returns the AttachmentBlobMutation object of the builder.
+func (_c *AttachmentBlobCreate) Mutation() *AttachmentBlobMutation {
+	return _c.mutation
+}
+
+// Save creates the AttachmentBlob in the database.
+func (_c *AttachmentBlobCreate) Save(ctx context.Context) (*AttachmentBlob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AttachmentBlobCreate) SaveX(ctx context.Context) *AttachmentBlob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AttachmentBlobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AttachmentBlobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AttachmentBlobCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := attachmentblob.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AttachmentBlobCreate) check() error {
+	if _, ok := _c.mutation.Sha256(); !ok {
+		return &ValidationError{Name: "sha256", err: errors.New(`ent: missing required field "AttachmentBlob.sha256"`)}
+	}
+	if v, ok := _c.mutation.Sha256(); ok {
+		if err := attachmentblob.Sha256Validator(v); err != nil {
+			return &ValidationError{Name: "sha256", err: fmt.Errorf(`ent: validator failed for field "AttachmentBlob.sha256": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "AttachmentBlob.size"`)}
+	}
+	if v, ok := _c.mutation.Size(); ok {
+		if err := attachmentblob.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "AttachmentBlob.size": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AttachmentBlob.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *AttachmentBlobCreate) sqlSave(ctx context.Context) (*AttachmentBlob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected AttachmentBlob.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AttachmentBlobCreate) createSpec() (*AttachmentBlob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AttachmentBlob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(attachmentblob.Table, sqlgraph.NewFieldSpec(attachmentblob.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Sha256(); ok {
+		_spec.SetField(attachmentblob.FieldSha256, field.TypeString, value)
+		_node.Sha256 = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(attachmentblob.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.MimeType(); ok {
+		_spec.SetField(attachmentblob.FieldMimeType, field.TypeString, value)
+		_node.MimeType = value
+	}
+	if value, ok := _c.mutation.StorageKey(); ok {
+		_spec.SetField(attachmentblob.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(attachmentblob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.LinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attachmentblob.LinksTable,
+			Columns: []string{attachmentblob.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachmentlink.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AttachmentBlob.Create().
+//		SetSha256(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AttachmentBlobUpsert) {
+//			SetSha256(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AttachmentBlobCreate) OnConflict(opts ...sql.ConflictOption) *AttachmentBlobUpsertOne {
+	_c.conflict = opts
+	return &AttachmentBlobUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AttachmentBlob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AttachmentBlobCreate) OnConflictColumns(columns ...string) *AttachmentBlobUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AttachmentBlobUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AttachmentBlobUpsertOne is the builder for "upsert"-ing
+	//  one AttachmentBlob node.
+	AttachmentBlobUpsertOne struct {
+		create *AttachmentBlobCreate
+	}
+
+	// AttachmentBlobUpsert is the "OnConflict" setter.
+	AttachmentBlobUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetMimeType sets the "mime_type" field.
+func (u *AttachmentBlobUpsert) SetMimeType(v string) *AttachmentBlobUpsert {
+	u.Set(attachmentblob.FieldMimeType, v)
+	return u
+}
+
+// UpdateMimeType sets the "mime_type" field to the value that was provided on create.
+func (u *AttachmentBlobUpsert) UpdateMimeType() *AttachmentBlobUpsert {
+	u.SetExcluded(attachmentblob.FieldMimeType)
+	return u
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (u *AttachmentBlobUpsert) ClearMimeType() *AttachmentBlobUpsert {
+	u.SetNull(attachmentblob.FieldMimeType)
+	return u
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (u *AttachmentBlobUpsert) SetStorageKey(v string) *AttachmentBlobUpsert {
+	u.Set(attachmentblob.FieldStorageKey, v)
+	return u
+}
+
+// UpdateStorageKey sets the "storage_key" field to the value that was provided on create.
+func (u *AttachmentBlobUpsert) UpdateStorageKey() *AttachmentBlobUpsert {
+	u.SetExcluded(attachmentblob.FieldStorageKey)
+	return u
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (u *AttachmentBlobUpsert) ClearStorageKey() *AttachmentBlobUpsert {
+	u.SetNull(attachmentblob.FieldStorageKey)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AttachmentBlob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(attachmentblob.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AttachmentBlobUpsertOne) UpdateNewValues() *AttachmentBlobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(attachmentblob.FieldID)
+		}
+		if _, exists := u.create.mutation.Sha256(); exists {
+			s.SetIgnore(attachmentblob.FieldSha256)
+		}
+		if _, exists := u.create.mutation.Size(); exists {
+			s.SetIgnore(attachmentblob.FieldSize)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(attachmentblob.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AttachmentBlob.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AttachmentBlobUpsertOne) Ignore() *AttachmentBlobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AttachmentBlobUpsertOne) DoNothing() *AttachmentBlobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AttachmentBlobCreate.OnConflict
+// documentation for more info.
+func (u *AttachmentBlobUpsertOne) Update(set func(*AttachmentBlobUpsert)) *AttachmentBlobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AttachmentBlobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMimeType sets the "mime_type" field.
+func (u *AttachmentBlobUpsertOne) SetMimeType(v string) *AttachmentBlobUpsertOne {
+	return u.Update(func(s *AttachmentBlobUpsert) {
+		s.SetMimeType(v)
+	})
+}
+
+// UpdateMimeType sets the "mime_type" field to the value that was provided on create.
+func (u *AttachmentBlobUpsertOne) UpdateMimeType() *AttachmentBlobUpsertOne {
+	return u.Update(func(s *AttachmentBlobUpsert) {
+		s.UpdateMimeType()
+	})
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (u *AttachmentBlobUpsertOne) ClearMimeType() *AttachmentBlobUpsertOne {
+	return u.Update(func(s *AttachmentBlobUpsert) {
+		s.ClearMimeType()
+	})
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (u *AttachmentBlobUpsertOne) SetStorageKey(v string) *AttachmentBlobUpsertOne {
+	return u.Update(func(s *AttachmentBlobUpsert) {
+		s.SetStorageKey(v)
+	})
+}
+
+// UpdateStorageKey sets the "storage_key" field to the value that was provided on create.
+func (u *AttachmentBlobUpsertOne) UpdateStorageKey() *AttachmentBlobUpsertOne {
+	return u.Update(func(s *AttachmentBlobUpsert) {
+		s.UpdateStorageKey()
+	})
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (u *AttachmentBlobUpsertOne) ClearStorageKey() *AttachmentBlobUpsertOne {
+	return u.Update(func(s *AttachmentBlobUpsert) {
+		s.ClearStorageKey()
+	})
+}
+
+// Exec executes the query.
+func (u *AttachmentBlobUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AttachmentBlobCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AttachmentBlobUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AttachmentBlobUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AttachmentBlobUpsertOne.ID is not supported by MySQL driver. Use AttachmentBlobUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AttachmentBlobUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AttachmentBlobCreateBulk is the builder for creating many AttachmentBlob entities in bulk.
+type AttachmentBlobCreateBulk struct {
+	config
+	err      error
+	builders []*AttachmentBlobCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AttachmentBlob entities in the database.
+func (_c *AttachmentBlobCreateBulk) Save(ctx context.Context) ([]*AttachmentBlob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AttachmentBlob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AttachmentBlobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AttachmentBlobCreateBulk) SaveX(ctx context.Context) []*AttachmentBlob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AttachmentBlobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AttachmentBlobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AttachmentBlob.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AttachmentBlobUpsert) {
+//			SetSha256(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AttachmentBlobCreateBulk) OnConflict(opts ...sql.ConflictOption) *AttachmentBlobUpsertBulk {
+	_c.conflict = opts
+	return &AttachmentBlobUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AttachmentBlob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AttachmentBlobCreateBulk) OnConflictColumns(columns ...string) *AttachmentBlobUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AttachmentBlobUpsertBulk{
+		create: _c,
+	}
+}
+
+// AttachmentBlobUpsertBulk is the builder for "upsert"-ing
+// a bulk of AttachmentBlob nodes.
+type AttachmentBlobUpsertBulk struct {
+	create *AttachmentBlobCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AttachmentBlob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(attachmentblob.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AttachmentBlobUpsertBulk) UpdateNewValues() *AttachmentBlobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(attachmentblob.FieldID)
+			}
+			if _, exists := b.mutation.Sha256(); exists {
+				s.SetIgnore(attachmentblob.FieldSha256)
+			}
+			if _, exists := b.mutation.Size(); exists {
+				s.SetIgnore(attachmentblob.FieldSize)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(attachmentblob.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AttachmentBlob.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AttachmentBlobUpsertBulk) Ignore() *AttachmentBlobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AttachmentBlobUpsertBulk) DoNothing() *AttachmentBlobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AttachmentBlobCreateBulk.OnConflict
+// documentation for more info.
+func (u *AttachmentBlobUpsertBulk) Update(set func(*AttachmentBlobUpsert)) *AttachmentBlobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AttachmentBlobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMimeType sets the "mime_type" field.
+func (u *AttachmentBlobUpsertBulk) SetMimeType(v string) *AttachmentBlobUpsertBulk {
+	return u.Update(func(s *AttachmentBlobUpsert) {
+		s.SetMimeType(v)
+	})
+}
+
+// UpdateMimeType sets the "mime_type" field to the value that was provided on create.
+func (u *AttachmentBlobUpsertBulk) UpdateMimeType() *AttachmentBlobUpsertBulk {
+	return u.Update(func(s *AttachmentBlobUpsert) {
+		s.UpdateMimeType()
+	})
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (u *AttachmentBlobUpsertBulk) ClearMimeType() *AttachmentBlobUpsertBulk {
+	return u.Update(func(s *AttachmentBlobUpsert) {
+		s.ClearMimeType()
+	})
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (u *AttachmentBlobUpsertBulk) SetStorageKey(v string) *AttachmentBlobUpsertBulk {
+	return u.Update(func(s *AttachmentBlobUpsert) {
+		s.SetStorageKey(v)
+	})
+}
+
+// UpdateStorageKey sets the "storage_key" field to the value that was provided on create.
+func (u *AttachmentBlobUpsertBulk) UpdateStorageKey() *AttachmentBlobUpsertBulk {
+	return u.Update(func(s *AttachmentBlobUpsert) {
+		s.UpdateStorageKey()
+	})
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (u *AttachmentBlobUpsertBulk) ClearStorageKey() *AttachmentBlobUpsertBulk {
+	return u.Update(func(s *AttachmentBlobUpsert) {
+		s.ClearStorageKey()
+	})
+}
+
+// Exec executes the query.
+func (u *AttachmentBlobUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AttachmentBlobCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AttachmentBlobCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AttachmentBlobUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
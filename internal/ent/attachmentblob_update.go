@@ -0,0 +1,442 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/attachmentblob"
+	"clockzen-next/internal/ent/attachmentlink"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttachmentBlobUpdate is the builder for updating AttachmentBlob entities.
+type AttachmentBlobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AttachmentBlobMutation
+}
+
+// Where appends a list predicates to the AttachmentBlobUpdate builder.
+func (_u *AttachmentBlobUpdate) Where(ps ...predicate.AttachmentBlob) *AttachmentBlobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_u *AttachmentBlobUpdate) SetMimeType(v string) *AttachmentBlobUpdate {
+	_u.mutation.SetMimeType(v)
+	return _u
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_u *AttachmentBlobUpdate) SetNillableMimeType(v *string) *AttachmentBlobUpdate {
+	if v != nil {
+		_u.SetMimeType(*v)
+	}
+	return _u
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (_u *AttachmentBlobUpdate) ClearMimeType() *AttachmentBlobUpdate {
+	_u.mutation.ClearMimeType()
+	return _u
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_u *AttachmentBlobUpdate) SetStorageKey(v string) *AttachmentBlobUpdate {
+	_u.mutation.SetStorageKey(v)
+	return _u
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (_u *AttachmentBlobUpdate) SetNillableStorageKey(v *string) *AttachmentBlobUpdate {
+	if v != nil {
+		_u.SetStorageKey(*v)
+	}
+	return _u
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (_u *AttachmentBlobUpdate) ClearStorageKey() *AttachmentBlobUpdate {
+	_u.mutation.ClearStorageKey()
+	return _u
+}
+
+// AddLinkIDs adds the "links" edge to the AttachmentLink entity by IDs.
+func (_u *AttachmentBlobUpdate) AddLinkIDs(ids ...string) *AttachmentBlobUpdate {
+	_u.mutation.AddLinkIDs(ids...)
+	return _u
+}
+
+// AddLinks adds the "links" edges to the AttachmentLink entity.
+func (_u *AttachmentBlobUpdate) AddLinks(v ...*AttachmentLink) *AttachmentBlobUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLinkIDs(ids...)
+}
+
+// Mutation returns the AttachmentBlobMutation object of the builder.
+func (_u *AttachmentBlobUpdate) Mutation() *AttachmentBlobMutation {
+	return _u.mutation
+}
+
+// ClearLinks clears all "links" edges to the AttachmentLink entity.
+func (_u *AttachmentBlobUpdate) ClearLinks() *AttachmentBlobUpdate {
+	_u.mutation.ClearLinks()
+	return _u
+}
+
+// RemoveLinkIDs removes the "links" edge to AttachmentLink entities by IDs.
+func (_u *AttachmentBlobUpdate) RemoveLinkIDs(ids ...string) *AttachmentBlobUpdate {
+	_u.mutation.RemoveLinkIDs(ids...)
+	return _u
+}
+
+// RemoveLinks removes "links" edges to AttachmentLink entities.
+func (_u *AttachmentBlobUpdate) RemoveLinks(v ...*AttachmentLink) *AttachmentBlobUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLinkIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AttachmentBlobUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AttachmentBlobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AttachmentBlobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AttachmentBlobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AttachmentBlobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(attachmentblob.Table, attachmentblob.Columns, sqlgraph.NewFieldSpec(attachmentblob.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MimeType(); ok {
+		_spec.SetField(attachmentblob.FieldMimeType, field.TypeString, value)
+	}
+	if _u.mutation.MimeTypeCleared() {
+		_spec.ClearField(attachmentblob.FieldMimeType, field.TypeString)
+	}
+	if value, ok := _u.mutation.StorageKey(); ok {
+		_spec.SetField(attachmentblob.FieldStorageKey, field.TypeString, value)
+	}
+	if _u.mutation.StorageKeyCleared() {
+		_spec.ClearField(attachmentblob.FieldStorageKey, field.TypeString)
+	}
+	if _u.mutation.LinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attachmentblob.LinksTable,
+			Columns: []string{attachmentblob.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachmentlink.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinksIDs(); len(nodes) > 0 && !_u.mutation.LinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attachmentblob.LinksTable,
+			Columns: []string{attachmentblob.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachmentlink.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attachmentblob.LinksTable,
+			Columns: []string{attachmentblob.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachmentlink.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachmentblob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AttachmentBlobUpdateOne is the builder for updating a single AttachmentBlob entity.
+type AttachmentBlobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AttachmentBlobMutation
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_u *AttachmentBlobUpdateOne) SetMimeType(v string) *AttachmentBlobUpdateOne {
+	_u.mutation.SetMimeType(v)
+	return _u
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_u *AttachmentBlobUpdateOne) SetNillableMimeType(v *string) *AttachmentBlobUpdateOne {
+	if v != nil {
+		_u.SetMimeType(*v)
+	}
+	return _u
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (_u *AttachmentBlobUpdateOne) ClearMimeType() *AttachmentBlobUpdateOne {
+	_u.mutation.ClearMimeType()
+	return _u
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_u *AttachmentBlobUpdateOne) SetStorageKey(v string) *AttachmentBlobUpdateOne {
+	_u.mutation.SetStorageKey(v)
+	return _u
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (_u *AttachmentBlobUpdateOne) SetNillableStorageKey(v *string) *AttachmentBlobUpdateOne {
+	if v != nil {
+		_u.SetStorageKey(*v)
+	}
+	return _u
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (_u *AttachmentBlobUpdateOne) ClearStorageKey() *AttachmentBlobUpdateOne {
+	_u.mutation.ClearStorageKey()
+	return _u
+}
+
+// AddLinkIDs adds the "links" edge to the AttachmentLink entity by IDs.
+func (_u *AttachmentBlobUpdateOne) AddLinkIDs(ids ...string) *AttachmentBlobUpdateOne {
+	_u.mutation.AddLinkIDs(ids...)
+	return _u
+}
+
+// AddLinks adds the "links" edges to the AttachmentLink entity.
+func (_u *AttachmentBlobUpdateOne) AddLinks(v ...*AttachmentLink) *AttachmentBlobUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLinkIDs(ids...)
+}
+
+// Mutation returns the AttachmentBlobMutation object of the builder.
+func (_u *AttachmentBlobUpdateOne) Mutation() *AttachmentBlobMutation {
+	return _u.mutation
+}
+
+// ClearLinks clears all "links" edges to the AttachmentLink entity.
+func (_u *AttachmentBlobUpdateOne) ClearLinks() *AttachmentBlobUpdateOne {
+	_u.mutation.ClearLinks()
+	return _u
+}
+
+// RemoveLinkIDs removes the "links" edge to AttachmentLink entities by IDs.
+func (_u *AttachmentBlobUpdateOne) RemoveLinkIDs(ids ...string) *AttachmentBlobUpdateOne {
+	_u.mutation.RemoveLinkIDs(ids...)
+	return _u
+}
+
+// RemoveLinks removes "links" edges to AttachmentLink entities.
+func (_u *AttachmentBlobUpdateOne) RemoveLinks(v ...*AttachmentLink) *AttachmentBlobUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLinkIDs(ids...)
+}
+
+// Where appends a list predicates to the AttachmentBlobUpdate builder.
+func (_u *AttachmentBlobUpdateOne) Where(ps ...predicate.AttachmentBlob) *AttachmentBlobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AttachmentBlobUpdateOne) Select(field string, fields ...string) *AttachmentBlobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AttachmentBlob entity.
+func (_u *AttachmentBlobUpdateOne) Save(ctx context.Context) (*AttachmentBlob, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AttachmentBlobUpdateOne) SaveX(ctx context.Context) *AttachmentBlob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AttachmentBlobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AttachmentBlobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AttachmentBlobUpdateOne) sqlSave(ctx context.Context) (_node *AttachmentBlob, err error) {
+	_spec := sqlgraph.NewUpdateSpec(attachmentblob.Table, attachmentblob.Columns, sqlgraph.NewFieldSpec(attachmentblob.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AttachmentBlob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attachmentblob.FieldID)
+		for _, f := range fields {
+			if !attachmentblob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attachmentblob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MimeType(); ok {
+		_spec.SetField(attachmentblob.FieldMimeType, field.TypeString, value)
+	}
+	if _u.mutation.MimeTypeCleared() {
+		_spec.ClearField(attachmentblob.FieldMimeType, field.TypeString)
+	}
+	if value, ok := _u.mutation.StorageKey(); ok {
+		_spec.SetField(attachmentblob.FieldStorageKey, field.TypeString, value)
+	}
+	if _u.mutation.StorageKeyCleared() {
+		_spec.ClearField(attachmentblob.FieldStorageKey, field.TypeString)
+	}
+	if _u.mutation.LinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attachmentblob.LinksTable,
+			Columns: []string{attachmentblob.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachmentlink.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinksIDs(); len(nodes) > 0 && !_u.mutation.LinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attachmentblob.LinksTable,
+			Columns: []string{attachmentblob.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachmentlink.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attachmentblob.LinksTable,
+			Columns: []string{attachmentblob.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachmentlink.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AttachmentBlob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachmentblob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/attachmentblob"
+	"clockzen-next/internal/ent/attachmentlink"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AttachmentLink is the model entity for the AttachmentLink schema.
+type AttachmentLink struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ID of the AttachmentBlob holding the content
+	BlobID string `json:"blob_id,omitempty"`
+	// ID of the EmailConnection the message belongs to
+	ConnectionID string `json:"connection_id,omitempty"`
+	// Message ID from the email provider
+	MessageID string `json:"message_id,omitempty"`
+	// Attachment ID from the email provider
+	AttachmentID string `json:"attachment_id,omitempty"`
+	// File name of the attachment in this message
+	Filename string `json:"filename,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AttachmentLinkQuery when eager-loading is set.
+	Edges        AttachmentLinkEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AttachmentLinkEdges holds the relations/edges for other nodes in the graph.
+type AttachmentLinkEdges struct {
+	// The blob holding the attachment's content
+	Blob *AttachmentBlob `json:"blob,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BlobOrErr returns the Blob value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttachmentLinkEdges) BlobOrErr() (*AttachmentBlob, error) {
+	if e.Blob != nil {
+		return e.Blob, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: attachmentblob.Label}
+	}
+	return nil, &NotLoadedError{edge: "blob"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AttachmentLink) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case attachmentlink.FieldID, attachmentlink.FieldBlobID, attachmentlink.FieldConnectionID, attachmentlink.FieldMessageID, attachmentlink.FieldAttachmentID, attachmentlink.FieldFilename:
+			values[i] = new(sql.NullString)
+		case attachmentlink.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AttachmentLink fields.
+func (_m *AttachmentLink) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case attachmentlink.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case attachmentlink.FieldBlobID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field blob_id", values[i])
+			} else if value.Valid {
+				_m.BlobID = value.String
+			}
+		case attachmentlink.FieldConnectionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field connection_id", values[i])
+			} else if value.Valid {
+				_m.ConnectionID = value.String
+			}
+		case attachmentlink.FieldMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				_m.MessageID = value.String
+			}
+		case attachmentlink.FieldAttachmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attachment_id", values[i])
+			} else if value.Valid {
+				_m.AttachmentID = value.String
+			}
+		case attachmentlink.FieldFilename:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field filename", values[i])
+			} else if value.Valid {
+				_m.Filename = value.String
+			}
+		case attachmentlink.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AttachmentLink.
+// This includes values selected through modifiers, order, etc.
+func (_m *AttachmentLink) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBlob queries the "blob" edge of the AttachmentLink entity.
+func (_m *AttachmentLink) QueryBlob() *AttachmentBlobQuery {
+	return NewAttachmentLinkClient(_m.config).QueryBlob(_m)
+}
+
+// Update returns a builder for updating this AttachmentLink.
+// Note that you need to call AttachmentLink.Unwrap() before calling this method if this AttachmentLink
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AttachmentLink) Update() *AttachmentLinkUpdateOne {
+	return NewAttachmentLinkClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AttachmentLink entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AttachmentLink) Unwrap() *AttachmentLink {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AttachmentLink is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AttachmentLink) String() string {
+	var builder strings.Builder
+	builder.WriteString("AttachmentLink(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("blob_id=")
+	builder.WriteString(_m.BlobID)
+	builder.WriteString(", ")
+	builder.WriteString("connection_id=")
+	builder.WriteString(_m.ConnectionID)
+	builder.WriteString(", ")
+	builder.WriteString("message_id=")
+	builder.WriteString(_m.MessageID)
+	builder.WriteString(", ")
+	builder.WriteString("attachment_id=")
+	builder.WriteString(_m.AttachmentID)
+	builder.WriteString(", ")
+	builder.WriteString("filename=")
+	builder.WriteString(_m.Filename)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AttachmentLinks is a parsable slice of AttachmentLink.
+type AttachmentLinks []*AttachmentLink
@@ -0,0 +1,509 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attachmentlink
+
+import (
+	"clockzen-next/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldContainsFold(FieldID, id))
+}
+
+// BlobID applies equality check predicate on the "blob_id" field. It's identical to BlobIDEQ.
+func BlobID(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldEQ(FieldBlobID, v))
+}
+
+// ConnectionID applies equality check predicate on the "connection_id" field. It's identical to ConnectionIDEQ.
+func ConnectionID(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldEQ(FieldConnectionID, v))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldEQ(FieldMessageID, v))
+}
+
+// AttachmentID applies equality check predicate on the "attachment_id" field. It's identical to AttachmentIDEQ.
+func AttachmentID(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldEQ(FieldAttachmentID, v))
+}
+
+// Filename applies equality check predicate on the "filename" field. It's identical to FilenameEQ.
+func Filename(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldEQ(FieldFilename, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// BlobIDEQ applies the EQ predicate on the "blob_id" field.
+func BlobIDEQ(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldEQ(FieldBlobID, v))
+}
+
+// BlobIDNEQ applies the NEQ predicate on the "blob_id" field.
+func BlobIDNEQ(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldNEQ(FieldBlobID, v))
+}
+
+// BlobIDIn applies the In predicate on the "blob_id" field.
+func BlobIDIn(vs ...string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldIn(FieldBlobID, vs...))
+}
+
+// BlobIDNotIn applies the NotIn predicate on the "blob_id" field.
+func BlobIDNotIn(vs ...string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldNotIn(FieldBlobID, vs...))
+}
+
+// BlobIDGT applies the GT predicate on the "blob_id" field.
+func BlobIDGT(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldGT(FieldBlobID, v))
+}
+
+// BlobIDGTE applies the GTE predicate on the "blob_id" field.
+func BlobIDGTE(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldGTE(FieldBlobID, v))
+}
+
+// BlobIDLT applies the LT predicate on the "blob_id" field.
+func BlobIDLT(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldLT(FieldBlobID, v))
+}
+
+// BlobIDLTE applies the LTE predicate on the "blob_id" field.
+func BlobIDLTE(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldLTE(FieldBlobID, v))
+}
+
+// BlobIDContains applies the Contains predicate on the "blob_id" field.
+func BlobIDContains(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldContains(FieldBlobID, v))
+}
+
+// BlobIDHasPrefix applies the HasPrefix predicate on the "blob_id" field.
+func BlobIDHasPrefix(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldHasPrefix(FieldBlobID, v))
+}
+
+// BlobIDHasSuffix applies the HasSuffix predicate on the "blob_id" field.
+func BlobIDHasSuffix(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldHasSuffix(FieldBlobID, v))
+}
+
+// BlobIDEqualFold applies the EqualFold predicate on the "blob_id" field.
+func BlobIDEqualFold(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldEqualFold(FieldBlobID, v))
+}
+
+// BlobIDContainsFold applies the ContainsFold predicate on the "blob_id" field.
+func BlobIDContainsFold(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldContainsFold(FieldBlobID, v))
+}
+
+// ConnectionIDEQ applies the EQ predicate on the "connection_id" field.
+func ConnectionIDEQ(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldEQ(FieldConnectionID, v))
+}
+
+// ConnectionIDNEQ applies the NEQ predicate on the "connection_id" field.
+func ConnectionIDNEQ(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldNEQ(FieldConnectionID, v))
+}
+
+// ConnectionIDIn applies the In predicate on the "connection_id" field.
+func ConnectionIDIn(vs ...string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldIn(FieldConnectionID, vs...))
+}
+
+// ConnectionIDNotIn applies the NotIn predicate on the "connection_id" field.
+func ConnectionIDNotIn(vs ...string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldNotIn(FieldConnectionID, vs...))
+}
+
+// ConnectionIDGT applies the GT predicate on the "connection_id" field.
+func ConnectionIDGT(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldGT(FieldConnectionID, v))
+}
+
+// ConnectionIDGTE applies the GTE predicate on the "connection_id" field.
+func ConnectionIDGTE(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldGTE(FieldConnectionID, v))
+}
+
+// ConnectionIDLT applies the LT predicate on the "connection_id" field.
+func ConnectionIDLT(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldLT(FieldConnectionID, v))
+}
+
+// ConnectionIDLTE applies the LTE predicate on the "connection_id" field.
+func ConnectionIDLTE(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldLTE(FieldConnectionID, v))
+}
+
+// ConnectionIDContains applies the Contains predicate on the "connection_id" field.
+func ConnectionIDContains(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldContains(FieldConnectionID, v))
+}
+
+// ConnectionIDHasPrefix applies the HasPrefix predicate on the "connection_id" field.
+func ConnectionIDHasPrefix(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldHasPrefix(FieldConnectionID, v))
+}
+
+// ConnectionIDHasSuffix applies the HasSuffix predicate on the "connection_id" field.
+func ConnectionIDHasSuffix(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldHasSuffix(FieldConnectionID, v))
+}
+
+// ConnectionIDEqualFold applies the EqualFold predicate on the "connection_id" field.
+func ConnectionIDEqualFold(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldEqualFold(FieldConnectionID, v))
+}
+
+// ConnectionIDContainsFold applies the ContainsFold predicate on the "connection_id" field.
+func ConnectionIDContainsFold(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldContainsFold(FieldConnectionID, v))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// MessageIDGT applies the GT predicate on the "message_id" field.
+func MessageIDGT(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldGT(FieldMessageID, v))
+}
+
+// MessageIDGTE applies the GTE predicate on the "message_id" field.
+func MessageIDGTE(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldGTE(FieldMessageID, v))
+}
+
+// MessageIDLT applies the LT predicate on the "message_id" field.
+func MessageIDLT(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldLT(FieldMessageID, v))
+}
+
+// MessageIDLTE applies the LTE predicate on the "message_id" field.
+func MessageIDLTE(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldLTE(FieldMessageID, v))
+}
+
+// MessageIDContains applies the Contains predicate on the "message_id" field.
+func MessageIDContains(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldContains(FieldMessageID, v))
+}
+
+// MessageIDHasPrefix applies the HasPrefix predicate on the "message_id" field.
+func MessageIDHasPrefix(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldHasPrefix(FieldMessageID, v))
+}
+
+// MessageIDHasSuffix applies the HasSuffix predicate on the "message_id" field.
+func MessageIDHasSuffix(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldHasSuffix(FieldMessageID, v))
+}
+
+// MessageIDEqualFold applies the EqualFold predicate on the "message_id" field.
+func MessageIDEqualFold(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldEqualFold(FieldMessageID, v))
+}
+
+// MessageIDContainsFold applies the ContainsFold predicate on the "message_id" field.
+func MessageIDContainsFold(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldContainsFold(FieldMessageID, v))
+}
+
+// AttachmentIDEQ applies the EQ predicate on the "attachment_id" field.
+func AttachmentIDEQ(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldEQ(FieldAttachmentID, v))
+}
+
+// AttachmentIDNEQ applies the NEQ predicate on the "attachment_id" field.
+func AttachmentIDNEQ(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldNEQ(FieldAttachmentID, v))
+}
+
+// AttachmentIDIn applies the In predicate on the "attachment_id" field.
+func AttachmentIDIn(vs ...string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldIn(FieldAttachmentID, vs...))
+}
+
+// AttachmentIDNotIn applies the NotIn predicate on the "attachment_id" field.
+func AttachmentIDNotIn(vs ...string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldNotIn(FieldAttachmentID, vs...))
+}
+
+// AttachmentIDGT applies the GT predicate on the "attachment_id" field.
+func AttachmentIDGT(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldGT(FieldAttachmentID, v))
+}
+
+// AttachmentIDGTE applies the GTE predicate on the "attachment_id" field.
+func AttachmentIDGTE(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldGTE(FieldAttachmentID, v))
+}
+
+// AttachmentIDLT applies the LT predicate on the "attachment_id" field.
+func AttachmentIDLT(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldLT(FieldAttachmentID, v))
+}
+
+// AttachmentIDLTE applies the LTE predicate on the "attachment_id" field.
+func AttachmentIDLTE(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldLTE(FieldAttachmentID, v))
+}
+
+// AttachmentIDContains applies the Contains predicate on the "attachment_id" field.
+func AttachmentIDContains(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldContains(FieldAttachmentID, v))
+}
+
+// AttachmentIDHasPrefix applies the HasPrefix predicate on the "attachment_id" field.
+func AttachmentIDHasPrefix(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldHasPrefix(FieldAttachmentID, v))
+}
+
+// AttachmentIDHasSuffix applies the HasSuffix predicate on the "attachment_id" field.
+func AttachmentIDHasSuffix(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldHasSuffix(FieldAttachmentID, v))
+}
+
+// AttachmentIDEqualFold applies the EqualFold predicate on the "attachment_id" field.
+func AttachmentIDEqualFold(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldEqualFold(FieldAttachmentID, v))
+}
+
+// AttachmentIDContainsFold applies the ContainsFold predicate on the "attachment_id" field.
+func AttachmentIDContainsFold(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldContainsFold(FieldAttachmentID, v))
+}
+
+// FilenameEQ applies the EQ predicate on the "filename" field.
+func FilenameEQ(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldEQ(FieldFilename, v))
+}
+
+// FilenameNEQ applies the NEQ predicate on the "filename" field.
+func FilenameNEQ(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldNEQ(FieldFilename, v))
+}
+
+// FilenameIn applies the In predicate on the "filename" field.
+func FilenameIn(vs ...string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldIn(FieldFilename, vs...))
+}
+
+// FilenameNotIn applies the NotIn predicate on the "filename" field.
+func FilenameNotIn(vs ...string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldNotIn(FieldFilename, vs...))
+}
+
+// FilenameGT applies the GT predicate on the "filename" field.
+func FilenameGT(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldGT(FieldFilename, v))
+}
+
+// FilenameGTE applies the GTE predicate on the "filename" field.
+func FilenameGTE(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldGTE(FieldFilename, v))
+}
+
+// FilenameLT applies the LT predicate on the "filename" field.
+func FilenameLT(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldLT(FieldFilename, v))
+}
+
+// FilenameLTE applies the LTE predicate on the "filename" field.
+func FilenameLTE(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldLTE(FieldFilename, v))
+}
+
+// FilenameContains applies the Contains predicate on the "filename" field.
+func FilenameContains(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldContains(FieldFilename, v))
+}
+
+// FilenameHasPrefix applies the HasPrefix predicate on the "filename" field.
+func FilenameHasPrefix(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldHasPrefix(FieldFilename, v))
+}
+
+// FilenameHasSuffix applies the HasSuffix predicate on the "filename" field.
+func FilenameHasSuffix(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldHasSuffix(FieldFilename, v))
+}
+
+// FilenameIsNil applies the IsNil predicate on the "filename" field.
+func FilenameIsNil() predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldIsNull(FieldFilename))
+}
+
+// FilenameNotNil applies the NotNil predicate on the "filename" field.
+func FilenameNotNil() predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldNotNull(FieldFilename))
+}
+
+// FilenameEqualFold applies the EqualFold predicate on the "filename" field.
+func FilenameEqualFold(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldEqualFold(FieldFilename, v))
+}
+
+// FilenameContainsFold applies the ContainsFold predicate on the "filename" field.
+func FilenameContainsFold(v string) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldContainsFold(FieldFilename, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasBlob applies the HasEdge predicate on the "blob" edge.
+func HasBlob() predicate.AttachmentLink {
+	return predicate.AttachmentLink(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BlobTable, BlobColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlobWith applies the HasEdge predicate on the "blob" edge with a given conditions (other predicates).
+func HasBlobWith(preds ...predicate.AttachmentBlob) predicate.AttachmentLink {
+	return predicate.AttachmentLink(func(s *sql.Selector) {
+		step := newBlobStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AttachmentLink) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AttachmentLink) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AttachmentLink) predicate.AttachmentLink {
+	return predicate.AttachmentLink(sql.NotPredicates(p))
+}
@@ -0,0 +1,801 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/attachmentblob"
+	"clockzen-next/internal/ent/attachmentlink"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttachmentLinkCreate is the builder for creating a AttachmentLink entity.
+type AttachmentLinkCreate struct {
+	config
+	mutation *AttachmentLinkMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetBlobID sets the "blob_id" field.
+func (_c *AttachmentLinkCreate) SetBlobID(v string) *AttachmentLinkCreate {
+	_c.mutation.SetBlobID(v)
+	return _c
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_c *AttachmentLinkCreate) SetConnectionID(v string) *AttachmentLinkCreate {
+	_c.mutation.SetConnectionID(v)
+	return _c
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *AttachmentLinkCreate) SetMessageID(v string) *AttachmentLinkCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetAttachmentID sets the "attachment_id" field.
+func (_c *AttachmentLinkCreate) SetAttachmentID(v string) *AttachmentLinkCreate {
+	_c.mutation.SetAttachmentID(v)
+	return _c
+}
+
+// SetFilename sets the "filename" field.
+func (_c *AttachmentLinkCreate) SetFilename(v string) *AttachmentLinkCreate {
+	_c.mutation.SetFilename(v)
+	return _c
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_c *AttachmentLinkCreate) SetNillableFilename(v *string) *AttachmentLinkCreate {
+	if v != nil {
+		_c.SetFilename(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AttachmentLinkCreate) SetCreatedAt(v time.Time) *AttachmentLinkCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AttachmentLinkCreate) SetNillableCreatedAt(v *time.Time) *AttachmentLinkCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AttachmentLinkCreate) SetID(v string) *AttachmentLinkCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetBlob sets the "blob" edge to the AttachmentBlob entity.
+func (_c *AttachmentLinkCreate) SetBlob(v *AttachmentBlob) *AttachmentLinkCreate {
+	return _c.SetBlobID(v.ID)
+}
+
+// Mutation returns the AttachmentLinkMutation object of the builder.
+func (_c *AttachmentLinkCreate) Mutation() *AttachmentLinkMutation {
+	return _c.mutation
+}
+
+// Save creates the AttachmentLink in the database.
+func (_c *AttachmentLinkCreate) Save(ctx context.Context) (*AttachmentLink, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AttachmentLinkCreate) SaveX(ctx context.Context) *AttachmentLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AttachmentLinkCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AttachmentLinkCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AttachmentLinkCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := attachmentlink.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AttachmentLinkCreate) check() error {
+	if _, ok := _c.mutation.BlobID(); !ok {
+		return &ValidationError{Name: "blob_id", err: errors.New(`ent: missing required field "AttachmentLink.blob_id"`)}
+	}
+	if v, ok := _c.mutation.BlobID(); ok {
+		if err := attachmentlink.BlobIDValidator(v); err != nil {
+			return &ValidationError{Name: "blob_id", err: fmt.Errorf(`ent: validator failed for field "AttachmentLink.blob_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ConnectionID(); !ok {
+		return &ValidationError{Name: "connection_id", err: errors.New(`ent: missing required field "AttachmentLink.connection_id"`)}
+	}
+	if v, ok := _c.mutation.ConnectionID(); ok {
+		if err := attachmentlink.ConnectionIDValidator(v); err != nil {
+			return &ValidationError{Name: "connection_id", err: fmt.Errorf(`ent: validator failed for field "AttachmentLink.connection_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "AttachmentLink.message_id"`)}
+	}
+	if v, ok := _c.mutation.MessageID(); ok {
+		if err := attachmentlink.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "AttachmentLink.message_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AttachmentID(); !ok {
+		return &ValidationError{Name: "attachment_id", err: errors.New(`ent: missing required field "AttachmentLink.attachment_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AttachmentLink.created_at"`)}
+	}
+	if len(_c.mutation.BlobIDs()) == 0 {
+		return &ValidationError{Name: "blob", err: errors.New(`ent: missing required edge "AttachmentLink.blob"`)}
+	}
+	return nil
+}
+
+func (_c *AttachmentLinkCreate) sqlSave(ctx context.Context) (*AttachmentLink, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected AttachmentLink.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AttachmentLinkCreate) createSpec() (*AttachmentLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AttachmentLink{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(attachmentlink.Table, sqlgraph.NewFieldSpec(attachmentlink.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ConnectionID(); ok {
+		_spec.SetField(attachmentlink.FieldConnectionID, field.TypeString, value)
+		_node.ConnectionID = value
+	}
+	if value, ok := _c.mutation.MessageID(); ok {
+		_spec.SetField(attachmentlink.FieldMessageID, field.TypeString, value)
+		_node.MessageID = value
+	}
+	if value, ok := _c.mutation.AttachmentID(); ok {
+		_spec.SetField(attachmentlink.FieldAttachmentID, field.TypeString, value)
+		_node.AttachmentID = value
+	}
+	if value, ok := _c.mutation.Filename(); ok {
+		_spec.SetField(attachmentlink.FieldFilename, field.TypeString, value)
+		_node.Filename = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(attachmentlink.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.BlobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachmentlink.BlobTable,
+			Columns: []string{attachmentlink.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachmentblob.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BlobID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AttachmentLink.Create().
+//		SetBlobID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AttachmentLinkUpsert) {
+//			SetBlobID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AttachmentLinkCreate) OnConflict(opts ...sql.ConflictOption) *AttachmentLinkUpsertOne {
+	_c.conflict = opts
+	return &AttachmentLinkUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AttachmentLink.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AttachmentLinkCreate) OnConflictColumns(columns ...string) *AttachmentLinkUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AttachmentLinkUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AttachmentLinkUpsertOne is the builder for "upsert"-ing
+	//  one AttachmentLink node.
+	AttachmentLinkUpsertOne struct {
+		create *AttachmentLinkCreate
+	}
+
+	// AttachmentLinkUpsert is the "OnConflict" setter.
+	AttachmentLinkUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetBlobID sets the "blob_id" field.
+func (u *AttachmentLinkUpsert) SetBlobID(v string) *AttachmentLinkUpsert {
+	u.Set(attachmentlink.FieldBlobID, v)
+	return u
+}
+
+// UpdateBlobID sets the "blob_id" field to the value that was provided on create.
+func (u *AttachmentLinkUpsert) UpdateBlobID() *AttachmentLinkUpsert {
+	u.SetExcluded(attachmentlink.FieldBlobID)
+	return u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (u *AttachmentLinkUpsert) SetConnectionID(v string) *AttachmentLinkUpsert {
+	u.Set(attachmentlink.FieldConnectionID, v)
+	return u
+}
+
+// UpdateConnectionID sets the "connection_id" field to the value that was provided on create.
+func (u *AttachmentLinkUpsert) UpdateConnectionID() *AttachmentLinkUpsert {
+	u.SetExcluded(attachmentlink.FieldConnectionID)
+	return u
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *AttachmentLinkUpsert) SetMessageID(v string) *AttachmentLinkUpsert {
+	u.Set(attachmentlink.FieldMessageID, v)
+	return u
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *AttachmentLinkUpsert) UpdateMessageID() *AttachmentLinkUpsert {
+	u.SetExcluded(attachmentlink.FieldMessageID)
+	return u
+}
+
+// SetAttachmentID sets the "attachment_id" field.
+func (u *AttachmentLinkUpsert) SetAttachmentID(v string) *AttachmentLinkUpsert {
+	u.Set(attachmentlink.FieldAttachmentID, v)
+	return u
+}
+
+// UpdateAttachmentID sets the "attachment_id" field to the value that was provided on create.
+func (u *AttachmentLinkUpsert) UpdateAttachmentID() *AttachmentLinkUpsert {
+	u.SetExcluded(attachmentlink.FieldAttachmentID)
+	return u
+}
+
+// SetFilename sets the "filename" field.
+func (u *AttachmentLinkUpsert) SetFilename(v string) *AttachmentLinkUpsert {
+	u.Set(attachmentlink.FieldFilename, v)
+	return u
+}
+
+// UpdateFilename sets the "filename" field to the value that was provided on create.
+func (u *AttachmentLinkUpsert) UpdateFilename() *AttachmentLinkUpsert {
+	u.SetExcluded(attachmentlink.FieldFilename)
+	return u
+}
+
+// ClearFilename clears the value of the "filename" field.
+func (u *AttachmentLinkUpsert) ClearFilename() *AttachmentLinkUpsert {
+	u.SetNull(attachmentlink.FieldFilename)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AttachmentLink.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(attachmentlink.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AttachmentLinkUpsertOne) UpdateNewValues() *AttachmentLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(attachmentlink.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(attachmentlink.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AttachmentLink.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AttachmentLinkUpsertOne) Ignore() *AttachmentLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AttachmentLinkUpsertOne) DoNothing() *AttachmentLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AttachmentLinkCreate.OnConflict
+// documentation for more info.
+func (u *AttachmentLinkUpsertOne) Update(set func(*AttachmentLinkUpsert)) *AttachmentLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AttachmentLinkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetBlobID sets the "blob_id" field.
+func (u *AttachmentLinkUpsertOne) SetBlobID(v string) *AttachmentLinkUpsertOne {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.SetBlobID(v)
+	})
+}
+
+// UpdateBlobID sets the "blob_id" field to the value that was provided on create.
+func (u *AttachmentLinkUpsertOne) UpdateBlobID() *AttachmentLinkUpsertOne {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.UpdateBlobID()
+	})
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (u *AttachmentLinkUpsertOne) SetConnectionID(v string) *AttachmentLinkUpsertOne {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.SetConnectionID(v)
+	})
+}
+
+// UpdateConnectionID sets the "connection_id" field to the value that was provided on create.
+func (u *AttachmentLinkUpsertOne) UpdateConnectionID() *AttachmentLinkUpsertOne {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.UpdateConnectionID()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *AttachmentLinkUpsertOne) SetMessageID(v string) *AttachmentLinkUpsertOne {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *AttachmentLinkUpsertOne) UpdateMessageID() *AttachmentLinkUpsertOne {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetAttachmentID sets the "attachment_id" field.
+func (u *AttachmentLinkUpsertOne) SetAttachmentID(v string) *AttachmentLinkUpsertOne {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.SetAttachmentID(v)
+	})
+}
+
+// UpdateAttachmentID sets the "attachment_id" field to the value that was provided on create.
+func (u *AttachmentLinkUpsertOne) UpdateAttachmentID() *AttachmentLinkUpsertOne {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.UpdateAttachmentID()
+	})
+}
+
+// SetFilename sets the "filename" field.
+func (u *AttachmentLinkUpsertOne) SetFilename(v string) *AttachmentLinkUpsertOne {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.SetFilename(v)
+	})
+}
+
+// UpdateFilename sets the "filename" field to the value that was provided on create.
+func (u *AttachmentLinkUpsertOne) UpdateFilename() *AttachmentLinkUpsertOne {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.UpdateFilename()
+	})
+}
+
+// ClearFilename clears the value of the "filename" field.
+func (u *AttachmentLinkUpsertOne) ClearFilename() *AttachmentLinkUpsertOne {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.ClearFilename()
+	})
+}
+
+// Exec executes the query.
+func (u *AttachmentLinkUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AttachmentLinkCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AttachmentLinkUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AttachmentLinkUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AttachmentLinkUpsertOne.ID is not supported by MySQL driver. Use AttachmentLinkUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AttachmentLinkUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AttachmentLinkCreateBulk is the builder for creating many AttachmentLink entities in bulk.
+type AttachmentLinkCreateBulk struct {
+	config
+	err      error
+	builders []*AttachmentLinkCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AttachmentLink entities in the database.
+func (_c *AttachmentLinkCreateBulk) Save(ctx context.Context) ([]*AttachmentLink, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AttachmentLink, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AttachmentLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AttachmentLinkCreateBulk) SaveX(ctx context.Context) []*AttachmentLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AttachmentLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AttachmentLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AttachmentLink.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AttachmentLinkUpsert) {
+//			SetBlobID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AttachmentLinkCreateBulk) OnConflict(opts ...sql.ConflictOption) *AttachmentLinkUpsertBulk {
+	_c.conflict = opts
+	return &AttachmentLinkUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AttachmentLink.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AttachmentLinkCreateBulk) OnConflictColumns(columns ...string) *AttachmentLinkUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AttachmentLinkUpsertBulk{
+		create: _c,
+	}
+}
+
+// AttachmentLinkUpsertBulk is the builder for "upsert"-ing
+// a bulk of AttachmentLink nodes.
+type AttachmentLinkUpsertBulk struct {
+	create *AttachmentLinkCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AttachmentLink.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(attachmentlink.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AttachmentLinkUpsertBulk) UpdateNewValues() *AttachmentLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(attachmentlink.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(attachmentlink.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AttachmentLink.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AttachmentLinkUpsertBulk) Ignore() *AttachmentLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AttachmentLinkUpsertBulk) DoNothing() *AttachmentLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AttachmentLinkCreateBulk.OnConflict
+// documentation for more info.
+func (u *AttachmentLinkUpsertBulk) Update(set func(*AttachmentLinkUpsert)) *AttachmentLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AttachmentLinkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetBlobID sets the "blob_id" field.
+func (u *AttachmentLinkUpsertBulk) SetBlobID(v string) *AttachmentLinkUpsertBulk {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.SetBlobID(v)
+	})
+}
+
+// UpdateBlobID sets the "blob_id" field to the value that was provided on create.
+func (u *AttachmentLinkUpsertBulk) UpdateBlobID() *AttachmentLinkUpsertBulk {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.UpdateBlobID()
+	})
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (u *AttachmentLinkUpsertBulk) SetConnectionID(v string) *AttachmentLinkUpsertBulk {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.SetConnectionID(v)
+	})
+}
+
+// UpdateConnectionID sets the "connection_id" field to the value that was provided on create.
+func (u *AttachmentLinkUpsertBulk) UpdateConnectionID() *AttachmentLinkUpsertBulk {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.UpdateConnectionID()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *AttachmentLinkUpsertBulk) SetMessageID(v string) *AttachmentLinkUpsertBulk {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *AttachmentLinkUpsertBulk) UpdateMessageID() *AttachmentLinkUpsertBulk {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetAttachmentID sets the "attachment_id" field.
+func (u *AttachmentLinkUpsertBulk) SetAttachmentID(v string) *AttachmentLinkUpsertBulk {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.SetAttachmentID(v)
+	})
+}
+
+// UpdateAttachmentID sets the "attachment_id" field to the value that was provided on create.
+func (u *AttachmentLinkUpsertBulk) UpdateAttachmentID() *AttachmentLinkUpsertBulk {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.UpdateAttachmentID()
+	})
+}
+
+// SetFilename sets the "filename" field.
+func (u *AttachmentLinkUpsertBulk) SetFilename(v string) *AttachmentLinkUpsertBulk {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.SetFilename(v)
+	})
+}
+
+// UpdateFilename sets the "filename" field to the value that was provided on create.
+func (u *AttachmentLinkUpsertBulk) UpdateFilename() *AttachmentLinkUpsertBulk {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.UpdateFilename()
+	})
+}
+
+// ClearFilename clears the value of the "filename" field.
+func (u *AttachmentLinkUpsertBulk) ClearFilename() *AttachmentLinkUpsertBulk {
+	return u.Update(func(s *AttachmentLinkUpsert) {
+		s.ClearFilename()
+	})
+}
+
+// Exec executes the query.
+func (u *AttachmentLinkUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AttachmentLinkCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AttachmentLinkCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AttachmentLinkUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,490 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/attachmentblob"
+	"clockzen-next/internal/ent/attachmentlink"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttachmentLinkUpdate is the builder for updating AttachmentLink entities.
+type AttachmentLinkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AttachmentLinkMutation
+}
+
+// Where appends a list predicates to the AttachmentLinkUpdate builder.
+func (_u *AttachmentLinkUpdate) Where(ps ...predicate.AttachmentLink) *AttachmentLinkUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetBlobID sets the "blob_id" field.
+func (_u *AttachmentLinkUpdate) SetBlobID(v string) *AttachmentLinkUpdate {
+	_u.mutation.SetBlobID(v)
+	return _u
+}
+
+// SetNillableBlobID sets the "blob_id" field if the given value is not nil.
+func (_u *AttachmentLinkUpdate) SetNillableBlobID(v *string) *AttachmentLinkUpdate {
+	if v != nil {
+		_u.SetBlobID(*v)
+	}
+	return _u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_u *AttachmentLinkUpdate) SetConnectionID(v string) *AttachmentLinkUpdate {
+	_u.mutation.SetConnectionID(v)
+	return _u
+}
+
+// SetNillableConnectionID sets the "connection_id" field if the given value is not nil.
+func (_u *AttachmentLinkUpdate) SetNillableConnectionID(v *string) *AttachmentLinkUpdate {
+	if v != nil {
+		_u.SetConnectionID(*v)
+	}
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *AttachmentLinkUpdate) SetMessageID(v string) *AttachmentLinkUpdate {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *AttachmentLinkUpdate) SetNillableMessageID(v *string) *AttachmentLinkUpdate {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetAttachmentID sets the "attachment_id" field.
+func (_u *AttachmentLinkUpdate) SetAttachmentID(v string) *AttachmentLinkUpdate {
+	_u.mutation.SetAttachmentID(v)
+	return _u
+}
+
+// SetNillableAttachmentID sets the "attachment_id" field if the given value is not nil.
+func (_u *AttachmentLinkUpdate) SetNillableAttachmentID(v *string) *AttachmentLinkUpdate {
+	if v != nil {
+		_u.SetAttachmentID(*v)
+	}
+	return _u
+}
+
+// SetFilename sets the "filename" field.
+func (_u *AttachmentLinkUpdate) SetFilename(v string) *AttachmentLinkUpdate {
+	_u.mutation.SetFilename(v)
+	return _u
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_u *AttachmentLinkUpdate) SetNillableFilename(v *string) *AttachmentLinkUpdate {
+	if v != nil {
+		_u.SetFilename(*v)
+	}
+	return _u
+}
+
+// ClearFilename clears the value of the "filename" field.
+func (_u *AttachmentLinkUpdate) ClearFilename() *AttachmentLinkUpdate {
+	_u.mutation.ClearFilename()
+	return _u
+}
+
+// SetBlob sets the "blob" edge to the AttachmentBlob entity.
+func (_u *AttachmentLinkUpdate) SetBlob(v *AttachmentBlob) *AttachmentLinkUpdate {
+	return _u.SetBlobID(v.ID)
+}
+
+// Mutation returns the AttachmentLinkMutation object of the builder.
+func (_u *AttachmentLinkUpdate) Mutation() *AttachmentLinkMutation {
+	return _u.mutation
+}
+
+// ClearBlob clears the "blob" edge to the AttachmentBlob entity.
+func (_u *AttachmentLinkUpdate) ClearBlob() *AttachmentLinkUpdate {
+	_u.mutation.ClearBlob()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AttachmentLinkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AttachmentLinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AttachmentLinkUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AttachmentLinkUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AttachmentLinkUpdate) check() error {
+	if v, ok := _u.mutation.BlobID(); ok {
+		if err := attachmentlink.BlobIDValidator(v); err != nil {
+			return &ValidationError{Name: "blob_id", err: fmt.Errorf(`ent: validator failed for field "AttachmentLink.blob_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ConnectionID(); ok {
+		if err := attachmentlink.ConnectionIDValidator(v); err != nil {
+			return &ValidationError{Name: "connection_id", err: fmt.Errorf(`ent: validator failed for field "AttachmentLink.connection_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MessageID(); ok {
+		if err := attachmentlink.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "AttachmentLink.message_id": %w`, err)}
+		}
+	}
+	if _u.mutation.BlobCleared() && len(_u.mutation.BlobIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttachmentLink.blob"`)
+	}
+	return nil
+}
+
+func (_u *AttachmentLinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attachmentlink.Table, attachmentlink.Columns, sqlgraph.NewFieldSpec(attachmentlink.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ConnectionID(); ok {
+		_spec.SetField(attachmentlink.FieldConnectionID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(attachmentlink.FieldMessageID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AttachmentID(); ok {
+		_spec.SetField(attachmentlink.FieldAttachmentID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Filename(); ok {
+		_spec.SetField(attachmentlink.FieldFilename, field.TypeString, value)
+	}
+	if _u.mutation.FilenameCleared() {
+		_spec.ClearField(attachmentlink.FieldFilename, field.TypeString)
+	}
+	if _u.mutation.BlobCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachmentlink.BlobTable,
+			Columns: []string{attachmentlink.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachmentblob.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachmentlink.BlobTable,
+			Columns: []string{attachmentlink.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachmentblob.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachmentlink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AttachmentLinkUpdateOne is the builder for updating a single AttachmentLink entity.
+type AttachmentLinkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AttachmentLinkMutation
+}
+
+// SetBlobID sets the "blob_id" field.
+func (_u *AttachmentLinkUpdateOne) SetBlobID(v string) *AttachmentLinkUpdateOne {
+	_u.mutation.SetBlobID(v)
+	return _u
+}
+
+// SetNillableBlobID sets the "blob_id" field if the given value is not nil.
+func (_u *AttachmentLinkUpdateOne) SetNillableBlobID(v *string) *AttachmentLinkUpdateOne {
+	if v != nil {
+		_u.SetBlobID(*v)
+	}
+	return _u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_u *AttachmentLinkUpdateOne) SetConnectionID(v string) *AttachmentLinkUpdateOne {
+	_u.mutation.SetConnectionID(v)
+	return _u
+}
+
+// SetNillableConnectionID sets the "connection_id" field if the given value is not nil.
+func (_u *AttachmentLinkUpdateOne) SetNillableConnectionID(v *string) *AttachmentLinkUpdateOne {
+	if v != nil {
+		_u.SetConnectionID(*v)
+	}
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *AttachmentLinkUpdateOne) SetMessageID(v string) *AttachmentLinkUpdateOne {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *AttachmentLinkUpdateOne) SetNillableMessageID(v *string) *AttachmentLinkUpdateOne {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetAttachmentID sets the "attachment_id" field.
+func (_u *AttachmentLinkUpdateOne) SetAttachmentID(v string) *AttachmentLinkUpdateOne {
+	_u.mutation.SetAttachmentID(v)
+	return _u
+}
+
+// SetNillableAttachmentID sets the "attachment_id" field if the given value is not nil.
+func (_u *AttachmentLinkUpdateOne) SetNillableAttachmentID(v *string) *AttachmentLinkUpdateOne {
+	if v != nil {
+		_u.SetAttachmentID(*v)
+	}
+	return _u
+}
+
+// SetFilename sets the "filename" field.
+func (_u *AttachmentLinkUpdateOne) SetFilename(v string) *AttachmentLinkUpdateOne {
+	_u.mutation.SetFilename(v)
+	return _u
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_u *AttachmentLinkUpdateOne) SetNillableFilename(v *string) *AttachmentLinkUpdateOne {
+	if v != nil {
+		_u.SetFilename(*v)
+	}
+	return _u
+}
+
+// ClearFilename clears the value of the "filename" field.
+func (_u *AttachmentLinkUpdateOne) ClearFilename() *AttachmentLinkUpdateOne {
+	_u.mutation.ClearFilename()
+	return _u
+}
+
+// SetBlob sets the "blob" edge to the AttachmentBlob entity.
+func (_u *AttachmentLinkUpdateOne) SetBlob(v *AttachmentBlob) *AttachmentLinkUpdateOne {
+	return _u.SetBlobID(v.ID)
+}
+
+// Mutation returns the AttachmentLinkMutation object of the builder.
+func (_u *AttachmentLinkUpdateOne) Mutation() *AttachmentLinkMutation {
+	return _u.mutation
+}
+
+// ClearBlob clears the "blob" edge to the AttachmentBlob entity.
+func (_u *AttachmentLinkUpdateOne) ClearBlob() *AttachmentLinkUpdateOne {
+	_u.mutation.ClearBlob()
+	return _u
+}
+
+// Where appends a list predicates to the AttachmentLinkUpdate builder.
+func (_u *AttachmentLinkUpdateOne) Where(ps ...predicate.AttachmentLink) *AttachmentLinkUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AttachmentLinkUpdateOne) Select(field string, fields ...string) *AttachmentLinkUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AttachmentLink entity.
+func (_u *AttachmentLinkUpdateOne) Save(ctx context.Context) (*AttachmentLink, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AttachmentLinkUpdateOne) SaveX(ctx context.Context) *AttachmentLink {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AttachmentLinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AttachmentLinkUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AttachmentLinkUpdateOne) check() error {
+	if v, ok := _u.mutation.BlobID(); ok {
+		if err := attachmentlink.BlobIDValidator(v); err != nil {
+			return &ValidationError{Name: "blob_id", err: fmt.Errorf(`ent: validator failed for field "AttachmentLink.blob_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ConnectionID(); ok {
+		if err := attachmentlink.ConnectionIDValidator(v); err != nil {
+			return &ValidationError{Name: "connection_id", err: fmt.Errorf(`ent: validator failed for field "AttachmentLink.connection_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MessageID(); ok {
+		if err := attachmentlink.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "AttachmentLink.message_id": %w`, err)}
+		}
+	}
+	if _u.mutation.BlobCleared() && len(_u.mutation.BlobIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttachmentLink.blob"`)
+	}
+	return nil
+}
+
+func (_u *AttachmentLinkUpdateOne) sqlSave(ctx context.Context) (_node *AttachmentLink, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attachmentlink.Table, attachmentlink.Columns, sqlgraph.NewFieldSpec(attachmentlink.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AttachmentLink.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attachmentlink.FieldID)
+		for _, f := range fields {
+			if !attachmentlink.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attachmentlink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ConnectionID(); ok {
+		_spec.SetField(attachmentlink.FieldConnectionID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(attachmentlink.FieldMessageID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AttachmentID(); ok {
+		_spec.SetField(attachmentlink.FieldAttachmentID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Filename(); ok {
+		_spec.SetField(attachmentlink.FieldFilename, field.TypeString, value)
+	}
+	if _u.mutation.FilenameCleared() {
+		_spec.ClearField(attachmentlink.FieldFilename, field.TypeString)
+	}
+	if _u.mutation.BlobCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachmentlink.BlobTable,
+			Columns: []string{attachmentlink.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachmentblob.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachmentlink.BlobTable,
+			Columns: []string{attachmentlink.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachmentblob.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AttachmentLink{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachmentlink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/budgetreallocation"
+	"clockzen-next/internal/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BudgetReallocation is the model entity for the BudgetReallocation schema.
+type BudgetReallocation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ID of the user who moved the money
+	UserID string `json:"user_id,omitempty"`
+	// ID of the organization whose budget was changed; unset for the user's own budgets
+	OrganizationID *string `json:"organization_id,omitempty"`
+	// ID of the budget the reallocation applies to, as sent with backtests
+	BudgetID string `json:"budget_id,omitempty"`
+	// Budget category the amount was taken from
+	FromCategory string `json:"from_category,omitempty"`
+	// Budget category the amount was moved to
+	ToCategory string `json:"to_category,omitempty"`
+	// Amount moved between the categories
+	Amount float64 `json:"amount,omitempty"`
+	// The reallocation applies to the budget period containing this date
+	EffectiveDate time.Time `json:"effective_date,omitempty"`
+	// Why the money was moved
+	Note *string `json:"note,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BudgetReallocationQuery when eager-loading is set.
+	Edges        BudgetReallocationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BudgetReallocationEdges holds the relations/edges for other nodes in the graph.
+type BudgetReallocationEdges struct {
+	// The user who moved the money
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BudgetReallocationEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BudgetReallocation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case budgetreallocation.FieldAmount:
+			values[i] = new(sql.NullFloat64)
+		case budgetreallocation.FieldID, budgetreallocation.FieldUserID, budgetreallocation.FieldOrganizationID, budgetreallocation.FieldBudgetID, budgetreallocation.FieldFromCategory, budgetreallocation.FieldToCategory, budgetreallocation.FieldNote:
+			values[i] = new(sql.NullString)
+		case budgetreallocation.FieldEffectiveDate, budgetreallocation.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BudgetReallocation fields.
+func (_m *BudgetReallocation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case budgetreallocation.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case budgetreallocation.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case budgetreallocation.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				_m.OrganizationID = new(string)
+				*_m.OrganizationID = value.String
+			}
+		case budgetreallocation.FieldBudgetID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field budget_id", values[i])
+			} else if value.Valid {
+				_m.BudgetID = value.String
+			}
+		case budgetreallocation.FieldFromCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_category", values[i])
+			} else if value.Valid {
+				_m.FromCategory = value.String
+			}
+		case budgetreallocation.FieldToCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_category", values[i])
+			} else if value.Valid {
+				_m.ToCategory = value.String
+			}
+		case budgetreallocation.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				_m.Amount = value.Float64
+			}
+		case budgetreallocation.FieldEffectiveDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_date", values[i])
+			} else if value.Valid {
+				_m.EffectiveDate = value.Time
+			}
+		case budgetreallocation.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = new(string)
+				*_m.Note = value.String
+			}
+		case budgetreallocation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BudgetReallocation.
+// This includes values selected through modifiers, order, etc.
+func (_m *BudgetReallocation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the BudgetReallocation entity.
+func (_m *BudgetReallocation) QueryUser() *UserQuery {
+	return NewBudgetReallocationClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this BudgetReallocation.
+// Note that you need to call BudgetReallocation.Unwrap() before calling this method if this BudgetReallocation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BudgetReallocation) Update() *BudgetReallocationUpdateOne {
+	return NewBudgetReallocationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BudgetReallocation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BudgetReallocation) Unwrap() *BudgetReallocation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BudgetReallocation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BudgetReallocation) String() string {
+	var builder strings.Builder
+	builder.WriteString("BudgetReallocation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	if v := _m.OrganizationID; v != nil {
+		builder.WriteString("organization_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("budget_id=")
+	builder.WriteString(_m.BudgetID)
+	builder.WriteString(", ")
+	builder.WriteString("from_category=")
+	builder.WriteString(_m.FromCategory)
+	builder.WriteString(", ")
+	builder.WriteString("to_category=")
+	builder.WriteString(_m.ToCategory)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("effective_date=")
+	builder.WriteString(_m.EffectiveDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.Note; v != nil {
+		builder.WriteString("note=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BudgetReallocations is a parsable slice of BudgetReallocation.
+type BudgetReallocations []*BudgetReallocation
@@ -0,0 +1,152 @@
+// Code generated by ent, DO NOT EDIT.
+
+package budgetreallocation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the budgetreallocation type in the database.
+	Label = "budget_reallocation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldOrganizationID holds the string denoting the organization_id field in the database.
+	FieldOrganizationID = "organization_id"
+	// FieldBudgetID holds the string denoting the budget_id field in the database.
+	FieldBudgetID = "budget_id"
+	// FieldFromCategory holds the string denoting the from_category field in the database.
+	FieldFromCategory = "from_category"
+	// FieldToCategory holds the string denoting the to_category field in the database.
+	FieldToCategory = "to_category"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldEffectiveDate holds the string denoting the effective_date field in the database.
+	FieldEffectiveDate = "effective_date"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the budgetreallocation in the database.
+	Table = "budget_reallocations"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "budget_reallocations"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+)
+
+// Columns holds all SQL columns for budgetreallocation fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldOrganizationID,
+	FieldBudgetID,
+	FieldFromCategory,
+	FieldToCategory,
+	FieldAmount,
+	FieldEffectiveDate,
+	FieldNote,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// BudgetIDValidator is a validator for the "budget_id" field. It is called by the builders before save.
+	BudgetIDValidator func(string) error
+	// FromCategoryValidator is a validator for the "from_category" field. It is called by the builders before save.
+	FromCategoryValidator func(string) error
+	// ToCategoryValidator is a validator for the "to_category" field. It is called by the builders before save.
+	ToCategoryValidator func(string) error
+	// AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	AmountValidator func(float64) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the BudgetReallocation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByOrganizationID orders the results by the organization_id field.
+func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
+}
+
+// ByBudgetID orders the results by the budget_id field.
+func ByBudgetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBudgetID, opts...).ToFunc()
+}
+
+// ByFromCategory orders the results by the from_category field.
+func ByFromCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromCategory, opts...).ToFunc()
+}
+
+// ByToCategory orders the results by the to_category field.
+func ByToCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToCategory, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByEffectiveDate orders the results by the effective_date field.
+func ByEffectiveDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEffectiveDate, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
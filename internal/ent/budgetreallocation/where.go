@@ -0,0 +1,679 @@
+// Code generated by ent, DO NOT EDIT.
+
+package budgetreallocation
+
+import (
+	"clockzen-next/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldContainsFold(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEQ(FieldUserID, v))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// BudgetID applies equality check predicate on the "budget_id" field. It's identical to BudgetIDEQ.
+func BudgetID(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEQ(FieldBudgetID, v))
+}
+
+// FromCategory applies equality check predicate on the "from_category" field. It's identical to FromCategoryEQ.
+func FromCategory(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEQ(FieldFromCategory, v))
+}
+
+// ToCategory applies equality check predicate on the "to_category" field. It's identical to ToCategoryEQ.
+func ToCategory(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEQ(FieldToCategory, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEQ(FieldAmount, v))
+}
+
+// EffectiveDate applies equality check predicate on the "effective_date" field. It's identical to EffectiveDateEQ.
+func EffectiveDate(v time.Time) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEQ(FieldEffectiveDate, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEQ(FieldNote, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDGT applies the GT predicate on the "organization_id" field.
+func OrganizationIDGT(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldGT(FieldOrganizationID, v))
+}
+
+// OrganizationIDGTE applies the GTE predicate on the "organization_id" field.
+func OrganizationIDGTE(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldGTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDLT applies the LT predicate on the "organization_id" field.
+func OrganizationIDLT(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldLT(FieldOrganizationID, v))
+}
+
+// OrganizationIDLTE applies the LTE predicate on the "organization_id" field.
+func OrganizationIDLTE(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldLTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDContains applies the Contains predicate on the "organization_id" field.
+func OrganizationIDContains(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldContains(FieldOrganizationID, v))
+}
+
+// OrganizationIDHasPrefix applies the HasPrefix predicate on the "organization_id" field.
+func OrganizationIDHasPrefix(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldHasPrefix(FieldOrganizationID, v))
+}
+
+// OrganizationIDHasSuffix applies the HasSuffix predicate on the "organization_id" field.
+func OrganizationIDHasSuffix(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldHasSuffix(FieldOrganizationID, v))
+}
+
+// OrganizationIDIsNil applies the IsNil predicate on the "organization_id" field.
+func OrganizationIDIsNil() predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldIsNull(FieldOrganizationID))
+}
+
+// OrganizationIDNotNil applies the NotNil predicate on the "organization_id" field.
+func OrganizationIDNotNil() predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNotNull(FieldOrganizationID))
+}
+
+// OrganizationIDEqualFold applies the EqualFold predicate on the "organization_id" field.
+func OrganizationIDEqualFold(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEqualFold(FieldOrganizationID, v))
+}
+
+// OrganizationIDContainsFold applies the ContainsFold predicate on the "organization_id" field.
+func OrganizationIDContainsFold(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldContainsFold(FieldOrganizationID, v))
+}
+
+// BudgetIDEQ applies the EQ predicate on the "budget_id" field.
+func BudgetIDEQ(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEQ(FieldBudgetID, v))
+}
+
+// BudgetIDNEQ applies the NEQ predicate on the "budget_id" field.
+func BudgetIDNEQ(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNEQ(FieldBudgetID, v))
+}
+
+// BudgetIDIn applies the In predicate on the "budget_id" field.
+func BudgetIDIn(vs ...string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldIn(FieldBudgetID, vs...))
+}
+
+// BudgetIDNotIn applies the NotIn predicate on the "budget_id" field.
+func BudgetIDNotIn(vs ...string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNotIn(FieldBudgetID, vs...))
+}
+
+// BudgetIDGT applies the GT predicate on the "budget_id" field.
+func BudgetIDGT(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldGT(FieldBudgetID, v))
+}
+
+// BudgetIDGTE applies the GTE predicate on the "budget_id" field.
+func BudgetIDGTE(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldGTE(FieldBudgetID, v))
+}
+
+// BudgetIDLT applies the LT predicate on the "budget_id" field.
+func BudgetIDLT(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldLT(FieldBudgetID, v))
+}
+
+// BudgetIDLTE applies the LTE predicate on the "budget_id" field.
+func BudgetIDLTE(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldLTE(FieldBudgetID, v))
+}
+
+// BudgetIDContains applies the Contains predicate on the "budget_id" field.
+func BudgetIDContains(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldContains(FieldBudgetID, v))
+}
+
+// BudgetIDHasPrefix applies the HasPrefix predicate on the "budget_id" field.
+func BudgetIDHasPrefix(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldHasPrefix(FieldBudgetID, v))
+}
+
+// BudgetIDHasSuffix applies the HasSuffix predicate on the "budget_id" field.
+func BudgetIDHasSuffix(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldHasSuffix(FieldBudgetID, v))
+}
+
+// BudgetIDEqualFold applies the EqualFold predicate on the "budget_id" field.
+func BudgetIDEqualFold(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEqualFold(FieldBudgetID, v))
+}
+
+// BudgetIDContainsFold applies the ContainsFold predicate on the "budget_id" field.
+func BudgetIDContainsFold(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldContainsFold(FieldBudgetID, v))
+}
+
+// FromCategoryEQ applies the EQ predicate on the "from_category" field.
+func FromCategoryEQ(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEQ(FieldFromCategory, v))
+}
+
+// FromCategoryNEQ applies the NEQ predicate on the "from_category" field.
+func FromCategoryNEQ(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNEQ(FieldFromCategory, v))
+}
+
+// FromCategoryIn applies the In predicate on the "from_category" field.
+func FromCategoryIn(vs ...string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldIn(FieldFromCategory, vs...))
+}
+
+// FromCategoryNotIn applies the NotIn predicate on the "from_category" field.
+func FromCategoryNotIn(vs ...string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNotIn(FieldFromCategory, vs...))
+}
+
+// FromCategoryGT applies the GT predicate on the "from_category" field.
+func FromCategoryGT(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldGT(FieldFromCategory, v))
+}
+
+// FromCategoryGTE applies the GTE predicate on the "from_category" field.
+func FromCategoryGTE(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldGTE(FieldFromCategory, v))
+}
+
+// FromCategoryLT applies the LT predicate on the "from_category" field.
+func FromCategoryLT(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldLT(FieldFromCategory, v))
+}
+
+// FromCategoryLTE applies the LTE predicate on the "from_category" field.
+func FromCategoryLTE(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldLTE(FieldFromCategory, v))
+}
+
+// FromCategoryContains applies the Contains predicate on the "from_category" field.
+func FromCategoryContains(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldContains(FieldFromCategory, v))
+}
+
+// FromCategoryHasPrefix applies the HasPrefix predicate on the "from_category" field.
+func FromCategoryHasPrefix(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldHasPrefix(FieldFromCategory, v))
+}
+
+// FromCategoryHasSuffix applies the HasSuffix predicate on the "from_category" field.
+func FromCategoryHasSuffix(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldHasSuffix(FieldFromCategory, v))
+}
+
+// FromCategoryEqualFold applies the EqualFold predicate on the "from_category" field.
+func FromCategoryEqualFold(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEqualFold(FieldFromCategory, v))
+}
+
+// FromCategoryContainsFold applies the ContainsFold predicate on the "from_category" field.
+func FromCategoryContainsFold(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldContainsFold(FieldFromCategory, v))
+}
+
+// ToCategoryEQ applies the EQ predicate on the "to_category" field.
+func ToCategoryEQ(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEQ(FieldToCategory, v))
+}
+
+// ToCategoryNEQ applies the NEQ predicate on the "to_category" field.
+func ToCategoryNEQ(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNEQ(FieldToCategory, v))
+}
+
+// ToCategoryIn applies the In predicate on the "to_category" field.
+func ToCategoryIn(vs ...string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldIn(FieldToCategory, vs...))
+}
+
+// ToCategoryNotIn applies the NotIn predicate on the "to_category" field.
+func ToCategoryNotIn(vs ...string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNotIn(FieldToCategory, vs...))
+}
+
+// ToCategoryGT applies the GT predicate on the "to_category" field.
+func ToCategoryGT(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldGT(FieldToCategory, v))
+}
+
+// ToCategoryGTE applies the GTE predicate on the "to_category" field.
+func ToCategoryGTE(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldGTE(FieldToCategory, v))
+}
+
+// ToCategoryLT applies the LT predicate on the "to_category" field.
+func ToCategoryLT(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldLT(FieldToCategory, v))
+}
+
+// ToCategoryLTE applies the LTE predicate on the "to_category" field.
+func ToCategoryLTE(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldLTE(FieldToCategory, v))
+}
+
+// ToCategoryContains applies the Contains predicate on the "to_category" field.
+func ToCategoryContains(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldContains(FieldToCategory, v))
+}
+
+// ToCategoryHasPrefix applies the HasPrefix predicate on the "to_category" field.
+func ToCategoryHasPrefix(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldHasPrefix(FieldToCategory, v))
+}
+
+// ToCategoryHasSuffix applies the HasSuffix predicate on the "to_category" field.
+func ToCategoryHasSuffix(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldHasSuffix(FieldToCategory, v))
+}
+
+// ToCategoryEqualFold applies the EqualFold predicate on the "to_category" field.
+func ToCategoryEqualFold(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEqualFold(FieldToCategory, v))
+}
+
+// ToCategoryContainsFold applies the ContainsFold predicate on the "to_category" field.
+func ToCategoryContainsFold(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldContainsFold(FieldToCategory, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldLTE(FieldAmount, v))
+}
+
+// EffectiveDateEQ applies the EQ predicate on the "effective_date" field.
+func EffectiveDateEQ(v time.Time) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEQ(FieldEffectiveDate, v))
+}
+
+// EffectiveDateNEQ applies the NEQ predicate on the "effective_date" field.
+func EffectiveDateNEQ(v time.Time) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNEQ(FieldEffectiveDate, v))
+}
+
+// EffectiveDateIn applies the In predicate on the "effective_date" field.
+func EffectiveDateIn(vs ...time.Time) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldIn(FieldEffectiveDate, vs...))
+}
+
+// EffectiveDateNotIn applies the NotIn predicate on the "effective_date" field.
+func EffectiveDateNotIn(vs ...time.Time) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNotIn(FieldEffectiveDate, vs...))
+}
+
+// EffectiveDateGT applies the GT predicate on the "effective_date" field.
+func EffectiveDateGT(v time.Time) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldGT(FieldEffectiveDate, v))
+}
+
+// EffectiveDateGTE applies the GTE predicate on the "effective_date" field.
+func EffectiveDateGTE(v time.Time) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldGTE(FieldEffectiveDate, v))
+}
+
+// EffectiveDateLT applies the LT predicate on the "effective_date" field.
+func EffectiveDateLT(v time.Time) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldLT(FieldEffectiveDate, v))
+}
+
+// EffectiveDateLTE applies the LTE predicate on the "effective_date" field.
+func EffectiveDateLTE(v time.Time) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldLTE(FieldEffectiveDate, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldContainsFold(FieldNote, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BudgetReallocation) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BudgetReallocation) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BudgetReallocation) predicate.BudgetReallocation {
+	return predicate.BudgetReallocation(sql.NotPredicates(p))
+}
@@ -0,0 +1,683 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/budgetreallocation"
+	"clockzen-next/internal/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BudgetReallocationCreate is the builder for creating a BudgetReallocation entity.
+type BudgetReallocationCreate struct {
+	config
+	mutation *BudgetReallocationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *BudgetReallocationCreate) SetUserID(v string) *BudgetReallocationCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_c *BudgetReallocationCreate) SetOrganizationID(v string) *BudgetReallocationCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_c *BudgetReallocationCreate) SetNillableOrganizationID(v *string) *BudgetReallocationCreate {
+	if v != nil {
+		_c.SetOrganizationID(*v)
+	}
+	return _c
+}
+
+// SetBudgetID sets the "budget_id" field.
+func (_c *BudgetReallocationCreate) SetBudgetID(v string) *BudgetReallocationCreate {
+	_c.mutation.SetBudgetID(v)
+	return _c
+}
+
+// SetFromCategory sets the "from_category" field.
+func (_c *BudgetReallocationCreate) SetFromCategory(v string) *BudgetReallocationCreate {
+	_c.mutation.SetFromCategory(v)
+	return _c
+}
+
+// SetToCategory sets the "to_category" field.
+func (_c *BudgetReallocationCreate) SetToCategory(v string) *BudgetReallocationCreate {
+	_c.mutation.SetToCategory(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *BudgetReallocationCreate) SetAmount(v float64) *BudgetReallocationCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetEffectiveDate sets the "effective_date" field.
+func (_c *BudgetReallocationCreate) SetEffectiveDate(v time.Time) *BudgetReallocationCreate {
+	_c.mutation.SetEffectiveDate(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *BudgetReallocationCreate) SetNote(v string) *BudgetReallocationCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *BudgetReallocationCreate) SetNillableNote(v *string) *BudgetReallocationCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BudgetReallocationCreate) SetCreatedAt(v time.Time) *BudgetReallocationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BudgetReallocationCreate) SetNillableCreatedAt(v *time.Time) *BudgetReallocationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BudgetReallocationCreate) SetID(v string) *BudgetReallocationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *BudgetReallocationCreate) SetUser(v *User) *BudgetReallocationCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the BudgetReallocationMutation object of the builder.
+func (_c *BudgetReallocationCreate) Mutation() *BudgetReallocationMutation {
+	return _c.mutation
+}
+
+// Save creates the BudgetReallocation in the database.
+func (_c *BudgetReallocationCreate) Save(ctx context.Context) (*BudgetReallocation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BudgetReallocationCreate) SaveX(ctx context.Context) *BudgetReallocation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BudgetReallocationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BudgetReallocationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BudgetReallocationCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := budgetreallocation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BudgetReallocationCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "BudgetReallocation.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := budgetreallocation.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "BudgetReallocation.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BudgetID(); !ok {
+		return &ValidationError{Name: "budget_id", err: errors.New(`ent: missing required field "BudgetReallocation.budget_id"`)}
+	}
+	if v, ok := _c.mutation.BudgetID(); ok {
+		if err := budgetreallocation.BudgetIDValidator(v); err != nil {
+			return &ValidationError{Name: "budget_id", err: fmt.Errorf(`ent: validator failed for field "BudgetReallocation.budget_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FromCategory(); !ok {
+		return &ValidationError{Name: "from_category", err: errors.New(`ent: missing required field "BudgetReallocation.from_category"`)}
+	}
+	if v, ok := _c.mutation.FromCategory(); ok {
+		if err := budgetreallocation.FromCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "from_category", err: fmt.Errorf(`ent: validator failed for field "BudgetReallocation.from_category": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ToCategory(); !ok {
+		return &ValidationError{Name: "to_category", err: errors.New(`ent: missing required field "BudgetReallocation.to_category"`)}
+	}
+	if v, ok := _c.mutation.ToCategory(); ok {
+		if err := budgetreallocation.ToCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "to_category", err: fmt.Errorf(`ent: validator failed for field "BudgetReallocation.to_category": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "BudgetReallocation.amount"`)}
+	}
+	if v, ok := _c.mutation.Amount(); ok {
+		if err := budgetreallocation.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "BudgetReallocation.amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EffectiveDate(); !ok {
+		return &ValidationError{Name: "effective_date", err: errors.New(`ent: missing required field "BudgetReallocation.effective_date"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BudgetReallocation.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "BudgetReallocation.user"`)}
+	}
+	return nil
+}
+
+func (_c *BudgetReallocationCreate) sqlSave(ctx context.Context) (*BudgetReallocation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BudgetReallocation.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BudgetReallocationCreate) createSpec() (*BudgetReallocation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BudgetReallocation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(budgetreallocation.Table, sqlgraph.NewFieldSpec(budgetreallocation.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.OrganizationID(); ok {
+		_spec.SetField(budgetreallocation.FieldOrganizationID, field.TypeString, value)
+		_node.OrganizationID = &value
+	}
+	if value, ok := _c.mutation.BudgetID(); ok {
+		_spec.SetField(budgetreallocation.FieldBudgetID, field.TypeString, value)
+		_node.BudgetID = value
+	}
+	if value, ok := _c.mutation.FromCategory(); ok {
+		_spec.SetField(budgetreallocation.FieldFromCategory, field.TypeString, value)
+		_node.FromCategory = value
+	}
+	if value, ok := _c.mutation.ToCategory(); ok {
+		_spec.SetField(budgetreallocation.FieldToCategory, field.TypeString, value)
+		_node.ToCategory = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(budgetreallocation.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.EffectiveDate(); ok {
+		_spec.SetField(budgetreallocation.FieldEffectiveDate, field.TypeTime, value)
+		_node.EffectiveDate = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(budgetreallocation.FieldNote, field.TypeString, value)
+		_node.Note = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(budgetreallocation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   budgetreallocation.UserTable,
+			Columns: []string{budgetreallocation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BudgetReallocation.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BudgetReallocationUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BudgetReallocationCreate) OnConflict(opts ...sql.ConflictOption) *BudgetReallocationUpsertOne {
+	_c.conflict = opts
+	return &BudgetReallocationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BudgetReallocation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BudgetReallocationCreate) OnConflictColumns(columns ...string) *BudgetReallocationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BudgetReallocationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BudgetReallocationUpsertOne is the builder for "upsert"-ing
+	//  one BudgetReallocation node.
+	BudgetReallocationUpsertOne struct {
+		create *BudgetReallocationCreate
+	}
+
+	// BudgetReallocationUpsert is the "OnConflict" setter.
+	BudgetReallocationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.BudgetReallocation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(budgetreallocation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BudgetReallocationUpsertOne) UpdateNewValues() *BudgetReallocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(budgetreallocation.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(budgetreallocation.FieldUserID)
+		}
+		if _, exists := u.create.mutation.OrganizationID(); exists {
+			s.SetIgnore(budgetreallocation.FieldOrganizationID)
+		}
+		if _, exists := u.create.mutation.BudgetID(); exists {
+			s.SetIgnore(budgetreallocation.FieldBudgetID)
+		}
+		if _, exists := u.create.mutation.FromCategory(); exists {
+			s.SetIgnore(budgetreallocation.FieldFromCategory)
+		}
+		if _, exists := u.create.mutation.ToCategory(); exists {
+			s.SetIgnore(budgetreallocation.FieldToCategory)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(budgetreallocation.FieldAmount)
+		}
+		if _, exists := u.create.mutation.EffectiveDate(); exists {
+			s.SetIgnore(budgetreallocation.FieldEffectiveDate)
+		}
+		if _, exists := u.create.mutation.Note(); exists {
+			s.SetIgnore(budgetreallocation.FieldNote)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(budgetreallocation.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BudgetReallocation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BudgetReallocationUpsertOne) Ignore() *BudgetReallocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BudgetReallocationUpsertOne) DoNothing() *BudgetReallocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BudgetReallocationCreate.OnConflict
+// documentation for more info.
+func (u *BudgetReallocationUpsertOne) Update(set func(*BudgetReallocationUpsert)) *BudgetReallocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BudgetReallocationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *BudgetReallocationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BudgetReallocationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BudgetReallocationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BudgetReallocationUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BudgetReallocationUpsertOne.ID is not supported by MySQL driver. Use BudgetReallocationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BudgetReallocationUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BudgetReallocationCreateBulk is the builder for creating many BudgetReallocation entities in bulk.
+type BudgetReallocationCreateBulk struct {
+	config
+	err      error
+	builders []*BudgetReallocationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BudgetReallocation entities in the database.
+func (_c *BudgetReallocationCreateBulk) Save(ctx context.Context) ([]*BudgetReallocation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BudgetReallocation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BudgetReallocationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BudgetReallocationCreateBulk) SaveX(ctx context.Context) []*BudgetReallocation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BudgetReallocationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BudgetReallocationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BudgetReallocation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BudgetReallocationUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BudgetReallocationCreateBulk) OnConflict(opts ...sql.ConflictOption) *BudgetReallocationUpsertBulk {
+	_c.conflict = opts
+	return &BudgetReallocationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BudgetReallocation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BudgetReallocationCreateBulk) OnConflictColumns(columns ...string) *BudgetReallocationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BudgetReallocationUpsertBulk{
+		create: _c,
+	}
+}
+
+// BudgetReallocationUpsertBulk is the builder for "upsert"-ing
+// a bulk of BudgetReallocation nodes.
+type BudgetReallocationUpsertBulk struct {
+	create *BudgetReallocationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BudgetReallocation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(budgetreallocation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BudgetReallocationUpsertBulk) UpdateNewValues() *BudgetReallocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(budgetreallocation.FieldID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(budgetreallocation.FieldUserID)
+			}
+			if _, exists := b.mutation.OrganizationID(); exists {
+				s.SetIgnore(budgetreallocation.FieldOrganizationID)
+			}
+			if _, exists := b.mutation.BudgetID(); exists {
+				s.SetIgnore(budgetreallocation.FieldBudgetID)
+			}
+			if _, exists := b.mutation.FromCategory(); exists {
+				s.SetIgnore(budgetreallocation.FieldFromCategory)
+			}
+			if _, exists := b.mutation.ToCategory(); exists {
+				s.SetIgnore(budgetreallocation.FieldToCategory)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(budgetreallocation.FieldAmount)
+			}
+			if _, exists := b.mutation.EffectiveDate(); exists {
+				s.SetIgnore(budgetreallocation.FieldEffectiveDate)
+			}
+			if _, exists := b.mutation.Note(); exists {
+				s.SetIgnore(budgetreallocation.FieldNote)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(budgetreallocation.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BudgetReallocation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BudgetReallocationUpsertBulk) Ignore() *BudgetReallocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BudgetReallocationUpsertBulk) DoNothing() *BudgetReallocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BudgetReallocationCreateBulk.OnConflict
+// documentation for more info.
+func (u *BudgetReallocationUpsertBulk) Update(set func(*BudgetReallocationUpsert)) *BudgetReallocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BudgetReallocationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *BudgetReallocationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BudgetReallocationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BudgetReallocationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BudgetReallocationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
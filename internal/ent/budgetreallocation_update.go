@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/budgetreallocation"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BudgetReallocationUpdate is the builder for updating BudgetReallocation entities.
+type BudgetReallocationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BudgetReallocationMutation
+}
+
+// Where appends a list predicates to the BudgetReallocationUpdate builder.
+func (_u *BudgetReallocationUpdate) Where(ps ...predicate.BudgetReallocation) *BudgetReallocationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the BudgetReallocationMutation object of the builder.
+func (_u *BudgetReallocationUpdate) Mutation() *BudgetReallocationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BudgetReallocationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BudgetReallocationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BudgetReallocationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BudgetReallocationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BudgetReallocationUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BudgetReallocation.user"`)
+	}
+	return nil
+}
+
+func (_u *BudgetReallocationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(budgetreallocation.Table, budgetreallocation.Columns, sqlgraph.NewFieldSpec(budgetreallocation.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.OrganizationIDCleared() {
+		_spec.ClearField(budgetreallocation.FieldOrganizationID, field.TypeString)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(budgetreallocation.FieldNote, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{budgetreallocation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BudgetReallocationUpdateOne is the builder for updating a single BudgetReallocation entity.
+type BudgetReallocationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BudgetReallocationMutation
+}
+
+// Mutation returns the BudgetReallocationMutation object of the builder.
+func (_u *BudgetReallocationUpdateOne) Mutation() *BudgetReallocationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BudgetReallocationUpdate builder.
+func (_u *BudgetReallocationUpdateOne) Where(ps ...predicate.BudgetReallocation) *BudgetReallocationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BudgetReallocationUpdateOne) Select(field string, fields ...string) *BudgetReallocationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BudgetReallocation entity.
+func (_u *BudgetReallocationUpdateOne) Save(ctx context.Context) (*BudgetReallocation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BudgetReallocationUpdateOne) SaveX(ctx context.Context) *BudgetReallocation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BudgetReallocationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BudgetReallocationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BudgetReallocationUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BudgetReallocation.user"`)
+	}
+	return nil
+}
+
+func (_u *BudgetReallocationUpdateOne) sqlSave(ctx context.Context) (_node *BudgetReallocation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(budgetreallocation.Table, budgetreallocation.Columns, sqlgraph.NewFieldSpec(budgetreallocation.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BudgetReallocation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, budgetreallocation.FieldID)
+		for _, f := range fields {
+			if !budgetreallocation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != budgetreallocation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.OrganizationIDCleared() {
+		_spec.ClearField(budgetreallocation.FieldOrganizationID, field.TypeString)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(budgetreallocation.FieldNote, field.TypeString)
+	}
+	_node = &BudgetReallocation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{budgetreallocation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/bulkoperation"
+	"encoding/json"
+	"encoding/json/jsontext"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BulkOperation is the model entity for the BulkOperation schema.
+type BulkOperation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ID of the user whose transactions were changed
+	UserID string `json:"user_id,omitempty"`
+	// categorize is a batch of changes from applying categorization rules
+	Kind bulkoperation.Kind `json:"kind,omitempty"`
+	// Category the transactions were moved to, for recategorizations
+	Category *string `json:"category,omitempty"`
+	// IDs of the transactions changed
+	TransactionIds []string `json:"transaction_ids,omitempty"`
+	// The transactions as they were before the operation, restored by undoing it
+	BeforeImages jsontext.Value `json:"before_images,omitempty"`
+	// The operation can be undone until then
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// UndoneAt holds the value of the "undone_at" field.
+	UndoneAt *time.Time `json:"undone_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BulkOperation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bulkoperation.FieldTransactionIds, bulkoperation.FieldBeforeImages:
+			values[i] = new([]byte)
+		case bulkoperation.FieldID, bulkoperation.FieldUserID, bulkoperation.FieldKind, bulkoperation.FieldCategory:
+			values[i] = new(sql.NullString)
+		case bulkoperation.FieldExpiresAt, bulkoperation.FieldUndoneAt, bulkoperation.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BulkOperation fields.
+func (_m *BulkOperation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bulkoperation.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bulkoperation.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case bulkoperation.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = bulkoperation.Kind(value.String)
+			}
+		case bulkoperation.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = new(string)
+				*_m.Category = value.String
+			}
+		case bulkoperation.FieldTransactionIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TransactionIds); err != nil {
+					return fmt.Errorf("unmarshal field transaction_ids: %w", err)
+				}
+			}
+		case bulkoperation.FieldBeforeImages:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field before_images", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.BeforeImages); err != nil {
+					return fmt.Errorf("unmarshal field before_images: %w", err)
+				}
+			}
+		case bulkoperation.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case bulkoperation.FieldUndoneAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field undone_at", values[i])
+			} else if value.Valid {
+				_m.UndoneAt = new(time.Time)
+				*_m.UndoneAt = value.Time
+			}
+		case bulkoperation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BulkOperation.
+// This includes values selected through modifiers, order, etc.
+func (_m *BulkOperation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BulkOperation.
+// Note that you need to call BulkOperation.Unwrap() before calling this method if this BulkOperation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BulkOperation) Update() *BulkOperationUpdateOne {
+	return NewBulkOperationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BulkOperation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BulkOperation) Unwrap() *BulkOperation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BulkOperation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BulkOperation) String() string {
+	var builder strings.Builder
+	builder.WriteString("BulkOperation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	if v := _m.Category; v != nil {
+		builder.WriteString("category=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("transaction_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TransactionIds))
+	builder.WriteString(", ")
+	builder.WriteString("before_images=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BeforeImages))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.UndoneAt; v != nil {
+		builder.WriteString("undone_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BulkOperations is a parsable slice of BulkOperation.
+type BulkOperations []*BulkOperation
@@ -0,0 +1,682 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/bulkoperation"
+	"context"
+	"encoding/json/jsontext"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BulkOperationCreate is the builder for creating a BulkOperation entity.
+type BulkOperationCreate struct {
+	config
+	mutation *BulkOperationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *BulkOperationCreate) SetUserID(v string) *BulkOperationCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *BulkOperationCreate) SetKind(v bulkoperation.Kind) *BulkOperationCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *BulkOperationCreate) SetCategory(v string) *BulkOperationCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_c *BulkOperationCreate) SetNillableCategory(v *string) *BulkOperationCreate {
+	if v != nil {
+		_c.SetCategory(*v)
+	}
+	return _c
+}
+
+// SetTransactionIds sets the "transaction_ids" field.
+func (_c *BulkOperationCreate) SetTransactionIds(v []string) *BulkOperationCreate {
+	_c.mutation.SetTransactionIds(v)
+	return _c
+}
+
+// SetBeforeImages sets the "before_images" field.
+func (_c *BulkOperationCreate) SetBeforeImages(v jsontext.Value) *BulkOperationCreate {
+	_c.mutation.SetBeforeImages(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *BulkOperationCreate) SetExpiresAt(v time.Time) *BulkOperationCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetUndoneAt sets the "undone_at" field.
+func (_c *BulkOperationCreate) SetUndoneAt(v time.Time) *BulkOperationCreate {
+	_c.mutation.SetUndoneAt(v)
+	return _c
+}
+
+// SetNillableUndoneAt sets the "undone_at" field if the given value is not nil.
+func (_c *BulkOperationCreate) SetNillableUndoneAt(v *time.Time) *BulkOperationCreate {
+	if v != nil {
+		_c.SetUndoneAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BulkOperationCreate) SetCreatedAt(v time.Time) *BulkOperationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BulkOperationCreate) SetNillableCreatedAt(v *time.Time) *BulkOperationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BulkOperationCreate) SetID(v string) *BulkOperationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BulkOperationMutation object of the builder.
+func (_c *BulkOperationCreate) Mutation() *BulkOperationMutation {
+	return _c.mutation
+}
+
+// Save creates the BulkOperation in the database.
+func (_c *BulkOperationCreate) Save(ctx context.Context) (*BulkOperation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BulkOperationCreate) SaveX(ctx context.Context) *BulkOperation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BulkOperationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BulkOperationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BulkOperationCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := bulkoperation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BulkOperationCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "BulkOperation.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := bulkoperation.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "BulkOperation.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "BulkOperation.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := bulkoperation.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "BulkOperation.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TransactionIds(); !ok {
+		return &ValidationError{Name: "transaction_ids", err: errors.New(`ent: missing required field "BulkOperation.transaction_ids"`)}
+	}
+	if _, ok := _c.mutation.BeforeImages(); !ok {
+		return &ValidationError{Name: "before_images", err: errors.New(`ent: missing required field "BulkOperation.before_images"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "BulkOperation.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BulkOperation.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *BulkOperationCreate) sqlSave(ctx context.Context) (*BulkOperation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BulkOperation.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BulkOperationCreate) createSpec() (*BulkOperation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BulkOperation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bulkoperation.Table, sqlgraph.NewFieldSpec(bulkoperation.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(bulkoperation.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(bulkoperation.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(bulkoperation.FieldCategory, field.TypeString, value)
+		_node.Category = &value
+	}
+	if value, ok := _c.mutation.TransactionIds(); ok {
+		_spec.SetField(bulkoperation.FieldTransactionIds, field.TypeJSON, value)
+		_node.TransactionIds = value
+	}
+	if value, ok := _c.mutation.BeforeImages(); ok {
+		_spec.SetField(bulkoperation.FieldBeforeImages, field.TypeJSON, value)
+		_node.BeforeImages = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(bulkoperation.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.UndoneAt(); ok {
+		_spec.SetField(bulkoperation.FieldUndoneAt, field.TypeTime, value)
+		_node.UndoneAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(bulkoperation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BulkOperation.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BulkOperationUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BulkOperationCreate) OnConflict(opts ...sql.ConflictOption) *BulkOperationUpsertOne {
+	_c.conflict = opts
+	return &BulkOperationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BulkOperation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BulkOperationCreate) OnConflictColumns(columns ...string) *BulkOperationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BulkOperationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BulkOperationUpsertOne is the builder for "upsert"-ing
+	//  one BulkOperation node.
+	BulkOperationUpsertOne struct {
+		create *BulkOperationCreate
+	}
+
+	// BulkOperationUpsert is the "OnConflict" setter.
+	BulkOperationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUndoneAt sets the "undone_at" field.
+func (u *BulkOperationUpsert) SetUndoneAt(v time.Time) *BulkOperationUpsert {
+	u.Set(bulkoperation.FieldUndoneAt, v)
+	return u
+}
+
+// UpdateUndoneAt sets the "undone_at" field to the value that was provided on create.
+func (u *BulkOperationUpsert) UpdateUndoneAt() *BulkOperationUpsert {
+	u.SetExcluded(bulkoperation.FieldUndoneAt)
+	return u
+}
+
+// ClearUndoneAt clears the value of the "undone_at" field.
+func (u *BulkOperationUpsert) ClearUndoneAt() *BulkOperationUpsert {
+	u.SetNull(bulkoperation.FieldUndoneAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.BulkOperation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(bulkoperation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BulkOperationUpsertOne) UpdateNewValues() *BulkOperationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(bulkoperation.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(bulkoperation.FieldUserID)
+		}
+		if _, exists := u.create.mutation.Kind(); exists {
+			s.SetIgnore(bulkoperation.FieldKind)
+		}
+		if _, exists := u.create.mutation.Category(); exists {
+			s.SetIgnore(bulkoperation.FieldCategory)
+		}
+		if _, exists := u.create.mutation.TransactionIds(); exists {
+			s.SetIgnore(bulkoperation.FieldTransactionIds)
+		}
+		if _, exists := u.create.mutation.BeforeImages(); exists {
+			s.SetIgnore(bulkoperation.FieldBeforeImages)
+		}
+		if _, exists := u.create.mutation.ExpiresAt(); exists {
+			s.SetIgnore(bulkoperation.FieldExpiresAt)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(bulkoperation.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BulkOperation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BulkOperationUpsertOne) Ignore() *BulkOperationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BulkOperationUpsertOne) DoNothing() *BulkOperationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BulkOperationCreate.OnConflict
+// documentation for more info.
+func (u *BulkOperationUpsertOne) Update(set func(*BulkOperationUpsert)) *BulkOperationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BulkOperationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUndoneAt sets the "undone_at" field.
+func (u *BulkOperationUpsertOne) SetUndoneAt(v time.Time) *BulkOperationUpsertOne {
+	return u.Update(func(s *BulkOperationUpsert) {
+		s.SetUndoneAt(v)
+	})
+}
+
+// UpdateUndoneAt sets the "undone_at" field to the value that was provided on create.
+func (u *BulkOperationUpsertOne) UpdateUndoneAt() *BulkOperationUpsertOne {
+	return u.Update(func(s *BulkOperationUpsert) {
+		s.UpdateUndoneAt()
+	})
+}
+
+// ClearUndoneAt clears the value of the "undone_at" field.
+func (u *BulkOperationUpsertOne) ClearUndoneAt() *BulkOperationUpsertOne {
+	return u.Update(func(s *BulkOperationUpsert) {
+		s.ClearUndoneAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BulkOperationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BulkOperationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BulkOperationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BulkOperationUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BulkOperationUpsertOne.ID is not supported by MySQL driver. Use BulkOperationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BulkOperationUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BulkOperationCreateBulk is the builder for creating many BulkOperation entities in bulk.
+type BulkOperationCreateBulk struct {
+	config
+	err      error
+	builders []*BulkOperationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BulkOperation entities in the database.
+func (_c *BulkOperationCreateBulk) Save(ctx context.Context) ([]*BulkOperation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BulkOperation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BulkOperationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BulkOperationCreateBulk) SaveX(ctx context.Context) []*BulkOperation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BulkOperationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BulkOperationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BulkOperation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BulkOperationUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BulkOperationCreateBulk) OnConflict(opts ...sql.ConflictOption) *BulkOperationUpsertBulk {
+	_c.conflict = opts
+	return &BulkOperationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BulkOperation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BulkOperationCreateBulk) OnConflictColumns(columns ...string) *BulkOperationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BulkOperationUpsertBulk{
+		create: _c,
+	}
+}
+
+// BulkOperationUpsertBulk is the builder for "upsert"-ing
+// a bulk of BulkOperation nodes.
+type BulkOperationUpsertBulk struct {
+	create *BulkOperationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BulkOperation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(bulkoperation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BulkOperationUpsertBulk) UpdateNewValues() *BulkOperationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(bulkoperation.FieldID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(bulkoperation.FieldUserID)
+			}
+			if _, exists := b.mutation.Kind(); exists {
+				s.SetIgnore(bulkoperation.FieldKind)
+			}
+			if _, exists := b.mutation.Category(); exists {
+				s.SetIgnore(bulkoperation.FieldCategory)
+			}
+			if _, exists := b.mutation.TransactionIds(); exists {
+				s.SetIgnore(bulkoperation.FieldTransactionIds)
+			}
+			if _, exists := b.mutation.BeforeImages(); exists {
+				s.SetIgnore(bulkoperation.FieldBeforeImages)
+			}
+			if _, exists := b.mutation.ExpiresAt(); exists {
+				s.SetIgnore(bulkoperation.FieldExpiresAt)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(bulkoperation.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BulkOperation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BulkOperationUpsertBulk) Ignore() *BulkOperationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BulkOperationUpsertBulk) DoNothing() *BulkOperationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BulkOperationCreateBulk.OnConflict
+// documentation for more info.
+func (u *BulkOperationUpsertBulk) Update(set func(*BulkOperationUpsert)) *BulkOperationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BulkOperationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUndoneAt sets the "undone_at" field.
+func (u *BulkOperationUpsertBulk) SetUndoneAt(v time.Time) *BulkOperationUpsertBulk {
+	return u.Update(func(s *BulkOperationUpsert) {
+		s.SetUndoneAt(v)
+	})
+}
+
+// UpdateUndoneAt sets the "undone_at" field to the value that was provided on create.
+func (u *BulkOperationUpsertBulk) UpdateUndoneAt() *BulkOperationUpsertBulk {
+	return u.Update(func(s *BulkOperationUpsert) {
+		s.UpdateUndoneAt()
+	})
+}
+
+// ClearUndoneAt clears the value of the "undone_at" field.
+func (u *BulkOperationUpsertBulk) ClearUndoneAt() *BulkOperationUpsertBulk {
+	return u.Update(func(s *BulkOperationUpsert) {
+		s.ClearUndoneAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BulkOperationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BulkOperationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BulkOperationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BulkOperationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/bulkoperation"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BulkOperationUpdate is the builder for updating BulkOperation entities.
+type BulkOperationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BulkOperationMutation
+}
+
+// Where appends a list predicates to the BulkOperationUpdate builder.
+func (_u *BulkOperationUpdate) Where(ps ...predicate.BulkOperation) *BulkOperationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUndoneAt sets the "undone_at" field.
+func (_u *BulkOperationUpdate) SetUndoneAt(v time.Time) *BulkOperationUpdate {
+	_u.mutation.SetUndoneAt(v)
+	return _u
+}
+
+// SetNillableUndoneAt sets the "undone_at" field if the given value is not nil.
+func (_u *BulkOperationUpdate) SetNillableUndoneAt(v *time.Time) *BulkOperationUpdate {
+	if v != nil {
+		_u.SetUndoneAt(*v)
+	}
+	return _u
+}
+
+// ClearUndoneAt clears the value of the "undone_at" field.
+func (_u *BulkOperationUpdate) ClearUndoneAt() *BulkOperationUpdate {
+	_u.mutation.ClearUndoneAt()
+	return _u
+}
+
+// Mutation returns the BulkOperationMutation object of the builder.
+func (_u *BulkOperationUpdate) Mutation() *BulkOperationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BulkOperationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BulkOperationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BulkOperationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BulkOperationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BulkOperationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(bulkoperation.Table, bulkoperation.Columns, sqlgraph.NewFieldSpec(bulkoperation.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CategoryCleared() {
+		_spec.ClearField(bulkoperation.FieldCategory, field.TypeString)
+	}
+	if value, ok := _u.mutation.UndoneAt(); ok {
+		_spec.SetField(bulkoperation.FieldUndoneAt, field.TypeTime, value)
+	}
+	if _u.mutation.UndoneAtCleared() {
+		_spec.ClearField(bulkoperation.FieldUndoneAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bulkoperation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BulkOperationUpdateOne is the builder for updating a single BulkOperation entity.
+type BulkOperationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BulkOperationMutation
+}
+
+// SetUndoneAt sets the "undone_at" field.
+func (_u *BulkOperationUpdateOne) SetUndoneAt(v time.Time) *BulkOperationUpdateOne {
+	_u.mutation.SetUndoneAt(v)
+	return _u
+}
+
+// SetNillableUndoneAt sets the "undone_at" field if the given value is not nil.
+func (_u *BulkOperationUpdateOne) SetNillableUndoneAt(v *time.Time) *BulkOperationUpdateOne {
+	if v != nil {
+		_u.SetUndoneAt(*v)
+	}
+	return _u
+}
+
+// ClearUndoneAt clears the value of the "undone_at" field.
+func (_u *BulkOperationUpdateOne) ClearUndoneAt() *BulkOperationUpdateOne {
+	_u.mutation.ClearUndoneAt()
+	return _u
+}
+
+// Mutation returns the BulkOperationMutation object of the builder.
+func (_u *BulkOperationUpdateOne) Mutation() *BulkOperationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BulkOperationUpdate builder.
+func (_u *BulkOperationUpdateOne) Where(ps ...predicate.BulkOperation) *BulkOperationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BulkOperationUpdateOne) Select(field string, fields ...string) *BulkOperationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BulkOperation entity.
+func (_u *BulkOperationUpdateOne) Save(ctx context.Context) (*BulkOperation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BulkOperationUpdateOne) SaveX(ctx context.Context) *BulkOperation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BulkOperationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BulkOperationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BulkOperationUpdateOne) sqlSave(ctx context.Context) (_node *BulkOperation, err error) {
+	_spec := sqlgraph.NewUpdateSpec(bulkoperation.Table, bulkoperation.Columns, sqlgraph.NewFieldSpec(bulkoperation.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BulkOperation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bulkoperation.FieldID)
+		for _, f := range fields {
+			if !bulkoperation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bulkoperation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CategoryCleared() {
+		_spec.ClearField(bulkoperation.FieldCategory, field.TypeString)
+	}
+	if value, ok := _u.mutation.UndoneAt(); ok {
+		_spec.SetField(bulkoperation.FieldUndoneAt, field.TypeTime, value)
+	}
+	if _u.mutation.UndoneAtCleared() {
+		_spec.ClearField(bulkoperation.FieldUndoneAt, field.TypeTime)
+	}
+	_node = &BulkOperation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bulkoperation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/cardaccount"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// CardAccount is the model entity for the CardAccount schema.
+type CardAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ID of the user who owns the card
+	UserID string `json:"user_id,omitempty"`
+	// Name of the card, e.g. the issuer and product
+	Name string `json:"name,omitempty"`
+	// Last 4 digits of the card; transactions with them belong to its statements
+	CardLastFour *string `json:"card_last_four,omitempty"`
+	// Day of the month the statement closes; the last day in shorter months
+	StatementClosingDay int `json:"statement_closing_day,omitempty"`
+	// Days after the statement closes that payment is due
+	PaymentDueDays int `json:"payment_due_days,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CardAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case cardaccount.FieldStatementClosingDay, cardaccount.FieldPaymentDueDays:
+			values[i] = new(sql.NullInt64)
+		case cardaccount.FieldID, cardaccount.FieldUserID, cardaccount.FieldName, cardaccount.FieldCardLastFour:
+			values[i] = new(sql.NullString)
+		case cardaccount.FieldCreatedAt, cardaccount.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CardAccount fields.
+func (_m *CardAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case cardaccount.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case cardaccount.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case cardaccount.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case cardaccount.FieldCardLastFour:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field card_last_four", values[i])
+			} else if value.Valid {
+				_m.CardLastFour = new(string)
+				*_m.CardLastFour = value.String
+			}
+		case cardaccount.FieldStatementClosingDay:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field statement_closing_day", values[i])
+			} else if value.Valid {
+				_m.StatementClosingDay = int(value.Int64)
+			}
+		case cardaccount.FieldPaymentDueDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_due_days", values[i])
+			} else if value.Valid {
+				_m.PaymentDueDays = int(value.Int64)
+			}
+		case cardaccount.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case cardaccount.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CardAccount.
+// This includes values selected through modifiers, order, etc.
+func (_m *CardAccount) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CardAccount.
+// Note that you need to call CardAccount.Unwrap() before calling this method if this CardAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CardAccount) Update() *CardAccountUpdateOne {
+	return NewCardAccountClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CardAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CardAccount) Unwrap() *CardAccount {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CardAccount is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CardAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("CardAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	if v := _m.CardLastFour; v != nil {
+		builder.WriteString("card_last_four=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("statement_closing_day=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StatementClosingDay))
+	builder.WriteString(", ")
+	builder.WriteString("payment_due_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PaymentDueDays))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CardAccounts is a parsable slice of CardAccount.
+type CardAccounts []*CardAccount
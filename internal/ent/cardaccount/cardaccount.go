@@ -0,0 +1,116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cardaccount
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the cardaccount type in the database.
+	Label = "card_account"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldCardLastFour holds the string denoting the card_last_four field in the database.
+	FieldCardLastFour = "card_last_four"
+	// FieldStatementClosingDay holds the string denoting the statement_closing_day field in the database.
+	FieldStatementClosingDay = "statement_closing_day"
+	// FieldPaymentDueDays holds the string denoting the payment_due_days field in the database.
+	FieldPaymentDueDays = "payment_due_days"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the cardaccount in the database.
+	Table = "card_accounts"
+)
+
+// Columns holds all SQL columns for cardaccount fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldName,
+	FieldCardLastFour,
+	FieldStatementClosingDay,
+	FieldPaymentDueDays,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// StatementClosingDayValidator is a validator for the "statement_closing_day" field. It is called by the builders before save.
+	StatementClosingDayValidator func(int) error
+	// DefaultPaymentDueDays holds the default value on creation for the "payment_due_days" field.
+	DefaultPaymentDueDays int
+	// PaymentDueDaysValidator is a validator for the "payment_due_days" field. It is called by the builders before save.
+	PaymentDueDaysValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the CardAccount queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByCardLastFour orders the results by the card_last_four field.
+func ByCardLastFour(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCardLastFour, opts...).ToFunc()
+}
+
+// ByStatementClosingDay orders the results by the statement_closing_day field.
+func ByStatementClosingDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatementClosingDay, opts...).ToFunc()
+}
+
+// ByPaymentDueDays orders the results by the payment_due_days field.
+func ByPaymentDueDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentDueDays, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cardaccount
+
+import (
+	"clockzen-next/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldContainsFold(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldEQ(FieldName, v))
+}
+
+// CardLastFour applies equality check predicate on the "card_last_four" field. It's identical to CardLastFourEQ.
+func CardLastFour(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldEQ(FieldCardLastFour, v))
+}
+
+// StatementClosingDay applies equality check predicate on the "statement_closing_day" field. It's identical to StatementClosingDayEQ.
+func StatementClosingDay(v int) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldEQ(FieldStatementClosingDay, v))
+}
+
+// PaymentDueDays applies equality check predicate on the "payment_due_days" field. It's identical to PaymentDueDaysEQ.
+func PaymentDueDays(v int) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldEQ(FieldPaymentDueDays, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldContainsFold(FieldName, v))
+}
+
+// CardLastFourEQ applies the EQ predicate on the "card_last_four" field.
+func CardLastFourEQ(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldEQ(FieldCardLastFour, v))
+}
+
+// CardLastFourNEQ applies the NEQ predicate on the "card_last_four" field.
+func CardLastFourNEQ(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldNEQ(FieldCardLastFour, v))
+}
+
+// CardLastFourIn applies the In predicate on the "card_last_four" field.
+func CardLastFourIn(vs ...string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldIn(FieldCardLastFour, vs...))
+}
+
+// CardLastFourNotIn applies the NotIn predicate on the "card_last_four" field.
+func CardLastFourNotIn(vs ...string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldNotIn(FieldCardLastFour, vs...))
+}
+
+// CardLastFourGT applies the GT predicate on the "card_last_four" field.
+func CardLastFourGT(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldGT(FieldCardLastFour, v))
+}
+
+// CardLastFourGTE applies the GTE predicate on the "card_last_four" field.
+func CardLastFourGTE(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldGTE(FieldCardLastFour, v))
+}
+
+// CardLastFourLT applies the LT predicate on the "card_last_four" field.
+func CardLastFourLT(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldLT(FieldCardLastFour, v))
+}
+
+// CardLastFourLTE applies the LTE predicate on the "card_last_four" field.
+func CardLastFourLTE(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldLTE(FieldCardLastFour, v))
+}
+
+// CardLastFourContains applies the Contains predicate on the "card_last_four" field.
+func CardLastFourContains(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldContains(FieldCardLastFour, v))
+}
+
+// CardLastFourHasPrefix applies the HasPrefix predicate on the "card_last_four" field.
+func CardLastFourHasPrefix(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldHasPrefix(FieldCardLastFour, v))
+}
+
+// CardLastFourHasSuffix applies the HasSuffix predicate on the "card_last_four" field.
+func CardLastFourHasSuffix(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldHasSuffix(FieldCardLastFour, v))
+}
+
+// CardLastFourIsNil applies the IsNil predicate on the "card_last_four" field.
+func CardLastFourIsNil() predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldIsNull(FieldCardLastFour))
+}
+
+// CardLastFourNotNil applies the NotNil predicate on the "card_last_four" field.
+func CardLastFourNotNil() predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldNotNull(FieldCardLastFour))
+}
+
+// CardLastFourEqualFold applies the EqualFold predicate on the "card_last_four" field.
+func CardLastFourEqualFold(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldEqualFold(FieldCardLastFour, v))
+}
+
+// CardLastFourContainsFold applies the ContainsFold predicate on the "card_last_four" field.
+func CardLastFourContainsFold(v string) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldContainsFold(FieldCardLastFour, v))
+}
+
+// StatementClosingDayEQ applies the EQ predicate on the "statement_closing_day" field.
+func StatementClosingDayEQ(v int) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldEQ(FieldStatementClosingDay, v))
+}
+
+// StatementClosingDayNEQ applies the NEQ predicate on the "statement_closing_day" field.
+func StatementClosingDayNEQ(v int) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldNEQ(FieldStatementClosingDay, v))
+}
+
+// StatementClosingDayIn applies the In predicate on the "statement_closing_day" field.
+func StatementClosingDayIn(vs ...int) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldIn(FieldStatementClosingDay, vs...))
+}
+
+// StatementClosingDayNotIn applies the NotIn predicate on the "statement_closing_day" field.
+func StatementClosingDayNotIn(vs ...int) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldNotIn(FieldStatementClosingDay, vs...))
+}
+
+// StatementClosingDayGT applies the GT predicate on the "statement_closing_day" field.
+func StatementClosingDayGT(v int) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldGT(FieldStatementClosingDay, v))
+}
+
+// StatementClosingDayGTE applies the GTE predicate on the "statement_closing_day" field.
+func StatementClosingDayGTE(v int) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldGTE(FieldStatementClosingDay, v))
+}
+
+// StatementClosingDayLT applies the LT predicate on the "statement_closing_day" field.
+func StatementClosingDayLT(v int) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldLT(FieldStatementClosingDay, v))
+}
+
+// StatementClosingDayLTE applies the LTE predicate on the "statement_closing_day" field.
+func StatementClosingDayLTE(v int) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldLTE(FieldStatementClosingDay, v))
+}
+
+// PaymentDueDaysEQ applies the EQ predicate on the "payment_due_days" field.
+func PaymentDueDaysEQ(v int) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldEQ(FieldPaymentDueDays, v))
+}
+
+// PaymentDueDaysNEQ applies the NEQ predicate on the "payment_due_days" field.
+func PaymentDueDaysNEQ(v int) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldNEQ(FieldPaymentDueDays, v))
+}
+
+// PaymentDueDaysIn applies the In predicate on the "payment_due_days" field.
+func PaymentDueDaysIn(vs ...int) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldIn(FieldPaymentDueDays, vs...))
+}
+
+// PaymentDueDaysNotIn applies the NotIn predicate on the "payment_due_days" field.
+func PaymentDueDaysNotIn(vs ...int) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldNotIn(FieldPaymentDueDays, vs...))
+}
+
+// PaymentDueDaysGT applies the GT predicate on the "payment_due_days" field.
+func PaymentDueDaysGT(v int) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldGT(FieldPaymentDueDays, v))
+}
+
+// PaymentDueDaysGTE applies the GTE predicate on the "payment_due_days" field.
+func PaymentDueDaysGTE(v int) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldGTE(FieldPaymentDueDays, v))
+}
+
+// PaymentDueDaysLT applies the LT predicate on the "payment_due_days" field.
+func PaymentDueDaysLT(v int) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldLT(FieldPaymentDueDays, v))
+}
+
+// PaymentDueDaysLTE applies the LTE predicate on the "payment_due_days" field.
+func PaymentDueDaysLTE(v int) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldLTE(FieldPaymentDueDays, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CardAccount {
+	return predicate.CardAccount(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CardAccount) predicate.CardAccount {
+	return predicate.CardAccount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CardAccount) predicate.CardAccount {
+	return predicate.CardAccount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CardAccount) predicate.CardAccount {
+	return predicate.CardAccount(sql.NotPredicates(p))
+}
@@ -0,0 +1,901 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/cardaccount"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CardAccountCreate is the builder for creating a CardAccount entity.
+type CardAccountCreate struct {
+	config
+	mutation *CardAccountMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *CardAccountCreate) SetUserID(v string) *CardAccountCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *CardAccountCreate) SetName(v string) *CardAccountCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetCardLastFour sets the "card_last_four" field.
+func (_c *CardAccountCreate) SetCardLastFour(v string) *CardAccountCreate {
+	_c.mutation.SetCardLastFour(v)
+	return _c
+}
+
+// SetNillableCardLastFour sets the "card_last_four" field if the given value is not nil.
+func (_c *CardAccountCreate) SetNillableCardLastFour(v *string) *CardAccountCreate {
+	if v != nil {
+		_c.SetCardLastFour(*v)
+	}
+	return _c
+}
+
+// SetStatementClosingDay sets the "statement_closing_day" field.
+func (_c *CardAccountCreate) SetStatementClosingDay(v int) *CardAccountCreate {
+	_c.mutation.SetStatementClosingDay(v)
+	return _c
+}
+
+// SetPaymentDueDays sets the "payment_due_days" field.
+func (_c *CardAccountCreate) SetPaymentDueDays(v int) *CardAccountCreate {
+	_c.mutation.SetPaymentDueDays(v)
+	return _c
+}
+
+// SetNillablePaymentDueDays sets the "payment_due_days" field if the given value is not nil.
+func (_c *CardAccountCreate) SetNillablePaymentDueDays(v *int) *CardAccountCreate {
+	if v != nil {
+		_c.SetPaymentDueDays(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CardAccountCreate) SetCreatedAt(v time.Time) *CardAccountCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CardAccountCreate) SetNillableCreatedAt(v *time.Time) *CardAccountCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CardAccountCreate) SetUpdatedAt(v time.Time) *CardAccountCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CardAccountCreate) SetNillableUpdatedAt(v *time.Time) *CardAccountCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CardAccountCreate) SetID(v string) *CardAccountCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the CardAccountMutation object of the builder.
+func (_c *CardAccountCreate) Mutation() *CardAccountMutation {
+	return _c.mutation
+}
+
+// Save creates the CardAccount in the database.
+func (_c *CardAccountCreate) Save(ctx context.Context) (*CardAccount, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CardAccountCreate) SaveX(ctx context.Context) *CardAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CardAccountCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CardAccountCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CardAccountCreate) defaults() {
+	if _, ok := _c.mutation.PaymentDueDays(); !ok {
+		v := cardaccount.DefaultPaymentDueDays
+		_c.mutation.SetPaymentDueDays(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := cardaccount.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := cardaccount.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CardAccountCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "CardAccount.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := cardaccount.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "CardAccount.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "CardAccount.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := cardaccount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CardAccount.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StatementClosingDay(); !ok {
+		return &ValidationError{Name: "statement_closing_day", err: errors.New(`ent: missing required field "CardAccount.statement_closing_day"`)}
+	}
+	if v, ok := _c.mutation.StatementClosingDay(); ok {
+		if err := cardaccount.StatementClosingDayValidator(v); err != nil {
+			return &ValidationError{Name: "statement_closing_day", err: fmt.Errorf(`ent: validator failed for field "CardAccount.statement_closing_day": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PaymentDueDays(); !ok {
+		return &ValidationError{Name: "payment_due_days", err: errors.New(`ent: missing required field "CardAccount.payment_due_days"`)}
+	}
+	if v, ok := _c.mutation.PaymentDueDays(); ok {
+		if err := cardaccount.PaymentDueDaysValidator(v); err != nil {
+			return &ValidationError{Name: "payment_due_days", err: fmt.Errorf(`ent: validator failed for field "CardAccount.payment_due_days": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CardAccount.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CardAccount.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *CardAccountCreate) sqlSave(ctx context.Context) (*CardAccount, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected CardAccount.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CardAccountCreate) createSpec() (*CardAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CardAccount{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(cardaccount.Table, sqlgraph.NewFieldSpec(cardaccount.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(cardaccount.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(cardaccount.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.CardLastFour(); ok {
+		_spec.SetField(cardaccount.FieldCardLastFour, field.TypeString, value)
+		_node.CardLastFour = &value
+	}
+	if value, ok := _c.mutation.StatementClosingDay(); ok {
+		_spec.SetField(cardaccount.FieldStatementClosingDay, field.TypeInt, value)
+		_node.StatementClosingDay = value
+	}
+	if value, ok := _c.mutation.PaymentDueDays(); ok {
+		_spec.SetField(cardaccount.FieldPaymentDueDays, field.TypeInt, value)
+		_node.PaymentDueDays = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(cardaccount.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(cardaccount.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CardAccount.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CardAccountUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CardAccountCreate) OnConflict(opts ...sql.ConflictOption) *CardAccountUpsertOne {
+	_c.conflict = opts
+	return &CardAccountUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CardAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CardAccountCreate) OnConflictColumns(columns ...string) *CardAccountUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CardAccountUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CardAccountUpsertOne is the builder for "upsert"-ing
+	//  one CardAccount node.
+	CardAccountUpsertOne struct {
+		create *CardAccountCreate
+	}
+
+	// CardAccountUpsert is the "OnConflict" setter.
+	CardAccountUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *CardAccountUpsert) SetUserID(v string) *CardAccountUpsert {
+	u.Set(cardaccount.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CardAccountUpsert) UpdateUserID() *CardAccountUpsert {
+	u.SetExcluded(cardaccount.FieldUserID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CardAccountUpsert) SetName(v string) *CardAccountUpsert {
+	u.Set(cardaccount.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CardAccountUpsert) UpdateName() *CardAccountUpsert {
+	u.SetExcluded(cardaccount.FieldName)
+	return u
+}
+
+// SetCardLastFour sets the "card_last_four" field.
+func (u *CardAccountUpsert) SetCardLastFour(v string) *CardAccountUpsert {
+	u.Set(cardaccount.FieldCardLastFour, v)
+	return u
+}
+
+// UpdateCardLastFour sets the "card_last_four" field to the value that was provided on create.
+func (u *CardAccountUpsert) UpdateCardLastFour() *CardAccountUpsert {
+	u.SetExcluded(cardaccount.FieldCardLastFour)
+	return u
+}
+
+// ClearCardLastFour clears the value of the "card_last_four" field.
+func (u *CardAccountUpsert) ClearCardLastFour() *CardAccountUpsert {
+	u.SetNull(cardaccount.FieldCardLastFour)
+	return u
+}
+
+// SetStatementClosingDay sets the "statement_closing_day" field.
+func (u *CardAccountUpsert) SetStatementClosingDay(v int) *CardAccountUpsert {
+	u.Set(cardaccount.FieldStatementClosingDay, v)
+	return u
+}
+
+// UpdateStatementClosingDay sets the "statement_closing_day" field to the value that was provided on create.
+func (u *CardAccountUpsert) UpdateStatementClosingDay() *CardAccountUpsert {
+	u.SetExcluded(cardaccount.FieldStatementClosingDay)
+	return u
+}
+
+// AddStatementClosingDay adds v to the "statement_closing_day" field.
+func (u *CardAccountUpsert) AddStatementClosingDay(v int) *CardAccountUpsert {
+	u.Add(cardaccount.FieldStatementClosingDay, v)
+	return u
+}
+
+// SetPaymentDueDays sets the "payment_due_days" field.
+func (u *CardAccountUpsert) SetPaymentDueDays(v int) *CardAccountUpsert {
+	u.Set(cardaccount.FieldPaymentDueDays, v)
+	return u
+}
+
+// UpdatePaymentDueDays sets the "payment_due_days" field to the value that was provided on create.
+func (u *CardAccountUpsert) UpdatePaymentDueDays() *CardAccountUpsert {
+	u.SetExcluded(cardaccount.FieldPaymentDueDays)
+	return u
+}
+
+// AddPaymentDueDays adds v to the "payment_due_days" field.
+func (u *CardAccountUpsert) AddPaymentDueDays(v int) *CardAccountUpsert {
+	u.Add(cardaccount.FieldPaymentDueDays, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CardAccountUpsert) SetUpdatedAt(v time.Time) *CardAccountUpsert {
+	u.Set(cardaccount.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CardAccountUpsert) UpdateUpdatedAt() *CardAccountUpsert {
+	u.SetExcluded(cardaccount.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CardAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(cardaccount.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CardAccountUpsertOne) UpdateNewValues() *CardAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(cardaccount.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(cardaccount.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CardAccount.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CardAccountUpsertOne) Ignore() *CardAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CardAccountUpsertOne) DoNothing() *CardAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CardAccountCreate.OnConflict
+// documentation for more info.
+func (u *CardAccountUpsertOne) Update(set func(*CardAccountUpsert)) *CardAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CardAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *CardAccountUpsertOne) SetUserID(v string) *CardAccountUpsertOne {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CardAccountUpsertOne) UpdateUserID() *CardAccountUpsertOne {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CardAccountUpsertOne) SetName(v string) *CardAccountUpsertOne {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CardAccountUpsertOne) UpdateName() *CardAccountUpsertOne {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCardLastFour sets the "card_last_four" field.
+func (u *CardAccountUpsertOne) SetCardLastFour(v string) *CardAccountUpsertOne {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.SetCardLastFour(v)
+	})
+}
+
+// UpdateCardLastFour sets the "card_last_four" field to the value that was provided on create.
+func (u *CardAccountUpsertOne) UpdateCardLastFour() *CardAccountUpsertOne {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.UpdateCardLastFour()
+	})
+}
+
+// ClearCardLastFour clears the value of the "card_last_four" field.
+func (u *CardAccountUpsertOne) ClearCardLastFour() *CardAccountUpsertOne {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.ClearCardLastFour()
+	})
+}
+
+// SetStatementClosingDay sets the "statement_closing_day" field.
+func (u *CardAccountUpsertOne) SetStatementClosingDay(v int) *CardAccountUpsertOne {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.SetStatementClosingDay(v)
+	})
+}
+
+// AddStatementClosingDay adds v to the "statement_closing_day" field.
+func (u *CardAccountUpsertOne) AddStatementClosingDay(v int) *CardAccountUpsertOne {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.AddStatementClosingDay(v)
+	})
+}
+
+// UpdateStatementClosingDay sets the "statement_closing_day" field to the value that was provided on create.
+func (u *CardAccountUpsertOne) UpdateStatementClosingDay() *CardAccountUpsertOne {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.UpdateStatementClosingDay()
+	})
+}
+
+// SetPaymentDueDays sets the "payment_due_days" field.
+func (u *CardAccountUpsertOne) SetPaymentDueDays(v int) *CardAccountUpsertOne {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.SetPaymentDueDays(v)
+	})
+}
+
+// AddPaymentDueDays adds v to the "payment_due_days" field.
+func (u *CardAccountUpsertOne) AddPaymentDueDays(v int) *CardAccountUpsertOne {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.AddPaymentDueDays(v)
+	})
+}
+
+// UpdatePaymentDueDays sets the "payment_due_days" field to the value that was provided on create.
+func (u *CardAccountUpsertOne) UpdatePaymentDueDays() *CardAccountUpsertOne {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.UpdatePaymentDueDays()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CardAccountUpsertOne) SetUpdatedAt(v time.Time) *CardAccountUpsertOne {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CardAccountUpsertOne) UpdateUpdatedAt() *CardAccountUpsertOne {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CardAccountUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CardAccountCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CardAccountUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CardAccountUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CardAccountUpsertOne.ID is not supported by MySQL driver. Use CardAccountUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CardAccountUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CardAccountCreateBulk is the builder for creating many CardAccount entities in bulk.
+type CardAccountCreateBulk struct {
+	config
+	err      error
+	builders []*CardAccountCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CardAccount entities in the database.
+func (_c *CardAccountCreateBulk) Save(ctx context.Context) ([]*CardAccount, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CardAccount, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CardAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CardAccountCreateBulk) SaveX(ctx context.Context) []*CardAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CardAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CardAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CardAccount.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CardAccountUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CardAccountCreateBulk) OnConflict(opts ...sql.ConflictOption) *CardAccountUpsertBulk {
+	_c.conflict = opts
+	return &CardAccountUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CardAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CardAccountCreateBulk) OnConflictColumns(columns ...string) *CardAccountUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CardAccountUpsertBulk{
+		create: _c,
+	}
+}
+
+// CardAccountUpsertBulk is the builder for "upsert"-ing
+// a bulk of CardAccount nodes.
+type CardAccountUpsertBulk struct {
+	create *CardAccountCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CardAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(cardaccount.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CardAccountUpsertBulk) UpdateNewValues() *CardAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(cardaccount.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(cardaccount.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CardAccount.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CardAccountUpsertBulk) Ignore() *CardAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CardAccountUpsertBulk) DoNothing() *CardAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CardAccountCreateBulk.OnConflict
+// documentation for more info.
+func (u *CardAccountUpsertBulk) Update(set func(*CardAccountUpsert)) *CardAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CardAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *CardAccountUpsertBulk) SetUserID(v string) *CardAccountUpsertBulk {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CardAccountUpsertBulk) UpdateUserID() *CardAccountUpsertBulk {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CardAccountUpsertBulk) SetName(v string) *CardAccountUpsertBulk {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CardAccountUpsertBulk) UpdateName() *CardAccountUpsertBulk {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCardLastFour sets the "card_last_four" field.
+func (u *CardAccountUpsertBulk) SetCardLastFour(v string) *CardAccountUpsertBulk {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.SetCardLastFour(v)
+	})
+}
+
+// UpdateCardLastFour sets the "card_last_four" field to the value that was provided on create.
+func (u *CardAccountUpsertBulk) UpdateCardLastFour() *CardAccountUpsertBulk {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.UpdateCardLastFour()
+	})
+}
+
+// ClearCardLastFour clears the value of the "card_last_four" field.
+func (u *CardAccountUpsertBulk) ClearCardLastFour() *CardAccountUpsertBulk {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.ClearCardLastFour()
+	})
+}
+
+// SetStatementClosingDay sets the "statement_closing_day" field.
+func (u *CardAccountUpsertBulk) SetStatementClosingDay(v int) *CardAccountUpsertBulk {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.SetStatementClosingDay(v)
+	})
+}
+
+// AddStatementClosingDay adds v to the "statement_closing_day" field.
+func (u *CardAccountUpsertBulk) AddStatementClosingDay(v int) *CardAccountUpsertBulk {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.AddStatementClosingDay(v)
+	})
+}
+
+// UpdateStatementClosingDay sets the "statement_closing_day" field to the value that was provided on create.
+func (u *CardAccountUpsertBulk) UpdateStatementClosingDay() *CardAccountUpsertBulk {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.UpdateStatementClosingDay()
+	})
+}
+
+// SetPaymentDueDays sets the "payment_due_days" field.
+func (u *CardAccountUpsertBulk) SetPaymentDueDays(v int) *CardAccountUpsertBulk {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.SetPaymentDueDays(v)
+	})
+}
+
+// AddPaymentDueDays adds v to the "payment_due_days" field.
+func (u *CardAccountUpsertBulk) AddPaymentDueDays(v int) *CardAccountUpsertBulk {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.AddPaymentDueDays(v)
+	})
+}
+
+// UpdatePaymentDueDays sets the "payment_due_days" field to the value that was provided on create.
+func (u *CardAccountUpsertBulk) UpdatePaymentDueDays() *CardAccountUpsertBulk {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.UpdatePaymentDueDays()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CardAccountUpsertBulk) SetUpdatedAt(v time.Time) *CardAccountUpsertBulk {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CardAccountUpsertBulk) UpdateUpdatedAt() *CardAccountUpsertBulk {
+	return u.Update(func(s *CardAccountUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CardAccountUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CardAccountCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CardAccountCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CardAccountUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,496 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/cardaccount"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CardAccountUpdate is the builder for updating CardAccount entities.
+type CardAccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CardAccountMutation
+}
+
+// Where appends a list predicates to the CardAccountUpdate builder.
+func (_u *CardAccountUpdate) Where(ps ...predicate.CardAccount) *CardAccountUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *CardAccountUpdate) SetUserID(v string) *CardAccountUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *CardAccountUpdate) SetNillableUserID(v *string) *CardAccountUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CardAccountUpdate) SetName(v string) *CardAccountUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CardAccountUpdate) SetNillableName(v *string) *CardAccountUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCardLastFour sets the "card_last_four" field.
+func (_u *CardAccountUpdate) SetCardLastFour(v string) *CardAccountUpdate {
+	_u.mutation.SetCardLastFour(v)
+	return _u
+}
+
+// SetNillableCardLastFour sets the "card_last_four" field if the given value is not nil.
+func (_u *CardAccountUpdate) SetNillableCardLastFour(v *string) *CardAccountUpdate {
+	if v != nil {
+		_u.SetCardLastFour(*v)
+	}
+	return _u
+}
+
+// ClearCardLastFour clears the value of the "card_last_four" field.
+func (_u *CardAccountUpdate) ClearCardLastFour() *CardAccountUpdate {
+	_u.mutation.ClearCardLastFour()
+	return _u
+}
+
+// SetStatementClosingDay sets the "statement_closing_day" field.
+func (_u *CardAccountUpdate) SetStatementClosingDay(v int) *CardAccountUpdate {
+	_u.mutation.ResetStatementClosingDay()
+	_u.mutation.SetStatementClosingDay(v)
+	return _u
+}
+
+// SetNillableStatementClosingDay sets the "statement_closing_day" field if the given value is not nil.
+func (_u *CardAccountUpdate) SetNillableStatementClosingDay(v *int) *CardAccountUpdate {
+	if v != nil {
+		_u.SetStatementClosingDay(*v)
+	}
+	return _u
+}
+
+// AddStatementClosingDay adds value to the "statement_closing_day" field.
+func (_u *CardAccountUpdate) AddStatementClosingDay(v int) *CardAccountUpdate {
+	_u.mutation.AddStatementClosingDay(v)
+	return _u
+}
+
+// SetPaymentDueDays sets the "payment_due_days" field.
+func (_u *CardAccountUpdate) SetPaymentDueDays(v int) *CardAccountUpdate {
+	_u.mutation.ResetPaymentDueDays()
+	_u.mutation.SetPaymentDueDays(v)
+	return _u
+}
+
+// SetNillablePaymentDueDays sets the "payment_due_days" field if the given value is not nil.
+func (_u *CardAccountUpdate) SetNillablePaymentDueDays(v *int) *CardAccountUpdate {
+	if v != nil {
+		_u.SetPaymentDueDays(*v)
+	}
+	return _u
+}
+
+// AddPaymentDueDays adds value to the "payment_due_days" field.
+func (_u *CardAccountUpdate) AddPaymentDueDays(v int) *CardAccountUpdate {
+	_u.mutation.AddPaymentDueDays(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CardAccountUpdate) SetUpdatedAt(v time.Time) *CardAccountUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the CardAccountMutation object of the builder.
+func (_u *CardAccountUpdate) Mutation() *CardAccountMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CardAccountUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CardAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CardAccountUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CardAccountUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CardAccountUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := cardaccount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CardAccountUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := cardaccount.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "CardAccount.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := cardaccount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CardAccount.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StatementClosingDay(); ok {
+		if err := cardaccount.StatementClosingDayValidator(v); err != nil {
+			return &ValidationError{Name: "statement_closing_day", err: fmt.Errorf(`ent: validator failed for field "CardAccount.statement_closing_day": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PaymentDueDays(); ok {
+		if err := cardaccount.PaymentDueDaysValidator(v); err != nil {
+			return &ValidationError{Name: "payment_due_days", err: fmt.Errorf(`ent: validator failed for field "CardAccount.payment_due_days": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CardAccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cardaccount.Table, cardaccount.Columns, sqlgraph.NewFieldSpec(cardaccount.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(cardaccount.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(cardaccount.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CardLastFour(); ok {
+		_spec.SetField(cardaccount.FieldCardLastFour, field.TypeString, value)
+	}
+	if _u.mutation.CardLastFourCleared() {
+		_spec.ClearField(cardaccount.FieldCardLastFour, field.TypeString)
+	}
+	if value, ok := _u.mutation.StatementClosingDay(); ok {
+		_spec.SetField(cardaccount.FieldStatementClosingDay, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatementClosingDay(); ok {
+		_spec.AddField(cardaccount.FieldStatementClosingDay, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PaymentDueDays(); ok {
+		_spec.SetField(cardaccount.FieldPaymentDueDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPaymentDueDays(); ok {
+		_spec.AddField(cardaccount.FieldPaymentDueDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(cardaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cardaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CardAccountUpdateOne is the builder for updating a single CardAccount entity.
+type CardAccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CardAccountMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *CardAccountUpdateOne) SetUserID(v string) *CardAccountUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *CardAccountUpdateOne) SetNillableUserID(v *string) *CardAccountUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CardAccountUpdateOne) SetName(v string) *CardAccountUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CardAccountUpdateOne) SetNillableName(v *string) *CardAccountUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCardLastFour sets the "card_last_four" field.
+func (_u *CardAccountUpdateOne) SetCardLastFour(v string) *CardAccountUpdateOne {
+	_u.mutation.SetCardLastFour(v)
+	return _u
+}
+
+// SetNillableCardLastFour sets the "card_last_four" field if the given value is not nil.
+func (_u *CardAccountUpdateOne) SetNillableCardLastFour(v *string) *CardAccountUpdateOne {
+	if v != nil {
+		_u.SetCardLastFour(*v)
+	}
+	return _u
+}
+
+// ClearCardLastFour clears the value of the "card_last_four" field.
+func (_u *CardAccountUpdateOne) ClearCardLastFour() *CardAccountUpdateOne {
+	_u.mutation.ClearCardLastFour()
+	return _u
+}
+
+// SetStatementClosingDay sets the "statement_closing_day" field.
+func (_u *CardAccountUpdateOne) SetStatementClosingDay(v int) *CardAccountUpdateOne {
+	_u.mutation.ResetStatementClosingDay()
+	_u.mutation.SetStatementClosingDay(v)
+	return _u
+}
+
+// SetNillableStatementClosingDay sets the "statement_closing_day" field if the given value is not nil.
+func (_u *CardAccountUpdateOne) SetNillableStatementClosingDay(v *int) *CardAccountUpdateOne {
+	if v != nil {
+		_u.SetStatementClosingDay(*v)
+	}
+	return _u
+}
+
+// AddStatementClosingDay adds value to the "statement_closing_day" field.
+func (_u *CardAccountUpdateOne) AddStatementClosingDay(v int) *CardAccountUpdateOne {
+	_u.mutation.AddStatementClosingDay(v)
+	return _u
+}
+
+// SetPaymentDueDays sets the "payment_due_days" field.
+func (_u *CardAccountUpdateOne) SetPaymentDueDays(v int) *CardAccountUpdateOne {
+	_u.mutation.ResetPaymentDueDays()
+	_u.mutation.SetPaymentDueDays(v)
+	return _u
+}
+
+// SetNillablePaymentDueDays sets the "payment_due_days" field if the given value is not nil.
+func (_u *CardAccountUpdateOne) SetNillablePaymentDueDays(v *int) *CardAccountUpdateOne {
+	if v != nil {
+		_u.SetPaymentDueDays(*v)
+	}
+	return _u
+}
+
+// AddPaymentDueDays adds value to the "payment_due_days" field.
+func (_u *CardAccountUpdateOne) AddPaymentDueDays(v int) *CardAccountUpdateOne {
+	_u.mutation.AddPaymentDueDays(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CardAccountUpdateOne) SetUpdatedAt(v time.Time) *CardAccountUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the CardAccountMutation object of the builder.
+func (_u *CardAccountUpdateOne) Mutation() *CardAccountMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CardAccountUpdate builder.
+func (_u *CardAccountUpdateOne) Where(ps ...predicate.CardAccount) *CardAccountUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CardAccountUpdateOne) Select(field string, fields ...string) *CardAccountUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CardAccount entity.
+func (_u *CardAccountUpdateOne) Save(ctx context.Context) (*CardAccount, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CardAccountUpdateOne) SaveX(ctx context.Context) *CardAccount {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CardAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CardAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CardAccountUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := cardaccount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CardAccountUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := cardaccount.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "CardAccount.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := cardaccount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CardAccount.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StatementClosingDay(); ok {
+		if err := cardaccount.StatementClosingDayValidator(v); err != nil {
+			return &ValidationError{Name: "statement_closing_day", err: fmt.Errorf(`ent: validator failed for field "CardAccount.statement_closing_day": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PaymentDueDays(); ok {
+		if err := cardaccount.PaymentDueDaysValidator(v); err != nil {
+			return &ValidationError{Name: "payment_due_days", err: fmt.Errorf(`ent: validator failed for field "CardAccount.payment_due_days": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CardAccountUpdateOne) sqlSave(ctx context.Context) (_node *CardAccount, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cardaccount.Table, cardaccount.Columns, sqlgraph.NewFieldSpec(cardaccount.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CardAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cardaccount.FieldID)
+		for _, f := range fields {
+			if !cardaccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != cardaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(cardaccount.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(cardaccount.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CardLastFour(); ok {
+		_spec.SetField(cardaccount.FieldCardLastFour, field.TypeString, value)
+	}
+	if _u.mutation.CardLastFourCleared() {
+		_spec.ClearField(cardaccount.FieldCardLastFour, field.TypeString)
+	}
+	if value, ok := _u.mutation.StatementClosingDay(); ok {
+		_spec.SetField(cardaccount.FieldStatementClosingDay, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatementClosingDay(); ok {
+		_spec.AddField(cardaccount.FieldStatementClosingDay, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PaymentDueDays(); ok {
+		_spec.SetField(cardaccount.FieldPaymentDueDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPaymentDueDays(); ok {
+		_spec.AddField(cardaccount.FieldPaymentDueDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(cardaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &CardAccount{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cardaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
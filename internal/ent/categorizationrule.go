@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/categorizationrule"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// CategorizationRule is the model entity for the CategorizationRule schema.
+type CategorizationRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ID of the user the rule belongs to
+	UserID string `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Rules are tried in priority order, lowest first
+	Priority int `json:"priority,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// Regular expression merchant names must match, ignoring case
+	MerchantPattern *string `json:"merchant_pattern,omitempty"`
+	// MinAmount holds the value of the "min_amount" field.
+	MinAmount *float64 `json:"min_amount,omitempty"`
+	// MaxAmount holds the value of the "max_amount" field.
+	MaxAmount *float64 `json:"max_amount,omitempty"`
+	// Descriptions must contain one of these, ignoring case
+	DescriptionKeywords []string `json:"description_keywords,omitempty"`
+	// Category given to matching transactions
+	Category *string `json:"category,omitempty"`
+	// Tags added to matching transactions
+	Tags []string `json:"tags,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CategorizationRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case categorizationrule.FieldDescriptionKeywords, categorizationrule.FieldTags:
+			values[i] = new([]byte)
+		case categorizationrule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case categorizationrule.FieldMinAmount, categorizationrule.FieldMaxAmount:
+			values[i] = new(sql.NullFloat64)
+		case categorizationrule.FieldPriority:
+			values[i] = new(sql.NullInt64)
+		case categorizationrule.FieldID, categorizationrule.FieldUserID, categorizationrule.FieldName, categorizationrule.FieldMerchantPattern, categorizationrule.FieldCategory:
+			values[i] = new(sql.NullString)
+		case categorizationrule.FieldCreatedAt, categorizationrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CategorizationRule fields.
+func (_m *CategorizationRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case categorizationrule.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case categorizationrule.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case categorizationrule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case categorizationrule.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = int(value.Int64)
+			}
+		case categorizationrule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case categorizationrule.FieldMerchantPattern:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_pattern", values[i])
+			} else if value.Valid {
+				_m.MerchantPattern = new(string)
+				*_m.MerchantPattern = value.String
+			}
+		case categorizationrule.FieldMinAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_amount", values[i])
+			} else if value.Valid {
+				_m.MinAmount = new(float64)
+				*_m.MinAmount = value.Float64
+			}
+		case categorizationrule.FieldMaxAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_amount", values[i])
+			} else if value.Valid {
+				_m.MaxAmount = new(float64)
+				*_m.MaxAmount = value.Float64
+			}
+		case categorizationrule.FieldDescriptionKeywords:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field description_keywords", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DescriptionKeywords); err != nil {
+					return fmt.Errorf("unmarshal field description_keywords: %w", err)
+				}
+			}
+		case categorizationrule.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = new(string)
+				*_m.Category = value.String
+			}
+		case categorizationrule.FieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
+		case categorizationrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case categorizationrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CategorizationRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *CategorizationRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CategorizationRule.
+// Note that you need to call CategorizationRule.Unwrap() before calling this method if this CategorizationRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CategorizationRule) Update() *CategorizationRuleUpdateOne {
+	return NewCategorizationRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CategorizationRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CategorizationRule) Unwrap() *CategorizationRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CategorizationRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CategorizationRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("CategorizationRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	if v := _m.MerchantPattern; v != nil {
+		builder.WriteString("merchant_pattern=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.MinAmount; v != nil {
+		builder.WriteString("min_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxAmount; v != nil {
+		builder.WriteString("max_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("description_keywords=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DescriptionKeywords))
+	builder.WriteString(", ")
+	if v := _m.Category; v != nil {
+		builder.WriteString("category=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tags))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CategorizationRules is a parsable slice of CategorizationRule.
+type CategorizationRules []*CategorizationRule
@@ -0,0 +1,660 @@
+// Code generated by ent, DO NOT EDIT.
+
+package categorizationrule
+
+import (
+	"clockzen-next/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldContainsFold(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldName, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldPriority, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// MerchantPattern applies equality check predicate on the "merchant_pattern" field. It's identical to MerchantPatternEQ.
+func MerchantPattern(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldMerchantPattern, v))
+}
+
+// MinAmount applies equality check predicate on the "min_amount" field. It's identical to MinAmountEQ.
+func MinAmount(v float64) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldMinAmount, v))
+}
+
+// MaxAmount applies equality check predicate on the "max_amount" field. It's identical to MaxAmountEQ.
+func MaxAmount(v float64) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldMaxAmount, v))
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldCategory, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldLTE(FieldPriority, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// MerchantPatternEQ applies the EQ predicate on the "merchant_pattern" field.
+func MerchantPatternEQ(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldMerchantPattern, v))
+}
+
+// MerchantPatternNEQ applies the NEQ predicate on the "merchant_pattern" field.
+func MerchantPatternNEQ(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNEQ(FieldMerchantPattern, v))
+}
+
+// MerchantPatternIn applies the In predicate on the "merchant_pattern" field.
+func MerchantPatternIn(vs ...string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldIn(FieldMerchantPattern, vs...))
+}
+
+// MerchantPatternNotIn applies the NotIn predicate on the "merchant_pattern" field.
+func MerchantPatternNotIn(vs ...string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNotIn(FieldMerchantPattern, vs...))
+}
+
+// MerchantPatternGT applies the GT predicate on the "merchant_pattern" field.
+func MerchantPatternGT(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldGT(FieldMerchantPattern, v))
+}
+
+// MerchantPatternGTE applies the GTE predicate on the "merchant_pattern" field.
+func MerchantPatternGTE(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldGTE(FieldMerchantPattern, v))
+}
+
+// MerchantPatternLT applies the LT predicate on the "merchant_pattern" field.
+func MerchantPatternLT(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldLT(FieldMerchantPattern, v))
+}
+
+// MerchantPatternLTE applies the LTE predicate on the "merchant_pattern" field.
+func MerchantPatternLTE(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldLTE(FieldMerchantPattern, v))
+}
+
+// MerchantPatternContains applies the Contains predicate on the "merchant_pattern" field.
+func MerchantPatternContains(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldContains(FieldMerchantPattern, v))
+}
+
+// MerchantPatternHasPrefix applies the HasPrefix predicate on the "merchant_pattern" field.
+func MerchantPatternHasPrefix(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldHasPrefix(FieldMerchantPattern, v))
+}
+
+// MerchantPatternHasSuffix applies the HasSuffix predicate on the "merchant_pattern" field.
+func MerchantPatternHasSuffix(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldHasSuffix(FieldMerchantPattern, v))
+}
+
+// MerchantPatternIsNil applies the IsNil predicate on the "merchant_pattern" field.
+func MerchantPatternIsNil() predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldIsNull(FieldMerchantPattern))
+}
+
+// MerchantPatternNotNil applies the NotNil predicate on the "merchant_pattern" field.
+func MerchantPatternNotNil() predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNotNull(FieldMerchantPattern))
+}
+
+// MerchantPatternEqualFold applies the EqualFold predicate on the "merchant_pattern" field.
+func MerchantPatternEqualFold(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEqualFold(FieldMerchantPattern, v))
+}
+
+// MerchantPatternContainsFold applies the ContainsFold predicate on the "merchant_pattern" field.
+func MerchantPatternContainsFold(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldContainsFold(FieldMerchantPattern, v))
+}
+
+// MinAmountEQ applies the EQ predicate on the "min_amount" field.
+func MinAmountEQ(v float64) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldMinAmount, v))
+}
+
+// MinAmountNEQ applies the NEQ predicate on the "min_amount" field.
+func MinAmountNEQ(v float64) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNEQ(FieldMinAmount, v))
+}
+
+// MinAmountIn applies the In predicate on the "min_amount" field.
+func MinAmountIn(vs ...float64) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldIn(FieldMinAmount, vs...))
+}
+
+// MinAmountNotIn applies the NotIn predicate on the "min_amount" field.
+func MinAmountNotIn(vs ...float64) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNotIn(FieldMinAmount, vs...))
+}
+
+// MinAmountGT applies the GT predicate on the "min_amount" field.
+func MinAmountGT(v float64) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldGT(FieldMinAmount, v))
+}
+
+// MinAmountGTE applies the GTE predicate on the "min_amount" field.
+func MinAmountGTE(v float64) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldGTE(FieldMinAmount, v))
+}
+
+// MinAmountLT applies the LT predicate on the "min_amount" field.
+func MinAmountLT(v float64) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldLT(FieldMinAmount, v))
+}
+
+// MinAmountLTE applies the LTE predicate on the "min_amount" field.
+func MinAmountLTE(v float64) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldLTE(FieldMinAmount, v))
+}
+
+// MinAmountIsNil applies the IsNil predicate on the "min_amount" field.
+func MinAmountIsNil() predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldIsNull(FieldMinAmount))
+}
+
+// MinAmountNotNil applies the NotNil predicate on the "min_amount" field.
+func MinAmountNotNil() predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNotNull(FieldMinAmount))
+}
+
+// MaxAmountEQ applies the EQ predicate on the "max_amount" field.
+func MaxAmountEQ(v float64) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldMaxAmount, v))
+}
+
+// MaxAmountNEQ applies the NEQ predicate on the "max_amount" field.
+func MaxAmountNEQ(v float64) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNEQ(FieldMaxAmount, v))
+}
+
+// MaxAmountIn applies the In predicate on the "max_amount" field.
+func MaxAmountIn(vs ...float64) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldIn(FieldMaxAmount, vs...))
+}
+
+// MaxAmountNotIn applies the NotIn predicate on the "max_amount" field.
+func MaxAmountNotIn(vs ...float64) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNotIn(FieldMaxAmount, vs...))
+}
+
+// MaxAmountGT applies the GT predicate on the "max_amount" field.
+func MaxAmountGT(v float64) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldGT(FieldMaxAmount, v))
+}
+
+// MaxAmountGTE applies the GTE predicate on the "max_amount" field.
+func MaxAmountGTE(v float64) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldGTE(FieldMaxAmount, v))
+}
+
+// MaxAmountLT applies the LT predicate on the "max_amount" field.
+func MaxAmountLT(v float64) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldLT(FieldMaxAmount, v))
+}
+
+// MaxAmountLTE applies the LTE predicate on the "max_amount" field.
+func MaxAmountLTE(v float64) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldLTE(FieldMaxAmount, v))
+}
+
+// MaxAmountIsNil applies the IsNil predicate on the "max_amount" field.
+func MaxAmountIsNil() predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldIsNull(FieldMaxAmount))
+}
+
+// MaxAmountNotNil applies the NotNil predicate on the "max_amount" field.
+func MaxAmountNotNil() predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNotNull(FieldMaxAmount))
+}
+
+// DescriptionKeywordsIsNil applies the IsNil predicate on the "description_keywords" field.
+func DescriptionKeywordsIsNil() predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldIsNull(FieldDescriptionKeywords))
+}
+
+// DescriptionKeywordsNotNil applies the NotNil predicate on the "description_keywords" field.
+func DescriptionKeywordsNotNil() predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNotNull(FieldDescriptionKeywords))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldGT(FieldCategory, v))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldGTE(FieldCategory, v))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldLT(FieldCategory, v))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldLTE(FieldCategory, v))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldContains(FieldCategory, v))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldHasPrefix(FieldCategory, v))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldHasSuffix(FieldCategory, v))
+}
+
+// CategoryIsNil applies the IsNil predicate on the "category" field.
+func CategoryIsNil() predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldIsNull(FieldCategory))
+}
+
+// CategoryNotNil applies the NotNil predicate on the "category" field.
+func CategoryNotNil() predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNotNull(FieldCategory))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEqualFold(FieldCategory, v))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldContainsFold(FieldCategory, v))
+}
+
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNotNull(FieldTags))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CategorizationRule) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CategorizationRule) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CategorizationRule) predicate.CategorizationRule {
+	return predicate.CategorizationRule(sql.NotPredicates(p))
+}
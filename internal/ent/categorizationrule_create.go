@@ -0,0 +1,1263 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/categorizationrule"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CategorizationRuleCreate is the builder for creating a CategorizationRule entity.
+type CategorizationRuleCreate struct {
+	config
+	mutation *CategorizationRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *CategorizationRuleCreate) SetUserID(v string) *CategorizationRuleCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *CategorizationRuleCreate) SetName(v string) *CategorizationRuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *CategorizationRuleCreate) SetPriority(v int) *CategorizationRuleCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *CategorizationRuleCreate) SetNillablePriority(v *int) *CategorizationRuleCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *CategorizationRuleCreate) SetEnabled(v bool) *CategorizationRuleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *CategorizationRuleCreate) SetNillableEnabled(v *bool) *CategorizationRuleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetMerchantPattern sets the "merchant_pattern" field.
+func (_c *CategorizationRuleCreate) SetMerchantPattern(v string) *CategorizationRuleCreate {
+	_c.mutation.SetMerchantPattern(v)
+	return _c
+}
+
+// SetNillableMerchantPattern sets the "merchant_pattern" field if the given value is not nil.
+func (_c *CategorizationRuleCreate) SetNillableMerchantPattern(v *string) *CategorizationRuleCreate {
+	if v != nil {
+		_c.SetMerchantPattern(*v)
+	}
+	return _c
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (_c *CategorizationRuleCreate) SetMinAmount(v float64) *CategorizationRuleCreate {
+	_c.mutation.SetMinAmount(v)
+	return _c
+}
+
+// SetNillableMinAmount sets the "min_amount" field if the given value is not nil.
+func (_c *CategorizationRuleCreate) SetNillableMinAmount(v *float64) *CategorizationRuleCreate {
+	if v != nil {
+		_c.SetMinAmount(*v)
+	}
+	return _c
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (_c *CategorizationRuleCreate) SetMaxAmount(v float64) *CategorizationRuleCreate {
+	_c.mutation.SetMaxAmount(v)
+	return _c
+}
+
+// SetNillableMaxAmount sets the "max_amount" field if the given value is not nil.
+func (_c *CategorizationRuleCreate) SetNillableMaxAmount(v *float64) *CategorizationRuleCreate {
+	if v != nil {
+		_c.SetMaxAmount(*v)
+	}
+	return _c
+}
+
+// SetDescriptionKeywords sets the "description_keywords" field.
+func (_c *CategorizationRuleCreate) SetDescriptionKeywords(v []string) *CategorizationRuleCreate {
+	_c.mutation.SetDescriptionKeywords(v)
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *CategorizationRuleCreate) SetCategory(v string) *CategorizationRuleCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_c *CategorizationRuleCreate) SetNillableCategory(v *string) *CategorizationRuleCreate {
+	if v != nil {
+		_c.SetCategory(*v)
+	}
+	return _c
+}
+
+// SetTags sets the "tags" field.
+func (_c *CategorizationRuleCreate) SetTags(v []string) *CategorizationRuleCreate {
+	_c.mutation.SetTags(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CategorizationRuleCreate) SetCreatedAt(v time.Time) *CategorizationRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CategorizationRuleCreate) SetNillableCreatedAt(v *time.Time) *CategorizationRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CategorizationRuleCreate) SetUpdatedAt(v time.Time) *CategorizationRuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CategorizationRuleCreate) SetNillableUpdatedAt(v *time.Time) *CategorizationRuleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CategorizationRuleCreate) SetID(v string) *CategorizationRuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the CategorizationRuleMutation object of the builder.
+func (_c *CategorizationRuleCreate) Mutation() *CategorizationRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the CategorizationRule in the database.
+func (_c *CategorizationRuleCreate) Save(ctx context.Context) (*CategorizationRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CategorizationRuleCreate) SaveX(ctx context.Context) *CategorizationRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategorizationRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategorizationRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CategorizationRuleCreate) defaults() {
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := categorizationrule.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := categorizationrule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := categorizationrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := categorizationrule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CategorizationRuleCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "CategorizationRule.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := categorizationrule.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "CategorizationRule.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "CategorizationRule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := categorizationrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CategorizationRule.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "CategorizationRule.priority"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "CategorizationRule.enabled"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CategorizationRule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CategorizationRule.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *CategorizationRuleCreate) sqlSave(ctx context.Context) (*CategorizationRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected CategorizationRule.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CategorizationRuleCreate) createSpec() (*CategorizationRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CategorizationRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(categorizationrule.Table, sqlgraph.NewFieldSpec(categorizationrule.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(categorizationrule.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(categorizationrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(categorizationrule.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(categorizationrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.MerchantPattern(); ok {
+		_spec.SetField(categorizationrule.FieldMerchantPattern, field.TypeString, value)
+		_node.MerchantPattern = &value
+	}
+	if value, ok := _c.mutation.MinAmount(); ok {
+		_spec.SetField(categorizationrule.FieldMinAmount, field.TypeFloat64, value)
+		_node.MinAmount = &value
+	}
+	if value, ok := _c.mutation.MaxAmount(); ok {
+		_spec.SetField(categorizationrule.FieldMaxAmount, field.TypeFloat64, value)
+		_node.MaxAmount = &value
+	}
+	if value, ok := _c.mutation.DescriptionKeywords(); ok {
+		_spec.SetField(categorizationrule.FieldDescriptionKeywords, field.TypeJSON, value)
+		_node.DescriptionKeywords = value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(categorizationrule.FieldCategory, field.TypeString, value)
+		_node.Category = &value
+	}
+	if value, ok := _c.mutation.Tags(); ok {
+		_spec.SetField(categorizationrule.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(categorizationrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(categorizationrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CategorizationRule.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategorizationRuleUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CategorizationRuleCreate) OnConflict(opts ...sql.ConflictOption) *CategorizationRuleUpsertOne {
+	_c.conflict = opts
+	return &CategorizationRuleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CategorizationRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CategorizationRuleCreate) OnConflictColumns(columns ...string) *CategorizationRuleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CategorizationRuleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CategorizationRuleUpsertOne is the builder for "upsert"-ing
+	//  one CategorizationRule node.
+	CategorizationRuleUpsertOne struct {
+		create *CategorizationRuleCreate
+	}
+
+	// CategorizationRuleUpsert is the "OnConflict" setter.
+	CategorizationRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *CategorizationRuleUpsert) SetName(v string) *CategorizationRuleUpsert {
+	u.Set(categorizationrule.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CategorizationRuleUpsert) UpdateName() *CategorizationRuleUpsert {
+	u.SetExcluded(categorizationrule.FieldName)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *CategorizationRuleUpsert) SetPriority(v int) *CategorizationRuleUpsert {
+	u.Set(categorizationrule.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *CategorizationRuleUpsert) UpdatePriority() *CategorizationRuleUpsert {
+	u.SetExcluded(categorizationrule.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *CategorizationRuleUpsert) AddPriority(v int) *CategorizationRuleUpsert {
+	u.Add(categorizationrule.FieldPriority, v)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *CategorizationRuleUpsert) SetEnabled(v bool) *CategorizationRuleUpsert {
+	u.Set(categorizationrule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *CategorizationRuleUpsert) UpdateEnabled() *CategorizationRuleUpsert {
+	u.SetExcluded(categorizationrule.FieldEnabled)
+	return u
+}
+
+// SetMerchantPattern sets the "merchant_pattern" field.
+func (u *CategorizationRuleUpsert) SetMerchantPattern(v string) *CategorizationRuleUpsert {
+	u.Set(categorizationrule.FieldMerchantPattern, v)
+	return u
+}
+
+// UpdateMerchantPattern sets the "merchant_pattern" field to the value that was provided on create.
+func (u *CategorizationRuleUpsert) UpdateMerchantPattern() *CategorizationRuleUpsert {
+	u.SetExcluded(categorizationrule.FieldMerchantPattern)
+	return u
+}
+
+// ClearMerchantPattern clears the value of the "merchant_pattern" field.
+func (u *CategorizationRuleUpsert) ClearMerchantPattern() *CategorizationRuleUpsert {
+	u.SetNull(categorizationrule.FieldMerchantPattern)
+	return u
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (u *CategorizationRuleUpsert) SetMinAmount(v float64) *CategorizationRuleUpsert {
+	u.Set(categorizationrule.FieldMinAmount, v)
+	return u
+}
+
+// UpdateMinAmount sets the "min_amount" field to the value that was provided on create.
+func (u *CategorizationRuleUpsert) UpdateMinAmount() *CategorizationRuleUpsert {
+	u.SetExcluded(categorizationrule.FieldMinAmount)
+	return u
+}
+
+// AddMinAmount adds v to the "min_amount" field.
+func (u *CategorizationRuleUpsert) AddMinAmount(v float64) *CategorizationRuleUpsert {
+	u.Add(categorizationrule.FieldMinAmount, v)
+	return u
+}
+
+// ClearMinAmount clears the value of the "min_amount" field.
+func (u *CategorizationRuleUpsert) ClearMinAmount() *CategorizationRuleUpsert {
+	u.SetNull(categorizationrule.FieldMinAmount)
+	return u
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (u *CategorizationRuleUpsert) SetMaxAmount(v float64) *CategorizationRuleUpsert {
+	u.Set(categorizationrule.FieldMaxAmount, v)
+	return u
+}
+
+// UpdateMaxAmount sets the "max_amount" field to the value that was provided on create.
+func (u *CategorizationRuleUpsert) UpdateMaxAmount() *CategorizationRuleUpsert {
+	u.SetExcluded(categorizationrule.FieldMaxAmount)
+	return u
+}
+
+// AddMaxAmount adds v to the "max_amount" field.
+func (u *CategorizationRuleUpsert) AddMaxAmount(v float64) *CategorizationRuleUpsert {
+	u.Add(categorizationrule.FieldMaxAmount, v)
+	return u
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (u *CategorizationRuleUpsert) ClearMaxAmount() *CategorizationRuleUpsert {
+	u.SetNull(categorizationrule.FieldMaxAmount)
+	return u
+}
+
+// SetDescriptionKeywords sets the "description_keywords" field.
+func (u *CategorizationRuleUpsert) SetDescriptionKeywords(v []string) *CategorizationRuleUpsert {
+	u.Set(categorizationrule.FieldDescriptionKeywords, v)
+	return u
+}
+
+// UpdateDescriptionKeywords sets the "description_keywords" field to the value that was provided on create.
+func (u *CategorizationRuleUpsert) UpdateDescriptionKeywords() *CategorizationRuleUpsert {
+	u.SetExcluded(categorizationrule.FieldDescriptionKeywords)
+	return u
+}
+
+// ClearDescriptionKeywords clears the value of the "description_keywords" field.
+func (u *CategorizationRuleUpsert) ClearDescriptionKeywords() *CategorizationRuleUpsert {
+	u.SetNull(categorizationrule.FieldDescriptionKeywords)
+	return u
+}
+
+// SetCategory sets the "category" field.
+func (u *CategorizationRuleUpsert) SetCategory(v string) *CategorizationRuleUpsert {
+	u.Set(categorizationrule.FieldCategory, v)
+	return u
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *CategorizationRuleUpsert) UpdateCategory() *CategorizationRuleUpsert {
+	u.SetExcluded(categorizationrule.FieldCategory)
+	return u
+}
+
+// ClearCategory clears the value of the "category" field.
+func (u *CategorizationRuleUpsert) ClearCategory() *CategorizationRuleUpsert {
+	u.SetNull(categorizationrule.FieldCategory)
+	return u
+}
+
+// SetTags sets the "tags" field.
+func (u *CategorizationRuleUpsert) SetTags(v []string) *CategorizationRuleUpsert {
+	u.Set(categorizationrule.FieldTags, v)
+	return u
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *CategorizationRuleUpsert) UpdateTags() *CategorizationRuleUpsert {
+	u.SetExcluded(categorizationrule.FieldTags)
+	return u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *CategorizationRuleUpsert) ClearTags() *CategorizationRuleUpsert {
+	u.SetNull(categorizationrule.FieldTags)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CategorizationRuleUpsert) SetUpdatedAt(v time.Time) *CategorizationRuleUpsert {
+	u.Set(categorizationrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CategorizationRuleUpsert) UpdateUpdatedAt() *CategorizationRuleUpsert {
+	u.SetExcluded(categorizationrule.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CategorizationRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(categorizationrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CategorizationRuleUpsertOne) UpdateNewValues() *CategorizationRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(categorizationrule.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(categorizationrule.FieldUserID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(categorizationrule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CategorizationRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CategorizationRuleUpsertOne) Ignore() *CategorizationRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategorizationRuleUpsertOne) DoNothing() *CategorizationRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategorizationRuleCreate.OnConflict
+// documentation for more info.
+func (u *CategorizationRuleUpsertOne) Update(set func(*CategorizationRuleUpsert)) *CategorizationRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategorizationRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CategorizationRuleUpsertOne) SetName(v string) *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CategorizationRuleUpsertOne) UpdateName() *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *CategorizationRuleUpsertOne) SetPriority(v int) *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *CategorizationRuleUpsertOne) AddPriority(v int) *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *CategorizationRuleUpsertOne) UpdatePriority() *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *CategorizationRuleUpsertOne) SetEnabled(v bool) *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *CategorizationRuleUpsertOne) UpdateEnabled() *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetMerchantPattern sets the "merchant_pattern" field.
+func (u *CategorizationRuleUpsertOne) SetMerchantPattern(v string) *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.SetMerchantPattern(v)
+	})
+}
+
+// UpdateMerchantPattern sets the "merchant_pattern" field to the value that was provided on create.
+func (u *CategorizationRuleUpsertOne) UpdateMerchantPattern() *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.UpdateMerchantPattern()
+	})
+}
+
+// ClearMerchantPattern clears the value of the "merchant_pattern" field.
+func (u *CategorizationRuleUpsertOne) ClearMerchantPattern() *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.ClearMerchantPattern()
+	})
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (u *CategorizationRuleUpsertOne) SetMinAmount(v float64) *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.SetMinAmount(v)
+	})
+}
+
+// AddMinAmount adds v to the "min_amount" field.
+func (u *CategorizationRuleUpsertOne) AddMinAmount(v float64) *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.AddMinAmount(v)
+	})
+}
+
+// UpdateMinAmount sets the "min_amount" field to the value that was provided on create.
+func (u *CategorizationRuleUpsertOne) UpdateMinAmount() *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.UpdateMinAmount()
+	})
+}
+
+// ClearMinAmount clears the value of the "min_amount" field.
+func (u *CategorizationRuleUpsertOne) ClearMinAmount() *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.ClearMinAmount()
+	})
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (u *CategorizationRuleUpsertOne) SetMaxAmount(v float64) *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.SetMaxAmount(v)
+	})
+}
+
+// AddMaxAmount adds v to the "max_amount" field.
+func (u *CategorizationRuleUpsertOne) AddMaxAmount(v float64) *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.AddMaxAmount(v)
+	})
+}
+
+// UpdateMaxAmount sets the "max_amount" field to the value that was provided on create.
+func (u *CategorizationRuleUpsertOne) UpdateMaxAmount() *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.UpdateMaxAmount()
+	})
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (u *CategorizationRuleUpsertOne) ClearMaxAmount() *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.ClearMaxAmount()
+	})
+}
+
+// SetDescriptionKeywords sets the "description_keywords" field.
+func (u *CategorizationRuleUpsertOne) SetDescriptionKeywords(v []string) *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.SetDescriptionKeywords(v)
+	})
+}
+
+// UpdateDescriptionKeywords sets the "description_keywords" field to the value that was provided on create.
+func (u *CategorizationRuleUpsertOne) UpdateDescriptionKeywords() *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.UpdateDescriptionKeywords()
+	})
+}
+
+// ClearDescriptionKeywords clears the value of the "description_keywords" field.
+func (u *CategorizationRuleUpsertOne) ClearDescriptionKeywords() *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.ClearDescriptionKeywords()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *CategorizationRuleUpsertOne) SetCategory(v string) *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *CategorizationRuleUpsertOne) UpdateCategory() *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// ClearCategory clears the value of the "category" field.
+func (u *CategorizationRuleUpsertOne) ClearCategory() *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.ClearCategory()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *CategorizationRuleUpsertOne) SetTags(v []string) *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *CategorizationRuleUpsertOne) UpdateTags() *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *CategorizationRuleUpsertOne) ClearTags() *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.ClearTags()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CategorizationRuleUpsertOne) SetUpdatedAt(v time.Time) *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CategorizationRuleUpsertOne) UpdateUpdatedAt() *CategorizationRuleUpsertOne {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CategorizationRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CategorizationRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategorizationRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CategorizationRuleUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CategorizationRuleUpsertOne.ID is not supported by MySQL driver. Use CategorizationRuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CategorizationRuleUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CategorizationRuleCreateBulk is the builder for creating many CategorizationRule entities in bulk.
+type CategorizationRuleCreateBulk struct {
+	config
+	err      error
+	builders []*CategorizationRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CategorizationRule entities in the database.
+func (_c *CategorizationRuleCreateBulk) Save(ctx context.Context) ([]*CategorizationRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CategorizationRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CategorizationRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CategorizationRuleCreateBulk) SaveX(ctx context.Context) []*CategorizationRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategorizationRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategorizationRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CategorizationRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategorizationRuleUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CategorizationRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *CategorizationRuleUpsertBulk {
+	_c.conflict = opts
+	return &CategorizationRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CategorizationRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CategorizationRuleCreateBulk) OnConflictColumns(columns ...string) *CategorizationRuleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CategorizationRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// CategorizationRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of CategorizationRule nodes.
+type CategorizationRuleUpsertBulk struct {
+	create *CategorizationRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CategorizationRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(categorizationrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CategorizationRuleUpsertBulk) UpdateNewValues() *CategorizationRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(categorizationrule.FieldID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(categorizationrule.FieldUserID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(categorizationrule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CategorizationRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CategorizationRuleUpsertBulk) Ignore() *CategorizationRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategorizationRuleUpsertBulk) DoNothing() *CategorizationRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategorizationRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *CategorizationRuleUpsertBulk) Update(set func(*CategorizationRuleUpsert)) *CategorizationRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategorizationRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CategorizationRuleUpsertBulk) SetName(v string) *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CategorizationRuleUpsertBulk) UpdateName() *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *CategorizationRuleUpsertBulk) SetPriority(v int) *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *CategorizationRuleUpsertBulk) AddPriority(v int) *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *CategorizationRuleUpsertBulk) UpdatePriority() *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *CategorizationRuleUpsertBulk) SetEnabled(v bool) *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *CategorizationRuleUpsertBulk) UpdateEnabled() *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetMerchantPattern sets the "merchant_pattern" field.
+func (u *CategorizationRuleUpsertBulk) SetMerchantPattern(v string) *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.SetMerchantPattern(v)
+	})
+}
+
+// UpdateMerchantPattern sets the "merchant_pattern" field to the value that was provided on create.
+func (u *CategorizationRuleUpsertBulk) UpdateMerchantPattern() *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.UpdateMerchantPattern()
+	})
+}
+
+// ClearMerchantPattern clears the value of the "merchant_pattern" field.
+func (u *CategorizationRuleUpsertBulk) ClearMerchantPattern() *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.ClearMerchantPattern()
+	})
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (u *CategorizationRuleUpsertBulk) SetMinAmount(v float64) *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.SetMinAmount(v)
+	})
+}
+
+// AddMinAmount adds v to the "min_amount" field.
+func (u *CategorizationRuleUpsertBulk) AddMinAmount(v float64) *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.AddMinAmount(v)
+	})
+}
+
+// UpdateMinAmount sets the "min_amount" field to the value that was provided on create.
+func (u *CategorizationRuleUpsertBulk) UpdateMinAmount() *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.UpdateMinAmount()
+	})
+}
+
+// ClearMinAmount clears the value of the "min_amount" field.
+func (u *CategorizationRuleUpsertBulk) ClearMinAmount() *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.ClearMinAmount()
+	})
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (u *CategorizationRuleUpsertBulk) SetMaxAmount(v float64) *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.SetMaxAmount(v)
+	})
+}
+
+// AddMaxAmount adds v to the "max_amount" field.
+func (u *CategorizationRuleUpsertBulk) AddMaxAmount(v float64) *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.AddMaxAmount(v)
+	})
+}
+
+// UpdateMaxAmount sets the "max_amount" field to the value that was provided on create.
+func (u *CategorizationRuleUpsertBulk) UpdateMaxAmount() *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.UpdateMaxAmount()
+	})
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (u *CategorizationRuleUpsertBulk) ClearMaxAmount() *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.ClearMaxAmount()
+	})
+}
+
+// SetDescriptionKeywords sets the "description_keywords" field.
+func (u *CategorizationRuleUpsertBulk) SetDescriptionKeywords(v []string) *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.SetDescriptionKeywords(v)
+	})
+}
+
+// UpdateDescriptionKeywords sets the "description_keywords" field to the value that was provided on create.
+func (u *CategorizationRuleUpsertBulk) UpdateDescriptionKeywords() *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.UpdateDescriptionKeywords()
+	})
+}
+
+// ClearDescriptionKeywords clears the value of the "description_keywords" field.
+func (u *CategorizationRuleUpsertBulk) ClearDescriptionKeywords() *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.ClearDescriptionKeywords()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *CategorizationRuleUpsertBulk) SetCategory(v string) *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *CategorizationRuleUpsertBulk) UpdateCategory() *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// ClearCategory clears the value of the "category" field.
+func (u *CategorizationRuleUpsertBulk) ClearCategory() *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.ClearCategory()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *CategorizationRuleUpsertBulk) SetTags(v []string) *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *CategorizationRuleUpsertBulk) UpdateTags() *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *CategorizationRuleUpsertBulk) ClearTags() *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.ClearTags()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CategorizationRuleUpsertBulk) SetUpdatedAt(v time.Time) *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CategorizationRuleUpsertBulk) UpdateUpdatedAt() *CategorizationRuleUpsertBulk {
+	return u.Update(func(s *CategorizationRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CategorizationRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CategorizationRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CategorizationRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategorizationRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,725 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/categorizationrule"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// CategorizationRuleUpdate is the builder for updating CategorizationRule entities.
+type CategorizationRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CategorizationRuleMutation
+}
+
+// Where appends a list predicates to the CategorizationRuleUpdate builder.
+func (_u *CategorizationRuleUpdate) Where(ps ...predicate.CategorizationRule) *CategorizationRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CategorizationRuleUpdate) SetName(v string) *CategorizationRuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CategorizationRuleUpdate) SetNillableName(v *string) *CategorizationRuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *CategorizationRuleUpdate) SetPriority(v int) *CategorizationRuleUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *CategorizationRuleUpdate) SetNillablePriority(v *int) *CategorizationRuleUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *CategorizationRuleUpdate) AddPriority(v int) *CategorizationRuleUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *CategorizationRuleUpdate) SetEnabled(v bool) *CategorizationRuleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *CategorizationRuleUpdate) SetNillableEnabled(v *bool) *CategorizationRuleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetMerchantPattern sets the "merchant_pattern" field.
+func (_u *CategorizationRuleUpdate) SetMerchantPattern(v string) *CategorizationRuleUpdate {
+	_u.mutation.SetMerchantPattern(v)
+	return _u
+}
+
+// SetNillableMerchantPattern sets the "merchant_pattern" field if the given value is not nil.
+func (_u *CategorizationRuleUpdate) SetNillableMerchantPattern(v *string) *CategorizationRuleUpdate {
+	if v != nil {
+		_u.SetMerchantPattern(*v)
+	}
+	return _u
+}
+
+// ClearMerchantPattern clears the value of the "merchant_pattern" field.
+func (_u *CategorizationRuleUpdate) ClearMerchantPattern() *CategorizationRuleUpdate {
+	_u.mutation.ClearMerchantPattern()
+	return _u
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (_u *CategorizationRuleUpdate) SetMinAmount(v float64) *CategorizationRuleUpdate {
+	_u.mutation.ResetMinAmount()
+	_u.mutation.SetMinAmount(v)
+	return _u
+}
+
+// SetNillableMinAmount sets the "min_amount" field if the given value is not nil.
+func (_u *CategorizationRuleUpdate) SetNillableMinAmount(v *float64) *CategorizationRuleUpdate {
+	if v != nil {
+		_u.SetMinAmount(*v)
+	}
+	return _u
+}
+
+// AddMinAmount adds value to the "min_amount" field.
+func (_u *CategorizationRuleUpdate) AddMinAmount(v float64) *CategorizationRuleUpdate {
+	_u.mutation.AddMinAmount(v)
+	return _u
+}
+
+// ClearMinAmount clears the value of the "min_amount" field.
+func (_u *CategorizationRuleUpdate) ClearMinAmount() *CategorizationRuleUpdate {
+	_u.mutation.ClearMinAmount()
+	return _u
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (_u *CategorizationRuleUpdate) SetMaxAmount(v float64) *CategorizationRuleUpdate {
+	_u.mutation.ResetMaxAmount()
+	_u.mutation.SetMaxAmount(v)
+	return _u
+}
+
+// SetNillableMaxAmount sets the "max_amount" field if the given value is not nil.
+func (_u *CategorizationRuleUpdate) SetNillableMaxAmount(v *float64) *CategorizationRuleUpdate {
+	if v != nil {
+		_u.SetMaxAmount(*v)
+	}
+	return _u
+}
+
+// AddMaxAmount adds value to the "max_amount" field.
+func (_u *CategorizationRuleUpdate) AddMaxAmount(v float64) *CategorizationRuleUpdate {
+	_u.mutation.AddMaxAmount(v)
+	return _u
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (_u *CategorizationRuleUpdate) ClearMaxAmount() *CategorizationRuleUpdate {
+	_u.mutation.ClearMaxAmount()
+	return _u
+}
+
+// SetDescriptionKeywords sets the "description_keywords" field.
+func (_u *CategorizationRuleUpdate) SetDescriptionKeywords(v []string) *CategorizationRuleUpdate {
+	_u.mutation.SetDescriptionKeywords(v)
+	return _u
+}
+
+// AppendDescriptionKeywords appends value to the "description_keywords" field.
+func (_u *CategorizationRuleUpdate) AppendDescriptionKeywords(v []string) *CategorizationRuleUpdate {
+	_u.mutation.AppendDescriptionKeywords(v)
+	return _u
+}
+
+// ClearDescriptionKeywords clears the value of the "description_keywords" field.
+func (_u *CategorizationRuleUpdate) ClearDescriptionKeywords() *CategorizationRuleUpdate {
+	_u.mutation.ClearDescriptionKeywords()
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *CategorizationRuleUpdate) SetCategory(v string) *CategorizationRuleUpdate {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *CategorizationRuleUpdate) SetNillableCategory(v *string) *CategorizationRuleUpdate {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// ClearCategory clears the value of the "category" field.
+func (_u *CategorizationRuleUpdate) ClearCategory() *CategorizationRuleUpdate {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *CategorizationRuleUpdate) SetTags(v []string) *CategorizationRuleUpdate {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *CategorizationRuleUpdate) AppendTags(v []string) *CategorizationRuleUpdate {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *CategorizationRuleUpdate) ClearTags() *CategorizationRuleUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CategorizationRuleUpdate) SetUpdatedAt(v time.Time) *CategorizationRuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the CategorizationRuleMutation object of the builder.
+func (_u *CategorizationRuleUpdate) Mutation() *CategorizationRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CategorizationRuleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategorizationRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CategorizationRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategorizationRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CategorizationRuleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := categorizationrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CategorizationRuleUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := categorizationrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CategorizationRule.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CategorizationRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(categorizationrule.Table, categorizationrule.Columns, sqlgraph.NewFieldSpec(categorizationrule.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(categorizationrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(categorizationrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(categorizationrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(categorizationrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MerchantPattern(); ok {
+		_spec.SetField(categorizationrule.FieldMerchantPattern, field.TypeString, value)
+	}
+	if _u.mutation.MerchantPatternCleared() {
+		_spec.ClearField(categorizationrule.FieldMerchantPattern, field.TypeString)
+	}
+	if value, ok := _u.mutation.MinAmount(); ok {
+		_spec.SetField(categorizationrule.FieldMinAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMinAmount(); ok {
+		_spec.AddField(categorizationrule.FieldMinAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.MinAmountCleared() {
+		_spec.ClearField(categorizationrule.FieldMinAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.MaxAmount(); ok {
+		_spec.SetField(categorizationrule.FieldMaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAmount(); ok {
+		_spec.AddField(categorizationrule.FieldMaxAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.MaxAmountCleared() {
+		_spec.ClearField(categorizationrule.FieldMaxAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.DescriptionKeywords(); ok {
+		_spec.SetField(categorizationrule.FieldDescriptionKeywords, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDescriptionKeywords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, categorizationrule.FieldDescriptionKeywords, value)
+		})
+	}
+	if _u.mutation.DescriptionKeywordsCleared() {
+		_spec.ClearField(categorizationrule.FieldDescriptionKeywords, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(categorizationrule.FieldCategory, field.TypeString, value)
+	}
+	if _u.mutation.CategoryCleared() {
+		_spec.ClearField(categorizationrule.FieldCategory, field.TypeString)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(categorizationrule.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, categorizationrule.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(categorizationrule.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(categorizationrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categorizationrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CategorizationRuleUpdateOne is the builder for updating a single CategorizationRule entity.
+type CategorizationRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CategorizationRuleMutation
+}
+
+// SetName sets the "name" field.
+func (_u *CategorizationRuleUpdateOne) SetName(v string) *CategorizationRuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CategorizationRuleUpdateOne) SetNillableName(v *string) *CategorizationRuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *CategorizationRuleUpdateOne) SetPriority(v int) *CategorizationRuleUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *CategorizationRuleUpdateOne) SetNillablePriority(v *int) *CategorizationRuleUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *CategorizationRuleUpdateOne) AddPriority(v int) *CategorizationRuleUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *CategorizationRuleUpdateOne) SetEnabled(v bool) *CategorizationRuleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *CategorizationRuleUpdateOne) SetNillableEnabled(v *bool) *CategorizationRuleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetMerchantPattern sets the "merchant_pattern" field.
+func (_u *CategorizationRuleUpdateOne) SetMerchantPattern(v string) *CategorizationRuleUpdateOne {
+	_u.mutation.SetMerchantPattern(v)
+	return _u
+}
+
+// SetNillableMerchantPattern sets the "merchant_pattern" field if the given value is not nil.
+func (_u *CategorizationRuleUpdateOne) SetNillableMerchantPattern(v *string) *CategorizationRuleUpdateOne {
+	if v != nil {
+		_u.SetMerchantPattern(*v)
+	}
+	return _u
+}
+
+// ClearMerchantPattern clears the value of the "merchant_pattern" field.
+func (_u *CategorizationRuleUpdateOne) ClearMerchantPattern() *CategorizationRuleUpdateOne {
+	_u.mutation.ClearMerchantPattern()
+	return _u
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (_u *CategorizationRuleUpdateOne) SetMinAmount(v float64) *CategorizationRuleUpdateOne {
+	_u.mutation.ResetMinAmount()
+	_u.mutation.SetMinAmount(v)
+	return _u
+}
+
+// SetNillableMinAmount sets the "min_amount" field if the given value is not nil.
+func (_u *CategorizationRuleUpdateOne) SetNillableMinAmount(v *float64) *CategorizationRuleUpdateOne {
+	if v != nil {
+		_u.SetMinAmount(*v)
+	}
+	return _u
+}
+
+// AddMinAmount adds value to the "min_amount" field.
+func (_u *CategorizationRuleUpdateOne) AddMinAmount(v float64) *CategorizationRuleUpdateOne {
+	_u.mutation.AddMinAmount(v)
+	return _u
+}
+
+// ClearMinAmount clears the value of the "min_amount" field.
+func (_u *CategorizationRuleUpdateOne) ClearMinAmount() *CategorizationRuleUpdateOne {
+	_u.mutation.ClearMinAmount()
+	return _u
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (_u *CategorizationRuleUpdateOne) SetMaxAmount(v float64) *CategorizationRuleUpdateOne {
+	_u.mutation.ResetMaxAmount()
+	_u.mutation.SetMaxAmount(v)
+	return _u
+}
+
+// SetNillableMaxAmount sets the "max_amount" field if the given value is not nil.
+func (_u *CategorizationRuleUpdateOne) SetNillableMaxAmount(v *float64) *CategorizationRuleUpdateOne {
+	if v != nil {
+		_u.SetMaxAmount(*v)
+	}
+	return _u
+}
+
+// AddMaxAmount adds value to the "max_amount" field.
+func (_u *CategorizationRuleUpdateOne) AddMaxAmount(v float64) *CategorizationRuleUpdateOne {
+	_u.mutation.AddMaxAmount(v)
+	return _u
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (_u *CategorizationRuleUpdateOne) ClearMaxAmount() *CategorizationRuleUpdateOne {
+	_u.mutation.ClearMaxAmount()
+	return _u
+}
+
+// SetDescriptionKeywords sets the "description_keywords" field.
+func (_u *CategorizationRuleUpdateOne) SetDescriptionKeywords(v []string) *CategorizationRuleUpdateOne {
+	_u.mutation.SetDescriptionKeywords(v)
+	return _u
+}
+
+// AppendDescriptionKeywords appends value to the "description_keywords" field.
+func (_u *CategorizationRuleUpdateOne) AppendDescriptionKeywords(v []string) *CategorizationRuleUpdateOne {
+	_u.mutation.AppendDescriptionKeywords(v)
+	return _u
+}
+
+// ClearDescriptionKeywords clears the value of the "description_keywords" field.
+func (_u *CategorizationRuleUpdateOne) ClearDescriptionKeywords() *CategorizationRuleUpdateOne {
+	_u.mutation.ClearDescriptionKeywords()
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *CategorizationRuleUpdateOne) SetCategory(v string) *CategorizationRuleUpdateOne {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *CategorizationRuleUpdateOne) SetNillableCategory(v *string) *CategorizationRuleUpdateOne {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// ClearCategory clears the value of the "category" field.
+func (_u *CategorizationRuleUpdateOne) ClearCategory() *CategorizationRuleUpdateOne {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *CategorizationRuleUpdateOne) SetTags(v []string) *CategorizationRuleUpdateOne {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *CategorizationRuleUpdateOne) AppendTags(v []string) *CategorizationRuleUpdateOne {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *CategorizationRuleUpdateOne) ClearTags() *CategorizationRuleUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CategorizationRuleUpdateOne) SetUpdatedAt(v time.Time) *CategorizationRuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the CategorizationRuleMutation object of the builder.
+func (_u *CategorizationRuleUpdateOne) Mutation() *CategorizationRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CategorizationRuleUpdate builder.
+func (_u *CategorizationRuleUpdateOne) Where(ps ...predicate.CategorizationRule) *CategorizationRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CategorizationRuleUpdateOne) Select(field string, fields ...string) *CategorizationRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CategorizationRule entity.
+func (_u *CategorizationRuleUpdateOne) Save(ctx context.Context) (*CategorizationRule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategorizationRuleUpdateOne) SaveX(ctx context.Context) *CategorizationRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CategorizationRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategorizationRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CategorizationRuleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := categorizationrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CategorizationRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := categorizationrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CategorizationRule.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CategorizationRuleUpdateOne) sqlSave(ctx context.Context) (_node *CategorizationRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(categorizationrule.Table, categorizationrule.Columns, sqlgraph.NewFieldSpec(categorizationrule.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CategorizationRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, categorizationrule.FieldID)
+		for _, f := range fields {
+			if !categorizationrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != categorizationrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(categorizationrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(categorizationrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(categorizationrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(categorizationrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MerchantPattern(); ok {
+		_spec.SetField(categorizationrule.FieldMerchantPattern, field.TypeString, value)
+	}
+	if _u.mutation.MerchantPatternCleared() {
+		_spec.ClearField(categorizationrule.FieldMerchantPattern, field.TypeString)
+	}
+	if value, ok := _u.mutation.MinAmount(); ok {
+		_spec.SetField(categorizationrule.FieldMinAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMinAmount(); ok {
+		_spec.AddField(categorizationrule.FieldMinAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.MinAmountCleared() {
+		_spec.ClearField(categorizationrule.FieldMinAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.MaxAmount(); ok {
+		_spec.SetField(categorizationrule.FieldMaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAmount(); ok {
+		_spec.AddField(categorizationrule.FieldMaxAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.MaxAmountCleared() {
+		_spec.ClearField(categorizationrule.FieldMaxAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.DescriptionKeywords(); ok {
+		_spec.SetField(categorizationrule.FieldDescriptionKeywords, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDescriptionKeywords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, categorizationrule.FieldDescriptionKeywords, value)
+		})
+	}
+	if _u.mutation.DescriptionKeywordsCleared() {
+		_spec.ClearField(categorizationrule.FieldDescriptionKeywords, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(categorizationrule.FieldCategory, field.TypeString, value)
+	}
+	if _u.mutation.CategoryCleared() {
+		_spec.ClearField(categorizationrule.FieldCategory, field.TypeString)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(categorizationrule.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, categorizationrule.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(categorizationrule.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(categorizationrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &CategorizationRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categorizationrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
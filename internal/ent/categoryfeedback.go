@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/categoryfeedback"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// CategoryFeedback is the model entity for the CategoryFeedback schema.
+type CategoryFeedback struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ID of the user who gave the feedback
+	UserID string `json:"user_id,omitempty"`
+	// TransactionID holds the value of the "transaction_id" field.
+	TransactionID string `json:"transaction_id,omitempty"`
+	// Merchant name of the transaction, as trained on
+	MerchantName *string `json:"merchant_name,omitempty"`
+	// Description of the transaction, as trained on
+	Description *string `json:"description,omitempty"`
+	// SuggestedCategory holds the value of the "suggested_category" field.
+	SuggestedCategory string `json:"suggested_category,omitempty"`
+	// Whether the learned model or a categorization rule made the suggestion
+	SuggestionSource categoryfeedback.SuggestionSource `json:"suggestion_source,omitempty"`
+	// Model's confidence in the suggested category, 0-1
+	Confidence *float64 `json:"confidence,omitempty"`
+	// Accepted holds the value of the "accepted" field.
+	Accepted bool `json:"accepted,omitempty"`
+	// Category the transaction was given: the suggestion if accepted, else the user's correction
+	Category *string `json:"category,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CategoryFeedback) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case categoryfeedback.FieldAccepted:
+			values[i] = new(sql.NullBool)
+		case categoryfeedback.FieldConfidence:
+			values[i] = new(sql.NullFloat64)
+		case categoryfeedback.FieldID, categoryfeedback.FieldUserID, categoryfeedback.FieldTransactionID, categoryfeedback.FieldMerchantName, categoryfeedback.FieldDescription, categoryfeedback.FieldSuggestedCategory, categoryfeedback.FieldSuggestionSource, categoryfeedback.FieldCategory:
+			values[i] = new(sql.NullString)
+		case categoryfeedback.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CategoryFeedback fields.
+func (_m *CategoryFeedback) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case categoryfeedback.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case categoryfeedback.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case categoryfeedback.FieldTransactionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
+			} else if value.Valid {
+				_m.TransactionID = value.String
+			}
+		case categoryfeedback.FieldMerchantName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_name", values[i])
+			} else if value.Valid {
+				_m.MerchantName = new(string)
+				*_m.MerchantName = value.String
+			}
+		case categoryfeedback.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = new(string)
+				*_m.Description = value.String
+			}
+		case categoryfeedback.FieldSuggestedCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field suggested_category", values[i])
+			} else if value.Valid {
+				_m.SuggestedCategory = value.String
+			}
+		case categoryfeedback.FieldSuggestionSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field suggestion_source", values[i])
+			} else if value.Valid {
+				_m.SuggestionSource = categoryfeedback.SuggestionSource(value.String)
+			}
+		case categoryfeedback.FieldConfidence:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field confidence", values[i])
+			} else if value.Valid {
+				_m.Confidence = new(float64)
+				*_m.Confidence = value.Float64
+			}
+		case categoryfeedback.FieldAccepted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted", values[i])
+			} else if value.Valid {
+				_m.Accepted = value.Bool
+			}
+		case categoryfeedback.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = new(string)
+				*_m.Category = value.String
+			}
+		case categoryfeedback.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CategoryFeedback.
+// This includes values selected through modifiers, order, etc.
+func (_m *CategoryFeedback) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CategoryFeedback.
+// Note that you need to call CategoryFeedback.Unwrap() before calling this method if this CategoryFeedback
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CategoryFeedback) Update() *CategoryFeedbackUpdateOne {
+	return NewCategoryFeedbackClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CategoryFeedback entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CategoryFeedback) Unwrap() *CategoryFeedback {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CategoryFeedback is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CategoryFeedback) String() string {
+	var builder strings.Builder
+	builder.WriteString("CategoryFeedback(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("transaction_id=")
+	builder.WriteString(_m.TransactionID)
+	builder.WriteString(", ")
+	if v := _m.MerchantName; v != nil {
+		builder.WriteString("merchant_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("suggested_category=")
+	builder.WriteString(_m.SuggestedCategory)
+	builder.WriteString(", ")
+	builder.WriteString("suggestion_source=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SuggestionSource))
+	builder.WriteString(", ")
+	if v := _m.Confidence; v != nil {
+		builder.WriteString("confidence=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("accepted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Accepted))
+	builder.WriteString(", ")
+	if v := _m.Category; v != nil {
+		builder.WriteString("category=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CategoryFeedbacks is a parsable slice of CategoryFeedback.
+type CategoryFeedbacks []*CategoryFeedback
@@ -0,0 +1,156 @@
+// Code generated by ent, DO NOT EDIT.
+
+package categoryfeedback
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the categoryfeedback type in the database.
+	Label = "category_feedback"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldTransactionID holds the string denoting the transaction_id field in the database.
+	FieldTransactionID = "transaction_id"
+	// FieldMerchantName holds the string denoting the merchant_name field in the database.
+	FieldMerchantName = "merchant_name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldSuggestedCategory holds the string denoting the suggested_category field in the database.
+	FieldSuggestedCategory = "suggested_category"
+	// FieldSuggestionSource holds the string denoting the suggestion_source field in the database.
+	FieldSuggestionSource = "suggestion_source"
+	// FieldConfidence holds the string denoting the confidence field in the database.
+	FieldConfidence = "confidence"
+	// FieldAccepted holds the string denoting the accepted field in the database.
+	FieldAccepted = "accepted"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the categoryfeedback in the database.
+	Table = "category_feedbacks"
+)
+
+// Columns holds all SQL columns for categoryfeedback fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldTransactionID,
+	FieldMerchantName,
+	FieldDescription,
+	FieldSuggestedCategory,
+	FieldSuggestionSource,
+	FieldConfidence,
+	FieldAccepted,
+	FieldCategory,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// TransactionIDValidator is a validator for the "transaction_id" field. It is called by the builders before save.
+	TransactionIDValidator func(string) error
+	// SuggestedCategoryValidator is a validator for the "suggested_category" field. It is called by the builders before save.
+	SuggestedCategoryValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// SuggestionSource defines the type for the "suggestion_source" enum field.
+type SuggestionSource string
+
+// SuggestionSource values.
+const (
+	SuggestionSourceModel SuggestionSource = "model"
+	SuggestionSourceRule  SuggestionSource = "rule"
+)
+
+func (ss SuggestionSource) String() string {
+	return string(ss)
+}
+
+// SuggestionSourceValidator is a validator for the "suggestion_source" field enum values. It is called by the builders before save.
+func SuggestionSourceValidator(ss SuggestionSource) error {
+	switch ss {
+	case SuggestionSourceModel, SuggestionSourceRule:
+		return nil
+	default:
+		return fmt.Errorf("categoryfeedback: invalid enum value for suggestion_source field: %q", ss)
+	}
+}
+
+// OrderOption defines the ordering options for the CategoryFeedback queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByTransactionID orders the results by the transaction_id field.
+func ByTransactionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransactionID, opts...).ToFunc()
+}
+
+// ByMerchantName orders the results by the merchant_name field.
+func ByMerchantName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMerchantName, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// BySuggestedCategory orders the results by the suggested_category field.
+func BySuggestedCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuggestedCategory, opts...).ToFunc()
+}
+
+// BySuggestionSource orders the results by the suggestion_source field.
+func BySuggestionSource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuggestionSource, opts...).ToFunc()
+}
+
+// ByConfidence orders the results by the confidence field.
+func ByConfidence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConfidence, opts...).ToFunc()
+}
+
+// ByAccepted orders the results by the accepted field.
+func ByAccepted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccepted, opts...).ToFunc()
+}
+
+// ByCategory orders the results by the category field.
+func ByCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategory, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,665 @@
+// Code generated by ent, DO NOT EDIT.
+
+package categoryfeedback
+
+import (
+	"clockzen-next/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldContainsFold(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEQ(FieldUserID, v))
+}
+
+// TransactionID applies equality check predicate on the "transaction_id" field. It's identical to TransactionIDEQ.
+func TransactionID(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// MerchantName applies equality check predicate on the "merchant_name" field. It's identical to MerchantNameEQ.
+func MerchantName(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEQ(FieldMerchantName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEQ(FieldDescription, v))
+}
+
+// SuggestedCategory applies equality check predicate on the "suggested_category" field. It's identical to SuggestedCategoryEQ.
+func SuggestedCategory(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEQ(FieldSuggestedCategory, v))
+}
+
+// Confidence applies equality check predicate on the "confidence" field. It's identical to ConfidenceEQ.
+func Confidence(v float64) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEQ(FieldConfidence, v))
+}
+
+// Accepted applies equality check predicate on the "accepted" field. It's identical to AcceptedEQ.
+func Accepted(v bool) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEQ(FieldAccepted, v))
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEQ(FieldCategory, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// TransactionIDEQ applies the EQ predicate on the "transaction_id" field.
+func TransactionIDEQ(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// TransactionIDNEQ applies the NEQ predicate on the "transaction_id" field.
+func TransactionIDNEQ(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNEQ(FieldTransactionID, v))
+}
+
+// TransactionIDIn applies the In predicate on the "transaction_id" field.
+func TransactionIDIn(vs ...string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDNotIn applies the NotIn predicate on the "transaction_id" field.
+func TransactionIDNotIn(vs ...string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNotIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDGT applies the GT predicate on the "transaction_id" field.
+func TransactionIDGT(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldGT(FieldTransactionID, v))
+}
+
+// TransactionIDGTE applies the GTE predicate on the "transaction_id" field.
+func TransactionIDGTE(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldGTE(FieldTransactionID, v))
+}
+
+// TransactionIDLT applies the LT predicate on the "transaction_id" field.
+func TransactionIDLT(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldLT(FieldTransactionID, v))
+}
+
+// TransactionIDLTE applies the LTE predicate on the "transaction_id" field.
+func TransactionIDLTE(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldLTE(FieldTransactionID, v))
+}
+
+// TransactionIDContains applies the Contains predicate on the "transaction_id" field.
+func TransactionIDContains(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldContains(FieldTransactionID, v))
+}
+
+// TransactionIDHasPrefix applies the HasPrefix predicate on the "transaction_id" field.
+func TransactionIDHasPrefix(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldHasPrefix(FieldTransactionID, v))
+}
+
+// TransactionIDHasSuffix applies the HasSuffix predicate on the "transaction_id" field.
+func TransactionIDHasSuffix(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldHasSuffix(FieldTransactionID, v))
+}
+
+// TransactionIDEqualFold applies the EqualFold predicate on the "transaction_id" field.
+func TransactionIDEqualFold(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEqualFold(FieldTransactionID, v))
+}
+
+// TransactionIDContainsFold applies the ContainsFold predicate on the "transaction_id" field.
+func TransactionIDContainsFold(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldContainsFold(FieldTransactionID, v))
+}
+
+// MerchantNameEQ applies the EQ predicate on the "merchant_name" field.
+func MerchantNameEQ(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEQ(FieldMerchantName, v))
+}
+
+// MerchantNameNEQ applies the NEQ predicate on the "merchant_name" field.
+func MerchantNameNEQ(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNEQ(FieldMerchantName, v))
+}
+
+// MerchantNameIn applies the In predicate on the "merchant_name" field.
+func MerchantNameIn(vs ...string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldIn(FieldMerchantName, vs...))
+}
+
+// MerchantNameNotIn applies the NotIn predicate on the "merchant_name" field.
+func MerchantNameNotIn(vs ...string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNotIn(FieldMerchantName, vs...))
+}
+
+// MerchantNameGT applies the GT predicate on the "merchant_name" field.
+func MerchantNameGT(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldGT(FieldMerchantName, v))
+}
+
+// MerchantNameGTE applies the GTE predicate on the "merchant_name" field.
+func MerchantNameGTE(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldGTE(FieldMerchantName, v))
+}
+
+// MerchantNameLT applies the LT predicate on the "merchant_name" field.
+func MerchantNameLT(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldLT(FieldMerchantName, v))
+}
+
+// MerchantNameLTE applies the LTE predicate on the "merchant_name" field.
+func MerchantNameLTE(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldLTE(FieldMerchantName, v))
+}
+
+// MerchantNameContains applies the Contains predicate on the "merchant_name" field.
+func MerchantNameContains(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldContains(FieldMerchantName, v))
+}
+
+// MerchantNameHasPrefix applies the HasPrefix predicate on the "merchant_name" field.
+func MerchantNameHasPrefix(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldHasPrefix(FieldMerchantName, v))
+}
+
+// MerchantNameHasSuffix applies the HasSuffix predicate on the "merchant_name" field.
+func MerchantNameHasSuffix(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldHasSuffix(FieldMerchantName, v))
+}
+
+// MerchantNameIsNil applies the IsNil predicate on the "merchant_name" field.
+func MerchantNameIsNil() predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldIsNull(FieldMerchantName))
+}
+
+// MerchantNameNotNil applies the NotNil predicate on the "merchant_name" field.
+func MerchantNameNotNil() predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNotNull(FieldMerchantName))
+}
+
+// MerchantNameEqualFold applies the EqualFold predicate on the "merchant_name" field.
+func MerchantNameEqualFold(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEqualFold(FieldMerchantName, v))
+}
+
+// MerchantNameContainsFold applies the ContainsFold predicate on the "merchant_name" field.
+func MerchantNameContainsFold(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldContainsFold(FieldMerchantName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// SuggestedCategoryEQ applies the EQ predicate on the "suggested_category" field.
+func SuggestedCategoryEQ(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEQ(FieldSuggestedCategory, v))
+}
+
+// SuggestedCategoryNEQ applies the NEQ predicate on the "suggested_category" field.
+func SuggestedCategoryNEQ(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNEQ(FieldSuggestedCategory, v))
+}
+
+// SuggestedCategoryIn applies the In predicate on the "suggested_category" field.
+func SuggestedCategoryIn(vs ...string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldIn(FieldSuggestedCategory, vs...))
+}
+
+// SuggestedCategoryNotIn applies the NotIn predicate on the "suggested_category" field.
+func SuggestedCategoryNotIn(vs ...string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNotIn(FieldSuggestedCategory, vs...))
+}
+
+// SuggestedCategoryGT applies the GT predicate on the "suggested_category" field.
+func SuggestedCategoryGT(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldGT(FieldSuggestedCategory, v))
+}
+
+// SuggestedCategoryGTE applies the GTE predicate on the "suggested_category" field.
+func SuggestedCategoryGTE(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldGTE(FieldSuggestedCategory, v))
+}
+
+// SuggestedCategoryLT applies the LT predicate on the "suggested_category" field.
+func SuggestedCategoryLT(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldLT(FieldSuggestedCategory, v))
+}
+
+// SuggestedCategoryLTE applies the LTE predicate on the "suggested_category" field.
+func SuggestedCategoryLTE(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldLTE(FieldSuggestedCategory, v))
+}
+
+// SuggestedCategoryContains applies the Contains predicate on the "suggested_category" field.
+func SuggestedCategoryContains(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldContains(FieldSuggestedCategory, v))
+}
+
+// SuggestedCategoryHasPrefix applies the HasPrefix predicate on the "suggested_category" field.
+func SuggestedCategoryHasPrefix(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldHasPrefix(FieldSuggestedCategory, v))
+}
+
+// SuggestedCategoryHasSuffix applies the HasSuffix predicate on the "suggested_category" field.
+func SuggestedCategoryHasSuffix(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldHasSuffix(FieldSuggestedCategory, v))
+}
+
+// SuggestedCategoryEqualFold applies the EqualFold predicate on the "suggested_category" field.
+func SuggestedCategoryEqualFold(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEqualFold(FieldSuggestedCategory, v))
+}
+
+// SuggestedCategoryContainsFold applies the ContainsFold predicate on the "suggested_category" field.
+func SuggestedCategoryContainsFold(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldContainsFold(FieldSuggestedCategory, v))
+}
+
+// SuggestionSourceEQ applies the EQ predicate on the "suggestion_source" field.
+func SuggestionSourceEQ(v SuggestionSource) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEQ(FieldSuggestionSource, v))
+}
+
+// SuggestionSourceNEQ applies the NEQ predicate on the "suggestion_source" field.
+func SuggestionSourceNEQ(v SuggestionSource) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNEQ(FieldSuggestionSource, v))
+}
+
+// SuggestionSourceIn applies the In predicate on the "suggestion_source" field.
+func SuggestionSourceIn(vs ...SuggestionSource) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldIn(FieldSuggestionSource, vs...))
+}
+
+// SuggestionSourceNotIn applies the NotIn predicate on the "suggestion_source" field.
+func SuggestionSourceNotIn(vs ...SuggestionSource) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNotIn(FieldSuggestionSource, vs...))
+}
+
+// ConfidenceEQ applies the EQ predicate on the "confidence" field.
+func ConfidenceEQ(v float64) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEQ(FieldConfidence, v))
+}
+
+// ConfidenceNEQ applies the NEQ predicate on the "confidence" field.
+func ConfidenceNEQ(v float64) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNEQ(FieldConfidence, v))
+}
+
+// ConfidenceIn applies the In predicate on the "confidence" field.
+func ConfidenceIn(vs ...float64) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldIn(FieldConfidence, vs...))
+}
+
+// ConfidenceNotIn applies the NotIn predicate on the "confidence" field.
+func ConfidenceNotIn(vs ...float64) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNotIn(FieldConfidence, vs...))
+}
+
+// ConfidenceGT applies the GT predicate on the "confidence" field.
+func ConfidenceGT(v float64) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldGT(FieldConfidence, v))
+}
+
+// ConfidenceGTE applies the GTE predicate on the "confidence" field.
+func ConfidenceGTE(v float64) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldGTE(FieldConfidence, v))
+}
+
+// ConfidenceLT applies the LT predicate on the "confidence" field.
+func ConfidenceLT(v float64) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldLT(FieldConfidence, v))
+}
+
+// ConfidenceLTE applies the LTE predicate on the "confidence" field.
+func ConfidenceLTE(v float64) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldLTE(FieldConfidence, v))
+}
+
+// ConfidenceIsNil applies the IsNil predicate on the "confidence" field.
+func ConfidenceIsNil() predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldIsNull(FieldConfidence))
+}
+
+// ConfidenceNotNil applies the NotNil predicate on the "confidence" field.
+func ConfidenceNotNil() predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNotNull(FieldConfidence))
+}
+
+// AcceptedEQ applies the EQ predicate on the "accepted" field.
+func AcceptedEQ(v bool) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEQ(FieldAccepted, v))
+}
+
+// AcceptedNEQ applies the NEQ predicate on the "accepted" field.
+func AcceptedNEQ(v bool) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNEQ(FieldAccepted, v))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldGT(FieldCategory, v))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldGTE(FieldCategory, v))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldLT(FieldCategory, v))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldLTE(FieldCategory, v))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldContains(FieldCategory, v))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldHasPrefix(FieldCategory, v))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldHasSuffix(FieldCategory, v))
+}
+
+// CategoryIsNil applies the IsNil predicate on the "category" field.
+func CategoryIsNil() predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldIsNull(FieldCategory))
+}
+
+// CategoryNotNil applies the NotNil predicate on the "category" field.
+func CategoryNotNil() predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNotNull(FieldCategory))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEqualFold(FieldCategory, v))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldContainsFold(FieldCategory, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CategoryFeedback) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CategoryFeedback) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CategoryFeedback) predicate.CategoryFeedback {
+	return predicate.CategoryFeedback(sql.NotPredicates(p))
+}
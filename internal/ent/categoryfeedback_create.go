@@ -0,0 +1,685 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/categoryfeedback"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CategoryFeedbackCreate is the builder for creating a CategoryFeedback entity.
+type CategoryFeedbackCreate struct {
+	config
+	mutation *CategoryFeedbackMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *CategoryFeedbackCreate) SetUserID(v string) *CategoryFeedbackCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_c *CategoryFeedbackCreate) SetTransactionID(v string) *CategoryFeedbackCreate {
+	_c.mutation.SetTransactionID(v)
+	return _c
+}
+
+// SetMerchantName sets the "merchant_name" field.
+func (_c *CategoryFeedbackCreate) SetMerchantName(v string) *CategoryFeedbackCreate {
+	_c.mutation.SetMerchantName(v)
+	return _c
+}
+
+// SetNillableMerchantName sets the "merchant_name" field if the given value is not nil.
+func (_c *CategoryFeedbackCreate) SetNillableMerchantName(v *string) *CategoryFeedbackCreate {
+	if v != nil {
+		_c.SetMerchantName(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *CategoryFeedbackCreate) SetDescription(v string) *CategoryFeedbackCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *CategoryFeedbackCreate) SetNillableDescription(v *string) *CategoryFeedbackCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetSuggestedCategory sets the "suggested_category" field.
+func (_c *CategoryFeedbackCreate) SetSuggestedCategory(v string) *CategoryFeedbackCreate {
+	_c.mutation.SetSuggestedCategory(v)
+	return _c
+}
+
+// SetSuggestionSource sets the "suggestion_source" field.
+func (_c *CategoryFeedbackCreate) SetSuggestionSource(v categoryfeedback.SuggestionSource) *CategoryFeedbackCreate {
+	_c.mutation.SetSuggestionSource(v)
+	return _c
+}
+
+// SetConfidence sets the "confidence" field.
+func (_c *CategoryFeedbackCreate) SetConfidence(v float64) *CategoryFeedbackCreate {
+	_c.mutation.SetConfidence(v)
+	return _c
+}
+
+// SetNillableConfidence sets the "confidence" field if the given value is not nil.
+func (_c *CategoryFeedbackCreate) SetNillableConfidence(v *float64) *CategoryFeedbackCreate {
+	if v != nil {
+		_c.SetConfidence(*v)
+	}
+	return _c
+}
+
+// SetAccepted sets the "accepted" field.
+func (_c *CategoryFeedbackCreate) SetAccepted(v bool) *CategoryFeedbackCreate {
+	_c.mutation.SetAccepted(v)
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *CategoryFeedbackCreate) SetCategory(v string) *CategoryFeedbackCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_c *CategoryFeedbackCreate) SetNillableCategory(v *string) *CategoryFeedbackCreate {
+	if v != nil {
+		_c.SetCategory(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CategoryFeedbackCreate) SetCreatedAt(v time.Time) *CategoryFeedbackCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CategoryFeedbackCreate) SetNillableCreatedAt(v *time.Time) *CategoryFeedbackCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CategoryFeedbackCreate) SetID(v string) *CategoryFeedbackCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the CategoryFeedbackMutation object of the builder.
+func (_c *CategoryFeedbackCreate) Mutation() *CategoryFeedbackMutation {
+	return _c.mutation
+}
+
+// Save creates the CategoryFeedback in the database.
+func (_c *CategoryFeedbackCreate) Save(ctx context.Context) (*CategoryFeedback, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CategoryFeedbackCreate) SaveX(ctx context.Context) *CategoryFeedback {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategoryFeedbackCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategoryFeedbackCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CategoryFeedbackCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := categoryfeedback.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CategoryFeedbackCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "CategoryFeedback.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := categoryfeedback.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "CategoryFeedback.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TransactionID(); !ok {
+		return &ValidationError{Name: "transaction_id", err: errors.New(`ent: missing required field "CategoryFeedback.transaction_id"`)}
+	}
+	if v, ok := _c.mutation.TransactionID(); ok {
+		if err := categoryfeedback.TransactionIDValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_id", err: fmt.Errorf(`ent: validator failed for field "CategoryFeedback.transaction_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SuggestedCategory(); !ok {
+		return &ValidationError{Name: "suggested_category", err: errors.New(`ent: missing required field "CategoryFeedback.suggested_category"`)}
+	}
+	if v, ok := _c.mutation.SuggestedCategory(); ok {
+		if err := categoryfeedback.SuggestedCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "suggested_category", err: fmt.Errorf(`ent: validator failed for field "CategoryFeedback.suggested_category": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SuggestionSource(); !ok {
+		return &ValidationError{Name: "suggestion_source", err: errors.New(`ent: missing required field "CategoryFeedback.suggestion_source"`)}
+	}
+	if v, ok := _c.mutation.SuggestionSource(); ok {
+		if err := categoryfeedback.SuggestionSourceValidator(v); err != nil {
+			return &ValidationError{Name: "suggestion_source", err: fmt.Errorf(`ent: validator failed for field "CategoryFeedback.suggestion_source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Accepted(); !ok {
+		return &ValidationError{Name: "accepted", err: errors.New(`ent: missing required field "CategoryFeedback.accepted"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CategoryFeedback.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *CategoryFeedbackCreate) sqlSave(ctx context.Context) (*CategoryFeedback, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected CategoryFeedback.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CategoryFeedbackCreate) createSpec() (*CategoryFeedback, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CategoryFeedback{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(categoryfeedback.Table, sqlgraph.NewFieldSpec(categoryfeedback.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(categoryfeedback.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.TransactionID(); ok {
+		_spec.SetField(categoryfeedback.FieldTransactionID, field.TypeString, value)
+		_node.TransactionID = value
+	}
+	if value, ok := _c.mutation.MerchantName(); ok {
+		_spec.SetField(categoryfeedback.FieldMerchantName, field.TypeString, value)
+		_node.MerchantName = &value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(categoryfeedback.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := _c.mutation.SuggestedCategory(); ok {
+		_spec.SetField(categoryfeedback.FieldSuggestedCategory, field.TypeString, value)
+		_node.SuggestedCategory = value
+	}
+	if value, ok := _c.mutation.SuggestionSource(); ok {
+		_spec.SetField(categoryfeedback.FieldSuggestionSource, field.TypeEnum, value)
+		_node.SuggestionSource = value
+	}
+	if value, ok := _c.mutation.Confidence(); ok {
+		_spec.SetField(categoryfeedback.FieldConfidence, field.TypeFloat64, value)
+		_node.Confidence = &value
+	}
+	if value, ok := _c.mutation.Accepted(); ok {
+		_spec.SetField(categoryfeedback.FieldAccepted, field.TypeBool, value)
+		_node.Accepted = value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(categoryfeedback.FieldCategory, field.TypeString, value)
+		_node.Category = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(categoryfeedback.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CategoryFeedback.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategoryFeedbackUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CategoryFeedbackCreate) OnConflict(opts ...sql.ConflictOption) *CategoryFeedbackUpsertOne {
+	_c.conflict = opts
+	return &CategoryFeedbackUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CategoryFeedback.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CategoryFeedbackCreate) OnConflictColumns(columns ...string) *CategoryFeedbackUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CategoryFeedbackUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CategoryFeedbackUpsertOne is the builder for "upsert"-ing
+	//  one CategoryFeedback node.
+	CategoryFeedbackUpsertOne struct {
+		create *CategoryFeedbackCreate
+	}
+
+	// CategoryFeedbackUpsert is the "OnConflict" setter.
+	CategoryFeedbackUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CategoryFeedback.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(categoryfeedback.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CategoryFeedbackUpsertOne) UpdateNewValues() *CategoryFeedbackUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(categoryfeedback.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(categoryfeedback.FieldUserID)
+		}
+		if _, exists := u.create.mutation.TransactionID(); exists {
+			s.SetIgnore(categoryfeedback.FieldTransactionID)
+		}
+		if _, exists := u.create.mutation.MerchantName(); exists {
+			s.SetIgnore(categoryfeedback.FieldMerchantName)
+		}
+		if _, exists := u.create.mutation.Description(); exists {
+			s.SetIgnore(categoryfeedback.FieldDescription)
+		}
+		if _, exists := u.create.mutation.SuggestedCategory(); exists {
+			s.SetIgnore(categoryfeedback.FieldSuggestedCategory)
+		}
+		if _, exists := u.create.mutation.SuggestionSource(); exists {
+			s.SetIgnore(categoryfeedback.FieldSuggestionSource)
+		}
+		if _, exists := u.create.mutation.Confidence(); exists {
+			s.SetIgnore(categoryfeedback.FieldConfidence)
+		}
+		if _, exists := u.create.mutation.Accepted(); exists {
+			s.SetIgnore(categoryfeedback.FieldAccepted)
+		}
+		if _, exists := u.create.mutation.Category(); exists {
+			s.SetIgnore(categoryfeedback.FieldCategory)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(categoryfeedback.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CategoryFeedback.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CategoryFeedbackUpsertOne) Ignore() *CategoryFeedbackUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategoryFeedbackUpsertOne) DoNothing() *CategoryFeedbackUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategoryFeedbackCreate.OnConflict
+// documentation for more info.
+func (u *CategoryFeedbackUpsertOne) Update(set func(*CategoryFeedbackUpsert)) *CategoryFeedbackUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategoryFeedbackUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *CategoryFeedbackUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CategoryFeedbackCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategoryFeedbackUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CategoryFeedbackUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CategoryFeedbackUpsertOne.ID is not supported by MySQL driver. Use CategoryFeedbackUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CategoryFeedbackUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CategoryFeedbackCreateBulk is the builder for creating many CategoryFeedback entities in bulk.
+type CategoryFeedbackCreateBulk struct {
+	config
+	err      error
+	builders []*CategoryFeedbackCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CategoryFeedback entities in the database.
+func (_c *CategoryFeedbackCreateBulk) Save(ctx context.Context) ([]*CategoryFeedback, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CategoryFeedback, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CategoryFeedbackMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CategoryFeedbackCreateBulk) SaveX(ctx context.Context) []*CategoryFeedback {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategoryFeedbackCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategoryFeedbackCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CategoryFeedback.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategoryFeedbackUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CategoryFeedbackCreateBulk) OnConflict(opts ...sql.ConflictOption) *CategoryFeedbackUpsertBulk {
+	_c.conflict = opts
+	return &CategoryFeedbackUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CategoryFeedback.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CategoryFeedbackCreateBulk) OnConflictColumns(columns ...string) *CategoryFeedbackUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CategoryFeedbackUpsertBulk{
+		create: _c,
+	}
+}
+
+// CategoryFeedbackUpsertBulk is the builder for "upsert"-ing
+// a bulk of CategoryFeedback nodes.
+type CategoryFeedbackUpsertBulk struct {
+	create *CategoryFeedbackCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CategoryFeedback.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(categoryfeedback.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CategoryFeedbackUpsertBulk) UpdateNewValues() *CategoryFeedbackUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(categoryfeedback.FieldID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(categoryfeedback.FieldUserID)
+			}
+			if _, exists := b.mutation.TransactionID(); exists {
+				s.SetIgnore(categoryfeedback.FieldTransactionID)
+			}
+			if _, exists := b.mutation.MerchantName(); exists {
+				s.SetIgnore(categoryfeedback.FieldMerchantName)
+			}
+			if _, exists := b.mutation.Description(); exists {
+				s.SetIgnore(categoryfeedback.FieldDescription)
+			}
+			if _, exists := b.mutation.SuggestedCategory(); exists {
+				s.SetIgnore(categoryfeedback.FieldSuggestedCategory)
+			}
+			if _, exists := b.mutation.SuggestionSource(); exists {
+				s.SetIgnore(categoryfeedback.FieldSuggestionSource)
+			}
+			if _, exists := b.mutation.Confidence(); exists {
+				s.SetIgnore(categoryfeedback.FieldConfidence)
+			}
+			if _, exists := b.mutation.Accepted(); exists {
+				s.SetIgnore(categoryfeedback.FieldAccepted)
+			}
+			if _, exists := b.mutation.Category(); exists {
+				s.SetIgnore(categoryfeedback.FieldCategory)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(categoryfeedback.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CategoryFeedback.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CategoryFeedbackUpsertBulk) Ignore() *CategoryFeedbackUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategoryFeedbackUpsertBulk) DoNothing() *CategoryFeedbackUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategoryFeedbackCreateBulk.OnConflict
+// documentation for more info.
+func (u *CategoryFeedbackUpsertBulk) Update(set func(*CategoryFeedbackUpsert)) *CategoryFeedbackUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategoryFeedbackUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *CategoryFeedbackUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CategoryFeedbackCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CategoryFeedbackCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategoryFeedbackUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
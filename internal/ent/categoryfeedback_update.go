@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/categoryfeedback"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CategoryFeedbackUpdate is the builder for updating CategoryFeedback entities.
+type CategoryFeedbackUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CategoryFeedbackMutation
+}
+
+// Where appends a list predicates to the CategoryFeedbackUpdate builder.
+func (_u *CategoryFeedbackUpdate) Where(ps ...predicate.CategoryFeedback) *CategoryFeedbackUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the CategoryFeedbackMutation object of the builder.
+func (_u *CategoryFeedbackUpdate) Mutation() *CategoryFeedbackMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CategoryFeedbackUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategoryFeedbackUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CategoryFeedbackUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategoryFeedbackUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *CategoryFeedbackUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(categoryfeedback.Table, categoryfeedback.Columns, sqlgraph.NewFieldSpec(categoryfeedback.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.MerchantNameCleared() {
+		_spec.ClearField(categoryfeedback.FieldMerchantName, field.TypeString)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(categoryfeedback.FieldDescription, field.TypeString)
+	}
+	if _u.mutation.ConfidenceCleared() {
+		_spec.ClearField(categoryfeedback.FieldConfidence, field.TypeFloat64)
+	}
+	if _u.mutation.CategoryCleared() {
+		_spec.ClearField(categoryfeedback.FieldCategory, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categoryfeedback.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CategoryFeedbackUpdateOne is the builder for updating a single CategoryFeedback entity.
+type CategoryFeedbackUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CategoryFeedbackMutation
+}
+
+// Mutation returns the CategoryFeedbackMutation object of the builder.
+func (_u *CategoryFeedbackUpdateOne) Mutation() *CategoryFeedbackMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CategoryFeedbackUpdate builder.
+func (_u *CategoryFeedbackUpdateOne) Where(ps ...predicate.CategoryFeedback) *CategoryFeedbackUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CategoryFeedbackUpdateOne) Select(field string, fields ...string) *CategoryFeedbackUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CategoryFeedback entity.
+func (_u *CategoryFeedbackUpdateOne) Save(ctx context.Context) (*CategoryFeedback, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategoryFeedbackUpdateOne) SaveX(ctx context.Context) *CategoryFeedback {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CategoryFeedbackUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategoryFeedbackUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *CategoryFeedbackUpdateOne) sqlSave(ctx context.Context) (_node *CategoryFeedback, err error) {
+	_spec := sqlgraph.NewUpdateSpec(categoryfeedback.Table, categoryfeedback.Columns, sqlgraph.NewFieldSpec(categoryfeedback.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CategoryFeedback.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, categoryfeedback.FieldID)
+		for _, f := range fields {
+			if !categoryfeedback.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != categoryfeedback.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.MerchantNameCleared() {
+		_spec.ClearField(categoryfeedback.FieldMerchantName, field.TypeString)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(categoryfeedback.FieldDescription, field.TypeString)
+	}
+	if _u.mutation.ConfidenceCleared() {
+		_spec.ClearField(categoryfeedback.FieldConfidence, field.TypeFloat64)
+	}
+	if _u.mutation.CategoryCleared() {
+		_spec.ClearField(categoryfeedback.FieldCategory, field.TypeString)
+	}
+	_node = &CategoryFeedback{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categoryfeedback.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
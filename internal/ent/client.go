@@ -11,18 +11,54 @@ import (
 
 	"clockzen-next/internal/ent/migrate"
 
+	"clockzen-next/internal/ent/accountdeletion"
+	"clockzen-next/internal/ent/alert"
+	"clockzen-next/internal/ent/alertpreference"
+	"clockzen-next/internal/ent/attachmentblob"
+	"clockzen-next/internal/ent/attachmentlink"
+	"clockzen-next/internal/ent/budgetreallocation"
+	"clockzen-next/internal/ent/bulkoperation"
+	"clockzen-next/internal/ent/cardaccount"
+	"clockzen-next/internal/ent/categorizationrule"
+	"clockzen-next/internal/ent/categoryfeedback"
+	"clockzen-next/internal/ent/debt"
 	"clockzen-next/internal/ent/emailconnection"
 	"clockzen-next/internal/ent/emaillabel"
+	"clockzen-next/internal/ent/emailmessage"
 	"clockzen-next/internal/ent/emailsync"
+	"clockzen-next/internal/ent/emailsyncfailure"
+	"clockzen-next/internal/ent/emergencyfundsnapshot"
+	"clockzen-next/internal/ent/emergencyfundtarget"
+	"clockzen-next/internal/ent/goal"
 	"clockzen-next/internal/ent/googledriveconnection"
 	"clockzen-next/internal/ent/googledrivefolder"
 	"clockzen-next/internal/ent/googledrivesync"
+	"clockzen-next/internal/ent/householdmember"
+	"clockzen-next/internal/ent/jobqueue"
+	"clockzen-next/internal/ent/jobschedule"
+	"clockzen-next/internal/ent/jobschedulerun"
 	"clockzen-next/internal/ent/lineitem"
+	"clockzen-next/internal/ent/liquidaccount"
+	"clockzen-next/internal/ent/membership"
+	"clockzen-next/internal/ent/merchant"
+	"clockzen-next/internal/ent/migrationstate"
+	"clockzen-next/internal/ent/notification"
+	"clockzen-next/internal/ent/oauthstate"
+	"clockzen-next/internal/ent/ocrfeedback"
+	"clockzen-next/internal/ent/organization"
 	"clockzen-next/internal/ent/pipelineconfig"
 	"clockzen-next/internal/ent/pipelinerule"
 	"clockzen-next/internal/ent/pipelineversion"
+	"clockzen-next/internal/ent/queuedjob"
 	"clockzen-next/internal/ent/receipt"
+	"clockzen-next/internal/ent/receiptevent"
+	"clockzen-next/internal/ent/roundingrule"
+	"clockzen-next/internal/ent/savedfilter"
+	"clockzen-next/internal/ent/taxyear"
 	"clockzen-next/internal/ent/transaction"
+	"clockzen-next/internal/ent/user"
+	"clockzen-next/internal/ent/webhookdelivery"
+	"clockzen-next/internal/ent/webhookendpoint"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -35,30 +71,102 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AccountDeletion is the client for interacting with the AccountDeletion builders.
+	AccountDeletion *AccountDeletionClient
+	// Alert is the client for interacting with the Alert builders.
+	Alert *AlertClient
+	// AlertPreference is the client for interacting with the AlertPreference builders.
+	AlertPreference *AlertPreferenceClient
+	// AttachmentBlob is the client for interacting with the AttachmentBlob builders.
+	AttachmentBlob *AttachmentBlobClient
+	// AttachmentLink is the client for interacting with the AttachmentLink builders.
+	AttachmentLink *AttachmentLinkClient
+	// BudgetReallocation is the client for interacting with the BudgetReallocation builders.
+	BudgetReallocation *BudgetReallocationClient
+	// BulkOperation is the client for interacting with the BulkOperation builders.
+	BulkOperation *BulkOperationClient
+	// CardAccount is the client for interacting with the CardAccount builders.
+	CardAccount *CardAccountClient
+	// CategorizationRule is the client for interacting with the CategorizationRule builders.
+	CategorizationRule *CategorizationRuleClient
+	// CategoryFeedback is the client for interacting with the CategoryFeedback builders.
+	CategoryFeedback *CategoryFeedbackClient
+	// Debt is the client for interacting with the Debt builders.
+	Debt *DebtClient
 	// EmailConnection is the client for interacting with the EmailConnection builders.
 	EmailConnection *EmailConnectionClient
 	// EmailLabel is the client for interacting with the EmailLabel builders.
 	EmailLabel *EmailLabelClient
+	// EmailMessage is the client for interacting with the EmailMessage builders.
+	EmailMessage *EmailMessageClient
 	// EmailSync is the client for interacting with the EmailSync builders.
 	EmailSync *EmailSyncClient
+	// EmailSyncFailure is the client for interacting with the EmailSyncFailure builders.
+	EmailSyncFailure *EmailSyncFailureClient
+	// EmergencyFundSnapshot is the client for interacting with the EmergencyFundSnapshot builders.
+	EmergencyFundSnapshot *EmergencyFundSnapshotClient
+	// EmergencyFundTarget is the client for interacting with the EmergencyFundTarget builders.
+	EmergencyFundTarget *EmergencyFundTargetClient
+	// Goal is the client for interacting with the Goal builders.
+	Goal *GoalClient
 	// GoogleDriveConnection is the client for interacting with the GoogleDriveConnection builders.
 	GoogleDriveConnection *GoogleDriveConnectionClient
 	// GoogleDriveFolder is the client for interacting with the GoogleDriveFolder builders.
 	GoogleDriveFolder *GoogleDriveFolderClient
 	// GoogleDriveSync is the client for interacting with the GoogleDriveSync builders.
 	GoogleDriveSync *GoogleDriveSyncClient
+	// HouseholdMember is the client for interacting with the HouseholdMember builders.
+	HouseholdMember *HouseholdMemberClient
+	// JobQueue is the client for interacting with the JobQueue builders.
+	JobQueue *JobQueueClient
+	// JobSchedule is the client for interacting with the JobSchedule builders.
+	JobSchedule *JobScheduleClient
+	// JobScheduleRun is the client for interacting with the JobScheduleRun builders.
+	JobScheduleRun *JobScheduleRunClient
 	// LineItem is the client for interacting with the LineItem builders.
 	LineItem *LineItemClient
+	// LiquidAccount is the client for interacting with the LiquidAccount builders.
+	LiquidAccount *LiquidAccountClient
+	// Membership is the client for interacting with the Membership builders.
+	Membership *MembershipClient
+	// Merchant is the client for interacting with the Merchant builders.
+	Merchant *MerchantClient
+	// MigrationState is the client for interacting with the MigrationState builders.
+	MigrationState *MigrationStateClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// OAuthState is the client for interacting with the OAuthState builders.
+	OAuthState *OAuthStateClient
+	// OCRFeedback is the client for interacting with the OCRFeedback builders.
+	OCRFeedback *OCRFeedbackClient
+	// Organization is the client for interacting with the Organization builders.
+	Organization *OrganizationClient
 	// PipelineConfig is the client for interacting with the PipelineConfig builders.
 	PipelineConfig *PipelineConfigClient
 	// PipelineRule is the client for interacting with the PipelineRule builders.
 	PipelineRule *PipelineRuleClient
 	// PipelineVersion is the client for interacting with the PipelineVersion builders.
 	PipelineVersion *PipelineVersionClient
+	// QueuedJob is the client for interacting with the QueuedJob builders.
+	QueuedJob *QueuedJobClient
 	// Receipt is the client for interacting with the Receipt builders.
 	Receipt *ReceiptClient
+	// ReceiptEvent is the client for interacting with the ReceiptEvent builders.
+	ReceiptEvent *ReceiptEventClient
+	// RoundingRule is the client for interacting with the RoundingRule builders.
+	RoundingRule *RoundingRuleClient
+	// SavedFilter is the client for interacting with the SavedFilter builders.
+	SavedFilter *SavedFilterClient
+	// TaxYear is the client for interacting with the TaxYear builders.
+	TaxYear *TaxYearClient
 	// Transaction is the client for interacting with the Transaction builders.
 	Transaction *TransactionClient
+	// User is the client for interacting with the User builders.
+	User *UserClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookEndpoint is the client for interacting with the WebhookEndpoint builders.
+	WebhookEndpoint *WebhookEndpointClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -70,18 +178,54 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AccountDeletion = NewAccountDeletionClient(c.config)
+	c.Alert = NewAlertClient(c.config)
+	c.AlertPreference = NewAlertPreferenceClient(c.config)
+	c.AttachmentBlob = NewAttachmentBlobClient(c.config)
+	c.AttachmentLink = NewAttachmentLinkClient(c.config)
+	c.BudgetReallocation = NewBudgetReallocationClient(c.config)
+	c.BulkOperation = NewBulkOperationClient(c.config)
+	c.CardAccount = NewCardAccountClient(c.config)
+	c.CategorizationRule = NewCategorizationRuleClient(c.config)
+	c.CategoryFeedback = NewCategoryFeedbackClient(c.config)
+	c.Debt = NewDebtClient(c.config)
 	c.EmailConnection = NewEmailConnectionClient(c.config)
 	c.EmailLabel = NewEmailLabelClient(c.config)
+	c.EmailMessage = NewEmailMessageClient(c.config)
 	c.EmailSync = NewEmailSyncClient(c.config)
+	c.EmailSyncFailure = NewEmailSyncFailureClient(c.config)
+	c.EmergencyFundSnapshot = NewEmergencyFundSnapshotClient(c.config)
+	c.EmergencyFundTarget = NewEmergencyFundTargetClient(c.config)
+	c.Goal = NewGoalClient(c.config)
 	c.GoogleDriveConnection = NewGoogleDriveConnectionClient(c.config)
 	c.GoogleDriveFolder = NewGoogleDriveFolderClient(c.config)
 	c.GoogleDriveSync = NewGoogleDriveSyncClient(c.config)
+	c.HouseholdMember = NewHouseholdMemberClient(c.config)
+	c.JobQueue = NewJobQueueClient(c.config)
+	c.JobSchedule = NewJobScheduleClient(c.config)
+	c.JobScheduleRun = NewJobScheduleRunClient(c.config)
 	c.LineItem = NewLineItemClient(c.config)
+	c.LiquidAccount = NewLiquidAccountClient(c.config)
+	c.Membership = NewMembershipClient(c.config)
+	c.Merchant = NewMerchantClient(c.config)
+	c.MigrationState = NewMigrationStateClient(c.config)
+	c.Notification = NewNotificationClient(c.config)
+	c.OAuthState = NewOAuthStateClient(c.config)
+	c.OCRFeedback = NewOCRFeedbackClient(c.config)
+	c.Organization = NewOrganizationClient(c.config)
 	c.PipelineConfig = NewPipelineConfigClient(c.config)
 	c.PipelineRule = NewPipelineRuleClient(c.config)
 	c.PipelineVersion = NewPipelineVersionClient(c.config)
+	c.QueuedJob = NewQueuedJobClient(c.config)
 	c.Receipt = NewReceiptClient(c.config)
+	c.ReceiptEvent = NewReceiptEventClient(c.config)
+	c.RoundingRule = NewRoundingRuleClient(c.config)
+	c.SavedFilter = NewSavedFilterClient(c.config)
+	c.TaxYear = NewTaxYearClient(c.config)
 	c.Transaction = NewTransactionClient(c.config)
+	c.User = NewUserClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
+	c.WebhookEndpoint = NewWebhookEndpointClient(c.config)
 }
 
 type (
@@ -174,18 +318,54 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                   ctx,
 		config:                cfg,
+		AccountDeletion:       NewAccountDeletionClient(cfg),
+		Alert:                 NewAlertClient(cfg),
+		AlertPreference:       NewAlertPreferenceClient(cfg),
+		AttachmentBlob:        NewAttachmentBlobClient(cfg),
+		AttachmentLink:        NewAttachmentLinkClient(cfg),
+		BudgetReallocation:    NewBudgetReallocationClient(cfg),
+		BulkOperation:         NewBulkOperationClient(cfg),
+		CardAccount:           NewCardAccountClient(cfg),
+		CategorizationRule:    NewCategorizationRuleClient(cfg),
+		CategoryFeedback:      NewCategoryFeedbackClient(cfg),
+		Debt:                  NewDebtClient(cfg),
 		EmailConnection:       NewEmailConnectionClient(cfg),
 		EmailLabel:            NewEmailLabelClient(cfg),
+		EmailMessage:          NewEmailMessageClient(cfg),
 		EmailSync:             NewEmailSyncClient(cfg),
+		EmailSyncFailure:      NewEmailSyncFailureClient(cfg),
+		EmergencyFundSnapshot: NewEmergencyFundSnapshotClient(cfg),
+		EmergencyFundTarget:   NewEmergencyFundTargetClient(cfg),
+		Goal:                  NewGoalClient(cfg),
 		GoogleDriveConnection: NewGoogleDriveConnectionClient(cfg),
 		GoogleDriveFolder:     NewGoogleDriveFolderClient(cfg),
 		GoogleDriveSync:       NewGoogleDriveSyncClient(cfg),
+		HouseholdMember:       NewHouseholdMemberClient(cfg),
+		JobQueue:              NewJobQueueClient(cfg),
+		JobSchedule:           NewJobScheduleClient(cfg),
+		JobScheduleRun:        NewJobScheduleRunClient(cfg),
 		LineItem:              NewLineItemClient(cfg),
+		LiquidAccount:         NewLiquidAccountClient(cfg),
+		Membership:            NewMembershipClient(cfg),
+		Merchant:              NewMerchantClient(cfg),
+		MigrationState:        NewMigrationStateClient(cfg),
+		Notification:          NewNotificationClient(cfg),
+		OAuthState:            NewOAuthStateClient(cfg),
+		OCRFeedback:           NewOCRFeedbackClient(cfg),
+		Organization:          NewOrganizationClient(cfg),
 		PipelineConfig:        NewPipelineConfigClient(cfg),
 		PipelineRule:          NewPipelineRuleClient(cfg),
 		PipelineVersion:       NewPipelineVersionClient(cfg),
+		QueuedJob:             NewQueuedJobClient(cfg),
 		Receipt:               NewReceiptClient(cfg),
+		ReceiptEvent:          NewReceiptEventClient(cfg),
+		RoundingRule:          NewRoundingRuleClient(cfg),
+		SavedFilter:           NewSavedFilterClient(cfg),
+		TaxYear:               NewTaxYearClient(cfg),
 		Transaction:           NewTransactionClient(cfg),
+		User:                  NewUserClient(cfg),
+		WebhookDelivery:       NewWebhookDeliveryClient(cfg),
+		WebhookEndpoint:       NewWebhookEndpointClient(cfg),
 	}, nil
 }
 
@@ -205,25 +385,61 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                   ctx,
 		config:                cfg,
+		AccountDeletion:       NewAccountDeletionClient(cfg),
+		Alert:                 NewAlertClient(cfg),
+		AlertPreference:       NewAlertPreferenceClient(cfg),
+		AttachmentBlob:        NewAttachmentBlobClient(cfg),
+		AttachmentLink:        NewAttachmentLinkClient(cfg),
+		BudgetReallocation:    NewBudgetReallocationClient(cfg),
+		BulkOperation:         NewBulkOperationClient(cfg),
+		CardAccount:           NewCardAccountClient(cfg),
+		CategorizationRule:    NewCategorizationRuleClient(cfg),
+		CategoryFeedback:      NewCategoryFeedbackClient(cfg),
+		Debt:                  NewDebtClient(cfg),
 		EmailConnection:       NewEmailConnectionClient(cfg),
 		EmailLabel:            NewEmailLabelClient(cfg),
+		EmailMessage:          NewEmailMessageClient(cfg),
 		EmailSync:             NewEmailSyncClient(cfg),
+		EmailSyncFailure:      NewEmailSyncFailureClient(cfg),
+		EmergencyFundSnapshot: NewEmergencyFundSnapshotClient(cfg),
+		EmergencyFundTarget:   NewEmergencyFundTargetClient(cfg),
+		Goal:                  NewGoalClient(cfg),
 		GoogleDriveConnection: NewGoogleDriveConnectionClient(cfg),
 		GoogleDriveFolder:     NewGoogleDriveFolderClient(cfg),
 		GoogleDriveSync:       NewGoogleDriveSyncClient(cfg),
+		HouseholdMember:       NewHouseholdMemberClient(cfg),
+		JobQueue:              NewJobQueueClient(cfg),
+		JobSchedule:           NewJobScheduleClient(cfg),
+		JobScheduleRun:        NewJobScheduleRunClient(cfg),
 		LineItem:              NewLineItemClient(cfg),
+		LiquidAccount:         NewLiquidAccountClient(cfg),
+		Membership:            NewMembershipClient(cfg),
+		Merchant:              NewMerchantClient(cfg),
+		MigrationState:        NewMigrationStateClient(cfg),
+		Notification:          NewNotificationClient(cfg),
+		OAuthState:            NewOAuthStateClient(cfg),
+		OCRFeedback:           NewOCRFeedbackClient(cfg),
+		Organization:          NewOrganizationClient(cfg),
 		PipelineConfig:        NewPipelineConfigClient(cfg),
 		PipelineRule:          NewPipelineRuleClient(cfg),
 		PipelineVersion:       NewPipelineVersionClient(cfg),
+		QueuedJob:             NewQueuedJobClient(cfg),
 		Receipt:               NewReceiptClient(cfg),
+		ReceiptEvent:          NewReceiptEventClient(cfg),
+		RoundingRule:          NewRoundingRuleClient(cfg),
+		SavedFilter:           NewSavedFilterClient(cfg),
+		TaxYear:               NewTaxYearClient(cfg),
 		Transaction:           NewTransactionClient(cfg),
+		User:                  NewUserClient(cfg),
+		WebhookDelivery:       NewWebhookDeliveryClient(cfg),
+		WebhookEndpoint:       NewWebhookEndpointClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		EmailConnection.
+//		AccountDeletion.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -246,9 +462,18 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.EmailConnection, c.EmailLabel, c.EmailSync, c.GoogleDriveConnection,
-		c.GoogleDriveFolder, c.GoogleDriveSync, c.LineItem, c.PipelineConfig,
-		c.PipelineRule, c.PipelineVersion, c.Receipt, c.Transaction,
+		c.AccountDeletion, c.Alert, c.AlertPreference, c.AttachmentBlob,
+		c.AttachmentLink, c.BudgetReallocation, c.BulkOperation, c.CardAccount,
+		c.CategorizationRule, c.CategoryFeedback, c.Debt, c.EmailConnection,
+		c.EmailLabel, c.EmailMessage, c.EmailSync, c.EmailSyncFailure,
+		c.EmergencyFundSnapshot, c.EmergencyFundTarget, c.Goal,
+		c.GoogleDriveConnection, c.GoogleDriveFolder, c.GoogleDriveSync,
+		c.HouseholdMember, c.JobQueue, c.JobSchedule, c.JobScheduleRun, c.LineItem,
+		c.LiquidAccount, c.Membership, c.Merchant, c.MigrationState, c.Notification,
+		c.OAuthState, c.OCRFeedback, c.Organization, c.PipelineConfig, c.PipelineRule,
+		c.PipelineVersion, c.QueuedJob, c.Receipt, c.ReceiptEvent, c.RoundingRule,
+		c.SavedFilter, c.TaxYear, c.Transaction, c.User, c.WebhookDelivery,
+		c.WebhookEndpoint,
 	} {
 		n.Use(hooks...)
 	}
@@ -258,9 +483,18 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.EmailConnection, c.EmailLabel, c.EmailSync, c.GoogleDriveConnection,
-		c.GoogleDriveFolder, c.GoogleDriveSync, c.LineItem, c.PipelineConfig,
-		c.PipelineRule, c.PipelineVersion, c.Receipt, c.Transaction,
+		c.AccountDeletion, c.Alert, c.AlertPreference, c.AttachmentBlob,
+		c.AttachmentLink, c.BudgetReallocation, c.BulkOperation, c.CardAccount,
+		c.CategorizationRule, c.CategoryFeedback, c.Debt, c.EmailConnection,
+		c.EmailLabel, c.EmailMessage, c.EmailSync, c.EmailSyncFailure,
+		c.EmergencyFundSnapshot, c.EmergencyFundTarget, c.Goal,
+		c.GoogleDriveConnection, c.GoogleDriveFolder, c.GoogleDriveSync,
+		c.HouseholdMember, c.JobQueue, c.JobSchedule, c.JobScheduleRun, c.LineItem,
+		c.LiquidAccount, c.Membership, c.Merchant, c.MigrationState, c.Notification,
+		c.OAuthState, c.OCRFeedback, c.Organization, c.PipelineConfig, c.PipelineRule,
+		c.PipelineVersion, c.QueuedJob, c.Receipt, c.ReceiptEvent, c.RoundingRule,
+		c.SavedFilter, c.TaxYear, c.Transaction, c.User, c.WebhookDelivery,
+		c.WebhookEndpoint,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -269,136 +503,208 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AccountDeletionMutation:
+		return c.AccountDeletion.mutate(ctx, m)
+	case *AlertMutation:
+		return c.Alert.mutate(ctx, m)
+	case *AlertPreferenceMutation:
+		return c.AlertPreference.mutate(ctx, m)
+	case *AttachmentBlobMutation:
+		return c.AttachmentBlob.mutate(ctx, m)
+	case *AttachmentLinkMutation:
+		return c.AttachmentLink.mutate(ctx, m)
+	case *BudgetReallocationMutation:
+		return c.BudgetReallocation.mutate(ctx, m)
+	case *BulkOperationMutation:
+		return c.BulkOperation.mutate(ctx, m)
+	case *CardAccountMutation:
+		return c.CardAccount.mutate(ctx, m)
+	case *CategorizationRuleMutation:
+		return c.CategorizationRule.mutate(ctx, m)
+	case *CategoryFeedbackMutation:
+		return c.CategoryFeedback.mutate(ctx, m)
+	case *DebtMutation:
+		return c.Debt.mutate(ctx, m)
 	case *EmailConnectionMutation:
 		return c.EmailConnection.mutate(ctx, m)
 	case *EmailLabelMutation:
 		return c.EmailLabel.mutate(ctx, m)
+	case *EmailMessageMutation:
+		return c.EmailMessage.mutate(ctx, m)
 	case *EmailSyncMutation:
 		return c.EmailSync.mutate(ctx, m)
+	case *EmailSyncFailureMutation:
+		return c.EmailSyncFailure.mutate(ctx, m)
+	case *EmergencyFundSnapshotMutation:
+		return c.EmergencyFundSnapshot.mutate(ctx, m)
+	case *EmergencyFundTargetMutation:
+		return c.EmergencyFundTarget.mutate(ctx, m)
+	case *GoalMutation:
+		return c.Goal.mutate(ctx, m)
 	case *GoogleDriveConnectionMutation:
 		return c.GoogleDriveConnection.mutate(ctx, m)
 	case *GoogleDriveFolderMutation:
 		return c.GoogleDriveFolder.mutate(ctx, m)
 	case *GoogleDriveSyncMutation:
 		return c.GoogleDriveSync.mutate(ctx, m)
+	case *HouseholdMemberMutation:
+		return c.HouseholdMember.mutate(ctx, m)
+	case *JobQueueMutation:
+		return c.JobQueue.mutate(ctx, m)
+	case *JobScheduleMutation:
+		return c.JobSchedule.mutate(ctx, m)
+	case *JobScheduleRunMutation:
+		return c.JobScheduleRun.mutate(ctx, m)
 	case *LineItemMutation:
 		return c.LineItem.mutate(ctx, m)
+	case *LiquidAccountMutation:
+		return c.LiquidAccount.mutate(ctx, m)
+	case *MembershipMutation:
+		return c.Membership.mutate(ctx, m)
+	case *MerchantMutation:
+		return c.Merchant.mutate(ctx, m)
+	case *MigrationStateMutation:
+		return c.MigrationState.mutate(ctx, m)
+	case *NotificationMutation:
+		return c.Notification.mutate(ctx, m)
+	case *OAuthStateMutation:
+		return c.OAuthState.mutate(ctx, m)
+	case *OCRFeedbackMutation:
+		return c.OCRFeedback.mutate(ctx, m)
+	case *OrganizationMutation:
+		return c.Organization.mutate(ctx, m)
 	case *PipelineConfigMutation:
 		return c.PipelineConfig.mutate(ctx, m)
 	case *PipelineRuleMutation:
 		return c.PipelineRule.mutate(ctx, m)
 	case *PipelineVersionMutation:
 		return c.PipelineVersion.mutate(ctx, m)
+	case *QueuedJobMutation:
+		return c.QueuedJob.mutate(ctx, m)
 	case *ReceiptMutation:
 		return c.Receipt.mutate(ctx, m)
+	case *ReceiptEventMutation:
+		return c.ReceiptEvent.mutate(ctx, m)
+	case *RoundingRuleMutation:
+		return c.RoundingRule.mutate(ctx, m)
+	case *SavedFilterMutation:
+		return c.SavedFilter.mutate(ctx, m)
+	case *TaxYearMutation:
+		return c.TaxYear.mutate(ctx, m)
 	case *TransactionMutation:
 		return c.Transaction.mutate(ctx, m)
+	case *UserMutation:
+		return c.User.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
+	case *WebhookEndpointMutation:
+		return c.WebhookEndpoint.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
-// EmailConnectionClient is a client for the EmailConnection schema.
-type EmailConnectionClient struct {
+// AccountDeletionClient is a client for the AccountDeletion schema.
+type AccountDeletionClient struct {
 	config
 }
 
-// NewEmailConnectionClient returns a client for the EmailConnection from the given config.
-func NewEmailConnectionClient(c config) *EmailConnectionClient {
-	return &EmailConnectionClient{config: c}
+// NewAccountDeletionClient returns a client for the AccountDeletion from the given config.
+func NewAccountDeletionClient(c config) *AccountDeletionClient {
+	return &AccountDeletionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `emailconnection.Hooks(f(g(h())))`.
-func (c *EmailConnectionClient) Use(hooks ...Hook) {
-	c.hooks.EmailConnection = append(c.hooks.EmailConnection, hooks...)
+// A call to `Use(f, g, h)` equals to `accountdeletion.Hooks(f(g(h())))`.
+func (c *AccountDeletionClient) Use(hooks ...Hook) {
+	c.hooks.AccountDeletion = append(c.hooks.AccountDeletion, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `emailconnection.Intercept(f(g(h())))`.
-func (c *EmailConnectionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.EmailConnection = append(c.inters.EmailConnection, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `accountdeletion.Intercept(f(g(h())))`.
+func (c *AccountDeletionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccountDeletion = append(c.inters.AccountDeletion, interceptors...)
 }
 
-// Create returns a builder for creating a EmailConnection entity.
-func (c *EmailConnectionClient) Create() *EmailConnectionCreate {
-	mutation := newEmailConnectionMutation(c.config, OpCreate)
-	return &EmailConnectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AccountDeletion entity.
+func (c *AccountDeletionClient) Create() *AccountDeletionCreate {
+	mutation := newAccountDeletionMutation(c.config, OpCreate)
+	return &AccountDeletionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of EmailConnection entities.
-func (c *EmailConnectionClient) CreateBulk(builders ...*EmailConnectionCreate) *EmailConnectionCreateBulk {
-	return &EmailConnectionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AccountDeletion entities.
+func (c *AccountDeletionClient) CreateBulk(builders ...*AccountDeletionCreate) *AccountDeletionCreateBulk {
+	return &AccountDeletionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EmailConnectionClient) MapCreateBulk(slice any, setFunc func(*EmailConnectionCreate, int)) *EmailConnectionCreateBulk {
+func (c *AccountDeletionClient) MapCreateBulk(slice any, setFunc func(*AccountDeletionCreate, int)) *AccountDeletionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EmailConnectionCreateBulk{err: fmt.Errorf("calling to EmailConnectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AccountDeletionCreateBulk{err: fmt.Errorf("calling to AccountDeletionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EmailConnectionCreate, rv.Len())
+	builders := make([]*AccountDeletionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EmailConnectionCreateBulk{config: c.config, builders: builders}
+	return &AccountDeletionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for EmailConnection.
-func (c *EmailConnectionClient) Update() *EmailConnectionUpdate {
-	mutation := newEmailConnectionMutation(c.config, OpUpdate)
-	return &EmailConnectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AccountDeletion.
+func (c *AccountDeletionClient) Update() *AccountDeletionUpdate {
+	mutation := newAccountDeletionMutation(c.config, OpUpdate)
+	return &AccountDeletionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EmailConnectionClient) UpdateOne(_m *EmailConnection) *EmailConnectionUpdateOne {
-	mutation := newEmailConnectionMutation(c.config, OpUpdateOne, withEmailConnection(_m))
-	return &EmailConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AccountDeletionClient) UpdateOne(_m *AccountDeletion) *AccountDeletionUpdateOne {
+	mutation := newAccountDeletionMutation(c.config, OpUpdateOne, withAccountDeletion(_m))
+	return &AccountDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EmailConnectionClient) UpdateOneID(id string) *EmailConnectionUpdateOne {
-	mutation := newEmailConnectionMutation(c.config, OpUpdateOne, withEmailConnectionID(id))
-	return &EmailConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AccountDeletionClient) UpdateOneID(id string) *AccountDeletionUpdateOne {
+	mutation := newAccountDeletionMutation(c.config, OpUpdateOne, withAccountDeletionID(id))
+	return &AccountDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for EmailConnection.
-func (c *EmailConnectionClient) Delete() *EmailConnectionDelete {
-	mutation := newEmailConnectionMutation(c.config, OpDelete)
-	return &EmailConnectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AccountDeletion.
+func (c *AccountDeletionClient) Delete() *AccountDeletionDelete {
+	mutation := newAccountDeletionMutation(c.config, OpDelete)
+	return &AccountDeletionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EmailConnectionClient) DeleteOne(_m *EmailConnection) *EmailConnectionDeleteOne {
+func (c *AccountDeletionClient) DeleteOne(_m *AccountDeletion) *AccountDeletionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EmailConnectionClient) DeleteOneID(id string) *EmailConnectionDeleteOne {
-	builder := c.Delete().Where(emailconnection.ID(id))
+func (c *AccountDeletionClient) DeleteOneID(id string) *AccountDeletionDeleteOne {
+	builder := c.Delete().Where(accountdeletion.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EmailConnectionDeleteOne{builder}
+	return &AccountDeletionDeleteOne{builder}
 }
 
-// Query returns a query builder for EmailConnection.
-func (c *EmailConnectionClient) Query() *EmailConnectionQuery {
-	return &EmailConnectionQuery{
+// Query returns a query builder for AccountDeletion.
+func (c *AccountDeletionClient) Query() *AccountDeletionQuery {
+	return &AccountDeletionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEmailConnection},
+		ctx:    &QueryContext{Type: TypeAccountDeletion},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a EmailConnection entity by its id.
-func (c *EmailConnectionClient) Get(ctx context.Context, id string) (*EmailConnection, error) {
-	return c.Query().Where(emailconnection.ID(id)).Only(ctx)
+// Get returns a AccountDeletion entity by its id.
+func (c *AccountDeletionClient) Get(ctx context.Context, id string) (*AccountDeletion, error) {
+	return c.Query().Where(accountdeletion.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EmailConnectionClient) GetX(ctx context.Context, id string) *EmailConnection {
+func (c *AccountDeletionClient) GetX(ctx context.Context, id string) *AccountDeletion {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -406,164 +712,132 @@ func (c *EmailConnectionClient) GetX(ctx context.Context, id string) *EmailConne
 	return obj
 }
 
-// QueryLabels queries the labels edge of a EmailConnection.
-func (c *EmailConnectionClient) QueryLabels(_m *EmailConnection) *EmailLabelQuery {
-	query := (&EmailLabelClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(emailconnection.Table, emailconnection.FieldID, id),
-			sqlgraph.To(emaillabel.Table, emaillabel.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, emailconnection.LabelsTable, emailconnection.LabelsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QuerySyncs queries the syncs edge of a EmailConnection.
-func (c *EmailConnectionClient) QuerySyncs(_m *EmailConnection) *EmailSyncQuery {
-	query := (&EmailSyncClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(emailconnection.Table, emailconnection.FieldID, id),
-			sqlgraph.To(emailsync.Table, emailsync.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, emailconnection.SyncsTable, emailconnection.SyncsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *EmailConnectionClient) Hooks() []Hook {
-	return c.hooks.EmailConnection
+func (c *AccountDeletionClient) Hooks() []Hook {
+	return c.hooks.AccountDeletion
 }
 
 // Interceptors returns the client interceptors.
-func (c *EmailConnectionClient) Interceptors() []Interceptor {
-	return c.inters.EmailConnection
+func (c *AccountDeletionClient) Interceptors() []Interceptor {
+	return c.inters.AccountDeletion
 }
 
-func (c *EmailConnectionClient) mutate(ctx context.Context, m *EmailConnectionMutation) (Value, error) {
+func (c *AccountDeletionClient) mutate(ctx context.Context, m *AccountDeletionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EmailConnectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AccountDeletionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EmailConnectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AccountDeletionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EmailConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AccountDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EmailConnectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AccountDeletionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown EmailConnection mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AccountDeletion mutation op: %q", m.Op())
 	}
 }
 
-// EmailLabelClient is a client for the EmailLabel schema.
-type EmailLabelClient struct {
+// AlertClient is a client for the Alert schema.
+type AlertClient struct {
 	config
 }
 
-// NewEmailLabelClient returns a client for the EmailLabel from the given config.
-func NewEmailLabelClient(c config) *EmailLabelClient {
-	return &EmailLabelClient{config: c}
+// NewAlertClient returns a client for the Alert from the given config.
+func NewAlertClient(c config) *AlertClient {
+	return &AlertClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `emaillabel.Hooks(f(g(h())))`.
-func (c *EmailLabelClient) Use(hooks ...Hook) {
-	c.hooks.EmailLabel = append(c.hooks.EmailLabel, hooks...)
+// A call to `Use(f, g, h)` equals to `alert.Hooks(f(g(h())))`.
+func (c *AlertClient) Use(hooks ...Hook) {
+	c.hooks.Alert = append(c.hooks.Alert, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `emaillabel.Intercept(f(g(h())))`.
-func (c *EmailLabelClient) Intercept(interceptors ...Interceptor) {
-	c.inters.EmailLabel = append(c.inters.EmailLabel, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `alert.Intercept(f(g(h())))`.
+func (c *AlertClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Alert = append(c.inters.Alert, interceptors...)
 }
 
-// Create returns a builder for creating a EmailLabel entity.
-func (c *EmailLabelClient) Create() *EmailLabelCreate {
-	mutation := newEmailLabelMutation(c.config, OpCreate)
-	return &EmailLabelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Alert entity.
+func (c *AlertClient) Create() *AlertCreate {
+	mutation := newAlertMutation(c.config, OpCreate)
+	return &AlertCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of EmailLabel entities.
-func (c *EmailLabelClient) CreateBulk(builders ...*EmailLabelCreate) *EmailLabelCreateBulk {
-	return &EmailLabelCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Alert entities.
+func (c *AlertClient) CreateBulk(builders ...*AlertCreate) *AlertCreateBulk {
+	return &AlertCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EmailLabelClient) MapCreateBulk(slice any, setFunc func(*EmailLabelCreate, int)) *EmailLabelCreateBulk {
+func (c *AlertClient) MapCreateBulk(slice any, setFunc func(*AlertCreate, int)) *AlertCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EmailLabelCreateBulk{err: fmt.Errorf("calling to EmailLabelClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AlertCreateBulk{err: fmt.Errorf("calling to AlertClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EmailLabelCreate, rv.Len())
+	builders := make([]*AlertCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EmailLabelCreateBulk{config: c.config, builders: builders}
+	return &AlertCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for EmailLabel.
-func (c *EmailLabelClient) Update() *EmailLabelUpdate {
-	mutation := newEmailLabelMutation(c.config, OpUpdate)
-	return &EmailLabelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Alert.
+func (c *AlertClient) Update() *AlertUpdate {
+	mutation := newAlertMutation(c.config, OpUpdate)
+	return &AlertUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EmailLabelClient) UpdateOne(_m *EmailLabel) *EmailLabelUpdateOne {
-	mutation := newEmailLabelMutation(c.config, OpUpdateOne, withEmailLabel(_m))
-	return &EmailLabelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AlertClient) UpdateOne(_m *Alert) *AlertUpdateOne {
+	mutation := newAlertMutation(c.config, OpUpdateOne, withAlert(_m))
+	return &AlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EmailLabelClient) UpdateOneID(id string) *EmailLabelUpdateOne {
-	mutation := newEmailLabelMutation(c.config, OpUpdateOne, withEmailLabelID(id))
-	return &EmailLabelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AlertClient) UpdateOneID(id string) *AlertUpdateOne {
+	mutation := newAlertMutation(c.config, OpUpdateOne, withAlertID(id))
+	return &AlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for EmailLabel.
-func (c *EmailLabelClient) Delete() *EmailLabelDelete {
-	mutation := newEmailLabelMutation(c.config, OpDelete)
-	return &EmailLabelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Alert.
+func (c *AlertClient) Delete() *AlertDelete {
+	mutation := newAlertMutation(c.config, OpDelete)
+	return &AlertDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EmailLabelClient) DeleteOne(_m *EmailLabel) *EmailLabelDeleteOne {
+func (c *AlertClient) DeleteOne(_m *Alert) *AlertDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EmailLabelClient) DeleteOneID(id string) *EmailLabelDeleteOne {
-	builder := c.Delete().Where(emaillabel.ID(id))
+func (c *AlertClient) DeleteOneID(id string) *AlertDeleteOne {
+	builder := c.Delete().Where(alert.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EmailLabelDeleteOne{builder}
+	return &AlertDeleteOne{builder}
 }
 
-// Query returns a query builder for EmailLabel.
-func (c *EmailLabelClient) Query() *EmailLabelQuery {
-	return &EmailLabelQuery{
+// Query returns a query builder for Alert.
+func (c *AlertClient) Query() *AlertQuery {
+	return &AlertQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEmailLabel},
+		ctx:    &QueryContext{Type: TypeAlert},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a EmailLabel entity by its id.
-func (c *EmailLabelClient) Get(ctx context.Context, id string) (*EmailLabel, error) {
-	return c.Query().Where(emaillabel.ID(id)).Only(ctx)
+// Get returns a Alert entity by its id.
+func (c *AlertClient) Get(ctx context.Context, id string) (*Alert, error) {
+	return c.Query().Where(alert.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EmailLabelClient) GetX(ctx context.Context, id string) *EmailLabel {
+func (c *AlertClient) GetX(ctx context.Context, id string) *Alert {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -571,148 +845,132 @@ func (c *EmailLabelClient) GetX(ctx context.Context, id string) *EmailLabel {
 	return obj
 }
 
-// QueryConnection queries the connection edge of a EmailLabel.
-func (c *EmailLabelClient) QueryConnection(_m *EmailLabel) *EmailConnectionQuery {
-	query := (&EmailConnectionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(emaillabel.Table, emaillabel.FieldID, id),
-			sqlgraph.To(emailconnection.Table, emailconnection.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, emaillabel.ConnectionTable, emaillabel.ConnectionColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *EmailLabelClient) Hooks() []Hook {
-	return c.hooks.EmailLabel
+func (c *AlertClient) Hooks() []Hook {
+	return c.hooks.Alert
 }
 
 // Interceptors returns the client interceptors.
-func (c *EmailLabelClient) Interceptors() []Interceptor {
-	return c.inters.EmailLabel
+func (c *AlertClient) Interceptors() []Interceptor {
+	return c.inters.Alert
 }
 
-func (c *EmailLabelClient) mutate(ctx context.Context, m *EmailLabelMutation) (Value, error) {
+func (c *AlertClient) mutate(ctx context.Context, m *AlertMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EmailLabelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AlertCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EmailLabelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AlertUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EmailLabelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EmailLabelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AlertDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown EmailLabel mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Alert mutation op: %q", m.Op())
 	}
 }
 
-// EmailSyncClient is a client for the EmailSync schema.
-type EmailSyncClient struct {
+// AlertPreferenceClient is a client for the AlertPreference schema.
+type AlertPreferenceClient struct {
 	config
 }
 
-// NewEmailSyncClient returns a client for the EmailSync from the given config.
-func NewEmailSyncClient(c config) *EmailSyncClient {
-	return &EmailSyncClient{config: c}
+// NewAlertPreferenceClient returns a client for the AlertPreference from the given config.
+func NewAlertPreferenceClient(c config) *AlertPreferenceClient {
+	return &AlertPreferenceClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `emailsync.Hooks(f(g(h())))`.
-func (c *EmailSyncClient) Use(hooks ...Hook) {
-	c.hooks.EmailSync = append(c.hooks.EmailSync, hooks...)
+// A call to `Use(f, g, h)` equals to `alertpreference.Hooks(f(g(h())))`.
+func (c *AlertPreferenceClient) Use(hooks ...Hook) {
+	c.hooks.AlertPreference = append(c.hooks.AlertPreference, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `emailsync.Intercept(f(g(h())))`.
-func (c *EmailSyncClient) Intercept(interceptors ...Interceptor) {
-	c.inters.EmailSync = append(c.inters.EmailSync, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `alertpreference.Intercept(f(g(h())))`.
+func (c *AlertPreferenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AlertPreference = append(c.inters.AlertPreference, interceptors...)
 }
 
-// Create returns a builder for creating a EmailSync entity.
-func (c *EmailSyncClient) Create() *EmailSyncCreate {
-	mutation := newEmailSyncMutation(c.config, OpCreate)
-	return &EmailSyncCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AlertPreference entity.
+func (c *AlertPreferenceClient) Create() *AlertPreferenceCreate {
+	mutation := newAlertPreferenceMutation(c.config, OpCreate)
+	return &AlertPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of EmailSync entities.
-func (c *EmailSyncClient) CreateBulk(builders ...*EmailSyncCreate) *EmailSyncCreateBulk {
-	return &EmailSyncCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AlertPreference entities.
+func (c *AlertPreferenceClient) CreateBulk(builders ...*AlertPreferenceCreate) *AlertPreferenceCreateBulk {
+	return &AlertPreferenceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EmailSyncClient) MapCreateBulk(slice any, setFunc func(*EmailSyncCreate, int)) *EmailSyncCreateBulk {
+func (c *AlertPreferenceClient) MapCreateBulk(slice any, setFunc func(*AlertPreferenceCreate, int)) *AlertPreferenceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EmailSyncCreateBulk{err: fmt.Errorf("calling to EmailSyncClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AlertPreferenceCreateBulk{err: fmt.Errorf("calling to AlertPreferenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EmailSyncCreate, rv.Len())
+	builders := make([]*AlertPreferenceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EmailSyncCreateBulk{config: c.config, builders: builders}
+	return &AlertPreferenceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for EmailSync.
-func (c *EmailSyncClient) Update() *EmailSyncUpdate {
-	mutation := newEmailSyncMutation(c.config, OpUpdate)
-	return &EmailSyncUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AlertPreference.
+func (c *AlertPreferenceClient) Update() *AlertPreferenceUpdate {
+	mutation := newAlertPreferenceMutation(c.config, OpUpdate)
+	return &AlertPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EmailSyncClient) UpdateOne(_m *EmailSync) *EmailSyncUpdateOne {
-	mutation := newEmailSyncMutation(c.config, OpUpdateOne, withEmailSync(_m))
-	return &EmailSyncUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AlertPreferenceClient) UpdateOne(_m *AlertPreference) *AlertPreferenceUpdateOne {
+	mutation := newAlertPreferenceMutation(c.config, OpUpdateOne, withAlertPreference(_m))
+	return &AlertPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EmailSyncClient) UpdateOneID(id string) *EmailSyncUpdateOne {
-	mutation := newEmailSyncMutation(c.config, OpUpdateOne, withEmailSyncID(id))
-	return &EmailSyncUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AlertPreferenceClient) UpdateOneID(id string) *AlertPreferenceUpdateOne {
+	mutation := newAlertPreferenceMutation(c.config, OpUpdateOne, withAlertPreferenceID(id))
+	return &AlertPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for EmailSync.
-func (c *EmailSyncClient) Delete() *EmailSyncDelete {
-	mutation := newEmailSyncMutation(c.config, OpDelete)
-	return &EmailSyncDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AlertPreference.
+func (c *AlertPreferenceClient) Delete() *AlertPreferenceDelete {
+	mutation := newAlertPreferenceMutation(c.config, OpDelete)
+	return &AlertPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EmailSyncClient) DeleteOne(_m *EmailSync) *EmailSyncDeleteOne {
+func (c *AlertPreferenceClient) DeleteOne(_m *AlertPreference) *AlertPreferenceDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EmailSyncClient) DeleteOneID(id string) *EmailSyncDeleteOne {
-	builder := c.Delete().Where(emailsync.ID(id))
+func (c *AlertPreferenceClient) DeleteOneID(id string) *AlertPreferenceDeleteOne {
+	builder := c.Delete().Where(alertpreference.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EmailSyncDeleteOne{builder}
+	return &AlertPreferenceDeleteOne{builder}
 }
 
-// Query returns a query builder for EmailSync.
-func (c *EmailSyncClient) Query() *EmailSyncQuery {
-	return &EmailSyncQuery{
+// Query returns a query builder for AlertPreference.
+func (c *AlertPreferenceClient) Query() *AlertPreferenceQuery {
+	return &AlertPreferenceQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEmailSync},
+		ctx:    &QueryContext{Type: TypeAlertPreference},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a EmailSync entity by its id.
-func (c *EmailSyncClient) Get(ctx context.Context, id string) (*EmailSync, error) {
-	return c.Query().Where(emailsync.ID(id)).Only(ctx)
+// Get returns a AlertPreference entity by its id.
+func (c *AlertPreferenceClient) Get(ctx context.Context, id string) (*AlertPreference, error) {
+	return c.Query().Where(alertpreference.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EmailSyncClient) GetX(ctx context.Context, id string) *EmailSync {
+func (c *AlertPreferenceClient) GetX(ctx context.Context, id string) *AlertPreference {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -720,148 +978,132 @@ func (c *EmailSyncClient) GetX(ctx context.Context, id string) *EmailSync {
 	return obj
 }
 
-// QueryConnection queries the connection edge of a EmailSync.
-func (c *EmailSyncClient) QueryConnection(_m *EmailSync) *EmailConnectionQuery {
-	query := (&EmailConnectionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(emailsync.Table, emailsync.FieldID, id),
-			sqlgraph.To(emailconnection.Table, emailconnection.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, emailsync.ConnectionTable, emailsync.ConnectionColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *EmailSyncClient) Hooks() []Hook {
-	return c.hooks.EmailSync
+func (c *AlertPreferenceClient) Hooks() []Hook {
+	return c.hooks.AlertPreference
 }
 
 // Interceptors returns the client interceptors.
-func (c *EmailSyncClient) Interceptors() []Interceptor {
-	return c.inters.EmailSync
+func (c *AlertPreferenceClient) Interceptors() []Interceptor {
+	return c.inters.AlertPreference
 }
 
-func (c *EmailSyncClient) mutate(ctx context.Context, m *EmailSyncMutation) (Value, error) {
+func (c *AlertPreferenceClient) mutate(ctx context.Context, m *AlertPreferenceMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EmailSyncCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AlertPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EmailSyncUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AlertPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EmailSyncUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AlertPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EmailSyncDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AlertPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown EmailSync mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AlertPreference mutation op: %q", m.Op())
 	}
 }
 
-// GoogleDriveConnectionClient is a client for the GoogleDriveConnection schema.
-type GoogleDriveConnectionClient struct {
+// AttachmentBlobClient is a client for the AttachmentBlob schema.
+type AttachmentBlobClient struct {
 	config
 }
 
-// NewGoogleDriveConnectionClient returns a client for the GoogleDriveConnection from the given config.
-func NewGoogleDriveConnectionClient(c config) *GoogleDriveConnectionClient {
-	return &GoogleDriveConnectionClient{config: c}
+// NewAttachmentBlobClient returns a client for the AttachmentBlob from the given config.
+func NewAttachmentBlobClient(c config) *AttachmentBlobClient {
+	return &AttachmentBlobClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `googledriveconnection.Hooks(f(g(h())))`.
-func (c *GoogleDriveConnectionClient) Use(hooks ...Hook) {
-	c.hooks.GoogleDriveConnection = append(c.hooks.GoogleDriveConnection, hooks...)
+// A call to `Use(f, g, h)` equals to `attachmentblob.Hooks(f(g(h())))`.
+func (c *AttachmentBlobClient) Use(hooks ...Hook) {
+	c.hooks.AttachmentBlob = append(c.hooks.AttachmentBlob, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `googledriveconnection.Intercept(f(g(h())))`.
-func (c *GoogleDriveConnectionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.GoogleDriveConnection = append(c.inters.GoogleDriveConnection, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `attachmentblob.Intercept(f(g(h())))`.
+func (c *AttachmentBlobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AttachmentBlob = append(c.inters.AttachmentBlob, interceptors...)
 }
 
-// Create returns a builder for creating a GoogleDriveConnection entity.
-func (c *GoogleDriveConnectionClient) Create() *GoogleDriveConnectionCreate {
-	mutation := newGoogleDriveConnectionMutation(c.config, OpCreate)
-	return &GoogleDriveConnectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AttachmentBlob entity.
+func (c *AttachmentBlobClient) Create() *AttachmentBlobCreate {
+	mutation := newAttachmentBlobMutation(c.config, OpCreate)
+	return &AttachmentBlobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of GoogleDriveConnection entities.
-func (c *GoogleDriveConnectionClient) CreateBulk(builders ...*GoogleDriveConnectionCreate) *GoogleDriveConnectionCreateBulk {
-	return &GoogleDriveConnectionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AttachmentBlob entities.
+func (c *AttachmentBlobClient) CreateBulk(builders ...*AttachmentBlobCreate) *AttachmentBlobCreateBulk {
+	return &AttachmentBlobCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *GoogleDriveConnectionClient) MapCreateBulk(slice any, setFunc func(*GoogleDriveConnectionCreate, int)) *GoogleDriveConnectionCreateBulk {
+func (c *AttachmentBlobClient) MapCreateBulk(slice any, setFunc func(*AttachmentBlobCreate, int)) *AttachmentBlobCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &GoogleDriveConnectionCreateBulk{err: fmt.Errorf("calling to GoogleDriveConnectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AttachmentBlobCreateBulk{err: fmt.Errorf("calling to AttachmentBlobClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*GoogleDriveConnectionCreate, rv.Len())
+	builders := make([]*AttachmentBlobCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &GoogleDriveConnectionCreateBulk{config: c.config, builders: builders}
+	return &AttachmentBlobCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for GoogleDriveConnection.
-func (c *GoogleDriveConnectionClient) Update() *GoogleDriveConnectionUpdate {
-	mutation := newGoogleDriveConnectionMutation(c.config, OpUpdate)
-	return &GoogleDriveConnectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AttachmentBlob.
+func (c *AttachmentBlobClient) Update() *AttachmentBlobUpdate {
+	mutation := newAttachmentBlobMutation(c.config, OpUpdate)
+	return &AttachmentBlobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *GoogleDriveConnectionClient) UpdateOne(_m *GoogleDriveConnection) *GoogleDriveConnectionUpdateOne {
-	mutation := newGoogleDriveConnectionMutation(c.config, OpUpdateOne, withGoogleDriveConnection(_m))
-	return &GoogleDriveConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AttachmentBlobClient) UpdateOne(_m *AttachmentBlob) *AttachmentBlobUpdateOne {
+	mutation := newAttachmentBlobMutation(c.config, OpUpdateOne, withAttachmentBlob(_m))
+	return &AttachmentBlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *GoogleDriveConnectionClient) UpdateOneID(id string) *GoogleDriveConnectionUpdateOne {
-	mutation := newGoogleDriveConnectionMutation(c.config, OpUpdateOne, withGoogleDriveConnectionID(id))
-	return &GoogleDriveConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AttachmentBlobClient) UpdateOneID(id string) *AttachmentBlobUpdateOne {
+	mutation := newAttachmentBlobMutation(c.config, OpUpdateOne, withAttachmentBlobID(id))
+	return &AttachmentBlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for GoogleDriveConnection.
-func (c *GoogleDriveConnectionClient) Delete() *GoogleDriveConnectionDelete {
-	mutation := newGoogleDriveConnectionMutation(c.config, OpDelete)
-	return &GoogleDriveConnectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AttachmentBlob.
+func (c *AttachmentBlobClient) Delete() *AttachmentBlobDelete {
+	mutation := newAttachmentBlobMutation(c.config, OpDelete)
+	return &AttachmentBlobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *GoogleDriveConnectionClient) DeleteOne(_m *GoogleDriveConnection) *GoogleDriveConnectionDeleteOne {
+func (c *AttachmentBlobClient) DeleteOne(_m *AttachmentBlob) *AttachmentBlobDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *GoogleDriveConnectionClient) DeleteOneID(id string) *GoogleDriveConnectionDeleteOne {
-	builder := c.Delete().Where(googledriveconnection.ID(id))
+func (c *AttachmentBlobClient) DeleteOneID(id string) *AttachmentBlobDeleteOne {
+	builder := c.Delete().Where(attachmentblob.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &GoogleDriveConnectionDeleteOne{builder}
+	return &AttachmentBlobDeleteOne{builder}
 }
 
-// Query returns a query builder for GoogleDriveConnection.
-func (c *GoogleDriveConnectionClient) Query() *GoogleDriveConnectionQuery {
-	return &GoogleDriveConnectionQuery{
+// Query returns a query builder for AttachmentBlob.
+func (c *AttachmentBlobClient) Query() *AttachmentBlobQuery {
+	return &AttachmentBlobQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeGoogleDriveConnection},
+		ctx:    &QueryContext{Type: TypeAttachmentBlob},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a GoogleDriveConnection entity by its id.
-func (c *GoogleDriveConnectionClient) Get(ctx context.Context, id string) (*GoogleDriveConnection, error) {
-	return c.Query().Where(googledriveconnection.ID(id)).Only(ctx)
+// Get returns a AttachmentBlob entity by its id.
+func (c *AttachmentBlobClient) Get(ctx context.Context, id string) (*AttachmentBlob, error) {
+	return c.Query().Where(attachmentblob.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *GoogleDriveConnectionClient) GetX(ctx context.Context, id string) *GoogleDriveConnection {
+func (c *AttachmentBlobClient) GetX(ctx context.Context, id string) *AttachmentBlob {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -869,31 +1111,15 @@ func (c *GoogleDriveConnectionClient) GetX(ctx context.Context, id string) *Goog
 	return obj
 }
 
-// QueryFolders queries the folders edge of a GoogleDriveConnection.
-func (c *GoogleDriveConnectionClient) QueryFolders(_m *GoogleDriveConnection) *GoogleDriveFolderQuery {
-	query := (&GoogleDriveFolderClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(googledriveconnection.Table, googledriveconnection.FieldID, id),
-			sqlgraph.To(googledrivefolder.Table, googledrivefolder.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, googledriveconnection.FoldersTable, googledriveconnection.FoldersColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QuerySyncs queries the syncs edge of a GoogleDriveConnection.
-func (c *GoogleDriveConnectionClient) QuerySyncs(_m *GoogleDriveConnection) *GoogleDriveSyncQuery {
-	query := (&GoogleDriveSyncClient{config: c.config}).Query()
+// QueryLinks queries the links edge of a AttachmentBlob.
+func (c *AttachmentBlobClient) QueryLinks(_m *AttachmentBlob) *AttachmentLinkQuery {
+	query := (&AttachmentLinkClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(googledriveconnection.Table, googledriveconnection.FieldID, id),
-			sqlgraph.To(googledrivesync.Table, googledrivesync.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, googledriveconnection.SyncsTable, googledriveconnection.SyncsColumn),
+			sqlgraph.From(attachmentblob.Table, attachmentblob.FieldID, id),
+			sqlgraph.To(attachmentlink.Table, attachmentlink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, attachmentblob.LinksTable, attachmentblob.LinksColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -902,131 +1128,131 @@ func (c *GoogleDriveConnectionClient) QuerySyncs(_m *GoogleDriveConnection) *Goo
 }
 
 // Hooks returns the client hooks.
-func (c *GoogleDriveConnectionClient) Hooks() []Hook {
-	return c.hooks.GoogleDriveConnection
+func (c *AttachmentBlobClient) Hooks() []Hook {
+	return c.hooks.AttachmentBlob
 }
 
 // Interceptors returns the client interceptors.
-func (c *GoogleDriveConnectionClient) Interceptors() []Interceptor {
-	return c.inters.GoogleDriveConnection
+func (c *AttachmentBlobClient) Interceptors() []Interceptor {
+	return c.inters.AttachmentBlob
 }
 
-func (c *GoogleDriveConnectionClient) mutate(ctx context.Context, m *GoogleDriveConnectionMutation) (Value, error) {
+func (c *AttachmentBlobClient) mutate(ctx context.Context, m *AttachmentBlobMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&GoogleDriveConnectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AttachmentBlobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&GoogleDriveConnectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AttachmentBlobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&GoogleDriveConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AttachmentBlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&GoogleDriveConnectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AttachmentBlobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown GoogleDriveConnection mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AttachmentBlob mutation op: %q", m.Op())
 	}
 }
 
-// GoogleDriveFolderClient is a client for the GoogleDriveFolder schema.
-type GoogleDriveFolderClient struct {
+// AttachmentLinkClient is a client for the AttachmentLink schema.
+type AttachmentLinkClient struct {
 	config
 }
 
-// NewGoogleDriveFolderClient returns a client for the GoogleDriveFolder from the given config.
-func NewGoogleDriveFolderClient(c config) *GoogleDriveFolderClient {
-	return &GoogleDriveFolderClient{config: c}
+// NewAttachmentLinkClient returns a client for the AttachmentLink from the given config.
+func NewAttachmentLinkClient(c config) *AttachmentLinkClient {
+	return &AttachmentLinkClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `googledrivefolder.Hooks(f(g(h())))`.
-func (c *GoogleDriveFolderClient) Use(hooks ...Hook) {
-	c.hooks.GoogleDriveFolder = append(c.hooks.GoogleDriveFolder, hooks...)
+// A call to `Use(f, g, h)` equals to `attachmentlink.Hooks(f(g(h())))`.
+func (c *AttachmentLinkClient) Use(hooks ...Hook) {
+	c.hooks.AttachmentLink = append(c.hooks.AttachmentLink, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `googledrivefolder.Intercept(f(g(h())))`.
-func (c *GoogleDriveFolderClient) Intercept(interceptors ...Interceptor) {
-	c.inters.GoogleDriveFolder = append(c.inters.GoogleDriveFolder, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `attachmentlink.Intercept(f(g(h())))`.
+func (c *AttachmentLinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AttachmentLink = append(c.inters.AttachmentLink, interceptors...)
 }
 
-// Create returns a builder for creating a GoogleDriveFolder entity.
-func (c *GoogleDriveFolderClient) Create() *GoogleDriveFolderCreate {
-	mutation := newGoogleDriveFolderMutation(c.config, OpCreate)
-	return &GoogleDriveFolderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AttachmentLink entity.
+func (c *AttachmentLinkClient) Create() *AttachmentLinkCreate {
+	mutation := newAttachmentLinkMutation(c.config, OpCreate)
+	return &AttachmentLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of GoogleDriveFolder entities.
-func (c *GoogleDriveFolderClient) CreateBulk(builders ...*GoogleDriveFolderCreate) *GoogleDriveFolderCreateBulk {
-	return &GoogleDriveFolderCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AttachmentLink entities.
+func (c *AttachmentLinkClient) CreateBulk(builders ...*AttachmentLinkCreate) *AttachmentLinkCreateBulk {
+	return &AttachmentLinkCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *GoogleDriveFolderClient) MapCreateBulk(slice any, setFunc func(*GoogleDriveFolderCreate, int)) *GoogleDriveFolderCreateBulk {
+func (c *AttachmentLinkClient) MapCreateBulk(slice any, setFunc func(*AttachmentLinkCreate, int)) *AttachmentLinkCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &GoogleDriveFolderCreateBulk{err: fmt.Errorf("calling to GoogleDriveFolderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AttachmentLinkCreateBulk{err: fmt.Errorf("calling to AttachmentLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*GoogleDriveFolderCreate, rv.Len())
+	builders := make([]*AttachmentLinkCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &GoogleDriveFolderCreateBulk{config: c.config, builders: builders}
+	return &AttachmentLinkCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for GoogleDriveFolder.
-func (c *GoogleDriveFolderClient) Update() *GoogleDriveFolderUpdate {
-	mutation := newGoogleDriveFolderMutation(c.config, OpUpdate)
-	return &GoogleDriveFolderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AttachmentLink.
+func (c *AttachmentLinkClient) Update() *AttachmentLinkUpdate {
+	mutation := newAttachmentLinkMutation(c.config, OpUpdate)
+	return &AttachmentLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *GoogleDriveFolderClient) UpdateOne(_m *GoogleDriveFolder) *GoogleDriveFolderUpdateOne {
-	mutation := newGoogleDriveFolderMutation(c.config, OpUpdateOne, withGoogleDriveFolder(_m))
-	return &GoogleDriveFolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AttachmentLinkClient) UpdateOne(_m *AttachmentLink) *AttachmentLinkUpdateOne {
+	mutation := newAttachmentLinkMutation(c.config, OpUpdateOne, withAttachmentLink(_m))
+	return &AttachmentLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *GoogleDriveFolderClient) UpdateOneID(id string) *GoogleDriveFolderUpdateOne {
-	mutation := newGoogleDriveFolderMutation(c.config, OpUpdateOne, withGoogleDriveFolderID(id))
-	return &GoogleDriveFolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AttachmentLinkClient) UpdateOneID(id string) *AttachmentLinkUpdateOne {
+	mutation := newAttachmentLinkMutation(c.config, OpUpdateOne, withAttachmentLinkID(id))
+	return &AttachmentLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for GoogleDriveFolder.
-func (c *GoogleDriveFolderClient) Delete() *GoogleDriveFolderDelete {
-	mutation := newGoogleDriveFolderMutation(c.config, OpDelete)
-	return &GoogleDriveFolderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AttachmentLink.
+func (c *AttachmentLinkClient) Delete() *AttachmentLinkDelete {
+	mutation := newAttachmentLinkMutation(c.config, OpDelete)
+	return &AttachmentLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *GoogleDriveFolderClient) DeleteOne(_m *GoogleDriveFolder) *GoogleDriveFolderDeleteOne {
+func (c *AttachmentLinkClient) DeleteOne(_m *AttachmentLink) *AttachmentLinkDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *GoogleDriveFolderClient) DeleteOneID(id string) *GoogleDriveFolderDeleteOne {
-	builder := c.Delete().Where(googledrivefolder.ID(id))
+func (c *AttachmentLinkClient) DeleteOneID(id string) *AttachmentLinkDeleteOne {
+	builder := c.Delete().Where(attachmentlink.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &GoogleDriveFolderDeleteOne{builder}
+	return &AttachmentLinkDeleteOne{builder}
 }
 
-// Query returns a query builder for GoogleDriveFolder.
-func (c *GoogleDriveFolderClient) Query() *GoogleDriveFolderQuery {
-	return &GoogleDriveFolderQuery{
+// Query returns a query builder for AttachmentLink.
+func (c *AttachmentLinkClient) Query() *AttachmentLinkQuery {
+	return &AttachmentLinkQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeGoogleDriveFolder},
+		ctx:    &QueryContext{Type: TypeAttachmentLink},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a GoogleDriveFolder entity by its id.
-func (c *GoogleDriveFolderClient) Get(ctx context.Context, id string) (*GoogleDriveFolder, error) {
-	return c.Query().Where(googledrivefolder.ID(id)).Only(ctx)
+// Get returns a AttachmentLink entity by its id.
+func (c *AttachmentLinkClient) Get(ctx context.Context, id string) (*AttachmentLink, error) {
+	return c.Query().Where(attachmentlink.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *GoogleDriveFolderClient) GetX(ctx context.Context, id string) *GoogleDriveFolder {
+func (c *AttachmentLinkClient) GetX(ctx context.Context, id string) *AttachmentLink {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1034,15 +1260,15 @@ func (c *GoogleDriveFolderClient) GetX(ctx context.Context, id string) *GoogleDr
 	return obj
 }
 
-// QueryConnection queries the connection edge of a GoogleDriveFolder.
-func (c *GoogleDriveFolderClient) QueryConnection(_m *GoogleDriveFolder) *GoogleDriveConnectionQuery {
-	query := (&GoogleDriveConnectionClient{config: c.config}).Query()
+// QueryBlob queries the blob edge of a AttachmentLink.
+func (c *AttachmentLinkClient) QueryBlob(_m *AttachmentLink) *AttachmentBlobQuery {
+	query := (&AttachmentBlobClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(googledrivefolder.Table, googledrivefolder.FieldID, id),
-			sqlgraph.To(googledriveconnection.Table, googledriveconnection.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, googledrivefolder.ConnectionTable, googledrivefolder.ConnectionColumn),
+			sqlgraph.From(attachmentlink.Table, attachmentlink.FieldID, id),
+			sqlgraph.To(attachmentblob.Table, attachmentblob.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attachmentlink.BlobTable, attachmentlink.BlobColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1051,131 +1277,131 @@ func (c *GoogleDriveFolderClient) QueryConnection(_m *GoogleDriveFolder) *Google
 }
 
 // Hooks returns the client hooks.
-func (c *GoogleDriveFolderClient) Hooks() []Hook {
-	return c.hooks.GoogleDriveFolder
+func (c *AttachmentLinkClient) Hooks() []Hook {
+	return c.hooks.AttachmentLink
 }
 
 // Interceptors returns the client interceptors.
-func (c *GoogleDriveFolderClient) Interceptors() []Interceptor {
-	return c.inters.GoogleDriveFolder
+func (c *AttachmentLinkClient) Interceptors() []Interceptor {
+	return c.inters.AttachmentLink
 }
 
-func (c *GoogleDriveFolderClient) mutate(ctx context.Context, m *GoogleDriveFolderMutation) (Value, error) {
+func (c *AttachmentLinkClient) mutate(ctx context.Context, m *AttachmentLinkMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&GoogleDriveFolderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AttachmentLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&GoogleDriveFolderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AttachmentLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&GoogleDriveFolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AttachmentLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&GoogleDriveFolderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AttachmentLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown GoogleDriveFolder mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AttachmentLink mutation op: %q", m.Op())
 	}
 }
 
-// GoogleDriveSyncClient is a client for the GoogleDriveSync schema.
-type GoogleDriveSyncClient struct {
+// BudgetReallocationClient is a client for the BudgetReallocation schema.
+type BudgetReallocationClient struct {
 	config
 }
 
-// NewGoogleDriveSyncClient returns a client for the GoogleDriveSync from the given config.
-func NewGoogleDriveSyncClient(c config) *GoogleDriveSyncClient {
-	return &GoogleDriveSyncClient{config: c}
+// NewBudgetReallocationClient returns a client for the BudgetReallocation from the given config.
+func NewBudgetReallocationClient(c config) *BudgetReallocationClient {
+	return &BudgetReallocationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `googledrivesync.Hooks(f(g(h())))`.
-func (c *GoogleDriveSyncClient) Use(hooks ...Hook) {
-	c.hooks.GoogleDriveSync = append(c.hooks.GoogleDriveSync, hooks...)
+// A call to `Use(f, g, h)` equals to `budgetreallocation.Hooks(f(g(h())))`.
+func (c *BudgetReallocationClient) Use(hooks ...Hook) {
+	c.hooks.BudgetReallocation = append(c.hooks.BudgetReallocation, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `googledrivesync.Intercept(f(g(h())))`.
-func (c *GoogleDriveSyncClient) Intercept(interceptors ...Interceptor) {
-	c.inters.GoogleDriveSync = append(c.inters.GoogleDriveSync, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `budgetreallocation.Intercept(f(g(h())))`.
+func (c *BudgetReallocationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BudgetReallocation = append(c.inters.BudgetReallocation, interceptors...)
 }
 
-// Create returns a builder for creating a GoogleDriveSync entity.
-func (c *GoogleDriveSyncClient) Create() *GoogleDriveSyncCreate {
-	mutation := newGoogleDriveSyncMutation(c.config, OpCreate)
-	return &GoogleDriveSyncCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BudgetReallocation entity.
+func (c *BudgetReallocationClient) Create() *BudgetReallocationCreate {
+	mutation := newBudgetReallocationMutation(c.config, OpCreate)
+	return &BudgetReallocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of GoogleDriveSync entities.
-func (c *GoogleDriveSyncClient) CreateBulk(builders ...*GoogleDriveSyncCreate) *GoogleDriveSyncCreateBulk {
-	return &GoogleDriveSyncCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BudgetReallocation entities.
+func (c *BudgetReallocationClient) CreateBulk(builders ...*BudgetReallocationCreate) *BudgetReallocationCreateBulk {
+	return &BudgetReallocationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *GoogleDriveSyncClient) MapCreateBulk(slice any, setFunc func(*GoogleDriveSyncCreate, int)) *GoogleDriveSyncCreateBulk {
+func (c *BudgetReallocationClient) MapCreateBulk(slice any, setFunc func(*BudgetReallocationCreate, int)) *BudgetReallocationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &GoogleDriveSyncCreateBulk{err: fmt.Errorf("calling to GoogleDriveSyncClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BudgetReallocationCreateBulk{err: fmt.Errorf("calling to BudgetReallocationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*GoogleDriveSyncCreate, rv.Len())
+	builders := make([]*BudgetReallocationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &GoogleDriveSyncCreateBulk{config: c.config, builders: builders}
+	return &BudgetReallocationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for GoogleDriveSync.
-func (c *GoogleDriveSyncClient) Update() *GoogleDriveSyncUpdate {
-	mutation := newGoogleDriveSyncMutation(c.config, OpUpdate)
-	return &GoogleDriveSyncUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BudgetReallocation.
+func (c *BudgetReallocationClient) Update() *BudgetReallocationUpdate {
+	mutation := newBudgetReallocationMutation(c.config, OpUpdate)
+	return &BudgetReallocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *GoogleDriveSyncClient) UpdateOne(_m *GoogleDriveSync) *GoogleDriveSyncUpdateOne {
-	mutation := newGoogleDriveSyncMutation(c.config, OpUpdateOne, withGoogleDriveSync(_m))
-	return &GoogleDriveSyncUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BudgetReallocationClient) UpdateOne(_m *BudgetReallocation) *BudgetReallocationUpdateOne {
+	mutation := newBudgetReallocationMutation(c.config, OpUpdateOne, withBudgetReallocation(_m))
+	return &BudgetReallocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *GoogleDriveSyncClient) UpdateOneID(id string) *GoogleDriveSyncUpdateOne {
-	mutation := newGoogleDriveSyncMutation(c.config, OpUpdateOne, withGoogleDriveSyncID(id))
-	return &GoogleDriveSyncUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BudgetReallocationClient) UpdateOneID(id string) *BudgetReallocationUpdateOne {
+	mutation := newBudgetReallocationMutation(c.config, OpUpdateOne, withBudgetReallocationID(id))
+	return &BudgetReallocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for GoogleDriveSync.
-func (c *GoogleDriveSyncClient) Delete() *GoogleDriveSyncDelete {
-	mutation := newGoogleDriveSyncMutation(c.config, OpDelete)
-	return &GoogleDriveSyncDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BudgetReallocation.
+func (c *BudgetReallocationClient) Delete() *BudgetReallocationDelete {
+	mutation := newBudgetReallocationMutation(c.config, OpDelete)
+	return &BudgetReallocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *GoogleDriveSyncClient) DeleteOne(_m *GoogleDriveSync) *GoogleDriveSyncDeleteOne {
+func (c *BudgetReallocationClient) DeleteOne(_m *BudgetReallocation) *BudgetReallocationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *GoogleDriveSyncClient) DeleteOneID(id string) *GoogleDriveSyncDeleteOne {
-	builder := c.Delete().Where(googledrivesync.ID(id))
+func (c *BudgetReallocationClient) DeleteOneID(id string) *BudgetReallocationDeleteOne {
+	builder := c.Delete().Where(budgetreallocation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &GoogleDriveSyncDeleteOne{builder}
+	return &BudgetReallocationDeleteOne{builder}
 }
 
-// Query returns a query builder for GoogleDriveSync.
-func (c *GoogleDriveSyncClient) Query() *GoogleDriveSyncQuery {
-	return &GoogleDriveSyncQuery{
+// Query returns a query builder for BudgetReallocation.
+func (c *BudgetReallocationClient) Query() *BudgetReallocationQuery {
+	return &BudgetReallocationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeGoogleDriveSync},
+		ctx:    &QueryContext{Type: TypeBudgetReallocation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a GoogleDriveSync entity by its id.
-func (c *GoogleDriveSyncClient) Get(ctx context.Context, id string) (*GoogleDriveSync, error) {
-	return c.Query().Where(googledrivesync.ID(id)).Only(ctx)
+// Get returns a BudgetReallocation entity by its id.
+func (c *BudgetReallocationClient) Get(ctx context.Context, id string) (*BudgetReallocation, error) {
+	return c.Query().Where(budgetreallocation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *GoogleDriveSyncClient) GetX(ctx context.Context, id string) *GoogleDriveSync {
+func (c *BudgetReallocationClient) GetX(ctx context.Context, id string) *BudgetReallocation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1183,15 +1409,15 @@ func (c *GoogleDriveSyncClient) GetX(ctx context.Context, id string) *GoogleDriv
 	return obj
 }
 
-// QueryConnection queries the connection edge of a GoogleDriveSync.
-func (c *GoogleDriveSyncClient) QueryConnection(_m *GoogleDriveSync) *GoogleDriveConnectionQuery {
-	query := (&GoogleDriveConnectionClient{config: c.config}).Query()
+// QueryUser queries the user edge of a BudgetReallocation.
+func (c *BudgetReallocationClient) QueryUser(_m *BudgetReallocation) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(googledrivesync.Table, googledrivesync.FieldID, id),
-			sqlgraph.To(googledriveconnection.Table, googledriveconnection.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, googledrivesync.ConnectionTable, googledrivesync.ConnectionColumn),
+			sqlgraph.From(budgetreallocation.Table, budgetreallocation.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, budgetreallocation.UserTable, budgetreallocation.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1200,131 +1426,131 @@ func (c *GoogleDriveSyncClient) QueryConnection(_m *GoogleDriveSync) *GoogleDriv
 }
 
 // Hooks returns the client hooks.
-func (c *GoogleDriveSyncClient) Hooks() []Hook {
-	return c.hooks.GoogleDriveSync
+func (c *BudgetReallocationClient) Hooks() []Hook {
+	return c.hooks.BudgetReallocation
 }
 
 // Interceptors returns the client interceptors.
-func (c *GoogleDriveSyncClient) Interceptors() []Interceptor {
-	return c.inters.GoogleDriveSync
+func (c *BudgetReallocationClient) Interceptors() []Interceptor {
+	return c.inters.BudgetReallocation
 }
 
-func (c *GoogleDriveSyncClient) mutate(ctx context.Context, m *GoogleDriveSyncMutation) (Value, error) {
+func (c *BudgetReallocationClient) mutate(ctx context.Context, m *BudgetReallocationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&GoogleDriveSyncCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BudgetReallocationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&GoogleDriveSyncUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BudgetReallocationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&GoogleDriveSyncUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BudgetReallocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&GoogleDriveSyncDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BudgetReallocationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown GoogleDriveSync mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BudgetReallocation mutation op: %q", m.Op())
 	}
 }
 
-// LineItemClient is a client for the LineItem schema.
-type LineItemClient struct {
+// BulkOperationClient is a client for the BulkOperation schema.
+type BulkOperationClient struct {
 	config
 }
 
-// NewLineItemClient returns a client for the LineItem from the given config.
-func NewLineItemClient(c config) *LineItemClient {
-	return &LineItemClient{config: c}
+// NewBulkOperationClient returns a client for the BulkOperation from the given config.
+func NewBulkOperationClient(c config) *BulkOperationClient {
+	return &BulkOperationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `lineitem.Hooks(f(g(h())))`.
-func (c *LineItemClient) Use(hooks ...Hook) {
-	c.hooks.LineItem = append(c.hooks.LineItem, hooks...)
+// A call to `Use(f, g, h)` equals to `bulkoperation.Hooks(f(g(h())))`.
+func (c *BulkOperationClient) Use(hooks ...Hook) {
+	c.hooks.BulkOperation = append(c.hooks.BulkOperation, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `lineitem.Intercept(f(g(h())))`.
-func (c *LineItemClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LineItem = append(c.inters.LineItem, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `bulkoperation.Intercept(f(g(h())))`.
+func (c *BulkOperationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BulkOperation = append(c.inters.BulkOperation, interceptors...)
 }
 
-// Create returns a builder for creating a LineItem entity.
-func (c *LineItemClient) Create() *LineItemCreate {
-	mutation := newLineItemMutation(c.config, OpCreate)
-	return &LineItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BulkOperation entity.
+func (c *BulkOperationClient) Create() *BulkOperationCreate {
+	mutation := newBulkOperationMutation(c.config, OpCreate)
+	return &BulkOperationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LineItem entities.
-func (c *LineItemClient) CreateBulk(builders ...*LineItemCreate) *LineItemCreateBulk {
-	return &LineItemCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BulkOperation entities.
+func (c *BulkOperationClient) CreateBulk(builders ...*BulkOperationCreate) *BulkOperationCreateBulk {
+	return &BulkOperationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LineItemClient) MapCreateBulk(slice any, setFunc func(*LineItemCreate, int)) *LineItemCreateBulk {
+func (c *BulkOperationClient) MapCreateBulk(slice any, setFunc func(*BulkOperationCreate, int)) *BulkOperationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LineItemCreateBulk{err: fmt.Errorf("calling to LineItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BulkOperationCreateBulk{err: fmt.Errorf("calling to BulkOperationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LineItemCreate, rv.Len())
+	builders := make([]*BulkOperationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LineItemCreateBulk{config: c.config, builders: builders}
+	return &BulkOperationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LineItem.
-func (c *LineItemClient) Update() *LineItemUpdate {
-	mutation := newLineItemMutation(c.config, OpUpdate)
-	return &LineItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BulkOperation.
+func (c *BulkOperationClient) Update() *BulkOperationUpdate {
+	mutation := newBulkOperationMutation(c.config, OpUpdate)
+	return &BulkOperationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LineItemClient) UpdateOne(_m *LineItem) *LineItemUpdateOne {
-	mutation := newLineItemMutation(c.config, OpUpdateOne, withLineItem(_m))
-	return &LineItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BulkOperationClient) UpdateOne(_m *BulkOperation) *BulkOperationUpdateOne {
+	mutation := newBulkOperationMutation(c.config, OpUpdateOne, withBulkOperation(_m))
+	return &BulkOperationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LineItemClient) UpdateOneID(id string) *LineItemUpdateOne {
-	mutation := newLineItemMutation(c.config, OpUpdateOne, withLineItemID(id))
-	return &LineItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BulkOperationClient) UpdateOneID(id string) *BulkOperationUpdateOne {
+	mutation := newBulkOperationMutation(c.config, OpUpdateOne, withBulkOperationID(id))
+	return &BulkOperationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LineItem.
-func (c *LineItemClient) Delete() *LineItemDelete {
-	mutation := newLineItemMutation(c.config, OpDelete)
-	return &LineItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BulkOperation.
+func (c *BulkOperationClient) Delete() *BulkOperationDelete {
+	mutation := newBulkOperationMutation(c.config, OpDelete)
+	return &BulkOperationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LineItemClient) DeleteOne(_m *LineItem) *LineItemDeleteOne {
+func (c *BulkOperationClient) DeleteOne(_m *BulkOperation) *BulkOperationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LineItemClient) DeleteOneID(id string) *LineItemDeleteOne {
-	builder := c.Delete().Where(lineitem.ID(id))
+func (c *BulkOperationClient) DeleteOneID(id string) *BulkOperationDeleteOne {
+	builder := c.Delete().Where(bulkoperation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LineItemDeleteOne{builder}
+	return &BulkOperationDeleteOne{builder}
 }
 
-// Query returns a query builder for LineItem.
-func (c *LineItemClient) Query() *LineItemQuery {
-	return &LineItemQuery{
+// Query returns a query builder for BulkOperation.
+func (c *BulkOperationClient) Query() *BulkOperationQuery {
+	return &BulkOperationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLineItem},
+		ctx:    &QueryContext{Type: TypeBulkOperation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LineItem entity by its id.
-func (c *LineItemClient) Get(ctx context.Context, id string) (*LineItem, error) {
-	return c.Query().Where(lineitem.ID(id)).Only(ctx)
+// Get returns a BulkOperation entity by its id.
+func (c *BulkOperationClient) Get(ctx context.Context, id string) (*BulkOperation, error) {
+	return c.Query().Where(bulkoperation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LineItemClient) GetX(ctx context.Context, id string) *LineItem {
+func (c *BulkOperationClient) GetX(ctx context.Context, id string) *BulkOperation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1332,148 +1558,132 @@ func (c *LineItemClient) GetX(ctx context.Context, id string) *LineItem {
 	return obj
 }
 
-// QueryReceipt queries the receipt edge of a LineItem.
-func (c *LineItemClient) QueryReceipt(_m *LineItem) *ReceiptQuery {
-	query := (&ReceiptClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(lineitem.Table, lineitem.FieldID, id),
-			sqlgraph.To(receipt.Table, receipt.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, lineitem.ReceiptTable, lineitem.ReceiptColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *LineItemClient) Hooks() []Hook {
-	return c.hooks.LineItem
+func (c *BulkOperationClient) Hooks() []Hook {
+	return c.hooks.BulkOperation
 }
 
 // Interceptors returns the client interceptors.
-func (c *LineItemClient) Interceptors() []Interceptor {
-	return c.inters.LineItem
+func (c *BulkOperationClient) Interceptors() []Interceptor {
+	return c.inters.BulkOperation
 }
 
-func (c *LineItemClient) mutate(ctx context.Context, m *LineItemMutation) (Value, error) {
+func (c *BulkOperationClient) mutate(ctx context.Context, m *BulkOperationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LineItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BulkOperationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LineItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BulkOperationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LineItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BulkOperationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LineItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BulkOperationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LineItem mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BulkOperation mutation op: %q", m.Op())
 	}
 }
 
-// PipelineConfigClient is a client for the PipelineConfig schema.
-type PipelineConfigClient struct {
+// CardAccountClient is a client for the CardAccount schema.
+type CardAccountClient struct {
 	config
 }
 
-// NewPipelineConfigClient returns a client for the PipelineConfig from the given config.
-func NewPipelineConfigClient(c config) *PipelineConfigClient {
-	return &PipelineConfigClient{config: c}
+// NewCardAccountClient returns a client for the CardAccount from the given config.
+func NewCardAccountClient(c config) *CardAccountClient {
+	return &CardAccountClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `pipelineconfig.Hooks(f(g(h())))`.
-func (c *PipelineConfigClient) Use(hooks ...Hook) {
-	c.hooks.PipelineConfig = append(c.hooks.PipelineConfig, hooks...)
+// A call to `Use(f, g, h)` equals to `cardaccount.Hooks(f(g(h())))`.
+func (c *CardAccountClient) Use(hooks ...Hook) {
+	c.hooks.CardAccount = append(c.hooks.CardAccount, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `pipelineconfig.Intercept(f(g(h())))`.
-func (c *PipelineConfigClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PipelineConfig = append(c.inters.PipelineConfig, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `cardaccount.Intercept(f(g(h())))`.
+func (c *CardAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CardAccount = append(c.inters.CardAccount, interceptors...)
 }
 
-// Create returns a builder for creating a PipelineConfig entity.
-func (c *PipelineConfigClient) Create() *PipelineConfigCreate {
-	mutation := newPipelineConfigMutation(c.config, OpCreate)
-	return &PipelineConfigCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CardAccount entity.
+func (c *CardAccountClient) Create() *CardAccountCreate {
+	mutation := newCardAccountMutation(c.config, OpCreate)
+	return &CardAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PipelineConfig entities.
-func (c *PipelineConfigClient) CreateBulk(builders ...*PipelineConfigCreate) *PipelineConfigCreateBulk {
-	return &PipelineConfigCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CardAccount entities.
+func (c *CardAccountClient) CreateBulk(builders ...*CardAccountCreate) *CardAccountCreateBulk {
+	return &CardAccountCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PipelineConfigClient) MapCreateBulk(slice any, setFunc func(*PipelineConfigCreate, int)) *PipelineConfigCreateBulk {
+func (c *CardAccountClient) MapCreateBulk(slice any, setFunc func(*CardAccountCreate, int)) *CardAccountCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PipelineConfigCreateBulk{err: fmt.Errorf("calling to PipelineConfigClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CardAccountCreateBulk{err: fmt.Errorf("calling to CardAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PipelineConfigCreate, rv.Len())
+	builders := make([]*CardAccountCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PipelineConfigCreateBulk{config: c.config, builders: builders}
+	return &CardAccountCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PipelineConfig.
-func (c *PipelineConfigClient) Update() *PipelineConfigUpdate {
-	mutation := newPipelineConfigMutation(c.config, OpUpdate)
-	return &PipelineConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CardAccount.
+func (c *CardAccountClient) Update() *CardAccountUpdate {
+	mutation := newCardAccountMutation(c.config, OpUpdate)
+	return &CardAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PipelineConfigClient) UpdateOne(_m *PipelineConfig) *PipelineConfigUpdateOne {
-	mutation := newPipelineConfigMutation(c.config, OpUpdateOne, withPipelineConfig(_m))
-	return &PipelineConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CardAccountClient) UpdateOne(_m *CardAccount) *CardAccountUpdateOne {
+	mutation := newCardAccountMutation(c.config, OpUpdateOne, withCardAccount(_m))
+	return &CardAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PipelineConfigClient) UpdateOneID(id string) *PipelineConfigUpdateOne {
-	mutation := newPipelineConfigMutation(c.config, OpUpdateOne, withPipelineConfigID(id))
-	return &PipelineConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CardAccountClient) UpdateOneID(id string) *CardAccountUpdateOne {
+	mutation := newCardAccountMutation(c.config, OpUpdateOne, withCardAccountID(id))
+	return &CardAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PipelineConfig.
-func (c *PipelineConfigClient) Delete() *PipelineConfigDelete {
-	mutation := newPipelineConfigMutation(c.config, OpDelete)
-	return &PipelineConfigDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CardAccount.
+func (c *CardAccountClient) Delete() *CardAccountDelete {
+	mutation := newCardAccountMutation(c.config, OpDelete)
+	return &CardAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PipelineConfigClient) DeleteOne(_m *PipelineConfig) *PipelineConfigDeleteOne {
+func (c *CardAccountClient) DeleteOne(_m *CardAccount) *CardAccountDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PipelineConfigClient) DeleteOneID(id string) *PipelineConfigDeleteOne {
-	builder := c.Delete().Where(pipelineconfig.ID(id))
+func (c *CardAccountClient) DeleteOneID(id string) *CardAccountDeleteOne {
+	builder := c.Delete().Where(cardaccount.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PipelineConfigDeleteOne{builder}
+	return &CardAccountDeleteOne{builder}
 }
 
-// Query returns a query builder for PipelineConfig.
-func (c *PipelineConfigClient) Query() *PipelineConfigQuery {
-	return &PipelineConfigQuery{
+// Query returns a query builder for CardAccount.
+func (c *CardAccountClient) Query() *CardAccountQuery {
+	return &CardAccountQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePipelineConfig},
+		ctx:    &QueryContext{Type: TypeCardAccount},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PipelineConfig entity by its id.
-func (c *PipelineConfigClient) Get(ctx context.Context, id string) (*PipelineConfig, error) {
-	return c.Query().Where(pipelineconfig.ID(id)).Only(ctx)
+// Get returns a CardAccount entity by its id.
+func (c *CardAccountClient) Get(ctx context.Context, id string) (*CardAccount, error) {
+	return c.Query().Where(cardaccount.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PipelineConfigClient) GetX(ctx context.Context, id string) *PipelineConfig {
+func (c *CardAccountClient) GetX(ctx context.Context, id string) *CardAccount {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1481,164 +1691,132 @@ func (c *PipelineConfigClient) GetX(ctx context.Context, id string) *PipelineCon
 	return obj
 }
 
-// QueryRules queries the rules edge of a PipelineConfig.
-func (c *PipelineConfigClient) QueryRules(_m *PipelineConfig) *PipelineRuleQuery {
-	query := (&PipelineRuleClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(pipelineconfig.Table, pipelineconfig.FieldID, id),
-			sqlgraph.To(pipelinerule.Table, pipelinerule.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, pipelineconfig.RulesTable, pipelineconfig.RulesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryVersions queries the versions edge of a PipelineConfig.
-func (c *PipelineConfigClient) QueryVersions(_m *PipelineConfig) *PipelineVersionQuery {
-	query := (&PipelineVersionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(pipelineconfig.Table, pipelineconfig.FieldID, id),
-			sqlgraph.To(pipelineversion.Table, pipelineversion.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, pipelineconfig.VersionsTable, pipelineconfig.VersionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *PipelineConfigClient) Hooks() []Hook {
-	return c.hooks.PipelineConfig
+func (c *CardAccountClient) Hooks() []Hook {
+	return c.hooks.CardAccount
 }
 
 // Interceptors returns the client interceptors.
-func (c *PipelineConfigClient) Interceptors() []Interceptor {
-	return c.inters.PipelineConfig
+func (c *CardAccountClient) Interceptors() []Interceptor {
+	return c.inters.CardAccount
 }
 
-func (c *PipelineConfigClient) mutate(ctx context.Context, m *PipelineConfigMutation) (Value, error) {
+func (c *CardAccountClient) mutate(ctx context.Context, m *CardAccountMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PipelineConfigCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CardAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PipelineConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CardAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PipelineConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CardAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PipelineConfigDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CardAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PipelineConfig mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CardAccount mutation op: %q", m.Op())
 	}
 }
 
-// PipelineRuleClient is a client for the PipelineRule schema.
-type PipelineRuleClient struct {
+// CategorizationRuleClient is a client for the CategorizationRule schema.
+type CategorizationRuleClient struct {
 	config
 }
 
-// NewPipelineRuleClient returns a client for the PipelineRule from the given config.
-func NewPipelineRuleClient(c config) *PipelineRuleClient {
-	return &PipelineRuleClient{config: c}
+// NewCategorizationRuleClient returns a client for the CategorizationRule from the given config.
+func NewCategorizationRuleClient(c config) *CategorizationRuleClient {
+	return &CategorizationRuleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `pipelinerule.Hooks(f(g(h())))`.
-func (c *PipelineRuleClient) Use(hooks ...Hook) {
-	c.hooks.PipelineRule = append(c.hooks.PipelineRule, hooks...)
+// A call to `Use(f, g, h)` equals to `categorizationrule.Hooks(f(g(h())))`.
+func (c *CategorizationRuleClient) Use(hooks ...Hook) {
+	c.hooks.CategorizationRule = append(c.hooks.CategorizationRule, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `pipelinerule.Intercept(f(g(h())))`.
-func (c *PipelineRuleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PipelineRule = append(c.inters.PipelineRule, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `categorizationrule.Intercept(f(g(h())))`.
+func (c *CategorizationRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CategorizationRule = append(c.inters.CategorizationRule, interceptors...)
 }
 
-// Create returns a builder for creating a PipelineRule entity.
-func (c *PipelineRuleClient) Create() *PipelineRuleCreate {
-	mutation := newPipelineRuleMutation(c.config, OpCreate)
-	return &PipelineRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CategorizationRule entity.
+func (c *CategorizationRuleClient) Create() *CategorizationRuleCreate {
+	mutation := newCategorizationRuleMutation(c.config, OpCreate)
+	return &CategorizationRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PipelineRule entities.
-func (c *PipelineRuleClient) CreateBulk(builders ...*PipelineRuleCreate) *PipelineRuleCreateBulk {
-	return &PipelineRuleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CategorizationRule entities.
+func (c *CategorizationRuleClient) CreateBulk(builders ...*CategorizationRuleCreate) *CategorizationRuleCreateBulk {
+	return &CategorizationRuleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PipelineRuleClient) MapCreateBulk(slice any, setFunc func(*PipelineRuleCreate, int)) *PipelineRuleCreateBulk {
+func (c *CategorizationRuleClient) MapCreateBulk(slice any, setFunc func(*CategorizationRuleCreate, int)) *CategorizationRuleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PipelineRuleCreateBulk{err: fmt.Errorf("calling to PipelineRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CategorizationRuleCreateBulk{err: fmt.Errorf("calling to CategorizationRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PipelineRuleCreate, rv.Len())
+	builders := make([]*CategorizationRuleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PipelineRuleCreateBulk{config: c.config, builders: builders}
+	return &CategorizationRuleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PipelineRule.
-func (c *PipelineRuleClient) Update() *PipelineRuleUpdate {
-	mutation := newPipelineRuleMutation(c.config, OpUpdate)
-	return &PipelineRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CategorizationRule.
+func (c *CategorizationRuleClient) Update() *CategorizationRuleUpdate {
+	mutation := newCategorizationRuleMutation(c.config, OpUpdate)
+	return &CategorizationRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PipelineRuleClient) UpdateOne(_m *PipelineRule) *PipelineRuleUpdateOne {
-	mutation := newPipelineRuleMutation(c.config, OpUpdateOne, withPipelineRule(_m))
-	return &PipelineRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CategorizationRuleClient) UpdateOne(_m *CategorizationRule) *CategorizationRuleUpdateOne {
+	mutation := newCategorizationRuleMutation(c.config, OpUpdateOne, withCategorizationRule(_m))
+	return &CategorizationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PipelineRuleClient) UpdateOneID(id string) *PipelineRuleUpdateOne {
-	mutation := newPipelineRuleMutation(c.config, OpUpdateOne, withPipelineRuleID(id))
-	return &PipelineRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CategorizationRuleClient) UpdateOneID(id string) *CategorizationRuleUpdateOne {
+	mutation := newCategorizationRuleMutation(c.config, OpUpdateOne, withCategorizationRuleID(id))
+	return &CategorizationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PipelineRule.
-func (c *PipelineRuleClient) Delete() *PipelineRuleDelete {
-	mutation := newPipelineRuleMutation(c.config, OpDelete)
-	return &PipelineRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CategorizationRule.
+func (c *CategorizationRuleClient) Delete() *CategorizationRuleDelete {
+	mutation := newCategorizationRuleMutation(c.config, OpDelete)
+	return &CategorizationRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PipelineRuleClient) DeleteOne(_m *PipelineRule) *PipelineRuleDeleteOne {
+func (c *CategorizationRuleClient) DeleteOne(_m *CategorizationRule) *CategorizationRuleDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PipelineRuleClient) DeleteOneID(id string) *PipelineRuleDeleteOne {
-	builder := c.Delete().Where(pipelinerule.ID(id))
+func (c *CategorizationRuleClient) DeleteOneID(id string) *CategorizationRuleDeleteOne {
+	builder := c.Delete().Where(categorizationrule.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PipelineRuleDeleteOne{builder}
+	return &CategorizationRuleDeleteOne{builder}
 }
 
-// Query returns a query builder for PipelineRule.
-func (c *PipelineRuleClient) Query() *PipelineRuleQuery {
-	return &PipelineRuleQuery{
+// Query returns a query builder for CategorizationRule.
+func (c *CategorizationRuleClient) Query() *CategorizationRuleQuery {
+	return &CategorizationRuleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePipelineRule},
+		ctx:    &QueryContext{Type: TypeCategorizationRule},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PipelineRule entity by its id.
-func (c *PipelineRuleClient) Get(ctx context.Context, id string) (*PipelineRule, error) {
-	return c.Query().Where(pipelinerule.ID(id)).Only(ctx)
+// Get returns a CategorizationRule entity by its id.
+func (c *CategorizationRuleClient) Get(ctx context.Context, id string) (*CategorizationRule, error) {
+	return c.Query().Where(categorizationrule.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PipelineRuleClient) GetX(ctx context.Context, id string) *PipelineRule {
+func (c *CategorizationRuleClient) GetX(ctx context.Context, id string) *CategorizationRule {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1646,148 +1824,132 @@ func (c *PipelineRuleClient) GetX(ctx context.Context, id string) *PipelineRule
 	return obj
 }
 
-// QueryConfig queries the config edge of a PipelineRule.
-func (c *PipelineRuleClient) QueryConfig(_m *PipelineRule) *PipelineConfigQuery {
-	query := (&PipelineConfigClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(pipelinerule.Table, pipelinerule.FieldID, id),
-			sqlgraph.To(pipelineconfig.Table, pipelineconfig.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, pipelinerule.ConfigTable, pipelinerule.ConfigColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *PipelineRuleClient) Hooks() []Hook {
-	return c.hooks.PipelineRule
+func (c *CategorizationRuleClient) Hooks() []Hook {
+	return c.hooks.CategorizationRule
 }
 
 // Interceptors returns the client interceptors.
-func (c *PipelineRuleClient) Interceptors() []Interceptor {
-	return c.inters.PipelineRule
+func (c *CategorizationRuleClient) Interceptors() []Interceptor {
+	return c.inters.CategorizationRule
 }
 
-func (c *PipelineRuleClient) mutate(ctx context.Context, m *PipelineRuleMutation) (Value, error) {
+func (c *CategorizationRuleClient) mutate(ctx context.Context, m *CategorizationRuleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PipelineRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CategorizationRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PipelineRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CategorizationRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PipelineRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CategorizationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PipelineRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CategorizationRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PipelineRule mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CategorizationRule mutation op: %q", m.Op())
 	}
 }
 
-// PipelineVersionClient is a client for the PipelineVersion schema.
-type PipelineVersionClient struct {
+// CategoryFeedbackClient is a client for the CategoryFeedback schema.
+type CategoryFeedbackClient struct {
 	config
 }
 
-// NewPipelineVersionClient returns a client for the PipelineVersion from the given config.
-func NewPipelineVersionClient(c config) *PipelineVersionClient {
-	return &PipelineVersionClient{config: c}
+// NewCategoryFeedbackClient returns a client for the CategoryFeedback from the given config.
+func NewCategoryFeedbackClient(c config) *CategoryFeedbackClient {
+	return &CategoryFeedbackClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `pipelineversion.Hooks(f(g(h())))`.
-func (c *PipelineVersionClient) Use(hooks ...Hook) {
-	c.hooks.PipelineVersion = append(c.hooks.PipelineVersion, hooks...)
+// A call to `Use(f, g, h)` equals to `categoryfeedback.Hooks(f(g(h())))`.
+func (c *CategoryFeedbackClient) Use(hooks ...Hook) {
+	c.hooks.CategoryFeedback = append(c.hooks.CategoryFeedback, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `pipelineversion.Intercept(f(g(h())))`.
-func (c *PipelineVersionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PipelineVersion = append(c.inters.PipelineVersion, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `categoryfeedback.Intercept(f(g(h())))`.
+func (c *CategoryFeedbackClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CategoryFeedback = append(c.inters.CategoryFeedback, interceptors...)
 }
 
-// Create returns a builder for creating a PipelineVersion entity.
-func (c *PipelineVersionClient) Create() *PipelineVersionCreate {
-	mutation := newPipelineVersionMutation(c.config, OpCreate)
-	return &PipelineVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CategoryFeedback entity.
+func (c *CategoryFeedbackClient) Create() *CategoryFeedbackCreate {
+	mutation := newCategoryFeedbackMutation(c.config, OpCreate)
+	return &CategoryFeedbackCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PipelineVersion entities.
-func (c *PipelineVersionClient) CreateBulk(builders ...*PipelineVersionCreate) *PipelineVersionCreateBulk {
-	return &PipelineVersionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CategoryFeedback entities.
+func (c *CategoryFeedbackClient) CreateBulk(builders ...*CategoryFeedbackCreate) *CategoryFeedbackCreateBulk {
+	return &CategoryFeedbackCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PipelineVersionClient) MapCreateBulk(slice any, setFunc func(*PipelineVersionCreate, int)) *PipelineVersionCreateBulk {
+func (c *CategoryFeedbackClient) MapCreateBulk(slice any, setFunc func(*CategoryFeedbackCreate, int)) *CategoryFeedbackCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PipelineVersionCreateBulk{err: fmt.Errorf("calling to PipelineVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CategoryFeedbackCreateBulk{err: fmt.Errorf("calling to CategoryFeedbackClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PipelineVersionCreate, rv.Len())
+	builders := make([]*CategoryFeedbackCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PipelineVersionCreateBulk{config: c.config, builders: builders}
+	return &CategoryFeedbackCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PipelineVersion.
-func (c *PipelineVersionClient) Update() *PipelineVersionUpdate {
-	mutation := newPipelineVersionMutation(c.config, OpUpdate)
-	return &PipelineVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CategoryFeedback.
+func (c *CategoryFeedbackClient) Update() *CategoryFeedbackUpdate {
+	mutation := newCategoryFeedbackMutation(c.config, OpUpdate)
+	return &CategoryFeedbackUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PipelineVersionClient) UpdateOne(_m *PipelineVersion) *PipelineVersionUpdateOne {
-	mutation := newPipelineVersionMutation(c.config, OpUpdateOne, withPipelineVersion(_m))
-	return &PipelineVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CategoryFeedbackClient) UpdateOne(_m *CategoryFeedback) *CategoryFeedbackUpdateOne {
+	mutation := newCategoryFeedbackMutation(c.config, OpUpdateOne, withCategoryFeedback(_m))
+	return &CategoryFeedbackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PipelineVersionClient) UpdateOneID(id string) *PipelineVersionUpdateOne {
-	mutation := newPipelineVersionMutation(c.config, OpUpdateOne, withPipelineVersionID(id))
-	return &PipelineVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CategoryFeedbackClient) UpdateOneID(id string) *CategoryFeedbackUpdateOne {
+	mutation := newCategoryFeedbackMutation(c.config, OpUpdateOne, withCategoryFeedbackID(id))
+	return &CategoryFeedbackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PipelineVersion.
-func (c *PipelineVersionClient) Delete() *PipelineVersionDelete {
-	mutation := newPipelineVersionMutation(c.config, OpDelete)
-	return &PipelineVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CategoryFeedback.
+func (c *CategoryFeedbackClient) Delete() *CategoryFeedbackDelete {
+	mutation := newCategoryFeedbackMutation(c.config, OpDelete)
+	return &CategoryFeedbackDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PipelineVersionClient) DeleteOne(_m *PipelineVersion) *PipelineVersionDeleteOne {
+func (c *CategoryFeedbackClient) DeleteOne(_m *CategoryFeedback) *CategoryFeedbackDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PipelineVersionClient) DeleteOneID(id string) *PipelineVersionDeleteOne {
-	builder := c.Delete().Where(pipelineversion.ID(id))
+func (c *CategoryFeedbackClient) DeleteOneID(id string) *CategoryFeedbackDeleteOne {
+	builder := c.Delete().Where(categoryfeedback.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PipelineVersionDeleteOne{builder}
+	return &CategoryFeedbackDeleteOne{builder}
 }
 
-// Query returns a query builder for PipelineVersion.
-func (c *PipelineVersionClient) Query() *PipelineVersionQuery {
-	return &PipelineVersionQuery{
+// Query returns a query builder for CategoryFeedback.
+func (c *CategoryFeedbackClient) Query() *CategoryFeedbackQuery {
+	return &CategoryFeedbackQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePipelineVersion},
+		ctx:    &QueryContext{Type: TypeCategoryFeedback},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PipelineVersion entity by its id.
-func (c *PipelineVersionClient) Get(ctx context.Context, id string) (*PipelineVersion, error) {
-	return c.Query().Where(pipelineversion.ID(id)).Only(ctx)
+// Get returns a CategoryFeedback entity by its id.
+func (c *CategoryFeedbackClient) Get(ctx context.Context, id string) (*CategoryFeedback, error) {
+	return c.Query().Where(categoryfeedback.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PipelineVersionClient) GetX(ctx context.Context, id string) *PipelineVersion {
+func (c *CategoryFeedbackClient) GetX(ctx context.Context, id string) *CategoryFeedback {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1795,148 +1957,5171 @@ func (c *PipelineVersionClient) GetX(ctx context.Context, id string) *PipelineVe
 	return obj
 }
 
-// QueryConfig queries the config edge of a PipelineVersion.
-func (c *PipelineVersionClient) QueryConfig(_m *PipelineVersion) *PipelineConfigQuery {
-	query := (&PipelineConfigClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(pipelineversion.Table, pipelineversion.FieldID, id),
-			sqlgraph.To(pipelineconfig.Table, pipelineconfig.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, pipelineversion.ConfigTable, pipelineversion.ConfigColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *PipelineVersionClient) Hooks() []Hook {
-	return c.hooks.PipelineVersion
+func (c *CategoryFeedbackClient) Hooks() []Hook {
+	return c.hooks.CategoryFeedback
 }
 
 // Interceptors returns the client interceptors.
-func (c *PipelineVersionClient) Interceptors() []Interceptor {
-	return c.inters.PipelineVersion
+func (c *CategoryFeedbackClient) Interceptors() []Interceptor {
+	return c.inters.CategoryFeedback
 }
 
-func (c *PipelineVersionClient) mutate(ctx context.Context, m *PipelineVersionMutation) (Value, error) {
+func (c *CategoryFeedbackClient) mutate(ctx context.Context, m *CategoryFeedbackMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PipelineVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CategoryFeedbackCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PipelineVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CategoryFeedbackUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PipelineVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CategoryFeedbackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PipelineVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CategoryFeedbackDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PipelineVersion mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CategoryFeedback mutation op: %q", m.Op())
 	}
 }
 
-// ReceiptClient is a client for the Receipt schema.
-type ReceiptClient struct {
+// DebtClient is a client for the Debt schema.
+type DebtClient struct {
 	config
 }
 
-// NewReceiptClient returns a client for the Receipt from the given config.
-func NewReceiptClient(c config) *ReceiptClient {
-	return &ReceiptClient{config: c}
+// NewDebtClient returns a client for the Debt from the given config.
+func NewDebtClient(c config) *DebtClient {
+	return &DebtClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `receipt.Hooks(f(g(h())))`.
-func (c *ReceiptClient) Use(hooks ...Hook) {
-	c.hooks.Receipt = append(c.hooks.Receipt, hooks...)
+// A call to `Use(f, g, h)` equals to `debt.Hooks(f(g(h())))`.
+func (c *DebtClient) Use(hooks ...Hook) {
+	c.hooks.Debt = append(c.hooks.Debt, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `receipt.Intercept(f(g(h())))`.
-func (c *ReceiptClient) Intercept(interceptors ...Interceptor) {
+// A call to `Intercept(f, g, h)` equals to `debt.Intercept(f(g(h())))`.
+func (c *DebtClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Debt = append(c.inters.Debt, interceptors...)
+}
+
+// Create returns a builder for creating a Debt entity.
+func (c *DebtClient) Create() *DebtCreate {
+	mutation := newDebtMutation(c.config, OpCreate)
+	return &DebtCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Debt entities.
+func (c *DebtClient) CreateBulk(builders ...*DebtCreate) *DebtCreateBulk {
+	return &DebtCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DebtClient) MapCreateBulk(slice any, setFunc func(*DebtCreate, int)) *DebtCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DebtCreateBulk{err: fmt.Errorf("calling to DebtClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DebtCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DebtCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Debt.
+func (c *DebtClient) Update() *DebtUpdate {
+	mutation := newDebtMutation(c.config, OpUpdate)
+	return &DebtUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DebtClient) UpdateOne(_m *Debt) *DebtUpdateOne {
+	mutation := newDebtMutation(c.config, OpUpdateOne, withDebt(_m))
+	return &DebtUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DebtClient) UpdateOneID(id string) *DebtUpdateOne {
+	mutation := newDebtMutation(c.config, OpUpdateOne, withDebtID(id))
+	return &DebtUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Debt.
+func (c *DebtClient) Delete() *DebtDelete {
+	mutation := newDebtMutation(c.config, OpDelete)
+	return &DebtDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DebtClient) DeleteOne(_m *Debt) *DebtDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DebtClient) DeleteOneID(id string) *DebtDeleteOne {
+	builder := c.Delete().Where(debt.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DebtDeleteOne{builder}
+}
+
+// Query returns a query builder for Debt.
+func (c *DebtClient) Query() *DebtQuery {
+	return &DebtQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDebt},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Debt entity by its id.
+func (c *DebtClient) Get(ctx context.Context, id string) (*Debt, error) {
+	return c.Query().Where(debt.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DebtClient) GetX(ctx context.Context, id string) *Debt {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DebtClient) Hooks() []Hook {
+	return c.hooks.Debt
+}
+
+// Interceptors returns the client interceptors.
+func (c *DebtClient) Interceptors() []Interceptor {
+	return c.inters.Debt
+}
+
+func (c *DebtClient) mutate(ctx context.Context, m *DebtMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DebtCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DebtUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DebtUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DebtDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Debt mutation op: %q", m.Op())
+	}
+}
+
+// EmailConnectionClient is a client for the EmailConnection schema.
+type EmailConnectionClient struct {
+	config
+}
+
+// NewEmailConnectionClient returns a client for the EmailConnection from the given config.
+func NewEmailConnectionClient(c config) *EmailConnectionClient {
+	return &EmailConnectionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `emailconnection.Hooks(f(g(h())))`.
+func (c *EmailConnectionClient) Use(hooks ...Hook) {
+	c.hooks.EmailConnection = append(c.hooks.EmailConnection, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `emailconnection.Intercept(f(g(h())))`.
+func (c *EmailConnectionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailConnection = append(c.inters.EmailConnection, interceptors...)
+}
+
+// Create returns a builder for creating a EmailConnection entity.
+func (c *EmailConnectionClient) Create() *EmailConnectionCreate {
+	mutation := newEmailConnectionMutation(c.config, OpCreate)
+	return &EmailConnectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmailConnection entities.
+func (c *EmailConnectionClient) CreateBulk(builders ...*EmailConnectionCreate) *EmailConnectionCreateBulk {
+	return &EmailConnectionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmailConnectionClient) MapCreateBulk(slice any, setFunc func(*EmailConnectionCreate, int)) *EmailConnectionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmailConnectionCreateBulk{err: fmt.Errorf("calling to EmailConnectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmailConnectionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmailConnectionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmailConnection.
+func (c *EmailConnectionClient) Update() *EmailConnectionUpdate {
+	mutation := newEmailConnectionMutation(c.config, OpUpdate)
+	return &EmailConnectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmailConnectionClient) UpdateOne(_m *EmailConnection) *EmailConnectionUpdateOne {
+	mutation := newEmailConnectionMutation(c.config, OpUpdateOne, withEmailConnection(_m))
+	return &EmailConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmailConnectionClient) UpdateOneID(id string) *EmailConnectionUpdateOne {
+	mutation := newEmailConnectionMutation(c.config, OpUpdateOne, withEmailConnectionID(id))
+	return &EmailConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmailConnection.
+func (c *EmailConnectionClient) Delete() *EmailConnectionDelete {
+	mutation := newEmailConnectionMutation(c.config, OpDelete)
+	return &EmailConnectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmailConnectionClient) DeleteOne(_m *EmailConnection) *EmailConnectionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmailConnectionClient) DeleteOneID(id string) *EmailConnectionDeleteOne {
+	builder := c.Delete().Where(emailconnection.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmailConnectionDeleteOne{builder}
+}
+
+// Query returns a query builder for EmailConnection.
+func (c *EmailConnectionClient) Query() *EmailConnectionQuery {
+	return &EmailConnectionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmailConnection},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmailConnection entity by its id.
+func (c *EmailConnectionClient) Get(ctx context.Context, id string) (*EmailConnection, error) {
+	return c.Query().Where(emailconnection.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmailConnectionClient) GetX(ctx context.Context, id string) *EmailConnection {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a EmailConnection.
+func (c *EmailConnectionClient) QueryUser(_m *EmailConnection) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emailconnection.Table, emailconnection.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, emailconnection.UserTable, emailconnection.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLabels queries the labels edge of a EmailConnection.
+func (c *EmailConnectionClient) QueryLabels(_m *EmailConnection) *EmailLabelQuery {
+	query := (&EmailLabelClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emailconnection.Table, emailconnection.FieldID, id),
+			sqlgraph.To(emaillabel.Table, emaillabel.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, emailconnection.LabelsTable, emailconnection.LabelsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySyncs queries the syncs edge of a EmailConnection.
+func (c *EmailConnectionClient) QuerySyncs(_m *EmailConnection) *EmailSyncQuery {
+	query := (&EmailSyncClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emailconnection.Table, emailconnection.FieldID, id),
+			sqlgraph.To(emailsync.Table, emailsync.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, emailconnection.SyncsTable, emailconnection.SyncsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EmailConnectionClient) Hooks() []Hook {
+	return c.hooks.EmailConnection
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmailConnectionClient) Interceptors() []Interceptor {
+	return c.inters.EmailConnection
+}
+
+func (c *EmailConnectionClient) mutate(ctx context.Context, m *EmailConnectionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmailConnectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmailConnectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmailConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmailConnectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmailConnection mutation op: %q", m.Op())
+	}
+}
+
+// EmailLabelClient is a client for the EmailLabel schema.
+type EmailLabelClient struct {
+	config
+}
+
+// NewEmailLabelClient returns a client for the EmailLabel from the given config.
+func NewEmailLabelClient(c config) *EmailLabelClient {
+	return &EmailLabelClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `emaillabel.Hooks(f(g(h())))`.
+func (c *EmailLabelClient) Use(hooks ...Hook) {
+	c.hooks.EmailLabel = append(c.hooks.EmailLabel, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `emaillabel.Intercept(f(g(h())))`.
+func (c *EmailLabelClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailLabel = append(c.inters.EmailLabel, interceptors...)
+}
+
+// Create returns a builder for creating a EmailLabel entity.
+func (c *EmailLabelClient) Create() *EmailLabelCreate {
+	mutation := newEmailLabelMutation(c.config, OpCreate)
+	return &EmailLabelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmailLabel entities.
+func (c *EmailLabelClient) CreateBulk(builders ...*EmailLabelCreate) *EmailLabelCreateBulk {
+	return &EmailLabelCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmailLabelClient) MapCreateBulk(slice any, setFunc func(*EmailLabelCreate, int)) *EmailLabelCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmailLabelCreateBulk{err: fmt.Errorf("calling to EmailLabelClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmailLabelCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmailLabelCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmailLabel.
+func (c *EmailLabelClient) Update() *EmailLabelUpdate {
+	mutation := newEmailLabelMutation(c.config, OpUpdate)
+	return &EmailLabelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmailLabelClient) UpdateOne(_m *EmailLabel) *EmailLabelUpdateOne {
+	mutation := newEmailLabelMutation(c.config, OpUpdateOne, withEmailLabel(_m))
+	return &EmailLabelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmailLabelClient) UpdateOneID(id string) *EmailLabelUpdateOne {
+	mutation := newEmailLabelMutation(c.config, OpUpdateOne, withEmailLabelID(id))
+	return &EmailLabelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmailLabel.
+func (c *EmailLabelClient) Delete() *EmailLabelDelete {
+	mutation := newEmailLabelMutation(c.config, OpDelete)
+	return &EmailLabelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmailLabelClient) DeleteOne(_m *EmailLabel) *EmailLabelDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmailLabelClient) DeleteOneID(id string) *EmailLabelDeleteOne {
+	builder := c.Delete().Where(emaillabel.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmailLabelDeleteOne{builder}
+}
+
+// Query returns a query builder for EmailLabel.
+func (c *EmailLabelClient) Query() *EmailLabelQuery {
+	return &EmailLabelQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmailLabel},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmailLabel entity by its id.
+func (c *EmailLabelClient) Get(ctx context.Context, id string) (*EmailLabel, error) {
+	return c.Query().Where(emaillabel.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmailLabelClient) GetX(ctx context.Context, id string) *EmailLabel {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryConnection queries the connection edge of a EmailLabel.
+func (c *EmailLabelClient) QueryConnection(_m *EmailLabel) *EmailConnectionQuery {
+	query := (&EmailConnectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emaillabel.Table, emaillabel.FieldID, id),
+			sqlgraph.To(emailconnection.Table, emailconnection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, emaillabel.ConnectionTable, emaillabel.ConnectionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EmailLabelClient) Hooks() []Hook {
+	return c.hooks.EmailLabel
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmailLabelClient) Interceptors() []Interceptor {
+	return c.inters.EmailLabel
+}
+
+func (c *EmailLabelClient) mutate(ctx context.Context, m *EmailLabelMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmailLabelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmailLabelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmailLabelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmailLabelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmailLabel mutation op: %q", m.Op())
+	}
+}
+
+// EmailMessageClient is a client for the EmailMessage schema.
+type EmailMessageClient struct {
+	config
+}
+
+// NewEmailMessageClient returns a client for the EmailMessage from the given config.
+func NewEmailMessageClient(c config) *EmailMessageClient {
+	return &EmailMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `emailmessage.Hooks(f(g(h())))`.
+func (c *EmailMessageClient) Use(hooks ...Hook) {
+	c.hooks.EmailMessage = append(c.hooks.EmailMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `emailmessage.Intercept(f(g(h())))`.
+func (c *EmailMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailMessage = append(c.inters.EmailMessage, interceptors...)
+}
+
+// Create returns a builder for creating a EmailMessage entity.
+func (c *EmailMessageClient) Create() *EmailMessageCreate {
+	mutation := newEmailMessageMutation(c.config, OpCreate)
+	return &EmailMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmailMessage entities.
+func (c *EmailMessageClient) CreateBulk(builders ...*EmailMessageCreate) *EmailMessageCreateBulk {
+	return &EmailMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmailMessageClient) MapCreateBulk(slice any, setFunc func(*EmailMessageCreate, int)) *EmailMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmailMessageCreateBulk{err: fmt.Errorf("calling to EmailMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmailMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmailMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmailMessage.
+func (c *EmailMessageClient) Update() *EmailMessageUpdate {
+	mutation := newEmailMessageMutation(c.config, OpUpdate)
+	return &EmailMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmailMessageClient) UpdateOne(_m *EmailMessage) *EmailMessageUpdateOne {
+	mutation := newEmailMessageMutation(c.config, OpUpdateOne, withEmailMessage(_m))
+	return &EmailMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmailMessageClient) UpdateOneID(id string) *EmailMessageUpdateOne {
+	mutation := newEmailMessageMutation(c.config, OpUpdateOne, withEmailMessageID(id))
+	return &EmailMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmailMessage.
+func (c *EmailMessageClient) Delete() *EmailMessageDelete {
+	mutation := newEmailMessageMutation(c.config, OpDelete)
+	return &EmailMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmailMessageClient) DeleteOne(_m *EmailMessage) *EmailMessageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmailMessageClient) DeleteOneID(id string) *EmailMessageDeleteOne {
+	builder := c.Delete().Where(emailmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmailMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for EmailMessage.
+func (c *EmailMessageClient) Query() *EmailMessageQuery {
+	return &EmailMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmailMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmailMessage entity by its id.
+func (c *EmailMessageClient) Get(ctx context.Context, id string) (*EmailMessage, error) {
+	return c.Query().Where(emailmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmailMessageClient) GetX(ctx context.Context, id string) *EmailMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EmailMessageClient) Hooks() []Hook {
+	return c.hooks.EmailMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmailMessageClient) Interceptors() []Interceptor {
+	return c.inters.EmailMessage
+}
+
+func (c *EmailMessageClient) mutate(ctx context.Context, m *EmailMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmailMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmailMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmailMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmailMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmailMessage mutation op: %q", m.Op())
+	}
+}
+
+// EmailSyncClient is a client for the EmailSync schema.
+type EmailSyncClient struct {
+	config
+}
+
+// NewEmailSyncClient returns a client for the EmailSync from the given config.
+func NewEmailSyncClient(c config) *EmailSyncClient {
+	return &EmailSyncClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `emailsync.Hooks(f(g(h())))`.
+func (c *EmailSyncClient) Use(hooks ...Hook) {
+	c.hooks.EmailSync = append(c.hooks.EmailSync, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `emailsync.Intercept(f(g(h())))`.
+func (c *EmailSyncClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailSync = append(c.inters.EmailSync, interceptors...)
+}
+
+// Create returns a builder for creating a EmailSync entity.
+func (c *EmailSyncClient) Create() *EmailSyncCreate {
+	mutation := newEmailSyncMutation(c.config, OpCreate)
+	return &EmailSyncCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmailSync entities.
+func (c *EmailSyncClient) CreateBulk(builders ...*EmailSyncCreate) *EmailSyncCreateBulk {
+	return &EmailSyncCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmailSyncClient) MapCreateBulk(slice any, setFunc func(*EmailSyncCreate, int)) *EmailSyncCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmailSyncCreateBulk{err: fmt.Errorf("calling to EmailSyncClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmailSyncCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmailSyncCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmailSync.
+func (c *EmailSyncClient) Update() *EmailSyncUpdate {
+	mutation := newEmailSyncMutation(c.config, OpUpdate)
+	return &EmailSyncUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmailSyncClient) UpdateOne(_m *EmailSync) *EmailSyncUpdateOne {
+	mutation := newEmailSyncMutation(c.config, OpUpdateOne, withEmailSync(_m))
+	return &EmailSyncUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmailSyncClient) UpdateOneID(id string) *EmailSyncUpdateOne {
+	mutation := newEmailSyncMutation(c.config, OpUpdateOne, withEmailSyncID(id))
+	return &EmailSyncUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmailSync.
+func (c *EmailSyncClient) Delete() *EmailSyncDelete {
+	mutation := newEmailSyncMutation(c.config, OpDelete)
+	return &EmailSyncDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmailSyncClient) DeleteOne(_m *EmailSync) *EmailSyncDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmailSyncClient) DeleteOneID(id string) *EmailSyncDeleteOne {
+	builder := c.Delete().Where(emailsync.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmailSyncDeleteOne{builder}
+}
+
+// Query returns a query builder for EmailSync.
+func (c *EmailSyncClient) Query() *EmailSyncQuery {
+	return &EmailSyncQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmailSync},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmailSync entity by its id.
+func (c *EmailSyncClient) Get(ctx context.Context, id string) (*EmailSync, error) {
+	return c.Query().Where(emailsync.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmailSyncClient) GetX(ctx context.Context, id string) *EmailSync {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryConnection queries the connection edge of a EmailSync.
+func (c *EmailSyncClient) QueryConnection(_m *EmailSync) *EmailConnectionQuery {
+	query := (&EmailConnectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emailsync.Table, emailsync.FieldID, id),
+			sqlgraph.To(emailconnection.Table, emailconnection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, emailsync.ConnectionTable, emailsync.ConnectionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFailures queries the failures edge of a EmailSync.
+func (c *EmailSyncClient) QueryFailures(_m *EmailSync) *EmailSyncFailureQuery {
+	query := (&EmailSyncFailureClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emailsync.Table, emailsync.FieldID, id),
+			sqlgraph.To(emailsyncfailure.Table, emailsyncfailure.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, emailsync.FailuresTable, emailsync.FailuresColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EmailSyncClient) Hooks() []Hook {
+	return c.hooks.EmailSync
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmailSyncClient) Interceptors() []Interceptor {
+	return c.inters.EmailSync
+}
+
+func (c *EmailSyncClient) mutate(ctx context.Context, m *EmailSyncMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmailSyncCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmailSyncUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmailSyncUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmailSyncDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmailSync mutation op: %q", m.Op())
+	}
+}
+
+// EmailSyncFailureClient is a client for the EmailSyncFailure schema.
+type EmailSyncFailureClient struct {
+	config
+}
+
+// NewEmailSyncFailureClient returns a client for the EmailSyncFailure from the given config.
+func NewEmailSyncFailureClient(c config) *EmailSyncFailureClient {
+	return &EmailSyncFailureClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `emailsyncfailure.Hooks(f(g(h())))`.
+func (c *EmailSyncFailureClient) Use(hooks ...Hook) {
+	c.hooks.EmailSyncFailure = append(c.hooks.EmailSyncFailure, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `emailsyncfailure.Intercept(f(g(h())))`.
+func (c *EmailSyncFailureClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailSyncFailure = append(c.inters.EmailSyncFailure, interceptors...)
+}
+
+// Create returns a builder for creating a EmailSyncFailure entity.
+func (c *EmailSyncFailureClient) Create() *EmailSyncFailureCreate {
+	mutation := newEmailSyncFailureMutation(c.config, OpCreate)
+	return &EmailSyncFailureCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmailSyncFailure entities.
+func (c *EmailSyncFailureClient) CreateBulk(builders ...*EmailSyncFailureCreate) *EmailSyncFailureCreateBulk {
+	return &EmailSyncFailureCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmailSyncFailureClient) MapCreateBulk(slice any, setFunc func(*EmailSyncFailureCreate, int)) *EmailSyncFailureCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmailSyncFailureCreateBulk{err: fmt.Errorf("calling to EmailSyncFailureClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmailSyncFailureCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmailSyncFailureCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmailSyncFailure.
+func (c *EmailSyncFailureClient) Update() *EmailSyncFailureUpdate {
+	mutation := newEmailSyncFailureMutation(c.config, OpUpdate)
+	return &EmailSyncFailureUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmailSyncFailureClient) UpdateOne(_m *EmailSyncFailure) *EmailSyncFailureUpdateOne {
+	mutation := newEmailSyncFailureMutation(c.config, OpUpdateOne, withEmailSyncFailure(_m))
+	return &EmailSyncFailureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmailSyncFailureClient) UpdateOneID(id string) *EmailSyncFailureUpdateOne {
+	mutation := newEmailSyncFailureMutation(c.config, OpUpdateOne, withEmailSyncFailureID(id))
+	return &EmailSyncFailureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmailSyncFailure.
+func (c *EmailSyncFailureClient) Delete() *EmailSyncFailureDelete {
+	mutation := newEmailSyncFailureMutation(c.config, OpDelete)
+	return &EmailSyncFailureDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmailSyncFailureClient) DeleteOne(_m *EmailSyncFailure) *EmailSyncFailureDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmailSyncFailureClient) DeleteOneID(id string) *EmailSyncFailureDeleteOne {
+	builder := c.Delete().Where(emailsyncfailure.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmailSyncFailureDeleteOne{builder}
+}
+
+// Query returns a query builder for EmailSyncFailure.
+func (c *EmailSyncFailureClient) Query() *EmailSyncFailureQuery {
+	return &EmailSyncFailureQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmailSyncFailure},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmailSyncFailure entity by its id.
+func (c *EmailSyncFailureClient) Get(ctx context.Context, id string) (*EmailSyncFailure, error) {
+	return c.Query().Where(emailsyncfailure.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmailSyncFailureClient) GetX(ctx context.Context, id string) *EmailSyncFailure {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySync queries the sync edge of a EmailSyncFailure.
+func (c *EmailSyncFailureClient) QuerySync(_m *EmailSyncFailure) *EmailSyncQuery {
+	query := (&EmailSyncClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emailsyncfailure.Table, emailsyncfailure.FieldID, id),
+			sqlgraph.To(emailsync.Table, emailsync.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, emailsyncfailure.SyncTable, emailsyncfailure.SyncColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EmailSyncFailureClient) Hooks() []Hook {
+	return c.hooks.EmailSyncFailure
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmailSyncFailureClient) Interceptors() []Interceptor {
+	return c.inters.EmailSyncFailure
+}
+
+func (c *EmailSyncFailureClient) mutate(ctx context.Context, m *EmailSyncFailureMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmailSyncFailureCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmailSyncFailureUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmailSyncFailureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmailSyncFailureDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmailSyncFailure mutation op: %q", m.Op())
+	}
+}
+
+// EmergencyFundSnapshotClient is a client for the EmergencyFundSnapshot schema.
+type EmergencyFundSnapshotClient struct {
+	config
+}
+
+// NewEmergencyFundSnapshotClient returns a client for the EmergencyFundSnapshot from the given config.
+func NewEmergencyFundSnapshotClient(c config) *EmergencyFundSnapshotClient {
+	return &EmergencyFundSnapshotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `emergencyfundsnapshot.Hooks(f(g(h())))`.
+func (c *EmergencyFundSnapshotClient) Use(hooks ...Hook) {
+	c.hooks.EmergencyFundSnapshot = append(c.hooks.EmergencyFundSnapshot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `emergencyfundsnapshot.Intercept(f(g(h())))`.
+func (c *EmergencyFundSnapshotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmergencyFundSnapshot = append(c.inters.EmergencyFundSnapshot, interceptors...)
+}
+
+// Create returns a builder for creating a EmergencyFundSnapshot entity.
+func (c *EmergencyFundSnapshotClient) Create() *EmergencyFundSnapshotCreate {
+	mutation := newEmergencyFundSnapshotMutation(c.config, OpCreate)
+	return &EmergencyFundSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmergencyFundSnapshot entities.
+func (c *EmergencyFundSnapshotClient) CreateBulk(builders ...*EmergencyFundSnapshotCreate) *EmergencyFundSnapshotCreateBulk {
+	return &EmergencyFundSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmergencyFundSnapshotClient) MapCreateBulk(slice any, setFunc func(*EmergencyFundSnapshotCreate, int)) *EmergencyFundSnapshotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmergencyFundSnapshotCreateBulk{err: fmt.Errorf("calling to EmergencyFundSnapshotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmergencyFundSnapshotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmergencyFundSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmergencyFundSnapshot.
+func (c *EmergencyFundSnapshotClient) Update() *EmergencyFundSnapshotUpdate {
+	mutation := newEmergencyFundSnapshotMutation(c.config, OpUpdate)
+	return &EmergencyFundSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmergencyFundSnapshotClient) UpdateOne(_m *EmergencyFundSnapshot) *EmergencyFundSnapshotUpdateOne {
+	mutation := newEmergencyFundSnapshotMutation(c.config, OpUpdateOne, withEmergencyFundSnapshot(_m))
+	return &EmergencyFundSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmergencyFundSnapshotClient) UpdateOneID(id string) *EmergencyFundSnapshotUpdateOne {
+	mutation := newEmergencyFundSnapshotMutation(c.config, OpUpdateOne, withEmergencyFundSnapshotID(id))
+	return &EmergencyFundSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmergencyFundSnapshot.
+func (c *EmergencyFundSnapshotClient) Delete() *EmergencyFundSnapshotDelete {
+	mutation := newEmergencyFundSnapshotMutation(c.config, OpDelete)
+	return &EmergencyFundSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmergencyFundSnapshotClient) DeleteOne(_m *EmergencyFundSnapshot) *EmergencyFundSnapshotDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmergencyFundSnapshotClient) DeleteOneID(id string) *EmergencyFundSnapshotDeleteOne {
+	builder := c.Delete().Where(emergencyfundsnapshot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmergencyFundSnapshotDeleteOne{builder}
+}
+
+// Query returns a query builder for EmergencyFundSnapshot.
+func (c *EmergencyFundSnapshotClient) Query() *EmergencyFundSnapshotQuery {
+	return &EmergencyFundSnapshotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmergencyFundSnapshot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmergencyFundSnapshot entity by its id.
+func (c *EmergencyFundSnapshotClient) Get(ctx context.Context, id string) (*EmergencyFundSnapshot, error) {
+	return c.Query().Where(emergencyfundsnapshot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmergencyFundSnapshotClient) GetX(ctx context.Context, id string) *EmergencyFundSnapshot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EmergencyFundSnapshotClient) Hooks() []Hook {
+	return c.hooks.EmergencyFundSnapshot
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmergencyFundSnapshotClient) Interceptors() []Interceptor {
+	return c.inters.EmergencyFundSnapshot
+}
+
+func (c *EmergencyFundSnapshotClient) mutate(ctx context.Context, m *EmergencyFundSnapshotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmergencyFundSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmergencyFundSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmergencyFundSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmergencyFundSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmergencyFundSnapshot mutation op: %q", m.Op())
+	}
+}
+
+// EmergencyFundTargetClient is a client for the EmergencyFundTarget schema.
+type EmergencyFundTargetClient struct {
+	config
+}
+
+// NewEmergencyFundTargetClient returns a client for the EmergencyFundTarget from the given config.
+func NewEmergencyFundTargetClient(c config) *EmergencyFundTargetClient {
+	return &EmergencyFundTargetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `emergencyfundtarget.Hooks(f(g(h())))`.
+func (c *EmergencyFundTargetClient) Use(hooks ...Hook) {
+	c.hooks.EmergencyFundTarget = append(c.hooks.EmergencyFundTarget, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `emergencyfundtarget.Intercept(f(g(h())))`.
+func (c *EmergencyFundTargetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmergencyFundTarget = append(c.inters.EmergencyFundTarget, interceptors...)
+}
+
+// Create returns a builder for creating a EmergencyFundTarget entity.
+func (c *EmergencyFundTargetClient) Create() *EmergencyFundTargetCreate {
+	mutation := newEmergencyFundTargetMutation(c.config, OpCreate)
+	return &EmergencyFundTargetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmergencyFundTarget entities.
+func (c *EmergencyFundTargetClient) CreateBulk(builders ...*EmergencyFundTargetCreate) *EmergencyFundTargetCreateBulk {
+	return &EmergencyFundTargetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmergencyFundTargetClient) MapCreateBulk(slice any, setFunc func(*EmergencyFundTargetCreate, int)) *EmergencyFundTargetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmergencyFundTargetCreateBulk{err: fmt.Errorf("calling to EmergencyFundTargetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmergencyFundTargetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmergencyFundTargetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmergencyFundTarget.
+func (c *EmergencyFundTargetClient) Update() *EmergencyFundTargetUpdate {
+	mutation := newEmergencyFundTargetMutation(c.config, OpUpdate)
+	return &EmergencyFundTargetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmergencyFundTargetClient) UpdateOne(_m *EmergencyFundTarget) *EmergencyFundTargetUpdateOne {
+	mutation := newEmergencyFundTargetMutation(c.config, OpUpdateOne, withEmergencyFundTarget(_m))
+	return &EmergencyFundTargetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmergencyFundTargetClient) UpdateOneID(id string) *EmergencyFundTargetUpdateOne {
+	mutation := newEmergencyFundTargetMutation(c.config, OpUpdateOne, withEmergencyFundTargetID(id))
+	return &EmergencyFundTargetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmergencyFundTarget.
+func (c *EmergencyFundTargetClient) Delete() *EmergencyFundTargetDelete {
+	mutation := newEmergencyFundTargetMutation(c.config, OpDelete)
+	return &EmergencyFundTargetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmergencyFundTargetClient) DeleteOne(_m *EmergencyFundTarget) *EmergencyFundTargetDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmergencyFundTargetClient) DeleteOneID(id string) *EmergencyFundTargetDeleteOne {
+	builder := c.Delete().Where(emergencyfundtarget.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmergencyFundTargetDeleteOne{builder}
+}
+
+// Query returns a query builder for EmergencyFundTarget.
+func (c *EmergencyFundTargetClient) Query() *EmergencyFundTargetQuery {
+	return &EmergencyFundTargetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmergencyFundTarget},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmergencyFundTarget entity by its id.
+func (c *EmergencyFundTargetClient) Get(ctx context.Context, id string) (*EmergencyFundTarget, error) {
+	return c.Query().Where(emergencyfundtarget.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmergencyFundTargetClient) GetX(ctx context.Context, id string) *EmergencyFundTarget {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EmergencyFundTargetClient) Hooks() []Hook {
+	return c.hooks.EmergencyFundTarget
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmergencyFundTargetClient) Interceptors() []Interceptor {
+	return c.inters.EmergencyFundTarget
+}
+
+func (c *EmergencyFundTargetClient) mutate(ctx context.Context, m *EmergencyFundTargetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmergencyFundTargetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmergencyFundTargetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmergencyFundTargetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmergencyFundTargetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmergencyFundTarget mutation op: %q", m.Op())
+	}
+}
+
+// GoalClient is a client for the Goal schema.
+type GoalClient struct {
+	config
+}
+
+// NewGoalClient returns a client for the Goal from the given config.
+func NewGoalClient(c config) *GoalClient {
+	return &GoalClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `goal.Hooks(f(g(h())))`.
+func (c *GoalClient) Use(hooks ...Hook) {
+	c.hooks.Goal = append(c.hooks.Goal, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `goal.Intercept(f(g(h())))`.
+func (c *GoalClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Goal = append(c.inters.Goal, interceptors...)
+}
+
+// Create returns a builder for creating a Goal entity.
+func (c *GoalClient) Create() *GoalCreate {
+	mutation := newGoalMutation(c.config, OpCreate)
+	return &GoalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Goal entities.
+func (c *GoalClient) CreateBulk(builders ...*GoalCreate) *GoalCreateBulk {
+	return &GoalCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GoalClient) MapCreateBulk(slice any, setFunc func(*GoalCreate, int)) *GoalCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GoalCreateBulk{err: fmt.Errorf("calling to GoalClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GoalCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GoalCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Goal.
+func (c *GoalClient) Update() *GoalUpdate {
+	mutation := newGoalMutation(c.config, OpUpdate)
+	return &GoalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GoalClient) UpdateOne(_m *Goal) *GoalUpdateOne {
+	mutation := newGoalMutation(c.config, OpUpdateOne, withGoal(_m))
+	return &GoalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GoalClient) UpdateOneID(id string) *GoalUpdateOne {
+	mutation := newGoalMutation(c.config, OpUpdateOne, withGoalID(id))
+	return &GoalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Goal.
+func (c *GoalClient) Delete() *GoalDelete {
+	mutation := newGoalMutation(c.config, OpDelete)
+	return &GoalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GoalClient) DeleteOne(_m *Goal) *GoalDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GoalClient) DeleteOneID(id string) *GoalDeleteOne {
+	builder := c.Delete().Where(goal.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GoalDeleteOne{builder}
+}
+
+// Query returns a query builder for Goal.
+func (c *GoalClient) Query() *GoalQuery {
+	return &GoalQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGoal},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Goal entity by its id.
+func (c *GoalClient) Get(ctx context.Context, id string) (*Goal, error) {
+	return c.Query().Where(goal.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GoalClient) GetX(ctx context.Context, id string) *Goal {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GoalClient) Hooks() []Hook {
+	return c.hooks.Goal
+}
+
+// Interceptors returns the client interceptors.
+func (c *GoalClient) Interceptors() []Interceptor {
+	return c.inters.Goal
+}
+
+func (c *GoalClient) mutate(ctx context.Context, m *GoalMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GoalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GoalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GoalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GoalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Goal mutation op: %q", m.Op())
+	}
+}
+
+// GoogleDriveConnectionClient is a client for the GoogleDriveConnection schema.
+type GoogleDriveConnectionClient struct {
+	config
+}
+
+// NewGoogleDriveConnectionClient returns a client for the GoogleDriveConnection from the given config.
+func NewGoogleDriveConnectionClient(c config) *GoogleDriveConnectionClient {
+	return &GoogleDriveConnectionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `googledriveconnection.Hooks(f(g(h())))`.
+func (c *GoogleDriveConnectionClient) Use(hooks ...Hook) {
+	c.hooks.GoogleDriveConnection = append(c.hooks.GoogleDriveConnection, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `googledriveconnection.Intercept(f(g(h())))`.
+func (c *GoogleDriveConnectionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GoogleDriveConnection = append(c.inters.GoogleDriveConnection, interceptors...)
+}
+
+// Create returns a builder for creating a GoogleDriveConnection entity.
+func (c *GoogleDriveConnectionClient) Create() *GoogleDriveConnectionCreate {
+	mutation := newGoogleDriveConnectionMutation(c.config, OpCreate)
+	return &GoogleDriveConnectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GoogleDriveConnection entities.
+func (c *GoogleDriveConnectionClient) CreateBulk(builders ...*GoogleDriveConnectionCreate) *GoogleDriveConnectionCreateBulk {
+	return &GoogleDriveConnectionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GoogleDriveConnectionClient) MapCreateBulk(slice any, setFunc func(*GoogleDriveConnectionCreate, int)) *GoogleDriveConnectionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GoogleDriveConnectionCreateBulk{err: fmt.Errorf("calling to GoogleDriveConnectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GoogleDriveConnectionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GoogleDriveConnectionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GoogleDriveConnection.
+func (c *GoogleDriveConnectionClient) Update() *GoogleDriveConnectionUpdate {
+	mutation := newGoogleDriveConnectionMutation(c.config, OpUpdate)
+	return &GoogleDriveConnectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GoogleDriveConnectionClient) UpdateOne(_m *GoogleDriveConnection) *GoogleDriveConnectionUpdateOne {
+	mutation := newGoogleDriveConnectionMutation(c.config, OpUpdateOne, withGoogleDriveConnection(_m))
+	return &GoogleDriveConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GoogleDriveConnectionClient) UpdateOneID(id string) *GoogleDriveConnectionUpdateOne {
+	mutation := newGoogleDriveConnectionMutation(c.config, OpUpdateOne, withGoogleDriveConnectionID(id))
+	return &GoogleDriveConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GoogleDriveConnection.
+func (c *GoogleDriveConnectionClient) Delete() *GoogleDriveConnectionDelete {
+	mutation := newGoogleDriveConnectionMutation(c.config, OpDelete)
+	return &GoogleDriveConnectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GoogleDriveConnectionClient) DeleteOne(_m *GoogleDriveConnection) *GoogleDriveConnectionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GoogleDriveConnectionClient) DeleteOneID(id string) *GoogleDriveConnectionDeleteOne {
+	builder := c.Delete().Where(googledriveconnection.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GoogleDriveConnectionDeleteOne{builder}
+}
+
+// Query returns a query builder for GoogleDriveConnection.
+func (c *GoogleDriveConnectionClient) Query() *GoogleDriveConnectionQuery {
+	return &GoogleDriveConnectionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGoogleDriveConnection},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GoogleDriveConnection entity by its id.
+func (c *GoogleDriveConnectionClient) Get(ctx context.Context, id string) (*GoogleDriveConnection, error) {
+	return c.Query().Where(googledriveconnection.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GoogleDriveConnectionClient) GetX(ctx context.Context, id string) *GoogleDriveConnection {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a GoogleDriveConnection.
+func (c *GoogleDriveConnectionClient) QueryUser(_m *GoogleDriveConnection) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(googledriveconnection.Table, googledriveconnection.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, googledriveconnection.UserTable, googledriveconnection.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFolders queries the folders edge of a GoogleDriveConnection.
+func (c *GoogleDriveConnectionClient) QueryFolders(_m *GoogleDriveConnection) *GoogleDriveFolderQuery {
+	query := (&GoogleDriveFolderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(googledriveconnection.Table, googledriveconnection.FieldID, id),
+			sqlgraph.To(googledrivefolder.Table, googledrivefolder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, googledriveconnection.FoldersTable, googledriveconnection.FoldersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySyncs queries the syncs edge of a GoogleDriveConnection.
+func (c *GoogleDriveConnectionClient) QuerySyncs(_m *GoogleDriveConnection) *GoogleDriveSyncQuery {
+	query := (&GoogleDriveSyncClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(googledriveconnection.Table, googledriveconnection.FieldID, id),
+			sqlgraph.To(googledrivesync.Table, googledrivesync.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, googledriveconnection.SyncsTable, googledriveconnection.SyncsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GoogleDriveConnectionClient) Hooks() []Hook {
+	return c.hooks.GoogleDriveConnection
+}
+
+// Interceptors returns the client interceptors.
+func (c *GoogleDriveConnectionClient) Interceptors() []Interceptor {
+	return c.inters.GoogleDriveConnection
+}
+
+func (c *GoogleDriveConnectionClient) mutate(ctx context.Context, m *GoogleDriveConnectionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GoogleDriveConnectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GoogleDriveConnectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GoogleDriveConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GoogleDriveConnectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GoogleDriveConnection mutation op: %q", m.Op())
+	}
+}
+
+// GoogleDriveFolderClient is a client for the GoogleDriveFolder schema.
+type GoogleDriveFolderClient struct {
+	config
+}
+
+// NewGoogleDriveFolderClient returns a client for the GoogleDriveFolder from the given config.
+func NewGoogleDriveFolderClient(c config) *GoogleDriveFolderClient {
+	return &GoogleDriveFolderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `googledrivefolder.Hooks(f(g(h())))`.
+func (c *GoogleDriveFolderClient) Use(hooks ...Hook) {
+	c.hooks.GoogleDriveFolder = append(c.hooks.GoogleDriveFolder, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `googledrivefolder.Intercept(f(g(h())))`.
+func (c *GoogleDriveFolderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GoogleDriveFolder = append(c.inters.GoogleDriveFolder, interceptors...)
+}
+
+// Create returns a builder for creating a GoogleDriveFolder entity.
+func (c *GoogleDriveFolderClient) Create() *GoogleDriveFolderCreate {
+	mutation := newGoogleDriveFolderMutation(c.config, OpCreate)
+	return &GoogleDriveFolderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GoogleDriveFolder entities.
+func (c *GoogleDriveFolderClient) CreateBulk(builders ...*GoogleDriveFolderCreate) *GoogleDriveFolderCreateBulk {
+	return &GoogleDriveFolderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GoogleDriveFolderClient) MapCreateBulk(slice any, setFunc func(*GoogleDriveFolderCreate, int)) *GoogleDriveFolderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GoogleDriveFolderCreateBulk{err: fmt.Errorf("calling to GoogleDriveFolderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GoogleDriveFolderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GoogleDriveFolderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GoogleDriveFolder.
+func (c *GoogleDriveFolderClient) Update() *GoogleDriveFolderUpdate {
+	mutation := newGoogleDriveFolderMutation(c.config, OpUpdate)
+	return &GoogleDriveFolderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GoogleDriveFolderClient) UpdateOne(_m *GoogleDriveFolder) *GoogleDriveFolderUpdateOne {
+	mutation := newGoogleDriveFolderMutation(c.config, OpUpdateOne, withGoogleDriveFolder(_m))
+	return &GoogleDriveFolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GoogleDriveFolderClient) UpdateOneID(id string) *GoogleDriveFolderUpdateOne {
+	mutation := newGoogleDriveFolderMutation(c.config, OpUpdateOne, withGoogleDriveFolderID(id))
+	return &GoogleDriveFolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GoogleDriveFolder.
+func (c *GoogleDriveFolderClient) Delete() *GoogleDriveFolderDelete {
+	mutation := newGoogleDriveFolderMutation(c.config, OpDelete)
+	return &GoogleDriveFolderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GoogleDriveFolderClient) DeleteOne(_m *GoogleDriveFolder) *GoogleDriveFolderDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GoogleDriveFolderClient) DeleteOneID(id string) *GoogleDriveFolderDeleteOne {
+	builder := c.Delete().Where(googledrivefolder.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GoogleDriveFolderDeleteOne{builder}
+}
+
+// Query returns a query builder for GoogleDriveFolder.
+func (c *GoogleDriveFolderClient) Query() *GoogleDriveFolderQuery {
+	return &GoogleDriveFolderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGoogleDriveFolder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GoogleDriveFolder entity by its id.
+func (c *GoogleDriveFolderClient) Get(ctx context.Context, id string) (*GoogleDriveFolder, error) {
+	return c.Query().Where(googledrivefolder.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GoogleDriveFolderClient) GetX(ctx context.Context, id string) *GoogleDriveFolder {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryConnection queries the connection edge of a GoogleDriveFolder.
+func (c *GoogleDriveFolderClient) QueryConnection(_m *GoogleDriveFolder) *GoogleDriveConnectionQuery {
+	query := (&GoogleDriveConnectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(googledrivefolder.Table, googledrivefolder.FieldID, id),
+			sqlgraph.To(googledriveconnection.Table, googledriveconnection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, googledrivefolder.ConnectionTable, googledrivefolder.ConnectionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GoogleDriveFolderClient) Hooks() []Hook {
+	return c.hooks.GoogleDriveFolder
+}
+
+// Interceptors returns the client interceptors.
+func (c *GoogleDriveFolderClient) Interceptors() []Interceptor {
+	return c.inters.GoogleDriveFolder
+}
+
+func (c *GoogleDriveFolderClient) mutate(ctx context.Context, m *GoogleDriveFolderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GoogleDriveFolderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GoogleDriveFolderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GoogleDriveFolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GoogleDriveFolderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GoogleDriveFolder mutation op: %q", m.Op())
+	}
+}
+
+// GoogleDriveSyncClient is a client for the GoogleDriveSync schema.
+type GoogleDriveSyncClient struct {
+	config
+}
+
+// NewGoogleDriveSyncClient returns a client for the GoogleDriveSync from the given config.
+func NewGoogleDriveSyncClient(c config) *GoogleDriveSyncClient {
+	return &GoogleDriveSyncClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `googledrivesync.Hooks(f(g(h())))`.
+func (c *GoogleDriveSyncClient) Use(hooks ...Hook) {
+	c.hooks.GoogleDriveSync = append(c.hooks.GoogleDriveSync, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `googledrivesync.Intercept(f(g(h())))`.
+func (c *GoogleDriveSyncClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GoogleDriveSync = append(c.inters.GoogleDriveSync, interceptors...)
+}
+
+// Create returns a builder for creating a GoogleDriveSync entity.
+func (c *GoogleDriveSyncClient) Create() *GoogleDriveSyncCreate {
+	mutation := newGoogleDriveSyncMutation(c.config, OpCreate)
+	return &GoogleDriveSyncCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GoogleDriveSync entities.
+func (c *GoogleDriveSyncClient) CreateBulk(builders ...*GoogleDriveSyncCreate) *GoogleDriveSyncCreateBulk {
+	return &GoogleDriveSyncCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GoogleDriveSyncClient) MapCreateBulk(slice any, setFunc func(*GoogleDriveSyncCreate, int)) *GoogleDriveSyncCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GoogleDriveSyncCreateBulk{err: fmt.Errorf("calling to GoogleDriveSyncClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GoogleDriveSyncCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GoogleDriveSyncCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GoogleDriveSync.
+func (c *GoogleDriveSyncClient) Update() *GoogleDriveSyncUpdate {
+	mutation := newGoogleDriveSyncMutation(c.config, OpUpdate)
+	return &GoogleDriveSyncUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GoogleDriveSyncClient) UpdateOne(_m *GoogleDriveSync) *GoogleDriveSyncUpdateOne {
+	mutation := newGoogleDriveSyncMutation(c.config, OpUpdateOne, withGoogleDriveSync(_m))
+	return &GoogleDriveSyncUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GoogleDriveSyncClient) UpdateOneID(id string) *GoogleDriveSyncUpdateOne {
+	mutation := newGoogleDriveSyncMutation(c.config, OpUpdateOne, withGoogleDriveSyncID(id))
+	return &GoogleDriveSyncUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GoogleDriveSync.
+func (c *GoogleDriveSyncClient) Delete() *GoogleDriveSyncDelete {
+	mutation := newGoogleDriveSyncMutation(c.config, OpDelete)
+	return &GoogleDriveSyncDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GoogleDriveSyncClient) DeleteOne(_m *GoogleDriveSync) *GoogleDriveSyncDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GoogleDriveSyncClient) DeleteOneID(id string) *GoogleDriveSyncDeleteOne {
+	builder := c.Delete().Where(googledrivesync.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GoogleDriveSyncDeleteOne{builder}
+}
+
+// Query returns a query builder for GoogleDriveSync.
+func (c *GoogleDriveSyncClient) Query() *GoogleDriveSyncQuery {
+	return &GoogleDriveSyncQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGoogleDriveSync},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GoogleDriveSync entity by its id.
+func (c *GoogleDriveSyncClient) Get(ctx context.Context, id string) (*GoogleDriveSync, error) {
+	return c.Query().Where(googledrivesync.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GoogleDriveSyncClient) GetX(ctx context.Context, id string) *GoogleDriveSync {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryConnection queries the connection edge of a GoogleDriveSync.
+func (c *GoogleDriveSyncClient) QueryConnection(_m *GoogleDriveSync) *GoogleDriveConnectionQuery {
+	query := (&GoogleDriveConnectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(googledrivesync.Table, googledrivesync.FieldID, id),
+			sqlgraph.To(googledriveconnection.Table, googledriveconnection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, googledrivesync.ConnectionTable, googledrivesync.ConnectionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GoogleDriveSyncClient) Hooks() []Hook {
+	return c.hooks.GoogleDriveSync
+}
+
+// Interceptors returns the client interceptors.
+func (c *GoogleDriveSyncClient) Interceptors() []Interceptor {
+	return c.inters.GoogleDriveSync
+}
+
+func (c *GoogleDriveSyncClient) mutate(ctx context.Context, m *GoogleDriveSyncMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GoogleDriveSyncCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GoogleDriveSyncUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GoogleDriveSyncUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GoogleDriveSyncDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GoogleDriveSync mutation op: %q", m.Op())
+	}
+}
+
+// HouseholdMemberClient is a client for the HouseholdMember schema.
+type HouseholdMemberClient struct {
+	config
+}
+
+// NewHouseholdMemberClient returns a client for the HouseholdMember from the given config.
+func NewHouseholdMemberClient(c config) *HouseholdMemberClient {
+	return &HouseholdMemberClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `householdmember.Hooks(f(g(h())))`.
+func (c *HouseholdMemberClient) Use(hooks ...Hook) {
+	c.hooks.HouseholdMember = append(c.hooks.HouseholdMember, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `householdmember.Intercept(f(g(h())))`.
+func (c *HouseholdMemberClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HouseholdMember = append(c.inters.HouseholdMember, interceptors...)
+}
+
+// Create returns a builder for creating a HouseholdMember entity.
+func (c *HouseholdMemberClient) Create() *HouseholdMemberCreate {
+	mutation := newHouseholdMemberMutation(c.config, OpCreate)
+	return &HouseholdMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of HouseholdMember entities.
+func (c *HouseholdMemberClient) CreateBulk(builders ...*HouseholdMemberCreate) *HouseholdMemberCreateBulk {
+	return &HouseholdMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HouseholdMemberClient) MapCreateBulk(slice any, setFunc func(*HouseholdMemberCreate, int)) *HouseholdMemberCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HouseholdMemberCreateBulk{err: fmt.Errorf("calling to HouseholdMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HouseholdMemberCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HouseholdMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for HouseholdMember.
+func (c *HouseholdMemberClient) Update() *HouseholdMemberUpdate {
+	mutation := newHouseholdMemberMutation(c.config, OpUpdate)
+	return &HouseholdMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HouseholdMemberClient) UpdateOne(_m *HouseholdMember) *HouseholdMemberUpdateOne {
+	mutation := newHouseholdMemberMutation(c.config, OpUpdateOne, withHouseholdMember(_m))
+	return &HouseholdMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HouseholdMemberClient) UpdateOneID(id string) *HouseholdMemberUpdateOne {
+	mutation := newHouseholdMemberMutation(c.config, OpUpdateOne, withHouseholdMemberID(id))
+	return &HouseholdMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for HouseholdMember.
+func (c *HouseholdMemberClient) Delete() *HouseholdMemberDelete {
+	mutation := newHouseholdMemberMutation(c.config, OpDelete)
+	return &HouseholdMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HouseholdMemberClient) DeleteOne(_m *HouseholdMember) *HouseholdMemberDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HouseholdMemberClient) DeleteOneID(id string) *HouseholdMemberDeleteOne {
+	builder := c.Delete().Where(householdmember.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HouseholdMemberDeleteOne{builder}
+}
+
+// Query returns a query builder for HouseholdMember.
+func (c *HouseholdMemberClient) Query() *HouseholdMemberQuery {
+	return &HouseholdMemberQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHouseholdMember},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a HouseholdMember entity by its id.
+func (c *HouseholdMemberClient) Get(ctx context.Context, id string) (*HouseholdMember, error) {
+	return c.Query().Where(householdmember.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HouseholdMemberClient) GetX(ctx context.Context, id string) *HouseholdMember {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *HouseholdMemberClient) Hooks() []Hook {
+	return c.hooks.HouseholdMember
+}
+
+// Interceptors returns the client interceptors.
+func (c *HouseholdMemberClient) Interceptors() []Interceptor {
+	return c.inters.HouseholdMember
+}
+
+func (c *HouseholdMemberClient) mutate(ctx context.Context, m *HouseholdMemberMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HouseholdMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HouseholdMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HouseholdMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HouseholdMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown HouseholdMember mutation op: %q", m.Op())
+	}
+}
+
+// JobQueueClient is a client for the JobQueue schema.
+type JobQueueClient struct {
+	config
+}
+
+// NewJobQueueClient returns a client for the JobQueue from the given config.
+func NewJobQueueClient(c config) *JobQueueClient {
+	return &JobQueueClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `jobqueue.Hooks(f(g(h())))`.
+func (c *JobQueueClient) Use(hooks ...Hook) {
+	c.hooks.JobQueue = append(c.hooks.JobQueue, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `jobqueue.Intercept(f(g(h())))`.
+func (c *JobQueueClient) Intercept(interceptors ...Interceptor) {
+	c.inters.JobQueue = append(c.inters.JobQueue, interceptors...)
+}
+
+// Create returns a builder for creating a JobQueue entity.
+func (c *JobQueueClient) Create() *JobQueueCreate {
+	mutation := newJobQueueMutation(c.config, OpCreate)
+	return &JobQueueCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of JobQueue entities.
+func (c *JobQueueClient) CreateBulk(builders ...*JobQueueCreate) *JobQueueCreateBulk {
+	return &JobQueueCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobQueueClient) MapCreateBulk(slice any, setFunc func(*JobQueueCreate, int)) *JobQueueCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobQueueCreateBulk{err: fmt.Errorf("calling to JobQueueClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobQueueCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobQueueCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for JobQueue.
+func (c *JobQueueClient) Update() *JobQueueUpdate {
+	mutation := newJobQueueMutation(c.config, OpUpdate)
+	return &JobQueueUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobQueueClient) UpdateOne(_m *JobQueue) *JobQueueUpdateOne {
+	mutation := newJobQueueMutation(c.config, OpUpdateOne, withJobQueue(_m))
+	return &JobQueueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobQueueClient) UpdateOneID(id string) *JobQueueUpdateOne {
+	mutation := newJobQueueMutation(c.config, OpUpdateOne, withJobQueueID(id))
+	return &JobQueueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for JobQueue.
+func (c *JobQueueClient) Delete() *JobQueueDelete {
+	mutation := newJobQueueMutation(c.config, OpDelete)
+	return &JobQueueDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobQueueClient) DeleteOne(_m *JobQueue) *JobQueueDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobQueueClient) DeleteOneID(id string) *JobQueueDeleteOne {
+	builder := c.Delete().Where(jobqueue.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobQueueDeleteOne{builder}
+}
+
+// Query returns a query builder for JobQueue.
+func (c *JobQueueClient) Query() *JobQueueQuery {
+	return &JobQueueQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJobQueue},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a JobQueue entity by its id.
+func (c *JobQueueClient) Get(ctx context.Context, id string) (*JobQueue, error) {
+	return c.Query().Where(jobqueue.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobQueueClient) GetX(ctx context.Context, id string) *JobQueue {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JobQueueClient) Hooks() []Hook {
+	return c.hooks.JobQueue
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobQueueClient) Interceptors() []Interceptor {
+	return c.inters.JobQueue
+}
+
+func (c *JobQueueClient) mutate(ctx context.Context, m *JobQueueMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobQueueCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobQueueUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobQueueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobQueueDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown JobQueue mutation op: %q", m.Op())
+	}
+}
+
+// JobScheduleClient is a client for the JobSchedule schema.
+type JobScheduleClient struct {
+	config
+}
+
+// NewJobScheduleClient returns a client for the JobSchedule from the given config.
+func NewJobScheduleClient(c config) *JobScheduleClient {
+	return &JobScheduleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `jobschedule.Hooks(f(g(h())))`.
+func (c *JobScheduleClient) Use(hooks ...Hook) {
+	c.hooks.JobSchedule = append(c.hooks.JobSchedule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `jobschedule.Intercept(f(g(h())))`.
+func (c *JobScheduleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.JobSchedule = append(c.inters.JobSchedule, interceptors...)
+}
+
+// Create returns a builder for creating a JobSchedule entity.
+func (c *JobScheduleClient) Create() *JobScheduleCreate {
+	mutation := newJobScheduleMutation(c.config, OpCreate)
+	return &JobScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of JobSchedule entities.
+func (c *JobScheduleClient) CreateBulk(builders ...*JobScheduleCreate) *JobScheduleCreateBulk {
+	return &JobScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobScheduleClient) MapCreateBulk(slice any, setFunc func(*JobScheduleCreate, int)) *JobScheduleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobScheduleCreateBulk{err: fmt.Errorf("calling to JobScheduleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobScheduleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for JobSchedule.
+func (c *JobScheduleClient) Update() *JobScheduleUpdate {
+	mutation := newJobScheduleMutation(c.config, OpUpdate)
+	return &JobScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobScheduleClient) UpdateOne(_m *JobSchedule) *JobScheduleUpdateOne {
+	mutation := newJobScheduleMutation(c.config, OpUpdateOne, withJobSchedule(_m))
+	return &JobScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobScheduleClient) UpdateOneID(id string) *JobScheduleUpdateOne {
+	mutation := newJobScheduleMutation(c.config, OpUpdateOne, withJobScheduleID(id))
+	return &JobScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for JobSchedule.
+func (c *JobScheduleClient) Delete() *JobScheduleDelete {
+	mutation := newJobScheduleMutation(c.config, OpDelete)
+	return &JobScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobScheduleClient) DeleteOne(_m *JobSchedule) *JobScheduleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobScheduleClient) DeleteOneID(id string) *JobScheduleDeleteOne {
+	builder := c.Delete().Where(jobschedule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobScheduleDeleteOne{builder}
+}
+
+// Query returns a query builder for JobSchedule.
+func (c *JobScheduleClient) Query() *JobScheduleQuery {
+	return &JobScheduleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJobSchedule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a JobSchedule entity by its id.
+func (c *JobScheduleClient) Get(ctx context.Context, id string) (*JobSchedule, error) {
+	return c.Query().Where(jobschedule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobScheduleClient) GetX(ctx context.Context, id string) *JobSchedule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JobScheduleClient) Hooks() []Hook {
+	return c.hooks.JobSchedule
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobScheduleClient) Interceptors() []Interceptor {
+	return c.inters.JobSchedule
+}
+
+func (c *JobScheduleClient) mutate(ctx context.Context, m *JobScheduleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown JobSchedule mutation op: %q", m.Op())
+	}
+}
+
+// JobScheduleRunClient is a client for the JobScheduleRun schema.
+type JobScheduleRunClient struct {
+	config
+}
+
+// NewJobScheduleRunClient returns a client for the JobScheduleRun from the given config.
+func NewJobScheduleRunClient(c config) *JobScheduleRunClient {
+	return &JobScheduleRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `jobschedulerun.Hooks(f(g(h())))`.
+func (c *JobScheduleRunClient) Use(hooks ...Hook) {
+	c.hooks.JobScheduleRun = append(c.hooks.JobScheduleRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `jobschedulerun.Intercept(f(g(h())))`.
+func (c *JobScheduleRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.JobScheduleRun = append(c.inters.JobScheduleRun, interceptors...)
+}
+
+// Create returns a builder for creating a JobScheduleRun entity.
+func (c *JobScheduleRunClient) Create() *JobScheduleRunCreate {
+	mutation := newJobScheduleRunMutation(c.config, OpCreate)
+	return &JobScheduleRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of JobScheduleRun entities.
+func (c *JobScheduleRunClient) CreateBulk(builders ...*JobScheduleRunCreate) *JobScheduleRunCreateBulk {
+	return &JobScheduleRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobScheduleRunClient) MapCreateBulk(slice any, setFunc func(*JobScheduleRunCreate, int)) *JobScheduleRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobScheduleRunCreateBulk{err: fmt.Errorf("calling to JobScheduleRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobScheduleRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobScheduleRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for JobScheduleRun.
+func (c *JobScheduleRunClient) Update() *JobScheduleRunUpdate {
+	mutation := newJobScheduleRunMutation(c.config, OpUpdate)
+	return &JobScheduleRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobScheduleRunClient) UpdateOne(_m *JobScheduleRun) *JobScheduleRunUpdateOne {
+	mutation := newJobScheduleRunMutation(c.config, OpUpdateOne, withJobScheduleRun(_m))
+	return &JobScheduleRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobScheduleRunClient) UpdateOneID(id string) *JobScheduleRunUpdateOne {
+	mutation := newJobScheduleRunMutation(c.config, OpUpdateOne, withJobScheduleRunID(id))
+	return &JobScheduleRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for JobScheduleRun.
+func (c *JobScheduleRunClient) Delete() *JobScheduleRunDelete {
+	mutation := newJobScheduleRunMutation(c.config, OpDelete)
+	return &JobScheduleRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobScheduleRunClient) DeleteOne(_m *JobScheduleRun) *JobScheduleRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobScheduleRunClient) DeleteOneID(id string) *JobScheduleRunDeleteOne {
+	builder := c.Delete().Where(jobschedulerun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobScheduleRunDeleteOne{builder}
+}
+
+// Query returns a query builder for JobScheduleRun.
+func (c *JobScheduleRunClient) Query() *JobScheduleRunQuery {
+	return &JobScheduleRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJobScheduleRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a JobScheduleRun entity by its id.
+func (c *JobScheduleRunClient) Get(ctx context.Context, id string) (*JobScheduleRun, error) {
+	return c.Query().Where(jobschedulerun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobScheduleRunClient) GetX(ctx context.Context, id string) *JobScheduleRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JobScheduleRunClient) Hooks() []Hook {
+	return c.hooks.JobScheduleRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobScheduleRunClient) Interceptors() []Interceptor {
+	return c.inters.JobScheduleRun
+}
+
+func (c *JobScheduleRunClient) mutate(ctx context.Context, m *JobScheduleRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobScheduleRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobScheduleRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobScheduleRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobScheduleRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown JobScheduleRun mutation op: %q", m.Op())
+	}
+}
+
+// LineItemClient is a client for the LineItem schema.
+type LineItemClient struct {
+	config
+}
+
+// NewLineItemClient returns a client for the LineItem from the given config.
+func NewLineItemClient(c config) *LineItemClient {
+	return &LineItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `lineitem.Hooks(f(g(h())))`.
+func (c *LineItemClient) Use(hooks ...Hook) {
+	c.hooks.LineItem = append(c.hooks.LineItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `lineitem.Intercept(f(g(h())))`.
+func (c *LineItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LineItem = append(c.inters.LineItem, interceptors...)
+}
+
+// Create returns a builder for creating a LineItem entity.
+func (c *LineItemClient) Create() *LineItemCreate {
+	mutation := newLineItemMutation(c.config, OpCreate)
+	return &LineItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LineItem entities.
+func (c *LineItemClient) CreateBulk(builders ...*LineItemCreate) *LineItemCreateBulk {
+	return &LineItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LineItemClient) MapCreateBulk(slice any, setFunc func(*LineItemCreate, int)) *LineItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LineItemCreateBulk{err: fmt.Errorf("calling to LineItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LineItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LineItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LineItem.
+func (c *LineItemClient) Update() *LineItemUpdate {
+	mutation := newLineItemMutation(c.config, OpUpdate)
+	return &LineItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LineItemClient) UpdateOne(_m *LineItem) *LineItemUpdateOne {
+	mutation := newLineItemMutation(c.config, OpUpdateOne, withLineItem(_m))
+	return &LineItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LineItemClient) UpdateOneID(id string) *LineItemUpdateOne {
+	mutation := newLineItemMutation(c.config, OpUpdateOne, withLineItemID(id))
+	return &LineItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LineItem.
+func (c *LineItemClient) Delete() *LineItemDelete {
+	mutation := newLineItemMutation(c.config, OpDelete)
+	return &LineItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LineItemClient) DeleteOne(_m *LineItem) *LineItemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LineItemClient) DeleteOneID(id string) *LineItemDeleteOne {
+	builder := c.Delete().Where(lineitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LineItemDeleteOne{builder}
+}
+
+// Query returns a query builder for LineItem.
+func (c *LineItemClient) Query() *LineItemQuery {
+	return &LineItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLineItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LineItem entity by its id.
+func (c *LineItemClient) Get(ctx context.Context, id string) (*LineItem, error) {
+	return c.Query().Where(lineitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LineItemClient) GetX(ctx context.Context, id string) *LineItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryReceipt queries the receipt edge of a LineItem.
+func (c *LineItemClient) QueryReceipt(_m *LineItem) *ReceiptQuery {
+	query := (&ReceiptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lineitem.Table, lineitem.FieldID, id),
+			sqlgraph.To(receipt.Table, receipt.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lineitem.ReceiptTable, lineitem.ReceiptColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LineItemClient) Hooks() []Hook {
+	return c.hooks.LineItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *LineItemClient) Interceptors() []Interceptor {
+	return c.inters.LineItem
+}
+
+func (c *LineItemClient) mutate(ctx context.Context, m *LineItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LineItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LineItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LineItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LineItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LineItem mutation op: %q", m.Op())
+	}
+}
+
+// LiquidAccountClient is a client for the LiquidAccount schema.
+type LiquidAccountClient struct {
+	config
+}
+
+// NewLiquidAccountClient returns a client for the LiquidAccount from the given config.
+func NewLiquidAccountClient(c config) *LiquidAccountClient {
+	return &LiquidAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `liquidaccount.Hooks(f(g(h())))`.
+func (c *LiquidAccountClient) Use(hooks ...Hook) {
+	c.hooks.LiquidAccount = append(c.hooks.LiquidAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `liquidaccount.Intercept(f(g(h())))`.
+func (c *LiquidAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LiquidAccount = append(c.inters.LiquidAccount, interceptors...)
+}
+
+// Create returns a builder for creating a LiquidAccount entity.
+func (c *LiquidAccountClient) Create() *LiquidAccountCreate {
+	mutation := newLiquidAccountMutation(c.config, OpCreate)
+	return &LiquidAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LiquidAccount entities.
+func (c *LiquidAccountClient) CreateBulk(builders ...*LiquidAccountCreate) *LiquidAccountCreateBulk {
+	return &LiquidAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LiquidAccountClient) MapCreateBulk(slice any, setFunc func(*LiquidAccountCreate, int)) *LiquidAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LiquidAccountCreateBulk{err: fmt.Errorf("calling to LiquidAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LiquidAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LiquidAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LiquidAccount.
+func (c *LiquidAccountClient) Update() *LiquidAccountUpdate {
+	mutation := newLiquidAccountMutation(c.config, OpUpdate)
+	return &LiquidAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LiquidAccountClient) UpdateOne(_m *LiquidAccount) *LiquidAccountUpdateOne {
+	mutation := newLiquidAccountMutation(c.config, OpUpdateOne, withLiquidAccount(_m))
+	return &LiquidAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LiquidAccountClient) UpdateOneID(id string) *LiquidAccountUpdateOne {
+	mutation := newLiquidAccountMutation(c.config, OpUpdateOne, withLiquidAccountID(id))
+	return &LiquidAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LiquidAccount.
+func (c *LiquidAccountClient) Delete() *LiquidAccountDelete {
+	mutation := newLiquidAccountMutation(c.config, OpDelete)
+	return &LiquidAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LiquidAccountClient) DeleteOne(_m *LiquidAccount) *LiquidAccountDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LiquidAccountClient) DeleteOneID(id string) *LiquidAccountDeleteOne {
+	builder := c.Delete().Where(liquidaccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LiquidAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for LiquidAccount.
+func (c *LiquidAccountClient) Query() *LiquidAccountQuery {
+	return &LiquidAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLiquidAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LiquidAccount entity by its id.
+func (c *LiquidAccountClient) Get(ctx context.Context, id string) (*LiquidAccount, error) {
+	return c.Query().Where(liquidaccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LiquidAccountClient) GetX(ctx context.Context, id string) *LiquidAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LiquidAccountClient) Hooks() []Hook {
+	return c.hooks.LiquidAccount
+}
+
+// Interceptors returns the client interceptors.
+func (c *LiquidAccountClient) Interceptors() []Interceptor {
+	return c.inters.LiquidAccount
+}
+
+func (c *LiquidAccountClient) mutate(ctx context.Context, m *LiquidAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LiquidAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LiquidAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LiquidAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LiquidAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LiquidAccount mutation op: %q", m.Op())
+	}
+}
+
+// MembershipClient is a client for the Membership schema.
+type MembershipClient struct {
+	config
+}
+
+// NewMembershipClient returns a client for the Membership from the given config.
+func NewMembershipClient(c config) *MembershipClient {
+	return &MembershipClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `membership.Hooks(f(g(h())))`.
+func (c *MembershipClient) Use(hooks ...Hook) {
+	c.hooks.Membership = append(c.hooks.Membership, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `membership.Intercept(f(g(h())))`.
+func (c *MembershipClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Membership = append(c.inters.Membership, interceptors...)
+}
+
+// Create returns a builder for creating a Membership entity.
+func (c *MembershipClient) Create() *MembershipCreate {
+	mutation := newMembershipMutation(c.config, OpCreate)
+	return &MembershipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Membership entities.
+func (c *MembershipClient) CreateBulk(builders ...*MembershipCreate) *MembershipCreateBulk {
+	return &MembershipCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MembershipClient) MapCreateBulk(slice any, setFunc func(*MembershipCreate, int)) *MembershipCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MembershipCreateBulk{err: fmt.Errorf("calling to MembershipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MembershipCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MembershipCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Membership.
+func (c *MembershipClient) Update() *MembershipUpdate {
+	mutation := newMembershipMutation(c.config, OpUpdate)
+	return &MembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MembershipClient) UpdateOne(_m *Membership) *MembershipUpdateOne {
+	mutation := newMembershipMutation(c.config, OpUpdateOne, withMembership(_m))
+	return &MembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MembershipClient) UpdateOneID(id string) *MembershipUpdateOne {
+	mutation := newMembershipMutation(c.config, OpUpdateOne, withMembershipID(id))
+	return &MembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Membership.
+func (c *MembershipClient) Delete() *MembershipDelete {
+	mutation := newMembershipMutation(c.config, OpDelete)
+	return &MembershipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MembershipClient) DeleteOne(_m *Membership) *MembershipDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MembershipClient) DeleteOneID(id string) *MembershipDeleteOne {
+	builder := c.Delete().Where(membership.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MembershipDeleteOne{builder}
+}
+
+// Query returns a query builder for Membership.
+func (c *MembershipClient) Query() *MembershipQuery {
+	return &MembershipQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMembership},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Membership entity by its id.
+func (c *MembershipClient) Get(ctx context.Context, id string) (*Membership, error) {
+	return c.Query().Where(membership.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MembershipClient) GetX(ctx context.Context, id string) *Membership {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a Membership.
+func (c *MembershipClient) QueryOrganization(_m *Membership) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(membership.Table, membership.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, membership.OrganizationTable, membership.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MembershipClient) Hooks() []Hook {
+	return c.hooks.Membership
+}
+
+// Interceptors returns the client interceptors.
+func (c *MembershipClient) Interceptors() []Interceptor {
+	return c.inters.Membership
+}
+
+func (c *MembershipClient) mutate(ctx context.Context, m *MembershipMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MembershipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MembershipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Membership mutation op: %q", m.Op())
+	}
+}
+
+// MerchantClient is a client for the Merchant schema.
+type MerchantClient struct {
+	config
+}
+
+// NewMerchantClient returns a client for the Merchant from the given config.
+func NewMerchantClient(c config) *MerchantClient {
+	return &MerchantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `merchant.Hooks(f(g(h())))`.
+func (c *MerchantClient) Use(hooks ...Hook) {
+	c.hooks.Merchant = append(c.hooks.Merchant, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `merchant.Intercept(f(g(h())))`.
+func (c *MerchantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Merchant = append(c.inters.Merchant, interceptors...)
+}
+
+// Create returns a builder for creating a Merchant entity.
+func (c *MerchantClient) Create() *MerchantCreate {
+	mutation := newMerchantMutation(c.config, OpCreate)
+	return &MerchantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Merchant entities.
+func (c *MerchantClient) CreateBulk(builders ...*MerchantCreate) *MerchantCreateBulk {
+	return &MerchantCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MerchantClient) MapCreateBulk(slice any, setFunc func(*MerchantCreate, int)) *MerchantCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MerchantCreateBulk{err: fmt.Errorf("calling to MerchantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MerchantCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MerchantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Merchant.
+func (c *MerchantClient) Update() *MerchantUpdate {
+	mutation := newMerchantMutation(c.config, OpUpdate)
+	return &MerchantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MerchantClient) UpdateOne(_m *Merchant) *MerchantUpdateOne {
+	mutation := newMerchantMutation(c.config, OpUpdateOne, withMerchant(_m))
+	return &MerchantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MerchantClient) UpdateOneID(id string) *MerchantUpdateOne {
+	mutation := newMerchantMutation(c.config, OpUpdateOne, withMerchantID(id))
+	return &MerchantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Merchant.
+func (c *MerchantClient) Delete() *MerchantDelete {
+	mutation := newMerchantMutation(c.config, OpDelete)
+	return &MerchantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MerchantClient) DeleteOne(_m *Merchant) *MerchantDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MerchantClient) DeleteOneID(id string) *MerchantDeleteOne {
+	builder := c.Delete().Where(merchant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MerchantDeleteOne{builder}
+}
+
+// Query returns a query builder for Merchant.
+func (c *MerchantClient) Query() *MerchantQuery {
+	return &MerchantQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMerchant},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Merchant entity by its id.
+func (c *MerchantClient) Get(ctx context.Context, id string) (*Merchant, error) {
+	return c.Query().Where(merchant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MerchantClient) GetX(ctx context.Context, id string) *Merchant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTransactions queries the transactions edge of a Merchant.
+func (c *MerchantClient) QueryTransactions(_m *Merchant) *TransactionQuery {
+	query := (&TransactionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(merchant.Table, merchant.FieldID, id),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, merchant.TransactionsTable, merchant.TransactionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MerchantClient) Hooks() []Hook {
+	return c.hooks.Merchant
+}
+
+// Interceptors returns the client interceptors.
+func (c *MerchantClient) Interceptors() []Interceptor {
+	return c.inters.Merchant
+}
+
+func (c *MerchantClient) mutate(ctx context.Context, m *MerchantMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MerchantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MerchantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MerchantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MerchantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Merchant mutation op: %q", m.Op())
+	}
+}
+
+// MigrationStateClient is a client for the MigrationState schema.
+type MigrationStateClient struct {
+	config
+}
+
+// NewMigrationStateClient returns a client for the MigrationState from the given config.
+func NewMigrationStateClient(c config) *MigrationStateClient {
+	return &MigrationStateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `migrationstate.Hooks(f(g(h())))`.
+func (c *MigrationStateClient) Use(hooks ...Hook) {
+	c.hooks.MigrationState = append(c.hooks.MigrationState, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `migrationstate.Intercept(f(g(h())))`.
+func (c *MigrationStateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MigrationState = append(c.inters.MigrationState, interceptors...)
+}
+
+// Create returns a builder for creating a MigrationState entity.
+func (c *MigrationStateClient) Create() *MigrationStateCreate {
+	mutation := newMigrationStateMutation(c.config, OpCreate)
+	return &MigrationStateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MigrationState entities.
+func (c *MigrationStateClient) CreateBulk(builders ...*MigrationStateCreate) *MigrationStateCreateBulk {
+	return &MigrationStateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MigrationStateClient) MapCreateBulk(slice any, setFunc func(*MigrationStateCreate, int)) *MigrationStateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MigrationStateCreateBulk{err: fmt.Errorf("calling to MigrationStateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MigrationStateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MigrationStateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MigrationState.
+func (c *MigrationStateClient) Update() *MigrationStateUpdate {
+	mutation := newMigrationStateMutation(c.config, OpUpdate)
+	return &MigrationStateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MigrationStateClient) UpdateOne(_m *MigrationState) *MigrationStateUpdateOne {
+	mutation := newMigrationStateMutation(c.config, OpUpdateOne, withMigrationState(_m))
+	return &MigrationStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MigrationStateClient) UpdateOneID(id string) *MigrationStateUpdateOne {
+	mutation := newMigrationStateMutation(c.config, OpUpdateOne, withMigrationStateID(id))
+	return &MigrationStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MigrationState.
+func (c *MigrationStateClient) Delete() *MigrationStateDelete {
+	mutation := newMigrationStateMutation(c.config, OpDelete)
+	return &MigrationStateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MigrationStateClient) DeleteOne(_m *MigrationState) *MigrationStateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MigrationStateClient) DeleteOneID(id string) *MigrationStateDeleteOne {
+	builder := c.Delete().Where(migrationstate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MigrationStateDeleteOne{builder}
+}
+
+// Query returns a query builder for MigrationState.
+func (c *MigrationStateClient) Query() *MigrationStateQuery {
+	return &MigrationStateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMigrationState},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MigrationState entity by its id.
+func (c *MigrationStateClient) Get(ctx context.Context, id string) (*MigrationState, error) {
+	return c.Query().Where(migrationstate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MigrationStateClient) GetX(ctx context.Context, id string) *MigrationState {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MigrationStateClient) Hooks() []Hook {
+	return c.hooks.MigrationState
+}
+
+// Interceptors returns the client interceptors.
+func (c *MigrationStateClient) Interceptors() []Interceptor {
+	return c.inters.MigrationState
+}
+
+func (c *MigrationStateClient) mutate(ctx context.Context, m *MigrationStateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MigrationStateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MigrationStateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MigrationStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MigrationStateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MigrationState mutation op: %q", m.Op())
+	}
+}
+
+// NotificationClient is a client for the Notification schema.
+type NotificationClient struct {
+	config
+}
+
+// NewNotificationClient returns a client for the Notification from the given config.
+func NewNotificationClient(c config) *NotificationClient {
+	return &NotificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
+func (c *NotificationClient) Use(hooks ...Hook) {
+	c.hooks.Notification = append(c.hooks.Notification, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
+func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Notification = append(c.inters.Notification, interceptors...)
+}
+
+// Create returns a builder for creating a Notification entity.
+func (c *NotificationClient) Create() *NotificationCreate {
+	mutation := newNotificationMutation(c.config, OpCreate)
+	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Notification entities.
+func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Notification.
+func (c *NotificationClient) Update() *NotificationUpdate {
+	mutation := newNotificationMutation(c.config, OpUpdate)
+	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationClient) UpdateOne(_m *Notification) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(_m))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationClient) UpdateOneID(id string) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Notification.
+func (c *NotificationClient) Delete() *NotificationDelete {
+	mutation := newNotificationMutation(c.config, OpDelete)
+	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationClient) DeleteOne(_m *Notification) *NotificationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationClient) DeleteOneID(id string) *NotificationDeleteOne {
+	builder := c.Delete().Where(notification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationDeleteOne{builder}
+}
+
+// Query returns a query builder for Notification.
+func (c *NotificationClient) Query() *NotificationQuery {
+	return &NotificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Notification entity by its id.
+func (c *NotificationClient) Get(ctx context.Context, id string) (*Notification, error) {
+	return c.Query().Where(notification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationClient) GetX(ctx context.Context, id string) *Notification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationClient) Hooks() []Hook {
+	return c.hooks.Notification
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationClient) Interceptors() []Interceptor {
+	return c.inters.Notification
+}
+
+func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Notification mutation op: %q", m.Op())
+	}
+}
+
+// OAuthStateClient is a client for the OAuthState schema.
+type OAuthStateClient struct {
+	config
+}
+
+// NewOAuthStateClient returns a client for the OAuthState from the given config.
+func NewOAuthStateClient(c config) *OAuthStateClient {
+	return &OAuthStateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `oauthstate.Hooks(f(g(h())))`.
+func (c *OAuthStateClient) Use(hooks ...Hook) {
+	c.hooks.OAuthState = append(c.hooks.OAuthState, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `oauthstate.Intercept(f(g(h())))`.
+func (c *OAuthStateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OAuthState = append(c.inters.OAuthState, interceptors...)
+}
+
+// Create returns a builder for creating a OAuthState entity.
+func (c *OAuthStateClient) Create() *OAuthStateCreate {
+	mutation := newOAuthStateMutation(c.config, OpCreate)
+	return &OAuthStateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OAuthState entities.
+func (c *OAuthStateClient) CreateBulk(builders ...*OAuthStateCreate) *OAuthStateCreateBulk {
+	return &OAuthStateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OAuthStateClient) MapCreateBulk(slice any, setFunc func(*OAuthStateCreate, int)) *OAuthStateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OAuthStateCreateBulk{err: fmt.Errorf("calling to OAuthStateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OAuthStateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OAuthStateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OAuthState.
+func (c *OAuthStateClient) Update() *OAuthStateUpdate {
+	mutation := newOAuthStateMutation(c.config, OpUpdate)
+	return &OAuthStateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OAuthStateClient) UpdateOne(_m *OAuthState) *OAuthStateUpdateOne {
+	mutation := newOAuthStateMutation(c.config, OpUpdateOne, withOAuthState(_m))
+	return &OAuthStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OAuthStateClient) UpdateOneID(id string) *OAuthStateUpdateOne {
+	mutation := newOAuthStateMutation(c.config, OpUpdateOne, withOAuthStateID(id))
+	return &OAuthStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OAuthState.
+func (c *OAuthStateClient) Delete() *OAuthStateDelete {
+	mutation := newOAuthStateMutation(c.config, OpDelete)
+	return &OAuthStateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OAuthStateClient) DeleteOne(_m *OAuthState) *OAuthStateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OAuthStateClient) DeleteOneID(id string) *OAuthStateDeleteOne {
+	builder := c.Delete().Where(oauthstate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OAuthStateDeleteOne{builder}
+}
+
+// Query returns a query builder for OAuthState.
+func (c *OAuthStateClient) Query() *OAuthStateQuery {
+	return &OAuthStateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOAuthState},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OAuthState entity by its id.
+func (c *OAuthStateClient) Get(ctx context.Context, id string) (*OAuthState, error) {
+	return c.Query().Where(oauthstate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OAuthStateClient) GetX(ctx context.Context, id string) *OAuthState {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OAuthStateClient) Hooks() []Hook {
+	return c.hooks.OAuthState
+}
+
+// Interceptors returns the client interceptors.
+func (c *OAuthStateClient) Interceptors() []Interceptor {
+	return c.inters.OAuthState
+}
+
+func (c *OAuthStateClient) mutate(ctx context.Context, m *OAuthStateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OAuthStateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OAuthStateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OAuthStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OAuthStateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OAuthState mutation op: %q", m.Op())
+	}
+}
+
+// OCRFeedbackClient is a client for the OCRFeedback schema.
+type OCRFeedbackClient struct {
+	config
+}
+
+// NewOCRFeedbackClient returns a client for the OCRFeedback from the given config.
+func NewOCRFeedbackClient(c config) *OCRFeedbackClient {
+	return &OCRFeedbackClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ocrfeedback.Hooks(f(g(h())))`.
+func (c *OCRFeedbackClient) Use(hooks ...Hook) {
+	c.hooks.OCRFeedback = append(c.hooks.OCRFeedback, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ocrfeedback.Intercept(f(g(h())))`.
+func (c *OCRFeedbackClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OCRFeedback = append(c.inters.OCRFeedback, interceptors...)
+}
+
+// Create returns a builder for creating a OCRFeedback entity.
+func (c *OCRFeedbackClient) Create() *OCRFeedbackCreate {
+	mutation := newOCRFeedbackMutation(c.config, OpCreate)
+	return &OCRFeedbackCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OCRFeedback entities.
+func (c *OCRFeedbackClient) CreateBulk(builders ...*OCRFeedbackCreate) *OCRFeedbackCreateBulk {
+	return &OCRFeedbackCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OCRFeedbackClient) MapCreateBulk(slice any, setFunc func(*OCRFeedbackCreate, int)) *OCRFeedbackCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OCRFeedbackCreateBulk{err: fmt.Errorf("calling to OCRFeedbackClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OCRFeedbackCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OCRFeedbackCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OCRFeedback.
+func (c *OCRFeedbackClient) Update() *OCRFeedbackUpdate {
+	mutation := newOCRFeedbackMutation(c.config, OpUpdate)
+	return &OCRFeedbackUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OCRFeedbackClient) UpdateOne(_m *OCRFeedback) *OCRFeedbackUpdateOne {
+	mutation := newOCRFeedbackMutation(c.config, OpUpdateOne, withOCRFeedback(_m))
+	return &OCRFeedbackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OCRFeedbackClient) UpdateOneID(id string) *OCRFeedbackUpdateOne {
+	mutation := newOCRFeedbackMutation(c.config, OpUpdateOne, withOCRFeedbackID(id))
+	return &OCRFeedbackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OCRFeedback.
+func (c *OCRFeedbackClient) Delete() *OCRFeedbackDelete {
+	mutation := newOCRFeedbackMutation(c.config, OpDelete)
+	return &OCRFeedbackDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OCRFeedbackClient) DeleteOne(_m *OCRFeedback) *OCRFeedbackDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OCRFeedbackClient) DeleteOneID(id string) *OCRFeedbackDeleteOne {
+	builder := c.Delete().Where(ocrfeedback.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OCRFeedbackDeleteOne{builder}
+}
+
+// Query returns a query builder for OCRFeedback.
+func (c *OCRFeedbackClient) Query() *OCRFeedbackQuery {
+	return &OCRFeedbackQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOCRFeedback},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OCRFeedback entity by its id.
+func (c *OCRFeedbackClient) Get(ctx context.Context, id string) (*OCRFeedback, error) {
+	return c.Query().Where(ocrfeedback.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OCRFeedbackClient) GetX(ctx context.Context, id string) *OCRFeedback {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OCRFeedbackClient) Hooks() []Hook {
+	return c.hooks.OCRFeedback
+}
+
+// Interceptors returns the client interceptors.
+func (c *OCRFeedbackClient) Interceptors() []Interceptor {
+	return c.inters.OCRFeedback
+}
+
+func (c *OCRFeedbackClient) mutate(ctx context.Context, m *OCRFeedbackMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OCRFeedbackCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OCRFeedbackUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OCRFeedbackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OCRFeedbackDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OCRFeedback mutation op: %q", m.Op())
+	}
+}
+
+// OrganizationClient is a client for the Organization schema.
+type OrganizationClient struct {
+	config
+}
+
+// NewOrganizationClient returns a client for the Organization from the given config.
+func NewOrganizationClient(c config) *OrganizationClient {
+	return &OrganizationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `organization.Hooks(f(g(h())))`.
+func (c *OrganizationClient) Use(hooks ...Hook) {
+	c.hooks.Organization = append(c.hooks.Organization, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `organization.Intercept(f(g(h())))`.
+func (c *OrganizationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Organization = append(c.inters.Organization, interceptors...)
+}
+
+// Create returns a builder for creating a Organization entity.
+func (c *OrganizationClient) Create() *OrganizationCreate {
+	mutation := newOrganizationMutation(c.config, OpCreate)
+	return &OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Organization entities.
+func (c *OrganizationClient) CreateBulk(builders ...*OrganizationCreate) *OrganizationCreateBulk {
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrganizationClient) MapCreateBulk(slice any, setFunc func(*OrganizationCreate, int)) *OrganizationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrganizationCreateBulk{err: fmt.Errorf("calling to OrganizationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrganizationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Organization.
+func (c *OrganizationClient) Update() *OrganizationUpdate {
+	mutation := newOrganizationMutation(c.config, OpUpdate)
+	return &OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrganizationClient) UpdateOne(_m *Organization) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganization(_m))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrganizationClient) UpdateOneID(id string) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganizationID(id))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Organization.
+func (c *OrganizationClient) Delete() *OrganizationDelete {
+	mutation := newOrganizationMutation(c.config, OpDelete)
+	return &OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrganizationClient) DeleteOne(_m *Organization) *OrganizationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrganizationClient) DeleteOneID(id string) *OrganizationDeleteOne {
+	builder := c.Delete().Where(organization.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrganizationDeleteOne{builder}
+}
+
+// Query returns a query builder for Organization.
+func (c *OrganizationClient) Query() *OrganizationQuery {
+	return &OrganizationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrganization},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Organization entity by its id.
+func (c *OrganizationClient) Get(ctx context.Context, id string) (*Organization, error) {
+	return c.Query().Where(organization.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrganizationClient) GetX(ctx context.Context, id string) *Organization {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMemberships queries the memberships edge of a Organization.
+func (c *OrganizationClient) QueryMemberships(_m *Organization) *MembershipQuery {
+	query := (&MembershipClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(membership.Table, membership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.MembershipsTable, organization.MembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrganizationClient) Hooks() []Hook {
+	return c.hooks.Organization
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrganizationClient) Interceptors() []Interceptor {
+	return c.inters.Organization
+}
+
+func (c *OrganizationClient) mutate(ctx context.Context, m *OrganizationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Organization mutation op: %q", m.Op())
+	}
+}
+
+// PipelineConfigClient is a client for the PipelineConfig schema.
+type PipelineConfigClient struct {
+	config
+}
+
+// NewPipelineConfigClient returns a client for the PipelineConfig from the given config.
+func NewPipelineConfigClient(c config) *PipelineConfigClient {
+	return &PipelineConfigClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pipelineconfig.Hooks(f(g(h())))`.
+func (c *PipelineConfigClient) Use(hooks ...Hook) {
+	c.hooks.PipelineConfig = append(c.hooks.PipelineConfig, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pipelineconfig.Intercept(f(g(h())))`.
+func (c *PipelineConfigClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PipelineConfig = append(c.inters.PipelineConfig, interceptors...)
+}
+
+// Create returns a builder for creating a PipelineConfig entity.
+func (c *PipelineConfigClient) Create() *PipelineConfigCreate {
+	mutation := newPipelineConfigMutation(c.config, OpCreate)
+	return &PipelineConfigCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PipelineConfig entities.
+func (c *PipelineConfigClient) CreateBulk(builders ...*PipelineConfigCreate) *PipelineConfigCreateBulk {
+	return &PipelineConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PipelineConfigClient) MapCreateBulk(slice any, setFunc func(*PipelineConfigCreate, int)) *PipelineConfigCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PipelineConfigCreateBulk{err: fmt.Errorf("calling to PipelineConfigClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PipelineConfigCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PipelineConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PipelineConfig.
+func (c *PipelineConfigClient) Update() *PipelineConfigUpdate {
+	mutation := newPipelineConfigMutation(c.config, OpUpdate)
+	return &PipelineConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PipelineConfigClient) UpdateOne(_m *PipelineConfig) *PipelineConfigUpdateOne {
+	mutation := newPipelineConfigMutation(c.config, OpUpdateOne, withPipelineConfig(_m))
+	return &PipelineConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PipelineConfigClient) UpdateOneID(id string) *PipelineConfigUpdateOne {
+	mutation := newPipelineConfigMutation(c.config, OpUpdateOne, withPipelineConfigID(id))
+	return &PipelineConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PipelineConfig.
+func (c *PipelineConfigClient) Delete() *PipelineConfigDelete {
+	mutation := newPipelineConfigMutation(c.config, OpDelete)
+	return &PipelineConfigDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PipelineConfigClient) DeleteOne(_m *PipelineConfig) *PipelineConfigDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PipelineConfigClient) DeleteOneID(id string) *PipelineConfigDeleteOne {
+	builder := c.Delete().Where(pipelineconfig.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PipelineConfigDeleteOne{builder}
+}
+
+// Query returns a query builder for PipelineConfig.
+func (c *PipelineConfigClient) Query() *PipelineConfigQuery {
+	return &PipelineConfigQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePipelineConfig},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PipelineConfig entity by its id.
+func (c *PipelineConfigClient) Get(ctx context.Context, id string) (*PipelineConfig, error) {
+	return c.Query().Where(pipelineconfig.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PipelineConfigClient) GetX(ctx context.Context, id string) *PipelineConfig {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRules queries the rules edge of a PipelineConfig.
+func (c *PipelineConfigClient) QueryRules(_m *PipelineConfig) *PipelineRuleQuery {
+	query := (&PipelineRuleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pipelineconfig.Table, pipelineconfig.FieldID, id),
+			sqlgraph.To(pipelinerule.Table, pipelinerule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, pipelineconfig.RulesTable, pipelineconfig.RulesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVersions queries the versions edge of a PipelineConfig.
+func (c *PipelineConfigClient) QueryVersions(_m *PipelineConfig) *PipelineVersionQuery {
+	query := (&PipelineVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pipelineconfig.Table, pipelineconfig.FieldID, id),
+			sqlgraph.To(pipelineversion.Table, pipelineversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, pipelineconfig.VersionsTable, pipelineconfig.VersionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PipelineConfigClient) Hooks() []Hook {
+	return c.hooks.PipelineConfig
+}
+
+// Interceptors returns the client interceptors.
+func (c *PipelineConfigClient) Interceptors() []Interceptor {
+	return c.inters.PipelineConfig
+}
+
+func (c *PipelineConfigClient) mutate(ctx context.Context, m *PipelineConfigMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PipelineConfigCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PipelineConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PipelineConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PipelineConfigDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PipelineConfig mutation op: %q", m.Op())
+	}
+}
+
+// PipelineRuleClient is a client for the PipelineRule schema.
+type PipelineRuleClient struct {
+	config
+}
+
+// NewPipelineRuleClient returns a client for the PipelineRule from the given config.
+func NewPipelineRuleClient(c config) *PipelineRuleClient {
+	return &PipelineRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pipelinerule.Hooks(f(g(h())))`.
+func (c *PipelineRuleClient) Use(hooks ...Hook) {
+	c.hooks.PipelineRule = append(c.hooks.PipelineRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pipelinerule.Intercept(f(g(h())))`.
+func (c *PipelineRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PipelineRule = append(c.inters.PipelineRule, interceptors...)
+}
+
+// Create returns a builder for creating a PipelineRule entity.
+func (c *PipelineRuleClient) Create() *PipelineRuleCreate {
+	mutation := newPipelineRuleMutation(c.config, OpCreate)
+	return &PipelineRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PipelineRule entities.
+func (c *PipelineRuleClient) CreateBulk(builders ...*PipelineRuleCreate) *PipelineRuleCreateBulk {
+	return &PipelineRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PipelineRuleClient) MapCreateBulk(slice any, setFunc func(*PipelineRuleCreate, int)) *PipelineRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PipelineRuleCreateBulk{err: fmt.Errorf("calling to PipelineRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PipelineRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PipelineRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PipelineRule.
+func (c *PipelineRuleClient) Update() *PipelineRuleUpdate {
+	mutation := newPipelineRuleMutation(c.config, OpUpdate)
+	return &PipelineRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PipelineRuleClient) UpdateOne(_m *PipelineRule) *PipelineRuleUpdateOne {
+	mutation := newPipelineRuleMutation(c.config, OpUpdateOne, withPipelineRule(_m))
+	return &PipelineRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PipelineRuleClient) UpdateOneID(id string) *PipelineRuleUpdateOne {
+	mutation := newPipelineRuleMutation(c.config, OpUpdateOne, withPipelineRuleID(id))
+	return &PipelineRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PipelineRule.
+func (c *PipelineRuleClient) Delete() *PipelineRuleDelete {
+	mutation := newPipelineRuleMutation(c.config, OpDelete)
+	return &PipelineRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PipelineRuleClient) DeleteOne(_m *PipelineRule) *PipelineRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PipelineRuleClient) DeleteOneID(id string) *PipelineRuleDeleteOne {
+	builder := c.Delete().Where(pipelinerule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PipelineRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for PipelineRule.
+func (c *PipelineRuleClient) Query() *PipelineRuleQuery {
+	return &PipelineRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePipelineRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PipelineRule entity by its id.
+func (c *PipelineRuleClient) Get(ctx context.Context, id string) (*PipelineRule, error) {
+	return c.Query().Where(pipelinerule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PipelineRuleClient) GetX(ctx context.Context, id string) *PipelineRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryConfig queries the config edge of a PipelineRule.
+func (c *PipelineRuleClient) QueryConfig(_m *PipelineRule) *PipelineConfigQuery {
+	query := (&PipelineConfigClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pipelinerule.Table, pipelinerule.FieldID, id),
+			sqlgraph.To(pipelineconfig.Table, pipelineconfig.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pipelinerule.ConfigTable, pipelinerule.ConfigColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PipelineRuleClient) Hooks() []Hook {
+	return c.hooks.PipelineRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *PipelineRuleClient) Interceptors() []Interceptor {
+	return c.inters.PipelineRule
+}
+
+func (c *PipelineRuleClient) mutate(ctx context.Context, m *PipelineRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PipelineRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PipelineRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PipelineRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PipelineRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PipelineRule mutation op: %q", m.Op())
+	}
+}
+
+// PipelineVersionClient is a client for the PipelineVersion schema.
+type PipelineVersionClient struct {
+	config
+}
+
+// NewPipelineVersionClient returns a client for the PipelineVersion from the given config.
+func NewPipelineVersionClient(c config) *PipelineVersionClient {
+	return &PipelineVersionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pipelineversion.Hooks(f(g(h())))`.
+func (c *PipelineVersionClient) Use(hooks ...Hook) {
+	c.hooks.PipelineVersion = append(c.hooks.PipelineVersion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pipelineversion.Intercept(f(g(h())))`.
+func (c *PipelineVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PipelineVersion = append(c.inters.PipelineVersion, interceptors...)
+}
+
+// Create returns a builder for creating a PipelineVersion entity.
+func (c *PipelineVersionClient) Create() *PipelineVersionCreate {
+	mutation := newPipelineVersionMutation(c.config, OpCreate)
+	return &PipelineVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PipelineVersion entities.
+func (c *PipelineVersionClient) CreateBulk(builders ...*PipelineVersionCreate) *PipelineVersionCreateBulk {
+	return &PipelineVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PipelineVersionClient) MapCreateBulk(slice any, setFunc func(*PipelineVersionCreate, int)) *PipelineVersionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PipelineVersionCreateBulk{err: fmt.Errorf("calling to PipelineVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PipelineVersionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PipelineVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PipelineVersion.
+func (c *PipelineVersionClient) Update() *PipelineVersionUpdate {
+	mutation := newPipelineVersionMutation(c.config, OpUpdate)
+	return &PipelineVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PipelineVersionClient) UpdateOne(_m *PipelineVersion) *PipelineVersionUpdateOne {
+	mutation := newPipelineVersionMutation(c.config, OpUpdateOne, withPipelineVersion(_m))
+	return &PipelineVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PipelineVersionClient) UpdateOneID(id string) *PipelineVersionUpdateOne {
+	mutation := newPipelineVersionMutation(c.config, OpUpdateOne, withPipelineVersionID(id))
+	return &PipelineVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PipelineVersion.
+func (c *PipelineVersionClient) Delete() *PipelineVersionDelete {
+	mutation := newPipelineVersionMutation(c.config, OpDelete)
+	return &PipelineVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PipelineVersionClient) DeleteOne(_m *PipelineVersion) *PipelineVersionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PipelineVersionClient) DeleteOneID(id string) *PipelineVersionDeleteOne {
+	builder := c.Delete().Where(pipelineversion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PipelineVersionDeleteOne{builder}
+}
+
+// Query returns a query builder for PipelineVersion.
+func (c *PipelineVersionClient) Query() *PipelineVersionQuery {
+	return &PipelineVersionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePipelineVersion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PipelineVersion entity by its id.
+func (c *PipelineVersionClient) Get(ctx context.Context, id string) (*PipelineVersion, error) {
+	return c.Query().Where(pipelineversion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PipelineVersionClient) GetX(ctx context.Context, id string) *PipelineVersion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryConfig queries the config edge of a PipelineVersion.
+func (c *PipelineVersionClient) QueryConfig(_m *PipelineVersion) *PipelineConfigQuery {
+	query := (&PipelineConfigClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pipelineversion.Table, pipelineversion.FieldID, id),
+			sqlgraph.To(pipelineconfig.Table, pipelineconfig.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pipelineversion.ConfigTable, pipelineversion.ConfigColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PipelineVersionClient) Hooks() []Hook {
+	return c.hooks.PipelineVersion
+}
+
+// Interceptors returns the client interceptors.
+func (c *PipelineVersionClient) Interceptors() []Interceptor {
+	return c.inters.PipelineVersion
+}
+
+func (c *PipelineVersionClient) mutate(ctx context.Context, m *PipelineVersionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PipelineVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PipelineVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PipelineVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PipelineVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PipelineVersion mutation op: %q", m.Op())
+	}
+}
+
+// QueuedJobClient is a client for the QueuedJob schema.
+type QueuedJobClient struct {
+	config
+}
+
+// NewQueuedJobClient returns a client for the QueuedJob from the given config.
+func NewQueuedJobClient(c config) *QueuedJobClient {
+	return &QueuedJobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `queuedjob.Hooks(f(g(h())))`.
+func (c *QueuedJobClient) Use(hooks ...Hook) {
+	c.hooks.QueuedJob = append(c.hooks.QueuedJob, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `queuedjob.Intercept(f(g(h())))`.
+func (c *QueuedJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QueuedJob = append(c.inters.QueuedJob, interceptors...)
+}
+
+// Create returns a builder for creating a QueuedJob entity.
+func (c *QueuedJobClient) Create() *QueuedJobCreate {
+	mutation := newQueuedJobMutation(c.config, OpCreate)
+	return &QueuedJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of QueuedJob entities.
+func (c *QueuedJobClient) CreateBulk(builders ...*QueuedJobCreate) *QueuedJobCreateBulk {
+	return &QueuedJobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QueuedJobClient) MapCreateBulk(slice any, setFunc func(*QueuedJobCreate, int)) *QueuedJobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QueuedJobCreateBulk{err: fmt.Errorf("calling to QueuedJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QueuedJobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QueuedJobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for QueuedJob.
+func (c *QueuedJobClient) Update() *QueuedJobUpdate {
+	mutation := newQueuedJobMutation(c.config, OpUpdate)
+	return &QueuedJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QueuedJobClient) UpdateOne(_m *QueuedJob) *QueuedJobUpdateOne {
+	mutation := newQueuedJobMutation(c.config, OpUpdateOne, withQueuedJob(_m))
+	return &QueuedJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QueuedJobClient) UpdateOneID(id string) *QueuedJobUpdateOne {
+	mutation := newQueuedJobMutation(c.config, OpUpdateOne, withQueuedJobID(id))
+	return &QueuedJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for QueuedJob.
+func (c *QueuedJobClient) Delete() *QueuedJobDelete {
+	mutation := newQueuedJobMutation(c.config, OpDelete)
+	return &QueuedJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QueuedJobClient) DeleteOne(_m *QueuedJob) *QueuedJobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QueuedJobClient) DeleteOneID(id string) *QueuedJobDeleteOne {
+	builder := c.Delete().Where(queuedjob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QueuedJobDeleteOne{builder}
+}
+
+// Query returns a query builder for QueuedJob.
+func (c *QueuedJobClient) Query() *QueuedJobQuery {
+	return &QueuedJobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQueuedJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a QueuedJob entity by its id.
+func (c *QueuedJobClient) Get(ctx context.Context, id string) (*QueuedJob, error) {
+	return c.Query().Where(queuedjob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QueuedJobClient) GetX(ctx context.Context, id string) *QueuedJob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *QueuedJobClient) Hooks() []Hook {
+	return c.hooks.QueuedJob
+}
+
+// Interceptors returns the client interceptors.
+func (c *QueuedJobClient) Interceptors() []Interceptor {
+	return c.inters.QueuedJob
+}
+
+func (c *QueuedJobClient) mutate(ctx context.Context, m *QueuedJobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QueuedJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QueuedJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QueuedJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QueuedJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown QueuedJob mutation op: %q", m.Op())
+	}
+}
+
+// ReceiptClient is a client for the Receipt schema.
+type ReceiptClient struct {
+	config
+}
+
+// NewReceiptClient returns a client for the Receipt from the given config.
+func NewReceiptClient(c config) *ReceiptClient {
+	return &ReceiptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `receipt.Hooks(f(g(h())))`.
+func (c *ReceiptClient) Use(hooks ...Hook) {
+	c.hooks.Receipt = append(c.hooks.Receipt, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `receipt.Intercept(f(g(h())))`.
+func (c *ReceiptClient) Intercept(interceptors ...Interceptor) {
 	c.inters.Receipt = append(c.inters.Receipt, interceptors...)
 }
 
-// Create returns a builder for creating a Receipt entity.
-func (c *ReceiptClient) Create() *ReceiptCreate {
-	mutation := newReceiptMutation(c.config, OpCreate)
-	return &ReceiptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Receipt entity.
+func (c *ReceiptClient) Create() *ReceiptCreate {
+	mutation := newReceiptMutation(c.config, OpCreate)
+	return &ReceiptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Receipt entities.
+func (c *ReceiptClient) CreateBulk(builders ...*ReceiptCreate) *ReceiptCreateBulk {
+	return &ReceiptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReceiptClient) MapCreateBulk(slice any, setFunc func(*ReceiptCreate, int)) *ReceiptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReceiptCreateBulk{err: fmt.Errorf("calling to ReceiptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReceiptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReceiptCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Receipt.
+func (c *ReceiptClient) Update() *ReceiptUpdate {
+	mutation := newReceiptMutation(c.config, OpUpdate)
+	return &ReceiptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReceiptClient) UpdateOne(_m *Receipt) *ReceiptUpdateOne {
+	mutation := newReceiptMutation(c.config, OpUpdateOne, withReceipt(_m))
+	return &ReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReceiptClient) UpdateOneID(id string) *ReceiptUpdateOne {
+	mutation := newReceiptMutation(c.config, OpUpdateOne, withReceiptID(id))
+	return &ReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Receipt.
+func (c *ReceiptClient) Delete() *ReceiptDelete {
+	mutation := newReceiptMutation(c.config, OpDelete)
+	return &ReceiptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReceiptClient) DeleteOne(_m *Receipt) *ReceiptDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReceiptClient) DeleteOneID(id string) *ReceiptDeleteOne {
+	builder := c.Delete().Where(receipt.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReceiptDeleteOne{builder}
+}
+
+// Query returns a query builder for Receipt.
+func (c *ReceiptClient) Query() *ReceiptQuery {
+	return &ReceiptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReceipt},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Receipt entity by its id.
+func (c *ReceiptClient) Get(ctx context.Context, id string) (*Receipt, error) {
+	return c.Query().Where(receipt.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReceiptClient) GetX(ctx context.Context, id string) *Receipt {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTransactions queries the transactions edge of a Receipt.
+func (c *ReceiptClient) QueryTransactions(_m *Receipt) *TransactionQuery {
+	query := (&TransactionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(receipt.Table, receipt.FieldID, id),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, receipt.TransactionsTable, receipt.TransactionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLineItems queries the line_items edge of a Receipt.
+func (c *ReceiptClient) QueryLineItems(_m *Receipt) *LineItemQuery {
+	query := (&LineItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(receipt.Table, receipt.FieldID, id),
+			sqlgraph.To(lineitem.Table, lineitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, receipt.LineItemsTable, receipt.LineItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReceiptClient) Hooks() []Hook {
+	return c.hooks.Receipt
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReceiptClient) Interceptors() []Interceptor {
+	return c.inters.Receipt
+}
+
+func (c *ReceiptClient) mutate(ctx context.Context, m *ReceiptMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReceiptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReceiptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReceiptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Receipt mutation op: %q", m.Op())
+	}
+}
+
+// ReceiptEventClient is a client for the ReceiptEvent schema.
+type ReceiptEventClient struct {
+	config
+}
+
+// NewReceiptEventClient returns a client for the ReceiptEvent from the given config.
+func NewReceiptEventClient(c config) *ReceiptEventClient {
+	return &ReceiptEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `receiptevent.Hooks(f(g(h())))`.
+func (c *ReceiptEventClient) Use(hooks ...Hook) {
+	c.hooks.ReceiptEvent = append(c.hooks.ReceiptEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `receiptevent.Intercept(f(g(h())))`.
+func (c *ReceiptEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReceiptEvent = append(c.inters.ReceiptEvent, interceptors...)
+}
+
+// Create returns a builder for creating a ReceiptEvent entity.
+func (c *ReceiptEventClient) Create() *ReceiptEventCreate {
+	mutation := newReceiptEventMutation(c.config, OpCreate)
+	return &ReceiptEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReceiptEvent entities.
+func (c *ReceiptEventClient) CreateBulk(builders ...*ReceiptEventCreate) *ReceiptEventCreateBulk {
+	return &ReceiptEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReceiptEventClient) MapCreateBulk(slice any, setFunc func(*ReceiptEventCreate, int)) *ReceiptEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReceiptEventCreateBulk{err: fmt.Errorf("calling to ReceiptEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReceiptEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReceiptEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReceiptEvent.
+func (c *ReceiptEventClient) Update() *ReceiptEventUpdate {
+	mutation := newReceiptEventMutation(c.config, OpUpdate)
+	return &ReceiptEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReceiptEventClient) UpdateOne(_m *ReceiptEvent) *ReceiptEventUpdateOne {
+	mutation := newReceiptEventMutation(c.config, OpUpdateOne, withReceiptEvent(_m))
+	return &ReceiptEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReceiptEventClient) UpdateOneID(id string) *ReceiptEventUpdateOne {
+	mutation := newReceiptEventMutation(c.config, OpUpdateOne, withReceiptEventID(id))
+	return &ReceiptEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReceiptEvent.
+func (c *ReceiptEventClient) Delete() *ReceiptEventDelete {
+	mutation := newReceiptEventMutation(c.config, OpDelete)
+	return &ReceiptEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReceiptEventClient) DeleteOne(_m *ReceiptEvent) *ReceiptEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReceiptEventClient) DeleteOneID(id string) *ReceiptEventDeleteOne {
+	builder := c.Delete().Where(receiptevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReceiptEventDeleteOne{builder}
+}
+
+// Query returns a query builder for ReceiptEvent.
+func (c *ReceiptEventClient) Query() *ReceiptEventQuery {
+	return &ReceiptEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReceiptEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReceiptEvent entity by its id.
+func (c *ReceiptEventClient) Get(ctx context.Context, id string) (*ReceiptEvent, error) {
+	return c.Query().Where(receiptevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReceiptEventClient) GetX(ctx context.Context, id string) *ReceiptEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ReceiptEventClient) Hooks() []Hook {
+	return c.hooks.ReceiptEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReceiptEventClient) Interceptors() []Interceptor {
+	return c.inters.ReceiptEvent
+}
+
+func (c *ReceiptEventClient) mutate(ctx context.Context, m *ReceiptEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReceiptEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReceiptEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReceiptEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReceiptEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReceiptEvent mutation op: %q", m.Op())
+	}
+}
+
+// RoundingRuleClient is a client for the RoundingRule schema.
+type RoundingRuleClient struct {
+	config
+}
+
+// NewRoundingRuleClient returns a client for the RoundingRule from the given config.
+func NewRoundingRuleClient(c config) *RoundingRuleClient {
+	return &RoundingRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `roundingrule.Hooks(f(g(h())))`.
+func (c *RoundingRuleClient) Use(hooks ...Hook) {
+	c.hooks.RoundingRule = append(c.hooks.RoundingRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `roundingrule.Intercept(f(g(h())))`.
+func (c *RoundingRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoundingRule = append(c.inters.RoundingRule, interceptors...)
+}
+
+// Create returns a builder for creating a RoundingRule entity.
+func (c *RoundingRuleClient) Create() *RoundingRuleCreate {
+	mutation := newRoundingRuleMutation(c.config, OpCreate)
+	return &RoundingRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoundingRule entities.
+func (c *RoundingRuleClient) CreateBulk(builders ...*RoundingRuleCreate) *RoundingRuleCreateBulk {
+	return &RoundingRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoundingRuleClient) MapCreateBulk(slice any, setFunc func(*RoundingRuleCreate, int)) *RoundingRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoundingRuleCreateBulk{err: fmt.Errorf("calling to RoundingRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoundingRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoundingRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoundingRule.
+func (c *RoundingRuleClient) Update() *RoundingRuleUpdate {
+	mutation := newRoundingRuleMutation(c.config, OpUpdate)
+	return &RoundingRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoundingRuleClient) UpdateOne(_m *RoundingRule) *RoundingRuleUpdateOne {
+	mutation := newRoundingRuleMutation(c.config, OpUpdateOne, withRoundingRule(_m))
+	return &RoundingRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoundingRuleClient) UpdateOneID(id string) *RoundingRuleUpdateOne {
+	mutation := newRoundingRuleMutation(c.config, OpUpdateOne, withRoundingRuleID(id))
+	return &RoundingRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoundingRule.
+func (c *RoundingRuleClient) Delete() *RoundingRuleDelete {
+	mutation := newRoundingRuleMutation(c.config, OpDelete)
+	return &RoundingRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoundingRuleClient) DeleteOne(_m *RoundingRule) *RoundingRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoundingRuleClient) DeleteOneID(id string) *RoundingRuleDeleteOne {
+	builder := c.Delete().Where(roundingrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoundingRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for RoundingRule.
+func (c *RoundingRuleClient) Query() *RoundingRuleQuery {
+	return &RoundingRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoundingRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoundingRule entity by its id.
+func (c *RoundingRuleClient) Get(ctx context.Context, id string) (*RoundingRule, error) {
+	return c.Query().Where(roundingrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoundingRuleClient) GetX(ctx context.Context, id string) *RoundingRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RoundingRuleClient) Hooks() []Hook {
+	return c.hooks.RoundingRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoundingRuleClient) Interceptors() []Interceptor {
+	return c.inters.RoundingRule
+}
+
+func (c *RoundingRuleClient) mutate(ctx context.Context, m *RoundingRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoundingRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoundingRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoundingRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoundingRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RoundingRule mutation op: %q", m.Op())
+	}
+}
+
+// SavedFilterClient is a client for the SavedFilter schema.
+type SavedFilterClient struct {
+	config
+}
+
+// NewSavedFilterClient returns a client for the SavedFilter from the given config.
+func NewSavedFilterClient(c config) *SavedFilterClient {
+	return &SavedFilterClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `savedfilter.Hooks(f(g(h())))`.
+func (c *SavedFilterClient) Use(hooks ...Hook) {
+	c.hooks.SavedFilter = append(c.hooks.SavedFilter, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `savedfilter.Intercept(f(g(h())))`.
+func (c *SavedFilterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SavedFilter = append(c.inters.SavedFilter, interceptors...)
+}
+
+// Create returns a builder for creating a SavedFilter entity.
+func (c *SavedFilterClient) Create() *SavedFilterCreate {
+	mutation := newSavedFilterMutation(c.config, OpCreate)
+	return &SavedFilterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SavedFilter entities.
+func (c *SavedFilterClient) CreateBulk(builders ...*SavedFilterCreate) *SavedFilterCreateBulk {
+	return &SavedFilterCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SavedFilterClient) MapCreateBulk(slice any, setFunc func(*SavedFilterCreate, int)) *SavedFilterCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SavedFilterCreateBulk{err: fmt.Errorf("calling to SavedFilterClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SavedFilterCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SavedFilterCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SavedFilter.
+func (c *SavedFilterClient) Update() *SavedFilterUpdate {
+	mutation := newSavedFilterMutation(c.config, OpUpdate)
+	return &SavedFilterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SavedFilterClient) UpdateOne(_m *SavedFilter) *SavedFilterUpdateOne {
+	mutation := newSavedFilterMutation(c.config, OpUpdateOne, withSavedFilter(_m))
+	return &SavedFilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SavedFilterClient) UpdateOneID(id string) *SavedFilterUpdateOne {
+	mutation := newSavedFilterMutation(c.config, OpUpdateOne, withSavedFilterID(id))
+	return &SavedFilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SavedFilter.
+func (c *SavedFilterClient) Delete() *SavedFilterDelete {
+	mutation := newSavedFilterMutation(c.config, OpDelete)
+	return &SavedFilterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SavedFilterClient) DeleteOne(_m *SavedFilter) *SavedFilterDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SavedFilterClient) DeleteOneID(id string) *SavedFilterDeleteOne {
+	builder := c.Delete().Where(savedfilter.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SavedFilterDeleteOne{builder}
+}
+
+// Query returns a query builder for SavedFilter.
+func (c *SavedFilterClient) Query() *SavedFilterQuery {
+	return &SavedFilterQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSavedFilter},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SavedFilter entity by its id.
+func (c *SavedFilterClient) Get(ctx context.Context, id string) (*SavedFilter, error) {
+	return c.Query().Where(savedfilter.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SavedFilterClient) GetX(ctx context.Context, id string) *SavedFilter {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SavedFilterClient) Hooks() []Hook {
+	return c.hooks.SavedFilter
+}
+
+// Interceptors returns the client interceptors.
+func (c *SavedFilterClient) Interceptors() []Interceptor {
+	return c.inters.SavedFilter
+}
+
+func (c *SavedFilterClient) mutate(ctx context.Context, m *SavedFilterMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SavedFilterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SavedFilterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SavedFilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SavedFilterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SavedFilter mutation op: %q", m.Op())
+	}
+}
+
+// TaxYearClient is a client for the TaxYear schema.
+type TaxYearClient struct {
+	config
+}
+
+// NewTaxYearClient returns a client for the TaxYear from the given config.
+func NewTaxYearClient(c config) *TaxYearClient {
+	return &TaxYearClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taxyear.Hooks(f(g(h())))`.
+func (c *TaxYearClient) Use(hooks ...Hook) {
+	c.hooks.TaxYear = append(c.hooks.TaxYear, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taxyear.Intercept(f(g(h())))`.
+func (c *TaxYearClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaxYear = append(c.inters.TaxYear, interceptors...)
+}
+
+// Create returns a builder for creating a TaxYear entity.
+func (c *TaxYearClient) Create() *TaxYearCreate {
+	mutation := newTaxYearMutation(c.config, OpCreate)
+	return &TaxYearCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaxYear entities.
+func (c *TaxYearClient) CreateBulk(builders ...*TaxYearCreate) *TaxYearCreateBulk {
+	return &TaxYearCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaxYearClient) MapCreateBulk(slice any, setFunc func(*TaxYearCreate, int)) *TaxYearCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaxYearCreateBulk{err: fmt.Errorf("calling to TaxYearClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaxYearCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaxYearCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaxYear.
+func (c *TaxYearClient) Update() *TaxYearUpdate {
+	mutation := newTaxYearMutation(c.config, OpUpdate)
+	return &TaxYearUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaxYearClient) UpdateOne(_m *TaxYear) *TaxYearUpdateOne {
+	mutation := newTaxYearMutation(c.config, OpUpdateOne, withTaxYear(_m))
+	return &TaxYearUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaxYearClient) UpdateOneID(id int) *TaxYearUpdateOne {
+	mutation := newTaxYearMutation(c.config, OpUpdateOne, withTaxYearID(id))
+	return &TaxYearUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaxYear.
+func (c *TaxYearClient) Delete() *TaxYearDelete {
+	mutation := newTaxYearMutation(c.config, OpDelete)
+	return &TaxYearDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaxYearClient) DeleteOne(_m *TaxYear) *TaxYearDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaxYearClient) DeleteOneID(id int) *TaxYearDeleteOne {
+	builder := c.Delete().Where(taxyear.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaxYearDeleteOne{builder}
+}
+
+// Query returns a query builder for TaxYear.
+func (c *TaxYearClient) Query() *TaxYearQuery {
+	return &TaxYearQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaxYear},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaxYear entity by its id.
+func (c *TaxYearClient) Get(ctx context.Context, id int) (*TaxYear, error) {
+	return c.Query().Where(taxyear.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaxYearClient) GetX(ctx context.Context, id int) *TaxYear {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TaxYearClient) Hooks() []Hook {
+	return c.hooks.TaxYear
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaxYearClient) Interceptors() []Interceptor {
+	return c.inters.TaxYear
+}
+
+func (c *TaxYearClient) mutate(ctx context.Context, m *TaxYearMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaxYearCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaxYearUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaxYearUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaxYearDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaxYear mutation op: %q", m.Op())
+	}
+}
+
+// TransactionClient is a client for the Transaction schema.
+type TransactionClient struct {
+	config
+}
+
+// NewTransactionClient returns a client for the Transaction from the given config.
+func NewTransactionClient(c config) *TransactionClient {
+	return &TransactionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `transaction.Hooks(f(g(h())))`.
+func (c *TransactionClient) Use(hooks ...Hook) {
+	c.hooks.Transaction = append(c.hooks.Transaction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `transaction.Intercept(f(g(h())))`.
+func (c *TransactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Transaction = append(c.inters.Transaction, interceptors...)
+}
+
+// Create returns a builder for creating a Transaction entity.
+func (c *TransactionClient) Create() *TransactionCreate {
+	mutation := newTransactionMutation(c.config, OpCreate)
+	return &TransactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Transaction entities.
+func (c *TransactionClient) CreateBulk(builders ...*TransactionCreate) *TransactionCreateBulk {
+	return &TransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TransactionClient) MapCreateBulk(slice any, setFunc func(*TransactionCreate, int)) *TransactionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TransactionCreateBulk{err: fmt.Errorf("calling to TransactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TransactionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Transaction.
+func (c *TransactionClient) Update() *TransactionUpdate {
+	mutation := newTransactionMutation(c.config, OpUpdate)
+	return &TransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TransactionClient) UpdateOne(_m *Transaction) *TransactionUpdateOne {
+	mutation := newTransactionMutation(c.config, OpUpdateOne, withTransaction(_m))
+	return &TransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TransactionClient) UpdateOneID(id string) *TransactionUpdateOne {
+	mutation := newTransactionMutation(c.config, OpUpdateOne, withTransactionID(id))
+	return &TransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Transaction.
+func (c *TransactionClient) Delete() *TransactionDelete {
+	mutation := newTransactionMutation(c.config, OpDelete)
+	return &TransactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TransactionClient) DeleteOne(_m *Transaction) *TransactionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TransactionClient) DeleteOneID(id string) *TransactionDeleteOne {
+	builder := c.Delete().Where(transaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TransactionDeleteOne{builder}
+}
+
+// Query returns a query builder for Transaction.
+func (c *TransactionClient) Query() *TransactionQuery {
+	return &TransactionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTransaction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Transaction entity by its id.
+func (c *TransactionClient) Get(ctx context.Context, id string) (*Transaction, error) {
+	return c.Query().Where(transaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TransactionClient) GetX(ctx context.Context, id string) *Transaction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryReceipt queries the receipt edge of a Transaction.
+func (c *TransactionClient) QueryReceipt(_m *Transaction) *ReceiptQuery {
+	query := (&ReceiptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, id),
+			sqlgraph.To(receipt.Table, receipt.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transaction.ReceiptTable, transaction.ReceiptColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMerchant queries the merchant edge of a Transaction.
+func (c *TransactionClient) QueryMerchant(_m *Transaction) *MerchantQuery {
+	query := (&MerchantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, id),
+			sqlgraph.To(merchant.Table, merchant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transaction.MerchantTable, transaction.MerchantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TransactionClient) Hooks() []Hook {
+	return c.hooks.Transaction
 }
 
-// CreateBulk returns a builder for creating a bulk of Receipt entities.
-func (c *ReceiptClient) CreateBulk(builders ...*ReceiptCreate) *ReceiptCreateBulk {
-	return &ReceiptCreateBulk{config: c.config, builders: builders}
+// Interceptors returns the client interceptors.
+func (c *TransactionClient) Interceptors() []Interceptor {
+	return c.inters.Transaction
+}
+
+func (c *TransactionClient) mutate(ctx context.Context, m *TransactionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TransactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TransactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Transaction mutation op: %q", m.Op())
+	}
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ReceiptClient) MapCreateBulk(slice any, setFunc func(*ReceiptCreate, int)) *ReceiptCreateBulk {
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ReceiptCreateBulk{err: fmt.Errorf("calling to ReceiptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ReceiptCreate, rv.Len())
+	builders := make([]*UserCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ReceiptCreateBulk{config: c.config, builders: builders}
+	return &UserCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Receipt.
-func (c *ReceiptClient) Update() *ReceiptUpdate {
-	mutation := newReceiptMutation(c.config, OpUpdate)
-	return &ReceiptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ReceiptClient) UpdateOne(_m *Receipt) *ReceiptUpdateOne {
-	mutation := newReceiptMutation(c.config, OpUpdateOne, withReceipt(_m))
-	return &ReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ReceiptClient) UpdateOneID(id string) *ReceiptUpdateOne {
-	mutation := newReceiptMutation(c.config, OpUpdateOne, withReceiptID(id))
-	return &ReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserClient) UpdateOneID(id string) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Receipt.
-func (c *ReceiptClient) Delete() *ReceiptDelete {
-	mutation := newReceiptMutation(c.config, OpDelete)
-	return &ReceiptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ReceiptClient) DeleteOne(_m *Receipt) *ReceiptDeleteOne {
+func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ReceiptClient) DeleteOneID(id string) *ReceiptDeleteOne {
-	builder := c.Delete().Where(receipt.ID(id))
+func (c *UserClient) DeleteOneID(id string) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ReceiptDeleteOne{builder}
+	return &UserDeleteOne{builder}
 }
 
-// Query returns a query builder for Receipt.
-func (c *ReceiptClient) Query() *ReceiptQuery {
-	return &ReceiptQuery{
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeReceipt},
+		ctx:    &QueryContext{Type: TypeUser},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Receipt entity by its id.
-func (c *ReceiptClient) Get(ctx context.Context, id string) (*Receipt, error) {
-	return c.Query().Where(receipt.ID(id)).Only(ctx)
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id string) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ReceiptClient) GetX(ctx context.Context, id string) *Receipt {
+func (c *UserClient) GetX(ctx context.Context, id string) *User {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1944,15 +7129,15 @@ func (c *ReceiptClient) GetX(ctx context.Context, id string) *Receipt {
 	return obj
 }
 
-// QueryTransactions queries the transactions edge of a Receipt.
-func (c *ReceiptClient) QueryTransactions(_m *Receipt) *TransactionQuery {
-	query := (&TransactionClient{config: c.config}).Query()
+// QueryEmailConnections queries the email_connections edge of a User.
+func (c *UserClient) QueryEmailConnections(_m *User) *EmailConnectionQuery {
+	query := (&EmailConnectionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(receipt.Table, receipt.FieldID, id),
-			sqlgraph.To(transaction.Table, transaction.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, receipt.TransactionsTable, receipt.TransactionsColumn),
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(emailconnection.Table, emailconnection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.EmailConnectionsTable, user.EmailConnectionsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1960,15 +7145,31 @@ func (c *ReceiptClient) QueryTransactions(_m *Receipt) *TransactionQuery {
 	return query
 }
 
-// QueryLineItems queries the line_items edge of a Receipt.
-func (c *ReceiptClient) QueryLineItems(_m *Receipt) *LineItemQuery {
-	query := (&LineItemClient{config: c.config}).Query()
+// QueryGoogleDriveConnections queries the google_drive_connections edge of a User.
+func (c *UserClient) QueryGoogleDriveConnections(_m *User) *GoogleDriveConnectionQuery {
+	query := (&GoogleDriveConnectionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(receipt.Table, receipt.FieldID, id),
-			sqlgraph.To(lineitem.Table, lineitem.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, receipt.LineItemsTable, receipt.LineItemsColumn),
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(googledriveconnection.Table, googledriveconnection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GoogleDriveConnectionsTable, user.GoogleDriveConnectionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBudgetReallocations queries the budget_reallocations edge of a User.
+func (c *UserClient) QueryBudgetReallocations(_m *User) *BudgetReallocationQuery {
+	query := (&BudgetReallocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(budgetreallocation.Table, budgetreallocation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.BudgetReallocationsTable, user.BudgetReallocationsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1977,131 +7178,131 @@ func (c *ReceiptClient) QueryLineItems(_m *Receipt) *LineItemQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *ReceiptClient) Hooks() []Hook {
-	return c.hooks.Receipt
+func (c *UserClient) Hooks() []Hook {
+	return c.hooks.User
 }
 
 // Interceptors returns the client interceptors.
-func (c *ReceiptClient) Interceptors() []Interceptor {
-	return c.inters.Receipt
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
 }
 
-func (c *ReceiptClient) mutate(ctx context.Context, m *ReceiptMutation) (Value, error) {
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ReceiptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ReceiptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ReceiptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Receipt mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
 	}
 }
 
-// TransactionClient is a client for the Transaction schema.
-type TransactionClient struct {
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
 	config
 }
 
-// NewTransactionClient returns a client for the Transaction from the given config.
-func NewTransactionClient(c config) *TransactionClient {
-	return &TransactionClient{config: c}
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `transaction.Hooks(f(g(h())))`.
-func (c *TransactionClient) Use(hooks ...Hook) {
-	c.hooks.Transaction = append(c.hooks.Transaction, hooks...)
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `transaction.Intercept(f(g(h())))`.
-func (c *TransactionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Transaction = append(c.inters.Transaction, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
 }
 
-// Create returns a builder for creating a Transaction entity.
-func (c *TransactionClient) Create() *TransactionCreate {
-	mutation := newTransactionMutation(c.config, OpCreate)
-	return &TransactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Transaction entities.
-func (c *TransactionClient) CreateBulk(builders ...*TransactionCreate) *TransactionCreateBulk {
-	return &TransactionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TransactionClient) MapCreateBulk(slice any, setFunc func(*TransactionCreate, int)) *TransactionCreateBulk {
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TransactionCreateBulk{err: fmt.Errorf("calling to TransactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TransactionCreate, rv.Len())
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TransactionCreateBulk{config: c.config, builders: builders}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Transaction.
-func (c *TransactionClient) Update() *TransactionUpdate {
-	mutation := newTransactionMutation(c.config, OpUpdate)
-	return &TransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TransactionClient) UpdateOne(_m *Transaction) *TransactionUpdateOne {
-	mutation := newTransactionMutation(c.config, OpUpdateOne, withTransaction(_m))
-	return &TransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookDeliveryClient) UpdateOne(_m *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(_m))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TransactionClient) UpdateOneID(id string) *TransactionUpdateOne {
-	mutation := newTransactionMutation(c.config, OpUpdateOne, withTransactionID(id))
-	return &TransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookDeliveryClient) UpdateOneID(id string) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Transaction.
-func (c *TransactionClient) Delete() *TransactionDelete {
-	mutation := newTransactionMutation(c.config, OpDelete)
-	return &TransactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TransactionClient) DeleteOne(_m *Transaction) *TransactionDeleteOne {
+func (c *WebhookDeliveryClient) DeleteOne(_m *WebhookDelivery) *WebhookDeliveryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TransactionClient) DeleteOneID(id string) *TransactionDeleteOne {
-	builder := c.Delete().Where(transaction.ID(id))
+func (c *WebhookDeliveryClient) DeleteOneID(id string) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TransactionDeleteOne{builder}
+	return &WebhookDeliveryDeleteOne{builder}
 }
 
-// Query returns a query builder for Transaction.
-func (c *TransactionClient) Query() *TransactionQuery {
-	return &TransactionQuery{
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTransaction},
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Transaction entity by its id.
-func (c *TransactionClient) Get(ctx context.Context, id string) (*Transaction, error) {
-	return c.Query().Where(transaction.ID(id)).Only(ctx)
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id string) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TransactionClient) GetX(ctx context.Context, id string) *Transaction {
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id string) *WebhookDelivery {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2109,57 +7310,188 @@ func (c *TransactionClient) GetX(ctx context.Context, id string) *Transaction {
 	return obj
 }
 
-// QueryReceipt queries the receipt edge of a Transaction.
-func (c *TransactionClient) QueryReceipt(_m *Transaction) *ReceiptQuery {
-	query := (&ReceiptClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(transaction.Table, transaction.FieldID, id),
-			sqlgraph.To(receipt.Table, receipt.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, transaction.ReceiptTable, transaction.ReceiptColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
+}
+
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookDelivery mutation op: %q", m.Op())
 	}
-	return query
+}
+
+// WebhookEndpointClient is a client for the WebhookEndpoint schema.
+type WebhookEndpointClient struct {
+	config
+}
+
+// NewWebhookEndpointClient returns a client for the WebhookEndpoint from the given config.
+func NewWebhookEndpointClient(c config) *WebhookEndpointClient {
+	return &WebhookEndpointClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookendpoint.Hooks(f(g(h())))`.
+func (c *WebhookEndpointClient) Use(hooks ...Hook) {
+	c.hooks.WebhookEndpoint = append(c.hooks.WebhookEndpoint, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookendpoint.Intercept(f(g(h())))`.
+func (c *WebhookEndpointClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookEndpoint = append(c.inters.WebhookEndpoint, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookEndpoint entity.
+func (c *WebhookEndpointClient) Create() *WebhookEndpointCreate {
+	mutation := newWebhookEndpointMutation(c.config, OpCreate)
+	return &WebhookEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookEndpoint entities.
+func (c *WebhookEndpointClient) CreateBulk(builders ...*WebhookEndpointCreate) *WebhookEndpointCreateBulk {
+	return &WebhookEndpointCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookEndpointClient) MapCreateBulk(slice any, setFunc func(*WebhookEndpointCreate, int)) *WebhookEndpointCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookEndpointCreateBulk{err: fmt.Errorf("calling to WebhookEndpointClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookEndpointCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookEndpointCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookEndpoint.
+func (c *WebhookEndpointClient) Update() *WebhookEndpointUpdate {
+	mutation := newWebhookEndpointMutation(c.config, OpUpdate)
+	return &WebhookEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookEndpointClient) UpdateOne(_m *WebhookEndpoint) *WebhookEndpointUpdateOne {
+	mutation := newWebhookEndpointMutation(c.config, OpUpdateOne, withWebhookEndpoint(_m))
+	return &WebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookEndpointClient) UpdateOneID(id string) *WebhookEndpointUpdateOne {
+	mutation := newWebhookEndpointMutation(c.config, OpUpdateOne, withWebhookEndpointID(id))
+	return &WebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookEndpoint.
+func (c *WebhookEndpointClient) Delete() *WebhookEndpointDelete {
+	mutation := newWebhookEndpointMutation(c.config, OpDelete)
+	return &WebhookEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookEndpointClient) DeleteOne(_m *WebhookEndpoint) *WebhookEndpointDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookEndpointClient) DeleteOneID(id string) *WebhookEndpointDeleteOne {
+	builder := c.Delete().Where(webhookendpoint.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookEndpointDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookEndpoint.
+func (c *WebhookEndpointClient) Query() *WebhookEndpointQuery {
+	return &WebhookEndpointQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookEndpoint},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookEndpoint entity by its id.
+func (c *WebhookEndpointClient) Get(ctx context.Context, id string) (*WebhookEndpoint, error) {
+	return c.Query().Where(webhookendpoint.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookEndpointClient) GetX(ctx context.Context, id string) *WebhookEndpoint {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *TransactionClient) Hooks() []Hook {
-	return c.hooks.Transaction
+func (c *WebhookEndpointClient) Hooks() []Hook {
+	return c.hooks.WebhookEndpoint
 }
 
 // Interceptors returns the client interceptors.
-func (c *TransactionClient) Interceptors() []Interceptor {
-	return c.inters.Transaction
+func (c *WebhookEndpointClient) Interceptors() []Interceptor {
+	return c.inters.WebhookEndpoint
 }
 
-func (c *TransactionClient) mutate(ctx context.Context, m *TransactionMutation) (Value, error) {
+func (c *WebhookEndpointClient) mutate(ctx context.Context, m *WebhookEndpointMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TransactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TransactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WebhookEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Transaction mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown WebhookEndpoint mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		EmailConnection, EmailLabel, EmailSync, GoogleDriveConnection,
-		GoogleDriveFolder, GoogleDriveSync, LineItem, PipelineConfig, PipelineRule,
-		PipelineVersion, Receipt, Transaction []ent.Hook
+		AccountDeletion, Alert, AlertPreference, AttachmentBlob, AttachmentLink,
+		BudgetReallocation, BulkOperation, CardAccount, CategorizationRule,
+		CategoryFeedback, Debt, EmailConnection, EmailLabel, EmailMessage, EmailSync,
+		EmailSyncFailure, EmergencyFundSnapshot, EmergencyFundTarget, Goal,
+		GoogleDriveConnection, GoogleDriveFolder, GoogleDriveSync, HouseholdMember,
+		JobQueue, JobSchedule, JobScheduleRun, LineItem, LiquidAccount, Membership,
+		Merchant, MigrationState, Notification, OAuthState, OCRFeedback, Organization,
+		PipelineConfig, PipelineRule, PipelineVersion, QueuedJob, Receipt,
+		ReceiptEvent, RoundingRule, SavedFilter, TaxYear, Transaction, User,
+		WebhookDelivery, WebhookEndpoint []ent.Hook
 	}
 	inters struct {
-		EmailConnection, EmailLabel, EmailSync, GoogleDriveConnection,
-		GoogleDriveFolder, GoogleDriveSync, LineItem, PipelineConfig, PipelineRule,
-		PipelineVersion, Receipt, Transaction []ent.Interceptor
+		AccountDeletion, Alert, AlertPreference, AttachmentBlob, AttachmentLink,
+		BudgetReallocation, BulkOperation, CardAccount, CategorizationRule,
+		CategoryFeedback, Debt, EmailConnection, EmailLabel, EmailMessage, EmailSync,
+		EmailSyncFailure, EmergencyFundSnapshot, EmergencyFundTarget, Goal,
+		GoogleDriveConnection, GoogleDriveFolder, GoogleDriveSync, HouseholdMember,
+		JobQueue, JobSchedule, JobScheduleRun, LineItem, LiquidAccount, Membership,
+		Merchant, MigrationState, Notification, OAuthState, OCRFeedback, Organization,
+		PipelineConfig, PipelineRule, PipelineVersion, QueuedJob, Receipt,
+		ReceiptEvent, RoundingRule, SavedFilter, TaxYear, Transaction, User,
+		WebhookDelivery, WebhookEndpoint []ent.Interceptor
 	}
 )
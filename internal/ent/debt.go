@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/debt"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Debt is the model entity for the Debt schema.
+type Debt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ID of the user who owes the debt
+	UserID string `json:"user_id,omitempty"`
+	// Name of the debt, e.g. the card or lender
+	Name string `json:"name,omitempty"`
+	// Type holds the value of the "type" field.
+	Type debt.Type `json:"type,omitempty"`
+	// Amount currently owed
+	Balance float64 `json:"balance,omitempty"`
+	// Annual percentage rate, e.g. 19.99
+	Apr float64 `json:"apr,omitempty"`
+	// Minimum monthly payment
+	MinimumPayment float64 `json:"minimum_payment,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Debt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case debt.FieldBalance, debt.FieldApr, debt.FieldMinimumPayment:
+			values[i] = new(sql.NullFloat64)
+		case debt.FieldID, debt.FieldUserID, debt.FieldName, debt.FieldType:
+			values[i] = new(sql.NullString)
+		case debt.FieldCreatedAt, debt.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Debt fields.
+func (_m *Debt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case debt.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case debt.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case debt.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case debt.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = debt.Type(value.String)
+			}
+		case debt.FieldBalance:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field balance", values[i])
+			} else if value.Valid {
+				_m.Balance = value.Float64
+			}
+		case debt.FieldApr:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field apr", values[i])
+			} else if value.Valid {
+				_m.Apr = value.Float64
+			}
+		case debt.FieldMinimumPayment:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field minimum_payment", values[i])
+			} else if value.Valid {
+				_m.MinimumPayment = value.Float64
+			}
+		case debt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case debt.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Debt.
+// This includes values selected through modifiers, order, etc.
+func (_m *Debt) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Debt.
+// Note that you need to call Debt.Unwrap() before calling this method if this Debt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Debt) Update() *DebtUpdateOne {
+	return NewDebtClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Debt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Debt) Unwrap() *Debt {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Debt is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Debt) String() string {
+	var builder strings.Builder
+	builder.WriteString("Debt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Type))
+	builder.WriteString(", ")
+	builder.WriteString("balance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Balance))
+	builder.WriteString(", ")
+	builder.WriteString("apr=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Apr))
+	builder.WriteString(", ")
+	builder.WriteString("minimum_payment=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinimumPayment))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Debts is a parsable slice of Debt.
+type Debts []*Debt
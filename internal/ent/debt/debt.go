@@ -0,0 +1,156 @@
+// Code generated by ent, DO NOT EDIT.
+
+package debt
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the debt type in the database.
+	Label = "debt"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldBalance holds the string denoting the balance field in the database.
+	FieldBalance = "balance"
+	// FieldApr holds the string denoting the apr field in the database.
+	FieldApr = "apr"
+	// FieldMinimumPayment holds the string denoting the minimum_payment field in the database.
+	FieldMinimumPayment = "minimum_payment"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the debt in the database.
+	Table = "debts"
+)
+
+// Columns holds all SQL columns for debt fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldName,
+	FieldType,
+	FieldBalance,
+	FieldApr,
+	FieldMinimumPayment,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// BalanceValidator is a validator for the "balance" field. It is called by the builders before save.
+	BalanceValidator func(float64) error
+	// AprValidator is a validator for the "apr" field. It is called by the builders before save.
+	AprValidator func(float64) error
+	// MinimumPaymentValidator is a validator for the "minimum_payment" field. It is called by the builders before save.
+	MinimumPaymentValidator func(float64) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// TypeOther is the default value of the Type enum.
+const DefaultType = TypeOther
+
+// Type values.
+const (
+	TypeCreditCard   Type = "credit_card"
+	TypeStudentLoan  Type = "student_loan"
+	TypeAutoLoan     Type = "auto_loan"
+	TypeMortgage     Type = "mortgage"
+	TypePersonalLoan Type = "personal_loan"
+	TypeMedical      Type = "medical"
+	TypeOther        Type = "other"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeCreditCard, TypeStudentLoan, TypeAutoLoan, TypeMortgage, TypePersonalLoan, TypeMedical, TypeOther:
+		return nil
+	default:
+		return fmt.Errorf("debt: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the Debt queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByBalance orders the results by the balance field.
+func ByBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBalance, opts...).ToFunc()
+}
+
+// ByApr orders the results by the apr field.
+func ByApr(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApr, opts...).ToFunc()
+}
+
+// ByMinimumPayment orders the results by the minimum_payment field.
+func ByMinimumPayment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinimumPayment, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
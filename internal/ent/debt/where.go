@@ -0,0 +1,465 @@
+// Code generated by ent, DO NOT EDIT.
+
+package debt
+
+import (
+	"clockzen-next/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Debt {
+	return predicate.Debt(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Debt {
+	return predicate.Debt(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Debt {
+	return predicate.Debt(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Debt {
+	return predicate.Debt(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Debt {
+	return predicate.Debt(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Debt {
+	return predicate.Debt(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Debt {
+	return predicate.Debt(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Debt {
+	return predicate.Debt(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Debt {
+	return predicate.Debt(sql.FieldContainsFold(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldName, v))
+}
+
+// Balance applies equality check predicate on the "balance" field. It's identical to BalanceEQ.
+func Balance(v float64) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldBalance, v))
+}
+
+// Apr applies equality check predicate on the "apr" field. It's identical to AprEQ.
+func Apr(v float64) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldApr, v))
+}
+
+// MinimumPayment applies equality check predicate on the "minimum_payment" field. It's identical to MinimumPaymentEQ.
+func MinimumPayment(v float64) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldMinimumPayment, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.Debt {
+	return predicate.Debt(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.Debt {
+	return predicate.Debt(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Debt {
+	return predicate.Debt(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Debt {
+	return predicate.Debt(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Debt {
+	return predicate.Debt(sql.FieldContainsFold(FieldName, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.Debt {
+	return predicate.Debt(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.Debt {
+	return predicate.Debt(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.Debt {
+	return predicate.Debt(sql.FieldNotIn(FieldType, vs...))
+}
+
+// BalanceEQ applies the EQ predicate on the "balance" field.
+func BalanceEQ(v float64) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldBalance, v))
+}
+
+// BalanceNEQ applies the NEQ predicate on the "balance" field.
+func BalanceNEQ(v float64) predicate.Debt {
+	return predicate.Debt(sql.FieldNEQ(FieldBalance, v))
+}
+
+// BalanceIn applies the In predicate on the "balance" field.
+func BalanceIn(vs ...float64) predicate.Debt {
+	return predicate.Debt(sql.FieldIn(FieldBalance, vs...))
+}
+
+// BalanceNotIn applies the NotIn predicate on the "balance" field.
+func BalanceNotIn(vs ...float64) predicate.Debt {
+	return predicate.Debt(sql.FieldNotIn(FieldBalance, vs...))
+}
+
+// BalanceGT applies the GT predicate on the "balance" field.
+func BalanceGT(v float64) predicate.Debt {
+	return predicate.Debt(sql.FieldGT(FieldBalance, v))
+}
+
+// BalanceGTE applies the GTE predicate on the "balance" field.
+func BalanceGTE(v float64) predicate.Debt {
+	return predicate.Debt(sql.FieldGTE(FieldBalance, v))
+}
+
+// BalanceLT applies the LT predicate on the "balance" field.
+func BalanceLT(v float64) predicate.Debt {
+	return predicate.Debt(sql.FieldLT(FieldBalance, v))
+}
+
+// BalanceLTE applies the LTE predicate on the "balance" field.
+func BalanceLTE(v float64) predicate.Debt {
+	return predicate.Debt(sql.FieldLTE(FieldBalance, v))
+}
+
+// AprEQ applies the EQ predicate on the "apr" field.
+func AprEQ(v float64) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldApr, v))
+}
+
+// AprNEQ applies the NEQ predicate on the "apr" field.
+func AprNEQ(v float64) predicate.Debt {
+	return predicate.Debt(sql.FieldNEQ(FieldApr, v))
+}
+
+// AprIn applies the In predicate on the "apr" field.
+func AprIn(vs ...float64) predicate.Debt {
+	return predicate.Debt(sql.FieldIn(FieldApr, vs...))
+}
+
+// AprNotIn applies the NotIn predicate on the "apr" field.
+func AprNotIn(vs ...float64) predicate.Debt {
+	return predicate.Debt(sql.FieldNotIn(FieldApr, vs...))
+}
+
+// AprGT applies the GT predicate on the "apr" field.
+func AprGT(v float64) predicate.Debt {
+	return predicate.Debt(sql.FieldGT(FieldApr, v))
+}
+
+// AprGTE applies the GTE predicate on the "apr" field.
+func AprGTE(v float64) predicate.Debt {
+	return predicate.Debt(sql.FieldGTE(FieldApr, v))
+}
+
+// AprLT applies the LT predicate on the "apr" field.
+func AprLT(v float64) predicate.Debt {
+	return predicate.Debt(sql.FieldLT(FieldApr, v))
+}
+
+// AprLTE applies the LTE predicate on the "apr" field.
+func AprLTE(v float64) predicate.Debt {
+	return predicate.Debt(sql.FieldLTE(FieldApr, v))
+}
+
+// MinimumPaymentEQ applies the EQ predicate on the "minimum_payment" field.
+func MinimumPaymentEQ(v float64) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldMinimumPayment, v))
+}
+
+// MinimumPaymentNEQ applies the NEQ predicate on the "minimum_payment" field.
+func MinimumPaymentNEQ(v float64) predicate.Debt {
+	return predicate.Debt(sql.FieldNEQ(FieldMinimumPayment, v))
+}
+
+// MinimumPaymentIn applies the In predicate on the "minimum_payment" field.
+func MinimumPaymentIn(vs ...float64) predicate.Debt {
+	return predicate.Debt(sql.FieldIn(FieldMinimumPayment, vs...))
+}
+
+// MinimumPaymentNotIn applies the NotIn predicate on the "minimum_payment" field.
+func MinimumPaymentNotIn(vs ...float64) predicate.Debt {
+	return predicate.Debt(sql.FieldNotIn(FieldMinimumPayment, vs...))
+}
+
+// MinimumPaymentGT applies the GT predicate on the "minimum_payment" field.
+func MinimumPaymentGT(v float64) predicate.Debt {
+	return predicate.Debt(sql.FieldGT(FieldMinimumPayment, v))
+}
+
+// MinimumPaymentGTE applies the GTE predicate on the "minimum_payment" field.
+func MinimumPaymentGTE(v float64) predicate.Debt {
+	return predicate.Debt(sql.FieldGTE(FieldMinimumPayment, v))
+}
+
+// MinimumPaymentLT applies the LT predicate on the "minimum_payment" field.
+func MinimumPaymentLT(v float64) predicate.Debt {
+	return predicate.Debt(sql.FieldLT(FieldMinimumPayment, v))
+}
+
+// MinimumPaymentLTE applies the LTE predicate on the "minimum_payment" field.
+func MinimumPaymentLTE(v float64) predicate.Debt {
+	return predicate.Debt(sql.FieldLTE(FieldMinimumPayment, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Debt) predicate.Debt {
+	return predicate.Debt(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Debt) predicate.Debt {
+	return predicate.Debt(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Debt) predicate.Debt {
+	return predicate.Debt(sql.NotPredicates(p))
+}
@@ -0,0 +1,959 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/debt"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DebtCreate is the builder for creating a Debt entity.
+type DebtCreate struct {
+	config
+	mutation *DebtMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *DebtCreate) SetUserID(v string) *DebtCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *DebtCreate) SetName(v string) *DebtCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *DebtCreate) SetType(v debt.Type) *DebtCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_c *DebtCreate) SetNillableType(v *debt.Type) *DebtCreate {
+	if v != nil {
+		_c.SetType(*v)
+	}
+	return _c
+}
+
+// SetBalance sets the "balance" field.
+func (_c *DebtCreate) SetBalance(v float64) *DebtCreate {
+	_c.mutation.SetBalance(v)
+	return _c
+}
+
+// SetApr sets the "apr" field.
+func (_c *DebtCreate) SetApr(v float64) *DebtCreate {
+	_c.mutation.SetApr(v)
+	return _c
+}
+
+// SetMinimumPayment sets the "minimum_payment" field.
+func (_c *DebtCreate) SetMinimumPayment(v float64) *DebtCreate {
+	_c.mutation.SetMinimumPayment(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DebtCreate) SetCreatedAt(v time.Time) *DebtCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DebtCreate) SetNillableCreatedAt(v *time.Time) *DebtCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DebtCreate) SetUpdatedAt(v time.Time) *DebtCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DebtCreate) SetNillableUpdatedAt(v *time.Time) *DebtCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DebtCreate) SetID(v string) *DebtCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the DebtMutation object of the builder.
+func (_c *DebtCreate) Mutation() *DebtMutation {
+	return _c.mutation
+}
+
+// Save creates the Debt in the database.
+func (_c *DebtCreate) Save(ctx context.Context) (*Debt, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DebtCreate) SaveX(ctx context.Context) *Debt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DebtCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DebtCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DebtCreate) defaults() {
+	if _, ok := _c.mutation.GetType(); !ok {
+		v := debt.DefaultType
+		_c.mutation.SetType(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := debt.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := debt.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DebtCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Debt.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := debt.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Debt.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Debt.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := debt.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Debt.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Debt.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := debt.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Debt.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Balance(); !ok {
+		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required field "Debt.balance"`)}
+	}
+	if v, ok := _c.mutation.Balance(); ok {
+		if err := debt.BalanceValidator(v); err != nil {
+			return &ValidationError{Name: "balance", err: fmt.Errorf(`ent: validator failed for field "Debt.balance": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Apr(); !ok {
+		return &ValidationError{Name: "apr", err: errors.New(`ent: missing required field "Debt.apr"`)}
+	}
+	if v, ok := _c.mutation.Apr(); ok {
+		if err := debt.AprValidator(v); err != nil {
+			return &ValidationError{Name: "apr", err: fmt.Errorf(`ent: validator failed for field "Debt.apr": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MinimumPayment(); !ok {
+		return &ValidationError{Name: "minimum_payment", err: errors.New(`ent: missing required field "Debt.minimum_payment"`)}
+	}
+	if v, ok := _c.mutation.MinimumPayment(); ok {
+		if err := debt.MinimumPaymentValidator(v); err != nil {
+			return &ValidationError{Name: "minimum_payment", err: fmt.Errorf(`ent: validator failed for field "Debt.minimum_payment": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Debt.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Debt.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *DebtCreate) sqlSave(ctx context.Context) (*Debt, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Debt.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DebtCreate) createSpec() (*Debt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Debt{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(debt.Table, sqlgraph.NewFieldSpec(debt.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(debt.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(debt.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(debt.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Balance(); ok {
+		_spec.SetField(debt.FieldBalance, field.TypeFloat64, value)
+		_node.Balance = value
+	}
+	if value, ok := _c.mutation.Apr(); ok {
+		_spec.SetField(debt.FieldApr, field.TypeFloat64, value)
+		_node.Apr = value
+	}
+	if value, ok := _c.mutation.MinimumPayment(); ok {
+		_spec.SetField(debt.FieldMinimumPayment, field.TypeFloat64, value)
+		_node.MinimumPayment = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(debt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(debt.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Debt.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DebtUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DebtCreate) OnConflict(opts ...sql.ConflictOption) *DebtUpsertOne {
+	_c.conflict = opts
+	return &DebtUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Debt.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DebtCreate) OnConflictColumns(columns ...string) *DebtUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DebtUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DebtUpsertOne is the builder for "upsert"-ing
+	//  one Debt node.
+	DebtUpsertOne struct {
+		create *DebtCreate
+	}
+
+	// DebtUpsert is the "OnConflict" setter.
+	DebtUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *DebtUpsert) SetUserID(v string) *DebtUpsert {
+	u.Set(debt.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *DebtUpsert) UpdateUserID() *DebtUpsert {
+	u.SetExcluded(debt.FieldUserID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *DebtUpsert) SetName(v string) *DebtUpsert {
+	u.Set(debt.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *DebtUpsert) UpdateName() *DebtUpsert {
+	u.SetExcluded(debt.FieldName)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *DebtUpsert) SetType(v debt.Type) *DebtUpsert {
+	u.Set(debt.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *DebtUpsert) UpdateType() *DebtUpsert {
+	u.SetExcluded(debt.FieldType)
+	return u
+}
+
+// SetBalance sets the "balance" field.
+func (u *DebtUpsert) SetBalance(v float64) *DebtUpsert {
+	u.Set(debt.FieldBalance, v)
+	return u
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *DebtUpsert) UpdateBalance() *DebtUpsert {
+	u.SetExcluded(debt.FieldBalance)
+	return u
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *DebtUpsert) AddBalance(v float64) *DebtUpsert {
+	u.Add(debt.FieldBalance, v)
+	return u
+}
+
+// SetApr sets the "apr" field.
+func (u *DebtUpsert) SetApr(v float64) *DebtUpsert {
+	u.Set(debt.FieldApr, v)
+	return u
+}
+
+// UpdateApr sets the "apr" field to the value that was provided on create.
+func (u *DebtUpsert) UpdateApr() *DebtUpsert {
+	u.SetExcluded(debt.FieldApr)
+	return u
+}
+
+// AddApr adds v to the "apr" field.
+func (u *DebtUpsert) AddApr(v float64) *DebtUpsert {
+	u.Add(debt.FieldApr, v)
+	return u
+}
+
+// SetMinimumPayment sets the "minimum_payment" field.
+func (u *DebtUpsert) SetMinimumPayment(v float64) *DebtUpsert {
+	u.Set(debt.FieldMinimumPayment, v)
+	return u
+}
+
+// UpdateMinimumPayment sets the "minimum_payment" field to the value that was provided on create.
+func (u *DebtUpsert) UpdateMinimumPayment() *DebtUpsert {
+	u.SetExcluded(debt.FieldMinimumPayment)
+	return u
+}
+
+// AddMinimumPayment adds v to the "minimum_payment" field.
+func (u *DebtUpsert) AddMinimumPayment(v float64) *DebtUpsert {
+	u.Add(debt.FieldMinimumPayment, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DebtUpsert) SetUpdatedAt(v time.Time) *DebtUpsert {
+	u.Set(debt.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DebtUpsert) UpdateUpdatedAt() *DebtUpsert {
+	u.SetExcluded(debt.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Debt.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(debt.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DebtUpsertOne) UpdateNewValues() *DebtUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(debt.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(debt.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Debt.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DebtUpsertOne) Ignore() *DebtUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DebtUpsertOne) DoNothing() *DebtUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DebtCreate.OnConflict
+// documentation for more info.
+func (u *DebtUpsertOne) Update(set func(*DebtUpsert)) *DebtUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DebtUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *DebtUpsertOne) SetUserID(v string) *DebtUpsertOne {
+	return u.Update(func(s *DebtUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *DebtUpsertOne) UpdateUserID() *DebtUpsertOne {
+	return u.Update(func(s *DebtUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *DebtUpsertOne) SetName(v string) *DebtUpsertOne {
+	return u.Update(func(s *DebtUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *DebtUpsertOne) UpdateName() *DebtUpsertOne {
+	return u.Update(func(s *DebtUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *DebtUpsertOne) SetType(v debt.Type) *DebtUpsertOne {
+	return u.Update(func(s *DebtUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *DebtUpsertOne) UpdateType() *DebtUpsertOne {
+	return u.Update(func(s *DebtUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *DebtUpsertOne) SetBalance(v float64) *DebtUpsertOne {
+	return u.Update(func(s *DebtUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *DebtUpsertOne) AddBalance(v float64) *DebtUpsertOne {
+	return u.Update(func(s *DebtUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *DebtUpsertOne) UpdateBalance() *DebtUpsertOne {
+	return u.Update(func(s *DebtUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// SetApr sets the "apr" field.
+func (u *DebtUpsertOne) SetApr(v float64) *DebtUpsertOne {
+	return u.Update(func(s *DebtUpsert) {
+		s.SetApr(v)
+	})
+}
+
+// AddApr adds v to the "apr" field.
+func (u *DebtUpsertOne) AddApr(v float64) *DebtUpsertOne {
+	return u.Update(func(s *DebtUpsert) {
+		s.AddApr(v)
+	})
+}
+
+// UpdateApr sets the "apr" field to the value that was provided on create.
+func (u *DebtUpsertOne) UpdateApr() *DebtUpsertOne {
+	return u.Update(func(s *DebtUpsert) {
+		s.UpdateApr()
+	})
+}
+
+// SetMinimumPayment sets the "minimum_payment" field.
+func (u *DebtUpsertOne) SetMinimumPayment(v float64) *DebtUpsertOne {
+	return u.Update(func(s *DebtUpsert) {
+		s.SetMinimumPayment(v)
+	})
+}
+
+// AddMinimumPayment adds v to the "minimum_payment" field.
+func (u *DebtUpsertOne) AddMinimumPayment(v float64) *DebtUpsertOne {
+	return u.Update(func(s *DebtUpsert) {
+		s.AddMinimumPayment(v)
+	})
+}
+
+// UpdateMinimumPayment sets the "minimum_payment" field to the value that was provided on create.
+func (u *DebtUpsertOne) UpdateMinimumPayment() *DebtUpsertOne {
+	return u.Update(func(s *DebtUpsert) {
+		s.UpdateMinimumPayment()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DebtUpsertOne) SetUpdatedAt(v time.Time) *DebtUpsertOne {
+	return u.Update(func(s *DebtUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DebtUpsertOne) UpdateUpdatedAt() *DebtUpsertOne {
+	return u.Update(func(s *DebtUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DebtUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DebtCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DebtUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DebtUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DebtUpsertOne.ID is not supported by MySQL driver. Use DebtUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DebtUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DebtCreateBulk is the builder for creating many Debt entities in bulk.
+type DebtCreateBulk struct {
+	config
+	err      error
+	builders []*DebtCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Debt entities in the database.
+func (_c *DebtCreateBulk) Save(ctx context.Context) ([]*Debt, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Debt, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DebtMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DebtCreateBulk) SaveX(ctx context.Context) []*Debt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DebtCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DebtCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Debt.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DebtUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DebtCreateBulk) OnConflict(opts ...sql.ConflictOption) *DebtUpsertBulk {
+	_c.conflict = opts
+	return &DebtUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Debt.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DebtCreateBulk) OnConflictColumns(columns ...string) *DebtUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DebtUpsertBulk{
+		create: _c,
+	}
+}
+
+// DebtUpsertBulk is the builder for "upsert"-ing
+// a bulk of Debt nodes.
+type DebtUpsertBulk struct {
+	create *DebtCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Debt.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(debt.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DebtUpsertBulk) UpdateNewValues() *DebtUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(debt.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(debt.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Debt.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DebtUpsertBulk) Ignore() *DebtUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DebtUpsertBulk) DoNothing() *DebtUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DebtCreateBulk.OnConflict
+// documentation for more info.
+func (u *DebtUpsertBulk) Update(set func(*DebtUpsert)) *DebtUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DebtUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *DebtUpsertBulk) SetUserID(v string) *DebtUpsertBulk {
+	return u.Update(func(s *DebtUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *DebtUpsertBulk) UpdateUserID() *DebtUpsertBulk {
+	return u.Update(func(s *DebtUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *DebtUpsertBulk) SetName(v string) *DebtUpsertBulk {
+	return u.Update(func(s *DebtUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *DebtUpsertBulk) UpdateName() *DebtUpsertBulk {
+	return u.Update(func(s *DebtUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *DebtUpsertBulk) SetType(v debt.Type) *DebtUpsertBulk {
+	return u.Update(func(s *DebtUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *DebtUpsertBulk) UpdateType() *DebtUpsertBulk {
+	return u.Update(func(s *DebtUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *DebtUpsertBulk) SetBalance(v float64) *DebtUpsertBulk {
+	return u.Update(func(s *DebtUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *DebtUpsertBulk) AddBalance(v float64) *DebtUpsertBulk {
+	return u.Update(func(s *DebtUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *DebtUpsertBulk) UpdateBalance() *DebtUpsertBulk {
+	return u.Update(func(s *DebtUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// SetApr sets the "apr" field.
+func (u *DebtUpsertBulk) SetApr(v float64) *DebtUpsertBulk {
+	return u.Update(func(s *DebtUpsert) {
+		s.SetApr(v)
+	})
+}
+
+// AddApr adds v to the "apr" field.
+func (u *DebtUpsertBulk) AddApr(v float64) *DebtUpsertBulk {
+	return u.Update(func(s *DebtUpsert) {
+		s.AddApr(v)
+	})
+}
+
+// UpdateApr sets the "apr" field to the value that was provided on create.
+func (u *DebtUpsertBulk) UpdateApr() *DebtUpsertBulk {
+	return u.Update(func(s *DebtUpsert) {
+		s.UpdateApr()
+	})
+}
+
+// SetMinimumPayment sets the "minimum_payment" field.
+func (u *DebtUpsertBulk) SetMinimumPayment(v float64) *DebtUpsertBulk {
+	return u.Update(func(s *DebtUpsert) {
+		s.SetMinimumPayment(v)
+	})
+}
+
+// AddMinimumPayment adds v to the "minimum_payment" field.
+func (u *DebtUpsertBulk) AddMinimumPayment(v float64) *DebtUpsertBulk {
+	return u.Update(func(s *DebtUpsert) {
+		s.AddMinimumPayment(v)
+	})
+}
+
+// UpdateMinimumPayment sets the "minimum_payment" field to the value that was provided on create.
+func (u *DebtUpsertBulk) UpdateMinimumPayment() *DebtUpsertBulk {
+	return u.Update(func(s *DebtUpsert) {
+		s.UpdateMinimumPayment()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DebtUpsertBulk) SetUpdatedAt(v time.Time) *DebtUpsertBulk {
+	return u.Update(func(s *DebtUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DebtUpsertBulk) UpdateUpdatedAt() *DebtUpsertBulk {
+	return u.Update(func(s *DebtUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DebtUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DebtCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DebtCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DebtUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,552 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/debt"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DebtUpdate is the builder for updating Debt entities.
+type DebtUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DebtMutation
+}
+
+// Where appends a list predicates to the DebtUpdate builder.
+func (_u *DebtUpdate) Where(ps ...predicate.Debt) *DebtUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *DebtUpdate) SetUserID(v string) *DebtUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *DebtUpdate) SetNillableUserID(v *string) *DebtUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *DebtUpdate) SetName(v string) *DebtUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *DebtUpdate) SetNillableName(v *string) *DebtUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *DebtUpdate) SetType(v debt.Type) *DebtUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *DebtUpdate) SetNillableType(v *debt.Type) *DebtUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetBalance sets the "balance" field.
+func (_u *DebtUpdate) SetBalance(v float64) *DebtUpdate {
+	_u.mutation.ResetBalance()
+	_u.mutation.SetBalance(v)
+	return _u
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (_u *DebtUpdate) SetNillableBalance(v *float64) *DebtUpdate {
+	if v != nil {
+		_u.SetBalance(*v)
+	}
+	return _u
+}
+
+// AddBalance adds value to the "balance" field.
+func (_u *DebtUpdate) AddBalance(v float64) *DebtUpdate {
+	_u.mutation.AddBalance(v)
+	return _u
+}
+
+// SetApr sets the "apr" field.
+func (_u *DebtUpdate) SetApr(v float64) *DebtUpdate {
+	_u.mutation.ResetApr()
+	_u.mutation.SetApr(v)
+	return _u
+}
+
+// SetNillableApr sets the "apr" field if the given value is not nil.
+func (_u *DebtUpdate) SetNillableApr(v *float64) *DebtUpdate {
+	if v != nil {
+		_u.SetApr(*v)
+	}
+	return _u
+}
+
+// AddApr adds value to the "apr" field.
+func (_u *DebtUpdate) AddApr(v float64) *DebtUpdate {
+	_u.mutation.AddApr(v)
+	return _u
+}
+
+// SetMinimumPayment sets the "minimum_payment" field.
+func (_u *DebtUpdate) SetMinimumPayment(v float64) *DebtUpdate {
+	_u.mutation.ResetMinimumPayment()
+	_u.mutation.SetMinimumPayment(v)
+	return _u
+}
+
+// SetNillableMinimumPayment sets the "minimum_payment" field if the given value is not nil.
+func (_u *DebtUpdate) SetNillableMinimumPayment(v *float64) *DebtUpdate {
+	if v != nil {
+		_u.SetMinimumPayment(*v)
+	}
+	return _u
+}
+
+// AddMinimumPayment adds value to the "minimum_payment" field.
+func (_u *DebtUpdate) AddMinimumPayment(v float64) *DebtUpdate {
+	_u.mutation.AddMinimumPayment(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DebtUpdate) SetUpdatedAt(v time.Time) *DebtUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DebtMutation object of the builder.
+func (_u *DebtUpdate) Mutation() *DebtMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DebtUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DebtUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DebtUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DebtUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DebtUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := debt.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DebtUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := debt.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Debt.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := debt.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Debt.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := debt.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Debt.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Balance(); ok {
+		if err := debt.BalanceValidator(v); err != nil {
+			return &ValidationError{Name: "balance", err: fmt.Errorf(`ent: validator failed for field "Debt.balance": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Apr(); ok {
+		if err := debt.AprValidator(v); err != nil {
+			return &ValidationError{Name: "apr", err: fmt.Errorf(`ent: validator failed for field "Debt.apr": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MinimumPayment(); ok {
+		if err := debt.MinimumPaymentValidator(v); err != nil {
+			return &ValidationError{Name: "minimum_payment", err: fmt.Errorf(`ent: validator failed for field "Debt.minimum_payment": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DebtUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(debt.Table, debt.Columns, sqlgraph.NewFieldSpec(debt.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(debt.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(debt.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(debt.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Balance(); ok {
+		_spec.SetField(debt.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBalance(); ok {
+		_spec.AddField(debt.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Apr(); ok {
+		_spec.SetField(debt.FieldApr, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedApr(); ok {
+		_spec.AddField(debt.FieldApr, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MinimumPayment(); ok {
+		_spec.SetField(debt.FieldMinimumPayment, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMinimumPayment(); ok {
+		_spec.AddField(debt.FieldMinimumPayment, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(debt.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{debt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DebtUpdateOne is the builder for updating a single Debt entity.
+type DebtUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DebtMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *DebtUpdateOne) SetUserID(v string) *DebtUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *DebtUpdateOne) SetNillableUserID(v *string) *DebtUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *DebtUpdateOne) SetName(v string) *DebtUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *DebtUpdateOne) SetNillableName(v *string) *DebtUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *DebtUpdateOne) SetType(v debt.Type) *DebtUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *DebtUpdateOne) SetNillableType(v *debt.Type) *DebtUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetBalance sets the "balance" field.
+func (_u *DebtUpdateOne) SetBalance(v float64) *DebtUpdateOne {
+	_u.mutation.ResetBalance()
+	_u.mutation.SetBalance(v)
+	return _u
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (_u *DebtUpdateOne) SetNillableBalance(v *float64) *DebtUpdateOne {
+	if v != nil {
+		_u.SetBalance(*v)
+	}
+	return _u
+}
+
+// AddBalance adds value to the "balance" field.
+func (_u *DebtUpdateOne) AddBalance(v float64) *DebtUpdateOne {
+	_u.mutation.AddBalance(v)
+	return _u
+}
+
+// SetApr sets the "apr" field.
+func (_u *DebtUpdateOne) SetApr(v float64) *DebtUpdateOne {
+	_u.mutation.ResetApr()
+	_u.mutation.SetApr(v)
+	return _u
+}
+
+// SetNillableApr sets the "apr" field if the given value is not nil.
+func (_u *DebtUpdateOne) SetNillableApr(v *float64) *DebtUpdateOne {
+	if v != nil {
+		_u.SetApr(*v)
+	}
+	return _u
+}
+
+// AddApr adds value to the "apr" field.
+func (_u *DebtUpdateOne) AddApr(v float64) *DebtUpdateOne {
+	_u.mutation.AddApr(v)
+	return _u
+}
+
+// SetMinimumPayment sets the "minimum_payment" field.
+func (_u *DebtUpdateOne) SetMinimumPayment(v float64) *DebtUpdateOne {
+	_u.mutation.ResetMinimumPayment()
+	_u.mutation.SetMinimumPayment(v)
+	return _u
+}
+
+// SetNillableMinimumPayment sets the "minimum_payment" field if the given value is not nil.
+func (_u *DebtUpdateOne) SetNillableMinimumPayment(v *float64) *DebtUpdateOne {
+	if v != nil {
+		_u.SetMinimumPayment(*v)
+	}
+	return _u
+}
+
+// AddMinimumPayment adds value to the "minimum_payment" field.
+func (_u *DebtUpdateOne) AddMinimumPayment(v float64) *DebtUpdateOne {
+	_u.mutation.AddMinimumPayment(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DebtUpdateOne) SetUpdatedAt(v time.Time) *DebtUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DebtMutation object of the builder.
+func (_u *DebtUpdateOne) Mutation() *DebtMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DebtUpdate builder.
+func (_u *DebtUpdateOne) Where(ps ...predicate.Debt) *DebtUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DebtUpdateOne) Select(field string, fields ...string) *DebtUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Debt entity.
+func (_u *DebtUpdateOne) Save(ctx context.Context) (*Debt, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DebtUpdateOne) SaveX(ctx context.Context) *Debt {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DebtUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DebtUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DebtUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := debt.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DebtUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := debt.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Debt.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := debt.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Debt.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := debt.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Debt.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Balance(); ok {
+		if err := debt.BalanceValidator(v); err != nil {
+			return &ValidationError{Name: "balance", err: fmt.Errorf(`ent: validator failed for field "Debt.balance": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Apr(); ok {
+		if err := debt.AprValidator(v); err != nil {
+			return &ValidationError{Name: "apr", err: fmt.Errorf(`ent: validator failed for field "Debt.apr": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MinimumPayment(); ok {
+		if err := debt.MinimumPaymentValidator(v); err != nil {
+			return &ValidationError{Name: "minimum_payment", err: fmt.Errorf(`ent: validator failed for field "Debt.minimum_payment": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DebtUpdateOne) sqlSave(ctx context.Context) (_node *Debt, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(debt.Table, debt.Columns, sqlgraph.NewFieldSpec(debt.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Debt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, debt.FieldID)
+		for _, f := range fields {
+			if !debt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != debt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(debt.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(debt.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(debt.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Balance(); ok {
+		_spec.SetField(debt.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBalance(); ok {
+		_spec.AddField(debt.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Apr(); ok {
+		_spec.SetField(debt.FieldApr, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedApr(); ok {
+		_spec.AddField(debt.FieldApr, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MinimumPayment(); ok {
+		_spec.SetField(debt.FieldMinimumPayment, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMinimumPayment(); ok {
+		_spec.AddField(debt.FieldMinimumPayment, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(debt.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Debt{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{debt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
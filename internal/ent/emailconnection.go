@@ -4,6 +4,7 @@ package ent
 
 import (
 	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/user"
 	"fmt"
 	"strings"
 	"time"
@@ -17,13 +18,15 @@ type EmailConnection struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
-	// ID of the user who owns this connection
+	// ID of the user who connected the account
 	UserID string `json:"user_id,omitempty"`
+	// ID of the organization the connection is shared with; unset for the user's own connections
+	OrganizationID *string `json:"organization_id,omitempty"`
 	// Email provider account identifier
 	ProviderAccountID string `json:"provider_account_id,omitempty"`
 	// Email address
 	Email string `json:"email,omitempty"`
-	// Email provider type
+	// Email provider type; sandbox serves a synthetic mailbox
 	Provider emailconnection.Provider `json:"provider,omitempty"`
 	// OAuth2 access token
 	AccessToken string `json:"-"`
@@ -33,12 +36,18 @@ type EmailConnection struct {
 	TokenExpiry time.Time `json:"token_expiry,omitempty"`
 	// Connection status
 	Status emailconnection.Status `json:"status,omitempty"`
+	// ID of the legacy migration run that created the connection, so the run can be rolled back
+	MigrationRunID *string `json:"migration_run_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Last successful sync timestamp
 	LastSyncAt *time.Time `json:"last_sync_at,omitempty"`
+	// How often the worker syncs the connection; manual only syncs on request
+	SyncSchedule emailconnection.SyncSchedule `json:"sync_schedule,omitempty"`
+	// When the worker next syncs the connection on its schedule
+	NextSyncAt *time.Time `json:"next_sync_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EmailConnectionQuery when eager-loading is set.
 	Edges        EmailConnectionEdges `json:"edges"`
@@ -47,19 +56,32 @@ type EmailConnection struct {
 
 // EmailConnectionEdges holds the relations/edges for other nodes in the graph.
 type EmailConnectionEdges struct {
+	// The user who connected the account
+	User *User `json:"user,omitempty"`
 	// Labels/folders associated with this connection
 	Labels []*EmailLabel `json:"labels,omitempty"`
 	// Sync history for this connection
 	Syncs []*EmailSync `json:"syncs,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmailConnectionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
 }
 
 // LabelsOrErr returns the Labels value or an error if the edge
 // was not loaded in eager-loading.
 func (e EmailConnectionEdges) LabelsOrErr() ([]*EmailLabel, error) {
-	if e.loadedTypes[0] {
+	if e.loadedTypes[1] {
 		return e.Labels, nil
 	}
 	return nil, &NotLoadedError{edge: "labels"}
@@ -68,7 +90,7 @@ func (e EmailConnectionEdges) LabelsOrErr() ([]*EmailLabel, error) {
 // SyncsOrErr returns the Syncs value or an error if the edge
 // was not loaded in eager-loading.
 func (e EmailConnectionEdges) SyncsOrErr() ([]*EmailSync, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.Syncs, nil
 	}
 	return nil, &NotLoadedError{edge: "syncs"}
@@ -79,9 +101,9 @@ func (*EmailConnection) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case emailconnection.FieldID, emailconnection.FieldUserID, emailconnection.FieldProviderAccountID, emailconnection.FieldEmail, emailconnection.FieldProvider, emailconnection.FieldAccessToken, emailconnection.FieldRefreshToken, emailconnection.FieldStatus:
+		case emailconnection.FieldID, emailconnection.FieldUserID, emailconnection.FieldOrganizationID, emailconnection.FieldProviderAccountID, emailconnection.FieldEmail, emailconnection.FieldProvider, emailconnection.FieldAccessToken, emailconnection.FieldRefreshToken, emailconnection.FieldStatus, emailconnection.FieldMigrationRunID, emailconnection.FieldSyncSchedule:
 			values[i] = new(sql.NullString)
-		case emailconnection.FieldTokenExpiry, emailconnection.FieldCreatedAt, emailconnection.FieldUpdatedAt, emailconnection.FieldLastSyncAt:
+		case emailconnection.FieldTokenExpiry, emailconnection.FieldCreatedAt, emailconnection.FieldUpdatedAt, emailconnection.FieldLastSyncAt, emailconnection.FieldNextSyncAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -110,6 +132,13 @@ func (_m *EmailConnection) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UserID = value.String
 			}
+		case emailconnection.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				_m.OrganizationID = new(string)
+				*_m.OrganizationID = value.String
+			}
 		case emailconnection.FieldProviderAccountID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field provider_account_id", values[i])
@@ -152,6 +181,13 @@ func (_m *EmailConnection) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Status = emailconnection.Status(value.String)
 			}
+		case emailconnection.FieldMigrationRunID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field migration_run_id", values[i])
+			} else if value.Valid {
+				_m.MigrationRunID = new(string)
+				*_m.MigrationRunID = value.String
+			}
 		case emailconnection.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -171,6 +207,19 @@ func (_m *EmailConnection) assignValues(columns []string, values []any) error {
 				_m.LastSyncAt = new(time.Time)
 				*_m.LastSyncAt = value.Time
 			}
+		case emailconnection.FieldSyncSchedule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sync_schedule", values[i])
+			} else if value.Valid {
+				_m.SyncSchedule = emailconnection.SyncSchedule(value.String)
+			}
+		case emailconnection.FieldNextSyncAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_sync_at", values[i])
+			} else if value.Valid {
+				_m.NextSyncAt = new(time.Time)
+				*_m.NextSyncAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -184,6 +233,11 @@ func (_m *EmailConnection) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryUser queries the "user" edge of the EmailConnection entity.
+func (_m *EmailConnection) QueryUser() *UserQuery {
+	return NewEmailConnectionClient(_m.config).QueryUser(_m)
+}
+
 // QueryLabels queries the "labels" edge of the EmailConnection entity.
 func (_m *EmailConnection) QueryLabels() *EmailLabelQuery {
 	return NewEmailConnectionClient(_m.config).QueryLabels(_m)
@@ -220,6 +274,11 @@ func (_m *EmailConnection) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(_m.UserID)
 	builder.WriteString(", ")
+	if v := _m.OrganizationID; v != nil {
+		builder.WriteString("organization_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("provider_account_id=")
 	builder.WriteString(_m.ProviderAccountID)
 	builder.WriteString(", ")
@@ -239,6 +298,11 @@ func (_m *EmailConnection) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
+	if v := _m.MigrationRunID; v != nil {
+		builder.WriteString("migration_run_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -249,6 +313,14 @@ func (_m *EmailConnection) String() string {
 		builder.WriteString("last_sync_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("sync_schedule=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SyncSchedule))
+	builder.WriteString(", ")
+	if v := _m.NextSyncAt; v != nil {
+		builder.WriteString("next_sync_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -70,6 +70,11 @@ func UserID(v string) predicate.EmailConnection {
 	return predicate.EmailConnection(sql.FieldEQ(FieldUserID, v))
 }
 
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldEQ(FieldOrganizationID, v))
+}
+
 // ProviderAccountID applies equality check predicate on the "provider_account_id" field. It's identical to ProviderAccountIDEQ.
 func ProviderAccountID(v string) predicate.EmailConnection {
 	return predicate.EmailConnection(sql.FieldEQ(FieldProviderAccountID, v))
@@ -95,6 +100,11 @@ func TokenExpiry(v time.Time) predicate.EmailConnection {
 	return predicate.EmailConnection(sql.FieldEQ(FieldTokenExpiry, v))
 }
 
+// MigrationRunID applies equality check predicate on the "migration_run_id" field. It's identical to MigrationRunIDEQ.
+func MigrationRunID(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldEQ(FieldMigrationRunID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.EmailConnection {
 	return predicate.EmailConnection(sql.FieldEQ(FieldCreatedAt, v))
@@ -110,6 +120,11 @@ func LastSyncAt(v time.Time) predicate.EmailConnection {
 	return predicate.EmailConnection(sql.FieldEQ(FieldLastSyncAt, v))
 }
 
+// NextSyncAt applies equality check predicate on the "next_sync_at" field. It's identical to NextSyncAtEQ.
+func NextSyncAt(v time.Time) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldEQ(FieldNextSyncAt, v))
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v string) predicate.EmailConnection {
 	return predicate.EmailConnection(sql.FieldEQ(FieldUserID, v))
@@ -175,6 +190,81 @@ func UserIDContainsFold(v string) predicate.EmailConnection {
 	return predicate.EmailConnection(sql.FieldContainsFold(FieldUserID, v))
 }
 
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDGT applies the GT predicate on the "organization_id" field.
+func OrganizationIDGT(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldGT(FieldOrganizationID, v))
+}
+
+// OrganizationIDGTE applies the GTE predicate on the "organization_id" field.
+func OrganizationIDGTE(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldGTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDLT applies the LT predicate on the "organization_id" field.
+func OrganizationIDLT(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldLT(FieldOrganizationID, v))
+}
+
+// OrganizationIDLTE applies the LTE predicate on the "organization_id" field.
+func OrganizationIDLTE(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldLTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDContains applies the Contains predicate on the "organization_id" field.
+func OrganizationIDContains(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldContains(FieldOrganizationID, v))
+}
+
+// OrganizationIDHasPrefix applies the HasPrefix predicate on the "organization_id" field.
+func OrganizationIDHasPrefix(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldHasPrefix(FieldOrganizationID, v))
+}
+
+// OrganizationIDHasSuffix applies the HasSuffix predicate on the "organization_id" field.
+func OrganizationIDHasSuffix(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldHasSuffix(FieldOrganizationID, v))
+}
+
+// OrganizationIDIsNil applies the IsNil predicate on the "organization_id" field.
+func OrganizationIDIsNil() predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldIsNull(FieldOrganizationID))
+}
+
+// OrganizationIDNotNil applies the NotNil predicate on the "organization_id" field.
+func OrganizationIDNotNil() predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldNotNull(FieldOrganizationID))
+}
+
+// OrganizationIDEqualFold applies the EqualFold predicate on the "organization_id" field.
+func OrganizationIDEqualFold(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldEqualFold(FieldOrganizationID, v))
+}
+
+// OrganizationIDContainsFold applies the ContainsFold predicate on the "organization_id" field.
+func OrganizationIDContainsFold(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldContainsFold(FieldOrganizationID, v))
+}
+
 // ProviderAccountIDEQ applies the EQ predicate on the "provider_account_id" field.
 func ProviderAccountIDEQ(v string) predicate.EmailConnection {
 	return predicate.EmailConnection(sql.FieldEQ(FieldProviderAccountID, v))
@@ -515,6 +605,81 @@ func StatusNotIn(vs ...Status) predicate.EmailConnection {
 	return predicate.EmailConnection(sql.FieldNotIn(FieldStatus, vs...))
 }
 
+// MigrationRunIDEQ applies the EQ predicate on the "migration_run_id" field.
+func MigrationRunIDEQ(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldEQ(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDNEQ applies the NEQ predicate on the "migration_run_id" field.
+func MigrationRunIDNEQ(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldNEQ(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDIn applies the In predicate on the "migration_run_id" field.
+func MigrationRunIDIn(vs ...string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldIn(FieldMigrationRunID, vs...))
+}
+
+// MigrationRunIDNotIn applies the NotIn predicate on the "migration_run_id" field.
+func MigrationRunIDNotIn(vs ...string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldNotIn(FieldMigrationRunID, vs...))
+}
+
+// MigrationRunIDGT applies the GT predicate on the "migration_run_id" field.
+func MigrationRunIDGT(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldGT(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDGTE applies the GTE predicate on the "migration_run_id" field.
+func MigrationRunIDGTE(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldGTE(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDLT applies the LT predicate on the "migration_run_id" field.
+func MigrationRunIDLT(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldLT(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDLTE applies the LTE predicate on the "migration_run_id" field.
+func MigrationRunIDLTE(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldLTE(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDContains applies the Contains predicate on the "migration_run_id" field.
+func MigrationRunIDContains(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldContains(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDHasPrefix applies the HasPrefix predicate on the "migration_run_id" field.
+func MigrationRunIDHasPrefix(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldHasPrefix(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDHasSuffix applies the HasSuffix predicate on the "migration_run_id" field.
+func MigrationRunIDHasSuffix(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldHasSuffix(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDIsNil applies the IsNil predicate on the "migration_run_id" field.
+func MigrationRunIDIsNil() predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldIsNull(FieldMigrationRunID))
+}
+
+// MigrationRunIDNotNil applies the NotNil predicate on the "migration_run_id" field.
+func MigrationRunIDNotNil() predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldNotNull(FieldMigrationRunID))
+}
+
+// MigrationRunIDEqualFold applies the EqualFold predicate on the "migration_run_id" field.
+func MigrationRunIDEqualFold(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldEqualFold(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDContainsFold applies the ContainsFold predicate on the "migration_run_id" field.
+func MigrationRunIDContainsFold(v string) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldContainsFold(FieldMigrationRunID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.EmailConnection {
 	return predicate.EmailConnection(sql.FieldEQ(FieldCreatedAt, v))
@@ -645,6 +810,99 @@ func LastSyncAtNotNil() predicate.EmailConnection {
 	return predicate.EmailConnection(sql.FieldNotNull(FieldLastSyncAt))
 }
 
+// SyncScheduleEQ applies the EQ predicate on the "sync_schedule" field.
+func SyncScheduleEQ(v SyncSchedule) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldEQ(FieldSyncSchedule, v))
+}
+
+// SyncScheduleNEQ applies the NEQ predicate on the "sync_schedule" field.
+func SyncScheduleNEQ(v SyncSchedule) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldNEQ(FieldSyncSchedule, v))
+}
+
+// SyncScheduleIn applies the In predicate on the "sync_schedule" field.
+func SyncScheduleIn(vs ...SyncSchedule) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldIn(FieldSyncSchedule, vs...))
+}
+
+// SyncScheduleNotIn applies the NotIn predicate on the "sync_schedule" field.
+func SyncScheduleNotIn(vs ...SyncSchedule) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldNotIn(FieldSyncSchedule, vs...))
+}
+
+// NextSyncAtEQ applies the EQ predicate on the "next_sync_at" field.
+func NextSyncAtEQ(v time.Time) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldEQ(FieldNextSyncAt, v))
+}
+
+// NextSyncAtNEQ applies the NEQ predicate on the "next_sync_at" field.
+func NextSyncAtNEQ(v time.Time) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldNEQ(FieldNextSyncAt, v))
+}
+
+// NextSyncAtIn applies the In predicate on the "next_sync_at" field.
+func NextSyncAtIn(vs ...time.Time) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldIn(FieldNextSyncAt, vs...))
+}
+
+// NextSyncAtNotIn applies the NotIn predicate on the "next_sync_at" field.
+func NextSyncAtNotIn(vs ...time.Time) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldNotIn(FieldNextSyncAt, vs...))
+}
+
+// NextSyncAtGT applies the GT predicate on the "next_sync_at" field.
+func NextSyncAtGT(v time.Time) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldGT(FieldNextSyncAt, v))
+}
+
+// NextSyncAtGTE applies the GTE predicate on the "next_sync_at" field.
+func NextSyncAtGTE(v time.Time) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldGTE(FieldNextSyncAt, v))
+}
+
+// NextSyncAtLT applies the LT predicate on the "next_sync_at" field.
+func NextSyncAtLT(v time.Time) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldLT(FieldNextSyncAt, v))
+}
+
+// NextSyncAtLTE applies the LTE predicate on the "next_sync_at" field.
+func NextSyncAtLTE(v time.Time) predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldLTE(FieldNextSyncAt, v))
+}
+
+// NextSyncAtIsNil applies the IsNil predicate on the "next_sync_at" field.
+func NextSyncAtIsNil() predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldIsNull(FieldNextSyncAt))
+}
+
+// NextSyncAtNotNil applies the NotNil predicate on the "next_sync_at" field.
+func NextSyncAtNotNil() predicate.EmailConnection {
+	return predicate.EmailConnection(sql.FieldNotNull(FieldNextSyncAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.EmailConnection {
+	return predicate.EmailConnection(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.EmailConnection {
+	return predicate.EmailConnection(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasLabels applies the HasEdge predicate on the "labels" edge.
 func HasLabels() predicate.EmailConnection {
 	return predicate.EmailConnection(func(s *sql.Selector) {
@@ -6,11 +6,14 @@ import (
 	"clockzen-next/internal/ent/emailconnection"
 	"clockzen-next/internal/ent/emaillabel"
 	"clockzen-next/internal/ent/emailsync"
+	"clockzen-next/internal/ent/user"
 	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -20,6 +23,7 @@ type EmailConnectionCreate struct {
 	config
 	mutation *EmailConnectionMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUserID sets the "user_id" field.
@@ -28,6 +32,20 @@ func (_c *EmailConnectionCreate) SetUserID(v string) *EmailConnectionCreate {
 	return _c
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (_c *EmailConnectionCreate) SetOrganizationID(v string) *EmailConnectionCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_c *EmailConnectionCreate) SetNillableOrganizationID(v *string) *EmailConnectionCreate {
+	if v != nil {
+		_c.SetOrganizationID(*v)
+	}
+	return _c
+}
+
 // SetProviderAccountID sets the "provider_account_id" field.
 func (_c *EmailConnectionCreate) SetProviderAccountID(v string) *EmailConnectionCreate {
 	_c.mutation.SetProviderAccountID(v)
@@ -78,6 +96,20 @@ func (_c *EmailConnectionCreate) SetNillableStatus(v *emailconnection.Status) *E
 	return _c
 }
 
+// SetMigrationRunID sets the "migration_run_id" field.
+func (_c *EmailConnectionCreate) SetMigrationRunID(v string) *EmailConnectionCreate {
+	_c.mutation.SetMigrationRunID(v)
+	return _c
+}
+
+// SetNillableMigrationRunID sets the "migration_run_id" field if the given value is not nil.
+func (_c *EmailConnectionCreate) SetNillableMigrationRunID(v *string) *EmailConnectionCreate {
+	if v != nil {
+		_c.SetMigrationRunID(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *EmailConnectionCreate) SetCreatedAt(v time.Time) *EmailConnectionCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -120,12 +152,45 @@ func (_c *EmailConnectionCreate) SetNillableLastSyncAt(v *time.Time) *EmailConne
 	return _c
 }
 
+// SetSyncSchedule sets the "sync_schedule" field.
+func (_c *EmailConnectionCreate) SetSyncSchedule(v emailconnection.SyncSchedule) *EmailConnectionCreate {
+	_c.mutation.SetSyncSchedule(v)
+	return _c
+}
+
+// SetNillableSyncSchedule sets the "sync_schedule" field if the given value is not nil.
+func (_c *EmailConnectionCreate) SetNillableSyncSchedule(v *emailconnection.SyncSchedule) *EmailConnectionCreate {
+	if v != nil {
+		_c.SetSyncSchedule(*v)
+	}
+	return _c
+}
+
+// SetNextSyncAt sets the "next_sync_at" field.
+func (_c *EmailConnectionCreate) SetNextSyncAt(v time.Time) *EmailConnectionCreate {
+	_c.mutation.SetNextSyncAt(v)
+	return _c
+}
+
+// SetNillableNextSyncAt sets the "next_sync_at" field if the given value is not nil.
+func (_c *EmailConnectionCreate) SetNillableNextSyncAt(v *time.Time) *EmailConnectionCreate {
+	if v != nil {
+		_c.SetNextSyncAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *EmailConnectionCreate) SetID(v string) *EmailConnectionCreate {
 	_c.mutation.SetID(v)
 	return _c
 }
 
+// SetUser sets the "user" edge to the User entity.
+func (_c *EmailConnectionCreate) SetUser(v *User) *EmailConnectionCreate {
+	return _c.SetUserID(v.ID)
+}
+
 // AddLabelIDs adds the "labels" edge to the EmailLabel entity by IDs.
 func (_c *EmailConnectionCreate) AddLabelIDs(ids ...string) *EmailConnectionCreate {
 	_c.mutation.AddLabelIDs(ids...)
@@ -203,6 +268,10 @@ func (_c *EmailConnectionCreate) defaults() {
 		v := emailconnection.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.SyncSchedule(); !ok {
+		v := emailconnection.DefaultSyncSchedule
+		_c.mutation.SetSyncSchedule(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -262,6 +331,17 @@ func (_c *EmailConnectionCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EmailConnection.updated_at"`)}
 	}
+	if _, ok := _c.mutation.SyncSchedule(); !ok {
+		return &ValidationError{Name: "sync_schedule", err: errors.New(`ent: missing required field "EmailConnection.sync_schedule"`)}
+	}
+	if v, ok := _c.mutation.SyncSchedule(); ok {
+		if err := emailconnection.SyncScheduleValidator(v); err != nil {
+			return &ValidationError{Name: "sync_schedule", err: fmt.Errorf(`ent: validator failed for field "EmailConnection.sync_schedule": %w`, err)}
+		}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "EmailConnection.user"`)}
+	}
 	return nil
 }
 
@@ -293,13 +373,14 @@ func (_c *EmailConnectionCreate) createSpec() (*EmailConnection, *sqlgraph.Creat
 		_node = &EmailConnection{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(emailconnection.Table, sqlgraph.NewFieldSpec(emailconnection.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
-	if value, ok := _c.mutation.UserID(); ok {
-		_spec.SetField(emailconnection.FieldUserID, field.TypeString, value)
-		_node.UserID = value
+	if value, ok := _c.mutation.OrganizationID(); ok {
+		_spec.SetField(emailconnection.FieldOrganizationID, field.TypeString, value)
+		_node.OrganizationID = &value
 	}
 	if value, ok := _c.mutation.ProviderAccountID(); ok {
 		_spec.SetField(emailconnection.FieldProviderAccountID, field.TypeString, value)
@@ -329,6 +410,10 @@ func (_c *EmailConnectionCreate) createSpec() (*EmailConnection, *sqlgraph.Creat
 		_spec.SetField(emailconnection.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.MigrationRunID(); ok {
+		_spec.SetField(emailconnection.FieldMigrationRunID, field.TypeString, value)
+		_node.MigrationRunID = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(emailconnection.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -341,6 +426,31 @@ func (_c *EmailConnectionCreate) createSpec() (*EmailConnection, *sqlgraph.Creat
 		_spec.SetField(emailconnection.FieldLastSyncAt, field.TypeTime, value)
 		_node.LastSyncAt = &value
 	}
+	if value, ok := _c.mutation.SyncSchedule(); ok {
+		_spec.SetField(emailconnection.FieldSyncSchedule, field.TypeEnum, value)
+		_node.SyncSchedule = value
+	}
+	if value, ok := _c.mutation.NextSyncAt(); ok {
+		_spec.SetField(emailconnection.FieldNextSyncAt, field.TypeTime, value)
+		_node.NextSyncAt = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailconnection.UserTable,
+			Columns: []string{emailconnection.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.LabelsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -376,11 +486,566 @@ func (_c *EmailConnectionCreate) createSpec() (*EmailConnection, *sqlgraph.Creat
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmailConnection.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmailConnectionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EmailConnectionCreate) OnConflict(opts ...sql.ConflictOption) *EmailConnectionUpsertOne {
+	_c.conflict = opts
+	return &EmailConnectionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmailConnection.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EmailConnectionCreate) OnConflictColumns(columns ...string) *EmailConnectionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EmailConnectionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EmailConnectionUpsertOne is the builder for "upsert"-ing
+	//  one EmailConnection node.
+	EmailConnectionUpsertOne struct {
+		create *EmailConnectionCreate
+	}
+
+	// EmailConnectionUpsert is the "OnConflict" setter.
+	EmailConnectionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *EmailConnectionUpsert) SetUserID(v string) *EmailConnectionUpsert {
+	u.Set(emailconnection.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmailConnectionUpsert) UpdateUserID() *EmailConnectionUpsert {
+	u.SetExcluded(emailconnection.FieldUserID)
+	return u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (u *EmailConnectionUpsert) SetOrganizationID(v string) *EmailConnectionUpsert {
+	u.Set(emailconnection.FieldOrganizationID, v)
+	return u
+}
+
+// UpdateOrganizationID sets the "organization_id" field to the value that was provided on create.
+func (u *EmailConnectionUpsert) UpdateOrganizationID() *EmailConnectionUpsert {
+	u.SetExcluded(emailconnection.FieldOrganizationID)
+	return u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (u *EmailConnectionUpsert) ClearOrganizationID() *EmailConnectionUpsert {
+	u.SetNull(emailconnection.FieldOrganizationID)
+	return u
+}
+
+// SetProviderAccountID sets the "provider_account_id" field.
+func (u *EmailConnectionUpsert) SetProviderAccountID(v string) *EmailConnectionUpsert {
+	u.Set(emailconnection.FieldProviderAccountID, v)
+	return u
+}
+
+// UpdateProviderAccountID sets the "provider_account_id" field to the value that was provided on create.
+func (u *EmailConnectionUpsert) UpdateProviderAccountID() *EmailConnectionUpsert {
+	u.SetExcluded(emailconnection.FieldProviderAccountID)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *EmailConnectionUpsert) SetEmail(v string) *EmailConnectionUpsert {
+	u.Set(emailconnection.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *EmailConnectionUpsert) UpdateEmail() *EmailConnectionUpsert {
+	u.SetExcluded(emailconnection.FieldEmail)
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *EmailConnectionUpsert) SetProvider(v emailconnection.Provider) *EmailConnectionUpsert {
+	u.Set(emailconnection.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *EmailConnectionUpsert) UpdateProvider() *EmailConnectionUpsert {
+	u.SetExcluded(emailconnection.FieldProvider)
+	return u
+}
+
+// SetAccessToken sets the "access_token" field.
+func (u *EmailConnectionUpsert) SetAccessToken(v string) *EmailConnectionUpsert {
+	u.Set(emailconnection.FieldAccessToken, v)
+	return u
+}
+
+// UpdateAccessToken sets the "access_token" field to the value that was provided on create.
+func (u *EmailConnectionUpsert) UpdateAccessToken() *EmailConnectionUpsert {
+	u.SetExcluded(emailconnection.FieldAccessToken)
+	return u
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (u *EmailConnectionUpsert) SetRefreshToken(v string) *EmailConnectionUpsert {
+	u.Set(emailconnection.FieldRefreshToken, v)
+	return u
+}
+
+// UpdateRefreshToken sets the "refresh_token" field to the value that was provided on create.
+func (u *EmailConnectionUpsert) UpdateRefreshToken() *EmailConnectionUpsert {
+	u.SetExcluded(emailconnection.FieldRefreshToken)
+	return u
+}
+
+// SetTokenExpiry sets the "token_expiry" field.
+func (u *EmailConnectionUpsert) SetTokenExpiry(v time.Time) *EmailConnectionUpsert {
+	u.Set(emailconnection.FieldTokenExpiry, v)
+	return u
+}
+
+// UpdateTokenExpiry sets the "token_expiry" field to the value that was provided on create.
+func (u *EmailConnectionUpsert) UpdateTokenExpiry() *EmailConnectionUpsert {
+	u.SetExcluded(emailconnection.FieldTokenExpiry)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *EmailConnectionUpsert) SetStatus(v emailconnection.Status) *EmailConnectionUpsert {
+	u.Set(emailconnection.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EmailConnectionUpsert) UpdateStatus() *EmailConnectionUpsert {
+	u.SetExcluded(emailconnection.FieldStatus)
+	return u
+}
+
+// SetMigrationRunID sets the "migration_run_id" field.
+func (u *EmailConnectionUpsert) SetMigrationRunID(v string) *EmailConnectionUpsert {
+	u.Set(emailconnection.FieldMigrationRunID, v)
+	return u
+}
+
+// UpdateMigrationRunID sets the "migration_run_id" field to the value that was provided on create.
+func (u *EmailConnectionUpsert) UpdateMigrationRunID() *EmailConnectionUpsert {
+	u.SetExcluded(emailconnection.FieldMigrationRunID)
+	return u
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (u *EmailConnectionUpsert) ClearMigrationRunID() *EmailConnectionUpsert {
+	u.SetNull(emailconnection.FieldMigrationRunID)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmailConnectionUpsert) SetUpdatedAt(v time.Time) *EmailConnectionUpsert {
+	u.Set(emailconnection.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmailConnectionUpsert) UpdateUpdatedAt() *EmailConnectionUpsert {
+	u.SetExcluded(emailconnection.FieldUpdatedAt)
+	return u
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (u *EmailConnectionUpsert) SetLastSyncAt(v time.Time) *EmailConnectionUpsert {
+	u.Set(emailconnection.FieldLastSyncAt, v)
+	return u
+}
+
+// UpdateLastSyncAt sets the "last_sync_at" field to the value that was provided on create.
+func (u *EmailConnectionUpsert) UpdateLastSyncAt() *EmailConnectionUpsert {
+	u.SetExcluded(emailconnection.FieldLastSyncAt)
+	return u
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (u *EmailConnectionUpsert) ClearLastSyncAt() *EmailConnectionUpsert {
+	u.SetNull(emailconnection.FieldLastSyncAt)
+	return u
+}
+
+// SetSyncSchedule sets the "sync_schedule" field.
+func (u *EmailConnectionUpsert) SetSyncSchedule(v emailconnection.SyncSchedule) *EmailConnectionUpsert {
+	u.Set(emailconnection.FieldSyncSchedule, v)
+	return u
+}
+
+// UpdateSyncSchedule sets the "sync_schedule" field to the value that was provided on create.
+func (u *EmailConnectionUpsert) UpdateSyncSchedule() *EmailConnectionUpsert {
+	u.SetExcluded(emailconnection.FieldSyncSchedule)
+	return u
+}
+
+// SetNextSyncAt sets the "next_sync_at" field.
+func (u *EmailConnectionUpsert) SetNextSyncAt(v time.Time) *EmailConnectionUpsert {
+	u.Set(emailconnection.FieldNextSyncAt, v)
+	return u
+}
+
+// UpdateNextSyncAt sets the "next_sync_at" field to the value that was provided on create.
+func (u *EmailConnectionUpsert) UpdateNextSyncAt() *EmailConnectionUpsert {
+	u.SetExcluded(emailconnection.FieldNextSyncAt)
+	return u
+}
+
+// ClearNextSyncAt clears the value of the "next_sync_at" field.
+func (u *EmailConnectionUpsert) ClearNextSyncAt() *EmailConnectionUpsert {
+	u.SetNull(emailconnection.FieldNextSyncAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EmailConnection.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emailconnection.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmailConnectionUpsertOne) UpdateNewValues() *EmailConnectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(emailconnection.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(emailconnection.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmailConnection.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EmailConnectionUpsertOne) Ignore() *EmailConnectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmailConnectionUpsertOne) DoNothing() *EmailConnectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmailConnectionCreate.OnConflict
+// documentation for more info.
+func (u *EmailConnectionUpsertOne) Update(set func(*EmailConnectionUpsert)) *EmailConnectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmailConnectionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *EmailConnectionUpsertOne) SetUserID(v string) *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmailConnectionUpsertOne) UpdateUserID() *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (u *EmailConnectionUpsertOne) SetOrganizationID(v string) *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetOrganizationID(v)
+	})
+}
+
+// UpdateOrganizationID sets the "organization_id" field to the value that was provided on create.
+func (u *EmailConnectionUpsertOne) UpdateOrganizationID() *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateOrganizationID()
+	})
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (u *EmailConnectionUpsertOne) ClearOrganizationID() *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.ClearOrganizationID()
+	})
+}
+
+// SetProviderAccountID sets the "provider_account_id" field.
+func (u *EmailConnectionUpsertOne) SetProviderAccountID(v string) *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetProviderAccountID(v)
+	})
+}
+
+// UpdateProviderAccountID sets the "provider_account_id" field to the value that was provided on create.
+func (u *EmailConnectionUpsertOne) UpdateProviderAccountID() *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateProviderAccountID()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *EmailConnectionUpsertOne) SetEmail(v string) *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *EmailConnectionUpsertOne) UpdateEmail() *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *EmailConnectionUpsertOne) SetProvider(v emailconnection.Provider) *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *EmailConnectionUpsertOne) UpdateProvider() *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetAccessToken sets the "access_token" field.
+func (u *EmailConnectionUpsertOne) SetAccessToken(v string) *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetAccessToken(v)
+	})
+}
+
+// UpdateAccessToken sets the "access_token" field to the value that was provided on create.
+func (u *EmailConnectionUpsertOne) UpdateAccessToken() *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateAccessToken()
+	})
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (u *EmailConnectionUpsertOne) SetRefreshToken(v string) *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetRefreshToken(v)
+	})
+}
+
+// UpdateRefreshToken sets the "refresh_token" field to the value that was provided on create.
+func (u *EmailConnectionUpsertOne) UpdateRefreshToken() *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateRefreshToken()
+	})
+}
+
+// SetTokenExpiry sets the "token_expiry" field.
+func (u *EmailConnectionUpsertOne) SetTokenExpiry(v time.Time) *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetTokenExpiry(v)
+	})
+}
+
+// UpdateTokenExpiry sets the "token_expiry" field to the value that was provided on create.
+func (u *EmailConnectionUpsertOne) UpdateTokenExpiry() *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateTokenExpiry()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *EmailConnectionUpsertOne) SetStatus(v emailconnection.Status) *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EmailConnectionUpsertOne) UpdateStatus() *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMigrationRunID sets the "migration_run_id" field.
+func (u *EmailConnectionUpsertOne) SetMigrationRunID(v string) *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetMigrationRunID(v)
+	})
+}
+
+// UpdateMigrationRunID sets the "migration_run_id" field to the value that was provided on create.
+func (u *EmailConnectionUpsertOne) UpdateMigrationRunID() *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateMigrationRunID()
+	})
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (u *EmailConnectionUpsertOne) ClearMigrationRunID() *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.ClearMigrationRunID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmailConnectionUpsertOne) SetUpdatedAt(v time.Time) *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmailConnectionUpsertOne) UpdateUpdatedAt() *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (u *EmailConnectionUpsertOne) SetLastSyncAt(v time.Time) *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetLastSyncAt(v)
+	})
+}
+
+// UpdateLastSyncAt sets the "last_sync_at" field to the value that was provided on create.
+func (u *EmailConnectionUpsertOne) UpdateLastSyncAt() *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateLastSyncAt()
+	})
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (u *EmailConnectionUpsertOne) ClearLastSyncAt() *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.ClearLastSyncAt()
+	})
+}
+
+// SetSyncSchedule sets the "sync_schedule" field.
+func (u *EmailConnectionUpsertOne) SetSyncSchedule(v emailconnection.SyncSchedule) *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetSyncSchedule(v)
+	})
+}
+
+// UpdateSyncSchedule sets the "sync_schedule" field to the value that was provided on create.
+func (u *EmailConnectionUpsertOne) UpdateSyncSchedule() *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateSyncSchedule()
+	})
+}
+
+// SetNextSyncAt sets the "next_sync_at" field.
+func (u *EmailConnectionUpsertOne) SetNextSyncAt(v time.Time) *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetNextSyncAt(v)
+	})
+}
+
+// UpdateNextSyncAt sets the "next_sync_at" field to the value that was provided on create.
+func (u *EmailConnectionUpsertOne) UpdateNextSyncAt() *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateNextSyncAt()
+	})
+}
+
+// ClearNextSyncAt clears the value of the "next_sync_at" field.
+func (u *EmailConnectionUpsertOne) ClearNextSyncAt() *EmailConnectionUpsertOne {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.ClearNextSyncAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmailConnectionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmailConnectionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmailConnectionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EmailConnectionUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EmailConnectionUpsertOne.ID is not supported by MySQL driver. Use EmailConnectionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EmailConnectionUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // EmailConnectionCreateBulk is the builder for creating many EmailConnection entities in bulk.
 type EmailConnectionCreateBulk struct {
 	config
 	err      error
 	builders []*EmailConnectionCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the EmailConnection entities in the database.
@@ -410,6 +1075,7 @@ func (_c *EmailConnectionCreateBulk) Save(ctx context.Context) ([]*EmailConnecti
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -459,3 +1125,344 @@ func (_c *EmailConnectionCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmailConnection.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmailConnectionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EmailConnectionCreateBulk) OnConflict(opts ...sql.ConflictOption) *EmailConnectionUpsertBulk {
+	_c.conflict = opts
+	return &EmailConnectionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmailConnection.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EmailConnectionCreateBulk) OnConflictColumns(columns ...string) *EmailConnectionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EmailConnectionUpsertBulk{
+		create: _c,
+	}
+}
+
+// EmailConnectionUpsertBulk is the builder for "upsert"-ing
+// a bulk of EmailConnection nodes.
+type EmailConnectionUpsertBulk struct {
+	create *EmailConnectionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EmailConnection.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emailconnection.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmailConnectionUpsertBulk) UpdateNewValues() *EmailConnectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(emailconnection.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(emailconnection.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmailConnection.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EmailConnectionUpsertBulk) Ignore() *EmailConnectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmailConnectionUpsertBulk) DoNothing() *EmailConnectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmailConnectionCreateBulk.OnConflict
+// documentation for more info.
+func (u *EmailConnectionUpsertBulk) Update(set func(*EmailConnectionUpsert)) *EmailConnectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmailConnectionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *EmailConnectionUpsertBulk) SetUserID(v string) *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmailConnectionUpsertBulk) UpdateUserID() *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (u *EmailConnectionUpsertBulk) SetOrganizationID(v string) *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetOrganizationID(v)
+	})
+}
+
+// UpdateOrganizationID sets the "organization_id" field to the value that was provided on create.
+func (u *EmailConnectionUpsertBulk) UpdateOrganizationID() *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateOrganizationID()
+	})
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (u *EmailConnectionUpsertBulk) ClearOrganizationID() *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.ClearOrganizationID()
+	})
+}
+
+// SetProviderAccountID sets the "provider_account_id" field.
+func (u *EmailConnectionUpsertBulk) SetProviderAccountID(v string) *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetProviderAccountID(v)
+	})
+}
+
+// UpdateProviderAccountID sets the "provider_account_id" field to the value that was provided on create.
+func (u *EmailConnectionUpsertBulk) UpdateProviderAccountID() *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateProviderAccountID()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *EmailConnectionUpsertBulk) SetEmail(v string) *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *EmailConnectionUpsertBulk) UpdateEmail() *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *EmailConnectionUpsertBulk) SetProvider(v emailconnection.Provider) *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *EmailConnectionUpsertBulk) UpdateProvider() *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetAccessToken sets the "access_token" field.
+func (u *EmailConnectionUpsertBulk) SetAccessToken(v string) *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetAccessToken(v)
+	})
+}
+
+// UpdateAccessToken sets the "access_token" field to the value that was provided on create.
+func (u *EmailConnectionUpsertBulk) UpdateAccessToken() *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateAccessToken()
+	})
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (u *EmailConnectionUpsertBulk) SetRefreshToken(v string) *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetRefreshToken(v)
+	})
+}
+
+// UpdateRefreshToken sets the "refresh_token" field to the value that was provided on create.
+func (u *EmailConnectionUpsertBulk) UpdateRefreshToken() *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateRefreshToken()
+	})
+}
+
+// SetTokenExpiry sets the "token_expiry" field.
+func (u *EmailConnectionUpsertBulk) SetTokenExpiry(v time.Time) *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetTokenExpiry(v)
+	})
+}
+
+// UpdateTokenExpiry sets the "token_expiry" field to the value that was provided on create.
+func (u *EmailConnectionUpsertBulk) UpdateTokenExpiry() *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateTokenExpiry()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *EmailConnectionUpsertBulk) SetStatus(v emailconnection.Status) *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EmailConnectionUpsertBulk) UpdateStatus() *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMigrationRunID sets the "migration_run_id" field.
+func (u *EmailConnectionUpsertBulk) SetMigrationRunID(v string) *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetMigrationRunID(v)
+	})
+}
+
+// UpdateMigrationRunID sets the "migration_run_id" field to the value that was provided on create.
+func (u *EmailConnectionUpsertBulk) UpdateMigrationRunID() *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateMigrationRunID()
+	})
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (u *EmailConnectionUpsertBulk) ClearMigrationRunID() *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.ClearMigrationRunID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmailConnectionUpsertBulk) SetUpdatedAt(v time.Time) *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmailConnectionUpsertBulk) UpdateUpdatedAt() *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (u *EmailConnectionUpsertBulk) SetLastSyncAt(v time.Time) *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetLastSyncAt(v)
+	})
+}
+
+// UpdateLastSyncAt sets the "last_sync_at" field to the value that was provided on create.
+func (u *EmailConnectionUpsertBulk) UpdateLastSyncAt() *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateLastSyncAt()
+	})
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (u *EmailConnectionUpsertBulk) ClearLastSyncAt() *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.ClearLastSyncAt()
+	})
+}
+
+// SetSyncSchedule sets the "sync_schedule" field.
+func (u *EmailConnectionUpsertBulk) SetSyncSchedule(v emailconnection.SyncSchedule) *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetSyncSchedule(v)
+	})
+}
+
+// UpdateSyncSchedule sets the "sync_schedule" field to the value that was provided on create.
+func (u *EmailConnectionUpsertBulk) UpdateSyncSchedule() *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateSyncSchedule()
+	})
+}
+
+// SetNextSyncAt sets the "next_sync_at" field.
+func (u *EmailConnectionUpsertBulk) SetNextSyncAt(v time.Time) *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.SetNextSyncAt(v)
+	})
+}
+
+// UpdateNextSyncAt sets the "next_sync_at" field to the value that was provided on create.
+func (u *EmailConnectionUpsertBulk) UpdateNextSyncAt() *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.UpdateNextSyncAt()
+	})
+}
+
+// ClearNextSyncAt clears the value of the "next_sync_at" field.
+func (u *EmailConnectionUpsertBulk) ClearNextSyncAt() *EmailConnectionUpsertBulk {
+	return u.Update(func(s *EmailConnectionUpsert) {
+		s.ClearNextSyncAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmailConnectionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EmailConnectionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmailConnectionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmailConnectionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"clockzen-next/internal/ent/emaillabel"
 	"clockzen-next/internal/ent/emailsync"
 	"clockzen-next/internal/ent/predicate"
+	"clockzen-next/internal/ent/user"
 	"context"
 	"database/sql/driver"
 	"fmt"
@@ -25,6 +26,7 @@ type EmailConnectionQuery struct {
 	order      []emailconnection.OrderOption
 	inters     []Interceptor
 	predicates []predicate.EmailConnection
+	withUser   *UserQuery
 	withLabels *EmailLabelQuery
 	withSyncs  *EmailSyncQuery
 	// intermediate query (i.e. traversal path).
@@ -63,6 +65,28 @@ func (_q *EmailConnectionQuery) Order(o ...emailconnection.OrderOption) *EmailCo
 	return _q
 }
 
+// QueryUser chains the current query on the "user" edge.
+func (_q *EmailConnectionQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emailconnection.Table, emailconnection.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, emailconnection.UserTable, emailconnection.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryLabels chains the current query on the "labels" edge.
 func (_q *EmailConnectionQuery) QueryLabels() *EmailLabelQuery {
 	query := (&EmailLabelClient{config: _q.config}).Query()
@@ -299,6 +323,7 @@ func (_q *EmailConnectionQuery) Clone() *EmailConnectionQuery {
 		order:      append([]emailconnection.OrderOption{}, _q.order...),
 		inters:     append([]Interceptor{}, _q.inters...),
 		predicates: append([]predicate.EmailConnection{}, _q.predicates...),
+		withUser:   _q.withUser.Clone(),
 		withLabels: _q.withLabels.Clone(),
 		withSyncs:  _q.withSyncs.Clone(),
 		// clone intermediate query.
@@ -307,6 +332,17 @@ func (_q *EmailConnectionQuery) Clone() *EmailConnectionQuery {
 	}
 }
 
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EmailConnectionQuery) WithUser(opts ...func(*UserQuery)) *EmailConnectionQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withUser = query
+	return _q
+}
+
 // WithLabels tells the query-builder to eager-load the nodes that are connected to
 // the "labels" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *EmailConnectionQuery) WithLabels(opts ...func(*EmailLabelQuery)) *EmailConnectionQuery {
@@ -407,7 +443,8 @@ func (_q *EmailConnectionQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	var (
 		nodes       = []*EmailConnection{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
+			_q.withUser != nil,
 			_q.withLabels != nil,
 			_q.withSyncs != nil,
 		}
@@ -430,6 +467,12 @@ func (_q *EmailConnectionQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := _q.withUser; query != nil {
+		if err := _q.loadUser(ctx, query, nodes, nil,
+			func(n *EmailConnection, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withLabels; query != nil {
 		if err := _q.loadLabels(ctx, query, nodes,
 			func(n *EmailConnection) { n.Edges.Labels = []*EmailLabel{} },
@@ -447,6 +490,35 @@ func (_q *EmailConnectionQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	return nodes, nil
 }
 
+func (_q *EmailConnectionQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*EmailConnection, init func(*EmailConnection), assign func(*EmailConnection, *User)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*EmailConnection)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *EmailConnectionQuery) loadLabels(ctx context.Context, query *EmailLabelQuery, nodes []*EmailConnection, init func(*EmailConnection), assign func(*EmailConnection, *EmailLabel)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*EmailConnection)
@@ -533,6 +605,9 @@ func (_q *EmailConnectionQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withUser != nil {
+			_spec.Node.AddColumnOnce(emailconnection.FieldUserID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
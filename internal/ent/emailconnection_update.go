@@ -7,6 +7,7 @@ import (
 	"clockzen-next/internal/ent/emaillabel"
 	"clockzen-next/internal/ent/emailsync"
 	"clockzen-next/internal/ent/predicate"
+	"clockzen-next/internal/ent/user"
 	"context"
 	"errors"
 	"fmt"
@@ -44,6 +45,26 @@ func (_u *EmailConnectionUpdate) SetNillableUserID(v *string) *EmailConnectionUp
 	return _u
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (_u *EmailConnectionUpdate) SetOrganizationID(v string) *EmailConnectionUpdate {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *EmailConnectionUpdate) SetNillableOrganizationID(v *string) *EmailConnectionUpdate {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (_u *EmailConnectionUpdate) ClearOrganizationID() *EmailConnectionUpdate {
+	_u.mutation.ClearOrganizationID()
+	return _u
+}
+
 // SetProviderAccountID sets the "provider_account_id" field.
 func (_u *EmailConnectionUpdate) SetProviderAccountID(v string) *EmailConnectionUpdate {
 	_u.mutation.SetProviderAccountID(v)
@@ -142,6 +163,26 @@ func (_u *EmailConnectionUpdate) SetNillableStatus(v *emailconnection.Status) *E
 	return _u
 }
 
+// SetMigrationRunID sets the "migration_run_id" field.
+func (_u *EmailConnectionUpdate) SetMigrationRunID(v string) *EmailConnectionUpdate {
+	_u.mutation.SetMigrationRunID(v)
+	return _u
+}
+
+// SetNillableMigrationRunID sets the "migration_run_id" field if the given value is not nil.
+func (_u *EmailConnectionUpdate) SetNillableMigrationRunID(v *string) *EmailConnectionUpdate {
+	if v != nil {
+		_u.SetMigrationRunID(*v)
+	}
+	return _u
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (_u *EmailConnectionUpdate) ClearMigrationRunID() *EmailConnectionUpdate {
+	_u.mutation.ClearMigrationRunID()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *EmailConnectionUpdate) SetUpdatedAt(v time.Time) *EmailConnectionUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -168,6 +209,45 @@ func (_u *EmailConnectionUpdate) ClearLastSyncAt() *EmailConnectionUpdate {
 	return _u
 }
 
+// SetSyncSchedule sets the "sync_schedule" field.
+func (_u *EmailConnectionUpdate) SetSyncSchedule(v emailconnection.SyncSchedule) *EmailConnectionUpdate {
+	_u.mutation.SetSyncSchedule(v)
+	return _u
+}
+
+// SetNillableSyncSchedule sets the "sync_schedule" field if the given value is not nil.
+func (_u *EmailConnectionUpdate) SetNillableSyncSchedule(v *emailconnection.SyncSchedule) *EmailConnectionUpdate {
+	if v != nil {
+		_u.SetSyncSchedule(*v)
+	}
+	return _u
+}
+
+// SetNextSyncAt sets the "next_sync_at" field.
+func (_u *EmailConnectionUpdate) SetNextSyncAt(v time.Time) *EmailConnectionUpdate {
+	_u.mutation.SetNextSyncAt(v)
+	return _u
+}
+
+// SetNillableNextSyncAt sets the "next_sync_at" field if the given value is not nil.
+func (_u *EmailConnectionUpdate) SetNillableNextSyncAt(v *time.Time) *EmailConnectionUpdate {
+	if v != nil {
+		_u.SetNextSyncAt(*v)
+	}
+	return _u
+}
+
+// ClearNextSyncAt clears the value of the "next_sync_at" field.
+func (_u *EmailConnectionUpdate) ClearNextSyncAt() *EmailConnectionUpdate {
+	_u.mutation.ClearNextSyncAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *EmailConnectionUpdate) SetUser(v *User) *EmailConnectionUpdate {
+	return _u.SetUserID(v.ID)
+}
+
 // AddLabelIDs adds the "labels" edge to the EmailLabel entity by IDs.
 func (_u *EmailConnectionUpdate) AddLabelIDs(ids ...string) *EmailConnectionUpdate {
 	_u.mutation.AddLabelIDs(ids...)
@@ -203,6 +283,12 @@ func (_u *EmailConnectionUpdate) Mutation() *EmailConnectionMutation {
 	return _u.mutation
 }
 
+// ClearUser clears the "user" edge to the User entity.
+func (_u *EmailConnectionUpdate) ClearUser() *EmailConnectionUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
 // ClearLabels clears all "labels" edges to the EmailLabel entity.
 func (_u *EmailConnectionUpdate) ClearLabels() *EmailConnectionUpdate {
 	_u.mutation.ClearLabels()
@@ -308,6 +394,14 @@ func (_u *EmailConnectionUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmailConnection.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SyncSchedule(); ok {
+		if err := emailconnection.SyncScheduleValidator(v); err != nil {
+			return &ValidationError{Name: "sync_schedule", err: fmt.Errorf(`ent: validator failed for field "EmailConnection.sync_schedule": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmailConnection.user"`)
+	}
 	return nil
 }
 
@@ -323,8 +417,11 @@ func (_u *EmailConnectionUpdate) sqlSave(ctx context.Context) (_node int, err er
 			}
 		}
 	}
-	if value, ok := _u.mutation.UserID(); ok {
-		_spec.SetField(emailconnection.FieldUserID, field.TypeString, value)
+	if value, ok := _u.mutation.OrganizationID(); ok {
+		_spec.SetField(emailconnection.FieldOrganizationID, field.TypeString, value)
+	}
+	if _u.mutation.OrganizationIDCleared() {
+		_spec.ClearField(emailconnection.FieldOrganizationID, field.TypeString)
 	}
 	if value, ok := _u.mutation.ProviderAccountID(); ok {
 		_spec.SetField(emailconnection.FieldProviderAccountID, field.TypeString, value)
@@ -347,6 +444,12 @@ func (_u *EmailConnectionUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(emailconnection.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.MigrationRunID(); ok {
+		_spec.SetField(emailconnection.FieldMigrationRunID, field.TypeString, value)
+	}
+	if _u.mutation.MigrationRunIDCleared() {
+		_spec.ClearField(emailconnection.FieldMigrationRunID, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(emailconnection.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -356,6 +459,44 @@ func (_u *EmailConnectionUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if _u.mutation.LastSyncAtCleared() {
 		_spec.ClearField(emailconnection.FieldLastSyncAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.SyncSchedule(); ok {
+		_spec.SetField(emailconnection.FieldSyncSchedule, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.NextSyncAt(); ok {
+		_spec.SetField(emailconnection.FieldNextSyncAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextSyncAtCleared() {
+		_spec.ClearField(emailconnection.FieldNextSyncAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailconnection.UserTable,
+			Columns: []string{emailconnection.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailconnection.UserTable,
+			Columns: []string{emailconnection.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.LabelsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -480,6 +621,26 @@ func (_u *EmailConnectionUpdateOne) SetNillableUserID(v *string) *EmailConnectio
 	return _u
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (_u *EmailConnectionUpdateOne) SetOrganizationID(v string) *EmailConnectionUpdateOne {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *EmailConnectionUpdateOne) SetNillableOrganizationID(v *string) *EmailConnectionUpdateOne {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (_u *EmailConnectionUpdateOne) ClearOrganizationID() *EmailConnectionUpdateOne {
+	_u.mutation.ClearOrganizationID()
+	return _u
+}
+
 // SetProviderAccountID sets the "provider_account_id" field.
 func (_u *EmailConnectionUpdateOne) SetProviderAccountID(v string) *EmailConnectionUpdateOne {
 	_u.mutation.SetProviderAccountID(v)
@@ -578,6 +739,26 @@ func (_u *EmailConnectionUpdateOne) SetNillableStatus(v *emailconnection.Status)
 	return _u
 }
 
+// SetMigrationRunID sets the "migration_run_id" field.
+func (_u *EmailConnectionUpdateOne) SetMigrationRunID(v string) *EmailConnectionUpdateOne {
+	_u.mutation.SetMigrationRunID(v)
+	return _u
+}
+
+// SetNillableMigrationRunID sets the "migration_run_id" field if the given value is not nil.
+func (_u *EmailConnectionUpdateOne) SetNillableMigrationRunID(v *string) *EmailConnectionUpdateOne {
+	if v != nil {
+		_u.SetMigrationRunID(*v)
+	}
+	return _u
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (_u *EmailConnectionUpdateOne) ClearMigrationRunID() *EmailConnectionUpdateOne {
+	_u.mutation.ClearMigrationRunID()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *EmailConnectionUpdateOne) SetUpdatedAt(v time.Time) *EmailConnectionUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -604,6 +785,45 @@ func (_u *EmailConnectionUpdateOne) ClearLastSyncAt() *EmailConnectionUpdateOne
 	return _u
 }
 
+// SetSyncSchedule sets the "sync_schedule" field.
+func (_u *EmailConnectionUpdateOne) SetSyncSchedule(v emailconnection.SyncSchedule) *EmailConnectionUpdateOne {
+	_u.mutation.SetSyncSchedule(v)
+	return _u
+}
+
+// SetNillableSyncSchedule sets the "sync_schedule" field if the given value is not nil.
+func (_u *EmailConnectionUpdateOne) SetNillableSyncSchedule(v *emailconnection.SyncSchedule) *EmailConnectionUpdateOne {
+	if v != nil {
+		_u.SetSyncSchedule(*v)
+	}
+	return _u
+}
+
+// SetNextSyncAt sets the "next_sync_at" field.
+func (_u *EmailConnectionUpdateOne) SetNextSyncAt(v time.Time) *EmailConnectionUpdateOne {
+	_u.mutation.SetNextSyncAt(v)
+	return _u
+}
+
+// SetNillableNextSyncAt sets the "next_sync_at" field if the given value is not nil.
+func (_u *EmailConnectionUpdateOne) SetNillableNextSyncAt(v *time.Time) *EmailConnectionUpdateOne {
+	if v != nil {
+		_u.SetNextSyncAt(*v)
+	}
+	return _u
+}
+
+// ClearNextSyncAt clears the value of the "next_sync_at" field.
+func (_u *EmailConnectionUpdateOne) ClearNextSyncAt() *EmailConnectionUpdateOne {
+	_u.mutation.ClearNextSyncAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *EmailConnectionUpdateOne) SetUser(v *User) *EmailConnectionUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
 // AddLabelIDs adds the "labels" edge to the EmailLabel entity by IDs.
 func (_u *EmailConnectionUpdateOne) AddLabelIDs(ids ...string) *EmailConnectionUpdateOne {
 	_u.mutation.AddLabelIDs(ids...)
@@ -639,6 +859,12 @@ func (_u *EmailConnectionUpdateOne) Mutation() *EmailConnectionMutation {
 	return _u.mutation
 }
 
+// ClearUser clears the "user" edge to the User entity.
+func (_u *EmailConnectionUpdateOne) ClearUser() *EmailConnectionUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
 // ClearLabels clears all "labels" edges to the EmailLabel entity.
 func (_u *EmailConnectionUpdateOne) ClearLabels() *EmailConnectionUpdateOne {
 	_u.mutation.ClearLabels()
@@ -757,6 +983,14 @@ func (_u *EmailConnectionUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmailConnection.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SyncSchedule(); ok {
+		if err := emailconnection.SyncScheduleValidator(v); err != nil {
+			return &ValidationError{Name: "sync_schedule", err: fmt.Errorf(`ent: validator failed for field "EmailConnection.sync_schedule": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmailConnection.user"`)
+	}
 	return nil
 }
 
@@ -789,8 +1023,11 @@ func (_u *EmailConnectionUpdateOne) sqlSave(ctx context.Context) (_node *EmailCo
 			}
 		}
 	}
-	if value, ok := _u.mutation.UserID(); ok {
-		_spec.SetField(emailconnection.FieldUserID, field.TypeString, value)
+	if value, ok := _u.mutation.OrganizationID(); ok {
+		_spec.SetField(emailconnection.FieldOrganizationID, field.TypeString, value)
+	}
+	if _u.mutation.OrganizationIDCleared() {
+		_spec.ClearField(emailconnection.FieldOrganizationID, field.TypeString)
 	}
 	if value, ok := _u.mutation.ProviderAccountID(); ok {
 		_spec.SetField(emailconnection.FieldProviderAccountID, field.TypeString, value)
@@ -813,6 +1050,12 @@ func (_u *EmailConnectionUpdateOne) sqlSave(ctx context.Context) (_node *EmailCo
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(emailconnection.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.MigrationRunID(); ok {
+		_spec.SetField(emailconnection.FieldMigrationRunID, field.TypeString, value)
+	}
+	if _u.mutation.MigrationRunIDCleared() {
+		_spec.ClearField(emailconnection.FieldMigrationRunID, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(emailconnection.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -822,6 +1065,44 @@ func (_u *EmailConnectionUpdateOne) sqlSave(ctx context.Context) (_node *EmailCo
 	if _u.mutation.LastSyncAtCleared() {
 		_spec.ClearField(emailconnection.FieldLastSyncAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.SyncSchedule(); ok {
+		_spec.SetField(emailconnection.FieldSyncSchedule, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.NextSyncAt(); ok {
+		_spec.SetField(emailconnection.FieldNextSyncAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextSyncAtCleared() {
+		_spec.ClearField(emailconnection.FieldNextSyncAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailconnection.UserTable,
+			Columns: []string{emailconnection.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailconnection.UserTable,
+			Columns: []string{emailconnection.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.LabelsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
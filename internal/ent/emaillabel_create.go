@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -19,6 +21,7 @@ type EmailLabelCreate struct {
 	config
 	mutation *EmailLabelMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetConnectionID sets the "connection_id" field.
@@ -334,6 +337,7 @@ func (_c *EmailLabelCreate) createSpec() (*EmailLabel, *sqlgraph.CreateSpec) {
 		_node = &EmailLabel{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(emaillabel.Table, sqlgraph.NewFieldSpec(emaillabel.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -406,11 +410,540 @@ func (_c *EmailLabelCreate) createSpec() (*EmailLabel, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmailLabel.Create().
+//		SetConnectionID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmailLabelUpsert) {
+//			SetConnectionID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EmailLabelCreate) OnConflict(opts ...sql.ConflictOption) *EmailLabelUpsertOne {
+	_c.conflict = opts
+	return &EmailLabelUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmailLabel.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EmailLabelCreate) OnConflictColumns(columns ...string) *EmailLabelUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EmailLabelUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EmailLabelUpsertOne is the builder for "upsert"-ing
+	//  one EmailLabel node.
+	EmailLabelUpsertOne struct {
+		create *EmailLabelCreate
+	}
+
+	// EmailLabelUpsert is the "OnConflict" setter.
+	EmailLabelUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetConnectionID sets the "connection_id" field.
+func (u *EmailLabelUpsert) SetConnectionID(v string) *EmailLabelUpsert {
+	u.Set(emaillabel.FieldConnectionID, v)
+	return u
+}
+
+// UpdateConnectionID sets the "connection_id" field to the value that was provided on create.
+func (u *EmailLabelUpsert) UpdateConnectionID() *EmailLabelUpsert {
+	u.SetExcluded(emaillabel.FieldConnectionID)
+	return u
+}
+
+// SetProviderLabelID sets the "provider_label_id" field.
+func (u *EmailLabelUpsert) SetProviderLabelID(v string) *EmailLabelUpsert {
+	u.Set(emaillabel.FieldProviderLabelID, v)
+	return u
+}
+
+// UpdateProviderLabelID sets the "provider_label_id" field to the value that was provided on create.
+func (u *EmailLabelUpsert) UpdateProviderLabelID() *EmailLabelUpsert {
+	u.SetExcluded(emaillabel.FieldProviderLabelID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *EmailLabelUpsert) SetName(v string) *EmailLabelUpsert {
+	u.Set(emaillabel.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EmailLabelUpsert) UpdateName() *EmailLabelUpsert {
+	u.SetExcluded(emaillabel.FieldName)
+	return u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *EmailLabelUpsert) SetDisplayName(v string) *EmailLabelUpsert {
+	u.Set(emaillabel.FieldDisplayName, v)
+	return u
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *EmailLabelUpsert) UpdateDisplayName() *EmailLabelUpsert {
+	u.SetExcluded(emaillabel.FieldDisplayName)
+	return u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (u *EmailLabelUpsert) ClearDisplayName() *EmailLabelUpsert {
+	u.SetNull(emaillabel.FieldDisplayName)
+	return u
+}
+
+// SetLabelType sets the "label_type" field.
+func (u *EmailLabelUpsert) SetLabelType(v emaillabel.LabelType) *EmailLabelUpsert {
+	u.Set(emaillabel.FieldLabelType, v)
+	return u
+}
+
+// UpdateLabelType sets the "label_type" field to the value that was provided on create.
+func (u *EmailLabelUpsert) UpdateLabelType() *EmailLabelUpsert {
+	u.SetExcluded(emaillabel.FieldLabelType)
+	return u
+}
+
+// SetParentLabelID sets the "parent_label_id" field.
+func (u *EmailLabelUpsert) SetParentLabelID(v string) *EmailLabelUpsert {
+	u.Set(emaillabel.FieldParentLabelID, v)
+	return u
+}
+
+// UpdateParentLabelID sets the "parent_label_id" field to the value that was provided on create.
+func (u *EmailLabelUpsert) UpdateParentLabelID() *EmailLabelUpsert {
+	u.SetExcluded(emaillabel.FieldParentLabelID)
+	return u
+}
+
+// ClearParentLabelID clears the value of the "parent_label_id" field.
+func (u *EmailLabelUpsert) ClearParentLabelID() *EmailLabelUpsert {
+	u.SetNull(emaillabel.FieldParentLabelID)
+	return u
+}
+
+// SetSyncEnabled sets the "sync_enabled" field.
+func (u *EmailLabelUpsert) SetSyncEnabled(v bool) *EmailLabelUpsert {
+	u.Set(emaillabel.FieldSyncEnabled, v)
+	return u
+}
+
+// UpdateSyncEnabled sets the "sync_enabled" field to the value that was provided on create.
+func (u *EmailLabelUpsert) UpdateSyncEnabled() *EmailLabelUpsert {
+	u.SetExcluded(emaillabel.FieldSyncEnabled)
+	return u
+}
+
+// SetMessageCount sets the "message_count" field.
+func (u *EmailLabelUpsert) SetMessageCount(v int64) *EmailLabelUpsert {
+	u.Set(emaillabel.FieldMessageCount, v)
+	return u
+}
+
+// UpdateMessageCount sets the "message_count" field to the value that was provided on create.
+func (u *EmailLabelUpsert) UpdateMessageCount() *EmailLabelUpsert {
+	u.SetExcluded(emaillabel.FieldMessageCount)
+	return u
+}
+
+// AddMessageCount adds v to the "message_count" field.
+func (u *EmailLabelUpsert) AddMessageCount(v int64) *EmailLabelUpsert {
+	u.Add(emaillabel.FieldMessageCount, v)
+	return u
+}
+
+// SetUnreadCount sets the "unread_count" field.
+func (u *EmailLabelUpsert) SetUnreadCount(v int64) *EmailLabelUpsert {
+	u.Set(emaillabel.FieldUnreadCount, v)
+	return u
+}
+
+// UpdateUnreadCount sets the "unread_count" field to the value that was provided on create.
+func (u *EmailLabelUpsert) UpdateUnreadCount() *EmailLabelUpsert {
+	u.SetExcluded(emaillabel.FieldUnreadCount)
+	return u
+}
+
+// AddUnreadCount adds v to the "unread_count" field.
+func (u *EmailLabelUpsert) AddUnreadCount(v int64) *EmailLabelUpsert {
+	u.Add(emaillabel.FieldUnreadCount, v)
+	return u
+}
+
+// SetColor sets the "color" field.
+func (u *EmailLabelUpsert) SetColor(v string) *EmailLabelUpsert {
+	u.Set(emaillabel.FieldColor, v)
+	return u
+}
+
+// UpdateColor sets the "color" field to the value that was provided on create.
+func (u *EmailLabelUpsert) UpdateColor() *EmailLabelUpsert {
+	u.SetExcluded(emaillabel.FieldColor)
+	return u
+}
+
+// ClearColor clears the value of the "color" field.
+func (u *EmailLabelUpsert) ClearColor() *EmailLabelUpsert {
+	u.SetNull(emaillabel.FieldColor)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmailLabelUpsert) SetUpdatedAt(v time.Time) *EmailLabelUpsert {
+	u.Set(emaillabel.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmailLabelUpsert) UpdateUpdatedAt() *EmailLabelUpsert {
+	u.SetExcluded(emaillabel.FieldUpdatedAt)
+	return u
+}
+
+// SetLastScannedAt sets the "last_scanned_at" field.
+func (u *EmailLabelUpsert) SetLastScannedAt(v time.Time) *EmailLabelUpsert {
+	u.Set(emaillabel.FieldLastScannedAt, v)
+	return u
+}
+
+// UpdateLastScannedAt sets the "last_scanned_at" field to the value that was provided on create.
+func (u *EmailLabelUpsert) UpdateLastScannedAt() *EmailLabelUpsert {
+	u.SetExcluded(emaillabel.FieldLastScannedAt)
+	return u
+}
+
+// ClearLastScannedAt clears the value of the "last_scanned_at" field.
+func (u *EmailLabelUpsert) ClearLastScannedAt() *EmailLabelUpsert {
+	u.SetNull(emaillabel.FieldLastScannedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EmailLabel.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emaillabel.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmailLabelUpsertOne) UpdateNewValues() *EmailLabelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(emaillabel.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(emaillabel.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmailLabel.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EmailLabelUpsertOne) Ignore() *EmailLabelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmailLabelUpsertOne) DoNothing() *EmailLabelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmailLabelCreate.OnConflict
+// documentation for more info.
+func (u *EmailLabelUpsertOne) Update(set func(*EmailLabelUpsert)) *EmailLabelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmailLabelUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (u *EmailLabelUpsertOne) SetConnectionID(v string) *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetConnectionID(v)
+	})
+}
+
+// UpdateConnectionID sets the "connection_id" field to the value that was provided on create.
+func (u *EmailLabelUpsertOne) UpdateConnectionID() *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateConnectionID()
+	})
+}
+
+// SetProviderLabelID sets the "provider_label_id" field.
+func (u *EmailLabelUpsertOne) SetProviderLabelID(v string) *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetProviderLabelID(v)
+	})
+}
+
+// UpdateProviderLabelID sets the "provider_label_id" field to the value that was provided on create.
+func (u *EmailLabelUpsertOne) UpdateProviderLabelID() *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateProviderLabelID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *EmailLabelUpsertOne) SetName(v string) *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EmailLabelUpsertOne) UpdateName() *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *EmailLabelUpsertOne) SetDisplayName(v string) *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *EmailLabelUpsertOne) UpdateDisplayName() *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (u *EmailLabelUpsertOne) ClearDisplayName() *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.ClearDisplayName()
+	})
+}
+
+// SetLabelType sets the "label_type" field.
+func (u *EmailLabelUpsertOne) SetLabelType(v emaillabel.LabelType) *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetLabelType(v)
+	})
+}
+
+// UpdateLabelType sets the "label_type" field to the value that was provided on create.
+func (u *EmailLabelUpsertOne) UpdateLabelType() *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateLabelType()
+	})
+}
+
+// SetParentLabelID sets the "parent_label_id" field.
+func (u *EmailLabelUpsertOne) SetParentLabelID(v string) *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetParentLabelID(v)
+	})
+}
+
+// UpdateParentLabelID sets the "parent_label_id" field to the value that was provided on create.
+func (u *EmailLabelUpsertOne) UpdateParentLabelID() *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateParentLabelID()
+	})
+}
+
+// ClearParentLabelID clears the value of the "parent_label_id" field.
+func (u *EmailLabelUpsertOne) ClearParentLabelID() *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.ClearParentLabelID()
+	})
+}
+
+// SetSyncEnabled sets the "sync_enabled" field.
+func (u *EmailLabelUpsertOne) SetSyncEnabled(v bool) *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetSyncEnabled(v)
+	})
+}
+
+// UpdateSyncEnabled sets the "sync_enabled" field to the value that was provided on create.
+func (u *EmailLabelUpsertOne) UpdateSyncEnabled() *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateSyncEnabled()
+	})
+}
+
+// SetMessageCount sets the "message_count" field.
+func (u *EmailLabelUpsertOne) SetMessageCount(v int64) *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetMessageCount(v)
+	})
+}
+
+// AddMessageCount adds v to the "message_count" field.
+func (u *EmailLabelUpsertOne) AddMessageCount(v int64) *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.AddMessageCount(v)
+	})
+}
+
+// UpdateMessageCount sets the "message_count" field to the value that was provided on create.
+func (u *EmailLabelUpsertOne) UpdateMessageCount() *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateMessageCount()
+	})
+}
+
+// SetUnreadCount sets the "unread_count" field.
+func (u *EmailLabelUpsertOne) SetUnreadCount(v int64) *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetUnreadCount(v)
+	})
+}
+
+// AddUnreadCount adds v to the "unread_count" field.
+func (u *EmailLabelUpsertOne) AddUnreadCount(v int64) *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.AddUnreadCount(v)
+	})
+}
+
+// UpdateUnreadCount sets the "unread_count" field to the value that was provided on create.
+func (u *EmailLabelUpsertOne) UpdateUnreadCount() *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateUnreadCount()
+	})
+}
+
+// SetColor sets the "color" field.
+func (u *EmailLabelUpsertOne) SetColor(v string) *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetColor(v)
+	})
+}
+
+// UpdateColor sets the "color" field to the value that was provided on create.
+func (u *EmailLabelUpsertOne) UpdateColor() *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateColor()
+	})
+}
+
+// ClearColor clears the value of the "color" field.
+func (u *EmailLabelUpsertOne) ClearColor() *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.ClearColor()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmailLabelUpsertOne) SetUpdatedAt(v time.Time) *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmailLabelUpsertOne) UpdateUpdatedAt() *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLastScannedAt sets the "last_scanned_at" field.
+func (u *EmailLabelUpsertOne) SetLastScannedAt(v time.Time) *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetLastScannedAt(v)
+	})
+}
+
+// UpdateLastScannedAt sets the "last_scanned_at" field to the value that was provided on create.
+func (u *EmailLabelUpsertOne) UpdateLastScannedAt() *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateLastScannedAt()
+	})
+}
+
+// ClearLastScannedAt clears the value of the "last_scanned_at" field.
+func (u *EmailLabelUpsertOne) ClearLastScannedAt() *EmailLabelUpsertOne {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.ClearLastScannedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmailLabelUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmailLabelCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmailLabelUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EmailLabelUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EmailLabelUpsertOne.ID is not supported by MySQL driver. Use EmailLabelUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EmailLabelUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // EmailLabelCreateBulk is the builder for creating many EmailLabel entities in bulk.
 type EmailLabelCreateBulk struct {
 	config
 	err      error
 	builders []*EmailLabelCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the EmailLabel entities in the database.
@@ -440,6 +973,7 @@ func (_c *EmailLabelCreateBulk) Save(ctx context.Context) ([]*EmailLabel, error)
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -489,3 +1023,330 @@ func (_c *EmailLabelCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmailLabel.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmailLabelUpsert) {
+//			SetConnectionID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EmailLabelCreateBulk) OnConflict(opts ...sql.ConflictOption) *EmailLabelUpsertBulk {
+	_c.conflict = opts
+	return &EmailLabelUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmailLabel.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EmailLabelCreateBulk) OnConflictColumns(columns ...string) *EmailLabelUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EmailLabelUpsertBulk{
+		create: _c,
+	}
+}
+
+// EmailLabelUpsertBulk is the builder for "upsert"-ing
+// a bulk of EmailLabel nodes.
+type EmailLabelUpsertBulk struct {
+	create *EmailLabelCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EmailLabel.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emaillabel.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmailLabelUpsertBulk) UpdateNewValues() *EmailLabelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(emaillabel.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(emaillabel.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmailLabel.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EmailLabelUpsertBulk) Ignore() *EmailLabelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmailLabelUpsertBulk) DoNothing() *EmailLabelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmailLabelCreateBulk.OnConflict
+// documentation for more info.
+func (u *EmailLabelUpsertBulk) Update(set func(*EmailLabelUpsert)) *EmailLabelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmailLabelUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (u *EmailLabelUpsertBulk) SetConnectionID(v string) *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetConnectionID(v)
+	})
+}
+
+// UpdateConnectionID sets the "connection_id" field to the value that was provided on create.
+func (u *EmailLabelUpsertBulk) UpdateConnectionID() *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateConnectionID()
+	})
+}
+
+// SetProviderLabelID sets the "provider_label_id" field.
+func (u *EmailLabelUpsertBulk) SetProviderLabelID(v string) *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetProviderLabelID(v)
+	})
+}
+
+// UpdateProviderLabelID sets the "provider_label_id" field to the value that was provided on create.
+func (u *EmailLabelUpsertBulk) UpdateProviderLabelID() *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateProviderLabelID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *EmailLabelUpsertBulk) SetName(v string) *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EmailLabelUpsertBulk) UpdateName() *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *EmailLabelUpsertBulk) SetDisplayName(v string) *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *EmailLabelUpsertBulk) UpdateDisplayName() *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (u *EmailLabelUpsertBulk) ClearDisplayName() *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.ClearDisplayName()
+	})
+}
+
+// SetLabelType sets the "label_type" field.
+func (u *EmailLabelUpsertBulk) SetLabelType(v emaillabel.LabelType) *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetLabelType(v)
+	})
+}
+
+// UpdateLabelType sets the "label_type" field to the value that was provided on create.
+func (u *EmailLabelUpsertBulk) UpdateLabelType() *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateLabelType()
+	})
+}
+
+// SetParentLabelID sets the "parent_label_id" field.
+func (u *EmailLabelUpsertBulk) SetParentLabelID(v string) *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetParentLabelID(v)
+	})
+}
+
+// UpdateParentLabelID sets the "parent_label_id" field to the value that was provided on create.
+func (u *EmailLabelUpsertBulk) UpdateParentLabelID() *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateParentLabelID()
+	})
+}
+
+// ClearParentLabelID clears the value of the "parent_label_id" field.
+func (u *EmailLabelUpsertBulk) ClearParentLabelID() *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.ClearParentLabelID()
+	})
+}
+
+// SetSyncEnabled sets the "sync_enabled" field.
+func (u *EmailLabelUpsertBulk) SetSyncEnabled(v bool) *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetSyncEnabled(v)
+	})
+}
+
+// UpdateSyncEnabled sets the "sync_enabled" field to the value that was provided on create.
+func (u *EmailLabelUpsertBulk) UpdateSyncEnabled() *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateSyncEnabled()
+	})
+}
+
+// SetMessageCount sets the "message_count" field.
+func (u *EmailLabelUpsertBulk) SetMessageCount(v int64) *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetMessageCount(v)
+	})
+}
+
+// AddMessageCount adds v to the "message_count" field.
+func (u *EmailLabelUpsertBulk) AddMessageCount(v int64) *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.AddMessageCount(v)
+	})
+}
+
+// UpdateMessageCount sets the "message_count" field to the value that was provided on create.
+func (u *EmailLabelUpsertBulk) UpdateMessageCount() *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateMessageCount()
+	})
+}
+
+// SetUnreadCount sets the "unread_count" field.
+func (u *EmailLabelUpsertBulk) SetUnreadCount(v int64) *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetUnreadCount(v)
+	})
+}
+
+// AddUnreadCount adds v to the "unread_count" field.
+func (u *EmailLabelUpsertBulk) AddUnreadCount(v int64) *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.AddUnreadCount(v)
+	})
+}
+
+// UpdateUnreadCount sets the "unread_count" field to the value that was provided on create.
+func (u *EmailLabelUpsertBulk) UpdateUnreadCount() *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateUnreadCount()
+	})
+}
+
+// SetColor sets the "color" field.
+func (u *EmailLabelUpsertBulk) SetColor(v string) *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetColor(v)
+	})
+}
+
+// UpdateColor sets the "color" field to the value that was provided on create.
+func (u *EmailLabelUpsertBulk) UpdateColor() *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateColor()
+	})
+}
+
+// ClearColor clears the value of the "color" field.
+func (u *EmailLabelUpsertBulk) ClearColor() *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.ClearColor()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmailLabelUpsertBulk) SetUpdatedAt(v time.Time) *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmailLabelUpsertBulk) UpdateUpdatedAt() *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLastScannedAt sets the "last_scanned_at" field.
+func (u *EmailLabelUpsertBulk) SetLastScannedAt(v time.Time) *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.SetLastScannedAt(v)
+	})
+}
+
+// UpdateLastScannedAt sets the "last_scanned_at" field to the value that was provided on create.
+func (u *EmailLabelUpsertBulk) UpdateLastScannedAt() *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.UpdateLastScannedAt()
+	})
+}
+
+// ClearLastScannedAt clears the value of the "last_scanned_at" field.
+func (u *EmailLabelUpsertBulk) ClearLastScannedAt() *EmailLabelUpsertBulk {
+	return u.Update(func(s *EmailLabelUpsert) {
+		s.ClearLastScannedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmailLabelUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EmailLabelCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmailLabelCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmailLabelUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
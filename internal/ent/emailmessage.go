@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/emailmessage"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// EmailMessage is the model entity for the EmailMessage schema.
+type EmailMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ID of the EmailConnection the message belongs to
+	ConnectionID string `json:"connection_id,omitempty"`
+	// Message ID from the email provider
+	MessageID string `json:"message_id,omitempty"`
+	// Thread ID from the email provider
+	ThreadID string `json:"thread_id,omitempty"`
+	// Subject holds the value of the "subject" field.
+	Subject string `json:"subject,omitempty"`
+	// From header of the message
+	Sender string `json:"sender,omitempty"`
+	// Short plain text excerpt of the body
+	Snippet string `json:"snippet,omitempty"`
+	// Provider label IDs the message had when indexed
+	LabelIds []string `json:"label_ids,omitempty"`
+	// HasAttachments holds the value of the "has_attachments" field.
+	HasAttachments bool `json:"has_attachments,omitempty"`
+	// AttachmentCount holds the value of the "attachment_count" field.
+	AttachmentCount int `json:"attachment_count,omitempty"`
+	// Largest amount of money mentioned in the subject or snippet
+	Amount *float64 `json:"amount,omitempty"`
+	// ReceivedAt holds the value of the "received_at" field.
+	ReceivedAt *time.Time `json:"received_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmailMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emailmessage.FieldLabelIds:
+			values[i] = new([]byte)
+		case emailmessage.FieldHasAttachments:
+			values[i] = new(sql.NullBool)
+		case emailmessage.FieldAmount:
+			values[i] = new(sql.NullFloat64)
+		case emailmessage.FieldAttachmentCount:
+			values[i] = new(sql.NullInt64)
+		case emailmessage.FieldID, emailmessage.FieldConnectionID, emailmessage.FieldMessageID, emailmessage.FieldThreadID, emailmessage.FieldSubject, emailmessage.FieldSender, emailmessage.FieldSnippet:
+			values[i] = new(sql.NullString)
+		case emailmessage.FieldReceivedAt, emailmessage.FieldCreatedAt, emailmessage.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmailMessage fields.
+func (_m *EmailMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emailmessage.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case emailmessage.FieldConnectionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field connection_id", values[i])
+			} else if value.Valid {
+				_m.ConnectionID = value.String
+			}
+		case emailmessage.FieldMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				_m.MessageID = value.String
+			}
+		case emailmessage.FieldThreadID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field thread_id", values[i])
+			} else if value.Valid {
+				_m.ThreadID = value.String
+			}
+		case emailmessage.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				_m.Subject = value.String
+			}
+		case emailmessage.FieldSender:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sender", values[i])
+			} else if value.Valid {
+				_m.Sender = value.String
+			}
+		case emailmessage.FieldSnippet:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field snippet", values[i])
+			} else if value.Valid {
+				_m.Snippet = value.String
+			}
+		case emailmessage.FieldLabelIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field label_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LabelIds); err != nil {
+					return fmt.Errorf("unmarshal field label_ids: %w", err)
+				}
+			}
+		case emailmessage.FieldHasAttachments:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field has_attachments", values[i])
+			} else if value.Valid {
+				_m.HasAttachments = value.Bool
+			}
+		case emailmessage.FieldAttachmentCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attachment_count", values[i])
+			} else if value.Valid {
+				_m.AttachmentCount = int(value.Int64)
+			}
+		case emailmessage.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				_m.Amount = new(float64)
+				*_m.Amount = value.Float64
+			}
+		case emailmessage.FieldReceivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field received_at", values[i])
+			} else if value.Valid {
+				_m.ReceivedAt = new(time.Time)
+				*_m.ReceivedAt = value.Time
+			}
+		case emailmessage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case emailmessage.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmailMessage.
+// This includes values selected through modifiers, order, etc.
+func (_m *EmailMessage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EmailMessage.
+// Note that you need to call EmailMessage.Unwrap() before calling this method if this EmailMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EmailMessage) Update() *EmailMessageUpdateOne {
+	return NewEmailMessageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EmailMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EmailMessage) Unwrap() *EmailMessage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmailMessage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EmailMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmailMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("connection_id=")
+	builder.WriteString(_m.ConnectionID)
+	builder.WriteString(", ")
+	builder.WriteString("message_id=")
+	builder.WriteString(_m.MessageID)
+	builder.WriteString(", ")
+	builder.WriteString("thread_id=")
+	builder.WriteString(_m.ThreadID)
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(_m.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("sender=")
+	builder.WriteString(_m.Sender)
+	builder.WriteString(", ")
+	builder.WriteString("snippet=")
+	builder.WriteString(_m.Snippet)
+	builder.WriteString(", ")
+	builder.WriteString("label_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LabelIds))
+	builder.WriteString(", ")
+	builder.WriteString("has_attachments=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HasAttachments))
+	builder.WriteString(", ")
+	builder.WriteString("attachment_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttachmentCount))
+	builder.WriteString(", ")
+	if v := _m.Amount; v != nil {
+		builder.WriteString("amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReceivedAt; v != nil {
+		builder.WriteString("received_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmailMessages is a parsable slice of EmailMessage.
+type EmailMessages []*EmailMessage
@@ -0,0 +1,810 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emailmessage
+
+import (
+	"clockzen-next/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldContainsFold(FieldID, id))
+}
+
+// ConnectionID applies equality check predicate on the "connection_id" field. It's identical to ConnectionIDEQ.
+func ConnectionID(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldConnectionID, v))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldMessageID, v))
+}
+
+// ThreadID applies equality check predicate on the "thread_id" field. It's identical to ThreadIDEQ.
+func ThreadID(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldThreadID, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldSubject, v))
+}
+
+// Sender applies equality check predicate on the "sender" field. It's identical to SenderEQ.
+func Sender(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldSender, v))
+}
+
+// Snippet applies equality check predicate on the "snippet" field. It's identical to SnippetEQ.
+func Snippet(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldSnippet, v))
+}
+
+// HasAttachments applies equality check predicate on the "has_attachments" field. It's identical to HasAttachmentsEQ.
+func HasAttachments(v bool) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldHasAttachments, v))
+}
+
+// AttachmentCount applies equality check predicate on the "attachment_count" field. It's identical to AttachmentCountEQ.
+func AttachmentCount(v int) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldAttachmentCount, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldAmount, v))
+}
+
+// ReceivedAt applies equality check predicate on the "received_at" field. It's identical to ReceivedAtEQ.
+func ReceivedAt(v time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ConnectionIDEQ applies the EQ predicate on the "connection_id" field.
+func ConnectionIDEQ(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldConnectionID, v))
+}
+
+// ConnectionIDNEQ applies the NEQ predicate on the "connection_id" field.
+func ConnectionIDNEQ(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNEQ(FieldConnectionID, v))
+}
+
+// ConnectionIDIn applies the In predicate on the "connection_id" field.
+func ConnectionIDIn(vs ...string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldIn(FieldConnectionID, vs...))
+}
+
+// ConnectionIDNotIn applies the NotIn predicate on the "connection_id" field.
+func ConnectionIDNotIn(vs ...string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNotIn(FieldConnectionID, vs...))
+}
+
+// ConnectionIDGT applies the GT predicate on the "connection_id" field.
+func ConnectionIDGT(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGT(FieldConnectionID, v))
+}
+
+// ConnectionIDGTE applies the GTE predicate on the "connection_id" field.
+func ConnectionIDGTE(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGTE(FieldConnectionID, v))
+}
+
+// ConnectionIDLT applies the LT predicate on the "connection_id" field.
+func ConnectionIDLT(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLT(FieldConnectionID, v))
+}
+
+// ConnectionIDLTE applies the LTE predicate on the "connection_id" field.
+func ConnectionIDLTE(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLTE(FieldConnectionID, v))
+}
+
+// ConnectionIDContains applies the Contains predicate on the "connection_id" field.
+func ConnectionIDContains(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldContains(FieldConnectionID, v))
+}
+
+// ConnectionIDHasPrefix applies the HasPrefix predicate on the "connection_id" field.
+func ConnectionIDHasPrefix(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldHasPrefix(FieldConnectionID, v))
+}
+
+// ConnectionIDHasSuffix applies the HasSuffix predicate on the "connection_id" field.
+func ConnectionIDHasSuffix(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldHasSuffix(FieldConnectionID, v))
+}
+
+// ConnectionIDEqualFold applies the EqualFold predicate on the "connection_id" field.
+func ConnectionIDEqualFold(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEqualFold(FieldConnectionID, v))
+}
+
+// ConnectionIDContainsFold applies the ContainsFold predicate on the "connection_id" field.
+func ConnectionIDContainsFold(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldContainsFold(FieldConnectionID, v))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// MessageIDGT applies the GT predicate on the "message_id" field.
+func MessageIDGT(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGT(FieldMessageID, v))
+}
+
+// MessageIDGTE applies the GTE predicate on the "message_id" field.
+func MessageIDGTE(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGTE(FieldMessageID, v))
+}
+
+// MessageIDLT applies the LT predicate on the "message_id" field.
+func MessageIDLT(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLT(FieldMessageID, v))
+}
+
+// MessageIDLTE applies the LTE predicate on the "message_id" field.
+func MessageIDLTE(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLTE(FieldMessageID, v))
+}
+
+// MessageIDContains applies the Contains predicate on the "message_id" field.
+func MessageIDContains(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldContains(FieldMessageID, v))
+}
+
+// MessageIDHasPrefix applies the HasPrefix predicate on the "message_id" field.
+func MessageIDHasPrefix(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldHasPrefix(FieldMessageID, v))
+}
+
+// MessageIDHasSuffix applies the HasSuffix predicate on the "message_id" field.
+func MessageIDHasSuffix(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldHasSuffix(FieldMessageID, v))
+}
+
+// MessageIDEqualFold applies the EqualFold predicate on the "message_id" field.
+func MessageIDEqualFold(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEqualFold(FieldMessageID, v))
+}
+
+// MessageIDContainsFold applies the ContainsFold predicate on the "message_id" field.
+func MessageIDContainsFold(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldContainsFold(FieldMessageID, v))
+}
+
+// ThreadIDEQ applies the EQ predicate on the "thread_id" field.
+func ThreadIDEQ(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldThreadID, v))
+}
+
+// ThreadIDNEQ applies the NEQ predicate on the "thread_id" field.
+func ThreadIDNEQ(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNEQ(FieldThreadID, v))
+}
+
+// ThreadIDIn applies the In predicate on the "thread_id" field.
+func ThreadIDIn(vs ...string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldIn(FieldThreadID, vs...))
+}
+
+// ThreadIDNotIn applies the NotIn predicate on the "thread_id" field.
+func ThreadIDNotIn(vs ...string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNotIn(FieldThreadID, vs...))
+}
+
+// ThreadIDGT applies the GT predicate on the "thread_id" field.
+func ThreadIDGT(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGT(FieldThreadID, v))
+}
+
+// ThreadIDGTE applies the GTE predicate on the "thread_id" field.
+func ThreadIDGTE(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGTE(FieldThreadID, v))
+}
+
+// ThreadIDLT applies the LT predicate on the "thread_id" field.
+func ThreadIDLT(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLT(FieldThreadID, v))
+}
+
+// ThreadIDLTE applies the LTE predicate on the "thread_id" field.
+func ThreadIDLTE(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLTE(FieldThreadID, v))
+}
+
+// ThreadIDContains applies the Contains predicate on the "thread_id" field.
+func ThreadIDContains(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldContains(FieldThreadID, v))
+}
+
+// ThreadIDHasPrefix applies the HasPrefix predicate on the "thread_id" field.
+func ThreadIDHasPrefix(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldHasPrefix(FieldThreadID, v))
+}
+
+// ThreadIDHasSuffix applies the HasSuffix predicate on the "thread_id" field.
+func ThreadIDHasSuffix(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldHasSuffix(FieldThreadID, v))
+}
+
+// ThreadIDIsNil applies the IsNil predicate on the "thread_id" field.
+func ThreadIDIsNil() predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldIsNull(FieldThreadID))
+}
+
+// ThreadIDNotNil applies the NotNil predicate on the "thread_id" field.
+func ThreadIDNotNil() predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNotNull(FieldThreadID))
+}
+
+// ThreadIDEqualFold applies the EqualFold predicate on the "thread_id" field.
+func ThreadIDEqualFold(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEqualFold(FieldThreadID, v))
+}
+
+// ThreadIDContainsFold applies the ContainsFold predicate on the "thread_id" field.
+func ThreadIDContainsFold(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldContainsFold(FieldThreadID, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectIsNil applies the IsNil predicate on the "subject" field.
+func SubjectIsNil() predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldIsNull(FieldSubject))
+}
+
+// SubjectNotNil applies the NotNil predicate on the "subject" field.
+func SubjectNotNil() predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNotNull(FieldSubject))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// SenderEQ applies the EQ predicate on the "sender" field.
+func SenderEQ(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldSender, v))
+}
+
+// SenderNEQ applies the NEQ predicate on the "sender" field.
+func SenderNEQ(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNEQ(FieldSender, v))
+}
+
+// SenderIn applies the In predicate on the "sender" field.
+func SenderIn(vs ...string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldIn(FieldSender, vs...))
+}
+
+// SenderNotIn applies the NotIn predicate on the "sender" field.
+func SenderNotIn(vs ...string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNotIn(FieldSender, vs...))
+}
+
+// SenderGT applies the GT predicate on the "sender" field.
+func SenderGT(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGT(FieldSender, v))
+}
+
+// SenderGTE applies the GTE predicate on the "sender" field.
+func SenderGTE(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGTE(FieldSender, v))
+}
+
+// SenderLT applies the LT predicate on the "sender" field.
+func SenderLT(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLT(FieldSender, v))
+}
+
+// SenderLTE applies the LTE predicate on the "sender" field.
+func SenderLTE(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLTE(FieldSender, v))
+}
+
+// SenderContains applies the Contains predicate on the "sender" field.
+func SenderContains(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldContains(FieldSender, v))
+}
+
+// SenderHasPrefix applies the HasPrefix predicate on the "sender" field.
+func SenderHasPrefix(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldHasPrefix(FieldSender, v))
+}
+
+// SenderHasSuffix applies the HasSuffix predicate on the "sender" field.
+func SenderHasSuffix(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldHasSuffix(FieldSender, v))
+}
+
+// SenderIsNil applies the IsNil predicate on the "sender" field.
+func SenderIsNil() predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldIsNull(FieldSender))
+}
+
+// SenderNotNil applies the NotNil predicate on the "sender" field.
+func SenderNotNil() predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNotNull(FieldSender))
+}
+
+// SenderEqualFold applies the EqualFold predicate on the "sender" field.
+func SenderEqualFold(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEqualFold(FieldSender, v))
+}
+
+// SenderContainsFold applies the ContainsFold predicate on the "sender" field.
+func SenderContainsFold(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldContainsFold(FieldSender, v))
+}
+
+// SnippetEQ applies the EQ predicate on the "snippet" field.
+func SnippetEQ(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldSnippet, v))
+}
+
+// SnippetNEQ applies the NEQ predicate on the "snippet" field.
+func SnippetNEQ(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNEQ(FieldSnippet, v))
+}
+
+// SnippetIn applies the In predicate on the "snippet" field.
+func SnippetIn(vs ...string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldIn(FieldSnippet, vs...))
+}
+
+// SnippetNotIn applies the NotIn predicate on the "snippet" field.
+func SnippetNotIn(vs ...string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNotIn(FieldSnippet, vs...))
+}
+
+// SnippetGT applies the GT predicate on the "snippet" field.
+func SnippetGT(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGT(FieldSnippet, v))
+}
+
+// SnippetGTE applies the GTE predicate on the "snippet" field.
+func SnippetGTE(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGTE(FieldSnippet, v))
+}
+
+// SnippetLT applies the LT predicate on the "snippet" field.
+func SnippetLT(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLT(FieldSnippet, v))
+}
+
+// SnippetLTE applies the LTE predicate on the "snippet" field.
+func SnippetLTE(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLTE(FieldSnippet, v))
+}
+
+// SnippetContains applies the Contains predicate on the "snippet" field.
+func SnippetContains(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldContains(FieldSnippet, v))
+}
+
+// SnippetHasPrefix applies the HasPrefix predicate on the "snippet" field.
+func SnippetHasPrefix(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldHasPrefix(FieldSnippet, v))
+}
+
+// SnippetHasSuffix applies the HasSuffix predicate on the "snippet" field.
+func SnippetHasSuffix(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldHasSuffix(FieldSnippet, v))
+}
+
+// SnippetIsNil applies the IsNil predicate on the "snippet" field.
+func SnippetIsNil() predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldIsNull(FieldSnippet))
+}
+
+// SnippetNotNil applies the NotNil predicate on the "snippet" field.
+func SnippetNotNil() predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNotNull(FieldSnippet))
+}
+
+// SnippetEqualFold applies the EqualFold predicate on the "snippet" field.
+func SnippetEqualFold(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEqualFold(FieldSnippet, v))
+}
+
+// SnippetContainsFold applies the ContainsFold predicate on the "snippet" field.
+func SnippetContainsFold(v string) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldContainsFold(FieldSnippet, v))
+}
+
+// LabelIdsIsNil applies the IsNil predicate on the "label_ids" field.
+func LabelIdsIsNil() predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldIsNull(FieldLabelIds))
+}
+
+// LabelIdsNotNil applies the NotNil predicate on the "label_ids" field.
+func LabelIdsNotNil() predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNotNull(FieldLabelIds))
+}
+
+// HasAttachmentsEQ applies the EQ predicate on the "has_attachments" field.
+func HasAttachmentsEQ(v bool) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldHasAttachments, v))
+}
+
+// HasAttachmentsNEQ applies the NEQ predicate on the "has_attachments" field.
+func HasAttachmentsNEQ(v bool) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNEQ(FieldHasAttachments, v))
+}
+
+// AttachmentCountEQ applies the EQ predicate on the "attachment_count" field.
+func AttachmentCountEQ(v int) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldAttachmentCount, v))
+}
+
+// AttachmentCountNEQ applies the NEQ predicate on the "attachment_count" field.
+func AttachmentCountNEQ(v int) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNEQ(FieldAttachmentCount, v))
+}
+
+// AttachmentCountIn applies the In predicate on the "attachment_count" field.
+func AttachmentCountIn(vs ...int) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldIn(FieldAttachmentCount, vs...))
+}
+
+// AttachmentCountNotIn applies the NotIn predicate on the "attachment_count" field.
+func AttachmentCountNotIn(vs ...int) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNotIn(FieldAttachmentCount, vs...))
+}
+
+// AttachmentCountGT applies the GT predicate on the "attachment_count" field.
+func AttachmentCountGT(v int) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGT(FieldAttachmentCount, v))
+}
+
+// AttachmentCountGTE applies the GTE predicate on the "attachment_count" field.
+func AttachmentCountGTE(v int) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGTE(FieldAttachmentCount, v))
+}
+
+// AttachmentCountLT applies the LT predicate on the "attachment_count" field.
+func AttachmentCountLT(v int) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLT(FieldAttachmentCount, v))
+}
+
+// AttachmentCountLTE applies the LTE predicate on the "attachment_count" field.
+func AttachmentCountLTE(v int) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLTE(FieldAttachmentCount, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLTE(FieldAmount, v))
+}
+
+// AmountIsNil applies the IsNil predicate on the "amount" field.
+func AmountIsNil() predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldIsNull(FieldAmount))
+}
+
+// AmountNotNil applies the NotNil predicate on the "amount" field.
+func AmountNotNil() predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNotNull(FieldAmount))
+}
+
+// ReceivedAtEQ applies the EQ predicate on the "received_at" field.
+func ReceivedAtEQ(v time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtNEQ applies the NEQ predicate on the "received_at" field.
+func ReceivedAtNEQ(v time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtIn applies the In predicate on the "received_at" field.
+func ReceivedAtIn(vs ...time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtNotIn applies the NotIn predicate on the "received_at" field.
+func ReceivedAtNotIn(vs ...time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNotIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtGT applies the GT predicate on the "received_at" field.
+func ReceivedAtGT(v time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGT(FieldReceivedAt, v))
+}
+
+// ReceivedAtGTE applies the GTE predicate on the "received_at" field.
+func ReceivedAtGTE(v time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGTE(FieldReceivedAt, v))
+}
+
+// ReceivedAtLT applies the LT predicate on the "received_at" field.
+func ReceivedAtLT(v time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLT(FieldReceivedAt, v))
+}
+
+// ReceivedAtLTE applies the LTE predicate on the "received_at" field.
+func ReceivedAtLTE(v time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLTE(FieldReceivedAt, v))
+}
+
+// ReceivedAtIsNil applies the IsNil predicate on the "received_at" field.
+func ReceivedAtIsNil() predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldIsNull(FieldReceivedAt))
+}
+
+// ReceivedAtNotNil applies the NotNil predicate on the "received_at" field.
+func ReceivedAtNotNil() predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNotNull(FieldReceivedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmailMessage) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmailMessage) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmailMessage) predicate.EmailMessage {
+	return predicate.EmailMessage(sql.NotPredicates(p))
+}
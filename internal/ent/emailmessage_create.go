@@ -0,0 +1,1363 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/emailmessage"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmailMessageCreate is the builder for creating a EmailMessage entity.
+type EmailMessageCreate struct {
+	config
+	mutation *EmailMessageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_c *EmailMessageCreate) SetConnectionID(v string) *EmailMessageCreate {
+	_c.mutation.SetConnectionID(v)
+	return _c
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *EmailMessageCreate) SetMessageID(v string) *EmailMessageCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetThreadID sets the "thread_id" field.
+func (_c *EmailMessageCreate) SetThreadID(v string) *EmailMessageCreate {
+	_c.mutation.SetThreadID(v)
+	return _c
+}
+
+// SetNillableThreadID sets the "thread_id" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableThreadID(v *string) *EmailMessageCreate {
+	if v != nil {
+		_c.SetThreadID(*v)
+	}
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *EmailMessageCreate) SetSubject(v string) *EmailMessageCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableSubject(v *string) *EmailMessageCreate {
+	if v != nil {
+		_c.SetSubject(*v)
+	}
+	return _c
+}
+
+// SetSender sets the "sender" field.
+func (_c *EmailMessageCreate) SetSender(v string) *EmailMessageCreate {
+	_c.mutation.SetSender(v)
+	return _c
+}
+
+// SetNillableSender sets the "sender" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableSender(v *string) *EmailMessageCreate {
+	if v != nil {
+		_c.SetSender(*v)
+	}
+	return _c
+}
+
+// SetSnippet sets the "snippet" field.
+func (_c *EmailMessageCreate) SetSnippet(v string) *EmailMessageCreate {
+	_c.mutation.SetSnippet(v)
+	return _c
+}
+
+// SetNillableSnippet sets the "snippet" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableSnippet(v *string) *EmailMessageCreate {
+	if v != nil {
+		_c.SetSnippet(*v)
+	}
+	return _c
+}
+
+// SetLabelIds sets the "label_ids" field.
+func (_c *EmailMessageCreate) SetLabelIds(v []string) *EmailMessageCreate {
+	_c.mutation.SetLabelIds(v)
+	return _c
+}
+
+// SetHasAttachments sets the "has_attachments" field.
+func (_c *EmailMessageCreate) SetHasAttachments(v bool) *EmailMessageCreate {
+	_c.mutation.SetHasAttachments(v)
+	return _c
+}
+
+// SetNillableHasAttachments sets the "has_attachments" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableHasAttachments(v *bool) *EmailMessageCreate {
+	if v != nil {
+		_c.SetHasAttachments(*v)
+	}
+	return _c
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (_c *EmailMessageCreate) SetAttachmentCount(v int) *EmailMessageCreate {
+	_c.mutation.SetAttachmentCount(v)
+	return _c
+}
+
+// SetNillableAttachmentCount sets the "attachment_count" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableAttachmentCount(v *int) *EmailMessageCreate {
+	if v != nil {
+		_c.SetAttachmentCount(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *EmailMessageCreate) SetAmount(v float64) *EmailMessageCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableAmount(v *float64) *EmailMessageCreate {
+	if v != nil {
+		_c.SetAmount(*v)
+	}
+	return _c
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_c *EmailMessageCreate) SetReceivedAt(v time.Time) *EmailMessageCreate {
+	_c.mutation.SetReceivedAt(v)
+	return _c
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableReceivedAt(v *time.Time) *EmailMessageCreate {
+	if v != nil {
+		_c.SetReceivedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EmailMessageCreate) SetCreatedAt(v time.Time) *EmailMessageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableCreatedAt(v *time.Time) *EmailMessageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EmailMessageCreate) SetUpdatedAt(v time.Time) *EmailMessageCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableUpdatedAt(v *time.Time) *EmailMessageCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EmailMessageCreate) SetID(v string) *EmailMessageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the EmailMessageMutation object of the builder.
+func (_c *EmailMessageCreate) Mutation() *EmailMessageMutation {
+	return _c.mutation
+}
+
+// Save creates the EmailMessage in the database.
+func (_c *EmailMessageCreate) Save(ctx context.Context) (*EmailMessage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EmailMessageCreate) SaveX(ctx context.Context) *EmailMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmailMessageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmailMessageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EmailMessageCreate) defaults() {
+	if _, ok := _c.mutation.HasAttachments(); !ok {
+		v := emailmessage.DefaultHasAttachments
+		_c.mutation.SetHasAttachments(v)
+	}
+	if _, ok := _c.mutation.AttachmentCount(); !ok {
+		v := emailmessage.DefaultAttachmentCount
+		_c.mutation.SetAttachmentCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := emailmessage.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := emailmessage.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EmailMessageCreate) check() error {
+	if _, ok := _c.mutation.ConnectionID(); !ok {
+		return &ValidationError{Name: "connection_id", err: errors.New(`ent: missing required field "EmailMessage.connection_id"`)}
+	}
+	if v, ok := _c.mutation.ConnectionID(); ok {
+		if err := emailmessage.ConnectionIDValidator(v); err != nil {
+			return &ValidationError{Name: "connection_id", err: fmt.Errorf(`ent: validator failed for field "EmailMessage.connection_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "EmailMessage.message_id"`)}
+	}
+	if v, ok := _c.mutation.MessageID(); ok {
+		if err := emailmessage.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "EmailMessage.message_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.HasAttachments(); !ok {
+		return &ValidationError{Name: "has_attachments", err: errors.New(`ent: missing required field "EmailMessage.has_attachments"`)}
+	}
+	if _, ok := _c.mutation.AttachmentCount(); !ok {
+		return &ValidationError{Name: "attachment_count", err: errors.New(`ent: missing required field "EmailMessage.attachment_count"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EmailMessage.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EmailMessage.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *EmailMessageCreate) sqlSave(ctx context.Context) (*EmailMessage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected EmailMessage.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EmailMessageCreate) createSpec() (*EmailMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmailMessage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(emailmessage.Table, sqlgraph.NewFieldSpec(emailmessage.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ConnectionID(); ok {
+		_spec.SetField(emailmessage.FieldConnectionID, field.TypeString, value)
+		_node.ConnectionID = value
+	}
+	if value, ok := _c.mutation.MessageID(); ok {
+		_spec.SetField(emailmessage.FieldMessageID, field.TypeString, value)
+		_node.MessageID = value
+	}
+	if value, ok := _c.mutation.ThreadID(); ok {
+		_spec.SetField(emailmessage.FieldThreadID, field.TypeString, value)
+		_node.ThreadID = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(emailmessage.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.Sender(); ok {
+		_spec.SetField(emailmessage.FieldSender, field.TypeString, value)
+		_node.Sender = value
+	}
+	if value, ok := _c.mutation.Snippet(); ok {
+		_spec.SetField(emailmessage.FieldSnippet, field.TypeString, value)
+		_node.Snippet = value
+	}
+	if value, ok := _c.mutation.LabelIds(); ok {
+		_spec.SetField(emailmessage.FieldLabelIds, field.TypeJSON, value)
+		_node.LabelIds = value
+	}
+	if value, ok := _c.mutation.HasAttachments(); ok {
+		_spec.SetField(emailmessage.FieldHasAttachments, field.TypeBool, value)
+		_node.HasAttachments = value
+	}
+	if value, ok := _c.mutation.AttachmentCount(); ok {
+		_spec.SetField(emailmessage.FieldAttachmentCount, field.TypeInt, value)
+		_node.AttachmentCount = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(emailmessage.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = &value
+	}
+	if value, ok := _c.mutation.ReceivedAt(); ok {
+		_spec.SetField(emailmessage.FieldReceivedAt, field.TypeTime, value)
+		_node.ReceivedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(emailmessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailmessage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmailMessage.Create().
+//		SetConnectionID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmailMessageUpsert) {
+//			SetConnectionID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EmailMessageCreate) OnConflict(opts ...sql.ConflictOption) *EmailMessageUpsertOne {
+	_c.conflict = opts
+	return &EmailMessageUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmailMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EmailMessageCreate) OnConflictColumns(columns ...string) *EmailMessageUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EmailMessageUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EmailMessageUpsertOne is the builder for "upsert"-ing
+	//  one EmailMessage node.
+	EmailMessageUpsertOne struct {
+		create *EmailMessageCreate
+	}
+
+	// EmailMessageUpsert is the "OnConflict" setter.
+	EmailMessageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetConnectionID sets the "connection_id" field.
+func (u *EmailMessageUpsert) SetConnectionID(v string) *EmailMessageUpsert {
+	u.Set(emailmessage.FieldConnectionID, v)
+	return u
+}
+
+// UpdateConnectionID sets the "connection_id" field to the value that was provided on create.
+func (u *EmailMessageUpsert) UpdateConnectionID() *EmailMessageUpsert {
+	u.SetExcluded(emailmessage.FieldConnectionID)
+	return u
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *EmailMessageUpsert) SetMessageID(v string) *EmailMessageUpsert {
+	u.Set(emailmessage.FieldMessageID, v)
+	return u
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *EmailMessageUpsert) UpdateMessageID() *EmailMessageUpsert {
+	u.SetExcluded(emailmessage.FieldMessageID)
+	return u
+}
+
+// SetThreadID sets the "thread_id" field.
+func (u *EmailMessageUpsert) SetThreadID(v string) *EmailMessageUpsert {
+	u.Set(emailmessage.FieldThreadID, v)
+	return u
+}
+
+// UpdateThreadID sets the "thread_id" field to the value that was provided on create.
+func (u *EmailMessageUpsert) UpdateThreadID() *EmailMessageUpsert {
+	u.SetExcluded(emailmessage.FieldThreadID)
+	return u
+}
+
+// ClearThreadID clears the value of the "thread_id" field.
+func (u *EmailMessageUpsert) ClearThreadID() *EmailMessageUpsert {
+	u.SetNull(emailmessage.FieldThreadID)
+	return u
+}
+
+// SetSubject sets the "subject" field.
+func (u *EmailMessageUpsert) SetSubject(v string) *EmailMessageUpsert {
+	u.Set(emailmessage.FieldSubject, v)
+	return u
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *EmailMessageUpsert) UpdateSubject() *EmailMessageUpsert {
+	u.SetExcluded(emailmessage.FieldSubject)
+	return u
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (u *EmailMessageUpsert) ClearSubject() *EmailMessageUpsert {
+	u.SetNull(emailmessage.FieldSubject)
+	return u
+}
+
+// SetSender sets the "sender" field.
+func (u *EmailMessageUpsert) SetSender(v string) *EmailMessageUpsert {
+	u.Set(emailmessage.FieldSender, v)
+	return u
+}
+
+// UpdateSender sets the "sender" field to the value that was provided on create.
+func (u *EmailMessageUpsert) UpdateSender() *EmailMessageUpsert {
+	u.SetExcluded(emailmessage.FieldSender)
+	return u
+}
+
+// ClearSender clears the value of the "sender" field.
+func (u *EmailMessageUpsert) ClearSender() *EmailMessageUpsert {
+	u.SetNull(emailmessage.FieldSender)
+	return u
+}
+
+// SetSnippet sets the "snippet" field.
+func (u *EmailMessageUpsert) SetSnippet(v string) *EmailMessageUpsert {
+	u.Set(emailmessage.FieldSnippet, v)
+	return u
+}
+
+// UpdateSnippet sets the "snippet" field to the value that was provided on create.
+func (u *EmailMessageUpsert) UpdateSnippet() *EmailMessageUpsert {
+	u.SetExcluded(emailmessage.FieldSnippet)
+	return u
+}
+
+// ClearSnippet clears the value of the "snippet" field.
+func (u *EmailMessageUpsert) ClearSnippet() *EmailMessageUpsert {
+	u.SetNull(emailmessage.FieldSnippet)
+	return u
+}
+
+// SetLabelIds sets the "label_ids" field.
+func (u *EmailMessageUpsert) SetLabelIds(v []string) *EmailMessageUpsert {
+	u.Set(emailmessage.FieldLabelIds, v)
+	return u
+}
+
+// UpdateLabelIds sets the "label_ids" field to the value that was provided on create.
+func (u *EmailMessageUpsert) UpdateLabelIds() *EmailMessageUpsert {
+	u.SetExcluded(emailmessage.FieldLabelIds)
+	return u
+}
+
+// ClearLabelIds clears the value of the "label_ids" field.
+func (u *EmailMessageUpsert) ClearLabelIds() *EmailMessageUpsert {
+	u.SetNull(emailmessage.FieldLabelIds)
+	return u
+}
+
+// SetHasAttachments sets the "has_attachments" field.
+func (u *EmailMessageUpsert) SetHasAttachments(v bool) *EmailMessageUpsert {
+	u.Set(emailmessage.FieldHasAttachments, v)
+	return u
+}
+
+// UpdateHasAttachments sets the "has_attachments" field to the value that was provided on create.
+func (u *EmailMessageUpsert) UpdateHasAttachments() *EmailMessageUpsert {
+	u.SetExcluded(emailmessage.FieldHasAttachments)
+	return u
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (u *EmailMessageUpsert) SetAttachmentCount(v int) *EmailMessageUpsert {
+	u.Set(emailmessage.FieldAttachmentCount, v)
+	return u
+}
+
+// UpdateAttachmentCount sets the "attachment_count" field to the value that was provided on create.
+func (u *EmailMessageUpsert) UpdateAttachmentCount() *EmailMessageUpsert {
+	u.SetExcluded(emailmessage.FieldAttachmentCount)
+	return u
+}
+
+// AddAttachmentCount adds v to the "attachment_count" field.
+func (u *EmailMessageUpsert) AddAttachmentCount(v int) *EmailMessageUpsert {
+	u.Add(emailmessage.FieldAttachmentCount, v)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *EmailMessageUpsert) SetAmount(v float64) *EmailMessageUpsert {
+	u.Set(emailmessage.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *EmailMessageUpsert) UpdateAmount() *EmailMessageUpsert {
+	u.SetExcluded(emailmessage.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *EmailMessageUpsert) AddAmount(v float64) *EmailMessageUpsert {
+	u.Add(emailmessage.FieldAmount, v)
+	return u
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (u *EmailMessageUpsert) ClearAmount() *EmailMessageUpsert {
+	u.SetNull(emailmessage.FieldAmount)
+	return u
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (u *EmailMessageUpsert) SetReceivedAt(v time.Time) *EmailMessageUpsert {
+	u.Set(emailmessage.FieldReceivedAt, v)
+	return u
+}
+
+// UpdateReceivedAt sets the "received_at" field to the value that was provided on create.
+func (u *EmailMessageUpsert) UpdateReceivedAt() *EmailMessageUpsert {
+	u.SetExcluded(emailmessage.FieldReceivedAt)
+	return u
+}
+
+// ClearReceivedAt clears the value of the "received_at" field.
+func (u *EmailMessageUpsert) ClearReceivedAt() *EmailMessageUpsert {
+	u.SetNull(emailmessage.FieldReceivedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmailMessageUpsert) SetUpdatedAt(v time.Time) *EmailMessageUpsert {
+	u.Set(emailmessage.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmailMessageUpsert) UpdateUpdatedAt() *EmailMessageUpsert {
+	u.SetExcluded(emailmessage.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EmailMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emailmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmailMessageUpsertOne) UpdateNewValues() *EmailMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(emailmessage.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(emailmessage.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmailMessage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EmailMessageUpsertOne) Ignore() *EmailMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmailMessageUpsertOne) DoNothing() *EmailMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmailMessageCreate.OnConflict
+// documentation for more info.
+func (u *EmailMessageUpsertOne) Update(set func(*EmailMessageUpsert)) *EmailMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmailMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (u *EmailMessageUpsertOne) SetConnectionID(v string) *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetConnectionID(v)
+	})
+}
+
+// UpdateConnectionID sets the "connection_id" field to the value that was provided on create.
+func (u *EmailMessageUpsertOne) UpdateConnectionID() *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateConnectionID()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *EmailMessageUpsertOne) SetMessageID(v string) *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *EmailMessageUpsertOne) UpdateMessageID() *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetThreadID sets the "thread_id" field.
+func (u *EmailMessageUpsertOne) SetThreadID(v string) *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetThreadID(v)
+	})
+}
+
+// UpdateThreadID sets the "thread_id" field to the value that was provided on create.
+func (u *EmailMessageUpsertOne) UpdateThreadID() *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateThreadID()
+	})
+}
+
+// ClearThreadID clears the value of the "thread_id" field.
+func (u *EmailMessageUpsertOne) ClearThreadID() *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.ClearThreadID()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *EmailMessageUpsertOne) SetSubject(v string) *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *EmailMessageUpsertOne) UpdateSubject() *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (u *EmailMessageUpsertOne) ClearSubject() *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.ClearSubject()
+	})
+}
+
+// SetSender sets the "sender" field.
+func (u *EmailMessageUpsertOne) SetSender(v string) *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetSender(v)
+	})
+}
+
+// UpdateSender sets the "sender" field to the value that was provided on create.
+func (u *EmailMessageUpsertOne) UpdateSender() *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateSender()
+	})
+}
+
+// ClearSender clears the value of the "sender" field.
+func (u *EmailMessageUpsertOne) ClearSender() *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.ClearSender()
+	})
+}
+
+// SetSnippet sets the "snippet" field.
+func (u *EmailMessageUpsertOne) SetSnippet(v string) *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetSnippet(v)
+	})
+}
+
+// UpdateSnippet sets the "snippet" field to the value that was provided on create.
+func (u *EmailMessageUpsertOne) UpdateSnippet() *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateSnippet()
+	})
+}
+
+// ClearSnippet clears the value of the "snippet" field.
+func (u *EmailMessageUpsertOne) ClearSnippet() *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.ClearSnippet()
+	})
+}
+
+// SetLabelIds sets the "label_ids" field.
+func (u *EmailMessageUpsertOne) SetLabelIds(v []string) *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetLabelIds(v)
+	})
+}
+
+// UpdateLabelIds sets the "label_ids" field to the value that was provided on create.
+func (u *EmailMessageUpsertOne) UpdateLabelIds() *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateLabelIds()
+	})
+}
+
+// ClearLabelIds clears the value of the "label_ids" field.
+func (u *EmailMessageUpsertOne) ClearLabelIds() *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.ClearLabelIds()
+	})
+}
+
+// SetHasAttachments sets the "has_attachments" field.
+func (u *EmailMessageUpsertOne) SetHasAttachments(v bool) *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetHasAttachments(v)
+	})
+}
+
+// UpdateHasAttachments sets the "has_attachments" field to the value that was provided on create.
+func (u *EmailMessageUpsertOne) UpdateHasAttachments() *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateHasAttachments()
+	})
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (u *EmailMessageUpsertOne) SetAttachmentCount(v int) *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetAttachmentCount(v)
+	})
+}
+
+// AddAttachmentCount adds v to the "attachment_count" field.
+func (u *EmailMessageUpsertOne) AddAttachmentCount(v int) *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.AddAttachmentCount(v)
+	})
+}
+
+// UpdateAttachmentCount sets the "attachment_count" field to the value that was provided on create.
+func (u *EmailMessageUpsertOne) UpdateAttachmentCount() *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateAttachmentCount()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *EmailMessageUpsertOne) SetAmount(v float64) *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *EmailMessageUpsertOne) AddAmount(v float64) *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *EmailMessageUpsertOne) UpdateAmount() *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (u *EmailMessageUpsertOne) ClearAmount() *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.ClearAmount()
+	})
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (u *EmailMessageUpsertOne) SetReceivedAt(v time.Time) *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetReceivedAt(v)
+	})
+}
+
+// UpdateReceivedAt sets the "received_at" field to the value that was provided on create.
+func (u *EmailMessageUpsertOne) UpdateReceivedAt() *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateReceivedAt()
+	})
+}
+
+// ClearReceivedAt clears the value of the "received_at" field.
+func (u *EmailMessageUpsertOne) ClearReceivedAt() *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.ClearReceivedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmailMessageUpsertOne) SetUpdatedAt(v time.Time) *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmailMessageUpsertOne) UpdateUpdatedAt() *EmailMessageUpsertOne {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmailMessageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmailMessageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmailMessageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EmailMessageUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EmailMessageUpsertOne.ID is not supported by MySQL driver. Use EmailMessageUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EmailMessageUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EmailMessageCreateBulk is the builder for creating many EmailMessage entities in bulk.
+type EmailMessageCreateBulk struct {
+	config
+	err      error
+	builders []*EmailMessageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EmailMessage entities in the database.
+func (_c *EmailMessageCreateBulk) Save(ctx context.Context) ([]*EmailMessage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EmailMessage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmailMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EmailMessageCreateBulk) SaveX(ctx context.Context) []*EmailMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmailMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmailMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmailMessage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmailMessageUpsert) {
+//			SetConnectionID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EmailMessageCreateBulk) OnConflict(opts ...sql.ConflictOption) *EmailMessageUpsertBulk {
+	_c.conflict = opts
+	return &EmailMessageUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmailMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EmailMessageCreateBulk) OnConflictColumns(columns ...string) *EmailMessageUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EmailMessageUpsertBulk{
+		create: _c,
+	}
+}
+
+// EmailMessageUpsertBulk is the builder for "upsert"-ing
+// a bulk of EmailMessage nodes.
+type EmailMessageUpsertBulk struct {
+	create *EmailMessageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EmailMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emailmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmailMessageUpsertBulk) UpdateNewValues() *EmailMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(emailmessage.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(emailmessage.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmailMessage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EmailMessageUpsertBulk) Ignore() *EmailMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmailMessageUpsertBulk) DoNothing() *EmailMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmailMessageCreateBulk.OnConflict
+// documentation for more info.
+func (u *EmailMessageUpsertBulk) Update(set func(*EmailMessageUpsert)) *EmailMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmailMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (u *EmailMessageUpsertBulk) SetConnectionID(v string) *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetConnectionID(v)
+	})
+}
+
+// UpdateConnectionID sets the "connection_id" field to the value that was provided on create.
+func (u *EmailMessageUpsertBulk) UpdateConnectionID() *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateConnectionID()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *EmailMessageUpsertBulk) SetMessageID(v string) *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *EmailMessageUpsertBulk) UpdateMessageID() *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetThreadID sets the "thread_id" field.
+func (u *EmailMessageUpsertBulk) SetThreadID(v string) *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetThreadID(v)
+	})
+}
+
+// UpdateThreadID sets the "thread_id" field to the value that was provided on create.
+func (u *EmailMessageUpsertBulk) UpdateThreadID() *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateThreadID()
+	})
+}
+
+// ClearThreadID clears the value of the "thread_id" field.
+func (u *EmailMessageUpsertBulk) ClearThreadID() *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.ClearThreadID()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *EmailMessageUpsertBulk) SetSubject(v string) *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *EmailMessageUpsertBulk) UpdateSubject() *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (u *EmailMessageUpsertBulk) ClearSubject() *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.ClearSubject()
+	})
+}
+
+// SetSender sets the "sender" field.
+func (u *EmailMessageUpsertBulk) SetSender(v string) *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetSender(v)
+	})
+}
+
+// UpdateSender sets the "sender" field to the value that was provided on create.
+func (u *EmailMessageUpsertBulk) UpdateSender() *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateSender()
+	})
+}
+
+// ClearSender clears the value of the "sender" field.
+func (u *EmailMessageUpsertBulk) ClearSender() *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.ClearSender()
+	})
+}
+
+// SetSnippet sets the "snippet" field.
+func (u *EmailMessageUpsertBulk) SetSnippet(v string) *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetSnippet(v)
+	})
+}
+
+// UpdateSnippet sets the "snippet" field to the value that was provided on create.
+func (u *EmailMessageUpsertBulk) UpdateSnippet() *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateSnippet()
+	})
+}
+
+// ClearSnippet clears the value of the "snippet" field.
+func (u *EmailMessageUpsertBulk) ClearSnippet() *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.ClearSnippet()
+	})
+}
+
+// SetLabelIds sets the "label_ids" field.
+func (u *EmailMessageUpsertBulk) SetLabelIds(v []string) *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetLabelIds(v)
+	})
+}
+
+// UpdateLabelIds sets the "label_ids" field to the value that was provided on create.
+func (u *EmailMessageUpsertBulk) UpdateLabelIds() *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateLabelIds()
+	})
+}
+
+// ClearLabelIds clears the value of the "label_ids" field.
+func (u *EmailMessageUpsertBulk) ClearLabelIds() *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.ClearLabelIds()
+	})
+}
+
+// SetHasAttachments sets the "has_attachments" field.
+func (u *EmailMessageUpsertBulk) SetHasAttachments(v bool) *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetHasAttachments(v)
+	})
+}
+
+// UpdateHasAttachments sets the "has_attachments" field to the value that was provided on create.
+func (u *EmailMessageUpsertBulk) UpdateHasAttachments() *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateHasAttachments()
+	})
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (u *EmailMessageUpsertBulk) SetAttachmentCount(v int) *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetAttachmentCount(v)
+	})
+}
+
+// AddAttachmentCount adds v to the "attachment_count" field.
+func (u *EmailMessageUpsertBulk) AddAttachmentCount(v int) *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.AddAttachmentCount(v)
+	})
+}
+
+// UpdateAttachmentCount sets the "attachment_count" field to the value that was provided on create.
+func (u *EmailMessageUpsertBulk) UpdateAttachmentCount() *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateAttachmentCount()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *EmailMessageUpsertBulk) SetAmount(v float64) *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *EmailMessageUpsertBulk) AddAmount(v float64) *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *EmailMessageUpsertBulk) UpdateAmount() *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (u *EmailMessageUpsertBulk) ClearAmount() *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.ClearAmount()
+	})
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (u *EmailMessageUpsertBulk) SetReceivedAt(v time.Time) *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetReceivedAt(v)
+	})
+}
+
+// UpdateReceivedAt sets the "received_at" field to the value that was provided on create.
+func (u *EmailMessageUpsertBulk) UpdateReceivedAt() *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateReceivedAt()
+	})
+}
+
+// ClearReceivedAt clears the value of the "received_at" field.
+func (u *EmailMessageUpsertBulk) ClearReceivedAt() *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.ClearReceivedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmailMessageUpsertBulk) SetUpdatedAt(v time.Time) *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmailMessageUpsertBulk) UpdateUpdatedAt() *EmailMessageUpsertBulk {
+	return u.Update(func(s *EmailMessageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmailMessageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EmailMessageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmailMessageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmailMessageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
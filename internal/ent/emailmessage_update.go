@@ -0,0 +1,795 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/emailmessage"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// EmailMessageUpdate is the builder for updating EmailMessage entities.
+type EmailMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmailMessageMutation
+}
+
+// Where appends a list predicates to the EmailMessageUpdate builder.
+func (_u *EmailMessageUpdate) Where(ps ...predicate.EmailMessage) *EmailMessageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_u *EmailMessageUpdate) SetConnectionID(v string) *EmailMessageUpdate {
+	_u.mutation.SetConnectionID(v)
+	return _u
+}
+
+// SetNillableConnectionID sets the "connection_id" field if the given value is not nil.
+func (_u *EmailMessageUpdate) SetNillableConnectionID(v *string) *EmailMessageUpdate {
+	if v != nil {
+		_u.SetConnectionID(*v)
+	}
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *EmailMessageUpdate) SetMessageID(v string) *EmailMessageUpdate {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *EmailMessageUpdate) SetNillableMessageID(v *string) *EmailMessageUpdate {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetThreadID sets the "thread_id" field.
+func (_u *EmailMessageUpdate) SetThreadID(v string) *EmailMessageUpdate {
+	_u.mutation.SetThreadID(v)
+	return _u
+}
+
+// SetNillableThreadID sets the "thread_id" field if the given value is not nil.
+func (_u *EmailMessageUpdate) SetNillableThreadID(v *string) *EmailMessageUpdate {
+	if v != nil {
+		_u.SetThreadID(*v)
+	}
+	return _u
+}
+
+// ClearThreadID clears the value of the "thread_id" field.
+func (_u *EmailMessageUpdate) ClearThreadID() *EmailMessageUpdate {
+	_u.mutation.ClearThreadID()
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *EmailMessageUpdate) SetSubject(v string) *EmailMessageUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *EmailMessageUpdate) SetNillableSubject(v *string) *EmailMessageUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (_u *EmailMessageUpdate) ClearSubject() *EmailMessageUpdate {
+	_u.mutation.ClearSubject()
+	return _u
+}
+
+// SetSender sets the "sender" field.
+func (_u *EmailMessageUpdate) SetSender(v string) *EmailMessageUpdate {
+	_u.mutation.SetSender(v)
+	return _u
+}
+
+// SetNillableSender sets the "sender" field if the given value is not nil.
+func (_u *EmailMessageUpdate) SetNillableSender(v *string) *EmailMessageUpdate {
+	if v != nil {
+		_u.SetSender(*v)
+	}
+	return _u
+}
+
+// ClearSender clears the value of the "sender" field.
+func (_u *EmailMessageUpdate) ClearSender() *EmailMessageUpdate {
+	_u.mutation.ClearSender()
+	return _u
+}
+
+// SetSnippet sets the "snippet" field.
+func (_u *EmailMessageUpdate) SetSnippet(v string) *EmailMessageUpdate {
+	_u.mutation.SetSnippet(v)
+	return _u
+}
+
+// SetNillableSnippet sets the "snippet" field if the given value is not nil.
+func (_u *EmailMessageUpdate) SetNillableSnippet(v *string) *EmailMessageUpdate {
+	if v != nil {
+		_u.SetSnippet(*v)
+	}
+	return _u
+}
+
+// ClearSnippet clears the value of the "snippet" field.
+func (_u *EmailMessageUpdate) ClearSnippet() *EmailMessageUpdate {
+	_u.mutation.ClearSnippet()
+	return _u
+}
+
+// SetLabelIds sets the "label_ids" field.
+func (_u *EmailMessageUpdate) SetLabelIds(v []string) *EmailMessageUpdate {
+	_u.mutation.SetLabelIds(v)
+	return _u
+}
+
+// AppendLabelIds appends value to the "label_ids" field.
+func (_u *EmailMessageUpdate) AppendLabelIds(v []string) *EmailMessageUpdate {
+	_u.mutation.AppendLabelIds(v)
+	return _u
+}
+
+// ClearLabelIds clears the value of the "label_ids" field.
+func (_u *EmailMessageUpdate) ClearLabelIds() *EmailMessageUpdate {
+	_u.mutation.ClearLabelIds()
+	return _u
+}
+
+// SetHasAttachments sets the "has_attachments" field.
+func (_u *EmailMessageUpdate) SetHasAttachments(v bool) *EmailMessageUpdate {
+	_u.mutation.SetHasAttachments(v)
+	return _u
+}
+
+// SetNillableHasAttachments sets the "has_attachments" field if the given value is not nil.
+func (_u *EmailMessageUpdate) SetNillableHasAttachments(v *bool) *EmailMessageUpdate {
+	if v != nil {
+		_u.SetHasAttachments(*v)
+	}
+	return _u
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (_u *EmailMessageUpdate) SetAttachmentCount(v int) *EmailMessageUpdate {
+	_u.mutation.ResetAttachmentCount()
+	_u.mutation.SetAttachmentCount(v)
+	return _u
+}
+
+// SetNillableAttachmentCount sets the "attachment_count" field if the given value is not nil.
+func (_u *EmailMessageUpdate) SetNillableAttachmentCount(v *int) *EmailMessageUpdate {
+	if v != nil {
+		_u.SetAttachmentCount(*v)
+	}
+	return _u
+}
+
+// AddAttachmentCount adds value to the "attachment_count" field.
+func (_u *EmailMessageUpdate) AddAttachmentCount(v int) *EmailMessageUpdate {
+	_u.mutation.AddAttachmentCount(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *EmailMessageUpdate) SetAmount(v float64) *EmailMessageUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *EmailMessageUpdate) SetNillableAmount(v *float64) *EmailMessageUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *EmailMessageUpdate) AddAmount(v float64) *EmailMessageUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (_u *EmailMessageUpdate) ClearAmount() *EmailMessageUpdate {
+	_u.mutation.ClearAmount()
+	return _u
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_u *EmailMessageUpdate) SetReceivedAt(v time.Time) *EmailMessageUpdate {
+	_u.mutation.SetReceivedAt(v)
+	return _u
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_u *EmailMessageUpdate) SetNillableReceivedAt(v *time.Time) *EmailMessageUpdate {
+	if v != nil {
+		_u.SetReceivedAt(*v)
+	}
+	return _u
+}
+
+// ClearReceivedAt clears the value of the "received_at" field.
+func (_u *EmailMessageUpdate) ClearReceivedAt() *EmailMessageUpdate {
+	_u.mutation.ClearReceivedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EmailMessageUpdate) SetUpdatedAt(v time.Time) *EmailMessageUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EmailMessageMutation object of the builder.
+func (_u *EmailMessageUpdate) Mutation() *EmailMessageMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EmailMessageUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmailMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EmailMessageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmailMessageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmailMessageUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := emailmessage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmailMessageUpdate) check() error {
+	if v, ok := _u.mutation.ConnectionID(); ok {
+		if err := emailmessage.ConnectionIDValidator(v); err != nil {
+			return &ValidationError{Name: "connection_id", err: fmt.Errorf(`ent: validator failed for field "EmailMessage.connection_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MessageID(); ok {
+		if err := emailmessage.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "EmailMessage.message_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EmailMessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailmessage.Table, emailmessage.Columns, sqlgraph.NewFieldSpec(emailmessage.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ConnectionID(); ok {
+		_spec.SetField(emailmessage.FieldConnectionID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(emailmessage.FieldMessageID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ThreadID(); ok {
+		_spec.SetField(emailmessage.FieldThreadID, field.TypeString, value)
+	}
+	if _u.mutation.ThreadIDCleared() {
+		_spec.ClearField(emailmessage.FieldThreadID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(emailmessage.FieldSubject, field.TypeString, value)
+	}
+	if _u.mutation.SubjectCleared() {
+		_spec.ClearField(emailmessage.FieldSubject, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sender(); ok {
+		_spec.SetField(emailmessage.FieldSender, field.TypeString, value)
+	}
+	if _u.mutation.SenderCleared() {
+		_spec.ClearField(emailmessage.FieldSender, field.TypeString)
+	}
+	if value, ok := _u.mutation.Snippet(); ok {
+		_spec.SetField(emailmessage.FieldSnippet, field.TypeString, value)
+	}
+	if _u.mutation.SnippetCleared() {
+		_spec.ClearField(emailmessage.FieldSnippet, field.TypeString)
+	}
+	if value, ok := _u.mutation.LabelIds(); ok {
+		_spec.SetField(emailmessage.FieldLabelIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, emailmessage.FieldLabelIds, value)
+		})
+	}
+	if _u.mutation.LabelIdsCleared() {
+		_spec.ClearField(emailmessage.FieldLabelIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HasAttachments(); ok {
+		_spec.SetField(emailmessage.FieldHasAttachments, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AttachmentCount(); ok {
+		_spec.SetField(emailmessage.FieldAttachmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttachmentCount(); ok {
+		_spec.AddField(emailmessage.FieldAttachmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(emailmessage.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(emailmessage.FieldAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.AmountCleared() {
+		_spec.ClearField(emailmessage.FieldAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ReceivedAt(); ok {
+		_spec.SetField(emailmessage.FieldReceivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReceivedAtCleared() {
+		_spec.ClearField(emailmessage.FieldReceivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailmessage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EmailMessageUpdateOne is the builder for updating a single EmailMessage entity.
+type EmailMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmailMessageMutation
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_u *EmailMessageUpdateOne) SetConnectionID(v string) *EmailMessageUpdateOne {
+	_u.mutation.SetConnectionID(v)
+	return _u
+}
+
+// SetNillableConnectionID sets the "connection_id" field if the given value is not nil.
+func (_u *EmailMessageUpdateOne) SetNillableConnectionID(v *string) *EmailMessageUpdateOne {
+	if v != nil {
+		_u.SetConnectionID(*v)
+	}
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *EmailMessageUpdateOne) SetMessageID(v string) *EmailMessageUpdateOne {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *EmailMessageUpdateOne) SetNillableMessageID(v *string) *EmailMessageUpdateOne {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetThreadID sets the "thread_id" field.
+func (_u *EmailMessageUpdateOne) SetThreadID(v string) *EmailMessageUpdateOne {
+	_u.mutation.SetThreadID(v)
+	return _u
+}
+
+// SetNillableThreadID sets the "thread_id" field if the given value is not nil.
+func (_u *EmailMessageUpdateOne) SetNillableThreadID(v *string) *EmailMessageUpdateOne {
+	if v != nil {
+		_u.SetThreadID(*v)
+	}
+	return _u
+}
+
+// ClearThreadID clears the value of the "thread_id" field.
+func (_u *EmailMessageUpdateOne) ClearThreadID() *EmailMessageUpdateOne {
+	_u.mutation.ClearThreadID()
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *EmailMessageUpdateOne) SetSubject(v string) *EmailMessageUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *EmailMessageUpdateOne) SetNillableSubject(v *string) *EmailMessageUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (_u *EmailMessageUpdateOne) ClearSubject() *EmailMessageUpdateOne {
+	_u.mutation.ClearSubject()
+	return _u
+}
+
+// SetSender sets the "sender" field.
+func (_u *EmailMessageUpdateOne) SetSender(v string) *EmailMessageUpdateOne {
+	_u.mutation.SetSender(v)
+	return _u
+}
+
+// SetNillableSender sets the "sender" field if the given value is not nil.
+func (_u *EmailMessageUpdateOne) SetNillableSender(v *string) *EmailMessageUpdateOne {
+	if v != nil {
+		_u.SetSender(*v)
+	}
+	return _u
+}
+
+// ClearSender clears the value of the "sender" field.
+func (_u *EmailMessageUpdateOne) ClearSender() *EmailMessageUpdateOne {
+	_u.mutation.ClearSender()
+	return _u
+}
+
+// SetSnippet sets the "snippet" field.
+func (_u *EmailMessageUpdateOne) SetSnippet(v string) *EmailMessageUpdateOne {
+	_u.mutation.SetSnippet(v)
+	return _u
+}
+
+// SetNillableSnippet sets the "snippet" field if the given value is not nil.
+func (_u *EmailMessageUpdateOne) SetNillableSnippet(v *string) *EmailMessageUpdateOne {
+	if v != nil {
+		_u.SetSnippet(*v)
+	}
+	return _u
+}
+
+// ClearSnippet clears the value of the "snippet" field.
+func (_u *EmailMessageUpdateOne) ClearSnippet() *EmailMessageUpdateOne {
+	_u.mutation.ClearSnippet()
+	return _u
+}
+
+// SetLabelIds sets the "label_ids" field.
+func (_u *EmailMessageUpdateOne) SetLabelIds(v []string) *EmailMessageUpdateOne {
+	_u.mutation.SetLabelIds(v)
+	return _u
+}
+
+// AppendLabelIds appends value to the "label_ids" field.
+func (_u *EmailMessageUpdateOne) AppendLabelIds(v []string) *EmailMessageUpdateOne {
+	_u.mutation.AppendLabelIds(v)
+	return _u
+}
+
+// ClearLabelIds clears the value of the "label_ids" field.
+func (_u *EmailMessageUpdateOne) ClearLabelIds() *EmailMessageUpdateOne {
+	_u.mutation.ClearLabelIds()
+	return _u
+}
+
+// SetHasAttachments sets the "has_attachments" field.
+func (_u *EmailMessageUpdateOne) SetHasAttachments(v bool) *EmailMessageUpdateOne {
+	_u.mutation.SetHasAttachments(v)
+	return _u
+}
+
+// SetNillableHasAttachments sets the "has_attachments" field if the given value is not nil.
+func (_u *EmailMessageUpdateOne) SetNillableHasAttachments(v *bool) *EmailMessageUpdateOne {
+	if v != nil {
+		_u.SetHasAttachments(*v)
+	}
+	return _u
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (_u *EmailMessageUpdateOne) SetAttachmentCount(v int) *EmailMessageUpdateOne {
+	_u.mutation.ResetAttachmentCount()
+	_u.mutation.SetAttachmentCount(v)
+	return _u
+}
+
+// SetNillableAttachmentCount sets the "attachment_count" field if the given value is not nil.
+func (_u *EmailMessageUpdateOne) SetNillableAttachmentCount(v *int) *EmailMessageUpdateOne {
+	if v != nil {
+		_u.SetAttachmentCount(*v)
+	}
+	return _u
+}
+
+// AddAttachmentCount adds value to the "attachment_count" field.
+func (_u *EmailMessageUpdateOne) AddAttachmentCount(v int) *EmailMessageUpdateOne {
+	_u.mutation.AddAttachmentCount(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *EmailMessageUpdateOne) SetAmount(v float64) *EmailMessageUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *EmailMessageUpdateOne) SetNillableAmount(v *float64) *EmailMessageUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *EmailMessageUpdateOne) AddAmount(v float64) *EmailMessageUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (_u *EmailMessageUpdateOne) ClearAmount() *EmailMessageUpdateOne {
+	_u.mutation.ClearAmount()
+	return _u
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_u *EmailMessageUpdateOne) SetReceivedAt(v time.Time) *EmailMessageUpdateOne {
+	_u.mutation.SetReceivedAt(v)
+	return _u
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_u *EmailMessageUpdateOne) SetNillableReceivedAt(v *time.Time) *EmailMessageUpdateOne {
+	if v != nil {
+		_u.SetReceivedAt(*v)
+	}
+	return _u
+}
+
+// ClearReceivedAt clears the value of the "received_at" field.
+func (_u *EmailMessageUpdateOne) ClearReceivedAt() *EmailMessageUpdateOne {
+	_u.mutation.ClearReceivedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EmailMessageUpdateOne) SetUpdatedAt(v time.Time) *EmailMessageUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EmailMessageMutation object of the builder.
+func (_u *EmailMessageUpdateOne) Mutation() *EmailMessageMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EmailMessageUpdate builder.
+func (_u *EmailMessageUpdateOne) Where(ps ...predicate.EmailMessage) *EmailMessageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EmailMessageUpdateOne) Select(field string, fields ...string) *EmailMessageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EmailMessage entity.
+func (_u *EmailMessageUpdateOne) Save(ctx context.Context) (*EmailMessage, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmailMessageUpdateOne) SaveX(ctx context.Context) *EmailMessage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EmailMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmailMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmailMessageUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := emailmessage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmailMessageUpdateOne) check() error {
+	if v, ok := _u.mutation.ConnectionID(); ok {
+		if err := emailmessage.ConnectionIDValidator(v); err != nil {
+			return &ValidationError{Name: "connection_id", err: fmt.Errorf(`ent: validator failed for field "EmailMessage.connection_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MessageID(); ok {
+		if err := emailmessage.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "EmailMessage.message_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EmailMessageUpdateOne) sqlSave(ctx context.Context) (_node *EmailMessage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailmessage.Table, emailmessage.Columns, sqlgraph.NewFieldSpec(emailmessage.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmailMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailmessage.FieldID)
+		for _, f := range fields {
+			if !emailmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emailmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ConnectionID(); ok {
+		_spec.SetField(emailmessage.FieldConnectionID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(emailmessage.FieldMessageID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ThreadID(); ok {
+		_spec.SetField(emailmessage.FieldThreadID, field.TypeString, value)
+	}
+	if _u.mutation.ThreadIDCleared() {
+		_spec.ClearField(emailmessage.FieldThreadID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(emailmessage.FieldSubject, field.TypeString, value)
+	}
+	if _u.mutation.SubjectCleared() {
+		_spec.ClearField(emailmessage.FieldSubject, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sender(); ok {
+		_spec.SetField(emailmessage.FieldSender, field.TypeString, value)
+	}
+	if _u.mutation.SenderCleared() {
+		_spec.ClearField(emailmessage.FieldSender, field.TypeString)
+	}
+	if value, ok := _u.mutation.Snippet(); ok {
+		_spec.SetField(emailmessage.FieldSnippet, field.TypeString, value)
+	}
+	if _u.mutation.SnippetCleared() {
+		_spec.ClearField(emailmessage.FieldSnippet, field.TypeString)
+	}
+	if value, ok := _u.mutation.LabelIds(); ok {
+		_spec.SetField(emailmessage.FieldLabelIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, emailmessage.FieldLabelIds, value)
+		})
+	}
+	if _u.mutation.LabelIdsCleared() {
+		_spec.ClearField(emailmessage.FieldLabelIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HasAttachments(); ok {
+		_spec.SetField(emailmessage.FieldHasAttachments, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AttachmentCount(); ok {
+		_spec.SetField(emailmessage.FieldAttachmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttachmentCount(); ok {
+		_spec.AddField(emailmessage.FieldAttachmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(emailmessage.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(emailmessage.FieldAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.AmountCleared() {
+		_spec.ClearField(emailmessage.FieldAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ReceivedAt(); ok {
+		_spec.SetField(emailmessage.FieldReceivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReceivedAtCleared() {
+		_spec.ClearField(emailmessage.FieldReceivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailmessage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &EmailMessage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
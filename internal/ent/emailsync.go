@@ -47,8 +47,12 @@ type EmailSync struct {
 	ErrorMessage *string `json:"error_message,omitempty"`
 	// Detailed error information
 	ErrorDetails map[string]interface{} `json:"error_details,omitempty"`
+	// Resumable progress of a full sync, by label
+	Checkpoints map[string]interface{} `json:"checkpoints,omitempty"`
 	// Email provider history ID for incremental sync
 	HistoryID *string `json:"history_id,omitempty"`
+	// Why an incremental sync fell back to a backfill or full sync
+	FallbackReason *string `json:"fallback_reason,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -63,9 +67,11 @@ type EmailSync struct {
 type EmailSyncEdges struct {
 	// The connection this sync belongs to
 	Connection *EmailConnection `json:"connection,omitempty"`
+	// Messages that failed to process in this sync
+	Failures []*EmailSyncFailure `json:"failures,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // ConnectionOrErr returns the Connection value or an error if the edge
@@ -79,16 +85,25 @@ func (e EmailSyncEdges) ConnectionOrErr() (*EmailConnection, error) {
 	return nil, &NotLoadedError{edge: "connection"}
 }
 
+// FailuresOrErr returns the Failures value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmailSyncEdges) FailuresOrErr() ([]*EmailSyncFailure, error) {
+	if e.loadedTypes[1] {
+		return e.Failures, nil
+	}
+	return nil, &NotLoadedError{edge: "failures"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*EmailSync) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case emailsync.FieldErrorDetails:
+		case emailsync.FieldErrorDetails, emailsync.FieldCheckpoints:
 			values[i] = new([]byte)
 		case emailsync.FieldMessagesScanned, emailsync.FieldMessagesDownloaded, emailsync.FieldMessagesIndexed, emailsync.FieldMessagesFailed, emailsync.FieldAttachmentsDownloaded, emailsync.FieldBytesTransferred:
 			values[i] = new(sql.NullInt64)
-		case emailsync.FieldID, emailsync.FieldConnectionID, emailsync.FieldLabelID, emailsync.FieldSyncType, emailsync.FieldStatus, emailsync.FieldErrorMessage, emailsync.FieldHistoryID:
+		case emailsync.FieldID, emailsync.FieldConnectionID, emailsync.FieldLabelID, emailsync.FieldSyncType, emailsync.FieldStatus, emailsync.FieldErrorMessage, emailsync.FieldHistoryID, emailsync.FieldFallbackReason:
 			values[i] = new(sql.NullString)
 		case emailsync.FieldStartedAt, emailsync.FieldCompletedAt, emailsync.FieldCreatedAt, emailsync.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -203,6 +218,14 @@ func (_m *EmailSync) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field error_details: %w", err)
 				}
 			}
+		case emailsync.FieldCheckpoints:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field checkpoints", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Checkpoints); err != nil {
+					return fmt.Errorf("unmarshal field checkpoints: %w", err)
+				}
+			}
 		case emailsync.FieldHistoryID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field history_id", values[i])
@@ -210,6 +233,13 @@ func (_m *EmailSync) assignValues(columns []string, values []any) error {
 				_m.HistoryID = new(string)
 				*_m.HistoryID = value.String
 			}
+		case emailsync.FieldFallbackReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fallback_reason", values[i])
+			} else if value.Valid {
+				_m.FallbackReason = new(string)
+				*_m.FallbackReason = value.String
+			}
 		case emailsync.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -240,6 +270,11 @@ func (_m *EmailSync) QueryConnection() *EmailConnectionQuery {
 	return NewEmailSyncClient(_m.config).QueryConnection(_m)
 }
 
+// QueryFailures queries the "failures" edge of the EmailSync entity.
+func (_m *EmailSync) QueryFailures() *EmailSyncFailureQuery {
+	return NewEmailSyncClient(_m.config).QueryFailures(_m)
+}
+
 // Update returns a builder for updating this EmailSync.
 // Note that you need to call EmailSync.Unwrap() before calling this method if this EmailSync
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -313,11 +348,19 @@ func (_m *EmailSync) String() string {
 	builder.WriteString("error_details=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ErrorDetails))
 	builder.WriteString(", ")
+	builder.WriteString("checkpoints=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Checkpoints))
+	builder.WriteString(", ")
 	if v := _m.HistoryID; v != nil {
 		builder.WriteString("history_id=")
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.FallbackReason; v != nil {
+		builder.WriteString("fallback_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
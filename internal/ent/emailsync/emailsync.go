@@ -43,14 +43,20 @@ const (
 	FieldErrorMessage = "error_message"
 	// FieldErrorDetails holds the string denoting the error_details field in the database.
 	FieldErrorDetails = "error_details"
+	// FieldCheckpoints holds the string denoting the checkpoints field in the database.
+	FieldCheckpoints = "checkpoints"
 	// FieldHistoryID holds the string denoting the history_id field in the database.
 	FieldHistoryID = "history_id"
+	// FieldFallbackReason holds the string denoting the fallback_reason field in the database.
+	FieldFallbackReason = "fallback_reason"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// EdgeConnection holds the string denoting the connection edge name in mutations.
 	EdgeConnection = "connection"
+	// EdgeFailures holds the string denoting the failures edge name in mutations.
+	EdgeFailures = "failures"
 	// Table holds the table name of the emailsync in the database.
 	Table = "email_syncs"
 	// ConnectionTable is the table that holds the connection relation/edge.
@@ -60,6 +66,13 @@ const (
 	ConnectionInverseTable = "email_connections"
 	// ConnectionColumn is the table column denoting the connection relation/edge.
 	ConnectionColumn = "connection_id"
+	// FailuresTable is the table that holds the failures relation/edge.
+	FailuresTable = "email_sync_failures"
+	// FailuresInverseTable is the table name for the EmailSyncFailure entity.
+	// It exists in this package in order to avoid circular dependency with the "emailsyncfailure" package.
+	FailuresInverseTable = "email_sync_failures"
+	// FailuresColumn is the table column denoting the failures relation/edge.
+	FailuresColumn = "sync_id"
 )
 
 // Columns holds all SQL columns for emailsync fields.
@@ -79,7 +92,9 @@ var Columns = []string{
 	FieldBytesTransferred,
 	FieldErrorMessage,
 	FieldErrorDetails,
+	FieldCheckpoints,
 	FieldHistoryID,
+	FieldFallbackReason,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -251,6 +266,11 @@ func ByHistoryID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldHistoryID, opts...).ToFunc()
 }
 
+// ByFallbackReason orders the results by the fallback_reason field.
+func ByFallbackReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFallbackReason, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -267,6 +287,20 @@ func ByConnectionField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newConnectionStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByFailuresCount orders the results by failures count.
+func ByFailuresCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFailuresStep(), opts...)
+	}
+}
+
+// ByFailures orders the results by failures terms.
+func ByFailures(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFailuresStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newConnectionStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -274,3 +308,10 @@ func newConnectionStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, ConnectionTable, ConnectionColumn),
 	)
 }
+func newFailuresStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FailuresInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FailuresTable, FailuresColumn),
+	)
+}
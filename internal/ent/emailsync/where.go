@@ -125,6 +125,11 @@ func HistoryID(v string) predicate.EmailSync {
 	return predicate.EmailSync(sql.FieldEQ(FieldHistoryID, v))
 }
 
+// FallbackReason applies equality check predicate on the "fallback_reason" field. It's identical to FallbackReasonEQ.
+func FallbackReason(v string) predicate.EmailSync {
+	return predicate.EmailSync(sql.FieldEQ(FieldFallbackReason, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.EmailSync {
 	return predicate.EmailSync(sql.FieldEQ(FieldCreatedAt, v))
@@ -740,6 +745,16 @@ func ErrorDetailsNotNil() predicate.EmailSync {
 	return predicate.EmailSync(sql.FieldNotNull(FieldErrorDetails))
 }
 
+// CheckpointsIsNil applies the IsNil predicate on the "checkpoints" field.
+func CheckpointsIsNil() predicate.EmailSync {
+	return predicate.EmailSync(sql.FieldIsNull(FieldCheckpoints))
+}
+
+// CheckpointsNotNil applies the NotNil predicate on the "checkpoints" field.
+func CheckpointsNotNil() predicate.EmailSync {
+	return predicate.EmailSync(sql.FieldNotNull(FieldCheckpoints))
+}
+
 // HistoryIDEQ applies the EQ predicate on the "history_id" field.
 func HistoryIDEQ(v string) predicate.EmailSync {
 	return predicate.EmailSync(sql.FieldEQ(FieldHistoryID, v))
@@ -815,6 +830,81 @@ func HistoryIDContainsFold(v string) predicate.EmailSync {
 	return predicate.EmailSync(sql.FieldContainsFold(FieldHistoryID, v))
 }
 
+// FallbackReasonEQ applies the EQ predicate on the "fallback_reason" field.
+func FallbackReasonEQ(v string) predicate.EmailSync {
+	return predicate.EmailSync(sql.FieldEQ(FieldFallbackReason, v))
+}
+
+// FallbackReasonNEQ applies the NEQ predicate on the "fallback_reason" field.
+func FallbackReasonNEQ(v string) predicate.EmailSync {
+	return predicate.EmailSync(sql.FieldNEQ(FieldFallbackReason, v))
+}
+
+// FallbackReasonIn applies the In predicate on the "fallback_reason" field.
+func FallbackReasonIn(vs ...string) predicate.EmailSync {
+	return predicate.EmailSync(sql.FieldIn(FieldFallbackReason, vs...))
+}
+
+// FallbackReasonNotIn applies the NotIn predicate on the "fallback_reason" field.
+func FallbackReasonNotIn(vs ...string) predicate.EmailSync {
+	return predicate.EmailSync(sql.FieldNotIn(FieldFallbackReason, vs...))
+}
+
+// FallbackReasonGT applies the GT predicate on the "fallback_reason" field.
+func FallbackReasonGT(v string) predicate.EmailSync {
+	return predicate.EmailSync(sql.FieldGT(FieldFallbackReason, v))
+}
+
+// FallbackReasonGTE applies the GTE predicate on the "fallback_reason" field.
+func FallbackReasonGTE(v string) predicate.EmailSync {
+	return predicate.EmailSync(sql.FieldGTE(FieldFallbackReason, v))
+}
+
+// FallbackReasonLT applies the LT predicate on the "fallback_reason" field.
+func FallbackReasonLT(v string) predicate.EmailSync {
+	return predicate.EmailSync(sql.FieldLT(FieldFallbackReason, v))
+}
+
+// FallbackReasonLTE applies the LTE predicate on the "fallback_reason" field.
+func FallbackReasonLTE(v string) predicate.EmailSync {
+	return predicate.EmailSync(sql.FieldLTE(FieldFallbackReason, v))
+}
+
+// FallbackReasonContains applies the Contains predicate on the "fallback_reason" field.
+func FallbackReasonContains(v string) predicate.EmailSync {
+	return predicate.EmailSync(sql.FieldContains(FieldFallbackReason, v))
+}
+
+// FallbackReasonHasPrefix applies the HasPrefix predicate on the "fallback_reason" field.
+func FallbackReasonHasPrefix(v string) predicate.EmailSync {
+	return predicate.EmailSync(sql.FieldHasPrefix(FieldFallbackReason, v))
+}
+
+// FallbackReasonHasSuffix applies the HasSuffix predicate on the "fallback_reason" field.
+func FallbackReasonHasSuffix(v string) predicate.EmailSync {
+	return predicate.EmailSync(sql.FieldHasSuffix(FieldFallbackReason, v))
+}
+
+// FallbackReasonIsNil applies the IsNil predicate on the "fallback_reason" field.
+func FallbackReasonIsNil() predicate.EmailSync {
+	return predicate.EmailSync(sql.FieldIsNull(FieldFallbackReason))
+}
+
+// FallbackReasonNotNil applies the NotNil predicate on the "fallback_reason" field.
+func FallbackReasonNotNil() predicate.EmailSync {
+	return predicate.EmailSync(sql.FieldNotNull(FieldFallbackReason))
+}
+
+// FallbackReasonEqualFold applies the EqualFold predicate on the "fallback_reason" field.
+func FallbackReasonEqualFold(v string) predicate.EmailSync {
+	return predicate.EmailSync(sql.FieldEqualFold(FieldFallbackReason, v))
+}
+
+// FallbackReasonContainsFold applies the ContainsFold predicate on the "fallback_reason" field.
+func FallbackReasonContainsFold(v string) predicate.EmailSync {
+	return predicate.EmailSync(sql.FieldContainsFold(FieldFallbackReason, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.EmailSync {
 	return predicate.EmailSync(sql.FieldEQ(FieldCreatedAt, v))
@@ -918,6 +1008,29 @@ func HasConnectionWith(preds ...predicate.EmailConnection) predicate.EmailSync {
 	})
 }
 
+// HasFailures applies the HasEdge predicate on the "failures" edge.
+func HasFailures() predicate.EmailSync {
+	return predicate.EmailSync(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FailuresTable, FailuresColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFailuresWith applies the HasEdge predicate on the "failures" edge with a given conditions (other predicates).
+func HasFailuresWith(preds ...predicate.EmailSyncFailure) predicate.EmailSync {
+	return predicate.EmailSync(func(s *sql.Selector) {
+		step := newFailuresStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.EmailSync) predicate.EmailSync {
 	return predicate.EmailSync(sql.AndPredicates(predicates...))
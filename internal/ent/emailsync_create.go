@@ -5,11 +5,14 @@ package ent
 import (
 	"clockzen-next/internal/ent/emailconnection"
 	"clockzen-next/internal/ent/emailsync"
+	"clockzen-next/internal/ent/emailsyncfailure"
 	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -19,6 +22,7 @@ type EmailSyncCreate struct {
 	config
 	mutation *EmailSyncMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetConnectionID sets the "connection_id" field.
@@ -201,6 +205,12 @@ func (_c *EmailSyncCreate) SetErrorDetails(v map[string]interface{}) *EmailSyncC
 	return _c
 }
 
+// SetCheckpoints sets the "checkpoints" field.
+func (_c *EmailSyncCreate) SetCheckpoints(v map[string]interface{}) *EmailSyncCreate {
+	_c.mutation.SetCheckpoints(v)
+	return _c
+}
+
 // SetHistoryID sets the "history_id" field.
 func (_c *EmailSyncCreate) SetHistoryID(v string) *EmailSyncCreate {
 	_c.mutation.SetHistoryID(v)
@@ -215,6 +225,20 @@ func (_c *EmailSyncCreate) SetNillableHistoryID(v *string) *EmailSyncCreate {
 	return _c
 }
 
+// SetFallbackReason sets the "fallback_reason" field.
+func (_c *EmailSyncCreate) SetFallbackReason(v string) *EmailSyncCreate {
+	_c.mutation.SetFallbackReason(v)
+	return _c
+}
+
+// SetNillableFallbackReason sets the "fallback_reason" field if the given value is not nil.
+func (_c *EmailSyncCreate) SetNillableFallbackReason(v *string) *EmailSyncCreate {
+	if v != nil {
+		_c.SetFallbackReason(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *EmailSyncCreate) SetCreatedAt(v time.Time) *EmailSyncCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -254,6 +278,21 @@ func (_c *EmailSyncCreate) SetConnection(v *EmailConnection) *EmailSyncCreate {
 	return _c.SetConnectionID(v.ID)
 }
 
+// AddFailureIDs adds the "failures" edge to the EmailSyncFailure entity by IDs.
+func (_c *EmailSyncCreate) AddFailureIDs(ids ...string) *EmailSyncCreate {
+	_c.mutation.AddFailureIDs(ids...)
+	return _c
+}
+
+// AddFailures adds the "failures" edges to the EmailSyncFailure entity.
+func (_c *EmailSyncCreate) AddFailures(v ...*EmailSyncFailure) *EmailSyncCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddFailureIDs(ids...)
+}
+
 // Mutation returns the EmailSyncMutation object of the builder.
 func (_c *EmailSyncCreate) Mutation() *EmailSyncMutation {
 	return _c.mutation
@@ -415,6 +454,7 @@ func (_c *EmailSyncCreate) createSpec() (*EmailSync, *sqlgraph.CreateSpec) {
 		_node = &EmailSync{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(emailsync.Table, sqlgraph.NewFieldSpec(emailsync.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -471,10 +511,18 @@ func (_c *EmailSyncCreate) createSpec() (*EmailSync, *sqlgraph.CreateSpec) {
 		_spec.SetField(emailsync.FieldErrorDetails, field.TypeJSON, value)
 		_node.ErrorDetails = value
 	}
+	if value, ok := _c.mutation.Checkpoints(); ok {
+		_spec.SetField(emailsync.FieldCheckpoints, field.TypeJSON, value)
+		_node.Checkpoints = value
+	}
 	if value, ok := _c.mutation.HistoryID(); ok {
 		_spec.SetField(emailsync.FieldHistoryID, field.TypeString, value)
 		_node.HistoryID = &value
 	}
+	if value, ok := _c.mutation.FallbackReason(); ok {
+		_spec.SetField(emailsync.FieldFallbackReason, field.TypeString, value)
+		_node.FallbackReason = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(emailsync.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -500,14 +548,819 @@ func (_c *EmailSyncCreate) createSpec() (*EmailSync, *sqlgraph.CreateSpec) {
 		_node.ConnectionID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.FailuresIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   emailsync.FailuresTable,
+			Columns: []string{emailsync.FailuresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailsyncfailure.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmailSync.Create().
+//		SetConnectionID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmailSyncUpsert) {
+//			SetConnectionID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EmailSyncCreate) OnConflict(opts ...sql.ConflictOption) *EmailSyncUpsertOne {
+	_c.conflict = opts
+	return &EmailSyncUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmailSync.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EmailSyncCreate) OnConflictColumns(columns ...string) *EmailSyncUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EmailSyncUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EmailSyncUpsertOne is the builder for "upsert"-ing
+	//  one EmailSync node.
+	EmailSyncUpsertOne struct {
+		create *EmailSyncCreate
+	}
+
+	// EmailSyncUpsert is the "OnConflict" setter.
+	EmailSyncUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetConnectionID sets the "connection_id" field.
+func (u *EmailSyncUpsert) SetConnectionID(v string) *EmailSyncUpsert {
+	u.Set(emailsync.FieldConnectionID, v)
+	return u
+}
+
+// UpdateConnectionID sets the "connection_id" field to the value that was provided on create.
+func (u *EmailSyncUpsert) UpdateConnectionID() *EmailSyncUpsert {
+	u.SetExcluded(emailsync.FieldConnectionID)
+	return u
+}
+
+// SetLabelID sets the "label_id" field.
+func (u *EmailSyncUpsert) SetLabelID(v string) *EmailSyncUpsert {
+	u.Set(emailsync.FieldLabelID, v)
+	return u
+}
+
+// UpdateLabelID sets the "label_id" field to the value that was provided on create.
+func (u *EmailSyncUpsert) UpdateLabelID() *EmailSyncUpsert {
+	u.SetExcluded(emailsync.FieldLabelID)
+	return u
+}
+
+// ClearLabelID clears the value of the "label_id" field.
+func (u *EmailSyncUpsert) ClearLabelID() *EmailSyncUpsert {
+	u.SetNull(emailsync.FieldLabelID)
+	return u
+}
+
+// SetSyncType sets the "sync_type" field.
+func (u *EmailSyncUpsert) SetSyncType(v emailsync.SyncType) *EmailSyncUpsert {
+	u.Set(emailsync.FieldSyncType, v)
+	return u
+}
+
+// UpdateSyncType sets the "sync_type" field to the value that was provided on create.
+func (u *EmailSyncUpsert) UpdateSyncType() *EmailSyncUpsert {
+	u.SetExcluded(emailsync.FieldSyncType)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *EmailSyncUpsert) SetStatus(v emailsync.Status) *EmailSyncUpsert {
+	u.Set(emailsync.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EmailSyncUpsert) UpdateStatus() *EmailSyncUpsert {
+	u.SetExcluded(emailsync.FieldStatus)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *EmailSyncUpsert) SetStartedAt(v time.Time) *EmailSyncUpsert {
+	u.Set(emailsync.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *EmailSyncUpsert) UpdateStartedAt() *EmailSyncUpsert {
+	u.SetExcluded(emailsync.FieldStartedAt)
+	return u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *EmailSyncUpsert) ClearStartedAt() *EmailSyncUpsert {
+	u.SetNull(emailsync.FieldStartedAt)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *EmailSyncUpsert) SetCompletedAt(v time.Time) *EmailSyncUpsert {
+	u.Set(emailsync.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *EmailSyncUpsert) UpdateCompletedAt() *EmailSyncUpsert {
+	u.SetExcluded(emailsync.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *EmailSyncUpsert) ClearCompletedAt() *EmailSyncUpsert {
+	u.SetNull(emailsync.FieldCompletedAt)
+	return u
+}
+
+// SetMessagesScanned sets the "messages_scanned" field.
+func (u *EmailSyncUpsert) SetMessagesScanned(v int) *EmailSyncUpsert {
+	u.Set(emailsync.FieldMessagesScanned, v)
+	return u
+}
+
+// UpdateMessagesScanned sets the "messages_scanned" field to the value that was provided on create.
+func (u *EmailSyncUpsert) UpdateMessagesScanned() *EmailSyncUpsert {
+	u.SetExcluded(emailsync.FieldMessagesScanned)
+	return u
+}
+
+// AddMessagesScanned adds v to the "messages_scanned" field.
+func (u *EmailSyncUpsert) AddMessagesScanned(v int) *EmailSyncUpsert {
+	u.Add(emailsync.FieldMessagesScanned, v)
+	return u
+}
+
+// SetMessagesDownloaded sets the "messages_downloaded" field.
+func (u *EmailSyncUpsert) SetMessagesDownloaded(v int) *EmailSyncUpsert {
+	u.Set(emailsync.FieldMessagesDownloaded, v)
+	return u
+}
+
+// UpdateMessagesDownloaded sets the "messages_downloaded" field to the value that was provided on create.
+func (u *EmailSyncUpsert) UpdateMessagesDownloaded() *EmailSyncUpsert {
+	u.SetExcluded(emailsync.FieldMessagesDownloaded)
+	return u
+}
+
+// AddMessagesDownloaded adds v to the "messages_downloaded" field.
+func (u *EmailSyncUpsert) AddMessagesDownloaded(v int) *EmailSyncUpsert {
+	u.Add(emailsync.FieldMessagesDownloaded, v)
+	return u
+}
+
+// SetMessagesIndexed sets the "messages_indexed" field.
+func (u *EmailSyncUpsert) SetMessagesIndexed(v int) *EmailSyncUpsert {
+	u.Set(emailsync.FieldMessagesIndexed, v)
+	return u
+}
+
+// UpdateMessagesIndexed sets the "messages_indexed" field to the value that was provided on create.
+func (u *EmailSyncUpsert) UpdateMessagesIndexed() *EmailSyncUpsert {
+	u.SetExcluded(emailsync.FieldMessagesIndexed)
+	return u
+}
+
+// AddMessagesIndexed adds v to the "messages_indexed" field.
+func (u *EmailSyncUpsert) AddMessagesIndexed(v int) *EmailSyncUpsert {
+	u.Add(emailsync.FieldMessagesIndexed, v)
+	return u
+}
+
+// SetMessagesFailed sets the "messages_failed" field.
+func (u *EmailSyncUpsert) SetMessagesFailed(v int) *EmailSyncUpsert {
+	u.Set(emailsync.FieldMessagesFailed, v)
+	return u
+}
+
+// UpdateMessagesFailed sets the "messages_failed" field to the value that was provided on create.
+func (u *EmailSyncUpsert) UpdateMessagesFailed() *EmailSyncUpsert {
+	u.SetExcluded(emailsync.FieldMessagesFailed)
+	return u
+}
+
+// AddMessagesFailed adds v to the "messages_failed" field.
+func (u *EmailSyncUpsert) AddMessagesFailed(v int) *EmailSyncUpsert {
+	u.Add(emailsync.FieldMessagesFailed, v)
+	return u
+}
+
+// SetAttachmentsDownloaded sets the "attachments_downloaded" field.
+func (u *EmailSyncUpsert) SetAttachmentsDownloaded(v int) *EmailSyncUpsert {
+	u.Set(emailsync.FieldAttachmentsDownloaded, v)
+	return u
+}
+
+// UpdateAttachmentsDownloaded sets the "attachments_downloaded" field to the value that was provided on create.
+func (u *EmailSyncUpsert) UpdateAttachmentsDownloaded() *EmailSyncUpsert {
+	u.SetExcluded(emailsync.FieldAttachmentsDownloaded)
+	return u
+}
+
+// AddAttachmentsDownloaded adds v to the "attachments_downloaded" field.
+func (u *EmailSyncUpsert) AddAttachmentsDownloaded(v int) *EmailSyncUpsert {
+	u.Add(emailsync.FieldAttachmentsDownloaded, v)
+	return u
+}
+
+// SetBytesTransferred sets the "bytes_transferred" field.
+func (u *EmailSyncUpsert) SetBytesTransferred(v int64) *EmailSyncUpsert {
+	u.Set(emailsync.FieldBytesTransferred, v)
+	return u
+}
+
+// UpdateBytesTransferred sets the "bytes_transferred" field to the value that was provided on create.
+func (u *EmailSyncUpsert) UpdateBytesTransferred() *EmailSyncUpsert {
+	u.SetExcluded(emailsync.FieldBytesTransferred)
+	return u
+}
+
+// AddBytesTransferred adds v to the "bytes_transferred" field.
+func (u *EmailSyncUpsert) AddBytesTransferred(v int64) *EmailSyncUpsert {
+	u.Add(emailsync.FieldBytesTransferred, v)
+	return u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *EmailSyncUpsert) SetErrorMessage(v string) *EmailSyncUpsert {
+	u.Set(emailsync.FieldErrorMessage, v)
+	return u
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *EmailSyncUpsert) UpdateErrorMessage() *EmailSyncUpsert {
+	u.SetExcluded(emailsync.FieldErrorMessage)
+	return u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *EmailSyncUpsert) ClearErrorMessage() *EmailSyncUpsert {
+	u.SetNull(emailsync.FieldErrorMessage)
+	return u
+}
+
+// SetErrorDetails sets the "error_details" field.
+func (u *EmailSyncUpsert) SetErrorDetails(v map[string]interface{}) *EmailSyncUpsert {
+	u.Set(emailsync.FieldErrorDetails, v)
+	return u
+}
+
+// UpdateErrorDetails sets the "error_details" field to the value that was provided on create.
+func (u *EmailSyncUpsert) UpdateErrorDetails() *EmailSyncUpsert {
+	u.SetExcluded(emailsync.FieldErrorDetails)
+	return u
+}
+
+// ClearErrorDetails clears the value of the "error_details" field.
+func (u *EmailSyncUpsert) ClearErrorDetails() *EmailSyncUpsert {
+	u.SetNull(emailsync.FieldErrorDetails)
+	return u
+}
+
+// SetCheckpoints sets the "checkpoints" field.
+func (u *EmailSyncUpsert) SetCheckpoints(v map[string]interface{}) *EmailSyncUpsert {
+	u.Set(emailsync.FieldCheckpoints, v)
+	return u
+}
+
+// UpdateCheckpoints sets the "checkpoints" field to the value that was provided on create.
+func (u *EmailSyncUpsert) UpdateCheckpoints() *EmailSyncUpsert {
+	u.SetExcluded(emailsync.FieldCheckpoints)
+	return u
+}
+
+// ClearCheckpoints clears the value of the "checkpoints" field.
+func (u *EmailSyncUpsert) ClearCheckpoints() *EmailSyncUpsert {
+	u.SetNull(emailsync.FieldCheckpoints)
+	return u
+}
+
+// SetHistoryID sets the "history_id" field.
+func (u *EmailSyncUpsert) SetHistoryID(v string) *EmailSyncUpsert {
+	u.Set(emailsync.FieldHistoryID, v)
+	return u
+}
+
+// UpdateHistoryID sets the "history_id" field to the value that was provided on create.
+func (u *EmailSyncUpsert) UpdateHistoryID() *EmailSyncUpsert {
+	u.SetExcluded(emailsync.FieldHistoryID)
+	return u
+}
+
+// ClearHistoryID clears the value of the "history_id" field.
+func (u *EmailSyncUpsert) ClearHistoryID() *EmailSyncUpsert {
+	u.SetNull(emailsync.FieldHistoryID)
+	return u
+}
+
+// SetFallbackReason sets the "fallback_reason" field.
+func (u *EmailSyncUpsert) SetFallbackReason(v string) *EmailSyncUpsert {
+	u.Set(emailsync.FieldFallbackReason, v)
+	return u
+}
+
+// UpdateFallbackReason sets the "fallback_reason" field to the value that was provided on create.
+func (u *EmailSyncUpsert) UpdateFallbackReason() *EmailSyncUpsert {
+	u.SetExcluded(emailsync.FieldFallbackReason)
+	return u
+}
+
+// ClearFallbackReason clears the value of the "fallback_reason" field.
+func (u *EmailSyncUpsert) ClearFallbackReason() *EmailSyncUpsert {
+	u.SetNull(emailsync.FieldFallbackReason)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmailSyncUpsert) SetUpdatedAt(v time.Time) *EmailSyncUpsert {
+	u.Set(emailsync.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmailSyncUpsert) UpdateUpdatedAt() *EmailSyncUpsert {
+	u.SetExcluded(emailsync.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EmailSync.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emailsync.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmailSyncUpsertOne) UpdateNewValues() *EmailSyncUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(emailsync.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(emailsync.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmailSync.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EmailSyncUpsertOne) Ignore() *EmailSyncUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmailSyncUpsertOne) DoNothing() *EmailSyncUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmailSyncCreate.OnConflict
+// documentation for more info.
+func (u *EmailSyncUpsertOne) Update(set func(*EmailSyncUpsert)) *EmailSyncUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmailSyncUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (u *EmailSyncUpsertOne) SetConnectionID(v string) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetConnectionID(v)
+	})
+}
+
+// UpdateConnectionID sets the "connection_id" field to the value that was provided on create.
+func (u *EmailSyncUpsertOne) UpdateConnectionID() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateConnectionID()
+	})
+}
+
+// SetLabelID sets the "label_id" field.
+func (u *EmailSyncUpsertOne) SetLabelID(v string) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetLabelID(v)
+	})
+}
+
+// UpdateLabelID sets the "label_id" field to the value that was provided on create.
+func (u *EmailSyncUpsertOne) UpdateLabelID() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateLabelID()
+	})
+}
+
+// ClearLabelID clears the value of the "label_id" field.
+func (u *EmailSyncUpsertOne) ClearLabelID() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.ClearLabelID()
+	})
+}
+
+// SetSyncType sets the "sync_type" field.
+func (u *EmailSyncUpsertOne) SetSyncType(v emailsync.SyncType) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetSyncType(v)
+	})
+}
+
+// UpdateSyncType sets the "sync_type" field to the value that was provided on create.
+func (u *EmailSyncUpsertOne) UpdateSyncType() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateSyncType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *EmailSyncUpsertOne) SetStatus(v emailsync.Status) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EmailSyncUpsertOne) UpdateStatus() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *EmailSyncUpsertOne) SetStartedAt(v time.Time) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *EmailSyncUpsertOne) UpdateStartedAt() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *EmailSyncUpsertOne) ClearStartedAt() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *EmailSyncUpsertOne) SetCompletedAt(v time.Time) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *EmailSyncUpsertOne) UpdateCompletedAt() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *EmailSyncUpsertOne) ClearCompletedAt() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetMessagesScanned sets the "messages_scanned" field.
+func (u *EmailSyncUpsertOne) SetMessagesScanned(v int) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetMessagesScanned(v)
+	})
+}
+
+// AddMessagesScanned adds v to the "messages_scanned" field.
+func (u *EmailSyncUpsertOne) AddMessagesScanned(v int) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.AddMessagesScanned(v)
+	})
+}
+
+// UpdateMessagesScanned sets the "messages_scanned" field to the value that was provided on create.
+func (u *EmailSyncUpsertOne) UpdateMessagesScanned() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateMessagesScanned()
+	})
+}
+
+// SetMessagesDownloaded sets the "messages_downloaded" field.
+func (u *EmailSyncUpsertOne) SetMessagesDownloaded(v int) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetMessagesDownloaded(v)
+	})
+}
+
+// AddMessagesDownloaded adds v to the "messages_downloaded" field.
+func (u *EmailSyncUpsertOne) AddMessagesDownloaded(v int) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.AddMessagesDownloaded(v)
+	})
+}
+
+// UpdateMessagesDownloaded sets the "messages_downloaded" field to the value that was provided on create.
+func (u *EmailSyncUpsertOne) UpdateMessagesDownloaded() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateMessagesDownloaded()
+	})
+}
+
+// SetMessagesIndexed sets the "messages_indexed" field.
+func (u *EmailSyncUpsertOne) SetMessagesIndexed(v int) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetMessagesIndexed(v)
+	})
+}
+
+// AddMessagesIndexed adds v to the "messages_indexed" field.
+func (u *EmailSyncUpsertOne) AddMessagesIndexed(v int) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.AddMessagesIndexed(v)
+	})
+}
+
+// UpdateMessagesIndexed sets the "messages_indexed" field to the value that was provided on create.
+func (u *EmailSyncUpsertOne) UpdateMessagesIndexed() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateMessagesIndexed()
+	})
+}
+
+// SetMessagesFailed sets the "messages_failed" field.
+func (u *EmailSyncUpsertOne) SetMessagesFailed(v int) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetMessagesFailed(v)
+	})
+}
+
+// AddMessagesFailed adds v to the "messages_failed" field.
+func (u *EmailSyncUpsertOne) AddMessagesFailed(v int) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.AddMessagesFailed(v)
+	})
+}
+
+// UpdateMessagesFailed sets the "messages_failed" field to the value that was provided on create.
+func (u *EmailSyncUpsertOne) UpdateMessagesFailed() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateMessagesFailed()
+	})
+}
+
+// SetAttachmentsDownloaded sets the "attachments_downloaded" field.
+func (u *EmailSyncUpsertOne) SetAttachmentsDownloaded(v int) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetAttachmentsDownloaded(v)
+	})
+}
+
+// AddAttachmentsDownloaded adds v to the "attachments_downloaded" field.
+func (u *EmailSyncUpsertOne) AddAttachmentsDownloaded(v int) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.AddAttachmentsDownloaded(v)
+	})
+}
+
+// UpdateAttachmentsDownloaded sets the "attachments_downloaded" field to the value that was provided on create.
+func (u *EmailSyncUpsertOne) UpdateAttachmentsDownloaded() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateAttachmentsDownloaded()
+	})
+}
+
+// SetBytesTransferred sets the "bytes_transferred" field.
+func (u *EmailSyncUpsertOne) SetBytesTransferred(v int64) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetBytesTransferred(v)
+	})
+}
+
+// AddBytesTransferred adds v to the "bytes_transferred" field.
+func (u *EmailSyncUpsertOne) AddBytesTransferred(v int64) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.AddBytesTransferred(v)
+	})
+}
+
+// UpdateBytesTransferred sets the "bytes_transferred" field to the value that was provided on create.
+func (u *EmailSyncUpsertOne) UpdateBytesTransferred() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateBytesTransferred()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *EmailSyncUpsertOne) SetErrorMessage(v string) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *EmailSyncUpsertOne) UpdateErrorMessage() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *EmailSyncUpsertOne) ClearErrorMessage() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetErrorDetails sets the "error_details" field.
+func (u *EmailSyncUpsertOne) SetErrorDetails(v map[string]interface{}) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetErrorDetails(v)
+	})
+}
+
+// UpdateErrorDetails sets the "error_details" field to the value that was provided on create.
+func (u *EmailSyncUpsertOne) UpdateErrorDetails() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateErrorDetails()
+	})
+}
+
+// ClearErrorDetails clears the value of the "error_details" field.
+func (u *EmailSyncUpsertOne) ClearErrorDetails() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.ClearErrorDetails()
+	})
+}
+
+// SetCheckpoints sets the "checkpoints" field.
+func (u *EmailSyncUpsertOne) SetCheckpoints(v map[string]interface{}) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetCheckpoints(v)
+	})
+}
+
+// UpdateCheckpoints sets the "checkpoints" field to the value that was provided on create.
+func (u *EmailSyncUpsertOne) UpdateCheckpoints() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateCheckpoints()
+	})
+}
+
+// ClearCheckpoints clears the value of the "checkpoints" field.
+func (u *EmailSyncUpsertOne) ClearCheckpoints() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.ClearCheckpoints()
+	})
+}
+
+// SetHistoryID sets the "history_id" field.
+func (u *EmailSyncUpsertOne) SetHistoryID(v string) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetHistoryID(v)
+	})
+}
+
+// UpdateHistoryID sets the "history_id" field to the value that was provided on create.
+func (u *EmailSyncUpsertOne) UpdateHistoryID() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateHistoryID()
+	})
+}
+
+// ClearHistoryID clears the value of the "history_id" field.
+func (u *EmailSyncUpsertOne) ClearHistoryID() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.ClearHistoryID()
+	})
+}
+
+// SetFallbackReason sets the "fallback_reason" field.
+func (u *EmailSyncUpsertOne) SetFallbackReason(v string) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetFallbackReason(v)
+	})
+}
+
+// UpdateFallbackReason sets the "fallback_reason" field to the value that was provided on create.
+func (u *EmailSyncUpsertOne) UpdateFallbackReason() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateFallbackReason()
+	})
+}
+
+// ClearFallbackReason clears the value of the "fallback_reason" field.
+func (u *EmailSyncUpsertOne) ClearFallbackReason() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.ClearFallbackReason()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmailSyncUpsertOne) SetUpdatedAt(v time.Time) *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmailSyncUpsertOne) UpdateUpdatedAt() *EmailSyncUpsertOne {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmailSyncUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmailSyncCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmailSyncUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EmailSyncUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EmailSyncUpsertOne.ID is not supported by MySQL driver. Use EmailSyncUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EmailSyncUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // EmailSyncCreateBulk is the builder for creating many EmailSync entities in bulk.
 type EmailSyncCreateBulk struct {
 	config
 	err      error
 	builders []*EmailSyncCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the EmailSync entities in the database.
@@ -537,6 +1390,7 @@ func (_c *EmailSyncCreateBulk) Save(ctx context.Context) ([]*EmailSync, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -586,3 +1440,470 @@ func (_c *EmailSyncCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmailSync.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmailSyncUpsert) {
+//			SetConnectionID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EmailSyncCreateBulk) OnConflict(opts ...sql.ConflictOption) *EmailSyncUpsertBulk {
+	_c.conflict = opts
+	return &EmailSyncUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmailSync.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EmailSyncCreateBulk) OnConflictColumns(columns ...string) *EmailSyncUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EmailSyncUpsertBulk{
+		create: _c,
+	}
+}
+
+// EmailSyncUpsertBulk is the builder for "upsert"-ing
+// a bulk of EmailSync nodes.
+type EmailSyncUpsertBulk struct {
+	create *EmailSyncCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EmailSync.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emailsync.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmailSyncUpsertBulk) UpdateNewValues() *EmailSyncUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(emailsync.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(emailsync.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmailSync.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EmailSyncUpsertBulk) Ignore() *EmailSyncUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmailSyncUpsertBulk) DoNothing() *EmailSyncUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmailSyncCreateBulk.OnConflict
+// documentation for more info.
+func (u *EmailSyncUpsertBulk) Update(set func(*EmailSyncUpsert)) *EmailSyncUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmailSyncUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (u *EmailSyncUpsertBulk) SetConnectionID(v string) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetConnectionID(v)
+	})
+}
+
+// UpdateConnectionID sets the "connection_id" field to the value that was provided on create.
+func (u *EmailSyncUpsertBulk) UpdateConnectionID() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateConnectionID()
+	})
+}
+
+// SetLabelID sets the "label_id" field.
+func (u *EmailSyncUpsertBulk) SetLabelID(v string) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetLabelID(v)
+	})
+}
+
+// UpdateLabelID sets the "label_id" field to the value that was provided on create.
+func (u *EmailSyncUpsertBulk) UpdateLabelID() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateLabelID()
+	})
+}
+
+// ClearLabelID clears the value of the "label_id" field.
+func (u *EmailSyncUpsertBulk) ClearLabelID() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.ClearLabelID()
+	})
+}
+
+// SetSyncType sets the "sync_type" field.
+func (u *EmailSyncUpsertBulk) SetSyncType(v emailsync.SyncType) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetSyncType(v)
+	})
+}
+
+// UpdateSyncType sets the "sync_type" field to the value that was provided on create.
+func (u *EmailSyncUpsertBulk) UpdateSyncType() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateSyncType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *EmailSyncUpsertBulk) SetStatus(v emailsync.Status) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EmailSyncUpsertBulk) UpdateStatus() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *EmailSyncUpsertBulk) SetStartedAt(v time.Time) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *EmailSyncUpsertBulk) UpdateStartedAt() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *EmailSyncUpsertBulk) ClearStartedAt() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *EmailSyncUpsertBulk) SetCompletedAt(v time.Time) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *EmailSyncUpsertBulk) UpdateCompletedAt() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *EmailSyncUpsertBulk) ClearCompletedAt() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetMessagesScanned sets the "messages_scanned" field.
+func (u *EmailSyncUpsertBulk) SetMessagesScanned(v int) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetMessagesScanned(v)
+	})
+}
+
+// AddMessagesScanned adds v to the "messages_scanned" field.
+func (u *EmailSyncUpsertBulk) AddMessagesScanned(v int) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.AddMessagesScanned(v)
+	})
+}
+
+// UpdateMessagesScanned sets the "messages_scanned" field to the value that was provided on create.
+func (u *EmailSyncUpsertBulk) UpdateMessagesScanned() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateMessagesScanned()
+	})
+}
+
+// SetMessagesDownloaded sets the "messages_downloaded" field.
+func (u *EmailSyncUpsertBulk) SetMessagesDownloaded(v int) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetMessagesDownloaded(v)
+	})
+}
+
+// AddMessagesDownloaded adds v to the "messages_downloaded" field.
+func (u *EmailSyncUpsertBulk) AddMessagesDownloaded(v int) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.AddMessagesDownloaded(v)
+	})
+}
+
+// UpdateMessagesDownloaded sets the "messages_downloaded" field to the value that was provided on create.
+func (u *EmailSyncUpsertBulk) UpdateMessagesDownloaded() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateMessagesDownloaded()
+	})
+}
+
+// SetMessagesIndexed sets the "messages_indexed" field.
+func (u *EmailSyncUpsertBulk) SetMessagesIndexed(v int) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetMessagesIndexed(v)
+	})
+}
+
+// AddMessagesIndexed adds v to the "messages_indexed" field.
+func (u *EmailSyncUpsertBulk) AddMessagesIndexed(v int) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.AddMessagesIndexed(v)
+	})
+}
+
+// UpdateMessagesIndexed sets the "messages_indexed" field to the value that was provided on create.
+func (u *EmailSyncUpsertBulk) UpdateMessagesIndexed() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateMessagesIndexed()
+	})
+}
+
+// SetMessagesFailed sets the "messages_failed" field.
+func (u *EmailSyncUpsertBulk) SetMessagesFailed(v int) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetMessagesFailed(v)
+	})
+}
+
+// AddMessagesFailed adds v to the "messages_failed" field.
+func (u *EmailSyncUpsertBulk) AddMessagesFailed(v int) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.AddMessagesFailed(v)
+	})
+}
+
+// UpdateMessagesFailed sets the "messages_failed" field to the value that was provided on create.
+func (u *EmailSyncUpsertBulk) UpdateMessagesFailed() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateMessagesFailed()
+	})
+}
+
+// SetAttachmentsDownloaded sets the "attachments_downloaded" field.
+func (u *EmailSyncUpsertBulk) SetAttachmentsDownloaded(v int) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetAttachmentsDownloaded(v)
+	})
+}
+
+// AddAttachmentsDownloaded adds v to the "attachments_downloaded" field.
+func (u *EmailSyncUpsertBulk) AddAttachmentsDownloaded(v int) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.AddAttachmentsDownloaded(v)
+	})
+}
+
+// UpdateAttachmentsDownloaded sets the "attachments_downloaded" field to the value that was provided on create.
+func (u *EmailSyncUpsertBulk) UpdateAttachmentsDownloaded() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateAttachmentsDownloaded()
+	})
+}
+
+// SetBytesTransferred sets the "bytes_transferred" field.
+func (u *EmailSyncUpsertBulk) SetBytesTransferred(v int64) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetBytesTransferred(v)
+	})
+}
+
+// AddBytesTransferred adds v to the "bytes_transferred" field.
+func (u *EmailSyncUpsertBulk) AddBytesTransferred(v int64) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.AddBytesTransferred(v)
+	})
+}
+
+// UpdateBytesTransferred sets the "bytes_transferred" field to the value that was provided on create.
+func (u *EmailSyncUpsertBulk) UpdateBytesTransferred() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateBytesTransferred()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *EmailSyncUpsertBulk) SetErrorMessage(v string) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *EmailSyncUpsertBulk) UpdateErrorMessage() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *EmailSyncUpsertBulk) ClearErrorMessage() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetErrorDetails sets the "error_details" field.
+func (u *EmailSyncUpsertBulk) SetErrorDetails(v map[string]interface{}) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetErrorDetails(v)
+	})
+}
+
+// UpdateErrorDetails sets the "error_details" field to the value that was provided on create.
+func (u *EmailSyncUpsertBulk) UpdateErrorDetails() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateErrorDetails()
+	})
+}
+
+// ClearErrorDetails clears the value of the "error_details" field.
+func (u *EmailSyncUpsertBulk) ClearErrorDetails() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.ClearErrorDetails()
+	})
+}
+
+// SetCheckpoints sets the "checkpoints" field.
+func (u *EmailSyncUpsertBulk) SetCheckpoints(v map[string]interface{}) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetCheckpoints(v)
+	})
+}
+
+// UpdateCheckpoints sets the "checkpoints" field to the value that was provided on create.
+func (u *EmailSyncUpsertBulk) UpdateCheckpoints() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateCheckpoints()
+	})
+}
+
+// ClearCheckpoints clears the value of the "checkpoints" field.
+func (u *EmailSyncUpsertBulk) ClearCheckpoints() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.ClearCheckpoints()
+	})
+}
+
+// SetHistoryID sets the "history_id" field.
+func (u *EmailSyncUpsertBulk) SetHistoryID(v string) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetHistoryID(v)
+	})
+}
+
+// UpdateHistoryID sets the "history_id" field to the value that was provided on create.
+func (u *EmailSyncUpsertBulk) UpdateHistoryID() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateHistoryID()
+	})
+}
+
+// ClearHistoryID clears the value of the "history_id" field.
+func (u *EmailSyncUpsertBulk) ClearHistoryID() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.ClearHistoryID()
+	})
+}
+
+// SetFallbackReason sets the "fallback_reason" field.
+func (u *EmailSyncUpsertBulk) SetFallbackReason(v string) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetFallbackReason(v)
+	})
+}
+
+// UpdateFallbackReason sets the "fallback_reason" field to the value that was provided on create.
+func (u *EmailSyncUpsertBulk) UpdateFallbackReason() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateFallbackReason()
+	})
+}
+
+// ClearFallbackReason clears the value of the "fallback_reason" field.
+func (u *EmailSyncUpsertBulk) ClearFallbackReason() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.ClearFallbackReason()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmailSyncUpsertBulk) SetUpdatedAt(v time.Time) *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmailSyncUpsertBulk) UpdateUpdatedAt() *EmailSyncUpsertBulk {
+	return u.Update(func(s *EmailSyncUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmailSyncUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EmailSyncCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmailSyncCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmailSyncUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -5,8 +5,10 @@ package ent
 import (
 	"clockzen-next/internal/ent/emailconnection"
 	"clockzen-next/internal/ent/emailsync"
+	"clockzen-next/internal/ent/emailsyncfailure"
 	"clockzen-next/internal/ent/predicate"
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -24,6 +26,7 @@ type EmailSyncQuery struct {
 	inters         []Interceptor
 	predicates     []predicate.EmailSync
 	withConnection *EmailConnectionQuery
+	withFailures   *EmailSyncFailureQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -82,6 +85,28 @@ func (_q *EmailSyncQuery) QueryConnection() *EmailConnectionQuery {
 	return query
 }
 
+// QueryFailures chains the current query on the "failures" edge.
+func (_q *EmailSyncQuery) QueryFailures() *EmailSyncFailureQuery {
+	query := (&EmailSyncFailureClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emailsync.Table, emailsync.FieldID, selector),
+			sqlgraph.To(emailsyncfailure.Table, emailsyncfailure.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, emailsync.FailuresTable, emailsync.FailuresColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first EmailSync entity from the query.
 // Returns a *NotFoundError when no EmailSync was found.
 func (_q *EmailSyncQuery) First(ctx context.Context) (*EmailSync, error) {
@@ -275,6 +300,7 @@ func (_q *EmailSyncQuery) Clone() *EmailSyncQuery {
 		inters:         append([]Interceptor{}, _q.inters...),
 		predicates:     append([]predicate.EmailSync{}, _q.predicates...),
 		withConnection: _q.withConnection.Clone(),
+		withFailures:   _q.withFailures.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -292,6 +318,17 @@ func (_q *EmailSyncQuery) WithConnection(opts ...func(*EmailConnectionQuery)) *E
 	return _q
 }
 
+// WithFailures tells the query-builder to eager-load the nodes that are connected to
+// the "failures" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EmailSyncQuery) WithFailures(opts ...func(*EmailSyncFailureQuery)) *EmailSyncQuery {
+	query := (&EmailSyncFailureClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFailures = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -370,8 +407,9 @@ func (_q *EmailSyncQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Em
 	var (
 		nodes       = []*EmailSync{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withConnection != nil,
+			_q.withFailures != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -398,6 +436,13 @@ func (_q *EmailSyncQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Em
 			return nil, err
 		}
 	}
+	if query := _q.withFailures; query != nil {
+		if err := _q.loadFailures(ctx, query, nodes,
+			func(n *EmailSync) { n.Edges.Failures = []*EmailSyncFailure{} },
+			func(n *EmailSync, e *EmailSyncFailure) { n.Edges.Failures = append(n.Edges.Failures, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -430,6 +475,36 @@ func (_q *EmailSyncQuery) loadConnection(ctx context.Context, query *EmailConnec
 	}
 	return nil
 }
+func (_q *EmailSyncQuery) loadFailures(ctx context.Context, query *EmailSyncFailureQuery, nodes []*EmailSync, init func(*EmailSync), assign func(*EmailSync, *EmailSyncFailure)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*EmailSync)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(emailsyncfailure.FieldSyncID)
+	}
+	query.Where(predicate.EmailSyncFailure(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(emailsync.FailuresColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SyncID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "sync_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *EmailSyncQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
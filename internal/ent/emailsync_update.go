@@ -5,6 +5,7 @@ package ent
 import (
 	"clockzen-next/internal/ent/emailconnection"
 	"clockzen-next/internal/ent/emailsync"
+	"clockzen-next/internal/ent/emailsyncfailure"
 	"clockzen-next/internal/ent/predicate"
 	"context"
 	"errors"
@@ -289,6 +290,18 @@ func (_u *EmailSyncUpdate) ClearErrorDetails() *EmailSyncUpdate {
 	return _u
 }
 
+// SetCheckpoints sets the "checkpoints" field.
+func (_u *EmailSyncUpdate) SetCheckpoints(v map[string]interface{}) *EmailSyncUpdate {
+	_u.mutation.SetCheckpoints(v)
+	return _u
+}
+
+// ClearCheckpoints clears the value of the "checkpoints" field.
+func (_u *EmailSyncUpdate) ClearCheckpoints() *EmailSyncUpdate {
+	_u.mutation.ClearCheckpoints()
+	return _u
+}
+
 // SetHistoryID sets the "history_id" field.
 func (_u *EmailSyncUpdate) SetHistoryID(v string) *EmailSyncUpdate {
 	_u.mutation.SetHistoryID(v)
@@ -309,6 +322,26 @@ func (_u *EmailSyncUpdate) ClearHistoryID() *EmailSyncUpdate {
 	return _u
 }
 
+// SetFallbackReason sets the "fallback_reason" field.
+func (_u *EmailSyncUpdate) SetFallbackReason(v string) *EmailSyncUpdate {
+	_u.mutation.SetFallbackReason(v)
+	return _u
+}
+
+// SetNillableFallbackReason sets the "fallback_reason" field if the given value is not nil.
+func (_u *EmailSyncUpdate) SetNillableFallbackReason(v *string) *EmailSyncUpdate {
+	if v != nil {
+		_u.SetFallbackReason(*v)
+	}
+	return _u
+}
+
+// ClearFallbackReason clears the value of the "fallback_reason" field.
+func (_u *EmailSyncUpdate) ClearFallbackReason() *EmailSyncUpdate {
+	_u.mutation.ClearFallbackReason()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *EmailSyncUpdate) SetUpdatedAt(v time.Time) *EmailSyncUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -320,6 +353,21 @@ func (_u *EmailSyncUpdate) SetConnection(v *EmailConnection) *EmailSyncUpdate {
 	return _u.SetConnectionID(v.ID)
 }
 
+// AddFailureIDs adds the "failures" edge to the EmailSyncFailure entity by IDs.
+func (_u *EmailSyncUpdate) AddFailureIDs(ids ...string) *EmailSyncUpdate {
+	_u.mutation.AddFailureIDs(ids...)
+	return _u
+}
+
+// AddFailures adds the "failures" edges to the EmailSyncFailure entity.
+func (_u *EmailSyncUpdate) AddFailures(v ...*EmailSyncFailure) *EmailSyncUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFailureIDs(ids...)
+}
+
 // Mutation returns the EmailSyncMutation object of the builder.
 func (_u *EmailSyncUpdate) Mutation() *EmailSyncMutation {
 	return _u.mutation
@@ -331,6 +379,27 @@ func (_u *EmailSyncUpdate) ClearConnection() *EmailSyncUpdate {
 	return _u
 }
 
+// ClearFailures clears all "failures" edges to the EmailSyncFailure entity.
+func (_u *EmailSyncUpdate) ClearFailures() *EmailSyncUpdate {
+	_u.mutation.ClearFailures()
+	return _u
+}
+
+// RemoveFailureIDs removes the "failures" edge to EmailSyncFailure entities by IDs.
+func (_u *EmailSyncUpdate) RemoveFailureIDs(ids ...string) *EmailSyncUpdate {
+	_u.mutation.RemoveFailureIDs(ids...)
+	return _u
+}
+
+// RemoveFailures removes "failures" edges to EmailSyncFailure entities.
+func (_u *EmailSyncUpdate) RemoveFailures(v ...*EmailSyncFailure) *EmailSyncUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFailureIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *EmailSyncUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -474,12 +543,24 @@ func (_u *EmailSyncUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ErrorDetailsCleared() {
 		_spec.ClearField(emailsync.FieldErrorDetails, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Checkpoints(); ok {
+		_spec.SetField(emailsync.FieldCheckpoints, field.TypeJSON, value)
+	}
+	if _u.mutation.CheckpointsCleared() {
+		_spec.ClearField(emailsync.FieldCheckpoints, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.HistoryID(); ok {
 		_spec.SetField(emailsync.FieldHistoryID, field.TypeString, value)
 	}
 	if _u.mutation.HistoryIDCleared() {
 		_spec.ClearField(emailsync.FieldHistoryID, field.TypeString)
 	}
+	if value, ok := _u.mutation.FallbackReason(); ok {
+		_spec.SetField(emailsync.FieldFallbackReason, field.TypeString, value)
+	}
+	if _u.mutation.FallbackReasonCleared() {
+		_spec.ClearField(emailsync.FieldFallbackReason, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(emailsync.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -512,6 +593,51 @@ func (_u *EmailSyncUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.FailuresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   emailsync.FailuresTable,
+			Columns: []string{emailsync.FailuresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailsyncfailure.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFailuresIDs(); len(nodes) > 0 && !_u.mutation.FailuresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   emailsync.FailuresTable,
+			Columns: []string{emailsync.FailuresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailsyncfailure.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FailuresIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   emailsync.FailuresTable,
+			Columns: []string{emailsync.FailuresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailsyncfailure.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{emailsync.Label}
@@ -792,6 +918,18 @@ func (_u *EmailSyncUpdateOne) ClearErrorDetails() *EmailSyncUpdateOne {
 	return _u
 }
 
+// SetCheckpoints sets the "checkpoints" field.
+func (_u *EmailSyncUpdateOne) SetCheckpoints(v map[string]interface{}) *EmailSyncUpdateOne {
+	_u.mutation.SetCheckpoints(v)
+	return _u
+}
+
+// ClearCheckpoints clears the value of the "checkpoints" field.
+func (_u *EmailSyncUpdateOne) ClearCheckpoints() *EmailSyncUpdateOne {
+	_u.mutation.ClearCheckpoints()
+	return _u
+}
+
 // SetHistoryID sets the "history_id" field.
 func (_u *EmailSyncUpdateOne) SetHistoryID(v string) *EmailSyncUpdateOne {
 	_u.mutation.SetHistoryID(v)
@@ -812,6 +950,26 @@ func (_u *EmailSyncUpdateOne) ClearHistoryID() *EmailSyncUpdateOne {
 	return _u
 }
 
+// SetFallbackReason sets the "fallback_reason" field.
+func (_u *EmailSyncUpdateOne) SetFallbackReason(v string) *EmailSyncUpdateOne {
+	_u.mutation.SetFallbackReason(v)
+	return _u
+}
+
+// SetNillableFallbackReason sets the "fallback_reason" field if the given value is not nil.
+func (_u *EmailSyncUpdateOne) SetNillableFallbackReason(v *string) *EmailSyncUpdateOne {
+	if v != nil {
+		_u.SetFallbackReason(*v)
+	}
+	return _u
+}
+
+// ClearFallbackReason clears the value of the "fallback_reason" field.
+func (_u *EmailSyncUpdateOne) ClearFallbackReason() *EmailSyncUpdateOne {
+	_u.mutation.ClearFallbackReason()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *EmailSyncUpdateOne) SetUpdatedAt(v time.Time) *EmailSyncUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -823,6 +981,21 @@ func (_u *EmailSyncUpdateOne) SetConnection(v *EmailConnection) *EmailSyncUpdate
 	return _u.SetConnectionID(v.ID)
 }
 
+// AddFailureIDs adds the "failures" edge to the EmailSyncFailure entity by IDs.
+func (_u *EmailSyncUpdateOne) AddFailureIDs(ids ...string) *EmailSyncUpdateOne {
+	_u.mutation.AddFailureIDs(ids...)
+	return _u
+}
+
+// AddFailures adds the "failures" edges to the EmailSyncFailure entity.
+func (_u *EmailSyncUpdateOne) AddFailures(v ...*EmailSyncFailure) *EmailSyncUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFailureIDs(ids...)
+}
+
 // Mutation returns the EmailSyncMutation object of the builder.
 func (_u *EmailSyncUpdateOne) Mutation() *EmailSyncMutation {
 	return _u.mutation
@@ -834,6 +1007,27 @@ func (_u *EmailSyncUpdateOne) ClearConnection() *EmailSyncUpdateOne {
 	return _u
 }
 
+// ClearFailures clears all "failures" edges to the EmailSyncFailure entity.
+func (_u *EmailSyncUpdateOne) ClearFailures() *EmailSyncUpdateOne {
+	_u.mutation.ClearFailures()
+	return _u
+}
+
+// RemoveFailureIDs removes the "failures" edge to EmailSyncFailure entities by IDs.
+func (_u *EmailSyncUpdateOne) RemoveFailureIDs(ids ...string) *EmailSyncUpdateOne {
+	_u.mutation.RemoveFailureIDs(ids...)
+	return _u
+}
+
+// RemoveFailures removes "failures" edges to EmailSyncFailure entities.
+func (_u *EmailSyncUpdateOne) RemoveFailures(v ...*EmailSyncFailure) *EmailSyncUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFailureIDs(ids...)
+}
+
 // Where appends a list predicates to the EmailSyncUpdate builder.
 func (_u *EmailSyncUpdateOne) Where(ps ...predicate.EmailSync) *EmailSyncUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1007,12 +1201,24 @@ func (_u *EmailSyncUpdateOne) sqlSave(ctx context.Context) (_node *EmailSync, er
 	if _u.mutation.ErrorDetailsCleared() {
 		_spec.ClearField(emailsync.FieldErrorDetails, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Checkpoints(); ok {
+		_spec.SetField(emailsync.FieldCheckpoints, field.TypeJSON, value)
+	}
+	if _u.mutation.CheckpointsCleared() {
+		_spec.ClearField(emailsync.FieldCheckpoints, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.HistoryID(); ok {
 		_spec.SetField(emailsync.FieldHistoryID, field.TypeString, value)
 	}
 	if _u.mutation.HistoryIDCleared() {
 		_spec.ClearField(emailsync.FieldHistoryID, field.TypeString)
 	}
+	if value, ok := _u.mutation.FallbackReason(); ok {
+		_spec.SetField(emailsync.FieldFallbackReason, field.TypeString, value)
+	}
+	if _u.mutation.FallbackReasonCleared() {
+		_spec.ClearField(emailsync.FieldFallbackReason, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(emailsync.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -1045,6 +1251,51 @@ func (_u *EmailSyncUpdateOne) sqlSave(ctx context.Context) (_node *EmailSync, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.FailuresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   emailsync.FailuresTable,
+			Columns: []string{emailsync.FailuresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailsyncfailure.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFailuresIDs(); len(nodes) > 0 && !_u.mutation.FailuresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   emailsync.FailuresTable,
+			Columns: []string{emailsync.FailuresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailsyncfailure.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FailuresIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   emailsync.FailuresTable,
+			Columns: []string{emailsync.FailuresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailsyncfailure.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &EmailSync{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,226 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/emailsync"
+	"clockzen-next/internal/ent/emailsyncfailure"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// EmailSyncFailure is the model entity for the EmailSyncFailure schema.
+type EmailSyncFailure struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ID of the EmailSync the message failed in
+	SyncID string `json:"sync_id,omitempty"`
+	// ID of the EmailConnection the message belongs to
+	ConnectionID string `json:"connection_id,omitempty"`
+	// Message ID from the email provider
+	MessageID string `json:"message_id,omitempty"`
+	// Whether the message is still failing or was processed by a retry
+	Status emailsyncfailure.Status `json:"status,omitempty"`
+	// Error from the most recent attempt
+	ErrorMessage string `json:"error_message,omitempty"`
+	// Number of times processing the message was attempted
+	Attempts int `json:"attempts,omitempty"`
+	// When a retry processed the message
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EmailSyncFailureQuery when eager-loading is set.
+	Edges        EmailSyncFailureEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EmailSyncFailureEdges holds the relations/edges for other nodes in the graph.
+type EmailSyncFailureEdges struct {
+	// The sync this failure was recorded in
+	Sync *EmailSync `json:"sync,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SyncOrErr returns the Sync value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmailSyncFailureEdges) SyncOrErr() (*EmailSync, error) {
+	if e.Sync != nil {
+		return e.Sync, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: emailsync.Label}
+	}
+	return nil, &NotLoadedError{edge: "sync"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmailSyncFailure) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emailsyncfailure.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case emailsyncfailure.FieldID, emailsyncfailure.FieldSyncID, emailsyncfailure.FieldConnectionID, emailsyncfailure.FieldMessageID, emailsyncfailure.FieldStatus, emailsyncfailure.FieldErrorMessage:
+			values[i] = new(sql.NullString)
+		case emailsyncfailure.FieldResolvedAt, emailsyncfailure.FieldCreatedAt, emailsyncfailure.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmailSyncFailure fields.
+func (_m *EmailSyncFailure) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emailsyncfailure.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case emailsyncfailure.FieldSyncID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sync_id", values[i])
+			} else if value.Valid {
+				_m.SyncID = value.String
+			}
+		case emailsyncfailure.FieldConnectionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field connection_id", values[i])
+			} else if value.Valid {
+				_m.ConnectionID = value.String
+			}
+		case emailsyncfailure.FieldMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				_m.MessageID = value.String
+			}
+		case emailsyncfailure.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = emailsyncfailure.Status(value.String)
+			}
+		case emailsyncfailure.FieldErrorMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_message", values[i])
+			} else if value.Valid {
+				_m.ErrorMessage = value.String
+			}
+		case emailsyncfailure.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case emailsyncfailure.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				_m.ResolvedAt = new(time.Time)
+				*_m.ResolvedAt = value.Time
+			}
+		case emailsyncfailure.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case emailsyncfailure.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmailSyncFailure.
+// This includes values selected through modifiers, order, etc.
+func (_m *EmailSyncFailure) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySync queries the "sync" edge of the EmailSyncFailure entity.
+func (_m *EmailSyncFailure) QuerySync() *EmailSyncQuery {
+	return NewEmailSyncFailureClient(_m.config).QuerySync(_m)
+}
+
+// Update returns a builder for updating this EmailSyncFailure.
+// Note that you need to call EmailSyncFailure.Unwrap() before calling this method if this EmailSyncFailure
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EmailSyncFailure) Update() *EmailSyncFailureUpdateOne {
+	return NewEmailSyncFailureClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EmailSyncFailure entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EmailSyncFailure) Unwrap() *EmailSyncFailure {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmailSyncFailure is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EmailSyncFailure) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmailSyncFailure(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("sync_id=")
+	builder.WriteString(_m.SyncID)
+	builder.WriteString(", ")
+	builder.WriteString("connection_id=")
+	builder.WriteString(_m.ConnectionID)
+	builder.WriteString(", ")
+	builder.WriteString("message_id=")
+	builder.WriteString(_m.MessageID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("error_message=")
+	builder.WriteString(_m.ErrorMessage)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	if v := _m.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmailSyncFailures is a parsable slice of EmailSyncFailure.
+type EmailSyncFailures []*EmailSyncFailure
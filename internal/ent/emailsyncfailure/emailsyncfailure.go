@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emailsyncfailure
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the emailsyncfailure type in the database.
+	Label = "email_sync_failure"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSyncID holds the string denoting the sync_id field in the database.
+	FieldSyncID = "sync_id"
+	// FieldConnectionID holds the string denoting the connection_id field in the database.
+	FieldConnectionID = "connection_id"
+	// FieldMessageID holds the string denoting the message_id field in the database.
+	FieldMessageID = "message_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldErrorMessage holds the string denoting the error_message field in the database.
+	FieldErrorMessage = "error_message"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
+	FieldResolvedAt = "resolved_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeSync holds the string denoting the sync edge name in mutations.
+	EdgeSync = "sync"
+	// Table holds the table name of the emailsyncfailure in the database.
+	Table = "email_sync_failures"
+	// SyncTable is the table that holds the sync relation/edge.
+	SyncTable = "email_sync_failures"
+	// SyncInverseTable is the table name for the EmailSync entity.
+	// It exists in this package in order to avoid circular dependency with the "emailsync" package.
+	SyncInverseTable = "email_syncs"
+	// SyncColumn is the table column denoting the sync relation/edge.
+	SyncColumn = "sync_id"
+)
+
+// Columns holds all SQL columns for emailsyncfailure fields.
+var Columns = []string{
+	FieldID,
+	FieldSyncID,
+	FieldConnectionID,
+	FieldMessageID,
+	FieldStatus,
+	FieldErrorMessage,
+	FieldAttempts,
+	FieldResolvedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SyncIDValidator is a validator for the "sync_id" field. It is called by the builders before save.
+	SyncIDValidator func(string) error
+	// ConnectionIDValidator is a validator for the "connection_id" field. It is called by the builders before save.
+	ConnectionIDValidator func(string) error
+	// MessageIDValidator is a validator for the "message_id" field. It is called by the builders before save.
+	MessageIDValidator func(string) error
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusFailed is the default value of the Status enum.
+const DefaultStatus = StatusFailed
+
+// Status values.
+const (
+	StatusFailed   Status = "failed"
+	StatusResolved Status = "resolved"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusFailed, StatusResolved:
+		return nil
+	default:
+		return fmt.Errorf("emailsyncfailure: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the EmailSyncFailure queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySyncID orders the results by the sync_id field.
+func BySyncID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSyncID, opts...).ToFunc()
+}
+
+// ByConnectionID orders the results by the connection_id field.
+func ByConnectionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConnectionID, opts...).ToFunc()
+}
+
+// ByMessageID orders the results by the message_id field.
+func ByMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByErrorMessage orders the results by the error_message field.
+func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorMessage, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByResolvedAt orders the results by the resolved_at field.
+func ByResolvedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// BySyncField orders the results by sync field.
+func BySyncField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSyncStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSyncStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SyncInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SyncTable, SyncColumn),
+	)
+}
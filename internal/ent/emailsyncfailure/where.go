@@ -0,0 +1,594 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emailsyncfailure
+
+import (
+	"clockzen-next/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldContainsFold(FieldID, id))
+}
+
+// SyncID applies equality check predicate on the "sync_id" field. It's identical to SyncIDEQ.
+func SyncID(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEQ(FieldSyncID, v))
+}
+
+// ConnectionID applies equality check predicate on the "connection_id" field. It's identical to ConnectionIDEQ.
+func ConnectionID(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEQ(FieldConnectionID, v))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEQ(FieldMessageID, v))
+}
+
+// ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
+func ErrorMessage(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEQ(FieldAttempts, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// SyncIDEQ applies the EQ predicate on the "sync_id" field.
+func SyncIDEQ(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEQ(FieldSyncID, v))
+}
+
+// SyncIDNEQ applies the NEQ predicate on the "sync_id" field.
+func SyncIDNEQ(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldNEQ(FieldSyncID, v))
+}
+
+// SyncIDIn applies the In predicate on the "sync_id" field.
+func SyncIDIn(vs ...string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldIn(FieldSyncID, vs...))
+}
+
+// SyncIDNotIn applies the NotIn predicate on the "sync_id" field.
+func SyncIDNotIn(vs ...string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldNotIn(FieldSyncID, vs...))
+}
+
+// SyncIDGT applies the GT predicate on the "sync_id" field.
+func SyncIDGT(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldGT(FieldSyncID, v))
+}
+
+// SyncIDGTE applies the GTE predicate on the "sync_id" field.
+func SyncIDGTE(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldGTE(FieldSyncID, v))
+}
+
+// SyncIDLT applies the LT predicate on the "sync_id" field.
+func SyncIDLT(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldLT(FieldSyncID, v))
+}
+
+// SyncIDLTE applies the LTE predicate on the "sync_id" field.
+func SyncIDLTE(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldLTE(FieldSyncID, v))
+}
+
+// SyncIDContains applies the Contains predicate on the "sync_id" field.
+func SyncIDContains(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldContains(FieldSyncID, v))
+}
+
+// SyncIDHasPrefix applies the HasPrefix predicate on the "sync_id" field.
+func SyncIDHasPrefix(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldHasPrefix(FieldSyncID, v))
+}
+
+// SyncIDHasSuffix applies the HasSuffix predicate on the "sync_id" field.
+func SyncIDHasSuffix(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldHasSuffix(FieldSyncID, v))
+}
+
+// SyncIDEqualFold applies the EqualFold predicate on the "sync_id" field.
+func SyncIDEqualFold(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEqualFold(FieldSyncID, v))
+}
+
+// SyncIDContainsFold applies the ContainsFold predicate on the "sync_id" field.
+func SyncIDContainsFold(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldContainsFold(FieldSyncID, v))
+}
+
+// ConnectionIDEQ applies the EQ predicate on the "connection_id" field.
+func ConnectionIDEQ(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEQ(FieldConnectionID, v))
+}
+
+// ConnectionIDNEQ applies the NEQ predicate on the "connection_id" field.
+func ConnectionIDNEQ(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldNEQ(FieldConnectionID, v))
+}
+
+// ConnectionIDIn applies the In predicate on the "connection_id" field.
+func ConnectionIDIn(vs ...string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldIn(FieldConnectionID, vs...))
+}
+
+// ConnectionIDNotIn applies the NotIn predicate on the "connection_id" field.
+func ConnectionIDNotIn(vs ...string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldNotIn(FieldConnectionID, vs...))
+}
+
+// ConnectionIDGT applies the GT predicate on the "connection_id" field.
+func ConnectionIDGT(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldGT(FieldConnectionID, v))
+}
+
+// ConnectionIDGTE applies the GTE predicate on the "connection_id" field.
+func ConnectionIDGTE(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldGTE(FieldConnectionID, v))
+}
+
+// ConnectionIDLT applies the LT predicate on the "connection_id" field.
+func ConnectionIDLT(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldLT(FieldConnectionID, v))
+}
+
+// ConnectionIDLTE applies the LTE predicate on the "connection_id" field.
+func ConnectionIDLTE(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldLTE(FieldConnectionID, v))
+}
+
+// ConnectionIDContains applies the Contains predicate on the "connection_id" field.
+func ConnectionIDContains(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldContains(FieldConnectionID, v))
+}
+
+// ConnectionIDHasPrefix applies the HasPrefix predicate on the "connection_id" field.
+func ConnectionIDHasPrefix(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldHasPrefix(FieldConnectionID, v))
+}
+
+// ConnectionIDHasSuffix applies the HasSuffix predicate on the "connection_id" field.
+func ConnectionIDHasSuffix(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldHasSuffix(FieldConnectionID, v))
+}
+
+// ConnectionIDEqualFold applies the EqualFold predicate on the "connection_id" field.
+func ConnectionIDEqualFold(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEqualFold(FieldConnectionID, v))
+}
+
+// ConnectionIDContainsFold applies the ContainsFold predicate on the "connection_id" field.
+func ConnectionIDContainsFold(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldContainsFold(FieldConnectionID, v))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// MessageIDGT applies the GT predicate on the "message_id" field.
+func MessageIDGT(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldGT(FieldMessageID, v))
+}
+
+// MessageIDGTE applies the GTE predicate on the "message_id" field.
+func MessageIDGTE(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldGTE(FieldMessageID, v))
+}
+
+// MessageIDLT applies the LT predicate on the "message_id" field.
+func MessageIDLT(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldLT(FieldMessageID, v))
+}
+
+// MessageIDLTE applies the LTE predicate on the "message_id" field.
+func MessageIDLTE(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldLTE(FieldMessageID, v))
+}
+
+// MessageIDContains applies the Contains predicate on the "message_id" field.
+func MessageIDContains(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldContains(FieldMessageID, v))
+}
+
+// MessageIDHasPrefix applies the HasPrefix predicate on the "message_id" field.
+func MessageIDHasPrefix(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldHasPrefix(FieldMessageID, v))
+}
+
+// MessageIDHasSuffix applies the HasSuffix predicate on the "message_id" field.
+func MessageIDHasSuffix(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldHasSuffix(FieldMessageID, v))
+}
+
+// MessageIDEqualFold applies the EqualFold predicate on the "message_id" field.
+func MessageIDEqualFold(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEqualFold(FieldMessageID, v))
+}
+
+// MessageIDContainsFold applies the ContainsFold predicate on the "message_id" field.
+func MessageIDContainsFold(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldContainsFold(FieldMessageID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ErrorMessageEQ applies the EQ predicate on the "error_message" field.
+func ErrorMessageEQ(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageNEQ applies the NEQ predicate on the "error_message" field.
+func ErrorMessageNEQ(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldNEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageIn applies the In predicate on the "error_message" field.
+func ErrorMessageIn(vs ...string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageNotIn applies the NotIn predicate on the "error_message" field.
+func ErrorMessageNotIn(vs ...string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldNotIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageGT applies the GT predicate on the "error_message" field.
+func ErrorMessageGT(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldGT(FieldErrorMessage, v))
+}
+
+// ErrorMessageGTE applies the GTE predicate on the "error_message" field.
+func ErrorMessageGTE(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldGTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageLT applies the LT predicate on the "error_message" field.
+func ErrorMessageLT(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldLT(FieldErrorMessage, v))
+}
+
+// ErrorMessageLTE applies the LTE predicate on the "error_message" field.
+func ErrorMessageLTE(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldLTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageContains applies the Contains predicate on the "error_message" field.
+func ErrorMessageContains(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldContains(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasPrefix applies the HasPrefix predicate on the "error_message" field.
+func ErrorMessageHasPrefix(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldHasPrefix(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasSuffix applies the HasSuffix predicate on the "error_message" field.
+func ErrorMessageHasSuffix(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldHasSuffix(FieldErrorMessage, v))
+}
+
+// ErrorMessageEqualFold applies the EqualFold predicate on the "error_message" field.
+func ErrorMessageEqualFold(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEqualFold(FieldErrorMessage, v))
+}
+
+// ErrorMessageContainsFold applies the ContainsFold predicate on the "error_message" field.
+func ErrorMessageContainsFold(v string) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldContainsFold(FieldErrorMessage, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldLTE(FieldAttempts, v))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasSync applies the HasEdge predicate on the "sync" edge.
+func HasSync() predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SyncTable, SyncColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSyncWith applies the HasEdge predicate on the "sync" edge with a given conditions (other predicates).
+func HasSyncWith(preds ...predicate.EmailSync) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(func(s *sql.Selector) {
+		step := newSyncStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmailSyncFailure) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmailSyncFailure) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmailSyncFailure) predicate.EmailSyncFailure {
+	return predicate.EmailSyncFailure(sql.NotPredicates(p))
+}
@@ -0,0 +1,1021 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/emailsync"
+	"clockzen-next/internal/ent/emailsyncfailure"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmailSyncFailureCreate is the builder for creating a EmailSyncFailure entity.
+type EmailSyncFailureCreate struct {
+	config
+	mutation *EmailSyncFailureMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetSyncID sets the "sync_id" field.
+func (_c *EmailSyncFailureCreate) SetSyncID(v string) *EmailSyncFailureCreate {
+	_c.mutation.SetSyncID(v)
+	return _c
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_c *EmailSyncFailureCreate) SetConnectionID(v string) *EmailSyncFailureCreate {
+	_c.mutation.SetConnectionID(v)
+	return _c
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *EmailSyncFailureCreate) SetMessageID(v string) *EmailSyncFailureCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *EmailSyncFailureCreate) SetStatus(v emailsyncfailure.Status) *EmailSyncFailureCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *EmailSyncFailureCreate) SetNillableStatus(v *emailsyncfailure.Status) *EmailSyncFailureCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_c *EmailSyncFailureCreate) SetErrorMessage(v string) *EmailSyncFailureCreate {
+	_c.mutation.SetErrorMessage(v)
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *EmailSyncFailureCreate) SetAttempts(v int) *EmailSyncFailureCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *EmailSyncFailureCreate) SetNillableAttempts(v *int) *EmailSyncFailureCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_c *EmailSyncFailureCreate) SetResolvedAt(v time.Time) *EmailSyncFailureCreate {
+	_c.mutation.SetResolvedAt(v)
+	return _c
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_c *EmailSyncFailureCreate) SetNillableResolvedAt(v *time.Time) *EmailSyncFailureCreate {
+	if v != nil {
+		_c.SetResolvedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EmailSyncFailureCreate) SetCreatedAt(v time.Time) *EmailSyncFailureCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EmailSyncFailureCreate) SetNillableCreatedAt(v *time.Time) *EmailSyncFailureCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EmailSyncFailureCreate) SetUpdatedAt(v time.Time) *EmailSyncFailureCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EmailSyncFailureCreate) SetNillableUpdatedAt(v *time.Time) *EmailSyncFailureCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EmailSyncFailureCreate) SetID(v string) *EmailSyncFailureCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetSync sets the "sync" edge to the EmailSync entity.
+func (_c *EmailSyncFailureCreate) SetSync(v *EmailSync) *EmailSyncFailureCreate {
+	return _c.SetSyncID(v.ID)
+}
+
+// Mutation returns the EmailSyncFailureMutation object of the builder.
+func (_c *EmailSyncFailureCreate) Mutation() *EmailSyncFailureMutation {
+	return _c.mutation
+}
+
+// Save creates the EmailSyncFailure in the database.
+func (_c *EmailSyncFailureCreate) Save(ctx context.Context) (*EmailSyncFailure, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EmailSyncFailureCreate) SaveX(ctx context.Context) *EmailSyncFailure {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmailSyncFailureCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmailSyncFailureCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EmailSyncFailureCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := emailsyncfailure.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := emailsyncfailure.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := emailsyncfailure.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := emailsyncfailure.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EmailSyncFailureCreate) check() error {
+	if _, ok := _c.mutation.SyncID(); !ok {
+		return &ValidationError{Name: "sync_id", err: errors.New(`ent: missing required field "EmailSyncFailure.sync_id"`)}
+	}
+	if v, ok := _c.mutation.SyncID(); ok {
+		if err := emailsyncfailure.SyncIDValidator(v); err != nil {
+			return &ValidationError{Name: "sync_id", err: fmt.Errorf(`ent: validator failed for field "EmailSyncFailure.sync_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ConnectionID(); !ok {
+		return &ValidationError{Name: "connection_id", err: errors.New(`ent: missing required field "EmailSyncFailure.connection_id"`)}
+	}
+	if v, ok := _c.mutation.ConnectionID(); ok {
+		if err := emailsyncfailure.ConnectionIDValidator(v); err != nil {
+			return &ValidationError{Name: "connection_id", err: fmt.Errorf(`ent: validator failed for field "EmailSyncFailure.connection_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "EmailSyncFailure.message_id"`)}
+	}
+	if v, ok := _c.mutation.MessageID(); ok {
+		if err := emailsyncfailure.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "EmailSyncFailure.message_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "EmailSyncFailure.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := emailsyncfailure.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmailSyncFailure.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ErrorMessage(); !ok {
+		return &ValidationError{Name: "error_message", err: errors.New(`ent: missing required field "EmailSyncFailure.error_message"`)}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "EmailSyncFailure.attempts"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EmailSyncFailure.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EmailSyncFailure.updated_at"`)}
+	}
+	if len(_c.mutation.SyncIDs()) == 0 {
+		return &ValidationError{Name: "sync", err: errors.New(`ent: missing required edge "EmailSyncFailure.sync"`)}
+	}
+	return nil
+}
+
+func (_c *EmailSyncFailureCreate) sqlSave(ctx context.Context) (*EmailSyncFailure, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected EmailSyncFailure.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EmailSyncFailureCreate) createSpec() (*EmailSyncFailure, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmailSyncFailure{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(emailsyncfailure.Table, sqlgraph.NewFieldSpec(emailsyncfailure.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ConnectionID(); ok {
+		_spec.SetField(emailsyncfailure.FieldConnectionID, field.TypeString, value)
+		_node.ConnectionID = value
+	}
+	if value, ok := _c.mutation.MessageID(); ok {
+		_spec.SetField(emailsyncfailure.FieldMessageID, field.TypeString, value)
+		_node.MessageID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(emailsyncfailure.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ErrorMessage(); ok {
+		_spec.SetField(emailsyncfailure.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(emailsyncfailure.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.ResolvedAt(); ok {
+		_spec.SetField(emailsyncfailure.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(emailsyncfailure.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailsyncfailure.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.SyncIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailsyncfailure.SyncTable,
+			Columns: []string{emailsyncfailure.SyncColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailsync.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SyncID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmailSyncFailure.Create().
+//		SetSyncID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmailSyncFailureUpsert) {
+//			SetSyncID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EmailSyncFailureCreate) OnConflict(opts ...sql.ConflictOption) *EmailSyncFailureUpsertOne {
+	_c.conflict = opts
+	return &EmailSyncFailureUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmailSyncFailure.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EmailSyncFailureCreate) OnConflictColumns(columns ...string) *EmailSyncFailureUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EmailSyncFailureUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EmailSyncFailureUpsertOne is the builder for "upsert"-ing
+	//  one EmailSyncFailure node.
+	EmailSyncFailureUpsertOne struct {
+		create *EmailSyncFailureCreate
+	}
+
+	// EmailSyncFailureUpsert is the "OnConflict" setter.
+	EmailSyncFailureUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetSyncID sets the "sync_id" field.
+func (u *EmailSyncFailureUpsert) SetSyncID(v string) *EmailSyncFailureUpsert {
+	u.Set(emailsyncfailure.FieldSyncID, v)
+	return u
+}
+
+// UpdateSyncID sets the "sync_id" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsert) UpdateSyncID() *EmailSyncFailureUpsert {
+	u.SetExcluded(emailsyncfailure.FieldSyncID)
+	return u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (u *EmailSyncFailureUpsert) SetConnectionID(v string) *EmailSyncFailureUpsert {
+	u.Set(emailsyncfailure.FieldConnectionID, v)
+	return u
+}
+
+// UpdateConnectionID sets the "connection_id" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsert) UpdateConnectionID() *EmailSyncFailureUpsert {
+	u.SetExcluded(emailsyncfailure.FieldConnectionID)
+	return u
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *EmailSyncFailureUpsert) SetMessageID(v string) *EmailSyncFailureUpsert {
+	u.Set(emailsyncfailure.FieldMessageID, v)
+	return u
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsert) UpdateMessageID() *EmailSyncFailureUpsert {
+	u.SetExcluded(emailsyncfailure.FieldMessageID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *EmailSyncFailureUpsert) SetStatus(v emailsyncfailure.Status) *EmailSyncFailureUpsert {
+	u.Set(emailsyncfailure.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsert) UpdateStatus() *EmailSyncFailureUpsert {
+	u.SetExcluded(emailsyncfailure.FieldStatus)
+	return u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *EmailSyncFailureUpsert) SetErrorMessage(v string) *EmailSyncFailureUpsert {
+	u.Set(emailsyncfailure.FieldErrorMessage, v)
+	return u
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsert) UpdateErrorMessage() *EmailSyncFailureUpsert {
+	u.SetExcluded(emailsyncfailure.FieldErrorMessage)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *EmailSyncFailureUpsert) SetAttempts(v int) *EmailSyncFailureUpsert {
+	u.Set(emailsyncfailure.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsert) UpdateAttempts() *EmailSyncFailureUpsert {
+	u.SetExcluded(emailsyncfailure.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *EmailSyncFailureUpsert) AddAttempts(v int) *EmailSyncFailureUpsert {
+	u.Add(emailsyncfailure.FieldAttempts, v)
+	return u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *EmailSyncFailureUpsert) SetResolvedAt(v time.Time) *EmailSyncFailureUpsert {
+	u.Set(emailsyncfailure.FieldResolvedAt, v)
+	return u
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsert) UpdateResolvedAt() *EmailSyncFailureUpsert {
+	u.SetExcluded(emailsyncfailure.FieldResolvedAt)
+	return u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *EmailSyncFailureUpsert) ClearResolvedAt() *EmailSyncFailureUpsert {
+	u.SetNull(emailsyncfailure.FieldResolvedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmailSyncFailureUpsert) SetUpdatedAt(v time.Time) *EmailSyncFailureUpsert {
+	u.Set(emailsyncfailure.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsert) UpdateUpdatedAt() *EmailSyncFailureUpsert {
+	u.SetExcluded(emailsyncfailure.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EmailSyncFailure.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emailsyncfailure.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmailSyncFailureUpsertOne) UpdateNewValues() *EmailSyncFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(emailsyncfailure.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(emailsyncfailure.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmailSyncFailure.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EmailSyncFailureUpsertOne) Ignore() *EmailSyncFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmailSyncFailureUpsertOne) DoNothing() *EmailSyncFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmailSyncFailureCreate.OnConflict
+// documentation for more info.
+func (u *EmailSyncFailureUpsertOne) Update(set func(*EmailSyncFailureUpsert)) *EmailSyncFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmailSyncFailureUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSyncID sets the "sync_id" field.
+func (u *EmailSyncFailureUpsertOne) SetSyncID(v string) *EmailSyncFailureUpsertOne {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.SetSyncID(v)
+	})
+}
+
+// UpdateSyncID sets the "sync_id" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsertOne) UpdateSyncID() *EmailSyncFailureUpsertOne {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.UpdateSyncID()
+	})
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (u *EmailSyncFailureUpsertOne) SetConnectionID(v string) *EmailSyncFailureUpsertOne {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.SetConnectionID(v)
+	})
+}
+
+// UpdateConnectionID sets the "connection_id" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsertOne) UpdateConnectionID() *EmailSyncFailureUpsertOne {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.UpdateConnectionID()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *EmailSyncFailureUpsertOne) SetMessageID(v string) *EmailSyncFailureUpsertOne {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsertOne) UpdateMessageID() *EmailSyncFailureUpsertOne {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *EmailSyncFailureUpsertOne) SetStatus(v emailsyncfailure.Status) *EmailSyncFailureUpsertOne {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsertOne) UpdateStatus() *EmailSyncFailureUpsertOne {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *EmailSyncFailureUpsertOne) SetErrorMessage(v string) *EmailSyncFailureUpsertOne {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsertOne) UpdateErrorMessage() *EmailSyncFailureUpsertOne {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *EmailSyncFailureUpsertOne) SetAttempts(v int) *EmailSyncFailureUpsertOne {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *EmailSyncFailureUpsertOne) AddAttempts(v int) *EmailSyncFailureUpsertOne {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsertOne) UpdateAttempts() *EmailSyncFailureUpsertOne {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *EmailSyncFailureUpsertOne) SetResolvedAt(v time.Time) *EmailSyncFailureUpsertOne {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsertOne) UpdateResolvedAt() *EmailSyncFailureUpsertOne {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *EmailSyncFailureUpsertOne) ClearResolvedAt() *EmailSyncFailureUpsertOne {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmailSyncFailureUpsertOne) SetUpdatedAt(v time.Time) *EmailSyncFailureUpsertOne {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsertOne) UpdateUpdatedAt() *EmailSyncFailureUpsertOne {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmailSyncFailureUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmailSyncFailureCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmailSyncFailureUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EmailSyncFailureUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EmailSyncFailureUpsertOne.ID is not supported by MySQL driver. Use EmailSyncFailureUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EmailSyncFailureUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EmailSyncFailureCreateBulk is the builder for creating many EmailSyncFailure entities in bulk.
+type EmailSyncFailureCreateBulk struct {
+	config
+	err      error
+	builders []*EmailSyncFailureCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EmailSyncFailure entities in the database.
+func (_c *EmailSyncFailureCreateBulk) Save(ctx context.Context) ([]*EmailSyncFailure, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EmailSyncFailure, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmailSyncFailureMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EmailSyncFailureCreateBulk) SaveX(ctx context.Context) []*EmailSyncFailure {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmailSyncFailureCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmailSyncFailureCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmailSyncFailure.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmailSyncFailureUpsert) {
+//			SetSyncID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EmailSyncFailureCreateBulk) OnConflict(opts ...sql.ConflictOption) *EmailSyncFailureUpsertBulk {
+	_c.conflict = opts
+	return &EmailSyncFailureUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmailSyncFailure.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EmailSyncFailureCreateBulk) OnConflictColumns(columns ...string) *EmailSyncFailureUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EmailSyncFailureUpsertBulk{
+		create: _c,
+	}
+}
+
+// EmailSyncFailureUpsertBulk is the builder for "upsert"-ing
+// a bulk of EmailSyncFailure nodes.
+type EmailSyncFailureUpsertBulk struct {
+	create *EmailSyncFailureCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EmailSyncFailure.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emailsyncfailure.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmailSyncFailureUpsertBulk) UpdateNewValues() *EmailSyncFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(emailsyncfailure.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(emailsyncfailure.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmailSyncFailure.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EmailSyncFailureUpsertBulk) Ignore() *EmailSyncFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmailSyncFailureUpsertBulk) DoNothing() *EmailSyncFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmailSyncFailureCreateBulk.OnConflict
+// documentation for more info.
+func (u *EmailSyncFailureUpsertBulk) Update(set func(*EmailSyncFailureUpsert)) *EmailSyncFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmailSyncFailureUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSyncID sets the "sync_id" field.
+func (u *EmailSyncFailureUpsertBulk) SetSyncID(v string) *EmailSyncFailureUpsertBulk {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.SetSyncID(v)
+	})
+}
+
+// UpdateSyncID sets the "sync_id" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsertBulk) UpdateSyncID() *EmailSyncFailureUpsertBulk {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.UpdateSyncID()
+	})
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (u *EmailSyncFailureUpsertBulk) SetConnectionID(v string) *EmailSyncFailureUpsertBulk {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.SetConnectionID(v)
+	})
+}
+
+// UpdateConnectionID sets the "connection_id" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsertBulk) UpdateConnectionID() *EmailSyncFailureUpsertBulk {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.UpdateConnectionID()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *EmailSyncFailureUpsertBulk) SetMessageID(v string) *EmailSyncFailureUpsertBulk {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsertBulk) UpdateMessageID() *EmailSyncFailureUpsertBulk {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *EmailSyncFailureUpsertBulk) SetStatus(v emailsyncfailure.Status) *EmailSyncFailureUpsertBulk {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsertBulk) UpdateStatus() *EmailSyncFailureUpsertBulk {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *EmailSyncFailureUpsertBulk) SetErrorMessage(v string) *EmailSyncFailureUpsertBulk {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsertBulk) UpdateErrorMessage() *EmailSyncFailureUpsertBulk {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *EmailSyncFailureUpsertBulk) SetAttempts(v int) *EmailSyncFailureUpsertBulk {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *EmailSyncFailureUpsertBulk) AddAttempts(v int) *EmailSyncFailureUpsertBulk {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsertBulk) UpdateAttempts() *EmailSyncFailureUpsertBulk {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *EmailSyncFailureUpsertBulk) SetResolvedAt(v time.Time) *EmailSyncFailureUpsertBulk {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsertBulk) UpdateResolvedAt() *EmailSyncFailureUpsertBulk {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *EmailSyncFailureUpsertBulk) ClearResolvedAt() *EmailSyncFailureUpsertBulk {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmailSyncFailureUpsertBulk) SetUpdatedAt(v time.Time) *EmailSyncFailureUpsertBulk {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmailSyncFailureUpsertBulk) UpdateUpdatedAt() *EmailSyncFailureUpsertBulk {
+	return u.Update(func(s *EmailSyncFailureUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmailSyncFailureUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EmailSyncFailureCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmailSyncFailureCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmailSyncFailureUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,625 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/emailsync"
+	"clockzen-next/internal/ent/emailsyncfailure"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmailSyncFailureUpdate is the builder for updating EmailSyncFailure entities.
+type EmailSyncFailureUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmailSyncFailureMutation
+}
+
+// Where appends a list predicates to the EmailSyncFailureUpdate builder.
+func (_u *EmailSyncFailureUpdate) Where(ps ...predicate.EmailSyncFailure) *EmailSyncFailureUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSyncID sets the "sync_id" field.
+func (_u *EmailSyncFailureUpdate) SetSyncID(v string) *EmailSyncFailureUpdate {
+	_u.mutation.SetSyncID(v)
+	return _u
+}
+
+// SetNillableSyncID sets the "sync_id" field if the given value is not nil.
+func (_u *EmailSyncFailureUpdate) SetNillableSyncID(v *string) *EmailSyncFailureUpdate {
+	if v != nil {
+		_u.SetSyncID(*v)
+	}
+	return _u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_u *EmailSyncFailureUpdate) SetConnectionID(v string) *EmailSyncFailureUpdate {
+	_u.mutation.SetConnectionID(v)
+	return _u
+}
+
+// SetNillableConnectionID sets the "connection_id" field if the given value is not nil.
+func (_u *EmailSyncFailureUpdate) SetNillableConnectionID(v *string) *EmailSyncFailureUpdate {
+	if v != nil {
+		_u.SetConnectionID(*v)
+	}
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *EmailSyncFailureUpdate) SetMessageID(v string) *EmailSyncFailureUpdate {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *EmailSyncFailureUpdate) SetNillableMessageID(v *string) *EmailSyncFailureUpdate {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *EmailSyncFailureUpdate) SetStatus(v emailsyncfailure.Status) *EmailSyncFailureUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *EmailSyncFailureUpdate) SetNillableStatus(v *emailsyncfailure.Status) *EmailSyncFailureUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *EmailSyncFailureUpdate) SetErrorMessage(v string) *EmailSyncFailureUpdate {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *EmailSyncFailureUpdate) SetNillableErrorMessage(v *string) *EmailSyncFailureUpdate {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *EmailSyncFailureUpdate) SetAttempts(v int) *EmailSyncFailureUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *EmailSyncFailureUpdate) SetNillableAttempts(v *int) *EmailSyncFailureUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *EmailSyncFailureUpdate) AddAttempts(v int) *EmailSyncFailureUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *EmailSyncFailureUpdate) SetResolvedAt(v time.Time) *EmailSyncFailureUpdate {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *EmailSyncFailureUpdate) SetNillableResolvedAt(v *time.Time) *EmailSyncFailureUpdate {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *EmailSyncFailureUpdate) ClearResolvedAt() *EmailSyncFailureUpdate {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EmailSyncFailureUpdate) SetUpdatedAt(v time.Time) *EmailSyncFailureUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSync sets the "sync" edge to the EmailSync entity.
+func (_u *EmailSyncFailureUpdate) SetSync(v *EmailSync) *EmailSyncFailureUpdate {
+	return _u.SetSyncID(v.ID)
+}
+
+// Mutation returns the EmailSyncFailureMutation object of the builder.
+func (_u *EmailSyncFailureUpdate) Mutation() *EmailSyncFailureMutation {
+	return _u.mutation
+}
+
+// ClearSync clears the "sync" edge to the EmailSync entity.
+func (_u *EmailSyncFailureUpdate) ClearSync() *EmailSyncFailureUpdate {
+	_u.mutation.ClearSync()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EmailSyncFailureUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmailSyncFailureUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EmailSyncFailureUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmailSyncFailureUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmailSyncFailureUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := emailsyncfailure.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmailSyncFailureUpdate) check() error {
+	if v, ok := _u.mutation.SyncID(); ok {
+		if err := emailsyncfailure.SyncIDValidator(v); err != nil {
+			return &ValidationError{Name: "sync_id", err: fmt.Errorf(`ent: validator failed for field "EmailSyncFailure.sync_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ConnectionID(); ok {
+		if err := emailsyncfailure.ConnectionIDValidator(v); err != nil {
+			return &ValidationError{Name: "connection_id", err: fmt.Errorf(`ent: validator failed for field "EmailSyncFailure.connection_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MessageID(); ok {
+		if err := emailsyncfailure.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "EmailSyncFailure.message_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := emailsyncfailure.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmailSyncFailure.status": %w`, err)}
+		}
+	}
+	if _u.mutation.SyncCleared() && len(_u.mutation.SyncIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmailSyncFailure.sync"`)
+	}
+	return nil
+}
+
+func (_u *EmailSyncFailureUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailsyncfailure.Table, emailsyncfailure.Columns, sqlgraph.NewFieldSpec(emailsyncfailure.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ConnectionID(); ok {
+		_spec.SetField(emailsyncfailure.FieldConnectionID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(emailsyncfailure.FieldMessageID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(emailsyncfailure.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(emailsyncfailure.FieldErrorMessage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(emailsyncfailure.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(emailsyncfailure.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(emailsyncfailure.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(emailsyncfailure.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailsyncfailure.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SyncCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailsyncfailure.SyncTable,
+			Columns: []string{emailsyncfailure.SyncColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailsync.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SyncIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailsyncfailure.SyncTable,
+			Columns: []string{emailsyncfailure.SyncColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailsync.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailsyncfailure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EmailSyncFailureUpdateOne is the builder for updating a single EmailSyncFailure entity.
+type EmailSyncFailureUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmailSyncFailureMutation
+}
+
+// SetSyncID sets the "sync_id" field.
+func (_u *EmailSyncFailureUpdateOne) SetSyncID(v string) *EmailSyncFailureUpdateOne {
+	_u.mutation.SetSyncID(v)
+	return _u
+}
+
+// SetNillableSyncID sets the "sync_id" field if the given value is not nil.
+func (_u *EmailSyncFailureUpdateOne) SetNillableSyncID(v *string) *EmailSyncFailureUpdateOne {
+	if v != nil {
+		_u.SetSyncID(*v)
+	}
+	return _u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_u *EmailSyncFailureUpdateOne) SetConnectionID(v string) *EmailSyncFailureUpdateOne {
+	_u.mutation.SetConnectionID(v)
+	return _u
+}
+
+// SetNillableConnectionID sets the "connection_id" field if the given value is not nil.
+func (_u *EmailSyncFailureUpdateOne) SetNillableConnectionID(v *string) *EmailSyncFailureUpdateOne {
+	if v != nil {
+		_u.SetConnectionID(*v)
+	}
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *EmailSyncFailureUpdateOne) SetMessageID(v string) *EmailSyncFailureUpdateOne {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *EmailSyncFailureUpdateOne) SetNillableMessageID(v *string) *EmailSyncFailureUpdateOne {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *EmailSyncFailureUpdateOne) SetStatus(v emailsyncfailure.Status) *EmailSyncFailureUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *EmailSyncFailureUpdateOne) SetNillableStatus(v *emailsyncfailure.Status) *EmailSyncFailureUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *EmailSyncFailureUpdateOne) SetErrorMessage(v string) *EmailSyncFailureUpdateOne {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *EmailSyncFailureUpdateOne) SetNillableErrorMessage(v *string) *EmailSyncFailureUpdateOne {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *EmailSyncFailureUpdateOne) SetAttempts(v int) *EmailSyncFailureUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *EmailSyncFailureUpdateOne) SetNillableAttempts(v *int) *EmailSyncFailureUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *EmailSyncFailureUpdateOne) AddAttempts(v int) *EmailSyncFailureUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *EmailSyncFailureUpdateOne) SetResolvedAt(v time.Time) *EmailSyncFailureUpdateOne {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *EmailSyncFailureUpdateOne) SetNillableResolvedAt(v *time.Time) *EmailSyncFailureUpdateOne {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *EmailSyncFailureUpdateOne) ClearResolvedAt() *EmailSyncFailureUpdateOne {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EmailSyncFailureUpdateOne) SetUpdatedAt(v time.Time) *EmailSyncFailureUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSync sets the "sync" edge to the EmailSync entity.
+func (_u *EmailSyncFailureUpdateOne) SetSync(v *EmailSync) *EmailSyncFailureUpdateOne {
+	return _u.SetSyncID(v.ID)
+}
+
+// Mutation returns the EmailSyncFailureMutation object of the builder.
+func (_u *EmailSyncFailureUpdateOne) Mutation() *EmailSyncFailureMutation {
+	return _u.mutation
+}
+
+// ClearSync clears the "sync" edge to the EmailSync entity.
+func (_u *EmailSyncFailureUpdateOne) ClearSync() *EmailSyncFailureUpdateOne {
+	_u.mutation.ClearSync()
+	return _u
+}
+
+// Where appends a list predicates to the EmailSyncFailureUpdate builder.
+func (_u *EmailSyncFailureUpdateOne) Where(ps ...predicate.EmailSyncFailure) *EmailSyncFailureUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EmailSyncFailureUpdateOne) Select(field string, fields ...string) *EmailSyncFailureUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EmailSyncFailure entity.
+func (_u *EmailSyncFailureUpdateOne) Save(ctx context.Context) (*EmailSyncFailure, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmailSyncFailureUpdateOne) SaveX(ctx context.Context) *EmailSyncFailure {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EmailSyncFailureUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmailSyncFailureUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmailSyncFailureUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := emailsyncfailure.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmailSyncFailureUpdateOne) check() error {
+	if v, ok := _u.mutation.SyncID(); ok {
+		if err := emailsyncfailure.SyncIDValidator(v); err != nil {
+			return &ValidationError{Name: "sync_id", err: fmt.Errorf(`ent: validator failed for field "EmailSyncFailure.sync_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ConnectionID(); ok {
+		if err := emailsyncfailure.ConnectionIDValidator(v); err != nil {
+			return &ValidationError{Name: "connection_id", err: fmt.Errorf(`ent: validator failed for field "EmailSyncFailure.connection_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MessageID(); ok {
+		if err := emailsyncfailure.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "EmailSyncFailure.message_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := emailsyncfailure.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmailSyncFailure.status": %w`, err)}
+		}
+	}
+	if _u.mutation.SyncCleared() && len(_u.mutation.SyncIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmailSyncFailure.sync"`)
+	}
+	return nil
+}
+
+func (_u *EmailSyncFailureUpdateOne) sqlSave(ctx context.Context) (_node *EmailSyncFailure, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailsyncfailure.Table, emailsyncfailure.Columns, sqlgraph.NewFieldSpec(emailsyncfailure.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmailSyncFailure.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailsyncfailure.FieldID)
+		for _, f := range fields {
+			if !emailsyncfailure.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emailsyncfailure.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ConnectionID(); ok {
+		_spec.SetField(emailsyncfailure.FieldConnectionID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(emailsyncfailure.FieldMessageID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(emailsyncfailure.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(emailsyncfailure.FieldErrorMessage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(emailsyncfailure.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(emailsyncfailure.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(emailsyncfailure.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(emailsyncfailure.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailsyncfailure.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SyncCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailsyncfailure.SyncTable,
+			Columns: []string{emailsyncfailure.SyncColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailsync.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SyncIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailsyncfailure.SyncTable,
+			Columns: []string{emailsyncfailure.SyncColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailsync.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &EmailSyncFailure{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailsyncfailure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/emergencyfundsnapshot"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// EmergencyFundSnapshot is the model entity for the EmergencyFundSnapshot schema.
+type EmergencyFundSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ID of the user the snapshot belongs to
+	UserID string `json:"user_id,omitempty"`
+	// Day the snapshot was taken, at midnight UTC
+	Date time.Time `json:"date,omitempty"`
+	// Total balance of the designated accounts
+	Balance float64 `json:"balance,omitempty"`
+	// Average monthly expenses from recent transactions
+	MonthlyExpenses float64 `json:"monthly_expenses,omitempty"`
+	// Months of expenses the balance covers; unset without any expense history
+	MonthsCovered *float64 `json:"months_covered,omitempty"`
+	// The user's target when the snapshot was taken
+	TargetMonths float64 `json:"target_months,omitempty"`
+	// Whether coverage was below the target
+	BelowTarget bool `json:"below_target,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmergencyFundSnapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emergencyfundsnapshot.FieldBelowTarget:
+			values[i] = new(sql.NullBool)
+		case emergencyfundsnapshot.FieldBalance, emergencyfundsnapshot.FieldMonthlyExpenses, emergencyfundsnapshot.FieldMonthsCovered, emergencyfundsnapshot.FieldTargetMonths:
+			values[i] = new(sql.NullFloat64)
+		case emergencyfundsnapshot.FieldID, emergencyfundsnapshot.FieldUserID:
+			values[i] = new(sql.NullString)
+		case emergencyfundsnapshot.FieldDate, emergencyfundsnapshot.FieldCreatedAt, emergencyfundsnapshot.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmergencyFundSnapshot fields.
+func (_m *EmergencyFundSnapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emergencyfundsnapshot.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case emergencyfundsnapshot.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case emergencyfundsnapshot.FieldDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				_m.Date = value.Time
+			}
+		case emergencyfundsnapshot.FieldBalance:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field balance", values[i])
+			} else if value.Valid {
+				_m.Balance = value.Float64
+			}
+		case emergencyfundsnapshot.FieldMonthlyExpenses:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field monthly_expenses", values[i])
+			} else if value.Valid {
+				_m.MonthlyExpenses = value.Float64
+			}
+		case emergencyfundsnapshot.FieldMonthsCovered:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field months_covered", values[i])
+			} else if value.Valid {
+				_m.MonthsCovered = new(float64)
+				*_m.MonthsCovered = value.Float64
+			}
+		case emergencyfundsnapshot.FieldTargetMonths:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_months", values[i])
+			} else if value.Valid {
+				_m.TargetMonths = value.Float64
+			}
+		case emergencyfundsnapshot.FieldBelowTarget:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field below_target", values[i])
+			} else if value.Valid {
+				_m.BelowTarget = value.Bool
+			}
+		case emergencyfundsnapshot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case emergencyfundsnapshot.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmergencyFundSnapshot.
+// This includes values selected through modifiers, order, etc.
+func (_m *EmergencyFundSnapshot) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EmergencyFundSnapshot.
+// Note that you need to call EmergencyFundSnapshot.Unwrap() before calling this method if this EmergencyFundSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EmergencyFundSnapshot) Update() *EmergencyFundSnapshotUpdateOne {
+	return NewEmergencyFundSnapshotClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EmergencyFundSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EmergencyFundSnapshot) Unwrap() *EmergencyFundSnapshot {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmergencyFundSnapshot is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EmergencyFundSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmergencyFundSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(_m.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("balance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Balance))
+	builder.WriteString(", ")
+	builder.WriteString("monthly_expenses=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MonthlyExpenses))
+	builder.WriteString(", ")
+	if v := _m.MonthsCovered; v != nil {
+		builder.WriteString("months_covered=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("target_months=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetMonths))
+	builder.WriteString(", ")
+	builder.WriteString("below_target=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BelowTarget))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmergencyFundSnapshots is a parsable slice of EmergencyFundSnapshot.
+type EmergencyFundSnapshots []*EmergencyFundSnapshot
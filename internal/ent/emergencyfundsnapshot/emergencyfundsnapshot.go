@@ -0,0 +1,126 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emergencyfundsnapshot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the emergencyfundsnapshot type in the database.
+	Label = "emergency_fund_snapshot"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldDate holds the string denoting the date field in the database.
+	FieldDate = "date"
+	// FieldBalance holds the string denoting the balance field in the database.
+	FieldBalance = "balance"
+	// FieldMonthlyExpenses holds the string denoting the monthly_expenses field in the database.
+	FieldMonthlyExpenses = "monthly_expenses"
+	// FieldMonthsCovered holds the string denoting the months_covered field in the database.
+	FieldMonthsCovered = "months_covered"
+	// FieldTargetMonths holds the string denoting the target_months field in the database.
+	FieldTargetMonths = "target_months"
+	// FieldBelowTarget holds the string denoting the below_target field in the database.
+	FieldBelowTarget = "below_target"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the emergencyfundsnapshot in the database.
+	Table = "emergency_fund_snapshots"
+)
+
+// Columns holds all SQL columns for emergencyfundsnapshot fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldDate,
+	FieldBalance,
+	FieldMonthlyExpenses,
+	FieldMonthsCovered,
+	FieldTargetMonths,
+	FieldBelowTarget,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// DefaultBelowTarget holds the default value on creation for the "below_target" field.
+	DefaultBelowTarget bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the EmergencyFundSnapshot queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByDate orders the results by the date field.
+func ByDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDate, opts...).ToFunc()
+}
+
+// ByBalance orders the results by the balance field.
+func ByBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBalance, opts...).ToFunc()
+}
+
+// ByMonthlyExpenses orders the results by the monthly_expenses field.
+func ByMonthlyExpenses(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonthlyExpenses, opts...).ToFunc()
+}
+
+// ByMonthsCovered orders the results by the months_covered field.
+func ByMonthsCovered(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonthsCovered, opts...).ToFunc()
+}
+
+// ByTargetMonths orders the results by the target_months field.
+func ByTargetMonths(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetMonths, opts...).ToFunc()
+}
+
+// ByBelowTarget orders the results by the below_target field.
+func ByBelowTarget(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBelowTarget, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
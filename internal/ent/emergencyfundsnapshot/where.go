@@ -0,0 +1,490 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emergencyfundsnapshot
+
+import (
+	"clockzen-next/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldContainsFold(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEQ(FieldUserID, v))
+}
+
+// Date applies equality check predicate on the "date" field. It's identical to DateEQ.
+func Date(v time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEQ(FieldDate, v))
+}
+
+// Balance applies equality check predicate on the "balance" field. It's identical to BalanceEQ.
+func Balance(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEQ(FieldBalance, v))
+}
+
+// MonthlyExpenses applies equality check predicate on the "monthly_expenses" field. It's identical to MonthlyExpensesEQ.
+func MonthlyExpenses(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEQ(FieldMonthlyExpenses, v))
+}
+
+// MonthsCovered applies equality check predicate on the "months_covered" field. It's identical to MonthsCoveredEQ.
+func MonthsCovered(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEQ(FieldMonthsCovered, v))
+}
+
+// TargetMonths applies equality check predicate on the "target_months" field. It's identical to TargetMonthsEQ.
+func TargetMonths(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEQ(FieldTargetMonths, v))
+}
+
+// BelowTarget applies equality check predicate on the "below_target" field. It's identical to BelowTargetEQ.
+func BelowTarget(v bool) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEQ(FieldBelowTarget, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// DateEQ applies the EQ predicate on the "date" field.
+func DateEQ(v time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEQ(FieldDate, v))
+}
+
+// DateNEQ applies the NEQ predicate on the "date" field.
+func DateNEQ(v time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldNEQ(FieldDate, v))
+}
+
+// DateIn applies the In predicate on the "date" field.
+func DateIn(vs ...time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldIn(FieldDate, vs...))
+}
+
+// DateNotIn applies the NotIn predicate on the "date" field.
+func DateNotIn(vs ...time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldNotIn(FieldDate, vs...))
+}
+
+// DateGT applies the GT predicate on the "date" field.
+func DateGT(v time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldGT(FieldDate, v))
+}
+
+// DateGTE applies the GTE predicate on the "date" field.
+func DateGTE(v time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldGTE(FieldDate, v))
+}
+
+// DateLT applies the LT predicate on the "date" field.
+func DateLT(v time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldLT(FieldDate, v))
+}
+
+// DateLTE applies the LTE predicate on the "date" field.
+func DateLTE(v time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldLTE(FieldDate, v))
+}
+
+// BalanceEQ applies the EQ predicate on the "balance" field.
+func BalanceEQ(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEQ(FieldBalance, v))
+}
+
+// BalanceNEQ applies the NEQ predicate on the "balance" field.
+func BalanceNEQ(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldNEQ(FieldBalance, v))
+}
+
+// BalanceIn applies the In predicate on the "balance" field.
+func BalanceIn(vs ...float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldIn(FieldBalance, vs...))
+}
+
+// BalanceNotIn applies the NotIn predicate on the "balance" field.
+func BalanceNotIn(vs ...float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldNotIn(FieldBalance, vs...))
+}
+
+// BalanceGT applies the GT predicate on the "balance" field.
+func BalanceGT(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldGT(FieldBalance, v))
+}
+
+// BalanceGTE applies the GTE predicate on the "balance" field.
+func BalanceGTE(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldGTE(FieldBalance, v))
+}
+
+// BalanceLT applies the LT predicate on the "balance" field.
+func BalanceLT(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldLT(FieldBalance, v))
+}
+
+// BalanceLTE applies the LTE predicate on the "balance" field.
+func BalanceLTE(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldLTE(FieldBalance, v))
+}
+
+// MonthlyExpensesEQ applies the EQ predicate on the "monthly_expenses" field.
+func MonthlyExpensesEQ(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEQ(FieldMonthlyExpenses, v))
+}
+
+// MonthlyExpensesNEQ applies the NEQ predicate on the "monthly_expenses" field.
+func MonthlyExpensesNEQ(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldNEQ(FieldMonthlyExpenses, v))
+}
+
+// MonthlyExpensesIn applies the In predicate on the "monthly_expenses" field.
+func MonthlyExpensesIn(vs ...float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldIn(FieldMonthlyExpenses, vs...))
+}
+
+// MonthlyExpensesNotIn applies the NotIn predicate on the "monthly_expenses" field.
+func MonthlyExpensesNotIn(vs ...float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldNotIn(FieldMonthlyExpenses, vs...))
+}
+
+// MonthlyExpensesGT applies the GT predicate on the "monthly_expenses" field.
+func MonthlyExpensesGT(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldGT(FieldMonthlyExpenses, v))
+}
+
+// MonthlyExpensesGTE applies the GTE predicate on the "monthly_expenses" field.
+func MonthlyExpensesGTE(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldGTE(FieldMonthlyExpenses, v))
+}
+
+// MonthlyExpensesLT applies the LT predicate on the "monthly_expenses" field.
+func MonthlyExpensesLT(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldLT(FieldMonthlyExpenses, v))
+}
+
+// MonthlyExpensesLTE applies the LTE predicate on the "monthly_expenses" field.
+func MonthlyExpensesLTE(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldLTE(FieldMonthlyExpenses, v))
+}
+
+// MonthsCoveredEQ applies the EQ predicate on the "months_covered" field.
+func MonthsCoveredEQ(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEQ(FieldMonthsCovered, v))
+}
+
+// MonthsCoveredNEQ applies the NEQ predicate on the "months_covered" field.
+func MonthsCoveredNEQ(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldNEQ(FieldMonthsCovered, v))
+}
+
+// MonthsCoveredIn applies the In predicate on the "months_covered" field.
+func MonthsCoveredIn(vs ...float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldIn(FieldMonthsCovered, vs...))
+}
+
+// MonthsCoveredNotIn applies the NotIn predicate on the "months_covered" field.
+func MonthsCoveredNotIn(vs ...float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldNotIn(FieldMonthsCovered, vs...))
+}
+
+// MonthsCoveredGT applies the GT predicate on the "months_covered" field.
+func MonthsCoveredGT(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldGT(FieldMonthsCovered, v))
+}
+
+// MonthsCoveredGTE applies the GTE predicate on the "months_covered" field.
+func MonthsCoveredGTE(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldGTE(FieldMonthsCovered, v))
+}
+
+// MonthsCoveredLT applies the LT predicate on the "months_covered" field.
+func MonthsCoveredLT(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldLT(FieldMonthsCovered, v))
+}
+
+// MonthsCoveredLTE applies the LTE predicate on the "months_covered" field.
+func MonthsCoveredLTE(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldLTE(FieldMonthsCovered, v))
+}
+
+// MonthsCoveredIsNil applies the IsNil predicate on the "months_covered" field.
+func MonthsCoveredIsNil() predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldIsNull(FieldMonthsCovered))
+}
+
+// MonthsCoveredNotNil applies the NotNil predicate on the "months_covered" field.
+func MonthsCoveredNotNil() predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldNotNull(FieldMonthsCovered))
+}
+
+// TargetMonthsEQ applies the EQ predicate on the "target_months" field.
+func TargetMonthsEQ(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEQ(FieldTargetMonths, v))
+}
+
+// TargetMonthsNEQ applies the NEQ predicate on the "target_months" field.
+func TargetMonthsNEQ(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldNEQ(FieldTargetMonths, v))
+}
+
+// TargetMonthsIn applies the In predicate on the "target_months" field.
+func TargetMonthsIn(vs ...float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldIn(FieldTargetMonths, vs...))
+}
+
+// TargetMonthsNotIn applies the NotIn predicate on the "target_months" field.
+func TargetMonthsNotIn(vs ...float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldNotIn(FieldTargetMonths, vs...))
+}
+
+// TargetMonthsGT applies the GT predicate on the "target_months" field.
+func TargetMonthsGT(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldGT(FieldTargetMonths, v))
+}
+
+// TargetMonthsGTE applies the GTE predicate on the "target_months" field.
+func TargetMonthsGTE(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldGTE(FieldTargetMonths, v))
+}
+
+// TargetMonthsLT applies the LT predicate on the "target_months" field.
+func TargetMonthsLT(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldLT(FieldTargetMonths, v))
+}
+
+// TargetMonthsLTE applies the LTE predicate on the "target_months" field.
+func TargetMonthsLTE(v float64) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldLTE(FieldTargetMonths, v))
+}
+
+// BelowTargetEQ applies the EQ predicate on the "below_target" field.
+func BelowTargetEQ(v bool) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEQ(FieldBelowTarget, v))
+}
+
+// BelowTargetNEQ applies the NEQ predicate on the "below_target" field.
+func BelowTargetNEQ(v bool) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldNEQ(FieldBelowTarget, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmergencyFundSnapshot) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmergencyFundSnapshot) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmergencyFundSnapshot) predicate.EmergencyFundSnapshot {
+	return predicate.EmergencyFundSnapshot(sql.NotPredicates(p))
+}
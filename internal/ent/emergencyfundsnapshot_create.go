@@ -0,0 +1,1032 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/emergencyfundsnapshot"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmergencyFundSnapshotCreate is the builder for creating a EmergencyFundSnapshot entity.
+type EmergencyFundSnapshotCreate struct {
+	config
+	mutation *EmergencyFundSnapshotMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *EmergencyFundSnapshotCreate) SetUserID(v string) *EmergencyFundSnapshotCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetDate sets the "date" field.
+func (_c *EmergencyFundSnapshotCreate) SetDate(v time.Time) *EmergencyFundSnapshotCreate {
+	_c.mutation.SetDate(v)
+	return _c
+}
+
+// SetBalance sets the "balance" field.
+func (_c *EmergencyFundSnapshotCreate) SetBalance(v float64) *EmergencyFundSnapshotCreate {
+	_c.mutation.SetBalance(v)
+	return _c
+}
+
+// SetMonthlyExpenses sets the "monthly_expenses" field.
+func (_c *EmergencyFundSnapshotCreate) SetMonthlyExpenses(v float64) *EmergencyFundSnapshotCreate {
+	_c.mutation.SetMonthlyExpenses(v)
+	return _c
+}
+
+// SetMonthsCovered sets the "months_covered" field.
+func (_c *EmergencyFundSnapshotCreate) SetMonthsCovered(v float64) *EmergencyFundSnapshotCreate {
+	_c.mutation.SetMonthsCovered(v)
+	return _c
+}
+
+// SetNillableMonthsCovered sets the "months_covered" field if the given value is not nil.
+func (_c *EmergencyFundSnapshotCreate) SetNillableMonthsCovered(v *float64) *EmergencyFundSnapshotCreate {
+	if v != nil {
+		_c.SetMonthsCovered(*v)
+	}
+	return _c
+}
+
+// SetTargetMonths sets the "target_months" field.
+func (_c *EmergencyFundSnapshotCreate) SetTargetMonths(v float64) *EmergencyFundSnapshotCreate {
+	_c.mutation.SetTargetMonths(v)
+	return _c
+}
+
+// SetBelowTarget sets the "below_target" field.
+func (_c *EmergencyFundSnapshotCreate) SetBelowTarget(v bool) *EmergencyFundSnapshotCreate {
+	_c.mutation.SetBelowTarget(v)
+	return _c
+}
+
+// SetNillableBelowTarget sets the "below_target" field if the given value is not nil.
+func (_c *EmergencyFundSnapshotCreate) SetNillableBelowTarget(v *bool) *EmergencyFundSnapshotCreate {
+	if v != nil {
+		_c.SetBelowTarget(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EmergencyFundSnapshotCreate) SetCreatedAt(v time.Time) *EmergencyFundSnapshotCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EmergencyFundSnapshotCreate) SetNillableCreatedAt(v *time.Time) *EmergencyFundSnapshotCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EmergencyFundSnapshotCreate) SetUpdatedAt(v time.Time) *EmergencyFundSnapshotCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EmergencyFundSnapshotCreate) SetNillableUpdatedAt(v *time.Time) *EmergencyFundSnapshotCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EmergencyFundSnapshotCreate) SetID(v string) *EmergencyFundSnapshotCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the EmergencyFundSnapshotMutation object of the builder.
+func (_c *EmergencyFundSnapshotCreate) Mutation() *EmergencyFundSnapshotMutation {
+	return _c.mutation
+}
+
+// Save creates the EmergencyFundSnapshot in the database.
+func (_c *EmergencyFundSnapshotCreate) Save(ctx context.Context) (*EmergencyFundSnapshot, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EmergencyFundSnapshotCreate) SaveX(ctx context.Context) *EmergencyFundSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmergencyFundSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmergencyFundSnapshotCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EmergencyFundSnapshotCreate) defaults() {
+	if _, ok := _c.mutation.BelowTarget(); !ok {
+		v := emergencyfundsnapshot.DefaultBelowTarget
+		_c.mutation.SetBelowTarget(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := emergencyfundsnapshot.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := emergencyfundsnapshot.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EmergencyFundSnapshotCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "EmergencyFundSnapshot.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := emergencyfundsnapshot.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "EmergencyFundSnapshot.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "EmergencyFundSnapshot.date"`)}
+	}
+	if _, ok := _c.mutation.Balance(); !ok {
+		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required field "EmergencyFundSnapshot.balance"`)}
+	}
+	if _, ok := _c.mutation.MonthlyExpenses(); !ok {
+		return &ValidationError{Name: "monthly_expenses", err: errors.New(`ent: missing required field "EmergencyFundSnapshot.monthly_expenses"`)}
+	}
+	if _, ok := _c.mutation.TargetMonths(); !ok {
+		return &ValidationError{Name: "target_months", err: errors.New(`ent: missing required field "EmergencyFundSnapshot.target_months"`)}
+	}
+	if _, ok := _c.mutation.BelowTarget(); !ok {
+		return &ValidationError{Name: "below_target", err: errors.New(`ent: missing required field "EmergencyFundSnapshot.below_target"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EmergencyFundSnapshot.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EmergencyFundSnapshot.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *EmergencyFundSnapshotCreate) sqlSave(ctx context.Context) (*EmergencyFundSnapshot, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected EmergencyFundSnapshot.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EmergencyFundSnapshotCreate) createSpec() (*EmergencyFundSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmergencyFundSnapshot{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(emergencyfundsnapshot.Table, sqlgraph.NewFieldSpec(emergencyfundsnapshot.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Date(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	if value, ok := _c.mutation.Balance(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldBalance, field.TypeFloat64, value)
+		_node.Balance = value
+	}
+	if value, ok := _c.mutation.MonthlyExpenses(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldMonthlyExpenses, field.TypeFloat64, value)
+		_node.MonthlyExpenses = value
+	}
+	if value, ok := _c.mutation.MonthsCovered(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldMonthsCovered, field.TypeFloat64, value)
+		_node.MonthsCovered = &value
+	}
+	if value, ok := _c.mutation.TargetMonths(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldTargetMonths, field.TypeFloat64, value)
+		_node.TargetMonths = value
+	}
+	if value, ok := _c.mutation.BelowTarget(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldBelowTarget, field.TypeBool, value)
+		_node.BelowTarget = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmergencyFundSnapshot.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmergencyFundSnapshotUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EmergencyFundSnapshotCreate) OnConflict(opts ...sql.ConflictOption) *EmergencyFundSnapshotUpsertOne {
+	_c.conflict = opts
+	return &EmergencyFundSnapshotUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmergencyFundSnapshot.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EmergencyFundSnapshotCreate) OnConflictColumns(columns ...string) *EmergencyFundSnapshotUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EmergencyFundSnapshotUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EmergencyFundSnapshotUpsertOne is the builder for "upsert"-ing
+	//  one EmergencyFundSnapshot node.
+	EmergencyFundSnapshotUpsertOne struct {
+		create *EmergencyFundSnapshotCreate
+	}
+
+	// EmergencyFundSnapshotUpsert is the "OnConflict" setter.
+	EmergencyFundSnapshotUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *EmergencyFundSnapshotUpsert) SetUserID(v string) *EmergencyFundSnapshotUpsert {
+	u.Set(emergencyfundsnapshot.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsert) UpdateUserID() *EmergencyFundSnapshotUpsert {
+	u.SetExcluded(emergencyfundsnapshot.FieldUserID)
+	return u
+}
+
+// SetDate sets the "date" field.
+func (u *EmergencyFundSnapshotUpsert) SetDate(v time.Time) *EmergencyFundSnapshotUpsert {
+	u.Set(emergencyfundsnapshot.FieldDate, v)
+	return u
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsert) UpdateDate() *EmergencyFundSnapshotUpsert {
+	u.SetExcluded(emergencyfundsnapshot.FieldDate)
+	return u
+}
+
+// SetBalance sets the "balance" field.
+func (u *EmergencyFundSnapshotUpsert) SetBalance(v float64) *EmergencyFundSnapshotUpsert {
+	u.Set(emergencyfundsnapshot.FieldBalance, v)
+	return u
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsert) UpdateBalance() *EmergencyFundSnapshotUpsert {
+	u.SetExcluded(emergencyfundsnapshot.FieldBalance)
+	return u
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *EmergencyFundSnapshotUpsert) AddBalance(v float64) *EmergencyFundSnapshotUpsert {
+	u.Add(emergencyfundsnapshot.FieldBalance, v)
+	return u
+}
+
+// SetMonthlyExpenses sets the "monthly_expenses" field.
+func (u *EmergencyFundSnapshotUpsert) SetMonthlyExpenses(v float64) *EmergencyFundSnapshotUpsert {
+	u.Set(emergencyfundsnapshot.FieldMonthlyExpenses, v)
+	return u
+}
+
+// UpdateMonthlyExpenses sets the "monthly_expenses" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsert) UpdateMonthlyExpenses() *EmergencyFundSnapshotUpsert {
+	u.SetExcluded(emergencyfundsnapshot.FieldMonthlyExpenses)
+	return u
+}
+
+// AddMonthlyExpenses adds v to the "monthly_expenses" field.
+func (u *EmergencyFundSnapshotUpsert) AddMonthlyExpenses(v float64) *EmergencyFundSnapshotUpsert {
+	u.Add(emergencyfundsnapshot.FieldMonthlyExpenses, v)
+	return u
+}
+
+// SetMonthsCovered sets the "months_covered" field.
+func (u *EmergencyFundSnapshotUpsert) SetMonthsCovered(v float64) *EmergencyFundSnapshotUpsert {
+	u.Set(emergencyfundsnapshot.FieldMonthsCovered, v)
+	return u
+}
+
+// UpdateMonthsCovered sets the "months_covered" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsert) UpdateMonthsCovered() *EmergencyFundSnapshotUpsert {
+	u.SetExcluded(emergencyfundsnapshot.FieldMonthsCovered)
+	return u
+}
+
+// AddMonthsCovered adds v to the "months_covered" field.
+func (u *EmergencyFundSnapshotUpsert) AddMonthsCovered(v float64) *EmergencyFundSnapshotUpsert {
+	u.Add(emergencyfundsnapshot.FieldMonthsCovered, v)
+	return u
+}
+
+// ClearMonthsCovered clears the value of the "months_covered" field.
+func (u *EmergencyFundSnapshotUpsert) ClearMonthsCovered() *EmergencyFundSnapshotUpsert {
+	u.SetNull(emergencyfundsnapshot.FieldMonthsCovered)
+	return u
+}
+
+// SetTargetMonths sets the "target_months" field.
+func (u *EmergencyFundSnapshotUpsert) SetTargetMonths(v float64) *EmergencyFundSnapshotUpsert {
+	u.Set(emergencyfundsnapshot.FieldTargetMonths, v)
+	return u
+}
+
+// UpdateTargetMonths sets the "target_months" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsert) UpdateTargetMonths() *EmergencyFundSnapshotUpsert {
+	u.SetExcluded(emergencyfundsnapshot.FieldTargetMonths)
+	return u
+}
+
+// AddTargetMonths adds v to the "target_months" field.
+func (u *EmergencyFundSnapshotUpsert) AddTargetMonths(v float64) *EmergencyFundSnapshotUpsert {
+	u.Add(emergencyfundsnapshot.FieldTargetMonths, v)
+	return u
+}
+
+// SetBelowTarget sets the "below_target" field.
+func (u *EmergencyFundSnapshotUpsert) SetBelowTarget(v bool) *EmergencyFundSnapshotUpsert {
+	u.Set(emergencyfundsnapshot.FieldBelowTarget, v)
+	return u
+}
+
+// UpdateBelowTarget sets the "below_target" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsert) UpdateBelowTarget() *EmergencyFundSnapshotUpsert {
+	u.SetExcluded(emergencyfundsnapshot.FieldBelowTarget)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmergencyFundSnapshotUpsert) SetUpdatedAt(v time.Time) *EmergencyFundSnapshotUpsert {
+	u.Set(emergencyfundsnapshot.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsert) UpdateUpdatedAt() *EmergencyFundSnapshotUpsert {
+	u.SetExcluded(emergencyfundsnapshot.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EmergencyFundSnapshot.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emergencyfundsnapshot.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmergencyFundSnapshotUpsertOne) UpdateNewValues() *EmergencyFundSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(emergencyfundsnapshot.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(emergencyfundsnapshot.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmergencyFundSnapshot.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EmergencyFundSnapshotUpsertOne) Ignore() *EmergencyFundSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmergencyFundSnapshotUpsertOne) DoNothing() *EmergencyFundSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmergencyFundSnapshotCreate.OnConflict
+// documentation for more info.
+func (u *EmergencyFundSnapshotUpsertOne) Update(set func(*EmergencyFundSnapshotUpsert)) *EmergencyFundSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmergencyFundSnapshotUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *EmergencyFundSnapshotUpsertOne) SetUserID(v string) *EmergencyFundSnapshotUpsertOne {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsertOne) UpdateUserID() *EmergencyFundSnapshotUpsertOne {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetDate sets the "date" field.
+func (u *EmergencyFundSnapshotUpsertOne) SetDate(v time.Time) *EmergencyFundSnapshotUpsertOne {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsertOne) UpdateDate() *EmergencyFundSnapshotUpsertOne {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *EmergencyFundSnapshotUpsertOne) SetBalance(v float64) *EmergencyFundSnapshotUpsertOne {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *EmergencyFundSnapshotUpsertOne) AddBalance(v float64) *EmergencyFundSnapshotUpsertOne {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsertOne) UpdateBalance() *EmergencyFundSnapshotUpsertOne {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// SetMonthlyExpenses sets the "monthly_expenses" field.
+func (u *EmergencyFundSnapshotUpsertOne) SetMonthlyExpenses(v float64) *EmergencyFundSnapshotUpsertOne {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.SetMonthlyExpenses(v)
+	})
+}
+
+// AddMonthlyExpenses adds v to the "monthly_expenses" field.
+func (u *EmergencyFundSnapshotUpsertOne) AddMonthlyExpenses(v float64) *EmergencyFundSnapshotUpsertOne {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.AddMonthlyExpenses(v)
+	})
+}
+
+// UpdateMonthlyExpenses sets the "monthly_expenses" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsertOne) UpdateMonthlyExpenses() *EmergencyFundSnapshotUpsertOne {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.UpdateMonthlyExpenses()
+	})
+}
+
+// SetMonthsCovered sets the "months_covered" field.
+func (u *EmergencyFundSnapshotUpsertOne) SetMonthsCovered(v float64) *EmergencyFundSnapshotUpsertOne {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.SetMonthsCovered(v)
+	})
+}
+
+// AddMonthsCovered adds v to the "months_covered" field.
+func (u *EmergencyFundSnapshotUpsertOne) AddMonthsCovered(v float64) *EmergencyFundSnapshotUpsertOne {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.AddMonthsCovered(v)
+	})
+}
+
+// UpdateMonthsCovered sets the "months_covered" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsertOne) UpdateMonthsCovered() *EmergencyFundSnapshotUpsertOne {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.UpdateMonthsCovered()
+	})
+}
+
+// ClearMonthsCovered clears the value of the "months_covered" field.
+func (u *EmergencyFundSnapshotUpsertOne) ClearMonthsCovered() *EmergencyFundSnapshotUpsertOne {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.ClearMonthsCovered()
+	})
+}
+
+// SetTargetMonths sets the "target_months" field.
+func (u *EmergencyFundSnapshotUpsertOne) SetTargetMonths(v float64) *EmergencyFundSnapshotUpsertOne {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.SetTargetMonths(v)
+	})
+}
+
+// AddTargetMonths adds v to the "target_months" field.
+func (u *EmergencyFundSnapshotUpsertOne) AddTargetMonths(v float64) *EmergencyFundSnapshotUpsertOne {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.AddTargetMonths(v)
+	})
+}
+
+// UpdateTargetMonths sets the "target_months" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsertOne) UpdateTargetMonths() *EmergencyFundSnapshotUpsertOne {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.UpdateTargetMonths()
+	})
+}
+
+// SetBelowTarget sets the "below_target" field.
+func (u *EmergencyFundSnapshotUpsertOne) SetBelowTarget(v bool) *EmergencyFundSnapshotUpsertOne {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.SetBelowTarget(v)
+	})
+}
+
+// UpdateBelowTarget sets the "below_target" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsertOne) UpdateBelowTarget() *EmergencyFundSnapshotUpsertOne {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.UpdateBelowTarget()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmergencyFundSnapshotUpsertOne) SetUpdatedAt(v time.Time) *EmergencyFundSnapshotUpsertOne {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsertOne) UpdateUpdatedAt() *EmergencyFundSnapshotUpsertOne {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmergencyFundSnapshotUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmergencyFundSnapshotCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmergencyFundSnapshotUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EmergencyFundSnapshotUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EmergencyFundSnapshotUpsertOne.ID is not supported by MySQL driver. Use EmergencyFundSnapshotUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EmergencyFundSnapshotUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EmergencyFundSnapshotCreateBulk is the builder for creating many EmergencyFundSnapshot entities in bulk.
+type EmergencyFundSnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*EmergencyFundSnapshotCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EmergencyFundSnapshot entities in the database.
+func (_c *EmergencyFundSnapshotCreateBulk) Save(ctx context.Context) ([]*EmergencyFundSnapshot, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EmergencyFundSnapshot, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmergencyFundSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EmergencyFundSnapshotCreateBulk) SaveX(ctx context.Context) []*EmergencyFundSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmergencyFundSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmergencyFundSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmergencyFundSnapshot.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmergencyFundSnapshotUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EmergencyFundSnapshotCreateBulk) OnConflict(opts ...sql.ConflictOption) *EmergencyFundSnapshotUpsertBulk {
+	_c.conflict = opts
+	return &EmergencyFundSnapshotUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmergencyFundSnapshot.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EmergencyFundSnapshotCreateBulk) OnConflictColumns(columns ...string) *EmergencyFundSnapshotUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EmergencyFundSnapshotUpsertBulk{
+		create: _c,
+	}
+}
+
+// EmergencyFundSnapshotUpsertBulk is the builder for "upsert"-ing
+// a bulk of EmergencyFundSnapshot nodes.
+type EmergencyFundSnapshotUpsertBulk struct {
+	create *EmergencyFundSnapshotCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EmergencyFundSnapshot.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emergencyfundsnapshot.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmergencyFundSnapshotUpsertBulk) UpdateNewValues() *EmergencyFundSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(emergencyfundsnapshot.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(emergencyfundsnapshot.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmergencyFundSnapshot.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EmergencyFundSnapshotUpsertBulk) Ignore() *EmergencyFundSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmergencyFundSnapshotUpsertBulk) DoNothing() *EmergencyFundSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmergencyFundSnapshotCreateBulk.OnConflict
+// documentation for more info.
+func (u *EmergencyFundSnapshotUpsertBulk) Update(set func(*EmergencyFundSnapshotUpsert)) *EmergencyFundSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmergencyFundSnapshotUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *EmergencyFundSnapshotUpsertBulk) SetUserID(v string) *EmergencyFundSnapshotUpsertBulk {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsertBulk) UpdateUserID() *EmergencyFundSnapshotUpsertBulk {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetDate sets the "date" field.
+func (u *EmergencyFundSnapshotUpsertBulk) SetDate(v time.Time) *EmergencyFundSnapshotUpsertBulk {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsertBulk) UpdateDate() *EmergencyFundSnapshotUpsertBulk {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *EmergencyFundSnapshotUpsertBulk) SetBalance(v float64) *EmergencyFundSnapshotUpsertBulk {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *EmergencyFundSnapshotUpsertBulk) AddBalance(v float64) *EmergencyFundSnapshotUpsertBulk {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsertBulk) UpdateBalance() *EmergencyFundSnapshotUpsertBulk {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// SetMonthlyExpenses sets the "monthly_expenses" field.
+func (u *EmergencyFundSnapshotUpsertBulk) SetMonthlyExpenses(v float64) *EmergencyFundSnapshotUpsertBulk {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.SetMonthlyExpenses(v)
+	})
+}
+
+// AddMonthlyExpenses adds v to the "monthly_expenses" field.
+func (u *EmergencyFundSnapshotUpsertBulk) AddMonthlyExpenses(v float64) *EmergencyFundSnapshotUpsertBulk {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.AddMonthlyExpenses(v)
+	})
+}
+
+// UpdateMonthlyExpenses sets the "monthly_expenses" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsertBulk) UpdateMonthlyExpenses() *EmergencyFundSnapshotUpsertBulk {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.UpdateMonthlyExpenses()
+	})
+}
+
+// SetMonthsCovered sets the "months_covered" field.
+func (u *EmergencyFundSnapshotUpsertBulk) SetMonthsCovered(v float64) *EmergencyFundSnapshotUpsertBulk {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.SetMonthsCovered(v)
+	})
+}
+
+// AddMonthsCovered adds v to the "months_covered" field.
+func (u *EmergencyFundSnapshotUpsertBulk) AddMonthsCovered(v float64) *EmergencyFundSnapshotUpsertBulk {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.AddMonthsCovered(v)
+	})
+}
+
+// UpdateMonthsCovered sets the "months_covered" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsertBulk) UpdateMonthsCovered() *EmergencyFundSnapshotUpsertBulk {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.UpdateMonthsCovered()
+	})
+}
+
+// ClearMonthsCovered clears the value of the "months_covered" field.
+func (u *EmergencyFundSnapshotUpsertBulk) ClearMonthsCovered() *EmergencyFundSnapshotUpsertBulk {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.ClearMonthsCovered()
+	})
+}
+
+// SetTargetMonths sets the "target_months" field.
+func (u *EmergencyFundSnapshotUpsertBulk) SetTargetMonths(v float64) *EmergencyFundSnapshotUpsertBulk {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.SetTargetMonths(v)
+	})
+}
+
+// AddTargetMonths adds v to the "target_months" field.
+func (u *EmergencyFundSnapshotUpsertBulk) AddTargetMonths(v float64) *EmergencyFundSnapshotUpsertBulk {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.AddTargetMonths(v)
+	})
+}
+
+// UpdateTargetMonths sets the "target_months" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsertBulk) UpdateTargetMonths() *EmergencyFundSnapshotUpsertBulk {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.UpdateTargetMonths()
+	})
+}
+
+// SetBelowTarget sets the "below_target" field.
+func (u *EmergencyFundSnapshotUpsertBulk) SetBelowTarget(v bool) *EmergencyFundSnapshotUpsertBulk {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.SetBelowTarget(v)
+	})
+}
+
+// UpdateBelowTarget sets the "below_target" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsertBulk) UpdateBelowTarget() *EmergencyFundSnapshotUpsertBulk {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.UpdateBelowTarget()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmergencyFundSnapshotUpsertBulk) SetUpdatedAt(v time.Time) *EmergencyFundSnapshotUpsertBulk {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmergencyFundSnapshotUpsertBulk) UpdateUpdatedAt() *EmergencyFundSnapshotUpsertBulk {
+	return u.Update(func(s *EmergencyFundSnapshotUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmergencyFundSnapshotUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EmergencyFundSnapshotCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmergencyFundSnapshotCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmergencyFundSnapshotUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
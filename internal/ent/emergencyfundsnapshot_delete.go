@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/emergencyfundsnapshot"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmergencyFundSnapshotDelete is the builder for deleting a EmergencyFundSnapshot entity.
+type EmergencyFundSnapshotDelete struct {
+	config
+	hooks    []Hook
+	mutation *EmergencyFundSnapshotMutation
+}
+
+// Where appends a list predicates to the EmergencyFundSnapshotDelete builder.
+func (_d *EmergencyFundSnapshotDelete) Where(ps ...predicate.EmergencyFundSnapshot) *EmergencyFundSnapshotDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *EmergencyFundSnapshotDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EmergencyFundSnapshotDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *EmergencyFundSnapshotDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(emergencyfundsnapshot.Table, sqlgraph.NewFieldSpec(emergencyfundsnapshot.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// EmergencyFundSnapshotDeleteOne is the builder for deleting a single EmergencyFundSnapshot entity.
+type EmergencyFundSnapshotDeleteOne struct {
+	_d *EmergencyFundSnapshotDelete
+}
+
+// Where appends a list predicates to the EmergencyFundSnapshotDelete builder.
+func (_d *EmergencyFundSnapshotDeleteOne) Where(ps ...predicate.EmergencyFundSnapshot) *EmergencyFundSnapshotDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *EmergencyFundSnapshotDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{emergencyfundsnapshot.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EmergencyFundSnapshotDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
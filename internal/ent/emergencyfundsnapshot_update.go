@@ -0,0 +1,574 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/emergencyfundsnapshot"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmergencyFundSnapshotUpdate is the builder for updating EmergencyFundSnapshot entities.
+type EmergencyFundSnapshotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmergencyFundSnapshotMutation
+}
+
+// Where appends a list predicates to the EmergencyFundSnapshotUpdate builder.
+func (_u *EmergencyFundSnapshotUpdate) Where(ps ...predicate.EmergencyFundSnapshot) *EmergencyFundSnapshotUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *EmergencyFundSnapshotUpdate) SetUserID(v string) *EmergencyFundSnapshotUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *EmergencyFundSnapshotUpdate) SetNillableUserID(v *string) *EmergencyFundSnapshotUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *EmergencyFundSnapshotUpdate) SetDate(v time.Time) *EmergencyFundSnapshotUpdate {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *EmergencyFundSnapshotUpdate) SetNillableDate(v *time.Time) *EmergencyFundSnapshotUpdate {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetBalance sets the "balance" field.
+func (_u *EmergencyFundSnapshotUpdate) SetBalance(v float64) *EmergencyFundSnapshotUpdate {
+	_u.mutation.ResetBalance()
+	_u.mutation.SetBalance(v)
+	return _u
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (_u *EmergencyFundSnapshotUpdate) SetNillableBalance(v *float64) *EmergencyFundSnapshotUpdate {
+	if v != nil {
+		_u.SetBalance(*v)
+	}
+	return _u
+}
+
+// AddBalance adds value to the "balance" field.
+func (_u *EmergencyFundSnapshotUpdate) AddBalance(v float64) *EmergencyFundSnapshotUpdate {
+	_u.mutation.AddBalance(v)
+	return _u
+}
+
+// SetMonthlyExpenses sets the "monthly_expenses" field.
+func (_u *EmergencyFundSnapshotUpdate) SetMonthlyExpenses(v float64) *EmergencyFundSnapshotUpdate {
+	_u.mutation.ResetMonthlyExpenses()
+	_u.mutation.SetMonthlyExpenses(v)
+	return _u
+}
+
+// SetNillableMonthlyExpenses sets the "monthly_expenses" field if the given value is not nil.
+func (_u *EmergencyFundSnapshotUpdate) SetNillableMonthlyExpenses(v *float64) *EmergencyFundSnapshotUpdate {
+	if v != nil {
+		_u.SetMonthlyExpenses(*v)
+	}
+	return _u
+}
+
+// AddMonthlyExpenses adds value to the "monthly_expenses" field.
+func (_u *EmergencyFundSnapshotUpdate) AddMonthlyExpenses(v float64) *EmergencyFundSnapshotUpdate {
+	_u.mutation.AddMonthlyExpenses(v)
+	return _u
+}
+
+// SetMonthsCovered sets the "months_covered" field.
+func (_u *EmergencyFundSnapshotUpdate) SetMonthsCovered(v float64) *EmergencyFundSnapshotUpdate {
+	_u.mutation.ResetMonthsCovered()
+	_u.mutation.SetMonthsCovered(v)
+	return _u
+}
+
+// SetNillableMonthsCovered sets the "months_covered" field if the given value is not nil.
+func (_u *EmergencyFundSnapshotUpdate) SetNillableMonthsCovered(v *float64) *EmergencyFundSnapshotUpdate {
+	if v != nil {
+		_u.SetMonthsCovered(*v)
+	}
+	return _u
+}
+
+// AddMonthsCovered adds value to the "months_covered" field.
+func (_u *EmergencyFundSnapshotUpdate) AddMonthsCovered(v float64) *EmergencyFundSnapshotUpdate {
+	_u.mutation.AddMonthsCovered(v)
+	return _u
+}
+
+// ClearMonthsCovered clears the value of the "months_covered" field.
+func (_u *EmergencyFundSnapshotUpdate) ClearMonthsCovered() *EmergencyFundSnapshotUpdate {
+	_u.mutation.ClearMonthsCovered()
+	return _u
+}
+
+// SetTargetMonths sets the "target_months" field.
+func (_u *EmergencyFundSnapshotUpdate) SetTargetMonths(v float64) *EmergencyFundSnapshotUpdate {
+	_u.mutation.ResetTargetMonths()
+	_u.mutation.SetTargetMonths(v)
+	return _u
+}
+
+// SetNillableTargetMonths sets the "target_months" field if the given value is not nil.
+func (_u *EmergencyFundSnapshotUpdate) SetNillableTargetMonths(v *float64) *EmergencyFundSnapshotUpdate {
+	if v != nil {
+		_u.SetTargetMonths(*v)
+	}
+	return _u
+}
+
+// AddTargetMonths adds value to the "target_months" field.
+func (_u *EmergencyFundSnapshotUpdate) AddTargetMonths(v float64) *EmergencyFundSnapshotUpdate {
+	_u.mutation.AddTargetMonths(v)
+	return _u
+}
+
+// SetBelowTarget sets the "below_target" field.
+func (_u *EmergencyFundSnapshotUpdate) SetBelowTarget(v bool) *EmergencyFundSnapshotUpdate {
+	_u.mutation.SetBelowTarget(v)
+	return _u
+}
+
+// SetNillableBelowTarget sets the "below_target" field if the given value is not nil.
+func (_u *EmergencyFundSnapshotUpdate) SetNillableBelowTarget(v *bool) *EmergencyFundSnapshotUpdate {
+	if v != nil {
+		_u.SetBelowTarget(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EmergencyFundSnapshotUpdate) SetUpdatedAt(v time.Time) *EmergencyFundSnapshotUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EmergencyFundSnapshotMutation object of the builder.
+func (_u *EmergencyFundSnapshotUpdate) Mutation() *EmergencyFundSnapshotMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EmergencyFundSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmergencyFundSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EmergencyFundSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmergencyFundSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmergencyFundSnapshotUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := emergencyfundsnapshot.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmergencyFundSnapshotUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := emergencyfundsnapshot.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "EmergencyFundSnapshot.user_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EmergencyFundSnapshotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emergencyfundsnapshot.Table, emergencyfundsnapshot.Columns, sqlgraph.NewFieldSpec(emergencyfundsnapshot.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Balance(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBalance(); ok {
+		_spec.AddField(emergencyfundsnapshot.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MonthlyExpenses(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldMonthlyExpenses, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyExpenses(); ok {
+		_spec.AddField(emergencyfundsnapshot.FieldMonthlyExpenses, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MonthsCovered(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldMonthsCovered, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMonthsCovered(); ok {
+		_spec.AddField(emergencyfundsnapshot.FieldMonthsCovered, field.TypeFloat64, value)
+	}
+	if _u.mutation.MonthsCoveredCleared() {
+		_spec.ClearField(emergencyfundsnapshot.FieldMonthsCovered, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.TargetMonths(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldTargetMonths, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTargetMonths(); ok {
+		_spec.AddField(emergencyfundsnapshot.FieldTargetMonths, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.BelowTarget(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldBelowTarget, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emergencyfundsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EmergencyFundSnapshotUpdateOne is the builder for updating a single EmergencyFundSnapshot entity.
+type EmergencyFundSnapshotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmergencyFundSnapshotMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *EmergencyFundSnapshotUpdateOne) SetUserID(v string) *EmergencyFundSnapshotUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *EmergencyFundSnapshotUpdateOne) SetNillableUserID(v *string) *EmergencyFundSnapshotUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *EmergencyFundSnapshotUpdateOne) SetDate(v time.Time) *EmergencyFundSnapshotUpdateOne {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *EmergencyFundSnapshotUpdateOne) SetNillableDate(v *time.Time) *EmergencyFundSnapshotUpdateOne {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetBalance sets the "balance" field.
+func (_u *EmergencyFundSnapshotUpdateOne) SetBalance(v float64) *EmergencyFundSnapshotUpdateOne {
+	_u.mutation.ResetBalance()
+	_u.mutation.SetBalance(v)
+	return _u
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (_u *EmergencyFundSnapshotUpdateOne) SetNillableBalance(v *float64) *EmergencyFundSnapshotUpdateOne {
+	if v != nil {
+		_u.SetBalance(*v)
+	}
+	return _u
+}
+
+// AddBalance adds value to the "balance" field.
+func (_u *EmergencyFundSnapshotUpdateOne) AddBalance(v float64) *EmergencyFundSnapshotUpdateOne {
+	_u.mutation.AddBalance(v)
+	return _u
+}
+
+// SetMonthlyExpenses sets the "monthly_expenses" field.
+func (_u *EmergencyFundSnapshotUpdateOne) SetMonthlyExpenses(v float64) *EmergencyFundSnapshotUpdateOne {
+	_u.mutation.ResetMonthlyExpenses()
+	_u.mutation.SetMonthlyExpenses(v)
+	return _u
+}
+
+// SetNillableMonthlyExpenses sets the "monthly_expenses" field if the given value is not nil.
+func (_u *EmergencyFundSnapshotUpdateOne) SetNillableMonthlyExpenses(v *float64) *EmergencyFundSnapshotUpdateOne {
+	if v != nil {
+		_u.SetMonthlyExpenses(*v)
+	}
+	return _u
+}
+
+// AddMonthlyExpenses adds value to the "monthly_expenses" field.
+func (_u *EmergencyFundSnapshotUpdateOne) AddMonthlyExpenses(v float64) *EmergencyFundSnapshotUpdateOne {
+	_u.mutation.AddMonthlyExpenses(v)
+	return _u
+}
+
+// SetMonthsCovered sets the "months_covered" field.
+func (_u *EmergencyFundSnapshotUpdateOne) SetMonthsCovered(v float64) *EmergencyFundSnapshotUpdateOne {
+	_u.mutation.ResetMonthsCovered()
+	_u.mutation.SetMonthsCovered(v)
+	return _u
+}
+
+// SetNillableMonthsCovered sets the "months_covered" field if the given value is not nil.
+func (_u *EmergencyFundSnapshotUpdateOne) SetNillableMonthsCovered(v *float64) *EmergencyFundSnapshotUpdateOne {
+	if v != nil {
+		_u.SetMonthsCovered(*v)
+	}
+	return _u
+}
+
+// AddMonthsCovered adds value to the "months_covered" field.
+func (_u *EmergencyFundSnapshotUpdateOne) AddMonthsCovered(v float64) *EmergencyFundSnapshotUpdateOne {
+	_u.mutation.AddMonthsCovered(v)
+	return _u
+}
+
+// ClearMonthsCovered clears the value of the "months_covered" field.
+func (_u *EmergencyFundSnapshotUpdateOne) ClearMonthsCovered() *EmergencyFundSnapshotUpdateOne {
+	_u.mutation.ClearMonthsCovered()
+	return _u
+}
+
+// SetTargetMonths sets the "target_months" field.
+func (_u *EmergencyFundSnapshotUpdateOne) SetTargetMonths(v float64) *EmergencyFundSnapshotUpdateOne {
+	_u.mutation.ResetTargetMonths()
+	_u.mutation.SetTargetMonths(v)
+	return _u
+}
+
+// SetNillableTargetMonths sets the "target_months" field if the given value is not nil.
+func (_u *EmergencyFundSnapshotUpdateOne) SetNillableTargetMonths(v *float64) *EmergencyFundSnapshotUpdateOne {
+	if v != nil {
+		_u.SetTargetMonths(*v)
+	}
+	return _u
+}
+
+// AddTargetMonths adds value to the "target_months" field.
+func (_u *EmergencyFundSnapshotUpdateOne) AddTargetMonths(v float64) *EmergencyFundSnapshotUpdateOne {
+	_u.mutation.AddTargetMonths(v)
+	return _u
+}
+
+// SetBelowTarget sets the "below_target" field.
+func (_u *EmergencyFundSnapshotUpdateOne) SetBelowTarget(v bool) *EmergencyFundSnapshotUpdateOne {
+	_u.mutation.SetBelowTarget(v)
+	return _u
+}
+
+// SetNillableBelowTarget sets the "below_target" field if the given value is not nil.
+func (_u *EmergencyFundSnapshotUpdateOne) SetNillableBelowTarget(v *bool) *EmergencyFundSnapshotUpdateOne {
+	if v != nil {
+		_u.SetBelowTarget(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EmergencyFundSnapshotUpdateOne) SetUpdatedAt(v time.Time) *EmergencyFundSnapshotUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EmergencyFundSnapshotMutation object of the builder.
+func (_u *EmergencyFundSnapshotUpdateOne) Mutation() *EmergencyFundSnapshotMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EmergencyFundSnapshotUpdate builder.
+func (_u *EmergencyFundSnapshotUpdateOne) Where(ps ...predicate.EmergencyFundSnapshot) *EmergencyFundSnapshotUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EmergencyFundSnapshotUpdateOne) Select(field string, fields ...string) *EmergencyFundSnapshotUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EmergencyFundSnapshot entity.
+func (_u *EmergencyFundSnapshotUpdateOne) Save(ctx context.Context) (*EmergencyFundSnapshot, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmergencyFundSnapshotUpdateOne) SaveX(ctx context.Context) *EmergencyFundSnapshot {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EmergencyFundSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmergencyFundSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmergencyFundSnapshotUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := emergencyfundsnapshot.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmergencyFundSnapshotUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := emergencyfundsnapshot.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "EmergencyFundSnapshot.user_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EmergencyFundSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *EmergencyFundSnapshot, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emergencyfundsnapshot.Table, emergencyfundsnapshot.Columns, sqlgraph.NewFieldSpec(emergencyfundsnapshot.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmergencyFundSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emergencyfundsnapshot.FieldID)
+		for _, f := range fields {
+			if !emergencyfundsnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emergencyfundsnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Balance(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBalance(); ok {
+		_spec.AddField(emergencyfundsnapshot.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MonthlyExpenses(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldMonthlyExpenses, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyExpenses(); ok {
+		_spec.AddField(emergencyfundsnapshot.FieldMonthlyExpenses, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MonthsCovered(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldMonthsCovered, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMonthsCovered(); ok {
+		_spec.AddField(emergencyfundsnapshot.FieldMonthsCovered, field.TypeFloat64, value)
+	}
+	if _u.mutation.MonthsCoveredCleared() {
+		_spec.ClearField(emergencyfundsnapshot.FieldMonthsCovered, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.TargetMonths(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldTargetMonths, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTargetMonths(); ok {
+		_spec.AddField(emergencyfundsnapshot.FieldTargetMonths, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.BelowTarget(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldBelowTarget, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(emergencyfundsnapshot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &EmergencyFundSnapshot{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emergencyfundsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
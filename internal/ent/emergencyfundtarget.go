@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/emergencyfundtarget"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// EmergencyFundTarget is the model entity for the EmergencyFundTarget schema.
+type EmergencyFundTarget struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ID of the user the target belongs to
+	UserID string `json:"user_id,omitempty"`
+	// Months of expenses the user wants their emergency fund to cover
+	TargetMonths float64 `json:"target_months,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmergencyFundTarget) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emergencyfundtarget.FieldTargetMonths:
+			values[i] = new(sql.NullFloat64)
+		case emergencyfundtarget.FieldID, emergencyfundtarget.FieldUserID:
+			values[i] = new(sql.NullString)
+		case emergencyfundtarget.FieldCreatedAt, emergencyfundtarget.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmergencyFundTarget fields.
+func (_m *EmergencyFundTarget) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emergencyfundtarget.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case emergencyfundtarget.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case emergencyfundtarget.FieldTargetMonths:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_months", values[i])
+			} else if value.Valid {
+				_m.TargetMonths = value.Float64
+			}
+		case emergencyfundtarget.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case emergencyfundtarget.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmergencyFundTarget.
+// This includes values selected through modifiers, order, etc.
+func (_m *EmergencyFundTarget) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EmergencyFundTarget.
+// Note that you need to call EmergencyFundTarget.Unwrap() before calling this method if this EmergencyFundTarget
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EmergencyFundTarget) Update() *EmergencyFundTargetUpdateOne {
+	return NewEmergencyFundTargetClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EmergencyFundTarget entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EmergencyFundTarget) Unwrap() *EmergencyFundTarget {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmergencyFundTarget is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EmergencyFundTarget) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmergencyFundTarget(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("target_months=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetMonths))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmergencyFundTargets is a parsable slice of EmergencyFundTarget.
+type EmergencyFundTargets []*EmergencyFundTarget
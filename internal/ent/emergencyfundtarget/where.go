@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emergencyfundtarget
+
+import (
+	"clockzen-next/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldContainsFold(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldEQ(FieldUserID, v))
+}
+
+// TargetMonths applies equality check predicate on the "target_months" field. It's identical to TargetMonthsEQ.
+func TargetMonths(v float64) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldEQ(FieldTargetMonths, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// TargetMonthsEQ applies the EQ predicate on the "target_months" field.
+func TargetMonthsEQ(v float64) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldEQ(FieldTargetMonths, v))
+}
+
+// TargetMonthsNEQ applies the NEQ predicate on the "target_months" field.
+func TargetMonthsNEQ(v float64) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldNEQ(FieldTargetMonths, v))
+}
+
+// TargetMonthsIn applies the In predicate on the "target_months" field.
+func TargetMonthsIn(vs ...float64) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldIn(FieldTargetMonths, vs...))
+}
+
+// TargetMonthsNotIn applies the NotIn predicate on the "target_months" field.
+func TargetMonthsNotIn(vs ...float64) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldNotIn(FieldTargetMonths, vs...))
+}
+
+// TargetMonthsGT applies the GT predicate on the "target_months" field.
+func TargetMonthsGT(v float64) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldGT(FieldTargetMonths, v))
+}
+
+// TargetMonthsGTE applies the GTE predicate on the "target_months" field.
+func TargetMonthsGTE(v float64) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldGTE(FieldTargetMonths, v))
+}
+
+// TargetMonthsLT applies the LT predicate on the "target_months" field.
+func TargetMonthsLT(v float64) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldLT(FieldTargetMonths, v))
+}
+
+// TargetMonthsLTE applies the LTE predicate on the "target_months" field.
+func TargetMonthsLTE(v float64) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldLTE(FieldTargetMonths, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmergencyFundTarget) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmergencyFundTarget) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmergencyFundTarget) predicate.EmergencyFundTarget {
+	return predicate.EmergencyFundTarget(sql.NotPredicates(p))
+}
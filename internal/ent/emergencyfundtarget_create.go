@@ -0,0 +1,675 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/emergencyfundtarget"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmergencyFundTargetCreate is the builder for creating a EmergencyFundTarget entity.
+type EmergencyFundTargetCreate struct {
+	config
+	mutation *EmergencyFundTargetMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *EmergencyFundTargetCreate) SetUserID(v string) *EmergencyFundTargetCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetTargetMonths sets the "target_months" field.
+func (_c *EmergencyFundTargetCreate) SetTargetMonths(v float64) *EmergencyFundTargetCreate {
+	_c.mutation.SetTargetMonths(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EmergencyFundTargetCreate) SetCreatedAt(v time.Time) *EmergencyFundTargetCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EmergencyFundTargetCreate) SetNillableCreatedAt(v *time.Time) *EmergencyFundTargetCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EmergencyFundTargetCreate) SetUpdatedAt(v time.Time) *EmergencyFundTargetCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EmergencyFundTargetCreate) SetNillableUpdatedAt(v *time.Time) *EmergencyFundTargetCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EmergencyFundTargetCreate) SetID(v string) *EmergencyFundTargetCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the EmergencyFundTargetMutation object of the builder.
+func (_c *EmergencyFundTargetCreate) Mutation() *EmergencyFundTargetMutation {
+	return _c.mutation
+}
+
+// Save creates the EmergencyFundTarget in the database.
+func (_c *EmergencyFundTargetCreate) Save(ctx context.Context) (*EmergencyFundTarget, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EmergencyFundTargetCreate) SaveX(ctx context.Context) *EmergencyFundTarget {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmergencyFundTargetCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmergencyFundTargetCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EmergencyFundTargetCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := emergencyfundtarget.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := emergencyfundtarget.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EmergencyFundTargetCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "EmergencyFundTarget.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := emergencyfundtarget.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "EmergencyFundTarget.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TargetMonths(); !ok {
+		return &ValidationError{Name: "target_months", err: errors.New(`ent: missing required field "EmergencyFundTarget.target_months"`)}
+	}
+	if v, ok := _c.mutation.TargetMonths(); ok {
+		if err := emergencyfundtarget.TargetMonthsValidator(v); err != nil {
+			return &ValidationError{Name: "target_months", err: fmt.Errorf(`ent: validator failed for field "EmergencyFundTarget.target_months": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EmergencyFundTarget.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EmergencyFundTarget.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *EmergencyFundTargetCreate) sqlSave(ctx context.Context) (*EmergencyFundTarget, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected EmergencyFundTarget.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EmergencyFundTargetCreate) createSpec() (*EmergencyFundTarget, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmergencyFundTarget{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(emergencyfundtarget.Table, sqlgraph.NewFieldSpec(emergencyfundtarget.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(emergencyfundtarget.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.TargetMonths(); ok {
+		_spec.SetField(emergencyfundtarget.FieldTargetMonths, field.TypeFloat64, value)
+		_node.TargetMonths = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(emergencyfundtarget.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(emergencyfundtarget.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmergencyFundTarget.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmergencyFundTargetUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EmergencyFundTargetCreate) OnConflict(opts ...sql.ConflictOption) *EmergencyFundTargetUpsertOne {
+	_c.conflict = opts
+	return &EmergencyFundTargetUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmergencyFundTarget.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EmergencyFundTargetCreate) OnConflictColumns(columns ...string) *EmergencyFundTargetUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EmergencyFundTargetUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EmergencyFundTargetUpsertOne is the builder for "upsert"-ing
+	//  one EmergencyFundTarget node.
+	EmergencyFundTargetUpsertOne struct {
+		create *EmergencyFundTargetCreate
+	}
+
+	// EmergencyFundTargetUpsert is the "OnConflict" setter.
+	EmergencyFundTargetUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *EmergencyFundTargetUpsert) SetUserID(v string) *EmergencyFundTargetUpsert {
+	u.Set(emergencyfundtarget.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmergencyFundTargetUpsert) UpdateUserID() *EmergencyFundTargetUpsert {
+	u.SetExcluded(emergencyfundtarget.FieldUserID)
+	return u
+}
+
+// SetTargetMonths sets the "target_months" field.
+func (u *EmergencyFundTargetUpsert) SetTargetMonths(v float64) *EmergencyFundTargetUpsert {
+	u.Set(emergencyfundtarget.FieldTargetMonths, v)
+	return u
+}
+
+// UpdateTargetMonths sets the "target_months" field to the value that was provided on create.
+func (u *EmergencyFundTargetUpsert) UpdateTargetMonths() *EmergencyFundTargetUpsert {
+	u.SetExcluded(emergencyfundtarget.FieldTargetMonths)
+	return u
+}
+
+// AddTargetMonths adds v to the "target_months" field.
+func (u *EmergencyFundTargetUpsert) AddTargetMonths(v float64) *EmergencyFundTargetUpsert {
+	u.Add(emergencyfundtarget.FieldTargetMonths, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmergencyFundTargetUpsert) SetUpdatedAt(v time.Time) *EmergencyFundTargetUpsert {
+	u.Set(emergencyfundtarget.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmergencyFundTargetUpsert) UpdateUpdatedAt() *EmergencyFundTargetUpsert {
+	u.SetExcluded(emergencyfundtarget.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EmergencyFundTarget.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emergencyfundtarget.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmergencyFundTargetUpsertOne) UpdateNewValues() *EmergencyFundTargetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(emergencyfundtarget.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(emergencyfundtarget.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmergencyFundTarget.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EmergencyFundTargetUpsertOne) Ignore() *EmergencyFundTargetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmergencyFundTargetUpsertOne) DoNothing() *EmergencyFundTargetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmergencyFundTargetCreate.OnConflict
+// documentation for more info.
+func (u *EmergencyFundTargetUpsertOne) Update(set func(*EmergencyFundTargetUpsert)) *EmergencyFundTargetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmergencyFundTargetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *EmergencyFundTargetUpsertOne) SetUserID(v string) *EmergencyFundTargetUpsertOne {
+	return u.Update(func(s *EmergencyFundTargetUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmergencyFundTargetUpsertOne) UpdateUserID() *EmergencyFundTargetUpsertOne {
+	return u.Update(func(s *EmergencyFundTargetUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetTargetMonths sets the "target_months" field.
+func (u *EmergencyFundTargetUpsertOne) SetTargetMonths(v float64) *EmergencyFundTargetUpsertOne {
+	return u.Update(func(s *EmergencyFundTargetUpsert) {
+		s.SetTargetMonths(v)
+	})
+}
+
+// AddTargetMonths adds v to the "target_months" field.
+func (u *EmergencyFundTargetUpsertOne) AddTargetMonths(v float64) *EmergencyFundTargetUpsertOne {
+	return u.Update(func(s *EmergencyFundTargetUpsert) {
+		s.AddTargetMonths(v)
+	})
+}
+
+// UpdateTargetMonths sets the "target_months" field to the value that was provided on create.
+func (u *EmergencyFundTargetUpsertOne) UpdateTargetMonths() *EmergencyFundTargetUpsertOne {
+	return u.Update(func(s *EmergencyFundTargetUpsert) {
+		s.UpdateTargetMonths()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmergencyFundTargetUpsertOne) SetUpdatedAt(v time.Time) *EmergencyFundTargetUpsertOne {
+	return u.Update(func(s *EmergencyFundTargetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmergencyFundTargetUpsertOne) UpdateUpdatedAt() *EmergencyFundTargetUpsertOne {
+	return u.Update(func(s *EmergencyFundTargetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmergencyFundTargetUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmergencyFundTargetCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmergencyFundTargetUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EmergencyFundTargetUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EmergencyFundTargetUpsertOne.ID is not supported by MySQL driver. Use EmergencyFundTargetUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EmergencyFundTargetUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EmergencyFundTargetCreateBulk is the builder for creating many EmergencyFundTarget entities in bulk.
+type EmergencyFundTargetCreateBulk struct {
+	config
+	err      error
+	builders []*EmergencyFundTargetCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EmergencyFundTarget entities in the database.
+func (_c *EmergencyFundTargetCreateBulk) Save(ctx context.Context) ([]*EmergencyFundTarget, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EmergencyFundTarget, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmergencyFundTargetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EmergencyFundTargetCreateBulk) SaveX(ctx context.Context) []*EmergencyFundTarget {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmergencyFundTargetCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmergencyFundTargetCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmergencyFundTarget.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmergencyFundTargetUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EmergencyFundTargetCreateBulk) OnConflict(opts ...sql.ConflictOption) *EmergencyFundTargetUpsertBulk {
+	_c.conflict = opts
+	return &EmergencyFundTargetUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmergencyFundTarget.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EmergencyFundTargetCreateBulk) OnConflictColumns(columns ...string) *EmergencyFundTargetUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EmergencyFundTargetUpsertBulk{
+		create: _c,
+	}
+}
+
+// EmergencyFundTargetUpsertBulk is the builder for "upsert"-ing
+// a bulk of EmergencyFundTarget nodes.
+type EmergencyFundTargetUpsertBulk struct {
+	create *EmergencyFundTargetCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EmergencyFundTarget.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emergencyfundtarget.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmergencyFundTargetUpsertBulk) UpdateNewValues() *EmergencyFundTargetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(emergencyfundtarget.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(emergencyfundtarget.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmergencyFundTarget.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EmergencyFundTargetUpsertBulk) Ignore() *EmergencyFundTargetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmergencyFundTargetUpsertBulk) DoNothing() *EmergencyFundTargetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmergencyFundTargetCreateBulk.OnConflict
+// documentation for more info.
+func (u *EmergencyFundTargetUpsertBulk) Update(set func(*EmergencyFundTargetUpsert)) *EmergencyFundTargetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmergencyFundTargetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *EmergencyFundTargetUpsertBulk) SetUserID(v string) *EmergencyFundTargetUpsertBulk {
+	return u.Update(func(s *EmergencyFundTargetUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmergencyFundTargetUpsertBulk) UpdateUserID() *EmergencyFundTargetUpsertBulk {
+	return u.Update(func(s *EmergencyFundTargetUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetTargetMonths sets the "target_months" field.
+func (u *EmergencyFundTargetUpsertBulk) SetTargetMonths(v float64) *EmergencyFundTargetUpsertBulk {
+	return u.Update(func(s *EmergencyFundTargetUpsert) {
+		s.SetTargetMonths(v)
+	})
+}
+
+// AddTargetMonths adds v to the "target_months" field.
+func (u *EmergencyFundTargetUpsertBulk) AddTargetMonths(v float64) *EmergencyFundTargetUpsertBulk {
+	return u.Update(func(s *EmergencyFundTargetUpsert) {
+		s.AddTargetMonths(v)
+	})
+}
+
+// UpdateTargetMonths sets the "target_months" field to the value that was provided on create.
+func (u *EmergencyFundTargetUpsertBulk) UpdateTargetMonths() *EmergencyFundTargetUpsertBulk {
+	return u.Update(func(s *EmergencyFundTargetUpsert) {
+		s.UpdateTargetMonths()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmergencyFundTargetUpsertBulk) SetUpdatedAt(v time.Time) *EmergencyFundTargetUpsertBulk {
+	return u.Update(func(s *EmergencyFundTargetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmergencyFundTargetUpsertBulk) UpdateUpdatedAt() *EmergencyFundTargetUpsertBulk {
+	return u.Update(func(s *EmergencyFundTargetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmergencyFundTargetUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EmergencyFundTargetCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmergencyFundTargetCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmergencyFundTargetUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/emergencyfundtarget"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmergencyFundTargetDelete is the builder for deleting a EmergencyFundTarget entity.
+type EmergencyFundTargetDelete struct {
+	config
+	hooks    []Hook
+	mutation *EmergencyFundTargetMutation
+}
+
+// Where appends a list predicates to the EmergencyFundTargetDelete builder.
+func (_d *EmergencyFundTargetDelete) Where(ps ...predicate.EmergencyFundTarget) *EmergencyFundTargetDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *EmergencyFundTargetDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EmergencyFundTargetDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *EmergencyFundTargetDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(emergencyfundtarget.Table, sqlgraph.NewFieldSpec(emergencyfundtarget.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// EmergencyFundTargetDeleteOne is the builder for deleting a single EmergencyFundTarget entity.
+type EmergencyFundTargetDeleteOne struct {
+	_d *EmergencyFundTargetDelete
+}
+
+// Where appends a list predicates to the EmergencyFundTargetDelete builder.
+func (_d *EmergencyFundTargetDeleteOne) Where(ps ...predicate.EmergencyFundTarget) *EmergencyFundTargetDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *EmergencyFundTargetDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{emergencyfundtarget.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EmergencyFundTargetDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
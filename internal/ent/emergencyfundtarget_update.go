@@ -0,0 +1,336 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/emergencyfundtarget"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmergencyFundTargetUpdate is the builder for updating EmergencyFundTarget entities.
+type EmergencyFundTargetUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmergencyFundTargetMutation
+}
+
+// Where appends a list predicates to the EmergencyFundTargetUpdate builder.
+func (_u *EmergencyFundTargetUpdate) Where(ps ...predicate.EmergencyFundTarget) *EmergencyFundTargetUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *EmergencyFundTargetUpdate) SetUserID(v string) *EmergencyFundTargetUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *EmergencyFundTargetUpdate) SetNillableUserID(v *string) *EmergencyFundTargetUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetTargetMonths sets the "target_months" field.
+func (_u *EmergencyFundTargetUpdate) SetTargetMonths(v float64) *EmergencyFundTargetUpdate {
+	_u.mutation.ResetTargetMonths()
+	_u.mutation.SetTargetMonths(v)
+	return _u
+}
+
+// SetNillableTargetMonths sets the "target_months" field if the given value is not nil.
+func (_u *EmergencyFundTargetUpdate) SetNillableTargetMonths(v *float64) *EmergencyFundTargetUpdate {
+	if v != nil {
+		_u.SetTargetMonths(*v)
+	}
+	return _u
+}
+
+// AddTargetMonths adds value to the "target_months" field.
+func (_u *EmergencyFundTargetUpdate) AddTargetMonths(v float64) *EmergencyFundTargetUpdate {
+	_u.mutation.AddTargetMonths(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EmergencyFundTargetUpdate) SetUpdatedAt(v time.Time) *EmergencyFundTargetUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EmergencyFundTargetMutation object of the builder.
+func (_u *EmergencyFundTargetUpdate) Mutation() *EmergencyFundTargetMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EmergencyFundTargetUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmergencyFundTargetUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EmergencyFundTargetUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmergencyFundTargetUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmergencyFundTargetUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := emergencyfundtarget.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmergencyFundTargetUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := emergencyfundtarget.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "EmergencyFundTarget.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetMonths(); ok {
+		if err := emergencyfundtarget.TargetMonthsValidator(v); err != nil {
+			return &ValidationError{Name: "target_months", err: fmt.Errorf(`ent: validator failed for field "EmergencyFundTarget.target_months": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EmergencyFundTargetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emergencyfundtarget.Table, emergencyfundtarget.Columns, sqlgraph.NewFieldSpec(emergencyfundtarget.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(emergencyfundtarget.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetMonths(); ok {
+		_spec.SetField(emergencyfundtarget.FieldTargetMonths, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTargetMonths(); ok {
+		_spec.AddField(emergencyfundtarget.FieldTargetMonths, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(emergencyfundtarget.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emergencyfundtarget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EmergencyFundTargetUpdateOne is the builder for updating a single EmergencyFundTarget entity.
+type EmergencyFundTargetUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmergencyFundTargetMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *EmergencyFundTargetUpdateOne) SetUserID(v string) *EmergencyFundTargetUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *EmergencyFundTargetUpdateOne) SetNillableUserID(v *string) *EmergencyFundTargetUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetTargetMonths sets the "target_months" field.
+func (_u *EmergencyFundTargetUpdateOne) SetTargetMonths(v float64) *EmergencyFundTargetUpdateOne {
+	_u.mutation.ResetTargetMonths()
+	_u.mutation.SetTargetMonths(v)
+	return _u
+}
+
+// SetNillableTargetMonths sets the "target_months" field if the given value is not nil.
+func (_u *EmergencyFundTargetUpdateOne) SetNillableTargetMonths(v *float64) *EmergencyFundTargetUpdateOne {
+	if v != nil {
+		_u.SetTargetMonths(*v)
+	}
+	return _u
+}
+
+// AddTargetMonths adds value to the "target_months" field.
+func (_u *EmergencyFundTargetUpdateOne) AddTargetMonths(v float64) *EmergencyFundTargetUpdateOne {
+	_u.mutation.AddTargetMonths(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EmergencyFundTargetUpdateOne) SetUpdatedAt(v time.Time) *EmergencyFundTargetUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EmergencyFundTargetMutation object of the builder.
+func (_u *EmergencyFundTargetUpdateOne) Mutation() *EmergencyFundTargetMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EmergencyFundTargetUpdate builder.
+func (_u *EmergencyFundTargetUpdateOne) Where(ps ...predicate.EmergencyFundTarget) *EmergencyFundTargetUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EmergencyFundTargetUpdateOne) Select(field string, fields ...string) *EmergencyFundTargetUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EmergencyFundTarget entity.
+func (_u *EmergencyFundTargetUpdateOne) Save(ctx context.Context) (*EmergencyFundTarget, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmergencyFundTargetUpdateOne) SaveX(ctx context.Context) *EmergencyFundTarget {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EmergencyFundTargetUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmergencyFundTargetUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmergencyFundTargetUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := emergencyfundtarget.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmergencyFundTargetUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := emergencyfundtarget.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "EmergencyFundTarget.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetMonths(); ok {
+		if err := emergencyfundtarget.TargetMonthsValidator(v); err != nil {
+			return &ValidationError{Name: "target_months", err: fmt.Errorf(`ent: validator failed for field "EmergencyFundTarget.target_months": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EmergencyFundTargetUpdateOne) sqlSave(ctx context.Context) (_node *EmergencyFundTarget, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emergencyfundtarget.Table, emergencyfundtarget.Columns, sqlgraph.NewFieldSpec(emergencyfundtarget.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmergencyFundTarget.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emergencyfundtarget.FieldID)
+		for _, f := range fields {
+			if !emergencyfundtarget.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emergencyfundtarget.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(emergencyfundtarget.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetMonths(); ok {
+		_spec.SetField(emergencyfundtarget.FieldTargetMonths, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTargetMonths(); ok {
+		_spec.AddField(emergencyfundtarget.FieldTargetMonths, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(emergencyfundtarget.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &EmergencyFundTarget{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emergencyfundtarget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
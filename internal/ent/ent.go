@@ -3,18 +3,54 @@
 package ent
 
 import (
+	"clockzen-next/internal/ent/accountdeletion"
+	"clockzen-next/internal/ent/alert"
+	"clockzen-next/internal/ent/alertpreference"
+	"clockzen-next/internal/ent/attachmentblob"
+	"clockzen-next/internal/ent/attachmentlink"
+	"clockzen-next/internal/ent/budgetreallocation"
+	"clockzen-next/internal/ent/bulkoperation"
+	"clockzen-next/internal/ent/cardaccount"
+	"clockzen-next/internal/ent/categorizationrule"
+	"clockzen-next/internal/ent/categoryfeedback"
+	"clockzen-next/internal/ent/debt"
 	"clockzen-next/internal/ent/emailconnection"
 	"clockzen-next/internal/ent/emaillabel"
+	"clockzen-next/internal/ent/emailmessage"
 	"clockzen-next/internal/ent/emailsync"
+	"clockzen-next/internal/ent/emailsyncfailure"
+	"clockzen-next/internal/ent/emergencyfundsnapshot"
+	"clockzen-next/internal/ent/emergencyfundtarget"
+	"clockzen-next/internal/ent/goal"
 	"clockzen-next/internal/ent/googledriveconnection"
 	"clockzen-next/internal/ent/googledrivefolder"
 	"clockzen-next/internal/ent/googledrivesync"
+	"clockzen-next/internal/ent/householdmember"
+	"clockzen-next/internal/ent/jobqueue"
+	"clockzen-next/internal/ent/jobschedule"
+	"clockzen-next/internal/ent/jobschedulerun"
 	"clockzen-next/internal/ent/lineitem"
+	"clockzen-next/internal/ent/liquidaccount"
+	"clockzen-next/internal/ent/membership"
+	"clockzen-next/internal/ent/merchant"
+	"clockzen-next/internal/ent/migrationstate"
+	"clockzen-next/internal/ent/notification"
+	"clockzen-next/internal/ent/oauthstate"
+	"clockzen-next/internal/ent/ocrfeedback"
+	"clockzen-next/internal/ent/organization"
 	"clockzen-next/internal/ent/pipelineconfig"
 	"clockzen-next/internal/ent/pipelinerule"
 	"clockzen-next/internal/ent/pipelineversion"
+	"clockzen-next/internal/ent/queuedjob"
 	"clockzen-next/internal/ent/receipt"
+	"clockzen-next/internal/ent/receiptevent"
+	"clockzen-next/internal/ent/roundingrule"
+	"clockzen-next/internal/ent/savedfilter"
+	"clockzen-next/internal/ent/taxyear"
 	"clockzen-next/internal/ent/transaction"
+	"clockzen-next/internal/ent/user"
+	"clockzen-next/internal/ent/webhookdelivery"
+	"clockzen-next/internal/ent/webhookendpoint"
 	"context"
 	"errors"
 	"fmt"
@@ -84,18 +120,54 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			accountdeletion.Table:       accountdeletion.ValidColumn,
+			alert.Table:                 alert.ValidColumn,
+			alertpreference.Table:       alertpreference.ValidColumn,
+			attachmentblob.Table:        attachmentblob.ValidColumn,
+			attachmentlink.Table:        attachmentlink.ValidColumn,
+			budgetreallocation.Table:    budgetreallocation.ValidColumn,
+			bulkoperation.Table:         bulkoperation.ValidColumn,
+			cardaccount.Table:           cardaccount.ValidColumn,
+			categorizationrule.Table:    categorizationrule.ValidColumn,
+			categoryfeedback.Table:      categoryfeedback.ValidColumn,
+			debt.Table:                  debt.ValidColumn,
 			emailconnection.Table:       emailconnection.ValidColumn,
 			emaillabel.Table:            emaillabel.ValidColumn,
+			emailmessage.Table:          emailmessage.ValidColumn,
 			emailsync.Table:             emailsync.ValidColumn,
+			emailsyncfailure.Table:      emailsyncfailure.ValidColumn,
+			emergencyfundsnapshot.Table: emergencyfundsnapshot.ValidColumn,
+			emergencyfundtarget.Table:   emergencyfundtarget.ValidColumn,
+			goal.Table:                  goal.ValidColumn,
 			googledriveconnection.Table: googledriveconnection.ValidColumn,
 			googledrivefolder.Table:     googledrivefolder.ValidColumn,
 			googledrivesync.Table:       googledrivesync.ValidColumn,
+			householdmember.Table:       householdmember.ValidColumn,
+			jobqueue.Table:              jobqueue.ValidColumn,
+			jobschedule.Table:           jobschedule.ValidColumn,
+			jobschedulerun.Table:        jobschedulerun.ValidColumn,
 			lineitem.Table:              lineitem.ValidColumn,
+			liquidaccount.Table:         liquidaccount.ValidColumn,
+			membership.Table:            membership.ValidColumn,
+			merchant.Table:              merchant.ValidColumn,
+			migrationstate.Table:        migrationstate.ValidColumn,
+			notification.Table:          notification.ValidColumn,
+			oauthstate.Table:            oauthstate.ValidColumn,
+			ocrfeedback.Table:           ocrfeedback.ValidColumn,
+			organization.Table:          organization.ValidColumn,
 			pipelineconfig.Table:        pipelineconfig.ValidColumn,
 			pipelinerule.Table:          pipelinerule.ValidColumn,
 			pipelineversion.Table:       pipelineversion.ValidColumn,
+			queuedjob.Table:             queuedjob.ValidColumn,
 			receipt.Table:               receipt.ValidColumn,
+			receiptevent.Table:          receiptevent.ValidColumn,
+			roundingrule.Table:          roundingrule.ValidColumn,
+			savedfilter.Table:           savedfilter.ValidColumn,
+			taxyear.Table:               taxyear.ValidColumn,
 			transaction.Table:           transaction.ValidColumn,
+			user.Table:                  user.ValidColumn,
+			webhookdelivery.Table:       webhookdelivery.ValidColumn,
+			webhookendpoint.Table:       webhookendpoint.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/goal"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Goal is the model entity for the Goal schema.
+type Goal struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ID of the user who set the goal
+	UserID string `json:"user_id,omitempty"`
+	// Name of the goal, e.g. what it saves for
+	Name string `json:"name,omitempty"`
+	// Type holds the value of the "type" field.
+	Type goal.Type `json:"type,omitempty"`
+	// Amount to save, or of debt to pay off
+	TargetAmount float64 `json:"target_amount,omitempty"`
+	// Amount already saved when the goal was set; for debt payoff goals linked to a debt, its balance then
+	StartingAmount float64 `json:"starting_amount,omitempty"`
+	// Date the goal should be met by
+	Deadline *time.Time `json:"deadline,omitempty"`
+	// Category or tag of the transactions that count toward the goal
+	Category *string `json:"category,omitempty"`
+	// ID of the liquid account saved in, or the debt paid off, whose balance tracks the goal
+	AccountID *string `json:"account_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Goal) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case goal.FieldTargetAmount, goal.FieldStartingAmount:
+			values[i] = new(sql.NullFloat64)
+		case goal.FieldID, goal.FieldUserID, goal.FieldName, goal.FieldType, goal.FieldCategory, goal.FieldAccountID:
+			values[i] = new(sql.NullString)
+		case goal.FieldDeadline, goal.FieldCreatedAt, goal.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Goal fields.
+func (_m *Goal) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case goal.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case goal.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case goal.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case goal.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = goal.Type(value.String)
+			}
+		case goal.FieldTargetAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_amount", values[i])
+			} else if value.Valid {
+				_m.TargetAmount = value.Float64
+			}
+		case goal.FieldStartingAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field starting_amount", values[i])
+			} else if value.Valid {
+				_m.StartingAmount = value.Float64
+			}
+		case goal.FieldDeadline:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deadline", values[i])
+			} else if value.Valid {
+				_m.Deadline = new(time.Time)
+				*_m.Deadline = value.Time
+			}
+		case goal.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = new(string)
+				*_m.Category = value.String
+			}
+		case goal.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = new(string)
+				*_m.AccountID = value.String
+			}
+		case goal.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case goal.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Goal.
+// This includes values selected through modifiers, order, etc.
+func (_m *Goal) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Goal.
+// Note that you need to call Goal.Unwrap() before calling this method if this Goal
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Goal) Update() *GoalUpdateOne {
+	return NewGoalClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Goal entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Goal) Unwrap() *Goal {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Goal is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Goal) String() string {
+	var builder strings.Builder
+	builder.WriteString("Goal(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Type))
+	builder.WriteString(", ")
+	builder.WriteString("target_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetAmount))
+	builder.WriteString(", ")
+	builder.WriteString("starting_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartingAmount))
+	builder.WriteString(", ")
+	if v := _m.Deadline; v != nil {
+		builder.WriteString("deadline=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Category; v != nil {
+		builder.WriteString("category=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.AccountID; v != nil {
+		builder.WriteString("account_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Goals is a parsable slice of Goal.
+type Goals []*Goal
@@ -0,0 +1,985 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/goal"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GoalCreate is the builder for creating a Goal entity.
+type GoalCreate struct {
+	config
+	mutation *GoalMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *GoalCreate) SetUserID(v string) *GoalCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *GoalCreate) SetName(v string) *GoalCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *GoalCreate) SetType(v goal.Type) *GoalCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableType(v *goal.Type) *GoalCreate {
+	if v != nil {
+		_c.SetType(*v)
+	}
+	return _c
+}
+
+// SetTargetAmount sets the "target_amount" field.
+func (_c *GoalCreate) SetTargetAmount(v float64) *GoalCreate {
+	_c.mutation.SetTargetAmount(v)
+	return _c
+}
+
+// SetStartingAmount sets the "starting_amount" field.
+func (_c *GoalCreate) SetStartingAmount(v float64) *GoalCreate {
+	_c.mutation.SetStartingAmount(v)
+	return _c
+}
+
+// SetNillableStartingAmount sets the "starting_amount" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableStartingAmount(v *float64) *GoalCreate {
+	if v != nil {
+		_c.SetStartingAmount(*v)
+	}
+	return _c
+}
+
+// SetDeadline sets the "deadline" field.
+func (_c *GoalCreate) SetDeadline(v time.Time) *GoalCreate {
+	_c.mutation.SetDeadline(v)
+	return _c
+}
+
+// SetNillableDeadline sets the "deadline" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableDeadline(v *time.Time) *GoalCreate {
+	if v != nil {
+		_c.SetDeadline(*v)
+	}
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *GoalCreate) SetCategory(v string) *GoalCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableCategory(v *string) *GoalCreate {
+	if v != nil {
+		_c.SetCategory(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *GoalCreate) SetAccountID(v string) *GoalCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableAccountID(v *string) *GoalCreate {
+	if v != nil {
+		_c.SetAccountID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GoalCreate) SetCreatedAt(v time.Time) *GoalCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableCreatedAt(v *time.Time) *GoalCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *GoalCreate) SetUpdatedAt(v time.Time) *GoalCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableUpdatedAt(v *time.Time) *GoalCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GoalCreate) SetID(v string) *GoalCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the GoalMutation object of the builder.
+func (_c *GoalCreate) Mutation() *GoalMutation {
+	return _c.mutation
+}
+
+// Save creates the Goal in the database.
+func (_c *GoalCreate) Save(ctx context.Context) (*Goal, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GoalCreate) SaveX(ctx context.Context) *Goal {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoalCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoalCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GoalCreate) defaults() {
+	if _, ok := _c.mutation.GetType(); !ok {
+		v := goal.DefaultType
+		_c.mutation.SetType(v)
+	}
+	if _, ok := _c.mutation.StartingAmount(); !ok {
+		v := goal.DefaultStartingAmount
+		_c.mutation.SetStartingAmount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := goal.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := goal.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GoalCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Goal.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := goal.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Goal.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Goal.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := goal.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Goal.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Goal.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := goal.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Goal.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TargetAmount(); !ok {
+		return &ValidationError{Name: "target_amount", err: errors.New(`ent: missing required field "Goal.target_amount"`)}
+	}
+	if v, ok := _c.mutation.TargetAmount(); ok {
+		if err := goal.TargetAmountValidator(v); err != nil {
+			return &ValidationError{Name: "target_amount", err: fmt.Errorf(`ent: validator failed for field "Goal.target_amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartingAmount(); !ok {
+		return &ValidationError{Name: "starting_amount", err: errors.New(`ent: missing required field "Goal.starting_amount"`)}
+	}
+	if v, ok := _c.mutation.StartingAmount(); ok {
+		if err := goal.StartingAmountValidator(v); err != nil {
+			return &ValidationError{Name: "starting_amount", err: fmt.Errorf(`ent: validator failed for field "Goal.starting_amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Goal.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Goal.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *GoalCreate) sqlSave(ctx context.Context) (*Goal, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Goal.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GoalCreate) createSpec() (*Goal, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Goal{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(goal.Table, sqlgraph.NewFieldSpec(goal.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(goal.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(goal.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(goal.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.TargetAmount(); ok {
+		_spec.SetField(goal.FieldTargetAmount, field.TypeFloat64, value)
+		_node.TargetAmount = value
+	}
+	if value, ok := _c.mutation.StartingAmount(); ok {
+		_spec.SetField(goal.FieldStartingAmount, field.TypeFloat64, value)
+		_node.StartingAmount = value
+	}
+	if value, ok := _c.mutation.Deadline(); ok {
+		_spec.SetField(goal.FieldDeadline, field.TypeTime, value)
+		_node.Deadline = &value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(goal.FieldCategory, field.TypeString, value)
+		_node.Category = &value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(goal.FieldAccountID, field.TypeString, value)
+		_node.AccountID = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(goal.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(goal.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Goal.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoalUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoalCreate) OnConflict(opts ...sql.ConflictOption) *GoalUpsertOne {
+	_c.conflict = opts
+	return &GoalUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoalCreate) OnConflictColumns(columns ...string) *GoalUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoalUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GoalUpsertOne is the builder for "upsert"-ing
+	//  one Goal node.
+	GoalUpsertOne struct {
+		create *GoalCreate
+	}
+
+	// GoalUpsert is the "OnConflict" setter.
+	GoalUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *GoalUpsert) SetUserID(v string) *GoalUpsert {
+	u.Set(goal.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateUserID() *GoalUpsert {
+	u.SetExcluded(goal.FieldUserID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *GoalUpsert) SetName(v string) *GoalUpsert {
+	u.Set(goal.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateName() *GoalUpsert {
+	u.SetExcluded(goal.FieldName)
+	return u
+}
+
+// SetTargetAmount sets the "target_amount" field.
+func (u *GoalUpsert) SetTargetAmount(v float64) *GoalUpsert {
+	u.Set(goal.FieldTargetAmount, v)
+	return u
+}
+
+// UpdateTargetAmount sets the "target_amount" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateTargetAmount() *GoalUpsert {
+	u.SetExcluded(goal.FieldTargetAmount)
+	return u
+}
+
+// AddTargetAmount adds v to the "target_amount" field.
+func (u *GoalUpsert) AddTargetAmount(v float64) *GoalUpsert {
+	u.Add(goal.FieldTargetAmount, v)
+	return u
+}
+
+// SetStartingAmount sets the "starting_amount" field.
+func (u *GoalUpsert) SetStartingAmount(v float64) *GoalUpsert {
+	u.Set(goal.FieldStartingAmount, v)
+	return u
+}
+
+// UpdateStartingAmount sets the "starting_amount" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateStartingAmount() *GoalUpsert {
+	u.SetExcluded(goal.FieldStartingAmount)
+	return u
+}
+
+// AddStartingAmount adds v to the "starting_amount" field.
+func (u *GoalUpsert) AddStartingAmount(v float64) *GoalUpsert {
+	u.Add(goal.FieldStartingAmount, v)
+	return u
+}
+
+// SetDeadline sets the "deadline" field.
+func (u *GoalUpsert) SetDeadline(v time.Time) *GoalUpsert {
+	u.Set(goal.FieldDeadline, v)
+	return u
+}
+
+// UpdateDeadline sets the "deadline" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateDeadline() *GoalUpsert {
+	u.SetExcluded(goal.FieldDeadline)
+	return u
+}
+
+// ClearDeadline clears the value of the "deadline" field.
+func (u *GoalUpsert) ClearDeadline() *GoalUpsert {
+	u.SetNull(goal.FieldDeadline)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoalUpsert) SetUpdatedAt(v time.Time) *GoalUpsert {
+	u.Set(goal.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateUpdatedAt() *GoalUpsert {
+	u.SetExcluded(goal.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(goal.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoalUpsertOne) UpdateNewValues() *GoalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(goal.FieldID)
+		}
+		if _, exists := u.create.mutation.GetType(); exists {
+			s.SetIgnore(goal.FieldType)
+		}
+		if _, exists := u.create.mutation.Category(); exists {
+			s.SetIgnore(goal.FieldCategory)
+		}
+		if _, exists := u.create.mutation.AccountID(); exists {
+			s.SetIgnore(goal.FieldAccountID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(goal.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GoalUpsertOne) Ignore() *GoalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoalUpsertOne) DoNothing() *GoalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoalCreate.OnConflict
+// documentation for more info.
+func (u *GoalUpsertOne) Update(set func(*GoalUpsert)) *GoalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoalUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *GoalUpsertOne) SetUserID(v string) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateUserID() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *GoalUpsertOne) SetName(v string) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateName() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTargetAmount sets the "target_amount" field.
+func (u *GoalUpsertOne) SetTargetAmount(v float64) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetTargetAmount(v)
+	})
+}
+
+// AddTargetAmount adds v to the "target_amount" field.
+func (u *GoalUpsertOne) AddTargetAmount(v float64) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.AddTargetAmount(v)
+	})
+}
+
+// UpdateTargetAmount sets the "target_amount" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateTargetAmount() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateTargetAmount()
+	})
+}
+
+// SetStartingAmount sets the "starting_amount" field.
+func (u *GoalUpsertOne) SetStartingAmount(v float64) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetStartingAmount(v)
+	})
+}
+
+// AddStartingAmount adds v to the "starting_amount" field.
+func (u *GoalUpsertOne) AddStartingAmount(v float64) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.AddStartingAmount(v)
+	})
+}
+
+// UpdateStartingAmount sets the "starting_amount" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateStartingAmount() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateStartingAmount()
+	})
+}
+
+// SetDeadline sets the "deadline" field.
+func (u *GoalUpsertOne) SetDeadline(v time.Time) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetDeadline(v)
+	})
+}
+
+// UpdateDeadline sets the "deadline" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateDeadline() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateDeadline()
+	})
+}
+
+// ClearDeadline clears the value of the "deadline" field.
+func (u *GoalUpsertOne) ClearDeadline() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearDeadline()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoalUpsertOne) SetUpdatedAt(v time.Time) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateUpdatedAt() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GoalUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoalCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoalUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GoalUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: GoalUpsertOne.ID is not supported by MySQL driver. Use GoalUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GoalUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GoalCreateBulk is the builder for creating many Goal entities in bulk.
+type GoalCreateBulk struct {
+	config
+	err      error
+	builders []*GoalCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Goal entities in the database.
+func (_c *GoalCreateBulk) Save(ctx context.Context) ([]*Goal, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Goal, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GoalMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GoalCreateBulk) SaveX(ctx context.Context) []*Goal {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoalCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoalCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Goal.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoalUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoalCreateBulk) OnConflict(opts ...sql.ConflictOption) *GoalUpsertBulk {
+	_c.conflict = opts
+	return &GoalUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoalCreateBulk) OnConflictColumns(columns ...string) *GoalUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoalUpsertBulk{
+		create: _c,
+	}
+}
+
+// GoalUpsertBulk is the builder for "upsert"-ing
+// a bulk of Goal nodes.
+type GoalUpsertBulk struct {
+	create *GoalCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(goal.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoalUpsertBulk) UpdateNewValues() *GoalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(goal.FieldID)
+			}
+			if _, exists := b.mutation.GetType(); exists {
+				s.SetIgnore(goal.FieldType)
+			}
+			if _, exists := b.mutation.Category(); exists {
+				s.SetIgnore(goal.FieldCategory)
+			}
+			if _, exists := b.mutation.AccountID(); exists {
+				s.SetIgnore(goal.FieldAccountID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(goal.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GoalUpsertBulk) Ignore() *GoalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoalUpsertBulk) DoNothing() *GoalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoalCreateBulk.OnConflict
+// documentation for more info.
+func (u *GoalUpsertBulk) Update(set func(*GoalUpsert)) *GoalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoalUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *GoalUpsertBulk) SetUserID(v string) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateUserID() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *GoalUpsertBulk) SetName(v string) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateName() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTargetAmount sets the "target_amount" field.
+func (u *GoalUpsertBulk) SetTargetAmount(v float64) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetTargetAmount(v)
+	})
+}
+
+// AddTargetAmount adds v to the "target_amount" field.
+func (u *GoalUpsertBulk) AddTargetAmount(v float64) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.AddTargetAmount(v)
+	})
+}
+
+// UpdateTargetAmount sets the "target_amount" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateTargetAmount() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateTargetAmount()
+	})
+}
+
+// SetStartingAmount sets the "starting_amount" field.
+func (u *GoalUpsertBulk) SetStartingAmount(v float64) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetStartingAmount(v)
+	})
+}
+
+// AddStartingAmount adds v to the "starting_amount" field.
+func (u *GoalUpsertBulk) AddStartingAmount(v float64) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.AddStartingAmount(v)
+	})
+}
+
+// UpdateStartingAmount sets the "starting_amount" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateStartingAmount() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateStartingAmount()
+	})
+}
+
+// SetDeadline sets the "deadline" field.
+func (u *GoalUpsertBulk) SetDeadline(v time.Time) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetDeadline(v)
+	})
+}
+
+// UpdateDeadline sets the "deadline" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateDeadline() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateDeadline()
+	})
+}
+
+// ClearDeadline clears the value of the "deadline" field.
+func (u *GoalUpsertBulk) ClearDeadline() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearDeadline()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoalUpsertBulk) SetUpdatedAt(v time.Time) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateUpdatedAt() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GoalUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GoalCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoalCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoalUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
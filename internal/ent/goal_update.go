@@ -0,0 +1,508 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/goal"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GoalUpdate is the builder for updating Goal entities.
+type GoalUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GoalMutation
+}
+
+// Where appends a list predicates to the GoalUpdate builder.
+func (_u *GoalUpdate) Where(ps ...predicate.Goal) *GoalUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *GoalUpdate) SetUserID(v string) *GoalUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillableUserID(v *string) *GoalUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *GoalUpdate) SetName(v string) *GoalUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillableName(v *string) *GoalUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTargetAmount sets the "target_amount" field.
+func (_u *GoalUpdate) SetTargetAmount(v float64) *GoalUpdate {
+	_u.mutation.ResetTargetAmount()
+	_u.mutation.SetTargetAmount(v)
+	return _u
+}
+
+// SetNillableTargetAmount sets the "target_amount" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillableTargetAmount(v *float64) *GoalUpdate {
+	if v != nil {
+		_u.SetTargetAmount(*v)
+	}
+	return _u
+}
+
+// AddTargetAmount adds value to the "target_amount" field.
+func (_u *GoalUpdate) AddTargetAmount(v float64) *GoalUpdate {
+	_u.mutation.AddTargetAmount(v)
+	return _u
+}
+
+// SetStartingAmount sets the "starting_amount" field.
+func (_u *GoalUpdate) SetStartingAmount(v float64) *GoalUpdate {
+	_u.mutation.ResetStartingAmount()
+	_u.mutation.SetStartingAmount(v)
+	return _u
+}
+
+// SetNillableStartingAmount sets the "starting_amount" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillableStartingAmount(v *float64) *GoalUpdate {
+	if v != nil {
+		_u.SetStartingAmount(*v)
+	}
+	return _u
+}
+
+// AddStartingAmount adds value to the "starting_amount" field.
+func (_u *GoalUpdate) AddStartingAmount(v float64) *GoalUpdate {
+	_u.mutation.AddStartingAmount(v)
+	return _u
+}
+
+// SetDeadline sets the "deadline" field.
+func (_u *GoalUpdate) SetDeadline(v time.Time) *GoalUpdate {
+	_u.mutation.SetDeadline(v)
+	return _u
+}
+
+// SetNillableDeadline sets the "deadline" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillableDeadline(v *time.Time) *GoalUpdate {
+	if v != nil {
+		_u.SetDeadline(*v)
+	}
+	return _u
+}
+
+// ClearDeadline clears the value of the "deadline" field.
+func (_u *GoalUpdate) ClearDeadline() *GoalUpdate {
+	_u.mutation.ClearDeadline()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GoalUpdate) SetUpdatedAt(v time.Time) *GoalUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the GoalMutation object of the builder.
+func (_u *GoalUpdate) Mutation() *GoalMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GoalUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoalUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GoalUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoalUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GoalUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := goal.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoalUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := goal.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Goal.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := goal.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Goal.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetAmount(); ok {
+		if err := goal.TargetAmountValidator(v); err != nil {
+			return &ValidationError{Name: "target_amount", err: fmt.Errorf(`ent: validator failed for field "Goal.target_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StartingAmount(); ok {
+		if err := goal.StartingAmountValidator(v); err != nil {
+			return &ValidationError{Name: "starting_amount", err: fmt.Errorf(`ent: validator failed for field "Goal.starting_amount": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GoalUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goal.Table, goal.Columns, sqlgraph.NewFieldSpec(goal.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(goal.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(goal.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetAmount(); ok {
+		_spec.SetField(goal.FieldTargetAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTargetAmount(); ok {
+		_spec.AddField(goal.FieldTargetAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.StartingAmount(); ok {
+		_spec.SetField(goal.FieldStartingAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedStartingAmount(); ok {
+		_spec.AddField(goal.FieldStartingAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Deadline(); ok {
+		_spec.SetField(goal.FieldDeadline, field.TypeTime, value)
+	}
+	if _u.mutation.DeadlineCleared() {
+		_spec.ClearField(goal.FieldDeadline, field.TypeTime)
+	}
+	if _u.mutation.CategoryCleared() {
+		_spec.ClearField(goal.FieldCategory, field.TypeString)
+	}
+	if _u.mutation.AccountIDCleared() {
+		_spec.ClearField(goal.FieldAccountID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(goal.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GoalUpdateOne is the builder for updating a single Goal entity.
+type GoalUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GoalMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *GoalUpdateOne) SetUserID(v string) *GoalUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableUserID(v *string) *GoalUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *GoalUpdateOne) SetName(v string) *GoalUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableName(v *string) *GoalUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTargetAmount sets the "target_amount" field.
+func (_u *GoalUpdateOne) SetTargetAmount(v float64) *GoalUpdateOne {
+	_u.mutation.ResetTargetAmount()
+	_u.mutation.SetTargetAmount(v)
+	return _u
+}
+
+// SetNillableTargetAmount sets the "target_amount" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableTargetAmount(v *float64) *GoalUpdateOne {
+	if v != nil {
+		_u.SetTargetAmount(*v)
+	}
+	return _u
+}
+
+// AddTargetAmount adds value to the "target_amount" field.
+func (_u *GoalUpdateOne) AddTargetAmount(v float64) *GoalUpdateOne {
+	_u.mutation.AddTargetAmount(v)
+	return _u
+}
+
+// SetStartingAmount sets the "starting_amount" field.
+func (_u *GoalUpdateOne) SetStartingAmount(v float64) *GoalUpdateOne {
+	_u.mutation.ResetStartingAmount()
+	_u.mutation.SetStartingAmount(v)
+	return _u
+}
+
+// SetNillableStartingAmount sets the "starting_amount" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableStartingAmount(v *float64) *GoalUpdateOne {
+	if v != nil {
+		_u.SetStartingAmount(*v)
+	}
+	return _u
+}
+
+// AddStartingAmount adds value to the "starting_amount" field.
+func (_u *GoalUpdateOne) AddStartingAmount(v float64) *GoalUpdateOne {
+	_u.mutation.AddStartingAmount(v)
+	return _u
+}
+
+// SetDeadline sets the "deadline" field.
+func (_u *GoalUpdateOne) SetDeadline(v time.Time) *GoalUpdateOne {
+	_u.mutation.SetDeadline(v)
+	return _u
+}
+
+// SetNillableDeadline sets the "deadline" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableDeadline(v *time.Time) *GoalUpdateOne {
+	if v != nil {
+		_u.SetDeadline(*v)
+	}
+	return _u
+}
+
+// ClearDeadline clears the value of the "deadline" field.
+func (_u *GoalUpdateOne) ClearDeadline() *GoalUpdateOne {
+	_u.mutation.ClearDeadline()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GoalUpdateOne) SetUpdatedAt(v time.Time) *GoalUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the GoalMutation object of the builder.
+func (_u *GoalUpdateOne) Mutation() *GoalMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the GoalUpdate builder.
+func (_u *GoalUpdateOne) Where(ps ...predicate.Goal) *GoalUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GoalUpdateOne) Select(field string, fields ...string) *GoalUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Goal entity.
+func (_u *GoalUpdateOne) Save(ctx context.Context) (*Goal, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoalUpdateOne) SaveX(ctx context.Context) *Goal {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GoalUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoalUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GoalUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := goal.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoalUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := goal.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Goal.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := goal.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Goal.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetAmount(); ok {
+		if err := goal.TargetAmountValidator(v); err != nil {
+			return &ValidationError{Name: "target_amount", err: fmt.Errorf(`ent: validator failed for field "Goal.target_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StartingAmount(); ok {
+		if err := goal.StartingAmountValidator(v); err != nil {
+			return &ValidationError{Name: "starting_amount", err: fmt.Errorf(`ent: validator failed for field "Goal.starting_amount": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GoalUpdateOne) sqlSave(ctx context.Context) (_node *Goal, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goal.Table, goal.Columns, sqlgraph.NewFieldSpec(goal.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Goal.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, goal.FieldID)
+		for _, f := range fields {
+			if !goal.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != goal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(goal.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(goal.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetAmount(); ok {
+		_spec.SetField(goal.FieldTargetAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTargetAmount(); ok {
+		_spec.AddField(goal.FieldTargetAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.StartingAmount(); ok {
+		_spec.SetField(goal.FieldStartingAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedStartingAmount(); ok {
+		_spec.AddField(goal.FieldStartingAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Deadline(); ok {
+		_spec.SetField(goal.FieldDeadline, field.TypeTime, value)
+	}
+	if _u.mutation.DeadlineCleared() {
+		_spec.ClearField(goal.FieldDeadline, field.TypeTime)
+	}
+	if _u.mutation.CategoryCleared() {
+		_spec.ClearField(goal.FieldCategory, field.TypeString)
+	}
+	if _u.mutation.AccountIDCleared() {
+		_spec.ClearField(goal.FieldAccountID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(goal.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Goal{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
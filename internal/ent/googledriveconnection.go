@@ -4,6 +4,7 @@ package ent
 
 import (
 	"clockzen-next/internal/ent/googledriveconnection"
+	"clockzen-next/internal/ent/user"
 	"fmt"
 	"strings"
 	"time"
@@ -17,8 +18,10 @@ type GoogleDriveConnection struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
-	// ID of the user who owns this connection
+	// ID of the user who connected the account
 	UserID string `json:"user_id,omitempty"`
+	// ID of the organization the connection is shared with; unset for the user's own connections
+	OrganizationID *string `json:"organization_id,omitempty"`
 	// Google account identifier
 	GoogleAccountID string `json:"google_account_id,omitempty"`
 	// Google account email address
@@ -31,12 +34,18 @@ type GoogleDriveConnection struct {
 	TokenExpiry time.Time `json:"token_expiry,omitempty"`
 	// Connection status
 	Status googledriveconnection.Status `json:"status,omitempty"`
+	// ID of the legacy migration run that created the connection, so the run can be rolled back
+	MigrationRunID *string `json:"migration_run_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Last successful sync timestamp
 	LastSyncAt *time.Time `json:"last_sync_at,omitempty"`
+	// How often the worker syncs the connection; manual only syncs on request
+	SyncSchedule googledriveconnection.SyncSchedule `json:"sync_schedule,omitempty"`
+	// When the worker next syncs the connection on its schedule
+	NextSyncAt *time.Time `json:"next_sync_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the GoogleDriveConnectionQuery when eager-loading is set.
 	Edges        GoogleDriveConnectionEdges `json:"edges"`
@@ -45,19 +54,32 @@ type GoogleDriveConnection struct {
 
 // GoogleDriveConnectionEdges holds the relations/edges for other nodes in the graph.
 type GoogleDriveConnectionEdges struct {
+	// The user who connected the account
+	User *User `json:"user,omitempty"`
 	// Folders being tracked by this connection
 	Folders []*GoogleDriveFolder `json:"folders,omitempty"`
 	// Sync history for this connection
 	Syncs []*GoogleDriveSync `json:"syncs,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GoogleDriveConnectionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
 }
 
 // FoldersOrErr returns the Folders value or an error if the edge
 // was not loaded in eager-loading.
 func (e GoogleDriveConnectionEdges) FoldersOrErr() ([]*GoogleDriveFolder, error) {
-	if e.loadedTypes[0] {
+	if e.loadedTypes[1] {
 		return e.Folders, nil
 	}
 	return nil, &NotLoadedError{edge: "folders"}
@@ -66,7 +88,7 @@ func (e GoogleDriveConnectionEdges) FoldersOrErr() ([]*GoogleDriveFolder, error)
 // SyncsOrErr returns the Syncs value or an error if the edge
 // was not loaded in eager-loading.
 func (e GoogleDriveConnectionEdges) SyncsOrErr() ([]*GoogleDriveSync, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.Syncs, nil
 	}
 	return nil, &NotLoadedError{edge: "syncs"}
@@ -77,9 +99,9 @@ func (*GoogleDriveConnection) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case googledriveconnection.FieldID, googledriveconnection.FieldUserID, googledriveconnection.FieldGoogleAccountID, googledriveconnection.FieldEmail, googledriveconnection.FieldAccessToken, googledriveconnection.FieldRefreshToken, googledriveconnection.FieldStatus:
+		case googledriveconnection.FieldID, googledriveconnection.FieldUserID, googledriveconnection.FieldOrganizationID, googledriveconnection.FieldGoogleAccountID, googledriveconnection.FieldEmail, googledriveconnection.FieldAccessToken, googledriveconnection.FieldRefreshToken, googledriveconnection.FieldStatus, googledriveconnection.FieldMigrationRunID, googledriveconnection.FieldSyncSchedule:
 			values[i] = new(sql.NullString)
-		case googledriveconnection.FieldTokenExpiry, googledriveconnection.FieldCreatedAt, googledriveconnection.FieldUpdatedAt, googledriveconnection.FieldLastSyncAt:
+		case googledriveconnection.FieldTokenExpiry, googledriveconnection.FieldCreatedAt, googledriveconnection.FieldUpdatedAt, googledriveconnection.FieldLastSyncAt, googledriveconnection.FieldNextSyncAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -108,6 +130,13 @@ func (_m *GoogleDriveConnection) assignValues(columns []string, values []any) er
 			} else if value.Valid {
 				_m.UserID = value.String
 			}
+		case googledriveconnection.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				_m.OrganizationID = new(string)
+				*_m.OrganizationID = value.String
+			}
 		case googledriveconnection.FieldGoogleAccountID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field google_account_id", values[i])
@@ -144,6 +173,13 @@ func (_m *GoogleDriveConnection) assignValues(columns []string, values []any) er
 			} else if value.Valid {
 				_m.Status = googledriveconnection.Status(value.String)
 			}
+		case googledriveconnection.FieldMigrationRunID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field migration_run_id", values[i])
+			} else if value.Valid {
+				_m.MigrationRunID = new(string)
+				*_m.MigrationRunID = value.String
+			}
 		case googledriveconnection.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -163,6 +199,19 @@ func (_m *GoogleDriveConnection) assignValues(columns []string, values []any) er
 				_m.LastSyncAt = new(time.Time)
 				*_m.LastSyncAt = value.Time
 			}
+		case googledriveconnection.FieldSyncSchedule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sync_schedule", values[i])
+			} else if value.Valid {
+				_m.SyncSchedule = googledriveconnection.SyncSchedule(value.String)
+			}
+		case googledriveconnection.FieldNextSyncAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_sync_at", values[i])
+			} else if value.Valid {
+				_m.NextSyncAt = new(time.Time)
+				*_m.NextSyncAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -176,6 +225,11 @@ func (_m *GoogleDriveConnection) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryUser queries the "user" edge of the GoogleDriveConnection entity.
+func (_m *GoogleDriveConnection) QueryUser() *UserQuery {
+	return NewGoogleDriveConnectionClient(_m.config).QueryUser(_m)
+}
+
 // QueryFolders queries the "folders" edge of the GoogleDriveConnection entity.
 func (_m *GoogleDriveConnection) QueryFolders() *GoogleDriveFolderQuery {
 	return NewGoogleDriveConnectionClient(_m.config).QueryFolders(_m)
@@ -212,6 +266,11 @@ func (_m *GoogleDriveConnection) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(_m.UserID)
 	builder.WriteString(", ")
+	if v := _m.OrganizationID; v != nil {
+		builder.WriteString("organization_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("google_account_id=")
 	builder.WriteString(_m.GoogleAccountID)
 	builder.WriteString(", ")
@@ -228,6 +287,11 @@ func (_m *GoogleDriveConnection) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
+	if v := _m.MigrationRunID; v != nil {
+		builder.WriteString("migration_run_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -238,6 +302,14 @@ func (_m *GoogleDriveConnection) String() string {
 		builder.WriteString("last_sync_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("sync_schedule=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SyncSchedule))
+	builder.WriteString(", ")
+	if v := _m.NextSyncAt; v != nil {
+		builder.WriteString("next_sync_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -17,6 +17,8 @@ const (
 	FieldID = "id"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
+	// FieldOrganizationID holds the string denoting the organization_id field in the database.
+	FieldOrganizationID = "organization_id"
 	// FieldGoogleAccountID holds the string denoting the google_account_id field in the database.
 	FieldGoogleAccountID = "google_account_id"
 	// FieldEmail holds the string denoting the email field in the database.
@@ -29,18 +31,33 @@ const (
 	FieldTokenExpiry = "token_expiry"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldMigrationRunID holds the string denoting the migration_run_id field in the database.
+	FieldMigrationRunID = "migration_run_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// FieldLastSyncAt holds the string denoting the last_sync_at field in the database.
 	FieldLastSyncAt = "last_sync_at"
+	// FieldSyncSchedule holds the string denoting the sync_schedule field in the database.
+	FieldSyncSchedule = "sync_schedule"
+	// FieldNextSyncAt holds the string denoting the next_sync_at field in the database.
+	FieldNextSyncAt = "next_sync_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
 	// EdgeFolders holds the string denoting the folders edge name in mutations.
 	EdgeFolders = "folders"
 	// EdgeSyncs holds the string denoting the syncs edge name in mutations.
 	EdgeSyncs = "syncs"
 	// Table holds the table name of the googledriveconnection in the database.
 	Table = "google_drive_connections"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "google_drive_connections"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
 	// FoldersTable is the table that holds the folders relation/edge.
 	FoldersTable = "google_drive_folders"
 	// FoldersInverseTable is the table name for the GoogleDriveFolder entity.
@@ -61,15 +78,19 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldUserID,
+	FieldOrganizationID,
 	FieldGoogleAccountID,
 	FieldEmail,
 	FieldAccessToken,
 	FieldRefreshToken,
 	FieldTokenExpiry,
 	FieldStatus,
+	FieldMigrationRunID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldLastSyncAt,
+	FieldSyncSchedule,
+	FieldNextSyncAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -125,6 +146,34 @@ func StatusValidator(s Status) error {
 	}
 }
 
+// SyncSchedule defines the type for the "sync_schedule" enum field.
+type SyncSchedule string
+
+// SyncScheduleManual is the default value of the SyncSchedule enum.
+const DefaultSyncSchedule = SyncScheduleManual
+
+// SyncSchedule values.
+const (
+	SyncScheduleEvery15Minutes SyncSchedule = "every_15_minutes"
+	SyncScheduleHourly         SyncSchedule = "hourly"
+	SyncScheduleDaily          SyncSchedule = "daily"
+	SyncScheduleManual         SyncSchedule = "manual"
+)
+
+func (ss SyncSchedule) String() string {
+	return string(ss)
+}
+
+// SyncScheduleValidator is a validator for the "sync_schedule" field enum values. It is called by the builders before save.
+func SyncScheduleValidator(ss SyncSchedule) error {
+	switch ss {
+	case SyncScheduleEvery15Minutes, SyncScheduleHourly, SyncScheduleDaily, SyncScheduleManual:
+		return nil
+	default:
+		return fmt.Errorf("googledriveconnection: invalid enum value for sync_schedule field: %q", ss)
+	}
+}
+
 // OrderOption defines the ordering options for the GoogleDriveConnection queries.
 type OrderOption func(*sql.Selector)
 
@@ -138,6 +187,11 @@ func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
 }
 
+// ByOrganizationID orders the results by the organization_id field.
+func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
+}
+
 // ByGoogleAccountID orders the results by the google_account_id field.
 func ByGoogleAccountID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldGoogleAccountID, opts...).ToFunc()
@@ -168,6 +222,11 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByMigrationRunID orders the results by the migration_run_id field.
+func ByMigrationRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMigrationRunID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -183,6 +242,23 @@ func ByLastSyncAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastSyncAt, opts...).ToFunc()
 }
 
+// BySyncSchedule orders the results by the sync_schedule field.
+func BySyncSchedule(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSyncSchedule, opts...).ToFunc()
+}
+
+// ByNextSyncAt orders the results by the next_sync_at field.
+func ByNextSyncAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextSyncAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByFoldersCount orders the results by folders count.
 func ByFoldersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -210,6 +286,13 @@ func BySyncs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newSyncsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
 func newFoldersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
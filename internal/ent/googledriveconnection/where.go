@@ -70,6 +70,11 @@ func UserID(v string) predicate.GoogleDriveConnection {
 	return predicate.GoogleDriveConnection(sql.FieldEQ(FieldUserID, v))
 }
 
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldEQ(FieldOrganizationID, v))
+}
+
 // GoogleAccountID applies equality check predicate on the "google_account_id" field. It's identical to GoogleAccountIDEQ.
 func GoogleAccountID(v string) predicate.GoogleDriveConnection {
 	return predicate.GoogleDriveConnection(sql.FieldEQ(FieldGoogleAccountID, v))
@@ -95,6 +100,11 @@ func TokenExpiry(v time.Time) predicate.GoogleDriveConnection {
 	return predicate.GoogleDriveConnection(sql.FieldEQ(FieldTokenExpiry, v))
 }
 
+// MigrationRunID applies equality check predicate on the "migration_run_id" field. It's identical to MigrationRunIDEQ.
+func MigrationRunID(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldEQ(FieldMigrationRunID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.GoogleDriveConnection {
 	return predicate.GoogleDriveConnection(sql.FieldEQ(FieldCreatedAt, v))
@@ -110,6 +120,11 @@ func LastSyncAt(v time.Time) predicate.GoogleDriveConnection {
 	return predicate.GoogleDriveConnection(sql.FieldEQ(FieldLastSyncAt, v))
 }
 
+// NextSyncAt applies equality check predicate on the "next_sync_at" field. It's identical to NextSyncAtEQ.
+func NextSyncAt(v time.Time) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldEQ(FieldNextSyncAt, v))
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v string) predicate.GoogleDriveConnection {
 	return predicate.GoogleDriveConnection(sql.FieldEQ(FieldUserID, v))
@@ -175,6 +190,81 @@ func UserIDContainsFold(v string) predicate.GoogleDriveConnection {
 	return predicate.GoogleDriveConnection(sql.FieldContainsFold(FieldUserID, v))
 }
 
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDGT applies the GT predicate on the "organization_id" field.
+func OrganizationIDGT(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldGT(FieldOrganizationID, v))
+}
+
+// OrganizationIDGTE applies the GTE predicate on the "organization_id" field.
+func OrganizationIDGTE(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldGTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDLT applies the LT predicate on the "organization_id" field.
+func OrganizationIDLT(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldLT(FieldOrganizationID, v))
+}
+
+// OrganizationIDLTE applies the LTE predicate on the "organization_id" field.
+func OrganizationIDLTE(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldLTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDContains applies the Contains predicate on the "organization_id" field.
+func OrganizationIDContains(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldContains(FieldOrganizationID, v))
+}
+
+// OrganizationIDHasPrefix applies the HasPrefix predicate on the "organization_id" field.
+func OrganizationIDHasPrefix(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldHasPrefix(FieldOrganizationID, v))
+}
+
+// OrganizationIDHasSuffix applies the HasSuffix predicate on the "organization_id" field.
+func OrganizationIDHasSuffix(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldHasSuffix(FieldOrganizationID, v))
+}
+
+// OrganizationIDIsNil applies the IsNil predicate on the "organization_id" field.
+func OrganizationIDIsNil() predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldIsNull(FieldOrganizationID))
+}
+
+// OrganizationIDNotNil applies the NotNil predicate on the "organization_id" field.
+func OrganizationIDNotNil() predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldNotNull(FieldOrganizationID))
+}
+
+// OrganizationIDEqualFold applies the EqualFold predicate on the "organization_id" field.
+func OrganizationIDEqualFold(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldEqualFold(FieldOrganizationID, v))
+}
+
+// OrganizationIDContainsFold applies the ContainsFold predicate on the "organization_id" field.
+func OrganizationIDContainsFold(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldContainsFold(FieldOrganizationID, v))
+}
+
 // GoogleAccountIDEQ applies the EQ predicate on the "google_account_id" field.
 func GoogleAccountIDEQ(v string) predicate.GoogleDriveConnection {
 	return predicate.GoogleDriveConnection(sql.FieldEQ(FieldGoogleAccountID, v))
@@ -495,6 +585,81 @@ func StatusNotIn(vs ...Status) predicate.GoogleDriveConnection {
 	return predicate.GoogleDriveConnection(sql.FieldNotIn(FieldStatus, vs...))
 }
 
+// MigrationRunIDEQ applies the EQ predicate on the "migration_run_id" field.
+func MigrationRunIDEQ(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldEQ(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDNEQ applies the NEQ predicate on the "migration_run_id" field.
+func MigrationRunIDNEQ(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldNEQ(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDIn applies the In predicate on the "migration_run_id" field.
+func MigrationRunIDIn(vs ...string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldIn(FieldMigrationRunID, vs...))
+}
+
+// MigrationRunIDNotIn applies the NotIn predicate on the "migration_run_id" field.
+func MigrationRunIDNotIn(vs ...string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldNotIn(FieldMigrationRunID, vs...))
+}
+
+// MigrationRunIDGT applies the GT predicate on the "migration_run_id" field.
+func MigrationRunIDGT(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldGT(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDGTE applies the GTE predicate on the "migration_run_id" field.
+func MigrationRunIDGTE(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldGTE(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDLT applies the LT predicate on the "migration_run_id" field.
+func MigrationRunIDLT(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldLT(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDLTE applies the LTE predicate on the "migration_run_id" field.
+func MigrationRunIDLTE(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldLTE(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDContains applies the Contains predicate on the "migration_run_id" field.
+func MigrationRunIDContains(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldContains(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDHasPrefix applies the HasPrefix predicate on the "migration_run_id" field.
+func MigrationRunIDHasPrefix(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldHasPrefix(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDHasSuffix applies the HasSuffix predicate on the "migration_run_id" field.
+func MigrationRunIDHasSuffix(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldHasSuffix(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDIsNil applies the IsNil predicate on the "migration_run_id" field.
+func MigrationRunIDIsNil() predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldIsNull(FieldMigrationRunID))
+}
+
+// MigrationRunIDNotNil applies the NotNil predicate on the "migration_run_id" field.
+func MigrationRunIDNotNil() predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldNotNull(FieldMigrationRunID))
+}
+
+// MigrationRunIDEqualFold applies the EqualFold predicate on the "migration_run_id" field.
+func MigrationRunIDEqualFold(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldEqualFold(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDContainsFold applies the ContainsFold predicate on the "migration_run_id" field.
+func MigrationRunIDContainsFold(v string) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldContainsFold(FieldMigrationRunID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.GoogleDriveConnection {
 	return predicate.GoogleDriveConnection(sql.FieldEQ(FieldCreatedAt, v))
@@ -625,6 +790,99 @@ func LastSyncAtNotNil() predicate.GoogleDriveConnection {
 	return predicate.GoogleDriveConnection(sql.FieldNotNull(FieldLastSyncAt))
 }
 
+// SyncScheduleEQ applies the EQ predicate on the "sync_schedule" field.
+func SyncScheduleEQ(v SyncSchedule) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldEQ(FieldSyncSchedule, v))
+}
+
+// SyncScheduleNEQ applies the NEQ predicate on the "sync_schedule" field.
+func SyncScheduleNEQ(v SyncSchedule) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldNEQ(FieldSyncSchedule, v))
+}
+
+// SyncScheduleIn applies the In predicate on the "sync_schedule" field.
+func SyncScheduleIn(vs ...SyncSchedule) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldIn(FieldSyncSchedule, vs...))
+}
+
+// SyncScheduleNotIn applies the NotIn predicate on the "sync_schedule" field.
+func SyncScheduleNotIn(vs ...SyncSchedule) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldNotIn(FieldSyncSchedule, vs...))
+}
+
+// NextSyncAtEQ applies the EQ predicate on the "next_sync_at" field.
+func NextSyncAtEQ(v time.Time) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldEQ(FieldNextSyncAt, v))
+}
+
+// NextSyncAtNEQ applies the NEQ predicate on the "next_sync_at" field.
+func NextSyncAtNEQ(v time.Time) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldNEQ(FieldNextSyncAt, v))
+}
+
+// NextSyncAtIn applies the In predicate on the "next_sync_at" field.
+func NextSyncAtIn(vs ...time.Time) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldIn(FieldNextSyncAt, vs...))
+}
+
+// NextSyncAtNotIn applies the NotIn predicate on the "next_sync_at" field.
+func NextSyncAtNotIn(vs ...time.Time) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldNotIn(FieldNextSyncAt, vs...))
+}
+
+// NextSyncAtGT applies the GT predicate on the "next_sync_at" field.
+func NextSyncAtGT(v time.Time) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldGT(FieldNextSyncAt, v))
+}
+
+// NextSyncAtGTE applies the GTE predicate on the "next_sync_at" field.
+func NextSyncAtGTE(v time.Time) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldGTE(FieldNextSyncAt, v))
+}
+
+// NextSyncAtLT applies the LT predicate on the "next_sync_at" field.
+func NextSyncAtLT(v time.Time) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldLT(FieldNextSyncAt, v))
+}
+
+// NextSyncAtLTE applies the LTE predicate on the "next_sync_at" field.
+func NextSyncAtLTE(v time.Time) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldLTE(FieldNextSyncAt, v))
+}
+
+// NextSyncAtIsNil applies the IsNil predicate on the "next_sync_at" field.
+func NextSyncAtIsNil() predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldIsNull(FieldNextSyncAt))
+}
+
+// NextSyncAtNotNil applies the NotNil predicate on the "next_sync_at" field.
+func NextSyncAtNotNil() predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(sql.FieldNotNull(FieldNextSyncAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.GoogleDriveConnection {
+	return predicate.GoogleDriveConnection(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasFolders applies the HasEdge predicate on the "folders" edge.
 func HasFolders() predicate.GoogleDriveConnection {
 	return predicate.GoogleDriveConnection(func(s *sql.Selector) {
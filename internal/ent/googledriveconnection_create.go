@@ -6,11 +6,14 @@ import (
 	"clockzen-next/internal/ent/googledriveconnection"
 	"clockzen-next/internal/ent/googledrivefolder"
 	"clockzen-next/internal/ent/googledrivesync"
+	"clockzen-next/internal/ent/user"
 	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -20,6 +23,7 @@ type GoogleDriveConnectionCreate struct {
 	config
 	mutation *GoogleDriveConnectionMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUserID sets the "user_id" field.
@@ -28,6 +32,20 @@ func (_c *GoogleDriveConnectionCreate) SetUserID(v string) *GoogleDriveConnectio
 	return _c
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (_c *GoogleDriveConnectionCreate) SetOrganizationID(v string) *GoogleDriveConnectionCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_c *GoogleDriveConnectionCreate) SetNillableOrganizationID(v *string) *GoogleDriveConnectionCreate {
+	if v != nil {
+		_c.SetOrganizationID(*v)
+	}
+	return _c
+}
+
 // SetGoogleAccountID sets the "google_account_id" field.
 func (_c *GoogleDriveConnectionCreate) SetGoogleAccountID(v string) *GoogleDriveConnectionCreate {
 	_c.mutation.SetGoogleAccountID(v)
@@ -72,6 +90,20 @@ func (_c *GoogleDriveConnectionCreate) SetNillableStatus(v *googledriveconnectio
 	return _c
 }
 
+// SetMigrationRunID sets the "migration_run_id" field.
+func (_c *GoogleDriveConnectionCreate) SetMigrationRunID(v string) *GoogleDriveConnectionCreate {
+	_c.mutation.SetMigrationRunID(v)
+	return _c
+}
+
+// SetNillableMigrationRunID sets the "migration_run_id" field if the given value is not nil.
+func (_c *GoogleDriveConnectionCreate) SetNillableMigrationRunID(v *string) *GoogleDriveConnectionCreate {
+	if v != nil {
+		_c.SetMigrationRunID(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *GoogleDriveConnectionCreate) SetCreatedAt(v time.Time) *GoogleDriveConnectionCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -114,12 +146,45 @@ func (_c *GoogleDriveConnectionCreate) SetNillableLastSyncAt(v *time.Time) *Goog
 	return _c
 }
 
+// SetSyncSchedule sets the "sync_schedule" field.
+func (_c *GoogleDriveConnectionCreate) SetSyncSchedule(v googledriveconnection.SyncSchedule) *GoogleDriveConnectionCreate {
+	_c.mutation.SetSyncSchedule(v)
+	return _c
+}
+
+// SetNillableSyncSchedule sets the "sync_schedule" field if the given value is not nil.
+func (_c *GoogleDriveConnectionCreate) SetNillableSyncSchedule(v *googledriveconnection.SyncSchedule) *GoogleDriveConnectionCreate {
+	if v != nil {
+		_c.SetSyncSchedule(*v)
+	}
+	return _c
+}
+
+// SetNextSyncAt sets the "next_sync_at" field.
+func (_c *GoogleDriveConnectionCreate) SetNextSyncAt(v time.Time) *GoogleDriveConnectionCreate {
+	_c.mutation.SetNextSyncAt(v)
+	return _c
+}
+
+// SetNillableNextSyncAt sets the "next_sync_at" field if the given value is not nil.
+func (_c *GoogleDriveConnectionCreate) SetNillableNextSyncAt(v *time.Time) *GoogleDriveConnectionCreate {
+	if v != nil {
+		_c.SetNextSyncAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *GoogleDriveConnectionCreate) SetID(v string) *GoogleDriveConnectionCreate {
 	_c.mutation.SetID(v)
 	return _c
 }
 
+// SetUser sets the "user" edge to the User entity.
+func (_c *GoogleDriveConnectionCreate) SetUser(v *User) *GoogleDriveConnectionCreate {
+	return _c.SetUserID(v.ID)
+}
+
 // AddFolderIDs adds the "folders" edge to the GoogleDriveFolder entity by IDs.
 func (_c *GoogleDriveConnectionCreate) AddFolderIDs(ids ...string) *GoogleDriveConnectionCreate {
 	_c.mutation.AddFolderIDs(ids...)
@@ -197,6 +262,10 @@ func (_c *GoogleDriveConnectionCreate) defaults() {
 		v := googledriveconnection.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.SyncSchedule(); !ok {
+		v := googledriveconnection.DefaultSyncSchedule
+		_c.mutation.SetSyncSchedule(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -248,6 +317,17 @@ func (_c *GoogleDriveConnectionCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "GoogleDriveConnection.updated_at"`)}
 	}
+	if _, ok := _c.mutation.SyncSchedule(); !ok {
+		return &ValidationError{Name: "sync_schedule", err: errors.New(`ent: missing required field "GoogleDriveConnection.sync_schedule"`)}
+	}
+	if v, ok := _c.mutation.SyncSchedule(); ok {
+		if err := googledriveconnection.SyncScheduleValidator(v); err != nil {
+			return &ValidationError{Name: "sync_schedule", err: fmt.Errorf(`ent: validator failed for field "GoogleDriveConnection.sync_schedule": %w`, err)}
+		}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "GoogleDriveConnection.user"`)}
+	}
 	return nil
 }
 
@@ -279,13 +359,14 @@ func (_c *GoogleDriveConnectionCreate) createSpec() (*GoogleDriveConnection, *sq
 		_node = &GoogleDriveConnection{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(googledriveconnection.Table, sqlgraph.NewFieldSpec(googledriveconnection.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
-	if value, ok := _c.mutation.UserID(); ok {
-		_spec.SetField(googledriveconnection.FieldUserID, field.TypeString, value)
-		_node.UserID = value
+	if value, ok := _c.mutation.OrganizationID(); ok {
+		_spec.SetField(googledriveconnection.FieldOrganizationID, field.TypeString, value)
+		_node.OrganizationID = &value
 	}
 	if value, ok := _c.mutation.GoogleAccountID(); ok {
 		_spec.SetField(googledriveconnection.FieldGoogleAccountID, field.TypeString, value)
@@ -311,6 +392,10 @@ func (_c *GoogleDriveConnectionCreate) createSpec() (*GoogleDriveConnection, *sq
 		_spec.SetField(googledriveconnection.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.MigrationRunID(); ok {
+		_spec.SetField(googledriveconnection.FieldMigrationRunID, field.TypeString, value)
+		_node.MigrationRunID = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(googledriveconnection.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -323,6 +408,31 @@ func (_c *GoogleDriveConnectionCreate) createSpec() (*GoogleDriveConnection, *sq
 		_spec.SetField(googledriveconnection.FieldLastSyncAt, field.TypeTime, value)
 		_node.LastSyncAt = &value
 	}
+	if value, ok := _c.mutation.SyncSchedule(); ok {
+		_spec.SetField(googledriveconnection.FieldSyncSchedule, field.TypeEnum, value)
+		_node.SyncSchedule = value
+	}
+	if value, ok := _c.mutation.NextSyncAt(); ok {
+		_spec.SetField(googledriveconnection.FieldNextSyncAt, field.TypeTime, value)
+		_node.NextSyncAt = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   googledriveconnection.UserTable,
+			Columns: []string{googledriveconnection.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.FoldersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -358,11 +468,540 @@ func (_c *GoogleDriveConnectionCreate) createSpec() (*GoogleDriveConnection, *sq
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GoogleDriveConnection.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoogleDriveConnectionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoogleDriveConnectionCreate) OnConflict(opts ...sql.ConflictOption) *GoogleDriveConnectionUpsertOne {
+	_c.conflict = opts
+	return &GoogleDriveConnectionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GoogleDriveConnection.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoogleDriveConnectionCreate) OnConflictColumns(columns ...string) *GoogleDriveConnectionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoogleDriveConnectionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GoogleDriveConnectionUpsertOne is the builder for "upsert"-ing
+	//  one GoogleDriveConnection node.
+	GoogleDriveConnectionUpsertOne struct {
+		create *GoogleDriveConnectionCreate
+	}
+
+	// GoogleDriveConnectionUpsert is the "OnConflict" setter.
+	GoogleDriveConnectionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *GoogleDriveConnectionUpsert) SetUserID(v string) *GoogleDriveConnectionUpsert {
+	u.Set(googledriveconnection.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsert) UpdateUserID() *GoogleDriveConnectionUpsert {
+	u.SetExcluded(googledriveconnection.FieldUserID)
+	return u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (u *GoogleDriveConnectionUpsert) SetOrganizationID(v string) *GoogleDriveConnectionUpsert {
+	u.Set(googledriveconnection.FieldOrganizationID, v)
+	return u
+}
+
+// UpdateOrganizationID sets the "organization_id" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsert) UpdateOrganizationID() *GoogleDriveConnectionUpsert {
+	u.SetExcluded(googledriveconnection.FieldOrganizationID)
+	return u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (u *GoogleDriveConnectionUpsert) ClearOrganizationID() *GoogleDriveConnectionUpsert {
+	u.SetNull(googledriveconnection.FieldOrganizationID)
+	return u
+}
+
+// SetGoogleAccountID sets the "google_account_id" field.
+func (u *GoogleDriveConnectionUpsert) SetGoogleAccountID(v string) *GoogleDriveConnectionUpsert {
+	u.Set(googledriveconnection.FieldGoogleAccountID, v)
+	return u
+}
+
+// UpdateGoogleAccountID sets the "google_account_id" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsert) UpdateGoogleAccountID() *GoogleDriveConnectionUpsert {
+	u.SetExcluded(googledriveconnection.FieldGoogleAccountID)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *GoogleDriveConnectionUpsert) SetEmail(v string) *GoogleDriveConnectionUpsert {
+	u.Set(googledriveconnection.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsert) UpdateEmail() *GoogleDriveConnectionUpsert {
+	u.SetExcluded(googledriveconnection.FieldEmail)
+	return u
+}
+
+// SetAccessToken sets the "access_token" field.
+func (u *GoogleDriveConnectionUpsert) SetAccessToken(v string) *GoogleDriveConnectionUpsert {
+	u.Set(googledriveconnection.FieldAccessToken, v)
+	return u
+}
+
+// UpdateAccessToken sets the "access_token" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsert) UpdateAccessToken() *GoogleDriveConnectionUpsert {
+	u.SetExcluded(googledriveconnection.FieldAccessToken)
+	return u
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (u *GoogleDriveConnectionUpsert) SetRefreshToken(v string) *GoogleDriveConnectionUpsert {
+	u.Set(googledriveconnection.FieldRefreshToken, v)
+	return u
+}
+
+// UpdateRefreshToken sets the "refresh_token" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsert) UpdateRefreshToken() *GoogleDriveConnectionUpsert {
+	u.SetExcluded(googledriveconnection.FieldRefreshToken)
+	return u
+}
+
+// SetTokenExpiry sets the "token_expiry" field.
+func (u *GoogleDriveConnectionUpsert) SetTokenExpiry(v time.Time) *GoogleDriveConnectionUpsert {
+	u.Set(googledriveconnection.FieldTokenExpiry, v)
+	return u
+}
+
+// UpdateTokenExpiry sets the "token_expiry" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsert) UpdateTokenExpiry() *GoogleDriveConnectionUpsert {
+	u.SetExcluded(googledriveconnection.FieldTokenExpiry)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *GoogleDriveConnectionUpsert) SetStatus(v googledriveconnection.Status) *GoogleDriveConnectionUpsert {
+	u.Set(googledriveconnection.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsert) UpdateStatus() *GoogleDriveConnectionUpsert {
+	u.SetExcluded(googledriveconnection.FieldStatus)
+	return u
+}
+
+// SetMigrationRunID sets the "migration_run_id" field.
+func (u *GoogleDriveConnectionUpsert) SetMigrationRunID(v string) *GoogleDriveConnectionUpsert {
+	u.Set(googledriveconnection.FieldMigrationRunID, v)
+	return u
+}
+
+// UpdateMigrationRunID sets the "migration_run_id" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsert) UpdateMigrationRunID() *GoogleDriveConnectionUpsert {
+	u.SetExcluded(googledriveconnection.FieldMigrationRunID)
+	return u
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (u *GoogleDriveConnectionUpsert) ClearMigrationRunID() *GoogleDriveConnectionUpsert {
+	u.SetNull(googledriveconnection.FieldMigrationRunID)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoogleDriveConnectionUpsert) SetUpdatedAt(v time.Time) *GoogleDriveConnectionUpsert {
+	u.Set(googledriveconnection.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsert) UpdateUpdatedAt() *GoogleDriveConnectionUpsert {
+	u.SetExcluded(googledriveconnection.FieldUpdatedAt)
+	return u
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (u *GoogleDriveConnectionUpsert) SetLastSyncAt(v time.Time) *GoogleDriveConnectionUpsert {
+	u.Set(googledriveconnection.FieldLastSyncAt, v)
+	return u
+}
+
+// UpdateLastSyncAt sets the "last_sync_at" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsert) UpdateLastSyncAt() *GoogleDriveConnectionUpsert {
+	u.SetExcluded(googledriveconnection.FieldLastSyncAt)
+	return u
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (u *GoogleDriveConnectionUpsert) ClearLastSyncAt() *GoogleDriveConnectionUpsert {
+	u.SetNull(googledriveconnection.FieldLastSyncAt)
+	return u
+}
+
+// SetSyncSchedule sets the "sync_schedule" field.
+func (u *GoogleDriveConnectionUpsert) SetSyncSchedule(v googledriveconnection.SyncSchedule) *GoogleDriveConnectionUpsert {
+	u.Set(googledriveconnection.FieldSyncSchedule, v)
+	return u
+}
+
+// UpdateSyncSchedule sets the "sync_schedule" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsert) UpdateSyncSchedule() *GoogleDriveConnectionUpsert {
+	u.SetExcluded(googledriveconnection.FieldSyncSchedule)
+	return u
+}
+
+// SetNextSyncAt sets the "next_sync_at" field.
+func (u *GoogleDriveConnectionUpsert) SetNextSyncAt(v time.Time) *GoogleDriveConnectionUpsert {
+	u.Set(googledriveconnection.FieldNextSyncAt, v)
+	return u
+}
+
+// UpdateNextSyncAt sets the "next_sync_at" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsert) UpdateNextSyncAt() *GoogleDriveConnectionUpsert {
+	u.SetExcluded(googledriveconnection.FieldNextSyncAt)
+	return u
+}
+
+// ClearNextSyncAt clears the value of the "next_sync_at" field.
+func (u *GoogleDriveConnectionUpsert) ClearNextSyncAt() *GoogleDriveConnectionUpsert {
+	u.SetNull(googledriveconnection.FieldNextSyncAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.GoogleDriveConnection.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(googledriveconnection.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoogleDriveConnectionUpsertOne) UpdateNewValues() *GoogleDriveConnectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(googledriveconnection.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(googledriveconnection.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GoogleDriveConnection.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GoogleDriveConnectionUpsertOne) Ignore() *GoogleDriveConnectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoogleDriveConnectionUpsertOne) DoNothing() *GoogleDriveConnectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoogleDriveConnectionCreate.OnConflict
+// documentation for more info.
+func (u *GoogleDriveConnectionUpsertOne) Update(set func(*GoogleDriveConnectionUpsert)) *GoogleDriveConnectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoogleDriveConnectionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *GoogleDriveConnectionUpsertOne) SetUserID(v string) *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertOne) UpdateUserID() *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (u *GoogleDriveConnectionUpsertOne) SetOrganizationID(v string) *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetOrganizationID(v)
+	})
+}
+
+// UpdateOrganizationID sets the "organization_id" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertOne) UpdateOrganizationID() *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateOrganizationID()
+	})
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (u *GoogleDriveConnectionUpsertOne) ClearOrganizationID() *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.ClearOrganizationID()
+	})
+}
+
+// SetGoogleAccountID sets the "google_account_id" field.
+func (u *GoogleDriveConnectionUpsertOne) SetGoogleAccountID(v string) *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetGoogleAccountID(v)
+	})
+}
+
+// UpdateGoogleAccountID sets the "google_account_id" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertOne) UpdateGoogleAccountID() *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateGoogleAccountID()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *GoogleDriveConnectionUpsertOne) SetEmail(v string) *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertOne) UpdateEmail() *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetAccessToken sets the "access_token" field.
+func (u *GoogleDriveConnectionUpsertOne) SetAccessToken(v string) *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetAccessToken(v)
+	})
+}
+
+// UpdateAccessToken sets the "access_token" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertOne) UpdateAccessToken() *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateAccessToken()
+	})
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (u *GoogleDriveConnectionUpsertOne) SetRefreshToken(v string) *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetRefreshToken(v)
+	})
+}
+
+// UpdateRefreshToken sets the "refresh_token" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertOne) UpdateRefreshToken() *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateRefreshToken()
+	})
+}
+
+// SetTokenExpiry sets the "token_expiry" field.
+func (u *GoogleDriveConnectionUpsertOne) SetTokenExpiry(v time.Time) *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetTokenExpiry(v)
+	})
+}
+
+// UpdateTokenExpiry sets the "token_expiry" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertOne) UpdateTokenExpiry() *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateTokenExpiry()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *GoogleDriveConnectionUpsertOne) SetStatus(v googledriveconnection.Status) *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertOne) UpdateStatus() *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMigrationRunID sets the "migration_run_id" field.
+func (u *GoogleDriveConnectionUpsertOne) SetMigrationRunID(v string) *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetMigrationRunID(v)
+	})
+}
+
+// UpdateMigrationRunID sets the "migration_run_id" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertOne) UpdateMigrationRunID() *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateMigrationRunID()
+	})
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (u *GoogleDriveConnectionUpsertOne) ClearMigrationRunID() *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.ClearMigrationRunID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoogleDriveConnectionUpsertOne) SetUpdatedAt(v time.Time) *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertOne) UpdateUpdatedAt() *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (u *GoogleDriveConnectionUpsertOne) SetLastSyncAt(v time.Time) *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetLastSyncAt(v)
+	})
+}
+
+// UpdateLastSyncAt sets the "last_sync_at" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertOne) UpdateLastSyncAt() *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateLastSyncAt()
+	})
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (u *GoogleDriveConnectionUpsertOne) ClearLastSyncAt() *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.ClearLastSyncAt()
+	})
+}
+
+// SetSyncSchedule sets the "sync_schedule" field.
+func (u *GoogleDriveConnectionUpsertOne) SetSyncSchedule(v googledriveconnection.SyncSchedule) *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetSyncSchedule(v)
+	})
+}
+
+// UpdateSyncSchedule sets the "sync_schedule" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertOne) UpdateSyncSchedule() *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateSyncSchedule()
+	})
+}
+
+// SetNextSyncAt sets the "next_sync_at" field.
+func (u *GoogleDriveConnectionUpsertOne) SetNextSyncAt(v time.Time) *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetNextSyncAt(v)
+	})
+}
+
+// UpdateNextSyncAt sets the "next_sync_at" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertOne) UpdateNextSyncAt() *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateNextSyncAt()
+	})
+}
+
+// ClearNextSyncAt clears the value of the "next_sync_at" field.
+func (u *GoogleDriveConnectionUpsertOne) ClearNextSyncAt() *GoogleDriveConnectionUpsertOne {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.ClearNextSyncAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GoogleDriveConnectionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoogleDriveConnectionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoogleDriveConnectionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GoogleDriveConnectionUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: GoogleDriveConnectionUpsertOne.ID is not supported by MySQL driver. Use GoogleDriveConnectionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GoogleDriveConnectionUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // GoogleDriveConnectionCreateBulk is the builder for creating many GoogleDriveConnection entities in bulk.
 type GoogleDriveConnectionCreateBulk struct {
 	config
 	err      error
 	builders []*GoogleDriveConnectionCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the GoogleDriveConnection entities in the database.
@@ -392,6 +1031,7 @@ func (_c *GoogleDriveConnectionCreateBulk) Save(ctx context.Context) ([]*GoogleD
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -441,3 +1081,330 @@ func (_c *GoogleDriveConnectionCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GoogleDriveConnection.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoogleDriveConnectionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoogleDriveConnectionCreateBulk) OnConflict(opts ...sql.ConflictOption) *GoogleDriveConnectionUpsertBulk {
+	_c.conflict = opts
+	return &GoogleDriveConnectionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GoogleDriveConnection.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoogleDriveConnectionCreateBulk) OnConflictColumns(columns ...string) *GoogleDriveConnectionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoogleDriveConnectionUpsertBulk{
+		create: _c,
+	}
+}
+
+// GoogleDriveConnectionUpsertBulk is the builder for "upsert"-ing
+// a bulk of GoogleDriveConnection nodes.
+type GoogleDriveConnectionUpsertBulk struct {
+	create *GoogleDriveConnectionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GoogleDriveConnection.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(googledriveconnection.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoogleDriveConnectionUpsertBulk) UpdateNewValues() *GoogleDriveConnectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(googledriveconnection.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(googledriveconnection.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GoogleDriveConnection.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GoogleDriveConnectionUpsertBulk) Ignore() *GoogleDriveConnectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoogleDriveConnectionUpsertBulk) DoNothing() *GoogleDriveConnectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoogleDriveConnectionCreateBulk.OnConflict
+// documentation for more info.
+func (u *GoogleDriveConnectionUpsertBulk) Update(set func(*GoogleDriveConnectionUpsert)) *GoogleDriveConnectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoogleDriveConnectionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *GoogleDriveConnectionUpsertBulk) SetUserID(v string) *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertBulk) UpdateUserID() *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (u *GoogleDriveConnectionUpsertBulk) SetOrganizationID(v string) *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetOrganizationID(v)
+	})
+}
+
+// UpdateOrganizationID sets the "organization_id" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertBulk) UpdateOrganizationID() *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateOrganizationID()
+	})
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (u *GoogleDriveConnectionUpsertBulk) ClearOrganizationID() *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.ClearOrganizationID()
+	})
+}
+
+// SetGoogleAccountID sets the "google_account_id" field.
+func (u *GoogleDriveConnectionUpsertBulk) SetGoogleAccountID(v string) *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetGoogleAccountID(v)
+	})
+}
+
+// UpdateGoogleAccountID sets the "google_account_id" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertBulk) UpdateGoogleAccountID() *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateGoogleAccountID()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *GoogleDriveConnectionUpsertBulk) SetEmail(v string) *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertBulk) UpdateEmail() *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetAccessToken sets the "access_token" field.
+func (u *GoogleDriveConnectionUpsertBulk) SetAccessToken(v string) *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetAccessToken(v)
+	})
+}
+
+// UpdateAccessToken sets the "access_token" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertBulk) UpdateAccessToken() *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateAccessToken()
+	})
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (u *GoogleDriveConnectionUpsertBulk) SetRefreshToken(v string) *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetRefreshToken(v)
+	})
+}
+
+// UpdateRefreshToken sets the "refresh_token" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertBulk) UpdateRefreshToken() *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateRefreshToken()
+	})
+}
+
+// SetTokenExpiry sets the "token_expiry" field.
+func (u *GoogleDriveConnectionUpsertBulk) SetTokenExpiry(v time.Time) *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetTokenExpiry(v)
+	})
+}
+
+// UpdateTokenExpiry sets the "token_expiry" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertBulk) UpdateTokenExpiry() *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateTokenExpiry()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *GoogleDriveConnectionUpsertBulk) SetStatus(v googledriveconnection.Status) *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertBulk) UpdateStatus() *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMigrationRunID sets the "migration_run_id" field.
+func (u *GoogleDriveConnectionUpsertBulk) SetMigrationRunID(v string) *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetMigrationRunID(v)
+	})
+}
+
+// UpdateMigrationRunID sets the "migration_run_id" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertBulk) UpdateMigrationRunID() *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateMigrationRunID()
+	})
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (u *GoogleDriveConnectionUpsertBulk) ClearMigrationRunID() *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.ClearMigrationRunID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoogleDriveConnectionUpsertBulk) SetUpdatedAt(v time.Time) *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertBulk) UpdateUpdatedAt() *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (u *GoogleDriveConnectionUpsertBulk) SetLastSyncAt(v time.Time) *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetLastSyncAt(v)
+	})
+}
+
+// UpdateLastSyncAt sets the "last_sync_at" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertBulk) UpdateLastSyncAt() *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateLastSyncAt()
+	})
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (u *GoogleDriveConnectionUpsertBulk) ClearLastSyncAt() *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.ClearLastSyncAt()
+	})
+}
+
+// SetSyncSchedule sets the "sync_schedule" field.
+func (u *GoogleDriveConnectionUpsertBulk) SetSyncSchedule(v googledriveconnection.SyncSchedule) *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetSyncSchedule(v)
+	})
+}
+
+// UpdateSyncSchedule sets the "sync_schedule" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertBulk) UpdateSyncSchedule() *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateSyncSchedule()
+	})
+}
+
+// SetNextSyncAt sets the "next_sync_at" field.
+func (u *GoogleDriveConnectionUpsertBulk) SetNextSyncAt(v time.Time) *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.SetNextSyncAt(v)
+	})
+}
+
+// UpdateNextSyncAt sets the "next_sync_at" field to the value that was provided on create.
+func (u *GoogleDriveConnectionUpsertBulk) UpdateNextSyncAt() *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.UpdateNextSyncAt()
+	})
+}
+
+// ClearNextSyncAt clears the value of the "next_sync_at" field.
+func (u *GoogleDriveConnectionUpsertBulk) ClearNextSyncAt() *GoogleDriveConnectionUpsertBulk {
+	return u.Update(func(s *GoogleDriveConnectionUpsert) {
+		s.ClearNextSyncAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GoogleDriveConnectionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GoogleDriveConnectionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoogleDriveConnectionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoogleDriveConnectionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
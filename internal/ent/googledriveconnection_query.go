@@ -7,6 +7,7 @@ import (
 	"clockzen-next/internal/ent/googledrivefolder"
 	"clockzen-next/internal/ent/googledrivesync"
 	"clockzen-next/internal/ent/predicate"
+	"clockzen-next/internal/ent/user"
 	"context"
 	"database/sql/driver"
 	"fmt"
@@ -25,6 +26,7 @@ type GoogleDriveConnectionQuery struct {
 	order       []googledriveconnection.OrderOption
 	inters      []Interceptor
 	predicates  []predicate.GoogleDriveConnection
+	withUser    *UserQuery
 	withFolders *GoogleDriveFolderQuery
 	withSyncs   *GoogleDriveSyncQuery
 	// intermediate query (i.e. traversal path).
@@ -63,6 +65,28 @@ func (_q *GoogleDriveConnectionQuery) Order(o ...googledriveconnection.OrderOpti
 	return _q
 }
 
+// QueryUser chains the current query on the "user" edge.
+func (_q *GoogleDriveConnectionQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(googledriveconnection.Table, googledriveconnection.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, googledriveconnection.UserTable, googledriveconnection.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryFolders chains the current query on the "folders" edge.
 func (_q *GoogleDriveConnectionQuery) QueryFolders() *GoogleDriveFolderQuery {
 	query := (&GoogleDriveFolderClient{config: _q.config}).Query()
@@ -299,6 +323,7 @@ func (_q *GoogleDriveConnectionQuery) Clone() *GoogleDriveConnectionQuery {
 		order:       append([]googledriveconnection.OrderOption{}, _q.order...),
 		inters:      append([]Interceptor{}, _q.inters...),
 		predicates:  append([]predicate.GoogleDriveConnection{}, _q.predicates...),
+		withUser:    _q.withUser.Clone(),
 		withFolders: _q.withFolders.Clone(),
 		withSyncs:   _q.withSyncs.Clone(),
 		// clone intermediate query.
@@ -307,6 +332,17 @@ func (_q *GoogleDriveConnectionQuery) Clone() *GoogleDriveConnectionQuery {
 	}
 }
 
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GoogleDriveConnectionQuery) WithUser(opts ...func(*UserQuery)) *GoogleDriveConnectionQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withUser = query
+	return _q
+}
+
 // WithFolders tells the query-builder to eager-load the nodes that are connected to
 // the "folders" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *GoogleDriveConnectionQuery) WithFolders(opts ...func(*GoogleDriveFolderQuery)) *GoogleDriveConnectionQuery {
@@ -407,7 +443,8 @@ func (_q *GoogleDriveConnectionQuery) sqlAll(ctx context.Context, hooks ...query
 	var (
 		nodes       = []*GoogleDriveConnection{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
+			_q.withUser != nil,
 			_q.withFolders != nil,
 			_q.withSyncs != nil,
 		}
@@ -430,6 +467,12 @@ func (_q *GoogleDriveConnectionQuery) sqlAll(ctx context.Context, hooks ...query
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := _q.withUser; query != nil {
+		if err := _q.loadUser(ctx, query, nodes, nil,
+			func(n *GoogleDriveConnection, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withFolders; query != nil {
 		if err := _q.loadFolders(ctx, query, nodes,
 			func(n *GoogleDriveConnection) { n.Edges.Folders = []*GoogleDriveFolder{} },
@@ -447,6 +490,35 @@ func (_q *GoogleDriveConnectionQuery) sqlAll(ctx context.Context, hooks ...query
 	return nodes, nil
 }
 
+func (_q *GoogleDriveConnectionQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*GoogleDriveConnection, init func(*GoogleDriveConnection), assign func(*GoogleDriveConnection, *User)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*GoogleDriveConnection)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *GoogleDriveConnectionQuery) loadFolders(ctx context.Context, query *GoogleDriveFolderQuery, nodes []*GoogleDriveConnection, init func(*GoogleDriveConnection), assign func(*GoogleDriveConnection, *GoogleDriveFolder)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*GoogleDriveConnection)
@@ -533,6 +605,9 @@ func (_q *GoogleDriveConnectionQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withUser != nil {
+			_spec.Node.AddColumnOnce(googledriveconnection.FieldUserID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -7,6 +7,7 @@ import (
 	"clockzen-next/internal/ent/googledrivefolder"
 	"clockzen-next/internal/ent/googledrivesync"
 	"clockzen-next/internal/ent/predicate"
+	"clockzen-next/internal/ent/user"
 	"context"
 	"errors"
 	"fmt"
@@ -44,6 +45,26 @@ func (_u *GoogleDriveConnectionUpdate) SetNillableUserID(v *string) *GoogleDrive
 	return _u
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (_u *GoogleDriveConnectionUpdate) SetOrganizationID(v string) *GoogleDriveConnectionUpdate {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *GoogleDriveConnectionUpdate) SetNillableOrganizationID(v *string) *GoogleDriveConnectionUpdate {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (_u *GoogleDriveConnectionUpdate) ClearOrganizationID() *GoogleDriveConnectionUpdate {
+	_u.mutation.ClearOrganizationID()
+	return _u
+}
+
 // SetGoogleAccountID sets the "google_account_id" field.
 func (_u *GoogleDriveConnectionUpdate) SetGoogleAccountID(v string) *GoogleDriveConnectionUpdate {
 	_u.mutation.SetGoogleAccountID(v)
@@ -128,6 +149,26 @@ func (_u *GoogleDriveConnectionUpdate) SetNillableStatus(v *googledriveconnectio
 	return _u
 }
 
+// SetMigrationRunID sets the "migration_run_id" field.
+func (_u *GoogleDriveConnectionUpdate) SetMigrationRunID(v string) *GoogleDriveConnectionUpdate {
+	_u.mutation.SetMigrationRunID(v)
+	return _u
+}
+
+// SetNillableMigrationRunID sets the "migration_run_id" field if the given value is not nil.
+func (_u *GoogleDriveConnectionUpdate) SetNillableMigrationRunID(v *string) *GoogleDriveConnectionUpdate {
+	if v != nil {
+		_u.SetMigrationRunID(*v)
+	}
+	return _u
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (_u *GoogleDriveConnectionUpdate) ClearMigrationRunID() *GoogleDriveConnectionUpdate {
+	_u.mutation.ClearMigrationRunID()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *GoogleDriveConnectionUpdate) SetUpdatedAt(v time.Time) *GoogleDriveConnectionUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -154,6 +195,45 @@ func (_u *GoogleDriveConnectionUpdate) ClearLastSyncAt() *GoogleDriveConnectionU
 	return _u
 }
 
+// SetSyncSchedule sets the "sync_schedule" field.
+func (_u *GoogleDriveConnectionUpdate) SetSyncSchedule(v googledriveconnection.SyncSchedule) *GoogleDriveConnectionUpdate {
+	_u.mutation.SetSyncSchedule(v)
+	return _u
+}
+
+// SetNillableSyncSchedule sets the "sync_schedule" field if the given value is not nil.
+func (_u *GoogleDriveConnectionUpdate) SetNillableSyncSchedule(v *googledriveconnection.SyncSchedule) *GoogleDriveConnectionUpdate {
+	if v != nil {
+		_u.SetSyncSchedule(*v)
+	}
+	return _u
+}
+
+// SetNextSyncAt sets the "next_sync_at" field.
+func (_u *GoogleDriveConnectionUpdate) SetNextSyncAt(v time.Time) *GoogleDriveConnectionUpdate {
+	_u.mutation.SetNextSyncAt(v)
+	return _u
+}
+
+// SetNillableNextSyncAt sets the "next_sync_at" field if the given value is not nil.
+func (_u *GoogleDriveConnectionUpdate) SetNillableNextSyncAt(v *time.Time) *GoogleDriveConnectionUpdate {
+	if v != nil {
+		_u.SetNextSyncAt(*v)
+	}
+	return _u
+}
+
+// ClearNextSyncAt clears the value of the "next_sync_at" field.
+func (_u *GoogleDriveConnectionUpdate) ClearNextSyncAt() *GoogleDriveConnectionUpdate {
+	_u.mutation.ClearNextSyncAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *GoogleDriveConnectionUpdate) SetUser(v *User) *GoogleDriveConnectionUpdate {
+	return _u.SetUserID(v.ID)
+}
+
 // AddFolderIDs adds the "folders" edge to the GoogleDriveFolder entity by IDs.
 func (_u *GoogleDriveConnectionUpdate) AddFolderIDs(ids ...string) *GoogleDriveConnectionUpdate {
 	_u.mutation.AddFolderIDs(ids...)
@@ -189,6 +269,12 @@ func (_u *GoogleDriveConnectionUpdate) Mutation() *GoogleDriveConnectionMutation
 	return _u.mutation
 }
 
+// ClearUser clears the "user" edge to the User entity.
+func (_u *GoogleDriveConnectionUpdate) ClearUser() *GoogleDriveConnectionUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
 // ClearFolders clears all "folders" edges to the GoogleDriveFolder entity.
 func (_u *GoogleDriveConnectionUpdate) ClearFolders() *GoogleDriveConnectionUpdate {
 	_u.mutation.ClearFolders()
@@ -289,6 +375,14 @@ func (_u *GoogleDriveConnectionUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "GoogleDriveConnection.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SyncSchedule(); ok {
+		if err := googledriveconnection.SyncScheduleValidator(v); err != nil {
+			return &ValidationError{Name: "sync_schedule", err: fmt.Errorf(`ent: validator failed for field "GoogleDriveConnection.sync_schedule": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GoogleDriveConnection.user"`)
+	}
 	return nil
 }
 
@@ -304,8 +398,11 @@ func (_u *GoogleDriveConnectionUpdate) sqlSave(ctx context.Context) (_node int,
 			}
 		}
 	}
-	if value, ok := _u.mutation.UserID(); ok {
-		_spec.SetField(googledriveconnection.FieldUserID, field.TypeString, value)
+	if value, ok := _u.mutation.OrganizationID(); ok {
+		_spec.SetField(googledriveconnection.FieldOrganizationID, field.TypeString, value)
+	}
+	if _u.mutation.OrganizationIDCleared() {
+		_spec.ClearField(googledriveconnection.FieldOrganizationID, field.TypeString)
 	}
 	if value, ok := _u.mutation.GoogleAccountID(); ok {
 		_spec.SetField(googledriveconnection.FieldGoogleAccountID, field.TypeString, value)
@@ -325,6 +422,12 @@ func (_u *GoogleDriveConnectionUpdate) sqlSave(ctx context.Context) (_node int,
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(googledriveconnection.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.MigrationRunID(); ok {
+		_spec.SetField(googledriveconnection.FieldMigrationRunID, field.TypeString, value)
+	}
+	if _u.mutation.MigrationRunIDCleared() {
+		_spec.ClearField(googledriveconnection.FieldMigrationRunID, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(googledriveconnection.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -334,6 +437,44 @@ func (_u *GoogleDriveConnectionUpdate) sqlSave(ctx context.Context) (_node int,
 	if _u.mutation.LastSyncAtCleared() {
 		_spec.ClearField(googledriveconnection.FieldLastSyncAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.SyncSchedule(); ok {
+		_spec.SetField(googledriveconnection.FieldSyncSchedule, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.NextSyncAt(); ok {
+		_spec.SetField(googledriveconnection.FieldNextSyncAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextSyncAtCleared() {
+		_spec.ClearField(googledriveconnection.FieldNextSyncAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   googledriveconnection.UserTable,
+			Columns: []string{googledriveconnection.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   googledriveconnection.UserTable,
+			Columns: []string{googledriveconnection.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.FoldersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -458,6 +599,26 @@ func (_u *GoogleDriveConnectionUpdateOne) SetNillableUserID(v *string) *GoogleDr
 	return _u
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (_u *GoogleDriveConnectionUpdateOne) SetOrganizationID(v string) *GoogleDriveConnectionUpdateOne {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *GoogleDriveConnectionUpdateOne) SetNillableOrganizationID(v *string) *GoogleDriveConnectionUpdateOne {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (_u *GoogleDriveConnectionUpdateOne) ClearOrganizationID() *GoogleDriveConnectionUpdateOne {
+	_u.mutation.ClearOrganizationID()
+	return _u
+}
+
 // SetGoogleAccountID sets the "google_account_id" field.
 func (_u *GoogleDriveConnectionUpdateOne) SetGoogleAccountID(v string) *GoogleDriveConnectionUpdateOne {
 	_u.mutation.SetGoogleAccountID(v)
@@ -542,6 +703,26 @@ func (_u *GoogleDriveConnectionUpdateOne) SetNillableStatus(v *googledriveconnec
 	return _u
 }
 
+// SetMigrationRunID sets the "migration_run_id" field.
+func (_u *GoogleDriveConnectionUpdateOne) SetMigrationRunID(v string) *GoogleDriveConnectionUpdateOne {
+	_u.mutation.SetMigrationRunID(v)
+	return _u
+}
+
+// SetNillableMigrationRunID sets the "migration_run_id" field if the given value is not nil.
+func (_u *GoogleDriveConnectionUpdateOne) SetNillableMigrationRunID(v *string) *GoogleDriveConnectionUpdateOne {
+	if v != nil {
+		_u.SetMigrationRunID(*v)
+	}
+	return _u
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (_u *GoogleDriveConnectionUpdateOne) ClearMigrationRunID() *GoogleDriveConnectionUpdateOne {
+	_u.mutation.ClearMigrationRunID()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *GoogleDriveConnectionUpdateOne) SetUpdatedAt(v time.Time) *GoogleDriveConnectionUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -568,6 +749,45 @@ func (_u *GoogleDriveConnectionUpdateOne) ClearLastSyncAt() *GoogleDriveConnecti
 	return _u
 }
 
+// SetSyncSchedule sets the "sync_schedule" field.
+func (_u *GoogleDriveConnectionUpdateOne) SetSyncSchedule(v googledriveconnection.SyncSchedule) *GoogleDriveConnectionUpdateOne {
+	_u.mutation.SetSyncSchedule(v)
+	return _u
+}
+
+// SetNillableSyncSchedule sets the "sync_schedule" field if the given value is not nil.
+func (_u *GoogleDriveConnectionUpdateOne) SetNillableSyncSchedule(v *googledriveconnection.SyncSchedule) *GoogleDriveConnectionUpdateOne {
+	if v != nil {
+		_u.SetSyncSchedule(*v)
+	}
+	return _u
+}
+
+// SetNextSyncAt sets the "next_sync_at" field.
+func (_u *GoogleDriveConnectionUpdateOne) SetNextSyncAt(v time.Time) *GoogleDriveConnectionUpdateOne {
+	_u.mutation.SetNextSyncAt(v)
+	return _u
+}
+
+// SetNillableNextSyncAt sets the "next_sync_at" field if the given value is not nil.
+func (_u *GoogleDriveConnectionUpdateOne) SetNillableNextSyncAt(v *time.Time) *GoogleDriveConnectionUpdateOne {
+	if v != nil {
+		_u.SetNextSyncAt(*v)
+	}
+	return _u
+}
+
+// ClearNextSyncAt clears the value of the "next_sync_at" field.
+func (_u *GoogleDriveConnectionUpdateOne) ClearNextSyncAt() *GoogleDriveConnectionUpdateOne {
+	_u.mutation.ClearNextSyncAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *GoogleDriveConnectionUpdateOne) SetUser(v *User) *GoogleDriveConnectionUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
 // AddFolderIDs adds the "folders" edge to the GoogleDriveFolder entity by IDs.
 func (_u *GoogleDriveConnectionUpdateOne) AddFolderIDs(ids ...string) *GoogleDriveConnectionUpdateOne {
 	_u.mutation.AddFolderIDs(ids...)
@@ -603,6 +823,12 @@ func (_u *GoogleDriveConnectionUpdateOne) Mutation() *GoogleDriveConnectionMutat
 	return _u.mutation
 }
 
+// ClearUser clears the "user" edge to the User entity.
+func (_u *GoogleDriveConnectionUpdateOne) ClearUser() *GoogleDriveConnectionUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
 // ClearFolders clears all "folders" edges to the GoogleDriveFolder entity.
 func (_u *GoogleDriveConnectionUpdateOne) ClearFolders() *GoogleDriveConnectionUpdateOne {
 	_u.mutation.ClearFolders()
@@ -716,6 +942,14 @@ func (_u *GoogleDriveConnectionUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "GoogleDriveConnection.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SyncSchedule(); ok {
+		if err := googledriveconnection.SyncScheduleValidator(v); err != nil {
+			return &ValidationError{Name: "sync_schedule", err: fmt.Errorf(`ent: validator failed for field "GoogleDriveConnection.sync_schedule": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GoogleDriveConnection.user"`)
+	}
 	return nil
 }
 
@@ -748,8 +982,11 @@ func (_u *GoogleDriveConnectionUpdateOne) sqlSave(ctx context.Context) (_node *G
 			}
 		}
 	}
-	if value, ok := _u.mutation.UserID(); ok {
-		_spec.SetField(googledriveconnection.FieldUserID, field.TypeString, value)
+	if value, ok := _u.mutation.OrganizationID(); ok {
+		_spec.SetField(googledriveconnection.FieldOrganizationID, field.TypeString, value)
+	}
+	if _u.mutation.OrganizationIDCleared() {
+		_spec.ClearField(googledriveconnection.FieldOrganizationID, field.TypeString)
 	}
 	if value, ok := _u.mutation.GoogleAccountID(); ok {
 		_spec.SetField(googledriveconnection.FieldGoogleAccountID, field.TypeString, value)
@@ -769,6 +1006,12 @@ func (_u *GoogleDriveConnectionUpdateOne) sqlSave(ctx context.Context) (_node *G
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(googledriveconnection.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.MigrationRunID(); ok {
+		_spec.SetField(googledriveconnection.FieldMigrationRunID, field.TypeString, value)
+	}
+	if _u.mutation.MigrationRunIDCleared() {
+		_spec.ClearField(googledriveconnection.FieldMigrationRunID, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(googledriveconnection.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -778,6 +1021,44 @@ func (_u *GoogleDriveConnectionUpdateOne) sqlSave(ctx context.Context) (_node *G
 	if _u.mutation.LastSyncAtCleared() {
 		_spec.ClearField(googledriveconnection.FieldLastSyncAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.SyncSchedule(); ok {
+		_spec.SetField(googledriveconnection.FieldSyncSchedule, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.NextSyncAt(); ok {
+		_spec.SetField(googledriveconnection.FieldNextSyncAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextSyncAtCleared() {
+		_spec.ClearField(googledriveconnection.FieldNextSyncAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   googledriveconnection.UserTable,
+			Columns: []string{googledriveconnection.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   googledriveconnection.UserTable,
+			Columns: []string{googledriveconnection.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.FoldersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -32,6 +32,8 @@ type GoogleDriveFolder struct {
 	IsRoot bool `json:"is_root,omitempty"`
 	// Whether syncing is enabled for this folder
 	SyncEnabled bool `json:"sync_enabled,omitempty"`
+	// Whether subfolders are synced along with the folder
+	Recursive bool `json:"recursive,omitempty"`
 	// Direction of synchronization
 	SyncDirection googledrivefolder.SyncDirection `json:"sync_direction,omitempty"`
 	// Number of files in folder
@@ -75,7 +77,7 @@ func (*GoogleDriveFolder) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case googledrivefolder.FieldIsRoot, googledrivefolder.FieldSyncEnabled:
+		case googledrivefolder.FieldIsRoot, googledrivefolder.FieldSyncEnabled, googledrivefolder.FieldRecursive:
 			values[i] = new(sql.NullBool)
 		case googledrivefolder.FieldFileCount, googledrivefolder.FieldTotalSizeBytes:
 			values[i] = new(sql.NullInt64)
@@ -147,6 +149,12 @@ func (_m *GoogleDriveFolder) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				_m.SyncEnabled = value.Bool
 			}
+		case googledrivefolder.FieldRecursive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field recursive", values[i])
+			} else if value.Valid {
+				_m.Recursive = value.Bool
+			}
 		case googledrivefolder.FieldSyncDirection:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field sync_direction", values[i])
@@ -248,6 +256,9 @@ func (_m *GoogleDriveFolder) String() string {
 	builder.WriteString("sync_enabled=")
 	builder.WriteString(fmt.Sprintf("%v", _m.SyncEnabled))
 	builder.WriteString(", ")
+	builder.WriteString("recursive=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Recursive))
+	builder.WriteString(", ")
 	builder.WriteString("sync_direction=")
 	builder.WriteString(fmt.Sprintf("%v", _m.SyncDirection))
 	builder.WriteString(", ")
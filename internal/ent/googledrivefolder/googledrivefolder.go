@@ -29,6 +29,8 @@ const (
 	FieldIsRoot = "is_root"
 	// FieldSyncEnabled holds the string denoting the sync_enabled field in the database.
 	FieldSyncEnabled = "sync_enabled"
+	// FieldRecursive holds the string denoting the recursive field in the database.
+	FieldRecursive = "recursive"
 	// FieldSyncDirection holds the string denoting the sync_direction field in the database.
 	FieldSyncDirection = "sync_direction"
 	// FieldFileCount holds the string denoting the file_count field in the database.
@@ -64,6 +66,7 @@ var Columns = []string{
 	FieldParentFolderID,
 	FieldIsRoot,
 	FieldSyncEnabled,
+	FieldRecursive,
 	FieldSyncDirection,
 	FieldFileCount,
 	FieldTotalSizeBytes,
@@ -93,6 +96,8 @@ var (
 	DefaultIsRoot bool
 	// DefaultSyncEnabled holds the default value on creation for the "sync_enabled" field.
 	DefaultSyncEnabled bool
+	// DefaultRecursive holds the default value on creation for the "recursive" field.
+	DefaultRecursive bool
 	// DefaultFileCount holds the default value on creation for the "file_count" field.
 	DefaultFileCount int64
 	// DefaultTotalSizeBytes holds the default value on creation for the "total_size_bytes" field.
@@ -175,6 +180,11 @@ func BySyncEnabled(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSyncEnabled, opts...).ToFunc()
 }
 
+// ByRecursive orders the results by the recursive field.
+func ByRecursive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecursive, opts...).ToFunc()
+}
+
 // BySyncDirection orders the results by the sync_direction field.
 func BySyncDirection(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSyncDirection, opts...).ToFunc()
@@ -100,6 +100,11 @@ func SyncEnabled(v bool) predicate.GoogleDriveFolder {
 	return predicate.GoogleDriveFolder(sql.FieldEQ(FieldSyncEnabled, v))
 }
 
+// Recursive applies equality check predicate on the "recursive" field. It's identical to RecursiveEQ.
+func Recursive(v bool) predicate.GoogleDriveFolder {
+	return predicate.GoogleDriveFolder(sql.FieldEQ(FieldRecursive, v))
+}
+
 // FileCount applies equality check predicate on the "file_count" field. It's identical to FileCountEQ.
 func FileCount(v int64) predicate.GoogleDriveFolder {
 	return predicate.GoogleDriveFolder(sql.FieldEQ(FieldFileCount, v))
@@ -490,6 +495,16 @@ func SyncEnabledNEQ(v bool) predicate.GoogleDriveFolder {
 	return predicate.GoogleDriveFolder(sql.FieldNEQ(FieldSyncEnabled, v))
 }
 
+// RecursiveEQ applies the EQ predicate on the "recursive" field.
+func RecursiveEQ(v bool) predicate.GoogleDriveFolder {
+	return predicate.GoogleDriveFolder(sql.FieldEQ(FieldRecursive, v))
+}
+
+// RecursiveNEQ applies the NEQ predicate on the "recursive" field.
+func RecursiveNEQ(v bool) predicate.GoogleDriveFolder {
+	return predicate.GoogleDriveFolder(sql.FieldNEQ(FieldRecursive, v))
+}
+
 // SyncDirectionEQ applies the EQ predicate on the "sync_direction" field.
 func SyncDirectionEQ(v SyncDirection) predicate.GoogleDriveFolder {
 	return predicate.GoogleDriveFolder(sql.FieldEQ(FieldSyncDirection, v))
@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -19,6 +21,7 @@ type GoogleDriveFolderCreate struct {
 	config
 	mutation *GoogleDriveFolderMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetConnectionID sets the "connection_id" field.
@@ -95,6 +98,20 @@ func (_c *GoogleDriveFolderCreate) SetNillableSyncEnabled(v *bool) *GoogleDriveF
 	return _c
 }
 
+// SetRecursive sets the "recursive" field.
+func (_c *GoogleDriveFolderCreate) SetRecursive(v bool) *GoogleDriveFolderCreate {
+	_c.mutation.SetRecursive(v)
+	return _c
+}
+
+// SetNillableRecursive sets the "recursive" field if the given value is not nil.
+func (_c *GoogleDriveFolderCreate) SetNillableRecursive(v *bool) *GoogleDriveFolderCreate {
+	if v != nil {
+		_c.SetRecursive(*v)
+	}
+	return _c
+}
+
 // SetSyncDirection sets the "sync_direction" field.
 func (_c *GoogleDriveFolderCreate) SetSyncDirection(v googledrivefolder.SyncDirection) *GoogleDriveFolderCreate {
 	_c.mutation.SetSyncDirection(v)
@@ -233,6 +250,10 @@ func (_c *GoogleDriveFolderCreate) defaults() {
 		v := googledrivefolder.DefaultSyncEnabled
 		_c.mutation.SetSyncEnabled(v)
 	}
+	if _, ok := _c.mutation.Recursive(); !ok {
+		v := googledrivefolder.DefaultRecursive
+		_c.mutation.SetRecursive(v)
+	}
 	if _, ok := _c.mutation.SyncDirection(); !ok {
 		v := googledrivefolder.DefaultSyncDirection
 		_c.mutation.SetSyncDirection(v)
@@ -287,6 +308,9 @@ func (_c *GoogleDriveFolderCreate) check() error {
 	if _, ok := _c.mutation.SyncEnabled(); !ok {
 		return &ValidationError{Name: "sync_enabled", err: errors.New(`ent: missing required field "GoogleDriveFolder.sync_enabled"`)}
 	}
+	if _, ok := _c.mutation.Recursive(); !ok {
+		return &ValidationError{Name: "recursive", err: errors.New(`ent: missing required field "GoogleDriveFolder.recursive"`)}
+	}
 	if _, ok := _c.mutation.SyncDirection(); !ok {
 		return &ValidationError{Name: "sync_direction", err: errors.New(`ent: missing required field "GoogleDriveFolder.sync_direction"`)}
 	}
@@ -341,6 +365,7 @@ func (_c *GoogleDriveFolderCreate) createSpec() (*GoogleDriveFolder, *sqlgraph.C
 		_node = &GoogleDriveFolder{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(googledrivefolder.Table, sqlgraph.NewFieldSpec(googledrivefolder.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -369,6 +394,10 @@ func (_c *GoogleDriveFolderCreate) createSpec() (*GoogleDriveFolder, *sqlgraph.C
 		_spec.SetField(googledrivefolder.FieldSyncEnabled, field.TypeBool, value)
 		_node.SyncEnabled = value
 	}
+	if value, ok := _c.mutation.Recursive(); ok {
+		_spec.SetField(googledrivefolder.FieldRecursive, field.TypeBool, value)
+		_node.Recursive = value
+	}
 	if value, ok := _c.mutation.SyncDirection(); ok {
 		_spec.SetField(googledrivefolder.FieldSyncDirection, field.TypeEnum, value)
 		_node.SyncDirection = value
@@ -413,11 +442,553 @@ func (_c *GoogleDriveFolderCreate) createSpec() (*GoogleDriveFolder, *sqlgraph.C
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GoogleDriveFolder.Create().
+//		SetConnectionID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoogleDriveFolderUpsert) {
+//			SetConnectionID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoogleDriveFolderCreate) OnConflict(opts ...sql.ConflictOption) *GoogleDriveFolderUpsertOne {
+	_c.conflict = opts
+	return &GoogleDriveFolderUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GoogleDriveFolder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoogleDriveFolderCreate) OnConflictColumns(columns ...string) *GoogleDriveFolderUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoogleDriveFolderUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GoogleDriveFolderUpsertOne is the builder for "upsert"-ing
+	//  one GoogleDriveFolder node.
+	GoogleDriveFolderUpsertOne struct {
+		create *GoogleDriveFolderCreate
+	}
+
+	// GoogleDriveFolderUpsert is the "OnConflict" setter.
+	GoogleDriveFolderUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetConnectionID sets the "connection_id" field.
+func (u *GoogleDriveFolderUpsert) SetConnectionID(v string) *GoogleDriveFolderUpsert {
+	u.Set(googledrivefolder.FieldConnectionID, v)
+	return u
+}
+
+// UpdateConnectionID sets the "connection_id" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsert) UpdateConnectionID() *GoogleDriveFolderUpsert {
+	u.SetExcluded(googledrivefolder.FieldConnectionID)
+	return u
+}
+
+// SetDriveFolderID sets the "drive_folder_id" field.
+func (u *GoogleDriveFolderUpsert) SetDriveFolderID(v string) *GoogleDriveFolderUpsert {
+	u.Set(googledrivefolder.FieldDriveFolderID, v)
+	return u
+}
+
+// UpdateDriveFolderID sets the "drive_folder_id" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsert) UpdateDriveFolderID() *GoogleDriveFolderUpsert {
+	u.SetExcluded(googledrivefolder.FieldDriveFolderID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *GoogleDriveFolderUpsert) SetName(v string) *GoogleDriveFolderUpsert {
+	u.Set(googledrivefolder.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsert) UpdateName() *GoogleDriveFolderUpsert {
+	u.SetExcluded(googledrivefolder.FieldName)
+	return u
+}
+
+// SetPath sets the "path" field.
+func (u *GoogleDriveFolderUpsert) SetPath(v string) *GoogleDriveFolderUpsert {
+	u.Set(googledrivefolder.FieldPath, v)
+	return u
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsert) UpdatePath() *GoogleDriveFolderUpsert {
+	u.SetExcluded(googledrivefolder.FieldPath)
+	return u
+}
+
+// ClearPath clears the value of the "path" field.
+func (u *GoogleDriveFolderUpsert) ClearPath() *GoogleDriveFolderUpsert {
+	u.SetNull(googledrivefolder.FieldPath)
+	return u
+}
+
+// SetParentFolderID sets the "parent_folder_id" field.
+func (u *GoogleDriveFolderUpsert) SetParentFolderID(v string) *GoogleDriveFolderUpsert {
+	u.Set(googledrivefolder.FieldParentFolderID, v)
+	return u
+}
+
+// UpdateParentFolderID sets the "parent_folder_id" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsert) UpdateParentFolderID() *GoogleDriveFolderUpsert {
+	u.SetExcluded(googledrivefolder.FieldParentFolderID)
+	return u
+}
+
+// ClearParentFolderID clears the value of the "parent_folder_id" field.
+func (u *GoogleDriveFolderUpsert) ClearParentFolderID() *GoogleDriveFolderUpsert {
+	u.SetNull(googledrivefolder.FieldParentFolderID)
+	return u
+}
+
+// SetIsRoot sets the "is_root" field.
+func (u *GoogleDriveFolderUpsert) SetIsRoot(v bool) *GoogleDriveFolderUpsert {
+	u.Set(googledrivefolder.FieldIsRoot, v)
+	return u
+}
+
+// UpdateIsRoot sets the "is_root" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsert) UpdateIsRoot() *GoogleDriveFolderUpsert {
+	u.SetExcluded(googledrivefolder.FieldIsRoot)
+	return u
+}
+
+// SetSyncEnabled sets the "sync_enabled" field.
+func (u *GoogleDriveFolderUpsert) SetSyncEnabled(v bool) *GoogleDriveFolderUpsert {
+	u.Set(googledrivefolder.FieldSyncEnabled, v)
+	return u
+}
+
+// UpdateSyncEnabled sets the "sync_enabled" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsert) UpdateSyncEnabled() *GoogleDriveFolderUpsert {
+	u.SetExcluded(googledrivefolder.FieldSyncEnabled)
+	return u
+}
+
+// SetRecursive sets the "recursive" field.
+func (u *GoogleDriveFolderUpsert) SetRecursive(v bool) *GoogleDriveFolderUpsert {
+	u.Set(googledrivefolder.FieldRecursive, v)
+	return u
+}
+
+// UpdateRecursive sets the "recursive" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsert) UpdateRecursive() *GoogleDriveFolderUpsert {
+	u.SetExcluded(googledrivefolder.FieldRecursive)
+	return u
+}
+
+// SetSyncDirection sets the "sync_direction" field.
+func (u *GoogleDriveFolderUpsert) SetSyncDirection(v googledrivefolder.SyncDirection) *GoogleDriveFolderUpsert {
+	u.Set(googledrivefolder.FieldSyncDirection, v)
+	return u
+}
+
+// UpdateSyncDirection sets the "sync_direction" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsert) UpdateSyncDirection() *GoogleDriveFolderUpsert {
+	u.SetExcluded(googledrivefolder.FieldSyncDirection)
+	return u
+}
+
+// SetFileCount sets the "file_count" field.
+func (u *GoogleDriveFolderUpsert) SetFileCount(v int64) *GoogleDriveFolderUpsert {
+	u.Set(googledrivefolder.FieldFileCount, v)
+	return u
+}
+
+// UpdateFileCount sets the "file_count" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsert) UpdateFileCount() *GoogleDriveFolderUpsert {
+	u.SetExcluded(googledrivefolder.FieldFileCount)
+	return u
+}
+
+// AddFileCount adds v to the "file_count" field.
+func (u *GoogleDriveFolderUpsert) AddFileCount(v int64) *GoogleDriveFolderUpsert {
+	u.Add(googledrivefolder.FieldFileCount, v)
+	return u
+}
+
+// SetTotalSizeBytes sets the "total_size_bytes" field.
+func (u *GoogleDriveFolderUpsert) SetTotalSizeBytes(v int64) *GoogleDriveFolderUpsert {
+	u.Set(googledrivefolder.FieldTotalSizeBytes, v)
+	return u
+}
+
+// UpdateTotalSizeBytes sets the "total_size_bytes" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsert) UpdateTotalSizeBytes() *GoogleDriveFolderUpsert {
+	u.SetExcluded(googledrivefolder.FieldTotalSizeBytes)
+	return u
+}
+
+// AddTotalSizeBytes adds v to the "total_size_bytes" field.
+func (u *GoogleDriveFolderUpsert) AddTotalSizeBytes(v int64) *GoogleDriveFolderUpsert {
+	u.Add(googledrivefolder.FieldTotalSizeBytes, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoogleDriveFolderUpsert) SetUpdatedAt(v time.Time) *GoogleDriveFolderUpsert {
+	u.Set(googledrivefolder.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsert) UpdateUpdatedAt() *GoogleDriveFolderUpsert {
+	u.SetExcluded(googledrivefolder.FieldUpdatedAt)
+	return u
+}
+
+// SetLastScannedAt sets the "last_scanned_at" field.
+func (u *GoogleDriveFolderUpsert) SetLastScannedAt(v time.Time) *GoogleDriveFolderUpsert {
+	u.Set(googledrivefolder.FieldLastScannedAt, v)
+	return u
+}
+
+// UpdateLastScannedAt sets the "last_scanned_at" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsert) UpdateLastScannedAt() *GoogleDriveFolderUpsert {
+	u.SetExcluded(googledrivefolder.FieldLastScannedAt)
+	return u
+}
+
+// ClearLastScannedAt clears the value of the "last_scanned_at" field.
+func (u *GoogleDriveFolderUpsert) ClearLastScannedAt() *GoogleDriveFolderUpsert {
+	u.SetNull(googledrivefolder.FieldLastScannedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.GoogleDriveFolder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(googledrivefolder.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoogleDriveFolderUpsertOne) UpdateNewValues() *GoogleDriveFolderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(googledrivefolder.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(googledrivefolder.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GoogleDriveFolder.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GoogleDriveFolderUpsertOne) Ignore() *GoogleDriveFolderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoogleDriveFolderUpsertOne) DoNothing() *GoogleDriveFolderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoogleDriveFolderCreate.OnConflict
+// documentation for more info.
+func (u *GoogleDriveFolderUpsertOne) Update(set func(*GoogleDriveFolderUpsert)) *GoogleDriveFolderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoogleDriveFolderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (u *GoogleDriveFolderUpsertOne) SetConnectionID(v string) *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetConnectionID(v)
+	})
+}
+
+// UpdateConnectionID sets the "connection_id" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertOne) UpdateConnectionID() *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateConnectionID()
+	})
+}
+
+// SetDriveFolderID sets the "drive_folder_id" field.
+func (u *GoogleDriveFolderUpsertOne) SetDriveFolderID(v string) *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetDriveFolderID(v)
+	})
+}
+
+// UpdateDriveFolderID sets the "drive_folder_id" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertOne) UpdateDriveFolderID() *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateDriveFolderID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *GoogleDriveFolderUpsertOne) SetName(v string) *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertOne) UpdateName() *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *GoogleDriveFolderUpsertOne) SetPath(v string) *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertOne) UpdatePath() *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// ClearPath clears the value of the "path" field.
+func (u *GoogleDriveFolderUpsertOne) ClearPath() *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.ClearPath()
+	})
+}
+
+// SetParentFolderID sets the "parent_folder_id" field.
+func (u *GoogleDriveFolderUpsertOne) SetParentFolderID(v string) *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetParentFolderID(v)
+	})
+}
+
+// UpdateParentFolderID sets the "parent_folder_id" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertOne) UpdateParentFolderID() *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateParentFolderID()
+	})
+}
+
+// ClearParentFolderID clears the value of the "parent_folder_id" field.
+func (u *GoogleDriveFolderUpsertOne) ClearParentFolderID() *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.ClearParentFolderID()
+	})
+}
+
+// SetIsRoot sets the "is_root" field.
+func (u *GoogleDriveFolderUpsertOne) SetIsRoot(v bool) *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetIsRoot(v)
+	})
+}
+
+// UpdateIsRoot sets the "is_root" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertOne) UpdateIsRoot() *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateIsRoot()
+	})
+}
+
+// SetSyncEnabled sets the "sync_enabled" field.
+func (u *GoogleDriveFolderUpsertOne) SetSyncEnabled(v bool) *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetSyncEnabled(v)
+	})
+}
+
+// UpdateSyncEnabled sets the "sync_enabled" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertOne) UpdateSyncEnabled() *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateSyncEnabled()
+	})
+}
+
+// SetRecursive sets the "recursive" field.
+func (u *GoogleDriveFolderUpsertOne) SetRecursive(v bool) *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetRecursive(v)
+	})
+}
+
+// UpdateRecursive sets the "recursive" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertOne) UpdateRecursive() *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateRecursive()
+	})
+}
+
+// SetSyncDirection sets the "sync_direction" field.
+func (u *GoogleDriveFolderUpsertOne) SetSyncDirection(v googledrivefolder.SyncDirection) *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetSyncDirection(v)
+	})
+}
+
+// UpdateSyncDirection sets the "sync_direction" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertOne) UpdateSyncDirection() *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateSyncDirection()
+	})
+}
+
+// SetFileCount sets the "file_count" field.
+func (u *GoogleDriveFolderUpsertOne) SetFileCount(v int64) *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetFileCount(v)
+	})
+}
+
+// AddFileCount adds v to the "file_count" field.
+func (u *GoogleDriveFolderUpsertOne) AddFileCount(v int64) *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.AddFileCount(v)
+	})
+}
+
+// UpdateFileCount sets the "file_count" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertOne) UpdateFileCount() *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateFileCount()
+	})
+}
+
+// SetTotalSizeBytes sets the "total_size_bytes" field.
+func (u *GoogleDriveFolderUpsertOne) SetTotalSizeBytes(v int64) *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetTotalSizeBytes(v)
+	})
+}
+
+// AddTotalSizeBytes adds v to the "total_size_bytes" field.
+func (u *GoogleDriveFolderUpsertOne) AddTotalSizeBytes(v int64) *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.AddTotalSizeBytes(v)
+	})
+}
+
+// UpdateTotalSizeBytes sets the "total_size_bytes" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertOne) UpdateTotalSizeBytes() *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateTotalSizeBytes()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoogleDriveFolderUpsertOne) SetUpdatedAt(v time.Time) *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertOne) UpdateUpdatedAt() *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLastScannedAt sets the "last_scanned_at" field.
+func (u *GoogleDriveFolderUpsertOne) SetLastScannedAt(v time.Time) *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetLastScannedAt(v)
+	})
+}
+
+// UpdateLastScannedAt sets the "last_scanned_at" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertOne) UpdateLastScannedAt() *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateLastScannedAt()
+	})
+}
+
+// ClearLastScannedAt clears the value of the "last_scanned_at" field.
+func (u *GoogleDriveFolderUpsertOne) ClearLastScannedAt() *GoogleDriveFolderUpsertOne {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.ClearLastScannedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GoogleDriveFolderUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoogleDriveFolderCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoogleDriveFolderUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GoogleDriveFolderUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: GoogleDriveFolderUpsertOne.ID is not supported by MySQL driver. Use GoogleDriveFolderUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GoogleDriveFolderUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // GoogleDriveFolderCreateBulk is the builder for creating many GoogleDriveFolder entities in bulk.
 type GoogleDriveFolderCreateBulk struct {
 	config
 	err      error
 	builders []*GoogleDriveFolderCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the GoogleDriveFolder entities in the database.
@@ -447,6 +1018,7 @@ func (_c *GoogleDriveFolderCreateBulk) Save(ctx context.Context) ([]*GoogleDrive
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -496,3 +1068,337 @@ func (_c *GoogleDriveFolderCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GoogleDriveFolder.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoogleDriveFolderUpsert) {
+//			SetConnectionID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoogleDriveFolderCreateBulk) OnConflict(opts ...sql.ConflictOption) *GoogleDriveFolderUpsertBulk {
+	_c.conflict = opts
+	return &GoogleDriveFolderUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GoogleDriveFolder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoogleDriveFolderCreateBulk) OnConflictColumns(columns ...string) *GoogleDriveFolderUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoogleDriveFolderUpsertBulk{
+		create: _c,
+	}
+}
+
+// GoogleDriveFolderUpsertBulk is the builder for "upsert"-ing
+// a bulk of GoogleDriveFolder nodes.
+type GoogleDriveFolderUpsertBulk struct {
+	create *GoogleDriveFolderCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GoogleDriveFolder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(googledrivefolder.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoogleDriveFolderUpsertBulk) UpdateNewValues() *GoogleDriveFolderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(googledrivefolder.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(googledrivefolder.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GoogleDriveFolder.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GoogleDriveFolderUpsertBulk) Ignore() *GoogleDriveFolderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoogleDriveFolderUpsertBulk) DoNothing() *GoogleDriveFolderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoogleDriveFolderCreateBulk.OnConflict
+// documentation for more info.
+func (u *GoogleDriveFolderUpsertBulk) Update(set func(*GoogleDriveFolderUpsert)) *GoogleDriveFolderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoogleDriveFolderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (u *GoogleDriveFolderUpsertBulk) SetConnectionID(v string) *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetConnectionID(v)
+	})
+}
+
+// UpdateConnectionID sets the "connection_id" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertBulk) UpdateConnectionID() *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateConnectionID()
+	})
+}
+
+// SetDriveFolderID sets the "drive_folder_id" field.
+func (u *GoogleDriveFolderUpsertBulk) SetDriveFolderID(v string) *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetDriveFolderID(v)
+	})
+}
+
+// UpdateDriveFolderID sets the "drive_folder_id" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertBulk) UpdateDriveFolderID() *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateDriveFolderID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *GoogleDriveFolderUpsertBulk) SetName(v string) *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertBulk) UpdateName() *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *GoogleDriveFolderUpsertBulk) SetPath(v string) *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertBulk) UpdatePath() *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// ClearPath clears the value of the "path" field.
+func (u *GoogleDriveFolderUpsertBulk) ClearPath() *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.ClearPath()
+	})
+}
+
+// SetParentFolderID sets the "parent_folder_id" field.
+func (u *GoogleDriveFolderUpsertBulk) SetParentFolderID(v string) *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetParentFolderID(v)
+	})
+}
+
+// UpdateParentFolderID sets the "parent_folder_id" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertBulk) UpdateParentFolderID() *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateParentFolderID()
+	})
+}
+
+// ClearParentFolderID clears the value of the "parent_folder_id" field.
+func (u *GoogleDriveFolderUpsertBulk) ClearParentFolderID() *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.ClearParentFolderID()
+	})
+}
+
+// SetIsRoot sets the "is_root" field.
+func (u *GoogleDriveFolderUpsertBulk) SetIsRoot(v bool) *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetIsRoot(v)
+	})
+}
+
+// UpdateIsRoot sets the "is_root" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertBulk) UpdateIsRoot() *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateIsRoot()
+	})
+}
+
+// SetSyncEnabled sets the "sync_enabled" field.
+func (u *GoogleDriveFolderUpsertBulk) SetSyncEnabled(v bool) *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetSyncEnabled(v)
+	})
+}
+
+// UpdateSyncEnabled sets the "sync_enabled" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertBulk) UpdateSyncEnabled() *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateSyncEnabled()
+	})
+}
+
+// SetRecursive sets the "recursive" field.
+func (u *GoogleDriveFolderUpsertBulk) SetRecursive(v bool) *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetRecursive(v)
+	})
+}
+
+// UpdateRecursive sets the "recursive" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertBulk) UpdateRecursive() *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateRecursive()
+	})
+}
+
+// SetSyncDirection sets the "sync_direction" field.
+func (u *GoogleDriveFolderUpsertBulk) SetSyncDirection(v googledrivefolder.SyncDirection) *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetSyncDirection(v)
+	})
+}
+
+// UpdateSyncDirection sets the "sync_direction" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertBulk) UpdateSyncDirection() *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateSyncDirection()
+	})
+}
+
+// SetFileCount sets the "file_count" field.
+func (u *GoogleDriveFolderUpsertBulk) SetFileCount(v int64) *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetFileCount(v)
+	})
+}
+
+// AddFileCount adds v to the "file_count" field.
+func (u *GoogleDriveFolderUpsertBulk) AddFileCount(v int64) *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.AddFileCount(v)
+	})
+}
+
+// UpdateFileCount sets the "file_count" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertBulk) UpdateFileCount() *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateFileCount()
+	})
+}
+
+// SetTotalSizeBytes sets the "total_size_bytes" field.
+func (u *GoogleDriveFolderUpsertBulk) SetTotalSizeBytes(v int64) *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetTotalSizeBytes(v)
+	})
+}
+
+// AddTotalSizeBytes adds v to the "total_size_bytes" field.
+func (u *GoogleDriveFolderUpsertBulk) AddTotalSizeBytes(v int64) *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.AddTotalSizeBytes(v)
+	})
+}
+
+// UpdateTotalSizeBytes sets the "total_size_bytes" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertBulk) UpdateTotalSizeBytes() *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateTotalSizeBytes()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoogleDriveFolderUpsertBulk) SetUpdatedAt(v time.Time) *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertBulk) UpdateUpdatedAt() *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLastScannedAt sets the "last_scanned_at" field.
+func (u *GoogleDriveFolderUpsertBulk) SetLastScannedAt(v time.Time) *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.SetLastScannedAt(v)
+	})
+}
+
+// UpdateLastScannedAt sets the "last_scanned_at" field to the value that was provided on create.
+func (u *GoogleDriveFolderUpsertBulk) UpdateLastScannedAt() *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.UpdateLastScannedAt()
+	})
+}
+
+// ClearLastScannedAt clears the value of the "last_scanned_at" field.
+func (u *GoogleDriveFolderUpsertBulk) ClearLastScannedAt() *GoogleDriveFolderUpsertBulk {
+	return u.Update(func(s *GoogleDriveFolderUpsert) {
+		s.ClearLastScannedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GoogleDriveFolderUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GoogleDriveFolderCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoogleDriveFolderCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoogleDriveFolderUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
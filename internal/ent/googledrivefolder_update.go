@@ -139,6 +139,20 @@ func (_u *GoogleDriveFolderUpdate) SetNillableSyncEnabled(v *bool) *GoogleDriveF
 	return _u
 }
 
+// SetRecursive sets the "recursive" field.
+func (_u *GoogleDriveFolderUpdate) SetRecursive(v bool) *GoogleDriveFolderUpdate {
+	_u.mutation.SetRecursive(v)
+	return _u
+}
+
+// SetNillableRecursive sets the "recursive" field if the given value is not nil.
+func (_u *GoogleDriveFolderUpdate) SetNillableRecursive(v *bool) *GoogleDriveFolderUpdate {
+	if v != nil {
+		_u.SetRecursive(*v)
+	}
+	return _u
+}
+
 // SetSyncDirection sets the "sync_direction" field.
 func (_u *GoogleDriveFolderUpdate) SetSyncDirection(v googledrivefolder.SyncDirection) *GoogleDriveFolderUpdate {
 	_u.mutation.SetSyncDirection(v)
@@ -337,6 +351,9 @@ func (_u *GoogleDriveFolderUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.SyncEnabled(); ok {
 		_spec.SetField(googledrivefolder.FieldSyncEnabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Recursive(); ok {
+		_spec.SetField(googledrivefolder.FieldRecursive, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.SyncDirection(); ok {
 		_spec.SetField(googledrivefolder.FieldSyncDirection, field.TypeEnum, value)
 	}
@@ -520,6 +537,20 @@ func (_u *GoogleDriveFolderUpdateOne) SetNillableSyncEnabled(v *bool) *GoogleDri
 	return _u
 }
 
+// SetRecursive sets the "recursive" field.
+func (_u *GoogleDriveFolderUpdateOne) SetRecursive(v bool) *GoogleDriveFolderUpdateOne {
+	_u.mutation.SetRecursive(v)
+	return _u
+}
+
+// SetNillableRecursive sets the "recursive" field if the given value is not nil.
+func (_u *GoogleDriveFolderUpdateOne) SetNillableRecursive(v *bool) *GoogleDriveFolderUpdateOne {
+	if v != nil {
+		_u.SetRecursive(*v)
+	}
+	return _u
+}
+
 // SetSyncDirection sets the "sync_direction" field.
 func (_u *GoogleDriveFolderUpdateOne) SetSyncDirection(v googledrivefolder.SyncDirection) *GoogleDriveFolderUpdateOne {
 	_u.mutation.SetSyncDirection(v)
@@ -748,6 +779,9 @@ func (_u *GoogleDriveFolderUpdateOne) sqlSave(ctx context.Context) (_node *Googl
 	if value, ok := _u.mutation.SyncEnabled(); ok {
 		_spec.SetField(googledrivefolder.FieldSyncEnabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Recursive(); ok {
+		_spec.SetField(googledrivefolder.FieldRecursive, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.SyncDirection(); ok {
 		_spec.SetField(googledrivefolder.FieldSyncDirection, field.TypeEnum, value)
 	}
@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -19,6 +21,7 @@ type GoogleDriveSyncCreate struct {
 	config
 	mutation *GoogleDriveSyncMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetConnectionID sets the "connection_id" field.
@@ -415,6 +418,7 @@ func (_c *GoogleDriveSyncCreate) createSpec() (*GoogleDriveSync, *sqlgraph.Creat
 		_node = &GoogleDriveSync{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(googledrivesync.Table, sqlgraph.NewFieldSpec(googledrivesync.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -503,11 +507,722 @@ func (_c *GoogleDriveSyncCreate) createSpec() (*GoogleDriveSync, *sqlgraph.Creat
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GoogleDriveSync.Create().
+//		SetConnectionID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoogleDriveSyncUpsert) {
+//			SetConnectionID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoogleDriveSyncCreate) OnConflict(opts ...sql.ConflictOption) *GoogleDriveSyncUpsertOne {
+	_c.conflict = opts
+	return &GoogleDriveSyncUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GoogleDriveSync.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoogleDriveSyncCreate) OnConflictColumns(columns ...string) *GoogleDriveSyncUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoogleDriveSyncUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GoogleDriveSyncUpsertOne is the builder for "upsert"-ing
+	//  one GoogleDriveSync node.
+	GoogleDriveSyncUpsertOne struct {
+		create *GoogleDriveSyncCreate
+	}
+
+	// GoogleDriveSyncUpsert is the "OnConflict" setter.
+	GoogleDriveSyncUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetConnectionID sets the "connection_id" field.
+func (u *GoogleDriveSyncUpsert) SetConnectionID(v string) *GoogleDriveSyncUpsert {
+	u.Set(googledrivesync.FieldConnectionID, v)
+	return u
+}
+
+// UpdateConnectionID sets the "connection_id" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsert) UpdateConnectionID() *GoogleDriveSyncUpsert {
+	u.SetExcluded(googledrivesync.FieldConnectionID)
+	return u
+}
+
+// SetFolderID sets the "folder_id" field.
+func (u *GoogleDriveSyncUpsert) SetFolderID(v string) *GoogleDriveSyncUpsert {
+	u.Set(googledrivesync.FieldFolderID, v)
+	return u
+}
+
+// UpdateFolderID sets the "folder_id" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsert) UpdateFolderID() *GoogleDriveSyncUpsert {
+	u.SetExcluded(googledrivesync.FieldFolderID)
+	return u
+}
+
+// ClearFolderID clears the value of the "folder_id" field.
+func (u *GoogleDriveSyncUpsert) ClearFolderID() *GoogleDriveSyncUpsert {
+	u.SetNull(googledrivesync.FieldFolderID)
+	return u
+}
+
+// SetSyncType sets the "sync_type" field.
+func (u *GoogleDriveSyncUpsert) SetSyncType(v googledrivesync.SyncType) *GoogleDriveSyncUpsert {
+	u.Set(googledrivesync.FieldSyncType, v)
+	return u
+}
+
+// UpdateSyncType sets the "sync_type" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsert) UpdateSyncType() *GoogleDriveSyncUpsert {
+	u.SetExcluded(googledrivesync.FieldSyncType)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *GoogleDriveSyncUpsert) SetStatus(v googledrivesync.Status) *GoogleDriveSyncUpsert {
+	u.Set(googledrivesync.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsert) UpdateStatus() *GoogleDriveSyncUpsert {
+	u.SetExcluded(googledrivesync.FieldStatus)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *GoogleDriveSyncUpsert) SetStartedAt(v time.Time) *GoogleDriveSyncUpsert {
+	u.Set(googledrivesync.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsert) UpdateStartedAt() *GoogleDriveSyncUpsert {
+	u.SetExcluded(googledrivesync.FieldStartedAt)
+	return u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *GoogleDriveSyncUpsert) ClearStartedAt() *GoogleDriveSyncUpsert {
+	u.SetNull(googledrivesync.FieldStartedAt)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *GoogleDriveSyncUpsert) SetCompletedAt(v time.Time) *GoogleDriveSyncUpsert {
+	u.Set(googledrivesync.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsert) UpdateCompletedAt() *GoogleDriveSyncUpsert {
+	u.SetExcluded(googledrivesync.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *GoogleDriveSyncUpsert) ClearCompletedAt() *GoogleDriveSyncUpsert {
+	u.SetNull(googledrivesync.FieldCompletedAt)
+	return u
+}
+
+// SetFilesScanned sets the "files_scanned" field.
+func (u *GoogleDriveSyncUpsert) SetFilesScanned(v int) *GoogleDriveSyncUpsert {
+	u.Set(googledrivesync.FieldFilesScanned, v)
+	return u
+}
+
+// UpdateFilesScanned sets the "files_scanned" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsert) UpdateFilesScanned() *GoogleDriveSyncUpsert {
+	u.SetExcluded(googledrivesync.FieldFilesScanned)
+	return u
+}
+
+// AddFilesScanned adds v to the "files_scanned" field.
+func (u *GoogleDriveSyncUpsert) AddFilesScanned(v int) *GoogleDriveSyncUpsert {
+	u.Add(googledrivesync.FieldFilesScanned, v)
+	return u
+}
+
+// SetFilesDownloaded sets the "files_downloaded" field.
+func (u *GoogleDriveSyncUpsert) SetFilesDownloaded(v int) *GoogleDriveSyncUpsert {
+	u.Set(googledrivesync.FieldFilesDownloaded, v)
+	return u
+}
+
+// UpdateFilesDownloaded sets the "files_downloaded" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsert) UpdateFilesDownloaded() *GoogleDriveSyncUpsert {
+	u.SetExcluded(googledrivesync.FieldFilesDownloaded)
+	return u
+}
+
+// AddFilesDownloaded adds v to the "files_downloaded" field.
+func (u *GoogleDriveSyncUpsert) AddFilesDownloaded(v int) *GoogleDriveSyncUpsert {
+	u.Add(googledrivesync.FieldFilesDownloaded, v)
+	return u
+}
+
+// SetFilesUploaded sets the "files_uploaded" field.
+func (u *GoogleDriveSyncUpsert) SetFilesUploaded(v int) *GoogleDriveSyncUpsert {
+	u.Set(googledrivesync.FieldFilesUploaded, v)
+	return u
+}
+
+// UpdateFilesUploaded sets the "files_uploaded" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsert) UpdateFilesUploaded() *GoogleDriveSyncUpsert {
+	u.SetExcluded(googledrivesync.FieldFilesUploaded)
+	return u
+}
+
+// AddFilesUploaded adds v to the "files_uploaded" field.
+func (u *GoogleDriveSyncUpsert) AddFilesUploaded(v int) *GoogleDriveSyncUpsert {
+	u.Add(googledrivesync.FieldFilesUploaded, v)
+	return u
+}
+
+// SetFilesDeleted sets the "files_deleted" field.
+func (u *GoogleDriveSyncUpsert) SetFilesDeleted(v int) *GoogleDriveSyncUpsert {
+	u.Set(googledrivesync.FieldFilesDeleted, v)
+	return u
+}
+
+// UpdateFilesDeleted sets the "files_deleted" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsert) UpdateFilesDeleted() *GoogleDriveSyncUpsert {
+	u.SetExcluded(googledrivesync.FieldFilesDeleted)
+	return u
+}
+
+// AddFilesDeleted adds v to the "files_deleted" field.
+func (u *GoogleDriveSyncUpsert) AddFilesDeleted(v int) *GoogleDriveSyncUpsert {
+	u.Add(googledrivesync.FieldFilesDeleted, v)
+	return u
+}
+
+// SetFilesFailed sets the "files_failed" field.
+func (u *GoogleDriveSyncUpsert) SetFilesFailed(v int) *GoogleDriveSyncUpsert {
+	u.Set(googledrivesync.FieldFilesFailed, v)
+	return u
+}
+
+// UpdateFilesFailed sets the "files_failed" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsert) UpdateFilesFailed() *GoogleDriveSyncUpsert {
+	u.SetExcluded(googledrivesync.FieldFilesFailed)
+	return u
+}
+
+// AddFilesFailed adds v to the "files_failed" field.
+func (u *GoogleDriveSyncUpsert) AddFilesFailed(v int) *GoogleDriveSyncUpsert {
+	u.Add(googledrivesync.FieldFilesFailed, v)
+	return u
+}
+
+// SetBytesTransferred sets the "bytes_transferred" field.
+func (u *GoogleDriveSyncUpsert) SetBytesTransferred(v int64) *GoogleDriveSyncUpsert {
+	u.Set(googledrivesync.FieldBytesTransferred, v)
+	return u
+}
+
+// UpdateBytesTransferred sets the "bytes_transferred" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsert) UpdateBytesTransferred() *GoogleDriveSyncUpsert {
+	u.SetExcluded(googledrivesync.FieldBytesTransferred)
+	return u
+}
+
+// AddBytesTransferred adds v to the "bytes_transferred" field.
+func (u *GoogleDriveSyncUpsert) AddBytesTransferred(v int64) *GoogleDriveSyncUpsert {
+	u.Add(googledrivesync.FieldBytesTransferred, v)
+	return u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *GoogleDriveSyncUpsert) SetErrorMessage(v string) *GoogleDriveSyncUpsert {
+	u.Set(googledrivesync.FieldErrorMessage, v)
+	return u
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsert) UpdateErrorMessage() *GoogleDriveSyncUpsert {
+	u.SetExcluded(googledrivesync.FieldErrorMessage)
+	return u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *GoogleDriveSyncUpsert) ClearErrorMessage() *GoogleDriveSyncUpsert {
+	u.SetNull(googledrivesync.FieldErrorMessage)
+	return u
+}
+
+// SetErrorDetails sets the "error_details" field.
+func (u *GoogleDriveSyncUpsert) SetErrorDetails(v map[string]interface{}) *GoogleDriveSyncUpsert {
+	u.Set(googledrivesync.FieldErrorDetails, v)
+	return u
+}
+
+// UpdateErrorDetails sets the "error_details" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsert) UpdateErrorDetails() *GoogleDriveSyncUpsert {
+	u.SetExcluded(googledrivesync.FieldErrorDetails)
+	return u
+}
+
+// ClearErrorDetails clears the value of the "error_details" field.
+func (u *GoogleDriveSyncUpsert) ClearErrorDetails() *GoogleDriveSyncUpsert {
+	u.SetNull(googledrivesync.FieldErrorDetails)
+	return u
+}
+
+// SetChangeToken sets the "change_token" field.
+func (u *GoogleDriveSyncUpsert) SetChangeToken(v string) *GoogleDriveSyncUpsert {
+	u.Set(googledrivesync.FieldChangeToken, v)
+	return u
+}
+
+// UpdateChangeToken sets the "change_token" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsert) UpdateChangeToken() *GoogleDriveSyncUpsert {
+	u.SetExcluded(googledrivesync.FieldChangeToken)
+	return u
+}
+
+// ClearChangeToken clears the value of the "change_token" field.
+func (u *GoogleDriveSyncUpsert) ClearChangeToken() *GoogleDriveSyncUpsert {
+	u.SetNull(googledrivesync.FieldChangeToken)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoogleDriveSyncUpsert) SetUpdatedAt(v time.Time) *GoogleDriveSyncUpsert {
+	u.Set(googledrivesync.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsert) UpdateUpdatedAt() *GoogleDriveSyncUpsert {
+	u.SetExcluded(googledrivesync.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.GoogleDriveSync.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(googledrivesync.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoogleDriveSyncUpsertOne) UpdateNewValues() *GoogleDriveSyncUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(googledrivesync.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(googledrivesync.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GoogleDriveSync.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GoogleDriveSyncUpsertOne) Ignore() *GoogleDriveSyncUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoogleDriveSyncUpsertOne) DoNothing() *GoogleDriveSyncUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoogleDriveSyncCreate.OnConflict
+// documentation for more info.
+func (u *GoogleDriveSyncUpsertOne) Update(set func(*GoogleDriveSyncUpsert)) *GoogleDriveSyncUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoogleDriveSyncUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (u *GoogleDriveSyncUpsertOne) SetConnectionID(v string) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetConnectionID(v)
+	})
+}
+
+// UpdateConnectionID sets the "connection_id" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertOne) UpdateConnectionID() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateConnectionID()
+	})
+}
+
+// SetFolderID sets the "folder_id" field.
+func (u *GoogleDriveSyncUpsertOne) SetFolderID(v string) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetFolderID(v)
+	})
+}
+
+// UpdateFolderID sets the "folder_id" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertOne) UpdateFolderID() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateFolderID()
+	})
+}
+
+// ClearFolderID clears the value of the "folder_id" field.
+func (u *GoogleDriveSyncUpsertOne) ClearFolderID() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.ClearFolderID()
+	})
+}
+
+// SetSyncType sets the "sync_type" field.
+func (u *GoogleDriveSyncUpsertOne) SetSyncType(v googledrivesync.SyncType) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetSyncType(v)
+	})
+}
+
+// UpdateSyncType sets the "sync_type" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertOne) UpdateSyncType() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateSyncType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *GoogleDriveSyncUpsertOne) SetStatus(v googledrivesync.Status) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertOne) UpdateStatus() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *GoogleDriveSyncUpsertOne) SetStartedAt(v time.Time) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertOne) UpdateStartedAt() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *GoogleDriveSyncUpsertOne) ClearStartedAt() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *GoogleDriveSyncUpsertOne) SetCompletedAt(v time.Time) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertOne) UpdateCompletedAt() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *GoogleDriveSyncUpsertOne) ClearCompletedAt() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetFilesScanned sets the "files_scanned" field.
+func (u *GoogleDriveSyncUpsertOne) SetFilesScanned(v int) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetFilesScanned(v)
+	})
+}
+
+// AddFilesScanned adds v to the "files_scanned" field.
+func (u *GoogleDriveSyncUpsertOne) AddFilesScanned(v int) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.AddFilesScanned(v)
+	})
+}
+
+// UpdateFilesScanned sets the "files_scanned" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertOne) UpdateFilesScanned() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateFilesScanned()
+	})
+}
+
+// SetFilesDownloaded sets the "files_downloaded" field.
+func (u *GoogleDriveSyncUpsertOne) SetFilesDownloaded(v int) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetFilesDownloaded(v)
+	})
+}
+
+// AddFilesDownloaded adds v to the "files_downloaded" field.
+func (u *GoogleDriveSyncUpsertOne) AddFilesDownloaded(v int) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.AddFilesDownloaded(v)
+	})
+}
+
+// UpdateFilesDownloaded sets the "files_downloaded" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertOne) UpdateFilesDownloaded() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateFilesDownloaded()
+	})
+}
+
+// SetFilesUploaded sets the "files_uploaded" field.
+func (u *GoogleDriveSyncUpsertOne) SetFilesUploaded(v int) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetFilesUploaded(v)
+	})
+}
+
+// AddFilesUploaded adds v to the "files_uploaded" field.
+func (u *GoogleDriveSyncUpsertOne) AddFilesUploaded(v int) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.AddFilesUploaded(v)
+	})
+}
+
+// UpdateFilesUploaded sets the "files_uploaded" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertOne) UpdateFilesUploaded() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateFilesUploaded()
+	})
+}
+
+// SetFilesDeleted sets the "files_deleted" field.
+func (u *GoogleDriveSyncUpsertOne) SetFilesDeleted(v int) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetFilesDeleted(v)
+	})
+}
+
+// AddFilesDeleted adds v to the "files_deleted" field.
+func (u *GoogleDriveSyncUpsertOne) AddFilesDeleted(v int) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.AddFilesDeleted(v)
+	})
+}
+
+// UpdateFilesDeleted sets the "files_deleted" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertOne) UpdateFilesDeleted() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateFilesDeleted()
+	})
+}
+
+// SetFilesFailed sets the "files_failed" field.
+func (u *GoogleDriveSyncUpsertOne) SetFilesFailed(v int) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetFilesFailed(v)
+	})
+}
+
+// AddFilesFailed adds v to the "files_failed" field.
+func (u *GoogleDriveSyncUpsertOne) AddFilesFailed(v int) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.AddFilesFailed(v)
+	})
+}
+
+// UpdateFilesFailed sets the "files_failed" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertOne) UpdateFilesFailed() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateFilesFailed()
+	})
+}
+
+// SetBytesTransferred sets the "bytes_transferred" field.
+func (u *GoogleDriveSyncUpsertOne) SetBytesTransferred(v int64) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetBytesTransferred(v)
+	})
+}
+
+// AddBytesTransferred adds v to the "bytes_transferred" field.
+func (u *GoogleDriveSyncUpsertOne) AddBytesTransferred(v int64) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.AddBytesTransferred(v)
+	})
+}
+
+// UpdateBytesTransferred sets the "bytes_transferred" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertOne) UpdateBytesTransferred() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateBytesTransferred()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *GoogleDriveSyncUpsertOne) SetErrorMessage(v string) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertOne) UpdateErrorMessage() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *GoogleDriveSyncUpsertOne) ClearErrorMessage() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetErrorDetails sets the "error_details" field.
+func (u *GoogleDriveSyncUpsertOne) SetErrorDetails(v map[string]interface{}) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetErrorDetails(v)
+	})
+}
+
+// UpdateErrorDetails sets the "error_details" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertOne) UpdateErrorDetails() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateErrorDetails()
+	})
+}
+
+// ClearErrorDetails clears the value of the "error_details" field.
+func (u *GoogleDriveSyncUpsertOne) ClearErrorDetails() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.ClearErrorDetails()
+	})
+}
+
+// SetChangeToken sets the "change_token" field.
+func (u *GoogleDriveSyncUpsertOne) SetChangeToken(v string) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetChangeToken(v)
+	})
+}
+
+// UpdateChangeToken sets the "change_token" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertOne) UpdateChangeToken() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateChangeToken()
+	})
+}
+
+// ClearChangeToken clears the value of the "change_token" field.
+func (u *GoogleDriveSyncUpsertOne) ClearChangeToken() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.ClearChangeToken()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoogleDriveSyncUpsertOne) SetUpdatedAt(v time.Time) *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertOne) UpdateUpdatedAt() *GoogleDriveSyncUpsertOne {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GoogleDriveSyncUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoogleDriveSyncCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoogleDriveSyncUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GoogleDriveSyncUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: GoogleDriveSyncUpsertOne.ID is not supported by MySQL driver. Use GoogleDriveSyncUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GoogleDriveSyncUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // GoogleDriveSyncCreateBulk is the builder for creating many GoogleDriveSync entities in bulk.
 type GoogleDriveSyncCreateBulk struct {
 	config
 	err      error
 	builders []*GoogleDriveSyncCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the GoogleDriveSync entities in the database.
@@ -537,6 +1252,7 @@ func (_c *GoogleDriveSyncCreateBulk) Save(ctx context.Context) ([]*GoogleDriveSy
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -586,3 +1302,428 @@ func (_c *GoogleDriveSyncCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GoogleDriveSync.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoogleDriveSyncUpsert) {
+//			SetConnectionID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoogleDriveSyncCreateBulk) OnConflict(opts ...sql.ConflictOption) *GoogleDriveSyncUpsertBulk {
+	_c.conflict = opts
+	return &GoogleDriveSyncUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GoogleDriveSync.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoogleDriveSyncCreateBulk) OnConflictColumns(columns ...string) *GoogleDriveSyncUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoogleDriveSyncUpsertBulk{
+		create: _c,
+	}
+}
+
+// GoogleDriveSyncUpsertBulk is the builder for "upsert"-ing
+// a bulk of GoogleDriveSync nodes.
+type GoogleDriveSyncUpsertBulk struct {
+	create *GoogleDriveSyncCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GoogleDriveSync.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(googledrivesync.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoogleDriveSyncUpsertBulk) UpdateNewValues() *GoogleDriveSyncUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(googledrivesync.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(googledrivesync.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GoogleDriveSync.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GoogleDriveSyncUpsertBulk) Ignore() *GoogleDriveSyncUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoogleDriveSyncUpsertBulk) DoNothing() *GoogleDriveSyncUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoogleDriveSyncCreateBulk.OnConflict
+// documentation for more info.
+func (u *GoogleDriveSyncUpsertBulk) Update(set func(*GoogleDriveSyncUpsert)) *GoogleDriveSyncUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoogleDriveSyncUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (u *GoogleDriveSyncUpsertBulk) SetConnectionID(v string) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetConnectionID(v)
+	})
+}
+
+// UpdateConnectionID sets the "connection_id" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertBulk) UpdateConnectionID() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateConnectionID()
+	})
+}
+
+// SetFolderID sets the "folder_id" field.
+func (u *GoogleDriveSyncUpsertBulk) SetFolderID(v string) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetFolderID(v)
+	})
+}
+
+// UpdateFolderID sets the "folder_id" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertBulk) UpdateFolderID() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateFolderID()
+	})
+}
+
+// ClearFolderID clears the value of the "folder_id" field.
+func (u *GoogleDriveSyncUpsertBulk) ClearFolderID() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.ClearFolderID()
+	})
+}
+
+// SetSyncType sets the "sync_type" field.
+func (u *GoogleDriveSyncUpsertBulk) SetSyncType(v googledrivesync.SyncType) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetSyncType(v)
+	})
+}
+
+// UpdateSyncType sets the "sync_type" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertBulk) UpdateSyncType() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateSyncType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *GoogleDriveSyncUpsertBulk) SetStatus(v googledrivesync.Status) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertBulk) UpdateStatus() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *GoogleDriveSyncUpsertBulk) SetStartedAt(v time.Time) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertBulk) UpdateStartedAt() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *GoogleDriveSyncUpsertBulk) ClearStartedAt() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *GoogleDriveSyncUpsertBulk) SetCompletedAt(v time.Time) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertBulk) UpdateCompletedAt() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *GoogleDriveSyncUpsertBulk) ClearCompletedAt() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetFilesScanned sets the "files_scanned" field.
+func (u *GoogleDriveSyncUpsertBulk) SetFilesScanned(v int) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetFilesScanned(v)
+	})
+}
+
+// AddFilesScanned adds v to the "files_scanned" field.
+func (u *GoogleDriveSyncUpsertBulk) AddFilesScanned(v int) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.AddFilesScanned(v)
+	})
+}
+
+// UpdateFilesScanned sets the "files_scanned" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertBulk) UpdateFilesScanned() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateFilesScanned()
+	})
+}
+
+// SetFilesDownloaded sets the "files_downloaded" field.
+func (u *GoogleDriveSyncUpsertBulk) SetFilesDownloaded(v int) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetFilesDownloaded(v)
+	})
+}
+
+// AddFilesDownloaded adds v to the "files_downloaded" field.
+func (u *GoogleDriveSyncUpsertBulk) AddFilesDownloaded(v int) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.AddFilesDownloaded(v)
+	})
+}
+
+// UpdateFilesDownloaded sets the "files_downloaded" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertBulk) UpdateFilesDownloaded() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateFilesDownloaded()
+	})
+}
+
+// SetFilesUploaded sets the "files_uploaded" field.
+func (u *GoogleDriveSyncUpsertBulk) SetFilesUploaded(v int) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetFilesUploaded(v)
+	})
+}
+
+// AddFilesUploaded adds v to the "files_uploaded" field.
+func (u *GoogleDriveSyncUpsertBulk) AddFilesUploaded(v int) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.AddFilesUploaded(v)
+	})
+}
+
+// UpdateFilesUploaded sets the "files_uploaded" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertBulk) UpdateFilesUploaded() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateFilesUploaded()
+	})
+}
+
+// SetFilesDeleted sets the "files_deleted" field.
+func (u *GoogleDriveSyncUpsertBulk) SetFilesDeleted(v int) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetFilesDeleted(v)
+	})
+}
+
+// AddFilesDeleted adds v to the "files_deleted" field.
+func (u *GoogleDriveSyncUpsertBulk) AddFilesDeleted(v int) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.AddFilesDeleted(v)
+	})
+}
+
+// UpdateFilesDeleted sets the "files_deleted" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertBulk) UpdateFilesDeleted() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateFilesDeleted()
+	})
+}
+
+// SetFilesFailed sets the "files_failed" field.
+func (u *GoogleDriveSyncUpsertBulk) SetFilesFailed(v int) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetFilesFailed(v)
+	})
+}
+
+// AddFilesFailed adds v to the "files_failed" field.
+func (u *GoogleDriveSyncUpsertBulk) AddFilesFailed(v int) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.AddFilesFailed(v)
+	})
+}
+
+// UpdateFilesFailed sets the "files_failed" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertBulk) UpdateFilesFailed() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateFilesFailed()
+	})
+}
+
+// SetBytesTransferred sets the "bytes_transferred" field.
+func (u *GoogleDriveSyncUpsertBulk) SetBytesTransferred(v int64) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetBytesTransferred(v)
+	})
+}
+
+// AddBytesTransferred adds v to the "bytes_transferred" field.
+func (u *GoogleDriveSyncUpsertBulk) AddBytesTransferred(v int64) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.AddBytesTransferred(v)
+	})
+}
+
+// UpdateBytesTransferred sets the "bytes_transferred" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertBulk) UpdateBytesTransferred() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateBytesTransferred()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *GoogleDriveSyncUpsertBulk) SetErrorMessage(v string) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertBulk) UpdateErrorMessage() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *GoogleDriveSyncUpsertBulk) ClearErrorMessage() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetErrorDetails sets the "error_details" field.
+func (u *GoogleDriveSyncUpsertBulk) SetErrorDetails(v map[string]interface{}) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetErrorDetails(v)
+	})
+}
+
+// UpdateErrorDetails sets the "error_details" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertBulk) UpdateErrorDetails() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateErrorDetails()
+	})
+}
+
+// ClearErrorDetails clears the value of the "error_details" field.
+func (u *GoogleDriveSyncUpsertBulk) ClearErrorDetails() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.ClearErrorDetails()
+	})
+}
+
+// SetChangeToken sets the "change_token" field.
+func (u *GoogleDriveSyncUpsertBulk) SetChangeToken(v string) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetChangeToken(v)
+	})
+}
+
+// UpdateChangeToken sets the "change_token" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertBulk) UpdateChangeToken() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateChangeToken()
+	})
+}
+
+// ClearChangeToken clears the value of the "change_token" field.
+func (u *GoogleDriveSyncUpsertBulk) ClearChangeToken() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.ClearChangeToken()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoogleDriveSyncUpsertBulk) SetUpdatedAt(v time.Time) *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoogleDriveSyncUpsertBulk) UpdateUpdatedAt() *GoogleDriveSyncUpsertBulk {
+	return u.Update(func(s *GoogleDriveSyncUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GoogleDriveSyncUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GoogleDriveSyncCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoogleDriveSyncCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoogleDriveSyncUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
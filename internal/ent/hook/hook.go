@@ -8,6 +8,138 @@ import (
 	"fmt"
 )
 
+// The AccountDeletionFunc type is an adapter to allow the use of ordinary
+// function as AccountDeletion mutator.
+type AccountDeletionFunc func(context.Context, *ent.AccountDeletionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AccountDeletionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AccountDeletionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AccountDeletionMutation", m)
+}
+
+// The AlertFunc type is an adapter to allow the use of ordinary
+// function as Alert mutator.
+type AlertFunc func(context.Context, *ent.AlertMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AlertFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AlertMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AlertMutation", m)
+}
+
+// The AlertPreferenceFunc type is an adapter to allow the use of ordinary
+// function as AlertPreference mutator.
+type AlertPreferenceFunc func(context.Context, *ent.AlertPreferenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AlertPreferenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AlertPreferenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AlertPreferenceMutation", m)
+}
+
+// The AttachmentBlobFunc type is an adapter to allow the use of ordinary
+// function as AttachmentBlob mutator.
+type AttachmentBlobFunc func(context.Context, *ent.AttachmentBlobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AttachmentBlobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AttachmentBlobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AttachmentBlobMutation", m)
+}
+
+// The AttachmentLinkFunc type is an adapter to allow the use of ordinary
+// function as AttachmentLink mutator.
+type AttachmentLinkFunc func(context.Context, *ent.AttachmentLinkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AttachmentLinkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AttachmentLinkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AttachmentLinkMutation", m)
+}
+
+// The BudgetReallocationFunc type is an adapter to allow the use of ordinary
+// function as BudgetReallocation mutator.
+type BudgetReallocationFunc func(context.Context, *ent.BudgetReallocationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BudgetReallocationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BudgetReallocationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BudgetReallocationMutation", m)
+}
+
+// The BulkOperationFunc type is an adapter to allow the use of ordinary
+// function as BulkOperation mutator.
+type BulkOperationFunc func(context.Context, *ent.BulkOperationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BulkOperationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BulkOperationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BulkOperationMutation", m)
+}
+
+// The CardAccountFunc type is an adapter to allow the use of ordinary
+// function as CardAccount mutator.
+type CardAccountFunc func(context.Context, *ent.CardAccountMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CardAccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CardAccountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CardAccountMutation", m)
+}
+
+// The CategorizationRuleFunc type is an adapter to allow the use of ordinary
+// function as CategorizationRule mutator.
+type CategorizationRuleFunc func(context.Context, *ent.CategorizationRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CategorizationRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CategorizationRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CategorizationRuleMutation", m)
+}
+
+// The CategoryFeedbackFunc type is an adapter to allow the use of ordinary
+// function as CategoryFeedback mutator.
+type CategoryFeedbackFunc func(context.Context, *ent.CategoryFeedbackMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CategoryFeedbackFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CategoryFeedbackMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CategoryFeedbackMutation", m)
+}
+
+// The DebtFunc type is an adapter to allow the use of ordinary
+// function as Debt mutator.
+type DebtFunc func(context.Context, *ent.DebtMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DebtFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DebtMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DebtMutation", m)
+}
+
 // The EmailConnectionFunc type is an adapter to allow the use of ordinary
 // function as EmailConnection mutator.
 type EmailConnectionFunc func(context.Context, *ent.EmailConnectionMutation) (ent.Value, error)
@@ -32,6 +164,18 @@ func (f EmailLabelFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmailLabelMutation", m)
 }
 
+// The EmailMessageFunc type is an adapter to allow the use of ordinary
+// function as EmailMessage mutator.
+type EmailMessageFunc func(context.Context, *ent.EmailMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmailMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmailMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmailMessageMutation", m)
+}
+
 // The EmailSyncFunc type is an adapter to allow the use of ordinary
 // function as EmailSync mutator.
 type EmailSyncFunc func(context.Context, *ent.EmailSyncMutation) (ent.Value, error)
@@ -44,6 +188,54 @@ func (f EmailSyncFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmailSyncMutation", m)
 }
 
+// The EmailSyncFailureFunc type is an adapter to allow the use of ordinary
+// function as EmailSyncFailure mutator.
+type EmailSyncFailureFunc func(context.Context, *ent.EmailSyncFailureMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmailSyncFailureFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmailSyncFailureMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmailSyncFailureMutation", m)
+}
+
+// The EmergencyFundSnapshotFunc type is an adapter to allow the use of ordinary
+// function as EmergencyFundSnapshot mutator.
+type EmergencyFundSnapshotFunc func(context.Context, *ent.EmergencyFundSnapshotMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmergencyFundSnapshotFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmergencyFundSnapshotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmergencyFundSnapshotMutation", m)
+}
+
+// The EmergencyFundTargetFunc type is an adapter to allow the use of ordinary
+// function as EmergencyFundTarget mutator.
+type EmergencyFundTargetFunc func(context.Context, *ent.EmergencyFundTargetMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmergencyFundTargetFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmergencyFundTargetMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmergencyFundTargetMutation", m)
+}
+
+// The GoalFunc type is an adapter to allow the use of ordinary
+// function as Goal mutator.
+type GoalFunc func(context.Context, *ent.GoalMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GoalFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GoalMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GoalMutation", m)
+}
+
 // The GoogleDriveConnectionFunc type is an adapter to allow the use of ordinary
 // function as GoogleDriveConnection mutator.
 type GoogleDriveConnectionFunc func(context.Context, *ent.GoogleDriveConnectionMutation) (ent.Value, error)
@@ -80,6 +272,54 @@ func (f GoogleDriveSyncFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GoogleDriveSyncMutation", m)
 }
 
+// The HouseholdMemberFunc type is an adapter to allow the use of ordinary
+// function as HouseholdMember mutator.
+type HouseholdMemberFunc func(context.Context, *ent.HouseholdMemberMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HouseholdMemberFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.HouseholdMemberMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.HouseholdMemberMutation", m)
+}
+
+// The JobQueueFunc type is an adapter to allow the use of ordinary
+// function as JobQueue mutator.
+type JobQueueFunc func(context.Context, *ent.JobQueueMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JobQueueFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.JobQueueMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JobQueueMutation", m)
+}
+
+// The JobScheduleFunc type is an adapter to allow the use of ordinary
+// function as JobSchedule mutator.
+type JobScheduleFunc func(context.Context, *ent.JobScheduleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JobScheduleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.JobScheduleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JobScheduleMutation", m)
+}
+
+// The JobScheduleRunFunc type is an adapter to allow the use of ordinary
+// function as JobScheduleRun mutator.
+type JobScheduleRunFunc func(context.Context, *ent.JobScheduleRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JobScheduleRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.JobScheduleRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JobScheduleRunMutation", m)
+}
+
 // The LineItemFunc type is an adapter to allow the use of ordinary
 // function as LineItem mutator.
 type LineItemFunc func(context.Context, *ent.LineItemMutation) (ent.Value, error)
@@ -92,6 +332,102 @@ func (f LineItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LineItemMutation", m)
 }
 
+// The LiquidAccountFunc type is an adapter to allow the use of ordinary
+// function as LiquidAccount mutator.
+type LiquidAccountFunc func(context.Context, *ent.LiquidAccountMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LiquidAccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LiquidAccountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LiquidAccountMutation", m)
+}
+
+// The MembershipFunc type is an adapter to allow the use of ordinary
+// function as Membership mutator.
+type MembershipFunc func(context.Context, *ent.MembershipMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MembershipFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MembershipMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MembershipMutation", m)
+}
+
+// The MerchantFunc type is an adapter to allow the use of ordinary
+// function as Merchant mutator.
+type MerchantFunc func(context.Context, *ent.MerchantMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MerchantFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MerchantMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MerchantMutation", m)
+}
+
+// The MigrationStateFunc type is an adapter to allow the use of ordinary
+// function as MigrationState mutator.
+type MigrationStateFunc func(context.Context, *ent.MigrationStateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MigrationStateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MigrationStateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MigrationStateMutation", m)
+}
+
+// The NotificationFunc type is an adapter to allow the use of ordinary
+// function as Notification mutator.
+type NotificationFunc func(context.Context, *ent.NotificationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NotificationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NotificationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotificationMutation", m)
+}
+
+// The OAuthStateFunc type is an adapter to allow the use of ordinary
+// function as OAuthState mutator.
+type OAuthStateFunc func(context.Context, *ent.OAuthStateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OAuthStateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OAuthStateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OAuthStateMutation", m)
+}
+
+// The OCRFeedbackFunc type is an adapter to allow the use of ordinary
+// function as OCRFeedback mutator.
+type OCRFeedbackFunc func(context.Context, *ent.OCRFeedbackMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OCRFeedbackFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OCRFeedbackMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OCRFeedbackMutation", m)
+}
+
+// The OrganizationFunc type is an adapter to allow the use of ordinary
+// function as Organization mutator.
+type OrganizationFunc func(context.Context, *ent.OrganizationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OrganizationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OrganizationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrganizationMutation", m)
+}
+
 // The PipelineConfigFunc type is an adapter to allow the use of ordinary
 // function as PipelineConfig mutator.
 type PipelineConfigFunc func(context.Context, *ent.PipelineConfigMutation) (ent.Value, error)
@@ -128,6 +464,18 @@ func (f PipelineVersionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PipelineVersionMutation", m)
 }
 
+// The QueuedJobFunc type is an adapter to allow the use of ordinary
+// function as QueuedJob mutator.
+type QueuedJobFunc func(context.Context, *ent.QueuedJobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QueuedJobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.QueuedJobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QueuedJobMutation", m)
+}
+
 // The ReceiptFunc type is an adapter to allow the use of ordinary
 // function as Receipt mutator.
 type ReceiptFunc func(context.Context, *ent.ReceiptMutation) (ent.Value, error)
@@ -140,6 +488,54 @@ func (f ReceiptFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReceiptMutation", m)
 }
 
+// The ReceiptEventFunc type is an adapter to allow the use of ordinary
+// function as ReceiptEvent mutator.
+type ReceiptEventFunc func(context.Context, *ent.ReceiptEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReceiptEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReceiptEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReceiptEventMutation", m)
+}
+
+// The RoundingRuleFunc type is an adapter to allow the use of ordinary
+// function as RoundingRule mutator.
+type RoundingRuleFunc func(context.Context, *ent.RoundingRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RoundingRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RoundingRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoundingRuleMutation", m)
+}
+
+// The SavedFilterFunc type is an adapter to allow the use of ordinary
+// function as SavedFilter mutator.
+type SavedFilterFunc func(context.Context, *ent.SavedFilterMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SavedFilterFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SavedFilterMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SavedFilterMutation", m)
+}
+
+// The TaxYearFunc type is an adapter to allow the use of ordinary
+// function as TaxYear mutator.
+type TaxYearFunc func(context.Context, *ent.TaxYearMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaxYearFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaxYearMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaxYearMutation", m)
+}
+
 // The TransactionFunc type is an adapter to allow the use of ordinary
 // function as Transaction mutator.
 type TransactionFunc func(context.Context, *ent.TransactionMutation) (ent.Value, error)
@@ -152,6 +548,42 @@ func (f TransactionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TransactionMutation", m)
 }
 
+// The UserFunc type is an adapter to allow the use of ordinary
+// function as User mutator.
+type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserMutation", m)
+}
+
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebhookDelivery mutator.
+type WebhookDeliveryFunc func(context.Context, *ent.WebhookDeliveryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebhookDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookDeliveryMutation", m)
+}
+
+// The WebhookEndpointFunc type is an adapter to allow the use of ordinary
+// function as WebhookEndpoint mutator.
+type WebhookEndpointFunc func(context.Context, *ent.WebhookEndpointMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookEndpointFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebhookEndpointMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookEndpointMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -0,0 +1,725 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/householdmember"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HouseholdMemberCreate is the builder for creating a HouseholdMember entity.
+type HouseholdMemberCreate struct {
+	config
+	mutation *HouseholdMemberMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *HouseholdMemberCreate) SetUserID(v string) *HouseholdMemberCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *HouseholdMemberCreate) SetName(v string) *HouseholdMemberCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetConnectionIds sets the "connection_ids" field.
+func (_c *HouseholdMemberCreate) SetConnectionIds(v []string) *HouseholdMemberCreate {
+	_c.mutation.SetConnectionIds(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *HouseholdMemberCreate) SetCreatedAt(v time.Time) *HouseholdMemberCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *HouseholdMemberCreate) SetNillableCreatedAt(v *time.Time) *HouseholdMemberCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *HouseholdMemberCreate) SetUpdatedAt(v time.Time) *HouseholdMemberCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *HouseholdMemberCreate) SetNillableUpdatedAt(v *time.Time) *HouseholdMemberCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *HouseholdMemberCreate) SetID(v string) *HouseholdMemberCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the HouseholdMemberMutation object of the builder.
+func (_c *HouseholdMemberCreate) Mutation() *HouseholdMemberMutation {
+	return _c.mutation
+}
+
+// Save creates the HouseholdMember in the database.
+func (_c *HouseholdMemberCreate) Save(ctx context.Context) (*HouseholdMember, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *HouseholdMemberCreate) SaveX(ctx context.Context) *HouseholdMember {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HouseholdMemberCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HouseholdMemberCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *HouseholdMemberCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := householdmember.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := householdmember.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *HouseholdMemberCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "HouseholdMember.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := householdmember.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "HouseholdMember.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "HouseholdMember.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := householdmember.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "HouseholdMember.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "HouseholdMember.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "HouseholdMember.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *HouseholdMemberCreate) sqlSave(ctx context.Context) (*HouseholdMember, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected HouseholdMember.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *HouseholdMemberCreate) createSpec() (*HouseholdMember, *sqlgraph.CreateSpec) {
+	var (
+		_node = &HouseholdMember{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(householdmember.Table, sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(householdmember.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(householdmember.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.ConnectionIds(); ok {
+		_spec.SetField(householdmember.FieldConnectionIds, field.TypeJSON, value)
+		_node.ConnectionIds = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(householdmember.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(householdmember.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.HouseholdMember.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HouseholdMemberUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *HouseholdMemberCreate) OnConflict(opts ...sql.ConflictOption) *HouseholdMemberUpsertOne {
+	_c.conflict = opts
+	return &HouseholdMemberUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.HouseholdMember.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *HouseholdMemberCreate) OnConflictColumns(columns ...string) *HouseholdMemberUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &HouseholdMemberUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// HouseholdMemberUpsertOne is the builder for "upsert"-ing
+	//  one HouseholdMember node.
+	HouseholdMemberUpsertOne struct {
+		create *HouseholdMemberCreate
+	}
+
+	// HouseholdMemberUpsert is the "OnConflict" setter.
+	HouseholdMemberUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *HouseholdMemberUpsert) SetUserID(v string) *HouseholdMemberUpsert {
+	u.Set(householdmember.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *HouseholdMemberUpsert) UpdateUserID() *HouseholdMemberUpsert {
+	u.SetExcluded(householdmember.FieldUserID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *HouseholdMemberUpsert) SetName(v string) *HouseholdMemberUpsert {
+	u.Set(householdmember.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *HouseholdMemberUpsert) UpdateName() *HouseholdMemberUpsert {
+	u.SetExcluded(householdmember.FieldName)
+	return u
+}
+
+// SetConnectionIds sets the "connection_ids" field.
+func (u *HouseholdMemberUpsert) SetConnectionIds(v []string) *HouseholdMemberUpsert {
+	u.Set(householdmember.FieldConnectionIds, v)
+	return u
+}
+
+// UpdateConnectionIds sets the "connection_ids" field to the value that was provided on create.
+func (u *HouseholdMemberUpsert) UpdateConnectionIds() *HouseholdMemberUpsert {
+	u.SetExcluded(householdmember.FieldConnectionIds)
+	return u
+}
+
+// ClearConnectionIds clears the value of the "connection_ids" field.
+func (u *HouseholdMemberUpsert) ClearConnectionIds() *HouseholdMemberUpsert {
+	u.SetNull(householdmember.FieldConnectionIds)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *HouseholdMemberUpsert) SetUpdatedAt(v time.Time) *HouseholdMemberUpsert {
+	u.Set(householdmember.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *HouseholdMemberUpsert) UpdateUpdatedAt() *HouseholdMemberUpsert {
+	u.SetExcluded(householdmember.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.HouseholdMember.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(householdmember.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HouseholdMemberUpsertOne) UpdateNewValues() *HouseholdMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(householdmember.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(householdmember.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.HouseholdMember.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *HouseholdMemberUpsertOne) Ignore() *HouseholdMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HouseholdMemberUpsertOne) DoNothing() *HouseholdMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HouseholdMemberCreate.OnConflict
+// documentation for more info.
+func (u *HouseholdMemberUpsertOne) Update(set func(*HouseholdMemberUpsert)) *HouseholdMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HouseholdMemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *HouseholdMemberUpsertOne) SetUserID(v string) *HouseholdMemberUpsertOne {
+	return u.Update(func(s *HouseholdMemberUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *HouseholdMemberUpsertOne) UpdateUserID() *HouseholdMemberUpsertOne {
+	return u.Update(func(s *HouseholdMemberUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *HouseholdMemberUpsertOne) SetName(v string) *HouseholdMemberUpsertOne {
+	return u.Update(func(s *HouseholdMemberUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *HouseholdMemberUpsertOne) UpdateName() *HouseholdMemberUpsertOne {
+	return u.Update(func(s *HouseholdMemberUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetConnectionIds sets the "connection_ids" field.
+func (u *HouseholdMemberUpsertOne) SetConnectionIds(v []string) *HouseholdMemberUpsertOne {
+	return u.Update(func(s *HouseholdMemberUpsert) {
+		s.SetConnectionIds(v)
+	})
+}
+
+// UpdateConnectionIds sets the "connection_ids" field to the value that was provided on create.
+func (u *HouseholdMemberUpsertOne) UpdateConnectionIds() *HouseholdMemberUpsertOne {
+	return u.Update(func(s *HouseholdMemberUpsert) {
+		s.UpdateConnectionIds()
+	})
+}
+
+// ClearConnectionIds clears the value of the "connection_ids" field.
+func (u *HouseholdMemberUpsertOne) ClearConnectionIds() *HouseholdMemberUpsertOne {
+	return u.Update(func(s *HouseholdMemberUpsert) {
+		s.ClearConnectionIds()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *HouseholdMemberUpsertOne) SetUpdatedAt(v time.Time) *HouseholdMemberUpsertOne {
+	return u.Update(func(s *HouseholdMemberUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *HouseholdMemberUpsertOne) UpdateUpdatedAt() *HouseholdMemberUpsertOne {
+	return u.Update(func(s *HouseholdMemberUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *HouseholdMemberUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HouseholdMemberCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HouseholdMemberUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *HouseholdMemberUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: HouseholdMemberUpsertOne.ID is not supported by MySQL driver. Use HouseholdMemberUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *HouseholdMemberUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// HouseholdMemberCreateBulk is the builder for creating many HouseholdMember entities in bulk.
+type HouseholdMemberCreateBulk struct {
+	config
+	err      error
+	builders []*HouseholdMemberCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the HouseholdMember entities in the database.
+func (_c *HouseholdMemberCreateBulk) Save(ctx context.Context) ([]*HouseholdMember, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*HouseholdMember, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HouseholdMemberMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *HouseholdMemberCreateBulk) SaveX(ctx context.Context) []*HouseholdMember {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HouseholdMemberCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HouseholdMemberCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.HouseholdMember.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HouseholdMemberUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *HouseholdMemberCreateBulk) OnConflict(opts ...sql.ConflictOption) *HouseholdMemberUpsertBulk {
+	_c.conflict = opts
+	return &HouseholdMemberUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.HouseholdMember.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *HouseholdMemberCreateBulk) OnConflictColumns(columns ...string) *HouseholdMemberUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &HouseholdMemberUpsertBulk{
+		create: _c,
+	}
+}
+
+// HouseholdMemberUpsertBulk is the builder for "upsert"-ing
+// a bulk of HouseholdMember nodes.
+type HouseholdMemberUpsertBulk struct {
+	create *HouseholdMemberCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.HouseholdMember.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(householdmember.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HouseholdMemberUpsertBulk) UpdateNewValues() *HouseholdMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(householdmember.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(householdmember.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.HouseholdMember.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *HouseholdMemberUpsertBulk) Ignore() *HouseholdMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HouseholdMemberUpsertBulk) DoNothing() *HouseholdMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HouseholdMemberCreateBulk.OnConflict
+// documentation for more info.
+func (u *HouseholdMemberUpsertBulk) Update(set func(*HouseholdMemberUpsert)) *HouseholdMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HouseholdMemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *HouseholdMemberUpsertBulk) SetUserID(v string) *HouseholdMemberUpsertBulk {
+	return u.Update(func(s *HouseholdMemberUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *HouseholdMemberUpsertBulk) UpdateUserID() *HouseholdMemberUpsertBulk {
+	return u.Update(func(s *HouseholdMemberUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *HouseholdMemberUpsertBulk) SetName(v string) *HouseholdMemberUpsertBulk {
+	return u.Update(func(s *HouseholdMemberUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *HouseholdMemberUpsertBulk) UpdateName() *HouseholdMemberUpsertBulk {
+	return u.Update(func(s *HouseholdMemberUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetConnectionIds sets the "connection_ids" field.
+func (u *HouseholdMemberUpsertBulk) SetConnectionIds(v []string) *HouseholdMemberUpsertBulk {
+	return u.Update(func(s *HouseholdMemberUpsert) {
+		s.SetConnectionIds(v)
+	})
+}
+
+// UpdateConnectionIds sets the "connection_ids" field to the value that was provided on create.
+func (u *HouseholdMemberUpsertBulk) UpdateConnectionIds() *HouseholdMemberUpsertBulk {
+	return u.Update(func(s *HouseholdMemberUpsert) {
+		s.UpdateConnectionIds()
+	})
+}
+
+// ClearConnectionIds clears the value of the "connection_ids" field.
+func (u *HouseholdMemberUpsertBulk) ClearConnectionIds() *HouseholdMemberUpsertBulk {
+	return u.Update(func(s *HouseholdMemberUpsert) {
+		s.ClearConnectionIds()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *HouseholdMemberUpsertBulk) SetUpdatedAt(v time.Time) *HouseholdMemberUpsertBulk {
+	return u.Update(func(s *HouseholdMemberUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *HouseholdMemberUpsertBulk) UpdateUpdatedAt() *HouseholdMemberUpsertBulk {
+	return u.Update(func(s *HouseholdMemberUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *HouseholdMemberUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the HouseholdMemberCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HouseholdMemberCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HouseholdMemberUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
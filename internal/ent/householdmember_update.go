@@ -0,0 +1,375 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/householdmember"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// HouseholdMemberUpdate is the builder for updating HouseholdMember entities.
+type HouseholdMemberUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HouseholdMemberMutation
+}
+
+// Where appends a list predicates to the HouseholdMemberUpdate builder.
+func (_u *HouseholdMemberUpdate) Where(ps ...predicate.HouseholdMember) *HouseholdMemberUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *HouseholdMemberUpdate) SetUserID(v string) *HouseholdMemberUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *HouseholdMemberUpdate) SetNillableUserID(v *string) *HouseholdMemberUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *HouseholdMemberUpdate) SetName(v string) *HouseholdMemberUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *HouseholdMemberUpdate) SetNillableName(v *string) *HouseholdMemberUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetConnectionIds sets the "connection_ids" field.
+func (_u *HouseholdMemberUpdate) SetConnectionIds(v []string) *HouseholdMemberUpdate {
+	_u.mutation.SetConnectionIds(v)
+	return _u
+}
+
+// AppendConnectionIds appends value to the "connection_ids" field.
+func (_u *HouseholdMemberUpdate) AppendConnectionIds(v []string) *HouseholdMemberUpdate {
+	_u.mutation.AppendConnectionIds(v)
+	return _u
+}
+
+// ClearConnectionIds clears the value of the "connection_ids" field.
+func (_u *HouseholdMemberUpdate) ClearConnectionIds() *HouseholdMemberUpdate {
+	_u.mutation.ClearConnectionIds()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *HouseholdMemberUpdate) SetUpdatedAt(v time.Time) *HouseholdMemberUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the HouseholdMemberMutation object of the builder.
+func (_u *HouseholdMemberUpdate) Mutation() *HouseholdMemberMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *HouseholdMemberUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HouseholdMemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *HouseholdMemberUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HouseholdMemberUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *HouseholdMemberUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := householdmember.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HouseholdMemberUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := householdmember.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "HouseholdMember.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := householdmember.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "HouseholdMember.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *HouseholdMemberUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(householdmember.Table, householdmember.Columns, sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(householdmember.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(householdmember.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ConnectionIds(); ok {
+		_spec.SetField(householdmember.FieldConnectionIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedConnectionIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, householdmember.FieldConnectionIds, value)
+		})
+	}
+	if _u.mutation.ConnectionIdsCleared() {
+		_spec.ClearField(householdmember.FieldConnectionIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(householdmember.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{householdmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// HouseholdMemberUpdateOne is the builder for updating a single HouseholdMember entity.
+type HouseholdMemberUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HouseholdMemberMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *HouseholdMemberUpdateOne) SetUserID(v string) *HouseholdMemberUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *HouseholdMemberUpdateOne) SetNillableUserID(v *string) *HouseholdMemberUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *HouseholdMemberUpdateOne) SetName(v string) *HouseholdMemberUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *HouseholdMemberUpdateOne) SetNillableName(v *string) *HouseholdMemberUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetConnectionIds sets the "connection_ids" field.
+func (_u *HouseholdMemberUpdateOne) SetConnectionIds(v []string) *HouseholdMemberUpdateOne {
+	_u.mutation.SetConnectionIds(v)
+	return _u
+}
+
+// AppendConnectionIds appends value to the "connection_ids" field.
+func (_u *HouseholdMemberUpdateOne) AppendConnectionIds(v []string) *HouseholdMemberUpdateOne {
+	_u.mutation.AppendConnectionIds(v)
+	return _u
+}
+
+// ClearConnectionIds clears the value of the "connection_ids" field.
+func (_u *HouseholdMemberUpdateOne) ClearConnectionIds() *HouseholdMemberUpdateOne {
+	_u.mutation.ClearConnectionIds()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *HouseholdMemberUpdateOne) SetUpdatedAt(v time.Time) *HouseholdMemberUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the HouseholdMemberMutation object of the builder.
+func (_u *HouseholdMemberUpdateOne) Mutation() *HouseholdMemberMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the HouseholdMemberUpdate builder.
+func (_u *HouseholdMemberUpdateOne) Where(ps ...predicate.HouseholdMember) *HouseholdMemberUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *HouseholdMemberUpdateOne) Select(field string, fields ...string) *HouseholdMemberUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated HouseholdMember entity.
+func (_u *HouseholdMemberUpdateOne) Save(ctx context.Context) (*HouseholdMember, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HouseholdMemberUpdateOne) SaveX(ctx context.Context) *HouseholdMember {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *HouseholdMemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HouseholdMemberUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *HouseholdMemberUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := householdmember.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HouseholdMemberUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := householdmember.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "HouseholdMember.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := householdmember.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "HouseholdMember.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *HouseholdMemberUpdateOne) sqlSave(ctx context.Context) (_node *HouseholdMember, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(householdmember.Table, householdmember.Columns, sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "HouseholdMember.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, householdmember.FieldID)
+		for _, f := range fields {
+			if !householdmember.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != householdmember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(householdmember.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(householdmember.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ConnectionIds(); ok {
+		_spec.SetField(householdmember.FieldConnectionIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedConnectionIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, householdmember.FieldConnectionIds, value)
+		})
+	}
+	if _u.mutation.ConnectionIdsCleared() {
+		_spec.ClearField(householdmember.FieldConnectionIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(householdmember.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &HouseholdMember{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{householdmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,604 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/jobqueue"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JobQueueCreate is the builder for creating a JobQueue entity.
+type JobQueueCreate struct {
+	config
+	mutation *JobQueueMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPaused sets the "paused" field.
+func (_c *JobQueueCreate) SetPaused(v bool) *JobQueueCreate {
+	_c.mutation.SetPaused(v)
+	return _c
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (_c *JobQueueCreate) SetNillablePaused(v *bool) *JobQueueCreate {
+	if v != nil {
+		_c.SetPaused(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *JobQueueCreate) SetCreatedAt(v time.Time) *JobQueueCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *JobQueueCreate) SetNillableCreatedAt(v *time.Time) *JobQueueCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *JobQueueCreate) SetUpdatedAt(v time.Time) *JobQueueCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *JobQueueCreate) SetNillableUpdatedAt(v *time.Time) *JobQueueCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *JobQueueCreate) SetID(v string) *JobQueueCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the JobQueueMutation object of the builder.
+func (_c *JobQueueCreate) Mutation() *JobQueueMutation {
+	return _c.mutation
+}
+
+// Save creates the JobQueue in the database.
+func (_c *JobQueueCreate) Save(ctx context.Context) (*JobQueue, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *JobQueueCreate) SaveX(ctx context.Context) *JobQueue {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JobQueueCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JobQueueCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *JobQueueCreate) defaults() {
+	if _, ok := _c.mutation.Paused(); !ok {
+		v := jobqueue.DefaultPaused
+		_c.mutation.SetPaused(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := jobqueue.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := jobqueue.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *JobQueueCreate) check() error {
+	if _, ok := _c.mutation.Paused(); !ok {
+		return &ValidationError{Name: "paused", err: errors.New(`ent: missing required field "JobQueue.paused"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "JobQueue.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "JobQueue.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *JobQueueCreate) sqlSave(ctx context.Context) (*JobQueue, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected JobQueue.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *JobQueueCreate) createSpec() (*JobQueue, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JobQueue{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(jobqueue.Table, sqlgraph.NewFieldSpec(jobqueue.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Paused(); ok {
+		_spec.SetField(jobqueue.FieldPaused, field.TypeBool, value)
+		_node.Paused = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(jobqueue.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(jobqueue.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JobQueue.Create().
+//		SetPaused(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobQueueUpsert) {
+//			SetPaused(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *JobQueueCreate) OnConflict(opts ...sql.ConflictOption) *JobQueueUpsertOne {
+	_c.conflict = opts
+	return &JobQueueUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JobQueue.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *JobQueueCreate) OnConflictColumns(columns ...string) *JobQueueUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &JobQueueUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// JobQueueUpsertOne is the builder for "upsert"-ing
+	//  one JobQueue node.
+	JobQueueUpsertOne struct {
+		create *JobQueueCreate
+	}
+
+	// JobQueueUpsert is the "OnConflict" setter.
+	JobQueueUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPaused sets the "paused" field.
+func (u *JobQueueUpsert) SetPaused(v bool) *JobQueueUpsert {
+	u.Set(jobqueue.FieldPaused, v)
+	return u
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *JobQueueUpsert) UpdatePaused() *JobQueueUpsert {
+	u.SetExcluded(jobqueue.FieldPaused)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobQueueUpsert) SetUpdatedAt(v time.Time) *JobQueueUpsert {
+	u.Set(jobqueue.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobQueueUpsert) UpdateUpdatedAt() *JobQueueUpsert {
+	u.SetExcluded(jobqueue.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.JobQueue.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(jobqueue.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JobQueueUpsertOne) UpdateNewValues() *JobQueueUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(jobqueue.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(jobqueue.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JobQueue.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *JobQueueUpsertOne) Ignore() *JobQueueUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobQueueUpsertOne) DoNothing() *JobQueueUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobQueueCreate.OnConflict
+// documentation for more info.
+func (u *JobQueueUpsertOne) Update(set func(*JobQueueUpsert)) *JobQueueUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobQueueUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPaused sets the "paused" field.
+func (u *JobQueueUpsertOne) SetPaused(v bool) *JobQueueUpsertOne {
+	return u.Update(func(s *JobQueueUpsert) {
+		s.SetPaused(v)
+	})
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *JobQueueUpsertOne) UpdatePaused() *JobQueueUpsertOne {
+	return u.Update(func(s *JobQueueUpsert) {
+		s.UpdatePaused()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobQueueUpsertOne) SetUpdatedAt(v time.Time) *JobQueueUpsertOne {
+	return u.Update(func(s *JobQueueUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobQueueUpsertOne) UpdateUpdatedAt() *JobQueueUpsertOne {
+	return u.Update(func(s *JobQueueUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *JobQueueUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobQueueCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobQueueUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *JobQueueUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: JobQueueUpsertOne.ID is not supported by MySQL driver. Use JobQueueUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *JobQueueUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// JobQueueCreateBulk is the builder for creating many JobQueue entities in bulk.
+type JobQueueCreateBulk struct {
+	config
+	err      error
+	builders []*JobQueueCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the JobQueue entities in the database.
+func (_c *JobQueueCreateBulk) Save(ctx context.Context) ([]*JobQueue, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*JobQueue, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobQueueMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *JobQueueCreateBulk) SaveX(ctx context.Context) []*JobQueue {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JobQueueCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JobQueueCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JobQueue.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobQueueUpsert) {
+//			SetPaused(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *JobQueueCreateBulk) OnConflict(opts ...sql.ConflictOption) *JobQueueUpsertBulk {
+	_c.conflict = opts
+	return &JobQueueUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JobQueue.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *JobQueueCreateBulk) OnConflictColumns(columns ...string) *JobQueueUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &JobQueueUpsertBulk{
+		create: _c,
+	}
+}
+
+// JobQueueUpsertBulk is the builder for "upsert"-ing
+// a bulk of JobQueue nodes.
+type JobQueueUpsertBulk struct {
+	create *JobQueueCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.JobQueue.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(jobqueue.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JobQueueUpsertBulk) UpdateNewValues() *JobQueueUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(jobqueue.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(jobqueue.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JobQueue.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *JobQueueUpsertBulk) Ignore() *JobQueueUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobQueueUpsertBulk) DoNothing() *JobQueueUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobQueueCreateBulk.OnConflict
+// documentation for more info.
+func (u *JobQueueUpsertBulk) Update(set func(*JobQueueUpsert)) *JobQueueUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobQueueUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPaused sets the "paused" field.
+func (u *JobQueueUpsertBulk) SetPaused(v bool) *JobQueueUpsertBulk {
+	return u.Update(func(s *JobQueueUpsert) {
+		s.SetPaused(v)
+	})
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *JobQueueUpsertBulk) UpdatePaused() *JobQueueUpsertBulk {
+	return u.Update(func(s *JobQueueUpsert) {
+		s.UpdatePaused()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobQueueUpsertBulk) SetUpdatedAt(v time.Time) *JobQueueUpsertBulk {
+	return u.Update(func(s *JobQueueUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobQueueUpsertBulk) UpdateUpdatedAt() *JobQueueUpsertBulk {
+	return u.Update(func(s *JobQueueUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *JobQueueUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the JobQueueCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobQueueCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobQueueUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/jobschedule"
+	"context"
+	"encoding/json/jsontext"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JobScheduleCreate is the builder for creating a JobSchedule entity.
+type JobScheduleCreate struct {
+	config
+	mutation *JobScheduleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *JobScheduleCreate) SetUserID(v string) *JobScheduleCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *JobScheduleCreate) SetName(v string) *JobScheduleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetJobType sets the "job_type" field.
+func (_c *JobScheduleCreate) SetJobType(v string) *JobScheduleCreate {
+	_c.mutation.SetJobType(v)
+	return _c
+}
+
+// SetFrequency sets the "frequency" field.
+func (_c *JobScheduleCreate) SetFrequency(v jobschedule.Frequency) *JobScheduleCreate {
+	_c.mutation.SetFrequency(v)
+	return _c
+}
+
+// SetParams sets the "params" field.
+func (_c *JobScheduleCreate) SetParams(v jsontext.Value) *JobScheduleCreate {
+	_c.mutation.SetParams(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *JobScheduleCreate) SetEnabled(v bool) *JobScheduleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *JobScheduleCreate) SetNillableEnabled(v *bool) *JobScheduleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_c *JobScheduleCreate) SetNextRunAt(v time.Time) *JobScheduleCreate {
+	_c.mutation.SetNextRunAt(v)
+	return _c
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_c *JobScheduleCreate) SetLastRunAt(v time.Time) *JobScheduleCreate {
+	_c.mutation.SetLastRunAt(v)
+	return _c
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_c *JobScheduleCreate) SetNillableLastRunAt(v *time.Time) *JobScheduleCreate {
+	if v != nil {
+		_c.SetLastRunAt(*v)
+	}
+	return _c
+}
+
+// SetLastStatus sets the "last_status" field.
+func (_c *JobScheduleCreate) SetLastStatus(v string) *JobScheduleCreate {
+	_c.mutation.SetLastStatus(v)
+	return _c
+}
+
+// SetNillableLastStatus sets the "last_status" field if the given value is not nil.
+func (_c *JobScheduleCreate) SetNillableLastStatus(v *string) *JobScheduleCreate {
+	if v != nil {
+		_c.SetLastStatus(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *JobScheduleCreate) SetLastError(v string) *JobScheduleCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *JobScheduleCreate) SetNillableLastError(v *string) *JobScheduleCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_c *JobScheduleCreate) SetConsecutiveFailures(v int) *JobScheduleCreate {
+	_c.mutation.SetConsecutiveFailures(v)
+	return _c
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_c *JobScheduleCreate) SetNillableConsecutiveFailures(v *int) *JobScheduleCreate {
+	if v != nil {
+		_c.SetConsecutiveFailures(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *JobScheduleCreate) SetCreatedAt(v time.Time) *JobScheduleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *JobScheduleCreate) SetNillableCreatedAt(v *time.Time) *JobScheduleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *JobScheduleCreate) SetUpdatedAt(v time.Time) *JobScheduleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *JobScheduleCreate) SetNillableUpdatedAt(v *time.Time) *JobScheduleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *JobScheduleCreate) SetID(v string) *JobScheduleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the JobScheduleMutation object of the builder.
+func (_c *JobScheduleCreate) Mutation() *JobScheduleMutation {
+	return _c.mutation
+}
+
+// Save creates the JobSchedule in the database.
+func (_c *JobScheduleCreate) Save(ctx context.Context) (*JobSchedule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *JobScheduleCreate) SaveX(ctx context.Context) *JobSchedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JobScheduleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JobScheduleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *JobScheduleCreate) defaults() {
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := jobschedule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.ConsecutiveFailures(); !ok {
+		v := jobschedule.DefaultConsecutiveFailures
+		_c.mutation.SetConsecutiveFailures(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := jobschedule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := jobschedule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *JobScheduleCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "JobSchedule.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := jobschedule.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "JobSchedule.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "JobSchedule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := jobschedule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "JobSchedule.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.JobType(); !ok {
+		return &ValidationError{Name: "job_type", err: errors.New(`ent: missing required field "JobSchedule.job_type"`)}
+	}
+	if v, ok := _c.mutation.JobType(); ok {
+		if err := jobschedule.JobTypeValidator(v); err != nil {
+			return &ValidationError{Name: "job_type", err: fmt.Errorf(`ent: validator failed for field "JobSchedule.job_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Frequency(); !ok {
+		return &ValidationError{Name: "frequency", err: errors.New(`ent: missing required field "JobSchedule.frequency"`)}
+	}
+	if v, ok := _c.mutation.Frequency(); ok {
+		if err := jobschedule.FrequencyValidator(v); err != nil {
+			return &ValidationError{Name: "frequency", err: fmt.Errorf(`ent: validator failed for field "JobSchedule.frequency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "JobSchedule.enabled"`)}
+	}
+	if _, ok := _c.mutation.NextRunAt(); !ok {
+		return &ValidationError{Name: "next_run_at", err: errors.New(`ent: missing required field "JobSchedule.next_run_at"`)}
+	}
+	if _, ok := _c.mutation.ConsecutiveFailures(); !ok {
+		return &ValidationError{Name: "consecutive_failures", err: errors.New(`ent: missing required field "JobSchedule.consecutive_failures"`)}
+	}
+	if v, ok := _c.mutation.ConsecutiveFailures(); ok {
+		if err := jobschedule.ConsecutiveFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "consecutive_failures", err: fmt.Errorf(`ent: validator failed for field "JobSchedule.consecutive_failures": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "JobSchedule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "JobSchedule.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *JobScheduleCreate) sqlSave(ctx context.Context) (*JobSchedule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected JobSchedule.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *JobScheduleCreate) createSpec() (*JobSchedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JobSchedule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(jobschedule.Table, sqlgraph.NewFieldSpec(jobschedule.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(jobschedule.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(jobschedule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.JobType(); ok {
+		_spec.SetField(jobschedule.FieldJobType, field.TypeString, value)
+		_node.JobType = value
+	}
+	if value, ok := _c.mutation.Frequency(); ok {
+		_spec.SetField(jobschedule.FieldFrequency, field.TypeEnum, value)
+		_node.Frequency = value
+	}
+	if value, ok := _c.mutation.Params(); ok {
+		_spec.SetField(jobschedule.FieldParams, field.TypeJSON, value)
+		_node.Params = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(jobschedule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.NextRunAt(); ok {
+		_spec.SetField(jobschedule.FieldNextRunAt, field.TypeTime, value)
+		_node.NextRunAt = value
+	}
+	if value, ok := _c.mutation.LastRunAt(); ok {
+		_spec.SetField(jobschedule.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = &value
+	}
+	if value, ok := _c.mutation.LastStatus(); ok {
+		_spec.SetField(jobschedule.FieldLastStatus, field.TypeString, value)
+		_node.LastStatus = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(jobschedule.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(jobschedule.FieldConsecutiveFailures, field.TypeInt, value)
+		_node.ConsecutiveFailures = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(jobschedule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(jobschedule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JobSchedule.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobScheduleUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *JobScheduleCreate) OnConflict(opts ...sql.ConflictOption) *JobScheduleUpsertOne {
+	_c.conflict = opts
+	return &JobScheduleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JobSchedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *JobScheduleCreate) OnConflictColumns(columns ...string) *JobScheduleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &JobScheduleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// JobScheduleUpsertOne is the builder for "upsert"-ing
+	//  one JobSchedule node.
+	JobScheduleUpsertOne struct {
+		create *JobScheduleCreate
+	}
+
+	// JobScheduleUpsert is the "OnConflict" setter.
+	JobScheduleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *JobScheduleUpsert) SetName(v string) *JobScheduleUpsert {
+	u.Set(jobschedule.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *JobScheduleUpsert) UpdateName() *JobScheduleUpsert {
+	u.SetExcluded(jobschedule.FieldName)
+	return u
+}
+
+// SetFrequency sets the "frequency" field.
+func (u *JobScheduleUpsert) SetFrequency(v jobschedule.Frequency) *JobScheduleUpsert {
+	u.Set(jobschedule.FieldFrequency, v)
+	return u
+}
+
+// UpdateFrequency sets the "frequency" field to the value that was provided on create.
+func (u *JobScheduleUpsert) UpdateFrequency() *JobScheduleUpsert {
+	u.SetExcluded(jobschedule.FieldFrequency)
+	return u
+}
+
+// SetParams sets the "params" field.
+func (u *JobScheduleUpsert) SetParams(v jsontext.Value) *JobScheduleUpsert {
+	u.Set(jobschedule.FieldParams, v)
+	return u
+}
+
+// UpdateParams sets the "params" field to the value that was provided on create.
+func (u *JobScheduleUpsert) UpdateParams() *JobScheduleUpsert {
+	u.SetExcluded(jobschedule.FieldParams)
+	return u
+}
+
+// ClearParams clears the value of the "params" field.
+func (u *JobScheduleUpsert) ClearParams() *JobScheduleUpsert {
+	u.SetNull(jobschedule.FieldParams)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *JobScheduleUpsert) SetEnabled(v bool) *JobScheduleUpsert {
+	u.Set(jobschedule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *JobScheduleUpsert) UpdateEnabled() *JobScheduleUpsert {
+	u.SetExcluded(jobschedule.FieldEnabled)
+	return u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *JobScheduleUpsert) SetNextRunAt(v time.Time) *JobScheduleUpsert {
+	u.Set(jobschedule.FieldNextRunAt, v)
+	return u
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *JobScheduleUpsert) UpdateNextRunAt() *JobScheduleUpsert {
+	u.SetExcluded(jobschedule.FieldNextRunAt)
+	return u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *JobScheduleUpsert) SetLastRunAt(v time.Time) *JobScheduleUpsert {
+	u.Set(jobschedule.FieldLastRunAt, v)
+	return u
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *JobScheduleUpsert) UpdateLastRunAt() *JobScheduleUpsert {
+	u.SetExcluded(jobschedule.FieldLastRunAt)
+	return u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *JobScheduleUpsert) ClearLastRunAt() *JobScheduleUpsert {
+	u.SetNull(jobschedule.FieldLastRunAt)
+	return u
+}
+
+// SetLastStatus sets the "last_status" field.
+func (u *JobScheduleUpsert) SetLastStatus(v string) *JobScheduleUpsert {
+	u.Set(jobschedule.FieldLastStatus, v)
+	return u
+}
+
+// UpdateLastStatus sets the "last_status" field to the value that was provided on create.
+func (u *JobScheduleUpsert) UpdateLastStatus() *JobScheduleUpsert {
+	u.SetExcluded(jobschedule.FieldLastStatus)
+	return u
+}
+
+// ClearLastStatus clears the value of the "last_status" field.
+func (u *JobScheduleUpsert) ClearLastStatus() *JobScheduleUpsert {
+	u.SetNull(jobschedule.FieldLastStatus)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *JobScheduleUpsert) SetLastError(v string) *JobScheduleUpsert {
+	u.Set(jobschedule.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *JobScheduleUpsert) UpdateLastError() *JobScheduleUpsert {
+	u.SetExcluded(jobschedule.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *JobScheduleUpsert) ClearLastError() *JobScheduleUpsert {
+	u.SetNull(jobschedule.FieldLastError)
+	return u
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (u *JobScheduleUpsert) SetConsecutiveFailures(v int) *JobScheduleUpsert {
+	u.Set(jobschedule.FieldConsecutiveFailures, v)
+	return u
+}
+
+// UpdateConsecutiveFailures sets the "consecutive_failures" field to the value that was provided on create.
+func (u *JobScheduleUpsert) UpdateConsecutiveFailures() *JobScheduleUpsert {
+	u.SetExcluded(jobschedule.FieldConsecutiveFailures)
+	return u
+}
+
+// AddConsecutiveFailures adds v to the "consecutive_failures" field.
+func (u *JobScheduleUpsert) AddConsecutiveFailures(v int) *JobScheduleUpsert {
+	u.Add(jobschedule.FieldConsecutiveFailures, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobScheduleUpsert) SetUpdatedAt(v time.Time) *JobScheduleUpsert {
+	u.Set(jobschedule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobScheduleUpsert) UpdateUpdatedAt() *JobScheduleUpsert {
+	u.SetExcluded(jobschedule.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.JobSchedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(jobschedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JobScheduleUpsertOne) UpdateNewValues() *JobScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(jobschedule.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(jobschedule.FieldUserID)
+		}
+		if _, exists := u.create.mutation.JobType(); exists {
+			s.SetIgnore(jobschedule.FieldJobType)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(jobschedule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JobSchedule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *JobScheduleUpsertOne) Ignore() *JobScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobScheduleUpsertOne) DoNothing() *JobScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobScheduleCreate.OnConflict
+// documentation for more info.
+func (u *JobScheduleUpsertOne) Update(set func(*JobScheduleUpsert)) *JobScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *JobScheduleUpsertOne) SetName(v string) *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *JobScheduleUpsertOne) UpdateName() *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetFrequency sets the "frequency" field.
+func (u *JobScheduleUpsertOne) SetFrequency(v jobschedule.Frequency) *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.SetFrequency(v)
+	})
+}
+
+// UpdateFrequency sets the "frequency" field to the value that was provided on create.
+func (u *JobScheduleUpsertOne) UpdateFrequency() *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.UpdateFrequency()
+	})
+}
+
+// SetParams sets the "params" field.
+func (u *JobScheduleUpsertOne) SetParams(v jsontext.Value) *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.SetParams(v)
+	})
+}
+
+// UpdateParams sets the "params" field to the value that was provided on create.
+func (u *JobScheduleUpsertOne) UpdateParams() *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.UpdateParams()
+	})
+}
+
+// ClearParams clears the value of the "params" field.
+func (u *JobScheduleUpsertOne) ClearParams() *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.ClearParams()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *JobScheduleUpsertOne) SetEnabled(v bool) *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *JobScheduleUpsertOne) UpdateEnabled() *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *JobScheduleUpsertOne) SetNextRunAt(v time.Time) *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *JobScheduleUpsertOne) UpdateNextRunAt() *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *JobScheduleUpsertOne) SetLastRunAt(v time.Time) *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *JobScheduleUpsertOne) UpdateLastRunAt() *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *JobScheduleUpsertOne) ClearLastRunAt() *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.ClearLastRunAt()
+	})
+}
+
+// SetLastStatus sets the "last_status" field.
+func (u *JobScheduleUpsertOne) SetLastStatus(v string) *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.SetLastStatus(v)
+	})
+}
+
+// UpdateLastStatus sets the "last_status" field to the value that was provided on create.
+func (u *JobScheduleUpsertOne) UpdateLastStatus() *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.UpdateLastStatus()
+	})
+}
+
+// ClearLastStatus clears the value of the "last_status" field.
+func (u *JobScheduleUpsertOne) ClearLastStatus() *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.ClearLastStatus()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *JobScheduleUpsertOne) SetLastError(v string) *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *JobScheduleUpsertOne) UpdateLastError() *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *JobScheduleUpsertOne) ClearLastError() *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (u *JobScheduleUpsertOne) SetConsecutiveFailures(v int) *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.SetConsecutiveFailures(v)
+	})
+}
+
+// AddConsecutiveFailures adds v to the "consecutive_failures" field.
+func (u *JobScheduleUpsertOne) AddConsecutiveFailures(v int) *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.AddConsecutiveFailures(v)
+	})
+}
+
+// UpdateConsecutiveFailures sets the "consecutive_failures" field to the value that was provided on create.
+func (u *JobScheduleUpsertOne) UpdateConsecutiveFailures() *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.UpdateConsecutiveFailures()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobScheduleUpsertOne) SetUpdatedAt(v time.Time) *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobScheduleUpsertOne) UpdateUpdatedAt() *JobScheduleUpsertOne {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *JobScheduleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobScheduleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobScheduleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *JobScheduleUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: JobScheduleUpsertOne.ID is not supported by MySQL driver. Use JobScheduleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *JobScheduleUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// JobScheduleCreateBulk is the builder for creating many JobSchedule entities in bulk.
+type JobScheduleCreateBulk struct {
+	config
+	err      error
+	builders []*JobScheduleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the JobSchedule entities in the database.
+func (_c *JobScheduleCreateBulk) Save(ctx context.Context) ([]*JobSchedule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*JobSchedule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *JobScheduleCreateBulk) SaveX(ctx context.Context) []*JobSchedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JobScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JobScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JobSchedule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobScheduleUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *JobScheduleCreateBulk) OnConflict(opts ...sql.ConflictOption) *JobScheduleUpsertBulk {
+	_c.conflict = opts
+	return &JobScheduleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JobSchedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *JobScheduleCreateBulk) OnConflictColumns(columns ...string) *JobScheduleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &JobScheduleUpsertBulk{
+		create: _c,
+	}
+}
+
+// JobScheduleUpsertBulk is the builder for "upsert"-ing
+// a bulk of JobSchedule nodes.
+type JobScheduleUpsertBulk struct {
+	create *JobScheduleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.JobSchedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(jobschedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JobScheduleUpsertBulk) UpdateNewValues() *JobScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(jobschedule.FieldID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(jobschedule.FieldUserID)
+			}
+			if _, exists := b.mutation.JobType(); exists {
+				s.SetIgnore(jobschedule.FieldJobType)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(jobschedule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JobSchedule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *JobScheduleUpsertBulk) Ignore() *JobScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobScheduleUpsertBulk) DoNothing() *JobScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobScheduleCreateBulk.OnConflict
+// documentation for more info.
+func (u *JobScheduleUpsertBulk) Update(set func(*JobScheduleUpsert)) *JobScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *JobScheduleUpsertBulk) SetName(v string) *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *JobScheduleUpsertBulk) UpdateName() *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetFrequency sets the "frequency" field.
+func (u *JobScheduleUpsertBulk) SetFrequency(v jobschedule.Frequency) *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.SetFrequency(v)
+	})
+}
+
+// UpdateFrequency sets the "frequency" field to the value that was provided on create.
+func (u *JobScheduleUpsertBulk) UpdateFrequency() *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.UpdateFrequency()
+	})
+}
+
+// SetParams sets the "params" field.
+func (u *JobScheduleUpsertBulk) SetParams(v jsontext.Value) *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.SetParams(v)
+	})
+}
+
+// UpdateParams sets the "params" field to the value that was provided on create.
+func (u *JobScheduleUpsertBulk) UpdateParams() *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.UpdateParams()
+	})
+}
+
+// ClearParams clears the value of the "params" field.
+func (u *JobScheduleUpsertBulk) ClearParams() *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.ClearParams()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *JobScheduleUpsertBulk) SetEnabled(v bool) *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *JobScheduleUpsertBulk) UpdateEnabled() *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *JobScheduleUpsertBulk) SetNextRunAt(v time.Time) *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *JobScheduleUpsertBulk) UpdateNextRunAt() *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *JobScheduleUpsertBulk) SetLastRunAt(v time.Time) *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *JobScheduleUpsertBulk) UpdateLastRunAt() *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *JobScheduleUpsertBulk) ClearLastRunAt() *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.ClearLastRunAt()
+	})
+}
+
+// SetLastStatus sets the "last_status" field.
+func (u *JobScheduleUpsertBulk) SetLastStatus(v string) *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.SetLastStatus(v)
+	})
+}
+
+// UpdateLastStatus sets the "last_status" field to the value that was provided on create.
+func (u *JobScheduleUpsertBulk) UpdateLastStatus() *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.UpdateLastStatus()
+	})
+}
+
+// ClearLastStatus clears the value of the "last_status" field.
+func (u *JobScheduleUpsertBulk) ClearLastStatus() *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.ClearLastStatus()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *JobScheduleUpsertBulk) SetLastError(v string) *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *JobScheduleUpsertBulk) UpdateLastError() *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *JobScheduleUpsertBulk) ClearLastError() *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (u *JobScheduleUpsertBulk) SetConsecutiveFailures(v int) *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.SetConsecutiveFailures(v)
+	})
+}
+
+// AddConsecutiveFailures adds v to the "consecutive_failures" field.
+func (u *JobScheduleUpsertBulk) AddConsecutiveFailures(v int) *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.AddConsecutiveFailures(v)
+	})
+}
+
+// UpdateConsecutiveFailures sets the "consecutive_failures" field to the value that was provided on create.
+func (u *JobScheduleUpsertBulk) UpdateConsecutiveFailures() *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.UpdateConsecutiveFailures()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobScheduleUpsertBulk) SetUpdatedAt(v time.Time) *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobScheduleUpsertBulk) UpdateUpdatedAt() *JobScheduleUpsertBulk {
+	return u.Update(func(s *JobScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *JobScheduleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the JobScheduleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobScheduleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobScheduleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
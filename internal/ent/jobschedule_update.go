@@ -0,0 +1,664 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/jobschedule"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+	"encoding/json/jsontext"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// JobScheduleUpdate is the builder for updating JobSchedule entities.
+type JobScheduleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JobScheduleMutation
+}
+
+// Where appends a list predicates to the JobScheduleUpdate builder.
+func (_u *JobScheduleUpdate) Where(ps ...predicate.JobSchedule) *JobScheduleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *JobScheduleUpdate) SetName(v string) *JobScheduleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *JobScheduleUpdate) SetNillableName(v *string) *JobScheduleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetFrequency sets the "frequency" field.
+func (_u *JobScheduleUpdate) SetFrequency(v jobschedule.Frequency) *JobScheduleUpdate {
+	_u.mutation.SetFrequency(v)
+	return _u
+}
+
+// SetNillableFrequency sets the "frequency" field if the given value is not nil.
+func (_u *JobScheduleUpdate) SetNillableFrequency(v *jobschedule.Frequency) *JobScheduleUpdate {
+	if v != nil {
+		_u.SetFrequency(*v)
+	}
+	return _u
+}
+
+// SetParams sets the "params" field.
+func (_u *JobScheduleUpdate) SetParams(v jsontext.Value) *JobScheduleUpdate {
+	_u.mutation.SetParams(v)
+	return _u
+}
+
+// AppendParams appends value to the "params" field.
+func (_u *JobScheduleUpdate) AppendParams(v jsontext.Value) *JobScheduleUpdate {
+	_u.mutation.AppendParams(v)
+	return _u
+}
+
+// ClearParams clears the value of the "params" field.
+func (_u *JobScheduleUpdate) ClearParams() *JobScheduleUpdate {
+	_u.mutation.ClearParams()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *JobScheduleUpdate) SetEnabled(v bool) *JobScheduleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *JobScheduleUpdate) SetNillableEnabled(v *bool) *JobScheduleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *JobScheduleUpdate) SetNextRunAt(v time.Time) *JobScheduleUpdate {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *JobScheduleUpdate) SetNillableNextRunAt(v *time.Time) *JobScheduleUpdate {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *JobScheduleUpdate) SetLastRunAt(v time.Time) *JobScheduleUpdate {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *JobScheduleUpdate) SetNillableLastRunAt(v *time.Time) *JobScheduleUpdate {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *JobScheduleUpdate) ClearLastRunAt() *JobScheduleUpdate {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetLastStatus sets the "last_status" field.
+func (_u *JobScheduleUpdate) SetLastStatus(v string) *JobScheduleUpdate {
+	_u.mutation.SetLastStatus(v)
+	return _u
+}
+
+// SetNillableLastStatus sets the "last_status" field if the given value is not nil.
+func (_u *JobScheduleUpdate) SetNillableLastStatus(v *string) *JobScheduleUpdate {
+	if v != nil {
+		_u.SetLastStatus(*v)
+	}
+	return _u
+}
+
+// ClearLastStatus clears the value of the "last_status" field.
+func (_u *JobScheduleUpdate) ClearLastStatus() *JobScheduleUpdate {
+	_u.mutation.ClearLastStatus()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *JobScheduleUpdate) SetLastError(v string) *JobScheduleUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *JobScheduleUpdate) SetNillableLastError(v *string) *JobScheduleUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *JobScheduleUpdate) ClearLastError() *JobScheduleUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_u *JobScheduleUpdate) SetConsecutiveFailures(v int) *JobScheduleUpdate {
+	_u.mutation.ResetConsecutiveFailures()
+	_u.mutation.SetConsecutiveFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_u *JobScheduleUpdate) SetNillableConsecutiveFailures(v *int) *JobScheduleUpdate {
+	if v != nil {
+		_u.SetConsecutiveFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFailures adds value to the "consecutive_failures" field.
+func (_u *JobScheduleUpdate) AddConsecutiveFailures(v int) *JobScheduleUpdate {
+	_u.mutation.AddConsecutiveFailures(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *JobScheduleUpdate) SetUpdatedAt(v time.Time) *JobScheduleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the JobScheduleMutation object of the builder.
+func (_u *JobScheduleUpdate) Mutation() *JobScheduleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *JobScheduleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JobScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *JobScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JobScheduleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *JobScheduleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := jobschedule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *JobScheduleUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := jobschedule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "JobSchedule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Frequency(); ok {
+		if err := jobschedule.FrequencyValidator(v); err != nil {
+			return &ValidationError{Name: "frequency", err: fmt.Errorf(`ent: validator failed for field "JobSchedule.frequency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ConsecutiveFailures(); ok {
+		if err := jobschedule.ConsecutiveFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "consecutive_failures", err: fmt.Errorf(`ent: validator failed for field "JobSchedule.consecutive_failures": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *JobScheduleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(jobschedule.Table, jobschedule.Columns, sqlgraph.NewFieldSpec(jobschedule.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(jobschedule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Frequency(); ok {
+		_spec.SetField(jobschedule.FieldFrequency, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Params(); ok {
+		_spec.SetField(jobschedule.FieldParams, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedParams(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, jobschedule.FieldParams, value)
+		})
+	}
+	if _u.mutation.ParamsCleared() {
+		_spec.ClearField(jobschedule.FieldParams, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(jobschedule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(jobschedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(jobschedule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(jobschedule.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastStatus(); ok {
+		_spec.SetField(jobschedule.FieldLastStatus, field.TypeString, value)
+	}
+	if _u.mutation.LastStatusCleared() {
+		_spec.ClearField(jobschedule.FieldLastStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(jobschedule.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(jobschedule.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(jobschedule.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(jobschedule.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(jobschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// JobScheduleUpdateOne is the builder for updating a single JobSchedule entity.
+type JobScheduleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JobScheduleMutation
+}
+
+// SetName sets the "name" field.
+func (_u *JobScheduleUpdateOne) SetName(v string) *JobScheduleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *JobScheduleUpdateOne) SetNillableName(v *string) *JobScheduleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetFrequency sets the "frequency" field.
+func (_u *JobScheduleUpdateOne) SetFrequency(v jobschedule.Frequency) *JobScheduleUpdateOne {
+	_u.mutation.SetFrequency(v)
+	return _u
+}
+
+// SetNillableFrequency sets the "frequency" field if the given value is not nil.
+func (_u *JobScheduleUpdateOne) SetNillableFrequency(v *jobschedule.Frequency) *JobScheduleUpdateOne {
+	if v != nil {
+		_u.SetFrequency(*v)
+	}
+	return _u
+}
+
+// SetParams sets the "params" field.
+func (_u *JobScheduleUpdateOne) SetParams(v jsontext.Value) *JobScheduleUpdateOne {
+	_u.mutation.SetParams(v)
+	return _u
+}
+
+// AppendParams appends value to the "params" field.
+func (_u *JobScheduleUpdateOne) AppendParams(v jsontext.Value) *JobScheduleUpdateOne {
+	_u.mutation.AppendParams(v)
+	return _u
+}
+
+// ClearParams clears the value of the "params" field.
+func (_u *JobScheduleUpdateOne) ClearParams() *JobScheduleUpdateOne {
+	_u.mutation.ClearParams()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *JobScheduleUpdateOne) SetEnabled(v bool) *JobScheduleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *JobScheduleUpdateOne) SetNillableEnabled(v *bool) *JobScheduleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *JobScheduleUpdateOne) SetNextRunAt(v time.Time) *JobScheduleUpdateOne {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *JobScheduleUpdateOne) SetNillableNextRunAt(v *time.Time) *JobScheduleUpdateOne {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *JobScheduleUpdateOne) SetLastRunAt(v time.Time) *JobScheduleUpdateOne {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *JobScheduleUpdateOne) SetNillableLastRunAt(v *time.Time) *JobScheduleUpdateOne {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *JobScheduleUpdateOne) ClearLastRunAt() *JobScheduleUpdateOne {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetLastStatus sets the "last_status" field.
+func (_u *JobScheduleUpdateOne) SetLastStatus(v string) *JobScheduleUpdateOne {
+	_u.mutation.SetLastStatus(v)
+	return _u
+}
+
+// SetNillableLastStatus sets the "last_status" field if the given value is not nil.
+func (_u *JobScheduleUpdateOne) SetNillableLastStatus(v *string) *JobScheduleUpdateOne {
+	if v != nil {
+		_u.SetLastStatus(*v)
+	}
+	return _u
+}
+
+// ClearLastStatus clears the value of the "last_status" field.
+func (_u *JobScheduleUpdateOne) ClearLastStatus() *JobScheduleUpdateOne {
+	_u.mutation.ClearLastStatus()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *JobScheduleUpdateOne) SetLastError(v string) *JobScheduleUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *JobScheduleUpdateOne) SetNillableLastError(v *string) *JobScheduleUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *JobScheduleUpdateOne) ClearLastError() *JobScheduleUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_u *JobScheduleUpdateOne) SetConsecutiveFailures(v int) *JobScheduleUpdateOne {
+	_u.mutation.ResetConsecutiveFailures()
+	_u.mutation.SetConsecutiveFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_u *JobScheduleUpdateOne) SetNillableConsecutiveFailures(v *int) *JobScheduleUpdateOne {
+	if v != nil {
+		_u.SetConsecutiveFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFailures adds value to the "consecutive_failures" field.
+func (_u *JobScheduleUpdateOne) AddConsecutiveFailures(v int) *JobScheduleUpdateOne {
+	_u.mutation.AddConsecutiveFailures(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *JobScheduleUpdateOne) SetUpdatedAt(v time.Time) *JobScheduleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the JobScheduleMutation object of the builder.
+func (_u *JobScheduleUpdateOne) Mutation() *JobScheduleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the JobScheduleUpdate builder.
+func (_u *JobScheduleUpdateOne) Where(ps ...predicate.JobSchedule) *JobScheduleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *JobScheduleUpdateOne) Select(field string, fields ...string) *JobScheduleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated JobSchedule entity.
+func (_u *JobScheduleUpdateOne) Save(ctx context.Context) (*JobSchedule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JobScheduleUpdateOne) SaveX(ctx context.Context) *JobSchedule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *JobScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JobScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *JobScheduleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := jobschedule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *JobScheduleUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := jobschedule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "JobSchedule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Frequency(); ok {
+		if err := jobschedule.FrequencyValidator(v); err != nil {
+			return &ValidationError{Name: "frequency", err: fmt.Errorf(`ent: validator failed for field "JobSchedule.frequency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ConsecutiveFailures(); ok {
+		if err := jobschedule.ConsecutiveFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "consecutive_failures", err: fmt.Errorf(`ent: validator failed for field "JobSchedule.consecutive_failures": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *JobScheduleUpdateOne) sqlSave(ctx context.Context) (_node *JobSchedule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(jobschedule.Table, jobschedule.Columns, sqlgraph.NewFieldSpec(jobschedule.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "JobSchedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jobschedule.FieldID)
+		for _, f := range fields {
+			if !jobschedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != jobschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(jobschedule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Frequency(); ok {
+		_spec.SetField(jobschedule.FieldFrequency, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Params(); ok {
+		_spec.SetField(jobschedule.FieldParams, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedParams(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, jobschedule.FieldParams, value)
+		})
+	}
+	if _u.mutation.ParamsCleared() {
+		_spec.ClearField(jobschedule.FieldParams, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(jobschedule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(jobschedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(jobschedule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(jobschedule.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastStatus(); ok {
+		_spec.SetField(jobschedule.FieldLastStatus, field.TypeString, value)
+	}
+	if _u.mutation.LastStatusCleared() {
+		_spec.ClearField(jobschedule.FieldLastStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(jobschedule.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(jobschedule.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(jobschedule.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(jobschedule.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(jobschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &JobSchedule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,772 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/jobschedulerun"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JobScheduleRunCreate is the builder for creating a JobScheduleRun entity.
+type JobScheduleRunCreate struct {
+	config
+	mutation *JobScheduleRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (_c *JobScheduleRunCreate) SetScheduleID(v string) *JobScheduleRunCreate {
+	_c.mutation.SetScheduleID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *JobScheduleRunCreate) SetUserID(v string) *JobScheduleRunCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetJobID sets the "job_id" field.
+func (_c *JobScheduleRunCreate) SetJobID(v string) *JobScheduleRunCreate {
+	_c.mutation.SetJobID(v)
+	return _c
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (_c *JobScheduleRunCreate) SetNillableJobID(v *string) *JobScheduleRunCreate {
+	if v != nil {
+		_c.SetJobID(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *JobScheduleRunCreate) SetStatus(v jobschedulerun.Status) *JobScheduleRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *JobScheduleRunCreate) SetNillableStatus(v *jobschedulerun.Status) *JobScheduleRunCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *JobScheduleRunCreate) SetError(v string) *JobScheduleRunCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *JobScheduleRunCreate) SetNillableError(v *string) *JobScheduleRunCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *JobScheduleRunCreate) SetStartedAt(v time.Time) *JobScheduleRunCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *JobScheduleRunCreate) SetNillableStartedAt(v *time.Time) *JobScheduleRunCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *JobScheduleRunCreate) SetCompletedAt(v time.Time) *JobScheduleRunCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *JobScheduleRunCreate) SetNillableCompletedAt(v *time.Time) *JobScheduleRunCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *JobScheduleRunCreate) SetID(v string) *JobScheduleRunCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the JobScheduleRunMutation object of the builder.
+func (_c *JobScheduleRunCreate) Mutation() *JobScheduleRunMutation {
+	return _c.mutation
+}
+
+// Save creates the JobScheduleRun in the database.
+func (_c *JobScheduleRunCreate) Save(ctx context.Context) (*JobScheduleRun, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *JobScheduleRunCreate) SaveX(ctx context.Context) *JobScheduleRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JobScheduleRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JobScheduleRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *JobScheduleRunCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := jobschedulerun.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		v := jobschedulerun.DefaultStartedAt()
+		_c.mutation.SetStartedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *JobScheduleRunCreate) check() error {
+	if _, ok := _c.mutation.ScheduleID(); !ok {
+		return &ValidationError{Name: "schedule_id", err: errors.New(`ent: missing required field "JobScheduleRun.schedule_id"`)}
+	}
+	if v, ok := _c.mutation.ScheduleID(); ok {
+		if err := jobschedulerun.ScheduleIDValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_id", err: fmt.Errorf(`ent: validator failed for field "JobScheduleRun.schedule_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "JobScheduleRun.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := jobschedulerun.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "JobScheduleRun.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "JobScheduleRun.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := jobschedulerun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "JobScheduleRun.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "JobScheduleRun.started_at"`)}
+	}
+	return nil
+}
+
+func (_c *JobScheduleRunCreate) sqlSave(ctx context.Context) (*JobScheduleRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected JobScheduleRun.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *JobScheduleRunCreate) createSpec() (*JobScheduleRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JobScheduleRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(jobschedulerun.Table, sqlgraph.NewFieldSpec(jobschedulerun.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ScheduleID(); ok {
+		_spec.SetField(jobschedulerun.FieldScheduleID, field.TypeString, value)
+		_node.ScheduleID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(jobschedulerun.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.JobID(); ok {
+		_spec.SetField(jobschedulerun.FieldJobID, field.TypeString, value)
+		_node.JobID = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(jobschedulerun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(jobschedulerun.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(jobschedulerun.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(jobschedulerun.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JobScheduleRun.Create().
+//		SetScheduleID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobScheduleRunUpsert) {
+//			SetScheduleID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *JobScheduleRunCreate) OnConflict(opts ...sql.ConflictOption) *JobScheduleRunUpsertOne {
+	_c.conflict = opts
+	return &JobScheduleRunUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JobScheduleRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *JobScheduleRunCreate) OnConflictColumns(columns ...string) *JobScheduleRunUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &JobScheduleRunUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// JobScheduleRunUpsertOne is the builder for "upsert"-ing
+	//  one JobScheduleRun node.
+	JobScheduleRunUpsertOne struct {
+		create *JobScheduleRunCreate
+	}
+
+	// JobScheduleRunUpsert is the "OnConflict" setter.
+	JobScheduleRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *JobScheduleRunUpsert) SetStatus(v jobschedulerun.Status) *JobScheduleRunUpsert {
+	u.Set(jobschedulerun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobScheduleRunUpsert) UpdateStatus() *JobScheduleRunUpsert {
+	u.SetExcluded(jobschedulerun.FieldStatus)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *JobScheduleRunUpsert) SetError(v string) *JobScheduleRunUpsert {
+	u.Set(jobschedulerun.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *JobScheduleRunUpsert) UpdateError() *JobScheduleRunUpsert {
+	u.SetExcluded(jobschedulerun.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *JobScheduleRunUpsert) ClearError() *JobScheduleRunUpsert {
+	u.SetNull(jobschedulerun.FieldError)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *JobScheduleRunUpsert) SetCompletedAt(v time.Time) *JobScheduleRunUpsert {
+	u.Set(jobschedulerun.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *JobScheduleRunUpsert) UpdateCompletedAt() *JobScheduleRunUpsert {
+	u.SetExcluded(jobschedulerun.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *JobScheduleRunUpsert) ClearCompletedAt() *JobScheduleRunUpsert {
+	u.SetNull(jobschedulerun.FieldCompletedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.JobScheduleRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(jobschedulerun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JobScheduleRunUpsertOne) UpdateNewValues() *JobScheduleRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(jobschedulerun.FieldID)
+		}
+		if _, exists := u.create.mutation.ScheduleID(); exists {
+			s.SetIgnore(jobschedulerun.FieldScheduleID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(jobschedulerun.FieldUserID)
+		}
+		if _, exists := u.create.mutation.JobID(); exists {
+			s.SetIgnore(jobschedulerun.FieldJobID)
+		}
+		if _, exists := u.create.mutation.StartedAt(); exists {
+			s.SetIgnore(jobschedulerun.FieldStartedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JobScheduleRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *JobScheduleRunUpsertOne) Ignore() *JobScheduleRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobScheduleRunUpsertOne) DoNothing() *JobScheduleRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobScheduleRunCreate.OnConflict
+// documentation for more info.
+func (u *JobScheduleRunUpsertOne) Update(set func(*JobScheduleRunUpsert)) *JobScheduleRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobScheduleRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *JobScheduleRunUpsertOne) SetStatus(v jobschedulerun.Status) *JobScheduleRunUpsertOne {
+	return u.Update(func(s *JobScheduleRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobScheduleRunUpsertOne) UpdateStatus() *JobScheduleRunUpsertOne {
+	return u.Update(func(s *JobScheduleRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *JobScheduleRunUpsertOne) SetError(v string) *JobScheduleRunUpsertOne {
+	return u.Update(func(s *JobScheduleRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *JobScheduleRunUpsertOne) UpdateError() *JobScheduleRunUpsertOne {
+	return u.Update(func(s *JobScheduleRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *JobScheduleRunUpsertOne) ClearError() *JobScheduleRunUpsertOne {
+	return u.Update(func(s *JobScheduleRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *JobScheduleRunUpsertOne) SetCompletedAt(v time.Time) *JobScheduleRunUpsertOne {
+	return u.Update(func(s *JobScheduleRunUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *JobScheduleRunUpsertOne) UpdateCompletedAt() *JobScheduleRunUpsertOne {
+	return u.Update(func(s *JobScheduleRunUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *JobScheduleRunUpsertOne) ClearCompletedAt() *JobScheduleRunUpsertOne {
+	return u.Update(func(s *JobScheduleRunUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *JobScheduleRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobScheduleRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobScheduleRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *JobScheduleRunUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: JobScheduleRunUpsertOne.ID is not supported by MySQL driver. Use JobScheduleRunUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *JobScheduleRunUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// JobScheduleRunCreateBulk is the builder for creating many JobScheduleRun entities in bulk.
+type JobScheduleRunCreateBulk struct {
+	config
+	err      error
+	builders []*JobScheduleRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the JobScheduleRun entities in the database.
+func (_c *JobScheduleRunCreateBulk) Save(ctx context.Context) ([]*JobScheduleRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*JobScheduleRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobScheduleRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *JobScheduleRunCreateBulk) SaveX(ctx context.Context) []*JobScheduleRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JobScheduleRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JobScheduleRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JobScheduleRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobScheduleRunUpsert) {
+//			SetScheduleID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *JobScheduleRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *JobScheduleRunUpsertBulk {
+	_c.conflict = opts
+	return &JobScheduleRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JobScheduleRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *JobScheduleRunCreateBulk) OnConflictColumns(columns ...string) *JobScheduleRunUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &JobScheduleRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// JobScheduleRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of JobScheduleRun nodes.
+type JobScheduleRunUpsertBulk struct {
+	create *JobScheduleRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.JobScheduleRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(jobschedulerun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JobScheduleRunUpsertBulk) UpdateNewValues() *JobScheduleRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(jobschedulerun.FieldID)
+			}
+			if _, exists := b.mutation.ScheduleID(); exists {
+				s.SetIgnore(jobschedulerun.FieldScheduleID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(jobschedulerun.FieldUserID)
+			}
+			if _, exists := b.mutation.JobID(); exists {
+				s.SetIgnore(jobschedulerun.FieldJobID)
+			}
+			if _, exists := b.mutation.StartedAt(); exists {
+				s.SetIgnore(jobschedulerun.FieldStartedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JobScheduleRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *JobScheduleRunUpsertBulk) Ignore() *JobScheduleRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobScheduleRunUpsertBulk) DoNothing() *JobScheduleRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobScheduleRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *JobScheduleRunUpsertBulk) Update(set func(*JobScheduleRunUpsert)) *JobScheduleRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobScheduleRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *JobScheduleRunUpsertBulk) SetStatus(v jobschedulerun.Status) *JobScheduleRunUpsertBulk {
+	return u.Update(func(s *JobScheduleRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobScheduleRunUpsertBulk) UpdateStatus() *JobScheduleRunUpsertBulk {
+	return u.Update(func(s *JobScheduleRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *JobScheduleRunUpsertBulk) SetError(v string) *JobScheduleRunUpsertBulk {
+	return u.Update(func(s *JobScheduleRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *JobScheduleRunUpsertBulk) UpdateError() *JobScheduleRunUpsertBulk {
+	return u.Update(func(s *JobScheduleRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *JobScheduleRunUpsertBulk) ClearError() *JobScheduleRunUpsertBulk {
+	return u.Update(func(s *JobScheduleRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *JobScheduleRunUpsertBulk) SetCompletedAt(v time.Time) *JobScheduleRunUpsertBulk {
+	return u.Update(func(s *JobScheduleRunUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *JobScheduleRunUpsertBulk) UpdateCompletedAt() *JobScheduleRunUpsertBulk {
+	return u.Update(func(s *JobScheduleRunUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *JobScheduleRunUpsertBulk) ClearCompletedAt() *JobScheduleRunUpsertBulk {
+	return u.Update(func(s *JobScheduleRunUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *JobScheduleRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the JobScheduleRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobScheduleRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobScheduleRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/jobschedulerun"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JobScheduleRunUpdate is the builder for updating JobScheduleRun entities.
+type JobScheduleRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JobScheduleRunMutation
+}
+
+// Where appends a list predicates to the JobScheduleRunUpdate builder.
+func (_u *JobScheduleRunUpdate) Where(ps ...predicate.JobScheduleRun) *JobScheduleRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *JobScheduleRunUpdate) SetStatus(v jobschedulerun.Status) *JobScheduleRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *JobScheduleRunUpdate) SetNillableStatus(v *jobschedulerun.Status) *JobScheduleRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *JobScheduleRunUpdate) SetError(v string) *JobScheduleRunUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *JobScheduleRunUpdate) SetNillableError(v *string) *JobScheduleRunUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *JobScheduleRunUpdate) ClearError() *JobScheduleRunUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *JobScheduleRunUpdate) SetCompletedAt(v time.Time) *JobScheduleRunUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *JobScheduleRunUpdate) SetNillableCompletedAt(v *time.Time) *JobScheduleRunUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *JobScheduleRunUpdate) ClearCompletedAt() *JobScheduleRunUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// Mutation returns the JobScheduleRunMutation object of the builder.
+func (_u *JobScheduleRunUpdate) Mutation() *JobScheduleRunMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *JobScheduleRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JobScheduleRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *JobScheduleRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JobScheduleRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *JobScheduleRunUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := jobschedulerun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "JobScheduleRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *JobScheduleRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(jobschedulerun.Table, jobschedulerun.Columns, sqlgraph.NewFieldSpec(jobschedulerun.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.JobIDCleared() {
+		_spec.ClearField(jobschedulerun.FieldJobID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(jobschedulerun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(jobschedulerun.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(jobschedulerun.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(jobschedulerun.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(jobschedulerun.FieldCompletedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobschedulerun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// JobScheduleRunUpdateOne is the builder for updating a single JobScheduleRun entity.
+type JobScheduleRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JobScheduleRunMutation
+}
+
+// SetStatus sets the "status" field.
+func (_u *JobScheduleRunUpdateOne) SetStatus(v jobschedulerun.Status) *JobScheduleRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *JobScheduleRunUpdateOne) SetNillableStatus(v *jobschedulerun.Status) *JobScheduleRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *JobScheduleRunUpdateOne) SetError(v string) *JobScheduleRunUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *JobScheduleRunUpdateOne) SetNillableError(v *string) *JobScheduleRunUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *JobScheduleRunUpdateOne) ClearError() *JobScheduleRunUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *JobScheduleRunUpdateOne) SetCompletedAt(v time.Time) *JobScheduleRunUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *JobScheduleRunUpdateOne) SetNillableCompletedAt(v *time.Time) *JobScheduleRunUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *JobScheduleRunUpdateOne) ClearCompletedAt() *JobScheduleRunUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// Mutation returns the JobScheduleRunMutation object of the builder.
+func (_u *JobScheduleRunUpdateOne) Mutation() *JobScheduleRunMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the JobScheduleRunUpdate builder.
+func (_u *JobScheduleRunUpdateOne) Where(ps ...predicate.JobScheduleRun) *JobScheduleRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *JobScheduleRunUpdateOne) Select(field string, fields ...string) *JobScheduleRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated JobScheduleRun entity.
+func (_u *JobScheduleRunUpdateOne) Save(ctx context.Context) (*JobScheduleRun, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JobScheduleRunUpdateOne) SaveX(ctx context.Context) *JobScheduleRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *JobScheduleRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JobScheduleRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *JobScheduleRunUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := jobschedulerun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "JobScheduleRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *JobScheduleRunUpdateOne) sqlSave(ctx context.Context) (_node *JobScheduleRun, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(jobschedulerun.Table, jobschedulerun.Columns, sqlgraph.NewFieldSpec(jobschedulerun.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "JobScheduleRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jobschedulerun.FieldID)
+		for _, f := range fields {
+			if !jobschedulerun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != jobschedulerun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.JobIDCleared() {
+		_spec.ClearField(jobschedulerun.FieldJobID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(jobschedulerun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(jobschedulerun.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(jobschedulerun.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(jobschedulerun.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(jobschedulerun.FieldCompletedAt, field.TypeTime)
+	}
+	_node = &JobScheduleRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobschedulerun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
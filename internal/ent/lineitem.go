@@ -55,6 +55,8 @@ type LineItem struct {
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	// ID from legacy system for migration tracking
 	LegacyID *string `json:"legacy_id,omitempty"`
+	// ID of the legacy migration run that created the line item, so the run can be rolled back
+	MigrationRunID *string `json:"migration_run_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -98,7 +100,7 @@ func (*LineItem) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullFloat64)
 		case lineitem.FieldLineNumber:
 			values[i] = new(sql.NullInt64)
-		case lineitem.FieldID, lineitem.FieldReceiptID, lineitem.FieldDescription, lineitem.FieldSku, lineitem.FieldProductCode, lineitem.FieldUnit, lineitem.FieldDiscountDescription, lineitem.FieldCategory, lineitem.FieldLegacyID:
+		case lineitem.FieldID, lineitem.FieldReceiptID, lineitem.FieldDescription, lineitem.FieldSku, lineitem.FieldProductCode, lineitem.FieldUnit, lineitem.FieldDiscountDescription, lineitem.FieldCategory, lineitem.FieldLegacyID, lineitem.FieldMigrationRunID:
 			values[i] = new(sql.NullString)
 		case lineitem.FieldCreatedAt, lineitem.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -242,6 +244,13 @@ func (_m *LineItem) assignValues(columns []string, values []any) error {
 				_m.LegacyID = new(string)
 				*_m.LegacyID = value.String
 			}
+		case lineitem.FieldMigrationRunID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field migration_run_id", values[i])
+			} else if value.Valid {
+				_m.MigrationRunID = new(string)
+				*_m.MigrationRunID = value.String
+			}
 		case lineitem.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -363,6 +372,11 @@ func (_m *LineItem) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.MigrationRunID; v != nil {
+		builder.WriteString("migration_run_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
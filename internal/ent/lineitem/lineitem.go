@@ -50,6 +50,8 @@ const (
 	FieldMetadata = "metadata"
 	// FieldLegacyID holds the string denoting the legacy_id field in the database.
 	FieldLegacyID = "legacy_id"
+	// FieldMigrationRunID holds the string denoting the migration_run_id field in the database.
+	FieldMigrationRunID = "migration_run_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -88,6 +90,7 @@ var Columns = []string{
 	FieldTags,
 	FieldMetadata,
 	FieldLegacyID,
+	FieldMigrationRunID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -213,6 +216,11 @@ func ByLegacyID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLegacyID, opts...).ToFunc()
 }
 
+// ByMigrationRunID orders the results by the migration_run_id field.
+func ByMigrationRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMigrationRunID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -145,6 +145,11 @@ func LegacyID(v string) predicate.LineItem {
 	return predicate.LineItem(sql.FieldEQ(FieldLegacyID, v))
 }
 
+// MigrationRunID applies equality check predicate on the "migration_run_id" field. It's identical to MigrationRunIDEQ.
+func MigrationRunID(v string) predicate.LineItem {
+	return predicate.LineItem(sql.FieldEQ(FieldMigrationRunID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.LineItem {
 	return predicate.LineItem(sql.FieldEQ(FieldCreatedAt, v))
@@ -1075,6 +1080,81 @@ func LegacyIDContainsFold(v string) predicate.LineItem {
 	return predicate.LineItem(sql.FieldContainsFold(FieldLegacyID, v))
 }
 
+// MigrationRunIDEQ applies the EQ predicate on the "migration_run_id" field.
+func MigrationRunIDEQ(v string) predicate.LineItem {
+	return predicate.LineItem(sql.FieldEQ(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDNEQ applies the NEQ predicate on the "migration_run_id" field.
+func MigrationRunIDNEQ(v string) predicate.LineItem {
+	return predicate.LineItem(sql.FieldNEQ(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDIn applies the In predicate on the "migration_run_id" field.
+func MigrationRunIDIn(vs ...string) predicate.LineItem {
+	return predicate.LineItem(sql.FieldIn(FieldMigrationRunID, vs...))
+}
+
+// MigrationRunIDNotIn applies the NotIn predicate on the "migration_run_id" field.
+func MigrationRunIDNotIn(vs ...string) predicate.LineItem {
+	return predicate.LineItem(sql.FieldNotIn(FieldMigrationRunID, vs...))
+}
+
+// MigrationRunIDGT applies the GT predicate on the "migration_run_id" field.
+func MigrationRunIDGT(v string) predicate.LineItem {
+	return predicate.LineItem(sql.FieldGT(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDGTE applies the GTE predicate on the "migration_run_id" field.
+func MigrationRunIDGTE(v string) predicate.LineItem {
+	return predicate.LineItem(sql.FieldGTE(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDLT applies the LT predicate on the "migration_run_id" field.
+func MigrationRunIDLT(v string) predicate.LineItem {
+	return predicate.LineItem(sql.FieldLT(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDLTE applies the LTE predicate on the "migration_run_id" field.
+func MigrationRunIDLTE(v string) predicate.LineItem {
+	return predicate.LineItem(sql.FieldLTE(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDContains applies the Contains predicate on the "migration_run_id" field.
+func MigrationRunIDContains(v string) predicate.LineItem {
+	return predicate.LineItem(sql.FieldContains(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDHasPrefix applies the HasPrefix predicate on the "migration_run_id" field.
+func MigrationRunIDHasPrefix(v string) predicate.LineItem {
+	return predicate.LineItem(sql.FieldHasPrefix(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDHasSuffix applies the HasSuffix predicate on the "migration_run_id" field.
+func MigrationRunIDHasSuffix(v string) predicate.LineItem {
+	return predicate.LineItem(sql.FieldHasSuffix(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDIsNil applies the IsNil predicate on the "migration_run_id" field.
+func MigrationRunIDIsNil() predicate.LineItem {
+	return predicate.LineItem(sql.FieldIsNull(FieldMigrationRunID))
+}
+
+// MigrationRunIDNotNil applies the NotNil predicate on the "migration_run_id" field.
+func MigrationRunIDNotNil() predicate.LineItem {
+	return predicate.LineItem(sql.FieldNotNull(FieldMigrationRunID))
+}
+
+// MigrationRunIDEqualFold applies the EqualFold predicate on the "migration_run_id" field.
+func MigrationRunIDEqualFold(v string) predicate.LineItem {
+	return predicate.LineItem(sql.FieldEqualFold(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDContainsFold applies the ContainsFold predicate on the "migration_run_id" field.
+func MigrationRunIDContainsFold(v string) predicate.LineItem {
+	return predicate.LineItem(sql.FieldContainsFold(FieldMigrationRunID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.LineItem {
 	return predicate.LineItem(sql.FieldEQ(FieldCreatedAt, v))
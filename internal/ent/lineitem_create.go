@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -19,6 +21,7 @@ type LineItemCreate struct {
 	config
 	mutation *LineItemMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetReceiptID sets the "receipt_id" field.
@@ -225,6 +228,20 @@ func (_c *LineItemCreate) SetNillableLegacyID(v *string) *LineItemCreate {
 	return _c
 }
 
+// SetMigrationRunID sets the "migration_run_id" field.
+func (_c *LineItemCreate) SetMigrationRunID(v string) *LineItemCreate {
+	_c.mutation.SetMigrationRunID(v)
+	return _c
+}
+
+// SetNillableMigrationRunID sets the "migration_run_id" field if the given value is not nil.
+func (_c *LineItemCreate) SetNillableMigrationRunID(v *string) *LineItemCreate {
+	if v != nil {
+		_c.SetMigrationRunID(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *LineItemCreate) SetCreatedAt(v time.Time) *LineItemCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -402,6 +419,7 @@ func (_c *LineItemCreate) createSpec() (*LineItem, *sqlgraph.CreateSpec) {
 		_node = &LineItem{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(lineitem.Table, sqlgraph.NewFieldSpec(lineitem.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -474,6 +492,10 @@ func (_c *LineItemCreate) createSpec() (*LineItem, *sqlgraph.CreateSpec) {
 		_spec.SetField(lineitem.FieldLegacyID, field.TypeString, value)
 		_node.LegacyID = &value
 	}
+	if value, ok := _c.mutation.MigrationRunID(); ok {
+		_spec.SetField(lineitem.FieldMigrationRunID, field.TypeString, value)
+		_node.MigrationRunID = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(lineitem.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -502,11 +524,917 @@ func (_c *LineItemCreate) createSpec() (*LineItem, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LineItem.Create().
+//		SetReceiptID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LineItemUpsert) {
+//			SetReceiptID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LineItemCreate) OnConflict(opts ...sql.ConflictOption) *LineItemUpsertOne {
+	_c.conflict = opts
+	return &LineItemUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LineItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LineItemCreate) OnConflictColumns(columns ...string) *LineItemUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LineItemUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LineItemUpsertOne is the builder for "upsert"-ing
+	//  one LineItem node.
+	LineItemUpsertOne struct {
+		create *LineItemCreate
+	}
+
+	// LineItemUpsert is the "OnConflict" setter.
+	LineItemUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *LineItemUpsert) SetReceiptID(v string) *LineItemUpsert {
+	u.Set(lineitem.FieldReceiptID, v)
+	return u
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *LineItemUpsert) UpdateReceiptID() *LineItemUpsert {
+	u.SetExcluded(lineitem.FieldReceiptID)
+	return u
+}
+
+// SetLineNumber sets the "line_number" field.
+func (u *LineItemUpsert) SetLineNumber(v int) *LineItemUpsert {
+	u.Set(lineitem.FieldLineNumber, v)
+	return u
+}
+
+// UpdateLineNumber sets the "line_number" field to the value that was provided on create.
+func (u *LineItemUpsert) UpdateLineNumber() *LineItemUpsert {
+	u.SetExcluded(lineitem.FieldLineNumber)
+	return u
+}
+
+// AddLineNumber adds v to the "line_number" field.
+func (u *LineItemUpsert) AddLineNumber(v int) *LineItemUpsert {
+	u.Add(lineitem.FieldLineNumber, v)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *LineItemUpsert) SetDescription(v string) *LineItemUpsert {
+	u.Set(lineitem.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *LineItemUpsert) UpdateDescription() *LineItemUpsert {
+	u.SetExcluded(lineitem.FieldDescription)
+	return u
+}
+
+// SetSku sets the "sku" field.
+func (u *LineItemUpsert) SetSku(v string) *LineItemUpsert {
+	u.Set(lineitem.FieldSku, v)
+	return u
+}
+
+// UpdateSku sets the "sku" field to the value that was provided on create.
+func (u *LineItemUpsert) UpdateSku() *LineItemUpsert {
+	u.SetExcluded(lineitem.FieldSku)
+	return u
+}
+
+// ClearSku clears the value of the "sku" field.
+func (u *LineItemUpsert) ClearSku() *LineItemUpsert {
+	u.SetNull(lineitem.FieldSku)
+	return u
+}
+
+// SetProductCode sets the "product_code" field.
+func (u *LineItemUpsert) SetProductCode(v string) *LineItemUpsert {
+	u.Set(lineitem.FieldProductCode, v)
+	return u
+}
+
+// UpdateProductCode sets the "product_code" field to the value that was provided on create.
+func (u *LineItemUpsert) UpdateProductCode() *LineItemUpsert {
+	u.SetExcluded(lineitem.FieldProductCode)
+	return u
+}
+
+// ClearProductCode clears the value of the "product_code" field.
+func (u *LineItemUpsert) ClearProductCode() *LineItemUpsert {
+	u.SetNull(lineitem.FieldProductCode)
+	return u
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *LineItemUpsert) SetQuantity(v float64) *LineItemUpsert {
+	u.Set(lineitem.FieldQuantity, v)
+	return u
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *LineItemUpsert) UpdateQuantity() *LineItemUpsert {
+	u.SetExcluded(lineitem.FieldQuantity)
+	return u
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *LineItemUpsert) AddQuantity(v float64) *LineItemUpsert {
+	u.Add(lineitem.FieldQuantity, v)
+	return u
+}
+
+// SetUnit sets the "unit" field.
+func (u *LineItemUpsert) SetUnit(v string) *LineItemUpsert {
+	u.Set(lineitem.FieldUnit, v)
+	return u
+}
+
+// UpdateUnit sets the "unit" field to the value that was provided on create.
+func (u *LineItemUpsert) UpdateUnit() *LineItemUpsert {
+	u.SetExcluded(lineitem.FieldUnit)
+	return u
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (u *LineItemUpsert) ClearUnit() *LineItemUpsert {
+	u.SetNull(lineitem.FieldUnit)
+	return u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (u *LineItemUpsert) SetUnitPrice(v float64) *LineItemUpsert {
+	u.Set(lineitem.FieldUnitPrice, v)
+	return u
+}
+
+// UpdateUnitPrice sets the "unit_price" field to the value that was provided on create.
+func (u *LineItemUpsert) UpdateUnitPrice() *LineItemUpsert {
+	u.SetExcluded(lineitem.FieldUnitPrice)
+	return u
+}
+
+// AddUnitPrice adds v to the "unit_price" field.
+func (u *LineItemUpsert) AddUnitPrice(v float64) *LineItemUpsert {
+	u.Add(lineitem.FieldUnitPrice, v)
+	return u
+}
+
+// SetTotalPrice sets the "total_price" field.
+func (u *LineItemUpsert) SetTotalPrice(v float64) *LineItemUpsert {
+	u.Set(lineitem.FieldTotalPrice, v)
+	return u
+}
+
+// UpdateTotalPrice sets the "total_price" field to the value that was provided on create.
+func (u *LineItemUpsert) UpdateTotalPrice() *LineItemUpsert {
+	u.SetExcluded(lineitem.FieldTotalPrice)
+	return u
+}
+
+// AddTotalPrice adds v to the "total_price" field.
+func (u *LineItemUpsert) AddTotalPrice(v float64) *LineItemUpsert {
+	u.Add(lineitem.FieldTotalPrice, v)
+	return u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *LineItemUpsert) SetDiscountAmount(v float64) *LineItemUpsert {
+	u.Set(lineitem.FieldDiscountAmount, v)
+	return u
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *LineItemUpsert) UpdateDiscountAmount() *LineItemUpsert {
+	u.SetExcluded(lineitem.FieldDiscountAmount)
+	return u
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *LineItemUpsert) AddDiscountAmount(v float64) *LineItemUpsert {
+	u.Add(lineitem.FieldDiscountAmount, v)
+	return u
+}
+
+// ClearDiscountAmount clears the value of the "discount_amount" field.
+func (u *LineItemUpsert) ClearDiscountAmount() *LineItemUpsert {
+	u.SetNull(lineitem.FieldDiscountAmount)
+	return u
+}
+
+// SetDiscountDescription sets the "discount_description" field.
+func (u *LineItemUpsert) SetDiscountDescription(v string) *LineItemUpsert {
+	u.Set(lineitem.FieldDiscountDescription, v)
+	return u
+}
+
+// UpdateDiscountDescription sets the "discount_description" field to the value that was provided on create.
+func (u *LineItemUpsert) UpdateDiscountDescription() *LineItemUpsert {
+	u.SetExcluded(lineitem.FieldDiscountDescription)
+	return u
+}
+
+// ClearDiscountDescription clears the value of the "discount_description" field.
+func (u *LineItemUpsert) ClearDiscountDescription() *LineItemUpsert {
+	u.SetNull(lineitem.FieldDiscountDescription)
+	return u
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (u *LineItemUpsert) SetTaxAmount(v float64) *LineItemUpsert {
+	u.Set(lineitem.FieldTaxAmount, v)
+	return u
+}
+
+// UpdateTaxAmount sets the "tax_amount" field to the value that was provided on create.
+func (u *LineItemUpsert) UpdateTaxAmount() *LineItemUpsert {
+	u.SetExcluded(lineitem.FieldTaxAmount)
+	return u
+}
+
+// AddTaxAmount adds v to the "tax_amount" field.
+func (u *LineItemUpsert) AddTaxAmount(v float64) *LineItemUpsert {
+	u.Add(lineitem.FieldTaxAmount, v)
+	return u
+}
+
+// ClearTaxAmount clears the value of the "tax_amount" field.
+func (u *LineItemUpsert) ClearTaxAmount() *LineItemUpsert {
+	u.SetNull(lineitem.FieldTaxAmount)
+	return u
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (u *LineItemUpsert) SetTaxRate(v float64) *LineItemUpsert {
+	u.Set(lineitem.FieldTaxRate, v)
+	return u
+}
+
+// UpdateTaxRate sets the "tax_rate" field to the value that was provided on create.
+func (u *LineItemUpsert) UpdateTaxRate() *LineItemUpsert {
+	u.SetExcluded(lineitem.FieldTaxRate)
+	return u
+}
+
+// AddTaxRate adds v to the "tax_rate" field.
+func (u *LineItemUpsert) AddTaxRate(v float64) *LineItemUpsert {
+	u.Add(lineitem.FieldTaxRate, v)
+	return u
+}
+
+// ClearTaxRate clears the value of the "tax_rate" field.
+func (u *LineItemUpsert) ClearTaxRate() *LineItemUpsert {
+	u.SetNull(lineitem.FieldTaxRate)
+	return u
+}
+
+// SetIsTaxable sets the "is_taxable" field.
+func (u *LineItemUpsert) SetIsTaxable(v bool) *LineItemUpsert {
+	u.Set(lineitem.FieldIsTaxable, v)
+	return u
+}
+
+// UpdateIsTaxable sets the "is_taxable" field to the value that was provided on create.
+func (u *LineItemUpsert) UpdateIsTaxable() *LineItemUpsert {
+	u.SetExcluded(lineitem.FieldIsTaxable)
+	return u
+}
+
+// SetCategory sets the "category" field.
+func (u *LineItemUpsert) SetCategory(v string) *LineItemUpsert {
+	u.Set(lineitem.FieldCategory, v)
+	return u
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *LineItemUpsert) UpdateCategory() *LineItemUpsert {
+	u.SetExcluded(lineitem.FieldCategory)
+	return u
+}
+
+// ClearCategory clears the value of the "category" field.
+func (u *LineItemUpsert) ClearCategory() *LineItemUpsert {
+	u.SetNull(lineitem.FieldCategory)
+	return u
+}
+
+// SetTags sets the "tags" field.
+func (u *LineItemUpsert) SetTags(v []string) *LineItemUpsert {
+	u.Set(lineitem.FieldTags, v)
+	return u
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *LineItemUpsert) UpdateTags() *LineItemUpsert {
+	u.SetExcluded(lineitem.FieldTags)
+	return u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *LineItemUpsert) ClearTags() *LineItemUpsert {
+	u.SetNull(lineitem.FieldTags)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *LineItemUpsert) SetMetadata(v map[string]interface{}) *LineItemUpsert {
+	u.Set(lineitem.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *LineItemUpsert) UpdateMetadata() *LineItemUpsert {
+	u.SetExcluded(lineitem.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *LineItemUpsert) ClearMetadata() *LineItemUpsert {
+	u.SetNull(lineitem.FieldMetadata)
+	return u
+}
+
+// SetLegacyID sets the "legacy_id" field.
+func (u *LineItemUpsert) SetLegacyID(v string) *LineItemUpsert {
+	u.Set(lineitem.FieldLegacyID, v)
+	return u
+}
+
+// UpdateLegacyID sets the "legacy_id" field to the value that was provided on create.
+func (u *LineItemUpsert) UpdateLegacyID() *LineItemUpsert {
+	u.SetExcluded(lineitem.FieldLegacyID)
+	return u
+}
+
+// ClearLegacyID clears the value of the "legacy_id" field.
+func (u *LineItemUpsert) ClearLegacyID() *LineItemUpsert {
+	u.SetNull(lineitem.FieldLegacyID)
+	return u
+}
+
+// SetMigrationRunID sets the "migration_run_id" field.
+func (u *LineItemUpsert) SetMigrationRunID(v string) *LineItemUpsert {
+	u.Set(lineitem.FieldMigrationRunID, v)
+	return u
+}
+
+// UpdateMigrationRunID sets the "migration_run_id" field to the value that was provided on create.
+func (u *LineItemUpsert) UpdateMigrationRunID() *LineItemUpsert {
+	u.SetExcluded(lineitem.FieldMigrationRunID)
+	return u
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (u *LineItemUpsert) ClearMigrationRunID() *LineItemUpsert {
+	u.SetNull(lineitem.FieldMigrationRunID)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LineItemUpsert) SetUpdatedAt(v time.Time) *LineItemUpsert {
+	u.Set(lineitem.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LineItemUpsert) UpdateUpdatedAt() *LineItemUpsert {
+	u.SetExcluded(lineitem.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LineItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(lineitem.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LineItemUpsertOne) UpdateNewValues() *LineItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(lineitem.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(lineitem.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LineItem.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LineItemUpsertOne) Ignore() *LineItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LineItemUpsertOne) DoNothing() *LineItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LineItemCreate.OnConflict
+// documentation for more info.
+func (u *LineItemUpsertOne) Update(set func(*LineItemUpsert)) *LineItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LineItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *LineItemUpsertOne) SetReceiptID(v string) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetReceiptID(v)
+	})
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *LineItemUpsertOne) UpdateReceiptID() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateReceiptID()
+	})
+}
+
+// SetLineNumber sets the "line_number" field.
+func (u *LineItemUpsertOne) SetLineNumber(v int) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetLineNumber(v)
+	})
+}
+
+// AddLineNumber adds v to the "line_number" field.
+func (u *LineItemUpsertOne) AddLineNumber(v int) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.AddLineNumber(v)
+	})
+}
+
+// UpdateLineNumber sets the "line_number" field to the value that was provided on create.
+func (u *LineItemUpsertOne) UpdateLineNumber() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateLineNumber()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *LineItemUpsertOne) SetDescription(v string) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *LineItemUpsertOne) UpdateDescription() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetSku sets the "sku" field.
+func (u *LineItemUpsertOne) SetSku(v string) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetSku(v)
+	})
+}
+
+// UpdateSku sets the "sku" field to the value that was provided on create.
+func (u *LineItemUpsertOne) UpdateSku() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateSku()
+	})
+}
+
+// ClearSku clears the value of the "sku" field.
+func (u *LineItemUpsertOne) ClearSku() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearSku()
+	})
+}
+
+// SetProductCode sets the "product_code" field.
+func (u *LineItemUpsertOne) SetProductCode(v string) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetProductCode(v)
+	})
+}
+
+// UpdateProductCode sets the "product_code" field to the value that was provided on create.
+func (u *LineItemUpsertOne) UpdateProductCode() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateProductCode()
+	})
+}
+
+// ClearProductCode clears the value of the "product_code" field.
+func (u *LineItemUpsertOne) ClearProductCode() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearProductCode()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *LineItemUpsertOne) SetQuantity(v float64) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *LineItemUpsertOne) AddQuantity(v float64) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *LineItemUpsertOne) UpdateQuantity() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetUnit sets the "unit" field.
+func (u *LineItemUpsertOne) SetUnit(v string) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetUnit(v)
+	})
+}
+
+// UpdateUnit sets the "unit" field to the value that was provided on create.
+func (u *LineItemUpsertOne) UpdateUnit() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateUnit()
+	})
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (u *LineItemUpsertOne) ClearUnit() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearUnit()
+	})
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (u *LineItemUpsertOne) SetUnitPrice(v float64) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetUnitPrice(v)
+	})
+}
+
+// AddUnitPrice adds v to the "unit_price" field.
+func (u *LineItemUpsertOne) AddUnitPrice(v float64) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.AddUnitPrice(v)
+	})
+}
+
+// UpdateUnitPrice sets the "unit_price" field to the value that was provided on create.
+func (u *LineItemUpsertOne) UpdateUnitPrice() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateUnitPrice()
+	})
+}
+
+// SetTotalPrice sets the "total_price" field.
+func (u *LineItemUpsertOne) SetTotalPrice(v float64) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetTotalPrice(v)
+	})
+}
+
+// AddTotalPrice adds v to the "total_price" field.
+func (u *LineItemUpsertOne) AddTotalPrice(v float64) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.AddTotalPrice(v)
+	})
+}
+
+// UpdateTotalPrice sets the "total_price" field to the value that was provided on create.
+func (u *LineItemUpsertOne) UpdateTotalPrice() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateTotalPrice()
+	})
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *LineItemUpsertOne) SetDiscountAmount(v float64) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetDiscountAmount(v)
+	})
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *LineItemUpsertOne) AddDiscountAmount(v float64) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.AddDiscountAmount(v)
+	})
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *LineItemUpsertOne) UpdateDiscountAmount() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateDiscountAmount()
+	})
+}
+
+// ClearDiscountAmount clears the value of the "discount_amount" field.
+func (u *LineItemUpsertOne) ClearDiscountAmount() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearDiscountAmount()
+	})
+}
+
+// SetDiscountDescription sets the "discount_description" field.
+func (u *LineItemUpsertOne) SetDiscountDescription(v string) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetDiscountDescription(v)
+	})
+}
+
+// UpdateDiscountDescription sets the "discount_description" field to the value that was provided on create.
+func (u *LineItemUpsertOne) UpdateDiscountDescription() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateDiscountDescription()
+	})
+}
+
+// ClearDiscountDescription clears the value of the "discount_description" field.
+func (u *LineItemUpsertOne) ClearDiscountDescription() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearDiscountDescription()
+	})
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (u *LineItemUpsertOne) SetTaxAmount(v float64) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetTaxAmount(v)
+	})
+}
+
+// AddTaxAmount adds v to the "tax_amount" field.
+func (u *LineItemUpsertOne) AddTaxAmount(v float64) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.AddTaxAmount(v)
+	})
+}
+
+// UpdateTaxAmount sets the "tax_amount" field to the value that was provided on create.
+func (u *LineItemUpsertOne) UpdateTaxAmount() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateTaxAmount()
+	})
+}
+
+// ClearTaxAmount clears the value of the "tax_amount" field.
+func (u *LineItemUpsertOne) ClearTaxAmount() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearTaxAmount()
+	})
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (u *LineItemUpsertOne) SetTaxRate(v float64) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetTaxRate(v)
+	})
+}
+
+// AddTaxRate adds v to the "tax_rate" field.
+func (u *LineItemUpsertOne) AddTaxRate(v float64) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.AddTaxRate(v)
+	})
+}
+
+// UpdateTaxRate sets the "tax_rate" field to the value that was provided on create.
+func (u *LineItemUpsertOne) UpdateTaxRate() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateTaxRate()
+	})
+}
+
+// ClearTaxRate clears the value of the "tax_rate" field.
+func (u *LineItemUpsertOne) ClearTaxRate() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearTaxRate()
+	})
+}
+
+// SetIsTaxable sets the "is_taxable" field.
+func (u *LineItemUpsertOne) SetIsTaxable(v bool) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetIsTaxable(v)
+	})
+}
+
+// UpdateIsTaxable sets the "is_taxable" field to the value that was provided on create.
+func (u *LineItemUpsertOne) UpdateIsTaxable() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateIsTaxable()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *LineItemUpsertOne) SetCategory(v string) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *LineItemUpsertOne) UpdateCategory() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// ClearCategory clears the value of the "category" field.
+func (u *LineItemUpsertOne) ClearCategory() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearCategory()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *LineItemUpsertOne) SetTags(v []string) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *LineItemUpsertOne) UpdateTags() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *LineItemUpsertOne) ClearTags() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearTags()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *LineItemUpsertOne) SetMetadata(v map[string]interface{}) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *LineItemUpsertOne) UpdateMetadata() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *LineItemUpsertOne) ClearMetadata() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetLegacyID sets the "legacy_id" field.
+func (u *LineItemUpsertOne) SetLegacyID(v string) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetLegacyID(v)
+	})
+}
+
+// UpdateLegacyID sets the "legacy_id" field to the value that was provided on create.
+func (u *LineItemUpsertOne) UpdateLegacyID() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateLegacyID()
+	})
+}
+
+// ClearLegacyID clears the value of the "legacy_id" field.
+func (u *LineItemUpsertOne) ClearLegacyID() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearLegacyID()
+	})
+}
+
+// SetMigrationRunID sets the "migration_run_id" field.
+func (u *LineItemUpsertOne) SetMigrationRunID(v string) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetMigrationRunID(v)
+	})
+}
+
+// UpdateMigrationRunID sets the "migration_run_id" field to the value that was provided on create.
+func (u *LineItemUpsertOne) UpdateMigrationRunID() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateMigrationRunID()
+	})
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (u *LineItemUpsertOne) ClearMigrationRunID() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearMigrationRunID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LineItemUpsertOne) SetUpdatedAt(v time.Time) *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LineItemUpsertOne) UpdateUpdatedAt() *LineItemUpsertOne {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LineItemUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LineItemCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LineItemUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LineItemUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LineItemUpsertOne.ID is not supported by MySQL driver. Use LineItemUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LineItemUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // LineItemCreateBulk is the builder for creating many LineItem entities in bulk.
 type LineItemCreateBulk struct {
 	config
 	err      error
 	builders []*LineItemCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the LineItem entities in the database.
@@ -536,6 +1464,7 @@ func (_c *LineItemCreateBulk) Save(ctx context.Context) ([]*LineItem, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -585,3 +1514,533 @@ func (_c *LineItemCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LineItem.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LineItemUpsert) {
+//			SetReceiptID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LineItemCreateBulk) OnConflict(opts ...sql.ConflictOption) *LineItemUpsertBulk {
+	_c.conflict = opts
+	return &LineItemUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LineItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LineItemCreateBulk) OnConflictColumns(columns ...string) *LineItemUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LineItemUpsertBulk{
+		create: _c,
+	}
+}
+
+// LineItemUpsertBulk is the builder for "upsert"-ing
+// a bulk of LineItem nodes.
+type LineItemUpsertBulk struct {
+	create *LineItemCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LineItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(lineitem.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LineItemUpsertBulk) UpdateNewValues() *LineItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(lineitem.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(lineitem.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LineItem.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LineItemUpsertBulk) Ignore() *LineItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LineItemUpsertBulk) DoNothing() *LineItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LineItemCreateBulk.OnConflict
+// documentation for more info.
+func (u *LineItemUpsertBulk) Update(set func(*LineItemUpsert)) *LineItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LineItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *LineItemUpsertBulk) SetReceiptID(v string) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetReceiptID(v)
+	})
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *LineItemUpsertBulk) UpdateReceiptID() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateReceiptID()
+	})
+}
+
+// SetLineNumber sets the "line_number" field.
+func (u *LineItemUpsertBulk) SetLineNumber(v int) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetLineNumber(v)
+	})
+}
+
+// AddLineNumber adds v to the "line_number" field.
+func (u *LineItemUpsertBulk) AddLineNumber(v int) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.AddLineNumber(v)
+	})
+}
+
+// UpdateLineNumber sets the "line_number" field to the value that was provided on create.
+func (u *LineItemUpsertBulk) UpdateLineNumber() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateLineNumber()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *LineItemUpsertBulk) SetDescription(v string) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *LineItemUpsertBulk) UpdateDescription() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetSku sets the "sku" field.
+func (u *LineItemUpsertBulk) SetSku(v string) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetSku(v)
+	})
+}
+
+// UpdateSku sets the "sku" field to the value that was provided on create.
+func (u *LineItemUpsertBulk) UpdateSku() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateSku()
+	})
+}
+
+// ClearSku clears the value of the "sku" field.
+func (u *LineItemUpsertBulk) ClearSku() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearSku()
+	})
+}
+
+// SetProductCode sets the "product_code" field.
+func (u *LineItemUpsertBulk) SetProductCode(v string) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetProductCode(v)
+	})
+}
+
+// UpdateProductCode sets the "product_code" field to the value that was provided on create.
+func (u *LineItemUpsertBulk) UpdateProductCode() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateProductCode()
+	})
+}
+
+// ClearProductCode clears the value of the "product_code" field.
+func (u *LineItemUpsertBulk) ClearProductCode() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearProductCode()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *LineItemUpsertBulk) SetQuantity(v float64) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *LineItemUpsertBulk) AddQuantity(v float64) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *LineItemUpsertBulk) UpdateQuantity() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetUnit sets the "unit" field.
+func (u *LineItemUpsertBulk) SetUnit(v string) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetUnit(v)
+	})
+}
+
+// UpdateUnit sets the "unit" field to the value that was provided on create.
+func (u *LineItemUpsertBulk) UpdateUnit() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateUnit()
+	})
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (u *LineItemUpsertBulk) ClearUnit() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearUnit()
+	})
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (u *LineItemUpsertBulk) SetUnitPrice(v float64) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetUnitPrice(v)
+	})
+}
+
+// AddUnitPrice adds v to the "unit_price" field.
+func (u *LineItemUpsertBulk) AddUnitPrice(v float64) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.AddUnitPrice(v)
+	})
+}
+
+// UpdateUnitPrice sets the "unit_price" field to the value that was provided on create.
+func (u *LineItemUpsertBulk) UpdateUnitPrice() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateUnitPrice()
+	})
+}
+
+// SetTotalPrice sets the "total_price" field.
+func (u *LineItemUpsertBulk) SetTotalPrice(v float64) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetTotalPrice(v)
+	})
+}
+
+// AddTotalPrice adds v to the "total_price" field.
+func (u *LineItemUpsertBulk) AddTotalPrice(v float64) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.AddTotalPrice(v)
+	})
+}
+
+// UpdateTotalPrice sets the "total_price" field to the value that was provided on create.
+func (u *LineItemUpsertBulk) UpdateTotalPrice() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateTotalPrice()
+	})
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *LineItemUpsertBulk) SetDiscountAmount(v float64) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetDiscountAmount(v)
+	})
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *LineItemUpsertBulk) AddDiscountAmount(v float64) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.AddDiscountAmount(v)
+	})
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *LineItemUpsertBulk) UpdateDiscountAmount() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateDiscountAmount()
+	})
+}
+
+// ClearDiscountAmount clears the value of the "discount_amount" field.
+func (u *LineItemUpsertBulk) ClearDiscountAmount() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearDiscountAmount()
+	})
+}
+
+// SetDiscountDescription sets the "discount_description" field.
+func (u *LineItemUpsertBulk) SetDiscountDescription(v string) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetDiscountDescription(v)
+	})
+}
+
+// UpdateDiscountDescription sets the "discount_description" field to the value that was provided on create.
+func (u *LineItemUpsertBulk) UpdateDiscountDescription() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateDiscountDescription()
+	})
+}
+
+// ClearDiscountDescription clears the value of the "discount_description" field.
+func (u *LineItemUpsertBulk) ClearDiscountDescription() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearDiscountDescription()
+	})
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (u *LineItemUpsertBulk) SetTaxAmount(v float64) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetTaxAmount(v)
+	})
+}
+
+// AddTaxAmount adds v to the "tax_amount" field.
+func (u *LineItemUpsertBulk) AddTaxAmount(v float64) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.AddTaxAmount(v)
+	})
+}
+
+// UpdateTaxAmount sets the "tax_amount" field to the value that was provided on create.
+func (u *LineItemUpsertBulk) UpdateTaxAmount() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateTaxAmount()
+	})
+}
+
+// ClearTaxAmount clears the value of the "tax_amount" field.
+func (u *LineItemUpsertBulk) ClearTaxAmount() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearTaxAmount()
+	})
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (u *LineItemUpsertBulk) SetTaxRate(v float64) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetTaxRate(v)
+	})
+}
+
+// AddTaxRate adds v to the "tax_rate" field.
+func (u *LineItemUpsertBulk) AddTaxRate(v float64) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.AddTaxRate(v)
+	})
+}
+
+// UpdateTaxRate sets the "tax_rate" field to the value that was provided on create.
+func (u *LineItemUpsertBulk) UpdateTaxRate() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateTaxRate()
+	})
+}
+
+// ClearTaxRate clears the value of the "tax_rate" field.
+func (u *LineItemUpsertBulk) ClearTaxRate() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearTaxRate()
+	})
+}
+
+// SetIsTaxable sets the "is_taxable" field.
+func (u *LineItemUpsertBulk) SetIsTaxable(v bool) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetIsTaxable(v)
+	})
+}
+
+// UpdateIsTaxable sets the "is_taxable" field to the value that was provided on create.
+func (u *LineItemUpsertBulk) UpdateIsTaxable() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateIsTaxable()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *LineItemUpsertBulk) SetCategory(v string) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *LineItemUpsertBulk) UpdateCategory() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// ClearCategory clears the value of the "category" field.
+func (u *LineItemUpsertBulk) ClearCategory() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearCategory()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *LineItemUpsertBulk) SetTags(v []string) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *LineItemUpsertBulk) UpdateTags() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *LineItemUpsertBulk) ClearTags() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearTags()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *LineItemUpsertBulk) SetMetadata(v map[string]interface{}) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *LineItemUpsertBulk) UpdateMetadata() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *LineItemUpsertBulk) ClearMetadata() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetLegacyID sets the "legacy_id" field.
+func (u *LineItemUpsertBulk) SetLegacyID(v string) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetLegacyID(v)
+	})
+}
+
+// UpdateLegacyID sets the "legacy_id" field to the value that was provided on create.
+func (u *LineItemUpsertBulk) UpdateLegacyID() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateLegacyID()
+	})
+}
+
+// ClearLegacyID clears the value of the "legacy_id" field.
+func (u *LineItemUpsertBulk) ClearLegacyID() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearLegacyID()
+	})
+}
+
+// SetMigrationRunID sets the "migration_run_id" field.
+func (u *LineItemUpsertBulk) SetMigrationRunID(v string) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetMigrationRunID(v)
+	})
+}
+
+// UpdateMigrationRunID sets the "migration_run_id" field to the value that was provided on create.
+func (u *LineItemUpsertBulk) UpdateMigrationRunID() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateMigrationRunID()
+	})
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (u *LineItemUpsertBulk) ClearMigrationRunID() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.ClearMigrationRunID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LineItemUpsertBulk) SetUpdatedAt(v time.Time) *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LineItemUpsertBulk) UpdateUpdatedAt() *LineItemUpsertBulk {
+	return u.Update(func(s *LineItemUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LineItemUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LineItemCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LineItemCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LineItemUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -387,6 +387,26 @@ func (_u *LineItemUpdate) ClearLegacyID() *LineItemUpdate {
 	return _u
 }
 
+// SetMigrationRunID sets the "migration_run_id" field.
+func (_u *LineItemUpdate) SetMigrationRunID(v string) *LineItemUpdate {
+	_u.mutation.SetMigrationRunID(v)
+	return _u
+}
+
+// SetNillableMigrationRunID sets the "migration_run_id" field if the given value is not nil.
+func (_u *LineItemUpdate) SetNillableMigrationRunID(v *string) *LineItemUpdate {
+	if v != nil {
+		_u.SetMigrationRunID(*v)
+	}
+	return _u
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (_u *LineItemUpdate) ClearMigrationRunID() *LineItemUpdate {
+	_u.mutation.ClearMigrationRunID()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *LineItemUpdate) SetUpdatedAt(v time.Time) *LineItemUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -585,6 +605,12 @@ func (_u *LineItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.LegacyIDCleared() {
 		_spec.ClearField(lineitem.FieldLegacyID, field.TypeString)
 	}
+	if value, ok := _u.mutation.MigrationRunID(); ok {
+		_spec.SetField(lineitem.FieldMigrationRunID, field.TypeString, value)
+	}
+	if _u.mutation.MigrationRunIDCleared() {
+		_spec.ClearField(lineitem.FieldMigrationRunID, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lineitem.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -994,6 +1020,26 @@ func (_u *LineItemUpdateOne) ClearLegacyID() *LineItemUpdateOne {
 	return _u
 }
 
+// SetMigrationRunID sets the "migration_run_id" field.
+func (_u *LineItemUpdateOne) SetMigrationRunID(v string) *LineItemUpdateOne {
+	_u.mutation.SetMigrationRunID(v)
+	return _u
+}
+
+// SetNillableMigrationRunID sets the "migration_run_id" field if the given value is not nil.
+func (_u *LineItemUpdateOne) SetNillableMigrationRunID(v *string) *LineItemUpdateOne {
+	if v != nil {
+		_u.SetMigrationRunID(*v)
+	}
+	return _u
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (_u *LineItemUpdateOne) ClearMigrationRunID() *LineItemUpdateOne {
+	_u.mutation.ClearMigrationRunID()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *LineItemUpdateOne) SetUpdatedAt(v time.Time) *LineItemUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -1222,6 +1268,12 @@ func (_u *LineItemUpdateOne) sqlSave(ctx context.Context) (_node *LineItem, err
 	if _u.mutation.LegacyIDCleared() {
 		_spec.ClearField(lineitem.FieldLegacyID, field.TypeString)
 	}
+	if value, ok := _u.mutation.MigrationRunID(); ok {
+		_spec.SetField(lineitem.FieldMigrationRunID, field.TypeString, value)
+	}
+	if _u.mutation.MigrationRunIDCleared() {
+		_spec.ClearField(lineitem.FieldMigrationRunID, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(lineitem.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -0,0 +1,868 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/liquidaccount"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LiquidAccountCreate is the builder for creating a LiquidAccount entity.
+type LiquidAccountCreate struct {
+	config
+	mutation *LiquidAccountMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LiquidAccountCreate) SetUserID(v string) *LiquidAccountCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *LiquidAccountCreate) SetName(v string) *LiquidAccountCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *LiquidAccountCreate) SetType(v liquidaccount.Type) *LiquidAccountCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_c *LiquidAccountCreate) SetNillableType(v *liquidaccount.Type) *LiquidAccountCreate {
+	if v != nil {
+		_c.SetType(*v)
+	}
+	return _c
+}
+
+// SetBalance sets the "balance" field.
+func (_c *LiquidAccountCreate) SetBalance(v float64) *LiquidAccountCreate {
+	_c.mutation.SetBalance(v)
+	return _c
+}
+
+// SetEmergencyFund sets the "emergency_fund" field.
+func (_c *LiquidAccountCreate) SetEmergencyFund(v bool) *LiquidAccountCreate {
+	_c.mutation.SetEmergencyFund(v)
+	return _c
+}
+
+// SetNillableEmergencyFund sets the "emergency_fund" field if the given value is not nil.
+func (_c *LiquidAccountCreate) SetNillableEmergencyFund(v *bool) *LiquidAccountCreate {
+	if v != nil {
+		_c.SetEmergencyFund(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LiquidAccountCreate) SetCreatedAt(v time.Time) *LiquidAccountCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LiquidAccountCreate) SetNillableCreatedAt(v *time.Time) *LiquidAccountCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LiquidAccountCreate) SetUpdatedAt(v time.Time) *LiquidAccountCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LiquidAccountCreate) SetNillableUpdatedAt(v *time.Time) *LiquidAccountCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LiquidAccountCreate) SetID(v string) *LiquidAccountCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the LiquidAccountMutation object of the builder.
+func (_c *LiquidAccountCreate) Mutation() *LiquidAccountMutation {
+	return _c.mutation
+}
+
+// Save creates the LiquidAccount in the database.
+func (_c *LiquidAccountCreate) Save(ctx context.Context) (*LiquidAccount, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LiquidAccountCreate) SaveX(ctx context.Context) *LiquidAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LiquidAccountCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LiquidAccountCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LiquidAccountCreate) defaults() {
+	if _, ok := _c.mutation.GetType(); !ok {
+		v := liquidaccount.DefaultType
+		_c.mutation.SetType(v)
+	}
+	if _, ok := _c.mutation.EmergencyFund(); !ok {
+		v := liquidaccount.DefaultEmergencyFund
+		_c.mutation.SetEmergencyFund(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := liquidaccount.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := liquidaccount.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LiquidAccountCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "LiquidAccount.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := liquidaccount.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "LiquidAccount.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "LiquidAccount.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := liquidaccount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LiquidAccount.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "LiquidAccount.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := liquidaccount.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "LiquidAccount.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Balance(); !ok {
+		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required field "LiquidAccount.balance"`)}
+	}
+	if v, ok := _c.mutation.Balance(); ok {
+		if err := liquidaccount.BalanceValidator(v); err != nil {
+			return &ValidationError{Name: "balance", err: fmt.Errorf(`ent: validator failed for field "LiquidAccount.balance": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EmergencyFund(); !ok {
+		return &ValidationError{Name: "emergency_fund", err: errors.New(`ent: missing required field "LiquidAccount.emergency_fund"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LiquidAccount.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LiquidAccount.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *LiquidAccountCreate) sqlSave(ctx context.Context) (*LiquidAccount, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected LiquidAccount.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LiquidAccountCreate) createSpec() (*LiquidAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LiquidAccount{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(liquidaccount.Table, sqlgraph.NewFieldSpec(liquidaccount.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(liquidaccount.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(liquidaccount.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(liquidaccount.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Balance(); ok {
+		_spec.SetField(liquidaccount.FieldBalance, field.TypeFloat64, value)
+		_node.Balance = value
+	}
+	if value, ok := _c.mutation.EmergencyFund(); ok {
+		_spec.SetField(liquidaccount.FieldEmergencyFund, field.TypeBool, value)
+		_node.EmergencyFund = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(liquidaccount.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(liquidaccount.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LiquidAccount.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LiquidAccountUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LiquidAccountCreate) OnConflict(opts ...sql.ConflictOption) *LiquidAccountUpsertOne {
+	_c.conflict = opts
+	return &LiquidAccountUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LiquidAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LiquidAccountCreate) OnConflictColumns(columns ...string) *LiquidAccountUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LiquidAccountUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LiquidAccountUpsertOne is the builder for "upsert"-ing
+	//  one LiquidAccount node.
+	LiquidAccountUpsertOne struct {
+		create *LiquidAccountCreate
+	}
+
+	// LiquidAccountUpsert is the "OnConflict" setter.
+	LiquidAccountUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *LiquidAccountUpsert) SetUserID(v string) *LiquidAccountUpsert {
+	u.Set(liquidaccount.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LiquidAccountUpsert) UpdateUserID() *LiquidAccountUpsert {
+	u.SetExcluded(liquidaccount.FieldUserID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *LiquidAccountUpsert) SetName(v string) *LiquidAccountUpsert {
+	u.Set(liquidaccount.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LiquidAccountUpsert) UpdateName() *LiquidAccountUpsert {
+	u.SetExcluded(liquidaccount.FieldName)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *LiquidAccountUpsert) SetType(v liquidaccount.Type) *LiquidAccountUpsert {
+	u.Set(liquidaccount.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *LiquidAccountUpsert) UpdateType() *LiquidAccountUpsert {
+	u.SetExcluded(liquidaccount.FieldType)
+	return u
+}
+
+// SetBalance sets the "balance" field.
+func (u *LiquidAccountUpsert) SetBalance(v float64) *LiquidAccountUpsert {
+	u.Set(liquidaccount.FieldBalance, v)
+	return u
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *LiquidAccountUpsert) UpdateBalance() *LiquidAccountUpsert {
+	u.SetExcluded(liquidaccount.FieldBalance)
+	return u
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *LiquidAccountUpsert) AddBalance(v float64) *LiquidAccountUpsert {
+	u.Add(liquidaccount.FieldBalance, v)
+	return u
+}
+
+// SetEmergencyFund sets the "emergency_fund" field.
+func (u *LiquidAccountUpsert) SetEmergencyFund(v bool) *LiquidAccountUpsert {
+	u.Set(liquidaccount.FieldEmergencyFund, v)
+	return u
+}
+
+// UpdateEmergencyFund sets the "emergency_fund" field to the value that was provided on create.
+func (u *LiquidAccountUpsert) UpdateEmergencyFund() *LiquidAccountUpsert {
+	u.SetExcluded(liquidaccount.FieldEmergencyFund)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LiquidAccountUpsert) SetUpdatedAt(v time.Time) *LiquidAccountUpsert {
+	u.Set(liquidaccount.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LiquidAccountUpsert) UpdateUpdatedAt() *LiquidAccountUpsert {
+	u.SetExcluded(liquidaccount.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LiquidAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(liquidaccount.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LiquidAccountUpsertOne) UpdateNewValues() *LiquidAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(liquidaccount.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(liquidaccount.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LiquidAccount.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LiquidAccountUpsertOne) Ignore() *LiquidAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LiquidAccountUpsertOne) DoNothing() *LiquidAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LiquidAccountCreate.OnConflict
+// documentation for more info.
+func (u *LiquidAccountUpsertOne) Update(set func(*LiquidAccountUpsert)) *LiquidAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LiquidAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *LiquidAccountUpsertOne) SetUserID(v string) *LiquidAccountUpsertOne {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LiquidAccountUpsertOne) UpdateUserID() *LiquidAccountUpsertOne {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *LiquidAccountUpsertOne) SetName(v string) *LiquidAccountUpsertOne {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LiquidAccountUpsertOne) UpdateName() *LiquidAccountUpsertOne {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *LiquidAccountUpsertOne) SetType(v liquidaccount.Type) *LiquidAccountUpsertOne {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *LiquidAccountUpsertOne) UpdateType() *LiquidAccountUpsertOne {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *LiquidAccountUpsertOne) SetBalance(v float64) *LiquidAccountUpsertOne {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *LiquidAccountUpsertOne) AddBalance(v float64) *LiquidAccountUpsertOne {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *LiquidAccountUpsertOne) UpdateBalance() *LiquidAccountUpsertOne {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// SetEmergencyFund sets the "emergency_fund" field.
+func (u *LiquidAccountUpsertOne) SetEmergencyFund(v bool) *LiquidAccountUpsertOne {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.SetEmergencyFund(v)
+	})
+}
+
+// UpdateEmergencyFund sets the "emergency_fund" field to the value that was provided on create.
+func (u *LiquidAccountUpsertOne) UpdateEmergencyFund() *LiquidAccountUpsertOne {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.UpdateEmergencyFund()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LiquidAccountUpsertOne) SetUpdatedAt(v time.Time) *LiquidAccountUpsertOne {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LiquidAccountUpsertOne) UpdateUpdatedAt() *LiquidAccountUpsertOne {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LiquidAccountUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LiquidAccountCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LiquidAccountUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LiquidAccountUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LiquidAccountUpsertOne.ID is not supported by MySQL driver. Use LiquidAccountUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LiquidAccountUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LiquidAccountCreateBulk is the builder for creating many LiquidAccount entities in bulk.
+type LiquidAccountCreateBulk struct {
+	config
+	err      error
+	builders []*LiquidAccountCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LiquidAccount entities in the database.
+func (_c *LiquidAccountCreateBulk) Save(ctx context.Context) ([]*LiquidAccount, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LiquidAccount, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LiquidAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LiquidAccountCreateBulk) SaveX(ctx context.Context) []*LiquidAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LiquidAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LiquidAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LiquidAccount.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LiquidAccountUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LiquidAccountCreateBulk) OnConflict(opts ...sql.ConflictOption) *LiquidAccountUpsertBulk {
+	_c.conflict = opts
+	return &LiquidAccountUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LiquidAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LiquidAccountCreateBulk) OnConflictColumns(columns ...string) *LiquidAccountUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LiquidAccountUpsertBulk{
+		create: _c,
+	}
+}
+
+// LiquidAccountUpsertBulk is the builder for "upsert"-ing
+// a bulk of LiquidAccount nodes.
+type LiquidAccountUpsertBulk struct {
+	create *LiquidAccountCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LiquidAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(liquidaccount.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LiquidAccountUpsertBulk) UpdateNewValues() *LiquidAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(liquidaccount.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(liquidaccount.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LiquidAccount.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LiquidAccountUpsertBulk) Ignore() *LiquidAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LiquidAccountUpsertBulk) DoNothing() *LiquidAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LiquidAccountCreateBulk.OnConflict
+// documentation for more info.
+func (u *LiquidAccountUpsertBulk) Update(set func(*LiquidAccountUpsert)) *LiquidAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LiquidAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *LiquidAccountUpsertBulk) SetUserID(v string) *LiquidAccountUpsertBulk {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LiquidAccountUpsertBulk) UpdateUserID() *LiquidAccountUpsertBulk {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *LiquidAccountUpsertBulk) SetName(v string) *LiquidAccountUpsertBulk {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LiquidAccountUpsertBulk) UpdateName() *LiquidAccountUpsertBulk {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *LiquidAccountUpsertBulk) SetType(v liquidaccount.Type) *LiquidAccountUpsertBulk {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *LiquidAccountUpsertBulk) UpdateType() *LiquidAccountUpsertBulk {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *LiquidAccountUpsertBulk) SetBalance(v float64) *LiquidAccountUpsertBulk {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *LiquidAccountUpsertBulk) AddBalance(v float64) *LiquidAccountUpsertBulk {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *LiquidAccountUpsertBulk) UpdateBalance() *LiquidAccountUpsertBulk {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// SetEmergencyFund sets the "emergency_fund" field.
+func (u *LiquidAccountUpsertBulk) SetEmergencyFund(v bool) *LiquidAccountUpsertBulk {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.SetEmergencyFund(v)
+	})
+}
+
+// UpdateEmergencyFund sets the "emergency_fund" field to the value that was provided on create.
+func (u *LiquidAccountUpsertBulk) UpdateEmergencyFund() *LiquidAccountUpsertBulk {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.UpdateEmergencyFund()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LiquidAccountUpsertBulk) SetUpdatedAt(v time.Time) *LiquidAccountUpsertBulk {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LiquidAccountUpsertBulk) UpdateUpdatedAt() *LiquidAccountUpsertBulk {
+	return u.Update(func(s *LiquidAccountUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LiquidAccountUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LiquidAccountCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LiquidAccountCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LiquidAccountUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,458 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/liquidaccount"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LiquidAccountUpdate is the builder for updating LiquidAccount entities.
+type LiquidAccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LiquidAccountMutation
+}
+
+// Where appends a list predicates to the LiquidAccountUpdate builder.
+func (_u *LiquidAccountUpdate) Where(ps ...predicate.LiquidAccount) *LiquidAccountUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LiquidAccountUpdate) SetUserID(v string) *LiquidAccountUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LiquidAccountUpdate) SetNillableUserID(v *string) *LiquidAccountUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *LiquidAccountUpdate) SetName(v string) *LiquidAccountUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *LiquidAccountUpdate) SetNillableName(v *string) *LiquidAccountUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *LiquidAccountUpdate) SetType(v liquidaccount.Type) *LiquidAccountUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *LiquidAccountUpdate) SetNillableType(v *liquidaccount.Type) *LiquidAccountUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetBalance sets the "balance" field.
+func (_u *LiquidAccountUpdate) SetBalance(v float64) *LiquidAccountUpdate {
+	_u.mutation.ResetBalance()
+	_u.mutation.SetBalance(v)
+	return _u
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (_u *LiquidAccountUpdate) SetNillableBalance(v *float64) *LiquidAccountUpdate {
+	if v != nil {
+		_u.SetBalance(*v)
+	}
+	return _u
+}
+
+// AddBalance adds value to the "balance" field.
+func (_u *LiquidAccountUpdate) AddBalance(v float64) *LiquidAccountUpdate {
+	_u.mutation.AddBalance(v)
+	return _u
+}
+
+// SetEmergencyFund sets the "emergency_fund" field.
+func (_u *LiquidAccountUpdate) SetEmergencyFund(v bool) *LiquidAccountUpdate {
+	_u.mutation.SetEmergencyFund(v)
+	return _u
+}
+
+// SetNillableEmergencyFund sets the "emergency_fund" field if the given value is not nil.
+func (_u *LiquidAccountUpdate) SetNillableEmergencyFund(v *bool) *LiquidAccountUpdate {
+	if v != nil {
+		_u.SetEmergencyFund(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LiquidAccountUpdate) SetUpdatedAt(v time.Time) *LiquidAccountUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the LiquidAccountMutation object of the builder.
+func (_u *LiquidAccountUpdate) Mutation() *LiquidAccountMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LiquidAccountUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LiquidAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LiquidAccountUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LiquidAccountUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LiquidAccountUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := liquidaccount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LiquidAccountUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := liquidaccount.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "LiquidAccount.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := liquidaccount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LiquidAccount.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := liquidaccount.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "LiquidAccount.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Balance(); ok {
+		if err := liquidaccount.BalanceValidator(v); err != nil {
+			return &ValidationError{Name: "balance", err: fmt.Errorf(`ent: validator failed for field "LiquidAccount.balance": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *LiquidAccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(liquidaccount.Table, liquidaccount.Columns, sqlgraph.NewFieldSpec(liquidaccount.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(liquidaccount.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(liquidaccount.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(liquidaccount.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Balance(); ok {
+		_spec.SetField(liquidaccount.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBalance(); ok {
+		_spec.AddField(liquidaccount.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.EmergencyFund(); ok {
+		_spec.SetField(liquidaccount.FieldEmergencyFund, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(liquidaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{liquidaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LiquidAccountUpdateOne is the builder for updating a single LiquidAccount entity.
+type LiquidAccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LiquidAccountMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LiquidAccountUpdateOne) SetUserID(v string) *LiquidAccountUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LiquidAccountUpdateOne) SetNillableUserID(v *string) *LiquidAccountUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *LiquidAccountUpdateOne) SetName(v string) *LiquidAccountUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *LiquidAccountUpdateOne) SetNillableName(v *string) *LiquidAccountUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *LiquidAccountUpdateOne) SetType(v liquidaccount.Type) *LiquidAccountUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *LiquidAccountUpdateOne) SetNillableType(v *liquidaccount.Type) *LiquidAccountUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetBalance sets the "balance" field.
+func (_u *LiquidAccountUpdateOne) SetBalance(v float64) *LiquidAccountUpdateOne {
+	_u.mutation.ResetBalance()
+	_u.mutation.SetBalance(v)
+	return _u
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (_u *LiquidAccountUpdateOne) SetNillableBalance(v *float64) *LiquidAccountUpdateOne {
+	if v != nil {
+		_u.SetBalance(*v)
+	}
+	return _u
+}
+
+// AddBalance adds value to the "balance" field.
+func (_u *LiquidAccountUpdateOne) AddBalance(v float64) *LiquidAccountUpdateOne {
+	_u.mutation.AddBalance(v)
+	return _u
+}
+
+// SetEmergencyFund sets the "emergency_fund" field.
+func (_u *LiquidAccountUpdateOne) SetEmergencyFund(v bool) *LiquidAccountUpdateOne {
+	_u.mutation.SetEmergencyFund(v)
+	return _u
+}
+
+// SetNillableEmergencyFund sets the "emergency_fund" field if the given value is not nil.
+func (_u *LiquidAccountUpdateOne) SetNillableEmergencyFund(v *bool) *LiquidAccountUpdateOne {
+	if v != nil {
+		_u.SetEmergencyFund(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LiquidAccountUpdateOne) SetUpdatedAt(v time.Time) *LiquidAccountUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the LiquidAccountMutation object of the builder.
+func (_u *LiquidAccountUpdateOne) Mutation() *LiquidAccountMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LiquidAccountUpdate builder.
+func (_u *LiquidAccountUpdateOne) Where(ps ...predicate.LiquidAccount) *LiquidAccountUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LiquidAccountUpdateOne) Select(field string, fields ...string) *LiquidAccountUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LiquidAccount entity.
+func (_u *LiquidAccountUpdateOne) Save(ctx context.Context) (*LiquidAccount, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LiquidAccountUpdateOne) SaveX(ctx context.Context) *LiquidAccount {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LiquidAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LiquidAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LiquidAccountUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := liquidaccount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LiquidAccountUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := liquidaccount.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "LiquidAccount.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := liquidaccount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LiquidAccount.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := liquidaccount.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "LiquidAccount.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Balance(); ok {
+		if err := liquidaccount.BalanceValidator(v); err != nil {
+			return &ValidationError{Name: "balance", err: fmt.Errorf(`ent: validator failed for field "LiquidAccount.balance": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *LiquidAccountUpdateOne) sqlSave(ctx context.Context) (_node *LiquidAccount, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(liquidaccount.Table, liquidaccount.Columns, sqlgraph.NewFieldSpec(liquidaccount.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LiquidAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, liquidaccount.FieldID)
+		for _, f := range fields {
+			if !liquidaccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != liquidaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(liquidaccount.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(liquidaccount.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(liquidaccount.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Balance(); ok {
+		_spec.SetField(liquidaccount.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBalance(); ok {
+		_spec.AddField(liquidaccount.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.EmergencyFund(); ok {
+		_spec.SetField(liquidaccount.FieldEmergencyFund, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(liquidaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &LiquidAccount{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{liquidaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
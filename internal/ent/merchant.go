@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/merchant"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Merchant is the model entity for the Merchant schema.
+type Merchant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Clean display name, e.g. "Blue Bottle Coffee" for "SQ *BLUE BOTTLE COFFEE 4423 SAN F"
+	Name string `json:"name,omitempty"`
+	// Lowercased name the raw merchant strings normalizing to it are matched by
+	NormalizedKey string `json:"normalized_key,omitempty"`
+	// Spending category of the merchant, filled in for its uncategorized transactions
+	Category *string `json:"category,omitempty"`
+	// LogoURL holds the value of the "logo_url" field.
+	LogoURL *string `json:"logo_url,omitempty"`
+	// Website holds the value of the "website" field.
+	Website *string `json:"website,omitempty"`
+	// When category and logo were last looked up; unset until they are
+	EnrichedAt *time.Time `json:"enriched_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MerchantQuery when eager-loading is set.
+	Edges        MerchantEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MerchantEdges holds the relations/edges for other nodes in the graph.
+type MerchantEdges struct {
+	// Transactions at the merchant
+	Transactions []*Transaction `json:"transactions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TransactionsOrErr returns the Transactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e MerchantEdges) TransactionsOrErr() ([]*Transaction, error) {
+	if e.loadedTypes[0] {
+		return e.Transactions, nil
+	}
+	return nil, &NotLoadedError{edge: "transactions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Merchant) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case merchant.FieldID, merchant.FieldName, merchant.FieldNormalizedKey, merchant.FieldCategory, merchant.FieldLogoURL, merchant.FieldWebsite:
+			values[i] = new(sql.NullString)
+		case merchant.FieldEnrichedAt, merchant.FieldCreatedAt, merchant.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Merchant fields.
+func (_m *Merchant) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case merchant.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case merchant.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case merchant.FieldNormalizedKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field normalized_key", values[i])
+			} else if value.Valid {
+				_m.NormalizedKey = value.String
+			}
+		case merchant.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = new(string)
+				*_m.Category = value.String
+			}
+		case merchant.FieldLogoURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field logo_url", values[i])
+			} else if value.Valid {
+				_m.LogoURL = new(string)
+				*_m.LogoURL = value.String
+			}
+		case merchant.FieldWebsite:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field website", values[i])
+			} else if value.Valid {
+				_m.Website = new(string)
+				*_m.Website = value.String
+			}
+		case merchant.FieldEnrichedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field enriched_at", values[i])
+			} else if value.Valid {
+				_m.EnrichedAt = new(time.Time)
+				*_m.EnrichedAt = value.Time
+			}
+		case merchant.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case merchant.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Merchant.
+// This includes values selected through modifiers, order, etc.
+func (_m *Merchant) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTransactions queries the "transactions" edge of the Merchant entity.
+func (_m *Merchant) QueryTransactions() *TransactionQuery {
+	return NewMerchantClient(_m.config).QueryTransactions(_m)
+}
+
+// Update returns a builder for updating this Merchant.
+// Note that you need to call Merchant.Unwrap() before calling this method if this Merchant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Merchant) Update() *MerchantUpdateOne {
+	return NewMerchantClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Merchant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Merchant) Unwrap() *Merchant {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Merchant is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Merchant) String() string {
+	var builder strings.Builder
+	builder.WriteString("Merchant(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("normalized_key=")
+	builder.WriteString(_m.NormalizedKey)
+	builder.WriteString(", ")
+	if v := _m.Category; v != nil {
+		builder.WriteString("category=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.LogoURL; v != nil {
+		builder.WriteString("logo_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Website; v != nil {
+		builder.WriteString("website=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.EnrichedAt; v != nil {
+		builder.WriteString("enriched_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Merchants is a parsable slice of Merchant.
+type Merchants []*Merchant
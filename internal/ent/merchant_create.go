@@ -0,0 +1,965 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/merchant"
+	"clockzen-next/internal/ent/transaction"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MerchantCreate is the builder for creating a Merchant entity.
+type MerchantCreate struct {
+	config
+	mutation *MerchantMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (_c *MerchantCreate) SetName(v string) *MerchantCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNormalizedKey sets the "normalized_key" field.
+func (_c *MerchantCreate) SetNormalizedKey(v string) *MerchantCreate {
+	_c.mutation.SetNormalizedKey(v)
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *MerchantCreate) SetCategory(v string) *MerchantCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_c *MerchantCreate) SetNillableCategory(v *string) *MerchantCreate {
+	if v != nil {
+		_c.SetCategory(*v)
+	}
+	return _c
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (_c *MerchantCreate) SetLogoURL(v string) *MerchantCreate {
+	_c.mutation.SetLogoURL(v)
+	return _c
+}
+
+// SetNillableLogoURL sets the "logo_url" field if the given value is not nil.
+func (_c *MerchantCreate) SetNillableLogoURL(v *string) *MerchantCreate {
+	if v != nil {
+		_c.SetLogoURL(*v)
+	}
+	return _c
+}
+
+// SetWebsite sets the "website" field.
+func (_c *MerchantCreate) SetWebsite(v string) *MerchantCreate {
+	_c.mutation.SetWebsite(v)
+	return _c
+}
+
+// SetNillableWebsite sets the "website" field if the given value is not nil.
+func (_c *MerchantCreate) SetNillableWebsite(v *string) *MerchantCreate {
+	if v != nil {
+		_c.SetWebsite(*v)
+	}
+	return _c
+}
+
+// SetEnrichedAt sets the "enriched_at" field.
+func (_c *MerchantCreate) SetEnrichedAt(v time.Time) *MerchantCreate {
+	_c.mutation.SetEnrichedAt(v)
+	return _c
+}
+
+// SetNillableEnrichedAt sets the "enriched_at" field if the given value is not nil.
+func (_c *MerchantCreate) SetNillableEnrichedAt(v *time.Time) *MerchantCreate {
+	if v != nil {
+		_c.SetEnrichedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MerchantCreate) SetCreatedAt(v time.Time) *MerchantCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MerchantCreate) SetNillableCreatedAt(v *time.Time) *MerchantCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *MerchantCreate) SetUpdatedAt(v time.Time) *MerchantCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *MerchantCreate) SetNillableUpdatedAt(v *time.Time) *MerchantCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MerchantCreate) SetID(v string) *MerchantCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (_c *MerchantCreate) AddTransactionIDs(ids ...string) *MerchantCreate {
+	_c.mutation.AddTransactionIDs(ids...)
+	return _c
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (_c *MerchantCreate) AddTransactions(v ...*Transaction) *MerchantCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the MerchantMutation object of the builder.
+func (_c *MerchantCreate) Mutation() *MerchantMutation {
+	return _c.mutation
+}
+
+// Save creates the Merchant in the database.
+func (_c *MerchantCreate) Save(ctx context.Context) (*Merchant, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MerchantCreate) SaveX(ctx context.Context) *Merchant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MerchantCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MerchantCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MerchantCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := merchant.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := merchant.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MerchantCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Merchant.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := merchant.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Merchant.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.NormalizedKey(); !ok {
+		return &ValidationError{Name: "normalized_key", err: errors.New(`ent: missing required field "Merchant.normalized_key"`)}
+	}
+	if v, ok := _c.mutation.NormalizedKey(); ok {
+		if err := merchant.NormalizedKeyValidator(v); err != nil {
+			return &ValidationError{Name: "normalized_key", err: fmt.Errorf(`ent: validator failed for field "Merchant.normalized_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Merchant.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Merchant.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *MerchantCreate) sqlSave(ctx context.Context) (*Merchant, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Merchant.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MerchantCreate) createSpec() (*Merchant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Merchant{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(merchant.Table, sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(merchant.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.NormalizedKey(); ok {
+		_spec.SetField(merchant.FieldNormalizedKey, field.TypeString, value)
+		_node.NormalizedKey = value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(merchant.FieldCategory, field.TypeString, value)
+		_node.Category = &value
+	}
+	if value, ok := _c.mutation.LogoURL(); ok {
+		_spec.SetField(merchant.FieldLogoURL, field.TypeString, value)
+		_node.LogoURL = &value
+	}
+	if value, ok := _c.mutation.Website(); ok {
+		_spec.SetField(merchant.FieldWebsite, field.TypeString, value)
+		_node.Website = &value
+	}
+	if value, ok := _c.mutation.EnrichedAt(); ok {
+		_spec.SetField(merchant.FieldEnrichedAt, field.TypeTime, value)
+		_node.EnrichedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(merchant.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(merchant.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   merchant.TransactionsTable,
+			Columns: []string{merchant.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Merchant.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MerchantUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MerchantCreate) OnConflict(opts ...sql.ConflictOption) *MerchantUpsertOne {
+	_c.conflict = opts
+	return &MerchantUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Merchant.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MerchantCreate) OnConflictColumns(columns ...string) *MerchantUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MerchantUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// MerchantUpsertOne is the builder for "upsert"-ing
+	//  one Merchant node.
+	MerchantUpsertOne struct {
+		create *MerchantCreate
+	}
+
+	// MerchantUpsert is the "OnConflict" setter.
+	MerchantUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *MerchantUpsert) SetName(v string) *MerchantUpsert {
+	u.Set(merchant.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MerchantUpsert) UpdateName() *MerchantUpsert {
+	u.SetExcluded(merchant.FieldName)
+	return u
+}
+
+// SetCategory sets the "category" field.
+func (u *MerchantUpsert) SetCategory(v string) *MerchantUpsert {
+	u.Set(merchant.FieldCategory, v)
+	return u
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *MerchantUpsert) UpdateCategory() *MerchantUpsert {
+	u.SetExcluded(merchant.FieldCategory)
+	return u
+}
+
+// ClearCategory clears the value of the "category" field.
+func (u *MerchantUpsert) ClearCategory() *MerchantUpsert {
+	u.SetNull(merchant.FieldCategory)
+	return u
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (u *MerchantUpsert) SetLogoURL(v string) *MerchantUpsert {
+	u.Set(merchant.FieldLogoURL, v)
+	return u
+}
+
+// UpdateLogoURL sets the "logo_url" field to the value that was provided on create.
+func (u *MerchantUpsert) UpdateLogoURL() *MerchantUpsert {
+	u.SetExcluded(merchant.FieldLogoURL)
+	return u
+}
+
+// ClearLogoURL clears the value of the "logo_url" field.
+func (u *MerchantUpsert) ClearLogoURL() *MerchantUpsert {
+	u.SetNull(merchant.FieldLogoURL)
+	return u
+}
+
+// SetWebsite sets the "website" field.
+func (u *MerchantUpsert) SetWebsite(v string) *MerchantUpsert {
+	u.Set(merchant.FieldWebsite, v)
+	return u
+}
+
+// UpdateWebsite sets the "website" field to the value that was provided on create.
+func (u *MerchantUpsert) UpdateWebsite() *MerchantUpsert {
+	u.SetExcluded(merchant.FieldWebsite)
+	return u
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (u *MerchantUpsert) ClearWebsite() *MerchantUpsert {
+	u.SetNull(merchant.FieldWebsite)
+	return u
+}
+
+// SetEnrichedAt sets the "enriched_at" field.
+func (u *MerchantUpsert) SetEnrichedAt(v time.Time) *MerchantUpsert {
+	u.Set(merchant.FieldEnrichedAt, v)
+	return u
+}
+
+// UpdateEnrichedAt sets the "enriched_at" field to the value that was provided on create.
+func (u *MerchantUpsert) UpdateEnrichedAt() *MerchantUpsert {
+	u.SetExcluded(merchant.FieldEnrichedAt)
+	return u
+}
+
+// ClearEnrichedAt clears the value of the "enriched_at" field.
+func (u *MerchantUpsert) ClearEnrichedAt() *MerchantUpsert {
+	u.SetNull(merchant.FieldEnrichedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MerchantUpsert) SetUpdatedAt(v time.Time) *MerchantUpsert {
+	u.Set(merchant.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MerchantUpsert) UpdateUpdatedAt() *MerchantUpsert {
+	u.SetExcluded(merchant.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Merchant.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(merchant.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MerchantUpsertOne) UpdateNewValues() *MerchantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(merchant.FieldID)
+		}
+		if _, exists := u.create.mutation.NormalizedKey(); exists {
+			s.SetIgnore(merchant.FieldNormalizedKey)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(merchant.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Merchant.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MerchantUpsertOne) Ignore() *MerchantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MerchantUpsertOne) DoNothing() *MerchantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MerchantCreate.OnConflict
+// documentation for more info.
+func (u *MerchantUpsertOne) Update(set func(*MerchantUpsert)) *MerchantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MerchantUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *MerchantUpsertOne) SetName(v string) *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MerchantUpsertOne) UpdateName() *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *MerchantUpsertOne) SetCategory(v string) *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *MerchantUpsertOne) UpdateCategory() *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// ClearCategory clears the value of the "category" field.
+func (u *MerchantUpsertOne) ClearCategory() *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.ClearCategory()
+	})
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (u *MerchantUpsertOne) SetLogoURL(v string) *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetLogoURL(v)
+	})
+}
+
+// UpdateLogoURL sets the "logo_url" field to the value that was provided on create.
+func (u *MerchantUpsertOne) UpdateLogoURL() *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateLogoURL()
+	})
+}
+
+// ClearLogoURL clears the value of the "logo_url" field.
+func (u *MerchantUpsertOne) ClearLogoURL() *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.ClearLogoURL()
+	})
+}
+
+// SetWebsite sets the "website" field.
+func (u *MerchantUpsertOne) SetWebsite(v string) *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetWebsite(v)
+	})
+}
+
+// UpdateWebsite sets the "website" field to the value that was provided on create.
+func (u *MerchantUpsertOne) UpdateWebsite() *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateWebsite()
+	})
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (u *MerchantUpsertOne) ClearWebsite() *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.ClearWebsite()
+	})
+}
+
+// SetEnrichedAt sets the "enriched_at" field.
+func (u *MerchantUpsertOne) SetEnrichedAt(v time.Time) *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetEnrichedAt(v)
+	})
+}
+
+// UpdateEnrichedAt sets the "enriched_at" field to the value that was provided on create.
+func (u *MerchantUpsertOne) UpdateEnrichedAt() *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateEnrichedAt()
+	})
+}
+
+// ClearEnrichedAt clears the value of the "enriched_at" field.
+func (u *MerchantUpsertOne) ClearEnrichedAt() *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.ClearEnrichedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MerchantUpsertOne) SetUpdatedAt(v time.Time) *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MerchantUpsertOne) UpdateUpdatedAt() *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MerchantUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MerchantCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MerchantUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MerchantUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MerchantUpsertOne.ID is not supported by MySQL driver. Use MerchantUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MerchantUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MerchantCreateBulk is the builder for creating many Merchant entities in bulk.
+type MerchantCreateBulk struct {
+	config
+	err      error
+	builders []*MerchantCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Merchant entities in the database.
+func (_c *MerchantCreateBulk) Save(ctx context.Context) ([]*Merchant, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Merchant, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MerchantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MerchantCreateBulk) SaveX(ctx context.Context) []*Merchant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MerchantCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MerchantCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Merchant.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MerchantUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MerchantCreateBulk) OnConflict(opts ...sql.ConflictOption) *MerchantUpsertBulk {
+	_c.conflict = opts
+	return &MerchantUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Merchant.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MerchantCreateBulk) OnConflictColumns(columns ...string) *MerchantUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MerchantUpsertBulk{
+		create: _c,
+	}
+}
+
+// MerchantUpsertBulk is the builder for "upsert"-ing
+// a bulk of Merchant nodes.
+type MerchantUpsertBulk struct {
+	create *MerchantCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Merchant.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(merchant.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MerchantUpsertBulk) UpdateNewValues() *MerchantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(merchant.FieldID)
+			}
+			if _, exists := b.mutation.NormalizedKey(); exists {
+				s.SetIgnore(merchant.FieldNormalizedKey)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(merchant.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Merchant.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MerchantUpsertBulk) Ignore() *MerchantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MerchantUpsertBulk) DoNothing() *MerchantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MerchantCreateBulk.OnConflict
+// documentation for more info.
+func (u *MerchantUpsertBulk) Update(set func(*MerchantUpsert)) *MerchantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MerchantUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *MerchantUpsertBulk) SetName(v string) *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MerchantUpsertBulk) UpdateName() *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *MerchantUpsertBulk) SetCategory(v string) *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *MerchantUpsertBulk) UpdateCategory() *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// ClearCategory clears the value of the "category" field.
+func (u *MerchantUpsertBulk) ClearCategory() *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.ClearCategory()
+	})
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (u *MerchantUpsertBulk) SetLogoURL(v string) *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetLogoURL(v)
+	})
+}
+
+// UpdateLogoURL sets the "logo_url" field to the value that was provided on create.
+func (u *MerchantUpsertBulk) UpdateLogoURL() *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateLogoURL()
+	})
+}
+
+// ClearLogoURL clears the value of the "logo_url" field.
+func (u *MerchantUpsertBulk) ClearLogoURL() *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.ClearLogoURL()
+	})
+}
+
+// SetWebsite sets the "website" field.
+func (u *MerchantUpsertBulk) SetWebsite(v string) *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetWebsite(v)
+	})
+}
+
+// UpdateWebsite sets the "website" field to the value that was provided on create.
+func (u *MerchantUpsertBulk) UpdateWebsite() *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateWebsite()
+	})
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (u *MerchantUpsertBulk) ClearWebsite() *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.ClearWebsite()
+	})
+}
+
+// SetEnrichedAt sets the "enriched_at" field.
+func (u *MerchantUpsertBulk) SetEnrichedAt(v time.Time) *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetEnrichedAt(v)
+	})
+}
+
+// UpdateEnrichedAt sets the "enriched_at" field to the value that was provided on create.
+func (u *MerchantUpsertBulk) UpdateEnrichedAt() *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateEnrichedAt()
+	})
+}
+
+// ClearEnrichedAt clears the value of the "enriched_at" field.
+func (u *MerchantUpsertBulk) ClearEnrichedAt() *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.ClearEnrichedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MerchantUpsertBulk) SetUpdatedAt(v time.Time) *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MerchantUpsertBulk) UpdateUpdatedAt() *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MerchantUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MerchantCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MerchantCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MerchantUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
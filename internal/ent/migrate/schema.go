@@ -3,52 +3,398 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// AccountDeletionsColumns holds the columns for the "account_deletions" table.
+	AccountDeletionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "requested_by", Type: field.TypeString},
+		{Name: "rows_deleted", Type: field.TypeJSON, Nullable: true},
+		{Name: "tokens_revoked", Type: field.TypeInt, Default: 0},
+		{Name: "tokens_failed", Type: field.TypeInt, Default: 0},
+		{Name: "blobs_purged", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AccountDeletionsTable holds the schema information for the "account_deletions" table.
+	AccountDeletionsTable = &schema.Table{
+		Name:       "account_deletions",
+		Columns:    AccountDeletionsColumns,
+		PrimaryKey: []*schema.Column{AccountDeletionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accountdeletion_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{AccountDeletionsColumns[1]},
+			},
+		},
+	}
+	// AlertsColumns holds the columns for the "alerts" table.
+	AlertsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"anomaly", "budget_overrun"}},
+		{Name: "severity", Type: field.TypeEnum, Enums: []string{"low", "medium", "high"}},
+		{Name: "title", Type: field.TypeString},
+		{Name: "message", Type: field.TypeString, Size: 2147483647},
+		{Name: "dedupe_key", Type: field.TypeString},
+		{Name: "data", Type: field.TypeJSON, Nullable: true},
+		{Name: "delivery_status", Type: field.TypeEnum, Enums: []string{"pending", "delivered", "skipped"}, Default: "pending"},
+		{Name: "delivery_error", Type: field.TypeString, Nullable: true},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AlertsTable holds the schema information for the "alerts" table.
+	AlertsTable = &schema.Table{
+		Name:       "alerts",
+		Columns:    AlertsColumns,
+		PrimaryKey: []*schema.Column{AlertsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "alert_user_id_dedupe_key",
+				Unique:  true,
+				Columns: []*schema.Column{AlertsColumns[1], AlertsColumns[6]},
+			},
+			{
+				Name:    "alert_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AlertsColumns[1], AlertsColumns[12]},
+			},
+			{
+				Name:    "alert_delivery_status",
+				Unique:  false,
+				Columns: []*schema.Column{AlertsColumns[8]},
+			},
+		},
+	}
+	// AlertPreferencesColumns holds the columns for the "alert_preferences" table.
+	AlertPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString, Unique: true},
+		{Name: "anomaly_alerts", Type: field.TypeBool, Default: true},
+		{Name: "budget_alerts", Type: field.TypeBool, Default: true},
+		{Name: "budget_limits", Type: field.TypeJSON, Nullable: true},
+		{Name: "budget_threshold_percent", Type: field.TypeFloat64, Default: 100},
+		{Name: "min_severity", Type: field.TypeEnum, Enums: []string{"low", "medium", "high"}, Default: "medium"},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "webhook_url", Type: field.TypeString, Nullable: true},
+		{Name: "push_token", Type: field.TypeString, Nullable: true},
+		{Name: "digest", Type: field.TypeEnum, Enums: []string{"immediate", "hourly", "daily"}, Default: "daily"},
+		{Name: "weekly_summary", Type: field.TypeBool, Default: true},
+		{Name: "monthly_report", Type: field.TypeBool, Default: true},
+		{Name: "last_digest_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// AlertPreferencesTable holds the schema information for the "alert_preferences" table.
+	AlertPreferencesTable = &schema.Table{
+		Name:       "alert_preferences",
+		Columns:    AlertPreferencesColumns,
+		PrimaryKey: []*schema.Column{AlertPreferencesColumns[0]},
+	}
+	// AttachmentBlobsColumns holds the columns for the "attachment_blobs" table.
+	AttachmentBlobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "sha256", Type: field.TypeString, Unique: true},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "mime_type", Type: field.TypeString, Nullable: true},
+		{Name: "storage_key", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AttachmentBlobsTable holds the schema information for the "attachment_blobs" table.
+	AttachmentBlobsTable = &schema.Table{
+		Name:       "attachment_blobs",
+		Columns:    AttachmentBlobsColumns,
+		PrimaryKey: []*schema.Column{AttachmentBlobsColumns[0]},
+	}
+	// AttachmentLinksColumns holds the columns for the "attachment_links" table.
+	AttachmentLinksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "connection_id", Type: field.TypeString},
+		{Name: "message_id", Type: field.TypeString},
+		{Name: "attachment_id", Type: field.TypeString},
+		{Name: "filename", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "blob_id", Type: field.TypeString},
+	}
+	// AttachmentLinksTable holds the schema information for the "attachment_links" table.
+	AttachmentLinksTable = &schema.Table{
+		Name:       "attachment_links",
+		Columns:    AttachmentLinksColumns,
+		PrimaryKey: []*schema.Column{AttachmentLinksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attachment_links_attachment_blobs_links",
+				Columns:    []*schema.Column{AttachmentLinksColumns[6]},
+				RefColumns: []*schema.Column{AttachmentBlobsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attachmentlink_connection_id_message_id_blob_id",
+				Unique:  true,
+				Columns: []*schema.Column{AttachmentLinksColumns[1], AttachmentLinksColumns[2], AttachmentLinksColumns[6]},
+			},
+			{
+				Name:    "attachmentlink_blob_id",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentLinksColumns[6]},
+			},
+		},
+	}
+	// BudgetReallocationsColumns holds the columns for the "budget_reallocations" table.
+	BudgetReallocationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "organization_id", Type: field.TypeString, Nullable: true},
+		{Name: "budget_id", Type: field.TypeString},
+		{Name: "from_category", Type: field.TypeString},
+		{Name: "to_category", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "effective_date", Type: field.TypeTime},
+		{Name: "note", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// BudgetReallocationsTable holds the schema information for the "budget_reallocations" table.
+	BudgetReallocationsTable = &schema.Table{
+		Name:       "budget_reallocations",
+		Columns:    BudgetReallocationsColumns,
+		PrimaryKey: []*schema.Column{BudgetReallocationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "budget_reallocations_users_budget_reallocations",
+				Columns:    []*schema.Column{BudgetReallocationsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "budgetreallocation_user_id_budget_id_effective_date",
+				Unique:  false,
+				Columns: []*schema.Column{BudgetReallocationsColumns[9], BudgetReallocationsColumns[2], BudgetReallocationsColumns[6]},
+			},
+			{
+				Name:    "budgetreallocation_organization_id_budget_id_effective_date",
+				Unique:  false,
+				Columns: []*schema.Column{BudgetReallocationsColumns[1], BudgetReallocationsColumns[2], BudgetReallocationsColumns[6]},
+			},
+		},
+	}
+	// BulkOperationsColumns holds the columns for the "bulk_operations" table.
+	BulkOperationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"recategorize", "delete", "categorize"}},
+		{Name: "category", Type: field.TypeString, Nullable: true},
+		{Name: "transaction_ids", Type: field.TypeJSON},
+		{Name: "before_images", Type: field.TypeJSON},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "undone_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// BulkOperationsTable holds the schema information for the "bulk_operations" table.
+	BulkOperationsTable = &schema.Table{
+		Name:       "bulk_operations",
+		Columns:    BulkOperationsColumns,
+		PrimaryKey: []*schema.Column{BulkOperationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bulkoperation_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{BulkOperationsColumns[1], BulkOperationsColumns[8]},
+			},
+		},
+	}
+	// CardAccountsColumns holds the columns for the "card_accounts" table.
+	CardAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "card_last_four", Type: field.TypeString, Nullable: true},
+		{Name: "statement_closing_day", Type: field.TypeInt},
+		{Name: "payment_due_days", Type: field.TypeInt, Default: 25},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// CardAccountsTable holds the schema information for the "card_accounts" table.
+	CardAccountsTable = &schema.Table{
+		Name:       "card_accounts",
+		Columns:    CardAccountsColumns,
+		PrimaryKey: []*schema.Column{CardAccountsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "cardaccount_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{CardAccountsColumns[1]},
+			},
+		},
+	}
+	// CategorizationRulesColumns holds the columns for the "categorization_rules" table.
+	CategorizationRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "merchant_pattern", Type: field.TypeString, Nullable: true},
+		{Name: "min_amount", Type: field.TypeFloat64, Nullable: true},
+		{Name: "max_amount", Type: field.TypeFloat64, Nullable: true},
+		{Name: "description_keywords", Type: field.TypeJSON, Nullable: true},
+		{Name: "category", Type: field.TypeString, Nullable: true},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// CategorizationRulesTable holds the schema information for the "categorization_rules" table.
+	CategorizationRulesTable = &schema.Table{
+		Name:       "categorization_rules",
+		Columns:    CategorizationRulesColumns,
+		PrimaryKey: []*schema.Column{CategorizationRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "categorizationrule_user_id_priority",
+				Unique:  false,
+				Columns: []*schema.Column{CategorizationRulesColumns[1], CategorizationRulesColumns[3]},
+			},
+		},
+	}
+	// CategoryFeedbacksColumns holds the columns for the "category_feedbacks" table.
+	CategoryFeedbacksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "transaction_id", Type: field.TypeString},
+		{Name: "merchant_name", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "suggested_category", Type: field.TypeString},
+		{Name: "suggestion_source", Type: field.TypeEnum, Enums: []string{"model", "rule"}},
+		{Name: "confidence", Type: field.TypeFloat64, Nullable: true},
+		{Name: "accepted", Type: field.TypeBool},
+		{Name: "category", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// CategoryFeedbacksTable holds the schema information for the "category_feedbacks" table.
+	CategoryFeedbacksTable = &schema.Table{
+		Name:       "category_feedbacks",
+		Columns:    CategoryFeedbacksColumns,
+		PrimaryKey: []*schema.Column{CategoryFeedbacksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "categoryfeedback_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CategoryFeedbacksColumns[1], CategoryFeedbacksColumns[10]},
+			},
+			{
+				Name:    "categoryfeedback_transaction_id",
+				Unique:  false,
+				Columns: []*schema.Column{CategoryFeedbacksColumns[2]},
+			},
+		},
+	}
+	// DebtsColumns holds the columns for the "debts" table.
+	DebtsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"credit_card", "student_loan", "auto_loan", "mortgage", "personal_loan", "medical", "other"}, Default: "other"},
+		{Name: "balance", Type: field.TypeFloat64},
+		{Name: "apr", Type: field.TypeFloat64},
+		{Name: "minimum_payment", Type: field.TypeFloat64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// DebtsTable holds the schema information for the "debts" table.
+	DebtsTable = &schema.Table{
+		Name:       "debts",
+		Columns:    DebtsColumns,
+		PrimaryKey: []*schema.Column{DebtsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "debt_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{DebtsColumns[1]},
+			},
+		},
+	}
 	// EmailConnectionsColumns holds the columns for the "email_connections" table.
 	EmailConnectionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
-		{Name: "user_id", Type: field.TypeString},
+		{Name: "organization_id", Type: field.TypeString, Nullable: true},
 		{Name: "provider_account_id", Type: field.TypeString},
 		{Name: "email", Type: field.TypeString},
-		{Name: "provider", Type: field.TypeEnum, Enums: []string{"gmail", "outlook", "imap"}},
+		{Name: "provider", Type: field.TypeEnum, Enums: []string{"gmail", "outlook", "imap", "sandbox"}},
 		{Name: "access_token", Type: field.TypeString},
 		{Name: "refresh_token", Type: field.TypeString},
 		{Name: "token_expiry", Type: field.TypeTime},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "inactive", "revoked", "expired"}, Default: "active"},
+		{Name: "migration_run_id", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "last_sync_at", Type: field.TypeTime, Nullable: true},
+		{Name: "sync_schedule", Type: field.TypeEnum, Enums: []string{"every_15_minutes", "hourly", "daily", "manual"}, Default: "manual"},
+		{Name: "next_sync_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeString},
 	}
 	// EmailConnectionsTable holds the schema information for the "email_connections" table.
 	EmailConnectionsTable = &schema.Table{
 		Name:       "email_connections",
 		Columns:    EmailConnectionsColumns,
 		PrimaryKey: []*schema.Column{EmailConnectionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "email_connections_users_email_connections",
+				Columns:    []*schema.Column{EmailConnectionsColumns[15]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "emailconnection_user_id",
 				Unique:  false,
+				Columns: []*schema.Column{EmailConnectionsColumns[15]},
+			},
+			{
+				Name:    "emailconnection_organization_id",
+				Unique:  false,
 				Columns: []*schema.Column{EmailConnectionsColumns[1]},
 			},
 			{
-				Name:    "emailconnection_provider_account_id",
+				Name:    "emailconnection_provider_account_id_provider",
 				Unique:  true,
-				Columns: []*schema.Column{EmailConnectionsColumns[2]},
+				Columns: []*schema.Column{EmailConnectionsColumns[2], EmailConnectionsColumns[4]},
 			},
 			{
 				Name:    "emailconnection_status",
 				Unique:  false,
 				Columns: []*schema.Column{EmailConnectionsColumns[8]},
 			},
+			{
+				Name:    "emailconnection_sync_schedule_next_sync_at",
+				Unique:  false,
+				Columns: []*schema.Column{EmailConnectionsColumns[13], EmailConnectionsColumns[14]},
+			},
+			{
+				Name:    "emailconnection_status_token_expiry",
+				Unique:  false,
+				Columns: []*schema.Column{EmailConnectionsColumns[8], EmailConnectionsColumns[7]},
+			},
 			{
 				Name:    "emailconnection_provider",
 				Unique:  false,
 				Columns: []*schema.Column{EmailConnectionsColumns[4]},
 			},
+			{
+				Name:    "emailconnection_migration_run_id",
+				Unique:  false,
+				Columns: []*schema.Column{EmailConnectionsColumns[9]},
+			},
 		},
 	}
 	// EmailLabelsColumns holds the columns for the "email_labels" table.
@@ -78,7 +424,7 @@ var (
 				Symbol:     "email_labels_email_connections_labels",
 				Columns:    []*schema.Column{EmailLabelsColumns[13]},
 				RefColumns: []*schema.Column{EmailConnectionsColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
@@ -109,6 +455,41 @@ var (
 			},
 		},
 	}
+	// EmailMessagesColumns holds the columns for the "email_messages" table.
+	EmailMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "connection_id", Type: field.TypeString},
+		{Name: "message_id", Type: field.TypeString},
+		{Name: "thread_id", Type: field.TypeString, Nullable: true},
+		{Name: "subject", Type: field.TypeString, Nullable: true},
+		{Name: "sender", Type: field.TypeString, Nullable: true},
+		{Name: "snippet", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "label_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "has_attachments", Type: field.TypeBool, Default: false},
+		{Name: "attachment_count", Type: field.TypeInt, Default: 0},
+		{Name: "amount", Type: field.TypeFloat64, Nullable: true},
+		{Name: "received_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// EmailMessagesTable holds the schema information for the "email_messages" table.
+	EmailMessagesTable = &schema.Table{
+		Name:       "email_messages",
+		Columns:    EmailMessagesColumns,
+		PrimaryKey: []*schema.Column{EmailMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "emailmessage_connection_id_message_id",
+				Unique:  true,
+				Columns: []*schema.Column{EmailMessagesColumns[1], EmailMessagesColumns[2]},
+			},
+			{
+				Name:    "emailmessage_connection_id_received_at",
+				Unique:  false,
+				Columns: []*schema.Column{EmailMessagesColumns[1], EmailMessagesColumns[11]},
+			},
+		},
+	}
 	// EmailSyncsColumns holds the columns for the "email_syncs" table.
 	EmailSyncsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -125,7 +506,9 @@ var (
 		{Name: "bytes_transferred", Type: field.TypeInt64, Default: 0},
 		{Name: "error_message", Type: field.TypeString, Nullable: true},
 		{Name: "error_details", Type: field.TypeJSON, Nullable: true},
+		{Name: "checkpoints", Type: field.TypeJSON, Nullable: true},
 		{Name: "history_id", Type: field.TypeString, Nullable: true},
+		{Name: "fallback_reason", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "connection_id", Type: field.TypeString},
@@ -138,16 +521,16 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "email_syncs_email_connections_syncs",
-				Columns:    []*schema.Column{EmailSyncsColumns[17]},
+				Columns:    []*schema.Column{EmailSyncsColumns[19]},
 				RefColumns: []*schema.Column{EmailConnectionsColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "emailsync_connection_id",
 				Unique:  false,
-				Columns: []*schema.Column{EmailSyncsColumns[17]},
+				Columns: []*schema.Column{EmailSyncsColumns[19]},
 			},
 			{
 				Name:    "emailsync_status",
@@ -162,38 +545,166 @@ var (
 			{
 				Name:    "emailsync_connection_id_status",
 				Unique:  false,
-				Columns: []*schema.Column{EmailSyncsColumns[17], EmailSyncsColumns[3]},
+				Columns: []*schema.Column{EmailSyncsColumns[19], EmailSyncsColumns[3]},
 			},
 			{
 				Name:    "emailsync_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{EmailSyncsColumns[15]},
+				Columns: []*schema.Column{EmailSyncsColumns[17]},
+			},
+		},
+	}
+	// EmailSyncFailuresColumns holds the columns for the "email_sync_failures" table.
+	EmailSyncFailuresColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "connection_id", Type: field.TypeString},
+		{Name: "message_id", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"failed", "resolved"}, Default: "failed"},
+		{Name: "error_message", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeInt, Default: 1},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "sync_id", Type: field.TypeString},
+	}
+	// EmailSyncFailuresTable holds the schema information for the "email_sync_failures" table.
+	EmailSyncFailuresTable = &schema.Table{
+		Name:       "email_sync_failures",
+		Columns:    EmailSyncFailuresColumns,
+		PrimaryKey: []*schema.Column{EmailSyncFailuresColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "email_sync_failures_email_syncs_failures",
+				Columns:    []*schema.Column{EmailSyncFailuresColumns[9]},
+				RefColumns: []*schema.Column{EmailSyncsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "emailsyncfailure_sync_id_message_id",
+				Unique:  true,
+				Columns: []*schema.Column{EmailSyncFailuresColumns[9], EmailSyncFailuresColumns[2]},
+			},
+			{
+				Name:    "emailsyncfailure_sync_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{EmailSyncFailuresColumns[9], EmailSyncFailuresColumns[3]},
+			},
+			{
+				Name:    "emailsyncfailure_connection_id",
+				Unique:  false,
+				Columns: []*schema.Column{EmailSyncFailuresColumns[1]},
+			},
+		},
+	}
+	// EmergencyFundSnapshotsColumns holds the columns for the "emergency_fund_snapshots" table.
+	EmergencyFundSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "date", Type: field.TypeTime},
+		{Name: "balance", Type: field.TypeFloat64},
+		{Name: "monthly_expenses", Type: field.TypeFloat64},
+		{Name: "months_covered", Type: field.TypeFloat64, Nullable: true},
+		{Name: "target_months", Type: field.TypeFloat64},
+		{Name: "below_target", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// EmergencyFundSnapshotsTable holds the schema information for the "emergency_fund_snapshots" table.
+	EmergencyFundSnapshotsTable = &schema.Table{
+		Name:       "emergency_fund_snapshots",
+		Columns:    EmergencyFundSnapshotsColumns,
+		PrimaryKey: []*schema.Column{EmergencyFundSnapshotsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "emergencyfundsnapshot_user_id_date",
+				Unique:  true,
+				Columns: []*schema.Column{EmergencyFundSnapshotsColumns[1], EmergencyFundSnapshotsColumns[2]},
+			},
+		},
+	}
+	// EmergencyFundTargetsColumns holds the columns for the "emergency_fund_targets" table.
+	EmergencyFundTargetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString, Unique: true},
+		{Name: "target_months", Type: field.TypeFloat64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// EmergencyFundTargetsTable holds the schema information for the "emergency_fund_targets" table.
+	EmergencyFundTargetsTable = &schema.Table{
+		Name:       "emergency_fund_targets",
+		Columns:    EmergencyFundTargetsColumns,
+		PrimaryKey: []*schema.Column{EmergencyFundTargetsColumns[0]},
+	}
+	// GoalsColumns holds the columns for the "goals" table.
+	GoalsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"savings", "debt_payoff"}, Default: "savings"},
+		{Name: "target_amount", Type: field.TypeFloat64},
+		{Name: "starting_amount", Type: field.TypeFloat64, Default: 0},
+		{Name: "deadline", Type: field.TypeTime, Nullable: true},
+		{Name: "category", Type: field.TypeString, Nullable: true},
+		{Name: "account_id", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// GoalsTable holds the schema information for the "goals" table.
+	GoalsTable = &schema.Table{
+		Name:       "goals",
+		Columns:    GoalsColumns,
+		PrimaryKey: []*schema.Column{GoalsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "goal_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{GoalsColumns[1]},
 			},
 		},
 	}
 	// GoogleDriveConnectionsColumns holds the columns for the "google_drive_connections" table.
 	GoogleDriveConnectionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
-		{Name: "user_id", Type: field.TypeString},
+		{Name: "organization_id", Type: field.TypeString, Nullable: true},
 		{Name: "google_account_id", Type: field.TypeString},
 		{Name: "email", Type: field.TypeString},
 		{Name: "access_token", Type: field.TypeString},
 		{Name: "refresh_token", Type: field.TypeString},
 		{Name: "token_expiry", Type: field.TypeTime},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "inactive", "revoked", "expired"}, Default: "active"},
+		{Name: "migration_run_id", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "last_sync_at", Type: field.TypeTime, Nullable: true},
+		{Name: "sync_schedule", Type: field.TypeEnum, Enums: []string{"every_15_minutes", "hourly", "daily", "manual"}, Default: "manual"},
+		{Name: "next_sync_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeString},
 	}
 	// GoogleDriveConnectionsTable holds the schema information for the "google_drive_connections" table.
 	GoogleDriveConnectionsTable = &schema.Table{
 		Name:       "google_drive_connections",
 		Columns:    GoogleDriveConnectionsColumns,
 		PrimaryKey: []*schema.Column{GoogleDriveConnectionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "google_drive_connections_users_google_drive_connections",
+				Columns:    []*schema.Column{GoogleDriveConnectionsColumns[14]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "googledriveconnection_user_id",
 				Unique:  false,
+				Columns: []*schema.Column{GoogleDriveConnectionsColumns[14]},
+			},
+			{
+				Name:    "googledriveconnection_organization_id",
+				Unique:  false,
 				Columns: []*schema.Column{GoogleDriveConnectionsColumns[1]},
 			},
 			{
@@ -206,6 +717,21 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{GoogleDriveConnectionsColumns[7]},
 			},
+			{
+				Name:    "googledriveconnection_sync_schedule_next_sync_at",
+				Unique:  false,
+				Columns: []*schema.Column{GoogleDriveConnectionsColumns[12], GoogleDriveConnectionsColumns[13]},
+			},
+			{
+				Name:    "googledriveconnection_status_token_expiry",
+				Unique:  false,
+				Columns: []*schema.Column{GoogleDriveConnectionsColumns[7], GoogleDriveConnectionsColumns[6]},
+			},
+			{
+				Name:    "googledriveconnection_migration_run_id",
+				Unique:  false,
+				Columns: []*schema.Column{GoogleDriveConnectionsColumns[8]},
+			},
 		},
 	}
 	// GoogleDriveFoldersColumns holds the columns for the "google_drive_folders" table.
@@ -217,6 +743,7 @@ var (
 		{Name: "parent_folder_id", Type: field.TypeString, Nullable: true},
 		{Name: "is_root", Type: field.TypeBool, Default: false},
 		{Name: "sync_enabled", Type: field.TypeBool, Default: true},
+		{Name: "recursive", Type: field.TypeBool, Default: true},
 		{Name: "sync_direction", Type: field.TypeEnum, Enums: []string{"download", "upload", "bidirectional"}, Default: "download"},
 		{Name: "file_count", Type: field.TypeInt64, Default: 0},
 		{Name: "total_size_bytes", Type: field.TypeInt64, Default: 0},
@@ -233,16 +760,16 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "google_drive_folders_google_drive_connections_folders",
-				Columns:    []*schema.Column{GoogleDriveFoldersColumns[13]},
+				Columns:    []*schema.Column{GoogleDriveFoldersColumns[14]},
 				RefColumns: []*schema.Column{GoogleDriveConnectionsColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "googledrivefolder_connection_id",
 				Unique:  false,
-				Columns: []*schema.Column{GoogleDriveFoldersColumns[13]},
+				Columns: []*schema.Column{GoogleDriveFoldersColumns[14]},
 			},
 			{
 				Name:    "googledrivefolder_drive_folder_id",
@@ -252,7 +779,7 @@ var (
 			{
 				Name:    "googledrivefolder_connection_id_drive_folder_id",
 				Unique:  true,
-				Columns: []*schema.Column{GoogleDriveFoldersColumns[13], GoogleDriveFoldersColumns[1]},
+				Columns: []*schema.Column{GoogleDriveFoldersColumns[14], GoogleDriveFoldersColumns[1]},
 			},
 			{
 				Name:    "googledrivefolder_sync_enabled",
@@ -292,7 +819,7 @@ var (
 				Symbol:     "google_drive_syncs_google_drive_connections_syncs",
 				Columns:    []*schema.Column{GoogleDriveSyncsColumns[17]},
 				RefColumns: []*schema.Column{GoogleDriveConnectionsColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
@@ -323,6 +850,110 @@ var (
 			},
 		},
 	}
+	// HouseholdMembersColumns holds the columns for the "household_members" table.
+	HouseholdMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "connection_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// HouseholdMembersTable holds the schema information for the "household_members" table.
+	HouseholdMembersTable = &schema.Table{
+		Name:       "household_members",
+		Columns:    HouseholdMembersColumns,
+		PrimaryKey: []*schema.Column{HouseholdMembersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "householdmember_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{HouseholdMembersColumns[1]},
+			},
+		},
+	}
+	// JobQueuesColumns holds the columns for the "job_queues" table.
+	JobQueuesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "paused", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// JobQueuesTable holds the schema information for the "job_queues" table.
+	JobQueuesTable = &schema.Table{
+		Name:       "job_queues",
+		Columns:    JobQueuesColumns,
+		PrimaryKey: []*schema.Column{JobQueuesColumns[0]},
+	}
+	// JobSchedulesColumns holds the columns for the "job_schedules" table.
+	JobSchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "job_type", Type: field.TypeString},
+		{Name: "frequency", Type: field.TypeEnum, Enums: []string{"daily", "weekly", "monthly", "quarterly"}},
+		{Name: "params", Type: field.TypeJSON, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "next_run_at", Type: field.TypeTime},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_status", Type: field.TypeString, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "consecutive_failures", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// JobSchedulesTable holds the schema information for the "job_schedules" table.
+	JobSchedulesTable = &schema.Table{
+		Name:       "job_schedules",
+		Columns:    JobSchedulesColumns,
+		PrimaryKey: []*schema.Column{JobSchedulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "jobschedule_enabled_next_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobSchedulesColumns[6], JobSchedulesColumns[7]},
+			},
+			{
+				Name:    "jobschedule_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{JobSchedulesColumns[1]},
+			},
+		},
+	}
+	// JobScheduleRunsColumns holds the columns for the "job_schedule_runs" table.
+	JobScheduleRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "schedule_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "job_id", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "completed", "failed", "cancelled"}, Default: "pending"},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// JobScheduleRunsTable holds the schema information for the "job_schedule_runs" table.
+	JobScheduleRunsTable = &schema.Table{
+		Name:       "job_schedule_runs",
+		Columns:    JobScheduleRunsColumns,
+		PrimaryKey: []*schema.Column{JobScheduleRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "jobschedulerun_schedule_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobScheduleRunsColumns[1], JobScheduleRunsColumns[6]},
+			},
+			{
+				Name:    "jobschedulerun_status",
+				Unique:  false,
+				Columns: []*schema.Column{JobScheduleRunsColumns[4]},
+			},
+			{
+				Name:    "jobschedulerun_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{JobScheduleRunsColumns[2]},
+			},
+		},
+	}
 	// LineItemsColumns holds the columns for the "line_items" table.
 	LineItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -343,6 +974,7 @@ var (
 		{Name: "tags", Type: field.TypeJSON, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "legacy_id", Type: field.TypeString, Nullable: true},
+		{Name: "migration_run_id", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "receipt_id", Type: field.TypeString},
@@ -355,7 +987,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "line_items_receipts_line_items",
-				Columns:    []*schema.Column{LineItemsColumns[20]},
+				Columns:    []*schema.Column{LineItemsColumns[21]},
 				RefColumns: []*schema.Column{ReceiptsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -364,12 +996,12 @@ var (
 			{
 				Name:    "lineitem_receipt_id",
 				Unique:  false,
-				Columns: []*schema.Column{LineItemsColumns[20]},
+				Columns: []*schema.Column{LineItemsColumns[21]},
 			},
 			{
 				Name:    "lineitem_receipt_id_line_number",
 				Unique:  false,
-				Columns: []*schema.Column{LineItemsColumns[20], LineItemsColumns[1]},
+				Columns: []*schema.Column{LineItemsColumns[21], LineItemsColumns[1]},
 			},
 			{
 				Name:    "lineitem_sku",
@@ -391,8 +1023,211 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{LineItemsColumns[17]},
 			},
+			{
+				Name:    "lineitem_migration_run_id",
+				Unique:  false,
+				Columns: []*schema.Column{LineItemsColumns[18]},
+			},
+		},
+	}
+	// LiquidAccountsColumns holds the columns for the "liquid_accounts" table.
+	LiquidAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"checking", "savings", "money_market", "cash", "other"}, Default: "savings"},
+		{Name: "balance", Type: field.TypeFloat64},
+		{Name: "emergency_fund", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// LiquidAccountsTable holds the schema information for the "liquid_accounts" table.
+	LiquidAccountsTable = &schema.Table{
+		Name:       "liquid_accounts",
+		Columns:    LiquidAccountsColumns,
+		PrimaryKey: []*schema.Column{LiquidAccountsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "liquidaccount_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{LiquidAccountsColumns[1]},
+			},
 		},
 	}
+	// MembershipsColumns holds the columns for the "memberships" table.
+	MembershipsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "admin", "member", "viewer"}, Default: "member"},
+		{Name: "invited_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeString},
+	}
+	// MembershipsTable holds the schema information for the "memberships" table.
+	MembershipsTable = &schema.Table{
+		Name:       "memberships",
+		Columns:    MembershipsColumns,
+		PrimaryKey: []*schema.Column{MembershipsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "memberships_organizations_memberships",
+				Columns:    []*schema.Column{MembershipsColumns[6]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "membership_organization_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{MembershipsColumns[6], MembershipsColumns[1]},
+			},
+			{
+				Name:    "membership_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{MembershipsColumns[1]},
+			},
+		},
+	}
+	// MerchantsColumns holds the columns for the "merchants" table.
+	MerchantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "normalized_key", Type: field.TypeString, Unique: true},
+		{Name: "category", Type: field.TypeString, Nullable: true},
+		{Name: "logo_url", Type: field.TypeString, Nullable: true},
+		{Name: "website", Type: field.TypeString, Nullable: true},
+		{Name: "enriched_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// MerchantsTable holds the schema information for the "merchants" table.
+	MerchantsTable = &schema.Table{
+		Name:       "merchants",
+		Columns:    MerchantsColumns,
+		PrimaryKey: []*schema.Column{MerchantsColumns[0]},
+	}
+	// MigrationStateColumns holds the columns for the "migration_state" table.
+	MigrationStateColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"user_id", "cursor", "run"}},
+		{Name: "key", Type: field.TypeString},
+		{Name: "value", Type: field.TypeString},
+		{Name: "run_id", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// MigrationStateTable holds the schema information for the "migration_state" table.
+	MigrationStateTable = &schema.Table{
+		Name:       "migration_state",
+		Columns:    MigrationStateColumns,
+		PrimaryKey: []*schema.Column{MigrationStateColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "migrationstate_kind_key",
+				Unique:  true,
+				Columns: []*schema.Column{MigrationStateColumns[1], MigrationStateColumns[2]},
+			},
+		},
+	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"connection_failure", "sync_errors", "weekly_summary", "monthly_report"}},
+		{Name: "dedupe_key", Type: field.TypeString},
+		{Name: "recipient", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"sent", "failed"}},
+		{Name: "attempts", Type: field.TypeInt, Default: 1},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_user_id_dedupe_key",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationsColumns[1], NotificationsColumns[3]},
+			},
+			{
+				Name:    "notification_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[1], NotificationsColumns[10]},
+			},
+		},
+	}
+	// OauthStatesColumns holds the columns for the "oauth_states" table.
+	OauthStatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "data", Type: field.TypeBytes},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OauthStatesTable holds the schema information for the "oauth_states" table.
+	OauthStatesTable = &schema.Table{
+		Name:       "oauth_states",
+		Columns:    OauthStatesColumns,
+		PrimaryKey: []*schema.Column{OauthStatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "oauthstate_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{OauthStatesColumns[2]},
+			},
+		},
+	}
+	// OcrFeedbacksColumns holds the columns for the "ocr_feedbacks" table.
+	OcrFeedbacksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "receipt_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "field", Type: field.TypeString},
+		{Name: "value", Type: field.TypeString, Nullable: true},
+		{Name: "box", Type: field.TypeJSON},
+		{Name: "predicted_value", Type: field.TypeString, Nullable: true},
+		{Name: "predicted_box", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OcrFeedbacksTable holds the schema information for the "ocr_feedbacks" table.
+	OcrFeedbacksTable = &schema.Table{
+		Name:       "ocr_feedbacks",
+		Columns:    OcrFeedbacksColumns,
+		PrimaryKey: []*schema.Column{OcrFeedbacksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ocrfeedback_receipt_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{OcrFeedbacksColumns[1], OcrFeedbacksColumns[8]},
+			},
+			{
+				Name:    "ocrfeedback_field_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{OcrFeedbacksColumns[3], OcrFeedbacksColumns[8]},
+			},
+		},
+	}
+	// OrganizationsColumns holds the columns for the "organizations" table.
+	OrganizationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// OrganizationsTable holds the schema information for the "organizations" table.
+	OrganizationsTable = &schema.Table{
+		Name:       "organizations",
+		Columns:    OrganizationsColumns,
+		PrimaryKey: []*schema.Column{OrganizationsColumns[0]},
+	}
 	// PipelineConfigsColumns holds the columns for the "pipeline_configs" table.
 	PipelineConfigsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -607,6 +1442,57 @@ var (
 			},
 		},
 	}
+	// QueuedJobsColumns holds the columns for the "queued_jobs" table.
+	QueuedJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "queue", Type: field.TypeString},
+		{Name: "type", Type: field.TypeString},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "completed", "failed", "cancelled"}, Default: "pending"},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "payload", Type: field.TypeJSON, Nullable: true},
+		{Name: "result", Type: field.TypeJSON, Nullable: true},
+		{Name: "progress", Type: field.TypeFloat64, Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "retry_count", Type: field.TypeInt, Default: 0},
+		{Name: "max_retries", Type: field.TypeInt, Default: 3},
+		{Name: "run_at", Type: field.TypeTime},
+		{Name: "locked_by", Type: field.TypeString, Nullable: true},
+		{Name: "heartbeat_at", Type: field.TypeTime, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// QueuedJobsTable holds the schema information for the "queued_jobs" table.
+	QueuedJobsTable = &schema.Table{
+		Name:       "queued_jobs",
+		Columns:    QueuedJobsColumns,
+		PrimaryKey: []*schema.Column{QueuedJobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "queuedjob_queue_status_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{QueuedJobsColumns[1], QueuedJobsColumns[4], QueuedJobsColumns[12]},
+			},
+			{
+				Name:    "queuedjob_status_heartbeat_at",
+				Unique:  false,
+				Columns: []*schema.Column{QueuedJobsColumns[4], QueuedJobsColumns[14]},
+			},
+			{
+				Name:    "queuedjob_status_completed_at",
+				Unique:  false,
+				Columns: []*schema.Column{QueuedJobsColumns[4], QueuedJobsColumns[16]},
+			},
+			{
+				Name:    "queuedjob_queue_owner_id",
+				Unique:  false,
+				Columns: []*schema.Column{QueuedJobsColumns[1], QueuedJobsColumns[3]},
+			},
+		},
+	}
 	// ReceiptsColumns holds the columns for the "receipts" table.
 	ReceiptsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -639,6 +1525,7 @@ var (
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "notes", Type: field.TypeString, Nullable: true},
 		{Name: "legacy_id", Type: field.TypeString, Nullable: true},
+		{Name: "migration_run_id", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "processed_at", Type: field.TypeTime, Nullable: true},
@@ -690,16 +1577,106 @@ var (
 				Columns: []*schema.Column{ReceiptsColumns[29]},
 			},
 			{
-				Name:    "receipt_created_at",
+				Name:    "receipt_migration_run_id",
 				Unique:  false,
 				Columns: []*schema.Column{ReceiptsColumns[30]},
 			},
+			{
+				Name:    "receipt_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReceiptsColumns[31]},
+			},
+		},
+	}
+	// ReceiptEventsColumns holds the columns for the "receipt_events" table.
+	ReceiptEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "receipt_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "stage", Type: field.TypeEnum, Enums: []string{"fetched", "downloaded", "ocr", "parsed", "matched", "categorized"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"succeeded", "failed"}, Default: "succeeded"},
+		{Name: "version", Type: field.TypeString, Nullable: true},
+		{Name: "detail", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "data", Type: field.TypeJSON, Nullable: true},
+		{Name: "occurred_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ReceiptEventsTable holds the schema information for the "receipt_events" table.
+	ReceiptEventsTable = &schema.Table{
+		Name:       "receipt_events",
+		Columns:    ReceiptEventsColumns,
+		PrimaryKey: []*schema.Column{ReceiptEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "receiptevent_receipt_id_occurred_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReceiptEventsColumns[1], ReceiptEventsColumns[8]},
+			},
+			{
+				Name:    "receiptevent_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReceiptEventsColumns[2]},
+			},
+		},
+	}
+	// RoundingRulesColumns holds the columns for the "rounding_rules" table.
+	RoundingRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString, Unique: true},
+		{Name: "increment", Type: field.TypeFloat64},
+		{Name: "multiplier", Type: field.TypeFloat64, Default: 1},
+		{Name: "max_round_up", Type: field.TypeFloat64, Nullable: true},
+		{Name: "payment_methods", Type: field.TypeJSON, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// RoundingRulesTable holds the schema information for the "rounding_rules" table.
+	RoundingRulesTable = &schema.Table{
+		Name:       "rounding_rules",
+		Columns:    RoundingRulesColumns,
+		PrimaryKey: []*schema.Column{RoundingRulesColumns[0]},
+	}
+	// SavedFiltersColumns holds the columns for the "saved_filters" table.
+	SavedFiltersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "filter", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SavedFiltersTable holds the schema information for the "saved_filters" table.
+	SavedFiltersTable = &schema.Table{
+		Name:       "saved_filters",
+		Columns:    SavedFiltersColumns,
+		PrimaryKey: []*schema.Column{SavedFiltersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "savedfilter_user_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{SavedFiltersColumns[1], SavedFiltersColumns[2]},
+			},
 		},
 	}
+	// TaxYearsColumns holds the columns for the "tax_years" table.
+	TaxYearsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "data", Type: field.TypeBytes},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TaxYearsTable holds the schema information for the "tax_years" table.
+	TaxYearsTable = &schema.Table{
+		Name:       "tax_years",
+		Columns:    TaxYearsColumns,
+		PrimaryKey: []*schema.Column{TaxYearsColumns[0]},
+	}
 	// TransactionsColumns holds the columns for the "transactions" table.
 	TransactionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
 		{Name: "user_id", Type: field.TypeString},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"receipt", "manual"}, Default: "receipt"},
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"purchase", "refund", "payment", "withdrawal", "deposit", "transfer", "other"}, Default: "purchase"},
 		{Name: "amount", Type: field.TypeFloat64},
 		{Name: "currency", Type: field.TypeString, Default: "USD"},
@@ -712,15 +1689,19 @@ var (
 		{Name: "reference_number", Type: field.TypeString, Nullable: true},
 		{Name: "authorization_code", Type: field.TypeString, Nullable: true},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "completed", "failed", "refunded", "disputed", "cancelled"}, Default: "completed"},
+		{Name: "round_up_amount", Type: field.TypeFloat64, Default: 0},
 		{Name: "is_recurring", Type: field.TypeBool, Default: false},
 		{Name: "recurrence_pattern", Type: field.TypeString, Nullable: true},
 		{Name: "category_tags", Type: field.TypeJSON, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "notes", Type: field.TypeString, Nullable: true},
+		{Name: "member_id", Type: field.TypeString, Nullable: true},
 		{Name: "legacy_id", Type: field.TypeString, Nullable: true},
+		{Name: "migration_run_id", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "receipt_id", Type: field.TypeString},
+		{Name: "merchant_id", Type: field.TypeString, Nullable: true},
+		{Name: "receipt_id", Type: field.TypeString, Nullable: true},
 	}
 	// TransactionsTable holds the schema information for the "transactions" table.
 	TransactionsTable = &schema.Table{
@@ -728,18 +1709,24 @@ var (
 		Columns:    TransactionsColumns,
 		PrimaryKey: []*schema.Column{TransactionsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "transactions_merchants_transactions",
+				Columns:    []*schema.Column{TransactionsColumns[26]},
+				RefColumns: []*schema.Column{MerchantsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "transactions_receipts_transactions",
-				Columns:    []*schema.Column{TransactionsColumns[22]},
+				Columns:    []*schema.Column{TransactionsColumns[27]},
 				RefColumns: []*schema.Column{ReceiptsColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.SetNull,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "transaction_receipt_id",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[22]},
+				Columns: []*schema.Column{TransactionsColumns[27]},
 			},
 			{
 				Name:    "transaction_user_id",
@@ -749,64 +1736,219 @@ var (
 			{
 				Name:    "transaction_type",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[2]},
+				Columns: []*schema.Column{TransactionsColumns[3]},
 			},
 			{
 				Name:    "transaction_status",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[13]},
+				Columns: []*schema.Column{TransactionsColumns[14]},
 			},
 			{
 				Name:    "transaction_transaction_date",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[5]},
+				Columns: []*schema.Column{TransactionsColumns[6]},
 			},
 			{
 				Name:    "transaction_user_id_transaction_date",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[1], TransactionsColumns[5]},
+				Columns: []*schema.Column{TransactionsColumns[1], TransactionsColumns[6]},
+			},
+			{
+				Name:    "transaction_user_id_source",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[1], TransactionsColumns[2]},
 			},
 			{
 				Name:    "transaction_merchant_name",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[7]},
+				Columns: []*schema.Column{TransactionsColumns[8]},
+			},
+			{
+				Name:    "transaction_merchant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[26]},
 			},
 			{
 				Name:    "transaction_legacy_id",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[19]},
+				Columns: []*schema.Column{TransactionsColumns[22]},
+			},
+			{
+				Name:    "transaction_migration_run_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[23]},
 			},
 			{
 				Name:    "transaction_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[20]},
+				Columns: []*schema.Column{TransactionsColumns[24]},
+			},
+		},
+	}
+	// UsersColumns holds the columns for the "users" table.
+	UsersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "email", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "email_verified", Type: field.TypeBool, Default: false},
+		{Name: "pending_email", Type: field.TypeString, Nullable: true},
+		{Name: "email_verification_hash", Type: field.TypeString, Nullable: true},
+		{Name: "email_verification_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString, Default: ""},
+		{Name: "locale", Type: field.TypeString, Nullable: true},
+		{Name: "password_hash", Type: field.TypeString, Nullable: true},
+		{Name: "google_id", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// UsersTable holds the schema information for the "users" table.
+	UsersTable = &schema.Table{
+		Name:       "users",
+		Columns:    UsersColumns,
+		PrimaryKey: []*schema.Column{UsersColumns[0]},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "endpoint_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "event", Type: field.TypeString},
+		{Name: "event_id", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "succeeded", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[6], WebhookDeliveriesColumns[8]},
+			},
+			{
+				Name:    "webhookdelivery_endpoint_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[1], WebhookDeliveriesColumns[13]},
+			},
+			{
+				Name:    "webhookdelivery_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[2]},
+			},
+		},
+	}
+	// WebhookEndpointsColumns holds the columns for the "webhook_endpoints" table.
+	WebhookEndpointsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// WebhookEndpointsTable holds the schema information for the "webhook_endpoints" table.
+	WebhookEndpointsTable = &schema.Table{
+		Name:       "webhook_endpoints",
+		Columns:    WebhookEndpointsColumns,
+		PrimaryKey: []*schema.Column{WebhookEndpointsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookendpoint_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookEndpointsColumns[1]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccountDeletionsTable,
+		AlertsTable,
+		AlertPreferencesTable,
+		AttachmentBlobsTable,
+		AttachmentLinksTable,
+		BudgetReallocationsTable,
+		BulkOperationsTable,
+		CardAccountsTable,
+		CategorizationRulesTable,
+		CategoryFeedbacksTable,
+		DebtsTable,
 		EmailConnectionsTable,
 		EmailLabelsTable,
+		EmailMessagesTable,
 		EmailSyncsTable,
+		EmailSyncFailuresTable,
+		EmergencyFundSnapshotsTable,
+		EmergencyFundTargetsTable,
+		GoalsTable,
 		GoogleDriveConnectionsTable,
 		GoogleDriveFoldersTable,
 		GoogleDriveSyncsTable,
+		HouseholdMembersTable,
+		JobQueuesTable,
+		JobSchedulesTable,
+		JobScheduleRunsTable,
 		LineItemsTable,
+		LiquidAccountsTable,
+		MembershipsTable,
+		MerchantsTable,
+		MigrationStateTable,
+		NotificationsTable,
+		OauthStatesTable,
+		OcrFeedbacksTable,
+		OrganizationsTable,
 		PipelineConfigsTable,
 		PipelineRulesTable,
 		PipelineVersionsTable,
+		QueuedJobsTable,
 		ReceiptsTable,
+		ReceiptEventsTable,
+		RoundingRulesTable,
+		SavedFiltersTable,
+		TaxYearsTable,
 		TransactionsTable,
+		UsersTable,
+		WebhookDeliveriesTable,
+		WebhookEndpointsTable,
 	}
 )
 
 func init() {
+	AttachmentLinksTable.ForeignKeys[0].RefTable = AttachmentBlobsTable
+	BudgetReallocationsTable.ForeignKeys[0].RefTable = UsersTable
+	EmailConnectionsTable.ForeignKeys[0].RefTable = UsersTable
 	EmailLabelsTable.ForeignKeys[0].RefTable = EmailConnectionsTable
 	EmailSyncsTable.ForeignKeys[0].RefTable = EmailConnectionsTable
+	EmailSyncFailuresTable.ForeignKeys[0].RefTable = EmailSyncsTable
+	GoogleDriveConnectionsTable.ForeignKeys[0].RefTable = UsersTable
 	GoogleDriveFoldersTable.ForeignKeys[0].RefTable = GoogleDriveConnectionsTable
 	GoogleDriveSyncsTable.ForeignKeys[0].RefTable = GoogleDriveConnectionsTable
 	LineItemsTable.ForeignKeys[0].RefTable = ReceiptsTable
+	MembershipsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	MigrationStateTable.Annotation = &entsql.Annotation{
+		Table: "migration_state",
+	}
+	OauthStatesTable.Annotation = &entsql.Annotation{
+		Table: "oauth_states",
+	}
 	PipelineRulesTable.ForeignKeys[0].RefTable = PipelineConfigsTable
 	PipelineVersionsTable.ForeignKeys[0].RefTable = PipelineConfigsTable
-	TransactionsTable.ForeignKeys[0].RefTable = ReceiptsTable
+	TaxYearsTable.Annotation = &entsql.Annotation{
+		Table: "tax_years",
+	}
+	TransactionsTable.ForeignKeys[0].RefTable = MerchantsTable
+	TransactionsTable.ForeignKeys[1].RefTable = ReceiptsTable
 }
@@ -0,0 +1,723 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/migrationstate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MigrationStateCreate is the builder for creating a MigrationState entity.
+type MigrationStateCreate struct {
+	config
+	mutation *MigrationStateMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetKind sets the "kind" field.
+func (_c *MigrationStateCreate) SetKind(v migrationstate.Kind) *MigrationStateCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetKey sets the "key" field.
+func (_c *MigrationStateCreate) SetKey(v string) *MigrationStateCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *MigrationStateCreate) SetValue(v string) *MigrationStateCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetRunID sets the "run_id" field.
+func (_c *MigrationStateCreate) SetRunID(v string) *MigrationStateCreate {
+	_c.mutation.SetRunID(v)
+	return _c
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_c *MigrationStateCreate) SetNillableRunID(v *string) *MigrationStateCreate {
+	if v != nil {
+		_c.SetRunID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MigrationStateCreate) SetCreatedAt(v time.Time) *MigrationStateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MigrationStateCreate) SetNillableCreatedAt(v *time.Time) *MigrationStateCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *MigrationStateCreate) SetUpdatedAt(v time.Time) *MigrationStateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *MigrationStateCreate) SetNillableUpdatedAt(v *time.Time) *MigrationStateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MigrationStateCreate) SetID(v string) *MigrationStateCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the MigrationStateMutation object of the builder.
+func (_c *MigrationStateCreate) Mutation() *MigrationStateMutation {
+	return _c.mutation
+}
+
+// Save creates the MigrationState in the database.
+func (_c *MigrationStateCreate) Save(ctx context.Context) (*MigrationState, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MigrationStateCreate) SaveX(ctx context.Context) *MigrationState {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MigrationStateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MigrationStateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MigrationStateCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := migrationstate.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := migrationstate.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MigrationStateCreate) check() error {
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "MigrationState.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := migrationstate.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "MigrationState.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "MigrationState.key"`)}
+	}
+	if v, ok := _c.mutation.Key(); ok {
+		if err := migrationstate.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "MigrationState.key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "MigrationState.value"`)}
+	}
+	if v, ok := _c.mutation.Value(); ok {
+		if err := migrationstate.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "MigrationState.value": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MigrationState.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MigrationState.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *MigrationStateCreate) sqlSave(ctx context.Context) (*MigrationState, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected MigrationState.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MigrationStateCreate) createSpec() (*MigrationState, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MigrationState{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(migrationstate.Table, sqlgraph.NewFieldSpec(migrationstate.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(migrationstate.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(migrationstate.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(migrationstate.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if value, ok := _c.mutation.RunID(); ok {
+		_spec.SetField(migrationstate.FieldRunID, field.TypeString, value)
+		_node.RunID = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(migrationstate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(migrationstate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MigrationState.Create().
+//		SetKind(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MigrationStateUpsert) {
+//			SetKind(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MigrationStateCreate) OnConflict(opts ...sql.ConflictOption) *MigrationStateUpsertOne {
+	_c.conflict = opts
+	return &MigrationStateUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MigrationState.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MigrationStateCreate) OnConflictColumns(columns ...string) *MigrationStateUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MigrationStateUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// MigrationStateUpsertOne is the builder for "upsert"-ing
+	//  one MigrationState node.
+	MigrationStateUpsertOne struct {
+		create *MigrationStateCreate
+	}
+
+	// MigrationStateUpsert is the "OnConflict" setter.
+	MigrationStateUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetValue sets the "value" field.
+func (u *MigrationStateUpsert) SetValue(v string) *MigrationStateUpsert {
+	u.Set(migrationstate.FieldValue, v)
+	return u
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *MigrationStateUpsert) UpdateValue() *MigrationStateUpsert {
+	u.SetExcluded(migrationstate.FieldValue)
+	return u
+}
+
+// SetRunID sets the "run_id" field.
+func (u *MigrationStateUpsert) SetRunID(v string) *MigrationStateUpsert {
+	u.Set(migrationstate.FieldRunID, v)
+	return u
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *MigrationStateUpsert) UpdateRunID() *MigrationStateUpsert {
+	u.SetExcluded(migrationstate.FieldRunID)
+	return u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *MigrationStateUpsert) ClearRunID() *MigrationStateUpsert {
+	u.SetNull(migrationstate.FieldRunID)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MigrationStateUpsert) SetUpdatedAt(v time.Time) *MigrationStateUpsert {
+	u.Set(migrationstate.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MigrationStateUpsert) UpdateUpdatedAt() *MigrationStateUpsert {
+	u.SetExcluded(migrationstate.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MigrationState.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(migrationstate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MigrationStateUpsertOne) UpdateNewValues() *MigrationStateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(migrationstate.FieldID)
+		}
+		if _, exists := u.create.mutation.Kind(); exists {
+			s.SetIgnore(migrationstate.FieldKind)
+		}
+		if _, exists := u.create.mutation.Key(); exists {
+			s.SetIgnore(migrationstate.FieldKey)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(migrationstate.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MigrationState.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MigrationStateUpsertOne) Ignore() *MigrationStateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MigrationStateUpsertOne) DoNothing() *MigrationStateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MigrationStateCreate.OnConflict
+// documentation for more info.
+func (u *MigrationStateUpsertOne) Update(set func(*MigrationStateUpsert)) *MigrationStateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MigrationStateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetValue sets the "value" field.
+func (u *MigrationStateUpsertOne) SetValue(v string) *MigrationStateUpsertOne {
+	return u.Update(func(s *MigrationStateUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *MigrationStateUpsertOne) UpdateValue() *MigrationStateUpsertOne {
+	return u.Update(func(s *MigrationStateUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// SetRunID sets the "run_id" field.
+func (u *MigrationStateUpsertOne) SetRunID(v string) *MigrationStateUpsertOne {
+	return u.Update(func(s *MigrationStateUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *MigrationStateUpsertOne) UpdateRunID() *MigrationStateUpsertOne {
+	return u.Update(func(s *MigrationStateUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *MigrationStateUpsertOne) ClearRunID() *MigrationStateUpsertOne {
+	return u.Update(func(s *MigrationStateUpsert) {
+		s.ClearRunID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MigrationStateUpsertOne) SetUpdatedAt(v time.Time) *MigrationStateUpsertOne {
+	return u.Update(func(s *MigrationStateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MigrationStateUpsertOne) UpdateUpdatedAt() *MigrationStateUpsertOne {
+	return u.Update(func(s *MigrationStateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MigrationStateUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MigrationStateCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MigrationStateUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MigrationStateUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MigrationStateUpsertOne.ID is not supported by MySQL driver. Use MigrationStateUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MigrationStateUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MigrationStateCreateBulk is the builder for creating many MigrationState entities in bulk.
+type MigrationStateCreateBulk struct {
+	config
+	err      error
+	builders []*MigrationStateCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MigrationState entities in the database.
+func (_c *MigrationStateCreateBulk) Save(ctx context.Context) ([]*MigrationState, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MigrationState, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MigrationStateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MigrationStateCreateBulk) SaveX(ctx context.Context) []*MigrationState {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MigrationStateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MigrationStateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MigrationState.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MigrationStateUpsert) {
+//			SetKind(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MigrationStateCreateBulk) OnConflict(opts ...sql.ConflictOption) *MigrationStateUpsertBulk {
+	_c.conflict = opts
+	return &MigrationStateUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MigrationState.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MigrationStateCreateBulk) OnConflictColumns(columns ...string) *MigrationStateUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MigrationStateUpsertBulk{
+		create: _c,
+	}
+}
+
+// MigrationStateUpsertBulk is the builder for "upsert"-ing
+// a bulk of MigrationState nodes.
+type MigrationStateUpsertBulk struct {
+	create *MigrationStateCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MigrationState.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(migrationstate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MigrationStateUpsertBulk) UpdateNewValues() *MigrationStateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(migrationstate.FieldID)
+			}
+			if _, exists := b.mutation.Kind(); exists {
+				s.SetIgnore(migrationstate.FieldKind)
+			}
+			if _, exists := b.mutation.Key(); exists {
+				s.SetIgnore(migrationstate.FieldKey)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(migrationstate.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MigrationState.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MigrationStateUpsertBulk) Ignore() *MigrationStateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MigrationStateUpsertBulk) DoNothing() *MigrationStateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MigrationStateCreateBulk.OnConflict
+// documentation for more info.
+func (u *MigrationStateUpsertBulk) Update(set func(*MigrationStateUpsert)) *MigrationStateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MigrationStateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetValue sets the "value" field.
+func (u *MigrationStateUpsertBulk) SetValue(v string) *MigrationStateUpsertBulk {
+	return u.Update(func(s *MigrationStateUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *MigrationStateUpsertBulk) UpdateValue() *MigrationStateUpsertBulk {
+	return u.Update(func(s *MigrationStateUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// SetRunID sets the "run_id" field.
+func (u *MigrationStateUpsertBulk) SetRunID(v string) *MigrationStateUpsertBulk {
+	return u.Update(func(s *MigrationStateUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *MigrationStateUpsertBulk) UpdateRunID() *MigrationStateUpsertBulk {
+	return u.Update(func(s *MigrationStateUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *MigrationStateUpsertBulk) ClearRunID() *MigrationStateUpsertBulk {
+	return u.Update(func(s *MigrationStateUpsert) {
+		s.ClearRunID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MigrationStateUpsertBulk) SetUpdatedAt(v time.Time) *MigrationStateUpsertBulk {
+	return u.Update(func(s *MigrationStateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MigrationStateUpsertBulk) UpdateUpdatedAt() *MigrationStateUpsertBulk {
+	return u.Update(func(s *MigrationStateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MigrationStateUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MigrationStateCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MigrationStateCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MigrationStateUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
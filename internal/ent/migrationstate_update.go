@@ -0,0 +1,324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/migrationstate"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MigrationStateUpdate is the builder for updating MigrationState entities.
+type MigrationStateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MigrationStateMutation
+}
+
+// Where appends a list predicates to the MigrationStateUpdate builder.
+func (_u *MigrationStateUpdate) Where(ps ...predicate.MigrationState) *MigrationStateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *MigrationStateUpdate) SetValue(v string) *MigrationStateUpdate {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *MigrationStateUpdate) SetNillableValue(v *string) *MigrationStateUpdate {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// SetRunID sets the "run_id" field.
+func (_u *MigrationStateUpdate) SetRunID(v string) *MigrationStateUpdate {
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *MigrationStateUpdate) SetNillableRunID(v *string) *MigrationStateUpdate {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (_u *MigrationStateUpdate) ClearRunID() *MigrationStateUpdate {
+	_u.mutation.ClearRunID()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MigrationStateUpdate) SetUpdatedAt(v time.Time) *MigrationStateUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the MigrationStateMutation object of the builder.
+func (_u *MigrationStateUpdate) Mutation() *MigrationStateMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MigrationStateUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MigrationStateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MigrationStateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MigrationStateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MigrationStateUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := migrationstate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MigrationStateUpdate) check() error {
+	if v, ok := _u.mutation.Value(); ok {
+		if err := migrationstate.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "MigrationState.value": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MigrationStateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(migrationstate.Table, migrationstate.Columns, sqlgraph.NewFieldSpec(migrationstate.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(migrationstate.FieldValue, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RunID(); ok {
+		_spec.SetField(migrationstate.FieldRunID, field.TypeString, value)
+	}
+	if _u.mutation.RunIDCleared() {
+		_spec.ClearField(migrationstate.FieldRunID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(migrationstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{migrationstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MigrationStateUpdateOne is the builder for updating a single MigrationState entity.
+type MigrationStateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MigrationStateMutation
+}
+
+// SetValue sets the "value" field.
+func (_u *MigrationStateUpdateOne) SetValue(v string) *MigrationStateUpdateOne {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *MigrationStateUpdateOne) SetNillableValue(v *string) *MigrationStateUpdateOne {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// SetRunID sets the "run_id" field.
+func (_u *MigrationStateUpdateOne) SetRunID(v string) *MigrationStateUpdateOne {
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *MigrationStateUpdateOne) SetNillableRunID(v *string) *MigrationStateUpdateOne {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (_u *MigrationStateUpdateOne) ClearRunID() *MigrationStateUpdateOne {
+	_u.mutation.ClearRunID()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MigrationStateUpdateOne) SetUpdatedAt(v time.Time) *MigrationStateUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the MigrationStateMutation object of the builder.
+func (_u *MigrationStateUpdateOne) Mutation() *MigrationStateMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MigrationStateUpdate builder.
+func (_u *MigrationStateUpdateOne) Where(ps ...predicate.MigrationState) *MigrationStateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MigrationStateUpdateOne) Select(field string, fields ...string) *MigrationStateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MigrationState entity.
+func (_u *MigrationStateUpdateOne) Save(ctx context.Context) (*MigrationState, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MigrationStateUpdateOne) SaveX(ctx context.Context) *MigrationState {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MigrationStateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MigrationStateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MigrationStateUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := migrationstate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MigrationStateUpdateOne) check() error {
+	if v, ok := _u.mutation.Value(); ok {
+		if err := migrationstate.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "MigrationState.value": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MigrationStateUpdateOne) sqlSave(ctx context.Context) (_node *MigrationState, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(migrationstate.Table, migrationstate.Columns, sqlgraph.NewFieldSpec(migrationstate.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MigrationState.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, migrationstate.FieldID)
+		for _, f := range fields {
+			if !migrationstate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != migrationstate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(migrationstate.FieldValue, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RunID(); ok {
+		_spec.SetField(migrationstate.FieldRunID, field.TypeString, value)
+	}
+	if _u.mutation.RunIDCleared() {
+		_spec.ClearField(migrationstate.FieldRunID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(migrationstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &MigrationState{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{migrationstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
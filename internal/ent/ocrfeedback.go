@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/ocrfeedback"
+	"encoding/json"
+	"encoding/json/jsontext"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// OCRFeedback is the model entity for the OCRFeedback schema.
+type OCRFeedback struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ReceiptID holds the value of the "receipt_id" field.
+	ReceiptID string `json:"receipt_id,omitempty"`
+	// ID of the user who corrected the receipt
+	UserID string `json:"user_id,omitempty"`
+	// Receipt field corrected, e.g. total_amount
+	Field string `json:"field,omitempty"`
+	// Value the user read in the selection, if they gave one
+	Value *string `json:"value,omitempty"`
+	// Bounding box the user selected, in page-relative coordinates
+	Box jsontext.Value `json:"box,omitempty"`
+	// Value extracted for the field before the correction
+	PredictedValue *string `json:"predicted_value,omitempty"`
+	// Bounding box the field was extracted from before the correction
+	PredictedBox jsontext.Value `json:"predicted_box,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OCRFeedback) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ocrfeedback.FieldBox, ocrfeedback.FieldPredictedBox:
+			values[i] = new([]byte)
+		case ocrfeedback.FieldID, ocrfeedback.FieldReceiptID, ocrfeedback.FieldUserID, ocrfeedback.FieldField, ocrfeedback.FieldValue, ocrfeedback.FieldPredictedValue:
+			values[i] = new(sql.NullString)
+		case ocrfeedback.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OCRFeedback fields.
+func (_m *OCRFeedback) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ocrfeedback.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case ocrfeedback.FieldReceiptID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field receipt_id", values[i])
+			} else if value.Valid {
+				_m.ReceiptID = value.String
+			}
+		case ocrfeedback.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case ocrfeedback.FieldField:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field field", values[i])
+			} else if value.Valid {
+				_m.Field = value.String
+			}
+		case ocrfeedback.FieldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				_m.Value = new(string)
+				*_m.Value = value.String
+			}
+		case ocrfeedback.FieldBox:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field box", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Box); err != nil {
+					return fmt.Errorf("unmarshal field box: %w", err)
+				}
+			}
+		case ocrfeedback.FieldPredictedValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field predicted_value", values[i])
+			} else if value.Valid {
+				_m.PredictedValue = new(string)
+				*_m.PredictedValue = value.String
+			}
+		case ocrfeedback.FieldPredictedBox:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field predicted_box", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PredictedBox); err != nil {
+					return fmt.Errorf("unmarshal field predicted_box: %w", err)
+				}
+			}
+		case ocrfeedback.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the OCRFeedback.
+// This includes values selected through modifiers, order, etc.
+func (_m *OCRFeedback) GetValue(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OCRFeedback.
+// Note that you need to call OCRFeedback.Unwrap() before calling this method if this OCRFeedback
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OCRFeedback) Update() *OCRFeedbackUpdateOne {
+	return NewOCRFeedbackClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OCRFeedback entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OCRFeedback) Unwrap() *OCRFeedback {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OCRFeedback is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OCRFeedback) String() string {
+	var builder strings.Builder
+	builder.WriteString("OCRFeedback(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("receipt_id=")
+	builder.WriteString(_m.ReceiptID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("field=")
+	builder.WriteString(_m.Field)
+	builder.WriteString(", ")
+	if v := _m.Value; v != nil {
+		builder.WriteString("value=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("box=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Box))
+	builder.WriteString(", ")
+	if v := _m.PredictedValue; v != nil {
+		builder.WriteString("predicted_value=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("predicted_box=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PredictedBox))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OCRFeedbacks is a parsable slice of OCRFeedback.
+type OCRFeedbacks []*OCRFeedback
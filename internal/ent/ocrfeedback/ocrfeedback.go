@@ -0,0 +1,106 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ocrfeedback
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the ocrfeedback type in the database.
+	Label = "ocr_feedback"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldReceiptID holds the string denoting the receipt_id field in the database.
+	FieldReceiptID = "receipt_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldField holds the string denoting the field field in the database.
+	FieldField = "field"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// FieldBox holds the string denoting the box field in the database.
+	FieldBox = "box"
+	// FieldPredictedValue holds the string denoting the predicted_value field in the database.
+	FieldPredictedValue = "predicted_value"
+	// FieldPredictedBox holds the string denoting the predicted_box field in the database.
+	FieldPredictedBox = "predicted_box"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the ocrfeedback in the database.
+	Table = "ocr_feedbacks"
+)
+
+// Columns holds all SQL columns for ocrfeedback fields.
+var Columns = []string{
+	FieldID,
+	FieldReceiptID,
+	FieldUserID,
+	FieldField,
+	FieldValue,
+	FieldBox,
+	FieldPredictedValue,
+	FieldPredictedBox,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ReceiptIDValidator is a validator for the "receipt_id" field. It is called by the builders before save.
+	ReceiptIDValidator func(string) error
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// FieldValidator is a validator for the "field" field. It is called by the builders before save.
+	FieldValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the OCRFeedback queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByReceiptID orders the results by the receipt_id field.
+func ByReceiptID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceiptID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByField orders the results by the field field.
+func ByField(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldField, opts...).ToFunc()
+}
+
+// ByValue orders the results by the value field.
+func ByValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValue, opts...).ToFunc()
+}
+
+// ByPredictedValue orders the results by the predicted_value field.
+func ByPredictedValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPredictedValue, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,505 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ocrfeedback
+
+import (
+	"clockzen-next/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldContainsFold(FieldID, id))
+}
+
+// ReceiptID applies equality check predicate on the "receipt_id" field. It's identical to ReceiptIDEQ.
+func ReceiptID(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldEQ(FieldReceiptID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldEQ(FieldUserID, v))
+}
+
+// Field applies equality check predicate on the "field" field. It's identical to FieldEQ.
+func Field(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldEQ(FieldField, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldEQ(FieldValue, v))
+}
+
+// PredictedValue applies equality check predicate on the "predicted_value" field. It's identical to PredictedValueEQ.
+func PredictedValue(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldEQ(FieldPredictedValue, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ReceiptIDEQ applies the EQ predicate on the "receipt_id" field.
+func ReceiptIDEQ(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldEQ(FieldReceiptID, v))
+}
+
+// ReceiptIDNEQ applies the NEQ predicate on the "receipt_id" field.
+func ReceiptIDNEQ(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldNEQ(FieldReceiptID, v))
+}
+
+// ReceiptIDIn applies the In predicate on the "receipt_id" field.
+func ReceiptIDIn(vs ...string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldIn(FieldReceiptID, vs...))
+}
+
+// ReceiptIDNotIn applies the NotIn predicate on the "receipt_id" field.
+func ReceiptIDNotIn(vs ...string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldNotIn(FieldReceiptID, vs...))
+}
+
+// ReceiptIDGT applies the GT predicate on the "receipt_id" field.
+func ReceiptIDGT(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldGT(FieldReceiptID, v))
+}
+
+// ReceiptIDGTE applies the GTE predicate on the "receipt_id" field.
+func ReceiptIDGTE(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldGTE(FieldReceiptID, v))
+}
+
+// ReceiptIDLT applies the LT predicate on the "receipt_id" field.
+func ReceiptIDLT(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldLT(FieldReceiptID, v))
+}
+
+// ReceiptIDLTE applies the LTE predicate on the "receipt_id" field.
+func ReceiptIDLTE(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldLTE(FieldReceiptID, v))
+}
+
+// ReceiptIDContains applies the Contains predicate on the "receipt_id" field.
+func ReceiptIDContains(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldContains(FieldReceiptID, v))
+}
+
+// ReceiptIDHasPrefix applies the HasPrefix predicate on the "receipt_id" field.
+func ReceiptIDHasPrefix(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldHasPrefix(FieldReceiptID, v))
+}
+
+// ReceiptIDHasSuffix applies the HasSuffix predicate on the "receipt_id" field.
+func ReceiptIDHasSuffix(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldHasSuffix(FieldReceiptID, v))
+}
+
+// ReceiptIDEqualFold applies the EqualFold predicate on the "receipt_id" field.
+func ReceiptIDEqualFold(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldEqualFold(FieldReceiptID, v))
+}
+
+// ReceiptIDContainsFold applies the ContainsFold predicate on the "receipt_id" field.
+func ReceiptIDContainsFold(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldContainsFold(FieldReceiptID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// FieldEQ applies the EQ predicate on the "field" field.
+func FieldEQ(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldEQ(FieldField, v))
+}
+
+// FieldNEQ applies the NEQ predicate on the "field" field.
+func FieldNEQ(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldNEQ(FieldField, v))
+}
+
+// FieldIn applies the In predicate on the "field" field.
+func FieldIn(vs ...string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldIn(FieldField, vs...))
+}
+
+// FieldNotIn applies the NotIn predicate on the "field" field.
+func FieldNotIn(vs ...string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldNotIn(FieldField, vs...))
+}
+
+// FieldGT applies the GT predicate on the "field" field.
+func FieldGT(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldGT(FieldField, v))
+}
+
+// FieldGTE applies the GTE predicate on the "field" field.
+func FieldGTE(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldGTE(FieldField, v))
+}
+
+// FieldLT applies the LT predicate on the "field" field.
+func FieldLT(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldLT(FieldField, v))
+}
+
+// FieldLTE applies the LTE predicate on the "field" field.
+func FieldLTE(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldLTE(FieldField, v))
+}
+
+// FieldContains applies the Contains predicate on the "field" field.
+func FieldContains(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldContains(FieldField, v))
+}
+
+// FieldHasPrefix applies the HasPrefix predicate on the "field" field.
+func FieldHasPrefix(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldHasPrefix(FieldField, v))
+}
+
+// FieldHasSuffix applies the HasSuffix predicate on the "field" field.
+func FieldHasSuffix(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldHasSuffix(FieldField, v))
+}
+
+// FieldEqualFold applies the EqualFold predicate on the "field" field.
+func FieldEqualFold(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldEqualFold(FieldField, v))
+}
+
+// FieldContainsFold applies the ContainsFold predicate on the "field" field.
+func FieldContainsFold(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldContainsFold(FieldField, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldLTE(FieldValue, v))
+}
+
+// ValueContains applies the Contains predicate on the "value" field.
+func ValueContains(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldContains(FieldValue, v))
+}
+
+// ValueHasPrefix applies the HasPrefix predicate on the "value" field.
+func ValueHasPrefix(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldHasPrefix(FieldValue, v))
+}
+
+// ValueHasSuffix applies the HasSuffix predicate on the "value" field.
+func ValueHasSuffix(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldHasSuffix(FieldValue, v))
+}
+
+// ValueIsNil applies the IsNil predicate on the "value" field.
+func ValueIsNil() predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldIsNull(FieldValue))
+}
+
+// ValueNotNil applies the NotNil predicate on the "value" field.
+func ValueNotNil() predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldNotNull(FieldValue))
+}
+
+// ValueEqualFold applies the EqualFold predicate on the "value" field.
+func ValueEqualFold(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldEqualFold(FieldValue, v))
+}
+
+// ValueContainsFold applies the ContainsFold predicate on the "value" field.
+func ValueContainsFold(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldContainsFold(FieldValue, v))
+}
+
+// PredictedValueEQ applies the EQ predicate on the "predicted_value" field.
+func PredictedValueEQ(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldEQ(FieldPredictedValue, v))
+}
+
+// PredictedValueNEQ applies the NEQ predicate on the "predicted_value" field.
+func PredictedValueNEQ(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldNEQ(FieldPredictedValue, v))
+}
+
+// PredictedValueIn applies the In predicate on the "predicted_value" field.
+func PredictedValueIn(vs ...string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldIn(FieldPredictedValue, vs...))
+}
+
+// PredictedValueNotIn applies the NotIn predicate on the "predicted_value" field.
+func PredictedValueNotIn(vs ...string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldNotIn(FieldPredictedValue, vs...))
+}
+
+// PredictedValueGT applies the GT predicate on the "predicted_value" field.
+func PredictedValueGT(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldGT(FieldPredictedValue, v))
+}
+
+// PredictedValueGTE applies the GTE predicate on the "predicted_value" field.
+func PredictedValueGTE(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldGTE(FieldPredictedValue, v))
+}
+
+// PredictedValueLT applies the LT predicate on the "predicted_value" field.
+func PredictedValueLT(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldLT(FieldPredictedValue, v))
+}
+
+// PredictedValueLTE applies the LTE predicate on the "predicted_value" field.
+func PredictedValueLTE(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldLTE(FieldPredictedValue, v))
+}
+
+// PredictedValueContains applies the Contains predicate on the "predicted_value" field.
+func PredictedValueContains(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldContains(FieldPredictedValue, v))
+}
+
+// PredictedValueHasPrefix applies the HasPrefix predicate on the "predicted_value" field.
+func PredictedValueHasPrefix(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldHasPrefix(FieldPredictedValue, v))
+}
+
+// PredictedValueHasSuffix applies the HasSuffix predicate on the "predicted_value" field.
+func PredictedValueHasSuffix(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldHasSuffix(FieldPredictedValue, v))
+}
+
+// PredictedValueIsNil applies the IsNil predicate on the "predicted_value" field.
+func PredictedValueIsNil() predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldIsNull(FieldPredictedValue))
+}
+
+// PredictedValueNotNil applies the NotNil predicate on the "predicted_value" field.
+func PredictedValueNotNil() predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldNotNull(FieldPredictedValue))
+}
+
+// PredictedValueEqualFold applies the EqualFold predicate on the "predicted_value" field.
+func PredictedValueEqualFold(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldEqualFold(FieldPredictedValue, v))
+}
+
+// PredictedValueContainsFold applies the ContainsFold predicate on the "predicted_value" field.
+func PredictedValueContainsFold(v string) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldContainsFold(FieldPredictedValue, v))
+}
+
+// PredictedBoxIsNil applies the IsNil predicate on the "predicted_box" field.
+func PredictedBoxIsNil() predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldIsNull(FieldPredictedBox))
+}
+
+// PredictedBoxNotNil applies the NotNil predicate on the "predicted_box" field.
+func PredictedBoxNotNil() predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldNotNull(FieldPredictedBox))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OCRFeedback) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OCRFeedback) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OCRFeedback) predicate.OCRFeedback {
+	return predicate.OCRFeedback(sql.NotPredicates(p))
+}
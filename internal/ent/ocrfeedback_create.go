@@ -0,0 +1,630 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/ocrfeedback"
+	"context"
+	"encoding/json/jsontext"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OCRFeedbackCreate is the builder for creating a OCRFeedback entity.
+type OCRFeedbackCreate struct {
+	config
+	mutation *OCRFeedbackMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (_c *OCRFeedbackCreate) SetReceiptID(v string) *OCRFeedbackCreate {
+	_c.mutation.SetReceiptID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *OCRFeedbackCreate) SetUserID(v string) *OCRFeedbackCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetField sets the "field" field.
+func (_c *OCRFeedbackCreate) SetField(v string) *OCRFeedbackCreate {
+	_c.mutation.SetFieldField(v)
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *OCRFeedbackCreate) SetValue(v string) *OCRFeedbackCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_c *OCRFeedbackCreate) SetNillableValue(v *string) *OCRFeedbackCreate {
+	if v != nil {
+		_c.SetValue(*v)
+	}
+	return _c
+}
+
+// SetBox sets the "box" field.
+func (_c *OCRFeedbackCreate) SetBox(v jsontext.Value) *OCRFeedbackCreate {
+	_c.mutation.SetBox(v)
+	return _c
+}
+
+// SetPredictedValue sets the "predicted_value" field.
+func (_c *OCRFeedbackCreate) SetPredictedValue(v string) *OCRFeedbackCreate {
+	_c.mutation.SetPredictedValue(v)
+	return _c
+}
+
+// SetNillablePredictedValue sets the "predicted_value" field if the given value is not nil.
+func (_c *OCRFeedbackCreate) SetNillablePredictedValue(v *string) *OCRFeedbackCreate {
+	if v != nil {
+		_c.SetPredictedValue(*v)
+	}
+	return _c
+}
+
+// SetPredictedBox sets the "predicted_box" field.
+func (_c *OCRFeedbackCreate) SetPredictedBox(v jsontext.Value) *OCRFeedbackCreate {
+	_c.mutation.SetPredictedBox(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OCRFeedbackCreate) SetCreatedAt(v time.Time) *OCRFeedbackCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OCRFeedbackCreate) SetNillableCreatedAt(v *time.Time) *OCRFeedbackCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OCRFeedbackCreate) SetID(v string) *OCRFeedbackCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the OCRFeedbackMutation object of the builder.
+func (_c *OCRFeedbackCreate) Mutation() *OCRFeedbackMutation {
+	return _c.mutation
+}
+
+// Save creates the OCRFeedback in the database.
+func (_c *OCRFeedbackCreate) Save(ctx context.Context) (*OCRFeedback, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OCRFeedbackCreate) SaveX(ctx context.Context) *OCRFeedback {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OCRFeedbackCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OCRFeedbackCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OCRFeedbackCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ocrfeedback.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OCRFeedbackCreate) check() error {
+	if _, ok := _c.mutation.ReceiptID(); !ok {
+		return &ValidationError{Name: "receipt_id", err: errors.New(`ent: missing required field "OCRFeedback.receipt_id"`)}
+	}
+	if v, ok := _c.mutation.ReceiptID(); ok {
+		if err := ocrfeedback.ReceiptIDValidator(v); err != nil {
+			return &ValidationError{Name: "receipt_id", err: fmt.Errorf(`ent: validator failed for field "OCRFeedback.receipt_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "OCRFeedback.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := ocrfeedback.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "OCRFeedback.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GetField(); !ok {
+		return &ValidationError{Name: "field", err: errors.New(`ent: missing required field "OCRFeedback.field"`)}
+	}
+	if v, ok := _c.mutation.GetField(); ok {
+		if err := ocrfeedback.FieldValidator(v); err != nil {
+			return &ValidationError{Name: "field", err: fmt.Errorf(`ent: validator failed for field "OCRFeedback.field": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Box(); !ok {
+		return &ValidationError{Name: "box", err: errors.New(`ent: missing required field "OCRFeedback.box"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OCRFeedback.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *OCRFeedbackCreate) sqlSave(ctx context.Context) (*OCRFeedback, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected OCRFeedback.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OCRFeedbackCreate) createSpec() (*OCRFeedback, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OCRFeedback{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ocrfeedback.Table, sqlgraph.NewFieldSpec(ocrfeedback.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ReceiptID(); ok {
+		_spec.SetField(ocrfeedback.FieldReceiptID, field.TypeString, value)
+		_node.ReceiptID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(ocrfeedback.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.GetField(); ok {
+		_spec.SetField(ocrfeedback.FieldField, field.TypeString, value)
+		_node.Field = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(ocrfeedback.FieldValue, field.TypeString, value)
+		_node.Value = &value
+	}
+	if value, ok := _c.mutation.Box(); ok {
+		_spec.SetField(ocrfeedback.FieldBox, field.TypeJSON, value)
+		_node.Box = value
+	}
+	if value, ok := _c.mutation.PredictedValue(); ok {
+		_spec.SetField(ocrfeedback.FieldPredictedValue, field.TypeString, value)
+		_node.PredictedValue = &value
+	}
+	if value, ok := _c.mutation.PredictedBox(); ok {
+		_spec.SetField(ocrfeedback.FieldPredictedBox, field.TypeJSON, value)
+		_node.PredictedBox = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ocrfeedback.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OCRFeedback.Create().
+//		SetReceiptID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OCRFeedbackUpsert) {
+//			SetReceiptID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *OCRFeedbackCreate) OnConflict(opts ...sql.ConflictOption) *OCRFeedbackUpsertOne {
+	_c.conflict = opts
+	return &OCRFeedbackUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OCRFeedback.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *OCRFeedbackCreate) OnConflictColumns(columns ...string) *OCRFeedbackUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &OCRFeedbackUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// OCRFeedbackUpsertOne is the builder for "upsert"-ing
+	//  one OCRFeedback node.
+	OCRFeedbackUpsertOne struct {
+		create *OCRFeedbackCreate
+	}
+
+	// OCRFeedbackUpsert is the "OnConflict" setter.
+	OCRFeedbackUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.OCRFeedback.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ocrfeedback.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OCRFeedbackUpsertOne) UpdateNewValues() *OCRFeedbackUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(ocrfeedback.FieldID)
+		}
+		if _, exists := u.create.mutation.ReceiptID(); exists {
+			s.SetIgnore(ocrfeedback.FieldReceiptID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(ocrfeedback.FieldUserID)
+		}
+		if _, exists := u.create.mutation.GetField(); exists {
+			s.SetIgnore(ocrfeedback.FieldField)
+		}
+		if _, exists := u.create.mutation.Value(); exists {
+			s.SetIgnore(ocrfeedback.FieldValue)
+		}
+		if _, exists := u.create.mutation.Box(); exists {
+			s.SetIgnore(ocrfeedback.FieldBox)
+		}
+		if _, exists := u.create.mutation.PredictedValue(); exists {
+			s.SetIgnore(ocrfeedback.FieldPredictedValue)
+		}
+		if _, exists := u.create.mutation.PredictedBox(); exists {
+			s.SetIgnore(ocrfeedback.FieldPredictedBox)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(ocrfeedback.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OCRFeedback.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *OCRFeedbackUpsertOne) Ignore() *OCRFeedbackUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OCRFeedbackUpsertOne) DoNothing() *OCRFeedbackUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OCRFeedbackCreate.OnConflict
+// documentation for more info.
+func (u *OCRFeedbackUpsertOne) Update(set func(*OCRFeedbackUpsert)) *OCRFeedbackUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OCRFeedbackUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *OCRFeedbackUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OCRFeedbackCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OCRFeedbackUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *OCRFeedbackUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: OCRFeedbackUpsertOne.ID is not supported by MySQL driver. Use OCRFeedbackUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *OCRFeedbackUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// OCRFeedbackCreateBulk is the builder for creating many OCRFeedback entities in bulk.
+type OCRFeedbackCreateBulk struct {
+	config
+	err      error
+	builders []*OCRFeedbackCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the OCRFeedback entities in the database.
+func (_c *OCRFeedbackCreateBulk) Save(ctx context.Context) ([]*OCRFeedback, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OCRFeedback, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OCRFeedbackMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OCRFeedbackCreateBulk) SaveX(ctx context.Context) []*OCRFeedback {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OCRFeedbackCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OCRFeedbackCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OCRFeedback.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OCRFeedbackUpsert) {
+//			SetReceiptID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *OCRFeedbackCreateBulk) OnConflict(opts ...sql.ConflictOption) *OCRFeedbackUpsertBulk {
+	_c.conflict = opts
+	return &OCRFeedbackUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OCRFeedback.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *OCRFeedbackCreateBulk) OnConflictColumns(columns ...string) *OCRFeedbackUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &OCRFeedbackUpsertBulk{
+		create: _c,
+	}
+}
+
+// OCRFeedbackUpsertBulk is the builder for "upsert"-ing
+// a bulk of OCRFeedback nodes.
+type OCRFeedbackUpsertBulk struct {
+	create *OCRFeedbackCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.OCRFeedback.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ocrfeedback.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OCRFeedbackUpsertBulk) UpdateNewValues() *OCRFeedbackUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(ocrfeedback.FieldID)
+			}
+			if _, exists := b.mutation.ReceiptID(); exists {
+				s.SetIgnore(ocrfeedback.FieldReceiptID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(ocrfeedback.FieldUserID)
+			}
+			if _, exists := b.mutation.GetField(); exists {
+				s.SetIgnore(ocrfeedback.FieldField)
+			}
+			if _, exists := b.mutation.Value(); exists {
+				s.SetIgnore(ocrfeedback.FieldValue)
+			}
+			if _, exists := b.mutation.Box(); exists {
+				s.SetIgnore(ocrfeedback.FieldBox)
+			}
+			if _, exists := b.mutation.PredictedValue(); exists {
+				s.SetIgnore(ocrfeedback.FieldPredictedValue)
+			}
+			if _, exists := b.mutation.PredictedBox(); exists {
+				s.SetIgnore(ocrfeedback.FieldPredictedBox)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(ocrfeedback.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OCRFeedback.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *OCRFeedbackUpsertBulk) Ignore() *OCRFeedbackUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OCRFeedbackUpsertBulk) DoNothing() *OCRFeedbackUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OCRFeedbackCreateBulk.OnConflict
+// documentation for more info.
+func (u *OCRFeedbackUpsertBulk) Update(set func(*OCRFeedbackUpsert)) *OCRFeedbackUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OCRFeedbackUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *OCRFeedbackUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the OCRFeedbackCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OCRFeedbackCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OCRFeedbackUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/ocrfeedback"
+	"clockzen-next/internal/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OCRFeedbackUpdate is the builder for updating OCRFeedback entities.
+type OCRFeedbackUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OCRFeedbackMutation
+}
+
+// Where appends a list predicates to the OCRFeedbackUpdate builder.
+func (_u *OCRFeedbackUpdate) Where(ps ...predicate.OCRFeedback) *OCRFeedbackUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the OCRFeedbackMutation object of the builder.
+func (_u *OCRFeedbackUpdate) Mutation() *OCRFeedbackMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OCRFeedbackUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OCRFeedbackUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OCRFeedbackUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OCRFeedbackUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *OCRFeedbackUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ocrfeedback.Table, ocrfeedback.Columns, sqlgraph.NewFieldSpec(ocrfeedback.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ValueCleared() {
+		_spec.ClearField(ocrfeedback.FieldValue, field.TypeString)
+	}
+	if _u.mutation.PredictedValueCleared() {
+		_spec.ClearField(ocrfeedback.FieldPredictedValue, field.TypeString)
+	}
+	if _u.mutation.PredictedBoxCleared() {
+		_spec.ClearField(ocrfeedback.FieldPredictedBox, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ocrfeedback.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OCRFeedbackUpdateOne is the builder for updating a single OCRFeedback entity.
+type OCRFeedbackUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OCRFeedbackMutation
+}
+
+// Mutation returns the OCRFeedbackMutation object of the builder.
+func (_u *OCRFeedbackUpdateOne) Mutation() *OCRFeedbackMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OCRFeedbackUpdate builder.
+func (_u *OCRFeedbackUpdateOne) Where(ps ...predicate.OCRFeedback) *OCRFeedbackUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OCRFeedbackUpdateOne) Select(field string, fields ...string) *OCRFeedbackUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OCRFeedback entity.
+func (_u *OCRFeedbackUpdateOne) Save(ctx context.Context) (*OCRFeedback, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OCRFeedbackUpdateOne) SaveX(ctx context.Context) *OCRFeedback {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OCRFeedbackUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OCRFeedbackUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *OCRFeedbackUpdateOne) sqlSave(ctx context.Context) (_node *OCRFeedback, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ocrfeedback.Table, ocrfeedback.Columns, sqlgraph.NewFieldSpec(ocrfeedback.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OCRFeedback.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ocrfeedback.FieldID)
+		for _, f := range fields {
+			if !ocrfeedback.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ocrfeedback.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ValueCleared() {
+		_spec.ClearField(ocrfeedback.FieldValue, field.TypeString)
+	}
+	if _u.mutation.PredictedValueCleared() {
+		_spec.ClearField(ocrfeedback.FieldPredictedValue, field.TypeString)
+	}
+	if _u.mutation.PredictedBoxCleared() {
+		_spec.ClearField(ocrfeedback.FieldPredictedBox, field.TypeJSON)
+	}
+	_node = &OCRFeedback{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ocrfeedback.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -11,6 +11,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -20,6 +22,7 @@ type PipelineConfigCreate struct {
 	config
 	mutation *PipelineConfigMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUserID sets the "user_id" field.
@@ -430,6 +433,7 @@ func (_c *PipelineConfigCreate) createSpec() (*PipelineConfig, *sqlgraph.CreateS
 		_node = &PipelineConfig{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(pipelineconfig.Table, sqlgraph.NewFieldSpec(pipelineconfig.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -545,11 +549,761 @@ func (_c *PipelineConfigCreate) createSpec() (*PipelineConfig, *sqlgraph.CreateS
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PipelineConfig.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PipelineConfigUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PipelineConfigCreate) OnConflict(opts ...sql.ConflictOption) *PipelineConfigUpsertOne {
+	_c.conflict = opts
+	return &PipelineConfigUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PipelineConfig.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PipelineConfigCreate) OnConflictColumns(columns ...string) *PipelineConfigUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PipelineConfigUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PipelineConfigUpsertOne is the builder for "upsert"-ing
+	//  one PipelineConfig node.
+	PipelineConfigUpsertOne struct {
+		create *PipelineConfigCreate
+	}
+
+	// PipelineConfigUpsert is the "OnConflict" setter.
+	PipelineConfigUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *PipelineConfigUpsert) SetUserID(v string) *PipelineConfigUpsert {
+	u.Set(pipelineconfig.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PipelineConfigUpsert) UpdateUserID() *PipelineConfigUpsert {
+	u.SetExcluded(pipelineconfig.FieldUserID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PipelineConfigUpsert) SetName(v string) *PipelineConfigUpsert {
+	u.Set(pipelineconfig.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PipelineConfigUpsert) UpdateName() *PipelineConfigUpsert {
+	u.SetExcluded(pipelineconfig.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *PipelineConfigUpsert) SetDescription(v string) *PipelineConfigUpsert {
+	u.Set(pipelineconfig.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PipelineConfigUpsert) UpdateDescription() *PipelineConfigUpsert {
+	u.SetExcluded(pipelineconfig.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PipelineConfigUpsert) ClearDescription() *PipelineConfigUpsert {
+	u.SetNull(pipelineconfig.FieldDescription)
+	return u
+}
+
+// SetPipelineType sets the "pipeline_type" field.
+func (u *PipelineConfigUpsert) SetPipelineType(v pipelineconfig.PipelineType) *PipelineConfigUpsert {
+	u.Set(pipelineconfig.FieldPipelineType, v)
+	return u
+}
+
+// UpdatePipelineType sets the "pipeline_type" field to the value that was provided on create.
+func (u *PipelineConfigUpsert) UpdatePipelineType() *PipelineConfigUpsert {
+	u.SetExcluded(pipelineconfig.FieldPipelineType)
+	return u
+}
+
+// SetTriggerType sets the "trigger_type" field.
+func (u *PipelineConfigUpsert) SetTriggerType(v pipelineconfig.TriggerType) *PipelineConfigUpsert {
+	u.Set(pipelineconfig.FieldTriggerType, v)
+	return u
+}
+
+// UpdateTriggerType sets the "trigger_type" field to the value that was provided on create.
+func (u *PipelineConfigUpsert) UpdateTriggerType() *PipelineConfigUpsert {
+	u.SetExcluded(pipelineconfig.FieldTriggerType)
+	return u
+}
+
+// SetTriggerConfig sets the "trigger_config" field.
+func (u *PipelineConfigUpsert) SetTriggerConfig(v string) *PipelineConfigUpsert {
+	u.Set(pipelineconfig.FieldTriggerConfig, v)
+	return u
+}
+
+// UpdateTriggerConfig sets the "trigger_config" field to the value that was provided on create.
+func (u *PipelineConfigUpsert) UpdateTriggerConfig() *PipelineConfigUpsert {
+	u.SetExcluded(pipelineconfig.FieldTriggerConfig)
+	return u
+}
+
+// ClearTriggerConfig clears the value of the "trigger_config" field.
+func (u *PipelineConfigUpsert) ClearTriggerConfig() *PipelineConfigUpsert {
+	u.SetNull(pipelineconfig.FieldTriggerConfig)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *PipelineConfigUpsert) SetEnabled(v bool) *PipelineConfigUpsert {
+	u.Set(pipelineconfig.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *PipelineConfigUpsert) UpdateEnabled() *PipelineConfigUpsert {
+	u.SetExcluded(pipelineconfig.FieldEnabled)
+	return u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (u *PipelineConfigUpsert) SetIsDefault(v bool) *PipelineConfigUpsert {
+	u.Set(pipelineconfig.FieldIsDefault, v)
+	return u
+}
+
+// UpdateIsDefault sets the "is_default" field to the value that was provided on create.
+func (u *PipelineConfigUpsert) UpdateIsDefault() *PipelineConfigUpsert {
+	u.SetExcluded(pipelineconfig.FieldIsDefault)
+	return u
+}
+
+// SetCurrentVersion sets the "current_version" field.
+func (u *PipelineConfigUpsert) SetCurrentVersion(v int) *PipelineConfigUpsert {
+	u.Set(pipelineconfig.FieldCurrentVersion, v)
+	return u
+}
+
+// UpdateCurrentVersion sets the "current_version" field to the value that was provided on create.
+func (u *PipelineConfigUpsert) UpdateCurrentVersion() *PipelineConfigUpsert {
+	u.SetExcluded(pipelineconfig.FieldCurrentVersion)
+	return u
+}
+
+// AddCurrentVersion adds v to the "current_version" field.
+func (u *PipelineConfigUpsert) AddCurrentVersion(v int) *PipelineConfigUpsert {
+	u.Add(pipelineconfig.FieldCurrentVersion, v)
+	return u
+}
+
+// SetSettings sets the "settings" field.
+func (u *PipelineConfigUpsert) SetSettings(v map[string]interface{}) *PipelineConfigUpsert {
+	u.Set(pipelineconfig.FieldSettings, v)
+	return u
+}
+
+// UpdateSettings sets the "settings" field to the value that was provided on create.
+func (u *PipelineConfigUpsert) UpdateSettings() *PipelineConfigUpsert {
+	u.SetExcluded(pipelineconfig.FieldSettings)
+	return u
+}
+
+// ClearSettings clears the value of the "settings" field.
+func (u *PipelineConfigUpsert) ClearSettings() *PipelineConfigUpsert {
+	u.SetNull(pipelineconfig.FieldSettings)
+	return u
+}
+
+// SetInputSchema sets the "input_schema" field.
+func (u *PipelineConfigUpsert) SetInputSchema(v map[string]interface{}) *PipelineConfigUpsert {
+	u.Set(pipelineconfig.FieldInputSchema, v)
+	return u
+}
+
+// UpdateInputSchema sets the "input_schema" field to the value that was provided on create.
+func (u *PipelineConfigUpsert) UpdateInputSchema() *PipelineConfigUpsert {
+	u.SetExcluded(pipelineconfig.FieldInputSchema)
+	return u
+}
+
+// ClearInputSchema clears the value of the "input_schema" field.
+func (u *PipelineConfigUpsert) ClearInputSchema() *PipelineConfigUpsert {
+	u.SetNull(pipelineconfig.FieldInputSchema)
+	return u
+}
+
+// SetOutputSchema sets the "output_schema" field.
+func (u *PipelineConfigUpsert) SetOutputSchema(v map[string]interface{}) *PipelineConfigUpsert {
+	u.Set(pipelineconfig.FieldOutputSchema, v)
+	return u
+}
+
+// UpdateOutputSchema sets the "output_schema" field to the value that was provided on create.
+func (u *PipelineConfigUpsert) UpdateOutputSchema() *PipelineConfigUpsert {
+	u.SetExcluded(pipelineconfig.FieldOutputSchema)
+	return u
+}
+
+// ClearOutputSchema clears the value of the "output_schema" field.
+func (u *PipelineConfigUpsert) ClearOutputSchema() *PipelineConfigUpsert {
+	u.SetNull(pipelineconfig.FieldOutputSchema)
+	return u
+}
+
+// SetTags sets the "tags" field.
+func (u *PipelineConfigUpsert) SetTags(v []string) *PipelineConfigUpsert {
+	u.Set(pipelineconfig.FieldTags, v)
+	return u
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *PipelineConfigUpsert) UpdateTags() *PipelineConfigUpsert {
+	u.SetExcluded(pipelineconfig.FieldTags)
+	return u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *PipelineConfigUpsert) ClearTags() *PipelineConfigUpsert {
+	u.SetNull(pipelineconfig.FieldTags)
+	return u
+}
+
+// SetExecutionCount sets the "execution_count" field.
+func (u *PipelineConfigUpsert) SetExecutionCount(v int) *PipelineConfigUpsert {
+	u.Set(pipelineconfig.FieldExecutionCount, v)
+	return u
+}
+
+// UpdateExecutionCount sets the "execution_count" field to the value that was provided on create.
+func (u *PipelineConfigUpsert) UpdateExecutionCount() *PipelineConfigUpsert {
+	u.SetExcluded(pipelineconfig.FieldExecutionCount)
+	return u
+}
+
+// AddExecutionCount adds v to the "execution_count" field.
+func (u *PipelineConfigUpsert) AddExecutionCount(v int) *PipelineConfigUpsert {
+	u.Add(pipelineconfig.FieldExecutionCount, v)
+	return u
+}
+
+// SetSuccessCount sets the "success_count" field.
+func (u *PipelineConfigUpsert) SetSuccessCount(v int) *PipelineConfigUpsert {
+	u.Set(pipelineconfig.FieldSuccessCount, v)
+	return u
+}
+
+// UpdateSuccessCount sets the "success_count" field to the value that was provided on create.
+func (u *PipelineConfigUpsert) UpdateSuccessCount() *PipelineConfigUpsert {
+	u.SetExcluded(pipelineconfig.FieldSuccessCount)
+	return u
+}
+
+// AddSuccessCount adds v to the "success_count" field.
+func (u *PipelineConfigUpsert) AddSuccessCount(v int) *PipelineConfigUpsert {
+	u.Add(pipelineconfig.FieldSuccessCount, v)
+	return u
+}
+
+// SetFailureCount sets the "failure_count" field.
+func (u *PipelineConfigUpsert) SetFailureCount(v int) *PipelineConfigUpsert {
+	u.Set(pipelineconfig.FieldFailureCount, v)
+	return u
+}
+
+// UpdateFailureCount sets the "failure_count" field to the value that was provided on create.
+func (u *PipelineConfigUpsert) UpdateFailureCount() *PipelineConfigUpsert {
+	u.SetExcluded(pipelineconfig.FieldFailureCount)
+	return u
+}
+
+// AddFailureCount adds v to the "failure_count" field.
+func (u *PipelineConfigUpsert) AddFailureCount(v int) *PipelineConfigUpsert {
+	u.Add(pipelineconfig.FieldFailureCount, v)
+	return u
+}
+
+// SetLastExecutedAt sets the "last_executed_at" field.
+func (u *PipelineConfigUpsert) SetLastExecutedAt(v time.Time) *PipelineConfigUpsert {
+	u.Set(pipelineconfig.FieldLastExecutedAt, v)
+	return u
+}
+
+// UpdateLastExecutedAt sets the "last_executed_at" field to the value that was provided on create.
+func (u *PipelineConfigUpsert) UpdateLastExecutedAt() *PipelineConfigUpsert {
+	u.SetExcluded(pipelineconfig.FieldLastExecutedAt)
+	return u
+}
+
+// ClearLastExecutedAt clears the value of the "last_executed_at" field.
+func (u *PipelineConfigUpsert) ClearLastExecutedAt() *PipelineConfigUpsert {
+	u.SetNull(pipelineconfig.FieldLastExecutedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PipelineConfigUpsert) SetUpdatedAt(v time.Time) *PipelineConfigUpsert {
+	u.Set(pipelineconfig.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PipelineConfigUpsert) UpdateUpdatedAt() *PipelineConfigUpsert {
+	u.SetExcluded(pipelineconfig.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PipelineConfig.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pipelineconfig.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PipelineConfigUpsertOne) UpdateNewValues() *PipelineConfigUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(pipelineconfig.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(pipelineconfig.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PipelineConfig.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PipelineConfigUpsertOne) Ignore() *PipelineConfigUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PipelineConfigUpsertOne) DoNothing() *PipelineConfigUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PipelineConfigCreate.OnConflict
+// documentation for more info.
+func (u *PipelineConfigUpsertOne) Update(set func(*PipelineConfigUpsert)) *PipelineConfigUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PipelineConfigUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PipelineConfigUpsertOne) SetUserID(v string) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PipelineConfigUpsertOne) UpdateUserID() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PipelineConfigUpsertOne) SetName(v string) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PipelineConfigUpsertOne) UpdateName() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *PipelineConfigUpsertOne) SetDescription(v string) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PipelineConfigUpsertOne) UpdateDescription() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PipelineConfigUpsertOne) ClearDescription() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetPipelineType sets the "pipeline_type" field.
+func (u *PipelineConfigUpsertOne) SetPipelineType(v pipelineconfig.PipelineType) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetPipelineType(v)
+	})
+}
+
+// UpdatePipelineType sets the "pipeline_type" field to the value that was provided on create.
+func (u *PipelineConfigUpsertOne) UpdatePipelineType() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdatePipelineType()
+	})
+}
+
+// SetTriggerType sets the "trigger_type" field.
+func (u *PipelineConfigUpsertOne) SetTriggerType(v pipelineconfig.TriggerType) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetTriggerType(v)
+	})
+}
+
+// UpdateTriggerType sets the "trigger_type" field to the value that was provided on create.
+func (u *PipelineConfigUpsertOne) UpdateTriggerType() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateTriggerType()
+	})
+}
+
+// SetTriggerConfig sets the "trigger_config" field.
+func (u *PipelineConfigUpsertOne) SetTriggerConfig(v string) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetTriggerConfig(v)
+	})
+}
+
+// UpdateTriggerConfig sets the "trigger_config" field to the value that was provided on create.
+func (u *PipelineConfigUpsertOne) UpdateTriggerConfig() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateTriggerConfig()
+	})
+}
+
+// ClearTriggerConfig clears the value of the "trigger_config" field.
+func (u *PipelineConfigUpsertOne) ClearTriggerConfig() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.ClearTriggerConfig()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *PipelineConfigUpsertOne) SetEnabled(v bool) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *PipelineConfigUpsertOne) UpdateEnabled() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetIsDefault sets the "is_default" field.
+func (u *PipelineConfigUpsertOne) SetIsDefault(v bool) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetIsDefault(v)
+	})
+}
+
+// UpdateIsDefault sets the "is_default" field to the value that was provided on create.
+func (u *PipelineConfigUpsertOne) UpdateIsDefault() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateIsDefault()
+	})
+}
+
+// SetCurrentVersion sets the "current_version" field.
+func (u *PipelineConfigUpsertOne) SetCurrentVersion(v int) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetCurrentVersion(v)
+	})
+}
+
+// AddCurrentVersion adds v to the "current_version" field.
+func (u *PipelineConfigUpsertOne) AddCurrentVersion(v int) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.AddCurrentVersion(v)
+	})
+}
+
+// UpdateCurrentVersion sets the "current_version" field to the value that was provided on create.
+func (u *PipelineConfigUpsertOne) UpdateCurrentVersion() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateCurrentVersion()
+	})
+}
+
+// SetSettings sets the "settings" field.
+func (u *PipelineConfigUpsertOne) SetSettings(v map[string]interface{}) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetSettings(v)
+	})
+}
+
+// UpdateSettings sets the "settings" field to the value that was provided on create.
+func (u *PipelineConfigUpsertOne) UpdateSettings() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateSettings()
+	})
+}
+
+// ClearSettings clears the value of the "settings" field.
+func (u *PipelineConfigUpsertOne) ClearSettings() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.ClearSettings()
+	})
+}
+
+// SetInputSchema sets the "input_schema" field.
+func (u *PipelineConfigUpsertOne) SetInputSchema(v map[string]interface{}) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetInputSchema(v)
+	})
+}
+
+// UpdateInputSchema sets the "input_schema" field to the value that was provided on create.
+func (u *PipelineConfigUpsertOne) UpdateInputSchema() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateInputSchema()
+	})
+}
+
+// ClearInputSchema clears the value of the "input_schema" field.
+func (u *PipelineConfigUpsertOne) ClearInputSchema() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.ClearInputSchema()
+	})
+}
+
+// SetOutputSchema sets the "output_schema" field.
+func (u *PipelineConfigUpsertOne) SetOutputSchema(v map[string]interface{}) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetOutputSchema(v)
+	})
+}
+
+// UpdateOutputSchema sets the "output_schema" field to the value that was provided on create.
+func (u *PipelineConfigUpsertOne) UpdateOutputSchema() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateOutputSchema()
+	})
+}
+
+// ClearOutputSchema clears the value of the "output_schema" field.
+func (u *PipelineConfigUpsertOne) ClearOutputSchema() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.ClearOutputSchema()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *PipelineConfigUpsertOne) SetTags(v []string) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *PipelineConfigUpsertOne) UpdateTags() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *PipelineConfigUpsertOne) ClearTags() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.ClearTags()
+	})
+}
+
+// SetExecutionCount sets the "execution_count" field.
+func (u *PipelineConfigUpsertOne) SetExecutionCount(v int) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetExecutionCount(v)
+	})
+}
+
+// AddExecutionCount adds v to the "execution_count" field.
+func (u *PipelineConfigUpsertOne) AddExecutionCount(v int) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.AddExecutionCount(v)
+	})
+}
+
+// UpdateExecutionCount sets the "execution_count" field to the value that was provided on create.
+func (u *PipelineConfigUpsertOne) UpdateExecutionCount() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateExecutionCount()
+	})
+}
+
+// SetSuccessCount sets the "success_count" field.
+func (u *PipelineConfigUpsertOne) SetSuccessCount(v int) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetSuccessCount(v)
+	})
+}
+
+// AddSuccessCount adds v to the "success_count" field.
+func (u *PipelineConfigUpsertOne) AddSuccessCount(v int) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.AddSuccessCount(v)
+	})
+}
+
+// UpdateSuccessCount sets the "success_count" field to the value that was provided on create.
+func (u *PipelineConfigUpsertOne) UpdateSuccessCount() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateSuccessCount()
+	})
+}
+
+// SetFailureCount sets the "failure_count" field.
+func (u *PipelineConfigUpsertOne) SetFailureCount(v int) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetFailureCount(v)
+	})
+}
+
+// AddFailureCount adds v to the "failure_count" field.
+func (u *PipelineConfigUpsertOne) AddFailureCount(v int) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.AddFailureCount(v)
+	})
+}
+
+// UpdateFailureCount sets the "failure_count" field to the value that was provided on create.
+func (u *PipelineConfigUpsertOne) UpdateFailureCount() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateFailureCount()
+	})
+}
+
+// SetLastExecutedAt sets the "last_executed_at" field.
+func (u *PipelineConfigUpsertOne) SetLastExecutedAt(v time.Time) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetLastExecutedAt(v)
+	})
+}
+
+// UpdateLastExecutedAt sets the "last_executed_at" field to the value that was provided on create.
+func (u *PipelineConfigUpsertOne) UpdateLastExecutedAt() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateLastExecutedAt()
+	})
+}
+
+// ClearLastExecutedAt clears the value of the "last_executed_at" field.
+func (u *PipelineConfigUpsertOne) ClearLastExecutedAt() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.ClearLastExecutedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PipelineConfigUpsertOne) SetUpdatedAt(v time.Time) *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PipelineConfigUpsertOne) UpdateUpdatedAt() *PipelineConfigUpsertOne {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PipelineConfigUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PipelineConfigCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PipelineConfigUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PipelineConfigUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PipelineConfigUpsertOne.ID is not supported by MySQL driver. Use PipelineConfigUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PipelineConfigUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // PipelineConfigCreateBulk is the builder for creating many PipelineConfig entities in bulk.
 type PipelineConfigCreateBulk struct {
 	config
 	err      error
 	builders []*PipelineConfigCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the PipelineConfig entities in the database.
@@ -579,6 +1333,7 @@ func (_c *PipelineConfigCreateBulk) Save(ctx context.Context) ([]*PipelineConfig
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -628,3 +1383,449 @@ func (_c *PipelineConfigCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PipelineConfig.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PipelineConfigUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PipelineConfigCreateBulk) OnConflict(opts ...sql.ConflictOption) *PipelineConfigUpsertBulk {
+	_c.conflict = opts
+	return &PipelineConfigUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PipelineConfig.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PipelineConfigCreateBulk) OnConflictColumns(columns ...string) *PipelineConfigUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PipelineConfigUpsertBulk{
+		create: _c,
+	}
+}
+
+// PipelineConfigUpsertBulk is the builder for "upsert"-ing
+// a bulk of PipelineConfig nodes.
+type PipelineConfigUpsertBulk struct {
+	create *PipelineConfigCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PipelineConfig.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pipelineconfig.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PipelineConfigUpsertBulk) UpdateNewValues() *PipelineConfigUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(pipelineconfig.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(pipelineconfig.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PipelineConfig.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PipelineConfigUpsertBulk) Ignore() *PipelineConfigUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PipelineConfigUpsertBulk) DoNothing() *PipelineConfigUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PipelineConfigCreateBulk.OnConflict
+// documentation for more info.
+func (u *PipelineConfigUpsertBulk) Update(set func(*PipelineConfigUpsert)) *PipelineConfigUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PipelineConfigUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PipelineConfigUpsertBulk) SetUserID(v string) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PipelineConfigUpsertBulk) UpdateUserID() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PipelineConfigUpsertBulk) SetName(v string) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PipelineConfigUpsertBulk) UpdateName() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *PipelineConfigUpsertBulk) SetDescription(v string) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PipelineConfigUpsertBulk) UpdateDescription() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PipelineConfigUpsertBulk) ClearDescription() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetPipelineType sets the "pipeline_type" field.
+func (u *PipelineConfigUpsertBulk) SetPipelineType(v pipelineconfig.PipelineType) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetPipelineType(v)
+	})
+}
+
+// UpdatePipelineType sets the "pipeline_type" field to the value that was provided on create.
+func (u *PipelineConfigUpsertBulk) UpdatePipelineType() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdatePipelineType()
+	})
+}
+
+// SetTriggerType sets the "trigger_type" field.
+func (u *PipelineConfigUpsertBulk) SetTriggerType(v pipelineconfig.TriggerType) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetTriggerType(v)
+	})
+}
+
+// UpdateTriggerType sets the "trigger_type" field to the value that was provided on create.
+func (u *PipelineConfigUpsertBulk) UpdateTriggerType() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateTriggerType()
+	})
+}
+
+// SetTriggerConfig sets the "trigger_config" field.
+func (u *PipelineConfigUpsertBulk) SetTriggerConfig(v string) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetTriggerConfig(v)
+	})
+}
+
+// UpdateTriggerConfig sets the "trigger_config" field to the value that was provided on create.
+func (u *PipelineConfigUpsertBulk) UpdateTriggerConfig() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateTriggerConfig()
+	})
+}
+
+// ClearTriggerConfig clears the value of the "trigger_config" field.
+func (u *PipelineConfigUpsertBulk) ClearTriggerConfig() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.ClearTriggerConfig()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *PipelineConfigUpsertBulk) SetEnabled(v bool) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *PipelineConfigUpsertBulk) UpdateEnabled() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetIsDefault sets the "is_default" field.
+func (u *PipelineConfigUpsertBulk) SetIsDefault(v bool) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetIsDefault(v)
+	})
+}
+
+// UpdateIsDefault sets the "is_default" field to the value that was provided on create.
+func (u *PipelineConfigUpsertBulk) UpdateIsDefault() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateIsDefault()
+	})
+}
+
+// SetCurrentVersion sets the "current_version" field.
+func (u *PipelineConfigUpsertBulk) SetCurrentVersion(v int) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetCurrentVersion(v)
+	})
+}
+
+// AddCurrentVersion adds v to the "current_version" field.
+func (u *PipelineConfigUpsertBulk) AddCurrentVersion(v int) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.AddCurrentVersion(v)
+	})
+}
+
+// UpdateCurrentVersion sets the "current_version" field to the value that was provided on create.
+func (u *PipelineConfigUpsertBulk) UpdateCurrentVersion() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateCurrentVersion()
+	})
+}
+
+// SetSettings sets the "settings" field.
+func (u *PipelineConfigUpsertBulk) SetSettings(v map[string]interface{}) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetSettings(v)
+	})
+}
+
+// UpdateSettings sets the "settings" field to the value that was provided on create.
+func (u *PipelineConfigUpsertBulk) UpdateSettings() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateSettings()
+	})
+}
+
+// ClearSettings clears the value of the "settings" field.
+func (u *PipelineConfigUpsertBulk) ClearSettings() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.ClearSettings()
+	})
+}
+
+// SetInputSchema sets the "input_schema" field.
+func (u *PipelineConfigUpsertBulk) SetInputSchema(v map[string]interface{}) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetInputSchema(v)
+	})
+}
+
+// UpdateInputSchema sets the "input_schema" field to the value that was provided on create.
+func (u *PipelineConfigUpsertBulk) UpdateInputSchema() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateInputSchema()
+	})
+}
+
+// ClearInputSchema clears the value of the "input_schema" field.
+func (u *PipelineConfigUpsertBulk) ClearInputSchema() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.ClearInputSchema()
+	})
+}
+
+// SetOutputSchema sets the "output_schema" field.
+func (u *PipelineConfigUpsertBulk) SetOutputSchema(v map[string]interface{}) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetOutputSchema(v)
+	})
+}
+
+// UpdateOutputSchema sets the "output_schema" field to the value that was provided on create.
+func (u *PipelineConfigUpsertBulk) UpdateOutputSchema() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateOutputSchema()
+	})
+}
+
+// ClearOutputSchema clears the value of the "output_schema" field.
+func (u *PipelineConfigUpsertBulk) ClearOutputSchema() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.ClearOutputSchema()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *PipelineConfigUpsertBulk) SetTags(v []string) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *PipelineConfigUpsertBulk) UpdateTags() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *PipelineConfigUpsertBulk) ClearTags() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.ClearTags()
+	})
+}
+
+// SetExecutionCount sets the "execution_count" field.
+func (u *PipelineConfigUpsertBulk) SetExecutionCount(v int) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetExecutionCount(v)
+	})
+}
+
+// AddExecutionCount adds v to the "execution_count" field.
+func (u *PipelineConfigUpsertBulk) AddExecutionCount(v int) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.AddExecutionCount(v)
+	})
+}
+
+// UpdateExecutionCount sets the "execution_count" field to the value that was provided on create.
+func (u *PipelineConfigUpsertBulk) UpdateExecutionCount() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateExecutionCount()
+	})
+}
+
+// SetSuccessCount sets the "success_count" field.
+func (u *PipelineConfigUpsertBulk) SetSuccessCount(v int) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetSuccessCount(v)
+	})
+}
+
+// AddSuccessCount adds v to the "success_count" field.
+func (u *PipelineConfigUpsertBulk) AddSuccessCount(v int) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.AddSuccessCount(v)
+	})
+}
+
+// UpdateSuccessCount sets the "success_count" field to the value that was provided on create.
+func (u *PipelineConfigUpsertBulk) UpdateSuccessCount() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateSuccessCount()
+	})
+}
+
+// SetFailureCount sets the "failure_count" field.
+func (u *PipelineConfigUpsertBulk) SetFailureCount(v int) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetFailureCount(v)
+	})
+}
+
+// AddFailureCount adds v to the "failure_count" field.
+func (u *PipelineConfigUpsertBulk) AddFailureCount(v int) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.AddFailureCount(v)
+	})
+}
+
+// UpdateFailureCount sets the "failure_count" field to the value that was provided on create.
+func (u *PipelineConfigUpsertBulk) UpdateFailureCount() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateFailureCount()
+	})
+}
+
+// SetLastExecutedAt sets the "last_executed_at" field.
+func (u *PipelineConfigUpsertBulk) SetLastExecutedAt(v time.Time) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetLastExecutedAt(v)
+	})
+}
+
+// UpdateLastExecutedAt sets the "last_executed_at" field to the value that was provided on create.
+func (u *PipelineConfigUpsertBulk) UpdateLastExecutedAt() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateLastExecutedAt()
+	})
+}
+
+// ClearLastExecutedAt clears the value of the "last_executed_at" field.
+func (u *PipelineConfigUpsertBulk) ClearLastExecutedAt() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.ClearLastExecutedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PipelineConfigUpsertBulk) SetUpdatedAt(v time.Time) *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PipelineConfigUpsertBulk) UpdateUpdatedAt() *PipelineConfigUpsertBulk {
+	return u.Update(func(s *PipelineConfigUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PipelineConfigUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PipelineConfigCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PipelineConfigCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PipelineConfigUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
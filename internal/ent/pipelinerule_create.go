@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -19,6 +21,7 @@ type PipelineRuleCreate struct {
 	config
 	mutation *PipelineRuleMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUserID sets the "user_id" field.
@@ -365,6 +368,7 @@ func (_c *PipelineRuleCreate) createSpec() (*PipelineRule, *sqlgraph.CreateSpec)
 		_node = &PipelineRule{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(pipelinerule.Table, sqlgraph.NewFieldSpec(pipelinerule.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -453,11 +457,670 @@ func (_c *PipelineRuleCreate) createSpec() (*PipelineRule, *sqlgraph.CreateSpec)
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PipelineRule.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PipelineRuleUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PipelineRuleCreate) OnConflict(opts ...sql.ConflictOption) *PipelineRuleUpsertOne {
+	_c.conflict = opts
+	return &PipelineRuleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PipelineRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PipelineRuleCreate) OnConflictColumns(columns ...string) *PipelineRuleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PipelineRuleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PipelineRuleUpsertOne is the builder for "upsert"-ing
+	//  one PipelineRule node.
+	PipelineRuleUpsertOne struct {
+		create *PipelineRuleCreate
+	}
+
+	// PipelineRuleUpsert is the "OnConflict" setter.
+	PipelineRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *PipelineRuleUpsert) SetUserID(v string) *PipelineRuleUpsert {
+	u.Set(pipelinerule.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PipelineRuleUpsert) UpdateUserID() *PipelineRuleUpsert {
+	u.SetExcluded(pipelinerule.FieldUserID)
+	return u
+}
+
+// SetConfigID sets the "config_id" field.
+func (u *PipelineRuleUpsert) SetConfigID(v string) *PipelineRuleUpsert {
+	u.Set(pipelinerule.FieldConfigID, v)
+	return u
+}
+
+// UpdateConfigID sets the "config_id" field to the value that was provided on create.
+func (u *PipelineRuleUpsert) UpdateConfigID() *PipelineRuleUpsert {
+	u.SetExcluded(pipelinerule.FieldConfigID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PipelineRuleUpsert) SetName(v string) *PipelineRuleUpsert {
+	u.Set(pipelinerule.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PipelineRuleUpsert) UpdateName() *PipelineRuleUpsert {
+	u.SetExcluded(pipelinerule.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *PipelineRuleUpsert) SetDescription(v string) *PipelineRuleUpsert {
+	u.Set(pipelinerule.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PipelineRuleUpsert) UpdateDescription() *PipelineRuleUpsert {
+	u.SetExcluded(pipelinerule.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PipelineRuleUpsert) ClearDescription() *PipelineRuleUpsert {
+	u.SetNull(pipelinerule.FieldDescription)
+	return u
+}
+
+// SetRuleType sets the "rule_type" field.
+func (u *PipelineRuleUpsert) SetRuleType(v pipelinerule.RuleType) *PipelineRuleUpsert {
+	u.Set(pipelinerule.FieldRuleType, v)
+	return u
+}
+
+// UpdateRuleType sets the "rule_type" field to the value that was provided on create.
+func (u *PipelineRuleUpsert) UpdateRuleType() *PipelineRuleUpsert {
+	u.SetExcluded(pipelinerule.FieldRuleType)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *PipelineRuleUpsert) SetPriority(v int) *PipelineRuleUpsert {
+	u.Set(pipelinerule.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *PipelineRuleUpsert) UpdatePriority() *PipelineRuleUpsert {
+	u.SetExcluded(pipelinerule.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *PipelineRuleUpsert) AddPriority(v int) *PipelineRuleUpsert {
+	u.Add(pipelinerule.FieldPriority, v)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *PipelineRuleUpsert) SetEnabled(v bool) *PipelineRuleUpsert {
+	u.Set(pipelinerule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *PipelineRuleUpsert) UpdateEnabled() *PipelineRuleUpsert {
+	u.SetExcluded(pipelinerule.FieldEnabled)
+	return u
+}
+
+// SetConditions sets the "conditions" field.
+func (u *PipelineRuleUpsert) SetConditions(v map[string]interface{}) *PipelineRuleUpsert {
+	u.Set(pipelinerule.FieldConditions, v)
+	return u
+}
+
+// UpdateConditions sets the "conditions" field to the value that was provided on create.
+func (u *PipelineRuleUpsert) UpdateConditions() *PipelineRuleUpsert {
+	u.SetExcluded(pipelinerule.FieldConditions)
+	return u
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (u *PipelineRuleUpsert) ClearConditions() *PipelineRuleUpsert {
+	u.SetNull(pipelinerule.FieldConditions)
+	return u
+}
+
+// SetActions sets the "actions" field.
+func (u *PipelineRuleUpsert) SetActions(v map[string]interface{}) *PipelineRuleUpsert {
+	u.Set(pipelinerule.FieldActions, v)
+	return u
+}
+
+// UpdateActions sets the "actions" field to the value that was provided on create.
+func (u *PipelineRuleUpsert) UpdateActions() *PipelineRuleUpsert {
+	u.SetExcluded(pipelinerule.FieldActions)
+	return u
+}
+
+// ClearActions clears the value of the "actions" field.
+func (u *PipelineRuleUpsert) ClearActions() *PipelineRuleUpsert {
+	u.SetNull(pipelinerule.FieldActions)
+	return u
+}
+
+// SetParameters sets the "parameters" field.
+func (u *PipelineRuleUpsert) SetParameters(v map[string]interface{}) *PipelineRuleUpsert {
+	u.Set(pipelinerule.FieldParameters, v)
+	return u
+}
+
+// UpdateParameters sets the "parameters" field to the value that was provided on create.
+func (u *PipelineRuleUpsert) UpdateParameters() *PipelineRuleUpsert {
+	u.SetExcluded(pipelinerule.FieldParameters)
+	return u
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (u *PipelineRuleUpsert) ClearParameters() *PipelineRuleUpsert {
+	u.SetNull(pipelinerule.FieldParameters)
+	return u
+}
+
+// SetTargetFields sets the "target_fields" field.
+func (u *PipelineRuleUpsert) SetTargetFields(v []string) *PipelineRuleUpsert {
+	u.Set(pipelinerule.FieldTargetFields, v)
+	return u
+}
+
+// UpdateTargetFields sets the "target_fields" field to the value that was provided on create.
+func (u *PipelineRuleUpsert) UpdateTargetFields() *PipelineRuleUpsert {
+	u.SetExcluded(pipelinerule.FieldTargetFields)
+	return u
+}
+
+// ClearTargetFields clears the value of the "target_fields" field.
+func (u *PipelineRuleUpsert) ClearTargetFields() *PipelineRuleUpsert {
+	u.SetNull(pipelinerule.FieldTargetFields)
+	return u
+}
+
+// SetMatchMode sets the "match_mode" field.
+func (u *PipelineRuleUpsert) SetMatchMode(v pipelinerule.MatchMode) *PipelineRuleUpsert {
+	u.Set(pipelinerule.FieldMatchMode, v)
+	return u
+}
+
+// UpdateMatchMode sets the "match_mode" field to the value that was provided on create.
+func (u *PipelineRuleUpsert) UpdateMatchMode() *PipelineRuleUpsert {
+	u.SetExcluded(pipelinerule.FieldMatchMode)
+	return u
+}
+
+// SetStopOnMatch sets the "stop_on_match" field.
+func (u *PipelineRuleUpsert) SetStopOnMatch(v bool) *PipelineRuleUpsert {
+	u.Set(pipelinerule.FieldStopOnMatch, v)
+	return u
+}
+
+// UpdateStopOnMatch sets the "stop_on_match" field to the value that was provided on create.
+func (u *PipelineRuleUpsert) UpdateStopOnMatch() *PipelineRuleUpsert {
+	u.SetExcluded(pipelinerule.FieldStopOnMatch)
+	return u
+}
+
+// SetExecutionCount sets the "execution_count" field.
+func (u *PipelineRuleUpsert) SetExecutionCount(v int) *PipelineRuleUpsert {
+	u.Set(pipelinerule.FieldExecutionCount, v)
+	return u
+}
+
+// UpdateExecutionCount sets the "execution_count" field to the value that was provided on create.
+func (u *PipelineRuleUpsert) UpdateExecutionCount() *PipelineRuleUpsert {
+	u.SetExcluded(pipelinerule.FieldExecutionCount)
+	return u
+}
+
+// AddExecutionCount adds v to the "execution_count" field.
+func (u *PipelineRuleUpsert) AddExecutionCount(v int) *PipelineRuleUpsert {
+	u.Add(pipelinerule.FieldExecutionCount, v)
+	return u
+}
+
+// SetLastExecutedAt sets the "last_executed_at" field.
+func (u *PipelineRuleUpsert) SetLastExecutedAt(v time.Time) *PipelineRuleUpsert {
+	u.Set(pipelinerule.FieldLastExecutedAt, v)
+	return u
+}
+
+// UpdateLastExecutedAt sets the "last_executed_at" field to the value that was provided on create.
+func (u *PipelineRuleUpsert) UpdateLastExecutedAt() *PipelineRuleUpsert {
+	u.SetExcluded(pipelinerule.FieldLastExecutedAt)
+	return u
+}
+
+// ClearLastExecutedAt clears the value of the "last_executed_at" field.
+func (u *PipelineRuleUpsert) ClearLastExecutedAt() *PipelineRuleUpsert {
+	u.SetNull(pipelinerule.FieldLastExecutedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PipelineRuleUpsert) SetUpdatedAt(v time.Time) *PipelineRuleUpsert {
+	u.Set(pipelinerule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PipelineRuleUpsert) UpdateUpdatedAt() *PipelineRuleUpsert {
+	u.SetExcluded(pipelinerule.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PipelineRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pipelinerule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PipelineRuleUpsertOne) UpdateNewValues() *PipelineRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(pipelinerule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(pipelinerule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PipelineRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PipelineRuleUpsertOne) Ignore() *PipelineRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PipelineRuleUpsertOne) DoNothing() *PipelineRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PipelineRuleCreate.OnConflict
+// documentation for more info.
+func (u *PipelineRuleUpsertOne) Update(set func(*PipelineRuleUpsert)) *PipelineRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PipelineRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PipelineRuleUpsertOne) SetUserID(v string) *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PipelineRuleUpsertOne) UpdateUserID() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetConfigID sets the "config_id" field.
+func (u *PipelineRuleUpsertOne) SetConfigID(v string) *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetConfigID(v)
+	})
+}
+
+// UpdateConfigID sets the "config_id" field to the value that was provided on create.
+func (u *PipelineRuleUpsertOne) UpdateConfigID() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateConfigID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PipelineRuleUpsertOne) SetName(v string) *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PipelineRuleUpsertOne) UpdateName() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *PipelineRuleUpsertOne) SetDescription(v string) *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PipelineRuleUpsertOne) UpdateDescription() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PipelineRuleUpsertOne) ClearDescription() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetRuleType sets the "rule_type" field.
+func (u *PipelineRuleUpsertOne) SetRuleType(v pipelinerule.RuleType) *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetRuleType(v)
+	})
+}
+
+// UpdateRuleType sets the "rule_type" field to the value that was provided on create.
+func (u *PipelineRuleUpsertOne) UpdateRuleType() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateRuleType()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *PipelineRuleUpsertOne) SetPriority(v int) *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *PipelineRuleUpsertOne) AddPriority(v int) *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *PipelineRuleUpsertOne) UpdatePriority() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *PipelineRuleUpsertOne) SetEnabled(v bool) *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *PipelineRuleUpsertOne) UpdateEnabled() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetConditions sets the "conditions" field.
+func (u *PipelineRuleUpsertOne) SetConditions(v map[string]interface{}) *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetConditions(v)
+	})
+}
+
+// UpdateConditions sets the "conditions" field to the value that was provided on create.
+func (u *PipelineRuleUpsertOne) UpdateConditions() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateConditions()
+	})
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (u *PipelineRuleUpsertOne) ClearConditions() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.ClearConditions()
+	})
+}
+
+// SetActions sets the "actions" field.
+func (u *PipelineRuleUpsertOne) SetActions(v map[string]interface{}) *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetActions(v)
+	})
+}
+
+// UpdateActions sets the "actions" field to the value that was provided on create.
+func (u *PipelineRuleUpsertOne) UpdateActions() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateActions()
+	})
+}
+
+// ClearActions clears the value of the "actions" field.
+func (u *PipelineRuleUpsertOne) ClearActions() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.ClearActions()
+	})
+}
+
+// SetParameters sets the "parameters" field.
+func (u *PipelineRuleUpsertOne) SetParameters(v map[string]interface{}) *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetParameters(v)
+	})
+}
+
+// UpdateParameters sets the "parameters" field to the value that was provided on create.
+func (u *PipelineRuleUpsertOne) UpdateParameters() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateParameters()
+	})
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (u *PipelineRuleUpsertOne) ClearParameters() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.ClearParameters()
+	})
+}
+
+// SetTargetFields sets the "target_fields" field.
+func (u *PipelineRuleUpsertOne) SetTargetFields(v []string) *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetTargetFields(v)
+	})
+}
+
+// UpdateTargetFields sets the "target_fields" field to the value that was provided on create.
+func (u *PipelineRuleUpsertOne) UpdateTargetFields() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateTargetFields()
+	})
+}
+
+// ClearTargetFields clears the value of the "target_fields" field.
+func (u *PipelineRuleUpsertOne) ClearTargetFields() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.ClearTargetFields()
+	})
+}
+
+// SetMatchMode sets the "match_mode" field.
+func (u *PipelineRuleUpsertOne) SetMatchMode(v pipelinerule.MatchMode) *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetMatchMode(v)
+	})
+}
+
+// UpdateMatchMode sets the "match_mode" field to the value that was provided on create.
+func (u *PipelineRuleUpsertOne) UpdateMatchMode() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateMatchMode()
+	})
+}
+
+// SetStopOnMatch sets the "stop_on_match" field.
+func (u *PipelineRuleUpsertOne) SetStopOnMatch(v bool) *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetStopOnMatch(v)
+	})
+}
+
+// UpdateStopOnMatch sets the "stop_on_match" field to the value that was provided on create.
+func (u *PipelineRuleUpsertOne) UpdateStopOnMatch() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateStopOnMatch()
+	})
+}
+
+// SetExecutionCount sets the "execution_count" field.
+func (u *PipelineRuleUpsertOne) SetExecutionCount(v int) *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetExecutionCount(v)
+	})
+}
+
+// AddExecutionCount adds v to the "execution_count" field.
+func (u *PipelineRuleUpsertOne) AddExecutionCount(v int) *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.AddExecutionCount(v)
+	})
+}
+
+// UpdateExecutionCount sets the "execution_count" field to the value that was provided on create.
+func (u *PipelineRuleUpsertOne) UpdateExecutionCount() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateExecutionCount()
+	})
+}
+
+// SetLastExecutedAt sets the "last_executed_at" field.
+func (u *PipelineRuleUpsertOne) SetLastExecutedAt(v time.Time) *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetLastExecutedAt(v)
+	})
+}
+
+// UpdateLastExecutedAt sets the "last_executed_at" field to the value that was provided on create.
+func (u *PipelineRuleUpsertOne) UpdateLastExecutedAt() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateLastExecutedAt()
+	})
+}
+
+// ClearLastExecutedAt clears the value of the "last_executed_at" field.
+func (u *PipelineRuleUpsertOne) ClearLastExecutedAt() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.ClearLastExecutedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PipelineRuleUpsertOne) SetUpdatedAt(v time.Time) *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PipelineRuleUpsertOne) UpdateUpdatedAt() *PipelineRuleUpsertOne {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PipelineRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PipelineRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PipelineRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PipelineRuleUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PipelineRuleUpsertOne.ID is not supported by MySQL driver. Use PipelineRuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PipelineRuleUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // PipelineRuleCreateBulk is the builder for creating many PipelineRule entities in bulk.
 type PipelineRuleCreateBulk struct {
 	config
 	err      error
 	builders []*PipelineRuleCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the PipelineRule entities in the database.
@@ -487,6 +1150,7 @@ func (_c *PipelineRuleCreateBulk) Save(ctx context.Context) ([]*PipelineRule, er
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -536,3 +1200,400 @@ func (_c *PipelineRuleCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PipelineRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PipelineRuleUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PipelineRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *PipelineRuleUpsertBulk {
+	_c.conflict = opts
+	return &PipelineRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PipelineRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PipelineRuleCreateBulk) OnConflictColumns(columns ...string) *PipelineRuleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PipelineRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// PipelineRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of PipelineRule nodes.
+type PipelineRuleUpsertBulk struct {
+	create *PipelineRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PipelineRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pipelinerule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PipelineRuleUpsertBulk) UpdateNewValues() *PipelineRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(pipelinerule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(pipelinerule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PipelineRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PipelineRuleUpsertBulk) Ignore() *PipelineRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PipelineRuleUpsertBulk) DoNothing() *PipelineRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PipelineRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *PipelineRuleUpsertBulk) Update(set func(*PipelineRuleUpsert)) *PipelineRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PipelineRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PipelineRuleUpsertBulk) SetUserID(v string) *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PipelineRuleUpsertBulk) UpdateUserID() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetConfigID sets the "config_id" field.
+func (u *PipelineRuleUpsertBulk) SetConfigID(v string) *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetConfigID(v)
+	})
+}
+
+// UpdateConfigID sets the "config_id" field to the value that was provided on create.
+func (u *PipelineRuleUpsertBulk) UpdateConfigID() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateConfigID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PipelineRuleUpsertBulk) SetName(v string) *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PipelineRuleUpsertBulk) UpdateName() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *PipelineRuleUpsertBulk) SetDescription(v string) *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PipelineRuleUpsertBulk) UpdateDescription() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PipelineRuleUpsertBulk) ClearDescription() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetRuleType sets the "rule_type" field.
+func (u *PipelineRuleUpsertBulk) SetRuleType(v pipelinerule.RuleType) *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetRuleType(v)
+	})
+}
+
+// UpdateRuleType sets the "rule_type" field to the value that was provided on create.
+func (u *PipelineRuleUpsertBulk) UpdateRuleType() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateRuleType()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *PipelineRuleUpsertBulk) SetPriority(v int) *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *PipelineRuleUpsertBulk) AddPriority(v int) *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *PipelineRuleUpsertBulk) UpdatePriority() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *PipelineRuleUpsertBulk) SetEnabled(v bool) *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *PipelineRuleUpsertBulk) UpdateEnabled() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetConditions sets the "conditions" field.
+func (u *PipelineRuleUpsertBulk) SetConditions(v map[string]interface{}) *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetConditions(v)
+	})
+}
+
+// UpdateConditions sets the "conditions" field to the value that was provided on create.
+func (u *PipelineRuleUpsertBulk) UpdateConditions() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateConditions()
+	})
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (u *PipelineRuleUpsertBulk) ClearConditions() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.ClearConditions()
+	})
+}
+
+// SetActions sets the "actions" field.
+func (u *PipelineRuleUpsertBulk) SetActions(v map[string]interface{}) *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetActions(v)
+	})
+}
+
+// UpdateActions sets the "actions" field to the value that was provided on create.
+func (u *PipelineRuleUpsertBulk) UpdateActions() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateActions()
+	})
+}
+
+// ClearActions clears the value of the "actions" field.
+func (u *PipelineRuleUpsertBulk) ClearActions() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.ClearActions()
+	})
+}
+
+// SetParameters sets the "parameters" field.
+func (u *PipelineRuleUpsertBulk) SetParameters(v map[string]interface{}) *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetParameters(v)
+	})
+}
+
+// UpdateParameters sets the "parameters" field to the value that was provided on create.
+func (u *PipelineRuleUpsertBulk) UpdateParameters() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateParameters()
+	})
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (u *PipelineRuleUpsertBulk) ClearParameters() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.ClearParameters()
+	})
+}
+
+// SetTargetFields sets the "target_fields" field.
+func (u *PipelineRuleUpsertBulk) SetTargetFields(v []string) *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetTargetFields(v)
+	})
+}
+
+// UpdateTargetFields sets the "target_fields" field to the value that was provided on create.
+func (u *PipelineRuleUpsertBulk) UpdateTargetFields() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateTargetFields()
+	})
+}
+
+// ClearTargetFields clears the value of the "target_fields" field.
+func (u *PipelineRuleUpsertBulk) ClearTargetFields() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.ClearTargetFields()
+	})
+}
+
+// SetMatchMode sets the "match_mode" field.
+func (u *PipelineRuleUpsertBulk) SetMatchMode(v pipelinerule.MatchMode) *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetMatchMode(v)
+	})
+}
+
+// UpdateMatchMode sets the "match_mode" field to the value that was provided on create.
+func (u *PipelineRuleUpsertBulk) UpdateMatchMode() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateMatchMode()
+	})
+}
+
+// SetStopOnMatch sets the "stop_on_match" field.
+func (u *PipelineRuleUpsertBulk) SetStopOnMatch(v bool) *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetStopOnMatch(v)
+	})
+}
+
+// UpdateStopOnMatch sets the "stop_on_match" field to the value that was provided on create.
+func (u *PipelineRuleUpsertBulk) UpdateStopOnMatch() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateStopOnMatch()
+	})
+}
+
+// SetExecutionCount sets the "execution_count" field.
+func (u *PipelineRuleUpsertBulk) SetExecutionCount(v int) *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetExecutionCount(v)
+	})
+}
+
+// AddExecutionCount adds v to the "execution_count" field.
+func (u *PipelineRuleUpsertBulk) AddExecutionCount(v int) *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.AddExecutionCount(v)
+	})
+}
+
+// UpdateExecutionCount sets the "execution_count" field to the value that was provided on create.
+func (u *PipelineRuleUpsertBulk) UpdateExecutionCount() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateExecutionCount()
+	})
+}
+
+// SetLastExecutedAt sets the "last_executed_at" field.
+func (u *PipelineRuleUpsertBulk) SetLastExecutedAt(v time.Time) *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetLastExecutedAt(v)
+	})
+}
+
+// UpdateLastExecutedAt sets the "last_executed_at" field to the value that was provided on create.
+func (u *PipelineRuleUpsertBulk) UpdateLastExecutedAt() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateLastExecutedAt()
+	})
+}
+
+// ClearLastExecutedAt clears the value of the "last_executed_at" field.
+func (u *PipelineRuleUpsertBulk) ClearLastExecutedAt() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.ClearLastExecutedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PipelineRuleUpsertBulk) SetUpdatedAt(v time.Time) *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PipelineRuleUpsertBulk) UpdateUpdatedAt() *PipelineRuleUpsertBulk {
+	return u.Update(func(s *PipelineRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PipelineRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PipelineRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PipelineRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PipelineRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
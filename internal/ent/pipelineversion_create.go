@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -19,6 +21,7 @@ type PipelineVersionCreate struct {
 	config
 	mutation *PipelineVersionMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetConfigID sets the "config_id" field.
@@ -341,6 +344,7 @@ func (_c *PipelineVersionCreate) createSpec() (*PipelineVersion, *sqlgraph.Creat
 		_node = &PipelineVersion{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(pipelineversion.Table, sqlgraph.NewFieldSpec(pipelineversion.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -425,11 +429,683 @@ func (_c *PipelineVersionCreate) createSpec() (*PipelineVersion, *sqlgraph.Creat
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PipelineVersion.Create().
+//		SetConfigID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PipelineVersionUpsert) {
+//			SetConfigID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PipelineVersionCreate) OnConflict(opts ...sql.ConflictOption) *PipelineVersionUpsertOne {
+	_c.conflict = opts
+	return &PipelineVersionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PipelineVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PipelineVersionCreate) OnConflictColumns(columns ...string) *PipelineVersionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PipelineVersionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PipelineVersionUpsertOne is the builder for "upsert"-ing
+	//  one PipelineVersion node.
+	PipelineVersionUpsertOne struct {
+		create *PipelineVersionCreate
+	}
+
+	// PipelineVersionUpsert is the "OnConflict" setter.
+	PipelineVersionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetConfigID sets the "config_id" field.
+func (u *PipelineVersionUpsert) SetConfigID(v string) *PipelineVersionUpsert {
+	u.Set(pipelineversion.FieldConfigID, v)
+	return u
+}
+
+// UpdateConfigID sets the "config_id" field to the value that was provided on create.
+func (u *PipelineVersionUpsert) UpdateConfigID() *PipelineVersionUpsert {
+	u.SetExcluded(pipelineversion.FieldConfigID)
+	return u
+}
+
+// SetVersionNumber sets the "version_number" field.
+func (u *PipelineVersionUpsert) SetVersionNumber(v int) *PipelineVersionUpsert {
+	u.Set(pipelineversion.FieldVersionNumber, v)
+	return u
+}
+
+// UpdateVersionNumber sets the "version_number" field to the value that was provided on create.
+func (u *PipelineVersionUpsert) UpdateVersionNumber() *PipelineVersionUpsert {
+	u.SetExcluded(pipelineversion.FieldVersionNumber)
+	return u
+}
+
+// AddVersionNumber adds v to the "version_number" field.
+func (u *PipelineVersionUpsert) AddVersionNumber(v int) *PipelineVersionUpsert {
+	u.Add(pipelineversion.FieldVersionNumber, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PipelineVersionUpsert) SetName(v string) *PipelineVersionUpsert {
+	u.Set(pipelineversion.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PipelineVersionUpsert) UpdateName() *PipelineVersionUpsert {
+	u.SetExcluded(pipelineversion.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *PipelineVersionUpsert) ClearName() *PipelineVersionUpsert {
+	u.SetNull(pipelineversion.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *PipelineVersionUpsert) SetDescription(v string) *PipelineVersionUpsert {
+	u.Set(pipelineversion.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PipelineVersionUpsert) UpdateDescription() *PipelineVersionUpsert {
+	u.SetExcluded(pipelineversion.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PipelineVersionUpsert) ClearDescription() *PipelineVersionUpsert {
+	u.SetNull(pipelineversion.FieldDescription)
+	return u
+}
+
+// SetChangelog sets the "changelog" field.
+func (u *PipelineVersionUpsert) SetChangelog(v string) *PipelineVersionUpsert {
+	u.Set(pipelineversion.FieldChangelog, v)
+	return u
+}
+
+// UpdateChangelog sets the "changelog" field to the value that was provided on create.
+func (u *PipelineVersionUpsert) UpdateChangelog() *PipelineVersionUpsert {
+	u.SetExcluded(pipelineversion.FieldChangelog)
+	return u
+}
+
+// ClearChangelog clears the value of the "changelog" field.
+func (u *PipelineVersionUpsert) ClearChangelog() *PipelineVersionUpsert {
+	u.SetNull(pipelineversion.FieldChangelog)
+	return u
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (u *PipelineVersionUpsert) SetSnapshot(v map[string]interface{}) *PipelineVersionUpsert {
+	u.Set(pipelineversion.FieldSnapshot, v)
+	return u
+}
+
+// UpdateSnapshot sets the "snapshot" field to the value that was provided on create.
+func (u *PipelineVersionUpsert) UpdateSnapshot() *PipelineVersionUpsert {
+	u.SetExcluded(pipelineversion.FieldSnapshot)
+	return u
+}
+
+// ClearSnapshot clears the value of the "snapshot" field.
+func (u *PipelineVersionUpsert) ClearSnapshot() *PipelineVersionUpsert {
+	u.SetNull(pipelineversion.FieldSnapshot)
+	return u
+}
+
+// SetRulesSnapshot sets the "rules_snapshot" field.
+func (u *PipelineVersionUpsert) SetRulesSnapshot(v []map[string]interface{}) *PipelineVersionUpsert {
+	u.Set(pipelineversion.FieldRulesSnapshot, v)
+	return u
+}
+
+// UpdateRulesSnapshot sets the "rules_snapshot" field to the value that was provided on create.
+func (u *PipelineVersionUpsert) UpdateRulesSnapshot() *PipelineVersionUpsert {
+	u.SetExcluded(pipelineversion.FieldRulesSnapshot)
+	return u
+}
+
+// ClearRulesSnapshot clears the value of the "rules_snapshot" field.
+func (u *PipelineVersionUpsert) ClearRulesSnapshot() *PipelineVersionUpsert {
+	u.SetNull(pipelineversion.FieldRulesSnapshot)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PipelineVersionUpsert) SetStatus(v pipelineversion.Status) *PipelineVersionUpsert {
+	u.Set(pipelineversion.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PipelineVersionUpsert) UpdateStatus() *PipelineVersionUpsert {
+	u.SetExcluded(pipelineversion.FieldStatus)
+	return u
+}
+
+// SetIsCurrent sets the "is_current" field.
+func (u *PipelineVersionUpsert) SetIsCurrent(v bool) *PipelineVersionUpsert {
+	u.Set(pipelineversion.FieldIsCurrent, v)
+	return u
+}
+
+// UpdateIsCurrent sets the "is_current" field to the value that was provided on create.
+func (u *PipelineVersionUpsert) UpdateIsCurrent() *PipelineVersionUpsert {
+	u.SetExcluded(pipelineversion.FieldIsCurrent)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *PipelineVersionUpsert) SetCreatedBy(v string) *PipelineVersionUpsert {
+	u.Set(pipelineversion.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *PipelineVersionUpsert) UpdateCreatedBy() *PipelineVersionUpsert {
+	u.SetExcluded(pipelineversion.FieldCreatedBy)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *PipelineVersionUpsert) ClearCreatedBy() *PipelineVersionUpsert {
+	u.SetNull(pipelineversion.FieldCreatedBy)
+	return u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (u *PipelineVersionUpsert) SetApprovedBy(v string) *PipelineVersionUpsert {
+	u.Set(pipelineversion.FieldApprovedBy, v)
+	return u
+}
+
+// UpdateApprovedBy sets the "approved_by" field to the value that was provided on create.
+func (u *PipelineVersionUpsert) UpdateApprovedBy() *PipelineVersionUpsert {
+	u.SetExcluded(pipelineversion.FieldApprovedBy)
+	return u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (u *PipelineVersionUpsert) ClearApprovedBy() *PipelineVersionUpsert {
+	u.SetNull(pipelineversion.FieldApprovedBy)
+	return u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (u *PipelineVersionUpsert) SetApprovedAt(v time.Time) *PipelineVersionUpsert {
+	u.Set(pipelineversion.FieldApprovedAt, v)
+	return u
+}
+
+// UpdateApprovedAt sets the "approved_at" field to the value that was provided on create.
+func (u *PipelineVersionUpsert) UpdateApprovedAt() *PipelineVersionUpsert {
+	u.SetExcluded(pipelineversion.FieldApprovedAt)
+	return u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (u *PipelineVersionUpsert) ClearApprovedAt() *PipelineVersionUpsert {
+	u.SetNull(pipelineversion.FieldApprovedAt)
+	return u
+}
+
+// SetActivatedAt sets the "activated_at" field.
+func (u *PipelineVersionUpsert) SetActivatedAt(v time.Time) *PipelineVersionUpsert {
+	u.Set(pipelineversion.FieldActivatedAt, v)
+	return u
+}
+
+// UpdateActivatedAt sets the "activated_at" field to the value that was provided on create.
+func (u *PipelineVersionUpsert) UpdateActivatedAt() *PipelineVersionUpsert {
+	u.SetExcluded(pipelineversion.FieldActivatedAt)
+	return u
+}
+
+// ClearActivatedAt clears the value of the "activated_at" field.
+func (u *PipelineVersionUpsert) ClearActivatedAt() *PipelineVersionUpsert {
+	u.SetNull(pipelineversion.FieldActivatedAt)
+	return u
+}
+
+// SetDeprecatedAt sets the "deprecated_at" field.
+func (u *PipelineVersionUpsert) SetDeprecatedAt(v time.Time) *PipelineVersionUpsert {
+	u.Set(pipelineversion.FieldDeprecatedAt, v)
+	return u
+}
+
+// UpdateDeprecatedAt sets the "deprecated_at" field to the value that was provided on create.
+func (u *PipelineVersionUpsert) UpdateDeprecatedAt() *PipelineVersionUpsert {
+	u.SetExcluded(pipelineversion.FieldDeprecatedAt)
+	return u
+}
+
+// ClearDeprecatedAt clears the value of the "deprecated_at" field.
+func (u *PipelineVersionUpsert) ClearDeprecatedAt() *PipelineVersionUpsert {
+	u.SetNull(pipelineversion.FieldDeprecatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PipelineVersionUpsert) SetUpdatedAt(v time.Time) *PipelineVersionUpsert {
+	u.Set(pipelineversion.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PipelineVersionUpsert) UpdateUpdatedAt() *PipelineVersionUpsert {
+	u.SetExcluded(pipelineversion.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PipelineVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pipelineversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PipelineVersionUpsertOne) UpdateNewValues() *PipelineVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(pipelineversion.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(pipelineversion.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PipelineVersion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PipelineVersionUpsertOne) Ignore() *PipelineVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PipelineVersionUpsertOne) DoNothing() *PipelineVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PipelineVersionCreate.OnConflict
+// documentation for more info.
+func (u *PipelineVersionUpsertOne) Update(set func(*PipelineVersionUpsert)) *PipelineVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PipelineVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConfigID sets the "config_id" field.
+func (u *PipelineVersionUpsertOne) SetConfigID(v string) *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetConfigID(v)
+	})
+}
+
+// UpdateConfigID sets the "config_id" field to the value that was provided on create.
+func (u *PipelineVersionUpsertOne) UpdateConfigID() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateConfigID()
+	})
+}
+
+// SetVersionNumber sets the "version_number" field.
+func (u *PipelineVersionUpsertOne) SetVersionNumber(v int) *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetVersionNumber(v)
+	})
+}
+
+// AddVersionNumber adds v to the "version_number" field.
+func (u *PipelineVersionUpsertOne) AddVersionNumber(v int) *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.AddVersionNumber(v)
+	})
+}
+
+// UpdateVersionNumber sets the "version_number" field to the value that was provided on create.
+func (u *PipelineVersionUpsertOne) UpdateVersionNumber() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateVersionNumber()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PipelineVersionUpsertOne) SetName(v string) *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PipelineVersionUpsertOne) UpdateName() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *PipelineVersionUpsertOne) ClearName() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *PipelineVersionUpsertOne) SetDescription(v string) *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PipelineVersionUpsertOne) UpdateDescription() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PipelineVersionUpsertOne) ClearDescription() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetChangelog sets the "changelog" field.
+func (u *PipelineVersionUpsertOne) SetChangelog(v string) *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetChangelog(v)
+	})
+}
+
+// UpdateChangelog sets the "changelog" field to the value that was provided on create.
+func (u *PipelineVersionUpsertOne) UpdateChangelog() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateChangelog()
+	})
+}
+
+// ClearChangelog clears the value of the "changelog" field.
+func (u *PipelineVersionUpsertOne) ClearChangelog() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.ClearChangelog()
+	})
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (u *PipelineVersionUpsertOne) SetSnapshot(v map[string]interface{}) *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetSnapshot(v)
+	})
+}
+
+// UpdateSnapshot sets the "snapshot" field to the value that was provided on create.
+func (u *PipelineVersionUpsertOne) UpdateSnapshot() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateSnapshot()
+	})
+}
+
+// ClearSnapshot clears the value of the "snapshot" field.
+func (u *PipelineVersionUpsertOne) ClearSnapshot() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.ClearSnapshot()
+	})
+}
+
+// SetRulesSnapshot sets the "rules_snapshot" field.
+func (u *PipelineVersionUpsertOne) SetRulesSnapshot(v []map[string]interface{}) *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetRulesSnapshot(v)
+	})
+}
+
+// UpdateRulesSnapshot sets the "rules_snapshot" field to the value that was provided on create.
+func (u *PipelineVersionUpsertOne) UpdateRulesSnapshot() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateRulesSnapshot()
+	})
+}
+
+// ClearRulesSnapshot clears the value of the "rules_snapshot" field.
+func (u *PipelineVersionUpsertOne) ClearRulesSnapshot() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.ClearRulesSnapshot()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PipelineVersionUpsertOne) SetStatus(v pipelineversion.Status) *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PipelineVersionUpsertOne) UpdateStatus() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetIsCurrent sets the "is_current" field.
+func (u *PipelineVersionUpsertOne) SetIsCurrent(v bool) *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetIsCurrent(v)
+	})
+}
+
+// UpdateIsCurrent sets the "is_current" field to the value that was provided on create.
+func (u *PipelineVersionUpsertOne) UpdateIsCurrent() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateIsCurrent()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *PipelineVersionUpsertOne) SetCreatedBy(v string) *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *PipelineVersionUpsertOne) UpdateCreatedBy() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *PipelineVersionUpsertOne) ClearCreatedBy() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (u *PipelineVersionUpsertOne) SetApprovedBy(v string) *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetApprovedBy(v)
+	})
+}
+
+// UpdateApprovedBy sets the "approved_by" field to the value that was provided on create.
+func (u *PipelineVersionUpsertOne) UpdateApprovedBy() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateApprovedBy()
+	})
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (u *PipelineVersionUpsertOne) ClearApprovedBy() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.ClearApprovedBy()
+	})
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (u *PipelineVersionUpsertOne) SetApprovedAt(v time.Time) *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetApprovedAt(v)
+	})
+}
+
+// UpdateApprovedAt sets the "approved_at" field to the value that was provided on create.
+func (u *PipelineVersionUpsertOne) UpdateApprovedAt() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateApprovedAt()
+	})
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (u *PipelineVersionUpsertOne) ClearApprovedAt() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.ClearApprovedAt()
+	})
+}
+
+// SetActivatedAt sets the "activated_at" field.
+func (u *PipelineVersionUpsertOne) SetActivatedAt(v time.Time) *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetActivatedAt(v)
+	})
+}
+
+// UpdateActivatedAt sets the "activated_at" field to the value that was provided on create.
+func (u *PipelineVersionUpsertOne) UpdateActivatedAt() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateActivatedAt()
+	})
+}
+
+// ClearActivatedAt clears the value of the "activated_at" field.
+func (u *PipelineVersionUpsertOne) ClearActivatedAt() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.ClearActivatedAt()
+	})
+}
+
+// SetDeprecatedAt sets the "deprecated_at" field.
+func (u *PipelineVersionUpsertOne) SetDeprecatedAt(v time.Time) *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetDeprecatedAt(v)
+	})
+}
+
+// UpdateDeprecatedAt sets the "deprecated_at" field to the value that was provided on create.
+func (u *PipelineVersionUpsertOne) UpdateDeprecatedAt() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateDeprecatedAt()
+	})
+}
+
+// ClearDeprecatedAt clears the value of the "deprecated_at" field.
+func (u *PipelineVersionUpsertOne) ClearDeprecatedAt() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.ClearDeprecatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PipelineVersionUpsertOne) SetUpdatedAt(v time.Time) *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PipelineVersionUpsertOne) UpdateUpdatedAt() *PipelineVersionUpsertOne {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PipelineVersionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PipelineVersionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PipelineVersionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PipelineVersionUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PipelineVersionUpsertOne.ID is not supported by MySQL driver. Use PipelineVersionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PipelineVersionUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // PipelineVersionCreateBulk is the builder for creating many PipelineVersion entities in bulk.
 type PipelineVersionCreateBulk struct {
 	config
 	err      error
 	builders []*PipelineVersionCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the PipelineVersion entities in the database.
@@ -459,6 +1135,7 @@ func (_c *PipelineVersionCreateBulk) Save(ctx context.Context) ([]*PipelineVersi
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -508,3 +1185,407 @@ func (_c *PipelineVersionCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PipelineVersion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PipelineVersionUpsert) {
+//			SetConfigID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PipelineVersionCreateBulk) OnConflict(opts ...sql.ConflictOption) *PipelineVersionUpsertBulk {
+	_c.conflict = opts
+	return &PipelineVersionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PipelineVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PipelineVersionCreateBulk) OnConflictColumns(columns ...string) *PipelineVersionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PipelineVersionUpsertBulk{
+		create: _c,
+	}
+}
+
+// PipelineVersionUpsertBulk is the builder for "upsert"-ing
+// a bulk of PipelineVersion nodes.
+type PipelineVersionUpsertBulk struct {
+	create *PipelineVersionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PipelineVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pipelineversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PipelineVersionUpsertBulk) UpdateNewValues() *PipelineVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(pipelineversion.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(pipelineversion.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PipelineVersion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PipelineVersionUpsertBulk) Ignore() *PipelineVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PipelineVersionUpsertBulk) DoNothing() *PipelineVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PipelineVersionCreateBulk.OnConflict
+// documentation for more info.
+func (u *PipelineVersionUpsertBulk) Update(set func(*PipelineVersionUpsert)) *PipelineVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PipelineVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConfigID sets the "config_id" field.
+func (u *PipelineVersionUpsertBulk) SetConfigID(v string) *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetConfigID(v)
+	})
+}
+
+// UpdateConfigID sets the "config_id" field to the value that was provided on create.
+func (u *PipelineVersionUpsertBulk) UpdateConfigID() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateConfigID()
+	})
+}
+
+// SetVersionNumber sets the "version_number" field.
+func (u *PipelineVersionUpsertBulk) SetVersionNumber(v int) *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetVersionNumber(v)
+	})
+}
+
+// AddVersionNumber adds v to the "version_number" field.
+func (u *PipelineVersionUpsertBulk) AddVersionNumber(v int) *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.AddVersionNumber(v)
+	})
+}
+
+// UpdateVersionNumber sets the "version_number" field to the value that was provided on create.
+func (u *PipelineVersionUpsertBulk) UpdateVersionNumber() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateVersionNumber()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PipelineVersionUpsertBulk) SetName(v string) *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PipelineVersionUpsertBulk) UpdateName() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *PipelineVersionUpsertBulk) ClearName() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *PipelineVersionUpsertBulk) SetDescription(v string) *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PipelineVersionUpsertBulk) UpdateDescription() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PipelineVersionUpsertBulk) ClearDescription() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetChangelog sets the "changelog" field.
+func (u *PipelineVersionUpsertBulk) SetChangelog(v string) *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetChangelog(v)
+	})
+}
+
+// UpdateChangelog sets the "changelog" field to the value that was provided on create.
+func (u *PipelineVersionUpsertBulk) UpdateChangelog() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateChangelog()
+	})
+}
+
+// ClearChangelog clears the value of the "changelog" field.
+func (u *PipelineVersionUpsertBulk) ClearChangelog() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.ClearChangelog()
+	})
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (u *PipelineVersionUpsertBulk) SetSnapshot(v map[string]interface{}) *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetSnapshot(v)
+	})
+}
+
+// UpdateSnapshot sets the "snapshot" field to the value that was provided on create.
+func (u *PipelineVersionUpsertBulk) UpdateSnapshot() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateSnapshot()
+	})
+}
+
+// ClearSnapshot clears the value of the "snapshot" field.
+func (u *PipelineVersionUpsertBulk) ClearSnapshot() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.ClearSnapshot()
+	})
+}
+
+// SetRulesSnapshot sets the "rules_snapshot" field.
+func (u *PipelineVersionUpsertBulk) SetRulesSnapshot(v []map[string]interface{}) *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetRulesSnapshot(v)
+	})
+}
+
+// UpdateRulesSnapshot sets the "rules_snapshot" field to the value that was provided on create.
+func (u *PipelineVersionUpsertBulk) UpdateRulesSnapshot() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateRulesSnapshot()
+	})
+}
+
+// ClearRulesSnapshot clears the value of the "rules_snapshot" field.
+func (u *PipelineVersionUpsertBulk) ClearRulesSnapshot() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.ClearRulesSnapshot()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PipelineVersionUpsertBulk) SetStatus(v pipelineversion.Status) *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PipelineVersionUpsertBulk) UpdateStatus() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetIsCurrent sets the "is_current" field.
+func (u *PipelineVersionUpsertBulk) SetIsCurrent(v bool) *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetIsCurrent(v)
+	})
+}
+
+// UpdateIsCurrent sets the "is_current" field to the value that was provided on create.
+func (u *PipelineVersionUpsertBulk) UpdateIsCurrent() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateIsCurrent()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *PipelineVersionUpsertBulk) SetCreatedBy(v string) *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *PipelineVersionUpsertBulk) UpdateCreatedBy() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *PipelineVersionUpsertBulk) ClearCreatedBy() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (u *PipelineVersionUpsertBulk) SetApprovedBy(v string) *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetApprovedBy(v)
+	})
+}
+
+// UpdateApprovedBy sets the "approved_by" field to the value that was provided on create.
+func (u *PipelineVersionUpsertBulk) UpdateApprovedBy() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateApprovedBy()
+	})
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (u *PipelineVersionUpsertBulk) ClearApprovedBy() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.ClearApprovedBy()
+	})
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (u *PipelineVersionUpsertBulk) SetApprovedAt(v time.Time) *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetApprovedAt(v)
+	})
+}
+
+// UpdateApprovedAt sets the "approved_at" field to the value that was provided on create.
+func (u *PipelineVersionUpsertBulk) UpdateApprovedAt() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateApprovedAt()
+	})
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (u *PipelineVersionUpsertBulk) ClearApprovedAt() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.ClearApprovedAt()
+	})
+}
+
+// SetActivatedAt sets the "activated_at" field.
+func (u *PipelineVersionUpsertBulk) SetActivatedAt(v time.Time) *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetActivatedAt(v)
+	})
+}
+
+// UpdateActivatedAt sets the "activated_at" field to the value that was provided on create.
+func (u *PipelineVersionUpsertBulk) UpdateActivatedAt() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateActivatedAt()
+	})
+}
+
+// ClearActivatedAt clears the value of the "activated_at" field.
+func (u *PipelineVersionUpsertBulk) ClearActivatedAt() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.ClearActivatedAt()
+	})
+}
+
+// SetDeprecatedAt sets the "deprecated_at" field.
+func (u *PipelineVersionUpsertBulk) SetDeprecatedAt(v time.Time) *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetDeprecatedAt(v)
+	})
+}
+
+// UpdateDeprecatedAt sets the "deprecated_at" field to the value that was provided on create.
+func (u *PipelineVersionUpsertBulk) UpdateDeprecatedAt() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateDeprecatedAt()
+	})
+}
+
+// ClearDeprecatedAt clears the value of the "deprecated_at" field.
+func (u *PipelineVersionUpsertBulk) ClearDeprecatedAt() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.ClearDeprecatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PipelineVersionUpsertBulk) SetUpdatedAt(v time.Time) *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PipelineVersionUpsertBulk) UpdateUpdatedAt() *PipelineVersionUpsertBulk {
+	return u.Update(func(s *PipelineVersionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PipelineVersionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PipelineVersionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PipelineVersionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PipelineVersionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
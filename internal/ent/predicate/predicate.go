@@ -6,15 +6,63 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AccountDeletion is the predicate function for accountdeletion builders.
+type AccountDeletion func(*sql.Selector)
+
+// Alert is the predicate function for alert builders.
+type Alert func(*sql.Selector)
+
+// AlertPreference is the predicate function for alertpreference builders.
+type AlertPreference func(*sql.Selector)
+
+// AttachmentBlob is the predicate function for attachmentblob builders.
+type AttachmentBlob func(*sql.Selector)
+
+// AttachmentLink is the predicate function for attachmentlink builders.
+type AttachmentLink func(*sql.Selector)
+
+// BudgetReallocation is the predicate function for budgetreallocation builders.
+type BudgetReallocation func(*sql.Selector)
+
+// BulkOperation is the predicate function for bulkoperation builders.
+type BulkOperation func(*sql.Selector)
+
+// CardAccount is the predicate function for cardaccount builders.
+type CardAccount func(*sql.Selector)
+
+// CategorizationRule is the predicate function for categorizationrule builders.
+type CategorizationRule func(*sql.Selector)
+
+// CategoryFeedback is the predicate function for categoryfeedback builders.
+type CategoryFeedback func(*sql.Selector)
+
+// Debt is the predicate function for debt builders.
+type Debt func(*sql.Selector)
+
 // EmailConnection is the predicate function for emailconnection builders.
 type EmailConnection func(*sql.Selector)
 
 // EmailLabel is the predicate function for emaillabel builders.
 type EmailLabel func(*sql.Selector)
 
+// EmailMessage is the predicate function for emailmessage builders.
+type EmailMessage func(*sql.Selector)
+
 // EmailSync is the predicate function for emailsync builders.
 type EmailSync func(*sql.Selector)
 
+// EmailSyncFailure is the predicate function for emailsyncfailure builders.
+type EmailSyncFailure func(*sql.Selector)
+
+// EmergencyFundSnapshot is the predicate function for emergencyfundsnapshot builders.
+type EmergencyFundSnapshot func(*sql.Selector)
+
+// EmergencyFundTarget is the predicate function for emergencyfundtarget builders.
+type EmergencyFundTarget func(*sql.Selector)
+
+// Goal is the predicate function for goal builders.
+type Goal func(*sql.Selector)
+
 // GoogleDriveConnection is the predicate function for googledriveconnection builders.
 type GoogleDriveConnection func(*sql.Selector)
 
@@ -24,9 +72,45 @@ type GoogleDriveFolder func(*sql.Selector)
 // GoogleDriveSync is the predicate function for googledrivesync builders.
 type GoogleDriveSync func(*sql.Selector)
 
+// HouseholdMember is the predicate function for householdmember builders.
+type HouseholdMember func(*sql.Selector)
+
+// JobQueue is the predicate function for jobqueue builders.
+type JobQueue func(*sql.Selector)
+
+// JobSchedule is the predicate function for jobschedule builders.
+type JobSchedule func(*sql.Selector)
+
+// JobScheduleRun is the predicate function for jobschedulerun builders.
+type JobScheduleRun func(*sql.Selector)
+
 // LineItem is the predicate function for lineitem builders.
 type LineItem func(*sql.Selector)
 
+// LiquidAccount is the predicate function for liquidaccount builders.
+type LiquidAccount func(*sql.Selector)
+
+// Membership is the predicate function for membership builders.
+type Membership func(*sql.Selector)
+
+// Merchant is the predicate function for merchant builders.
+type Merchant func(*sql.Selector)
+
+// MigrationState is the predicate function for migrationstate builders.
+type MigrationState func(*sql.Selector)
+
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
+// OAuthState is the predicate function for oauthstate builders.
+type OAuthState func(*sql.Selector)
+
+// OCRFeedback is the predicate function for ocrfeedback builders.
+type OCRFeedback func(*sql.Selector)
+
+// Organization is the predicate function for organization builders.
+type Organization func(*sql.Selector)
+
 // PipelineConfig is the predicate function for pipelineconfig builders.
 type PipelineConfig func(*sql.Selector)
 
@@ -36,8 +120,32 @@ type PipelineRule func(*sql.Selector)
 // PipelineVersion is the predicate function for pipelineversion builders.
 type PipelineVersion func(*sql.Selector)
 
+// QueuedJob is the predicate function for queuedjob builders.
+type QueuedJob func(*sql.Selector)
+
 // Receipt is the predicate function for receipt builders.
 type Receipt func(*sql.Selector)
 
+// ReceiptEvent is the predicate function for receiptevent builders.
+type ReceiptEvent func(*sql.Selector)
+
+// RoundingRule is the predicate function for roundingrule builders.
+type RoundingRule func(*sql.Selector)
+
+// SavedFilter is the predicate function for savedfilter builders.
+type SavedFilter func(*sql.Selector)
+
+// TaxYear is the predicate function for taxyear builders.
+type TaxYear func(*sql.Selector)
+
 // Transaction is the predicate function for transaction builders.
 type Transaction func(*sql.Selector)
+
+// User is the predicate function for user builders.
+type User func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// WebhookEndpoint is the predicate function for webhookendpoint builders.
+type WebhookEndpoint func(*sql.Selector)
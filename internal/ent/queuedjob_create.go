@@ -0,0 +1,1695 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/queuedjob"
+	"context"
+	"encoding/json/jsontext"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QueuedJobCreate is the builder for creating a QueuedJob entity.
+type QueuedJobCreate struct {
+	config
+	mutation *QueuedJobMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetQueue sets the "queue" field.
+func (_c *QueuedJobCreate) SetQueue(v string) *QueuedJobCreate {
+	_c.mutation.SetQueue(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *QueuedJobCreate) SetType(v string) *QueuedJobCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *QueuedJobCreate) SetOwnerID(v string) *QueuedJobCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_c *QueuedJobCreate) SetNillableOwnerID(v *string) *QueuedJobCreate {
+	if v != nil {
+		_c.SetOwnerID(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *QueuedJobCreate) SetStatus(v queuedjob.Status) *QueuedJobCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *QueuedJobCreate) SetNillableStatus(v *queuedjob.Status) *QueuedJobCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *QueuedJobCreate) SetPriority(v int) *QueuedJobCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *QueuedJobCreate) SetNillablePriority(v *int) *QueuedJobCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *QueuedJobCreate) SetPayload(v jsontext.Value) *QueuedJobCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetResult sets the "result" field.
+func (_c *QueuedJobCreate) SetResult(v jsontext.Value) *QueuedJobCreate {
+	_c.mutation.SetResult(v)
+	return _c
+}
+
+// SetProgress sets the "progress" field.
+func (_c *QueuedJobCreate) SetProgress(v float64) *QueuedJobCreate {
+	_c.mutation.SetProgress(v)
+	return _c
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (_c *QueuedJobCreate) SetNillableProgress(v *float64) *QueuedJobCreate {
+	if v != nil {
+		_c.SetProgress(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *QueuedJobCreate) SetError(v string) *QueuedJobCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *QueuedJobCreate) SetNillableError(v *string) *QueuedJobCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (_c *QueuedJobCreate) SetRetryCount(v int) *QueuedJobCreate {
+	_c.mutation.SetRetryCount(v)
+	return _c
+}
+
+// SetNillableRetryCount sets the "retry_count" field if the given value is not nil.
+func (_c *QueuedJobCreate) SetNillableRetryCount(v *int) *QueuedJobCreate {
+	if v != nil {
+		_c.SetRetryCount(*v)
+	}
+	return _c
+}
+
+// SetMaxRetries sets the "max_retries" field.
+func (_c *QueuedJobCreate) SetMaxRetries(v int) *QueuedJobCreate {
+	_c.mutation.SetMaxRetries(v)
+	return _c
+}
+
+// SetNillableMaxRetries sets the "max_retries" field if the given value is not nil.
+func (_c *QueuedJobCreate) SetNillableMaxRetries(v *int) *QueuedJobCreate {
+	if v != nil {
+		_c.SetMaxRetries(*v)
+	}
+	return _c
+}
+
+// SetRunAt sets the "run_at" field.
+func (_c *QueuedJobCreate) SetRunAt(v time.Time) *QueuedJobCreate {
+	_c.mutation.SetRunAt(v)
+	return _c
+}
+
+// SetNillableRunAt sets the "run_at" field if the given value is not nil.
+func (_c *QueuedJobCreate) SetNillableRunAt(v *time.Time) *QueuedJobCreate {
+	if v != nil {
+		_c.SetRunAt(*v)
+	}
+	return _c
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (_c *QueuedJobCreate) SetLockedBy(v string) *QueuedJobCreate {
+	_c.mutation.SetLockedBy(v)
+	return _c
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (_c *QueuedJobCreate) SetNillableLockedBy(v *string) *QueuedJobCreate {
+	if v != nil {
+		_c.SetLockedBy(*v)
+	}
+	return _c
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (_c *QueuedJobCreate) SetHeartbeatAt(v time.Time) *QueuedJobCreate {
+	_c.mutation.SetHeartbeatAt(v)
+	return _c
+}
+
+// SetNillableHeartbeatAt sets the "heartbeat_at" field if the given value is not nil.
+func (_c *QueuedJobCreate) SetNillableHeartbeatAt(v *time.Time) *QueuedJobCreate {
+	if v != nil {
+		_c.SetHeartbeatAt(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *QueuedJobCreate) SetStartedAt(v time.Time) *QueuedJobCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *QueuedJobCreate) SetNillableStartedAt(v *time.Time) *QueuedJobCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *QueuedJobCreate) SetCompletedAt(v time.Time) *QueuedJobCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *QueuedJobCreate) SetNillableCompletedAt(v *time.Time) *QueuedJobCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_c *QueuedJobCreate) SetCancelledAt(v time.Time) *QueuedJobCreate {
+	_c.mutation.SetCancelledAt(v)
+	return _c
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_c *QueuedJobCreate) SetNillableCancelledAt(v *time.Time) *QueuedJobCreate {
+	if v != nil {
+		_c.SetCancelledAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *QueuedJobCreate) SetCreatedAt(v time.Time) *QueuedJobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *QueuedJobCreate) SetNillableCreatedAt(v *time.Time) *QueuedJobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *QueuedJobCreate) SetUpdatedAt(v time.Time) *QueuedJobCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *QueuedJobCreate) SetNillableUpdatedAt(v *time.Time) *QueuedJobCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *QueuedJobCreate) SetID(v string) *QueuedJobCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the QueuedJobMutation object of the builder.
+func (_c *QueuedJobCreate) Mutation() *QueuedJobMutation {
+	return _c.mutation
+}
+
+// Save creates the QueuedJob in the database.
+func (_c *QueuedJobCreate) Save(ctx context.Context) (*QueuedJob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *QueuedJobCreate) SaveX(ctx context.Context) *QueuedJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QueuedJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QueuedJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *QueuedJobCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := queuedjob.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := queuedjob.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.Progress(); !ok {
+		v := queuedjob.DefaultProgress
+		_c.mutation.SetProgress(v)
+	}
+	if _, ok := _c.mutation.RetryCount(); !ok {
+		v := queuedjob.DefaultRetryCount
+		_c.mutation.SetRetryCount(v)
+	}
+	if _, ok := _c.mutation.MaxRetries(); !ok {
+		v := queuedjob.DefaultMaxRetries
+		_c.mutation.SetMaxRetries(v)
+	}
+	if _, ok := _c.mutation.RunAt(); !ok {
+		v := queuedjob.DefaultRunAt()
+		_c.mutation.SetRunAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := queuedjob.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := queuedjob.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *QueuedJobCreate) check() error {
+	if _, ok := _c.mutation.Queue(); !ok {
+		return &ValidationError{Name: "queue", err: errors.New(`ent: missing required field "QueuedJob.queue"`)}
+	}
+	if v, ok := _c.mutation.Queue(); ok {
+		if err := queuedjob.QueueValidator(v); err != nil {
+			return &ValidationError{Name: "queue", err: fmt.Errorf(`ent: validator failed for field "QueuedJob.queue": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "QueuedJob.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := queuedjob.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "QueuedJob.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "QueuedJob.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := queuedjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "QueuedJob.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "QueuedJob.priority"`)}
+	}
+	if _, ok := _c.mutation.Progress(); !ok {
+		return &ValidationError{Name: "progress", err: errors.New(`ent: missing required field "QueuedJob.progress"`)}
+	}
+	if _, ok := _c.mutation.RetryCount(); !ok {
+		return &ValidationError{Name: "retry_count", err: errors.New(`ent: missing required field "QueuedJob.retry_count"`)}
+	}
+	if _, ok := _c.mutation.MaxRetries(); !ok {
+		return &ValidationError{Name: "max_retries", err: errors.New(`ent: missing required field "QueuedJob.max_retries"`)}
+	}
+	if _, ok := _c.mutation.RunAt(); !ok {
+		return &ValidationError{Name: "run_at", err: errors.New(`ent: missing required field "QueuedJob.run_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "QueuedJob.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "QueuedJob.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *QueuedJobCreate) sqlSave(ctx context.Context) (*QueuedJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected QueuedJob.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *QueuedJobCreate) createSpec() (*QueuedJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QueuedJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(queuedjob.Table, sqlgraph.NewFieldSpec(queuedjob.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Queue(); ok {
+		_spec.SetField(queuedjob.FieldQueue, field.TypeString, value)
+		_node.Queue = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(queuedjob.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(queuedjob.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(queuedjob.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(queuedjob.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(queuedjob.FieldPayload, field.TypeJSON, value)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.Result(); ok {
+		_spec.SetField(queuedjob.FieldResult, field.TypeJSON, value)
+		_node.Result = value
+	}
+	if value, ok := _c.mutation.Progress(); ok {
+		_spec.SetField(queuedjob.FieldProgress, field.TypeFloat64, value)
+		_node.Progress = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(queuedjob.FieldError, field.TypeString, value)
+		_node.Error = &value
+	}
+	if value, ok := _c.mutation.RetryCount(); ok {
+		_spec.SetField(queuedjob.FieldRetryCount, field.TypeInt, value)
+		_node.RetryCount = value
+	}
+	if value, ok := _c.mutation.MaxRetries(); ok {
+		_spec.SetField(queuedjob.FieldMaxRetries, field.TypeInt, value)
+		_node.MaxRetries = value
+	}
+	if value, ok := _c.mutation.RunAt(); ok {
+		_spec.SetField(queuedjob.FieldRunAt, field.TypeTime, value)
+		_node.RunAt = value
+	}
+	if value, ok := _c.mutation.LockedBy(); ok {
+		_spec.SetField(queuedjob.FieldLockedBy, field.TypeString, value)
+		_node.LockedBy = &value
+	}
+	if value, ok := _c.mutation.HeartbeatAt(); ok {
+		_spec.SetField(queuedjob.FieldHeartbeatAt, field.TypeTime, value)
+		_node.HeartbeatAt = &value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(queuedjob.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(queuedjob.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := _c.mutation.CancelledAt(); ok {
+		_spec.SetField(queuedjob.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(queuedjob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(queuedjob.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.QueuedJob.Create().
+//		SetQueue(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QueuedJobUpsert) {
+//			SetQueue(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *QueuedJobCreate) OnConflict(opts ...sql.ConflictOption) *QueuedJobUpsertOne {
+	_c.conflict = opts
+	return &QueuedJobUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.QueuedJob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *QueuedJobCreate) OnConflictColumns(columns ...string) *QueuedJobUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &QueuedJobUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// QueuedJobUpsertOne is the builder for "upsert"-ing
+	//  one QueuedJob node.
+	QueuedJobUpsertOne struct {
+		create *QueuedJobCreate
+	}
+
+	// QueuedJobUpsert is the "OnConflict" setter.
+	QueuedJobUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *QueuedJobUpsert) SetStatus(v queuedjob.Status) *QueuedJobUpsert {
+	u.Set(queuedjob.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *QueuedJobUpsert) UpdateStatus() *QueuedJobUpsert {
+	u.SetExcluded(queuedjob.FieldStatus)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *QueuedJobUpsert) SetPriority(v int) *QueuedJobUpsert {
+	u.Set(queuedjob.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *QueuedJobUpsert) UpdatePriority() *QueuedJobUpsert {
+	u.SetExcluded(queuedjob.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *QueuedJobUpsert) AddPriority(v int) *QueuedJobUpsert {
+	u.Add(queuedjob.FieldPriority, v)
+	return u
+}
+
+// SetPayload sets the "payload" field.
+func (u *QueuedJobUpsert) SetPayload(v jsontext.Value) *QueuedJobUpsert {
+	u.Set(queuedjob.FieldPayload, v)
+	return u
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *QueuedJobUpsert) UpdatePayload() *QueuedJobUpsert {
+	u.SetExcluded(queuedjob.FieldPayload)
+	return u
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (u *QueuedJobUpsert) ClearPayload() *QueuedJobUpsert {
+	u.SetNull(queuedjob.FieldPayload)
+	return u
+}
+
+// SetResult sets the "result" field.
+func (u *QueuedJobUpsert) SetResult(v jsontext.Value) *QueuedJobUpsert {
+	u.Set(queuedjob.FieldResult, v)
+	return u
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *QueuedJobUpsert) UpdateResult() *QueuedJobUpsert {
+	u.SetExcluded(queuedjob.FieldResult)
+	return u
+}
+
+// ClearResult clears the value of the "result" field.
+func (u *QueuedJobUpsert) ClearResult() *QueuedJobUpsert {
+	u.SetNull(queuedjob.FieldResult)
+	return u
+}
+
+// SetProgress sets the "progress" field.
+func (u *QueuedJobUpsert) SetProgress(v float64) *QueuedJobUpsert {
+	u.Set(queuedjob.FieldProgress, v)
+	return u
+}
+
+// UpdateProgress sets the "progress" field to the value that was provided on create.
+func (u *QueuedJobUpsert) UpdateProgress() *QueuedJobUpsert {
+	u.SetExcluded(queuedjob.FieldProgress)
+	return u
+}
+
+// AddProgress adds v to the "progress" field.
+func (u *QueuedJobUpsert) AddProgress(v float64) *QueuedJobUpsert {
+	u.Add(queuedjob.FieldProgress, v)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *QueuedJobUpsert) SetError(v string) *QueuedJobUpsert {
+	u.Set(queuedjob.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *QueuedJobUpsert) UpdateError() *QueuedJobUpsert {
+	u.SetExcluded(queuedjob.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *QueuedJobUpsert) ClearError() *QueuedJobUpsert {
+	u.SetNull(queuedjob.FieldError)
+	return u
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (u *QueuedJobUpsert) SetRetryCount(v int) *QueuedJobUpsert {
+	u.Set(queuedjob.FieldRetryCount, v)
+	return u
+}
+
+// UpdateRetryCount sets the "retry_count" field to the value that was provided on create.
+func (u *QueuedJobUpsert) UpdateRetryCount() *QueuedJobUpsert {
+	u.SetExcluded(queuedjob.FieldRetryCount)
+	return u
+}
+
+// AddRetryCount adds v to the "retry_count" field.
+func (u *QueuedJobUpsert) AddRetryCount(v int) *QueuedJobUpsert {
+	u.Add(queuedjob.FieldRetryCount, v)
+	return u
+}
+
+// SetMaxRetries sets the "max_retries" field.
+func (u *QueuedJobUpsert) SetMaxRetries(v int) *QueuedJobUpsert {
+	u.Set(queuedjob.FieldMaxRetries, v)
+	return u
+}
+
+// UpdateMaxRetries sets the "max_retries" field to the value that was provided on create.
+func (u *QueuedJobUpsert) UpdateMaxRetries() *QueuedJobUpsert {
+	u.SetExcluded(queuedjob.FieldMaxRetries)
+	return u
+}
+
+// AddMaxRetries adds v to the "max_retries" field.
+func (u *QueuedJobUpsert) AddMaxRetries(v int) *QueuedJobUpsert {
+	u.Add(queuedjob.FieldMaxRetries, v)
+	return u
+}
+
+// SetRunAt sets the "run_at" field.
+func (u *QueuedJobUpsert) SetRunAt(v time.Time) *QueuedJobUpsert {
+	u.Set(queuedjob.FieldRunAt, v)
+	return u
+}
+
+// UpdateRunAt sets the "run_at" field to the value that was provided on create.
+func (u *QueuedJobUpsert) UpdateRunAt() *QueuedJobUpsert {
+	u.SetExcluded(queuedjob.FieldRunAt)
+	return u
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (u *QueuedJobUpsert) SetLockedBy(v string) *QueuedJobUpsert {
+	u.Set(queuedjob.FieldLockedBy, v)
+	return u
+}
+
+// UpdateLockedBy sets the "locked_by" field to the value that was provided on create.
+func (u *QueuedJobUpsert) UpdateLockedBy() *QueuedJobUpsert {
+	u.SetExcluded(queuedjob.FieldLockedBy)
+	return u
+}
+
+// ClearLockedBy clears the value of the "locked_by" field.
+func (u *QueuedJobUpsert) ClearLockedBy() *QueuedJobUpsert {
+	u.SetNull(queuedjob.FieldLockedBy)
+	return u
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (u *QueuedJobUpsert) SetHeartbeatAt(v time.Time) *QueuedJobUpsert {
+	u.Set(queuedjob.FieldHeartbeatAt, v)
+	return u
+}
+
+// UpdateHeartbeatAt sets the "heartbeat_at" field to the value that was provided on create.
+func (u *QueuedJobUpsert) UpdateHeartbeatAt() *QueuedJobUpsert {
+	u.SetExcluded(queuedjob.FieldHeartbeatAt)
+	return u
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (u *QueuedJobUpsert) ClearHeartbeatAt() *QueuedJobUpsert {
+	u.SetNull(queuedjob.FieldHeartbeatAt)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *QueuedJobUpsert) SetStartedAt(v time.Time) *QueuedJobUpsert {
+	u.Set(queuedjob.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *QueuedJobUpsert) UpdateStartedAt() *QueuedJobUpsert {
+	u.SetExcluded(queuedjob.FieldStartedAt)
+	return u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *QueuedJobUpsert) ClearStartedAt() *QueuedJobUpsert {
+	u.SetNull(queuedjob.FieldStartedAt)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *QueuedJobUpsert) SetCompletedAt(v time.Time) *QueuedJobUpsert {
+	u.Set(queuedjob.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *QueuedJobUpsert) UpdateCompletedAt() *QueuedJobUpsert {
+	u.SetExcluded(queuedjob.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *QueuedJobUpsert) ClearCompletedAt() *QueuedJobUpsert {
+	u.SetNull(queuedjob.FieldCompletedAt)
+	return u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *QueuedJobUpsert) SetCancelledAt(v time.Time) *QueuedJobUpsert {
+	u.Set(queuedjob.FieldCancelledAt, v)
+	return u
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *QueuedJobUpsert) UpdateCancelledAt() *QueuedJobUpsert {
+	u.SetExcluded(queuedjob.FieldCancelledAt)
+	return u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *QueuedJobUpsert) ClearCancelledAt() *QueuedJobUpsert {
+	u.SetNull(queuedjob.FieldCancelledAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QueuedJobUpsert) SetUpdatedAt(v time.Time) *QueuedJobUpsert {
+	u.Set(queuedjob.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QueuedJobUpsert) UpdateUpdatedAt() *QueuedJobUpsert {
+	u.SetExcluded(queuedjob.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.QueuedJob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(queuedjob.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QueuedJobUpsertOne) UpdateNewValues() *QueuedJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(queuedjob.FieldID)
+		}
+		if _, exists := u.create.mutation.Queue(); exists {
+			s.SetIgnore(queuedjob.FieldQueue)
+		}
+		if _, exists := u.create.mutation.GetType(); exists {
+			s.SetIgnore(queuedjob.FieldType)
+		}
+		if _, exists := u.create.mutation.OwnerID(); exists {
+			s.SetIgnore(queuedjob.FieldOwnerID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(queuedjob.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.QueuedJob.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *QueuedJobUpsertOne) Ignore() *QueuedJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QueuedJobUpsertOne) DoNothing() *QueuedJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QueuedJobCreate.OnConflict
+// documentation for more info.
+func (u *QueuedJobUpsertOne) Update(set func(*QueuedJobUpsert)) *QueuedJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QueuedJobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *QueuedJobUpsertOne) SetStatus(v queuedjob.Status) *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *QueuedJobUpsertOne) UpdateStatus() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *QueuedJobUpsertOne) SetPriority(v int) *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *QueuedJobUpsertOne) AddPriority(v int) *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *QueuedJobUpsertOne) UpdatePriority() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *QueuedJobUpsertOne) SetPayload(v jsontext.Value) *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *QueuedJobUpsertOne) UpdatePayload() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (u *QueuedJobUpsertOne) ClearPayload() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.ClearPayload()
+	})
+}
+
+// SetResult sets the "result" field.
+func (u *QueuedJobUpsertOne) SetResult(v jsontext.Value) *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetResult(v)
+	})
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *QueuedJobUpsertOne) UpdateResult() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateResult()
+	})
+}
+
+// ClearResult clears the value of the "result" field.
+func (u *QueuedJobUpsertOne) ClearResult() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.ClearResult()
+	})
+}
+
+// SetProgress sets the "progress" field.
+func (u *QueuedJobUpsertOne) SetProgress(v float64) *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetProgress(v)
+	})
+}
+
+// AddProgress adds v to the "progress" field.
+func (u *QueuedJobUpsertOne) AddProgress(v float64) *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.AddProgress(v)
+	})
+}
+
+// UpdateProgress sets the "progress" field to the value that was provided on create.
+func (u *QueuedJobUpsertOne) UpdateProgress() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateProgress()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *QueuedJobUpsertOne) SetError(v string) *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *QueuedJobUpsertOne) UpdateError() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *QueuedJobUpsertOne) ClearError() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (u *QueuedJobUpsertOne) SetRetryCount(v int) *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetRetryCount(v)
+	})
+}
+
+// AddRetryCount adds v to the "retry_count" field.
+func (u *QueuedJobUpsertOne) AddRetryCount(v int) *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.AddRetryCount(v)
+	})
+}
+
+// UpdateRetryCount sets the "retry_count" field to the value that was provided on create.
+func (u *QueuedJobUpsertOne) UpdateRetryCount() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateRetryCount()
+	})
+}
+
+// SetMaxRetries sets the "max_retries" field.
+func (u *QueuedJobUpsertOne) SetMaxRetries(v int) *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetMaxRetries(v)
+	})
+}
+
+// AddMaxRetries adds v to the "max_retries" field.
+func (u *QueuedJobUpsertOne) AddMaxRetries(v int) *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.AddMaxRetries(v)
+	})
+}
+
+// UpdateMaxRetries sets the "max_retries" field to the value that was provided on create.
+func (u *QueuedJobUpsertOne) UpdateMaxRetries() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateMaxRetries()
+	})
+}
+
+// SetRunAt sets the "run_at" field.
+func (u *QueuedJobUpsertOne) SetRunAt(v time.Time) *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetRunAt(v)
+	})
+}
+
+// UpdateRunAt sets the "run_at" field to the value that was provided on create.
+func (u *QueuedJobUpsertOne) UpdateRunAt() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateRunAt()
+	})
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (u *QueuedJobUpsertOne) SetLockedBy(v string) *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetLockedBy(v)
+	})
+}
+
+// UpdateLockedBy sets the "locked_by" field to the value that was provided on create.
+func (u *QueuedJobUpsertOne) UpdateLockedBy() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateLockedBy()
+	})
+}
+
+// ClearLockedBy clears the value of the "locked_by" field.
+func (u *QueuedJobUpsertOne) ClearLockedBy() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.ClearLockedBy()
+	})
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (u *QueuedJobUpsertOne) SetHeartbeatAt(v time.Time) *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetHeartbeatAt(v)
+	})
+}
+
+// UpdateHeartbeatAt sets the "heartbeat_at" field to the value that was provided on create.
+func (u *QueuedJobUpsertOne) UpdateHeartbeatAt() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateHeartbeatAt()
+	})
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (u *QueuedJobUpsertOne) ClearHeartbeatAt() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.ClearHeartbeatAt()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *QueuedJobUpsertOne) SetStartedAt(v time.Time) *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *QueuedJobUpsertOne) UpdateStartedAt() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *QueuedJobUpsertOne) ClearStartedAt() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *QueuedJobUpsertOne) SetCompletedAt(v time.Time) *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *QueuedJobUpsertOne) UpdateCompletedAt() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *QueuedJobUpsertOne) ClearCompletedAt() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *QueuedJobUpsertOne) SetCancelledAt(v time.Time) *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *QueuedJobUpsertOne) UpdateCancelledAt() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *QueuedJobUpsertOne) ClearCancelledAt() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QueuedJobUpsertOne) SetUpdatedAt(v time.Time) *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QueuedJobUpsertOne) UpdateUpdatedAt() *QueuedJobUpsertOne {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *QueuedJobUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QueuedJobCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QueuedJobUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *QueuedJobUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: QueuedJobUpsertOne.ID is not supported by MySQL driver. Use QueuedJobUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *QueuedJobUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// QueuedJobCreateBulk is the builder for creating many QueuedJob entities in bulk.
+type QueuedJobCreateBulk struct {
+	config
+	err      error
+	builders []*QueuedJobCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the QueuedJob entities in the database.
+func (_c *QueuedJobCreateBulk) Save(ctx context.Context) ([]*QueuedJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*QueuedJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QueuedJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *QueuedJobCreateBulk) SaveX(ctx context.Context) []*QueuedJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QueuedJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QueuedJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.QueuedJob.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QueuedJobUpsert) {
+//			SetQueue(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *QueuedJobCreateBulk) OnConflict(opts ...sql.ConflictOption) *QueuedJobUpsertBulk {
+	_c.conflict = opts
+	return &QueuedJobUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.QueuedJob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *QueuedJobCreateBulk) OnConflictColumns(columns ...string) *QueuedJobUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &QueuedJobUpsertBulk{
+		create: _c,
+	}
+}
+
+// QueuedJobUpsertBulk is the builder for "upsert"-ing
+// a bulk of QueuedJob nodes.
+type QueuedJobUpsertBulk struct {
+	create *QueuedJobCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.QueuedJob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(queuedjob.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QueuedJobUpsertBulk) UpdateNewValues() *QueuedJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(queuedjob.FieldID)
+			}
+			if _, exists := b.mutation.Queue(); exists {
+				s.SetIgnore(queuedjob.FieldQueue)
+			}
+			if _, exists := b.mutation.GetType(); exists {
+				s.SetIgnore(queuedjob.FieldType)
+			}
+			if _, exists := b.mutation.OwnerID(); exists {
+				s.SetIgnore(queuedjob.FieldOwnerID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(queuedjob.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.QueuedJob.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *QueuedJobUpsertBulk) Ignore() *QueuedJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QueuedJobUpsertBulk) DoNothing() *QueuedJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QueuedJobCreateBulk.OnConflict
+// documentation for more info.
+func (u *QueuedJobUpsertBulk) Update(set func(*QueuedJobUpsert)) *QueuedJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QueuedJobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *QueuedJobUpsertBulk) SetStatus(v queuedjob.Status) *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *QueuedJobUpsertBulk) UpdateStatus() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *QueuedJobUpsertBulk) SetPriority(v int) *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *QueuedJobUpsertBulk) AddPriority(v int) *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *QueuedJobUpsertBulk) UpdatePriority() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *QueuedJobUpsertBulk) SetPayload(v jsontext.Value) *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *QueuedJobUpsertBulk) UpdatePayload() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (u *QueuedJobUpsertBulk) ClearPayload() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.ClearPayload()
+	})
+}
+
+// SetResult sets the "result" field.
+func (u *QueuedJobUpsertBulk) SetResult(v jsontext.Value) *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetResult(v)
+	})
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *QueuedJobUpsertBulk) UpdateResult() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateResult()
+	})
+}
+
+// ClearResult clears the value of the "result" field.
+func (u *QueuedJobUpsertBulk) ClearResult() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.ClearResult()
+	})
+}
+
+// SetProgress sets the "progress" field.
+func (u *QueuedJobUpsertBulk) SetProgress(v float64) *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetProgress(v)
+	})
+}
+
+// AddProgress adds v to the "progress" field.
+func (u *QueuedJobUpsertBulk) AddProgress(v float64) *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.AddProgress(v)
+	})
+}
+
+// UpdateProgress sets the "progress" field to the value that was provided on create.
+func (u *QueuedJobUpsertBulk) UpdateProgress() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateProgress()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *QueuedJobUpsertBulk) SetError(v string) *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *QueuedJobUpsertBulk) UpdateError() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *QueuedJobUpsertBulk) ClearError() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (u *QueuedJobUpsertBulk) SetRetryCount(v int) *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetRetryCount(v)
+	})
+}
+
+// AddRetryCount adds v to the "retry_count" field.
+func (u *QueuedJobUpsertBulk) AddRetryCount(v int) *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.AddRetryCount(v)
+	})
+}
+
+// UpdateRetryCount sets the "retry_count" field to the value that was provided on create.
+func (u *QueuedJobUpsertBulk) UpdateRetryCount() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateRetryCount()
+	})
+}
+
+// SetMaxRetries sets the "max_retries" field.
+func (u *QueuedJobUpsertBulk) SetMaxRetries(v int) *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetMaxRetries(v)
+	})
+}
+
+// AddMaxRetries adds v to the "max_retries" field.
+func (u *QueuedJobUpsertBulk) AddMaxRetries(v int) *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.AddMaxRetries(v)
+	})
+}
+
+// UpdateMaxRetries sets the "max_retries" field to the value that was provided on create.
+func (u *QueuedJobUpsertBulk) UpdateMaxRetries() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateMaxRetries()
+	})
+}
+
+// SetRunAt sets the "run_at" field.
+func (u *QueuedJobUpsertBulk) SetRunAt(v time.Time) *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetRunAt(v)
+	})
+}
+
+// UpdateRunAt sets the "run_at" field to the value that was provided on create.
+func (u *QueuedJobUpsertBulk) UpdateRunAt() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateRunAt()
+	})
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (u *QueuedJobUpsertBulk) SetLockedBy(v string) *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetLockedBy(v)
+	})
+}
+
+// UpdateLockedBy sets the "locked_by" field to the value that was provided on create.
+func (u *QueuedJobUpsertBulk) UpdateLockedBy() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateLockedBy()
+	})
+}
+
+// ClearLockedBy clears the value of the "locked_by" field.
+func (u *QueuedJobUpsertBulk) ClearLockedBy() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.ClearLockedBy()
+	})
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (u *QueuedJobUpsertBulk) SetHeartbeatAt(v time.Time) *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetHeartbeatAt(v)
+	})
+}
+
+// UpdateHeartbeatAt sets the "heartbeat_at" field to the value that was provided on create.
+func (u *QueuedJobUpsertBulk) UpdateHeartbeatAt() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateHeartbeatAt()
+	})
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (u *QueuedJobUpsertBulk) ClearHeartbeatAt() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.ClearHeartbeatAt()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *QueuedJobUpsertBulk) SetStartedAt(v time.Time) *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *QueuedJobUpsertBulk) UpdateStartedAt() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *QueuedJobUpsertBulk) ClearStartedAt() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *QueuedJobUpsertBulk) SetCompletedAt(v time.Time) *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *QueuedJobUpsertBulk) UpdateCompletedAt() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *QueuedJobUpsertBulk) ClearCompletedAt() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *QueuedJobUpsertBulk) SetCancelledAt(v time.Time) *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *QueuedJobUpsertBulk) UpdateCancelledAt() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *QueuedJobUpsertBulk) ClearCancelledAt() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QueuedJobUpsertBulk) SetUpdatedAt(v time.Time) *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QueuedJobUpsertBulk) UpdateUpdatedAt() *QueuedJobUpsertBulk {
+	return u.Update(func(s *QueuedJobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *QueuedJobUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the QueuedJobCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QueuedJobCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QueuedJobUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
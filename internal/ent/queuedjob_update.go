@@ -0,0 +1,958 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/predicate"
+	"clockzen-next/internal/ent/queuedjob"
+	"context"
+	"encoding/json/jsontext"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// QueuedJobUpdate is the builder for updating QueuedJob entities.
+type QueuedJobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QueuedJobMutation
+}
+
+// Where appends a list predicates to the QueuedJobUpdate builder.
+func (_u *QueuedJobUpdate) Where(ps ...predicate.QueuedJob) *QueuedJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *QueuedJobUpdate) SetStatus(v queuedjob.Status) *QueuedJobUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *QueuedJobUpdate) SetNillableStatus(v *queuedjob.Status) *QueuedJobUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *QueuedJobUpdate) SetPriority(v int) *QueuedJobUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *QueuedJobUpdate) SetNillablePriority(v *int) *QueuedJobUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *QueuedJobUpdate) AddPriority(v int) *QueuedJobUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *QueuedJobUpdate) SetPayload(v jsontext.Value) *QueuedJobUpdate {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// AppendPayload appends value to the "payload" field.
+func (_u *QueuedJobUpdate) AppendPayload(v jsontext.Value) *QueuedJobUpdate {
+	_u.mutation.AppendPayload(v)
+	return _u
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (_u *QueuedJobUpdate) ClearPayload() *QueuedJobUpdate {
+	_u.mutation.ClearPayload()
+	return _u
+}
+
+// SetResult sets the "result" field.
+func (_u *QueuedJobUpdate) SetResult(v jsontext.Value) *QueuedJobUpdate {
+	_u.mutation.SetResult(v)
+	return _u
+}
+
+// AppendResult appends value to the "result" field.
+func (_u *QueuedJobUpdate) AppendResult(v jsontext.Value) *QueuedJobUpdate {
+	_u.mutation.AppendResult(v)
+	return _u
+}
+
+// ClearResult clears the value of the "result" field.
+func (_u *QueuedJobUpdate) ClearResult() *QueuedJobUpdate {
+	_u.mutation.ClearResult()
+	return _u
+}
+
+// SetProgress sets the "progress" field.
+func (_u *QueuedJobUpdate) SetProgress(v float64) *QueuedJobUpdate {
+	_u.mutation.ResetProgress()
+	_u.mutation.SetProgress(v)
+	return _u
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (_u *QueuedJobUpdate) SetNillableProgress(v *float64) *QueuedJobUpdate {
+	if v != nil {
+		_u.SetProgress(*v)
+	}
+	return _u
+}
+
+// AddProgress adds value to the "progress" field.
+func (_u *QueuedJobUpdate) AddProgress(v float64) *QueuedJobUpdate {
+	_u.mutation.AddProgress(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *QueuedJobUpdate) SetError(v string) *QueuedJobUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *QueuedJobUpdate) SetNillableError(v *string) *QueuedJobUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *QueuedJobUpdate) ClearError() *QueuedJobUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (_u *QueuedJobUpdate) SetRetryCount(v int) *QueuedJobUpdate {
+	_u.mutation.ResetRetryCount()
+	_u.mutation.SetRetryCount(v)
+	return _u
+}
+
+// SetNillableRetryCount sets the "retry_count" field if the given value is not nil.
+func (_u *QueuedJobUpdate) SetNillableRetryCount(v *int) *QueuedJobUpdate {
+	if v != nil {
+		_u.SetRetryCount(*v)
+	}
+	return _u
+}
+
+// AddRetryCount adds value to the "retry_count" field.
+func (_u *QueuedJobUpdate) AddRetryCount(v int) *QueuedJobUpdate {
+	_u.mutation.AddRetryCount(v)
+	return _u
+}
+
+// SetMaxRetries sets the "max_retries" field.
+func (_u *QueuedJobUpdate) SetMaxRetries(v int) *QueuedJobUpdate {
+	_u.mutation.ResetMaxRetries()
+	_u.mutation.SetMaxRetries(v)
+	return _u
+}
+
+// SetNillableMaxRetries sets the "max_retries" field if the given value is not nil.
+func (_u *QueuedJobUpdate) SetNillableMaxRetries(v *int) *QueuedJobUpdate {
+	if v != nil {
+		_u.SetMaxRetries(*v)
+	}
+	return _u
+}
+
+// AddMaxRetries adds value to the "max_retries" field.
+func (_u *QueuedJobUpdate) AddMaxRetries(v int) *QueuedJobUpdate {
+	_u.mutation.AddMaxRetries(v)
+	return _u
+}
+
+// SetRunAt sets the "run_at" field.
+func (_u *QueuedJobUpdate) SetRunAt(v time.Time) *QueuedJobUpdate {
+	_u.mutation.SetRunAt(v)
+	return _u
+}
+
+// SetNillableRunAt sets the "run_at" field if the given value is not nil.
+func (_u *QueuedJobUpdate) SetNillableRunAt(v *time.Time) *QueuedJobUpdate {
+	if v != nil {
+		_u.SetRunAt(*v)
+	}
+	return _u
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (_u *QueuedJobUpdate) SetLockedBy(v string) *QueuedJobUpdate {
+	_u.mutation.SetLockedBy(v)
+	return _u
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (_u *QueuedJobUpdate) SetNillableLockedBy(v *string) *QueuedJobUpdate {
+	if v != nil {
+		_u.SetLockedBy(*v)
+	}
+	return _u
+}
+
+// ClearLockedBy clears the value of the "locked_by" field.
+func (_u *QueuedJobUpdate) ClearLockedBy() *QueuedJobUpdate {
+	_u.mutation.ClearLockedBy()
+	return _u
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (_u *QueuedJobUpdate) SetHeartbeatAt(v time.Time) *QueuedJobUpdate {
+	_u.mutation.SetHeartbeatAt(v)
+	return _u
+}
+
+// SetNillableHeartbeatAt sets the "heartbeat_at" field if the given value is not nil.
+func (_u *QueuedJobUpdate) SetNillableHeartbeatAt(v *time.Time) *QueuedJobUpdate {
+	if v != nil {
+		_u.SetHeartbeatAt(*v)
+	}
+	return _u
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (_u *QueuedJobUpdate) ClearHeartbeatAt() *QueuedJobUpdate {
+	_u.mutation.ClearHeartbeatAt()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *QueuedJobUpdate) SetStartedAt(v time.Time) *QueuedJobUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *QueuedJobUpdate) SetNillableStartedAt(v *time.Time) *QueuedJobUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *QueuedJobUpdate) ClearStartedAt() *QueuedJobUpdate {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *QueuedJobUpdate) SetCompletedAt(v time.Time) *QueuedJobUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *QueuedJobUpdate) SetNillableCompletedAt(v *time.Time) *QueuedJobUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *QueuedJobUpdate) ClearCompletedAt() *QueuedJobUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *QueuedJobUpdate) SetCancelledAt(v time.Time) *QueuedJobUpdate {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *QueuedJobUpdate) SetNillableCancelledAt(v *time.Time) *QueuedJobUpdate {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *QueuedJobUpdate) ClearCancelledAt() *QueuedJobUpdate {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *QueuedJobUpdate) SetUpdatedAt(v time.Time) *QueuedJobUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the QueuedJobMutation object of the builder.
+func (_u *QueuedJobUpdate) Mutation() *QueuedJobMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *QueuedJobUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QueuedJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *QueuedJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QueuedJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *QueuedJobUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := queuedjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QueuedJobUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := queuedjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "QueuedJob.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *QueuedJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(queuedjob.Table, queuedjob.Columns, sqlgraph.NewFieldSpec(queuedjob.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(queuedjob.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(queuedjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(queuedjob.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(queuedjob.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(queuedjob.FieldPayload, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPayload(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, queuedjob.FieldPayload, value)
+		})
+	}
+	if _u.mutation.PayloadCleared() {
+		_spec.ClearField(queuedjob.FieldPayload, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Result(); ok {
+		_spec.SetField(queuedjob.FieldResult, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedResult(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, queuedjob.FieldResult, value)
+		})
+	}
+	if _u.mutation.ResultCleared() {
+		_spec.ClearField(queuedjob.FieldResult, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Progress(); ok {
+		_spec.SetField(queuedjob.FieldProgress, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedProgress(); ok {
+		_spec.AddField(queuedjob.FieldProgress, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(queuedjob.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(queuedjob.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.RetryCount(); ok {
+		_spec.SetField(queuedjob.FieldRetryCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRetryCount(); ok {
+		_spec.AddField(queuedjob.FieldRetryCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxRetries(); ok {
+		_spec.SetField(queuedjob.FieldMaxRetries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRetries(); ok {
+		_spec.AddField(queuedjob.FieldMaxRetries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RunAt(); ok {
+		_spec.SetField(queuedjob.FieldRunAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LockedBy(); ok {
+		_spec.SetField(queuedjob.FieldLockedBy, field.TypeString, value)
+	}
+	if _u.mutation.LockedByCleared() {
+		_spec.ClearField(queuedjob.FieldLockedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.HeartbeatAt(); ok {
+		_spec.SetField(queuedjob.FieldHeartbeatAt, field.TypeTime, value)
+	}
+	if _u.mutation.HeartbeatAtCleared() {
+		_spec.ClearField(queuedjob.FieldHeartbeatAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(queuedjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(queuedjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(queuedjob.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(queuedjob.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(queuedjob.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(queuedjob.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(queuedjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{queuedjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// QueuedJobUpdateOne is the builder for updating a single QueuedJob entity.
+type QueuedJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QueuedJobMutation
+}
+
+// SetStatus sets the "status" field.
+func (_u *QueuedJobUpdateOne) SetStatus(v queuedjob.Status) *QueuedJobUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *QueuedJobUpdateOne) SetNillableStatus(v *queuedjob.Status) *QueuedJobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *QueuedJobUpdateOne) SetPriority(v int) *QueuedJobUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *QueuedJobUpdateOne) SetNillablePriority(v *int) *QueuedJobUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *QueuedJobUpdateOne) AddPriority(v int) *QueuedJobUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *QueuedJobUpdateOne) SetPayload(v jsontext.Value) *QueuedJobUpdateOne {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// AppendPayload appends value to the "payload" field.
+func (_u *QueuedJobUpdateOne) AppendPayload(v jsontext.Value) *QueuedJobUpdateOne {
+	_u.mutation.AppendPayload(v)
+	return _u
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (_u *QueuedJobUpdateOne) ClearPayload() *QueuedJobUpdateOne {
+	_u.mutation.ClearPayload()
+	return _u
+}
+
+// SetResult sets the "result" field.
+func (_u *QueuedJobUpdateOne) SetResult(v jsontext.Value) *QueuedJobUpdateOne {
+	_u.mutation.SetResult(v)
+	return _u
+}
+
+// AppendResult appends value to the "result" field.
+func (_u *QueuedJobUpdateOne) AppendResult(v jsontext.Value) *QueuedJobUpdateOne {
+	_u.mutation.AppendResult(v)
+	return _u
+}
+
+// ClearResult clears the value of the "result" field.
+func (_u *QueuedJobUpdateOne) ClearResult() *QueuedJobUpdateOne {
+	_u.mutation.ClearResult()
+	return _u
+}
+
+// SetProgress sets the "progress" field.
+func (_u *QueuedJobUpdateOne) SetProgress(v float64) *QueuedJobUpdateOne {
+	_u.mutation.ResetProgress()
+	_u.mutation.SetProgress(v)
+	return _u
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (_u *QueuedJobUpdateOne) SetNillableProgress(v *float64) *QueuedJobUpdateOne {
+	if v != nil {
+		_u.SetProgress(*v)
+	}
+	return _u
+}
+
+// AddProgress adds value to the "progress" field.
+func (_u *QueuedJobUpdateOne) AddProgress(v float64) *QueuedJobUpdateOne {
+	_u.mutation.AddProgress(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *QueuedJobUpdateOne) SetError(v string) *QueuedJobUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *QueuedJobUpdateOne) SetNillableError(v *string) *QueuedJobUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *QueuedJobUpdateOne) ClearError() *QueuedJobUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (_u *QueuedJobUpdateOne) SetRetryCount(v int) *QueuedJobUpdateOne {
+	_u.mutation.ResetRetryCount()
+	_u.mutation.SetRetryCount(v)
+	return _u
+}
+
+// SetNillableRetryCount sets the "retry_count" field if the given value is not nil.
+func (_u *QueuedJobUpdateOne) SetNillableRetryCount(v *int) *QueuedJobUpdateOne {
+	if v != nil {
+		_u.SetRetryCount(*v)
+	}
+	return _u
+}
+
+// AddRetryCount adds value to the "retry_count" field.
+func (_u *QueuedJobUpdateOne) AddRetryCount(v int) *QueuedJobUpdateOne {
+	_u.mutation.AddRetryCount(v)
+	return _u
+}
+
+// SetMaxRetries sets the "max_retries" field.
+func (_u *QueuedJobUpdateOne) SetMaxRetries(v int) *QueuedJobUpdateOne {
+	_u.mutation.ResetMaxRetries()
+	_u.mutation.SetMaxRetries(v)
+	return _u
+}
+
+// SetNillableMaxRetries sets the "max_retries" field if the given value is not nil.
+func (_u *QueuedJobUpdateOne) SetNillableMaxRetries(v *int) *QueuedJobUpdateOne {
+	if v != nil {
+		_u.SetMaxRetries(*v)
+	}
+	return _u
+}
+
+// AddMaxRetries adds value to the "max_retries" field.
+func (_u *QueuedJobUpdateOne) AddMaxRetries(v int) *QueuedJobUpdateOne {
+	_u.mutation.AddMaxRetries(v)
+	return _u
+}
+
+// SetRunAt sets the "run_at" field.
+func (_u *QueuedJobUpdateOne) SetRunAt(v time.Time) *QueuedJobUpdateOne {
+	_u.mutation.SetRunAt(v)
+	return _u
+}
+
+// SetNillableRunAt sets the "run_at" field if the given value is not nil.
+func (_u *QueuedJobUpdateOne) SetNillableRunAt(v *time.Time) *QueuedJobUpdateOne {
+	if v != nil {
+		_u.SetRunAt(*v)
+	}
+	return _u
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (_u *QueuedJobUpdateOne) SetLockedBy(v string) *QueuedJobUpdateOne {
+	_u.mutation.SetLockedBy(v)
+	return _u
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (_u *QueuedJobUpdateOne) SetNillableLockedBy(v *string) *QueuedJobUpdateOne {
+	if v != nil {
+		_u.SetLockedBy(*v)
+	}
+	return _u
+}
+
+// ClearLockedBy clears the value of the "locked_by" field.
+func (_u *QueuedJobUpdateOne) ClearLockedBy() *QueuedJobUpdateOne {
+	_u.mutation.ClearLockedBy()
+	return _u
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (_u *QueuedJobUpdateOne) SetHeartbeatAt(v time.Time) *QueuedJobUpdateOne {
+	_u.mutation.SetHeartbeatAt(v)
+	return _u
+}
+
+// SetNillableHeartbeatAt sets the "heartbeat_at" field if the given value is not nil.
+func (_u *QueuedJobUpdateOne) SetNillableHeartbeatAt(v *time.Time) *QueuedJobUpdateOne {
+	if v != nil {
+		_u.SetHeartbeatAt(*v)
+	}
+	return _u
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (_u *QueuedJobUpdateOne) ClearHeartbeatAt() *QueuedJobUpdateOne {
+	_u.mutation.ClearHeartbeatAt()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *QueuedJobUpdateOne) SetStartedAt(v time.Time) *QueuedJobUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *QueuedJobUpdateOne) SetNillableStartedAt(v *time.Time) *QueuedJobUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *QueuedJobUpdateOne) ClearStartedAt() *QueuedJobUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *QueuedJobUpdateOne) SetCompletedAt(v time.Time) *QueuedJobUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *QueuedJobUpdateOne) SetNillableCompletedAt(v *time.Time) *QueuedJobUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *QueuedJobUpdateOne) ClearCompletedAt() *QueuedJobUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *QueuedJobUpdateOne) SetCancelledAt(v time.Time) *QueuedJobUpdateOne {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *QueuedJobUpdateOne) SetNillableCancelledAt(v *time.Time) *QueuedJobUpdateOne {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *QueuedJobUpdateOne) ClearCancelledAt() *QueuedJobUpdateOne {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *QueuedJobUpdateOne) SetUpdatedAt(v time.Time) *QueuedJobUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the QueuedJobMutation object of the builder.
+func (_u *QueuedJobUpdateOne) Mutation() *QueuedJobMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the QueuedJobUpdate builder.
+func (_u *QueuedJobUpdateOne) Where(ps ...predicate.QueuedJob) *QueuedJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *QueuedJobUpdateOne) Select(field string, fields ...string) *QueuedJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated QueuedJob entity.
+func (_u *QueuedJobUpdateOne) Save(ctx context.Context) (*QueuedJob, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QueuedJobUpdateOne) SaveX(ctx context.Context) *QueuedJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *QueuedJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QueuedJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *QueuedJobUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := queuedjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QueuedJobUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := queuedjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "QueuedJob.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *QueuedJobUpdateOne) sqlSave(ctx context.Context) (_node *QueuedJob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(queuedjob.Table, queuedjob.Columns, sqlgraph.NewFieldSpec(queuedjob.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QueuedJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, queuedjob.FieldID)
+		for _, f := range fields {
+			if !queuedjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != queuedjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(queuedjob.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(queuedjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(queuedjob.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(queuedjob.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(queuedjob.FieldPayload, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPayload(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, queuedjob.FieldPayload, value)
+		})
+	}
+	if _u.mutation.PayloadCleared() {
+		_spec.ClearField(queuedjob.FieldPayload, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Result(); ok {
+		_spec.SetField(queuedjob.FieldResult, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedResult(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, queuedjob.FieldResult, value)
+		})
+	}
+	if _u.mutation.ResultCleared() {
+		_spec.ClearField(queuedjob.FieldResult, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Progress(); ok {
+		_spec.SetField(queuedjob.FieldProgress, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedProgress(); ok {
+		_spec.AddField(queuedjob.FieldProgress, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(queuedjob.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(queuedjob.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.RetryCount(); ok {
+		_spec.SetField(queuedjob.FieldRetryCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRetryCount(); ok {
+		_spec.AddField(queuedjob.FieldRetryCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxRetries(); ok {
+		_spec.SetField(queuedjob.FieldMaxRetries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRetries(); ok {
+		_spec.AddField(queuedjob.FieldMaxRetries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RunAt(); ok {
+		_spec.SetField(queuedjob.FieldRunAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LockedBy(); ok {
+		_spec.SetField(queuedjob.FieldLockedBy, field.TypeString, value)
+	}
+	if _u.mutation.LockedByCleared() {
+		_spec.ClearField(queuedjob.FieldLockedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.HeartbeatAt(); ok {
+		_spec.SetField(queuedjob.FieldHeartbeatAt, field.TypeTime, value)
+	}
+	if _u.mutation.HeartbeatAtCleared() {
+		_spec.ClearField(queuedjob.FieldHeartbeatAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(queuedjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(queuedjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(queuedjob.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(queuedjob.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(queuedjob.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(queuedjob.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(queuedjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &QueuedJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{queuedjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
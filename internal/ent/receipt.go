@@ -76,6 +76,8 @@ type Receipt struct {
 	Notes *string `json:"notes,omitempty"`
 	// ID from legacy system for migration tracking
 	LegacyID *string `json:"legacy_id,omitempty"`
+	// ID of the legacy migration run that created the receipt, so the run can be rolled back
+	MigrationRunID *string `json:"migration_run_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -130,7 +132,7 @@ func (*Receipt) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullFloat64)
 		case receipt.FieldFileSize:
 			values[i] = new(sql.NullInt64)
-		case receipt.FieldID, receipt.FieldUserID, receipt.FieldSourceType, receipt.FieldSourceID, receipt.FieldSourceConnectionID, receipt.FieldFileName, receipt.FieldFilePath, receipt.FieldMimeType, receipt.FieldStorageBucket, receipt.FieldStorageKey, receipt.FieldThumbnailPath, receipt.FieldStatus, receipt.FieldOcrText, receipt.FieldMerchantName, receipt.FieldMerchantAddress, receipt.FieldCurrency, receipt.FieldPaymentMethod, receipt.FieldReceiptNumber, receipt.FieldNotes, receipt.FieldLegacyID:
+		case receipt.FieldID, receipt.FieldUserID, receipt.FieldSourceType, receipt.FieldSourceID, receipt.FieldSourceConnectionID, receipt.FieldFileName, receipt.FieldFilePath, receipt.FieldMimeType, receipt.FieldStorageBucket, receipt.FieldStorageKey, receipt.FieldThumbnailPath, receipt.FieldStatus, receipt.FieldOcrText, receipt.FieldMerchantName, receipt.FieldMerchantAddress, receipt.FieldCurrency, receipt.FieldPaymentMethod, receipt.FieldReceiptNumber, receipt.FieldNotes, receipt.FieldLegacyID, receipt.FieldMigrationRunID:
 			values[i] = new(sql.NullString)
 		case receipt.FieldReceiptDate, receipt.FieldCreatedAt, receipt.FieldUpdatedAt, receipt.FieldProcessedAt:
 			values[i] = new(sql.NullTime)
@@ -353,6 +355,13 @@ func (_m *Receipt) assignValues(columns []string, values []any) error {
 				_m.LegacyID = new(string)
 				*_m.LegacyID = value.String
 			}
+		case receipt.FieldMigrationRunID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field migration_run_id", values[i])
+			} else if value.Valid {
+				_m.MigrationRunID = new(string)
+				*_m.MigrationRunID = value.String
+			}
 		case receipt.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -541,6 +550,11 @@ func (_m *Receipt) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.MigrationRunID; v != nil {
+		builder.WriteString("migration_run_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
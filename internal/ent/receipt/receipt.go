@@ -73,6 +73,8 @@ const (
 	FieldNotes = "notes"
 	// FieldLegacyID holds the string denoting the legacy_id field in the database.
 	FieldLegacyID = "legacy_id"
+	// FieldMigrationRunID holds the string denoting the migration_run_id field in the database.
+	FieldMigrationRunID = "migration_run_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -133,6 +135,7 @@ var Columns = []string{
 	FieldMetadata,
 	FieldNotes,
 	FieldLegacyID,
+	FieldMigrationRunID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldProcessedAt,
@@ -361,6 +364,11 @@ func ByLegacyID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLegacyID, opts...).ToFunc()
 }
 
+// ByMigrationRunID orders the results by the migration_run_id field.
+func ByMigrationRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMigrationRunID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
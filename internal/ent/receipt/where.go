@@ -185,6 +185,11 @@ func LegacyID(v string) predicate.Receipt {
 	return predicate.Receipt(sql.FieldEQ(FieldLegacyID, v))
 }
 
+// MigrationRunID applies equality check predicate on the "migration_run_id" field. It's identical to MigrationRunIDEQ.
+func MigrationRunID(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldMigrationRunID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Receipt {
 	return predicate.Receipt(sql.FieldEQ(FieldCreatedAt, v))
@@ -1815,6 +1820,81 @@ func LegacyIDContainsFold(v string) predicate.Receipt {
 	return predicate.Receipt(sql.FieldContainsFold(FieldLegacyID, v))
 }
 
+// MigrationRunIDEQ applies the EQ predicate on the "migration_run_id" field.
+func MigrationRunIDEQ(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDNEQ applies the NEQ predicate on the "migration_run_id" field.
+func MigrationRunIDNEQ(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNEQ(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDIn applies the In predicate on the "migration_run_id" field.
+func MigrationRunIDIn(vs ...string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldIn(FieldMigrationRunID, vs...))
+}
+
+// MigrationRunIDNotIn applies the NotIn predicate on the "migration_run_id" field.
+func MigrationRunIDNotIn(vs ...string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotIn(FieldMigrationRunID, vs...))
+}
+
+// MigrationRunIDGT applies the GT predicate on the "migration_run_id" field.
+func MigrationRunIDGT(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGT(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDGTE applies the GTE predicate on the "migration_run_id" field.
+func MigrationRunIDGTE(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGTE(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDLT applies the LT predicate on the "migration_run_id" field.
+func MigrationRunIDLT(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLT(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDLTE applies the LTE predicate on the "migration_run_id" field.
+func MigrationRunIDLTE(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLTE(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDContains applies the Contains predicate on the "migration_run_id" field.
+func MigrationRunIDContains(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldContains(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDHasPrefix applies the HasPrefix predicate on the "migration_run_id" field.
+func MigrationRunIDHasPrefix(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldHasPrefix(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDHasSuffix applies the HasSuffix predicate on the "migration_run_id" field.
+func MigrationRunIDHasSuffix(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldHasSuffix(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDIsNil applies the IsNil predicate on the "migration_run_id" field.
+func MigrationRunIDIsNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldIsNull(FieldMigrationRunID))
+}
+
+// MigrationRunIDNotNil applies the NotNil predicate on the "migration_run_id" field.
+func MigrationRunIDNotNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotNull(FieldMigrationRunID))
+}
+
+// MigrationRunIDEqualFold applies the EqualFold predicate on the "migration_run_id" field.
+func MigrationRunIDEqualFold(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEqualFold(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDContainsFold applies the ContainsFold predicate on the "migration_run_id" field.
+func MigrationRunIDContainsFold(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldContainsFold(FieldMigrationRunID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Receipt {
 	return predicate.Receipt(sql.FieldEQ(FieldCreatedAt, v))
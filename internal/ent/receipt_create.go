@@ -11,6 +11,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -20,6 +22,7 @@ type ReceiptCreate struct {
 	config
 	mutation *ReceiptMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUserID sets the "user_id" field.
@@ -372,6 +375,20 @@ func (_c *ReceiptCreate) SetNillableLegacyID(v *string) *ReceiptCreate {
 	return _c
 }
 
+// SetMigrationRunID sets the "migration_run_id" field.
+func (_c *ReceiptCreate) SetMigrationRunID(v string) *ReceiptCreate {
+	_c.mutation.SetMigrationRunID(v)
+	return _c
+}
+
+// SetNillableMigrationRunID sets the "migration_run_id" field if the given value is not nil.
+func (_c *ReceiptCreate) SetNillableMigrationRunID(v *string) *ReceiptCreate {
+	if v != nil {
+		_c.SetMigrationRunID(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *ReceiptCreate) SetCreatedAt(v time.Time) *ReceiptCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -596,6 +613,7 @@ func (_c *ReceiptCreate) createSpec() (*Receipt, *sqlgraph.CreateSpec) {
 		_node = &Receipt{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(receipt.Table, sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -716,6 +734,10 @@ func (_c *ReceiptCreate) createSpec() (*Receipt, *sqlgraph.CreateSpec) {
 		_spec.SetField(receipt.FieldLegacyID, field.TypeString, value)
 		_node.LegacyID = &value
 	}
+	if value, ok := _c.mutation.MigrationRunID(); ok {
+		_spec.SetField(receipt.FieldMigrationRunID, field.TypeString, value)
+		_node.MigrationRunID = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(receipt.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -763,86 +785,2203 @@ func (_c *ReceiptCreate) createSpec() (*Receipt, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
-// ReceiptCreateBulk is the builder for creating many Receipt entities in bulk.
-type ReceiptCreateBulk struct {
-	config
-	err      error
-	builders []*ReceiptCreate
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Receipt.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReceiptUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReceiptCreate) OnConflict(opts ...sql.ConflictOption) *ReceiptUpsertOne {
+	_c.conflict = opts
+	return &ReceiptUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Receipt.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReceiptCreate) OnConflictColumns(columns ...string) *ReceiptUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReceiptUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReceiptUpsertOne is the builder for "upsert"-ing
+	//  one Receipt node.
+	ReceiptUpsertOne struct {
+		create *ReceiptCreate
+	}
+
+	// ReceiptUpsert is the "OnConflict" setter.
+	ReceiptUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *ReceiptUpsert) SetUserID(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldUserID, v)
+	return u
 }
 
-// Save creates the Receipt entities in the database.
-func (_c *ReceiptCreateBulk) Save(ctx context.Context) ([]*Receipt, error) {
-	if _c.err != nil {
-		return nil, _c.err
-	}
-	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
-	nodes := make([]*Receipt, len(_c.builders))
-	mutators := make([]Mutator, len(_c.builders))
-	for i := range _c.builders {
-		func(i int, root context.Context) {
-			builder := _c.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*ReceiptMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				mutation.done = true
-				return nodes[i], nil
-			})
-			for i := len(builder.hooks) - 1; i >= 0; i-- {
-				mut = builder.hooks[i](mut)
-			}
-			mutators[i] = mut
-		}(i, ctx)
-	}
-	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
-			return nil, err
-		}
-	}
-	return nodes, nil
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateUserID() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldUserID)
+	return u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_c *ReceiptCreateBulk) SaveX(ctx context.Context) []*Receipt {
-	v, err := _c.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
+// SetSourceType sets the "source_type" field.
+func (u *ReceiptUpsert) SetSourceType(v receipt.SourceType) *ReceiptUpsert {
+	u.Set(receipt.FieldSourceType, v)
+	return u
 }
 
-// Exec executes the query.
-func (_c *ReceiptCreateBulk) Exec(ctx context.Context) error {
-	_, err := _c.Save(ctx)
-	return err
+// UpdateSourceType sets the "source_type" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateSourceType() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldSourceType)
+	return u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_c *ReceiptCreateBulk) ExecX(ctx context.Context) {
-	if err := _c.Exec(ctx); err != nil {
+// SetSourceID sets the "source_id" field.
+func (u *ReceiptUpsert) SetSourceID(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldSourceID, v)
+	return u
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateSourceID() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldSourceID)
+	return u
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (u *ReceiptUpsert) ClearSourceID() *ReceiptUpsert {
+	u.SetNull(receipt.FieldSourceID)
+	return u
+}
+
+// SetSourceConnectionID sets the "source_connection_id" field.
+func (u *ReceiptUpsert) SetSourceConnectionID(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldSourceConnectionID, v)
+	return u
+}
+
+// UpdateSourceConnectionID sets the "source_connection_id" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateSourceConnectionID() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldSourceConnectionID)
+	return u
+}
+
+// ClearSourceConnectionID clears the value of the "source_connection_id" field.
+func (u *ReceiptUpsert) ClearSourceConnectionID() *ReceiptUpsert {
+	u.SetNull(receipt.FieldSourceConnectionID)
+	return u
+}
+
+// SetFileName sets the "file_name" field.
+func (u *ReceiptUpsert) SetFileName(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldFileName, v)
+	return u
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateFileName() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldFileName)
+	return u
+}
+
+// SetFilePath sets the "file_path" field.
+func (u *ReceiptUpsert) SetFilePath(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldFilePath, v)
+	return u
+}
+
+// UpdateFilePath sets the "file_path" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateFilePath() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldFilePath)
+	return u
+}
+
+// ClearFilePath clears the value of the "file_path" field.
+func (u *ReceiptUpsert) ClearFilePath() *ReceiptUpsert {
+	u.SetNull(receipt.FieldFilePath)
+	return u
+}
+
+// SetMimeType sets the "mime_type" field.
+func (u *ReceiptUpsert) SetMimeType(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldMimeType, v)
+	return u
+}
+
+// UpdateMimeType sets the "mime_type" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateMimeType() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldMimeType)
+	return u
+}
+
+// SetFileSize sets the "file_size" field.
+func (u *ReceiptUpsert) SetFileSize(v int64) *ReceiptUpsert {
+	u.Set(receipt.FieldFileSize, v)
+	return u
+}
+
+// UpdateFileSize sets the "file_size" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateFileSize() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldFileSize)
+	return u
+}
+
+// AddFileSize adds v to the "file_size" field.
+func (u *ReceiptUpsert) AddFileSize(v int64) *ReceiptUpsert {
+	u.Add(receipt.FieldFileSize, v)
+	return u
+}
+
+// SetStorageBucket sets the "storage_bucket" field.
+func (u *ReceiptUpsert) SetStorageBucket(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldStorageBucket, v)
+	return u
+}
+
+// UpdateStorageBucket sets the "storage_bucket" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateStorageBucket() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldStorageBucket)
+	return u
+}
+
+// ClearStorageBucket clears the value of the "storage_bucket" field.
+func (u *ReceiptUpsert) ClearStorageBucket() *ReceiptUpsert {
+	u.SetNull(receipt.FieldStorageBucket)
+	return u
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (u *ReceiptUpsert) SetStorageKey(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldStorageKey, v)
+	return u
+}
+
+// UpdateStorageKey sets the "storage_key" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateStorageKey() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldStorageKey)
+	return u
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (u *ReceiptUpsert) ClearStorageKey() *ReceiptUpsert {
+	u.SetNull(receipt.FieldStorageKey)
+	return u
+}
+
+// SetThumbnailPath sets the "thumbnail_path" field.
+func (u *ReceiptUpsert) SetThumbnailPath(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldThumbnailPath, v)
+	return u
+}
+
+// UpdateThumbnailPath sets the "thumbnail_path" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateThumbnailPath() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldThumbnailPath)
+	return u
+}
+
+// ClearThumbnailPath clears the value of the "thumbnail_path" field.
+func (u *ReceiptUpsert) ClearThumbnailPath() *ReceiptUpsert {
+	u.SetNull(receipt.FieldThumbnailPath)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ReceiptUpsert) SetStatus(v receipt.Status) *ReceiptUpsert {
+	u.Set(receipt.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateStatus() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldStatus)
+	return u
+}
+
+// SetOcrCompleted sets the "ocr_completed" field.
+func (u *ReceiptUpsert) SetOcrCompleted(v bool) *ReceiptUpsert {
+	u.Set(receipt.FieldOcrCompleted, v)
+	return u
+}
+
+// UpdateOcrCompleted sets the "ocr_completed" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateOcrCompleted() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldOcrCompleted)
+	return u
+}
+
+// SetOcrText sets the "ocr_text" field.
+func (u *ReceiptUpsert) SetOcrText(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldOcrText, v)
+	return u
+}
+
+// UpdateOcrText sets the "ocr_text" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateOcrText() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldOcrText)
+	return u
+}
+
+// ClearOcrText clears the value of the "ocr_text" field.
+func (u *ReceiptUpsert) ClearOcrText() *ReceiptUpsert {
+	u.SetNull(receipt.FieldOcrText)
+	return u
+}
+
+// SetOcrConfidence sets the "ocr_confidence" field.
+func (u *ReceiptUpsert) SetOcrConfidence(v float64) *ReceiptUpsert {
+	u.Set(receipt.FieldOcrConfidence, v)
+	return u
+}
+
+// UpdateOcrConfidence sets the "ocr_confidence" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateOcrConfidence() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldOcrConfidence)
+	return u
+}
+
+// AddOcrConfidence adds v to the "ocr_confidence" field.
+func (u *ReceiptUpsert) AddOcrConfidence(v float64) *ReceiptUpsert {
+	u.Add(receipt.FieldOcrConfidence, v)
+	return u
+}
+
+// ClearOcrConfidence clears the value of the "ocr_confidence" field.
+func (u *ReceiptUpsert) ClearOcrConfidence() *ReceiptUpsert {
+	u.SetNull(receipt.FieldOcrConfidence)
+	return u
+}
+
+// SetMerchantName sets the "merchant_name" field.
+func (u *ReceiptUpsert) SetMerchantName(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldMerchantName, v)
+	return u
+}
+
+// UpdateMerchantName sets the "merchant_name" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateMerchantName() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldMerchantName)
+	return u
+}
+
+// ClearMerchantName clears the value of the "merchant_name" field.
+func (u *ReceiptUpsert) ClearMerchantName() *ReceiptUpsert {
+	u.SetNull(receipt.FieldMerchantName)
+	return u
+}
+
+// SetMerchantAddress sets the "merchant_address" field.
+func (u *ReceiptUpsert) SetMerchantAddress(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldMerchantAddress, v)
+	return u
+}
+
+// UpdateMerchantAddress sets the "merchant_address" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateMerchantAddress() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldMerchantAddress)
+	return u
+}
+
+// ClearMerchantAddress clears the value of the "merchant_address" field.
+func (u *ReceiptUpsert) ClearMerchantAddress() *ReceiptUpsert {
+	u.SetNull(receipt.FieldMerchantAddress)
+	return u
+}
+
+// SetReceiptDate sets the "receipt_date" field.
+func (u *ReceiptUpsert) SetReceiptDate(v time.Time) *ReceiptUpsert {
+	u.Set(receipt.FieldReceiptDate, v)
+	return u
+}
+
+// UpdateReceiptDate sets the "receipt_date" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateReceiptDate() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldReceiptDate)
+	return u
+}
+
+// ClearReceiptDate clears the value of the "receipt_date" field.
+func (u *ReceiptUpsert) ClearReceiptDate() *ReceiptUpsert {
+	u.SetNull(receipt.FieldReceiptDate)
+	return u
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (u *ReceiptUpsert) SetTotalAmount(v float64) *ReceiptUpsert {
+	u.Set(receipt.FieldTotalAmount, v)
+	return u
+}
+
+// UpdateTotalAmount sets the "total_amount" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateTotalAmount() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldTotalAmount)
+	return u
+}
+
+// AddTotalAmount adds v to the "total_amount" field.
+func (u *ReceiptUpsert) AddTotalAmount(v float64) *ReceiptUpsert {
+	u.Add(receipt.FieldTotalAmount, v)
+	return u
+}
+
+// ClearTotalAmount clears the value of the "total_amount" field.
+func (u *ReceiptUpsert) ClearTotalAmount() *ReceiptUpsert {
+	u.SetNull(receipt.FieldTotalAmount)
+	return u
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (u *ReceiptUpsert) SetTaxAmount(v float64) *ReceiptUpsert {
+	u.Set(receipt.FieldTaxAmount, v)
+	return u
+}
+
+// UpdateTaxAmount sets the "tax_amount" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateTaxAmount() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldTaxAmount)
+	return u
+}
+
+// AddTaxAmount adds v to the "tax_amount" field.
+func (u *ReceiptUpsert) AddTaxAmount(v float64) *ReceiptUpsert {
+	u.Add(receipt.FieldTaxAmount, v)
+	return u
+}
+
+// ClearTaxAmount clears the value of the "tax_amount" field.
+func (u *ReceiptUpsert) ClearTaxAmount() *ReceiptUpsert {
+	u.SetNull(receipt.FieldTaxAmount)
+	return u
+}
+
+// SetSubtotalAmount sets the "subtotal_amount" field.
+func (u *ReceiptUpsert) SetSubtotalAmount(v float64) *ReceiptUpsert {
+	u.Set(receipt.FieldSubtotalAmount, v)
+	return u
+}
+
+// UpdateSubtotalAmount sets the "subtotal_amount" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateSubtotalAmount() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldSubtotalAmount)
+	return u
+}
+
+// AddSubtotalAmount adds v to the "subtotal_amount" field.
+func (u *ReceiptUpsert) AddSubtotalAmount(v float64) *ReceiptUpsert {
+	u.Add(receipt.FieldSubtotalAmount, v)
+	return u
+}
+
+// ClearSubtotalAmount clears the value of the "subtotal_amount" field.
+func (u *ReceiptUpsert) ClearSubtotalAmount() *ReceiptUpsert {
+	u.SetNull(receipt.FieldSubtotalAmount)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *ReceiptUpsert) SetCurrency(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateCurrency() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldCurrency)
+	return u
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *ReceiptUpsert) ClearCurrency() *ReceiptUpsert {
+	u.SetNull(receipt.FieldCurrency)
+	return u
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (u *ReceiptUpsert) SetPaymentMethod(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldPaymentMethod, v)
+	return u
+}
+
+// UpdatePaymentMethod sets the "payment_method" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdatePaymentMethod() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldPaymentMethod)
+	return u
+}
+
+// ClearPaymentMethod clears the value of the "payment_method" field.
+func (u *ReceiptUpsert) ClearPaymentMethod() *ReceiptUpsert {
+	u.SetNull(receipt.FieldPaymentMethod)
+	return u
+}
+
+// SetReceiptNumber sets the "receipt_number" field.
+func (u *ReceiptUpsert) SetReceiptNumber(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldReceiptNumber, v)
+	return u
+}
+
+// UpdateReceiptNumber sets the "receipt_number" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateReceiptNumber() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldReceiptNumber)
+	return u
+}
+
+// ClearReceiptNumber clears the value of the "receipt_number" field.
+func (u *ReceiptUpsert) ClearReceiptNumber() *ReceiptUpsert {
+	u.SetNull(receipt.FieldReceiptNumber)
+	return u
+}
+
+// SetCategoryTags sets the "category_tags" field.
+func (u *ReceiptUpsert) SetCategoryTags(v []string) *ReceiptUpsert {
+	u.Set(receipt.FieldCategoryTags, v)
+	return u
+}
+
+// UpdateCategoryTags sets the "category_tags" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateCategoryTags() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldCategoryTags)
+	return u
+}
+
+// ClearCategoryTags clears the value of the "category_tags" field.
+func (u *ReceiptUpsert) ClearCategoryTags() *ReceiptUpsert {
+	u.SetNull(receipt.FieldCategoryTags)
+	return u
+}
+
+// SetExtractedData sets the "extracted_data" field.
+func (u *ReceiptUpsert) SetExtractedData(v map[string]interface{}) *ReceiptUpsert {
+	u.Set(receipt.FieldExtractedData, v)
+	return u
+}
+
+// UpdateExtractedData sets the "extracted_data" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateExtractedData() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldExtractedData)
+	return u
+}
+
+// ClearExtractedData clears the value of the "extracted_data" field.
+func (u *ReceiptUpsert) ClearExtractedData() *ReceiptUpsert {
+	u.SetNull(receipt.FieldExtractedData)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *ReceiptUpsert) SetMetadata(v map[string]interface{}) *ReceiptUpsert {
+	u.Set(receipt.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateMetadata() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *ReceiptUpsert) ClearMetadata() *ReceiptUpsert {
+	u.SetNull(receipt.FieldMetadata)
+	return u
+}
+
+// SetNotes sets the "notes" field.
+func (u *ReceiptUpsert) SetNotes(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldNotes, v)
+	return u
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateNotes() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldNotes)
+	return u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *ReceiptUpsert) ClearNotes() *ReceiptUpsert {
+	u.SetNull(receipt.FieldNotes)
+	return u
+}
+
+// SetLegacyID sets the "legacy_id" field.
+func (u *ReceiptUpsert) SetLegacyID(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldLegacyID, v)
+	return u
+}
+
+// UpdateLegacyID sets the "legacy_id" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateLegacyID() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldLegacyID)
+	return u
+}
+
+// ClearLegacyID clears the value of the "legacy_id" field.
+func (u *ReceiptUpsert) ClearLegacyID() *ReceiptUpsert {
+	u.SetNull(receipt.FieldLegacyID)
+	return u
+}
+
+// SetMigrationRunID sets the "migration_run_id" field.
+func (u *ReceiptUpsert) SetMigrationRunID(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldMigrationRunID, v)
+	return u
+}
+
+// UpdateMigrationRunID sets the "migration_run_id" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateMigrationRunID() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldMigrationRunID)
+	return u
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (u *ReceiptUpsert) ClearMigrationRunID() *ReceiptUpsert {
+	u.SetNull(receipt.FieldMigrationRunID)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReceiptUpsert) SetUpdatedAt(v time.Time) *ReceiptUpsert {
+	u.Set(receipt.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateUpdatedAt() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldUpdatedAt)
+	return u
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (u *ReceiptUpsert) SetProcessedAt(v time.Time) *ReceiptUpsert {
+	u.Set(receipt.FieldProcessedAt, v)
+	return u
+}
+
+// UpdateProcessedAt sets the "processed_at" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateProcessedAt() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldProcessedAt)
+	return u
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (u *ReceiptUpsert) ClearProcessedAt() *ReceiptUpsert {
+	u.SetNull(receipt.FieldProcessedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Receipt.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(receipt.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReceiptUpsertOne) UpdateNewValues() *ReceiptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(receipt.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(receipt.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Receipt.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReceiptUpsertOne) Ignore() *ReceiptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReceiptUpsertOne) DoNothing() *ReceiptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReceiptCreate.OnConflict
+// documentation for more info.
+func (u *ReceiptUpsertOne) Update(set func(*ReceiptUpsert)) *ReceiptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReceiptUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ReceiptUpsertOne) SetUserID(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateUserID() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetSourceType sets the "source_type" field.
+func (u *ReceiptUpsertOne) SetSourceType(v receipt.SourceType) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetSourceType(v)
+	})
+}
+
+// UpdateSourceType sets the "source_type" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateSourceType() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateSourceType()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *ReceiptUpsertOne) SetSourceID(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateSourceID() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (u *ReceiptUpsertOne) ClearSourceID() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearSourceID()
+	})
+}
+
+// SetSourceConnectionID sets the "source_connection_id" field.
+func (u *ReceiptUpsertOne) SetSourceConnectionID(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetSourceConnectionID(v)
+	})
+}
+
+// UpdateSourceConnectionID sets the "source_connection_id" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateSourceConnectionID() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateSourceConnectionID()
+	})
+}
+
+// ClearSourceConnectionID clears the value of the "source_connection_id" field.
+func (u *ReceiptUpsertOne) ClearSourceConnectionID() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearSourceConnectionID()
+	})
+}
+
+// SetFileName sets the "file_name" field.
+func (u *ReceiptUpsertOne) SetFileName(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetFileName(v)
+	})
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateFileName() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateFileName()
+	})
+}
+
+// SetFilePath sets the "file_path" field.
+func (u *ReceiptUpsertOne) SetFilePath(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetFilePath(v)
+	})
+}
+
+// UpdateFilePath sets the "file_path" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateFilePath() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateFilePath()
+	})
+}
+
+// ClearFilePath clears the value of the "file_path" field.
+func (u *ReceiptUpsertOne) ClearFilePath() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearFilePath()
+	})
+}
+
+// SetMimeType sets the "mime_type" field.
+func (u *ReceiptUpsertOne) SetMimeType(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetMimeType(v)
+	})
+}
+
+// UpdateMimeType sets the "mime_type" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateMimeType() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateMimeType()
+	})
+}
+
+// SetFileSize sets the "file_size" field.
+func (u *ReceiptUpsertOne) SetFileSize(v int64) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetFileSize(v)
+	})
+}
+
+// AddFileSize adds v to the "file_size" field.
+func (u *ReceiptUpsertOne) AddFileSize(v int64) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.AddFileSize(v)
+	})
+}
+
+// UpdateFileSize sets the "file_size" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateFileSize() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateFileSize()
+	})
+}
+
+// SetStorageBucket sets the "storage_bucket" field.
+func (u *ReceiptUpsertOne) SetStorageBucket(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetStorageBucket(v)
+	})
+}
+
+// UpdateStorageBucket sets the "storage_bucket" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateStorageBucket() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateStorageBucket()
+	})
+}
+
+// ClearStorageBucket clears the value of the "storage_bucket" field.
+func (u *ReceiptUpsertOne) ClearStorageBucket() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearStorageBucket()
+	})
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (u *ReceiptUpsertOne) SetStorageKey(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetStorageKey(v)
+	})
+}
+
+// UpdateStorageKey sets the "storage_key" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateStorageKey() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateStorageKey()
+	})
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (u *ReceiptUpsertOne) ClearStorageKey() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearStorageKey()
+	})
+}
+
+// SetThumbnailPath sets the "thumbnail_path" field.
+func (u *ReceiptUpsertOne) SetThumbnailPath(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetThumbnailPath(v)
+	})
+}
+
+// UpdateThumbnailPath sets the "thumbnail_path" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateThumbnailPath() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateThumbnailPath()
+	})
+}
+
+// ClearThumbnailPath clears the value of the "thumbnail_path" field.
+func (u *ReceiptUpsertOne) ClearThumbnailPath() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearThumbnailPath()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReceiptUpsertOne) SetStatus(v receipt.Status) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateStatus() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetOcrCompleted sets the "ocr_completed" field.
+func (u *ReceiptUpsertOne) SetOcrCompleted(v bool) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetOcrCompleted(v)
+	})
+}
+
+// UpdateOcrCompleted sets the "ocr_completed" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateOcrCompleted() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateOcrCompleted()
+	})
+}
+
+// SetOcrText sets the "ocr_text" field.
+func (u *ReceiptUpsertOne) SetOcrText(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetOcrText(v)
+	})
+}
+
+// UpdateOcrText sets the "ocr_text" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateOcrText() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateOcrText()
+	})
+}
+
+// ClearOcrText clears the value of the "ocr_text" field.
+func (u *ReceiptUpsertOne) ClearOcrText() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearOcrText()
+	})
+}
+
+// SetOcrConfidence sets the "ocr_confidence" field.
+func (u *ReceiptUpsertOne) SetOcrConfidence(v float64) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetOcrConfidence(v)
+	})
+}
+
+// AddOcrConfidence adds v to the "ocr_confidence" field.
+func (u *ReceiptUpsertOne) AddOcrConfidence(v float64) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.AddOcrConfidence(v)
+	})
+}
+
+// UpdateOcrConfidence sets the "ocr_confidence" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateOcrConfidence() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateOcrConfidence()
+	})
+}
+
+// ClearOcrConfidence clears the value of the "ocr_confidence" field.
+func (u *ReceiptUpsertOne) ClearOcrConfidence() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearOcrConfidence()
+	})
+}
+
+// SetMerchantName sets the "merchant_name" field.
+func (u *ReceiptUpsertOne) SetMerchantName(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetMerchantName(v)
+	})
+}
+
+// UpdateMerchantName sets the "merchant_name" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateMerchantName() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateMerchantName()
+	})
+}
+
+// ClearMerchantName clears the value of the "merchant_name" field.
+func (u *ReceiptUpsertOne) ClearMerchantName() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearMerchantName()
+	})
+}
+
+// SetMerchantAddress sets the "merchant_address" field.
+func (u *ReceiptUpsertOne) SetMerchantAddress(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetMerchantAddress(v)
+	})
+}
+
+// UpdateMerchantAddress sets the "merchant_address" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateMerchantAddress() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateMerchantAddress()
+	})
+}
+
+// ClearMerchantAddress clears the value of the "merchant_address" field.
+func (u *ReceiptUpsertOne) ClearMerchantAddress() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearMerchantAddress()
+	})
+}
+
+// SetReceiptDate sets the "receipt_date" field.
+func (u *ReceiptUpsertOne) SetReceiptDate(v time.Time) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetReceiptDate(v)
+	})
+}
+
+// UpdateReceiptDate sets the "receipt_date" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateReceiptDate() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateReceiptDate()
+	})
+}
+
+// ClearReceiptDate clears the value of the "receipt_date" field.
+func (u *ReceiptUpsertOne) ClearReceiptDate() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearReceiptDate()
+	})
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (u *ReceiptUpsertOne) SetTotalAmount(v float64) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetTotalAmount(v)
+	})
+}
+
+// AddTotalAmount adds v to the "total_amount" field.
+func (u *ReceiptUpsertOne) AddTotalAmount(v float64) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.AddTotalAmount(v)
+	})
+}
+
+// UpdateTotalAmount sets the "total_amount" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateTotalAmount() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateTotalAmount()
+	})
+}
+
+// ClearTotalAmount clears the value of the "total_amount" field.
+func (u *ReceiptUpsertOne) ClearTotalAmount() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearTotalAmount()
+	})
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (u *ReceiptUpsertOne) SetTaxAmount(v float64) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetTaxAmount(v)
+	})
+}
+
+// AddTaxAmount adds v to the "tax_amount" field.
+func (u *ReceiptUpsertOne) AddTaxAmount(v float64) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.AddTaxAmount(v)
+	})
+}
+
+// UpdateTaxAmount sets the "tax_amount" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateTaxAmount() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateTaxAmount()
+	})
+}
+
+// ClearTaxAmount clears the value of the "tax_amount" field.
+func (u *ReceiptUpsertOne) ClearTaxAmount() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearTaxAmount()
+	})
+}
+
+// SetSubtotalAmount sets the "subtotal_amount" field.
+func (u *ReceiptUpsertOne) SetSubtotalAmount(v float64) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetSubtotalAmount(v)
+	})
+}
+
+// AddSubtotalAmount adds v to the "subtotal_amount" field.
+func (u *ReceiptUpsertOne) AddSubtotalAmount(v float64) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.AddSubtotalAmount(v)
+	})
+}
+
+// UpdateSubtotalAmount sets the "subtotal_amount" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateSubtotalAmount() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateSubtotalAmount()
+	})
+}
+
+// ClearSubtotalAmount clears the value of the "subtotal_amount" field.
+func (u *ReceiptUpsertOne) ClearSubtotalAmount() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearSubtotalAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *ReceiptUpsertOne) SetCurrency(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateCurrency() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *ReceiptUpsertOne) ClearCurrency() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearCurrency()
+	})
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (u *ReceiptUpsertOne) SetPaymentMethod(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetPaymentMethod(v)
+	})
+}
+
+// UpdatePaymentMethod sets the "payment_method" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdatePaymentMethod() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdatePaymentMethod()
+	})
+}
+
+// ClearPaymentMethod clears the value of the "payment_method" field.
+func (u *ReceiptUpsertOne) ClearPaymentMethod() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearPaymentMethod()
+	})
+}
+
+// SetReceiptNumber sets the "receipt_number" field.
+func (u *ReceiptUpsertOne) SetReceiptNumber(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetReceiptNumber(v)
+	})
+}
+
+// UpdateReceiptNumber sets the "receipt_number" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateReceiptNumber() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateReceiptNumber()
+	})
+}
+
+// ClearReceiptNumber clears the value of the "receipt_number" field.
+func (u *ReceiptUpsertOne) ClearReceiptNumber() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearReceiptNumber()
+	})
+}
+
+// SetCategoryTags sets the "category_tags" field.
+func (u *ReceiptUpsertOne) SetCategoryTags(v []string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetCategoryTags(v)
+	})
+}
+
+// UpdateCategoryTags sets the "category_tags" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateCategoryTags() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateCategoryTags()
+	})
+}
+
+// ClearCategoryTags clears the value of the "category_tags" field.
+func (u *ReceiptUpsertOne) ClearCategoryTags() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearCategoryTags()
+	})
+}
+
+// SetExtractedData sets the "extracted_data" field.
+func (u *ReceiptUpsertOne) SetExtractedData(v map[string]interface{}) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetExtractedData(v)
+	})
+}
+
+// UpdateExtractedData sets the "extracted_data" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateExtractedData() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateExtractedData()
+	})
+}
+
+// ClearExtractedData clears the value of the "extracted_data" field.
+func (u *ReceiptUpsertOne) ClearExtractedData() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearExtractedData()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *ReceiptUpsertOne) SetMetadata(v map[string]interface{}) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateMetadata() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *ReceiptUpsertOne) ClearMetadata() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *ReceiptUpsertOne) SetNotes(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateNotes() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *ReceiptUpsertOne) ClearNotes() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// SetLegacyID sets the "legacy_id" field.
+func (u *ReceiptUpsertOne) SetLegacyID(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetLegacyID(v)
+	})
+}
+
+// UpdateLegacyID sets the "legacy_id" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateLegacyID() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateLegacyID()
+	})
+}
+
+// ClearLegacyID clears the value of the "legacy_id" field.
+func (u *ReceiptUpsertOne) ClearLegacyID() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearLegacyID()
+	})
+}
+
+// SetMigrationRunID sets the "migration_run_id" field.
+func (u *ReceiptUpsertOne) SetMigrationRunID(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetMigrationRunID(v)
+	})
+}
+
+// UpdateMigrationRunID sets the "migration_run_id" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateMigrationRunID() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateMigrationRunID()
+	})
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (u *ReceiptUpsertOne) ClearMigrationRunID() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearMigrationRunID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReceiptUpsertOne) SetUpdatedAt(v time.Time) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateUpdatedAt() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (u *ReceiptUpsertOne) SetProcessedAt(v time.Time) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetProcessedAt(v)
+	})
+}
+
+// UpdateProcessedAt sets the "processed_at" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateProcessedAt() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateProcessedAt()
+	})
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (u *ReceiptUpsertOne) ClearProcessedAt() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearProcessedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReceiptUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReceiptCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReceiptUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReceiptUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReceiptUpsertOne.ID is not supported by MySQL driver. Use ReceiptUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReceiptUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReceiptCreateBulk is the builder for creating many Receipt entities in bulk.
+type ReceiptCreateBulk struct {
+	config
+	err      error
+	builders []*ReceiptCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Receipt entities in the database.
+func (_c *ReceiptCreateBulk) Save(ctx context.Context) ([]*Receipt, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Receipt, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReceiptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReceiptCreateBulk) SaveX(ctx context.Context) []*Receipt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReceiptCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReceiptCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Receipt.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReceiptUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReceiptCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReceiptUpsertBulk {
+	_c.conflict = opts
+	return &ReceiptUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Receipt.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReceiptCreateBulk) OnConflictColumns(columns ...string) *ReceiptUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReceiptUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReceiptUpsertBulk is the builder for "upsert"-ing
+// a bulk of Receipt nodes.
+type ReceiptUpsertBulk struct {
+	create *ReceiptCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Receipt.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(receipt.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReceiptUpsertBulk) UpdateNewValues() *ReceiptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(receipt.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(receipt.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Receipt.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReceiptUpsertBulk) Ignore() *ReceiptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReceiptUpsertBulk) DoNothing() *ReceiptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReceiptCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReceiptUpsertBulk) Update(set func(*ReceiptUpsert)) *ReceiptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReceiptUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ReceiptUpsertBulk) SetUserID(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateUserID() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetSourceType sets the "source_type" field.
+func (u *ReceiptUpsertBulk) SetSourceType(v receipt.SourceType) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetSourceType(v)
+	})
+}
+
+// UpdateSourceType sets the "source_type" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateSourceType() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateSourceType()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *ReceiptUpsertBulk) SetSourceID(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateSourceID() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (u *ReceiptUpsertBulk) ClearSourceID() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearSourceID()
+	})
+}
+
+// SetSourceConnectionID sets the "source_connection_id" field.
+func (u *ReceiptUpsertBulk) SetSourceConnectionID(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetSourceConnectionID(v)
+	})
+}
+
+// UpdateSourceConnectionID sets the "source_connection_id" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateSourceConnectionID() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateSourceConnectionID()
+	})
+}
+
+// ClearSourceConnectionID clears the value of the "source_connection_id" field.
+func (u *ReceiptUpsertBulk) ClearSourceConnectionID() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearSourceConnectionID()
+	})
+}
+
+// SetFileName sets the "file_name" field.
+func (u *ReceiptUpsertBulk) SetFileName(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetFileName(v)
+	})
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateFileName() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateFileName()
+	})
+}
+
+// SetFilePath sets the "file_path" field.
+func (u *ReceiptUpsertBulk) SetFilePath(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetFilePath(v)
+	})
+}
+
+// UpdateFilePath sets the "file_path" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateFilePath() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateFilePath()
+	})
+}
+
+// ClearFilePath clears the value of the "file_path" field.
+func (u *ReceiptUpsertBulk) ClearFilePath() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearFilePath()
+	})
+}
+
+// SetMimeType sets the "mime_type" field.
+func (u *ReceiptUpsertBulk) SetMimeType(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetMimeType(v)
+	})
+}
+
+// UpdateMimeType sets the "mime_type" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateMimeType() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateMimeType()
+	})
+}
+
+// SetFileSize sets the "file_size" field.
+func (u *ReceiptUpsertBulk) SetFileSize(v int64) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetFileSize(v)
+	})
+}
+
+// AddFileSize adds v to the "file_size" field.
+func (u *ReceiptUpsertBulk) AddFileSize(v int64) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.AddFileSize(v)
+	})
+}
+
+// UpdateFileSize sets the "file_size" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateFileSize() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateFileSize()
+	})
+}
+
+// SetStorageBucket sets the "storage_bucket" field.
+func (u *ReceiptUpsertBulk) SetStorageBucket(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetStorageBucket(v)
+	})
+}
+
+// UpdateStorageBucket sets the "storage_bucket" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateStorageBucket() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateStorageBucket()
+	})
+}
+
+// ClearStorageBucket clears the value of the "storage_bucket" field.
+func (u *ReceiptUpsertBulk) ClearStorageBucket() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearStorageBucket()
+	})
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (u *ReceiptUpsertBulk) SetStorageKey(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetStorageKey(v)
+	})
+}
+
+// UpdateStorageKey sets the "storage_key" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateStorageKey() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateStorageKey()
+	})
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (u *ReceiptUpsertBulk) ClearStorageKey() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearStorageKey()
+	})
+}
+
+// SetThumbnailPath sets the "thumbnail_path" field.
+func (u *ReceiptUpsertBulk) SetThumbnailPath(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetThumbnailPath(v)
+	})
+}
+
+// UpdateThumbnailPath sets the "thumbnail_path" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateThumbnailPath() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateThumbnailPath()
+	})
+}
+
+// ClearThumbnailPath clears the value of the "thumbnail_path" field.
+func (u *ReceiptUpsertBulk) ClearThumbnailPath() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearThumbnailPath()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReceiptUpsertBulk) SetStatus(v receipt.Status) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateStatus() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetOcrCompleted sets the "ocr_completed" field.
+func (u *ReceiptUpsertBulk) SetOcrCompleted(v bool) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetOcrCompleted(v)
+	})
+}
+
+// UpdateOcrCompleted sets the "ocr_completed" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateOcrCompleted() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateOcrCompleted()
+	})
+}
+
+// SetOcrText sets the "ocr_text" field.
+func (u *ReceiptUpsertBulk) SetOcrText(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetOcrText(v)
+	})
+}
+
+// UpdateOcrText sets the "ocr_text" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateOcrText() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateOcrText()
+	})
+}
+
+// ClearOcrText clears the value of the "ocr_text" field.
+func (u *ReceiptUpsertBulk) ClearOcrText() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearOcrText()
+	})
+}
+
+// SetOcrConfidence sets the "ocr_confidence" field.
+func (u *ReceiptUpsertBulk) SetOcrConfidence(v float64) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetOcrConfidence(v)
+	})
+}
+
+// AddOcrConfidence adds v to the "ocr_confidence" field.
+func (u *ReceiptUpsertBulk) AddOcrConfidence(v float64) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.AddOcrConfidence(v)
+	})
+}
+
+// UpdateOcrConfidence sets the "ocr_confidence" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateOcrConfidence() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateOcrConfidence()
+	})
+}
+
+// ClearOcrConfidence clears the value of the "ocr_confidence" field.
+func (u *ReceiptUpsertBulk) ClearOcrConfidence() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearOcrConfidence()
+	})
+}
+
+// SetMerchantName sets the "merchant_name" field.
+func (u *ReceiptUpsertBulk) SetMerchantName(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetMerchantName(v)
+	})
+}
+
+// UpdateMerchantName sets the "merchant_name" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateMerchantName() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateMerchantName()
+	})
+}
+
+// ClearMerchantName clears the value of the "merchant_name" field.
+func (u *ReceiptUpsertBulk) ClearMerchantName() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearMerchantName()
+	})
+}
+
+// SetMerchantAddress sets the "merchant_address" field.
+func (u *ReceiptUpsertBulk) SetMerchantAddress(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetMerchantAddress(v)
+	})
+}
+
+// UpdateMerchantAddress sets the "merchant_address" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateMerchantAddress() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateMerchantAddress()
+	})
+}
+
+// ClearMerchantAddress clears the value of the "merchant_address" field.
+func (u *ReceiptUpsertBulk) ClearMerchantAddress() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearMerchantAddress()
+	})
+}
+
+// SetReceiptDate sets the "receipt_date" field.
+func (u *ReceiptUpsertBulk) SetReceiptDate(v time.Time) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetReceiptDate(v)
+	})
+}
+
+// UpdateReceiptDate sets the "receipt_date" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateReceiptDate() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateReceiptDate()
+	})
+}
+
+// ClearReceiptDate clears the value of the "receipt_date" field.
+func (u *ReceiptUpsertBulk) ClearReceiptDate() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearReceiptDate()
+	})
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (u *ReceiptUpsertBulk) SetTotalAmount(v float64) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetTotalAmount(v)
+	})
+}
+
+// AddTotalAmount adds v to the "total_amount" field.
+func (u *ReceiptUpsertBulk) AddTotalAmount(v float64) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.AddTotalAmount(v)
+	})
+}
+
+// UpdateTotalAmount sets the "total_amount" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateTotalAmount() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateTotalAmount()
+	})
+}
+
+// ClearTotalAmount clears the value of the "total_amount" field.
+func (u *ReceiptUpsertBulk) ClearTotalAmount() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearTotalAmount()
+	})
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (u *ReceiptUpsertBulk) SetTaxAmount(v float64) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetTaxAmount(v)
+	})
+}
+
+// AddTaxAmount adds v to the "tax_amount" field.
+func (u *ReceiptUpsertBulk) AddTaxAmount(v float64) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.AddTaxAmount(v)
+	})
+}
+
+// UpdateTaxAmount sets the "tax_amount" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateTaxAmount() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateTaxAmount()
+	})
+}
+
+// ClearTaxAmount clears the value of the "tax_amount" field.
+func (u *ReceiptUpsertBulk) ClearTaxAmount() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearTaxAmount()
+	})
+}
+
+// SetSubtotalAmount sets the "subtotal_amount" field.
+func (u *ReceiptUpsertBulk) SetSubtotalAmount(v float64) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetSubtotalAmount(v)
+	})
+}
+
+// AddSubtotalAmount adds v to the "subtotal_amount" field.
+func (u *ReceiptUpsertBulk) AddSubtotalAmount(v float64) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.AddSubtotalAmount(v)
+	})
+}
+
+// UpdateSubtotalAmount sets the "subtotal_amount" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateSubtotalAmount() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateSubtotalAmount()
+	})
+}
+
+// ClearSubtotalAmount clears the value of the "subtotal_amount" field.
+func (u *ReceiptUpsertBulk) ClearSubtotalAmount() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearSubtotalAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *ReceiptUpsertBulk) SetCurrency(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateCurrency() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *ReceiptUpsertBulk) ClearCurrency() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearCurrency()
+	})
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (u *ReceiptUpsertBulk) SetPaymentMethod(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetPaymentMethod(v)
+	})
+}
+
+// UpdatePaymentMethod sets the "payment_method" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdatePaymentMethod() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdatePaymentMethod()
+	})
+}
+
+// ClearPaymentMethod clears the value of the "payment_method" field.
+func (u *ReceiptUpsertBulk) ClearPaymentMethod() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearPaymentMethod()
+	})
+}
+
+// SetReceiptNumber sets the "receipt_number" field.
+func (u *ReceiptUpsertBulk) SetReceiptNumber(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetReceiptNumber(v)
+	})
+}
+
+// UpdateReceiptNumber sets the "receipt_number" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateReceiptNumber() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateReceiptNumber()
+	})
+}
+
+// ClearReceiptNumber clears the value of the "receipt_number" field.
+func (u *ReceiptUpsertBulk) ClearReceiptNumber() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearReceiptNumber()
+	})
+}
+
+// SetCategoryTags sets the "category_tags" field.
+func (u *ReceiptUpsertBulk) SetCategoryTags(v []string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetCategoryTags(v)
+	})
+}
+
+// UpdateCategoryTags sets the "category_tags" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateCategoryTags() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateCategoryTags()
+	})
+}
+
+// ClearCategoryTags clears the value of the "category_tags" field.
+func (u *ReceiptUpsertBulk) ClearCategoryTags() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearCategoryTags()
+	})
+}
+
+// SetExtractedData sets the "extracted_data" field.
+func (u *ReceiptUpsertBulk) SetExtractedData(v map[string]interface{}) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetExtractedData(v)
+	})
+}
+
+// UpdateExtractedData sets the "extracted_data" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateExtractedData() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateExtractedData()
+	})
+}
+
+// ClearExtractedData clears the value of the "extracted_data" field.
+func (u *ReceiptUpsertBulk) ClearExtractedData() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearExtractedData()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *ReceiptUpsertBulk) SetMetadata(v map[string]interface{}) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateMetadata() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *ReceiptUpsertBulk) ClearMetadata() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *ReceiptUpsertBulk) SetNotes(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateNotes() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *ReceiptUpsertBulk) ClearNotes() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// SetLegacyID sets the "legacy_id" field.
+func (u *ReceiptUpsertBulk) SetLegacyID(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetLegacyID(v)
+	})
+}
+
+// UpdateLegacyID sets the "legacy_id" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateLegacyID() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateLegacyID()
+	})
+}
+
+// ClearLegacyID clears the value of the "legacy_id" field.
+func (u *ReceiptUpsertBulk) ClearLegacyID() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearLegacyID()
+	})
+}
+
+// SetMigrationRunID sets the "migration_run_id" field.
+func (u *ReceiptUpsertBulk) SetMigrationRunID(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetMigrationRunID(v)
+	})
+}
+
+// UpdateMigrationRunID sets the "migration_run_id" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateMigrationRunID() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateMigrationRunID()
+	})
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (u *ReceiptUpsertBulk) ClearMigrationRunID() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearMigrationRunID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReceiptUpsertBulk) SetUpdatedAt(v time.Time) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateUpdatedAt() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (u *ReceiptUpsertBulk) SetProcessedAt(v time.Time) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetProcessedAt(v)
+	})
+}
+
+// UpdateProcessedAt sets the "processed_at" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateProcessedAt() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateProcessedAt()
+	})
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (u *ReceiptUpsertBulk) ClearProcessedAt() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearProcessedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReceiptUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReceiptCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReceiptCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReceiptUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -586,6 +586,26 @@ func (_u *ReceiptUpdate) ClearLegacyID() *ReceiptUpdate {
 	return _u
 }
 
+// SetMigrationRunID sets the "migration_run_id" field.
+func (_u *ReceiptUpdate) SetMigrationRunID(v string) *ReceiptUpdate {
+	_u.mutation.SetMigrationRunID(v)
+	return _u
+}
+
+// SetNillableMigrationRunID sets the "migration_run_id" field if the given value is not nil.
+func (_u *ReceiptUpdate) SetNillableMigrationRunID(v *string) *ReceiptUpdate {
+	if v != nil {
+		_u.SetMigrationRunID(*v)
+	}
+	return _u
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (_u *ReceiptUpdate) ClearMigrationRunID() *ReceiptUpdate {
+	_u.mutation.ClearMigrationRunID()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *ReceiptUpdate) SetUpdatedAt(v time.Time) *ReceiptUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -940,6 +960,12 @@ func (_u *ReceiptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.LegacyIDCleared() {
 		_spec.ClearField(receipt.FieldLegacyID, field.TypeString)
 	}
+	if value, ok := _u.mutation.MigrationRunID(); ok {
+		_spec.SetField(receipt.FieldMigrationRunID, field.TypeString, value)
+	}
+	if _u.mutation.MigrationRunIDCleared() {
+		_spec.ClearField(receipt.FieldMigrationRunID, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(receipt.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -1614,6 +1640,26 @@ func (_u *ReceiptUpdateOne) ClearLegacyID() *ReceiptUpdateOne {
 	return _u
 }
 
+// SetMigrationRunID sets the "migration_run_id" field.
+func (_u *ReceiptUpdateOne) SetMigrationRunID(v string) *ReceiptUpdateOne {
+	_u.mutation.SetMigrationRunID(v)
+	return _u
+}
+
+// SetNillableMigrationRunID sets the "migration_run_id" field if the given value is not nil.
+func (_u *ReceiptUpdateOne) SetNillableMigrationRunID(v *string) *ReceiptUpdateOne {
+	if v != nil {
+		_u.SetMigrationRunID(*v)
+	}
+	return _u
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (_u *ReceiptUpdateOne) ClearMigrationRunID() *ReceiptUpdateOne {
+	_u.mutation.ClearMigrationRunID()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *ReceiptUpdateOne) SetUpdatedAt(v time.Time) *ReceiptUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -1998,6 +2044,12 @@ func (_u *ReceiptUpdateOne) sqlSave(ctx context.Context) (_node *Receipt, err er
 	if _u.mutation.LegacyIDCleared() {
 		_spec.ClearField(receipt.FieldLegacyID, field.TypeString)
 	}
+	if value, ok := _u.mutation.MigrationRunID(); ok {
+		_spec.SetField(receipt.FieldMigrationRunID, field.TypeString, value)
+	}
+	if _u.mutation.MigrationRunIDCleared() {
+		_spec.ClearField(receipt.FieldMigrationRunID, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(receipt.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -0,0 +1,520 @@
+// Code generated by ent, DO NOT EDIT.
+
+package receiptevent
+
+import (
+	"clockzen-next/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldContainsFold(FieldID, id))
+}
+
+// ReceiptID applies equality check predicate on the "receipt_id" field. It's identical to ReceiptIDEQ.
+func ReceiptID(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldEQ(FieldReceiptID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldEQ(FieldUserID, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldEQ(FieldVersion, v))
+}
+
+// Detail applies equality check predicate on the "detail" field. It's identical to DetailEQ.
+func Detail(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldEQ(FieldDetail, v))
+}
+
+// OccurredAt applies equality check predicate on the "occurred_at" field. It's identical to OccurredAtEQ.
+func OccurredAt(v time.Time) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ReceiptIDEQ applies the EQ predicate on the "receipt_id" field.
+func ReceiptIDEQ(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldEQ(FieldReceiptID, v))
+}
+
+// ReceiptIDNEQ applies the NEQ predicate on the "receipt_id" field.
+func ReceiptIDNEQ(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldNEQ(FieldReceiptID, v))
+}
+
+// ReceiptIDIn applies the In predicate on the "receipt_id" field.
+func ReceiptIDIn(vs ...string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldIn(FieldReceiptID, vs...))
+}
+
+// ReceiptIDNotIn applies the NotIn predicate on the "receipt_id" field.
+func ReceiptIDNotIn(vs ...string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldNotIn(FieldReceiptID, vs...))
+}
+
+// ReceiptIDGT applies the GT predicate on the "receipt_id" field.
+func ReceiptIDGT(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldGT(FieldReceiptID, v))
+}
+
+// ReceiptIDGTE applies the GTE predicate on the "receipt_id" field.
+func ReceiptIDGTE(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldGTE(FieldReceiptID, v))
+}
+
+// ReceiptIDLT applies the LT predicate on the "receipt_id" field.
+func ReceiptIDLT(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldLT(FieldReceiptID, v))
+}
+
+// ReceiptIDLTE applies the LTE predicate on the "receipt_id" field.
+func ReceiptIDLTE(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldLTE(FieldReceiptID, v))
+}
+
+// ReceiptIDContains applies the Contains predicate on the "receipt_id" field.
+func ReceiptIDContains(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldContains(FieldReceiptID, v))
+}
+
+// ReceiptIDHasPrefix applies the HasPrefix predicate on the "receipt_id" field.
+func ReceiptIDHasPrefix(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldHasPrefix(FieldReceiptID, v))
+}
+
+// ReceiptIDHasSuffix applies the HasSuffix predicate on the "receipt_id" field.
+func ReceiptIDHasSuffix(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldHasSuffix(FieldReceiptID, v))
+}
+
+// ReceiptIDEqualFold applies the EqualFold predicate on the "receipt_id" field.
+func ReceiptIDEqualFold(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldEqualFold(FieldReceiptID, v))
+}
+
+// ReceiptIDContainsFold applies the ContainsFold predicate on the "receipt_id" field.
+func ReceiptIDContainsFold(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldContainsFold(FieldReceiptID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// StageEQ applies the EQ predicate on the "stage" field.
+func StageEQ(v Stage) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldEQ(FieldStage, v))
+}
+
+// StageNEQ applies the NEQ predicate on the "stage" field.
+func StageNEQ(v Stage) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldNEQ(FieldStage, v))
+}
+
+// StageIn applies the In predicate on the "stage" field.
+func StageIn(vs ...Stage) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldIn(FieldStage, vs...))
+}
+
+// StageNotIn applies the NotIn predicate on the "stage" field.
+func StageNotIn(vs ...Stage) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldNotIn(FieldStage, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldLTE(FieldVersion, v))
+}
+
+// VersionContains applies the Contains predicate on the "version" field.
+func VersionContains(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldContains(FieldVersion, v))
+}
+
+// VersionHasPrefix applies the HasPrefix predicate on the "version" field.
+func VersionHasPrefix(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldHasPrefix(FieldVersion, v))
+}
+
+// VersionHasSuffix applies the HasSuffix predicate on the "version" field.
+func VersionHasSuffix(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldHasSuffix(FieldVersion, v))
+}
+
+// VersionIsNil applies the IsNil predicate on the "version" field.
+func VersionIsNil() predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldIsNull(FieldVersion))
+}
+
+// VersionNotNil applies the NotNil predicate on the "version" field.
+func VersionNotNil() predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldNotNull(FieldVersion))
+}
+
+// VersionEqualFold applies the EqualFold predicate on the "version" field.
+func VersionEqualFold(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldEqualFold(FieldVersion, v))
+}
+
+// VersionContainsFold applies the ContainsFold predicate on the "version" field.
+func VersionContainsFold(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldContainsFold(FieldVersion, v))
+}
+
+// DetailEQ applies the EQ predicate on the "detail" field.
+func DetailEQ(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldEQ(FieldDetail, v))
+}
+
+// DetailNEQ applies the NEQ predicate on the "detail" field.
+func DetailNEQ(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldNEQ(FieldDetail, v))
+}
+
+// DetailIn applies the In predicate on the "detail" field.
+func DetailIn(vs ...string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldIn(FieldDetail, vs...))
+}
+
+// DetailNotIn applies the NotIn predicate on the "detail" field.
+func DetailNotIn(vs ...string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldNotIn(FieldDetail, vs...))
+}
+
+// DetailGT applies the GT predicate on the "detail" field.
+func DetailGT(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldGT(FieldDetail, v))
+}
+
+// DetailGTE applies the GTE predicate on the "detail" field.
+func DetailGTE(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldGTE(FieldDetail, v))
+}
+
+// DetailLT applies the LT predicate on the "detail" field.
+func DetailLT(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldLT(FieldDetail, v))
+}
+
+// DetailLTE applies the LTE predicate on the "detail" field.
+func DetailLTE(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldLTE(FieldDetail, v))
+}
+
+// DetailContains applies the Contains predicate on the "detail" field.
+func DetailContains(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldContains(FieldDetail, v))
+}
+
+// DetailHasPrefix applies the HasPrefix predicate on the "detail" field.
+func DetailHasPrefix(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldHasPrefix(FieldDetail, v))
+}
+
+// DetailHasSuffix applies the HasSuffix predicate on the "detail" field.
+func DetailHasSuffix(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldHasSuffix(FieldDetail, v))
+}
+
+// DetailIsNil applies the IsNil predicate on the "detail" field.
+func DetailIsNil() predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldIsNull(FieldDetail))
+}
+
+// DetailNotNil applies the NotNil predicate on the "detail" field.
+func DetailNotNil() predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldNotNull(FieldDetail))
+}
+
+// DetailEqualFold applies the EqualFold predicate on the "detail" field.
+func DetailEqualFold(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldEqualFold(FieldDetail, v))
+}
+
+// DetailContainsFold applies the ContainsFold predicate on the "detail" field.
+func DetailContainsFold(v string) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldContainsFold(FieldDetail, v))
+}
+
+// DataIsNil applies the IsNil predicate on the "data" field.
+func DataIsNil() predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldIsNull(FieldData))
+}
+
+// DataNotNil applies the NotNil predicate on the "data" field.
+func DataNotNil() predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldNotNull(FieldData))
+}
+
+// OccurredAtEQ applies the EQ predicate on the "occurred_at" field.
+func OccurredAtEQ(v time.Time) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtNEQ applies the NEQ predicate on the "occurred_at" field.
+func OccurredAtNEQ(v time.Time) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldNEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtIn applies the In predicate on the "occurred_at" field.
+func OccurredAtIn(vs ...time.Time) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtNotIn applies the NotIn predicate on the "occurred_at" field.
+func OccurredAtNotIn(vs ...time.Time) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldNotIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtGT applies the GT predicate on the "occurred_at" field.
+func OccurredAtGT(v time.Time) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldGT(FieldOccurredAt, v))
+}
+
+// OccurredAtGTE applies the GTE predicate on the "occurred_at" field.
+func OccurredAtGTE(v time.Time) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldGTE(FieldOccurredAt, v))
+}
+
+// OccurredAtLT applies the LT predicate on the "occurred_at" field.
+func OccurredAtLT(v time.Time) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldLT(FieldOccurredAt, v))
+}
+
+// OccurredAtLTE applies the LTE predicate on the "occurred_at" field.
+func OccurredAtLTE(v time.Time) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldLTE(FieldOccurredAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReceiptEvent) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReceiptEvent) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReceiptEvent) predicate.ReceiptEvent {
+	return predicate.ReceiptEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,665 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/receiptevent"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReceiptEventCreate is the builder for creating a ReceiptEvent entity.
+type ReceiptEventCreate struct {
+	config
+	mutation *ReceiptEventMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (_c *ReceiptEventCreate) SetReceiptID(v string) *ReceiptEventCreate {
+	_c.mutation.SetReceiptID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ReceiptEventCreate) SetUserID(v string) *ReceiptEventCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetStage sets the "stage" field.
+func (_c *ReceiptEventCreate) SetStage(v receiptevent.Stage) *ReceiptEventCreate {
+	_c.mutation.SetStage(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ReceiptEventCreate) SetStatus(v receiptevent.Status) *ReceiptEventCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ReceiptEventCreate) SetNillableStatus(v *receiptevent.Status) *ReceiptEventCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *ReceiptEventCreate) SetVersion(v string) *ReceiptEventCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *ReceiptEventCreate) SetNillableVersion(v *string) *ReceiptEventCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetDetail sets the "detail" field.
+func (_c *ReceiptEventCreate) SetDetail(v string) *ReceiptEventCreate {
+	_c.mutation.SetDetail(v)
+	return _c
+}
+
+// SetNillableDetail sets the "detail" field if the given value is not nil.
+func (_c *ReceiptEventCreate) SetNillableDetail(v *string) *ReceiptEventCreate {
+	if v != nil {
+		_c.SetDetail(*v)
+	}
+	return _c
+}
+
+// SetData sets the "data" field.
+func (_c *ReceiptEventCreate) SetData(v map[string]interface{}) *ReceiptEventCreate {
+	_c.mutation.SetData(v)
+	return _c
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (_c *ReceiptEventCreate) SetOccurredAt(v time.Time) *ReceiptEventCreate {
+	_c.mutation.SetOccurredAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReceiptEventCreate) SetCreatedAt(v time.Time) *ReceiptEventCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReceiptEventCreate) SetNillableCreatedAt(v *time.Time) *ReceiptEventCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReceiptEventCreate) SetID(v string) *ReceiptEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ReceiptEventMutation object of the builder.
+func (_c *ReceiptEventCreate) Mutation() *ReceiptEventMutation {
+	return _c.mutation
+}
+
+// Save creates the ReceiptEvent in the database.
+func (_c *ReceiptEventCreate) Save(ctx context.Context) (*ReceiptEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReceiptEventCreate) SaveX(ctx context.Context) *ReceiptEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReceiptEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReceiptEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReceiptEventCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := receiptevent.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := receiptevent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReceiptEventCreate) check() error {
+	if _, ok := _c.mutation.ReceiptID(); !ok {
+		return &ValidationError{Name: "receipt_id", err: errors.New(`ent: missing required field "ReceiptEvent.receipt_id"`)}
+	}
+	if v, ok := _c.mutation.ReceiptID(); ok {
+		if err := receiptevent.ReceiptIDValidator(v); err != nil {
+			return &ValidationError{Name: "receipt_id", err: fmt.Errorf(`ent: validator failed for field "ReceiptEvent.receipt_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ReceiptEvent.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := receiptevent.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ReceiptEvent.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Stage(); !ok {
+		return &ValidationError{Name: "stage", err: errors.New(`ent: missing required field "ReceiptEvent.stage"`)}
+	}
+	if v, ok := _c.mutation.Stage(); ok {
+		if err := receiptevent.StageValidator(v); err != nil {
+			return &ValidationError{Name: "stage", err: fmt.Errorf(`ent: validator failed for field "ReceiptEvent.stage": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ReceiptEvent.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := receiptevent.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReceiptEvent.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OccurredAt(); !ok {
+		return &ValidationError{Name: "occurred_at", err: errors.New(`ent: missing required field "ReceiptEvent.occurred_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReceiptEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *ReceiptEventCreate) sqlSave(ctx context.Context) (*ReceiptEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ReceiptEvent.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReceiptEventCreate) createSpec() (*ReceiptEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReceiptEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(receiptevent.Table, sqlgraph.NewFieldSpec(receiptevent.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ReceiptID(); ok {
+		_spec.SetField(receiptevent.FieldReceiptID, field.TypeString, value)
+		_node.ReceiptID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(receiptevent.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Stage(); ok {
+		_spec.SetField(receiptevent.FieldStage, field.TypeEnum, value)
+		_node.Stage = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(receiptevent.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(receiptevent.FieldVersion, field.TypeString, value)
+		_node.Version = &value
+	}
+	if value, ok := _c.mutation.Detail(); ok {
+		_spec.SetField(receiptevent.FieldDetail, field.TypeString, value)
+		_node.Detail = &value
+	}
+	if value, ok := _c.mutation.Data(); ok {
+		_spec.SetField(receiptevent.FieldData, field.TypeJSON, value)
+		_node.Data = value
+	}
+	if value, ok := _c.mutation.OccurredAt(); ok {
+		_spec.SetField(receiptevent.FieldOccurredAt, field.TypeTime, value)
+		_node.OccurredAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(receiptevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReceiptEvent.Create().
+//		SetReceiptID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReceiptEventUpsert) {
+//			SetReceiptID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReceiptEventCreate) OnConflict(opts ...sql.ConflictOption) *ReceiptEventUpsertOne {
+	_c.conflict = opts
+	return &ReceiptEventUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReceiptEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReceiptEventCreate) OnConflictColumns(columns ...string) *ReceiptEventUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReceiptEventUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReceiptEventUpsertOne is the builder for "upsert"-ing
+	//  one ReceiptEvent node.
+	ReceiptEventUpsertOne struct {
+		create *ReceiptEventCreate
+	}
+
+	// ReceiptEventUpsert is the "OnConflict" setter.
+	ReceiptEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReceiptEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(receiptevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReceiptEventUpsertOne) UpdateNewValues() *ReceiptEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(receiptevent.FieldID)
+		}
+		if _, exists := u.create.mutation.ReceiptID(); exists {
+			s.SetIgnore(receiptevent.FieldReceiptID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(receiptevent.FieldUserID)
+		}
+		if _, exists := u.create.mutation.Stage(); exists {
+			s.SetIgnore(receiptevent.FieldStage)
+		}
+		if _, exists := u.create.mutation.Status(); exists {
+			s.SetIgnore(receiptevent.FieldStatus)
+		}
+		if _, exists := u.create.mutation.Version(); exists {
+			s.SetIgnore(receiptevent.FieldVersion)
+		}
+		if _, exists := u.create.mutation.Detail(); exists {
+			s.SetIgnore(receiptevent.FieldDetail)
+		}
+		if _, exists := u.create.mutation.Data(); exists {
+			s.SetIgnore(receiptevent.FieldData)
+		}
+		if _, exists := u.create.mutation.OccurredAt(); exists {
+			s.SetIgnore(receiptevent.FieldOccurredAt)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(receiptevent.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReceiptEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReceiptEventUpsertOne) Ignore() *ReceiptEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReceiptEventUpsertOne) DoNothing() *ReceiptEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReceiptEventCreate.OnConflict
+// documentation for more info.
+func (u *ReceiptEventUpsertOne) Update(set func(*ReceiptEventUpsert)) *ReceiptEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReceiptEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ReceiptEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReceiptEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReceiptEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReceiptEventUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReceiptEventUpsertOne.ID is not supported by MySQL driver. Use ReceiptEventUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReceiptEventUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReceiptEventCreateBulk is the builder for creating many ReceiptEvent entities in bulk.
+type ReceiptEventCreateBulk struct {
+	config
+	err      error
+	builders []*ReceiptEventCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReceiptEvent entities in the database.
+func (_c *ReceiptEventCreateBulk) Save(ctx context.Context) ([]*ReceiptEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReceiptEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReceiptEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReceiptEventCreateBulk) SaveX(ctx context.Context) []*ReceiptEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReceiptEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReceiptEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReceiptEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReceiptEventUpsert) {
+//			SetReceiptID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReceiptEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReceiptEventUpsertBulk {
+	_c.conflict = opts
+	return &ReceiptEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReceiptEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReceiptEventCreateBulk) OnConflictColumns(columns ...string) *ReceiptEventUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReceiptEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReceiptEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReceiptEvent nodes.
+type ReceiptEventUpsertBulk struct {
+	create *ReceiptEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReceiptEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(receiptevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReceiptEventUpsertBulk) UpdateNewValues() *ReceiptEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(receiptevent.FieldID)
+			}
+			if _, exists := b.mutation.ReceiptID(); exists {
+				s.SetIgnore(receiptevent.FieldReceiptID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(receiptevent.FieldUserID)
+			}
+			if _, exists := b.mutation.Stage(); exists {
+				s.SetIgnore(receiptevent.FieldStage)
+			}
+			if _, exists := b.mutation.Status(); exists {
+				s.SetIgnore(receiptevent.FieldStatus)
+			}
+			if _, exists := b.mutation.Version(); exists {
+				s.SetIgnore(receiptevent.FieldVersion)
+			}
+			if _, exists := b.mutation.Detail(); exists {
+				s.SetIgnore(receiptevent.FieldDetail)
+			}
+			if _, exists := b.mutation.Data(); exists {
+				s.SetIgnore(receiptevent.FieldData)
+			}
+			if _, exists := b.mutation.OccurredAt(); exists {
+				s.SetIgnore(receiptevent.FieldOccurredAt)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(receiptevent.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReceiptEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReceiptEventUpsertBulk) Ignore() *ReceiptEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReceiptEventUpsertBulk) DoNothing() *ReceiptEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReceiptEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReceiptEventUpsertBulk) Update(set func(*ReceiptEventUpsert)) *ReceiptEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReceiptEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ReceiptEventUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReceiptEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReceiptEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReceiptEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
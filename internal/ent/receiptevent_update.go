@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/predicate"
+	"clockzen-next/internal/ent/receiptevent"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReceiptEventUpdate is the builder for updating ReceiptEvent entities.
+type ReceiptEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReceiptEventMutation
+}
+
+// Where appends a list predicates to the ReceiptEventUpdate builder.
+func (_u *ReceiptEventUpdate) Where(ps ...predicate.ReceiptEvent) *ReceiptEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the ReceiptEventMutation object of the builder.
+func (_u *ReceiptEventUpdate) Mutation() *ReceiptEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReceiptEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReceiptEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReceiptEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReceiptEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ReceiptEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(receiptevent.Table, receiptevent.Columns, sqlgraph.NewFieldSpec(receiptevent.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.VersionCleared() {
+		_spec.ClearField(receiptevent.FieldVersion, field.TypeString)
+	}
+	if _u.mutation.DetailCleared() {
+		_spec.ClearField(receiptevent.FieldDetail, field.TypeString)
+	}
+	if _u.mutation.DataCleared() {
+		_spec.ClearField(receiptevent.FieldData, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{receiptevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReceiptEventUpdateOne is the builder for updating a single ReceiptEvent entity.
+type ReceiptEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReceiptEventMutation
+}
+
+// Mutation returns the ReceiptEventMutation object of the builder.
+func (_u *ReceiptEventUpdateOne) Mutation() *ReceiptEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ReceiptEventUpdate builder.
+func (_u *ReceiptEventUpdateOne) Where(ps ...predicate.ReceiptEvent) *ReceiptEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReceiptEventUpdateOne) Select(field string, fields ...string) *ReceiptEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReceiptEvent entity.
+func (_u *ReceiptEventUpdateOne) Save(ctx context.Context) (*ReceiptEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReceiptEventUpdateOne) SaveX(ctx context.Context) *ReceiptEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReceiptEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReceiptEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ReceiptEventUpdateOne) sqlSave(ctx context.Context) (_node *ReceiptEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(receiptevent.Table, receiptevent.Columns, sqlgraph.NewFieldSpec(receiptevent.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReceiptEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, receiptevent.FieldID)
+		for _, f := range fields {
+			if !receiptevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != receiptevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.VersionCleared() {
+		_spec.ClearField(receiptevent.FieldVersion, field.TypeString)
+	}
+	if _u.mutation.DetailCleared() {
+		_spec.ClearField(receiptevent.FieldDetail, field.TypeString)
+	}
+	if _u.mutation.DataCleared() {
+		_spec.ClearField(receiptevent.FieldData, field.TypeJSON)
+	}
+	_node = &ReceiptEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{receiptevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/roundingrule"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// RoundingRule is the model entity for the RoundingRule schema.
+type RoundingRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ID of the user the rule belongs to
+	UserID string `json:"user_id,omitempty"`
+	// Purchases are rounded up to the next multiple of this amount, e.g. 1.00
+	Increment float64 `json:"increment,omitempty"`
+	// Multiplies each round-up, e.g. 2 sets aside twice the spare change
+	Multiplier float64 `json:"multiplier,omitempty"`
+	// Cap on the round-up of a single purchase
+	MaxRoundUp *float64 `json:"max_round_up,omitempty"`
+	// Payment methods the rule applies to; empty applies to all
+	PaymentMethods []string `json:"payment_methods,omitempty"`
+	// Whether new purchases are rounded up
+	Enabled bool `json:"enabled,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RoundingRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case roundingrule.FieldPaymentMethods:
+			values[i] = new([]byte)
+		case roundingrule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case roundingrule.FieldIncrement, roundingrule.FieldMultiplier, roundingrule.FieldMaxRoundUp:
+			values[i] = new(sql.NullFloat64)
+		case roundingrule.FieldID, roundingrule.FieldUserID:
+			values[i] = new(sql.NullString)
+		case roundingrule.FieldCreatedAt, roundingrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RoundingRule fields.
+func (_m *RoundingRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case roundingrule.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case roundingrule.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case roundingrule.FieldIncrement:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field increment", values[i])
+			} else if value.Valid {
+				_m.Increment = value.Float64
+			}
+		case roundingrule.FieldMultiplier:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field multiplier", values[i])
+			} else if value.Valid {
+				_m.Multiplier = value.Float64
+			}
+		case roundingrule.FieldMaxRoundUp:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_round_up", values[i])
+			} else if value.Valid {
+				_m.MaxRoundUp = new(float64)
+				*_m.MaxRoundUp = value.Float64
+			}
+		case roundingrule.FieldPaymentMethods:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_methods", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PaymentMethods); err != nil {
+					return fmt.Errorf("unmarshal field payment_methods: %w", err)
+				}
+			}
+		case roundingrule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case roundingrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case roundingrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RoundingRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *RoundingRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RoundingRule.
+// Note that you need to call RoundingRule.Unwrap() before calling this method if this RoundingRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RoundingRule) Update() *RoundingRuleUpdateOne {
+	return NewRoundingRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RoundingRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RoundingRule) Unwrap() *RoundingRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RoundingRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RoundingRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("RoundingRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("increment=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Increment))
+	builder.WriteString(", ")
+	builder.WriteString("multiplier=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Multiplier))
+	builder.WriteString(", ")
+	if v := _m.MaxRoundUp; v != nil {
+		builder.WriteString("max_round_up=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("payment_methods=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PaymentMethods))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RoundingRules is a parsable slice of RoundingRule.
+type RoundingRules []*RoundingRule
@@ -0,0 +1,119 @@
+// Code generated by ent, DO NOT EDIT.
+
+package roundingrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the roundingrule type in the database.
+	Label = "rounding_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldIncrement holds the string denoting the increment field in the database.
+	FieldIncrement = "increment"
+	// FieldMultiplier holds the string denoting the multiplier field in the database.
+	FieldMultiplier = "multiplier"
+	// FieldMaxRoundUp holds the string denoting the max_round_up field in the database.
+	FieldMaxRoundUp = "max_round_up"
+	// FieldPaymentMethods holds the string denoting the payment_methods field in the database.
+	FieldPaymentMethods = "payment_methods"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the roundingrule in the database.
+	Table = "rounding_rules"
+)
+
+// Columns holds all SQL columns for roundingrule fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldIncrement,
+	FieldMultiplier,
+	FieldMaxRoundUp,
+	FieldPaymentMethods,
+	FieldEnabled,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// IncrementValidator is a validator for the "increment" field. It is called by the builders before save.
+	IncrementValidator func(float64) error
+	// DefaultMultiplier holds the default value on creation for the "multiplier" field.
+	DefaultMultiplier float64
+	// MultiplierValidator is a validator for the "multiplier" field. It is called by the builders before save.
+	MultiplierValidator func(float64) error
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the RoundingRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByIncrement orders the results by the increment field.
+func ByIncrement(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncrement, opts...).ToFunc()
+}
+
+// ByMultiplier orders the results by the multiplier field.
+func ByMultiplier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMultiplier, opts...).ToFunc()
+}
+
+// ByMaxRoundUp orders the results by the max_round_up field.
+func ByMaxRoundUp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxRoundUp, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package roundingrule
+
+import (
+	"clockzen-next/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldContainsFold(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldEQ(FieldUserID, v))
+}
+
+// Increment applies equality check predicate on the "increment" field. It's identical to IncrementEQ.
+func Increment(v float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldEQ(FieldIncrement, v))
+}
+
+// Multiplier applies equality check predicate on the "multiplier" field. It's identical to MultiplierEQ.
+func Multiplier(v float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldEQ(FieldMultiplier, v))
+}
+
+// MaxRoundUp applies equality check predicate on the "max_round_up" field. It's identical to MaxRoundUpEQ.
+func MaxRoundUp(v float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldEQ(FieldMaxRoundUp, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// IncrementEQ applies the EQ predicate on the "increment" field.
+func IncrementEQ(v float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldEQ(FieldIncrement, v))
+}
+
+// IncrementNEQ applies the NEQ predicate on the "increment" field.
+func IncrementNEQ(v float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldNEQ(FieldIncrement, v))
+}
+
+// IncrementIn applies the In predicate on the "increment" field.
+func IncrementIn(vs ...float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldIn(FieldIncrement, vs...))
+}
+
+// IncrementNotIn applies the NotIn predicate on the "increment" field.
+func IncrementNotIn(vs ...float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldNotIn(FieldIncrement, vs...))
+}
+
+// IncrementGT applies the GT predicate on the "increment" field.
+func IncrementGT(v float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldGT(FieldIncrement, v))
+}
+
+// IncrementGTE applies the GTE predicate on the "increment" field.
+func IncrementGTE(v float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldGTE(FieldIncrement, v))
+}
+
+// IncrementLT applies the LT predicate on the "increment" field.
+func IncrementLT(v float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldLT(FieldIncrement, v))
+}
+
+// IncrementLTE applies the LTE predicate on the "increment" field.
+func IncrementLTE(v float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldLTE(FieldIncrement, v))
+}
+
+// MultiplierEQ applies the EQ predicate on the "multiplier" field.
+func MultiplierEQ(v float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldEQ(FieldMultiplier, v))
+}
+
+// MultiplierNEQ applies the NEQ predicate on the "multiplier" field.
+func MultiplierNEQ(v float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldNEQ(FieldMultiplier, v))
+}
+
+// MultiplierIn applies the In predicate on the "multiplier" field.
+func MultiplierIn(vs ...float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldIn(FieldMultiplier, vs...))
+}
+
+// MultiplierNotIn applies the NotIn predicate on the "multiplier" field.
+func MultiplierNotIn(vs ...float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldNotIn(FieldMultiplier, vs...))
+}
+
+// MultiplierGT applies the GT predicate on the "multiplier" field.
+func MultiplierGT(v float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldGT(FieldMultiplier, v))
+}
+
+// MultiplierGTE applies the GTE predicate on the "multiplier" field.
+func MultiplierGTE(v float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldGTE(FieldMultiplier, v))
+}
+
+// MultiplierLT applies the LT predicate on the "multiplier" field.
+func MultiplierLT(v float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldLT(FieldMultiplier, v))
+}
+
+// MultiplierLTE applies the LTE predicate on the "multiplier" field.
+func MultiplierLTE(v float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldLTE(FieldMultiplier, v))
+}
+
+// MaxRoundUpEQ applies the EQ predicate on the "max_round_up" field.
+func MaxRoundUpEQ(v float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldEQ(FieldMaxRoundUp, v))
+}
+
+// MaxRoundUpNEQ applies the NEQ predicate on the "max_round_up" field.
+func MaxRoundUpNEQ(v float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldNEQ(FieldMaxRoundUp, v))
+}
+
+// MaxRoundUpIn applies the In predicate on the "max_round_up" field.
+func MaxRoundUpIn(vs ...float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldIn(FieldMaxRoundUp, vs...))
+}
+
+// MaxRoundUpNotIn applies the NotIn predicate on the "max_round_up" field.
+func MaxRoundUpNotIn(vs ...float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldNotIn(FieldMaxRoundUp, vs...))
+}
+
+// MaxRoundUpGT applies the GT predicate on the "max_round_up" field.
+func MaxRoundUpGT(v float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldGT(FieldMaxRoundUp, v))
+}
+
+// MaxRoundUpGTE applies the GTE predicate on the "max_round_up" field.
+func MaxRoundUpGTE(v float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldGTE(FieldMaxRoundUp, v))
+}
+
+// MaxRoundUpLT applies the LT predicate on the "max_round_up" field.
+func MaxRoundUpLT(v float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldLT(FieldMaxRoundUp, v))
+}
+
+// MaxRoundUpLTE applies the LTE predicate on the "max_round_up" field.
+func MaxRoundUpLTE(v float64) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldLTE(FieldMaxRoundUp, v))
+}
+
+// MaxRoundUpIsNil applies the IsNil predicate on the "max_round_up" field.
+func MaxRoundUpIsNil() predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldIsNull(FieldMaxRoundUp))
+}
+
+// MaxRoundUpNotNil applies the NotNil predicate on the "max_round_up" field.
+func MaxRoundUpNotNil() predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldNotNull(FieldMaxRoundUp))
+}
+
+// PaymentMethodsIsNil applies the IsNil predicate on the "payment_methods" field.
+func PaymentMethodsIsNil() predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldIsNull(FieldPaymentMethods))
+}
+
+// PaymentMethodsNotNil applies the NotNil predicate on the "payment_methods" field.
+func PaymentMethodsNotNil() predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldNotNull(FieldPaymentMethods))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RoundingRule) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RoundingRule) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RoundingRule) predicate.RoundingRule {
+	return predicate.RoundingRule(sql.NotPredicates(p))
+}
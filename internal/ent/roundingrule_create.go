@@ -0,0 +1,998 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/roundingrule"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RoundingRuleCreate is the builder for creating a RoundingRule entity.
+type RoundingRuleCreate struct {
+	config
+	mutation *RoundingRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *RoundingRuleCreate) SetUserID(v string) *RoundingRuleCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetIncrement sets the "increment" field.
+func (_c *RoundingRuleCreate) SetIncrement(v float64) *RoundingRuleCreate {
+	_c.mutation.SetIncrement(v)
+	return _c
+}
+
+// SetMultiplier sets the "multiplier" field.
+func (_c *RoundingRuleCreate) SetMultiplier(v float64) *RoundingRuleCreate {
+	_c.mutation.SetMultiplier(v)
+	return _c
+}
+
+// SetNillableMultiplier sets the "multiplier" field if the given value is not nil.
+func (_c *RoundingRuleCreate) SetNillableMultiplier(v *float64) *RoundingRuleCreate {
+	if v != nil {
+		_c.SetMultiplier(*v)
+	}
+	return _c
+}
+
+// SetMaxRoundUp sets the "max_round_up" field.
+func (_c *RoundingRuleCreate) SetMaxRoundUp(v float64) *RoundingRuleCreate {
+	_c.mutation.SetMaxRoundUp(v)
+	return _c
+}
+
+// SetNillableMaxRoundUp sets the "max_round_up" field if the given value is not nil.
+func (_c *RoundingRuleCreate) SetNillableMaxRoundUp(v *float64) *RoundingRuleCreate {
+	if v != nil {
+		_c.SetMaxRoundUp(*v)
+	}
+	return _c
+}
+
+// SetPaymentMethods sets the "payment_methods" field.
+func (_c *RoundingRuleCreate) SetPaymentMethods(v []string) *RoundingRuleCreate {
+	_c.mutation.SetPaymentMethods(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *RoundingRuleCreate) SetEnabled(v bool) *RoundingRuleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *RoundingRuleCreate) SetNillableEnabled(v *bool) *RoundingRuleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RoundingRuleCreate) SetCreatedAt(v time.Time) *RoundingRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RoundingRuleCreate) SetNillableCreatedAt(v *time.Time) *RoundingRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RoundingRuleCreate) SetUpdatedAt(v time.Time) *RoundingRuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RoundingRuleCreate) SetNillableUpdatedAt(v *time.Time) *RoundingRuleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RoundingRuleCreate) SetID(v string) *RoundingRuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the RoundingRuleMutation object of the builder.
+func (_c *RoundingRuleCreate) Mutation() *RoundingRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the RoundingRule in the database.
+func (_c *RoundingRuleCreate) Save(ctx context.Context) (*RoundingRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RoundingRuleCreate) SaveX(ctx context.Context) *RoundingRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RoundingRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RoundingRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RoundingRuleCreate) defaults() {
+	if _, ok := _c.mutation.Multiplier(); !ok {
+		v := roundingrule.DefaultMultiplier
+		_c.mutation.SetMultiplier(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := roundingrule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := roundingrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := roundingrule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RoundingRuleCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RoundingRule.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := roundingrule.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "RoundingRule.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Increment(); !ok {
+		return &ValidationError{Name: "increment", err: errors.New(`ent: missing required field "RoundingRule.increment"`)}
+	}
+	if v, ok := _c.mutation.Increment(); ok {
+		if err := roundingrule.IncrementValidator(v); err != nil {
+			return &ValidationError{Name: "increment", err: fmt.Errorf(`ent: validator failed for field "RoundingRule.increment": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Multiplier(); !ok {
+		return &ValidationError{Name: "multiplier", err: errors.New(`ent: missing required field "RoundingRule.multiplier"`)}
+	}
+	if v, ok := _c.mutation.Multiplier(); ok {
+		if err := roundingrule.MultiplierValidator(v); err != nil {
+			return &ValidationError{Name: "multiplier", err: fmt.Errorf(`ent: validator failed for field "RoundingRule.multiplier": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "RoundingRule.enabled"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RoundingRule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RoundingRule.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *RoundingRuleCreate) sqlSave(ctx context.Context) (*RoundingRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected RoundingRule.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RoundingRuleCreate) createSpec() (*RoundingRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RoundingRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(roundingrule.Table, sqlgraph.NewFieldSpec(roundingrule.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(roundingrule.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Increment(); ok {
+		_spec.SetField(roundingrule.FieldIncrement, field.TypeFloat64, value)
+		_node.Increment = value
+	}
+	if value, ok := _c.mutation.Multiplier(); ok {
+		_spec.SetField(roundingrule.FieldMultiplier, field.TypeFloat64, value)
+		_node.Multiplier = value
+	}
+	if value, ok := _c.mutation.MaxRoundUp(); ok {
+		_spec.SetField(roundingrule.FieldMaxRoundUp, field.TypeFloat64, value)
+		_node.MaxRoundUp = &value
+	}
+	if value, ok := _c.mutation.PaymentMethods(); ok {
+		_spec.SetField(roundingrule.FieldPaymentMethods, field.TypeJSON, value)
+		_node.PaymentMethods = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(roundingrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(roundingrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(roundingrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RoundingRule.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RoundingRuleUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RoundingRuleCreate) OnConflict(opts ...sql.ConflictOption) *RoundingRuleUpsertOne {
+	_c.conflict = opts
+	return &RoundingRuleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RoundingRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RoundingRuleCreate) OnConflictColumns(columns ...string) *RoundingRuleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RoundingRuleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RoundingRuleUpsertOne is the builder for "upsert"-ing
+	//  one RoundingRule node.
+	RoundingRuleUpsertOne struct {
+		create *RoundingRuleCreate
+	}
+
+	// RoundingRuleUpsert is the "OnConflict" setter.
+	RoundingRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *RoundingRuleUpsert) SetUserID(v string) *RoundingRuleUpsert {
+	u.Set(roundingrule.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *RoundingRuleUpsert) UpdateUserID() *RoundingRuleUpsert {
+	u.SetExcluded(roundingrule.FieldUserID)
+	return u
+}
+
+// SetIncrement sets the "increment" field.
+func (u *RoundingRuleUpsert) SetIncrement(v float64) *RoundingRuleUpsert {
+	u.Set(roundingrule.FieldIncrement, v)
+	return u
+}
+
+// UpdateIncrement sets the "increment" field to the value that was provided on create.
+func (u *RoundingRuleUpsert) UpdateIncrement() *RoundingRuleUpsert {
+	u.SetExcluded(roundingrule.FieldIncrement)
+	return u
+}
+
+// AddIncrement adds v to the "increment" field.
+func (u *RoundingRuleUpsert) AddIncrement(v float64) *RoundingRuleUpsert {
+	u.Add(roundingrule.FieldIncrement, v)
+	return u
+}
+
+// SetMultiplier sets the "multiplier" field.
+func (u *RoundingRuleUpsert) SetMultiplier(v float64) *RoundingRuleUpsert {
+	u.Set(roundingrule.FieldMultiplier, v)
+	return u
+}
+
+// UpdateMultiplier sets the "multiplier" field to the value that was provided on create.
+func (u *RoundingRuleUpsert) UpdateMultiplier() *RoundingRuleUpsert {
+	u.SetExcluded(roundingrule.FieldMultiplier)
+	return u
+}
+
+// AddMultiplier adds v to the "multiplier" field.
+func (u *RoundingRuleUpsert) AddMultiplier(v float64) *RoundingRuleUpsert {
+	u.Add(roundingrule.FieldMultiplier, v)
+	return u
+}
+
+// SetMaxRoundUp sets the "max_round_up" field.
+func (u *RoundingRuleUpsert) SetMaxRoundUp(v float64) *RoundingRuleUpsert {
+	u.Set(roundingrule.FieldMaxRoundUp, v)
+	return u
+}
+
+// UpdateMaxRoundUp sets the "max_round_up" field to the value that was provided on create.
+func (u *RoundingRuleUpsert) UpdateMaxRoundUp() *RoundingRuleUpsert {
+	u.SetExcluded(roundingrule.FieldMaxRoundUp)
+	return u
+}
+
+// AddMaxRoundUp adds v to the "max_round_up" field.
+func (u *RoundingRuleUpsert) AddMaxRoundUp(v float64) *RoundingRuleUpsert {
+	u.Add(roundingrule.FieldMaxRoundUp, v)
+	return u
+}
+
+// ClearMaxRoundUp clears the value of the "max_round_up" field.
+func (u *RoundingRuleUpsert) ClearMaxRoundUp() *RoundingRuleUpsert {
+	u.SetNull(roundingrule.FieldMaxRoundUp)
+	return u
+}
+
+// SetPaymentMethods sets the "payment_methods" field.
+func (u *RoundingRuleUpsert) SetPaymentMethods(v []string) *RoundingRuleUpsert {
+	u.Set(roundingrule.FieldPaymentMethods, v)
+	return u
+}
+
+// UpdatePaymentMethods sets the "payment_methods" field to the value that was provided on create.
+func (u *RoundingRuleUpsert) UpdatePaymentMethods() *RoundingRuleUpsert {
+	u.SetExcluded(roundingrule.FieldPaymentMethods)
+	return u
+}
+
+// ClearPaymentMethods clears the value of the "payment_methods" field.
+func (u *RoundingRuleUpsert) ClearPaymentMethods() *RoundingRuleUpsert {
+	u.SetNull(roundingrule.FieldPaymentMethods)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RoundingRuleUpsert) SetEnabled(v bool) *RoundingRuleUpsert {
+	u.Set(roundingrule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RoundingRuleUpsert) UpdateEnabled() *RoundingRuleUpsert {
+	u.SetExcluded(roundingrule.FieldEnabled)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RoundingRuleUpsert) SetUpdatedAt(v time.Time) *RoundingRuleUpsert {
+	u.Set(roundingrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RoundingRuleUpsert) UpdateUpdatedAt() *RoundingRuleUpsert {
+	u.SetExcluded(roundingrule.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RoundingRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(roundingrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RoundingRuleUpsertOne) UpdateNewValues() *RoundingRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(roundingrule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(roundingrule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RoundingRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RoundingRuleUpsertOne) Ignore() *RoundingRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RoundingRuleUpsertOne) DoNothing() *RoundingRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RoundingRuleCreate.OnConflict
+// documentation for more info.
+func (u *RoundingRuleUpsertOne) Update(set func(*RoundingRuleUpsert)) *RoundingRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RoundingRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *RoundingRuleUpsertOne) SetUserID(v string) *RoundingRuleUpsertOne {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *RoundingRuleUpsertOne) UpdateUserID() *RoundingRuleUpsertOne {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetIncrement sets the "increment" field.
+func (u *RoundingRuleUpsertOne) SetIncrement(v float64) *RoundingRuleUpsertOne {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.SetIncrement(v)
+	})
+}
+
+// AddIncrement adds v to the "increment" field.
+func (u *RoundingRuleUpsertOne) AddIncrement(v float64) *RoundingRuleUpsertOne {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.AddIncrement(v)
+	})
+}
+
+// UpdateIncrement sets the "increment" field to the value that was provided on create.
+func (u *RoundingRuleUpsertOne) UpdateIncrement() *RoundingRuleUpsertOne {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.UpdateIncrement()
+	})
+}
+
+// SetMultiplier sets the "multiplier" field.
+func (u *RoundingRuleUpsertOne) SetMultiplier(v float64) *RoundingRuleUpsertOne {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.SetMultiplier(v)
+	})
+}
+
+// AddMultiplier adds v to the "multiplier" field.
+func (u *RoundingRuleUpsertOne) AddMultiplier(v float64) *RoundingRuleUpsertOne {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.AddMultiplier(v)
+	})
+}
+
+// UpdateMultiplier sets the "multiplier" field to the value that was provided on create.
+func (u *RoundingRuleUpsertOne) UpdateMultiplier() *RoundingRuleUpsertOne {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.UpdateMultiplier()
+	})
+}
+
+// SetMaxRoundUp sets the "max_round_up" field.
+func (u *RoundingRuleUpsertOne) SetMaxRoundUp(v float64) *RoundingRuleUpsertOne {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.SetMaxRoundUp(v)
+	})
+}
+
+// AddMaxRoundUp adds v to the "max_round_up" field.
+func (u *RoundingRuleUpsertOne) AddMaxRoundUp(v float64) *RoundingRuleUpsertOne {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.AddMaxRoundUp(v)
+	})
+}
+
+// UpdateMaxRoundUp sets the "max_round_up" field to the value that was provided on create.
+func (u *RoundingRuleUpsertOne) UpdateMaxRoundUp() *RoundingRuleUpsertOne {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.UpdateMaxRoundUp()
+	})
+}
+
+// ClearMaxRoundUp clears the value of the "max_round_up" field.
+func (u *RoundingRuleUpsertOne) ClearMaxRoundUp() *RoundingRuleUpsertOne {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.ClearMaxRoundUp()
+	})
+}
+
+// SetPaymentMethods sets the "payment_methods" field.
+func (u *RoundingRuleUpsertOne) SetPaymentMethods(v []string) *RoundingRuleUpsertOne {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.SetPaymentMethods(v)
+	})
+}
+
+// UpdatePaymentMethods sets the "payment_methods" field to the value that was provided on create.
+func (u *RoundingRuleUpsertOne) UpdatePaymentMethods() *RoundingRuleUpsertOne {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.UpdatePaymentMethods()
+	})
+}
+
+// ClearPaymentMethods clears the value of the "payment_methods" field.
+func (u *RoundingRuleUpsertOne) ClearPaymentMethods() *RoundingRuleUpsertOne {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.ClearPaymentMethods()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RoundingRuleUpsertOne) SetEnabled(v bool) *RoundingRuleUpsertOne {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RoundingRuleUpsertOne) UpdateEnabled() *RoundingRuleUpsertOne {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RoundingRuleUpsertOne) SetUpdatedAt(v time.Time) *RoundingRuleUpsertOne {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RoundingRuleUpsertOne) UpdateUpdatedAt() *RoundingRuleUpsertOne {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RoundingRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RoundingRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RoundingRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RoundingRuleUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: RoundingRuleUpsertOne.ID is not supported by MySQL driver. Use RoundingRuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RoundingRuleUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RoundingRuleCreateBulk is the builder for creating many RoundingRule entities in bulk.
+type RoundingRuleCreateBulk struct {
+	config
+	err      error
+	builders []*RoundingRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RoundingRule entities in the database.
+func (_c *RoundingRuleCreateBulk) Save(ctx context.Context) ([]*RoundingRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RoundingRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RoundingRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RoundingRuleCreateBulk) SaveX(ctx context.Context) []*RoundingRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RoundingRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RoundingRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RoundingRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RoundingRuleUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RoundingRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *RoundingRuleUpsertBulk {
+	_c.conflict = opts
+	return &RoundingRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RoundingRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RoundingRuleCreateBulk) OnConflictColumns(columns ...string) *RoundingRuleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RoundingRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// RoundingRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of RoundingRule nodes.
+type RoundingRuleUpsertBulk struct {
+	create *RoundingRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RoundingRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(roundingrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RoundingRuleUpsertBulk) UpdateNewValues() *RoundingRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(roundingrule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(roundingrule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RoundingRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RoundingRuleUpsertBulk) Ignore() *RoundingRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RoundingRuleUpsertBulk) DoNothing() *RoundingRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RoundingRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *RoundingRuleUpsertBulk) Update(set func(*RoundingRuleUpsert)) *RoundingRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RoundingRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *RoundingRuleUpsertBulk) SetUserID(v string) *RoundingRuleUpsertBulk {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *RoundingRuleUpsertBulk) UpdateUserID() *RoundingRuleUpsertBulk {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetIncrement sets the "increment" field.
+func (u *RoundingRuleUpsertBulk) SetIncrement(v float64) *RoundingRuleUpsertBulk {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.SetIncrement(v)
+	})
+}
+
+// AddIncrement adds v to the "increment" field.
+func (u *RoundingRuleUpsertBulk) AddIncrement(v float64) *RoundingRuleUpsertBulk {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.AddIncrement(v)
+	})
+}
+
+// UpdateIncrement sets the "increment" field to the value that was provided on create.
+func (u *RoundingRuleUpsertBulk) UpdateIncrement() *RoundingRuleUpsertBulk {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.UpdateIncrement()
+	})
+}
+
+// SetMultiplier sets the "multiplier" field.
+func (u *RoundingRuleUpsertBulk) SetMultiplier(v float64) *RoundingRuleUpsertBulk {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.SetMultiplier(v)
+	})
+}
+
+// AddMultiplier adds v to the "multiplier" field.
+func (u *RoundingRuleUpsertBulk) AddMultiplier(v float64) *RoundingRuleUpsertBulk {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.AddMultiplier(v)
+	})
+}
+
+// UpdateMultiplier sets the "multiplier" field to the value that was provided on create.
+func (u *RoundingRuleUpsertBulk) UpdateMultiplier() *RoundingRuleUpsertBulk {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.UpdateMultiplier()
+	})
+}
+
+// SetMaxRoundUp sets the "max_round_up" field.
+func (u *RoundingRuleUpsertBulk) SetMaxRoundUp(v float64) *RoundingRuleUpsertBulk {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.SetMaxRoundUp(v)
+	})
+}
+
+// AddMaxRoundUp adds v to the "max_round_up" field.
+func (u *RoundingRuleUpsertBulk) AddMaxRoundUp(v float64) *RoundingRuleUpsertBulk {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.AddMaxRoundUp(v)
+	})
+}
+
+// UpdateMaxRoundUp sets the "max_round_up" field to the value that was provided on create.
+func (u *RoundingRuleUpsertBulk) UpdateMaxRoundUp() *RoundingRuleUpsertBulk {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.UpdateMaxRoundUp()
+	})
+}
+
+// ClearMaxRoundUp clears the value of the "max_round_up" field.
+func (u *RoundingRuleUpsertBulk) ClearMaxRoundUp() *RoundingRuleUpsertBulk {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.ClearMaxRoundUp()
+	})
+}
+
+// SetPaymentMethods sets the "payment_methods" field.
+func (u *RoundingRuleUpsertBulk) SetPaymentMethods(v []string) *RoundingRuleUpsertBulk {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.SetPaymentMethods(v)
+	})
+}
+
+// UpdatePaymentMethods sets the "payment_methods" field to the value that was provided on create.
+func (u *RoundingRuleUpsertBulk) UpdatePaymentMethods() *RoundingRuleUpsertBulk {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.UpdatePaymentMethods()
+	})
+}
+
+// ClearPaymentMethods clears the value of the "payment_methods" field.
+func (u *RoundingRuleUpsertBulk) ClearPaymentMethods() *RoundingRuleUpsertBulk {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.ClearPaymentMethods()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RoundingRuleUpsertBulk) SetEnabled(v bool) *RoundingRuleUpsertBulk {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RoundingRuleUpsertBulk) UpdateEnabled() *RoundingRuleUpsertBulk {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RoundingRuleUpsertBulk) SetUpdatedAt(v time.Time) *RoundingRuleUpsertBulk {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RoundingRuleUpsertBulk) UpdateUpdatedAt() *RoundingRuleUpsertBulk {
+	return u.Update(func(s *RoundingRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RoundingRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RoundingRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RoundingRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RoundingRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
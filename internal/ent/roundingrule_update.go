@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/predicate"
+	"clockzen-next/internal/ent/roundingrule"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// RoundingRuleUpdate is the builder for updating RoundingRule entities.
+type RoundingRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RoundingRuleMutation
+}
+
+// Where appends a list predicates to the RoundingRuleUpdate builder.
+func (_u *RoundingRuleUpdate) Where(ps ...predicate.RoundingRule) *RoundingRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *RoundingRuleUpdate) SetUserID(v string) *RoundingRuleUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *RoundingRuleUpdate) SetNillableUserID(v *string) *RoundingRuleUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetIncrement sets the "increment" field.
+func (_u *RoundingRuleUpdate) SetIncrement(v float64) *RoundingRuleUpdate {
+	_u.mutation.ResetIncrement()
+	_u.mutation.SetIncrement(v)
+	return _u
+}
+
+// SetNillableIncrement sets the "increment" field if the given value is not nil.
+func (_u *RoundingRuleUpdate) SetNillableIncrement(v *float64) *RoundingRuleUpdate {
+	if v != nil {
+		_u.SetIncrement(*v)
+	}
+	return _u
+}
+
+// AddIncrement adds value to the "increment" field.
+func (_u *RoundingRuleUpdate) AddIncrement(v float64) *RoundingRuleUpdate {
+	_u.mutation.AddIncrement(v)
+	return _u
+}
+
+// SetMultiplier sets the "multiplier" field.
+func (_u *RoundingRuleUpdate) SetMultiplier(v float64) *RoundingRuleUpdate {
+	_u.mutation.ResetMultiplier()
+	_u.mutation.SetMultiplier(v)
+	return _u
+}
+
+// SetNillableMultiplier sets the "multiplier" field if the given value is not nil.
+func (_u *RoundingRuleUpdate) SetNillableMultiplier(v *float64) *RoundingRuleUpdate {
+	if v != nil {
+		_u.SetMultiplier(*v)
+	}
+	return _u
+}
+
+// AddMultiplier adds value to the "multiplier" field.
+func (_u *RoundingRuleUpdate) AddMultiplier(v float64) *RoundingRuleUpdate {
+	_u.mutation.AddMultiplier(v)
+	return _u
+}
+
+// SetMaxRoundUp sets the "max_round_up" field.
+func (_u *RoundingRuleUpdate) SetMaxRoundUp(v float64) *RoundingRuleUpdate {
+	_u.mutation.ResetMaxRoundUp()
+	_u.mutation.SetMaxRoundUp(v)
+	return _u
+}
+
+// SetNillableMaxRoundUp sets the "max_round_up" field if the given value is not nil.
+func (_u *RoundingRuleUpdate) SetNillableMaxRoundUp(v *float64) *RoundingRuleUpdate {
+	if v != nil {
+		_u.SetMaxRoundUp(*v)
+	}
+	return _u
+}
+
+// AddMaxRoundUp adds value to the "max_round_up" field.
+func (_u *RoundingRuleUpdate) AddMaxRoundUp(v float64) *RoundingRuleUpdate {
+	_u.mutation.AddMaxRoundUp(v)
+	return _u
+}
+
+// ClearMaxRoundUp clears the value of the "max_round_up" field.
+func (_u *RoundingRuleUpdate) ClearMaxRoundUp() *RoundingRuleUpdate {
+	_u.mutation.ClearMaxRoundUp()
+	return _u
+}
+
+// SetPaymentMethods sets the "payment_methods" field.
+func (_u *RoundingRuleUpdate) SetPaymentMethods(v []string) *RoundingRuleUpdate {
+	_u.mutation.SetPaymentMethods(v)
+	return _u
+}
+
+// AppendPaymentMethods appends value to the "payment_methods" field.
+func (_u *RoundingRuleUpdate) AppendPaymentMethods(v []string) *RoundingRuleUpdate {
+	_u.mutation.AppendPaymentMethods(v)
+	return _u
+}
+
+// ClearPaymentMethods clears the value of the "payment_methods" field.
+func (_u *RoundingRuleUpdate) ClearPaymentMethods() *RoundingRuleUpdate {
+	_u.mutation.ClearPaymentMethods()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *RoundingRuleUpdate) SetEnabled(v bool) *RoundingRuleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *RoundingRuleUpdate) SetNillableEnabled(v *bool) *RoundingRuleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RoundingRuleUpdate) SetUpdatedAt(v time.Time) *RoundingRuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the RoundingRuleMutation object of the builder.
+func (_u *RoundingRuleUpdate) Mutation() *RoundingRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RoundingRuleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RoundingRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RoundingRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RoundingRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RoundingRuleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := roundingrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RoundingRuleUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := roundingrule.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "RoundingRule.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Increment(); ok {
+		if err := roundingrule.IncrementValidator(v); err != nil {
+			return &ValidationError{Name: "increment", err: fmt.Errorf(`ent: validator failed for field "RoundingRule.increment": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Multiplier(); ok {
+		if err := roundingrule.MultiplierValidator(v); err != nil {
+			return &ValidationError{Name: "multiplier", err: fmt.Errorf(`ent: validator failed for field "RoundingRule.multiplier": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RoundingRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roundingrule.Table, roundingrule.Columns, sqlgraph.NewFieldSpec(roundingrule.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(roundingrule.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Increment(); ok {
+		_spec.SetField(roundingrule.FieldIncrement, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedIncrement(); ok {
+		_spec.AddField(roundingrule.FieldIncrement, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Multiplier(); ok {
+		_spec.SetField(roundingrule.FieldMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMultiplier(); ok {
+		_spec.AddField(roundingrule.FieldMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxRoundUp(); ok {
+		_spec.SetField(roundingrule.FieldMaxRoundUp, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRoundUp(); ok {
+		_spec.AddField(roundingrule.FieldMaxRoundUp, field.TypeFloat64, value)
+	}
+	if _u.mutation.MaxRoundUpCleared() {
+		_spec.ClearField(roundingrule.FieldMaxRoundUp, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.PaymentMethods(); ok {
+		_spec.SetField(roundingrule.FieldPaymentMethods, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPaymentMethods(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, roundingrule.FieldPaymentMethods, value)
+		})
+	}
+	if _u.mutation.PaymentMethodsCleared() {
+		_spec.ClearField(roundingrule.FieldPaymentMethods, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(roundingrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(roundingrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roundingrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RoundingRuleUpdateOne is the builder for updating a single RoundingRule entity.
+type RoundingRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RoundingRuleMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *RoundingRuleUpdateOne) SetUserID(v string) *RoundingRuleUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *RoundingRuleUpdateOne) SetNillableUserID(v *string) *RoundingRuleUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetIncrement sets the "increment" field.
+func (_u *RoundingRuleUpdateOne) SetIncrement(v float64) *RoundingRuleUpdateOne {
+	_u.mutation.ResetIncrement()
+	_u.mutation.SetIncrement(v)
+	return _u
+}
+
+// SetNillableIncrement sets the "increment" field if the given value is not nil.
+func (_u *RoundingRuleUpdateOne) SetNillableIncrement(v *float64) *RoundingRuleUpdateOne {
+	if v != nil {
+		_u.SetIncrement(*v)
+	}
+	return _u
+}
+
+// AddIncrement adds value to the "increment" field.
+func (_u *RoundingRuleUpdateOne) AddIncrement(v float64) *RoundingRuleUpdateOne {
+	_u.mutation.AddIncrement(v)
+	return _u
+}
+
+// SetMultiplier sets the "multiplier" field.
+func (_u *RoundingRuleUpdateOne) SetMultiplier(v float64) *RoundingRuleUpdateOne {
+	_u.mutation.ResetMultiplier()
+	_u.mutation.SetMultiplier(v)
+	return _u
+}
+
+// SetNillableMultiplier sets the "multiplier" field if the given value is not nil.
+func (_u *RoundingRuleUpdateOne) SetNillableMultiplier(v *float64) *RoundingRuleUpdateOne {
+	if v != nil {
+		_u.SetMultiplier(*v)
+	}
+	return _u
+}
+
+// AddMultiplier adds value to the "multiplier" field.
+func (_u *RoundingRuleUpdateOne) AddMultiplier(v float64) *RoundingRuleUpdateOne {
+	_u.mutation.AddMultiplier(v)
+	return _u
+}
+
+// SetMaxRoundUp sets the "max_round_up" field.
+func (_u *RoundingRuleUpdateOne) SetMaxRoundUp(v float64) *RoundingRuleUpdateOne {
+	_u.mutation.ResetMaxRoundUp()
+	_u.mutation.SetMaxRoundUp(v)
+	return _u
+}
+
+// SetNillableMaxRoundUp sets the "max_round_up" field if the given value is not nil.
+func (_u *RoundingRuleUpdateOne) SetNillableMaxRoundUp(v *float64) *RoundingRuleUpdateOne {
+	if v != nil {
+		_u.SetMaxRoundUp(*v)
+	}
+	return _u
+}
+
+// AddMaxRoundUp adds value to the "max_round_up" field.
+func (_u *RoundingRuleUpdateOne) AddMaxRoundUp(v float64) *RoundingRuleUpdateOne {
+	_u.mutation.AddMaxRoundUp(v)
+	return _u
+}
+
+// ClearMaxRoundUp clears the value of the "max_round_up" field.
+func (_u *RoundingRuleUpdateOne) ClearMaxRoundUp() *RoundingRuleUpdateOne {
+	_u.mutation.ClearMaxRoundUp()
+	return _u
+}
+
+// SetPaymentMethods sets the "payment_methods" field.
+func (_u *RoundingRuleUpdateOne) SetPaymentMethods(v []string) *RoundingRuleUpdateOne {
+	_u.mutation.SetPaymentMethods(v)
+	return _u
+}
+
+// AppendPaymentMethods appends value to the "payment_methods" field.
+func (_u *RoundingRuleUpdateOne) AppendPaymentMethods(v []string) *RoundingRuleUpdateOne {
+	_u.mutation.AppendPaymentMethods(v)
+	return _u
+}
+
+// ClearPaymentMethods clears the value of the "payment_methods" field.
+func (_u *RoundingRuleUpdateOne) ClearPaymentMethods() *RoundingRuleUpdateOne {
+	_u.mutation.ClearPaymentMethods()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *RoundingRuleUpdateOne) SetEnabled(v bool) *RoundingRuleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *RoundingRuleUpdateOne) SetNillableEnabled(v *bool) *RoundingRuleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RoundingRuleUpdateOne) SetUpdatedAt(v time.Time) *RoundingRuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the RoundingRuleMutation object of the builder.
+func (_u *RoundingRuleUpdateOne) Mutation() *RoundingRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RoundingRuleUpdate builder.
+func (_u *RoundingRuleUpdateOne) Where(ps ...predicate.RoundingRule) *RoundingRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RoundingRuleUpdateOne) Select(field string, fields ...string) *RoundingRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RoundingRule entity.
+func (_u *RoundingRuleUpdateOne) Save(ctx context.Context) (*RoundingRule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RoundingRuleUpdateOne) SaveX(ctx context.Context) *RoundingRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RoundingRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RoundingRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RoundingRuleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := roundingrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RoundingRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := roundingrule.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "RoundingRule.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Increment(); ok {
+		if err := roundingrule.IncrementValidator(v); err != nil {
+			return &ValidationError{Name: "increment", err: fmt.Errorf(`ent: validator failed for field "RoundingRule.increment": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Multiplier(); ok {
+		if err := roundingrule.MultiplierValidator(v); err != nil {
+			return &ValidationError{Name: "multiplier", err: fmt.Errorf(`ent: validator failed for field "RoundingRule.multiplier": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RoundingRuleUpdateOne) sqlSave(ctx context.Context) (_node *RoundingRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roundingrule.Table, roundingrule.Columns, sqlgraph.NewFieldSpec(roundingrule.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RoundingRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, roundingrule.FieldID)
+		for _, f := range fields {
+			if !roundingrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != roundingrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(roundingrule.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Increment(); ok {
+		_spec.SetField(roundingrule.FieldIncrement, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedIncrement(); ok {
+		_spec.AddField(roundingrule.FieldIncrement, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Multiplier(); ok {
+		_spec.SetField(roundingrule.FieldMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMultiplier(); ok {
+		_spec.AddField(roundingrule.FieldMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxRoundUp(); ok {
+		_spec.SetField(roundingrule.FieldMaxRoundUp, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRoundUp(); ok {
+		_spec.AddField(roundingrule.FieldMaxRoundUp, field.TypeFloat64, value)
+	}
+	if _u.mutation.MaxRoundUpCleared() {
+		_spec.ClearField(roundingrule.FieldMaxRoundUp, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.PaymentMethods(); ok {
+		_spec.SetField(roundingrule.FieldPaymentMethods, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPaymentMethods(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, roundingrule.FieldPaymentMethods, value)
+		})
+	}
+	if _u.mutation.PaymentMethodsCleared() {
+		_spec.ClearField(roundingrule.FieldPaymentMethods, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(roundingrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(roundingrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &RoundingRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roundingrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
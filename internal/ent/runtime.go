@@ -3,19 +3,55 @@
 package ent
 
 import (
+	"clockzen-next/internal/ent/accountdeletion"
+	"clockzen-next/internal/ent/alert"
+	"clockzen-next/internal/ent/alertpreference"
+	"clockzen-next/internal/ent/attachmentblob"
+	"clockzen-next/internal/ent/attachmentlink"
+	"clockzen-next/internal/ent/budgetreallocation"
+	"clockzen-next/internal/ent/bulkoperation"
+	"clockzen-next/internal/ent/cardaccount"
+	"clockzen-next/internal/ent/categorizationrule"
+	"clockzen-next/internal/ent/categoryfeedback"
+	"clockzen-next/internal/ent/debt"
 	"clockzen-next/internal/ent/emailconnection"
 	"clockzen-next/internal/ent/emaillabel"
+	"clockzen-next/internal/ent/emailmessage"
 	"clockzen-next/internal/ent/emailsync"
+	"clockzen-next/internal/ent/emailsyncfailure"
+	"clockzen-next/internal/ent/emergencyfundsnapshot"
+	"clockzen-next/internal/ent/emergencyfundtarget"
+	"clockzen-next/internal/ent/goal"
 	"clockzen-next/internal/ent/googledriveconnection"
 	"clockzen-next/internal/ent/googledrivefolder"
 	"clockzen-next/internal/ent/googledrivesync"
+	"clockzen-next/internal/ent/householdmember"
+	"clockzen-next/internal/ent/jobqueue"
+	"clockzen-next/internal/ent/jobschedule"
+	"clockzen-next/internal/ent/jobschedulerun"
 	"clockzen-next/internal/ent/lineitem"
+	"clockzen-next/internal/ent/liquidaccount"
+	"clockzen-next/internal/ent/membership"
+	"clockzen-next/internal/ent/merchant"
+	"clockzen-next/internal/ent/migrationstate"
+	"clockzen-next/internal/ent/notification"
+	"clockzen-next/internal/ent/oauthstate"
+	"clockzen-next/internal/ent/ocrfeedback"
+	"clockzen-next/internal/ent/organization"
 	"clockzen-next/internal/ent/pipelineconfig"
 	"clockzen-next/internal/ent/pipelinerule"
 	"clockzen-next/internal/ent/pipelineversion"
+	"clockzen-next/internal/ent/queuedjob"
 	"clockzen-next/internal/ent/receipt"
+	"clockzen-next/internal/ent/receiptevent"
+	"clockzen-next/internal/ent/roundingrule"
+	"clockzen-next/internal/ent/savedfilter"
 	"clockzen-next/internal/ent/schema"
+	"clockzen-next/internal/ent/taxyear"
 	"clockzen-next/internal/ent/transaction"
+	"clockzen-next/internal/ent/user"
+	"clockzen-next/internal/ent/webhookdelivery"
+	"clockzen-next/internal/ent/webhookendpoint"
 	"time"
 )
 
@@ -23,6 +59,264 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accountdeletionFields := schema.AccountDeletion{}.Fields()
+	_ = accountdeletionFields
+	// accountdeletionDescUserID is the schema descriptor for user_id field.
+	accountdeletionDescUserID := accountdeletionFields[1].Descriptor()
+	// accountdeletion.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	accountdeletion.UserIDValidator = accountdeletionDescUserID.Validators[0].(func(string) error)
+	// accountdeletionDescRequestedBy is the schema descriptor for requested_by field.
+	accountdeletionDescRequestedBy := accountdeletionFields[2].Descriptor()
+	// accountdeletion.RequestedByValidator is a validator for the "requested_by" field. It is called by the builders before save.
+	accountdeletion.RequestedByValidator = accountdeletionDescRequestedBy.Validators[0].(func(string) error)
+	// accountdeletionDescTokensRevoked is the schema descriptor for tokens_revoked field.
+	accountdeletionDescTokensRevoked := accountdeletionFields[4].Descriptor()
+	// accountdeletion.DefaultTokensRevoked holds the default value on creation for the tokens_revoked field.
+	accountdeletion.DefaultTokensRevoked = accountdeletionDescTokensRevoked.Default.(int)
+	// accountdeletionDescTokensFailed is the schema descriptor for tokens_failed field.
+	accountdeletionDescTokensFailed := accountdeletionFields[5].Descriptor()
+	// accountdeletion.DefaultTokensFailed holds the default value on creation for the tokens_failed field.
+	accountdeletion.DefaultTokensFailed = accountdeletionDescTokensFailed.Default.(int)
+	// accountdeletionDescBlobsPurged is the schema descriptor for blobs_purged field.
+	accountdeletionDescBlobsPurged := accountdeletionFields[6].Descriptor()
+	// accountdeletion.DefaultBlobsPurged holds the default value on creation for the blobs_purged field.
+	accountdeletion.DefaultBlobsPurged = accountdeletionDescBlobsPurged.Default.(int)
+	// accountdeletionDescCreatedAt is the schema descriptor for created_at field.
+	accountdeletionDescCreatedAt := accountdeletionFields[7].Descriptor()
+	// accountdeletion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accountdeletion.DefaultCreatedAt = accountdeletionDescCreatedAt.Default.(func() time.Time)
+	alertFields := schema.Alert{}.Fields()
+	_ = alertFields
+	// alertDescUserID is the schema descriptor for user_id field.
+	alertDescUserID := alertFields[1].Descriptor()
+	// alert.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	alert.UserIDValidator = alertDescUserID.Validators[0].(func(string) error)
+	// alertDescTitle is the schema descriptor for title field.
+	alertDescTitle := alertFields[4].Descriptor()
+	// alert.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	alert.TitleValidator = alertDescTitle.Validators[0].(func(string) error)
+	// alertDescDedupeKey is the schema descriptor for dedupe_key field.
+	alertDescDedupeKey := alertFields[6].Descriptor()
+	// alert.DedupeKeyValidator is a validator for the "dedupe_key" field. It is called by the builders before save.
+	alert.DedupeKeyValidator = alertDescDedupeKey.Validators[0].(func(string) error)
+	// alertDescCreatedAt is the schema descriptor for created_at field.
+	alertDescCreatedAt := alertFields[12].Descriptor()
+	// alert.DefaultCreatedAt holds the default value on creation for the created_at field.
+	alert.DefaultCreatedAt = alertDescCreatedAt.Default.(func() time.Time)
+	alertpreferenceFields := schema.AlertPreference{}.Fields()
+	_ = alertpreferenceFields
+	// alertpreferenceDescUserID is the schema descriptor for user_id field.
+	alertpreferenceDescUserID := alertpreferenceFields[1].Descriptor()
+	// alertpreference.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	alertpreference.UserIDValidator = alertpreferenceDescUserID.Validators[0].(func(string) error)
+	// alertpreferenceDescAnomalyAlerts is the schema descriptor for anomaly_alerts field.
+	alertpreferenceDescAnomalyAlerts := alertpreferenceFields[2].Descriptor()
+	// alertpreference.DefaultAnomalyAlerts holds the default value on creation for the anomaly_alerts field.
+	alertpreference.DefaultAnomalyAlerts = alertpreferenceDescAnomalyAlerts.Default.(bool)
+	// alertpreferenceDescBudgetAlerts is the schema descriptor for budget_alerts field.
+	alertpreferenceDescBudgetAlerts := alertpreferenceFields[3].Descriptor()
+	// alertpreference.DefaultBudgetAlerts holds the default value on creation for the budget_alerts field.
+	alertpreference.DefaultBudgetAlerts = alertpreferenceDescBudgetAlerts.Default.(bool)
+	// alertpreferenceDescBudgetThresholdPercent is the schema descriptor for budget_threshold_percent field.
+	alertpreferenceDescBudgetThresholdPercent := alertpreferenceFields[5].Descriptor()
+	// alertpreference.DefaultBudgetThresholdPercent holds the default value on creation for the budget_threshold_percent field.
+	alertpreference.DefaultBudgetThresholdPercent = alertpreferenceDescBudgetThresholdPercent.Default.(float64)
+	// alertpreference.BudgetThresholdPercentValidator is a validator for the "budget_threshold_percent" field. It is called by the builders before save.
+	alertpreference.BudgetThresholdPercentValidator = alertpreferenceDescBudgetThresholdPercent.Validators[0].(func(float64) error)
+	// alertpreferenceDescWeeklySummary is the schema descriptor for weekly_summary field.
+	alertpreferenceDescWeeklySummary := alertpreferenceFields[11].Descriptor()
+	// alertpreference.DefaultWeeklySummary holds the default value on creation for the weekly_summary field.
+	alertpreference.DefaultWeeklySummary = alertpreferenceDescWeeklySummary.Default.(bool)
+	// alertpreferenceDescMonthlyReport is the schema descriptor for monthly_report field.
+	alertpreferenceDescMonthlyReport := alertpreferenceFields[12].Descriptor()
+	// alertpreference.DefaultMonthlyReport holds the default value on creation for the monthly_report field.
+	alertpreference.DefaultMonthlyReport = alertpreferenceDescMonthlyReport.Default.(bool)
+	// alertpreferenceDescCreatedAt is the schema descriptor for created_at field.
+	alertpreferenceDescCreatedAt := alertpreferenceFields[14].Descriptor()
+	// alertpreference.DefaultCreatedAt holds the default value on creation for the created_at field.
+	alertpreference.DefaultCreatedAt = alertpreferenceDescCreatedAt.Default.(func() time.Time)
+	// alertpreferenceDescUpdatedAt is the schema descriptor for updated_at field.
+	alertpreferenceDescUpdatedAt := alertpreferenceFields[15].Descriptor()
+	// alertpreference.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	alertpreference.DefaultUpdatedAt = alertpreferenceDescUpdatedAt.Default.(func() time.Time)
+	// alertpreference.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	alertpreference.UpdateDefaultUpdatedAt = alertpreferenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	attachmentblobFields := schema.AttachmentBlob{}.Fields()
+	_ = attachmentblobFields
+	// attachmentblobDescSha256 is the schema descriptor for sha256 field.
+	attachmentblobDescSha256 := attachmentblobFields[1].Descriptor()
+	// attachmentblob.Sha256Validator is a validator for the "sha256" field. It is called by the builders before save.
+	attachmentblob.Sha256Validator = attachmentblobDescSha256.Validators[0].(func(string) error)
+	// attachmentblobDescSize is the schema descriptor for size field.
+	attachmentblobDescSize := attachmentblobFields[2].Descriptor()
+	// attachmentblob.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	attachmentblob.SizeValidator = attachmentblobDescSize.Validators[0].(func(int64) error)
+	// attachmentblobDescCreatedAt is the schema descriptor for created_at field.
+	attachmentblobDescCreatedAt := attachmentblobFields[5].Descriptor()
+	// attachmentblob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attachmentblob.DefaultCreatedAt = attachmentblobDescCreatedAt.Default.(func() time.Time)
+	attachmentlinkFields := schema.AttachmentLink{}.Fields()
+	_ = attachmentlinkFields
+	// attachmentlinkDescBlobID is the schema descriptor for blob_id field.
+	attachmentlinkDescBlobID := attachmentlinkFields[1].Descriptor()
+	// attachmentlink.BlobIDValidator is a validator for the "blob_id" field. It is called by the builders before save.
+	attachmentlink.BlobIDValidator = attachmentlinkDescBlobID.Validators[0].(func(string) error)
+	// attachmentlinkDescConnectionID is the schema descriptor for connection_id field.
+	attachmentlinkDescConnectionID := attachmentlinkFields[2].Descriptor()
+	// attachmentlink.ConnectionIDValidator is a validator for the "connection_id" field. It is called by the builders before save.
+	attachmentlink.ConnectionIDValidator = attachmentlinkDescConnectionID.Validators[0].(func(string) error)
+	// attachmentlinkDescMessageID is the schema descriptor for message_id field.
+	attachmentlinkDescMessageID := attachmentlinkFields[3].Descriptor()
+	// attachmentlink.MessageIDValidator is a validator for the "message_id" field. It is called by the builders before save.
+	attachmentlink.MessageIDValidator = attachmentlinkDescMessageID.Validators[0].(func(string) error)
+	// attachmentlinkDescCreatedAt is the schema descriptor for created_at field.
+	attachmentlinkDescCreatedAt := attachmentlinkFields[6].Descriptor()
+	// attachmentlink.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attachmentlink.DefaultCreatedAt = attachmentlinkDescCreatedAt.Default.(func() time.Time)
+	budgetreallocationFields := schema.BudgetReallocation{}.Fields()
+	_ = budgetreallocationFields
+	// budgetreallocationDescUserID is the schema descriptor for user_id field.
+	budgetreallocationDescUserID := budgetreallocationFields[1].Descriptor()
+	// budgetreallocation.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	budgetreallocation.UserIDValidator = budgetreallocationDescUserID.Validators[0].(func(string) error)
+	// budgetreallocationDescBudgetID is the schema descriptor for budget_id field.
+	budgetreallocationDescBudgetID := budgetreallocationFields[3].Descriptor()
+	// budgetreallocation.BudgetIDValidator is a validator for the "budget_id" field. It is called by the builders before save.
+	budgetreallocation.BudgetIDValidator = budgetreallocationDescBudgetID.Validators[0].(func(string) error)
+	// budgetreallocationDescFromCategory is the schema descriptor for from_category field.
+	budgetreallocationDescFromCategory := budgetreallocationFields[4].Descriptor()
+	// budgetreallocation.FromCategoryValidator is a validator for the "from_category" field. It is called by the builders before save.
+	budgetreallocation.FromCategoryValidator = budgetreallocationDescFromCategory.Validators[0].(func(string) error)
+	// budgetreallocationDescToCategory is the schema descriptor for to_category field.
+	budgetreallocationDescToCategory := budgetreallocationFields[5].Descriptor()
+	// budgetreallocation.ToCategoryValidator is a validator for the "to_category" field. It is called by the builders before save.
+	budgetreallocation.ToCategoryValidator = budgetreallocationDescToCategory.Validators[0].(func(string) error)
+	// budgetreallocationDescAmount is the schema descriptor for amount field.
+	budgetreallocationDescAmount := budgetreallocationFields[6].Descriptor()
+	// budgetreallocation.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	budgetreallocation.AmountValidator = budgetreallocationDescAmount.Validators[0].(func(float64) error)
+	// budgetreallocationDescCreatedAt is the schema descriptor for created_at field.
+	budgetreallocationDescCreatedAt := budgetreallocationFields[9].Descriptor()
+	// budgetreallocation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	budgetreallocation.DefaultCreatedAt = budgetreallocationDescCreatedAt.Default.(func() time.Time)
+	bulkoperationFields := schema.BulkOperation{}.Fields()
+	_ = bulkoperationFields
+	// bulkoperationDescUserID is the schema descriptor for user_id field.
+	bulkoperationDescUserID := bulkoperationFields[1].Descriptor()
+	// bulkoperation.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	bulkoperation.UserIDValidator = bulkoperationDescUserID.Validators[0].(func(string) error)
+	// bulkoperationDescCreatedAt is the schema descriptor for created_at field.
+	bulkoperationDescCreatedAt := bulkoperationFields[8].Descriptor()
+	// bulkoperation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bulkoperation.DefaultCreatedAt = bulkoperationDescCreatedAt.Default.(func() time.Time)
+	cardaccountFields := schema.CardAccount{}.Fields()
+	_ = cardaccountFields
+	// cardaccountDescUserID is the schema descriptor for user_id field.
+	cardaccountDescUserID := cardaccountFields[1].Descriptor()
+	// cardaccount.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	cardaccount.UserIDValidator = cardaccountDescUserID.Validators[0].(func(string) error)
+	// cardaccountDescName is the schema descriptor for name field.
+	cardaccountDescName := cardaccountFields[2].Descriptor()
+	// cardaccount.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	cardaccount.NameValidator = cardaccountDescName.Validators[0].(func(string) error)
+	// cardaccountDescStatementClosingDay is the schema descriptor for statement_closing_day field.
+	cardaccountDescStatementClosingDay := cardaccountFields[4].Descriptor()
+	// cardaccount.StatementClosingDayValidator is a validator for the "statement_closing_day" field. It is called by the builders before save.
+	cardaccount.StatementClosingDayValidator = cardaccountDescStatementClosingDay.Validators[0].(func(int) error)
+	// cardaccountDescPaymentDueDays is the schema descriptor for payment_due_days field.
+	cardaccountDescPaymentDueDays := cardaccountFields[5].Descriptor()
+	// cardaccount.DefaultPaymentDueDays holds the default value on creation for the payment_due_days field.
+	cardaccount.DefaultPaymentDueDays = cardaccountDescPaymentDueDays.Default.(int)
+	// cardaccount.PaymentDueDaysValidator is a validator for the "payment_due_days" field. It is called by the builders before save.
+	cardaccount.PaymentDueDaysValidator = cardaccountDescPaymentDueDays.Validators[0].(func(int) error)
+	// cardaccountDescCreatedAt is the schema descriptor for created_at field.
+	cardaccountDescCreatedAt := cardaccountFields[6].Descriptor()
+	// cardaccount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	cardaccount.DefaultCreatedAt = cardaccountDescCreatedAt.Default.(func() time.Time)
+	// cardaccountDescUpdatedAt is the schema descriptor for updated_at field.
+	cardaccountDescUpdatedAt := cardaccountFields[7].Descriptor()
+	// cardaccount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	cardaccount.DefaultUpdatedAt = cardaccountDescUpdatedAt.Default.(func() time.Time)
+	// cardaccount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	cardaccount.UpdateDefaultUpdatedAt = cardaccountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	categorizationruleFields := schema.CategorizationRule{}.Fields()
+	_ = categorizationruleFields
+	// categorizationruleDescUserID is the schema descriptor for user_id field.
+	categorizationruleDescUserID := categorizationruleFields[1].Descriptor()
+	// categorizationrule.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	categorizationrule.UserIDValidator = categorizationruleDescUserID.Validators[0].(func(string) error)
+	// categorizationruleDescName is the schema descriptor for name field.
+	categorizationruleDescName := categorizationruleFields[2].Descriptor()
+	// categorizationrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	categorizationrule.NameValidator = categorizationruleDescName.Validators[0].(func(string) error)
+	// categorizationruleDescPriority is the schema descriptor for priority field.
+	categorizationruleDescPriority := categorizationruleFields[3].Descriptor()
+	// categorizationrule.DefaultPriority holds the default value on creation for the priority field.
+	categorizationrule.DefaultPriority = categorizationruleDescPriority.Default.(int)
+	// categorizationruleDescEnabled is the schema descriptor for enabled field.
+	categorizationruleDescEnabled := categorizationruleFields[4].Descriptor()
+	// categorizationrule.DefaultEnabled holds the default value on creation for the enabled field.
+	categorizationrule.DefaultEnabled = categorizationruleDescEnabled.Default.(bool)
+	// categorizationruleDescCreatedAt is the schema descriptor for created_at field.
+	categorizationruleDescCreatedAt := categorizationruleFields[11].Descriptor()
+	// categorizationrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	categorizationrule.DefaultCreatedAt = categorizationruleDescCreatedAt.Default.(func() time.Time)
+	// categorizationruleDescUpdatedAt is the schema descriptor for updated_at field.
+	categorizationruleDescUpdatedAt := categorizationruleFields[12].Descriptor()
+	// categorizationrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	categorizationrule.DefaultUpdatedAt = categorizationruleDescUpdatedAt.Default.(func() time.Time)
+	// categorizationrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	categorizationrule.UpdateDefaultUpdatedAt = categorizationruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	categoryfeedbackFields := schema.CategoryFeedback{}.Fields()
+	_ = categoryfeedbackFields
+	// categoryfeedbackDescUserID is the schema descriptor for user_id field.
+	categoryfeedbackDescUserID := categoryfeedbackFields[1].Descriptor()
+	// categoryfeedback.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	categoryfeedback.UserIDValidator = categoryfeedbackDescUserID.Validators[0].(func(string) error)
+	// categoryfeedbackDescTransactionID is the schema descriptor for transaction_id field.
+	categoryfeedbackDescTransactionID := categoryfeedbackFields[2].Descriptor()
+	// categoryfeedback.TransactionIDValidator is a validator for the "transaction_id" field. It is called by the builders before save.
+	categoryfeedback.TransactionIDValidator = categoryfeedbackDescTransactionID.Validators[0].(func(string) error)
+	// categoryfeedbackDescSuggestedCategory is the schema descriptor for suggested_category field.
+	categoryfeedbackDescSuggestedCategory := categoryfeedbackFields[5].Descriptor()
+	// categoryfeedback.SuggestedCategoryValidator is a validator for the "suggested_category" field. It is called by the builders before save.
+	categoryfeedback.SuggestedCategoryValidator = categoryfeedbackDescSuggestedCategory.Validators[0].(func(string) error)
+	// categoryfeedbackDescCreatedAt is the schema descriptor for created_at field.
+	categoryfeedbackDescCreatedAt := categoryfeedbackFields[10].Descriptor()
+	// categoryfeedback.DefaultCreatedAt holds the default value on creation for the created_at field.
+	categoryfeedback.DefaultCreatedAt = categoryfeedbackDescCreatedAt.Default.(func() time.Time)
+	debtFields := schema.Debt{}.Fields()
+	_ = debtFields
+	// debtDescUserID is the schema descriptor for user_id field.
+	debtDescUserID := debtFields[1].Descriptor()
+	// debt.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	debt.UserIDValidator = debtDescUserID.Validators[0].(func(string) error)
+	// debtDescName is the schema descriptor for name field.
+	debtDescName := debtFields[2].Descriptor()
+	// debt.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	debt.NameValidator = debtDescName.Validators[0].(func(string) error)
+	// debtDescBalance is the schema descriptor for balance field.
+	debtDescBalance := debtFields[4].Descriptor()
+	// debt.BalanceValidator is a validator for the "balance" field. It is called by the builders before save.
+	debt.BalanceValidator = debtDescBalance.Validators[0].(func(float64) error)
+	// debtDescApr is the schema descriptor for apr field.
+	debtDescApr := debtFields[5].Descriptor()
+	// debt.AprValidator is a validator for the "apr" field. It is called by the builders before save.
+	debt.AprValidator = debtDescApr.Validators[0].(func(float64) error)
+	// debtDescMinimumPayment is the schema descriptor for minimum_payment field.
+	debtDescMinimumPayment := debtFields[6].Descriptor()
+	// debt.MinimumPaymentValidator is a validator for the "minimum_payment" field. It is called by the builders before save.
+	debt.MinimumPaymentValidator = debtDescMinimumPayment.Validators[0].(func(float64) error)
+	// debtDescCreatedAt is the schema descriptor for created_at field.
+	debtDescCreatedAt := debtFields[7].Descriptor()
+	// debt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	debt.DefaultCreatedAt = debtDescCreatedAt.Default.(func() time.Time)
+	// debtDescUpdatedAt is the schema descriptor for updated_at field.
+	debtDescUpdatedAt := debtFields[8].Descriptor()
+	// debt.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	debt.DefaultUpdatedAt = debtDescUpdatedAt.Default.(func() time.Time)
+	// debt.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	debt.UpdateDefaultUpdatedAt = debtDescUpdatedAt.UpdateDefault.(func() time.Time)
 	emailconnectionFields := schema.EmailConnection{}.Fields()
 	_ = emailconnectionFields
 	// emailconnectionDescUserID is the schema descriptor for user_id field.
@@ -30,19 +324,19 @@ func init() {
 	// emailconnection.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
 	emailconnection.UserIDValidator = emailconnectionDescUserID.Validators[0].(func(string) error)
 	// emailconnectionDescProviderAccountID is the schema descriptor for provider_account_id field.
-	emailconnectionDescProviderAccountID := emailconnectionFields[2].Descriptor()
+	emailconnectionDescProviderAccountID := emailconnectionFields[3].Descriptor()
 	// emailconnection.ProviderAccountIDValidator is a validator for the "provider_account_id" field. It is called by the builders before save.
 	emailconnection.ProviderAccountIDValidator = emailconnectionDescProviderAccountID.Validators[0].(func(string) error)
 	// emailconnectionDescEmail is the schema descriptor for email field.
-	emailconnectionDescEmail := emailconnectionFields[3].Descriptor()
+	emailconnectionDescEmail := emailconnectionFields[4].Descriptor()
 	// emailconnection.EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	emailconnection.EmailValidator = emailconnectionDescEmail.Validators[0].(func(string) error)
 	// emailconnectionDescCreatedAt is the schema descriptor for created_at field.
-	emailconnectionDescCreatedAt := emailconnectionFields[9].Descriptor()
+	emailconnectionDescCreatedAt := emailconnectionFields[11].Descriptor()
 	// emailconnection.DefaultCreatedAt holds the default value on creation for the created_at field.
 	emailconnection.DefaultCreatedAt = emailconnectionDescCreatedAt.Default.(func() time.Time)
 	// emailconnectionDescUpdatedAt is the schema descriptor for updated_at field.
-	emailconnectionDescUpdatedAt := emailconnectionFields[10].Descriptor()
+	emailconnectionDescUpdatedAt := emailconnectionFields[12].Descriptor()
 	// emailconnection.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	emailconnection.DefaultUpdatedAt = emailconnectionDescUpdatedAt.Default.(func() time.Time)
 	// emailconnection.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -83,6 +377,34 @@ func init() {
 	emaillabel.DefaultUpdatedAt = emaillabelDescUpdatedAt.Default.(func() time.Time)
 	// emaillabel.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	emaillabel.UpdateDefaultUpdatedAt = emaillabelDescUpdatedAt.UpdateDefault.(func() time.Time)
+	emailmessageFields := schema.EmailMessage{}.Fields()
+	_ = emailmessageFields
+	// emailmessageDescConnectionID is the schema descriptor for connection_id field.
+	emailmessageDescConnectionID := emailmessageFields[1].Descriptor()
+	// emailmessage.ConnectionIDValidator is a validator for the "connection_id" field. It is called by the builders before save.
+	emailmessage.ConnectionIDValidator = emailmessageDescConnectionID.Validators[0].(func(string) error)
+	// emailmessageDescMessageID is the schema descriptor for message_id field.
+	emailmessageDescMessageID := emailmessageFields[2].Descriptor()
+	// emailmessage.MessageIDValidator is a validator for the "message_id" field. It is called by the builders before save.
+	emailmessage.MessageIDValidator = emailmessageDescMessageID.Validators[0].(func(string) error)
+	// emailmessageDescHasAttachments is the schema descriptor for has_attachments field.
+	emailmessageDescHasAttachments := emailmessageFields[8].Descriptor()
+	// emailmessage.DefaultHasAttachments holds the default value on creation for the has_attachments field.
+	emailmessage.DefaultHasAttachments = emailmessageDescHasAttachments.Default.(bool)
+	// emailmessageDescAttachmentCount is the schema descriptor for attachment_count field.
+	emailmessageDescAttachmentCount := emailmessageFields[9].Descriptor()
+	// emailmessage.DefaultAttachmentCount holds the default value on creation for the attachment_count field.
+	emailmessage.DefaultAttachmentCount = emailmessageDescAttachmentCount.Default.(int)
+	// emailmessageDescCreatedAt is the schema descriptor for created_at field.
+	emailmessageDescCreatedAt := emailmessageFields[12].Descriptor()
+	// emailmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emailmessage.DefaultCreatedAt = emailmessageDescCreatedAt.Default.(func() time.Time)
+	// emailmessageDescUpdatedAt is the schema descriptor for updated_at field.
+	emailmessageDescUpdatedAt := emailmessageFields[13].Descriptor()
+	// emailmessage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	emailmessage.DefaultUpdatedAt = emailmessageDescUpdatedAt.Default.(func() time.Time)
+	// emailmessage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	emailmessage.UpdateDefaultUpdatedAt = emailmessageDescUpdatedAt.UpdateDefault.(func() time.Time)
 	emailsyncFields := schema.EmailSync{}.Fields()
 	_ = emailsyncFields
 	// emailsyncDescConnectionID is the schema descriptor for connection_id field.
@@ -114,15 +436,113 @@ func init() {
 	// emailsync.DefaultBytesTransferred holds the default value on creation for the bytes_transferred field.
 	emailsync.DefaultBytesTransferred = emailsyncDescBytesTransferred.Default.(int64)
 	// emailsyncDescCreatedAt is the schema descriptor for created_at field.
-	emailsyncDescCreatedAt := emailsyncFields[16].Descriptor()
+	emailsyncDescCreatedAt := emailsyncFields[18].Descriptor()
 	// emailsync.DefaultCreatedAt holds the default value on creation for the created_at field.
 	emailsync.DefaultCreatedAt = emailsyncDescCreatedAt.Default.(func() time.Time)
 	// emailsyncDescUpdatedAt is the schema descriptor for updated_at field.
-	emailsyncDescUpdatedAt := emailsyncFields[17].Descriptor()
+	emailsyncDescUpdatedAt := emailsyncFields[19].Descriptor()
 	// emailsync.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	emailsync.DefaultUpdatedAt = emailsyncDescUpdatedAt.Default.(func() time.Time)
 	// emailsync.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	emailsync.UpdateDefaultUpdatedAt = emailsyncDescUpdatedAt.UpdateDefault.(func() time.Time)
+	emailsyncfailureFields := schema.EmailSyncFailure{}.Fields()
+	_ = emailsyncfailureFields
+	// emailsyncfailureDescSyncID is the schema descriptor for sync_id field.
+	emailsyncfailureDescSyncID := emailsyncfailureFields[1].Descriptor()
+	// emailsyncfailure.SyncIDValidator is a validator for the "sync_id" field. It is called by the builders before save.
+	emailsyncfailure.SyncIDValidator = emailsyncfailureDescSyncID.Validators[0].(func(string) error)
+	// emailsyncfailureDescConnectionID is the schema descriptor for connection_id field.
+	emailsyncfailureDescConnectionID := emailsyncfailureFields[2].Descriptor()
+	// emailsyncfailure.ConnectionIDValidator is a validator for the "connection_id" field. It is called by the builders before save.
+	emailsyncfailure.ConnectionIDValidator = emailsyncfailureDescConnectionID.Validators[0].(func(string) error)
+	// emailsyncfailureDescMessageID is the schema descriptor for message_id field.
+	emailsyncfailureDescMessageID := emailsyncfailureFields[3].Descriptor()
+	// emailsyncfailure.MessageIDValidator is a validator for the "message_id" field. It is called by the builders before save.
+	emailsyncfailure.MessageIDValidator = emailsyncfailureDescMessageID.Validators[0].(func(string) error)
+	// emailsyncfailureDescAttempts is the schema descriptor for attempts field.
+	emailsyncfailureDescAttempts := emailsyncfailureFields[6].Descriptor()
+	// emailsyncfailure.DefaultAttempts holds the default value on creation for the attempts field.
+	emailsyncfailure.DefaultAttempts = emailsyncfailureDescAttempts.Default.(int)
+	// emailsyncfailureDescCreatedAt is the schema descriptor for created_at field.
+	emailsyncfailureDescCreatedAt := emailsyncfailureFields[8].Descriptor()
+	// emailsyncfailure.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emailsyncfailure.DefaultCreatedAt = emailsyncfailureDescCreatedAt.Default.(func() time.Time)
+	// emailsyncfailureDescUpdatedAt is the schema descriptor for updated_at field.
+	emailsyncfailureDescUpdatedAt := emailsyncfailureFields[9].Descriptor()
+	// emailsyncfailure.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	emailsyncfailure.DefaultUpdatedAt = emailsyncfailureDescUpdatedAt.Default.(func() time.Time)
+	// emailsyncfailure.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	emailsyncfailure.UpdateDefaultUpdatedAt = emailsyncfailureDescUpdatedAt.UpdateDefault.(func() time.Time)
+	emergencyfundsnapshotFields := schema.EmergencyFundSnapshot{}.Fields()
+	_ = emergencyfundsnapshotFields
+	// emergencyfundsnapshotDescUserID is the schema descriptor for user_id field.
+	emergencyfundsnapshotDescUserID := emergencyfundsnapshotFields[1].Descriptor()
+	// emergencyfundsnapshot.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	emergencyfundsnapshot.UserIDValidator = emergencyfundsnapshotDescUserID.Validators[0].(func(string) error)
+	// emergencyfundsnapshotDescBelowTarget is the schema descriptor for below_target field.
+	emergencyfundsnapshotDescBelowTarget := emergencyfundsnapshotFields[7].Descriptor()
+	// emergencyfundsnapshot.DefaultBelowTarget holds the default value on creation for the below_target field.
+	emergencyfundsnapshot.DefaultBelowTarget = emergencyfundsnapshotDescBelowTarget.Default.(bool)
+	// emergencyfundsnapshotDescCreatedAt is the schema descriptor for created_at field.
+	emergencyfundsnapshotDescCreatedAt := emergencyfundsnapshotFields[8].Descriptor()
+	// emergencyfundsnapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emergencyfundsnapshot.DefaultCreatedAt = emergencyfundsnapshotDescCreatedAt.Default.(func() time.Time)
+	// emergencyfundsnapshotDescUpdatedAt is the schema descriptor for updated_at field.
+	emergencyfundsnapshotDescUpdatedAt := emergencyfundsnapshotFields[9].Descriptor()
+	// emergencyfundsnapshot.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	emergencyfundsnapshot.DefaultUpdatedAt = emergencyfundsnapshotDescUpdatedAt.Default.(func() time.Time)
+	// emergencyfundsnapshot.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	emergencyfundsnapshot.UpdateDefaultUpdatedAt = emergencyfundsnapshotDescUpdatedAt.UpdateDefault.(func() time.Time)
+	emergencyfundtargetFields := schema.EmergencyFundTarget{}.Fields()
+	_ = emergencyfundtargetFields
+	// emergencyfundtargetDescUserID is the schema descriptor for user_id field.
+	emergencyfundtargetDescUserID := emergencyfundtargetFields[1].Descriptor()
+	// emergencyfundtarget.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	emergencyfundtarget.UserIDValidator = emergencyfundtargetDescUserID.Validators[0].(func(string) error)
+	// emergencyfundtargetDescTargetMonths is the schema descriptor for target_months field.
+	emergencyfundtargetDescTargetMonths := emergencyfundtargetFields[2].Descriptor()
+	// emergencyfundtarget.TargetMonthsValidator is a validator for the "target_months" field. It is called by the builders before save.
+	emergencyfundtarget.TargetMonthsValidator = emergencyfundtargetDescTargetMonths.Validators[0].(func(float64) error)
+	// emergencyfundtargetDescCreatedAt is the schema descriptor for created_at field.
+	emergencyfundtargetDescCreatedAt := emergencyfundtargetFields[3].Descriptor()
+	// emergencyfundtarget.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emergencyfundtarget.DefaultCreatedAt = emergencyfundtargetDescCreatedAt.Default.(func() time.Time)
+	// emergencyfundtargetDescUpdatedAt is the schema descriptor for updated_at field.
+	emergencyfundtargetDescUpdatedAt := emergencyfundtargetFields[4].Descriptor()
+	// emergencyfundtarget.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	emergencyfundtarget.DefaultUpdatedAt = emergencyfundtargetDescUpdatedAt.Default.(func() time.Time)
+	// emergencyfundtarget.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	emergencyfundtarget.UpdateDefaultUpdatedAt = emergencyfundtargetDescUpdatedAt.UpdateDefault.(func() time.Time)
+	goalFields := schema.Goal{}.Fields()
+	_ = goalFields
+	// goalDescUserID is the schema descriptor for user_id field.
+	goalDescUserID := goalFields[1].Descriptor()
+	// goal.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	goal.UserIDValidator = goalDescUserID.Validators[0].(func(string) error)
+	// goalDescName is the schema descriptor for name field.
+	goalDescName := goalFields[2].Descriptor()
+	// goal.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	goal.NameValidator = goalDescName.Validators[0].(func(string) error)
+	// goalDescTargetAmount is the schema descriptor for target_amount field.
+	goalDescTargetAmount := goalFields[4].Descriptor()
+	// goal.TargetAmountValidator is a validator for the "target_amount" field. It is called by the builders before save.
+	goal.TargetAmountValidator = goalDescTargetAmount.Validators[0].(func(float64) error)
+	// goalDescStartingAmount is the schema descriptor for starting_amount field.
+	goalDescStartingAmount := goalFields[5].Descriptor()
+	// goal.DefaultStartingAmount holds the default value on creation for the starting_amount field.
+	goal.DefaultStartingAmount = goalDescStartingAmount.Default.(float64)
+	// goal.StartingAmountValidator is a validator for the "starting_amount" field. It is called by the builders before save.
+	goal.StartingAmountValidator = goalDescStartingAmount.Validators[0].(func(float64) error)
+	// goalDescCreatedAt is the schema descriptor for created_at field.
+	goalDescCreatedAt := goalFields[9].Descriptor()
+	// goal.DefaultCreatedAt holds the default value on creation for the created_at field.
+	goal.DefaultCreatedAt = goalDescCreatedAt.Default.(func() time.Time)
+	// goalDescUpdatedAt is the schema descriptor for updated_at field.
+	goalDescUpdatedAt := goalFields[10].Descriptor()
+	// goal.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	goal.DefaultUpdatedAt = goalDescUpdatedAt.Default.(func() time.Time)
+	// goal.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	goal.UpdateDefaultUpdatedAt = goalDescUpdatedAt.UpdateDefault.(func() time.Time)
 	googledriveconnectionFields := schema.GoogleDriveConnection{}.Fields()
 	_ = googledriveconnectionFields
 	// googledriveconnectionDescUserID is the schema descriptor for user_id field.
@@ -130,19 +550,19 @@ func init() {
 	// googledriveconnection.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
 	googledriveconnection.UserIDValidator = googledriveconnectionDescUserID.Validators[0].(func(string) error)
 	// googledriveconnectionDescGoogleAccountID is the schema descriptor for google_account_id field.
-	googledriveconnectionDescGoogleAccountID := googledriveconnectionFields[2].Descriptor()
+	googledriveconnectionDescGoogleAccountID := googledriveconnectionFields[3].Descriptor()
 	// googledriveconnection.GoogleAccountIDValidator is a validator for the "google_account_id" field. It is called by the builders before save.
 	googledriveconnection.GoogleAccountIDValidator = googledriveconnectionDescGoogleAccountID.Validators[0].(func(string) error)
 	// googledriveconnectionDescEmail is the schema descriptor for email field.
-	googledriveconnectionDescEmail := googledriveconnectionFields[3].Descriptor()
+	googledriveconnectionDescEmail := googledriveconnectionFields[4].Descriptor()
 	// googledriveconnection.EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	googledriveconnection.EmailValidator = googledriveconnectionDescEmail.Validators[0].(func(string) error)
 	// googledriveconnectionDescCreatedAt is the schema descriptor for created_at field.
-	googledriveconnectionDescCreatedAt := googledriveconnectionFields[8].Descriptor()
+	googledriveconnectionDescCreatedAt := googledriveconnectionFields[10].Descriptor()
 	// googledriveconnection.DefaultCreatedAt holds the default value on creation for the created_at field.
 	googledriveconnection.DefaultCreatedAt = googledriveconnectionDescCreatedAt.Default.(func() time.Time)
 	// googledriveconnectionDescUpdatedAt is the schema descriptor for updated_at field.
-	googledriveconnectionDescUpdatedAt := googledriveconnectionFields[9].Descriptor()
+	googledriveconnectionDescUpdatedAt := googledriveconnectionFields[11].Descriptor()
 	// googledriveconnection.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	googledriveconnection.DefaultUpdatedAt = googledriveconnectionDescUpdatedAt.Default.(func() time.Time)
 	// googledriveconnection.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -169,20 +589,24 @@ func init() {
 	googledrivefolderDescSyncEnabled := googledrivefolderFields[7].Descriptor()
 	// googledrivefolder.DefaultSyncEnabled holds the default value on creation for the sync_enabled field.
 	googledrivefolder.DefaultSyncEnabled = googledrivefolderDescSyncEnabled.Default.(bool)
+	// googledrivefolderDescRecursive is the schema descriptor for recursive field.
+	googledrivefolderDescRecursive := googledrivefolderFields[8].Descriptor()
+	// googledrivefolder.DefaultRecursive holds the default value on creation for the recursive field.
+	googledrivefolder.DefaultRecursive = googledrivefolderDescRecursive.Default.(bool)
 	// googledrivefolderDescFileCount is the schema descriptor for file_count field.
-	googledrivefolderDescFileCount := googledrivefolderFields[9].Descriptor()
+	googledrivefolderDescFileCount := googledrivefolderFields[10].Descriptor()
 	// googledrivefolder.DefaultFileCount holds the default value on creation for the file_count field.
 	googledrivefolder.DefaultFileCount = googledrivefolderDescFileCount.Default.(int64)
 	// googledrivefolderDescTotalSizeBytes is the schema descriptor for total_size_bytes field.
-	googledrivefolderDescTotalSizeBytes := googledrivefolderFields[10].Descriptor()
+	googledrivefolderDescTotalSizeBytes := googledrivefolderFields[11].Descriptor()
 	// googledrivefolder.DefaultTotalSizeBytes holds the default value on creation for the total_size_bytes field.
 	googledrivefolder.DefaultTotalSizeBytes = googledrivefolderDescTotalSizeBytes.Default.(int64)
 	// googledrivefolderDescCreatedAt is the schema descriptor for created_at field.
-	googledrivefolderDescCreatedAt := googledrivefolderFields[11].Descriptor()
+	googledrivefolderDescCreatedAt := googledrivefolderFields[12].Descriptor()
 	// googledrivefolder.DefaultCreatedAt holds the default value on creation for the created_at field.
 	googledrivefolder.DefaultCreatedAt = googledrivefolderDescCreatedAt.Default.(func() time.Time)
 	// googledrivefolderDescUpdatedAt is the schema descriptor for updated_at field.
-	googledrivefolderDescUpdatedAt := googledrivefolderFields[12].Descriptor()
+	googledrivefolderDescUpdatedAt := googledrivefolderFields[13].Descriptor()
 	// googledrivefolder.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	googledrivefolder.DefaultUpdatedAt = googledrivefolderDescUpdatedAt.Default.(func() time.Time)
 	// googledrivefolder.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -227,6 +651,90 @@ func init() {
 	googledrivesync.DefaultUpdatedAt = googledrivesyncDescUpdatedAt.Default.(func() time.Time)
 	// googledrivesync.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	googledrivesync.UpdateDefaultUpdatedAt = googledrivesyncDescUpdatedAt.UpdateDefault.(func() time.Time)
+	householdmemberFields := schema.HouseholdMember{}.Fields()
+	_ = householdmemberFields
+	// householdmemberDescUserID is the schema descriptor for user_id field.
+	householdmemberDescUserID := householdmemberFields[1].Descriptor()
+	// householdmember.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	householdmember.UserIDValidator = householdmemberDescUserID.Validators[0].(func(string) error)
+	// householdmemberDescName is the schema descriptor for name field.
+	householdmemberDescName := householdmemberFields[2].Descriptor()
+	// householdmember.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	householdmember.NameValidator = householdmemberDescName.Validators[0].(func(string) error)
+	// householdmemberDescCreatedAt is the schema descriptor for created_at field.
+	householdmemberDescCreatedAt := householdmemberFields[4].Descriptor()
+	// householdmember.DefaultCreatedAt holds the default value on creation for the created_at field.
+	householdmember.DefaultCreatedAt = householdmemberDescCreatedAt.Default.(func() time.Time)
+	// householdmemberDescUpdatedAt is the schema descriptor for updated_at field.
+	householdmemberDescUpdatedAt := householdmemberFields[5].Descriptor()
+	// householdmember.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	householdmember.DefaultUpdatedAt = householdmemberDescUpdatedAt.Default.(func() time.Time)
+	// householdmember.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	householdmember.UpdateDefaultUpdatedAt = householdmemberDescUpdatedAt.UpdateDefault.(func() time.Time)
+	jobqueueFields := schema.JobQueue{}.Fields()
+	_ = jobqueueFields
+	// jobqueueDescPaused is the schema descriptor for paused field.
+	jobqueueDescPaused := jobqueueFields[1].Descriptor()
+	// jobqueue.DefaultPaused holds the default value on creation for the paused field.
+	jobqueue.DefaultPaused = jobqueueDescPaused.Default.(bool)
+	// jobqueueDescCreatedAt is the schema descriptor for created_at field.
+	jobqueueDescCreatedAt := jobqueueFields[2].Descriptor()
+	// jobqueue.DefaultCreatedAt holds the default value on creation for the created_at field.
+	jobqueue.DefaultCreatedAt = jobqueueDescCreatedAt.Default.(func() time.Time)
+	// jobqueueDescUpdatedAt is the schema descriptor for updated_at field.
+	jobqueueDescUpdatedAt := jobqueueFields[3].Descriptor()
+	// jobqueue.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	jobqueue.DefaultUpdatedAt = jobqueueDescUpdatedAt.Default.(func() time.Time)
+	// jobqueue.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	jobqueue.UpdateDefaultUpdatedAt = jobqueueDescUpdatedAt.UpdateDefault.(func() time.Time)
+	jobscheduleFields := schema.JobSchedule{}.Fields()
+	_ = jobscheduleFields
+	// jobscheduleDescUserID is the schema descriptor for user_id field.
+	jobscheduleDescUserID := jobscheduleFields[1].Descriptor()
+	// jobschedule.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	jobschedule.UserIDValidator = jobscheduleDescUserID.Validators[0].(func(string) error)
+	// jobscheduleDescName is the schema descriptor for name field.
+	jobscheduleDescName := jobscheduleFields[2].Descriptor()
+	// jobschedule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	jobschedule.NameValidator = jobscheduleDescName.Validators[0].(func(string) error)
+	// jobscheduleDescJobType is the schema descriptor for job_type field.
+	jobscheduleDescJobType := jobscheduleFields[3].Descriptor()
+	// jobschedule.JobTypeValidator is a validator for the "job_type" field. It is called by the builders before save.
+	jobschedule.JobTypeValidator = jobscheduleDescJobType.Validators[0].(func(string) error)
+	// jobscheduleDescEnabled is the schema descriptor for enabled field.
+	jobscheduleDescEnabled := jobscheduleFields[6].Descriptor()
+	// jobschedule.DefaultEnabled holds the default value on creation for the enabled field.
+	jobschedule.DefaultEnabled = jobscheduleDescEnabled.Default.(bool)
+	// jobscheduleDescConsecutiveFailures is the schema descriptor for consecutive_failures field.
+	jobscheduleDescConsecutiveFailures := jobscheduleFields[11].Descriptor()
+	// jobschedule.DefaultConsecutiveFailures holds the default value on creation for the consecutive_failures field.
+	jobschedule.DefaultConsecutiveFailures = jobscheduleDescConsecutiveFailures.Default.(int)
+	// jobschedule.ConsecutiveFailuresValidator is a validator for the "consecutive_failures" field. It is called by the builders before save.
+	jobschedule.ConsecutiveFailuresValidator = jobscheduleDescConsecutiveFailures.Validators[0].(func(int) error)
+	// jobscheduleDescCreatedAt is the schema descriptor for created_at field.
+	jobscheduleDescCreatedAt := jobscheduleFields[12].Descriptor()
+	// jobschedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	jobschedule.DefaultCreatedAt = jobscheduleDescCreatedAt.Default.(func() time.Time)
+	// jobscheduleDescUpdatedAt is the schema descriptor for updated_at field.
+	jobscheduleDescUpdatedAt := jobscheduleFields[13].Descriptor()
+	// jobschedule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	jobschedule.DefaultUpdatedAt = jobscheduleDescUpdatedAt.Default.(func() time.Time)
+	// jobschedule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	jobschedule.UpdateDefaultUpdatedAt = jobscheduleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	jobschedulerunFields := schema.JobScheduleRun{}.Fields()
+	_ = jobschedulerunFields
+	// jobschedulerunDescScheduleID is the schema descriptor for schedule_id field.
+	jobschedulerunDescScheduleID := jobschedulerunFields[1].Descriptor()
+	// jobschedulerun.ScheduleIDValidator is a validator for the "schedule_id" field. It is called by the builders before save.
+	jobschedulerun.ScheduleIDValidator = jobschedulerunDescScheduleID.Validators[0].(func(string) error)
+	// jobschedulerunDescUserID is the schema descriptor for user_id field.
+	jobschedulerunDescUserID := jobschedulerunFields[2].Descriptor()
+	// jobschedulerun.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	jobschedulerun.UserIDValidator = jobschedulerunDescUserID.Validators[0].(func(string) error)
+	// jobschedulerunDescStartedAt is the schema descriptor for started_at field.
+	jobschedulerunDescStartedAt := jobschedulerunFields[6].Descriptor()
+	// jobschedulerun.DefaultStartedAt holds the default value on creation for the started_at field.
+	jobschedulerun.DefaultStartedAt = jobschedulerunDescStartedAt.Default.(func() time.Time)
 	lineitemFields := schema.LineItem{}.Fields()
 	_ = lineitemFields
 	// lineitemDescReceiptID is the schema descriptor for receipt_id field.
@@ -258,15 +766,181 @@ func init() {
 	// lineitem.DefaultIsTaxable holds the default value on creation for the is_taxable field.
 	lineitem.DefaultIsTaxable = lineitemDescIsTaxable.Default.(bool)
 	// lineitemDescCreatedAt is the schema descriptor for created_at field.
-	lineitemDescCreatedAt := lineitemFields[19].Descriptor()
+	lineitemDescCreatedAt := lineitemFields[20].Descriptor()
 	// lineitem.DefaultCreatedAt holds the default value on creation for the created_at field.
 	lineitem.DefaultCreatedAt = lineitemDescCreatedAt.Default.(func() time.Time)
 	// lineitemDescUpdatedAt is the schema descriptor for updated_at field.
-	lineitemDescUpdatedAt := lineitemFields[20].Descriptor()
+	lineitemDescUpdatedAt := lineitemFields[21].Descriptor()
 	// lineitem.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	lineitem.DefaultUpdatedAt = lineitemDescUpdatedAt.Default.(func() time.Time)
 	// lineitem.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	lineitem.UpdateDefaultUpdatedAt = lineitemDescUpdatedAt.UpdateDefault.(func() time.Time)
+	liquidaccountFields := schema.LiquidAccount{}.Fields()
+	_ = liquidaccountFields
+	// liquidaccountDescUserID is the schema descriptor for user_id field.
+	liquidaccountDescUserID := liquidaccountFields[1].Descriptor()
+	// liquidaccount.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	liquidaccount.UserIDValidator = liquidaccountDescUserID.Validators[0].(func(string) error)
+	// liquidaccountDescName is the schema descriptor for name field.
+	liquidaccountDescName := liquidaccountFields[2].Descriptor()
+	// liquidaccount.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	liquidaccount.NameValidator = liquidaccountDescName.Validators[0].(func(string) error)
+	// liquidaccountDescBalance is the schema descriptor for balance field.
+	liquidaccountDescBalance := liquidaccountFields[4].Descriptor()
+	// liquidaccount.BalanceValidator is a validator for the "balance" field. It is called by the builders before save.
+	liquidaccount.BalanceValidator = liquidaccountDescBalance.Validators[0].(func(float64) error)
+	// liquidaccountDescEmergencyFund is the schema descriptor for emergency_fund field.
+	liquidaccountDescEmergencyFund := liquidaccountFields[5].Descriptor()
+	// liquidaccount.DefaultEmergencyFund holds the default value on creation for the emergency_fund field.
+	liquidaccount.DefaultEmergencyFund = liquidaccountDescEmergencyFund.Default.(bool)
+	// liquidaccountDescCreatedAt is the schema descriptor for created_at field.
+	liquidaccountDescCreatedAt := liquidaccountFields[6].Descriptor()
+	// liquidaccount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	liquidaccount.DefaultCreatedAt = liquidaccountDescCreatedAt.Default.(func() time.Time)
+	// liquidaccountDescUpdatedAt is the schema descriptor for updated_at field.
+	liquidaccountDescUpdatedAt := liquidaccountFields[7].Descriptor()
+	// liquidaccount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	liquidaccount.DefaultUpdatedAt = liquidaccountDescUpdatedAt.Default.(func() time.Time)
+	// liquidaccount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	liquidaccount.UpdateDefaultUpdatedAt = liquidaccountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	membershipFields := schema.Membership{}.Fields()
+	_ = membershipFields
+	// membershipDescOrganizationID is the schema descriptor for organization_id field.
+	membershipDescOrganizationID := membershipFields[1].Descriptor()
+	// membership.OrganizationIDValidator is a validator for the "organization_id" field. It is called by the builders before save.
+	membership.OrganizationIDValidator = membershipDescOrganizationID.Validators[0].(func(string) error)
+	// membershipDescUserID is the schema descriptor for user_id field.
+	membershipDescUserID := membershipFields[2].Descriptor()
+	// membership.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	membership.UserIDValidator = membershipDescUserID.Validators[0].(func(string) error)
+	// membershipDescCreatedAt is the schema descriptor for created_at field.
+	membershipDescCreatedAt := membershipFields[5].Descriptor()
+	// membership.DefaultCreatedAt holds the default value on creation for the created_at field.
+	membership.DefaultCreatedAt = membershipDescCreatedAt.Default.(func() time.Time)
+	// membershipDescUpdatedAt is the schema descriptor for updated_at field.
+	membershipDescUpdatedAt := membershipFields[6].Descriptor()
+	// membership.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	membership.DefaultUpdatedAt = membershipDescUpdatedAt.Default.(func() time.Time)
+	// membership.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	membership.UpdateDefaultUpdatedAt = membershipDescUpdatedAt.UpdateDefault.(func() time.Time)
+	merchantFields := schema.Merchant{}.Fields()
+	_ = merchantFields
+	// merchantDescName is the schema descriptor for name field.
+	merchantDescName := merchantFields[1].Descriptor()
+	// merchant.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	merchant.NameValidator = merchantDescName.Validators[0].(func(string) error)
+	// merchantDescNormalizedKey is the schema descriptor for normalized_key field.
+	merchantDescNormalizedKey := merchantFields[2].Descriptor()
+	// merchant.NormalizedKeyValidator is a validator for the "normalized_key" field. It is called by the builders before save.
+	merchant.NormalizedKeyValidator = merchantDescNormalizedKey.Validators[0].(func(string) error)
+	// merchantDescCreatedAt is the schema descriptor for created_at field.
+	merchantDescCreatedAt := merchantFields[7].Descriptor()
+	// merchant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	merchant.DefaultCreatedAt = merchantDescCreatedAt.Default.(func() time.Time)
+	// merchantDescUpdatedAt is the schema descriptor for updated_at field.
+	merchantDescUpdatedAt := merchantFields[8].Descriptor()
+	// merchant.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	merchant.DefaultUpdatedAt = merchantDescUpdatedAt.Default.(func() time.Time)
+	// merchant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	merchant.UpdateDefaultUpdatedAt = merchantDescUpdatedAt.UpdateDefault.(func() time.Time)
+	migrationstateFields := schema.MigrationState{}.Fields()
+	_ = migrationstateFields
+	// migrationstateDescKey is the schema descriptor for key field.
+	migrationstateDescKey := migrationstateFields[2].Descriptor()
+	// migrationstate.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	migrationstate.KeyValidator = migrationstateDescKey.Validators[0].(func(string) error)
+	// migrationstateDescValue is the schema descriptor for value field.
+	migrationstateDescValue := migrationstateFields[3].Descriptor()
+	// migrationstate.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	migrationstate.ValueValidator = migrationstateDescValue.Validators[0].(func(string) error)
+	// migrationstateDescCreatedAt is the schema descriptor for created_at field.
+	migrationstateDescCreatedAt := migrationstateFields[5].Descriptor()
+	// migrationstate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	migrationstate.DefaultCreatedAt = migrationstateDescCreatedAt.Default.(func() time.Time)
+	// migrationstateDescUpdatedAt is the schema descriptor for updated_at field.
+	migrationstateDescUpdatedAt := migrationstateFields[6].Descriptor()
+	// migrationstate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	migrationstate.DefaultUpdatedAt = migrationstateDescUpdatedAt.Default.(func() time.Time)
+	// migrationstate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	migrationstate.UpdateDefaultUpdatedAt = migrationstateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescUserID is the schema descriptor for user_id field.
+	notificationDescUserID := notificationFields[1].Descriptor()
+	// notification.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	notification.UserIDValidator = notificationDescUserID.Validators[0].(func(string) error)
+	// notificationDescDedupeKey is the schema descriptor for dedupe_key field.
+	notificationDescDedupeKey := notificationFields[3].Descriptor()
+	// notification.DedupeKeyValidator is a validator for the "dedupe_key" field. It is called by the builders before save.
+	notification.DedupeKeyValidator = notificationDescDedupeKey.Validators[0].(func(string) error)
+	// notificationDescRecipient is the schema descriptor for recipient field.
+	notificationDescRecipient := notificationFields[4].Descriptor()
+	// notification.RecipientValidator is a validator for the "recipient" field. It is called by the builders before save.
+	notification.RecipientValidator = notificationDescRecipient.Validators[0].(func(string) error)
+	// notificationDescSubject is the schema descriptor for subject field.
+	notificationDescSubject := notificationFields[5].Descriptor()
+	// notification.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	notification.SubjectValidator = notificationDescSubject.Validators[0].(func(string) error)
+	// notificationDescAttempts is the schema descriptor for attempts field.
+	notificationDescAttempts := notificationFields[7].Descriptor()
+	// notification.DefaultAttempts holds the default value on creation for the attempts field.
+	notification.DefaultAttempts = notificationDescAttempts.Default.(int)
+	// notification.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	notification.AttemptsValidator = notificationDescAttempts.Validators[0].(func(int) error)
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationFields[10].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	// notificationDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationDescUpdatedAt := notificationFields[11].Descriptor()
+	// notification.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notification.DefaultUpdatedAt = notificationDescUpdatedAt.Default.(func() time.Time)
+	// notification.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notification.UpdateDefaultUpdatedAt = notificationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	oauthstateFields := schema.OAuthState{}.Fields()
+	_ = oauthstateFields
+	// oauthstateDescCreatedAt is the schema descriptor for created_at field.
+	oauthstateDescCreatedAt := oauthstateFields[3].Descriptor()
+	// oauthstate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthstate.DefaultCreatedAt = oauthstateDescCreatedAt.Default.(func() time.Time)
+	ocrfeedbackFields := schema.OCRFeedback{}.Fields()
+	_ = ocrfeedbackFields
+	// ocrfeedbackDescReceiptID is the schema descriptor for receipt_id field.
+	ocrfeedbackDescReceiptID := ocrfeedbackFields[1].Descriptor()
+	// ocrfeedback.ReceiptIDValidator is a validator for the "receipt_id" field. It is called by the builders before save.
+	ocrfeedback.ReceiptIDValidator = ocrfeedbackDescReceiptID.Validators[0].(func(string) error)
+	// ocrfeedbackDescUserID is the schema descriptor for user_id field.
+	ocrfeedbackDescUserID := ocrfeedbackFields[2].Descriptor()
+	// ocrfeedback.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	ocrfeedback.UserIDValidator = ocrfeedbackDescUserID.Validators[0].(func(string) error)
+	// ocrfeedbackDescField is the schema descriptor for field field.
+	ocrfeedbackDescField := ocrfeedbackFields[3].Descriptor()
+	// ocrfeedback.FieldValidator is a validator for the "field" field. It is called by the builders before save.
+	ocrfeedback.FieldValidator = ocrfeedbackDescField.Validators[0].(func(string) error)
+	// ocrfeedbackDescCreatedAt is the schema descriptor for created_at field.
+	ocrfeedbackDescCreatedAt := ocrfeedbackFields[8].Descriptor()
+	// ocrfeedback.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ocrfeedback.DefaultCreatedAt = ocrfeedbackDescCreatedAt.Default.(func() time.Time)
+	organizationFields := schema.Organization{}.Fields()
+	_ = organizationFields
+	// organizationDescName is the schema descriptor for name field.
+	organizationDescName := organizationFields[1].Descriptor()
+	// organization.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	organization.NameValidator = organizationDescName.Validators[0].(func(string) error)
+	// organizationDescCreatedBy is the schema descriptor for created_by field.
+	organizationDescCreatedBy := organizationFields[2].Descriptor()
+	// organization.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	organization.CreatedByValidator = organizationDescCreatedBy.Validators[0].(func(string) error)
+	// organizationDescCreatedAt is the schema descriptor for created_at field.
+	organizationDescCreatedAt := organizationFields[3].Descriptor()
+	// organization.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organization.DefaultCreatedAt = organizationDescCreatedAt.Default.(func() time.Time)
+	// organizationDescUpdatedAt is the schema descriptor for updated_at field.
+	organizationDescUpdatedAt := organizationFields[4].Descriptor()
+	// organization.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	organization.DefaultUpdatedAt = organizationDescUpdatedAt.Default.(func() time.Time)
+	// organization.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	organization.UpdateDefaultUpdatedAt = organizationDescUpdatedAt.UpdateDefault.(func() time.Time)
 	pipelineconfigFields := schema.PipelineConfig{}.Fields()
 	_ = pipelineconfigFields
 	// pipelineconfigDescUserID is the schema descriptor for user_id field.
@@ -371,6 +1045,46 @@ func init() {
 	pipelineversion.DefaultUpdatedAt = pipelineversionDescUpdatedAt.Default.(func() time.Time)
 	// pipelineversion.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	pipelineversion.UpdateDefaultUpdatedAt = pipelineversionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	queuedjobFields := schema.QueuedJob{}.Fields()
+	_ = queuedjobFields
+	// queuedjobDescQueue is the schema descriptor for queue field.
+	queuedjobDescQueue := queuedjobFields[1].Descriptor()
+	// queuedjob.QueueValidator is a validator for the "queue" field. It is called by the builders before save.
+	queuedjob.QueueValidator = queuedjobDescQueue.Validators[0].(func(string) error)
+	// queuedjobDescType is the schema descriptor for type field.
+	queuedjobDescType := queuedjobFields[2].Descriptor()
+	// queuedjob.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	queuedjob.TypeValidator = queuedjobDescType.Validators[0].(func(string) error)
+	// queuedjobDescPriority is the schema descriptor for priority field.
+	queuedjobDescPriority := queuedjobFields[5].Descriptor()
+	// queuedjob.DefaultPriority holds the default value on creation for the priority field.
+	queuedjob.DefaultPriority = queuedjobDescPriority.Default.(int)
+	// queuedjobDescProgress is the schema descriptor for progress field.
+	queuedjobDescProgress := queuedjobFields[8].Descriptor()
+	// queuedjob.DefaultProgress holds the default value on creation for the progress field.
+	queuedjob.DefaultProgress = queuedjobDescProgress.Default.(float64)
+	// queuedjobDescRetryCount is the schema descriptor for retry_count field.
+	queuedjobDescRetryCount := queuedjobFields[10].Descriptor()
+	// queuedjob.DefaultRetryCount holds the default value on creation for the retry_count field.
+	queuedjob.DefaultRetryCount = queuedjobDescRetryCount.Default.(int)
+	// queuedjobDescMaxRetries is the schema descriptor for max_retries field.
+	queuedjobDescMaxRetries := queuedjobFields[11].Descriptor()
+	// queuedjob.DefaultMaxRetries holds the default value on creation for the max_retries field.
+	queuedjob.DefaultMaxRetries = queuedjobDescMaxRetries.Default.(int)
+	// queuedjobDescRunAt is the schema descriptor for run_at field.
+	queuedjobDescRunAt := queuedjobFields[12].Descriptor()
+	// queuedjob.DefaultRunAt holds the default value on creation for the run_at field.
+	queuedjob.DefaultRunAt = queuedjobDescRunAt.Default.(func() time.Time)
+	// queuedjobDescCreatedAt is the schema descriptor for created_at field.
+	queuedjobDescCreatedAt := queuedjobFields[18].Descriptor()
+	// queuedjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	queuedjob.DefaultCreatedAt = queuedjobDescCreatedAt.Default.(func() time.Time)
+	// queuedjobDescUpdatedAt is the schema descriptor for updated_at field.
+	queuedjobDescUpdatedAt := queuedjobFields[19].Descriptor()
+	// queuedjob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	queuedjob.DefaultUpdatedAt = queuedjobDescUpdatedAt.Default.(func() time.Time)
+	// queuedjob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	queuedjob.UpdateDefaultUpdatedAt = queuedjobDescUpdatedAt.UpdateDefault.(func() time.Time)
 	receiptFields := schema.Receipt{}.Fields()
 	_ = receiptFields
 	// receiptDescUserID is the schema descriptor for user_id field.
@@ -398,41 +1112,207 @@ func init() {
 	// receipt.DefaultCurrency holds the default value on creation for the currency field.
 	receipt.DefaultCurrency = receiptDescCurrency.Default.(string)
 	// receiptDescCreatedAt is the schema descriptor for created_at field.
-	receiptDescCreatedAt := receiptFields[30].Descriptor()
+	receiptDescCreatedAt := receiptFields[31].Descriptor()
 	// receipt.DefaultCreatedAt holds the default value on creation for the created_at field.
 	receipt.DefaultCreatedAt = receiptDescCreatedAt.Default.(func() time.Time)
 	// receiptDescUpdatedAt is the schema descriptor for updated_at field.
-	receiptDescUpdatedAt := receiptFields[31].Descriptor()
+	receiptDescUpdatedAt := receiptFields[32].Descriptor()
 	// receipt.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	receipt.DefaultUpdatedAt = receiptDescUpdatedAt.Default.(func() time.Time)
 	// receipt.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	receipt.UpdateDefaultUpdatedAt = receiptDescUpdatedAt.UpdateDefault.(func() time.Time)
+	receipteventFields := schema.ReceiptEvent{}.Fields()
+	_ = receipteventFields
+	// receipteventDescReceiptID is the schema descriptor for receipt_id field.
+	receipteventDescReceiptID := receipteventFields[1].Descriptor()
+	// receiptevent.ReceiptIDValidator is a validator for the "receipt_id" field. It is called by the builders before save.
+	receiptevent.ReceiptIDValidator = receipteventDescReceiptID.Validators[0].(func(string) error)
+	// receipteventDescUserID is the schema descriptor for user_id field.
+	receipteventDescUserID := receipteventFields[2].Descriptor()
+	// receiptevent.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	receiptevent.UserIDValidator = receipteventDescUserID.Validators[0].(func(string) error)
+	// receipteventDescCreatedAt is the schema descriptor for created_at field.
+	receipteventDescCreatedAt := receipteventFields[9].Descriptor()
+	// receiptevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	receiptevent.DefaultCreatedAt = receipteventDescCreatedAt.Default.(func() time.Time)
+	roundingruleFields := schema.RoundingRule{}.Fields()
+	_ = roundingruleFields
+	// roundingruleDescUserID is the schema descriptor for user_id field.
+	roundingruleDescUserID := roundingruleFields[1].Descriptor()
+	// roundingrule.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	roundingrule.UserIDValidator = roundingruleDescUserID.Validators[0].(func(string) error)
+	// roundingruleDescIncrement is the schema descriptor for increment field.
+	roundingruleDescIncrement := roundingruleFields[2].Descriptor()
+	// roundingrule.IncrementValidator is a validator for the "increment" field. It is called by the builders before save.
+	roundingrule.IncrementValidator = roundingruleDescIncrement.Validators[0].(func(float64) error)
+	// roundingruleDescMultiplier is the schema descriptor for multiplier field.
+	roundingruleDescMultiplier := roundingruleFields[3].Descriptor()
+	// roundingrule.DefaultMultiplier holds the default value on creation for the multiplier field.
+	roundingrule.DefaultMultiplier = roundingruleDescMultiplier.Default.(float64)
+	// roundingrule.MultiplierValidator is a validator for the "multiplier" field. It is called by the builders before save.
+	roundingrule.MultiplierValidator = roundingruleDescMultiplier.Validators[0].(func(float64) error)
+	// roundingruleDescEnabled is the schema descriptor for enabled field.
+	roundingruleDescEnabled := roundingruleFields[6].Descriptor()
+	// roundingrule.DefaultEnabled holds the default value on creation for the enabled field.
+	roundingrule.DefaultEnabled = roundingruleDescEnabled.Default.(bool)
+	// roundingruleDescCreatedAt is the schema descriptor for created_at field.
+	roundingruleDescCreatedAt := roundingruleFields[7].Descriptor()
+	// roundingrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	roundingrule.DefaultCreatedAt = roundingruleDescCreatedAt.Default.(func() time.Time)
+	// roundingruleDescUpdatedAt is the schema descriptor for updated_at field.
+	roundingruleDescUpdatedAt := roundingruleFields[8].Descriptor()
+	// roundingrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	roundingrule.DefaultUpdatedAt = roundingruleDescUpdatedAt.Default.(func() time.Time)
+	// roundingrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	roundingrule.UpdateDefaultUpdatedAt = roundingruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	savedfilterFields := schema.SavedFilter{}.Fields()
+	_ = savedfilterFields
+	// savedfilterDescUserID is the schema descriptor for user_id field.
+	savedfilterDescUserID := savedfilterFields[1].Descriptor()
+	// savedfilter.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	savedfilter.UserIDValidator = savedfilterDescUserID.Validators[0].(func(string) error)
+	// savedfilterDescName is the schema descriptor for name field.
+	savedfilterDescName := savedfilterFields[2].Descriptor()
+	// savedfilter.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	savedfilter.NameValidator = savedfilterDescName.Validators[0].(func(string) error)
+	// savedfilterDescCreatedAt is the schema descriptor for created_at field.
+	savedfilterDescCreatedAt := savedfilterFields[4].Descriptor()
+	// savedfilter.DefaultCreatedAt holds the default value on creation for the created_at field.
+	savedfilter.DefaultCreatedAt = savedfilterDescCreatedAt.Default.(func() time.Time)
+	// savedfilterDescUpdatedAt is the schema descriptor for updated_at field.
+	savedfilterDescUpdatedAt := savedfilterFields[5].Descriptor()
+	// savedfilter.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	savedfilter.DefaultUpdatedAt = savedfilterDescUpdatedAt.Default.(func() time.Time)
+	// savedfilter.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	savedfilter.UpdateDefaultUpdatedAt = savedfilterDescUpdatedAt.UpdateDefault.(func() time.Time)
+	taxyearFields := schema.TaxYear{}.Fields()
+	_ = taxyearFields
+	// taxyearDescData is the schema descriptor for data field.
+	taxyearDescData := taxyearFields[1].Descriptor()
+	// taxyear.DataValidator is a validator for the "data" field. It is called by the builders before save.
+	taxyear.DataValidator = taxyearDescData.Validators[0].(func([]byte) error)
+	// taxyearDescCreatedAt is the schema descriptor for created_at field.
+	taxyearDescCreatedAt := taxyearFields[2].Descriptor()
+	// taxyear.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taxyear.DefaultCreatedAt = taxyearDescCreatedAt.Default.(func() time.Time)
+	// taxyearDescUpdatedAt is the schema descriptor for updated_at field.
+	taxyearDescUpdatedAt := taxyearFields[3].Descriptor()
+	// taxyear.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	taxyear.DefaultUpdatedAt = taxyearDescUpdatedAt.Default.(func() time.Time)
+	// taxyear.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	taxyear.UpdateDefaultUpdatedAt = taxyearDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// taxyearDescID is the schema descriptor for id field.
+	taxyearDescID := taxyearFields[0].Descriptor()
+	// taxyear.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	taxyear.IDValidator = taxyearDescID.Validators[0].(func(int) error)
 	transactionFields := schema.Transaction{}.Fields()
 	_ = transactionFields
-	// transactionDescReceiptID is the schema descriptor for receipt_id field.
-	transactionDescReceiptID := transactionFields[1].Descriptor()
-	// transaction.ReceiptIDValidator is a validator for the "receipt_id" field. It is called by the builders before save.
-	transaction.ReceiptIDValidator = transactionDescReceiptID.Validators[0].(func(string) error)
 	// transactionDescUserID is the schema descriptor for user_id field.
 	transactionDescUserID := transactionFields[2].Descriptor()
 	// transaction.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
 	transaction.UserIDValidator = transactionDescUserID.Validators[0].(func(string) error)
 	// transactionDescCurrency is the schema descriptor for currency field.
-	transactionDescCurrency := transactionFields[5].Descriptor()
+	transactionDescCurrency := transactionFields[6].Descriptor()
 	// transaction.DefaultCurrency holds the default value on creation for the currency field.
 	transaction.DefaultCurrency = transactionDescCurrency.Default.(string)
+	// transactionDescRoundUpAmount is the schema descriptor for round_up_amount field.
+	transactionDescRoundUpAmount := transactionFields[16].Descriptor()
+	// transaction.DefaultRoundUpAmount holds the default value on creation for the round_up_amount field.
+	transaction.DefaultRoundUpAmount = transactionDescRoundUpAmount.Default.(float64)
 	// transactionDescIsRecurring is the schema descriptor for is_recurring field.
-	transactionDescIsRecurring := transactionFields[15].Descriptor()
+	transactionDescIsRecurring := transactionFields[17].Descriptor()
 	// transaction.DefaultIsRecurring holds the default value on creation for the is_recurring field.
 	transaction.DefaultIsRecurring = transactionDescIsRecurring.Default.(bool)
 	// transactionDescCreatedAt is the schema descriptor for created_at field.
-	transactionDescCreatedAt := transactionFields[21].Descriptor()
+	transactionDescCreatedAt := transactionFields[26].Descriptor()
 	// transaction.DefaultCreatedAt holds the default value on creation for the created_at field.
 	transaction.DefaultCreatedAt = transactionDescCreatedAt.Default.(func() time.Time)
 	// transactionDescUpdatedAt is the schema descriptor for updated_at field.
-	transactionDescUpdatedAt := transactionFields[22].Descriptor()
+	transactionDescUpdatedAt := transactionFields[27].Descriptor()
 	// transaction.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	transaction.DefaultUpdatedAt = transactionDescUpdatedAt.Default.(func() time.Time)
 	// transaction.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	transaction.UpdateDefaultUpdatedAt = transactionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescEmailVerified is the schema descriptor for email_verified field.
+	userDescEmailVerified := userFields[2].Descriptor()
+	// user.DefaultEmailVerified holds the default value on creation for the email_verified field.
+	user.DefaultEmailVerified = userDescEmailVerified.Default.(bool)
+	// userDescName is the schema descriptor for name field.
+	userDescName := userFields[6].Descriptor()
+	// user.DefaultName holds the default value on creation for the name field.
+	user.DefaultName = userDescName.Default.(string)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[11].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[12].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescEndpointID is the schema descriptor for endpoint_id field.
+	webhookdeliveryDescEndpointID := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.EndpointIDValidator is a validator for the "endpoint_id" field. It is called by the builders before save.
+	webhookdelivery.EndpointIDValidator = webhookdeliveryDescEndpointID.Validators[0].(func(string) error)
+	// webhookdeliveryDescUserID is the schema descriptor for user_id field.
+	webhookdeliveryDescUserID := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	webhookdelivery.UserIDValidator = webhookdeliveryDescUserID.Validators[0].(func(string) error)
+	// webhookdeliveryDescEvent is the schema descriptor for event field.
+	webhookdeliveryDescEvent := webhookdeliveryFields[3].Descriptor()
+	// webhookdelivery.EventValidator is a validator for the "event" field. It is called by the builders before save.
+	webhookdelivery.EventValidator = webhookdeliveryDescEvent.Validators[0].(func(string) error)
+	// webhookdeliveryDescEventID is the schema descriptor for event_id field.
+	webhookdeliveryDescEventID := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	webhookdelivery.EventIDValidator = webhookdeliveryDescEventID.Validators[0].(func(string) error)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdelivery.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	webhookdelivery.AttemptsValidator = webhookdeliveryDescAttempts.Validators[0].(func(int) error)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[13].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryFields[14].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webhookendpointFields := schema.WebhookEndpoint{}.Fields()
+	_ = webhookendpointFields
+	// webhookendpointDescUserID is the schema descriptor for user_id field.
+	webhookendpointDescUserID := webhookendpointFields[1].Descriptor()
+	// webhookendpoint.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	webhookendpoint.UserIDValidator = webhookendpointDescUserID.Validators[0].(func(string) error)
+	// webhookendpointDescURL is the schema descriptor for url field.
+	webhookendpointDescURL := webhookendpointFields[2].Descriptor()
+	// webhookendpoint.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhookendpoint.URLValidator = webhookendpointDescURL.Validators[0].(func(string) error)
+	// webhookendpointDescSecret is the schema descriptor for secret field.
+	webhookendpointDescSecret := webhookendpointFields[3].Descriptor()
+	// webhookendpoint.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhookendpoint.SecretValidator = webhookendpointDescSecret.Validators[0].(func(string) error)
+	// webhookendpointDescEnabled is the schema descriptor for enabled field.
+	webhookendpointDescEnabled := webhookendpointFields[5].Descriptor()
+	// webhookendpoint.DefaultEnabled holds the default value on creation for the enabled field.
+	webhookendpoint.DefaultEnabled = webhookendpointDescEnabled.Default.(bool)
+	// webhookendpointDescCreatedAt is the schema descriptor for created_at field.
+	webhookendpointDescCreatedAt := webhookendpointFields[7].Descriptor()
+	// webhookendpoint.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookendpoint.DefaultCreatedAt = webhookendpointDescCreatedAt.Default.(func() time.Time)
+	// webhookendpointDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookendpointDescUpdatedAt := webhookendpointFields[8].Descriptor()
+	// webhookendpoint.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookendpoint.DefaultUpdatedAt = webhookendpointDescUpdatedAt.Default.(func() time.Time)
+	// webhookendpoint.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookendpoint.UpdateDefaultUpdatedAt = webhookendpointDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AccountDeletion holds the schema definition for the AccountDeletion entity.
+type AccountDeletion struct {
+	ent.Schema
+}
+
+// Fields of the AccountDeletion.
+func (AccountDeletion) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable().
+			Comment("ID of the deleted user; kept after the user is gone, so not a foreign key"),
+		field.String("requested_by").
+			NotEmpty().
+			Immutable().
+			Comment("ID of the user who asked for the deletion, the user themselves or an admin"),
+		field.JSON("rows_deleted", map[string]int{}).
+			Optional().
+			Immutable().
+			Comment("Rows deleted per table, e.g. {\"receipts\": 12}"),
+		field.Int("tokens_revoked").
+			Default(0).
+			Immutable().
+			Comment("OAuth tokens of the user's connections revoked with Google"),
+		field.Int("tokens_failed").
+			Default(0).
+			Immutable().
+			Comment("OAuth tokens Google could not revoke; they lapse when they expire"),
+		field.Int("blobs_purged").
+			Default(0).
+			Immutable().
+			Comment("Attachment contents no other user's messages referenced, deleted with the user"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the AccountDeletion.
+func (AccountDeletion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
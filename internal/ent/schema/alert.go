@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Alert holds the schema definition for the Alert entity: something the
+// alert engine found worth telling a user about, such as a spending anomaly
+// or a budget overrun, and whether it has been delivered.
+type Alert struct {
+	ent.Schema
+}
+
+// Fields of the Alert.
+func (Alert) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable().
+			Comment("ID of the user the alert is for"),
+		field.Enum("kind").
+			Values("anomaly", "budget_overrun").
+			Immutable(),
+		field.Enum("severity").
+			Values("low", "medium", "high").
+			Immutable(),
+		field.String("title").
+			NotEmpty().
+			Immutable(),
+		field.Text("message").
+			Immutable(),
+		field.String("dedupe_key").
+			NotEmpty().
+			Immutable().
+			Comment("Identifies what the alert is about, so it is raised once, e.g. anomaly:<anomaly id> or budget:<category>:<month>:<threshold>"),
+		field.JSON("data", map[string]interface{}{}).
+			Optional().
+			Immutable().
+			Comment("Details of what raised the alert"),
+		field.Enum("delivery_status").
+			Values("pending", "delivered", "skipped").
+			Default("pending").
+			Comment("Delivery to the user's channels; skipped when none is set up or the alert is below the user's minimum severity"),
+		field.String("delivery_error").
+			Optional().
+			Nillable().
+			Comment("Why the last delivery attempt failed; it is retried on the next run"),
+		field.Time("delivered_at").
+			Optional().
+			Nillable(),
+		field.Time("read_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the Alert.
+func (Alert) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "dedupe_key").
+			Unique(),
+		index.Fields("user_id", "created_at"),
+		index.Fields("delivery_status"),
+	}
+}
@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// AlertPreference holds the schema definition for the AlertPreference
+// entity: what a user wants to be alerted about, and how.
+type AlertPreference struct {
+	ent.Schema
+}
+
+// Fields of the AlertPreference.
+func (AlertPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Unique().
+			Comment("ID of the user the preferences belong to"),
+		field.Bool("anomaly_alerts").
+			Default(true).
+			Comment("Whether spending anomalies raise alerts"),
+		field.Bool("budget_alerts").
+			Default(true).
+			Comment("Whether budget overruns raise alerts"),
+		field.JSON("budget_limits", map[string]float64{}).
+			Optional().
+			Comment("Monthly spending limit per spending category, e.g. {\"dining\": 300}"),
+		field.Float("budget_threshold_percent").
+			Default(100).
+			Positive().
+			Comment("Share of a limit spent that raises a warning before the overrun itself, e.g. 80"),
+		field.Enum("min_severity").
+			Values("low", "medium", "high").
+			Default("medium").
+			Comment("Least severe alert delivered; less severe ones are only listed"),
+		field.String("email").
+			Optional().
+			Nillable().
+			Comment("Address alerts are emailed to"),
+		field.String("webhook_url").
+			Optional().
+			Nillable().
+			Comment("URL alerts are posted to as JSON"),
+		field.String("push_token").
+			Optional().
+			Nillable().
+			Comment("Device token alerts are pushed to"),
+		field.Enum("digest").
+			Values("immediate", "hourly", "daily").
+			Default("daily").
+			Comment("Whether alerts are delivered as they are raised, or batched into a digest"),
+		field.Bool("weekly_summary").
+			Default(true).
+			Comment("Whether a summary of the week's syncs, receipts and spending is emailed every week"),
+		field.Bool("monthly_report").
+			Default(true).
+			Comment("Whether the monthly spending report is emailed at the end of each month"),
+		field.Time("last_digest_at").
+			Optional().
+			Nillable().
+			Comment("When the last digest was delivered"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
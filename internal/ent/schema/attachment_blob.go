@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// AttachmentBlob holds the schema definition for the AttachmentBlob entity.
+// A blob is the stored content of an attachment, kept once however many
+// messages it appears in.
+type AttachmentBlob struct {
+	ent.Schema
+}
+
+// Fields of the AttachmentBlob.
+func (AttachmentBlob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("sha256").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("Hex SHA-256 of the attachment content"),
+		field.Int64("size").
+			NonNegative().
+			Immutable().
+			Comment("Size of the content in bytes"),
+		field.String("mime_type").
+			Optional().
+			Comment("MIME type of the attachment the content was first stored from"),
+		field.String("storage_key").
+			Optional().
+			Comment("Key of the content in the blob store; empty when no store is configured"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the AttachmentBlob.
+func (AttachmentBlob) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("links", AttachmentLink.Type).
+			Comment("Message attachments with this content"),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AttachmentLink holds the schema definition for the AttachmentLink entity.
+// A link records that a message's attachment has the content of a blob.
+type AttachmentLink struct {
+	ent.Schema
+}
+
+// Fields of the AttachmentLink.
+func (AttachmentLink) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("blob_id").
+			NotEmpty().
+			Comment("ID of the AttachmentBlob holding the content"),
+		field.String("connection_id").
+			NotEmpty().
+			Comment("ID of the EmailConnection the message belongs to"),
+		field.String("message_id").
+			NotEmpty().
+			Comment("Message ID from the email provider"),
+		field.String("attachment_id").
+			Comment("Attachment ID from the email provider"),
+		field.String("filename").
+			Optional().
+			Comment("File name of the attachment in this message"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the AttachmentLink.
+func (AttachmentLink) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("blob", AttachmentBlob.Type).
+			Ref("links").
+			Field("blob_id").
+			Required().
+			Unique().
+			Comment("The blob holding the attachment's content"),
+	}
+}
+
+// Indexes of the AttachmentLink.
+func (AttachmentLink) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("connection_id", "message_id", "blob_id").
+			Unique(),
+		index.Fields("blob_id"),
+	}
+}
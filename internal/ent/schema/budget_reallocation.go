@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BudgetReallocation holds the schema definition for the BudgetReallocation entity.
+type BudgetReallocation struct {
+	ent.Schema
+}
+
+// Fields of the BudgetReallocation.
+func (BudgetReallocation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable().
+			Comment("ID of the user who moved the money"),
+		field.String("organization_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("ID of the organization whose budget was changed; unset for the user's own budgets"),
+		field.String("budget_id").
+			NotEmpty().
+			Immutable().
+			Comment("ID of the budget the reallocation applies to, as sent with backtests"),
+		field.String("from_category").
+			NotEmpty().
+			Immutable().
+			Comment("Budget category the amount was taken from"),
+		field.String("to_category").
+			NotEmpty().
+			Immutable().
+			Comment("Budget category the amount was moved to"),
+		field.Float("amount").
+			Positive().
+			Immutable().
+			Comment("Amount moved between the categories"),
+		field.Time("effective_date").
+			Immutable().
+			Comment("The reallocation applies to the budget period containing this date"),
+		field.String("note").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Why the money was moved"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the BudgetReallocation.
+func (BudgetReallocation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("budget_reallocations").
+			Field("user_id").
+			Required().
+			Unique().
+			Immutable().
+			Comment("The user who moved the money"),
+	}
+}
+
+// Indexes of the BudgetReallocation.
+func (BudgetReallocation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "budget_id", "effective_date"),
+		index.Fields("organization_id", "budget_id", "effective_date"),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BulkOperation holds the schema definition for the BulkOperation entity.
+type BulkOperation struct {
+	ent.Schema
+}
+
+// Fields of the BulkOperation.
+func (BulkOperation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable().
+			Comment("ID of the user whose transactions were changed"),
+		field.Enum("kind").
+			Values("recategorize", "delete", "categorize").
+			Immutable().
+			Comment("categorize is a batch of changes from applying categorization rules"),
+		field.String("category").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Category the transactions were moved to, for recategorizations"),
+		field.Strings("transaction_ids").
+			Immutable().
+			Comment("IDs of the transactions changed"),
+		field.JSON("before_images", json.RawMessage{}).
+			Immutable().
+			Comment("The transactions as they were before the operation, restored by undoing it"),
+		field.Time("expires_at").
+			Immutable().
+			Comment("The operation can be undone until then"),
+		field.Time("undone_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the BulkOperation.
+func (BulkOperation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CardAccount holds the schema definition for the CardAccount entity.
+type CardAccount struct {
+	ent.Schema
+}
+
+// Fields of the CardAccount.
+func (CardAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Comment("ID of the user who owns the card"),
+		field.String("name").
+			NotEmpty().
+			Comment("Name of the card, e.g. the issuer and product"),
+		field.String("card_last_four").
+			Optional().
+			Nillable().
+			Comment("Last 4 digits of the card; transactions with them belong to its statements"),
+		field.Int("statement_closing_day").
+			Range(1, 31).
+			Comment("Day of the month the statement closes; the last day in shorter months"),
+		field.Int("payment_due_days").
+			Default(25).
+			Min(0).
+			Comment("Days after the statement closes that payment is due"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the CardAccount.
+func (CardAccount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
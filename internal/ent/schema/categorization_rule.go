@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CategorizationRule holds the schema definition for the
+// CategorizationRule entity.
+type CategorizationRule struct {
+	ent.Schema
+}
+
+// Fields of the CategorizationRule.
+func (CategorizationRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable().
+			Comment("ID of the user the rule belongs to"),
+		field.String("name").
+			NotEmpty(),
+		field.Int("priority").
+			Default(0).
+			Comment("Rules are tried in priority order, lowest first"),
+		field.Bool("enabled").
+			Default(true),
+		field.String("merchant_pattern").
+			Optional().
+			Nillable().
+			Comment("Regular expression merchant names must match, ignoring case"),
+		field.Float("min_amount").
+			Optional().
+			Nillable(),
+		field.Float("max_amount").
+			Optional().
+			Nillable(),
+		field.Strings("description_keywords").
+			Optional().
+			Comment("Descriptions must contain one of these, ignoring case"),
+		field.String("category").
+			Optional().
+			Nillable().
+			Comment("Category given to matching transactions"),
+		field.Strings("tags").
+			Optional().
+			Comment("Tags added to matching transactions"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the CategorizationRule.
+func (CategorizationRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "priority"),
+	}
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CategoryFeedback holds the schema definition for the CategoryFeedback
+// entity: a user accepting or rejecting a category suggested for one of
+// their transactions, kept as training data for the suggestions.
+type CategoryFeedback struct {
+	ent.Schema
+}
+
+// Fields of the CategoryFeedback.
+func (CategoryFeedback) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable().
+			Comment("ID of the user who gave the feedback"),
+		field.String("transaction_id").
+			NotEmpty().
+			Immutable(),
+		field.String("merchant_name").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Merchant name of the transaction, as trained on"),
+		field.String("description").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Description of the transaction, as trained on"),
+		field.String("suggested_category").
+			NotEmpty().
+			Immutable(),
+		field.Enum("suggestion_source").
+			Values("model", "rule").
+			Immutable().
+			Comment("Whether the learned model or a categorization rule made the suggestion"),
+		field.Float("confidence").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Model's confidence in the suggested category, 0-1"),
+		field.Bool("accepted").
+			Immutable(),
+		field.String("category").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Category the transaction was given: the suggestion if accepted, else the user's correction"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the CategoryFeedback.
+func (CategoryFeedback) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("transaction_id"),
+	}
+}
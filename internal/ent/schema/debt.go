@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Debt holds the schema definition for the Debt entity.
+type Debt struct {
+	ent.Schema
+}
+
+// Fields of the Debt.
+func (Debt) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Comment("ID of the user who owes the debt"),
+		field.String("name").
+			NotEmpty().
+			Comment("Name of the debt, e.g. the card or lender"),
+		field.Enum("type").
+			Values("credit_card", "student_loan", "auto_loan", "mortgage", "personal_loan", "medical", "other").
+			Default("other"),
+		field.Float("balance").
+			Min(0).
+			Comment("Amount currently owed"),
+		field.Float("apr").
+			Min(0).
+			Comment("Annual percentage rate, e.g. 19.99"),
+		field.Float("minimum_payment").
+			Positive().
+			Comment("Minimum monthly payment"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the Debt.
+func (Debt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
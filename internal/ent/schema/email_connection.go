@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -22,7 +23,11 @@ func (EmailConnection) Fields() []ent.Field {
 			Immutable(),
 		field.String("user_id").
 			NotEmpty().
-			Comment("ID of the user who owns this connection"),
+			Comment("ID of the user who connected the account"),
+		field.String("organization_id").
+			Optional().
+			Nillable().
+			Comment("ID of the organization the connection is shared with; unset for the user's own connections"),
 		field.String("provider_account_id").
 			NotEmpty().
 			Comment("Email provider account identifier"),
@@ -30,8 +35,8 @@ func (EmailConnection) Fields() []ent.Field {
 			NotEmpty().
 			Comment("Email address"),
 		field.Enum("provider").
-			Values("gmail", "outlook", "imap").
-			Comment("Email provider type"),
+			Values("gmail", "outlook", "imap", "sandbox").
+			Comment("Email provider type; sandbox serves a synthetic mailbox"),
 		field.String("access_token").
 			Sensitive().
 			Comment("OAuth2 access token"),
@@ -44,6 +49,10 @@ func (EmailConnection) Fields() []ent.Field {
 			Values("active", "inactive", "revoked", "expired").
 			Default("active").
 			Comment("Connection status"),
+		field.String("migration_run_id").
+			Optional().
+			Nillable().
+			Comment("ID of the legacy migration run that created the connection, so the run can be rolled back"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -54,15 +63,31 @@ func (EmailConnection) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Last successful sync timestamp"),
+		field.Enum("sync_schedule").
+			Values("every_15_minutes", "hourly", "daily", "manual").
+			Default("manual").
+			Comment("How often the worker syncs the connection; manual only syncs on request"),
+		field.Time("next_sync_at").
+			Optional().
+			Nillable().
+			Comment("When the worker next syncs the connection on its schedule"),
 	}
 }
 
 // Edges of the EmailConnection.
 func (EmailConnection) Edges() []ent.Edge {
 	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("email_connections").
+			Field("user_id").
+			Required().
+			Unique().
+			Comment("The user who connected the account"),
 		edge.To("labels", EmailLabel.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
 			Comment("Labels/folders associated with this connection"),
 		edge.To("syncs", EmailSync.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
 			Comment("Sync history for this connection"),
 	}
 }
@@ -71,9 +96,14 @@ func (EmailConnection) Edges() []ent.Edge {
 func (EmailConnection) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("user_id"),
-		index.Fields("provider_account_id").
+		index.Fields("organization_id"),
+		// A provider account can only be connected once per provider.
+		index.Fields("provider_account_id", "provider").
 			Unique(),
 		index.Fields("status"),
+		index.Fields("sync_schedule", "next_sync_at"),
+		index.Fields("status", "token_expiry"),
 		index.Fields("provider"),
+		index.Fields("migration_run_id"),
 	}
 }
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EmailMessage holds the schema definition for the EmailMessage entity.
+// A message is recorded when an email sync indexes it, so it can be
+// searched without going back to the email provider.
+type EmailMessage struct {
+	ent.Schema
+}
+
+// Fields of the EmailMessage.
+func (EmailMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("connection_id").
+			NotEmpty().
+			Comment("ID of the EmailConnection the message belongs to"),
+		field.String("message_id").
+			NotEmpty().
+			Comment("Message ID from the email provider"),
+		field.String("thread_id").
+			Optional().
+			Comment("Thread ID from the email provider"),
+		field.String("subject").
+			Optional(),
+		field.String("sender").
+			Optional().
+			Comment("From header of the message"),
+		field.Text("snippet").
+			Optional().
+			Comment("Short plain text excerpt of the body"),
+		field.Strings("label_ids").
+			Optional().
+			Comment("Provider label IDs the message had when indexed"),
+		field.Bool("has_attachments").
+			Default(false),
+		field.Int("attachment_count").
+			Default(0),
+		field.Float("amount").
+			Optional().
+			Nillable().
+			Comment("Largest amount of money mentioned in the subject or snippet"),
+		field.Time("received_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the EmailMessage.
+func (EmailMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("connection_id", "message_id").
+			Unique(),
+		index.Fields("connection_id", "received_at"),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -68,10 +69,17 @@ func (EmailSync) Fields() []ent.Field {
 		field.JSON("error_details", map[string]interface{}{}).
 			Optional().
 			Comment("Detailed error information"),
+		field.JSON("checkpoints", map[string]interface{}{}).
+			Optional().
+			Comment("Resumable progress of a full sync, by label"),
 		field.String("history_id").
 			Optional().
 			Nillable().
 			Comment("Email provider history ID for incremental sync"),
+		field.String("fallback_reason").
+			Optional().
+			Nillable().
+			Comment("Why an incremental sync fell back to a backfill or full sync"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -90,6 +98,9 @@ func (EmailSync) Edges() []ent.Edge {
 			Required().
 			Unique().
 			Comment("The connection this sync belongs to"),
+		edge.To("failures", EmailSyncFailure.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Messages that failed to process in this sync"),
 	}
 }
 
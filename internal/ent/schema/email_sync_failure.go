@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EmailSyncFailure holds the schema definition for the EmailSyncFailure entity.
+type EmailSyncFailure struct {
+	ent.Schema
+}
+
+// Fields of the EmailSyncFailure.
+func (EmailSyncFailure) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("sync_id").
+			NotEmpty().
+			Comment("ID of the EmailSync the message failed in"),
+		field.String("connection_id").
+			NotEmpty().
+			Comment("ID of the EmailConnection the message belongs to"),
+		field.String("message_id").
+			NotEmpty().
+			Comment("Message ID from the email provider"),
+		field.Enum("status").
+			Values("failed", "resolved").
+			Default("failed").
+			Comment("Whether the message is still failing or was processed by a retry"),
+		field.String("error_message").
+			Comment("Error from the most recent attempt"),
+		field.Int("attempts").
+			Default(1).
+			Comment("Number of times processing the message was attempted"),
+		field.Time("resolved_at").
+			Optional().
+			Nillable().
+			Comment("When a retry processed the message"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the EmailSyncFailure.
+func (EmailSyncFailure) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("sync", EmailSync.Type).
+			Ref("failures").
+			Field("sync_id").
+			Required().
+			Unique().
+			Comment("The sync this failure was recorded in"),
+	}
+}
+
+// Indexes of the EmailSyncFailure.
+func (EmailSyncFailure) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("sync_id", "message_id").
+			Unique(),
+		index.Fields("sync_id", "status"),
+		index.Fields("connection_id"),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EmergencyFundSnapshot holds the schema definition for the
+// EmergencyFundSnapshot entity. A snapshot records a user's emergency fund
+// coverage on a day, so it can be tracked over time.
+type EmergencyFundSnapshot struct {
+	ent.Schema
+}
+
+// Fields of the EmergencyFundSnapshot.
+func (EmergencyFundSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Comment("ID of the user the snapshot belongs to"),
+		field.Time("date").
+			Comment("Day the snapshot was taken, at midnight UTC"),
+		field.Float("balance").
+			Comment("Total balance of the designated accounts"),
+		field.Float("monthly_expenses").
+			Comment("Average monthly expenses from recent transactions"),
+		field.Float("months_covered").
+			Optional().
+			Nillable().
+			Comment("Months of expenses the balance covers; unset without any expense history"),
+		field.Float("target_months").
+			Comment("The user's target when the snapshot was taken"),
+		field.Bool("below_target").
+			Default(false).
+			Comment("Whether coverage was below the target"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the EmergencyFundSnapshot.
+func (EmergencyFundSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "date").
+			Unique(),
+	}
+}
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// EmergencyFundTarget holds the schema definition for the
+// EmergencyFundTarget entity.
+type EmergencyFundTarget struct {
+	ent.Schema
+}
+
+// Fields of the EmergencyFundTarget.
+func (EmergencyFundTarget) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Unique().
+			Comment("ID of the user the target belongs to"),
+		field.Float("target_months").
+			Positive().
+			Comment("Months of expenses the user wants their emergency fund to cover"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Goal holds the schema definition for the Goal entity.
+type Goal struct {
+	ent.Schema
+}
+
+// Fields of the Goal.
+func (Goal) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Comment("ID of the user who set the goal"),
+		field.String("name").
+			NotEmpty().
+			Comment("Name of the goal, e.g. what it saves for"),
+		field.Enum("type").
+			Values("savings", "debt_payoff").
+			Default("savings").
+			Immutable(),
+		field.Float("target_amount").
+			Positive().
+			Comment("Amount to save, or of debt to pay off"),
+		field.Float("starting_amount").
+			Default(0).
+			Min(0).
+			Comment("Amount already saved when the goal was set; for debt payoff goals linked to a debt, its balance then"),
+		field.Time("deadline").
+			Optional().
+			Nillable().
+			Comment("Date the goal should be met by"),
+		field.String("category").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Category or tag of the transactions that count toward the goal"),
+		field.String("account_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("ID of the liquid account saved in, or the debt paid off, whose balance tracks the goal"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the Goal.
+func (Goal) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -22,7 +23,11 @@ func (GoogleDriveConnection) Fields() []ent.Field {
 			Immutable(),
 		field.String("user_id").
 			NotEmpty().
-			Comment("ID of the user who owns this connection"),
+			Comment("ID of the user who connected the account"),
+		field.String("organization_id").
+			Optional().
+			Nillable().
+			Comment("ID of the organization the connection is shared with; unset for the user's own connections"),
 		field.String("google_account_id").
 			NotEmpty().
 			Comment("Google account identifier"),
@@ -41,6 +46,10 @@ func (GoogleDriveConnection) Fields() []ent.Field {
 			Values("active", "inactive", "revoked", "expired").
 			Default("active").
 			Comment("Connection status"),
+		field.String("migration_run_id").
+			Optional().
+			Nillable().
+			Comment("ID of the legacy migration run that created the connection, so the run can be rolled back"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -51,15 +60,31 @@ func (GoogleDriveConnection) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Last successful sync timestamp"),
+		field.Enum("sync_schedule").
+			Values("every_15_minutes", "hourly", "daily", "manual").
+			Default("manual").
+			Comment("How often the worker syncs the connection; manual only syncs on request"),
+		field.Time("next_sync_at").
+			Optional().
+			Nillable().
+			Comment("When the worker next syncs the connection on its schedule"),
 	}
 }
 
 // Edges of the GoogleDriveConnection.
 func (GoogleDriveConnection) Edges() []ent.Edge {
 	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("google_drive_connections").
+			Field("user_id").
+			Required().
+			Unique().
+			Comment("The user who connected the account"),
 		edge.To("folders", GoogleDriveFolder.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
 			Comment("Folders being tracked by this connection"),
 		edge.To("syncs", GoogleDriveSync.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
 			Comment("Sync history for this connection"),
 	}
 }
@@ -68,8 +93,12 @@ func (GoogleDriveConnection) Edges() []ent.Edge {
 func (GoogleDriveConnection) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("user_id"),
+		index.Fields("organization_id"),
 		index.Fields("google_account_id").
 			Unique(),
 		index.Fields("status"),
+		index.Fields("sync_schedule", "next_sync_at"),
+		index.Fields("status", "token_expiry"),
+		index.Fields("migration_run_id"),
 	}
 }
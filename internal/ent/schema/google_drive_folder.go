@@ -42,6 +42,9 @@ func (GoogleDriveFolder) Fields() []ent.Field {
 		field.Bool("sync_enabled").
 			Default(true).
 			Comment("Whether syncing is enabled for this folder"),
+		field.Bool("recursive").
+			Default(true).
+			Comment("Whether subfolders are synced along with the folder"),
 		field.Enum("sync_direction").
 			Values("download", "upload", "bidirectional").
 			Default("download").
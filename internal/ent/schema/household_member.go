@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// HouseholdMember holds the schema definition for the HouseholdMember entity.
+type HouseholdMember struct {
+	ent.Schema
+}
+
+// Fields of the HouseholdMember.
+func (HouseholdMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Comment("ID of the user whose household the member belongs to"),
+		field.String("name").
+			NotEmpty().
+			Comment("Display name of the member"),
+		field.Strings("connection_ids").
+			Optional().
+			Comment("Email and Drive connections whose receipts are attributed to the member"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the HouseholdMember.
+func (HouseholdMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
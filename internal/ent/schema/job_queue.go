@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// JobQueue holds the schema definition for the JobQueue entity.
+type JobQueue struct {
+	ent.Schema
+}
+
+// Fields of the JobQueue.
+func (JobQueue) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable().
+			Comment("Queue name, e.g. email_import"),
+		field.Bool("paused").
+			Default(false).
+			Comment("Workers don't start jobs from a paused queue"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// JobSchedule holds the schema definition for the JobSchedule entity: a
+// user-defined recurring background job, such as a weekly anomaly scan.
+// Each run is claimed by advancing next_run_at, so a run is submitted once
+// however many API instances check for due schedules.
+type JobSchedule struct {
+	ent.Schema
+}
+
+// Fields of the JobSchedule.
+func (JobSchedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable().
+			Comment("ID of the user who owns the schedule"),
+		field.String("name").
+			NotEmpty(),
+		field.String("job_type").
+			NotEmpty().
+			Immutable().
+			Comment("Type of the job each run submits, e.g. anomaly_scan"),
+		field.Enum("frequency").
+			Values("daily", "weekly", "monthly", "quarterly"),
+		field.JSON("params", json.RawMessage{}).
+			Optional().
+			Comment("Schedule parameters the job type's runner builds each run's job from"),
+		field.Bool("enabled").
+			Default(true).
+			Comment("Disabled by the user, or after too many consecutive failures"),
+		field.Time("next_run_at"),
+		field.Time("last_run_at").
+			Optional().
+			Nillable().
+			Comment("When the last finished run completed"),
+		field.String("last_status").
+			Optional().
+			Comment("Status of the last finished run"),
+		field.String("last_error").
+			Optional(),
+		field.Int("consecutive_failures").
+			Default(0).
+			NonNegative(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the JobSchedule.
+func (JobSchedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("enabled", "next_run_at"),
+		index.Fields("user_id"),
+	}
+}
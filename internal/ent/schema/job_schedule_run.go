@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// JobScheduleRun holds the schema definition for the JobScheduleRun entity:
+// one run of a job schedule, and its outcome once its job finishes. Runs
+// whose job never started, because its parameters couldn't be built or it
+// couldn't be queued, are recorded as failed without a job.
+type JobScheduleRun struct {
+	ent.Schema
+}
+
+// Fields of the JobScheduleRun.
+func (JobScheduleRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("schedule_id").
+			NotEmpty().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable().
+			Comment("ID of the user who owns the schedule"),
+		field.String("job_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("ID of the queued job the run submitted"),
+		field.Enum("status").
+			Values("pending", "running", "completed", "failed", "cancelled").
+			Default("pending"),
+		field.String("error").
+			Optional(),
+		field.Time("started_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the JobScheduleRun.
+func (JobScheduleRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("schedule_id", "started_at"),
+		index.Fields("status"),
+		index.Fields("user_id"),
+	}
+}
@@ -81,6 +81,10 @@ func (LineItem) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("ID from legacy system for migration tracking"),
+		field.String("migration_run_id").
+			Optional().
+			Nillable().
+			Comment("ID of the legacy migration run that created the line item, so the run can be rolled back"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -111,5 +115,6 @@ func (LineItem) Indexes() []ent.Index {
 		index.Fields("product_code"),
 		index.Fields("category"),
 		index.Fields("legacy_id"),
+		index.Fields("migration_run_id"),
 	}
 }
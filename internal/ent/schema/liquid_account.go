@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LiquidAccount holds the schema definition for the LiquidAccount entity.
+type LiquidAccount struct {
+	ent.Schema
+}
+
+// Fields of the LiquidAccount.
+func (LiquidAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Comment("ID of the user who owns the account"),
+		field.String("name").
+			NotEmpty().
+			Comment("Name of the account, e.g. the bank and account"),
+		field.Enum("type").
+			Values("checking", "savings", "money_market", "cash", "other").
+			Default("savings"),
+		field.Float("balance").
+			Min(0).
+			Comment("Current balance"),
+		field.Bool("emergency_fund").
+			Default(true).
+			Comment("Whether the user designated the account as part of their emergency fund"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the LiquidAccount.
+func (LiquidAccount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
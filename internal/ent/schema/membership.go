@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Membership holds the schema definition for the Membership entity.
+type Membership struct {
+	ent.Schema
+}
+
+// Fields of the Membership.
+func (Membership) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("organization_id").
+			NotEmpty().
+			Immutable().
+			Comment("ID of the organization the user belongs to"),
+		field.String("user_id").
+			NotEmpty().
+			Immutable().
+			Comment("ID of the member"),
+		field.Enum("role").
+			Values("owner", "admin", "member", "viewer").
+			Default("member").
+			Comment("Owners and admins manage the organization, members share its data and viewers can only read it"),
+		field.String("invited_by").
+			Optional().
+			Nillable().
+			Comment("ID of the user who added the member"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Membership.
+func (Membership) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("memberships").
+			Field("organization_id").
+			Required().
+			Unique().
+			Immutable().
+			Comment("The organization the user belongs to"),
+	}
+}
+
+// Indexes of the Membership.
+func (Membership) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "user_id").
+			Unique(),
+		index.Fields("user_id"),
+	}
+}
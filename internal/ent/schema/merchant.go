@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Merchant holds the schema definition for the Merchant entity.
+type Merchant struct {
+	ent.Schema
+}
+
+// Fields of the Merchant.
+func (Merchant) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("name").
+			NotEmpty().
+			Comment("Clean display name, e.g. \"Blue Bottle Coffee\" for \"SQ *BLUE BOTTLE COFFEE 4423 SAN F\""),
+		field.String("normalized_key").
+			Unique().
+			NotEmpty().
+			Immutable().
+			Comment("Lowercased name the raw merchant strings normalizing to it are matched by"),
+		field.String("category").
+			Optional().
+			Nillable().
+			Comment("Spending category of the merchant, filled in for its uncategorized transactions"),
+		field.String("logo_url").
+			Optional().
+			Nillable(),
+		field.String("website").
+			Optional().
+			Nillable(),
+		field.Time("enriched_at").
+			Optional().
+			Nillable().
+			Comment("When category and logo were last looked up; unset until they are"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Merchant.
+func (Merchant) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("transactions", Transaction.Type).
+			Comment("Transactions at the merchant"),
+	}
+}
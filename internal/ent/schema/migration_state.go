@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MigrationState holds the schema definition for the MigrationState entity:
+// progress of the legacy data migration, kept so an interrupted migration
+// can resume. It records the new ID each legacy user was given, how far
+// each phase of the migration got, and each run of the migration.
+type MigrationState struct {
+	ent.Schema
+}
+
+// Annotations of the MigrationState.
+func (MigrationState) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "migration_state"},
+	}
+}
+
+// Fields of the MigrationState.
+func (MigrationState) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.Enum("kind").
+			Values("user_id", "cursor", "run").
+			Immutable().
+			Comment("user_id maps a legacy user ID to its new ID; cursor is the last legacy ID a phase migrated; run is a run of the migration"),
+		field.String("key").
+			NotEmpty().
+			Immutable().
+			Comment("The legacy user ID for user_id, the phase for cursor, e.g. users or receipts, or the run ID for run"),
+		field.String("value").
+			NotEmpty().
+			Comment("The new user ID for user_id, the last legacy ID migrated for cursor, or the status of a run: running, completed or rolled_back"),
+		field.String("run_id").
+			Optional().
+			Nillable().
+			Comment("The run that last saved a cursor, so rolling the run back resets only its cursors"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the MigrationState.
+func (MigrationState) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind", "key").
+			Unique(),
+	}
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Notification holds the schema definition for the Notification entity: an
+// email sent to a user other than an alert, such as a connection that needs
+// reconnecting, a weekly summary or a monthly report. It is recorded so
+// each is sent once.
+type Notification struct {
+	ent.Schema
+}
+
+// Fields of the Notification.
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable().
+			Comment("ID of the user the notification is for"),
+		field.Enum("kind").
+			Values("connection_failure", "sync_errors", "weekly_summary", "monthly_report").
+			Immutable(),
+		field.String("dedupe_key").
+			NotEmpty().
+			Immutable().
+			Comment("Identifies what the notification is about, so it is sent once, e.g. connection:<kind>:<id>:<status>:<token expiry>, sync_errors:<kind>:<connection id>:<first failed sync id>, weekly_summary:<ISO week> or monthly_report:<YYYY-MM>"),
+		field.String("recipient").
+			NotEmpty().
+			Comment("Address the notification was sent to"),
+		field.String("subject").
+			NotEmpty(),
+		field.Enum("status").
+			Values("sent", "failed").
+			Comment("failed notifications are retried on the next run"),
+		field.Int("attempts").
+			Default(1).
+			NonNegative(),
+		field.String("error").
+			Optional().
+			Nillable().
+			Comment("Why the last attempt to send failed"),
+		field.Time("sent_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the Notification.
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "dedupe_key").
+			Unique(),
+		index.Fields("user_id", "created_at"),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OAuthState holds the schema definition for the OAuthState entity: the
+// CSRF state of an OAuth flow, kept from the request that starts the flow
+// until its callback so any API replica can complete it.
+type OAuthState struct {
+	ent.Schema
+}
+
+// Annotations of the OAuthState.
+func (OAuthState) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "oauth_states"},
+	}
+}
+
+// Fields of the OAuthState.
+func (OAuthState) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable().
+			Comment("The state parameter sent to the provider"),
+		field.Bytes("data").
+			Immutable().
+			Comment("What the callback needs to complete the flow, such as the user and scopes, as JSON"),
+		field.Time("expires_at").
+			Immutable().
+			Comment("When the state stops being accepted; expired states are deleted as new ones are saved"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the OAuthState.
+func (OAuthState) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OCRFeedback holds the schema definition for the OCRFeedback entity: a
+// user's correction of where a field was read from a receipt image, kept
+// as training data for the OCR field extraction.
+type OCRFeedback struct {
+	ent.Schema
+}
+
+// Fields of the OCRFeedback.
+func (OCRFeedback) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("receipt_id").
+			NotEmpty().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable().
+			Comment("ID of the user who corrected the receipt"),
+		field.String("field").
+			NotEmpty().
+			Immutable().
+			Comment("Receipt field corrected, e.g. total_amount"),
+		field.String("value").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Value the user read in the selection, if they gave one"),
+		field.JSON("box", json.RawMessage{}).
+			Immutable().
+			Comment("Bounding box the user selected, in page-relative coordinates"),
+		field.String("predicted_value").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Value extracted for the field before the correction"),
+		field.JSON("predicted_box", json.RawMessage{}).
+			Optional().
+			Immutable().
+			Comment("Bounding box the field was extracted from before the correction"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the OCRFeedback.
+func (OCRFeedback) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("receipt_id", "created_at"),
+		index.Fields("field", "created_at"),
+	}
+}
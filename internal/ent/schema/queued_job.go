@@ -0,0 +1,102 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// QueuedJob holds the schema definition for the QueuedJob entity.
+type QueuedJob struct {
+	ent.Schema
+}
+
+// Fields of the QueuedJob.
+func (QueuedJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("queue").
+			NotEmpty().
+			Immutable().
+			Comment("Name of the queue the job is in, e.g. email_import"),
+		field.String("type").
+			NotEmpty().
+			Immutable().
+			Comment("Kind of work the job does within its queue"),
+		field.String("owner_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("ID of the user the job runs for, if any"),
+		field.Enum("status").
+			Values("pending", "processing", "completed", "failed", "cancelled").
+			Default("pending").
+			Comment("Lifecycle state; pending jobs run once run_at has passed"),
+		field.Int("priority").
+			Default(0).
+			Comment("Higher priority jobs run first"),
+		field.JSON("payload", json.RawMessage{}).
+			Optional().
+			Comment("Arguments the job's handler decodes"),
+		field.JSON("result", json.RawMessage{}).
+			Optional().
+			Comment("What the handler returned for a completed job"),
+		field.Float("progress").
+			Default(0).
+			Comment("Fraction of the running attempt done, from 0 to 1"),
+		field.String("error").
+			Optional().
+			Nillable().
+			Comment("Error from the most recent attempt"),
+		field.Int("retry_count").
+			Default(0).
+			Comment("Number of failed attempts that were retried"),
+		field.Int("max_retries").
+			Default(3).
+			Comment("Retries allowed before the job is failed"),
+		field.Time("run_at").
+			Default(time.Now).
+			Comment("When the job is next due; set in the future for scheduled jobs and retry backoff"),
+		field.String("locked_by").
+			Optional().
+			Nillable().
+			Comment("ID of the worker process running the job"),
+		field.Time("heartbeat_at").
+			Optional().
+			Nillable().
+			Comment("When the running worker last reported the job alive"),
+		field.Time("started_at").
+			Optional().
+			Nillable().
+			Comment("When the latest attempt started"),
+		field.Time("completed_at").
+			Optional().
+			Nillable().
+			Comment("When the job completed or failed for good"),
+		field.Time("cancelled_at").
+			Optional().
+			Nillable().
+			Comment("When the job was cancelled"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the QueuedJob.
+func (QueuedJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("queue", "status", "run_at"),
+		index.Fields("status", "heartbeat_at"),
+		index.Fields("status", "completed_at"),
+		index.Fields("queue", "owner_id"),
+	}
+}
@@ -127,6 +127,10 @@ func (Receipt) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("ID from legacy system for migration tracking"),
+		field.String("migration_run_id").
+			Optional().
+			Nillable().
+			Comment("ID of the legacy migration run that created the receipt, so the run can be rolled back"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -161,6 +165,7 @@ func (Receipt) Indexes() []ent.Index {
 		index.Fields("merchant_name"),
 		index.Fields("receipt_date"),
 		index.Fields("legacy_id"),
+		index.Fields("migration_run_id"),
 		index.Fields("created_at"),
 	}
 }
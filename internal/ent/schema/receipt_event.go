@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ReceiptEvent holds the schema definition for the ReceiptEvent entity: a
+// pipeline stage run on a receipt, recorded by the component that ran it,
+// making up the receipt's provenance trail.
+type ReceiptEvent struct {
+	ent.Schema
+}
+
+// Fields of the ReceiptEvent.
+func (ReceiptEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("receipt_id").
+			NotEmpty().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable().
+			Comment("ID of the user who owns the receipt"),
+		field.Enum("stage").
+			Values("fetched", "downloaded", "ocr", "parsed", "matched", "categorized").
+			Immutable().
+			Comment("Pipeline stage the event records"),
+		field.Enum("status").
+			Values("succeeded", "failed").
+			Default("succeeded").
+			Immutable(),
+		field.String("version").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Version of the component that ran the stage, e.g. the OCR engine or parser"),
+		field.Text("detail").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("What the stage did, or why it failed"),
+		field.JSON("data", map[string]interface{}{}).
+			Optional().
+			Immutable().
+			Comment("Stage outputs worth tracing, e.g. the attachment hash or extracted total"),
+		field.Time("occurred_at").
+			Immutable().
+			Comment("When the stage ran"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the ReceiptEvent.
+func (ReceiptEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("receipt_id", "occurred_at"),
+		index.Fields("user_id"),
+	}
+}
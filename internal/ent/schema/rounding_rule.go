@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// RoundingRule holds the schema definition for the RoundingRule entity.
+type RoundingRule struct {
+	ent.Schema
+}
+
+// Fields of the RoundingRule.
+func (RoundingRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Unique().
+			Comment("ID of the user the rule belongs to"),
+		field.Float("increment").
+			Positive().
+			Comment("Purchases are rounded up to the next multiple of this amount, e.g. 1.00"),
+		field.Float("multiplier").
+			Positive().
+			Default(1).
+			Comment("Multiplies each round-up, e.g. 2 sets aside twice the spare change"),
+		field.Float("max_round_up").
+			Optional().
+			Nillable().
+			Comment("Cap on the round-up of a single purchase"),
+		field.Strings("payment_methods").
+			Optional().
+			Comment("Payment methods the rule applies to; empty applies to all"),
+		field.Bool("enabled").
+			Default(true).
+			Comment("Whether new purchases are rounded up"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SavedFilter holds the schema definition for the SavedFilter entity.
+type SavedFilter struct {
+	ent.Schema
+}
+
+// Fields of the SavedFilter.
+func (SavedFilter) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable().
+			Comment("ID of the user who saved the filter"),
+		field.String("name").
+			NotEmpty().
+			Comment("Name the filter is picked by, unique for the user"),
+		field.JSON("filter", json.RawMessage{}).
+			Comment("The transaction filter, as sent to the transaction search"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the SavedFilter.
+func (SavedFilter) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "name").
+			Unique(),
+	}
+}
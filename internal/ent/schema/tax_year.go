@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// TaxYear holds the schema definition for the TaxYear entity: a tax year
+// data file installed through /api/admin/tax-data. Installed years are
+// loaded alongside the built-in ones at startup, replacing a built-in year
+// with the same tax year.
+type TaxYear struct {
+	ent.Schema
+}
+
+// Annotations of the TaxYear.
+func (TaxYear) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "tax_years"},
+	}
+}
+
+// Fields of the TaxYear.
+func (TaxYear) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable().
+			Comment("The tax year the data is for"),
+		field.Bytes("data").
+			NotEmpty().
+			Comment("The tax year data file as installed"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
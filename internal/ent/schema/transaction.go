@@ -21,11 +21,15 @@ func (Transaction) Fields() []ent.Field {
 			Unique().
 			Immutable(),
 		field.String("receipt_id").
-			NotEmpty().
-			Comment("ID of the parent Receipt"),
+			Optional().
+			Comment("ID of the parent Receipt; empty for manual entries"),
 		field.String("user_id").
 			NotEmpty().
 			Comment("ID of the user who owns this transaction"),
+		field.Enum("source").
+			Values("receipt", "manual").
+			Default("receipt").
+			Comment("Whether the transaction was extracted from a receipt or entered by hand"),
 		field.Enum("type").
 			Values("purchase", "refund", "payment", "withdrawal", "deposit", "transfer", "other").
 			Default("purchase").
@@ -69,6 +73,9 @@ func (Transaction) Fields() []ent.Field {
 			Values("pending", "completed", "failed", "refunded", "disputed", "cancelled").
 			Default("completed").
 			Comment("Transaction status"),
+		field.Float("round_up_amount").
+			Default(0).
+			Comment("Amount set aside for savings by the user's rounding rule"),
 		field.Bool("is_recurring").
 			Default(false).
 			Comment("Whether this is a recurring transaction"),
@@ -86,10 +93,22 @@ func (Transaction) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("User notes about the transaction"),
+		field.String("member_id").
+			Optional().
+			Nillable().
+			Comment("ID of the household member the transaction was assigned to by hand; overrides the source connection's member"),
+		field.String("merchant_id").
+			Optional().
+			Nillable().
+			Comment("ID of the canonical merchant the merchant name normalizes to; unset until resolved"),
 		field.String("legacy_id").
 			Optional().
 			Nillable().
 			Comment("ID from legacy system for migration tracking"),
+		field.String("migration_run_id").
+			Optional().
+			Nillable().
+			Comment("ID of the legacy migration run that created the transaction, so the run can be rolled back"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -105,9 +124,13 @@ func (Transaction) Edges() []ent.Edge {
 		edge.From("receipt", Receipt.Type).
 			Ref("transactions").
 			Field("receipt_id").
-			Required().
 			Unique().
 			Comment("The receipt this transaction belongs to"),
+		edge.From("merchant", Merchant.Type).
+			Ref("transactions").
+			Field("merchant_id").
+			Unique().
+			Comment("The canonical merchant the transaction was at"),
 	}
 }
 
@@ -120,8 +143,11 @@ func (Transaction) Indexes() []ent.Index {
 		index.Fields("status"),
 		index.Fields("transaction_date"),
 		index.Fields("user_id", "transaction_date"),
+		index.Fields("user_id", "source"),
 		index.Fields("merchant_name"),
+		index.Fields("merchant_id"),
 		index.Fields("legacy_id"),
+		index.Fields("migration_run_id"),
 		index.Fields("created_at"),
 	}
 }
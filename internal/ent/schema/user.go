@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// User holds the schema definition for the User entity.
+type User struct {
+	ent.Schema
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("email").
+			Optional().
+			Nillable().
+			Unique().
+			Comment("Lowercased email address the user signs in with; unset for users authenticated by tokens issued elsewhere"),
+		field.Bool("email_verified").
+			Default(false).
+			Comment("Whether the email address was verified, by Google for SSO users"),
+		field.String("pending_email").
+			Optional().
+			Nillable().
+			Comment("Lowercased email address the user changed theirs to, which replaces it once verified"),
+		field.String("email_verification_hash").
+			Optional().
+			Nillable().
+			Sensitive().
+			Comment("SHA-256 hash of the token last emailed to verify the pending or current email address"),
+		field.Time("email_verification_expires_at").
+			Optional().
+			Nillable().
+			Comment("When the email verification token expires"),
+		field.String("name").
+			Default("").
+			Comment("Display name"),
+		field.String("locale").
+			Optional().
+			Nillable().
+			Comment("Language tag the user prefers generated text in"),
+		field.String("password_hash").
+			Optional().
+			Nillable().
+			Sensitive().
+			Comment("bcrypt hash of the password; unset for users who only sign in with Google"),
+		field.String("google_id").
+			Optional().
+			Nillable().
+			Unique().
+			Comment("Google account ID of users who sign in with Google"),
+		field.Time("last_login_at").
+			Optional().
+			Nillable().
+			Comment("When the user last signed in"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the User.
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("email_connections", EmailConnection.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Email accounts the user connected, deleted with the user"),
+		edge.To("google_drive_connections", GoogleDriveConnection.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Drive accounts the user connected, deleted with the user"),
+		edge.To("budget_reallocations", BudgetReallocation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Money the user moved between budget categories, deleted with the user"),
+	}
+}
@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery
+// entity: an event to be posted to a webhook endpoint, and the outcome of
+// the attempts to post it. Failed attempts are retried with backoff.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("endpoint_id").
+			NotEmpty().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable(),
+		field.String("event").
+			NotEmpty().
+			Immutable().
+			Comment("Event type, e.g. sync.completed"),
+		field.String("event_id").
+			NotEmpty().
+			Immutable().
+			Comment("ID of the event; shared by its deliveries to each endpoint"),
+		field.JSON("payload", json.RawMessage{}).
+			Immutable().
+			Comment("Request body, exactly as signed"),
+		field.Enum("status").
+			Values("pending", "succeeded", "failed").
+			Default("pending").
+			Comment("failed once attempts run out"),
+		field.Int("attempts").
+			Default(0).
+			NonNegative(),
+		field.Time("next_attempt_at").
+			Optional().
+			Nillable().
+			Comment("When the delivery is next attempted, while pending"),
+		field.Time("last_attempt_at").
+			Optional().
+			Nillable(),
+		field.Int("response_status").
+			Optional().
+			Nillable().
+			Comment("HTTP status of the last attempt's response"),
+		field.String("error").
+			Optional().
+			Nillable().
+			Comment("Why the last attempt failed"),
+		field.Time("delivered_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("endpoint_id", "created_at"),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookEndpoint holds the schema definition for the WebhookEndpoint
+// entity: a URL a user has ClockZen post events to, such as completed syncs
+// or extracted receipts, signed with the endpoint's secret.
+type WebhookEndpoint struct {
+	ent.Schema
+}
+
+// Fields of the WebhookEndpoint.
+func (WebhookEndpoint) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable().
+			Comment("ID of the user who owns the endpoint"),
+		field.String("url").
+			NotEmpty(),
+		field.String("secret").
+			NotEmpty().
+			Sensitive().
+			Comment("Key deliveries are signed with; encrypted at rest when token encryption keys are configured"),
+		field.JSON("events", []string{}).
+			Comment("Event types delivered to the endpoint"),
+		field.Bool("enabled").
+			Default(true),
+		field.String("description").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the WebhookEndpoint.
+func (WebhookEndpoint) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
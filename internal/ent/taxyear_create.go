@@ -0,0 +1,597 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/taxyear"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaxYearCreate is the builder for creating a TaxYear entity.
+type TaxYearCreate struct {
+	config
+	mutation *TaxYearMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetData sets the "data" field.
+func (_c *TaxYearCreate) SetData(v []byte) *TaxYearCreate {
+	_c.mutation.SetData(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TaxYearCreate) SetCreatedAt(v time.Time) *TaxYearCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TaxYearCreate) SetNillableCreatedAt(v *time.Time) *TaxYearCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TaxYearCreate) SetUpdatedAt(v time.Time) *TaxYearCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TaxYearCreate) SetNillableUpdatedAt(v *time.Time) *TaxYearCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TaxYearCreate) SetID(v int) *TaxYearCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the TaxYearMutation object of the builder.
+func (_c *TaxYearCreate) Mutation() *TaxYearMutation {
+	return _c.mutation
+}
+
+// Save creates the TaxYear in the database.
+func (_c *TaxYearCreate) Save(ctx context.Context) (*TaxYear, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TaxYearCreate) SaveX(ctx context.Context) *TaxYear {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaxYearCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaxYearCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TaxYearCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := taxyear.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := taxyear.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TaxYearCreate) check() error {
+	if _, ok := _c.mutation.Data(); !ok {
+		return &ValidationError{Name: "data", err: errors.New(`ent: missing required field "TaxYear.data"`)}
+	}
+	if v, ok := _c.mutation.Data(); ok {
+		if err := taxyear.DataValidator(v); err != nil {
+			return &ValidationError{Name: "data", err: fmt.Errorf(`ent: validator failed for field "TaxYear.data": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaxYear.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TaxYear.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := taxyear.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TaxYear.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TaxYearCreate) sqlSave(ctx context.Context) (*TaxYear, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TaxYearCreate) createSpec() (*TaxYear, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaxYear{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(taxyear.Table, sqlgraph.NewFieldSpec(taxyear.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Data(); ok {
+		_spec.SetField(taxyear.FieldData, field.TypeBytes, value)
+		_node.Data = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(taxyear.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(taxyear.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaxYear.Create().
+//		SetData(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaxYearUpsert) {
+//			SetData(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TaxYearCreate) OnConflict(opts ...sql.ConflictOption) *TaxYearUpsertOne {
+	_c.conflict = opts
+	return &TaxYearUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaxYear.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TaxYearCreate) OnConflictColumns(columns ...string) *TaxYearUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TaxYearUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TaxYearUpsertOne is the builder for "upsert"-ing
+	//  one TaxYear node.
+	TaxYearUpsertOne struct {
+		create *TaxYearCreate
+	}
+
+	// TaxYearUpsert is the "OnConflict" setter.
+	TaxYearUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetData sets the "data" field.
+func (u *TaxYearUpsert) SetData(v []byte) *TaxYearUpsert {
+	u.Set(taxyear.FieldData, v)
+	return u
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *TaxYearUpsert) UpdateData() *TaxYearUpsert {
+	u.SetExcluded(taxyear.FieldData)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaxYearUpsert) SetUpdatedAt(v time.Time) *TaxYearUpsert {
+	u.Set(taxyear.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaxYearUpsert) UpdateUpdatedAt() *TaxYearUpsert {
+	u.SetExcluded(taxyear.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TaxYear.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(taxyear.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TaxYearUpsertOne) UpdateNewValues() *TaxYearUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(taxyear.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(taxyear.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaxYear.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TaxYearUpsertOne) Ignore() *TaxYearUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaxYearUpsertOne) DoNothing() *TaxYearUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaxYearCreate.OnConflict
+// documentation for more info.
+func (u *TaxYearUpsertOne) Update(set func(*TaxYearUpsert)) *TaxYearUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaxYearUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetData sets the "data" field.
+func (u *TaxYearUpsertOne) SetData(v []byte) *TaxYearUpsertOne {
+	return u.Update(func(s *TaxYearUpsert) {
+		s.SetData(v)
+	})
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *TaxYearUpsertOne) UpdateData() *TaxYearUpsertOne {
+	return u.Update(func(s *TaxYearUpsert) {
+		s.UpdateData()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaxYearUpsertOne) SetUpdatedAt(v time.Time) *TaxYearUpsertOne {
+	return u.Update(func(s *TaxYearUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaxYearUpsertOne) UpdateUpdatedAt() *TaxYearUpsertOne {
+	return u.Update(func(s *TaxYearUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TaxYearUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaxYearCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaxYearUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TaxYearUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TaxYearUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TaxYearCreateBulk is the builder for creating many TaxYear entities in bulk.
+type TaxYearCreateBulk struct {
+	config
+	err      error
+	builders []*TaxYearCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TaxYear entities in the database.
+func (_c *TaxYearCreateBulk) Save(ctx context.Context) ([]*TaxYear, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TaxYear, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaxYearMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TaxYearCreateBulk) SaveX(ctx context.Context) []*TaxYear {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaxYearCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaxYearCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaxYear.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaxYearUpsert) {
+//			SetData(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TaxYearCreateBulk) OnConflict(opts ...sql.ConflictOption) *TaxYearUpsertBulk {
+	_c.conflict = opts
+	return &TaxYearUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaxYear.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TaxYearCreateBulk) OnConflictColumns(columns ...string) *TaxYearUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TaxYearUpsertBulk{
+		create: _c,
+	}
+}
+
+// TaxYearUpsertBulk is the builder for "upsert"-ing
+// a bulk of TaxYear nodes.
+type TaxYearUpsertBulk struct {
+	create *TaxYearCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TaxYear.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(taxyear.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TaxYearUpsertBulk) UpdateNewValues() *TaxYearUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(taxyear.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(taxyear.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaxYear.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TaxYearUpsertBulk) Ignore() *TaxYearUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaxYearUpsertBulk) DoNothing() *TaxYearUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaxYearCreateBulk.OnConflict
+// documentation for more info.
+func (u *TaxYearUpsertBulk) Update(set func(*TaxYearUpsert)) *TaxYearUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaxYearUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetData sets the "data" field.
+func (u *TaxYearUpsertBulk) SetData(v []byte) *TaxYearUpsertBulk {
+	return u.Update(func(s *TaxYearUpsert) {
+		s.SetData(v)
+	})
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *TaxYearUpsertBulk) UpdateData() *TaxYearUpsertBulk {
+	return u.Update(func(s *TaxYearUpsert) {
+		s.UpdateData()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaxYearUpsertBulk) SetUpdatedAt(v time.Time) *TaxYearUpsertBulk {
+	return u.Update(func(s *TaxYearUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaxYearUpsertBulk) UpdateUpdatedAt() *TaxYearUpsertBulk {
+	return u.Update(func(s *TaxYearUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TaxYearUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TaxYearCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaxYearCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaxYearUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
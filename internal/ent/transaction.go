@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"clockzen-next/internal/ent/merchant"
 	"clockzen-next/internal/ent/receipt"
 	"clockzen-next/internal/ent/transaction"
 	"encoding/json"
@@ -19,10 +20,12 @@ type Transaction struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
-	// ID of the parent Receipt
+	// ID of the parent Receipt; empty for manual entries
 	ReceiptID string `json:"receipt_id,omitempty"`
 	// ID of the user who owns this transaction
 	UserID string `json:"user_id,omitempty"`
+	// Whether the transaction was extracted from a receipt or entered by hand
+	Source transaction.Source `json:"source,omitempty"`
 	// Type of transaction
 	Type transaction.Type `json:"type,omitempty"`
 	// Transaction amount
@@ -47,6 +50,8 @@ type Transaction struct {
 	AuthorizationCode *string `json:"authorization_code,omitempty"`
 	// Transaction status
 	Status transaction.Status `json:"status,omitempty"`
+	// Amount set aside for savings by the user's rounding rule
+	RoundUpAmount float64 `json:"round_up_amount,omitempty"`
 	// Whether this is a recurring transaction
 	IsRecurring bool `json:"is_recurring,omitempty"`
 	// Recurrence pattern if recurring (e.g., monthly, weekly)
@@ -57,8 +62,14 @@ type Transaction struct {
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	// User notes about the transaction
 	Notes *string `json:"notes,omitempty"`
+	// ID of the household member the transaction was assigned to by hand; overrides the source connection's member
+	MemberID *string `json:"member_id,omitempty"`
+	// ID of the canonical merchant the merchant name normalizes to; unset until resolved
+	MerchantID *string `json:"merchant_id,omitempty"`
 	// ID from legacy system for migration tracking
 	LegacyID *string `json:"legacy_id,omitempty"`
+	// ID of the legacy migration run that created the transaction, so the run can be rolled back
+	MigrationRunID *string `json:"migration_run_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -73,9 +84,11 @@ type Transaction struct {
 type TransactionEdges struct {
 	// The receipt this transaction belongs to
 	Receipt *Receipt `json:"receipt,omitempty"`
+	// The canonical merchant the transaction was at
+	Merchant *Merchant `json:"merchant,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // ReceiptOrErr returns the Receipt value or an error if the edge
@@ -89,6 +102,17 @@ func (e TransactionEdges) ReceiptOrErr() (*Receipt, error) {
 	return nil, &NotLoadedError{edge: "receipt"}
 }
 
+// MerchantOrErr returns the Merchant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) MerchantOrErr() (*Merchant, error) {
+	if e.Merchant != nil {
+		return e.Merchant, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: merchant.Label}
+	}
+	return nil, &NotLoadedError{edge: "merchant"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Transaction) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -98,9 +122,9 @@ func (*Transaction) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case transaction.FieldIsRecurring:
 			values[i] = new(sql.NullBool)
-		case transaction.FieldAmount:
+		case transaction.FieldAmount, transaction.FieldRoundUpAmount:
 			values[i] = new(sql.NullFloat64)
-		case transaction.FieldID, transaction.FieldReceiptID, transaction.FieldUserID, transaction.FieldType, transaction.FieldCurrency, transaction.FieldDescription, transaction.FieldMerchantName, transaction.FieldMerchantCategory, transaction.FieldPaymentMethod, transaction.FieldCardLastFour, transaction.FieldReferenceNumber, transaction.FieldAuthorizationCode, transaction.FieldStatus, transaction.FieldRecurrencePattern, transaction.FieldNotes, transaction.FieldLegacyID:
+		case transaction.FieldID, transaction.FieldReceiptID, transaction.FieldUserID, transaction.FieldSource, transaction.FieldType, transaction.FieldCurrency, transaction.FieldDescription, transaction.FieldMerchantName, transaction.FieldMerchantCategory, transaction.FieldPaymentMethod, transaction.FieldCardLastFour, transaction.FieldReferenceNumber, transaction.FieldAuthorizationCode, transaction.FieldStatus, transaction.FieldRecurrencePattern, transaction.FieldNotes, transaction.FieldMemberID, transaction.FieldMerchantID, transaction.FieldLegacyID, transaction.FieldMigrationRunID:
 			values[i] = new(sql.NullString)
 		case transaction.FieldTransactionDate, transaction.FieldCreatedAt, transaction.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -137,6 +161,12 @@ func (_m *Transaction) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UserID = value.String
 			}
+		case transaction.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = transaction.Source(value.String)
+			}
 		case transaction.FieldType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field type", values[i])
@@ -216,6 +246,12 @@ func (_m *Transaction) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Status = transaction.Status(value.String)
 			}
+		case transaction.FieldRoundUpAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field round_up_amount", values[i])
+			} else if value.Valid {
+				_m.RoundUpAmount = value.Float64
+			}
 		case transaction.FieldIsRecurring:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_recurring", values[i])
@@ -252,6 +288,20 @@ func (_m *Transaction) assignValues(columns []string, values []any) error {
 				_m.Notes = new(string)
 				*_m.Notes = value.String
 			}
+		case transaction.FieldMemberID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field member_id", values[i])
+			} else if value.Valid {
+				_m.MemberID = new(string)
+				*_m.MemberID = value.String
+			}
+		case transaction.FieldMerchantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value.Valid {
+				_m.MerchantID = new(string)
+				*_m.MerchantID = value.String
+			}
 		case transaction.FieldLegacyID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field legacy_id", values[i])
@@ -259,6 +309,13 @@ func (_m *Transaction) assignValues(columns []string, values []any) error {
 				_m.LegacyID = new(string)
 				*_m.LegacyID = value.String
 			}
+		case transaction.FieldMigrationRunID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field migration_run_id", values[i])
+			} else if value.Valid {
+				_m.MigrationRunID = new(string)
+				*_m.MigrationRunID = value.String
+			}
 		case transaction.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -289,6 +346,11 @@ func (_m *Transaction) QueryReceipt() *ReceiptQuery {
 	return NewTransactionClient(_m.config).QueryReceipt(_m)
 }
 
+// QueryMerchant queries the "merchant" edge of the Transaction entity.
+func (_m *Transaction) QueryMerchant() *MerchantQuery {
+	return NewTransactionClient(_m.config).QueryMerchant(_m)
+}
+
 // Update returns a builder for updating this Transaction.
 // Note that you need to call Transaction.Unwrap() before calling this method if this Transaction
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -318,6 +380,9 @@ func (_m *Transaction) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(_m.UserID)
 	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Source))
+	builder.WriteString(", ")
 	builder.WriteString("type=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Type))
 	builder.WriteString(", ")
@@ -368,6 +433,9 @@ func (_m *Transaction) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
+	builder.WriteString("round_up_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RoundUpAmount))
+	builder.WriteString(", ")
 	builder.WriteString("is_recurring=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsRecurring))
 	builder.WriteString(", ")
@@ -387,11 +455,26 @@ func (_m *Transaction) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.MemberID; v != nil {
+		builder.WriteString("member_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.MerchantID; v != nil {
+		builder.WriteString("merchant_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := _m.LegacyID; v != nil {
 		builder.WriteString("legacy_id=")
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.MigrationRunID; v != nil {
+		builder.WriteString("migration_run_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
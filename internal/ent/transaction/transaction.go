@@ -19,6 +19,8 @@ const (
 	FieldReceiptID = "receipt_id"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
 	// FieldAmount holds the string denoting the amount field in the database.
@@ -43,6 +45,8 @@ const (
 	FieldAuthorizationCode = "authorization_code"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldRoundUpAmount holds the string denoting the round_up_amount field in the database.
+	FieldRoundUpAmount = "round_up_amount"
 	// FieldIsRecurring holds the string denoting the is_recurring field in the database.
 	FieldIsRecurring = "is_recurring"
 	// FieldRecurrencePattern holds the string denoting the recurrence_pattern field in the database.
@@ -53,14 +57,22 @@ const (
 	FieldMetadata = "metadata"
 	// FieldNotes holds the string denoting the notes field in the database.
 	FieldNotes = "notes"
+	// FieldMemberID holds the string denoting the member_id field in the database.
+	FieldMemberID = "member_id"
+	// FieldMerchantID holds the string denoting the merchant_id field in the database.
+	FieldMerchantID = "merchant_id"
 	// FieldLegacyID holds the string denoting the legacy_id field in the database.
 	FieldLegacyID = "legacy_id"
+	// FieldMigrationRunID holds the string denoting the migration_run_id field in the database.
+	FieldMigrationRunID = "migration_run_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// EdgeReceipt holds the string denoting the receipt edge name in mutations.
 	EdgeReceipt = "receipt"
+	// EdgeMerchant holds the string denoting the merchant edge name in mutations.
+	EdgeMerchant = "merchant"
 	// Table holds the table name of the transaction in the database.
 	Table = "transactions"
 	// ReceiptTable is the table that holds the receipt relation/edge.
@@ -70,6 +82,13 @@ const (
 	ReceiptInverseTable = "receipts"
 	// ReceiptColumn is the table column denoting the receipt relation/edge.
 	ReceiptColumn = "receipt_id"
+	// MerchantTable is the table that holds the merchant relation/edge.
+	MerchantTable = "transactions"
+	// MerchantInverseTable is the table name for the Merchant entity.
+	// It exists in this package in order to avoid circular dependency with the "merchant" package.
+	MerchantInverseTable = "merchants"
+	// MerchantColumn is the table column denoting the merchant relation/edge.
+	MerchantColumn = "merchant_id"
 )
 
 // Columns holds all SQL columns for transaction fields.
@@ -77,6 +96,7 @@ var Columns = []string{
 	FieldID,
 	FieldReceiptID,
 	FieldUserID,
+	FieldSource,
 	FieldType,
 	FieldAmount,
 	FieldCurrency,
@@ -89,12 +109,16 @@ var Columns = []string{
 	FieldReferenceNumber,
 	FieldAuthorizationCode,
 	FieldStatus,
+	FieldRoundUpAmount,
 	FieldIsRecurring,
 	FieldRecurrencePattern,
 	FieldCategoryTags,
 	FieldMetadata,
 	FieldNotes,
+	FieldMemberID,
+	FieldMerchantID,
 	FieldLegacyID,
+	FieldMigrationRunID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -110,12 +134,12 @@ func ValidColumn(column string) bool {
 }
 
 var (
-	// ReceiptIDValidator is a validator for the "receipt_id" field. It is called by the builders before save.
-	ReceiptIDValidator func(string) error
 	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
 	UserIDValidator func(string) error
 	// DefaultCurrency holds the default value on creation for the "currency" field.
 	DefaultCurrency string
+	// DefaultRoundUpAmount holds the default value on creation for the "round_up_amount" field.
+	DefaultRoundUpAmount float64
 	// DefaultIsRecurring holds the default value on creation for the "is_recurring" field.
 	DefaultIsRecurring bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -126,6 +150,32 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 )
 
+// Source defines the type for the "source" enum field.
+type Source string
+
+// SourceReceipt is the default value of the Source enum.
+const DefaultSource = SourceReceipt
+
+// Source values.
+const (
+	SourceReceipt Source = "receipt"
+	SourceManual  Source = "manual"
+)
+
+func (s Source) String() string {
+	return string(s)
+}
+
+// SourceValidator is a validator for the "source" field enum values. It is called by the builders before save.
+func SourceValidator(s Source) error {
+	switch s {
+	case SourceReceipt, SourceManual:
+		return nil
+	default:
+		return fmt.Errorf("transaction: invalid enum value for source field: %q", s)
+	}
+}
+
 // Type defines the type for the "type" enum field.
 type Type string
 
@@ -205,6 +255,11 @@ func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
 }
 
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
 // ByType orders the results by the type field.
 func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
@@ -265,6 +320,11 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByRoundUpAmount orders the results by the round_up_amount field.
+func ByRoundUpAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoundUpAmount, opts...).ToFunc()
+}
+
 // ByIsRecurring orders the results by the is_recurring field.
 func ByIsRecurring(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsRecurring, opts...).ToFunc()
@@ -280,11 +340,26 @@ func ByNotes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNotes, opts...).ToFunc()
 }
 
+// ByMemberID orders the results by the member_id field.
+func ByMemberID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMemberID, opts...).ToFunc()
+}
+
+// ByMerchantID orders the results by the merchant_id field.
+func ByMerchantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMerchantID, opts...).ToFunc()
+}
+
 // ByLegacyID orders the results by the legacy_id field.
 func ByLegacyID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLegacyID, opts...).ToFunc()
 }
 
+// ByMigrationRunID orders the results by the migration_run_id field.
+func ByMigrationRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMigrationRunID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -301,6 +376,13 @@ func ByReceiptField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newReceiptStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByMerchantField orders the results by merchant field.
+func ByMerchantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMerchantStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newReceiptStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -308,3 +390,10 @@ func newReceiptStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, ReceiptTable, ReceiptColumn),
 	)
 }
+func newMerchantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MerchantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MerchantTable, MerchantColumn),
+	)
+}
@@ -125,6 +125,11 @@ func AuthorizationCode(v string) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldAuthorizationCode, v))
 }
 
+// RoundUpAmount applies equality check predicate on the "round_up_amount" field. It's identical to RoundUpAmountEQ.
+func RoundUpAmount(v float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldRoundUpAmount, v))
+}
+
 // IsRecurring applies equality check predicate on the "is_recurring" field. It's identical to IsRecurringEQ.
 func IsRecurring(v bool) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldIsRecurring, v))
@@ -140,11 +145,26 @@ func Notes(v string) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldNotes, v))
 }
 
+// MemberID applies equality check predicate on the "member_id" field. It's identical to MemberIDEQ.
+func MemberID(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldMemberID, v))
+}
+
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldMerchantID, v))
+}
+
 // LegacyID applies equality check predicate on the "legacy_id" field. It's identical to LegacyIDEQ.
 func LegacyID(v string) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldLegacyID, v))
 }
 
+// MigrationRunID applies equality check predicate on the "migration_run_id" field. It's identical to MigrationRunIDEQ.
+func MigrationRunID(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldMigrationRunID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldCreatedAt, v))
@@ -210,6 +230,16 @@ func ReceiptIDHasSuffix(v string) predicate.Transaction {
 	return predicate.Transaction(sql.FieldHasSuffix(FieldReceiptID, v))
 }
 
+// ReceiptIDIsNil applies the IsNil predicate on the "receipt_id" field.
+func ReceiptIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldReceiptID))
+}
+
+// ReceiptIDNotNil applies the NotNil predicate on the "receipt_id" field.
+func ReceiptIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldReceiptID))
+}
+
 // ReceiptIDEqualFold applies the EqualFold predicate on the "receipt_id" field.
 func ReceiptIDEqualFold(v string) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEqualFold(FieldReceiptID, v))
@@ -285,6 +315,26 @@ func UserIDContainsFold(v string) predicate.Transaction {
 	return predicate.Transaction(sql.FieldContainsFold(FieldUserID, v))
 }
 
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v Source) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v Source) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...Source) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...Source) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldSource, vs...))
+}
+
 // TypeEQ applies the EQ predicate on the "type" field.
 func TypeEQ(v Type) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldType, v))
@@ -995,6 +1045,46 @@ func StatusNotIn(vs ...Status) predicate.Transaction {
 	return predicate.Transaction(sql.FieldNotIn(FieldStatus, vs...))
 }
 
+// RoundUpAmountEQ applies the EQ predicate on the "round_up_amount" field.
+func RoundUpAmountEQ(v float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldRoundUpAmount, v))
+}
+
+// RoundUpAmountNEQ applies the NEQ predicate on the "round_up_amount" field.
+func RoundUpAmountNEQ(v float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldRoundUpAmount, v))
+}
+
+// RoundUpAmountIn applies the In predicate on the "round_up_amount" field.
+func RoundUpAmountIn(vs ...float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldRoundUpAmount, vs...))
+}
+
+// RoundUpAmountNotIn applies the NotIn predicate on the "round_up_amount" field.
+func RoundUpAmountNotIn(vs ...float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldRoundUpAmount, vs...))
+}
+
+// RoundUpAmountGT applies the GT predicate on the "round_up_amount" field.
+func RoundUpAmountGT(v float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldRoundUpAmount, v))
+}
+
+// RoundUpAmountGTE applies the GTE predicate on the "round_up_amount" field.
+func RoundUpAmountGTE(v float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldRoundUpAmount, v))
+}
+
+// RoundUpAmountLT applies the LT predicate on the "round_up_amount" field.
+func RoundUpAmountLT(v float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldRoundUpAmount, v))
+}
+
+// RoundUpAmountLTE applies the LTE predicate on the "round_up_amount" field.
+func RoundUpAmountLTE(v float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldRoundUpAmount, v))
+}
+
 // IsRecurringEQ applies the EQ predicate on the "is_recurring" field.
 func IsRecurringEQ(v bool) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldIsRecurring, v))
@@ -1175,6 +1265,156 @@ func NotesContainsFold(v string) predicate.Transaction {
 	return predicate.Transaction(sql.FieldContainsFold(FieldNotes, v))
 }
 
+// MemberIDEQ applies the EQ predicate on the "member_id" field.
+func MemberIDEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldMemberID, v))
+}
+
+// MemberIDNEQ applies the NEQ predicate on the "member_id" field.
+func MemberIDNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldMemberID, v))
+}
+
+// MemberIDIn applies the In predicate on the "member_id" field.
+func MemberIDIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldMemberID, vs...))
+}
+
+// MemberIDNotIn applies the NotIn predicate on the "member_id" field.
+func MemberIDNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldMemberID, vs...))
+}
+
+// MemberIDGT applies the GT predicate on the "member_id" field.
+func MemberIDGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldMemberID, v))
+}
+
+// MemberIDGTE applies the GTE predicate on the "member_id" field.
+func MemberIDGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldMemberID, v))
+}
+
+// MemberIDLT applies the LT predicate on the "member_id" field.
+func MemberIDLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldMemberID, v))
+}
+
+// MemberIDLTE applies the LTE predicate on the "member_id" field.
+func MemberIDLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldMemberID, v))
+}
+
+// MemberIDContains applies the Contains predicate on the "member_id" field.
+func MemberIDContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldMemberID, v))
+}
+
+// MemberIDHasPrefix applies the HasPrefix predicate on the "member_id" field.
+func MemberIDHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldMemberID, v))
+}
+
+// MemberIDHasSuffix applies the HasSuffix predicate on the "member_id" field.
+func MemberIDHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldMemberID, v))
+}
+
+// MemberIDIsNil applies the IsNil predicate on the "member_id" field.
+func MemberIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldMemberID))
+}
+
+// MemberIDNotNil applies the NotNil predicate on the "member_id" field.
+func MemberIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldMemberID))
+}
+
+// MemberIDEqualFold applies the EqualFold predicate on the "member_id" field.
+func MemberIDEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldMemberID, v))
+}
+
+// MemberIDContainsFold applies the ContainsFold predicate on the "member_id" field.
+func MemberIDContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldMemberID, v))
+}
+
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDGT applies the GT predicate on the "merchant_id" field.
+func MerchantIDGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldMerchantID, v))
+}
+
+// MerchantIDGTE applies the GTE predicate on the "merchant_id" field.
+func MerchantIDGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldMerchantID, v))
+}
+
+// MerchantIDLT applies the LT predicate on the "merchant_id" field.
+func MerchantIDLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldMerchantID, v))
+}
+
+// MerchantIDLTE applies the LTE predicate on the "merchant_id" field.
+func MerchantIDLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldMerchantID, v))
+}
+
+// MerchantIDContains applies the Contains predicate on the "merchant_id" field.
+func MerchantIDContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldMerchantID, v))
+}
+
+// MerchantIDHasPrefix applies the HasPrefix predicate on the "merchant_id" field.
+func MerchantIDHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldMerchantID, v))
+}
+
+// MerchantIDHasSuffix applies the HasSuffix predicate on the "merchant_id" field.
+func MerchantIDHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldMerchantID, v))
+}
+
+// MerchantIDIsNil applies the IsNil predicate on the "merchant_id" field.
+func MerchantIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldMerchantID))
+}
+
+// MerchantIDNotNil applies the NotNil predicate on the "merchant_id" field.
+func MerchantIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldMerchantID))
+}
+
+// MerchantIDEqualFold applies the EqualFold predicate on the "merchant_id" field.
+func MerchantIDEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldMerchantID, v))
+}
+
+// MerchantIDContainsFold applies the ContainsFold predicate on the "merchant_id" field.
+func MerchantIDContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldMerchantID, v))
+}
+
 // LegacyIDEQ applies the EQ predicate on the "legacy_id" field.
 func LegacyIDEQ(v string) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldLegacyID, v))
@@ -1250,6 +1490,81 @@ func LegacyIDContainsFold(v string) predicate.Transaction {
 	return predicate.Transaction(sql.FieldContainsFold(FieldLegacyID, v))
 }
 
+// MigrationRunIDEQ applies the EQ predicate on the "migration_run_id" field.
+func MigrationRunIDEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDNEQ applies the NEQ predicate on the "migration_run_id" field.
+func MigrationRunIDNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDIn applies the In predicate on the "migration_run_id" field.
+func MigrationRunIDIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldMigrationRunID, vs...))
+}
+
+// MigrationRunIDNotIn applies the NotIn predicate on the "migration_run_id" field.
+func MigrationRunIDNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldMigrationRunID, vs...))
+}
+
+// MigrationRunIDGT applies the GT predicate on the "migration_run_id" field.
+func MigrationRunIDGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDGTE applies the GTE predicate on the "migration_run_id" field.
+func MigrationRunIDGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDLT applies the LT predicate on the "migration_run_id" field.
+func MigrationRunIDLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDLTE applies the LTE predicate on the "migration_run_id" field.
+func MigrationRunIDLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDContains applies the Contains predicate on the "migration_run_id" field.
+func MigrationRunIDContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDHasPrefix applies the HasPrefix predicate on the "migration_run_id" field.
+func MigrationRunIDHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDHasSuffix applies the HasSuffix predicate on the "migration_run_id" field.
+func MigrationRunIDHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDIsNil applies the IsNil predicate on the "migration_run_id" field.
+func MigrationRunIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldMigrationRunID))
+}
+
+// MigrationRunIDNotNil applies the NotNil predicate on the "migration_run_id" field.
+func MigrationRunIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldMigrationRunID))
+}
+
+// MigrationRunIDEqualFold applies the EqualFold predicate on the "migration_run_id" field.
+func MigrationRunIDEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldMigrationRunID, v))
+}
+
+// MigrationRunIDContainsFold applies the ContainsFold predicate on the "migration_run_id" field.
+func MigrationRunIDContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldMigrationRunID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldCreatedAt, v))
@@ -1353,6 +1668,29 @@ func HasReceiptWith(preds ...predicate.Receipt) predicate.Transaction {
 	})
 }
 
+// HasMerchant applies the HasEdge predicate on the "merchant" edge.
+func HasMerchant() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MerchantTable, MerchantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMerchantWith applies the HasEdge predicate on the "merchant" edge with a given conditions (other predicates).
+func HasMerchantWith(preds ...predicate.Merchant) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newMerchantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Transaction) predicate.Transaction {
 	return predicate.Transaction(sql.AndPredicates(predicates...))
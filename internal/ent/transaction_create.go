@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"clockzen-next/internal/ent/merchant"
 	"clockzen-next/internal/ent/receipt"
 	"clockzen-next/internal/ent/transaction"
 	"context"
@@ -10,6 +11,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -19,6 +22,7 @@ type TransactionCreate struct {
 	config
 	mutation *TransactionMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetReceiptID sets the "receipt_id" field.
@@ -27,12 +31,34 @@ func (_c *TransactionCreate) SetReceiptID(v string) *TransactionCreate {
 	return _c
 }
 
+// SetNillableReceiptID sets the "receipt_id" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableReceiptID(v *string) *TransactionCreate {
+	if v != nil {
+		_c.SetReceiptID(*v)
+	}
+	return _c
+}
+
 // SetUserID sets the "user_id" field.
 func (_c *TransactionCreate) SetUserID(v string) *TransactionCreate {
 	_c.mutation.SetUserID(v)
 	return _c
 }
 
+// SetSource sets the "source" field.
+func (_c *TransactionCreate) SetSource(v transaction.Source) *TransactionCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableSource(v *transaction.Source) *TransactionCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
 // SetType sets the "type" field.
 func (_c *TransactionCreate) SetType(v transaction.Type) *TransactionCreate {
 	_c.mutation.SetType(v)
@@ -185,6 +211,20 @@ func (_c *TransactionCreate) SetNillableStatus(v *transaction.Status) *Transacti
 	return _c
 }
 
+// SetRoundUpAmount sets the "round_up_amount" field.
+func (_c *TransactionCreate) SetRoundUpAmount(v float64) *TransactionCreate {
+	_c.mutation.SetRoundUpAmount(v)
+	return _c
+}
+
+// SetNillableRoundUpAmount sets the "round_up_amount" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableRoundUpAmount(v *float64) *TransactionCreate {
+	if v != nil {
+		_c.SetRoundUpAmount(*v)
+	}
+	return _c
+}
+
 // SetIsRecurring sets the "is_recurring" field.
 func (_c *TransactionCreate) SetIsRecurring(v bool) *TransactionCreate {
 	_c.mutation.SetIsRecurring(v)
@@ -239,6 +279,34 @@ func (_c *TransactionCreate) SetNillableNotes(v *string) *TransactionCreate {
 	return _c
 }
 
+// SetMemberID sets the "member_id" field.
+func (_c *TransactionCreate) SetMemberID(v string) *TransactionCreate {
+	_c.mutation.SetMemberID(v)
+	return _c
+}
+
+// SetNillableMemberID sets the "member_id" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableMemberID(v *string) *TransactionCreate {
+	if v != nil {
+		_c.SetMemberID(*v)
+	}
+	return _c
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (_c *TransactionCreate) SetMerchantID(v string) *TransactionCreate {
+	_c.mutation.SetMerchantID(v)
+	return _c
+}
+
+// SetNillableMerchantID sets the "merchant_id" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableMerchantID(v *string) *TransactionCreate {
+	if v != nil {
+		_c.SetMerchantID(*v)
+	}
+	return _c
+}
+
 // SetLegacyID sets the "legacy_id" field.
 func (_c *TransactionCreate) SetLegacyID(v string) *TransactionCreate {
 	_c.mutation.SetLegacyID(v)
@@ -253,6 +321,20 @@ func (_c *TransactionCreate) SetNillableLegacyID(v *string) *TransactionCreate {
 	return _c
 }
 
+// SetMigrationRunID sets the "migration_run_id" field.
+func (_c *TransactionCreate) SetMigrationRunID(v string) *TransactionCreate {
+	_c.mutation.SetMigrationRunID(v)
+	return _c
+}
+
+// SetNillableMigrationRunID sets the "migration_run_id" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableMigrationRunID(v *string) *TransactionCreate {
+	if v != nil {
+		_c.SetMigrationRunID(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *TransactionCreate) SetCreatedAt(v time.Time) *TransactionCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -292,6 +374,11 @@ func (_c *TransactionCreate) SetReceipt(v *Receipt) *TransactionCreate {
 	return _c.SetReceiptID(v.ID)
 }
 
+// SetMerchant sets the "merchant" edge to the Merchant entity.
+func (_c *TransactionCreate) SetMerchant(v *Merchant) *TransactionCreate {
+	return _c.SetMerchantID(v.ID)
+}
+
 // Mutation returns the TransactionMutation object of the builder.
 func (_c *TransactionCreate) Mutation() *TransactionMutation {
 	return _c.mutation
@@ -327,6 +414,10 @@ func (_c *TransactionCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *TransactionCreate) defaults() {
+	if _, ok := _c.mutation.Source(); !ok {
+		v := transaction.DefaultSource
+		_c.mutation.SetSource(v)
+	}
 	if _, ok := _c.mutation.GetType(); !ok {
 		v := transaction.DefaultType
 		_c.mutation.SetType(v)
@@ -339,6 +430,10 @@ func (_c *TransactionCreate) defaults() {
 		v := transaction.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.RoundUpAmount(); !ok {
+		v := transaction.DefaultRoundUpAmount
+		_c.mutation.SetRoundUpAmount(v)
+	}
 	if _, ok := _c.mutation.IsRecurring(); !ok {
 		v := transaction.DefaultIsRecurring
 		_c.mutation.SetIsRecurring(v)
@@ -355,14 +450,6 @@ func (_c *TransactionCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *TransactionCreate) check() error {
-	if _, ok := _c.mutation.ReceiptID(); !ok {
-		return &ValidationError{Name: "receipt_id", err: errors.New(`ent: missing required field "Transaction.receipt_id"`)}
-	}
-	if v, ok := _c.mutation.ReceiptID(); ok {
-		if err := transaction.ReceiptIDValidator(v); err != nil {
-			return &ValidationError{Name: "receipt_id", err: fmt.Errorf(`ent: validator failed for field "Transaction.receipt_id": %w`, err)}
-		}
-	}
 	if _, ok := _c.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Transaction.user_id"`)}
 	}
@@ -371,6 +458,14 @@ func (_c *TransactionCreate) check() error {
 			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Transaction.user_id": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "Transaction.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := transaction.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Transaction.source": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.GetType(); !ok {
 		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Transaction.type"`)}
 	}
@@ -396,6 +491,9 @@ func (_c *TransactionCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Transaction.status": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.RoundUpAmount(); !ok {
+		return &ValidationError{Name: "round_up_amount", err: errors.New(`ent: missing required field "Transaction.round_up_amount"`)}
+	}
 	if _, ok := _c.mutation.IsRecurring(); !ok {
 		return &ValidationError{Name: "is_recurring", err: errors.New(`ent: missing required field "Transaction.is_recurring"`)}
 	}
@@ -405,9 +503,6 @@ func (_c *TransactionCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Transaction.updated_at"`)}
 	}
-	if len(_c.mutation.ReceiptIDs()) == 0 {
-		return &ValidationError{Name: "receipt", err: errors.New(`ent: missing required edge "Transaction.receipt"`)}
-	}
 	return nil
 }
 
@@ -439,6 +534,7 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_node = &Transaction{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(transaction.Table, sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -447,6 +543,10 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_spec.SetField(transaction.FieldUserID, field.TypeString, value)
 		_node.UserID = value
 	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(transaction.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
 	if value, ok := _c.mutation.GetType(); ok {
 		_spec.SetField(transaction.FieldType, field.TypeEnum, value)
 		_node.Type = value
@@ -495,6 +595,10 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_spec.SetField(transaction.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.RoundUpAmount(); ok {
+		_spec.SetField(transaction.FieldRoundUpAmount, field.TypeFloat64, value)
+		_node.RoundUpAmount = value
+	}
 	if value, ok := _c.mutation.IsRecurring(); ok {
 		_spec.SetField(transaction.FieldIsRecurring, field.TypeBool, value)
 		_node.IsRecurring = value
@@ -515,10 +619,18 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_spec.SetField(transaction.FieldNotes, field.TypeString, value)
 		_node.Notes = &value
 	}
+	if value, ok := _c.mutation.MemberID(); ok {
+		_spec.SetField(transaction.FieldMemberID, field.TypeString, value)
+		_node.MemberID = &value
+	}
 	if value, ok := _c.mutation.LegacyID(); ok {
 		_spec.SetField(transaction.FieldLegacyID, field.TypeString, value)
 		_node.LegacyID = &value
 	}
+	if value, ok := _c.mutation.MigrationRunID(); ok {
+		_spec.SetField(transaction.FieldMigrationRunID, field.TypeString, value)
+		_node.MigrationRunID = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(transaction.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -544,89 +656,1763 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_node.ReceiptID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.MerchantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.MerchantTable,
+			Columns: []string{transaction.MerchantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MerchantID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
-// TransactionCreateBulk is the builder for creating many Transaction entities in bulk.
-type TransactionCreateBulk struct {
-	config
-	err      error
-	builders []*TransactionCreate
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Transaction.Create().
+//		SetReceiptID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TransactionUpsert) {
+//			SetReceiptID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TransactionCreate) OnConflict(opts ...sql.ConflictOption) *TransactionUpsertOne {
+	_c.conflict = opts
+	return &TransactionUpsertOne{
+		create: _c,
+	}
 }
 
-// Save creates the Transaction entities in the database.
-func (_c *TransactionCreateBulk) Save(ctx context.Context) ([]*Transaction, error) {
-	if _c.err != nil {
-		return nil, _c.err
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TransactionCreate) OnConflictColumns(columns ...string) *TransactionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TransactionUpsertOne{
+		create: _c,
 	}
-	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
-	nodes := make([]*Transaction, len(_c.builders))
-	mutators := make([]Mutator, len(_c.builders))
-	for i := range _c.builders {
-		func(i int, root context.Context) {
-			builder := _c.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*TransactionMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				mutation.done = true
-				return nodes[i], nil
-			})
-			for i := len(builder.hooks) - 1; i >= 0; i-- {
-				mut = builder.hooks[i](mut)
-			}
-			mutators[i] = mut
-		}(i, ctx)
+}
+
+type (
+	// TransactionUpsertOne is the builder for "upsert"-ing
+	//  one Transaction node.
+	TransactionUpsertOne struct {
+		create *TransactionCreate
 	}
-	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
-			return nil, err
-		}
+
+	// TransactionUpsert is the "OnConflict" setter.
+	TransactionUpsert struct {
+		*sql.UpdateSet
 	}
-	return nodes, nil
+)
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *TransactionUpsert) SetReceiptID(v string) *TransactionUpsert {
+	u.Set(transaction.FieldReceiptID, v)
+	return u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_c *TransactionCreateBulk) SaveX(ctx context.Context) []*Transaction {
-	v, err := _c.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateReceiptID() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldReceiptID)
+	return u
 }
 
-// Exec executes the query.
-func (_c *TransactionCreateBulk) Exec(ctx context.Context) error {
-	_, err := _c.Save(ctx)
-	return err
+// ClearReceiptID clears the value of the "receipt_id" field.
+func (u *TransactionUpsert) ClearReceiptID() *TransactionUpsert {
+	u.SetNull(transaction.FieldReceiptID)
+	return u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_c *TransactionCreateBulk) ExecX(ctx context.Context) {
-	if err := _c.Exec(ctx); err != nil {
+// SetUserID sets the "user_id" field.
+func (u *TransactionUpsert) SetUserID(v string) *TransactionUpsert {
+	u.Set(transaction.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateUserID() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldUserID)
+	return u
+}
+
+// SetSource sets the "source" field.
+func (u *TransactionUpsert) SetSource(v transaction.Source) *TransactionUpsert {
+	u.Set(transaction.FieldSource, v)
+	return u
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateSource() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldSource)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *TransactionUpsert) SetType(v transaction.Type) *TransactionUpsert {
+	u.Set(transaction.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateType() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldType)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *TransactionUpsert) SetAmount(v float64) *TransactionUpsert {
+	u.Set(transaction.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateAmount() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *TransactionUpsert) AddAmount(v float64) *TransactionUpsert {
+	u.Add(transaction.FieldAmount, v)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *TransactionUpsert) SetCurrency(v string) *TransactionUpsert {
+	u.Set(transaction.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateCurrency() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldCurrency)
+	return u
+}
+
+// SetTransactionDate sets the "transaction_date" field.
+func (u *TransactionUpsert) SetTransactionDate(v time.Time) *TransactionUpsert {
+	u.Set(transaction.FieldTransactionDate, v)
+	return u
+}
+
+// UpdateTransactionDate sets the "transaction_date" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateTransactionDate() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldTransactionDate)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *TransactionUpsert) SetDescription(v string) *TransactionUpsert {
+	u.Set(transaction.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateDescription() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TransactionUpsert) ClearDescription() *TransactionUpsert {
+	u.SetNull(transaction.FieldDescription)
+	return u
+}
+
+// SetMerchantName sets the "merchant_name" field.
+func (u *TransactionUpsert) SetMerchantName(v string) *TransactionUpsert {
+	u.Set(transaction.FieldMerchantName, v)
+	return u
+}
+
+// UpdateMerchantName sets the "merchant_name" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateMerchantName() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldMerchantName)
+	return u
+}
+
+// ClearMerchantName clears the value of the "merchant_name" field.
+func (u *TransactionUpsert) ClearMerchantName() *TransactionUpsert {
+	u.SetNull(transaction.FieldMerchantName)
+	return u
+}
+
+// SetMerchantCategory sets the "merchant_category" field.
+func (u *TransactionUpsert) SetMerchantCategory(v string) *TransactionUpsert {
+	u.Set(transaction.FieldMerchantCategory, v)
+	return u
+}
+
+// UpdateMerchantCategory sets the "merchant_category" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateMerchantCategory() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldMerchantCategory)
+	return u
+}
+
+// ClearMerchantCategory clears the value of the "merchant_category" field.
+func (u *TransactionUpsert) ClearMerchantCategory() *TransactionUpsert {
+	u.SetNull(transaction.FieldMerchantCategory)
+	return u
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (u *TransactionUpsert) SetPaymentMethod(v string) *TransactionUpsert {
+	u.Set(transaction.FieldPaymentMethod, v)
+	return u
+}
+
+// UpdatePaymentMethod sets the "payment_method" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdatePaymentMethod() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldPaymentMethod)
+	return u
+}
+
+// ClearPaymentMethod clears the value of the "payment_method" field.
+func (u *TransactionUpsert) ClearPaymentMethod() *TransactionUpsert {
+	u.SetNull(transaction.FieldPaymentMethod)
+	return u
+}
+
+// SetCardLastFour sets the "card_last_four" field.
+func (u *TransactionUpsert) SetCardLastFour(v string) *TransactionUpsert {
+	u.Set(transaction.FieldCardLastFour, v)
+	return u
+}
+
+// UpdateCardLastFour sets the "card_last_four" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateCardLastFour() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldCardLastFour)
+	return u
+}
+
+// ClearCardLastFour clears the value of the "card_last_four" field.
+func (u *TransactionUpsert) ClearCardLastFour() *TransactionUpsert {
+	u.SetNull(transaction.FieldCardLastFour)
+	return u
+}
+
+// SetReferenceNumber sets the "reference_number" field.
+func (u *TransactionUpsert) SetReferenceNumber(v string) *TransactionUpsert {
+	u.Set(transaction.FieldReferenceNumber, v)
+	return u
+}
+
+// UpdateReferenceNumber sets the "reference_number" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateReferenceNumber() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldReferenceNumber)
+	return u
+}
+
+// ClearReferenceNumber clears the value of the "reference_number" field.
+func (u *TransactionUpsert) ClearReferenceNumber() *TransactionUpsert {
+	u.SetNull(transaction.FieldReferenceNumber)
+	return u
+}
+
+// SetAuthorizationCode sets the "authorization_code" field.
+func (u *TransactionUpsert) SetAuthorizationCode(v string) *TransactionUpsert {
+	u.Set(transaction.FieldAuthorizationCode, v)
+	return u
+}
+
+// UpdateAuthorizationCode sets the "authorization_code" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateAuthorizationCode() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldAuthorizationCode)
+	return u
+}
+
+// ClearAuthorizationCode clears the value of the "authorization_code" field.
+func (u *TransactionUpsert) ClearAuthorizationCode() *TransactionUpsert {
+	u.SetNull(transaction.FieldAuthorizationCode)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TransactionUpsert) SetStatus(v transaction.Status) *TransactionUpsert {
+	u.Set(transaction.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateStatus() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldStatus)
+	return u
+}
+
+// SetRoundUpAmount sets the "round_up_amount" field.
+func (u *TransactionUpsert) SetRoundUpAmount(v float64) *TransactionUpsert {
+	u.Set(transaction.FieldRoundUpAmount, v)
+	return u
+}
+
+// UpdateRoundUpAmount sets the "round_up_amount" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateRoundUpAmount() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldRoundUpAmount)
+	return u
+}
+
+// AddRoundUpAmount adds v to the "round_up_amount" field.
+func (u *TransactionUpsert) AddRoundUpAmount(v float64) *TransactionUpsert {
+	u.Add(transaction.FieldRoundUpAmount, v)
+	return u
+}
+
+// SetIsRecurring sets the "is_recurring" field.
+func (u *TransactionUpsert) SetIsRecurring(v bool) *TransactionUpsert {
+	u.Set(transaction.FieldIsRecurring, v)
+	return u
+}
+
+// UpdateIsRecurring sets the "is_recurring" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateIsRecurring() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldIsRecurring)
+	return u
+}
+
+// SetRecurrencePattern sets the "recurrence_pattern" field.
+func (u *TransactionUpsert) SetRecurrencePattern(v string) *TransactionUpsert {
+	u.Set(transaction.FieldRecurrencePattern, v)
+	return u
+}
+
+// UpdateRecurrencePattern sets the "recurrence_pattern" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateRecurrencePattern() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldRecurrencePattern)
+	return u
+}
+
+// ClearRecurrencePattern clears the value of the "recurrence_pattern" field.
+func (u *TransactionUpsert) ClearRecurrencePattern() *TransactionUpsert {
+	u.SetNull(transaction.FieldRecurrencePattern)
+	return u
+}
+
+// SetCategoryTags sets the "category_tags" field.
+func (u *TransactionUpsert) SetCategoryTags(v []string) *TransactionUpsert {
+	u.Set(transaction.FieldCategoryTags, v)
+	return u
+}
+
+// UpdateCategoryTags sets the "category_tags" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateCategoryTags() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldCategoryTags)
+	return u
+}
+
+// ClearCategoryTags clears the value of the "category_tags" field.
+func (u *TransactionUpsert) ClearCategoryTags() *TransactionUpsert {
+	u.SetNull(transaction.FieldCategoryTags)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *TransactionUpsert) SetMetadata(v map[string]interface{}) *TransactionUpsert {
+	u.Set(transaction.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateMetadata() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *TransactionUpsert) ClearMetadata() *TransactionUpsert {
+	u.SetNull(transaction.FieldMetadata)
+	return u
+}
+
+// SetNotes sets the "notes" field.
+func (u *TransactionUpsert) SetNotes(v string) *TransactionUpsert {
+	u.Set(transaction.FieldNotes, v)
+	return u
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateNotes() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldNotes)
+	return u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *TransactionUpsert) ClearNotes() *TransactionUpsert {
+	u.SetNull(transaction.FieldNotes)
+	return u
+}
+
+// SetMemberID sets the "member_id" field.
+func (u *TransactionUpsert) SetMemberID(v string) *TransactionUpsert {
+	u.Set(transaction.FieldMemberID, v)
+	return u
+}
+
+// UpdateMemberID sets the "member_id" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateMemberID() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldMemberID)
+	return u
+}
+
+// ClearMemberID clears the value of the "member_id" field.
+func (u *TransactionUpsert) ClearMemberID() *TransactionUpsert {
+	u.SetNull(transaction.FieldMemberID)
+	return u
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (u *TransactionUpsert) SetMerchantID(v string) *TransactionUpsert {
+	u.Set(transaction.FieldMerchantID, v)
+	return u
+}
+
+// UpdateMerchantID sets the "merchant_id" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateMerchantID() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldMerchantID)
+	return u
+}
+
+// ClearMerchantID clears the value of the "merchant_id" field.
+func (u *TransactionUpsert) ClearMerchantID() *TransactionUpsert {
+	u.SetNull(transaction.FieldMerchantID)
+	return u
+}
+
+// SetLegacyID sets the "legacy_id" field.
+func (u *TransactionUpsert) SetLegacyID(v string) *TransactionUpsert {
+	u.Set(transaction.FieldLegacyID, v)
+	return u
+}
+
+// UpdateLegacyID sets the "legacy_id" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateLegacyID() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldLegacyID)
+	return u
+}
+
+// ClearLegacyID clears the value of the "legacy_id" field.
+func (u *TransactionUpsert) ClearLegacyID() *TransactionUpsert {
+	u.SetNull(transaction.FieldLegacyID)
+	return u
+}
+
+// SetMigrationRunID sets the "migration_run_id" field.
+func (u *TransactionUpsert) SetMigrationRunID(v string) *TransactionUpsert {
+	u.Set(transaction.FieldMigrationRunID, v)
+	return u
+}
+
+// UpdateMigrationRunID sets the "migration_run_id" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateMigrationRunID() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldMigrationRunID)
+	return u
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (u *TransactionUpsert) ClearMigrationRunID() *TransactionUpsert {
+	u.SetNull(transaction.FieldMigrationRunID)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TransactionUpsert) SetUpdatedAt(v time.Time) *TransactionUpsert {
+	u.Set(transaction.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateUpdatedAt() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(transaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TransactionUpsertOne) UpdateNewValues() *TransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(transaction.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(transaction.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TransactionUpsertOne) Ignore() *TransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TransactionUpsertOne) DoNothing() *TransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TransactionCreate.OnConflict
+// documentation for more info.
+func (u *TransactionUpsertOne) Update(set func(*TransactionUpsert)) *TransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *TransactionUpsertOne) SetReceiptID(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetReceiptID(v)
+	})
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateReceiptID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateReceiptID()
+	})
+}
+
+// ClearReceiptID clears the value of the "receipt_id" field.
+func (u *TransactionUpsertOne) ClearReceiptID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearReceiptID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *TransactionUpsertOne) SetUserID(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateUserID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *TransactionUpsertOne) SetSource(v transaction.Source) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateSource() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *TransactionUpsertOne) SetType(v transaction.Type) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateType() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *TransactionUpsertOne) SetAmount(v float64) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *TransactionUpsertOne) AddAmount(v float64) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateAmount() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *TransactionUpsertOne) SetCurrency(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateCurrency() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetTransactionDate sets the "transaction_date" field.
+func (u *TransactionUpsertOne) SetTransactionDate(v time.Time) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetTransactionDate(v)
+	})
+}
+
+// UpdateTransactionDate sets the "transaction_date" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateTransactionDate() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateTransactionDate()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *TransactionUpsertOne) SetDescription(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateDescription() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TransactionUpsertOne) ClearDescription() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetMerchantName sets the "merchant_name" field.
+func (u *TransactionUpsertOne) SetMerchantName(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetMerchantName(v)
+	})
+}
+
+// UpdateMerchantName sets the "merchant_name" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateMerchantName() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateMerchantName()
+	})
+}
+
+// ClearMerchantName clears the value of the "merchant_name" field.
+func (u *TransactionUpsertOne) ClearMerchantName() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearMerchantName()
+	})
+}
+
+// SetMerchantCategory sets the "merchant_category" field.
+func (u *TransactionUpsertOne) SetMerchantCategory(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetMerchantCategory(v)
+	})
+}
+
+// UpdateMerchantCategory sets the "merchant_category" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateMerchantCategory() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateMerchantCategory()
+	})
+}
+
+// ClearMerchantCategory clears the value of the "merchant_category" field.
+func (u *TransactionUpsertOne) ClearMerchantCategory() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearMerchantCategory()
+	})
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (u *TransactionUpsertOne) SetPaymentMethod(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetPaymentMethod(v)
+	})
+}
+
+// UpdatePaymentMethod sets the "payment_method" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdatePaymentMethod() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdatePaymentMethod()
+	})
+}
+
+// ClearPaymentMethod clears the value of the "payment_method" field.
+func (u *TransactionUpsertOne) ClearPaymentMethod() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearPaymentMethod()
+	})
+}
+
+// SetCardLastFour sets the "card_last_four" field.
+func (u *TransactionUpsertOne) SetCardLastFour(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetCardLastFour(v)
+	})
+}
+
+// UpdateCardLastFour sets the "card_last_four" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateCardLastFour() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateCardLastFour()
+	})
+}
+
+// ClearCardLastFour clears the value of the "card_last_four" field.
+func (u *TransactionUpsertOne) ClearCardLastFour() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearCardLastFour()
+	})
+}
+
+// SetReferenceNumber sets the "reference_number" field.
+func (u *TransactionUpsertOne) SetReferenceNumber(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetReferenceNumber(v)
+	})
+}
+
+// UpdateReferenceNumber sets the "reference_number" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateReferenceNumber() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateReferenceNumber()
+	})
+}
+
+// ClearReferenceNumber clears the value of the "reference_number" field.
+func (u *TransactionUpsertOne) ClearReferenceNumber() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearReferenceNumber()
+	})
+}
+
+// SetAuthorizationCode sets the "authorization_code" field.
+func (u *TransactionUpsertOne) SetAuthorizationCode(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetAuthorizationCode(v)
+	})
+}
+
+// UpdateAuthorizationCode sets the "authorization_code" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateAuthorizationCode() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateAuthorizationCode()
+	})
+}
+
+// ClearAuthorizationCode clears the value of the "authorization_code" field.
+func (u *TransactionUpsertOne) ClearAuthorizationCode() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearAuthorizationCode()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TransactionUpsertOne) SetStatus(v transaction.Status) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateStatus() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRoundUpAmount sets the "round_up_amount" field.
+func (u *TransactionUpsertOne) SetRoundUpAmount(v float64) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetRoundUpAmount(v)
+	})
+}
+
+// AddRoundUpAmount adds v to the "round_up_amount" field.
+func (u *TransactionUpsertOne) AddRoundUpAmount(v float64) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddRoundUpAmount(v)
+	})
+}
+
+// UpdateRoundUpAmount sets the "round_up_amount" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateRoundUpAmount() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateRoundUpAmount()
+	})
+}
+
+// SetIsRecurring sets the "is_recurring" field.
+func (u *TransactionUpsertOne) SetIsRecurring(v bool) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetIsRecurring(v)
+	})
+}
+
+// UpdateIsRecurring sets the "is_recurring" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateIsRecurring() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateIsRecurring()
+	})
+}
+
+// SetRecurrencePattern sets the "recurrence_pattern" field.
+func (u *TransactionUpsertOne) SetRecurrencePattern(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetRecurrencePattern(v)
+	})
+}
+
+// UpdateRecurrencePattern sets the "recurrence_pattern" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateRecurrencePattern() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateRecurrencePattern()
+	})
+}
+
+// ClearRecurrencePattern clears the value of the "recurrence_pattern" field.
+func (u *TransactionUpsertOne) ClearRecurrencePattern() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearRecurrencePattern()
+	})
+}
+
+// SetCategoryTags sets the "category_tags" field.
+func (u *TransactionUpsertOne) SetCategoryTags(v []string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetCategoryTags(v)
+	})
+}
+
+// UpdateCategoryTags sets the "category_tags" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateCategoryTags() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateCategoryTags()
+	})
+}
+
+// ClearCategoryTags clears the value of the "category_tags" field.
+func (u *TransactionUpsertOne) ClearCategoryTags() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearCategoryTags()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *TransactionUpsertOne) SetMetadata(v map[string]interface{}) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateMetadata() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *TransactionUpsertOne) ClearMetadata() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *TransactionUpsertOne) SetNotes(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateNotes() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *TransactionUpsertOne) ClearNotes() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// SetMemberID sets the "member_id" field.
+func (u *TransactionUpsertOne) SetMemberID(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetMemberID(v)
+	})
+}
+
+// UpdateMemberID sets the "member_id" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateMemberID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateMemberID()
+	})
+}
+
+// ClearMemberID clears the value of the "member_id" field.
+func (u *TransactionUpsertOne) ClearMemberID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearMemberID()
+	})
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (u *TransactionUpsertOne) SetMerchantID(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetMerchantID(v)
+	})
+}
+
+// UpdateMerchantID sets the "merchant_id" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateMerchantID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateMerchantID()
+	})
+}
+
+// ClearMerchantID clears the value of the "merchant_id" field.
+func (u *TransactionUpsertOne) ClearMerchantID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearMerchantID()
+	})
+}
+
+// SetLegacyID sets the "legacy_id" field.
+func (u *TransactionUpsertOne) SetLegacyID(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetLegacyID(v)
+	})
+}
+
+// UpdateLegacyID sets the "legacy_id" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateLegacyID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateLegacyID()
+	})
+}
+
+// ClearLegacyID clears the value of the "legacy_id" field.
+func (u *TransactionUpsertOne) ClearLegacyID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearLegacyID()
+	})
+}
+
+// SetMigrationRunID sets the "migration_run_id" field.
+func (u *TransactionUpsertOne) SetMigrationRunID(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetMigrationRunID(v)
+	})
+}
+
+// UpdateMigrationRunID sets the "migration_run_id" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateMigrationRunID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateMigrationRunID()
+	})
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (u *TransactionUpsertOne) ClearMigrationRunID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearMigrationRunID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TransactionUpsertOne) SetUpdatedAt(v time.Time) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateUpdatedAt() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TransactionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TransactionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TransactionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TransactionUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TransactionUpsertOne.ID is not supported by MySQL driver. Use TransactionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TransactionUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TransactionCreateBulk is the builder for creating many Transaction entities in bulk.
+type TransactionCreateBulk struct {
+	config
+	err      error
+	builders []*TransactionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Transaction entities in the database.
+func (_c *TransactionCreateBulk) Save(ctx context.Context) ([]*Transaction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Transaction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TransactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TransactionCreateBulk) SaveX(ctx context.Context) []*Transaction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TransactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TransactionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Transaction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TransactionUpsert) {
+//			SetReceiptID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TransactionCreateBulk) OnConflict(opts ...sql.ConflictOption) *TransactionUpsertBulk {
+	_c.conflict = opts
+	return &TransactionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TransactionCreateBulk) OnConflictColumns(columns ...string) *TransactionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TransactionUpsertBulk{
+		create: _c,
+	}
+}
+
+// TransactionUpsertBulk is the builder for "upsert"-ing
+// a bulk of Transaction nodes.
+type TransactionUpsertBulk struct {
+	create *TransactionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(transaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TransactionUpsertBulk) UpdateNewValues() *TransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(transaction.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(transaction.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TransactionUpsertBulk) Ignore() *TransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TransactionUpsertBulk) DoNothing() *TransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TransactionCreateBulk.OnConflict
+// documentation for more info.
+func (u *TransactionUpsertBulk) Update(set func(*TransactionUpsert)) *TransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *TransactionUpsertBulk) SetReceiptID(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetReceiptID(v)
+	})
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateReceiptID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateReceiptID()
+	})
+}
+
+// ClearReceiptID clears the value of the "receipt_id" field.
+func (u *TransactionUpsertBulk) ClearReceiptID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearReceiptID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *TransactionUpsertBulk) SetUserID(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateUserID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *TransactionUpsertBulk) SetSource(v transaction.Source) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateSource() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *TransactionUpsertBulk) SetType(v transaction.Type) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateType() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *TransactionUpsertBulk) SetAmount(v float64) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *TransactionUpsertBulk) AddAmount(v float64) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateAmount() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *TransactionUpsertBulk) SetCurrency(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateCurrency() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetTransactionDate sets the "transaction_date" field.
+func (u *TransactionUpsertBulk) SetTransactionDate(v time.Time) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetTransactionDate(v)
+	})
+}
+
+// UpdateTransactionDate sets the "transaction_date" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateTransactionDate() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateTransactionDate()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *TransactionUpsertBulk) SetDescription(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateDescription() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TransactionUpsertBulk) ClearDescription() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetMerchantName sets the "merchant_name" field.
+func (u *TransactionUpsertBulk) SetMerchantName(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetMerchantName(v)
+	})
+}
+
+// UpdateMerchantName sets the "merchant_name" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateMerchantName() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateMerchantName()
+	})
+}
+
+// ClearMerchantName clears the value of the "merchant_name" field.
+func (u *TransactionUpsertBulk) ClearMerchantName() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearMerchantName()
+	})
+}
+
+// SetMerchantCategory sets the "merchant_category" field.
+func (u *TransactionUpsertBulk) SetMerchantCategory(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetMerchantCategory(v)
+	})
+}
+
+// UpdateMerchantCategory sets the "merchant_category" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateMerchantCategory() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateMerchantCategory()
+	})
+}
+
+// ClearMerchantCategory clears the value of the "merchant_category" field.
+func (u *TransactionUpsertBulk) ClearMerchantCategory() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearMerchantCategory()
+	})
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (u *TransactionUpsertBulk) SetPaymentMethod(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetPaymentMethod(v)
+	})
+}
+
+// UpdatePaymentMethod sets the "payment_method" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdatePaymentMethod() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdatePaymentMethod()
+	})
+}
+
+// ClearPaymentMethod clears the value of the "payment_method" field.
+func (u *TransactionUpsertBulk) ClearPaymentMethod() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearPaymentMethod()
+	})
+}
+
+// SetCardLastFour sets the "card_last_four" field.
+func (u *TransactionUpsertBulk) SetCardLastFour(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetCardLastFour(v)
+	})
+}
+
+// UpdateCardLastFour sets the "card_last_four" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateCardLastFour() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateCardLastFour()
+	})
+}
+
+// ClearCardLastFour clears the value of the "card_last_four" field.
+func (u *TransactionUpsertBulk) ClearCardLastFour() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearCardLastFour()
+	})
+}
+
+// SetReferenceNumber sets the "reference_number" field.
+func (u *TransactionUpsertBulk) SetReferenceNumber(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetReferenceNumber(v)
+	})
+}
+
+// UpdateReferenceNumber sets the "reference_number" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateReferenceNumber() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateReferenceNumber()
+	})
+}
+
+// ClearReferenceNumber clears the value of the "reference_number" field.
+func (u *TransactionUpsertBulk) ClearReferenceNumber() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearReferenceNumber()
+	})
+}
+
+// SetAuthorizationCode sets the "authorization_code" field.
+func (u *TransactionUpsertBulk) SetAuthorizationCode(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetAuthorizationCode(v)
+	})
+}
+
+// UpdateAuthorizationCode sets the "authorization_code" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateAuthorizationCode() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateAuthorizationCode()
+	})
+}
+
+// ClearAuthorizationCode clears the value of the "authorization_code" field.
+func (u *TransactionUpsertBulk) ClearAuthorizationCode() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearAuthorizationCode()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TransactionUpsertBulk) SetStatus(v transaction.Status) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateStatus() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRoundUpAmount sets the "round_up_amount" field.
+func (u *TransactionUpsertBulk) SetRoundUpAmount(v float64) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetRoundUpAmount(v)
+	})
+}
+
+// AddRoundUpAmount adds v to the "round_up_amount" field.
+func (u *TransactionUpsertBulk) AddRoundUpAmount(v float64) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddRoundUpAmount(v)
+	})
+}
+
+// UpdateRoundUpAmount sets the "round_up_amount" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateRoundUpAmount() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateRoundUpAmount()
+	})
+}
+
+// SetIsRecurring sets the "is_recurring" field.
+func (u *TransactionUpsertBulk) SetIsRecurring(v bool) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetIsRecurring(v)
+	})
+}
+
+// UpdateIsRecurring sets the "is_recurring" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateIsRecurring() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateIsRecurring()
+	})
+}
+
+// SetRecurrencePattern sets the "recurrence_pattern" field.
+func (u *TransactionUpsertBulk) SetRecurrencePattern(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetRecurrencePattern(v)
+	})
+}
+
+// UpdateRecurrencePattern sets the "recurrence_pattern" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateRecurrencePattern() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateRecurrencePattern()
+	})
+}
+
+// ClearRecurrencePattern clears the value of the "recurrence_pattern" field.
+func (u *TransactionUpsertBulk) ClearRecurrencePattern() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearRecurrencePattern()
+	})
+}
+
+// SetCategoryTags sets the "category_tags" field.
+func (u *TransactionUpsertBulk) SetCategoryTags(v []string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetCategoryTags(v)
+	})
+}
+
+// UpdateCategoryTags sets the "category_tags" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateCategoryTags() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateCategoryTags()
+	})
+}
+
+// ClearCategoryTags clears the value of the "category_tags" field.
+func (u *TransactionUpsertBulk) ClearCategoryTags() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearCategoryTags()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *TransactionUpsertBulk) SetMetadata(v map[string]interface{}) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateMetadata() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *TransactionUpsertBulk) ClearMetadata() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *TransactionUpsertBulk) SetNotes(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateNotes() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *TransactionUpsertBulk) ClearNotes() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// SetMemberID sets the "member_id" field.
+func (u *TransactionUpsertBulk) SetMemberID(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetMemberID(v)
+	})
+}
+
+// UpdateMemberID sets the "member_id" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateMemberID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateMemberID()
+	})
+}
+
+// ClearMemberID clears the value of the "member_id" field.
+func (u *TransactionUpsertBulk) ClearMemberID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearMemberID()
+	})
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (u *TransactionUpsertBulk) SetMerchantID(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetMerchantID(v)
+	})
+}
+
+// UpdateMerchantID sets the "merchant_id" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateMerchantID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateMerchantID()
+	})
+}
+
+// ClearMerchantID clears the value of the "merchant_id" field.
+func (u *TransactionUpsertBulk) ClearMerchantID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearMerchantID()
+	})
+}
+
+// SetLegacyID sets the "legacy_id" field.
+func (u *TransactionUpsertBulk) SetLegacyID(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetLegacyID(v)
+	})
+}
+
+// UpdateLegacyID sets the "legacy_id" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateLegacyID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateLegacyID()
+	})
+}
+
+// ClearLegacyID clears the value of the "legacy_id" field.
+func (u *TransactionUpsertBulk) ClearLegacyID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearLegacyID()
+	})
+}
+
+// SetMigrationRunID sets the "migration_run_id" field.
+func (u *TransactionUpsertBulk) SetMigrationRunID(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetMigrationRunID(v)
+	})
+}
+
+// UpdateMigrationRunID sets the "migration_run_id" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateMigrationRunID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateMigrationRunID()
+	})
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (u *TransactionUpsertBulk) ClearMigrationRunID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearMigrationRunID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TransactionUpsertBulk) SetUpdatedAt(v time.Time) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateUpdatedAt() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TransactionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TransactionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TransactionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TransactionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"clockzen-next/internal/ent/merchant"
 	"clockzen-next/internal/ent/predicate"
 	"clockzen-next/internal/ent/receipt"
 	"clockzen-next/internal/ent/transaction"
@@ -19,11 +20,12 @@ import (
 // TransactionQuery is the builder for querying Transaction entities.
 type TransactionQuery struct {
 	config
-	ctx         *QueryContext
-	order       []transaction.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Transaction
-	withReceipt *ReceiptQuery
+	ctx          *QueryContext
+	order        []transaction.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.Transaction
+	withReceipt  *ReceiptQuery
+	withMerchant *MerchantQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -82,6 +84,28 @@ func (_q *TransactionQuery) QueryReceipt() *ReceiptQuery {
 	return query
 }
 
+// QueryMerchant chains the current query on the "merchant" edge.
+func (_q *TransactionQuery) QueryMerchant() *MerchantQuery {
+	query := (&MerchantClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(merchant.Table, merchant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transaction.MerchantTable, transaction.MerchantColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Transaction entity from the query.
 // Returns a *NotFoundError when no Transaction was found.
 func (_q *TransactionQuery) First(ctx context.Context) (*Transaction, error) {
@@ -269,12 +293,13 @@ func (_q *TransactionQuery) Clone() *TransactionQuery {
 		return nil
 	}
 	return &TransactionQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]transaction.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.Transaction{}, _q.predicates...),
-		withReceipt: _q.withReceipt.Clone(),
+		config:       _q.config,
+		ctx:          _q.ctx.Clone(),
+		order:        append([]transaction.OrderOption{}, _q.order...),
+		inters:       append([]Interceptor{}, _q.inters...),
+		predicates:   append([]predicate.Transaction{}, _q.predicates...),
+		withReceipt:  _q.withReceipt.Clone(),
+		withMerchant: _q.withMerchant.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -292,6 +317,17 @@ func (_q *TransactionQuery) WithReceipt(opts ...func(*ReceiptQuery)) *Transactio
 	return _q
 }
 
+// WithMerchant tells the query-builder to eager-load the nodes that are connected to
+// the "merchant" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TransactionQuery) WithMerchant(opts ...func(*MerchantQuery)) *TransactionQuery {
+	query := (&MerchantClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMerchant = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -370,8 +406,9 @@ func (_q *TransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	var (
 		nodes       = []*Transaction{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withReceipt != nil,
+			_q.withMerchant != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -398,6 +435,12 @@ func (_q *TransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := _q.withMerchant; query != nil {
+		if err := _q.loadMerchant(ctx, query, nodes, nil,
+			func(n *Transaction, e *Merchant) { n.Edges.Merchant = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -430,6 +473,38 @@ func (_q *TransactionQuery) loadReceipt(ctx context.Context, query *ReceiptQuery
 	}
 	return nil
 }
+func (_q *TransactionQuery) loadMerchant(ctx context.Context, query *MerchantQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *Merchant)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Transaction)
+	for i := range nodes {
+		if nodes[i].MerchantID == nil {
+			continue
+		}
+		fk := *nodes[i].MerchantID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(merchant.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "merchant_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *TransactionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -459,6 +534,9 @@ func (_q *TransactionQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withReceipt != nil {
 			_spec.Node.AddColumnOnce(transaction.FieldReceiptID)
 		}
+		if _q.withMerchant != nil {
+			_spec.Node.AddColumnOnce(transaction.FieldMerchantID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"clockzen-next/internal/ent/merchant"
 	"clockzen-next/internal/ent/predicate"
 	"clockzen-next/internal/ent/receipt"
 	"clockzen-next/internal/ent/transaction"
@@ -44,6 +45,12 @@ func (_u *TransactionUpdate) SetNillableReceiptID(v *string) *TransactionUpdate
 	return _u
 }
 
+// ClearReceiptID clears the value of the "receipt_id" field.
+func (_u *TransactionUpdate) ClearReceiptID() *TransactionUpdate {
+	_u.mutation.ClearReceiptID()
+	return _u
+}
+
 // SetUserID sets the "user_id" field.
 func (_u *TransactionUpdate) SetUserID(v string) *TransactionUpdate {
 	_u.mutation.SetUserID(v)
@@ -58,6 +65,20 @@ func (_u *TransactionUpdate) SetNillableUserID(v *string) *TransactionUpdate {
 	return _u
 }
 
+// SetSource sets the "source" field.
+func (_u *TransactionUpdate) SetSource(v transaction.Source) *TransactionUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableSource(v *transaction.Source) *TransactionUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
 // SetType sets the "type" field.
 func (_u *TransactionUpdate) SetType(v transaction.Type) *TransactionUpdate {
 	_u.mutation.SetType(v)
@@ -275,6 +296,27 @@ func (_u *TransactionUpdate) SetNillableStatus(v *transaction.Status) *Transacti
 	return _u
 }
 
+// SetRoundUpAmount sets the "round_up_amount" field.
+func (_u *TransactionUpdate) SetRoundUpAmount(v float64) *TransactionUpdate {
+	_u.mutation.ResetRoundUpAmount()
+	_u.mutation.SetRoundUpAmount(v)
+	return _u
+}
+
+// SetNillableRoundUpAmount sets the "round_up_amount" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableRoundUpAmount(v *float64) *TransactionUpdate {
+	if v != nil {
+		_u.SetRoundUpAmount(*v)
+	}
+	return _u
+}
+
+// AddRoundUpAmount adds value to the "round_up_amount" field.
+func (_u *TransactionUpdate) AddRoundUpAmount(v float64) *TransactionUpdate {
+	_u.mutation.AddRoundUpAmount(v)
+	return _u
+}
+
 // SetIsRecurring sets the "is_recurring" field.
 func (_u *TransactionUpdate) SetIsRecurring(v bool) *TransactionUpdate {
 	_u.mutation.SetIsRecurring(v)
@@ -359,6 +401,46 @@ func (_u *TransactionUpdate) ClearNotes() *TransactionUpdate {
 	return _u
 }
 
+// SetMemberID sets the "member_id" field.
+func (_u *TransactionUpdate) SetMemberID(v string) *TransactionUpdate {
+	_u.mutation.SetMemberID(v)
+	return _u
+}
+
+// SetNillableMemberID sets the "member_id" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableMemberID(v *string) *TransactionUpdate {
+	if v != nil {
+		_u.SetMemberID(*v)
+	}
+	return _u
+}
+
+// ClearMemberID clears the value of the "member_id" field.
+func (_u *TransactionUpdate) ClearMemberID() *TransactionUpdate {
+	_u.mutation.ClearMemberID()
+	return _u
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (_u *TransactionUpdate) SetMerchantID(v string) *TransactionUpdate {
+	_u.mutation.SetMerchantID(v)
+	return _u
+}
+
+// SetNillableMerchantID sets the "merchant_id" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableMerchantID(v *string) *TransactionUpdate {
+	if v != nil {
+		_u.SetMerchantID(*v)
+	}
+	return _u
+}
+
+// ClearMerchantID clears the value of the "merchant_id" field.
+func (_u *TransactionUpdate) ClearMerchantID() *TransactionUpdate {
+	_u.mutation.ClearMerchantID()
+	return _u
+}
+
 // SetLegacyID sets the "legacy_id" field.
 func (_u *TransactionUpdate) SetLegacyID(v string) *TransactionUpdate {
 	_u.mutation.SetLegacyID(v)
@@ -379,6 +461,26 @@ func (_u *TransactionUpdate) ClearLegacyID() *TransactionUpdate {
 	return _u
 }
 
+// SetMigrationRunID sets the "migration_run_id" field.
+func (_u *TransactionUpdate) SetMigrationRunID(v string) *TransactionUpdate {
+	_u.mutation.SetMigrationRunID(v)
+	return _u
+}
+
+// SetNillableMigrationRunID sets the "migration_run_id" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableMigrationRunID(v *string) *TransactionUpdate {
+	if v != nil {
+		_u.SetMigrationRunID(*v)
+	}
+	return _u
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (_u *TransactionUpdate) ClearMigrationRunID() *TransactionUpdate {
+	_u.mutation.ClearMigrationRunID()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *TransactionUpdate) SetUpdatedAt(v time.Time) *TransactionUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -390,6 +492,11 @@ func (_u *TransactionUpdate) SetReceipt(v *Receipt) *TransactionUpdate {
 	return _u.SetReceiptID(v.ID)
 }
 
+// SetMerchant sets the "merchant" edge to the Merchant entity.
+func (_u *TransactionUpdate) SetMerchant(v *Merchant) *TransactionUpdate {
+	return _u.SetMerchantID(v.ID)
+}
+
 // Mutation returns the TransactionMutation object of the builder.
 func (_u *TransactionUpdate) Mutation() *TransactionMutation {
 	return _u.mutation
@@ -401,6 +508,12 @@ func (_u *TransactionUpdate) ClearReceipt() *TransactionUpdate {
 	return _u
 }
 
+// ClearMerchant clears the "merchant" edge to the Merchant entity.
+func (_u *TransactionUpdate) ClearMerchant() *TransactionUpdate {
+	_u.mutation.ClearMerchant()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TransactionUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -439,16 +552,16 @@ func (_u *TransactionUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *TransactionUpdate) check() error {
-	if v, ok := _u.mutation.ReceiptID(); ok {
-		if err := transaction.ReceiptIDValidator(v); err != nil {
-			return &ValidationError{Name: "receipt_id", err: fmt.Errorf(`ent: validator failed for field "Transaction.receipt_id": %w`, err)}
-		}
-	}
 	if v, ok := _u.mutation.UserID(); ok {
 		if err := transaction.UserIDValidator(v); err != nil {
 			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Transaction.user_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := transaction.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Transaction.source": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.GetType(); ok {
 		if err := transaction.TypeValidator(v); err != nil {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Transaction.type": %w`, err)}
@@ -459,9 +572,6 @@ func (_u *TransactionUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Transaction.status": %w`, err)}
 		}
 	}
-	if _u.mutation.ReceiptCleared() && len(_u.mutation.ReceiptIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Transaction.receipt"`)
-	}
 	return nil
 }
 
@@ -480,6 +590,9 @@ func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.UserID(); ok {
 		_spec.SetField(transaction.FieldUserID, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(transaction.FieldSource, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.GetType(); ok {
 		_spec.SetField(transaction.FieldType, field.TypeEnum, value)
 	}
@@ -540,6 +653,12 @@ func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(transaction.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.RoundUpAmount(); ok {
+		_spec.SetField(transaction.FieldRoundUpAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRoundUpAmount(); ok {
+		_spec.AddField(transaction.FieldRoundUpAmount, field.TypeFloat64, value)
+	}
 	if value, ok := _u.mutation.IsRecurring(); ok {
 		_spec.SetField(transaction.FieldIsRecurring, field.TypeBool, value)
 	}
@@ -572,12 +691,24 @@ func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.NotesCleared() {
 		_spec.ClearField(transaction.FieldNotes, field.TypeString)
 	}
+	if value, ok := _u.mutation.MemberID(); ok {
+		_spec.SetField(transaction.FieldMemberID, field.TypeString, value)
+	}
+	if _u.mutation.MemberIDCleared() {
+		_spec.ClearField(transaction.FieldMemberID, field.TypeString)
+	}
 	if value, ok := _u.mutation.LegacyID(); ok {
 		_spec.SetField(transaction.FieldLegacyID, field.TypeString, value)
 	}
 	if _u.mutation.LegacyIDCleared() {
 		_spec.ClearField(transaction.FieldLegacyID, field.TypeString)
 	}
+	if value, ok := _u.mutation.MigrationRunID(); ok {
+		_spec.SetField(transaction.FieldMigrationRunID, field.TypeString, value)
+	}
+	if _u.mutation.MigrationRunIDCleared() {
+		_spec.ClearField(transaction.FieldMigrationRunID, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(transaction.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -610,6 +741,35 @@ func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.MerchantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.MerchantTable,
+			Columns: []string{transaction.MerchantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MerchantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.MerchantTable,
+			Columns: []string{transaction.MerchantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{transaction.Label}
@@ -644,6 +804,12 @@ func (_u *TransactionUpdateOne) SetNillableReceiptID(v *string) *TransactionUpda
 	return _u
 }
 
+// ClearReceiptID clears the value of the "receipt_id" field.
+func (_u *TransactionUpdateOne) ClearReceiptID() *TransactionUpdateOne {
+	_u.mutation.ClearReceiptID()
+	return _u
+}
+
 // SetUserID sets the "user_id" field.
 func (_u *TransactionUpdateOne) SetUserID(v string) *TransactionUpdateOne {
 	_u.mutation.SetUserID(v)
@@ -658,6 +824,20 @@ func (_u *TransactionUpdateOne) SetNillableUserID(v *string) *TransactionUpdateO
 	return _u
 }
 
+// SetSource sets the "source" field.
+func (_u *TransactionUpdateOne) SetSource(v transaction.Source) *TransactionUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableSource(v *transaction.Source) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
 // SetType sets the "type" field.
 func (_u *TransactionUpdateOne) SetType(v transaction.Type) *TransactionUpdateOne {
 	_u.mutation.SetType(v)
@@ -875,6 +1055,27 @@ func (_u *TransactionUpdateOne) SetNillableStatus(v *transaction.Status) *Transa
 	return _u
 }
 
+// SetRoundUpAmount sets the "round_up_amount" field.
+func (_u *TransactionUpdateOne) SetRoundUpAmount(v float64) *TransactionUpdateOne {
+	_u.mutation.ResetRoundUpAmount()
+	_u.mutation.SetRoundUpAmount(v)
+	return _u
+}
+
+// SetNillableRoundUpAmount sets the "round_up_amount" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableRoundUpAmount(v *float64) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetRoundUpAmount(*v)
+	}
+	return _u
+}
+
+// AddRoundUpAmount adds value to the "round_up_amount" field.
+func (_u *TransactionUpdateOne) AddRoundUpAmount(v float64) *TransactionUpdateOne {
+	_u.mutation.AddRoundUpAmount(v)
+	return _u
+}
+
 // SetIsRecurring sets the "is_recurring" field.
 func (_u *TransactionUpdateOne) SetIsRecurring(v bool) *TransactionUpdateOne {
 	_u.mutation.SetIsRecurring(v)
@@ -959,6 +1160,46 @@ func (_u *TransactionUpdateOne) ClearNotes() *TransactionUpdateOne {
 	return _u
 }
 
+// SetMemberID sets the "member_id" field.
+func (_u *TransactionUpdateOne) SetMemberID(v string) *TransactionUpdateOne {
+	_u.mutation.SetMemberID(v)
+	return _u
+}
+
+// SetNillableMemberID sets the "member_id" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableMemberID(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetMemberID(*v)
+	}
+	return _u
+}
+
+// ClearMemberID clears the value of the "member_id" field.
+func (_u *TransactionUpdateOne) ClearMemberID() *TransactionUpdateOne {
+	_u.mutation.ClearMemberID()
+	return _u
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (_u *TransactionUpdateOne) SetMerchantID(v string) *TransactionUpdateOne {
+	_u.mutation.SetMerchantID(v)
+	return _u
+}
+
+// SetNillableMerchantID sets the "merchant_id" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableMerchantID(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetMerchantID(*v)
+	}
+	return _u
+}
+
+// ClearMerchantID clears the value of the "merchant_id" field.
+func (_u *TransactionUpdateOne) ClearMerchantID() *TransactionUpdateOne {
+	_u.mutation.ClearMerchantID()
+	return _u
+}
+
 // SetLegacyID sets the "legacy_id" field.
 func (_u *TransactionUpdateOne) SetLegacyID(v string) *TransactionUpdateOne {
 	_u.mutation.SetLegacyID(v)
@@ -979,6 +1220,26 @@ func (_u *TransactionUpdateOne) ClearLegacyID() *TransactionUpdateOne {
 	return _u
 }
 
+// SetMigrationRunID sets the "migration_run_id" field.
+func (_u *TransactionUpdateOne) SetMigrationRunID(v string) *TransactionUpdateOne {
+	_u.mutation.SetMigrationRunID(v)
+	return _u
+}
+
+// SetNillableMigrationRunID sets the "migration_run_id" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableMigrationRunID(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetMigrationRunID(*v)
+	}
+	return _u
+}
+
+// ClearMigrationRunID clears the value of the "migration_run_id" field.
+func (_u *TransactionUpdateOne) ClearMigrationRunID() *TransactionUpdateOne {
+	_u.mutation.ClearMigrationRunID()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *TransactionUpdateOne) SetUpdatedAt(v time.Time) *TransactionUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -990,6 +1251,11 @@ func (_u *TransactionUpdateOne) SetReceipt(v *Receipt) *TransactionUpdateOne {
 	return _u.SetReceiptID(v.ID)
 }
 
+// SetMerchant sets the "merchant" edge to the Merchant entity.
+func (_u *TransactionUpdateOne) SetMerchant(v *Merchant) *TransactionUpdateOne {
+	return _u.SetMerchantID(v.ID)
+}
+
 // Mutation returns the TransactionMutation object of the builder.
 func (_u *TransactionUpdateOne) Mutation() *TransactionMutation {
 	return _u.mutation
@@ -1001,6 +1267,12 @@ func (_u *TransactionUpdateOne) ClearReceipt() *TransactionUpdateOne {
 	return _u
 }
 
+// ClearMerchant clears the "merchant" edge to the Merchant entity.
+func (_u *TransactionUpdateOne) ClearMerchant() *TransactionUpdateOne {
+	_u.mutation.ClearMerchant()
+	return _u
+}
+
 // Where appends a list predicates to the TransactionUpdate builder.
 func (_u *TransactionUpdateOne) Where(ps ...predicate.Transaction) *TransactionUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1052,16 +1324,16 @@ func (_u *TransactionUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *TransactionUpdateOne) check() error {
-	if v, ok := _u.mutation.ReceiptID(); ok {
-		if err := transaction.ReceiptIDValidator(v); err != nil {
-			return &ValidationError{Name: "receipt_id", err: fmt.Errorf(`ent: validator failed for field "Transaction.receipt_id": %w`, err)}
-		}
-	}
 	if v, ok := _u.mutation.UserID(); ok {
 		if err := transaction.UserIDValidator(v); err != nil {
 			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Transaction.user_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := transaction.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Transaction.source": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.GetType(); ok {
 		if err := transaction.TypeValidator(v); err != nil {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Transaction.type": %w`, err)}
@@ -1072,9 +1344,6 @@ func (_u *TransactionUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Transaction.status": %w`, err)}
 		}
 	}
-	if _u.mutation.ReceiptCleared() && len(_u.mutation.ReceiptIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Transaction.receipt"`)
-	}
 	return nil
 }
 
@@ -1110,6 +1379,9 @@ func (_u *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transaction
 	if value, ok := _u.mutation.UserID(); ok {
 		_spec.SetField(transaction.FieldUserID, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(transaction.FieldSource, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.GetType(); ok {
 		_spec.SetField(transaction.FieldType, field.TypeEnum, value)
 	}
@@ -1170,6 +1442,12 @@ func (_u *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transaction
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(transaction.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.RoundUpAmount(); ok {
+		_spec.SetField(transaction.FieldRoundUpAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRoundUpAmount(); ok {
+		_spec.AddField(transaction.FieldRoundUpAmount, field.TypeFloat64, value)
+	}
 	if value, ok := _u.mutation.IsRecurring(); ok {
 		_spec.SetField(transaction.FieldIsRecurring, field.TypeBool, value)
 	}
@@ -1202,12 +1480,24 @@ func (_u *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transaction
 	if _u.mutation.NotesCleared() {
 		_spec.ClearField(transaction.FieldNotes, field.TypeString)
 	}
+	if value, ok := _u.mutation.MemberID(); ok {
+		_spec.SetField(transaction.FieldMemberID, field.TypeString, value)
+	}
+	if _u.mutation.MemberIDCleared() {
+		_spec.ClearField(transaction.FieldMemberID, field.TypeString)
+	}
 	if value, ok := _u.mutation.LegacyID(); ok {
 		_spec.SetField(transaction.FieldLegacyID, field.TypeString, value)
 	}
 	if _u.mutation.LegacyIDCleared() {
 		_spec.ClearField(transaction.FieldLegacyID, field.TypeString)
 	}
+	if value, ok := _u.mutation.MigrationRunID(); ok {
+		_spec.SetField(transaction.FieldMigrationRunID, field.TypeString, value)
+	}
+	if _u.mutation.MigrationRunIDCleared() {
+		_spec.ClearField(transaction.FieldMigrationRunID, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(transaction.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -1240,6 +1530,35 @@ func (_u *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transaction
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.MerchantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.MerchantTable,
+			Columns: []string{transaction.MerchantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MerchantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.MerchantTable,
+			Columns: []string{transaction.MerchantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Transaction{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -12,30 +12,102 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AccountDeletion is the client for interacting with the AccountDeletion builders.
+	AccountDeletion *AccountDeletionClient
+	// Alert is the client for interacting with the Alert builders.
+	Alert *AlertClient
+	// AlertPreference is the client for interacting with the AlertPreference builders.
+	AlertPreference *AlertPreferenceClient
+	// AttachmentBlob is the client for interacting with the AttachmentBlob builders.
+	AttachmentBlob *AttachmentBlobClient
+	// AttachmentLink is the client for interacting with the AttachmentLink builders.
+	AttachmentLink *AttachmentLinkClient
+	// BudgetReallocation is the client for interacting with the BudgetReallocation builders.
+	BudgetReallocation *BudgetReallocationClient
+	// BulkOperation is the client for interacting with the BulkOperation builders.
+	BulkOperation *BulkOperationClient
+	// CardAccount is the client for interacting with the CardAccount builders.
+	CardAccount *CardAccountClient
+	// CategorizationRule is the client for interacting with the CategorizationRule builders.
+	CategorizationRule *CategorizationRuleClient
+	// CategoryFeedback is the client for interacting with the CategoryFeedback builders.
+	CategoryFeedback *CategoryFeedbackClient
+	// Debt is the client for interacting with the Debt builders.
+	Debt *DebtClient
 	// EmailConnection is the client for interacting with the EmailConnection builders.
 	EmailConnection *EmailConnectionClient
 	// EmailLabel is the client for interacting with the EmailLabel builders.
 	EmailLabel *EmailLabelClient
+	// EmailMessage is the client for interacting with the EmailMessage builders.
+	EmailMessage *EmailMessageClient
 	// EmailSync is the client for interacting with the EmailSync builders.
 	EmailSync *EmailSyncClient
+	// EmailSyncFailure is the client for interacting with the EmailSyncFailure builders.
+	EmailSyncFailure *EmailSyncFailureClient
+	// EmergencyFundSnapshot is the client for interacting with the EmergencyFundSnapshot builders.
+	EmergencyFundSnapshot *EmergencyFundSnapshotClient
+	// EmergencyFundTarget is the client for interacting with the EmergencyFundTarget builders.
+	EmergencyFundTarget *EmergencyFundTargetClient
+	// Goal is the client for interacting with the Goal builders.
+	Goal *GoalClient
 	// GoogleDriveConnection is the client for interacting with the GoogleDriveConnection builders.
 	GoogleDriveConnection *GoogleDriveConnectionClient
 	// GoogleDriveFolder is the client for interacting with the GoogleDriveFolder builders.
 	GoogleDriveFolder *GoogleDriveFolderClient
 	// GoogleDriveSync is the client for interacting with the GoogleDriveSync builders.
 	GoogleDriveSync *GoogleDriveSyncClient
+	// HouseholdMember is the client for interacting with the HouseholdMember builders.
+	HouseholdMember *HouseholdMemberClient
+	// JobQueue is the client for interacting with the JobQueue builders.
+	JobQueue *JobQueueClient
+	// JobSchedule is the client for interacting with the JobSchedule builders.
+	JobSchedule *JobScheduleClient
+	// JobScheduleRun is the client for interacting with the JobScheduleRun builders.
+	JobScheduleRun *JobScheduleRunClient
 	// LineItem is the client for interacting with the LineItem builders.
 	LineItem *LineItemClient
+	// LiquidAccount is the client for interacting with the LiquidAccount builders.
+	LiquidAccount *LiquidAccountClient
+	// Membership is the client for interacting with the Membership builders.
+	Membership *MembershipClient
+	// Merchant is the client for interacting with the Merchant builders.
+	Merchant *MerchantClient
+	// MigrationState is the client for interacting with the MigrationState builders.
+	MigrationState *MigrationStateClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// OAuthState is the client for interacting with the OAuthState builders.
+	OAuthState *OAuthStateClient
+	// OCRFeedback is the client for interacting with the OCRFeedback builders.
+	OCRFeedback *OCRFeedbackClient
+	// Organization is the client for interacting with the Organization builders.
+	Organization *OrganizationClient
 	// PipelineConfig is the client for interacting with the PipelineConfig builders.
 	PipelineConfig *PipelineConfigClient
 	// PipelineRule is the client for interacting with the PipelineRule builders.
 	PipelineRule *PipelineRuleClient
 	// PipelineVersion is the client for interacting with the PipelineVersion builders.
 	PipelineVersion *PipelineVersionClient
+	// QueuedJob is the client for interacting with the QueuedJob builders.
+	QueuedJob *QueuedJobClient
 	// Receipt is the client for interacting with the Receipt builders.
 	Receipt *ReceiptClient
+	// ReceiptEvent is the client for interacting with the ReceiptEvent builders.
+	ReceiptEvent *ReceiptEventClient
+	// RoundingRule is the client for interacting with the RoundingRule builders.
+	RoundingRule *RoundingRuleClient
+	// SavedFilter is the client for interacting with the SavedFilter builders.
+	SavedFilter *SavedFilterClient
+	// TaxYear is the client for interacting with the TaxYear builders.
+	TaxYear *TaxYearClient
 	// Transaction is the client for interacting with the Transaction builders.
 	Transaction *TransactionClient
+	// User is the client for interacting with the User builders.
+	User *UserClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookEndpoint is the client for interacting with the WebhookEndpoint builders.
+	WebhookEndpoint *WebhookEndpointClient
 
 	// lazily loaded.
 	client     *Client
@@ -167,18 +239,54 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AccountDeletion = NewAccountDeletionClient(tx.config)
+	tx.Alert = NewAlertClient(tx.config)
+	tx.AlertPreference = NewAlertPreferenceClient(tx.config)
+	tx.AttachmentBlob = NewAttachmentBlobClient(tx.config)
+	tx.AttachmentLink = NewAttachmentLinkClient(tx.config)
+	tx.BudgetReallocation = NewBudgetReallocationClient(tx.config)
+	tx.BulkOperation = NewBulkOperationClient(tx.config)
+	tx.CardAccount = NewCardAccountClient(tx.config)
+	tx.CategorizationRule = NewCategorizationRuleClient(tx.config)
+	tx.CategoryFeedback = NewCategoryFeedbackClient(tx.config)
+	tx.Debt = NewDebtClient(tx.config)
 	tx.EmailConnection = NewEmailConnectionClient(tx.config)
 	tx.EmailLabel = NewEmailLabelClient(tx.config)
+	tx.EmailMessage = NewEmailMessageClient(tx.config)
 	tx.EmailSync = NewEmailSyncClient(tx.config)
+	tx.EmailSyncFailure = NewEmailSyncFailureClient(tx.config)
+	tx.EmergencyFundSnapshot = NewEmergencyFundSnapshotClient(tx.config)
+	tx.EmergencyFundTarget = NewEmergencyFundTargetClient(tx.config)
+	tx.Goal = NewGoalClient(tx.config)
 	tx.GoogleDriveConnection = NewGoogleDriveConnectionClient(tx.config)
 	tx.GoogleDriveFolder = NewGoogleDriveFolderClient(tx.config)
 	tx.GoogleDriveSync = NewGoogleDriveSyncClient(tx.config)
+	tx.HouseholdMember = NewHouseholdMemberClient(tx.config)
+	tx.JobQueue = NewJobQueueClient(tx.config)
+	tx.JobSchedule = NewJobScheduleClient(tx.config)
+	tx.JobScheduleRun = NewJobScheduleRunClient(tx.config)
 	tx.LineItem = NewLineItemClient(tx.config)
+	tx.LiquidAccount = NewLiquidAccountClient(tx.config)
+	tx.Membership = NewMembershipClient(tx.config)
+	tx.Merchant = NewMerchantClient(tx.config)
+	tx.MigrationState = NewMigrationStateClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
+	tx.OAuthState = NewOAuthStateClient(tx.config)
+	tx.OCRFeedback = NewOCRFeedbackClient(tx.config)
+	tx.Organization = NewOrganizationClient(tx.config)
 	tx.PipelineConfig = NewPipelineConfigClient(tx.config)
 	tx.PipelineRule = NewPipelineRuleClient(tx.config)
 	tx.PipelineVersion = NewPipelineVersionClient(tx.config)
+	tx.QueuedJob = NewQueuedJobClient(tx.config)
 	tx.Receipt = NewReceiptClient(tx.config)
+	tx.ReceiptEvent = NewReceiptEventClient(tx.config)
+	tx.RoundingRule = NewRoundingRuleClient(tx.config)
+	tx.SavedFilter = NewSavedFilterClient(tx.config)
+	tx.TaxYear = NewTaxYearClient(tx.config)
 	tx.Transaction = NewTransactionClient(tx.config)
+	tx.User = NewUserClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
+	tx.WebhookEndpoint = NewWebhookEndpointClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -188,7 +296,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: EmailConnection.QueryXXX(), the query will be executed
+// applies a query, for example: AccountDeletion.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
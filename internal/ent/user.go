@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// User is the model entity for the User schema.
+type User struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Lowercased email address the user signs in with; unset for users authenticated by tokens issued elsewhere
+	Email *string `json:"email,omitempty"`
+	// Whether the email address was verified, by Google for SSO users
+	EmailVerified bool `json:"email_verified,omitempty"`
+	// Lowercased email address the user changed theirs to, which replaces it once verified
+	PendingEmail *string `json:"pending_email,omitempty"`
+	// SHA-256 hash of the token last emailed to verify the pending or current email address
+	EmailVerificationHash *string `json:"-"`
+	// When the email verification token expires
+	EmailVerificationExpiresAt *time.Time `json:"email_verification_expires_at,omitempty"`
+	// Display name
+	Name string `json:"name,omitempty"`
+	// Language tag the user prefers generated text in
+	Locale *string `json:"locale,omitempty"`
+	// bcrypt hash of the password; unset for users who only sign in with Google
+	PasswordHash *string `json:"-"`
+	// Google account ID of users who sign in with Google
+	GoogleID *string `json:"google_id,omitempty"`
+	// When the user last signed in
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserQuery when eager-loading is set.
+	Edges        UserEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UserEdges holds the relations/edges for other nodes in the graph.
+type UserEdges struct {
+	// Email accounts the user connected, deleted with the user
+	EmailConnections []*EmailConnection `json:"email_connections,omitempty"`
+	// Drive accounts the user connected, deleted with the user
+	GoogleDriveConnections []*GoogleDriveConnection `json:"google_drive_connections,omitempty"`
+	// Money the user moved between budget categories, deleted with the user
+	BudgetReallocations []*BudgetReallocation `json:"budget_reallocations,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// EmailConnectionsOrErr returns the EmailConnections value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) EmailConnectionsOrErr() ([]*EmailConnection, error) {
+	if e.loadedTypes[0] {
+		return e.EmailConnections, nil
+	}
+	return nil, &NotLoadedError{edge: "email_connections"}
+}
+
+// GoogleDriveConnectionsOrErr returns the GoogleDriveConnections value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) GoogleDriveConnectionsOrErr() ([]*GoogleDriveConnection, error) {
+	if e.loadedTypes[1] {
+		return e.GoogleDriveConnections, nil
+	}
+	return nil, &NotLoadedError{edge: "google_drive_connections"}
+}
+
+// BudgetReallocationsOrErr returns the BudgetReallocations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BudgetReallocationsOrErr() ([]*BudgetReallocation, error) {
+	if e.loadedTypes[2] {
+		return e.BudgetReallocations, nil
+	}
+	return nil, &NotLoadedError{edge: "budget_reallocations"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*User) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldEmailVerified:
+			values[i] = new(sql.NullBool)
+		case user.FieldID, user.FieldEmail, user.FieldPendingEmail, user.FieldEmailVerificationHash, user.FieldName, user.FieldLocale, user.FieldPasswordHash, user.FieldGoogleID:
+			values[i] = new(sql.NullString)
+		case user.FieldEmailVerificationExpiresAt, user.FieldLastLoginAt, user.FieldCreatedAt, user.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the User fields.
+func (_m *User) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case user.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				_m.Email = new(string)
+				*_m.Email = value.String
+			}
+		case user.FieldEmailVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verified", values[i])
+			} else if value.Valid {
+				_m.EmailVerified = value.Bool
+			}
+		case user.FieldPendingEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pending_email", values[i])
+			} else if value.Valid {
+				_m.PendingEmail = new(string)
+				*_m.PendingEmail = value.String
+			}
+		case user.FieldEmailVerificationHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verification_hash", values[i])
+			} else if value.Valid {
+				_m.EmailVerificationHash = new(string)
+				*_m.EmailVerificationHash = value.String
+			}
+		case user.FieldEmailVerificationExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verification_expires_at", values[i])
+			} else if value.Valid {
+				_m.EmailVerificationExpiresAt = new(time.Time)
+				*_m.EmailVerificationExpiresAt = value.Time
+			}
+		case user.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case user.FieldLocale:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locale", values[i])
+			} else if value.Valid {
+				_m.Locale = new(string)
+				*_m.Locale = value.String
+			}
+		case user.FieldPasswordHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password_hash", values[i])
+			} else if value.Valid {
+				_m.PasswordHash = new(string)
+				*_m.PasswordHash = value.String
+			}
+		case user.FieldGoogleID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field google_id", values[i])
+			} else if value.Valid {
+				_m.GoogleID = new(string)
+				*_m.GoogleID = value.String
+			}
+		case user.FieldLastLoginAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_login_at", values[i])
+			} else if value.Valid {
+				_m.LastLoginAt = new(time.Time)
+				*_m.LastLoginAt = value.Time
+			}
+		case user.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case user.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the User.
+// This includes values selected through modifiers, order, etc.
+func (_m *User) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryEmailConnections queries the "email_connections" edge of the User entity.
+func (_m *User) QueryEmailConnections() *EmailConnectionQuery {
+	return NewUserClient(_m.config).QueryEmailConnections(_m)
+}
+
+// QueryGoogleDriveConnections queries the "google_drive_connections" edge of the User entity.
+func (_m *User) QueryGoogleDriveConnections() *GoogleDriveConnectionQuery {
+	return NewUserClient(_m.config).QueryGoogleDriveConnections(_m)
+}
+
+// QueryBudgetReallocations queries the "budget_reallocations" edge of the User entity.
+func (_m *User) QueryBudgetReallocations() *BudgetReallocationQuery {
+	return NewUserClient(_m.config).QueryBudgetReallocations(_m)
+}
+
+// Update returns a builder for updating this User.
+// Note that you need to call User.Unwrap() before calling this method if this User
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *User) Update() *UserUpdateOne {
+	return NewUserClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the User entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *User) Unwrap() *User {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: User is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *User) String() string {
+	var builder strings.Builder
+	builder.WriteString("User(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.Email; v != nil {
+		builder.WriteString("email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("email_verified=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EmailVerified))
+	builder.WriteString(", ")
+	if v := _m.PendingEmail; v != nil {
+		builder.WriteString("pending_email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("email_verification_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.EmailVerificationExpiresAt; v != nil {
+		builder.WriteString("email_verification_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	if v := _m.Locale; v != nil {
+		builder.WriteString("locale=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("password_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.GoogleID; v != nil {
+		builder.WriteString("google_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.LastLoginAt; v != nil {
+		builder.WriteString("last_login_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Users is a parsable slice of User.
+type Users []*User
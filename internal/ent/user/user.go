@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package user
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the user type in the database.
+	Label = "user"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldEmailVerified holds the string denoting the email_verified field in the database.
+	FieldEmailVerified = "email_verified"
+	// FieldPendingEmail holds the string denoting the pending_email field in the database.
+	FieldPendingEmail = "pending_email"
+	// FieldEmailVerificationHash holds the string denoting the email_verification_hash field in the database.
+	FieldEmailVerificationHash = "email_verification_hash"
+	// FieldEmailVerificationExpiresAt holds the string denoting the email_verification_expires_at field in the database.
+	FieldEmailVerificationExpiresAt = "email_verification_expires_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldLocale holds the string denoting the locale field in the database.
+	FieldLocale = "locale"
+	// FieldPasswordHash holds the string denoting the password_hash field in the database.
+	FieldPasswordHash = "password_hash"
+	// FieldGoogleID holds the string denoting the google_id field in the database.
+	FieldGoogleID = "google_id"
+	// FieldLastLoginAt holds the string denoting the last_login_at field in the database.
+	FieldLastLoginAt = "last_login_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeEmailConnections holds the string denoting the email_connections edge name in mutations.
+	EdgeEmailConnections = "email_connections"
+	// EdgeGoogleDriveConnections holds the string denoting the google_drive_connections edge name in mutations.
+	EdgeGoogleDriveConnections = "google_drive_connections"
+	// EdgeBudgetReallocations holds the string denoting the budget_reallocations edge name in mutations.
+	EdgeBudgetReallocations = "budget_reallocations"
+	// Table holds the table name of the user in the database.
+	Table = "users"
+	// EmailConnectionsTable is the table that holds the email_connections relation/edge.
+	EmailConnectionsTable = "email_connections"
+	// EmailConnectionsInverseTable is the table name for the EmailConnection entity.
+	// It exists in this package in order to avoid circular dependency with the "emailconnection" package.
+	EmailConnectionsInverseTable = "email_connections"
+	// EmailConnectionsColumn is the table column denoting the email_connections relation/edge.
+	EmailConnectionsColumn = "user_id"
+	// GoogleDriveConnectionsTable is the table that holds the google_drive_connections relation/edge.
+	GoogleDriveConnectionsTable = "google_drive_connections"
+	// GoogleDriveConnectionsInverseTable is the table name for the GoogleDriveConnection entity.
+	// It exists in this package in order to avoid circular dependency with the "googledriveconnection" package.
+	GoogleDriveConnectionsInverseTable = "google_drive_connections"
+	// GoogleDriveConnectionsColumn is the table column denoting the google_drive_connections relation/edge.
+	GoogleDriveConnectionsColumn = "user_id"
+	// BudgetReallocationsTable is the table that holds the budget_reallocations relation/edge.
+	BudgetReallocationsTable = "budget_reallocations"
+	// BudgetReallocationsInverseTable is the table name for the BudgetReallocation entity.
+	// It exists in this package in order to avoid circular dependency with the "budgetreallocation" package.
+	BudgetReallocationsInverseTable = "budget_reallocations"
+	// BudgetReallocationsColumn is the table column denoting the budget_reallocations relation/edge.
+	BudgetReallocationsColumn = "user_id"
+)
+
+// Columns holds all SQL columns for user fields.
+var Columns = []string{
+	FieldID,
+	FieldEmail,
+	FieldEmailVerified,
+	FieldPendingEmail,
+	FieldEmailVerificationHash,
+	FieldEmailVerificationExpiresAt,
+	FieldName,
+	FieldLocale,
+	FieldPasswordHash,
+	FieldGoogleID,
+	FieldLastLoginAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultEmailVerified holds the default value on creation for the "email_verified" field.
+	DefaultEmailVerified bool
+	// DefaultName holds the default value on creation for the "name" field.
+	DefaultName string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the User queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEmail orders the results by the email field.
+func ByEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmail, opts...).ToFunc()
+}
+
+// ByEmailVerified orders the results by the email_verified field.
+func ByEmailVerified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailVerified, opts...).ToFunc()
+}
+
+// ByPendingEmail orders the results by the pending_email field.
+func ByPendingEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPendingEmail, opts...).ToFunc()
+}
+
+// ByEmailVerificationHash orders the results by the email_verification_hash field.
+func ByEmailVerificationHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailVerificationHash, opts...).ToFunc()
+}
+
+// ByEmailVerificationExpiresAt orders the results by the email_verification_expires_at field.
+func ByEmailVerificationExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailVerificationExpiresAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByLocale orders the results by the locale field.
+func ByLocale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocale, opts...).ToFunc()
+}
+
+// ByPasswordHash orders the results by the password_hash field.
+func ByPasswordHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordHash, opts...).ToFunc()
+}
+
+// ByGoogleID orders the results by the google_id field.
+func ByGoogleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGoogleID, opts...).ToFunc()
+}
+
+// ByLastLoginAt orders the results by the last_login_at field.
+func ByLastLoginAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastLoginAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByEmailConnectionsCount orders the results by email_connections count.
+func ByEmailConnectionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEmailConnectionsStep(), opts...)
+	}
+}
+
+// ByEmailConnections orders the results by email_connections terms.
+func ByEmailConnections(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmailConnectionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByGoogleDriveConnectionsCount orders the results by google_drive_connections count.
+func ByGoogleDriveConnectionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGoogleDriveConnectionsStep(), opts...)
+	}
+}
+
+// ByGoogleDriveConnections orders the results by google_drive_connections terms.
+func ByGoogleDriveConnections(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGoogleDriveConnectionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBudgetReallocationsCount orders the results by budget_reallocations count.
+func ByBudgetReallocationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBudgetReallocationsStep(), opts...)
+	}
+}
+
+// ByBudgetReallocations orders the results by budget_reallocations terms.
+func ByBudgetReallocations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBudgetReallocationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newEmailConnectionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmailConnectionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EmailConnectionsTable, EmailConnectionsColumn),
+	)
+}
+func newGoogleDriveConnectionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GoogleDriveConnectionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, GoogleDriveConnectionsTable, GoogleDriveConnectionsColumn),
+	)
+}
+func newBudgetReallocationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BudgetReallocationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BudgetReallocationsTable, BudgetReallocationsColumn),
+	)
+}
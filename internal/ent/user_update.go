@@ -0,0 +1,1185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"clockzen-next/internal/ent/budgetreallocation"
+	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/googledriveconnection"
+	"clockzen-next/internal/ent/predicate"
+	"clockzen-next/internal/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserUpdate is the builder for updating User entities.
+type UserUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// Where appends a list predicates to the UserUpdate builder.
+func (_u *UserUpdate) Where(ps ...predicate.User) *UserUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *UserUpdate) SetEmail(v string) *UserUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableEmail(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *UserUpdate) ClearEmail() *UserUpdate {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (_u *UserUpdate) SetEmailVerified(v bool) *UserUpdate {
+	_u.mutation.SetEmailVerified(v)
+	return _u
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableEmailVerified(v *bool) *UserUpdate {
+	if v != nil {
+		_u.SetEmailVerified(*v)
+	}
+	return _u
+}
+
+// SetPendingEmail sets the "pending_email" field.
+func (_u *UserUpdate) SetPendingEmail(v string) *UserUpdate {
+	_u.mutation.SetPendingEmail(v)
+	return _u
+}
+
+// SetNillablePendingEmail sets the "pending_email" field if the given value is not nil.
+func (_u *UserUpdate) SetNillablePendingEmail(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetPendingEmail(*v)
+	}
+	return _u
+}
+
+// ClearPendingEmail clears the value of the "pending_email" field.
+func (_u *UserUpdate) ClearPendingEmail() *UserUpdate {
+	_u.mutation.ClearPendingEmail()
+	return _u
+}
+
+// SetEmailVerificationHash sets the "email_verification_hash" field.
+func (_u *UserUpdate) SetEmailVerificationHash(v string) *UserUpdate {
+	_u.mutation.SetEmailVerificationHash(v)
+	return _u
+}
+
+// SetNillableEmailVerificationHash sets the "email_verification_hash" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableEmailVerificationHash(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetEmailVerificationHash(*v)
+	}
+	return _u
+}
+
+// ClearEmailVerificationHash clears the value of the "email_verification_hash" field.
+func (_u *UserUpdate) ClearEmailVerificationHash() *UserUpdate {
+	_u.mutation.ClearEmailVerificationHash()
+	return _u
+}
+
+// SetEmailVerificationExpiresAt sets the "email_verification_expires_at" field.
+func (_u *UserUpdate) SetEmailVerificationExpiresAt(v time.Time) *UserUpdate {
+	_u.mutation.SetEmailVerificationExpiresAt(v)
+	return _u
+}
+
+// SetNillableEmailVerificationExpiresAt sets the "email_verification_expires_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableEmailVerificationExpiresAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetEmailVerificationExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearEmailVerificationExpiresAt clears the value of the "email_verification_expires_at" field.
+func (_u *UserUpdate) ClearEmailVerificationExpiresAt() *UserUpdate {
+	_u.mutation.ClearEmailVerificationExpiresAt()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *UserUpdate) SetName(v string) *UserUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableName(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *UserUpdate) SetLocale(v string) *UserUpdate {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableLocale(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// ClearLocale clears the value of the "locale" field.
+func (_u *UserUpdate) ClearLocale() *UserUpdate {
+	_u.mutation.ClearLocale()
+	return _u
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (_u *UserUpdate) SetPasswordHash(v string) *UserUpdate {
+	_u.mutation.SetPasswordHash(v)
+	return _u
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (_u *UserUpdate) SetNillablePasswordHash(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetPasswordHash(*v)
+	}
+	return _u
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (_u *UserUpdate) ClearPasswordHash() *UserUpdate {
+	_u.mutation.ClearPasswordHash()
+	return _u
+}
+
+// SetGoogleID sets the "google_id" field.
+func (_u *UserUpdate) SetGoogleID(v string) *UserUpdate {
+	_u.mutation.SetGoogleID(v)
+	return _u
+}
+
+// SetNillableGoogleID sets the "google_id" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableGoogleID(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetGoogleID(*v)
+	}
+	return _u
+}
+
+// ClearGoogleID clears the value of the "google_id" field.
+func (_u *UserUpdate) ClearGoogleID() *UserUpdate {
+	_u.mutation.ClearGoogleID()
+	return _u
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (_u *UserUpdate) SetLastLoginAt(v time.Time) *UserUpdate {
+	_u.mutation.SetLastLoginAt(v)
+	return _u
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableLastLoginAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetLastLoginAt(*v)
+	}
+	return _u
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (_u *UserUpdate) ClearLastLoginAt() *UserUpdate {
+	_u.mutation.ClearLastLoginAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserUpdate) SetUpdatedAt(v time.Time) *UserUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddEmailConnectionIDs adds the "email_connections" edge to the EmailConnection entity by IDs.
+func (_u *UserUpdate) AddEmailConnectionIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddEmailConnectionIDs(ids...)
+	return _u
+}
+
+// AddEmailConnections adds the "email_connections" edges to the EmailConnection entity.
+func (_u *UserUpdate) AddEmailConnections(v ...*EmailConnection) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEmailConnectionIDs(ids...)
+}
+
+// AddGoogleDriveConnectionIDs adds the "google_drive_connections" edge to the GoogleDriveConnection entity by IDs.
+func (_u *UserUpdate) AddGoogleDriveConnectionIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddGoogleDriveConnectionIDs(ids...)
+	return _u
+}
+
+// AddGoogleDriveConnections adds the "google_drive_connections" edges to the GoogleDriveConnection entity.
+func (_u *UserUpdate) AddGoogleDriveConnections(v ...*GoogleDriveConnection) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGoogleDriveConnectionIDs(ids...)
+}
+
+// AddBudgetReallocationIDs adds the "budget_reallocations" edge to the BudgetReallocation entity by IDs.
+func (_u *UserUpdate) AddBudgetReallocationIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddBudgetReallocationIDs(ids...)
+	return _u
+}
+
+// AddBudgetReallocations adds the "budget_reallocations" edges to the BudgetReallocation entity.
+func (_u *UserUpdate) AddBudgetReallocations(v ...*BudgetReallocation) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBudgetReallocationIDs(ids...)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (_u *UserUpdate) Mutation() *UserMutation {
+	return _u.mutation
+}
+
+// ClearEmailConnections clears all "email_connections" edges to the EmailConnection entity.
+func (_u *UserUpdate) ClearEmailConnections() *UserUpdate {
+	_u.mutation.ClearEmailConnections()
+	return _u
+}
+
+// RemoveEmailConnectionIDs removes the "email_connections" edge to EmailConnection entities by IDs.
+func (_u *UserUpdate) RemoveEmailConnectionIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveEmailConnectionIDs(ids...)
+	return _u
+}
+
+// RemoveEmailConnections removes "email_connections" edges to EmailConnection entities.
+func (_u *UserUpdate) RemoveEmailConnections(v ...*EmailConnection) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEmailConnectionIDs(ids...)
+}
+
+// ClearGoogleDriveConnections clears all "google_drive_connections" edges to the GoogleDriveConnection entity.
+func (_u *UserUpdate) ClearGoogleDriveConnections() *UserUpdate {
+	_u.mutation.ClearGoogleDriveConnections()
+	return _u
+}
+
+// RemoveGoogleDriveConnectionIDs removes the "google_drive_connections" edge to GoogleDriveConnection entities by IDs.
+func (_u *UserUpdate) RemoveGoogleDriveConnectionIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveGoogleDriveConnectionIDs(ids...)
+	return _u
+}
+
+// RemoveGoogleDriveConnections removes "google_drive_connections" edges to GoogleDriveConnection entities.
+func (_u *UserUpdate) RemoveGoogleDriveConnections(v ...*GoogleDriveConnection) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGoogleDriveConnectionIDs(ids...)
+}
+
+// ClearBudgetReallocations clears all "budget_reallocations" edges to the BudgetReallocation entity.
+func (_u *UserUpdate) ClearBudgetReallocations() *UserUpdate {
+	_u.mutation.ClearBudgetReallocations()
+	return _u
+}
+
+// RemoveBudgetReallocationIDs removes the "budget_reallocations" edge to BudgetReallocation entities by IDs.
+func (_u *UserUpdate) RemoveBudgetReallocationIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveBudgetReallocationIDs(ids...)
+	return _u
+}
+
+// RemoveBudgetReallocations removes "budget_reallocations" edges to BudgetReallocation entities.
+func (_u *UserUpdate) RemoveBudgetReallocations(v ...*BudgetReallocation) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBudgetReallocationIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := user.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(user.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.EmailVerified(); ok {
+		_spec.SetField(user.FieldEmailVerified, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PendingEmail(); ok {
+		_spec.SetField(user.FieldPendingEmail, field.TypeString, value)
+	}
+	if _u.mutation.PendingEmailCleared() {
+		_spec.ClearField(user.FieldPendingEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.EmailVerificationHash(); ok {
+		_spec.SetField(user.FieldEmailVerificationHash, field.TypeString, value)
+	}
+	if _u.mutation.EmailVerificationHashCleared() {
+		_spec.ClearField(user.FieldEmailVerificationHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.EmailVerificationExpiresAt(); ok {
+		_spec.SetField(user.FieldEmailVerificationExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.EmailVerificationExpiresAtCleared() {
+		_spec.ClearField(user.FieldEmailVerificationExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(user.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(user.FieldLocale, field.TypeString, value)
+	}
+	if _u.mutation.LocaleCleared() {
+		_spec.ClearField(user.FieldLocale, field.TypeString)
+	}
+	if value, ok := _u.mutation.PasswordHash(); ok {
+		_spec.SetField(user.FieldPasswordHash, field.TypeString, value)
+	}
+	if _u.mutation.PasswordHashCleared() {
+		_spec.ClearField(user.FieldPasswordHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.GoogleID(); ok {
+		_spec.SetField(user.FieldGoogleID, field.TypeString, value)
+	}
+	if _u.mutation.GoogleIDCleared() {
+		_spec.ClearField(user.FieldGoogleID, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastLoginAt(); ok {
+		_spec.SetField(user.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastLoginAtCleared() {
+		_spec.ClearField(user.FieldLastLoginAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EmailConnectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailConnectionsTable,
+			Columns: []string{user.EmailConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailconnection.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEmailConnectionsIDs(); len(nodes) > 0 && !_u.mutation.EmailConnectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailConnectionsTable,
+			Columns: []string{user.EmailConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailconnection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EmailConnectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailConnectionsTable,
+			Columns: []string{user.EmailConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailconnection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GoogleDriveConnectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GoogleDriveConnectionsTable,
+			Columns: []string{user.GoogleDriveConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(googledriveconnection.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedGoogleDriveConnectionsIDs(); len(nodes) > 0 && !_u.mutation.GoogleDriveConnectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GoogleDriveConnectionsTable,
+			Columns: []string{user.GoogleDriveConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(googledriveconnection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GoogleDriveConnectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GoogleDriveConnectionsTable,
+			Columns: []string{user.GoogleDriveConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(googledriveconnection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BudgetReallocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BudgetReallocationsTable,
+			Columns: []string{user.BudgetReallocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budgetreallocation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBudgetReallocationsIDs(); len(nodes) > 0 && !_u.mutation.BudgetReallocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BudgetReallocationsTable,
+			Columns: []string{user.BudgetReallocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budgetreallocation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BudgetReallocationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BudgetReallocationsTable,
+			Columns: []string{user.BudgetReallocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budgetreallocation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetEmail sets the "email" field.
+func (_u *UserUpdateOne) SetEmail(v string) *UserUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEmail(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *UserUpdateOne) ClearEmail() *UserUpdateOne {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (_u *UserUpdateOne) SetEmailVerified(v bool) *UserUpdateOne {
+	_u.mutation.SetEmailVerified(v)
+	return _u
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEmailVerified(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetEmailVerified(*v)
+	}
+	return _u
+}
+
+// SetPendingEmail sets the "pending_email" field.
+func (_u *UserUpdateOne) SetPendingEmail(v string) *UserUpdateOne {
+	_u.mutation.SetPendingEmail(v)
+	return _u
+}
+
+// SetNillablePendingEmail sets the "pending_email" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillablePendingEmail(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetPendingEmail(*v)
+	}
+	return _u
+}
+
+// ClearPendingEmail clears the value of the "pending_email" field.
+func (_u *UserUpdateOne) ClearPendingEmail() *UserUpdateOne {
+	_u.mutation.ClearPendingEmail()
+	return _u
+}
+
+// SetEmailVerificationHash sets the "email_verification_hash" field.
+func (_u *UserUpdateOne) SetEmailVerificationHash(v string) *UserUpdateOne {
+	_u.mutation.SetEmailVerificationHash(v)
+	return _u
+}
+
+// SetNillableEmailVerificationHash sets the "email_verification_hash" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEmailVerificationHash(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetEmailVerificationHash(*v)
+	}
+	return _u
+}
+
+// ClearEmailVerificationHash clears the value of the "email_verification_hash" field.
+func (_u *UserUpdateOne) ClearEmailVerificationHash() *UserUpdateOne {
+	_u.mutation.ClearEmailVerificationHash()
+	return _u
+}
+
+// SetEmailVerificationExpiresAt sets the "email_verification_expires_at" field.
+func (_u *UserUpdateOne) SetEmailVerificationExpiresAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetEmailVerificationExpiresAt(v)
+	return _u
+}
+
+// SetNillableEmailVerificationExpiresAt sets the "email_verification_expires_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEmailVerificationExpiresAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetEmailVerificationExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearEmailVerificationExpiresAt clears the value of the "email_verification_expires_at" field.
+func (_u *UserUpdateOne) ClearEmailVerificationExpiresAt() *UserUpdateOne {
+	_u.mutation.ClearEmailVerificationExpiresAt()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *UserUpdateOne) SetName(v string) *UserUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableName(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *UserUpdateOne) SetLocale(v string) *UserUpdateOne {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableLocale(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// ClearLocale clears the value of the "locale" field.
+func (_u *UserUpdateOne) ClearLocale() *UserUpdateOne {
+	_u.mutation.ClearLocale()
+	return _u
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (_u *UserUpdateOne) SetPasswordHash(v string) *UserUpdateOne {
+	_u.mutation.SetPasswordHash(v)
+	return _u
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillablePasswordHash(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetPasswordHash(*v)
+	}
+	return _u
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (_u *UserUpdateOne) ClearPasswordHash() *UserUpdateOne {
+	_u.mutation.ClearPasswordHash()
+	return _u
+}
+
+// SetGoogleID sets the "google_id" field.
+func (_u *UserUpdateOne) SetGoogleID(v string) *UserUpdateOne {
+	_u.mutation.SetGoogleID(v)
+	return _u
+}
+
+// SetNillableGoogleID sets the "google_id" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableGoogleID(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetGoogleID(*v)
+	}
+	return _u
+}
+
+// ClearGoogleID clears the value of the "google_id" field.
+func (_u *UserUpdateOne) ClearGoogleID() *UserUpdateOne {
+	_u.mutation.ClearGoogleID()
+	return _u
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (_u *UserUpdateOne) SetLastLoginAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetLastLoginAt(v)
+	return _u
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableLastLoginAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetLastLoginAt(*v)
+	}
+	return _u
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (_u *UserUpdateOne) ClearLastLoginAt() *UserUpdateOne {
+	_u.mutation.ClearLastLoginAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserUpdateOne) SetUpdatedAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddEmailConnectionIDs adds the "email_connections" edge to the EmailConnection entity by IDs.
+func (_u *UserUpdateOne) AddEmailConnectionIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddEmailConnectionIDs(ids...)
+	return _u
+}
+
+// AddEmailConnections adds the "email_connections" edges to the EmailConnection entity.
+func (_u *UserUpdateOne) AddEmailConnections(v ...*EmailConnection) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEmailConnectionIDs(ids...)
+}
+
+// AddGoogleDriveConnectionIDs adds the "google_drive_connections" edge to the GoogleDriveConnection entity by IDs.
+func (_u *UserUpdateOne) AddGoogleDriveConnectionIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddGoogleDriveConnectionIDs(ids...)
+	return _u
+}
+
+// AddGoogleDriveConnections adds the "google_drive_connections" edges to the GoogleDriveConnection entity.
+func (_u *UserUpdateOne) AddGoogleDriveConnections(v ...*GoogleDriveConnection) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGoogleDriveConnectionIDs(ids...)
+}
+
+// AddBudgetReallocationIDs adds the "budget_reallocations" edge to the BudgetReallocation entity by IDs.
+func (_u *UserUpdateOne) AddBudgetReallocationIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddBudgetReallocationIDs(ids...)
+	return _u
+}
+
+// AddBudgetReallocations adds the "budget_reallocations" edges to the BudgetReallocation entity.
+func (_u *UserUpdateOne) AddBudgetReallocations(v ...*BudgetReallocation) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBudgetReallocationIDs(ids...)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (_u *UserUpdateOne) Mutation() *UserMutation {
+	return _u.mutation
+}
+
+// ClearEmailConnections clears all "email_connections" edges to the EmailConnection entity.
+func (_u *UserUpdateOne) ClearEmailConnections() *UserUpdateOne {
+	_u.mutation.ClearEmailConnections()
+	return _u
+}
+
+// RemoveEmailConnectionIDs removes the "email_connections" edge to EmailConnection entities by IDs.
+func (_u *UserUpdateOne) RemoveEmailConnectionIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveEmailConnectionIDs(ids...)
+	return _u
+}
+
+// RemoveEmailConnections removes "email_connections" edges to EmailConnection entities.
+func (_u *UserUpdateOne) RemoveEmailConnections(v ...*EmailConnection) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEmailConnectionIDs(ids...)
+}
+
+// ClearGoogleDriveConnections clears all "google_drive_connections" edges to the GoogleDriveConnection entity.
+func (_u *UserUpdateOne) ClearGoogleDriveConnections() *UserUpdateOne {
+	_u.mutation.ClearGoogleDriveConnections()
+	return _u
+}
+
+// RemoveGoogleDriveConnectionIDs removes the "google_drive_connections" edge to GoogleDriveConnection entities by IDs.
+func (_u *UserUpdateOne) RemoveGoogleDriveConnectionIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveGoogleDriveConnectionIDs(ids...)
+	return _u
+}
+
+// RemoveGoogleDriveConnections removes "google_drive_connections" edges to GoogleDriveConnection entities.
+func (_u *UserUpdateOne) RemoveGoogleDriveConnections(v ...*GoogleDriveConnection) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGoogleDriveConnectionIDs(ids...)
+}
+
+// ClearBudgetReallocations clears all "budget_reallocations" edges to the BudgetReallocation entity.
+func (_u *UserUpdateOne) ClearBudgetReallocations() *UserUpdateOne {
+	_u.mutation.ClearBudgetReallocations()
+	return _u
+}
+
+// RemoveBudgetReallocationIDs removes the "budget_reallocations" edge to BudgetReallocation entities by IDs.
+func (_u *UserUpdateOne) RemoveBudgetReallocationIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveBudgetReallocationIDs(ids...)
+	return _u
+}
+
+// RemoveBudgetReallocations removes "budget_reallocations" edges to BudgetReallocation entities.
+func (_u *UserUpdateOne) RemoveBudgetReallocations(v ...*BudgetReallocation) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBudgetReallocationIDs(ids...)
+}
+
+// Where appends a list predicates to the UserUpdate builder.
+func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated User entity.
+func (_u *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserUpdateOne) SaveX(ctx context.Context) *User {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := user.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
+		for _, f := range fields {
+			if !user.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != user.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(user.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.EmailVerified(); ok {
+		_spec.SetField(user.FieldEmailVerified, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PendingEmail(); ok {
+		_spec.SetField(user.FieldPendingEmail, field.TypeString, value)
+	}
+	if _u.mutation.PendingEmailCleared() {
+		_spec.ClearField(user.FieldPendingEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.EmailVerificationHash(); ok {
+		_spec.SetField(user.FieldEmailVerificationHash, field.TypeString, value)
+	}
+	if _u.mutation.EmailVerificationHashCleared() {
+		_spec.ClearField(user.FieldEmailVerificationHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.EmailVerificationExpiresAt(); ok {
+		_spec.SetField(user.FieldEmailVerificationExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.EmailVerificationExpiresAtCleared() {
+		_spec.ClearField(user.FieldEmailVerificationExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(user.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(user.FieldLocale, field.TypeString, value)
+	}
+	if _u.mutation.LocaleCleared() {
+		_spec.ClearField(user.FieldLocale, field.TypeString)
+	}
+	if value, ok := _u.mutation.PasswordHash(); ok {
+		_spec.SetField(user.FieldPasswordHash, field.TypeString, value)
+	}
+	if _u.mutation.PasswordHashCleared() {
+		_spec.ClearField(user.FieldPasswordHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.GoogleID(); ok {
+		_spec.SetField(user.FieldGoogleID, field.TypeString, value)
+	}
+	if _u.mutation.GoogleIDCleared() {
+		_spec.ClearField(user.FieldGoogleID, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastLoginAt(); ok {
+		_spec.SetField(user.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastLoginAtCleared() {
+		_spec.ClearField(user.FieldLastLoginAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EmailConnectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailConnectionsTable,
+			Columns: []string{user.EmailConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailconnection.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEmailConnectionsIDs(); len(nodes) > 0 && !_u.mutation.EmailConnectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailConnectionsTable,
+			Columns: []string{user.EmailConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailconnection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EmailConnectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailConnectionsTable,
+			Columns: []string{user.EmailConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailconnection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GoogleDriveConnectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GoogleDriveConnectionsTable,
+			Columns: []string{user.GoogleDriveConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(googledriveconnection.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedGoogleDriveConnectionsIDs(); len(nodes) > 0 && !_u.mutation.GoogleDriveConnectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GoogleDriveConnectionsTable,
+			Columns: []string{user.GoogleDriveConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(googledriveconnection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GoogleDriveConnectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GoogleDriveConnectionsTable,
+			Columns: []string{user.GoogleDriveConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(googledriveconnection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BudgetReallocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BudgetReallocationsTable,
+			Columns: []string{user.BudgetReallocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budgetreallocation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBudgetReallocationsIDs(); len(nodes) > 0 && !_u.mutation.BudgetReallocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BudgetReallocationsTable,
+			Columns: []string{user.BudgetReallocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budgetreallocation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BudgetReallocationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BudgetReallocationsTable,
+			Columns: []string{user.BudgetReallocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budgetreallocation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &User{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,156 @@
+// Package capitalmarkets provides market assumptions from a remote capital
+// market assumptions service.
+package capitalmarkets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"clockzen-next/internal/application/retirement"
+)
+
+// ProviderName names the remote provider's assumption sets
+const ProviderName = "remote"
+
+// ErrServiceError is returned when the service answers with an error
+var ErrServiceError = errors.New("capital market assumptions service error")
+
+// Config configures the service's location
+type Config struct {
+	// BaseURL is the service's root; assumptions are fetched from
+	// {BaseURL}/assumptions/latest and {BaseURL}/assumptions/{version}
+	BaseURL string
+	// APIKey, when set, is sent as a bearer token
+	APIKey string
+}
+
+// assumptionSetResponse is an assumption set as the service publishes it
+type assumptionSetResponse struct {
+	Version string    `json:"version"`
+	AsOf    time.Time `json:"as_of"`
+
+	Stocks assetClassResponse `json:"stocks"`
+	Bonds  assetClassResponse `json:"bonds"`
+	Cash   assetClassResponse `json:"cash"`
+
+	Correlations struct {
+		StockBond float64 `json:"stock_bond"`
+		StockCash float64 `json:"stock_cash"`
+		BondCash  float64 `json:"bond_cash"`
+	} `json:"correlations"`
+}
+
+// assetClassResponse is one asset class's expected return and volatility
+type assetClassResponse struct {
+	ExpectedReturn float64 `json:"expected_return"`
+	Volatility     float64 `json:"volatility"`
+}
+
+// Client fetches versioned assumptions from the service. It implements
+// retirement.AssumptionProvider.
+type Client struct {
+	config     Config
+	httpClient *http.Client
+}
+
+// NewClient creates a new capital market assumptions client
+func NewClient(config Config) *Client {
+	return &Client{
+		config: config,
+		httpClient: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
+		},
+	}
+}
+
+// NewClientWithHTTP creates a new client with a custom HTTP client
+func NewClientWithHTTP(config Config, httpClient *http.Client) *Client {
+	return &Client{
+		config:     config,
+		httpClient: httpClient,
+	}
+}
+
+// Name identifies the provider
+func (c *Client) Name() string {
+	return ProviderName
+}
+
+// Latest fetches the most recently published assumptions
+func (c *Client) Latest(ctx context.Context) (*retirement.AssumptionSet, error) {
+	return c.fetch(ctx, "latest")
+}
+
+// Version fetches a published version of the assumptions
+func (c *Client) Version(ctx context.Context, version string) (*retirement.AssumptionSet, error) {
+	if version == "" || version == "latest" {
+		return nil, retirement.ErrAssumptionVersionNotFound
+	}
+	return c.fetch(ctx, version)
+}
+
+// fetch requests one assumption set and checks it is usable
+func (c *Client) fetch(ctx context.Context, version string) (*retirement.AssumptionSet, error) {
+	endpoint := strings.TrimSuffix(c.config.BaseURL, "/") + "/assumptions/" + url.PathEscape(version)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.config.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.config.APIKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("executing request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, retirement.ErrAssumptionVersionNotFound
+	case resp.StatusCode != http.StatusOK:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("%w: status %d, body: %s", ErrServiceError, resp.StatusCode, string(body))
+	}
+
+	var set assumptionSetResponse
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("%w: decoding response: %v", ErrServiceError, err)
+	}
+	if set.Version == "" {
+		return nil, fmt.Errorf("%w: response has no version", ErrServiceError)
+	}
+
+	assumptions := retirement.MarketAssumptions{
+		StockReturn:          set.Stocks.ExpectedReturn,
+		BondReturn:           set.Bonds.ExpectedReturn,
+		CashReturn:           set.Cash.ExpectedReturn,
+		StockVolatility:      set.Stocks.Volatility,
+		BondVolatility:       set.Bonds.Volatility,
+		CashVolatility:       set.Cash.Volatility,
+		StockBondCorrelation: set.Correlations.StockBond,
+		StockCashCorrelation: set.Correlations.StockCash,
+		BondCashCorrelation:  set.Correlations.BondCash,
+	}
+	if err := assumptions.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: version %s: %v", ErrServiceError, set.Version, err)
+	}
+	return &retirement.AssumptionSet{
+		Provider:    ProviderName,
+		Version:     set.Version,
+		AsOf:        set.AsOf,
+		Assumptions: assumptions,
+	}, nil
+}
@@ -0,0 +1,55 @@
+package capitalmarkets
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/application/retirement"
+)
+
+func TestClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/assumptions/latest", "/assumptions/2026q3":
+			w.Write([]byte(`{"version":"2026q3","as_of":"2026-07-01T00:00:00Z",
+				"stocks":{"expected_return":0.065,"volatility":0.16},
+				"bonds":{"expected_return":0.045,"volatility":0.06},
+				"cash":{"expected_return":0.03,"volatility":0.01},
+				"correlations":{"stock_bond":0.2}}`))
+		case "/assumptions/broken":
+			w.Write([]byte(`{"version":"broken","stocks":{"volatility":-1}}`))
+		case "/assumptions/down":
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClientWithHTTP(Config{BaseURL: server.URL + "/", APIKey: "secret"}, server.Client())
+	ctx := context.Background()
+
+	latest, err := client.Latest(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, ProviderName, latest.Provider)
+	assert.Equal(t, "2026q3", latest.Version)
+	assert.Equal(t, 0.065, latest.Assumptions.StockReturn)
+	assert.Equal(t, 0.2, latest.Assumptions.StockBondCorrelation)
+
+	pinned, err := client.Version(ctx, "2026q3")
+	require.NoError(t, err)
+	assert.Equal(t, latest, pinned)
+
+	_, err = client.Version(ctx, "1999q1")
+	assert.ErrorIs(t, err, retirement.ErrAssumptionVersionNotFound)
+	_, err = client.Version(ctx, "broken")
+	assert.ErrorIs(t, err, ErrServiceError)
+	_, err = client.Version(ctx, "down")
+	assert.ErrorIs(t, err, ErrServiceError)
+}
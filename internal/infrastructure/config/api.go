@@ -0,0 +1,183 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"clockzen-next/internal/infrastructure/observability"
+	"clockzen-next/internal/infrastructure/telemetry"
+)
+
+// API is the configuration of the API server
+type API struct {
+	Server     Server     `yaml:"server"`
+	Database   Database   `yaml:"database"`
+	Logging    Logging    `yaml:"logging"`
+	Tracing    Tracing    `yaml:"tracing"`
+	Auth       Auth       `yaml:"auth"`
+	SLO        SLO        `yaml:"slo"`
+	Google     Google     `yaml:"google"`
+	Encryption Encryption `yaml:"encryption"`
+	Telemetry  Telemetry  `yaml:"telemetry"`
+	// TelemetryReceiver accepts other installations' telemetry reports
+	TelemetryReceiver TelemetryReceiver `yaml:"telemetry_receiver"`
+	// CapitalMarkets is the capital market assumptions service analyses
+	// can load assumptions from
+	CapitalMarkets CapitalMarkets `yaml:"capital_markets"`
+	// Redis keeps OAuth states shared by API replicas; without it they are
+	// kept in the database
+	Redis Redis `yaml:"redis"`
+	// Mail sends the tokens users verify their email addresses with
+	Mail Mail `yaml:"mail"`
+	// AttachmentStorageDir is where the worker keeps downloaded attachment
+	// content, purged when the users it belongs to are deleted
+	AttachmentStorageDir string `yaml:"attachment_storage_dir" env:"ATTACHMENT_STORAGE_DIR"`
+	// SandboxMode lets email connections be made to a synthetic mailbox
+	SandboxMode bool `yaml:"sandbox_mode" env:"SANDBOX_MODE"`
+	// CORSOrigin is the origin browsers may call the API from
+	CORSOrigin string `yaml:"cors_origin" env:"CORS_ORIGIN"`
+	// RequestTimeout is the deadline of each request's queries and calls,
+	// 0 for none. It should be below the server's 15s write timeout.
+	RequestTimeout time.Duration `yaml:"request_timeout" env:"REQUEST_TIMEOUT"`
+	// GRPCPort is the port the gRPC API listens on; it isn't served if
+	// empty
+	GRPCPort string `yaml:"grpc_port" env:"GRPC_PORT"`
+}
+
+// Auth configures bearer token verification and the tokens issued when
+// users sign in
+type Auth struct {
+	JWTSecret Secret `yaml:"jwt_secret" env:"JWT_SECRET" required:"true"`
+	JWTIssuer string `yaml:"jwt_issuer" env:"JWT_ISSUER"`
+	// Leeway allows for clock skew when checking token times
+	Leeway time.Duration `yaml:"leeway" env:"JWT_LEEWAY"`
+	// TokenTTL is how long tokens issued at sign in are valid
+	TokenTTL time.Duration `yaml:"token_ttl" env:"JWT_TTL"`
+}
+
+// SLO configures per-route latency tracking
+type SLO struct {
+	LatencyTarget time.Duration `yaml:"latency_target" env:"SLO_LATENCY_TARGET"`
+	Objective     float64       `yaml:"objective" env:"SLO_OBJECTIVE"`
+}
+
+// Telemetry configures anonymous usage reporting, which is opt-in
+type Telemetry struct {
+	Enabled        bool          `yaml:"enabled" env:"TELEMETRY_ENABLED"`
+	Endpoint       string        `yaml:"endpoint" env:"TELEMETRY_ENDPOINT"`
+	Token          Secret        `yaml:"token" env:"TELEMETRY_TOKEN"`
+	InstallationID string        `yaml:"installation_id" env:"TELEMETRY_INSTALLATION_ID"`
+	Interval       time.Duration `yaml:"interval" env:"TELEMETRY_INTERVAL"`
+}
+
+// Config returns the reporter configuration
+func (t Telemetry) Config() telemetry.Config {
+	config := telemetry.DefaultConfig()
+	config.Enabled = t.Enabled
+	config.Endpoint = t.Endpoint
+	config.Token = t.Token.Reveal()
+	config.InstallationID = t.InstallationID
+	config.Interval = t.Interval
+	return config
+}
+
+// TelemetryReceiver configures the telemetry receiver
+type TelemetryReceiver struct {
+	Enabled bool   `yaml:"enabled" env:"TELEMETRY_RECEIVER_ENABLED"`
+	Token   Secret `yaml:"token" env:"TELEMETRY_RECEIVER_TOKEN"`
+}
+
+// CapitalMarkets configures the capital market assumptions service
+type CapitalMarkets struct {
+	URL    string `yaml:"url" env:"CAPITAL_MARKETS_URL"`
+	APIKey Secret `yaml:"api_key" env:"CAPITAL_MARKETS_API_KEY"`
+}
+
+// Redis configures the Redis server the API keeps OAuth states in
+type Redis struct {
+	// URL is a redis:// or rediss:// URL, such as
+	// redis://:password@localhost:6379/0
+	URL Secret `yaml:"url" env:"REDIS_URL"`
+}
+
+// Options parses the URL into client options
+func (r Redis) Options() (*redis.Options, error) {
+	options, err := redis.ParseURL(r.URL.Reveal())
+	if err != nil {
+		return nil, fmt.Errorf("REDIS_URL: %w", err)
+	}
+	return options, nil
+}
+
+// DefaultAPI returns the default API server configuration
+func DefaultAPI() API {
+	slo := observability.DefaultSLOConfig()
+	return API{
+		Server:   Server{Port: "8080"},
+		Database: defaultDatabase(),
+		Logging:  defaultLogging(),
+		Tracing:  defaultTracing(),
+		Auth: Auth{
+			Leeway:   30 * time.Second,
+			TokenTTL: 24 * time.Hour,
+		},
+		SLO: SLO{
+			LatencyTarget: slo.LatencyTarget,
+			Objective:     slo.Objective,
+		},
+		Telemetry: Telemetry{
+			Interval: telemetry.DefaultConfig().Interval,
+		},
+		Mail: Mail{
+			SMTPPort: 587,
+		},
+		CORSOrigin:     "*",
+		RequestTimeout: 10 * time.Second,
+	}
+}
+
+// Validate checks settings that depend on each other
+func (c *API) Validate() error {
+	var errs []error
+	if _, err := c.Logging.Config(""); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.Database.validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.Tracing.validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if c.SLO.Objective <= 0 || c.SLO.Objective >= 1 {
+		errs = append(errs, fmt.Errorf("SLO objective must be between 0 and 1, got %g", c.SLO.Objective))
+	}
+	// Tokens stored in the database are encrypted, so the keys are needed
+	// whenever there is one
+	if c.Database.URL != "" && c.Encryption.TokenKeys == "" {
+		errs = append(errs, errors.New("TOKEN_ENCRYPTION_KEYS is required when DATABASE_URL is set"))
+	}
+	if err := c.Encryption.validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if c.Redis.URL != "" {
+		if _, err := c.Redis.Options(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := c.Mail.validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if c.Auth.TokenTTL <= 0 {
+		errs = append(errs, errors.New("token TTL must be positive"))
+	}
+	if c.RequestTimeout < 0 {
+		errs = append(errs, errors.New("request timeout must not be negative"))
+	}
+	if c.Telemetry.Enabled && c.Telemetry.Interval <= 0 {
+		errs = append(errs, errors.New("telemetry interval must be positive"))
+	}
+	return errors.Join(errs...)
+}
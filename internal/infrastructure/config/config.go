@@ -0,0 +1,316 @@
+// Package config loads the typed configuration of the API server, worker
+// and migration tool. A configuration is a struct whose fields are tagged
+// with where each setting is read from:
+//
+//	type Database struct {
+//		URL Secret `yaml:"url" env:"DATABASE_URL" flag:"database-url" required:"true" usage:"PostgreSQL connection string"`
+//	}
+//
+// Settings start from the defaults the struct holds when it is loaded and
+// are overridden, in order, by the YAML file named by -config or
+// CONFIG_FILE, by environment variables and by flags. Once loaded, required
+// settings are checked and the struct's Validate method, if it has one, is
+// called. Secrets are held as Secret, which never prints its value.
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config errors
+var (
+	ErrMissingRequired = errors.New("required setting is not set")
+	ErrInvalidValue    = errors.New("invalid setting")
+	ErrInvalidConfig   = errors.New("invalid configuration")
+)
+
+// FileEnv is the environment variable naming the config file when there is
+// no -config flag
+const FileEnv = "CONFIG_FILE"
+
+// Validator is a configuration that checks itself once loaded, for rules
+// tags can't express, such as settings required only in some modes
+type Validator interface {
+	Validate() error
+}
+
+// Options controls where a configuration is loaded from
+type Options struct {
+	// FlagSet receives a flag for each field with a flag tag, plus
+	// -config. It is parsed with Args. Nil skips flags.
+	FlagSet *flag.FlagSet
+	// Args are the command line arguments, without the program name
+	Args []string
+	// LookupEnv reads environment variables; nil uses os.LookupEnv
+	LookupEnv func(key string) (string, bool)
+}
+
+// MissingError lists the required settings that weren't set. It matches
+// ErrMissingRequired with errors.Is.
+type MissingError struct {
+	// Settings names each missing setting by its environment variable,
+	// flag or file key
+	Settings []string
+}
+
+// Error implements error
+func (e *MissingError) Error() string {
+	return "missing required settings: " + strings.Join(e.Settings, ", ")
+}
+
+// Is reports whether target is ErrMissingRequired
+func (e *MissingError) Is(target error) bool {
+	return target == ErrMissingRequired
+}
+
+// setting is a field of a configuration and where it is read from
+type setting struct {
+	value    reflect.Value
+	path     string
+	env      string
+	flag     string
+	usage    string
+	required bool
+}
+
+// name describes the setting for error messages, by the first of its
+// environment variable, flag and file key it has
+func (s setting) name() string {
+	switch {
+	case s.env != "":
+		return s.env
+	case s.flag != "":
+		return "-" + s.flag
+	default:
+		return s.path
+	}
+}
+
+// Load fills cfg, a pointer to a configuration struct holding its defaults,
+// from the config file, the environment and flags, then checks it
+func Load(cfg any, opts Options) error {
+	root := reflect.ValueOf(cfg)
+	if root.Kind() != reflect.Pointer || root.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w: expected a pointer to a struct, got %T", ErrInvalidConfig, cfg)
+	}
+	lookup := opts.LookupEnv
+	if lookup == nil {
+		lookup = os.LookupEnv
+	}
+
+	var settings []setting
+	collect(root.Elem(), "", &settings)
+
+	// Flags are parsed first, to find the config file, but applied last so
+	// they override it and the environment
+	var path string
+	flagValues := make(map[string]string)
+	if opts.FlagSet != nil {
+		opts.FlagSet.StringVar(&path, "config", "", "YAML config file (or "+FileEnv+")")
+		for _, s := range settings {
+			if s.flag == "" {
+				continue
+			}
+			opts.FlagSet.Var(&flagValue{setting: s, values: flagValues}, s.flag, s.usage)
+		}
+		if err := opts.FlagSet.Parse(opts.Args); err != nil {
+			return err
+		}
+	}
+	if path == "" {
+		path, _ = lookup(FileEnv)
+	}
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("reading config file: %w", err)
+		}
+		if err := yaml.Unmarshal(data, cfg); err != nil {
+			return fmt.Errorf("parsing config file %s: %w", path, err)
+		}
+	}
+
+	for _, s := range settings {
+		if s.env == "" {
+			continue
+		}
+		value, ok := lookup(s.env)
+		if !ok || value == "" {
+			continue
+		}
+		if err := set(s.value, value); err != nil {
+			return fmt.Errorf("%w %s: %v", ErrInvalidValue, s.env, err)
+		}
+	}
+	for _, s := range settings {
+		if value, ok := flagValues[s.flag]; ok && s.flag != "" {
+			if err := set(s.value, value); err != nil {
+				return fmt.Errorf("%w -%s: %v", ErrInvalidValue, s.flag, err)
+			}
+		}
+	}
+
+	var missing []string
+	for _, s := range settings {
+		if s.required && s.value.IsZero() {
+			missing = append(missing, s.name())
+		}
+	}
+	if len(missing) > 0 {
+		return &MissingError{Settings: missing}
+	}
+
+	if v, ok := cfg.(Validator); ok {
+		if err := v.Validate(); err != nil {
+			// Settings required only in some modes are reported like those
+			// required by tags
+			if _, ok := err.(*MissingError); ok {
+				return err
+			}
+			return fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+		}
+	}
+	return nil
+}
+
+// collect lists the settings of a struct, descending into nested structs
+func collect(v reflect.Value, prefix string, settings *[]setting) {
+	t := v.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		key, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if key == "-" {
+			continue
+		}
+		if key == "" {
+			key = strings.ToLower(field.Name)
+		}
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		value := v.Field(i)
+		if value.Kind() == reflect.Struct && value.Type() != durationType {
+			collect(value, path, settings)
+			continue
+		}
+		*settings = append(*settings, setting{
+			value:    value,
+			path:     path,
+			env:      field.Tag.Get("env"),
+			flag:     field.Tag.Get("flag"),
+			usage:    field.Tag.Get("usage"),
+			required: field.Tag.Get("required") == "true",
+		})
+	}
+}
+
+var durationType = reflect.TypeFor[time.Duration]()
+
+// set parses raw into the field
+func set(v reflect.Value, raw string) error {
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported type %s", v.Type())
+		}
+		var items []string
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v.Set(reflect.ValueOf(items).Convert(v.Type()))
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+// flagValue is the flag of a setting. The value is kept until the file and
+// environment have been read, then applied over them.
+type flagValue struct {
+	setting setting
+	values  map[string]string
+}
+
+// String shows the setting's default in usage
+func (f *flagValue) String() string {
+	if f == nil || !f.setting.value.IsValid() {
+		return ""
+	}
+	return format(f.setting.value)
+}
+
+// Set checks and keeps the flag's value
+func (f *flagValue) Set(raw string) error {
+	check := reflect.New(f.setting.value.Type()).Elem()
+	if err := set(check, raw); err != nil {
+		return err
+	}
+	f.values[f.setting.flag] = raw
+	return nil
+}
+
+// IsBoolFlag lets boolean flags be given without a value
+func (f *flagValue) IsBoolFlag() bool {
+	return f.setting.value.Kind() == reflect.Bool
+}
+
+// format formats a field's value, redacting secrets
+func format(v reflect.Value) string {
+	if s, ok := v.Interface().(fmt.Stringer); ok {
+		return s.String()
+	}
+	if v.Kind() == reflect.Slice {
+		items := make([]string, v.Len())
+		for i := range items {
+			items[i] = fmt.Sprint(v.Index(i).Interface())
+		}
+		return strings.Join(items, ",")
+	}
+	return fmt.Sprint(v.Interface())
+}
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"errors"
+)
+
+// Migrate is the configuration of the migration tool
+type Migrate struct {
+	LegacyDB     Secret `yaml:"legacy_db" env:"LEGACY_DATABASE_URL" flag:"legacy-db" usage:"Legacy database connection string (LEGACY_DATABASE_URL)"`
+	LegacyDriver string `yaml:"legacy_driver" flag:"legacy-driver" usage:"Legacy database driver: postgres, mysql or sqlite3"`
+	Mapping      string `yaml:"mapping" flag:"mapping" usage:"YAML or JSON file mapping the legacy schema, if it differs from the default"`
+	TargetDB     Secret `yaml:"target_db" env:"TARGET_DATABASE_URL" flag:"target-db" usage:"Target database connection string (TARGET_DATABASE_URL)"`
+	DryRun       bool   `yaml:"dry_run" flag:"dry-run" usage:"Perform a dry run without writing to target database"`
+	BatchSize    int    `yaml:"batch_size" flag:"batch-size" usage:"Number of records to process per batch"`
+	Verbose      bool   `yaml:"verbose" flag:"verbose" usage:"Enable verbose logging"`
+	Resume       bool   `yaml:"resume" flag:"resume" usage:"Continue an interrupted migration from where it stopped"`
+	// EncryptTokens, Verify and Rollback replace the migration with another
+	// task
+	EncryptTokens bool   `yaml:"encrypt_tokens" flag:"encrypt-tokens" usage:"Encrypt stored OAuth tokens with the primary key in TOKEN_ENCRYPTION_KEYS, then exit"`
+	Verify        bool   `yaml:"verify" flag:"verify" usage:"Compare the legacy and target databases after a migration, then exit"`
+	VerifySample  int    `yaml:"verify_sample" flag:"verify-sample" usage:"Number of records of each table to compare when verifying"`
+	Rollback      string `yaml:"rollback" flag:"rollback" usage:"Delete the records created by the migration run with this ID, then exit"`
+	// Encryption encrypts imported tokens; without keys they are stored in
+	// plaintext
+	Encryption Encryption `yaml:"encryption"`
+}
+
+// DefaultMigrate returns the default migration tool configuration
+func DefaultMigrate() Migrate {
+	return Migrate{
+		LegacyDriver: "postgres",
+		BatchSize:    100,
+		VerifySample: 50,
+	}
+}
+
+// Validate checks the databases the chosen task needs are set, and that
+// its options fit together
+func (c *Migrate) Validate() error {
+	var missing []string
+	if c.TargetDB == "" {
+		missing = append(missing, "-target-db")
+	}
+	// Encrypting tokens and rolling back only touch the target database
+	if c.LegacyDB == "" && !c.EncryptTokens && c.Rollback == "" {
+		missing = append(missing, "-legacy-db")
+	}
+	if len(missing) > 0 {
+		return &MissingError{Settings: missing}
+	}
+
+	var errs []error
+	// A dry run records no progress, so there is nothing to resume from
+	if c.Resume && c.DryRun {
+		errs = append(errs, errors.New("-resume can't be combined with -dry-run"))
+	}
+	if c.BatchSize <= 0 {
+		errs = append(errs, errors.New("-batch-size must be positive"))
+	}
+	if c.Verify && c.VerifySample <= 0 {
+		errs = append(errs, errors.New("-verify-sample must be positive"))
+	}
+	if err := c.Encryption.validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,35 @@
+package config
+
+import (
+	"errors"
+
+	"clockzen-next/internal/infrastructure/dbmigrate"
+)
+
+// MigrateDB is the configuration of the schema migration command
+type MigrateDB struct {
+	DatabaseURL Secret `yaml:"database_url" env:"DATABASE_URL" flag:"database-url" usage:"PostgreSQL connection string of the database to migrate (DATABASE_URL)"`
+	// DevURL is an empty database diff replays the migrations on to find
+	// what the ent schema changes
+	DevURL Secret `yaml:"dev_url" env:"MIGRATE_DEV_DATABASE_URL" flag:"dev-url" usage:"Empty PostgreSQL database to plan new migrations on (MIGRATE_DEV_DATABASE_URL)"`
+	// Dir is where diff and hash read and write migration files
+	Dir     string `yaml:"dir" flag:"dir" usage:"Migration directory"`
+	Steps   int    `yaml:"steps" flag:"steps" usage:"Number of migrations to apply or revert, 0 to apply all"`
+	Version int64  `yaml:"version" flag:"version" usage:"Migration version to baseline to"`
+	Name    string `yaml:"name" flag:"name" usage:"Name of the migration to generate"`
+}
+
+// DefaultMigrateDB returns the default schema migration configuration
+func DefaultMigrateDB() MigrateDB {
+	return MigrateDB{
+		Dir: dbmigrate.Dir,
+	}
+}
+
+// Validate checks settings that depend on each other
+func (c *MigrateDB) Validate() error {
+	if c.Steps < 0 {
+		return errors.New("-steps must not be negative")
+	}
+	return nil
+}
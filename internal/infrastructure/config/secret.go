@@ -0,0 +1,83 @@
+package config
+
+import (
+	"encoding/json"
+	"log/slog"
+	"reflect"
+	"strings"
+)
+
+// redacted replaces the value of a secret that is set
+const redacted = "[REDACTED]"
+
+// Secret is a setting such as a password or key. It prints, logs and
+// encodes as [REDACTED] when set, so it can't leak through a log line or
+// debug output; Reveal returns the value.
+type Secret string
+
+// Reveal returns the secret's value
+func (s Secret) Reveal() string {
+	return string(s)
+}
+
+// String implements fmt.Stringer
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return redacted
+}
+
+// LogValue implements slog.LogValuer
+func (s Secret) LogValue() slog.Value {
+	return slog.StringValue(s.String())
+}
+
+// MarshalJSON implements json.Marshaler
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// Sanitize returns a configuration as a map keyed like its config file,
+// with secrets redacted, for logging and debug endpoints
+func Sanitize(cfg any) map[string]any {
+	v := reflect.ValueOf(cfg)
+	for v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	return sanitize(v)
+}
+
+// sanitize converts a struct to a map, descending into nested structs
+func sanitize(v reflect.Value) map[string]any {
+	out := make(map[string]any)
+	t := v.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		key, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if key == "-" {
+			continue
+		}
+		if key == "" {
+			key = strings.ToLower(field.Name)
+		}
+
+		value := v.Field(i)
+		switch {
+		case value.Kind() == reflect.Struct && value.Type() != durationType:
+			out[key] = sanitize(value)
+		case value.Type() == secretType:
+			out[key] = value.Interface().(Secret).String()
+		case value.Type() == durationType:
+			out[key] = format(value)
+		default:
+			out[key] = value.Interface()
+		}
+	}
+	return out
+}
+
+var secretType = reflect.TypeFor[Secret]()
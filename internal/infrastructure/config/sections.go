@@ -0,0 +1,232 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"clockzen-next/internal/infrastructure/encryption"
+	"clockzen-next/internal/infrastructure/google"
+	"clockzen-next/internal/infrastructure/logging"
+	"clockzen-next/internal/infrastructure/observability"
+	"clockzen-next/internal/infrastructure/tracing"
+)
+
+// Server configures an HTTP listener
+type Server struct {
+	Port string `yaml:"port" env:"PORT" flag:"port" usage:"Port to listen on (PORT)"`
+}
+
+// Database configures the application database and its connection pool
+type Database struct {
+	URL Secret `yaml:"url" env:"DATABASE_URL" usage:"PostgreSQL connection string"`
+	// SlowQueryThreshold is the duration above which a query is logged
+	SlowQueryThreshold time.Duration `yaml:"slow_query_threshold" env:"SLOW_QUERY_THRESHOLD"`
+	// MaxOpenConns caps the connections open at once, 0 for no limit
+	MaxOpenConns    int           `yaml:"max_open_conns" env:"DB_MAX_OPEN_CONNS"`
+	MaxIdleConns    int           `yaml:"max_idle_conns" env:"DB_MAX_IDLE_CONNS"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime" env:"DB_CONN_MAX_LIFETIME"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time" env:"DB_CONN_MAX_IDLE_TIME"`
+	// StatementTimeout cancels statements that run longer, 0 for no limit
+	StatementTimeout time.Duration `yaml:"statement_timeout" env:"DB_STATEMENT_TIMEOUT"`
+	// AutoMigrate applies pending schema migrations at startup. Production
+	// runs `migratedb up` before deploying instead.
+	AutoMigrate bool `yaml:"auto_migrate" env:"DB_AUTO_MIGRATE" flag:"auto-migrate" usage:"Apply pending database migrations at startup (DB_AUTO_MIGRATE)"`
+}
+
+// defaultDatabase returns the default database configuration
+func defaultDatabase() Database {
+	pool := observability.DefaultPoolConfig()
+	return Database{
+		SlowQueryThreshold: observability.DefaultSlowQueryConfig().Threshold,
+		MaxOpenConns:       pool.MaxOpenConns,
+		MaxIdleConns:       pool.MaxIdleConns,
+		ConnMaxLifetime:    pool.ConnMaxLifetime,
+		ConnMaxIdleTime:    pool.ConnMaxIdleTime,
+		StatementTimeout:   pool.StatementTimeout,
+	}
+}
+
+// Pool returns the connection pool configuration
+func (d Database) Pool() observability.PoolConfig {
+	return observability.PoolConfig{
+		MaxOpenConns:     d.MaxOpenConns,
+		MaxIdleConns:     d.MaxIdleConns,
+		ConnMaxLifetime:  d.ConnMaxLifetime,
+		ConnMaxIdleTime:  d.ConnMaxIdleTime,
+		StatementTimeout: d.StatementTimeout,
+	}
+}
+
+// validate checks the pool settings aren't negative
+func (d Database) validate() error {
+	if d.MaxOpenConns < 0 || d.MaxIdleConns < 0 || d.ConnMaxLifetime < 0 || d.ConnMaxIdleTime < 0 || d.StatementTimeout < 0 {
+		return errors.New("database pool settings must not be negative")
+	}
+	return nil
+}
+
+// Logging configures the process logger
+type Logging struct {
+	// Format is "text" or "json"
+	Format string `yaml:"format" env:"LOG_FORMAT" flag:"log-format" usage:"Log format: text or json (LOG_FORMAT)"`
+	// Level is "debug", "info", "warn" or "error"
+	Level string `yaml:"level" env:"LOG_LEVEL" flag:"log-level" usage:"Minimum log level: debug, info, warn or error (LOG_LEVEL)"`
+}
+
+// defaultLogging returns the default logger configuration
+func defaultLogging() Logging {
+	defaults := logging.DefaultConfig()
+	return Logging{
+		Format: defaults.Format,
+		Level:  "info",
+	}
+}
+
+// Config returns the logger configuration of the service
+func (l Logging) Config(service string) (logging.Config, error) {
+	config := logging.DefaultConfig()
+	config.Service = service
+	if !slices.Contains([]string{logging.FormatText, logging.FormatJSON}, l.Format) {
+		return config, fmt.Errorf("unknown log format %q, expected text or json", l.Format)
+	}
+	config.Format = l.Format
+	if l.Level != "" {
+		level, err := logging.ParseLevel(l.Level)
+		if err != nil {
+			return config, err
+		}
+		config.Level = level
+	}
+	return config, nil
+}
+
+// Tracing configures span export
+type Tracing struct {
+	// Endpoint is the host:port of an OTLP/HTTP collector; spans are only
+	// exported when it is set
+	Endpoint    string  `yaml:"endpoint" env:"TRACING_ENDPOINT"`
+	Insecure    bool    `yaml:"insecure" env:"TRACING_INSECURE"`
+	SampleRatio float64 `yaml:"sample_ratio" env:"TRACING_SAMPLE_RATIO"`
+}
+
+// defaultTracing returns the default tracing configuration
+func defaultTracing() Tracing {
+	return Tracing{
+		SampleRatio: tracing.DefaultConfig().SampleRatio,
+	}
+}
+
+// Config returns the tracing configuration of the service
+func (t Tracing) Config(service string) tracing.Config {
+	config := tracing.DefaultConfig()
+	config.ServiceName = service
+	config.Endpoint = t.Endpoint
+	config.Insecure = t.Insecure
+	config.SampleRatio = t.SampleRatio
+	return config
+}
+
+// validate checks the sample ratio is a fraction
+func (t Tracing) validate() error {
+	if t.SampleRatio < 0 || t.SampleRatio > 1 {
+		return fmt.Errorf("tracing sample ratio must be between 0 and 1, got %g", t.SampleRatio)
+	}
+	return nil
+}
+
+// Google configures the OAuth client used for Gmail and Drive
+type Google struct {
+	ClientID     string `yaml:"client_id" env:"GOOGLE_CLIENT_ID"`
+	ClientSecret Secret `yaml:"client_secret" env:"GOOGLE_CLIENT_SECRET"`
+	RedirectURL  string `yaml:"redirect_url" env:"GOOGLE_REDIRECT_URL"`
+	// LoginRedirectURL is the callback of signing in with Google; Google
+	// sign in is disabled when it is empty
+	LoginRedirectURL string `yaml:"login_redirect_url" env:"GOOGLE_LOGIN_REDIRECT_URL"`
+}
+
+// Config returns the OAuth configuration
+func (g Google) Config() *google.Config {
+	return &google.Config{
+		ClientID:     g.ClientID,
+		ClientSecret: g.ClientSecret.Reveal(),
+		RedirectURL:  g.RedirectURL,
+	}
+}
+
+// LoginConfig returns the OAuth configuration for signing in with Google
+func (g Google) LoginConfig() *google.Config {
+	return &google.Config{
+		ClientID:     g.ClientID,
+		ClientSecret: g.ClientSecret.Reveal(),
+		RedirectURL:  g.LoginRedirectURL,
+		Scopes:       google.DefaultScopes(),
+	}
+}
+
+// Encryption configures the keys OAuth tokens and webhook secrets are
+// encrypted with at rest
+type Encryption struct {
+	// TokenKeys is "id:base64key[,id:base64key...]"; the first key
+	// encrypts new values
+	TokenKeys Secret `yaml:"token_keys" env:"TOKEN_ENCRYPTION_KEYS"`
+}
+
+// Keyring parses the keys into a keyring
+func (e Encryption) Keyring() (*encryption.Keyring, error) {
+	keys, err := encryption.ParseKeys(e.TokenKeys.Reveal())
+	if err != nil {
+		return nil, err
+	}
+	return encryption.NewKeyring(keys)
+}
+
+// validate checks the keys parse, if there are any
+func (e Encryption) validate() error {
+	if e.TokenKeys == "" {
+		return nil
+	}
+	if _, err := e.Keyring(); err != nil {
+		return fmt.Errorf("TOKEN_ENCRYPTION_KEYS: %w", err)
+	}
+	return nil
+}
+
+// Mail providers
+const (
+	MailProviderSMTP     = "smtp"
+	MailProviderSendGrid = "sendgrid"
+)
+
+// Mail configures the email provider. Without a provider, emails are
+// logged.
+type Mail struct {
+	// Provider is "smtp", "sendgrid" or empty
+	Provider       string `yaml:"provider" env:"MAIL_PROVIDER"`
+	From           string `yaml:"from" env:"MAIL_FROM"`
+	SMTPHost       string `yaml:"smtp_host" env:"SMTP_HOST"`
+	SMTPPort       int    `yaml:"smtp_port" env:"SMTP_PORT"`
+	SMTPUsername   string `yaml:"smtp_username" env:"SMTP_USERNAME"`
+	SMTPPassword   Secret `yaml:"smtp_password" env:"SMTP_PASSWORD"`
+	SendGridAPIKey Secret `yaml:"sendgrid_api_key" env:"SENDGRID_API_KEY"`
+}
+
+// validate checks the provider has the settings it needs
+func (m Mail) validate() error {
+	switch m.Provider {
+	case "":
+		return nil
+	case MailProviderSMTP:
+		if m.From == "" || m.SMTPHost == "" {
+			return errors.New("MAIL_FROM and SMTP_HOST are required for the smtp mail provider")
+		}
+	case MailProviderSendGrid:
+		if m.From == "" || m.SendGridAPIKey == "" {
+			return errors.New("MAIL_FROM and SENDGRID_API_KEY are required for the sendgrid mail provider")
+		}
+	default:
+		return fmt.Errorf("unknown mail provider %q, expected smtp or sendgrid", m.Provider)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"clockzen-next/internal/application/notifications"
+	"clockzen-next/internal/infrastructure/queue"
+	"clockzen-next/internal/infrastructure/worker"
+)
+
+// Worker is the configuration of the background worker
+type Worker struct {
+	Server   Server   `yaml:"server"`
+	Database Database `yaml:"database"`
+	Logging  Logging  `yaml:"logging"`
+	Tracing  Tracing  `yaml:"tracing"`
+	Google   Google   `yaml:"google"`
+	// Encryption decrypts the OAuth tokens the API server stored
+	Encryption  Encryption  `yaml:"encryption"`
+	Queue       Queue       `yaml:"queue"`
+	EmailWorker EmailWorker `yaml:"email_worker"`
+	DriveWorker DriveWorker `yaml:"drive_worker"`
+	Intervals   Intervals   `yaml:"intervals"`
+	Mail        Mail        `yaml:"mail"`
+	// NotificationSyncErrorThreshold is how many syncs of a connection in a
+	// row must fail before the user is notified
+	NotificationSyncErrorThreshold int `yaml:"notification_sync_error_threshold" env:"NOTIFICATION_SYNC_ERROR_THRESHOLD"`
+	// AttachmentStorageDir keeps downloaded attachment content on disk
+	AttachmentStorageDir string `yaml:"attachment_storage_dir" env:"ATTACHMENT_STORAGE_DIR"`
+	// AdminToken enables the worker admin endpoints
+	AdminToken Secret `yaml:"admin_token" env:"WORKER_ADMIN_TOKEN"`
+}
+
+// Queue configures the database-backed job queue
+type Queue struct {
+	PollInterval time.Duration `yaml:"poll_interval" env:"JOB_POLL_INTERVAL"`
+	RescueAfter  time.Duration `yaml:"rescue_after" env:"JOB_RESCUE_AFTER"`
+}
+
+// Config returns the job queue configuration
+func (q Queue) Config() queue.Config {
+	config := queue.DefaultConfig()
+	config.PollInterval = q.PollInterval
+	config.RescueAfter = q.RescueAfter
+	return config
+}
+
+// EmailWorker sizes the email import worker pool and its backpressure
+type EmailWorker struct {
+	Concurrency   int `yaml:"concurrency" env:"EMAIL_WORKER_CONCURRENCY" flag:"email-concurrency" usage:"Email import tasks run at once (EMAIL_WORKER_CONCURRENCY)"`
+	MaxQueued     int `yaml:"max_queued" env:"EMAIL_WORKER_MAX_QUEUED" flag:"email-max-queued" usage:"Email import tasks that may wait in the queue before new ones are rejected, 0 for no limit (EMAIL_WORKER_MAX_QUEUED)"`
+	PerConnection int `yaml:"per_connection" env:"EMAIL_WORKER_PER_CONNECTION" flag:"email-per-connection" usage:"Email import tasks of one connection run at once, 0 for no limit (EMAIL_WORKER_PER_CONNECTION)"`
+}
+
+// DriveWorker sizes the drive sync worker pool and its backpressure
+type DriveWorker struct {
+	Concurrency   int `yaml:"concurrency" env:"DRIVE_WORKER_CONCURRENCY" flag:"drive-concurrency" usage:"Drive sync tasks run at once (DRIVE_WORKER_CONCURRENCY)"`
+	MaxQueued     int `yaml:"max_queued" env:"DRIVE_WORKER_MAX_QUEUED" flag:"drive-max-queued" usage:"Drive sync tasks that may wait in the queue before new ones are rejected, 0 for no limit (DRIVE_WORKER_MAX_QUEUED)"`
+	PerConnection int `yaml:"per_connection" env:"DRIVE_WORKER_PER_CONNECTION" flag:"drive-per-connection" usage:"Drive sync tasks of one connection run at once, 0 for no limit (DRIVE_WORKER_PER_CONNECTION)"`
+}
+
+// Intervals are how often the periodic workers run
+type Intervals struct {
+	SyncSchedule  time.Duration `yaml:"sync_schedule" env:"SYNC_SCHEDULE_CHECK_INTERVAL"`
+	EmergencyFund time.Duration `yaml:"emergency_fund" env:"EMERGENCY_FUND_CHECK_INTERVAL"`
+	Alerts        time.Duration `yaml:"alerts" env:"ALERT_CHECK_INTERVAL"`
+	Webhooks      time.Duration `yaml:"webhooks" env:"WEBHOOK_POLL_INTERVAL"`
+	Notifications time.Duration `yaml:"notifications" env:"NOTIFICATION_CHECK_INTERVAL"`
+	TokenRefresh  time.Duration `yaml:"token_refresh" env:"TOKEN_REFRESH_INTERVAL"`
+}
+
+// DefaultWorker returns the default worker configuration
+func DefaultWorker() Worker {
+	email := worker.DefaultEmailImportWorkerConfig()
+	drive := worker.DefaultDriveSyncWorkerConfig()
+	queueConfig := queue.DefaultConfig()
+	return Worker{
+		Server:   Server{Port: "8081"},
+		Database: defaultDatabase(),
+		Logging:  defaultLogging(),
+		Tracing:  defaultTracing(),
+		Queue: Queue{
+			PollInterval: queueConfig.PollInterval,
+			RescueAfter:  queueConfig.RescueAfter,
+		},
+		EmailWorker: EmailWorker{
+			Concurrency:   email.MaxConcurrentTasks,
+			MaxQueued:     email.MaxQueuedTasks,
+			PerConnection: email.MaxTasksPerConnection,
+		},
+		DriveWorker: DriveWorker{
+			Concurrency:   drive.MaxConcurrentTasks,
+			MaxQueued:     drive.MaxQueuedTasks,
+			PerConnection: drive.MaxTasksPerConnection,
+		},
+		Intervals: Intervals{
+			SyncSchedule:  worker.DefaultSyncSchedulerConfig().CheckInterval,
+			EmergencyFund: worker.DefaultEmergencyFundMonitorConfig().CheckInterval,
+			Alerts:        worker.DefaultAlertEngineConfig().CheckInterval,
+			Webhooks:      worker.DefaultWebhookDispatcherConfig().PollInterval,
+			Notifications: worker.DefaultNotificationMonitorConfig().CheckInterval,
+			TokenRefresh:  worker.DefaultTokenRefresherConfig().CheckInterval,
+		},
+		Mail: Mail{
+			SMTPPort: 587,
+		},
+		NotificationSyncErrorThreshold: notifications.DefaultConfig().SyncErrorThreshold,
+	}
+}
+
+// EmailWorkerConfig returns the email import worker configuration
+func (c *Worker) EmailWorkerConfig() worker.EmailImportWorkerConfig {
+	config := worker.DefaultEmailImportWorkerConfig()
+	config.MaxConcurrentTasks = c.EmailWorker.Concurrency
+	config.MaxQueuedTasks = c.EmailWorker.MaxQueued
+	config.MaxTasksPerConnection = c.EmailWorker.PerConnection
+	return config
+}
+
+// DriveWorkerConfig returns the drive sync worker configuration
+func (c *Worker) DriveWorkerConfig() worker.DriveSyncWorkerConfig {
+	config := worker.DefaultDriveSyncWorkerConfig()
+	config.MaxConcurrentTasks = c.DriveWorker.Concurrency
+	config.MaxQueuedTasks = c.DriveWorker.MaxQueued
+	config.MaxTasksPerConnection = c.DriveWorker.PerConnection
+	return config
+}
+
+// Validate checks settings that depend on each other
+func (c *Worker) Validate() error {
+	// The worker syncs connections from the database, decrypting their
+	// tokens, so it can't run without either
+	var missing []string
+	if c.Database.URL == "" {
+		missing = append(missing, "DATABASE_URL")
+	}
+	if c.Encryption.TokenKeys == "" {
+		missing = append(missing, "TOKEN_ENCRYPTION_KEYS")
+	}
+	if len(missing) > 0 {
+		return &MissingError{Settings: missing}
+	}
+
+	var errs []error
+	if _, err := c.Logging.Config(""); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.Database.validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.Tracing.validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.Encryption.validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.EmailWorkerConfig().Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("email worker: %w", err))
+	}
+	if err := c.DriveWorkerConfig().Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("drive worker: %w", err))
+	}
+	if err := c.Mail.validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
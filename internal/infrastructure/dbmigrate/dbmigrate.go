@@ -0,0 +1,392 @@
+// Package dbmigrate applies the versioned schema migrations in migrations/
+// to a Postgres database. Migration files use the golang-migrate layout,
+// <version>_<name>.up.sql and <version>_<name>.down.sql, and are generated
+// from the ent schema with `migratedb diff`. Applied versions are recorded in
+// the schema_migrations table. Each migration runs in its own transaction,
+// and runs are serialized across processes by an advisory lock.
+package dbmigrate
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Migration errors
+var (
+	ErrInvalidMigration = errors.New("invalid migration file")
+	ErrUnknownVersion   = errors.New("applied migration has no file")
+	ErrAlreadyMigrated  = errors.New("database already has migrations applied")
+	ErrVersionNotFound  = errors.New("migration version not found")
+)
+
+// TableName is the table applied migrations are recorded in
+const TableName = "schema_migrations"
+
+// lockID is the Postgres advisory lock held while migrating, so processes
+// started together don't apply the same migration twice
+const lockID int64 = 4_322_061_017
+
+// Migration is a versioned schema change
+type Migration struct {
+	Version int64
+	Name    string
+	// Up applies the change and Down reverts it
+	Up   string
+	Down string
+}
+
+// Status is a migration and whether it has been applied
+type Status struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+	// Missing is set for applied versions with no migration file, such as
+	// those applied by a newer release
+	Missing bool
+}
+
+// Load reads the migrations of fsys, sorted by version. Every migration must
+// have an up and a down file.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		filename := entry.Name()
+		if entry.IsDir() || path.Ext(filename) != ".sql" {
+			continue
+		}
+		base, direction, ok := cutDirection(strings.TrimSuffix(filename, ".sql"))
+		if !ok {
+			return nil, fmt.Errorf("%w: %s: expected <version>_<name>.up.sql or .down.sql", ErrInvalidMigration, filename)
+		}
+		rawVersion, name, _ := strings.Cut(base, "_")
+		version, err := strconv.ParseInt(rawVersion, 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("%w: %s: version must be a positive number", ErrInvalidMigration, filename)
+		}
+		content, err := fs.ReadFile(fsys, filename)
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: name}
+			byVersion[version] = m
+		} else if m.Name != name {
+			return nil, fmt.Errorf("%w: version %d is used by %q and %q", ErrInvalidMigration, version, m.Name, name)
+		}
+		if direction == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("%w: version %d needs both an up and a down file", ErrInvalidMigration, m.Version)
+		}
+		migrations = append(migrations, *m)
+	}
+	slices.SortFunc(migrations, func(a, b Migration) int {
+		return cmp.Compare(a.Version, b.Version)
+	})
+	return migrations, nil
+}
+
+// cutDirection splits "name.up" or "name.down" into the name and direction
+func cutDirection(name string) (string, string, bool) {
+	if base, ok := strings.CutSuffix(name, ".up"); ok {
+		return base, "up", true
+	}
+	if base, ok := strings.CutSuffix(name, ".down"); ok {
+		return base, "down", true
+	}
+	return "", "", false
+}
+
+// Migrator applies and reverts migrations
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// New creates a Migrator for the migrations of fsys
+func New(db *sql.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+// NewWithDefaults creates a Migrator for the migrations built into the
+// binary
+func NewWithDefaults(db *sql.DB) (*Migrator, error) {
+	return New(db, Files())
+}
+
+// Migrations returns the known migrations, sorted by version
+func (m *Migrator) Migrations() []Migration {
+	return slices.Clone(m.migrations)
+}
+
+// Up applies up to steps pending migrations in version order, or all of
+// them if steps is zero, and returns those applied
+func (m *Migrator) Up(ctx context.Context, steps int) ([]Migration, error) {
+	var applied []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		versions, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			if steps > 0 && len(applied) == steps {
+				break
+			}
+			if _, ok := versions[migration.Version]; ok {
+				continue
+			}
+			if err := run(ctx, conn, migration.Up,
+				`INSERT INTO `+TableName+` (version, name) VALUES ($1, $2)`, migration.Version, migration.Name); err != nil {
+				return fmt.Errorf("applying migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down reverts the last steps applied migrations, newest first, and returns
+// those reverted. A steps of zero reverts one.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	if steps <= 0 {
+		steps = 1
+	}
+	var reverted []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		versions, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		applied := make([]int64, 0, len(versions))
+		for version := range versions {
+			applied = append(applied, version)
+		}
+		slices.Sort(applied)
+		slices.Reverse(applied)
+
+		for _, version := range applied[:min(steps, len(applied))] {
+			migration, ok := m.find(version)
+			if !ok {
+				return fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+			}
+			if err := run(ctx, conn, migration.Down,
+				`DELETE FROM `+TableName+` WHERE version = $1`, migration.Version); err != nil {
+				return fmt.Errorf("reverting migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+			reverted = append(reverted, migration)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Baseline records the migrations up to and including version as applied
+// without running them, for databases whose schema was created before
+// versioned migrations. It fails if any migration is already recorded.
+func (m *Migrator) Baseline(ctx context.Context, version int64) ([]Migration, error) {
+	if _, ok := m.find(version); !ok {
+		return nil, fmt.Errorf("%w: %d", ErrVersionNotFound, version)
+	}
+	var recorded []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		versions, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if len(versions) > 0 {
+			return ErrAlreadyMigrated
+		}
+		for _, migration := range m.migrations {
+			if migration.Version > version {
+				break
+			}
+			if _, err := conn.ExecContext(ctx,
+				`INSERT INTO `+TableName+` (version, name) VALUES ($1, $2)`, migration.Version, migration.Name); err != nil {
+				return err
+			}
+			recorded = append(recorded, migration)
+		}
+		return nil
+	})
+	return recorded, err
+}
+
+// Status lists every migration and whether it has been applied, including
+// applied versions with no migration file
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	rows, err := m.appliedRows(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Version: migration.Version, Name: migration.Name}
+		if row, ok := rows[migration.Version]; ok {
+			status.Applied = true
+			status.AppliedAt = row.AppliedAt
+			delete(rows, migration.Version)
+		}
+		statuses = append(statuses, status)
+	}
+	for _, row := range rows {
+		statuses = append(statuses, row)
+	}
+	slices.SortFunc(statuses, func(a, b Status) int {
+		return cmp.Compare(a.Version, b.Version)
+	})
+	return statuses, nil
+}
+
+// Pending returns the migrations not yet applied
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	rows, err := m.appliedRows(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if _, ok := rows[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// find returns the migration with the given version
+func (m *Migrator) find(version int64) (Migration, bool) {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return migration, true
+		}
+	}
+	return Migration{}, false
+}
+
+// appliedRows reads the applied migrations without creating the table, so
+// status can be checked with read-only credentials
+func (m *Migrator) appliedRows(ctx context.Context) (map[int64]Status, error) {
+	var exists bool
+	if err := m.db.QueryRowContext(ctx, `SELECT to_regclass($1) IS NOT NULL`, TableName).Scan(&exists); err != nil {
+		return nil, err
+	}
+	applied := make(map[int64]Status)
+	if !exists {
+		return applied, nil
+	}
+
+	rows, err := m.db.QueryContext(ctx, `SELECT version, name, applied_at FROM `+TableName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		status := Status{Applied: true, Missing: true}
+		if err := rows.Scan(&status.Version, &status.Name, &status.AppliedAt); err != nil {
+			return nil, err
+		}
+		if _, ok := m.find(status.Version); ok {
+			status.Missing = false
+		}
+		applied[status.Version] = status
+	}
+	return applied, rows.Err()
+}
+
+// withLock runs fn on a connection holding the migration lock, once the
+// migrations table exists
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, lockID); err != nil {
+		return fmt.Errorf("acquiring migration lock: %w", err)
+	}
+	// The lock is released on a fresh context so a cancelled run doesn't
+	// leave it held by the pooled connection
+	defer conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, lockID)
+
+	if _, err := conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+TableName+` (
+		version bigint PRIMARY KEY,
+		name text NOT NULL,
+		applied_at timestamptz NOT NULL DEFAULT now()
+	)`); err != nil {
+		return fmt.Errorf("creating %s: %w", TableName, err)
+	}
+	return fn(conn)
+}
+
+// appliedVersions returns the versions recorded as applied
+func appliedVersions(ctx context.Context, conn *sql.Conn) (map[int64]struct{}, error) {
+	rows, err := conn.QueryContext(ctx, `SELECT version FROM `+TableName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	versions := make(map[int64]struct{})
+	for rows.Next() {
+		var version int64
+		if err := rows.Scan(&version); err != nil {
+			return nil, err
+		}
+		versions[version] = struct{}{}
+	}
+	return versions, rows.Err()
+}
+
+// run executes a migration script and records it in one transaction
+func run(ctx context.Context, conn *sql.Conn, script, record string, args ...any) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Building indexes on large tables can outlast the pool's statement
+	// timeout, which is meant for application queries
+	if _, err := tx.ExecContext(ctx, `SET LOCAL statement_timeout = 0`); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
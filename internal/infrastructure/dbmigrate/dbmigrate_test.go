@@ -0,0 +1,66 @@
+package dbmigrate
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/sqltool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	fsys := fstest.MapFS{
+		"20240102000000_add_goals.up.sql":   {Data: []byte("CREATE TABLE goals ();")},
+		"20240102000000_add_goals.down.sql": {Data: []byte("DROP TABLE goals;")},
+		"20240101000000_initial.up.sql":     {Data: []byte("CREATE TABLE users ();")},
+		"20240101000000_initial.down.sql":   {Data: []byte("DROP TABLE users;")},
+		"atlas.sum":                         {Data: []byte("h1:...")},
+	}
+
+	migrations, err := Load(fsys)
+	require.NoError(t, err)
+
+	require.Len(t, migrations, 2)
+	assert.Equal(t, int64(20240101000000), migrations[0].Version)
+	assert.Equal(t, "initial", migrations[0].Name)
+	assert.Equal(t, "CREATE TABLE users ();", migrations[0].Up)
+	assert.Equal(t, "DROP TABLE users;", migrations[0].Down)
+	assert.Equal(t, "add_goals", migrations[1].Name)
+}
+
+func TestLoadInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		files fstest.MapFS
+	}{
+		{"no direction", fstest.MapFS{"1_initial.sql": {Data: []byte("x")}}},
+		{"bad version", fstest.MapFS{"first_initial.up.sql": {Data: []byte("x")}}},
+		{"missing down", fstest.MapFS{"1_initial.up.sql": {Data: []byte("x")}}},
+		{"version reused", fstest.MapFS{
+			"1_initial.up.sql":   {Data: []byte("x")},
+			"1_initial.down.sql": {Data: []byte("x")},
+			"1_other.up.sql":     {Data: []byte("x")},
+			"1_other.down.sql":   {Data: []byte("x")},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(tt.files)
+			assert.ErrorIs(t, err, ErrInvalidMigration)
+		})
+	}
+}
+
+func TestBuiltInMigrations(t *testing.T) {
+	migrations, err := Load(Files())
+	require.NoError(t, err)
+	assert.NotEmpty(t, migrations)
+
+	// atlas.sum guards against migrations edited after they were generated
+	// or merged out of order
+	dir, err := sqltool.NewGolangMigrateDir("migrations")
+	require.NoError(t, err)
+	assert.NoError(t, migrate.Validate(dir), "atlas.sum is out of date; regenerate it with `migratedb hash`")
+}
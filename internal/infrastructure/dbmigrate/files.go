@@ -0,0 +1,22 @@
+package dbmigrate
+
+import (
+	"embed"
+	"io/fs"
+)
+
+// Dir is the migration directory, relative to the module root, that
+// `migratedb diff` writes new migrations to
+const Dir = "internal/infrastructure/dbmigrate/migrations"
+
+//go:embed migrations/*.sql
+var files embed.FS
+
+// Files returns the migrations built into the binary
+func Files() fs.FS {
+	sub, err := fs.Sub(files, "migrations")
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
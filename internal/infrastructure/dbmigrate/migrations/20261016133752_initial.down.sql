@@ -0,0 +1,326 @@
+-- reverse: create index "transaction_created_at" to table: "transactions"
+DROP INDEX "transaction_created_at";
+-- reverse: create index "transaction_migration_run_id" to table: "transactions"
+DROP INDEX "transaction_migration_run_id";
+-- reverse: create index "transaction_legacy_id" to table: "transactions"
+DROP INDEX "transaction_legacy_id";
+-- reverse: create index "transaction_merchant_id" to table: "transactions"
+DROP INDEX "transaction_merchant_id";
+-- reverse: create index "transaction_merchant_name" to table: "transactions"
+DROP INDEX "transaction_merchant_name";
+-- reverse: create index "transaction_user_id_source" to table: "transactions"
+DROP INDEX "transaction_user_id_source";
+-- reverse: create index "transaction_user_id_transaction_date" to table: "transactions"
+DROP INDEX "transaction_user_id_transaction_date";
+-- reverse: create index "transaction_transaction_date" to table: "transactions"
+DROP INDEX "transaction_transaction_date";
+-- reverse: create index "transaction_status" to table: "transactions"
+DROP INDEX "transaction_status";
+-- reverse: create index "transaction_type" to table: "transactions"
+DROP INDEX "transaction_type";
+-- reverse: create index "transaction_user_id" to table: "transactions"
+DROP INDEX "transaction_user_id";
+-- reverse: create index "transaction_receipt_id" to table: "transactions"
+DROP INDEX "transaction_receipt_id";
+-- reverse: create "transactions" table
+DROP TABLE "transactions";
+-- reverse: create index "merchants_normalized_key_key" to table: "merchants"
+DROP INDEX "merchants_normalized_key_key";
+-- reverse: create "merchants" table
+DROP TABLE "merchants";
+-- reverse: create index "pipelineversion_created_at" to table: "pipeline_versions"
+DROP INDEX "pipelineversion_created_at";
+-- reverse: create index "pipelineversion_config_id_is_current" to table: "pipeline_versions"
+DROP INDEX "pipelineversion_config_id_is_current";
+-- reverse: create index "pipelineversion_config_id_version_number" to table: "pipeline_versions"
+DROP INDEX "pipelineversion_config_id_version_number";
+-- reverse: create index "pipelineversion_is_current" to table: "pipeline_versions"
+DROP INDEX "pipelineversion_is_current";
+-- reverse: create index "pipelineversion_status" to table: "pipeline_versions"
+DROP INDEX "pipelineversion_status";
+-- reverse: create index "pipelineversion_version_number" to table: "pipeline_versions"
+DROP INDEX "pipelineversion_version_number";
+-- reverse: create index "pipelineversion_config_id" to table: "pipeline_versions"
+DROP INDEX "pipelineversion_config_id";
+-- reverse: create "pipeline_versions" table
+DROP TABLE "pipeline_versions";
+-- reverse: create index "pipelinerule_created_at" to table: "pipeline_rules"
+DROP INDEX "pipelinerule_created_at";
+-- reverse: create index "pipelinerule_config_id_priority" to table: "pipeline_rules"
+DROP INDEX "pipelinerule_config_id_priority";
+-- reverse: create index "pipelinerule_priority" to table: "pipeline_rules"
+DROP INDEX "pipelinerule_priority";
+-- reverse: create index "pipelinerule_enabled" to table: "pipeline_rules"
+DROP INDEX "pipelinerule_enabled";
+-- reverse: create index "pipelinerule_rule_type" to table: "pipeline_rules"
+DROP INDEX "pipelinerule_rule_type";
+-- reverse: create index "pipelinerule_config_id" to table: "pipeline_rules"
+DROP INDEX "pipelinerule_config_id";
+-- reverse: create index "pipelinerule_user_id" to table: "pipeline_rules"
+DROP INDEX "pipelinerule_user_id";
+-- reverse: create "pipeline_rules" table
+DROP TABLE "pipeline_rules";
+-- reverse: create index "pipelineconfig_created_at" to table: "pipeline_configs"
+DROP INDEX "pipelineconfig_created_at";
+-- reverse: create index "pipelineconfig_user_id_is_default" to table: "pipeline_configs"
+DROP INDEX "pipelineconfig_user_id_is_default";
+-- reverse: create index "pipelineconfig_user_id_pipeline_type" to table: "pipeline_configs"
+DROP INDEX "pipelineconfig_user_id_pipeline_type";
+-- reverse: create index "pipelineconfig_is_default" to table: "pipeline_configs"
+DROP INDEX "pipelineconfig_is_default";
+-- reverse: create index "pipelineconfig_enabled" to table: "pipeline_configs"
+DROP INDEX "pipelineconfig_enabled";
+-- reverse: create index "pipelineconfig_trigger_type" to table: "pipeline_configs"
+DROP INDEX "pipelineconfig_trigger_type";
+-- reverse: create index "pipelineconfig_pipeline_type" to table: "pipeline_configs"
+DROP INDEX "pipelineconfig_pipeline_type";
+-- reverse: create index "pipelineconfig_user_id" to table: "pipeline_configs"
+DROP INDEX "pipelineconfig_user_id";
+-- reverse: create "pipeline_configs" table
+DROP TABLE "pipeline_configs";
+-- reverse: create index "lineitem_migration_run_id" to table: "line_items"
+DROP INDEX "lineitem_migration_run_id";
+-- reverse: create index "lineitem_legacy_id" to table: "line_items"
+DROP INDEX "lineitem_legacy_id";
+-- reverse: create index "lineitem_category" to table: "line_items"
+DROP INDEX "lineitem_category";
+-- reverse: create index "lineitem_product_code" to table: "line_items"
+DROP INDEX "lineitem_product_code";
+-- reverse: create index "lineitem_sku" to table: "line_items"
+DROP INDEX "lineitem_sku";
+-- reverse: create index "lineitem_receipt_id_line_number" to table: "line_items"
+DROP INDEX "lineitem_receipt_id_line_number";
+-- reverse: create index "lineitem_receipt_id" to table: "line_items"
+DROP INDEX "lineitem_receipt_id";
+-- reverse: create "line_items" table
+DROP TABLE "line_items";
+-- reverse: create index "receipt_created_at" to table: "receipts"
+DROP INDEX "receipt_created_at";
+-- reverse: create index "receipt_migration_run_id" to table: "receipts"
+DROP INDEX "receipt_migration_run_id";
+-- reverse: create index "receipt_legacy_id" to table: "receipts"
+DROP INDEX "receipt_legacy_id";
+-- reverse: create index "receipt_receipt_date" to table: "receipts"
+DROP INDEX "receipt_receipt_date";
+-- reverse: create index "receipt_merchant_name" to table: "receipts"
+DROP INDEX "receipt_merchant_name";
+-- reverse: create index "receipt_user_id_status" to table: "receipts"
+DROP INDEX "receipt_user_id_status";
+-- reverse: create index "receipt_status" to table: "receipts"
+DROP INDEX "receipt_status";
+-- reverse: create index "receipt_source_id" to table: "receipts"
+DROP INDEX "receipt_source_id";
+-- reverse: create index "receipt_source_type" to table: "receipts"
+DROP INDEX "receipt_source_type";
+-- reverse: create index "receipt_user_id" to table: "receipts"
+DROP INDEX "receipt_user_id";
+-- reverse: create "receipts" table
+DROP TABLE "receipts";
+-- reverse: create index "googledrivesync_created_at" to table: "google_drive_syncs"
+DROP INDEX "googledrivesync_created_at";
+-- reverse: create index "googledrivesync_connection_id_status" to table: "google_drive_syncs"
+DROP INDEX "googledrivesync_connection_id_status";
+-- reverse: create index "googledrivesync_sync_type" to table: "google_drive_syncs"
+DROP INDEX "googledrivesync_sync_type";
+-- reverse: create index "googledrivesync_status" to table: "google_drive_syncs"
+DROP INDEX "googledrivesync_status";
+-- reverse: create index "googledrivesync_connection_id" to table: "google_drive_syncs"
+DROP INDEX "googledrivesync_connection_id";
+-- reverse: create "google_drive_syncs" table
+DROP TABLE "google_drive_syncs";
+-- reverse: create index "googledrivefolder_sync_enabled" to table: "google_drive_folders"
+DROP INDEX "googledrivefolder_sync_enabled";
+-- reverse: create index "googledrivefolder_connection_id_drive_folder_id" to table: "google_drive_folders"
+DROP INDEX "googledrivefolder_connection_id_drive_folder_id";
+-- reverse: create index "googledrivefolder_drive_folder_id" to table: "google_drive_folders"
+DROP INDEX "googledrivefolder_drive_folder_id";
+-- reverse: create index "googledrivefolder_connection_id" to table: "google_drive_folders"
+DROP INDEX "googledrivefolder_connection_id";
+-- reverse: create "google_drive_folders" table
+DROP TABLE "google_drive_folders";
+-- reverse: create index "googledriveconnection_migration_run_id" to table: "google_drive_connections"
+DROP INDEX "googledriveconnection_migration_run_id";
+-- reverse: create index "googledriveconnection_sync_schedule_next_sync_at" to table: "google_drive_connections"
+DROP INDEX "googledriveconnection_sync_schedule_next_sync_at";
+-- reverse: create index "googledriveconnection_status" to table: "google_drive_connections"
+DROP INDEX "googledriveconnection_status";
+-- reverse: create index "googledriveconnection_google_account_id" to table: "google_drive_connections"
+DROP INDEX "googledriveconnection_google_account_id";
+-- reverse: create index "googledriveconnection_user_id" to table: "google_drive_connections"
+DROP INDEX "googledriveconnection_user_id";
+-- reverse: create "google_drive_connections" table
+DROP TABLE "google_drive_connections";
+-- reverse: create index "emailsyncfailure_connection_id" to table: "email_sync_failures"
+DROP INDEX "emailsyncfailure_connection_id";
+-- reverse: create index "emailsyncfailure_sync_id_status" to table: "email_sync_failures"
+DROP INDEX "emailsyncfailure_sync_id_status";
+-- reverse: create index "emailsyncfailure_sync_id_message_id" to table: "email_sync_failures"
+DROP INDEX "emailsyncfailure_sync_id_message_id";
+-- reverse: create "email_sync_failures" table
+DROP TABLE "email_sync_failures";
+-- reverse: create index "emailsync_created_at" to table: "email_syncs"
+DROP INDEX "emailsync_created_at";
+-- reverse: create index "emailsync_connection_id_status" to table: "email_syncs"
+DROP INDEX "emailsync_connection_id_status";
+-- reverse: create index "emailsync_sync_type" to table: "email_syncs"
+DROP INDEX "emailsync_sync_type";
+-- reverse: create index "emailsync_status" to table: "email_syncs"
+DROP INDEX "emailsync_status";
+-- reverse: create index "emailsync_connection_id" to table: "email_syncs"
+DROP INDEX "emailsync_connection_id";
+-- reverse: create "email_syncs" table
+DROP TABLE "email_syncs";
+-- reverse: create index "emaillabel_label_type" to table: "email_labels"
+DROP INDEX "emaillabel_label_type";
+-- reverse: create index "emaillabel_sync_enabled" to table: "email_labels"
+DROP INDEX "emaillabel_sync_enabled";
+-- reverse: create index "emaillabel_connection_id_provider_label_id" to table: "email_labels"
+DROP INDEX "emaillabel_connection_id_provider_label_id";
+-- reverse: create index "emaillabel_provider_label_id" to table: "email_labels"
+DROP INDEX "emaillabel_provider_label_id";
+-- reverse: create index "emaillabel_connection_id" to table: "email_labels"
+DROP INDEX "emaillabel_connection_id";
+-- reverse: create "email_labels" table
+DROP TABLE "email_labels";
+-- reverse: create index "emailconnection_migration_run_id" to table: "email_connections"
+DROP INDEX "emailconnection_migration_run_id";
+-- reverse: create index "emailconnection_provider" to table: "email_connections"
+DROP INDEX "emailconnection_provider";
+-- reverse: create index "emailconnection_sync_schedule_next_sync_at" to table: "email_connections"
+DROP INDEX "emailconnection_sync_schedule_next_sync_at";
+-- reverse: create index "emailconnection_status" to table: "email_connections"
+DROP INDEX "emailconnection_status";
+-- reverse: create index "emailconnection_provider_account_id_provider" to table: "email_connections"
+DROP INDEX "emailconnection_provider_account_id_provider";
+-- reverse: create index "emailconnection_user_id" to table: "email_connections"
+DROP INDEX "emailconnection_user_id";
+-- reverse: create "email_connections" table
+DROP TABLE "email_connections";
+-- reverse: create index "attachmentlink_blob_id" to table: "attachment_links"
+DROP INDEX "attachmentlink_blob_id";
+-- reverse: create index "attachmentlink_connection_id_message_id_blob_id" to table: "attachment_links"
+DROP INDEX "attachmentlink_connection_id_message_id_blob_id";
+-- reverse: create "attachment_links" table
+DROP TABLE "attachment_links";
+-- reverse: create index "liquidaccount_user_id" to table: "liquid_accounts"
+DROP INDEX "liquidaccount_user_id";
+-- reverse: create "liquid_accounts" table
+DROP TABLE "liquid_accounts";
+-- reverse: create index "webhookendpoint_user_id" to table: "webhook_endpoints"
+DROP INDEX "webhookendpoint_user_id";
+-- reverse: create "webhook_endpoints" table
+DROP TABLE "webhook_endpoints";
+-- reverse: create "job_queues" table
+DROP TABLE "job_queues";
+-- reverse: create index "householdmember_user_id" to table: "household_members"
+DROP INDEX "householdmember_user_id";
+-- reverse: create "household_members" table
+DROP TABLE "household_members";
+-- reverse: create index "alert_delivery_status" to table: "alerts"
+DROP INDEX "alert_delivery_status";
+-- reverse: create index "alert_user_id_created_at" to table: "alerts"
+DROP INDEX "alert_user_id_created_at";
+-- reverse: create index "alert_user_id_dedupe_key" to table: "alerts"
+DROP INDEX "alert_user_id_dedupe_key";
+-- reverse: create "alerts" table
+DROP TABLE "alerts";
+-- reverse: create index "webhookdelivery_user_id" to table: "webhook_deliveries"
+DROP INDEX "webhookdelivery_user_id";
+-- reverse: create index "webhookdelivery_endpoint_id_created_at" to table: "webhook_deliveries"
+DROP INDEX "webhookdelivery_endpoint_id_created_at";
+-- reverse: create index "webhookdelivery_status_next_attempt_at" to table: "webhook_deliveries"
+DROP INDEX "webhookdelivery_status_next_attempt_at";
+-- reverse: create "webhook_deliveries" table
+DROP TABLE "webhook_deliveries";
+-- reverse: create index "migrationstate_kind_key" to table: "migration_state"
+DROP INDEX "migrationstate_kind_key";
+-- reverse: create "migration_state" table
+DROP TABLE "migration_state";
+-- reverse: create index "emergencyfundsnapshot_user_id_date" to table: "emergency_fund_snapshots"
+DROP INDEX "emergencyfundsnapshot_user_id_date";
+-- reverse: create "emergency_fund_snapshots" table
+DROP TABLE "emergency_fund_snapshots";
+-- reverse: create index "categoryfeedback_transaction_id" to table: "category_feedbacks"
+DROP INDEX "categoryfeedback_transaction_id";
+-- reverse: create index "categoryfeedback_user_id_created_at" to table: "category_feedbacks"
+DROP INDEX "categoryfeedback_user_id_created_at";
+-- reverse: create "category_feedbacks" table
+DROP TABLE "category_feedbacks";
+-- reverse: create index "notification_user_id_created_at" to table: "notifications"
+DROP INDEX "notification_user_id_created_at";
+-- reverse: create index "notification_user_id_dedupe_key" to table: "notifications"
+DROP INDEX "notification_user_id_dedupe_key";
+-- reverse: create "notifications" table
+DROP TABLE "notifications";
+-- reverse: create index "alert_preferences_user_id_key" to table: "alert_preferences"
+DROP INDEX "alert_preferences_user_id_key";
+-- reverse: create "alert_preferences" table
+DROP TABLE "alert_preferences";
+-- reverse: create index "ocrfeedback_field_created_at" to table: "ocr_feedbacks"
+DROP INDEX "ocrfeedback_field_created_at";
+-- reverse: create index "ocrfeedback_receipt_id_created_at" to table: "ocr_feedbacks"
+DROP INDEX "ocrfeedback_receipt_id_created_at";
+-- reverse: create "ocr_feedbacks" table
+DROP TABLE "ocr_feedbacks";
+-- reverse: create index "emailmessage_connection_id_received_at" to table: "email_messages"
+DROP INDEX "emailmessage_connection_id_received_at";
+-- reverse: create index "emailmessage_connection_id_message_id" to table: "email_messages"
+DROP INDEX "emailmessage_connection_id_message_id";
+-- reverse: create "email_messages" table
+DROP TABLE "email_messages";
+-- reverse: create index "savedfilter_user_id_name" to table: "saved_filters"
+DROP INDEX "savedfilter_user_id_name";
+-- reverse: create "saved_filters" table
+DROP TABLE "saved_filters";
+-- reverse: create index "rounding_rules_user_id_key" to table: "rounding_rules"
+DROP INDEX "rounding_rules_user_id_key";
+-- reverse: create "rounding_rules" table
+DROP TABLE "rounding_rules";
+-- reverse: create index "debt_user_id" to table: "debts"
+DROP INDEX "debt_user_id";
+-- reverse: create "debts" table
+DROP TABLE "debts";
+-- reverse: create index "queuedjob_queue_owner_id" to table: "queued_jobs"
+DROP INDEX "queuedjob_queue_owner_id";
+-- reverse: create index "queuedjob_status_completed_at" to table: "queued_jobs"
+DROP INDEX "queuedjob_status_completed_at";
+-- reverse: create index "queuedjob_status_heartbeat_at" to table: "queued_jobs"
+DROP INDEX "queuedjob_status_heartbeat_at";
+-- reverse: create index "queuedjob_queue_status_run_at" to table: "queued_jobs"
+DROP INDEX "queuedjob_queue_status_run_at";
+-- reverse: create "queued_jobs" table
+DROP TABLE "queued_jobs";
+-- reverse: create index "categorizationrule_user_id_priority" to table: "categorization_rules"
+DROP INDEX "categorizationrule_user_id_priority";
+-- reverse: create "categorization_rules" table
+DROP TABLE "categorization_rules";
+-- reverse: create index "cardaccount_user_id" to table: "card_accounts"
+DROP INDEX "cardaccount_user_id";
+-- reverse: create "card_accounts" table
+DROP TABLE "card_accounts";
+-- reverse: create index "bulkoperation_user_id_created_at" to table: "bulk_operations"
+DROP INDEX "bulkoperation_user_id_created_at";
+-- reverse: create "bulk_operations" table
+DROP TABLE "bulk_operations";
+-- reverse: create index "budgetreallocation_user_id_budget_id_effective_date" to table: "budget_reallocations"
+DROP INDEX "budgetreallocation_user_id_budget_id_effective_date";
+-- reverse: create "budget_reallocations" table
+DROP TABLE "budget_reallocations";
+-- reverse: create index "receiptevent_user_id" to table: "receipt_events"
+DROP INDEX "receiptevent_user_id";
+-- reverse: create index "receiptevent_receipt_id_occurred_at" to table: "receipt_events"
+DROP INDEX "receiptevent_receipt_id_occurred_at";
+-- reverse: create "receipt_events" table
+DROP TABLE "receipt_events";
+-- reverse: create index "attachment_blobs_sha256_key" to table: "attachment_blobs"
+DROP INDEX "attachment_blobs_sha256_key";
+-- reverse: create "attachment_blobs" table
+DROP TABLE "attachment_blobs";
+-- reverse: create index "goal_user_id" to table: "goals"
+DROP INDEX "goal_user_id";
+-- reverse: create "goals" table
+DROP TABLE "goals";
+-- reverse: create index "emergency_fund_targets_user_id_key" to table: "emergency_fund_targets"
+DROP INDEX "emergency_fund_targets_user_id_key";
+-- reverse: create "emergency_fund_targets" table
+DROP TABLE "emergency_fund_targets";
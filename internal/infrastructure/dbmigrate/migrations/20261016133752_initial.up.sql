@@ -0,0 +1,326 @@
+-- create "emergency_fund_targets" table
+CREATE TABLE "emergency_fund_targets" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "target_months" double precision NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "emergency_fund_targets_user_id_key" to table: "emergency_fund_targets"
+CREATE UNIQUE INDEX "emergency_fund_targets_user_id_key" ON "emergency_fund_targets" ("user_id");
+-- create "goals" table
+CREATE TABLE "goals" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "name" character varying NOT NULL, "type" character varying NOT NULL DEFAULT 'savings', "target_amount" double precision NOT NULL, "starting_amount" double precision NOT NULL DEFAULT 0, "deadline" timestamptz NULL, "category" character varying NULL, "account_id" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "goal_user_id" to table: "goals"
+CREATE INDEX "goal_user_id" ON "goals" ("user_id");
+-- create "attachment_blobs" table
+CREATE TABLE "attachment_blobs" ("id" character varying NOT NULL, "sha256" character varying NOT NULL, "size" bigint NOT NULL, "mime_type" character varying NULL, "storage_key" character varying NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "attachment_blobs_sha256_key" to table: "attachment_blobs"
+CREATE UNIQUE INDEX "attachment_blobs_sha256_key" ON "attachment_blobs" ("sha256");
+-- create "receipt_events" table
+CREATE TABLE "receipt_events" ("id" character varying NOT NULL, "receipt_id" character varying NOT NULL, "user_id" character varying NOT NULL, "stage" character varying NOT NULL, "status" character varying NOT NULL DEFAULT 'succeeded', "version" character varying NULL, "detail" text NULL, "data" jsonb NULL, "occurred_at" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "receiptevent_receipt_id_occurred_at" to table: "receipt_events"
+CREATE INDEX "receiptevent_receipt_id_occurred_at" ON "receipt_events" ("receipt_id", "occurred_at");
+-- create index "receiptevent_user_id" to table: "receipt_events"
+CREATE INDEX "receiptevent_user_id" ON "receipt_events" ("user_id");
+-- create "budget_reallocations" table
+CREATE TABLE "budget_reallocations" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "budget_id" character varying NOT NULL, "from_category" character varying NOT NULL, "to_category" character varying NOT NULL, "amount" double precision NOT NULL, "effective_date" timestamptz NOT NULL, "note" character varying NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "budgetreallocation_user_id_budget_id_effective_date" to table: "budget_reallocations"
+CREATE INDEX "budgetreallocation_user_id_budget_id_effective_date" ON "budget_reallocations" ("user_id", "budget_id", "effective_date");
+-- create "bulk_operations" table
+CREATE TABLE "bulk_operations" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "kind" character varying NOT NULL, "category" character varying NULL, "transaction_ids" jsonb NOT NULL, "before_images" jsonb NOT NULL, "expires_at" timestamptz NOT NULL, "undone_at" timestamptz NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "bulkoperation_user_id_created_at" to table: "bulk_operations"
+CREATE INDEX "bulkoperation_user_id_created_at" ON "bulk_operations" ("user_id", "created_at");
+-- create "card_accounts" table
+CREATE TABLE "card_accounts" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "name" character varying NOT NULL, "card_last_four" character varying NULL, "statement_closing_day" bigint NOT NULL, "payment_due_days" bigint NOT NULL DEFAULT 25, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "cardaccount_user_id" to table: "card_accounts"
+CREATE INDEX "cardaccount_user_id" ON "card_accounts" ("user_id");
+-- create "categorization_rules" table
+CREATE TABLE "categorization_rules" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "name" character varying NOT NULL, "priority" bigint NOT NULL DEFAULT 0, "enabled" boolean NOT NULL DEFAULT true, "merchant_pattern" character varying NULL, "min_amount" double precision NULL, "max_amount" double precision NULL, "description_keywords" jsonb NULL, "category" character varying NULL, "tags" jsonb NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "categorizationrule_user_id_priority" to table: "categorization_rules"
+CREATE INDEX "categorizationrule_user_id_priority" ON "categorization_rules" ("user_id", "priority");
+-- create "queued_jobs" table
+CREATE TABLE "queued_jobs" ("id" character varying NOT NULL, "queue" character varying NOT NULL, "type" character varying NOT NULL, "owner_id" character varying NULL, "status" character varying NOT NULL DEFAULT 'pending', "priority" bigint NOT NULL DEFAULT 0, "payload" jsonb NULL, "result" jsonb NULL, "progress" double precision NOT NULL DEFAULT 0, "error" character varying NULL, "retry_count" bigint NOT NULL DEFAULT 0, "max_retries" bigint NOT NULL DEFAULT 3, "run_at" timestamptz NOT NULL, "locked_by" character varying NULL, "heartbeat_at" timestamptz NULL, "started_at" timestamptz NULL, "completed_at" timestamptz NULL, "cancelled_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "queuedjob_queue_status_run_at" to table: "queued_jobs"
+CREATE INDEX "queuedjob_queue_status_run_at" ON "queued_jobs" ("queue", "status", "run_at");
+-- create index "queuedjob_status_heartbeat_at" to table: "queued_jobs"
+CREATE INDEX "queuedjob_status_heartbeat_at" ON "queued_jobs" ("status", "heartbeat_at");
+-- create index "queuedjob_status_completed_at" to table: "queued_jobs"
+CREATE INDEX "queuedjob_status_completed_at" ON "queued_jobs" ("status", "completed_at");
+-- create index "queuedjob_queue_owner_id" to table: "queued_jobs"
+CREATE INDEX "queuedjob_queue_owner_id" ON "queued_jobs" ("queue", "owner_id");
+-- create "debts" table
+CREATE TABLE "debts" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "name" character varying NOT NULL, "type" character varying NOT NULL DEFAULT 'other', "balance" double precision NOT NULL, "apr" double precision NOT NULL, "minimum_payment" double precision NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "debt_user_id" to table: "debts"
+CREATE INDEX "debt_user_id" ON "debts" ("user_id");
+-- create "rounding_rules" table
+CREATE TABLE "rounding_rules" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "increment" double precision NOT NULL, "multiplier" double precision NOT NULL DEFAULT 1, "max_round_up" double precision NULL, "payment_methods" jsonb NULL, "enabled" boolean NOT NULL DEFAULT true, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "rounding_rules_user_id_key" to table: "rounding_rules"
+CREATE UNIQUE INDEX "rounding_rules_user_id_key" ON "rounding_rules" ("user_id");
+-- create "saved_filters" table
+CREATE TABLE "saved_filters" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "name" character varying NOT NULL, "filter" jsonb NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "savedfilter_user_id_name" to table: "saved_filters"
+CREATE UNIQUE INDEX "savedfilter_user_id_name" ON "saved_filters" ("user_id", "name");
+-- create "email_messages" table
+CREATE TABLE "email_messages" ("id" character varying NOT NULL, "connection_id" character varying NOT NULL, "message_id" character varying NOT NULL, "thread_id" character varying NULL, "subject" character varying NULL, "sender" character varying NULL, "snippet" text NULL, "label_ids" jsonb NULL, "has_attachments" boolean NOT NULL DEFAULT false, "attachment_count" bigint NOT NULL DEFAULT 0, "amount" double precision NULL, "received_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "emailmessage_connection_id_message_id" to table: "email_messages"
+CREATE UNIQUE INDEX "emailmessage_connection_id_message_id" ON "email_messages" ("connection_id", "message_id");
+-- create index "emailmessage_connection_id_received_at" to table: "email_messages"
+CREATE INDEX "emailmessage_connection_id_received_at" ON "email_messages" ("connection_id", "received_at");
+-- create "ocr_feedbacks" table
+CREATE TABLE "ocr_feedbacks" ("id" character varying NOT NULL, "receipt_id" character varying NOT NULL, "user_id" character varying NOT NULL, "field" character varying NOT NULL, "value" character varying NULL, "box" jsonb NOT NULL, "predicted_value" character varying NULL, "predicted_box" jsonb NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "ocrfeedback_receipt_id_created_at" to table: "ocr_feedbacks"
+CREATE INDEX "ocrfeedback_receipt_id_created_at" ON "ocr_feedbacks" ("receipt_id", "created_at");
+-- create index "ocrfeedback_field_created_at" to table: "ocr_feedbacks"
+CREATE INDEX "ocrfeedback_field_created_at" ON "ocr_feedbacks" ("field", "created_at");
+-- create "alert_preferences" table
+CREATE TABLE "alert_preferences" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "anomaly_alerts" boolean NOT NULL DEFAULT true, "budget_alerts" boolean NOT NULL DEFAULT true, "budget_limits" jsonb NULL, "budget_threshold_percent" double precision NOT NULL DEFAULT 100, "min_severity" character varying NOT NULL DEFAULT 'medium', "email" character varying NULL, "webhook_url" character varying NULL, "push_token" character varying NULL, "digest" character varying NOT NULL DEFAULT 'daily', "weekly_summary" boolean NOT NULL DEFAULT true, "monthly_report" boolean NOT NULL DEFAULT true, "last_digest_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "alert_preferences_user_id_key" to table: "alert_preferences"
+CREATE UNIQUE INDEX "alert_preferences_user_id_key" ON "alert_preferences" ("user_id");
+-- create "notifications" table
+CREATE TABLE "notifications" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "kind" character varying NOT NULL, "dedupe_key" character varying NOT NULL, "recipient" character varying NOT NULL, "subject" character varying NOT NULL, "status" character varying NOT NULL, "attempts" bigint NOT NULL DEFAULT 1, "error" character varying NULL, "sent_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "notification_user_id_dedupe_key" to table: "notifications"
+CREATE UNIQUE INDEX "notification_user_id_dedupe_key" ON "notifications" ("user_id", "dedupe_key");
+-- create index "notification_user_id_created_at" to table: "notifications"
+CREATE INDEX "notification_user_id_created_at" ON "notifications" ("user_id", "created_at");
+-- create "category_feedbacks" table
+CREATE TABLE "category_feedbacks" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "transaction_id" character varying NOT NULL, "merchant_name" character varying NULL, "description" character varying NULL, "suggested_category" character varying NOT NULL, "suggestion_source" character varying NOT NULL, "confidence" double precision NULL, "accepted" boolean NOT NULL, "category" character varying NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "categoryfeedback_user_id_created_at" to table: "category_feedbacks"
+CREATE INDEX "categoryfeedback_user_id_created_at" ON "category_feedbacks" ("user_id", "created_at");
+-- create index "categoryfeedback_transaction_id" to table: "category_feedbacks"
+CREATE INDEX "categoryfeedback_transaction_id" ON "category_feedbacks" ("transaction_id");
+-- create "emergency_fund_snapshots" table
+CREATE TABLE "emergency_fund_snapshots" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "date" timestamptz NOT NULL, "balance" double precision NOT NULL, "monthly_expenses" double precision NOT NULL, "months_covered" double precision NULL, "target_months" double precision NOT NULL, "below_target" boolean NOT NULL DEFAULT false, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "emergencyfundsnapshot_user_id_date" to table: "emergency_fund_snapshots"
+CREATE UNIQUE INDEX "emergencyfundsnapshot_user_id_date" ON "emergency_fund_snapshots" ("user_id", "date");
+-- create "migration_state" table
+CREATE TABLE "migration_state" ("id" character varying NOT NULL, "kind" character varying NOT NULL, "key" character varying NOT NULL, "value" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "migrationstate_kind_key" to table: "migration_state"
+CREATE UNIQUE INDEX "migrationstate_kind_key" ON "migration_state" ("kind", "key");
+-- create "webhook_deliveries" table
+CREATE TABLE "webhook_deliveries" ("id" character varying NOT NULL, "endpoint_id" character varying NOT NULL, "user_id" character varying NOT NULL, "event" character varying NOT NULL, "event_id" character varying NOT NULL, "payload" jsonb NOT NULL, "status" character varying NOT NULL DEFAULT 'pending', "attempts" bigint NOT NULL DEFAULT 0, "next_attempt_at" timestamptz NULL, "last_attempt_at" timestamptz NULL, "response_status" bigint NULL, "response_body" text NULL, "error" character varying NULL, "delivered_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "webhookdelivery_status_next_attempt_at" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_status_next_attempt_at" ON "webhook_deliveries" ("status", "next_attempt_at");
+-- create index "webhookdelivery_endpoint_id_created_at" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_endpoint_id_created_at" ON "webhook_deliveries" ("endpoint_id", "created_at");
+-- create index "webhookdelivery_user_id" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_user_id" ON "webhook_deliveries" ("user_id");
+-- create "alerts" table
+CREATE TABLE "alerts" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "kind" character varying NOT NULL, "severity" character varying NOT NULL, "title" character varying NOT NULL, "message" text NOT NULL, "dedupe_key" character varying NOT NULL, "data" jsonb NULL, "delivery_status" character varying NOT NULL DEFAULT 'pending', "delivery_error" character varying NULL, "delivered_at" timestamptz NULL, "read_at" timestamptz NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "alert_user_id_dedupe_key" to table: "alerts"
+CREATE UNIQUE INDEX "alert_user_id_dedupe_key" ON "alerts" ("user_id", "dedupe_key");
+-- create index "alert_user_id_created_at" to table: "alerts"
+CREATE INDEX "alert_user_id_created_at" ON "alerts" ("user_id", "created_at");
+-- create index "alert_delivery_status" to table: "alerts"
+CREATE INDEX "alert_delivery_status" ON "alerts" ("delivery_status");
+-- create "household_members" table
+CREATE TABLE "household_members" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "name" character varying NOT NULL, "connection_ids" jsonb NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "householdmember_user_id" to table: "household_members"
+CREATE INDEX "householdmember_user_id" ON "household_members" ("user_id");
+-- create "job_queues" table
+CREATE TABLE "job_queues" ("id" character varying NOT NULL, "paused" boolean NOT NULL DEFAULT false, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create "webhook_endpoints" table
+CREATE TABLE "webhook_endpoints" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "url" character varying NOT NULL, "secret" character varying NOT NULL, "events" jsonb NOT NULL, "enabled" boolean NOT NULL DEFAULT true, "description" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "webhookendpoint_user_id" to table: "webhook_endpoints"
+CREATE INDEX "webhookendpoint_user_id" ON "webhook_endpoints" ("user_id");
+-- create "liquid_accounts" table
+CREATE TABLE "liquid_accounts" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "name" character varying NOT NULL, "type" character varying NOT NULL DEFAULT 'savings', "balance" double precision NOT NULL, "emergency_fund" boolean NOT NULL DEFAULT true, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "liquidaccount_user_id" to table: "liquid_accounts"
+CREATE INDEX "liquidaccount_user_id" ON "liquid_accounts" ("user_id");
+-- create "attachment_links" table
+CREATE TABLE "attachment_links" ("id" character varying NOT NULL, "connection_id" character varying NOT NULL, "message_id" character varying NOT NULL, "attachment_id" character varying NOT NULL, "filename" character varying NULL, "created_at" timestamptz NOT NULL, "blob_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "attachment_links_attachment_blobs_links" FOREIGN KEY ("blob_id") REFERENCES "attachment_blobs" ("id") ON DELETE NO ACTION);
+-- create index "attachmentlink_connection_id_message_id_blob_id" to table: "attachment_links"
+CREATE UNIQUE INDEX "attachmentlink_connection_id_message_id_blob_id" ON "attachment_links" ("connection_id", "message_id", "blob_id");
+-- create index "attachmentlink_blob_id" to table: "attachment_links"
+CREATE INDEX "attachmentlink_blob_id" ON "attachment_links" ("blob_id");
+-- create "email_connections" table
+CREATE TABLE "email_connections" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "provider_account_id" character varying NOT NULL, "email" character varying NOT NULL, "provider" character varying NOT NULL, "access_token" character varying NOT NULL, "refresh_token" character varying NOT NULL, "token_expiry" timestamptz NOT NULL, "status" character varying NOT NULL DEFAULT 'active', "migration_run_id" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "last_sync_at" timestamptz NULL, "sync_schedule" character varying NOT NULL DEFAULT 'manual', "next_sync_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "emailconnection_user_id" to table: "email_connections"
+CREATE INDEX "emailconnection_user_id" ON "email_connections" ("user_id");
+-- create index "emailconnection_provider_account_id_provider" to table: "email_connections"
+CREATE UNIQUE INDEX "emailconnection_provider_account_id_provider" ON "email_connections" ("provider_account_id", "provider");
+-- create index "emailconnection_status" to table: "email_connections"
+CREATE INDEX "emailconnection_status" ON "email_connections" ("status");
+-- create index "emailconnection_sync_schedule_next_sync_at" to table: "email_connections"
+CREATE INDEX "emailconnection_sync_schedule_next_sync_at" ON "email_connections" ("sync_schedule", "next_sync_at");
+-- create index "emailconnection_provider" to table: "email_connections"
+CREATE INDEX "emailconnection_provider" ON "email_connections" ("provider");
+-- create index "emailconnection_migration_run_id" to table: "email_connections"
+CREATE INDEX "emailconnection_migration_run_id" ON "email_connections" ("migration_run_id");
+-- create "email_labels" table
+CREATE TABLE "email_labels" ("id" character varying NOT NULL, "provider_label_id" character varying NOT NULL, "name" character varying NOT NULL, "display_name" character varying NULL, "label_type" character varying NOT NULL DEFAULT 'user', "parent_label_id" character varying NULL, "sync_enabled" boolean NOT NULL DEFAULT true, "message_count" bigint NOT NULL DEFAULT 0, "unread_count" bigint NOT NULL DEFAULT 0, "color" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "last_scanned_at" timestamptz NULL, "connection_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "email_labels_email_connections_labels" FOREIGN KEY ("connection_id") REFERENCES "email_connections" ("id") ON DELETE NO ACTION);
+-- create index "emaillabel_connection_id" to table: "email_labels"
+CREATE INDEX "emaillabel_connection_id" ON "email_labels" ("connection_id");
+-- create index "emaillabel_provider_label_id" to table: "email_labels"
+CREATE INDEX "emaillabel_provider_label_id" ON "email_labels" ("provider_label_id");
+-- create index "emaillabel_connection_id_provider_label_id" to table: "email_labels"
+CREATE UNIQUE INDEX "emaillabel_connection_id_provider_label_id" ON "email_labels" ("connection_id", "provider_label_id");
+-- create index "emaillabel_sync_enabled" to table: "email_labels"
+CREATE INDEX "emaillabel_sync_enabled" ON "email_labels" ("sync_enabled");
+-- create index "emaillabel_label_type" to table: "email_labels"
+CREATE INDEX "emaillabel_label_type" ON "email_labels" ("label_type");
+-- create "email_syncs" table
+CREATE TABLE "email_syncs" ("id" character varying NOT NULL, "label_id" character varying NULL, "sync_type" character varying NOT NULL DEFAULT 'incremental', "status" character varying NOT NULL DEFAULT 'pending', "started_at" timestamptz NULL, "completed_at" timestamptz NULL, "messages_scanned" bigint NOT NULL DEFAULT 0, "messages_downloaded" bigint NOT NULL DEFAULT 0, "messages_indexed" bigint NOT NULL DEFAULT 0, "messages_failed" bigint NOT NULL DEFAULT 0, "attachments_downloaded" bigint NOT NULL DEFAULT 0, "bytes_transferred" bigint NOT NULL DEFAULT 0, "error_message" character varying NULL, "error_details" jsonb NULL, "checkpoints" jsonb NULL, "history_id" character varying NULL, "fallback_reason" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "connection_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "email_syncs_email_connections_syncs" FOREIGN KEY ("connection_id") REFERENCES "email_connections" ("id") ON DELETE NO ACTION);
+-- create index "emailsync_connection_id" to table: "email_syncs"
+CREATE INDEX "emailsync_connection_id" ON "email_syncs" ("connection_id");
+-- create index "emailsync_status" to table: "email_syncs"
+CREATE INDEX "emailsync_status" ON "email_syncs" ("status");
+-- create index "emailsync_sync_type" to table: "email_syncs"
+CREATE INDEX "emailsync_sync_type" ON "email_syncs" ("sync_type");
+-- create index "emailsync_connection_id_status" to table: "email_syncs"
+CREATE INDEX "emailsync_connection_id_status" ON "email_syncs" ("connection_id", "status");
+-- create index "emailsync_created_at" to table: "email_syncs"
+CREATE INDEX "emailsync_created_at" ON "email_syncs" ("created_at");
+-- create "email_sync_failures" table
+CREATE TABLE "email_sync_failures" ("id" character varying NOT NULL, "connection_id" character varying NOT NULL, "message_id" character varying NOT NULL, "status" character varying NOT NULL DEFAULT 'failed', "error_message" character varying NOT NULL, "attempts" bigint NOT NULL DEFAULT 1, "resolved_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "sync_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "email_sync_failures_email_syncs_failures" FOREIGN KEY ("sync_id") REFERENCES "email_syncs" ("id") ON DELETE NO ACTION);
+-- create index "emailsyncfailure_sync_id_message_id" to table: "email_sync_failures"
+CREATE UNIQUE INDEX "emailsyncfailure_sync_id_message_id" ON "email_sync_failures" ("sync_id", "message_id");
+-- create index "emailsyncfailure_sync_id_status" to table: "email_sync_failures"
+CREATE INDEX "emailsyncfailure_sync_id_status" ON "email_sync_failures" ("sync_id", "status");
+-- create index "emailsyncfailure_connection_id" to table: "email_sync_failures"
+CREATE INDEX "emailsyncfailure_connection_id" ON "email_sync_failures" ("connection_id");
+-- create "google_drive_connections" table
+CREATE TABLE "google_drive_connections" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "google_account_id" character varying NOT NULL, "email" character varying NOT NULL, "access_token" character varying NOT NULL, "refresh_token" character varying NOT NULL, "token_expiry" timestamptz NOT NULL, "status" character varying NOT NULL DEFAULT 'active', "migration_run_id" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "last_sync_at" timestamptz NULL, "sync_schedule" character varying NOT NULL DEFAULT 'manual', "next_sync_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "googledriveconnection_user_id" to table: "google_drive_connections"
+CREATE INDEX "googledriveconnection_user_id" ON "google_drive_connections" ("user_id");
+-- create index "googledriveconnection_google_account_id" to table: "google_drive_connections"
+CREATE UNIQUE INDEX "googledriveconnection_google_account_id" ON "google_drive_connections" ("google_account_id");
+-- create index "googledriveconnection_status" to table: "google_drive_connections"
+CREATE INDEX "googledriveconnection_status" ON "google_drive_connections" ("status");
+-- create index "googledriveconnection_sync_schedule_next_sync_at" to table: "google_drive_connections"
+CREATE INDEX "googledriveconnection_sync_schedule_next_sync_at" ON "google_drive_connections" ("sync_schedule", "next_sync_at");
+-- create index "googledriveconnection_migration_run_id" to table: "google_drive_connections"
+CREATE INDEX "googledriveconnection_migration_run_id" ON "google_drive_connections" ("migration_run_id");
+-- create "google_drive_folders" table
+CREATE TABLE "google_drive_folders" ("id" character varying NOT NULL, "drive_folder_id" character varying NOT NULL, "name" character varying NOT NULL, "path" character varying NULL, "parent_folder_id" character varying NULL, "is_root" boolean NOT NULL DEFAULT false, "sync_enabled" boolean NOT NULL DEFAULT true, "sync_direction" character varying NOT NULL DEFAULT 'download', "file_count" bigint NOT NULL DEFAULT 0, "total_size_bytes" bigint NOT NULL DEFAULT 0, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "last_scanned_at" timestamptz NULL, "connection_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "google_drive_folders_google_drive_connections_folders" FOREIGN KEY ("connection_id") REFERENCES "google_drive_connections" ("id") ON DELETE NO ACTION);
+-- create index "googledrivefolder_connection_id" to table: "google_drive_folders"
+CREATE INDEX "googledrivefolder_connection_id" ON "google_drive_folders" ("connection_id");
+-- create index "googledrivefolder_drive_folder_id" to table: "google_drive_folders"
+CREATE INDEX "googledrivefolder_drive_folder_id" ON "google_drive_folders" ("drive_folder_id");
+-- create index "googledrivefolder_connection_id_drive_folder_id" to table: "google_drive_folders"
+CREATE UNIQUE INDEX "googledrivefolder_connection_id_drive_folder_id" ON "google_drive_folders" ("connection_id", "drive_folder_id");
+-- create index "googledrivefolder_sync_enabled" to table: "google_drive_folders"
+CREATE INDEX "googledrivefolder_sync_enabled" ON "google_drive_folders" ("sync_enabled");
+-- create "google_drive_syncs" table
+CREATE TABLE "google_drive_syncs" ("id" character varying NOT NULL, "folder_id" character varying NULL, "sync_type" character varying NOT NULL DEFAULT 'incremental', "status" character varying NOT NULL DEFAULT 'pending', "started_at" timestamptz NULL, "completed_at" timestamptz NULL, "files_scanned" bigint NOT NULL DEFAULT 0, "files_downloaded" bigint NOT NULL DEFAULT 0, "files_uploaded" bigint NOT NULL DEFAULT 0, "files_deleted" bigint NOT NULL DEFAULT 0, "files_failed" bigint NOT NULL DEFAULT 0, "bytes_transferred" bigint NOT NULL DEFAULT 0, "error_message" character varying NULL, "error_details" jsonb NULL, "change_token" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "connection_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "google_drive_syncs_google_drive_connections_syncs" FOREIGN KEY ("connection_id") REFERENCES "google_drive_connections" ("id") ON DELETE NO ACTION);
+-- create index "googledrivesync_connection_id" to table: "google_drive_syncs"
+CREATE INDEX "googledrivesync_connection_id" ON "google_drive_syncs" ("connection_id");
+-- create index "googledrivesync_status" to table: "google_drive_syncs"
+CREATE INDEX "googledrivesync_status" ON "google_drive_syncs" ("status");
+-- create index "googledrivesync_sync_type" to table: "google_drive_syncs"
+CREATE INDEX "googledrivesync_sync_type" ON "google_drive_syncs" ("sync_type");
+-- create index "googledrivesync_connection_id_status" to table: "google_drive_syncs"
+CREATE INDEX "googledrivesync_connection_id_status" ON "google_drive_syncs" ("connection_id", "status");
+-- create index "googledrivesync_created_at" to table: "google_drive_syncs"
+CREATE INDEX "googledrivesync_created_at" ON "google_drive_syncs" ("created_at");
+-- create "receipts" table
+CREATE TABLE "receipts" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "source_type" character varying NOT NULL, "source_id" character varying NULL, "source_connection_id" character varying NULL, "file_name" character varying NOT NULL, "file_path" character varying NULL, "mime_type" character varying NOT NULL, "file_size" bigint NOT NULL DEFAULT 0, "storage_bucket" character varying NULL, "storage_key" character varying NULL, "thumbnail_path" character varying NULL, "status" character varying NOT NULL DEFAULT 'pending', "ocr_completed" boolean NOT NULL DEFAULT false, "ocr_text" text NULL, "ocr_confidence" double precision NULL, "merchant_name" character varying NULL, "merchant_address" character varying NULL, "receipt_date" timestamptz NULL, "total_amount" double precision NULL, "tax_amount" double precision NULL, "subtotal_amount" double precision NULL, "currency" character varying NULL DEFAULT 'USD', "payment_method" character varying NULL, "receipt_number" character varying NULL, "category_tags" jsonb NULL, "extracted_data" jsonb NULL, "metadata" jsonb NULL, "notes" character varying NULL, "legacy_id" character varying NULL, "migration_run_id" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "processed_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "receipt_user_id" to table: "receipts"
+CREATE INDEX "receipt_user_id" ON "receipts" ("user_id");
+-- create index "receipt_source_type" to table: "receipts"
+CREATE INDEX "receipt_source_type" ON "receipts" ("source_type");
+-- create index "receipt_source_id" to table: "receipts"
+CREATE INDEX "receipt_source_id" ON "receipts" ("source_id");
+-- create index "receipt_status" to table: "receipts"
+CREATE INDEX "receipt_status" ON "receipts" ("status");
+-- create index "receipt_user_id_status" to table: "receipts"
+CREATE INDEX "receipt_user_id_status" ON "receipts" ("user_id", "status");
+-- create index "receipt_merchant_name" to table: "receipts"
+CREATE INDEX "receipt_merchant_name" ON "receipts" ("merchant_name");
+-- create index "receipt_receipt_date" to table: "receipts"
+CREATE INDEX "receipt_receipt_date" ON "receipts" ("receipt_date");
+-- create index "receipt_legacy_id" to table: "receipts"
+CREATE INDEX "receipt_legacy_id" ON "receipts" ("legacy_id");
+-- create index "receipt_migration_run_id" to table: "receipts"
+CREATE INDEX "receipt_migration_run_id" ON "receipts" ("migration_run_id");
+-- create index "receipt_created_at" to table: "receipts"
+CREATE INDEX "receipt_created_at" ON "receipts" ("created_at");
+-- create "line_items" table
+CREATE TABLE "line_items" ("id" character varying NOT NULL, "line_number" bigint NOT NULL DEFAULT 0, "description" character varying NOT NULL, "sku" character varying NULL, "product_code" character varying NULL, "quantity" double precision NOT NULL DEFAULT 1, "unit" character varying NULL, "unit_price" double precision NOT NULL, "total_price" double precision NOT NULL, "discount_amount" double precision NULL DEFAULT 0, "discount_description" character varying NULL, "tax_amount" double precision NULL DEFAULT 0, "tax_rate" double precision NULL, "is_taxable" boolean NOT NULL DEFAULT true, "category" character varying NULL, "tags" jsonb NULL, "metadata" jsonb NULL, "legacy_id" character varying NULL, "migration_run_id" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "receipt_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "line_items_receipts_line_items" FOREIGN KEY ("receipt_id") REFERENCES "receipts" ("id") ON DELETE NO ACTION);
+-- create index "lineitem_receipt_id" to table: "line_items"
+CREATE INDEX "lineitem_receipt_id" ON "line_items" ("receipt_id");
+-- create index "lineitem_receipt_id_line_number" to table: "line_items"
+CREATE INDEX "lineitem_receipt_id_line_number" ON "line_items" ("receipt_id", "line_number");
+-- create index "lineitem_sku" to table: "line_items"
+CREATE INDEX "lineitem_sku" ON "line_items" ("sku");
+-- create index "lineitem_product_code" to table: "line_items"
+CREATE INDEX "lineitem_product_code" ON "line_items" ("product_code");
+-- create index "lineitem_category" to table: "line_items"
+CREATE INDEX "lineitem_category" ON "line_items" ("category");
+-- create index "lineitem_legacy_id" to table: "line_items"
+CREATE INDEX "lineitem_legacy_id" ON "line_items" ("legacy_id");
+-- create index "lineitem_migration_run_id" to table: "line_items"
+CREATE INDEX "lineitem_migration_run_id" ON "line_items" ("migration_run_id");
+-- create "pipeline_configs" table
+CREATE TABLE "pipeline_configs" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "name" character varying NOT NULL, "description" text NULL, "pipeline_type" character varying NOT NULL, "trigger_type" character varying NOT NULL DEFAULT 'manual', "trigger_config" character varying NULL, "enabled" boolean NOT NULL DEFAULT true, "is_default" boolean NOT NULL DEFAULT false, "current_version" bigint NOT NULL DEFAULT 1, "settings" jsonb NULL, "input_schema" jsonb NULL, "output_schema" jsonb NULL, "tags" jsonb NULL, "execution_count" bigint NOT NULL DEFAULT 0, "success_count" bigint NOT NULL DEFAULT 0, "failure_count" bigint NOT NULL DEFAULT 0, "last_executed_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "pipelineconfig_user_id" to table: "pipeline_configs"
+CREATE INDEX "pipelineconfig_user_id" ON "pipeline_configs" ("user_id");
+-- create index "pipelineconfig_pipeline_type" to table: "pipeline_configs"
+CREATE INDEX "pipelineconfig_pipeline_type" ON "pipeline_configs" ("pipeline_type");
+-- create index "pipelineconfig_trigger_type" to table: "pipeline_configs"
+CREATE INDEX "pipelineconfig_trigger_type" ON "pipeline_configs" ("trigger_type");
+-- create index "pipelineconfig_enabled" to table: "pipeline_configs"
+CREATE INDEX "pipelineconfig_enabled" ON "pipeline_configs" ("enabled");
+-- create index "pipelineconfig_is_default" to table: "pipeline_configs"
+CREATE INDEX "pipelineconfig_is_default" ON "pipeline_configs" ("is_default");
+-- create index "pipelineconfig_user_id_pipeline_type" to table: "pipeline_configs"
+CREATE INDEX "pipelineconfig_user_id_pipeline_type" ON "pipeline_configs" ("user_id", "pipeline_type");
+-- create index "pipelineconfig_user_id_is_default" to table: "pipeline_configs"
+CREATE INDEX "pipelineconfig_user_id_is_default" ON "pipeline_configs" ("user_id", "is_default");
+-- create index "pipelineconfig_created_at" to table: "pipeline_configs"
+CREATE INDEX "pipelineconfig_created_at" ON "pipeline_configs" ("created_at");
+-- create "pipeline_rules" table
+CREATE TABLE "pipeline_rules" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "name" character varying NOT NULL, "description" text NULL, "rule_type" character varying NOT NULL, "priority" bigint NOT NULL DEFAULT 0, "enabled" boolean NOT NULL DEFAULT true, "conditions" jsonb NULL, "actions" jsonb NULL, "parameters" jsonb NULL, "target_fields" jsonb NULL, "match_mode" character varying NOT NULL DEFAULT 'all', "stop_on_match" boolean NOT NULL DEFAULT false, "execution_count" bigint NOT NULL DEFAULT 0, "last_executed_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "config_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "pipeline_rules_pipeline_configs_rules" FOREIGN KEY ("config_id") REFERENCES "pipeline_configs" ("id") ON DELETE NO ACTION);
+-- create index "pipelinerule_user_id" to table: "pipeline_rules"
+CREATE INDEX "pipelinerule_user_id" ON "pipeline_rules" ("user_id");
+-- create index "pipelinerule_config_id" to table: "pipeline_rules"
+CREATE INDEX "pipelinerule_config_id" ON "pipeline_rules" ("config_id");
+-- create index "pipelinerule_rule_type" to table: "pipeline_rules"
+CREATE INDEX "pipelinerule_rule_type" ON "pipeline_rules" ("rule_type");
+-- create index "pipelinerule_enabled" to table: "pipeline_rules"
+CREATE INDEX "pipelinerule_enabled" ON "pipeline_rules" ("enabled");
+-- create index "pipelinerule_priority" to table: "pipeline_rules"
+CREATE INDEX "pipelinerule_priority" ON "pipeline_rules" ("priority");
+-- create index "pipelinerule_config_id_priority" to table: "pipeline_rules"
+CREATE INDEX "pipelinerule_config_id_priority" ON "pipeline_rules" ("config_id", "priority");
+-- create index "pipelinerule_created_at" to table: "pipeline_rules"
+CREATE INDEX "pipelinerule_created_at" ON "pipeline_rules" ("created_at");
+-- create "pipeline_versions" table
+CREATE TABLE "pipeline_versions" ("id" character varying NOT NULL, "version_number" bigint NOT NULL, "name" character varying NULL, "description" text NULL, "changelog" text NULL, "snapshot" jsonb NULL, "rules_snapshot" jsonb NULL, "status" character varying NOT NULL DEFAULT 'draft', "is_current" boolean NOT NULL DEFAULT false, "created_by" character varying NULL, "approved_by" character varying NULL, "approved_at" timestamptz NULL, "activated_at" timestamptz NULL, "deprecated_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "config_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "pipeline_versions_pipeline_configs_versions" FOREIGN KEY ("config_id") REFERENCES "pipeline_configs" ("id") ON DELETE NO ACTION);
+-- create index "pipelineversion_config_id" to table: "pipeline_versions"
+CREATE INDEX "pipelineversion_config_id" ON "pipeline_versions" ("config_id");
+-- create index "pipelineversion_version_number" to table: "pipeline_versions"
+CREATE INDEX "pipelineversion_version_number" ON "pipeline_versions" ("version_number");
+-- create index "pipelineversion_status" to table: "pipeline_versions"
+CREATE INDEX "pipelineversion_status" ON "pipeline_versions" ("status");
+-- create index "pipelineversion_is_current" to table: "pipeline_versions"
+CREATE INDEX "pipelineversion_is_current" ON "pipeline_versions" ("is_current");
+-- create index "pipelineversion_config_id_version_number" to table: "pipeline_versions"
+CREATE UNIQUE INDEX "pipelineversion_config_id_version_number" ON "pipeline_versions" ("config_id", "version_number");
+-- create index "pipelineversion_config_id_is_current" to table: "pipeline_versions"
+CREATE INDEX "pipelineversion_config_id_is_current" ON "pipeline_versions" ("config_id", "is_current");
+-- create index "pipelineversion_created_at" to table: "pipeline_versions"
+CREATE INDEX "pipelineversion_created_at" ON "pipeline_versions" ("created_at");
+-- create "merchants" table
+CREATE TABLE "merchants" ("id" character varying NOT NULL, "name" character varying NOT NULL, "normalized_key" character varying NOT NULL, "category" character varying NULL, "logo_url" character varying NULL, "website" character varying NULL, "enriched_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "merchants_normalized_key_key" to table: "merchants"
+CREATE UNIQUE INDEX "merchants_normalized_key_key" ON "merchants" ("normalized_key");
+-- create "transactions" table
+CREATE TABLE "transactions" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "source" character varying NOT NULL DEFAULT 'receipt', "type" character varying NOT NULL DEFAULT 'purchase', "amount" double precision NOT NULL, "currency" character varying NOT NULL DEFAULT 'USD', "transaction_date" timestamptz NOT NULL, "description" character varying NULL, "merchant_name" character varying NULL, "merchant_category" character varying NULL, "payment_method" character varying NULL, "card_last_four" character varying NULL, "reference_number" character varying NULL, "authorization_code" character varying NULL, "status" character varying NOT NULL DEFAULT 'completed', "round_up_amount" double precision NOT NULL DEFAULT 0, "is_recurring" boolean NOT NULL DEFAULT false, "recurrence_pattern" character varying NULL, "category_tags" jsonb NULL, "metadata" jsonb NULL, "notes" character varying NULL, "member_id" character varying NULL, "legacy_id" character varying NULL, "migration_run_id" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "merchant_id" character varying NULL, "receipt_id" character varying NULL, PRIMARY KEY ("id"), CONSTRAINT "transactions_merchants_transactions" FOREIGN KEY ("merchant_id") REFERENCES "merchants" ("id") ON DELETE SET NULL, CONSTRAINT "transactions_receipts_transactions" FOREIGN KEY ("receipt_id") REFERENCES "receipts" ("id") ON DELETE SET NULL);
+-- create index "transaction_receipt_id" to table: "transactions"
+CREATE INDEX "transaction_receipt_id" ON "transactions" ("receipt_id");
+-- create index "transaction_user_id" to table: "transactions"
+CREATE INDEX "transaction_user_id" ON "transactions" ("user_id");
+-- create index "transaction_type" to table: "transactions"
+CREATE INDEX "transaction_type" ON "transactions" ("type");
+-- create index "transaction_status" to table: "transactions"
+CREATE INDEX "transaction_status" ON "transactions" ("status");
+-- create index "transaction_transaction_date" to table: "transactions"
+CREATE INDEX "transaction_transaction_date" ON "transactions" ("transaction_date");
+-- create index "transaction_user_id_transaction_date" to table: "transactions"
+CREATE INDEX "transaction_user_id_transaction_date" ON "transactions" ("user_id", "transaction_date");
+-- create index "transaction_user_id_source" to table: "transactions"
+CREATE INDEX "transaction_user_id_source" ON "transactions" ("user_id", "source");
+-- create index "transaction_merchant_name" to table: "transactions"
+CREATE INDEX "transaction_merchant_name" ON "transactions" ("merchant_name");
+-- create index "transaction_merchant_id" to table: "transactions"
+CREATE INDEX "transaction_merchant_id" ON "transactions" ("merchant_id");
+-- create index "transaction_legacy_id" to table: "transactions"
+CREATE INDEX "transaction_legacy_id" ON "transactions" ("legacy_id");
+-- create index "transaction_migration_run_id" to table: "transactions"
+CREATE INDEX "transaction_migration_run_id" ON "transactions" ("migration_run_id");
+-- create index "transaction_created_at" to table: "transactions"
+CREATE INDEX "transaction_created_at" ON "transactions" ("created_at");
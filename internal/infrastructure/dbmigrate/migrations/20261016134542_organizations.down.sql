@@ -0,0 +1,8 @@
+-- reverse: create index "membership_user_id" to table: "memberships"
+DROP INDEX "membership_user_id";
+-- reverse: create index "membership_organization_id_user_id" to table: "memberships"
+DROP INDEX "membership_organization_id_user_id";
+-- reverse: create "memberships" table
+DROP TABLE "memberships";
+-- reverse: create "organizations" table
+DROP TABLE "organizations";
@@ -0,0 +1,8 @@
+-- create "organizations" table
+CREATE TABLE "organizations" ("id" character varying NOT NULL, "name" character varying NOT NULL, "created_by" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create "memberships" table
+CREATE TABLE "memberships" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "role" character varying NOT NULL DEFAULT 'member', "invited_by" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "organization_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "memberships_organizations_memberships" FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON DELETE NO ACTION);
+-- create index "membership_organization_id_user_id" to table: "memberships"
+CREATE UNIQUE INDEX "membership_organization_id_user_id" ON "memberships" ("organization_id", "user_id");
+-- create index "membership_user_id" to table: "memberships"
+CREATE INDEX "membership_user_id" ON "memberships" ("user_id");
@@ -0,0 +1,38 @@
+-- reverse: modify "google_drive_syncs" table
+ALTER TABLE "google_drive_syncs" DROP CONSTRAINT "google_drive_syncs_google_drive_connections_syncs", ADD CONSTRAINT "google_drive_syncs_google_drive_connections_syncs" FOREIGN KEY ("connection_id") REFERENCES "google_drive_connections" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- reverse: modify "google_drive_folders" table
+ALTER TABLE "google_drive_folders" DROP CONSTRAINT "google_drive_folders_google_drive_connections_folders", ADD CONSTRAINT "google_drive_folders_google_drive_connections_folders" FOREIGN KEY ("connection_id") REFERENCES "google_drive_connections" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- reverse: modify "email_sync_failures" table
+ALTER TABLE "email_sync_failures" DROP CONSTRAINT "email_sync_failures_email_syncs_failures", ADD CONSTRAINT "email_sync_failures_email_syncs_failures" FOREIGN KEY ("sync_id") REFERENCES "email_syncs" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- reverse: modify "email_syncs" table
+ALTER TABLE "email_syncs" DROP CONSTRAINT "email_syncs_email_connections_syncs", ADD CONSTRAINT "email_syncs_email_connections_syncs" FOREIGN KEY ("connection_id") REFERENCES "email_connections" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- reverse: modify "email_labels" table
+ALTER TABLE "email_labels" DROP CONSTRAINT "email_labels_email_connections_labels", ADD CONSTRAINT "email_labels_email_connections_labels" FOREIGN KEY ("connection_id") REFERENCES "email_connections" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- reverse: modify "google_drive_connections" table
+ALTER TABLE "google_drive_connections" DROP CONSTRAINT "google_drive_connections_users_google_drive_connections";
+-- reverse: modify "email_connections" table
+ALTER TABLE "email_connections" DROP CONSTRAINT "email_connections_users_email_connections";
+-- reverse: modify "budget_reallocations" table
+ALTER TABLE "budget_reallocations" DROP CONSTRAINT "budget_reallocations_users_budget_reallocations";
+-- reverse: create index "googledriveconnection_organization_id" to table: "google_drive_connections"
+DROP INDEX "googledriveconnection_organization_id";
+-- reverse: create index "emailconnection_organization_id" to table: "email_connections"
+DROP INDEX "emailconnection_organization_id";
+-- reverse: create index "budgetreallocation_organization_id_budget_id_effective_date" to table: "budget_reallocations"
+DROP INDEX "budgetreallocation_organization_id_budget_id_effective_date";
+-- reverse: move rows owned by an organization to the user who created it
+UPDATE "google_drive_connections" SET "user_id" = "organization_id" WHERE "organization_id" IS NOT NULL;
+UPDATE "email_connections" SET "user_id" = "organization_id" WHERE "organization_id" IS NOT NULL;
+UPDATE "budget_reallocations" SET "user_id" = "organization_id" WHERE "organization_id" IS NOT NULL;
+-- reverse: modify "google_drive_connections" table
+ALTER TABLE "google_drive_connections" DROP COLUMN "organization_id";
+-- reverse: modify "email_connections" table
+ALTER TABLE "email_connections" DROP COLUMN "organization_id";
+-- reverse: modify "budget_reallocations" table
+ALTER TABLE "budget_reallocations" DROP COLUMN "organization_id";
+-- reverse: create index "users_google_id_key" to table: "users"
+DROP INDEX "users_google_id_key";
+-- reverse: create index "users_email_key" to table: "users"
+DROP INDEX "users_email_key";
+-- reverse: create "users" table
+DROP TABLE "users";
@@ -0,0 +1,47 @@
+-- create "users" table
+CREATE TABLE "users" ("id" character varying NOT NULL, "email" character varying NULL, "email_verified" boolean NOT NULL DEFAULT false, "name" character varying NOT NULL DEFAULT '', "locale" character varying NULL, "password_hash" character varying NULL, "google_id" character varying NULL, "last_login_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "users_email_key" to table: "users"
+CREATE UNIQUE INDEX "users_email_key" ON "users" ("email");
+-- create index "users_google_id_key" to table: "users"
+CREATE UNIQUE INDEX "users_google_id_key" ON "users" ("google_id");
+-- modify "budget_reallocations" table
+ALTER TABLE "budget_reallocations" ADD COLUMN "organization_id" character varying NULL;
+-- modify "email_connections" table
+ALTER TABLE "email_connections" ADD COLUMN "organization_id" character varying NULL;
+-- modify "google_drive_connections" table
+ALTER TABLE "google_drive_connections" ADD COLUMN "organization_id" character varying NULL;
+-- move rows owned by an organization to the user who created it
+UPDATE "budget_reallocations" AS r SET "organization_id" = r."user_id", "user_id" = o."created_by" FROM "organizations" AS o WHERE o."id" = r."user_id";
+UPDATE "email_connections" AS c SET "organization_id" = c."user_id", "user_id" = o."created_by" FROM "organizations" AS o WHERE o."id" = c."user_id";
+UPDATE "google_drive_connections" AS c SET "organization_id" = c."user_id", "user_id" = o."created_by" FROM "organizations" AS o WHERE o."id" = c."user_id";
+-- create users for the IDs already in use
+INSERT INTO "users" ("id", "created_at", "updated_at")
+SELECT "user_id", now(), now() FROM (
+  SELECT "user_id" FROM "budget_reallocations"
+  UNION SELECT "user_id" FROM "email_connections"
+  UNION SELECT "user_id" FROM "google_drive_connections"
+  UNION SELECT "user_id" FROM "memberships"
+) AS ids
+ON CONFLICT DO NOTHING;
+-- create index "budgetreallocation_organization_id_budget_id_effective_date" to table: "budget_reallocations"
+CREATE INDEX "budgetreallocation_organization_id_budget_id_effective_date" ON "budget_reallocations" ("organization_id", "budget_id", "effective_date");
+-- create index "emailconnection_organization_id" to table: "email_connections"
+CREATE INDEX "emailconnection_organization_id" ON "email_connections" ("organization_id");
+-- create index "googledriveconnection_organization_id" to table: "google_drive_connections"
+CREATE INDEX "googledriveconnection_organization_id" ON "google_drive_connections" ("organization_id");
+-- modify "budget_reallocations" table
+ALTER TABLE "budget_reallocations" ADD CONSTRAINT "budget_reallocations_users_budget_reallocations" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- modify "email_connections" table
+ALTER TABLE "email_connections" ADD CONSTRAINT "email_connections_users_email_connections" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- modify "google_drive_connections" table
+ALTER TABLE "google_drive_connections" ADD CONSTRAINT "google_drive_connections_users_google_drive_connections" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- modify "email_labels" table
+ALTER TABLE "email_labels" DROP CONSTRAINT "email_labels_email_connections_labels", ADD CONSTRAINT "email_labels_email_connections_labels" FOREIGN KEY ("connection_id") REFERENCES "email_connections" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- modify "email_syncs" table
+ALTER TABLE "email_syncs" DROP CONSTRAINT "email_syncs_email_connections_syncs", ADD CONSTRAINT "email_syncs_email_connections_syncs" FOREIGN KEY ("connection_id") REFERENCES "email_connections" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- modify "email_sync_failures" table
+ALTER TABLE "email_sync_failures" DROP CONSTRAINT "email_sync_failures_email_syncs_failures", ADD CONSTRAINT "email_sync_failures_email_syncs_failures" FOREIGN KEY ("sync_id") REFERENCES "email_syncs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- modify "google_drive_folders" table
+ALTER TABLE "google_drive_folders" DROP CONSTRAINT "google_drive_folders_google_drive_connections_folders", ADD CONSTRAINT "google_drive_folders_google_drive_connections_folders" FOREIGN KEY ("connection_id") REFERENCES "google_drive_connections" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- modify "google_drive_syncs" table
+ALTER TABLE "google_drive_syncs" DROP CONSTRAINT "google_drive_syncs_google_drive_connections_syncs", ADD CONSTRAINT "google_drive_syncs_google_drive_connections_syncs" FOREIGN KEY ("connection_id") REFERENCES "google_drive_connections" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
@@ -0,0 +1,4 @@
+-- reverse: create index "accountdeletion_user_id" to table: "account_deletions"
+DROP INDEX "accountdeletion_user_id";
+-- reverse: create "account_deletions" table
+DROP TABLE "account_deletions";
@@ -0,0 +1,4 @@
+-- create "account_deletions" table
+CREATE TABLE "account_deletions" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "requested_by" character varying NOT NULL, "rows_deleted" jsonb NULL, "tokens_revoked" bigint NOT NULL DEFAULT 0, "tokens_failed" bigint NOT NULL DEFAULT 0, "blobs_purged" bigint NOT NULL DEFAULT 0, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "accountdeletion_user_id" to table: "account_deletions"
+CREATE INDEX "accountdeletion_user_id" ON "account_deletions" ("user_id");
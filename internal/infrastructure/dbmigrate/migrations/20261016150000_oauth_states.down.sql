@@ -0,0 +1,4 @@
+-- reverse: create index "oauthstate_expires_at" to table: "oauth_states"
+DROP INDEX "oauthstate_expires_at";
+-- reverse: create "oauth_states" table
+DROP TABLE "oauth_states";
@@ -0,0 +1,4 @@
+-- create "oauth_states" table
+CREATE TABLE "oauth_states" ("id" character varying NOT NULL, "data" bytea NOT NULL, "expires_at" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "oauthstate_expires_at" to table: "oauth_states"
+CREATE INDEX "oauthstate_expires_at" ON "oauth_states" ("expires_at");
@@ -0,0 +1,4 @@
+-- reverse: create index "googledriveconnection_status_token_expiry" to table: "google_drive_connections"
+DROP INDEX "googledriveconnection_status_token_expiry";
+-- reverse: create index "emailconnection_status_token_expiry" to table: "email_connections"
+DROP INDEX "emailconnection_status_token_expiry";
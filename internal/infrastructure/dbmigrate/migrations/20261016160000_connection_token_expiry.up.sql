@@ -0,0 +1,4 @@
+-- create index "emailconnection_status_token_expiry" to table: "email_connections"
+CREATE INDEX "emailconnection_status_token_expiry" ON "email_connections" ("status", "token_expiry");
+-- create index "googledriveconnection_status_token_expiry" to table: "google_drive_connections"
+CREATE INDEX "googledriveconnection_status_token_expiry" ON "google_drive_connections" ("status", "token_expiry");
@@ -0,0 +1,2 @@
+-- reverse: modify "google_drive_folders" table
+ALTER TABLE "google_drive_folders" DROP COLUMN "recursive";
@@ -0,0 +1,2 @@
+-- modify "google_drive_folders" table
+ALTER TABLE "google_drive_folders" ADD COLUMN "recursive" boolean NOT NULL DEFAULT true;
@@ -0,0 +1,2 @@
+-- reverse: modify "webhook_deliveries" table
+ALTER TABLE "webhook_deliveries" ADD COLUMN "response_body" text NULL;
@@ -0,0 +1,2 @@
+-- modify "webhook_deliveries" table
+ALTER TABLE "webhook_deliveries" DROP COLUMN "response_body";
@@ -0,0 +1,2 @@
+-- reverse: create "tax_years" table
+DROP TABLE "tax_years";
@@ -0,0 +1,2 @@
+-- create "tax_years" table
+CREATE TABLE "tax_years" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "data" bytea NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
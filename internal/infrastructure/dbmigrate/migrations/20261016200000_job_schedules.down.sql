@@ -0,0 +1,14 @@
+-- reverse: create index "jobschedulerun_user_id" to table: "job_schedule_runs"
+DROP INDEX "jobschedulerun_user_id";
+-- reverse: create index "jobschedulerun_status" to table: "job_schedule_runs"
+DROP INDEX "jobschedulerun_status";
+-- reverse: create index "jobschedulerun_schedule_id_started_at" to table: "job_schedule_runs"
+DROP INDEX "jobschedulerun_schedule_id_started_at";
+-- reverse: create "job_schedule_runs" table
+DROP TABLE "job_schedule_runs";
+-- reverse: create index "jobschedule_user_id" to table: "job_schedules"
+DROP INDEX "jobschedule_user_id";
+-- reverse: create index "jobschedule_enabled_next_run_at" to table: "job_schedules"
+DROP INDEX "jobschedule_enabled_next_run_at";
+-- reverse: create "job_schedules" table
+DROP TABLE "job_schedules";
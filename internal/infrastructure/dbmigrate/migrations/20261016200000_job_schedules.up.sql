@@ -0,0 +1,14 @@
+-- create "job_schedules" table
+CREATE TABLE "job_schedules" ("id" character varying NOT NULL, "user_id" character varying NOT NULL, "name" character varying NOT NULL, "job_type" character varying NOT NULL, "frequency" character varying NOT NULL, "params" jsonb NULL, "enabled" boolean NOT NULL DEFAULT true, "next_run_at" timestamptz NOT NULL, "last_run_at" timestamptz NULL, "last_status" character varying NULL, "last_error" character varying NULL, "consecutive_failures" bigint NOT NULL DEFAULT 0, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "jobschedule_enabled_next_run_at" to table: "job_schedules"
+CREATE INDEX "jobschedule_enabled_next_run_at" ON "job_schedules" ("enabled", "next_run_at");
+-- create index "jobschedule_user_id" to table: "job_schedules"
+CREATE INDEX "jobschedule_user_id" ON "job_schedules" ("user_id");
+-- create "job_schedule_runs" table
+CREATE TABLE "job_schedule_runs" ("id" character varying NOT NULL, "schedule_id" character varying NOT NULL, "user_id" character varying NOT NULL, "job_id" character varying NULL, "status" character varying NOT NULL DEFAULT 'pending', "error" character varying NULL, "started_at" timestamptz NOT NULL, "completed_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "jobschedulerun_schedule_id_started_at" to table: "job_schedule_runs"
+CREATE INDEX "jobschedulerun_schedule_id_started_at" ON "job_schedule_runs" ("schedule_id", "started_at");
+-- create index "jobschedulerun_status" to table: "job_schedule_runs"
+CREATE INDEX "jobschedulerun_status" ON "job_schedule_runs" ("status");
+-- create index "jobschedulerun_user_id" to table: "job_schedule_runs"
+CREATE INDEX "jobschedulerun_user_id" ON "job_schedule_runs" ("user_id");
@@ -0,0 +1,2 @@
+-- reverse: modify "users" table
+ALTER TABLE "users" DROP COLUMN "email_verification_expires_at", DROP COLUMN "email_verification_hash", DROP COLUMN "pending_email";
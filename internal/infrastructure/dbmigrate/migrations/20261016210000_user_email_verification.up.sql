@@ -0,0 +1,2 @@
+-- modify "users" table
+ALTER TABLE "users" ADD COLUMN "pending_email" character varying NULL, ADD COLUMN "email_verification_hash" character varying NULL, ADD COLUMN "email_verification_expires_at" timestamptz NULL;
@@ -0,0 +1,2 @@
+-- reverse: modify "migration_state" table
+ALTER TABLE "migration_state" DROP COLUMN "run_id";
@@ -0,0 +1,2 @@
+-- modify "migration_state" table
+ALTER TABLE "migration_state" ADD COLUMN "run_id" character varying NULL;
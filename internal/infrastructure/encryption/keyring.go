@@ -0,0 +1,229 @@
+// Package encryption seals secrets such as OAuth tokens with AES-GCM before
+// they are stored, and supports rotating to new keys without downtime.
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Encryption errors
+var (
+	ErrNoKeys          = errors.New("no encryption keys configured")
+	ErrInvalidKey      = errors.New("encryption key must be 32 bytes (AES-256)")
+	ErrDuplicateKeyID  = errors.New("duplicate encryption key ID")
+	ErrUnknownKeyID    = errors.New("value was encrypted with an unknown key")
+	ErrMalformedValue  = errors.New("malformed encrypted value")
+	ErrDecryptionFail  = errors.New("decryption failed")
+	ErrInvalidKeySpec  = errors.New("invalid encryption key spec")
+	ErrKeySourceNotSet = errors.New("encryption key source is not configured")
+)
+
+// valuePrefix marks an encrypted value. Values without it are treated as
+// legacy plaintext so existing rows keep working until they are migrated.
+const valuePrefix = "enc:v1:"
+
+// Key is a named AES-256 key
+type Key struct {
+	ID       string
+	Material []byte
+}
+
+// KeySource loads encryption keys, primary key first. Implementations may
+// read from the environment or fetch data keys from a KMS.
+type KeySource interface {
+	Keys(ctx context.Context) ([]Key, error)
+}
+
+// EnvKeySource reads keys from an environment variable formatted as
+// "id:base64key[,id:base64key...]". The first key encrypts new values; the
+// rest are only used to decrypt values sealed before a rotation.
+type EnvKeySource struct {
+	Var string
+}
+
+// Keys parses the keys in the environment variable
+func (s EnvKeySource) Keys(ctx context.Context) ([]Key, error) {
+	spec := os.Getenv(s.Var)
+	if spec == "" {
+		return nil, fmt.Errorf("%w: %s is not set", ErrKeySourceNotSet, s.Var)
+	}
+	return ParseKeys(spec)
+}
+
+// ParseKeys parses a comma-separated list of "id:base64key" pairs
+func ParseKeys(spec string) ([]Key, error) {
+	keys := make([]Key, 0)
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		id, encoded, ok := strings.Cut(part, ":")
+		if !ok || id == "" || encoded == "" {
+			return nil, fmt.Errorf("%w: expected id:base64key", ErrInvalidKeySpec)
+		}
+
+		material, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("%w: key %s: %v", ErrInvalidKeySpec, id, err)
+		}
+		keys = append(keys, Key{ID: id, Material: material})
+	}
+
+	if len(keys) == 0 {
+		return nil, ErrNoKeys
+	}
+	return keys, nil
+}
+
+// Keyring encrypts with its primary key and decrypts with any of its keys
+type Keyring struct {
+	primary string
+	aeads   map[string]cipher.AEAD
+}
+
+// NewKeyring creates a keyring. The first key is the primary key.
+func NewKeyring(keys []Key) (*Keyring, error) {
+	if len(keys) == 0 {
+		return nil, ErrNoKeys
+	}
+
+	k := &Keyring{
+		primary: keys[0].ID,
+		aeads:   make(map[string]cipher.AEAD, len(keys)),
+	}
+	for _, key := range keys {
+		if strings.Contains(key.ID, ":") {
+			return nil, fmt.Errorf("%w: key ID %q must not contain ':'", ErrInvalidKeySpec, key.ID)
+		}
+		if len(key.Material) != 32 {
+			return nil, fmt.Errorf("%w: key %s", ErrInvalidKey, key.ID)
+		}
+		if _, exists := k.aeads[key.ID]; exists {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateKeyID, key.ID)
+		}
+
+		block, err := aes.NewCipher(key.Material)
+		if err != nil {
+			return nil, fmt.Errorf("creating cipher for key %s: %w", key.ID, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("creating GCM for key %s: %w", key.ID, err)
+		}
+		k.aeads[key.ID] = aead
+	}
+
+	return k, nil
+}
+
+// NewKeyringFromSource loads keys from source and creates a keyring
+func NewKeyringFromSource(ctx context.Context, source KeySource) (*Keyring, error) {
+	keys, err := source.Keys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewKeyring(keys)
+}
+
+// PrimaryKeyID returns the ID of the key used to encrypt new values
+func (k *Keyring) PrimaryKeyID() string {
+	return k.primary
+}
+
+// Encrypt seals plaintext with the primary key. Empty values stay empty so
+// optional secrets remain distinguishable from set ones.
+func (k *Keyring) Encrypt(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+
+	aead := k.aeads[k.primary]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("generating nonce: %w", err)
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(k.primary))
+	return valuePrefix + k.primary + ":" + base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt opens a value sealed by Encrypt. Values that were never encrypted
+// are returned unchanged.
+func (k *Keyring) Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	keyID, sealed, err := parseValue(value)
+	if err != nil {
+		return "", err
+	}
+
+	aead, ok := k.aeads[keyID]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownKeyID, keyID)
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", ErrMalformedValue
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(keyID))
+	if err != nil {
+		return "", ErrDecryptionFail
+	}
+	return string(plaintext), nil
+}
+
+// NeedsRotation reports whether a value is plaintext or sealed with a key
+// other than the primary key
+func (k *Keyring) NeedsRotation(value string) bool {
+	if value == "" {
+		return false
+	}
+	if !IsEncrypted(value) {
+		return true
+	}
+	keyID, _, err := parseValue(value)
+	return err != nil || keyID != k.primary
+}
+
+// Rotate re-encrypts a value with the primary key if needed
+func (k *Keyring) Rotate(value string) (string, error) {
+	if !k.NeedsRotation(value) {
+		return value, nil
+	}
+	plaintext, err := k.Decrypt(value)
+	if err != nil {
+		return "", err
+	}
+	return k.Encrypt(plaintext)
+}
+
+// IsEncrypted reports whether value was produced by Encrypt
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, valuePrefix)
+}
+
+// parseValue splits an encrypted value into its key ID and sealed bytes
+func parseValue(value string) (string, []byte, error) {
+	keyID, encoded, ok := strings.Cut(strings.TrimPrefix(value, valuePrefix), ":")
+	if !ok || keyID == "" {
+		return "", nil, ErrMalformedValue
+	}
+	sealed, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", nil, ErrMalformedValue
+	}
+	return keyID, sealed, nil
+}
@@ -0,0 +1,154 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testKey(id string, fill byte) Key {
+	return Key{ID: id, Material: bytes.Repeat([]byte{fill}, 32)}
+}
+
+func TestKeyringRoundTrip(t *testing.T) {
+	keyring, err := NewKeyring([]Key{testKey("k1", 1)})
+	require.NoError(t, err)
+
+	sealed, err := keyring.Encrypt("ya29.access-token")
+	require.NoError(t, err)
+	assert.True(t, IsEncrypted(sealed))
+	assert.NotContains(t, sealed, "access-token")
+
+	opened, err := keyring.Decrypt(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "ya29.access-token", opened)
+
+	again, err := keyring.Encrypt("ya29.access-token")
+	require.NoError(t, err)
+	assert.NotEqual(t, sealed, again, "each value gets a fresh nonce")
+
+	t.Run("empty value stays empty", func(t *testing.T) {
+		sealed, err := keyring.Encrypt("")
+		require.NoError(t, err)
+		assert.Empty(t, sealed)
+		assert.False(t, keyring.NeedsRotation(""))
+	})
+
+	t.Run("plaintext passes through", func(t *testing.T) {
+		opened, err := keyring.Decrypt("legacy-token")
+		require.NoError(t, err)
+		assert.Equal(t, "legacy-token", opened)
+		assert.True(t, keyring.NeedsRotation("legacy-token"))
+	})
+}
+
+func TestKeyringWrongKey(t *testing.T) {
+	keyring, err := NewKeyring([]Key{testKey("k1", 1)})
+	require.NoError(t, err)
+	sealed, err := keyring.Encrypt("secret")
+	require.NoError(t, err)
+
+	t.Run("same ID with other material", func(t *testing.T) {
+		other, err := NewKeyring([]Key{testKey("k1", 2)})
+		require.NoError(t, err)
+		_, err = other.Decrypt(sealed)
+		assert.ErrorIs(t, err, ErrDecryptionFail)
+	})
+
+	t.Run("tampered value", func(t *testing.T) {
+		keyID, data, err := parseValue(sealed)
+		require.NoError(t, err)
+		data[len(data)-1] ^= 0xff
+		tampered := valuePrefix + keyID + ":" + base64.RawURLEncoding.EncodeToString(data)
+		_, err = keyring.Decrypt(tampered)
+		assert.ErrorIs(t, err, ErrDecryptionFail)
+	})
+
+	t.Run("malformed value", func(t *testing.T) {
+		_, err := keyring.Decrypt(valuePrefix + "k1")
+		assert.ErrorIs(t, err, ErrMalformedValue)
+		_, err = keyring.Decrypt(valuePrefix + "k1:AA")
+		assert.ErrorIs(t, err, ErrMalformedValue)
+	})
+}
+
+func TestKeyringRotation(t *testing.T) {
+	old, err := NewKeyring([]Key{testKey("old", 1)})
+	require.NoError(t, err)
+	sealed, err := old.Encrypt("secret")
+	require.NoError(t, err)
+
+	rotating, err := NewKeyring([]Key{testKey("new", 2), testKey("old", 1)})
+	require.NoError(t, err)
+	assert.Equal(t, "new", rotating.PrimaryKeyID())
+
+	t.Run("old key still decrypts", func(t *testing.T) {
+		opened, err := rotating.Decrypt(sealed)
+		require.NoError(t, err)
+		assert.Equal(t, "secret", opened)
+		assert.True(t, rotating.NeedsRotation(sealed))
+	})
+
+	t.Run("rotate seals with the primary key", func(t *testing.T) {
+		rotated, err := rotating.Rotate(sealed)
+		require.NoError(t, err)
+		assert.False(t, rotating.NeedsRotation(rotated))
+
+		unchanged, err := rotating.Rotate(rotated)
+		require.NoError(t, err)
+		assert.Equal(t, rotated, unchanged)
+
+		retired, err := NewKeyring([]Key{testKey("new", 2)})
+		require.NoError(t, err)
+		opened, err := retired.Decrypt(rotated)
+		require.NoError(t, err)
+		assert.Equal(t, "secret", opened)
+	})
+
+	t.Run("rotated-out key is unknown", func(t *testing.T) {
+		retired, err := NewKeyring([]Key{testKey("new", 2)})
+		require.NoError(t, err)
+		_, err = retired.Decrypt(sealed)
+		assert.ErrorIs(t, err, ErrUnknownKeyID)
+	})
+}
+
+func TestNewKeyring(t *testing.T) {
+	tests := []struct {
+		name string
+		keys []Key
+		err  error
+	}{
+		{name: "no keys", keys: nil, err: ErrNoKeys},
+		{name: "short key", keys: []Key{{ID: "k1", Material: []byte("short")}}, err: ErrInvalidKey},
+		{name: "duplicate ID", keys: []Key{testKey("k1", 1), testKey("k1", 2)}, err: ErrDuplicateKeyID},
+		{name: "colon in ID", keys: []Key{testKey("k:1", 1)}, err: ErrInvalidKeySpec},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewKeyring(tt.keys)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestParseKeys(t *testing.T) {
+	material := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+
+	keys, err := ParseKeys("new:" + material + ", old:" + material)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, "new", keys[0].ID)
+	assert.Equal(t, "old", keys[1].ID)
+
+	_, err = ParseKeys("")
+	assert.ErrorIs(t, err, ErrNoKeys)
+	_, err = ParseKeys("missing-material")
+	assert.ErrorIs(t, err, ErrInvalidKeySpec)
+	_, err = ParseKeys("k1:not base64!")
+	assert.ErrorIs(t, err, ErrInvalidKeySpec)
+}
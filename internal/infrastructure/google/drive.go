@@ -2,6 +2,7 @@
 package google
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -12,6 +13,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 // Google Drive API endpoints
@@ -155,6 +158,7 @@ type driveErrorResponse struct {
 type DriveClient struct {
 	tokenSource *TokenSource
 	httpClient  *http.Client
+	retry       retrier
 }
 
 // NewDriveClient creates a new Google Drive client
@@ -162,8 +166,10 @@ func NewDriveClient(tokenSource *TokenSource) *DriveClient {
 	return &DriveClient{
 		tokenSource: tokenSource,
 		httpClient: &http.Client{
-			Timeout: 60 * time.Second,
+			Timeout:   60 * time.Second,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
 		},
+		retry: retrier{api: "drive", config: DefaultRetryConfig()},
 	}
 }
 
@@ -172,32 +178,55 @@ func NewDriveClientWithHTTP(tokenSource *TokenSource, httpClient *http.Client) *
 	return &DriveClient{
 		tokenSource: tokenSource,
 		httpClient:  httpClient,
+		retry:       retrier{api: "drive", config: DefaultRetryConfig()},
 	}
 }
 
-// doRequest performs an authenticated request to the Drive API
+// SetRetryConfig sets how rate limited and failed requests are retried
+func (dc *DriveClient) SetRetryConfig(config RetryConfig) {
+	dc.retry.config = config
+}
+
+// doRequest performs an authenticated request to the Drive API. Requests
+// that are rate limited or hit a transient server error are retried with
+// backoff; rate limits that persist are returned as a *QuotaError.
 func (dc *DriveClient) doRequest(ctx context.Context, method, urlStr string, body io.Reader) (*http.Response, error) {
-	token, err := dc.tokenSource.Token(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("getting token: %w", err)
+	// Buffer the body so it can be sent again on retry
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = io.ReadAll(body); err != nil {
+			return nil, fmt.Errorf("reading request body: %w", err)
+		}
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, urlStr, body)
-	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
-	}
+	return dc.retry.do(ctx, func() (*http.Response, error) {
+		// Fetch the token on every attempt in case it expired while waiting
+		token, err := dc.tokenSource.Token(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("getting token: %w", err)
+		}
 
-	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
-	}
+		var reqBody io.Reader
+		if body != nil {
+			reqBody = bytes.NewReader(payload)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, urlStr, reqBody)
+		if err != nil {
+			return nil, fmt.Errorf("creating request: %w", err)
+		}
 
-	resp, err := dc.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("executing request: %w", err)
-	}
+		req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
 
-	return resp, nil
+		resp, err := dc.httpClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("executing request: %w", err)
+		}
+		return resp, nil
+	})
 }
 
 // handleError parses and returns an appropriate error from an API response
@@ -312,7 +341,7 @@ func (dc *DriveClient) ListFiles(ctx context.Context, opts ListFilesOptions) (*F
 
 // ListFolder lists files within a specific folder
 func (dc *DriveClient) ListFolder(ctx context.Context, folderID string, opts ListFilesOptions) (*FileListResponse, error) {
-	query := fmt.Sprintf("'%s' in parents", folderID)
+	query := fmt.Sprintf("'%s' in parents", EscapeQuery(folderID))
 	if opts.Query != "" {
 		opts.Query = query + " and " + opts.Query
 	} else {
@@ -345,6 +374,43 @@ func (dc *DriveClient) ListFolderAll(ctx context.Context, folderID string, opts
 	return allFiles, nil
 }
 
+// ListSubfolders lists the folders directly within a folder, ordered by
+// name unless opts sets an order
+func (dc *DriveClient) ListSubfolders(ctx context.Context, folderID string, opts ListFilesOptions) (*FileListResponse, error) {
+	query := fmt.Sprintf("mimeType = '%s'", MimeTypeFolder)
+	if opts.Query != "" {
+		opts.Query = query + " and " + opts.Query
+	} else {
+		opts.Query = query
+	}
+	if opts.OrderBy == "" {
+		opts.OrderBy = "name"
+	}
+
+	return dc.ListFolder(ctx, folderID, opts)
+}
+
+// SearchFolders lists the folders anywhere in Drive whose name contains
+// name, ordered by name unless opts sets an order
+func (dc *DriveClient) SearchFolders(ctx context.Context, name string, opts ListFilesOptions) (*FileListResponse, error) {
+	query := fmt.Sprintf("mimeType = '%s' and name contains '%s'", MimeTypeFolder, EscapeQuery(name))
+	if opts.Query != "" {
+		opts.Query = query + " and " + opts.Query
+	} else {
+		opts.Query = query
+	}
+	if opts.OrderBy == "" {
+		opts.OrderBy = "name"
+	}
+
+	return dc.ListFiles(ctx, opts)
+}
+
+// EscapeQuery escapes a value for use in a quoted string of a Drive query
+func EscapeQuery(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value)
+}
+
 // GetFile retrieves metadata for a specific file
 func (dc *DriveClient) GetFile(ctx context.Context, fileID string, fields string) (*DriveFile, error) {
 	params := url.Values{}
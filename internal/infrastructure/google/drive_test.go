@@ -0,0 +1,47 @@
+package google
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscapeQuery(t *testing.T) {
+	assert.Equal(t, `Bob\'s receipts`, EscapeQuery("Bob's receipts"))
+	assert.Equal(t, `a\\b`, EscapeQuery(`a\b`))
+	assert.Equal(t, "Receipts", EscapeQuery("Receipts"))
+}
+
+func TestFolderQueries(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"files":[{"id":"folder-1","name":"Receipts","mimeType":"application/vnd.google-apps.folder"}]}`))
+	}))
+	t.Cleanup(server.Close)
+	target, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	client := NewDriveClientWithHTTP(
+		NewTokenSource(nil, &Token{AccessToken: "access", Expiry: time.Now().Add(time.Hour)}),
+		&http.Client{Transport: redirectTransport{target: target}},
+	)
+	ctx := context.Background()
+
+	result, err := client.ListSubfolders(ctx, "root", ListFilesOptions{})
+	require.NoError(t, err)
+	require.Len(t, result.Files, 1)
+	assert.Equal(t, "'root' in parents and mimeType = 'application/vnd.google-apps.folder' and trashed=false", query.Get("q"))
+	assert.Equal(t, "name", query.Get("orderBy"))
+
+	_, err = client.SearchFolders(ctx, "Bob's", ListFilesOptions{PageToken: "next"})
+	require.NoError(t, err)
+	assert.Equal(t, `mimeType = 'application/vnd.google-apps.folder' and name contains 'Bob\'s' and trashed=false`, query.Get("q"))
+	assert.Equal(t, "next", query.Get("pageToken"))
+}
@@ -2,6 +2,7 @@
 package google
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/json"
@@ -13,6 +14,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 // Gmail API endpoints
@@ -233,6 +236,7 @@ type gmailErrorResponse struct {
 type GmailClient struct {
 	tokenSource *TokenSource
 	httpClient  *http.Client
+	retry       retrier
 }
 
 // NewGmailClient creates a new Gmail client
@@ -240,8 +244,10 @@ func NewGmailClient(tokenSource *TokenSource) *GmailClient {
 	return &GmailClient{
 		tokenSource: tokenSource,
 		httpClient: &http.Client{
-			Timeout: 60 * time.Second,
+			Timeout:   60 * time.Second,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
 		},
+		retry: retrier{api: "gmail", config: DefaultRetryConfig()},
 	}
 }
 
@@ -250,32 +256,55 @@ func NewGmailClientWithHTTP(tokenSource *TokenSource, httpClient *http.Client) *
 	return &GmailClient{
 		tokenSource: tokenSource,
 		httpClient:  httpClient,
+		retry:       retrier{api: "gmail", config: DefaultRetryConfig()},
 	}
 }
 
-// doRequest performs an authenticated request to the Gmail API
+// SetRetryConfig sets how rate limited and failed requests are retried
+func (gc *GmailClient) SetRetryConfig(config RetryConfig) {
+	gc.retry.config = config
+}
+
+// doRequest performs an authenticated request to the Gmail API. Requests
+// that are rate limited or hit a transient server error are retried with
+// backoff; rate limits that persist are returned as a *QuotaError.
 func (gc *GmailClient) doRequest(ctx context.Context, method, urlStr string, body io.Reader) (*http.Response, error) {
-	token, err := gc.tokenSource.Token(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("getting token: %w", err)
+	// Buffer the body so it can be sent again on retry
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = io.ReadAll(body); err != nil {
+			return nil, fmt.Errorf("reading request body: %w", err)
+		}
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, urlStr, body)
-	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
-	}
+	return gc.retry.do(ctx, func() (*http.Response, error) {
+		// Fetch the token on every attempt in case it expired while waiting
+		token, err := gc.tokenSource.Token(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("getting token: %w", err)
+		}
 
-	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
-	}
+		var reqBody io.Reader
+		if body != nil {
+			reqBody = bytes.NewReader(payload)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, urlStr, reqBody)
+		if err != nil {
+			return nil, fmt.Errorf("creating request: %w", err)
+		}
 
-	resp, err := gc.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("executing request: %w", err)
-	}
+		req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
 
-	return resp, nil
+		resp, err := gc.httpClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("executing request: %w", err)
+		}
+		return resp, nil
+	})
 }
 
 // handleError parses and returns an appropriate error from an API response
@@ -711,12 +740,13 @@ func (gc *GmailClient) ListAllHistory(ctx context.Context, startHistoryID string
 
 // MessageIterator provides a way to iterate through all messages
 type MessageIterator struct {
-	client   *GmailClient
-	opts     ListMessagesOptions
-	buffer   []GmailMessage
-	bufIndex int
-	done     bool
-	ctx      context.Context
+	client    *GmailClient
+	opts      ListMessagesOptions
+	buffer    []GmailMessage
+	bufIndex  int
+	done      bool
+	pageToken string
+	ctx       context.Context
 }
 
 // NewMessageIterator creates a new message iterator
@@ -736,6 +766,7 @@ func (mi *MessageIterator) Next() (*GmailMessage, error) {
 
 	// If buffer is empty or exhausted, fetch more
 	if mi.bufIndex >= len(mi.buffer) {
+		mi.pageToken = mi.opts.PageToken
 		result, err := mi.client.ListMessages(mi.ctx, mi.opts)
 		if err != nil {
 			return nil, err
@@ -760,6 +791,13 @@ func (mi *MessageIterator) Next() (*GmailMessage, error) {
 	return &message, nil
 }
 
+// PageToken returns the token of the page holding the message last returned
+// by Next. Listing from it again starts at the beginning of that page; the
+// first page has an empty token.
+func (mi *MessageIterator) PageToken() string {
+	return mi.pageToken
+}
+
 // AttachmentInfo contains information about a message attachment
 type AttachmentInfo struct {
 	AttachmentID string
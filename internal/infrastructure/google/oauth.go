@@ -12,6 +12,8 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 // Common Google OAuth2 scopes
@@ -70,8 +72,39 @@ var (
 	ErrExchangeFailed = errors.New("authorization code exchange failed")
 	// ErrUserInfoFailed indicates fetching user info failed
 	ErrUserInfoFailed = errors.New("failed to fetch user info")
+	// ErrInvalidGrant indicates Google rejected a refresh token or
+	// authorization code because it was revoked, expired or already used.
+	// Retrying won't help: the user has to authorize again.
+	ErrInvalidGrant = errors.New("invalid grant")
 )
 
+// TokenError is an error response from Google's token endpoint. It matches
+// ErrExchangeFailed with errors.Is, and ErrInvalidGrant when Google
+// reported invalid_grant.
+type TokenError struct {
+	// StatusCode is the HTTP status of the response
+	StatusCode int
+	// Code is the OAuth error code, e.g. "invalid_grant"; empty if the
+	// body had none
+	Code string
+	// Description is Google's explanation of the error
+	Description string
+}
+
+// Error implements error
+func (e *TokenError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("%v: status %d", ErrExchangeFailed, e.StatusCode)
+	}
+	return fmt.Sprintf("%v: %s - %s", ErrExchangeFailed, e.Code, e.Description)
+}
+
+// Is reports whether target is ErrExchangeFailed, or ErrInvalidGrant for
+// invalid_grant errors
+func (e *TokenError) Is(target error) bool {
+	return target == ErrExchangeFailed || (target == ErrInvalidGrant && e.Code == "invalid_grant")
+}
+
 // Token represents an OAuth2 token with expiry tracking
 type Token struct {
 	AccessToken  string    `json:"access_token"`
@@ -244,7 +277,8 @@ func NewClient(config *Config) (*Client, error) {
 	return &Client{
 		config: config,
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout:   30 * time.Second,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
 		},
 		scopeManager: NewScopeManager(),
 	}, nil
@@ -311,8 +345,9 @@ func WithIncludeGrantedScopes() AuthCodeOption {
 	}
 }
 
-// Exchange exchanges an authorization code for a token
-func (c *Client) Exchange(ctx context.Context, code string) (*Token, error) {
+// Exchange exchanges an authorization code for a token. Flows started with
+// WithCodeChallenge must pass WithCodeVerifier.
+func (c *Client) Exchange(ctx context.Context, code string, opts ...ExchangeOption) (*Token, error) {
 	data := url.Values{
 		"code":          {code},
 		"client_id":     {c.config.ClientID},
@@ -320,11 +355,17 @@ func (c *Client) Exchange(ctx context.Context, code string) (*Token, error) {
 		"redirect_uri":  {c.config.RedirectURL},
 		"grant_type":    {"authorization_code"},
 	}
+	for _, opt := range opts {
+		opt(data)
+	}
 
 	return c.doTokenRequest(ctx, data)
 }
 
-// RefreshToken refreshes an access token using a refresh token
+// RefreshToken refreshes an access token using a refresh token. Google may
+// rotate the refresh token, returning a new one that replaces it; callers
+// must store the returned token's refresh token, not the one they passed.
+// A revoked or expired refresh token fails with ErrInvalidGrant.
 func (c *Client) RefreshToken(ctx context.Context, refreshToken string) (*Token, error) {
 	if refreshToken == "" {
 		return nil, fmt.Errorf("%w: refresh token is empty", ErrRefreshFailed)
@@ -339,10 +380,10 @@ func (c *Client) RefreshToken(ctx context.Context, refreshToken string) (*Token,
 
 	token, err := c.doTokenRequest(ctx, data)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrRefreshFailed, err)
+		return nil, fmt.Errorf("%w: %w", ErrRefreshFailed, err)
 	}
 
-	// Preserve the refresh token as Google doesn't always return a new one
+	// Keep the refresh token unless Google rotated it
 	if token.RefreshToken == "" {
 		token.RefreshToken = refreshToken
 	}
@@ -371,11 +412,13 @@ func (c *Client) doTokenRequest(ctx context.Context, data url.Values) (*Token, e
 	}
 
 	if resp.StatusCode != http.StatusOK {
+		tokenErr := &TokenError{StatusCode: resp.StatusCode}
 		var errResp tokenResponse
-		if err := json.Unmarshal(body, &errResp); err == nil && errResp.Error != "" {
-			return nil, fmt.Errorf("%w: %s - %s", ErrExchangeFailed, errResp.Error, errResp.ErrorDesc)
+		if err := json.Unmarshal(body, &errResp); err == nil {
+			tokenErr.Code = errResp.Error
+			tokenErr.Description = errResp.ErrorDesc
 		}
-		return nil, fmt.Errorf("%w: status %d", ErrExchangeFailed, resp.StatusCode)
+		return nil, tokenErr
 	}
 
 	var tr tokenResponse
@@ -466,15 +509,30 @@ func (c *Client) RevokeToken(ctx context.Context, token string) error {
 
 // TokenSource provides automatic token refresh capability
 type TokenSource struct {
-	client       *Client
+	refresh      func(ctx context.Context, current *Token) (*Token, error)
 	currentToken *Token
+	onRefresh    func(ctx context.Context, token *Token)
 	mu           sync.RWMutex
 }
 
 // NewTokenSource creates a new token source with automatic refresh
 func NewTokenSource(client *Client, initialToken *Token) *TokenSource {
 	return &TokenSource{
-		client:       client,
+		refresh: func(ctx context.Context, current *Token) (*Token, error) {
+			return client.RefreshToken(ctx, current.RefreshToken)
+		},
+		currentToken: initialToken,
+	}
+}
+
+// NewTokenSourceFunc creates a token source that gets a new token from
+// refresh once the current one expires, for callers that coordinate
+// refreshes themselves
+func NewTokenSourceFunc(initialToken *Token, refresh func(ctx context.Context) (*Token, error)) *TokenSource {
+	return &TokenSource{
+		refresh: func(ctx context.Context, _ *Token) (*Token, error) {
+			return refresh(ctx)
+		},
 		currentToken: initialToken,
 	}
 }
@@ -507,15 +565,27 @@ func (ts *TokenSource) Token(ctx context.Context) (*Token, error) {
 		return ts.currentToken, nil
 	}
 
-	newToken, err := ts.client.RefreshToken(ctx, ts.currentToken.RefreshToken)
+	newToken, err := ts.refresh(ctx, ts.currentToken)
 	if err != nil {
 		return nil, err
 	}
 
 	ts.currentToken = newToken
+	if ts.onRefresh != nil {
+		ts.onRefresh(ctx, newToken)
+	}
 	return newToken, nil
 }
 
+// OnRefresh sets a function called with each refreshed token, to store it.
+// Google may have rotated the refresh token, in which case the stored one
+// no longer works.
+func (ts *TokenSource) OnRefresh(fn func(ctx context.Context, token *Token)) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	ts.onRefresh = fn
+}
+
 // SetToken updates the current token
 func (ts *TokenSource) SetToken(token *Token) {
 	ts.mu.Lock()
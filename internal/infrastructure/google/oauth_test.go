@@ -0,0 +1,160 @@
+package google
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// redirectTransport sends every request to a test server
+type redirectTransport struct {
+	target *url.URL
+}
+
+// RoundTrip implements http.RoundTripper
+func (t redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// newTestClient returns a client whose token requests are handled by handler
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	target, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	client, err := NewClientWithHTTP(&Config{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RedirectURL:  "https://example.com/callback",
+	}, &http.Client{Transport: redirectTransport{target: target}})
+	require.NoError(t, err)
+	return client
+}
+
+func TestPKCE(t *testing.T) {
+	verifier, err := GenerateCodeVerifier()
+	require.NoError(t, err)
+	assert.Len(t, verifier, 43)
+
+	sum := sha256.Sum256([]byte(verifier))
+	challenge := base64.RawURLEncoding.EncodeToString(sum[:])
+	assert.Equal(t, challenge, CodeChallenge(verifier))
+
+	var form url.Values
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		form = r.PostForm
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"access","refresh_token":"refresh","expires_in":3600}`))
+	})
+
+	authURL, err := url.Parse(client.AuthCodeURL("state", WithCodeChallenge(verifier)))
+	require.NoError(t, err)
+	assert.Equal(t, challenge, authURL.Query().Get("code_challenge"))
+	assert.Equal(t, "S256", authURL.Query().Get("code_challenge_method"))
+
+	_, err = client.Exchange(context.Background(), "code", WithCodeVerifier(verifier))
+	require.NoError(t, err)
+	assert.Equal(t, verifier, form.Get("code_verifier"))
+	assert.Equal(t, "authorization_code", form.Get("grant_type"))
+}
+
+func TestRefreshToken(t *testing.T) {
+	t.Run("rotated refresh token", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"access_token":"new-access","refresh_token":"rotated","expires_in":3600}`))
+		})
+
+		token, err := client.RefreshToken(context.Background(), "old")
+		require.NoError(t, err)
+		assert.Equal(t, "rotated", token.RefreshToken)
+	})
+
+	t.Run("refresh token kept", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"access_token":"new-access","expires_in":3600}`))
+		})
+
+		token, err := client.RefreshToken(context.Background(), "old")
+		require.NoError(t, err)
+		assert.Equal(t, "old", token.RefreshToken)
+	})
+
+	t.Run("invalid grant", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"invalid_grant","error_description":"Token has been expired or revoked."}`))
+		})
+
+		_, err := client.RefreshToken(context.Background(), "revoked")
+		assert.ErrorIs(t, err, ErrRefreshFailed)
+		assert.ErrorIs(t, err, ErrExchangeFailed)
+		assert.ErrorIs(t, err, ErrInvalidGrant)
+
+		var tokenErr *TokenError
+		require.ErrorAs(t, err, &tokenErr)
+		assert.Equal(t, http.StatusBadRequest, tokenErr.StatusCode)
+	})
+
+	t.Run("transient failure", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+
+		_, err := client.RefreshToken(context.Background(), "refresh")
+		assert.ErrorIs(t, err, ErrRefreshFailed)
+		assert.NotErrorIs(t, err, ErrInvalidGrant)
+	})
+}
+
+func TestTokenSourceOnRefresh(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"access_token":"new-access","refresh_token":"rotated","expires_in":3600}`))
+	})
+	source := NewTokenSource(client, &Token{
+		AccessToken:  "expired",
+		RefreshToken: "old",
+		Expiry:       time.Now().Add(-time.Minute),
+	})
+
+	var refreshed *Token
+	source.OnRefresh(func(ctx context.Context, token *Token) { refreshed = token })
+
+	token, err := source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "new-access", token.AccessToken)
+	require.NotNil(t, refreshed)
+	assert.Equal(t, "rotated", refreshed.RefreshToken)
+}
+
+func TestTokenSourceFunc(t *testing.T) {
+	calls := 0
+	source := NewTokenSourceFunc(&Token{
+		AccessToken:  "expired",
+		RefreshToken: "refresh",
+		Expiry:       time.Now().Add(-time.Minute),
+	}, func(ctx context.Context) (*Token, error) {
+		calls++
+		return &Token{AccessToken: "renewed", RefreshToken: "refresh", Expiry: time.Now().Add(time.Hour)}, nil
+	})
+
+	for i := 0; i < 2; i++ {
+		token, err := source.Token(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "renewed", token.AccessToken)
+	}
+	assert.Equal(t, 1, calls, "a renewed token is reused until it expires")
+}
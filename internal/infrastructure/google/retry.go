@@ -0,0 +1,230 @@
+package google
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryConfig holds configuration for retrying Gmail and Drive API requests
+// that were rate limited or hit a transient server error
+type RetryConfig struct {
+	// MaxAttempts is the number of times a request is sent, including the
+	// first. One disables retries.
+	MaxAttempts int
+	// BaseDelay is the backoff before the first retry; it doubles with each
+	// further attempt
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff between attempts
+	MaxDelay time.Duration
+	// MaxRetryAfter is the longest Retry-After the client will wait out.
+	// Longer waits are returned to the caller as a QuotaError instead.
+	MaxRetryAfter time.Duration
+}
+
+// DefaultRetryConfig returns sensible default configuration
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts:   5,
+		BaseDelay:     time.Second,
+		MaxDelay:      32 * time.Second,
+		MaxRetryAfter: 2 * time.Minute,
+	}
+}
+
+// QuotaError is returned when Google keeps rejecting requests because a rate
+// limit or quota was exceeded, after any retries. Callers should stop making
+// requests, rather than treat the item being fetched as failed, and try again
+// after RetryAfter. It matches ErrRateLimited and ErrQuotaExceeded with
+// errors.Is.
+type QuotaError struct {
+	// API is the API that rejected the request, "gmail" or "drive"
+	API string
+	// StatusCode is the status of the last response
+	StatusCode int
+	// Reason is Google's error reason, e.g. "userRateLimitExceeded"
+	Reason string
+	// Message is Google's error message
+	Message string
+	// RetryAfter is how long Google asked callers to wait, or zero if it
+	// didn't say
+	RetryAfter time.Duration
+}
+
+// Error implements error
+func (e *QuotaError) Error() string {
+	msg := fmt.Sprintf("%s API quota exceeded (status %d", e.API, e.StatusCode)
+	if e.Reason != "" {
+		msg += ", " + e.Reason
+	}
+	msg += ")"
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.RetryAfter > 0 {
+		msg += fmt.Sprintf("; retry after %s", e.RetryAfter)
+	}
+	return msg
+}
+
+// Is reports whether target is ErrRateLimited or ErrQuotaExceeded
+func (e *QuotaError) Is(target error) bool {
+	return target == ErrRateLimited || target == ErrQuotaExceeded
+}
+
+// Error reasons Google reports with 403 responses for rate limits. Rate
+// limits clear within seconds and are retried; daily quotas don't.
+var (
+	rateLimitReasons = map[string]bool{
+		"rateLimitExceeded":     true,
+		"userRateLimitExceeded": true,
+	}
+	quotaReasons = map[string]bool{
+		"dailyLimitExceeded": true,
+		"quotaExceeded":      true,
+	}
+)
+
+// googleError is the error body shared by the Gmail and Drive APIs
+type googleError struct {
+	Error struct {
+		Message string `json:"message"`
+		Errors  []struct {
+			Reason string `json:"reason"`
+		} `json:"errors"`
+	} `json:"error"`
+}
+
+// retrier sends requests, retrying those that were rate limited or hit a
+// transient server error
+type retrier struct {
+	api    string
+	config RetryConfig
+}
+
+// do calls send until it returns a response that shouldn't be retried or
+// the attempts run out. send must build a new request each time. Rate limit
+// and quota rejections that aren't resolved by retrying are returned as a
+// *QuotaError; other responses, including a final server error, are
+// returned for the caller to handle.
+func (r retrier) do(ctx context.Context, send func() (*http.Response, error)) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		resp, err := send()
+		if err != nil {
+			return nil, err
+		}
+
+		quota, retryable, err := r.classify(resp)
+		if err != nil {
+			return nil, err
+		}
+		if !retryable {
+			if quota != nil {
+				return nil, quota
+			}
+			return resp, nil
+		}
+
+		delay := r.backoff(attempt)
+		if quota != nil && quota.RetryAfter > 0 {
+			delay = quota.RetryAfter
+		}
+		if attempt >= r.config.MaxAttempts || delay > r.config.MaxRetryAfter {
+			if quota != nil {
+				return nil, quota
+			}
+			return resp, nil
+		}
+
+		// Drain so the connection can be reused
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// classify reports whether a response was a rate limit or quota rejection,
+// as a QuotaError with its body consumed, and whether it is worth retrying.
+// Bodies of other error responses are left readable.
+func (r retrier) classify(resp *http.Response) (*QuotaError, bool, error) {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusForbidden:
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return nil, true, nil
+	default:
+		return nil, false, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, false, fmt.Errorf("reading response: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	var errResp googleError
+	_ = json.Unmarshal(body, &errResp)
+	reason := ""
+	if len(errResp.Error.Errors) > 0 {
+		reason = errResp.Error.Errors[0].Reason
+	}
+
+	// A 403 is only a quota rejection if Google says so; otherwise it is
+	// left for the caller to report as access denied
+	if resp.StatusCode == http.StatusForbidden && !rateLimitReasons[reason] && !quotaReasons[reason] {
+		return nil, false, nil
+	}
+
+	quota := &QuotaError{
+		API:        r.api,
+		StatusCode: resp.StatusCode,
+		Reason:     reason,
+		Message:    errResp.Error.Message,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+	}
+	return quota, !quotaReasons[reason], nil
+}
+
+// backoff returns the jittered delay before retrying after the given
+// attempt: a random duration between half and all of the exponential delay
+func (r retrier) backoff(attempt int) time.Duration {
+	delay := r.config.BaseDelay << (attempt - 1)
+	if delay <= 0 || delay > r.config.MaxDelay {
+		delay = r.config.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an
+// HTTP date, returning zero if it is missing or invalid
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0)
+	}
+	return 0
+}
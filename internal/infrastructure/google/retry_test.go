@@ -0,0 +1,193 @@
+package google
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	rateLimitBody  = `{"error":{"message":"Rate limit exceeded","errors":[{"reason":"userRateLimitExceeded"}]}}`
+	dailyQuotaBody = `{"error":{"message":"Daily limit exceeded","errors":[{"reason":"dailyLimitExceeded"}]}}`
+	forbiddenBody  = `{"error":{"message":"Insufficient permission","errors":[{"reason":"insufficientPermissions"}]}}`
+)
+
+// response is one canned reply of a test server
+type response struct {
+	status     int
+	retryAfter string
+	body       string
+}
+
+// serveSequence returns a server that replies with responses in order,
+// repeating the last one, and a counter of the requests it received
+func serveSequence(t *testing.T, responses ...response) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1))
+		resp := responses[min(n, len(responses))-1]
+		if resp.retryAfter != "" {
+			w.Header().Set("Retry-After", resp.retryAfter)
+		}
+		w.WriteHeader(resp.status)
+		io.WriteString(w, resp.body)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func testRetrier() retrier {
+	return retrier{
+		api: "gmail",
+		config: RetryConfig{
+			MaxAttempts:   3,
+			BaseDelay:     time.Millisecond,
+			MaxDelay:      5 * time.Millisecond,
+			MaxRetryAfter: 2 * time.Second,
+		},
+	}
+}
+
+func get(server *httptest.Server) func() (*http.Response, error) {
+	return func() (*http.Response, error) {
+		return http.Get(server.URL)
+	}
+}
+
+func TestRetrierRetriesRateLimitAfterRetryAfter(t *testing.T) {
+	server, calls := serveSequence(t,
+		response{status: http.StatusTooManyRequests, retryAfter: "1", body: rateLimitBody},
+		response{status: http.StatusOK, body: "ok"},
+	)
+
+	start := time.Now()
+	resp, err := testRetrier().do(context.Background(), get(server))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.EqualValues(t, 2, calls.Load())
+	assert.GreaterOrEqual(t, time.Since(start), time.Second, "waits out Retry-After")
+}
+
+func TestRetrierRetryAfterTooLong(t *testing.T) {
+	server, calls := serveSequence(t,
+		response{status: http.StatusTooManyRequests, retryAfter: "3600", body: rateLimitBody},
+	)
+
+	_, err := testRetrier().do(context.Background(), get(server))
+
+	var quota *QuotaError
+	require.ErrorAs(t, err, &quota)
+	assert.Equal(t, time.Hour, quota.RetryAfter)
+	assert.Equal(t, "userRateLimitExceeded", quota.Reason)
+	assert.True(t, errors.Is(err, ErrRateLimited))
+	assert.EqualValues(t, 1, calls.Load(), "a wait longer than MaxRetryAfter is left to the caller")
+}
+
+func TestRetrierServerErrors(t *testing.T) {
+	t.Run("retried until success", func(t *testing.T) {
+		server, calls := serveSequence(t,
+			response{status: http.StatusServiceUnavailable},
+			response{status: http.StatusBadGateway},
+			response{status: http.StatusOK, body: "ok"},
+		)
+
+		resp, err := testRetrier().do(context.Background(), get(server))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.EqualValues(t, 3, calls.Load())
+	})
+
+	t.Run("last error returned once attempts run out", func(t *testing.T) {
+		server, calls := serveSequence(t,
+			response{status: http.StatusInternalServerError, body: "boom"},
+		)
+
+		resp, err := testRetrier().do(context.Background(), get(server))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		assert.EqualValues(t, 3, calls.Load())
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "boom", string(body))
+	})
+}
+
+func TestRetrierDailyQuota(t *testing.T) {
+	server, calls := serveSequence(t,
+		response{status: http.StatusForbidden, body: dailyQuotaBody},
+	)
+
+	_, err := testRetrier().do(context.Background(), get(server))
+
+	var quota *QuotaError
+	require.ErrorAs(t, err, &quota)
+	assert.Equal(t, "gmail", quota.API)
+	assert.Equal(t, http.StatusForbidden, quota.StatusCode)
+	assert.Equal(t, "dailyLimitExceeded", quota.Reason)
+	assert.Equal(t, "Daily limit exceeded", quota.Message)
+	assert.True(t, errors.Is(err, ErrQuotaExceeded))
+	assert.EqualValues(t, 1, calls.Load(), "daily quotas are not retried")
+}
+
+func TestRetrierForbiddenWithoutQuotaReason(t *testing.T) {
+	server, calls := serveSequence(t,
+		response{status: http.StatusForbidden, body: forbiddenBody},
+	)
+
+	resp, err := testRetrier().do(context.Background(), get(server))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.EqualValues(t, 1, calls.Load())
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, forbiddenBody, string(body), "the body is left for the caller")
+}
+
+func TestRetrierStopsWhenCancelled(t *testing.T) {
+	server, _ := serveSequence(t,
+		response{status: http.StatusTooManyRequests, retryAfter: "1", body: rateLimitBody},
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := testRetrier().do(ctx, get(server))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{name: "missing", value: "", want: 0},
+		{name: "seconds", value: "30", want: 30 * time.Second},
+		{name: "negative", value: "-5", want: 0},
+		{name: "invalid", value: "soon", want: 0},
+		{name: "past date", value: "Mon, 02 Jan 2006 15:04:05 GMT", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseRetryAfter(tt.value))
+		})
+	}
+}
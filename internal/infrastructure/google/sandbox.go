@@ -0,0 +1,615 @@
+package google
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"html"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/domain/money"
+)
+
+// Labels of the sandbox mailbox besides the system ones
+const (
+	SandboxLabelReceipts    = "Label_receipts"
+	SandboxLabelNewsletters = "Label_newsletters"
+)
+
+const (
+	// sandboxWindowDays is how many days back the sandbox mailbox goes.
+	// History older than the window has expired, as it does on Gmail.
+	sandboxWindowDays = 120
+
+	// sandboxAccessToken is the access token of sandbox connections
+	sandboxAccessToken = "sandbox"
+)
+
+// sandboxMerchant is a store the sandbox mailbox has receipts from
+type sandboxMerchant struct {
+	Name   string
+	Sender string
+	Items  []string
+	Min    float64
+	Max    float64
+}
+
+// sandboxMerchants are the stores receipts in the sandbox mailbox are from
+var sandboxMerchants = []sandboxMerchant{
+	{Name: "Blue Bottle Coffee", Sender: "receipts@bluebottle.example", Items: []string{"Latte", "Cold brew", "Croissant", "Pour over"}, Min: 4, Max: 18},
+	{Name: "Whole Foods Market", Sender: "no-reply@wholefoods.example", Items: []string{"Organic bananas", "Sourdough loaf", "Greek yogurt", "Salmon fillet", "Spinach"}, Min: 25, Max: 180},
+	{Name: "Shell", Sender: "receipts@shell.example", Items: []string{"Unleaded fuel"}, Min: 30, Max: 75},
+	{Name: "Uber", Sender: "noreply@uber.example", Items: []string{"Trip fare", "Booking fee"}, Min: 9, Max: 45},
+	{Name: "Amazon", Sender: "auto-confirm@amazon.example", Items: []string{"USB-C cable", "Paperback book", "Desk lamp", "Phone case"}, Min: 12, Max: 150},
+	{Name: "Target", Sender: "receipts@target.example", Items: []string{"Laundry detergent", "Paper towels", "T-shirt", "Snacks"}, Min: 15, Max: 120},
+	{Name: "Chipotle", Sender: "receipts@chipotle.example", Items: []string{"Burrito bowl", "Chips & guac", "Lemonade"}, Min: 10, Max: 28},
+}
+
+// sandboxNewsletters are the subjects of the sandbox mailbox's emails that
+// aren't receipts
+var sandboxNewsletters = []string{
+	"This week's new arrivals",
+	"Tips for getting the most out of your weekend",
+	"Our spring collection is here",
+	"Members get early access this Friday",
+}
+
+// sandboxMessage is an email in the sandbox mailbox
+type sandboxMessage struct {
+	ID         string
+	HistoryID  uint64
+	Received   time.Time
+	LabelIDs   []string
+	From       string
+	Subject    string
+	Text       string
+	HTML       string
+	Attachment *sandboxAttachment
+}
+
+// sandboxAttachment is a receipt attached to a sandbox email
+type sandboxAttachment struct {
+	ID       string
+	Filename string
+	Data     []byte
+}
+
+// SandboxMailbox is a synthetic Gmail mailbox of receipt emails, from a
+// handful of stores, mixed with newsletters. It is generated from the
+// account's email address and the current time: every day of the window
+// has the same emails each time the mailbox is generated, so it only grows
+// as days pass, with history IDs increasing like Gmail's do.
+type SandboxMailbox struct {
+	email string
+	now   time.Time
+	// messages are ordered newest first
+	messages []sandboxMessage
+}
+
+// NewSandboxMailbox generates the sandbox mailbox of an email address as it
+// is at now
+func NewSandboxMailbox(email string, now time.Time) *SandboxMailbox {
+	hash := fnv.New64a()
+	hash.Write([]byte(email))
+	seed := hash.Sum64()
+
+	mailbox := &SandboxMailbox{email: email, now: now}
+	today := now.Unix() / 86400
+	for day := today; day > today-sandboxWindowDays; day-- {
+		rng := rand.New(rand.NewPCG(seed, uint64(day)))
+		midnight := time.Unix(day*86400, 0).UTC()
+
+		// Generate both emails so each day's are the same whatever the time
+		var daily []sandboxMessage
+		receipt := sandboxReceipt(rng, seed, day, midnight)
+		if rng.Float64() < 0.5 {
+			daily = append(daily, receipt)
+		}
+		newsletter := sandboxNewsletter(rng, seed, day, midnight)
+		if rng.Float64() < 0.2 {
+			daily = append(daily, newsletter)
+		}
+
+		// History IDs follow the order emails were received in
+		slices.SortFunc(daily, func(a, b sandboxMessage) int {
+			return a.Received.Compare(b.Received)
+		})
+		for i := len(daily) - 1; i >= 0; i-- {
+			daily[i].HistoryID = sandboxHistoryID(day, i)
+			if !daily[i].Received.After(now) {
+				mailbox.messages = append(mailbox.messages, daily[i])
+			}
+		}
+	}
+	return mailbox
+}
+
+// sandboxReceipt generates a day's receipt email
+func sandboxReceipt(rng *rand.Rand, seed uint64, day int64, midnight time.Time) sandboxMessage {
+	merchant := sandboxMerchants[rng.IntN(len(sandboxMerchants))]
+	received := midnight.Add(time.Duration(8*60+rng.IntN(13*60)) * time.Minute)
+	order := fmt.Sprintf("%s-%06d", strings.ToUpper(merchant.Name[:2]), rng.IntN(1000000))
+
+	// Split the subtotal among up to three items
+	subtotal := money.RoundCents(merchant.Min + rng.Float64()*(merchant.Max-merchant.Min))
+	count := 1 + rng.IntN(min(3, len(merchant.Items)))
+	items := rng.Perm(len(merchant.Items))[:count]
+	lines := make([]string, 0, count+6)
+	remaining := subtotal
+	for i, item := range items {
+		price := remaining
+		if i < count-1 {
+			price = money.RoundCents(remaining * (0.3 + rng.Float64()*0.4))
+		}
+		remaining = money.RoundCents(remaining - price)
+		lines = append(lines, fmt.Sprintf("%-24s %8.2f", merchant.Items[item], price))
+	}
+	tax := money.RoundCents(subtotal * 0.0825)
+	total := money.RoundCents(subtotal + tax)
+
+	text := strings.Join([]string{
+		"Thank you for shopping at " + merchant.Name + ".",
+		"",
+		"Order #" + order,
+		"Date: " + received.Format("Jan 2, 2006 15:04"),
+		"",
+		strings.Join(lines, "\n"),
+		"",
+		fmt.Sprintf("%-24s %8.2f", "Subtotal", subtotal),
+		fmt.Sprintf("%-24s %8.2f", "Tax", tax),
+		fmt.Sprintf("%-24s %8.2f", "Total", total),
+		"",
+		fmt.Sprintf("Paid with Visa ending in %04d", 1000+rng.IntN(9000)),
+	}, "\n")
+
+	id := sandboxMessageID(seed, day, 0)
+	return sandboxMessage{
+		ID:       id,
+		Received: received,
+		LabelIDs: []string{LabelInbox, SandboxLabelReceipts},
+		From:     fmt.Sprintf("%s <%s>", merchant.Name, merchant.Sender),
+		Subject:  "Your receipt from " + merchant.Name,
+		Text:     text,
+		HTML:     "<html><body><h1>" + html.EscapeString(merchant.Name) + "</h1><pre>" + html.EscapeString(text) + "</pre></body></html>",
+		Attachment: &sandboxAttachment{
+			ID:       "att-" + id,
+			Filename: "receipt-" + order + ".pdf",
+			Data:     sandboxPDF(strings.Split(text, "\n")),
+		},
+	}
+}
+
+// sandboxNewsletter generates a day's newsletter email
+func sandboxNewsletter(rng *rand.Rand, seed uint64, day int64, midnight time.Time) sandboxMessage {
+	merchant := sandboxMerchants[rng.IntN(len(sandboxMerchants))]
+	subject := sandboxNewsletters[rng.IntN(len(sandboxNewsletters))]
+	text := subject + "\n\nVisit us in store or online to see what's new at " + merchant.Name + "."
+
+	return sandboxMessage{
+		ID:       sandboxMessageID(seed, day, 1),
+		Received: midnight.Add(time.Duration(6*60+rng.IntN(16*60)) * time.Minute),
+		LabelIDs: []string{LabelInbox, SandboxLabelNewsletters},
+		From:     fmt.Sprintf("%s <%s>", merchant.Name, merchant.Sender),
+		Subject:  subject,
+		Text:     text,
+		HTML:     "<html><body><p>" + html.EscapeString(text) + "</p></body></html>",
+	}
+}
+
+// sandboxMessageID returns the ID of one of a day's emails, unique to the
+// mailbox
+func sandboxMessageID(seed uint64, day int64, slot int) string {
+	return fmt.Sprintf("%08x%06x%02x", uint32(seed), day, slot)
+}
+
+// sandboxHistoryID returns the history ID of the nth email received on a
+// day
+func sandboxHistoryID(day int64, n int) uint64 {
+	return uint64(day)*10 + uint64(n) + 1
+}
+
+// sandboxPDF returns a one-page PDF showing lines of text
+func sandboxPDF(lines []string) []byte {
+	var content strings.Builder
+	content.WriteString("BT /F1 10 Tf 14 TL 50 780 Td\n")
+	for _, line := range lines {
+		escaped := strings.NewReplacer(`\`, `\\`, "(", `\(`, ")", `\)`).Replace(line)
+		fmt.Fprintf(&content, "(%s) Tj T*\n", escaped)
+	}
+	content.WriteString("ET")
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 842] /Resources << /Font << /F1 5 0 R >> >> /Contents 4 0 R >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier >>",
+	}
+
+	var pdf bytes.Buffer
+	pdf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = pdf.Len()
+		fmt.Fprintf(&pdf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := pdf.Len()
+	fmt.Fprintf(&pdf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&pdf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&pdf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return pdf.Bytes()
+}
+
+// historyID returns the mailbox's current history ID, that of its latest
+// email
+func (m *SandboxMailbox) historyID() uint64 {
+	if len(m.messages) == 0 {
+		return sandboxHistoryID(m.now.Unix()/86400, 0)
+	}
+	return m.messages[0].HistoryID
+}
+
+// message returns the email with an ID
+func (m *SandboxMailbox) message(id string) (*sandboxMessage, bool) {
+	for i := range m.messages {
+		if m.messages[i].ID == id {
+			return &m.messages[i], true
+		}
+	}
+	return nil, false
+}
+
+// labels returns the mailbox's labels with their message counts
+func (m *SandboxMailbox) labels() []GmailLabel {
+	labels := []GmailLabel{
+		{ID: LabelInbox, Name: LabelInbox, Type: "system"},
+		{ID: LabelSpam, Name: LabelSpam, Type: "system"},
+		{ID: LabelTrash, Name: LabelTrash, Type: "system"},
+		{ID: SandboxLabelReceipts, Name: "Receipts", Type: "user"},
+		{ID: SandboxLabelNewsletters, Name: "Newsletters", Type: "user"},
+	}
+	for i := range labels {
+		for _, message := range m.messages {
+			if slices.Contains(message.LabelIDs, labels[i].ID) {
+				labels[i].MessagesTotal++
+				labels[i].ThreadsTotal++
+			}
+		}
+	}
+	return labels
+}
+
+// list returns the emails with all of labelIDs received in the range a
+// Gmail search query gives with after: and before: terms, newest first.
+// Other search terms are ignored.
+func (m *SandboxMailbox) list(labelIDs []string, query string) []sandboxMessage {
+	var after, before time.Time
+	for _, term := range strings.Fields(query) {
+		name, value, ok := strings.Cut(term, ":")
+		if !ok {
+			continue
+		}
+		switch name {
+		case "after":
+			after = sandboxQueryTime(value)
+		case "before":
+			before = sandboxQueryTime(value)
+		}
+	}
+
+	var listed []sandboxMessage
+	for _, message := range m.messages {
+		if !after.IsZero() && message.Received.Before(after) {
+			continue
+		}
+		if !before.IsZero() && !message.Received.Before(before) {
+			continue
+		}
+		labeled := true
+		for _, labelID := range labelIDs {
+			if !slices.Contains(message.LabelIDs, labelID) {
+				labeled = false
+				break
+			}
+		}
+		if labeled {
+			listed = append(listed, message)
+		}
+	}
+	return listed
+}
+
+// sandboxQueryTime parses the time of an after: or before: search term,
+// given in Unix seconds or as YYYY/MM/DD
+func sandboxQueryTime(value string) time.Time {
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0)
+	}
+	if date, err := time.Parse("2006/01/02", value); err == nil {
+		return date
+	}
+	return time.Time{}
+}
+
+// toGmail returns an email as the Gmail API does in a format
+func (message *sandboxMessage) toGmail(format MessageFormat, to string) GmailMessage {
+	snippet := strings.Join(strings.Fields(message.Text), " ")
+	if len(snippet) > 100 {
+		snippet = snippet[:100]
+	}
+	gmail := GmailMessage{
+		ID:           message.ID,
+		ThreadID:     message.ID,
+		LabelIDs:     message.LabelIDs,
+		Snippet:      snippet,
+		HistoryID:    strconv.FormatUint(message.HistoryID, 10),
+		InternalDate: strconv.FormatInt(message.Received.UnixMilli(), 10),
+		SizeEstimate: len(message.Text) + len(message.HTML),
+	}
+
+	headers := []MessageHeader{
+		{Name: "From", Value: message.From},
+		{Name: "To", Value: to},
+		{Name: "Subject", Value: message.Subject},
+		{Name: "Date", Value: message.Received.Format(time.RFC1123Z)},
+		{Name: "Message-ID", Value: "<" + message.ID + "@sandbox.example>"},
+	}
+
+	switch format {
+	case FormatMinimal:
+	case FormatMetadata:
+		gmail.Payload = &MessagePart{MimeType: "multipart/mixed", Headers: headers}
+	case FormatRaw:
+		gmail.Raw = base64.URLEncoding.EncodeToString(message.rfc2822(headers))
+	default:
+		gmail.Payload = message.payload(headers)
+	}
+	return gmail
+}
+
+// payload returns the MIME parts of an email: its text and HTML bodies and
+// its receipt, if it has one
+func (message *sandboxMessage) payload(headers []MessageHeader) *MessagePart {
+	body := MessagePart{
+		PartID:   "0",
+		MimeType: "multipart/alternative",
+		Parts: []MessagePart{
+			{PartID: "0.0", MimeType: "text/plain", Body: encodedBody(message.Text)},
+			{PartID: "0.1", MimeType: "text/html", Body: encodedBody(message.HTML)},
+		},
+	}
+	if message.Attachment == nil {
+		body.PartID = ""
+		body.Headers = headers
+		return &body
+	}
+
+	return &MessagePart{
+		MimeType: "multipart/mixed",
+		Headers:  headers,
+		Parts: []MessagePart{
+			body,
+			{
+				PartID:   "1",
+				MimeType: "application/pdf",
+				Filename: message.Attachment.Filename,
+				Body: &MessagePartBody{
+					AttachmentID: message.Attachment.ID,
+					Size:         len(message.Attachment.Data),
+				},
+			},
+		},
+	}
+}
+
+// encodedBody returns text as a message part body
+func encodedBody(text string) *MessagePartBody {
+	return &MessagePartBody{
+		Size: len(text),
+		Data: base64.URLEncoding.EncodeToString([]byte(text)),
+	}
+}
+
+// rfc2822 returns an email in RFC 2822 format, without its receipt
+func (message *sandboxMessage) rfc2822(headers []MessageHeader) []byte {
+	var raw bytes.Buffer
+	for _, header := range headers {
+		fmt.Fprintf(&raw, "%s: %s\r\n", header.Name, header.Value)
+	}
+	raw.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	raw.WriteString(strings.ReplaceAll(message.Text, "\n", "\r\n"))
+	return raw.Bytes()
+}
+
+// SandboxTransport serves the Gmail API from the sandbox mailbox of an
+// email address, for connections made in sandbox mode. It answers the
+// requests GmailClient makes, so syncs run just as they do against Gmail.
+type SandboxTransport struct {
+	Email string
+	// Now returns the time the mailbox is generated at; time.Now if nil
+	Now func() time.Time
+}
+
+// NewSandboxGmailClient creates a Gmail client reading the sandbox mailbox
+// of an email address
+func NewSandboxGmailClient(email string) *GmailClient {
+	return NewGmailClientWithHTTP(
+		NewTokenSource(nil, SandboxToken()),
+		&http.Client{Transport: &SandboxTransport{Email: email}},
+	)
+}
+
+// SandboxToken returns the token sandbox connections are stored with. It
+// never expires, so it is never refreshed.
+func SandboxToken() *Token {
+	return &Token{
+		AccessToken: sandboxAccessToken,
+		TokenType:   "Bearer",
+	}
+}
+
+// RoundTrip answers a Gmail API request from the sandbox mailbox
+func (t *SandboxTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	now := time.Now()
+	if t.Now != nil {
+		now = t.Now()
+	}
+	mailbox := NewSandboxMailbox(t.Email, now)
+
+	status, body := mailbox.serve(req)
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("encoding sandbox response: %w", err)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(encoded)),
+		ContentLength: int64(len(encoded)),
+		Request:       req,
+	}, nil
+}
+
+// serve returns the status and body of the Gmail API's response to a
+// request
+func (m *SandboxMailbox) serve(req *http.Request) (int, any) {
+	if req.Method != http.MethodGet {
+		return sandboxError(http.StatusMethodNotAllowed, "The sandbox mailbox is read-only")
+	}
+	if req.Header.Get("Authorization") != "Bearer "+sandboxAccessToken {
+		return sandboxError(http.StatusUnauthorized, "Invalid Credentials")
+	}
+
+	path, ok := strings.CutPrefix(req.URL.Path, strings.TrimPrefix(gmailUsersURL, "https://www.googleapis.com"))
+	if !ok {
+		return sandboxError(http.StatusNotFound, "Requested entity was not found.")
+	}
+	query := req.URL.Query()
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	switch {
+	case len(parts) == 1 && parts[0] == "profile":
+		return http.StatusOK, GmailProfile{
+			EmailAddress:  m.email,
+			MessagesTotal: len(m.messages),
+			ThreadsTotal:  len(m.messages),
+			HistoryID:     strconv.FormatUint(m.historyID(), 10),
+		}
+	case len(parts) == 1 && parts[0] == "labels":
+		return http.StatusOK, LabelListResponse{Labels: m.labels()}
+	case len(parts) == 2 && parts[0] == "labels":
+		for _, label := range m.labels() {
+			if label.ID == parts[1] {
+				return http.StatusOK, label
+			}
+		}
+		return sandboxError(http.StatusNotFound, "Label not found")
+	case len(parts) == 1 && parts[0] == "messages":
+		return m.serveList(query)
+	case len(parts) == 2 && parts[0] == "messages":
+		message, ok := m.message(parts[1])
+		if !ok {
+			return sandboxError(http.StatusNotFound, "Requested message not found")
+		}
+		return http.StatusOK, message.toGmail(MessageFormat(query.Get("format")), m.email)
+	case len(parts) == 4 && parts[0] == "messages" && parts[2] == "attachments":
+		message, ok := m.message(parts[1])
+		if !ok || message.Attachment == nil || message.Attachment.ID != parts[3] {
+			return sandboxError(http.StatusNotFound, "Requested attachment not found")
+		}
+		return http.StatusOK, GmailAttachment{
+			Size: len(message.Attachment.Data),
+			Data: base64.URLEncoding.EncodeToString(message.Attachment.Data),
+		}
+	case len(parts) == 1 && parts[0] == "history":
+		return m.serveHistory(query)
+	}
+	return sandboxError(http.StatusNotFound, "Requested entity was not found.")
+}
+
+// serveList answers a request listing messages, a page of maxResults at a
+// time with offsets as page tokens
+func (m *SandboxMailbox) serveList(query url.Values) (int, any) {
+	messages := m.list(query["labelIds"], query.Get("q"))
+
+	pageSize := 100
+	if n, err := strconv.Atoi(query.Get("maxResults")); err == nil && n > 0 {
+		pageSize = min(n, 500)
+	}
+	offset := 0
+	if token := query.Get("pageToken"); token != "" {
+		n, err := strconv.Atoi(token)
+		if err != nil || n < 0 || n > len(messages) {
+			return sandboxError(http.StatusBadRequest, "Invalid pageToken")
+		}
+		offset = n
+	}
+	end := min(offset+pageSize, len(messages))
+
+	result := MessageListResponse{ResultSizeEstimate: len(messages)}
+	for _, message := range messages[offset:end] {
+		result.Messages = append(result.Messages, GmailMessage{ID: message.ID, ThreadID: message.ID})
+	}
+	if end < len(messages) {
+		result.NextPageToken = strconv.Itoa(end)
+	}
+	return http.StatusOK, result
+}
+
+// serveHistory answers a request listing the messages added since a
+// history ID, oldest first. History from before the mailbox's window has
+// expired.
+func (m *SandboxMailbox) serveHistory(query url.Values) (int, any) {
+	start, err := strconv.ParseUint(query.Get("startHistoryId"), 10, 64)
+	oldest := sandboxHistoryID(m.now.Unix()/86400-sandboxWindowDays+1, 0)
+	if err != nil || start < oldest {
+		return sandboxError(http.StatusBadRequest, "Invalid startHistoryId: the historyId is unknown or too old")
+	}
+	labelID := query.Get("labelId")
+
+	result := HistoryListResponse{HistoryID: strconv.FormatUint(m.historyID(), 10)}
+	for i := len(m.messages) - 1; i >= 0; i-- {
+		message := m.messages[i]
+		if message.HistoryID <= start {
+			continue
+		}
+		if labelID != "" && !slices.Contains(message.LabelIDs, labelID) {
+			continue
+		}
+		added := GmailMessage{ID: message.ID, ThreadID: message.ID, LabelIDs: message.LabelIDs}
+		result.History = append(result.History, History{
+			ID:            strconv.FormatUint(message.HistoryID, 10),
+			Messages:      []GmailMessage{added},
+			MessagesAdded: []HistoryMessage{{Message: added}},
+		})
+	}
+	return http.StatusOK, result
+}
+
+// sandboxError returns a Gmail API error response
+func sandboxError(status int, message string) (int, any) {
+	var body gmailErrorResponse
+	body.Error.Code = status
+	body.Error.Message = message
+	body.Error.Status = http.StatusText(status)
+	return status, body
+}
@@ -0,0 +1,131 @@
+package google
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sandboxClient returns a Gmail client reading the sandbox mailbox of
+// email as it is at now
+func sandboxClient(email string, now time.Time) *GmailClient {
+	return NewGmailClientWithHTTP(
+		NewTokenSource(nil, SandboxToken()),
+		&http.Client{Transport: &SandboxTransport{Email: email, Now: func() time.Time { return now }}},
+	)
+}
+
+func TestSandboxMailboxIsDeterministic(t *testing.T) {
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	first := NewSandboxMailbox("demo@sandbox.example", now)
+	second := NewSandboxMailbox("demo@sandbox.example", now)
+	other := NewSandboxMailbox("other@sandbox.example", now)
+
+	require.NotEmpty(t, first.messages)
+	assert.Equal(t, first.messages, second.messages)
+	assert.NotEqual(t, first.messages[0].ID, other.messages[0].ID)
+
+	// A day later the mailbox has the same emails, and perhaps new ones
+	later := NewSandboxMailbox("demo@sandbox.example", now.Add(24*time.Hour))
+	for _, message := range first.messages[:10] {
+		found, ok := later.message(message.ID)
+		require.True(t, ok)
+		assert.Equal(t, message.HistoryID, found.HistoryID)
+	}
+}
+
+func TestSandboxGmailClientSync(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	client := sandboxClient("demo@sandbox.example", now)
+
+	labels, err := client.ListLabels(ctx)
+	require.NoError(t, err)
+	var receipts *GmailLabel
+	for i := range labels {
+		if labels[i].ID == SandboxLabelReceipts {
+			receipts = &labels[i]
+		}
+	}
+	require.NotNil(t, receipts)
+	require.Positive(t, receipts.MessagesTotal)
+
+	// Listing pages through every receipt email
+	listed, err := client.ListAllMessagesByLabel(ctx, SandboxLabelReceipts, ListMessagesOptions{MaxResults: 7})
+	require.NoError(t, err)
+	assert.Len(t, listed, receipts.MessagesTotal)
+
+	message, err := client.GetMessageContent(ctx, listed[0].ID)
+	require.NoError(t, err)
+	assert.Contains(t, message.Payload.GetHeader("Subject"), "receipt")
+	text, html, err := GetMessageBody(message)
+	require.NoError(t, err)
+	assert.Contains(t, text, "Total")
+	assert.Contains(t, html, "<pre>")
+
+	attachments := GetAttachments(message)
+	require.Len(t, attachments, 1)
+	assert.Equal(t, "application/pdf", attachments[0].MimeType)
+	data, err := client.DownloadAttachment(ctx, message.ID, attachments[0].AttachmentID)
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(data, []byte("%PDF-")))
+	assert.Len(t, data, attachments[0].Size)
+
+	_, err = client.GetMessageContent(ctx, "missing")
+	assert.ErrorIs(t, err, ErrMessageNotFound)
+}
+
+func TestSandboxGmailClientQueryRange(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	client := sandboxClient("demo@sandbox.example", now)
+
+	after := now.AddDate(0, 0, -14)
+	listed, err := client.ListAllMessagesByLabel(ctx, LabelInbox, ListMessagesOptions{
+		Query: "after:" + strconv.FormatInt(after.Unix(), 10),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, listed)
+	for _, ref := range listed {
+		message, err := client.GetMessageMetadata(ctx, ref.ID, nil)
+		require.NoError(t, err)
+		received, err := message.InternalDateTime()
+		require.NoError(t, err)
+		assert.False(t, received.Before(after))
+	}
+}
+
+func TestSandboxGmailClientHistory(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	profile, err := sandboxClient("demo@sandbox.example", now).GetProfile(ctx)
+	require.NoError(t, err)
+
+	// A week later the emails received since are in the history
+	later := now.AddDate(0, 0, 7)
+	client := sandboxClient("demo@sandbox.example", later)
+	history, latest, err := client.ListAllHistory(ctx, profile.HistoryID, ListHistoryOptions{})
+	require.NoError(t, err)
+	require.NotEmpty(t, history)
+	for _, record := range history {
+		require.Len(t, record.MessagesAdded, 1)
+		message, err := client.GetMessageMetadata(ctx, record.MessagesAdded[0].Message.ID, nil)
+		require.NoError(t, err)
+		received, err := message.InternalDateTime()
+		require.NoError(t, err)
+		assert.True(t, received.After(now))
+	}
+	updated, err := client.GetProfile(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, updated.HistoryID, latest)
+
+	// History from before the mailbox's window has expired
+	_, _, err = client.ListAllHistory(ctx, "1", ListHistoryOptions{})
+	assert.ErrorIs(t, err, ErrInvalidHistoryID)
+}
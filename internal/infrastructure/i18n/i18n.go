@@ -0,0 +1,292 @@
+// Package i18n localizes text generated for users, such as anomaly
+// descriptions and budget recommendations. Messages live in a catalog of
+// embedded message files, one per language. Each message is a text/template
+// and may have CLDR plural forms.
+//
+// Services take the Localizer to use from the request context; the Localize
+// middleware picks it from the user's preferred locale or Accept-Language.
+// Text is generated in English when no locale was selected.
+package i18n
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+	"sync"
+	"text/template"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// DefaultLanguage is the language text is generated in when no locale was
+// selected or a message has no translation
+var DefaultLanguage = language.English
+
+//go:embed locales/*.json
+var localeFiles embed.FS
+
+// pluralForms maps the keys of a plural message to CLDR plural forms
+var pluralForms = map[string]plural.Form{
+	"zero":  plural.Zero,
+	"one":   plural.One,
+	"two":   plural.Two,
+	"few":   plural.Few,
+	"many":  plural.Many,
+	"other": plural.Other,
+}
+
+// translation is a message in one language, with a template per plural
+// form. Messages without plural forms only have plural.Other.
+type translation map[plural.Form]*template.Template
+
+// Catalog holds the messages of every supported language
+type Catalog struct {
+	messages map[language.Tag]map[string]translation
+	tags     []language.Tag
+	matcher  language.Matcher
+}
+
+// NewCatalog creates a catalog from the message files in fsys, named by
+// language tag, e.g. "es.json". A file maps message IDs to a template, or
+// to an object of templates keyed by plural form ("one", "other", ...).
+// Messages missing from a language fall back to DefaultLanguage.
+func NewCatalog(fsys fs.FS) (*Catalog, error) {
+	files, err := fs.Glob(fsys, "*.json")
+	if err != nil {
+		return nil, fmt.Errorf("listing message files: %w", err)
+	}
+
+	catalog := &Catalog{messages: make(map[language.Tag]map[string]translation)}
+	for _, file := range files {
+		name := path.Base(file)
+		tag, err := language.Parse(strings.TrimSuffix(name, ".json"))
+		if err != nil {
+			return nil, fmt.Errorf("message file %s is not named by language: %w", name, err)
+		}
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", name, err)
+		}
+		messages, err := parseMessages(data)
+		if err != nil {
+			return nil, fmt.Errorf("loading %s: %w", name, err)
+		}
+		catalog.messages[tag] = messages
+	}
+	if _, ok := catalog.messages[DefaultLanguage]; !ok {
+		return nil, fmt.Errorf("no messages for default language %s", DefaultLanguage)
+	}
+
+	// The default language goes first, which makes it the matcher's
+	// fallback
+	catalog.tags = []language.Tag{DefaultLanguage}
+	for tag := range catalog.messages {
+		if tag != DefaultLanguage {
+			catalog.tags = append(catalog.tags, tag)
+		}
+	}
+	catalog.matcher = language.NewMatcher(catalog.tags)
+	return catalog, nil
+}
+
+// parseMessages parses the messages of a message file
+func parseMessages(data []byte) (map[string]translation, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	messages := make(map[string]translation, len(raw))
+	for id, value := range raw {
+		texts := map[string]string{}
+		var text string
+		if err := json.Unmarshal(value, &text); err == nil {
+			texts["other"] = text
+		} else if err := json.Unmarshal(value, &texts); err != nil {
+			return nil, fmt.Errorf("message %s must be a string or an object of plural forms", id)
+		}
+		if _, ok := texts["other"]; !ok {
+			return nil, fmt.Errorf("message %s has no \"other\" form", id)
+		}
+
+		forms := make(translation, len(texts))
+		for key, text := range texts {
+			form, ok := pluralForms[key]
+			if !ok {
+				return nil, fmt.Errorf("message %s has unknown plural form %q", id, key)
+			}
+			tmpl, err := template.New(id).Option("missingkey=zero").Parse(text)
+			if err != nil {
+				return nil, fmt.Errorf("message %s: %w", id, err)
+			}
+			forms[form] = tmpl
+		}
+		messages[id] = forms
+	}
+	return messages, nil
+}
+
+// defaultCatalog holds the embedded messages
+var defaultCatalog = sync.OnceValue(func() *Catalog {
+	locales, err := fs.Sub(localeFiles, "locales")
+	if err != nil {
+		panic(fmt.Sprintf("i18n: %v", err))
+	}
+	catalog, err := NewCatalog(locales)
+	if err != nil {
+		panic(fmt.Sprintf("i18n: %v", err))
+	}
+	return catalog
+})
+
+// Default returns the catalog of the application's embedded messages
+func Default() *Catalog {
+	return defaultCatalog()
+}
+
+// Languages returns the languages the catalog has messages for
+func (c *Catalog) Languages() []language.Tag {
+	return c.tags
+}
+
+// Localizer returns a localizer for the best supported match among the
+// given locales, in order of preference. Each may be a language tag such as
+// "es-MX" or an Accept-Language header value; invalid ones are ignored. The
+// default language is used if none are supported.
+func (c *Catalog) Localizer(locales ...string) *Localizer {
+	var preferred []language.Tag
+	for _, locale := range locales {
+		tags, _, err := language.ParseAcceptLanguage(locale)
+		if err != nil {
+			continue
+		}
+		preferred = append(preferred, tags...)
+	}
+
+	tag := c.tags[0]
+	if len(preferred) > 0 {
+		if _, index, confidence := c.matcher.Match(preferred...); confidence != language.No {
+			tag = c.tags[index]
+		}
+	}
+
+	return &Localizer{
+		catalog: c,
+		printer: message.NewPrinter(tag),
+		tag:     tag,
+	}
+}
+
+// Localizer renders messages in one language. A nil Localizer renders them
+// in the default language.
+type Localizer struct {
+	catalog *Catalog
+	printer *message.Printer
+	tag     language.Tag
+}
+
+// orDefault returns l, or a default language localizer if l is nil
+func (l *Localizer) orDefault() *Localizer {
+	if l == nil {
+		return Default().Localizer()
+	}
+	return l
+}
+
+// Language returns the language messages are rendered in
+func (l *Localizer) Language() language.Tag {
+	return l.orDefault().tag
+}
+
+// Message renders the message with the given ID, filling its template from
+// data. The ID is returned if the catalog has no such message.
+func (l *Localizer) Message(id string, data map[string]any) string {
+	return l.orDefault().render(id, plural.Other, data)
+}
+
+// Plural renders the form of the message with the given ID that suits
+// count, which is also available to the template as .Count
+func (l *Localizer) Plural(id string, count int, data map[string]any) string {
+	l = l.orDefault()
+	templateData := map[string]any{"Count": count}
+	for key, value := range data {
+		templateData[key] = value
+	}
+	form := plural.Cardinal.MatchPlural(l.tag, count, 0, 0, 0, 0)
+	return l.render(id, form, templateData)
+}
+
+// render renders a form of a message, falling back to the default
+// language if the localizer's has no such message, to plural.Other if the
+// message has no such form, and to the ID if the template fails
+func (l *Localizer) render(id string, form plural.Form, data map[string]any) string {
+	forms, ok := l.catalog.messages[l.tag][id]
+	if !ok {
+		if forms, ok = l.catalog.messages[DefaultLanguage][id]; !ok {
+			return id
+		}
+	}
+	tmpl, ok := forms[form]
+	if !ok {
+		tmpl = forms[plural.Other]
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return id
+	}
+	return buf.String()
+}
+
+// Category renders the name of a spending, budget or cash flow category,
+// falling back to the name itself with underscores as spaces
+func (l *Localizer) Category(name string) string {
+	id := "category." + name
+	if text := l.Message(id, nil); text != id {
+		return text
+	}
+	return strings.ReplaceAll(name, "_", " ")
+}
+
+// Percent formats a percentage with one decimal place, using the
+// language's separators
+func (l *Localizer) Percent(p float64) string {
+	return l.orDefault().printer.Sprintf("%.1f%%", p)
+}
+
+// Decimal formats a number with one decimal place, using the language's
+// separators
+func (l *Localizer) Decimal(v float64) string {
+	return l.orDefault().printer.Sprintf("%.1f", v)
+}
+
+// Amount formats a money amount with two decimal places, using the
+// language's separators. Messages place the currency symbol.
+func (l *Localizer) Amount(v float64) string {
+	return l.orDefault().printer.Sprintf("%.2f", v)
+}
+
+// localizerContextKey is the context key for the request's localizer
+type localizerContextKey struct{}
+
+// WithLocalizer returns a copy of ctx carrying the localizer to generate
+// text with
+func WithLocalizer(ctx context.Context, l *Localizer) context.Context {
+	return context.WithValue(ctx, localizerContextKey{}, l)
+}
+
+// FromContext returns the localizer carried by ctx, or a default language
+// localizer if there is none
+func FromContext(ctx context.Context) *Localizer {
+	if l, ok := ctx.Value(localizerContextKey{}).(*Localizer); ok && l != nil {
+		return l
+	}
+	return Default().Localizer()
+}
@@ -0,0 +1,116 @@
+// Package logging configures the structured logger shared by the API server
+// and the worker, and carries request IDs through contexts so every log
+// line written while serving a request can be correlated.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Output formats
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Config holds configuration for the logger
+type Config struct {
+	// Format is FormatText for humans or FormatJSON for log aggregation
+	Format string
+	// Level is the minimum level that is written
+	Level slog.Level
+	// Service is added to every record so aggregated logs can be split by
+	// process
+	Service string
+}
+
+// DefaultConfig returns sensible default configuration
+func DefaultConfig() Config {
+	return Config{
+		Format: FormatText,
+		Level:  slog.LevelInfo,
+	}
+}
+
+// New creates a logger that writes to w. Records logged with a context
+// carrying a request ID include it as the request_id attribute, and records
+// logged inside a span include its trace_id and span_id.
+func New(w io.Writer, config Config) (*slog.Logger, error) {
+	opts := &slog.HandlerOptions{Level: config.Level}
+
+	var handler slog.Handler
+	switch strings.ToLower(config.Format) {
+	case FormatText, "":
+		handler = slog.NewTextHandler(w, opts)
+	case FormatJSON:
+		handler = slog.NewJSONHandler(w, opts)
+	default:
+		return nil, fmt.Errorf("unknown log format %q", config.Format)
+	}
+
+	logger := slog.New(contextHandler{Handler: handler})
+	if config.Service != "" {
+		logger = logger.With("service", config.Service)
+	}
+	return logger, nil
+}
+
+// ParseLevel parses a level name such as "debug", "info", "warn" or "error"
+func ParseLevel(name string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(name)); err != nil {
+		return 0, fmt.Errorf("unknown log level %q", name)
+	}
+	return level, nil
+}
+
+// requestIDKey is the context key for the request ID
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying the request ID
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID carried by ctx, if any
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDKey{}).(string)
+	return requestID, ok && requestID != ""
+}
+
+// contextHandler adds the request ID and trace context from the record's
+// context
+type contextHandler struct {
+	slog.Handler
+}
+
+// Handle adds the request ID and trace context, if any, before passing the
+// record on
+func (h contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if requestID, ok := RequestIDFromContext(ctx); ok {
+		record.AddAttrs(slog.String("request_id", requestID))
+	}
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		record.AddAttrs(
+			slog.String("trace_id", spanContext.TraceID().String()),
+			slog.String("span_id", spanContext.SpanID().String()),
+		)
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+// WithAttrs keeps request ID injection on derived loggers
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup keeps request ID injection on derived loggers
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,74 @@
+// Package mail sends email through a configured provider: an SMTP server
+// or the SendGrid API. Senders share one interface, so the services that
+// email users don't depend on which provider is configured.
+package mail
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+)
+
+// ErrInvalidMessage is returned for a message without a recipient, subject
+// or body
+var ErrInvalidMessage = errors.New("invalid mail message")
+
+// Message is an email to one recipient. HTML is optional; Text is sent to
+// clients that don't render it.
+type Message struct {
+	To          string
+	Subject     string
+	Text        string
+	HTML        string
+	Attachments []Attachment
+}
+
+// Attachment is a file attached to a message
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// Validate checks the message can be sent
+func (m Message) Validate() error {
+	if m.To == "" || m.Subject == "" || (m.Text == "" && m.HTML == "") {
+		return ErrInvalidMessage
+	}
+	for _, attachment := range m.Attachments {
+		if attachment.Filename == "" || attachment.ContentType == "" {
+			return ErrInvalidMessage
+		}
+	}
+	return nil
+}
+
+// Sender sends email
+type Sender interface {
+	Send(ctx context.Context, message Message) error
+}
+
+// LogSender logs messages instead of sending them. It stands in when no
+// provider is configured, as the worker logs its other notifications.
+type LogSender struct {
+	Logger *slog.Logger
+}
+
+// Send logs the message
+func (s LogSender) Send(ctx context.Context, message Message) error {
+	if err := message.Validate(); err != nil {
+		return err
+	}
+	logger := s.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	logger.InfoContext(ctx, "email sent",
+		"notification", true,
+		"channel", "email",
+		"recipient", message.To,
+		"subject", message.Subject,
+		"attachments", len(message.Attachments),
+	)
+	return nil
+}
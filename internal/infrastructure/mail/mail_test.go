@@ -0,0 +1,117 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageValidate(t *testing.T) {
+	assert.NoError(t, Message{To: "a@example.com", Subject: "Hi", Text: "Body"}.Validate())
+	assert.NoError(t, Message{To: "a@example.com", Subject: "Hi", HTML: "<p>Body</p>"}.Validate())
+	assert.ErrorIs(t, Message{Subject: "Hi", Text: "Body"}.Validate(), ErrInvalidMessage)
+	assert.ErrorIs(t, Message{To: "a@example.com", Text: "Body"}.Validate(), ErrInvalidMessage)
+	assert.ErrorIs(t, Message{To: "a@example.com", Subject: "Hi"}.Validate(), ErrInvalidMessage)
+	assert.ErrorIs(t, Message{
+		To: "a@example.com", Subject: "Hi", Text: "Body",
+		Attachments: []Attachment{{Filename: "report.pdf"}},
+	}.Validate(), ErrInvalidMessage)
+}
+
+func TestBuildMessage(t *testing.T) {
+	t.Run("plain text", func(t *testing.T) {
+		body, err := buildMessage("ClockZen <no-reply@example.com>", Message{
+			To: "user@example.com", Subject: "Sync failed", Text: "Reconnect your account",
+		})
+		require.NoError(t, err)
+
+		s := string(body)
+		assert.Contains(t, s, "From: ClockZen <no-reply@example.com>\r\n")
+		assert.Contains(t, s, "To: user@example.com\r\n")
+		assert.Contains(t, s, "Subject: Sync failed\r\n")
+		assert.Contains(t, s, "Content-Type: text/plain; charset=utf-8\r\n")
+		assert.True(t, strings.HasSuffix(s, "Reconnect your account"))
+	})
+
+	t.Run("html alternative", func(t *testing.T) {
+		body, err := buildMessage("no-reply@example.com", Message{
+			To: "user@example.com", Subject: "Résumé", Text: "text", HTML: "<p>html</p>",
+		})
+		require.NoError(t, err)
+
+		s := string(body)
+		assert.Contains(t, s, "Subject: =?utf-8?q?R=C3=A9sum=C3=A9?=\r\n")
+		assert.Contains(t, s, "multipart/alternative")
+		assert.Less(t, strings.Index(s, "text/plain"), strings.Index(s, "text/html"))
+	})
+
+	t.Run("attachments", func(t *testing.T) {
+		body, err := buildMessage("no-reply@example.com", Message{
+			To: "user@example.com", Subject: "Report", Text: "Attached",
+			Attachments: []Attachment{{Filename: "report.pdf", ContentType: "application/pdf", Data: []byte("%PDF-1.4")}},
+		})
+		require.NoError(t, err)
+
+		s := string(body)
+		assert.Contains(t, s, "multipart/mixed")
+		assert.Contains(t, s, "Content-Disposition: attachment; filename=\"report.pdf\"\r\n")
+		assert.Contains(t, s, "JVBERi0xLjQ=")
+	})
+}
+
+func TestParseAddress(t *testing.T) {
+	addr, err := parseAddress("ClockZen <no-reply@example.com>")
+	require.NoError(t, err)
+	assert.Equal(t, "no-reply@example.com", addr)
+
+	_, err = parseAddress("not an address")
+	assert.Error(t, err)
+}
+
+func TestSendGridSender(t *testing.T) {
+	t.Run("posts the message", func(t *testing.T) {
+		var got map[string]any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+			body, _ := io.ReadAll(r.Body)
+			require.NoError(t, json.Unmarshal(body, &got))
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		sender := NewSendGridSender(SendGridConfig{
+			APIKey: "key", From: "ClockZen <no-reply@example.com>", URL: server.URL,
+		}, server.Client())
+		err := sender.Send(context.Background(), Message{
+			To: "user@example.com", Subject: "Hi", Text: "text", HTML: "<p>html</p>",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "Hi", got["subject"])
+		assert.Equal(t, map[string]any{"email": "no-reply@example.com", "name": "ClockZen"}, got["from"])
+		content := got["content"].([]any)
+		require.Len(t, content, 2)
+		assert.Equal(t, "text/plain", content[0].(map[string]any)["type"])
+	})
+
+	t.Run("fails on error status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "bad key", http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		sender := NewSendGridSender(SendGridConfig{
+			APIKey: "key", From: "no-reply@example.com", URL: server.URL,
+		}, server.Client())
+		err := sender.Send(context.Background(), Message{To: "user@example.com", Subject: "Hi", Text: "text"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "bad key")
+	})
+}
@@ -0,0 +1,139 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	netmail "net/mail"
+	"time"
+)
+
+// DefaultSendGridURL is the SendGrid v3 mail send endpoint
+const DefaultSendGridURL = "https://api.sendgrid.com/v3/mail/send"
+
+// SendGridConfig holds configuration for sending through SendGrid
+type SendGridConfig struct {
+	APIKey string
+	// From is the sender address, e.g. "ClockZen <no-reply@example.com>";
+	// it must be a verified sender
+	From string
+	// URL defaults to DefaultSendGridURL
+	URL string
+}
+
+// SendGridSender sends email through the SendGrid API
+type SendGridSender struct {
+	config SendGridConfig
+	client *http.Client
+}
+
+// NewSendGridSender creates a sender that posts with client, or with a
+// client that times out after 30 seconds if it is nil
+func NewSendGridSender(config SendGridConfig, client *http.Client) *SendGridSender {
+	if config.URL == "" {
+		config.URL = DefaultSendGridURL
+	}
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &SendGridSender{config: config, client: client}
+}
+
+// sendGridAddress is an address in a SendGrid request
+type sendGridAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+// sendGridRequest is the body of a SendGrid mail send request
+type sendGridRequest struct {
+	Personalizations []struct {
+		To []sendGridAddress `json:"to"`
+	} `json:"personalizations"`
+	From    sendGridAddress `json:"from"`
+	Subject string          `json:"subject"`
+	Content []struct {
+		Type  string `json:"type"`
+		Value string `json:"value"`
+	} `json:"content"`
+	Attachments []sendGridAttachment `json:"attachments,omitempty"`
+}
+
+// sendGridAttachment is an attachment in a SendGrid request
+type sendGridAttachment struct {
+	// Content is base64 encoded
+	Content     string `json:"content"`
+	Type        string `json:"type"`
+	Filename    string `json:"filename"`
+	Disposition string `json:"disposition"`
+}
+
+// Send sends the message; any status but 2xx fails
+func (s *SendGridSender) Send(ctx context.Context, message Message) error {
+	if err := message.Validate(); err != nil {
+		return err
+	}
+	from, err := netmail.ParseAddress(s.config.From)
+	if err != nil {
+		return fmt.Errorf("parsing from address: %w", err)
+	}
+	to, err := netmail.ParseAddress(message.To)
+	if err != nil {
+		return fmt.Errorf("parsing recipient address: %w", err)
+	}
+
+	var req sendGridRequest
+	req.Personalizations = make([]struct {
+		To []sendGridAddress `json:"to"`
+	}, 1)
+	req.Personalizations[0].To = []sendGridAddress{{Email: to.Address, Name: to.Name}}
+	req.From = sendGridAddress{Email: from.Address, Name: from.Name}
+	req.Subject = message.Subject
+	// SendGrid requires text/plain to come before text/html
+	for _, part := range []struct{ contentType, value string }{
+		{"text/plain", message.Text},
+		{"text/html", message.HTML},
+	} {
+		if part.value != "" {
+			req.Content = append(req.Content, struct {
+				Type  string `json:"type"`
+				Value string `json:"value"`
+			}{part.contentType, part.value})
+		}
+	}
+
+	for _, attachment := range message.Attachments {
+		req.Attachments = append(req.Attachments, sendGridAttachment{
+			Content:     base64.StdEncoding.EncodeToString(attachment.Data),
+			Type:        attachment.ContentType,
+			Filename:    attachment.Filename,
+			Disposition: "attachment",
+		})
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("encoding request: %w", err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+s.config.APIKey)
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("posting to SendGrid: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("SendGrid responded %s: %s", resp.Status, bytes.TrimSpace(detail))
+	}
+	return nil
+}
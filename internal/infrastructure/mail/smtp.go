@@ -0,0 +1,228 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	netmail "net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+// SMTPConfig holds configuration for sending through an SMTP server
+type SMTPConfig struct {
+	Host string
+	// Port defaults to 587, the submission port
+	Port     int
+	Username string
+	Password string
+	// From is the sender address, e.g. "ClockZen <no-reply@example.com>"
+	From string
+	// Timeout limits connecting and sending; defaults to 30 seconds
+	Timeout time.Duration
+}
+
+// SMTPSender sends email through an SMTP server, upgrading the connection
+// with STARTTLS when the server offers it. Credentials are only sent over
+// TLS.
+type SMTPSender struct {
+	config SMTPConfig
+}
+
+// NewSMTPSender creates a sender for the server in config
+func NewSMTPSender(config SMTPConfig) *SMTPSender {
+	if config.Port == 0 {
+		config.Port = 587
+	}
+	if config.Timeout == 0 {
+		config.Timeout = 30 * time.Second
+	}
+	return &SMTPSender{config: config}
+}
+
+// Send sends the message
+func (s *SMTPSender) Send(ctx context.Context, message Message) error {
+	if err := message.Validate(); err != nil {
+		return err
+	}
+	from, err := parseAddress(s.config.From)
+	if err != nil {
+		return fmt.Errorf("parsing from address: %w", err)
+	}
+	to, err := parseAddress(message.To)
+	if err != nil {
+		return fmt.Errorf("parsing recipient address: %w", err)
+	}
+	body, err := buildMessage(s.config.From, message)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+
+	addr := net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port))
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("connecting to %s: %w", addr, err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, s.config.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("starting SMTP session: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.config.Host}); err != nil {
+			return fmt.Errorf("starting TLS: %w", err)
+		}
+	}
+	if s.config.Username != "" {
+		// PlainAuth refuses to send credentials over an unencrypted
+		// connection, except to localhost
+		auth := smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("authenticating: %w", err)
+		}
+	}
+
+	if err := client.Mail(from); err != nil {
+		return fmt.Errorf("setting sender: %w", err)
+	}
+	if err := client.Rcpt(to); err != nil {
+		return fmt.Errorf("setting recipient: %w", err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("starting message: %w", err)
+	}
+	if _, err := w.Write(body); err != nil {
+		return fmt.Errorf("writing message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("sending message: %w", err)
+	}
+	return client.Quit()
+}
+
+// buildMessage formats a message as MIME: plain text, or multipart with an
+// HTML alternative if it has one, and mixed with any attachments
+func buildMessage(from string, message Message) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", message.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+
+	if len(message.Attachments) == 0 {
+		if err := writeBody(&buf, message); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	boundary, err := newBoundary()
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\n\r\n", boundary)
+	fmt.Fprintf(&buf, "--%s\r\n", boundary)
+	if err := writeBody(&buf, message); err != nil {
+		return nil, err
+	}
+	for _, attachment := range message.Attachments {
+		fmt.Fprintf(&buf, "\r\n--%s\r\n", boundary)
+		writeAttachment(&buf, attachment)
+	}
+	fmt.Fprintf(&buf, "\r\n--%s--\r\n", boundary)
+	return buf.Bytes(), nil
+}
+
+// writeBody writes the message's text, with its HTML as an alternative if
+// it has any
+func writeBody(buf *bytes.Buffer, message Message) error {
+	if message.HTML == "" {
+		return writePart(buf, "text/plain", message.Text)
+	}
+
+	boundary, err := newBoundary()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(buf, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", boundary)
+	for _, part := range []struct{ contentType, content string }{
+		{"text/plain", message.Text},
+		{"text/html", message.HTML},
+	} {
+		if part.content == "" {
+			continue
+		}
+		fmt.Fprintf(buf, "--%s\r\n", boundary)
+		if err := writePart(buf, part.contentType, part.content); err != nil {
+			return err
+		}
+		buf.WriteString("\r\n")
+	}
+	fmt.Fprintf(buf, "--%s--\r\n", boundary)
+	return nil
+}
+
+// writeAttachment writes an attachment base64 encoded, in lines of 76
+// characters
+func writeAttachment(buf *bytes.Buffer, attachment Attachment) {
+	fmt.Fprintf(buf, "Content-Type: %s\r\n", attachment.ContentType)
+	buf.WriteString("Content-Transfer-Encoding: base64\r\n")
+	fmt.Fprintf(buf, "Content-Disposition: attachment; filename=%q\r\n\r\n", attachment.Filename)
+	encoded := base64.StdEncoding.EncodeToString(attachment.Data)
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76])
+		buf.WriteString("\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded)
+}
+
+// writePart writes a quoted-printable body with its headers
+func writePart(buf *bytes.Buffer, contentType, content string) error {
+	fmt.Fprintf(buf, "Content-Type: %s; charset=utf-8\r\n", contentType)
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+	w := quotedprintable.NewWriter(buf)
+	if _, err := w.Write([]byte(content)); err != nil {
+		return fmt.Errorf("encoding message: %w", err)
+	}
+	return w.Close()
+}
+
+// parseAddress returns the bare address in an RFC 5322 address such as
+// "Name <user@example.com>"
+func parseAddress(address string) (string, error) {
+	parsed, err := netmail.ParseAddress(address)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Address, nil
+}
+
+// newBoundary returns a random MIME boundary
+func newBoundary() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating boundary: %w", err)
+	}
+	return "clockzen-" + hex.EncodeToString(b), nil
+}
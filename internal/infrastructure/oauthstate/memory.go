@@ -0,0 +1,62 @@
+package oauthstate
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps states in process memory. It only suits a single API
+// process, such as in development and tests: states are lost on restart
+// and other replicas can't see them.
+type MemoryStore struct {
+	mu     sync.Mutex
+	states map[string]memoryState
+	now    func() time.Time
+}
+
+// memoryState is a state's data and when it expires
+type memoryState struct {
+	data      []byte
+	expiresAt time.Time
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		states: make(map[string]memoryState),
+		now:    time.Now,
+	}
+}
+
+// Save stores data under state until ttl has passed. Expired states are
+// removed as new ones are saved.
+func (s *MemoryStore) Save(ctx context.Context, state string, data []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for key, stored := range s.states {
+		if !now.Before(stored.expiresAt) {
+			delete(s.states, key)
+		}
+	}
+	s.states[state] = memoryState{data: data, expiresAt: now.Add(ttl)}
+	return nil
+}
+
+// Take returns the data stored under state and deletes it
+func (s *MemoryStore) Take(ctx context.Context, state string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.states[state]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	delete(s.states, state)
+	if !s.now().Before(stored.expiresAt) {
+		return nil, ErrNotFound
+	}
+	return stored.data, nil
+}
@@ -0,0 +1,70 @@
+package oauthstate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"clockzen-next/internal/ent"
+	entstate "clockzen-next/internal/ent/oauthstate"
+)
+
+// PostgresStore keeps states in the oauth_states table of the application
+// database, for deployments without Redis
+type PostgresStore struct {
+	entClient *ent.Client
+	now       func() time.Time
+}
+
+// NewPostgresStore creates a store keeping states in the database
+func NewPostgresStore(entClient *ent.Client) *PostgresStore {
+	return &PostgresStore{entClient: entClient, now: time.Now}
+}
+
+// Save stores data under state until ttl has passed. Expired states are
+// deleted as new ones are saved.
+func (s *PostgresStore) Save(ctx context.Context, state string, data []byte, ttl time.Duration) error {
+	now := s.now()
+	if _, err := s.entClient.OAuthState.Delete().
+		Where(entstate.ExpiresAtLTE(now)).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("deleting expired oauth states: %w", err)
+	}
+
+	err := s.entClient.OAuthState.Create().
+		SetID(state).
+		SetData(data).
+		SetExpiresAt(now.Add(ttl)).
+		SetCreatedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("saving oauth state: %w", err)
+	}
+	return nil
+}
+
+// Take returns the data stored under state and deletes it. Of concurrent
+// calls only the one whose delete removes the row returns the data.
+func (s *PostgresStore) Take(ctx context.Context, state string) ([]byte, error) {
+	now := s.now()
+	stored, err := s.entClient.OAuthState.Query().
+		Where(entstate.ID(state), entstate.ExpiresAtGT(now)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting oauth state: %w", err)
+	}
+
+	deleted, err := s.entClient.OAuthState.Delete().
+		Where(entstate.ID(state)).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("deleting oauth state: %w", err)
+	}
+	if deleted == 0 {
+		return nil, ErrNotFound
+	}
+	return stored.Data, nil
+}
@@ -0,0 +1,52 @@
+package oauthstate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// DefaultRedisKeyPrefix is the prefix of the keys RedisStore sets
+const DefaultRedisKeyPrefix = "clockzen:oauth_state:"
+
+// RedisStore keeps states in Redis, which expires them itself. Taking a
+// state uses GETDEL, so it needs Redis 6.2 or later.
+type RedisStore struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+// NewRedisStore creates a store keeping states in Redis under keys with
+// the prefix
+func NewRedisStore(client redis.UniversalClient, prefix string) *RedisStore {
+	return &RedisStore{client: client, prefix: prefix}
+}
+
+// NewDefaultRedisStore creates a store keeping states under
+// DefaultRedisKeyPrefix
+func NewDefaultRedisStore(client redis.UniversalClient) *RedisStore {
+	return NewRedisStore(client, DefaultRedisKeyPrefix)
+}
+
+// Save stores data under state until ttl has passed
+func (s *RedisStore) Save(ctx context.Context, state string, data []byte, ttl time.Duration) error {
+	if err := s.client.Set(ctx, s.prefix+state, data, ttl).Err(); err != nil {
+		return fmt.Errorf("saving oauth state: %w", err)
+	}
+	return nil
+}
+
+// Take returns the data stored under state and deletes it
+func (s *RedisStore) Take(ctx context.Context, state string) ([]byte, error) {
+	data, err := s.client.GetDel(ctx, s.prefix+state).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("taking oauth state: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,52 @@
+// Package oauthstate keeps the CSRF states of OAuth flows from the request
+// that starts a flow until its callback. With a store shared by every API
+// replica, such as Redis or the application database, a callback can land
+// on another replica than the one that started the flow, and flows in
+// progress survive restarts.
+//
+// Each state can be taken once: the callback that takes it deletes it, so
+// a replayed callback is rejected. States expire after the TTL they were
+// saved with.
+package oauthstate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrNotFound is returned for states that were never saved, were already
+// taken or have expired
+var ErrNotFound = errors.New("oauth state not found")
+
+// Store keeps OAuth states until they are taken or expire
+type Store interface {
+	// Save stores data under state until ttl has passed
+	Save(ctx context.Context, state string, data []byte, ttl time.Duration) error
+	// Take returns the data stored under state and deletes it, or
+	// ErrNotFound. Of concurrent calls for a state, only one gets its data.
+	Take(ctx context.Context, state string) ([]byte, error)
+}
+
+// SaveJSON stores value as JSON under state until ttl has passed
+func SaveJSON(ctx context.Context, store Store, state string, value any, ttl time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("encoding oauth state: %w", err)
+	}
+	return store.Save(ctx, state, data, ttl)
+}
+
+// TakeJSON takes the JSON stored under state and decodes it into value
+func TakeJSON(ctx context.Context, store Store, state string, value any) error {
+	data, err := store.Take(ctx, state)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return fmt.Errorf("decoding oauth state: %w", err)
+	}
+	return nil
+}
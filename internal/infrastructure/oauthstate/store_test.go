@@ -0,0 +1,118 @@
+package oauthstate
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	require.NoError(t, store.Save(ctx, "state-1", []byte("data"), time.Minute))
+
+	data, err := store.Take(ctx, "state-1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+
+	_, err = store.Take(ctx, "state-1")
+	assert.ErrorIs(t, err, ErrNotFound, "a state can only be taken once")
+
+	_, err = store.Take(ctx, "unknown")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestMemoryStoreExpiry(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+
+	require.NoError(t, store.Save(ctx, "expiring", []byte("data"), time.Minute))
+	require.NoError(t, store.Save(ctx, "swept", []byte("data"), time.Minute))
+
+	now = now.Add(time.Minute)
+	_, err := store.Take(ctx, "expiring")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, store.Save(ctx, "fresh", []byte("data"), time.Minute))
+	assert.Len(t, store.states, 1, "expired states are removed on save")
+}
+
+func TestRedisStore(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+	store := NewDefaultRedisStore(client)
+
+	require.NoError(t, store.Save(ctx, "state-1", []byte("data"), time.Minute))
+	assert.True(t, server.Exists(DefaultRedisKeyPrefix+"state-1"))
+	assert.Equal(t, time.Minute, server.TTL(DefaultRedisKeyPrefix+"state-1"))
+
+	data, err := store.Take(ctx, "state-1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+
+	_, err = store.Take(ctx, "state-1")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, store.Save(ctx, "expiring", []byte("data"), time.Minute))
+	server.FastForward(time.Minute)
+	_, err = store.Take(ctx, "expiring")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestRedisStoreConcurrentTake(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+	store := NewDefaultRedisStore(client)
+
+	require.NoError(t, store.Save(ctx, "state-1", []byte("data"), time.Minute))
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		taken int
+	)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := store.Take(ctx, "state-1"); err == nil {
+				mu.Lock()
+				taken++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, taken)
+}
+
+func TestJSONHelpers(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	type flow struct {
+		UserID string   `json:"user_id"`
+		Scopes []string `json:"scopes"`
+	}
+	saved := flow{UserID: "user-1", Scopes: []string{"drive.readonly"}}
+	require.NoError(t, SaveJSON(ctx, store, "state-1", saved, time.Minute))
+
+	var taken flow
+	require.NoError(t, TakeJSON(ctx, store, "state-1", &taken))
+	assert.Equal(t, saved, taken)
+
+	assert.ErrorIs(t, TakeJSON(ctx, store, "state-1", &taken), ErrNotFound)
+}
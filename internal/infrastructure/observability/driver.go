@@ -0,0 +1,192 @@
+package observability
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"clockzen-next/internal/infrastructure/logging"
+)
+
+// SlowQueryConfig holds configuration for slow query logging
+type SlowQueryConfig struct {
+	// Threshold is the duration above which a query is logged
+	Threshold time.Duration
+	// Log receives slow queries; nil only writes them to the process log
+	Log *SlowQueryLog
+}
+
+// DefaultSlowQueryConfig returns sensible default configuration
+func DefaultSlowQueryConfig() SlowQueryConfig {
+	return SlowQueryConfig{
+		Threshold: 200 * time.Millisecond,
+	}
+}
+
+// SlowQueryDriver wraps an ent driver and logs queries slower than the
+// configured threshold. Argument values are redacted before logging.
+type SlowQueryDriver struct {
+	dialect.Driver
+	config SlowQueryConfig
+}
+
+// NewSlowQueryDriver wraps drv with slow query logging
+func NewSlowQueryDriver(drv dialect.Driver, config SlowQueryConfig) *SlowQueryDriver {
+	return &SlowQueryDriver{
+		Driver: drv,
+		config: config,
+	}
+}
+
+// DB returns the connection pool of the wrapped driver, or nil if it isn't
+// backed by database/sql
+func (d *SlowQueryDriver) DB() *sql.DB {
+	if drv, ok := d.Driver.(*entsql.Driver); ok {
+		return drv.DB()
+	}
+	return nil
+}
+
+// OpenDriver opens a database connection pool limited by pool and wraps it
+// with slow query logging. Pass the result to ent.NewClient with ent.Driver.
+func OpenDriver(driverName, dataSourceName string, pool PoolConfig, config SlowQueryConfig) (*SlowQueryDriver, error) {
+	dataSourceName, err := WithStatementTimeout(dataSourceName, pool.StatementTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid connection string: %w", err)
+	}
+	drv, err := entsql.Open(driverName, dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+	pool.Apply(drv.DB())
+	return NewSlowQueryDriver(drv, config), nil
+}
+
+// Exec times the underlying driver Exec method
+func (d *SlowQueryDriver) Exec(ctx context.Context, query string, args, v any) error {
+	start := time.Now()
+	err := d.Driver.Exec(ctx, query, args, v)
+	d.observe(ctx, "exec", query, args, false, time.Since(start), err)
+	return err
+}
+
+// Query times the underlying driver Query method
+func (d *SlowQueryDriver) Query(ctx context.Context, query string, args, v any) error {
+	start := time.Now()
+	err := d.Driver.Query(ctx, query, args, v)
+	d.observe(ctx, "query", query, args, false, time.Since(start), err)
+	return err
+}
+
+// ExecContext times the underlying driver ExecContext method if it is
+// supported
+func (d *SlowQueryDriver) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	drv, ok := d.Driver.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	start := time.Now()
+	result, err := drv.ExecContext(ctx, query, args...)
+	d.observe(ctx, "exec", query, args, false, time.Since(start), err)
+	return result, err
+}
+
+// QueryContext times the underlying driver QueryContext method if it is
+// supported
+func (d *SlowQueryDriver) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	drv, ok := d.Driver.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	start := time.Now()
+	rows, err := drv.QueryContext(ctx, query, args...)
+	d.observe(ctx, "query", query, args, false, time.Since(start), err)
+	return rows, err
+}
+
+// Tx starts a transaction whose queries are also timed
+func (d *SlowQueryDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &slowQueryTx{Tx: tx, driver: d}, nil
+}
+
+// BeginTx starts a transaction with options if the underlying driver
+// supports it
+func (d *SlowQueryDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &slowQueryTx{Tx: tx, driver: d}, nil
+}
+
+// observe logs and records the query if it exceeded the threshold
+func (d *SlowQueryDriver) observe(ctx context.Context, operation, query string, args any, inTx bool, duration time.Duration, err error) {
+	if duration < d.config.Threshold {
+		return
+	}
+
+	slow := SlowQuery{
+		Operation:  operation,
+		Query:      query,
+		Args:       RedactArgs(args),
+		Duration:   duration,
+		InTx:       inTx,
+		RecordedAt: time.Now(),
+	}
+	if err != nil {
+		slow.Error = err.Error()
+	}
+	slow.RequestID, _ = logging.RequestIDFromContext(ctx)
+
+	slog.WarnContext(ctx, "slow query",
+		"operation", operation,
+		"duration", duration,
+		"query", query,
+		"args", slow.Args,
+		"in_tx", inTx,
+	)
+	if d.config.Log != nil {
+		d.config.Log.Record(slow)
+	}
+}
+
+// slowQueryTx times queries run inside a transaction
+type slowQueryTx struct {
+	dialect.Tx
+	driver *SlowQueryDriver
+}
+
+// Exec times the underlying transaction Exec method
+func (t *slowQueryTx) Exec(ctx context.Context, query string, args, v any) error {
+	start := time.Now()
+	err := t.Tx.Exec(ctx, query, args, v)
+	t.driver.observe(ctx, "exec", query, args, true, time.Since(start), err)
+	return err
+}
+
+// Query times the underlying transaction Query method
+func (t *slowQueryTx) Query(ctx context.Context, query string, args, v any) error {
+	start := time.Now()
+	err := t.Tx.Query(ctx, query, args, v)
+	t.driver.observe(ctx, "query", query, args, true, time.Since(start), err)
+	return err
+}
@@ -0,0 +1,81 @@
+package observability
+
+import (
+	"database/sql"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PoolConfig holds configuration for the database connection pool
+type PoolConfig struct {
+	// MaxOpenConns caps the connections open at once, so a burst of slow
+	// syncs or analyses queues for a connection rather than overloading the
+	// database. Zero means no limit.
+	MaxOpenConns int
+	// MaxIdleConns is how many idle connections are kept for reuse
+	MaxIdleConns int
+	// ConnMaxLifetime closes connections older than this, so they are
+	// spread over database replicas and failovers. Zero keeps them.
+	ConnMaxLifetime time.Duration
+	// ConnMaxIdleTime closes connections idle for longer than this. Zero
+	// keeps them.
+	ConnMaxIdleTime time.Duration
+	// StatementTimeout makes Postgres cancel statements that run longer
+	// than this. Zero means no timeout.
+	StatementTimeout time.Duration
+}
+
+// DefaultPoolConfig returns sensible default configuration
+func DefaultPoolConfig() PoolConfig {
+	return PoolConfig{
+		MaxOpenConns:     25,
+		MaxIdleConns:     10,
+		ConnMaxLifetime:  30 * time.Minute,
+		ConnMaxIdleTime:  5 * time.Minute,
+		StatementTimeout: 30 * time.Second,
+	}
+}
+
+// Apply sets the pool limits on db
+func (c PoolConfig) Apply(db *sql.DB) {
+	db.SetMaxOpenConns(c.MaxOpenConns)
+	db.SetMaxIdleConns(c.MaxIdleConns)
+	db.SetConnMaxLifetime(c.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(c.ConnMaxIdleTime)
+}
+
+// statementTimeoutParam is the Postgres setting limiting statement run time
+const statementTimeoutParam = "statement_timeout"
+
+// WithStatementTimeout adds a Postgres statement_timeout to a connection
+// string, in URL or key=value form, so every connection of the pool is
+// opened with it. A timeout already in the connection string is kept.
+func WithStatementTimeout(dataSourceName string, timeout time.Duration) (string, error) {
+	if timeout <= 0 {
+		return dataSourceName, nil
+	}
+	millis := strconv.FormatInt(timeout.Milliseconds(), 10)
+
+	if strings.HasPrefix(dataSourceName, "postgres://") || strings.HasPrefix(dataSourceName, "postgresql://") {
+		u, err := url.Parse(dataSourceName)
+		if err != nil {
+			return "", err
+		}
+		query := u.Query()
+		if query.Has(statementTimeoutParam) {
+			return dataSourceName, nil
+		}
+		query.Set(statementTimeoutParam, millis)
+		u.RawQuery = query.Encode()
+		return u.String(), nil
+	}
+
+	for _, field := range strings.Fields(dataSourceName) {
+		if key, _, _ := strings.Cut(field, "="); key == statementTimeoutParam {
+			return dataSourceName, nil
+		}
+	}
+	return strings.TrimSpace(dataSourceName + " " + statementTimeoutParam + "=" + millis), nil
+}
@@ -0,0 +1,249 @@
+// Package observability tracks request latency against service level
+// objectives and records slow database queries for debugging.
+package observability
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultLatencyBuckets are the upper bounds of the latency histogram
+var DefaultLatencyBuckets = []time.Duration{
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// SLOConfig holds configuration for latency objectives
+type SLOConfig struct {
+	// Buckets are the histogram upper bounds, in ascending order
+	Buckets []time.Duration
+	// LatencyTarget is the default latency a request must beat to count
+	// as good
+	LatencyTarget time.Duration
+	// Objective is the target fraction of good requests, e.g. 0.99
+	Objective float64
+	// RouteTargets overrides LatencyTarget for specific routes, keyed by
+	// "METHOD /normalized/path"
+	RouteTargets map[string]time.Duration
+	// MaxRoutes caps the number of tracked routes so unmatched paths can't
+	// grow the table without bound; later routes are tracked as OverflowRoute
+	MaxRoutes int
+}
+
+// OverflowRoute collects requests once MaxRoutes routes are tracked
+const OverflowRoute = "OTHER"
+
+// DefaultSLOConfig returns sensible default configuration
+func DefaultSLOConfig() SLOConfig {
+	return SLOConfig{
+		Buckets:       DefaultLatencyBuckets,
+		LatencyTarget: 500 * time.Millisecond,
+		Objective:     0.99,
+		RouteTargets:  make(map[string]time.Duration),
+		MaxRoutes:     500,
+	}
+}
+
+// LatencyBucket is one histogram bucket. Count is cumulative: it includes
+// every request at or below UpperBound.
+type LatencyBucket struct {
+	UpperBound time.Duration
+	Count      int64
+}
+
+// RouteSLO is a snapshot of a route's latency and objective compliance
+type RouteSLO struct {
+	Route          string
+	Requests       int64
+	Errors         int64
+	GoodRequests   int64
+	LatencyTarget  time.Duration
+	Objective      float64
+	Compliance     float64 // fraction of good requests
+	ErrorBudget    float64 // fraction of the allowed bad requests remaining, never below 0
+	Breaching      bool
+	Buckets        []LatencyBucket
+	MaxLatency     time.Duration
+	AverageLatency time.Duration
+}
+
+// routeStats accumulates observations for one route
+type routeStats struct {
+	counts   []int64 // per bucket, non-cumulative; last entry is overflow
+	requests int64
+	errors   int64
+	good     int64
+	total    time.Duration
+	max      time.Duration
+}
+
+// SLOTracker records request latencies per route
+type SLOTracker struct {
+	config SLOConfig
+
+	mu     sync.RWMutex
+	routes map[string]*routeStats
+	since  time.Time
+}
+
+// NewSLOTracker creates a new SLO tracker
+func NewSLOTracker(config SLOConfig) *SLOTracker {
+	if len(config.Buckets) == 0 {
+		config.Buckets = DefaultLatencyBuckets
+	}
+	if config.RouteTargets == nil {
+		config.RouteTargets = make(map[string]time.Duration)
+	}
+	return &SLOTracker{
+		config: config,
+		routes: make(map[string]*routeStats),
+		since:  time.Now(),
+	}
+}
+
+// NewSLOTrackerWithDefaults creates a tracker with default configuration
+func NewSLOTrackerWithDefaults() *SLOTracker {
+	return NewSLOTracker(DefaultSLOConfig())
+}
+
+// Observe records a request. Server errors count against the objective
+// regardless of latency.
+func (t *SLOTracker) Observe(route string, status int, latency time.Duration) {
+	bucket := sort.Search(len(t.config.Buckets), func(i int) bool {
+		return latency <= t.config.Buckets[i]
+	})
+	good := status < 500 && latency <= t.targetFor(route)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	stats, ok := t.routes[route]
+	if !ok && t.config.MaxRoutes > 0 && len(t.routes) >= t.config.MaxRoutes {
+		route = OverflowRoute
+		stats, ok = t.routes[route]
+	}
+	if !ok {
+		stats = &routeStats{counts: make([]int64, len(t.config.Buckets)+1)}
+		t.routes[route] = stats
+	}
+
+	stats.counts[bucket]++
+	stats.requests++
+	stats.total += latency
+	if latency > stats.max {
+		stats.max = latency
+	}
+	if status >= 500 {
+		stats.errors++
+	}
+	if good {
+		stats.good++
+	}
+}
+
+// Snapshot returns the current state of every route, worst compliance first
+func (t *SLOTracker) Snapshot() []RouteSLO {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	snapshot := make([]RouteSLO, 0, len(t.routes))
+	for route, stats := range t.routes {
+		snapshot = append(snapshot, t.routeSLOLocked(route, stats))
+	}
+
+	sort.Slice(snapshot, func(i, j int) bool {
+		if snapshot[i].Compliance != snapshot[j].Compliance {
+			return snapshot[i].Compliance < snapshot[j].Compliance
+		}
+		return snapshot[i].Route < snapshot[j].Route
+	})
+	return snapshot
+}
+
+// Since returns when the tracker started collecting
+func (t *SLOTracker) Since() time.Time {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.since
+}
+
+// Reset clears all recorded observations
+func (t *SLOTracker) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.routes = make(map[string]*routeStats)
+	t.since = time.Now()
+}
+
+// targetFor returns the latency target for a route
+func (t *SLOTracker) targetFor(route string) time.Duration {
+	if target, ok := t.config.RouteTargets[route]; ok {
+		return target
+	}
+	return t.config.LatencyTarget
+}
+
+// routeSLOLocked builds a snapshot for one route. The caller must hold t.mu.
+func (t *SLOTracker) routeSLOLocked(route string, stats *routeStats) RouteSLO {
+	slo := RouteSLO{
+		Route:         route,
+		Requests:      stats.requests,
+		Errors:        stats.errors,
+		GoodRequests:  stats.good,
+		LatencyTarget: t.targetFor(route),
+		Objective:     t.config.Objective,
+		Compliance:    1,
+		ErrorBudget:   1,
+		Buckets:       make([]LatencyBucket, len(t.config.Buckets)),
+		MaxLatency:    stats.max,
+	}
+
+	var cumulative int64
+	for i, upper := range t.config.Buckets {
+		cumulative += stats.counts[i]
+		slo.Buckets[i] = LatencyBucket{UpperBound: upper, Count: cumulative}
+	}
+
+	if stats.requests > 0 {
+		slo.AverageLatency = stats.total / time.Duration(stats.requests)
+		slo.Compliance = float64(stats.good) / float64(stats.requests)
+
+		allowedBad := (1 - t.config.Objective) * float64(stats.requests)
+		bad := float64(stats.requests - stats.good)
+		if allowedBad > 0 {
+			slo.ErrorBudget = max(1-bad/allowedBad, 0)
+		} else if bad > 0 {
+			slo.ErrorBudget = 0
+		}
+		slo.Breaching = slo.Compliance < t.config.Objective
+	}
+
+	return slo
+}
+
+// idSegment matches path segments that identify a resource rather than name
+// an endpoint: UUIDs, numbers and long hex or base64-like tokens
+var idSegment = regexp.MustCompile(`^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9]+|[0-9a-fA-F]{16,}|[A-Za-z0-9_-]{24,})$`)
+
+// RouteKey returns the key a request is tracked under: the method and the
+// path with resource IDs replaced by {id}, so every user's requests to the
+// same endpoint share one route
+func RouteKey(method, path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if idSegment.MatchString(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	return method + " " + strings.Join(segments, "/")
+}
@@ -0,0 +1,165 @@
+package observability
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// redactedValue replaces sensitive query arguments
+const redactedValue = "[REDACTED]"
+
+// entPackage is the import path of the generated ent client, whose
+// subpackages hold the schema's enum types
+const entPackage = "clockzen-next/internal/ent"
+
+// SlowQuery is a database query that exceeded the slow query threshold
+type SlowQuery struct {
+	Operation  string // exec or query
+	Query      string
+	Args       []string // redacted
+	Duration   time.Duration
+	InTx       bool
+	Error      string
+	RequestID  string // request that ran the query, if known
+	RecordedAt time.Time
+}
+
+// SlowQueryLog keeps the most recent slow queries in a fixed-size ring
+// buffer
+type SlowQueryLog struct {
+	mu      sync.RWMutex
+	entries []SlowQuery
+	next    int
+	full    bool
+	total   int64
+}
+
+// NewSlowQueryLog creates a log holding up to capacity queries
+func NewSlowQueryLog(capacity int) *SlowQueryLog {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &SlowQueryLog{
+		entries: make([]SlowQuery, capacity),
+	}
+}
+
+// Record adds a query, overwriting the oldest once the buffer is full
+func (l *SlowQueryLog) Record(query SlowQuery) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries[l.next] = query
+	l.next = (l.next + 1) % len(l.entries)
+	if l.next == 0 {
+		l.full = true
+	}
+	l.total++
+}
+
+// Recent returns up to limit queries, newest first. A limit of zero or less
+// returns every buffered query.
+func (l *SlowQueryLog) Recent(limit int) []SlowQuery {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	size := l.next
+	if l.full {
+		size = len(l.entries)
+	}
+	if limit <= 0 || limit > size {
+		limit = size
+	}
+
+	queries := make([]SlowQuery, limit)
+	for i := 0; i < limit; i++ {
+		idx := (l.next - 1 - i + len(l.entries)) % len(l.entries)
+		queries[i] = l.entries[idx]
+	}
+	return queries
+}
+
+// Total returns how many slow queries have been recorded, including those
+// no longer buffered
+func (l *SlowQueryLog) Total() int64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.total
+}
+
+// Capacity returns the buffer size
+func (l *SlowQueryLog) Capacity() int {
+	return len(l.entries)
+}
+
+// Clear removes all buffered queries
+func (l *SlowQueryLog) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries = make([]SlowQuery, len(l.entries))
+	l.next = 0
+	l.full = false
+}
+
+// RedactArgs describes query arguments without exposing their values.
+// Strings and byte slices may hold tokens, emails or message content, so
+// only their length is kept. Floats are amounts and balances, so they are
+// redacted too. Integers, booleans, times, UUIDs and ent enum values are
+// shown as is; any other type is redacted.
+func RedactArgs(args any) []string {
+	list, ok := args.([]any)
+	if !ok {
+		if args == nil {
+			return nil
+		}
+		list = []any{args}
+	}
+
+	redacted := make([]string, len(list))
+	for i, arg := range list {
+		redacted[i] = redactArg(arg)
+	}
+	return redacted
+}
+
+// redactArg describes a single query argument
+func redactArg(arg any) string {
+	switch v := arg.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return fmt.Sprintf("%s(len=%d)", redactedValue, len(v))
+	case []byte:
+		return fmt.Sprintf("%s(len=%d)", redactedValue, len(v))
+	case *string:
+		if v == nil {
+			return "NULL"
+		}
+		return fmt.Sprintf("%s(len=%d)", redactedValue, len(*v))
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprint(v)
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case uuid.UUID:
+		return v.String()
+	default:
+		if isEnum(arg) {
+			return fmt.Sprint(arg)
+		}
+		return redactedValue
+	}
+}
+
+// isEnum reports whether arg is a value of one of the enum types generated
+// for the ent schema. Those are identifiers, not secrets, unlike other named
+// string types which may wrap anything.
+func isEnum(arg any) bool {
+	t := reflect.TypeOf(arg)
+	return t.Kind() == reflect.String && strings.HasPrefix(t.PkgPath(), entPackage+"/")
+}
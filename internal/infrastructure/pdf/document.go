@@ -0,0 +1,225 @@
+// Package pdf writes simple PDF documents: pages of text in the standard
+// Helvetica fonts, lines and filled rectangles. It covers what generated
+// reports need without a dependency on a PDF library; it doesn't embed
+// fonts or images, so text is limited to the Latin-1 characters the
+// standard fonts' WinAnsi encoding covers.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Page sizes in points, 72 to the inch
+const (
+	LetterWidth  = 612.0
+	LetterHeight = 792.0
+)
+
+// Font is one of the standard fonts every PDF reader has
+type Font string
+
+const (
+	Helvetica     Font = "F1"
+	HelveticaBold Font = "F2"
+)
+
+// baseFonts are the names of the standard fonts by resource name
+var baseFonts = map[Font]string{
+	Helvetica:     "Helvetica",
+	HelveticaBold: "Helvetica-Bold",
+}
+
+// Color is an RGB color, each component from 0 to 1
+type Color struct {
+	R, G, B float64
+}
+
+// Colors used by generated documents
+var (
+	Black     = Color{0, 0, 0}
+	Gray      = Color{0.45, 0.45, 0.45}
+	LightGray = Color{0.9, 0.9, 0.9}
+)
+
+// Document is a PDF document being written. Coordinates are in points from
+// the top left corner of the page, unlike PDF's own from the bottom left.
+type Document struct {
+	width, height float64
+	pages         []*bytes.Buffer
+}
+
+// NewDocument creates an empty document with pages of the size given
+func NewDocument(width, height float64) *Document {
+	return &Document{width: width, height: height}
+}
+
+// Width returns the page width
+func (d *Document) Width() float64 {
+	return d.width
+}
+
+// Height returns the page height
+func (d *Document) Height() float64 {
+	return d.height
+}
+
+// PageCount returns how many pages have been added
+func (d *Document) PageCount() int {
+	return len(d.pages)
+}
+
+// AddPage starts a new page; what is drawn next goes on it
+func (d *Document) AddPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+}
+
+// page returns the current page, starting one if there is none
+func (d *Document) page() *bytes.Buffer {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+	return d.pages[len(d.pages)-1]
+}
+
+// Text draws text with its baseline at y
+func (d *Document) Text(x, y float64, font Font, size float64, color Color, text string) {
+	fmt.Fprintf(d.page(), "BT %s %s %s rg /%s %s Tf %s %s Td (%s) Tj ET\n",
+		num(color.R), num(color.G), num(color.B),
+		font, num(size), num(x), num(d.height-y), escape(text))
+}
+
+// TextRight draws text ending at x, with its baseline at y
+func (d *Document) TextRight(x, y float64, font Font, size float64, color Color, text string) {
+	d.Text(x-TextWidth(font, size, text), y, font, size, color, text)
+}
+
+// Line draws a line from (x1, y1) to (x2, y2)
+func (d *Document) Line(x1, y1, x2, y2, width float64, color Color) {
+	fmt.Fprintf(d.page(), "%s %s %s RG %s w %s %s m %s %s l S\n",
+		num(color.R), num(color.G), num(color.B), num(width),
+		num(x1), num(d.height-y1), num(x2), num(d.height-y2))
+}
+
+// Rect fills a rectangle whose top left corner is at (x, y)
+func (d *Document) Rect(x, y, width, height float64, color Color) {
+	fmt.Fprintf(d.page(), "%s %s %s rg %s %s %s %s re f\n",
+		num(color.R), num(color.G), num(color.B),
+		num(x), num(d.height-y-height), num(width), num(height))
+}
+
+// WriteTo writes the document as a PDF file
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+
+	// Objects are numbered from 1: the catalog, the page tree, the fonts,
+	// then each page followed by its content stream
+	fonts := []Font{Helvetica, HelveticaBold}
+	firstPage := 3 + len(fonts)
+	var objects []string
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPage+2*i)
+	}
+	objects = append(objects,
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)),
+	)
+	fontResources := make([]string, len(fonts))
+	for i, font := range fonts {
+		objects = append(objects, fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", baseFonts[font]))
+		fontResources[i] = fmt.Sprintf("/%s %d 0 R", font, 3+i)
+	}
+	for i, content := range d.pages {
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << %s >> >> /Contents %d 0 R >>",
+				num(d.width), num(d.height), strings.Join(fontResources, " "), firstPage+2*i+1),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()),
+		)
+	}
+
+	var buf bytes.Buffer
+	// The comment's high bytes mark the file as binary to transfer tools
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	n, err := w.Write(buf.Bytes())
+	return int64(n), err
+}
+
+// Bytes returns the document as a PDF file
+func (d *Document) Bytes() []byte {
+	var buf bytes.Buffer
+	d.WriteTo(&buf)
+	return buf.Bytes()
+}
+
+// num formats a number for a content stream
+func num(f float64) string {
+	s := fmt.Sprintf("%.2f", f)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	if s == "" || s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+// escape encodes text as a PDF string in WinAnsi, replacing characters it
+// has no code for with "?"
+func escape(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		c, ok := winAnsi(r)
+		if !ok {
+			c = '?'
+		}
+		switch c {
+		case '\\', '(', ')':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		default:
+			if c < 0x20 || c > 0x7e {
+				fmt.Fprintf(&b, "\\%03o", c)
+			} else {
+				b.WriteByte(c)
+			}
+		}
+	}
+	return b.String()
+}
+
+// winAnsiSpecials are the WinAnsi codes for characters outside Latin-1
+var winAnsiSpecials = map[rune]byte{
+	'€': 0x80, '‚': 0x82, 'ƒ': 0x83, '„': 0x84, '…': 0x85, '†': 0x86, '‡': 0x87,
+	'ˆ': 0x88, '‰': 0x89, 'Š': 0x8a, '‹': 0x8b, 'Œ': 0x8c, 'Ž': 0x8e,
+	'‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97,
+	'˜': 0x98, '™': 0x99, 'š': 0x9a, '›': 0x9b, 'œ': 0x9c, 'ž': 0x9e, 'Ÿ': 0x9f,
+}
+
+// winAnsi returns the WinAnsi code for a character
+func winAnsi(r rune) (byte, bool) {
+	if c, ok := winAnsiSpecials[r]; ok {
+		return c, true
+	}
+	if r == '\t' || r == '\n' {
+		return ' ', true
+	}
+	if (r >= 0x20 && r <= 0x7e) || (r >= 0xa0 && r <= 0xff) {
+		return byte(r), true
+	}
+	return 0, false
+}
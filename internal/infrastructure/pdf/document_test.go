@@ -0,0 +1,59 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocumentWriteTo(t *testing.T) {
+	doc := NewDocument(LetterWidth, LetterHeight)
+	doc.AddPage()
+	doc.Text(72, 72, HelveticaBold, 18, Black, "Monthly report (September)")
+	doc.Rect(72, 100, 200, 12, LightGray)
+	doc.AddPage()
+	doc.Line(72, 72, 540, 72, 0.5, Gray)
+
+	out := doc.Bytes()
+	require.True(t, bytes.HasPrefix(out, []byte("%PDF-1.4\n")))
+	assert.True(t, bytes.HasSuffix(out, []byte("%%EOF\n")))
+	assert.Contains(t, string(out), "/Count 2")
+	assert.Contains(t, string(out), `(Monthly report \(September\)) Tj`)
+	// Coordinates are flipped from the top left
+	assert.Contains(t, string(out), "72 720 Td")
+
+	// Every object in the cross-reference table is where it says
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	require.NotNil(t, startxref)
+	xref, err := strconv.Atoi(string(startxref[1]))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(out[xref:], []byte("xref\n")))
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(out[xref:], -1)
+	require.Len(t, entries, 8)
+	for i, entry := range entries {
+		offset, err := strconv.Atoi(string(entry[1]))
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(out[offset:], []byte(fmt.Sprintf("%d 0 obj\n", i+1))), "object %d", i+1)
+	}
+}
+
+func TestEscape(t *testing.T) {
+	assert.Equal(t, `a\\b \(c\)`, escape(`a\b (c)`))
+	assert.Equal(t, `Caf\351 \200`, escape("Café €"))
+	assert.Equal(t, "?", escape("日"))
+}
+
+func TestTextWidth(t *testing.T) {
+	assert.InDelta(t, 5.56, TextWidth(Helvetica, 10, "0"), 0.001)
+	assert.Greater(t, TextWidth(HelveticaBold, 10, "abc"), TextWidth(Helvetica, 10, "abc"))
+
+	truncated := Truncate(Helvetica, 10, 40, "A merchant with a long name")
+	assert.LessOrEqual(t, TextWidth(Helvetica, 10, truncated), 40.0)
+	assert.Contains(t, truncated, "...")
+	assert.Equal(t, "Short", Truncate(Helvetica, 10, 40, "Short"))
+}
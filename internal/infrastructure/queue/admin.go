@@ -0,0 +1,351 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/jobqueue"
+	"clockzen-next/internal/ent/queuedjob"
+)
+
+// =============================================================================
+// Queue Management
+// =============================================================================
+
+// QueueInfo describes a queue with the number of jobs in each status
+type QueueInfo struct {
+	Name      string
+	Paused    bool
+	Counts    map[queuedjob.Status]int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Stats summarizes the jobs of every queue
+type Stats struct {
+	Queues          int
+	Jobs            int
+	Counts          map[queuedjob.Status]int
+	JobsByQueue     map[string]int
+	AvgProcessingMs float64
+}
+
+// ListJobsOptions filters the jobs returned by ListJobs
+type ListJobsOptions struct {
+	// Status limits the jobs to one status if set
+	Status queuedjob.Status
+	// Limit caps the number of jobs returned. Zero returns all.
+	Limit int
+}
+
+// statusCounts returns the number of jobs in each status, by queue
+func (q *Queue) statusCounts(ctx context.Context, names ...string) (map[string]map[queuedjob.Status]int, error) {
+	var rows []struct {
+		Queue  string           `json:"queue"`
+		Status queuedjob.Status `json:"status"`
+		Count  int              `json:"count"`
+	}
+	query := q.entClient.QueuedJob.Query()
+	if len(names) > 0 {
+		query = query.Where(queuedjob.QueueIn(names...))
+	}
+	err := query.
+		GroupBy(queuedjob.FieldQueue, queuedjob.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("counting jobs: %w", err)
+	}
+
+	counts := make(map[string]map[queuedjob.Status]int)
+	for _, row := range rows {
+		if counts[row.Queue] == nil {
+			counts[row.Queue] = make(map[queuedjob.Status]int)
+		}
+		counts[row.Queue][row.Status] = row.Count
+	}
+	return counts, nil
+}
+
+// queueInfo combines a queue record with its job counts
+func queueInfo(record *ent.JobQueue, counts map[queuedjob.Status]int) *QueueInfo {
+	if counts == nil {
+		counts = make(map[queuedjob.Status]int)
+	}
+	return &QueueInfo{
+		Name:      record.ID,
+		Paused:    record.Paused,
+		Counts:    counts,
+		CreatedAt: record.CreatedAt,
+		UpdatedAt: record.UpdatedAt,
+	}
+}
+
+// Queues returns every queue, ordered by name
+func (q *Queue) Queues(ctx context.Context) ([]*QueueInfo, error) {
+	records, err := q.entClient.JobQueue.Query().
+		Order(ent.Asc(jobqueue.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying queues: %w", err)
+	}
+	counts, err := q.statusCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queues := make([]*QueueInfo, len(records))
+	for i, record := range records {
+		queues[i] = queueInfo(record, counts[record.ID])
+	}
+	return queues, nil
+}
+
+// GetQueue returns the named queue
+func (q *Queue) GetQueue(ctx context.Context, name string) (*QueueInfo, error) {
+	record, err := q.entClient.JobQueue.Get(ctx, name)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrQueueNotFound
+		}
+		return nil, fmt.Errorf("getting queue: %w", err)
+	}
+	counts, err := q.statusCounts(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return queueInfo(record, counts[name]), nil
+}
+
+// Stats returns job counts across all queues and the average time taken
+// by completed jobs
+func (q *Queue) Stats(ctx context.Context) (*Stats, error) {
+	queues, err := q.entClient.JobQueue.Query().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("counting queues: %w", err)
+	}
+	counts, err := q.statusCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &Stats{
+		Queues:      queues,
+		Counts:      make(map[queuedjob.Status]int),
+		JobsByQueue: make(map[string]int),
+	}
+	for name, byStatus := range counts {
+		for status, count := range byStatus {
+			stats.Jobs += count
+			stats.Counts[status] += count
+			stats.JobsByQueue[name] += count
+		}
+	}
+
+	completed, err := q.entClient.QueuedJob.Query().
+		Where(
+			queuedjob.StatusEQ(queuedjob.StatusCompleted),
+			queuedjob.StartedAtNotNil(),
+			queuedjob.CompletedAtNotNil(),
+		).
+		Select(queuedjob.FieldStartedAt, queuedjob.FieldCompletedAt).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying completed jobs: %w", err)
+	}
+	if len(completed) > 0 {
+		var total time.Duration
+		for _, job := range completed {
+			total += job.CompletedAt.Sub(*job.StartedAt)
+		}
+		stats.AvgProcessingMs = float64(total.Milliseconds()) / float64(len(completed))
+	}
+	return stats, nil
+}
+
+// ListJobs returns the jobs of the named queue, newest first
+func (q *Queue) ListJobs(ctx context.Context, name string, opts ListJobsOptions) ([]*ent.QueuedJob, error) {
+	if _, err := q.GetQueue(ctx, name); err != nil {
+		return nil, err
+	}
+
+	query := q.entClient.QueuedJob.Query().
+		Where(queuedjob.Queue(name)).
+		Order(ent.Desc(queuedjob.FieldCreatedAt))
+	if opts.Status != "" {
+		query = query.Where(queuedjob.StatusEQ(opts.Status))
+	}
+	if opts.Limit > 0 {
+		query = query.Limit(opts.Limit)
+	}
+	jobs, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying jobs: %w", err)
+	}
+	return jobs, nil
+}
+
+// GetJob returns a job of the named queue
+func (q *Queue) GetJob(ctx context.Context, name, jobID string) (*ent.QueuedJob, error) {
+	job, err := q.entClient.QueuedJob.Query().
+		Where(queuedjob.ID(jobID), queuedjob.Queue(name)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrJobNotFound
+		}
+		return nil, fmt.Errorf("getting job: %w", err)
+	}
+	return job, nil
+}
+
+// RetryJob returns a failed or cancelled job to pending so it runs again
+// now. The job keeps its retry count unless resetRetryCount is set, in
+// which case it gets all its retries again.
+func (q *Queue) RetryJob(ctx context.Context, name, jobID string, resetRetryCount bool) (*ent.QueuedJob, error) {
+	job, err := q.GetJob(ctx, name, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	update := q.entClient.QueuedJob.Update().
+		Where(
+			queuedjob.ID(jobID),
+			queuedjob.StatusIn(queuedjob.StatusFailed, queuedjob.StatusCancelled),
+		).
+		SetStatus(queuedjob.StatusPending).
+		SetRunAt(time.Now()).
+		ClearError().
+		ClearStartedAt().
+		ClearCompletedAt().
+		ClearCancelledAt()
+	if resetRetryCount {
+		update.SetRetryCount(0)
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("updating job: %w", err)
+	}
+	if updated == 0 {
+		return nil, fmt.Errorf("%w: status is %s", ErrJobNotRetryable, job.Status)
+	}
+
+	q.wakeQueue(name)
+	return q.GetJob(ctx, name, jobID)
+}
+
+// CancelJob cancels a pending or processing job. A processing job is
+// stopped by its worker at the next heartbeat.
+func (q *Queue) CancelJob(ctx context.Context, name, jobID string) (*ent.QueuedJob, error) {
+	job, err := q.GetJob(ctx, name, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := q.entClient.QueuedJob.Update().
+		Where(
+			queuedjob.ID(jobID),
+			queuedjob.StatusIn(queuedjob.StatusPending, queuedjob.StatusProcessing),
+		).
+		SetStatus(queuedjob.StatusCancelled).
+		SetCancelledAt(time.Now()).
+		ClearLockedBy().
+		ClearHeartbeatAt().
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("updating job: %w", err)
+	}
+	if updated == 0 {
+		return nil, fmt.Errorf("%w: status is %s", ErrJobNotCancellable, job.Status)
+	}
+
+	// Stop the job now if this process is running it
+	q.mu.RLock()
+	if cancel, ok := q.active[jobID]; ok {
+		cancel()
+	}
+	q.mu.RUnlock()
+
+	return q.GetJob(ctx, name, jobID)
+}
+
+// DeleteJob removes a job that is not processing
+func (q *Queue) DeleteJob(ctx context.Context, name, jobID string) error {
+	if _, err := q.GetJob(ctx, name, jobID); err != nil {
+		return err
+	}
+
+	deleted, err := q.entClient.QueuedJob.Delete().
+		Where(
+			queuedjob.ID(jobID),
+			queuedjob.StatusNEQ(queuedjob.StatusProcessing),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting job: %w", err)
+	}
+	if deleted == 0 {
+		return ErrJobProcessing
+	}
+	return nil
+}
+
+// Flush deletes the jobs of the named queue with the given status, or all
+// jobs that are not processing if status is empty. It returns the number
+// of jobs deleted.
+func (q *Queue) Flush(ctx context.Context, name string, status queuedjob.Status) (int, error) {
+	if _, err := q.GetQueue(ctx, name); err != nil {
+		return 0, err
+	}
+
+	del := q.entClient.QueuedJob.Delete().Where(queuedjob.Queue(name))
+	if status != "" {
+		del = del.Where(queuedjob.StatusEQ(status))
+	} else {
+		del = del.Where(queuedjob.StatusNEQ(queuedjob.StatusProcessing))
+	}
+	flushed, err := del.Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("flushing queue: %w", err)
+	}
+	return flushed, nil
+}
+
+// PauseQueue stops workers from starting jobs of the named queue. Jobs
+// already running finish.
+func (q *Queue) PauseQueue(ctx context.Context, name string) (*QueueInfo, error) {
+	return q.setPaused(ctx, name, true)
+}
+
+// ResumeQueue lets workers start jobs of a paused queue again
+func (q *Queue) ResumeQueue(ctx context.Context, name string) (*QueueInfo, error) {
+	info, err := q.setPaused(ctx, name, false)
+	if err == nil {
+		q.wakeQueue(name)
+	}
+	return info, err
+}
+
+// setPaused pauses or resumes a queue
+func (q *Queue) setPaused(ctx context.Context, name string, paused bool) (*QueueInfo, error) {
+	if _, err := q.GetQueue(ctx, name); err != nil {
+		return nil, err
+	}
+
+	updated, err := q.entClient.JobQueue.Update().
+		Where(jobqueue.ID(name), jobqueue.Paused(!paused)).
+		SetPaused(paused).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("updating queue: %w", err)
+	}
+	if updated == 0 {
+		if paused {
+			return nil, ErrQueueAlreadyPaused
+		}
+		return nil, ErrQueueNotPaused
+	}
+	return q.GetQueue(ctx, name)
+}
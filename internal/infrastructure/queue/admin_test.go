@@ -0,0 +1,95 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"clockzen-next/internal/ent/enttest"
+	"clockzen-next/internal/ent/queuedjob"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryDeadJob(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	q := NewWithDefaults(client)
+
+	runs := 0
+	failing := true
+	require.NoError(t, q.Register(ctx, "sync", func(ctx context.Context, job *Job) (any, error) {
+		runs++
+		if failing {
+			return nil, errors.New("mailbox unavailable")
+		}
+		return "synced", nil
+	}, HandlerOptions{RetryBackoff: time.Nanosecond}))
+
+	// attempt claims and runs the queue's next due job, as the dispatcher
+	// does, reporting whether there was one
+	attempt := func() bool {
+		t.Helper()
+		time.Sleep(time.Millisecond)
+		record, err := q.claim(ctx, "sync")
+		require.NoError(t, err)
+		if record == nil {
+			return false
+		}
+		q.runJob(ctx, q.handlers["sync"], record)
+		return true
+	}
+
+	enqueued, err := q.Enqueue(ctx, "sync", "email_sync", map[string]string{"connection_id": "c1"}, EnqueueOptions{MaxRetries: 1})
+	require.NoError(t, err)
+
+	// The job fails its attempt and its one retry, and is dead-lettered
+	require.True(t, attempt())
+	require.True(t, attempt())
+	assert.False(t, attempt())
+	dead, err := q.GetJob(ctx, "sync", enqueued.ID)
+	require.NoError(t, err)
+	assert.Equal(t, queuedjob.StatusFailed, dead.Status)
+	assert.Equal(t, 1, dead.RetryCount)
+	assert.Equal(t, "mailbox unavailable", *dead.Error)
+	assert.NotNil(t, dead.CompletedAt)
+	assert.Equal(t, 2, runs)
+
+	_, err = q.RetryJob(ctx, "sync", "missing", true)
+	assert.ErrorIs(t, err, ErrJobNotFound)
+
+	retried, err := q.RetryJob(ctx, "sync", enqueued.ID, true)
+	require.NoError(t, err)
+	assert.Equal(t, enqueued.ID, retried.ID)
+	assert.Equal(t, queuedjob.StatusPending, retried.Status)
+	assert.Zero(t, retried.RetryCount)
+	assert.Nil(t, retried.Error)
+	assert.Nil(t, retried.CompletedAt)
+	assert.False(t, retried.RunAt.After(time.Now()))
+
+	// Retrying it again doesn't enqueue it twice
+	_, err = q.RetryJob(ctx, "sync", enqueued.ID, true)
+	assert.ErrorIs(t, err, ErrJobNotRetryable)
+	pending, err := q.PendingCount(ctx, "sync")
+	require.NoError(t, err)
+	assert.Equal(t, 1, pending)
+	assert.Equal(t, 1, client.QueuedJob.Query().CountX(ctx))
+
+	// The retried job runs once more, and succeeds
+	failing = false
+	require.True(t, attempt())
+	assert.False(t, attempt())
+	assert.Equal(t, 3, runs)
+	done, err := q.GetJob(ctx, "sync", enqueued.ID)
+	require.NoError(t, err)
+	assert.Equal(t, queuedjob.StatusCompleted, done.Status)
+	assert.Zero(t, done.RetryCount)
+	assert.Nil(t, done.Error)
+
+	_, err = q.RetryJob(ctx, "sync", enqueued.ID, true)
+	assert.ErrorIs(t, err, ErrJobNotRetryable, "completed jobs aren't retried")
+}
@@ -0,0 +1,877 @@
+// Package queue is a persistent job queue backed by the application
+// database. Jobs are rows in the queued_jobs table, so queued work survives
+// worker restarts and any number of worker processes can share a queue:
+// each claims a job by moving it from pending to processing with a
+// conditional update, which only one of them can win.
+//
+// Jobs run in priority order once their run_at time has passed, which is
+// also how jobs are scheduled for later and how retries are backed off.
+// Running jobs are kept alive by a heartbeat; a job whose worker stopped
+// heartbeating, e.g. because it crashed, is returned to pending and picked
+// up again by another worker, which counts as one of its retries.
+package queue
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/jobqueue"
+	"clockzen-next/internal/ent/predicate"
+	"clockzen-next/internal/ent/queuedjob"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// drainPollInterval is how often Drain checks for running jobs
+const drainPollInterval = 100 * time.Millisecond
+
+// Queue errors
+var (
+	ErrJobNotFound        = errors.New("job not found")
+	ErrQueueNotFound      = errors.New("queue not found")
+	ErrJobNotRetryable    = errors.New("only failed or cancelled jobs can be retried")
+	ErrJobNotCancellable  = errors.New("only pending or processing jobs can be cancelled")
+	ErrJobProcessing      = errors.New("job is processing")
+	ErrQueueAlreadyPaused = errors.New("queue is already paused")
+	ErrQueueNotPaused     = errors.New("queue is not paused")
+	ErrHandlerRegistered  = errors.New("queue already has a handler")
+	ErrInvalidHandler     = errors.New("job handler is required")
+	ErrQueueNotRunning    = errors.New("job queue is not running")
+	ErrHandlerNotFound    = errors.New("queue has no handler")
+	errJobReleased        = errors.New("job is no longer held by this worker")
+	errJobAbandoned       = errors.New("job was abandoned by its worker")
+)
+
+// Config holds configuration for the job queue
+type Config struct {
+	// PollInterval is how often an idle queue checks for due jobs
+	PollInterval time.Duration
+	// RetryBackoff is the delay before the first retry of a failed job.
+	// It doubles with each further retry.
+	RetryBackoff time.Duration
+	// MaxRetryBackoff caps the delay between retries
+	MaxRetryBackoff time.Duration
+	// HeartbeatInterval is how often running jobs are reported alive
+	HeartbeatInterval time.Duration
+	// RescueAfter is how long a processing job may go without a heartbeat
+	// before it is considered abandoned and returned to pending
+	RescueAfter time.Duration
+	// Retention is how long completed jobs are kept. Zero keeps them.
+	Retention time.Duration
+}
+
+// DefaultConfig returns sensible default configuration
+func DefaultConfig() Config {
+	return Config{
+		PollInterval:      time.Second,
+		RetryBackoff:      30 * time.Second,
+		MaxRetryBackoff:   time.Hour,
+		HeartbeatInterval: 30 * time.Second,
+		RescueAfter:       5 * time.Minute,
+		Retention:         7 * 24 * time.Hour,
+	}
+}
+
+// Job is a claimed job handed to its queue's handler
+type Job struct {
+	ID         string
+	Queue      string
+	Type       string
+	Priority   int
+	RetryCount int
+	MaxRetries int
+	OwnerID    string
+	Payload    json.RawMessage
+	CreatedAt  time.Time
+}
+
+// Decode unmarshals the job's payload into v
+func (j *Job) Decode(v any) error {
+	if len(j.Payload) == 0 {
+		return nil
+	}
+	return json.Unmarshal(j.Payload, v)
+}
+
+// SetPayload replaces the job's payload with v, which is stored if the
+// job is retried. Handlers use it to pass progress on to the next attempt.
+func (j *Job) SetPayload(v any) error {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	j.Payload = payload
+	return nil
+}
+
+// DeferError is returned by a handler that can't run a job yet, e.g.
+// because a resource it needs is busy. The job goes back to pending to run
+// after Delay, without counting as a retry.
+type DeferError struct {
+	Delay time.Duration
+}
+
+// Error implements error
+func (e *DeferError) Error() string {
+	return fmt.Sprintf("job deferred for %s", e.Delay)
+}
+
+// Defer returns a DeferError that runs the job again after delay
+func Defer(delay time.Duration) error {
+	return &DeferError{Delay: delay}
+}
+
+// Handler runs a job. What it returns is stored as the job's result. It
+// should return promptly with the context's error once ctx is cancelled.
+type Handler func(ctx context.Context, job *Job) (any, error)
+
+// HandlerOptions controls how the jobs of a queue are run
+type HandlerOptions struct {
+	// Concurrency is the number of the queue's jobs run at once by this
+	// process
+	Concurrency int
+	// Timeout is the maximum time a single attempt may run. Zero means no
+	// limit.
+	Timeout time.Duration
+	// RetryBackoff overrides the queue's Config.RetryBackoff if set
+	RetryBackoff time.Duration
+}
+
+// EnqueueOptions controls when and how often a job runs
+type EnqueueOptions struct {
+	// Priority orders due jobs; higher runs first
+	Priority int
+	// RunAt schedules the job for later. The zero value runs it now.
+	RunAt time.Time
+	// MaxRetries is the number of times a failing job is retried
+	MaxRetries int
+	// OwnerID records the user the job runs for, if any
+	OwnerID string
+}
+
+// registration is a queue's handler with its concurrency slots
+type registration struct {
+	name    string
+	handler Handler
+	opts    HandlerOptions
+	slots   chan struct{}
+	wake    chan struct{}
+	// paused stops this process claiming the queue's jobs; guarded by
+	// Queue.mu
+	paused bool
+}
+
+// Queue enqueues jobs and, once started, runs the jobs of the queues it has
+// handlers for. A Queue that is never started can still enqueue and manage
+// jobs, as the API server does.
+type Queue struct {
+	config    Config
+	entClient *ent.Client
+	workerID  string
+
+	mu       sync.RWMutex
+	running  bool
+	ctx      context.Context
+	handlers map[string]*registration
+	active   map[string]context.CancelFunc
+	stopCh   chan struct{}
+	wg       sync.WaitGroup
+}
+
+// New creates a new job queue
+func New(entClient *ent.Client, config Config) *Queue {
+	return &Queue{
+		config:    config,
+		entClient: entClient,
+		workerID:  uuid.New().String(),
+		handlers:  make(map[string]*registration),
+		active:    make(map[string]context.CancelFunc),
+		stopCh:    make(chan struct{}),
+	}
+}
+
+// NewWithDefaults creates a job queue with default configuration
+func NewWithDefaults(entClient *ent.Client) *Queue {
+	return New(entClient, DefaultConfig())
+}
+
+// Register sets the handler that runs the jobs of the named queue. If the
+// queue is already running, the handler starts taking jobs right away.
+func (q *Queue) Register(ctx context.Context, name string, handler Handler, opts HandlerOptions) error {
+	if handler == nil {
+		return ErrInvalidHandler
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 1
+	}
+	if err := q.ensureQueue(ctx, name); err != nil {
+		return err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if _, exists := q.handlers[name]; exists {
+		return fmt.Errorf("%w: %s", ErrHandlerRegistered, name)
+	}
+	reg := &registration{
+		name:    name,
+		handler: handler,
+		opts:    opts,
+		slots:   make(chan struct{}, opts.Concurrency),
+		wake:    make(chan struct{}, 1),
+	}
+	q.handlers[name] = reg
+	if q.running {
+		q.wg.Add(1)
+		go q.dispatchLoop(q.ctx, reg)
+	}
+	return nil
+}
+
+// ensureQueue creates the named queue's record if it doesn't exist
+func (q *Queue) ensureQueue(ctx context.Context, name string) error {
+	err := q.entClient.JobQueue.Create().
+		SetID(name).
+		OnConflictColumns(jobqueue.FieldID).
+		DoNothing().
+		Exec(ctx)
+	// DoNothing reports no rows when the queue already exists
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("creating queue %s: %w", name, err)
+	}
+	return nil
+}
+
+// Enqueue adds a job to the named queue. The payload is stored as JSON and
+// decoded by the queue's handler.
+func (q *Queue) Enqueue(ctx context.Context, name, jobType string, payload any, opts EnqueueOptions) (*ent.QueuedJob, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("encoding job payload: %w", err)
+	}
+	if err := q.ensureQueue(ctx, name); err != nil {
+		return nil, err
+	}
+
+	runAt := opts.RunAt
+	if runAt.IsZero() {
+		runAt = time.Now()
+	}
+	create := q.entClient.QueuedJob.Create().
+		SetID(uuid.New().String()).
+		SetQueue(name).
+		SetType(jobType).
+		SetPriority(opts.Priority).
+		SetPayload(data).
+		SetMaxRetries(max(opts.MaxRetries, 0)).
+		SetRunAt(runAt)
+	if opts.OwnerID != "" {
+		create.SetOwnerID(opts.OwnerID)
+	}
+	job, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("enqueueing job: %w", err)
+	}
+
+	q.wakeQueue(name)
+	return job, nil
+}
+
+// wakeQueue prompts the named queue's dispatcher, if this process runs one,
+// to look for jobs without waiting for the next poll
+func (q *Queue) wakeQueue(name string) {
+	q.mu.RLock()
+	reg, ok := q.handlers[name]
+	q.mu.RUnlock()
+	if ok {
+		select {
+		case reg.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Start begins running jobs for the registered queues
+func (q *Queue) Start(ctx context.Context) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.running {
+		return nil
+	}
+	q.running = true
+	q.ctx = ctx
+	q.stopCh = make(chan struct{})
+
+	for _, reg := range q.handlers {
+		q.wg.Add(1)
+		go q.dispatchLoop(ctx, reg)
+	}
+	q.wg.Add(1)
+	go q.maintenanceLoop(ctx)
+
+	return nil
+}
+
+// Stop stops taking jobs, cancels running ones and waits for them to
+// return. Jobs interrupted this way go back to pending without counting as
+// a retry, so another worker picks them up.
+func (q *Queue) Stop() error {
+	q.mu.Lock()
+	if !q.running {
+		q.mu.Unlock()
+		return ErrQueueNotRunning
+	}
+	q.running = false
+	close(q.stopCh)
+	for _, cancel := range q.active {
+		cancel()
+	}
+	q.mu.Unlock()
+
+	q.wg.Wait()
+	return nil
+}
+
+// IsRunning reports whether the queue is running jobs
+func (q *Queue) IsRunning() bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.running
+}
+
+// PauseHandler stops this process taking jobs from the named queue. Unlike
+// PauseQueue, other workers sharing the queue keep running its jobs, and
+// jobs already running here are left to finish.
+func (q *Queue) PauseHandler(name string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	reg, ok := q.handlers[name]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrHandlerNotFound, name)
+	}
+	reg.paused = true
+	return nil
+}
+
+// ResumeHandler lets this process take jobs from the named queue again
+func (q *Queue) ResumeHandler(name string) error {
+	q.mu.Lock()
+	reg, ok := q.handlers[name]
+	if ok {
+		reg.paused = false
+	}
+	q.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrHandlerNotFound, name)
+	}
+
+	q.wakeQueue(name)
+	return nil
+}
+
+// HandlerPaused reports whether this process has stopped taking jobs from
+// the named queue
+func (q *Queue) HandlerPaused(name string) bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	reg, ok := q.handlers[name]
+	return ok && reg.paused
+}
+
+// handlerPaused reports whether the registration is paused
+func (q *Queue) handlerPaused(reg *registration) bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return reg.paused
+}
+
+// ActiveCount returns the number of jobs this process is running
+func (q *Queue) ActiveCount() int {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return len(q.active)
+}
+
+// Drain pauses every queue this process handles, then waits for the jobs
+// it is running to finish or for ctx to be done. Unlike Stop, running jobs
+// aren't cancelled. The queues stay paused until resumed with
+// ResumeHandler.
+func (q *Queue) Drain(ctx context.Context) error {
+	q.mu.Lock()
+	for _, reg := range q.handlers {
+		reg.paused = true
+	}
+	q.mu.Unlock()
+
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for q.ActiveCount() > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// stopping reports whether Stop has been called
+func (q *Queue) stopping() bool {
+	select {
+	case <-q.stopCh:
+		return true
+	default:
+		return false
+	}
+}
+
+// dispatchLoop claims due jobs of a queue while it has free slots
+func (q *Queue) dispatchLoop(ctx context.Context, reg *registration) {
+	defer q.wg.Done()
+
+	ticker := time.NewTicker(q.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		q.fillSlots(ctx, reg)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.stopCh:
+			return
+		case <-ticker.C:
+		case <-reg.wake:
+		}
+	}
+}
+
+// fillSlots starts due jobs until the queue's slots are full or no job is
+// due
+func (q *Queue) fillSlots(ctx context.Context, reg *registration) {
+	for !q.stopping() && !q.handlerPaused(reg) {
+		select {
+		case reg.slots <- struct{}{}:
+		default:
+			return
+		}
+
+		job, err := q.claim(ctx, reg.name)
+		if err != nil || job == nil {
+			<-reg.slots
+			if err != nil && ctx.Err() == nil {
+				slog.WarnContext(ctx, "claiming queued job", "queue", reg.name, "error", err)
+			}
+			return
+		}
+
+		q.wg.Add(1)
+		go func() {
+			defer q.wg.Done()
+			defer func() {
+				<-reg.slots
+				select {
+				case reg.wake <- struct{}{}:
+				default:
+				}
+			}()
+			q.runJob(ctx, reg, job)
+		}()
+	}
+}
+
+// claim moves the next due job of a queue to processing for this worker,
+// returning nil if the queue is paused or has no due job
+func (q *Queue) claim(ctx context.Context, name string) (*ent.QueuedJob, error) {
+	paused, err := q.entClient.JobQueue.Query().
+		Where(jobqueue.ID(name), jobqueue.Paused(true)).
+		Exist(ctx)
+	if err != nil || paused {
+		return nil, err
+	}
+
+	// Another worker may claim the candidate first, in which case the
+	// next one is tried
+	for range 3 {
+		now := time.Now()
+		candidate, err := q.entClient.QueuedJob.Query().
+			Where(
+				queuedjob.Queue(name),
+				queuedjob.StatusEQ(queuedjob.StatusPending),
+				queuedjob.RunAtLTE(now),
+			).
+			Order(ent.Desc(queuedjob.FieldPriority), ent.Asc(queuedjob.FieldRunAt), ent.Asc(queuedjob.FieldCreatedAt)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		claimed, err := q.entClient.QueuedJob.Update().
+			Where(
+				queuedjob.ID(candidate.ID),
+				queuedjob.StatusEQ(queuedjob.StatusPending),
+			).
+			SetStatus(queuedjob.StatusProcessing).
+			SetLockedBy(q.workerID).
+			SetHeartbeatAt(now).
+			SetStartedAt(now).
+			SetProgress(0).
+			ClearResult().
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if claimed == 1 {
+			return q.entClient.QueuedJob.Get(ctx, candidate.ID)
+		}
+	}
+	return nil, nil
+}
+
+// runJob runs a claimed job and records its outcome
+func (q *Queue) runJob(ctx context.Context, reg *registration, record *ent.QueuedJob) {
+	var jobCtx context.Context
+	var cancel context.CancelFunc
+	if reg.opts.Timeout > 0 {
+		jobCtx, cancel = context.WithTimeout(ctx, reg.opts.Timeout)
+	} else {
+		jobCtx, cancel = context.WithCancel(ctx)
+	}
+	q.mu.Lock()
+	q.active[record.ID] = cancel
+	q.mu.Unlock()
+
+	defer func() {
+		q.mu.Lock()
+		delete(q.active, record.ID)
+		q.mu.Unlock()
+		cancel()
+	}()
+
+	job := &Job{
+		ID:         record.ID,
+		Queue:      record.Queue,
+		Type:       record.Type,
+		Priority:   record.Priority,
+		RetryCount: record.RetryCount,
+		MaxRetries: record.MaxRetries,
+		Payload:    record.Payload,
+		CreatedAt:  record.CreatedAt,
+	}
+	if record.OwnerID != nil {
+		job.OwnerID = *record.OwnerID
+	}
+	result, err := q.safeRun(jobCtx, reg.handler, job)
+
+	// The outcome is recorded even when the job was cancelled by Stop
+	saveCtx := context.WithoutCancel(ctx)
+	if err := q.finish(saveCtx, reg, job, result, err); err != nil && !errors.Is(err, errJobReleased) {
+		slog.ErrorContext(saveCtx, "recording queued job outcome",
+			"queue", job.Queue,
+			"job_id", job.ID,
+			"error", err,
+		)
+	}
+}
+
+// safeRun invokes the handler, turning a panic into a job failure
+func (q *Queue) safeRun(ctx context.Context, handler Handler, job *Job) (result any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+	return handler(ctx, job)
+}
+
+// finish records the outcome of an attempt. The update only applies while
+// this worker still holds the job, so a job cancelled or rescued meanwhile
+// keeps the state it was given.
+func (q *Queue) finish(ctx context.Context, reg *registration, job *Job, result any, runErr error) error {
+	now := time.Now()
+	var deferErr *DeferError
+	update := q.entClient.QueuedJob.Update().
+		Where(
+			queuedjob.ID(job.ID),
+			queuedjob.StatusEQ(queuedjob.StatusProcessing),
+			queuedjob.LockedBy(q.workerID),
+		).
+		ClearLockedBy().
+		ClearHeartbeatAt()
+
+	switch {
+	case runErr == nil:
+		data, err := json.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("encoding job result: %w", err)
+		}
+		update.SetStatus(queuedjob.StatusCompleted).
+			SetResult(data).
+			SetProgress(1).
+			ClearError().
+			SetCompletedAt(now)
+	case q.stopping():
+		// Interrupted by shutdown rather than failed
+		update.SetStatus(queuedjob.StatusPending).
+			SetPayload(job.Payload).
+			SetRunAt(now)
+	case errors.As(runErr, &deferErr):
+		update.SetStatus(queuedjob.StatusPending).
+			SetPayload(job.Payload).
+			SetRunAt(now.Add(deferErr.Delay))
+	case job.RetryCount < job.MaxRetries:
+		update.SetStatus(queuedjob.StatusPending).
+			SetPayload(job.Payload).
+			SetError(runErr.Error()).
+			AddRetryCount(1).
+			SetRunAt(now.Add(q.backoff(reg, job.RetryCount)))
+	default:
+		update.SetStatus(queuedjob.StatusFailed).
+			SetPayload(job.Payload).
+			SetError(runErr.Error()).
+			SetCompletedAt(now)
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return errJobReleased
+	}
+	if runErr != nil && !q.stopping() && deferErr == nil {
+		slog.WarnContext(ctx, "queued job failed",
+			"queue", job.Queue,
+			"job_id", job.ID,
+			"type", job.Type,
+			"retry_count", job.RetryCount,
+			"max_retries", job.MaxRetries,
+			"error", runErr,
+		)
+	}
+	return nil
+}
+
+// ReportProgress records how far a running job has got, as a fraction
+// between 0 and 1. It only applies while this worker holds the job.
+func (q *Queue) ReportProgress(ctx context.Context, jobID string, progress float64) error {
+	_, err := q.entClient.QueuedJob.Update().
+		Where(
+			queuedjob.ID(jobID),
+			queuedjob.StatusEQ(queuedjob.StatusProcessing),
+			queuedjob.LockedBy(q.workerID),
+		).
+		SetProgress(min(max(progress, 0), 1)).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("recording job progress: %w", err)
+	}
+	return nil
+}
+
+// ReportPartialResult records what a running job has produced so far. It
+// is replaced by the result when the job completes, kept when it fails or
+// is cancelled, and cleared when it is retried. It only applies while this
+// worker holds the job.
+func (q *Queue) ReportPartialResult(ctx context.Context, jobID string, result any) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("encoding partial job result: %w", err)
+	}
+	_, err = q.entClient.QueuedJob.Update().
+		Where(
+			queuedjob.ID(jobID),
+			queuedjob.StatusEQ(queuedjob.StatusProcessing),
+			queuedjob.LockedBy(q.workerID),
+		).
+		SetResult(data).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("recording partial job result: %w", err)
+	}
+	return nil
+}
+
+// backoff returns the delay before retrying a job that has already been
+// retried retryCount times
+func (q *Queue) backoff(reg *registration, retryCount int) time.Duration {
+	delay := q.config.RetryBackoff
+	if reg.opts.RetryBackoff > 0 {
+		delay = reg.opts.RetryBackoff
+	}
+	for range retryCount {
+		delay *= 2
+		if delay >= q.config.MaxRetryBackoff {
+			return q.config.MaxRetryBackoff
+		}
+	}
+	return min(delay, q.config.MaxRetryBackoff)
+}
+
+// maintenanceLoop heartbeats this worker's jobs, stops those cancelled
+// through the admin API, rescues abandoned jobs and removes expired ones
+func (q *Queue) maintenanceLoop(ctx context.Context) {
+	defer q.wg.Done()
+
+	ticker := time.NewTicker(q.config.HeartbeatInterval)
+	defer ticker.Stop()
+
+	q.rescueAbandoned(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.stopCh:
+			return
+		case <-ticker.C:
+			q.heartbeat(ctx)
+			q.rescueAbandoned(ctx)
+			q.removeExpired(ctx)
+		}
+	}
+}
+
+// activeJobIDs returns the IDs of the jobs this process is running
+func (q *Queue) activeJobIDs() []string {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	ids := make([]string, 0, len(q.active))
+	for id := range q.active {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// heartbeat reports this worker's running jobs alive and cancels those
+// that were cancelled since they started
+func (q *Queue) heartbeat(ctx context.Context) {
+	ids := q.activeJobIDs()
+	if len(ids) == 0 {
+		return
+	}
+
+	_, err := q.entClient.QueuedJob.Update().
+		Where(
+			queuedjob.IDIn(ids...),
+			queuedjob.StatusEQ(queuedjob.StatusProcessing),
+			queuedjob.LockedBy(q.workerID),
+		).
+		SetHeartbeatAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "heartbeating queued jobs", "error", err)
+	}
+
+	cancelled, err := q.entClient.QueuedJob.Query().
+		Where(
+			queuedjob.IDIn(ids...),
+			queuedjob.StatusEQ(queuedjob.StatusCancelled),
+		).
+		IDs(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "checking for cancelled jobs", "error", err)
+		return
+	}
+	q.mu.RLock()
+	for _, id := range cancelled {
+		if cancel, ok := q.active[id]; ok {
+			cancel()
+		}
+	}
+	q.mu.RUnlock()
+}
+
+// rescueAbandoned returns processing jobs whose worker stopped heartbeating
+// to pending. A rescue counts as a retry, so a job that keeps taking its
+// worker down fails once its retries run out instead of being run forever.
+func (q *Queue) rescueAbandoned(ctx context.Context) {
+	now := time.Now()
+	abandoned := []predicate.QueuedJob{
+		queuedjob.StatusEQ(queuedjob.StatusProcessing),
+		queuedjob.HeartbeatAtLT(now.Add(-q.config.RescueAfter)),
+	}
+
+	failed, err := q.entClient.QueuedJob.Update().
+		Where(append(abandoned, retriesLeft(false))...).
+		SetStatus(queuedjob.StatusFailed).
+		SetError(errJobAbandoned.Error()).
+		SetCompletedAt(now).
+		ClearLockedBy().
+		ClearHeartbeatAt().
+		Save(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "failing abandoned jobs", "error", err)
+		return
+	}
+	if failed > 0 {
+		slog.WarnContext(ctx, "abandoned jobs ran out of retries", "count", failed)
+	}
+
+	rescued, err := q.entClient.QueuedJob.Update().
+		Where(append(abandoned, retriesLeft(true))...).
+		SetStatus(queuedjob.StatusPending).
+		SetError(errJobAbandoned.Error()).
+		AddRetryCount(1).
+		SetRunAt(now).
+		ClearLockedBy().
+		ClearHeartbeatAt().
+		Save(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "rescuing abandoned jobs", "error", err)
+		return
+	}
+	if rescued > 0 {
+		slog.InfoContext(ctx, "rescued abandoned jobs", "count", rescued)
+	}
+}
+
+// retriesLeft matches jobs that have, or if left is false have not, retries
+// left
+func retriesLeft(left bool) predicate.QueuedJob {
+	return predicate.QueuedJob(func(s *entsql.Selector) {
+		retries, limit := s.C(queuedjob.FieldRetryCount), s.C(queuedjob.FieldMaxRetries)
+		if left {
+			s.Where(entsql.ColumnsLT(retries, limit))
+		} else {
+			s.Where(entsql.ColumnsGTE(retries, limit))
+		}
+	})
+}
+
+// removeExpired deletes completed jobs older than the retention period
+func (q *Queue) removeExpired(ctx context.Context) {
+	if q.config.Retention <= 0 {
+		return
+	}
+	_, err := q.entClient.QueuedJob.Delete().
+		Where(
+			queuedjob.StatusEQ(queuedjob.StatusCompleted),
+			queuedjob.CompletedAtLT(time.Now().Add(-q.config.Retention)),
+		).
+		Exec(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "removing expired jobs", "error", err)
+	}
+}
+
+// PendingCount returns the number of jobs waiting in the named queue,
+// including those scheduled for later
+func (q *Queue) PendingCount(ctx context.Context, name string) (int, error) {
+	return q.entClient.QueuedJob.Query().
+		Where(
+			queuedjob.Queue(name),
+			queuedjob.StatusEQ(queuedjob.StatusPending),
+		).
+		Count(ctx)
+}
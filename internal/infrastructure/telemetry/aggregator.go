@@ -0,0 +1,207 @@
+package telemetry
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrInvalidReport is returned when a received report is malformed or
+// exceeds the aggregator's limits
+var ErrInvalidReport = errors.New("invalid telemetry report")
+
+// AggregatorConfig holds configuration for the telemetry receiver
+type AggregatorConfig struct {
+	// MaxKeysPerReport rejects reports counting more features or error
+	// categories than this
+	MaxKeysPerReport int
+	// MaxKeys caps the number of distinct features and error categories
+	// kept; later ones are totalled under OverflowKey
+	MaxKeys int
+	// MaxInstallations caps the number of installations tracked; reports
+	// from further installations are still totalled
+	MaxInstallations int
+	// MaxKeyLength rejects reports with longer feature or category names
+	MaxKeyLength int
+}
+
+// DefaultAggregatorConfig returns sensible default configuration
+func DefaultAggregatorConfig() AggregatorConfig {
+	return AggregatorConfig{
+		MaxKeysPerReport: 500,
+		MaxKeys:          2000,
+		MaxInstallations: 10000,
+		MaxKeyLength:     200,
+	}
+}
+
+// Count is a named total in a Summary
+type Count struct {
+	Name  string
+	Count int64
+}
+
+// Installation describes one installation that has sent reports
+type Installation struct {
+	ID        string
+	Version   string
+	Reports   int64
+	FirstSeen time.Time
+	LastSeen  time.Time
+}
+
+// Summary is a snapshot of the totals received
+type Summary struct {
+	Since         time.Time
+	Reports       int64
+	Installations []Installation
+	Features      []Count
+	Errors        []Count
+}
+
+// Aggregator totals the reports sent by installations, so self-hosters can
+// receive their own telemetry. Totals are kept in memory only.
+type Aggregator struct {
+	config AggregatorConfig
+
+	mu            sync.RWMutex
+	reports       int64
+	installations map[string]*Installation
+	features      map[string]int64
+	errors        map[string]int64
+	since         time.Time
+}
+
+// NewAggregator creates a new aggregator
+func NewAggregator(config AggregatorConfig) *Aggregator {
+	return &Aggregator{
+		config:        config,
+		installations: make(map[string]*Installation),
+		features:      make(map[string]int64),
+		errors:        make(map[string]int64),
+		since:         time.Now(),
+	}
+}
+
+// NewAggregatorWithDefaults creates an aggregator with default configuration
+func NewAggregatorWithDefaults() *Aggregator {
+	return NewAggregator(DefaultAggregatorConfig())
+}
+
+// Receive validates a report and adds it to the totals
+func (a *Aggregator) Receive(report Report) error {
+	if err := a.validate(report); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.reports++
+	if inst, ok := a.installations[report.InstallationID]; ok {
+		inst.Reports++
+		inst.LastSeen = now
+		if report.Version != "" {
+			inst.Version = report.Version
+		}
+	} else if a.config.MaxInstallations <= 0 || len(a.installations) < a.config.MaxInstallations {
+		a.installations[report.InstallationID] = &Installation{
+			ID:        report.InstallationID,
+			Version:   report.Version,
+			Reports:   1,
+			FirstSeen: now,
+			LastSeen:  now,
+		}
+	}
+
+	for key, n := range report.Features {
+		a.addLocked(a.features, key, n)
+	}
+	for key, n := range report.Errors {
+		a.addLocked(a.errors, key, n)
+	}
+	return nil
+}
+
+// validate checks a report against the schema and the configured limits
+func (a *Aggregator) validate(report Report) error {
+	if report.SchemaVersion != SchemaVersion {
+		return fmt.Errorf("%w: unsupported schema version %d", ErrInvalidReport, report.SchemaVersion)
+	}
+	if report.InstallationID == "" || len(report.InstallationID) > 64 {
+		return fmt.Errorf("%w: installation_id must be 1 to 64 characters", ErrInvalidReport)
+	}
+	if a.config.MaxKeysPerReport > 0 && len(report.Features)+len(report.Errors) > a.config.MaxKeysPerReport {
+		return fmt.Errorf("%w: more than %d keys", ErrInvalidReport, a.config.MaxKeysPerReport)
+	}
+	for _, counts := range []map[string]int64{report.Features, report.Errors} {
+		for key, n := range counts {
+			if key == "" || (a.config.MaxKeyLength > 0 && len(key) > a.config.MaxKeyLength) {
+				return fmt.Errorf("%w: key %.40q has an invalid length", ErrInvalidReport, key)
+			}
+			if n < 0 {
+				return fmt.Errorf("%w: negative count for %q", ErrInvalidReport, key)
+			}
+		}
+	}
+	return nil
+}
+
+// addLocked adds n to a total, folding new keys into OverflowKey once
+// MaxKeys are tracked. The caller must hold a.mu.
+func (a *Aggregator) addLocked(totals map[string]int64, key string, n int64) {
+	if _, ok := totals[key]; !ok && a.config.MaxKeys > 0 && len(totals) >= a.config.MaxKeys {
+		key = OverflowKey
+	}
+	totals[key] += n
+}
+
+// Summary returns the current totals, largest first
+func (a *Aggregator) Summary() Summary {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	summary := Summary{
+		Since:         a.since,
+		Reports:       a.reports,
+		Installations: make([]Installation, 0, len(a.installations)),
+		Features:      sortedCounts(a.features),
+		Errors:        sortedCounts(a.errors),
+	}
+	for _, inst := range a.installations {
+		summary.Installations = append(summary.Installations, *inst)
+	}
+	sort.Slice(summary.Installations, func(i, j int) bool {
+		return summary.Installations[i].LastSeen.After(summary.Installations[j].LastSeen)
+	})
+	return summary
+}
+
+// Reset clears all totals
+func (a *Aggregator) Reset() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.reports = 0
+	a.installations = make(map[string]*Installation)
+	a.features = make(map[string]int64)
+	a.errors = make(map[string]int64)
+	a.since = time.Now()
+}
+
+// sortedCounts returns totals ordered by count, then name
+func sortedCounts(totals map[string]int64) []Count {
+	counts := make([]Count, 0, len(totals))
+	for name, n := range totals {
+		counts = append(counts, Count{Name: name, Count: n})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Name < counts[j].Name
+	})
+	return counts
+}
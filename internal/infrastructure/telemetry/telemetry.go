@@ -0,0 +1,300 @@
+// Package telemetry reports anonymous usage so maintainers can see which
+// features are used and which errors are common. It is off unless enabled
+// and only ever sends counts: which API routes were called, with IDs
+// collapsed, and which categories of error were returned. Request bodies,
+// resource IDs, user details and financial data are never collected.
+//
+// Reports go to a configurable endpoint. Self-hosters who want to keep
+// telemetry internal can run the receiver built on Aggregator and point
+// their installations at it.
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SchemaVersion is the version of the Report format
+const SchemaVersion = 1
+
+// OverflowKey collects counts once MaxKeys features or error categories are
+// tracked
+const OverflowKey = "OTHER"
+
+// Config holds configuration for telemetry reporting
+type Config struct {
+	// Enabled opts in to reporting; nothing is recorded or sent otherwise
+	Enabled bool
+	// Endpoint is the URL reports are POSTed to
+	Endpoint string
+	// Token is sent as a bearer token, for receivers that require one
+	Token string
+	// InstallationID identifies this installation in reports. A random ID
+	// is used if it is empty, so reports can't be linked across restarts.
+	InstallationID string
+	// Version is the application version included in reports
+	Version string
+	// Interval is how often reports are sent
+	Interval time.Duration
+	// Timeout bounds each report request
+	Timeout time.Duration
+	// MaxKeys caps the number of features and error categories counted
+	// between reports; later ones are counted as OverflowKey
+	MaxKeys int
+}
+
+// DefaultConfig returns sensible default configuration
+func DefaultConfig() Config {
+	return Config{
+		Interval: time.Hour,
+		Timeout:  10 * time.Second,
+		MaxKeys:  200,
+	}
+}
+
+// Report is the payload sent to the telemetry endpoint
+type Report struct {
+	SchemaVersion  int              `json:"schema_version"`
+	InstallationID string           `json:"installation_id"`
+	Version        string           `json:"version,omitempty"`
+	PeriodStart    time.Time        `json:"period_start"`
+	PeriodEnd      time.Time        `json:"period_end"`
+	Features       map[string]int64 `json:"features"`
+	Errors         map[string]int64 `json:"errors"`
+}
+
+// Reporter counts feature usage and error categories and periodically sends
+// them to the configured endpoint. A nil or disabled Reporter ignores
+// everything recorded.
+type Reporter struct {
+	config Config
+	client *http.Client
+
+	mu       sync.Mutex
+	features map[string]int64
+	errors   map[string]int64
+	since    time.Time
+
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+// NewReporter creates a new reporter. Reporting stays disabled if no
+// endpoint is configured.
+func NewReporter(config Config) *Reporter {
+	if config.Endpoint == "" {
+		config.Enabled = false
+	}
+	if config.InstallationID == "" {
+		config.InstallationID = uuid.New().String()
+	}
+	if config.Interval <= 0 {
+		config.Interval = DefaultConfig().Interval
+	}
+	return &Reporter{
+		config:   config,
+		client:   &http.Client{Timeout: config.Timeout},
+		features: make(map[string]int64),
+		errors:   make(map[string]int64),
+		since:    time.Now(),
+	}
+}
+
+// Enabled reports whether usage is being recorded
+func (r *Reporter) Enabled() bool {
+	return r != nil && r.config.Enabled
+}
+
+// RecordFeature counts one use of a feature
+func (r *Reporter) RecordFeature(name string) {
+	if !r.Enabled() {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.incrementLocked(r.features, name, 1)
+}
+
+// RecordError counts one error of the given category
+func (r *Reporter) RecordError(category string) {
+	if !r.Enabled() {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.incrementLocked(r.errors, category, 1)
+}
+
+// incrementLocked adds n to a counter, folding new keys into OverflowKey
+// once MaxKeys are tracked. The caller must hold r.mu.
+func (r *Reporter) incrementLocked(counts map[string]int64, key string, n int64) {
+	if _, ok := counts[key]; !ok && r.config.MaxKeys > 0 && len(counts) >= r.config.MaxKeys {
+		key = OverflowKey
+	}
+	counts[key] += n
+}
+
+// Start sends a report every interval until Stop is called
+func (r *Reporter) Start(ctx context.Context) {
+	if !r.Enabled() || r.stopCh != nil {
+		return
+	}
+	r.stopCh = make(chan struct{})
+	r.doneCh = make(chan struct{})
+
+	go func() {
+		defer close(r.doneCh)
+		ticker := time.NewTicker(r.config.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := r.Flush(ctx); err != nil {
+					slog.WarnContext(ctx, "sending telemetry report", "error", err)
+				}
+			case <-r.stopCh:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops periodic reporting and sends what was recorded since the last
+// report
+func (r *Reporter) Stop(ctx context.Context) error {
+	if !r.Enabled() {
+		return nil
+	}
+	if r.stopCh != nil {
+		close(r.stopCh)
+		<-r.doneCh
+		r.stopCh = nil
+	}
+	return r.Flush(ctx)
+}
+
+// Flush sends a report of the counts recorded since the last report. Nothing
+// is sent if nothing was recorded. If sending fails the counts are kept for
+// the next report.
+func (r *Reporter) Flush(ctx context.Context) error {
+	if !r.Enabled() {
+		return nil
+	}
+
+	report := r.take()
+	if len(report.Features) == 0 && len(report.Errors) == 0 {
+		return nil
+	}
+
+	if err := r.send(ctx, report); err != nil {
+		r.restore(report)
+		return err
+	}
+	return nil
+}
+
+// take returns a report of the current counts and resets them
+func (r *Reporter) take() Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	report := Report{
+		SchemaVersion:  SchemaVersion,
+		InstallationID: r.config.InstallationID,
+		Version:        r.config.Version,
+		PeriodStart:    r.since,
+		PeriodEnd:      now,
+		Features:       r.features,
+		Errors:         r.errors,
+	}
+	r.features = make(map[string]int64)
+	r.errors = make(map[string]int64)
+	r.since = now
+	return report
+}
+
+// restore adds the counts of an unsent report back, so they are included in
+// the next one
+func (r *Reporter) restore(report Report) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key, n := range report.Features {
+		r.incrementLocked(r.features, key, n)
+	}
+	for key, n := range report.Errors {
+		r.incrementLocked(r.errors, key, n)
+	}
+	r.since = report.PeriodStart
+}
+
+// send POSTs a report to the endpoint
+func (r *Reporter) send(ctx context.Context, report Report) error {
+	body, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("encoding report: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.config.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if r.config.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+r.config.Token)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending report: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("telemetry endpoint returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// ErrorCategory returns the category an HTTP error status is reported as,
+// or "" if the status is not an error
+func ErrorCategory(status int) string {
+	switch {
+	case status < 400:
+		return ""
+	case status == http.StatusBadRequest, status == http.StatusUnprocessableEntity:
+		return "invalid_request"
+	case status == http.StatusUnauthorized:
+		return "unauthenticated"
+	case status == http.StatusForbidden:
+		return "forbidden"
+	case status == http.StatusNotFound:
+		return "not_found"
+	case status == http.StatusMethodNotAllowed:
+		return "method_not_allowed"
+	case status == http.StatusConflict:
+		return "conflict"
+	case status == http.StatusRequestEntityTooLarge:
+		return "too_large"
+	case status == http.StatusTooManyRequests:
+		return "rate_limited"
+	case status < 500:
+		return "client_error"
+	case status == http.StatusBadGateway, status == http.StatusServiceUnavailable, status == http.StatusGatewayTimeout:
+		return "upstream_unavailable"
+	default:
+		return "server_error"
+	}
+}
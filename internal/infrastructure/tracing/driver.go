@@ -0,0 +1,139 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Driver wraps an ent driver and records a client span for every query.
+// Only the statement is recorded; argument values are never attached.
+type Driver struct {
+	dialect.Driver
+}
+
+// NewDriver wraps drv with query tracing. Pass the result to ent.NewClient
+// with ent.Driver.
+func NewDriver(drv dialect.Driver) *Driver {
+	return &Driver{Driver: drv}
+}
+
+// Exec traces the underlying driver Exec method
+func (d *Driver) Exec(ctx context.Context, query string, args, v any) error {
+	ctx, span := d.start(ctx, "exec", query, false)
+	err := d.Driver.Exec(ctx, query, args, v)
+	finish(span, err)
+	return err
+}
+
+// Query traces the underlying driver Query method
+func (d *Driver) Query(ctx context.Context, query string, args, v any) error {
+	ctx, span := d.start(ctx, "query", query, false)
+	err := d.Driver.Query(ctx, query, args, v)
+	finish(span, err)
+	return err
+}
+
+// ExecContext traces the underlying driver ExecContext method if it is
+// supported
+func (d *Driver) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	drv, ok := d.Driver.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	ctx, span := d.start(ctx, "exec", query, false)
+	result, err := drv.ExecContext(ctx, query, args...)
+	finish(span, err)
+	return result, err
+}
+
+// QueryContext traces the underlying driver QueryContext method if it is
+// supported
+func (d *Driver) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	drv, ok := d.Driver.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	ctx, span := d.start(ctx, "query", query, false)
+	rows, err := drv.QueryContext(ctx, query, args...)
+	finish(span, err)
+	return rows, err
+}
+
+// Tx starts a transaction whose queries are also traced
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &tracingTx{Tx: tx, driver: d}, nil
+}
+
+// BeginTx starts a transaction with options if the underlying driver
+// supports it
+func (d *Driver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &tracingTx{Tx: tx, driver: d}, nil
+}
+
+// start starts a client span for a single statement
+func (d *Driver) start(ctx context.Context, operation, query string, inTx bool) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, "db."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", d.Dialect()),
+			attribute.String("db.operation", operation),
+			attribute.String("db.statement", query),
+			attribute.Bool("db.in_tx", inTx),
+		),
+	)
+}
+
+// finish records err, if any, and ends the span
+func finish(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// tracingTx traces queries run inside a transaction
+type tracingTx struct {
+	dialect.Tx
+	driver *Driver
+}
+
+// Exec traces the underlying transaction Exec method
+func (t *tracingTx) Exec(ctx context.Context, query string, args, v any) error {
+	ctx, span := t.driver.start(ctx, "exec", query, true)
+	err := t.Tx.Exec(ctx, query, args, v)
+	finish(span, err)
+	return err
+}
+
+// Query traces the underlying transaction Query method
+func (t *tracingTx) Query(ctx context.Context, query string, args, v any) error {
+	ctx, span := t.driver.start(ctx, "query", query, true)
+	err := t.Tx.Query(ctx, query, args, v)
+	finish(span, err)
+	return err
+}
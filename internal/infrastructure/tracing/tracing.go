@@ -0,0 +1,104 @@
+// Package tracing configures OpenTelemetry for the API server and the worker
+// so a single sync can be followed from the HTTP request through Gmail and
+// Drive API calls down to the database queries it issues.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the instrumentation name used for spans created by this
+// module
+const TracerName = "clockzen-next"
+
+// Config holds configuration for tracing
+type Config struct {
+	// ServiceName is reported as the service.name resource attribute
+	ServiceName string
+	// Endpoint is the host:port of an OTLP/HTTP collector; spans are only
+	// exported when it is set
+	Endpoint string
+	// Insecure sends spans over plain HTTP, for collectors on a private
+	// network
+	Insecure bool
+	// SampleRatio is the fraction of new traces that are sampled. Traces
+	// started upstream keep the caller's sampling decision.
+	SampleRatio float64
+}
+
+// DefaultConfig returns sensible default configuration
+func DefaultConfig() Config {
+	return Config{
+		SampleRatio: 1.0,
+	}
+}
+
+// Setup installs the global propagator and, if an endpoint is configured, a
+// tracer provider exporting spans over OTLP/HTTP. The returned function
+// flushes pending spans and must be called before the process exits.
+func Setup(ctx context.Context, config Config) (func(context.Context) error, error) {
+	// Propagate trace context even when spans are not exported, so traces
+	// started by callers continue through outgoing Google API requests
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if config.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(config.Endpoint)}
+	if config.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		attribute.String("service.name", config.ServiceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer used for spans created by this module
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// Start starts a span as a child of any span carried by ctx
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records err, if any, on span and ends it. It is meant to be deferred
+// with a pointer to the function's named error result.
+func End(span trace.Span, err *error) {
+	if err == nil {
+		span.End()
+		return
+	}
+	finish(span, *err)
+}
@@ -0,0 +1,146 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"clockzen-next/internal/application/alerts"
+)
+
+// AlertEngineConfig holds configuration for the alert engine
+type AlertEngineConfig struct {
+	// CheckInterval is how often every user's spending is evaluated and
+	// pending alerts delivered. Hourly digests go out no more often than
+	// this.
+	CheckInterval time.Duration
+}
+
+// DefaultAlertEngineConfig returns the default alert engine configuration
+func DefaultAlertEngineConfig() AlertEngineConfig {
+	return AlertEngineConfig{
+		CheckInterval: time.Hour,
+	}
+}
+
+// AlertEngine periodically evaluates every user's spending for anomalies
+// and budget overruns, storing an alert for each new finding, then delivers
+// pending alerts to the channels in each user's preferences
+type AlertEngine struct {
+	config  AlertEngineConfig
+	service *alerts.Service
+
+	mu      sync.RWMutex
+	running bool
+	paused  bool
+	stopCh  chan struct{}
+	doneCh  chan struct{}
+}
+
+// NewAlertEngine creates a new alert engine
+func NewAlertEngine(service *alerts.Service, config AlertEngineConfig) *AlertEngine {
+	return &AlertEngine{
+		config:  config,
+		service: service,
+	}
+}
+
+// NewAlertEngineWithDefaults creates an alert engine with default
+// configuration
+func NewAlertEngineWithDefaults(service *alerts.Service) *AlertEngine {
+	return NewAlertEngine(service, DefaultAlertEngineConfig())
+}
+
+// Start evaluates and delivers alerts every CheckInterval until Stop is
+// called
+func (e *AlertEngine) Start(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.running {
+		return nil
+	}
+	e.running = true
+	e.stopCh = make(chan struct{})
+	e.doneCh = make(chan struct{})
+
+	go e.run(ctx, e.stopCh, e.doneCh)
+	return nil
+}
+
+// Stop stops the engine and waits for a run in progress to finish
+func (e *AlertEngine) Stop() error {
+	e.mu.Lock()
+	if !e.running {
+		e.mu.Unlock()
+		return ErrWorkerNotRunning
+	}
+	e.running = false
+	close(e.stopCh)
+	doneCh := e.doneCh
+	e.mu.Unlock()
+
+	<-doneCh
+	return nil
+}
+
+// IsRunning returns whether the engine is running
+func (e *AlertEngine) IsRunning() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.running
+}
+
+// Pause stops the engine evaluating and delivering alerts on each tick until Resume is
+// called. A run in progress finishes.
+func (e *AlertEngine) Pause() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.running {
+		return ErrWorkerNotRunning
+	}
+	e.paused = true
+	return nil
+}
+
+// Resume lets a paused engine run again from the next tick
+func (e *AlertEngine) Resume() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.running {
+		return ErrWorkerNotRunning
+	}
+	e.paused = false
+	return nil
+}
+
+// IsPaused returns whether the engine is paused
+func (e *AlertEngine) IsPaused() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.paused
+}
+
+// run evaluates and delivers alerts on every tick
+func (e *AlertEngine) run(ctx context.Context, stopCh, doneCh chan struct{}) {
+	defer close(doneCh)
+	ticker := time.NewTicker(e.config.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if !e.IsPaused() {
+			now := time.Now()
+			raised := e.service.EvaluateAll(ctx, now)
+			delivered := e.service.DeliverAll(ctx, now)
+			slog.DebugContext(ctx, "ran alert engine", "raised", raised, "delivered", delivered)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"clockzen-next/internal/infrastructure/queue"
+)
+
+// Backpressure errors
+var (
+	ErrQueueSaturated      = errors.New("task queue is saturated")
+	ErrInvalidWorkerConfig = errors.New("invalid worker configuration")
+)
+
+// connectionBusyDelay is how long a task waits before running again when
+// its connection already has as many tasks running as it may
+const connectionBusyDelay = 15 * time.Second
+
+// QueueSaturatedError is returned when a task is queued while its queue
+// already holds as many pending tasks as it may. The task isn't queued;
+// callers should try again later rather than retry at once. It matches
+// ErrQueueSaturated with errors.Is.
+type QueueSaturatedError struct {
+	// Queue is the job queue the task was for
+	Queue string
+	// Pending is the number of tasks waiting in the queue
+	Pending int
+	// Limit is the queue's configured depth limit
+	Limit int
+}
+
+// Error implements error
+func (e *QueueSaturatedError) Error() string {
+	return fmt.Sprintf("task queue %s is saturated (%d pending, limit %d)", e.Queue, e.Pending, e.Limit)
+}
+
+// Is reports whether target is ErrQueueSaturated
+func (e *QueueSaturatedError) Is(target error) bool {
+	return target == ErrQueueSaturated
+}
+
+// checkQueueDepth returns a QueueSaturatedError if the named queue holds
+// limit or more pending tasks. A limit of zero or less is no limit.
+func checkQueueDepth(ctx context.Context, jobQueue *queue.Queue, name string, limit int) error {
+	if limit <= 0 {
+		return nil
+	}
+	pending, err := jobQueue.PendingCount(ctx, name)
+	if err != nil {
+		return fmt.Errorf("counting queued tasks: %w", err)
+	}
+	if pending >= limit {
+		return &QueueSaturatedError{Queue: name, Pending: pending, Limit: limit}
+	}
+	return nil
+}
+
+// validatePoolConfig checks the sizing shared by the email and Drive
+// worker configurations
+func validatePoolConfig(maxConcurrent, maxQueued, maxPerConnection int, taskTimeout time.Duration) error {
+	switch {
+	case maxConcurrent < 1:
+		return fmt.Errorf("%w: max concurrent tasks must be at least 1, got %d", ErrInvalidWorkerConfig, maxConcurrent)
+	case maxQueued < 0:
+		return fmt.Errorf("%w: max queued tasks must not be negative, got %d", ErrInvalidWorkerConfig, maxQueued)
+	case maxPerConnection < 0:
+		return fmt.Errorf("%w: max tasks per connection must not be negative, got %d", ErrInvalidWorkerConfig, maxPerConnection)
+	case maxPerConnection > maxConcurrent:
+		return fmt.Errorf("%w: max tasks per connection (%d) exceeds max concurrent tasks (%d)", ErrInvalidWorkerConfig, maxPerConnection, maxConcurrent)
+	case taskTimeout <= 0:
+		return fmt.Errorf("%w: task timeout must be positive, got %s", ErrInvalidWorkerConfig, taskTimeout)
+	}
+	return nil
+}
+
+// connectionSlots counts the tasks running for each connection, so no
+// connection takes more than its share of a worker's concurrency
+type connectionSlots struct {
+	limit int
+
+	mu      sync.Mutex
+	running map[string]int
+}
+
+// newConnectionSlots allows limit tasks per connection at once. A limit of
+// zero or less is no limit.
+func newConnectionSlots(limit int) *connectionSlots {
+	return &connectionSlots{
+		limit:   limit,
+		running: make(map[string]int),
+	}
+}
+
+// acquire takes a slot for a task of the connection, reporting false if
+// the connection has none free
+func (s *connectionSlots) acquire(connectionID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.limit > 0 && s.running[connectionID] >= s.limit {
+		return false
+	}
+	s.running[connectionID]++
+	return true
+}
+
+// release frees a slot taken with acquire
+func (s *connectionSlots) release(connectionID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.running[connectionID]--
+	if s.running[connectionID] <= 0 {
+		delete(s.running, connectionID)
+	}
+}
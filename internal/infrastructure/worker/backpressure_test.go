@@ -0,0 +1,63 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueueSaturatedError(t *testing.T) {
+	err := fmt.Errorf("queueing: %w", &QueueSaturatedError{Queue: EmailImportQueue, Pending: 10, Limit: 10})
+
+	assert.ErrorIs(t, err, ErrQueueSaturated)
+	var saturated *QueueSaturatedError
+	assert.True(t, errors.As(err, &saturated))
+	assert.Equal(t, 10, saturated.Limit)
+}
+
+func TestWorkerConfigValidate(t *testing.T) {
+	assert.NoError(t, DefaultEmailImportWorkerConfig().Validate())
+	assert.NoError(t, DefaultDriveSyncWorkerConfig().Validate())
+
+	tests := []struct {
+		name   string
+		modify func(*EmailImportWorkerConfig)
+	}{
+		{"no concurrency", func(c *EmailImportWorkerConfig) { c.MaxConcurrentTasks = 0 }},
+		{"negative queue depth", func(c *EmailImportWorkerConfig) { c.MaxQueuedTasks = -1 }},
+		{"negative per connection", func(c *EmailImportWorkerConfig) { c.MaxTasksPerConnection = -1 }},
+		{"per connection above concurrency", func(c *EmailImportWorkerConfig) {
+			c.MaxConcurrentTasks = 2
+			c.MaxTasksPerConnection = 3
+		}},
+		{"no task timeout", func(c *EmailImportWorkerConfig) { c.TaskTimeout = 0 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DefaultEmailImportWorkerConfig()
+			tt.modify(&config)
+			assert.ErrorIs(t, config.Validate(), ErrInvalidWorkerConfig)
+		})
+	}
+}
+
+func TestConnectionSlots(t *testing.T) {
+	slots := newConnectionSlots(2)
+
+	assert.True(t, slots.acquire("conn-1"))
+	assert.True(t, slots.acquire("conn-1"))
+	assert.False(t, slots.acquire("conn-1"), "limit reached")
+	assert.True(t, slots.acquire("conn-2"), "connections are limited separately")
+
+	slots.release("conn-1")
+	assert.True(t, slots.acquire("conn-1"))
+
+	t.Run("no limit", func(t *testing.T) {
+		slots := newConnectionSlots(0)
+		for range 10 {
+			assert.True(t, slots.acquire("conn-1"))
+		}
+	})
+}
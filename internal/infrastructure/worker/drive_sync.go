@@ -5,12 +5,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"sync"
 	"time"
 
 	"clockzen-next/internal/application/integration"
+	"clockzen-next/internal/application/organizations"
+	"clockzen-next/internal/application/webhooks"
 	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/googledriveconnection"
 	"clockzen-next/internal/infrastructure/google"
+	"clockzen-next/internal/infrastructure/queue"
 
 	"github.com/google/uuid"
 )
@@ -23,6 +28,9 @@ var (
 	ErrDriveSyncOCRQueueFull          = errors.New("OCR task queue is full")
 )
 
+// DriveSyncQueue is the job queue drive sync tasks run from
+const DriveSyncQueue = "drive_sync"
+
 // DriveSyncTaskStatus represents the status of a drive sync task
 type DriveSyncTaskStatus string
 
@@ -47,6 +55,11 @@ type DriveSyncTask struct {
 	MaxRetries   int                 `json:"max_retries"`
 	Error        string              `json:"error,omitempty"`
 	Result       *DriveSyncResult    `json:"result,omitempty"`
+	// Priority orders queued tasks; higher runs first
+	Priority int `json:"priority,omitempty"`
+	// RunAt schedules the task for later instead of running it when a
+	// worker is free
+	RunAt *time.Time `json:"run_at,omitempty"`
 }
 
 // DriveSyncResult contains the result of a drive sync operation
@@ -81,10 +94,18 @@ type DriveSyncWorkerConfig struct {
 	MaxConcurrentTasks int
 	// MaxRetriesPerTask is the maximum number of retries for failed tasks
 	MaxRetriesPerTask int
-	// RetryBackoffDuration is the base duration for exponential backoff
+	// RetryBackoffDuration is the delay before the first retry, doubled
+	// for each further retry
 	RetryBackoffDuration time.Duration
 	// OCRQueueSize is the maximum size of the OCR task queue
 	OCRQueueSize int
+	// MaxQueuedTasks limits the tasks waiting in the job queue; QueueTask
+	// fails with a QueueSaturatedError once it is reached. Zero is no
+	// limit.
+	MaxQueuedTasks int
+	// MaxTasksPerConnection limits the tasks of one connection run at once
+	// by this worker; further tasks wait. Zero is no limit.
+	MaxTasksPerConnection int
 	// TaskTimeout is the maximum time allowed for a single task
 	TaskTimeout time.Duration
 	// EnableOCRQueueing enables automatic OCR task queuing for receipt files
@@ -94,53 +115,62 @@ type DriveSyncWorkerConfig struct {
 // DefaultDriveSyncWorkerConfig returns sensible default configuration
 func DefaultDriveSyncWorkerConfig() DriveSyncWorkerConfig {
 	return DriveSyncWorkerConfig{
-		MaxConcurrentTasks:   3,
-		MaxRetriesPerTask:    3,
-		RetryBackoffDuration: 30 * time.Second,
-		OCRQueueSize:         1000,
-		TaskTimeout:          60 * time.Minute,
-		EnableOCRQueueing:    true,
+		MaxConcurrentTasks:    3,
+		MaxRetriesPerTask:     3,
+		RetryBackoffDuration:  30 * time.Second,
+		MaxQueuedTasks:        5000,
+		MaxTasksPerConnection: 2,
+		OCRQueueSize:          1000,
+		TaskTimeout:           60 * time.Minute,
+		EnableOCRQueueing:     true,
 	}
 }
 
+// Validate checks the configuration's pool sizing and limits
+func (c DriveSyncWorkerConfig) Validate() error {
+	return validatePoolConfig(c.MaxConcurrentTasks, c.MaxQueuedTasks, c.MaxTasksPerConnection, c.TaskTimeout)
+}
+
 // DriveSyncWorker processes Google Drive sync tasks
 type DriveSyncWorker struct {
 	config      DriveSyncWorkerConfig
 	entClient   *ent.Client
 	oauthCfg    *google.Config
 	syncService *integration.DriveSyncService
+	jobQueue    *queue.Queue
 
-	mu           sync.RWMutex
-	running      bool
-	taskQueue    chan *DriveSyncTask
-	ocrQueue     chan *OCRTask
-	activeTasks  map[string]*DriveSyncTask
-	cancelFuncs  map[string]context.CancelFunc
-	stopCh       chan struct{}
-	wg           sync.WaitGroup
+	mu          sync.RWMutex
+	running     bool
+	ocrQueue    chan *OCRTask
+	connSlots   *connectionSlots
+	activeTasks map[string]*DriveSyncTask
+	cancelFuncs map[string]context.CancelFunc
 
 	// Callbacks for external integrations
 	onTaskComplete func(task *DriveSyncTask)
 	onOCRTaskQueue func(task *OCRTask) error
+	publisher      webhooks.Publisher
 }
 
-// NewDriveSyncWorker creates a new drive sync worker
+// NewDriveSyncWorker creates a new drive sync worker. Tasks are queued on
+// and run from jobQueue's DriveSyncQueue.
 func NewDriveSyncWorker(
 	entClient *ent.Client,
 	oauthCfg *google.Config,
 	syncService *integration.DriveSyncService,
+	jobQueue *queue.Queue,
 	config DriveSyncWorkerConfig,
 ) *DriveSyncWorker {
 	return &DriveSyncWorker{
-		config:       config,
-		entClient:    entClient,
-		oauthCfg:     oauthCfg,
-		syncService:  syncService,
-		taskQueue:    make(chan *DriveSyncTask, 100),
-		ocrQueue:     make(chan *OCRTask, config.OCRQueueSize),
-		activeTasks:  make(map[string]*DriveSyncTask),
-		cancelFuncs:  make(map[string]context.CancelFunc),
-		stopCh:       make(chan struct{}),
+		config:      config,
+		entClient:   entClient,
+		oauthCfg:    oauthCfg,
+		syncService: syncService,
+		jobQueue:    jobQueue,
+		ocrQueue:    make(chan *OCRTask, config.OCRQueueSize),
+		connSlots:   newConnectionSlots(config.MaxTasksPerConnection),
+		activeTasks: make(map[string]*DriveSyncTask),
+		cancelFuncs: make(map[string]context.CancelFunc),
 	}
 }
 
@@ -149,8 +179,9 @@ func NewDriveSyncWorkerWithDefaults(
 	entClient *ent.Client,
 	oauthCfg *google.Config,
 	syncService *integration.DriveSyncService,
+	jobQueue *queue.Queue,
 ) *DriveSyncWorker {
-	return NewDriveSyncWorker(entClient, oauthCfg, syncService, DefaultDriveSyncWorkerConfig())
+	return NewDriveSyncWorker(entClient, oauthCfg, syncService, jobQueue, DefaultDriveSyncWorkerConfig())
 }
 
 // SetOnTaskComplete sets the callback for task completion
@@ -160,6 +191,14 @@ func (w *DriveSyncWorker) SetOnTaskComplete(callback func(task *DriveSyncTask))
 	w.onTaskComplete = callback
 }
 
+// SetEventPublisher sets where sync.completed events are published as
+// tasks complete
+func (w *DriveSyncWorker) SetEventPublisher(publisher webhooks.Publisher) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.publisher = publisher
+}
+
 // SetOnOCRTaskQueue sets the callback for OCR task queuing
 func (w *DriveSyncWorker) SetOnOCRTaskQueue(callback func(task *OCRTask) error) {
 	w.mu.Lock()
@@ -167,57 +206,48 @@ func (w *DriveSyncWorker) SetOnOCRTaskQueue(callback func(task *OCRTask) error)
 	w.onOCRTaskQueue = callback
 }
 
-// Start begins processing tasks
+// Start registers the worker as the handler of DriveSyncQueue, so the job
+// queue runs queued tasks once it is started
 func (w *DriveSyncWorker) Start(ctx context.Context) error {
 	w.mu.Lock()
+	defer w.mu.Unlock()
 	if w.running {
-		w.mu.Unlock()
 		return nil
 	}
-	w.running = true
-	w.stopCh = make(chan struct{})
-	w.mu.Unlock()
 
-	// Start worker goroutines
-	for i := 0; i < w.config.MaxConcurrentTasks; i++ {
-		w.wg.Add(1)
-		go w.workerLoop(ctx, i)
+	err := w.jobQueue.Register(ctx, DriveSyncQueue, w.handleJob, queue.HandlerOptions{
+		Concurrency:  w.config.MaxConcurrentTasks,
+		RetryBackoff: w.config.RetryBackoffDuration,
+	})
+	if err != nil {
+		return fmt.Errorf("registering drive sync handler: %w", err)
 	}
-
+	w.running = true
 	return nil
 }
 
-// Stop gracefully stops the worker
+// Stop cancels the tasks being processed. Stop the job queue first so no
+// new tasks are started and interrupted ones are queued again.
 func (w *DriveSyncWorker) Stop() error {
 	w.mu.Lock()
+	defer w.mu.Unlock()
 	if !w.running {
-		w.mu.Unlock()
 		return ErrDriveSyncWorkerNotRunning
 	}
 	w.running = false
-	close(w.stopCh)
 
 	// Cancel all active tasks
 	for _, cancel := range w.cancelFuncs {
 		cancel()
 	}
-	w.mu.Unlock()
-
-	// Wait for all workers to finish
-	w.wg.Wait()
-
 	return nil
 }
 
-// QueueTask adds a new drive sync task to the queue
-func (w *DriveSyncWorker) QueueTask(task *DriveSyncTask) error {
-	w.mu.RLock()
-	if !w.running {
-		w.mu.RUnlock()
-		return ErrDriveSyncWorkerNotRunning
-	}
-	w.mu.RUnlock()
-
+// QueueTask adds a new drive sync task to the job queue. The task is
+// stored, so it runs even if this worker stops before it is picked up, and
+// is run by whichever worker process is free first. Once MaxQueuedTasks
+// are waiting, the task is rejected with a QueueSaturatedError.
+func (w *DriveSyncWorker) QueueTask(ctx context.Context, task *DriveSyncTask) error {
 	// Set defaults
 	if task.ID == "" {
 		task.ID = uuid.New().String()
@@ -235,16 +265,51 @@ func (w *DriveSyncWorker) QueueTask(task *DriveSyncTask) error {
 		task.SyncType = "incremental"
 	}
 
-	select {
-	case w.taskQueue <- task:
-		return nil
-	default:
-		return errors.New("drive sync task queue is full")
+	opts := queue.EnqueueOptions{
+		Priority:   task.Priority,
+		MaxRetries: task.MaxRetries,
 	}
+	if task.RunAt != nil {
+		opts.RunAt = *task.RunAt
+	}
+	if err := checkQueueDepth(ctx, w.jobQueue, DriveSyncQueue, w.config.MaxQueuedTasks); err != nil {
+		return err
+	}
+	job, err := w.jobQueue.Enqueue(ctx, DriveSyncQueue, task.SyncType, task, opts)
+	if err != nil {
+		return fmt.Errorf("queueing drive sync task: %w", err)
+	}
+	task.ID = job.ID
+	return nil
+}
+
+// handleJob runs a drive sync task taken from the job queue, which retries
+// failed tasks with backoff
+func (w *DriveSyncWorker) handleJob(ctx context.Context, job *queue.Job) (any, error) {
+	var task DriveSyncTask
+	if err := job.Decode(&task); err != nil {
+		return nil, fmt.Errorf("decoding drive sync task: %w", err)
+	}
+	task.ID = job.ID
+	task.RetryCount = job.RetryCount
+	task.MaxRetries = job.MaxRetries
+
+	// A connection that already has its share of tasks running waits, so
+	// one busy connection doesn't hold every slot
+	if !w.connSlots.acquire(task.ConnectionID) {
+		return nil, queue.Defer(connectionBusyDelay)
+	}
+	defer w.connSlots.release(task.ConnectionID)
+
+	if err := w.HandleDriveSync(ctx, &task); err != nil {
+		return nil, err
+	}
+	return task.Result, nil
 }
 
-// HandleDriveSync processes a single drive sync task synchronously
-// This is the main entry point for handling drive sync tasks
+// HandleDriveSync processes a single drive sync task synchronously.
+// Retrying a failed task is left to the caller; tasks run from the job
+// queue are retried by the queue.
 func (w *DriveSyncWorker) HandleDriveSync(ctx context.Context, task *DriveSyncTask) error {
 	if task == nil {
 		return ErrDriveSyncTaskNotFound
@@ -283,18 +348,6 @@ func (w *DriveSyncWorker) HandleDriveSync(ctx context.Context, task *DriveSyncTa
 		completedAt := time.Now()
 		task.CompletedAt = &completedAt
 
-		// Check if we should retry
-		if task.RetryCount < task.MaxRetries {
-			task.RetryCount++
-			task.Status = DriveSyncTaskStatusPending
-			// Re-queue with backoff
-			go func() {
-				backoff := w.config.RetryBackoffDuration * time.Duration(task.RetryCount)
-				time.Sleep(backoff)
-				_ = w.QueueTask(task)
-			}()
-		}
-
 		w.notifyTaskComplete(task)
 		return err
 	}
@@ -306,6 +359,7 @@ func (w *DriveSyncWorker) HandleDriveSync(ctx context.Context, task *DriveSyncTa
 	task.CompletedAt = &completedAt
 
 	w.notifyTaskComplete(task)
+	w.publishSyncCompleted(ctx, task)
 	return nil
 }
 
@@ -414,22 +468,39 @@ func (w *DriveSyncWorker) GetOCRQueue() <-chan *OCRTask {
 	return w.ocrQueue
 }
 
-// workerLoop is the main loop for a worker goroutine
-func (w *DriveSyncWorker) workerLoop(ctx context.Context, _ int) {
-	defer w.wg.Done()
+// publishSyncCompleted publishes a sync.completed event for a completed
+// task to the connection owner's webhooks. Failures are logged; the task
+// still completed.
+func (w *DriveSyncWorker) publishSyncCompleted(ctx context.Context, task *DriveSyncTask) {
+	w.mu.RLock()
+	publisher := w.publisher
+	w.mu.RUnlock()
+	if publisher == nil || task.Result == nil {
+		return
+	}
 
-	for {
-		select {
-		case <-w.stopCh:
-			return
-		case <-ctx.Done():
-			return
-		case task := <-w.taskQueue:
-			if task == nil {
-				continue
-			}
-			_ = w.HandleDriveSync(ctx, task)
-		}
+	conn, err := w.entClient.GoogleDriveConnection.Query().
+		Where(googledriveconnection.ID(task.ConnectionID)).
+		Select(googledriveconnection.FieldUserID, googledriveconnection.FieldOrganizationID).
+		Only(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "getting connection owner for sync event", "connection_id", task.ConnectionID, "error", err)
+		return
+	}
+	userID := organizations.OwnerID(conn.UserID, conn.OrganizationID)
+
+	data := SyncCompletedEvent{
+		Source:            "drive",
+		ConnectionID:      task.ConnectionID,
+		SyncID:            task.Result.SyncID,
+		SyncType:          task.SyncType,
+		ItemsScanned:      task.Result.FilesScanned,
+		ItemsFailed:       task.Result.FilesFailed,
+		ReceiptsExtracted: task.Result.ReceiptsExtracted,
+		CompletedAt:       *task.CompletedAt,
+	}
+	if err := publisher.Publish(ctx, userID, webhooks.EventSyncCompleted, data); err != nil {
+		slog.ErrorContext(ctx, "publishing sync event", "connection_id", task.ConnectionID, "error", err)
 	}
 }
 
@@ -491,6 +562,29 @@ func (w *DriveSyncWorker) CancelTask(taskID string) error {
 	return nil
 }
 
+// Pause stops this worker taking tasks from DriveSyncQueue. Tasks already
+// running finish, and queued ones wait for Resume or are run by another
+// worker process.
+func (w *DriveSyncWorker) Pause() error {
+	if !w.IsRunning() {
+		return ErrDriveSyncWorkerNotRunning
+	}
+	return w.jobQueue.PauseHandler(DriveSyncQueue)
+}
+
+// Resume lets a paused worker take tasks again
+func (w *DriveSyncWorker) Resume() error {
+	if !w.IsRunning() {
+		return ErrDriveSyncWorkerNotRunning
+	}
+	return w.jobQueue.ResumeHandler(DriveSyncQueue)
+}
+
+// IsPaused returns whether the worker is paused
+func (w *DriveSyncWorker) IsPaused() bool {
+	return w.jobQueue.HandlerPaused(DriveSyncQueue)
+}
+
 // IsRunning returns whether the worker is running
 func (w *DriveSyncWorker) IsRunning() bool {
 	w.mu.RLock()
@@ -498,9 +592,10 @@ func (w *DriveSyncWorker) IsRunning() bool {
 	return w.running
 }
 
-// QueuedTaskCount returns the number of tasks waiting in the queue
-func (w *DriveSyncWorker) QueuedTaskCount() int {
-	return len(w.taskQueue)
+// QueuedTaskCount returns the number of tasks waiting in the job queue,
+// including those scheduled for later
+func (w *DriveSyncWorker) QueuedTaskCount(ctx context.Context) (int, error) {
+	return w.jobQueue.PendingCount(ctx, DriveSyncQueue)
 }
 
 // QueuedOCRTaskCount returns the number of OCR tasks waiting in the queue
@@ -5,13 +5,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"sync"
 	"time"
 
 	"clockzen-next/internal/application/integration"
+	"clockzen-next/internal/application/organizations"
+	"clockzen-next/internal/application/webhooks"
 	"clockzen-next/internal/ent"
 	"clockzen-next/internal/ent/emailconnection"
 	"clockzen-next/internal/infrastructure/google"
+	"clockzen-next/internal/infrastructure/queue"
 
 	"github.com/google/uuid"
 )
@@ -25,6 +29,9 @@ var (
 	ErrOCRQueueFull          = errors.New("OCR task queue is full")
 )
 
+// EmailImportQueue is the job queue email import tasks run from
+const EmailImportQueue = "email_import"
+
 // EmailImportTaskStatus represents the status of an email import task
 type EmailImportTaskStatus string
 
@@ -49,21 +56,28 @@ type EmailImportTask struct {
 	MaxRetries   int                   `json:"max_retries"`
 	Error        string                `json:"error,omitempty"`
 	Result       *EmailImportResult    `json:"result,omitempty"`
+	ResumeSyncID string                `json:"resume_sync_id,omitempty"`
+	// Priority orders queued tasks; higher runs first
+	Priority int `json:"priority,omitempty"`
+	// RunAt schedules the task for later instead of running it when a
+	// worker is free
+	RunAt *time.Time `json:"run_at,omitempty"`
 }
 
 // EmailImportResult contains the result of an email import operation
 type EmailImportResult struct {
-	SyncID                string                 `json:"sync_id"`
-	MessagesScanned       int                    `json:"messages_scanned"`
-	MessagesDownloaded    int                    `json:"messages_downloaded"`
-	MessagesIndexed       int                    `json:"messages_indexed"`
-	MessagesFailed        int                    `json:"messages_failed"`
-	AttachmentsDownloaded int                    `json:"attachments_downloaded"`
-	BytesTransferred      int64                  `json:"bytes_transferred"`
-	ReceiptsExtracted     int                    `json:"receipts_extracted"`
-	OCRTasksQueued        int                    `json:"ocr_tasks_queued"`
-	Receipts              []ExtractedReceipt     `json:"receipts,omitempty"`
-	QueuedOCRTasks        []OCRTask              `json:"queued_ocr_tasks,omitempty"`
+	SyncID                  string             `json:"sync_id"`
+	MessagesScanned         int                `json:"messages_scanned"`
+	MessagesDownloaded      int                `json:"messages_downloaded"`
+	MessagesIndexed         int                `json:"messages_indexed"`
+	MessagesFailed          int                `json:"messages_failed"`
+	AttachmentsDownloaded   int                `json:"attachments_downloaded"`
+	AttachmentsDeduplicated int                `json:"attachments_deduplicated"`
+	BytesTransferred        int64              `json:"bytes_transferred"`
+	ReceiptsExtracted       int                `json:"receipts_extracted"`
+	OCRTasksQueued          int                `json:"ocr_tasks_queued"`
+	Receipts                []ExtractedReceipt `json:"receipts,omitempty"`
+	QueuedOCRTasks          []OCRTask          `json:"queued_ocr_tasks,omitempty"`
 }
 
 // ExtractedReceipt represents a receipt extracted from an email
@@ -88,6 +102,8 @@ type ExtractedAttachment struct {
 	MimeType     string `json:"mime_type"`
 	Size         int    `json:"size"`
 	IsReceipt    bool   `json:"is_receipt"`
+	ContentHash  string `json:"content_hash,omitempty"`
+	Duplicate    bool   `json:"duplicate,omitempty"`
 }
 
 // OCRTask represents a task to perform OCR on an attachment
@@ -96,6 +112,8 @@ type OCRTask struct {
 	ConnectionID string    `json:"connection_id"`
 	MessageID    string    `json:"message_id"`
 	AttachmentID string    `json:"attachment_id"`
+	BlobID       string    `json:"blob_id,omitempty"`
+	ContentHash  string    `json:"content_hash,omitempty"`
 	Filename     string    `json:"filename"`
 	MimeType     string    `json:"mime_type"`
 	Size         int       `json:"size"`
@@ -110,10 +128,18 @@ type EmailImportWorkerConfig struct {
 	MaxConcurrentTasks int
 	// MaxRetriesPerTask is the maximum number of retries for failed tasks
 	MaxRetriesPerTask int
-	// RetryBackoffDuration is the base duration for exponential backoff
+	// RetryBackoffDuration is the delay before the first retry, doubled
+	// for each further retry
 	RetryBackoffDuration time.Duration
 	// OCRQueueSize is the maximum size of the OCR task queue
 	OCRQueueSize int
+	// MaxQueuedTasks limits the tasks waiting in the job queue; QueueTask
+	// fails with a QueueSaturatedError once it is reached. Zero is no
+	// limit.
+	MaxQueuedTasks int
+	// MaxTasksPerConnection limits the tasks of one connection run at once
+	// by this worker; further tasks wait. Zero is no limit.
+	MaxTasksPerConnection int
 	// TaskTimeout is the maximum time allowed for a single task
 	TaskTimeout time.Duration
 	// EnableOCRQueueing enables automatic OCR task queuing for receipt attachments
@@ -123,53 +149,62 @@ type EmailImportWorkerConfig struct {
 // DefaultEmailImportWorkerConfig returns sensible default configuration
 func DefaultEmailImportWorkerConfig() EmailImportWorkerConfig {
 	return EmailImportWorkerConfig{
-		MaxConcurrentTasks:   5,
-		MaxRetriesPerTask:    3,
-		RetryBackoffDuration: 30 * time.Second,
-		OCRQueueSize:         1000,
-		TaskTimeout:          30 * time.Minute,
-		EnableOCRQueueing:    true,
+		MaxConcurrentTasks:    5,
+		MaxRetriesPerTask:     3,
+		RetryBackoffDuration:  30 * time.Second,
+		MaxQueuedTasks:        5000,
+		MaxTasksPerConnection: 2,
+		OCRQueueSize:          1000,
+		TaskTimeout:           30 * time.Minute,
+		EnableOCRQueueing:     true,
 	}
 }
 
+// Validate checks the configuration's pool sizing and limits
+func (c EmailImportWorkerConfig) Validate() error {
+	return validatePoolConfig(c.MaxConcurrentTasks, c.MaxQueuedTasks, c.MaxTasksPerConnection, c.TaskTimeout)
+}
+
 // EmailImportWorker processes email import tasks
 type EmailImportWorker struct {
 	config      EmailImportWorkerConfig
 	entClient   *ent.Client
 	oauthCfg    *google.Config
 	syncService *integration.EmailSyncService
+	jobQueue    *queue.Queue
 
-	mu           sync.RWMutex
-	running      bool
-	taskQueue    chan *EmailImportTask
-	ocrQueue     chan *OCRTask
-	activeTasks  map[string]*EmailImportTask
-	cancelFuncs  map[string]context.CancelFunc
-	stopCh       chan struct{}
-	wg           sync.WaitGroup
+	mu          sync.RWMutex
+	running     bool
+	ocrQueue    chan *OCRTask
+	connSlots   *connectionSlots
+	activeTasks map[string]*EmailImportTask
+	cancelFuncs map[string]context.CancelFunc
 
 	// Callbacks for external integrations
 	onTaskComplete func(task *EmailImportTask)
 	onOCRTaskQueue func(task *OCRTask) error
+	publisher      webhooks.Publisher
 }
 
-// NewEmailImportWorker creates a new email import worker
+// NewEmailImportWorker creates a new email import worker. Tasks are queued
+// on and run from jobQueue's EmailImportQueue.
 func NewEmailImportWorker(
 	entClient *ent.Client,
 	oauthCfg *google.Config,
 	syncService *integration.EmailSyncService,
+	jobQueue *queue.Queue,
 	config EmailImportWorkerConfig,
 ) *EmailImportWorker {
 	return &EmailImportWorker{
-		config:       config,
-		entClient:    entClient,
-		oauthCfg:     oauthCfg,
-		syncService:  syncService,
-		taskQueue:    make(chan *EmailImportTask, 100),
-		ocrQueue:     make(chan *OCRTask, config.OCRQueueSize),
-		activeTasks:  make(map[string]*EmailImportTask),
-		cancelFuncs:  make(map[string]context.CancelFunc),
-		stopCh:       make(chan struct{}),
+		config:      config,
+		entClient:   entClient,
+		oauthCfg:    oauthCfg,
+		syncService: syncService,
+		jobQueue:    jobQueue,
+		ocrQueue:    make(chan *OCRTask, config.OCRQueueSize),
+		connSlots:   newConnectionSlots(config.MaxTasksPerConnection),
+		activeTasks: make(map[string]*EmailImportTask),
+		cancelFuncs: make(map[string]context.CancelFunc),
 	}
 }
 
@@ -178,8 +213,9 @@ func NewEmailImportWorkerWithDefaults(
 	entClient *ent.Client,
 	oauthCfg *google.Config,
 	syncService *integration.EmailSyncService,
+	jobQueue *queue.Queue,
 ) *EmailImportWorker {
-	return NewEmailImportWorker(entClient, oauthCfg, syncService, DefaultEmailImportWorkerConfig())
+	return NewEmailImportWorker(entClient, oauthCfg, syncService, jobQueue, DefaultEmailImportWorkerConfig())
 }
 
 // SetOnTaskComplete sets the callback for task completion
@@ -189,6 +225,14 @@ func (w *EmailImportWorker) SetOnTaskComplete(callback func(task *EmailImportTas
 	w.onTaskComplete = callback
 }
 
+// SetEventPublisher sets where sync.completed events are published as
+// tasks complete
+func (w *EmailImportWorker) SetEventPublisher(publisher webhooks.Publisher) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.publisher = publisher
+}
+
 // SetOnOCRTaskQueue sets the callback for OCR task queuing
 func (w *EmailImportWorker) SetOnOCRTaskQueue(callback func(task *OCRTask) error) {
 	w.mu.Lock()
@@ -196,57 +240,48 @@ func (w *EmailImportWorker) SetOnOCRTaskQueue(callback func(task *OCRTask) error
 	w.onOCRTaskQueue = callback
 }
 
-// Start begins processing tasks
+// Start registers the worker as the handler of EmailImportQueue, so the
+// job queue runs queued tasks once it is started
 func (w *EmailImportWorker) Start(ctx context.Context) error {
 	w.mu.Lock()
+	defer w.mu.Unlock()
 	if w.running {
-		w.mu.Unlock()
 		return nil
 	}
-	w.running = true
-	w.stopCh = make(chan struct{})
-	w.mu.Unlock()
 
-	// Start worker goroutines
-	for i := 0; i < w.config.MaxConcurrentTasks; i++ {
-		w.wg.Add(1)
-		go w.workerLoop(ctx, i)
+	err := w.jobQueue.Register(ctx, EmailImportQueue, w.handleJob, queue.HandlerOptions{
+		Concurrency:  w.config.MaxConcurrentTasks,
+		RetryBackoff: w.config.RetryBackoffDuration,
+	})
+	if err != nil {
+		return fmt.Errorf("registering email import handler: %w", err)
 	}
-
+	w.running = true
 	return nil
 }
 
-// Stop gracefully stops the worker
+// Stop cancels the tasks being processed. Stop the job queue first so no
+// new tasks are started and interrupted ones are queued again.
 func (w *EmailImportWorker) Stop() error {
 	w.mu.Lock()
+	defer w.mu.Unlock()
 	if !w.running {
-		w.mu.Unlock()
 		return ErrWorkerNotRunning
 	}
 	w.running = false
-	close(w.stopCh)
 
 	// Cancel all active tasks
 	for _, cancel := range w.cancelFuncs {
 		cancel()
 	}
-	w.mu.Unlock()
-
-	// Wait for all workers to finish
-	w.wg.Wait()
-
 	return nil
 }
 
-// QueueTask adds a new email import task to the queue
-func (w *EmailImportWorker) QueueTask(task *EmailImportTask) error {
-	w.mu.RLock()
-	if !w.running {
-		w.mu.RUnlock()
-		return ErrWorkerNotRunning
-	}
-	w.mu.RUnlock()
-
+// QueueTask adds a new email import task to the job queue. The task is
+// stored, so it runs even if this worker stops before it is picked up, and
+// is run by whichever worker process is free first. Once MaxQueuedTasks
+// are waiting, the task is rejected with a QueueSaturatedError.
+func (w *EmailImportWorker) QueueTask(ctx context.Context, task *EmailImportTask) error {
 	// Set defaults
 	if task.ID == "" {
 		task.ID = uuid.New().String()
@@ -264,16 +299,56 @@ func (w *EmailImportWorker) QueueTask(task *EmailImportTask) error {
 		task.SyncType = "incremental"
 	}
 
-	select {
-	case w.taskQueue <- task:
-		return nil
-	default:
-		return errors.New("task queue is full")
+	opts := queue.EnqueueOptions{
+		Priority:   task.Priority,
+		MaxRetries: task.MaxRetries,
+	}
+	if task.RunAt != nil {
+		opts.RunAt = *task.RunAt
 	}
+	if err := checkQueueDepth(ctx, w.jobQueue, EmailImportQueue, w.config.MaxQueuedTasks); err != nil {
+		return err
+	}
+	job, err := w.jobQueue.Enqueue(ctx, EmailImportQueue, task.SyncType, task, opts)
+	if err != nil {
+		return fmt.Errorf("queueing email import task: %w", err)
+	}
+	task.ID = job.ID
+	return nil
 }
 
-// HandleEmailImport processes a single email import task synchronously
-// This is the main entry point for handling email import tasks
+// handleJob runs an email import task taken from the job queue. The queue
+// retries failed tasks with backoff; the task is stored back on the job
+// first so a retry resumes from the failed sync's checkpoint.
+func (w *EmailImportWorker) handleJob(ctx context.Context, job *queue.Job) (any, error) {
+	var task EmailImportTask
+	if err := job.Decode(&task); err != nil {
+		return nil, fmt.Errorf("decoding email import task: %w", err)
+	}
+	task.ID = job.ID
+	task.RetryCount = job.RetryCount
+	task.MaxRetries = job.MaxRetries
+
+	// A connection that already has its share of tasks running waits, so
+	// one busy connection doesn't hold every slot
+	if !w.connSlots.acquire(task.ConnectionID) {
+		return nil, queue.Defer(connectionBusyDelay)
+	}
+	defer w.connSlots.release(task.ConnectionID)
+
+	if err := w.HandleEmailImport(ctx, &task); err != nil {
+		task.Status = TaskStatusPending
+		if payloadErr := job.SetPayload(&task); payloadErr != nil {
+			return nil, errors.Join(err, payloadErr)
+		}
+		return nil, err
+	}
+	return task.Result, nil
+}
+
+// HandleEmailImport processes a single email import task synchronously.
+// Retrying a failed task is left to the caller; tasks run from the job
+// queue are retried by the queue.
 func (w *EmailImportWorker) HandleEmailImport(ctx context.Context, task *EmailImportTask) error {
 	if task == nil {
 		return ErrTaskNotFound
@@ -312,18 +387,6 @@ func (w *EmailImportWorker) HandleEmailImport(ctx context.Context, task *EmailIm
 		completedAt := time.Now()
 		task.CompletedAt = &completedAt
 
-		// Check if we should retry
-		if task.RetryCount < task.MaxRetries {
-			task.RetryCount++
-			task.Status = TaskStatusPending
-			// Re-queue with backoff
-			go func() {
-				backoff := w.config.RetryBackoffDuration * time.Duration(task.RetryCount)
-				time.Sleep(backoff)
-				_ = w.QueueTask(task)
-			}()
-		}
-
 		w.notifyTaskComplete(task)
 		return err
 	}
@@ -335,6 +398,7 @@ func (w *EmailImportWorker) HandleEmailImport(ctx context.Context, task *EmailIm
 	task.CompletedAt = &completedAt
 
 	w.notifyTaskComplete(task)
+	w.publishSyncCompleted(ctx, task)
 	return nil
 }
 
@@ -358,21 +422,29 @@ func (w *EmailImportWorker) processEmailImport(ctx context.Context, task *EmailI
 		return nil, fmt.Errorf("%w: status is %s", integration.ErrEmailConnectionInactive, connection.Status)
 	}
 
-	// Call Gmail sync service with progress tracking
-	syncResult, err := w.syncService.SyncLabelWithProgress(
-		ctx,
-		task.ConnectionID,
-		task.LabelID,
-		task.SyncType,
-		func(progress integration.EmailSyncProgress) {
-			// Update result with progress
-			result.MessagesScanned = progress.MessagesScanned
-			result.MessagesDownloaded = progress.MessagesProcessed
-			result.AttachmentsDownloaded = progress.AttachmentsDownloaded
-			result.BytesTransferred = progress.BytesTransferred
-		},
-	)
+	progressCb := func(progress integration.EmailSyncProgress) {
+		// Update result with progress
+		result.MessagesScanned = progress.MessagesScanned
+		result.MessagesDownloaded = progress.MessagesProcessed
+		result.AttachmentsDownloaded = progress.AttachmentsDownloaded
+		result.BytesTransferred = progress.BytesTransferred
+	}
+
+	// Call Gmail sync service with progress tracking, resuming an
+	// interrupted sync if there is one to resume
+	var syncResult *integration.EmailSyncResult
+	err = integration.ErrEmailSyncNotResumable
+	if task.ResumeSyncID != "" {
+		syncResult, err = w.syncService.ResumeSync(ctx, task.ResumeSyncID, progressCb)
+	}
+	if errors.Is(err, integration.ErrEmailSyncNotResumable) {
+		syncResult, err = w.syncService.SyncLabelWithProgress(ctx, task.ConnectionID, task.LabelID, task.SyncType, progressCb)
+	}
 	if err != nil {
+		// Let a retry continue from the failed sync's checkpoint
+		if syncResult != nil && task.SyncType != "incremental" {
+			task.ResumeSyncID = syncResult.SyncID
+		}
 		return nil, fmt.Errorf("syncing emails: %w", err)
 	}
 
@@ -383,6 +455,7 @@ func (w *EmailImportWorker) processEmailImport(ctx context.Context, task *EmailI
 	result.MessagesIndexed = syncResult.MessagesIndexed
 	result.MessagesFailed = syncResult.MessagesFailed
 	result.AttachmentsDownloaded = syncResult.AttachmentsDownloaded
+	result.AttachmentsDeduplicated = syncResult.AttachmentsDeduplicated
 	result.BytesTransferred = syncResult.BytesTransferred
 
 	// Extract receipts from the sync result
@@ -408,11 +481,13 @@ func (w *EmailImportWorker) processEmailImport(ctx context.Context, task *EmailI
 				MimeType:     att.MimeType,
 				Size:         att.Size,
 				IsReceipt:    att.IsReceipt,
+				ContentHash:  att.ContentHash,
+				Duplicate:    att.Duplicate,
 			}
 			extractedReceipt.Attachments = append(extractedReceipt.Attachments, extractedAtt)
 
-			// Queue OCR task for receipt attachments
-			if att.IsReceipt && w.config.EnableOCRQueueing {
+			// Queue OCR task for receipt attachments, once per stored content
+			if att.IsReceipt && w.config.EnableOCRQueueing && !ocrQueued(result.QueuedOCRTasks, att) {
 				ocrTask := w.createOCRTask(task.ConnectionID, att)
 				if err := w.queueOCRTask(ctx, &ocrTask); err != nil {
 					// Log but continue - OCR queue failure shouldn't fail the import
@@ -430,26 +505,12 @@ func (w *EmailImportWorker) processEmailImport(ctx context.Context, task *EmailI
 	// Also queue OCR tasks for all receipt attachments from the sync
 	for _, att := range syncResult.Attachments {
 		if att.IsReceipt && w.config.EnableOCRQueueing {
-			// Check if we already queued this attachment
-			alreadyQueued := false
-			for _, queued := range result.QueuedOCRTasks {
-				if queued.AttachmentID == att.AttachmentID {
-					alreadyQueued = true
-					break
-				}
-			}
-			if alreadyQueued {
+			// Check if we already queued this attachment or its content
+			if ocrQueued(result.QueuedOCRTasks, att) {
 				continue
 			}
 
-			ocrTask := w.createOCRTask(task.ConnectionID, integration.ExtractedEmailAttachment{
-				AttachmentID: att.AttachmentID,
-				MessageID:    att.MessageID,
-				Filename:     att.Filename,
-				MimeType:     att.MimeType,
-				Size:         att.Size,
-				IsReceipt:    att.IsReceipt,
-			})
+			ocrTask := w.createOCRTask(task.ConnectionID, att)
 			if err := w.queueOCRTask(ctx, &ocrTask); err != nil {
 				continue
 			}
@@ -468,6 +529,8 @@ func (w *EmailImportWorker) createOCRTask(connectionID string, att integration.E
 		ConnectionID: connectionID,
 		MessageID:    att.MessageID,
 		AttachmentID: att.AttachmentID,
+		BlobID:       att.BlobID,
+		ContentHash:  att.ContentHash,
 		Filename:     att.Filename,
 		MimeType:     att.MimeType,
 		Size:         att.Size,
@@ -477,6 +540,24 @@ func (w *EmailImportWorker) createOCRTask(connectionID string, att integration.E
 	}
 }
 
+// ocrQueued reports whether an attachment needs no OCR task: its content
+// was OCRed when the connection first stored it from an earlier message, or
+// a task for it or the same content was already queued in this import
+func ocrQueued(queued []OCRTask, att integration.ExtractedEmailAttachment) bool {
+	if att.Duplicate {
+		return true
+	}
+	for _, task := range queued {
+		if task.AttachmentID == att.AttachmentID {
+			return true
+		}
+		if att.BlobID != "" && task.BlobID == att.BlobID {
+			return true
+		}
+	}
+	return false
+}
+
 // queueOCRTask adds an OCR task to the queue
 func (w *EmailImportWorker) queueOCRTask(ctx context.Context, task *OCRTask) error {
 	// First try the callback if set
@@ -504,22 +585,39 @@ func (w *EmailImportWorker) GetOCRQueue() <-chan *OCRTask {
 	return w.ocrQueue
 }
 
-// workerLoop is the main loop for a worker goroutine
-func (w *EmailImportWorker) workerLoop(ctx context.Context, _ int) {
-	defer w.wg.Done()
+// publishSyncCompleted publishes a sync.completed event for a completed
+// task to the connection owner's webhooks. Failures are logged; the task
+// still completed.
+func (w *EmailImportWorker) publishSyncCompleted(ctx context.Context, task *EmailImportTask) {
+	w.mu.RLock()
+	publisher := w.publisher
+	w.mu.RUnlock()
+	if publisher == nil || task.Result == nil {
+		return
+	}
 
-	for {
-		select {
-		case <-w.stopCh:
-			return
-		case <-ctx.Done():
-			return
-		case task := <-w.taskQueue:
-			if task == nil {
-				continue
-			}
-			_ = w.HandleEmailImport(ctx, task)
-		}
+	conn, err := w.entClient.EmailConnection.Query().
+		Where(emailconnection.ID(task.ConnectionID)).
+		Select(emailconnection.FieldUserID, emailconnection.FieldOrganizationID).
+		Only(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "getting connection owner for sync event", "connection_id", task.ConnectionID, "error", err)
+		return
+	}
+	userID := organizations.OwnerID(conn.UserID, conn.OrganizationID)
+
+	data := SyncCompletedEvent{
+		Source:            "email",
+		ConnectionID:      task.ConnectionID,
+		SyncID:            task.Result.SyncID,
+		SyncType:          task.SyncType,
+		ItemsScanned:      task.Result.MessagesScanned,
+		ItemsFailed:       task.Result.MessagesFailed,
+		ReceiptsExtracted: task.Result.ReceiptsExtracted,
+		CompletedAt:       *task.CompletedAt,
+	}
+	if err := publisher.Publish(ctx, userID, webhooks.EventSyncCompleted, data); err != nil {
+		slog.ErrorContext(ctx, "publishing sync event", "connection_id", task.ConnectionID, "error", err)
 	}
 }
 
@@ -581,6 +679,29 @@ func (w *EmailImportWorker) CancelTask(taskID string) error {
 	return nil
 }
 
+// Pause stops this worker taking tasks from EmailImportQueue. Tasks already
+// running finish, and queued ones wait for Resume or are run by another
+// worker process.
+func (w *EmailImportWorker) Pause() error {
+	if !w.IsRunning() {
+		return ErrWorkerNotRunning
+	}
+	return w.jobQueue.PauseHandler(EmailImportQueue)
+}
+
+// Resume lets a paused worker take tasks again
+func (w *EmailImportWorker) Resume() error {
+	if !w.IsRunning() {
+		return ErrWorkerNotRunning
+	}
+	return w.jobQueue.ResumeHandler(EmailImportQueue)
+}
+
+// IsPaused returns whether the worker is paused
+func (w *EmailImportWorker) IsPaused() bool {
+	return w.jobQueue.HandlerPaused(EmailImportQueue)
+}
+
 // IsRunning returns whether the worker is running
 func (w *EmailImportWorker) IsRunning() bool {
 	w.mu.RLock()
@@ -588,9 +709,10 @@ func (w *EmailImportWorker) IsRunning() bool {
 	return w.running
 }
 
-// QueuedTaskCount returns the number of tasks waiting in the queue
-func (w *EmailImportWorker) QueuedTaskCount() int {
-	return len(w.taskQueue)
+// QueuedTaskCount returns the number of tasks waiting in the job queue,
+// including those scheduled for later
+func (w *EmailImportWorker) QueuedTaskCount(ctx context.Context) (int, error) {
+	return w.jobQueue.PendingCount(ctx, EmailImportQueue)
 }
 
 // QueuedOCRTaskCount returns the number of OCR tasks waiting in the queue
@@ -611,6 +733,31 @@ func CreateEmailImportTask(connectionID, labelID, syncType string) *EmailImportT
 	}
 }
 
+// QueueInterruptedSyncs queues a task to resume each sync left running when
+// the worker last stopped. Call it once at startup, before the job queue is
+// started.
+func (w *EmailImportWorker) QueueInterruptedSyncs(ctx context.Context) (int, error) {
+	syncs, err := w.syncService.InterruptedSyncs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	queued := 0
+	for _, record := range syncs {
+		labelID := ""
+		if record.LabelID != nil {
+			labelID = *record.LabelID
+		}
+		task := CreateEmailImportTask(record.ConnectionID, labelID, string(record.SyncType))
+		task.ResumeSyncID = record.ID
+		if err := w.QueueTask(ctx, task); err != nil {
+			return queued, fmt.Errorf("queueing resume of sync %s: %w", record.ID, err)
+		}
+		queued++
+	}
+	return queued, nil
+}
+
 // EmailImportTaskHandler is a function type for handling email import tasks
 type EmailImportTaskHandler func(ctx context.Context, task *EmailImportTask) error
 
@@ -0,0 +1,53 @@
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"clockzen-next/internal/application/integration"
+)
+
+func TestOCRQueued(t *testing.T) {
+	queued := []OCRTask{
+		{AttachmentID: "att-1", BlobID: "blob-1"},
+	}
+
+	tests := []struct {
+		name string
+		att  integration.ExtractedEmailAttachment
+		want bool
+	}{
+		{
+			name: "new content",
+			att:  integration.ExtractedEmailAttachment{AttachmentID: "att-2", BlobID: "blob-2"},
+			want: false,
+		},
+		{
+			name: "already linked by the connection",
+			att:  integration.ExtractedEmailAttachment{AttachmentID: "att-2", BlobID: "blob-2", Duplicate: true},
+			want: true,
+		},
+		{
+			name: "same attachment queued in this import",
+			att:  integration.ExtractedEmailAttachment{AttachmentID: "att-1"},
+			want: true,
+		},
+		{
+			name: "same content queued in this import",
+			att:  integration.ExtractedEmailAttachment{AttachmentID: "att-3", BlobID: "blob-1"},
+			want: true,
+		},
+		{
+			name: "content not downloaded",
+			att:  integration.ExtractedEmailAttachment{AttachmentID: "att-4"},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ocrQueued(queued, tt.att))
+		})
+	}
+}
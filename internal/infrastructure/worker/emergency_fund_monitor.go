@@ -0,0 +1,147 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"clockzen-next/internal/application/emergencyfund"
+)
+
+// EmergencyFundMonitorConfig holds configuration for the emergency fund
+// monitor
+type EmergencyFundMonitorConfig struct {
+	// CheckInterval is how often every user's emergency fund is checked.
+	// One snapshot is kept per user per day, so checking more than daily
+	// only keeps today's snapshot current.
+	CheckInterval time.Duration
+}
+
+// DefaultEmergencyFundMonitorConfig returns the default monitor
+// configuration
+func DefaultEmergencyFundMonitorConfig() EmergencyFundMonitorConfig {
+	return EmergencyFundMonitorConfig{
+		CheckInterval: 6 * time.Hour,
+	}
+}
+
+// EmergencyFundMonitor periodically records each user's emergency fund
+// coverage, so it can be tracked over time, and raises the service's
+// alerts when a fund drops below its target
+type EmergencyFundMonitor struct {
+	config  EmergencyFundMonitorConfig
+	service *emergencyfund.Service
+
+	mu      sync.RWMutex
+	running bool
+	paused  bool
+	stopCh  chan struct{}
+	doneCh  chan struct{}
+}
+
+// NewEmergencyFundMonitor creates a new emergency fund monitor
+func NewEmergencyFundMonitor(service *emergencyfund.Service, config EmergencyFundMonitorConfig) *EmergencyFundMonitor {
+	return &EmergencyFundMonitor{
+		config:  config,
+		service: service,
+	}
+}
+
+// NewEmergencyFundMonitorWithDefaults creates an emergency fund monitor
+// with default configuration
+func NewEmergencyFundMonitorWithDefaults(service *emergencyfund.Service) *EmergencyFundMonitor {
+	return NewEmergencyFundMonitor(service, DefaultEmergencyFundMonitorConfig())
+}
+
+// Start checks every user's emergency fund every CheckInterval until Stop
+// is called
+func (m *EmergencyFundMonitor) Start(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.running {
+		return nil
+	}
+	m.running = true
+	m.stopCh = make(chan struct{})
+	m.doneCh = make(chan struct{})
+
+	go m.run(ctx, m.stopCh, m.doneCh)
+	return nil
+}
+
+// Stop stops checking emergency funds and waits for a check in progress to
+// finish
+func (m *EmergencyFundMonitor) Stop() error {
+	m.mu.Lock()
+	if !m.running {
+		m.mu.Unlock()
+		return ErrWorkerNotRunning
+	}
+	m.running = false
+	close(m.stopCh)
+	doneCh := m.doneCh
+	m.mu.Unlock()
+
+	<-doneCh
+	return nil
+}
+
+// IsRunning returns whether the monitor is running
+func (m *EmergencyFundMonitor) IsRunning() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.running
+}
+
+// Pause stops the monitor checking funds on each tick until Resume is
+// called. A run in progress finishes.
+func (m *EmergencyFundMonitor) Pause() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.running {
+		return ErrWorkerNotRunning
+	}
+	m.paused = true
+	return nil
+}
+
+// Resume lets a paused monitor run again from the next tick
+func (m *EmergencyFundMonitor) Resume() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.running {
+		return ErrWorkerNotRunning
+	}
+	m.paused = false
+	return nil
+}
+
+// IsPaused returns whether the monitor is paused
+func (m *EmergencyFundMonitor) IsPaused() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.paused
+}
+
+// run checks every user's emergency fund on every tick
+func (m *EmergencyFundMonitor) run(ctx context.Context, stopCh, doneCh chan struct{}) {
+	defer close(doneCh)
+	ticker := time.NewTicker(m.config.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if !m.IsPaused() {
+			checked := m.service.CheckAll(ctx)
+			slog.DebugContext(ctx, "checked emergency funds", "users", checked)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
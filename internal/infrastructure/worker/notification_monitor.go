@@ -0,0 +1,150 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"clockzen-next/internal/application/notifications"
+)
+
+// NotificationMonitorConfig holds configuration for the notification
+// monitor
+type NotificationMonitorConfig struct {
+	// CheckInterval is how often connections are checked for failures and
+	// weekly summaries sent. Summaries go out on the first check of each
+	// week.
+	CheckInterval time.Duration
+}
+
+// DefaultNotificationMonitorConfig returns the default notification monitor
+// configuration
+func DefaultNotificationMonitorConfig() NotificationMonitorConfig {
+	return NotificationMonitorConfig{
+		CheckInterval: time.Hour,
+	}
+}
+
+// NotificationMonitor periodically emails users whose connections expired,
+// were revoked or keep failing to sync, and sends weekly summaries
+type NotificationMonitor struct {
+	config  NotificationMonitorConfig
+	service *notifications.Service
+
+	mu      sync.RWMutex
+	running bool
+	paused  bool
+	stopCh  chan struct{}
+	doneCh  chan struct{}
+}
+
+// NewNotificationMonitor creates a new notification monitor
+func NewNotificationMonitor(service *notifications.Service, config NotificationMonitorConfig) *NotificationMonitor {
+	return &NotificationMonitor{
+		config:  config,
+		service: service,
+	}
+}
+
+// NewNotificationMonitorWithDefaults creates a notification monitor with
+// default configuration
+func NewNotificationMonitorWithDefaults(service *notifications.Service) *NotificationMonitor {
+	return NewNotificationMonitor(service, DefaultNotificationMonitorConfig())
+}
+
+// Start checks for notifications to send every CheckInterval until Stop is
+// called
+func (m *NotificationMonitor) Start(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.running {
+		return nil
+	}
+	m.running = true
+	m.stopCh = make(chan struct{})
+	m.doneCh = make(chan struct{})
+
+	go m.run(ctx, m.stopCh, m.doneCh)
+	return nil
+}
+
+// Stop stops the monitor and waits for a run in progress to finish
+func (m *NotificationMonitor) Stop() error {
+	m.mu.Lock()
+	if !m.running {
+		m.mu.Unlock()
+		return ErrWorkerNotRunning
+	}
+	m.running = false
+	close(m.stopCh)
+	doneCh := m.doneCh
+	m.mu.Unlock()
+
+	<-doneCh
+	return nil
+}
+
+// IsRunning returns whether the monitor is running
+func (m *NotificationMonitor) IsRunning() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.running
+}
+
+// Pause stops the monitor sending notifications on each tick until Resume is
+// called. A run in progress finishes.
+func (m *NotificationMonitor) Pause() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.running {
+		return ErrWorkerNotRunning
+	}
+	m.paused = true
+	return nil
+}
+
+// Resume lets a paused monitor run again from the next tick
+func (m *NotificationMonitor) Resume() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.running {
+		return ErrWorkerNotRunning
+	}
+	m.paused = false
+	return nil
+}
+
+// IsPaused returns whether the monitor is paused
+func (m *NotificationMonitor) IsPaused() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.paused
+}
+
+// run sends notifications on every tick
+func (m *NotificationMonitor) run(ctx context.Context, stopCh, doneCh chan struct{}) {
+	defer close(doneCh)
+	ticker := time.NewTicker(m.config.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if !m.IsPaused() {
+			result := m.service.Run(ctx, time.Now())
+			slog.DebugContext(ctx, "ran notification monitor",
+				"connection_failures", result.ConnectionFailures,
+				"sync_errors", result.SyncErrors,
+				"weekly_summaries", result.WeeklySummaries,
+				"monthly_reports", result.MonthlyReports,
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
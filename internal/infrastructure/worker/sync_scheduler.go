@@ -0,0 +1,372 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"clockzen-next/internal/application/integration"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/googledriveconnection"
+)
+
+// Sync scheduler errors
+var (
+	ErrUnknownSyncSource   = errors.New("unknown sync source")
+	ErrConnectionNotFound  = errors.New("connection not found")
+	ErrConnectionNotActive = errors.New("connection is not active")
+)
+
+// Sources of connections that can be synced
+const (
+	SyncSourceEmail = "email"
+	SyncSourceDrive = "drive"
+)
+
+// syncNowPriority puts syncs triggered with SyncNow ahead of scheduled ones
+const syncNowPriority = 10
+
+// SyncSchedulerConfig holds configuration for the sync scheduler
+type SyncSchedulerConfig struct {
+	// CheckInterval is how often connections are checked for a due sync
+	CheckInterval time.Duration
+}
+
+// DefaultSyncSchedulerConfig returns the default scheduler configuration
+func DefaultSyncSchedulerConfig() SyncSchedulerConfig {
+	return SyncSchedulerConfig{
+		CheckInterval: time.Minute,
+	}
+}
+
+// SyncScheduler queues incremental syncs of email and Drive connections as
+// their sync schedule comes due. Connections on the manual schedule are
+// never synced by the scheduler.
+type SyncScheduler struct {
+	config      SyncSchedulerConfig
+	entClient   *ent.Client
+	emailWorker *EmailImportWorker
+	driveWorker *DriveSyncWorker
+
+	mu      sync.RWMutex
+	running bool
+	paused  bool
+	stopCh  chan struct{}
+	doneCh  chan struct{}
+}
+
+// NewSyncScheduler creates a new sync scheduler that queues syncs on the
+// given workers
+func NewSyncScheduler(
+	entClient *ent.Client,
+	emailWorker *EmailImportWorker,
+	driveWorker *DriveSyncWorker,
+	config SyncSchedulerConfig,
+) *SyncScheduler {
+	return &SyncScheduler{
+		config:      config,
+		entClient:   entClient,
+		emailWorker: emailWorker,
+		driveWorker: driveWorker,
+	}
+}
+
+// NewSyncSchedulerWithDefaults creates a sync scheduler with default
+// configuration
+func NewSyncSchedulerWithDefaults(entClient *ent.Client, emailWorker *EmailImportWorker, driveWorker *DriveSyncWorker) *SyncScheduler {
+	return NewSyncScheduler(entClient, emailWorker, driveWorker, DefaultSyncSchedulerConfig())
+}
+
+// Start checks for due syncs every CheckInterval until Stop is called
+func (s *SyncScheduler) Start(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		return nil
+	}
+	s.running = true
+	s.stopCh = make(chan struct{})
+	s.doneCh = make(chan struct{})
+
+	go s.run(ctx, s.stopCh, s.doneCh)
+	return nil
+}
+
+// Stop stops checking for due syncs and waits for a check in progress to
+// finish
+func (s *SyncScheduler) Stop() error {
+	s.mu.Lock()
+	if !s.running {
+		s.mu.Unlock()
+		return ErrWorkerNotRunning
+	}
+	s.running = false
+	close(s.stopCh)
+	doneCh := s.doneCh
+	s.mu.Unlock()
+
+	<-doneCh
+	return nil
+}
+
+// IsRunning returns whether the scheduler is running
+func (s *SyncScheduler) IsRunning() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.running
+}
+
+// Pause stops the scheduler queueing due syncs on each tick until Resume is
+// called. A run in progress finishes.
+func (s *SyncScheduler) Pause() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.running {
+		return ErrWorkerNotRunning
+	}
+	s.paused = true
+	return nil
+}
+
+// Resume lets a paused scheduler run again from the next tick
+func (s *SyncScheduler) Resume() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.running {
+		return ErrWorkerNotRunning
+	}
+	s.paused = false
+	return nil
+}
+
+// IsPaused returns whether the scheduler is paused
+func (s *SyncScheduler) IsPaused() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.paused
+}
+
+// run checks for due syncs on every tick
+func (s *SyncScheduler) run(ctx context.Context, stopCh, doneCh chan struct{}) {
+	defer close(doneCh)
+	ticker := time.NewTicker(s.config.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if !s.IsPaused() {
+			s.QueueDueSyncs(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// QueueDueSyncs queues a sync of each active connection whose schedule is
+// due and returns how many were queued. Failures are logged, so one
+// connection doesn't hold up the others.
+func (s *SyncScheduler) QueueDueSyncs(ctx context.Context) int {
+	now := time.Now()
+	queued := 0
+
+	emailConns, err := s.entClient.EmailConnection.Query().
+		Where(
+			emailconnection.StatusEQ(emailconnection.StatusActive),
+			emailconnection.SyncScheduleNEQ(emailconnection.SyncScheduleManual),
+			emailconnection.Or(
+				emailconnection.NextSyncAtIsNil(),
+				emailconnection.NextSyncAtLTE(now),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "querying email connections due to sync", "error", err)
+	}
+	for _, conn := range emailConns {
+		ok, err := s.queueEmailSync(ctx, conn, now)
+		if errors.Is(err, ErrQueueSaturated) {
+			slog.WarnContext(ctx, "email sync queue saturated, deferring scheduled syncs", "error", err)
+			break
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "queueing scheduled email sync", "connection_id", conn.ID, "error", err)
+			continue
+		}
+		if ok {
+			queued++
+		}
+	}
+
+	driveConns, err := s.entClient.GoogleDriveConnection.Query().
+		Where(
+			googledriveconnection.StatusEQ(googledriveconnection.StatusActive),
+			googledriveconnection.SyncScheduleNEQ(googledriveconnection.SyncScheduleManual),
+			googledriveconnection.Or(
+				googledriveconnection.NextSyncAtIsNil(),
+				googledriveconnection.NextSyncAtLTE(now),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "querying drive connections due to sync", "error", err)
+	}
+	for _, conn := range driveConns {
+		ok, err := s.queueDriveSync(ctx, conn, now)
+		if errors.Is(err, ErrQueueSaturated) {
+			slog.WarnContext(ctx, "drive sync queue saturated, deferring scheduled syncs", "error", err)
+			break
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "queueing scheduled drive sync", "connection_id", conn.ID, "error", err)
+			continue
+		}
+		if ok {
+			queued++
+		}
+	}
+
+	return queued
+}
+
+// queueEmailSync moves the connection's next sync one interval on and
+// queues an incremental sync. The next sync time only moves if it's
+// unchanged since the connection was read, so when several workers see the
+// same due connection only one queues its sync. It reports false if
+// another worker queued it.
+func (s *SyncScheduler) queueEmailSync(ctx context.Context, conn *ent.EmailConnection, now time.Time) (bool, error) {
+	interval, ok := integration.SyncScheduleInterval(string(conn.SyncSchedule))
+	if !ok {
+		return false, nil
+	}
+
+	update := s.entClient.EmailConnection.Update().
+		Where(emailconnection.ID(conn.ID)).
+		SetNextSyncAt(now.Add(interval))
+	if conn.NextSyncAt == nil {
+		update.Where(emailconnection.NextSyncAtIsNil())
+	} else {
+		update.Where(emailconnection.NextSyncAt(*conn.NextSyncAt))
+	}
+	claimed, err := update.Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("scheduling next sync: %w", err)
+	}
+	if claimed == 0 {
+		return false, nil
+	}
+
+	if err := s.emailWorker.QueueTask(ctx, CreateEmailImportTask(conn.ID, "", "incremental")); err != nil {
+		if errors.Is(err, ErrQueueSaturated) {
+			// Leave the sync due, so it's queued once the queue drains
+			restore := s.entClient.EmailConnection.UpdateOneID(conn.ID)
+			if conn.NextSyncAt == nil {
+				restore.ClearNextSyncAt()
+			} else {
+				restore.SetNextSyncAt(*conn.NextSyncAt)
+			}
+			if restoreErr := restore.Exec(ctx); restoreErr != nil {
+				return false, errors.Join(err, restoreErr)
+			}
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// queueDriveSync moves the connection's next sync one interval on and
+// queues an incremental sync, like queueEmailSync
+func (s *SyncScheduler) queueDriveSync(ctx context.Context, conn *ent.GoogleDriveConnection, now time.Time) (bool, error) {
+	interval, ok := integration.SyncScheduleInterval(string(conn.SyncSchedule))
+	if !ok {
+		return false, nil
+	}
+
+	update := s.entClient.GoogleDriveConnection.Update().
+		Where(googledriveconnection.ID(conn.ID)).
+		SetNextSyncAt(now.Add(interval))
+	if conn.NextSyncAt == nil {
+		update.Where(googledriveconnection.NextSyncAtIsNil())
+	} else {
+		update.Where(googledriveconnection.NextSyncAt(*conn.NextSyncAt))
+	}
+	claimed, err := update.Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("scheduling next sync: %w", err)
+	}
+	if claimed == 0 {
+		return false, nil
+	}
+
+	if err := s.driveWorker.QueueTask(ctx, CreateDriveSyncTask(conn.ID, "", "incremental")); err != nil {
+		if errors.Is(err, ErrQueueSaturated) {
+			// Leave the sync due, so it's queued once the queue drains
+			restore := s.entClient.GoogleDriveConnection.UpdateOneID(conn.ID)
+			if conn.NextSyncAt == nil {
+				restore.ClearNextSyncAt()
+			} else {
+				restore.SetNextSyncAt(*conn.NextSyncAt)
+			}
+			if restoreErr := restore.Exec(ctx); restoreErr != nil {
+				return false, errors.Join(err, restoreErr)
+			}
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// SyncNow queues an incremental sync of an active email or Drive
+// connection, whatever its schedule, ahead of other queued syncs, and
+// returns the ID of the queued task. The connection's next scheduled sync
+// is left as it is.
+func (s *SyncScheduler) SyncNow(ctx context.Context, source, connectionID string) (string, error) {
+	switch source {
+	case SyncSourceEmail:
+		conn, err := s.entClient.EmailConnection.Get(ctx, connectionID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return "", ErrConnectionNotFound
+			}
+			return "", fmt.Errorf("loading email connection: %w", err)
+		}
+		if conn.Status != emailconnection.StatusActive {
+			return "", ErrConnectionNotActive
+		}
+		task := CreateEmailImportTask(conn.ID, "", "incremental")
+		task.Priority = syncNowPriority
+		if err := s.emailWorker.QueueTask(ctx, task); err != nil {
+			return "", err
+		}
+		return task.ID, nil
+
+	case SyncSourceDrive:
+		conn, err := s.entClient.GoogleDriveConnection.Get(ctx, connectionID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return "", ErrConnectionNotFound
+			}
+			return "", fmt.Errorf("loading drive connection: %w", err)
+		}
+		if conn.Status != googledriveconnection.StatusActive {
+			return "", ErrConnectionNotActive
+		}
+		task := CreateDriveSyncTask(conn.ID, "", "incremental")
+		task.Priority = syncNowPriority
+		if err := s.driveWorker.QueueTask(ctx, task); err != nil {
+			return "", err
+		}
+		return task.ID, nil
+
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnknownSyncSource, source)
+	}
+}
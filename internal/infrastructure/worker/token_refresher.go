@@ -0,0 +1,150 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"clockzen-next/internal/application/integration"
+)
+
+// TokenRefresherConfig holds configuration for the token refresher
+type TokenRefresherConfig struct {
+	// CheckInterval is how often connections are checked for tokens about
+	// to expire. It should be shorter than the token manager's
+	// RefreshBefore, so every token is renewed before it expires.
+	CheckInterval time.Duration
+}
+
+// DefaultTokenRefresherConfig returns the default token refresher
+// configuration
+func DefaultTokenRefresherConfig() TokenRefresherConfig {
+	return TokenRefresherConfig{
+		CheckInterval: 5 * time.Minute,
+	}
+}
+
+// TokenRefresher periodically renews the OAuth tokens of active connections
+// before they expire
+type TokenRefresher struct {
+	config  TokenRefresherConfig
+	manager *integration.TokenManager
+
+	mu      sync.RWMutex
+	running bool
+	paused  bool
+	stopCh  chan struct{}
+	doneCh  chan struct{}
+}
+
+// NewTokenRefresher creates a new token refresher
+func NewTokenRefresher(manager *integration.TokenManager, config TokenRefresherConfig) *TokenRefresher {
+	return &TokenRefresher{
+		config:  config,
+		manager: manager,
+	}
+}
+
+// NewTokenRefresherWithDefaults creates a token refresher with default
+// configuration
+func NewTokenRefresherWithDefaults(manager *integration.TokenManager) *TokenRefresher {
+	return NewTokenRefresher(manager, DefaultTokenRefresherConfig())
+}
+
+// Start refreshes expiring tokens every CheckInterval until Stop is called
+func (r *TokenRefresher) Start(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.running {
+		return nil
+	}
+	r.running = true
+	r.stopCh = make(chan struct{})
+	r.doneCh = make(chan struct{})
+
+	go r.run(ctx, r.stopCh, r.doneCh)
+	return nil
+}
+
+// Stop stops the refresher and waits for a run in progress to finish
+func (r *TokenRefresher) Stop() error {
+	r.mu.Lock()
+	if !r.running {
+		r.mu.Unlock()
+		return ErrWorkerNotRunning
+	}
+	r.running = false
+	close(r.stopCh)
+	doneCh := r.doneCh
+	r.mu.Unlock()
+
+	<-doneCh
+	return nil
+}
+
+// IsRunning returns whether the refresher is running
+func (r *TokenRefresher) IsRunning() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.running
+}
+
+// Pause stops the refresher renewing tokens on each tick until Resume is
+// called. A run in progress finishes.
+func (r *TokenRefresher) Pause() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.running {
+		return ErrWorkerNotRunning
+	}
+	r.paused = true
+	return nil
+}
+
+// Resume lets a paused refresher run again from the next tick
+func (r *TokenRefresher) Resume() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.running {
+		return ErrWorkerNotRunning
+	}
+	r.paused = false
+	return nil
+}
+
+// IsPaused returns whether the refresher is paused
+func (r *TokenRefresher) IsPaused() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.paused
+}
+
+// run refreshes expiring tokens on every tick
+func (r *TokenRefresher) run(ctx context.Context, stopCh, doneCh chan struct{}) {
+	defer close(doneCh)
+	ticker := time.NewTicker(r.config.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if !r.IsPaused() {
+			result, err := r.manager.RefreshExpiring(ctx, time.Now())
+			if err != nil {
+				slog.ErrorContext(ctx, "refreshing expiring tokens", "error", err)
+			}
+			slog.DebugContext(ctx, "ran token refresher",
+				"refreshed", result.Refreshed,
+				"expired", result.Expired,
+				"failed", result.Failed,
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"clockzen-next/internal/application/webhooks"
+)
+
+// SyncCompletedEvent is the data of a sync.completed event. Items are the
+// messages of an email sync or the files of a Drive sync.
+type SyncCompletedEvent struct {
+	// Source is "email" or "drive"
+	Source            string    `json:"source"`
+	ConnectionID      string    `json:"connection_id"`
+	SyncID            string    `json:"sync_id"`
+	SyncType          string    `json:"sync_type"`
+	ItemsScanned      int       `json:"items_scanned"`
+	ItemsFailed       int       `json:"items_failed"`
+	ReceiptsExtracted int       `json:"receipts_extracted"`
+	CompletedAt       time.Time `json:"completed_at"`
+}
+
+// WebhookDispatcherConfig holds configuration for the webhook dispatcher
+type WebhookDispatcherConfig struct {
+	// PollInterval is how often due deliveries are posted. Retries are
+	// posted no sooner than this after they come due.
+	PollInterval time.Duration
+}
+
+// DefaultWebhookDispatcherConfig returns the default webhook dispatcher
+// configuration
+func DefaultWebhookDispatcherConfig() WebhookDispatcherConfig {
+	return WebhookDispatcherConfig{
+		PollInterval: 10 * time.Second,
+	}
+}
+
+// WebhookDispatcher periodically posts the webhook deliveries that are due:
+// events queued since the last poll, and retries of failed attempts whose
+// backoff has passed
+type WebhookDispatcher struct {
+	config  WebhookDispatcherConfig
+	service *webhooks.Service
+
+	mu      sync.RWMutex
+	running bool
+	paused  bool
+	stopCh  chan struct{}
+	doneCh  chan struct{}
+}
+
+// NewWebhookDispatcher creates a new webhook dispatcher
+func NewWebhookDispatcher(service *webhooks.Service, config WebhookDispatcherConfig) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		config:  config,
+		service: service,
+	}
+}
+
+// NewWebhookDispatcherWithDefaults creates a webhook dispatcher with
+// default configuration
+func NewWebhookDispatcherWithDefaults(service *webhooks.Service) *WebhookDispatcher {
+	return NewWebhookDispatcher(service, DefaultWebhookDispatcherConfig())
+}
+
+// Start posts due deliveries every PollInterval until Stop is called
+func (d *WebhookDispatcher) Start(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.running {
+		return nil
+	}
+	d.running = true
+	d.stopCh = make(chan struct{})
+	d.doneCh = make(chan struct{})
+
+	go d.run(ctx, d.stopCh, d.doneCh)
+	return nil
+}
+
+// Stop stops the dispatcher and waits for a run in progress to finish
+func (d *WebhookDispatcher) Stop() error {
+	d.mu.Lock()
+	if !d.running {
+		d.mu.Unlock()
+		return ErrWorkerNotRunning
+	}
+	d.running = false
+	close(d.stopCh)
+	doneCh := d.doneCh
+	d.mu.Unlock()
+
+	<-doneCh
+	return nil
+}
+
+// IsRunning returns whether the dispatcher is running
+func (d *WebhookDispatcher) IsRunning() bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.running
+}
+
+// Pause stops the dispatcher posting deliveries on each tick until Resume is
+// called. A run in progress finishes.
+func (d *WebhookDispatcher) Pause() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.running {
+		return ErrWorkerNotRunning
+	}
+	d.paused = true
+	return nil
+}
+
+// Resume lets a paused dispatcher run again from the next tick
+func (d *WebhookDispatcher) Resume() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.running {
+		return ErrWorkerNotRunning
+	}
+	d.paused = false
+	return nil
+}
+
+// IsPaused returns whether the dispatcher is paused
+func (d *WebhookDispatcher) IsPaused() bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.paused
+}
+
+// run posts due deliveries on every tick
+func (d *WebhookDispatcher) run(ctx context.Context, stopCh, doneCh chan struct{}) {
+	defer close(doneCh)
+	ticker := time.NewTicker(d.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if !d.IsPaused() {
+			delivered := d.service.DeliverDue(ctx, time.Now())
+			if delivered > 0 {
+				slog.DebugContext(ctx, "posted webhook deliveries", "delivered", delivered)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,263 @@
+// Package xlsx writes Excel workbooks of a single sheet, streaming rows as
+// they are written rather than holding the sheet in memory. It covers what
+// exports need without a dependency on a spreadsheet library: strings are
+// written inline, numbers and booleans as values, times as dates, and the
+// header row in bold.
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ContentType is the media type of xlsx workbooks
+const ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+// ErrClosed is returned for writes after Close
+var ErrClosed = errors.New("xlsx: writer is closed")
+
+// Styles of cells, indexes into cellXfs in stylesXML
+const (
+	styleDefault = 0
+	styleHeader  = 1
+	styleDate    = 2
+)
+
+// excelEpoch is day 0 of Excel's date serial numbers
+var excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// Writer writes a workbook to an underlying writer
+type Writer struct {
+	zip    *zip.Writer
+	sheet  *bufio.Writer
+	row    int
+	closed bool
+}
+
+// NewWriter starts a workbook with a sheet called name. Rows are written to
+// w as they are added; Close finishes the workbook.
+func NewWriter(w io.Writer, name string) (*Writer, error) {
+	z := zip.NewWriter(w)
+	parts := []struct{ name, content string }{
+		{"[Content_Types].xml", contentTypesXML},
+		{"_rels/.rels", rootRelsXML},
+		{"xl/workbook.xml", fmt.Sprintf(workbookXML, escape(sheetName(name)))},
+		{"xl/_rels/workbook.xml.rels", workbookRelsXML},
+		{"xl/styles.xml", stylesXML},
+	}
+	for _, part := range parts {
+		f, err := z.Create(part.name)
+		if err != nil {
+			return nil, fmt.Errorf("xlsx: creating %s: %w", part.name, err)
+		}
+		if _, err := io.WriteString(f, part.content); err != nil {
+			return nil, fmt.Errorf("xlsx: writing %s: %w", part.name, err)
+		}
+	}
+
+	// The sheet is written last, so its rows stream out as they come
+	f, err := z.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, fmt.Errorf("xlsx: creating sheet: %w", err)
+	}
+	sheet := bufio.NewWriter(f)
+	sheet.WriteString(xml.Header)
+	sheet.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	return &Writer{zip: z, sheet: sheet}, nil
+}
+
+// WriteHeader writes a row of column names, in bold
+func (w *Writer) WriteHeader(columns ...string) error {
+	cells := make([]any, len(columns))
+	for i, column := range columns {
+		cells[i] = column
+	}
+	return w.writeRow(styleHeader, cells)
+}
+
+// WriteRow writes a row of cells. Strings are written as text; ints,
+// floats and bools as values; times as dates; nil as an empty cell; and
+// anything else as text in its default format.
+func (w *Writer) WriteRow(cells ...any) error {
+	return w.writeRow(styleDefault, cells)
+}
+
+// writeRow writes a row with cells in style
+func (w *Writer) writeRow(style int, cells []any) error {
+	if w.closed {
+		return ErrClosed
+	}
+	w.row++
+	fmt.Fprintf(w.sheet, `<row r="%d">`, w.row)
+	for i, cell := range cells {
+		ref := columnName(i) + strconv.Itoa(w.row)
+		w.writeCell(ref, style, cell)
+	}
+	// The buffer keeps any error writing to the underlying writer, and
+	// returns it from every write after
+	_, err := w.sheet.WriteString(`</row>`)
+	return err
+}
+
+// writeCell writes a cell at ref
+func (w *Writer) writeCell(ref string, style int, cell any) {
+	attrs := `r="` + ref + `"`
+	if style != styleDefault {
+		attrs += ` s="` + strconv.Itoa(style) + `"`
+	}
+
+	var value string
+	switch v := cell.(type) {
+	case nil:
+		return
+	case string:
+		fmt.Fprintf(w.sheet, `<c %s t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, attrs, escape(v))
+		return
+	case bool:
+		value = "0"
+		if v {
+			value = "1"
+		}
+		fmt.Fprintf(w.sheet, `<c %s t="b"><v>%s</v></c>`, attrs, value)
+		return
+	case time.Time:
+		if style == styleDefault {
+			attrs += ` s="` + strconv.Itoa(styleDate) + `"`
+		}
+		value = formatFloat(dateSerial(v))
+	case int:
+		value = strconv.Itoa(v)
+	case int64:
+		value = strconv.FormatInt(v, 10)
+	case float64:
+		// Excel has no NaN or infinity
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return
+		}
+		value = formatFloat(v)
+	default:
+		fmt.Fprintf(w.sheet, `<c %s t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, attrs, escape(fmt.Sprint(v)))
+		return
+	}
+	fmt.Fprintf(w.sheet, `<c %s><v>%s</v></c>`, attrs, value)
+}
+
+// Flush writes the buffered rows to the underlying writer. Rows the
+// compressor holds back go out with the next block.
+func (w *Writer) Flush() error {
+	if w.closed {
+		return ErrClosed
+	}
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+	return w.zip.Flush()
+}
+
+// Close finishes the sheet and the workbook. It doesn't close the
+// underlying writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return ErrClosed
+	}
+	w.closed = true
+	w.sheet.WriteString(`</sheetData></worksheet>`)
+	if err := w.sheet.Flush(); err != nil {
+		return fmt.Errorf("xlsx: writing sheet: %w", err)
+	}
+	return w.zip.Close()
+}
+
+// columnName returns the letters of the column at index i, e.g. A, Z, AA
+func columnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
+
+// dateSerial returns t as an Excel date serial number: days since the
+// epoch, with the time of day as a fraction
+func dateSerial(t time.Time) float64 {
+	// Dates are written as they read in t's location
+	wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	seconds := float64(wall.Unix()-excelEpoch.Unix()) + float64(wall.Nanosecond())/1e9
+	return seconds / (24 * 60 * 60)
+}
+
+// formatFloat formats a number as the shortest value that reads back the
+// same
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// escape escapes text for XML
+func escape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// sheetName makes name a valid sheet name: at most 31 characters, none of
+// : \ / ? * [ ]
+func sheetName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`:\/?*[]`, r) {
+			return '_'
+		}
+		return r
+	}, name)
+	if name == "" {
+		return "Sheet1"
+	}
+	if runes := []rune(name); len(runes) > 31 {
+		name = string(runes[:31])
+	}
+	return name
+}
+
+const contentTypesXML = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+	`</Types>`
+
+const rootRelsXML = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const workbookXML = xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets>` +
+	`</workbook>`
+
+const workbookRelsXML = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+	`</Relationships>`
+
+// stylesXML defines the cell styles: default, bold for the header and a
+// yyyy-mm-dd date format
+const stylesXML = xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy-mm-dd"/></numFmts>` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="3">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`</cellXfs>` +
+	`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>` +
+	`</styleSheet>`
@@ -0,0 +1,86 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readPart returns a part of the workbook in data
+func readPart(t *testing.T, data []byte, name string) string {
+	t.Helper()
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	f, err := r.Open(name)
+	require.NoError(t, err)
+	defer f.Close()
+	content, err := io.ReadAll(f)
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, "Spending: 2026/09")
+	require.NoError(t, err)
+
+	require.NoError(t, w.WriteHeader("date", "category", "amount", "count", "recurring"))
+	require.NoError(t, w.WriteRow(time.Date(2026, 9, 1, 12, 0, 0, 0, time.UTC), "Food & <drink>", 12.5, 3, true))
+	require.NoError(t, w.WriteRow(nil, "other", math.NaN(), int64(0), false))
+	require.NoError(t, w.Close())
+
+	for _, part := range []string{"[Content_Types].xml", "_rels/.rels", "xl/_rels/workbook.xml.rels", "xl/styles.xml"} {
+		readPart(t, buf.Bytes(), part)
+	}
+	assert.Contains(t, readPart(t, buf.Bytes(), "xl/workbook.xml"), `<sheet name="Spending_ 2026_09"`)
+
+	sheet := readPart(t, buf.Bytes(), "xl/worksheets/sheet1.xml")
+	assert.Contains(t, sheet, `<row r="1"><c r="A1" s="1" t="inlineStr"><is><t xml:space="preserve">date</t></is></c>`)
+	// Dates are serial numbers in the date style; the time is a fraction
+	assert.Contains(t, sheet, `<c r="A2" s="2"><v>46266.5</v></c>`)
+	assert.Contains(t, sheet, `<t xml:space="preserve">Food &amp; &lt;drink&gt;</t>`)
+	assert.Contains(t, sheet, `<c r="C2"><v>12.5</v></c>`)
+	assert.Contains(t, sheet, `<c r="D2"><v>3</v></c>`)
+	assert.Contains(t, sheet, `<c r="E2" t="b"><v>1</v></c>`)
+	// nil and NaN leave the cell empty
+	assert.Contains(t, sheet, `<row r="3"><c r="B3" t="inlineStr"><is><t xml:space="preserve">other</t></is></c><c r="D3"><v>0</v></c>`)
+	assert.Contains(t, sheet, `</sheetData></worksheet>`)
+
+	assert.ErrorIs(t, w.WriteRow("late"), ErrClosed)
+	assert.ErrorIs(t, w.Close(), ErrClosed)
+}
+
+// failingWriter fails every write
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("connection reset") }
+
+func TestWriterReportsWriteErrors(t *testing.T) {
+	w, err := NewWriter(failingWriter{}, "Sheet")
+	require.NoError(t, err)
+
+	// Rows are buffered until there are enough to write out
+	for i := 0; i < 10000 && err == nil; i++ {
+		err = w.WriteRow("a long enough row to fill the buffers", i)
+	}
+	assert.Error(t, err)
+}
+
+func TestColumnName(t *testing.T) {
+	for i, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 51: "AZ", 52: "BA", 701: "ZZ", 702: "AAA"} {
+		assert.Equal(t, want, columnName(i))
+	}
+}
+
+func TestSheetName(t *testing.T) {
+	assert.Equal(t, "Sheet1", sheetName(""))
+	assert.Equal(t, "Backtest results", sheetName("Backtest results"))
+	assert.Len(t, sheetName("A name that is much longer than Excel allows"), 31)
+}
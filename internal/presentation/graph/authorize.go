@@ -0,0 +1,111 @@
+package graph
+
+import (
+	"context"
+	"errors"
+
+	"clockzen-next/internal/application/organizations"
+	"clockzen-next/internal/presentation/http/middleware"
+
+	"github.com/graphql-go/graphql"
+)
+
+// Errors resolving fields
+var (
+	errUnauthenticated = errors.New("authentication required")
+	errForbidden       = errors.New("not allowed to read this field")
+)
+
+// scope is who a request reads data as
+type scope struct {
+	// ownerID owns the data the request can read: the organization it acts
+	// in, or else the user
+	ownerID string
+	userID  string
+	// role is the user's role in the organization; empty outside one
+	role organizations.Role
+}
+
+// scopeFromContext returns the scope of an authenticated request
+func scopeFromContext(ctx context.Context) (*scope, error) {
+	ownerID, ok := middleware.OwnerIDFromContext(ctx)
+	if !ok {
+		return nil, errUnauthenticated
+	}
+	userID, _ := middleware.UserIDFromContext(ctx)
+	s := &scope{ownerID: ownerID, userID: userID}
+	if org, ok := middleware.OrganizationFromContext(ctx); ok {
+		s.role = org.Role
+	}
+	return s, nil
+}
+
+// owner is who a node's data belongs to
+type owner struct {
+	userID         string
+	organizationID *string
+}
+
+// rule reports whether the caller may read a field of a node in their scope
+type rule func(s *scope, o owner) bool
+
+// creatorOrManager lets the user who made a row read the field, and in an
+// organization its admins and owners
+func creatorOrManager(s *scope, o owner) bool {
+	return o.userID == s.userID || s.role.CanManage()
+}
+
+// writers lets the users who can change the data read the field: in an
+// organization its members, but not viewers
+func writers(s *scope, o owner) bool {
+	return s.role == "" || s.role.CanWrite()
+}
+
+// authorize checks the node is in the caller's scope and every rule lets
+// the caller read the field
+func authorize(ctx context.Context, o owner, rules ...rule) error {
+	s, err := scopeFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if organizations.OwnerID(o.userID, o.organizationID) != s.ownerID {
+		return errForbidden
+	}
+	for _, allowed := range rules {
+		if !allowed(s, o) {
+			return errForbidden
+		}
+	}
+	return nil
+}
+
+// node is a row the schema resolved, with who it belongs to
+type node[T any] struct {
+	row   T
+	owner owner
+}
+
+// resolver returns a field resolver of nodes of T, which resolves only the
+// fields of nodes the caller is allowed to read
+func resolver[T any](resolve func(p graphql.ResolveParams, n *node[T]) (any, error), rules ...rule) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (any, error) {
+		n, ok := p.Source.(*node[T])
+		if !ok {
+			return nil, errForbidden
+		}
+		if err := authorize(p.Context, n.owner, rules...); err != nil {
+			return nil, err
+		}
+		return resolve(p, n)
+	}
+}
+
+// field returns a field of nodes of T holding a value of their row
+func field[T any](typ graphql.Output, get func(T) any, rules ...rule) *graphql.Field {
+	return &graphql.Field{
+		Type: typ,
+		Resolve: resolver(func(_ graphql.ResolveParams, n *node[T]) (any, error) {
+			return get(n.row), nil
+		}, rules...),
+	}
+}
@@ -0,0 +1,141 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"clockzen-next/internal/presentation/http/pagination"
+
+	"github.com/graphql-go/graphql"
+)
+
+// Lists are relay connections paginated forward: first and after take the
+// place of the REST lists' limit and cursor, and orderBy, startDate, endDate
+// and the filter arguments of their sort, start_date, end_date and filter
+// parameters; see package pagination.
+
+// fetch returns the rows of a page, at most page.Limit+1 of them after its
+// cursor, and the number of rows of the list
+type fetch[T any] func(ctx context.Context, page *pagination.Page[T]) ([]T, int, error)
+
+// pageInfo is the relay PageInfo type
+var pageInfo = graphql.NewObject(graphql.ObjectConfig{
+	Name: "PageInfo",
+	Fields: graphql.Fields{
+		"hasNextPage":     &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+		"hasPreviousPage": &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+		"startCursor":     &graphql.Field{Type: graphql.String},
+		"endCursor":       &graphql.Field{Type: graphql.String},
+	},
+})
+
+// connectionType returns the relay connection type of a list of typ
+func connectionType(typ *graphql.Object) *graphql.Object {
+	edge := graphql.NewObject(graphql.ObjectConfig{
+		Name: typ.Name() + "Edge",
+		Fields: graphql.Fields{
+			"cursor": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"node":   &graphql.Field{Type: graphql.NewNonNull(typ)},
+		},
+	})
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: typ.Name() + "Connection",
+		Fields: graphql.Fields{
+			"edges":      &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(edge)))},
+			"pageInfo":   &graphql.Field{Type: graphql.NewNonNull(pageInfo)},
+			"totalCount": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		},
+	})
+}
+
+// connectionArgs returns the arguments of a connection listing spec, along
+// with extra
+func connectionArgs[T any](spec *pagination.Spec[T], extra graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	args := graphql.FieldConfigArgument{
+		"first":   &graphql.ArgumentConfig{Type: graphql.Int},
+		"after":   &graphql.ArgumentConfig{Type: graphql.String},
+		"orderBy": &graphql.ArgumentConfig{Type: graphql.String, Description: "Column to sort by, prefixed with - for descending"},
+	}
+	if spec.DateColumn != "" {
+		args["startDate"] = &graphql.ArgumentConfig{Type: graphql.String}
+		args["endDate"] = &graphql.ArgumentConfig{Type: graphql.String}
+	}
+	for column := range spec.Filters {
+		args[camelCase(column)] = &graphql.ArgumentConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.String))}
+	}
+	for name, arg := range extra {
+		args[name] = arg
+	}
+	return args
+}
+
+// resolveConnection resolves a connection listing spec, of the rows list
+// returns, owned by ownerOf
+func resolveConnection[T any](p graphql.ResolveParams, spec *pagination.Spec[T], list fetch[T], ownerOf func(T) owner) (any, error) {
+	query := url.Values{}
+	if first, ok := p.Args["first"].(int); ok {
+		if first <= 0 {
+			return nil, errors.New("first must be a positive integer")
+		}
+		query.Set("limit", strconv.Itoa(first))
+	}
+	after, _ := p.Args["after"].(string)
+	query.Set("cursor", after)
+	for arg, param := range map[string]string{"orderBy": "sort", "startDate": "start_date", "endDate": "end_date"} {
+		if value, ok := p.Args[arg].(string); ok {
+			query.Set(param, value)
+		}
+	}
+	for column := range spec.Filters {
+		values, _ := p.Args[camelCase(column)].([]any)
+		for _, value := range values {
+			query.Add(column, value.(string))
+		}
+	}
+
+	page, err := spec.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	rows, total, err := list(p.Context, page)
+	if err != nil {
+		return nil, err
+	}
+	hasNext := len(rows) > page.Limit
+	rows, _ = page.Trim(rows)
+
+	edges := make([]map[string]any, len(rows))
+	info := map[string]any{
+		"hasNextPage":     hasNext,
+		"hasPreviousPage": after != "",
+	}
+	for i, row := range rows {
+		edges[i] = map[string]any{
+			"cursor": page.Cursor(row),
+			"node":   &node[T]{row: row, owner: ownerOf(row)},
+		}
+	}
+	if len(edges) > 0 {
+		info["startCursor"] = edges[0]["cursor"]
+		info["endCursor"] = edges[len(edges)-1]["cursor"]
+	}
+	return map[string]any{
+		"edges":      edges,
+		"pageInfo":   info,
+		"totalCount": total,
+	}, nil
+}
+
+// camelCase returns the argument name of a snake_case column
+func camelCase(column string) string {
+	parts := strings.Split(column, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
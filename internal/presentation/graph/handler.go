@@ -0,0 +1,102 @@
+package graph
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/presentation/http/middleware"
+
+	"github.com/graphql-go/graphql"
+)
+
+// maxRequestSize is the largest GraphQL request body accepted
+const maxRequestSize = 1 << 20
+
+// ErrorResponse represents an error response to a request that isn't run
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// Request is a GraphQL request, sent as a JSON body or, for GET requests,
+// as query parameters
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// Handler serves GraphQL requests. The schema only has queries, so
+// viewers may POST to it.
+type Handler struct {
+	schema graphql.Schema
+}
+
+// NewHandler creates a new Handler resolving from entClient
+func NewHandler(entClient *ent.Client) (*Handler, error) {
+	schema, err := NewSchema(entClient)
+	if err != nil {
+		return nil, err
+	}
+	return &Handler{schema: schema}, nil
+}
+
+// ServeHTTP handles GET and POST /api/graphql. Requests that run get 200 OK
+// with the result's data and errors, as GraphQL clients expect.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if _, ok := middleware.OwnerIDFromContext(r.Context()); !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req Request
+	switch r.Method {
+	case http.MethodGet:
+		query := r.URL.Query()
+		req.Query = query.Get("query")
+		req.OperationName = query.Get("operationName")
+		if variables := query.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				h.writeError(w, http.StatusBadRequest, "invalid_request", "variables must be a JSON object")
+				return
+			}
+		}
+	case http.MethodPost:
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(&req); err != nil {
+			h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+			return
+		}
+	default:
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET and POST methods are allowed")
+		return
+	}
+	if req.Query == "" {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "query is required")
+		return
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:         h.schema,
+		RequestString:  req.Query,
+		VariableValues: req.Variables,
+		OperationName:  req.OperationName,
+		Context:        r.Context(),
+	})
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// writeJSON writes a JSON response
+func (h *Handler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *Handler) writeError(w http.ResponseWriter, status int, errCode string, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
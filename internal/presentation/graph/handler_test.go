@@ -0,0 +1,249 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"clockzen-next/internal/application/organizations"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/enttest"
+	"clockzen-next/internal/presentation/http/middleware"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// result is a GraphQL response
+type result struct {
+	Data   map[string]any `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+		Path    []any  `json:"path"`
+	} `json:"errors"`
+}
+
+// fixture holds two users' data, and an organization's
+type fixture struct {
+	client  *ent.Client
+	handler *Handler
+	orgID   string
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+
+	for _, id := range []string{"alice", "bob"} {
+		client.User.Create().SetID(id).SetEmail(id + "@example.com").SaveX(ctx)
+	}
+	orgID := "org-1"
+	connect := func(id, userID string, orgID *string) *ent.EmailConnection {
+		return client.EmailConnection.Create().
+			SetID(id).
+			SetUserID(userID).
+			SetNillableOrganizationID(orgID).
+			SetProviderAccountID(id).
+			SetEmail(id + "@mail.example.com").
+			SetProvider(emailconnection.ProviderGmail).
+			SetAccessToken("access").
+			SetRefreshToken("refresh").
+			SetTokenExpiry(time.Now().Add(time.Hour)).
+			SaveX(ctx)
+	}
+	connect("alice-mail", "alice", nil)
+	connect("bob-mail", "bob", nil)
+	connect("org-mail", "alice", &orgID)
+
+	for i, name := range []string{"INBOX", "Receipts", "Travel"} {
+		client.EmailLabel.Create().
+			SetID("label-" + name).
+			SetConnectionID("alice-mail").
+			SetProviderLabelID(name).
+			SetName(name).
+			SetCreatedAt(time.Now().Add(time.Duration(i) * time.Minute)).
+			SaveX(ctx)
+	}
+	client.EmailSync.Create().SetID("sync-1").SetConnectionID("alice-mail").SetMessagesScanned(12).SaveX(ctx)
+
+	for i, owner := range []string{"alice", "alice", "alice", "bob", orgID} {
+		client.Transaction.Create().
+			SetID("tx-" + string(rune('a'+i))).
+			SetUserID(owner).
+			SetAmount(float64(10 * (i + 1))).
+			SetTransactionDate(time.Date(2026, 3, i+1, 0, 0, 0, 0, time.UTC)).
+			SetNotes("note " + owner).
+			SaveX(ctx)
+	}
+
+	handler, err := NewHandler(client)
+	require.NoError(t, err)
+	return &fixture{client: client, handler: handler, orgID: orgID}
+}
+
+// query runs a query as the user, in the organization if role is set
+func (f *fixture) query(t *testing.T, userID string, role organizations.Role, query string, variables map[string]any) result {
+	t.Helper()
+	body, err := json.Marshal(Request{Query: query, Variables: variables})
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/api/graphql", strings.NewReader(string(body)))
+	ctx := middleware.WithUser(req.Context(), &middleware.User{ID: userID})
+	if role != "" {
+		ctx = middleware.WithOrganization(ctx, &middleware.Organization{ID: f.orgID, Role: role})
+	}
+	rec := httptest.NewRecorder()
+	f.handler.ServeHTTP(rec, req.WithContext(ctx))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var res result
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	return res
+}
+
+// at returns the value at a path of the result's data
+func at(data any, path ...any) any {
+	for _, key := range path {
+		switch key := key.(type) {
+		case string:
+			data = data.(map[string]any)[key]
+		case int:
+			data = data.([]any)[key]
+		}
+	}
+	return data
+}
+
+func TestConnectionsAreScoped(t *testing.T) {
+	f := newFixture(t)
+
+	res := f.query(t, "alice", "", `{
+		emailConnections { totalCount edges { node { id email labels(first: 2) { totalCount edges { node { name } } } syncs { edges { node { messagesScanned } } } } } }
+	}`, nil)
+	require.Empty(t, res.Errors)
+	assert.EqualValues(t, 1, at(res.Data, "emailConnections", "totalCount"))
+	conn := at(res.Data, "emailConnections", "edges", 0, "node")
+	assert.Equal(t, "alice-mail", at(conn, "id"))
+	assert.Equal(t, "alice-mail@mail.example.com", at(conn, "email"))
+	assert.EqualValues(t, 3, at(conn, "labels", "totalCount"))
+	assert.Len(t, at(conn, "labels", "edges"), 2)
+	assert.Equal(t, "INBOX", at(conn, "labels", "edges", 0, "node", "name"))
+	assert.EqualValues(t, 12, at(conn, "syncs", "edges", 0, "node", "messagesScanned"))
+
+	// Other users' connections aren't found
+	res = f.query(t, "alice", "", `query($id: ID!) { emailConnection(id: $id) { id } }`, map[string]any{"id": "bob-mail"})
+	require.Empty(t, res.Errors)
+	assert.Nil(t, res.Data["emailConnection"])
+
+	// In an organization only its connections are listed
+	res = f.query(t, "bob", organizations.RoleMember, `{ emailConnections { edges { node { id } } } }`, nil)
+	require.Empty(t, res.Errors)
+	assert.Equal(t, "org-mail", at(res.Data, "emailConnections", "edges", 0, "node", "id"))
+}
+
+func TestTransactionsPaginate(t *testing.T) {
+	f := newFixture(t)
+	const query = `query($after: String) {
+		transactions(first: 2, after: $after) { totalCount edges { cursor node { id } } pageInfo { hasNextPage hasPreviousPage endCursor } }
+	}`
+
+	res := f.query(t, "alice", "", query, nil)
+	require.Empty(t, res.Errors)
+	assert.EqualValues(t, 3, at(res.Data, "transactions", "totalCount"))
+	assert.Equal(t, "tx-c", at(res.Data, "transactions", "edges", 0, "node", "id"))
+	assert.Equal(t, "tx-b", at(res.Data, "transactions", "edges", 1, "node", "id"))
+	assert.Equal(t, true, at(res.Data, "transactions", "pageInfo", "hasNextPage"))
+	assert.Equal(t, false, at(res.Data, "transactions", "pageInfo", "hasPreviousPage"))
+	end := at(res.Data, "transactions", "pageInfo", "endCursor")
+	assert.Equal(t, at(res.Data, "transactions", "edges", 1, "cursor"), end)
+
+	res = f.query(t, "alice", "", query, map[string]any{"after": end})
+	require.Empty(t, res.Errors)
+	assert.Len(t, at(res.Data, "transactions", "edges"), 1)
+	assert.Equal(t, "tx-a", at(res.Data, "transactions", "edges", 0, "node", "id"))
+	assert.Equal(t, false, at(res.Data, "transactions", "pageInfo", "hasNextPage"))
+	assert.Equal(t, true, at(res.Data, "transactions", "pageInfo", "hasPreviousPage"))
+
+	res = f.query(t, "alice", "", `{ transactions(first: 0) { totalCount } }`, nil)
+	assert.NotEmpty(t, res.Errors)
+	res = f.query(t, "alice", "", `{ transactions(orderBy: "amount") { totalCount } }`, nil)
+	assert.NotEmpty(t, res.Errors)
+}
+
+func TestRestrictedFields(t *testing.T) {
+	f := newFixture(t)
+	const query = `{
+		emailConnections { edges { node { id email } } }
+		transactions { edges { node { id notes } } }
+	}`
+
+	// Viewers read neither another member's connection address nor notes
+	res := f.query(t, "bob", organizations.RoleViewer, query, nil)
+	assert.Equal(t, "org-mail", at(res.Data, "emailConnections", "edges", 0, "node", "id"))
+	assert.Nil(t, at(res.Data, "emailConnections", "edges", 0, "node", "email"))
+	assert.Nil(t, at(res.Data, "transactions", "edges", 0, "node", "notes"))
+	require.Len(t, res.Errors, 2)
+	for _, err := range res.Errors {
+		assert.Equal(t, errForbidden.Error(), err.Message)
+	}
+
+	// Members read notes but not the address of a connection they didn't make
+	res = f.query(t, "bob", organizations.RoleMember, query, nil)
+	assert.Nil(t, at(res.Data, "emailConnections", "edges", 0, "node", "email"))
+	assert.Equal(t, "note "+f.orgID, at(res.Data, "transactions", "edges", 0, "node", "notes"))
+	assert.Len(t, res.Errors, 1)
+
+	// Admins, and the member who made the connection, read its address
+	for _, caller := range []struct {
+		userID string
+		role   organizations.Role
+	}{{"bob", organizations.RoleAdmin}, {"alice", organizations.RoleMember}} {
+		res = f.query(t, caller.userID, caller.role, query, nil)
+		require.Empty(t, res.Errors)
+		assert.Equal(t, "org-mail@mail.example.com", at(res.Data, "emailConnections", "edges", 0, "node", "email"))
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	ctx := middleware.WithUser(context.Background(), &middleware.User{ID: "alice"})
+	orgID := "org-1"
+
+	assert.NoError(t, authorize(ctx, owner{userID: "alice"}))
+	assert.ErrorIs(t, authorize(ctx, owner{userID: "bob"}), errForbidden)
+	// The user's rows made in an organization are out of their own scope
+	assert.ErrorIs(t, authorize(ctx, owner{userID: "alice", organizationID: &orgID}), errForbidden)
+	assert.ErrorIs(t, authorize(context.Background(), owner{userID: "alice"}), errUnauthenticated)
+}
+
+func TestHandlerRequests(t *testing.T) {
+	f := newFixture(t)
+
+	rec := httptest.NewRecorder()
+	f.handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/graphql", strings.NewReader(`{"query":"{ transactions { totalCount } }"}`)))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	ctx := middleware.WithUser(context.Background(), &middleware.User{ID: "alice"})
+	for _, tc := range []struct {
+		method string
+		target string
+		body   string
+		status int
+	}{
+		{http.MethodGet, "/api/graphql?query=%7B+transactions+%7B+totalCount+%7D+%7D", "", http.StatusOK},
+		{http.MethodPost, "/api/graphql", `not json`, http.StatusBadRequest},
+		{http.MethodPost, "/api/graphql", `{}`, http.StatusBadRequest},
+		{http.MethodDelete, "/api/graphql", "", http.StatusMethodNotAllowed},
+	} {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body)).WithContext(ctx)
+		f.handler.ServeHTTP(rec, req)
+		assert.Equal(t, tc.status, rec.Code, tc.method+" "+tc.target)
+	}
+}
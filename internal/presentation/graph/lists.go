@@ -0,0 +1,123 @@
+package graph
+
+import (
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/budgetreallocation"
+	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/emaillabel"
+	"clockzen-next/internal/ent/emailsync"
+	"clockzen-next/internal/ent/googledriveconnection"
+	"clockzen-next/internal/ent/googledrivesync"
+	"clockzen-next/internal/ent/transaction"
+	"clockzen-next/internal/presentation/http/pagination"
+)
+
+// What the connections of the schema can be sorted and filtered by. The
+// integration lists match the REST API's.
+
+// emailConnectionList lists email connections, the newest first
+var emailConnectionList = &pagination.Spec[*ent.EmailConnection]{
+	Sorts: map[string]func(*ent.EmailConnection) any{
+		emailconnection.FieldCreatedAt: func(c *ent.EmailConnection) any { return c.CreatedAt },
+		emailconnection.FieldUpdatedAt: func(c *ent.EmailConnection) any { return c.UpdatedAt },
+		emailconnection.FieldEmail:     func(c *ent.EmailConnection) any { return c.Email },
+	},
+	DefaultSort: "-" + emailconnection.FieldCreatedAt,
+	Filters: map[string]func(string) error{
+		emailconnection.FieldStatus:   pagination.Enum(emailconnection.StatusValidator),
+		emailconnection.FieldProvider: pagination.Enum(emailconnection.ProviderValidator),
+	},
+	DateColumn: emailconnection.FieldCreatedAt,
+	ID:         func(c *ent.EmailConnection) string { return c.ID },
+}
+
+// driveConnectionList lists Drive connections, the newest first
+var driveConnectionList = &pagination.Spec[*ent.GoogleDriveConnection]{
+	Sorts: map[string]func(*ent.GoogleDriveConnection) any{
+		googledriveconnection.FieldCreatedAt: func(c *ent.GoogleDriveConnection) any { return c.CreatedAt },
+		googledriveconnection.FieldUpdatedAt: func(c *ent.GoogleDriveConnection) any { return c.UpdatedAt },
+		googledriveconnection.FieldEmail:     func(c *ent.GoogleDriveConnection) any { return c.Email },
+	},
+	DefaultSort: "-" + googledriveconnection.FieldCreatedAt,
+	Filters: map[string]func(string) error{
+		googledriveconnection.FieldStatus: pagination.Enum(googledriveconnection.StatusValidator),
+	},
+	DateColumn: googledriveconnection.FieldCreatedAt,
+	ID:         func(c *ent.GoogleDriveConnection) string { return c.ID },
+}
+
+// emailLabelList lists a connection's labels by name
+var emailLabelList = &pagination.Spec[*ent.EmailLabel]{
+	Sorts: map[string]func(*ent.EmailLabel) any{
+		emaillabel.FieldName:      func(l *ent.EmailLabel) any { return l.Name },
+		emaillabel.FieldCreatedAt: func(l *ent.EmailLabel) any { return l.CreatedAt },
+	},
+	DefaultSort: emaillabel.FieldName,
+	Filters: map[string]func(string) error{
+		emaillabel.FieldLabelType: pagination.Enum(emaillabel.LabelTypeValidator),
+	},
+	DateColumn: emaillabel.FieldCreatedAt,
+	ID:         func(l *ent.EmailLabel) string { return l.ID },
+}
+
+// emailSyncList lists a connection's sync history, the latest first
+var emailSyncList = &pagination.Spec[*ent.EmailSync]{
+	Sorts: map[string]func(*ent.EmailSync) any{
+		emailsync.FieldCreatedAt: func(s *ent.EmailSync) any { return s.CreatedAt },
+	},
+	DefaultSort: "-" + emailsync.FieldCreatedAt,
+	Filters: map[string]func(string) error{
+		emailsync.FieldStatus:   pagination.Enum(emailsync.StatusValidator),
+		emailsync.FieldSyncType: pagination.Enum(emailsync.SyncTypeValidator),
+		emailsync.FieldLabelID:  nil,
+	},
+	DateColumn: emailsync.FieldCreatedAt,
+	ID:         func(s *ent.EmailSync) string { return s.ID },
+}
+
+// driveSyncList lists a connection's sync history, the latest first
+var driveSyncList = &pagination.Spec[*ent.GoogleDriveSync]{
+	Sorts: map[string]func(*ent.GoogleDriveSync) any{
+		googledrivesync.FieldCreatedAt: func(s *ent.GoogleDriveSync) any { return s.CreatedAt },
+	},
+	DefaultSort: "-" + googledrivesync.FieldCreatedAt,
+	Filters: map[string]func(string) error{
+		googledrivesync.FieldStatus:   pagination.Enum(googledrivesync.StatusValidator),
+		googledrivesync.FieldSyncType: pagination.Enum(googledrivesync.SyncTypeValidator),
+		googledrivesync.FieldFolderID: nil,
+	},
+	DateColumn: googledrivesync.FieldCreatedAt,
+	ID:         func(s *ent.GoogleDriveSync) string { return s.ID },
+}
+
+// transactionList lists transactions, the latest first
+var transactionList = &pagination.Spec[*ent.Transaction]{
+	Sorts: map[string]func(*ent.Transaction) any{
+		transaction.FieldTransactionDate: func(t *ent.Transaction) any { return t.TransactionDate },
+		transaction.FieldCreatedAt:       func(t *ent.Transaction) any { return t.CreatedAt },
+		transaction.FieldCurrency:        func(t *ent.Transaction) any { return t.Currency },
+	},
+	DefaultSort: "-" + transaction.FieldTransactionDate,
+	Filters: map[string]func(string) error{
+		transaction.FieldType:   pagination.Enum(transaction.TypeValidator),
+		transaction.FieldStatus: pagination.Enum(transaction.StatusValidator),
+		transaction.FieldSource: pagination.Enum(transaction.SourceValidator),
+	},
+	DateColumn: transaction.FieldTransactionDate,
+	ID:         func(t *ent.Transaction) string { return t.ID },
+}
+
+// budgetReallocationList lists a budget's reallocations, the latest first
+var budgetReallocationList = &pagination.Spec[*ent.BudgetReallocation]{
+	Sorts: map[string]func(*ent.BudgetReallocation) any{
+		budgetreallocation.FieldEffectiveDate: func(r *ent.BudgetReallocation) any { return r.EffectiveDate },
+		budgetreallocation.FieldCreatedAt:     func(r *ent.BudgetReallocation) any { return r.CreatedAt },
+	},
+	DefaultSort: "-" + budgetreallocation.FieldEffectiveDate,
+	Filters: map[string]func(string) error{
+		budgetreallocation.FieldFromCategory: nil,
+		budgetreallocation.FieldToCategory:   nil,
+	},
+	DateColumn: budgetreallocation.FieldEffectiveDate,
+	ID:         func(r *ent.BudgetReallocation) string { return r.ID },
+}
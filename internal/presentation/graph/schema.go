@@ -0,0 +1,407 @@
+// Package graph serves a read-only GraphQL API over the data the REST API
+// lists: integration connections with their labels and syncs, transactions
+// and budget reallocations. Lists are relay connections paginated like the
+// REST lists, and each field checks the caller may read it: nodes outside
+// the caller's user or organization scope resolve to null with an error,
+// and so do restricted fields, such as connections' email addresses, which
+// in an organization only the member who connected the account and admins
+// may read.
+package graph
+
+import (
+	"context"
+
+	"clockzen-next/internal/application/organizations"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/budgetreallocation"
+	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/emaillabel"
+	"clockzen-next/internal/ent/emailsync"
+	"clockzen-next/internal/ent/googledriveconnection"
+	"clockzen-next/internal/ent/googledrivesync"
+	"clockzen-next/internal/ent/predicate"
+	"clockzen-next/internal/ent/transaction"
+	"clockzen-next/internal/presentation/http/pagination"
+
+	"github.com/graphql-go/graphql"
+)
+
+// NewSchema returns the schema of the GraphQL API, resolved from client
+func NewSchema(client *ent.Client) (graphql.Schema, error) {
+	emailConnection := emailConnectionType(client)
+	driveConnection := driveConnectionType(client)
+	transactionNode := transactionType()
+	reallocation := budgetReallocationType()
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"emailConnections": &graphql.Field{
+				Type: graphql.NewNonNull(connectionType(emailConnection)),
+				Args: connectionArgs(emailConnectionList, nil),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					s, err := scopeFromContext(p.Context)
+					if err != nil {
+						return nil, err
+					}
+					return resolveConnection(p, emailConnectionList, func(ctx context.Context, page *pagination.Page[*ent.EmailConnection]) ([]*ent.EmailConnection, int, error) {
+						query := client.EmailConnection.Query().
+							Where(
+								organizations.OwnedBy[predicate.EmailConnection](s.ownerID),
+								pagination.Filter[predicate.EmailConnection](page),
+							)
+						return fetchPage(ctx, query.Clone().Count, query.
+							Where(pagination.After[predicate.EmailConnection](page)).
+							Order(pagination.Order[emailconnection.OrderOption](page)).
+							Limit(page.Limit+1).
+							All)
+					}, emailConnectionOwner)
+				},
+			},
+			"emailConnection": &graphql.Field{
+				Type: emailConnection,
+				Args: graphql.FieldConfigArgument{"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)}},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					s, err := scopeFromContext(p.Context)
+					if err != nil {
+						return nil, err
+					}
+					conn, err := client.EmailConnection.Query().
+						Where(
+							emailconnection.ID(p.Args["id"].(string)),
+							organizations.OwnedBy[predicate.EmailConnection](s.ownerID),
+						).
+						Only(p.Context)
+					if err != nil {
+						return nil, ignoreNotFound(err)
+					}
+					return &node[*ent.EmailConnection]{row: conn, owner: emailConnectionOwner(conn)}, nil
+				},
+			},
+			"driveConnections": &graphql.Field{
+				Type: graphql.NewNonNull(connectionType(driveConnection)),
+				Args: connectionArgs(driveConnectionList, nil),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					s, err := scopeFromContext(p.Context)
+					if err != nil {
+						return nil, err
+					}
+					return resolveConnection(p, driveConnectionList, func(ctx context.Context, page *pagination.Page[*ent.GoogleDriveConnection]) ([]*ent.GoogleDriveConnection, int, error) {
+						query := client.GoogleDriveConnection.Query().
+							Where(
+								organizations.OwnedBy[predicate.GoogleDriveConnection](s.ownerID),
+								pagination.Filter[predicate.GoogleDriveConnection](page),
+							)
+						return fetchPage(ctx, query.Clone().Count, query.
+							Where(pagination.After[predicate.GoogleDriveConnection](page)).
+							Order(pagination.Order[googledriveconnection.OrderOption](page)).
+							Limit(page.Limit+1).
+							All)
+					}, driveConnectionOwner)
+				},
+			},
+			"driveConnection": &graphql.Field{
+				Type: driveConnection,
+				Args: graphql.FieldConfigArgument{"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)}},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					s, err := scopeFromContext(p.Context)
+					if err != nil {
+						return nil, err
+					}
+					conn, err := client.GoogleDriveConnection.Query().
+						Where(
+							googledriveconnection.ID(p.Args["id"].(string)),
+							organizations.OwnedBy[predicate.GoogleDriveConnection](s.ownerID),
+						).
+						Only(p.Context)
+					if err != nil {
+						return nil, ignoreNotFound(err)
+					}
+					return &node[*ent.GoogleDriveConnection]{row: conn, owner: driveConnectionOwner(conn)}, nil
+				},
+			},
+			"transactions": &graphql.Field{
+				Type: graphql.NewNonNull(connectionType(transactionNode)),
+				Args: connectionArgs(transactionList, nil),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					s, err := scopeFromContext(p.Context)
+					if err != nil {
+						return nil, err
+					}
+					return resolveConnection(p, transactionList, func(ctx context.Context, page *pagination.Page[*ent.Transaction]) ([]*ent.Transaction, int, error) {
+						query := client.Transaction.Query().
+							Where(
+								transaction.UserID(s.ownerID),
+								pagination.Filter[predicate.Transaction](page),
+							)
+						return fetchPage(ctx, query.Clone().Count, query.
+							Where(pagination.After[predicate.Transaction](page)).
+							Order(pagination.Order[transaction.OrderOption](page)).
+							Limit(page.Limit+1).
+							All)
+					}, transactionOwner)
+				},
+			},
+			"budgetReallocations": &graphql.Field{
+				Type: graphql.NewNonNull(connectionType(reallocation)),
+				Args: connectionArgs(budgetReallocationList, graphql.FieldConfigArgument{
+					"budgetId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				}),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					s, err := scopeFromContext(p.Context)
+					if err != nil {
+						return nil, err
+					}
+					return resolveConnection(p, budgetReallocationList, func(ctx context.Context, page *pagination.Page[*ent.BudgetReallocation]) ([]*ent.BudgetReallocation, int, error) {
+						query := client.BudgetReallocation.Query().
+							Where(
+								budgetreallocation.BudgetID(p.Args["budgetId"].(string)),
+								organizations.OwnedBy[predicate.BudgetReallocation](s.ownerID),
+								pagination.Filter[predicate.BudgetReallocation](page),
+							)
+						return fetchPage(ctx, query.Clone().Count, query.
+							Where(pagination.After[predicate.BudgetReallocation](page)).
+							Order(pagination.Order[budgetreallocation.OrderOption](page)).
+							Limit(page.Limit+1).
+							All)
+					}, budgetReallocationOwner)
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+// emailConnectionType returns the EmailConnection type
+func emailConnectionType(client *ent.Client) *graphql.Object {
+	type row = *ent.EmailConnection
+
+	label := graphql.NewObject(graphql.ObjectConfig{
+		Name: "EmailLabel",
+		Fields: graphql.Fields{
+			"id":            field(graphql.NewNonNull(graphql.ID), func(l *ent.EmailLabel) any { return l.ID }),
+			"name":          field(graphql.NewNonNull(graphql.String), func(l *ent.EmailLabel) any { return l.Name }),
+			"displayName":   field(graphql.NewNonNull(graphql.String), func(l *ent.EmailLabel) any { return l.DisplayName }),
+			"labelType":     field(graphql.NewNonNull(graphql.String), func(l *ent.EmailLabel) any { return l.LabelType }),
+			"syncEnabled":   field(graphql.NewNonNull(graphql.Boolean), func(l *ent.EmailLabel) any { return l.SyncEnabled }),
+			"messageCount":  field(graphql.NewNonNull(graphql.Int), func(l *ent.EmailLabel) any { return int(l.MessageCount) }),
+			"unreadCount":   field(graphql.NewNonNull(graphql.Int), func(l *ent.EmailLabel) any { return int(l.UnreadCount) }),
+			"color":         field(graphql.String, func(l *ent.EmailLabel) any { return l.Color }),
+			"lastScannedAt": field(graphql.DateTime, func(l *ent.EmailLabel) any { return l.LastScannedAt }),
+			"createdAt":     field(graphql.NewNonNull(graphql.DateTime), func(l *ent.EmailLabel) any { return l.CreatedAt }),
+		},
+	})
+	sync := graphql.NewObject(graphql.ObjectConfig{
+		Name: "EmailSync",
+		Fields: graphql.Fields{
+			"id":                    field(graphql.NewNonNull(graphql.ID), func(s *ent.EmailSync) any { return s.ID }),
+			"labelId":               field(graphql.ID, func(s *ent.EmailSync) any { return s.LabelID }),
+			"syncType":              field(graphql.NewNonNull(graphql.String), func(s *ent.EmailSync) any { return s.SyncType }),
+			"status":                field(graphql.NewNonNull(graphql.String), func(s *ent.EmailSync) any { return s.Status }),
+			"startedAt":             field(graphql.DateTime, func(s *ent.EmailSync) any { return s.StartedAt }),
+			"completedAt":           field(graphql.DateTime, func(s *ent.EmailSync) any { return s.CompletedAt }),
+			"messagesScanned":       field(graphql.NewNonNull(graphql.Int), func(s *ent.EmailSync) any { return s.MessagesScanned }),
+			"messagesDownloaded":    field(graphql.NewNonNull(graphql.Int), func(s *ent.EmailSync) any { return s.MessagesDownloaded }),
+			"messagesIndexed":       field(graphql.NewNonNull(graphql.Int), func(s *ent.EmailSync) any { return s.MessagesIndexed }),
+			"messagesFailed":        field(graphql.NewNonNull(graphql.Int), func(s *ent.EmailSync) any { return s.MessagesFailed }),
+			"attachmentsDownloaded": field(graphql.NewNonNull(graphql.Int), func(s *ent.EmailSync) any { return s.AttachmentsDownloaded }),
+			"errorMessage":          field(graphql.String, func(s *ent.EmailSync) any { return s.ErrorMessage }),
+			"createdAt":             field(graphql.NewNonNull(graphql.DateTime), func(s *ent.EmailSync) any { return s.CreatedAt }),
+		},
+	})
+
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "EmailConnection",
+		Fields: graphql.Fields{
+			"id":             field(graphql.NewNonNull(graphql.ID), func(c row) any { return c.ID }),
+			"organizationId": field(graphql.ID, func(c row) any { return c.OrganizationID }),
+			"email":          field(graphql.String, func(c row) any { return c.Email }, creatorOrManager),
+			"provider":       field(graphql.NewNonNull(graphql.String), func(c row) any { return c.Provider }),
+			"status":         field(graphql.NewNonNull(graphql.String), func(c row) any { return c.Status }),
+			"syncSchedule":   field(graphql.NewNonNull(graphql.String), func(c row) any { return c.SyncSchedule }),
+			"lastSyncAt":     field(graphql.DateTime, func(c row) any { return c.LastSyncAt }),
+			"nextSyncAt":     field(graphql.DateTime, func(c row) any { return c.NextSyncAt }),
+			"createdAt":      field(graphql.NewNonNull(graphql.DateTime), func(c row) any { return c.CreatedAt }),
+			"updatedAt":      field(graphql.NewNonNull(graphql.DateTime), func(c row) any { return c.UpdatedAt }),
+			"labels": &graphql.Field{
+				Type: graphql.NewNonNull(connectionType(label)),
+				Args: connectionArgs(emailLabelList, nil),
+				Resolve: resolver(func(p graphql.ResolveParams, conn *node[row]) (any, error) {
+					return resolveConnection(p, emailLabelList, func(ctx context.Context, page *pagination.Page[*ent.EmailLabel]) ([]*ent.EmailLabel, int, error) {
+						query := client.EmailLabel.Query().
+							Where(
+								emaillabel.ConnectionID(conn.row.ID),
+								pagination.Filter[predicate.EmailLabel](page),
+							)
+						return fetchPage(ctx, query.Clone().Count, query.
+							Where(pagination.After[predicate.EmailLabel](page)).
+							Order(pagination.Order[emaillabel.OrderOption](page)).
+							Limit(page.Limit+1).
+							All)
+					}, func(*ent.EmailLabel) owner { return conn.owner })
+				}),
+			},
+			"syncs": &graphql.Field{
+				Type: graphql.NewNonNull(connectionType(sync)),
+				Args: connectionArgs(emailSyncList, nil),
+				Resolve: resolver(func(p graphql.ResolveParams, conn *node[row]) (any, error) {
+					return resolveConnection(p, emailSyncList, func(ctx context.Context, page *pagination.Page[*ent.EmailSync]) ([]*ent.EmailSync, int, error) {
+						query := client.EmailSync.Query().
+							Where(
+								emailsync.ConnectionID(conn.row.ID),
+								pagination.Filter[predicate.EmailSync](page),
+							)
+						return fetchPage(ctx, query.Clone().Count, query.
+							Where(pagination.After[predicate.EmailSync](page)).
+							Order(pagination.Order[emailsync.OrderOption](page)).
+							Limit(page.Limit+1).
+							All)
+					}, func(*ent.EmailSync) owner { return conn.owner })
+				}),
+			},
+		},
+	})
+}
+
+// driveConnectionType returns the DriveConnection type
+func driveConnectionType(client *ent.Client) *graphql.Object {
+	type row = *ent.GoogleDriveConnection
+
+	sync := graphql.NewObject(graphql.ObjectConfig{
+		Name: "DriveSync",
+		Fields: graphql.Fields{
+			"id":              field(graphql.NewNonNull(graphql.ID), func(s *ent.GoogleDriveSync) any { return s.ID }),
+			"folderId":        field(graphql.ID, func(s *ent.GoogleDriveSync) any { return s.FolderID }),
+			"syncType":        field(graphql.NewNonNull(graphql.String), func(s *ent.GoogleDriveSync) any { return s.SyncType }),
+			"status":          field(graphql.NewNonNull(graphql.String), func(s *ent.GoogleDriveSync) any { return s.Status }),
+			"startedAt":       field(graphql.DateTime, func(s *ent.GoogleDriveSync) any { return s.StartedAt }),
+			"completedAt":     field(graphql.DateTime, func(s *ent.GoogleDriveSync) any { return s.CompletedAt }),
+			"filesScanned":    field(graphql.NewNonNull(graphql.Int), func(s *ent.GoogleDriveSync) any { return s.FilesScanned }),
+			"filesDownloaded": field(graphql.NewNonNull(graphql.Int), func(s *ent.GoogleDriveSync) any { return s.FilesDownloaded }),
+			"filesUploaded":   field(graphql.NewNonNull(graphql.Int), func(s *ent.GoogleDriveSync) any { return s.FilesUploaded }),
+			"filesDeleted":    field(graphql.NewNonNull(graphql.Int), func(s *ent.GoogleDriveSync) any { return s.FilesDeleted }),
+			"filesFailed":     field(graphql.NewNonNull(graphql.Int), func(s *ent.GoogleDriveSync) any { return s.FilesFailed }),
+			"errorMessage":    field(graphql.String, func(s *ent.GoogleDriveSync) any { return s.ErrorMessage }),
+			"createdAt":       field(graphql.NewNonNull(graphql.DateTime), func(s *ent.GoogleDriveSync) any { return s.CreatedAt }),
+		},
+	})
+
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "DriveConnection",
+		Fields: graphql.Fields{
+			"id":             field(graphql.NewNonNull(graphql.ID), func(c row) any { return c.ID }),
+			"organizationId": field(graphql.ID, func(c row) any { return c.OrganizationID }),
+			"email":          field(graphql.String, func(c row) any { return c.Email }, creatorOrManager),
+			"status":         field(graphql.NewNonNull(graphql.String), func(c row) any { return c.Status }),
+			"syncSchedule":   field(graphql.NewNonNull(graphql.String), func(c row) any { return c.SyncSchedule }),
+			"lastSyncAt":     field(graphql.DateTime, func(c row) any { return c.LastSyncAt }),
+			"nextSyncAt":     field(graphql.DateTime, func(c row) any { return c.NextSyncAt }),
+			"createdAt":      field(graphql.NewNonNull(graphql.DateTime), func(c row) any { return c.CreatedAt }),
+			"updatedAt":      field(graphql.NewNonNull(graphql.DateTime), func(c row) any { return c.UpdatedAt }),
+			"syncs": &graphql.Field{
+				Type: graphql.NewNonNull(connectionType(sync)),
+				Args: connectionArgs(driveSyncList, nil),
+				Resolve: resolver(func(p graphql.ResolveParams, conn *node[row]) (any, error) {
+					return resolveConnection(p, driveSyncList, func(ctx context.Context, page *pagination.Page[*ent.GoogleDriveSync]) ([]*ent.GoogleDriveSync, int, error) {
+						query := client.GoogleDriveSync.Query().
+							Where(
+								googledrivesync.ConnectionID(conn.row.ID),
+								pagination.Filter[predicate.GoogleDriveSync](page),
+							)
+						return fetchPage(ctx, query.Clone().Count, query.
+							Where(pagination.After[predicate.GoogleDriveSync](page)).
+							Order(pagination.Order[googledrivesync.OrderOption](page)).
+							Limit(page.Limit+1).
+							All)
+					}, func(*ent.GoogleDriveSync) owner { return conn.owner })
+				}),
+			},
+		},
+	})
+}
+
+// transactionType returns the Transaction type. In an organization its
+// notes can only be read by the members who can change transactions.
+func transactionType() *graphql.Object {
+	type row = *ent.Transaction
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "Transaction",
+		Fields: graphql.Fields{
+			"id":               field(graphql.NewNonNull(graphql.ID), func(t row) any { return t.ID }),
+			"type":             field(graphql.NewNonNull(graphql.String), func(t row) any { return t.Type }),
+			"source":           field(graphql.NewNonNull(graphql.String), func(t row) any { return t.Source }),
+			"status":           field(graphql.NewNonNull(graphql.String), func(t row) any { return t.Status }),
+			"amount":           field(graphql.NewNonNull(graphql.Float), func(t row) any { return t.Amount }),
+			"currency":         field(graphql.NewNonNull(graphql.String), func(t row) any { return t.Currency }),
+			"transactionDate":  field(graphql.NewNonNull(graphql.DateTime), func(t row) any { return t.TransactionDate }),
+			"description":      field(graphql.String, func(t row) any { return t.Description }),
+			"merchantName":     field(graphql.String, func(t row) any { return t.MerchantName }),
+			"merchantCategory": field(graphql.String, func(t row) any { return t.MerchantCategory }),
+			"categoryTags":     field(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String))), func(t row) any { return t.CategoryTags }),
+			"notes":            field(graphql.String, func(t row) any { return t.Notes }, writers),
+			"createdAt":        field(graphql.NewNonNull(graphql.DateTime), func(t row) any { return t.CreatedAt }),
+		},
+	})
+}
+
+// budgetReallocationType returns the BudgetReallocation type
+func budgetReallocationType() *graphql.Object {
+	type row = *ent.BudgetReallocation
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "BudgetReallocation",
+		Fields: graphql.Fields{
+			"id":             field(graphql.NewNonNull(graphql.ID), func(r row) any { return r.ID }),
+			"budgetId":       field(graphql.NewNonNull(graphql.ID), func(r row) any { return r.BudgetID }),
+			"userId":         field(graphql.NewNonNull(graphql.ID), func(r row) any { return r.UserID }),
+			"fromCategory":   field(graphql.NewNonNull(graphql.String), func(r row) any { return r.FromCategory }),
+			"toCategory":     field(graphql.NewNonNull(graphql.String), func(r row) any { return r.ToCategory }),
+			"amount":         field(graphql.NewNonNull(graphql.Float), func(r row) any { return r.Amount }),
+			"effectiveDate":  field(graphql.NewNonNull(graphql.DateTime), func(r row) any { return r.EffectiveDate }),
+			"note":           field(graphql.String, func(r row) any { return r.Note }),
+			"createdAt":      field(graphql.NewNonNull(graphql.DateTime), func(r row) any { return r.CreatedAt }),
+			"organizationId": field(graphql.ID, func(r row) any { return r.OrganizationID }),
+		},
+	})
+}
+
+// Who rows belong to. Transactions have no organization column; their
+// user_id holds the owner ID they were made under.
+
+func emailConnectionOwner(c *ent.EmailConnection) owner {
+	return owner{userID: c.UserID, organizationID: c.OrganizationID}
+}
+
+func driveConnectionOwner(c *ent.GoogleDriveConnection) owner {
+	return owner{userID: c.UserID, organizationID: c.OrganizationID}
+}
+
+func transactionOwner(t *ent.Transaction) owner {
+	return owner{userID: t.UserID}
+}
+
+func budgetReallocationOwner(r *ent.BudgetReallocation) owner {
+	return owner{userID: r.UserID, organizationID: r.OrganizationID}
+}
+
+// fetchPage runs the count and page queries of a connection
+func fetchPage[T any](ctx context.Context, count func(context.Context) (int, error), all func(context.Context) ([]T, error)) ([]T, int, error) {
+	total, err := count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, err := all(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return rows, total, nil
+}
+
+// ignoreNotFound returns err unless it's a not found error, which lookups
+// resolve as null
+func ignoreNotFound(err error) error {
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,221 @@
+// Package export lets endpoints send their results as CSV or Excel tables
+// as well as JSON. The format is asked for with ?format=json|csv|xlsx, or
+// else the Accept header, and tables are streamed row by row, so large
+// results go out as they are written rather than built up in memory.
+package export
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/infrastructure/xlsx"
+)
+
+// Format is a response format
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+// CSVContentType is the media type of CSV tables
+const CSVContentType = "text/csv"
+
+// flushRows is how many rows are written between flushes to the client
+const flushRows = 500
+
+// ErrUnsupportedFormat is returned for a ?format that can't be exported
+var ErrUnsupportedFormat = errors.New("format must be json, csv or xlsx")
+
+// Negotiate returns the format the request asks for: its ?format, or else
+// the first of CSV, Excel or JSON its Accept header lists. It is JSON if
+// neither asks for a table.
+func Negotiate(r *http.Request) (Format, error) {
+	switch format := Format(r.URL.Query().Get("format")); format {
+	case "":
+	case FormatJSON, FormatCSV, FormatXLSX:
+		return format, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+
+	for _, accepted := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case CSVContentType:
+			return FormatCSV, nil
+		case xlsx.ContentType:
+			return FormatXLSX, nil
+		case "application/json", "*/*":
+			return FormatJSON, nil
+		}
+	}
+	return FormatJSON, nil
+}
+
+// Table is a table being written
+type Table interface {
+	// WriteHeader writes the column names
+	WriteHeader(columns ...string) error
+	// WriteRow writes a row. Cells may be strings, ints, floats, bools,
+	// times, or nil for an empty cell.
+	WriteRow(cells ...any) error
+}
+
+// Stream responds with the table write writes, as a CSV or Excel
+// attachment named filename (without extension). An Excel table's sheet
+// is called sheet. Once the response has started its status can't change,
+// so an error from write or the connection ends the response early and is
+// returned for logging.
+func Stream(w http.ResponseWriter, format Format, filename, sheet string, write func(Table) error) error {
+	controller := http.NewResponseController(w)
+
+	var table interface {
+		Table
+		flush() error
+		close() error
+	}
+	switch format {
+	case FormatCSV:
+		w.Header().Set("Content-Type", CSVContentType+"; charset=utf-8")
+	case FormatXLSX:
+		w.Header().Set("Content-Type", xlsx.ContentType)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename+"."+string(format)))
+	w.WriteHeader(http.StatusOK)
+
+	if format == FormatCSV {
+		table = &csvTable{writer: csv.NewWriter(w)}
+	} else {
+		writer, err := xlsx.NewWriter(w, sheet)
+		if err != nil {
+			return err
+		}
+		table = &xlsxTable{writer: writer}
+	}
+
+	flushing := &flushingTable{table: table, flush: func() error {
+		if err := table.flush(); err != nil {
+			return err
+		}
+		// Not every ResponseWriter can flush; those buffer the response
+		if err := controller.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return err
+		}
+		return nil
+	}}
+	if err := write(flushing); err != nil {
+		return err
+	}
+	return table.close()
+}
+
+// flushingTable flushes a table to the client every flushRows rows
+type flushingTable struct {
+	table Table
+	flush func() error
+	rows  int
+}
+
+// WriteHeader writes the column names
+func (t *flushingTable) WriteHeader(columns ...string) error {
+	return t.table.WriteHeader(columns...)
+}
+
+// WriteRow writes a row, flushing if enough have been written since the
+// last flush
+func (t *flushingTable) WriteRow(cells ...any) error {
+	if err := t.table.WriteRow(cells...); err != nil {
+		return err
+	}
+	t.rows++
+	if t.rows%flushRows == 0 {
+		return t.flush()
+	}
+	return nil
+}
+
+// csvTable writes a table as CSV
+type csvTable struct {
+	writer *csv.Writer
+	record []string
+}
+
+// WriteHeader writes the column names
+func (t *csvTable) WriteHeader(columns ...string) error {
+	return t.writer.Write(columns)
+}
+
+// WriteRow writes a row of cells formatted by formatCell
+func (t *csvTable) WriteRow(cells ...any) error {
+	t.record = t.record[:0]
+	for _, cell := range cells {
+		t.record = append(t.record, formatCell(cell))
+	}
+	return t.writer.Write(t.record)
+}
+
+func (t *csvTable) flush() error {
+	t.writer.Flush()
+	return t.writer.Error()
+}
+
+func (t *csvTable) close() error {
+	return t.flush()
+}
+
+// formatCell formats a cell as CSV text: numbers in full, dates as
+// 2006-01-02 or, with a time of day, RFC 3339, and nil as empty
+func formatCell(cell any) string {
+	switch v := cell.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case time.Time:
+		if v.Hour() == 0 && v.Minute() == 0 && v.Second() == 0 && v.Nanosecond() == 0 {
+			return v.Format(time.DateOnly)
+		}
+		return v.Format(time.RFC3339)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// xlsxTable writes a table as an Excel sheet
+type xlsxTable struct {
+	writer *xlsx.Writer
+}
+
+// WriteHeader writes the column names in bold
+func (t *xlsxTable) WriteHeader(columns ...string) error {
+	return t.writer.WriteHeader(columns...)
+}
+
+// WriteRow writes a row of cells
+func (t *xlsxTable) WriteRow(cells ...any) error {
+	return t.writer.WriteRow(cells...)
+}
+
+func (t *xlsxTable) flush() error {
+	return t.writer.Flush()
+}
+
+func (t *xlsxTable) close() error {
+	return t.writer.Close()
+}
@@ -0,0 +1,115 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"clockzen-next/internal/infrastructure/xlsx"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		accept string
+		want   Format
+	}{
+		{"default", "", "", FormatJSON},
+		{"format parameter", "?format=csv", "", FormatCSV},
+		{"format parameter wins", "?format=json", "text/csv", FormatJSON},
+		{"accept csv", "", "text/csv", FormatCSV},
+		{"accept xlsx", "", xlsx.ContentType, FormatXLSX},
+		{"accept with parameters", "", "text/csv; charset=utf-8", FormatCSV},
+		{"first listed wins", "", "application/json, text/csv", FormatJSON},
+		{"unknown types skipped", "", "text/html, text/csv;q=0.9", FormatCSV},
+		{"anything", "", "*/*", FormatJSON},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/api/analysis/spending"+tt.query, nil)
+			if tt.accept != "" {
+				r.Header.Set("Accept", tt.accept)
+			}
+			format, err := Negotiate(r)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, format)
+		})
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/api/analysis/spending?format=pdf", nil)
+	_, err := Negotiate(r)
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+// writeRows writes n rows to a table
+func writeRows(n int) func(Table) error {
+	return func(table Table) error {
+		if err := table.WriteHeader("date", "category", "amount", "count", "note"); err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			date := time.Date(2026, 9, 1+i%30, 0, 0, 0, 0, time.UTC)
+			if err := table.WriteRow(date, "dining, out", 12.5, i, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func TestStreamCSV(t *testing.T) {
+	rec := httptest.NewRecorder()
+	require.NoError(t, Stream(rec, FormatCSV, "spending-2026-09", "Spending", writeRows(2)))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="spending-2026-09.csv"`, rec.Header().Get("Content-Disposition"))
+	assert.Equal(t, "date,category,amount,count,note\n"+
+		"2026-09-01,\"dining, out\",12.5,0,\n"+
+		"2026-09-02,\"dining, out\",12.5,1,\n", rec.Body.String())
+}
+
+func TestStreamFlushes(t *testing.T) {
+	rec := httptest.NewRecorder()
+	require.NoError(t, Stream(rec, FormatCSV, "spending", "Spending", writeRows(flushRows+1)))
+	assert.True(t, rec.Flushed)
+	assert.Equal(t, flushRows+2, bytes.Count(rec.Body.Bytes(), []byte("\n")))
+}
+
+func TestStreamXLSX(t *testing.T) {
+	rec := httptest.NewRecorder()
+	require.NoError(t, Stream(rec, FormatXLSX, "spending", "Spending", writeRows(3)))
+
+	assert.Equal(t, xlsx.ContentType, rec.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="spending.xlsx"`, rec.Header().Get("Content-Disposition"))
+	r, err := zip.NewReader(bytes.NewReader(rec.Body.Bytes()), int64(rec.Body.Len()))
+	require.NoError(t, err)
+	_, err = r.Open("xl/worksheets/sheet1.xml")
+	assert.NoError(t, err)
+}
+
+func TestStreamReturnsWriteErrors(t *testing.T) {
+	failed := errors.New("query failed")
+	err := Stream(httptest.NewRecorder(), FormatCSV, "spending", "Spending", func(Table) error { return failed })
+	assert.ErrorIs(t, err, failed)
+
+	assert.ErrorIs(t, Stream(httptest.NewRecorder(), FormatJSON, "spending", "Spending", writeRows(1)), ErrUnsupportedFormat)
+}
+
+func TestFormatCell(t *testing.T) {
+	assert.Equal(t, "", formatCell(nil))
+	assert.Equal(t, "0.1", formatCell(0.1))
+	assert.Equal(t, "1234567.89", formatCell(1234567.89))
+	assert.Equal(t, "42", formatCell(42))
+	assert.Equal(t, "true", formatCell(true))
+	assert.Equal(t, "2026-09-01", formatCell(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "2026-09-01T12:30:00Z", formatCell(time.Date(2026, 9, 1, 12, 30, 0, 0, time.UTC)))
+}
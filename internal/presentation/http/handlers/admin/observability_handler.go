@@ -0,0 +1,311 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"clockzen-next/internal/infrastructure/observability"
+	"clockzen-next/internal/infrastructure/telemetry"
+)
+
+// SlowQueryResponse represents a logged slow database query
+type SlowQueryResponse struct {
+	Operation  string    `json:"operation"`
+	Query      string    `json:"query"`
+	Args       []string  `json:"args"`
+	DurationMs float64   `json:"duration_ms"`
+	InTx       bool      `json:"in_tx"`
+	Error      string    `json:"error,omitempty"`
+	RequestID  string    `json:"request_id,omitempty"`
+	RecordedAt time.Time `json:"recorded_at"`
+}
+
+// ListSlowQueriesResponse represents the slow query ring buffer
+type ListSlowQueriesResponse struct {
+	Queries       []SlowQueryResponse `json:"queries"`
+	Total         int                 `json:"total"`
+	TotalRecorded int64               `json:"total_recorded"`
+	Capacity      int                 `json:"capacity"`
+}
+
+// LatencyBucketResponse represents a cumulative latency histogram bucket
+type LatencyBucketResponse struct {
+	LeMs  float64 `json:"le_ms"`
+	Count int64   `json:"count"`
+}
+
+// RouteSLOResponse represents a route's latency and objective compliance
+type RouteSLOResponse struct {
+	Route            string                  `json:"route"`
+	Requests         int64                   `json:"requests"`
+	Errors           int64                   `json:"errors"`
+	GoodRequests     int64                   `json:"good_requests"`
+	LatencyTargetMs  float64                 `json:"latency_target_ms"`
+	Objective        float64                 `json:"objective"`
+	Compliance       float64                 `json:"compliance"`
+	ErrorBudget      float64                 `json:"error_budget_remaining"`
+	Breaching        bool                    `json:"breaching"`
+	AverageLatencyMs float64                 `json:"average_latency_ms"`
+	MaxLatencyMs     float64                 `json:"max_latency_ms"`
+	Buckets          []LatencyBucketResponse `json:"buckets"`
+}
+
+// SLOReportResponse represents the per-route SLO report
+type SLOReportResponse struct {
+	Routes    []RouteSLOResponse `json:"routes"`
+	Total     int                `json:"total"`
+	Breaching int                `json:"breaching"`
+	Since     time.Time          `json:"since"`
+}
+
+// TelemetryCountResponse represents a total in the telemetry summary
+type TelemetryCountResponse struct {
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+// TelemetryInstallationResponse represents an installation that has sent
+// telemetry reports
+type TelemetryInstallationResponse struct {
+	ID        string    `json:"id"`
+	Version   string    `json:"version,omitempty"`
+	Reports   int64     `json:"reports"`
+	FirstSeen time.Time `json:"first_seen"`
+	LastSeen  time.Time `json:"last_seen"`
+}
+
+// TelemetrySummaryResponse represents the totals received by the telemetry
+// receiver
+type TelemetrySummaryResponse struct {
+	Since         time.Time                       `json:"since"`
+	Reports       int64                           `json:"reports"`
+	Installations []TelemetryInstallationResponse `json:"installations"`
+	Features      []TelemetryCountResponse        `json:"features"`
+	Errors        []TelemetryCountResponse        `json:"errors"`
+}
+
+// ObservabilityHandler handles HTTP requests for latency objectives, slow
+// query debugging and received telemetry
+type ObservabilityHandler struct {
+	mu         sync.RWMutex
+	sloTracker *observability.SLOTracker
+	slowLog    *observability.SlowQueryLog
+	telemetry  *telemetry.Aggregator
+}
+
+// NewObservabilityHandler creates a new ObservabilityHandler instance
+func NewObservabilityHandler() *ObservabilityHandler {
+	return &ObservabilityHandler{}
+}
+
+// SetSLOTracker sets the tracker reported by the SLO endpoint
+func (h *ObservabilityHandler) SetSLOTracker(tracker *observability.SLOTracker) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.sloTracker = tracker
+}
+
+// SetSlowQueryLog sets the log reported by the slow query endpoint
+func (h *ObservabilityHandler) SetSlowQueryLog(slowLog *observability.SlowQueryLog) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.slowLog = slowLog
+}
+
+// SetTelemetryAggregator sets the receiver reported by the telemetry endpoint
+func (h *ObservabilityHandler) SetTelemetryAggregator(aggregator *telemetry.Aggregator) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.telemetry = aggregator
+}
+
+// HandleSlowQueries handles GET and DELETE /api/admin/slow-queries
+func (h *ObservabilityHandler) HandleSlowQueries(w http.ResponseWriter, r *http.Request) {
+	h.mu.RLock()
+	slowLog := h.slowLog
+	h.mu.RUnlock()
+
+	if slowLog == nil {
+		h.writeError(w, http.StatusServiceUnavailable, "not_configured", "Slow query logging is not enabled")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodDelete:
+		slowLog.Clear()
+		w.WriteHeader(http.StatusNoContent)
+		return
+	default:
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET or DELETE method is allowed")
+		return
+	}
+
+	limit := 0
+	if v := r.URL.Query().Get("limit"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed < 0 {
+			h.writeError(w, http.StatusBadRequest, "invalid_parameter", "limit must be a non-negative integer")
+			return
+		}
+		limit = parsed
+	}
+
+	queries := slowLog.Recent(limit)
+	resp := ListSlowQueriesResponse{
+		Queries:       make([]SlowQueryResponse, len(queries)),
+		Total:         len(queries),
+		TotalRecorded: slowLog.Total(),
+		Capacity:      slowLog.Capacity(),
+	}
+	for i, q := range queries {
+		resp.Queries[i] = SlowQueryResponse{
+			Operation:  q.Operation,
+			Query:      q.Query,
+			Args:       q.Args,
+			DurationMs: durationMs(q.Duration),
+			InTx:       q.InTx,
+			Error:      q.Error,
+			RecordedAt: q.RecordedAt,
+		}
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleSLO handles GET and DELETE /api/admin/slo
+func (h *ObservabilityHandler) HandleSLO(w http.ResponseWriter, r *http.Request) {
+	h.mu.RLock()
+	tracker := h.sloTracker
+	h.mu.RUnlock()
+
+	if tracker == nil {
+		h.writeError(w, http.StatusServiceUnavailable, "not_configured", "SLO tracking is not enabled")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodDelete:
+		tracker.Reset()
+		w.WriteHeader(http.StatusNoContent)
+		return
+	default:
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET or DELETE method is allowed")
+		return
+	}
+
+	onlyBreaching, _ := strconv.ParseBool(r.URL.Query().Get("breaching"))
+
+	resp := SLOReportResponse{
+		Routes: make([]RouteSLOResponse, 0),
+		Since:  tracker.Since(),
+	}
+	for _, slo := range tracker.Snapshot() {
+		if slo.Breaching {
+			resp.Breaching++
+		} else if onlyBreaching {
+			continue
+		}
+
+		route := RouteSLOResponse{
+			Route:            slo.Route,
+			Requests:         slo.Requests,
+			Errors:           slo.Errors,
+			GoodRequests:     slo.GoodRequests,
+			LatencyTargetMs:  durationMs(slo.LatencyTarget),
+			Objective:        slo.Objective,
+			Compliance:       slo.Compliance,
+			ErrorBudget:      slo.ErrorBudget,
+			Breaching:        slo.Breaching,
+			AverageLatencyMs: durationMs(slo.AverageLatency),
+			MaxLatencyMs:     durationMs(slo.MaxLatency),
+			Buckets:          make([]LatencyBucketResponse, len(slo.Buckets)),
+		}
+		for i, bucket := range slo.Buckets {
+			route.Buckets[i] = LatencyBucketResponse{
+				LeMs:  durationMs(bucket.UpperBound),
+				Count: bucket.Count,
+			}
+		}
+		resp.Routes = append(resp.Routes, route)
+	}
+	resp.Total = len(resp.Routes)
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleTelemetry handles GET and DELETE /api/admin/telemetry
+func (h *ObservabilityHandler) HandleTelemetry(w http.ResponseWriter, r *http.Request) {
+	h.mu.RLock()
+	aggregator := h.telemetry
+	h.mu.RUnlock()
+
+	if aggregator == nil {
+		h.writeError(w, http.StatusServiceUnavailable, "not_configured", "The telemetry receiver is not enabled")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodDelete:
+		aggregator.Reset()
+		w.WriteHeader(http.StatusNoContent)
+		return
+	default:
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET or DELETE method is allowed")
+		return
+	}
+
+	summary := aggregator.Summary()
+	resp := TelemetrySummaryResponse{
+		Since:         summary.Since,
+		Reports:       summary.Reports,
+		Installations: make([]TelemetryInstallationResponse, len(summary.Installations)),
+		Features:      telemetryCounts(summary.Features),
+		Errors:        telemetryCounts(summary.Errors),
+	}
+	for i, inst := range summary.Installations {
+		resp.Installations[i] = TelemetryInstallationResponse{
+			ID:        inst.ID,
+			Version:   inst.Version,
+			Reports:   inst.Reports,
+			FirstSeen: inst.FirstSeen,
+			LastSeen:  inst.LastSeen,
+		}
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// telemetryCounts converts telemetry totals to responses
+func telemetryCounts(counts []telemetry.Count) []TelemetryCountResponse {
+	resp := make([]TelemetryCountResponse, len(counts))
+	for i, c := range counts {
+		resp[i] = TelemetryCountResponse{Name: c.Name, Count: c.Count}
+	}
+	return resp
+}
+
+// durationMs converts a duration to fractional milliseconds
+func durationMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// writeJSON writes a JSON response
+func (h *ObservabilityHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *ObservabilityHandler) writeError(w http.ResponseWriter, status int, errCode string, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
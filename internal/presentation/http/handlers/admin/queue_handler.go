@@ -2,11 +2,15 @@ package admin
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
-	"github.com/google/uuid"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/queuedjob"
+	"clockzen-next/internal/infrastructure/queue"
 )
 
 // JobStatus represents the status of a job in the queue
@@ -35,10 +39,13 @@ type Job struct {
 	QueueName   string          `json:"queue_name"`
 	Type        string          `json:"type"`
 	Status      JobStatus       `json:"status"`
+	Priority    int             `json:"priority"`
 	Payload     json.RawMessage `json:"payload,omitempty"`
+	Result      json.RawMessage `json:"result,omitempty"`
 	RetryCount  int             `json:"retry_count"`
 	MaxRetries  int             `json:"max_retries"`
 	Error       string          `json:"error,omitempty"`
+	RunAt       time.Time       `json:"run_at"`
 	CreatedAt   time.Time       `json:"created_at"`
 	StartedAt   *time.Time      `json:"started_at,omitempty"`
 	CompletedAt *time.Time      `json:"completed_at,omitempty"`
@@ -100,36 +107,39 @@ type CancelJobResponse struct {
 	Message string `json:"message"`
 }
 
-// QueueHandler handles HTTP requests for admin queue management
+// defaultJobListLimit caps the jobs listed when no ?limit is given
+const defaultJobListLimit = 100
+
+// QueueHandler handles HTTP requests for admin queue management. Queues and
+// jobs are those of the persistent job queue the workers run from.
 type QueueHandler struct {
-	mu     sync.RWMutex
-	queues map[string]*Queue
-	jobs   map[string]*Job
+	mu       sync.RWMutex
+	jobQueue *queue.Queue
 }
 
 // NewQueueHandler creates a new QueueHandler instance
 func NewQueueHandler() *QueueHandler {
-	h := &QueueHandler{
-		queues: make(map[string]*Queue),
-		jobs:   make(map[string]*Job),
-	}
-	// Initialize default queues
-	h.initializeDefaultQueues()
-	return h
+	return &QueueHandler{}
 }
 
-// initializeDefaultQueues creates the default queues
-func (h *QueueHandler) initializeDefaultQueues() {
-	now := time.Now()
-	defaultQueues := []string{"email_import", "drive_sync", "ocr_processing", "receipt_extraction"}
-	for _, name := range defaultQueues {
-		h.queues[name] = &Queue{
-			Name:      name,
-			Status:    QueueStatusActive,
-			CreatedAt: now,
-			UpdatedAt: now,
-		}
+// SetJobQueue sets the job queue managed by the queue endpoints
+func (h *QueueHandler) SetJobQueue(jobQueue *queue.Queue) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.jobQueue = jobQueue
+}
+
+// queue returns the managed job queue, writing an error if none is set
+func (h *QueueHandler) queue(w http.ResponseWriter) (*queue.Queue, bool) {
+	h.mu.RLock()
+	jobQueue := h.jobQueue
+	h.mu.RUnlock()
+
+	if jobQueue == nil {
+		h.writeError(w, http.StatusServiceUnavailable, "not_configured", "Job queue is not configured")
+		return nil, false
 	}
+	return jobQueue, true
 }
 
 // HandleListQueues handles GET /api/admin/queues
@@ -138,21 +148,28 @@ func (h *QueueHandler) HandleListQueues(w http.ResponseWriter, r *http.Request)
 		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
 		return
 	}
+	jobQueue, ok := h.queue(w)
+	if !ok {
+		return
+	}
 
 	// Get optional status filter
 	status := r.URL.Query().Get("status")
 
-	h.mu.RLock()
-	// Update queue counts based on jobs
-	h.updateQueueCounts()
-	queues := make([]*Queue, 0, len(h.queues))
-	for _, queue := range h.queues {
-		if status != "" && string(queue.Status) != status {
+	infos, err := jobQueue.Queues(r.Context())
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to list queues: "+err.Error())
+		return
+	}
+
+	queues := make([]*Queue, 0, len(infos))
+	for _, info := range infos {
+		q := queueToResponse(info)
+		if status != "" && string(q.Status) != status {
 			continue
 		}
-		queues = append(queues, queue)
+		queues = append(queues, q)
 	}
-	h.mu.RUnlock()
 
 	resp := ListQueuesResponse{
 		Queues: queues,
@@ -168,21 +185,18 @@ func (h *QueueHandler) HandleGetQueue(w http.ResponseWriter, r *http.Request, na
 		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
 		return
 	}
-
-	h.mu.RLock()
-	queue, exists := h.queues[name]
-	if exists {
-		h.updateQueueCounts()
-		queue = h.queues[name]
+	jobQueue, ok := h.queue(w)
+	if !ok {
+		return
 	}
-	h.mu.RUnlock()
 
-	if !exists {
-		h.writeError(w, http.StatusNotFound, "not_found", "Queue not found")
+	info, err := jobQueue.GetQueue(r.Context(), name)
+	if err != nil {
+		h.writeQueueError(w, err)
 		return
 	}
 
-	h.writeJSON(w, http.StatusOK, queue)
+	h.writeJSON(w, http.StatusOK, queueToResponse(info))
 }
 
 // HandleListJobs handles GET /api/admin/queues/{name}/jobs
@@ -191,29 +205,39 @@ func (h *QueueHandler) HandleListJobs(w http.ResponseWriter, r *http.Request, qu
 		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
 		return
 	}
-
-	// Get optional status filter
-	status := r.URL.Query().Get("status")
-
-	h.mu.RLock()
-	_, exists := h.queues[queueName]
-	if !exists {
-		h.mu.RUnlock()
-		h.writeError(w, http.StatusNotFound, "not_found", "Queue not found")
+	jobQueue, ok := h.queue(w)
+	if !ok {
 		return
 	}
 
-	jobs := make([]*Job, 0)
-	for _, job := range h.jobs {
-		if job.QueueName != queueName {
-			continue
+	// Get optional status filter and limit
+	opts := queue.ListJobsOptions{Limit: defaultJobListLimit}
+	if status := r.URL.Query().Get("status"); status != "" {
+		opts.Status = queuedjob.Status(status)
+		if err := queuedjob.StatusValidator(opts.Status); err != nil {
+			h.writeError(w, http.StatusBadRequest, "invalid_status", "Invalid job status: "+status)
+			return
 		}
-		if status != "" && string(job.Status) != status {
-			continue
+	}
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 1 {
+			h.writeError(w, http.StatusBadRequest, "invalid_limit", "Limit must be a positive integer")
+			return
 		}
-		jobs = append(jobs, job)
+		opts.Limit = n
+	}
+
+	records, err := jobQueue.ListJobs(r.Context(), queueName, opts)
+	if err != nil {
+		h.writeQueueError(w, err)
+		return
+	}
+
+	jobs := make([]*Job, len(records))
+	for i, record := range records {
+		jobs[i] = jobToResponse(record)
 	}
-	h.mu.RUnlock()
 
 	resp := ListJobsResponse{
 		Jobs:  jobs,
@@ -229,24 +253,18 @@ func (h *QueueHandler) HandleGetJob(w http.ResponseWriter, r *http.Request, queu
 		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
 		return
 	}
-
-	h.mu.RLock()
-	_, exists := h.queues[queueName]
-	if !exists {
-		h.mu.RUnlock()
-		h.writeError(w, http.StatusNotFound, "not_found", "Queue not found")
+	jobQueue, ok := h.queue(w)
+	if !ok {
 		return
 	}
 
-	job, exists := h.jobs[jobID]
-	h.mu.RUnlock()
-
-	if !exists || job.QueueName != queueName {
-		h.writeError(w, http.StatusNotFound, "not_found", "Job not found")
+	job, err := jobQueue.GetJob(r.Context(), queueName, jobID)
+	if err != nil {
+		h.writeQueueError(w, err)
 		return
 	}
 
-	h.writeJSON(w, http.StatusOK, job)
+	h.writeJSON(w, http.StatusOK, jobToResponse(job))
 }
 
 // HandleRetryJob handles POST /api/admin/queues/{name}/jobs/{id}/retry
@@ -255,6 +273,10 @@ func (h *QueueHandler) HandleRetryJob(w http.ResponseWriter, r *http.Request, qu
 		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
 		return
 	}
+	jobQueue, ok := h.queue(w)
+	if !ok {
+		return
+	}
 
 	var req RetryJobRequest
 	if r.Body != nil && r.ContentLength > 0 {
@@ -264,43 +286,14 @@ func (h *QueueHandler) HandleRetryJob(w http.ResponseWriter, r *http.Request, qu
 		}
 	}
 
-	h.mu.Lock()
-	defer h.mu.Unlock()
-
-	_, exists := h.queues[queueName]
-	if !exists {
-		h.writeError(w, http.StatusNotFound, "not_found", "Queue not found")
+	job, err := jobQueue.RetryJob(r.Context(), queueName, jobID, req.ResetRetryCount)
+	if err != nil {
+		h.writeQueueError(w, err)
 		return
 	}
 
-	job, exists := h.jobs[jobID]
-	if !exists || job.QueueName != queueName {
-		h.writeError(w, http.StatusNotFound, "not_found", "Job not found")
-		return
-	}
-
-	// Only allow retrying failed or cancelled jobs
-	if job.Status != JobStatusFailed && job.Status != JobStatusCancelled {
-		h.writeError(w, http.StatusConflict, "conflict", "Only failed or cancelled jobs can be retried")
-		return
-	}
-
-	// Reset the job for retry
-	if req.ResetRetryCount {
-		job.RetryCount = 0
-	} else {
-		job.RetryCount++
-	}
-	job.Status = JobStatusPending
-	job.Error = ""
-	job.StartedAt = nil
-	job.CompletedAt = nil
-	job.CancelledAt = nil
-
-	h.updateQueueCounts()
-
 	resp := RetryJobResponse{
-		Job:     job,
+		Job:     jobToResponse(job),
 		Message: "Job has been queued for retry",
 	}
 
@@ -313,36 +306,19 @@ func (h *QueueHandler) HandleCancelJob(w http.ResponseWriter, r *http.Request, q
 		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
 		return
 	}
-
-	h.mu.Lock()
-	defer h.mu.Unlock()
-
-	_, exists := h.queues[queueName]
-	if !exists {
-		h.writeError(w, http.StatusNotFound, "not_found", "Queue not found")
-		return
-	}
-
-	job, exists := h.jobs[jobID]
-	if !exists || job.QueueName != queueName {
-		h.writeError(w, http.StatusNotFound, "not_found", "Job not found")
+	jobQueue, ok := h.queue(w)
+	if !ok {
 		return
 	}
 
-	// Only allow cancelling pending or processing jobs
-	if job.Status != JobStatusPending && job.Status != JobStatusProcessing {
-		h.writeError(w, http.StatusConflict, "conflict", "Only pending or processing jobs can be cancelled")
+	job, err := jobQueue.CancelJob(r.Context(), queueName, jobID)
+	if err != nil {
+		h.writeQueueError(w, err)
 		return
 	}
 
-	now := time.Now()
-	job.Status = JobStatusCancelled
-	job.CancelledAt = &now
-
-	h.updateQueueCounts()
-
 	resp := CancelJobResponse{
-		Job:     job,
+		Job:     jobToResponse(job),
 		Message: "Job has been cancelled",
 	}
 
@@ -355,31 +331,16 @@ func (h *QueueHandler) HandleDeleteJob(w http.ResponseWriter, r *http.Request, q
 		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only DELETE method is allowed")
 		return
 	}
-
-	h.mu.Lock()
-	defer h.mu.Unlock()
-
-	_, exists := h.queues[queueName]
-	if !exists {
-		h.writeError(w, http.StatusNotFound, "not_found", "Queue not found")
-		return
-	}
-
-	job, exists := h.jobs[jobID]
-	if !exists || job.QueueName != queueName {
-		h.writeError(w, http.StatusNotFound, "not_found", "Job not found")
+	jobQueue, ok := h.queue(w)
+	if !ok {
 		return
 	}
 
-	// Don't allow deleting processing jobs
-	if job.Status == JobStatusProcessing {
-		h.writeError(w, http.StatusConflict, "conflict", "Cannot delete a processing job. Cancel it first.")
+	if err := jobQueue.DeleteJob(r.Context(), queueName, jobID); err != nil {
+		h.writeQueueError(w, err)
 		return
 	}
 
-	delete(h.jobs, jobID)
-	h.updateQueueCounts()
-
 	w.WriteHeader(http.StatusNoContent)
 }
 
@@ -389,45 +350,28 @@ func (h *QueueHandler) HandleGetStats(w http.ResponseWriter, r *http.Request) {
 		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
 		return
 	}
-
-	h.mu.RLock()
-	defer h.mu.RUnlock()
-
-	stats := QueueStats{
-		TotalQueues:    len(h.queues),
-		QueueBreakdown: make(map[string]int),
-	}
-
-	var totalProcessingTime int64
-	var completedJobCount int
-
-	for _, job := range h.jobs {
-		stats.TotalJobs++
-		stats.QueueBreakdown[job.QueueName]++
-
-		switch job.Status {
-		case JobStatusPending:
-			stats.PendingJobs++
-		case JobStatusProcessing:
-			stats.ProcessingJobs++
-		case JobStatusCompleted:
-			stats.CompletedJobs++
-			if job.StartedAt != nil && job.CompletedAt != nil {
-				totalProcessingTime += job.CompletedAt.Sub(*job.StartedAt).Milliseconds()
-				completedJobCount++
-			}
-		case JobStatusFailed:
-			stats.FailedJobs++
-		case JobStatusCancelled:
-			stats.CancelledJobs++
-		}
+	jobQueue, ok := h.queue(w)
+	if !ok {
+		return
 	}
 
-	if completedJobCount > 0 {
-		stats.AvgProcessingMs = float64(totalProcessingTime) / float64(completedJobCount)
+	stats, err := jobQueue.Stats(r.Context())
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get queue statistics: "+err.Error())
+		return
 	}
 
-	h.writeJSON(w, http.StatusOK, stats)
+	h.writeJSON(w, http.StatusOK, QueueStats{
+		TotalQueues:     stats.Queues,
+		TotalJobs:       stats.Jobs,
+		PendingJobs:     stats.Counts[queuedjob.StatusPending],
+		ProcessingJobs:  stats.Counts[queuedjob.StatusProcessing],
+		CompletedJobs:   stats.Counts[queuedjob.StatusCompleted],
+		FailedJobs:      stats.Counts[queuedjob.StatusFailed],
+		CancelledJobs:   stats.Counts[queuedjob.StatusCancelled],
+		QueueBreakdown:  stats.JobsByQueue,
+		AvgProcessingMs: stats.AvgProcessingMs,
+	})
 }
 
 // HandleFlushQueue handles POST /api/admin/queues/{name}/flush
@@ -436,36 +380,26 @@ func (h *QueueHandler) HandleFlushQueue(w http.ResponseWriter, r *http.Request,
 		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
 		return
 	}
-
-	// Get optional status filter - only flush jobs with this status
-	status := r.URL.Query().Get("status")
-
-	h.mu.Lock()
-	defer h.mu.Unlock()
-
-	_, exists := h.queues[queueName]
-	if !exists {
-		h.writeError(w, http.StatusNotFound, "not_found", "Queue not found")
+	jobQueue, ok := h.queue(w)
+	if !ok {
 		return
 	}
 
-	flushedCount := 0
-	for id, job := range h.jobs {
-		if job.QueueName != queueName {
-			continue
-		}
-		// Don't flush processing jobs unless explicitly requested
-		if job.Status == JobStatusProcessing && status != string(JobStatusProcessing) {
-			continue
-		}
-		if status != "" && string(job.Status) != status {
-			continue
+	// Get optional status filter - only flush jobs with this status.
+	// Processing jobs are only flushed when asked for explicitly.
+	status := queuedjob.Status(r.URL.Query().Get("status"))
+	if status != "" {
+		if err := queuedjob.StatusValidator(status); err != nil {
+			h.writeError(w, http.StatusBadRequest, "invalid_status", "Invalid job status: "+string(status))
+			return
 		}
-		delete(h.jobs, id)
-		flushedCount++
 	}
 
-	h.updateQueueCounts()
+	flushedCount, err := jobQueue.Flush(r.Context(), queueName, status)
+	if err != nil {
+		h.writeQueueError(w, err)
+		return
+	}
 
 	h.writeJSON(w, http.StatusOK, map[string]any{
 		"message":       "Queue flushed",
@@ -480,25 +414,18 @@ func (h *QueueHandler) HandlePauseQueue(w http.ResponseWriter, r *http.Request,
 		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
 		return
 	}
-
-	h.mu.Lock()
-	defer h.mu.Unlock()
-
-	queue, exists := h.queues[queueName]
-	if !exists {
-		h.writeError(w, http.StatusNotFound, "not_found", "Queue not found")
+	jobQueue, ok := h.queue(w)
+	if !ok {
 		return
 	}
 
-	if queue.Status == QueueStatusPaused {
-		h.writeError(w, http.StatusConflict, "conflict", "Queue is already paused")
+	info, err := jobQueue.PauseQueue(r.Context(), queueName)
+	if err != nil {
+		h.writeQueueError(w, err)
 		return
 	}
 
-	queue.Status = QueueStatusPaused
-	queue.UpdatedAt = time.Now()
-
-	h.writeJSON(w, http.StatusOK, queue)
+	h.writeJSON(w, http.StatusOK, queueToResponse(info))
 }
 
 // HandleResumeQueue handles POST /api/admin/queues/{name}/resume
@@ -507,94 +434,82 @@ func (h *QueueHandler) HandleResumeQueue(w http.ResponseWriter, r *http.Request,
 		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
 		return
 	}
-
-	h.mu.Lock()
-	defer h.mu.Unlock()
-
-	queue, exists := h.queues[queueName]
-	if !exists {
-		h.writeError(w, http.StatusNotFound, "not_found", "Queue not found")
+	jobQueue, ok := h.queue(w)
+	if !ok {
 		return
 	}
 
-	if queue.Status == QueueStatusActive {
-		h.writeError(w, http.StatusConflict, "conflict", "Queue is already active")
+	info, err := jobQueue.ResumeQueue(r.Context(), queueName)
+	if err != nil {
+		h.writeQueueError(w, err)
 		return
 	}
 
-	queue.Status = QueueStatusActive
-	queue.UpdatedAt = time.Now()
-
-	h.writeJSON(w, http.StatusOK, queue)
+	h.writeJSON(w, http.StatusOK, queueToResponse(info))
 }
 
-// AddJob adds a job to the queue (for testing and internal use)
-func (h *QueueHandler) AddJob(queueName, jobType string, payload json.RawMessage) (*Job, error) {
-	h.mu.Lock()
-	defer h.mu.Unlock()
-
-	_, exists := h.queues[queueName]
-	if !exists {
-		return nil, &QueueNotFoundError{QueueName: queueName}
+// queueToResponse converts a queue to its response
+func queueToResponse(info *queue.QueueInfo) *Queue {
+	status := QueueStatusActive
+	if info.Paused {
+		status = QueueStatusPaused
+	}
+	return &Queue{
+		Name:            info.Name,
+		Status:          status,
+		PendingCount:    info.Counts[queuedjob.StatusPending],
+		ProcessingCount: info.Counts[queuedjob.StatusProcessing],
+		CompletedCount:  info.Counts[queuedjob.StatusCompleted],
+		FailedCount:     info.Counts[queuedjob.StatusFailed],
+		CancelledCount:  info.Counts[queuedjob.StatusCancelled],
+		CreatedAt:       info.CreatedAt,
+		UpdatedAt:       info.UpdatedAt,
 	}
-
-	now := time.Now()
-	job := &Job{
-		ID:         uuid.New().String(),
-		QueueName:  queueName,
-		Type:       jobType,
-		Status:     JobStatusPending,
-		Payload:    payload,
-		MaxRetries: 3,
-		CreatedAt:  now,
-	}
-
-	h.jobs[job.ID] = job
-	h.updateQueueCounts()
-
-	return job, nil
 }
 
-// QueueNotFoundError is returned when a queue is not found
-type QueueNotFoundError struct {
-	QueueName string
+// jobToResponse converts a queued job to its response
+func jobToResponse(job *ent.QueuedJob) *Job {
+	resp := &Job{
+		ID:          job.ID,
+		QueueName:   job.Queue,
+		Type:        job.Type,
+		Status:      JobStatus(job.Status),
+		Priority:    job.Priority,
+		Payload:     job.Payload,
+		Result:      job.Result,
+		RetryCount:  job.RetryCount,
+		MaxRetries:  job.MaxRetries,
+		RunAt:       job.RunAt,
+		CreatedAt:   job.CreatedAt,
+		StartedAt:   job.StartedAt,
+		CompletedAt: job.CompletedAt,
+		CancelledAt: job.CancelledAt,
+	}
+	if job.Error != nil {
+		resp.Error = *job.Error
+	}
+	return resp
 }
 
-func (e *QueueNotFoundError) Error() string {
-	return "queue not found: " + e.QueueName
-}
-
-// updateQueueCounts updates the job counts for all queues
-// Must be called with mutex held
-func (h *QueueHandler) updateQueueCounts() {
-	// Reset counts
-	for _, queue := range h.queues {
-		queue.PendingCount = 0
-		queue.ProcessingCount = 0
-		queue.CompletedCount = 0
-		queue.FailedCount = 0
-		queue.CancelledCount = 0
-	}
-
-	// Count jobs per queue and status
-	for _, job := range h.jobs {
-		queue, exists := h.queues[job.QueueName]
-		if !exists {
-			continue
-		}
-
-		switch job.Status {
-		case JobStatusPending:
-			queue.PendingCount++
-		case JobStatusProcessing:
-			queue.ProcessingCount++
-		case JobStatusCompleted:
-			queue.CompletedCount++
-		case JobStatusFailed:
-			queue.FailedCount++
-		case JobStatusCancelled:
-			queue.CancelledCount++
-		}
+// writeQueueError maps a job queue error to a response
+func (h *QueueHandler) writeQueueError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, queue.ErrQueueNotFound):
+		h.writeError(w, http.StatusNotFound, "not_found", "Queue not found")
+	case errors.Is(err, queue.ErrJobNotFound):
+		h.writeError(w, http.StatusNotFound, "not_found", "Job not found")
+	case errors.Is(err, queue.ErrJobNotRetryable):
+		h.writeError(w, http.StatusConflict, "conflict", "Only failed or cancelled jobs can be retried")
+	case errors.Is(err, queue.ErrJobNotCancellable):
+		h.writeError(w, http.StatusConflict, "conflict", "Only pending or processing jobs can be cancelled")
+	case errors.Is(err, queue.ErrJobProcessing):
+		h.writeError(w, http.StatusConflict, "conflict", "Cannot delete a processing job. Cancel it first.")
+	case errors.Is(err, queue.ErrQueueAlreadyPaused):
+		h.writeError(w, http.StatusConflict, "conflict", "Queue is already paused")
+	case errors.Is(err, queue.ErrQueueNotPaused):
+		h.writeError(w, http.StatusConflict, "conflict", "Queue is already active")
+	default:
+		h.writeError(w, http.StatusInternalServerError, "internal_error", err.Error())
 	}
 }
 
@@ -2,6 +2,7 @@ package admin
 
 import (
 	"net/http"
+	"strconv"
 	"strings"
 )
 
@@ -16,6 +17,8 @@ type Router struct {
 	productHandler   *ProductHandler
 	categoryHandler  *CategoryHandler
 	configHandler    *ConfigHandler
+	obsHandler       *ObservabilityHandler
+	taxDataHandler   *TaxDataHandler
 }
 
 // NewRouter creates a new Router with the given handlers
@@ -29,6 +32,8 @@ func NewRouter(
 	productHandler *ProductHandler,
 	categoryHandler *CategoryHandler,
 	configHandler *ConfigHandler,
+	obsHandler *ObservabilityHandler,
+	taxDataHandler *TaxDataHandler,
 ) *Router {
 	return &Router{
 		userHandler:      userHandler,
@@ -40,6 +45,8 @@ func NewRouter(
 		productHandler:   productHandler,
 		categoryHandler:  categoryHandler,
 		configHandler:    configHandler,
+		obsHandler:       obsHandler,
+		taxDataHandler:   taxDataHandler,
 	}
 }
 
@@ -55,6 +62,8 @@ func NewDefaultRouter() *Router {
 		productHandler:   NewProductHandler(),
 		categoryHandler:  NewCategoryHandler(),
 		configHandler:    NewConfigHandler(),
+		obsHandler:       NewObservabilityHandler(),
+		taxDataHandler:   NewTaxDataHandler(),
 	}
 }
 
@@ -75,7 +84,7 @@ func NewDefaultRouter() *Router {
 //   9.  GET    /api/admin/queues                    - List all queues (with ?status filter)
 //  10. GET    /api/admin/queues/stats              - Get aggregate queue statistics
 //  11. GET    /api/admin/queues/{name}             - Get queue by name
-//  12. GET    /api/admin/queues/{name}/jobs        - List jobs in queue (with ?status filter)
+//  12. GET    /api/admin/queues/{name}/jobs        - List jobs in queue (with ?status and ?limit filters)
 //  13. POST   /api/admin/queues/{name}/flush       - Flush queue (with ?status filter)
 //  14. POST   /api/admin/queues/{name}/pause       - Pause queue processing
 //  15. POST   /api/admin/queues/{name}/resume      - Resume queue processing
@@ -178,7 +187,21 @@ func NewDefaultRouter() *Router {
 //  98. DELETE /api/admin/config/flags/{key}        - Delete feature flag
 //  99. POST   /api/admin/config/flags/{key}/toggle - Toggle feature flag
 //
-// Total: 99 endpoints
+// Observability Endpoints (6):
+// 100. GET    /api/admin/slow-queries              - List recent slow queries (with ?limit)
+// 101. DELETE /api/admin/slow-queries              - Clear the slow query buffer
+// 102. GET    /api/admin/slo                       - Per-route latency SLO report (with ?breaching)
+// 103. DELETE /api/admin/slo                       - Reset SLO counters
+// 104. GET    /api/admin/telemetry                 - Telemetry received from installations
+// 105. DELETE /api/admin/telemetry                 - Reset received telemetry
+//
+// Tax Data Endpoints (4):
+// 106. GET    /api/admin/tax-data                  - List loaded tax years and the active year
+// 107. POST   /api/admin/tax-data                  - Load a tax year data file (with ?activate)
+// 108. GET    /api/admin/tax-data/{year}           - Get a tax year's brackets and limits
+// 109. POST   /api/admin/tax-data/{year}/activate  - Make a tax year the one analyses use
+//
+// Total: 109 endpoints
 func (r *Router) RegisterRoutes(mux *http.ServeMux) {
 	// User management routes
 	mux.HandleFunc("/api/admin/users", r.handleUsers)
@@ -215,6 +238,15 @@ func (r *Router) RegisterRoutes(mux *http.ServeMux) {
 	// Configuration management routes
 	mux.HandleFunc("/api/admin/config", r.handleConfig)
 	mux.HandleFunc("/api/admin/config/", r.handleConfigByKey)
+
+	// Observability routes
+	mux.HandleFunc("/api/admin/slow-queries", r.obsHandler.HandleSlowQueries)
+	mux.HandleFunc("/api/admin/slo", r.obsHandler.HandleSLO)
+	mux.HandleFunc("/api/admin/telemetry", r.obsHandler.HandleTelemetry)
+
+	// Tax data routes
+	mux.HandleFunc("/api/admin/tax-data", r.handleTaxData)
+	mux.HandleFunc("/api/admin/tax-data/", r.handleTaxDataByYear)
 }
 
 // handleUsers routes requests for /api/admin/users
@@ -876,6 +908,48 @@ func (r *Router) handleConfigFlags(w http.ResponseWriter, req *http.Request, par
 	}
 }
 
+// handleTaxData routes requests for /api/admin/tax-data
+func (r *Router) handleTaxData(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		r.taxDataHandler.HandleList(w, req)
+	case http.MethodPost:
+		r.taxDataHandler.HandleInstall(w, req)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleTaxDataByYear routes requests for /api/admin/tax-data/{year} and
+// sub-resources
+func (r *Router) handleTaxDataByYear(w http.ResponseWriter, req *http.Request) {
+	path := strings.TrimPrefix(req.URL.Path, "/api/admin/tax-data/")
+	parts := strings.Split(path, "/")
+
+	taxYear, err := strconv.Atoi(parts[0])
+	if err != nil {
+		http.Error(w, "Tax year required", http.StatusBadRequest)
+		return
+	}
+
+	if len(parts) > 1 {
+		switch parts[1] {
+		case "activate":
+			r.taxDataHandler.HandleActivate(w, req, taxYear)
+		default:
+			http.Error(w, "Not found", http.StatusNotFound)
+		}
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		r.taxDataHandler.HandleGet(w, req, taxYear)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
 // Getter methods for handlers
 func (r *Router) GetUserHandler() *UserHandler {
 	return r.userHandler
@@ -912,3 +986,13 @@ func (r *Router) GetCategoryHandler() *CategoryHandler {
 func (r *Router) GetConfigHandler() *ConfigHandler {
 	return r.configHandler
 }
+
+// GetObservabilityHandler returns the observability handler
+func (r *Router) GetObservabilityHandler() *ObservabilityHandler {
+	return r.obsHandler
+}
+
+// GetTaxDataHandler returns the tax data handler
+func (r *Router) GetTaxDataHandler() *TaxDataHandler {
+	return r.taxDataHandler
+}
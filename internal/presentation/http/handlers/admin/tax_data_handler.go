@@ -0,0 +1,140 @@
+package admin
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	appRetirement "clockzen-next/internal/application/retirement"
+)
+
+// maxTaxYearDataSize is the largest tax year data file accepted
+const maxTaxYearDataSize = 1 << 20
+
+// TaxYearSummaryResponse represents a loaded tax year data set
+type TaxYearSummaryResponse struct {
+	TaxYear      int    `json:"tax_year"`
+	FilingStatus string `json:"filing_status"`
+	Active       bool   `json:"active"`
+}
+
+// ListTaxDataResponse represents the loaded tax year data sets
+type ListTaxDataResponse struct {
+	Years      []TaxYearSummaryResponse `json:"years"`
+	ActiveYear int                      `json:"active_year"`
+}
+
+// TaxYearDataResponse represents a tax year data set
+type TaxYearDataResponse struct {
+	*appRetirement.TaxYearData
+	Active bool `json:"active"`
+}
+
+// TaxDataHandler handles HTTP requests for the tax brackets and limits
+// retirement analyses use, so a new tax year can be loaded without a
+// redeploy
+type TaxDataHandler struct {
+	store *appRetirement.TaxDataStore
+}
+
+// NewTaxDataHandler creates a new TaxDataHandler for the default tax data
+func NewTaxDataHandler() *TaxDataHandler {
+	return &TaxDataHandler{store: appRetirement.DefaultTaxData()}
+}
+
+// HandleList handles GET /api/admin/tax-data
+func (h *TaxDataHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	active := h.store.Active().TaxYear
+	resp := ListTaxDataResponse{
+		Years:      []TaxYearSummaryResponse{},
+		ActiveYear: active,
+	}
+	for _, taxYear := range h.store.Years() {
+		data, _ := h.store.Get(taxYear)
+		resp.Years = append(resp.Years, TaxYearSummaryResponse{
+			TaxYear:      taxYear,
+			FilingStatus: data.FilingStatus,
+			Active:       taxYear == active,
+		})
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleInstall handles POST /api/admin/tax-data, loading a tax year data
+// file and making it active when ?activate=true
+func (h *TaxDataHandler) HandleInstall(w http.ResponseWriter, r *http.Request) {
+	activate := false
+	if v := r.URL.Query().Get("activate"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			h.writeError(w, http.StatusBadRequest, "invalid_parameter", "activate must be true or false")
+			return
+		}
+		activate = parsed
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxTaxYearDataSize))
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+	if _, err := appRetirement.ParseTaxYearData(body); err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+	data, err := h.store.Install(r.Context(), body, activate)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "internal_error", "Failed to install tax year data")
+		return
+	}
+	h.writeJSON(w, http.StatusCreated, TaxYearDataResponse{
+		TaxYearData: data,
+		Active:      h.store.Active().TaxYear == data.TaxYear,
+	})
+}
+
+// HandleGet handles GET /api/admin/tax-data/{year}
+func (h *TaxDataHandler) HandleGet(w http.ResponseWriter, r *http.Request, taxYear int) {
+	data, ok := h.store.Get(taxYear)
+	if !ok {
+		h.writeError(w, http.StatusNotFound, "not_found", "Tax year data not found")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, TaxYearDataResponse{
+		TaxYearData: data,
+		Active:      h.store.Active().TaxYear == taxYear,
+	})
+}
+
+// HandleActivate handles POST /api/admin/tax-data/{year}/activate
+func (h *TaxDataHandler) HandleActivate(w http.ResponseWriter, r *http.Request, taxYear int) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+	if err := h.store.Activate(taxYear); err != nil {
+		h.writeError(w, http.StatusNotFound, "not_found", err.Error())
+		return
+	}
+	data, _ := h.store.Get(taxYear)
+	h.writeJSON(w, http.StatusOK, TaxYearDataResponse{
+		TaxYearData: data,
+		Active:      true,
+	})
+}
+
+// writeJSON writes a JSON response
+func (h *TaxDataHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *TaxDataHandler) writeError(w http.ResponseWriter, status int, errCode string, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
@@ -0,0 +1,296 @@
+package alerts
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"clockzen-next/internal/application/alerts"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// ErrorResponse represents an error response
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// AlertResponse represents an alert
+type AlertResponse struct {
+	ID             string                 `json:"id"`
+	Kind           string                 `json:"kind"`
+	Severity       string                 `json:"severity"`
+	Title          string                 `json:"title"`
+	Message        string                 `json:"message"`
+	Data           map[string]interface{} `json:"data,omitempty"`
+	DeliveryStatus string                 `json:"delivery_status"`
+	DeliveryError  *string                `json:"delivery_error,omitempty"`
+	DeliveredAt    *time.Time             `json:"delivered_at,omitempty"`
+	ReadAt         *time.Time             `json:"read_at,omitempty"`
+	CreatedAt      time.Time              `json:"created_at"`
+}
+
+// ListAlertsResponse represents a list of alerts
+type ListAlertsResponse struct {
+	Alerts []AlertResponse `json:"alerts"`
+	Total  int             `json:"total"`
+}
+
+// PreferencesRequest represents a request to change alert preferences.
+// Fields left out are unchanged; an empty email, webhook_url or push_token
+// turns that channel off.
+type PreferencesRequest struct {
+	AnomalyAlerts          *bool              `json:"anomaly_alerts,omitempty"`
+	BudgetAlerts           *bool              `json:"budget_alerts,omitempty"`
+	BudgetLimits           map[string]float64 `json:"budget_limits,omitempty"`
+	BudgetThresholdPercent *float64           `json:"budget_threshold_percent,omitempty"`
+	MinSeverity            *string            `json:"min_severity,omitempty"`
+	Email                  *string            `json:"email,omitempty"`
+	WebhookURL             *string            `json:"webhook_url,omitempty"`
+	PushToken              *string            `json:"push_token,omitempty"`
+	Digest                 *string            `json:"digest,omitempty"`
+	WeeklySummary          *bool              `json:"weekly_summary,omitempty"`
+	MonthlyReport          *bool              `json:"monthly_report,omitempty"`
+}
+
+// PreferencesResponse represents a user's alert preferences
+type PreferencesResponse struct {
+	AnomalyAlerts          bool               `json:"anomaly_alerts"`
+	BudgetAlerts           bool               `json:"budget_alerts"`
+	BudgetLimits           map[string]float64 `json:"budget_limits"`
+	BudgetThresholdPercent float64            `json:"budget_threshold_percent"`
+	MinSeverity            string             `json:"min_severity"`
+	Email                  *string            `json:"email,omitempty"`
+	WebhookURL             *string            `json:"webhook_url,omitempty"`
+	PushToken              *string            `json:"push_token,omitempty"`
+	Digest                 string             `json:"digest"`
+	WeeklySummary          bool               `json:"weekly_summary"`
+	MonthlyReport          bool               `json:"monthly_report"`
+	LastDigestAt           *time.Time         `json:"last_digest_at,omitempty"`
+}
+
+// AlertHandler handles HTTP requests for alerts and alert preferences
+type AlertHandler struct {
+	service *alerts.Service
+}
+
+// NewAlertHandler creates a new AlertHandler instance
+func NewAlertHandler(service *alerts.Service) *AlertHandler {
+	return &AlertHandler{
+		service: service,
+	}
+}
+
+// HandleListAlerts handles GET /api/alerts
+func (h *AlertHandler) HandleListAlerts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	query := r.URL.Query()
+	filter := alerts.AlertFilter{
+		UnreadOnly: query.Get("unread") == "true",
+		Kind:       query.Get("kind"),
+	}
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			h.writeError(w, http.StatusBadRequest, "validation_error", "limit must be a positive integer")
+			return
+		}
+		filter.Limit = limit
+	}
+
+	records, err := h.service.ListAlerts(r.Context(), userID, filter)
+	if err != nil {
+		if errors.Is(err, alerts.ErrInvalidKind) {
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to list alerts: "+err.Error())
+		return
+	}
+	h.writeJSON(w, http.StatusOK, toListResponse(records))
+}
+
+// HandleEvaluate handles POST /api/alerts/evaluate
+func (h *AlertHandler) HandleEvaluate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	records, err := h.service.Evaluate(r.Context(), userID, time.Now())
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "evaluation_failed", "Failed to evaluate alerts: "+err.Error())
+		return
+	}
+	h.writeJSON(w, http.StatusOK, toListResponse(records))
+}
+
+// HandleMarkRead handles POST /api/alerts/{id}/read
+func (h *AlertHandler) HandleMarkRead(w http.ResponseWriter, r *http.Request, alertID string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	record, err := h.service.MarkRead(r.Context(), userID, alertID)
+	if err != nil {
+		if errors.Is(err, alerts.ErrAlertNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Alert not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "update_failed", "Failed to mark alert read: "+err.Error())
+		return
+	}
+	h.writeJSON(w, http.StatusOK, toAlertResponse(record))
+}
+
+// HandleGetPreferences handles GET /api/alerts/preferences
+func (h *AlertHandler) HandleGetPreferences(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	preferences, err := h.service.GetPreferences(r.Context(), userID)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get preferences: "+err.Error())
+		return
+	}
+	h.writeJSON(w, http.StatusOK, toPreferencesResponse(preferences))
+}
+
+// HandleUpdatePreferences handles PUT /api/alerts/preferences
+func (h *AlertHandler) HandleUpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut && r.Method != http.MethodPatch {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only PUT or PATCH method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req PreferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	preferences, err := h.service.UpdatePreferences(r.Context(), userID, alerts.PreferencesUpdate{
+		AnomalyAlerts:          req.AnomalyAlerts,
+		BudgetAlerts:           req.BudgetAlerts,
+		BudgetLimits:           req.BudgetLimits,
+		BudgetThresholdPercent: req.BudgetThresholdPercent,
+		MinSeverity:            req.MinSeverity,
+		Email:                  req.Email,
+		WebhookURL:             req.WebhookURL,
+		PushToken:              req.PushToken,
+		Digest:                 req.Digest,
+		WeeklySummary:          req.WeeklySummary,
+		MonthlyReport:          req.MonthlyReport,
+	})
+	if err != nil {
+		if errors.Is(err, alerts.ErrInvalidPreferences) {
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "update_failed", "Failed to update preferences: "+err.Error())
+		return
+	}
+	h.writeJSON(w, http.StatusOK, toPreferencesResponse(preferences))
+}
+
+// toListResponse converts alerts to a list response
+func toListResponse(records []*ent.Alert) ListAlertsResponse {
+	resp := ListAlertsResponse{
+		Alerts: make([]AlertResponse, len(records)),
+		Total:  len(records),
+	}
+	for i, record := range records {
+		resp.Alerts[i] = toAlertResponse(record)
+	}
+	return resp
+}
+
+// toAlertResponse converts an alert to its response
+func toAlertResponse(a *ent.Alert) AlertResponse {
+	return AlertResponse{
+		ID:             a.ID,
+		Kind:           string(a.Kind),
+		Severity:       string(a.Severity),
+		Title:          a.Title,
+		Message:        a.Message,
+		Data:           a.Data,
+		DeliveryStatus: string(a.DeliveryStatus),
+		DeliveryError:  a.DeliveryError,
+		DeliveredAt:    a.DeliveredAt,
+		ReadAt:         a.ReadAt,
+		CreatedAt:      a.CreatedAt,
+	}
+}
+
+// toPreferencesResponse converts alert preferences to their response
+func toPreferencesResponse(p *alerts.Preferences) PreferencesResponse {
+	return PreferencesResponse{
+		AnomalyAlerts:          p.AnomalyAlerts,
+		BudgetAlerts:           p.BudgetAlerts,
+		BudgetLimits:           p.BudgetLimits,
+		BudgetThresholdPercent: p.BudgetThresholdPercent,
+		MinSeverity:            string(p.MinSeverity),
+		Email:                  p.Email,
+		WebhookURL:             p.WebhookURL,
+		PushToken:              p.PushToken,
+		Digest:                 string(p.Digest),
+		WeeklySummary:          p.WeeklySummary,
+		MonthlyReport:          p.MonthlyReport,
+		LastDigestAt:           p.LastDigestAt,
+	}
+}
+
+// writeJSON writes a JSON response
+func (h *AlertHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *AlertHandler) writeError(w http.ResponseWriter, status int, errCode string, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
@@ -0,0 +1,91 @@
+package alerts
+
+import (
+	"net/http"
+	"strings"
+
+	"clockzen-next/internal/application/alerts"
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/ent"
+)
+
+// Router handles routing for alert endpoints
+type Router struct {
+	handler *AlertHandler
+}
+
+// NewRouter creates a new Router with the given handler
+func NewRouter(handler *AlertHandler) *Router {
+	return &Router{
+		handler: handler,
+	}
+}
+
+// NewDefaultRouter creates a new Router backed by the given ent client,
+// evaluating the transactions in transactions
+func NewDefaultRouter(entClient *ent.Client, transactions analysis.TransactionRepository) *Router {
+	return &Router{
+		handler: NewAlertHandler(alerts.NewService(entClient, transactions)),
+	}
+}
+
+// RegisterRoutes registers all alert routes with the given mux
+// Total routes: 5 endpoints
+//
+// Alerts belong to the authenticated user. The worker evaluates every
+// user's spending hourly: anomalies over the last 7 days, and this month's
+// spending by category against the budget_limits in their preferences
+// (a high severity alert over a limit, a medium one past
+// budget_threshold_percent of it). Each finding is alerted once.
+//
+// Alerts at or above the user's min_severity are delivered to each channel
+// they set up (email, webhook_url, push_token), as they are raised or in an
+// hourly or daily digest. The rest, and those with no channel, are skipped
+// but still listed. Webhooks receive a JSON POST of {user_id, digest,
+// alerts}.
+//
+// weekly_summary (on by default) emails a summary of the week's syncs,
+// receipts and spending every Monday to email, or to the account of a
+// connected mailbox or drive if email is not set. monthly_report (on by
+// default) emails the spending report for the month just ended, as
+// /api/reports/monthly generates, to the same address.
+//
+//  1. GET    /api/alerts               - List alerts, the latest first (with ?unread=true, ?kind, ?limit)
+//  2. POST   /api/alerts/evaluate      - Evaluate the user's spending now; returns the alerts raised
+//  3. POST   /api/alerts/{id}/read     - Mark an alert read
+//  4. GET    /api/alerts/preferences   - Get alert preferences
+//  5. PUT    /api/alerts/preferences   - Change alert preferences (also PATCH)
+func (r *Router) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/alerts", r.handler.HandleListAlerts)
+	mux.HandleFunc("/api/alerts/", r.handleAlertsByPath)
+}
+
+// handleAlertsByPath routes requests for /api/alerts/evaluate,
+// /api/alerts/preferences and /api/alerts/{id}/read
+func (r *Router) handleAlertsByPath(w http.ResponseWriter, req *http.Request) {
+	path := strings.TrimPrefix(req.URL.Path, "/api/alerts/")
+	parts := strings.Split(path, "/")
+
+	switch {
+	case len(parts) == 1 && parts[0] == "evaluate":
+		r.handler.HandleEvaluate(w, req)
+	case len(parts) == 1 && parts[0] == "preferences":
+		switch req.Method {
+		case http.MethodGet:
+			r.handler.HandleGetPreferences(w, req)
+		case http.MethodPut, http.MethodPatch:
+			r.handler.HandleUpdatePreferences(w, req)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	case len(parts) == 2 && parts[0] != "" && parts[1] == "read":
+		r.handler.HandleMarkRead(w, req, parts[0])
+	default:
+		http.Error(w, "Not found", http.StatusNotFound)
+	}
+}
+
+// GetHandler returns the alert handler
+func (r *Router) GetHandler() *AlertHandler {
+	return r.handler
+}
@@ -0,0 +1,119 @@
+package analysis
+
+import (
+	"net/http"
+
+	"clockzen-next/internal/application/dto"
+	"clockzen-next/internal/presentation/http/export"
+)
+
+// negotiateFormat returns the format the request asks for, writing the
+// error response when it can't be served
+func (h *AnalysisHandler) negotiateFormat(w http.ResponseWriter, r *http.Request) (export.Format, bool) {
+	format, err := export.Negotiate(r)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_parameter", err.Error())
+		return "", false
+	}
+	return format, true
+}
+
+// writeResult writes a spending analysis or backtest as JSON, or as a
+// table in format. Other results can only be sent as JSON.
+func (h *AnalysisHandler) writeResult(w http.ResponseWriter, format export.Format, result any) {
+	if format == export.FormatJSON {
+		h.writeJSON(w, http.StatusOK, result)
+		return
+	}
+
+	var filename, sheet string
+	var write func(export.Table) error
+	switch result := result.(type) {
+	case *dto.SpendingAnalysisResponse:
+		filename = "spending-" + result.StartDate.Format("2006-01-02") + "-" + result.EndDate.Format("2006-01-02")
+		sheet = "Spending"
+		write = spendingTable(result)
+	case *dto.BacktestResponse:
+		filename = "backtest-" + result.StartDate.Format("2006-01-02") + "-" + result.EndDate.Format("2006-01-02")
+		sheet = "Backtest"
+		write = backtestTable(result)
+	default:
+		h.writeError(w, http.StatusNotAcceptable, "not_exportable", "Only spending analyses and backtests can be exported as "+string(format))
+		return
+	}
+	// Nothing more can be reported once the body has started
+	_ = export.Stream(w, format, filename, sheet, write)
+}
+
+// spendingTable writes a spending analysis as a row for each period,
+// followed by a row for each category spent in that period
+func spendingTable(resp *dto.SpendingAnalysisResponse) func(export.Table) error {
+	return func(table export.Table) error {
+		if err := table.WriteHeader(
+			"period_start", "period_end", "category",
+			"amount", "transaction_count", "percentage", "average_transaction",
+		); err != nil {
+			return err
+		}
+		for _, period := range resp.Periods {
+			var average any
+			if period.TransactionCount > 0 {
+				average = period.TotalAmount / float64(period.TransactionCount)
+			}
+			if err := table.WriteRow(
+				period.StartDate, period.EndDate, nil,
+				period.TotalAmount, period.TransactionCount, nil, average,
+			); err != nil {
+				return err
+			}
+			for _, c := range period.ByCategory {
+				if err := table.WriteRow(
+					period.StartDate, period.EndDate, c.Category,
+					c.Amount, c.TransactionCount, c.Percentage, c.AverageTransaction,
+				); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// backtestTable writes a backtest as a row for each period, followed by a
+// row for each of the budget's categories in that period
+func backtestTable(resp *dto.BacktestResponse) func(export.Table) error {
+	return func(table export.Table) error {
+		if err := table.WriteHeader(
+			"period_start", "period_end", "category",
+			"budgeted_amount", "actual_amount", "variance", "variance_percent", "performance",
+			"transaction_count", "largest_expense", "average_daily",
+		); err != nil {
+			return err
+		}
+		for _, period := range resp.PeriodResults {
+			if err := table.WriteRow(
+				period.PeriodStart, period.PeriodEnd, nil,
+				period.BudgetedAmount, period.ActualAmount, period.Variance, period.VariancePercent, string(period.Performance),
+				period.TransactionCount, period.LargestExpense, period.AverageDaily,
+			); err != nil {
+				return err
+			}
+			for _, c := range period.CategoryResults {
+				// A category's percentage is of the period's spending, not
+				// of its budget, so its variance percent is worked out here
+				var variancePercent any
+				if c.BudgetAmount > 0 {
+					variancePercent = c.Variance / c.BudgetAmount * 100
+				}
+				if err := table.WriteRow(
+					period.PeriodStart, period.PeriodEnd, c.Category,
+					c.BudgetAmount, c.ActualAmount, c.Variance, variancePercent, string(c.Performance),
+					nil, nil, nil,
+				); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,106 @@
+package analysis
+
+import (
+	"encoding/csv"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/application/dto"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// exportCSV streams a table as CSV and reads it back
+func exportCSV(t *testing.T, h *AnalysisHandler, result any) [][]string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.writeResult(rec, "csv", result)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	records, err := csv.NewReader(rec.Body).ReadAll()
+	require.NoError(t, err)
+	return records
+}
+
+func TestSpendingTable(t *testing.T) {
+	start := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	resp := &dto.SpendingAnalysisResponse{
+		StartDate: start,
+		EndDate:   start.AddDate(0, 2, -1),
+		Periods: []dto.PeriodSpendingResponse{
+			{
+				StartDate: start, EndDate: start.AddDate(0, 1, -1), TotalAmount: 150, TransactionCount: 3,
+				ByCategory: []dto.CategorySpendingResponse{
+					{Category: "groceries", Amount: 100, TransactionCount: 2, Percentage: 66.67, AverageTransaction: 50},
+					{Category: "dining", Amount: 50, TransactionCount: 1, Percentage: 33.33, AverageTransaction: 50},
+				},
+			},
+			{StartDate: start.AddDate(0, 1, 0), EndDate: start.AddDate(0, 2, -1)},
+		},
+	}
+
+	assert.Equal(t, [][]string{
+		{"period_start", "period_end", "category", "amount", "transaction_count", "percentage", "average_transaction"},
+		{"2026-09-01", "2026-09-30", "", "150", "3", "", "50"},
+		{"2026-09-01", "2026-09-30", "groceries", "100", "2", "66.67", "50"},
+		{"2026-09-01", "2026-09-30", "dining", "50", "1", "33.33", "50"},
+		{"2026-10-01", "2026-10-31", "", "0", "0", "", ""},
+	}, exportCSV(t, NewAnalysisHandler(), resp))
+}
+
+func TestBacktestTable(t *testing.T) {
+	start := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	resp := &dto.BacktestResponse{
+		StartDate: start,
+		EndDate:   start.AddDate(0, 1, 0),
+		PeriodResults: []dto.PeriodBacktestResponse{{
+			PeriodStart: start, PeriodEnd: start.AddDate(0, 1, 0),
+			BudgetedAmount: 500, ActualAmount: 450, Variance: 50, VariancePercent: 10,
+			Performance: dto.BudgetPerformanceGood, TransactionCount: 12, LargestExpense: 120, AverageDaily: 15,
+			CategoryResults: []dto.CategoryAllocationResponse{
+				{Category: "dining", BudgetAmount: 200, ActualAmount: 250, Variance: -50, Percentage: 55.56, Performance: dto.BudgetPerformancePoor},
+				{Category: "gifts", ActualAmount: 10, Variance: -10},
+			},
+		}},
+	}
+
+	records := exportCSV(t, NewAnalysisHandler(), resp)
+	require.Len(t, records, 4)
+	assert.Equal(t, []string{"2026-09-01", "2026-10-01", "", "500", "450", "50", "10", "good", "12", "120", "15"}, records[1])
+	assert.Equal(t, []string{"2026-09-01", "2026-10-01", "dining", "200", "250", "-50", "-25", "poor", "", "", ""}, records[2])
+	// Nothing budgeted has no variance percent
+	assert.Equal(t, "", records[3][6])
+}
+
+func TestSpendingAnalysisExport(t *testing.T) {
+	h := NewAnalysisHandler()
+	body := `{"start_date":"2026-01-01T00:00:00Z","end_date":"2026-03-31T00:00:00Z","period":"monthly"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/analysis/spending", strings.NewReader(body))
+	req.Header.Set("Accept", "text/csv")
+	req = req.WithContext(middleware.WithUser(req.Context(), &middleware.User{ID: "user-1"}))
+	rec := httptest.NewRecorder()
+
+	h.HandleSpendingAnalysis(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="spending-2026-01-01-2026-03-31.csv"`, rec.Header().Get("Content-Disposition"))
+	assert.True(t, strings.HasPrefix(rec.Body.String(), "period_start,period_end,category,"))
+
+	// Unknown formats are refused before anything is run
+	req = httptest.NewRequest(http.MethodPost, "/api/analysis/spending?format=pdf", strings.NewReader(body))
+	req = req.WithContext(middleware.WithUser(req.Context(), &middleware.User{ID: "user-1"}))
+	rec = httptest.NewRecorder()
+	h.HandleSpendingAnalysis(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestWriteResultNotExportable(t *testing.T) {
+	rec := httptest.NewRecorder()
+	NewAnalysisHandler().writeResult(rec, "xlsx", &dto.TrendAnalysisResponse{})
+	assert.Equal(t, http.StatusNotAcceptable, rec.Code)
+	assert.Contains(t, rec.Body.String(), "not_exportable")
+}
@@ -1,17 +1,26 @@
 package analysis
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"math"
 	"math/rand"
 	"net/http"
 	"sort"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 
+	"clockzen-next/internal/application/analysis"
 	"clockzen-next/internal/application/dto"
+	"clockzen-next/internal/application/jobs"
+	"clockzen-next/internal/infrastructure/i18n"
+	"clockzen-next/internal/presentation/http/export"
+	"clockzen-next/internal/presentation/http/middleware"
 )
 
 // ErrorResponse represents an error response
@@ -36,8 +45,15 @@ type AnalysisResult struct {
 
 // AnalysisHandler handles HTTP requests for analysis
 type AnalysisHandler struct {
-	mu       sync.RWMutex
-	analyses map[string]*AnalysisResult
+	mu           sync.RWMutex
+	analyses     map[string]*AnalysisResult
+	jobs         *jobs.Service
+	transactions analysis.TransactionRepository
+	spending     *analysis.SpendingService
+	debts        analysis.DebtPayoffPlanner
+	funds        analysis.EmergencyFundMonitor
+	cycles       analysis.StatementCycleRepository
+	realloc      analysis.ReallocationRepository
 }
 
 // NewAnalysisHandler creates a new AnalysisHandler instance
@@ -47,6 +63,13 @@ func NewAnalysisHandler() *AnalysisHandler {
 	}
 }
 
+// SetJobService enables ?async=true backtests on the given job service
+func (h *AnalysisHandler) SetJobService(service *jobs.Service) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.jobs = service
+}
+
 // HandleSpendingAnalysis handles POST /api/analysis/spending
 func (h *AnalysisHandler) HandleSpendingAnalysis(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -54,16 +77,22 @@ func (h *AnalysisHandler) HandleSpendingAnalysis(w http.ResponseWriter, r *http.
 		return
 	}
 
+	format, ok := h.negotiateFormat(w, r)
+	if !ok {
+		return
+	}
+
 	var req dto.SpendingAnalysisRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
 		return
 	}
 
-	if req.UserID == "" {
-		h.writeError(w, http.StatusBadRequest, "validation_error", "user_id is required")
+	userID, ok := h.requestUser(w, r, req.UserID)
+	if !ok {
 		return
 	}
+	req.UserID = userID
 
 	if req.EndDate.Before(req.StartDate) {
 		h.writeError(w, http.StatusBadRequest, "validation_error", "end_date must be after start_date")
@@ -74,9 +103,16 @@ func (h *AnalysisHandler) HandleSpendingAnalysis(w http.ResponseWriter, r *http.
 	if period == "" {
 		period = dto.TimePeriodMonthly
 	}
+	if period == dto.TimePeriodStatement && req.CardAccountID == "" {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "card_account_id is required for statement periods")
+		return
+	}
 
-	// Generate mock spending analysis
-	response := h.generateSpendingAnalysis(req.UserID, req.StartDate, req.EndDate, period)
+	response, err := h.spendingAnalysis(r.Context(), req.UserID, req.StartDate, req.EndDate, period, req.CardAccountID)
+	if err != nil {
+		h.writeAnalysisError(w, err)
+		return
+	}
 
 	// Store the analysis result
 	now := time.Now()
@@ -96,7 +132,7 @@ func (h *AnalysisHandler) HandleSpendingAnalysis(w http.ResponseWriter, r *http.
 	h.analyses[analysis.ID] = analysis
 	h.mu.Unlock()
 
-	h.writeJSON(w, http.StatusOK, response)
+	h.writeResult(w, format, response)
 }
 
 // HandleTrendAnalysis handles POST /api/analysis/trends
@@ -112,10 +148,11 @@ func (h *AnalysisHandler) HandleTrendAnalysis(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	if req.UserID == "" {
-		h.writeError(w, http.StatusBadRequest, "validation_error", "user_id is required")
+	userID, ok := h.requestUser(w, r, req.UserID)
+	if !ok {
 		return
 	}
+	req.UserID = userID
 
 	if req.EndDate.Before(req.StartDate) {
 		h.writeError(w, http.StatusBadRequest, "validation_error", "end_date must be after start_date")
@@ -127,8 +164,11 @@ func (h *AnalysisHandler) HandleTrendAnalysis(w http.ResponseWriter, r *http.Req
 		period = dto.TimePeriodMonthly
 	}
 
-	// Generate mock trend analysis
-	response := h.generateTrendAnalysis(req.UserID, req.StartDate, req.EndDate, period)
+	response, err := h.trendAnalysis(r.Context(), req.UserID, req.StartDate, req.EndDate, period)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "analysis_failed", "Failed to run analysis: "+err.Error())
+		return
+	}
 
 	// Store the analysis result
 	now := time.Now()
@@ -164,18 +204,22 @@ func (h *AnalysisHandler) HandleAnomalyDetection(w http.ResponseWriter, r *http.
 		return
 	}
 
-	if req.UserID == "" {
-		h.writeError(w, http.StatusBadRequest, "validation_error", "user_id is required")
+	userID, ok := h.requestUser(w, r, req.UserID)
+	if !ok {
 		return
 	}
+	req.UserID = userID
 
 	if req.EndDate.Before(req.StartDate) {
 		h.writeError(w, http.StatusBadRequest, "validation_error", "end_date must be after start_date")
 		return
 	}
 
-	// Generate mock anomaly detection
-	response := h.generateAnomalyDetection(req.UserID, req.StartDate, req.EndDate)
+	response, err := h.anomalyDetection(r.Context(), req.UserID, req.StartDate, req.EndDate)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "analysis_failed", "Failed to run analysis: "+err.Error())
+		return
+	}
 
 	// Store the analysis result
 	now := time.Now()
@@ -205,16 +249,27 @@ func (h *AnalysisHandler) HandleBacktest(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	shape, err := parseResponseShape(r)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_parameter", err.Error())
+		return
+	}
+	format, ok := h.negotiateFormat(w, r)
+	if !ok {
+		return
+	}
+
 	var req dto.BacktestRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
 		return
 	}
 
-	if req.UserID == "" {
-		h.writeError(w, http.StatusBadRequest, "validation_error", "user_id is required")
+	userID, ok := h.requestUser(w, r, req.UserID)
+	if !ok {
 		return
 	}
+	req.UserID = userID
 
 	if req.Budget.Name == "" {
 		h.writeError(w, http.StatusBadRequest, "validation_error", "budget name is required")
@@ -226,8 +281,25 @@ func (h *AnalysisHandler) HandleBacktest(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// Generate mock backtest result
-	response := h.generateBacktest(req.UserID, req.Budget, req.StartDate, req.EndDate)
+	if req.Budget.Period == string(analysis.BacktestPeriodStatement) && req.Budget.CardAccountID == "" {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "budget card_account_id is required for statement periods")
+		return
+	}
+
+	if async, _ := strconv.ParseBool(r.URL.Query().Get("async")); async {
+		if format != export.FormatJSON {
+			h.writeError(w, http.StatusBadRequest, "invalid_parameter", "async backtests are exported from /api/analysis/{id} once complete")
+			return
+		}
+		h.submitBacktestJob(w, r, req, shape)
+		return
+	}
+
+	response, err := h.backtest(r.Context(), i18n.FromContext(r.Context()), req.UserID, req.Budget, req.StartDate, req.EndDate)
+	if err != nil {
+		h.writeAnalysisError(w, err)
+		return
+	}
 
 	// Store the analysis result
 	now := time.Now()
@@ -247,7 +319,321 @@ func (h *AnalysisHandler) HandleBacktest(w http.ResponseWriter, r *http.Request)
 	h.analyses[analysis.ID] = analysis
 	h.mu.Unlock()
 
-	h.writeJSON(w, http.StatusOK, response)
+	// The full result is stored; only the response is shaped
+	h.writeResult(w, format, shapeBacktestResponse(response, shape))
+}
+
+// backtestJobParams are the parameters of a budget backtest job
+type backtestJobParams struct {
+	Budget    dto.BudgetRequest      `json:"budget"`
+	StartDate time.Time              `json:"start_date"`
+	EndDate   time.Time              `json:"end_date"`
+	Locale    string                 `json:"locale,omitempty"`
+	Shape     analysis.ResponseShape `json:"shape"`
+}
+
+// anomalyScanJobParams are the parameters of an anomaly scan job
+type anomalyScanJobParams struct {
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+}
+
+// billNegotiationJobParams are the parameters of a bill negotiation scan job
+type billNegotiationJobParams struct {
+	AsOf               time.Time `json:"as_of"`
+	MinIncreasePercent float64   `json:"min_increase_percent,omitempty"`
+}
+
+// submitBacktestJob queues a backtest on the job service and responds with
+// 202 Accepted. The analysis is stored as pending and completed once the
+// worker finishes the job.
+func (h *AnalysisHandler) submitBacktestJob(w http.ResponseWriter, r *http.Request, req dto.BacktestRequest, shape analysis.ResponseShape) {
+	h.mu.RLock()
+	service := h.jobs
+	h.mu.RUnlock()
+
+	if service == nil {
+		h.writeError(w, http.StatusServiceUnavailable, "async_unavailable", "Asynchronous runs are not enabled")
+		return
+	}
+
+	// The worker renders text in the request's language
+	job, err := service.Submit(r.Context(), req.UserID, jobs.JobTypeBudgetBacktest, backtestJobParams{
+		Budget:    req.Budget,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		Locale:    i18n.FromContext(r.Context()).Language().String(),
+		Shape:     shape,
+	})
+	if err != nil {
+		h.writeError(w, http.StatusServiceUnavailable, "queue_failed", "Failed to queue backtest: "+err.Error())
+		return
+	}
+
+	result := &AnalysisResult{
+		ID:        uuid.New().String(),
+		UserID:    req.UserID,
+		Type:      dto.AnalysisTypeBacktest,
+		Status:    dto.AnalysisStatusPending,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		CreatedAt: time.Now(),
+	}
+
+	h.mu.Lock()
+	h.analyses[result.ID] = result
+	h.mu.Unlock()
+
+	go h.trackBacktestJob(service, result, job.ID)
+
+	h.writeJSON(w, http.StatusAccepted, dto.JobAcceptedResponse{
+		JobID:     job.ID,
+		Status:    string(job.Status),
+		StatusURL: "/api/jobs/" + job.ID,
+		StreamURL: "/api/jobs/" + job.ID + "/stream",
+	})
+}
+
+// trackBacktestJob follows a backtest job and completes its stored analysis
+// with the job's outcome. The job returns the shaped response, so that is
+// what the analysis keeps.
+func (h *AnalysisHandler) trackBacktestJob(service *jobs.Service, result *AnalysisResult, jobID string) {
+	updates, err := service.Watch(context.Background(), jobID)
+	if err != nil {
+		return
+	}
+
+	for job := range updates {
+		h.mu.Lock()
+		switch job.Status {
+		case jobs.JobStatusRunning:
+			result.Status = dto.AnalysisStatusRunning
+		case jobs.JobStatusCompleted:
+			var response dto.BacktestResponse
+			if err := job.DecodeResult(&response); err != nil {
+				result.Status = dto.AnalysisStatusFailed
+				result.Error = err.Error()
+				break
+			}
+			result.Status = dto.AnalysisStatusCompleted
+			result.Result = &response
+			result.CompletedAt = job.CompletedAt
+		case jobs.JobStatusFailed, jobs.JobStatusCancelled:
+			result.Status = dto.AnalysisStatusFailed
+			result.Error = job.Error
+		}
+		h.mu.Unlock()
+	}
+}
+
+// RegisterJobHandlers runs budget backtest, anomaly scan and bill
+// negotiation scan jobs with this handler. It is called by the worker
+// process, which needs the same transaction repositories as the API.
+func (h *AnalysisHandler) RegisterJobHandlers(service *jobs.Service) error {
+	if err := service.RegisterHandler(jobs.JobTypeBudgetBacktest, h.runBacktestJob); err != nil {
+		return err
+	}
+	if err := service.RegisterHandler(jobs.JobTypeAnomalyScan, h.runAnomalyScanJob); err != nil {
+		return err
+	}
+	return service.RegisterHandler(jobs.JobTypeBillNegotiationScan, h.runBillNegotiationScanJob)
+}
+
+// runBacktestJob runs a budget backtest job on the worker
+func (h *AnalysisHandler) runBacktestJob(ctx context.Context, job *jobs.Job, report jobs.ProgressFunc) (any, error) {
+	var params backtestJobParams
+	if err := job.DecodeParams(&params); err != nil {
+		return nil, fmt.Errorf("decoding job params: %w", err)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	loc := i18n.Default().Localizer(params.Locale)
+	response, err := h.backtest(ctx, loc, job.UserID, params.Budget, params.StartDate, params.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	return shapeBacktestResponse(response, params.Shape), nil
+}
+
+// runAnomalyScanJob runs an anomaly scan job on the worker
+func (h *AnalysisHandler) runAnomalyScanJob(ctx context.Context, job *jobs.Job, report jobs.ProgressFunc) (any, error) {
+	var params anomalyScanJobParams
+	if err := job.DecodeParams(&params); err != nil {
+		return nil, fmt.Errorf("decoding job params: %w", err)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return h.anomalyDetection(ctx, job.UserID, params.StartDate, params.EndDate)
+}
+
+// runBillNegotiationScanJob runs a bill negotiation scan job on the worker
+func (h *AnalysisHandler) runBillNegotiationScanJob(ctx context.Context, job *jobs.Job, report jobs.ProgressFunc) (any, error) {
+	var params billNegotiationJobParams
+	if err := job.DecodeParams(&params); err != nil {
+		return nil, fmt.Errorf("decoding job params: %w", err)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if _, service := h.transactionRepository(); service == nil {
+		return nil, errors.New("bill negotiation needs stored transactions")
+	}
+
+	return h.billNegotiation(ctx, job.UserID, params.AsOf, params.MinIncreasePercent)
+}
+
+// Defaults for scheduled analysis runs
+const (
+	defaultAnomalyScanLookbackDays = 7
+	defaultBacktestRefreshMonths   = 12
+)
+
+// RegisterScheduledJobs makes anomaly scans, bill negotiation scans and
+// budget backtests available as recurring schedules
+func (h *AnalysisHandler) RegisterScheduledJobs(scheduler *jobs.Scheduler) error {
+	err := scheduler.RegisterRunner(jobs.JobTypeAnomalyScan, jobs.Runner{
+		Params:     h.anomalyScanParams,
+		OnComplete: h.storeAnomalyScan,
+	})
+	if err != nil {
+		return err
+	}
+	err = scheduler.RegisterRunner(jobs.JobTypeBillNegotiationScan, jobs.Runner{
+		Params:     h.billNegotiationScanParams,
+		OnComplete: h.storeBillNegotiationScan,
+	})
+	if err != nil {
+		return err
+	}
+	return scheduler.RegisterRunner(jobs.JobTypeBudgetBacktest, jobs.Runner{
+		Params:     h.backtestRefreshParams,
+		OnComplete: h.storeBacktestRefresh,
+	})
+}
+
+// anomalyScanParams scans the schedule owner's spending over the lookback
+// window ending at the time of each run
+func (h *AnalysisHandler) anomalyScanParams(schedule *jobs.Schedule) (any, error) {
+	var params dto.AnomalyScanScheduleParams
+	if len(schedule.Params) > 0 {
+		if err := json.Unmarshal(schedule.Params, &params); err != nil {
+			return nil, err
+		}
+	}
+	if params.LookbackDays < 0 {
+		return nil, fmt.Errorf("lookback_days cannot be negative")
+	}
+	if params.LookbackDays == 0 {
+		params.LookbackDays = defaultAnomalyScanLookbackDays
+	}
+
+	endDate := time.Now()
+	return anomalyScanJobParams{
+		StartDate: endDate.AddDate(0, 0, -params.LookbackDays),
+		EndDate:   endDate,
+	}, nil
+}
+
+// billNegotiationScanParams reviews the schedule owner's bills as of the
+// time of each run
+func (h *AnalysisHandler) billNegotiationScanParams(schedule *jobs.Schedule) (any, error) {
+	var params dto.BillNegotiationScheduleParams
+	if len(schedule.Params) > 0 {
+		if err := json.Unmarshal(schedule.Params, &params); err != nil {
+			return nil, err
+		}
+	}
+	if params.MinIncreasePercent < 0 {
+		return nil, fmt.Errorf("min_increase_percent cannot be negative")
+	}
+
+	return billNegotiationJobParams{
+		AsOf:               time.Now(),
+		MinIncreasePercent: params.MinIncreasePercent,
+	}, nil
+}
+
+// backtestRefreshParams re-runs a budget backtest over the lookback window
+// ending at the time of each run
+func (h *AnalysisHandler) backtestRefreshParams(schedule *jobs.Schedule) (any, error) {
+	var params dto.BacktestScheduleParams
+	if len(schedule.Params) > 0 {
+		if err := json.Unmarshal(schedule.Params, &params); err != nil {
+			return nil, err
+		}
+	}
+	if params.Budget.Name == "" {
+		return nil, fmt.Errorf("budget name is required")
+	}
+	if params.LookbackMonths < 0 {
+		return nil, fmt.Errorf("lookback_months cannot be negative")
+	}
+	if params.LookbackMonths == 0 {
+		params.LookbackMonths = defaultBacktestRefreshMonths
+	}
+
+	endDate := time.Now()
+	return backtestJobParams{
+		Budget:    params.Budget,
+		StartDate: endDate.AddDate(0, -params.LookbackMonths, 0),
+		EndDate:   endDate,
+	}, nil
+}
+
+// storeAnomalyScan records a completed scheduled anomaly scan
+func (h *AnalysisHandler) storeAnomalyScan(schedule jobs.Schedule, job *jobs.Job) {
+	var params anomalyScanJobParams
+	var response dto.AnomalyDetectionResponse
+	if job.DecodeParams(&params) != nil || job.DecodeResult(&response) != nil {
+		return
+	}
+	h.storeResult(schedule.UserID, dto.AnalysisTypeAnomaly, params.StartDate, params.EndDate, &response)
+}
+
+// storeBillNegotiationScan records a completed scheduled bill negotiation
+// scan
+func (h *AnalysisHandler) storeBillNegotiationScan(schedule jobs.Schedule, job *jobs.Job) {
+	var params billNegotiationJobParams
+	var response dto.BillNegotiationResponse
+	if job.DecodeParams(&params) != nil || job.DecodeResult(&response) != nil {
+		return
+	}
+	h.storeResult(schedule.UserID, dto.AnalysisTypeBillNegotiation, params.AsOf.AddDate(-1, 0, 0), params.AsOf, &response)
+}
+
+// storeBacktestRefresh records a completed scheduled backtest refresh
+func (h *AnalysisHandler) storeBacktestRefresh(schedule jobs.Schedule, job *jobs.Job) {
+	var params backtestJobParams
+	var response dto.BacktestResponse
+	if job.DecodeParams(&params) != nil || job.DecodeResult(&response) != nil {
+		return
+	}
+	h.storeResult(schedule.UserID, dto.AnalysisTypeBacktest, params.StartDate, params.EndDate, &response)
+}
+
+// storeResult records the result of a scheduled or remote run so it is listed
+// alongside on-demand analyses
+func (h *AnalysisHandler) storeResult(userID string, analysisType dto.AnalysisType, startDate, endDate time.Time, result any) {
+	now := time.Now()
+	analysis := &AnalysisResult{
+		ID:          uuid.New().String(),
+		UserID:      userID,
+		Type:        analysisType,
+		Status:      dto.AnalysisStatusCompleted,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Result:      result,
+		CreatedAt:   now,
+		CompletedAt: &now,
+	}
+
+	h.mu.Lock()
+	h.analyses[analysis.ID] = analysis
+	h.mu.Unlock()
 }
 
 // HandleWhatIf handles POST /api/analysis/what-if
@@ -263,23 +649,58 @@ func (h *AnalysisHandler) HandleWhatIf(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if req.UserID == "" {
-		h.writeError(w, http.StatusBadRequest, "validation_error", "user_id is required")
+	userID, ok := h.requestUser(w, r, req.UserID)
+	if !ok {
 		return
 	}
+	req.UserID = userID
 
 	if req.ScenarioType == "" {
 		h.writeError(w, http.StatusBadRequest, "validation_error", "scenario_type is required")
 		return
 	}
 
+	if req.RoundUpIncrement < 0 {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "round_up_increment must be positive")
+		return
+	}
+
+	if req.DebtStrategy != "" && req.DebtStrategy != "avalanche" && req.DebtStrategy != "snowball" {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "debt_strategy must be avalanche or snowball")
+		return
+	}
+
+	if req.ExtraDebtPayment < 0 {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "extra_debt_payment must not be negative")
+		return
+	}
+
+	if req.WindfallAmount < 0 || req.WindfallMonth < 0 {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "windfall_amount and windfall_month must not be negative")
+		return
+	}
+
+	if req.WindfallTaxRate < 0 || req.WindfallTaxRate > 1 {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "windfall_tax_rate must be between 0 and 1")
+		return
+	}
+
+	if req.WindfallAccount != "" && req.WindfallAccount != analysis.WindfallAccountTaxable &&
+		req.WindfallAccount != analysis.WindfallAccountInheritedIRA {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "windfall_account must be taxable or inherited_ira")
+		return
+	}
+
 	timeframeMonths := req.TimeframeMonths
 	if timeframeMonths <= 0 {
 		timeframeMonths = 12
 	}
 
-	// Generate mock what-if analysis
-	response := h.generateWhatIfAnalysis(req, timeframeMonths)
+	response, err := h.whatIfAnalysis(r.Context(), req, timeframeMonths)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "analysis_failed", "Failed to run analysis: "+err.Error())
+		return
+	}
 
 	// Store the analysis result
 	now := time.Now()
@@ -315,13 +736,17 @@ func (h *AnalysisHandler) HandleComparePeriods(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	if req.UserID == "" {
-		h.writeError(w, http.StatusBadRequest, "validation_error", "user_id is required")
+	userID, ok := h.requestUser(w, r, req.UserID)
+	if !ok {
 		return
 	}
+	req.UserID = userID
 
-	// Generate mock comparison
-	response := h.generateComparison(req)
+	response, err := h.comparison(r.Context(), req)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "analysis_failed", "Failed to run analysis: "+err.Error())
+		return
+	}
 
 	// Store the analysis result
 	now := time.Now()
@@ -344,6 +769,253 @@ func (h *AnalysisHandler) HandleComparePeriods(w http.ResponseWriter, r *http.Re
 	h.writeJSON(w, http.StatusOK, response)
 }
 
+// HandleSafeToSpend handles POST /api/analysis/safe-to-spend. The number is
+// live, so it isn't stored as an analysis.
+func (h *AnalysisHandler) HandleSafeToSpend(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	var req dto.SafeToSpendRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	userID, ok := h.requestUser(w, r, req.UserID)
+	if !ok {
+		return
+	}
+	req.UserID = userID
+
+	if req.Budget.Name == "" {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "budget name is required")
+		return
+	}
+
+	if req.Category != "" && !analysis.BudgetCategory(req.Category).IsValid() {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "unknown budget category: "+req.Category)
+		return
+	}
+
+	if req.Budget.Period == string(analysis.BacktestPeriodStatement) && req.Budget.CardAccountID == "" {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "budget card_account_id is required for statement periods")
+		return
+	}
+
+	if repo, _ := h.transactionRepository(); repo == nil {
+		h.writeError(w, http.StatusServiceUnavailable, "not_configured", "Safe to spend needs stored transactions")
+		return
+	}
+
+	date := time.Now()
+	if req.Date != nil {
+		date = *req.Date
+	}
+
+	response, err := h.safeToSpend(r.Context(), req.UserID, req.Budget, analysis.BudgetCategory(req.Category), date)
+	if err != nil {
+		h.writeAnalysisError(w, err)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, response)
+}
+
+// HandleBillNegotiation handles POST /api/analysis/bill-negotiation
+func (h *AnalysisHandler) HandleBillNegotiation(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	var req dto.BillNegotiationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	userID, ok := h.requestUser(w, r, req.UserID)
+	if !ok {
+		return
+	}
+	req.UserID = userID
+
+	if req.MinIncreasePercent < 0 {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "min_increase_percent cannot be negative")
+		return
+	}
+
+	if _, service := h.transactionRepository(); service == nil {
+		h.writeError(w, http.StatusServiceUnavailable, "not_configured", "Bill negotiation needs stored transactions")
+		return
+	}
+
+	now := time.Now()
+	response, err := h.billNegotiation(r.Context(), req.UserID, now, req.MinIncreasePercent)
+	if err != nil {
+		h.writeAnalysisError(w, err)
+		return
+	}
+
+	// Store the analysis result
+	analysis := &AnalysisResult{
+		ID:          uuid.New().String(),
+		UserID:      req.UserID,
+		Type:        dto.AnalysisTypeBillNegotiation,
+		Status:      dto.AnalysisStatusCompleted,
+		StartDate:   now.AddDate(-1, 0, 0),
+		EndDate:     now,
+		Result:      response,
+		CreatedAt:   now,
+		CompletedAt: &now,
+	}
+
+	h.mu.Lock()
+	h.analyses[analysis.ID] = analysis
+	h.mu.Unlock()
+
+	h.writeJSON(w, http.StatusOK, response)
+}
+
+// HandleRecurring handles POST /api/analysis/recurring
+func (h *AnalysisHandler) HandleRecurring(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	var req dto.RecurringDetectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	userID, ok := h.requestUser(w, r, req.UserID)
+	if !ok {
+		return
+	}
+	req.UserID = userID
+
+	if req.AmountTolerance < 0 || req.AmountTolerance >= 1 {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "amount_tolerance must be between 0 and 1")
+		return
+	}
+	if req.MinPriceIncreasePercent < 0 {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "min_price_increase_percent cannot be negative")
+		return
+	}
+
+	if repo, _ := h.transactionRepository(); repo == nil {
+		h.writeError(w, http.StatusServiceUnavailable, "not_configured", "Recurring detection needs stored transactions")
+		return
+	}
+
+	config := analysis.DefaultRecurringDetectionConfig()
+	if req.AmountTolerance > 0 {
+		config.AmountTolerance = req.AmountTolerance
+	}
+	if req.MinPriceIncreasePercent > 0 {
+		config.MinPriceIncreasePercent = req.MinPriceIncreasePercent
+	}
+
+	now := time.Now()
+	response, err := h.recurring(r.Context(), req.UserID, now, config)
+	if err != nil {
+		h.writeAnalysisError(w, err)
+		return
+	}
+
+	// Store the analysis result
+	result := &AnalysisResult{
+		ID:          uuid.New().String(),
+		UserID:      req.UserID,
+		Type:        dto.AnalysisTypeRecurring,
+		Status:      dto.AnalysisStatusCompleted,
+		StartDate:   now.AddDate(0, -config.LookbackMonths, 0),
+		EndDate:     now,
+		Result:      response,
+		CreatedAt:   now,
+		CompletedAt: &now,
+	}
+
+	h.mu.Lock()
+	h.analyses[result.ID] = result
+	h.mu.Unlock()
+
+	h.writeJSON(w, http.StatusOK, response)
+}
+
+// HandleForecast handles POST /api/analysis/forecast
+func (h *AnalysisHandler) HandleForecast(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	var req dto.SpendingForecastRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	userID, ok := h.requestUser(w, r, req.UserID)
+	if !ok {
+		return
+	}
+	req.UserID = userID
+
+	if req.Months == 0 {
+		req.Months = 6
+	}
+	if req.Months < analysis.MinForecastMonths || req.Months > analysis.MaxForecastMonths {
+		h.writeError(w, http.StatusBadRequest, "validation_error",
+			fmt.Sprintf("months must be between %d and %d", analysis.MinForecastMonths, analysis.MaxForecastMonths))
+		return
+	}
+	if req.Confidence < 0 || req.Confidence >= 1 {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "confidence must be between 0 and 1")
+		return
+	}
+
+	if repo, _ := h.transactionRepository(); repo == nil {
+		h.writeError(w, http.StatusServiceUnavailable, "not_configured", "Spending forecasts need stored transactions")
+		return
+	}
+
+	config := analysis.DefaultForecastConfig()
+	if req.Confidence > 0 {
+		config.Confidence = req.Confidence
+	}
+
+	now := time.Now()
+	response, err := h.forecast(r.Context(), req.UserID, req.Months, now, config)
+	if err != nil {
+		h.writeAnalysisError(w, err)
+		return
+	}
+
+	// Store the analysis result
+	result := &AnalysisResult{
+		ID:          uuid.New().String(),
+		UserID:      req.UserID,
+		Type:        dto.AnalysisTypeForecast,
+		Status:      dto.AnalysisStatusCompleted,
+		StartDate:   now.AddDate(0, -config.HistoryMonths, 0),
+		EndDate:     now.AddDate(0, req.Months, 0),
+		Result:      response,
+		CreatedAt:   now,
+		CompletedAt: &now,
+	}
+
+	h.mu.Lock()
+	h.analyses[result.ID] = result
+	h.mu.Unlock()
+
+	h.writeJSON(w, http.StatusOK, response)
+}
+
 // HandleList handles GET /api/analysis
 func (h *AnalysisHandler) HandleList(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
@@ -351,13 +1023,17 @@ func (h *AnalysisHandler) HandleList(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userID := r.URL.Query().Get("user_id")
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
 	analysisType := r.URL.Query().Get("type")
 
 	h.mu.RLock()
 	analyses := make([]dto.AnalysisResultResponse, 0)
 	for _, a := range h.analyses {
-		if userID != "" && a.UserID != userID {
+		if a.UserID != userID {
 			continue
 		}
 		if analysisType != "" && string(a.Type) != analysisType {
@@ -392,16 +1068,43 @@ func (h *AnalysisHandler) HandleGet(w http.ResponseWriter, r *http.Request, id s
 		return
 	}
 
+	shape, err := parseResponseShape(r)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_parameter", err.Error())
+		return
+	}
+	format, ok := h.negotiateFormat(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
 	h.mu.RLock()
-	analysis, exists := h.analyses[id]
+	stored, exists := h.analyses[id]
 	h.mu.RUnlock()
 
-	if !exists {
+	if !exists || stored.UserID != userID {
 		h.writeError(w, http.StatusNotFound, "not_found", "Analysis not found")
 		return
 	}
 
-	h.writeJSON(w, http.StatusOK, analysis)
+	if backtest, ok := stored.Result.(*dto.BacktestResponse); ok && !shape.IsZero() {
+		shaped := *stored
+		shaped.Result = shapeBacktestResponse(backtest, shape)
+		stored = &shaped
+	}
+
+	// Tables hold the result alone
+	if format != export.FormatJSON {
+		h.writeResult(w, format, stored.Result)
+		return
+	}
+	h.writeJSON(w, http.StatusOK, stored)
 }
 
 // HandleDelete handles DELETE /api/analysis/{id}
@@ -411,9 +1114,15 @@ func (h *AnalysisHandler) HandleDelete(w http.ResponseWriter, r *http.Request, i
 		return
 	}
 
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
 	h.mu.Lock()
-	_, exists := h.analyses[id]
-	if !exists {
+	stored, exists := h.analyses[id]
+	if !exists || stored.UserID != userID {
 		h.mu.Unlock()
 		h.writeError(w, http.StatusNotFound, "not_found", "Analysis not found")
 		return
@@ -517,7 +1226,7 @@ func (h *AnalysisHandler) generateSpendingAnalysis(userID string, startDate, end
 	}
 }
 
-func (h *AnalysisHandler) generateTrendAnalysis(userID string, startDate, endDate time.Time, period dto.TimePeriod) *dto.TrendAnalysisResponse {
+func (h *AnalysisHandler) generateTrendAnalysis(loc *i18n.Localizer, userID string, startDate, endDate time.Time, period dto.TimePeriod) *dto.TrendAnalysisResponse {
 	categories := []string{"groceries", "dining", "transportation", "utilities", "entertainment"}
 
 	trends := make([]dto.SpendingTrendResponse, len(categories))
@@ -547,7 +1256,7 @@ func (h *AnalysisHandler) generateTrendAnalysis(userID string, startDate, endDat
 			RSquared:      0.5 + rand.Float64()*0.5,
 			Confidence:    0.6 + rand.Float64()*0.4,
 			PeriodCount:   6,
-			Description:   generateTrendDescription(cat, direction, changePercent),
+			Description:   generateTrendDescription(loc, cat, direction, changePercent),
 		}
 
 		trends[i] = trend
@@ -576,7 +1285,7 @@ func (h *AnalysisHandler) generateTrendAnalysis(userID string, startDate, endDat
 		RSquared:      0.7,
 		Confidence:    0.8,
 		PeriodCount:   6,
-		Description:   generateTrendDescription("overall", overallDirection, overallChange),
+		Description:   generateTrendDescription(loc, "overall", overallDirection, overallChange),
 	}
 
 	return &dto.TrendAnalysisResponse{
@@ -591,7 +1300,7 @@ func (h *AnalysisHandler) generateTrendAnalysis(userID string, startDate, endDat
 	}
 }
 
-func (h *AnalysisHandler) generateAnomalyDetection(userID string, startDate, endDate time.Time) *dto.AnomalyDetectionResponse {
+func (h *AnalysisHandler) generateAnomalyDetection(loc *i18n.Localizer, userID string, startDate, endDate time.Time) *dto.AnomalyDetectionResponse {
 	anomalyTypes := []string{"unusually_high", "unusually_low", "duplicate_charge", "large_transaction"}
 	categories := []string{"groceries", "dining", "shopping", "entertainment"}
 	merchants := []string{"Amazon", "Walmart", "Target", "Costco", "Uber", "Netflix"}
@@ -630,7 +1339,7 @@ func (h *AnalysisHandler) generateAnomalyDetection(userID string, startDate, end
 			ZScore:          2 + rand.Float64()*2,
 			TransactionID:   uuid.New().String(),
 			TransactionDate: startDate.Add(time.Duration(rand.Intn(int(endDate.Sub(startDate).Hours()))) * time.Hour),
-			Description:     generateAnomalyDescription(anomalyType),
+			Description:     generateAnomalyDescription(loc, anomalyType),
 			Confidence:      0.6 + rand.Float64()*0.4,
 		}
 	}
@@ -648,7 +1357,7 @@ func (h *AnalysisHandler) generateAnomalyDetection(userID string, startDate, end
 	}
 }
 
-func (h *AnalysisHandler) generateBacktest(userID string, budget dto.BudgetRequest, startDate, endDate time.Time) *dto.BacktestResponse {
+func (h *AnalysisHandler) generateBacktest(loc *i18n.Localizer, userID string, budget dto.BudgetRequest, startDate, endDate time.Time) *dto.BacktestResponse {
 	budgetID := budget.ID
 	if budgetID == "" {
 		budgetID = uuid.New().String()
@@ -798,8 +1507,8 @@ func (h *AnalysisHandler) generateBacktest(userID string, budget dto.BudgetReque
 		recommendations = append(recommendations, dto.BudgetRecommendationResponse{
 			Priority:    "high",
 			Type:        "budget_adjustment",
-			Title:       "Budget Needs Adjustment",
-			Description: "You're consistently spending more than budgeted. Consider reviewing your budget allocations.",
+			Title:       loc.Message("analysis.budget.adjustment.title", nil),
+			Description: loc.Message("analysis.budget.adjustment.description", nil),
 			Impact:      totalActual - totalBudgeted,
 			Confidence:  0.9,
 		})
@@ -819,7 +1528,7 @@ func (h *AnalysisHandler) generateBacktest(userID string, budget dto.BudgetReque
 	}
 }
 
-func (h *AnalysisHandler) generateWhatIfAnalysis(req dto.WhatIfRequest, months int) *dto.WhatIfResponse {
+func (h *AnalysisHandler) generateWhatIfAnalysis(loc *i18n.Localizer, req dto.WhatIfRequest, months int) *dto.WhatIfResponse {
 	projections := make([]dto.WhatIfProjectionResponse, months)
 
 	baseIncome := 5000.0
@@ -832,11 +1541,18 @@ func (h *AnalysisHandler) generateWhatIfAnalysis(req dto.WhatIfRequest, months i
 	}
 
 	cumulativeSavings := 0.0
+	windfall := analysis.WhatIfParameters{
+		WindfallAmount:  req.WindfallAmount,
+		WindfallMonth:   req.WindfallMonth,
+		WindfallTaxRate: req.WindfallTaxRate,
+		WindfallAccount: req.WindfallAccount,
+	}
 
 	for i := 0; i < months; i++ {
 		date := time.Now().AddDate(0, i+1, 0)
 
-		income := baseIncome * (1 + req.IncomeChange)
+		windfallIncome := windfall.WindfallIncome(i + 1)
+		income := baseIncome*(1+req.IncomeChange) + windfallIncome
 		expenses := baseExpenses * (1 + req.ExpenseChange)
 
 		if i == 0 && req.OneTimeExpense > 0 {
@@ -871,7 +1587,8 @@ func (h *AnalysisHandler) generateWhatIfAnalysis(req dto.WhatIfRequest, months i
 				"entertainment":  expenses * 0.1,
 				"other":          expenses * 0.15,
 			},
-			GoalProgress: goalProgress,
+			GoalProgress:   goalProgress,
+			WindfallIncome: windfallIncome,
 		}
 	}
 
@@ -913,7 +1630,7 @@ func (h *AnalysisHandler) generateWhatIfAnalysis(req dto.WhatIfRequest, months i
 		feasibility.RiskLevel = "medium"
 		feasibility.ConfidenceLevel = 0.6
 		feasibility.Obstacles = append(feasibility.Obstacles,
-			"Some months projected with negative cash flow")
+			loc.Plural("analysis.whatif.obstacle.negative_cash_flow", negativeMonths, nil))
 	}
 
 	if negativeMonths > months/2 {
@@ -1025,6 +1742,118 @@ func (h *AnalysisHandler) generateComparison(req dto.ComparePeriodsRequest) *dto
 // Utility Methods
 // =============================================================================
 
+// parseResponseShape reads the response shaping query parameters:
+//
+//	max_points=N           downsample time series to at most N points
+//	category_details=false omit per-period category breakdowns
+func parseResponseShape(r *http.Request) (analysis.ResponseShape, error) {
+	var shape analysis.ResponseShape
+	query := r.URL.Query()
+
+	if v := query.Get("max_points"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < analysis.MinDownsamplePoints {
+			return shape, fmt.Errorf("max_points must be an integer of at least %d", analysis.MinDownsamplePoints)
+		}
+		shape.MaxPoints = n
+	}
+
+	if v := query.Get("category_details"); v != "" {
+		include, err := strconv.ParseBool(v)
+		if err != nil {
+			return shape, fmt.Errorf("category_details must be a boolean")
+		}
+		shape.OmitCategoryDetails = !include
+	}
+
+	return shape, nil
+}
+
+// shapeBacktestResponse returns a copy of resp with period results merged
+// into at most shape.MaxPoints aggregate periods. The summary is computed over
+// the full history and is returned unchanged.
+func shapeBacktestResponse(resp *dto.BacktestResponse, shape analysis.ResponseShape) *dto.BacktestResponse {
+	if resp == nil || shape.IsZero() {
+		return resp
+	}
+
+	shaped := *resp
+	buckets := analysis.DownsampleBuckets(len(resp.PeriodResults), shape.MaxPoints)
+	shaped.PeriodResults = make([]dto.PeriodBacktestResponse, 0, len(buckets))
+
+	for _, bucket := range buckets {
+		group := resp.PeriodResults[bucket.Start:bucket.End]
+		p := group[0]
+
+		if len(group) > 1 {
+			p = dto.PeriodBacktestResponse{
+				PeriodStart:     group[0].PeriodStart,
+				PeriodEnd:       group[len(group)-1].PeriodEnd,
+				CategoryResults: mergeCategoryAllocations(group),
+			}
+			for _, g := range group {
+				p.BudgetedAmount += g.BudgetedAmount
+				p.ActualAmount += g.ActualAmount
+				p.TransactionCount += g.TransactionCount
+				p.LargestExpense = math.Max(p.LargestExpense, g.LargestExpense)
+			}
+			p.Variance = p.BudgetedAmount - p.ActualAmount
+			if p.BudgetedAmount > 0 {
+				p.VariancePercent = (p.Variance / p.BudgetedAmount) * 100
+			}
+			p.Performance = determinePerformance(p.VariancePercent)
+			if days := p.PeriodEnd.Sub(p.PeriodStart).Hours() / 24; days > 0 {
+				p.AverageDaily = p.ActualAmount / days
+			}
+		}
+
+		if shape.OmitCategoryDetails {
+			p.CategoryResults = nil
+		}
+		shaped.PeriodResults = append(shaped.PeriodResults, p)
+	}
+
+	return &shaped
+}
+
+// mergeCategoryAllocations sums category allocations across several periods
+func mergeCategoryAllocations(group []dto.PeriodBacktestResponse) []dto.CategoryAllocationResponse {
+	var order []string
+	totals := make(map[string]*dto.CategoryAllocationResponse)
+	actualTotal := 0.0
+
+	for _, g := range group {
+		for _, cr := range g.CategoryResults {
+			total, ok := totals[cr.Category]
+			if !ok {
+				total = &dto.CategoryAllocationResponse{Category: cr.Category}
+				totals[cr.Category] = total
+				order = append(order, cr.Category)
+			}
+			total.BudgetAmount += cr.BudgetAmount
+			total.ActualAmount += cr.ActualAmount
+			actualTotal += cr.ActualAmount
+		}
+	}
+
+	results := make([]dto.CategoryAllocationResponse, 0, len(order))
+	for _, cat := range order {
+		total := totals[cat]
+		total.Variance = total.BudgetAmount - total.ActualAmount
+		if actualTotal > 0 {
+			total.Percentage = (total.ActualAmount / actualTotal) * 100
+		}
+		variancePercent := 0.0
+		if total.BudgetAmount > 0 {
+			variancePercent = (total.Variance / total.BudgetAmount) * 100
+		}
+		total.Performance = determinePerformance(variancePercent)
+		results = append(results, *total)
+	}
+
+	return results
+}
+
 func (h *AnalysisHandler) getPeriodEnd(t time.Time, period dto.TimePeriod) time.Time {
 	switch period {
 	case dto.TimePeriodDaily:
@@ -1068,38 +1897,63 @@ func (h *AnalysisHandler) writeError(w http.ResponseWriter, status int, errCode
 	})
 }
 
-func generateTrendDescription(category string, direction dto.TrendDirection, changePercent float64) string {
-	catStr := "Spending"
+// requestUser returns the owner an analysis runs for: the organization the
+// request acts in, or else the authenticated user. A user_id in the request
+// body is optional but must match that owner, so one user cannot read
+// another's transactions. On failure it writes the error.
+func (h *AnalysisHandler) requestUser(w http.ResponseWriter, r *http.Request, bodyUserID string) (string, bool) {
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return "", false
+	}
+	if bodyUserID != "" && bodyUserID != userID {
+		h.writeError(w, http.StatusForbidden, "forbidden", "user_id does not match the authenticated user or organization")
+		return "", false
+	}
+	return userID, true
+}
+
+// writeAnalysisError writes the error of a failed analysis run. Unknown
+// card accounts are not found, a missing statement cycle or unbudgeted
+// category is invalid; the rest are server errors.
+func (h *AnalysisHandler) writeAnalysisError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, analysis.ErrStatementCycleNotFound):
+		h.writeError(w, http.StatusNotFound, "not_found", "Card account not found")
+	case errors.Is(err, analysis.ErrStatementCycleRequired), errors.Is(err, analysis.ErrCategoryNotBudgeted):
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+	default:
+		h.writeError(w, http.StatusInternalServerError, "analysis_failed", "Failed to run analysis: "+err.Error())
+	}
+}
+
+func generateTrendDescription(loc *i18n.Localizer, category string, direction dto.TrendDirection, changePercent float64) string {
+	data := map[string]any{}
+	subject := "overall"
 	if category != "overall" {
-		catStr = category + " spending"
+		subject = "category"
+		data["Category"] = loc.Category(category)
 	}
 
 	switch direction {
 	case dto.TrendDirectionIncreasing:
-		return catStr + " has increased by " + formatPercent(changePercent)
+		data["Percent"] = loc.Percent(changePercent)
+		return loc.Message("analysis.trend."+subject+".increasing", data)
 	case dto.TrendDirectionDecreasing:
-		return catStr + " has decreased by " + formatPercent(math.Abs(changePercent))
+		data["Percent"] = loc.Percent(math.Abs(changePercent))
+		return loc.Message("analysis.trend."+subject+".decreasing", data)
 	default:
-		return catStr + " has remained stable"
+		return loc.Message("analysis.trend."+subject+".stable", data)
 	}
 }
 
-func formatPercent(p float64) string {
-	return string(rune(int('0')+int(p)/10)) + string(rune(int('0')+int(p)%10)) + "." + string(rune(int('0')+int(p*10)%10)) + "%"
-}
-
-func generateAnomalyDescription(anomalyType string) string {
+func generateAnomalyDescription(loc *i18n.Localizer, anomalyType string) string {
 	switch anomalyType {
-	case "unusually_high":
-		return "Transaction amount is unusually high compared to typical spending"
-	case "unusually_low":
-		return "Transaction amount is unusually low compared to typical spending"
-	case "duplicate_charge":
-		return "Potential duplicate charge detected"
-	case "large_transaction":
-		return "Large transaction significantly exceeds your average spending"
+	case "unusually_high", "unusually_low", "duplicate_charge", "large_transaction":
+		return loc.Message("analysis.anomaly."+anomalyType, nil)
 	default:
-		return "Unusual transaction detected"
+		return loc.Message("analysis.anomaly.unusual", nil)
 	}
 }
 
@@ -0,0 +1,92 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/application/dto"
+)
+
+func TestShapeBacktestResponse(t *testing.T) {
+	start := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	periods := make([]dto.PeriodBacktestResponse, 5)
+	for i := range periods {
+		periodStart := start.AddDate(0, i, 0)
+		periods[i] = dto.PeriodBacktestResponse{
+			PeriodStart:      periodStart,
+			PeriodEnd:        periodStart.AddDate(0, 1, 0),
+			BudgetedAmount:   100,
+			ActualAmount:     float64(80 + 10*i),
+			TransactionCount: 2,
+			LargestExpense:   float64(10 * (i + 1)),
+			CategoryResults: []dto.CategoryAllocationResponse{
+				{Category: "groceries", BudgetAmount: 60, ActualAmount: 40},
+				{Category: "dining", BudgetAmount: 40, ActualAmount: float64(40 + 10*i)},
+			},
+		}
+	}
+	resp := &dto.BacktestResponse{PeriodResults: periods}
+
+	t.Run("zero shape returns the response", func(t *testing.T) {
+		assert.Same(t, resp, shapeBacktestResponse(resp, analysis.ResponseShape{}))
+	})
+
+	t.Run("merges periods preserving totals", func(t *testing.T) {
+		shaped := shapeBacktestResponse(resp, analysis.ResponseShape{MaxPoints: 2})
+		require.Len(t, shaped.PeriodResults, 2)
+		assert.Len(t, resp.PeriodResults, 5, "the original is not modified")
+
+		// Buckets are periods [0,2) and [2,5)
+		first, second := shaped.PeriodResults[0], shaped.PeriodResults[1]
+		assert.Equal(t, periods[0].PeriodStart, first.PeriodStart)
+		assert.Equal(t, periods[1].PeriodEnd, first.PeriodEnd)
+		assert.Equal(t, periods[4].PeriodEnd, second.PeriodEnd)
+
+		assert.InDelta(t, 200, first.BudgetedAmount, 0.001)
+		assert.InDelta(t, 170, first.ActualAmount, 0.001)
+		assert.InDelta(t, 30, first.Variance, 0.001)
+		assert.InDelta(t, 15, first.VariancePercent, 0.001)
+		assert.Equal(t, dto.BudgetPerformanceExcellent, first.Performance)
+		assert.Equal(t, 4, first.TransactionCount)
+		assert.InDelta(t, 20, first.LargestExpense, 0.001)
+
+		assert.InDelta(t, 300, second.BudgetedAmount, 0.001)
+		assert.InDelta(t, 330, second.ActualAmount, 0.001)
+		assert.InDelta(t, -10, second.VariancePercent, 0.001)
+		assert.Equal(t, dto.BudgetPerformanceCaution, second.Performance)
+
+		days := first.PeriodEnd.Sub(first.PeriodStart).Hours() / 24
+		assert.InDelta(t, 170/days, first.AverageDaily, 0.001)
+	})
+
+	t.Run("sums category allocations", func(t *testing.T) {
+		shaped := shapeBacktestResponse(resp, analysis.ResponseShape{MaxPoints: 2})
+		categories := shaped.PeriodResults[0].CategoryResults
+		require.Len(t, categories, 2)
+
+		groceries, dining := categories[0], categories[1]
+		assert.Equal(t, "groceries", groceries.Category)
+		assert.InDelta(t, 120, groceries.BudgetAmount, 0.001)
+		assert.InDelta(t, 80, groceries.ActualAmount, 0.001)
+		assert.InDelta(t, 40, groceries.Variance, 0.001)
+		assert.InDelta(t, 80.0/170*100, groceries.Percentage, 0.001)
+
+		assert.Equal(t, "dining", dining.Category)
+		assert.InDelta(t, 90, dining.ActualAmount, 0.001)
+		assert.InDelta(t, -10, dining.Variance, 0.001)
+		assert.Equal(t, dto.BudgetPerformancePoor, dining.Performance, "12.5% over budget")
+	})
+
+	t.Run("omits category details", func(t *testing.T) {
+		shaped := shapeBacktestResponse(resp, analysis.ResponseShape{OmitCategoryDetails: true})
+		require.Len(t, shaped.PeriodResults, 5)
+		for _, p := range shaped.PeriodResults {
+			assert.Nil(t, p.CategoryResults)
+		}
+		assert.NotNil(t, resp.PeriodResults[0].CategoryResults)
+	})
+}
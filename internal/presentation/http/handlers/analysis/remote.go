@@ -0,0 +1,95 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/application/dto"
+)
+
+// =============================================================================
+// Analyses Run by Other Servers
+// =============================================================================
+
+// The gRPC API runs analyses through the handler, so their results are
+// stored and listed with those of HTTP requests. The methods validate
+// requests like the HTTP endpoints; callers set the request's user ID to
+// the owner the analysis runs for.
+
+// Errors of analyses run by other servers
+var (
+	// ErrInvalidRequest is returned for requests that fail validation
+	ErrInvalidRequest = errors.New("invalid analysis request")
+	// ErrNotConfigured is returned for analyses that need stored
+	// transactions when no transaction repository is set
+	ErrNotConfigured = errors.New("analysis needs stored transactions")
+)
+
+// AnalyzeSpending runs and stores a spending analysis, like POST
+// /api/analysis/spending
+func (h *AnalysisHandler) AnalyzeSpending(ctx context.Context, req dto.SpendingAnalysisRequest) (*dto.SpendingAnalysisResponse, error) {
+	if req.EndDate.Before(req.StartDate) {
+		return nil, fmt.Errorf("%w: end_date must be after start_date", ErrInvalidRequest)
+	}
+	if req.Period == "" {
+		req.Period = dto.TimePeriodMonthly
+	}
+	if req.Period == dto.TimePeriodStatement && req.CardAccountID == "" {
+		return nil, fmt.Errorf("%w: card_account_id is required for statement periods", ErrInvalidRequest)
+	}
+
+	response, err := h.spendingAnalysis(ctx, req.UserID, req.StartDate, req.EndDate, req.Period, req.CardAccountID)
+	if err != nil {
+		return nil, err
+	}
+	h.storeResult(req.UserID, dto.AnalysisTypeSpending, req.StartDate, req.EndDate, response)
+	return response, nil
+}
+
+// DetectAnomalies runs and stores an anomaly detection, like POST
+// /api/analysis/anomalies
+func (h *AnalysisHandler) DetectAnomalies(ctx context.Context, req dto.AnomalyDetectionRequest) (*dto.AnomalyDetectionResponse, error) {
+	if req.EndDate.Before(req.StartDate) {
+		return nil, fmt.Errorf("%w: end_date must be after start_date", ErrInvalidRequest)
+	}
+
+	response, err := h.anomalyDetection(ctx, req.UserID, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	h.storeResult(req.UserID, dto.AnalysisTypeAnomaly, req.StartDate, req.EndDate, response)
+	return response, nil
+}
+
+// ForecastSpending runs and stores a spending forecast, like POST
+// /api/analysis/forecast
+func (h *AnalysisHandler) ForecastSpending(ctx context.Context, req dto.SpendingForecastRequest) (*dto.SpendingForecastResponse, error) {
+	if req.Months == 0 {
+		req.Months = 6
+	}
+	if req.Months < analysis.MinForecastMonths || req.Months > analysis.MaxForecastMonths {
+		return nil, fmt.Errorf("%w: months must be between %d and %d", ErrInvalidRequest, analysis.MinForecastMonths, analysis.MaxForecastMonths)
+	}
+	if req.Confidence < 0 || req.Confidence >= 1 {
+		return nil, fmt.Errorf("%w: confidence must be between 0 and 1", ErrInvalidRequest)
+	}
+	if repo, _ := h.transactionRepository(); repo == nil {
+		return nil, ErrNotConfigured
+	}
+
+	config := analysis.DefaultForecastConfig()
+	if req.Confidence > 0 {
+		config.Confidence = req.Confidence
+	}
+
+	now := time.Now()
+	response, err := h.forecast(ctx, req.UserID, req.Months, now, config)
+	if err != nil {
+		return nil, err
+	}
+	h.storeResult(req.UserID, dto.AnalysisTypeForecast, now.AddDate(0, -config.HistoryMonths, 0), now.AddDate(0, req.Months, 0), response)
+	return response, nil
+}
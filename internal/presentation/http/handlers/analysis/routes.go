@@ -3,6 +3,9 @@ package analysis
 import (
 	"net/http"
 	"strings"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/application/jobs"
 )
 
 // Router handles routing for analysis-related endpoints
@@ -25,11 +28,15 @@ func NewDefaultRouter() *Router {
 }
 
 // RegisterRoutes registers all analysis routes with the given mux
-// Total routes: 10 endpoints
+// Total routes: 14 endpoints
 //
 // Spending Analysis (1):
 //  1. POST   /api/analysis/spending              - Analyze spending patterns
 //
+// Spending analyses and backtests with period "statement" follow the
+// statement cycle of the card account in card_account_id, counting only
+// that card's transactions.
+//
 // Trend Analysis (1):
 //  2. POST   /api/analysis/trends                - Detect spending trends
 //
@@ -39,16 +46,65 @@ func NewDefaultRouter() *Router {
 // Backtest (1):
 //  4. POST   /api/analysis/backtest              - Run budget backtest
 //
+// Backtest responses accept ?max_points=N to downsample period results and
+// ?category_details=false to drop per-period category breakdowns. Periods
+// use the category figures reallocated in them (see /api/budgets). With
+// ?async=true the backtest runs as a job and 202 Accepted points to
+// /api/jobs/{id} for the result.
+//
 // What-If Analysis (1):
 //  5. POST   /api/analysis/what-if               - Run what-if scenario analysis
 //
 // Period Comparison (1):
 //  6. POST   /api/analysis/compare               - Compare spending periods
 //
+// Safe to Spend (1):
+//  7. POST   /api/analysis/safe-to-spend         - How much can be spent today
+//
+// Safe to spend sets aside the recurring bills detected in the user's
+// transactions that fall due before the budget period ends, and the
+// everyday spending forecast for the rest of the period. It needs stored
+// transactions (503 otherwise).
+//
+// Bill Negotiation (1):
+//  8. POST   /api/analysis/bill-negotiation      - Find bills whose price went up
+//
+// Bill negotiation flags recurring bills whose price rose more than
+// min_increase_percent (default 10) over the past year, with the annual
+// savings from cancelling or getting back to last year's price. It needs
+// stored transactions (503 otherwise). Results are listed with type
+// "bill_negotiation", and the bill_negotiation_scan job type runs it on a
+// schedule (see /api/jobs/schedules).
+//
+// Recurring Detection (1):
+//  9. POST   /api/analysis/recurring             - Find subscriptions and recurring bills
+//
+// Recurring detection lists the charges from the same merchant for about
+// the same amount (within amount_tolerance, default 0.3) at a regular
+// interval, as subscriptions or bills, with their annual cost and any price
+// increase of at least min_price_increase_percent (default 2) that stuck. It
+// needs stored transactions (503 otherwise). Results are listed with type
+// "recurring".
+//
+// Spending Forecast (1):
+//  10. POST   /api/analysis/forecast             - Forecast spending for the coming months
+//
+// Spending forecasts project each category's monthly spending for the next
+// months (3 to 12, default 6) from up to two years of its history, with
+// confidence bands at the confidence level (default 0.8). Categories with
+// two years of history follow their seasons. It needs stored transactions
+// (503 otherwise). Results are listed with type "forecast".
+//
+// Spending analyses, backtests and GET /api/analysis/{id} of either can be
+// exported as a table with ?format=csv or xlsx, or an Accept header of
+// text/csv or the xlsx media type. The table has a row for each period,
+// followed by a row for each category in it; backtest tables follow
+// ?max_points and ?category_details. Tables are streamed as an attachment.
+//
 // CRUD Operations (4):
-//  7. GET    /api/analysis                       - List all analyses (with ?user_id filter)
-//  8. GET    /api/analysis/{id}                  - Get single analysis result
-//  9. DELETE /api/analysis/{id}                  - Delete analysis result
+//  11. GET    /api/analysis                      - List the user's analyses (with ?type filter)
+//  12. GET    /api/analysis/{id}                 - Get single analysis result
+//  13. DELETE /api/analysis/{id}                 - Delete analysis result
 func (r *Router) RegisterRoutes(mux *http.ServeMux) {
 	// Base routes
 	mux.HandleFunc("/api/analysis", r.handleAnalysis)
@@ -96,6 +152,18 @@ func (r *Router) handleAnalysisByPath(w http.ResponseWriter, req *http.Request)
 	case "compare":
 		r.handler.HandleComparePeriods(w, req)
 		return
+	case "safe-to-spend":
+		r.handler.HandleSafeToSpend(w, req)
+		return
+	case "bill-negotiation":
+		r.handler.HandleBillNegotiation(w, req)
+		return
+	case "recurring":
+		r.handler.HandleRecurring(w, req)
+		return
+	case "forecast":
+		r.handler.HandleForecast(w, req)
+		return
 	}
 
 	// If not a special endpoint, treat as an analysis ID
@@ -111,6 +179,52 @@ func (r *Router) handleAnalysisByPath(w http.ResponseWriter, req *http.Request)
 	}
 }
 
+// SetJobService enables asynchronous backtests (?async=true)
+func (r *Router) SetJobService(service *jobs.Service) {
+	r.handler.SetJobService(service)
+}
+
+// SetTransactionRepository runs analyses on the transactions in repo rather
+// than generating sample results
+func (r *Router) SetTransactionRepository(repo analysis.TransactionRepository) {
+	r.handler.SetTransactionRepository(repo)
+}
+
+// SetDebtPayoffPlanner projects the user's recorded debts in the debt
+// payoff what-if scenario
+func (r *Router) SetDebtPayoffPlanner(planner analysis.DebtPayoffPlanner) {
+	r.handler.SetDebtPayoffPlanner(planner)
+}
+
+// SetEmergencyFundMonitor includes the user's emergency fund coverage in
+// what-if feasibility assessments
+func (r *Router) SetEmergencyFundMonitor(monitor analysis.EmergencyFundMonitor) {
+	r.handler.SetEmergencyFundMonitor(monitor)
+}
+
+// SetStatementCycleRepository enables statement-aligned spending analyses
+// and backtests (period "statement") for the card accounts in repo
+func (r *Router) SetStatementCycleRepository(repo analysis.StatementCycleRepository) {
+	r.handler.SetStatementCycleRepository(repo)
+}
+
+// SetReallocationRepository makes backtests of a budget use the category
+// figures reallocated in each period
+func (r *Router) SetReallocationRepository(repo analysis.ReallocationRepository) {
+	r.handler.SetReallocationRepository(repo)
+}
+
+// RegisterScheduledJobs makes analyses available as recurring schedules
+func (r *Router) RegisterScheduledJobs(scheduler *jobs.Scheduler) error {
+	return r.handler.RegisterScheduledJobs(scheduler)
+}
+
+// RegisterJobHandlers runs analysis jobs in this process. The worker calls
+// it with a router set up with the same repositories as the API's.
+func (r *Router) RegisterJobHandlers(service *jobs.Service) error {
+	return r.handler.RegisterJobHandlers(service)
+}
+
 // GetHandler returns the analysis handler
 func (r *Router) GetHandler() *AnalysisHandler {
 	return r.handler
@@ -0,0 +1,861 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/application/dto"
+	"clockzen-next/internal/infrastructure/i18n"
+)
+
+// =============================================================================
+// Analysis of Stored Transactions
+// =============================================================================
+
+// Each analysis runs on the user's stored transactions, receipts and manual
+// entries alike, once a transaction repository is set. Until then the
+// handler generates sample results, so the API can be tried without a
+// database.
+
+// SetTransactionRepository runs analyses on the transactions in repo rather
+// than generating sample results
+func (h *AnalysisHandler) SetTransactionRepository(repo analysis.TransactionRepository) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.transactions = repo
+	h.spending = analysis.NewSpendingServiceWithDefaults(repo)
+}
+
+// transactionRepository returns the transaction repository and spending
+// service, which are nil until SetTransactionRepository is called
+func (h *AnalysisHandler) transactionRepository() (analysis.TransactionRepository, *analysis.SpendingService) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.transactions, h.spending
+}
+
+// SetDebtPayoffPlanner projects the user's recorded debts in the debt
+// payoff what-if scenario
+func (h *AnalysisHandler) SetDebtPayoffPlanner(planner analysis.DebtPayoffPlanner) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.debts = planner
+}
+
+// debtPayoffPlanner returns the debt payoff planner, which is nil until
+// SetDebtPayoffPlanner is called
+func (h *AnalysisHandler) debtPayoffPlanner() analysis.DebtPayoffPlanner {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.debts
+}
+
+// SetEmergencyFundMonitor includes the user's emergency fund coverage in
+// what-if feasibility assessments
+func (h *AnalysisHandler) SetEmergencyFundMonitor(monitor analysis.EmergencyFundMonitor) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.funds = monitor
+}
+
+// emergencyFundMonitor returns the emergency fund monitor, which is nil
+// until SetEmergencyFundMonitor is called
+func (h *AnalysisHandler) emergencyFundMonitor() analysis.EmergencyFundMonitor {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.funds
+}
+
+// SetStatementCycleRepository looks up the card accounts whose statement
+// cycles statement-aligned analyses follow
+func (h *AnalysisHandler) SetStatementCycleRepository(repo analysis.StatementCycleRepository) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.cycles = repo
+}
+
+// statementCycle returns the statement cycle of the user's card account
+func (h *AnalysisHandler) statementCycle(ctx context.Context, userID, accountID string) (*analysis.StatementCycle, error) {
+	h.mu.RLock()
+	repo := h.cycles
+	h.mu.RUnlock()
+
+	if repo == nil {
+		return nil, analysis.ErrStatementCycleRequired
+	}
+	return repo.GetStatementCycle(ctx, userID, accountID)
+}
+
+// SetReallocationRepository makes backtests of a budget use the category
+// figures reallocated in each period
+func (h *AnalysisHandler) SetReallocationRepository(repo analysis.ReallocationRepository) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.realloc = repo
+}
+
+// reallocations returns the reallocations of the user's budget, none until
+// SetReallocationRepository is called
+func (h *AnalysisHandler) reallocations(ctx context.Context, userID, budgetID string) ([]analysis.BudgetReallocation, error) {
+	h.mu.RLock()
+	repo := h.realloc
+	h.mu.RUnlock()
+
+	if repo == nil {
+		return nil, nil
+	}
+	return repo.GetReallocations(ctx, userID, budgetID)
+}
+
+// spendingAnalysis analyzes the user's spending by category over time.
+// Statement periods follow the cycle of the card account.
+func (h *AnalysisHandler) spendingAnalysis(ctx context.Context, userID string, startDate, endDate time.Time, period dto.TimePeriod, cardAccountID string) (*dto.SpendingAnalysisResponse, error) {
+	_, service := h.transactionRepository()
+	if service == nil {
+		return h.generateSpendingAnalysis(userID, startDate, endDate, period), nil
+	}
+
+	var result *analysis.SpendingOverTime
+	var err error
+	if period == dto.TimePeriodStatement {
+		cycle, cycleErr := h.statementCycle(ctx, userID, cardAccountID)
+		if cycleErr != nil {
+			return nil, cycleErr
+		}
+		result, err = service.AnalyzeSpendingByStatement(ctx, userID, *cycle, startDate, endDate)
+	} else {
+		result, err = service.AnalyzeSpendingByCategory(ctx, userID, startDate, endDate, analysis.TimePeriod(period))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	periods := make([]dto.PeriodSpendingResponse, len(result.Periods))
+	for i, p := range result.Periods {
+		byCategory := make([]analysis.CategorySpending, 0, len(p.ByCategory))
+		for _, cs := range p.ByCategory {
+			byCategory = append(byCategory, cs)
+		}
+		periods[i] = dto.PeriodSpendingResponse{
+			StartDate:        p.StartDate,
+			EndDate:          p.EndDate,
+			TotalAmount:      p.TotalAmount,
+			TransactionCount: p.TransactionCount,
+			ByCategory:       categorySpendingToResponse(byCategory),
+		}
+	}
+
+	return &dto.SpendingAnalysisResponse{
+		UserID:           userID,
+		Period:           period,
+		StartDate:        startDate,
+		EndDate:          endDate,
+		Periods:          periods,
+		TotalSpending:    result.TotalSpending,
+		AveragePerPeriod: result.AveragePerPeriod,
+		TopCategories:    categorySpendingToResponse(result.TopCategories),
+		Members:          memberSpendingToResponse(result.Members),
+		AnalyzedAt:       time.Now(),
+	}, nil
+}
+
+// trendAnalysis detects trends in the user's spending
+func (h *AnalysisHandler) trendAnalysis(ctx context.Context, userID string, startDate, endDate time.Time, period dto.TimePeriod) (*dto.TrendAnalysisResponse, error) {
+	_, service := h.transactionRepository()
+	if service == nil {
+		return h.generateTrendAnalysis(i18n.FromContext(ctx), userID, startDate, endDate, period), nil
+	}
+
+	result, err := service.DetectTrends(ctx, userID, startDate, endDate, analysis.TimePeriod(period))
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.TrendAnalysisResponse{
+		UserID:            userID,
+		AnalysisPeriod:    period,
+		StartDate:         startDate,
+		EndDate:           endDate,
+		Trends:            trendsToResponse(result.Trends),
+		OverallTrend:      trendToResponse(result.OverallTrend),
+		SignificantTrends: trendsToResponse(result.SignificantTrends),
+		AnalyzedAt:        result.AnalyzedAt,
+	}, nil
+}
+
+// anomalyDetection detects anomalies in the user's spending
+func (h *AnalysisHandler) anomalyDetection(ctx context.Context, userID string, startDate, endDate time.Time) (*dto.AnomalyDetectionResponse, error) {
+	_, service := h.transactionRepository()
+	if service == nil {
+		return h.generateAnomalyDetection(i18n.FromContext(ctx), userID, startDate, endDate), nil
+	}
+
+	result, err := service.DetectAnomalies(ctx, userID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	anomalies := make([]dto.SpendingAnomalyResponse, len(result.Anomalies))
+	for i, a := range result.Anomalies {
+		anomalies[i] = dto.SpendingAnomalyResponse{
+			ID:              a.ID,
+			Type:            string(a.Type),
+			Severity:        dto.AnomalySeverity(a.Severity),
+			Category:        string(a.Category),
+			MerchantName:    a.MerchantName,
+			Amount:          a.Amount,
+			ExpectedAmount:  a.ExpectedAmount,
+			Deviation:       a.Deviation,
+			ZScore:          a.ZScore,
+			TransactionID:   a.TransactionID,
+			TransactionDate: a.TransactionDate,
+			Description:     a.Description,
+			Confidence:      a.Confidence,
+		}
+	}
+
+	return &dto.AnomalyDetectionResponse{
+		UserID:         userID,
+		StartDate:      startDate,
+		EndDate:        endDate,
+		Anomalies:      anomalies,
+		AnomalyCount:   result.AnomalyCount,
+		HighSeverity:   result.HighSeverity,
+		MediumSeverity: result.MediumSeverity,
+		LowSeverity:    result.LowSeverity,
+		AnalyzedAt:     result.AnalyzedAt,
+	}, nil
+}
+
+// comparison compares the user's spending in two periods
+func (h *AnalysisHandler) comparison(ctx context.Context, req dto.ComparePeriodsRequest) (*dto.ComparePeriodsResponse, error) {
+	_, service := h.transactionRepository()
+	if service == nil {
+		return h.generateComparison(req), nil
+	}
+
+	result, err := service.CompareSpendingPeriods(ctx, req.UserID, req.Period1Start, req.Period1End, req.Period2Start, req.Period2End)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ComparePeriodsResponse{
+		UserID:             req.UserID,
+		Period1Start:       req.Period1Start,
+		Period1End:         req.Period1End,
+		Period2Start:       req.Period2Start,
+		Period2End:         req.Period2End,
+		Period1Total:       result.Period1Total,
+		Period2Total:       result.Period2Total,
+		TotalChangeAmount:  result.TotalChangeAmount,
+		TotalChangePercent: result.TotalChangePercent,
+		CategoryChanges:    categoryChangesToResponse(result.CategoryChanges),
+		BiggestIncreases:   categoryChangesToResponse(result.BiggestIncreases),
+		BiggestDecreases:   categoryChangesToResponse(result.BiggestDecreases),
+		AnalyzedAt:         time.Now(),
+	}, nil
+}
+
+// backtest runs the budget against the user's spending. The localizer is
+// passed separately since asynchronous runs outlive the request.
+func (h *AnalysisHandler) backtest(ctx context.Context, loc *i18n.Localizer, userID string, budget dto.BudgetRequest, startDate, endDate time.Time) (*dto.BacktestResponse, error) {
+	repo, _ := h.transactionRepository()
+	if repo == nil {
+		return h.generateBacktest(loc, userID, budget, startDate, endDate), nil
+	}
+
+	requestBudget := budgetFromRequest(userID, budget)
+	if requestBudget.Period == analysis.BacktestPeriodStatement {
+		cycle, err := h.statementCycle(ctx, userID, budget.CardAccountID)
+		if err != nil {
+			return nil, err
+		}
+		requestBudget.StatementCycle = cycle
+	}
+	if budget.ID != "" {
+		reallocations, err := h.reallocations(ctx, userID, budget.ID)
+		if err != nil {
+			return nil, err
+		}
+		requestBudget.Reallocations = reallocations
+	}
+	service := analysis.NewBacktestServiceWithDefaults(requestBudgetRepository{transactions: repo, budget: requestBudget})
+	result, err := service.RunHistoricalBacktest(i18n.WithLocalizer(ctx, loc), userID, requestBudget, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	periodResults := make([]dto.PeriodBacktestResponse, len(result.PeriodResults))
+	for i, p := range result.PeriodResults {
+		memberResults := make([]dto.MemberBudgetResultResponse, len(p.MemberResults))
+		for j, m := range p.MemberResults {
+			memberResults[j] = dto.MemberBudgetResultResponse{
+				MemberID:         m.MemberID,
+				BudgetedAmount:   m.BudgetedAmount,
+				ActualAmount:     m.ActualAmount,
+				Variance:         m.Variance,
+				VariancePercent:  m.VariancePercent,
+				Performance:      dto.BudgetPerformance(m.Performance),
+				CategoryResults:  categoryAllocationsToResponse(m.CategoryResults),
+				TransactionCount: m.TransactionCount,
+			}
+		}
+		var reallocations []dto.BudgetReallocationResponse
+		for _, r := range p.Reallocations {
+			reallocations = append(reallocations, dto.BudgetReallocationResponse{
+				ID:            r.ID,
+				FromCategory:  string(r.FromCategory),
+				ToCategory:    string(r.ToCategory),
+				Amount:        r.Amount,
+				EffectiveDate: r.EffectiveDate,
+				Note:          r.Note,
+			})
+		}
+		periodResults[i] = dto.PeriodBacktestResponse{
+			PeriodStart:      p.PeriodStart,
+			PeriodEnd:        p.PeriodEnd,
+			BudgetedAmount:   p.BudgetedAmount,
+			ActualAmount:     p.ActualAmount,
+			Variance:         p.Variance,
+			VariancePercent:  p.VariancePercent,
+			Performance:      dto.BudgetPerformance(p.Performance),
+			CategoryResults:  categoryAllocationsToResponse(p.CategoryResults),
+			TransactionCount: p.TransactionCount,
+			LargestExpense:   p.LargestExpense,
+			AverageDaily:     p.AverageDaily,
+			MemberResults:    memberResults,
+			Reallocations:    reallocations,
+		}
+	}
+
+	recommendations := make([]dto.BudgetRecommendationResponse, len(result.Recommendations))
+	for i, r := range result.Recommendations {
+		recommendations[i] = dto.BudgetRecommendationResponse{
+			Category:    string(r.Category),
+			Priority:    r.Priority,
+			Type:        r.Type,
+			Title:       r.Title,
+			Description: r.Description,
+			Impact:      r.Impact,
+			Confidence:  r.Confidence,
+		}
+	}
+
+	summary := result.Summary
+	return &dto.BacktestResponse{
+		UserID:        userID,
+		BudgetID:      result.BudgetID,
+		BudgetName:    result.BudgetName,
+		Period:        string(result.Period),
+		StartDate:     startDate,
+		EndDate:       endDate,
+		PeriodResults: periodResults,
+		Summary: dto.BacktestSummaryResponse{
+			TotalPeriods:         summary.TotalPeriods,
+			PeriodsUnderBudget:   summary.PeriodsUnderBudget,
+			PeriodsOverBudget:    summary.PeriodsOverBudget,
+			PeriodsOnTrack:       summary.PeriodsOnTrack,
+			AverageVariance:      summary.AverageVariance,
+			TotalBudgeted:        summary.TotalBudgeted,
+			TotalActual:          summary.TotalActual,
+			TotalSavings:         summary.TotalSavings,
+			ConsistencyScore:     summary.ConsistencyScore,
+			BestPerformingMonth:  summary.BestPerformingMonth,
+			WorstPerformingMonth: summary.WorstPerformingMonth,
+			OverallPerformance:   dto.BudgetPerformance(summary.OverallPerformance),
+		},
+		Recommendations: recommendations,
+		AnalyzedAt:      result.AnalyzedAt,
+	}, nil
+}
+
+// whatIfAnalysis projects the scenario from the user's recent spending
+func (h *AnalysisHandler) whatIfAnalysis(ctx context.Context, req dto.WhatIfRequest, months int) (*dto.WhatIfResponse, error) {
+	repo, _ := h.transactionRepository()
+	if repo == nil {
+		return h.generateWhatIfAnalysis(i18n.FromContext(ctx), req, months), nil
+	}
+
+	categoryChanges := make(map[analysis.BudgetCategory]float64, len(req.CategoryChanges))
+	for category, change := range req.CategoryChanges {
+		categoryChanges[analysis.BudgetCategory(category)] = change
+	}
+
+	requestBudget := budgetFromRequest(req.UserID, req.Budget)
+	service := analysis.NewBacktestServiceWithDefaults(requestBudgetRepository{transactions: repo, budget: requestBudget})
+	if planner := h.debtPayoffPlanner(); planner != nil {
+		service.SetDebtPayoffPlanner(planner)
+	}
+	if monitor := h.emergencyFundMonitor(); monitor != nil {
+		service.SetEmergencyFundMonitor(monitor)
+	}
+	result, err := service.RunWhatIfAnalysis(ctx, req.UserID, requestBudget, analysis.WhatIfParameters{
+		ScenarioType:     analysis.WhatIfScenario(req.ScenarioType),
+		Name:             req.Name,
+		Description:      req.Description,
+		IncomeChange:     req.IncomeChange,
+		ExpenseChange:    req.ExpenseChange,
+		CategoryChanges:  categoryChanges,
+		TargetSavings:    req.TargetSavings,
+		TimeframeMonths:  months,
+		OneTimeExpense:   req.OneTimeExpense,
+		RecurringChange:  req.RecurringChange,
+		RoundUpIncrement: req.RoundUpIncrement,
+		DebtStrategy:     req.DebtStrategy,
+		ExtraDebtPayment: req.ExtraDebtPayment,
+		WindfallAmount:   req.WindfallAmount,
+		WindfallMonth:    req.WindfallMonth,
+		WindfallTaxRate:  req.WindfallTaxRate,
+		WindfallAccount:  req.WindfallAccount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	projections := make([]dto.WhatIfProjectionResponse, len(result.Projections))
+	for i, p := range result.Projections {
+		breakdown := make(map[string]float64, len(p.CategoryBreakdown))
+		for category, amount := range p.CategoryBreakdown {
+			breakdown[string(category)] = amount
+		}
+		projections[i] = dto.WhatIfProjectionResponse{
+			Month:              p.Month,
+			Date:               p.Date,
+			ProjectedIncome:    p.ProjectedIncome,
+			ProjectedExpenses:  p.ProjectedExpenses,
+			ProjectedSavings:   p.ProjectedSavings,
+			CumulativeSavings:  p.CumulativeSavings,
+			BudgetVariance:     p.BudgetVariance,
+			CategoryBreakdown:  breakdown,
+			GoalProgress:       p.GoalProgress,
+			RoundUpSavings:     p.RoundUpSavings,
+			CumulativeRoundUps: p.CumulativeRoundUps,
+			DebtPayment:        p.DebtPayment,
+			DebtBalance:        p.DebtBalance,
+			WindfallIncome:     p.WindfallIncome,
+		}
+	}
+
+	recommendations := make([]dto.WhatIfRecommendationResponse, len(result.Recommendations))
+	for i, r := range result.Recommendations {
+		recommendations[i] = dto.WhatIfRecommendationResponse{
+			Category:    r.Category,
+			Action:      r.Action,
+			Impact:      r.Impact,
+			Difficulty:  r.Difficulty,
+			Description: r.Description,
+		}
+	}
+
+	comparison := result.Comparison
+	feasibility := result.Feasibility
+	return &dto.WhatIfResponse{
+		UserID:       req.UserID,
+		ScenarioType: req.ScenarioType,
+		ScenarioName: req.Name,
+		StartDate:    result.StartDate,
+		EndDate:      result.EndDate,
+		Projections:  projections,
+		Comparison: dto.WhatIfComparisonResponse{
+			BaselineTotal:      comparison.BaselineTotal,
+			ScenarioTotal:      comparison.ScenarioTotal,
+			Difference:         comparison.Difference,
+			DifferencePercent:  comparison.DifferencePercent,
+			BaselineSavings:    comparison.BaselineSavings,
+			ScenarioSavings:    comparison.ScenarioSavings,
+			SavingsDifference:  comparison.SavingsDifference,
+			HistoricalRoundUps: comparison.HistoricalRoundUps,
+			ProjectedRoundUps:  comparison.ProjectedRoundUps,
+			DebtPayoffMonths:   comparison.DebtPayoffMonths,
+			DebtInterest:       comparison.DebtInterest,
+			WindfallReceived:   comparison.WindfallReceived,
+			WindfallDeferred:   comparison.WindfallDeferred,
+		},
+		Feasibility: dto.FeasibilityResponse{
+			IsFeasible:          feasibility.IsFeasible,
+			ConfidenceLevel:     feasibility.ConfidenceLevel,
+			RiskLevel:           feasibility.RiskLevel,
+			RequiredChange:      feasibility.RequiredChange,
+			TimeToGoal:          feasibility.TimeToGoal,
+			Obstacles:           feasibility.Obstacles,
+			Opportunities:       feasibility.Opportunities,
+			EmergencyFundMonths: feasibility.EmergencyFundMonths,
+			EmergencyFundTarget: feasibility.EmergencyFundTarget,
+		},
+		Recommendations: recommendations,
+		AnalyzedAt:      result.AnalyzedAt,
+	}, nil
+}
+
+// safeToSpend computes how much of the budget can be spent on date. It needs
+// stored transactions, so callers check the repository is set.
+func (h *AnalysisHandler) safeToSpend(ctx context.Context, userID string, budget dto.BudgetRequest, category analysis.BudgetCategory, date time.Time) (*dto.SafeToSpendResponse, error) {
+	repo, _ := h.transactionRepository()
+
+	requestBudget := budgetFromRequest(userID, budget)
+	if requestBudget.Period == analysis.BacktestPeriodStatement {
+		cycle, err := h.statementCycle(ctx, userID, budget.CardAccountID)
+		if err != nil {
+			return nil, err
+		}
+		requestBudget.StatementCycle = cycle
+	}
+	if budget.ID != "" {
+		reallocations, err := h.reallocations(ctx, userID, budget.ID)
+		if err != nil {
+			return nil, err
+		}
+		requestBudget.Reallocations = reallocations
+	}
+	service := analysis.NewBacktestServiceWithDefaults(requestBudgetRepository{transactions: repo, budget: requestBudget})
+	result, err := service.SafeToSpend(ctx, userID, requestBudget, category, date)
+	if err != nil {
+		return nil, err
+	}
+
+	bills := make([]dto.UpcomingBillResponse, len(result.UpcomingBills))
+	for i, b := range result.UpcomingBills {
+		bills[i] = dto.UpcomingBillResponse{
+			Merchant: b.Merchant,
+			Category: string(b.Category),
+			Cadence:  string(b.Cadence),
+			Amount:   b.Amount,
+			DueDate:  b.DueDate,
+		}
+	}
+	var reallocations []dto.BudgetReallocationResponse
+	for _, r := range result.Reallocations {
+		reallocations = append(reallocations, dto.BudgetReallocationResponse{
+			ID:            r.ID,
+			FromCategory:  string(r.FromCategory),
+			ToCategory:    string(r.ToCategory),
+			Amount:        r.Amount,
+			EffectiveDate: r.EffectiveDate,
+			Note:          r.Note,
+		})
+	}
+
+	return &dto.SafeToSpendResponse{
+		UserID:             result.UserID,
+		BudgetID:           result.BudgetID,
+		Category:           string(result.Category),
+		Date:               result.Date,
+		PeriodStart:        result.PeriodStart,
+		PeriodEnd:          result.PeriodEnd,
+		DaysRemaining:      result.DaysRemaining,
+		BudgetedAmount:     result.BudgetedAmount,
+		SpentAmount:        result.SpentAmount,
+		RemainingBudget:    result.RemainingBudget,
+		UpcomingBills:      bills,
+		UpcomingBillsTotal: result.UpcomingBillsTotal,
+		DailySpendingRate:  result.DailySpendingRate,
+		ForecastSpending:   result.ForecastSpending,
+		SafeToSpend:        result.SafeToSpend,
+		DailyAllowance:     result.DailyAllowance,
+		Reallocations:      reallocations,
+		AnalyzedAt:         time.Now(),
+	}, nil
+}
+
+// billNegotiation finds the user's recurring bills whose price went up year
+// over year as of asOf. It needs stored transactions, so callers check the
+// repository is set.
+func (h *AnalysisHandler) billNegotiation(ctx context.Context, userID string, asOf time.Time, minIncreasePercent float64) (*dto.BillNegotiationResponse, error) {
+	_, service := h.transactionRepository()
+
+	result, err := service.DetectBillNegotiationOpportunities(ctx, userID, asOf, minIncreasePercent)
+	if err != nil {
+		return nil, err
+	}
+
+	opportunities := make([]dto.BillNegotiationOpportunityResponse, len(result.Opportunities))
+	for i, o := range result.Opportunities {
+		history := make([]dto.PricePointResponse, len(o.PriceHistory))
+		for j, p := range o.PriceHistory {
+			history[j] = dto.PricePointResponse{Date: p.Date, Amount: p.Amount}
+		}
+		opportunities[i] = dto.BillNegotiationOpportunityResponse{
+			Merchant:               o.Merchant,
+			Category:               string(o.Category),
+			Cadence:                string(o.Cadence),
+			CurrentPrice:           o.CurrentPrice,
+			PreviousPrice:          o.PreviousPrice,
+			PreviousDate:           o.PreviousDate,
+			IncreasePercent:        o.IncreasePercent,
+			AnnualCost:             o.AnnualCost,
+			CancellationSavings:    o.CancellationSavings,
+			DowngradeSavings:       o.DowngradeSavings,
+			SuggestedAction:        string(o.SuggestedAction),
+			EstimatedAnnualSavings: o.EstimatedAnnualSavings,
+			PriceHistory:           history,
+		}
+	}
+
+	return &dto.BillNegotiationResponse{
+		UserID:                result.UserID,
+		MinIncreasePercent:    result.MinIncreasePercent,
+		BillsReviewed:         result.BillsReviewed,
+		Opportunities:         opportunities,
+		TotalEstimatedSavings: result.TotalEstimatedSavings,
+		TotalAnnualIncrease:   result.TotalAnnualIncrease,
+		AnalyzedAt:            time.Now(),
+	}, nil
+}
+
+// recurring finds the user's subscriptions and recurring bills as of asOf.
+// It needs stored transactions, so callers check the repository is set.
+func (h *AnalysisHandler) recurring(ctx context.Context, userID string, asOf time.Time, config analysis.RecurringDetectionConfig) (*dto.RecurringDetectionResponse, error) {
+	repo, _ := h.transactionRepository()
+
+	result, err := analysis.NewRecurringDetectionService(repo, config).DetectRecurring(ctx, userID, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	charges := make([]dto.RecurringChargeResponse, len(result.Charges))
+	for i, c := range result.Charges {
+		history := make([]dto.PricePointResponse, len(c.PriceHistory))
+		for j, p := range c.PriceHistory {
+			history[j] = dto.PricePointResponse{Date: p.Date, Amount: p.Amount}
+		}
+		charges[i] = dto.RecurringChargeResponse{
+			Merchant:     c.Merchant,
+			Category:     string(c.Category),
+			Kind:         string(c.Kind),
+			Cadence:      string(c.Cadence),
+			Amount:       c.Amount,
+			Occurrences:  c.Occurrences,
+			LastDate:     c.LastDate,
+			NextDate:     c.NextDate,
+			AnnualCost:   c.AnnualCost,
+			MonthlyCost:  c.MonthlyCost,
+			PriceHistory: history,
+		}
+		if c.PriceIncrease != nil {
+			charges[i].PriceIncrease = &dto.PriceIncreaseResponse{
+				PreviousAmount:  c.PriceIncrease.PreviousAmount,
+				NewAmount:       c.PriceIncrease.NewAmount,
+				Date:            c.PriceIncrease.Date,
+				IncreasePercent: c.PriceIncrease.IncreasePercent,
+				AnnualIncrease:  c.PriceIncrease.AnnualIncrease,
+			}
+		}
+	}
+
+	return &dto.RecurringDetectionResponse{
+		UserID:                  result.UserID,
+		Charges:                 charges,
+		Subscriptions:           result.Subscriptions,
+		Bills:                   result.Bills,
+		PriceIncreases:          result.PriceIncreases,
+		SubscriptionsAnnualCost: result.SubscriptionsAnnualCost,
+		BillsAnnualCost:         result.BillsAnnualCost,
+		TotalAnnualCost:         result.TotalAnnualCost,
+		TotalMonthlyCost:        result.TotalMonthlyCost,
+		AnalyzedAt:              time.Now(),
+	}, nil
+}
+
+// forecast forecasts the user's spending for the months from asOf's. It
+// needs stored transactions, so callers check the repository is set.
+func (h *AnalysisHandler) forecast(ctx context.Context, userID string, months int, asOf time.Time, config analysis.ForecastConfig) (*dto.SpendingForecastResponse, error) {
+	repo, _ := h.transactionRepository()
+
+	result, err := analysis.NewForecastService(repo, config).ForecastSpending(ctx, userID, months, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]dto.CategoryForecastResponse, len(result.Categories))
+	for i, c := range result.Categories {
+		categories[i] = dto.CategoryForecastResponse{
+			Category:       string(c.Category),
+			HistoryMonths:  c.HistoryMonths,
+			AverageMonthly: c.AverageMonthly,
+			Trend:          string(c.Trend),
+			Seasonal:       c.Seasonal,
+			Points:         forecastPointResponses(c.Points),
+			Total:          c.Total,
+		}
+	}
+
+	return &dto.SpendingForecastResponse{
+		UserID:     result.UserID,
+		Months:     result.Months,
+		Confidence: result.Confidence,
+		Categories: categories,
+		Total:      forecastPointResponses(result.Total),
+		AnalyzedAt: time.Now(),
+	}, nil
+}
+
+// forecastPointResponses converts forecast points to response format
+func forecastPointResponses(points []analysis.ForecastPoint) []dto.ForecastPointResponse {
+	responses := make([]dto.ForecastPointResponse, len(points))
+	for i, p := range points {
+		responses[i] = dto.ForecastPointResponse{
+			PeriodStart: p.PeriodStart,
+			Amount:      p.Amount,
+			Lower:       p.Lower,
+			Upper:       p.Upper,
+		}
+	}
+	return responses
+}
+
+// budgetFromRequest converts a requested budget, defaulting to a monthly
+// budget with a generated ID
+func budgetFromRequest(userID string, req dto.BudgetRequest) analysis.Budget {
+	budget := analysis.Budget{
+		ID:              req.ID,
+		UserID:          userID,
+		Name:            req.Name,
+		Period:          analysis.BacktestPeriod(req.Period),
+		TotalBudget:     req.TotalBudget,
+		CategoryBudgets: make(map[analysis.BudgetCategory]float64, len(req.CategoryBudgets)),
+		Income:          req.Income,
+		SavingsGoal:     req.SavingsGoal,
+	}
+	if budget.ID == "" {
+		budget.ID = uuid.New().String()
+	}
+	if budget.Period == "" {
+		budget.Period = analysis.BacktestPeriodMonthly
+	}
+	for category, amount := range req.CategoryBudgets {
+		budget.CategoryBudgets[analysis.BudgetCategory(category)] = amount
+	}
+	for _, member := range req.MemberBudgets {
+		memberBudget := analysis.MemberBudget{
+			MemberID:        member.MemberID,
+			TotalBudget:     member.TotalBudget,
+			CategoryBudgets: make(map[analysis.BudgetCategory]float64, len(member.CategoryBudgets)),
+		}
+		for category, amount := range member.CategoryBudgets {
+			memberBudget.CategoryBudgets[analysis.BudgetCategory(category)] = amount
+		}
+		budget.MemberBudgets = append(budget.MemberBudgets, memberBudget)
+	}
+	return budget
+}
+
+// requestBudgetRepository serves the backtest service the budget from the
+// request, since budgets aren't stored, and the user's transactions
+type requestBudgetRepository struct {
+	transactions analysis.TransactionRepository
+	budget       analysis.Budget
+}
+
+func (r requestBudgetRepository) GetBudgetByID(ctx context.Context, budgetID string) (*analysis.Budget, error) {
+	if budgetID != r.budget.ID {
+		return nil, fmt.Errorf("budget %s not found", budgetID)
+	}
+	budget := r.budget
+	return &budget, nil
+}
+
+func (r requestBudgetRepository) GetBudgetsByUserID(ctx context.Context, userID string) ([]analysis.Budget, error) {
+	return []analysis.Budget{r.budget}, nil
+}
+
+func (r requestBudgetRepository) GetTransactionsByBudget(ctx context.Context, userID string, startDate, endDate time.Time) ([]analysis.Transaction, error) {
+	return r.transactions.GetByUserID(ctx, userID, startDate, endDate)
+}
+
+// categorySpendingToResponse converts category spending, largest first
+func categorySpendingToResponse(spending []analysis.CategorySpending) []dto.CategorySpendingResponse {
+	resp := make([]dto.CategorySpendingResponse, len(spending))
+	for i, cs := range spending {
+		resp[i] = dto.CategorySpendingResponse{
+			Category:           string(cs.Category),
+			Amount:             cs.Amount,
+			TransactionCount:   cs.TransactionCount,
+			Percentage:         cs.Percentage,
+			AverageTransaction: cs.AverageTransaction,
+		}
+	}
+	sort.SliceStable(resp, func(i, j int) bool {
+		return resp[i].Amount > resp[j].Amount
+	})
+	return resp
+}
+
+// memberSpendingToResponse converts spending split by household member
+func memberSpendingToResponse(members []analysis.MemberSpending) []dto.MemberSpendingResponse {
+	if members == nil {
+		return nil
+	}
+	resp := make([]dto.MemberSpendingResponse, len(members))
+	for i, m := range members {
+		resp[i] = dto.MemberSpendingResponse{
+			MemberID:         m.MemberID,
+			Amount:           m.Amount,
+			TransactionCount: m.TransactionCount,
+			Percentage:       m.Percentage,
+			ByCategory:       categorySpendingToResponse(m.ByCategory),
+		}
+	}
+	return resp
+}
+
+// categoryAllocationsToResponse converts budget category results
+func categoryAllocationsToResponse(allocations []analysis.BudgetCategoryAllocation) []dto.CategoryAllocationResponse {
+	resp := make([]dto.CategoryAllocationResponse, len(allocations))
+	for i, c := range allocations {
+		resp[i] = dto.CategoryAllocationResponse{
+			Category:     string(c.Category),
+			BudgetAmount: c.BudgetAmount,
+			ActualAmount: c.ActualAmount,
+			Variance:     c.Variance,
+			Percentage:   c.Percentage,
+			Performance:  dto.BudgetPerformance(c.Performance),
+		}
+	}
+	return resp
+}
+
+// trendsToResponse converts spending trends
+func trendsToResponse(trends []analysis.SpendingTrend) []dto.SpendingTrendResponse {
+	resp := make([]dto.SpendingTrendResponse, len(trends))
+	for i, t := range trends {
+		resp[i] = trendToResponse(t)
+	}
+	return resp
+}
+
+// trendToResponse converts a spending trend
+func trendToResponse(t analysis.SpendingTrend) dto.SpendingTrendResponse {
+	return dto.SpendingTrendResponse{
+		Category:      string(t.Category),
+		Direction:     dto.TrendDirection(t.Direction),
+		ChangePercent: t.ChangePercent,
+		ChangeAmount:  t.ChangeAmount,
+		StartAmount:   t.StartAmount,
+		EndAmount:     t.EndAmount,
+		Slope:         t.Slope,
+		RSquared:      t.RSquared,
+		Confidence:    t.Confidence,
+		PeriodCount:   t.PeriodCount,
+		Description:   t.Description,
+	}
+}
+
+// categoryChangesToResponse converts category spending changes
+func categoryChangesToResponse(changes []analysis.CategoryChange) []dto.CategoryChangeResponse {
+	resp := make([]dto.CategoryChangeResponse, len(changes))
+	for i, c := range changes {
+		resp[i] = dto.CategoryChangeResponse{
+			Category:      string(c.Category),
+			Period1Amount: c.Period1Amount,
+			Period2Amount: c.Period2Amount,
+			ChangeAmount:  c.ChangeAmount,
+			ChangePercent: c.ChangePercent,
+		}
+	}
+	return resp
+}
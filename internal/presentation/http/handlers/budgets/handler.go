@@ -0,0 +1,198 @@
+package budgets
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/application/budgets"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// ErrorResponse represents an error response
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// ReallocateRequest represents a request to move money between two of a
+// budget's categories
+type ReallocateRequest struct {
+	FromCategory string  `json:"from_category"`
+	ToCategory   string  `json:"to_category"`
+	Amount       float64 `json:"amount"`
+	// EffectiveDate picks the budget period; YYYY-MM-DD or RFC 3339, today
+	// if empty
+	EffectiveDate string `json:"effective_date,omitempty"`
+	Note          string `json:"note,omitempty"`
+}
+
+// ReallocationResponse represents a budget reallocation
+type ReallocationResponse struct {
+	ID            string    `json:"id"`
+	UserID        string    `json:"user_id"`
+	BudgetID      string    `json:"budget_id"`
+	FromCategory  string    `json:"from_category"`
+	ToCategory    string    `json:"to_category"`
+	Amount        float64   `json:"amount"`
+	EffectiveDate time.Time `json:"effective_date"`
+	Note          *string   `json:"note,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// ListReallocationsResponse represents the reallocation history of a budget
+type ListReallocationsResponse struct {
+	BudgetID      string                 `json:"budget_id"`
+	Reallocations []ReallocationResponse `json:"reallocations"`
+	Total         int                    `json:"total"`
+}
+
+// BudgetHandler handles HTTP requests for changes to budgets
+type BudgetHandler struct {
+	service *budgets.Service
+}
+
+// NewBudgetHandler creates a new BudgetHandler instance
+func NewBudgetHandler(service *budgets.Service) *BudgetHandler {
+	return &BudgetHandler{
+		service: service,
+	}
+}
+
+// HandleListReallocations handles GET /api/budgets/{id}/reallocations
+func (h *BudgetHandler) HandleListReallocations(w http.ResponseWriter, r *http.Request, budgetID string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	records, err := h.service.ListReallocations(r.Context(), userID, budgetID)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to list reallocations: "+err.Error())
+		return
+	}
+
+	resp := ListReallocationsResponse{
+		BudgetID:      budgetID,
+		Reallocations: make([]ReallocationResponse, len(records)),
+		Total:         len(records),
+	}
+	for i, record := range records {
+		resp.Reallocations[i] = reallocationToResponse(record)
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleReallocate handles POST /api/budgets/{id}/reallocations
+func (h *BudgetHandler) HandleReallocate(w http.ResponseWriter, r *http.Request, budgetID string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	// The reallocation records the user who moved the money, in the
+	// organization the request acts in if any
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+	organizationID := ""
+	if org, ok := middleware.OrganizationFromContext(r.Context()); ok {
+		organizationID = org.ID
+	}
+
+	var req ReallocateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+	effectiveDate, err := parseDate(req.EffectiveDate)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "effective_date: "+err.Error())
+		return
+	}
+
+	record, err := h.service.Reallocate(r.Context(), userID, budgetID, budgets.ReallocationInput{
+		FromCategory:   analysis.BudgetCategory(req.FromCategory),
+		ToCategory:     analysis.BudgetCategory(req.ToCategory),
+		Amount:         req.Amount,
+		EffectiveDate:  effectiveDate,
+		Note:           req.Note,
+		OrganizationID: organizationID,
+	})
+	if err != nil {
+		if isValidationError(err) {
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "create_failed", "Failed to reallocate budget: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, reallocationToResponse(record))
+}
+
+// isValidationError reports whether err is a rejected reallocation field
+func isValidationError(err error) bool {
+	return errors.Is(err, budgets.ErrInvalidBudgetID) ||
+		errors.Is(err, budgets.ErrInvalidCategory) ||
+		errors.Is(err, budgets.ErrSameCategory) ||
+		errors.Is(err, budgets.ErrInvalidAmount)
+}
+
+// parseDate parses a date (YYYY-MM-DD, midnight UTC) or an RFC 3339
+// timestamp; empty is a zero time
+func parseDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not a date (YYYY-MM-DD) or RFC 3339 timestamp", value)
+	}
+	return t, nil
+}
+
+// reallocationToResponse converts a reallocation to its response
+func reallocationToResponse(r *ent.BudgetReallocation) ReallocationResponse {
+	return ReallocationResponse{
+		ID:            r.ID,
+		UserID:        r.UserID,
+		BudgetID:      r.BudgetID,
+		FromCategory:  r.FromCategory,
+		ToCategory:    r.ToCategory,
+		Amount:        r.Amount,
+		EffectiveDate: r.EffectiveDate,
+		Note:          r.Note,
+		CreatedAt:     r.CreatedAt,
+	}
+}
+
+// writeJSON writes a JSON response
+func (h *BudgetHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *BudgetHandler) writeError(w http.ResponseWriter, status int, errCode string, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
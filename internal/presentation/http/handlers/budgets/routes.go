@@ -0,0 +1,62 @@
+package budgets
+
+import (
+	"net/http"
+	"strings"
+
+	"clockzen-next/internal/application/budgets"
+	"clockzen-next/internal/ent"
+)
+
+// Router handles routing for budget endpoints
+type Router struct {
+	handler *BudgetHandler
+}
+
+// NewRouter creates a new Router with the given handler
+func NewRouter(handler *BudgetHandler) *Router {
+	return &Router{
+		handler: handler,
+	}
+}
+
+// NewDefaultRouter creates a new Router backed by the given ent client
+func NewDefaultRouter(entClient *ent.Client) *Router {
+	return &Router{
+		handler: NewBudgetHandler(budgets.NewService(entClient)),
+	}
+}
+
+// RegisterRoutes registers all budget routes with the given mux
+// Total routes: 2 endpoints
+//
+// Budgets are sent with each analysis rather than stored; {id} is the
+// budget's ID as sent with backtests. Reallocations move money between the
+// budget's categories in the period containing their effective date, and
+// backtests of the budget compare that period's spending with the
+// reallocated figures.
+//
+//  1. GET    /api/budgets/{id}/reallocations    - History of the budget's reallocations
+//  2. POST   /api/budgets/{id}/reallocations    - Move money between categories
+func (r *Router) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/budgets/", r.handleBudgetByPath)
+}
+
+// handleBudgetByPath routes requests for /api/budgets/{id}/reallocations
+func (r *Router) handleBudgetByPath(w http.ResponseWriter, req *http.Request) {
+	path := strings.TrimPrefix(req.URL.Path, "/api/budgets/")
+	parts := strings.Split(path, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] != "reallocations" {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleListReallocations(w, req, parts[0])
+	case http.MethodPost:
+		r.handler.HandleReallocate(w, req, parts[0])
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
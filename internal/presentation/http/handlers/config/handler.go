@@ -0,0 +1,49 @@
+package config
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// ErrorResponse represents an error response
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// ConfigHandler serves the configuration the process is running with
+type ConfigHandler struct {
+	settings map[string]any
+}
+
+// NewConfigHandler creates a new ConfigHandler instance serving settings,
+// which must already have secrets redacted, as config.Sanitize does
+func NewConfigHandler(settings map[string]any) *ConfigHandler {
+	return &ConfigHandler{
+		settings: settings,
+	}
+}
+
+// HandleGetConfig handles GET /api/config
+func (h *ConfigHandler) HandleGetConfig(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, h.settings)
+}
+
+// writeJSON writes a JSON response
+func (h *ConfigHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *ConfigHandler) writeError(w http.ResponseWriter, status int, errCode, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
@@ -0,0 +1,41 @@
+package config
+
+import (
+	"net/http"
+)
+
+// Router handles routing for the configuration endpoint
+type Router struct {
+	handler *ConfigHandler
+}
+
+// NewRouter creates a new Router with the given handler
+func NewRouter(handler *ConfigHandler) *Router {
+	return &Router{
+		handler: handler,
+	}
+}
+
+// NewDefaultRouter creates a new Router serving the given sanitized
+// settings
+func NewDefaultRouter(settings map[string]any) *Router {
+	return &Router{
+		handler: NewConfigHandler(settings),
+	}
+}
+
+// RegisterRoutes registers the configuration route with the given mux
+// Total routes: 1 endpoint
+//
+// The settings show how the server is deployed, so the route should be
+// mounted behind admin authorization.
+//
+//  1. GET    /api/config                        - Get the sanitized configuration
+func (r *Router) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/config", r.handler.HandleGetConfig)
+}
+
+// GetConfigHandler returns the config handler
+func (r *Router) GetConfigHandler() *ConfigHandler {
+	return r.handler
+}
@@ -0,0 +1,365 @@
+package debts
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"clockzen-next/internal/application/debts"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/debt"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// ErrorResponse represents an error response
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// CreateDebtRequest represents a request to record a debt
+type CreateDebtRequest struct {
+	Name           string  `json:"name"`
+	Type           string  `json:"type,omitempty"`
+	Balance        float64 `json:"balance"`
+	APR            float64 `json:"apr"`
+	MinimumPayment float64 `json:"minimum_payment"`
+}
+
+// UpdateDebtRequest represents a request to update a debt
+type UpdateDebtRequest struct {
+	Name           *string  `json:"name,omitempty"`
+	Type           *string  `json:"type,omitempty"`
+	Balance        *float64 `json:"balance,omitempty"`
+	APR            *float64 `json:"apr,omitempty"`
+	MinimumPayment *float64 `json:"minimum_payment,omitempty"`
+}
+
+// DebtResponse represents a debt
+type DebtResponse struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	Type           string    `json:"type"`
+	Balance        float64   `json:"balance"`
+	APR            float64   `json:"apr"`
+	MinimumPayment float64   `json:"minimum_payment"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// ListDebtsResponse represents a list of debts
+type ListDebtsResponse struct {
+	Debts []DebtResponse `json:"debts"`
+	Total int            `json:"total"`
+}
+
+// SummaryResponse summarizes the user's debts for the dashboard
+type SummaryResponse struct {
+	DebtCount           int     `json:"debt_count"`
+	TotalBalance        float64 `json:"total_balance"`
+	TotalMinimumPayment float64 `json:"total_minimum_payment"`
+	WeightedAPR         float64 `json:"weighted_apr"`
+	// Set when the minimum payments pay the debts off
+	RecommendedStrategy    string     `json:"recommended_strategy,omitempty"`
+	PayoffDate             *time.Time `json:"payoff_date,omitempty"`
+	PayoffMonths           int        `json:"payoff_months,omitempty"`
+	TotalInterest          float64    `json:"total_interest,omitempty"`
+	AvalancheInterestSaved float64    `json:"avalanche_interest_saved,omitempty"`
+}
+
+// DebtHandler handles HTTP requests for debts and payoff plans
+type DebtHandler struct {
+	service *debts.Service
+}
+
+// NewDebtHandler creates a new DebtHandler instance
+func NewDebtHandler(service *debts.Service) *DebtHandler {
+	return &DebtHandler{
+		service: service,
+	}
+}
+
+// HandleList handles GET /api/debts
+func (h *DebtHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	records, err := h.service.ListDebts(r.Context(), userID)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to list debts: "+err.Error())
+		return
+	}
+
+	resp := ListDebtsResponse{
+		Debts: make([]DebtResponse, len(records)),
+		Total: len(records),
+	}
+	for i, record := range records {
+		resp.Debts[i] = debtToResponse(record)
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleCreate handles POST /api/debts
+func (h *DebtHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req CreateDebtRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	record, err := h.service.CreateDebt(r.Context(), userID, debts.DebtInput{
+		Name:           req.Name,
+		Type:           debt.Type(req.Type),
+		Balance:        req.Balance,
+		APR:            req.APR,
+		MinimumPayment: req.MinimumPayment,
+	})
+	if err != nil {
+		if isValidationError(err) {
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "create_failed", "Failed to create debt: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, debtToResponse(record))
+}
+
+// HandleGet handles GET /api/debts/{id}
+func (h *DebtHandler) HandleGet(w http.ResponseWriter, r *http.Request, debtID string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	record, err := h.service.GetDebt(r.Context(), userID, debtID)
+	if err != nil {
+		if errors.Is(err, debts.ErrDebtNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Debt not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get debt: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, debtToResponse(record))
+}
+
+// HandleUpdate handles PUT/PATCH /api/debts/{id}
+func (h *DebtHandler) HandleUpdate(w http.ResponseWriter, r *http.Request, debtID string) {
+	if r.Method != http.MethodPut && r.Method != http.MethodPatch {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only PUT/PATCH methods are allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req UpdateDebtRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	update := debts.DebtUpdate{
+		Name:           req.Name,
+		Balance:        req.Balance,
+		APR:            req.APR,
+		MinimumPayment: req.MinimumPayment,
+	}
+	if req.Type != nil {
+		debtType := debt.Type(*req.Type)
+		update.Type = &debtType
+	}
+
+	record, err := h.service.UpdateDebt(r.Context(), userID, debtID, update)
+	if err != nil {
+		switch {
+		case errors.Is(err, debts.ErrDebtNotFound):
+			h.writeError(w, http.StatusNotFound, "not_found", "Debt not found")
+		case isValidationError(err):
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		default:
+			h.writeError(w, http.StatusInternalServerError, "update_failed", "Failed to update debt: "+err.Error())
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, debtToResponse(record))
+}
+
+// HandleDelete handles DELETE /api/debts/{id}
+func (h *DebtHandler) HandleDelete(w http.ResponseWriter, r *http.Request, debtID string) {
+	if r.Method != http.MethodDelete {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only DELETE method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	if err := h.service.DeleteDebt(r.Context(), userID, debtID); err != nil {
+		if errors.Is(err, debts.ErrDebtNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Debt not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "delete_failed", "Failed to delete debt: "+err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandlePlan handles GET /api/debts/plan. It compares the avalanche and
+// snowball plans, with ?extra_payment put toward the debts each month on
+// top of the minimums.
+func (h *DebtHandler) HandlePlan(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	extraPayment := 0.0
+	if value := r.URL.Query().Get("extra_payment"); value != "" {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			h.writeError(w, http.StatusBadRequest, "invalid_parameter", "extra_payment must be a number")
+			return
+		}
+		extraPayment = parsed
+	}
+
+	comparison, err := h.service.ComparePlans(r.Context(), userID, extraPayment)
+	if err != nil {
+		switch {
+		case errors.Is(err, debts.ErrInvalidExtraPayment):
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		case errors.Is(err, debts.ErrNeverPaidOff):
+			h.writeError(w, http.StatusUnprocessableEntity, "never_paid_off", err.Error())
+		default:
+			h.writeError(w, http.StatusInternalServerError, "plan_failed", "Failed to plan payoff: "+err.Error())
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, comparison)
+}
+
+// HandleSummary handles GET /api/debts/summary, the debt totals and payoff
+// outlook shown on the dashboard
+func (h *DebtHandler) HandleSummary(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	summary, err := h.service.GetSummary(r.Context(), userID)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to summarize debts: "+err.Error())
+		return
+	}
+
+	resp := SummaryResponse{
+		DebtCount:           summary.DebtCount,
+		TotalBalance:        summary.TotalBalance,
+		TotalMinimumPayment: summary.TotalMinimumPayment,
+		WeightedAPR:         summary.WeightedAPR,
+	}
+	if summary.Plan != nil {
+		recommended := summary.Plan.Avalanche
+		if summary.Plan.Recommended == debts.StrategySnowball {
+			recommended = summary.Plan.Snowball
+		}
+		resp.RecommendedStrategy = string(summary.Plan.Recommended)
+		resp.PayoffDate = &recommended.PayoffDate
+		resp.PayoffMonths = recommended.Months
+		resp.TotalInterest = recommended.TotalInterest
+		resp.AvalancheInterestSaved = summary.Plan.AvalancheInterestSaved
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// isValidationError reports whether err is a rejected debt field
+func isValidationError(err error) bool {
+	return errors.Is(err, debts.ErrInvalidName) ||
+		errors.Is(err, debts.ErrInvalidType) ||
+		errors.Is(err, debts.ErrInvalidBalance) ||
+		errors.Is(err, debts.ErrInvalidAPR) ||
+		errors.Is(err, debts.ErrInvalidMinimumPayment)
+}
+
+// debtToResponse converts a debt to its response
+func debtToResponse(d *ent.Debt) DebtResponse {
+	return DebtResponse{
+		ID:             d.ID,
+		Name:           d.Name,
+		Type:           string(d.Type),
+		Balance:        d.Balance,
+		APR:            d.Apr,
+		MinimumPayment: d.MinimumPayment,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+}
+
+// writeJSON writes a JSON response
+func (h *DebtHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *DebtHandler) writeError(w http.ResponseWriter, status int, errCode string, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
@@ -0,0 +1,96 @@
+package debts
+
+import (
+	"net/http"
+	"strings"
+
+	"clockzen-next/internal/application/debts"
+	"clockzen-next/internal/ent"
+)
+
+// Router handles routing for debt endpoints
+type Router struct {
+	handler *DebtHandler
+}
+
+// NewRouter creates a new Router with the given handler
+func NewRouter(handler *DebtHandler) *Router {
+	return &Router{
+		handler: handler,
+	}
+}
+
+// NewDefaultRouter creates a new Router backed by the given ent client
+func NewDefaultRouter(entClient *ent.Client) *Router {
+	return &Router{
+		handler: NewDebtHandler(debts.NewService(entClient)),
+	}
+}
+
+// RegisterRoutes registers all debt routes with the given mux
+// Total routes: 7 endpoints
+//
+// Debts belong to the authenticated user. Payoff plans put the sum of the
+// minimum payments, plus any extra payment, toward the debts each month:
+// avalanche targets the highest rate first, snowball the smallest balance.
+//
+//  1. GET    /api/debts              - List debts
+//  2. POST   /api/debts              - Record a debt
+//  3. GET    /api/debts/{id}         - Get a debt
+//  4. PUT    /api/debts/{id}         - Update a debt (also PATCH)
+//  5. DELETE /api/debts/{id}         - Delete a debt
+//  6. GET    /api/debts/plan         - Compare avalanche and snowball plans (with ?extra_payment)
+//  7. GET    /api/debts/summary      - Debt totals and payoff outlook for the dashboard
+func (r *Router) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/debts", r.handleDebts)
+	mux.HandleFunc("/api/debts/", r.handleDebtByID)
+}
+
+// handleDebts routes requests for /api/debts
+func (r *Router) handleDebts(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleList(w, req)
+	case http.MethodPost:
+		r.handler.HandleCreate(w, req)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleDebtByID routes requests for /api/debts/{id}, /api/debts/plan and
+// /api/debts/summary
+func (r *Router) handleDebtByID(w http.ResponseWriter, req *http.Request) {
+	path := strings.TrimPrefix(req.URL.Path, "/api/debts/")
+	parts := strings.Split(path, "/")
+
+	if len(parts) == 0 || parts[0] == "" {
+		http.Error(w, "Debt ID required", http.StatusBadRequest)
+		return
+	}
+	if len(parts) > 1 {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+
+	switch parts[0] {
+	case "plan":
+		r.handler.HandlePlan(w, req)
+		return
+	case "summary":
+		r.handler.HandleSummary(w, req)
+		return
+	}
+
+	debtID := parts[0]
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleGet(w, req, debtID)
+	case http.MethodPut, http.MethodPatch:
+		r.handler.HandleUpdate(w, req, debtID)
+	case http.MethodDelete:
+		r.handler.HandleDelete(w, req, debtID)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
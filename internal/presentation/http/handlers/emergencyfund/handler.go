@@ -0,0 +1,393 @@
+package emergencyfund
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"clockzen-next/internal/application/emergencyfund"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/liquidaccount"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// Widget history defaults and limits, in days
+const (
+	defaultHistoryDays = 90
+	maxHistoryDays     = 730
+)
+
+// ErrorResponse represents an error response
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// CreateAccountRequest represents a request to record a liquid account
+type CreateAccountRequest struct {
+	Name          string  `json:"name"`
+	Type          string  `json:"type,omitempty"`
+	Balance       float64 `json:"balance"`
+	EmergencyFund *bool   `json:"emergency_fund,omitempty"`
+}
+
+// UpdateAccountRequest represents a request to update a liquid account
+type UpdateAccountRequest struct {
+	Name          *string  `json:"name,omitempty"`
+	Type          *string  `json:"type,omitempty"`
+	Balance       *float64 `json:"balance,omitempty"`
+	EmergencyFund *bool    `json:"emergency_fund,omitempty"`
+}
+
+// SetTargetRequest represents a request to set the emergency fund target
+type SetTargetRequest struct {
+	TargetMonths float64 `json:"target_months"`
+}
+
+// AccountResponse represents a liquid account
+type AccountResponse struct {
+	ID            string    `json:"id"`
+	Name          string    `json:"name"`
+	Type          string    `json:"type"`
+	Balance       float64   `json:"balance"`
+	EmergencyFund bool      `json:"emergency_fund"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// ListAccountsResponse represents a list of liquid accounts
+type ListAccountsResponse struct {
+	Accounts []AccountResponse `json:"accounts"`
+	Total    int               `json:"total"`
+}
+
+// HistoryPoint is the fund's coverage on a day
+type HistoryPoint struct {
+	Date            time.Time `json:"date"`
+	Balance         float64   `json:"balance"`
+	MonthlyExpenses float64   `json:"monthly_expenses"`
+	MonthsCovered   *float64  `json:"months_covered,omitempty"`
+	TargetMonths    float64   `json:"target_months"`
+	BelowTarget     bool      `json:"below_target"`
+}
+
+// WidgetResponse is the emergency fund's coverage for the dashboard, with
+// its history
+type WidgetResponse struct {
+	AccountCount    int     `json:"account_count"`
+	Balance         float64 `json:"balance"`
+	MonthlyExpenses float64 `json:"monthly_expenses"`
+	ExpenseMonths   int     `json:"expense_months"`
+	// Unset when the user has no expense history
+	MonthsCovered *float64       `json:"months_covered,omitempty"`
+	TargetMonths  float64        `json:"target_months"`
+	TargetBalance float64        `json:"target_balance,omitempty"`
+	Shortfall     float64        `json:"shortfall,omitempty"`
+	BelowTarget   bool           `json:"below_target"`
+	CheckedAt     time.Time      `json:"checked_at"`
+	History       []HistoryPoint `json:"history"`
+}
+
+// EmergencyFundHandler handles HTTP requests for liquid accounts and the
+// emergency fund they make up
+type EmergencyFundHandler struct {
+	service *emergencyfund.Service
+}
+
+// NewEmergencyFundHandler creates a new EmergencyFundHandler instance
+func NewEmergencyFundHandler(service *emergencyfund.Service) *EmergencyFundHandler {
+	return &EmergencyFundHandler{
+		service: service,
+	}
+}
+
+// HandleWidget handles GET /api/emergency-fund, the months of expenses the
+// emergency fund covers for the dashboard, with ?days of history
+func (h *EmergencyFundHandler) HandleWidget(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	days := defaultHistoryDays
+	if value := r.URL.Query().Get("days"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > maxHistoryDays {
+			h.writeError(w, http.StatusBadRequest, "invalid_parameter", "days must be between 1 and "+strconv.Itoa(maxHistoryDays))
+			return
+		}
+		days = parsed
+	}
+
+	status, err := h.service.GetStatus(r.Context(), userID)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to check emergency fund: "+err.Error())
+		return
+	}
+	snapshots, err := h.service.GetHistory(r.Context(), userID, time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get emergency fund history: "+err.Error())
+		return
+	}
+
+	resp := WidgetResponse{
+		AccountCount:    status.AccountCount,
+		Balance:         status.Balance,
+		MonthlyExpenses: status.MonthlyExpenses,
+		ExpenseMonths:   status.ExpenseMonths,
+		MonthsCovered:   status.MonthsCovered,
+		TargetMonths:    status.TargetMonths,
+		TargetBalance:   status.TargetBalance,
+		Shortfall:       status.Shortfall,
+		BelowTarget:     status.BelowTarget,
+		CheckedAt:       status.CheckedAt,
+		History:         make([]HistoryPoint, len(snapshots)),
+	}
+	for i, snapshot := range snapshots {
+		resp.History[i] = HistoryPoint{
+			Date:            snapshot.Date,
+			Balance:         snapshot.Balance,
+			MonthlyExpenses: snapshot.MonthlyExpenses,
+			MonthsCovered:   snapshot.MonthsCovered,
+			TargetMonths:    snapshot.TargetMonths,
+			BelowTarget:     snapshot.BelowTarget,
+		}
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleSetTarget handles PUT /api/emergency-fund/target
+func (h *EmergencyFundHandler) HandleSetTarget(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only PUT method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req SetTargetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	if err := h.service.SetTargetMonths(r.Context(), userID, req.TargetMonths); err != nil {
+		if errors.Is(err, emergencyfund.ErrInvalidTarget) {
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "update_failed", "Failed to set target: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, req)
+}
+
+// HandleListAccounts handles GET /api/emergency-fund/accounts
+func (h *EmergencyFundHandler) HandleListAccounts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	records, err := h.service.ListAccounts(r.Context(), userID)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to list accounts: "+err.Error())
+		return
+	}
+
+	resp := ListAccountsResponse{
+		Accounts: make([]AccountResponse, len(records)),
+		Total:    len(records),
+	}
+	for i, record := range records {
+		resp.Accounts[i] = accountToResponse(record)
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleCreateAccount handles POST /api/emergency-fund/accounts
+func (h *EmergencyFundHandler) HandleCreateAccount(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req CreateAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	record, err := h.service.CreateAccount(r.Context(), userID, emergencyfund.AccountInput{
+		Name:          req.Name,
+		Type:          liquidaccount.Type(req.Type),
+		Balance:       req.Balance,
+		EmergencyFund: req.EmergencyFund,
+	})
+	if err != nil {
+		if isValidationError(err) {
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "create_failed", "Failed to create account: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, accountToResponse(record))
+}
+
+// HandleGetAccount handles GET /api/emergency-fund/accounts/{id}
+func (h *EmergencyFundHandler) HandleGetAccount(w http.ResponseWriter, r *http.Request, accountID string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	record, err := h.service.GetAccount(r.Context(), userID, accountID)
+	if err != nil {
+		if errors.Is(err, emergencyfund.ErrAccountNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Account not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get account: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, accountToResponse(record))
+}
+
+// HandleUpdateAccount handles PUT/PATCH /api/emergency-fund/accounts/{id}
+func (h *EmergencyFundHandler) HandleUpdateAccount(w http.ResponseWriter, r *http.Request, accountID string) {
+	if r.Method != http.MethodPut && r.Method != http.MethodPatch {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only PUT/PATCH methods are allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req UpdateAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	update := emergencyfund.AccountUpdate{
+		Name:          req.Name,
+		Balance:       req.Balance,
+		EmergencyFund: req.EmergencyFund,
+	}
+	if req.Type != nil {
+		accountType := liquidaccount.Type(*req.Type)
+		update.Type = &accountType
+	}
+
+	record, err := h.service.UpdateAccount(r.Context(), userID, accountID, update)
+	if err != nil {
+		switch {
+		case errors.Is(err, emergencyfund.ErrAccountNotFound):
+			h.writeError(w, http.StatusNotFound, "not_found", "Account not found")
+		case isValidationError(err):
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		default:
+			h.writeError(w, http.StatusInternalServerError, "update_failed", "Failed to update account: "+err.Error())
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, accountToResponse(record))
+}
+
+// HandleDeleteAccount handles DELETE /api/emergency-fund/accounts/{id}
+func (h *EmergencyFundHandler) HandleDeleteAccount(w http.ResponseWriter, r *http.Request, accountID string) {
+	if r.Method != http.MethodDelete {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only DELETE method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	if err := h.service.DeleteAccount(r.Context(), userID, accountID); err != nil {
+		if errors.Is(err, emergencyfund.ErrAccountNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Account not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "delete_failed", "Failed to delete account: "+err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// isValidationError reports whether err is a rejected account field
+func isValidationError(err error) bool {
+	return errors.Is(err, emergencyfund.ErrInvalidName) ||
+		errors.Is(err, emergencyfund.ErrInvalidType) ||
+		errors.Is(err, emergencyfund.ErrInvalidBalance)
+}
+
+// accountToResponse converts a liquid account to its response
+func accountToResponse(a *ent.LiquidAccount) AccountResponse {
+	return AccountResponse{
+		ID:            a.ID,
+		Name:          a.Name,
+		Type:          string(a.Type),
+		Balance:       a.Balance,
+		EmergencyFund: a.EmergencyFund,
+		CreatedAt:     a.CreatedAt,
+		UpdatedAt:     a.UpdatedAt,
+	}
+}
+
+// writeJSON writes a JSON response
+func (h *EmergencyFundHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *EmergencyFundHandler) writeError(w http.ResponseWriter, status int, errCode string, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
@@ -0,0 +1,93 @@
+package emergencyfund
+
+import (
+	"net/http"
+	"strings"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/application/emergencyfund"
+	"clockzen-next/internal/ent"
+)
+
+// Router handles routing for emergency fund endpoints
+type Router struct {
+	handler *EmergencyFundHandler
+}
+
+// NewRouter creates a new Router with the given handler
+func NewRouter(handler *EmergencyFundHandler) *Router {
+	return &Router{
+		handler: handler,
+	}
+}
+
+// NewDefaultRouter creates a new Router backed by the given ent client,
+// averaging expenses from the transactions in expenses
+func NewDefaultRouter(entClient *ent.Client, expenses analysis.TransactionRepository) *Router {
+	return &Router{
+		handler: NewEmergencyFundHandler(emergencyfund.NewService(entClient, expenses)),
+	}
+}
+
+// RegisterRoutes registers all emergency fund routes with the given mux
+// Total routes: 7 endpoints
+//
+// Accounts belong to the authenticated user. Those designated as the
+// emergency fund are measured in months of the user's average expenses
+// over the last 6 months, against the user's target (6 months unless set).
+//
+//  1. GET    /api/emergency-fund                 - Coverage and history for the dashboard (with ?days)
+//  2. PUT    /api/emergency-fund/target          - Set the target months of expenses
+//  3. GET    /api/emergency-fund/accounts        - List liquid accounts
+//  4. POST   /api/emergency-fund/accounts        - Record a liquid account
+//  5. GET    /api/emergency-fund/accounts/{id}   - Get an account
+//  6. PUT    /api/emergency-fund/accounts/{id}   - Update an account (also PATCH)
+//  7. DELETE /api/emergency-fund/accounts/{id}   - Delete an account
+func (r *Router) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/emergency-fund", r.handler.HandleWidget)
+	mux.HandleFunc("/api/emergency-fund/", r.handleEmergencyFund)
+}
+
+// handleEmergencyFund routes requests for /api/emergency-fund/target and
+// /api/emergency-fund/accounts[/{id}]
+func (r *Router) handleEmergencyFund(w http.ResponseWriter, req *http.Request) {
+	path := strings.TrimPrefix(req.URL.Path, "/api/emergency-fund/")
+	parts := strings.Split(path, "/")
+
+	switch {
+	case len(parts) == 1 && parts[0] == "target":
+		r.handler.HandleSetTarget(w, req)
+	case len(parts) == 1 && parts[0] == "accounts":
+		r.handleAccounts(w, req)
+	case len(parts) == 2 && parts[0] == "accounts" && parts[1] != "":
+		r.handleAccountByID(w, req, parts[1])
+	default:
+		http.Error(w, "Not found", http.StatusNotFound)
+	}
+}
+
+// handleAccounts routes requests for /api/emergency-fund/accounts
+func (r *Router) handleAccounts(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleListAccounts(w, req)
+	case http.MethodPost:
+		r.handler.HandleCreateAccount(w, req)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleAccountByID routes requests for /api/emergency-fund/accounts/{id}
+func (r *Router) handleAccountByID(w http.ResponseWriter, req *http.Request, accountID string) {
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleGetAccount(w, req, accountID)
+	case http.MethodPut, http.MethodPatch:
+		r.handler.HandleUpdateAccount(w, req, accountID)
+	case http.MethodDelete:
+		r.handler.HandleDeleteAccount(w, req, accountID)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,314 @@
+package goals
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"clockzen-next/internal/application/goals"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/goal"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// ErrorResponse represents an error response
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// CreateGoalRequest represents a request to set a goal, linked to one of a
+// category or an account
+type CreateGoalRequest struct {
+	Name           string     `json:"name"`
+	Type           string     `json:"type,omitempty"`
+	TargetAmount   float64    `json:"target_amount,omitempty"`
+	StartingAmount float64    `json:"starting_amount,omitempty"`
+	Deadline       *time.Time `json:"deadline,omitempty"`
+	Category       string     `json:"category,omitempty"`
+	AccountID      string     `json:"account_id,omitempty"`
+}
+
+// UpdateGoalRequest represents a request to update a goal
+type UpdateGoalRequest struct {
+	Name           *string    `json:"name,omitempty"`
+	TargetAmount   *float64   `json:"target_amount,omitempty"`
+	StartingAmount *float64   `json:"starting_amount,omitempty"`
+	Deadline       *time.Time `json:"deadline,omitempty"`
+	ClearDeadline  bool       `json:"clear_deadline,omitempty"`
+}
+
+// GoalResponse represents a goal
+type GoalResponse struct {
+	ID             string     `json:"id"`
+	Name           string     `json:"name"`
+	Type           string     `json:"type"`
+	TargetAmount   float64    `json:"target_amount"`
+	StartingAmount float64    `json:"starting_amount"`
+	Deadline       *time.Time `json:"deadline,omitempty"`
+	Category       *string    `json:"category,omitempty"`
+	AccountID      *string    `json:"account_id,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// ListGoalsResponse represents a list of goals
+type ListGoalsResponse struct {
+	Goals []GoalResponse `json:"goals"`
+	Total int            `json:"total"`
+}
+
+// ProgressResponse is a goal's progress and monthly timeline
+type ProgressResponse struct {
+	GoalID string `json:"goal_id"`
+	goals.Progress
+}
+
+// GoalHandler handles HTTP requests for goals and their progress
+type GoalHandler struct {
+	service *goals.Service
+}
+
+// NewGoalHandler creates a new GoalHandler instance
+func NewGoalHandler(service *goals.Service) *GoalHandler {
+	return &GoalHandler{
+		service: service,
+	}
+}
+
+// HandleList handles GET /api/goals
+func (h *GoalHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	records, err := h.service.ListGoals(r.Context(), userID)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to list goals: "+err.Error())
+		return
+	}
+
+	resp := ListGoalsResponse{
+		Goals: make([]GoalResponse, len(records)),
+		Total: len(records),
+	}
+	for i, record := range records {
+		resp.Goals[i] = goalToResponse(record)
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleCreate handles POST /api/goals
+func (h *GoalHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req CreateGoalRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	record, err := h.service.CreateGoal(r.Context(), userID, goals.GoalInput{
+		Name:           req.Name,
+		Type:           goal.Type(req.Type),
+		TargetAmount:   req.TargetAmount,
+		StartingAmount: req.StartingAmount,
+		Deadline:       req.Deadline,
+		Category:       req.Category,
+		AccountID:      req.AccountID,
+	})
+	if err != nil {
+		if isValidationError(err) || errors.Is(err, goals.ErrAccountNotFound) {
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "create_failed", "Failed to create goal: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, goalToResponse(record))
+}
+
+// HandleGet handles GET /api/goals/{id}
+func (h *GoalHandler) HandleGet(w http.ResponseWriter, r *http.Request, goalID string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	record, err := h.service.GetGoal(r.Context(), userID, goalID)
+	if err != nil {
+		if errors.Is(err, goals.ErrGoalNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Goal not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get goal: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, goalToResponse(record))
+}
+
+// HandleUpdate handles PUT/PATCH /api/goals/{id}
+func (h *GoalHandler) HandleUpdate(w http.ResponseWriter, r *http.Request, goalID string) {
+	if r.Method != http.MethodPut && r.Method != http.MethodPatch {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only PUT/PATCH methods are allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req UpdateGoalRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	record, err := h.service.UpdateGoal(r.Context(), userID, goalID, goals.GoalUpdate{
+		Name:           req.Name,
+		TargetAmount:   req.TargetAmount,
+		StartingAmount: req.StartingAmount,
+		Deadline:       req.Deadline,
+		ClearDeadline:  req.ClearDeadline,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, goals.ErrGoalNotFound):
+			h.writeError(w, http.StatusNotFound, "not_found", "Goal not found")
+		case isValidationError(err):
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		default:
+			h.writeError(w, http.StatusInternalServerError, "update_failed", "Failed to update goal: "+err.Error())
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, goalToResponse(record))
+}
+
+// HandleDelete handles DELETE /api/goals/{id}
+func (h *GoalHandler) HandleDelete(w http.ResponseWriter, r *http.Request, goalID string) {
+	if r.Method != http.MethodDelete {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only DELETE method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	if err := h.service.DeleteGoal(r.Context(), userID, goalID); err != nil {
+		if errors.Is(err, goals.ErrGoalNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Goal not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "delete_failed", "Failed to delete goal: "+err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleProgress handles GET /api/goals/{id}/progress, the goal's progress,
+// projected completion and monthly timeline
+func (h *GoalHandler) HandleProgress(w http.ResponseWriter, r *http.Request, goalID string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	progress, err := h.service.GetProgress(r.Context(), userID, goalID, time.Now())
+	if err != nil {
+		switch {
+		case errors.Is(err, goals.ErrGoalNotFound):
+			h.writeError(w, http.StatusNotFound, "not_found", "Goal not found")
+		case errors.Is(err, goals.ErrAccountNotFound):
+			h.writeError(w, http.StatusConflict, "account_not_found", "The goal's linked account no longer exists")
+		case errors.Is(err, goals.ErrNoContributions):
+			h.writeError(w, http.StatusServiceUnavailable, "not_configured", err.Error())
+		default:
+			h.writeError(w, http.StatusInternalServerError, "progress_failed", "Failed to compute progress: "+err.Error())
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, ProgressResponse{
+		GoalID:   goalID,
+		Progress: *progress,
+	})
+}
+
+// isValidationError reports whether err is a rejected goal field
+func isValidationError(err error) bool {
+	return errors.Is(err, goals.ErrInvalidName) ||
+		errors.Is(err, goals.ErrInvalidType) ||
+		errors.Is(err, goals.ErrInvalidTarget) ||
+		errors.Is(err, goals.ErrInvalidStartingAmount) ||
+		errors.Is(err, goals.ErrInvalidLink)
+}
+
+// goalToResponse converts a goal to its response
+func goalToResponse(g *ent.Goal) GoalResponse {
+	return GoalResponse{
+		ID:             g.ID,
+		Name:           g.Name,
+		Type:           string(g.Type),
+		TargetAmount:   g.TargetAmount,
+		StartingAmount: g.StartingAmount,
+		Deadline:       g.Deadline,
+		Category:       g.Category,
+		AccountID:      g.AccountID,
+		CreatedAt:      g.CreatedAt,
+		UpdatedAt:      g.UpdatedAt,
+	}
+}
+
+// writeJSON writes a JSON response
+func (h *GoalHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *GoalHandler) writeError(w http.ResponseWriter, status int, errCode string, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
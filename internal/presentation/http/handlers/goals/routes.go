@@ -0,0 +1,93 @@
+package goals
+
+import (
+	"net/http"
+	"strings"
+
+	"clockzen-next/internal/application/goals"
+	"clockzen-next/internal/ent"
+)
+
+// Router handles routing for goal endpoints
+type Router struct {
+	handler *GoalHandler
+}
+
+// NewRouter creates a new Router with the given handler
+func NewRouter(handler *GoalHandler) *Router {
+	return &Router{
+		handler: handler,
+	}
+}
+
+// NewDefaultRouter creates a new Router backed by the given ent client,
+// finding goal contributions in contributions
+func NewDefaultRouter(entClient *ent.Client, contributions goals.ContributionRepository) *Router {
+	return &Router{
+		handler: NewGoalHandler(goals.NewService(entClient, contributions)),
+	}
+}
+
+// RegisterRoutes registers all goal routes with the given mux
+// Total routes: 6 endpoints
+//
+// Goals belong to the authenticated user. A goal is linked to a category,
+// whose transactions since it was set count toward it, or to an account: a
+// liquid account for savings goals, a debt for debt payoff goals. Its
+// completion is projected at the rate it progressed over recent months.
+//
+//  1. GET    /api/goals               - List goals
+//  2. POST   /api/goals               - Set a goal
+//  3. GET    /api/goals/{id}          - Get a goal
+//  4. PUT    /api/goals/{id}          - Update a goal (also PATCH)
+//  5. DELETE /api/goals/{id}          - Delete a goal
+//  6. GET    /api/goals/{id}/progress - Progress, projected completion and monthly timeline
+func (r *Router) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/goals", r.handleGoals)
+	mux.HandleFunc("/api/goals/", r.handleGoalByID)
+}
+
+// handleGoals routes requests for /api/goals
+func (r *Router) handleGoals(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleList(w, req)
+	case http.MethodPost:
+		r.handler.HandleCreate(w, req)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleGoalByID routes requests for /api/goals/{id} and
+// /api/goals/{id}/progress
+func (r *Router) handleGoalByID(w http.ResponseWriter, req *http.Request) {
+	path := strings.TrimPrefix(req.URL.Path, "/api/goals/")
+	parts := strings.Split(path, "/")
+
+	if len(parts) == 0 || parts[0] == "" {
+		http.Error(w, "Goal ID required", http.StatusBadRequest)
+		return
+	}
+
+	goalID := parts[0]
+	if len(parts) > 1 {
+		if len(parts) == 2 && parts[1] == "progress" {
+			r.handler.HandleProgress(w, req, goalID)
+			return
+		}
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleGet(w, req, goalID)
+	case http.MethodPut, http.MethodPatch:
+		r.handler.HandleUpdate(w, req, goalID)
+	case http.MethodDelete:
+		r.handler.HandleDelete(w, req, goalID)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
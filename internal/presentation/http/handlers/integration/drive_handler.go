@@ -2,18 +2,30 @@ package integration
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 
 	"clockzen-next/internal/application/integration"
+	"clockzen-next/internal/application/organizations"
 	"clockzen-next/internal/ent"
 	"clockzen-next/internal/ent/googledriveconnection"
 	"clockzen-next/internal/ent/googledrivefolder"
+	"clockzen-next/internal/ent/googledrivesync"
+	"clockzen-next/internal/ent/predicate"
 	"clockzen-next/internal/infrastructure/google"
+	"clockzen-next/internal/infrastructure/oauthstate"
+	"clockzen-next/internal/presentation/http/middleware"
+	"clockzen-next/internal/presentation/http/pagination"
 )
 
 // DriveHandler handles HTTP requests for Google Drive integration
@@ -21,15 +33,28 @@ type DriveHandler struct {
 	mu          sync.RWMutex
 	entClient   *ent.Client
 	oauthConfig *google.Config
+	tokens      *integration.TokenStore
 	syncService *integration.DriveSyncService
-	states      map[string]stateData // CSRF state storage
+	states      oauthstate.Store // CSRF state storage
 }
 
+// stateTTL is how long an OAuth flow can take before its state expires
+const stateTTL = 10 * time.Minute
+
 // stateData holds OAuth state information
 type stateData struct {
-	UserID    string
-	CreatedAt time.Time
-	Scopes    []string
+	UserID         string   `json:"user_id"`
+	OrganizationID string   `json:"organization_id,omitempty"` // empty outside organizations
+	Scopes         []string `json:"scopes"`
+	CodeVerifier   string   `json:"code_verifier,omitempty"` // PKCE verifier sent with the code exchange
+}
+
+// organization returns the organization the connection is made in, or nil
+func (s stateData) organization() *string {
+	if s.OrganizationID == "" {
+		return nil
+	}
+	return &s.OrganizationID
 }
 
 // NewDriveHandler creates a new DriveHandler instance
@@ -38,8 +63,9 @@ func NewDriveHandler(entClient *ent.Client, oauthConfig *google.Config) *DriveHa
 	return &DriveHandler{
 		entClient:   entClient,
 		oauthConfig: oauthConfig,
+		tokens:      integration.NewTokenStore(entClient, nil),
 		syncService: syncService,
-		states:      make(map[string]stateData),
+		states:      oauthstate.NewMemoryStore(),
 	}
 }
 
@@ -48,24 +74,62 @@ func NewDriveHandlerWithSyncService(entClient *ent.Client, oauthConfig *google.C
 	return &DriveHandler{
 		entClient:   entClient,
 		oauthConfig: oauthConfig,
+		tokens:      integration.NewTokenStore(entClient, nil),
 		syncService: syncService,
-		states:      make(map[string]stateData),
+		states:      oauthstate.NewMemoryStore(),
 	}
 }
 
+// SetTokenStore sets the store used to encrypt and decrypt connection
+// tokens, for both the handler and its sync service
+func (h *DriveHandler) SetTokenStore(tokens *integration.TokenStore) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.tokens = tokens
+	h.syncService.SetTokenStore(tokens)
+}
+
+// SetTokenManager sets the manager refreshing connection tokens, for both
+// the handler and its sync service, and uses its token store
+func (h *DriveHandler) SetTokenManager(manager *integration.TokenManager) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.tokens = manager.TokenStore()
+	h.syncService.SetTokenManager(manager)
+}
+
+// SetStateStore sets the store OAuth states are kept in between the
+// initiate and callback requests
+func (h *DriveHandler) SetStateStore(states oauthstate.Store) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.states = states
+}
+
 // ErrorResponse represents an error response
 type ErrorResponse struct {
 	Error   string `json:"error"`
 	Message string `json:"message"`
 }
 
+// ReauthRequiredResponse is the error response when Google no longer
+// accepts a connection's refresh token. The connection is marked expired;
+// sending the user to ReauthURL connects the account again.
+type ReauthRequiredResponse struct {
+	Error        string `json:"error"`
+	Message      string `json:"message"`
+	ConnectionID string `json:"connection_id"`
+	ReauthURL    string `json:"reauth_url"`
+	State        string `json:"state"`
+}
+
 // ========================================
 // OAuth Handlers
 // ========================================
 
 // InitiateOAuthRequest represents a request to initiate OAuth flow
 type InitiateOAuthRequest struct {
-	UserID string   `json:"user_id"`
+	UserID string   `json:"user_id,omitempty"` // defaults to the authenticated user
 	Scopes []string `json:"scopes,omitempty"`
 }
 
@@ -88,8 +152,14 @@ func (h *DriveHandler) HandleInitiateOAuth(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	if req.UserID == "" {
-		h.writeError(w, http.StatusBadRequest, "validation_error", "user_id is required")
+	// Connections are always made by the authenticated user, in the
+	// organization the request acts in if any
+	userID, organizationID, ok := requireConnector(w, r, h.writeError)
+	if !ok {
+		return
+	}
+	if req.UserID != "" && req.UserID != userID && req.UserID != organizationID {
+		h.writeError(w, http.StatusForbidden, "forbidden", "Cannot connect an account for another user")
 		return
 	}
 
@@ -102,37 +172,70 @@ func (h *DriveHandler) HandleInitiateOAuth(w http.ResponseWriter, r *http.Reques
 		scopes = google.DriveScopes()
 	}
 
-	// Store state with user context
-	h.mu.Lock()
-	h.states[state] = stateData{
-		UserID:    req.UserID,
-		CreatedAt: time.Now(),
-		Scopes:    scopes,
+	authURL, err := h.authorizationURL(r.Context(), state, stateData{
+		UserID:         userID,
+		OrganizationID: organizationID,
+		Scopes:         scopes,
+	})
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "oauth_error", "Failed to start OAuth flow: "+err.Error())
+		return
 	}
-	h.mu.Unlock()
 
-	// Clean up old states (older than 10 minutes)
-	go h.cleanupOldStates()
+	h.writeJSON(w, http.StatusOK, InitiateOAuthResponse{
+		AuthorizationURL: authURL,
+		State:            state,
+	})
+}
 
-	// Create OAuth client and generate auth URL
-	config := &google.Config{
+// authorizationURL stores the state of a new OAuth flow, with a fresh PKCE
+// verifier, and returns the URL that sends the user to Google's consent
+// screen
+func (h *DriveHandler) authorizationURL(ctx context.Context, state string, data stateData, opts ...google.AuthCodeOption) (string, error) {
+	oauthClient, err := google.NewClient(&google.Config{
 		ClientID:     h.oauthConfig.ClientID,
 		ClientSecret: h.oauthConfig.ClientSecret,
 		RedirectURL:  h.oauthConfig.RedirectURL,
-		Scopes:       scopes,
+		Scopes:       data.Scopes,
+	})
+	if err != nil {
+		return "", err
 	}
 
-	oauthClient, err := google.NewClient(config)
+	data.CodeVerifier, err = google.GenerateCodeVerifier()
 	if err != nil {
-		h.writeError(w, http.StatusInternalServerError, "oauth_error", "Failed to create OAuth client: "+err.Error())
-		return
+		return "", err
+	}
+	if err := oauthstate.SaveJSON(ctx, h.states, state, data, stateTTL); err != nil {
+		return "", err
 	}
 
-	authURL := oauthClient.AuthCodeURL(state, google.WithPrompt("consent"))
+	opts = append([]google.AuthCodeOption{google.WithPrompt("consent"), google.WithCodeChallenge(data.CodeVerifier)}, opts...)
+	return oauthClient.AuthCodeURL(state, opts...), nil
+}
 
-	h.writeJSON(w, http.StatusOK, InitiateOAuthResponse{
-		AuthorizationURL: authURL,
-		State:            state,
+// writeReauthRequired responds to a request on a connection whose refresh
+// token Google rejected, which the token manager has marked expired, with a
+// URL that connects it again
+func (h *DriveHandler) writeReauthRequired(w http.ResponseWriter, r *http.Request, conn *ent.GoogleDriveConnection) {
+	ctx := r.Context()
+	state := uuid.New().String()
+	data := stateData{UserID: conn.UserID, Scopes: google.DriveScopes()}
+	if conn.OrganizationID != nil {
+		data.OrganizationID = *conn.OrganizationID
+	}
+	reauthURL, err := h.authorizationURL(ctx, state, data, google.WithLoginHint(conn.Email))
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "oauth_error", "Failed to start OAuth flow: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusConflict, ReauthRequiredResponse{
+		Error:        "reauth_required",
+		Message:      "Google no longer accepts this connection's authorization; connect the account again",
+		ConnectionID: conn.ID,
+		ReauthURL:    reauthURL,
+		State:        state,
 	})
 }
 
@@ -147,12 +250,15 @@ type OAuthCallbackRequest struct {
 type ConnectionResponse struct {
 	ID              string     `json:"id"`
 	UserID          string     `json:"user_id"`
+	OrganizationID  *string    `json:"organization_id,omitempty"`
 	GoogleAccountID string     `json:"google_account_id"`
 	Email           string     `json:"email"`
 	Status          string     `json:"status"`
 	CreatedAt       time.Time  `json:"created_at"`
 	UpdatedAt       time.Time  `json:"updated_at"`
 	LastSyncAt      *time.Time `json:"last_sync_at,omitempty"`
+	SyncSchedule    string     `json:"sync_schedule"`
+	NextSyncAt      *time.Time `json:"next_sync_at,omitempty"`
 }
 
 // HandleOAuthCallback handles GET/POST /api/integrations/drive/oauth/callback
@@ -189,22 +295,14 @@ func (h *DriveHandler) HandleOAuthCallback(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	// Validate and retrieve state
-	h.mu.Lock()
-	stateInfo, exists := h.states[state]
-	if exists {
-		delete(h.states, state)
-	}
-	h.mu.Unlock()
-
-	if !exists {
-		h.writeError(w, http.StatusBadRequest, "invalid_state", "Invalid or expired state parameter")
-		return
-	}
-
-	// Check state age (10 minute max)
-	if time.Since(stateInfo.CreatedAt) > 10*time.Minute {
-		h.writeError(w, http.StatusBadRequest, "expired_state", "State parameter has expired")
+	// Validate and retrieve state; expired states are never returned
+	var stateInfo stateData
+	if err := oauthstate.TakeJSON(r.Context(), h.states, state, &stateInfo); err != nil {
+		if errors.Is(err, oauthstate.ErrNotFound) {
+			h.writeError(w, http.StatusBadRequest, "invalid_state", "Invalid or expired state parameter")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "internal_error", "Failed to retrieve OAuth state")
 		return
 	}
 
@@ -222,9 +320,13 @@ func (h *DriveHandler) HandleOAuthCallback(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	// Exchange code for token
+	// Exchange code for token, proving the flow was started here
 	ctx := r.Context()
-	token, err := oauthClient.Exchange(ctx, code)
+	var exchangeOpts []google.ExchangeOption
+	if stateInfo.CodeVerifier != "" {
+		exchangeOpts = append(exchangeOpts, google.WithCodeVerifier(stateInfo.CodeVerifier))
+	}
+	token, err := oauthClient.Exchange(ctx, code, exchangeOpts...)
 	if err != nil {
 		h.writeError(w, http.StatusBadRequest, "exchange_failed", "Failed to exchange authorization code: "+err.Error())
 		return
@@ -237,43 +339,53 @@ func (h *DriveHandler) HandleOAuthCallback(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	// Check if connection already exists for this Google account
-	existingConn, err := h.entClient.GoogleDriveConnection.Query().
-		Where(googledriveconnection.GoogleAccountID(userInfo.ID)).
-		Only(ctx)
-
-	var conn *ent.GoogleDriveConnection
-	if err == nil {
-		// Update existing connection
-		conn, err = existingConn.Update().
-			SetAccessToken(token.AccessToken).
-			SetRefreshToken(token.RefreshToken).
-			SetTokenExpiry(token.Expiry).
-			SetStatus(googledriveconnection.StatusActive).
-			SetEmail(userInfo.Email).
-			Save(ctx)
-		if err != nil {
-			h.writeError(w, http.StatusInternalServerError, "update_failed", "Failed to update connection: "+err.Error())
-			return
-		}
-	} else if ent.IsNotFound(err) {
-		// Create new connection
-		conn, err = h.entClient.GoogleDriveConnection.Create().
-			SetID(uuid.New().String()).
-			SetUserID(stateInfo.UserID).
-			SetGoogleAccountID(userInfo.ID).
-			SetEmail(userInfo.Email).
-			SetAccessToken(token.AccessToken).
-			SetRefreshToken(token.RefreshToken).
-			SetTokenExpiry(token.Expiry).
-			SetStatus(googledriveconnection.StatusActive).
-			Save(ctx)
-		if err != nil {
-			h.writeError(w, http.StatusInternalServerError, "create_failed", "Failed to create connection: "+err.Error())
-			return
-		}
-	} else {
-		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to check existing connection: "+err.Error())
+	accessToken, refreshToken, err := h.tokens.Seal(token)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "save_failed", "Failed to secure tokens: "+err.Error())
+		return
+	}
+
+	// Upsert the connection. The unique google_account_id index makes
+	// concurrent callbacks for the same account converge on one row. An
+	// account already connected by another user is left alone, and a
+	// re-consent that returns no refresh token keeps the stored one.
+	connID, err := h.entClient.GoogleDriveConnection.Create().
+		SetID(uuid.New().String()).
+		SetUserID(stateInfo.UserID).
+		SetNillableOrganizationID(stateInfo.organization()).
+		SetGoogleAccountID(userInfo.ID).
+		SetEmail(userInfo.Email).
+		SetAccessToken(accessToken).
+		SetRefreshToken(refreshToken).
+		SetTokenExpiry(token.Expiry).
+		SetStatus(googledriveconnection.StatusActive).
+		OnConflict(
+			entsql.ConflictColumns(googledriveconnection.FieldGoogleAccountID),
+			entsql.UpdateWhere(ownedBy(stateInfo.UserID, stateInfo.OrganizationID)),
+		).
+		Update(func(u *ent.GoogleDriveConnectionUpsert) {
+			u.UpdateAccessToken()
+			if token.RefreshToken != "" {
+				u.UpdateRefreshToken()
+			}
+			u.UpdateTokenExpiry()
+			u.UpdateStatus()
+			u.UpdateEmail()
+			u.SetUpdatedAt(time.Now())
+		}).
+		ID(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		h.writeError(w, http.StatusConflict, "account_connected", "This account is connected to another user")
+		return
+	}
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "save_failed", "Failed to save connection: "+err.Error())
+		return
+	}
+
+	conn, err := h.entClient.GoogleDriveConnection.Get(ctx, connID)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get connection: "+err.Error())
 		return
 	}
 
@@ -301,10 +413,10 @@ func (h *DriveHandler) HandleDisconnect(w http.ResponseWriter, r *http.Request,
 	}
 
 	// Optionally revoke the token with Google
-	if conn.RefreshToken != "" {
+	if token, err := h.tokens.DriveToken(conn); err == nil && token.RefreshToken != "" {
 		oauthClient, err := google.NewClient(h.oauthConfig)
 		if err == nil {
-			_ = oauthClient.RevokeToken(ctx, conn.RefreshToken)
+			_ = oauthClient.RevokeToken(ctx, token.RefreshToken)
 		}
 	}
 
@@ -328,6 +440,7 @@ func (h *DriveHandler) HandleDisconnect(w http.ResponseWriter, r *http.Request,
 type ListConnectionsResponse struct {
 	Connections []*ConnectionResponse `json:"connections"`
 	Total       int                   `json:"total"`
+	NextCursor  string                `json:"next_cursor,omitempty"`
 }
 
 // HandleListConnections handles GET /api/integrations/drive/connections
@@ -338,22 +451,43 @@ func (h *DriveHandler) HandleListConnections(w http.ResponseWriter, r *http.Requ
 	}
 
 	ctx := r.Context()
-	userID := r.URL.Query().Get("user_id")
+	userID, ok := middleware.OwnerIDFromContext(ctx)
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
 
-	query := h.entClient.GoogleDriveConnection.Query()
-	if userID != "" {
-		query = query.Where(googledriveconnection.UserID(userID))
+	page, err := driveConnectionList.Parse(r.URL.Query())
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
 	}
 
-	connections, err := query.All(ctx)
+	query := h.entClient.GoogleDriveConnection.Query().
+		Where(
+			organizations.OwnedBy[predicate.GoogleDriveConnection](userID),
+			pagination.Filter[predicate.GoogleDriveConnection](page),
+		)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to count connections: "+err.Error())
+		return
+	}
+	connections, err := query.
+		Where(pagination.After[predicate.GoogleDriveConnection](page)).
+		Order(pagination.Order[googledriveconnection.OrderOption](page)).
+		Limit(page.Limit + 1).
+		All(ctx)
 	if err != nil {
 		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to list connections: "+err.Error())
 		return
 	}
+	connections, next := page.Trim(connections)
 
 	resp := ListConnectionsResponse{
 		Connections: make([]*ConnectionResponse, len(connections)),
-		Total:       len(connections),
+		Total:       total,
+		NextCursor:  next,
 	}
 	for i, conn := range connections {
 		resp.Connections[i] = h.connectionToResponse(conn)
@@ -383,13 +517,33 @@ func (h *DriveHandler) HandleGetConnection(w http.ResponseWriter, r *http.Reques
 	h.writeJSON(w, http.StatusOK, h.connectionToResponse(conn))
 }
 
-// HandleRefreshConnection handles POST /api/integrations/drive/connections/{id}/refresh
-func (h *DriveHandler) HandleRefreshConnection(w http.ResponseWriter, r *http.Request, connectionID string) {
-	if r.Method != http.MethodPost {
-		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+// UpdateConnectionRequest represents a request to update a connection
+type UpdateConnectionRequest struct {
+	SyncSchedule *string `json:"sync_schedule,omitempty"`
+}
+
+// HandleUpdateConnection handles PUT/PATCH /api/integrations/drive/connections/{id}.
+// Putting a connection on a schedule makes it due one interval after its
+// last sync (or right away if it never synced); "manual" stops scheduled
+// syncs.
+func (h *DriveHandler) HandleUpdateConnection(w http.ResponseWriter, r *http.Request, connectionID string) {
+	if r.Method != http.MethodPut && r.Method != http.MethodPatch {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only PUT/PATCH methods are allowed")
 		return
 	}
 
+	var req UpdateConnectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+	if req.SyncSchedule != nil {
+		if err := integration.ValidateSyncSchedule(*req.SyncSchedule); err != nil {
+			h.writeError(w, http.StatusBadRequest, "invalid_request", err.Error())
+			return
+		}
+	}
+
 	ctx := r.Context()
 	conn, err := h.entClient.GoogleDriveConnection.Get(ctx, connectionID)
 	if err != nil {
@@ -401,33 +555,58 @@ func (h *DriveHandler) HandleRefreshConnection(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	if conn.RefreshToken == "" {
-		h.writeError(w, http.StatusBadRequest, "no_refresh_token", "Connection has no refresh token")
-		return
+	update := conn.Update()
+	if req.SyncSchedule != nil {
+		update = update.SetSyncSchedule(googledriveconnection.SyncSchedule(*req.SyncSchedule))
+		if next := integration.NextScheduledSync(*req.SyncSchedule, conn.LastSyncAt, time.Now()); next != nil {
+			update = update.SetNextSyncAt(*next)
+		} else {
+			update = update.ClearNextSyncAt()
+		}
 	}
 
-	// Create OAuth client and refresh token
-	oauthClient, err := google.NewClient(h.oauthConfig)
+	conn, err = update.Save(ctx)
 	if err != nil {
-		h.writeError(w, http.StatusInternalServerError, "oauth_error", "Failed to create OAuth client: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "update_failed", "Failed to update connection: "+err.Error())
 		return
 	}
 
-	newToken, err := oauthClient.RefreshToken(ctx, conn.RefreshToken)
+	h.writeJSON(w, http.StatusOK, h.connectionToResponse(conn))
+}
+
+// HandleRefreshConnection handles POST /api/integrations/drive/connections/{id}/refresh
+func (h *DriveHandler) HandleRefreshConnection(w http.ResponseWriter, r *http.Request, connectionID string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	ctx := r.Context()
+	conn, err := h.entClient.GoogleDriveConnection.Get(ctx, connectionID)
 	if err != nil {
-		// Mark connection as expired
-		_, _ = conn.Update().
-			SetStatus(googledriveconnection.StatusExpired).
-			Save(ctx)
-		h.writeError(w, http.StatusBadRequest, "refresh_failed", "Failed to refresh token: "+err.Error())
+		if ent.IsNotFound(err) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Connection not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get connection: "+err.Error())
+		return
+	}
+
+	if _, err := h.syncService.TokenManager().RefreshDriveToken(ctx, conn.ID); err != nil {
+		switch {
+		case integration.NeedsReauthorization(err):
+			h.writeReauthRequired(w, r, conn)
+		case errors.Is(err, integration.ErrNoRefreshToken):
+			h.writeError(w, http.StatusBadRequest, "no_refresh_token", "Connection has no refresh token")
+		case errors.Is(err, integration.ErrTokenDecryptFailed):
+			h.writeError(w, http.StatusInternalServerError, "token_error", "Failed to read stored token: "+err.Error())
+		default:
+			h.writeError(w, http.StatusBadGateway, "refresh_failed", "Failed to refresh token: "+err.Error())
+		}
 		return
 	}
 
-	// Update connection with new token
 	conn, err = conn.Update().
-		SetAccessToken(newToken.AccessToken).
-		SetRefreshToken(newToken.RefreshToken).
-		SetTokenExpiry(newToken.Expiry).
 		SetStatus(googledriveconnection.StatusActive).
 		Save(ctx)
 	if err != nil {
@@ -452,6 +631,7 @@ type FolderResponse struct {
 	ParentFolderID *string    `json:"parent_folder_id,omitempty"`
 	IsRoot         bool       `json:"is_root"`
 	SyncEnabled    bool       `json:"sync_enabled"`
+	Recursive      bool       `json:"recursive"`
 	SyncDirection  string     `json:"sync_direction"`
 	FileCount      int64      `json:"file_count"`
 	TotalSizeBytes int64      `json:"total_size_bytes"`
@@ -462,8 +642,9 @@ type FolderResponse struct {
 
 // ListFoldersResponse represents a list of folders
 type ListFoldersResponse struct {
-	Folders []*FolderResponse `json:"folders"`
-	Total   int               `json:"total"`
+	Folders    []*FolderResponse `json:"folders"`
+	Total      int               `json:"total"`
+	NextCursor string            `json:"next_cursor,omitempty"`
 }
 
 // CreateFolderRequest represents a request to add a folder
@@ -474,6 +655,7 @@ type CreateFolderRequest struct {
 	ParentFolderID *string `json:"parent_folder_id,omitempty"`
 	IsRoot         bool    `json:"is_root"`
 	SyncEnabled    *bool   `json:"sync_enabled,omitempty"`
+	Recursive      *bool   `json:"recursive,omitempty"` // default true
 	SyncDirection  string  `json:"sync_direction,omitempty"`
 }
 
@@ -481,6 +663,7 @@ type CreateFolderRequest struct {
 type UpdateFolderRequest struct {
 	Name          *string `json:"name,omitempty"`
 	SyncEnabled   *bool   `json:"sync_enabled,omitempty"`
+	Recursive     *bool   `json:"recursive,omitempty"`
 	SyncDirection *string `json:"sync_direction,omitempty"`
 }
 
@@ -491,10 +674,16 @@ func (h *DriveHandler) HandleListFolders(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
+	page, err := driveFolderList.Parse(r.URL.Query())
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
 	ctx := r.Context()
 
 	// Verify connection exists
-	_, err := h.entClient.GoogleDriveConnection.Get(ctx, connectionID)
+	_, err = h.entClient.GoogleDriveConnection.Get(ctx, connectionID)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			h.writeError(w, http.StatusNotFound, "not_found", "Connection not found")
@@ -504,17 +693,31 @@ func (h *DriveHandler) HandleListFolders(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
-	folders, err := h.entClient.GoogleDriveFolder.Query().
-		Where(googledrivefolder.ConnectionID(connectionID)).
+	query := h.entClient.GoogleDriveFolder.Query().
+		Where(
+			googledrivefolder.ConnectionID(connectionID),
+			pagination.Filter[predicate.GoogleDriveFolder](page),
+		)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to count folders: "+err.Error())
+		return
+	}
+	folders, err := query.
+		Where(pagination.After[predicate.GoogleDriveFolder](page)).
+		Order(pagination.Order[googledrivefolder.OrderOption](page)).
+		Limit(page.Limit + 1).
 		All(ctx)
 	if err != nil {
 		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to list folders: "+err.Error())
 		return
 	}
+	folders, next := page.Trim(folders)
 
 	resp := ListFoldersResponse{
-		Folders: make([]*FolderResponse, len(folders)),
-		Total:   len(folders),
+		Folders:    make([]*FolderResponse, len(folders)),
+		Total:      total,
+		NextCursor: next,
 	}
 	for i, folder := range folders {
 		resp.Folders[i] = h.folderToResponse(folder)
@@ -607,6 +810,7 @@ func (h *DriveHandler) HandleCreateFolder(w http.ResponseWriter, r *http.Request
 		SetName(req.Name).
 		SetIsRoot(req.IsRoot).
 		SetSyncEnabled(syncEnabled).
+		SetNillableRecursive(req.Recursive).
 		SetSyncDirection(syncDirection)
 
 	if req.Path != "" {
@@ -677,6 +881,9 @@ func (h *DriveHandler) HandleUpdateFolder(w http.ResponseWriter, r *http.Request
 	if req.SyncEnabled != nil {
 		update = update.SetSyncEnabled(*req.SyncEnabled)
 	}
+	if req.Recursive != nil {
+		update = update.SetRecursive(*req.Recursive)
+	}
 	if req.SyncDirection != nil {
 		switch *req.SyncDirection {
 		case "download":
@@ -751,24 +958,21 @@ func (h *DriveHandler) HandleBrowseDrive(w http.ResponseWriter, r *http.Request,
 	}
 
 	// Create Drive client
-	oauthClient, err := google.NewClient(h.oauthConfig)
+	tokenSource, err := h.syncService.TokenManager().DriveTokenSource(conn)
 	if err != nil {
-		h.writeError(w, http.StatusInternalServerError, "oauth_error", "Failed to create OAuth client: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "token_error", "Failed to read stored token: "+err.Error())
 		return
 	}
-
-	token := &google.Token{
-		AccessToken:  conn.AccessToken,
-		RefreshToken: conn.RefreshToken,
-		Expiry:       conn.TokenExpiry,
-	}
-	tokenSource := google.NewTokenSource(oauthClient, token)
 	driveClient := google.NewDriveClient(tokenSource)
 
 	// List folder contents
 	files, err := driveClient.ListFolder(ctx, folderID, google.ListFilesOptions{
 		PageSize: 100,
 	})
+	if integration.NeedsReauthorization(err) {
+		h.writeReauthRequired(w, r, conn)
+		return
+	}
 	if err != nil {
 		h.writeError(w, http.StatusInternalServerError, "drive_error", "Failed to list Drive folder: "+err.Error())
 		return
@@ -777,6 +981,209 @@ func (h *DriveHandler) HandleBrowseDrive(w http.ResponseWriter, r *http.Request,
 	h.writeJSON(w, http.StatusOK, files)
 }
 
+// ========================================
+// Folder Picker Handlers
+// ========================================
+
+// defaultPickerPageSize and maxPickerPageSize bound the folders listed per
+// picker request; Drive returns at most 1000 files a page
+const (
+	defaultPickerPageSize = 100
+	maxPickerPageSize     = 1000
+)
+
+// PickerFolderResponse represents a Drive folder offered by the folder
+// picker
+type PickerFolderResponse struct {
+	ID           string     `json:"id"`
+	Name         string     `json:"name"`
+	ParentIDs    []string   `json:"parent_ids,omitempty"`
+	ModifiedTime *time.Time `json:"modified_time,omitempty"`
+	// Tracked is the folder's tracking record if it is already tracked
+	Tracked *FolderResponse `json:"tracked,omitempty"`
+}
+
+// PickerFoldersResponse represents a page of Drive folders
+type PickerFoldersResponse struct {
+	Folders       []*PickerFolderResponse `json:"folders"`
+	NextPageToken string                  `json:"next_page_token,omitempty"`
+}
+
+// FolderSelectionRequest picks a Drive folder to track, or to stop tracking
+type FolderSelectionRequest struct {
+	DriveFolderID string `json:"drive_folder_id"`
+	Recursive     *bool  `json:"recursive,omitempty"` // default true
+	Selected      *bool  `json:"selected,omitempty"`  // default true; false stops tracking
+}
+
+// SelectFoldersRequest represents folders picked in the folder picker
+type SelectFoldersRequest struct {
+	Folders []FolderSelectionRequest `json:"folders"`
+}
+
+// HandlePickerFolders handles GET /api/integrations/drive/connections/{id}/picker/folders,
+// listing the folders directly within parent_id, or the top of My Drive
+func (h *DriveHandler) HandlePickerFolders(w http.ResponseWriter, r *http.Request, connectionID string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	query := r.URL.Query()
+	pageSize, ok := h.pickerPageSize(w, query.Get("page_size"))
+	if !ok {
+		return
+	}
+	parentID := query.Get("parent_id")
+	if parentID == "" {
+		parentID = "root"
+	}
+
+	page, err := h.syncService.ListDriveFolders(r.Context(), connectionID, parentID, query.Get("page_token"), pageSize)
+	if err != nil {
+		h.writePickerError(w, r, connectionID, err)
+		return
+	}
+	h.writeJSON(w, http.StatusOK, h.pickerPageToResponse(page))
+}
+
+// HandlePickerSearch handles GET /api/integrations/drive/connections/{id}/picker/search,
+// listing folders anywhere in Drive whose name contains q
+func (h *DriveHandler) HandlePickerSearch(w http.ResponseWriter, r *http.Request, connectionID string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	query := r.URL.Query()
+	name := strings.TrimSpace(query.Get("q"))
+	if name == "" {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "q is required")
+		return
+	}
+	pageSize, ok := h.pickerPageSize(w, query.Get("page_size"))
+	if !ok {
+		return
+	}
+
+	page, err := h.syncService.SearchDriveFolders(r.Context(), connectionID, name, query.Get("page_token"), pageSize)
+	if err != nil {
+		h.writePickerError(w, r, connectionID, err)
+		return
+	}
+	h.writeJSON(w, http.StatusOK, h.pickerPageToResponse(page))
+}
+
+// HandleSelectFolders handles POST /api/integrations/drive/connections/{id}/picker/selections,
+// tracking the selected folders and untracking the deselected ones
+func (h *DriveHandler) HandleSelectFolders(w http.ResponseWriter, r *http.Request, connectionID string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	var req SelectFoldersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+	if len(req.Folders) == 0 {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "folders is required")
+		return
+	}
+
+	selections := make([]integration.FolderSelection, len(req.Folders))
+	for i, folder := range req.Folders {
+		if folder.DriveFolderID == "" {
+			h.writeError(w, http.StatusBadRequest, "validation_error", fmt.Sprintf("folders[%d].drive_folder_id is required", i))
+			return
+		}
+		selections[i] = integration.FolderSelection{
+			DriveFolderID: folder.DriveFolderID,
+			Recursive:     folder.Recursive == nil || *folder.Recursive,
+			Deselect:      folder.Selected != nil && !*folder.Selected,
+		}
+	}
+
+	folders, err := h.syncService.SelectFolders(r.Context(), connectionID, selections)
+	if err != nil {
+		h.writePickerError(w, r, connectionID, err)
+		return
+	}
+
+	resp := ListFoldersResponse{
+		Folders: make([]*FolderResponse, len(folders)),
+		Total:   len(folders),
+	}
+	for i, folder := range folders {
+		resp.Folders[i] = h.folderToResponse(folder)
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// pickerPageSize parses a picker page size, or writes 400
+func (h *DriveHandler) pickerPageSize(w http.ResponseWriter, value string) (int, bool) {
+	if value == "" {
+		return defaultPickerPageSize, true
+	}
+	pageSize, err := strconv.Atoi(value)
+	if err != nil || pageSize < 1 || pageSize > maxPickerPageSize {
+		h.writeError(w, http.StatusBadRequest, "validation_error", fmt.Sprintf("page_size must be between 1 and %d", maxPickerPageSize))
+		return 0, false
+	}
+	return pageSize, true
+}
+
+// writePickerError writes the response for a folder picker error
+func (h *DriveHandler) writePickerError(w http.ResponseWriter, r *http.Request, connectionID string, err error) {
+	switch {
+	case errors.Is(err, integration.ErrConnectionNotFound):
+		h.writeError(w, http.StatusNotFound, "not_found", "Connection not found")
+	case errors.Is(err, integration.ErrConnectionInactive):
+		h.writeError(w, http.StatusBadRequest, "connection_inactive", "Connection is not active")
+	case errors.Is(err, integration.ErrFolderNotFound):
+		h.writeError(w, http.StatusNotFound, "folder_not_found", err.Error())
+	case errors.Is(err, integration.ErrNotAFolder):
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+	case errors.Is(err, google.ErrInvalidRequest):
+		h.writeError(w, http.StatusBadRequest, "invalid_request", err.Error())
+	case integration.NeedsReauthorization(err):
+		conn, getErr := h.entClient.GoogleDriveConnection.Get(r.Context(), connectionID)
+		if getErr != nil {
+			h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get connection: "+getErr.Error())
+			return
+		}
+		h.writeReauthRequired(w, r, conn)
+	case errors.Is(err, integration.ErrTokenDecryptFailed):
+		h.writeError(w, http.StatusInternalServerError, "token_error", "Failed to read stored token: "+err.Error())
+	default:
+		h.writeError(w, http.StatusInternalServerError, "drive_error", "Failed to query Drive: "+err.Error())
+	}
+}
+
+// pickerPageToResponse converts a page of Drive folders to response format
+func (h *DriveHandler) pickerPageToResponse(page *integration.DriveFolderPage) *PickerFoldersResponse {
+	resp := &PickerFoldersResponse{
+		Folders:       make([]*PickerFolderResponse, len(page.Folders)),
+		NextPageToken: page.NextPageToken,
+	}
+	for i, folder := range page.Folders {
+		entry := &PickerFolderResponse{
+			ID:        folder.ID,
+			Name:      folder.Name,
+			ParentIDs: folder.ParentIDs,
+		}
+		if !folder.ModifiedTime.IsZero() {
+			entry.ModifiedTime = &folder.ModifiedTime
+		}
+		if folder.Tracked != nil {
+			entry.Tracked = h.folderToResponse(folder.Tracked)
+		}
+		resp.Folders[i] = entry
+	}
+	return resp
+}
+
 // ========================================
 // Sync Handlers
 // ========================================
@@ -785,6 +1192,38 @@ func (h *DriveHandler) HandleBrowseDrive(w http.ResponseWriter, r *http.Request,
 type TriggerSyncRequest struct {
 	SyncType string `json:"sync_type"` // full, incremental, manual
 	FolderID string `json:"folder_id,omitempty"`
+	After    string `json:"after,omitempty"`  // YYYY-MM-DD or RFC 3339, inclusive
+	Before   string `json:"before,omitempty"` // YYYY-MM-DD or RFC 3339, exclusive
+}
+
+// parseSyncRange parses the optional after and before bounds of a sync
+// trigger request. Each bound is a date (YYYY-MM-DD, midnight UTC) or an
+// RFC 3339 timestamp.
+func parseSyncRange(after, before string) (integration.SyncRange, error) {
+	var dateRange integration.SyncRange
+	var err error
+	if dateRange.After, err = parseSyncBound(after); err != nil {
+		return integration.SyncRange{}, fmt.Errorf("after: %w", err)
+	}
+	if dateRange.Before, err = parseSyncBound(before); err != nil {
+		return integration.SyncRange{}, fmt.Errorf("before: %w", err)
+	}
+	return dateRange, nil
+}
+
+// parseSyncBound parses a single sync range bound; empty is a zero time
+func parseSyncBound(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not a date (YYYY-MM-DD) or RFC 3339 timestamp", value)
+	}
+	return t, nil
 }
 
 // SyncResponse represents a sync operation result
@@ -832,12 +1271,22 @@ func (h *DriveHandler) HandleTriggerSync(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
+	dateRange, err := parseSyncRange(req.After, req.Before)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
 	ctx := r.Context()
 
 	// Use a background context for the sync operation
 	syncCtx := context.Background()
-	result, err := h.syncService.SyncFolder(syncCtx, connectionID, req.FolderID, req.SyncType)
+	result, err := h.syncService.SyncFolderInRange(syncCtx, connectionID, req.FolderID, req.SyncType, dateRange, nil)
 	if err != nil {
+		if errors.Is(err, integration.ErrInvalidSyncRange) {
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
 		switch err {
 		case integration.ErrConnectionNotFound:
 			h.writeError(w, http.StatusNotFound, "not_found", "Connection not found")
@@ -884,8 +1333,9 @@ func (h *DriveHandler) HandleGetSyncStatus(w http.ResponseWriter, r *http.Reques
 
 // ListSyncsResponse represents a list of syncs
 type ListSyncsResponse struct {
-	Syncs []*SyncResponse `json:"syncs"`
-	Total int             `json:"total"`
+	Syncs      []*SyncResponse `json:"syncs"`
+	Total      int             `json:"total"`
+	NextCursor string          `json:"next_cursor,omitempty"`
 }
 
 // HandleListSyncs handles GET /api/integrations/drive/connections/{id}/syncs
@@ -895,10 +1345,16 @@ func (h *DriveHandler) HandleListSyncs(w http.ResponseWriter, r *http.Request, c
 		return
 	}
 
+	page, err := driveSyncList.Parse(r.URL.Query())
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
 	ctx := r.Context()
 
 	// Verify connection exists
-	_, err := h.entClient.GoogleDriveConnection.Get(ctx, connectionID)
+	_, err = h.entClient.GoogleDriveConnection.Get(ctx, connectionID)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			h.writeError(w, http.StatusNotFound, "not_found", "Connection not found")
@@ -908,18 +1364,34 @@ func (h *DriveHandler) HandleListSyncs(w http.ResponseWriter, r *http.Request, c
 		return
 	}
 
-	results, err := h.syncService.GetSyncHistory(ctx, connectionID, 50)
+	query := h.entClient.GoogleDriveSync.Query().
+		Where(
+			googledrivesync.ConnectionID(connectionID),
+			pagination.Filter[predicate.GoogleDriveSync](page),
+		)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to count syncs: "+err.Error())
+		return
+	}
+	syncs, err := query.
+		Where(pagination.After[predicate.GoogleDriveSync](page)).
+		Order(pagination.Order[googledrivesync.OrderOption](page)).
+		Limit(page.Limit + 1).
+		All(ctx)
 	if err != nil {
 		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get sync history: "+err.Error())
 		return
 	}
+	syncs, next := page.Trim(syncs)
 
 	resp := ListSyncsResponse{
-		Syncs: make([]*SyncResponse, len(results)),
-		Total: len(results),
+		Syncs:      make([]*SyncResponse, len(syncs)),
+		Total:      total,
+		NextCursor: next,
 	}
-	for i, result := range results {
-		resp.Syncs[i] = h.syncResultToResponse(result)
+	for i, sync := range syncs {
+		resp.Syncs[i] = h.syncResultToResponse(integration.NewSyncResult(sync))
 	}
 
 	h.writeJSON(w, http.StatusOK, resp)
@@ -949,29 +1421,19 @@ func (h *DriveHandler) HandleCancelSync(w http.ResponseWriter, r *http.Request,
 // Helper Methods
 // ========================================
 
-// cleanupOldStates removes OAuth states older than 10 minutes
-func (h *DriveHandler) cleanupOldStates() {
-	h.mu.Lock()
-	defer h.mu.Unlock()
-
-	cutoff := time.Now().Add(-10 * time.Minute)
-	for state, data := range h.states {
-		if data.CreatedAt.Before(cutoff) {
-			delete(h.states, state)
-		}
-	}
-}
-
 // connectionToResponse converts an ent connection to response format
 func (h *DriveHandler) connectionToResponse(conn *ent.GoogleDriveConnection) *ConnectionResponse {
 	resp := &ConnectionResponse{
 		ID:              conn.ID,
 		UserID:          conn.UserID,
+		OrganizationID:  conn.OrganizationID,
 		GoogleAccountID: conn.GoogleAccountID,
 		Email:           conn.Email,
 		Status:          string(conn.Status),
 		CreatedAt:       conn.CreatedAt,
 		UpdatedAt:       conn.UpdatedAt,
+		SyncSchedule:    string(conn.SyncSchedule),
+		NextSyncAt:      conn.NextSyncAt,
 	}
 	if conn.LastSyncAt != nil {
 		resp.LastSyncAt = conn.LastSyncAt
@@ -989,6 +1451,7 @@ func (h *DriveHandler) folderToResponse(folder *ent.GoogleDriveFolder) *FolderRe
 		Path:           folder.Path,
 		IsRoot:         folder.IsRoot,
 		SyncEnabled:    folder.SyncEnabled,
+		Recursive:      folder.Recursive,
 		SyncDirection:  string(folder.SyncDirection),
 		FileCount:      folder.FileCount,
 		TotalSizeBytes: folder.TotalSizeBytes,
@@ -2,18 +2,32 @@ package integration
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 	"sync"
 	"time"
 
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 
 	"clockzen-next/internal/application/integration"
+	"clockzen-next/internal/application/organizations"
 	"clockzen-next/internal/ent"
 	"clockzen-next/internal/ent/emailconnection"
 	"clockzen-next/internal/ent/emaillabel"
+	"clockzen-next/internal/ent/emailsync"
+	"clockzen-next/internal/ent/lineitem"
+	"clockzen-next/internal/ent/predicate"
+	"clockzen-next/internal/ent/receipt"
 	"clockzen-next/internal/infrastructure/google"
+	"clockzen-next/internal/infrastructure/oauthstate"
+	"clockzen-next/internal/presentation/http/middleware"
+	"clockzen-next/internal/presentation/http/pagination"
 )
 
 // EmailHandler handles HTTP requests for Email integration
@@ -21,16 +35,28 @@ type EmailHandler struct {
 	mu          sync.RWMutex
 	entClient   *ent.Client
 	oauthConfig *google.Config
+	tokens      *integration.TokenStore
 	syncService *integration.EmailSyncService
-	states      map[string]emailStateData // CSRF state storage
+	tracker     *integration.EmailSyncStatusTracker
+	states      oauthstate.Store // CSRF state storage
+	sandbox     bool             // whether sandbox connections can be made
 }
 
 // emailStateData holds OAuth state information for email
 type emailStateData struct {
-	UserID    string
-	CreatedAt time.Time
-	Scopes    []string
-	Provider  string
+	UserID         string   `json:"user_id"`
+	OrganizationID string   `json:"organization_id,omitempty"` // empty outside organizations
+	Scopes         []string `json:"scopes"`
+	Provider       string   `json:"provider"`
+	CodeVerifier   string   `json:"code_verifier,omitempty"` // PKCE verifier sent with the code exchange
+}
+
+// organization returns the organization the connection is made in, or nil
+func (s emailStateData) organization() *string {
+	if s.OrganizationID == "" {
+		return nil
+	}
+	return &s.OrganizationID
 }
 
 // NewEmailHandler creates a new EmailHandler instance
@@ -39,8 +65,10 @@ func NewEmailHandler(entClient *ent.Client, oauthConfig *google.Config) *EmailHa
 	return &EmailHandler{
 		entClient:   entClient,
 		oauthConfig: oauthConfig,
+		tokens:      integration.NewTokenStore(entClient, nil),
 		syncService: syncService,
-		states:      make(map[string]emailStateData),
+		tracker:     integration.NewEmailSyncStatusTracker(syncService),
+		states:      oauthstate.NewMemoryStore(),
 	}
 }
 
@@ -49,20 +77,64 @@ func NewEmailHandlerWithSyncService(entClient *ent.Client, oauthConfig *google.C
 	return &EmailHandler{
 		entClient:   entClient,
 		oauthConfig: oauthConfig,
+		tokens:      integration.NewTokenStore(entClient, nil),
 		syncService: syncService,
-		states:      make(map[string]emailStateData),
+		tracker:     integration.NewEmailSyncStatusTracker(syncService),
+		states:      oauthstate.NewMemoryStore(),
 	}
 }
 
+// SetTokenStore sets the store used to encrypt and decrypt connection
+// tokens, for both the handler and its sync service
+func (h *EmailHandler) SetTokenStore(tokens *integration.TokenStore) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.tokens = tokens
+	h.syncService.SetTokenStore(tokens)
+}
+
+// SetTokenManager sets the manager refreshing connection tokens, for both
+// the handler and its sync service, and uses its token store
+func (h *EmailHandler) SetTokenManager(manager *integration.TokenManager) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.tokens = manager.TokenStore()
+	h.syncService.SetTokenManager(manager)
+}
+
+// SetStateStore sets the store OAuth states are kept in between the
+// initiate and callback requests
+func (h *EmailHandler) SetStateStore(states oauthstate.Store) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.states = states
+}
+
+// SetSandboxMode sets whether sandbox connections can be made. Sandbox
+// connections are authorized without Google and sync a synthetic mailbox of
+// receipt emails, for demos and integration tests.
+func (h *EmailHandler) SetSandboxMode(enabled bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.sandbox = enabled
+}
+
+// sandboxEnabled reports whether sandbox connections can be made
+func (h *EmailHandler) sandboxEnabled() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.sandbox
+}
+
 // ========================================
 // OAuth Handlers
 // ========================================
 
 // EmailInitiateOAuthRequest represents a request to initiate OAuth flow for email
 type EmailInitiateOAuthRequest struct {
-	UserID   string   `json:"user_id"`
+	UserID   string   `json:"user_id,omitempty"` // defaults to the authenticated user
 	Scopes   []string `json:"scopes,omitempty"`
-	Provider string   `json:"provider"` // gmail, outlook
+	Provider string   `json:"provider"` // gmail, outlook, sandbox
 }
 
 // EmailInitiateOAuthResponse represents the response with authorization URL
@@ -84,8 +156,14 @@ func (h *EmailHandler) HandleInitiateOAuth(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	if req.UserID == "" {
-		h.writeError(w, http.StatusBadRequest, "validation_error", "user_id is required")
+	// Connections are always made by the authenticated user, in the
+	// organization the request acts in if any
+	userID, organizationID, ok := requireConnector(w, r, h.writeError)
+	if !ok {
+		return
+	}
+	if req.UserID != "" && req.UserID != userID && req.UserID != organizationID {
+		h.writeError(w, http.StatusForbidden, "forbidden", "Cannot connect an account for another user")
 		return
 	}
 
@@ -102,6 +180,11 @@ func (h *EmailHandler) HandleInitiateOAuth(w http.ResponseWriter, r *http.Reques
 	case "outlook":
 		h.writeError(w, http.StatusBadRequest, "unsupported_provider", "Outlook provider not yet implemented")
 		return
+	case "sandbox":
+		if !h.sandboxEnabled() {
+			h.writeError(w, http.StatusBadRequest, "unsupported_provider", "Sandbox provider is only available when SANDBOX_MODE is enabled")
+			return
+		}
 	default:
 		h.writeError(w, http.StatusBadRequest, "invalid_provider", "Provider must be one of: gmail, outlook")
 		return
@@ -116,38 +199,91 @@ func (h *EmailHandler) HandleInitiateOAuth(w http.ResponseWriter, r *http.Reques
 		scopes = google.GmailScopes()
 	}
 
-	// Store state with user context
-	h.mu.Lock()
-	h.states[state] = emailStateData{
-		UserID:    req.UserID,
-		CreatedAt: time.Now(),
-		Scopes:    scopes,
-		Provider:  provider,
+	data := emailStateData{
+		UserID:         userID,
+		OrganizationID: organizationID,
+		Scopes:         scopes,
+		Provider:       provider,
+	}
+
+	// Sandbox connections skip Google's consent screen: the authorization
+	// URL goes straight to the callback
+	if provider == "sandbox" {
+		if err := oauthstate.SaveJSON(r.Context(), h.states, state, data, stateTTL); err != nil {
+			h.writeError(w, http.StatusInternalServerError, "internal_error", "Failed to store OAuth state")
+			return
+		}
+		h.writeJSON(w, http.StatusOK, EmailInitiateOAuthResponse{
+			AuthorizationURL: h.sandboxAuthURL(state),
+			State:            state,
+		})
+		return
 	}
-	h.mu.Unlock()
 
-	// Clean up old states (older than 10 minutes)
-	go h.cleanupOldStates()
+	authURL, err := h.authorizationURL(r.Context(), state, data)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "oauth_error", "Failed to start OAuth flow: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, EmailInitiateOAuthResponse{
+		AuthorizationURL: authURL,
+		State:            state,
+	})
+}
 
-	// Create OAuth client and generate auth URL
-	config := &google.Config{
+// authorizationURL stores the state of a new Google OAuth flow, with a
+// fresh PKCE verifier, and returns the URL that sends the user to Google's
+// consent screen
+func (h *EmailHandler) authorizationURL(ctx context.Context, state string, data emailStateData, opts ...google.AuthCodeOption) (string, error) {
+	oauthClient, err := google.NewClient(&google.Config{
 		ClientID:     h.oauthConfig.ClientID,
 		ClientSecret: h.oauthConfig.ClientSecret,
 		RedirectURL:  h.oauthConfig.RedirectURL,
-		Scopes:       scopes,
+		Scopes:       data.Scopes,
+	})
+	if err != nil {
+		return "", err
 	}
 
-	oauthClient, err := google.NewClient(config)
+	data.CodeVerifier, err = google.GenerateCodeVerifier()
 	if err != nil {
-		h.writeError(w, http.StatusInternalServerError, "oauth_error", "Failed to create OAuth client: "+err.Error())
-		return
+		return "", err
+	}
+	if err := oauthstate.SaveJSON(ctx, h.states, state, data, stateTTL); err != nil {
+		return "", err
 	}
 
-	authURL := oauthClient.AuthCodeURL(state, google.WithPrompt("consent"))
+	opts = append([]google.AuthCodeOption{google.WithPrompt("consent"), google.WithCodeChallenge(data.CodeVerifier)}, opts...)
+	return oauthClient.AuthCodeURL(state, opts...), nil
+}
 
-	h.writeJSON(w, http.StatusOK, EmailInitiateOAuthResponse{
-		AuthorizationURL: authURL,
-		State:            state,
+// writeReauthRequired responds to a request on a connection whose refresh
+// token Google rejected, which the token manager has marked expired, with a
+// URL that connects it again
+func (h *EmailHandler) writeReauthRequired(w http.ResponseWriter, r *http.Request, conn *ent.EmailConnection) {
+	ctx := r.Context()
+	state := uuid.New().String()
+	data := emailStateData{
+		UserID:   conn.UserID,
+		Scopes:   google.GmailScopes(),
+		Provider: string(conn.Provider),
+	}
+	if conn.OrganizationID != nil {
+		data.OrganizationID = *conn.OrganizationID
+	}
+	reauthURL, err := h.authorizationURL(ctx, state, data, google.WithLoginHint(conn.Email))
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "oauth_error", "Failed to start OAuth flow: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusConflict, ReauthRequiredResponse{
+		Error:        "reauth_required",
+		Message:      "Google no longer accepts this connection's authorization; connect the account again",
+		ConnectionID: conn.ID,
+		ReauthURL:    reauthURL,
+		State:        state,
 	})
 }
 
@@ -162,6 +298,7 @@ type EmailOAuthCallbackRequest struct {
 type EmailConnectionResponse struct {
 	ID                string     `json:"id"`
 	UserID            string     `json:"user_id"`
+	OrganizationID    *string    `json:"organization_id,omitempty"`
 	ProviderAccountID string     `json:"provider_account_id"`
 	Email             string     `json:"email"`
 	Provider          string     `json:"provider"`
@@ -169,6 +306,8 @@ type EmailConnectionResponse struct {
 	CreatedAt         time.Time  `json:"created_at"`
 	UpdatedAt         time.Time  `json:"updated_at"`
 	LastSyncAt        *time.Time `json:"last_sync_at,omitempty"`
+	SyncSchedule      string     `json:"sync_schedule"`
+	NextSyncAt        *time.Time `json:"next_sync_at,omitempty"`
 }
 
 // HandleOAuthCallback handles GET/POST /api/integrations/email/oauth/callback
@@ -205,92 +344,114 @@ func (h *EmailHandler) HandleOAuthCallback(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	// Validate and retrieve state
-	h.mu.Lock()
-	stateInfo, exists := h.states[state]
-	if exists {
-		delete(h.states, state)
-	}
-	h.mu.Unlock()
-
-	if !exists {
-		h.writeError(w, http.StatusBadRequest, "invalid_state", "Invalid or expired state parameter")
+	// Validate and retrieve state; expired states are never returned
+	var stateInfo emailStateData
+	if err := oauthstate.TakeJSON(r.Context(), h.states, state, &stateInfo); err != nil {
+		if errors.Is(err, oauthstate.ErrNotFound) {
+			h.writeError(w, http.StatusBadRequest, "invalid_state", "Invalid or expired state parameter")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "internal_error", "Failed to retrieve OAuth state")
 		return
 	}
 
-	// Check state age (10 minute max)
-	if time.Since(stateInfo.CreatedAt) > 10*time.Minute {
-		h.writeError(w, http.StatusBadRequest, "expired_state", "State parameter has expired")
-		return
-	}
+	ctx := r.Context()
+	var token *google.Token
+	var userInfo *google.UserInfo
+	if stateInfo.Provider == "sandbox" {
+		if !h.sandboxEnabled() {
+			h.writeError(w, http.StatusBadRequest, "unsupported_provider", "Sandbox provider is only available when SANDBOX_MODE is enabled")
+			return
+		}
+		// Each user and organization has one sandbox account
+		owner := organizations.OwnerID(stateInfo.UserID, stateInfo.organization())
+		token = google.SandboxToken()
+		userInfo = &google.UserInfo{
+			ID:    "sandbox-" + owner,
+			Email: "sandbox-" + owner + "@sandbox.example",
+		}
+	} else {
+		// Create OAuth client
+		config := &google.Config{
+			ClientID:     h.oauthConfig.ClientID,
+			ClientSecret: h.oauthConfig.ClientSecret,
+			RedirectURL:  h.oauthConfig.RedirectURL,
+			Scopes:       stateInfo.Scopes,
+		}
 
-	// Create OAuth client
-	config := &google.Config{
-		ClientID:     h.oauthConfig.ClientID,
-		ClientSecret: h.oauthConfig.ClientSecret,
-		RedirectURL:  h.oauthConfig.RedirectURL,
-		Scopes:       stateInfo.Scopes,
+		oauthClient, err := google.NewClient(config)
+		if err != nil {
+			h.writeError(w, http.StatusInternalServerError, "oauth_error", "Failed to create OAuth client: "+err.Error())
+			return
+		}
+
+		// Exchange code for token, proving the flow was started here
+		var exchangeOpts []google.ExchangeOption
+		if stateInfo.CodeVerifier != "" {
+			exchangeOpts = append(exchangeOpts, google.WithCodeVerifier(stateInfo.CodeVerifier))
+		}
+		token, err = oauthClient.Exchange(ctx, code, exchangeOpts...)
+		if err != nil {
+			h.writeError(w, http.StatusBadRequest, "exchange_failed", "Failed to exchange authorization code: "+err.Error())
+			return
+		}
+
+		// Get user info from Google
+		userInfo, err = oauthClient.GetUserInfo(ctx, token.AccessToken)
+		if err != nil {
+			h.writeError(w, http.StatusInternalServerError, "user_info_failed", "Failed to get user info: "+err.Error())
+			return
+		}
 	}
 
-	oauthClient, err := google.NewClient(config)
+	accessToken, refreshToken, err := h.tokens.Seal(token)
 	if err != nil {
-		h.writeError(w, http.StatusInternalServerError, "oauth_error", "Failed to create OAuth client: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "save_failed", "Failed to secure tokens: "+err.Error())
 		return
 	}
 
-	// Exchange code for token
-	ctx := r.Context()
-	token, err := oauthClient.Exchange(ctx, code)
-	if err != nil {
-		h.writeError(w, http.StatusBadRequest, "exchange_failed", "Failed to exchange authorization code: "+err.Error())
+	// Upsert the connection. The unique (provider_account_id, provider) index
+	// makes concurrent callbacks for the same account converge on one row.
+	// An account already connected by another user is left alone, and a
+	// re-consent that returns no refresh token keeps the stored one.
+	connID, err := h.entClient.EmailConnection.Create().
+		SetID(uuid.New().String()).
+		SetUserID(stateInfo.UserID).
+		SetNillableOrganizationID(stateInfo.organization()).
+		SetProviderAccountID(userInfo.ID).
+		SetEmail(userInfo.Email).
+		SetProvider(emailconnection.Provider(stateInfo.Provider)).
+		SetAccessToken(accessToken).
+		SetRefreshToken(refreshToken).
+		SetTokenExpiry(token.Expiry).
+		SetStatus(emailconnection.StatusActive).
+		OnConflict(
+			entsql.ConflictColumns(emailconnection.FieldProviderAccountID, emailconnection.FieldProvider),
+			entsql.UpdateWhere(ownedBy(stateInfo.UserID, stateInfo.OrganizationID)),
+		).
+		Update(func(u *ent.EmailConnectionUpsert) {
+			u.UpdateAccessToken()
+			if token.RefreshToken != "" {
+				u.UpdateRefreshToken()
+			}
+			u.UpdateTokenExpiry()
+			u.UpdateStatus()
+			u.UpdateEmail()
+			u.SetUpdatedAt(time.Now())
+		}).
+		ID(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		h.writeError(w, http.StatusConflict, "account_connected", "This account is connected to another user")
 		return
 	}
-
-	// Get user info from Google
-	userInfo, err := oauthClient.GetUserInfo(ctx, token.AccessToken)
 	if err != nil {
-		h.writeError(w, http.StatusInternalServerError, "user_info_failed", "Failed to get user info: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "save_failed", "Failed to save connection: "+err.Error())
 		return
 	}
 
-	// Check if connection already exists for this provider account
-	existingConn, err := h.entClient.EmailConnection.Query().
-		Where(emailconnection.ProviderAccountID(userInfo.ID)).
-		Only(ctx)
-
-	var conn *ent.EmailConnection
-	if err == nil {
-		// Update existing connection
-		conn, err = existingConn.Update().
-			SetAccessToken(token.AccessToken).
-			SetRefreshToken(token.RefreshToken).
-			SetTokenExpiry(token.Expiry).
-			SetStatus(emailconnection.StatusActive).
-			SetEmail(userInfo.Email).
-			Save(ctx)
-		if err != nil {
-			h.writeError(w, http.StatusInternalServerError, "update_failed", "Failed to update connection: "+err.Error())
-			return
-		}
-	} else if ent.IsNotFound(err) {
-		// Create new connection
-		conn, err = h.entClient.EmailConnection.Create().
-			SetID(uuid.New().String()).
-			SetUserID(stateInfo.UserID).
-			SetProviderAccountID(userInfo.ID).
-			SetEmail(userInfo.Email).
-			SetProvider(emailconnection.Provider(stateInfo.Provider)).
-			SetAccessToken(token.AccessToken).
-			SetRefreshToken(token.RefreshToken).
-			SetTokenExpiry(token.Expiry).
-			SetStatus(emailconnection.StatusActive).
-			Save(ctx)
-		if err != nil {
-			h.writeError(w, http.StatusInternalServerError, "create_failed", "Failed to create connection: "+err.Error())
-			return
-		}
-	} else {
-		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to check existing connection: "+err.Error())
+	conn, err := h.entClient.EmailConnection.Get(ctx, connID)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get connection: "+err.Error())
 		return
 	}
 
@@ -318,10 +479,10 @@ func (h *EmailHandler) HandleDisconnect(w http.ResponseWriter, r *http.Request,
 	}
 
 	// Optionally revoke the token with provider
-	if conn.RefreshToken != "" {
+	if token, err := h.tokens.EmailToken(conn); err == nil && token.RefreshToken != "" {
 		oauthClient, err := google.NewClient(h.oauthConfig)
 		if err == nil {
-			_ = oauthClient.RevokeToken(ctx, conn.RefreshToken)
+			_ = oauthClient.RevokeToken(ctx, token.RefreshToken)
 		}
 	}
 
@@ -345,6 +506,7 @@ func (h *EmailHandler) HandleDisconnect(w http.ResponseWriter, r *http.Request,
 type ListEmailConnectionsResponse struct {
 	Connections []*EmailConnectionResponse `json:"connections"`
 	Total       int                        `json:"total"`
+	NextCursor  string                     `json:"next_cursor,omitempty"`
 }
 
 // HandleListConnections handles GET /api/integrations/email/connections
@@ -355,22 +517,43 @@ func (h *EmailHandler) HandleListConnections(w http.ResponseWriter, r *http.Requ
 	}
 
 	ctx := r.Context()
-	userID := r.URL.Query().Get("user_id")
+	userID, ok := middleware.OwnerIDFromContext(ctx)
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
 
-	query := h.entClient.EmailConnection.Query()
-	if userID != "" {
-		query = query.Where(emailconnection.UserID(userID))
+	page, err := emailConnectionList.Parse(r.URL.Query())
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
 	}
 
-	connections, err := query.All(ctx)
+	query := h.entClient.EmailConnection.Query().
+		Where(
+			organizations.OwnedBy[predicate.EmailConnection](userID),
+			pagination.Filter[predicate.EmailConnection](page),
+		)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to count connections: "+err.Error())
+		return
+	}
+	connections, err := query.
+		Where(pagination.After[predicate.EmailConnection](page)).
+		Order(pagination.Order[emailconnection.OrderOption](page)).
+		Limit(page.Limit + 1).
+		All(ctx)
 	if err != nil {
 		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to list connections: "+err.Error())
 		return
 	}
+	connections, next := page.Trim(connections)
 
 	resp := ListEmailConnectionsResponse{
 		Connections: make([]*EmailConnectionResponse, len(connections)),
-		Total:       len(connections),
+		Total:       total,
+		NextCursor:  next,
 	}
 	for i, conn := range connections {
 		resp.Connections[i] = h.connectionToResponse(conn)
@@ -400,13 +583,33 @@ func (h *EmailHandler) HandleGetConnection(w http.ResponseWriter, r *http.Reques
 	h.writeJSON(w, http.StatusOK, h.connectionToResponse(conn))
 }
 
-// HandleRefreshConnection handles POST /api/integrations/email/connections/{id}/refresh
-func (h *EmailHandler) HandleRefreshConnection(w http.ResponseWriter, r *http.Request, connectionID string) {
-	if r.Method != http.MethodPost {
-		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+// UpdateEmailConnectionRequest represents a request to update a connection
+type UpdateEmailConnectionRequest struct {
+	SyncSchedule *string `json:"sync_schedule,omitempty"`
+}
+
+// HandleUpdateConnection handles PUT/PATCH /api/integrations/email/connections/{id}.
+// Putting a connection on a schedule makes it due one interval after its
+// last sync (or right away if it never synced); "manual" stops scheduled
+// syncs.
+func (h *EmailHandler) HandleUpdateConnection(w http.ResponseWriter, r *http.Request, connectionID string) {
+	if r.Method != http.MethodPut && r.Method != http.MethodPatch {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only PUT/PATCH methods are allowed")
 		return
 	}
 
+	var req UpdateEmailConnectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+	if req.SyncSchedule != nil {
+		if err := integration.ValidateSyncSchedule(*req.SyncSchedule); err != nil {
+			h.writeError(w, http.StatusBadRequest, "invalid_request", err.Error())
+			return
+		}
+	}
+
 	ctx := r.Context()
 	conn, err := h.entClient.EmailConnection.Get(ctx, connectionID)
 	if err != nil {
@@ -418,33 +621,72 @@ func (h *EmailHandler) HandleRefreshConnection(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	if conn.RefreshToken == "" {
-		h.writeError(w, http.StatusBadRequest, "no_refresh_token", "Connection has no refresh token")
-		return
+	update := conn.Update()
+	if req.SyncSchedule != nil {
+		update = update.SetSyncSchedule(emailconnection.SyncSchedule(*req.SyncSchedule))
+		if next := integration.NextScheduledSync(*req.SyncSchedule, conn.LastSyncAt, time.Now()); next != nil {
+			update = update.SetNextSyncAt(*next)
+		} else {
+			update = update.ClearNextSyncAt()
+		}
 	}
 
-	// Create OAuth client and refresh token
-	oauthClient, err := google.NewClient(h.oauthConfig)
+	conn, err = update.Save(ctx)
 	if err != nil {
-		h.writeError(w, http.StatusInternalServerError, "oauth_error", "Failed to create OAuth client: "+err.Error())
+		h.writeError(w, http.StatusInternalServerError, "update_failed", "Failed to update connection: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, h.connectionToResponse(conn))
+}
+
+// HandleRefreshConnection handles POST /api/integrations/email/connections/{id}/refresh
+func (h *EmailHandler) HandleRefreshConnection(w http.ResponseWriter, r *http.Request, connectionID string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
 		return
 	}
 
-	newToken, err := oauthClient.RefreshToken(ctx, conn.RefreshToken)
+	ctx := r.Context()
+	conn, err := h.entClient.EmailConnection.Get(ctx, connectionID)
 	if err != nil {
-		// Mark connection as expired
-		_, _ = conn.Update().
-			SetStatus(emailconnection.StatusExpired).
+		if ent.IsNotFound(err) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Connection not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get connection: "+err.Error())
+		return
+	}
+
+	// Sandbox tokens never expire; refreshing only reactivates the
+	// connection
+	if conn.Provider == emailconnection.ProviderSandbox {
+		conn, err = conn.Update().
+			SetStatus(emailconnection.StatusActive).
 			Save(ctx)
-		h.writeError(w, http.StatusBadRequest, "refresh_failed", "Failed to refresh token: "+err.Error())
+		if err != nil {
+			h.writeError(w, http.StatusInternalServerError, "update_failed", "Failed to update connection: "+err.Error())
+			return
+		}
+		h.writeJSON(w, http.StatusOK, h.connectionToResponse(conn))
+		return
+	}
+
+	if _, err := h.syncService.TokenManager().RefreshEmailToken(ctx, conn.ID); err != nil {
+		switch {
+		case integration.NeedsReauthorization(err):
+			h.writeReauthRequired(w, r, conn)
+		case errors.Is(err, integration.ErrNoRefreshToken):
+			h.writeError(w, http.StatusBadRequest, "no_refresh_token", "Connection has no refresh token")
+		case errors.Is(err, integration.ErrTokenDecryptFailed):
+			h.writeError(w, http.StatusInternalServerError, "token_error", "Failed to read stored token: "+err.Error())
+		default:
+			h.writeError(w, http.StatusBadGateway, "refresh_failed", "Failed to refresh token: "+err.Error())
+		}
 		return
 	}
 
-	// Update connection with new token
 	conn, err = conn.Update().
-		SetAccessToken(newToken.AccessToken).
-		SetRefreshToken(newToken.RefreshToken).
-		SetTokenExpiry(newToken.Expiry).
 		SetStatus(emailconnection.StatusActive).
 		Save(ctx)
 	if err != nil {
@@ -479,8 +721,9 @@ type EmailLabelResponse struct {
 
 // ListEmailLabelsResponse represents a list of email labels
 type ListEmailLabelsResponse struct {
-	Labels []*EmailLabelResponse `json:"labels"`
-	Total  int                   `json:"total"`
+	Labels     []*EmailLabelResponse `json:"labels"`
+	Total      int                   `json:"total"`
+	NextCursor string                `json:"next_cursor,omitempty"`
 }
 
 // CreateEmailLabelRequest represents a request to create/add a label
@@ -508,10 +751,16 @@ func (h *EmailHandler) HandleListLabels(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
+	page, err := emailLabelList.Parse(r.URL.Query())
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
 	ctx := r.Context()
 
 	// Verify connection exists
-	_, err := h.entClient.EmailConnection.Get(ctx, connectionID)
+	_, err = h.entClient.EmailConnection.Get(ctx, connectionID)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			h.writeError(w, http.StatusNotFound, "not_found", "Connection not found")
@@ -521,17 +770,31 @@ func (h *EmailHandler) HandleListLabels(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
-	labels, err := h.entClient.EmailLabel.Query().
-		Where(emaillabel.ConnectionID(connectionID)).
+	query := h.entClient.EmailLabel.Query().
+		Where(
+			emaillabel.ConnectionID(connectionID),
+			pagination.Filter[predicate.EmailLabel](page),
+		)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to count labels: "+err.Error())
+		return
+	}
+	labels, err := query.
+		Where(pagination.After[predicate.EmailLabel](page)).
+		Order(pagination.Order[emaillabel.OrderOption](page)).
+		Limit(page.Limit + 1).
 		All(ctx)
 	if err != nil {
 		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to list labels: "+err.Error())
 		return
 	}
+	labels, next := page.Trim(labels)
 
 	resp := ListEmailLabelsResponse{
-		Labels: make([]*EmailLabelResponse, len(labels)),
-		Total:  len(labels),
+		Labels:     make([]*EmailLabelResponse, len(labels)),
+		Total:      total,
+		NextCursor: next,
 	}
 	for i, label := range labels {
 		resp.Labels[i] = h.labelToResponse(label)
@@ -756,23 +1019,25 @@ func (h *EmailHandler) HandleFetchLabels(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
-	// Create Gmail client
-	oauthClient, err := google.NewClient(h.oauthConfig)
-	if err != nil {
-		h.writeError(w, http.StatusInternalServerError, "oauth_error", "Failed to create OAuth client: "+err.Error())
-		return
-	}
-
-	token := &google.Token{
-		AccessToken:  conn.AccessToken,
-		RefreshToken: conn.RefreshToken,
-		Expiry:       conn.TokenExpiry,
+	// Create Gmail client; sandbox connections read their synthetic mailbox
+	var gmailClient *google.GmailClient
+	if conn.Provider == emailconnection.ProviderSandbox {
+		gmailClient = google.NewSandboxGmailClient(conn.Email)
+	} else {
+		tokenSource, err := h.syncService.TokenManager().EmailTokenSource(conn)
+		if err != nil {
+			h.writeError(w, http.StatusInternalServerError, "token_error", "Failed to read stored token: "+err.Error())
+			return
+		}
+		gmailClient = google.NewGmailClient(tokenSource)
 	}
-	tokenSource := google.NewTokenSource(oauthClient, token)
-	gmailClient := google.NewGmailClient(tokenSource)
 
 	// Fetch labels from Gmail
 	gmailLabels, err := gmailClient.ListLabels(ctx)
+	if integration.NeedsReauthorization(err) {
+		h.writeReauthRequired(w, r, conn)
+		return
+	}
 	if err != nil {
 		h.writeError(w, http.StatusInternalServerError, "gmail_error", "Failed to fetch labels: "+err.Error())
 		return
@@ -842,6 +1107,8 @@ func (h *EmailHandler) HandleFetchLabels(w http.ResponseWriter, r *http.Request,
 type EmailTriggerSyncRequest struct {
 	SyncType string `json:"sync_type"` // full, incremental, manual
 	LabelID  string `json:"label_id,omitempty"`
+	After    string `json:"after,omitempty"`  // YYYY-MM-DD or RFC 3339, inclusive
+	Before   string `json:"before,omitempty"` // YYYY-MM-DD or RFC 3339, exclusive
 }
 
 // EmailSyncResponse represents a sync operation result
@@ -860,6 +1127,7 @@ type EmailSyncResponse struct {
 	AttachmentsDownloaded int        `json:"attachments_downloaded"`
 	BytesTransferred      int64      `json:"bytes_transferred"`
 	ErrorMessage          *string    `json:"error_message,omitempty"`
+	FallbackReason        *string    `json:"fallback_reason,omitempty"`
 }
 
 // HandleTriggerSync handles POST /api/integrations/email/connections/{id}/sync
@@ -889,10 +1157,25 @@ func (h *EmailHandler) HandleTriggerSync(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
+	dateRange, err := parseSyncRange(req.After, req.Before)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
 	// Use a background context for the sync operation
 	syncCtx := context.Background()
-	result, err := h.syncService.SyncLabel(syncCtx, connectionID, req.LabelID, req.SyncType)
+	result, err := h.syncService.SyncLabelInRange(syncCtx, connectionID, req.LabelID, req.SyncType, dateRange, h.tracker.Notify)
+	if result != nil {
+		// Let stream clients see the final state before their channels close
+		h.tracker.Notify(h.emailSyncResultToProgress(result))
+		h.tracker.CleanupWatchers(result.SyncID)
+	}
 	if err != nil {
+		if errors.Is(err, integration.ErrInvalidSyncRange) {
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
 		switch err {
 		case integration.ErrEmailConnectionNotFound:
 			h.writeError(w, http.StatusNotFound, "not_found", "Connection not found")
@@ -934,10 +1217,125 @@ func (h *EmailHandler) HandleGetSyncStatus(w http.ResponseWriter, r *http.Reques
 	h.writeJSON(w, http.StatusOK, h.emailSyncResultToResponse(result))
 }
 
+// emailSyncStreamPollInterval is how often a sync stream re-reads the sync
+// record. This catches syncs that finish without a progress update, such as
+// failures or syncs run by the worker, and doubles as a keep-alive.
+const emailSyncStreamPollInterval = 5 * time.Second
+
+// EmailSyncProgressResponse represents a live progress update for a sync
+type EmailSyncProgressResponse struct {
+	SyncID                string   `json:"sync_id"`
+	Status                string   `json:"status"`
+	MessagesScanned       int      `json:"messages_scanned"`
+	MessagesProcessed     int      `json:"messages_processed"`
+	TotalMessages         int      `json:"total_messages"`
+	AttachmentsDownloaded int      `json:"attachments_downloaded"`
+	BytesTransferred      int64    `json:"bytes_transferred"`
+	CurrentMessage        string   `json:"current_message,omitempty"`
+	Errors                []string `json:"errors,omitempty"`
+}
+
+// HandleStreamSync handles GET /api/integrations/email/syncs/{id}/stream
+//
+// Progress is streamed as Server-Sent Events. The stream opens with a
+// "status" event holding the current sync record, emits a "progress" event
+// for each update from the tracker, and ends with a "complete" event once
+// the sync is no longer running.
+func (h *EmailHandler) HandleStreamSync(w http.ResponseWriter, r *http.Request, syncID string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	ctx := r.Context()
+
+	// Watch before reading the record so no update is missed in between
+	updates := h.tracker.Watch(syncID)
+	defer h.tracker.Unwatch(syncID, updates)
+
+	result, err := h.syncService.GetSyncStatus(ctx, syncID)
+	if err != nil {
+		if err == integration.ErrEmailSyncNotFound {
+			h.writeError(w, http.StatusNotFound, "not_found", "Sync not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get sync status: "+err.Error())
+		return
+	}
+
+	// Streams outlive the server's write timeout
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		h.writeError(w, http.StatusInternalServerError, "stream_failed", "Failed to start stream: "+err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if isEmailSyncFinished(result.Status) {
+		h.writeEvent(w, rc, "complete", h.emailSyncResultToResponse(result))
+		return
+	}
+	if err := h.writeEvent(w, rc, "status", h.emailSyncResultToResponse(result)); err != nil {
+		return
+	}
+
+	ticker := time.NewTicker(emailSyncStreamPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case progress, ok := <-updates:
+			if !ok {
+				// The tracker closed the channel because the sync finished
+				if result, err := h.syncService.GetSyncStatus(ctx, syncID); err == nil {
+					h.writeEvent(w, rc, "complete", h.emailSyncResultToResponse(result))
+				}
+				return
+			}
+			if err := h.writeEvent(w, rc, "progress", h.progressToResponse(progress)); err != nil {
+				return
+			}
+		case <-ticker.C:
+			result, err := h.syncService.GetSyncStatus(ctx, syncID)
+			if err != nil {
+				return
+			}
+			if isEmailSyncFinished(result.Status) {
+				h.writeEvent(w, rc, "complete", h.emailSyncResultToResponse(result))
+				return
+			}
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// isEmailSyncFinished reports whether a sync status is terminal
+func isEmailSyncFinished(status string) bool {
+	switch emailsync.Status(status) {
+	case emailsync.StatusCompleted, emailsync.StatusFailed, emailsync.StatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
 // ListEmailSyncsResponse represents a list of syncs
 type ListEmailSyncsResponse struct {
-	Syncs []*EmailSyncResponse `json:"syncs"`
-	Total int                  `json:"total"`
+	Syncs      []*EmailSyncResponse `json:"syncs"`
+	Total      int                  `json:"total"`
+	NextCursor string               `json:"next_cursor,omitempty"`
 }
 
 // HandleListSyncs handles GET /api/integrations/email/connections/{id}/syncs
@@ -947,10 +1345,16 @@ func (h *EmailHandler) HandleListSyncs(w http.ResponseWriter, r *http.Request, c
 		return
 	}
 
+	page, err := emailSyncList.Parse(r.URL.Query())
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
 	ctx := r.Context()
 
 	// Verify connection exists
-	_, err := h.entClient.EmailConnection.Get(ctx, connectionID)
+	_, err = h.entClient.EmailConnection.Get(ctx, connectionID)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			h.writeError(w, http.StatusNotFound, "not_found", "Connection not found")
@@ -960,18 +1364,34 @@ func (h *EmailHandler) HandleListSyncs(w http.ResponseWriter, r *http.Request, c
 		return
 	}
 
-	results, err := h.syncService.GetSyncHistory(ctx, connectionID, 50)
+	query := h.entClient.EmailSync.Query().
+		Where(
+			emailsync.ConnectionID(connectionID),
+			pagination.Filter[predicate.EmailSync](page),
+		)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to count syncs: "+err.Error())
+		return
+	}
+	syncs, err := query.
+		Where(pagination.After[predicate.EmailSync](page)).
+		Order(pagination.Order[emailsync.OrderOption](page)).
+		Limit(page.Limit + 1).
+		All(ctx)
 	if err != nil {
 		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get sync history: "+err.Error())
 		return
 	}
+	syncs, next := page.Trim(syncs)
 
 	resp := ListEmailSyncsResponse{
-		Syncs: make([]*EmailSyncResponse, len(results)),
-		Total: len(results),
+		Syncs:      make([]*EmailSyncResponse, len(syncs)),
+		Total:      total,
+		NextCursor: next,
 	}
-	for i, result := range results {
-		resp.Syncs[i] = h.emailSyncResultToResponse(result)
+	for i, sync := range syncs {
+		resp.Syncs[i] = h.emailSyncResultToResponse(integration.NewEmailSyncResult(sync))
 	}
 
 	h.writeJSON(w, http.StatusOK, resp)
@@ -997,6 +1417,132 @@ func (h *EmailHandler) HandleCancelSync(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// HandleResumeSync handles POST /api/integrations/email/syncs/{id}/resume
+func (h *EmailHandler) HandleResumeSync(w http.ResponseWriter, r *http.Request, syncID string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	// Use a background context for the sync operation
+	syncCtx := context.Background()
+	result, err := h.syncService.ResumeSync(syncCtx, syncID, h.tracker.Notify)
+	if result != nil {
+		// Let stream clients see the final state before their channels close
+		h.tracker.Notify(h.emailSyncResultToProgress(result))
+		h.tracker.CleanupWatchers(result.SyncID)
+	}
+	if err != nil {
+		if errors.Is(err, integration.ErrEmailSyncNotResumable) {
+			h.writeError(w, http.StatusConflict, "not_resumable", err.Error())
+			return
+		}
+		switch err {
+		case integration.ErrEmailSyncNotFound:
+			h.writeError(w, http.StatusNotFound, "not_found", "Sync not found")
+		case integration.ErrEmailConnectionNotFound:
+			h.writeError(w, http.StatusNotFound, "not_found", "Connection not found")
+		case integration.ErrEmailConnectionInactive:
+			h.writeError(w, http.StatusBadRequest, "connection_inactive", "Connection is not active")
+		case integration.ErrEmailSyncAlreadyRunning:
+			h.writeError(w, http.StatusConflict, "sync_running", "A sync is already running for this connection")
+		case integration.ErrEmailLabelNotFound:
+			h.writeError(w, http.StatusNotFound, "label_not_found", "Label not found")
+		default:
+			h.writeError(w, http.StatusInternalServerError, "sync_failed", "Sync failed: "+err.Error())
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusAccepted, h.emailSyncResultToResponse(result))
+}
+
+// EmailSyncFailureResponse represents a message that failed to process in
+// a sync
+type EmailSyncFailureResponse struct {
+	ID           string     `json:"id"`
+	SyncID       string     `json:"sync_id"`
+	MessageID    string     `json:"message_id"`
+	Status       string     `json:"status"`
+	ErrorMessage string     `json:"error_message"`
+	Attempts     int        `json:"attempts"`
+	ResolvedAt   *time.Time `json:"resolved_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// ListEmailSyncFailuresResponse represents the failed messages of a sync
+type ListEmailSyncFailuresResponse struct {
+	Failures []*EmailSyncFailureResponse `json:"failures"`
+	Total    int                         `json:"total"`
+}
+
+// HandleListSyncFailures handles GET /api/integrations/email/syncs/{id}/failures
+func (h *EmailHandler) HandleListSyncFailures(w http.ResponseWriter, r *http.Request, syncID string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	failures, err := h.syncService.GetSyncFailures(r.Context(), syncID)
+	if err != nil {
+		if err == integration.ErrEmailSyncNotFound {
+			h.writeError(w, http.StatusNotFound, "not_found", "Sync not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get sync failures: "+err.Error())
+		return
+	}
+
+	resp := ListEmailSyncFailuresResponse{
+		Failures: make([]*EmailSyncFailureResponse, len(failures)),
+		Total:    len(failures),
+	}
+	for i, failure := range failures {
+		resp.Failures[i] = h.syncFailureToResponse(failure)
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleRetrySyncFailures handles POST /api/integrations/email/syncs/{id}/retry
+func (h *EmailHandler) HandleRetrySyncFailures(w http.ResponseWriter, r *http.Request, syncID string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	// Use a background context for the sync operation
+	syncCtx := context.Background()
+	result, err := h.syncService.RetrySyncFailures(syncCtx, syncID, h.tracker.Notify)
+	if result != nil {
+		// Let stream clients see the final state before their channels close
+		h.tracker.Notify(h.emailSyncResultToProgress(result))
+		h.tracker.CleanupWatchers(result.SyncID)
+	}
+	if err != nil {
+		switch err {
+		case integration.ErrEmailSyncNotFound:
+			h.writeError(w, http.StatusNotFound, "not_found", "Sync not found")
+		case integration.ErrEmailSyncNoFailures:
+			h.writeError(w, http.StatusConflict, "no_failures", "Sync has no failed messages to retry")
+		case integration.ErrEmailConnectionNotFound:
+			h.writeError(w, http.StatusNotFound, "not_found", "Connection not found")
+		case integration.ErrEmailSyncAlreadyRunning:
+			h.writeError(w, http.StatusConflict, "sync_running", "A sync is already running for this connection")
+		default:
+			if errors.Is(err, integration.ErrEmailConnectionInactive) {
+				h.writeError(w, http.StatusBadRequest, "connection_inactive", "Connection is not active")
+				return
+			}
+			h.writeError(w, http.StatusInternalServerError, "sync_failed", "Retry failed: "+err.Error())
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusAccepted, h.emailSyncResultToResponse(result))
+}
+
 // ========================================
 // Receipt and Attachment Handlers
 // ========================================
@@ -1072,20 +1618,223 @@ func (h *EmailHandler) HandleDownloadAttachment(w http.ResponseWriter, r *http.R
 }
 
 // ========================================
-// Helper Methods
+// Export Handlers
 // ========================================
 
-// cleanupOldStates removes OAuth states older than 10 minutes
-func (h *EmailHandler) cleanupOldStates() {
-	h.mu.Lock()
-	defer h.mu.Unlock()
+// exportBatchSize is the number of receipts loaded per query while exporting
+const exportBatchSize = 200
+
+// EmailExportConnection is the first line of a connection export
+type EmailExportConnection struct {
+	Type       string     `json:"type"` // connection
+	ID         string     `json:"id"`
+	Email      string     `json:"email"`
+	Provider   string     `json:"provider"`
+	Status     string     `json:"status"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastSyncAt *time.Time `json:"last_sync_at,omitempty"`
+	ExportedAt time.Time  `json:"exported_at"`
+}
+
+// EmailExportReceipt is a receipt line of a connection export
+type EmailExportReceipt struct {
+	Type            string                 `json:"type"` // receipt
+	ID              string                 `json:"id"`
+	MessageID       *string                `json:"message_id,omitempty"`
+	Status          string                 `json:"status"`
+	Attachment      EmailExportAttachment  `json:"attachment"`
+	MerchantName    *string                `json:"merchant_name,omitempty"`
+	MerchantAddress *string                `json:"merchant_address,omitempty"`
+	ReceiptDate     *time.Time             `json:"receipt_date,omitempty"`
+	TotalAmount     *float64               `json:"total_amount,omitempty"`
+	TaxAmount       *float64               `json:"tax_amount,omitempty"`
+	SubtotalAmount  *float64               `json:"subtotal_amount,omitempty"`
+	Currency        string                 `json:"currency,omitempty"`
+	PaymentMethod   *string                `json:"payment_method,omitempty"`
+	ReceiptNumber   *string                `json:"receipt_number,omitempty"`
+	CategoryTags    []string               `json:"category_tags,omitempty"`
+	OCRCompleted    bool                   `json:"ocr_completed"`
+	OCRText         *string                `json:"ocr_text,omitempty"`
+	OCRConfidence   *float64               `json:"ocr_confidence,omitempty"`
+	ExtractedData   map[string]interface{} `json:"extracted_data,omitempty"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty"`
+	LineItems       []EmailExportLineItem  `json:"line_items,omitempty"`
+	CreatedAt       time.Time              `json:"created_at"`
+	ProcessedAt     *time.Time             `json:"processed_at,omitempty"`
+}
+
+// EmailExportAttachment describes the attachment a receipt was extracted from
+type EmailExportAttachment struct {
+	FileName      string  `json:"file_name"`
+	MimeType      string  `json:"mime_type"`
+	FileSize      int64   `json:"file_size"`
+	FilePath      *string `json:"file_path,omitempty"`
+	StorageBucket *string `json:"storage_bucket,omitempty"`
+	StorageKey    *string `json:"storage_key,omitempty"`
+}
+
+// EmailExportLineItem is a line item extracted from a receipt
+type EmailExportLineItem struct {
+	LineNumber  int      `json:"line_number"`
+	Description string   `json:"description"`
+	Quantity    float64  `json:"quantity"`
+	UnitPrice   float64  `json:"unit_price"`
+	TotalPrice  float64  `json:"total_price"`
+	TaxAmount   float64  `json:"tax_amount"`
+	Category    *string  `json:"category,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+// EmailExportSummary is the last line of a connection export. A stream that
+// ends without one was cut short.
+type EmailExportSummary struct {
+	Type     string `json:"type"` // summary
+	Receipts int    `json:"receipts"`
+	Error    string `json:"error,omitempty"`
+}
+
+// HandleExportConnection handles GET /api/integrations/email/connections/{id}/export
+// It streams the receipts extracted from the connection, with their
+// attachment metadata and line items, as JSON Lines: a connection line, one
+// line per receipt, and a closing summary line.
+func (h *EmailHandler) HandleExportConnection(w http.ResponseWriter, r *http.Request, connectionID string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
 
-	cutoff := time.Now().Add(-10 * time.Minute)
-	for state, data := range h.states {
-		if data.CreatedAt.Before(cutoff) {
-			delete(h.states, state)
+	ctx := r.Context()
+	conn, err := h.entClient.EmailConnection.Get(ctx, connectionID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Connection not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get connection: "+err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"email-connection-%s.jsonl\"", conn.ID))
+	w.WriteHeader(http.StatusOK)
+
+	enc := json.NewEncoder(w)
+	if err := enc.Encode(EmailExportConnection{
+		Type:       "connection",
+		ID:         conn.ID,
+		Email:      conn.Email,
+		Provider:   string(conn.Provider),
+		Status:     string(conn.Status),
+		CreatedAt:  conn.CreatedAt,
+		LastSyncAt: conn.LastSyncAt,
+		ExportedAt: time.Now(),
+	}); err != nil {
+		return
+	}
+
+	// Page by ID so receipts created during the export can't shift pages
+	summary := EmailExportSummary{Type: "summary"}
+	lastID := ""
+	for {
+		receipts, err := h.entClient.Receipt.Query().
+			Where(
+				receipt.SourceTypeEQ(receipt.SourceTypeEmail),
+				receipt.SourceConnectionID(conn.ID),
+				receipt.IDGT(lastID),
+			).
+			WithLineItems(func(q *ent.LineItemQuery) {
+				q.Order(lineitem.ByLineNumber())
+			}).
+			Order(receipt.ByID()).
+			Limit(exportBatchSize).
+			All(ctx)
+		if err != nil {
+			// Headers are already sent, so report the failure in the stream
+			summary.Error = "Failed to query receipts: " + err.Error()
+			break
 		}
+
+		for _, rec := range receipts {
+			if err := enc.Encode(h.receiptToExport(rec)); err != nil {
+				return
+			}
+			summary.Receipts++
+		}
+
+		if len(receipts) < exportBatchSize {
+			break
+		}
+		lastID = receipts[len(receipts)-1].ID
+	}
+
+	enc.Encode(summary)
+}
+
+// ========================================
+// Helper Methods
+// ========================================
+
+// sandboxAuthURL returns the authorization URL of a sandbox connection: the
+// OAuth redirect URL, or the callback itself if none is configured, with
+// the state and a placeholder code
+func (h *EmailHandler) sandboxAuthURL(state string) string {
+	callback := h.oauthConfig.RedirectURL
+	if callback == "" {
+		callback = "/api/integrations/email/oauth/callback"
+	}
+	params := url.Values{"code": {"sandbox"}, "state": {state}}
+	if strings.Contains(callback, "?") {
+		return callback + "&" + params.Encode()
 	}
+	return callback + "?" + params.Encode()
+}
+
+// receiptToExport converts an ent receipt to an export line
+func (h *EmailHandler) receiptToExport(rec *ent.Receipt) EmailExportReceipt {
+	line := EmailExportReceipt{
+		Type:      "receipt",
+		ID:        rec.ID,
+		MessageID: rec.SourceID,
+		Status:    string(rec.Status),
+		Attachment: EmailExportAttachment{
+			FileName:      rec.FileName,
+			MimeType:      rec.MimeType,
+			FileSize:      rec.FileSize,
+			FilePath:      rec.FilePath,
+			StorageBucket: rec.StorageBucket,
+			StorageKey:    rec.StorageKey,
+		},
+		MerchantName:    rec.MerchantName,
+		MerchantAddress: rec.MerchantAddress,
+		ReceiptDate:     rec.ReceiptDate,
+		TotalAmount:     rec.TotalAmount,
+		TaxAmount:       rec.TaxAmount,
+		SubtotalAmount:  rec.SubtotalAmount,
+		Currency:        rec.Currency,
+		PaymentMethod:   rec.PaymentMethod,
+		ReceiptNumber:   rec.ReceiptNumber,
+		CategoryTags:    rec.CategoryTags,
+		OCRCompleted:    rec.OcrCompleted,
+		OCRText:         rec.OcrText,
+		OCRConfidence:   rec.OcrConfidence,
+		ExtractedData:   rec.ExtractedData,
+		Metadata:        rec.Metadata,
+		CreatedAt:       rec.CreatedAt,
+		ProcessedAt:     rec.ProcessedAt,
+	}
+	for _, item := range rec.Edges.LineItems {
+		line.LineItems = append(line.LineItems, EmailExportLineItem{
+			LineNumber:  item.LineNumber,
+			Description: item.Description,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.UnitPrice,
+			TotalPrice:  item.TotalPrice,
+			TaxAmount:   item.TaxAmount,
+			Category:    item.Category,
+			Tags:        item.Tags,
+		})
+	}
+	return line
 }
 
 // connectionToResponse converts an ent connection to response format
@@ -1093,12 +1842,15 @@ func (h *EmailHandler) connectionToResponse(conn *ent.EmailConnection) *EmailCon
 	resp := &EmailConnectionResponse{
 		ID:                conn.ID,
 		UserID:            conn.UserID,
+		OrganizationID:    conn.OrganizationID,
 		ProviderAccountID: conn.ProviderAccountID,
 		Email:             conn.Email,
 		Provider:          string(conn.Provider),
 		Status:            string(conn.Status),
 		CreatedAt:         conn.CreatedAt,
 		UpdatedAt:         conn.UpdatedAt,
+		SyncSchedule:      string(conn.SyncSchedule),
+		NextSyncAt:        conn.NextSyncAt,
 	}
 	if conn.LastSyncAt != nil {
 		resp.LastSyncAt = conn.LastSyncAt
@@ -1150,6 +1902,53 @@ func (h *EmailHandler) emailSyncResultToResponse(result *integration.EmailSyncRe
 		AttachmentsDownloaded: result.AttachmentsDownloaded,
 		BytesTransferred:      result.BytesTransferred,
 		ErrorMessage:          result.ErrorMessage,
+		FallbackReason:        result.FallbackReason,
+	}
+}
+
+// syncFailureToResponse converts a sync failure entity to a response
+func (h *EmailHandler) syncFailureToResponse(failure *ent.EmailSyncFailure) *EmailSyncFailureResponse {
+	return &EmailSyncFailureResponse{
+		ID:           failure.ID,
+		SyncID:       failure.SyncID,
+		MessageID:    failure.MessageID,
+		Status:       string(failure.Status),
+		ErrorMessage: failure.ErrorMessage,
+		Attempts:     failure.Attempts,
+		ResolvedAt:   failure.ResolvedAt,
+		CreatedAt:    failure.CreatedAt,
+		UpdatedAt:    failure.UpdatedAt,
+	}
+}
+
+// emailSyncResultToProgress converts a sync result to a progress update
+func (h *EmailHandler) emailSyncResultToProgress(result *integration.EmailSyncResult) integration.EmailSyncProgress {
+	progress := integration.EmailSyncProgress{
+		SyncID:                result.SyncID,
+		Status:                result.Status,
+		MessagesScanned:       result.MessagesScanned,
+		MessagesProcessed:     result.MessagesDownloaded,
+		AttachmentsDownloaded: result.AttachmentsDownloaded,
+		BytesTransferred:      result.BytesTransferred,
+	}
+	if result.ErrorMessage != nil {
+		progress.Errors = []string{*result.ErrorMessage}
+	}
+	return progress
+}
+
+// progressToResponse converts a progress update to response format
+func (h *EmailHandler) progressToResponse(progress integration.EmailSyncProgress) *EmailSyncProgressResponse {
+	return &EmailSyncProgressResponse{
+		SyncID:                progress.SyncID,
+		Status:                progress.Status,
+		MessagesScanned:       progress.MessagesScanned,
+		MessagesProcessed:     progress.MessagesProcessed,
+		TotalMessages:         progress.TotalMessages,
+		AttachmentsDownloaded: progress.AttachmentsDownloaded,
+		BytesTransferred:      progress.BytesTransferred,
+		CurrentMessage:        progress.CurrentMessage,
+		Errors:                progress.Errors,
 	}
 }
 
@@ -1167,3 +1966,15 @@ func (h *EmailHandler) writeError(w http.ResponseWriter, status int, errCode str
 		Message: message,
 	})
 }
+
+// writeEvent writes a Server-Sent Event and flushes it to the client
+func (h *EmailHandler) writeEvent(w http.ResponseWriter, rc *http.ResponseController, event string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+		return err
+	}
+	return rc.Flush()
+}
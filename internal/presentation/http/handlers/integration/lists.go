@@ -0,0 +1,105 @@
+package integration
+
+import (
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/emaillabel"
+	"clockzen-next/internal/ent/emailsync"
+	"clockzen-next/internal/ent/googledriveconnection"
+	"clockzen-next/internal/ent/googledrivefolder"
+	"clockzen-next/internal/ent/googledrivesync"
+	"clockzen-next/internal/presentation/http/pagination"
+)
+
+// What the integration list endpoints can be sorted and filtered by. Each
+// takes limit, cursor and sort parameters, and start_date and end_date
+// filtering on when rows were created; see package pagination.
+
+// emailConnectionList lists email connections, the newest first
+var emailConnectionList = &pagination.Spec[*ent.EmailConnection]{
+	Sorts: map[string]func(*ent.EmailConnection) any{
+		emailconnection.FieldCreatedAt: func(c *ent.EmailConnection) any { return c.CreatedAt },
+		emailconnection.FieldUpdatedAt: func(c *ent.EmailConnection) any { return c.UpdatedAt },
+		emailconnection.FieldEmail:     func(c *ent.EmailConnection) any { return c.Email },
+	},
+	DefaultSort: "-" + emailconnection.FieldCreatedAt,
+	Filters: map[string]func(string) error{
+		emailconnection.FieldStatus:   pagination.Enum(emailconnection.StatusValidator),
+		emailconnection.FieldProvider: pagination.Enum(emailconnection.ProviderValidator),
+	},
+	DateColumn: emailconnection.FieldCreatedAt,
+	ID:         func(c *ent.EmailConnection) string { return c.ID },
+}
+
+// driveConnectionList lists Drive connections, the newest first
+var driveConnectionList = &pagination.Spec[*ent.GoogleDriveConnection]{
+	Sorts: map[string]func(*ent.GoogleDriveConnection) any{
+		googledriveconnection.FieldCreatedAt: func(c *ent.GoogleDriveConnection) any { return c.CreatedAt },
+		googledriveconnection.FieldUpdatedAt: func(c *ent.GoogleDriveConnection) any { return c.UpdatedAt },
+		googledriveconnection.FieldEmail:     func(c *ent.GoogleDriveConnection) any { return c.Email },
+	},
+	DefaultSort: "-" + googledriveconnection.FieldCreatedAt,
+	Filters: map[string]func(string) error{
+		googledriveconnection.FieldStatus: pagination.Enum(googledriveconnection.StatusValidator),
+	},
+	DateColumn: googledriveconnection.FieldCreatedAt,
+	ID:         func(c *ent.GoogleDriveConnection) string { return c.ID },
+}
+
+// emailLabelList lists a connection's labels by name
+var emailLabelList = &pagination.Spec[*ent.EmailLabel]{
+	Sorts: map[string]func(*ent.EmailLabel) any{
+		emaillabel.FieldName:      func(l *ent.EmailLabel) any { return l.Name },
+		emaillabel.FieldCreatedAt: func(l *ent.EmailLabel) any { return l.CreatedAt },
+	},
+	DefaultSort: emaillabel.FieldName,
+	Filters: map[string]func(string) error{
+		emaillabel.FieldLabelType: pagination.Enum(emaillabel.LabelTypeValidator),
+	},
+	DateColumn: emaillabel.FieldCreatedAt,
+	ID:         func(l *ent.EmailLabel) string { return l.ID },
+}
+
+// driveFolderList lists a connection's tracked folders by name
+var driveFolderList = &pagination.Spec[*ent.GoogleDriveFolder]{
+	Sorts: map[string]func(*ent.GoogleDriveFolder) any{
+		googledrivefolder.FieldName:      func(f *ent.GoogleDriveFolder) any { return f.Name },
+		googledrivefolder.FieldCreatedAt: func(f *ent.GoogleDriveFolder) any { return f.CreatedAt },
+	},
+	DefaultSort: googledrivefolder.FieldName,
+	Filters: map[string]func(string) error{
+		googledrivefolder.FieldSyncDirection: pagination.Enum(googledrivefolder.SyncDirectionValidator),
+	},
+	DateColumn: googledrivefolder.FieldCreatedAt,
+	ID:         func(f *ent.GoogleDriveFolder) string { return f.ID },
+}
+
+// emailSyncList lists a connection's sync history, the latest first
+var emailSyncList = &pagination.Spec[*ent.EmailSync]{
+	Sorts: map[string]func(*ent.EmailSync) any{
+		emailsync.FieldCreatedAt: func(s *ent.EmailSync) any { return s.CreatedAt },
+	},
+	DefaultSort: "-" + emailsync.FieldCreatedAt,
+	Filters: map[string]func(string) error{
+		emailsync.FieldStatus:   pagination.Enum(emailsync.StatusValidator),
+		emailsync.FieldSyncType: pagination.Enum(emailsync.SyncTypeValidator),
+		emailsync.FieldLabelID:  nil,
+	},
+	DateColumn: emailsync.FieldCreatedAt,
+	ID:         func(s *ent.EmailSync) string { return s.ID },
+}
+
+// driveSyncList lists a connection's sync history, the latest first
+var driveSyncList = &pagination.Spec[*ent.GoogleDriveSync]{
+	Sorts: map[string]func(*ent.GoogleDriveSync) any{
+		googledrivesync.FieldCreatedAt: func(s *ent.GoogleDriveSync) any { return s.CreatedAt },
+	},
+	DefaultSort: "-" + googledrivesync.FieldCreatedAt,
+	Filters: map[string]func(string) error{
+		googledrivesync.FieldStatus:   pagination.Enum(googledrivesync.StatusValidator),
+		googledrivesync.FieldSyncType: pagination.Enum(googledrivesync.SyncTypeValidator),
+		googledrivesync.FieldFolderID: nil,
+	},
+	DateColumn: googledrivesync.FieldCreatedAt,
+	ID:         func(s *ent.GoogleDriveSync) string { return s.ID },
+}
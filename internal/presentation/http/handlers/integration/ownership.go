@@ -0,0 +1,183 @@
+package integration
+
+import (
+	"net/http"
+
+	entsql "entgo.io/ent/dialect/sql"
+
+	"clockzen-next/internal/application/organizations"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// ========================================
+// Ownership Checks
+// ========================================
+//
+// Each check resolves a resource to its connection and verifies that the
+// connection belongs to the organization the request acts in, or else was
+// made by the authenticated user outside any organization. On failure the
+// error response has already been written and false is returned. Resources
+// owned by others are reported as not found so their existence is not
+// revealed.
+
+// requireUserID returns the ID connections are owned by, the organization
+// the request acts in or else the authenticated user, or writes 401
+func requireUserID(w http.ResponseWriter, r *http.Request, writeError func(http.ResponseWriter, int, string, string)) (string, bool) {
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return "", false
+	}
+	return userID, true
+}
+
+// requireConnector returns the authenticated user, who makes new
+// connections, and the organization the request acts in, or "" outside
+// organizations, or writes 401
+func requireConnector(w http.ResponseWriter, r *http.Request, writeError func(http.ResponseWriter, int, string, string)) (string, string, bool) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return "", "", false
+	}
+	organizationID := ""
+	if org, ok := middleware.OrganizationFromContext(r.Context()); ok {
+		organizationID = org.ID
+	}
+	return userID, organizationID, true
+}
+
+// ownedBy returns an upsert condition matching connections with the same
+// owner as one the user makes in the organization, or outside organizations
+// if organizationID is ""
+func ownedBy(userID, organizationID string) *entsql.Predicate {
+	if organizationID != "" {
+		return entsql.EQ("organization_id", organizationID)
+	}
+	return entsql.And(entsql.EQ("user_id", userID), entsql.IsNull("organization_id"))
+}
+
+// authorizeConnection verifies the user owns a Drive connection
+func (h *DriveHandler) authorizeConnection(w http.ResponseWriter, r *http.Request, connectionID string) bool {
+	userID, ok := requireUserID(w, r, h.writeError)
+	if !ok {
+		return false
+	}
+
+	conn, err := h.entClient.GoogleDriveConnection.Get(r.Context(), connectionID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Connection not found")
+			return false
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get connection: "+err.Error())
+		return false
+	}
+	if organizations.OwnerID(conn.UserID, conn.OrganizationID) != userID {
+		h.writeError(w, http.StatusNotFound, "not_found", "Connection not found")
+		return false
+	}
+
+	return true
+}
+
+// authorizeFolder verifies the user owns the connection of a Drive folder
+func (h *DriveHandler) authorizeFolder(w http.ResponseWriter, r *http.Request, folderID string) bool {
+	if _, ok := requireUserID(w, r, h.writeError); !ok {
+		return false
+	}
+
+	folder, err := h.entClient.GoogleDriveFolder.Get(r.Context(), folderID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Folder not found")
+			return false
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get folder: "+err.Error())
+		return false
+	}
+
+	return h.authorizeConnection(w, r, folder.ConnectionID)
+}
+
+// authorizeSync verifies the user owns the connection of a Drive sync
+func (h *DriveHandler) authorizeSync(w http.ResponseWriter, r *http.Request, syncID string) bool {
+	if _, ok := requireUserID(w, r, h.writeError); !ok {
+		return false
+	}
+
+	sync, err := h.entClient.GoogleDriveSync.Get(r.Context(), syncID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Sync not found")
+			return false
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get sync: "+err.Error())
+		return false
+	}
+
+	return h.authorizeConnection(w, r, sync.ConnectionID)
+}
+
+// authorizeConnection verifies the user owns an email connection
+func (h *EmailHandler) authorizeConnection(w http.ResponseWriter, r *http.Request, connectionID string) bool {
+	userID, ok := requireUserID(w, r, h.writeError)
+	if !ok {
+		return false
+	}
+
+	conn, err := h.entClient.EmailConnection.Get(r.Context(), connectionID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Connection not found")
+			return false
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get connection: "+err.Error())
+		return false
+	}
+	if organizations.OwnerID(conn.UserID, conn.OrganizationID) != userID {
+		h.writeError(w, http.StatusNotFound, "not_found", "Connection not found")
+		return false
+	}
+
+	return true
+}
+
+// authorizeLabel verifies the user owns the connection of an email label
+func (h *EmailHandler) authorizeLabel(w http.ResponseWriter, r *http.Request, labelID string) bool {
+	if _, ok := requireUserID(w, r, h.writeError); !ok {
+		return false
+	}
+
+	label, err := h.entClient.EmailLabel.Get(r.Context(), labelID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Label not found")
+			return false
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get label: "+err.Error())
+		return false
+	}
+
+	return h.authorizeConnection(w, r, label.ConnectionID)
+}
+
+// authorizeSync verifies the user owns the connection of an email sync
+func (h *EmailHandler) authorizeSync(w http.ResponseWriter, r *http.Request, syncID string) bool {
+	if _, ok := requireUserID(w, r, h.writeError); !ok {
+		return false
+	}
+
+	sync, err := h.entClient.EmailSync.Get(r.Context(), syncID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Sync not found")
+			return false
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get sync: "+err.Error())
+		return false
+	}
+
+	return h.authorizeConnection(w, r, sync.ConnectionID)
+}
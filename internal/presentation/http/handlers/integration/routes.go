@@ -4,8 +4,10 @@ import (
 	"net/http"
 	"strings"
 
+	"clockzen-next/internal/application/integration"
 	"clockzen-next/internal/ent"
 	"clockzen-next/internal/infrastructure/google"
+	"clockzen-next/internal/infrastructure/oauthstate"
 )
 
 // Router handles routing for integration-related endpoints
@@ -31,27 +33,44 @@ func NewDefaultRouter(entClient *ent.Client, oauthConfig *google.Config) *Router
 	}
 }
 
-// RegisterRoutes registers all integration routes with the given mux
-// Total routes: 44 (22 Drive + 22 Email)
+// RegisterPublicRoutes registers the routes that must be reachable without
+// authentication. OAuth callbacks are requested by the provider's redirect,
+// which carries no bearer token; the CSRF state issued by the authenticated
+// initiate request ties the callback to its user.
+func (r *Router) RegisterPublicRoutes(mux *http.ServeMux) {
+	// GET/POST /api/integrations/drive/oauth/callback - OAuth callback
+	mux.HandleFunc("/api/integrations/drive/oauth/callback", r.handleOAuthCallback)
+	// GET/POST /api/integrations/email/oauth/callback - OAuth callback
+	mux.HandleFunc("/api/integrations/email/oauth/callback", r.handleEmailOAuthCallback)
+}
+
+// RegisterRoutes registers the authenticated integration routes with the
+// given mux. Handlers expect the user injected by middleware.RequireAuth and
+// only expose connections, labels, folders and syncs owned by that user.
+// Their lists are paged with cursors and can be filtered and sorted; see
+// package pagination and lists.go.
+// Total routes: 48 (25 Drive + 23 Email), plus 2 from RegisterPublicRoutes
 func (r *Router) RegisterRoutes(mux *http.ServeMux) {
 	// ========================================
 	// Drive OAuth Routes
 	// ========================================
 	// POST /api/integrations/drive/oauth/initiate - Initiate OAuth flow
-	// GET/POST /api/integrations/drive/oauth/callback - OAuth callback
 	mux.HandleFunc("/api/integrations/drive/oauth/initiate", r.handleOAuthInitiate)
-	mux.HandleFunc("/api/integrations/drive/oauth/callback", r.handleOAuthCallback)
 
 	// ========================================
 	// Drive Connection Routes
 	// ========================================
 	// GET /api/integrations/drive/connections - List connections
 	// GET /api/integrations/drive/connections/{id} - Get connection
+	// PUT/PATCH /api/integrations/drive/connections/{id} - Update connection (sync schedule)
 	// DELETE /api/integrations/drive/connections/{id} - Disconnect (revoke)
 	// POST /api/integrations/drive/connections/{id}/refresh - Refresh token
 	// GET /api/integrations/drive/connections/{id}/folders - List folders
 	// POST /api/integrations/drive/connections/{id}/folders - Add folder
 	// GET /api/integrations/drive/connections/{id}/browse - Browse Drive
+	// GET /api/integrations/drive/connections/{id}/picker/folders - List Drive folders under a parent
+	// GET /api/integrations/drive/connections/{id}/picker/search - Search Drive folders by name
+	// POST /api/integrations/drive/connections/{id}/picker/selections - Track or untrack picked folders
 	// POST /api/integrations/drive/connections/{id}/sync - Trigger sync
 	// GET /api/integrations/drive/connections/{id}/syncs - List syncs
 	// POST /api/integrations/drive/connections/{id}/sync/cancel - Cancel sync
@@ -76,15 +95,14 @@ func (r *Router) RegisterRoutes(mux *http.ServeMux) {
 	// Email OAuth Routes
 	// ========================================
 	// POST /api/integrations/email/oauth/initiate - Initiate OAuth flow
-	// GET/POST /api/integrations/email/oauth/callback - OAuth callback
 	mux.HandleFunc("/api/integrations/email/oauth/initiate", r.handleEmailOAuthInitiate)
-	mux.HandleFunc("/api/integrations/email/oauth/callback", r.handleEmailOAuthCallback)
 
 	// ========================================
 	// Email Connection Routes
 	// ========================================
 	// GET /api/integrations/email/connections - List connections
 	// GET /api/integrations/email/connections/{id} - Get connection
+	// PUT/PATCH /api/integrations/email/connections/{id} - Update connection (sync schedule)
 	// DELETE /api/integrations/email/connections/{id} - Disconnect (revoke)
 	// POST /api/integrations/email/connections/{id}/refresh - Refresh token
 	// GET /api/integrations/email/connections/{id}/labels - List labels
@@ -94,6 +112,7 @@ func (r *Router) RegisterRoutes(mux *http.ServeMux) {
 	// GET /api/integrations/email/connections/{id}/syncs - List syncs
 	// POST /api/integrations/email/connections/{id}/sync/cancel - Cancel sync
 	// GET /api/integrations/email/connections/{id}/messages/{msgId}/attachments/{attId} - Download attachment
+	// GET /api/integrations/email/connections/{id}/export - Export extracted receipts as JSON Lines
 	mux.HandleFunc("/api/integrations/email/connections", r.handleEmailConnections)
 	mux.HandleFunc("/api/integrations/email/connections/", r.handleEmailConnectionByID)
 
@@ -110,6 +129,10 @@ func (r *Router) RegisterRoutes(mux *http.ServeMux) {
 	// Email Sync Status Routes
 	// ========================================
 	// GET /api/integrations/email/syncs/{id} - Get sync status
+	// GET /api/integrations/email/syncs/{id}/stream - Stream sync progress (SSE)
+	// POST /api/integrations/email/syncs/{id}/resume - Resume an interrupted sync
+	// GET /api/integrations/email/syncs/{id}/failures - List messages that failed to process
+	// POST /api/integrations/email/syncs/{id}/retry - Reprocess only the failed messages
 	mux.HandleFunc("/api/integrations/email/syncs/", r.handleEmailSyncByID)
 }
 
@@ -145,6 +168,9 @@ func (r *Router) handleConnectionByID(w http.ResponseWriter, req *http.Request)
 	}
 
 	connectionID := parts[0]
+	if !r.driveHandler.authorizeConnection(w, req, connectionID) {
+		return
+	}
 
 	// Check if this is a sub-resource request
 	if len(parts) > 1 {
@@ -158,6 +184,9 @@ func (r *Router) handleConnectionByID(w http.ResponseWriter, req *http.Request)
 		case "browse":
 			r.driveHandler.HandleBrowseDrive(w, req, connectionID)
 			return
+		case "picker":
+			r.handleFolderPicker(w, req, connectionID, parts[2:])
+			return
 		case "sync":
 			// Check for cancel sub-resource
 			if len(parts) > 2 && parts[2] == "cancel" {
@@ -179,6 +208,8 @@ func (r *Router) handleConnectionByID(w http.ResponseWriter, req *http.Request)
 	switch req.Method {
 	case http.MethodGet:
 		r.driveHandler.HandleGetConnection(w, req, connectionID)
+	case http.MethodPut, http.MethodPatch:
+		r.driveHandler.HandleUpdateConnection(w, req, connectionID)
 	case http.MethodDelete:
 		r.driveHandler.HandleDisconnect(w, req, connectionID)
 	default:
@@ -198,6 +229,25 @@ func (r *Router) handleConnectionFolders(w http.ResponseWriter, req *http.Reques
 	}
 }
 
+// handleFolderPicker routes folder picker requests under a connection
+func (r *Router) handleFolderPicker(w http.ResponseWriter, req *http.Request, connectionID string, parts []string) {
+	if len(parts) != 1 {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+
+	switch parts[0] {
+	case "folders":
+		r.driveHandler.HandlePickerFolders(w, req, connectionID)
+	case "search":
+		r.driveHandler.HandlePickerSearch(w, req, connectionID)
+	case "selections":
+		r.driveHandler.HandleSelectFolders(w, req, connectionID)
+	default:
+		http.Error(w, "Not found", http.StatusNotFound)
+	}
+}
+
 // handleFolderByID routes requests for /api/integrations/drive/folders/{id}
 func (r *Router) handleFolderByID(w http.ResponseWriter, req *http.Request) {
 	// Extract the ID from the URL path
@@ -206,6 +256,9 @@ func (r *Router) handleFolderByID(w http.ResponseWriter, req *http.Request) {
 		http.Error(w, "Folder ID required", http.StatusBadRequest)
 		return
 	}
+	if !r.driveHandler.authorizeFolder(w, req, folderID) {
+		return
+	}
 
 	// Handle folder CRUD operations
 	switch req.Method {
@@ -228,6 +281,9 @@ func (r *Router) handleSyncByID(w http.ResponseWriter, req *http.Request) {
 		http.Error(w, "Sync ID required", http.StatusBadRequest)
 		return
 	}
+	if !r.driveHandler.authorizeSync(w, req, syncID) {
+		return
+	}
 
 	// Handle sync status operations
 	switch req.Method {
@@ -238,6 +294,32 @@ func (r *Router) handleSyncByID(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
+// SetTokenStore sets the store both handlers use to encrypt and decrypt
+// connection tokens
+func (r *Router) SetTokenStore(tokens *integration.TokenStore) {
+	r.driveHandler.SetTokenStore(tokens)
+	r.emailHandler.SetTokenStore(tokens)
+}
+
+// SetTokenManager sets the manager both handlers refresh connection tokens
+// with, so concurrent refreshes of a connection are serialized
+func (r *Router) SetTokenManager(manager *integration.TokenManager) {
+	r.driveHandler.SetTokenManager(manager)
+	r.emailHandler.SetTokenManager(manager)
+}
+
+// SetStateStore sets the store both handlers keep OAuth states in
+func (r *Router) SetStateStore(states oauthstate.Store) {
+	r.driveHandler.SetStateStore(states)
+	r.emailHandler.SetStateStore(states)
+}
+
+// SetSandboxMode sets whether sandbox email connections, which sync a
+// synthetic mailbox without Google credentials, can be made
+func (r *Router) SetSandboxMode(enabled bool) {
+	r.emailHandler.SetSandboxMode(enabled)
+}
+
 // GetDriveHandler returns the drive handler
 func (r *Router) GetDriveHandler() *DriveHandler {
 	return r.driveHandler
@@ -284,6 +366,9 @@ func (r *Router) handleEmailConnectionByID(w http.ResponseWriter, req *http.Requ
 	}
 
 	connectionID := parts[0]
+	if !r.emailHandler.authorizeConnection(w, req, connectionID) {
+		return
+	}
 
 	// Check if this is a sub-resource request
 	if len(parts) > 1 {
@@ -305,6 +390,9 @@ func (r *Router) handleEmailConnectionByID(w http.ResponseWriter, req *http.Requ
 		case "syncs":
 			r.emailHandler.HandleListSyncs(w, req, connectionID)
 			return
+		case "export":
+			r.emailHandler.HandleExportConnection(w, req, connectionID)
+			return
 		case "messages":
 			// Handle attachment download: /connections/{id}/messages/{msgId}/attachments/{attId}
 			if len(parts) >= 5 && parts[3] == "attachments" {
@@ -325,6 +413,8 @@ func (r *Router) handleEmailConnectionByID(w http.ResponseWriter, req *http.Requ
 	switch req.Method {
 	case http.MethodGet:
 		r.emailHandler.HandleGetConnection(w, req, connectionID)
+	case http.MethodPut, http.MethodPatch:
+		r.emailHandler.HandleUpdateConnection(w, req, connectionID)
 	case http.MethodDelete:
 		r.emailHandler.HandleDisconnect(w, req, connectionID)
 	default:
@@ -362,6 +452,9 @@ func (r *Router) handleEmailLabelByID(w http.ResponseWriter, req *http.Request)
 	}
 
 	labelID := parts[0]
+	if !r.emailHandler.authorizeLabel(w, req, labelID) {
+		return
+	}
 
 	// Check for sub-resources
 	if len(parts) > 1 {
@@ -391,11 +484,38 @@ func (r *Router) handleEmailLabelByID(w http.ResponseWriter, req *http.Request)
 // handleEmailSyncByID routes requests for /api/integrations/email/syncs/{id}
 func (r *Router) handleEmailSyncByID(w http.ResponseWriter, req *http.Request) {
 	// Extract the ID from the URL path
-	syncID := strings.TrimPrefix(req.URL.Path, "/api/integrations/email/syncs/")
+	path := strings.TrimPrefix(req.URL.Path, "/api/integrations/email/syncs/")
+	parts := strings.Split(path, "/")
+	syncID := parts[0]
 	if syncID == "" {
 		http.Error(w, "Sync ID required", http.StatusBadRequest)
 		return
 	}
+	if !r.emailHandler.authorizeSync(w, req, syncID) {
+		return
+	}
+
+	// Handle /api/integrations/email/syncs/{id}/stream, /resume, /failures
+	// and /retry
+	if len(parts) > 1 {
+		switch parts[1] {
+		case "stream":
+			r.emailHandler.HandleStreamSync(w, req, syncID)
+			return
+		case "resume":
+			r.emailHandler.HandleResumeSync(w, req, syncID)
+			return
+		case "failures":
+			r.emailHandler.HandleListSyncFailures(w, req, syncID)
+			return
+		case "retry":
+			r.emailHandler.HandleRetrySyncFailures(w, req, syncID)
+			return
+		default:
+			http.Error(w, "Not found", http.StatusNotFound)
+			return
+		}
+	}
 
 	// Handle sync status operations
 	switch req.Method {
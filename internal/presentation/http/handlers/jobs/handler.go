@@ -0,0 +1,236 @@
+package jobs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"clockzen-next/internal/application/dto"
+	"clockzen-next/internal/application/jobs"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// streamKeepAliveInterval is how often an idle job stream sends a comment
+// so proxies do not close the connection
+const streamKeepAliveInterval = 15 * time.Second
+
+// ErrorResponse represents an error response
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// JobHandler handles HTTP requests for background jobs
+type JobHandler struct {
+	service *jobs.Service
+}
+
+// NewJobHandler creates a new JobHandler instance
+func NewJobHandler(service *jobs.Service) *JobHandler {
+	return &JobHandler{
+		service: service,
+	}
+}
+
+// HandleList handles GET /api/jobs
+func (h *JobHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	list, err := h.service.List(r.Context(), userID)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "list_failed", "Failed to list jobs: "+err.Error())
+		return
+	}
+	resp := dto.ListJobsResponse{
+		Jobs:  make([]dto.JobResponse, len(list)),
+		Total: len(list),
+	}
+	for i, job := range list {
+		// Results can be large; fetch a single job to retrieve one
+		resp.Jobs[i] = *h.jobToResponse(job)
+		resp.Jobs[i].Result = nil
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleGet handles GET /api/jobs/{id}
+func (h *JobHandler) HandleGet(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	job, ok := h.authorizeJob(w, r, id)
+	if !ok {
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, h.jobToResponse(job))
+}
+
+// HandleCancel handles POST /api/jobs/{id}/cancel and DELETE /api/jobs/{id}
+func (h *JobHandler) HandleCancel(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost && r.Method != http.MethodDelete {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST or DELETE method is allowed")
+		return
+	}
+
+	if _, ok := h.authorizeJob(w, r, id); !ok {
+		return
+	}
+
+	job, err := h.service.Cancel(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, jobs.ErrJobNotFound):
+			h.writeError(w, http.StatusNotFound, "not_found", "Job not found")
+		case errors.Is(err, jobs.ErrJobFinished):
+			h.writeError(w, http.StatusConflict, "job_finished", "Job has already finished")
+		default:
+			h.writeError(w, http.StatusInternalServerError, "cancel_failed", "Failed to cancel job: "+err.Error())
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusAccepted, h.jobToResponse(job))
+}
+
+// HandleStream handles GET /api/jobs/{id}/stream
+//
+// Job updates are streamed as Server-Sent Events. Each "job" event carries
+// the job's current state, with the partial result of jobs that report one;
+// the stream ends with a "complete" event that includes the result once the
+// job finishes.
+func (h *JobHandler) HandleStream(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	if _, ok := h.authorizeJob(w, r, id); !ok {
+		return
+	}
+
+	updates, err := h.service.Watch(r.Context(), id)
+	if err != nil {
+		h.writeError(w, http.StatusNotFound, "not_found", "Job not found")
+		return
+	}
+
+	// Streams outlive the server's write timeout
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		h.writeError(w, http.StatusInternalServerError, "stream_failed", "Failed to start stream: "+err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	ticker := time.NewTicker(streamKeepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case job, ok := <-updates:
+			if !ok {
+				return
+			}
+			if job.Status.IsTerminal() {
+				// The job finished; send its final state with the result
+				h.writeEvent(w, rc, "complete", h.jobToResponse(&job))
+				return
+			}
+			if err := h.writeEvent(w, rc, "job", h.jobToResponse(&job)); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// authorizeJob loads a job and verifies the caller owns it. Jobs owned by
+// other users are reported as not found.
+func (h *JobHandler) authorizeJob(w http.ResponseWriter, r *http.Request, id string) (*jobs.Job, bool) {
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return nil, false
+	}
+
+	job, err := h.service.Get(r.Context(), id)
+	if err != nil || job.UserID != userID {
+		h.writeError(w, http.StatusNotFound, "not_found", "Job not found")
+		return nil, false
+	}
+
+	return job, true
+}
+
+// jobToResponse converts a job to response format
+func (h *JobHandler) jobToResponse(job *jobs.Job) *dto.JobResponse {
+	resp := &dto.JobResponse{
+		ID:          job.ID,
+		Type:        string(job.Type),
+		Status:      string(job.Status),
+		Progress:    job.Progress,
+		Error:       job.Error,
+		CreatedAt:   job.CreatedAt,
+		StartedAt:   job.StartedAt,
+		CompletedAt: job.CompletedAt,
+	}
+	if len(job.Result) > 0 {
+		resp.Result = job.Result
+	}
+	return resp
+}
+
+// writeJSON writes a JSON response
+func (h *JobHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *JobHandler) writeError(w http.ResponseWriter, status int, errCode string, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
+
+// writeEvent writes a Server-Sent Event and flushes it to the client
+func (h *JobHandler) writeEvent(w http.ResponseWriter, rc *http.ResponseController, event string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+		return err
+	}
+	return rc.Flush()
+}
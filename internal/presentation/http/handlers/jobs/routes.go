@@ -0,0 +1,168 @@
+package jobs
+
+import (
+	"net/http"
+	"strings"
+
+	"clockzen-next/internal/application/jobs"
+)
+
+// Router handles routing for background job endpoints
+type Router struct {
+	handler         *JobHandler
+	scheduleHandler *ScheduleHandler
+}
+
+// NewRouter creates a new Router with the given handlers
+func NewRouter(handler *JobHandler, scheduleHandler *ScheduleHandler) *Router {
+	return &Router{
+		handler:         handler,
+		scheduleHandler: scheduleHandler,
+	}
+}
+
+// NewDefaultRouter creates a new Router backed by the given job service and
+// scheduler
+func NewDefaultRouter(service *jobs.Service, scheduler *jobs.Scheduler) *Router {
+	return &Router{
+		handler:         NewJobHandler(service),
+		scheduleHandler: NewScheduleHandler(scheduler),
+	}
+}
+
+// RegisterRoutes registers all job routes with the given mux
+// Total routes: 12 endpoints
+//
+// Jobs are created by the async mode of long-running endpoints (?async=true)
+// or by recurring schedules, and are only visible to the user who owns them.
+//
+//  1. GET    /api/jobs                          - List the caller's jobs
+//  2. GET    /api/jobs/{id}                     - Get job status and result
+//  3. GET    /api/jobs/{id}/stream              - Stream job updates (SSE)
+//  4. POST   /api/jobs/{id}/cancel              - Cancel a pending or running job
+//  5. DELETE /api/jobs/{id}                     - Cancel a pending or running job
+//
+// Schedule endpoints:
+//  6. GET    /api/jobs/schedules                - List the caller's schedules
+//  7. POST   /api/jobs/schedules                - Create a recurring schedule
+//  8. GET    /api/jobs/schedules/{id}           - Get a schedule
+//  9. PUT    /api/jobs/schedules/{id}           - Update a schedule (also PATCH)
+// 10. DELETE /api/jobs/schedules/{id}           - Delete a schedule
+// 11. GET    /api/jobs/schedules/{id}/runs      - Get the schedule's run history
+// 12. POST   /api/jobs/schedules/{id}/run       - Run the schedule now
+func (r *Router) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/jobs", r.handleJobs)
+	mux.HandleFunc("/api/jobs/", r.handleJobByID)
+	mux.HandleFunc("/api/jobs/schedules", r.handleSchedules)
+	mux.HandleFunc("/api/jobs/schedules/", r.handleScheduleByID)
+}
+
+// handleJobs routes requests for /api/jobs
+func (r *Router) handleJobs(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleList(w, req)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleJobByID routes requests for /api/jobs/{id}
+func (r *Router) handleJobByID(w http.ResponseWriter, req *http.Request) {
+	// Extract the ID from the URL path
+	path := strings.TrimPrefix(req.URL.Path, "/api/jobs/")
+	parts := strings.Split(path, "/")
+
+	if len(parts) == 0 || parts[0] == "" {
+		http.Error(w, "Job ID required", http.StatusBadRequest)
+		return
+	}
+
+	id := parts[0]
+
+	// Check if this is a sub-resource request
+	if len(parts) > 1 {
+		switch parts[1] {
+		case "stream":
+			r.handler.HandleStream(w, req, id)
+			return
+		case "cancel":
+			r.handler.HandleCancel(w, req, id)
+			return
+		default:
+			http.Error(w, "Not found", http.StatusNotFound)
+			return
+		}
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleGet(w, req, id)
+	case http.MethodDelete:
+		r.handler.HandleCancel(w, req, id)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleSchedules routes requests for /api/jobs/schedules
+func (r *Router) handleSchedules(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		r.scheduleHandler.HandleList(w, req)
+	case http.MethodPost:
+		r.scheduleHandler.HandleCreate(w, req)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleScheduleByID routes requests for /api/jobs/schedules/{id}
+func (r *Router) handleScheduleByID(w http.ResponseWriter, req *http.Request) {
+	// Extract the ID from the URL path
+	path := strings.TrimPrefix(req.URL.Path, "/api/jobs/schedules/")
+	parts := strings.Split(path, "/")
+
+	if len(parts) == 0 || parts[0] == "" {
+		r.handleSchedules(w, req)
+		return
+	}
+
+	id := parts[0]
+
+	// Check if this is a sub-resource request
+	if len(parts) > 1 {
+		switch parts[1] {
+		case "runs":
+			r.scheduleHandler.HandleListRuns(w, req, id)
+			return
+		case "run":
+			r.scheduleHandler.HandleRunNow(w, req, id)
+			return
+		default:
+			http.Error(w, "Not found", http.StatusNotFound)
+			return
+		}
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		r.scheduleHandler.HandleGet(w, req, id)
+	case http.MethodPut, http.MethodPatch:
+		r.scheduleHandler.HandleUpdate(w, req, id)
+	case http.MethodDelete:
+		r.scheduleHandler.HandleDelete(w, req, id)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// GetHandler returns the job handler
+func (r *Router) GetHandler() *JobHandler {
+	return r.handler
+}
+
+// GetScheduleHandler returns the schedule handler
+func (r *Router) GetScheduleHandler() *ScheduleHandler {
+	return r.scheduleHandler
+}
@@ -0,0 +1,305 @@
+package jobs
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"clockzen-next/internal/application/dto"
+	"clockzen-next/internal/application/jobs"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// ScheduleHandler handles HTTP requests for recurring job schedules
+type ScheduleHandler struct {
+	scheduler *jobs.Scheduler
+}
+
+// NewScheduleHandler creates a new ScheduleHandler instance
+func NewScheduleHandler(scheduler *jobs.Scheduler) *ScheduleHandler {
+	return &ScheduleHandler{
+		scheduler: scheduler,
+	}
+}
+
+// HandleCreate handles POST /api/jobs/schedules
+func (h *ScheduleHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req dto.CreateJobScheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	if req.Name == "" {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "name is required")
+		return
+	}
+	if req.JobType == "" {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "job_type is required")
+		return
+	}
+
+	var startAt time.Time
+	if req.StartAt != nil {
+		startAt = *req.StartAt
+	}
+
+	schedule, err := h.scheduler.Create(r.Context(), userID, req.Name, jobs.JobType(req.JobType), jobs.Frequency(req.Frequency), req.Params, startAt)
+	if err != nil {
+		h.writeScheduleError(w, err)
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, h.scheduleToResponse(schedule))
+}
+
+// HandleList handles GET /api/jobs/schedules
+func (h *ScheduleHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	list, err := h.scheduler.List(r.Context(), userID)
+	if err != nil {
+		h.writeScheduleError(w, err)
+		return
+	}
+	resp := dto.ListJobSchedulesResponse{
+		Schedules: make([]dto.JobScheduleResponse, len(list)),
+		Total:     len(list),
+	}
+	for i, schedule := range list {
+		resp.Schedules[i] = *h.scheduleToResponse(schedule)
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleGet handles GET /api/jobs/schedules/{id}
+func (h *ScheduleHandler) HandleGet(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	schedule, ok := h.authorizeSchedule(w, r, id)
+	if !ok {
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, h.scheduleToResponse(schedule))
+}
+
+// HandleUpdate handles PUT/PATCH /api/jobs/schedules/{id}
+func (h *ScheduleHandler) HandleUpdate(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPut && r.Method != http.MethodPatch {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only PUT or PATCH method is allowed")
+		return
+	}
+
+	if _, ok := h.authorizeSchedule(w, r, id); !ok {
+		return
+	}
+
+	var req dto.UpdateJobScheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	if req.Name != nil && *req.Name == "" {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "name cannot be empty")
+		return
+	}
+
+	update := jobs.ScheduleUpdate{
+		Name:      req.Name,
+		Params:    req.Params,
+		Enabled:   req.Enabled,
+		NextRunAt: req.NextRunAt,
+	}
+	if req.Frequency != nil {
+		frequency := jobs.Frequency(*req.Frequency)
+		update.Frequency = &frequency
+	}
+
+	schedule, err := h.scheduler.Update(r.Context(), id, update)
+	if err != nil {
+		h.writeScheduleError(w, err)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, h.scheduleToResponse(schedule))
+}
+
+// HandleDelete handles DELETE /api/jobs/schedules/{id}
+func (h *ScheduleHandler) HandleDelete(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodDelete {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only DELETE method is allowed")
+		return
+	}
+
+	if _, ok := h.authorizeSchedule(w, r, id); !ok {
+		return
+	}
+
+	if err := h.scheduler.Delete(r.Context(), id); err != nil {
+		h.writeScheduleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleListRuns handles GET /api/jobs/schedules/{id}/runs
+func (h *ScheduleHandler) HandleListRuns(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	if _, ok := h.authorizeSchedule(w, r, id); !ok {
+		return
+	}
+
+	runs, err := h.scheduler.History(r.Context(), id)
+	if err != nil {
+		h.writeScheduleError(w, err)
+		return
+	}
+
+	resp := dto.ListJobScheduleRunsResponse{
+		ScheduleID: id,
+		Runs:       make([]dto.JobScheduleRunResponse, len(runs)),
+		Total:      len(runs),
+	}
+	for i, run := range runs {
+		resp.Runs[i] = dto.JobScheduleRunResponse{
+			JobID:       run.JobID,
+			Status:      string(run.Status),
+			Error:       run.Error,
+			StartedAt:   run.StartedAt,
+			CompletedAt: run.CompletedAt,
+		}
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleRunNow handles POST /api/jobs/schedules/{id}/run
+func (h *ScheduleHandler) HandleRunNow(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	if _, ok := h.authorizeSchedule(w, r, id); !ok {
+		return
+	}
+
+	job, err := h.scheduler.RunNow(r.Context(), id)
+	if err != nil {
+		h.writeScheduleError(w, err)
+		return
+	}
+
+	h.writeJSON(w, http.StatusAccepted, dto.JobAcceptedResponse{
+		JobID:     job.ID,
+		Status:    string(job.Status),
+		StatusURL: "/api/jobs/" + job.ID,
+		StreamURL: "/api/jobs/" + job.ID + "/stream",
+	})
+}
+
+// authorizeSchedule loads a schedule and verifies the caller owns it.
+// Schedules owned by other users are reported as not found.
+func (h *ScheduleHandler) authorizeSchedule(w http.ResponseWriter, r *http.Request, id string) (*jobs.Schedule, bool) {
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return nil, false
+	}
+
+	schedule, err := h.scheduler.Get(r.Context(), id)
+	if err != nil && !errors.Is(err, jobs.ErrScheduleNotFound) {
+		h.writeScheduleError(w, err)
+		return nil, false
+	}
+	if err != nil || schedule.UserID != userID {
+		h.writeError(w, http.StatusNotFound, "not_found", "Schedule not found")
+		return nil, false
+	}
+
+	return schedule, true
+}
+
+// writeScheduleError maps scheduler errors to HTTP responses
+func (h *ScheduleHandler) writeScheduleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, jobs.ErrScheduleNotFound):
+		h.writeError(w, http.StatusNotFound, "not_found", "Schedule not found")
+	case errors.Is(err, jobs.ErrInvalidFrequency):
+		h.writeError(w, http.StatusBadRequest, "validation_error", "frequency must be one of daily, weekly, monthly, quarterly")
+	case errors.Is(err, jobs.ErrNoRunnerForJobType):
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+	case errors.Is(err, jobs.ErrInvalidScheduleParams):
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+	case errors.Is(err, jobs.ErrScheduleLimitReached):
+		h.writeError(w, http.StatusConflict, "limit_reached", "Schedule limit reached")
+	default:
+		h.writeError(w, http.StatusInternalServerError, "schedule_failed", err.Error())
+	}
+}
+
+// scheduleToResponse converts a schedule to response format
+func (h *ScheduleHandler) scheduleToResponse(schedule *jobs.Schedule) *dto.JobScheduleResponse {
+	return &dto.JobScheduleResponse{
+		ID:                  schedule.ID,
+		Name:                schedule.Name,
+		JobType:             string(schedule.JobType),
+		Frequency:           string(schedule.Frequency),
+		Params:              schedule.Params,
+		Enabled:             schedule.Enabled,
+		NextRunAt:           schedule.NextRunAt,
+		LastRunAt:           schedule.LastRunAt,
+		LastStatus:          string(schedule.LastStatus),
+		LastError:           schedule.LastError,
+		ConsecutiveFailures: schedule.ConsecutiveFailures,
+		CreatedAt:           schedule.CreatedAt,
+		UpdatedAt:           schedule.UpdatedAt,
+	}
+}
+
+// writeJSON writes a JSON response
+func (h *ScheduleHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *ScheduleHandler) writeError(w http.ResponseWriter, status int, errCode string, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
@@ -0,0 +1,245 @@
+package merchants
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"clockzen-next/internal/application/merchants"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// Merchant list defaults and limits
+const (
+	defaultListLimit = 50
+	maxListLimit     = 200
+)
+
+// ErrorResponse represents an error response
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// NormalizeRequest represents a request to preview a raw merchant name's
+// normalization
+type NormalizeRequest struct {
+	MerchantName string `json:"merchant_name"`
+}
+
+// MerchantResponse represents a canonical merchant
+type MerchantResponse struct {
+	ID            string     `json:"id"`
+	Name          string     `json:"name"`
+	NormalizedKey string     `json:"normalized_key"`
+	Category      *string    `json:"category,omitempty"`
+	LogoURL       *string    `json:"logo_url,omitempty"`
+	Website       *string    `json:"website,omitempty"`
+	EnrichedAt    *time.Time `json:"enriched_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// ListMerchantsResponse represents a list of canonical merchants
+type ListMerchantsResponse struct {
+	Merchants []MerchantResponse `json:"merchants"`
+	Total     int                `json:"total"`
+}
+
+// NormalizeResponse represents a raw merchant name's normalization
+type NormalizeResponse struct {
+	MerchantName   string `json:"merchant_name"`
+	NormalizedName string `json:"normalized_name"`
+	NormalizedKey  string `json:"normalized_key"`
+	// Merchant is the canonical merchant the name resolves to, omitted
+	// until a transaction there is linked to one
+	Merchant *MerchantResponse `json:"merchant,omitempty"`
+}
+
+// BackfillResponse represents the result of linking a user's transactions
+// to canonical merchants
+type BackfillResponse struct {
+	Scanned          int `json:"scanned"`
+	Linked           int `json:"linked"`
+	Categorized      int `json:"categorized"`
+	MerchantsCreated int `json:"merchants_created"`
+}
+
+// MerchantHandler handles HTTP requests for canonical merchants
+type MerchantHandler struct {
+	service *merchants.Service
+}
+
+// NewMerchantHandler creates a new MerchantHandler instance
+func NewMerchantHandler(service *merchants.Service) *MerchantHandler {
+	return &MerchantHandler{
+		service: service,
+	}
+}
+
+// HandleList handles GET /api/merchants
+func (h *MerchantHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	limit := defaultListLimit
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > maxListLimit {
+			h.writeError(w, http.StatusBadRequest, "invalid_parameter", "limit must be between 1 and "+strconv.Itoa(maxListLimit))
+			return
+		}
+		limit = parsed
+	}
+
+	records, err := h.service.List(r.Context(), userID, r.URL.Query().Get("q"), limit)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to list merchants: "+err.Error())
+		return
+	}
+
+	resp := ListMerchantsResponse{
+		Merchants: make([]MerchantResponse, len(records)),
+		Total:     len(records),
+	}
+	for i, record := range records {
+		resp.Merchants[i] = merchantToResponse(record)
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleGet handles GET /api/merchants/{id}
+func (h *MerchantHandler) HandleGet(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	record, err := h.service.Get(r.Context(), userID, id)
+	if err != nil {
+		if errors.Is(err, merchants.ErrMerchantNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Merchant not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get merchant: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, merchantToResponse(record))
+}
+
+// HandleNormalize handles POST /api/merchants/normalize
+func (h *MerchantHandler) HandleNormalize(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req NormalizeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+	if req.MerchantName == "" {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "merchant_name is required")
+		return
+	}
+
+	name := merchants.Normalize(req.MerchantName)
+	resp := NormalizeResponse{
+		MerchantName:   req.MerchantName,
+		NormalizedName: name,
+		NormalizedKey:  merchants.Key(name),
+	}
+	record, err := h.service.FindByName(r.Context(), userID, req.MerchantName)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to find merchant: "+err.Error())
+		return
+	}
+	if record != nil {
+		merchant := merchantToResponse(record)
+		resp.Merchant = &merchant
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleBackfill handles POST /api/merchants/backfill
+func (h *MerchantHandler) HandleBackfill(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	result, err := h.service.Backfill(r.Context(), userID)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "backfill_failed", "Failed to link transactions to merchants: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, BackfillResponse{
+		Scanned:          result.Scanned,
+		Linked:           result.Linked,
+		Categorized:      result.Categorized,
+		MerchantsCreated: result.MerchantsCreated,
+	})
+}
+
+// merchantToResponse converts a canonical merchant to its response
+func merchantToResponse(m *ent.Merchant) MerchantResponse {
+	return MerchantResponse{
+		ID:            m.ID,
+		Name:          m.Name,
+		NormalizedKey: m.NormalizedKey,
+		Category:      m.Category,
+		LogoURL:       m.LogoURL,
+		Website:       m.Website,
+		EnrichedAt:    m.EnrichedAt,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
+
+// writeJSON writes a JSON response
+func (h *MerchantHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *MerchantHandler) writeError(w http.ResponseWriter, status int, errCode string, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
@@ -0,0 +1,65 @@
+package merchants
+
+import (
+	"net/http"
+	"strings"
+
+	"clockzen-next/internal/application/merchants"
+)
+
+// Router handles routing for merchant endpoints
+type Router struct {
+	handler *MerchantHandler
+}
+
+// NewRouter creates a new Router with the given handler
+func NewRouter(handler *MerchantHandler) *Router {
+	return &Router{
+		handler: handler,
+	}
+}
+
+// NewDefaultRouter creates a new Router backed by the given merchant service
+func NewDefaultRouter(service *merchants.Service) *Router {
+	return &Router{
+		handler: NewMerchantHandler(service),
+	}
+}
+
+// RegisterRoutes registers all merchant routes with the given mux
+// Total routes: 4 endpoints
+//
+// Merchants are shared by all users: each is the clean name raw merchant
+// strings normalize to, e.g. "Blue Bottle Coffee" for "SQ *BLUE BOTTLE
+// COFFEE 4423 SAN F", with the category it was enriched with. Transactions
+// entered by hand are linked to their merchant as they are recorded; a
+// backfill links the rest of the authenticated user's transactions, and
+// gives uncategorized ones their merchant's category. Spending analyses
+// group by merchant name. Users only see the merchants of their own
+// transactions; others are not found.
+//
+//  1. GET    /api/merchants           - List the user's merchants (with ?q name search and ?limit, default 50)
+//  2. GET    /api/merchants/{id}      - Get a merchant of the user's transactions
+//  3. POST   /api/merchants/normalize - Preview the merchant a raw merchant name normalizes to
+//  4. POST   /api/merchants/backfill  - Link the user's transactions to merchants
+func (r *Router) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/merchants", r.handler.HandleList)
+	mux.HandleFunc("/api/merchants/normalize", r.handler.HandleNormalize)
+	mux.HandleFunc("/api/merchants/backfill", r.handler.HandleBackfill)
+	mux.HandleFunc("/api/merchants/", r.handleMerchantByID)
+}
+
+// handleMerchantByID routes requests for /api/merchants/{id}
+func (r *Router) handleMerchantByID(w http.ResponseWriter, req *http.Request) {
+	id := strings.TrimPrefix(req.URL.Path, "/api/merchants/")
+	if id == "" || strings.Contains(id, "/") {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+	r.handler.HandleGet(w, req, id)
+}
+
+// GetHandler returns the merchant handler
+func (r *Router) GetHandler() *MerchantHandler {
+	return r.handler
+}
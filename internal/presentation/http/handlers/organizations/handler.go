@@ -0,0 +1,332 @@
+package organizations
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"clockzen-next/internal/application/organizations"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/membership"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// ErrorResponse represents an error response
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// OrganizationRequest represents a request to create or rename an
+// organization
+type OrganizationRequest struct {
+	Name string `json:"name"`
+}
+
+// MemberRequest represents a request to add a member or change their role
+type MemberRequest struct {
+	// UserID is only read when adding a member
+	UserID string `json:"user_id,omitempty"`
+	Role   string `json:"role"`
+}
+
+// OrganizationResponse represents an organization and the user's role in it
+type OrganizationResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Role      string    `json:"role"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ListOrganizationsResponse represents the organizations the user belongs to
+type ListOrganizationsResponse struct {
+	Organizations []OrganizationResponse `json:"organizations"`
+	Total         int                    `json:"total"`
+}
+
+// MemberResponse represents a member of an organization
+type MemberResponse struct {
+	UserID    string    `json:"user_id"`
+	Role      string    `json:"role"`
+	InvitedBy *string   `json:"invited_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ListMembersResponse represents the members of an organization
+type ListMembersResponse struct {
+	OrganizationID string           `json:"organization_id"`
+	Members        []MemberResponse `json:"members"`
+	Total          int              `json:"total"`
+}
+
+// OrganizationHandler handles HTTP requests for organizations and their
+// members
+type OrganizationHandler struct {
+	service *organizations.Service
+}
+
+// NewOrganizationHandler creates a new OrganizationHandler instance
+func NewOrganizationHandler(service *organizations.Service) *OrganizationHandler {
+	return &OrganizationHandler{
+		service: service,
+	}
+}
+
+// HandleListOrganizations handles GET /api/organizations
+func (h *OrganizationHandler) HandleListOrganizations(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	memberships, err := h.service.List(r.Context(), userID)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to list organizations: "+err.Error())
+		return
+	}
+
+	resp := ListOrganizationsResponse{
+		Organizations: make([]OrganizationResponse, len(memberships)),
+		Total:         len(memberships),
+	}
+	for i, m := range memberships {
+		resp.Organizations[i] = organizationToResponse(m.Edges.Organization, m.Role)
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleCreateOrganization handles POST /api/organizations
+func (h *OrganizationHandler) HandleCreateOrganization(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req OrganizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	owner, err := h.service.Create(r.Context(), userID, req.Name)
+	if err != nil {
+		h.writeServiceError(w, err, "Failed to create organization")
+		return
+	}
+	h.writeJSON(w, http.StatusCreated, organizationToResponse(owner.Edges.Organization, owner.Role))
+}
+
+// HandleGetOrganization handles GET /api/organizations/{id}
+func (h *OrganizationHandler) HandleGetOrganization(w http.ResponseWriter, r *http.Request, organizationID string) {
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	member, err := h.service.Get(r.Context(), userID, organizationID)
+	if err != nil {
+		h.writeServiceError(w, err, "Failed to get organization")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, organizationToResponse(member.Edges.Organization, member.Role))
+}
+
+// HandleUpdateOrganization handles PATCH /api/organizations/{id}
+func (h *OrganizationHandler) HandleUpdateOrganization(w http.ResponseWriter, r *http.Request, organizationID string) {
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req OrganizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	if _, err := h.service.Rename(r.Context(), userID, organizationID, req.Name); err != nil {
+		h.writeServiceError(w, err, "Failed to update organization")
+		return
+	}
+	member, err := h.service.Get(r.Context(), userID, organizationID)
+	if err != nil {
+		h.writeServiceError(w, err, "Failed to get organization")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, organizationToResponse(member.Edges.Organization, member.Role))
+}
+
+// HandleDeleteOrganization handles DELETE /api/organizations/{id}
+func (h *OrganizationHandler) HandleDeleteOrganization(w http.ResponseWriter, r *http.Request, organizationID string) {
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), userID, organizationID); err != nil {
+		h.writeServiceError(w, err, "Failed to delete organization")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleListMembers handles GET /api/organizations/{id}/members
+func (h *OrganizationHandler) HandleListMembers(w http.ResponseWriter, r *http.Request, organizationID string) {
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	members, err := h.service.ListMembers(r.Context(), userID, organizationID)
+	if err != nil {
+		h.writeServiceError(w, err, "Failed to list members")
+		return
+	}
+
+	resp := ListMembersResponse{
+		OrganizationID: organizationID,
+		Members:        make([]MemberResponse, len(members)),
+		Total:          len(members),
+	}
+	for i, m := range members {
+		resp.Members[i] = memberToResponse(m)
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleAddMember handles POST /api/organizations/{id}/members
+func (h *OrganizationHandler) HandleAddMember(w http.ResponseWriter, r *http.Request, organizationID string) {
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req MemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+	role := organizations.Role(req.Role)
+	if role == "" {
+		role = organizations.RoleMember
+	}
+
+	member, err := h.service.AddMember(r.Context(), userID, organizationID, req.UserID, role)
+	if err != nil {
+		h.writeServiceError(w, err, "Failed to add member")
+		return
+	}
+	h.writeJSON(w, http.StatusCreated, memberToResponse(member))
+}
+
+// HandleUpdateMember handles PATCH /api/organizations/{id}/members/{user_id}
+func (h *OrganizationHandler) HandleUpdateMember(w http.ResponseWriter, r *http.Request, organizationID, memberID string) {
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req MemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	member, err := h.service.UpdateMemberRole(r.Context(), userID, organizationID, memberID, organizations.Role(req.Role))
+	if err != nil {
+		h.writeServiceError(w, err, "Failed to update member")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, memberToResponse(member))
+}
+
+// HandleRemoveMember handles DELETE /api/organizations/{id}/members/{user_id}
+func (h *OrganizationHandler) HandleRemoveMember(w http.ResponseWriter, r *http.Request, organizationID, memberID string) {
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.RemoveMember(r.Context(), userID, organizationID, memberID); err != nil {
+		h.writeServiceError(w, err, "Failed to remove member")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// requireUserID returns the authenticated user ID or writes 401.
+// Organizations are managed by users, whichever organization the request
+// acts in.
+func (h *OrganizationHandler) requireUserID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return "", false
+	}
+	return userID, true
+}
+
+// writeServiceError writes the response for an error from the organization
+// service
+func (h *OrganizationHandler) writeServiceError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, organizations.ErrNotFound):
+		h.writeError(w, http.StatusNotFound, "not_found", "Organization not found")
+	case errors.Is(err, organizations.ErrMemberNotFound):
+		h.writeError(w, http.StatusNotFound, "not_found", "Member not found")
+	case errors.Is(err, organizations.ErrForbidden):
+		h.writeError(w, http.StatusForbidden, "forbidden", err.Error())
+	case errors.Is(err, organizations.ErrAlreadyMember), errors.Is(err, organizations.ErrLastOwner):
+		h.writeError(w, http.StatusConflict, "conflict", err.Error())
+	case errors.Is(err, organizations.ErrInvalidName),
+		errors.Is(err, organizations.ErrNameTooLong),
+		errors.Is(err, organizations.ErrInvalidUserID),
+		errors.Is(err, organizations.ErrInvalidRole):
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+	default:
+		h.writeError(w, http.StatusInternalServerError, "internal_error", message+": "+err.Error())
+	}
+}
+
+// organizationToResponse converts an organization and the user's role in it
+// to its response
+func organizationToResponse(org *ent.Organization, role membership.Role) OrganizationResponse {
+	return OrganizationResponse{
+		ID:        org.ID,
+		Name:      org.Name,
+		Role:      role.String(),
+		CreatedBy: org.CreatedBy,
+		CreatedAt: org.CreatedAt,
+		UpdatedAt: org.UpdatedAt,
+	}
+}
+
+// memberToResponse converts a membership to its response
+func memberToResponse(m *ent.Membership) MemberResponse {
+	return MemberResponse{
+		UserID:    m.UserID,
+		Role:      m.Role.String(),
+		InvitedBy: m.InvitedBy,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+// writeJSON writes a JSON response
+func (h *OrganizationHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *OrganizationHandler) writeError(w http.ResponseWriter, status int, errCode string, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
@@ -0,0 +1,112 @@
+package organizations
+
+import (
+	"net/http"
+	"strings"
+
+	"clockzen-next/internal/application/organizations"
+	"clockzen-next/internal/ent"
+)
+
+// Router handles routing for organization endpoints
+type Router struct {
+	handler *OrganizationHandler
+}
+
+// NewRouter creates a new Router with the given handler
+func NewRouter(handler *OrganizationHandler) *Router {
+	return &Router{
+		handler: handler,
+	}
+}
+
+// NewDefaultRouter creates a new Router backed by the given ent client
+func NewDefaultRouter(entClient *ent.Client) *Router {
+	return &Router{
+		handler: NewOrganizationHandler(organizations.NewService(entClient)),
+	}
+}
+
+// GetOrganizationHandler returns the organization handler
+func (r *Router) GetOrganizationHandler() *OrganizationHandler {
+	return r.handler
+}
+
+// RegisterRoutes registers all organization routes with the given mux
+// Total routes: 9 endpoints
+//
+// Any member can read an organization and its members. Owners and admins
+// rename it and manage members with roles up to their own; only owners
+// delete it. Members can remove themselves, but the last owner can't leave.
+//
+//  1. GET    /api/organizations                             - Organizations the user belongs to
+//  2. POST   /api/organizations                             - Create an organization, owned by the user
+//  3. GET    /api/organizations/{id}                        - Get an organization
+//  4. PATCH  /api/organizations/{id}                        - Rename an organization
+//  5. DELETE /api/organizations/{id}                        - Delete an organization
+//  6. GET    /api/organizations/{id}/members                - List members
+//  7. POST   /api/organizations/{id}/members                - Add a member
+//  8. PATCH  /api/organizations/{id}/members/{user_id}      - Change a member's role
+//  9. DELETE /api/organizations/{id}/members/{user_id}      - Remove a member
+func (r *Router) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/organizations", r.handleOrganizations)
+	mux.HandleFunc("/api/organizations/", r.handleOrganizationByPath)
+}
+
+// handleOrganizations routes requests for /api/organizations
+func (r *Router) handleOrganizations(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleListOrganizations(w, req)
+	case http.MethodPost:
+		r.handler.HandleCreateOrganization(w, req)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleOrganizationByPath routes requests for /api/organizations/{id} and
+// its members
+func (r *Router) handleOrganizationByPath(w http.ResponseWriter, req *http.Request) {
+	path := strings.Trim(strings.TrimPrefix(req.URL.Path, "/api/organizations/"), "/")
+	parts := strings.Split(path, "/")
+	if parts[0] == "" {
+		r.handleOrganizations(w, req)
+		return
+	}
+	organizationID := parts[0]
+
+	switch {
+	case len(parts) == 1:
+		switch req.Method {
+		case http.MethodGet:
+			r.handler.HandleGetOrganization(w, req, organizationID)
+		case http.MethodPatch:
+			r.handler.HandleUpdateOrganization(w, req, organizationID)
+		case http.MethodDelete:
+			r.handler.HandleDeleteOrganization(w, req, organizationID)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	case len(parts) == 2 && parts[1] == "members":
+		switch req.Method {
+		case http.MethodGet:
+			r.handler.HandleListMembers(w, req, organizationID)
+		case http.MethodPost:
+			r.handler.HandleAddMember(w, req, organizationID)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	case len(parts) == 3 && parts[1] == "members" && parts[2] != "":
+		switch req.Method {
+		case http.MethodPatch:
+			r.handler.HandleUpdateMember(w, req, organizationID, parts[2])
+		case http.MethodDelete:
+			r.handler.HandleRemoveMember(w, req, organizationID, parts[2])
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	default:
+		http.Error(w, "Not found", http.StatusNotFound)
+	}
+}
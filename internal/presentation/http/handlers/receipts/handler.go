@@ -0,0 +1,329 @@
+package receipts
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"clockzen-next/internal/application/receipts"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// ErrorResponse represents an error response
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// OCRResponse represents the OCR text of a receipt and the regions of the
+// receipt image its fields were read from
+type OCRResponse struct {
+	ReceiptID     string              `json:"receipt_id"`
+	FileName      string              `json:"file_name"`
+	MimeType      string              `json:"mime_type"`
+	OCRCompleted  bool                `json:"ocr_completed"`
+	OCRText       *string             `json:"ocr_text,omitempty"`
+	OCRConfidence *float64            `json:"ocr_confidence,omitempty"`
+	Fields        []receipts.OCRField `json:"fields"`
+}
+
+// FeedbackRequest represents a user's selection of where a field is on a
+// receipt image, and optionally the value they read there
+type FeedbackRequest struct {
+	Field string               `json:"field"`
+	Value string               `json:"value,omitempty"`
+	Box   receipts.BoundingBox `json:"box"`
+}
+
+// FeedbackResponse represents a recorded OCR correction
+type FeedbackResponse struct {
+	ID             string          `json:"id"`
+	ReceiptID      string          `json:"receipt_id"`
+	Field          string          `json:"field"`
+	Value          *string         `json:"value,omitempty"`
+	Box            json.RawMessage `json:"box"`
+	PredictedValue *string         `json:"predicted_value,omitempty"`
+	PredictedBox   json.RawMessage `json:"predicted_box,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// ListFeedbackResponse represents the corrections recorded for a receipt
+type ListFeedbackResponse struct {
+	Feedback []FeedbackResponse `json:"feedback"`
+	Total    int                `json:"total"`
+}
+
+// ProvenanceResponse represents the trail of pipeline stages a receipt went
+// through
+type ProvenanceResponse struct {
+	ReceiptID  string                    `json:"receipt_id"`
+	SourceType string                    `json:"source_type"`
+	Status     string                    `json:"status"`
+	Steps      []receipts.ProvenanceStep `json:"steps"`
+}
+
+// RecordEventRequest represents a pipeline component reporting a stage it
+// ran on a receipt
+type RecordEventRequest struct {
+	Stage      string                 `json:"stage"`
+	Status     string                 `json:"status,omitempty"`
+	Version    string                 `json:"version,omitempty"`
+	Detail     string                 `json:"detail,omitempty"`
+	Data       map[string]interface{} `json:"data,omitempty"`
+	OccurredAt *time.Time             `json:"occurred_at,omitempty"`
+}
+
+// ReceiptEventResponse represents a recorded pipeline stage
+type ReceiptEventResponse struct {
+	ID         string                 `json:"id"`
+	ReceiptID  string                 `json:"receipt_id"`
+	Stage      string                 `json:"stage"`
+	Status     string                 `json:"status"`
+	Version    *string                `json:"version,omitempty"`
+	Detail     *string                `json:"detail,omitempty"`
+	Data       map[string]interface{} `json:"data,omitempty"`
+	OccurredAt time.Time              `json:"occurred_at"`
+	CreatedAt  time.Time              `json:"created_at"`
+}
+
+// ReceiptHandler handles HTTP requests for receipts' OCR results and
+// provenance
+type ReceiptHandler struct {
+	service *receipts.Service
+}
+
+// NewReceiptHandler creates a new ReceiptHandler instance
+func NewReceiptHandler(service *receipts.Service) *ReceiptHandler {
+	return &ReceiptHandler{
+		service: service,
+	}
+}
+
+// HandleGetOCR handles GET /api/receipts/{id}/ocr
+func (h *ReceiptHandler) HandleGetOCR(w http.ResponseWriter, r *http.Request, receiptID string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	result, err := h.service.GetOCR(r.Context(), userID, receiptID)
+	if err != nil {
+		if errors.Is(err, receipts.ErrReceiptNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Receipt not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get receipt OCR: "+err.Error())
+		return
+	}
+
+	fields := result.Fields
+	if fields == nil {
+		fields = []receipts.OCRField{}
+	}
+	h.writeJSON(w, http.StatusOK, OCRResponse{
+		ReceiptID:     result.ReceiptID,
+		FileName:      result.FileName,
+		MimeType:      result.MimeType,
+		OCRCompleted:  result.Completed,
+		OCRText:       result.Text,
+		OCRConfidence: result.Confidence,
+		Fields:        fields,
+	})
+}
+
+// HandleListFeedback handles GET /api/receipts/{id}/ocr/feedback
+func (h *ReceiptHandler) HandleListFeedback(w http.ResponseWriter, r *http.Request, receiptID string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	records, err := h.service.ListFeedback(r.Context(), userID, receiptID)
+	if err != nil {
+		if errors.Is(err, receipts.ErrReceiptNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Receipt not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to list feedback: "+err.Error())
+		return
+	}
+
+	resp := ListFeedbackResponse{
+		Feedback: make([]FeedbackResponse, len(records)),
+		Total:    len(records),
+	}
+	for i, record := range records {
+		resp.Feedback[i] = feedbackToResponse(record)
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleSubmitFeedback handles POST /api/receipts/{id}/ocr/feedback
+func (h *ReceiptHandler) HandleSubmitFeedback(w http.ResponseWriter, r *http.Request, receiptID string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req FeedbackRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	record, err := h.service.SubmitCorrection(r.Context(), userID, receiptID, receipts.Correction{
+		Field: req.Field,
+		Value: req.Value,
+		Box:   req.Box,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, receipts.ErrReceiptNotFound):
+			h.writeError(w, http.StatusNotFound, "not_found", "Receipt not found")
+		case errors.Is(err, receipts.ErrUnknownField),
+			errors.Is(err, receipts.ErrInvalidBox),
+			errors.Is(err, receipts.ErrInvalidValue):
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		default:
+			h.writeError(w, http.StatusInternalServerError, "create_failed", "Failed to record feedback: "+err.Error())
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, feedbackToResponse(record))
+}
+
+// HandleGetProvenance handles GET /api/receipts/{id}/provenance
+func (h *ReceiptHandler) HandleGetProvenance(w http.ResponseWriter, r *http.Request, receiptID string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	provenance, err := h.service.GetProvenance(r.Context(), userID, receiptID)
+	if err != nil {
+		if errors.Is(err, receipts.ErrReceiptNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Receipt not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get receipt provenance: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, ProvenanceResponse{
+		ReceiptID:  provenance.ReceiptID,
+		SourceType: provenance.SourceType,
+		Status:     provenance.Status,
+		Steps:      provenance.Steps,
+	})
+}
+
+// HandleRecordEvent handles POST /api/receipts/{id}/provenance
+func (h *ReceiptHandler) HandleRecordEvent(w http.ResponseWriter, r *http.Request, receiptID string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req RecordEventRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	event := receipts.ProvenanceEvent{
+		Stage:   req.Stage,
+		Status:  req.Status,
+		Version: req.Version,
+		Detail:  req.Detail,
+		Data:    req.Data,
+	}
+	if req.OccurredAt != nil {
+		event.OccurredAt = *req.OccurredAt
+	}
+	record, err := h.service.RecordEvent(r.Context(), userID, receiptID, event)
+	if err != nil {
+		switch {
+		case errors.Is(err, receipts.ErrReceiptNotFound):
+			h.writeError(w, http.StatusNotFound, "not_found", "Receipt not found")
+		case errors.Is(err, receipts.ErrInvalidEvent):
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		default:
+			h.writeError(w, http.StatusInternalServerError, "create_failed", "Failed to record event: "+err.Error())
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, ReceiptEventResponse{
+		ID:         record.ID,
+		ReceiptID:  record.ReceiptID,
+		Stage:      string(record.Stage),
+		Status:     string(record.Status),
+		Version:    record.Version,
+		Detail:     record.Detail,
+		Data:       record.Data,
+		OccurredAt: record.OccurredAt,
+		CreatedAt:  record.CreatedAt,
+	})
+}
+
+// feedbackToResponse converts a recorded OCR correction to its response
+func feedbackToResponse(f *ent.OCRFeedback) FeedbackResponse {
+	return FeedbackResponse{
+		ID:             f.ID,
+		ReceiptID:      f.ReceiptID,
+		Field:          f.Field,
+		Value:          f.Value,
+		Box:            f.Box,
+		PredictedValue: f.PredictedValue,
+		PredictedBox:   f.PredictedBox,
+		CreatedAt:      f.CreatedAt,
+	}
+}
+
+// writeJSON writes a JSON response
+func (h *ReceiptHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *ReceiptHandler) writeError(w http.ResponseWriter, status int, errCode string, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
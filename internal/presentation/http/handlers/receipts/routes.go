@@ -0,0 +1,109 @@
+package receipts
+
+import (
+	"net/http"
+	"strings"
+
+	"clockzen-next/internal/application/receipts"
+	"clockzen-next/internal/ent"
+)
+
+// Router handles routing for receipt endpoints
+type Router struct {
+	handler *ReceiptHandler
+}
+
+// NewRouter creates a new Router with the given handler
+func NewRouter(handler *ReceiptHandler) *Router {
+	return &Router{
+		handler: handler,
+	}
+}
+
+// NewDefaultRouter creates a new Router backed by the given ent client
+func NewDefaultRouter(entClient *ent.Client) *Router {
+	return &Router{
+		handler: NewReceiptHandler(receipts.NewService(entClient)),
+	}
+}
+
+// RegisterRoutes registers all receipt routes with the given mux
+// Total routes: 5 endpoints
+//
+// Receipts belong to the authenticated user. A receipt's OCR result has
+// its text and the fields read from it (merchant_name, merchant_address,
+// receipt_date, total_amount, tax_amount, subtotal_amount, payment_method,
+// receipt_number), each with the bounding box of the image region it was
+// read from for a viewer to highlight. Boxes are {page, x, y, width,
+// height}: page counts from 0, and the rest are fractions (0-1) of the
+// page's width or height, measured from its top-left corner.
+//
+// Feedback is a user's selection of where a field really is, optionally
+// with the value they read there. It is recorded, with what was extracted
+// before, as training data for field extraction, and replaces the field's
+// region, and its value if one was given, on the receipt.
+//
+// A receipt's provenance is the trail of pipeline stages it went through,
+// in order: fetched, downloaded, ocr, parsed, matched and categorized.
+// Pipeline components post each stage they run, with their version and
+// whether it succeeded or failed. Stages with nothing posted are inferred
+// from the receipt, its source email and its transactions (recorded is
+// false, and there is no version), or are pending, or skipped when they
+// don't apply to the receipt's source.
+//
+//  1. GET    /api/receipts/{id}/ocr          - Get a receipt's OCR text and field bounding boxes
+//  2. GET    /api/receipts/{id}/ocr/feedback - List a receipt's OCR corrections, the latest first
+//  3. POST   /api/receipts/{id}/ocr/feedback - Correct a field's bounding box (and value)
+//  4. GET    /api/receipts/{id}/provenance   - Get a receipt's pipeline provenance trail
+//  5. POST   /api/receipts/{id}/provenance   - Record a pipeline stage run on a receipt
+func (r *Router) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/receipts/", r.handleReceiptByPath)
+}
+
+// handleReceiptByPath routes requests for /api/receipts/{id}/ocr[/feedback]
+// and /api/receipts/{id}/provenance
+func (r *Router) handleReceiptByPath(w http.ResponseWriter, req *http.Request) {
+	path := strings.TrimPrefix(req.URL.Path, "/api/receipts/")
+	parts := strings.Split(path, "/")
+	if parts[0] == "" || len(parts) < 2 {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+
+	if parts[1] == "provenance" && len(parts) == 2 {
+		switch req.Method {
+		case http.MethodGet:
+			r.handler.HandleGetProvenance(w, req, parts[0])
+		case http.MethodPost:
+			r.handler.HandleRecordEvent(w, req, parts[0])
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+		return
+	}
+	if parts[1] != "ocr" {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+
+	switch {
+	case len(parts) == 2:
+		r.handler.HandleGetOCR(w, req, parts[0])
+	case len(parts) == 3 && parts[2] == "feedback":
+		switch req.Method {
+		case http.MethodGet:
+			r.handler.HandleListFeedback(w, req, parts[0])
+		case http.MethodPost:
+			r.handler.HandleSubmitFeedback(w, req, parts[0])
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	default:
+		http.Error(w, "Not found", http.StatusNotFound)
+	}
+}
+
+// GetHandler returns the receipt handler
+func (r *Router) GetHandler() *ReceiptHandler {
+	return r.handler
+}
@@ -0,0 +1,105 @@
+package reports
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"clockzen-next/internal/application/reports"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// Report formats
+const (
+	FormatJSON = "json"
+	FormatHTML = "html"
+	FormatPDF  = "pdf"
+)
+
+// ErrorResponse represents an error response
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// ReportHandler handles HTTP requests for spending reports
+type ReportHandler struct {
+	service *reports.Service
+}
+
+// NewReportHandler creates a new ReportHandler instance
+func NewReportHandler(service *reports.Service) *ReportHandler {
+	return &ReportHandler{
+		service: service,
+	}
+}
+
+// HandleMonthlyReport handles GET /api/reports/monthly
+func (h *ReportHandler) HandleMonthlyReport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	query := r.URL.Query()
+	format := query.Get("format")
+	if format == "" {
+		format = FormatJSON
+	}
+	if format != FormatJSON && format != FormatHTML && format != FormatPDF {
+		h.writeError(w, http.StatusBadRequest, "invalid_parameter", "format must be json, html or pdf")
+		return
+	}
+	month, err := reports.ParseMonth(query.Get("month"), time.Now())
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_parameter", err.Error())
+		return
+	}
+
+	report, err := h.service.Generate(r.Context(), userID, month)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "report_failed", "Failed to generate report: "+err.Error())
+		return
+	}
+
+	switch format {
+	case FormatHTML:
+		body, err := reports.RenderHTML(report)
+		if err != nil {
+			h.writeError(w, http.StatusInternalServerError, "report_failed", "Failed to render report: "+err.Error())
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		w.Write(body)
+	case FormatPDF:
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "spending-report-"+report.Month+".pdf"))
+		w.WriteHeader(http.StatusOK)
+		w.Write(reports.RenderPDF(report))
+	default:
+		h.writeJSON(w, http.StatusOK, report)
+	}
+}
+
+// writeJSON writes a JSON response
+func (h *ReportHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *ReportHandler) writeError(w http.ResponseWriter, status int, errCode string, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
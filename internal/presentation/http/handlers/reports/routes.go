@@ -0,0 +1,51 @@
+package reports
+
+import (
+	"net/http"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/application/reports"
+	"clockzen-next/internal/ent"
+)
+
+// Router handles routing for report endpoints
+type Router struct {
+	handler *ReportHandler
+}
+
+// NewRouter creates a new Router with the given handler
+func NewRouter(handler *ReportHandler) *Router {
+	return &Router{
+		handler: handler,
+	}
+}
+
+// NewDefaultRouter creates a new Router backed by the given ent client,
+// reporting on the transactions in transactions
+func NewDefaultRouter(entClient *ent.Client, transactions analysis.TransactionRepository) *Router {
+	return &Router{
+		handler: NewReportHandler(reports.NewService(entClient, transactions)),
+	}
+}
+
+// RegisterRoutes registers all report routes with the given mux
+// Total routes: 1 endpoints
+//
+// The monthly report covers the authenticated user's spending in a month
+// (?month=YYYY-MM, the last complete month by default): the breakdown by
+// category against the month before, the trend over the last 6 months,
+// anomalies, spending against the budget_limits in their alert preferences
+// and the top merchants. ?format is json (the default), html or pdf; pdf is
+// sent as an attachment. Unless monthly_report is turned off in their alert
+// preferences, the worker emails each user the report for the month just
+// ended, as HTML with the PDF attached.
+//
+//  1. GET    /api/reports/monthly    - Generate a monthly spending report (with ?month, ?format)
+func (r *Router) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/reports/monthly", r.handler.HandleMonthlyReport)
+}
+
+// GetHandler returns the report handler
+func (r *Router) GetHandler() *ReportHandler {
+	return r.handler
+}
@@ -0,0 +1,264 @@
+package retirement
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"clockzen-next/internal/application/dto"
+)
+
+// maxAnnotationLength is the longest note accepted, in characters
+const maxAnnotationLength = 2000
+
+// HandleAnnotations handles GET/POST /api/retirement/cashflow/{id}/annotations.
+// GET lists the analysis's notes, those on assumptions first and then by
+// age; POST adds one.
+func (h *CashFlowHandler) HandleAnnotations(w http.ResponseWriter, r *http.Request, id string) {
+	switch r.Method {
+	case http.MethodGet:
+		annotations, exists := h.annotations(id)
+		if !exists {
+			h.writeError(w, http.StatusNotFound, "not_found", "Cash flow analysis not found")
+			return
+		}
+		h.writeJSON(w, http.StatusOK, annotations)
+	case http.MethodPost:
+		h.createAnnotation(w, r, id)
+	default:
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET and POST methods are allowed")
+	}
+}
+
+// createAnnotation attaches a note to a year or an assumption of the
+// analysis
+func (h *CashFlowHandler) createAnnotation(w http.ResponseWriter, r *http.Request, id string) {
+	var req dto.CashFlowAnnotationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+	req.Note = strings.TrimSpace(req.Note)
+	req.Author = strings.TrimSpace(req.Author)
+	if err := validateAnnotationNote(req.Note); err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	analysis, exists := h.analyses[id]
+	if !exists {
+		h.writeError(w, http.StatusNotFound, "not_found", "Cash flow analysis not found")
+		return
+	}
+	if err := validateAnnotationTarget(&req, &analysis.Config); err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	now := time.Now()
+	annotation := &dto.CashFlowAnnotationResponse{
+		ID:         uuid.New().String(),
+		Age:        req.Age,
+		Assumption: req.Assumption,
+		Note:       req.Note,
+		Author:     req.Author,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	analysis.Annotations = append(analysis.Annotations, annotation)
+
+	h.writeJSON(w, http.StatusCreated, annotation)
+}
+
+// HandleAnnotationByID handles PUT/PATCH/DELETE
+// /api/retirement/cashflow/{id}/annotations/{annotationID}
+func (h *CashFlowHandler) HandleAnnotationByID(w http.ResponseWriter, r *http.Request, id, annotationID string) {
+	switch r.Method {
+	case http.MethodPut, http.MethodPatch:
+		h.updateAnnotation(w, r, id, annotationID)
+	case http.MethodDelete:
+		h.deleteAnnotation(w, id, annotationID)
+	default:
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only PUT/PATCH/DELETE methods are allowed")
+	}
+}
+
+// updateAnnotation changes a note's text or author
+func (h *CashFlowHandler) updateAnnotation(w http.ResponseWriter, r *http.Request, id, annotationID string) {
+	var req dto.UpdateCashFlowAnnotationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+	if req.Note != nil {
+		note := strings.TrimSpace(*req.Note)
+		if err := validateAnnotationNote(note); err != nil {
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
+		req.Note = &note
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	analysis, exists := h.analyses[id]
+	if !exists {
+		h.writeError(w, http.StatusNotFound, "not_found", "Cash flow analysis not found")
+		return
+	}
+	i := annotationIndex(analysis.Annotations, annotationID)
+	if i < 0 {
+		h.writeError(w, http.StatusNotFound, "not_found", "Annotation not found")
+		return
+	}
+
+	// Replace rather than modify it, as lists already handed out share it
+	annotation := *analysis.Annotations[i]
+	if req.Note != nil {
+		annotation.Note = *req.Note
+	}
+	if req.Author != nil {
+		annotation.Author = strings.TrimSpace(*req.Author)
+	}
+	annotation.UpdatedAt = time.Now()
+	analysis.Annotations[i] = &annotation
+
+	h.writeJSON(w, http.StatusOK, &annotation)
+}
+
+// deleteAnnotation removes a note
+func (h *CashFlowHandler) deleteAnnotation(w http.ResponseWriter, id, annotationID string) {
+	h.mu.Lock()
+	analysis, exists := h.analyses[id]
+	i := -1
+	if exists {
+		i = annotationIndex(analysis.Annotations, annotationID)
+	}
+	if i >= 0 {
+		analysis.Annotations = append(analysis.Annotations[:i:i], analysis.Annotations[i+1:]...)
+	}
+	h.mu.Unlock()
+
+	switch {
+	case !exists:
+		h.writeError(w, http.StatusNotFound, "not_found", "Cash flow analysis not found")
+	case i < 0:
+		h.writeError(w, http.StatusNotFound, "not_found", "Annotation not found")
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// annotations returns a copy of an analysis's notes, those on assumptions
+// first and then by age, and whether the analysis exists
+func (h *CashFlowHandler) annotations(id string) ([]dto.CashFlowAnnotationResponse, bool) {
+	h.mu.RLock()
+	analysis, exists := h.analyses[id]
+	annotations := []dto.CashFlowAnnotationResponse{}
+	if exists {
+		for _, annotation := range analysis.Annotations {
+			annotations = append(annotations, *annotation)
+		}
+	}
+	h.mu.RUnlock()
+
+	sort.SliceStable(annotations, func(i, j int) bool {
+		return annotations[i].Age < annotations[j].Age
+	})
+	return annotations, exists
+}
+
+// annotationsForAges returns the notes on the years from fromAge through
+// toAge
+func annotationsForAges(annotations []dto.CashFlowAnnotationResponse, fromAge, toAge int) []dto.CashFlowAnnotationResponse {
+	var matching []dto.CashFlowAnnotationResponse
+	for _, annotation := range annotations {
+		if annotation.Age > 0 && annotation.Age >= fromAge && annotation.Age <= toAge {
+			matching = append(matching, annotation)
+		}
+	}
+	return matching
+}
+
+// assumptionAnnotations returns the notes on assumptions
+func assumptionAnnotations(annotations []dto.CashFlowAnnotationResponse) []dto.CashFlowAnnotationResponse {
+	var matching []dto.CashFlowAnnotationResponse
+	for _, annotation := range annotations {
+		if annotation.Assumption != "" {
+			matching = append(matching, annotation)
+		}
+	}
+	return matching
+}
+
+// annotationIndex returns the position of the note with annotationID, or -1
+func annotationIndex(annotations []*dto.CashFlowAnnotationResponse, annotationID string) int {
+	for i, annotation := range annotations {
+		if annotation.ID == annotationID {
+			return i
+		}
+	}
+	return -1
+}
+
+// validateAnnotationNote checks a note's text
+func validateAnnotationNote(note string) error {
+	if note == "" {
+		return errors.New("note is required")
+	}
+	if len([]rune(note)) > maxAnnotationLength {
+		return fmt.Errorf("note must be at most %d characters", maxAnnotationLength)
+	}
+	return nil
+}
+
+// validateAnnotationTarget checks a note is attached to exactly one of a
+// year in the analysis or one of its assumptions
+func validateAnnotationTarget(req *dto.CashFlowAnnotationRequest, config *CashFlowAnalysisConfig) error {
+	if (req.Age == 0) == (req.Assumption == "") {
+		return errors.New("set one of age or assumption")
+	}
+	if req.Assumption != "" {
+		if !isConfigField(req.Assumption) {
+			return fmt.Errorf("unknown assumption %q", req.Assumption)
+		}
+		return nil
+	}
+	if req.Age < config.CurrentAge || req.Age > config.LifeExpectancy {
+		return fmt.Errorf("age must be between %d and %d", config.CurrentAge, config.LifeExpectancy)
+	}
+	return nil
+}
+
+// isConfigField reports whether name is the JSON name of a field of the
+// analysis config
+func isConfigField(name string) bool {
+	configType := reflect.TypeOf(CashFlowAnalysisConfig{})
+	for i := range configType.NumField() {
+		tag, _, _ := strings.Cut(configType.Field(i).Tag.Get("json"), ",")
+		if tag == name && tag != "-" {
+			return true
+		}
+	}
+	return false
+}
+
+// sankeyNotes returns the text of annotations, as exported with a period
+func sankeyNotes(annotations []dto.CashFlowAnnotationResponse) []string {
+	var notes []string
+	for _, annotation := range annotations {
+		notes = append(notes, annotation.Note)
+	}
+	return notes
+}
@@ -1,15 +1,20 @@
 package retirement
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 
 	"clockzen-next/internal/application/dto"
+	"clockzen-next/internal/application/jobs"
 	appRetirement "clockzen-next/internal/application/retirement"
+	"clockzen-next/internal/presentation/http/middleware"
 )
 
 // Backtest represents a stored Monte Carlo backtest
@@ -53,6 +58,7 @@ type BacktestConfig struct {
 type BacktestHandler struct {
 	mu        sync.RWMutex
 	backtests map[string]*Backtest
+	jobs      *jobs.Service
 }
 
 // NewBacktestHandler creates a new BacktestHandler instance
@@ -62,6 +68,13 @@ func NewBacktestHandler() *BacktestHandler {
 	}
 }
 
+// SetJobService enables ?async=true runs on the given job service
+func (h *BacktestHandler) SetJobService(service *jobs.Service) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.jobs = service
+}
+
 // CreateBacktestRequest represents a request to create a backtest
 type CreateBacktestRequest struct {
 	PlanID string         `json:"plan_id"`
@@ -289,6 +302,18 @@ func (h *BacktestHandler) HandleRun(w http.ResponseWriter, r *http.Request, id s
 		return
 	}
 
+	if isAsyncRequest(r) {
+		config := backtest.Config
+		h.mu.Unlock()
+
+		job, ok := h.submitBacktestJob(w, r, backtest, &config)
+		if !ok {
+			return
+		}
+		h.writeJSON(w, http.StatusAccepted, newJobAcceptedResponse(job))
+		return
+	}
+
 	backtest.Status = "running"
 	h.mu.Unlock()
 
@@ -329,26 +354,43 @@ func (h *BacktestHandler) HandleRunForPlan(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	// Run the Monte Carlo simulation
-	results, err := h.runBacktest(&config)
-	if err != nil {
-		h.writeError(w, http.StatusInternalServerError, "simulation_failed", err.Error())
-		return
-	}
-
-	// Store the backtest
 	now := time.Now()
 	backtest := &Backtest{
 		ID:        uuid.New().String(),
 		PlanID:    planID,
 		Name:      "Monte Carlo Backtest " + now.Format("2006-01-02 15:04:05"),
 		Config:    config,
-		Results:   results,
-		Status:    "completed",
+		Status:    "pending",
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
 
+	if isAsyncRequest(r) {
+		job, ok := h.submitBacktestJob(w, r, backtest, &config)
+		if !ok {
+			return
+		}
+
+		// Store the backtest so it can be fetched once the job finishes
+		h.mu.Lock()
+		h.backtests[backtest.ID] = backtest
+		h.mu.Unlock()
+
+		h.writeJSON(w, http.StatusAccepted, newJobAcceptedResponse(job))
+		return
+	}
+
+	// Run the Monte Carlo simulation
+	results, err := h.runBacktest(&config)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "simulation_failed", err.Error())
+		return
+	}
+
+	// Store the backtest
+	backtest.Results = results
+	backtest.Status = "completed"
+
 	h.mu.Lock()
 	h.backtests[backtest.ID] = backtest
 	h.mu.Unlock()
@@ -356,6 +398,189 @@ func (h *BacktestHandler) HandleRunForPlan(w http.ResponseWriter, r *http.Reques
 	h.writeJSON(w, http.StatusOK, backtest)
 }
 
+// monteCarloJobParams are the parameters of a Monte Carlo job. The worker
+// has no copy of the in-memory backtest, so the job carries it.
+type monteCarloJobParams struct {
+	Backtest Backtest `json:"backtest"`
+}
+
+// submitBacktestJob queues a backtest run on the job service. The backtest
+// is updated in place as the worker runs the job. On failure the error
+// response has already been written and false is returned.
+func (h *BacktestHandler) submitBacktestJob(w http.ResponseWriter, r *http.Request, backtest *Backtest, config *BacktestConfig) (*jobs.Job, bool) {
+	h.mu.RLock()
+	service := h.jobs
+	h.mu.RUnlock()
+
+	if service == nil {
+		h.writeError(w, http.StatusServiceUnavailable, "async_unavailable", "Asynchronous runs are not enabled")
+		return nil, false
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return nil, false
+	}
+
+	h.mu.Lock()
+	snapshot := *backtest
+	snapshot.Config = *config
+	snapshot.Results = nil
+	previousStatus := backtest.Status
+	backtest.Status = "pending"
+	h.mu.Unlock()
+
+	job, err := service.Submit(r.Context(), userID, jobs.JobTypeMonteCarlo, monteCarloJobParams{Backtest: snapshot})
+	if err != nil {
+		h.mu.Lock()
+		backtest.Status = previousStatus
+		h.mu.Unlock()
+
+		h.writeError(w, http.StatusServiceUnavailable, "queue_failed", "Failed to queue backtest: "+err.Error())
+		return nil, false
+	}
+
+	go h.trackBacktestJob(service, backtest, job.ID)
+	return job, true
+}
+
+// trackBacktestJob follows a backtest's job until it finishes
+func (h *BacktestHandler) trackBacktestJob(service *jobs.Service, backtest *Backtest, jobID string) {
+	updates, err := service.Watch(context.Background(), jobID)
+	if err != nil {
+		return
+	}
+
+	for job := range updates {
+		h.mu.Lock()
+		h.applyJobLocked(backtest, &job)
+		h.mu.Unlock()
+	}
+}
+
+// applyJobLocked updates a backtest with the state of its job. The caller
+// must hold h.mu.
+func (h *BacktestHandler) applyJobLocked(backtest *Backtest, job *jobs.Job) {
+	switch job.Status {
+	case jobs.JobStatusRunning:
+		backtest.Status = "running"
+		return
+	case jobs.JobStatusCompleted:
+		var result Backtest
+		if err := job.DecodeResult(&result); err != nil || result.Results == nil {
+			backtest.Status = "failed"
+			break
+		}
+		backtest.Results = result.Results
+		backtest.Status = "completed"
+	case jobs.JobStatusFailed, jobs.JobStatusCancelled:
+		backtest.Status = "failed"
+	default:
+		return
+	}
+	backtest.UpdatedAt = time.Now()
+}
+
+// isAsyncRequest reports whether the client asked for ?async=true
+func isAsyncRequest(r *http.Request) bool {
+	async, _ := strconv.ParseBool(r.URL.Query().Get("async"))
+	return async
+}
+
+// newJobAcceptedResponse builds the 202 response for a queued job
+func newJobAcceptedResponse(job *jobs.Job) dto.JobAcceptedResponse {
+	return dto.JobAcceptedResponse{
+		JobID:     job.ID,
+		Status:    string(job.Status),
+		StatusURL: "/api/jobs/" + job.ID,
+		StreamURL: "/api/jobs/" + job.ID + "/stream",
+	}
+}
+
+// RegisterJobHandlers runs Monte Carlo jobs with this handler. It is called
+// by the worker process.
+func (h *BacktestHandler) RegisterJobHandlers(service *jobs.Service) error {
+	return service.RegisterHandler(jobs.JobTypeMonteCarlo, h.runMonteCarloJob)
+}
+
+// runMonteCarloJob runs the simulation for the backtest a job carries and
+// returns the backtest with its results
+func (h *BacktestHandler) runMonteCarloJob(ctx context.Context, job *jobs.Job, report jobs.ProgressFunc) (any, error) {
+	var params monteCarloJobParams
+	if err := job.DecodeParams(&params); err != nil {
+		return nil, fmt.Errorf("decoding job params: %w", err)
+	}
+
+	backtest := params.Backtest
+	results, err := h.runBacktestContext(ctx, &backtest.Config, func(completed, total int) {
+		report(float64(completed) / float64(total))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	backtest.Results = results
+	backtest.Status = "completed"
+	backtest.UpdatedAt = time.Now()
+	return &backtest, nil
+}
+
+// RegisterScheduledJobs makes stored backtests available as recurring
+// retirement re-runs
+func (h *BacktestHandler) RegisterScheduledJobs(scheduler *jobs.Scheduler) error {
+	return scheduler.RegisterRunner(jobs.JobTypeMonteCarlo, jobs.Runner{
+		Params:     h.rerunParams,
+		OnComplete: h.storeRerun,
+	})
+}
+
+// rerunParams re-runs a stored backtest with its configuration at the time
+// of each run
+func (h *BacktestHandler) rerunParams(schedule *jobs.Schedule) (any, error) {
+	var params dto.MonteCarloScheduleParams
+	if len(schedule.Params) > 0 {
+		if err := json.Unmarshal(schedule.Params, &params); err != nil {
+			return nil, err
+		}
+	}
+	if params.BacktestID == "" {
+		return nil, newValidationError("backtest_id is required")
+	}
+
+	h.mu.RLock()
+	backtest, exists := h.backtests[params.BacktestID]
+	var snapshot Backtest
+	if exists {
+		snapshot = *backtest
+	}
+	h.mu.RUnlock()
+	if !exists {
+		return nil, newValidationError("backtest not found")
+	}
+
+	snapshot.Results = nil
+	return monteCarloJobParams{Backtest: snapshot}, nil
+}
+
+// storeRerun updates a re-run backtest with its completed job's results
+func (h *BacktestHandler) storeRerun(schedule jobs.Schedule, job *jobs.Job) {
+	var params monteCarloJobParams
+	if err := job.DecodeParams(&params); err != nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// The backtest may have been deleted since the run was scheduled
+	backtest, exists := h.backtests[params.Backtest.ID]
+	if !exists {
+		return
+	}
+	h.applyJobLocked(backtest, job)
+}
+
 // HandleGetPercentiles handles GET /api/retirement/backtest/{id}/percentiles
 func (h *BacktestHandler) HandleGetPercentiles(w http.ResponseWriter, r *http.Request, id string) {
 	if r.Method != http.MethodGet {
@@ -505,6 +730,12 @@ func (h *BacktestHandler) HandleScenarioAnalysisForPlan(w http.ResponseWriter, r
 
 // runBacktest executes the Monte Carlo simulation
 func (h *BacktestHandler) runBacktest(config *BacktestConfig) (*dto.MonteCarloResultsResponse, error) {
+	return h.runBacktestContext(context.Background(), config, nil)
+}
+
+// runBacktestContext executes the Monte Carlo simulation, stopping early if
+// ctx is cancelled
+func (h *BacktestHandler) runBacktestContext(ctx context.Context, config *BacktestConfig, progressCb appRetirement.SimulationProgressCallback) (*dto.MonteCarloResultsResponse, error) {
 	// Set defaults
 	numIterations := config.NumIterations
 	if numIterations <= 0 {
@@ -538,7 +769,7 @@ func (h *BacktestHandler) runBacktest(config *BacktestConfig) (*dto.MonteCarloRe
 		return nil, err
 	}
 
-	results, err := service.RunSimulation()
+	results, err := service.RunSimulationContext(ctx, progressCb)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,57 @@
+package retirement
+
+import (
+	"clockzen-next/internal/application/dto"
+	"clockzen-next/internal/presentation/http/export"
+)
+
+// yearlyFlowColumns are the columns of a yearly cash flow table
+var yearlyFlowColumns = []string{
+	"year", "age", "spouse_age", "is_retired", "survivor",
+	"employment_income", "self_employment_income", "social_security", "pension",
+	"investment_income", "rental_income", "other_income", "total_income",
+	"taxable_withdrawal", "traditional_withdrawal", "roth_withdrawal", "hsa_withdrawal",
+	"required_minimum_distribution", "roth_conversion", "total_withdrawals", "shortfall_amount",
+	"housing_expense", "healthcare_expense", "food_expense", "transportation_expense",
+	"utilities_expense", "insurance_expense", "discretionary_expense", "other_expenses",
+	"debt_payments", "total_expenses",
+	"federal_tax", "state_tax", "fica_tax", "capital_gains_tax", "total_tax",
+	"taxable_contribution", "traditional_contribution", "roth_contribution", "hsa_contribution",
+	"total_contributions",
+	"spending", "spending_adjustment", "net_cash_flow", "cumulative_surplus",
+	"portfolio_return", "total_portfolio", "rental_equity", "debt_balance", "net_worth",
+}
+
+// yearlyFlowsTable writes an analysis's cash flows as a row for each year.
+// A spouse's age is left empty outside a joint analysis.
+func yearlyFlowsTable(flows []dto.YearCashFlowResponse) func(export.Table) error {
+	return func(table export.Table) error {
+		if err := table.WriteHeader(yearlyFlowColumns...); err != nil {
+			return err
+		}
+		for _, f := range flows {
+			var spouseAge any
+			if f.SpouseAge > 0 {
+				spouseAge = f.SpouseAge
+			}
+			if err := table.WriteRow(
+				f.Year, f.Age, spouseAge, f.IsRetired, f.Survivor,
+				f.Income.EmploymentIncome, f.Income.SelfEmploymentIncome, f.Income.SocialSecurity, f.Income.Pension,
+				f.Income.InvestmentIncome, f.Income.RentalIncome, f.Income.OtherIncome, f.Income.TotalIncome,
+				f.Withdrawals.TaxableWithdrawal, f.Withdrawals.TraditionalWithdrawal, f.Withdrawals.RothWithdrawal, f.Withdrawals.HSAWithdrawal,
+				f.Withdrawals.RequiredMinimumDistribution, f.Withdrawals.RothConversion, f.Withdrawals.TotalWithdrawals, f.Withdrawals.ShortfallAmount,
+				f.Expenses.HousingExpense, f.Expenses.HealthcareExpense, f.Expenses.FoodExpense, f.Expenses.TransportationExpense,
+				f.Expenses.UtilitiesExpense, f.Expenses.InsuranceExpense, f.Expenses.DiscretionaryExpense, f.Expenses.OtherExpenses,
+				f.Expenses.DebtPayments, f.Expenses.TotalExpenses,
+				f.Taxes.FederalTax, f.Taxes.StateTax, f.Taxes.FICATax, f.Taxes.CapitalGainsTax, f.Taxes.TotalTax,
+				f.Savings.TaxableContribution, f.Savings.TraditionalContribution, f.Savings.RothContribution, f.Savings.HSAContribution,
+				f.Savings.TotalContributions,
+				f.Spending, f.SpendingAdjustment, f.NetCashFlow, f.CumulativeSurplus,
+				f.PortfolioReturn, f.TotalPortfolio, f.RentalEquity, f.DebtBalance, f.NetWorth,
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,114 @@
+package retirement
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"clockzen-next/internal/application/dto"
+	appRetirement "clockzen-next/internal/application/retirement"
+)
+
+// HandleFIRE handles POST /api/retirement/cashflow/{id}/fire
+func (h *CashFlowHandler) HandleFIRE(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	// The body is optional; regular FIRE at the analysis's retirement age
+	// by default
+	var req dto.CashFlowFIRERequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	h.mu.RLock()
+	analysis, exists := h.analyses[id]
+	var config CashFlowAnalysisConfig
+	if exists {
+		config = analysis.Config
+	}
+	h.mu.RUnlock()
+	if !exists {
+		h.writeError(w, http.StatusNotFound, "not_found", "Cash flow analysis not found")
+		return
+	}
+	if err := validateFIRERequest(&req, &config); err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	svcConfig := h.toServiceConfig(&config)
+	service, err := appRetirement.NewCashFlowService(svcConfig)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+	result, err := service.AnalyzeFIRE(svcConfig, appRetirement.FIREOptions{
+		Variant:        appRetirement.FIREVariant(req.Variant),
+		PartTimeIncome: req.PartTimeIncome,
+		PartTimeEndAge: req.PartTimeEndAge,
+		TargetAge:      req.TargetAge,
+		ReturnShifts:   req.ReturnShifts,
+	})
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	sensitivity := make([]dto.CashFlowFIRESensitivityResponse, len(result.Sensitivity))
+	for i, shifted := range result.Sensitivity {
+		sensitivity[i] = dto.CashFlowFIRESensitivityResponse{
+			ReturnShift:                 shifted.ReturnShift,
+			CashFlowFIREFiguresResponse: toFIREFiguresResponse(shifted.FIREFigures),
+		}
+	}
+	h.writeJSON(w, http.StatusOK, &dto.CashFlowFIREResponse{
+		CashFlowID:                  id,
+		Variant:                     string(result.Variant),
+		TargetAge:                   result.TargetAge,
+		CurrentPortfolio:            result.CurrentPortfolio,
+		CurrentSavingsRate:          result.CurrentSavingsRate,
+		CashFlowFIREFiguresResponse: toFIREFiguresResponse(result.FIREFigures),
+		CoastFIREReached:            result.CoastFIREReached,
+		Sensitivity:                 sensitivity,
+	})
+}
+
+// validateFIRERequest validates FIRE options against their analysis
+func validateFIRERequest(req *dto.CashFlowFIRERequest, config *CashFlowAnalysisConfig) error {
+	switch appRetirement.FIREVariant(req.Variant) {
+	case "", appRetirement.FIRERegular, appRetirement.FIRELean, appRetirement.FIREFat:
+	case appRetirement.FIREBarista:
+		if req.PartTimeIncome <= 0 {
+			return newValidationError("part_time_income is required for barista FIRE")
+		}
+	default:
+		return newValidationError("variant must be regular, lean, fat or barista")
+	}
+	if req.PartTimeIncome < 0 || req.PartTimeEndAge < 0 {
+		return newValidationError("part_time_income and part_time_end_age cannot be negative")
+	}
+	if req.TargetAge != 0 && (req.TargetAge < config.CurrentAge || req.TargetAge >= config.LifeExpectancy) {
+		return newValidationError("target_age must be between current_age and life_expectancy")
+	}
+	for _, shift := range req.ReturnShifts {
+		if shift <= -1 || shift >= 1 {
+			return newValidationError("return_shifts must be between -1 and 1")
+		}
+	}
+	return nil
+}
+
+// toFIREFiguresResponse converts FIRE figures to DTO response
+func toFIREFiguresResponse(figures appRetirement.FIREFigures) dto.CashFlowFIREFiguresResponse {
+	return dto.CashFlowFIREFiguresResponse{
+		EarliestRetirementAge: figures.EarliestRetirementAge,
+		PortfolioAtFIRE:       figures.PortfolioAtFIRE,
+		CoastFIRENumber:       figures.CoastFIRENumber,
+		RequiredSavingsRate:   figures.RequiredSavingsRate,
+	}
+}
@@ -2,14 +2,21 @@ package retirement
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
+	"math"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 
+	appAnalysis "clockzen-next/internal/application/analysis"
 	"clockzen-next/internal/application/dto"
+	"clockzen-next/internal/application/jobs"
 	appRetirement "clockzen-next/internal/application/retirement"
+	"clockzen-next/internal/presentation/http/export"
 )
 
 // CashFlowAnalysis represents a stored cash flow analysis
@@ -22,6 +29,12 @@ type CashFlowAnalysis struct {
 	Status    string                        `json:"status"` // pending, running, completed, failed
 	CreatedAt time.Time                     `json:"created_at"`
 	UpdatedAt time.Time                     `json:"updated_at"`
+
+	// RaiseCaptures are the recommendations to save part of a raise
+	RaiseCaptures []*dto.RaiseCaptureResponse `json:"raise_captures,omitempty"`
+
+	// Annotations are notes on the analysis's years and assumptions
+	Annotations []*dto.CashFlowAnnotationResponse `json:"annotations,omitempty"`
 }
 
 // CashFlowAnalysisConfig represents configuration for cash flow analysis
@@ -41,6 +54,56 @@ type CashFlowAnalysisConfig struct {
 	RentalIncome           float64 `json:"rental_income"`
 	OtherIncome            float64 `json:"other_income"`
 
+	// SelfEmploymentIncome is net self-employment earnings until retirement
+	SelfEmploymentIncome float64 `json:"self_employment_income,omitempty"`
+
+	// PartTimeIncome is earnings from part-time work in retirement, in
+	// today's dollars, until part_time_end_age (for life when omitted)
+	PartTimeIncome float64 `json:"part_time_income,omitempty"`
+	PartTimeEndAge int     `json:"part_time_end_age,omitempty"`
+
+	// Spouse, when set, is a second person planned for jointly
+	Spouse *SpouseAnalysisConfig `json:"spouse,omitempty"`
+
+	// Pensions are pensions and annuities with their own COLA and survivor
+	// terms, paid alongside pension_benefit
+	Pensions []PensionAnalysisConfig `json:"pensions,omitempty"`
+
+	// RentalProperties are rental properties modeled with their mortgages,
+	// depreciation and sale, alongside rental_income
+	RentalProperties []RentalPropertyAnalysisConfig `json:"rental_properties,omitempty"`
+
+	// Debts are amortized until paid off; a mortgage's payments are added
+	// to housing_expense, which then excludes them
+	Debts []DebtAnalysisConfig `json:"debts,omitempty"`
+
+	// Allocation, when set, invests the accounts along glide paths with
+	// separate stock, bond and cash returns in place of expected_return
+	Allocation *AllocationAnalysisConfig `json:"allocation,omitempty"`
+
+	// LongTermCare, when set, is a care event paid as healthcare, with its
+	// insurance
+	LongTermCare *LongTermCareAnalysisConfig `json:"long_term_care,omitempty"`
+
+	// Windfalls are one-time inflows such as inheritances
+	Windfalls []WindfallAnalysisConfig `json:"windfalls,omitempty"`
+
+	// InheritedIRAs are inherited accounts already held, emptied under the
+	// 10-year rule. inherited_ira_strategy spreads the traditional ones:
+	// even (default), deferred, or fill_bracket up to
+	// inherited_ira_target_rate (0.22 by default)
+	InheritedIRAs          []InheritedIRAAnalysisConfig `json:"inherited_iras,omitempty"`
+	InheritedIRAStrategy   string                       `json:"inherited_ira_strategy,omitempty"`
+	InheritedIRATargetRate float64                      `json:"inherited_ira_target_rate,omitempty"`
+
+	// ACA, when set, prices marketplace coverage and its premium tax
+	// credit for the retired years before Medicare
+	ACA *ACAAnalysisConfig `json:"aca,omitempty"`
+
+	// SocialSecurityEstimate, when set, estimates the household benefit from
+	// earnings and replaces social_security_benefit and start age
+	SocialSecurityEstimate *dto.SocialSecurityEstimateRequest `json:"social_security_estimate,omitempty"`
+
 	// Portfolio balances
 	TaxableBalance     float64 `json:"taxable_balance"`
 	TraditionalBalance float64 `json:"traditional_balance"`
@@ -66,6 +129,10 @@ type CashFlowAnalysisConfig struct {
 	// Healthcare growth rate
 	HealthcareGrowthRate float64 `json:"healthcare_growth_rate"`
 
+	// RetirementSpendingShape scales expenses in each year of retirement;
+	// without one, expenses stay flat in today's dollars
+	RetirementSpendingShape []float64 `json:"retirement_spending_shape,omitempty"`
+
 	// Market assumptions
 	ExpectedReturn float64 `json:"expected_return"`
 	InflationRate  float64 `json:"inflation_rate"`
@@ -77,9 +144,18 @@ type CashFlowAnalysisConfig struct {
 	CapitalGainsRate    float64 `json:"capital_gains_rate"`
 	StateHasNoIncomeTax bool    `json:"state_has_no_income_tax"`
 
+	// StateCode selects a state's income tax rules (e.g. "CA") in place of
+	// state_tax_rate and state_has_no_income_tax
+	StateCode string `json:"state_code,omitempty"`
+
 	// Withdrawal strategy
 	WithdrawalStrategy dto.WithdrawalStrategyType `json:"withdrawal_strategy"`
 
+	// SpendingStrategy is planned (the default), fixed_percentage,
+	// guardrails or vpw; spending_rules override its parameters
+	SpendingStrategy string               `json:"spending_strategy,omitempty"`
+	SpendingRules    *SpendingRulesConfig `json:"spending_rules,omitempty"`
+
 	// Tax optimization
 	UseTaxGainHarvesting bool    `json:"use_tax_gain_harvesting"`
 	UseRothConversion    bool    `json:"use_roth_conversion"`
@@ -87,16 +163,270 @@ type CashFlowAnalysisConfig struct {
 	RothConversionEndAge int     `json:"roth_conversion_end_age"`
 }
 
+// SpouseAnalysisConfig is a spouse or partner's part of a joint analysis
+type SpouseAnalysisConfig struct {
+	CurrentAge    int `json:"current_age"`
+	RetirementAge int `json:"retirement_age"`
+
+	// DeathAge, when set, ends the spouse's income at that age for a
+	// survivor scenario
+	DeathAge int `json:"death_age,omitempty"`
+
+	// Income sources
+	EmploymentIncome       float64 `json:"employment_income"`
+	EmploymentIncomeGrowth float64 `json:"employment_income_growth"`
+	SocialSecurityBenefit  float64 `json:"social_security_benefit"`
+	SocialSecurityStartAge int     `json:"social_security_start_age"`
+	PensionBenefit         float64 `json:"pension_benefit"`
+	PensionStartAge        int     `json:"pension_start_age"`
+
+	// Survivor scenario
+	PensionSurvivorShare     float64 `json:"pension_survivor_share,omitempty"`
+	SurvivorExpenseReduction float64 `json:"survivor_expense_reduction,omitempty"`
+
+	// Portfolio balances, pooled with the household's
+	TaxableBalance     float64 `json:"taxable_balance"`
+	TraditionalBalance float64 `json:"traditional_balance"`
+	RothBalance        float64 `json:"roth_balance"`
+	HSABalance         float64 `json:"hsa_balance"`
+}
+
+// PensionAnalysisConfig is a pension or annuity in an analysis
+type PensionAnalysisConfig struct {
+	Name string `json:"name,omitempty"`
+
+	// Spouse marks a pension on the spouse's work record
+	Spouse bool `json:"spouse,omitempty"`
+
+	// AnnualBenefit is the first year's payment at start_age, raised by
+	// cola each year after
+	AnnualBenefit float64 `json:"annual_benefit"`
+	StartAge      int     `json:"start_age"`
+	COLA          float64 `json:"cola"`
+
+	// SurvivorShare is the part paid on after the owner dies, 0 for a
+	// single life annuity
+	SurvivorShare float64 `json:"survivor_share"`
+
+	// LumpSum is what the plan offers instead; take_lump_sum rolls it over
+	LumpSum     float64 `json:"lump_sum,omitempty"`
+	TakeLumpSum bool    `json:"take_lump_sum,omitempty"`
+}
+
+// RentalPropertyAnalysisConfig is a rental property in an analysis; rent
+// and operating expenses are annual amounts in today's dollars
+type RentalPropertyAnalysisConfig struct {
+	Name             string  `json:"name,omitempty"`
+	Value            float64 `json:"value"`
+	AppreciationRate float64 `json:"appreciation_rate"`
+
+	GrossRent         float64 `json:"gross_rent"`
+	OperatingExpenses float64 `json:"operating_expenses"`
+
+	// MortgagePayment is the annual principal and interest payment
+	MortgageBalance float64 `json:"mortgage_balance"`
+	MortgageRate    float64 `json:"mortgage_rate"`
+	MortgagePayment float64 `json:"mortgage_payment"`
+
+	// DepreciableBasis is the building's part of cost_basis
+	CostBasis               float64 `json:"cost_basis"`
+	DepreciableBasis        float64 `json:"depreciable_basis"`
+	AccumulatedDepreciation float64 `json:"accumulated_depreciation"`
+
+	// SaleAge, when set, is the age the property is sold at
+	SaleAge         int     `json:"sale_age,omitempty"`
+	SellingCostRate float64 `json:"selling_cost_rate,omitempty"`
+}
+
+// DebtAnalysisConfig is a loan in an analysis
+type DebtAnalysisConfig struct {
+	Name string `json:"name,omitempty"`
+	// Type is mortgage, student_loan, auto_loan or other
+	Type      string  `json:"type"`
+	Balance   float64 `json:"balance"`
+	Rate      float64 `json:"rate"`
+	TermYears int     `json:"term_years"`
+	// ExtraPayment is extra principal paid each year
+	ExtraPayment float64 `json:"extra_payment,omitempty"`
+}
+
+// AllocationAnalysisConfig sets how an analysis's accounts are invested
+type AllocationAnalysisConfig struct {
+	// Assumptions default to long-run stock, bond and cash returns
+	Assumptions *MarketAssumptionsConfig `json:"assumptions,omitempty"`
+
+	// AssumptionsSource, when set, loads Assumptions from a provider's
+	// version; saving the analysis pins it to the provider's latest version
+	// when none is given
+	AssumptionsSource *AssumptionsSourceConfig `json:"assumptions_source,omitempty"`
+
+	// GlidePath applies to every account without its own; BondTent builds
+	// one around the retirement age instead
+	GlidePath []GlidePathPointConfig `json:"glide_path,omitempty"`
+	BondTent  *BondTentConfig        `json:"bond_tent,omitempty"`
+
+	TaxableGlidePath     []GlidePathPointConfig `json:"taxable_glide_path,omitempty"`
+	TraditionalGlidePath []GlidePathPointConfig `json:"traditional_glide_path,omitempty"`
+	RothGlidePath        []GlidePathPointConfig `json:"roth_glide_path,omitempty"`
+	HSAGlidePath         []GlidePathPointConfig `json:"hsa_glide_path,omitempty"`
+}
+
+// MarketAssumptionsConfig is each asset class's expected return and
+// volatility, and how their returns are correlated
+type MarketAssumptionsConfig struct {
+	StockReturn     float64 `json:"stock_return"`
+	BondReturn      float64 `json:"bond_return"`
+	CashReturn      float64 `json:"cash_return"`
+	StockVolatility float64 `json:"stock_volatility"`
+	BondVolatility  float64 `json:"bond_volatility"`
+	CashVolatility  float64 `json:"cash_volatility"`
+
+	StockBondCorrelation float64 `json:"stock_bond_correlation,omitempty"`
+	StockCashCorrelation float64 `json:"stock_cash_correlation,omitempty"`
+	BondCashCorrelation  float64 `json:"bond_cash_correlation,omitempty"`
+}
+
+// AssumptionsSourceConfig names a market assumptions provider ("default",
+// "upload", or one added with SetAssumptionProvider) and its version
+type AssumptionsSourceConfig struct {
+	Provider string `json:"provider"`
+	Version  string `json:"version,omitempty"`
+}
+
+// GlidePathPointConfig is the allocation held from an age
+type GlidePathPointConfig struct {
+	Age    int     `json:"age"`
+	Stocks float64 `json:"stocks"`
+	Bonds  float64 `json:"bonds"`
+	Cash   float64 `json:"cash"`
+}
+
+// BondTentConfig lowers stocks from base_stocks to retirement_stocks over
+// the years before retirement and raises them back over as many after
+type BondTentConfig struct {
+	Years            int     `json:"years"`
+	BaseStocks       float64 `json:"base_stocks"`
+	RetirementStocks float64 `json:"retirement_stocks"`
+}
+
+// LongTermCareAnalysisConfig is a care event in an analysis. start_age
+// places it in the projection; probability instead has simulations draw it,
+// starting between earliest_age and latest_age.
+type LongTermCareAnalysisConfig struct {
+	AnnualCost    float64 `json:"annual_cost"`
+	DurationYears int     `json:"duration_years"`
+	StartAge      int     `json:"start_age,omitempty"`
+
+	Probability float64 `json:"probability,omitempty"`
+	EarliestAge int     `json:"earliest_age,omitempty"`
+	LatestAge   int     `json:"latest_age,omitempty"`
+
+	Insurance *LongTermCareInsuranceConfig `json:"insurance,omitempty"`
+}
+
+// LongTermCareInsuranceConfig is a policy paying towards care
+type LongTermCareInsuranceConfig struct {
+	AnnualPremium float64 `json:"annual_premium"`
+	// PremiumEndAge is when premiums stop, 0 for life
+	PremiumEndAge int     `json:"premium_end_age,omitempty"`
+	AnnualBenefit float64 `json:"annual_benefit"`
+	// BenefitGrowth is the inflation rider's yearly increase
+	BenefitGrowth float64 `json:"benefit_growth,omitempty"`
+	// BenefitYears limits how long benefits are paid, 0 for no limit
+	BenefitYears int `json:"benefit_years,omitempty"`
+}
+
+// WindfallAnalysisConfig is a one-time inflow in today's dollars received
+// at age. account is taxable, or inherited_ira or inherited_roth to pay it
+// out over ten years under the 10-year rule; taxable marks cash taxed as
+// income when received.
+type WindfallAnalysisConfig struct {
+	Name    string  `json:"name,omitempty"`
+	Amount  float64 `json:"amount"`
+	Age     int     `json:"age"`
+	Taxable bool    `json:"taxable,omitempty"`
+	Account string  `json:"account"`
+}
+
+// InheritedIRAAnalysisConfig is an inherited IRA held at the start of the
+// plan; years_left counts this year, all ten when omitted
+type InheritedIRAAnalysisConfig struct {
+	Name      string  `json:"name,omitempty"`
+	Balance   float64 `json:"balance"`
+	Roth      bool    `json:"roth,omitempty"`
+	YearsLeft int     `json:"years_left,omitempty"`
+}
+
+// ACAAnalysisConfig is marketplace coverage before Medicare. Premiums are
+// annual, per person and in today's dollars; premium is the chosen plan's,
+// the benchmark (second-lowest-cost silver) plan's when omitted.
+type ACAAnalysisConfig struct {
+	BenchmarkPremium float64 `json:"benchmark_premium"`
+	Premium          float64 `json:"premium,omitempty"`
+
+	// SubsidyRules replace the rules in effect from 2026
+	SubsidyRules *ACASubsidyRulesConfig `json:"subsidy_rules,omitempty"`
+}
+
+// ACASubsidyRulesConfig sets the premium tax credit's poverty line and
+// contribution bands; incomes are multiples of the poverty line, and
+// subsidy_cliff 0 means no cliff
+type ACASubsidyRulesConfig struct {
+	PovertyLine          float64                     `json:"poverty_line"`
+	PovertyLinePerPerson float64                     `json:"poverty_line_per_person"`
+	Bands                []ACAContributionBandConfig `json:"bands"`
+	SubsidyCliff         float64                     `json:"subsidy_cliff"`
+}
+
+// ACAContributionBandConfig is the share of income expected towards the
+// benchmark premium, rising from initial_rate to final_rate by the next band
+type ACAContributionBandConfig struct {
+	Over        float64 `json:"over"`
+	InitialRate float64 `json:"initial_rate"`
+	FinalRate   float64 `json:"final_rate"`
+}
+
+// SpendingRulesConfig sets the dynamic spending strategies' parameters;
+// omitted ones take their defaults
+type SpendingRulesConfig struct {
+	WithdrawalRate      float64 `json:"withdrawal_rate,omitempty"`
+	GuardrailBand       float64 `json:"guardrail_band,omitempty"`
+	GuardrailAdjustment float64 `json:"guardrail_adjustment,omitempty"`
+	GuardrailFinalYears int     `json:"guardrail_final_years,omitempty"`
+	VPWReturn           float64 `json:"vpw_return,omitempty"`
+}
+
 // CashFlowHandler handles HTTP requests for cash flow analysis
 type CashFlowHandler struct {
 	mu       sync.RWMutex
 	analyses map[string]*CashFlowAnalysis
+
+	income         appAnalysis.IncomeRepository
+	onRaiseCapture RaiseCaptureFunc
+	spending       *appAnalysis.SpendingService
+	jobs           *jobs.Service
+
+	assumptionProviders map[string]appRetirement.AssumptionProvider
+	uploadedAssumptions *appRetirement.StaticAssumptionProvider
+
+	// results and simulations are cached by their inputs' fingerprints
+	results     *appRetirement.ResultCache[*appRetirement.CashFlowResults]
+	simulations *appRetirement.ResultCache[*appRetirement.CashFlowMonteCarloResults]
 }
 
 // NewCashFlowHandler creates a new CashFlowHandler instance
 func NewCashFlowHandler() *CashFlowHandler {
+	defaults := appRetirement.DefaultAssumptionProvider()
+	uploads := appRetirement.NewStaticAssumptionProvider(UploadedAssumptionsProvider)
 	return &CashFlowHandler{
 		analyses: make(map[string]*CashFlowAnalysis),
+		assumptionProviders: map[string]appRetirement.AssumptionProvider{
+			defaults.Name(): defaults,
+			uploads.Name():  uploads,
+		},
+		uploadedAssumptions: uploads,
+		results:             appRetirement.NewResultCache[*appRetirement.CashFlowResults](0),
+		simulations:         appRetirement.NewResultCache[*appRetirement.CashFlowMonteCarloResults](0),
 	}
 }
 
@@ -136,6 +466,10 @@ func (h *CashFlowHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
 		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
 		return
 	}
+	if err := h.pinAssumptions(r.Context(), &req.Config); err != nil {
+		h.writeAssumptionsError(w, err)
+		return
+	}
 
 	now := time.Now()
 	analysis := &CashFlowAnalysis{
@@ -171,6 +505,9 @@ func (h *CashFlowHandler) HandleGet(w http.ResponseWriter, r *http.Request, id s
 		return
 	}
 
+	if includeTables(r) {
+		analysis = withSankeyTables(analysis)
+	}
 	h.writeJSON(w, http.StatusOK, analysis)
 }
 
@@ -236,6 +573,12 @@ func (h *CashFlowHandler) HandleUpdate(w http.ResponseWriter, r *http.Request, i
 		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
 		return
 	}
+	if req.Config != nil {
+		if err := h.pinAssumptions(r.Context(), req.Config); err != nil {
+			h.writeAssumptionsError(w, err)
+			return
+		}
+	}
 
 	h.mu.Lock()
 	defer h.mu.Unlock()
@@ -316,6 +659,9 @@ func (h *CashFlowHandler) HandleRun(w http.ResponseWriter, r *http.Request, id s
 	analysis.UpdatedAt = time.Now()
 	h.mu.Unlock()
 
+	if includeTables(r) {
+		analysis = withSankeyTables(analysis)
+	}
 	h.writeJSON(w, http.StatusOK, analysis)
 }
 
@@ -363,16 +709,38 @@ func (h *CashFlowHandler) HandleRunForPlan(w http.ResponseWriter, r *http.Reques
 	h.analyses[analysis.ID] = analysis
 	h.mu.Unlock()
 
+	if includeTables(r) {
+		analysis = withSankeyTables(analysis)
+	}
 	h.writeJSON(w, http.StatusOK, analysis)
 }
 
 // HandleGetSankey handles GET /api/retirement/cashflow/{id}/sankey
+// (?age=N diagrams that year alone)
 func (h *CashFlowHandler) HandleGetSankey(w http.ResponseWriter, r *http.Request, id string) {
 	if r.Method != http.MethodGet {
 		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
 		return
 	}
 
+	if r.URL.Query().Has("age") {
+		age, err := ageParameter(r, "age")
+		if err != nil {
+			h.writeError(w, http.StatusBadRequest, "invalid_parameter", err.Error())
+			return
+		}
+		periods, ok := h.sankeyPeriods(w, id, appRetirement.SankeyByYear, age, age)
+		if !ok {
+			return
+		}
+		sankeyData := h.toSankeyResponse(periods[0].Sankey)
+		if includeTables(r) {
+			sankeyData = sankeyWithTable(sankeyData)
+		}
+		h.writeJSON(w, http.StatusOK, sankeyData)
+		return
+	}
+
 	h.mu.RLock()
 	analysis, exists := h.analyses[id]
 	h.mu.RUnlock()
@@ -397,6 +765,9 @@ func (h *CashFlowHandler) HandleGetSankey(w http.ResponseWriter, r *http.Request
 		sankeyData = analysis.Results.AccumulationSankey
 	}
 
+	if includeTables(r) {
+		sankeyData = sankeyWithTable(sankeyData)
+	}
 	h.writeJSON(w, http.StatusOK, sankeyData)
 }
 
@@ -425,6 +796,11 @@ func (h *CashFlowHandler) HandleGetSankeyForPlan(w http.ResponseWriter, r *http.
 		return
 	}
 
+	if includeTables(r) {
+		results.AccumulationSankey = sankeyWithTable(results.AccumulationSankey)
+		results.RetirementSankey = sankeyWithTable(results.RetirementSankey)
+	}
+
 	// Check if retirement or accumulation phase is requested
 	phase := r.URL.Query().Get("phase")
 
@@ -445,13 +821,21 @@ func (h *CashFlowHandler) HandleGetSankeyForPlan(w http.ResponseWriter, r *http.
 	}
 }
 
-// HandleGetYearlyFlows handles GET /api/retirement/cashflow/{id}/yearly
+// HandleGetYearlyFlows handles GET /api/retirement/cashflow/{id}/yearly,
+// as JSON or, with ?format=csv|xlsx or an Accept header asking for one, as
+// a table of a row for each year
 func (h *CashFlowHandler) HandleGetYearlyFlows(w http.ResponseWriter, r *http.Request, id string) {
 	if r.Method != http.MethodGet {
 		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
 		return
 	}
 
+	format, err := export.Negotiate(r)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_parameter", err.Error())
+		return
+	}
+
 	h.mu.RLock()
 	analysis, exists := h.analyses[id]
 	h.mu.RUnlock()
@@ -466,21 +850,134 @@ func (h *CashFlowHandler) HandleGetYearlyFlows(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	if format != export.FormatJSON {
+		// Nothing more can be reported once the body has started
+		_ = export.Stream(w, format, "cashflow-"+id+"-yearly", "Yearly cash flows", yearlyFlowsTable(analysis.Results.YearlyFlows))
+		return
+	}
 	h.writeJSON(w, http.StatusOK, analysis.Results.YearlyFlows)
 }
 
-// runCashFlowAnalysis executes the cash flow analysis
-func (h *CashFlowHandler) runCashFlowAnalysis(config *CashFlowAnalysisConfig) (*dto.CashFlowResultsResponse, error) {
-	// Convert handler config to service config
-	svcConfig := h.toServiceConfig(config)
+// HandleMonteCarlo handles POST /api/retirement/cashflow/{id}/monte-carlo
+func (h *CashFlowHandler) HandleMonteCarlo(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	// The body is optional; every setting has a default
+	var req dto.CashFlowMonteCarloRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	if err := h.validateMonteCarloRequest(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	h.mu.RLock()
+	analysis, exists := h.analyses[id]
+	var config CashFlowAnalysisConfig
+	if exists {
+		config = analysis.Config
+	}
+	h.mu.RUnlock()
+
+	if !exists {
+		h.writeError(w, http.StatusNotFound, "not_found", "Cash flow analysis not found")
+		return
+	}
+
+	svcConfig := h.toServiceConfig(&config)
+	mc := h.toMonteCarloConfig(&req)
+
+	// Only seeded simulations repeat, so only they are cached
+	key, cacheable := cacheKey(&config, svcConfig, mc)
+	cacheable = cacheable && mc.Seed != 0
+	if cacheable {
+		if results, ok := h.simulations.Get(key); ok {
+			h.writeJSON(w, http.StatusOK, h.toMonteCarloResponse(results))
+			return
+		}
+	}
 
-	// Create service and run analysis
 	service, err := appRetirement.NewCashFlowService(svcConfig)
 	if err != nil {
-		return nil, err
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	results, err := service.RunMonteCarlo(r.Context(), svcConfig, mc)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "analysis_failed", err.Error())
+		return
+	}
+	if cacheable {
+		h.simulations.Put(key, results)
+	}
+
+	h.writeJSON(w, http.StatusOK, h.toMonteCarloResponse(results))
+}
+
+// HandleHistorical handles POST /api/retirement/cashflow/{id}/historical
+func (h *CashFlowHandler) HandleHistorical(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	// The body is optional; by default every window since 1928 is replayed
+	var req dto.CashFlowHistoricalRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+	if req.FirstStartYear != 0 && req.LastStartYear != 0 && req.FirstStartYear > req.LastStartYear {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "first_start_year must not be after last_start_year")
+		return
 	}
 
-	results, err := service.RunAnalysis()
+	h.mu.RLock()
+	analysis, exists := h.analyses[id]
+	var config CashFlowAnalysisConfig
+	if exists {
+		config = analysis.Config
+	}
+	h.mu.RUnlock()
+
+	if !exists {
+		h.writeError(w, http.StatusNotFound, "not_found", "Cash flow analysis not found")
+		return
+	}
+
+	svcConfig := h.toServiceConfig(&config)
+	service, err := appRetirement.NewCashFlowService(svcConfig)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	results, err := service.RunHistoricalBacktest(r.Context(), svcConfig, appRetirement.HistoricalBacktestConfig{
+		FirstStartYear: req.FirstStartYear,
+		LastStartYear:  req.LastStartYear,
+	})
+	if err != nil {
+		if errors.Is(err, appRetirement.ErrHistoryTooShort) {
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "analysis_failed", err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, h.toHistoricalResponse(results))
+}
+
+// runCashFlowAnalysis executes the cash flow analysis
+func (h *CashFlowHandler) runCashFlowAnalysis(config *CashFlowAnalysisConfig) (*dto.CashFlowResultsResponse, error) {
+	results, err := h.runServiceAnalysis(config)
 	if err != nil {
 		return nil, err
 	}
@@ -503,7 +1000,7 @@ func (h *CashFlowHandler) toServiceConfig(config *CashFlowAnalysisConfig) appRet
 		strategy = appRetirement.RothFirst
 	}
 
-	return appRetirement.CashFlowConfig{
+	svcConfig := appRetirement.CashFlowConfig{
 		CurrentAge:                  config.CurrentAge,
 		RetirementAge:               config.RetirementAge,
 		LifeExpectancy:              config.LifeExpectancy,
@@ -515,6 +1012,9 @@ func (h *CashFlowHandler) toServiceConfig(config *CashFlowAnalysisConfig) appRet
 		PensionStartAge:             config.PensionStartAge,
 		RentalIncome:                config.RentalIncome,
 		OtherIncome:                 config.OtherIncome,
+		SelfEmploymentIncome:        config.SelfEmploymentIncome,
+		PartTimeIncome:              config.PartTimeIncome,
+		PartTimeEndAge:              config.PartTimeEndAge,
 		TaxableBalance:              config.TaxableBalance,
 		TraditionalBalance:          config.TraditionalBalance,
 		RothBalance:                 config.RothBalance,
@@ -544,63 +1044,190 @@ func (h *CashFlowHandler) toServiceConfig(config *CashFlowAnalysisConfig) appRet
 		UseRothConversion:           config.UseRothConversion,
 		RothConversionAmount:        config.RothConversionAmount,
 		RothConversionEndAge:        config.RothConversionEndAge,
+		RetirementSpendingShape:     config.RetirementSpendingShape,
+		StateCode:                   config.StateCode,
+	}
+	if spouse := config.Spouse; spouse != nil {
+		svcConfig.Spouse = &appRetirement.SpouseConfig{
+			CurrentAge:               spouse.CurrentAge,
+			RetirementAge:            spouse.RetirementAge,
+			DeathAge:                 spouse.DeathAge,
+			EmploymentIncome:         spouse.EmploymentIncome,
+			EmploymentIncomeGrowth:   spouse.EmploymentIncomeGrowth,
+			SocialSecurityBenefit:    spouse.SocialSecurityBenefit,
+			SocialSecurityStartAge:   spouse.SocialSecurityStartAge,
+			PensionBenefit:           spouse.PensionBenefit,
+			PensionStartAge:          spouse.PensionStartAge,
+			PensionSurvivorShare:     spouse.PensionSurvivorShare,
+			SurvivorExpenseReduction: spouse.SurvivorExpenseReduction,
+			TaxableBalance:           spouse.TaxableBalance,
+			TraditionalBalance:       spouse.TraditionalBalance,
+			RothBalance:              spouse.RothBalance,
+			HSABalance:               spouse.HSABalance,
+		}
+	}
+	for _, pension := range config.Pensions {
+		svcConfig.Pensions = append(svcConfig.Pensions, appRetirement.Pension{
+			Name:          pension.Name,
+			Spouse:        pension.Spouse,
+			AnnualBenefit: pension.AnnualBenefit,
+			StartAge:      pension.StartAge,
+			COLA:          pension.COLA,
+			SurvivorShare: pension.SurvivorShare,
+			LumpSum:       pension.LumpSum,
+			TakeLumpSum:   pension.TakeLumpSum,
+		})
+	}
+	for _, property := range config.RentalProperties {
+		svcConfig.RentalProperties = append(svcConfig.RentalProperties, appRetirement.RentalProperty{
+			Name:                    property.Name,
+			Value:                   property.Value,
+			AppreciationRate:        property.AppreciationRate,
+			GrossRent:               property.GrossRent,
+			OperatingExpenses:       property.OperatingExpenses,
+			MortgageBalance:         property.MortgageBalance,
+			MortgageRate:            property.MortgageRate,
+			MortgagePayment:         property.MortgagePayment,
+			CostBasis:               property.CostBasis,
+			DepreciableBasis:        property.DepreciableBasis,
+			AccumulatedDepreciation: property.AccumulatedDepreciation,
+			SaleAge:                 property.SaleAge,
+			SellingCostRate:         property.SellingCostRate,
+		})
 	}
+	for _, debt := range config.Debts {
+		svcConfig.Debts = append(svcConfig.Debts, appRetirement.Debt{
+			Name:         debt.Name,
+			Type:         appRetirement.DebtType(debt.Type),
+			Balance:      debt.Balance,
+			Rate:         debt.Rate,
+			TermYears:    debt.TermYears,
+			ExtraPayment: debt.ExtraPayment,
+		})
+	}
+	if config.Allocation != nil {
+		svcConfig.Allocation = toAllocationConfig(config.Allocation, config.RetirementAge)
+	}
+	for _, windfall := range config.Windfalls {
+		svcConfig.Windfalls = append(svcConfig.Windfalls, appRetirement.Windfall{
+			Name:    windfall.Name,
+			Amount:  windfall.Amount,
+			Age:     windfall.Age,
+			Taxable: windfall.Taxable,
+			Account: appRetirement.WindfallAccount(windfall.Account),
+		})
+	}
+	for _, ira := range config.InheritedIRAs {
+		svcConfig.InheritedIRAs = append(svcConfig.InheritedIRAs, appRetirement.InheritedIRA{
+			Name:      ira.Name,
+			Balance:   ira.Balance,
+			Roth:      ira.Roth,
+			YearsLeft: ira.YearsLeft,
+		})
+	}
+	svcConfig.InheritedIRAStrategy = appRetirement.InheritedIRAStrategy(config.InheritedIRAStrategy)
+	svcConfig.InheritedIRATargetRate = config.InheritedIRATargetRate
+	if aca := config.ACA; aca != nil {
+		svcConfig.ACA = &appRetirement.ACACoverage{
+			BenchmarkPremium: aca.BenchmarkPremium,
+			Premium:          aca.Premium,
+		}
+		if rules := aca.SubsidyRules; rules != nil {
+			svcConfig.ACA.Rules = appRetirement.ACASubsidyRules{
+				PovertyLine:          rules.PovertyLine,
+				PovertyLinePerPerson: rules.PovertyLinePerPerson,
+				SubsidyCliff:         rules.SubsidyCliff,
+			}
+			for _, band := range rules.Bands {
+				svcConfig.ACA.Rules.Bands = append(svcConfig.ACA.Rules.Bands, appRetirement.ACAContributionBand{
+					Over:        band.Over,
+					InitialRate: band.InitialRate,
+					FinalRate:   band.FinalRate,
+				})
+			}
+		}
+	}
+	svcConfig.SpendingStrategy = appRetirement.SpendingStrategy(config.SpendingStrategy)
+	if rules := config.SpendingRules; rules != nil {
+		svcConfig.SpendingRules = appRetirement.SpendingRules{
+			WithdrawalRate:      rules.WithdrawalRate,
+			GuardrailBand:       rules.GuardrailBand,
+			GuardrailAdjustment: rules.GuardrailAdjustment,
+			GuardrailFinalYears: rules.GuardrailFinalYears,
+			VPWReturn:           rules.VPWReturn,
+		}
+	}
+	if ltc := config.LongTermCare; ltc != nil {
+		svcConfig.LongTermCare = &appRetirement.LongTermCare{
+			AnnualCost:    ltc.AnnualCost,
+			DurationYears: ltc.DurationYears,
+			StartAge:      ltc.StartAge,
+			Probability:   ltc.Probability,
+			EarliestAge:   ltc.EarliestAge,
+			LatestAge:     ltc.LatestAge,
+		}
+		if p := ltc.Insurance; p != nil {
+			svcConfig.LongTermCare.Insurance = &appRetirement.LongTermCareInsurance{
+				AnnualPremium: p.AnnualPremium,
+				PremiumEndAge: p.PremiumEndAge,
+				AnnualBenefit: p.AnnualBenefit,
+				BenefitGrowth: p.BenefitGrowth,
+				BenefitYears:  p.BenefitYears,
+			}
+		}
+	}
+
+	// The estimate input was checked by validateConfig
+	if config.SocialSecurityEstimate != nil {
+		if estimate, err := appRetirement.EstimateSocialSecurity(toSocialSecurityInput(config.SocialSecurityEstimate)); err == nil {
+			estimate.ApplyTo(&svcConfig)
+		}
+	}
+	return svcConfig
+}
+
+// toAllocationConfig converts an analysis's allocation, building its bond
+// tent around retirementAge
+func toAllocationConfig(config *AllocationAnalysisConfig, retirementAge int) *appRetirement.AllocationConfig {
+	allocation := &appRetirement.AllocationConfig{
+		Assumptions: appRetirement.DefaultMarketAssumptions(),
+		GlidePath:   toGlidePath(config.GlidePath),
+		Taxable:     toGlidePath(config.TaxableGlidePath),
+		Traditional: toGlidePath(config.TraditionalGlidePath),
+		Roth:        toGlidePath(config.RothGlidePath),
+		HSA:         toGlidePath(config.HSAGlidePath),
+	}
+	if a := config.Assumptions; a != nil {
+		allocation.Assumptions = toMarketAssumptions(a)
+	}
+	if tent := config.BondTent; tent != nil {
+		allocation.GlidePath = appRetirement.BondTentGlidePath(retirementAge, tent.Years, tent.BaseStocks, tent.RetirementStocks)
+	}
+	return allocation
+}
+
+// toGlidePath converts a glide path, nil when it has no points
+func toGlidePath(points []GlidePathPointConfig) appRetirement.GlidePath {
+	if len(points) == 0 {
+		return nil
+	}
+	path := make(appRetirement.GlidePath, len(points))
+	for i, point := range points {
+		path[i] = appRetirement.GlidePathPoint{
+			Age:        point.Age,
+			Allocation: appRetirement.AssetAllocation{Stocks: point.Stocks, Bonds: point.Bonds, Cash: point.Cash},
+		}
+	}
+	return path
 }
 
 // toResultsResponse converts service results to DTO response
 func (h *CashFlowHandler) toResultsResponse(results *appRetirement.CashFlowResults) *dto.CashFlowResultsResponse {
-	// Convert yearly flows
+	// Convert yearly flows; the first year is this calendar year
+	startYear := time.Now().Year()
 	yearlyFlows := make([]dto.YearCashFlowResponse, len(results.YearlyFlows))
 	for i, flow := range results.YearlyFlows {
-		yearlyFlows[i] = dto.YearCashFlowResponse{
-			Year: flow.Year,
-			Age:  flow.Age,
-			Income: dto.IncomeBreakdownResponse{
-				EmploymentIncome: flow.EmploymentIncome,
-				SocialSecurity:   flow.SocialSecurity,
-				Pension:          flow.Pension,
-				InvestmentIncome: flow.InvestmentIncome,
-				RentalIncome:     flow.RentalIncome,
-				OtherIncome:      flow.OtherIncome,
-				TotalIncome:      flow.TotalIncome,
-			},
-			Withdrawals: dto.AccountWithdrawalsResponse{
-				TaxableWithdrawal:     flow.TaxableWithdrawal,
-				TraditionalWithdrawal: flow.TraditionalWithdrawal,
-				RothWithdrawal:        flow.RothWithdrawal,
-				HSAWithdrawal:         flow.HSAWithdrawal,
-				TotalWithdrawals:      flow.TotalWithdrawals,
-			},
-			Expenses: dto.ExpenseBreakdownResponse{
-				HousingExpense:        flow.HousingExpense,
-				HealthcareExpense:     flow.HealthcareExpense,
-				FoodExpense:           flow.FoodExpense,
-				TransportationExpense: flow.TransportationExpense,
-				UtilitiesExpense:      flow.UtilitiesExpense,
-				InsuranceExpense:      flow.InsuranceExpense,
-				DiscretionaryExpense:  flow.DiscretionaryExpense,
-				OtherExpenses:         flow.OtherExpenses,
-				TotalExpenses:         flow.TotalExpenses,
-			},
-			Taxes: dto.TaxBreakdownResponse{
-				FederalTax:      flow.FederalTax,
-				StateTax:        flow.StateTax,
-				FICATax:         flow.FICATax,
-				CapitalGainsTax: flow.CapitalGainsTax,
-				TotalTax:        flow.TotalTax,
-			},
-			Savings: dto.AccountContributionsResponse{
-				TaxableContribution:     flow.TaxableSavings,
-				TraditionalContribution: flow.TraditionalSavings,
-				RothContribution:        flow.RothSavings,
-				HSAContribution:         flow.HSASavings,
-				TotalContributions:      flow.TotalSavings,
-			},
-			NetCashFlow:       flow.NetCashFlow,
-			CumulativeSurplus: flow.CumulativeSurplus,
-			TotalPortfolio:    flow.TotalPortfolio,
-			IsRetired:         flow.IsRetired,
-		}
+		yearlyFlows[i] = toYearCashFlowResponse(flow, startYear)
 	}
 
 	// Convert Sankey data
@@ -614,16 +1241,312 @@ func (h *CashFlowHandler) toResultsResponse(results *appRetirement.CashFlowResul
 		TotalLifetimeTax:         results.TotalLifetimeTax,
 		TotalLifetimeSavings:     results.TotalLifetimeSavings,
 		TotalLifetimeWithdrawals: results.TotalLifetimeWithdrawals,
+		LifetimeTaxAnalysis:      h.toTaxImpactResponse(results.LifetimeTaxAnalysis),
 		AverageEffectiveTaxRate:  results.AverageEffectiveTaxRate,
 		AccumulationSankey:       accumulationSankey,
 		RetirementSankey:         retirementSankey,
 		YearsOfData:              results.YearsOfData,
 		RetirementReadiness:      results.RetirementReadiness,
 		ExpensesCoveredYears:     results.ExpensesCoveredYears,
+		Spending:                 toSpendingVolatilityResponse(results.Spending),
 		CalculationDurationMs:    results.Duration.Milliseconds(),
 	}
 }
 
+// toYearCashFlowResponse converts a year's cash flows to response format;
+// startYear is the calendar year of the first year
+func toYearCashFlowResponse(flow appRetirement.YearCashFlow, startYear int) dto.YearCashFlowResponse {
+	response := dto.YearCashFlowResponse{
+		Year: flow.Year,
+		Age:  flow.Age,
+		Income: dto.IncomeBreakdownResponse{
+			EmploymentIncome: flow.EmploymentIncome,
+			SocialSecurity:   flow.SocialSecurity,
+			Pension:          flow.Pension,
+			InvestmentIncome: flow.InvestmentIncome,
+			RentalIncome:     flow.RentalIncome,
+			OtherIncome:      flow.OtherIncome,
+			TotalIncome:      flow.TotalIncome,
+
+			SelfEmploymentIncome: flow.SelfEmploymentIncome,
+
+			SpouseEmploymentIncome: flow.SpouseEmploymentIncome,
+			SpouseSocialSecurity:   flow.SpouseSocialSecurity,
+			SpousePension:          flow.SpousePension,
+			PensionLumpSum:         flow.PensionLumpSum,
+			RentalSaleProceeds:     flow.RentalSaleProceeds,
+
+			Windfall:                 flow.Windfall,
+			TaxableWindfall:          flow.TaxableWindfall,
+			InheritedIRADistribution: flow.InheritedIRADistribution,
+
+			InheritedRothDistribution: flow.InheritedRothDistribution,
+		},
+		Withdrawals: dto.AccountWithdrawalsResponse{
+			TaxableWithdrawal:     flow.TaxableWithdrawal,
+			TraditionalWithdrawal: flow.TraditionalWithdrawal,
+			RothWithdrawal:        flow.RothWithdrawal,
+			HSAWithdrawal:         flow.HSAWithdrawal,
+			TotalWithdrawals:      flow.TotalWithdrawals,
+
+			RequiredMinimumDistribution: flow.RequiredMinimumDistribution,
+
+			RothConversion: flow.RothConversion,
+		},
+		Expenses: dto.ExpenseBreakdownResponse{
+			HousingExpense:        flow.HousingExpense,
+			HealthcareExpense:     flow.HealthcareExpense,
+			FoodExpense:           flow.FoodExpense,
+			TransportationExpense: flow.TransportationExpense,
+			UtilitiesExpense:      flow.UtilitiesExpense,
+			InsuranceExpense:      flow.InsuranceExpense,
+			DiscretionaryExpense:  flow.DiscretionaryExpense,
+			OtherExpenses:         flow.OtherExpenses,
+			TotalExpenses:         flow.TotalExpenses,
+
+			IRMAASurcharge: flow.IRMAASurcharge,
+			IRMAATier:      flow.IRMAATier,
+
+			DebtPayments:     flow.DebtPayments,
+			MortgagePayments: flow.MortgagePayments,
+			DebtInterest:     flow.DebtInterest,
+
+			LongTermCareCost:    flow.LongTermCareCost,
+			LongTermCareBenefit: flow.LongTermCareBenefit,
+			LongTermCarePremium: flow.LongTermCarePremium,
+
+			ACAPremium:          flow.ACAPremium,
+			ACAPremiumTaxCredit: flow.ACAPremiumTaxCredit,
+		},
+		Taxes: dto.TaxBreakdownResponse{
+			FederalTax:      flow.FederalTax,
+			StateTax:        flow.StateTax,
+			FICATax:         flow.FICATax,
+			CapitalGainsTax: flow.CapitalGainsTax,
+			TotalTax:        flow.TotalTax,
+			RMDTax:          flow.RMDTax,
+
+			TaxableSocialSecurity: flow.TaxableSocialSecurity,
+			MAGI:                  flow.MAGI,
+
+			SelfEmploymentTax:    flow.SelfEmploymentTax,
+			QBIDeduction:         flow.QBIDeduction,
+			EstimatedTaxPayments: toEstimatedTaxPaymentResponses(flow.EstimatedTaxDue, startYear+flow.Year-1),
+
+			RentalDepreciation:    flow.RentalDepreciation,
+			RentalSaleGain:        flow.RentalSaleGain,
+			DepreciationRecapture: flow.DepreciationRecapture,
+
+			ACASubsidyHeadroom: flow.ACASubsidyHeadroom,
+		},
+		Savings: dto.AccountContributionsResponse{
+			TaxableContribution:     flow.TaxableSavings,
+			TraditionalContribution: flow.TraditionalSavings,
+			RothContribution:        flow.RothSavings,
+			HSAContribution:         flow.HSASavings,
+			TotalContributions:      flow.TotalSavings,
+		},
+		NetCashFlow:       flow.NetCashFlow,
+		CumulativeSurplus: flow.CumulativeSurplus,
+		TotalPortfolio:    flow.TotalPortfolio,
+		IsRetired:         flow.IsRetired,
+		SpouseAge:         flow.SpouseAge,
+		Survivor:          flow.Survivor,
+		RentalEquity:      flow.RentalEquity,
+		NetWorth:          flow.NetWorth,
+		DebtBalance:       flow.DebtBalance,
+		PortfolioReturn:   flow.PortfolioReturn,
+
+		Spending:           flow.Spending,
+		SpendingAdjustment: flow.SpendingAdjustment,
+
+		InheritedIRABalance:  flow.InheritedIRABalance,
+		InheritedRothBalance: flow.InheritedRothBalance,
+	}
+	if flow.Allocation != (appRetirement.AssetAllocation{}) {
+		response.Allocation = &dto.AssetAllocationResponse{
+			Stocks: flow.Allocation.Stocks,
+			Bonds:  flow.Allocation.Bonds,
+			Cash:   flow.Allocation.Cash,
+		}
+	}
+	return response
+}
+
+// toTaxImpactResponse converts a tax impact analysis to response format
+func (h *CashFlowHandler) toTaxImpactResponse(analysis appRetirement.TaxImpactAnalysis) dto.TaxImpactResponse {
+	return dto.TaxImpactResponse{
+		GrossIncome:                 analysis.GrossIncome,
+		TaxableIncome:               analysis.TaxableIncome,
+		EffectiveTaxRate:            analysis.EffectiveTaxRate,
+		MarginalTaxRate:             analysis.MarginalTaxRate,
+		TotalTaxLiability:           analysis.TotalTaxLiability,
+		FederalTax:                  analysis.FederalTax,
+		StateTax:                    analysis.StateTax,
+		FICATax:                     analysis.FICATax,
+		CapitalGainsTax:             analysis.CapitalGainsTax,
+		TraditionalTaxSavings:       analysis.TraditionalTaxSavings,
+		RothTaxBenefit:              analysis.RothTaxBenefit,
+		HSATaxBenefit:               analysis.HSATaxBenefit,
+		RothConversionOpportunity:   analysis.RothConversionOpportunity,
+		TaxLossHarvestingAmount:     analysis.TaxLossHarvestingAmount,
+		AdditionalTraditionalRoom:   analysis.AdditionalTraditionalRoom,
+		RequiredMinimumDistribution: analysis.RequiredMinimumDistribution,
+		RMDTax:                      analysis.RMDTax,
+		TaxableSocialSecurity:       analysis.TaxableSocialSecurity,
+		SelfEmploymentTax:           analysis.SelfEmploymentTax,
+		QBIDeduction:                analysis.QBIDeduction,
+	}
+}
+
+// toEstimatedTaxPaymentResponses converts a year's estimated tax to its
+// quarterly payments
+func toEstimatedTaxPaymentResponses(annual float64, taxYear int) []dto.EstimatedTaxPaymentResponse {
+	schedule := appRetirement.EstimatedTaxSchedule(annual, taxYear)
+	if len(schedule) == 0 {
+		return nil
+	}
+	payments := make([]dto.EstimatedTaxPaymentResponse, len(schedule))
+	for i, payment := range schedule {
+		payments[i] = dto.EstimatedTaxPaymentResponse{
+			Quarter: payment.Quarter,
+			DueDate: payment.DueDate.Format("2006-01-02"),
+			Amount:  payment.Amount,
+		}
+	}
+	return payments
+}
+
+// toMonteCarloConfig converts Monte Carlo settings to service settings,
+// filling in defaults for anything omitted
+func (h *CashFlowHandler) toMonteCarloConfig(req *dto.CashFlowMonteCarloRequest) appRetirement.CashFlowMonteCarloConfig {
+	config := appRetirement.DefaultCashFlowMonteCarloConfig()
+	if req.Iterations > 0 {
+		config.Iterations = req.Iterations
+	}
+	if req.ReturnDistribution != "" {
+		config.ReturnDistribution = appRetirement.Distribution(req.ReturnDistribution)
+	}
+	if req.ReturnStdDev != nil {
+		config.ReturnStdDev = *req.ReturnStdDev
+	}
+	if req.InflationDistribution != "" {
+		config.InflationDistribution = appRetirement.Distribution(req.InflationDistribution)
+	}
+	if req.InflationStdDev != nil {
+		config.InflationStdDev = *req.InflationStdDev
+	}
+	if req.SequenceRiskYears > 0 {
+		config.SequenceRiskYears = req.SequenceRiskYears
+	}
+	config.Seed = req.Seed
+	return config
+}
+
+// toMonteCarloResponse converts Monte Carlo results to response format
+func (h *CashFlowHandler) toMonteCarloResponse(results *appRetirement.CashFlowMonteCarloResults) *dto.CashFlowMonteCarloResponse {
+	paths := make([]dto.PortfolioPathPointResponse, len(results.PortfolioPaths))
+	for i, point := range results.PortfolioPaths {
+		paths[i] = dto.PortfolioPathPointResponse{
+			Year:        point.Year,
+			Age:         point.Age,
+			Percentiles: toPercentileResponse(point.Percentiles),
+		}
+	}
+
+	risk := results.SequenceRisk
+	return &dto.CashFlowMonteCarloResponse{
+		SuccessProbability: results.SuccessProbability,
+		SuccessCount:       results.SuccessCount,
+		TotalSimulations:   results.TotalSimulations,
+		PortfolioPaths:     paths,
+		FinalPercentiles:   toPercentileResponse(results.FinalPercentiles),
+		SequenceRisk: dto.SequenceRiskResponse{
+			Years:                           risk.Years,
+			WorstQuartileSuccessProbability: risk.WorstQuartileSuccessProbability,
+			BestQuartileSuccessProbability:  risk.BestQuartileSuccessProbability,
+			WorstQuartileEarlyReturn:        risk.WorstQuartileEarlyReturn,
+			BestQuartileEarlyReturn:         risk.BestQuartileEarlyReturn,
+			EarlyReturnCorrelation:          risk.EarlyReturnCorrelation,
+			AverageDepletionAge:             risk.AverageDepletionAge,
+		},
+		Spending:              toSpendingVolatilityResponse(results.Spending),
+		CalculationDurationMs: results.Duration.Milliseconds(),
+	}
+}
+
+// toHistoricalResponse converts historical backtest results to response
+// format
+func (h *CashFlowHandler) toHistoricalResponse(results *appRetirement.HistoricalBacktestResults) *dto.CashFlowHistoricalResponse {
+	paths := make([]dto.PortfolioPathPointResponse, len(results.PortfolioPaths))
+	for i, point := range results.PortfolioPaths {
+		paths[i] = dto.PortfolioPathPointResponse{
+			Year:        point.Year,
+			Age:         point.Age,
+			Percentiles: toPercentileResponse(point.Percentiles),
+		}
+	}
+	windows := make([]dto.HistoricalWindowResponse, len(results.Windows))
+	for i, window := range results.Windows {
+		windows[i] = toHistoricalWindowResponse(window)
+	}
+	failed := results.FailedStartYears
+	if failed == nil {
+		failed = []int{}
+	}
+
+	return &dto.CashFlowHistoricalResponse{
+		WindowYears:           results.WindowYears,
+		SuccessRate:           results.SuccessRate,
+		SuccessCount:          results.SuccessCount,
+		TotalWindows:          results.TotalWindows,
+		Best:                  toHistoricalWindowResponse(results.Best),
+		Median:                toHistoricalWindowResponse(results.Median),
+		Worst:                 toHistoricalWindowResponse(results.Worst),
+		FailedStartYears:      failed,
+		Windows:               windows,
+		PortfolioPaths:        paths,
+		FinalPercentiles:      toPercentileResponse(results.FinalPercentiles),
+		CalculationDurationMs: results.Duration.Milliseconds(),
+	}
+}
+
+// toSpendingVolatilityResponse converts how retired spending varied
+func toSpendingVolatilityResponse(spending appRetirement.SpendingVolatility) dto.SpendingVolatilityResponse {
+	return dto.SpendingVolatilityResponse{
+		Volatility:          spending.Volatility,
+		LowestSpendingRatio: spending.LowestSpendingRatio,
+		LargestCut:          spending.LargestCut,
+	}
+}
+
+// toHistoricalWindowResponse converts a window's outcome to response format
+func toHistoricalWindowResponse(w appRetirement.HistoricalWindow) dto.HistoricalWindowResponse {
+	return dto.HistoricalWindowResponse{
+		StartYear:            w.StartYear,
+		EndYear:              w.EndYear,
+		Success:              w.Success,
+		DepletionAge:         w.DepletionAge,
+		FinalPortfolio:       w.FinalPortfolio,
+		LowestPortfolio:      w.LowestPortfolio,
+		LowestPortfolioAge:   w.LowestPortfolioAge,
+		AnnualizedRealReturn: w.AnnualizedRealReturn,
+	}
+}
+
+// toPercentileResponse converts percentile values to response format
+func toPercentileResponse(p appRetirement.PercentileResults) dto.PercentileResultsResponse {
+	return dto.PercentileResultsResponse{
+		P5:  p.P5,
+		P10: p.P10,
+		P25: p.P25,
+		P50: p.P50,
+		P75: p.P75,
+		P90: p.P90,
+		P95: p.P95,
+	}
+}
+
 // toSankeyResponse converts Sankey data to response format
 func (h *CashFlowHandler) toSankeyResponse(data appRetirement.SankeyData) dto.SankeyDataResponse {
 	nodes := make([]dto.SankeyNodeResponse, len(data.Nodes))
@@ -652,6 +1575,55 @@ func (h *CashFlowHandler) toSankeyResponse(data appRetirement.SankeyData) dto.Sa
 	}
 }
 
+// includeTables reports whether the request asks for data tables alongside
+// chart data with include_tables=true
+func includeTables(r *http.Request) bool {
+	include, _ := strconv.ParseBool(r.URL.Query().Get("include_tables"))
+	return include
+}
+
+// withSankeyTables returns a copy of analysis whose Sankey diagrams carry
+// data tables. The stored analysis is left as is.
+func withSankeyTables(analysis *CashFlowAnalysis) *CashFlowAnalysis {
+	if analysis.Results == nil {
+		return analysis
+	}
+
+	shaped := *analysis
+	results := *analysis.Results
+	results.AccumulationSankey = sankeyWithTable(results.AccumulationSankey)
+	results.RetirementSankey = sankeyWithTable(results.RetirementSankey)
+	shaped.Results = &results
+	return &shaped
+}
+
+// sankeyWithTable returns a copy of data with a table listing each flow,
+// so screen readers need not follow links between nodes
+func sankeyWithTable(data dto.SankeyDataResponse) dto.SankeyDataResponse {
+	labels := make(map[string]string, len(data.Nodes))
+	for _, node := range data.Nodes {
+		labels[node.ID] = node.Label
+	}
+	label := func(id string) string {
+		if l, ok := labels[id]; ok && l != "" {
+			return l
+		}
+		return id
+	}
+
+	table := &dto.DataTableResponse{
+		Caption: "Cash flows",
+		Headers: []string{"From", "To", "Amount"},
+		Rows:    make([][]string, len(data.Links)),
+	}
+	for i, link := range data.Links {
+		table.Rows[i] = []string{label(link.Source), label(link.Target), strconv.FormatFloat(link.Value, 'f', 2, 64)}
+	}
+
+	data.Table = table
+	return data
+}
+
 // validateCreateRequest validates the create request
 func (h *CashFlowHandler) validateCreateRequest(req *CreateCashFlowRequest) error {
 	if req.PlanID == "" {
@@ -690,6 +1662,364 @@ func (h *CashFlowHandler) validateConfig(config *CashFlowAnalysisConfig) error {
 		(config.SocialSecurityStartAge < 62 || config.SocialSecurityStartAge > 70) {
 		return newValidationError("social_security_start_age must be between 62 and 70")
 	}
+	if config.SelfEmploymentIncome < 0 {
+		return newValidationError("self_employment_income cannot be negative")
+	}
+	if config.PartTimeIncome < 0 || config.PartTimeEndAge < 0 {
+		return newValidationError("part_time_income and part_time_end_age cannot be negative")
+	}
+	if config.Spouse != nil {
+		if err := validateSpouseConfig(config.Spouse); err != nil {
+			return err
+		}
+	}
+	for _, pension := range config.Pensions {
+		if err := validatePensionConfig(pension, config.Spouse != nil); err != nil {
+			return err
+		}
+	}
+	for _, property := range config.RentalProperties {
+		if err := validateRentalPropertyConfig(property, config); err != nil {
+			return err
+		}
+	}
+	for _, debt := range config.Debts {
+		if err := validateDebtConfig(debt); err != nil {
+			return err
+		}
+	}
+	if config.Allocation != nil {
+		if err := validateAllocationConfig(config.Allocation); err != nil {
+			return err
+		}
+	}
+	if config.LongTermCare != nil {
+		if err := validateLongTermCareConfig(config.LongTermCare, config); err != nil {
+			return err
+		}
+	}
+	if err := validateSpendingStrategyConfig(config.SpendingStrategy, config.SpendingRules); err != nil {
+		return err
+	}
+	for _, windfall := range config.Windfalls {
+		if err := validateWindfallConfig(windfall, config); err != nil {
+			return err
+		}
+	}
+	if err := validateInheritedIRAConfig(config); err != nil {
+		return err
+	}
+	if config.ACA != nil {
+		if err := validateACAConfig(config.ACA); err != nil {
+			return err
+		}
+	}
+	if config.RothConversionAmount < 0 {
+		return newValidationError("roth_conversion_amount cannot be negative")
+	}
+	if config.SocialSecurityEstimate != nil {
+		if err := toSocialSecurityInput(config.SocialSecurityEstimate).Validate(); err != nil {
+			return newValidationError("social_security_estimate: " + err.Error())
+		}
+	}
+	if _, ok := appRetirement.StateTaxRulesFor(config.StateCode); config.StateCode != "" && !ok {
+		return newValidationError("state_code must be a US state code")
+	}
+	for _, multiplier := range config.RetirementSpendingShape {
+		if multiplier < 0 {
+			return newValidationError("retirement_spending_shape multipliers cannot be negative")
+		}
+	}
+	return nil
+}
+
+// validateSpouseConfig validates the spouse's part of a joint analysis
+func validateSpouseConfig(spouse *SpouseAnalysisConfig) error {
+	if spouse.CurrentAge < 1 || spouse.CurrentAge > 120 {
+		return newValidationError("spouse.current_age must be between 1 and 120")
+	}
+	if spouse.RetirementAge < spouse.CurrentAge {
+		return newValidationError("spouse.retirement_age must be at least spouse.current_age")
+	}
+	if spouse.DeathAge != 0 && spouse.DeathAge <= spouse.CurrentAge {
+		return newValidationError("spouse.death_age must be greater than spouse.current_age")
+	}
+	if spouse.SocialSecurityStartAge != 0 &&
+		(spouse.SocialSecurityStartAge < 62 || spouse.SocialSecurityStartAge > 70) {
+		return newValidationError("spouse.social_security_start_age must be between 62 and 70")
+	}
+	if spouse.PensionSurvivorShare < 0 || spouse.PensionSurvivorShare > 1 {
+		return newValidationError("spouse.pension_survivor_share must be between 0 and 1")
+	}
+	if spouse.SurvivorExpenseReduction < 0 || spouse.SurvivorExpenseReduction > 1 {
+		return newValidationError("spouse.survivor_expense_reduction must be between 0 and 1")
+	}
+	return nil
+}
+
+// validatePensionConfig validates a pension in an analysis
+func validatePensionConfig(pension PensionAnalysisConfig, hasSpouse bool) error {
+	if pension.Spouse && !hasSpouse {
+		return newValidationError("pensions: a spouse's pension needs a spouse")
+	}
+	if pension.StartAge < 1 || pension.StartAge > 120 {
+		return newValidationError("pensions: start_age must be between 1 and 120")
+	}
+	if pension.AnnualBenefit < 0 || pension.LumpSum < 0 {
+		return newValidationError("pensions: annual_benefit and lump_sum cannot be negative")
+	}
+	if pension.COLA < 0 || pension.COLA > 0.1 {
+		return newValidationError("pensions: cola must be between 0 and 0.1")
+	}
+	if pension.SurvivorShare < 0 || pension.SurvivorShare > 1 {
+		return newValidationError("pensions: survivor_share must be between 0 and 1")
+	}
+	if pension.TakeLumpSum && pension.LumpSum <= 0 {
+		return newValidationError("pensions: take_lump_sum needs a lump_sum")
+	}
+	return nil
+}
+
+// validateRentalPropertyConfig validates a rental property in an analysis
+func validateRentalPropertyConfig(property RentalPropertyAnalysisConfig, config *CashFlowAnalysisConfig) error {
+	for _, amount := range []float64{property.Value, property.GrossRent, property.OperatingExpenses, property.MortgageBalance,
+		property.MortgagePayment, property.CostBasis, property.DepreciableBasis, property.AccumulatedDepreciation} {
+		if amount < 0 {
+			return newValidationError("rental_properties: amounts cannot be negative")
+		}
+	}
+	if property.DepreciableBasis > property.CostBasis || property.AccumulatedDepreciation > property.DepreciableBasis {
+		return newValidationError("rental_properties: depreciable_basis must be within cost_basis and accumulated_depreciation within depreciable_basis")
+	}
+	if property.MortgageBalance > 0 && property.MortgagePayment <= property.MortgageBalance*property.MortgageRate {
+		return newValidationError("rental_properties: mortgage_payment must be more than a year's interest")
+	}
+	if property.SaleAge != 0 && (property.SaleAge < config.CurrentAge || property.SaleAge >= config.LifeExpectancy) {
+		return newValidationError("rental_properties: sale_age must be between current_age and life_expectancy")
+	}
+	return nil
+}
+
+// validateDebtConfig validates a loan in an analysis
+func validateDebtConfig(debt DebtAnalysisConfig) error {
+	switch appRetirement.DebtType(debt.Type) {
+	case appRetirement.DebtMortgage, appRetirement.DebtStudentLoan, appRetirement.DebtAutoLoan, appRetirement.DebtOther:
+	default:
+		return newValidationError("debts: type must be one of: mortgage, student_loan, auto_loan, other")
+	}
+	if debt.Balance < 0 || debt.ExtraPayment < 0 {
+		return newValidationError("debts: balance and extra_payment cannot be negative")
+	}
+	if debt.Rate < 0 || debt.Rate > 1 {
+		return newValidationError("debts: rate must be between 0 and 1")
+	}
+	if debt.TermYears < 1 || debt.TermYears > 50 {
+		return newValidationError("debts: term_years must be between 1 and 50")
+	}
+	return nil
+}
+
+// validateAllocationConfig validates an analysis's assumptions and glide
+// paths
+func validateAllocationConfig(allocation *AllocationAnalysisConfig) error {
+	if (len(allocation.GlidePath) == 0) == (allocation.BondTent == nil) {
+		return newValidationError("allocation: set exactly one of glide_path and bond_tent")
+	}
+	if tent := allocation.BondTent; tent != nil {
+		if tent.Years < 1 || tent.Years > 30 {
+			return newValidationError("allocation: bond_tent years must be between 1 and 30")
+		}
+		if tent.BaseStocks < 0 || tent.BaseStocks > 1 || tent.RetirementStocks < 0 || tent.RetirementStocks > 1 {
+			return newValidationError("allocation: bond_tent stock shares must be between 0 and 1")
+		}
+	}
+	if source := allocation.AssumptionsSource; source != nil && source.Provider == "" {
+		return newValidationError("allocation: assumptions_source provider is required")
+	}
+	if a := allocation.Assumptions; a != nil {
+		if err := toMarketAssumptions(a).Validate(); err != nil {
+			return newValidationError("allocation: " + err.Error())
+		}
+	}
+	paths := []struct {
+		name   string
+		points []GlidePathPointConfig
+	}{
+		{"glide_path", allocation.GlidePath},
+		{"taxable_glide_path", allocation.TaxableGlidePath},
+		{"traditional_glide_path", allocation.TraditionalGlidePath},
+		{"roth_glide_path", allocation.RothGlidePath},
+		{"hsa_glide_path", allocation.HSAGlidePath},
+	}
+	for _, path := range paths {
+		name := path.name
+		for i, point := range path.points {
+			if i > 0 && point.Age <= path.points[i-1].Age {
+				return newValidationError("allocation: " + name + " ages must increase")
+			}
+			if point.Stocks < 0 || point.Bonds < 0 || point.Cash < 0 ||
+				math.Abs(point.Stocks+point.Bonds+point.Cash-1) > 1e-6 {
+				return newValidationError("allocation: " + name + " shares must be non-negative and add up to 1")
+			}
+		}
+	}
+	return nil
+}
+
+// validateLongTermCareConfig validates a care event against its analysis
+func validateLongTermCareConfig(ltc *LongTermCareAnalysisConfig, config *CashFlowAnalysisConfig) error {
+	if ltc.AnnualCost < 0 {
+		return newValidationError("long_term_care: annual_cost cannot be negative")
+	}
+	if ltc.DurationYears < 1 || ltc.DurationYears > 30 {
+		return newValidationError("long_term_care: duration_years must be between 1 and 30")
+	}
+	if ltc.StartAge != 0 && (ltc.StartAge < config.CurrentAge || ltc.StartAge >= config.LifeExpectancy) {
+		return newValidationError("long_term_care: start_age must be between current_age and life_expectancy")
+	}
+	if ltc.Probability < 0 || ltc.Probability > 1 {
+		return newValidationError("long_term_care: probability must be between 0 and 1")
+	}
+	if ltc.Probability > 0 && (ltc.EarliestAge < config.CurrentAge || ltc.LatestAge < ltc.EarliestAge ||
+		ltc.LatestAge >= config.LifeExpectancy) {
+		return newValidationError("long_term_care: earliest_age and latest_age must be in order between current_age and life_expectancy")
+	}
+	if p := ltc.Insurance; p != nil {
+		if p.AnnualPremium < 0 || p.AnnualBenefit < 0 || p.BenefitYears < 0 {
+			return newValidationError("long_term_care: insurance amounts cannot be negative")
+		}
+		if p.BenefitGrowth < 0 || p.BenefitGrowth > 0.1 {
+			return newValidationError("long_term_care: insurance benefit_growth must be between 0 and 0.1")
+		}
+	}
+	return nil
+}
+
+// validateSpendingStrategyConfig validates a spending strategy and its
+// parameters
+func validateSpendingStrategyConfig(strategy string, rules *SpendingRulesConfig) error {
+	switch appRetirement.SpendingStrategy(strategy) {
+	case "", appRetirement.SpendingPlanned, appRetirement.SpendingFixedPercentage,
+		appRetirement.SpendingGuardrails, appRetirement.SpendingVPW:
+	default:
+		return newValidationError("spending_strategy must be one of: planned, fixed_percentage, guardrails, vpw")
+	}
+	if rules == nil {
+		return nil
+	}
+	if rules.WithdrawalRate < 0 || rules.WithdrawalRate > 0.2 {
+		return newValidationError("spending_rules: withdrawal_rate must be between 0 and 0.2")
+	}
+	if rules.GuardrailBand < 0 || rules.GuardrailBand > 1 {
+		return newValidationError("spending_rules: guardrail_band must be between 0 and 1")
+	}
+	if rules.GuardrailAdjustment < 0 || rules.GuardrailAdjustment > 0.5 {
+		return newValidationError("spending_rules: guardrail_adjustment must be between 0 and 0.5")
+	}
+	if rules.GuardrailFinalYears < 0 {
+		return newValidationError("spending_rules: guardrail_final_years cannot be negative")
+	}
+	if rules.VPWReturn < -0.05 || rules.VPWReturn > 0.15 {
+		return newValidationError("spending_rules: vpw_return must be between -0.05 and 0.15")
+	}
+	return nil
+}
+
+// validateWindfallConfig validates a one-time inflow against its analysis
+func validateWindfallConfig(windfall WindfallAnalysisConfig, config *CashFlowAnalysisConfig) error {
+	if windfall.Amount < 0 {
+		return newValidationError("windfalls: amount cannot be negative")
+	}
+	if windfall.Age < config.CurrentAge || windfall.Age >= config.LifeExpectancy {
+		return newValidationError("windfalls: age must be between current_age and life_expectancy")
+	}
+	switch appRetirement.WindfallAccount(windfall.Account) {
+	case appRetirement.WindfallTaxable:
+	case appRetirement.WindfallInheritedIRA, appRetirement.WindfallInheritedRoth:
+		if windfall.Taxable {
+			return newValidationError("windfalls: an inherited IRA is taxed, if at all, as it is distributed, not when received")
+		}
+	default:
+		return newValidationError("windfalls: account must be taxable, inherited_ira or inherited_roth")
+	}
+	return nil
+}
+
+// validateInheritedIRAConfig validates the inherited IRAs held and how
+// they are spread
+func validateInheritedIRAConfig(config *CashFlowAnalysisConfig) error {
+	for _, ira := range config.InheritedIRAs {
+		if ira.Balance < 0 {
+			return newValidationError("inherited_iras: balance cannot be negative")
+		}
+		if ira.YearsLeft < 0 || ira.YearsLeft > 10 {
+			return newValidationError("inherited_iras: years_left must be between 0 and 10")
+		}
+	}
+	switch appRetirement.InheritedIRAStrategy(config.InheritedIRAStrategy) {
+	case "", appRetirement.InheritedIRAEven, appRetirement.InheritedIRADeferred, appRetirement.InheritedIRAFillBracket:
+	default:
+		return newValidationError("inherited_ira_strategy must be even, deferred or fill_bracket")
+	}
+	if config.InheritedIRATargetRate < 0 || config.InheritedIRATargetRate >= 1 {
+		return newValidationError("inherited_ira_target_rate must be between 0 and 1")
+	}
+	return nil
+}
+
+// validateACAConfig validates marketplace premiums and subsidy rules
+func validateACAConfig(aca *ACAAnalysisConfig) error {
+	if aca.BenchmarkPremium < 0 || aca.Premium < 0 {
+		return newValidationError("aca: premiums cannot be negative")
+	}
+	rules := aca.SubsidyRules
+	if rules == nil {
+		return nil
+	}
+	if rules.PovertyLine <= 0 || rules.PovertyLinePerPerson < 0 {
+		return newValidationError("aca: subsidy_rules poverty_line must be positive")
+	}
+	if len(rules.Bands) == 0 || rules.Bands[0].Over != 0 {
+		return newValidationError("aca: subsidy_rules bands must start at 0")
+	}
+	for i, band := range rules.Bands {
+		if band.InitialRate < 0 || band.FinalRate < band.InitialRate || band.FinalRate > 1 {
+			return newValidationError("aca: subsidy_rules band rates must rise between 0 and 1")
+		}
+		if i > 0 && band.Over <= rules.Bands[i-1].Over {
+			return newValidationError("aca: subsidy_rules bands must be in order")
+		}
+	}
+	if rules.SubsidyCliff < 0 || (rules.SubsidyCliff > 0 && rules.SubsidyCliff <= rules.Bands[len(rules.Bands)-1].Over) {
+		return newValidationError("aca: subsidy_rules subsidy_cliff must be above the last band")
+	}
+	return nil
+}
+
+// maxMonteCarloIterations caps how many simulations one request may run
+const maxMonteCarloIterations = 10000
+
+// validateMonteCarloRequest validates Monte Carlo settings
+func (h *CashFlowHandler) validateMonteCarloRequest(req *dto.CashFlowMonteCarloRequest) error {
+	if req.Iterations < 0 || req.Iterations > maxMonteCarloIterations {
+		return newValidationError("iterations must be between 1 and 10000")
+	}
+	for _, d := range []string{req.ReturnDistribution, req.InflationDistribution} {
+		switch appRetirement.Distribution(d) {
+		case "", appRetirement.DistributionNormal, appRetirement.DistributionLognormal, appRetirement.DistributionHistorical:
+		default:
+			return newValidationError("distributions must be normal, lognormal or historical")
+		}
+	}
+	if req.ReturnStdDev != nil && *req.ReturnStdDev < 0 {
+		return newValidationError("return_std_dev cannot be negative")
+	}
+	if req.InflationStdDev != nil && *req.InflationStdDev < 0 {
+		return newValidationError("inflation_std_dev cannot be negative")
+	}
+	if req.SequenceRiskYears < 0 {
+		return newValidationError("sequence_risk_years cannot be negative")
+	}
 	return nil
 }
 
@@ -0,0 +1,69 @@
+package retirement
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"clockzen-next/internal/application/dto"
+	appRetirement "clockzen-next/internal/application/retirement"
+)
+
+// HandleDebtPayoff handles POST /api/retirement/cashflow/{id}/debt-payoff
+func (h *CashFlowHandler) HandleDebtPayoff(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	var req dto.DebtPayoffRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+	if req.ExtraPayment <= 0 {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "extra_payment must be positive")
+		return
+	}
+
+	h.mu.RLock()
+	analysis, exists := h.analyses[id]
+	var config CashFlowAnalysisConfig
+	if exists {
+		config = analysis.Config
+	}
+	h.mu.RUnlock()
+	if !exists {
+		h.writeError(w, http.StatusNotFound, "not_found", "Cash flow analysis not found")
+		return
+	}
+	if req.DebtIndex < 0 || req.DebtIndex >= len(config.Debts) {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "debt_index must refer to one of the analysis's debts")
+		return
+	}
+
+	svcConfig := h.toServiceConfig(&config)
+	service, err := appRetirement.NewCashFlowService(svcConfig)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+	comparison, err := service.CompareDebtPayoff(svcConfig, req.DebtIndex, req.ExtraPayment)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, &dto.DebtPayoffResponse{
+		CashFlowID:         id,
+		DebtName:           comparison.Debt.Name,
+		ExtraPayment:       comparison.ExtraPayment,
+		PayoffYears:        comparison.PayoffYears,
+		PrepaidPayoffYears: comparison.PrepaidPayoffYears,
+		InterestSaved:      comparison.InterestSaved,
+		PrepayWealth:       comparison.PrepayWealth,
+		InvestWealth:       comparison.InvestWealth,
+		PreferPrepay:       comparison.PreferPrepay,
+		Baseline:           toSpendingShapeOutcomeResponse(comparison.Baseline),
+		Prepay:             toSpendingShapeOutcomeResponse(comparison.Prepay),
+	})
+}
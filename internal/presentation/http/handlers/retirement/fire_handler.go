@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 
 	"clockzen-next/internal/application/dto"
+	"clockzen-next/internal/infrastructure/i18n"
 )
 
 // FIRECalculation represents a stored FIRE calculation
@@ -76,7 +77,7 @@ func (h *FIREHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
 
 	// Calculate FIRE result
 	result := h.calculateFIRE(&req.Request)
-	timeline := h.calculateTimeline(&req.Request, result)
+	timeline := h.calculateTimeline(i18n.FromContext(r.Context()), &req.Request, result)
 
 	now := time.Now()
 	calculation := &FIRECalculation{
@@ -197,7 +198,7 @@ func (h *FIREHandler) HandleUpdate(w http.ResponseWriter, r *http.Request, id st
 		calculation.Request = *req.Request
 		// Recalculate FIRE result
 		calculation.Result = h.calculateFIRE(&calculation.Request)
-		calculation.Timeline = h.calculateTimeline(&calculation.Request, calculation.Result)
+		calculation.Timeline = h.calculateTimeline(i18n.FromContext(r.Context()), &calculation.Request, calculation.Result)
 	}
 
 	calculation.UpdatedAt = time.Now()
@@ -245,7 +246,7 @@ func (h *FIREHandler) HandleCalculateForPlan(w http.ResponseWriter, r *http.Requ
 
 	// Calculate FIRE result
 	result := h.calculateFIRE(&req)
-	timeline := h.calculateTimeline(&req, result)
+	timeline := h.calculateTimeline(i18n.FromContext(r.Context()), &req, result)
 
 	now := time.Now()
 	calculation := &FIRECalculation{
@@ -429,7 +430,7 @@ func (h *FIREHandler) calculateYearsToTarget(current, annual, target, rate float
 }
 
 // calculateTimeline calculates the FIRE journey timeline
-func (h *FIREHandler) calculateTimeline(req *dto.FIRECalculationRequest, result *dto.FIREResultResponse) *dto.FIRETimelineResponse {
+func (h *FIREHandler) calculateTimeline(loc *i18n.Localizer, req *dto.FIRECalculationRequest, result *dto.FIREResultResponse) *dto.FIRETimelineResponse {
 	timeline := &dto.FIRETimelineResponse{
 		FIREResult: *result,
 	}
@@ -437,40 +438,40 @@ func (h *FIREHandler) calculateTimeline(req *dto.FIRECalculationRequest, result
 	// Create milestones
 	milestones := []dto.FIREMilestoneResponse{
 		{
-			Name:          "Emergency Fund",
+			Name:          loc.Message("retirement.milestone.emergency_fund.name", nil),
 			TargetAmount:  req.AnnualExpenses * 0.5, // 6 months expenses
 			CurrentAmount: min(req.TotalSavings, req.AnnualExpenses*0.5),
-			Description:   "6 months of expenses saved for emergencies",
+			Description:   loc.Message("retirement.milestone.emergency_fund.description", nil),
 		},
 		{
-			Name:          "Coast FIRE",
+			Name:          loc.Message("retirement.milestone.coast_fire.name", nil),
 			TargetAmount:  result.CoastFIRENumber,
 			CurrentAmount: req.TotalSavings,
-			Description:   "Enough saved to stop contributing and still reach FIRE by retirement",
+			Description:   loc.Message("retirement.milestone.coast_fire.description", nil),
 		},
 		{
-			Name:          "25% FIRE",
+			Name:          loc.Message("retirement.milestone.quarter_fire.name", nil),
 			TargetAmount:  result.FIRENumber * 0.25,
 			CurrentAmount: req.TotalSavings,
-			Description:   "Quarter of the way to financial independence",
+			Description:   loc.Message("retirement.milestone.quarter_fire.description", nil),
 		},
 		{
-			Name:          "50% FIRE",
+			Name:          loc.Message("retirement.milestone.half_fire.name", nil),
 			TargetAmount:  result.FIRENumber * 0.5,
 			CurrentAmount: req.TotalSavings,
-			Description:   "Halfway to financial independence",
+			Description:   loc.Message("retirement.milestone.half_fire.description", nil),
 		},
 		{
-			Name:          "75% FIRE",
+			Name:          loc.Message("retirement.milestone.three_quarter_fire.name", nil),
 			TargetAmount:  result.FIRENumber * 0.75,
 			CurrentAmount: req.TotalSavings,
-			Description:   "Three-quarters of the way to financial independence",
+			Description:   loc.Message("retirement.milestone.three_quarter_fire.description", nil),
 		},
 		{
-			Name:          "FIRE",
+			Name:          loc.Message("retirement.milestone.fire.name", nil),
 			TargetAmount:  result.FIRENumber,
 			CurrentAmount: req.TotalSavings,
-			Description:   "Financial independence achieved",
+			Description:   loc.Message("retirement.milestone.fire.description", nil),
 		},
 	}
 
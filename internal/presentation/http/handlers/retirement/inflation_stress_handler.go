@@ -0,0 +1,133 @@
+package retirement
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"clockzen-next/internal/application/dto"
+	appRetirement "clockzen-next/internal/application/retirement"
+)
+
+// HandleInflationStress handles POST
+// /api/retirement/cashflow/{id}/inflation-stress
+func (h *CashFlowHandler) HandleInflationStress(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	// The body is optional; the default paths from the retirement age
+	var req dto.CashFlowInflationStressRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	h.mu.RLock()
+	analysis, exists := h.analyses[id]
+	var config CashFlowAnalysisConfig
+	if exists {
+		config = analysis.Config
+	}
+	h.mu.RUnlock()
+	if !exists {
+		h.writeError(w, http.StatusNotFound, "not_found", "Cash flow analysis not found")
+		return
+	}
+	if err := validateInflationStressRequest(&req, &config); err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	paths := make([]appRetirement.InflationPath, len(req.Paths))
+	for i, path := range req.Paths {
+		paths[i] = appRetirement.InflationPath{
+			Name:        path.Name,
+			Description: path.Description,
+			Rates:       path.Rates,
+			Sustained:   path.Sustained,
+		}
+	}
+
+	svcConfig := h.toServiceConfig(&config)
+	service, err := appRetirement.NewCashFlowService(svcConfig)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+	result, err := service.StressTestInflation(svcConfig, appRetirement.InflationStressOptions{
+		Paths:    paths,
+		StartAge: req.StartAge,
+	})
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	response := &dto.CashFlowInflationStressResponse{
+		CashFlowID:   id,
+		StartAge:     result.StartAge,
+		Baseline:     toInflationStressResultResponse(result.Baseline),
+		Paths:        make([]dto.CashFlowInflationStressResultResponse, len(result.Paths)),
+		FirstToBreak: result.FirstToBreak,
+	}
+	for i, path := range result.Paths {
+		response.Paths[i] = toInflationStressResultResponse(path)
+	}
+	h.writeJSON(w, http.StatusOK, response)
+}
+
+// validateInflationStressRequest validates inflation paths against their
+// analysis
+func validateInflationStressRequest(req *dto.CashFlowInflationStressRequest, config *CashFlowAnalysisConfig) error {
+	if len(req.Paths) > appRetirement.MaxInflationPaths {
+		return newValidationError(fmt.Sprintf("paths must list at most %d inflation paths", appRetirement.MaxInflationPaths))
+	}
+	names := make(map[string]bool, len(req.Paths))
+	for _, path := range req.Paths {
+		if path.Name == "" {
+			return newValidationError("paths: name is required")
+		}
+		if names[path.Name] {
+			return newValidationError(fmt.Sprintf("paths: name %q is used twice", path.Name))
+		}
+		names[path.Name] = true
+		if len(path.Rates) == 0 {
+			return newValidationError(fmt.Sprintf("paths: %s: rates are required", path.Name))
+		}
+		for _, rate := range path.Rates {
+			if rate <= -0.5 || rate > 1 {
+				return newValidationError(fmt.Sprintf("paths: %s: rates must be between -0.5 and 1", path.Name))
+			}
+		}
+	}
+	if req.StartAge != 0 && (req.StartAge < config.CurrentAge || req.StartAge >= config.LifeExpectancy) {
+		return newValidationError("start_age must be between current_age and life_expectancy")
+	}
+	return nil
+}
+
+// toInflationStressResultResponse converts a path's outcome to DTO response
+func toInflationStressResultResponse(result appRetirement.InflationStressResult) dto.CashFlowInflationStressResultResponse {
+	response := dto.CashFlowInflationStressResultResponse{
+		Path:               result.Path,
+		Description:        result.Description,
+		AverageInflation:   result.AverageInflation,
+		Success:            result.Success,
+		DepletionAge:       result.DepletionAge,
+		FinalPortfolio:     result.FinalPortfolio,
+		RealFinalPortfolio: result.RealFinalPortfolio,
+		TotalExpenses:      result.TotalExpenses,
+		BreakingExpenses:   make([]dto.CashFlowExpensePressureResponse, len(result.BreakingExpenses)),
+	}
+	for i, pressure := range result.BreakingExpenses {
+		response.BreakingExpenses[i] = dto.CashFlowExpensePressureResponse{
+			Category:  pressure.Category,
+			ExtraCost: pressure.ExtraCost,
+		}
+	}
+	return response
+}
@@ -0,0 +1,57 @@
+package retirement
+
+import (
+	"net/http"
+
+	"clockzen-next/internal/application/dto"
+	appRetirement "clockzen-next/internal/application/retirement"
+)
+
+// HandleInheritedIRA handles POST /api/retirement/cashflow/{id}/inherited-ira
+func (h *CashFlowHandler) HandleInheritedIRA(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	h.mu.RLock()
+	analysis, exists := h.analyses[id]
+	var config CashFlowAnalysisConfig
+	if exists {
+		config = analysis.Config
+	}
+	h.mu.RUnlock()
+	if !exists {
+		h.writeError(w, http.StatusNotFound, "not_found", "Cash flow analysis not found")
+		return
+	}
+
+	svcConfig := h.toServiceConfig(&config)
+	service, err := appRetirement.NewCashFlowService(svcConfig)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+	result, err := service.CompareInheritedIRAStrategies(svcConfig)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	strategies := make([]dto.InheritedIRAStrategyResponse, len(result.Outcomes))
+	for i, outcome := range result.Outcomes {
+		strategies[i] = dto.InheritedIRAStrategyResponse{
+			Strategy:            string(outcome.Strategy),
+			TargetRate:          outcome.TargetRate,
+			Distributions:       outcome.Distributions,
+			LargestDistribution: outcome.LargestDistribution,
+			Outcome:             toSpendingShapeOutcomeResponse(outcome.Outcome),
+		}
+	}
+
+	h.writeJSON(w, http.StatusOK, &dto.InheritedIRAResponse{
+		CashFlowID:  id,
+		Strategies:  strategies,
+		Recommended: strategies[result.Recommended],
+	})
+}
@@ -0,0 +1,72 @@
+package retirement
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"clockzen-next/internal/application/dto"
+	appRetirement "clockzen-next/internal/application/retirement"
+)
+
+// HandleLongTermCare handles POST /api/retirement/cashflow/{id}/long-term-care
+func (h *CashFlowHandler) HandleLongTermCare(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	// The body is optional Monte Carlo settings; every setting has a default
+	var req dto.CashFlowMonteCarloRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+	if err := h.validateMonteCarloRequest(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	h.mu.RLock()
+	analysis, exists := h.analyses[id]
+	var config CashFlowAnalysisConfig
+	if exists {
+		config = analysis.Config
+	}
+	h.mu.RUnlock()
+	if !exists {
+		h.writeError(w, http.StatusNotFound, "not_found", "Cash flow analysis not found")
+		return
+	}
+	if config.LongTermCare == nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "Cash flow analysis has no long_term_care event")
+		return
+	}
+
+	svcConfig := h.toServiceConfig(&config)
+	service, err := appRetirement.NewCashFlowService(svcConfig)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+	result, err := service.AnalyzeLongTermCare(r.Context(), svcConfig, h.toMonteCarloConfig(&req))
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, &dto.LongTermCareResponse{
+		CashFlowID:            id,
+		StartAge:              result.StartAge,
+		WithoutCare:           toSpendingShapeOutcomeResponse(result.WithoutCare),
+		WithCare:              toSpendingShapeOutcomeResponse(result.WithCare),
+		TotalCost:             result.TotalCost,
+		TotalBenefits:         result.TotalBenefits,
+		TotalPremiums:         result.TotalPremiums,
+		SuccessWithoutCare:    result.SuccessWithoutCare,
+		SuccessWithCare:       result.SuccessWithCare,
+		DepletionRiskIncrease: result.DepletionRiskIncrease,
+		RecommendedReserve:    result.RecommendedReserve,
+	})
+}
@@ -0,0 +1,181 @@
+package retirement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"clockzen-next/internal/application/dto"
+	appRetirement "clockzen-next/internal/application/retirement"
+)
+
+// UploadedAssumptionsProvider names the provider CSV uploads publish to
+const UploadedAssumptionsProvider = "upload"
+
+// maxAssumptionsCSVSize is the largest assumptions CSV accepted
+const maxAssumptionsCSVSize = 64 << 10
+
+// errAssumptionsUnavailable marks a provider that failed to answer, as
+// opposed to a request naming one that doesn't exist
+var errAssumptionsUnavailable = errors.New("market assumptions unavailable")
+
+// SetAssumptionProvider makes a provider's assumptions available to
+// analyses by its name, replacing any provider of the same name. Cached
+// results are dropped, since a replaced provider's versions may differ.
+func (h *CashFlowHandler) SetAssumptionProvider(provider appRetirement.AssumptionProvider) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.assumptionProviders[provider.Name()] = provider
+	h.results.Purge()
+	h.simulations.Purge()
+}
+
+// HandleUploadAssumptions handles POST /api/retirement/market-assumptions,
+// publishing a CSV of assumptions as the upload provider's next version
+func (h *CashFlowHandler) HandleUploadAssumptions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	assumptions, err := appRetirement.ParseMarketAssumptionsCSV(http.MaxBytesReader(w, r.Body, maxAssumptionsCSVSize))
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+	set, err := h.uploadedAssumptions.Publish(assumptions, time.Now())
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+	h.writeJSON(w, http.StatusCreated, toAssumptionSetResponse(set))
+}
+
+// HandleGetAssumptions handles GET
+// /api/retirement/market-assumptions/{provider}, the provider's latest
+// assumptions or those of ?version=
+func (h *CashFlowHandler) HandleGetAssumptions(w http.ResponseWriter, r *http.Request, provider string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	set, err := h.resolveAssumptions(r.Context(), provider, r.URL.Query().Get("version"))
+	if err != nil {
+		h.writeAssumptionsError(w, err)
+		return
+	}
+	h.writeJSON(w, http.StatusOK, toAssumptionSetResponse(set))
+}
+
+// pinAssumptions loads the assumptions of an analysis with an
+// assumptions_source into its config, recording the version used so the
+// analysis keeps projecting with them after the provider publishes more
+func (h *CashFlowHandler) pinAssumptions(ctx context.Context, config *CashFlowAnalysisConfig) error {
+	if config.Allocation == nil || config.Allocation.AssumptionsSource == nil {
+		return nil
+	}
+	set, err := h.resolveAssumptions(ctx, config.Allocation.AssumptionsSource.Provider, config.Allocation.AssumptionsSource.Version)
+	if err != nil {
+		return err
+	}
+
+	// Copy the allocation rather than write through to a stored analysis
+	// the config may share it with
+	allocation := *config.Allocation
+	source := *allocation.AssumptionsSource
+	source.Version = set.Version
+	allocation.AssumptionsSource = &source
+	allocation.Assumptions = toMarketAssumptionsConfig(set.Assumptions)
+	config.Allocation = &allocation
+	return nil
+}
+
+// resolveAssumptions returns a provider's version of the assumptions, its
+// latest when version is empty
+func (h *CashFlowHandler) resolveAssumptions(ctx context.Context, name, version string) (*appRetirement.AssumptionSet, error) {
+	h.mu.RLock()
+	provider, ok := h.assumptionProviders[name]
+	h.mu.RUnlock()
+	if !ok {
+		return nil, newValidationError(fmt.Sprintf("unknown market assumptions provider %q", name))
+	}
+
+	set, err := appRetirement.ResolveAssumptions(ctx, provider, version)
+	switch {
+	case errors.Is(err, appRetirement.ErrAssumptionVersionNotFound):
+		return nil, newValidationError(fmt.Sprintf("market assumptions provider %q has no version %q", name, version))
+	case err != nil:
+		return nil, fmt.Errorf("%w: %s: %v", errAssumptionsUnavailable, name, err)
+	}
+	return set, nil
+}
+
+// writeAssumptionsError writes a failure to resolve assumptions: 400 for a
+// provider or version that doesn't exist, 502 when the provider failed
+func (h *CashFlowHandler) writeAssumptionsError(w http.ResponseWriter, err error) {
+	var invalid *validationError
+	if errors.As(err, &invalid) {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+	h.writeError(w, http.StatusBadGateway, "assumptions_unavailable", err.Error())
+}
+
+// toMarketAssumptions converts an analysis's market assumptions
+func toMarketAssumptions(a *MarketAssumptionsConfig) appRetirement.MarketAssumptions {
+	return appRetirement.MarketAssumptions{
+		StockReturn:          a.StockReturn,
+		BondReturn:           a.BondReturn,
+		CashReturn:           a.CashReturn,
+		StockVolatility:      a.StockVolatility,
+		BondVolatility:       a.BondVolatility,
+		CashVolatility:       a.CashVolatility,
+		StockBondCorrelation: a.StockBondCorrelation,
+		StockCashCorrelation: a.StockCashCorrelation,
+		BondCashCorrelation:  a.BondCashCorrelation,
+	}
+}
+
+// toMarketAssumptionsConfig converts service market assumptions to an
+// analysis's
+func toMarketAssumptionsConfig(m appRetirement.MarketAssumptions) *MarketAssumptionsConfig {
+	return &MarketAssumptionsConfig{
+		StockReturn:          m.StockReturn,
+		BondReturn:           m.BondReturn,
+		CashReturn:           m.CashReturn,
+		StockVolatility:      m.StockVolatility,
+		BondVolatility:       m.BondVolatility,
+		CashVolatility:       m.CashVolatility,
+		StockBondCorrelation: m.StockBondCorrelation,
+		StockCashCorrelation: m.StockCashCorrelation,
+		BondCashCorrelation:  m.BondCashCorrelation,
+	}
+}
+
+// toAssumptionSetResponse converts a published assumption set
+func toAssumptionSetResponse(set *appRetirement.AssumptionSet) dto.MarketAssumptionSetResponse {
+	m := set.Assumptions
+	response := dto.MarketAssumptionSetResponse{
+		Provider: set.Provider,
+		Version:  set.Version,
+		Assumptions: dto.MarketAssumptionsResponse{
+			StockReturn:          m.StockReturn,
+			BondReturn:           m.BondReturn,
+			CashReturn:           m.CashReturn,
+			StockVolatility:      m.StockVolatility,
+			BondVolatility:       m.BondVolatility,
+			CashVolatility:       m.CashVolatility,
+			StockBondCorrelation: m.StockBondCorrelation,
+			StockCashCorrelation: m.StockCashCorrelation,
+			BondCashCorrelation:  m.BondCashCorrelation,
+		},
+	}
+	if !set.AsOf.IsZero() {
+		asOf := set.AsOf
+		response.AsOf = &asOf
+	}
+	return response
+}
@@ -0,0 +1,60 @@
+package retirement
+
+import (
+	"net/http"
+
+	"clockzen-next/internal/application/dto"
+	appRetirement "clockzen-next/internal/application/retirement"
+)
+
+// HandlePensionLumpSums handles GET /api/retirement/cashflow/{id}/pensions
+func (h *CashFlowHandler) HandlePensionLumpSums(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	h.mu.RLock()
+	analysis, exists := h.analyses[id]
+	var config CashFlowAnalysisConfig
+	if exists {
+		config = analysis.Config
+	}
+	h.mu.RUnlock()
+	if !exists {
+		h.writeError(w, http.StatusNotFound, "not_found", "Cash flow analysis not found")
+		return
+	}
+
+	svcConfig := h.toServiceConfig(&config)
+	service, err := appRetirement.NewCashFlowService(svcConfig)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+	comparisons, err := service.ComparePensionLumpSums(svcConfig)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	pensions := make([]dto.PensionLumpSumResponse, len(comparisons))
+	for i, comparison := range comparisons {
+		pensions[i] = dto.PensionLumpSumResponse{
+			Name:                comparison.Pension.Name,
+			Spouse:              comparison.Pension.Spouse,
+			AnnualBenefit:       comparison.Pension.AnnualBenefit,
+			StartAge:            comparison.Pension.StartAge,
+			LumpSum:             comparison.Pension.LumpSum,
+			Annuity:             toSpendingShapeOutcomeResponse(comparison.Annuity),
+			LumpSumTaken:        toSpendingShapeOutcomeResponse(comparison.LumpSum),
+			AnnuityPresentValue: comparison.AnnuityPresentValue,
+			ImpliedReturn:       comparison.ImpliedReturn,
+			PreferLumpSum:       comparison.PreferLumpSum,
+		}
+	}
+	h.writeJSON(w, http.StatusOK, &dto.PensionLumpSumsResponse{
+		CashFlowID: id,
+		Pensions:   pensions,
+	})
+}
@@ -0,0 +1,369 @@
+package retirement
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	appAnalysis "clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/application/dto"
+	"clockzen-next/internal/application/jobs"
+	appRetirement "clockzen-next/internal/application/retirement"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// =============================================================================
+// Raise Capture
+// =============================================================================
+
+// When the user's paychecks show a raise, a cash flow analysis can be re-run
+// with part of the raise added to retirement contributions. Recommendations
+// are kept on the analysis; scheduled scans add one for each new raise and
+// pass it to the raise capture callback.
+
+// raiseCaptureRecentDays is how long after taking effect a raise found by a
+// scheduled scan is still recommended on
+const raiseCaptureRecentDays = 90
+
+// RaiseCaptureFunc is called with each recommendation a scheduled scan makes
+type RaiseCaptureFunc func(userID string, recommendation *dto.RaiseCaptureResponse)
+
+// SetIncomeRepository detects raises in the user's deposits when a raise
+// capture request doesn't give one
+func (h *CashFlowHandler) SetIncomeRepository(repo appAnalysis.IncomeRepository) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.income = repo
+}
+
+// SetOnRaiseCapture sets the callback for recommendations made by scheduled
+// raise capture scans
+func (h *CashFlowHandler) SetOnRaiseCapture(callback RaiseCaptureFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onRaiseCapture = callback
+}
+
+// HandleRaiseCapture handles GET/POST /api/retirement/cashflow/{id}/raise-capture.
+// GET lists the analysis's recommendations; POST makes one.
+func (h *CashFlowHandler) HandleRaiseCapture(w http.ResponseWriter, r *http.Request, id string) {
+	switch r.Method {
+	case http.MethodGet:
+		h.mu.RLock()
+		analysis, exists := h.analyses[id]
+		var recommendations []*dto.RaiseCaptureResponse
+		if exists {
+			recommendations = append(recommendations, analysis.RaiseCaptures...)
+		}
+		h.mu.RUnlock()
+
+		if !exists {
+			h.writeError(w, http.StatusNotFound, "not_found", "Cash flow analysis not found")
+			return
+		}
+		if recommendations == nil {
+			recommendations = []*dto.RaiseCaptureResponse{}
+		}
+		h.writeJSON(w, http.StatusOK, recommendations)
+	case http.MethodPost:
+		h.createRaiseCapture(w, r, id)
+	default:
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET and POST methods are allowed")
+	}
+}
+
+// createRaiseCapture recommends capturing the requested raise, or the
+// user's latest detected one
+func (h *CashFlowHandler) createRaiseCapture(w http.ResponseWriter, r *http.Request, id string) {
+	var req dto.RaiseCaptureRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+	if req.AnnualRaise < 0 || req.CapturePercent < 0 || req.CapturePercent > 100 || req.MinRaisePercent < 0 {
+		h.writeError(w, http.StatusBadRequest, "validation_error",
+			"annual_raise and min_raise_percent cannot be negative; capture_percent must be between 0 and 100")
+		return
+	}
+
+	h.mu.RLock()
+	analysis, exists := h.analyses[id]
+	var config CashFlowAnalysisConfig
+	if exists {
+		config = analysis.Config
+	}
+	repo := h.income
+	h.mu.RUnlock()
+	if !exists {
+		h.writeError(w, http.StatusNotFound, "not_found", "Cash flow analysis not found")
+		return
+	}
+
+	var raise *appAnalysis.Raise
+	if req.AnnualRaise == 0 {
+		if repo == nil {
+			h.writeError(w, http.StatusServiceUnavailable, "not_configured", "Raise detection needs stored transactions")
+			return
+		}
+		userID, ok := middleware.UserIDFromContext(r.Context())
+		if !ok {
+			h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+			return
+		}
+		raises, err := detectRaises(r.Context(), repo, userID, time.Now(), req.MinRaisePercent)
+		if err != nil {
+			h.writeError(w, http.StatusInternalServerError, "detection_failed", err.Error())
+			return
+		}
+		if len(raises) == 0 {
+			h.writeError(w, http.StatusNotFound, "no_raise_detected", "No raise was found in recent paychecks")
+			return
+		}
+		raise = &raises[0]
+	}
+
+	recommendation, err := h.raiseCapture(id, &config, req.AnnualRaise, req.CapturePercent, raise)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	h.mu.Lock()
+	// The analysis may have been deleted while the plan was re-run
+	if analysis, exists := h.analyses[id]; exists {
+		analysis.RaiseCaptures = append(analysis.RaiseCaptures, recommendation)
+	}
+	h.mu.Unlock()
+
+	h.writeJSON(w, http.StatusCreated, recommendation)
+}
+
+// detectRaises returns the user's raises as of asOf, most recent first
+func detectRaises(ctx context.Context, repo appAnalysis.IncomeRepository, userID string, asOf time.Time, minRaisePercent float64) ([]appAnalysis.Raise, error) {
+	if minRaisePercent == 0 {
+		minRaisePercent = appAnalysis.DefaultMinRaisePercent
+	}
+	income, err := repo.GetIncome(ctx, userID, asOf.AddDate(0, -appAnalysis.RaiseLookbackMonths, 0), asOf)
+	if err != nil {
+		return nil, err
+	}
+	return appAnalysis.DetectRaises(income, asOf, minRaisePercent), nil
+}
+
+// raiseCapture re-runs the analysis's plan with capturePercent of a raise
+// saved. The raise is annualRaise, or raise's annual increase when given.
+func (h *CashFlowHandler) raiseCapture(cashFlowID string, config *CashFlowAnalysisConfig, annualRaise, capturePercent float64, raise *appAnalysis.Raise) (*dto.RaiseCaptureResponse, error) {
+	if capturePercent == 0 {
+		capturePercent = appRetirement.DefaultRaiseCapturePercent
+	}
+	if raise != nil {
+		annualRaise = raise.AnnualIncrease
+	}
+
+	svcConfig := h.toServiceConfig(config)
+	service, err := appRetirement.NewCashFlowService(svcConfig)
+	if err != nil {
+		return nil, err
+	}
+	capture, err := service.AnalyzeRaiseCapture(svcConfig, annualRaise, capturePercent)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.RaiseCaptureResponse{
+		ID:                        uuid.New().String(),
+		CashFlowID:                cashFlowID,
+		AnnualRaise:               capture.AnnualRaise,
+		CapturePercent:            capture.CapturePercent,
+		AdditionalContribution:    capture.AdditionalContribution,
+		Kept:                      toRaiseCaptureOutcomeResponse(capture.Kept),
+		Captured:                  toRaiseCaptureOutcomeResponse(capture.Captured),
+		PortfolioAtRetirementGain: capture.PortfolioAtRetirementGain,
+		FinalPortfolioGain:        capture.FinalPortfolioGain,
+		ReadinessGain:             capture.ReadinessGain,
+		YearsGained:               capture.YearsGained,
+		CreatedAt:                 time.Now(),
+	}
+	if raise != nil {
+		response.Raise = &dto.RaiseResponse{
+			Source:          raise.Source,
+			Cadence:         string(raise.Cadence),
+			PreviousPay:     raise.PreviousPay,
+			CurrentPay:      raise.CurrentPay,
+			EffectiveDate:   raise.EffectiveDate,
+			IncreasePercent: raise.IncreasePercent,
+			AnnualIncrease:  raise.AnnualIncrease,
+		}
+	}
+	return response, nil
+}
+
+// toRaiseCaptureOutcomeResponse converts a service outcome to DTO response
+func toRaiseCaptureOutcomeResponse(outcome appRetirement.RaiseCaptureOutcome) dto.RaiseCaptureOutcomeResponse {
+	return dto.RaiseCaptureOutcomeResponse{
+		TraditionalContributionRate: outcome.TraditionalContributionRate,
+		PortfolioAtRetirement:       outcome.PortfolioAtRetirement,
+		FinalPortfolio:              outcome.FinalPortfolio,
+		RetirementReadiness:         outcome.RetirementReadiness,
+		ExpensesCoveredYears:        outcome.ExpensesCoveredYears,
+		DepletionAge:                outcome.DepletionAge,
+	}
+}
+
+// raiseCaptureJobParams are the parameters of a raise capture scan job. The
+// analysis's configuration travels with the job, as the worker doesn't hold
+// the analyses.
+type raiseCaptureJobParams struct {
+	CashFlowID      string                 `json:"cashflow_id"`
+	Config          CashFlowAnalysisConfig `json:"config"`
+	CapturePercent  float64                `json:"capture_percent"`
+	MinRaisePercent float64                `json:"min_raise_percent"`
+	AsOf            time.Time              `json:"as_of"`
+}
+
+// RegisterJobHandlers runs raise capture scans, scenario batches and tax
+// strategy comparisons with this handler. It is called by the worker
+// process.
+func (h *CashFlowHandler) RegisterJobHandlers(service *jobs.Service) error {
+	if err := service.RegisterHandler(jobs.JobTypeRaiseCaptureScan, h.runRaiseCaptureScanJob); err != nil {
+		return err
+	}
+	if err := service.RegisterHandler(jobs.JobTypeScenarioBatch, h.runScenarioBatchJob); err != nil {
+		return err
+	}
+	return service.RegisterHandler(jobs.JobTypeTaxStrategies, h.runTaxStrategiesJob)
+}
+
+// runRaiseCaptureScanJob recommends capturing each raise that took effect
+// recently
+func (h *CashFlowHandler) runRaiseCaptureScanJob(ctx context.Context, job *jobs.Job, report jobs.ProgressFunc) (any, error) {
+	var params raiseCaptureJobParams
+	if err := job.DecodeParams(&params); err != nil {
+		return nil, fmt.Errorf("decoding job params: %w", err)
+	}
+
+	h.mu.RLock()
+	repo := h.income
+	h.mu.RUnlock()
+	if repo == nil {
+		return nil, errors.New("raise capture needs stored transactions")
+	}
+
+	raises, err := detectRaises(ctx, repo, job.UserID, params.AsOf, params.MinRaisePercent)
+	if err != nil {
+		return nil, err
+	}
+
+	recommendations := []*dto.RaiseCaptureResponse{}
+	recent := params.AsOf.AddDate(0, 0, -raiseCaptureRecentDays)
+	for i := range raises {
+		if raises[i].EffectiveDate.Before(recent) {
+			continue
+		}
+		recommendation, err := h.raiseCapture(params.CashFlowID, &params.Config, 0, params.CapturePercent, &raises[i])
+		if err != nil {
+			return nil, err
+		}
+		recommendations = append(recommendations, recommendation)
+	}
+	return recommendations, nil
+}
+
+// RegisterScheduledJobs makes raise capture scans of stored analyses
+// available as recurring schedules
+func (h *CashFlowHandler) RegisterScheduledJobs(scheduler *jobs.Scheduler) error {
+	return scheduler.RegisterRunner(jobs.JobTypeRaiseCaptureScan, jobs.Runner{
+		Params:     h.raiseCaptureScanParams,
+		OnComplete: h.storeRaiseCaptureScan,
+	})
+}
+
+// raiseCaptureScanParams scans for raises as of the time of each run, with
+// the analysis's configuration at that time
+func (h *CashFlowHandler) raiseCaptureScanParams(schedule *jobs.Schedule) (any, error) {
+	var params dto.RaiseCaptureScheduleParams
+	if len(schedule.Params) > 0 {
+		if err := json.Unmarshal(schedule.Params, &params); err != nil {
+			return nil, err
+		}
+	}
+	if params.CashFlowID == "" {
+		return nil, newValidationError("cashflow_id is required")
+	}
+	if params.CapturePercent < 0 || params.CapturePercent > 100 {
+		return nil, newValidationError("capture_percent must be between 0 and 100")
+	}
+	if params.MinRaisePercent < 0 {
+		return nil, newValidationError("min_raise_percent cannot be negative")
+	}
+
+	h.mu.RLock()
+	analysis, exists := h.analyses[params.CashFlowID]
+	var config CashFlowAnalysisConfig
+	if exists {
+		config = analysis.Config
+	}
+	h.mu.RUnlock()
+	if !exists {
+		return nil, newValidationError("cash flow analysis not found")
+	}
+
+	return raiseCaptureJobParams{
+		CashFlowID:      params.CashFlowID,
+		Config:          config,
+		CapturePercent:  params.CapturePercent,
+		MinRaisePercent: params.MinRaisePercent,
+		AsOf:            time.Now(),
+	}, nil
+}
+
+// storeRaiseCaptureScan adds a completed scan's recommendations for raises
+// not recommended on before to the analysis, and passes each to the raise
+// capture callback
+func (h *CashFlowHandler) storeRaiseCaptureScan(schedule jobs.Schedule, job *jobs.Job) {
+	var params raiseCaptureJobParams
+	var recommendations []*dto.RaiseCaptureResponse
+	if job.DecodeParams(&params) != nil || job.DecodeResult(&recommendations) != nil {
+		return
+	}
+
+	h.mu.Lock()
+	// The analysis may have been deleted since the scan was scheduled
+	analysis, exists := h.analyses[params.CashFlowID]
+	if !exists {
+		h.mu.Unlock()
+		return
+	}
+	var added []*dto.RaiseCaptureResponse
+	for _, recommendation := range recommendations {
+		if recommendation.Raise == nil || hasRaiseCapture(analysis.RaiseCaptures, recommendation.Raise) {
+			continue
+		}
+		analysis.RaiseCaptures = append(analysis.RaiseCaptures, recommendation)
+		added = append(added, recommendation)
+	}
+	callback := h.onRaiseCapture
+	h.mu.Unlock()
+
+	if callback != nil {
+		for _, recommendation := range added {
+			callback(schedule.UserID, recommendation)
+		}
+	}
+}
+
+// hasRaiseCapture reports whether raise was already recommended on
+func hasRaiseCapture(recommendations []*dto.RaiseCaptureResponse, raise *dto.RaiseResponse) bool {
+	for _, recommendation := range recommendations {
+		if r := recommendation.Raise; r != nil && r.Source == raise.Source && r.EffectiveDate.Equal(raise.EffectiveDate) {
+			return true
+		}
+	}
+	return false
+}
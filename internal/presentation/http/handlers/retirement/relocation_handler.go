@@ -0,0 +1,84 @@
+package retirement
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"clockzen-next/internal/application/dto"
+	appRetirement "clockzen-next/internal/application/retirement"
+)
+
+// HandleRelocation handles POST /api/retirement/cashflow/{id}/relocation
+func (h *CashFlowHandler) HandleRelocation(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	var req dto.RelocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+	if len(req.States) == 0 || len(req.States) > len(appRetirement.StateCodes()) {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "states must list between 1 and 51 state codes")
+		return
+	}
+	for _, code := range req.States {
+		if _, ok := appRetirement.StateTaxRulesFor(code); !ok {
+			h.writeError(w, http.StatusBadRequest, "validation_error", "unknown state code: "+code)
+			return
+		}
+	}
+
+	h.mu.RLock()
+	analysis, exists := h.analyses[id]
+	var config CashFlowAnalysisConfig
+	if exists {
+		config = analysis.Config
+	}
+	h.mu.RUnlock()
+	if !exists {
+		h.writeError(w, http.StatusNotFound, "not_found", "Cash flow analysis not found")
+		return
+	}
+
+	svcConfig := h.toServiceConfig(&config)
+	service, err := appRetirement.NewCashFlowService(svcConfig)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+	comparison, err := service.CompareRelocation(svcConfig, req.States, req.MoveAge)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	scenarios := make([]dto.RelocationOutcomeResponse, len(comparison.Scenarios))
+	for i, outcome := range comparison.Scenarios {
+		scenarios[i] = toRelocationOutcomeResponse(outcome)
+	}
+	h.writeJSON(w, http.StatusOK, &dto.RelocationResponse{
+		CashFlowID: id,
+		MoveAge:    comparison.MoveAge,
+		Current:    toRelocationOutcomeResponse(comparison.Current),
+		Scenarios:  scenarios,
+		BestState:  comparison.Best,
+	})
+}
+
+// toRelocationOutcomeResponse converts a service outcome to DTO response
+func toRelocationOutcomeResponse(outcome appRetirement.RelocationOutcome) dto.RelocationOutcomeResponse {
+	return dto.RelocationOutcomeResponse{
+		StateCode:            outcome.StateCode,
+		StateName:            outcome.StateName,
+		StateTax:             outcome.StateTax,
+		TotalLifetimeTax:     outcome.TotalLifetimeTax,
+		FinalPortfolio:       outcome.FinalPortfolio,
+		ExpensesCoveredYears: outcome.ExpensesCoveredYears,
+		DepletionAge:         outcome.DepletionAge,
+		StateTaxSavings:      outcome.StateTaxSavings,
+		FinalPortfolioGain:   outcome.FinalPortfolioGain,
+	}
+}
@@ -0,0 +1,186 @@
+package retirement
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+
+	"clockzen-next/internal/application/dto"
+	appRetirement "clockzen-next/internal/application/retirement"
+)
+
+// DiffCashFlowRequest represents a request to compare two cash flow configs
+type DiffCashFlowRequest struct {
+	Base    CashFlowAnalysisConfig `json:"base"`
+	Compare CashFlowAnalysisConfig `json:"compare"`
+}
+
+// HandleDiff handles POST /api/retirement/diff
+func (h *CashFlowHandler) HandleDiff(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	var req DiffCashFlowRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+	if err := h.validateConfig(&req.Base); err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "base: "+err.Error())
+		return
+	}
+	if err := h.validateConfig(&req.Compare); err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "compare: "+err.Error())
+		return
+	}
+	if err := h.pinAssumptions(r.Context(), &req.Base); err != nil {
+		h.writeAssumptionsError(w, fmt.Errorf("base: %w", err))
+		return
+	}
+	if err := h.pinAssumptions(r.Context(), &req.Compare); err != nil {
+		h.writeAssumptionsError(w, fmt.Errorf("compare: %w", err))
+		return
+	}
+
+	base, err := h.runServiceAnalysis(&req.Base)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "base: "+err.Error())
+		return
+	}
+	compare, err := h.runServiceAnalysis(&req.Compare)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "compare: "+err.Error())
+		return
+	}
+
+	response := toResultsDiffResponse(appRetirement.DiffResults(base, compare))
+	response.ChangedFields = changedConfigFields(req.Base, req.Compare)
+	h.writeJSON(w, http.StatusOK, response)
+}
+
+// runServiceAnalysis runs config, returning the service results. Results
+// are cached by the config's fingerprint and shared, so must not be
+// modified.
+func (h *CashFlowHandler) runServiceAnalysis(config *CashFlowAnalysisConfig) (*appRetirement.CashFlowResults, error) {
+	svcConfig := h.toServiceConfig(config)
+	key, cacheable := cacheKey(config, svcConfig)
+	if cacheable {
+		if results, ok := h.results.Get(key); ok {
+			return results, nil
+		}
+	}
+
+	service, err := appRetirement.NewCashFlowService(svcConfig)
+	if err != nil {
+		return nil, err
+	}
+	results, err := service.RunAnalysis()
+	if err != nil {
+		return nil, err
+	}
+	if cacheable {
+		h.results.Put(key, results)
+	}
+	return results, nil
+}
+
+// cacheKey fingerprints a computation on an analysis: its service config,
+// the version of the market assumptions it was pinned to, and any further
+// inputs. cacheable is false if they can't be fingerprinted.
+func cacheKey(config *CashFlowAnalysisConfig, svcConfig appRetirement.CashFlowConfig, inputs ...any) (key string, cacheable bool) {
+	var source *AssumptionsSourceConfig
+	if config.Allocation != nil {
+		source = config.Allocation.AssumptionsSource
+	}
+	key, err := appRetirement.Fingerprint(append([]any{svcConfig, source}, inputs...)...)
+	return key, err == nil
+}
+
+// changedConfigFields returns the JSON names of the fields that differ
+// between two configs, sorted
+func changedConfigFields(base, compare CashFlowAnalysisConfig) []string {
+	baseFields, compareFields := configFields(base), configFields(compare)
+	changed := []string{}
+	for name, value := range compareFields {
+		if !reflect.DeepEqual(baseFields[name], value) {
+			changed = append(changed, name)
+		}
+	}
+	for name := range baseFields {
+		if _, ok := compareFields[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// configFields returns a config's fields by JSON name
+func configFields(config CashFlowAnalysisConfig) map[string]any {
+	fields := make(map[string]any)
+	data, err := json.Marshal(config)
+	if err == nil {
+		err = json.Unmarshal(data, &fields)
+	}
+	if err != nil {
+		return nil
+	}
+	return fields
+}
+
+// toResultsDiffResponse converts a service diff to DTO response
+func toResultsDiffResponse(diff *appRetirement.ResultsDiff) *dto.ResultsDiffResponse {
+	response := &dto.ResultsDiffResponse{
+		Summary: dto.ResultsDiffSummaryResponse{
+			FinalPortfolio:           toMetricDiffResponse(diff.FinalPortfolio),
+			TotalLifetimeIncome:      toMetricDiffResponse(diff.TotalLifetimeIncome),
+			TotalLifetimeExpenses:    toMetricDiffResponse(diff.TotalLifetimeExpenses),
+			TotalLifetimeTax:         toMetricDiffResponse(diff.TotalLifetimeTax),
+			TotalLifetimeSavings:     toMetricDiffResponse(diff.TotalLifetimeSavings),
+			TotalLifetimeWithdrawals: toMetricDiffResponse(diff.TotalLifetimeWithdrawals),
+			RetirementReadiness:      toMetricDiffResponse(diff.RetirementReadiness),
+			ExpensesCoveredYears:     toMetricDiffResponse(diff.ExpensesCoveredYears),
+		},
+		Years:   make([]dto.YearDiffResponse, len(diff.Years)),
+		Drivers: make([]dto.DiffDriverResponse, len(diff.Drivers)),
+	}
+	for i, year := range diff.Years {
+		response.Years[i] = dto.YearDiffResponse{
+			Age:              year.Age,
+			TotalIncome:      toMetricDiffResponse(year.TotalIncome),
+			TotalExpenses:    toMetricDiffResponse(year.TotalExpenses),
+			TotalTax:         toMetricDiffResponse(year.TotalTax),
+			TotalSavings:     toMetricDiffResponse(year.TotalSavings),
+			TotalWithdrawals: toMetricDiffResponse(year.TotalWithdrawals),
+			NetCashFlow:      toMetricDiffResponse(year.NetCashFlow),
+			TotalPortfolio:   toMetricDiffResponse(year.TotalPortfolio),
+			Material:         year.Material,
+		}
+		if year.Material {
+			response.MaterialYears++
+		}
+	}
+	for i, driver := range diff.Drivers {
+		response.Drivers[i] = dto.DiffDriverResponse{
+			Line:  driver.Line,
+			Delta: driver.Delta,
+			Share: driver.Share,
+		}
+	}
+	return response
+}
+
+// toMetricDiffResponse converts a service metric diff to DTO response
+func toMetricDiffResponse(metric appRetirement.MetricDiff) dto.MetricDiffResponse {
+	return dto.MetricDiffResponse{
+		Base:     metric.Base,
+		Compare:  metric.Compare,
+		Delta:    metric.Delta,
+		Percent:  metric.Percent,
+		Material: metric.Material,
+	}
+}
@@ -3,18 +3,23 @@ package retirement
 import (
 	"net/http"
 	"strings"
+
+	appAnalysis "clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/application/jobs"
+	appRetirement "clockzen-next/internal/application/retirement"
 )
 
 // Router handles routing for retirement-related endpoints
 type Router struct {
-	planHandler       *PlanHandler
-	accountHandler    *AccountHandler
-	incomeHandler     *IncomeHandler
-	expenseHandler    *ExpenseHandler
-	projectionHandler *ProjectionHandler
-	fireHandler       *FIREHandler
-	cashflowHandler   *CashFlowHandler
-	backtestHandler   *BacktestHandler
+	planHandler           *PlanHandler
+	accountHandler        *AccountHandler
+	incomeHandler         *IncomeHandler
+	expenseHandler        *ExpenseHandler
+	projectionHandler     *ProjectionHandler
+	fireHandler           *FIREHandler
+	cashflowHandler       *CashFlowHandler
+	backtestHandler       *BacktestHandler
+	socialSecurityHandler *SocialSecurityHandler
 }
 
 // NewRouter creates a new Router with the given handlers
@@ -27,35 +32,38 @@ func NewRouter(
 	fireHandler *FIREHandler,
 	cashflowHandler *CashFlowHandler,
 	backtestHandler *BacktestHandler,
+	socialSecurityHandler *SocialSecurityHandler,
 ) *Router {
 	return &Router{
-		planHandler:       planHandler,
-		accountHandler:    accountHandler,
-		incomeHandler:     incomeHandler,
-		expenseHandler:    expenseHandler,
-		projectionHandler: projectionHandler,
-		fireHandler:       fireHandler,
-		cashflowHandler:   cashflowHandler,
-		backtestHandler:   backtestHandler,
+		planHandler:           planHandler,
+		accountHandler:        accountHandler,
+		incomeHandler:         incomeHandler,
+		expenseHandler:        expenseHandler,
+		projectionHandler:     projectionHandler,
+		fireHandler:           fireHandler,
+		cashflowHandler:       cashflowHandler,
+		backtestHandler:       backtestHandler,
+		socialSecurityHandler: socialSecurityHandler,
 	}
 }
 
 // NewDefaultRouter creates a new Router with default handlers
 func NewDefaultRouter() *Router {
 	return &Router{
-		planHandler:       NewPlanHandler(),
-		accountHandler:    NewAccountHandler(),
-		incomeHandler:     NewIncomeHandler(),
-		expenseHandler:    NewExpenseHandler(),
-		projectionHandler: NewProjectionHandler(),
-		fireHandler:       NewFIREHandler(),
-		cashflowHandler:   NewCashFlowHandler(),
-		backtestHandler:   NewBacktestHandler(),
+		planHandler:           NewPlanHandler(),
+		accountHandler:        NewAccountHandler(),
+		incomeHandler:         NewIncomeHandler(),
+		expenseHandler:        NewExpenseHandler(),
+		projectionHandler:     NewProjectionHandler(),
+		fireHandler:           NewFIREHandler(),
+		cashflowHandler:       NewCashFlowHandler(),
+		backtestHandler:       NewBacktestHandler(),
+		socialSecurityHandler: NewSocialSecurityHandler(),
 	}
 }
 
 // RegisterRoutes registers all retirement routes with the given mux
-// Total routes: 82
+// Total routes: 108
 func (r *Router) RegisterRoutes(mux *http.ServeMux) {
 	// Plan routes (8 routes)
 	// GET/POST /api/retirement/plans
@@ -96,24 +104,60 @@ func (r *Router) RegisterRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("/api/retirement/fire", r.handleFIRE)
 	mux.HandleFunc("/api/retirement/fire/", r.handleFIREByID)
 
-	// Cash Flow routes (10 routes)
+	// Cash Flow routes (31 routes)
 	// GET/POST /api/retirement/cashflow
 	// GET/PUT/PATCH/DELETE /api/retirement/cashflow/{id}
 	// POST /api/retirement/cashflow/{id}/run
-	// GET /api/retirement/cashflow/{id}/sankey
-	// GET /api/retirement/cashflow/{id}/yearly
+	// POST /api/retirement/cashflow/{id}/monte-carlo
+	// POST /api/retirement/cashflow/{id}/historical
+	// GET /api/retirement/cashflow/{id}/sankey (?age=N for a single year)
+	// GET /api/retirement/cashflow/{id}/sankey/export (?granularity=year|decade&format=json|csv)
+	// GET /api/retirement/cashflow/{id}/yearly (?format=json|csv|xlsx)
+	// GET/POST /api/retirement/cashflow/{id}/raise-capture
+	// POST /api/retirement/cashflow/{id}/spending-shape
+	// POST /api/retirement/cashflow/{id}/relocation
+	// GET /api/retirement/cashflow/{id}/pensions
+	// POST /api/retirement/cashflow/{id}/debt-payoff
+	// POST /api/retirement/cashflow/{id}/long-term-care
+	// POST /api/retirement/cashflow/{id}/spending-strategies
+	// POST /api/retirement/cashflow/{id}/inherited-ira
+	// POST /api/retirement/cashflow/{id}/fire
+	// POST /api/retirement/cashflow/{id}/inflation-stress
+	// POST /api/retirement/cashflow/{id}/tax-strategies (?async=true queues a job)
+	// GET/POST /api/retirement/cashflow/{id}/annotations
+	// PUT/PATCH/DELETE /api/retirement/cashflow/{id}/annotations/{annotationID}
+	// POST /api/retirement/cashflow/scenarios (?async=true queues a job)
+	// (?include_tables=true adds a data table to each Sankey diagram)
 	mux.HandleFunc("/api/retirement/cashflow", r.handleCashFlow)
 	mux.HandleFunc("/api/retirement/cashflow/", r.handleCashFlowByID)
 
 	// Backtest routes (12 routes)
 	// GET/POST /api/retirement/backtest
 	// GET/PUT/PATCH/DELETE /api/retirement/backtest/{id}
-	// POST /api/retirement/backtest/{id}/run
+	// POST /api/retirement/backtest/{id}/run (?async=true queues a job)
 	// GET /api/retirement/backtest/{id}/percentiles
 	// GET /api/retirement/backtest/{id}/success
 	// POST /api/retirement/backtest/scenarios
 	mux.HandleFunc("/api/retirement/backtest", r.handleBacktest)
 	mux.HandleFunc("/api/retirement/backtest/", r.handleBacktestByID)
+
+	// Social Security routes (1 route)
+	// POST /api/retirement/social-security/estimate
+	mux.HandleFunc("/api/retirement/social-security/estimate", r.socialSecurityHandler.HandleEstimate)
+
+	// Results routes (1 route)
+	// GET /api/retirement/results/{id}/years/{age}
+	mux.HandleFunc("/api/retirement/results/", r.handleResultsByID)
+
+	// Results diff routes (1 route)
+	// POST /api/retirement/diff
+	mux.HandleFunc("/api/retirement/diff", r.cashflowHandler.HandleDiff)
+
+	// Market assumptions routes (2 routes)
+	// POST /api/retirement/market-assumptions (CSV upload)
+	// GET /api/retirement/market-assumptions/{provider}?version=
+	mux.HandleFunc("/api/retirement/market-assumptions", r.cashflowHandler.HandleUploadAssumptions)
+	mux.HandleFunc("/api/retirement/market-assumptions/", r.handleMarketAssumptionsByProvider)
 }
 
 // handlePlans routes requests for /api/retirement/plans
@@ -250,6 +294,56 @@ func (r *Router) handleAccountByID(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
+// SetJobService enables asynchronous Monte Carlo runs and scenario batches
+// (?async=true)
+func (r *Router) SetJobService(service *jobs.Service) {
+	r.backtestHandler.SetJobService(service)
+	r.cashflowHandler.SetJobService(service)
+}
+
+// SetAssumptionProvider makes a market assumptions provider, such as a
+// remote capital market assumptions service, available to cash flow
+// analyses
+func (r *Router) SetAssumptionProvider(provider appRetirement.AssumptionProvider) {
+	r.cashflowHandler.SetAssumptionProvider(provider)
+}
+
+// SetIncomeRepository detects raises in the user's deposits for raise
+// capture recommendations
+func (r *Router) SetIncomeRepository(repo appAnalysis.IncomeRepository) {
+	r.cashflowHandler.SetIncomeRepository(repo)
+}
+
+// SetTransactionRepository derives retirement spending shapes from the
+// user's stored transactions
+func (r *Router) SetTransactionRepository(repo appAnalysis.TransactionRepository) {
+	r.cashflowHandler.SetTransactionRepository(repo)
+}
+
+// SetOnRaiseCapture sets the callback for recommendations made by scheduled
+// raise capture scans
+func (r *Router) SetOnRaiseCapture(callback RaiseCaptureFunc) {
+	r.cashflowHandler.SetOnRaiseCapture(callback)
+}
+
+// RegisterScheduledJobs makes backtest re-runs and raise capture scans
+// available as recurring schedules
+func (r *Router) RegisterScheduledJobs(scheduler *jobs.Scheduler) error {
+	if err := r.backtestHandler.RegisterScheduledJobs(scheduler); err != nil {
+		return err
+	}
+	return r.cashflowHandler.RegisterScheduledJobs(scheduler)
+}
+
+// RegisterJobHandlers runs Monte Carlo jobs, raise capture scans and
+// scenario batches in this process. The worker calls it.
+func (r *Router) RegisterJobHandlers(service *jobs.Service) error {
+	if err := r.backtestHandler.RegisterJobHandlers(service); err != nil {
+		return err
+	}
+	return r.cashflowHandler.RegisterJobHandlers(service)
+}
+
 // GetPlanHandler returns the plan handler
 func (r *Router) GetPlanHandler() *PlanHandler {
 	return r.planHandler
@@ -290,6 +384,11 @@ func (r *Router) GetBacktestHandler() *BacktestHandler {
 	return r.backtestHandler
 }
 
+// GetSocialSecurityHandler returns the Social Security handler
+func (r *Router) GetSocialSecurityHandler() *SocialSecurityHandler {
+	return r.socialSecurityHandler
+}
+
 // handleIncomes routes requests for /api/retirement/incomes
 func (r *Router) handleIncomes(w http.ResponseWriter, req *http.Request) {
 	switch req.Method {
@@ -481,6 +580,12 @@ func (r *Router) handleCashFlowByID(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	// Special case: scenarios endpoint
+	if parts[0] == "scenarios" {
+		r.cashflowHandler.HandleScenarioBatch(w, req)
+		return
+	}
+
 	id := parts[0]
 
 	// Check if this is a sub-resource request
@@ -490,11 +595,61 @@ func (r *Router) handleCashFlowByID(w http.ResponseWriter, req *http.Request) {
 			r.cashflowHandler.HandleRun(w, req, id)
 			return
 		case "sankey":
+			if len(parts) > 2 && parts[2] == "export" {
+				r.cashflowHandler.HandleSankeyExport(w, req, id)
+				return
+			}
 			r.cashflowHandler.HandleGetSankey(w, req, id)
 			return
 		case "yearly":
 			r.cashflowHandler.HandleGetYearlyFlows(w, req, id)
 			return
+		case "monte-carlo":
+			r.cashflowHandler.HandleMonteCarlo(w, req, id)
+			return
+		case "historical":
+			r.cashflowHandler.HandleHistorical(w, req, id)
+			return
+		case "raise-capture":
+			r.cashflowHandler.HandleRaiseCapture(w, req, id)
+			return
+		case "annotations":
+			if len(parts) > 2 && parts[2] != "" {
+				r.cashflowHandler.HandleAnnotationByID(w, req, id, parts[2])
+				return
+			}
+			r.cashflowHandler.HandleAnnotations(w, req, id)
+			return
+		case "spending-shape":
+			r.cashflowHandler.HandleSpendingShape(w, req, id)
+			return
+		case "relocation":
+			r.cashflowHandler.HandleRelocation(w, req, id)
+			return
+		case "pensions":
+			r.cashflowHandler.HandlePensionLumpSums(w, req, id)
+			return
+		case "debt-payoff":
+			r.cashflowHandler.HandleDebtPayoff(w, req, id)
+			return
+		case "long-term-care":
+			r.cashflowHandler.HandleLongTermCare(w, req, id)
+			return
+		case "spending-strategies":
+			r.cashflowHandler.HandleSpendingStrategies(w, req, id)
+			return
+		case "inherited-ira":
+			r.cashflowHandler.HandleInheritedIRA(w, req, id)
+			return
+		case "fire":
+			r.cashflowHandler.HandleFIRE(w, req, id)
+			return
+		case "inflation-stress":
+			r.cashflowHandler.HandleInflationStress(w, req, id)
+			return
+		case "tax-strategies":
+			r.cashflowHandler.HandleTaxStrategies(w, req, id)
+			return
 		default:
 			http.Error(w, "Not found", http.StatusNotFound)
 			return
@@ -526,6 +681,31 @@ func (r *Router) handleBacktest(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
+// handleMarketAssumptionsByProvider routes requests for
+// /api/retirement/market-assumptions/{provider}
+func (r *Router) handleMarketAssumptionsByProvider(w http.ResponseWriter, req *http.Request) {
+	provider := strings.TrimPrefix(req.URL.Path, "/api/retirement/market-assumptions/")
+	if provider == "" || strings.Contains(provider, "/") {
+		http.Error(w, "Provider name required", http.StatusBadRequest)
+		return
+	}
+	r.cashflowHandler.HandleGetAssumptions(w, req, provider)
+}
+
+// handleResultsByID routes requests for
+// /api/retirement/results/{id}/years/{age}, where {id} is a cash flow
+// analysis
+func (r *Router) handleResultsByID(w http.ResponseWriter, req *http.Request) {
+	path := strings.TrimPrefix(req.URL.Path, "/api/retirement/results/")
+	parts := strings.Split(path, "/")
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] != "years" || parts[2] == "" {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+	r.cashflowHandler.HandleGetYearDetail(w, req, parts[0], parts[2])
+}
+
 // handleBacktestByID routes requests for /api/retirement/backtest/{id}
 func (r *Router) handleBacktestByID(w http.ResponseWriter, req *http.Request) {
 	// Extract the ID from the URL path
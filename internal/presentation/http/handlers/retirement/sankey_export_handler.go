@@ -0,0 +1,140 @@
+package retirement
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"clockzen-next/internal/application/dto"
+	appRetirement "clockzen-next/internal/application/retirement"
+)
+
+// HandleSankeyExport handles GET
+// /api/retirement/cashflow/{id}/sankey/export, the analysis's Sankey
+// diagrams by ?granularity=year (the default) or decade, for the ages from
+// ?from_age through ?to_age, as JSON or with ?format=csv as CSV. Notes on
+// a period's years are exported with it.
+func (h *CashFlowHandler) HandleSankeyExport(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	query := r.URL.Query()
+	granularity := appRetirement.SankeyGranularity(query.Get("granularity"))
+	switch granularity {
+	case "":
+		granularity = appRetirement.SankeyByYear
+	case appRetirement.SankeyByYear, appRetirement.SankeyByDecade:
+	default:
+		h.writeError(w, http.StatusBadRequest, "invalid_parameter", "granularity must be year or decade")
+		return
+	}
+	format := query.Get("format")
+	if format != "" && format != "json" && format != "csv" {
+		h.writeError(w, http.StatusBadRequest, "invalid_parameter", "format must be json or csv")
+		return
+	}
+	fromAge, err := ageParameter(r, "from_age")
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_parameter", err.Error())
+		return
+	}
+	toAge, err := ageParameter(r, "to_age")
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_parameter", err.Error())
+		return
+	}
+
+	periods, ok := h.sankeyPeriods(w, id, granularity, fromAge, toAge)
+	if !ok {
+		return
+	}
+	annotations, _ := h.annotations(id)
+	periodAnnotations := make([][]dto.CashFlowAnnotationResponse, len(periods))
+	for i := range periods {
+		periodAnnotations[i] = annotationsForAges(annotations, periods[i].StartAge, periods[i].EndAge)
+		periods[i].Notes = sankeyNotes(periodAnnotations[i])
+	}
+
+	if format == "csv" {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"cashflow-%s-sankey-%s.csv\"", id, granularity))
+		w.WriteHeader(http.StatusOK)
+		// Nothing more can be reported once the body has started
+		_ = appRetirement.WriteSankeyCSV(w, periods)
+		return
+	}
+
+	response := &dto.SankeyExportResponse{
+		CashFlowID:  id,
+		Granularity: string(granularity),
+		Periods:     make([]dto.SankeyPeriodResponse, len(periods)),
+		Annotations: assumptionAnnotations(annotations),
+	}
+	for i, period := range periods {
+		sankey := h.toSankeyResponse(period.Sankey)
+		if includeTables(r) {
+			sankey = sankeyWithTable(sankey)
+		}
+		response.Periods[i] = dto.SankeyPeriodResponse{
+			Label:    period.Label,
+			StartAge: period.StartAge,
+			EndAge:   period.EndAge,
+			Sankey:   sankey,
+
+			Annotations: periodAnnotations[i],
+		}
+	}
+	h.writeJSON(w, http.StatusOK, response)
+}
+
+// sankeyPeriods projects a stored analysis and diagrams the periods asked
+// for, writing the error response when it can't
+func (h *CashFlowHandler) sankeyPeriods(w http.ResponseWriter, id string, granularity appRetirement.SankeyGranularity, fromAge, toAge int) ([]appRetirement.SankeyPeriod, bool) {
+	h.mu.RLock()
+	analysis, exists := h.analyses[id]
+	var config CashFlowAnalysisConfig
+	if exists {
+		config = analysis.Config
+	}
+	h.mu.RUnlock()
+	if !exists {
+		h.writeError(w, http.StatusNotFound, "not_found", "Cash flow analysis not found")
+		return nil, false
+	}
+
+	results, err := h.runServiceAnalysis(&config)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "analysis_failed", err.Error())
+		return nil, false
+	}
+	service, err := appRetirement.NewCashFlowService(h.toServiceConfig(&config))
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return nil, false
+	}
+	periods, err := service.GenerateSankeyBreakdown(results.YearlyFlows, granularity, fromAge, toAge)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_parameter", err.Error())
+		return nil, false
+	}
+	if len(periods) == 0 {
+		h.writeError(w, http.StatusNotFound, "not_found", "The analysis has no years at those ages")
+		return nil, false
+	}
+	return periods, true
+}
+
+// ageParameter parses an optional age query parameter, 0 when absent
+func ageParameter(r *http.Request, name string) (int, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return 0, nil
+	}
+	age, err := strconv.Atoi(value)
+	if err != nil || age <= 0 {
+		return 0, fmt.Errorf("%s must be a positive age", name)
+	}
+	return age, nil
+}
@@ -0,0 +1,198 @@
+package retirement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"clockzen-next/internal/application/dto"
+	"clockzen-next/internal/application/jobs"
+	appRetirement "clockzen-next/internal/application/retirement"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// CashFlowScenarioBatchRequest compares up to ten plans with the same Monte
+// Carlo settings
+type CashFlowScenarioBatchRequest struct {
+	Scenarios  []CashFlowScenario            `json:"scenarios"`
+	MonteCarlo dto.CashFlowMonteCarloRequest `json:"monte_carlo"`
+}
+
+// CashFlowScenario is a named plan in a batch: a stored analysis's
+// configuration when cashflow_id is set, otherwise config
+type CashFlowScenario struct {
+	Name       string                  `json:"name"`
+	CashFlowID string                  `json:"cashflow_id,omitempty"`
+	Config     *CashFlowAnalysisConfig `json:"config,omitempty"`
+}
+
+// SetJobService enables ?async=true scenario batches on the given job
+// service
+func (h *CashFlowHandler) SetJobService(service *jobs.Service) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.jobs = service
+}
+
+// HandleScenarioBatch handles POST /api/retirement/cashflow/scenarios. With
+// ?async=true the batch runs as a job on the worker and 202 Accepted is
+// returned.
+func (h *CashFlowHandler) HandleScenarioBatch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	var req CashFlowScenarioBatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+	if err := h.resolveScenarios(r.Context(), &req); err != nil {
+		h.writeAssumptionsError(w, err)
+		return
+	}
+	if err := h.validateMonteCarloRequest(&req.MonteCarlo); err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	if isAsyncRequest(r) {
+		h.submitScenarioBatchJob(w, r, &req)
+		return
+	}
+
+	response, err := h.runScenarioBatch(r.Context(), &req, nil)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+	h.writeJSON(w, http.StatusOK, response)
+}
+
+// resolveScenarios validates a batch and fills in the configuration of
+// scenarios naming a stored analysis and their market assumptions, so the
+// batch no longer depends on the analyses or providers
+func (h *CashFlowHandler) resolveScenarios(ctx context.Context, req *CashFlowScenarioBatchRequest) error {
+	if len(req.Scenarios) == 0 || len(req.Scenarios) > appRetirement.MaxBatchScenarios {
+		return newValidationError(fmt.Sprintf("scenarios must list between 1 and %d plans", appRetirement.MaxBatchScenarios))
+	}
+
+	names := make(map[string]bool, len(req.Scenarios))
+	for i := range req.Scenarios {
+		scenario := &req.Scenarios[i]
+		if scenario.Name == "" {
+			return newValidationError("scenarios: name is required")
+		}
+		if names[scenario.Name] {
+			return newValidationError(fmt.Sprintf("scenarios: name %q is used twice", scenario.Name))
+		}
+		names[scenario.Name] = true
+
+		if (scenario.CashFlowID == "") == (scenario.Config == nil) {
+			return newValidationError("scenarios: set one of cashflow_id or config")
+		}
+		if scenario.CashFlowID != "" {
+			h.mu.RLock()
+			analysis, exists := h.analyses[scenario.CashFlowID]
+			var config CashFlowAnalysisConfig
+			if exists {
+				config = analysis.Config
+			}
+			h.mu.RUnlock()
+			if !exists {
+				return newValidationError(fmt.Sprintf("scenarios: cash flow analysis %q not found", scenario.CashFlowID))
+			}
+			scenario.Config = &config
+		}
+		if err := h.validateConfig(scenario.Config); err != nil {
+			return newValidationError(fmt.Sprintf("scenarios: %s: %s", scenario.Name, err.Error()))
+		}
+		if err := h.pinAssumptions(ctx, scenario.Config); err != nil {
+			return fmt.Errorf("scenarios: %s: %w", scenario.Name, err)
+		}
+	}
+	return nil
+}
+
+// submitScenarioBatchJob queues a resolved batch on the job service and
+// writes the 202 response
+func (h *CashFlowHandler) submitScenarioBatchJob(w http.ResponseWriter, r *http.Request, req *CashFlowScenarioBatchRequest) {
+	h.mu.RLock()
+	service := h.jobs
+	h.mu.RUnlock()
+	if service == nil {
+		h.writeError(w, http.StatusServiceUnavailable, "async_unavailable", "Asynchronous runs are not enabled")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	job, err := service.Submit(r.Context(), userID, jobs.JobTypeScenarioBatch, req)
+	if err != nil {
+		h.writeError(w, http.StatusServiceUnavailable, "queue_failed", "Failed to queue scenario batch: "+err.Error())
+		return
+	}
+	h.writeJSON(w, http.StatusAccepted, newJobAcceptedResponse(job))
+}
+
+// runScenarioBatchJob runs the resolved batch a job carries
+func (h *CashFlowHandler) runScenarioBatchJob(ctx context.Context, job *jobs.Job, report jobs.ProgressFunc) (any, error) {
+	var req CashFlowScenarioBatchRequest
+	if err := job.DecodeParams(&req); err != nil {
+		return nil, fmt.Errorf("decoding job params: %w", err)
+	}
+	return h.runScenarioBatch(ctx, &req, func(completed, total int) {
+		report(float64(completed) / float64(total))
+	})
+}
+
+// runScenarioBatch runs a resolved batch and builds its comparison
+func (h *CashFlowHandler) runScenarioBatch(ctx context.Context, req *CashFlowScenarioBatchRequest, progress func(completed, total int)) (*dto.CashFlowScenarioBatchResponse, error) {
+	scenarios := make([]appRetirement.BatchScenario, len(req.Scenarios))
+	for i, scenario := range req.Scenarios {
+		scenarios[i] = appRetirement.BatchScenario{
+			Name:   scenario.Name,
+			Config: h.toServiceConfig(scenario.Config),
+		}
+	}
+	metrics, err := appRetirement.RunScenarioBatch(ctx, scenarios, h.toMonteCarloConfig(&req.MonteCarlo), 0, progress)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.CashFlowScenarioBatchResponse{
+		Scenarios: make([]dto.CashFlowScenarioMetricsResponse, len(metrics)),
+	}
+	var best struct{ success, tax, ending int }
+	for i, m := range metrics {
+		response.Scenarios[i] = dto.CashFlowScenarioMetricsResponse{
+			Name:                  m.Name,
+			SuccessProbability:    m.SuccessProbability,
+			MedianEndingPortfolio: m.MedianFinalPortfolio,
+			LifetimeTax:           m.Outcome.TotalTax,
+			EndingPortfolio:       m.Outcome.FinalPortfolio,
+			RetirementReadiness:   m.Outcome.RetirementReadiness,
+			ExpensesCoveredYears:  m.Outcome.ExpensesCoveredYears,
+			DepletionAge:          m.Outcome.DepletionAge,
+		}
+		if m.SuccessProbability > metrics[best.success].SuccessProbability {
+			best.success = i
+		}
+		if m.Outcome.TotalTax < metrics[best.tax].Outcome.TotalTax {
+			best.tax = i
+		}
+		if m.Outcome.FinalPortfolio > metrics[best.ending].Outcome.FinalPortfolio {
+			best.ending = i
+		}
+	}
+	response.HighestSuccessProbability = metrics[best.success].Name
+	response.LowestLifetimeTax = metrics[best.tax].Name
+	response.HighestEndingPortfolio = metrics[best.ending].Name
+	return response, nil
+}
@@ -0,0 +1,126 @@
+package retirement
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"clockzen-next/internal/application/dto"
+	appRetirement "clockzen-next/internal/application/retirement"
+)
+
+// SocialSecurityHandler handles HTTP requests for Social Security benefit
+// estimates
+type SocialSecurityHandler struct{}
+
+// NewSocialSecurityHandler creates a new SocialSecurityHandler instance
+func NewSocialSecurityHandler() *SocialSecurityHandler {
+	return &SocialSecurityHandler{}
+}
+
+// HandleEstimate handles POST /api/retirement/social-security/estimate
+func (h *SocialSecurityHandler) HandleEstimate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	var req dto.SocialSecurityEstimateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	estimate, err := appRetirement.EstimateSocialSecurity(toSocialSecurityInput(&req))
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, toSocialSecurityEstimateResponse(estimate))
+}
+
+// toSocialSecurityInput converts an estimate request to service input
+func toSocialSecurityInput(req *dto.SocialSecurityEstimateRequest) appRetirement.SocialSecurityInput {
+	input := appRetirement.SocialSecurityInput{
+		Worker:      toSocialSecurityEarner(req.Worker),
+		CurrentYear: req.CurrentYear,
+	}
+	if input.CurrentYear == 0 {
+		input.CurrentYear = time.Now().Year()
+	}
+	if req.Spouse != nil {
+		spouse := toSocialSecurityEarner(*req.Spouse)
+		input.Spouse = &spouse
+	}
+	return input
+}
+
+// toSocialSecurityEarner converts an earner request to service input
+func toSocialSecurityEarner(req dto.SocialSecurityEarnerRequest) appRetirement.SocialSecurityEarner {
+	history := make([]appRetirement.EarningsRecord, len(req.EarningsHistory))
+	for i, record := range req.EarningsHistory {
+		history[i] = appRetirement.EarningsRecord{Year: record.Year, Earnings: record.Earnings}
+	}
+	return appRetirement.SocialSecurityEarner{
+		BirthYear:       req.BirthYear,
+		EarningsHistory: history,
+		CurrentSalary:   req.CurrentSalary,
+		SalaryGrowth:    req.SalaryGrowth,
+		StopWorkingAge:  req.StopWorkingAge,
+		ClaimingAge:     req.ClaimingAge,
+	}
+}
+
+// toSocialSecurityEstimateResponse converts a service estimate to DTO response
+func toSocialSecurityEstimateResponse(estimate *appRetirement.SocialSecurityEstimate) *dto.SocialSecurityEstimateResponse {
+	response := &dto.SocialSecurityEstimateResponse{
+		Worker:                 toEarnerBenefitResponse(estimate.Worker),
+		HouseholdAnnualBenefit: estimate.HouseholdAnnualBenefit,
+	}
+	if estimate.Spouse != nil {
+		spouse := toEarnerBenefitResponse(*estimate.Spouse)
+		response.Spouse = &spouse
+	}
+	return response
+}
+
+// toEarnerBenefitResponse converts one earner's estimate to DTO response
+func toEarnerBenefitResponse(benefit appRetirement.EarnerBenefit) dto.EarnerBenefitResponse {
+	options := make([]dto.ClaimingOptionResponse, len(benefit.ClaimingOptions))
+	for i, option := range benefit.ClaimingOptions {
+		options[i] = dto.ClaimingOptionResponse{
+			Age:             option.Age,
+			AdjustmentRatio: option.AdjustmentRatio,
+			MonthlyBenefit:  option.MonthlyBenefit,
+			AnnualBenefit:   option.AnnualBenefit,
+		}
+	}
+	return dto.EarnerBenefitResponse{
+		AIME:                    benefit.AIME,
+		PIA:                     benefit.PIA,
+		BendPoints:              benefit.BendPoints,
+		FullRetirementAgeMonths: benefit.FullRetirementAgeMonths,
+		ClaimingAge:             benefit.ClaimingAge,
+		AdjustmentRatio:         benefit.AdjustmentRatio,
+		MonthlyBenefit:          benefit.MonthlyBenefit,
+		MonthlySpousalBenefit:   benefit.MonthlySpousalBenefit,
+		AnnualBenefit:           benefit.AnnualBenefit,
+		ClaimingOptions:         options,
+	}
+}
+
+// writeJSON writes a JSON response
+func (h *SocialSecurityHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *SocialSecurityHandler) writeError(w http.ResponseWriter, status int, errCode string, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
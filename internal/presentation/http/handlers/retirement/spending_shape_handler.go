@@ -0,0 +1,174 @@
+package retirement
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	appAnalysis "clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/application/dto"
+	appRetirement "clockzen-next/internal/application/retirement"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// =============================================================================
+// Spending Shape
+// =============================================================================
+
+// A cash flow analysis assumes flat expenses in today's dollars. The spending
+// shape endpoint backtests its withdrawal strategies against the user's own
+// spending instead: their level and trend, giving way to the retirement
+// spending smile.
+
+const (
+	// defaultSpendingLookbackMonths is how much stored spending the shape is
+	// derived from by default
+	defaultSpendingLookbackMonths = 24
+	// maxSpendingLookbackMonths caps the requested lookback
+	maxSpendingLookbackMonths = 120
+)
+
+// SetTransactionRepository derives spending shapes from the user's stored
+// transactions when a request doesn't give monthly spending
+func (h *CashFlowHandler) SetTransactionRepository(repo appAnalysis.TransactionRepository) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.spending = appAnalysis.NewSpendingServiceWithDefaults(repo)
+}
+
+// HandleSpendingShape handles POST /api/retirement/cashflow/{id}/spending-shape
+func (h *CashFlowHandler) HandleSpendingShape(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	var req dto.SpendingShapeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+	if req.LookbackMonths != 0 && (req.LookbackMonths < 12 || req.LookbackMonths > maxSpendingLookbackMonths) {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "lookback_months must be between 12 and 120")
+		return
+	}
+	for _, amount := range req.MonthlySpending {
+		if amount < 0 {
+			h.writeError(w, http.StatusBadRequest, "validation_error", "monthly_spending cannot be negative")
+			return
+		}
+	}
+
+	h.mu.RLock()
+	analysis, exists := h.analyses[id]
+	var config CashFlowAnalysisConfig
+	if exists {
+		config = analysis.Config
+	}
+	spending := h.spending
+	h.mu.RUnlock()
+	if !exists {
+		h.writeError(w, http.StatusNotFound, "not_found", "Cash flow analysis not found")
+		return
+	}
+
+	monthly := req.MonthlySpending
+	if monthly == nil {
+		if spending == nil {
+			h.writeError(w, http.StatusServiceUnavailable, "not_configured", "Spending history needs stored transactions")
+			return
+		}
+		userID, ok := middleware.UserIDFromContext(r.Context())
+		if !ok {
+			h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+			return
+		}
+		lookback := req.LookbackMonths
+		if lookback == 0 {
+			lookback = defaultSpendingLookbackMonths
+		}
+		// Whole months only, ending with last month
+		now := time.Now()
+		end := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()).Add(-time.Nanosecond)
+		start := time.Date(now.Year(), now.Month()-time.Month(lookback), 1, 0, 0, 0, 0, now.Location())
+		var err error
+		monthly, err = spending.MonthlySpending(r.Context(), userID, start, end)
+		if err != nil {
+			h.writeError(w, http.StatusInternalServerError, "history_failed", err.Error())
+			return
+		}
+	}
+
+	history, err := appRetirement.NewSpendingHistory(monthly)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	svcConfig := h.toServiceConfig(&config)
+	service, err := appRetirement.NewCashFlowService(svcConfig)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+	comparison, err := service.CompareSpendingShapes(svcConfig, appRetirement.PersonalizedSpendingShape(svcConfig, history))
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "analysis_failed", err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, toSpendingShapeResponse(id, svcConfig, history, comparison))
+}
+
+// toSpendingShapeResponse converts a service comparison to DTO response
+func toSpendingShapeResponse(cashFlowID string, config appRetirement.CashFlowConfig, history appRetirement.SpendingHistory, comparison *appRetirement.SpendingShapeComparison) *dto.SpendingShapeResponse {
+	strategies := make([]dto.SpendingShapeStrategyResponse, len(comparison.Strategies))
+	for i, row := range comparison.Strategies {
+		strategies[i] = dto.SpendingShapeStrategyResponse{
+			Strategy:     toWithdrawalStrategyType(row.Strategy),
+			Flat:         toSpendingShapeOutcomeResponse(row.Flat),
+			Personalized: toSpendingShapeOutcomeResponse(row.Shaped),
+		}
+	}
+	return &dto.SpendingShapeResponse{
+		CashFlowID:               cashFlowID,
+		HistoryMonths:            history.Months,
+		AnnualSpending:           history.AnnualSpending,
+		AnnualTrendPercent:       history.AnnualTrend * 100,
+		ConfiguredExpenses:       config.AnnualExpenses(),
+		Shape:                    comparison.Shape,
+		Strategies:               strategies,
+		BestFlatStrategy:         toWithdrawalStrategyType(comparison.BestFlat),
+		BestPersonalizedStrategy: toWithdrawalStrategyType(comparison.BestShaped),
+	}
+}
+
+// toSpendingShapeOutcomeResponse converts a service outcome to DTO response
+func toSpendingShapeOutcomeResponse(outcome appRetirement.StrategyOutcome) dto.SpendingShapeOutcomeResponse {
+	return dto.SpendingShapeOutcomeResponse{
+		FinalPortfolio:       outcome.FinalPortfolio,
+		TotalTax:             outcome.TotalTax,
+		RetirementExpenses:   outcome.RetirementExpenses,
+		ExpensesCoveredYears: outcome.ExpensesCoveredYears,
+		RetirementReadiness:  outcome.RetirementReadiness,
+		DepletionAge:         outcome.DepletionAge,
+	}
+}
+
+// toWithdrawalStrategyType converts a service strategy to its DTO name
+func toWithdrawalStrategyType(strategy appRetirement.WithdrawalStrategy) dto.WithdrawalStrategyType {
+	switch strategy {
+	case appRetirement.ProRata:
+		return dto.WithdrawalStrategyProRata
+	case appRetirement.TaxableFirst:
+		return dto.WithdrawalStrategyTaxableFirst
+	case appRetirement.TraditionalFirst:
+		return dto.WithdrawalStrategyTraditionalFirst
+	case appRetirement.RothFirst:
+		return dto.WithdrawalStrategyRothFirst
+	default:
+		return dto.WithdrawalStrategyTaxOptimized
+	}
+}
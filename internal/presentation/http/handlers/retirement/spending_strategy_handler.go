@@ -0,0 +1,68 @@
+package retirement
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"clockzen-next/internal/application/dto"
+	appRetirement "clockzen-next/internal/application/retirement"
+)
+
+// HandleSpendingStrategies handles POST /api/retirement/cashflow/{id}/spending-strategies
+func (h *CashFlowHandler) HandleSpendingStrategies(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	// The body is optional Monte Carlo settings; every setting has a default
+	var req dto.CashFlowMonteCarloRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+	if err := h.validateMonteCarloRequest(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	h.mu.RLock()
+	analysis, exists := h.analyses[id]
+	var config CashFlowAnalysisConfig
+	if exists {
+		config = analysis.Config
+	}
+	h.mu.RUnlock()
+	if !exists {
+		h.writeError(w, http.StatusNotFound, "not_found", "Cash flow analysis not found")
+		return
+	}
+
+	svcConfig := h.toServiceConfig(&config)
+	service, err := appRetirement.NewCashFlowService(svcConfig)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+	outcomes, err := service.CompareSpendingStrategies(r.Context(), svcConfig, h.toMonteCarloConfig(&req))
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "analysis_failed", err.Error())
+		return
+	}
+
+	strategies := make([]dto.SpendingStrategyOutcomeResponse, len(outcomes))
+	for i, outcome := range outcomes {
+		strategies[i] = dto.SpendingStrategyOutcomeResponse{
+			Strategy:             string(outcome.Strategy),
+			SuccessProbability:   outcome.SuccessProbability,
+			MedianFinalPortfolio: outcome.MedianFinalPortfolio,
+			Spending:             toSpendingVolatilityResponse(outcome.Spending),
+		}
+	}
+	h.writeJSON(w, http.StatusOK, &dto.SpendingStrategiesResponse{
+		CashFlowID: id,
+		Strategies: strategies,
+	})
+}
@@ -0,0 +1,144 @@
+package retirement
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"clockzen-next/internal/application/dto"
+	"clockzen-next/internal/application/jobs"
+	appRetirement "clockzen-next/internal/application/retirement"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// taxStrategiesJobParams carries the analysis a tax strategy comparison job
+// projects, so the worker doesn't need the stored analysis
+type taxStrategiesJobParams struct {
+	CashFlowID string                 `json:"cashflow_id"`
+	Config     CashFlowAnalysisConfig `json:"config"`
+}
+
+// HandleTaxStrategies handles POST
+// /api/retirement/cashflow/{id}/tax-strategies, projecting the analysis
+// under every withdrawal strategy. With ?async=true the comparison runs as a
+// job on the worker and 202 Accepted is returned; the job's stream carries
+// the strategies as they finish.
+func (h *CashFlowHandler) HandleTaxStrategies(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	h.mu.RLock()
+	analysis, exists := h.analyses[id]
+	var config CashFlowAnalysisConfig
+	if exists {
+		config = analysis.Config
+	}
+	service := h.jobs
+	h.mu.RUnlock()
+	if !exists {
+		h.writeError(w, http.StatusNotFound, "not_found", "Cash flow analysis not found")
+		return
+	}
+
+	if isAsyncRequest(r) {
+		if service == nil {
+			h.writeError(w, http.StatusServiceUnavailable, "async_unavailable", "Asynchronous runs are not enabled")
+			return
+		}
+		userID, ok := middleware.UserIDFromContext(r.Context())
+		if !ok {
+			h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+			return
+		}
+		job, err := service.Submit(r.Context(), userID, jobs.JobTypeTaxStrategies, taxStrategiesJobParams{
+			CashFlowID: id,
+			Config:     config,
+		})
+		if err != nil {
+			h.writeError(w, http.StatusServiceUnavailable, "queue_failed", "Failed to queue tax strategy comparison: "+err.Error())
+			return
+		}
+		h.writeJSON(w, http.StatusAccepted, newJobAcceptedResponse(job))
+		return
+	}
+
+	response, err := h.runTaxStrategies(r.Context(), id, &config, nil)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "analysis_failed", err.Error())
+		return
+	}
+	h.writeJSON(w, http.StatusOK, response)
+}
+
+// runTaxStrategiesJob runs the comparison a job carries, recording the
+// strategies finished so far as its partial result
+func (h *CashFlowHandler) runTaxStrategiesJob(ctx context.Context, job *jobs.Job, report jobs.ProgressFunc) (any, error) {
+	var params taxStrategiesJobParams
+	if err := job.DecodeParams(&params); err != nil {
+		return nil, fmt.Errorf("decoding job params: %w", err)
+	}
+	return h.runTaxStrategies(ctx, params.CashFlowID, &params.Config, func(partial *dto.CashFlowTaxStrategiesResponse) {
+		job.ReportPartialResult(partial)
+		report(float64(partial.Completed) / float64(partial.Total))
+	})
+}
+
+// runTaxStrategies compares an analysis's withdrawal strategies. partial,
+// when set, is called with the comparison so far as each strategy finishes.
+func (h *CashFlowHandler) runTaxStrategies(ctx context.Context, id string, config *CashFlowAnalysisConfig, partial func(*dto.CashFlowTaxStrategiesResponse)) (*dto.CashFlowTaxStrategiesResponse, error) {
+	svcConfig := h.toServiceConfig(config)
+	service, err := appRetirement.NewCashFlowService(svcConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	finished := make(map[appRetirement.WithdrawalStrategy]appRetirement.StrategyOutcome)
+	_, err = service.CompareTaxStrategiesConcurrently(ctx, svcConfig, 0, func(result appRetirement.StrategyResult, completed, total int) {
+		finished[result.Strategy] = result.Outcome
+		if partial != nil && completed < total {
+			partial(toTaxStrategiesResponse(id, finished, total))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toTaxStrategiesResponse(id, finished, len(finished)), nil
+}
+
+// toTaxStrategiesResponse converts the finished strategies' outcomes to DTO
+// response
+func toTaxStrategiesResponse(id string, finished map[appRetirement.WithdrawalStrategy]appRetirement.StrategyOutcome, total int) *dto.CashFlowTaxStrategiesResponse {
+	response := &dto.CashFlowTaxStrategiesResponse{
+		CashFlowID: id,
+		Strategies: make([]dto.CashFlowTaxStrategyResponse, 0, len(finished)),
+		Completed:  len(finished),
+		Total:      total,
+	}
+	var lowestTax, highestEnding *appRetirement.StrategyOutcome
+	for _, strategy := range appRetirement.WithdrawalStrategies() {
+		outcome, ok := finished[strategy]
+		if !ok {
+			continue
+		}
+		response.Strategies = append(response.Strategies, dto.CashFlowTaxStrategyResponse{
+			Strategy:             toWithdrawalStrategyType(strategy),
+			LifetimeTax:          outcome.TotalTax,
+			EndingPortfolio:      outcome.FinalPortfolio,
+			RetirementExpenses:   outcome.RetirementExpenses,
+			RetirementReadiness:  outcome.RetirementReadiness,
+			ExpensesCoveredYears: outcome.ExpensesCoveredYears,
+			DepletionAge:         outcome.DepletionAge,
+		})
+		if lowestTax == nil || outcome.TotalTax < lowestTax.TotalTax {
+			lowestTax = &outcome
+			response.LowestLifetimeTax = toWithdrawalStrategyType(strategy)
+		}
+		if highestEnding == nil || outcome.FinalPortfolio > highestEnding.FinalPortfolio {
+			highestEnding = &outcome
+			response.HighestEndingPortfolio = toWithdrawalStrategyType(strategy)
+		}
+	}
+	return response
+}
@@ -0,0 +1,80 @@
+package retirement
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"clockzen-next/internal/application/dto"
+	appRetirement "clockzen-next/internal/application/retirement"
+)
+
+// HandleGetYearDetail handles GET /api/retirement/results/{id}/years/{age},
+// a single year of a cash flow analysis with its income, expenses and taxes
+// itemized and the notes on it, without the rest of the projection
+func (h *CashFlowHandler) HandleGetYearDetail(w http.ResponseWriter, r *http.Request, id, ageParam string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	age, err := strconv.Atoi(ageParam)
+	if err != nil || age <= 0 {
+		h.writeError(w, http.StatusBadRequest, "invalid_parameter", "age must be a positive whole number")
+		return
+	}
+
+	h.mu.RLock()
+	analysis, exists := h.analyses[id]
+	var config CashFlowAnalysisConfig
+	if exists {
+		config = analysis.Config
+	}
+	h.mu.RUnlock()
+
+	if !exists {
+		h.writeError(w, http.StatusNotFound, "not_found", "Cash flow analysis not found")
+		return
+	}
+
+	results, err := h.runServiceAnalysis(&config)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "analysis_failed", err.Error())
+		return
+	}
+	service, err := appRetirement.NewCashFlowService(h.toServiceConfig(&config))
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+	flow, err := service.GetFlowsForAge(results, age)
+	if err != nil {
+		h.writeError(w, http.StatusNotFound, "not_found", "The analysis has no year at age "+ageParam)
+		return
+	}
+
+	annotations, _ := h.annotations(id)
+	h.writeJSON(w, http.StatusOK, &dto.CashFlowYearDetailResponse{
+		CashFlowID:   id,
+		Summary:      toYearCashFlowResponse(*flow, time.Now().Year()),
+		IncomeFlows:  toCashFlowResponses(service.CalculateIncomeFlows(r.Context(), *flow)),
+		ExpenseFlows: toCashFlowResponses(service.CalculateExpenseFlows(r.Context(), *flow)),
+		TaxFlows:     toCashFlowResponses(service.CalculateTaxFlows(r.Context(), *flow)),
+		Annotations:  annotationsForAges(annotations, age, age),
+	})
+}
+
+// toCashFlowResponses converts itemized cash flows to response format
+func toCashFlowResponses(flows []appRetirement.CashFlow) []dto.CashFlowResponse {
+	responses := make([]dto.CashFlowResponse, len(flows))
+	for i, flow := range flows {
+		responses[i] = dto.CashFlowResponse{
+			Category:    string(flow.Category),
+			Type:        string(flow.Type),
+			Amount:      flow.Amount,
+			Description: flow.Description,
+			TaxImpact:   flow.TaxImpact,
+		}
+	}
+	return responses
+}
@@ -10,6 +10,7 @@ import (
 
 	"clockzen-next/internal/application/dto"
 	"clockzen-next/internal/domain/rules/model"
+	"clockzen-next/internal/presentation/http/middleware"
 )
 
 // Rule represents a rule in the handler layer
@@ -87,6 +88,11 @@ func (h *RuleHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
 	var req dto.CreateRuleRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
@@ -138,7 +144,7 @@ func (h *RuleHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
 
 	rule := &Rule{
 		ID:           uuid.New().String(),
-		UserID:       req.UserID,
+		UserID:       userID,
 		ConfigID:     req.ConfigID,
 		Name:         req.Name,
 		Description:  req.Description,
@@ -169,8 +175,13 @@ func (h *RuleHandler) HandleGet(w http.ResponseWriter, r *http.Request, id strin
 		return
 	}
 
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
 	h.mu.RLock()
-	rule, exists := h.rules[id]
+	rule, exists := h.ownedRule(userID, id)
 	h.mu.RUnlock()
 
 	if !exists {
@@ -188,13 +199,15 @@ func (h *RuleHandler) HandleList(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Get optional user_id filter from query params
-	userID := r.URL.Query().Get("user_id")
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
 
 	h.mu.RLock()
 	rules := make([]*Rule, 0)
 	for _, rule := range h.rules {
-		if userID == "" || rule.UserID == userID {
+		if rule.UserID == userID {
 			rules = append(rules, rule)
 		}
 	}
@@ -215,6 +228,11 @@ func (h *RuleHandler) HandleUpdate(w http.ResponseWriter, r *http.Request, id st
 		return
 	}
 
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
 	var req dto.UpdateRuleRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
@@ -224,7 +242,7 @@ func (h *RuleHandler) HandleUpdate(w http.ResponseWriter, r *http.Request, id st
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
-	rule, exists := h.rules[id]
+	rule, exists := h.ownedRule(userID, id)
 	if !exists {
 		h.writeError(w, http.StatusNotFound, "not_found", "Rule not found")
 		return
@@ -300,8 +318,13 @@ func (h *RuleHandler) HandleDelete(w http.ResponseWriter, r *http.Request, id st
 		return
 	}
 
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
 	h.mu.Lock()
-	_, exists := h.rules[id]
+	_, exists := h.ownedRule(userID, id)
 	if !exists {
 		h.mu.Unlock()
 		h.writeError(w, http.StatusNotFound, "not_found", "Rule not found")
@@ -320,8 +343,13 @@ func (h *RuleHandler) HandleEnable(w http.ResponseWriter, r *http.Request, id st
 		return
 	}
 
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
 	h.mu.Lock()
-	rule, exists := h.rules[id]
+	rule, exists := h.ownedRule(userID, id)
 	if !exists {
 		h.mu.Unlock()
 		h.writeError(w, http.StatusNotFound, "not_found", "Rule not found")
@@ -341,8 +369,13 @@ func (h *RuleHandler) HandleDisable(w http.ResponseWriter, r *http.Request, id s
 		return
 	}
 
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
 	h.mu.Lock()
-	rule, exists := h.rules[id]
+	rule, exists := h.ownedRule(userID, id)
 	if !exists {
 		h.mu.Unlock()
 		h.writeError(w, http.StatusNotFound, "not_found", "Rule not found")
@@ -362,6 +395,11 @@ func (h *RuleHandler) HandleSetPriority(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
 	var req dto.SetPriorityRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
@@ -369,7 +407,7 @@ func (h *RuleHandler) HandleSetPriority(w http.ResponseWriter, r *http.Request,
 	}
 
 	h.mu.Lock()
-	rule, exists := h.rules[id]
+	rule, exists := h.ownedRule(userID, id)
 	if !exists {
 		h.mu.Unlock()
 		h.writeError(w, http.StatusNotFound, "not_found", "Rule not found")
@@ -389,8 +427,13 @@ func (h *RuleHandler) HandleValidate(w http.ResponseWriter, r *http.Request, id
 		return
 	}
 
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
 	h.mu.RLock()
-	rule, exists := h.rules[id]
+	rule, exists := h.ownedRule(userID, id)
 	h.mu.RUnlock()
 
 	if !exists {
@@ -415,6 +458,11 @@ func (h *RuleHandler) HandleAddCondition(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
 	var req dto.AddConditionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
@@ -431,7 +479,7 @@ func (h *RuleHandler) HandleAddCondition(w http.ResponseWriter, r *http.Request,
 	}
 
 	h.mu.Lock()
-	rule, exists := h.rules[id]
+	rule, exists := h.ownedRule(userID, id)
 	if !exists {
 		h.mu.Unlock()
 		h.writeError(w, http.StatusNotFound, "not_found", "Rule not found")
@@ -460,8 +508,13 @@ func (h *RuleHandler) HandleRemoveCondition(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
 	h.mu.Lock()
-	rule, exists := h.rules[ruleID]
+	rule, exists := h.ownedRule(userID, ruleID)
 	if !exists {
 		h.mu.Unlock()
 		h.writeError(w, http.StatusNotFound, "not_found", "Rule not found")
@@ -498,8 +551,13 @@ func (h *RuleHandler) HandleListConditions(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
 	h.mu.RLock()
-	rule, exists := h.rules[id]
+	rule, exists := h.ownedRule(userID, id)
 	h.mu.RUnlock()
 
 	if !exists {
@@ -533,6 +591,11 @@ func (h *RuleHandler) HandleUpdateCondition(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
 	var req dto.UpdateConditionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
@@ -542,7 +605,7 @@ func (h *RuleHandler) HandleUpdateCondition(w http.ResponseWriter, r *http.Reque
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
-	rule, exists := h.rules[ruleID]
+	rule, exists := h.ownedRule(userID, ruleID)
 	if !exists {
 		h.writeError(w, http.StatusNotFound, "not_found", "Rule not found")
 		return
@@ -598,6 +661,11 @@ func (h *RuleHandler) HandleAddAction(w http.ResponseWriter, r *http.Request, id
 		return
 	}
 
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
 	var req dto.AddActionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
@@ -610,7 +678,7 @@ func (h *RuleHandler) HandleAddAction(w http.ResponseWriter, r *http.Request, id
 	}
 
 	h.mu.Lock()
-	rule, exists := h.rules[id]
+	rule, exists := h.ownedRule(userID, id)
 	if !exists {
 		h.mu.Unlock()
 		h.writeError(w, http.StatusNotFound, "not_found", "Rule not found")
@@ -644,8 +712,13 @@ func (h *RuleHandler) HandleRemoveAction(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
 	h.mu.Lock()
-	rule, exists := h.rules[ruleID]
+	rule, exists := h.ownedRule(userID, ruleID)
 	if !exists {
 		h.mu.Unlock()
 		h.writeError(w, http.StatusNotFound, "not_found", "Rule not found")
@@ -682,8 +755,13 @@ func (h *RuleHandler) HandleListActions(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
 	h.mu.RLock()
-	rule, exists := h.rules[id]
+	rule, exists := h.ownedRule(userID, id)
 	h.mu.RUnlock()
 
 	if !exists {
@@ -718,6 +796,11 @@ func (h *RuleHandler) HandleUpdateAction(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
 	var req dto.UpdateActionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
@@ -727,7 +810,7 @@ func (h *RuleHandler) HandleUpdateAction(w http.ResponseWriter, r *http.Request,
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
-	rule, exists := h.rules[ruleID]
+	rule, exists := h.ownedRule(userID, ruleID)
 	if !exists {
 		h.writeError(w, http.StatusNotFound, "not_found", "Rule not found")
 		return
@@ -787,6 +870,11 @@ func (h *RuleHandler) HandleExecute(w http.ResponseWriter, r *http.Request, id s
 		return
 	}
 
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
 	var req dto.ExecuteRuleRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
@@ -794,7 +882,7 @@ func (h *RuleHandler) HandleExecute(w http.ResponseWriter, r *http.Request, id s
 	}
 
 	h.mu.RLock()
-	rule, exists := h.rules[id]
+	rule, exists := h.ownedRule(userID, id)
 	h.mu.RUnlock()
 
 	if !exists {
@@ -853,6 +941,11 @@ func (h *RuleHandler) HandleTest(w http.ResponseWriter, r *http.Request, id stri
 		return
 	}
 
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
 	var req dto.TestRuleRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
@@ -860,7 +953,7 @@ func (h *RuleHandler) HandleTest(w http.ResponseWriter, r *http.Request, id stri
 	}
 
 	h.mu.RLock()
-	rule, exists := h.rules[id]
+	rule, exists := h.ownedRule(userID, id)
 	h.mu.RUnlock()
 
 	if !exists {
@@ -926,14 +1019,14 @@ func (h *RuleHandler) HandleBatchExecute(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	var req dto.BatchExecuteRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
 		return
 	}
 
-	if req.UserID == "" {
-		h.writeError(w, http.StatusBadRequest, "validation_error", "user_id is required")
+	var req dto.BatchExecuteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
 		return
 	}
 
@@ -944,7 +1037,7 @@ func (h *RuleHandler) HandleBatchExecute(w http.ResponseWriter, r *http.Request)
 	h.mu.RLock()
 	userRules := make([]*Rule, 0)
 	for _, rule := range h.rules {
-		if rule.UserID == req.UserID && rule.Enabled {
+		if rule.UserID == userID && rule.Enabled {
 			userRules = append(userRules, rule)
 		}
 	}
@@ -1006,11 +1099,29 @@ func (h *RuleHandler) HandleBatchExecute(w http.ResponseWriter, r *http.Request)
 	h.writeJSON(w, http.StatusOK, resp)
 }
 
+// requireUserID returns the ID of the user, or organization, the request
+// acts for, writing 401 Unauthorized if there is none
+func (h *RuleHandler) requireUserID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return "", false
+	}
+	return userID, true
+}
+
+// ownedRule returns the rule with the given ID if it belongs to userID.
+// Rules of other users are reported as missing. The caller holds h.mu.
+func (h *RuleHandler) ownedRule(userID, id string) (*Rule, bool) {
+	rule, exists := h.rules[id]
+	if !exists || rule.UserID != userID {
+		return nil, false
+	}
+	return rule, true
+}
+
 // validateCreateRequest validates the create rule request
 func (h *RuleHandler) validateCreateRequest(req *dto.CreateRuleRequest) error {
-	if req.UserID == "" {
-		return newValidationError("user_id is required")
-	}
 	if req.Name == "" {
 		return newValidationError("name is required")
 	}
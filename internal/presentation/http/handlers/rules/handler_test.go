@@ -0,0 +1,77 @@
+package rules
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// serveAs sends a request to the rule routes as the given user
+func serveAs(t *testing.T, mux *http.ServeMux, userID, method, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if userID != "" {
+		req = req.WithContext(middleware.WithUser(req.Context(), &middleware.User{ID: userID}))
+	}
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRulesAreScopedToTheirOwner(t *testing.T) {
+	mux := http.NewServeMux()
+	NewDefaultRouter().RegisterRoutes(mux)
+
+	// A client-supplied user_id is ignored; rules belong to the caller
+	rec := serveAs(t, mux, "user-1", http.MethodPost, "/api/rules", `{"user_id":"user-2","name":"Groceries","type":"categorize"}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var rule Rule
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&rule))
+	assert.Equal(t, "user-1", rule.UserID)
+
+	rec = serveAs(t, mux, "user-1", http.MethodGet, "/api/rules/"+rule.ID, "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	t.Run("other users can't see or change it", func(t *testing.T) {
+		for _, req := range []struct{ method, path, body string }{
+			{http.MethodGet, "/api/rules/" + rule.ID, ""},
+			{http.MethodPut, "/api/rules/" + rule.ID, `{"name":"Mine now"}`},
+			{http.MethodPost, "/api/rules/" + rule.ID + "/disable", ""},
+			{http.MethodGet, "/api/rules/" + rule.ID + "/conditions", ""},
+			{http.MethodPost, "/api/rules/" + rule.ID + "/execute", `{}`},
+			{http.MethodDelete, "/api/rules/" + rule.ID, ""},
+		} {
+			rec := serveAs(t, mux, "user-2", req.method, req.path, req.body)
+			assert.Equal(t, http.StatusNotFound, rec.Code, "%s %s", req.method, req.path)
+		}
+
+		rec := serveAs(t, mux, "user-2", http.MethodGet, "/api/rules?user_id=user-1", "")
+		require.Equal(t, http.StatusOK, rec.Code)
+		var list ListRulesResponse
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&list))
+		assert.Empty(t, list.Rules)
+
+		rec = serveAs(t, mux, "user-2", http.MethodPost, "/api/rules/batch-execute", `{"data":{}}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"rules_evaluated":0`)
+	})
+
+	t.Run("requests without a user are unauthorized", func(t *testing.T) {
+		rec := serveAs(t, mux, "", http.MethodGet, "/api/rules", "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	rec = serveAs(t, mux, "user-1", http.MethodGet, "/api/rules", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var list ListRulesResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&list))
+	require.Len(t, list.Rules, 1)
+	assert.Equal(t, "Groceries", list.Rules[0].Name)
+}
@@ -30,7 +30,7 @@ func NewDefaultRouter() *Router {
 // Core CRUD (5):
 //  1. POST   /api/rules                          - Create rule
 //  2. GET    /api/rules/{id}                     - Get single rule
-//  3. GET    /api/rules                          - List rules (with ?user_id filter)
+//  3. GET    /api/rules                          - List the user's rules
 //  4. PUT    /api/rules/{id}                     - Update rule
 //  5. DELETE /api/rules/{id}                     - Delete rule
 //
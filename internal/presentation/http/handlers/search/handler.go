@@ -0,0 +1,191 @@
+package search
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"clockzen-next/internal/application/search"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// ErrorResponse represents an error response
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// ResultResponse represents a matching message or receipt
+type ResultResponse struct {
+	Type           string     `json:"type"`
+	ID             string     `json:"id"`
+	ConnectionID   string     `json:"connection_id,omitempty"`
+	MessageID      string     `json:"message_id,omitempty"`
+	Title          string     `json:"title"`
+	Snippet        string     `json:"snippet,omitempty"`
+	Date           *time.Time `json:"date,omitempty"`
+	Amount         *float64   `json:"amount,omitempty"`
+	HasAttachments bool       `json:"has_attachments"`
+	Rank           float64    `json:"rank"`
+}
+
+// SearchResponse represents the results of a search
+type SearchResponse struct {
+	Query   string           `json:"query"`
+	Results []ResultResponse `json:"results"`
+	Total   int              `json:"total"`
+}
+
+// SearchHandler handles HTTP requests for search
+type SearchHandler struct {
+	service *search.Service
+}
+
+// NewSearchHandler creates a new SearchHandler instance
+func NewSearchHandler(service *search.Service) *SearchHandler {
+	return &SearchHandler{
+		service: service,
+	}
+}
+
+// HandleSearch handles GET /api/search
+func (h *SearchHandler) HandleSearch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	query, err := parseQuery(r)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_parameter", err.Error())
+		return
+	}
+
+	results, err := h.service.Search(r.Context(), userID, query)
+	if err != nil {
+		switch {
+		case errors.Is(err, search.ErrInvalidDateRange),
+			errors.Is(err, search.ErrInvalidAmountRange),
+			errors.Is(err, search.ErrInvalidType):
+			h.writeError(w, http.StatusBadRequest, "invalid_parameter", err.Error())
+		case errors.Is(err, search.ErrLabelNotFound):
+			h.writeError(w, http.StatusNotFound, "not_found", "Label not found")
+		default:
+			h.writeError(w, http.StatusInternalServerError, "search_failed", "Failed to search: "+err.Error())
+		}
+		return
+	}
+
+	resp := SearchResponse{
+		Query:   query.Text,
+		Results: make([]ResultResponse, len(results)),
+		Total:   len(results),
+	}
+	for i, result := range results {
+		resp.Results[i] = ResultResponse{
+			Type:           result.Type,
+			ID:             result.ID,
+			ConnectionID:   result.ConnectionID,
+			MessageID:      result.MessageID,
+			Title:          result.Title,
+			Snippet:        result.Snippet,
+			Date:           result.Date,
+			Amount:         result.Amount,
+			HasAttachments: result.HasAttachments,
+			Rank:           result.Rank,
+		}
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// parseQuery reads the search text and filters from the query string.
+// Dates are YYYY-MM-DD or RFC 3339; a date alone for to covers that day.
+func parseQuery(r *http.Request) (search.Query, error) {
+	values := r.URL.Query()
+	query := search.Query{
+		Text:    values.Get("q"),
+		LabelID: values.Get("label"),
+		Type:    values.Get("type"),
+	}
+
+	var err error
+	if query.From, err = parseDate(values.Get("from"), false); err != nil {
+		return query, errors.New("from must be a date (YYYY-MM-DD) or RFC 3339 time")
+	}
+	if query.To, err = parseDate(values.Get("to"), true); err != nil {
+		return query, errors.New("to must be a date (YYYY-MM-DD) or RFC 3339 time")
+	}
+	if value := values.Get("has_attachment"); value != "" {
+		hasAttachment, err := strconv.ParseBool(value)
+		if err != nil {
+			return query, errors.New("has_attachment must be true or false")
+		}
+		query.HasAttachment = &hasAttachment
+	}
+	if query.MinAmount, err = parseAmount(values.Get("min_amount")); err != nil {
+		return query, errors.New("min_amount must be a number")
+	}
+	if query.MaxAmount, err = parseAmount(values.Get("max_amount")); err != nil {
+		return query, errors.New("max_amount must be a number")
+	}
+	if value := values.Get("limit"); value != "" {
+		if query.Limit, err = strconv.Atoi(value); err != nil || query.Limit <= 0 {
+			return query, errors.New("limit must be a positive integer")
+		}
+	}
+	return query, nil
+}
+
+// parseDate parses a date or time parameter. A date given as the end of a
+// range is the end of that day.
+func parseDate(value string, endOfDay bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil, err
+	}
+	if endOfDay {
+		t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+	return &t, nil
+}
+
+// parseAmount parses an optional amount parameter
+func parseAmount(value string) (*float64, error) {
+	if value == "" {
+		return nil, nil
+	}
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &amount, nil
+}
+
+// writeJSON writes a JSON response
+func (h *SearchHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *SearchHandler) writeError(w http.ResponseWriter, status int, errCode string, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
@@ -0,0 +1,43 @@
+package search
+
+import (
+	"net/http"
+
+	"clockzen-next/internal/application/search"
+	"clockzen-next/internal/ent"
+)
+
+// Router handles routing for search endpoints
+type Router struct {
+	handler *SearchHandler
+}
+
+// NewRouter creates a new Router with the given handler
+func NewRouter(handler *SearchHandler) *Router {
+	return &Router{
+		handler: handler,
+	}
+}
+
+// NewDefaultRouter creates a new Router backed by the given ent client
+func NewDefaultRouter(entClient *ent.Client) *Router {
+	return &Router{
+		handler: NewSearchHandler(search.NewService(entClient)),
+	}
+}
+
+// RegisterRoutes registers all search routes with the given mux
+// Total routes: 1 endpoint
+//
+// Searches the authenticated user's indexed email messages and receipts
+// across all of their connections. Every filter is optional: q (quoted
+// phrases and -word exclusions work), from and to (YYYY-MM-DD or RFC 3339),
+// label (an email label ID; receipts must come from a message with it),
+// has_attachment (receipts always have one), min_amount and max_amount (a
+// receipt's total or the amount a message mentions), type (email or
+// receipt) and limit (default 50, at most 200).
+//
+//  1. GET    /api/search             - Search messages and receipts
+func (r *Router) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/search", r.handler.HandleSearch)
+}
@@ -0,0 +1,88 @@
+package telemetry
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"clockzen-next/internal/infrastructure/telemetry"
+)
+
+// maxReportSize caps the body of a telemetry report
+const maxReportSize = 256 << 10
+
+// ErrorResponse represents an error response
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// ReceiverHandler accepts telemetry reports from installations
+type ReceiverHandler struct {
+	aggregator *telemetry.Aggregator
+	token      string
+}
+
+// NewReceiverHandler creates a new ReceiverHandler instance. If token is
+// set, reports must carry it as a bearer token.
+func NewReceiverHandler(aggregator *telemetry.Aggregator, token string) *ReceiverHandler {
+	return &ReceiverHandler{
+		aggregator: aggregator,
+		token:      token,
+	}
+}
+
+// HandleReport handles POST /telemetry/v1/reports
+func (h *ReceiverHandler) HandleReport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	if h.token != "" {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(h.token)) != 1 {
+			h.writeError(w, http.StatusUnauthorized, "unauthorized", "A valid telemetry token is required")
+			return
+		}
+	}
+
+	var report telemetry.Report
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxReportSize)).Decode(&report); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			h.writeError(w, http.StatusRequestEntityTooLarge, "too_large", "Report is too large")
+			return
+		}
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	if err := h.aggregator.Receive(report); err != nil {
+		if errors.Is(err, telemetry.ErrInvalidReport) {
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "internal_error", "Failed to record report")
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// writeJSON writes a JSON response
+func (h *ReceiverHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *ReceiverHandler) writeError(w http.ResponseWriter, status int, errCode string, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
@@ -0,0 +1,38 @@
+package telemetry
+
+import (
+	"net/http"
+
+	"clockzen-next/internal/infrastructure/telemetry"
+)
+
+// Router handles routing for the telemetry receiver
+type Router struct {
+	handler *ReceiverHandler
+}
+
+// NewRouter creates a new Router with the given handler
+func NewRouter(handler *ReceiverHandler) *Router {
+	return &Router{
+		handler: handler,
+	}
+}
+
+// NewDefaultRouter creates a new Router receiving into the given aggregator
+func NewDefaultRouter(aggregator *telemetry.Aggregator, token string) *Router {
+	return &Router{
+		handler: NewReceiverHandler(aggregator, token),
+	}
+}
+
+// RegisterRoutes registers the telemetry receiver with the given mux
+// Total routes: 1 endpoint
+//
+// Reports come from other installations, which have no user session, so
+// this route is registered outside the authenticated API and checks its own
+// token instead. Totals are read through /api/admin/telemetry.
+//
+//  1. POST   /telemetry/v1/reports              - Receive a telemetry report
+func (r *Router) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/telemetry/v1/reports", r.handler.HandleReport)
+}
@@ -0,0 +1,280 @@
+package transactions
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/application/transactions"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// CreateCardAccountRequest represents a request to record a card account
+type CreateCardAccountRequest struct {
+	Name                string `json:"name"`
+	CardLastFour        string `json:"card_last_four,omitempty"`
+	StatementClosingDay int    `json:"statement_closing_day"`
+	PaymentDueDays      *int   `json:"payment_due_days,omitempty"`
+}
+
+// UpdateCardAccountRequest represents a request to update a card account
+type UpdateCardAccountRequest struct {
+	Name                *string `json:"name,omitempty"`
+	CardLastFour        *string `json:"card_last_four,omitempty"`
+	StatementClosingDay *int    `json:"statement_closing_day,omitempty"`
+	PaymentDueDays      *int    `json:"payment_due_days,omitempty"`
+}
+
+// CardAccountResponse represents a card account
+type CardAccountResponse struct {
+	ID                  string    `json:"id"`
+	Name                string    `json:"name"`
+	CardLastFour        *string   `json:"card_last_four,omitempty"`
+	StatementClosingDay int       `json:"statement_closing_day"`
+	PaymentDueDays      int       `json:"payment_due_days"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
+
+// ListCardAccountsResponse represents a list of card accounts
+type ListCardAccountsResponse struct {
+	Accounts []CardAccountResponse `json:"accounts"`
+	Total    int                   `json:"total"`
+}
+
+// StatementResponse represents a card's spending in a statement period
+type StatementResponse struct {
+	AccountID        string    `json:"account_id"`
+	PeriodStart      time.Time `json:"period_start"`
+	PeriodEnd        time.Time `json:"period_end"`
+	DueDate          time.Time `json:"due_date"`
+	TotalSpent       float64   `json:"total_spent"`
+	TransactionCount int       `json:"transaction_count"`
+	Closed           bool      `json:"closed"`
+}
+
+// HandleListCardAccounts handles GET /api/transactions/card-accounts
+func (h *TransactionHandler) HandleListCardAccounts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	records, err := h.service.ListCardAccounts(r.Context(), userID)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to list card accounts: "+err.Error())
+		return
+	}
+
+	resp := ListCardAccountsResponse{
+		Accounts: make([]CardAccountResponse, len(records)),
+		Total:    len(records),
+	}
+	for i, record := range records {
+		resp.Accounts[i] = cardAccountToResponse(record)
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleCreateCardAccount handles POST /api/transactions/card-accounts
+func (h *TransactionHandler) HandleCreateCardAccount(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req CreateCardAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	record, err := h.service.CreateCardAccount(r.Context(), userID, transactions.CardAccountInput{
+		Name:                req.Name,
+		CardLastFour:        req.CardLastFour,
+		StatementClosingDay: req.StatementClosingDay,
+		PaymentDueDays:      req.PaymentDueDays,
+	})
+	if err != nil {
+		if isCardAccountValidationError(err) {
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "create_failed", "Failed to create card account: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, cardAccountToResponse(record))
+}
+
+// HandleGetCardAccount handles GET /api/transactions/card-accounts/{id}
+func (h *TransactionHandler) HandleGetCardAccount(w http.ResponseWriter, r *http.Request, accountID string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	record, err := h.service.GetCardAccount(r.Context(), userID, accountID)
+	if err != nil {
+		if errors.Is(err, transactions.ErrCardAccountNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Card account not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get card account: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, cardAccountToResponse(record))
+}
+
+// HandleUpdateCardAccount handles PUT/PATCH /api/transactions/card-accounts/{id}
+func (h *TransactionHandler) HandleUpdateCardAccount(w http.ResponseWriter, r *http.Request, accountID string) {
+	if r.Method != http.MethodPut && r.Method != http.MethodPatch {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only PUT/PATCH methods are allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req UpdateCardAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	record, err := h.service.UpdateCardAccount(r.Context(), userID, accountID, transactions.CardAccountUpdate{
+		Name:                req.Name,
+		CardLastFour:        req.CardLastFour,
+		StatementClosingDay: req.StatementClosingDay,
+		PaymentDueDays:      req.PaymentDueDays,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, transactions.ErrCardAccountNotFound):
+			h.writeError(w, http.StatusNotFound, "not_found", "Card account not found")
+		case isCardAccountValidationError(err):
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		default:
+			h.writeError(w, http.StatusInternalServerError, "update_failed", "Failed to update card account: "+err.Error())
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, cardAccountToResponse(record))
+}
+
+// HandleDeleteCardAccount handles DELETE /api/transactions/card-accounts/{id}
+func (h *TransactionHandler) HandleDeleteCardAccount(w http.ResponseWriter, r *http.Request, accountID string) {
+	if r.Method != http.MethodDelete {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only DELETE method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	if err := h.service.DeleteCardAccount(r.Context(), userID, accountID); err != nil {
+		if errors.Is(err, transactions.ErrCardAccountNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Card account not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "delete_failed", "Failed to delete card account: "+err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleGetStatement handles GET /api/transactions/card-accounts/{id}/statement.
+// The statement period is the one containing ?date, today by default.
+func (h *TransactionHandler) HandleGetStatement(w http.ResponseWriter, r *http.Request, accountID string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	date, err := parseDate(r.URL.Query().Get("date"))
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_parameter", "date: "+err.Error())
+		return
+	}
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	summary, err := h.service.GetStatement(r.Context(), userID, accountID, date)
+	if err != nil {
+		if errors.Is(err, transactions.ErrCardAccountNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Card account not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get statement: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, StatementResponse{
+		AccountID:        summary.Account.ID,
+		PeriodStart:      summary.PeriodStart,
+		PeriodEnd:        summary.PeriodEnd,
+		DueDate:          summary.DueDate,
+		TotalSpent:       summary.TotalSpent,
+		TransactionCount: summary.TransactionCount,
+		Closed:           summary.Closed,
+	})
+}
+
+// isCardAccountValidationError reports whether err is a rejected card
+// account field
+func isCardAccountValidationError(err error) bool {
+	return errors.Is(err, transactions.ErrInvalidCardName) ||
+		errors.Is(err, transactions.ErrInvalidLastFour) ||
+		errors.Is(err, transactions.ErrInvalidDueDays) ||
+		errors.Is(err, analysis.ErrInvalidClosingDay)
+}
+
+// cardAccountToResponse converts a card account to its response
+func cardAccountToResponse(a *ent.CardAccount) CardAccountResponse {
+	return CardAccountResponse{
+		ID:                  a.ID,
+		Name:                a.Name,
+		CardLastFour:        a.CardLastFour,
+		StatementClosingDay: a.StatementClosingDay,
+		PaymentDueDays:      a.PaymentDueDays,
+		CreatedAt:           a.CreatedAt,
+		UpdatedAt:           a.UpdatedAt,
+	}
+}
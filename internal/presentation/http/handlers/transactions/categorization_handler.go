@@ -0,0 +1,368 @@
+package transactions
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"clockzen-next/internal/application/transactions"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// CategorizationRuleRequest represents a request to create or replace a
+// categorization rule
+type CategorizationRuleRequest struct {
+	Name                string   `json:"name"`
+	Priority            int      `json:"priority"`
+	Enabled             *bool    `json:"enabled,omitempty"`
+	MerchantPattern     string   `json:"merchant_pattern,omitempty"`
+	MinAmount           *float64 `json:"min_amount,omitempty"`
+	MaxAmount           *float64 `json:"max_amount,omitempty"`
+	DescriptionKeywords []string `json:"description_keywords,omitempty"`
+	Category            string   `json:"category,omitempty"`
+	Tags                []string `json:"tags,omitempty"`
+}
+
+// CategorizationRuleResponse represents a categorization rule
+type CategorizationRuleResponse struct {
+	ID                  string    `json:"id"`
+	Name                string    `json:"name"`
+	Priority            int       `json:"priority"`
+	Enabled             bool      `json:"enabled"`
+	MerchantPattern     *string   `json:"merchant_pattern,omitempty"`
+	MinAmount           *float64  `json:"min_amount,omitempty"`
+	MaxAmount           *float64  `json:"max_amount,omitempty"`
+	DescriptionKeywords []string  `json:"description_keywords,omitempty"`
+	Category            *string   `json:"category,omitempty"`
+	Tags                []string  `json:"tags,omitempty"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
+
+// ListCategorizationRulesResponse represents the user's categorization
+// rules, in the order they are tried
+type ListCategorizationRulesResponse struct {
+	Rules []CategorizationRuleResponse `json:"rules"`
+	Total int                          `json:"total"`
+}
+
+// CategorizationRunRequest represents a request to preview or re-apply
+// the categorization rules. The body is optional.
+type CategorizationRunRequest struct {
+	// Overwrite recategorizes transactions that already have a category
+	Overwrite bool `json:"overwrite,omitempty"`
+	// FilterID limits the run to the transactions a saved filter matches
+	FilterID string `json:"filter_id,omitempty"`
+	// Rule is a draft rule previewed along with the saved ones
+	Rule *CategorizationRuleRequest `json:"rule,omitempty"`
+}
+
+// CategorizationChangeResponse represents what a run changes about a
+// transaction
+type CategorizationChangeResponse struct {
+	TransactionID    string    `json:"transaction_id"`
+	TransactionDate  time.Time `json:"transaction_date"`
+	Amount           float64   `json:"amount"`
+	MerchantName     *string   `json:"merchant_name,omitempty"`
+	Description      *string   `json:"description,omitempty"`
+	PreviousCategory *string   `json:"previous_category,omitempty"`
+	// Category is the category given, omitted if it is unchanged
+	Category  string   `json:"category,omitempty"`
+	AddedTags []string `json:"added_tags,omitempty"`
+	// RuleIDs are the rules matched, in priority order; "draft" is the
+	// previewed rule
+	RuleIDs []string `json:"rule_ids"`
+}
+
+// CategorizationRunResponse represents what running the categorization
+// rules changed, or would change in a preview
+type CategorizationRunResponse struct {
+	DryRun      bool `json:"dry_run"`
+	Scanned     int  `json:"scanned"`
+	Matched     int  `json:"matched"`
+	Changed     int  `json:"changed"`
+	Categorized int  `json:"categorized"`
+	Tagged      int  `json:"tagged"`
+	// Changes lists the first 100 changes
+	Changes []CategorizationChangeResponse `json:"changes"`
+	// OperationIDs are the bulk operations that undo an applied run
+	OperationIDs []string `json:"operation_ids,omitempty"`
+}
+
+// HandleListCategorizationRules handles GET /api/transactions/categorization-rules
+func (h *TransactionHandler) HandleListCategorizationRules(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	records, err := h.service.ListCategorizationRules(r.Context(), userID)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to list categorization rules: "+err.Error())
+		return
+	}
+
+	resp := ListCategorizationRulesResponse{
+		Rules: make([]CategorizationRuleResponse, len(records)),
+		Total: len(records),
+	}
+	for i, record := range records {
+		resp.Rules[i] = categorizationRuleToResponse(record)
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleCreateCategorizationRule handles POST /api/transactions/categorization-rules
+func (h *TransactionHandler) HandleCreateCategorizationRule(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req CategorizationRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	record, err := h.service.CreateCategorizationRule(r.Context(), userID, categorizationRuleInput(req))
+	if err != nil {
+		if errors.Is(err, transactions.ErrInvalidRule) {
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "create_failed", "Failed to create categorization rule: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, categorizationRuleToResponse(record))
+}
+
+// HandleGetCategorizationRule handles GET /api/transactions/categorization-rules/{id}
+func (h *TransactionHandler) HandleGetCategorizationRule(w http.ResponseWriter, r *http.Request, ruleID string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	record, err := h.service.GetCategorizationRule(r.Context(), userID, ruleID)
+	if err != nil {
+		if errors.Is(err, transactions.ErrCategorizationRuleNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Categorization rule not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get categorization rule: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, categorizationRuleToResponse(record))
+}
+
+// HandleUpdateCategorizationRule handles PUT /api/transactions/categorization-rules/{id}
+func (h *TransactionHandler) HandleUpdateCategorizationRule(w http.ResponseWriter, r *http.Request, ruleID string) {
+	if r.Method != http.MethodPut {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only PUT method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req CategorizationRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	record, err := h.service.UpdateCategorizationRule(r.Context(), userID, ruleID, categorizationRuleInput(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, transactions.ErrCategorizationRuleNotFound):
+			h.writeError(w, http.StatusNotFound, "not_found", "Categorization rule not found")
+		case errors.Is(err, transactions.ErrInvalidRule):
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		default:
+			h.writeError(w, http.StatusInternalServerError, "update_failed", "Failed to update categorization rule: "+err.Error())
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, categorizationRuleToResponse(record))
+}
+
+// HandleDeleteCategorizationRule handles DELETE /api/transactions/categorization-rules/{id}
+func (h *TransactionHandler) HandleDeleteCategorizationRule(w http.ResponseWriter, r *http.Request, ruleID string) {
+	if r.Method != http.MethodDelete {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only DELETE method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	if err := h.service.DeleteCategorizationRule(r.Context(), userID, ruleID); err != nil {
+		if errors.Is(err, transactions.ErrCategorizationRuleNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Categorization rule not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "delete_failed", "Failed to delete categorization rule: "+err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandlePreviewCategorization handles POST /api/transactions/categorization-rules/preview
+func (h *TransactionHandler) HandlePreviewCategorization(w http.ResponseWriter, r *http.Request) {
+	h.handleCategorizationRun(w, r, true)
+}
+
+// HandleApplyCategorization handles POST /api/transactions/categorization-rules/apply
+func (h *TransactionHandler) HandleApplyCategorization(w http.ResponseWriter, r *http.Request) {
+	h.handleCategorizationRun(w, r, false)
+}
+
+// handleCategorizationRun previews or applies the user's categorization
+// rules
+func (h *TransactionHandler) handleCategorizationRun(w http.ResponseWriter, r *http.Request, dryRun bool) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req CategorizationRunRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+	opts := transactions.CategorizationOptions{
+		Overwrite: req.Overwrite,
+		FilterID:  req.FilterID,
+	}
+
+	var (
+		run *transactions.CategorizationRun
+		err error
+	)
+	if dryRun {
+		if req.Rule != nil {
+			draft := categorizationRuleInput(*req.Rule)
+			opts.Draft = &draft
+		}
+		run, err = h.service.PreviewCategorization(r.Context(), userID, opts)
+	} else {
+		if req.Rule != nil {
+			h.writeError(w, http.StatusBadRequest, "validation_error", "rule can only be previewed; save it to apply it")
+			return
+		}
+		run, err = h.service.ApplyCategorization(r.Context(), userID, opts)
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, transactions.ErrInvalidRule):
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		case errors.Is(err, transactions.ErrFilterNotFound):
+			h.writeError(w, http.StatusNotFound, "not_found", "Saved filter not found")
+		default:
+			h.writeError(w, http.StatusInternalServerError, "categorization_failed", "Failed to run categorization rules: "+err.Error())
+		}
+		return
+	}
+
+	resp := CategorizationRunResponse{
+		DryRun:      run.DryRun,
+		Scanned:     run.Scanned,
+		Matched:     run.Matched,
+		Changed:     run.Changed,
+		Categorized: run.Categorized,
+		Tagged:      run.Tagged,
+		Changes:     make([]CategorizationChangeResponse, len(run.Changes)),
+	}
+	resp.OperationIDs = run.OperationIDs
+	for i, change := range run.Changes {
+		resp.Changes[i] = CategorizationChangeResponse{
+			TransactionID:    change.Transaction.ID,
+			TransactionDate:  change.Transaction.TransactionDate,
+			Amount:           change.Transaction.Amount,
+			MerchantName:     change.Transaction.MerchantName,
+			Description:      change.Transaction.Description,
+			PreviousCategory: change.PreviousCategory,
+			Category:         change.Category,
+			AddedTags:        change.AddedTags,
+			RuleIDs:          change.RuleIDs,
+		}
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// categorizationRuleInput converts a categorization rule request; rules
+// are enabled unless the request says otherwise
+func categorizationRuleInput(req CategorizationRuleRequest) transactions.CategorizationRuleInput {
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+	return transactions.CategorizationRuleInput{
+		Name:                req.Name,
+		Priority:            req.Priority,
+		Enabled:             enabled,
+		MerchantPattern:     req.MerchantPattern,
+		MinAmount:           req.MinAmount,
+		MaxAmount:           req.MaxAmount,
+		DescriptionKeywords: req.DescriptionKeywords,
+		Category:            req.Category,
+		Tags:                req.Tags,
+	}
+}
+
+// categorizationRuleToResponse converts a categorization rule to its
+// response
+func categorizationRuleToResponse(rule *ent.CategorizationRule) CategorizationRuleResponse {
+	return CategorizationRuleResponse{
+		ID:                  rule.ID,
+		Name:                rule.Name,
+		Priority:            rule.Priority,
+		Enabled:             rule.Enabled,
+		MerchantPattern:     rule.MerchantPattern,
+		MinAmount:           rule.MinAmount,
+		MaxAmount:           rule.MaxAmount,
+		DescriptionKeywords: rule.DescriptionKeywords,
+		Category:            rule.Category,
+		Tags:                rule.Tags,
+		CreatedAt:           rule.CreatedAt,
+		UpdatedAt:           rule.UpdatedAt,
+	}
+}
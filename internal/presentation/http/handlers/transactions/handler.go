@@ -0,0 +1,380 @@
+package transactions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"clockzen-next/internal/application/transactions"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/transaction"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// ErrorResponse represents an error response
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// CreateTransactionRequest represents a request to enter a transaction by
+// hand. Only amount is required; the rest default to a cash purchase today.
+type CreateTransactionRequest struct {
+	Type            string   `json:"type,omitempty"`
+	Amount          float64  `json:"amount"`
+	Currency        string   `json:"currency,omitempty"`
+	TransactionDate string   `json:"transaction_date,omitempty"`
+	Description     string   `json:"description,omitempty"`
+	MerchantName    string   `json:"merchant_name,omitempty"`
+	Category        string   `json:"category,omitempty"`
+	PaymentMethod   string   `json:"payment_method,omitempty"`
+	Tags            []string `json:"tags,omitempty"`
+	Notes           string   `json:"notes,omitempty"`
+	MemberID        string   `json:"member_id,omitempty"`
+}
+
+// TransactionResponse represents a transaction
+type TransactionResponse struct {
+	ID              string    `json:"id"`
+	UserID          string    `json:"user_id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Status          string    `json:"status"`
+	Amount          float64   `json:"amount"`
+	Currency        string    `json:"currency"`
+	RoundUpAmount   float64   `json:"round_up_amount"`
+	TransactionDate time.Time `json:"transaction_date"`
+	Description     *string   `json:"description,omitempty"`
+	MerchantName    *string   `json:"merchant_name,omitempty"`
+	MerchantID      *string   `json:"merchant_id,omitempty"`
+	Category        *string   `json:"category,omitempty"`
+	PaymentMethod   *string   `json:"payment_method,omitempty"`
+	Tags            []string  `json:"tags,omitempty"`
+	Notes           *string   `json:"notes,omitempty"`
+	MemberID        *string   `json:"member_id,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// RoundingRuleRequest represents a request to set the rounding rule
+type RoundingRuleRequest struct {
+	Increment      float64  `json:"increment"`
+	Multiplier     float64  `json:"multiplier,omitempty"`
+	MaxRoundUp     *float64 `json:"max_round_up,omitempty"`
+	PaymentMethods []string `json:"payment_methods,omitempty"`
+	Enabled        *bool    `json:"enabled,omitempty"`
+}
+
+// RoundingRuleResponse represents a rounding rule
+type RoundingRuleResponse struct {
+	Increment      float64   `json:"increment"`
+	Multiplier     float64   `json:"multiplier"`
+	MaxRoundUp     *float64  `json:"max_round_up,omitempty"`
+	PaymentMethods []string  `json:"payment_methods"`
+	Enabled        bool      `json:"enabled"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// RoundUpSummaryResponse represents the round-ups set aside over a range
+type RoundUpSummaryResponse struct {
+	StartDate        time.Time `json:"start_date"`
+	EndDate          time.Time `json:"end_date"`
+	TotalRoundUp     float64   `json:"total_round_up"`
+	TotalSpent       float64   `json:"total_spent"`
+	TransactionCount int       `json:"transaction_count"`
+}
+
+// TransactionHandler handles HTTP requests for manual transactions and
+// rounding rules
+type TransactionHandler struct {
+	service *transactions.Service
+}
+
+// NewTransactionHandler creates a new TransactionHandler instance
+func NewTransactionHandler(service *transactions.Service) *TransactionHandler {
+	return &TransactionHandler{
+		service: service,
+	}
+}
+
+// HandleCreate handles POST /api/transactions
+func (h *TransactionHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req CreateTransactionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	if req.Amount <= 0 {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "amount must be positive")
+		return
+	}
+	if req.Type != "" {
+		if err := transaction.TypeValidator(transaction.Type(req.Type)); err != nil {
+			h.writeError(w, http.StatusBadRequest, "validation_error", "type must be one of: purchase, refund, payment, withdrawal, deposit, transfer, other")
+			return
+		}
+	}
+	transactionDate, err := parseDate(req.TransactionDate)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "transaction_date: "+err.Error())
+		return
+	}
+
+	record, err := h.service.CreateManualTransaction(r.Context(), transactions.ManualTransaction{
+		UserID:          userID,
+		Type:            transaction.Type(req.Type),
+		Amount:          req.Amount,
+		Currency:        req.Currency,
+		TransactionDate: transactionDate,
+		Description:     req.Description,
+		MerchantName:    req.MerchantName,
+		Category:        req.Category,
+		PaymentMethod:   req.PaymentMethod,
+		Tags:            req.Tags,
+		Notes:           req.Notes,
+		MemberID:        req.MemberID,
+	})
+	if err != nil {
+		if errors.Is(err, transactions.ErrMemberNotFound) {
+			h.writeError(w, http.StatusBadRequest, "validation_error", "member_id is not a member of your household")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "create_failed", "Failed to create transaction: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, transactionToResponse(record))
+}
+
+// HandleGetRoundingRule handles GET /api/transactions/rounding-rule
+func (h *TransactionHandler) HandleGetRoundingRule(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	rule, err := h.service.GetRoundingRule(r.Context(), userID)
+	if err != nil {
+		if errors.Is(err, transactions.ErrRoundingRuleNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "No rounding rule is set")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get rounding rule: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, roundingRuleToResponse(rule))
+}
+
+// HandleSetRoundingRule handles PUT /api/transactions/rounding-rule
+func (h *TransactionHandler) HandleSetRoundingRule(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only PUT method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req RoundingRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+
+	rule, err := h.service.SetRoundingRule(r.Context(), userID, transactions.RoundingRuleInput{
+		Increment:      req.Increment,
+		Multiplier:     req.Multiplier,
+		MaxRoundUp:     req.MaxRoundUp,
+		PaymentMethods: req.PaymentMethods,
+		Enabled:        enabled,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, transactions.ErrInvalidIncrement),
+			errors.Is(err, transactions.ErrInvalidMultiplier),
+			errors.Is(err, transactions.ErrInvalidMaxRoundUp):
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		default:
+			h.writeError(w, http.StatusInternalServerError, "update_failed", "Failed to save rounding rule: "+err.Error())
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, roundingRuleToResponse(rule))
+}
+
+// HandleDeleteRoundingRule handles DELETE /api/transactions/rounding-rule
+func (h *TransactionHandler) HandleDeleteRoundingRule(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only DELETE method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	if err := h.service.DeleteRoundingRule(r.Context(), userID); err != nil {
+		if errors.Is(err, transactions.ErrRoundingRuleNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "No rounding rule is set")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "delete_failed", "Failed to delete rounding rule: "+err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleRoundUpSummary handles GET /api/transactions/round-ups. The range
+// defaults to the current month to date.
+func (h *TransactionHandler) HandleRoundUpSummary(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	startDate, err := parseDate(r.URL.Query().Get("start_date"))
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_parameter", "start_date: "+err.Error())
+		return
+	}
+	endDate, err := parseDate(r.URL.Query().Get("end_date"))
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_parameter", "end_date: "+err.Error())
+		return
+	}
+	if endDate.IsZero() {
+		endDate = time.Now()
+	}
+	if startDate.IsZero() {
+		startDate = time.Date(endDate.Year(), endDate.Month(), 1, 0, 0, 0, 0, endDate.Location())
+	}
+	if endDate.Before(startDate) {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "end_date must be after start_date")
+		return
+	}
+
+	summary, err := h.service.GetRoundUpSummary(r.Context(), userID, startDate, endDate)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get round-ups: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, RoundUpSummaryResponse{
+		StartDate:        summary.StartDate,
+		EndDate:          summary.EndDate,
+		TotalRoundUp:     summary.TotalRoundUp,
+		TotalSpent:       summary.TotalSpent,
+		TransactionCount: summary.TransactionCount,
+	})
+}
+
+// parseDate parses a date (YYYY-MM-DD, midnight UTC) or an RFC 3339
+// timestamp; empty is a zero time
+func parseDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not a date (YYYY-MM-DD) or RFC 3339 timestamp", value)
+	}
+	return t, nil
+}
+
+// transactionToResponse converts a transaction to its response
+func transactionToResponse(t *ent.Transaction) TransactionResponse {
+	return TransactionResponse{
+		ID:              t.ID,
+		UserID:          t.UserID,
+		Source:          string(t.Source),
+		Type:            string(t.Type),
+		Status:          string(t.Status),
+		Amount:          t.Amount,
+		Currency:        t.Currency,
+		RoundUpAmount:   t.RoundUpAmount,
+		TransactionDate: t.TransactionDate,
+		Description:     t.Description,
+		MerchantName:    t.MerchantName,
+		MerchantID:      t.MerchantID,
+		Category:        t.MerchantCategory,
+		PaymentMethod:   t.PaymentMethod,
+		Tags:            t.CategoryTags,
+		Notes:           t.Notes,
+		MemberID:        t.MemberID,
+		CreatedAt:       t.CreatedAt,
+	}
+}
+
+// roundingRuleToResponse converts a rounding rule to its response
+func roundingRuleToResponse(rule *ent.RoundingRule) RoundingRuleResponse {
+	paymentMethods := rule.PaymentMethods
+	if paymentMethods == nil {
+		paymentMethods = []string{}
+	}
+	return RoundingRuleResponse{
+		Increment:      rule.Increment,
+		Multiplier:     rule.Multiplier,
+		MaxRoundUp:     rule.MaxRoundUp,
+		PaymentMethods: paymentMethods,
+		Enabled:        rule.Enabled,
+		CreatedAt:      rule.CreatedAt,
+		UpdatedAt:      rule.UpdatedAt,
+	}
+}
+
+// writeJSON writes a JSON response
+func (h *TransactionHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *TransactionHandler) writeError(w http.ResponseWriter, status int, errCode string, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
@@ -0,0 +1,259 @@
+package transactions
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"clockzen-next/internal/application/transactions"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// CreateMemberRequest represents a request to add a household member
+type CreateMemberRequest struct {
+	Name          string   `json:"name"`
+	ConnectionIDs []string `json:"connection_ids,omitempty"`
+}
+
+// UpdateMemberRequest represents a request to update a household member
+type UpdateMemberRequest struct {
+	Name          *string   `json:"name,omitempty"`
+	ConnectionIDs *[]string `json:"connection_ids,omitempty"`
+}
+
+// AssignMemberRequest represents a request to attribute a transaction to a
+// household member. An empty MemberID clears the assignment.
+type AssignMemberRequest struct {
+	MemberID string `json:"member_id"`
+}
+
+// MemberResponse represents a household member
+type MemberResponse struct {
+	ID            string    `json:"id"`
+	Name          string    `json:"name"`
+	ConnectionIDs []string  `json:"connection_ids"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// ListMembersResponse represents a list of household members
+type ListMembersResponse struct {
+	Members []MemberResponse `json:"members"`
+	Total   int              `json:"total"`
+}
+
+// HandleListMembers handles GET /api/transactions/members
+func (h *TransactionHandler) HandleListMembers(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	records, err := h.service.ListMembers(r.Context(), userID)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to list household members: "+err.Error())
+		return
+	}
+
+	resp := ListMembersResponse{
+		Members: make([]MemberResponse, len(records)),
+		Total:   len(records),
+	}
+	for i, record := range records {
+		resp.Members[i] = memberToResponse(record)
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleCreateMember handles POST /api/transactions/members
+func (h *TransactionHandler) HandleCreateMember(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req CreateMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	record, err := h.service.CreateMember(r.Context(), userID, transactions.MemberInput{
+		Name:          req.Name,
+		ConnectionIDs: req.ConnectionIDs,
+	})
+	if err != nil {
+		if !h.writeMemberValidationError(w, err) {
+			h.writeError(w, http.StatusInternalServerError, "create_failed", "Failed to create household member: "+err.Error())
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, memberToResponse(record))
+}
+
+// HandleGetMember handles GET /api/transactions/members/{id}
+func (h *TransactionHandler) HandleGetMember(w http.ResponseWriter, r *http.Request, memberID string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	record, err := h.service.GetMember(r.Context(), userID, memberID)
+	if err != nil {
+		if errors.Is(err, transactions.ErrMemberNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Household member not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get household member: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, memberToResponse(record))
+}
+
+// HandleUpdateMember handles PUT/PATCH /api/transactions/members/{id}
+func (h *TransactionHandler) HandleUpdateMember(w http.ResponseWriter, r *http.Request, memberID string) {
+	if r.Method != http.MethodPut && r.Method != http.MethodPatch {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only PUT/PATCH methods are allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req UpdateMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	record, err := h.service.UpdateMember(r.Context(), userID, memberID, transactions.MemberUpdate{
+		Name:          req.Name,
+		ConnectionIDs: req.ConnectionIDs,
+	})
+	if err != nil {
+		if errors.Is(err, transactions.ErrMemberNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Household member not found")
+			return
+		}
+		if !h.writeMemberValidationError(w, err) {
+			h.writeError(w, http.StatusInternalServerError, "update_failed", "Failed to update household member: "+err.Error())
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, memberToResponse(record))
+}
+
+// HandleDeleteMember handles DELETE /api/transactions/members/{id}
+func (h *TransactionHandler) HandleDeleteMember(w http.ResponseWriter, r *http.Request, memberID string) {
+	if r.Method != http.MethodDelete {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only DELETE method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	if err := h.service.DeleteMember(r.Context(), userID, memberID); err != nil {
+		if errors.Is(err, transactions.ErrMemberNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Household member not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "delete_failed", "Failed to delete household member: "+err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleAssignMember handles PUT /api/transactions/{id}/member
+func (h *TransactionHandler) HandleAssignMember(w http.ResponseWriter, r *http.Request, transactionID string) {
+	if r.Method != http.MethodPut {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only PUT method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req AssignMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	record, err := h.service.AssignTransaction(r.Context(), userID, transactionID, req.MemberID)
+	if err != nil {
+		switch {
+		case errors.Is(err, transactions.ErrTransactionNotFound):
+			h.writeError(w, http.StatusNotFound, "not_found", "Transaction not found")
+		case errors.Is(err, transactions.ErrMemberNotFound):
+			h.writeError(w, http.StatusBadRequest, "validation_error", "member_id is not a member of your household")
+		default:
+			h.writeError(w, http.StatusInternalServerError, "update_failed", "Failed to assign transaction: "+err.Error())
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, transactionToResponse(record))
+}
+
+// writeMemberValidationError writes the response for a rejected household
+// member field, reporting whether err was one
+func (h *TransactionHandler) writeMemberValidationError(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, transactions.ErrInvalidMemberName), errors.Is(err, transactions.ErrConnectionNotFound):
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+	case errors.Is(err, transactions.ErrConnectionAssigned):
+		h.writeError(w, http.StatusConflict, "conflict", err.Error())
+	default:
+		return false
+	}
+	return true
+}
+
+// memberToResponse converts a household member to its response
+func memberToResponse(m *ent.HouseholdMember) MemberResponse {
+	connectionIDs := m.ConnectionIds
+	if connectionIDs == nil {
+		connectionIDs = []string{}
+	}
+	return MemberResponse{
+		ID:            m.ID,
+		Name:          m.Name,
+		ConnectionIDs: connectionIDs,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
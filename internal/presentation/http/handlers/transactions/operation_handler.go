@@ -0,0 +1,250 @@
+package transactions
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"clockzen-next/internal/application/transactions"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// BulkSelectionRequest picks the transactions a bulk operation changes:
+// those in TransactionIDs, or else every one from MerchantName, or else
+// every one the saved filter FilterID matches
+type BulkSelectionRequest struct {
+	TransactionIDs []string `json:"transaction_ids,omitempty"`
+	MerchantName   string   `json:"merchant_name,omitempty"`
+	FilterID       string   `json:"filter_id,omitempty"`
+}
+
+// BulkRecategorizeRequest represents a request to move transactions to a
+// category
+type BulkRecategorizeRequest struct {
+	BulkSelectionRequest
+	Category string `json:"category"`
+}
+
+// OperationResponse represents a bulk operation on transactions
+type OperationResponse struct {
+	ID               string     `json:"id"`
+	Kind             string     `json:"kind"`
+	Category         *string    `json:"category,omitempty"`
+	TransactionIDs   []string   `json:"transaction_ids"`
+	TransactionCount int        `json:"transaction_count"`
+	CreatedAt        time.Time  `json:"created_at"`
+	ExpiresAt        time.Time  `json:"expires_at"`
+	UndoneAt         *time.Time `json:"undone_at,omitempty"`
+	// Undoable is set while the operation can still be undone
+	Undoable bool `json:"undoable"`
+}
+
+// ListOperationsResponse represents a list of bulk operations
+type ListOperationsResponse struct {
+	Operations []OperationResponse `json:"operations"`
+	Total      int                 `json:"total"`
+}
+
+// UndoOperationResponse represents the result of undoing a bulk operation
+type UndoOperationResponse struct {
+	Operation OperationResponse `json:"operation"`
+	Restored  int               `json:"restored"`
+	// Skipped counts transactions changed again since the operation, which
+	// are left as they are
+	Skipped int `json:"skipped"`
+}
+
+// HandleBulkRecategorize handles POST /api/transactions/bulk/recategorize
+func (h *TransactionHandler) HandleBulkRecategorize(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req BulkRecategorizeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	record, err := h.service.BulkRecategorize(r.Context(), userID, bulkSelection(req.BulkSelectionRequest), req.Category)
+	if err != nil {
+		if !h.writeBulkValidationError(w, err) {
+			h.writeError(w, http.StatusInternalServerError, "update_failed", "Failed to recategorize transactions: "+err.Error())
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, operationToResponse(record, time.Now()))
+}
+
+// HandleBulkDelete handles POST /api/transactions/bulk/delete
+func (h *TransactionHandler) HandleBulkDelete(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req BulkSelectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	record, err := h.service.BulkDelete(r.Context(), userID, bulkSelection(req))
+	if err != nil {
+		if !h.writeBulkValidationError(w, err) {
+			h.writeError(w, http.StatusInternalServerError, "delete_failed", "Failed to delete transactions: "+err.Error())
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, operationToResponse(record, time.Now()))
+}
+
+// HandleListOperations handles GET /api/operations
+func (h *TransactionHandler) HandleListOperations(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	records, err := h.service.ListOperations(r.Context(), userID)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to list operations: "+err.Error())
+		return
+	}
+
+	now := time.Now()
+	resp := ListOperationsResponse{
+		Operations: make([]OperationResponse, len(records)),
+		Total:      len(records),
+	}
+	for i, record := range records {
+		resp.Operations[i] = operationToResponse(record, now)
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleGetOperation handles GET /api/operations/{id}
+func (h *TransactionHandler) HandleGetOperation(w http.ResponseWriter, r *http.Request, operationID string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	record, err := h.service.GetOperation(r.Context(), userID, operationID)
+	if err != nil {
+		if errors.Is(err, transactions.ErrOperationNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Operation not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get operation: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, operationToResponse(record, time.Now()))
+}
+
+// HandleUndoOperation handles POST /api/operations/{id}/undo
+func (h *TransactionHandler) HandleUndoOperation(w http.ResponseWriter, r *http.Request, operationID string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	result, err := h.service.UndoOperation(r.Context(), userID, operationID)
+	if err != nil {
+		switch {
+		case errors.Is(err, transactions.ErrOperationNotFound):
+			h.writeError(w, http.StatusNotFound, "not_found", "Operation not found")
+		case errors.Is(err, transactions.ErrOperationUndone):
+			h.writeError(w, http.StatusConflict, "conflict", err.Error())
+		case errors.Is(err, transactions.ErrUndoWindowExpired):
+			h.writeError(w, http.StatusGone, "expired", err.Error())
+		default:
+			h.writeError(w, http.StatusInternalServerError, "undo_failed", "Failed to undo operation: "+err.Error())
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, UndoOperationResponse{
+		Operation: operationToResponse(result.Operation, time.Now()),
+		Restored:  result.Restored,
+		Skipped:   result.Skipped,
+	})
+}
+
+// writeBulkValidationError writes the response for a rejected bulk
+// operation, reporting whether err was one
+func (h *TransactionHandler) writeBulkValidationError(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, transactions.ErrEmptySelection),
+		errors.Is(err, transactions.ErrSelectionTooLarge),
+		errors.Is(err, transactions.ErrInvalidCategory):
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+	case errors.Is(err, transactions.ErrTransactionNotFound),
+		errors.Is(err, transactions.ErrFilterNotFound):
+		h.writeError(w, http.StatusNotFound, "not_found", err.Error())
+	default:
+		return false
+	}
+	return true
+}
+
+// bulkSelection converts a requested selection
+func bulkSelection(req BulkSelectionRequest) transactions.BulkSelection {
+	return transactions.BulkSelection{
+		TransactionIDs: req.TransactionIDs,
+		MerchantName:   req.MerchantName,
+		FilterID:       req.FilterID,
+	}
+}
+
+// operationToResponse converts a bulk operation to its response, undoable
+// if it can still be undone at now
+func operationToResponse(op *ent.BulkOperation, now time.Time) OperationResponse {
+	return OperationResponse{
+		ID:               op.ID,
+		Kind:             string(op.Kind),
+		Category:         op.Category,
+		TransactionIDs:   op.TransactionIds,
+		TransactionCount: len(op.TransactionIds),
+		CreatedAt:        op.CreatedAt,
+		ExpiresAt:        op.ExpiresAt,
+		UndoneAt:         op.UndoneAt,
+		Undoable:         op.UndoneAt == nil && !now.After(op.ExpiresAt),
+	}
+}
@@ -0,0 +1,333 @@
+package transactions
+
+import (
+	"net/http"
+	"strings"
+
+	"clockzen-next/internal/application/transactions"
+	"clockzen-next/internal/ent"
+)
+
+// Router handles routing for transaction endpoints
+type Router struct {
+	handler *TransactionHandler
+}
+
+// NewRouter creates a new Router with the given handler
+func NewRouter(handler *TransactionHandler) *Router {
+	return &Router{
+		handler: handler,
+	}
+}
+
+// NewDefaultRouter creates a new Router backed by the given ent client
+func NewDefaultRouter(entClient *ent.Client) *Router {
+	return &Router{
+		handler: NewTransactionHandler(transactions.NewService(entClient)),
+	}
+}
+
+// RegisterRoutes registers all transaction routes with the given mux
+// Total routes: 37 endpoints
+//
+// Transactions, rounding rules, card accounts and household members belong
+// to the authenticated user. Purchases entered by hand are rounded up by the
+// user's rounding rule, and are included in spending analysis alongside
+// those from receipts. A card account's statement closing day sets the
+// periods of statement-aligned analyses (period "statement"). Transactions
+// are attributed to the household member their receipt's connection is
+// assigned to, unless assigned to a member by hand.
+//
+// Bulk recategorizations and deletions pick transactions by
+// transaction_ids, or else every one from merchant_name, up to 1000 at a
+// time. Each is journaled with the transactions as they were, and can be
+// undone for 24 hours; undoing leaves alone transactions changed again
+// since (recategorized, or recreated).
+//
+// Searches take min_amount, max_amount, category, tag, merchant (a raw or
+// canonical name, or a merchant ID), source, q (text in the description,
+// merchant name or notes), start_date and end_date, matching all of them;
+// lists repeat or are comma-separated and match any entry. They are sorted
+// by sort (transaction_date, amount, merchant_name or created_at, "-" for
+// descending; the latest first by default) and paged by limit (default 50,
+// up to 500) and offset. Filters are saved by name with the same criteria,
+// and referenced by filter_id: a search narrows the saved filter with its
+// own criteria, and bulk operations change every transaction it matches.
+//
+// Categorization rules match transactions by merchant_pattern (a regular
+// expression on the merchant name, ignoring case), min_amount and
+// max_amount, and description_keywords (any of them, ignoring case),
+// assigning a category and tags. Rules are tried by priority, lowest
+// first: the first matching rule with a category gives it, and every
+// matching rule adds its tags. Transactions entered by hand are categorized
+// as they are recorded, unless given a category. Preview and apply run the
+// rules over the user's transactions (or a saved filter's, with
+// filter_id): only uncategorized ones get a category unless overwrite is
+// set. Previews can include an unsaved rule, and change nothing. Applying
+// journals the changes as bulk operations, one per batch of 500
+// transactions, listed in operation_ids and undone like bulk changes.
+//
+// Category suggestions come from a naive Bayes model of merchant names and
+// descriptions, learned from the user's corrections: suggestions they
+// accepted or corrected, and bulk recategorizations not undone. Each
+// reports the model's confidence in its best categories; below
+// min_confidence (0.6 by default) the categorization rules suggest
+// instead. Suggestions are for the transactions in transaction_id, or the
+// latest uncategorized ones up to limit (default 50). Accepting or
+// correcting a suggestion categorizes the transaction, and a rejected
+// category isn't suggested for it again.
+//
+//  1. POST   /api/transactions                                 - Enter a transaction by hand (e.g. a cash expense)
+//  2. GET    /api/transactions/rounding-rule                   - Get the rounding rule
+//  3. PUT    /api/transactions/rounding-rule                   - Create or replace the rounding rule
+//  4. DELETE /api/transactions/rounding-rule                   - Delete the rounding rule
+//  5. GET    /api/transactions/round-ups                       - Total round-ups (with ?start_date and ?end_date)
+//  6. GET    /api/transactions/card-accounts                   - List card accounts
+//  7. POST   /api/transactions/card-accounts                   - Record a card account and its statement cycle
+//  8. GET    /api/transactions/card-accounts/{id}              - Get a card account
+//  9. PUT    /api/transactions/card-accounts/{id}              - Update a card account (also PATCH)
+//  10. DELETE /api/transactions/card-accounts/{id}              - Delete a card account
+//  11. GET    /api/transactions/card-accounts/{id}/statement    - Spending in the statement period (with ?date)
+//  12. GET    /api/transactions/members                         - List household members
+//  13. POST   /api/transactions/members                         - Add a household member and their connections
+//  14. GET    /api/transactions/members/{id}                    - Get a household member
+//  15. PUT    /api/transactions/members/{id}                    - Update a household member (also PATCH)
+//  16. DELETE /api/transactions/members/{id}                    - Remove a household member
+//  17. PUT    /api/transactions/{id}/member                     - Assign a transaction to a member (empty member_id clears it)
+//  18. POST   /api/transactions/bulk/recategorize               - Move transactions to a category
+//  19. POST   /api/transactions/bulk/delete                     - Delete transactions
+//  20. GET    /api/operations                                   - List bulk operations, the latest first
+//  21. GET    /api/operations/{id}                              - Get a bulk operation
+//  22. POST   /api/operations/{id}/undo                         - Undo a bulk operation
+//  23. GET    /api/transactions                                 - Search transactions (with ?filter_id for a saved filter)
+//  24. GET    /api/transactions/filters                         - List saved filters
+//  25. POST   /api/transactions/filters                         - Save a filter under a name
+//  26. GET    /api/transactions/filters/{id}                    - Get a saved filter
+//  27. PUT    /api/transactions/filters/{id}                    - Rename or replace a saved filter
+//  28. DELETE /api/transactions/filters/{id}                    - Delete a saved filter
+//  29. GET    /api/transactions/categorization-rules            - List categorization rules in priority order
+//  30. POST   /api/transactions/categorization-rules            - Create a categorization rule
+//  31. GET    /api/transactions/categorization-rules/{id}       - Get a categorization rule
+//  32. PUT    /api/transactions/categorization-rules/{id}       - Replace a categorization rule
+//  33. DELETE /api/transactions/categorization-rules/{id}       - Delete a categorization rule
+//  34. POST   /api/transactions/categorization-rules/preview    - Dry-run the rules (and a draft rule) over past transactions
+//  35. POST   /api/transactions/categorization-rules/apply      - Re-apply the rules to past transactions
+//  36. GET    /api/transactions/category-suggestions            - Suggest categories with confidence scores
+//  37. POST   /api/transactions/category-suggestions/feedback   - Accept or reject (and correct) a suggestion
+func (r *Router) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/transactions", r.handleTransactions)
+	mux.HandleFunc("/api/transactions/filters", r.handleSavedFilters)
+	mux.HandleFunc("/api/transactions/filters/", r.handleSavedFilterByID)
+	mux.HandleFunc("/api/transactions/categorization-rules", r.handleCategorizationRules)
+	mux.HandleFunc("/api/transactions/categorization-rules/preview", r.handler.HandlePreviewCategorization)
+	mux.HandleFunc("/api/transactions/categorization-rules/apply", r.handler.HandleApplyCategorization)
+	mux.HandleFunc("/api/transactions/categorization-rules/", r.handleCategorizationRuleByID)
+	mux.HandleFunc("/api/transactions/category-suggestions", r.handler.HandleListCategorySuggestions)
+	mux.HandleFunc("/api/transactions/category-suggestions/feedback", r.handler.HandleSuggestionFeedback)
+	mux.HandleFunc("/api/transactions/rounding-rule", r.handleRoundingRule)
+	mux.HandleFunc("/api/transactions/round-ups", r.handler.HandleRoundUpSummary)
+	mux.HandleFunc("/api/transactions/card-accounts", r.handleCardAccounts)
+	mux.HandleFunc("/api/transactions/card-accounts/", r.handleCardAccountByPath)
+	mux.HandleFunc("/api/transactions/members", r.handleMembers)
+	mux.HandleFunc("/api/transactions/members/", r.handleMemberByPath)
+	mux.HandleFunc("/api/transactions/bulk/recategorize", r.handler.HandleBulkRecategorize)
+	mux.HandleFunc("/api/transactions/bulk/delete", r.handler.HandleBulkDelete)
+	mux.HandleFunc("/api/transactions/", r.handleTransactionByPath)
+	mux.HandleFunc("/api/operations", r.handler.HandleListOperations)
+	mux.HandleFunc("/api/operations/", r.handleOperationByPath)
+}
+
+// handleTransactions routes requests for /api/transactions
+func (r *Router) handleTransactions(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleSearch(w, req)
+	case http.MethodPost:
+		r.handler.HandleCreate(w, req)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleSavedFilters routes requests for /api/transactions/filters
+func (r *Router) handleSavedFilters(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleListSavedFilters(w, req)
+	case http.MethodPost:
+		r.handler.HandleCreateSavedFilter(w, req)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleSavedFilterByID routes requests for /api/transactions/filters/{id}
+func (r *Router) handleSavedFilterByID(w http.ResponseWriter, req *http.Request) {
+	filterID := strings.TrimPrefix(req.URL.Path, "/api/transactions/filters/")
+	if filterID == "" || strings.Contains(filterID, "/") {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleGetSavedFilter(w, req, filterID)
+	case http.MethodPut:
+		r.handler.HandleUpdateSavedFilter(w, req, filterID)
+	case http.MethodDelete:
+		r.handler.HandleDeleteSavedFilter(w, req, filterID)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleCategorizationRules routes requests for
+// /api/transactions/categorization-rules
+func (r *Router) handleCategorizationRules(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleListCategorizationRules(w, req)
+	case http.MethodPost:
+		r.handler.HandleCreateCategorizationRule(w, req)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleCategorizationRuleByID routes requests for
+// /api/transactions/categorization-rules/{id}
+func (r *Router) handleCategorizationRuleByID(w http.ResponseWriter, req *http.Request) {
+	ruleID := strings.TrimPrefix(req.URL.Path, "/api/transactions/categorization-rules/")
+	if ruleID == "" || strings.Contains(ruleID, "/") {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleGetCategorizationRule(w, req, ruleID)
+	case http.MethodPut:
+		r.handler.HandleUpdateCategorizationRule(w, req, ruleID)
+	case http.MethodDelete:
+		r.handler.HandleDeleteCategorizationRule(w, req, ruleID)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleRoundingRule routes requests for /api/transactions/rounding-rule
+func (r *Router) handleRoundingRule(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleGetRoundingRule(w, req)
+	case http.MethodPut:
+		r.handler.HandleSetRoundingRule(w, req)
+	case http.MethodDelete:
+		r.handler.HandleDeleteRoundingRule(w, req)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleCardAccounts routes requests for /api/transactions/card-accounts
+func (r *Router) handleCardAccounts(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleListCardAccounts(w, req)
+	case http.MethodPost:
+		r.handler.HandleCreateCardAccount(w, req)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleCardAccountByPath routes requests for
+// /api/transactions/card-accounts/{id}[/statement]
+func (r *Router) handleCardAccountByPath(w http.ResponseWriter, req *http.Request) {
+	path := strings.TrimPrefix(req.URL.Path, "/api/transactions/card-accounts/")
+	parts := strings.Split(path, "/")
+	if parts[0] == "" {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+
+	switch {
+	case len(parts) == 1:
+		switch req.Method {
+		case http.MethodGet:
+			r.handler.HandleGetCardAccount(w, req, parts[0])
+		case http.MethodPut, http.MethodPatch:
+			r.handler.HandleUpdateCardAccount(w, req, parts[0])
+		case http.MethodDelete:
+			r.handler.HandleDeleteCardAccount(w, req, parts[0])
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	case len(parts) == 2 && parts[1] == "statement":
+		r.handler.HandleGetStatement(w, req, parts[0])
+	default:
+		http.Error(w, "Not found", http.StatusNotFound)
+	}
+}
+
+// handleMembers routes requests for /api/transactions/members
+func (r *Router) handleMembers(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleListMembers(w, req)
+	case http.MethodPost:
+		r.handler.HandleCreateMember(w, req)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleMemberByPath routes requests for /api/transactions/members/{id}
+func (r *Router) handleMemberByPath(w http.ResponseWriter, req *http.Request) {
+	memberID := strings.TrimPrefix(req.URL.Path, "/api/transactions/members/")
+	if memberID == "" || strings.Contains(memberID, "/") {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleGetMember(w, req, memberID)
+	case http.MethodPut, http.MethodPatch:
+		r.handler.HandleUpdateMember(w, req, memberID)
+	case http.MethodDelete:
+		r.handler.HandleDeleteMember(w, req, memberID)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleOperationByPath routes requests for /api/operations/{id}[/undo]
+func (r *Router) handleOperationByPath(w http.ResponseWriter, req *http.Request) {
+	path := strings.TrimPrefix(req.URL.Path, "/api/operations/")
+	parts := strings.Split(path, "/")
+	if parts[0] == "" {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+
+	switch {
+	case len(parts) == 1:
+		r.handler.HandleGetOperation(w, req, parts[0])
+	case len(parts) == 2 && parts[1] == "undo":
+		r.handler.HandleUndoOperation(w, req, parts[0])
+	default:
+		http.Error(w, "Not found", http.StatusNotFound)
+	}
+}
+
+// handleTransactionByPath routes requests for /api/transactions/{id}/member
+func (r *Router) handleTransactionByPath(w http.ResponseWriter, req *http.Request) {
+	path := strings.TrimPrefix(req.URL.Path, "/api/transactions/")
+	parts := strings.Split(path, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] != "member" {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+	r.handler.HandleAssignMember(w, req, parts[0])
+}
@@ -0,0 +1,380 @@
+package transactions
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/application/transactions"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// SearchTransactionsResponse represents a page of the transactions a search
+// matched
+type SearchTransactionsResponse struct {
+	Transactions []TransactionResponse `json:"transactions"`
+	// Total counts every matching transaction, not just those on the page
+	Total    int    `json:"total"`
+	Limit    int    `json:"limit"`
+	Offset   int    `json:"offset"`
+	FilterID string `json:"filter_id,omitempty"`
+}
+
+// SavedFilterRequest represents a request to save a transaction filter
+type SavedFilterRequest struct {
+	Name   string                         `json:"name"`
+	Filter transactions.TransactionFilter `json:"filter"`
+}
+
+// SavedFilterResponse represents a saved transaction filter
+type SavedFilterResponse struct {
+	ID        string                         `json:"id"`
+	Name      string                         `json:"name"`
+	Filter    transactions.TransactionFilter `json:"filter"`
+	CreatedAt time.Time                      `json:"created_at"`
+	UpdatedAt time.Time                      `json:"updated_at"`
+}
+
+// ListSavedFiltersResponse represents a list of saved transaction filters
+type ListSavedFiltersResponse struct {
+	Filters []SavedFilterResponse `json:"filters"`
+	Total   int                   `json:"total"`
+}
+
+// HandleSearch handles GET /api/transactions. The filter is read from the
+// query; with ?filter_id it narrows the saved filter, replacing the
+// criteria it sets.
+func (h *TransactionHandler) HandleSearch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	query := r.URL.Query()
+	filter, err := parseFilter(query)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_parameter", err.Error())
+		return
+	}
+	limit, err := parseIntParam(query, "limit")
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_parameter", err.Error())
+		return
+	}
+	offset, err := parseIntParam(query, "offset")
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_parameter", err.Error())
+		return
+	}
+
+	filterID := query.Get("filter_id")
+	if filterID != "" {
+		saved, err := h.service.LoadSavedFilter(r.Context(), userID, filterID)
+		if err != nil {
+			if errors.Is(err, transactions.ErrFilterNotFound) {
+				h.writeError(w, http.StatusNotFound, "not_found", "Saved filter not found")
+				return
+			}
+			h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to load saved filter: "+err.Error())
+			return
+		}
+		filter = saved.Merge(filter)
+	}
+
+	result, err := h.service.SearchTransactions(r.Context(), userID, filter, limit, offset)
+	if err != nil {
+		if errors.Is(err, transactions.ErrInvalidFilter) {
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to search transactions: "+err.Error())
+		return
+	}
+
+	resp := SearchTransactionsResponse{
+		Transactions: make([]TransactionResponse, len(result.Transactions)),
+		Total:        result.Total,
+		Limit:        result.Limit,
+		Offset:       result.Offset,
+		FilterID:     filterID,
+	}
+	for i, record := range result.Transactions {
+		resp.Transactions[i] = transactionToResponse(record)
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleListSavedFilters handles GET /api/transactions/filters
+func (h *TransactionHandler) HandleListSavedFilters(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	records, err := h.service.ListSavedFilters(r.Context(), userID)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to list saved filters: "+err.Error())
+		return
+	}
+
+	resp := ListSavedFiltersResponse{
+		Filters: make([]SavedFilterResponse, 0, len(records)),
+	}
+	for _, record := range records {
+		filter, err := savedFilterToResponse(record)
+		if err != nil {
+			h.writeError(w, http.StatusInternalServerError, "query_failed", err.Error())
+			return
+		}
+		resp.Filters = append(resp.Filters, filter)
+	}
+	resp.Total = len(resp.Filters)
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleCreateSavedFilter handles POST /api/transactions/filters
+func (h *TransactionHandler) HandleCreateSavedFilter(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req SavedFilterRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	record, err := h.service.CreateSavedFilter(r.Context(), userID, req.Name, req.Filter)
+	if err != nil {
+		if !h.writeSavedFilterError(w, err) {
+			h.writeError(w, http.StatusInternalServerError, "create_failed", "Failed to save filter: "+err.Error())
+		}
+		return
+	}
+
+	h.writeSavedFilter(w, http.StatusCreated, record)
+}
+
+// HandleGetSavedFilter handles GET /api/transactions/filters/{id}
+func (h *TransactionHandler) HandleGetSavedFilter(w http.ResponseWriter, r *http.Request, filterID string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	record, err := h.service.GetSavedFilter(r.Context(), userID, filterID)
+	if err != nil {
+		if !h.writeSavedFilterError(w, err) {
+			h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to get saved filter: "+err.Error())
+		}
+		return
+	}
+
+	h.writeSavedFilter(w, http.StatusOK, record)
+}
+
+// HandleUpdateSavedFilter handles PUT /api/transactions/filters/{id}
+func (h *TransactionHandler) HandleUpdateSavedFilter(w http.ResponseWriter, r *http.Request, filterID string) {
+	if r.Method != http.MethodPut {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only PUT method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req SavedFilterRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	record, err := h.service.UpdateSavedFilter(r.Context(), userID, filterID, req.Name, req.Filter)
+	if err != nil {
+		if !h.writeSavedFilterError(w, err) {
+			h.writeError(w, http.StatusInternalServerError, "update_failed", "Failed to update saved filter: "+err.Error())
+		}
+		return
+	}
+
+	h.writeSavedFilter(w, http.StatusOK, record)
+}
+
+// HandleDeleteSavedFilter handles DELETE /api/transactions/filters/{id}
+func (h *TransactionHandler) HandleDeleteSavedFilter(w http.ResponseWriter, r *http.Request, filterID string) {
+	if r.Method != http.MethodDelete {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only DELETE method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	if err := h.service.DeleteSavedFilter(r.Context(), userID, filterID); err != nil {
+		if !h.writeSavedFilterError(w, err) {
+			h.writeError(w, http.StatusInternalServerError, "delete_failed", "Failed to delete saved filter: "+err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeSavedFilterError writes the response for a rejected saved filter
+// request, reporting whether err was one
+func (h *TransactionHandler) writeSavedFilterError(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, transactions.ErrInvalidFilter),
+		errors.Is(err, transactions.ErrInvalidFilterName):
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+	case errors.Is(err, transactions.ErrFilterNotFound):
+		h.writeError(w, http.StatusNotFound, "not_found", "Saved filter not found")
+	case errors.Is(err, transactions.ErrFilterNameTaken):
+		h.writeError(w, http.StatusConflict, "conflict", err.Error())
+	default:
+		return false
+	}
+	return true
+}
+
+// writeSavedFilter writes a saved filter response
+func (h *TransactionHandler) writeSavedFilter(w http.ResponseWriter, status int, record *ent.SavedFilter) {
+	resp, err := savedFilterToResponse(record)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", err.Error())
+		return
+	}
+	h.writeJSON(w, status, resp)
+}
+
+// parseFilter reads a transaction filter from search query parameters:
+// min_amount, max_amount, category, tag, merchant, source (each repeated or
+// comma-separated), q, start_date, end_date and sort
+func parseFilter(query url.Values) (transactions.TransactionFilter, error) {
+	filter := transactions.TransactionFilter{
+		Categories: listParam(query, "category"),
+		Tags:       listParam(query, "tag"),
+		Merchants:  listParam(query, "merchant"),
+		Sources:    listParam(query, "source"),
+		Text:       strings.TrimSpace(query.Get("q")),
+		Sort:       query.Get("sort"),
+	}
+
+	var err error
+	if filter.MinAmount, err = parseAmountParam(query, "min_amount"); err != nil {
+		return filter, err
+	}
+	if filter.MaxAmount, err = parseAmountParam(query, "max_amount"); err != nil {
+		return filter, err
+	}
+	if filter.StartDate, err = parseDateParam(query, "start_date"); err != nil {
+		return filter, err
+	}
+	if filter.EndDate, err = parseDateParam(query, "end_date"); err != nil {
+		return filter, err
+	}
+	return filter, nil
+}
+
+// listParam returns a query parameter's values, splitting comma-separated
+// ones and dropping empty ones
+func listParam(query url.Values, key string) []string {
+	var values []string
+	for _, value := range query[key] {
+		for _, part := range strings.Split(value, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				values = append(values, part)
+			}
+		}
+	}
+	return values
+}
+
+// parseAmountParam parses an amount query parameter; empty is nil
+func parseAmountParam(query url.Values, key string) (*float64, error) {
+	value := query.Get(key)
+	if value == "" {
+		return nil, nil
+	}
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, errors.New(key + " must be a number")
+	}
+	return &amount, nil
+}
+
+// parseDateParam parses a date query parameter; empty is nil
+func parseDateParam(query url.Values, key string) (*time.Time, error) {
+	date, err := parseDate(query.Get(key))
+	if err != nil {
+		return nil, errors.New(key + ": " + err.Error())
+	}
+	if date.IsZero() {
+		return nil, nil
+	}
+	return &date, nil
+}
+
+// parseIntParam parses a non-negative integer query parameter; empty is 0
+func parseIntParam(query url.Values, key string) (int, error) {
+	value := query.Get(key)
+	if value == "" {
+		return 0, nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed < 0 {
+		return 0, errors.New(key + " must be a non-negative integer")
+	}
+	return parsed, nil
+}
+
+// savedFilterToResponse converts a saved filter to its response
+func savedFilterToResponse(record *ent.SavedFilter) (SavedFilterResponse, error) {
+	filter, err := transactions.DecodeSavedFilter(record)
+	if err != nil {
+		return SavedFilterResponse{}, err
+	}
+	return SavedFilterResponse{
+		ID:        record.ID,
+		Name:      record.Name,
+		Filter:    filter,
+		CreatedAt: record.CreatedAt,
+		UpdatedAt: record.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,196 @@
+package transactions
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"clockzen-next/internal/application/transactions"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// CategoryScoreResponse represents the model's confidence in a category
+type CategoryScoreResponse struct {
+	Category   string  `json:"category"`
+	Confidence float64 `json:"confidence"`
+}
+
+// CategorySuggestionResponse represents the category suggested for a
+// transaction
+type CategorySuggestionResponse struct {
+	TransactionID   string    `json:"transaction_id"`
+	TransactionDate time.Time `json:"transaction_date"`
+	Amount          float64   `json:"amount"`
+	MerchantName    *string   `json:"merchant_name,omitempty"`
+	Description     *string   `json:"description,omitempty"`
+	// Category is omitted if neither the model nor the rules suggest one
+	Category string `json:"category,omitempty"`
+	// Source is "model" or "rule"
+	Source     string                  `json:"source,omitempty"`
+	Confidence float64                 `json:"confidence"`
+	Scores     []CategoryScoreResponse `json:"scores"`
+}
+
+// ListCategorySuggestionsResponse represents the suggestions for a set of
+// transactions
+type ListCategorySuggestionsResponse struct {
+	Suggestions      []CategorySuggestionResponse `json:"suggestions"`
+	Total            int                          `json:"total"`
+	TrainingExamples int                          `json:"training_examples"`
+}
+
+// SuggestionFeedbackRequest represents a request to accept or reject a
+// suggested category
+type SuggestionFeedbackRequest struct {
+	TransactionID     string   `json:"transaction_id"`
+	SuggestedCategory string   `json:"suggested_category"`
+	Source            string   `json:"source"`
+	Confidence        *float64 `json:"confidence,omitempty"`
+	Accepted          bool     `json:"accepted"`
+	// Category corrects a rejected suggestion
+	Category string `json:"category,omitempty"`
+}
+
+// SuggestionFeedbackResponse represents recorded feedback on a suggestion
+type SuggestionFeedbackResponse struct {
+	ID                string    `json:"id"`
+	TransactionID     string    `json:"transaction_id"`
+	SuggestedCategory string    `json:"suggested_category"`
+	Source            string    `json:"source"`
+	Confidence        *float64  `json:"confidence,omitempty"`
+	Accepted          bool      `json:"accepted"`
+	Category          *string   `json:"category,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// HandleListCategorySuggestions handles GET /api/transactions/category-suggestions
+func (h *TransactionHandler) HandleListCategorySuggestions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	query := r.URL.Query()
+	limit, err := parseIntParam(query, "limit")
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+	opts := transactions.SuggestionOptions{
+		TransactionIDs: listParam(query, "transaction_id"),
+		Limit:          limit,
+	}
+	if value := query.Get("min_confidence"); value != "" {
+		minConfidence, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			h.writeError(w, http.StatusBadRequest, "validation_error", "min_confidence must be a number")
+			return
+		}
+		opts.MinConfidence = &minConfidence
+	}
+
+	result, err := h.service.SuggestCategories(r.Context(), userID, opts)
+	if err != nil {
+		switch {
+		case errors.Is(err, transactions.ErrInvalidSuggestion):
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		case errors.Is(err, transactions.ErrTransactionNotFound):
+			h.writeError(w, http.StatusNotFound, "not_found", err.Error())
+		default:
+			h.writeError(w, http.StatusInternalServerError, "suggestion_failed", "Failed to suggest categories: "+err.Error())
+		}
+		return
+	}
+
+	resp := ListCategorySuggestionsResponse{
+		Suggestions:      make([]CategorySuggestionResponse, len(result.Suggestions)),
+		Total:            len(result.Suggestions),
+		TrainingExamples: result.TrainingExamples,
+	}
+	for i, suggestion := range result.Suggestions {
+		resp.Suggestions[i] = CategorySuggestionResponse{
+			TransactionID:   suggestion.Transaction.ID,
+			TransactionDate: suggestion.Transaction.TransactionDate,
+			Amount:          suggestion.Transaction.Amount,
+			MerchantName:    suggestion.Transaction.MerchantName,
+			Description:     suggestion.Transaction.Description,
+			Category:        suggestion.Category,
+			Source:          suggestion.Source,
+			Confidence:      suggestion.Confidence,
+			Scores:          make([]CategoryScoreResponse, len(suggestion.Scores)),
+		}
+		for j, score := range suggestion.Scores {
+			resp.Suggestions[i].Scores[j] = CategoryScoreResponse{
+				Category:   score.Category,
+				Confidence: score.Confidence,
+			}
+		}
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleSuggestionFeedback handles POST /api/transactions/category-suggestions/feedback
+func (h *TransactionHandler) HandleSuggestionFeedback(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.OwnerIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req SuggestionFeedbackRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	record, err := h.service.RecordSuggestionFeedback(r.Context(), userID, transactions.SuggestionFeedback{
+		TransactionID:     req.TransactionID,
+		SuggestedCategory: req.SuggestedCategory,
+		Source:            req.Source,
+		Confidence:        req.Confidence,
+		Accepted:          req.Accepted,
+		Category:          req.Category,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, transactions.ErrInvalidSuggestion):
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+		case errors.Is(err, transactions.ErrTransactionNotFound):
+			h.writeError(w, http.StatusNotFound, "not_found", "Transaction not found")
+		default:
+			h.writeError(w, http.StatusInternalServerError, "feedback_failed", "Failed to record feedback: "+err.Error())
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, suggestionFeedbackToResponse(record))
+}
+
+// suggestionFeedbackToResponse converts recorded suggestion feedback to its
+// response
+func suggestionFeedbackToResponse(record *ent.CategoryFeedback) SuggestionFeedbackResponse {
+	return SuggestionFeedbackResponse{
+		ID:                record.ID,
+		TransactionID:     record.TransactionID,
+		SuggestedCategory: record.SuggestedCategory,
+		Source:            string(record.SuggestionSource),
+		Confidence:        record.Confidence,
+		Accepted:          record.Accepted,
+		Category:          record.Category,
+		CreatedAt:         record.CreatedAt,
+	}
+}
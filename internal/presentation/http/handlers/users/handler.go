@@ -0,0 +1,534 @@
+package users
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"clockzen-next/internal/application/users"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/infrastructure/google"
+	"clockzen-next/internal/infrastructure/oauthstate"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// stateTTL is how long a Google sign in state is accepted
+const stateTTL = 10 * time.Minute
+
+// signInState is the data stored under Google sign in states. SignIn
+// tells them apart from the states of integration flows sharing the store.
+type signInState struct {
+	SignIn       bool   `json:"google_sign_in"`
+	CodeVerifier string `json:"code_verifier"` // PKCE verifier sent with the code exchange
+}
+
+// ErrorResponse represents an error response
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// RegisterRequest represents a request to sign up with an email and
+// password
+type RegisterRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	Name     string `json:"name,omitempty"`
+	Locale   string `json:"locale,omitempty"`
+}
+
+// LoginRequest represents a request to sign in with an email and password
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// UpdateProfileRequest represents a request to change the user's profile;
+// omitted fields are left unchanged
+type UpdateProfileRequest struct {
+	Name   *string `json:"name,omitempty"`
+	Email  *string `json:"email,omitempty"`
+	Locale *string `json:"locale,omitempty"`
+}
+
+// ChangePasswordRequest represents a request to change the user's password
+type ChangePasswordRequest struct {
+	// CurrentPassword is required unless the user has no password yet
+	CurrentPassword string `json:"current_password,omitempty"`
+	NewPassword     string `json:"new_password"`
+}
+
+// VerifyEmailRequest represents a request to verify the user's email with
+// the token emailed to it
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+// UserResponse represents a user's profile
+type UserResponse struct {
+	ID            string     `json:"id"`
+	Email         *string    `json:"email,omitempty"`
+	EmailVerified bool       `json:"email_verified"`
+	PendingEmail  *string    `json:"pending_email,omitempty"`
+	Name          string     `json:"name"`
+	Locale        *string    `json:"locale,omitempty"`
+	HasPassword   bool       `json:"has_password"`
+	GoogleLinked  bool       `json:"google_linked"`
+	LastLoginAt   *time.Time `json:"last_login_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// AuthResponse represents a signed in user and their bearer token
+type AuthResponse struct {
+	Token     string       `json:"token"`
+	ExpiresAt time.Time    `json:"expires_at"`
+	User      UserResponse `json:"user"`
+}
+
+// GoogleLoginResponse represents the URL that starts signing in with Google
+type GoogleLoginResponse struct {
+	AuthorizationURL string `json:"authorization_url"`
+	State            string `json:"state"`
+}
+
+// AccountDeletionResponse represents the record of a deleted account
+type AccountDeletionResponse struct {
+	ID            string         `json:"id"`
+	UserID        string         `json:"user_id"`
+	RequestedBy   string         `json:"requested_by"`
+	RowsDeleted   map[string]int `json:"rows_deleted"`
+	TokensRevoked int            `json:"tokens_revoked"`
+	TokensFailed  int            `json:"tokens_failed"`
+	BlobsPurged   int            `json:"blobs_purged"`
+	CreatedAt     time.Time      `json:"created_at"`
+}
+
+// UserHandler handles HTTP requests for signing in and users' profiles
+type UserHandler struct {
+	mu           sync.Mutex
+	service      *users.Service
+	auth         middleware.AuthConfig
+	tokenTTL     time.Duration
+	googleConfig *google.Config   // nil disables Google sign in
+	states       oauthstate.Store // Google sign in CSRF states
+}
+
+// NewUserHandler creates a new UserHandler instance issuing tokens signed
+// with auth that are valid for tokenTTL
+func NewUserHandler(service *users.Service, auth middleware.AuthConfig, tokenTTL time.Duration) *UserHandler {
+	return &UserHandler{
+		service:  service,
+		auth:     auth,
+		tokenTTL: tokenTTL,
+		states:   oauthstate.NewMemoryStore(),
+	}
+}
+
+// SetGoogleConfig enables signing in with Google using the OAuth client
+func (h *UserHandler) SetGoogleConfig(config *google.Config) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.googleConfig = config
+}
+
+// SetStateStore sets the store Google sign in states are kept in between
+// the login and callback requests
+func (h *UserHandler) SetStateStore(states oauthstate.Store) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.states = states
+}
+
+// HandleRegister handles POST /api/auth/register
+func (h *UserHandler) HandleRegister(w http.ResponseWriter, r *http.Request) {
+	var req RegisterRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	u, err := h.service.Register(r.Context(), users.Registration{
+		Email:    req.Email,
+		Password: req.Password,
+		Name:     req.Name,
+		Locale:   req.Locale,
+	})
+	if err != nil {
+		h.writeServiceError(w, err, "Failed to register")
+		return
+	}
+	h.writeAuth(w, http.StatusCreated, u)
+}
+
+// HandleLogin handles POST /api/auth/login
+func (h *UserHandler) HandleLogin(w http.ResponseWriter, r *http.Request) {
+	var req LoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	u, err := h.service.Login(r.Context(), req.Email, req.Password)
+	if err != nil {
+		h.writeServiceError(w, err, "Failed to sign in")
+		return
+	}
+	h.writeAuth(w, http.StatusOK, u)
+}
+
+// HandleGoogleLogin handles GET /api/auth/google/login
+func (h *UserHandler) HandleGoogleLogin(w http.ResponseWriter, r *http.Request) {
+	oauthClient, ok := h.googleClient(w)
+	if !ok {
+		return
+	}
+
+	verifier, err := google.GenerateCodeVerifier()
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "internal_error", "Failed to start sign in: "+err.Error())
+		return
+	}
+	state := uuid.New().String()
+	err = oauthstate.SaveJSON(r.Context(), h.states, state, signInState{SignIn: true, CodeVerifier: verifier}, stateTTL)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "internal_error", "Failed to store sign in state")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, GoogleLoginResponse{
+		AuthorizationURL: oauthClient.AuthCodeURL(state,
+			google.WithPrompt("select_account"),
+			google.WithCodeChallenge(verifier),
+		),
+		State: state,
+	})
+}
+
+// HandleGoogleCallback handles GET /api/auth/google/callback
+func (h *UserHandler) HandleGoogleCallback(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if oauthError := query.Get("error"); oauthError != "" {
+		h.writeError(w, http.StatusBadRequest, "oauth_error", "Google sign in failed: "+oauthError)
+		return
+	}
+	code, state := query.Get("code"), query.Get("state")
+	if code == "" || state == "" {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "code and state parameters are required")
+		return
+	}
+
+	var stateInfo signInState
+	err := oauthstate.TakeJSON(r.Context(), h.states, state, &stateInfo)
+	if err != nil && !errors.Is(err, oauthstate.ErrNotFound) {
+		h.writeError(w, http.StatusInternalServerError, "internal_error", "Failed to retrieve sign in state")
+		return
+	}
+	if err != nil || !stateInfo.SignIn {
+		h.writeError(w, http.StatusBadRequest, "invalid_state", "Invalid or expired state parameter")
+		return
+	}
+
+	oauthClient, ok := h.googleClient(w)
+	if !ok {
+		return
+	}
+	ctx := r.Context()
+	token, err := oauthClient.Exchange(ctx, code, google.WithCodeVerifier(stateInfo.CodeVerifier))
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "exchange_failed", "Failed to exchange authorization code: "+err.Error())
+		return
+	}
+	info, err := oauthClient.GetUserInfo(ctx, token.AccessToken)
+	if err != nil {
+		h.writeError(w, http.StatusBadGateway, "user_info_failed", "Failed to get user info: "+err.Error())
+		return
+	}
+
+	u, err := h.service.LoginWithGoogle(ctx, users.GoogleAccount{
+		ID:            info.ID,
+		Email:         info.Email,
+		EmailVerified: info.VerifiedEmail,
+		Name:          info.Name,
+		Locale:        info.Locale,
+	})
+	if err != nil {
+		h.writeServiceError(w, err, "Failed to sign in with Google")
+		return
+	}
+	h.writeAuth(w, http.StatusOK, u)
+}
+
+// HandleGetProfile handles GET /api/users/me
+func (h *UserHandler) HandleGetProfile(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	u, err := h.service.Get(r.Context(), userID)
+	if err != nil {
+		h.writeServiceError(w, err, "Failed to get profile")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, userToResponse(u))
+}
+
+// HandleUpdateProfile handles PATCH /api/users/me
+func (h *UserHandler) HandleUpdateProfile(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	u, err := h.service.UpdateProfile(r.Context(), userID, users.ProfileUpdate{
+		Name:   req.Name,
+		Email:  req.Email,
+		Locale: req.Locale,
+	})
+	if err != nil {
+		h.writeServiceError(w, err, "Failed to update profile")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, userToResponse(u))
+}
+
+// HandleSendEmailVerification handles POST /api/users/me/email/verification
+func (h *UserHandler) HandleSendEmailVerification(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.SendEmailVerification(r.Context(), userID); err != nil {
+		h.writeServiceError(w, err, "Failed to send verification email")
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// HandleVerifyEmail handles POST /api/users/me/email/verify
+func (h *UserHandler) HandleVerifyEmail(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req VerifyEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	u, err := h.service.VerifyEmail(r.Context(), userID, req.Token)
+	if err != nil {
+		h.writeServiceError(w, err, "Failed to verify email")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, userToResponse(u))
+}
+
+// HandleChangePassword handles POST /api/users/me/password
+func (h *UserHandler) HandleChangePassword(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	if err := h.service.ChangePassword(r.Context(), userID, req.CurrentPassword, req.NewPassword); err != nil {
+		h.writeServiceError(w, err, "Failed to change password")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleExport handles POST /api/users/{id}/export
+func (h *UserHandler) HandleExport(w http.ResponseWriter, r *http.Request, id string) {
+	userID, _, ok := h.requireAccountAccess(w, r, id)
+	if !ok {
+		return
+	}
+
+	// The archive is built before anything is written, so a failed export
+	// is reported as an error rather than a truncated file
+	var archive bytes.Buffer
+	if _, err := h.service.Export(r.Context(), userID, &archive); err != nil {
+		h.writeServiceError(w, err, "Failed to export data")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="clockzen-export-`+userID+`.zip"`)
+	w.WriteHeader(http.StatusOK)
+	w.Write(archive.Bytes())
+}
+
+// HandleDeleteAccount handles DELETE /api/users/{id}
+func (h *UserHandler) HandleDeleteAccount(w http.ResponseWriter, r *http.Request, id string) {
+	userID, requesterID, ok := h.requireAccountAccess(w, r, id)
+	if !ok {
+		return
+	}
+
+	record, err := h.service.DeleteAccount(r.Context(), userID, requesterID)
+	if err != nil {
+		h.writeServiceError(w, err, "Failed to delete account")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, AccountDeletionResponse{
+		ID:            record.ID,
+		UserID:        record.UserID,
+		RequestedBy:   record.RequestedBy,
+		RowsDeleted:   record.RowsDeleted,
+		TokensRevoked: record.TokensRevoked,
+		TokensFailed:  record.TokensFailed,
+		BlobsPurged:   record.BlobsPurged,
+		CreatedAt:     record.CreatedAt,
+	})
+}
+
+// googleClient returns the OAuth client for signing in with Google, or
+// writes 404 if Google sign in isn't configured
+func (h *UserHandler) googleClient(w http.ResponseWriter) (*google.Client, bool) {
+	h.mu.Lock()
+	config := h.googleConfig
+	h.mu.Unlock()
+	if config == nil || config.Validate() != nil {
+		h.writeError(w, http.StatusNotFound, "not_configured", "Signing in with Google is not configured")
+		return nil, false
+	}
+
+	oauthClient, err := google.NewClient(config)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "oauth_error", "Failed to create OAuth client: "+err.Error())
+		return nil, false
+	}
+	return oauthClient, true
+}
+
+// requireUserID returns the authenticated user ID or writes 401. Profiles
+// are the user's own, whichever organization the request acts in.
+func (h *UserHandler) requireUserID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return "", false
+	}
+	return userID, true
+}
+
+// requireAccountAccess resolves the account an export or deletion is for,
+// "me" or an ID, and returns it with the authenticated user's ID. Only
+// admins may act on other users' accounts; others get 403.
+func (h *UserHandler) requireAccountAccess(w http.ResponseWriter, r *http.Request, id string) (userID, requesterID string, ok bool) {
+	requesterID, ok = h.requireUserID(w, r)
+	if !ok {
+		return "", "", false
+	}
+	if id == "me" || id == requesterID {
+		return requesterID, requesterID, true
+	}
+	if u, found := middleware.UserFromContext(r.Context()); !found || !u.HasRole(middleware.AdminRole) {
+		h.writeError(w, http.StatusForbidden, "forbidden", "Only admins may act on other users' accounts")
+		return "", "", false
+	}
+	return id, requesterID, true
+}
+
+// writeAuth issues a token for the signed in user and writes it
+func (h *UserHandler) writeAuth(w http.ResponseWriter, status int, u *ent.User) {
+	now := time.Now()
+	expiresAt := now.Add(h.tokenTTL)
+	claims := middleware.JWTClaims{
+		UserID:    u.ID,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+	}
+	if u.Locale != nil {
+		claims.Locale = *u.Locale
+	}
+
+	token, err := middleware.IssueToken(h.auth, claims)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "token_failed", "Failed to issue token: "+err.Error())
+		return
+	}
+	h.writeJSON(w, status, AuthResponse{
+		Token:     token,
+		ExpiresAt: time.Unix(expiresAt.Unix(), 0).UTC(),
+		User:      userToResponse(u),
+	})
+}
+
+// writeServiceError writes the response for an error from the user service
+func (h *UserHandler) writeServiceError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, users.ErrNotFound):
+		h.writeError(w, http.StatusNotFound, "not_found", "User not found")
+	case errors.Is(err, users.ErrInvalidCredentials):
+		h.writeError(w, http.StatusUnauthorized, "invalid_credentials", err.Error())
+	case errors.Is(err, users.ErrEmailTaken),
+		errors.Is(err, users.ErrGoogleAccountTaken),
+		errors.Is(err, users.ErrSoleOwner),
+		errors.Is(err, users.ErrNothingToVerify):
+		h.writeError(w, http.StatusConflict, "conflict", err.Error())
+	case errors.Is(err, users.ErrInvalidVerification):
+		h.writeError(w, http.StatusBadRequest, "invalid_token", err.Error())
+	case errors.Is(err, users.ErrInvalidEmail),
+		errors.Is(err, users.ErrPasswordTooShort),
+		errors.Is(err, users.ErrPasswordTooLong),
+		errors.Is(err, users.ErrNameTooLong):
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+	default:
+		h.writeError(w, http.StatusInternalServerError, "internal_error", message+": "+err.Error())
+	}
+}
+
+// userToResponse converts a user to its response
+func userToResponse(u *ent.User) UserResponse {
+	return UserResponse{
+		ID:            u.ID,
+		Email:         u.Email,
+		EmailVerified: u.EmailVerified,
+		PendingEmail:  u.PendingEmail,
+		Name:          u.Name,
+		Locale:        u.Locale,
+		HasPassword:   u.PasswordHash != nil,
+		GoogleLinked:  u.GoogleID != nil,
+		LastLoginAt:   u.LastLoginAt,
+		CreatedAt:     u.CreatedAt,
+		UpdatedAt:     u.UpdatedAt,
+	}
+}
+
+// writeJSON writes a JSON response
+func (h *UserHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *UserHandler) writeError(w http.ResponseWriter, status int, errCode string, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
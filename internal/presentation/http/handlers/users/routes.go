@@ -0,0 +1,127 @@
+package users
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/application/users"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// Router handles routing for sign in and profile endpoints
+type Router struct {
+	handler *UserHandler
+}
+
+// NewRouter creates a new Router with the given handler
+func NewRouter(handler *UserHandler) *Router {
+	return &Router{
+		handler: handler,
+	}
+}
+
+// NewDefaultRouter creates a new Router backed by the given ent client,
+// issuing tokens signed with auth that are valid for tokenTTL
+func NewDefaultRouter(entClient *ent.Client, auth middleware.AuthConfig, tokenTTL time.Duration) *Router {
+	return &Router{
+		handler: NewUserHandler(users.NewService(entClient), auth, tokenTTL),
+	}
+}
+
+// GetUserHandler returns the user handler
+func (r *Router) GetUserHandler() *UserHandler {
+	return r.handler
+}
+
+// RegisterPublicRoutes registers the sign in routes, which are reachable
+// without authentication. Each responds with a bearer token for the other
+// API routes.
+// Total routes: 4 endpoints
+//
+//  1. POST /api/auth/register         - Sign up with an email and password
+//  2. POST /api/auth/login            - Sign in with an email and password
+//  3. GET  /api/auth/google/login     - Get the URL that starts signing in with Google
+//  4. GET  /api/auth/google/callback  - Finish signing in with Google
+func (r *Router) RegisterPublicRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/auth/register", r.only(http.MethodPost, r.handler.HandleRegister))
+	mux.HandleFunc("/api/auth/login", r.only(http.MethodPost, r.handler.HandleLogin))
+	mux.HandleFunc("/api/auth/google/login", r.only(http.MethodGet, r.handler.HandleGoogleLogin))
+	mux.HandleFunc("/api/auth/google/callback", r.only(http.MethodGet, r.handler.HandleGoogleCallback))
+}
+
+// RegisterRoutes registers the authenticated user's profile and account
+// routes with the given mux. {id} is "me" or, for admins, any user's ID.
+// Total routes: 7 endpoints
+//
+//  1. GET    /api/users/me                     - Get the user's profile
+//  2. PATCH  /api/users/me                     - Change the user's name, email or locale
+//  3. POST   /api/users/me/email/verification  - Email a token verifying the pending or unverified email
+//  4. POST   /api/users/me/email/verify        - Verify the email with the emailed token
+//  5. POST   /api/users/me/password            - Change the user's password
+//  6. POST   /api/users/{id}/export            - Download a zip of everything stored for the user
+//  7. DELETE /api/users/{id}                   - Permanently delete the user and their data
+func (r *Router) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/users/me", r.handleProfile)
+	mux.HandleFunc("/api/users/me/email/verification", r.only(http.MethodPost, r.handler.HandleSendEmailVerification))
+	mux.HandleFunc("/api/users/me/email/verify", r.only(http.MethodPost, r.handler.HandleVerifyEmail))
+	mux.HandleFunc("/api/users/me/password", r.only(http.MethodPost, r.handler.HandleChangePassword))
+	mux.HandleFunc("/api/users/", r.handleUserByID)
+}
+
+// handleProfile routes requests for /api/users/me
+func (r *Router) handleProfile(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleGetProfile(w, req)
+	case http.MethodPatch:
+		r.handler.HandleUpdateProfile(w, req)
+	case http.MethodDelete:
+		r.handler.HandleDeleteAccount(w, req, "me")
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleUserByID routes requests for /api/users/{id} and sub-resources
+func (r *Router) handleUserByID(w http.ResponseWriter, req *http.Request) {
+	path := strings.TrimPrefix(req.URL.Path, "/api/users/")
+	parts := strings.Split(path, "/")
+
+	if parts[0] == "" {
+		http.Error(w, "User ID required", http.StatusBadRequest)
+		return
+	}
+
+	userID := parts[0]
+
+	if len(parts) > 1 {
+		switch parts[1] {
+		case "export":
+			r.only(http.MethodPost, func(w http.ResponseWriter, req *http.Request) {
+				r.handler.HandleExport(w, req, userID)
+			})(w, req)
+		default:
+			http.Error(w, "Not found", http.StatusNotFound)
+		}
+		return
+	}
+
+	if req.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	r.handler.HandleDeleteAccount(w, req, userID)
+}
+
+// only routes requests with the method to handler
+func (r *Router) only(method string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != method {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		handler(w, req)
+	}
+}
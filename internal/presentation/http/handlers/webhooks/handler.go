@@ -0,0 +1,390 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"clockzen-next/internal/application/webhooks"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/presentation/http/middleware"
+)
+
+// ErrorResponse represents an error response
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// EndpointRequest represents a request to create or change a webhook
+// endpoint. Fields left out of a change are unchanged; an empty
+// description clears it.
+type EndpointRequest struct {
+	URL         *string  `json:"url,omitempty"`
+	Events      []string `json:"events,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Enabled     *bool    `json:"enabled,omitempty"`
+}
+
+// EndpointResponse represents a webhook endpoint. Secret is only set when
+// the endpoint is created or its secret rotated.
+type EndpointResponse struct {
+	ID          string    `json:"id"`
+	URL         string    `json:"url"`
+	Events      []string  `json:"events"`
+	Enabled     bool      `json:"enabled"`
+	Description *string   `json:"description,omitempty"`
+	Secret      string    `json:"secret,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ListEndpointsResponse represents a list of webhook endpoints
+type ListEndpointsResponse struct {
+	Endpoints []EndpointResponse `json:"endpoints"`
+	Total     int                `json:"total"`
+}
+
+// EventsResponse lists the event types endpoints can subscribe to
+type EventsResponse struct {
+	Events []string `json:"events"`
+}
+
+// DeliveryResponse represents a delivery of an event to an endpoint
+type DeliveryResponse struct {
+	ID             string          `json:"id"`
+	EndpointID     string          `json:"endpoint_id"`
+	Event          string          `json:"event"`
+	EventID        string          `json:"event_id"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty"`
+	LastAttemptAt  *time.Time      `json:"last_attempt_at,omitempty"`
+	ResponseStatus *int            `json:"response_status,omitempty"`
+	Error          *string         `json:"error,omitempty"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// ListDeliveriesResponse represents an endpoint's delivery log
+type ListDeliveriesResponse struct {
+	Deliveries []DeliveryResponse `json:"deliveries"`
+	Total      int                `json:"total"`
+}
+
+// WebhookHandler handles HTTP requests for webhook endpoints and their
+// deliveries
+type WebhookHandler struct {
+	service *webhooks.Service
+}
+
+// NewWebhookHandler creates a new WebhookHandler instance
+func NewWebhookHandler(service *webhooks.Service) *WebhookHandler {
+	return &WebhookHandler{
+		service: service,
+	}
+}
+
+// HandleListEvents handles GET /api/webhooks/events
+func (h *WebhookHandler) HandleListEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	resp := EventsResponse{Events: make([]string, len(webhooks.Events))}
+	for i, event := range webhooks.Events {
+		resp.Events[i] = string(event)
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleList handles GET /api/webhooks
+func (h *WebhookHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	records, err := h.service.ListEndpoints(r.Context(), userID)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "query_failed", "Failed to list webhooks: "+err.Error())
+		return
+	}
+
+	resp := ListEndpointsResponse{
+		Endpoints: make([]EndpointResponse, len(records)),
+		Total:     len(records),
+	}
+	for i, record := range records {
+		resp.Endpoints[i] = toEndpointResponse(record, "")
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleCreate handles POST /api/webhooks
+func (h *WebhookHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req EndpointRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+	if req.URL == nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "url is required")
+		return
+	}
+
+	record, secret, err := h.service.CreateEndpoint(r.Context(), userID, webhooks.EndpointInput{
+		URL:         *req.URL,
+		Events:      req.Events,
+		Description: req.Description,
+		Enabled:     req.Enabled,
+	})
+	if err != nil {
+		if errors.Is(err, webhooks.ErrInvalidEndpoint) {
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "create_failed", "Failed to create webhook: "+err.Error())
+		return
+	}
+	h.writeJSON(w, http.StatusCreated, toEndpointResponse(record, secret))
+}
+
+// HandleGet handles GET /api/webhooks/{id}
+func (h *WebhookHandler) HandleGet(w http.ResponseWriter, r *http.Request, endpointID string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	record, err := h.service.GetEndpoint(r.Context(), userID, endpointID)
+	if err != nil {
+		h.writeServiceError(w, err, "query_failed", "Failed to get webhook")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, toEndpointResponse(record, ""))
+}
+
+// HandleUpdate handles PUT /api/webhooks/{id}
+func (h *WebhookHandler) HandleUpdate(w http.ResponseWriter, r *http.Request, endpointID string) {
+	if r.Method != http.MethodPut && r.Method != http.MethodPatch {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only PUT or PATCH method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	var req EndpointRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+
+	record, err := h.service.UpdateEndpoint(r.Context(), userID, endpointID, webhooks.EndpointUpdate{
+		URL:         req.URL,
+		Events:      req.Events,
+		Description: req.Description,
+		Enabled:     req.Enabled,
+	})
+	if err != nil {
+		h.writeServiceError(w, err, "update_failed", "Failed to update webhook")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, toEndpointResponse(record, ""))
+}
+
+// HandleDelete handles DELETE /api/webhooks/{id}
+func (h *WebhookHandler) HandleDelete(w http.ResponseWriter, r *http.Request, endpointID string) {
+	if r.Method != http.MethodDelete {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only DELETE method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	if err := h.service.DeleteEndpoint(r.Context(), userID, endpointID); err != nil {
+		h.writeServiceError(w, err, "delete_failed", "Failed to delete webhook")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleRotateSecret handles POST /api/webhooks/{id}/rotate-secret
+func (h *WebhookHandler) HandleRotateSecret(w http.ResponseWriter, r *http.Request, endpointID string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	record, secret, err := h.service.RotateSecret(r.Context(), userID, endpointID)
+	if err != nil {
+		h.writeServiceError(w, err, "update_failed", "Failed to rotate secret")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, toEndpointResponse(record, secret))
+}
+
+// HandleTest handles POST /api/webhooks/{id}/test. The response is the
+// delivery, whether the endpoint accepted it or not.
+func (h *WebhookHandler) HandleTest(w http.ResponseWriter, r *http.Request, endpointID string) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	record, err := h.service.SendTest(r.Context(), userID, endpointID)
+	if err != nil {
+		h.writeServiceError(w, err, "test_failed", "Failed to send test delivery")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, toDeliveryResponse(record))
+}
+
+// HandleListDeliveries handles GET /api/webhooks/{id}/deliveries
+func (h *WebhookHandler) HandleListDeliveries(w http.ResponseWriter, r *http.Request, endpointID string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		h.writeError(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	query := r.URL.Query()
+	filter := webhooks.DeliveryFilter{Status: query.Get("status")}
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			h.writeError(w, http.StatusBadRequest, "validation_error", "limit must be a positive integer")
+			return
+		}
+		filter.Limit = limit
+	}
+
+	records, err := h.service.ListDeliveries(r.Context(), userID, endpointID, filter)
+	if err != nil {
+		h.writeServiceError(w, err, "query_failed", "Failed to list deliveries")
+		return
+	}
+
+	resp := ListDeliveriesResponse{
+		Deliveries: make([]DeliveryResponse, len(records)),
+		Total:      len(records),
+	}
+	for i, record := range records {
+		resp.Deliveries[i] = toDeliveryResponse(record)
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// toEndpointResponse converts a webhook endpoint to its response, with its
+// secret if given
+func toEndpointResponse(e *ent.WebhookEndpoint, secret string) EndpointResponse {
+	return EndpointResponse{
+		ID:          e.ID,
+		URL:         e.URL,
+		Events:      e.Events,
+		Enabled:     e.Enabled,
+		Description: e.Description,
+		Secret:      secret,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+	}
+}
+
+// toDeliveryResponse converts a webhook delivery to its response
+func toDeliveryResponse(d *ent.WebhookDelivery) DeliveryResponse {
+	return DeliveryResponse{
+		ID:             d.ID,
+		EndpointID:     d.EndpointID,
+		Event:          d.Event,
+		EventID:        d.EventID,
+		Payload:        d.Payload,
+		Status:         string(d.Status),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		LastAttemptAt:  d.LastAttemptAt,
+		ResponseStatus: d.ResponseStatus,
+		Error:          d.Error,
+		DeliveredAt:    d.DeliveredAt,
+		CreatedAt:      d.CreatedAt,
+	}
+}
+
+// writeServiceError writes the response for an error from the webhook
+// service: 404 for unknown endpoints, 400 for invalid input, else 500
+func (h *WebhookHandler) writeServiceError(w http.ResponseWriter, err error, errCode, message string) {
+	switch {
+	case errors.Is(err, webhooks.ErrEndpointNotFound):
+		h.writeError(w, http.StatusNotFound, "not_found", "Webhook not found")
+	case errors.Is(err, webhooks.ErrInvalidEndpoint), errors.Is(err, webhooks.ErrInvalidStatus):
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error())
+	default:
+		h.writeError(w, http.StatusInternalServerError, errCode, message+": "+err.Error())
+	}
+}
+
+// writeJSON writes a JSON response
+func (h *WebhookHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *WebhookHandler) writeError(w http.ResponseWriter, status int, errCode string, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
@@ -0,0 +1,119 @@
+package webhooks
+
+import (
+	"net/http"
+	"strings"
+
+	"clockzen-next/internal/application/webhooks"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/infrastructure/encryption"
+)
+
+// Router handles routing for webhook endpoints
+type Router struct {
+	handler *WebhookHandler
+}
+
+// NewRouter creates a new Router with the given handler
+func NewRouter(handler *WebhookHandler) *Router {
+	return &Router{
+		handler: handler,
+	}
+}
+
+// NewDefaultRouter creates a new Router backed by the given ent client,
+// sealing endpoint secrets with keyring if it is not nil
+func NewDefaultRouter(entClient *ent.Client, keyring *encryption.Keyring) *Router {
+	return &Router{
+		handler: NewWebhookHandler(webhooks.NewService(entClient, keyring)),
+	}
+}
+
+// RegisterRoutes registers all webhook routes with the given mux
+// Total routes: 9 endpoints
+//
+// Webhook endpoints belong to the authenticated user, and subscribe to
+// events: sync.completed, receipt.extracted, anomaly.detected and
+// budget.exceeded. Each event is POSTed as JSON {id, type, user_id,
+// created_at, data} to every enabled endpoint subscribed to it, with
+// headers X-ClockZen-Event, X-ClockZen-Delivery (the delivery ID) and
+// X-ClockZen-Signature: "t=<unix seconds>,v1=<hex HMAC-SHA256 of
+// "<t>.<body>" keyed with the endpoint's secret>". The secret is returned
+// when the endpoint is created or its secret rotated, and not again.
+//
+// Any response but 2xx fails the attempt; failed deliveries are retried
+// with exponential backoff, up to 8 attempts. Every delivery, with its
+// attempts, last response and error, is kept in the endpoint's log.
+//
+//  1. GET    /api/webhooks                       - List endpoints
+//  2. POST   /api/webhooks                       - Create an endpoint; returns its secret
+//  3. GET    /api/webhooks/events                - List the events endpoints can subscribe to
+//  4. GET    /api/webhooks/{id}                  - Get an endpoint
+//  5. PUT    /api/webhooks/{id}                  - Update an endpoint (also PATCH)
+//  6. DELETE /api/webhooks/{id}                  - Delete an endpoint and its deliveries
+//  7. POST   /api/webhooks/{id}/test             - Post a webhook.test event now; returns the delivery
+//  8. POST   /api/webhooks/{id}/rotate-secret    - Replace the signing secret; returns the new one
+//  9. GET    /api/webhooks/{id}/deliveries       - Delivery log, the latest first (with ?status, ?limit)
+func (r *Router) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/webhooks", r.handleWebhooks)
+	mux.HandleFunc("/api/webhooks/", r.handleWebhookByID)
+}
+
+// handleWebhooks routes requests for /api/webhooks
+func (r *Router) handleWebhooks(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleList(w, req)
+	case http.MethodPost:
+		r.handler.HandleCreate(w, req)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleWebhookByID routes requests for /api/webhooks/events,
+// /api/webhooks/{id} and its subresources
+func (r *Router) handleWebhookByID(w http.ResponseWriter, req *http.Request) {
+	path := strings.TrimPrefix(req.URL.Path, "/api/webhooks/")
+	parts := strings.Split(path, "/")
+
+	if len(parts) == 0 || parts[0] == "" {
+		http.Error(w, "Webhook ID required", http.StatusBadRequest)
+		return
+	}
+	if len(parts) == 1 && parts[0] == "events" {
+		r.handler.HandleListEvents(w, req)
+		return
+	}
+
+	endpointID := parts[0]
+	if len(parts) > 1 {
+		switch {
+		case len(parts) == 2 && parts[1] == "test":
+			r.handler.HandleTest(w, req, endpointID)
+		case len(parts) == 2 && parts[1] == "rotate-secret":
+			r.handler.HandleRotateSecret(w, req, endpointID)
+		case len(parts) == 2 && parts[1] == "deliveries":
+			r.handler.HandleListDeliveries(w, req, endpointID)
+		default:
+			http.Error(w, "Not found", http.StatusNotFound)
+		}
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleGet(w, req, endpointID)
+	case http.MethodPut, http.MethodPatch:
+		r.handler.HandleUpdate(w, req, endpointID)
+	case http.MethodDelete:
+		r.handler.HandleDelete(w, req, endpointID)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// GetHandler returns the webhook handler
+func (r *Router) GetHandler() *WebhookHandler {
+	return r.handler
+}
@@ -0,0 +1,535 @@
+package workers
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/queuedjob"
+	"clockzen-next/internal/infrastructure/queue"
+	"clockzen-next/internal/infrastructure/worker"
+)
+
+// defaultJobListLimit caps the jobs listed when no ?limit is given
+const defaultJobListLimit = 100
+
+// defaultDrainTimeout is how long a drain waits for running jobs when no
+// ?timeout is given
+const defaultDrainTimeout = 10 * time.Minute
+
+// Worker is a worker of the worker process that can be paused
+type Worker interface {
+	IsRunning() bool
+	IsPaused() bool
+	Pause() error
+	Resume() error
+}
+
+// SyncTrigger queues a sync of a connection on demand
+type SyncTrigger interface {
+	SyncNow(ctx context.Context, source, connectionID string) (string, error)
+}
+
+// JobQueue is the job queue the workers run from
+type JobQueue interface {
+	Queues(ctx context.Context) ([]*queue.QueueInfo, error)
+	ListJobs(ctx context.Context, name string, opts queue.ListJobsOptions) ([]*ent.QueuedJob, error)
+	ActiveCount() int
+	Drain(ctx context.Context) error
+}
+
+// DrainState is how far a drain has got
+type DrainState string
+
+const (
+	DrainStateIdle     DrainState = "idle"
+	DrainStateDraining DrainState = "draining"
+	DrainStateDrained  DrainState = "drained"
+	DrainStateTimedOut DrainState = "timed_out"
+)
+
+// ErrorResponse represents an error response
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// WorkerStatus represents a worker and whether it is taking work
+type WorkerStatus struct {
+	Name    string `json:"name"`
+	Running bool   `json:"running"`
+	Paused  bool   `json:"paused"`
+}
+
+// ListWorkersResponse represents a list of workers response
+type ListWorkersResponse struct {
+	Workers []*WorkerStatus `json:"workers"`
+	Total   int             `json:"total"`
+}
+
+// SyncRequest represents a request to sync a connection now
+type SyncRequest struct {
+	// Source is "email" or "drive"
+	Source       string `json:"source"`
+	ConnectionID string `json:"connection_id"`
+}
+
+// SyncResponse represents a response after queueing a sync
+type SyncResponse struct {
+	TaskID  string `json:"task_id"`
+	Message string `json:"message"`
+}
+
+// Queue represents a queue and the number of its jobs in each status
+type Queue struct {
+	Name            string `json:"name"`
+	Paused          bool   `json:"paused"`
+	PendingCount    int    `json:"pending_count"`
+	ProcessingCount int    `json:"processing_count"`
+	CompletedCount  int    `json:"completed_count"`
+	FailedCount     int    `json:"failed_count"`
+	CancelledCount  int    `json:"cancelled_count"`
+}
+
+// ListQueuesResponse represents a list of queues response
+type ListQueuesResponse struct {
+	Queues []*Queue `json:"queues"`
+	Total  int      `json:"total"`
+}
+
+// Job represents a job in a queue
+type Job struct {
+	ID          string          `json:"id"`
+	QueueName   string          `json:"queue_name"`
+	Type        string          `json:"type"`
+	Status      string          `json:"status"`
+	Priority    int             `json:"priority"`
+	Payload     json.RawMessage `json:"payload,omitempty"`
+	RetryCount  int             `json:"retry_count"`
+	MaxRetries  int             `json:"max_retries"`
+	Progress    float64         `json:"progress"`
+	Error       string          `json:"error,omitempty"`
+	RunAt       time.Time       `json:"run_at"`
+	CreatedAt   time.Time       `json:"created_at"`
+	StartedAt   *time.Time      `json:"started_at,omitempty"`
+	CompletedAt *time.Time      `json:"completed_at,omitempty"`
+}
+
+// ListJobsResponse represents a list of jobs response
+type ListJobsResponse struct {
+	Jobs  []*Job `json:"jobs"`
+	Total int    `json:"total"`
+}
+
+// DrainStatus represents the progress of a drain
+type DrainStatus struct {
+	State      DrainState `json:"state"`
+	ActiveJobs int        `json:"active_jobs"`
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	Errors     []string   `json:"errors,omitempty"`
+}
+
+// AdminHandler handles HTTP requests for controlling the workers of the
+// worker process. Every request must carry the admin token as a bearer
+// token; without a token configured, every request is refused.
+type AdminHandler struct {
+	token string
+
+	mu      sync.RWMutex
+	workers map[string]Worker
+	trigger SyncTrigger
+	queue   JobQueue
+	drain   DrainStatus
+}
+
+// NewAdminHandler creates a new AdminHandler instance protected by token
+func NewAdminHandler(token string) *AdminHandler {
+	return &AdminHandler{
+		token:   token,
+		workers: make(map[string]Worker),
+		drain:   DrainStatus{State: DrainStateIdle},
+	}
+}
+
+// SetWorker makes a worker controllable under name
+func (h *AdminHandler) SetWorker(name string, w Worker) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.workers[name] = w
+}
+
+// SetSyncTrigger sets what queues syncs requested through the API
+func (h *AdminHandler) SetSyncTrigger(trigger SyncTrigger) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.trigger = trigger
+}
+
+// SetJobQueue sets the job queue that is inspected and drained
+func (h *AdminHandler) SetJobQueue(jobQueue JobQueue) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.queue = jobQueue
+}
+
+// Authorize wraps next so it only runs for requests carrying the admin
+// token
+func (h *AdminHandler) Authorize(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if h.token == "" || !ok || subtle.ConstantTimeCompare([]byte(token), []byte(h.token)) != 1 {
+			h.writeError(w, http.StatusUnauthorized, "unauthorized", "A valid admin token is required")
+			return
+		}
+		next(w, r)
+	}
+}
+
+// HandleListWorkers handles GET /admin/workers
+func (h *AdminHandler) HandleListWorkers(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	h.mu.RLock()
+	statuses := make([]*WorkerStatus, 0, len(h.workers))
+	for name, wk := range h.workers {
+		statuses = append(statuses, workerStatus(name, wk))
+	}
+	h.mu.RUnlock()
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+
+	h.writeJSON(w, http.StatusOK, ListWorkersResponse{
+		Workers: statuses,
+		Total:   len(statuses),
+	})
+}
+
+// HandlePauseWorker handles POST /admin/workers/{name}/pause
+func (h *AdminHandler) HandlePauseWorker(w http.ResponseWriter, r *http.Request, name string) {
+	h.setPaused(w, r, name, true)
+}
+
+// HandleResumeWorker handles POST /admin/workers/{name}/resume
+func (h *AdminHandler) HandleResumeWorker(w http.ResponseWriter, r *http.Request, name string) {
+	h.setPaused(w, r, name, false)
+}
+
+// setPaused pauses or resumes the named worker. Pausing a paused worker,
+// or resuming a running one, does nothing.
+func (h *AdminHandler) setPaused(w http.ResponseWriter, r *http.Request, name string, paused bool) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	h.mu.RLock()
+	wk, ok := h.workers[name]
+	h.mu.RUnlock()
+	if !ok {
+		h.writeError(w, http.StatusNotFound, "not_found", "Worker not found")
+		return
+	}
+	if !wk.IsRunning() {
+		h.writeError(w, http.StatusConflict, "conflict", "Worker is not running")
+		return
+	}
+
+	var err error
+	if paused {
+		err = wk.Pause()
+	} else {
+		err = wk.Resume()
+	}
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, workerStatus(name, wk))
+}
+
+// HandleSync handles POST /admin/sync
+func (h *AdminHandler) HandleSync(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only POST method is allowed")
+		return
+	}
+
+	h.mu.RLock()
+	trigger := h.trigger
+	h.mu.RUnlock()
+	if trigger == nil {
+		h.writeError(w, http.StatusServiceUnavailable, "unavailable", "Syncs cannot be triggered by this worker")
+		return
+	}
+
+	var req SyncRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_request", "Invalid request body: "+err.Error())
+		return
+	}
+	if req.ConnectionID == "" {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "connection_id is required")
+		return
+	}
+
+	taskID, err := trigger.SyncNow(r.Context(), req.Source, req.ConnectionID)
+	if err != nil {
+		switch {
+		case errors.Is(err, worker.ErrUnknownSyncSource):
+			h.writeError(w, http.StatusBadRequest, "validation_error", "source must be email or drive")
+		case errors.Is(err, worker.ErrConnectionNotFound):
+			h.writeError(w, http.StatusNotFound, "not_found", "Connection not found")
+		case errors.Is(err, worker.ErrConnectionNotActive):
+			h.writeError(w, http.StatusConflict, "conflict", "Connection is not active")
+		case errors.Is(err, worker.ErrQueueSaturated):
+			h.writeError(w, http.StatusServiceUnavailable, "queue_saturated", "The sync queue is full, try again later")
+		default:
+			h.writeError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusAccepted, SyncResponse{
+		TaskID:  taskID,
+		Message: "Sync queued",
+	})
+}
+
+// HandleListQueues handles GET /admin/queues
+func (h *AdminHandler) HandleListQueues(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+	jobQueue, ok := h.jobQueue(w)
+	if !ok {
+		return
+	}
+
+	infos, err := jobQueue.Queues(r.Context())
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		return
+	}
+
+	queues := make([]*Queue, len(infos))
+	for i, info := range infos {
+		queues[i] = &Queue{
+			Name:            info.Name,
+			Paused:          info.Paused,
+			PendingCount:    info.Counts[queuedjob.StatusPending],
+			ProcessingCount: info.Counts[queuedjob.StatusProcessing],
+			CompletedCount:  info.Counts[queuedjob.StatusCompleted],
+			FailedCount:     info.Counts[queuedjob.StatusFailed],
+			CancelledCount:  info.Counts[queuedjob.StatusCancelled],
+		}
+	}
+
+	h.writeJSON(w, http.StatusOK, ListQueuesResponse{
+		Queues: queues,
+		Total:  len(queues),
+	})
+}
+
+// HandleListJobs handles GET /admin/queues/{name}/jobs
+func (h *AdminHandler) HandleListJobs(w http.ResponseWriter, r *http.Request, queueName string) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+	jobQueue, ok := h.jobQueue(w)
+	if !ok {
+		return
+	}
+
+	opts := queue.ListJobsOptions{Limit: defaultJobListLimit}
+	if status := r.URL.Query().Get("status"); status != "" {
+		opts.Status = queuedjob.Status(status)
+		if err := queuedjob.StatusValidator(opts.Status); err != nil {
+			h.writeError(w, http.StatusBadRequest, "invalid_status", "Invalid job status: "+status)
+			return
+		}
+	}
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 1 {
+			h.writeError(w, http.StatusBadRequest, "invalid_limit", "Limit must be a positive integer")
+			return
+		}
+		opts.Limit = n
+	}
+
+	records, err := jobQueue.ListJobs(r.Context(), queueName, opts)
+	if err != nil {
+		if errors.Is(err, queue.ErrQueueNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Queue not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		return
+	}
+
+	jobs := make([]*Job, len(records))
+	for i, record := range records {
+		jobs[i] = jobToResponse(record)
+	}
+
+	h.writeJSON(w, http.StatusOK, ListJobsResponse{
+		Jobs:  jobs,
+		Total: len(jobs),
+	})
+}
+
+// HandleGetDrain handles GET /admin/drain
+func (h *AdminHandler) HandleGetDrain(w http.ResponseWriter, r *http.Request) {
+	h.writeJSON(w, http.StatusOK, h.drainStatus())
+}
+
+// HandleDrain handles POST /admin/drain (with ?timeout, e.g. "5m"). Every
+// worker is paused, so no new work is scheduled or taken, and the jobs
+// running are left to finish in the background; poll GET /admin/drain
+// until it reports drained before stopping the process. Workers stay
+// paused until resumed.
+func (h *AdminHandler) HandleDrain(w http.ResponseWriter, r *http.Request) {
+	jobQueue, ok := h.jobQueue(w)
+	if !ok {
+		return
+	}
+
+	timeout := defaultDrainTimeout
+	if value := r.URL.Query().Get("timeout"); value != "" {
+		d, err := time.ParseDuration(value)
+		if err != nil || d <= 0 {
+			h.writeError(w, http.StatusBadRequest, "invalid_timeout", "Timeout must be a positive duration, e.g. 5m")
+			return
+		}
+		timeout = d
+	}
+
+	h.mu.Lock()
+	if h.drain.State == DrainStateDraining {
+		h.mu.Unlock()
+		h.writeError(w, http.StatusConflict, "conflict", "A drain is already in progress")
+		return
+	}
+	now := time.Now()
+	h.drain = DrainStatus{State: DrainStateDraining, StartedAt: &now}
+	workers := make(map[string]Worker, len(h.workers))
+	for name, wk := range h.workers {
+		workers[name] = wk
+	}
+	h.mu.Unlock()
+
+	// Pause the workers that schedule work first, then stop taking jobs
+	var pauseErrors []string
+	for name, wk := range workers {
+		if !wk.IsRunning() || wk.IsPaused() {
+			continue
+		}
+		if err := wk.Pause(); err != nil {
+			pauseErrors = append(pauseErrors, name+": "+err.Error())
+		}
+	}
+	sort.Strings(pauseErrors)
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(r.Context()), timeout)
+	go func() {
+		defer cancel()
+		err := jobQueue.Drain(ctx)
+
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		finished := time.Now()
+		h.drain.FinishedAt = &finished
+		h.drain.Errors = pauseErrors
+		h.drain.State = DrainStateDrained
+		if err != nil {
+			h.drain.State = DrainStateTimedOut
+		}
+	}()
+
+	h.writeJSON(w, http.StatusAccepted, h.drainStatus())
+}
+
+// drainStatus returns the progress of the latest drain
+func (h *AdminHandler) drainStatus() DrainStatus {
+	h.mu.RLock()
+	status := h.drain
+	jobQueue := h.queue
+	h.mu.RUnlock()
+	if jobQueue != nil {
+		status.ActiveJobs = jobQueue.ActiveCount()
+	}
+	return status
+}
+
+// jobQueue returns the job queue, writing an error if there is none
+func (h *AdminHandler) jobQueue(w http.ResponseWriter) (JobQueue, bool) {
+	h.mu.RLock()
+	jobQueue := h.queue
+	h.mu.RUnlock()
+	if jobQueue == nil {
+		h.writeError(w, http.StatusServiceUnavailable, "unavailable", "Job queue is not configured")
+		return nil, false
+	}
+	return jobQueue, true
+}
+
+// workerStatus describes a worker
+func workerStatus(name string, wk Worker) *WorkerStatus {
+	return &WorkerStatus{
+		Name:    name,
+		Running: wk.IsRunning(),
+		Paused:  wk.IsPaused(),
+	}
+}
+
+// jobToResponse converts a queued job to its response
+func jobToResponse(job *ent.QueuedJob) *Job {
+	resp := &Job{
+		ID:          job.ID,
+		QueueName:   job.Queue,
+		Type:        job.Type,
+		Status:      string(job.Status),
+		Priority:    job.Priority,
+		Payload:     job.Payload,
+		RetryCount:  job.RetryCount,
+		MaxRetries:  job.MaxRetries,
+		Progress:    job.Progress,
+		RunAt:       job.RunAt,
+		CreatedAt:   job.CreatedAt,
+		StartedAt:   job.StartedAt,
+		CompletedAt: job.CompletedAt,
+	}
+	if job.Error != nil {
+		resp.Error = *job.Error
+	}
+	return resp
+}
+
+// writeJSON writes a JSON response
+func (h *AdminHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response
+func (h *AdminHandler) writeError(w http.ResponseWriter, status int, errCode string, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:   errCode,
+		Message: message,
+	})
+}
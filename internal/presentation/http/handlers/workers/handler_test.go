@@ -0,0 +1,144 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/infrastructure/queue"
+	"clockzen-next/internal/infrastructure/worker"
+)
+
+type fakeWorker struct {
+	running bool
+	paused  bool
+}
+
+func (w *fakeWorker) IsRunning() bool { return w.running }
+func (w *fakeWorker) IsPaused() bool  { return w.paused }
+func (w *fakeWorker) Pause() error    { w.paused = true; return nil }
+func (w *fakeWorker) Resume() error   { w.paused = false; return nil }
+
+type fakeTrigger struct{}
+
+func (fakeTrigger) SyncNow(_ context.Context, source, connectionID string) (string, error) {
+	if source != worker.SyncSourceEmail {
+		return "", worker.ErrUnknownSyncSource
+	}
+	if connectionID != "conn-1" {
+		return "", worker.ErrConnectionNotFound
+	}
+	return "task-1", nil
+}
+
+type fakeQueue struct {
+	drained chan struct{}
+}
+
+func (q *fakeQueue) Queues(context.Context) ([]*queue.QueueInfo, error) { return nil, nil }
+func (q *fakeQueue) ListJobs(context.Context, string, queue.ListJobsOptions) ([]*ent.QueuedJob, error) {
+	return nil, nil
+}
+func (q *fakeQueue) ActiveCount() int { return 0 }
+func (q *fakeQueue) Drain(context.Context) error {
+	close(q.drained)
+	return nil
+}
+
+func newTestMux(t *testing.T) (*http.ServeMux, *fakeWorker, *fakeQueue) {
+	t.Helper()
+	router := NewDefaultRouter("secret")
+	wk := &fakeWorker{running: true}
+	jobQueue := &fakeQueue{drained: make(chan struct{})}
+	router.GetAdminHandler().SetWorker("email", wk)
+	router.GetAdminHandler().SetSyncTrigger(fakeTrigger{})
+	router.GetAdminHandler().SetJobQueue(jobQueue)
+	mux := http.NewServeMux()
+	router.RegisterRoutes(mux)
+	return mux, wk, jobQueue
+}
+
+func serve(mux *http.ServeMux, method, path, token, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAuthorize(t *testing.T) {
+	mux, _, _ := newTestMux(t)
+
+	assert.Equal(t, http.StatusUnauthorized, serve(mux, http.MethodGet, "/admin/workers", "", "").Code)
+	assert.Equal(t, http.StatusUnauthorized, serve(mux, http.MethodGet, "/admin/workers", "wrong", "").Code)
+	assert.Equal(t, http.StatusOK, serve(mux, http.MethodGet, "/admin/workers", "secret", "").Code)
+
+	t.Run("no token configured refuses every request", func(t *testing.T) {
+		mux := http.NewServeMux()
+		NewDefaultRouter("").RegisterRoutes(mux)
+		assert.Equal(t, http.StatusUnauthorized, serve(mux, http.MethodGet, "/admin/workers", "", "").Code)
+	})
+}
+
+func TestPauseResumeWorker(t *testing.T) {
+	mux, wk, _ := newTestMux(t)
+
+	rec := serve(mux, http.MethodPost, "/admin/workers/email/pause", "secret", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, wk.paused)
+
+	rec = serve(mux, http.MethodPost, "/admin/workers/email/resume", "secret", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.False(t, wk.paused)
+
+	assert.Equal(t, http.StatusNotFound, serve(mux, http.MethodPost, "/admin/workers/missing/pause", "secret", "").Code)
+	assert.Equal(t, http.StatusMethodNotAllowed, serve(mux, http.MethodGet, "/admin/workers/email/pause", "secret", "").Code)
+
+	wk.running = false
+	assert.Equal(t, http.StatusConflict, serve(mux, http.MethodPost, "/admin/workers/email/pause", "secret", "").Code)
+}
+
+func TestSync(t *testing.T) {
+	mux, _, _ := newTestMux(t)
+
+	rec := serve(mux, http.MethodPost, "/admin/sync", "secret", `{"source":"email","connection_id":"conn-1"}`)
+	require.Equal(t, http.StatusAccepted, rec.Code)
+	var resp SyncResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "task-1", resp.TaskID)
+
+	assert.Equal(t, http.StatusBadRequest, serve(mux, http.MethodPost, "/admin/sync", "secret", `{"source":"fax","connection_id":"conn-1"}`).Code)
+	assert.Equal(t, http.StatusNotFound, serve(mux, http.MethodPost, "/admin/sync", "secret", `{"source":"email","connection_id":"conn-2"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, serve(mux, http.MethodPost, "/admin/sync", "secret", `{"source":"email"}`).Code)
+}
+
+func TestDrain(t *testing.T) {
+	mux, wk, jobQueue := newTestMux(t)
+
+	rec := serve(mux, http.MethodPost, "/admin/drain", "secret", "")
+	require.Equal(t, http.StatusAccepted, rec.Code)
+	assert.True(t, wk.paused, "workers are paused before the queue drains")
+
+	select {
+	case <-jobQueue.drained:
+	case <-time.After(time.Second):
+		t.Fatal("queue was not drained")
+	}
+	require.Eventually(t, func() bool {
+		var status DrainStatus
+		rec := serve(mux, http.MethodGet, "/admin/drain", "secret", "")
+		return json.NewDecoder(rec.Body).Decode(&status) == nil && status.State == DrainStateDrained
+	}, time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, http.StatusBadRequest, serve(mux, http.MethodPost, "/admin/drain?timeout=soon", "secret", "").Code)
+}
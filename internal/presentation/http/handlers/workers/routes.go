@@ -0,0 +1,93 @@
+package workers
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Router handles routing for the worker process's admin endpoints
+type Router struct {
+	handler *AdminHandler
+}
+
+// NewRouter creates a new Router with the given handler
+func NewRouter(handler *AdminHandler) *Router {
+	return &Router{
+		handler: handler,
+	}
+}
+
+// NewDefaultRouter creates a new Router protected by the given admin token
+func NewDefaultRouter(token string) *Router {
+	return &Router{
+		handler: NewAdminHandler(token),
+	}
+}
+
+// RegisterRoutes registers the worker admin routes with the given mux
+// Total routes: 8 endpoints
+//
+// The worker process has no user sessions, so every route checks the admin
+// token itself.
+//
+//  1. GET    /admin/workers                     - List workers and whether they are paused
+//  2. POST   /admin/workers/{name}/pause        - Pause a worker
+//  3. POST   /admin/workers/{name}/resume       - Resume a paused worker
+//  4. POST   /admin/sync                        - Queue a sync of a connection now
+//  5. GET    /admin/queues                      - List task queues with job counts
+//  6. GET    /admin/queues/{name}/jobs          - List a queue's jobs (with ?status and ?limit filters)
+//  7. POST   /admin/drain                       - Pause every worker and let running jobs finish (with ?timeout)
+//  8. GET    /admin/drain                       - Get the progress of the latest drain
+func (r *Router) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/admin/workers", r.handler.Authorize(r.handler.HandleListWorkers))
+	mux.HandleFunc("/admin/workers/", r.handler.Authorize(r.handleWorkerByName))
+	mux.HandleFunc("/admin/sync", r.handler.Authorize(r.handler.HandleSync))
+	mux.HandleFunc("/admin/queues", r.handler.Authorize(r.handler.HandleListQueues))
+	mux.HandleFunc("/admin/queues/", r.handler.Authorize(r.handleQueueByName))
+	mux.HandleFunc("/admin/drain", r.handler.Authorize(r.handleDrain))
+}
+
+// GetAdminHandler returns the admin handler
+func (r *Router) GetAdminHandler() *AdminHandler {
+	return r.handler
+}
+
+// handleWorkerByName routes requests for /admin/workers/{name}/{action}
+func (r *Router) handleWorkerByName(w http.ResponseWriter, req *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(req.URL.Path, "/admin/workers/"), "/")
+	if len(parts) != 2 || parts[0] == "" {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+
+	switch parts[1] {
+	case "pause":
+		r.handler.HandlePauseWorker(w, req, parts[0])
+	case "resume":
+		r.handler.HandleResumeWorker(w, req, parts[0])
+	default:
+		http.Error(w, "Not found", http.StatusNotFound)
+	}
+}
+
+// handleQueueByName routes requests for /admin/queues/{name}/jobs
+func (r *Router) handleQueueByName(w http.ResponseWriter, req *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(req.URL.Path, "/admin/queues/"), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] != "jobs" {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+	r.handler.HandleListJobs(w, req, parts[0])
+}
+
+// handleDrain routes requests for /admin/drain
+func (r *Router) handleDrain(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		r.handler.HandleGetDrain(w, req)
+	case http.MethodPost:
+		r.handler.HandleDrain(w, req)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
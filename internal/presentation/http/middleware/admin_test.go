@@ -23,72 +23,47 @@ func TestRequireAdmin(t *testing.T) {
 
 	tests := []struct {
 		name           string
-		setupAuth      func(r *http.Request)
+		setupAuth      func(r *http.Request) *http.Request
 		expectedStatus int
 		expectedBody   string
 	}{
 		{
-			name:           "no auth header returns 403",
-			setupAuth:      func(r *http.Request) {},
+			name:           "no user returns 403",
+			setupAuth:      func(r *http.Request) *http.Request { return r },
 			expectedStatus: http.StatusForbidden,
 			expectedBody:   `"error":"forbidden"`,
 		},
 		{
-			name: "valid JWT with admin role returns 200",
-			setupAuth: func(r *http.Request) {
-				token := createTestJWT(t, JWTClaims{Role: "admin", UserID: "user-123"})
-				r.Header.Set("Authorization", "Bearer "+token)
-			},
-			expectedStatus: http.StatusOK,
-			expectedBody:   "success",
-		},
-		{
-			name: "valid JWT with admin in roles array returns 200",
-			setupAuth: func(r *http.Request) {
-				token := createTestJWT(t, JWTClaims{Roles: []string{"user", "admin"}, UserID: "user-123"})
-				r.Header.Set("Authorization", "Bearer "+token)
-			},
-			expectedStatus: http.StatusOK,
-			expectedBody:   "success",
-		},
-		{
-			name: "valid JWT without admin role returns 403",
-			setupAuth: func(r *http.Request) {
-				token := createTestJWT(t, JWTClaims{Role: "user", UserID: "user-123"})
-				r.Header.Set("Authorization", "Bearer "+token)
-			},
-			expectedStatus: http.StatusForbidden,
-			expectedBody:   `"error":"forbidden"`,
-		},
-		{
-			name: "admin API key returns 200",
-			setupAuth: func(r *http.Request) {
-				r.Header.Set("X-API-Key", "admin:secret-key-123")
+			name: "verified admin user returns 200",
+			setupAuth: func(r *http.Request) *http.Request {
+				return r.WithContext(WithUser(r.Context(), &User{ID: "user-123", Roles: []string{"user", "admin"}}))
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody:   "success",
 		},
 		{
-			name: "non-admin API key returns 403",
-			setupAuth: func(r *http.Request) {
-				r.Header.Set("X-API-Key", "user:secret-key-123")
+			name: "verified user without admin role returns 403",
+			setupAuth: func(r *http.Request) *http.Request {
+				return r.WithContext(WithUser(r.Context(), &User{ID: "user-123", Roles: []string{"user"}}))
 			},
 			expectedStatus: http.StatusForbidden,
 			expectedBody:   `"error":"forbidden"`,
 		},
 		{
-			name: "invalid JWT format returns 403",
-			setupAuth: func(r *http.Request) {
-				r.Header.Set("Authorization", "Bearer invalid-token")
+			name: "unverified JWT with admin role returns 403",
+			setupAuth: func(r *http.Request) *http.Request {
+				token := createTestJWT(t, JWTClaims{Role: "admin", UserID: "user-123"})
+				r.Header.Set("Authorization", "Bearer "+token)
+				return r
 			},
 			expectedStatus: http.StatusForbidden,
 			expectedBody:   `"error":"forbidden"`,
 		},
 		{
-			name: "malformed JWT payload returns 403",
-			setupAuth: func(r *http.Request) {
-				// Create a token with invalid base64 in payload
-				r.Header.Set("Authorization", "Bearer header.!!!invalid!!!.signature")
+			name: "admin-prefixed API key returns 403",
+			setupAuth: func(r *http.Request) *http.Request {
+				r.Header.Set("X-API-Key", "admin:secret-key-123")
+				return r
 			},
 			expectedStatus: http.StatusForbidden,
 			expectedBody:   `"error":"forbidden"`,
@@ -97,8 +72,7 @@ func TestRequireAdmin(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			req := httptest.NewRequest(http.MethodGet, "/admin/endpoint", nil)
-			tc.setupAuth(req)
+			req := tc.setupAuth(httptest.NewRequest(http.MethodGet, "/admin/endpoint", nil))
 
 			rr := httptest.NewRecorder()
 			protectedHandler.ServeHTTP(rr, req)
@@ -121,8 +95,7 @@ func TestRequireAdminFunc(t *testing.T) {
 
 	t.Run("allows admin access", func(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/admin/endpoint", nil)
-		token := createTestJWT(t, JWTClaims{Role: "admin", UserID: "user-123"})
-		req.Header.Set("Authorization", "Bearer "+token)
+		req = req.WithContext(WithUser(req.Context(), &User{ID: "user-123", Roles: []string{AdminRole}}))
 
 		rr := httptest.NewRecorder()
 		protectedHandler(rr, req)
@@ -140,59 +113,6 @@ func TestRequireAdminFunc(t *testing.T) {
 	})
 }
 
-func TestExtractJWTClaims(t *testing.T) {
-	t.Run("valid token", func(t *testing.T) {
-		token := createTestJWT(t, JWTClaims{
-			Role:   "admin",
-			Roles:  []string{"user", "admin"},
-			UserID: "user-123",
-		})
-
-		claims, err := extractJWTClaims(token)
-		require.NoError(t, err)
-		assert.Equal(t, "admin", claims.Role)
-		assert.Equal(t, []string{"user", "admin"}, claims.Roles)
-		assert.Equal(t, "user-123", claims.UserID)
-	})
-
-	t.Run("invalid token format", func(t *testing.T) {
-		_, err := extractJWTClaims("not-a-jwt")
-		assert.ErrorIs(t, err, ErrInvalidToken)
-	})
-
-	t.Run("invalid base64", func(t *testing.T) {
-		_, err := extractJWTClaims("header.!!!.signature")
-		assert.ErrorIs(t, err, ErrInvalidToken)
-	})
-
-	t.Run("invalid JSON", func(t *testing.T) {
-		invalidPayload := base64.RawURLEncoding.EncodeToString([]byte("not json"))
-		_, err := extractJWTClaims("header." + invalidPayload + ".signature")
-		assert.ErrorIs(t, err, ErrInvalidToken)
-	})
-}
-
-func TestIsAdminAPIKey(t *testing.T) {
-	tests := []struct {
-		name     string
-		apiKey   string
-		expected bool
-	}{
-		{"admin prefix", "admin:secret", true},
-		{"admin prefix with complex key", "admin:abc-123-xyz", true},
-		{"user prefix", "user:secret", false},
-		{"no prefix", "just-a-key", false},
-		{"empty", "", false},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			result := isAdminAPIKey(tc.apiKey)
-			assert.Equal(t, tc.expected, result)
-		})
-	}
-}
-
 func TestMiddlewareError(t *testing.T) {
 	err := &MiddlewareError{Code: "test_error", Message: "Test message"}
 	assert.Equal(t, "Test message", err.Error())
@@ -0,0 +1,213 @@
+package middleware
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// AuthConfig configures bearer token authentication.
+type AuthConfig struct {
+	// Secret is the HMAC key used to sign HS256 tokens.
+	Secret []byte
+	// Issuer, when set, must match the token's iss claim.
+	Issuer string
+	// Leeway allows for clock skew when checking exp and nbf.
+	Leeway time.Duration
+}
+
+// User is the authenticated principal attached to a request context.
+type User struct {
+	ID    string
+	Roles []string
+	// Locale is the language tag the user prefers generated text in, from
+	// the token's locale claim. Empty if the user hasn't chosen one.
+	Locale string
+}
+
+// HasRole reports whether the user holds the given role.
+func (u *User) HasRole(role string) bool {
+	return slices.Contains(u.Roles, role)
+}
+
+// userContextKey is the context key for the authenticated user.
+type userContextKey struct{}
+
+// WithUser returns a copy of ctx carrying the authenticated user.
+func WithUser(ctx context.Context, user *User) context.Context {
+	return context.WithValue(ctx, userContextKey{}, user)
+}
+
+// UserFromContext returns the authenticated user, if any.
+func UserFromContext(ctx context.Context) (*User, bool) {
+	user, ok := ctx.Value(userContextKey{}).(*User)
+	return user, ok && user != nil
+}
+
+// UserIDFromContext returns the authenticated user's ID, if any.
+func UserIDFromContext(ctx context.Context) (string, bool) {
+	user, ok := UserFromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	return user.ID, true
+}
+
+// RequireAuth returns middleware that verifies the request's bearer JWT and
+// injects the authenticated user into the request context. Requests without
+// a valid token get 401 Unauthorized.
+func RequireAuth(config AuthConfig) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || token == "" {
+				writeUnauthorized(w, ErrMissingToken)
+				return
+			}
+
+			user, err := Authenticate(token, config)
+			if err != nil {
+				writeUnauthorized(w, err)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(WithUser(r.Context(), user)))
+		})
+	}
+}
+
+// Authenticate verifies a bearer JWT and returns the user it was issued to.
+// It is the check RequireAuth makes, for servers other than HTTP ones.
+func Authenticate(token string, config AuthConfig) (*User, error) {
+	claims, err := verifyJWT(token, config, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	user := &User{ID: claims.UserID, Roles: claims.Roles, Locale: claims.Locale}
+	if claims.Role != "" && !user.HasRole(claims.Role) {
+		user.Roles = append(user.Roles, claims.Role)
+	}
+	return user, nil
+}
+
+// jwtHeader represents the JOSE header of a JWT.
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+}
+
+// verifyJWT checks an HS256 token's signature and registered claims and
+// returns its claims.
+func verifyJWT(token string, config AuthConfig, now time.Time) (*JWTClaims, error) {
+	if len(config.Secret) == 0 {
+		return nil, ErrInvalidToken
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+
+	headerJSON, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	var header jwtHeader
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		return nil, ErrInvalidToken
+	}
+	// Only accept the algorithm we sign with; never "none"
+	if header.Alg != "HS256" {
+		return nil, ErrInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	mac := hmac.New(sha256.New, config.Secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	var claims JWTClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrInvalidToken
+	}
+	if claims.UserID == "" {
+		return nil, ErrInvalidToken
+	}
+	if config.Issuer != "" && claims.Issuer != config.Issuer {
+		return nil, ErrInvalidToken
+	}
+
+	// Tokens must expire
+	if claims.ExpiresAt == 0 || now.After(time.Unix(claims.ExpiresAt, 0).Add(config.Leeway)) {
+		return nil, ErrTokenExpired
+	}
+	if claims.NotBefore != 0 && now.Add(config.Leeway).Before(time.Unix(claims.NotBefore, 0)) {
+		return nil, ErrInvalidToken
+	}
+
+	return &claims, nil
+}
+
+// IssueToken signs claims as an HS256 token that RequireAuth accepts with
+// the same config. The config's issuer is used when claims have none.
+func IssueToken(config AuthConfig, claims JWTClaims) (string, error) {
+	if len(config.Secret) == 0 {
+		return "", errors.New("signing secret is required")
+	}
+	if claims.UserID == "" {
+		return "", errors.New("token subject is required")
+	}
+	if claims.ExpiresAt == 0 {
+		return "", errors.New("token expiry is required")
+	}
+	if claims.Issuer == "" {
+		claims.Issuer = config.Issuer
+	}
+
+	header, err := json.Marshal(jwtHeader{Alg: "HS256", Typ: "JWT"})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	mac := hmac.New(sha256.New, config.Secret)
+	mac.Write([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// writeUnauthorized writes a 401 response for a failed authentication.
+func writeUnauthorized(w http.ResponseWriter, err error) {
+	mwErr, ok := err.(*MiddlewareError)
+	if !ok {
+		mwErr = ErrInvalidToken
+	}
+
+	w.Header().Set("WWW-Authenticate", `Bearer realm="clockzen"`)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnauthorized)
+	json.NewEncoder(w).Encode(map[string]string{
+		"error":   mwErr.Code,
+		"message": mwErr.Message,
+	})
+}
@@ -0,0 +1,212 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testAuthConfig = AuthConfig{
+	Secret: []byte("test-secret"),
+	Issuer: "clockzen",
+}
+
+func TestRequireAuth(t *testing.T) {
+	// Echo the authenticated user ID
+	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := UserIDFromContext(r.Context())
+		if !ok {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(userID))
+	})
+
+	protectedHandler := RequireAuth(testAuthConfig)(testHandler)
+	future := time.Now().Add(time.Hour).Unix()
+
+	tests := []struct {
+		name           string
+		setupAuth      func(r *http.Request)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "no auth header returns 401",
+			setupAuth:      func(r *http.Request) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `"error":"unauthorized"`,
+		},
+		{
+			name: "signed token injects user",
+			setupAuth: func(r *http.Request) {
+				token := createSignedTestJWT(t, testAuthConfig.Secret, JWTClaims{UserID: "user-123", Issuer: "clockzen", ExpiresAt: future})
+				r.Header.Set("Authorization", "Bearer "+token)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "user-123",
+		},
+		{
+			name: "unsigned token returns 401",
+			setupAuth: func(r *http.Request) {
+				token := createTestJWT(t, JWTClaims{UserID: "user-123", Issuer: "clockzen", ExpiresAt: future})
+				r.Header.Set("Authorization", "Bearer "+token)
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `"error":"invalid_token"`,
+		},
+		{
+			name: "token signed with another secret returns 401",
+			setupAuth: func(r *http.Request) {
+				token := createSignedTestJWT(t, []byte("other-secret"), JWTClaims{UserID: "user-123", Issuer: "clockzen", ExpiresAt: future})
+				r.Header.Set("Authorization", "Bearer "+token)
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `"error":"invalid_token"`,
+		},
+		{
+			name: "expired token returns 401",
+			setupAuth: func(r *http.Request) {
+				token := createSignedTestJWT(t, testAuthConfig.Secret, JWTClaims{UserID: "user-123", Issuer: "clockzen", ExpiresAt: time.Now().Add(-time.Hour).Unix()})
+				r.Header.Set("Authorization", "Bearer "+token)
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `"error":"token_expired"`,
+		},
+		{
+			name: "token without expiry returns 401",
+			setupAuth: func(r *http.Request) {
+				token := createSignedTestJWT(t, testAuthConfig.Secret, JWTClaims{UserID: "user-123", Issuer: "clockzen"})
+				r.Header.Set("Authorization", "Bearer "+token)
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `"error":"token_expired"`,
+		},
+		{
+			name: "wrong issuer returns 401",
+			setupAuth: func(r *http.Request) {
+				token := createSignedTestJWT(t, testAuthConfig.Secret, JWTClaims{UserID: "user-123", Issuer: "elsewhere", ExpiresAt: future})
+				r.Header.Set("Authorization", "Bearer "+token)
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `"error":"invalid_token"`,
+		},
+		{
+			name: "token without subject returns 401",
+			setupAuth: func(r *http.Request) {
+				token := createSignedTestJWT(t, testAuthConfig.Secret, JWTClaims{Issuer: "clockzen", ExpiresAt: future})
+				r.Header.Set("Authorization", "Bearer "+token)
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `"error":"invalid_token"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/integrations/drive/connections", nil)
+			tc.setupAuth(req)
+
+			rr := httptest.NewRecorder()
+			protectedHandler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+			assert.Contains(t, rr.Body.String(), tc.expectedBody)
+		})
+	}
+}
+
+func TestRequireAuthRejectsNoneAlgorithm(t *testing.T) {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	payload, err := json.Marshal(JWTClaims{UserID: "user-123", ExpiresAt: time.Now().Add(time.Hour).Unix()})
+	require.NoError(t, err)
+	token := header + "." + base64.RawURLEncoding.EncodeToString(payload) + "."
+
+	_, err = verifyJWT(token, testAuthConfig, time.Now())
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestRequireAuthThenAdmin(t *testing.T) {
+	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := RequireAuth(testAuthConfig)(RequireAdmin(testHandler))
+
+	token := createSignedTestJWT(t, testAuthConfig.Secret, JWTClaims{
+		UserID:    "admin-1",
+		Role:      AdminRole,
+		Issuer:    "clockzen",
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+	})
+	req := httptest.NewRequest(http.MethodGet, "/api/admin/queues", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestUserFromContext(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	_, ok := UserFromContext(req.Context())
+	assert.False(t, ok)
+
+	ctx := WithUser(req.Context(), &User{ID: "user-123", Roles: []string{"user"}})
+	user, ok := UserFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, "user-123", user.ID)
+	assert.True(t, user.HasRole("user"))
+	assert.False(t, user.HasRole(AdminRole))
+}
+
+func TestIssueToken(t *testing.T) {
+	now := time.Now()
+	token, err := IssueToken(testAuthConfig, JWTClaims{
+		UserID:    "user-123",
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(time.Hour).Unix(),
+		Locale:    "de",
+	})
+	require.NoError(t, err)
+
+	claims, err := verifyJWT(token, testAuthConfig, now)
+	require.NoError(t, err)
+	assert.Equal(t, "user-123", claims.UserID)
+	assert.Equal(t, "clockzen", claims.Issuer, "the config's issuer is used")
+	assert.Equal(t, "de", claims.Locale)
+
+	_, err = verifyJWT(token, AuthConfig{Secret: []byte("other-secret")}, now)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	_, err = IssueToken(testAuthConfig, JWTClaims{UserID: "user-123"})
+	assert.Error(t, err, "tokens must expire")
+	_, err = IssueToken(AuthConfig{}, JWTClaims{UserID: "user-123", ExpiresAt: now.Unix()})
+	assert.Error(t, err)
+}
+
+// createSignedTestJWT creates an HS256 token signed with secret.
+func createSignedTestJWT(t *testing.T, secret []byte, claims JWTClaims) string {
+	t.Helper()
+
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	signingInput := header + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signingInput))
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"net/http"
+
+	"clockzen-next/internal/infrastructure/i18n"
+)
+
+// Localize returns middleware that attaches a localizer to the request
+// context, which services use for the text they generate. The language is
+// the authenticated user's preferred locale if the catalog supports it,
+// otherwise the best match for the Accept-Language header, otherwise the
+// catalog's default. The chosen language is echoed in Content-Language.
+func Localize(catalog *i18n.Catalog) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var locales []string
+			if user, ok := UserFromContext(r.Context()); ok && user.Locale != "" {
+				locales = append(locales, user.Locale)
+			}
+			if accept := r.Header.Get("Accept-Language"); accept != "" {
+				locales = append(locales, accept)
+			}
+
+			localizer := catalog.Localizer(locales...)
+			w.Header().Set("Content-Language", localizer.Language().String())
+			next.ServeHTTP(w, r.WithContext(i18n.WithLocalizer(r.Context(), localizer)))
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"clockzen-next/internal/infrastructure/i18n"
+)
+
+func TestLocalize(t *testing.T) {
+	// Echo the language of the request's localizer
+	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(i18n.FromContext(r.Context()).Language().String()))
+	})
+	handler := Localize(i18n.Default())(testHandler)
+
+	tests := []struct {
+		name           string
+		user           *User
+		acceptLanguage string
+		expected       string
+	}{
+		{
+			name:     "no preference uses default language",
+			expected: "en",
+		},
+		{
+			name:           "Accept-Language picks supported language",
+			acceptLanguage: "es-MX,es;q=0.9,en;q=0.8",
+			expected:       "es",
+		},
+		{
+			name:           "unsupported Accept-Language uses default language",
+			acceptLanguage: "ja",
+			expected:       "en",
+		},
+		{
+			name:           "user preference wins over Accept-Language",
+			user:           &User{ID: "user-123", Locale: "es"},
+			acceptLanguage: "en-US",
+			expected:       "es",
+		},
+		{
+			name:           "unsupported user preference falls back to Accept-Language",
+			user:           &User{ID: "user-123", Locale: "ja-JP"},
+			acceptLanguage: "es",
+			expected:       "es",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/analysis/anomalies", nil)
+			if tt.user != nil {
+				req = req.WithContext(WithUser(req.Context(), tt.user))
+			}
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expected, rec.Body.String())
+			assert.Equal(t, tt.expected, rec.Header().Get("Content-Language"))
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/infrastructure/logging"
+)
+
+func TestRequestID(t *testing.T) {
+	// Echo the request ID seen by the handler
+	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID, ok := logging.RequestIDFromContext(r.Context())
+		require.True(t, ok)
+		w.Write([]byte(requestID))
+	})
+	handler := RequestID(testHandler)
+
+	tests := []struct {
+		name     string
+		incoming string
+		keep     bool
+	}{
+		{name: "missing ID is generated", incoming: "", keep: false},
+		{name: "valid ID is kept", incoming: "edge-7f3a.42:1", keep: true},
+		{name: "unsafe ID is replaced", incoming: "bad id\n", keep: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/jobs", nil)
+			if tt.incoming != "" {
+				req.Header.Set(RequestIDHeader, tt.incoming)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			requestID := rec.Header().Get(RequestIDHeader)
+			assert.NotEmpty(t, requestID)
+			assert.Equal(t, requestID, rec.Body.String())
+			if tt.keep {
+				assert.Equal(t, tt.incoming, requestID)
+			} else {
+				assert.NotEqual(t, tt.incoming, requestID)
+			}
+		})
+	}
+}
+
+func TestLogRequests(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := logging.New(&buf, logging.Config{Format: logging.FormatJSON, Level: slog.LevelInfo})
+	require.NoError(t, err)
+
+	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte("boom"))
+	})
+	handler := RequestID(LogRequests(logger)(testHandler))
+
+	req := httptest.NewRequest(http.MethodPost, "/api/jobs", nil)
+	req.Header.Set(RequestIDHeader, "req-123")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "ERROR", entry["level"])
+	assert.Equal(t, "request completed", entry["msg"])
+	assert.Equal(t, "req-123", entry["request_id"])
+	assert.Equal(t, "POST", entry["method"])
+	assert.Equal(t, "/api/jobs", entry["path"])
+	assert.Equal(t, float64(http.StatusInternalServerError), entry["status"])
+	assert.Equal(t, float64(4), entry["bytes"])
+}
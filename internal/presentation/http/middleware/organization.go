@@ -0,0 +1,125 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"clockzen-next/internal/application/organizations"
+)
+
+// OrganizationHeader names the organization a request acts in. Without it
+// requests act on the user's own data.
+const OrganizationHeader = "X-Organization-ID"
+
+// Organization is the organization a request acts in and the user's role in
+// it
+type Organization struct {
+	ID   string
+	Role organizations.Role
+}
+
+// OrganizationMembers looks up users' roles in organizations
+type OrganizationMembers interface {
+	// MemberRole returns the user's role, or organizations.ErrNotFound if
+	// they aren't a member
+	MemberRole(ctx context.Context, organizationID, userID string) (organizations.Role, error)
+}
+
+// organizationContextKey is the context key for the request's organization.
+type organizationContextKey struct{}
+
+// WithOrganization returns a copy of ctx acting in the organization.
+func WithOrganization(ctx context.Context, org *Organization) context.Context {
+	return context.WithValue(ctx, organizationContextKey{}, org)
+}
+
+// OrganizationFromContext returns the organization the request acts in, if
+// any.
+func OrganizationFromContext(ctx context.Context) (*Organization, bool) {
+	org, ok := ctx.Value(organizationContextKey{}).(*Organization)
+	return org, ok && org != nil
+}
+
+// OwnerIDFromContext returns the ID the data a request reads and writes is
+// owned by: the organization it acts in, or else the authenticated user.
+func OwnerIDFromContext(ctx context.Context) (string, bool) {
+	if org, ok := OrganizationFromContext(ctx); ok {
+		return org.ID, true
+	}
+	return UserIDFromContext(ctx)
+}
+
+// ScopeOrganization returns middleware that acts in the organization named
+// by the X-Organization-ID header, after checking the authenticated user is
+// a member. Organizations the user doesn't belong to are not found. Viewers
+// can only read: their requests with other methods than GET, HEAD and
+// OPTIONS are forbidden, except under the exempt path prefixes, such as
+// analyses, which only compute results, and routes that check roles
+// themselves. A nil members rejects every organization.
+func ScopeOrganization(members OrganizationMembers, exempt ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			organizationID := strings.TrimSpace(r.Header.Get(OrganizationHeader))
+			if organizationID == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			userID, ok := UserIDFromContext(r.Context())
+			if !ok {
+				writeUnauthorized(w, ErrMissingToken)
+				return
+			}
+			if members == nil {
+				writeOrganizationError(w, http.StatusNotFound, "not_found", "Organization not found")
+				return
+			}
+
+			role, err := members.MemberRole(r.Context(), organizationID, userID)
+			if err != nil {
+				if errors.Is(err, organizations.ErrNotFound) {
+					writeOrganizationError(w, http.StatusNotFound, "not_found", "Organization not found")
+					return
+				}
+				writeOrganizationError(w, http.StatusInternalServerError, "query_failed", "Failed to check organization membership")
+				return
+			}
+			if !role.CanWrite() && !isReadMethod(r.Method) && !hasAnyPrefix(r.URL.Path, exempt) {
+				writeOrganizationError(w, http.StatusForbidden, "forbidden", "Viewers can't change the organization's data")
+				return
+			}
+
+			org := &Organization{ID: organizationID, Role: role}
+			next.ServeHTTP(w, r.WithContext(WithOrganization(r.Context(), org)))
+		})
+	}
+}
+
+// isReadMethod reports whether requests with the method only read
+func isReadMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// hasAnyPrefix reports whether path starts with one of prefixes
+func hasAnyPrefix(path string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeOrganizationError writes an error response for a request that can't
+// act in its organization.
+func writeOrganizationError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{
+		"error":   code,
+		"message": message,
+	})
+}
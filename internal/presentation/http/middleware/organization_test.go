@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"clockzen-next/internal/application/organizations"
+)
+
+// fakeMembers holds each user's role by organization
+type fakeMembers map[string]map[string]organizations.Role
+
+func (f fakeMembers) MemberRole(_ context.Context, organizationID, userID string) (organizations.Role, error) {
+	role, ok := f[organizationID][userID]
+	if !ok {
+		return "", organizations.ErrNotFound
+	}
+	return role, nil
+}
+
+func TestScopeOrganization(t *testing.T) {
+	members := fakeMembers{"org-1": {
+		"alice": organizations.RoleOwner,
+		"bob":   organizations.RoleViewer,
+	}}
+	var ownerID string
+	handler := ScopeOrganization(members, "/api/analysis/")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ownerID, _ = OwnerIDFromContext(r.Context())
+	}))
+
+	serve := func(method, path, userID, organizationID string) int {
+		ownerID = ""
+		req := httptest.NewRequest(method, path, nil)
+		req = req.WithContext(WithUser(req.Context(), &User{ID: userID}))
+		if organizationID != "" {
+			req.Header.Set(OrganizationHeader, organizationID)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	t.Run("without the header requests act for the user", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/api/budgets/b1/reallocations", "alice", ""))
+		assert.Equal(t, "alice", ownerID)
+	})
+
+	t.Run("members act for the organization", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/api/budgets/b1/reallocations", "alice", "org-1"))
+		assert.Equal(t, "org-1", ownerID)
+	})
+
+	t.Run("non-members get not found", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, serve(http.MethodGet, "/api/budgets/b1/reallocations", "mallory", "org-1"))
+		assert.Empty(t, ownerID)
+	})
+
+	t.Run("viewers can only read", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/api/budgets/b1/reallocations", "bob", "org-1"))
+		assert.Equal(t, http.StatusForbidden, serve(http.MethodPost, "/api/budgets/b1/reallocations", "bob", "org-1"))
+		assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/api/analysis/spending", "bob", "org-1"), "analyses are exempt")
+	})
+
+	t.Run("no members rejects organizations", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/budgets/b1/reallocations", nil)
+		req.Header.Set(OrganizationHeader, "org-1")
+		ScopeOrganization(nil)(http.NotFoundHandler()).ServeHTTP(rec, req.WithContext(WithUser(req.Context(), &User{ID: "alice"})))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
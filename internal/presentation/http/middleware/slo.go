@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"clockzen-next/internal/infrastructure/observability"
+)
+
+// TrackSLO returns middleware that records each request's latency and status
+// against the tracker's per-route objectives. Server-Sent Event streams are
+// long-lived by design and are not recorded.
+func TrackSLO(tracker *observability.SLOTracker) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+
+			if strings.HasPrefix(rec.Header().Get("Content-Type"), "text/event-stream") {
+				return
+			}
+			tracker.Observe(observability.RouteKey(r.Method, r.URL.Path), rec.status, time.Since(start))
+		})
+	}
+}
+
+// statusRecorder captures the response status code and size.
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	bytes       int64
+	wroteHeader bool
+}
+
+// WriteHeader records the status before writing it.
+func (r *statusRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Write marks the header as written with the default status.
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	n, err := r.ResponseWriter.Write(b)
+	r.bytes += int64(n)
+	return n, err
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer so
+// handlers can still flush and set deadlines.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
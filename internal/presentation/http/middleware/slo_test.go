@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/infrastructure/observability"
+)
+
+func TestTrackSLO(t *testing.T) {
+	config := observability.DefaultSLOConfig()
+	config.LatencyTarget = time.Second
+	tracker := observability.NewSLOTracker(config)
+
+	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("mode") {
+		case "error":
+			w.WriteHeader(http.StatusInternalServerError)
+		case "stream":
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.WriteHeader(http.StatusOK)
+			require.NoError(t, http.NewResponseController(w).Flush())
+		default:
+			w.Write([]byte("ok"))
+		}
+	})
+	handler := TrackSLO(tracker)(testHandler)
+
+	for _, target := range []string{
+		"/api/jobs/7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		"/api/jobs/9b2d5c1e-1111-4c2b-8e3f-000000000001",
+		"/api/jobs/9b2d5c1e-1111-4c2b-8e3f-000000000001?mode=error",
+		"/api/jobs/9b2d5c1e-1111-4c2b-8e3f-000000000001/stream?mode=stream",
+	} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	}
+
+	snapshot := tracker.Snapshot()
+	require.Len(t, snapshot, 1, "IDs share a route and streams are not recorded")
+
+	route := snapshot[0]
+	assert.Equal(t, "GET /api/jobs/{id}", route.Route)
+	assert.Equal(t, int64(3), route.Requests)
+	assert.Equal(t, int64(1), route.Errors)
+	assert.Equal(t, int64(2), route.GoodRequests)
+	assert.True(t, route.Breaching)
+}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"clockzen-next/internal/infrastructure/observability"
+	"clockzen-next/internal/infrastructure/telemetry"
+)
+
+// RecordUsage returns middleware that counts each request's route as a
+// feature and its error status, if any, as an error category. Only the
+// method and the path with IDs collapsed are recorded. Responses of 404 are
+// not counted as features, since their paths may not be routes at all, and
+// health checks and CORS preflights are not counted. If telemetry is
+// disabled the handler is returned unchanged.
+func RecordUsage(reporter *telemetry.Reporter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !reporter.Enabled() {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+
+			if r.Method == http.MethodOptions || strings.HasPrefix(r.URL.Path, "/health") {
+				return
+			}
+			if category := telemetry.ErrorCategory(rec.status); category != "" {
+				reporter.RecordError(category)
+			}
+			if rec.status != http.StatusNotFound {
+				reporter.RecordFeature(observability.RouteKey(r.Method, r.URL.Path))
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/infrastructure/telemetry"
+)
+
+func TestRecordUsage(t *testing.T) {
+	// Collect reports with the built-in receiver
+	aggregator := telemetry.NewAggregatorWithDefaults()
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		var report telemetry.Report
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&report))
+		require.NoError(t, aggregator.Receive(report))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer receiver.Close()
+
+	config := telemetry.DefaultConfig()
+	config.Enabled = true
+	config.Endpoint = receiver.URL
+	config.Token = "secret"
+	reporter := telemetry.NewReporter(config)
+
+	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("mode") {
+		case "conflict":
+			w.WriteHeader(http.StatusConflict)
+		case "missing":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.Write([]byte("ok"))
+		}
+	})
+	handler := RecordUsage(reporter)(testHandler)
+
+	for _, target := range []string{
+		"/api/jobs/7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		"/api/jobs/9b2d5c1e-1111-4c2b-8e3f-000000000001?mode=conflict",
+		"/api/unknown/path?mode=missing",
+		"/health",
+	} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	}
+
+	require.NoError(t, reporter.Flush(context.Background()))
+
+	summary := aggregator.Summary()
+	assert.Equal(t, int64(1), summary.Reports)
+	require.Len(t, summary.Installations, 1)
+	assert.Equal(t, []telemetry.Count{{Name: "GET /api/jobs/{id}", Count: 2}}, summary.Features,
+		"IDs share a feature and 404s and health checks are not counted")
+	assert.Equal(t, []telemetry.Count{
+		{Name: "conflict", Count: 1},
+		{Name: "not_found", Count: 1},
+	}, summary.Errors)
+
+	// Nothing new was recorded, so nothing is sent
+	require.NoError(t, reporter.Flush(context.Background()))
+	assert.Equal(t, int64(1), aggregator.Summary().Reports)
+}
+
+func TestRecordUsageDisabled(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("disabled reporter sent a report")
+	}))
+	defer receiver.Close()
+
+	// An endpoint alone does not opt in
+	config := telemetry.DefaultConfig()
+	config.Endpoint = receiver.URL
+	reporter := telemetry.NewReporter(config)
+
+	handler := RecordUsage(reporter)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/jobs", nil))
+
+	assert.False(t, reporter.Enabled())
+	require.NoError(t, reporter.Flush(context.Background()))
+}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// RequestTimeout returns middleware that gives each request's context a
+// deadline, so queries and calls made while serving it are cancelled rather
+// than holding a database connection after the client has given up. The
+// timeout should be below the server's write timeout, so handlers can still
+// write an error response. Server-Sent Event streams are long-lived by
+// design and get no deadline. A timeout of zero disables the middleware.
+func RequestTimeout(timeout time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if timeout <= 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isStream(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// isStream reports whether the request is for a Server-Sent Event stream
+func isStream(r *http.Request) bool {
+	return strings.HasSuffix(r.URL.Path, "/stream") ||
+		strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+}
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestTimeout(t *testing.T) {
+	var deadline time.Time
+	var hasDeadline bool
+	handler := RequestTimeout(time.Minute)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deadline, hasDeadline = r.Context().Deadline()
+	}))
+
+	t.Run("requests get a deadline", func(t *testing.T) {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/transactions", nil))
+
+		assert.True(t, hasDeadline)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, 5*time.Second)
+	})
+
+	t.Run("streams get no deadline", func(t *testing.T) {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/jobs/7c9e6679-7425-40de-944b-e07fc1f90ae7/stream", nil))
+		assert.False(t, hasDeadline)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/events", nil)
+		req.Header.Set("Accept", "text/event-stream")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		assert.False(t, hasDeadline)
+	})
+
+	t.Run("zero disables the timeout", func(t *testing.T) {
+		RequestTimeout(0)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, hasDeadline = r.Context().Deadline()
+		})).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/transactions", nil))
+
+		assert.False(t, hasDeadline)
+	})
+}
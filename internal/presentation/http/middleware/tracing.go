@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"clockzen-next/internal/infrastructure/observability"
+)
+
+// Trace returns middleware that starts a server span for each request,
+// continuing any trace propagated by the caller. Spans are named by route,
+// with IDs collapsed, so they group the same way as SLO metrics. Health
+// checks are not traced.
+func Trace(service string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return otelhttp.NewHandler(next, service,
+			otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+				return observability.RouteKey(r.Method, r.URL.Path)
+			}),
+			otelhttp.WithFilter(func(r *http.Request) bool {
+				return !strings.HasPrefix(r.URL.Path, "/health")
+			}),
+		)
+	}
+}
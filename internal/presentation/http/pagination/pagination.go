@@ -0,0 +1,308 @@
+// Package pagination parses the cursor, limit, filter and sort parameters of
+// list endpoints and applies them to ent queries. Lists are keyset
+// paginated: a cursor holds the sort value and ID of the last row of a
+// page, so later pages neither skip nor repeat rows when rows are added.
+//
+// Lists take these query parameters:
+//
+//	limit       rows per page, DefaultLimit by default and at most MaxLimit
+//	cursor      next_cursor of the previous page
+//	sort        column to sort by, prefixed with "-" for descending
+//	start_date  earliest time of the list's date column, a date or RFC 3339
+//	end_date    latest time of the list's date column; a date is the whole day
+//
+// plus one parameter per filter column, repeated or comma-separated, such as
+// status=active,expired.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// DefaultLimit is the page size of requests without a limit
+	DefaultLimit = 50
+	// MaxLimit is the largest page size; larger limits are reduced to it
+	MaxLimit = 200
+)
+
+// ErrInvalidCursor is returned for cursors that can't be decoded or were
+// issued for another sort
+var ErrInvalidCursor = errors.New("cursor is invalid or was issued for another sort")
+
+// Spec describes what a list of T can be sorted and filtered by
+type Spec[T any] struct {
+	// Sorts maps the columns the list can be sorted by to a row's value of
+	// the column, a string or time.Time. Columns must not be nullable.
+	Sorts map[string]func(T) any
+	// DefaultSort is the sort of requests without one, e.g. "-created_at"
+	DefaultSort string
+	// Filters maps the columns the list can be filtered by to a check of
+	// the values given; a nil check accepts any value
+	Filters map[string]func(string) error
+	// DateColumn is the time column start_date and end_date filter on;
+	// empty for lists without a date range
+	DateColumn string
+	// ID returns a row's ID, which orders rows with equal sort values
+	ID func(T) string
+}
+
+// Page is a parsed list request
+type Page[T any] struct {
+	Limit   int
+	Sort    string // column
+	Desc    bool
+	Filters map[string][]string
+	Since   *time.Time
+	Until   *time.Time
+
+	after *cursor
+	spec  *Spec[T]
+}
+
+// cursor is the position after the last row of a page
+type cursor struct {
+	Sort  string `json:"s"` // sort parameter the cursor was issued for
+	Value string `json:"v"`
+	Time  bool   `json:"t,omitempty"` // whether Value is an RFC 3339 time
+	ID    string `json:"id"`
+}
+
+// Enum returns a filter check accepting the values of an ent enum field,
+// given the field's validator
+func Enum[E ~string](validate func(E) error) func(string) error {
+	return func(value string) error {
+		return validate(E(value))
+	}
+}
+
+// Parse reads a list request from query parameters
+func (s *Spec[T]) Parse(query url.Values) (*Page[T], error) {
+	page := &Page[T]{
+		Limit:   DefaultLimit,
+		Filters: make(map[string][]string),
+		spec:    s,
+	}
+
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			return nil, errors.New("limit must be a positive integer")
+		}
+		page.Limit = min(limit, MaxLimit)
+	}
+
+	sort := query.Get("sort")
+	if sort == "" {
+		sort = s.DefaultSort
+	}
+	page.Sort = strings.TrimPrefix(sort, "-")
+	page.Desc = page.Sort != sort
+	if _, ok := s.Sorts[page.Sort]; !ok {
+		columns := make([]string, 0, len(s.Sorts))
+		for column := range s.Sorts {
+			columns = append(columns, column)
+		}
+		slices.Sort(columns)
+		return nil, fmt.Errorf("sort must be one of %s, prefixed with - for descending", strings.Join(columns, ", "))
+	}
+
+	for column, check := range s.Filters {
+		values := listParam(query, column)
+		for _, value := range values {
+			if check == nil {
+				continue
+			}
+			if err := check(value); err != nil {
+				return nil, fmt.Errorf("%s: %w", column, err)
+			}
+		}
+		if len(values) > 0 {
+			page.Filters[column] = values
+		}
+	}
+
+	if s.DateColumn != "" {
+		var err error
+		if page.Since, err = parseDate(query.Get("start_date"), false); err != nil {
+			return nil, errors.New("start_date must be a date (YYYY-MM-DD) or RFC 3339 time")
+		}
+		if page.Until, err = parseDate(query.Get("end_date"), true); err != nil {
+			return nil, errors.New("end_date must be a date (YYYY-MM-DD) or RFC 3339 time")
+		}
+	}
+
+	if value := query.Get("cursor"); value != "" {
+		after, err := decodeCursor(value)
+		if err != nil || after.Sort != sort {
+			return nil, ErrInvalidCursor
+		}
+		page.after = after
+	}
+	return page, nil
+}
+
+// Trim cuts rows fetched with a limit of Limit+1 to the page, and returns
+// the cursor of the next page, empty if this is the last
+func (p *Page[T]) Trim(rows []T) ([]T, string) {
+	if len(rows) <= p.Limit {
+		return rows, ""
+	}
+	rows = rows[:p.Limit]
+	return rows, p.Cursor(rows[len(rows)-1])
+}
+
+// Cursor returns the cursor of the rows after row, in the page's order
+func (p *Page[T]) Cursor(row T) string {
+	c := cursor{Sort: p.sortParam(), ID: p.spec.ID(row)}
+	switch value := p.spec.Sorts[p.Sort](row).(type) {
+	case time.Time:
+		c.Value = value.Format(time.RFC3339Nano)
+		c.Time = true
+	default:
+		c.Value = fmt.Sprint(value)
+	}
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// sortParam returns the sort parameter of the page
+func (p *Page[T]) sortParam() string {
+	if p.Desc {
+		return "-" + p.Sort
+	}
+	return p.Sort
+}
+
+// Filter returns a predicate selecting the rows matching the page's
+// filters and date range
+func Filter[P ~func(*sql.Selector), T any](p *Page[T]) P {
+	return func(s *sql.Selector) {
+		columns := make([]string, 0, len(p.Filters))
+		for column := range p.Filters {
+			columns = append(columns, column)
+		}
+		slices.Sort(columns)
+
+		var preds []*sql.Predicate
+		for _, column := range columns {
+			values := p.Filters[column]
+			args := make([]any, len(values))
+			for i, value := range values {
+				args[i] = value
+			}
+			preds = append(preds, sql.In(s.C(column), args...))
+		}
+		if p.Since != nil {
+			preds = append(preds, sql.GTE(s.C(p.spec.DateColumn), *p.Since))
+		}
+		if p.Until != nil {
+			preds = append(preds, sql.LTE(s.C(p.spec.DateColumn), *p.Until))
+		}
+		if len(preds) > 0 {
+			s.Where(sql.And(preds...))
+		}
+	}
+}
+
+// After returns a predicate selecting the rows after the page's cursor, in
+// the page's order
+func After[P ~func(*sql.Selector), T any](p *Page[T]) P {
+	return func(s *sql.Selector) {
+		if p.after == nil {
+			return
+		}
+		var value any = p.after.Value
+		if p.after.Time {
+			// The cursor was checked when it was parsed
+			value, _ = time.Parse(time.RFC3339Nano, p.after.Value)
+		}
+
+		beyond := sql.GT
+		if p.Desc {
+			beyond = sql.LT
+		}
+		s.Where(sql.Or(
+			beyond(s.C(p.Sort), value),
+			sql.And(
+				sql.EQ(s.C(p.Sort), value),
+				beyond(s.C("id"), p.after.ID),
+			),
+		))
+	}
+}
+
+// Order returns the page's order, by the sort column and then by ID
+func Order[O ~func(*sql.Selector), T any](p *Page[T]) O {
+	return func(s *sql.Selector) {
+		if p.Desc {
+			s.OrderBy(sql.Desc(s.C(p.Sort)), sql.Desc(s.C("id")))
+		} else {
+			s.OrderBy(sql.Asc(s.C(p.Sort)), sql.Asc(s.C("id")))
+		}
+	}
+}
+
+// decodeCursor decodes a cursor from a cursor parameter
+func decodeCursor(value string) (*cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+	if c.ID == "" {
+		return nil, ErrInvalidCursor
+	}
+	if c.Time {
+		if _, err := time.Parse(time.RFC3339Nano, c.Value); err != nil {
+			return nil, err
+		}
+	}
+	return &c, nil
+}
+
+// listParam returns a query parameter's values, splitting comma-separated
+// ones and dropping empty ones
+func listParam(query url.Values, key string) []string {
+	var values []string
+	for _, value := range query[key] {
+		for _, part := range strings.Split(value, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				values = append(values, part)
+			}
+		}
+	}
+	return values
+}
+
+// parseDate parses a date or time parameter. A date given as the end of a
+// range is the end of that day.
+func parseDate(value string, endOfDay bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil, err
+	}
+	if endOfDay {
+		t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+	return &t, nil
+}
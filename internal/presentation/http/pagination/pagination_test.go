@@ -0,0 +1,117 @@
+package pagination
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type row struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+}
+
+var spec = &Spec[row]{
+	Sorts: map[string]func(row) any{
+		"name":       func(r row) any { return r.Name },
+		"created_at": func(r row) any { return r.CreatedAt },
+	},
+	DefaultSort: "-created_at",
+	Filters: map[string]func(string) error{
+		"status": func(value string) error {
+			if value != "active" && value != "expired" {
+				return errors.New("invalid status")
+			}
+			return nil
+		},
+	},
+	DateColumn: "created_at",
+	ID:         func(r row) string { return r.ID },
+}
+
+func TestParse(t *testing.T) {
+	page, err := spec.Parse(url.Values{})
+	require.NoError(t, err)
+	assert.Equal(t, DefaultLimit, page.Limit)
+	assert.Equal(t, "created_at", page.Sort)
+	assert.True(t, page.Desc)
+	assert.Empty(t, page.Filters)
+
+	page, err = spec.Parse(url.Values{
+		"limit":      {"1000"},
+		"sort":       {"name"},
+		"status":     {"active,expired"},
+		"start_date": {"2026-01-01"},
+		"end_date":   {"2026-01-31"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, MaxLimit, page.Limit)
+	assert.False(t, page.Desc)
+	assert.Equal(t, []string{"active", "expired"}, page.Filters["status"])
+	assert.Equal(t, time.Date(2026, 1, 31, 23, 59, 59, 999999999, time.UTC), *page.Until)
+
+	for _, query := range []url.Values{
+		{"limit": {"0"}},
+		{"sort": {"-email"}},
+		{"status": {"revoked"}},
+		{"start_date": {"yesterday"}},
+		{"cursor": {"not a cursor"}},
+	} {
+		_, err := spec.Parse(query)
+		assert.Error(t, err, query.Encode())
+	}
+}
+
+func TestTrim(t *testing.T) {
+	created := time.Date(2026, 3, 1, 12, 0, 0, 123456000, time.UTC)
+	rows := []row{{ID: "a", CreatedAt: created}, {ID: "b", CreatedAt: created}, {ID: "c"}}
+
+	page, err := spec.Parse(url.Values{"limit": {"2"}})
+	require.NoError(t, err)
+	trimmed, next := page.Trim(rows)
+	assert.Len(t, trimmed, 2)
+	require.NotEmpty(t, next)
+	assert.Equal(t, next, page.Cursor(trimmed[1]))
+
+	// The cursor continues after the last row of the page, in the same order
+	page, err = spec.Parse(url.Values{"limit": {"2"}, "cursor": {next}})
+	require.NoError(t, err)
+	require.NotNil(t, page.after)
+	assert.Equal(t, "b", page.after.ID)
+	assert.True(t, page.after.Time)
+
+	_, err = spec.Parse(url.Values{"sort": {"name"}, "cursor": {next}})
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	trimmed, next = page.Trim(rows[:2])
+	assert.Len(t, trimmed, 2)
+	assert.Empty(t, next)
+}
+
+func TestPredicates(t *testing.T) {
+	first, err := spec.Parse(url.Values{"limit": {"1"}})
+	require.NoError(t, err)
+	_, next := first.Trim([]row{{ID: "a", CreatedAt: time.Now()}, {ID: "b"}})
+
+	page, err := spec.Parse(url.Values{"cursor": {next}, "status": {"active"}})
+	require.NoError(t, err)
+
+	selector := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("connections"))
+	Filter[func(*sql.Selector)](page)(selector)
+	After[func(*sql.Selector)](page)(selector)
+	Order[func(*sql.Selector)](page)(selector)
+	query, args := selector.Query()
+
+	assert.Equal(t, `SELECT * FROM "connections" WHERE "connections"."status" IN ($1) AND `+
+		`("connections"."created_at" < $2 OR ("connections"."created_at" = $3 AND "connections"."id" < $4)) `+
+		`ORDER BY "connections"."created_at" DESC, "connections"."id" DESC`, query)
+	assert.Len(t, args, 4)
+	assert.IsType(t, time.Time{}, args[1])
+}
@@ -0,0 +1,222 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"clockzen-next/internal/application/dto"
+	"clockzen-next/internal/presentation/http/middleware"
+	"clockzen-next/internal/presentation/rpc/clockzenv1"
+)
+
+// Analyzer runs analyses for the owner named by the request's user ID. The
+// HTTP API's analysis handler is one, so analyses run over gRPC are stored
+// and listed with the others.
+type Analyzer interface {
+	AnalyzeSpending(ctx context.Context, req dto.SpendingAnalysisRequest) (*dto.SpendingAnalysisResponse, error)
+	DetectAnomalies(ctx context.Context, req dto.AnomalyDetectionRequest) (*dto.AnomalyDetectionResponse, error)
+	ForecastSpending(ctx context.Context, req dto.SpendingForecastRequest) (*dto.SpendingForecastResponse, error)
+}
+
+// AnalysisServer serves AnalysisService, running analyses of the caller's
+// transactions, or the organization's the call acts in
+type AnalysisServer struct {
+	clockzenv1.UnimplementedAnalysisServiceServer
+
+	analyzer Analyzer
+}
+
+// NewAnalysisServer creates an AnalysisServer
+func NewAnalysisServer(analyzer Analyzer) *AnalysisServer {
+	return &AnalysisServer{analyzer: analyzer}
+}
+
+// AnalyzeSpending breaks spending down by category and period
+func (s *AnalysisServer) AnalyzeSpending(ctx context.Context, req *clockzenv1.AnalyzeSpendingRequest) (*clockzenv1.SpendingAnalysis, error) {
+	ownerID, err := requireOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	startDate, endDate, err := dateRange(req.GetStartDate(), req.GetEndDate())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.analyzer.AnalyzeSpending(ctx, dto.SpendingAnalysisRequest{
+		UserID:        ownerID,
+		StartDate:     startDate,
+		EndDate:       endDate,
+		Period:        dto.TimePeriod(req.GetPeriod()),
+		CardAccountID: req.GetCardAccountId(),
+	})
+	if err != nil {
+		return nil, analysisError(err)
+	}
+
+	analysis := &clockzenv1.SpendingAnalysis{
+		UserId:           resp.UserID,
+		Period:           string(resp.Period),
+		StartDate:        timestamppb.New(resp.StartDate),
+		EndDate:          timestamppb.New(resp.EndDate),
+		TotalSpending:    resp.TotalSpending,
+		AveragePerPeriod: resp.AveragePerPeriod,
+		TopCategories:    categorySpending(resp.TopCategories),
+		AnalyzedAt:       timestamppb.New(resp.AnalyzedAt),
+	}
+	for _, period := range resp.Periods {
+		analysis.Periods = append(analysis.Periods, &clockzenv1.PeriodSpending{
+			StartDate:        timestamppb.New(period.StartDate),
+			EndDate:          timestamppb.New(period.EndDate),
+			TotalAmount:      period.TotalAmount,
+			TransactionCount: int32(period.TransactionCount),
+			ByCategory:       categorySpending(period.ByCategory),
+		})
+	}
+	for _, member := range resp.Members {
+		analysis.Members = append(analysis.Members, &clockzenv1.MemberSpending{
+			MemberId:         member.MemberID,
+			Amount:           member.Amount,
+			TransactionCount: int32(member.TransactionCount),
+			Percentage:       member.Percentage,
+			ByCategory:       categorySpending(member.ByCategory),
+		})
+	}
+	return analysis, nil
+}
+
+// DetectAnomalies finds unusual transactions
+func (s *AnalysisServer) DetectAnomalies(ctx context.Context, req *clockzenv1.DetectAnomaliesRequest) (*clockzenv1.AnomalyDetection, error) {
+	ownerID, err := requireOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	startDate, endDate, err := dateRange(req.GetStartDate(), req.GetEndDate())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.analyzer.DetectAnomalies(ctx, dto.AnomalyDetectionRequest{
+		UserID:    ownerID,
+		StartDate: startDate,
+		EndDate:   endDate,
+	})
+	if err != nil {
+		return nil, analysisError(err)
+	}
+
+	detection := &clockzenv1.AnomalyDetection{
+		UserId:              resp.UserID,
+		StartDate:           timestamppb.New(resp.StartDate),
+		EndDate:             timestamppb.New(resp.EndDate),
+		HighSeverityCount:   int32(resp.HighSeverity),
+		MediumSeverityCount: int32(resp.MediumSeverity),
+		LowSeverityCount:    int32(resp.LowSeverity),
+		AnalyzedAt:          timestamppb.New(resp.AnalyzedAt),
+	}
+	for _, anomaly := range resp.Anomalies {
+		detection.Anomalies = append(detection.Anomalies, &clockzenv1.SpendingAnomaly{
+			Id:              anomaly.ID,
+			Type:            anomaly.Type,
+			Severity:        string(anomaly.Severity),
+			Category:        anomaly.Category,
+			MerchantName:    anomaly.MerchantName,
+			Amount:          anomaly.Amount,
+			ExpectedAmount:  anomaly.ExpectedAmount,
+			Deviation:       anomaly.Deviation,
+			ZScore:          anomaly.ZScore,
+			TransactionId:   anomaly.TransactionID,
+			TransactionDate: timestamppb.New(anomaly.TransactionDate),
+			Description:     anomaly.Description,
+			Confidence:      anomaly.Confidence,
+		})
+	}
+	return detection, nil
+}
+
+// ForecastSpending forecasts spending for the coming months
+func (s *AnalysisServer) ForecastSpending(ctx context.Context, req *clockzenv1.ForecastSpendingRequest) (*clockzenv1.SpendingForecast, error) {
+	ownerID, err := requireOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.analyzer.ForecastSpending(ctx, dto.SpendingForecastRequest{
+		UserID:     ownerID,
+		Months:     int(req.GetMonths()),
+		Confidence: req.GetConfidence(),
+	})
+	if err != nil {
+		return nil, analysisError(err)
+	}
+
+	forecast := &clockzenv1.SpendingForecast{
+		UserId:     resp.UserID,
+		Months:     int32(resp.Months),
+		Confidence: resp.Confidence,
+		Total:      forecastPoints(resp.Total),
+		AnalyzedAt: timestamppb.New(resp.AnalyzedAt),
+	}
+	for _, category := range resp.Categories {
+		forecast.Categories = append(forecast.Categories, &clockzenv1.CategoryForecast{
+			Category:       category.Category,
+			HistoryMonths:  int32(category.HistoryMonths),
+			AverageMonthly: category.AverageMonthly,
+			Trend:          category.Trend,
+			Seasonal:       category.Seasonal,
+			Points:         forecastPoints(category.Points),
+			Total:          category.Total,
+		})
+	}
+	return forecast, nil
+}
+
+// requireOwner returns the ID the caller's data is owned by
+func requireOwner(ctx context.Context) (string, error) {
+	ownerID, ok := middleware.OwnerIDFromContext(ctx)
+	if !ok {
+		return "", newError(codes.Unauthenticated, ReasonUnauthenticated, "authentication required")
+	}
+	return ownerID, nil
+}
+
+// dateRange returns the dates an analysis covers, which are required
+func dateRange(start, end *timestamppb.Timestamp) (time.Time, time.Time, error) {
+	if start == nil || end == nil {
+		return time.Time{}, time.Time{}, invalidRequest("start_date and end_date are required")
+	}
+	return start.AsTime(), end.AsTime(), nil
+}
+
+// categorySpending converts spending by category
+func categorySpending(categories []dto.CategorySpendingResponse) []*clockzenv1.CategorySpending {
+	converted := make([]*clockzenv1.CategorySpending, len(categories))
+	for i, category := range categories {
+		converted[i] = &clockzenv1.CategorySpending{
+			Category:           category.Category,
+			Amount:             category.Amount,
+			TransactionCount:   int32(category.TransactionCount),
+			Percentage:         category.Percentage,
+			AverageTransaction: category.AverageTransaction,
+		}
+	}
+	return converted
+}
+
+// forecastPoints converts the points of a forecast
+func forecastPoints(points []dto.ForecastPointResponse) []*clockzenv1.ForecastPoint {
+	converted := make([]*clockzenv1.ForecastPoint, len(points))
+	for i, point := range points {
+		converted[i] = &clockzenv1.ForecastPoint{
+			PeriodStart: timestamppb.New(point.PeriodStart),
+			Amount:      point.Amount,
+			Lower:       point.Lower,
+			Upper:       point.Upper,
+		}
+	}
+	return converted
+}
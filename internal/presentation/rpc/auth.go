@@ -0,0 +1,125 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"clockzen-next/internal/application/organizations"
+	"clockzen-next/internal/presentation/http/middleware"
+	"clockzen-next/internal/presentation/rpc/clockzenv1"
+)
+
+// OrganizationMetadata names the organization a call acts in, like the HTTP
+// API's X-Organization-ID header. Without it calls act on the user's own
+// data.
+const OrganizationMetadata = "x-organization-id"
+
+// publicServices are the services callable without a token
+var publicServices = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.",
+}
+
+// writeMethods are the methods that change an organization's data, which
+// its viewers can't call. Analyses only compute results, so viewers may run
+// them like over HTTP.
+var writeMethods = map[string]bool{
+	clockzenv1.SyncService_StartEmailSync_FullMethodName: true,
+	clockzenv1.SyncService_StartDriveSync_FullMethodName: true,
+	clockzenv1.SyncService_CancelSync_FullMethodName:     true,
+}
+
+// authenticator authenticates calls and scopes them to organizations, the
+// checks the HTTP API's RequireAuth and ScopeOrganization middleware make
+type authenticator struct {
+	config  middleware.AuthConfig
+	members middleware.OrganizationMembers
+}
+
+// unary authenticates unary calls
+func (a *authenticator) unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := a.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// stream authenticates streaming calls
+func (a *authenticator) stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &scopedStream{ServerStream: ss, ctx: ctx})
+}
+
+// authenticate verifies the bearer JWT in the call's authorization metadata
+// and returns ctx with the authenticated user and the organization the call
+// acts in
+func (a *authenticator) authenticate(ctx context.Context, method string) (context.Context, error) {
+	for _, prefix := range publicServices {
+		if strings.HasPrefix(method, prefix) {
+			return ctx, nil
+		}
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	token, ok := strings.CutPrefix(firstValue(md, "authorization"), "Bearer ")
+	if !ok || token == "" {
+		return nil, newError(codes.Unauthenticated, ReasonUnauthenticated, "authentication required")
+	}
+	user, err := middleware.Authenticate(token, a.config)
+	if err != nil {
+		if errors.Is(err, middleware.ErrTokenExpired) {
+			return nil, newError(codes.Unauthenticated, ReasonTokenExpired, "token has expired")
+		}
+		return nil, newError(codes.Unauthenticated, ReasonUnauthenticated, "invalid or malformed token")
+	}
+	ctx = middleware.WithUser(ctx, user)
+
+	organizationID := strings.TrimSpace(firstValue(md, OrganizationMetadata))
+	if organizationID == "" {
+		return ctx, nil
+	}
+	if a.members == nil {
+		return nil, newError(codes.NotFound, ReasonOrganizationNotFound, "organization not found")
+	}
+	role, err := a.members.MemberRole(ctx, organizationID, user.ID)
+	if err != nil {
+		if errors.Is(err, organizations.ErrNotFound) {
+			return nil, newError(codes.NotFound, ReasonOrganizationNotFound, "organization not found")
+		}
+		return nil, internalError("failed to check organization membership", err)
+	}
+	if !role.CanWrite() && writeMethods[method] {
+		return nil, newError(codes.PermissionDenied, ReasonPermissionDenied, "viewers can't change the organization's data")
+	}
+
+	return middleware.WithOrganization(ctx, &middleware.Organization{ID: organizationID, Role: role}), nil
+}
+
+// firstValue returns the first value of a metadata key, or ""
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// scopedStream is a server stream whose context carries the authenticated
+// user and organization
+type scopedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the stream's scoped context
+func (s *scopedStream) Context() context.Context {
+	return s.ctx
+}
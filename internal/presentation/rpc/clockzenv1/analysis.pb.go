@@ -0,0 +1,1244 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.3
+// source: clockzen/v1/analysis.proto
+
+package clockzenv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AnalyzeSpendingRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// period is daily, weekly, monthly, quarterly, yearly or statement;
+	// monthly if empty
+	Period string `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	// card_account_id is the card whose statement periods are analyzed;
+	// required for the statement period
+	CardAccountId string `protobuf:"bytes,4,opt,name=card_account_id,json=cardAccountId,proto3" json:"card_account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeSpendingRequest) Reset() {
+	*x = AnalyzeSpendingRequest{}
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeSpendingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeSpendingRequest) ProtoMessage() {}
+
+func (x *AnalyzeSpendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeSpendingRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeSpendingRequest) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_analysis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AnalyzeSpendingRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *AnalyzeSpendingRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *AnalyzeSpendingRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *AnalyzeSpendingRequest) GetCardAccountId() string {
+	if x != nil {
+		return x.CardAccountId
+	}
+	return ""
+}
+
+type CategorySpending struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Category           string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Amount             float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	TransactionCount   int32                  `protobuf:"varint,3,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	Percentage         float64                `protobuf:"fixed64,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	AverageTransaction float64                `protobuf:"fixed64,5,opt,name=average_transaction,json=averageTransaction,proto3" json:"average_transaction,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CategorySpending) Reset() {
+	*x = CategorySpending{}
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategorySpending) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategorySpending) ProtoMessage() {}
+
+func (x *CategorySpending) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategorySpending.ProtoReflect.Descriptor instead.
+func (*CategorySpending) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_analysis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CategorySpending) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CategorySpending) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CategorySpending) GetTransactionCount() int32 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *CategorySpending) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *CategorySpending) GetAverageTransaction() float64 {
+	if x != nil {
+		return x.AverageTransaction
+	}
+	return 0
+}
+
+type PeriodSpending struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StartDate        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	TotalAmount      float64                `protobuf:"fixed64,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	TransactionCount int32                  `protobuf:"varint,4,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	ByCategory       []*CategorySpending    `protobuf:"bytes,5,rep,name=by_category,json=byCategory,proto3" json:"by_category,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PeriodSpending) Reset() {
+	*x = PeriodSpending{}
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodSpending) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodSpending) ProtoMessage() {}
+
+func (x *PeriodSpending) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodSpending.ProtoReflect.Descriptor instead.
+func (*PeriodSpending) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_analysis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PeriodSpending) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *PeriodSpending) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *PeriodSpending) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *PeriodSpending) GetTransactionCount() int32 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *PeriodSpending) GetByCategory() []*CategorySpending {
+	if x != nil {
+		return x.ByCategory
+	}
+	return nil
+}
+
+// MemberSpending is a household member's share of spending; member_id is
+// empty for shared spending
+type MemberSpending struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MemberId         string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Amount           float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	TransactionCount int32                  `protobuf:"varint,3,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	Percentage       float64                `protobuf:"fixed64,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	ByCategory       []*CategorySpending    `protobuf:"bytes,5,rep,name=by_category,json=byCategory,proto3" json:"by_category,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MemberSpending) Reset() {
+	*x = MemberSpending{}
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberSpending) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberSpending) ProtoMessage() {}
+
+func (x *MemberSpending) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberSpending.ProtoReflect.Descriptor instead.
+func (*MemberSpending) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_analysis_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MemberSpending) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *MemberSpending) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *MemberSpending) GetTransactionCount() int32 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *MemberSpending) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *MemberSpending) GetByCategory() []*CategorySpending {
+	if x != nil {
+		return x.ByCategory
+	}
+	return nil
+}
+
+type SpendingAnalysis struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Period           string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	StartDate        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Periods          []*PeriodSpending      `protobuf:"bytes,5,rep,name=periods,proto3" json:"periods,omitempty"`
+	TotalSpending    float64                `protobuf:"fixed64,6,opt,name=total_spending,json=totalSpending,proto3" json:"total_spending,omitempty"`
+	AveragePerPeriod float64                `protobuf:"fixed64,7,opt,name=average_per_period,json=averagePerPeriod,proto3" json:"average_per_period,omitempty"`
+	TopCategories    []*CategorySpending    `protobuf:"bytes,8,rep,name=top_categories,json=topCategories,proto3" json:"top_categories,omitempty"`
+	Members          []*MemberSpending      `protobuf:"bytes,9,rep,name=members,proto3" json:"members,omitempty"`
+	AnalyzedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=analyzed_at,json=analyzedAt,proto3" json:"analyzed_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SpendingAnalysis) Reset() {
+	*x = SpendingAnalysis{}
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpendingAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendingAnalysis) ProtoMessage() {}
+
+func (x *SpendingAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendingAnalysis.ProtoReflect.Descriptor instead.
+func (*SpendingAnalysis) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_analysis_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SpendingAnalysis) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SpendingAnalysis) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *SpendingAnalysis) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *SpendingAnalysis) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *SpendingAnalysis) GetPeriods() []*PeriodSpending {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *SpendingAnalysis) GetTotalSpending() float64 {
+	if x != nil {
+		return x.TotalSpending
+	}
+	return 0
+}
+
+func (x *SpendingAnalysis) GetAveragePerPeriod() float64 {
+	if x != nil {
+		return x.AveragePerPeriod
+	}
+	return 0
+}
+
+func (x *SpendingAnalysis) GetTopCategories() []*CategorySpending {
+	if x != nil {
+		return x.TopCategories
+	}
+	return nil
+}
+
+func (x *SpendingAnalysis) GetMembers() []*MemberSpending {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *SpendingAnalysis) GetAnalyzedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AnalyzedAt
+	}
+	return nil
+}
+
+type DetectAnomaliesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectAnomaliesRequest) Reset() {
+	*x = DetectAnomaliesRequest{}
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectAnomaliesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectAnomaliesRequest) ProtoMessage() {}
+
+func (x *DetectAnomaliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectAnomaliesRequest.ProtoReflect.Descriptor instead.
+func (*DetectAnomaliesRequest) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_analysis_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DetectAnomaliesRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *DetectAnomaliesRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type SpendingAnomaly struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type  string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// severity is low, medium or high
+	Severity        string                 `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	Category        string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	MerchantName    string                 `protobuf:"bytes,5,opt,name=merchant_name,json=merchantName,proto3" json:"merchant_name,omitempty"`
+	Amount          float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	ExpectedAmount  float64                `protobuf:"fixed64,7,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"`
+	Deviation       float64                `protobuf:"fixed64,8,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	ZScore          float64                `protobuf:"fixed64,9,opt,name=z_score,json=zScore,proto3" json:"z_score,omitempty"`
+	TransactionId   string                 `protobuf:"bytes,10,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	TransactionDate *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
+	Description     string                 `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
+	Confidence      float64                `protobuf:"fixed64,13,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SpendingAnomaly) Reset() {
+	*x = SpendingAnomaly{}
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpendingAnomaly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendingAnomaly) ProtoMessage() {}
+
+func (x *SpendingAnomaly) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendingAnomaly.ProtoReflect.Descriptor instead.
+func (*SpendingAnomaly) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_analysis_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SpendingAnomaly) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SpendingAnomaly) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SpendingAnomaly) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *SpendingAnomaly) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SpendingAnomaly) GetMerchantName() string {
+	if x != nil {
+		return x.MerchantName
+	}
+	return ""
+}
+
+func (x *SpendingAnomaly) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SpendingAnomaly) GetExpectedAmount() float64 {
+	if x != nil {
+		return x.ExpectedAmount
+	}
+	return 0
+}
+
+func (x *SpendingAnomaly) GetDeviation() float64 {
+	if x != nil {
+		return x.Deviation
+	}
+	return 0
+}
+
+func (x *SpendingAnomaly) GetZScore() float64 {
+	if x != nil {
+		return x.ZScore
+	}
+	return 0
+}
+
+func (x *SpendingAnomaly) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *SpendingAnomaly) GetTransactionDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TransactionDate
+	}
+	return nil
+}
+
+func (x *SpendingAnomaly) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SpendingAnomaly) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+type AnomalyDetection struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate             *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Anomalies           []*SpendingAnomaly     `protobuf:"bytes,4,rep,name=anomalies,proto3" json:"anomalies,omitempty"`
+	HighSeverityCount   int32                  `protobuf:"varint,5,opt,name=high_severity_count,json=highSeverityCount,proto3" json:"high_severity_count,omitempty"`
+	MediumSeverityCount int32                  `protobuf:"varint,6,opt,name=medium_severity_count,json=mediumSeverityCount,proto3" json:"medium_severity_count,omitempty"`
+	LowSeverityCount    int32                  `protobuf:"varint,7,opt,name=low_severity_count,json=lowSeverityCount,proto3" json:"low_severity_count,omitempty"`
+	AnalyzedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=analyzed_at,json=analyzedAt,proto3" json:"analyzed_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AnomalyDetection) Reset() {
+	*x = AnomalyDetection{}
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnomalyDetection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyDetection) ProtoMessage() {}
+
+func (x *AnomalyDetection) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyDetection.ProtoReflect.Descriptor instead.
+func (*AnomalyDetection) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_analysis_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AnomalyDetection) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AnomalyDetection) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *AnomalyDetection) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *AnomalyDetection) GetAnomalies() []*SpendingAnomaly {
+	if x != nil {
+		return x.Anomalies
+	}
+	return nil
+}
+
+func (x *AnomalyDetection) GetHighSeverityCount() int32 {
+	if x != nil {
+		return x.HighSeverityCount
+	}
+	return 0
+}
+
+func (x *AnomalyDetection) GetMediumSeverityCount() int32 {
+	if x != nil {
+		return x.MediumSeverityCount
+	}
+	return 0
+}
+
+func (x *AnomalyDetection) GetLowSeverityCount() int32 {
+	if x != nil {
+		return x.LowSeverityCount
+	}
+	return 0
+}
+
+func (x *AnomalyDetection) GetAnalyzedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AnalyzedAt
+	}
+	return nil
+}
+
+type ForecastSpendingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// months is how many months to forecast, from 3 to 12; 6 if unset
+	Months int32 `protobuf:"varint,1,opt,name=months,proto3" json:"months,omitempty"`
+	// confidence is the confidence level of the bands, between 0 and 1; 0.8
+	// if unset
+	Confidence    float64 `protobuf:"fixed64,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForecastSpendingRequest) Reset() {
+	*x = ForecastSpendingRequest{}
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForecastSpendingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastSpendingRequest) ProtoMessage() {}
+
+func (x *ForecastSpendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastSpendingRequest.ProtoReflect.Descriptor instead.
+func (*ForecastSpendingRequest) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_analysis_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ForecastSpendingRequest) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+func (x *ForecastSpendingRequest) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+// ForecastPoint is the spending forecast for a month, with the bounds of
+// its confidence band
+type ForecastPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Lower         float64                `protobuf:"fixed64,3,opt,name=lower,proto3" json:"lower,omitempty"`
+	Upper         float64                `protobuf:"fixed64,4,opt,name=upper,proto3" json:"upper,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForecastPoint) Reset() {
+	*x = ForecastPoint{}
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForecastPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastPoint) ProtoMessage() {}
+
+func (x *ForecastPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastPoint.ProtoReflect.Descriptor instead.
+func (*ForecastPoint) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_analysis_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ForecastPoint) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *ForecastPoint) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ForecastPoint) GetLower() float64 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *ForecastPoint) GetUpper() float64 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+type CategoryForecast struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Category       string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	HistoryMonths  int32                  `protobuf:"varint,2,opt,name=history_months,json=historyMonths,proto3" json:"history_months,omitempty"`
+	AverageMonthly float64                `protobuf:"fixed64,3,opt,name=average_monthly,json=averageMonthly,proto3" json:"average_monthly,omitempty"`
+	Trend          string                 `protobuf:"bytes,4,opt,name=trend,proto3" json:"trend,omitempty"`
+	// seasonal is set when the forecast follows the category's seasons
+	Seasonal      bool             `protobuf:"varint,5,opt,name=seasonal,proto3" json:"seasonal,omitempty"`
+	Points        []*ForecastPoint `protobuf:"bytes,6,rep,name=points,proto3" json:"points,omitempty"`
+	Total         float64          `protobuf:"fixed64,7,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryForecast) Reset() {
+	*x = CategoryForecast{}
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryForecast) ProtoMessage() {}
+
+func (x *CategoryForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryForecast.ProtoReflect.Descriptor instead.
+func (*CategoryForecast) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_analysis_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CategoryForecast) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CategoryForecast) GetHistoryMonths() int32 {
+	if x != nil {
+		return x.HistoryMonths
+	}
+	return 0
+}
+
+func (x *CategoryForecast) GetAverageMonthly() float64 {
+	if x != nil {
+		return x.AverageMonthly
+	}
+	return 0
+}
+
+func (x *CategoryForecast) GetTrend() string {
+	if x != nil {
+		return x.Trend
+	}
+	return ""
+}
+
+func (x *CategoryForecast) GetSeasonal() bool {
+	if x != nil {
+		return x.Seasonal
+	}
+	return false
+}
+
+func (x *CategoryForecast) GetPoints() []*ForecastPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *CategoryForecast) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type SpendingForecast struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Months     int32                  `protobuf:"varint,2,opt,name=months,proto3" json:"months,omitempty"`
+	Confidence float64                `protobuf:"fixed64,3,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	// categories are the largest first
+	Categories    []*CategoryForecast    `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
+	Total         []*ForecastPoint       `protobuf:"bytes,5,rep,name=total,proto3" json:"total,omitempty"`
+	AnalyzedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=analyzed_at,json=analyzedAt,proto3" json:"analyzed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpendingForecast) Reset() {
+	*x = SpendingForecast{}
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpendingForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendingForecast) ProtoMessage() {}
+
+func (x *SpendingForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_analysis_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendingForecast.ProtoReflect.Descriptor instead.
+func (*SpendingForecast) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_analysis_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SpendingForecast) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SpendingForecast) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+func (x *SpendingForecast) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *SpendingForecast) GetCategories() []*CategoryForecast {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *SpendingForecast) GetTotal() []*ForecastPoint {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *SpendingForecast) GetAnalyzedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AnalyzedAt
+	}
+	return nil
+}
+
+var File_clockzen_v1_analysis_proto protoreflect.FileDescriptor
+
+const file_clockzen_v1_analysis_proto_rawDesc = "" +
+	"\n" +
+	"\x1aclockzen/v1/analysis.proto\x12\vclockzen.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xca\x01\n" +
+	"\x16AnalyzeSpendingRequest\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x16\n" +
+	"\x06period\x18\x03 \x01(\tR\x06period\x12&\n" +
+	"\x0fcard_account_id\x18\x04 \x01(\tR\rcardAccountId\"\xc4\x01\n" +
+	"\x10CategorySpending\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12+\n" +
+	"\x11transaction_count\x18\x03 \x01(\x05R\x10transactionCount\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\x04 \x01(\x01R\n" +
+	"percentage\x12/\n" +
+	"\x13average_transaction\x18\x05 \x01(\x01R\x12averageTransaction\"\x92\x02\n" +
+	"\x0ePeriodSpending\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12!\n" +
+	"\ftotal_amount\x18\x03 \x01(\x01R\vtotalAmount\x12+\n" +
+	"\x11transaction_count\x18\x04 \x01(\x05R\x10transactionCount\x12>\n" +
+	"\vby_category\x18\x05 \x03(\v2\x1d.clockzen.v1.CategorySpendingR\n" +
+	"byCategory\"\xd2\x01\n" +
+	"\x0eMemberSpending\x12\x1b\n" +
+	"\tmember_id\x18\x01 \x01(\tR\bmemberId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12+\n" +
+	"\x11transaction_count\x18\x03 \x01(\x05R\x10transactionCount\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\x04 \x01(\x01R\n" +
+	"percentage\x12>\n" +
+	"\vby_category\x18\x05 \x03(\v2\x1d.clockzen.v1.CategorySpendingR\n" +
+	"byCategory\"\xfb\x03\n" +
+	"\x10SpendingAnalysis\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x125\n" +
+	"\aperiods\x18\x05 \x03(\v2\x1b.clockzen.v1.PeriodSpendingR\aperiods\x12%\n" +
+	"\x0etotal_spending\x18\x06 \x01(\x01R\rtotalSpending\x12,\n" +
+	"\x12average_per_period\x18\a \x01(\x01R\x10averagePerPeriod\x12D\n" +
+	"\x0etop_categories\x18\b \x03(\v2\x1d.clockzen.v1.CategorySpendingR\rtopCategories\x125\n" +
+	"\amembers\x18\t \x03(\v2\x1b.clockzen.v1.MemberSpendingR\amembers\x12;\n" +
+	"\vanalyzed_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"analyzedAt\"\x8a\x01\n" +
+	"\x16DetectAnomaliesRequest\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"\xba\x03\n" +
+	"\x0fSpendingAnomaly\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
+	"\bseverity\x18\x03 \x01(\tR\bseverity\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12#\n" +
+	"\rmerchant_name\x18\x05 \x01(\tR\fmerchantName\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12'\n" +
+	"\x0fexpected_amount\x18\a \x01(\x01R\x0eexpectedAmount\x12\x1c\n" +
+	"\tdeviation\x18\b \x01(\x01R\tdeviation\x12\x17\n" +
+	"\az_score\x18\t \x01(\x01R\x06zScore\x12%\n" +
+	"\x0etransaction_id\x18\n" +
+	" \x01(\tR\rtransactionId\x12E\n" +
+	"\x10transaction_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0ftransactionDate\x12 \n" +
+	"\vdescription\x18\f \x01(\tR\vdescription\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\r \x01(\x01R\n" +
+	"confidence\"\xa8\x03\n" +
+	"\x10AnomalyDetection\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12:\n" +
+	"\tanomalies\x18\x04 \x03(\v2\x1c.clockzen.v1.SpendingAnomalyR\tanomalies\x12.\n" +
+	"\x13high_severity_count\x18\x05 \x01(\x05R\x11highSeverityCount\x122\n" +
+	"\x15medium_severity_count\x18\x06 \x01(\x05R\x13mediumSeverityCount\x12,\n" +
+	"\x12low_severity_count\x18\a \x01(\x05R\x10lowSeverityCount\x12;\n" +
+	"\vanalyzed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"analyzedAt\"Q\n" +
+	"\x17ForecastSpendingRequest\x12\x16\n" +
+	"\x06months\x18\x01 \x01(\x05R\x06months\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x02 \x01(\x01R\n" +
+	"confidence\"\x92\x01\n" +
+	"\rForecastPoint\x12=\n" +
+	"\fperiod_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05lower\x18\x03 \x01(\x01R\x05lower\x12\x14\n" +
+	"\x05upper\x18\x04 \x01(\x01R\x05upper\"\xfa\x01\n" +
+	"\x10CategoryForecast\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12%\n" +
+	"\x0ehistory_months\x18\x02 \x01(\x05R\rhistoryMonths\x12'\n" +
+	"\x0faverage_monthly\x18\x03 \x01(\x01R\x0eaverageMonthly\x12\x14\n" +
+	"\x05trend\x18\x04 \x01(\tR\x05trend\x12\x1a\n" +
+	"\bseasonal\x18\x05 \x01(\bR\bseasonal\x122\n" +
+	"\x06points\x18\x06 \x03(\v2\x1a.clockzen.v1.ForecastPointR\x06points\x12\x14\n" +
+	"\x05total\x18\a \x01(\x01R\x05total\"\x91\x02\n" +
+	"\x10SpendingForecast\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06months\x18\x02 \x01(\x05R\x06months\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x03 \x01(\x01R\n" +
+	"confidence\x12=\n" +
+	"\n" +
+	"categories\x18\x04 \x03(\v2\x1d.clockzen.v1.CategoryForecastR\n" +
+	"categories\x120\n" +
+	"\x05total\x18\x05 \x03(\v2\x1a.clockzen.v1.ForecastPointR\x05total\x12;\n" +
+	"\vanalyzed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"analyzedAt2\x98\x02\n" +
+	"\x0fAnalysisService\x12U\n" +
+	"\x0fAnalyzeSpending\x12#.clockzen.v1.AnalyzeSpendingRequest\x1a\x1d.clockzen.v1.SpendingAnalysis\x12U\n" +
+	"\x0fDetectAnomalies\x12#.clockzen.v1.DetectAnomaliesRequest\x1a\x1d.clockzen.v1.AnomalyDetection\x12W\n" +
+	"\x10ForecastSpending\x12$.clockzen.v1.ForecastSpendingRequest\x1a\x1d.clockzen.v1.SpendingForecastB?Z=clockzen-next/internal/presentation/rpc/clockzenv1;clockzenv1b\x06proto3"
+
+var (
+	file_clockzen_v1_analysis_proto_rawDescOnce sync.Once
+	file_clockzen_v1_analysis_proto_rawDescData []byte
+)
+
+func file_clockzen_v1_analysis_proto_rawDescGZIP() []byte {
+	file_clockzen_v1_analysis_proto_rawDescOnce.Do(func() {
+		file_clockzen_v1_analysis_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_clockzen_v1_analysis_proto_rawDesc), len(file_clockzen_v1_analysis_proto_rawDesc)))
+	})
+	return file_clockzen_v1_analysis_proto_rawDescData
+}
+
+var file_clockzen_v1_analysis_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_clockzen_v1_analysis_proto_goTypes = []any{
+	(*AnalyzeSpendingRequest)(nil),  // 0: clockzen.v1.AnalyzeSpendingRequest
+	(*CategorySpending)(nil),        // 1: clockzen.v1.CategorySpending
+	(*PeriodSpending)(nil),          // 2: clockzen.v1.PeriodSpending
+	(*MemberSpending)(nil),          // 3: clockzen.v1.MemberSpending
+	(*SpendingAnalysis)(nil),        // 4: clockzen.v1.SpendingAnalysis
+	(*DetectAnomaliesRequest)(nil),  // 5: clockzen.v1.DetectAnomaliesRequest
+	(*SpendingAnomaly)(nil),         // 6: clockzen.v1.SpendingAnomaly
+	(*AnomalyDetection)(nil),        // 7: clockzen.v1.AnomalyDetection
+	(*ForecastSpendingRequest)(nil), // 8: clockzen.v1.ForecastSpendingRequest
+	(*ForecastPoint)(nil),           // 9: clockzen.v1.ForecastPoint
+	(*CategoryForecast)(nil),        // 10: clockzen.v1.CategoryForecast
+	(*SpendingForecast)(nil),        // 11: clockzen.v1.SpendingForecast
+	(*timestamppb.Timestamp)(nil),   // 12: google.protobuf.Timestamp
+}
+var file_clockzen_v1_analysis_proto_depIdxs = []int32{
+	12, // 0: clockzen.v1.AnalyzeSpendingRequest.start_date:type_name -> google.protobuf.Timestamp
+	12, // 1: clockzen.v1.AnalyzeSpendingRequest.end_date:type_name -> google.protobuf.Timestamp
+	12, // 2: clockzen.v1.PeriodSpending.start_date:type_name -> google.protobuf.Timestamp
+	12, // 3: clockzen.v1.PeriodSpending.end_date:type_name -> google.protobuf.Timestamp
+	1,  // 4: clockzen.v1.PeriodSpending.by_category:type_name -> clockzen.v1.CategorySpending
+	1,  // 5: clockzen.v1.MemberSpending.by_category:type_name -> clockzen.v1.CategorySpending
+	12, // 6: clockzen.v1.SpendingAnalysis.start_date:type_name -> google.protobuf.Timestamp
+	12, // 7: clockzen.v1.SpendingAnalysis.end_date:type_name -> google.protobuf.Timestamp
+	2,  // 8: clockzen.v1.SpendingAnalysis.periods:type_name -> clockzen.v1.PeriodSpending
+	1,  // 9: clockzen.v1.SpendingAnalysis.top_categories:type_name -> clockzen.v1.CategorySpending
+	3,  // 10: clockzen.v1.SpendingAnalysis.members:type_name -> clockzen.v1.MemberSpending
+	12, // 11: clockzen.v1.SpendingAnalysis.analyzed_at:type_name -> google.protobuf.Timestamp
+	12, // 12: clockzen.v1.DetectAnomaliesRequest.start_date:type_name -> google.protobuf.Timestamp
+	12, // 13: clockzen.v1.DetectAnomaliesRequest.end_date:type_name -> google.protobuf.Timestamp
+	12, // 14: clockzen.v1.SpendingAnomaly.transaction_date:type_name -> google.protobuf.Timestamp
+	12, // 15: clockzen.v1.AnomalyDetection.start_date:type_name -> google.protobuf.Timestamp
+	12, // 16: clockzen.v1.AnomalyDetection.end_date:type_name -> google.protobuf.Timestamp
+	6,  // 17: clockzen.v1.AnomalyDetection.anomalies:type_name -> clockzen.v1.SpendingAnomaly
+	12, // 18: clockzen.v1.AnomalyDetection.analyzed_at:type_name -> google.protobuf.Timestamp
+	12, // 19: clockzen.v1.ForecastPoint.period_start:type_name -> google.protobuf.Timestamp
+	9,  // 20: clockzen.v1.CategoryForecast.points:type_name -> clockzen.v1.ForecastPoint
+	10, // 21: clockzen.v1.SpendingForecast.categories:type_name -> clockzen.v1.CategoryForecast
+	9,  // 22: clockzen.v1.SpendingForecast.total:type_name -> clockzen.v1.ForecastPoint
+	12, // 23: clockzen.v1.SpendingForecast.analyzed_at:type_name -> google.protobuf.Timestamp
+	0,  // 24: clockzen.v1.AnalysisService.AnalyzeSpending:input_type -> clockzen.v1.AnalyzeSpendingRequest
+	5,  // 25: clockzen.v1.AnalysisService.DetectAnomalies:input_type -> clockzen.v1.DetectAnomaliesRequest
+	8,  // 26: clockzen.v1.AnalysisService.ForecastSpending:input_type -> clockzen.v1.ForecastSpendingRequest
+	4,  // 27: clockzen.v1.AnalysisService.AnalyzeSpending:output_type -> clockzen.v1.SpendingAnalysis
+	7,  // 28: clockzen.v1.AnalysisService.DetectAnomalies:output_type -> clockzen.v1.AnomalyDetection
+	11, // 29: clockzen.v1.AnalysisService.ForecastSpending:output_type -> clockzen.v1.SpendingForecast
+	27, // [27:30] is the sub-list for method output_type
+	24, // [24:27] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
+}
+
+func init() { file_clockzen_v1_analysis_proto_init() }
+func file_clockzen_v1_analysis_proto_init() {
+	if File_clockzen_v1_analysis_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_clockzen_v1_analysis_proto_rawDesc), len(file_clockzen_v1_analysis_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_clockzen_v1_analysis_proto_goTypes,
+		DependencyIndexes: file_clockzen_v1_analysis_proto_depIdxs,
+		MessageInfos:      file_clockzen_v1_analysis_proto_msgTypes,
+	}.Build()
+	File_clockzen_v1_analysis_proto = out.File
+	file_clockzen_v1_analysis_proto_goTypes = nil
+	file_clockzen_v1_analysis_proto_depIdxs = nil
+}
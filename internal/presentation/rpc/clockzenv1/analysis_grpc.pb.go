@@ -0,0 +1,219 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: clockzen/v1/analysis.proto
+
+package clockzenv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AnalysisService_AnalyzeSpending_FullMethodName  = "/clockzen.v1.AnalysisService/AnalyzeSpending"
+	AnalysisService_DetectAnomalies_FullMethodName  = "/clockzen.v1.AnalysisService/DetectAnomalies"
+	AnalysisService_ForecastSpending_FullMethodName = "/clockzen.v1.AnalysisService/ForecastSpending"
+)
+
+// AnalysisServiceClient is the client API for AnalysisService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AnalysisService runs spending analyses on the caller's transactions, or
+// an organization's when calls carry x-organization-id metadata. Results
+// are stored like those of the HTTP API and listed by GET /api/analysis.
+//
+// Failed calls carry a google.rpc.ErrorInfo detail in the "clockzen"
+// domain whose reason names the error, such as INVALID_REQUEST or
+// CARD_ACCOUNT_NOT_FOUND.
+type AnalysisServiceClient interface {
+	// AnalyzeSpending breaks spending down by category and period
+	AnalyzeSpending(ctx context.Context, in *AnalyzeSpendingRequest, opts ...grpc.CallOption) (*SpendingAnalysis, error)
+	// DetectAnomalies finds unusual transactions
+	DetectAnomalies(ctx context.Context, in *DetectAnomaliesRequest, opts ...grpc.CallOption) (*AnomalyDetection, error)
+	// ForecastSpending forecasts spending for the coming months
+	ForecastSpending(ctx context.Context, in *ForecastSpendingRequest, opts ...grpc.CallOption) (*SpendingForecast, error)
+}
+
+type analysisServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAnalysisServiceClient(cc grpc.ClientConnInterface) AnalysisServiceClient {
+	return &analysisServiceClient{cc}
+}
+
+func (c *analysisServiceClient) AnalyzeSpending(ctx context.Context, in *AnalyzeSpendingRequest, opts ...grpc.CallOption) (*SpendingAnalysis, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpendingAnalysis)
+	err := c.cc.Invoke(ctx, AnalysisService_AnalyzeSpending_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) DetectAnomalies(ctx context.Context, in *DetectAnomaliesRequest, opts ...grpc.CallOption) (*AnomalyDetection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnomalyDetection)
+	err := c.cc.Invoke(ctx, AnalysisService_DetectAnomalies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) ForecastSpending(ctx context.Context, in *ForecastSpendingRequest, opts ...grpc.CallOption) (*SpendingForecast, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpendingForecast)
+	err := c.cc.Invoke(ctx, AnalysisService_ForecastSpending_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AnalysisServiceServer is the server API for AnalysisService service.
+// All implementations must embed UnimplementedAnalysisServiceServer
+// for forward compatibility.
+//
+// AnalysisService runs spending analyses on the caller's transactions, or
+// an organization's when calls carry x-organization-id metadata. Results
+// are stored like those of the HTTP API and listed by GET /api/analysis.
+//
+// Failed calls carry a google.rpc.ErrorInfo detail in the "clockzen"
+// domain whose reason names the error, such as INVALID_REQUEST or
+// CARD_ACCOUNT_NOT_FOUND.
+type AnalysisServiceServer interface {
+	// AnalyzeSpending breaks spending down by category and period
+	AnalyzeSpending(context.Context, *AnalyzeSpendingRequest) (*SpendingAnalysis, error)
+	// DetectAnomalies finds unusual transactions
+	DetectAnomalies(context.Context, *DetectAnomaliesRequest) (*AnomalyDetection, error)
+	// ForecastSpending forecasts spending for the coming months
+	ForecastSpending(context.Context, *ForecastSpendingRequest) (*SpendingForecast, error)
+	mustEmbedUnimplementedAnalysisServiceServer()
+}
+
+// UnimplementedAnalysisServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAnalysisServiceServer struct{}
+
+func (UnimplementedAnalysisServiceServer) AnalyzeSpending(context.Context, *AnalyzeSpendingRequest) (*SpendingAnalysis, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeSpending not implemented")
+}
+func (UnimplementedAnalysisServiceServer) DetectAnomalies(context.Context, *DetectAnomaliesRequest) (*AnomalyDetection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetectAnomalies not implemented")
+}
+func (UnimplementedAnalysisServiceServer) ForecastSpending(context.Context, *ForecastSpendingRequest) (*SpendingForecast, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForecastSpending not implemented")
+}
+func (UnimplementedAnalysisServiceServer) mustEmbedUnimplementedAnalysisServiceServer() {}
+func (UnimplementedAnalysisServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeAnalysisServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AnalysisServiceServer will
+// result in compilation errors.
+type UnsafeAnalysisServiceServer interface {
+	mustEmbedUnimplementedAnalysisServiceServer()
+}
+
+func RegisterAnalysisServiceServer(s grpc.ServiceRegistrar, srv AnalysisServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAnalysisServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AnalysisService_ServiceDesc, srv)
+}
+
+func _AnalysisService_AnalyzeSpending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeSpendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).AnalyzeSpending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_AnalyzeSpending_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).AnalyzeSpending(ctx, req.(*AnalyzeSpendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_DetectAnomalies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectAnomaliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).DetectAnomalies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_DetectAnomalies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).DetectAnomalies(ctx, req.(*DetectAnomaliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_ForecastSpending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForecastSpendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).ForecastSpending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_ForecastSpending_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).ForecastSpending(ctx, req.(*ForecastSpendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AnalysisService_ServiceDesc is the grpc.ServiceDesc for AnalysisService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AnalysisService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "clockzen.v1.AnalysisService",
+	HandlerType: (*AnalysisServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AnalyzeSpending",
+			Handler:    _AnalysisService_AnalyzeSpending_Handler,
+		},
+		{
+			MethodName: "DetectAnomalies",
+			Handler:    _AnalysisService_DetectAnomalies_Handler,
+		},
+		{
+			MethodName: "ForecastSpending",
+			Handler:    _AnalysisService_ForecastSpending_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "clockzen/v1/analysis.proto",
+}
@@ -0,0 +1,5 @@
+// Package clockzenv1 is the gRPC API generated from the definitions in
+// proto/clockzen/v1. Regenerate it with go generate after changing them.
+package clockzenv1
+
+//go:generate protoc -I ../../../../proto --go_out=../../../.. --go_opt=module=clockzen-next --go-grpc_out=../../../.. --go-grpc_opt=module=clockzen-next clockzen/v1/sync.proto clockzen/v1/analysis.proto
@@ -0,0 +1,976 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.3
+// source: clockzen/v1/sync.proto
+
+package clockzenv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SyncKind is the kind of connection a sync is of
+type SyncKind int32
+
+const (
+	SyncKind_SYNC_KIND_UNSPECIFIED SyncKind = 0
+	SyncKind_SYNC_KIND_EMAIL       SyncKind = 1
+	SyncKind_SYNC_KIND_DRIVE       SyncKind = 2
+)
+
+// Enum value maps for SyncKind.
+var (
+	SyncKind_name = map[int32]string{
+		0: "SYNC_KIND_UNSPECIFIED",
+		1: "SYNC_KIND_EMAIL",
+		2: "SYNC_KIND_DRIVE",
+	}
+	SyncKind_value = map[string]int32{
+		"SYNC_KIND_UNSPECIFIED": 0,
+		"SYNC_KIND_EMAIL":       1,
+		"SYNC_KIND_DRIVE":       2,
+	}
+)
+
+func (x SyncKind) Enum() *SyncKind {
+	p := new(SyncKind)
+	*p = x
+	return p
+}
+
+func (x SyncKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SyncKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_clockzen_v1_sync_proto_enumTypes[0].Descriptor()
+}
+
+func (SyncKind) Type() protoreflect.EnumType {
+	return &file_clockzen_v1_sync_proto_enumTypes[0]
+}
+
+func (x SyncKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SyncKind.Descriptor instead.
+func (SyncKind) EnumDescriptor() ([]byte, []int) {
+	return file_clockzen_v1_sync_proto_rawDescGZIP(), []int{0}
+}
+
+type StartEmailSyncRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionId string                 `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// label_id limits the sync to one label; all synced labels otherwise
+	LabelId string `protobuf:"bytes,2,opt,name=label_id,json=labelId,proto3" json:"label_id,omitempty"`
+	// sync_type is full, incremental or manual; manual if empty
+	SyncType string `protobuf:"bytes,3,opt,name=sync_type,json=syncType,proto3" json:"sync_type,omitempty"`
+	// after and before limit full and manual syncs to messages received in
+	// the range; after is inclusive and before exclusive
+	After         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`
+	Before        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartEmailSyncRequest) Reset() {
+	*x = StartEmailSyncRequest{}
+	mi := &file_clockzen_v1_sync_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartEmailSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartEmailSyncRequest) ProtoMessage() {}
+
+func (x *StartEmailSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_sync_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartEmailSyncRequest.ProtoReflect.Descriptor instead.
+func (*StartEmailSyncRequest) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_sync_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StartEmailSyncRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *StartEmailSyncRequest) GetLabelId() string {
+	if x != nil {
+		return x.LabelId
+	}
+	return ""
+}
+
+func (x *StartEmailSyncRequest) GetSyncType() string {
+	if x != nil {
+		return x.SyncType
+	}
+	return ""
+}
+
+func (x *StartEmailSyncRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *StartEmailSyncRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+type StartDriveSyncRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionId string                 `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// folder_id limits the sync to one folder; all synced folders otherwise
+	FolderId string `protobuf:"bytes,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	// sync_type is full, incremental or manual; manual if empty
+	SyncType string `protobuf:"bytes,3,opt,name=sync_type,json=syncType,proto3" json:"sync_type,omitempty"`
+	// after and before limit full and manual syncs to files modified in the
+	// range; after is inclusive and before exclusive
+	After         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`
+	Before        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartDriveSyncRequest) Reset() {
+	*x = StartDriveSyncRequest{}
+	mi := &file_clockzen_v1_sync_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartDriveSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartDriveSyncRequest) ProtoMessage() {}
+
+func (x *StartDriveSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_sync_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartDriveSyncRequest.ProtoReflect.Descriptor instead.
+func (*StartDriveSyncRequest) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_sync_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StartDriveSyncRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *StartDriveSyncRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *StartDriveSyncRequest) GetSyncType() string {
+	if x != nil {
+		return x.SyncType
+	}
+	return ""
+}
+
+func (x *StartDriveSyncRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *StartDriveSyncRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+// SyncEvent is an update on a running sync
+type SyncEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*SyncEvent_Progress
+	//	*SyncEvent_Completed
+	Event         isSyncEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncEvent) Reset() {
+	*x = SyncEvent{}
+	mi := &file_clockzen_v1_sync_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncEvent) ProtoMessage() {}
+
+func (x *SyncEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_sync_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncEvent.ProtoReflect.Descriptor instead.
+func (*SyncEvent) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_sync_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SyncEvent) GetEvent() isSyncEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *SyncEvent) GetProgress() *SyncProgress {
+	if x != nil {
+		if x, ok := x.Event.(*SyncEvent_Progress); ok {
+			return x.Progress
+		}
+	}
+	return nil
+}
+
+func (x *SyncEvent) GetCompleted() *Sync {
+	if x != nil {
+		if x, ok := x.Event.(*SyncEvent_Completed); ok {
+			return x.Completed
+		}
+	}
+	return nil
+}
+
+type isSyncEvent_Event interface {
+	isSyncEvent_Event()
+}
+
+type SyncEvent_Progress struct {
+	Progress *SyncProgress `protobuf:"bytes,1,opt,name=progress,proto3,oneof"`
+}
+
+type SyncEvent_Completed struct {
+	// completed is the finished sync, sent last
+	Completed *Sync `protobuf:"bytes,2,opt,name=completed,proto3,oneof"`
+}
+
+func (*SyncEvent_Progress) isSyncEvent_Event() {}
+
+func (*SyncEvent_Completed) isSyncEvent_Event() {}
+
+// SyncProgress is how far a running sync has got. Items are messages for
+// email syncs and files for Drive syncs.
+type SyncProgress struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	SyncId                string                 `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	Status                string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ItemsScanned          int32                  `protobuf:"varint,3,opt,name=items_scanned,json=itemsScanned,proto3" json:"items_scanned,omitempty"`
+	ItemsProcessed        int32                  `protobuf:"varint,4,opt,name=items_processed,json=itemsProcessed,proto3" json:"items_processed,omitempty"`
+	TotalItems            int32                  `protobuf:"varint,5,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	AttachmentsDownloaded int32                  `protobuf:"varint,6,opt,name=attachments_downloaded,json=attachmentsDownloaded,proto3" json:"attachments_downloaded,omitempty"`
+	BytesTransferred      int64                  `protobuf:"varint,7,opt,name=bytes_transferred,json=bytesTransferred,proto3" json:"bytes_transferred,omitempty"`
+	CurrentItem           string                 `protobuf:"bytes,8,opt,name=current_item,json=currentItem,proto3" json:"current_item,omitempty"`
+	Errors                []string               `protobuf:"bytes,9,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SyncProgress) Reset() {
+	*x = SyncProgress{}
+	mi := &file_clockzen_v1_sync_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncProgress) ProtoMessage() {}
+
+func (x *SyncProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_sync_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncProgress.ProtoReflect.Descriptor instead.
+func (*SyncProgress) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_sync_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SyncProgress) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+func (x *SyncProgress) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SyncProgress) GetItemsScanned() int32 {
+	if x != nil {
+		return x.ItemsScanned
+	}
+	return 0
+}
+
+func (x *SyncProgress) GetItemsProcessed() int32 {
+	if x != nil {
+		return x.ItemsProcessed
+	}
+	return 0
+}
+
+func (x *SyncProgress) GetTotalItems() int32 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *SyncProgress) GetAttachmentsDownloaded() int32 {
+	if x != nil {
+		return x.AttachmentsDownloaded
+	}
+	return 0
+}
+
+func (x *SyncProgress) GetBytesTransferred() int64 {
+	if x != nil {
+		return x.BytesTransferred
+	}
+	return 0
+}
+
+func (x *SyncProgress) GetCurrentItem() string {
+	if x != nil {
+		return x.CurrentItem
+	}
+	return ""
+}
+
+func (x *SyncProgress) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// Sync is a sync of a connection. Items are messages for email syncs and
+// files for Drive syncs.
+type Sync struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind         SyncKind               `protobuf:"varint,2,opt,name=kind,proto3,enum=clockzen.v1.SyncKind" json:"kind,omitempty"`
+	ConnectionId string                 `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// scope_id is the label or folder synced, empty for all of them
+	ScopeId          string                 `protobuf:"bytes,4,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	SyncType         string                 `protobuf:"bytes,5,opt,name=sync_type,json=syncType,proto3" json:"sync_type,omitempty"`
+	Status           string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	StartedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	ItemsScanned     int32                  `protobuf:"varint,9,opt,name=items_scanned,json=itemsScanned,proto3" json:"items_scanned,omitempty"`
+	ItemsDownloaded  int32                  `protobuf:"varint,10,opt,name=items_downloaded,json=itemsDownloaded,proto3" json:"items_downloaded,omitempty"`
+	ItemsFailed      int32                  `protobuf:"varint,11,opt,name=items_failed,json=itemsFailed,proto3" json:"items_failed,omitempty"`
+	BytesTransferred int64                  `protobuf:"varint,12,opt,name=bytes_transferred,json=bytesTransferred,proto3" json:"bytes_transferred,omitempty"`
+	ErrorMessage     string                 `protobuf:"bytes,13,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Sync) Reset() {
+	*x = Sync{}
+	mi := &file_clockzen_v1_sync_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sync) ProtoMessage() {}
+
+func (x *Sync) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_sync_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sync.ProtoReflect.Descriptor instead.
+func (*Sync) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_sync_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Sync) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Sync) GetKind() SyncKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SyncKind_SYNC_KIND_UNSPECIFIED
+}
+
+func (x *Sync) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *Sync) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+func (x *Sync) GetSyncType() string {
+	if x != nil {
+		return x.SyncType
+	}
+	return ""
+}
+
+func (x *Sync) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Sync) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Sync) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Sync) GetItemsScanned() int32 {
+	if x != nil {
+		return x.ItemsScanned
+	}
+	return 0
+}
+
+func (x *Sync) GetItemsDownloaded() int32 {
+	if x != nil {
+		return x.ItemsDownloaded
+	}
+	return 0
+}
+
+func (x *Sync) GetItemsFailed() int32 {
+	if x != nil {
+		return x.ItemsFailed
+	}
+	return 0
+}
+
+func (x *Sync) GetBytesTransferred() int64 {
+	if x != nil {
+		return x.BytesTransferred
+	}
+	return 0
+}
+
+func (x *Sync) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetSyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          SyncKind               `protobuf:"varint,1,opt,name=kind,proto3,enum=clockzen.v1.SyncKind" json:"kind,omitempty"`
+	SyncId        string                 `protobuf:"bytes,2,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyncRequest) Reset() {
+	*x = GetSyncRequest{}
+	mi := &file_clockzen_v1_sync_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncRequest) ProtoMessage() {}
+
+func (x *GetSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_sync_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncRequest.ProtoReflect.Descriptor instead.
+func (*GetSyncRequest) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_sync_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSyncRequest) GetKind() SyncKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SyncKind_SYNC_KIND_UNSPECIFIED
+}
+
+func (x *GetSyncRequest) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+type ListSyncsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Kind         SyncKind               `protobuf:"varint,1,opt,name=kind,proto3,enum=clockzen.v1.SyncKind" json:"kind,omitempty"`
+	ConnectionId string                 `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// page_size is the number of syncs returned, 20 if unset and at most 100
+	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSyncsRequest) Reset() {
+	*x = ListSyncsRequest{}
+	mi := &file_clockzen_v1_sync_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSyncsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSyncsRequest) ProtoMessage() {}
+
+func (x *ListSyncsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_sync_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSyncsRequest.ProtoReflect.Descriptor instead.
+func (*ListSyncsRequest) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_sync_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListSyncsRequest) GetKind() SyncKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SyncKind_SYNC_KIND_UNSPECIFIED
+}
+
+func (x *ListSyncsRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *ListSyncsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListSyncsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Syncs         []*Sync                `protobuf:"bytes,1,rep,name=syncs,proto3" json:"syncs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSyncsResponse) Reset() {
+	*x = ListSyncsResponse{}
+	mi := &file_clockzen_v1_sync_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSyncsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSyncsResponse) ProtoMessage() {}
+
+func (x *ListSyncsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_sync_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSyncsResponse.ProtoReflect.Descriptor instead.
+func (*ListSyncsResponse) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_sync_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListSyncsResponse) GetSyncs() []*Sync {
+	if x != nil {
+		return x.Syncs
+	}
+	return nil
+}
+
+type CancelSyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          SyncKind               `protobuf:"varint,1,opt,name=kind,proto3,enum=clockzen.v1.SyncKind" json:"kind,omitempty"`
+	ConnectionId  string                 `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSyncRequest) Reset() {
+	*x = CancelSyncRequest{}
+	mi := &file_clockzen_v1_sync_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSyncRequest) ProtoMessage() {}
+
+func (x *CancelSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_sync_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSyncRequest.ProtoReflect.Descriptor instead.
+func (*CancelSyncRequest) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_sync_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelSyncRequest) GetKind() SyncKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SyncKind_SYNC_KIND_UNSPECIFIED
+}
+
+func (x *CancelSyncRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+type CancelSyncResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSyncResponse) Reset() {
+	*x = CancelSyncResponse{}
+	mi := &file_clockzen_v1_sync_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSyncResponse) ProtoMessage() {}
+
+func (x *CancelSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clockzen_v1_sync_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSyncResponse.ProtoReflect.Descriptor instead.
+func (*CancelSyncResponse) Descriptor() ([]byte, []int) {
+	return file_clockzen_v1_sync_proto_rawDescGZIP(), []int{9}
+}
+
+var File_clockzen_v1_sync_proto protoreflect.FileDescriptor
+
+const file_clockzen_v1_sync_proto_rawDesc = "" +
+	"\n" +
+	"\x16clockzen/v1/sync.proto\x12\vclockzen.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xda\x01\n" +
+	"\x15StartEmailSyncRequest\x12#\n" +
+	"\rconnection_id\x18\x01 \x01(\tR\fconnectionId\x12\x19\n" +
+	"\blabel_id\x18\x02 \x01(\tR\alabelId\x12\x1b\n" +
+	"\tsync_type\x18\x03 \x01(\tR\bsyncType\x120\n" +
+	"\x05after\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05after\x122\n" +
+	"\x06before\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\"\xdc\x01\n" +
+	"\x15StartDriveSyncRequest\x12#\n" +
+	"\rconnection_id\x18\x01 \x01(\tR\fconnectionId\x12\x1b\n" +
+	"\tfolder_id\x18\x02 \x01(\tR\bfolderId\x12\x1b\n" +
+	"\tsync_type\x18\x03 \x01(\tR\bsyncType\x120\n" +
+	"\x05after\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05after\x122\n" +
+	"\x06before\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\"\x80\x01\n" +
+	"\tSyncEvent\x127\n" +
+	"\bprogress\x18\x01 \x01(\v2\x19.clockzen.v1.SyncProgressH\x00R\bprogress\x121\n" +
+	"\tcompleted\x18\x02 \x01(\v2\x11.clockzen.v1.SyncH\x00R\tcompletedB\a\n" +
+	"\x05event\"\xcd\x02\n" +
+	"\fSyncProgress\x12\x17\n" +
+	"\async_id\x18\x01 \x01(\tR\x06syncId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\ritems_scanned\x18\x03 \x01(\x05R\fitemsScanned\x12'\n" +
+	"\x0fitems_processed\x18\x04 \x01(\x05R\x0eitemsProcessed\x12\x1f\n" +
+	"\vtotal_items\x18\x05 \x01(\x05R\n" +
+	"totalItems\x125\n" +
+	"\x16attachments_downloaded\x18\x06 \x01(\x05R\x15attachmentsDownloaded\x12+\n" +
+	"\x11bytes_transferred\x18\a \x01(\x03R\x10bytesTransferred\x12!\n" +
+	"\fcurrent_item\x18\b \x01(\tR\vcurrentItem\x12\x16\n" +
+	"\x06errors\x18\t \x03(\tR\x06errors\"\xf5\x03\n" +
+	"\x04Sync\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x15.clockzen.v1.SyncKindR\x04kind\x12#\n" +
+	"\rconnection_id\x18\x03 \x01(\tR\fconnectionId\x12\x19\n" +
+	"\bscope_id\x18\x04 \x01(\tR\ascopeId\x12\x1b\n" +
+	"\tsync_type\x18\x05 \x01(\tR\bsyncType\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"started_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12#\n" +
+	"\ritems_scanned\x18\t \x01(\x05R\fitemsScanned\x12)\n" +
+	"\x10items_downloaded\x18\n" +
+	" \x01(\x05R\x0fitemsDownloaded\x12!\n" +
+	"\fitems_failed\x18\v \x01(\x05R\vitemsFailed\x12+\n" +
+	"\x11bytes_transferred\x18\f \x01(\x03R\x10bytesTransferred\x12#\n" +
+	"\rerror_message\x18\r \x01(\tR\ferrorMessage\"T\n" +
+	"\x0eGetSyncRequest\x12)\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x15.clockzen.v1.SyncKindR\x04kind\x12\x17\n" +
+	"\async_id\x18\x02 \x01(\tR\x06syncId\"\x7f\n" +
+	"\x10ListSyncsRequest\x12)\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x15.clockzen.v1.SyncKindR\x04kind\x12#\n" +
+	"\rconnection_id\x18\x02 \x01(\tR\fconnectionId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"<\n" +
+	"\x11ListSyncsResponse\x12'\n" +
+	"\x05syncs\x18\x01 \x03(\v2\x11.clockzen.v1.SyncR\x05syncs\"c\n" +
+	"\x11CancelSyncRequest\x12)\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x15.clockzen.v1.SyncKindR\x04kind\x12#\n" +
+	"\rconnection_id\x18\x02 \x01(\tR\fconnectionId\"\x14\n" +
+	"\x12CancelSyncResponse*O\n" +
+	"\bSyncKind\x12\x19\n" +
+	"\x15SYNC_KIND_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fSYNC_KIND_EMAIL\x10\x01\x12\x13\n" +
+	"\x0fSYNC_KIND_DRIVE\x10\x022\x83\x03\n" +
+	"\vSyncService\x12N\n" +
+	"\x0eStartEmailSync\x12\".clockzen.v1.StartEmailSyncRequest\x1a\x16.clockzen.v1.SyncEvent0\x01\x12N\n" +
+	"\x0eStartDriveSync\x12\".clockzen.v1.StartDriveSyncRequest\x1a\x16.clockzen.v1.SyncEvent0\x01\x129\n" +
+	"\aGetSync\x12\x1b.clockzen.v1.GetSyncRequest\x1a\x11.clockzen.v1.Sync\x12J\n" +
+	"\tListSyncs\x12\x1d.clockzen.v1.ListSyncsRequest\x1a\x1e.clockzen.v1.ListSyncsResponse\x12M\n" +
+	"\n" +
+	"CancelSync\x12\x1e.clockzen.v1.CancelSyncRequest\x1a\x1f.clockzen.v1.CancelSyncResponseB?Z=clockzen-next/internal/presentation/rpc/clockzenv1;clockzenv1b\x06proto3"
+
+var (
+	file_clockzen_v1_sync_proto_rawDescOnce sync.Once
+	file_clockzen_v1_sync_proto_rawDescData []byte
+)
+
+func file_clockzen_v1_sync_proto_rawDescGZIP() []byte {
+	file_clockzen_v1_sync_proto_rawDescOnce.Do(func() {
+		file_clockzen_v1_sync_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_clockzen_v1_sync_proto_rawDesc), len(file_clockzen_v1_sync_proto_rawDesc)))
+	})
+	return file_clockzen_v1_sync_proto_rawDescData
+}
+
+var file_clockzen_v1_sync_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_clockzen_v1_sync_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_clockzen_v1_sync_proto_goTypes = []any{
+	(SyncKind)(0),                 // 0: clockzen.v1.SyncKind
+	(*StartEmailSyncRequest)(nil), // 1: clockzen.v1.StartEmailSyncRequest
+	(*StartDriveSyncRequest)(nil), // 2: clockzen.v1.StartDriveSyncRequest
+	(*SyncEvent)(nil),             // 3: clockzen.v1.SyncEvent
+	(*SyncProgress)(nil),          // 4: clockzen.v1.SyncProgress
+	(*Sync)(nil),                  // 5: clockzen.v1.Sync
+	(*GetSyncRequest)(nil),        // 6: clockzen.v1.GetSyncRequest
+	(*ListSyncsRequest)(nil),      // 7: clockzen.v1.ListSyncsRequest
+	(*ListSyncsResponse)(nil),     // 8: clockzen.v1.ListSyncsResponse
+	(*CancelSyncRequest)(nil),     // 9: clockzen.v1.CancelSyncRequest
+	(*CancelSyncResponse)(nil),    // 10: clockzen.v1.CancelSyncResponse
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+}
+var file_clockzen_v1_sync_proto_depIdxs = []int32{
+	11, // 0: clockzen.v1.StartEmailSyncRequest.after:type_name -> google.protobuf.Timestamp
+	11, // 1: clockzen.v1.StartEmailSyncRequest.before:type_name -> google.protobuf.Timestamp
+	11, // 2: clockzen.v1.StartDriveSyncRequest.after:type_name -> google.protobuf.Timestamp
+	11, // 3: clockzen.v1.StartDriveSyncRequest.before:type_name -> google.protobuf.Timestamp
+	4,  // 4: clockzen.v1.SyncEvent.progress:type_name -> clockzen.v1.SyncProgress
+	5,  // 5: clockzen.v1.SyncEvent.completed:type_name -> clockzen.v1.Sync
+	0,  // 6: clockzen.v1.Sync.kind:type_name -> clockzen.v1.SyncKind
+	11, // 7: clockzen.v1.Sync.started_at:type_name -> google.protobuf.Timestamp
+	11, // 8: clockzen.v1.Sync.completed_at:type_name -> google.protobuf.Timestamp
+	0,  // 9: clockzen.v1.GetSyncRequest.kind:type_name -> clockzen.v1.SyncKind
+	0,  // 10: clockzen.v1.ListSyncsRequest.kind:type_name -> clockzen.v1.SyncKind
+	5,  // 11: clockzen.v1.ListSyncsResponse.syncs:type_name -> clockzen.v1.Sync
+	0,  // 12: clockzen.v1.CancelSyncRequest.kind:type_name -> clockzen.v1.SyncKind
+	1,  // 13: clockzen.v1.SyncService.StartEmailSync:input_type -> clockzen.v1.StartEmailSyncRequest
+	2,  // 14: clockzen.v1.SyncService.StartDriveSync:input_type -> clockzen.v1.StartDriveSyncRequest
+	6,  // 15: clockzen.v1.SyncService.GetSync:input_type -> clockzen.v1.GetSyncRequest
+	7,  // 16: clockzen.v1.SyncService.ListSyncs:input_type -> clockzen.v1.ListSyncsRequest
+	9,  // 17: clockzen.v1.SyncService.CancelSync:input_type -> clockzen.v1.CancelSyncRequest
+	3,  // 18: clockzen.v1.SyncService.StartEmailSync:output_type -> clockzen.v1.SyncEvent
+	3,  // 19: clockzen.v1.SyncService.StartDriveSync:output_type -> clockzen.v1.SyncEvent
+	5,  // 20: clockzen.v1.SyncService.GetSync:output_type -> clockzen.v1.Sync
+	8,  // 21: clockzen.v1.SyncService.ListSyncs:output_type -> clockzen.v1.ListSyncsResponse
+	10, // 22: clockzen.v1.SyncService.CancelSync:output_type -> clockzen.v1.CancelSyncResponse
+	18, // [18:23] is the sub-list for method output_type
+	13, // [13:18] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_clockzen_v1_sync_proto_init() }
+func file_clockzen_v1_sync_proto_init() {
+	if File_clockzen_v1_sync_proto != nil {
+		return
+	}
+	file_clockzen_v1_sync_proto_msgTypes[2].OneofWrappers = []any{
+		(*SyncEvent_Progress)(nil),
+		(*SyncEvent_Completed)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_clockzen_v1_sync_proto_rawDesc), len(file_clockzen_v1_sync_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_clockzen_v1_sync_proto_goTypes,
+		DependencyIndexes: file_clockzen_v1_sync_proto_depIdxs,
+		EnumInfos:         file_clockzen_v1_sync_proto_enumTypes,
+		MessageInfos:      file_clockzen_v1_sync_proto_msgTypes,
+	}.Build()
+	File_clockzen_v1_sync_proto = out.File
+	file_clockzen_v1_sync_proto_goTypes = nil
+	file_clockzen_v1_sync_proto_depIdxs = nil
+}
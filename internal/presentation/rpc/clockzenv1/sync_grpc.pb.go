@@ -0,0 +1,312 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: clockzen/v1/sync.proto
+
+package clockzenv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SyncService_StartEmailSync_FullMethodName = "/clockzen.v1.SyncService/StartEmailSync"
+	SyncService_StartDriveSync_FullMethodName = "/clockzen.v1.SyncService/StartDriveSync"
+	SyncService_GetSync_FullMethodName        = "/clockzen.v1.SyncService/GetSync"
+	SyncService_ListSyncs_FullMethodName      = "/clockzen.v1.SyncService/ListSyncs"
+	SyncService_CancelSync_FullMethodName     = "/clockzen.v1.SyncService/CancelSync"
+)
+
+// SyncServiceClient is the client API for SyncService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SyncService starts and follows the syncs of the caller's email and Google
+// Drive connections. Calls act on the caller's own connections, or on an
+// organization's when they carry x-organization-id metadata.
+//
+// Failed calls carry a google.rpc.ErrorInfo detail in the "clockzen"
+// domain whose reason names the error, such as CONNECTION_NOT_FOUND or
+// SYNC_ALREADY_RUNNING.
+type SyncServiceClient interface {
+	// StartEmailSync syncs an email connection, streaming its progress. The
+	// last event holds the finished sync. The sync keeps running if the
+	// caller goes away; GetSync reports how it ended.
+	StartEmailSync(ctx context.Context, in *StartEmailSyncRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SyncEvent], error)
+	// StartDriveSync syncs a Drive connection, streaming its progress like
+	// StartEmailSync
+	StartDriveSync(ctx context.Context, in *StartDriveSyncRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SyncEvent], error)
+	// GetSync returns a sync
+	GetSync(ctx context.Context, in *GetSyncRequest, opts ...grpc.CallOption) (*Sync, error)
+	// ListSyncs lists a connection's syncs, the latest first
+	ListSyncs(ctx context.Context, in *ListSyncsRequest, opts ...grpc.CallOption) (*ListSyncsResponse, error)
+	// CancelSync cancels a connection's running sync
+	CancelSync(ctx context.Context, in *CancelSyncRequest, opts ...grpc.CallOption) (*CancelSyncResponse, error)
+}
+
+type syncServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSyncServiceClient(cc grpc.ClientConnInterface) SyncServiceClient {
+	return &syncServiceClient{cc}
+}
+
+func (c *syncServiceClient) StartEmailSync(ctx context.Context, in *StartEmailSyncRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SyncEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SyncService_ServiceDesc.Streams[0], SyncService_StartEmailSync_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StartEmailSyncRequest, SyncEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SyncService_StartEmailSyncClient = grpc.ServerStreamingClient[SyncEvent]
+
+func (c *syncServiceClient) StartDriveSync(ctx context.Context, in *StartDriveSyncRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SyncEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SyncService_ServiceDesc.Streams[1], SyncService_StartDriveSync_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StartDriveSyncRequest, SyncEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SyncService_StartDriveSyncClient = grpc.ServerStreamingClient[SyncEvent]
+
+func (c *syncServiceClient) GetSync(ctx context.Context, in *GetSyncRequest, opts ...grpc.CallOption) (*Sync, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Sync)
+	err := c.cc.Invoke(ctx, SyncService_GetSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syncServiceClient) ListSyncs(ctx context.Context, in *ListSyncsRequest, opts ...grpc.CallOption) (*ListSyncsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSyncsResponse)
+	err := c.cc.Invoke(ctx, SyncService_ListSyncs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syncServiceClient) CancelSync(ctx context.Context, in *CancelSyncRequest, opts ...grpc.CallOption) (*CancelSyncResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelSyncResponse)
+	err := c.cc.Invoke(ctx, SyncService_CancelSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SyncServiceServer is the server API for SyncService service.
+// All implementations must embed UnimplementedSyncServiceServer
+// for forward compatibility.
+//
+// SyncService starts and follows the syncs of the caller's email and Google
+// Drive connections. Calls act on the caller's own connections, or on an
+// organization's when they carry x-organization-id metadata.
+//
+// Failed calls carry a google.rpc.ErrorInfo detail in the "clockzen"
+// domain whose reason names the error, such as CONNECTION_NOT_FOUND or
+// SYNC_ALREADY_RUNNING.
+type SyncServiceServer interface {
+	// StartEmailSync syncs an email connection, streaming its progress. The
+	// last event holds the finished sync. The sync keeps running if the
+	// caller goes away; GetSync reports how it ended.
+	StartEmailSync(*StartEmailSyncRequest, grpc.ServerStreamingServer[SyncEvent]) error
+	// StartDriveSync syncs a Drive connection, streaming its progress like
+	// StartEmailSync
+	StartDriveSync(*StartDriveSyncRequest, grpc.ServerStreamingServer[SyncEvent]) error
+	// GetSync returns a sync
+	GetSync(context.Context, *GetSyncRequest) (*Sync, error)
+	// ListSyncs lists a connection's syncs, the latest first
+	ListSyncs(context.Context, *ListSyncsRequest) (*ListSyncsResponse, error)
+	// CancelSync cancels a connection's running sync
+	CancelSync(context.Context, *CancelSyncRequest) (*CancelSyncResponse, error)
+	mustEmbedUnimplementedSyncServiceServer()
+}
+
+// UnimplementedSyncServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSyncServiceServer struct{}
+
+func (UnimplementedSyncServiceServer) StartEmailSync(*StartEmailSyncRequest, grpc.ServerStreamingServer[SyncEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StartEmailSync not implemented")
+}
+func (UnimplementedSyncServiceServer) StartDriveSync(*StartDriveSyncRequest, grpc.ServerStreamingServer[SyncEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StartDriveSync not implemented")
+}
+func (UnimplementedSyncServiceServer) GetSync(context.Context, *GetSyncRequest) (*Sync, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSync not implemented")
+}
+func (UnimplementedSyncServiceServer) ListSyncs(context.Context, *ListSyncsRequest) (*ListSyncsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSyncs not implemented")
+}
+func (UnimplementedSyncServiceServer) CancelSync(context.Context, *CancelSyncRequest) (*CancelSyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSync not implemented")
+}
+func (UnimplementedSyncServiceServer) mustEmbedUnimplementedSyncServiceServer() {}
+func (UnimplementedSyncServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeSyncServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SyncServiceServer will
+// result in compilation errors.
+type UnsafeSyncServiceServer interface {
+	mustEmbedUnimplementedSyncServiceServer()
+}
+
+func RegisterSyncServiceServer(s grpc.ServiceRegistrar, srv SyncServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSyncServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SyncService_ServiceDesc, srv)
+}
+
+func _SyncService_StartEmailSync_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StartEmailSyncRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SyncServiceServer).StartEmailSync(m, &grpc.GenericServerStream[StartEmailSyncRequest, SyncEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SyncService_StartEmailSyncServer = grpc.ServerStreamingServer[SyncEvent]
+
+func _SyncService_StartDriveSync_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StartDriveSyncRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SyncServiceServer).StartDriveSync(m, &grpc.GenericServerStream[StartDriveSyncRequest, SyncEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SyncService_StartDriveSyncServer = grpc.ServerStreamingServer[SyncEvent]
+
+func _SyncService_GetSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServiceServer).GetSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SyncService_GetSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServiceServer).GetSync(ctx, req.(*GetSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SyncService_ListSyncs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSyncsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServiceServer).ListSyncs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SyncService_ListSyncs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServiceServer).ListSyncs(ctx, req.(*ListSyncsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SyncService_CancelSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServiceServer).CancelSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SyncService_CancelSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServiceServer).CancelSync(ctx, req.(*CancelSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SyncService_ServiceDesc is the grpc.ServiceDesc for SyncService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SyncService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "clockzen.v1.SyncService",
+	HandlerType: (*SyncServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSync",
+			Handler:    _SyncService_GetSync_Handler,
+		},
+		{
+			MethodName: "ListSyncs",
+			Handler:    _SyncService_ListSyncs_Handler,
+		},
+		{
+			MethodName: "CancelSync",
+			Handler:    _SyncService_CancelSync_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StartEmailSync",
+			Handler:       _SyncService_StartEmailSync_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StartDriveSync",
+			Handler:       _SyncService_StartDriveSync_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "clockzen/v1/sync.proto",
+}
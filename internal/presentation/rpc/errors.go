@@ -0,0 +1,108 @@
+package rpc
+
+import (
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/application/integration"
+	analysishandler "clockzen-next/internal/presentation/http/handlers/analysis"
+)
+
+// ErrorDomain is the domain of the ErrorInfo details failed calls carry
+const ErrorDomain = "clockzen"
+
+// Reasons of the ErrorInfo details failed calls carry
+const (
+	ReasonUnauthenticated      = "UNAUTHENTICATED"
+	ReasonTokenExpired         = "TOKEN_EXPIRED"
+	ReasonOrganizationNotFound = "ORGANIZATION_NOT_FOUND"
+	ReasonPermissionDenied     = "PERMISSION_DENIED"
+	ReasonInvalidRequest       = "INVALID_REQUEST"
+	ReasonConnectionNotFound   = "CONNECTION_NOT_FOUND"
+	ReasonConnectionInactive   = "CONNECTION_INACTIVE"
+	ReasonScopeNotFound        = "SCOPE_NOT_FOUND"
+	ReasonNothingToSync        = "NOTHING_TO_SYNC"
+	ReasonSyncNotFound         = "SYNC_NOT_FOUND"
+	ReasonSyncAlreadyRunning   = "SYNC_ALREADY_RUNNING"
+	ReasonSyncNotRunning       = "SYNC_NOT_RUNNING"
+	ReasonCardAccountNotFound  = "CARD_ACCOUNT_NOT_FOUND"
+	ReasonAnalysisUnavailable  = "ANALYSIS_UNAVAILABLE"
+	ReasonInternal             = "INTERNAL"
+)
+
+// newError returns a status error with an ErrorInfo detail holding the
+// reason
+func newError(code codes.Code, reason, message string) error {
+	st := status.New(code, message)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: ErrorDomain,
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// invalidRequest returns an INVALID_REQUEST error
+func invalidRequest(message string) error {
+	return newError(codes.InvalidArgument, ReasonInvalidRequest, message)
+}
+
+// internalError returns an INTERNAL error naming its cause, like the HTTP
+// API's failure messages
+func internalError(message string, err error) error {
+	return newError(codes.Internal, ReasonInternal, message+": "+err.Error())
+}
+
+// syncError maps errors of the email and Drive sync services to status
+// errors
+func syncError(err error) error {
+	switch {
+	case errors.Is(err, integration.ErrEmailConnectionNotFound),
+		errors.Is(err, integration.ErrConnectionNotFound):
+		return newError(codes.NotFound, ReasonConnectionNotFound, "connection not found")
+	case errors.Is(err, integration.ErrEmailConnectionInactive),
+		errors.Is(err, integration.ErrConnectionInactive):
+		return newError(codes.FailedPrecondition, ReasonConnectionInactive, "connection is not active")
+	case errors.Is(err, integration.ErrEmailLabelNotFound):
+		return newError(codes.NotFound, ReasonScopeNotFound, "label not found")
+	case errors.Is(err, integration.ErrFolderNotFound):
+		return newError(codes.NotFound, ReasonScopeNotFound, "folder not found")
+	case errors.Is(err, integration.ErrNoEmailLabelsToSync):
+		return newError(codes.FailedPrecondition, ReasonNothingToSync, "no labels configured for sync")
+	case errors.Is(err, integration.ErrNoFoldersToSync):
+		return newError(codes.FailedPrecondition, ReasonNothingToSync, "no folders configured for sync")
+	case errors.Is(err, integration.ErrEmailSyncAlreadyRunning),
+		errors.Is(err, integration.ErrSyncAlreadyRunning):
+		return newError(codes.AlreadyExists, ReasonSyncAlreadyRunning, "a sync is already running for this connection")
+	case errors.Is(err, integration.ErrEmailSyncNotFound),
+		errors.Is(err, integration.ErrSyncNotFound):
+		return newError(codes.NotFound, ReasonSyncNotFound, "sync not found")
+	case errors.Is(err, integration.ErrInvalidSyncRange),
+		errors.Is(err, integration.ErrInvalidEmailSyncType),
+		errors.Is(err, integration.ErrInvalidSyncType):
+		return invalidRequest(err.Error())
+	default:
+		return internalError("sync failed", err)
+	}
+}
+
+// analysisError maps errors of analyses to status errors
+func analysisError(err error) error {
+	switch {
+	case errors.Is(err, analysishandler.ErrInvalidRequest),
+		errors.Is(err, analysis.ErrStatementCycleRequired):
+		return invalidRequest(err.Error())
+	case errors.Is(err, analysis.ErrStatementCycleNotFound):
+		return newError(codes.NotFound, ReasonCardAccountNotFound, "card account not found")
+	case errors.Is(err, analysishandler.ErrNotConfigured):
+		return newError(codes.Unavailable, ReasonAnalysisUnavailable, err.Error())
+	default:
+		return internalError("analysis failed", err)
+	}
+}
@@ -0,0 +1,81 @@
+package rpc
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	"clockzen-next/internal/presentation/http/middleware"
+	"clockzen-next/internal/presentation/rpc/clockzenv1"
+)
+
+// Server is the gRPC API, served alongside the HTTP API for internal
+// consumers. Calls authenticate with the HTTP API's bearer tokens in their
+// authorization metadata. The standard health service reports each
+// registered service, and server reflection describes them; neither needs a
+// token.
+type Server struct {
+	server *grpc.Server
+	health *health.Server
+}
+
+// NewServer creates a gRPC server checking tokens against the auth config
+// and organization membership with members. A nil members rejects every
+// organization.
+func NewServer(authConfig middleware.AuthConfig, members middleware.OrganizationMembers, opts ...grpc.ServerOption) *Server {
+	auth := &authenticator{config: authConfig, members: members}
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(auth.unary),
+		grpc.ChainStreamInterceptor(auth.stream),
+	)
+
+	s := &Server{
+		server: grpc.NewServer(opts...),
+		health: health.NewServer(),
+	}
+	healthpb.RegisterHealthServer(s.server, s.health)
+	reflection.Register(s.server)
+	return s
+}
+
+// RegisterSyncService serves SyncService. Services must be registered
+// before Serve.
+func (s *Server) RegisterSyncService(srv clockzenv1.SyncServiceServer) {
+	clockzenv1.RegisterSyncServiceServer(s.server, srv)
+	s.health.SetServingStatus(clockzenv1.SyncService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+}
+
+// RegisterAnalysisService serves AnalysisService. Services must be
+// registered before Serve.
+func (s *Server) RegisterAnalysisService(srv clockzenv1.AnalysisServiceServer) {
+	clockzenv1.RegisterAnalysisServiceServer(s.server, srv)
+	s.health.SetServingStatus(clockzenv1.AnalysisService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+}
+
+// Serve accepts connections on lis until the server is shut down
+func (s *Server) Serve(lis net.Listener) error {
+	return s.server.Serve(lis)
+}
+
+// Shutdown reports the services as not serving and stops the server once
+// running calls finish, or when ctx is done, cancelling the calls left
+func (s *Server) Shutdown(ctx context.Context) {
+	s.health.Shutdown()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.server.Stop()
+		<-stopped
+	}
+}
@@ -0,0 +1,199 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"clockzen-next/internal/application/dto"
+	"clockzen-next/internal/application/organizations"
+	analysishandler "clockzen-next/internal/presentation/http/handlers/analysis"
+	"clockzen-next/internal/presentation/http/middleware"
+	"clockzen-next/internal/presentation/rpc/clockzenv1"
+)
+
+var testAuthConfig = middleware.AuthConfig{Secret: []byte("test-secret")}
+
+// stubAnalyzer records the requests it runs
+type stubAnalyzer struct {
+	spending []dto.SpendingAnalysisRequest
+}
+
+func (a *stubAnalyzer) AnalyzeSpending(ctx context.Context, req dto.SpendingAnalysisRequest) (*dto.SpendingAnalysisResponse, error) {
+	a.spending = append(a.spending, req)
+	if req.EndDate.Before(req.StartDate) {
+		return nil, analysishandler.ErrInvalidRequest
+	}
+	return &dto.SpendingAnalysisResponse{
+		UserID:        req.UserID,
+		Period:        dto.TimePeriodMonthly,
+		TotalSpending: 120,
+		TopCategories: []dto.CategorySpendingResponse{{Category: "groceries", Amount: 120, TransactionCount: 3}},
+	}, nil
+}
+
+func (a *stubAnalyzer) DetectAnomalies(ctx context.Context, req dto.AnomalyDetectionRequest) (*dto.AnomalyDetectionResponse, error) {
+	return &dto.AnomalyDetectionResponse{UserID: req.UserID}, nil
+}
+
+func (a *stubAnalyzer) ForecastSpending(ctx context.Context, req dto.SpendingForecastRequest) (*dto.SpendingForecastResponse, error) {
+	return &dto.SpendingForecastResponse{UserID: req.UserID}, nil
+}
+
+// stubMembers gives each user a role in org-1
+type stubMembers map[string]organizations.Role
+
+func (m stubMembers) MemberRole(ctx context.Context, organizationID, userID string) (organizations.Role, error) {
+	role, ok := m[userID]
+	if !ok || organizationID != "org-1" {
+		return "", organizations.ErrNotFound
+	}
+	return role, nil
+}
+
+// newTestConn serves the API on an in-memory listener and returns a client
+// connection to it
+func newTestConn(t *testing.T, analyzer Analyzer) *grpc.ClientConn {
+	t.Helper()
+	server := NewServer(testAuthConfig, stubMembers{"viewer-1": organizations.RoleViewer})
+	server.RegisterAnalysisService(NewAnalysisServer(analyzer))
+	server.RegisterSyncService(NewSyncServer(nil, nil, nil))
+
+	lis := bufconn.Listen(1 << 20)
+	go server.Serve(lis)
+	t.Cleanup(func() { server.Shutdown(context.Background()) })
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// withToken returns ctx calling as the user, in the organization if not
+// empty
+func withToken(t *testing.T, userID, organizationID string) context.Context {
+	t.Helper()
+	token, err := middleware.IssueToken(testAuthConfig, middleware.JWTClaims{
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+	if organizationID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, OrganizationMetadata, organizationID)
+	}
+	return ctx
+}
+
+// assertReason checks err is a status error with the code and reason
+func assertReason(t *testing.T, err error, code codes.Code, reason string) {
+	t.Helper()
+	st, ok := status.FromError(err)
+	require.True(t, ok, "not a status error: %v", err)
+	assert.Equal(t, code, st.Code())
+
+	require.Len(t, st.Details(), 1)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	assert.Equal(t, ErrorDomain, info.Domain)
+	assert.Equal(t, reason, info.Reason)
+}
+
+func TestAuthentication(t *testing.T) {
+	conn := newTestConn(t, &stubAnalyzer{})
+	analysis := clockzenv1.NewAnalysisServiceClient(conn)
+	req := &clockzenv1.AnalyzeSpendingRequest{StartDate: timestamppb.Now(), EndDate: timestamppb.Now()}
+
+	t.Run("rejects calls without a token", func(t *testing.T) {
+		_, err := analysis.AnalyzeSpending(context.Background(), req)
+		assertReason(t, err, codes.Unauthenticated, ReasonUnauthenticated)
+	})
+
+	t.Run("rejects expired tokens", func(t *testing.T) {
+		token, err := middleware.IssueToken(testAuthConfig, middleware.JWTClaims{
+			UserID:    "user-1",
+			ExpiresAt: time.Now().Add(-time.Hour).Unix(),
+		})
+		require.NoError(t, err)
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+
+		_, err = analysis.AnalyzeSpending(ctx, req)
+		assertReason(t, err, codes.Unauthenticated, ReasonTokenExpired)
+	})
+
+	t.Run("hides organizations the user isn't in", func(t *testing.T) {
+		_, err := analysis.AnalyzeSpending(withToken(t, "user-1", "org-1"), req)
+		assertReason(t, err, codes.NotFound, ReasonOrganizationNotFound)
+	})
+
+	t.Run("stops viewers changing the organization's data", func(t *testing.T) {
+		_, err := clockzenv1.NewSyncServiceClient(conn).CancelSync(withToken(t, "viewer-1", "org-1"), &clockzenv1.CancelSyncRequest{
+			Kind:         clockzenv1.SyncKind_SYNC_KIND_EMAIL,
+			ConnectionId: "conn-1",
+		})
+		assertReason(t, err, codes.PermissionDenied, ReasonPermissionDenied)
+	})
+
+	t.Run("serves health checks without a token", func(t *testing.T) {
+		resp, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{
+			Service: clockzenv1.AnalysisService_ServiceDesc.ServiceName,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+	})
+}
+
+func TestAnalyzeSpending(t *testing.T) {
+	analyzer := &stubAnalyzer{}
+	analysis := clockzenv1.NewAnalysisServiceClient(newTestConn(t, analyzer))
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("runs for the organization the call acts in", func(t *testing.T) {
+		resp, err := analysis.AnalyzeSpending(withToken(t, "viewer-1", "org-1"), &clockzenv1.AnalyzeSpendingRequest{
+			StartDate: timestamppb.New(start),
+			EndDate:   timestamppb.New(end),
+		})
+		require.NoError(t, err)
+
+		require.Len(t, analyzer.spending, 1)
+		assert.Equal(t, "org-1", analyzer.spending[0].UserID)
+		assert.Equal(t, start, analyzer.spending[0].StartDate)
+		assert.Equal(t, "org-1", resp.UserId)
+		assert.Equal(t, 120.0, resp.TotalSpending)
+		require.Len(t, resp.TopCategories, 1)
+		assert.Equal(t, int32(3), resp.TopCategories[0].TransactionCount)
+	})
+
+	t.Run("requires dates", func(t *testing.T) {
+		_, err := analysis.AnalyzeSpending(withToken(t, "user-1", ""), &clockzenv1.AnalyzeSpendingRequest{})
+		assertReason(t, err, codes.InvalidArgument, ReasonInvalidRequest)
+	})
+
+	t.Run("reports invalid requests", func(t *testing.T) {
+		_, err := analysis.AnalyzeSpending(withToken(t, "user-1", ""), &clockzenv1.AnalyzeSpendingRequest{
+			StartDate: timestamppb.New(end),
+			EndDate:   timestamppb.New(start),
+		})
+		assertReason(t, err, codes.InvalidArgument, ReasonInvalidRequest)
+	})
+}
@@ -0,0 +1,369 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"clockzen-next/internal/application/integration"
+	"clockzen-next/internal/application/organizations"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/presentation/rpc/clockzenv1"
+)
+
+const (
+	// defaultSyncPageSize is the number of syncs ListSyncs returns by default
+	defaultSyncPageSize = 20
+	// maxSyncPageSize is the most syncs ListSyncs returns
+	maxSyncPageSize = 100
+	// syncProgressBuffer is how many progress updates wait for a slow
+	// client before further ones are dropped
+	syncProgressBuffer = 16
+)
+
+// SyncServer serves SyncService with the email and Drive sync services the
+// HTTP API uses, so syncs started over either API see each other
+type SyncServer struct {
+	clockzenv1.UnimplementedSyncServiceServer
+
+	entClient *ent.Client
+	email     *integration.EmailSyncService
+	drive     *integration.DriveSyncService
+}
+
+// NewSyncServer creates a SyncServer
+func NewSyncServer(entClient *ent.Client, email *integration.EmailSyncService, drive *integration.DriveSyncService) *SyncServer {
+	return &SyncServer{
+		entClient: entClient,
+		email:     email,
+		drive:     drive,
+	}
+}
+
+// StartEmailSync syncs an email connection, streaming its progress
+func (s *SyncServer) StartEmailSync(req *clockzenv1.StartEmailSyncRequest, stream clockzenv1.SyncService_StartEmailSyncServer) error {
+	syncType, dateRange, err := syncOptions(req.GetConnectionId(), req.GetSyncType(), req.GetAfter(), req.GetBefore())
+	if err != nil {
+		return err
+	}
+	if err := s.authorizeConnection(stream.Context(), clockzenv1.SyncKind_SYNC_KIND_EMAIL, req.GetConnectionId()); err != nil {
+		return err
+	}
+
+	return streamSync(stream, func(ctx context.Context, progress func(*clockzenv1.SyncProgress)) (*clockzenv1.Sync, error) {
+		result, err := s.email.SyncLabelInRange(ctx, req.GetConnectionId(), req.GetLabelId(), syncType, dateRange, func(p integration.EmailSyncProgress) {
+			progress(&clockzenv1.SyncProgress{
+				SyncId:                p.SyncID,
+				Status:                p.Status,
+				ItemsScanned:          int32(p.MessagesScanned),
+				ItemsProcessed:        int32(p.MessagesProcessed),
+				TotalItems:            int32(p.TotalMessages),
+				AttachmentsDownloaded: int32(p.AttachmentsDownloaded),
+				BytesTransferred:      p.BytesTransferred,
+				CurrentItem:           p.CurrentMessage,
+				Errors:                p.Errors,
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+		return emailSync(result), nil
+	})
+}
+
+// StartDriveSync syncs a Drive connection, streaming its progress
+func (s *SyncServer) StartDriveSync(req *clockzenv1.StartDriveSyncRequest, stream clockzenv1.SyncService_StartDriveSyncServer) error {
+	syncType, dateRange, err := syncOptions(req.GetConnectionId(), req.GetSyncType(), req.GetAfter(), req.GetBefore())
+	if err != nil {
+		return err
+	}
+	if err := s.authorizeConnection(stream.Context(), clockzenv1.SyncKind_SYNC_KIND_DRIVE, req.GetConnectionId()); err != nil {
+		return err
+	}
+
+	return streamSync(stream, func(ctx context.Context, progress func(*clockzenv1.SyncProgress)) (*clockzenv1.Sync, error) {
+		result, err := s.drive.SyncFolderInRange(ctx, req.GetConnectionId(), req.GetFolderId(), syncType, dateRange, func(p integration.SyncProgress) {
+			progress(&clockzenv1.SyncProgress{
+				SyncId:           p.SyncID,
+				Status:           p.Status,
+				ItemsScanned:     int32(p.FilesScanned),
+				ItemsProcessed:   int32(p.FilesProcessed),
+				TotalItems:       int32(p.TotalFiles),
+				BytesTransferred: p.BytesTransferred,
+				CurrentItem:      p.CurrentFile,
+				Errors:           p.Errors,
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+		return driveSync(result), nil
+	})
+}
+
+// GetSync returns a sync of one of the caller's connections
+func (s *SyncServer) GetSync(ctx context.Context, req *clockzenv1.GetSyncRequest) (*clockzenv1.Sync, error) {
+	if req.GetSyncId() == "" {
+		return nil, invalidRequest("sync_id is required")
+	}
+
+	var sync *clockzenv1.Sync
+	switch req.GetKind() {
+	case clockzenv1.SyncKind_SYNC_KIND_EMAIL:
+		result, err := s.email.GetSyncStatus(ctx, req.GetSyncId())
+		if err != nil {
+			return nil, syncError(err)
+		}
+		sync = emailSync(result)
+	case clockzenv1.SyncKind_SYNC_KIND_DRIVE:
+		result, err := s.drive.GetSyncStatus(ctx, req.GetSyncId())
+		if err != nil {
+			return nil, syncError(err)
+		}
+		sync = driveSync(result)
+	default:
+		return nil, invalidRequest("kind must be SYNC_KIND_EMAIL or SYNC_KIND_DRIVE")
+	}
+
+	// Syncs of others' connections are not found, like the connections
+	if err := s.authorizeConnection(ctx, req.GetKind(), sync.ConnectionId); err != nil {
+		if st, _ := status.FromError(err); st.Code() == codes.NotFound {
+			return nil, syncError(integration.ErrSyncNotFound)
+		}
+		return nil, err
+	}
+	return sync, nil
+}
+
+// ListSyncs lists a connection's syncs, the latest first
+func (s *SyncServer) ListSyncs(ctx context.Context, req *clockzenv1.ListSyncsRequest) (*clockzenv1.ListSyncsResponse, error) {
+	if req.GetConnectionId() == "" {
+		return nil, invalidRequest("connection_id is required")
+	}
+	pageSize := int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, invalidRequest("page_size must not be negative")
+	case pageSize == 0:
+		pageSize = defaultSyncPageSize
+	case pageSize > maxSyncPageSize:
+		pageSize = maxSyncPageSize
+	}
+	if err := s.authorizeConnection(ctx, req.GetKind(), req.GetConnectionId()); err != nil {
+		return nil, err
+	}
+
+	resp := &clockzenv1.ListSyncsResponse{}
+	switch req.GetKind() {
+	case clockzenv1.SyncKind_SYNC_KIND_EMAIL:
+		results, err := s.email.GetSyncHistory(ctx, req.GetConnectionId(), pageSize)
+		if err != nil {
+			return nil, internalError("failed to list syncs", err)
+		}
+		for _, result := range results {
+			resp.Syncs = append(resp.Syncs, emailSync(result))
+		}
+	case clockzenv1.SyncKind_SYNC_KIND_DRIVE:
+		results, err := s.drive.GetSyncHistory(ctx, req.GetConnectionId(), pageSize)
+		if err != nil {
+			return nil, internalError("failed to list syncs", err)
+		}
+		for _, result := range results {
+			resp.Syncs = append(resp.Syncs, driveSync(result))
+		}
+	}
+	return resp, nil
+}
+
+// CancelSync cancels a connection's running sync
+func (s *SyncServer) CancelSync(ctx context.Context, req *clockzenv1.CancelSyncRequest) (*clockzenv1.CancelSyncResponse, error) {
+	if req.GetConnectionId() == "" {
+		return nil, invalidRequest("connection_id is required")
+	}
+	if err := s.authorizeConnection(ctx, req.GetKind(), req.GetConnectionId()); err != nil {
+		return nil, err
+	}
+
+	var err error
+	switch req.GetKind() {
+	case clockzenv1.SyncKind_SYNC_KIND_EMAIL:
+		err = s.email.CancelSync(req.GetConnectionId())
+	case clockzenv1.SyncKind_SYNC_KIND_DRIVE:
+		err = s.drive.CancelSync(req.GetConnectionId())
+	}
+	if errors.Is(err, integration.ErrEmailSyncNotFound) || errors.Is(err, integration.ErrSyncNotFound) {
+		return nil, newError(codes.FailedPrecondition, ReasonSyncNotRunning, "no sync is running for this connection")
+	}
+	if err != nil {
+		return nil, internalError("failed to cancel sync", err)
+	}
+	return &clockzenv1.CancelSyncResponse{}, nil
+}
+
+// authorizeConnection verifies the caller owns a connection of the kind.
+// Connections owned by others are not found, so their existence is not
+// revealed.
+func (s *SyncServer) authorizeConnection(ctx context.Context, kind clockzenv1.SyncKind, connectionID string) error {
+	ownerID, err := requireOwner(ctx)
+	if err != nil {
+		return err
+	}
+
+	var userID string
+	var organizationID *string
+	switch kind {
+	case clockzenv1.SyncKind_SYNC_KIND_EMAIL:
+		conn, err := s.entClient.EmailConnection.Get(ctx, connectionID)
+		if err != nil {
+			return connectionError(err)
+		}
+		userID, organizationID = conn.UserID, conn.OrganizationID
+	case clockzenv1.SyncKind_SYNC_KIND_DRIVE:
+		conn, err := s.entClient.GoogleDriveConnection.Get(ctx, connectionID)
+		if err != nil {
+			return connectionError(err)
+		}
+		userID, organizationID = conn.UserID, conn.OrganizationID
+	default:
+		return invalidRequest("kind must be SYNC_KIND_EMAIL or SYNC_KIND_DRIVE")
+	}
+
+	if organizations.OwnerID(userID, organizationID) != ownerID {
+		return syncError(integration.ErrConnectionNotFound)
+	}
+	return nil
+}
+
+// connectionError maps an error looking up a connection to a status error
+func connectionError(err error) error {
+	if ent.IsNotFound(err) {
+		return syncError(integration.ErrConnectionNotFound)
+	}
+	return internalError("failed to get connection", err)
+}
+
+// syncOptions validates the options of a sync request, returning its sync
+// type, manual if unset, and date range
+func syncOptions(connectionID, syncType string, after, before *timestamppb.Timestamp) (string, integration.SyncRange, error) {
+	if connectionID == "" {
+		return "", integration.SyncRange{}, invalidRequest("connection_id is required")
+	}
+
+	switch syncType {
+	case "":
+		syncType = "manual"
+	case "full", "incremental", "manual":
+	default:
+		return "", integration.SyncRange{}, invalidRequest("sync_type must be one of: full, incremental, manual")
+	}
+
+	var dateRange integration.SyncRange
+	if after != nil {
+		dateRange.After = after.AsTime()
+	}
+	if before != nil {
+		dateRange.Before = before.AsTime()
+	}
+	return syncType, dateRange, nil
+}
+
+// streamSync runs a sync, sending its progress on the stream and then the
+// finished sync. Like syncs started over HTTP, the sync runs detached from
+// the call, so it finishes even if the caller goes away. Progress updates
+// are dropped while the client is slow to read them rather than holding up
+// the sync.
+func streamSync(stream grpc.ServerStreamingServer[clockzenv1.SyncEvent], run func(ctx context.Context, progress func(*clockzenv1.SyncProgress)) (*clockzenv1.Sync, error)) error {
+	type outcome struct {
+		sync *clockzenv1.Sync
+		err  error
+	}
+
+	ctx := stream.Context()
+	updates := make(chan *clockzenv1.SyncProgress, syncProgressBuffer)
+	done := make(chan outcome, 1)
+	go func() {
+		sync, err := run(context.WithoutCancel(ctx), func(progress *clockzenv1.SyncProgress) {
+			select {
+			case updates <- progress:
+			default:
+			}
+		})
+		done <- outcome{sync: sync, err: err}
+	}()
+
+	for {
+		select {
+		case progress := <-updates:
+			event := &clockzenv1.SyncEvent{Event: &clockzenv1.SyncEvent_Progress{Progress: progress}}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		case result := <-done:
+			if result.err != nil {
+				return syncError(result.err)
+			}
+			return stream.Send(&clockzenv1.SyncEvent{Event: &clockzenv1.SyncEvent_Completed{Completed: result.sync}})
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+// emailSync converts the result of an email sync
+func emailSync(result *integration.EmailSyncResult) *clockzenv1.Sync {
+	return &clockzenv1.Sync{
+		Id:               result.SyncID,
+		Kind:             clockzenv1.SyncKind_SYNC_KIND_EMAIL,
+		ConnectionId:     result.ConnectionID,
+		ScopeId:          stringValue(result.LabelID),
+		SyncType:         result.SyncType,
+		Status:           result.Status,
+		StartedAt:        timestamppb.New(result.StartedAt),
+		CompletedAt:      timestampValue(result.CompletedAt),
+		ItemsScanned:     int32(result.MessagesScanned),
+		ItemsDownloaded:  int32(result.MessagesDownloaded),
+		ItemsFailed:      int32(result.MessagesFailed),
+		BytesTransferred: result.BytesTransferred,
+		ErrorMessage:     stringValue(result.ErrorMessage),
+	}
+}
+
+// driveSync converts the result of a Drive sync
+func driveSync(result *integration.SyncResult) *clockzenv1.Sync {
+	return &clockzenv1.Sync{
+		Id:               result.SyncID,
+		Kind:             clockzenv1.SyncKind_SYNC_KIND_DRIVE,
+		ConnectionId:     result.ConnectionID,
+		ScopeId:          stringValue(result.FolderID),
+		SyncType:         result.SyncType,
+		Status:           result.Status,
+		StartedAt:        timestamppb.New(result.StartedAt),
+		CompletedAt:      timestampValue(result.CompletedAt),
+		ItemsScanned:     int32(result.FilesScanned),
+		ItemsDownloaded:  int32(result.FilesDownloaded),
+		ItemsFailed:      int32(result.FilesFailed),
+		BytesTransferred: result.BytesTransferred,
+		ErrorMessage:     stringValue(result.ErrorMessage),
+	}
+}
+
+// stringValue returns the string s points to, or "" if s is nil
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// timestampValue converts the time t points to, or returns nil if t is nil
+func timestampValue(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
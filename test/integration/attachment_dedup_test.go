@@ -0,0 +1,65 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appintegration "clockzen-next/internal/application/integration"
+	"clockzen-next/internal/ent/attachmentlink"
+	"clockzen-next/internal/infrastructure/google"
+)
+
+// TestAttachmentDeduplication tests that attachment content is stored once
+// but only counts as a duplicate within the connection that stored it
+func TestAttachmentDeduplication(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	db := SetupTestDatabase(t)
+	defer db.Cleanup(t)
+
+	ctx := context.Background()
+	service := appintegration.NewEmailSyncServiceWithDefaults(db.Client, nil)
+	receipt := []byte("%PDF-1.4 receipt total 42.00")
+	att := google.AttachmentInfo{AttachmentID: "att-1", Filename: "receipt.pdf", MimeType: "application/pdf"}
+
+	first, err := service.StoreAttachment(ctx, "conn-a", "msg-1", att, receipt)
+	require.NoError(t, err)
+	assert.False(t, first.Duplicate)
+	assert.Equal(t, appintegration.ContentHash(receipt), first.ContentHash)
+
+	t.Run("same content in another message of the connection", func(t *testing.T) {
+		stored, err := service.StoreAttachment(ctx, "conn-a", "msg-2", att, receipt)
+		require.NoError(t, err)
+		assert.True(t, stored.Duplicate)
+		assert.Equal(t, first.BlobID, stored.BlobID)
+	})
+
+	t.Run("same content in another user's connection", func(t *testing.T) {
+		stored, err := service.StoreAttachment(ctx, "conn-b", "msg-1", att, receipt)
+		require.NoError(t, err)
+		assert.False(t, stored.Duplicate, "another connection's copy must still be OCRed")
+		assert.Equal(t, first.BlobID, stored.BlobID, "content is stored once")
+
+		links, err := db.Client.AttachmentLink.Query().
+			Where(attachmentlink.ConnectionID("conn-b")).
+			Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, links)
+	})
+
+	t.Run("different content", func(t *testing.T) {
+		stored, err := service.StoreAttachment(ctx, "conn-a", "msg-3", att, []byte("another receipt"))
+		require.NoError(t, err)
+		assert.False(t, stored.Duplicate)
+		assert.NotEqual(t, first.BlobID, stored.BlobID)
+	})
+
+	blobs, err := db.Client.AttachmentBlob.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, blobs)
+}
@@ -505,6 +505,57 @@ func TestEmailConnectionIntegration(t *testing.T) {
 		assert.Equal(t, "new-access-token", updated.AccessToken)
 		assert.True(t, updated.TokenExpiry.After(time.Now().Add(90*time.Minute)))
 	})
+
+	t.Run("upsert converges on existing provider account", func(t *testing.T) {
+		existing, err := db.Client.EmailConnection.Query().
+			Where(emailconnection.ProviderAccountIDEQ("provider-account-123")).
+			Only(ctx)
+		require.NoError(t, err)
+
+		id, err := db.Client.EmailConnection.Create().
+			SetID(uuid.New().String()).
+			SetUserID("user-456").
+			SetProviderAccountID("provider-account-123").
+			SetEmail("email@example.com").
+			SetProvider(emailconnection.ProviderGmail).
+			SetAccessToken("upserted-access-token").
+			SetRefreshToken("upserted-refresh-token").
+			SetTokenExpiry(time.Now().Add(1*time.Hour)).
+			SetStatus(emailconnection.StatusActive).
+			OnConflictColumns(emailconnection.FieldProviderAccountID, emailconnection.FieldProvider).
+			Update(func(u *ent.EmailConnectionUpsert) {
+				u.UpdateAccessToken()
+				u.UpdateRefreshToken()
+			}).
+			ID(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, existing.ID, id)
+
+		conn, err := db.Client.EmailConnection.Get(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "upserted-access-token", conn.AccessToken)
+
+		count, err := db.Client.EmailConnection.Query().
+			Where(emailconnection.ProviderAccountIDEQ("provider-account-123")).
+			Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("duplicate provider account is rejected", func(t *testing.T) {
+		_, err := db.Client.EmailConnection.Create().
+			SetID(uuid.New().String()).
+			SetUserID("user-999").
+			SetProviderAccountID("provider-account-123").
+			SetEmail("email@example.com").
+			SetProvider(emailconnection.ProviderGmail).
+			SetAccessToken("dup-access-token").
+			SetRefreshToken("dup-refresh-token").
+			SetTokenExpiry(time.Now().Add(1 * time.Hour)).
+			Save(ctx)
+		require.Error(t, err)
+		assert.True(t, ent.IsConstraintError(err))
+	})
 }
 
 // TestOAuthExchangeMock tests OAuth token exchange with a mock server
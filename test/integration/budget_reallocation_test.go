@@ -0,0 +1,74 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/application/budgets"
+)
+
+// TestBudgetReallocations tests that reallocations are kept as a budget's
+// history, oldest first, and only for the user who made them
+func TestBudgetReallocations(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	db := SetupTestDatabase(t)
+	defer db.Cleanup(t)
+
+	ctx := context.Background()
+	service := budgets.NewService(db.Client)
+	march := time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	_, err := service.Reallocate(ctx, "user-1", "budget-1", budgets.ReallocationInput{
+		FromCategory:  analysis.BudgetCategoryEntertainment,
+		ToCategory:    analysis.BudgetCategoryFood,
+		Amount:        75,
+		EffectiveDate: march.AddDate(0, 0, 5),
+		Note:          "Hosting family dinner",
+	})
+	require.NoError(t, err)
+	_, err = service.Reallocate(ctx, "user-1", "budget-1", budgets.ReallocationInput{
+		FromCategory:  analysis.BudgetCategoryPersonal,
+		ToCategory:    analysis.BudgetCategoryFood,
+		Amount:        20,
+		EffectiveDate: march,
+	})
+	require.NoError(t, err)
+	_, err = service.Reallocate(ctx, "user-2", "budget-1", budgets.ReallocationInput{
+		FromCategory:  analysis.BudgetCategoryFood,
+		ToCategory:    analysis.BudgetCategoryHousing,
+		Amount:        10,
+		EffectiveDate: march,
+	})
+	require.NoError(t, err)
+
+	reallocations, err := service.GetReallocations(ctx, "user-1", "budget-1")
+	require.NoError(t, err)
+	require.Len(t, reallocations, 2)
+	assert.Equal(t, analysis.BudgetCategoryPersonal, reallocations[0].FromCategory)
+	assert.Equal(t, 75.0, reallocations[1].Amount)
+	assert.Equal(t, "Hosting family dinner", reallocations[1].Note)
+
+	for _, tt := range []struct {
+		name  string
+		input budgets.ReallocationInput
+		want  error
+	}{
+		{"unknown category", budgets.ReallocationInput{FromCategory: "groceries", ToCategory: analysis.BudgetCategoryFood, Amount: 5}, budgets.ErrInvalidCategory},
+		{"same category", budgets.ReallocationInput{FromCategory: analysis.BudgetCategoryFood, ToCategory: analysis.BudgetCategoryFood, Amount: 5}, budgets.ErrSameCategory},
+		{"no amount", budgets.ReallocationInput{FromCategory: analysis.BudgetCategoryFood, ToCategory: analysis.BudgetCategoryDebt}, budgets.ErrInvalidAmount},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Reallocate(ctx, "user-1", "budget-1", tt.input)
+			assert.True(t, errors.Is(err, tt.want))
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/application/emergencyfund"
+	"clockzen-next/internal/ent/emergencyfundsnapshot"
+)
+
+// fixedExpenses serves the same transactions for every user
+type fixedExpenses []analysis.Transaction
+
+func (f fixedExpenses) GetByUserID(ctx context.Context, userID string, startDate, endDate time.Time) ([]analysis.Transaction, error) {
+	return f, nil
+}
+
+func (f fixedExpenses) GetByCategory(ctx context.Context, userID string, category analysis.SpendingCategory, startDate, endDate time.Time) ([]analysis.Transaction, error) {
+	return nil, nil
+}
+
+// TestEmergencyFundMonitor tests months-of-coverage snapshots and that an
+// alert is raised once when a fund drops below target
+func TestEmergencyFundMonitor(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	db := SetupTestDatabase(t)
+	defer db.Cleanup(t)
+
+	ctx := context.Background()
+	// 2,000 a month over the last six months
+	now := time.Now()
+	expenses := make(fixedExpenses, 6)
+	for i := range expenses {
+		expenses[i] = analysis.Transaction{Amount: 2000, TransactionDate: now.AddDate(0, -i, -1)}
+	}
+
+	service := emergencyfund.NewService(db.Client, expenses)
+	var alerts []emergencyfund.Alert
+	service.SetOnAlert(func(alert emergencyfund.Alert) {
+		alerts = append(alerts, alert)
+	})
+
+	account, err := service.CreateAccount(ctx, "user-1", emergencyfund.AccountInput{Name: "Savings", Balance: 15000})
+	require.NoError(t, err)
+	_, err = service.CreateAccount(ctx, "user-1", emergencyfund.AccountInput{Name: "Spending", Balance: 50000, EmergencyFund: new(bool)})
+	require.NoError(t, err)
+
+	t.Run("above target", func(t *testing.T) {
+		status, err := service.CheckStatus(ctx, "user-1")
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, status.AccountCount, "only designated accounts count")
+		assert.Equal(t, 15000.0, status.Balance)
+		assert.Equal(t, 2000.0, status.MonthlyExpenses)
+		require.NotNil(t, status.MonthsCovered)
+		assert.Equal(t, 7.5, *status.MonthsCovered)
+		assert.Equal(t, emergencyfund.DefaultTargetMonths, status.TargetMonths)
+		assert.False(t, status.BelowTarget)
+		assert.Empty(t, alerts)
+	})
+
+	t.Run("dropping below target alerts once", func(t *testing.T) {
+		balance := 6000.0
+		_, err := service.UpdateAccount(ctx, "user-1", account.ID, emergencyfund.AccountUpdate{Balance: &balance})
+		require.NoError(t, err)
+
+		status, err := service.CheckStatus(ctx, "user-1")
+		require.NoError(t, err)
+		assert.True(t, status.BelowTarget)
+		assert.Equal(t, 6000.0, status.Shortfall)
+		require.Len(t, alerts, 1)
+		assert.Equal(t, 3.0, alerts[0].MonthsCovered)
+		assert.Equal(t, 6000.0, alerts[0].Shortfall)
+
+		_, err = service.CheckStatus(ctx, "user-1")
+		require.NoError(t, err)
+		assert.Len(t, alerts, 1, "a fund that stays below target is not alerted again")
+
+		snapshots, err := db.Client.EmergencyFundSnapshot.Query().
+			Where(emergencyfundsnapshot.UserID("user-1")).
+			Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, snapshots, "one snapshot per day")
+	})
+
+	t.Run("lower target clears the shortfall", func(t *testing.T) {
+		require.NoError(t, service.SetTargetMonths(ctx, "user-1", 3))
+
+		status, err := service.CheckStatus(ctx, "user-1")
+		require.NoError(t, err)
+		assert.False(t, status.BelowTarget)
+		assert.Zero(t, status.Shortfall)
+		assert.Len(t, alerts, 1)
+	})
+
+	t.Run("no expenses", func(t *testing.T) {
+		noExpenses := emergencyfund.NewService(db.Client, fixedExpenses{})
+		noExpenses.SetOnAlert(func(alert emergencyfund.Alert) {
+			alerts = append(alerts, alert)
+		})
+		_, err := noExpenses.CreateAccount(ctx, "user-2", emergencyfund.AccountInput{Name: "Savings", Balance: 0})
+		require.NoError(t, err)
+
+		status, err := noExpenses.CheckStatus(ctx, "user-2")
+		require.NoError(t, err)
+		assert.Nil(t, status.MonthsCovered)
+		assert.Zero(t, status.MonthlyExpenses)
+		assert.False(t, status.BelowTarget)
+		assert.Len(t, alerts, 1, "no alert without expenses to cover")
+
+		snapshot, err := db.Client.EmergencyFundSnapshot.Query().
+			Where(emergencyfundsnapshot.UserID("user-2")).
+			Only(ctx)
+		require.NoError(t, err)
+		assert.Nil(t, snapshot.MonthsCovered)
+	})
+}
@@ -0,0 +1,144 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/application/analysis"
+	"clockzen-next/internal/application/transactions"
+	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/receipt"
+	"clockzen-next/internal/ent/transaction"
+)
+
+// TestHouseholdMembers tests that spending is attributed to household
+// members by their connections, that manual assignment overrides it, and
+// that connections can't be shared between members
+func TestHouseholdMembers(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	db := SetupTestDatabase(t)
+	defer db.Cleanup(t)
+
+	ctx := context.Background()
+	for _, conn := range []struct{ id, userID string }{{"conn-alex", "user-1"}, {"conn-sam", "user-1"}, {"conn-other", "user-2"}} {
+		_, err := db.Client.EmailConnection.Create().
+			SetID(conn.id).
+			SetUserID(conn.userID).
+			SetProviderAccountID("account-" + conn.id).
+			SetEmail(conn.id + "@example.com").
+			SetProvider(emailconnection.ProviderGmail).
+			SetAccessToken("access").
+			SetRefreshToken("refresh").
+			SetTokenExpiry(time.Now().Add(time.Hour)).
+			SetStatus(emailconnection.StatusActive).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	march := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+	purchase := func(id, connectionID string, amount float64) {
+		t.Helper()
+		_, err := db.Client.Receipt.Create().
+			SetID("receipt-" + id).
+			SetUserID("user-1").
+			SetSourceType(receipt.SourceTypeEmail).
+			SetSourceID("msg-" + id).
+			SetSourceConnectionID(connectionID).
+			SetFileName("receipt.pdf").
+			SetMimeType("application/pdf").
+			SetTotalAmount(amount).
+			SetReceiptDate(march).
+			Save(ctx)
+		require.NoError(t, err)
+		_, err = db.Client.Transaction.Create().
+			SetID(id).
+			SetReceiptID("receipt-" + id).
+			SetUserID("user-1").
+			SetType(transaction.TypePurchase).
+			SetAmount(amount).
+			SetTransactionDate(march).
+			SetMerchantCategory("groceries").
+			SetStatus(transaction.StatusCompleted).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+	purchase("tx-alex", "conn-alex", 80)
+	purchase("tx-sam", "conn-sam", 30)
+	purchase("tx-sam-for-alex", "conn-sam", 20)
+
+	service := transactions.NewService(db.Client)
+
+	alex, err := service.CreateMember(ctx, "user-1", transactions.MemberInput{Name: "Alex", ConnectionIDs: []string{"conn-alex"}})
+	require.NoError(t, err)
+	sam, err := service.CreateMember(ctx, "user-1", transactions.MemberInput{Name: "Sam", ConnectionIDs: []string{"conn-sam"}})
+	require.NoError(t, err)
+
+	t.Run("rejects shared and foreign connections", func(t *testing.T) {
+		_, err := service.CreateMember(ctx, "user-1", transactions.MemberInput{Name: "Kid", ConnectionIDs: []string{"conn-alex"}})
+		assert.True(t, errors.Is(err, transactions.ErrConnectionAssigned))
+
+		_, err = service.CreateMember(ctx, "user-1", transactions.MemberInput{Name: "Kid", ConnectionIDs: []string{"conn-other"}})
+		assert.True(t, errors.Is(err, transactions.ErrConnectionNotFound))
+
+		_, err = service.CreateMember(ctx, "user-1", transactions.MemberInput{})
+		assert.True(t, errors.Is(err, transactions.ErrInvalidMemberName))
+
+		// Saving a member with its own connections isn't a conflict
+		_, err = service.UpdateMember(ctx, "user-1", alex.ID, transactions.MemberUpdate{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("attributes by connection with manual overrides", func(t *testing.T) {
+		_, err := service.AssignTransaction(ctx, "user-1", "tx-sam-for-alex", alex.ID)
+		require.NoError(t, err)
+
+		_, err = service.AssignTransaction(ctx, "user-2", "tx-alex", "")
+		assert.True(t, errors.Is(err, transactions.ErrTransactionNotFound))
+
+		spending, err := service.GetByUserID(ctx, "user-1", march.AddDate(0, 0, -1), march.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		members := make(map[string]string, len(spending))
+		for _, tx := range spending {
+			members[tx.ID] = tx.MemberID
+		}
+		assert.Equal(t, map[string]string{
+			"tx-alex":         alex.ID,
+			"tx-sam":          sam.ID,
+			"tx-sam-for-alex": alex.ID,
+		}, members)
+
+		spendingService := analysis.NewSpendingServiceWithDefaults(service)
+		result, err := spendingService.AnalyzeSpendingByCategory(ctx, "user-1", march.AddDate(0, 0, -1), march.AddDate(0, 0, 1), analysis.PeriodMonthly)
+		require.NoError(t, err)
+		require.Len(t, result.Members, 2)
+		assert.Equal(t, alex.ID, result.Members[0].MemberID)
+		assert.Equal(t, 100.0, result.Members[0].Amount)
+		assert.Equal(t, sam.ID, result.Members[1].MemberID)
+		assert.Equal(t, 30.0, result.Members[1].Amount)
+	})
+
+	t.Run("deleting a member clears manual assignments", func(t *testing.T) {
+		require.NoError(t, service.DeleteMember(ctx, "user-1", alex.ID))
+		assert.True(t, errors.Is(service.DeleteMember(ctx, "user-1", alex.ID), transactions.ErrMemberNotFound))
+
+		spending, err := service.GetByUserID(ctx, "user-1", march.AddDate(0, 0, -1), march.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		members := make(map[string]string, len(spending))
+		for _, tx := range spending {
+			members[tx.ID] = tx.MemberID
+		}
+		assert.Equal(t, map[string]string{
+			"tx-alex":         "",
+			"tx-sam":          sam.ID,
+			"tx-sam-for-alex": sam.ID,
+		}, members)
+	})
+}
@@ -0,0 +1,164 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/application/jobs"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/infrastructure/queue"
+)
+
+// startJobService starts a job service that runs anomaly scans, failing
+// those whose params ask it to, on a worker queue stopped when the test
+// ends
+func startJobService(t *testing.T, client *ent.Client) *jobs.Service {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	q := queue.New(client, testQueueConfig())
+	config := jobs.DefaultConfig()
+	config.WatchInterval = 20 * time.Millisecond
+	service := jobs.NewService(client, q, config)
+	require.NoError(t, service.RegisterHandler(jobs.JobTypeAnomalyScan, func(ctx context.Context, job *jobs.Job, report jobs.ProgressFunc) (any, error) {
+		var params struct {
+			Fail bool `json:"fail"`
+		}
+		if err := job.DecodeParams(&params); err != nil {
+			return nil, err
+		}
+		if params.Fail {
+			return nil, errors.New("scan failed")
+		}
+		return map[string]int{"anomalies": 2}, nil
+	}))
+	require.NoError(t, service.Start(ctx))
+	require.NoError(t, q.Start(ctx))
+	t.Cleanup(func() {
+		q.Stop()
+		cancel()
+	})
+	return service
+}
+
+// newTestScheduler returns a scheduler for anomaly scans that counts the
+// completed runs handed to it and the failures it alerts on
+func newTestScheduler(t *testing.T, service *jobs.Service, completed, failed *atomic.Int32) *jobs.Scheduler {
+	t.Helper()
+	config := jobs.DefaultSchedulerConfig()
+	config.DisableAfterFailures = 2
+	scheduler := jobs.NewScheduler(service, config)
+	require.NoError(t, scheduler.RegisterRunner(jobs.JobTypeAnomalyScan, jobs.Runner{
+		Params: func(schedule *jobs.Schedule) (any, error) {
+			return schedule.Params, nil
+		},
+		OnComplete: func(jobs.Schedule, *jobs.Job) {
+			completed.Add(1)
+		},
+	}))
+	scheduler.SetOnFailure(func(jobs.Schedule, jobs.ScheduleRun) {
+		failed.Add(1)
+	})
+	return scheduler
+}
+
+// TestJobScheduler tests that schedules are kept in the database, that a
+// due run is submitted by one of the instances checking for it and that
+// each run's outcome is recorded once
+func TestJobScheduler(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	db := SetupTestDatabase(t)
+	defer db.Cleanup(t)
+
+	ctx := context.Background()
+	service := startJobService(t, db.Client)
+
+	// Two API instances share the schedules
+	var completed, failed atomic.Int32
+	first := newTestScheduler(t, service, &completed, &failed)
+	second := newTestScheduler(t, service, &completed, &failed)
+
+	now := time.Now()
+	schedule, err := first.Create(ctx, "user-1", "Weekly scan", jobs.JobTypeAnomalyScan, jobs.FrequencyWeekly, json.RawMessage(`{}`), now.Add(-time.Minute))
+	require.NoError(t, err)
+
+	stored, err := second.Get(ctx, schedule.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Weekly scan", stored.Name)
+	list, err := second.List(ctx, "user-1")
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+
+	t.Run("a due run is submitted once", func(t *testing.T) {
+		var wg sync.WaitGroup
+		var submitted atomic.Int32
+		for _, scheduler := range []*jobs.Scheduler{first, second} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				submitted.Add(int32(scheduler.RunDue(ctx, now)))
+			}()
+		}
+		wg.Wait()
+		assert.EqualValues(t, 1, submitted.Load())
+
+		advanced, err := first.Get(ctx, schedule.ID)
+		require.NoError(t, err)
+		assert.True(t, advanced.NextRunAt.After(now))
+		assert.Zero(t, first.RunDue(ctx, now), "the run isn't due again")
+	})
+
+	t.Run("the outcome is recorded once", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			runs, err := second.History(ctx, schedule.ID)
+			return err == nil && len(runs) == 1 && runs[0].Status == jobs.JobStatusCompleted
+		}, 10*time.Second, 20*time.Millisecond)
+		assert.Zero(t, second.SettleRuns(ctx))
+		assert.EqualValues(t, 1, completed.Load())
+
+		finished, err := second.Get(ctx, schedule.ID)
+		require.NoError(t, err)
+		assert.Equal(t, jobs.JobStatusCompleted, finished.LastStatus)
+		assert.NotNil(t, finished.LastRunAt)
+	})
+
+	t.Run("repeated failures pause the schedule", func(t *testing.T) {
+		failing, err := first.Create(ctx, "user-1", "Failing scan", jobs.JobTypeAnomalyScan, jobs.FrequencyDaily, json.RawMessage(`{"fail":true}`), time.Time{})
+		require.NoError(t, err)
+
+		for attempt := int32(1); attempt <= 2; attempt++ {
+			_, err := first.RunNow(ctx, failing.ID)
+			require.NoError(t, err)
+			require.Eventually(t, func() bool { return failed.Load() == attempt }, 10*time.Second, 20*time.Millisecond)
+		}
+
+		paused, err := first.Get(ctx, failing.ID)
+		require.NoError(t, err)
+		assert.False(t, paused.Enabled)
+		assert.Equal(t, 2, paused.ConsecutiveFailures)
+		assert.Equal(t, "scan failed", paused.LastError)
+
+		enabled := true
+		resumed, err := first.Update(ctx, failing.ID, jobs.ScheduleUpdate{Enabled: &enabled})
+		require.NoError(t, err)
+		assert.True(t, resumed.Enabled)
+		assert.Zero(t, resumed.ConsecutiveFailures)
+	})
+
+	t.Run("deleting a schedule removes its history", func(t *testing.T) {
+		require.NoError(t, second.Delete(ctx, schedule.ID))
+		_, err := first.History(ctx, schedule.ID)
+		assert.ErrorIs(t, err, jobs.ErrScheduleNotFound)
+		assert.ErrorIs(t, first.Delete(ctx, schedule.ID), jobs.ErrScheduleNotFound)
+	})
+}
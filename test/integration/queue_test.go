@@ -0,0 +1,253 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/queuedjob"
+	"clockzen-next/internal/infrastructure/queue"
+)
+
+// testQueueConfig polls and heartbeats quickly so tests don't wait on the
+// production intervals
+func testQueueConfig() queue.Config {
+	return queue.Config{
+		PollInterval:      10 * time.Millisecond,
+		RetryBackoff:      10 * time.Millisecond,
+		MaxRetryBackoff:   50 * time.Millisecond,
+		HeartbeatInterval: 50 * time.Millisecond,
+		RescueAfter:       time.Minute,
+	}
+}
+
+// startQueue registers a handler for the named queue on a new worker and
+// starts it, stopping it when the test ends
+func startQueue(t *testing.T, client *ent.Client, name string, handler queue.Handler, opts queue.HandlerOptions) *queue.Queue {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	q := queue.New(client, testQueueConfig())
+	require.NoError(t, q.Register(ctx, name, handler, opts))
+	require.NoError(t, q.Start(ctx))
+	t.Cleanup(func() {
+		q.Stop()
+		cancel()
+	})
+	return q
+}
+
+// waitForStatus waits until a job reaches the given status and returns it
+func waitForStatus(t *testing.T, client *ent.Client, id string, status queuedjob.Status) *ent.QueuedJob {
+	t.Helper()
+	var job *ent.QueuedJob
+	require.Eventually(t, func() bool {
+		var err error
+		job, err = client.QueuedJob.Get(context.Background(), id)
+		return err == nil && job.Status == status
+	}, 10*time.Second, 20*time.Millisecond, "job %s never became %s", id, status)
+	return job
+}
+
+// TestQueueSingleClaim tests that a job is run by exactly one of the
+// workers sharing its queue
+func TestQueueSingleClaim(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	db := SetupTestDatabase(t)
+	defer db.Cleanup(t)
+
+	ctx := context.Background()
+	producer := producerQueue(db.Client)
+
+	const jobCount = 50
+	ids := make([]string, 0, jobCount)
+	for i := range jobCount {
+		job, err := producer.Enqueue(ctx, "shared", "count", map[string]int{"n": i}, queue.EnqueueOptions{})
+		require.NoError(t, err)
+		ids = append(ids, job.ID)
+	}
+
+	var mu sync.Mutex
+	runs := make(map[string][]string)
+	handler := func(worker string) queue.Handler {
+		return func(ctx context.Context, job *queue.Job) (any, error) {
+			mu.Lock()
+			runs[job.ID] = append(runs[job.ID], worker)
+			mu.Unlock()
+			time.Sleep(5 * time.Millisecond)
+			return nil, nil
+		}
+	}
+	startQueue(t, db.Client, "shared", handler("a"), queue.HandlerOptions{Concurrency: 4})
+	startQueue(t, db.Client, "shared", handler("b"), queue.HandlerOptions{Concurrency: 4})
+
+	for _, id := range ids {
+		waitForStatus(t, db.Client, id, queuedjob.StatusCompleted)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	workers := make(map[string]int)
+	for _, id := range ids {
+		require.Len(t, runs[id], 1, "job %s ran more than once", id)
+		workers[runs[id][0]]++
+	}
+	assert.Len(t, workers, 2, "both workers take jobs")
+}
+
+// TestQueueRetries tests that failing jobs are retried up to MaxRetries
+// with each attempt counted
+func TestQueueRetries(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	db := SetupTestDatabase(t)
+	defer db.Cleanup(t)
+
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	attempts := make(map[string][]int)
+	startQueue(t, db.Client, "flaky", func(ctx context.Context, job *queue.Job) (any, error) {
+		mu.Lock()
+		attempts[job.ID] = append(attempts[job.ID], job.RetryCount)
+		mu.Unlock()
+
+		var payload struct{ Failures int }
+		if err := job.Decode(&payload); err != nil {
+			return nil, err
+		}
+		if job.RetryCount < payload.Failures {
+			return nil, errors.New("temporary failure")
+		}
+		return map[string]string{"status": "ok"}, nil
+	}, queue.HandlerOptions{})
+
+	t.Run("succeeds on a retry", func(t *testing.T) {
+		enqueued, err := producerQueue(db.Client).Enqueue(ctx, "flaky", "flaky", map[string]int{"Failures": 2}, queue.EnqueueOptions{MaxRetries: 3})
+		require.NoError(t, err)
+
+		job := waitForStatus(t, db.Client, enqueued.ID, queuedjob.StatusCompleted)
+		assert.Equal(t, 2, job.RetryCount)
+		assert.JSONEq(t, `{"status":"ok"}`, string(job.Result))
+		assert.Nil(t, job.Error, "a successful attempt clears the last error")
+
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, []int{0, 1, 2}, attempts[enqueued.ID])
+	})
+
+	t.Run("fails once retries run out", func(t *testing.T) {
+		enqueued, err := producerQueue(db.Client).Enqueue(ctx, "flaky", "flaky", map[string]int{"Failures": 10}, queue.EnqueueOptions{MaxRetries: 2})
+		require.NoError(t, err)
+
+		job := waitForStatus(t, db.Client, enqueued.ID, queuedjob.StatusFailed)
+		assert.Equal(t, 2, job.RetryCount)
+		require.NotNil(t, job.Error)
+		assert.Equal(t, "temporary failure", *job.Error)
+		assert.NotNil(t, job.CompletedAt)
+
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, []int{0, 1, 2}, attempts[enqueued.ID])
+	})
+}
+
+// TestQueueRescuesAbandonedJobs tests that a job left processing by a
+// worker that stopped heartbeating is run again by another worker, counting
+// the rescue as a retry, and fails once it has no retries left
+func TestQueueRescuesAbandonedJobs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	db := SetupTestDatabase(t)
+	defer db.Cleanup(t)
+
+	ctx := context.Background()
+	// Create the queue's record before the job that references it
+	_, err := producerQueue(db.Client).Enqueue(ctx, "stuck", "noop", nil, queue.EnqueueOptions{RunAt: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+
+	stale := time.Now().Add(-time.Hour)
+	abandoned, err := db.Client.QueuedJob.Create().
+		SetID(uuid.New().String()).
+		SetQueue("stuck").
+		SetType("noop").
+		SetStatus(queuedjob.StatusProcessing).
+		SetLockedBy("crashed-worker").
+		SetHeartbeatAt(stale).
+		SetStartedAt(stale).
+		SetRunAt(stale).
+		SetMaxRetries(3).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// A job that already used its retries, e.g. because it keeps crashing
+	// its worker, is failed instead of run again
+	exhausted, err := db.Client.QueuedJob.Create().
+		SetID(uuid.New().String()).
+		SetQueue("stuck").
+		SetType("noop").
+		SetStatus(queuedjob.StatusProcessing).
+		SetLockedBy("crashed-worker").
+		SetHeartbeatAt(stale).
+		SetStartedAt(stale).
+		SetRunAt(stale).
+		SetRetryCount(3).
+		SetMaxRetries(3).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// A job still heartbeating is left alone
+	alive, err := db.Client.QueuedJob.Create().
+		SetID(uuid.New().String()).
+		SetQueue("stuck").
+		SetType("noop").
+		SetStatus(queuedjob.StatusProcessing).
+		SetLockedBy("busy-worker").
+		SetHeartbeatAt(time.Now()).
+		SetStartedAt(time.Now()).
+		Save(ctx)
+	require.NoError(t, err)
+
+	ran := make(chan string, 2)
+	startQueue(t, db.Client, "stuck", func(ctx context.Context, job *queue.Job) (any, error) {
+		ran <- job.ID
+		return nil, nil
+	}, queue.HandlerOptions{})
+
+	job := waitForStatus(t, db.Client, abandoned.ID, queuedjob.StatusCompleted)
+	assert.Equal(t, abandoned.ID, <-ran)
+	assert.Nil(t, job.LockedBy)
+	assert.Equal(t, 1, job.RetryCount, "a rescue is a retry")
+
+	failed := waitForStatus(t, db.Client, exhausted.ID, queuedjob.StatusFailed)
+	assert.Nil(t, failed.LockedBy)
+	assert.Equal(t, 3, failed.RetryCount)
+	require.NotNil(t, failed.Error)
+	assert.Equal(t, "job was abandoned by its worker", *failed.Error)
+	assert.NotNil(t, failed.CompletedAt)
+	assert.Len(t, ran, 0, "the exhausted job is not run")
+
+	current, err := db.Client.QueuedJob.Get(ctx, alive.ID)
+	require.NoError(t, err)
+	assert.Equal(t, queuedjob.StatusProcessing, current.Status)
+	require.NotNil(t, current.LockedBy)
+	assert.Equal(t, "busy-worker", *current.LockedBy)
+}
+
+// producerQueue returns a queue used only to enqueue, as the API server does
+func producerQueue(client *ent.Client) *queue.Queue {
+	return queue.New(client, testQueueConfig())
+}
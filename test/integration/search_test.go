@@ -0,0 +1,185 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/application/search"
+	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/emaillabel"
+	"clockzen-next/internal/ent/receipt"
+)
+
+// TestSearch tests full-text search and filters over indexed messages and
+// receipts, and that other users' data is never returned
+func TestSearch(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	db := SetupTestDatabase(t)
+	defer db.Cleanup(t)
+
+	ctx := context.Background()
+	for _, conn := range []struct{ id, userID string }{{"conn-1", "user-1"}, {"conn-2", "user-2"}} {
+		_, err := db.Client.EmailConnection.Create().
+			SetID(conn.id).
+			SetUserID(conn.userID).
+			SetProviderAccountID("account-" + conn.id).
+			SetEmail(conn.id + "@example.com").
+			SetProvider(emailconnection.ProviderGmail).
+			SetAccessToken("access").
+			SetRefreshToken("refresh").
+			SetTokenExpiry(time.Now().Add(time.Hour)).
+			SetStatus(emailconnection.StatusActive).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+	label, err := db.Client.EmailLabel.Create().
+		SetID("label-receipts").
+		SetConnectionID("conn-1").
+		SetProviderLabelID("Label_7").
+		SetName("Receipts").
+		SetLabelType(emaillabel.LabelTypeUser).
+		Save(ctx)
+	require.NoError(t, err)
+
+	march := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+	june := time.Date(2026, time.June, 5, 12, 0, 0, 0, time.UTC)
+	message := func(connectionID, messageID, sender, subject, snippet string, received time.Time, amount *float64, attachments int, labels ...string) {
+		t.Helper()
+		_, err := db.Client.EmailMessage.Create().
+			SetID(connectionID + "-" + messageID).
+			SetConnectionID(connectionID).
+			SetMessageID(messageID).
+			SetSubject(subject).
+			SetSender(sender).
+			SetSnippet(snippet).
+			SetLabelIds(labels).
+			SetHasAttachments(attachments > 0).
+			SetAttachmentCount(attachments).
+			SetNillableAmount(amount).
+			SetReceivedAt(received).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+	amount := func(v float64) *float64 { return &v }
+
+	message("conn-1", "msg-coffee", "Coffee Corner <receipts@coffeecorner.example>", "Your Coffee Corner receipt", "Thanks for your order of $4.75", march, amount(4.75), 1, "Label_7")
+	message("conn-1", "msg-hardware", "orders@hardware.example", "Hardware store order", "Your order of $129.99 has shipped", june, amount(129.99), 0)
+	message("conn-2", "msg-other", "Coffee Corner <receipts@coffeecorner.example>", "Your Coffee Corner receipt", "Someone else's coffee $5.00", march, amount(5), 1)
+
+	_, err = db.Client.Receipt.Create().
+		SetID("receipt-coffee").
+		SetUserID("user-1").
+		SetSourceType(receipt.SourceTypeEmail).
+		SetSourceID("msg-coffee").
+		SetSourceConnectionID("conn-1").
+		SetFileName("receipt.pdf").
+		SetMimeType("application/pdf").
+		SetMerchantName("Coffee Corner").
+		SetOcrText("COFFEE CORNER\nFlat white 4.75\nTOTAL 4.75").
+		SetTotalAmount(4.75).
+		SetReceiptDate(march).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = db.Client.Receipt.Create().
+		SetID("receipt-upload").
+		SetUserID("user-1").
+		SetSourceType(receipt.SourceTypeUpload).
+		SetFileName("lumber.jpg").
+		SetMimeType("image/jpeg").
+		SetOcrText("Timber Yard lumber 2x4 TOTAL 86.40").
+		SetTotalAmount(86.40).
+		SetReceiptDate(june).
+		Save(ctx)
+	require.NoError(t, err)
+
+	service := search.NewService(db.Client)
+	ids := func(results []search.Result) []string {
+		ids := make([]string, len(results))
+		for i, r := range results {
+			ids[i] = r.ID
+		}
+		return ids
+	}
+
+	t.Run("matches messages and receipts across sources", func(t *testing.T) {
+		results, err := service.Search(ctx, "user-1", search.Query{Text: "coffee"})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"conn-1-msg-coffee", "receipt-coffee"}, ids(results))
+
+		for _, r := range results {
+			assert.Equal(t, "conn-1", r.ConnectionID)
+			assert.Equal(t, "msg-coffee", r.MessageID, "receipts link back to their message")
+		}
+	})
+
+	t.Run("matches OCR text and amounts", func(t *testing.T) {
+		results, err := service.Search(ctx, "user-1", search.Query{Text: "timber"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"receipt-upload"}, ids(results))
+		assert.Contains(t, results[0].Snippet, "Timber Yard")
+
+		results, err = service.Search(ctx, "user-1", search.Query{Text: "129.99"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"conn-1-msg-hardware"}, ids(results))
+	})
+
+	t.Run("date range", func(t *testing.T) {
+		from := time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC)
+		results, err := service.Search(ctx, "user-1", search.Query{From: &from})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"conn-1-msg-hardware", "receipt-upload"}, ids(results))
+	})
+
+	t.Run("amount range", func(t *testing.T) {
+		results, err := service.Search(ctx, "user-1", search.Query{MinAmount: amount(50), MaxAmount: amount(100)})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"receipt-upload"}, ids(results))
+	})
+
+	t.Run("label", func(t *testing.T) {
+		results, err := service.Search(ctx, "user-1", search.Query{LabelID: label.ID})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"conn-1-msg-coffee", "receipt-coffee"}, ids(results))
+
+		_, err = service.Search(ctx, "user-2", search.Query{LabelID: label.ID})
+		assert.ErrorIs(t, err, search.ErrLabelNotFound, "other users' labels are not found")
+	})
+
+	t.Run("has attachment", func(t *testing.T) {
+		without := false
+		results, err := service.Search(ctx, "user-1", search.Query{HasAttachment: &without})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"conn-1-msg-hardware"}, ids(results))
+	})
+
+	t.Run("type", func(t *testing.T) {
+		results, err := service.Search(ctx, "user-1", search.Query{Text: "coffee", Type: search.TypeReceipt})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"receipt-coffee"}, ids(results))
+	})
+
+	t.Run("only the user's own data", func(t *testing.T) {
+		results, err := service.Search(ctx, "user-2", search.Query{Text: "coffee"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"conn-2-msg-other"}, ids(results))
+
+		results, err = service.Search(ctx, "user-3", search.Query{})
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+
+	t.Run("invalid filters", func(t *testing.T) {
+		_, err := service.Search(ctx, "user-1", search.Query{MinAmount: amount(10), MaxAmount: amount(5)})
+		assert.ErrorIs(t, err, search.ErrInvalidAmountRange)
+
+		_, err = service.Search(ctx, "user-1", search.Query{Type: "drive"})
+		assert.ErrorIs(t, err, search.ErrInvalidType)
+	})
+}
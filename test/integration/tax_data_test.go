@@ -0,0 +1,68 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"clockzen-next/internal/application/retirement"
+)
+
+// TestTaxDataStoreInstalledYears tests that installed tax years are saved
+// in the database and loaded again by a restarted store
+func TestTaxDataStoreInstalledYears(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	db := SetupTestDatabase(t)
+	defer db.Cleanup(t)
+
+	ctx := context.Background()
+	store, err := retirement.NewTaxDataStore()
+	require.NoError(t, err)
+	require.NoError(t, store.LoadDB(ctx, db.Client))
+
+	// A new year is the built-in latest year with a new tax year
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(taxYearJSON(t, store, store.Active().TaxYear), &fields))
+	newYear := store.Active().TaxYear + 1
+	fields["tax_year"] = newYear
+	data, err := json.Marshal(fields)
+	require.NoError(t, err)
+
+	_, err = store.Install(ctx, data, false)
+	require.NoError(t, err)
+	saved, err := db.Client.TaxYear.Get(ctx, newYear)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(saved.Data))
+
+	// Installing the year again replaces it
+	fields["standard_deduction"] = 40000
+	data, err = json.Marshal(fields)
+	require.NoError(t, err)
+	_, err = store.Install(ctx, data, true)
+	require.NoError(t, err)
+	count, err := db.Client.TaxYear.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	restarted, err := retirement.NewTaxDataStore()
+	require.NoError(t, err)
+	require.NoError(t, restarted.LoadDB(ctx, db.Client))
+	assert.Equal(t, newYear, restarted.Active().TaxYear)
+	assert.Equal(t, 40000.0, restarted.Active().StandardDeduction)
+}
+
+// taxYearJSON returns the data of a loaded tax year as a data file
+func taxYearJSON(t *testing.T, store *retirement.TaxDataStore, taxYear int) []byte {
+	t.Helper()
+	year, ok := store.Get(taxYear)
+	require.True(t, ok)
+	data, err := json.Marshal(year)
+	require.NoError(t, err)
+	return data
+}
@@ -2,6 +2,7 @@ package integration
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"os"
 	"testing"
@@ -11,7 +12,9 @@ import (
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 	"github.com/testcontainers/testcontainers-go/wait"
 
+	"clockzen-next/internal/application/users"
 	"clockzen-next/internal/ent"
+	"clockzen-next/internal/infrastructure/dbmigrate"
 
 	_ "github.com/lib/pq"
 )
@@ -58,15 +61,16 @@ func SetupTestDatabase(t *testing.T) *TestDatabase {
 		t.Fatalf("failed to get connection string: %v", err)
 	}
 
-	// Create ent client
+	// Create ent client; tests make connections for users without accounts
 	client, err := ent.Open("postgres", connStr)
 	if err != nil {
 		pgContainer.Terminate(context.Background())
 		t.Fatalf("failed to create ent client: %v", err)
 	}
+	users.Provision(client)
 
 	// Run migrations
-	if err := client.Schema.Create(ctx); err != nil {
+	if err := migrateSchema(ctx, connStr); err != nil {
 		client.Close()
 		pgContainer.Terminate(context.Background())
 		t.Fatalf("failed to run migrations: %v", err)
@@ -120,6 +124,22 @@ func (td *TestDatabase) MustGetPort(t *testing.T) string {
 	return port.Port()
 }
 
+// migrateSchema applies the versioned schema migrations to the database
+func migrateSchema(ctx context.Context, connStr string) error {
+	db, err := sql.Open("postgres", connStr)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	migrator, err := dbmigrate.NewWithDefaults(db)
+	if err != nil {
+		return err
+	}
+	_, err = migrator.Up(ctx, 0)
+	return err
+}
+
 // FormatDSN creates a DSN from individual components
 func FormatDSN(host, port, user, password, database string) string {
 	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
@@ -0,0 +1,179 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appintegration "clockzen-next/internal/application/integration"
+	"clockzen-next/internal/ent"
+	"clockzen-next/internal/ent/googledriveconnection"
+	"clockzen-next/internal/infrastructure/google"
+)
+
+// tokenEndpoint stands in for Google's token endpoint, counting the
+// refresh requests it gets for each refresh token
+type tokenEndpoint struct {
+	mu       sync.Mutex
+	requests map[string]int
+}
+
+// ServeHTTP rotates "valid-refresh" to "rotated-refresh" after a short
+// delay, so concurrent callers overlap, and rejects every other refresh
+// token as revoked
+func (e *tokenEndpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	refreshToken := r.FormValue("refresh_token")
+	e.mu.Lock()
+	e.requests[refreshToken]++
+	e.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if refreshToken != "valid-refresh" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":"invalid_grant","error_description":"Token has been expired or revoked."}`))
+		return
+	}
+	time.Sleep(50 * time.Millisecond)
+	w.Write([]byte(`{"access_token":"new-access","refresh_token":"rotated-refresh","expires_in":3600,"token_type":"Bearer"}`))
+}
+
+// count returns the number of refresh requests made with a refresh token
+func (e *tokenEndpoint) count(refreshToken string) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.requests[refreshToken]
+}
+
+// rewriteTransport sends every request to a test server
+type rewriteTransport struct {
+	target *url.URL
+}
+
+// RoundTrip implements http.RoundTripper
+func (t rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// newTestTokenManager returns a token manager whose refreshes are served
+// by endpoint
+func newTestTokenManager(t *testing.T, client *ent.Client, endpoint *tokenEndpoint) *appintegration.TokenManager {
+	t.Helper()
+	server := httptest.NewServer(endpoint)
+	t.Cleanup(server.Close)
+	target, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	manager := appintegration.NewTokenManagerWithDefaults(client, &google.Config{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RedirectURL:  "http://localhost/callback",
+	}, appintegration.NewTokenStore(client, nil))
+	manager.SetHTTPClient(&http.Client{Transport: rewriteTransport{target: target}})
+	return manager
+}
+
+// createExpiredDriveConnection creates an active Drive connection whose
+// access token has expired
+func createExpiredDriveConnection(t *testing.T, client *ent.Client, id, refreshToken string) *ent.GoogleDriveConnection {
+	t.Helper()
+	conn, err := client.GoogleDriveConnection.Create().
+		SetID(id).
+		SetUserID("user-1").
+		SetGoogleAccountID("google-" + id).
+		SetEmail(id + "@example.com").
+		SetAccessToken("old-access").
+		SetRefreshToken(refreshToken).
+		SetTokenExpiry(time.Now().Add(-time.Minute)).
+		SetStatus(googledriveconnection.StatusActive).
+		Save(context.Background())
+	require.NoError(t, err)
+	return conn
+}
+
+// TestTokenManagerRefresh tests that concurrent callers share one refresh
+// of a connection's token and that a revoked refresh token expires the
+// connection
+func TestTokenManagerRefresh(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	db := SetupTestDatabase(t)
+	defer db.Cleanup(t)
+
+	ctx := context.Background()
+	_, err := db.Client.User.Create().SetID("user-1").Save(ctx)
+	require.NoError(t, err)
+
+	endpoint := &tokenEndpoint{requests: make(map[string]int)}
+	manager := newTestTokenManager(t, db.Client, endpoint)
+
+	t.Run("concurrent callers share one refresh", func(t *testing.T) {
+		conn := createExpiredDriveConnection(t, db.Client, "drive-concurrent", "valid-refresh")
+
+		var (
+			wg      sync.WaitGroup
+			start   = make(chan struct{})
+			fetched atomic.Int32
+		)
+		tokens := make([]*google.Token, 2)
+		errs := make([]error, 2)
+		for i := range tokens {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				tokens[i], errs[i] = manager.DriveToken(ctx, conn.ID)
+				fetched.Add(1)
+			}()
+		}
+		close(start)
+		wg.Wait()
+
+		require.EqualValues(t, 2, fetched.Load())
+		for i := range tokens {
+			require.NoError(t, errs[i])
+			assert.Equal(t, "new-access", tokens[i].AccessToken)
+			assert.Equal(t, "rotated-refresh", tokens[i].RefreshToken)
+		}
+		assert.Equal(t, 1, endpoint.count("valid-refresh"), "exactly one refresh request is made")
+
+		stored, err := db.Client.GoogleDriveConnection.Get(ctx, conn.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "new-access", stored.AccessToken)
+		assert.Equal(t, "rotated-refresh", stored.RefreshToken, "the rotated refresh token is saved")
+		assert.True(t, stored.TokenExpiry.After(time.Now().Add(30*time.Minute)))
+	})
+
+	t.Run("invalid grant expires the connection", func(t *testing.T) {
+		conn := createExpiredDriveConnection(t, db.Client, "drive-revoked", "revoked-refresh")
+
+		_, err := manager.DriveToken(ctx, conn.ID)
+		require.ErrorIs(t, err, google.ErrInvalidGrant)
+		assert.True(t, appintegration.NeedsReauthorization(err))
+
+		stored, err := db.Client.GoogleDriveConnection.Get(ctx, conn.ID)
+		require.NoError(t, err)
+		assert.Equal(t, googledriveconnection.StatusExpired, stored.Status)
+		assert.Equal(t, "revoked-refresh", stored.RefreshToken)
+
+		// The background refresher skips expired connections rather than
+		// retrying them
+		result, err := manager.RefreshExpiring(ctx, time.Now())
+		require.NoError(t, err)
+		assert.Zero(t, result.Expired)
+		assert.Zero(t, result.Failed)
+		assert.Equal(t, 1, endpoint.count("revoked-refresh"), "the revoked token is not retried")
+	})
+}
@@ -0,0 +1,137 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appintegration "clockzen-next/internal/application/integration"
+	"clockzen-next/internal/ent/emailconnection"
+	"clockzen-next/internal/ent/googledriveconnection"
+	"clockzen-next/internal/infrastructure/encryption"
+	"clockzen-next/internal/infrastructure/google"
+)
+
+// TestTokenMigration tests that MigrateTokens encrypts plaintext tokens,
+// re-encrypts tokens sealed with an old key and leaves current ones alone
+func TestTokenMigration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	db := SetupTestDatabase(t)
+	defer db.Cleanup(t)
+
+	ctx := context.Background()
+	oldKey := encryption.Key{ID: "old", Material: bytes.Repeat([]byte{1}, 32)}
+	newKey := encryption.Key{ID: "new", Material: bytes.Repeat([]byte{2}, 32)}
+
+	oldKeyring, err := encryption.NewKeyring([]encryption.Key{oldKey})
+	require.NoError(t, err)
+	keyring, err := encryption.NewKeyring([]encryption.Key{newKey, oldKey})
+	require.NoError(t, err)
+	store := appintegration.NewTokenStore(db.Client, keyring)
+
+	// A plaintext row from before encryption was enabled
+	plain, err := db.Client.EmailConnection.Create().
+		SetID("email-plain").
+		SetUserID("user-1").
+		SetProviderAccountID("account-1").
+		SetEmail("plain@example.com").
+		SetProvider(emailconnection.ProviderGmail).
+		SetAccessToken("plain-access").
+		SetRefreshToken("plain-refresh").
+		SetTokenExpiry(time.Now().Add(time.Hour)).
+		SetStatus(emailconnection.StatusActive).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// A row sealed with the key being rotated out
+	oldAccess, oldRefresh, err := appintegration.NewTokenStore(nil, oldKeyring).
+		Seal(&google.Token{AccessToken: "old-access", RefreshToken: "old-refresh"})
+	require.NoError(t, err)
+	_, err = db.Client.GoogleDriveConnection.Create().
+		SetID("drive-old").
+		SetUserID("user-1").
+		SetGoogleAccountID("google-1").
+		SetEmail("drive@example.com").
+		SetAccessToken(oldAccess).
+		SetRefreshToken(oldRefresh).
+		SetTokenExpiry(time.Now().Add(time.Hour)).
+		SetStatus(googledriveconnection.StatusActive).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// A row already sealed with the primary key
+	currentAccess, currentRefresh, err := store.Seal(&google.Token{AccessToken: "current-access", RefreshToken: "current-refresh"})
+	require.NoError(t, err)
+	_, err = db.Client.EmailConnection.Create().
+		SetID("email-current").
+		SetUserID("user-2").
+		SetProviderAccountID("account-2").
+		SetEmail("current@example.com").
+		SetProvider(emailconnection.ProviderGmail).
+		SetAccessToken(currentAccess).
+		SetRefreshToken(currentRefresh).
+		SetTokenExpiry(time.Now().Add(time.Hour)).
+		SetStatus(emailconnection.StatusActive).
+		Save(ctx)
+	require.NoError(t, err)
+
+	t.Run("dry run writes nothing", func(t *testing.T) {
+		stats, err := store.MigrateTokens(ctx, appintegration.TokenMigrationOptions{DryRun: true})
+		require.NoError(t, err)
+		assert.Equal(t, 1, stats.EmailConnectionsUpdated)
+		assert.Equal(t, 1, stats.DriveConnectionsUpdated)
+
+		conn, err := db.Client.EmailConnection.Get(ctx, plain.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "plain-access", conn.AccessToken)
+	})
+
+	t.Run("migrates plaintext and old keys", func(t *testing.T) {
+		stats, err := store.MigrateTokens(ctx, appintegration.TokenMigrationOptions{BatchSize: 1})
+		require.NoError(t, err)
+		assert.Empty(t, stats.Errors)
+		assert.Equal(t, 2, stats.EmailConnectionsScanned)
+		assert.Equal(t, 1, stats.EmailConnectionsUpdated)
+		assert.Equal(t, 1, stats.DriveConnectionsScanned)
+		assert.Equal(t, 1, stats.DriveConnectionsUpdated)
+
+		conn, err := db.Client.EmailConnection.Get(ctx, plain.ID)
+		require.NoError(t, err)
+		assert.False(t, keyring.NeedsRotation(conn.AccessToken))
+		token, err := store.EmailToken(conn)
+		require.NoError(t, err)
+		assert.Equal(t, "plain-access", token.AccessToken)
+		assert.Equal(t, "plain-refresh", token.RefreshToken)
+
+		drive, err := db.Client.GoogleDriveConnection.Get(ctx, "drive-old")
+		require.NoError(t, err)
+		retired, err := encryption.NewKeyring([]encryption.Key{newKey})
+		require.NoError(t, err)
+		token, err = appintegration.NewTokenStore(db.Client, retired).DriveToken(drive)
+		require.NoError(t, err, "the old key is no longer needed")
+		assert.Equal(t, "old-access", token.AccessToken)
+
+		current, err := db.Client.EmailConnection.Get(ctx, "email-current")
+		require.NoError(t, err)
+		assert.Equal(t, currentAccess, current.AccessToken, "rows sealed with the primary key are untouched")
+	})
+
+	t.Run("re-running changes nothing", func(t *testing.T) {
+		stats, err := store.MigrateTokens(ctx, appintegration.TokenMigrationOptions{})
+		require.NoError(t, err)
+		assert.Zero(t, stats.EmailConnectionsUpdated)
+		assert.Zero(t, stats.DriveConnectionsUpdated)
+	})
+
+	t.Run("requires a keyring", func(t *testing.T) {
+		_, err := appintegration.NewTokenStore(db.Client, nil).MigrateTokens(ctx, appintegration.TokenMigrationOptions{})
+		assert.ErrorIs(t, err, appintegration.ErrTokenKeyRequired)
+	})
+}